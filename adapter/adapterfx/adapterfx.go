@@ -1,9 +1,17 @@
 package adapterfx
 
 import (
+	"gitlab.jiguang.dev/pos-dine/dine/adapter/cartstore"
+	"gitlab.jiguang.dev/pos-dine/dine/adapter/couponverifier"
+	"gitlab.jiguang.dev/pos-dine/dine/adapter/deliveryplatform"
+	"gitlab.jiguang.dev/pos-dine/dine/adapter/miniprogram"
 	"gitlab.jiguang.dev/pos-dine/dine/adapter/mutex"
 	"gitlab.jiguang.dev/pos-dine/dine/adapter/objectstorage"
+	"gitlab.jiguang.dev/pos-dine/dine/adapter/paymentgateway"
+	"gitlab.jiguang.dev/pos-dine/dine/adapter/preorderscheduler"
+	"gitlab.jiguang.dev/pos-dine/dine/adapter/pusher"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/push"
 	"go.uber.org/fx"
 )
 
@@ -18,5 +26,46 @@ var Module = fx.Module(
 			objectstorage.NewStorage,
 			fx.As(new(domain.ObjectStorage)),
 		),
+		fx.Annotate(
+			couponverifier.NewRegistry,
+			fx.ParamTags(`group:"partner_coupon_verifiers"`),
+			fx.As(new(domain.PartnerCouponVerifierRegistry)),
+		),
+		fx.Annotate(
+			deliveryplatform.NewGrabFood,
+			fx.As(new(domain.DeliveryPlatform)),
+			fx.ResultTags(`group:"delivery_platforms"`),
+		),
+		fx.Annotate(
+			deliveryplatform.NewFoodpanda,
+			fx.As(new(domain.DeliveryPlatform)),
+			fx.ResultTags(`group:"delivery_platforms"`),
+		),
+		fx.Annotate(
+			deliveryplatform.NewRegistry,
+			fx.ParamTags(`group:"delivery_platforms"`),
+			fx.As(new(domain.DeliveryPlatformRegistry)),
+		),
+		fx.Annotate(
+			miniprogram.NewClient,
+			fx.As(new(domain.WechatMiniProgram)),
+		),
+		fx.Annotate(
+			cartstore.NewRedisStore,
+			fx.As(new(domain.TableCartStore)),
+		),
+		fx.Annotate(
+			paymentgateway.NewHuifu,
+			fx.As(new(domain.PaymentGateway)),
+		),
+		fx.Annotate(
+			preorderscheduler.NewAsynqScheduler,
+			fx.As(new(domain.PreorderScheduler)),
+		),
+		push.NewHub,
+		fx.Annotate(
+			pusher.NewHubPusher,
+			fx.As(new(domain.Pusher)),
+		),
 	),
 )
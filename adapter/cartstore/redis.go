@@ -0,0 +1,70 @@
+package cartstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	keyPrefix = "cart:table"
+	// cartTTL 购物车过期时间，每次修改后顺延
+	cartTTL = 4 * time.Hour
+)
+
+var _ domain.TableCartStore = (*RedisStore)(nil)
+
+// RedisStore 基于 Redis 的桌台购物车存储，购物车整体以 JSON 保存
+type RedisStore struct {
+	rdb redis.UniversalClient
+}
+
+func NewRedisStore(rdb redis.UniversalClient) *RedisStore {
+	return &RedisStore{
+		rdb: rdb,
+	}
+}
+
+func (s *RedisStore) Get(ctx context.Context, tableID uuid.UUID) (*domain.TableCart, error) {
+	data, err := s.rdb.Get(ctx, key(tableID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return domain.NewTableCart(tableID), nil
+		}
+		return nil, fmt.Errorf("failed to get table cart: %w", err)
+	}
+
+	cart := new(domain.TableCart)
+	if err = json.Unmarshal(data, cart); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal table cart: %w", err)
+	}
+	return cart, nil
+}
+
+func (s *RedisStore) Save(ctx context.Context, cart *domain.TableCart) error {
+	data, err := json.Marshal(cart)
+	if err != nil {
+		return fmt.Errorf("failed to marshal table cart: %w", err)
+	}
+	if err = s.rdb.Set(ctx, key(cart.TableID), data, cartTTL).Err(); err != nil {
+		return fmt.Errorf("failed to save table cart: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisStore) Delete(ctx context.Context, tableID uuid.UUID) error {
+	if err := s.rdb.Del(ctx, key(tableID)).Err(); err != nil {
+		return fmt.Errorf("failed to delete table cart: %w", err)
+	}
+	return nil
+}
+
+func key(tableID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", keyPrefix, tableID)
+}
@@ -0,0 +1,97 @@
+package cartstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	goredislib "github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	store  *RedisStore
+	server *miniredis.Miniredis
+}
+
+func (suite *RedisStoreTestSuite) SetupTest() {
+	server, err := miniredis.Run()
+	suite.Require().NoError(err)
+	suite.server = server
+
+	client := goredislib.NewClient(&goredislib.Options{
+		Addr: server.Addr(),
+	})
+	suite.T().Cleanup(func() { client.Close() })
+	suite.store = NewRedisStore(client)
+}
+
+func (suite *RedisStoreTestSuite) TearDownTest() {
+	if suite.server != nil {
+		suite.server.Close()
+	}
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (suite *RedisStoreTestSuite) TestEmptyCart() {
+	tableID := uuid.New()
+	cart, err := suite.store.Get(context.Background(), tableID)
+	suite.Require().NoError(err)
+	suite.Equal(tableID, cart.TableID)
+	suite.True(cart.IsEmpty())
+}
+
+func (suite *RedisStoreTestSuite) TestSaveAndDelete() {
+	ctx := context.Background()
+	tableID := uuid.New()
+	now := time.Now()
+
+	cart := domain.NewTableCart(tableID)
+	item := &domain.TableCartItem{
+		ProductID:    uuid.New(),
+		ProductName:  "宫保鸡丁",
+		Attrs:        []domain.TableCartAttr{{AttrItemID: uuid.New(), Quantity: 1}},
+		Price:        decimal.NewFromInt(3800),
+		Qty:          1,
+		CustomerID:   uuid.New(),
+		CustomerName: "小明",
+	}
+	suite.Require().NoError(cart.AddItem(item, now))
+	suite.Require().NoError(suite.store.Save(ctx, cart))
+	suite.True(suite.server.TTL(key(tableID)) > 0)
+
+	got, err := suite.store.Get(ctx, tableID)
+	suite.Require().NoError(err)
+	suite.Require().Len(got.Items, 1)
+	suite.Equal(item.ID, got.Items[0].ID)
+	suite.Equal("小明", got.Items[0].CustomerName)
+	suite.True(got.Items[0].Price.Equal(decimal.NewFromInt(3800)))
+	suite.Equal(item.Attrs, got.Items[0].Attrs)
+
+	suite.Require().NoError(suite.store.Delete(ctx, tableID))
+	got, err = suite.store.Get(ctx, tableID)
+	suite.Require().NoError(err)
+	suite.True(got.IsEmpty())
+}
+
+func (suite *RedisStoreTestSuite) TestExpire() {
+	ctx := context.Background()
+	tableID := uuid.New()
+
+	cart := domain.NewTableCart(tableID)
+	suite.Require().NoError(cart.AddItem(&domain.TableCartItem{ProductID: uuid.New(), Qty: 2}, time.Now()))
+	suite.Require().NoError(suite.store.Save(ctx, cart))
+
+	suite.server.FastForward(cartTTL + time.Second)
+	got, err := suite.store.Get(ctx, tableID)
+	suite.Require().NoError(err)
+	suite.True(got.IsEmpty())
+}
@@ -0,0 +1,46 @@
+package couponverifier
+
+import (
+	"slices"
+
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+var _ domain.PartnerCouponVerifierRegistry = (*Registry)(nil)
+
+// Registry 三方券核销器注册表
+//
+// 接入新的三方券平台时，实现 domain.PartnerCouponVerifier 并以
+// `group:"partner_coupon_verifiers"` 注册到 adapterfx 即可。
+type Registry struct {
+	verifiers map[string]domain.PartnerCouponVerifier
+}
+
+func NewRegistry(verifiers []domain.PartnerCouponVerifier) *Registry {
+	r := &Registry{
+		verifiers: make(map[string]domain.PartnerCouponVerifier, len(verifiers)),
+	}
+	for _, v := range verifiers {
+		r.verifiers[v.Provider()] = v
+	}
+	return r
+}
+
+// Get 获取三方券平台的核销器
+func (r *Registry) Get(provider string) (domain.PartnerCouponVerifier, error) {
+	v, ok := r.verifiers[provider]
+	if !ok {
+		return nil, domain.ParamsError(domain.ErrPartnerCouponProviderInvalid)
+	}
+	return v, nil
+}
+
+// Providers 已注册的三方券平台
+func (r *Registry) Providers() []string {
+	providers := make([]string, 0, len(r.verifiers))
+	for provider := range r.verifiers {
+		providers = append(providers, provider)
+	}
+	slices.Sort(providers)
+	return providers
+}
@@ -0,0 +1,163 @@
+package deliveryplatform
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// tokenExpiryLeeway 令牌提前刷新时间，避免请求途中过期
+const tokenExpiryLeeway = time.Minute
+
+// tokenFetcher 向平台申请访问令牌，返回令牌及有效期
+type tokenFetcher func(ctx context.Context, hc *http.Client) (token string, expiresIn time.Duration, err error)
+
+// partnerClient 外卖平台开放接口客户端，缓存客户端凭证令牌，令牌失效时重新申请
+type partnerClient struct {
+	name    string
+	baseURL string
+	hc      *http.Client
+	fetch   tokenFetcher
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func newPartnerClient(name string, cfg domain.DeliveryPlatformConfig, fetch tokenFetcher) *partnerClient {
+	timeout := time.Duration(cfg.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &partnerClient{
+		name:    name,
+		baseURL: strings.TrimRight(cfg.BaseURL, "/"),
+		hc:      &http.Client{Timeout: timeout},
+		fetch:   fetch,
+	}
+}
+
+func (c *partnerClient) accessToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && time.Now().Before(c.expiresAt) {
+		return c.token, nil
+	}
+	token, expiresIn, err := c.fetch(ctx, c.hc)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch %s access token: %w", c.name, err)
+	}
+	c.token = token
+	c.expiresAt = time.Now().Add(expiresIn - tokenExpiryLeeway)
+	return token, nil
+}
+
+func (c *partnerClient) resetToken() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = ""
+}
+
+// do 以 JSON 请求开放接口，out 为空时忽略响应内容；令牌被拒绝时清除缓存，下次请求重新申请
+func (c *partnerClient) do(ctx context.Context, method, path string, in, out any) error {
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s request: %w", c.name, err)
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to build %s request: %w", c.name, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request %s %s: %w", c.name, path, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read %s response: %w", c.name, err)
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		c.resetToken()
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s %s responded %d: %s", c.name, path, resp.StatusCode, data)
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("failed to unmarshal %s response: %w", c.name, err)
+		}
+	}
+	return nil
+}
+
+// postToken 申请令牌的通用请求，响应为 OAuth2 标准格式
+func postToken(ctx context.Context, hc *http.Client, url, contentType string, body []byte) (string, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("token endpoint responded %d: %s", resp.StatusCode, data)
+	}
+	var res struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return "", 0, err
+	}
+	if res.AccessToken == "" {
+		return "", 0, fmt.Errorf("token endpoint returned empty access_token")
+	}
+	return res.AccessToken, time.Duration(res.ExpiresIn) * time.Second, nil
+}
+
+// verifySignature 校验推送内容的 HMAC-SHA256 签名（十六进制），未配置密钥时一律拒绝
+func verifySignature(secret string, body []byte, signature string) bool {
+	if secret == "" || signature == "" {
+		return false
+	}
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
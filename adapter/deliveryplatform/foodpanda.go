@@ -0,0 +1,253 @@
+package deliveryplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+// FoodpandaSignatureHeader Foodpanda 推送签名请求头
+const FoodpandaSignatureHeader = "X-Foodpanda-Signature"
+
+var _ domain.DeliveryPlatform = (*Foodpanda)(nil)
+
+// Foodpanda Foodpanda POS 插件接口适配器
+type Foodpanda struct {
+	cfg    domain.DeliveryPlatformConfig
+	client *partnerClient
+}
+
+func NewFoodpanda(cfg domain.DeliveryConfig) *Foodpanda {
+	f := &Foodpanda{cfg: cfg.Foodpanda}
+	f.client = newPartnerClient("foodpanda", cfg.Foodpanda, f.fetchToken)
+	return f
+}
+
+func (f *Foodpanda) Code() domain.DeliveryPlatformCode {
+	return domain.DeliveryPlatformFoodpanda
+}
+
+// foodpandaWebhook 新订单与订单状态推送，状态推送带 status 字段
+type foodpandaWebhook struct {
+	Token              string `json:"token"`
+	ShortCode          string `json:"shortCode"`
+	Status             string `json:"status"`
+	CreatedAt          string `json:"createdAt"`
+	PlatformRestaurant struct {
+		ID string `json:"id"`
+	} `json:"platformRestaurant"`
+	Customer struct {
+		FirstName   string `json:"firstName"`
+		LastName    string `json:"lastName"`
+		MobilePhone string `json:"mobilePhone"`
+	} `json:"customer"`
+	Delivery struct {
+		Address struct {
+			Street   string `json:"street"`
+			Number   string `json:"number"`
+			City     string `json:"city"`
+			Postcode string `json:"postcode"`
+		} `json:"address"`
+	} `json:"delivery"`
+	Price struct {
+		SubTotal     decimal.Decimal `json:"subTotal"`
+		DeliveryFees []struct {
+			Value decimal.Decimal `json:"value"`
+		} `json:"deliveryFees"`
+		GrandTotal decimal.Decimal `json:"grandTotal"`
+	} `json:"price"`
+	Products []struct {
+		RemoteCode string          `json:"remoteCode"`
+		Name       string          `json:"name"`
+		Quantity   int             `json:"quantity"`
+		UnitPrice  decimal.Decimal `json:"unitPrice"`
+		Comment    string          `json:"comment"`
+	} `json:"products"`
+	Comments struct {
+		CustomerComment string `json:"customerComment"`
+	} `json:"comments"`
+}
+
+func (f *Foodpanda) ParseWebhook(ctx context.Context, header http.Header, body []byte) (res *domain.DeliveryWebhookEvent, err error) {
+	span, _ := util.StartSpan(ctx, "adapter", "Foodpanda.ParseWebhook")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if !verifySignature(f.cfg.WebhookSecret, body, header.Get(FoodpandaSignatureHeader)) {
+		return nil, domain.ErrDeliverySignatureInvalid
+	}
+	var w foodpandaWebhook
+	if err = json.Unmarshal(body, &w); err != nil || w.Token == "" || w.PlatformRestaurant.ID == "" {
+		return nil, domain.ErrDeliveryPayloadInvalid
+	}
+
+	res = &domain.DeliveryWebhookEvent{
+		PlatformStoreID: w.PlatformRestaurant.ID,
+		PlatformOrderID: w.Token,
+	}
+	switch w.Status {
+	case "":
+		res.Type = domain.DeliveryWebhookOrderCreated
+	case "ORDER_CANCELLED":
+		res.Type = domain.DeliveryWebhookOrderCancelled
+		return res, nil
+	default:
+		return nil, nil
+	}
+
+	order := &domain.DeliveryPlatformOrder{
+		ShortCode:     w.ShortCode,
+		Subtotal:      w.Price.SubTotal,
+		Total:         w.Price.GrandTotal,
+		CustomerName:  strings.TrimSpace(w.Customer.FirstName + " " + w.Customer.LastName),
+		CustomerPhone: w.Customer.MobilePhone,
+		Remark:        w.Comments.CustomerComment,
+		PlacedAt:      time.Now(),
+	}
+	for _, fee := range w.Price.DeliveryFees {
+		order.DeliveryFee = order.DeliveryFee.Add(fee.Value)
+	}
+	addr := w.Delivery.Address
+	order.Address = strings.Join(nonEmpty(strings.TrimSpace(addr.Street+" "+addr.Number), addr.City, addr.Postcode), ", ")
+	if w.CreatedAt != "" {
+		if t, err := time.Parse(time.RFC3339, w.CreatedAt); err == nil {
+			order.PlacedAt = t
+		}
+	}
+	for _, p := range w.Products {
+		if p.RemoteCode == "" || p.Quantity <= 0 {
+			return nil, domain.ErrDeliveryPayloadInvalid
+		}
+		order.Items = append(order.Items, domain.DeliveryOrderItem{
+			SKU:    p.RemoteCode,
+			Name:   p.Name,
+			Qty:    p.Quantity,
+			Price:  p.UnitPrice,
+			Remark: p.Comment,
+		})
+	}
+	if len(order.Items) == 0 {
+		return nil, domain.ErrDeliveryPayloadInvalid
+	}
+	res.Order = order
+	return res, nil
+}
+
+func (f *Foodpanda) AcceptOrder(ctx context.Context, platformStoreID, platformOrderID string) (err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "Foodpanda.AcceptOrder")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return f.client.do(ctx, http.MethodPost, "/v2/order/status/"+url.PathEscape(platformOrderID), map[string]any{
+		"status":         "order_accepted",
+		"acceptanceTime": time.Now().Format(time.RFC3339),
+	}, nil)
+}
+
+func (f *Foodpanda) RejectOrder(ctx context.Context, platformStoreID, platformOrderID, reason string) (err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "Foodpanda.RejectOrder")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return f.client.do(ctx, http.MethodPost, "/v2/order/status/"+url.PathEscape(platformOrderID), map[string]any{
+		"status":  "order_rejected",
+		"reason":  "CLOSED",
+		"message": reason,
+	}, nil)
+}
+
+func (f *Foodpanda) MarkReady(ctx context.Context, platformStoreID, platformOrderID string) (err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "Foodpanda.MarkReady")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return f.client.do(ctx, http.MethodPost, "/v2/orders/"+url.PathEscape(platformOrderID)+"/preparation-completed", nil, nil)
+}
+
+func (f *Foodpanda) PushMenu(ctx context.Context, platformStoreID string, menu *domain.DeliveryMenu) (err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "Foodpanda.PushMenu")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	type product struct {
+		RemoteCode  string          `json:"remoteCode"`
+		Title       string          `json:"title"`
+		Description string          `json:"description"`
+		ImageURL    string          `json:"imageUrl,omitempty"`
+		Price       decimal.Decimal `json:"price"`
+		Active      bool            `json:"active"`
+	}
+	type category struct {
+		Title    string    `json:"title"`
+		Products []product `json:"products"`
+	}
+	categories := make([]category, 0, len(menu.Categories))
+	for _, c := range menu.Categories {
+		cat := category{Title: c.Name}
+		for _, i := range c.Items {
+			cat.Products = append(cat.Products, product{
+				RemoteCode:  i.SKU,
+				Title:       i.Name,
+				Description: i.Description,
+				ImageURL:    i.Image,
+				Price:       i.Price,
+				Active:      i.Available,
+			})
+		}
+		categories = append(categories, cat)
+	}
+	return f.client.do(ctx, http.MethodPut, "/v2/vendors/"+url.PathEscape(platformStoreID)+"/menu", map[string]any{
+		"categories": categories,
+	}, nil)
+}
+
+func (f *Foodpanda) UpdateAvailability(ctx context.Context, platformStoreID string, items []domain.DeliverySKUAvailability) (err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "Foodpanda.UpdateAvailability")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	products := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		products = append(products, map[string]any{
+			"remoteCode": item.SKU,
+			"active":     item.Available,
+		})
+	}
+	return f.client.do(ctx, http.MethodPut, "/v2/vendors/"+url.PathEscape(platformStoreID)+"/products/availability", map[string]any{
+		"products": products,
+	}, nil)
+}
+
+// fetchToken 以客户端凭证登录申请访问令牌
+func (f *Foodpanda) fetchToken(ctx context.Context, hc *http.Client) (string, time.Duration, error) {
+	form := url.Values{
+		"username":   {f.cfg.ClientID},
+		"password":   {f.cfg.ClientSecret},
+		"grant_type": {"client_credentials"},
+	}
+	return postToken(ctx, hc, strings.TrimRight(f.cfg.BaseURL, "/")+"/v2/login",
+		"application/x-www-form-urlencoded", []byte(form.Encode()))
+}
+
+func nonEmpty(values ...string) []string {
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
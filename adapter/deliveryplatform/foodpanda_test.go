@@ -0,0 +1,97 @@
+package deliveryplatform
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"gitlab.jiguang.dev/pos-dine/dine/adapter/deliveryplatform/platformtest"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+func TestFoodpanda_ParseWebhook(t *testing.T) {
+	srv := platformtest.NewFoodpanda()
+	defer srv.Close()
+	f := NewFoodpanda(domain.DeliveryConfig{Foodpanda: srv.Config()})
+	ctx := context.Background()
+
+	body := []byte(`{
+		"token": "FP-2001",
+		"shortCode": "A12",
+		"createdAt": "2026-10-25T11:30:00Z",
+		"platformRestaurant": {"id": "vendor-1"},
+		"customer": {"firstName": "Siti", "lastName": "Aminah", "mobilePhone": "60123456789"},
+		"delivery": {"address": {"street": "Jalan Ampang", "number": "8", "city": "Kuala Lumpur", "postcode": "50450"}},
+		"price": {"subTotal": "36.00", "deliveryFees": [{"name": "delivery", "value": "4.90"}], "grandTotal": "40.90"},
+		"products": [{"remoteCode": "SKU-A", "name": "Nasi Lemak", "quantity": 2, "unitPrice": "18.00"}],
+		"comments": {"customerComment": "no cutlery"}
+	}`)
+
+	t.Run("新订单", func(t *testing.T) {
+		header := http.Header{}
+		header.Set(FoodpandaSignatureHeader, srv.Sign(body))
+		event, err := f.ParseWebhook(ctx, header, body)
+		require.NoError(t, err)
+		require.Equal(t, domain.DeliveryWebhookOrderCreated, event.Type)
+		require.Equal(t, "vendor-1", event.PlatformStoreID)
+		require.Equal(t, "FP-2001", event.PlatformOrderID)
+
+		order := event.Order
+		require.Equal(t, "A12", order.ShortCode)
+		require.Equal(t, "Siti Aminah", order.CustomerName)
+		require.Equal(t, "Jalan Ampang 8, Kuala Lumpur, 50450", order.Address)
+		require.Equal(t, "no cutlery", order.Remark)
+		require.True(t, decimal.RequireFromString("4.9").Equal(order.DeliveryFee))
+		require.True(t, decimal.RequireFromString("40.9").Equal(order.Total))
+		require.Len(t, order.Items, 1)
+		require.True(t, decimal.NewFromInt(18).Equal(order.Items[0].Price))
+	})
+
+	t.Run("签名无效", func(t *testing.T) {
+		header := http.Header{}
+		header.Set(FoodpandaSignatureHeader, "not-hex")
+		_, err := f.ParseWebhook(ctx, header, body)
+		require.ErrorIs(t, err, domain.ErrDeliverySignatureInvalid)
+	})
+
+	t.Run("内容无效", func(t *testing.T) {
+		invalid := []byte(`{"token": "FP-2002", "platformRestaurant": {"id": "vendor-1"}, "products": []}`)
+		header := http.Header{}
+		header.Set(FoodpandaSignatureHeader, srv.Sign(invalid))
+		_, err := f.ParseWebhook(ctx, header, invalid)
+		require.ErrorIs(t, err, domain.ErrDeliveryPayloadInvalid)
+	})
+
+	t.Run("取消订单", func(t *testing.T) {
+		cancelled := []byte(`{"token": "FP-2001", "platformRestaurant": {"id": "vendor-1"}, "status": "ORDER_CANCELLED"}`)
+		header := http.Header{}
+		header.Set(FoodpandaSignatureHeader, srv.Sign(cancelled))
+		event, err := f.ParseWebhook(ctx, header, cancelled)
+		require.NoError(t, err)
+		require.Equal(t, domain.DeliveryWebhookOrderCancelled, event.Type)
+	})
+}
+
+func TestFoodpanda_PartnerAPI(t *testing.T) {
+	srv := platformtest.NewFoodpanda()
+	defer srv.Close()
+	f := NewFoodpanda(domain.DeliveryConfig{Foodpanda: srv.Config()})
+	ctx := context.Background()
+
+	require.NoError(t, f.AcceptOrder(ctx, "vendor-1", "FP-2001"))
+	require.NoError(t, f.RejectOrder(ctx, "vendor-1", "FP-2002", "门店打烊"))
+	require.NoError(t, f.MarkReady(ctx, "vendor-1", "FP-2001"))
+	require.NoError(t, f.UpdateAvailability(ctx, "vendor-1", []domain.DeliverySKUAvailability{{SKU: "SKU-A", Available: true}}))
+
+	reqs := srv.Requests()
+	require.Len(t, reqs, 4)
+	require.Equal(t, "/v2/order/status/FP-2001", reqs[0].Path)
+	require.Equal(t, "order_accepted", reqs[0].Body["status"])
+	require.Equal(t, "order_rejected", reqs[1].Body["status"])
+	require.Equal(t, "门店打烊", reqs[1].Body["message"])
+	require.Equal(t, "/v2/orders/FP-2001/preparation-completed", reqs[2].Path)
+	require.Equal(t, "/v2/vendors/vendor-1/products/availability", reqs[3].Path)
+	require.Equal(t, 1, srv.TokenCount())
+}
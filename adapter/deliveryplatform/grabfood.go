@@ -0,0 +1,261 @@
+package deliveryplatform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+// GrabFoodSignatureHeader GrabFood 推送签名请求头
+const GrabFoodSignatureHeader = "X-Grab-Signature"
+
+// grabFoodDefaultExponent 未返回币种精度时按两位小数处理
+const grabFoodDefaultExponent = 2
+
+var _ domain.DeliveryPlatform = (*GrabFood)(nil)
+
+// GrabFood GrabFood 合作方接口适配器
+type GrabFood struct {
+	cfg    domain.DeliveryPlatformConfig
+	client *partnerClient
+}
+
+func NewGrabFood(cfg domain.DeliveryConfig) *GrabFood {
+	g := &GrabFood{cfg: cfg.GrabFood}
+	g.client = newPartnerClient("grabfood", cfg.GrabFood, g.fetchToken)
+	return g
+}
+
+func (g *GrabFood) Code() domain.DeliveryPlatformCode {
+	return domain.DeliveryPlatformGrabFood
+}
+
+// grabFoodWebhook 订单推送与订单状态推送共用一个回调地址，以 state 区分
+type grabFoodWebhook struct {
+	OrderID           string `json:"orderID"`
+	ShortOrderNumber  string `json:"shortOrderNumber"`
+	PartnerMerchantID string `json:"partnerMerchantID"`
+	State             string `json:"state"`
+	OrderTime         string `json:"orderTime"`
+	Currency          struct {
+		Exponent *int32 `json:"exponent"`
+	} `json:"currency"`
+	Items []struct {
+		ID       string `json:"id"`
+		Name     string `json:"name"`
+		Quantity int    `json:"quantity"`
+		Price    int64  `json:"price"`
+		Comment  string `json:"comment"`
+	} `json:"items"`
+	Price struct {
+		Subtotal     int64 `json:"subtotal"`
+		DeliveryFee  int64 `json:"deliveryFee"`
+		EaterPayment int64 `json:"eaterPayment"`
+	} `json:"price"`
+	Receiver struct {
+		Name    string `json:"name"`
+		Phones  string `json:"phones"`
+		Address struct {
+			Address string `json:"address"`
+		} `json:"address"`
+	} `json:"receiver"`
+	Comment string `json:"comment"`
+}
+
+func (g *GrabFood) ParseWebhook(ctx context.Context, header http.Header, body []byte) (res *domain.DeliveryWebhookEvent, err error) {
+	span, _ := util.StartSpan(ctx, "adapter", "GrabFood.ParseWebhook")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if !verifySignature(g.cfg.WebhookSecret, body, header.Get(GrabFoodSignatureHeader)) {
+		return nil, domain.ErrDeliverySignatureInvalid
+	}
+	var w grabFoodWebhook
+	if err = json.Unmarshal(body, &w); err != nil || w.OrderID == "" || w.PartnerMerchantID == "" {
+		return nil, domain.ErrDeliveryPayloadInvalid
+	}
+
+	res = &domain.DeliveryWebhookEvent{
+		PlatformStoreID: w.PartnerMerchantID,
+		PlatformOrderID: w.OrderID,
+	}
+	switch w.State {
+	case "":
+		res.Type = domain.DeliveryWebhookOrderCreated
+	case "CANCELLED":
+		res.Type = domain.DeliveryWebhookOrderCancelled
+		return res, nil
+	default:
+		// 其他状态（骑手取餐、送达等）无需处理
+		return nil, nil
+	}
+
+	exp := int32(grabFoodDefaultExponent)
+	if w.Currency.Exponent != nil {
+		exp = *w.Currency.Exponent
+	}
+	amount := func(v int64) decimal.Decimal {
+		return decimal.New(v, -exp)
+	}
+	order := &domain.DeliveryPlatformOrder{
+		ShortCode:     w.ShortOrderNumber,
+		Subtotal:      amount(w.Price.Subtotal),
+		DeliveryFee:   amount(w.Price.DeliveryFee),
+		Total:         amount(w.Price.EaterPayment),
+		CustomerName:  w.Receiver.Name,
+		CustomerPhone: w.Receiver.Phones,
+		Address:       w.Receiver.Address.Address,
+		Remark:        w.Comment,
+		PlacedAt:      time.Now(),
+	}
+	if w.OrderTime != "" {
+		if t, err := time.Parse(time.RFC3339, w.OrderTime); err == nil {
+			order.PlacedAt = t
+		}
+	}
+	for _, item := range w.Items {
+		if item.ID == "" || item.Quantity <= 0 {
+			return nil, domain.ErrDeliveryPayloadInvalid
+		}
+		// Grab 的商品价格为该行合计
+		order.Items = append(order.Items, domain.DeliveryOrderItem{
+			SKU:    item.ID,
+			Name:   item.Name,
+			Qty:    item.Quantity,
+			Price:  amount(item.Price).Div(decimal.NewFromInt(int64(item.Quantity))),
+			Remark: item.Comment,
+		})
+	}
+	if len(order.Items) == 0 {
+		return nil, domain.ErrDeliveryPayloadInvalid
+	}
+	res.Order = order
+	return res, nil
+}
+
+func (g *GrabFood) AcceptOrder(ctx context.Context, platformStoreID, platformOrderID string) (err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "GrabFood.AcceptOrder")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return g.client.do(ctx, http.MethodPost, "/partner/v1/order/prepare", map[string]any{
+		"orderID": platformOrderID,
+		"toState": "Accepted",
+	}, nil)
+}
+
+func (g *GrabFood) RejectOrder(ctx context.Context, platformStoreID, platformOrderID, reason string) (err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "GrabFood.RejectOrder")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return g.client.do(ctx, http.MethodPost, "/partner/v1/order/prepare", map[string]any{
+		"orderID": platformOrderID,
+		"toState": "Rejected",
+	}, nil)
+}
+
+func (g *GrabFood) MarkReady(ctx context.Context, platformStoreID, platformOrderID string) (err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "GrabFood.MarkReady")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return g.client.do(ctx, http.MethodPost, "/partner/v1/orders/mark", map[string]any{
+		"orderID":    platformOrderID,
+		"markStatus": 1,
+	}, nil)
+}
+
+func (g *GrabFood) PushMenu(ctx context.Context, platformStoreID string, menu *domain.DeliveryMenu) (err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "GrabFood.PushMenu")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	type item struct {
+		ID              string   `json:"id"`
+		Name            string   `json:"name"`
+		Description     string   `json:"description"`
+		Photos          []string `json:"photos"`
+		Price           int64    `json:"price"`
+		AvailableStatus string   `json:"availableStatus"`
+	}
+	type category struct {
+		ID    string `json:"id"`
+		Name  string `json:"name"`
+		Items []item `json:"items"`
+	}
+	categories := make([]category, 0, len(menu.Categories))
+	for idx, c := range menu.Categories {
+		cat := category{ID: fmt.Sprintf("C%03d", idx+1), Name: c.Name}
+		for _, i := range c.Items {
+			it := item{
+				ID:              i.SKU,
+				Name:            i.Name,
+				Description:     i.Description,
+				Price:           i.Price.Shift(grabFoodDefaultExponent).Round(0).IntPart(),
+				AvailableStatus: grabFoodAvailableStatus(i.Available),
+			}
+			if i.Image != "" {
+				it.Photos = []string{i.Image}
+			}
+			cat.Items = append(cat.Items, it)
+		}
+		categories = append(categories, cat)
+	}
+	return g.client.do(ctx, http.MethodPut, "/partner/v1/menu", map[string]any{
+		"merchantID": platformStoreID,
+		"categories": categories,
+	}, nil)
+}
+
+func (g *GrabFood) UpdateAvailability(ctx context.Context, platformStoreID string, items []domain.DeliverySKUAvailability) (err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "GrabFood.UpdateAvailability")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	entities := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		entities = append(entities, map[string]any{
+			"id":              item.SKU,
+			"availableStatus": grabFoodAvailableStatus(item.Available),
+		})
+	}
+	return g.client.do(ctx, http.MethodPut, "/partner/v1/batch/menu", map[string]any{
+		"merchantID":   platformStoreID,
+		"field":        "ITEM",
+		"menuEntities": entities,
+	}, nil)
+}
+
+// fetchToken 以客户端凭证申请访问令牌
+func (g *GrabFood) fetchToken(ctx context.Context, hc *http.Client) (string, time.Duration, error) {
+	form := url.Values{
+		"client_id":     {g.cfg.ClientID},
+		"client_secret": {g.cfg.ClientSecret},
+		"grant_type":    {"client_credentials"},
+		"scope":         {"food.partner_api"},
+	}
+	return postToken(ctx, hc, strings.TrimRight(g.cfg.BaseURL, "/")+"/grabid/v1/oauth2/token",
+		"application/x-www-form-urlencoded", []byte(form.Encode()))
+}
+
+func grabFoodAvailableStatus(available bool) string {
+	if available {
+		return "AVAILABLE"
+	}
+	return "UNAVAILABLE"
+}
@@ -0,0 +1,124 @@
+package deliveryplatform
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"gitlab.jiguang.dev/pos-dine/dine/adapter/deliveryplatform/platformtest"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+func TestGrabFood_ParseWebhook(t *testing.T) {
+	srv := platformtest.NewGrabFood()
+	defer srv.Close()
+	g := NewGrabFood(domain.DeliveryConfig{GrabFood: srv.Config()})
+	ctx := context.Background()
+
+	body := []byte(`{
+		"orderID": "GF-1001",
+		"shortOrderNumber": "GF-188",
+		"partnerMerchantID": "store-1",
+		"orderTime": "2026-10-25T11:30:00Z",
+		"currency": {"code": "SGD", "exponent": 2},
+		"items": [
+			{"id": "SKU-A", "name": "Beef Rice", "quantity": 2, "price": 2400, "comment": "less spicy"},
+			{"id": "SKU-B", "name": "Iced Tea", "quantity": 1, "price": 350}
+		],
+		"price": {"subtotal": 2750, "deliveryFee": 300, "eaterPayment": 3050},
+		"receiver": {"name": "Tan", "phones": "6588888888", "address": {"address": "1 Orchard Rd"}}
+	}`)
+
+	t.Run("新订单", func(t *testing.T) {
+		header := http.Header{}
+		header.Set(GrabFoodSignatureHeader, srv.Sign(body))
+		event, err := g.ParseWebhook(ctx, header, body)
+		require.NoError(t, err)
+		require.Equal(t, domain.DeliveryWebhookOrderCreated, event.Type)
+		require.Equal(t, "store-1", event.PlatformStoreID)
+		require.Equal(t, "GF-1001", event.PlatformOrderID)
+
+		order := event.Order
+		require.Equal(t, "GF-188", order.ShortCode)
+		require.Len(t, order.Items, 2)
+		require.True(t, decimal.NewFromInt(12).Equal(order.Items[0].Price))
+		require.Equal(t, 2, order.Items[0].Qty)
+		require.Equal(t, "less spicy", order.Items[0].Remark)
+		require.True(t, decimal.RequireFromString("27.5").Equal(order.Subtotal))
+		require.True(t, decimal.NewFromInt(3).Equal(order.DeliveryFee))
+		require.True(t, decimal.RequireFromString("30.5").Equal(order.Total))
+		require.Equal(t, "1 Orchard Rd", order.Address)
+		require.Equal(t, 2026, order.PlacedAt.Year())
+	})
+
+	t.Run("签名无效", func(t *testing.T) {
+		header := http.Header{}
+		header.Set(GrabFoodSignatureHeader, srv.Sign([]byte("tampered")))
+		_, err := g.ParseWebhook(ctx, header, body)
+		require.ErrorIs(t, err, domain.ErrDeliverySignatureInvalid)
+
+		_, err = g.ParseWebhook(ctx, http.Header{}, body)
+		require.ErrorIs(t, err, domain.ErrDeliverySignatureInvalid)
+	})
+
+	t.Run("订单状态推送", func(t *testing.T) {
+		cancelled := []byte(`{"orderID": "GF-1001", "partnerMerchantID": "store-1", "state": "CANCELLED"}`)
+		header := http.Header{}
+		header.Set(GrabFoodSignatureHeader, srv.Sign(cancelled))
+		event, err := g.ParseWebhook(ctx, header, cancelled)
+		require.NoError(t, err)
+		require.Equal(t, domain.DeliveryWebhookOrderCancelled, event.Type)
+		require.Nil(t, event.Order)
+
+		delivered := []byte(`{"orderID": "GF-1001", "partnerMerchantID": "store-1", "state": "DELIVERED"}`)
+		header.Set(GrabFoodSignatureHeader, srv.Sign(delivered))
+		event, err = g.ParseWebhook(ctx, header, delivered)
+		require.NoError(t, err)
+		require.Nil(t, event)
+	})
+}
+
+func TestGrabFood_PartnerAPI(t *testing.T) {
+	srv := platformtest.NewGrabFood()
+	defer srv.Close()
+	g := NewGrabFood(domain.DeliveryConfig{GrabFood: srv.Config()})
+	ctx := context.Background()
+
+	require.NoError(t, g.AcceptOrder(ctx, "store-1", "GF-1001"))
+	require.NoError(t, g.MarkReady(ctx, "store-1", "GF-1001"))
+	require.NoError(t, g.PushMenu(ctx, "store-1", &domain.DeliveryMenu{
+		Categories: []domain.DeliveryMenuCategory{{
+			Name: "主食",
+			Items: []domain.DeliveryMenuItem{
+				{SKU: "SKU-A", Name: "牛肉饭", Price: decimal.RequireFromString("12.5"), Available: true},
+			},
+		}},
+	}))
+	require.NoError(t, g.UpdateAvailability(ctx, "store-1", []domain.DeliverySKUAvailability{{SKU: "SKU-A"}}))
+	require.Equal(t, 1, srv.TokenCount(), "令牌应缓存复用")
+
+	reqs := srv.Requests()
+	require.Len(t, reqs, 4)
+	require.Equal(t, "/partner/v1/order/prepare", reqs[0].Path)
+	require.Equal(t, "Accepted", reqs[0].Body["toState"])
+	require.Equal(t, "/partner/v1/orders/mark", reqs[1].Path)
+	require.Equal(t, http.MethodPut, reqs[2].Method)
+	item := reqs[2].Body["categories"].([]any)[0].(map[string]any)["items"].([]any)[0].(map[string]any)
+	require.EqualValues(t, 1250, item["price"])
+	entity := reqs[3].Body["menuEntities"].([]any)[0].(map[string]any)
+	require.Equal(t, "UNAVAILABLE", entity["availableStatus"])
+
+	t.Run("令牌失效后重新申请", func(t *testing.T) {
+		srv.RevokeToken()
+		require.Error(t, g.RejectOrder(ctx, "store-1", "GF-1002", "售罄"))
+		require.NoError(t, g.RejectOrder(ctx, "store-1", "GF-1002", "售罄"))
+		require.Equal(t, 2, srv.TokenCount())
+	})
+
+	t.Run("平台返回错误", func(t *testing.T) {
+		srv.FailNext(http.StatusConflict)
+		require.Error(t, g.AcceptOrder(ctx, "store-1", "GF-1003"))
+	})
+}
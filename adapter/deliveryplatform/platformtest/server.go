@@ -0,0 +1,168 @@
+// Package platformtest 提供外卖平台开放接口的本地替身服务，用于适配器测试和本地联调：
+// 签发访问令牌、记录接口请求，并按平台算法为模拟推送签名。
+package platformtest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	ClientID      = "stand-in-client"
+	ClientSecret  = "stand-in-secret"
+	WebhookSecret = "stand-in-webhook-secret"
+
+	grabFoodTokenPath  = "/grabid/v1/oauth2/token"
+	foodpandaTokenPath = "/v2/login"
+)
+
+// Request 替身服务收到的开放接口请求（不含申请令牌）
+type Request struct {
+	Method string
+	Path   string
+	Body   map[string]any
+}
+
+// Server 外卖平台替身服务
+type Server struct {
+	*httptest.Server
+
+	tokenPath string
+
+	mu       sync.Mutex
+	token    string
+	tokens   int
+	requests []Request
+	failures []int
+}
+
+// NewGrabFood 启动 GrabFood 替身服务
+func NewGrabFood() *Server {
+	return newServer(grabFoodTokenPath)
+}
+
+// NewFoodpanda 启动 Foodpanda 替身服务
+func NewFoodpanda() *Server {
+	return newServer(foodpandaTokenPath)
+}
+
+func newServer(tokenPath string) *Server {
+	s := &Server{tokenPath: tokenPath}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Config 指向替身服务的平台配置
+func (s *Server) Config() domain.DeliveryPlatformConfig {
+	return domain.DeliveryPlatformConfig{
+		BaseURL:       s.URL,
+		ClientID:      ClientID,
+		ClientSecret:  ClientSecret,
+		WebhookSecret: WebhookSecret,
+		Timeout:       5,
+	}
+}
+
+// Sign 按平台算法为推送内容签名
+func (s *Server) Sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(WebhookSecret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Requests 已收到的开放接口请求
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// TokenCount 已签发的访问令牌数量
+func (s *Server) TokenCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens
+}
+
+// RevokeToken 作废当前令牌，下一次接口请求返回 401
+func (s *Server) RevokeToken() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+}
+
+// FailNext 下一次接口请求返回指定状态码
+func (s *Server) FailNext(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, status)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == s.tokenPath {
+		s.issueToken(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == "" || r.Header.Get("Authorization") != "Bearer "+s.token {
+		http.Error(w, `{"message":"unauthorized"}`, http.StatusUnauthorized)
+		return
+	}
+
+	req := Request{Method: r.Method, Path: r.URL.Path}
+	if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+		if err := json.Unmarshal(data, &req.Body); err != nil {
+			http.Error(w, `{"message":"invalid json"}`, http.StatusBadRequest)
+			return
+		}
+	}
+	s.requests = append(s.requests, req)
+
+	if len(s.failures) > 0 {
+		status := s.failures[0]
+		s.failures = s.failures[1:]
+		http.Error(w, `{"message":"stand-in failure"}`, status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{}`))
+}
+
+func (s *Server) issueToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	id, secret := r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	if s.tokenPath == foodpandaTokenPath {
+		id, secret = r.PostForm.Get("username"), r.PostForm.Get("password")
+	}
+	if id != ClientID || secret != ClientSecret {
+		http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+		return
+	}
+
+	s.mu.Lock()
+	s.tokens++
+	s.token = fmt.Sprintf("token-%d", s.tokens)
+	token := s.token
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
@@ -0,0 +1,46 @@
+package deliveryplatform
+
+import (
+	"slices"
+
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+var _ domain.DeliveryPlatformRegistry = (*Registry)(nil)
+
+// Registry 外卖平台适配器注册表
+//
+// 接入新的外卖平台时，实现 domain.DeliveryPlatform 并以
+// `group:"delivery_platforms"` 注册到 adapterfx 即可。
+type Registry struct {
+	platforms map[domain.DeliveryPlatformCode]domain.DeliveryPlatform
+}
+
+func NewRegistry(platforms []domain.DeliveryPlatform) *Registry {
+	r := &Registry{
+		platforms: make(map[domain.DeliveryPlatformCode]domain.DeliveryPlatform, len(platforms)),
+	}
+	for _, p := range platforms {
+		r.platforms[p.Code()] = p
+	}
+	return r
+}
+
+// Get 获取外卖平台适配器
+func (r *Registry) Get(code domain.DeliveryPlatformCode) (domain.DeliveryPlatform, error) {
+	p, ok := r.platforms[code]
+	if !ok {
+		return nil, domain.ParamsError(domain.ErrDeliveryPlatformInvalid)
+	}
+	return p, nil
+}
+
+// Codes 已注册的外卖平台
+func (r *Registry) Codes() []domain.DeliveryPlatformCode {
+	codes := make([]domain.DeliveryPlatformCode, 0, len(r.platforms))
+	for code := range r.platforms {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
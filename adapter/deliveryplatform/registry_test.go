@@ -0,0 +1,23 @@
+package deliveryplatform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry([]domain.DeliveryPlatform{
+		NewGrabFood(domain.DeliveryConfig{}),
+		NewFoodpanda(domain.DeliveryConfig{}),
+	})
+	require.Equal(t, []domain.DeliveryPlatformCode{domain.DeliveryPlatformFoodpanda, domain.DeliveryPlatformGrabFood}, r.Codes())
+
+	p, err := r.Get(domain.DeliveryPlatformGrabFood)
+	require.NoError(t, err)
+	require.Equal(t, domain.DeliveryPlatformGrabFood, p.Code())
+
+	_, err = r.Get("ubereats")
+	require.True(t, domain.IsParamsError(err))
+}
@@ -0,0 +1,75 @@
+package miniprogram
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/silenceper/wechat/v2/miniprogram"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+// 微信接口返回的 code 无效错误码
+const (
+	errCodeInvalidCode = 40029 // code 无效
+	errCodeUsedCode    = 40163 // code 已被使用
+)
+
+var _ domain.WechatMiniProgram = (*Client)(nil)
+
+type Client struct {
+	mp *miniprogram.MiniProgram
+}
+
+func NewClient(mp *miniprogram.MiniProgram) *Client {
+	return &Client{
+		mp: mp,
+	}
+}
+
+// Code2Session 登录凭证校验
+func (c *Client) Code2Session(ctx context.Context, code string) (res *domain.WechatSession, err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "MiniProgram.Code2Session")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	result, err := c.mp.GetAuth().Code2SessionContext(ctx, code)
+	if err != nil {
+		if isInvalidCode(result.ErrCode) {
+			return nil, domain.ErrWechatLoginCodeInvalid
+		}
+		return nil, fmt.Errorf("failed to code2session: %w", err)
+	}
+
+	return &domain.WechatSession{
+		OpenID:     result.OpenID,
+		UnionID:    result.UnionID,
+		SessionKey: result.SessionKey,
+	}, nil
+}
+
+// GetPhoneNumber 获取用户手机号，优先返回不带区号的手机号
+func (c *Client) GetPhoneNumber(ctx context.Context, code string) (phone string, err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "MiniProgram.GetPhoneNumber")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	result, err := c.mp.GetAuth().GetPhoneNumberContext(ctx, code)
+	if err != nil {
+		if result != nil && isInvalidCode(result.ErrCode) {
+			return "", domain.ErrWechatPhoneCodeInvalid
+		}
+		return "", fmt.Errorf("failed to get phone number: %w", err)
+	}
+
+	if result.PhoneInfo.PurePhoneNumber != "" {
+		return result.PhoneInfo.PurePhoneNumber, nil
+	}
+	return result.PhoneInfo.PhoneNumber, nil
+}
+
+func isInvalidCode(errCode int64) bool {
+	return errCode == errCodeInvalidCode || errCode == errCodeUsedCode
+}
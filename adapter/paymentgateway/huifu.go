@@ -0,0 +1,120 @@
+package paymentgateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/huifu"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+// 汇付日期时间格式
+const (
+	huifuDateLayout     = "20060102"
+	huifuDateTimeLayout = "20060102150405"
+)
+
+var _ domain.PaymentGateway = (*Huifu)(nil)
+
+// Huifu 汇付聚合支付网关
+type Huifu struct {
+	bp *huifu.BsPay
+}
+
+func NewHuifu(bp *huifu.BsPay) *Huifu {
+	return &Huifu{
+		bp: bp,
+	}
+}
+
+// Micropay 聚合反扫
+func (h *Huifu) Micropay(ctx context.Context, params domain.PaymentGatewayMicropayParams) (res *domain.PaymentGatewayResult, err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "Huifu.Micropay")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	resp, err := h.bp.V2TradePaymentMicropayRequest(ctx, huifu.V2TradePaymentMicropayRequest{
+		ReqDate:   params.RequestedAt.Format(huifuDateLayout),
+		ReqSeqId:  params.PaymentNo,
+		HuifuId:   params.MerchantNumber,
+		TransAmt:  params.Amount.StringFixed(2),
+		GoodsDesc: params.Description,
+		AuthCode:  params.AuthCode,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request huifu micropay: %w", err)
+	}
+	return parseResult(resp)
+}
+
+// Query 扫码交易查询
+func (h *Huifu) Query(ctx context.Context, params domain.PaymentGatewayQueryParams) (res *domain.PaymentGatewayResult, err error) {
+	span, ctx := util.StartSpan(ctx, "adapter", "Huifu.Query")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	resp, err := h.bp.V2TradePaymentScanpayQueryRequest(ctx, huifu.V2TradePaymentScanpayQueryRequest{
+		HuifuId:     params.MerchantNumber,
+		OrgReqDate:  params.RequestedAt.Format(huifuDateLayout),
+		OrgReqSeqId: params.PaymentNo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request huifu scanpay query: %w", err)
+	}
+	return parseResult(resp)
+}
+
+// parseResult 解析汇付响应，以交易状态为准；未返回交易状态时按业务返回码判断
+func parseResult(resp map[string]interface{}) (*domain.PaymentGatewayResult, error) {
+	data, ok := resp["data"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("huifu response data is missing")
+	}
+	respCode := str(data["resp_code"])
+	res := &domain.PaymentGatewayResult{
+		TradeNo: str(data["hf_seq_id"]),
+		Message: str(data["resp_desc"]),
+	}
+	if res.TradeNo == "" {
+		res.TradeNo = str(data["org_hf_seq_id"])
+	}
+
+	switch huifu.TransStat(str(data["trans_stat"])) {
+	case huifu.TransStatSuccess:
+		res.Status = domain.PaymentGatewayStatusSuccess
+	case huifu.TransStatFail:
+		res.Status = domain.PaymentGatewayStatusFailed
+	case huifu.TransStatProcessing:
+		res.Status = domain.PaymentGatewayStatusProcessing
+	default:
+		switch respCode {
+		case huifu.CodeSuccess:
+			res.Status = domain.PaymentGatewayStatusSuccess
+		case huifu.CodeProcessing:
+			res.Status = domain.PaymentGatewayStatusProcessing
+		default:
+			res.Status = domain.PaymentGatewayStatusFailed
+		}
+	}
+
+	if res.Status == domain.PaymentGatewayStatusSuccess {
+		res.PaidAt = time.Now()
+		if endTime := str(data["end_time"]); endTime != "" {
+			if t, err := time.ParseInLocation(huifuDateTimeLayout, endTime, time.Local); err == nil {
+				res.PaidAt = t
+			}
+		}
+	}
+	return res, nil
+}
+
+// str 读取字符串字段，字段不存在时返回空字符串
+func str(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
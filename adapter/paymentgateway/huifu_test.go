@@ -0,0 +1,70 @@
+package paymentgateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+func TestParseResult(t *testing.T) {
+	t.Run("交易成功", func(t *testing.T) {
+		res, err := parseResult(map[string]interface{}{
+			"data": map[string]interface{}{
+				"resp_code":  "00000000",
+				"resp_desc":  "交易成功",
+				"trans_stat": "S",
+				"hf_seq_id":  "002900TOP1A231025",
+				"end_time":   "20261023101530",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, domain.PaymentGatewayStatusSuccess, res.Status)
+		require.Equal(t, "002900TOP1A231025", res.TradeNo)
+		require.Equal(t, time.Date(2026, 10, 23, 10, 15, 30, 0, time.Local), res.PaidAt)
+	})
+
+	t.Run("等待顾客输入密码", func(t *testing.T) {
+		res, err := parseResult(map[string]interface{}{
+			"data": map[string]interface{}{
+				"resp_code":  "00000100",
+				"resp_desc":  "交易处理中",
+				"trans_stat": "P",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, domain.PaymentGatewayStatusProcessing, res.Status)
+		require.True(t, res.PaidAt.IsZero())
+	})
+
+	t.Run("查询返回原交易流水号", func(t *testing.T) {
+		res, err := parseResult(map[string]interface{}{
+			"data": map[string]interface{}{
+				"resp_code":     "00000000",
+				"trans_stat":    "S",
+				"org_hf_seq_id": "002900TOP1A231026",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "002900TOP1A231026", res.TradeNo)
+		require.False(t, res.PaidAt.IsZero())
+	})
+
+	t.Run("未返回交易状态按返回码判断失败", func(t *testing.T) {
+		res, err := parseResult(map[string]interface{}{
+			"data": map[string]interface{}{
+				"resp_code": "90000000",
+				"resp_desc": "付款码已失效",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, domain.PaymentGatewayStatusFailed, res.Status)
+		require.Equal(t, "付款码已失效", res.Message)
+	})
+
+	t.Run("响应缺少数据", func(t *testing.T) {
+		_, err := parseResult(map[string]interface{}{})
+		require.Error(t, err)
+	})
+}
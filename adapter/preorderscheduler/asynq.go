@@ -0,0 +1,47 @@
+package preorderscheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/scheduler/task"
+)
+
+var _ domain.PreorderScheduler = (*AsynqScheduler)(nil)
+
+// AsynqScheduler 通过 asynq 定时任务在计划送厨时间触发预点单送厨，由 scheduler 服务执行
+type AsynqScheduler struct {
+	client *asynq.Client
+}
+
+func NewAsynqScheduler(client *asynq.Client) *AsynqScheduler {
+	return &AsynqScheduler{
+		client: client,
+	}
+}
+
+func (s *AsynqScheduler) ScheduleRelease(ctx context.Context, preorder *domain.Preorder) error {
+	payload, err := json.Marshal(task.PreorderReleasePayload{PreorderID: preorder.ID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal preorder release payload: %w", err)
+	}
+
+	_, err = s.client.EnqueueContext(ctx,
+		asynq.NewTask(task.TaskTypePreorderRelease, payload),
+		asynq.TaskID(task.NewPreorderReleaseTaskID(preorder.ID)),
+		asynq.ProcessAt(preorder.ReleaseAt),
+		asynq.MaxRetry(5),
+	)
+	if err != nil {
+		// 同一预点单的任务已存在
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			return nil
+		}
+		return fmt.Errorf("failed to enqueue preorder release task: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package preorderscheduler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/scheduler/task"
+)
+
+type AsynqSchedulerTestSuite struct {
+	suite.Suite
+	scheduler *AsynqScheduler
+	inspector *asynq.Inspector
+	server    *miniredis.Miniredis
+}
+
+func (suite *AsynqSchedulerTestSuite) SetupTest() {
+	server, err := miniredis.Run()
+	suite.Require().NoError(err)
+	suite.server = server
+
+	opt := asynq.RedisClientOpt{Addr: server.Addr()}
+	client := asynq.NewClient(opt)
+	inspector := asynq.NewInspector(opt)
+	suite.T().Cleanup(func() {
+		client.Close()
+		inspector.Close()
+	})
+	suite.scheduler = NewAsynqScheduler(client)
+	suite.inspector = inspector
+}
+
+func (suite *AsynqSchedulerTestSuite) TearDownTest() {
+	if suite.server != nil {
+		suite.server.Close()
+	}
+}
+
+func TestAsynqSchedulerTestSuite(t *testing.T) {
+	suite.Run(t, new(AsynqSchedulerTestSuite))
+}
+
+func (suite *AsynqSchedulerTestSuite) TestScheduleRelease() {
+	ctx := context.Background()
+	preorder := &domain.Preorder{
+		ID:        uuid.New(),
+		ReleaseAt: time.Now().Add(2 * time.Hour).Truncate(time.Second),
+	}
+	suite.Require().NoError(suite.scheduler.ScheduleRelease(ctx, preorder))
+
+	info, err := suite.inspector.GetTaskInfo("default", task.NewPreorderReleaseTaskID(preorder.ID))
+	suite.Require().NoError(err)
+	suite.Equal(asynq.TaskStateScheduled, info.State)
+	suite.Equal(task.TaskTypePreorderRelease, info.Type)
+	suite.True(preorder.ReleaseAt.Equal(info.NextProcessAt))
+
+	var payload task.PreorderReleasePayload
+	suite.Require().NoError(json.Unmarshal(info.Payload, &payload))
+	suite.Equal(preorder.ID, payload.PreorderID)
+
+	suite.Run("重复创建忽略", func() {
+		suite.NoError(suite.scheduler.ScheduleRelease(ctx, preorder))
+		tasks, err := suite.inspector.ListScheduledTasks("default")
+		suite.Require().NoError(err)
+		suite.Len(tasks, 1)
+	})
+}
@@ -0,0 +1,33 @@
+package pusher
+
+import (
+	"context"
+
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/push"
+)
+
+var _ domain.Pusher = (*HubPusher)(nil)
+
+// HubPusher 通过推送中心向订阅方推送事件
+type HubPusher struct {
+	hub *push.Hub
+}
+
+func NewHubPusher(hub *push.Hub) *HubPusher {
+	return &HubPusher{
+		hub: hub,
+	}
+}
+
+func (p *HubPusher) Push(ctx context.Context, event domain.PushEventType, data any, topics ...domain.PushTopic) {
+	logger := logging.FromContext(ctx).Named("HubPusher.Push")
+	// 业务请求可能已结束，推送不跟随请求取消
+	ctx = context.WithoutCancel(ctx)
+	for _, topic := range topics {
+		if _, err := p.hub.Publish(ctx, topic.String(), string(event), data); err != nil {
+			logger.Errorf("推送事件 %s 到 %s 失败: %v", event, topic, err)
+		}
+	}
+}
@@ -71,6 +71,16 @@ var Module = fx.Module(
 		asHandler(handler.NewOssHandler),
 		asHandler(handler.NewBusinessConfigHandler),
 		asHandler(handler.NewRouterMenuHandler),
+		asHandler(handler.NewPriceChangeHandler),
+		asHandler(handler.NewPromotionHandler),
+		asHandler(handler.NewCouponHandler),
+		asHandler(handler.NewMemberHandler),
+		asHandler(handler.NewMemberAccountHandler),
+		asHandler(handler.NewMemberPointsHandler),
+		asHandler(handler.NewProductVersionHandler),
+		asHandler(handler.NewScanOrderHandler),
+		asHandler(handler.NewPickupHandler),
+		asHandler(handler.NewDeliveryHandler),
 	),
 )
 
@@ -66,6 +66,7 @@ func (h *CategoryHandler) CreateRoot() gin.HandlerFunc {
 		category := &domain.Category{
 			ID:         uuid.New(),
 			Name:       req.Name,
+			NameI18n:   req.NameI18n,
 			MerchantID: user.MerchantID,
 		}
 
@@ -158,6 +159,7 @@ func (h *CategoryHandler) CreateChild() gin.HandlerFunc {
 		category := &domain.Category{
 			ID:             uuid.New(),
 			Name:           req.Name,
+			NameI18n:       req.NameI18n,
 			ParentID:       parentID,
 			MerchantID:     user.MerchantID,
 			InheritTaxRate: req.InheritTaxRate,
@@ -232,6 +234,7 @@ func (h *CategoryHandler) Update() gin.HandlerFunc {
 		category := &domain.Category{
 			ID:             id,
 			Name:           req.Name,
+			NameI18n:       req.NameI18n,
 			InheritTaxRate: req.InheritTaxRate,
 			InheritStall:   req.InheritStall,
 		}
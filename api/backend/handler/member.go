@@ -0,0 +1,460 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/backend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type MemberHandler struct {
+	MemberTierInteractor domain.MemberTierInteractor
+	MemberInteractor     domain.MemberInteractor
+}
+
+func NewMemberHandler(
+	memberTierInteractor domain.MemberTierInteractor,
+	memberInteractor domain.MemberInteractor,
+) *MemberHandler {
+	return &MemberHandler{
+		MemberTierInteractor: memberTierInteractor,
+		MemberInteractor:     memberInteractor,
+	}
+}
+
+func (h *MemberHandler) Routes(r gin.IRouter) {
+	r = r.Group("member")
+	r.GET("/tier", h.ListTiers())
+	r.POST("/tier", h.CreateTier())
+	r.PUT("/tier/:id", h.UpdateTier())
+	r.DELETE("/tier/:id", h.DeleteTier())
+	r.POST("", h.Create())
+	r.PUT("/:id", h.Update())
+	r.DELETE("/:id", h.Delete())
+	r.GET("/:id", h.GetDetail())
+	r.GET("", h.List())
+	r.GET("/:id/orders", h.ListOrders())
+}
+
+func (h *MemberHandler) NoAuths() []string {
+	return []string{}
+}
+
+// ListTiers
+//
+//	@Tags		会员管理
+//	@Security	BearerAuth
+//	@Summary	查询会员等级列表
+//	@Success	200	{object}	domain.MemberTiers	"成功"
+//	@Router		/member/tier [get]
+func (h *MemberHandler) ListTiers() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.ListTiers")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		user := domain.FromBackendUserContext(ctx)
+		tiers, err := h.MemberTierInteractor.List(ctx, user)
+		if err != nil {
+			err = fmt.Errorf("failed to list member tiers: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, tiers)
+	}
+}
+
+// CreateTier
+//
+//	@Tags		会员管理
+//	@Security	BearerAuth
+//	@Summary	创建会员等级
+//	@Param		data	body	types.MemberTierSaveReq	true	"请求信息"
+//	@Success	200
+//	@Router		/member/tier [post]
+func (h *MemberHandler) CreateTier() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.CreateTier")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberTierSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		tier := toMemberTier(uuid.New(), req)
+		if err := h.MemberTierInteractor.Create(ctx, tier, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to create member tier: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// UpdateTier
+//
+//	@Tags		会员管理
+//	@Security	BearerAuth
+//	@Summary	更新会员等级
+//	@Param		id		path	string					true	"等级ID"
+//	@Param		data	body	types.MemberTierSaveReq	true	"请求信息"
+//	@Success	200
+//	@Router		/member/tier/{id} [put]
+func (h *MemberHandler) UpdateTier() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.UpdateTier")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.MemberTierSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		tier := toMemberTier(id, req)
+		if err = h.MemberTierInteractor.Update(ctx, tier, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to update member tier: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// DeleteTier
+//
+//	@Tags		会员管理
+//	@Security	BearerAuth
+//	@Summary	删除会员等级
+//	@Param		id	path	string	true	"等级ID"
+//	@Success	200
+//	@Router		/member/tier/{id} [delete]
+func (h *MemberHandler) DeleteTier() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.DeleteTier")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		if err = h.MemberTierInteractor.Delete(ctx, id, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to delete member tier: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// Create
+//
+//	@Tags		会员管理
+//	@Security	BearerAuth
+//	@Summary	创建会员
+//	@Param		data	body	types.MemberSaveReq	true	"请求信息"
+//	@Success	200
+//	@Router		/member [post]
+func (h *MemberHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.Create")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		member := toMember(uuid.New(), req)
+		if err := h.MemberInteractor.Create(ctx, member, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to create member: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// Update
+//
+//	@Tags		会员管理
+//	@Security	BearerAuth
+//	@Summary	更新会员
+//	@Param		id		path	string				true	"会员ID"
+//	@Param		data	body	types.MemberSaveReq	true	"请求信息"
+//	@Success	200
+//	@Router		/member/{id} [put]
+func (h *MemberHandler) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.Update")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.MemberSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		member := toMember(id, req)
+		if err = h.MemberInteractor.Update(ctx, member, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to update member: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// Delete
+//
+//	@Tags		会员管理
+//	@Security	BearerAuth
+//	@Summary	删除会员
+//	@Param		id	path	string	true	"会员ID"
+//	@Success	200
+//	@Router		/member/{id} [delete]
+func (h *MemberHandler) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.Delete")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		if err = h.MemberInteractor.Delete(ctx, id, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to delete member: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// GetDetail
+//
+//	@Tags		会员管理
+//	@Security	BearerAuth
+//	@Summary	获取会员详情
+//	@Param		id	path		string			true	"会员ID"
+//	@Success	200	{object}	domain.Member	"成功"
+//	@Router		/member/{id} [get]
+func (h *MemberHandler) GetDetail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.GetDetail")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		member, err := h.MemberInteractor.GetDetail(ctx, id, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to get member: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, member)
+	}
+}
+
+// List
+//
+//	@Tags		会员管理
+//	@Security	BearerAuth
+//	@Summary	查询会员列表
+//	@Param		data	query		types.MemberListReq		true	"请求信息"
+//	@Success	200		{object}	domain.MemberSearchRes	"成功"
+//	@Router		/member [get]
+func (h *MemberHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		page := upagination.New(req.Page, req.Size)
+		user := domain.FromBackendUserContext(ctx)
+		params := domain.MemberSearchParams{
+			MerchantID: user.MerchantID,
+			TierID:     req.TierID,
+			Keyword:    req.Keyword,
+			Enabled:    req.Enabled,
+		}
+
+		res, err := h.MemberInteractor.PagedListBySearch(ctx, page, params)
+		if err != nil {
+			err = fmt.Errorf("failed to list members: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// ListOrders
+//
+//	@Tags		会员管理
+//	@Security	BearerAuth
+//	@Summary	查询会员消费记录
+//	@Param		id		path		string						true	"会员ID"
+//	@Param		data	query		types.MemberOrderListReq	true	"请求信息"
+//	@Success	200		{object}	domain.MemberOrderSearchRes	"成功"
+//	@Router		/member/{id}/orders [get]
+func (h *MemberHandler) ListOrders() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.ListOrders")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.MemberOrderListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		page := upagination.New(req.Page, req.Size)
+		user := domain.FromBackendUserContext(ctx)
+		res, err := h.MemberInteractor.ListOrders(ctx, page, id, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to list member orders: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+func toMemberTier(id uuid.UUID, req types.MemberTierSaveReq) *domain.MemberTier {
+	return &domain.MemberTier{
+		ID:                 id,
+		Name:               req.Name,
+		Level:              req.Level,
+		MemberPriceEnabled: req.MemberPriceEnabled,
+		Description:        req.Description,
+	}
+}
+
+func toMember(id uuid.UUID, req types.MemberSaveReq) *domain.Member {
+	gender := req.Gender
+	if gender == "" {
+		gender = domain.GenderUnknown
+	}
+	return &domain.Member{
+		ID:       id,
+		TierID:   req.TierID,
+		Name:     req.Name,
+		Phone:    req.Phone,
+		OpenID:   req.OpenID,
+		Gender:   gender,
+		Birthday: req.Birthday,
+		Enabled:  req.Enabled,
+		Remark:   req.Remark,
+	}
+}
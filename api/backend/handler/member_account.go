@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/backend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type MemberAccountHandler struct {
+	MemberAccountInteractor domain.MemberAccountInteractor
+}
+
+func NewMemberAccountHandler(memberAccountInteractor domain.MemberAccountInteractor) *MemberAccountHandler {
+	return &MemberAccountHandler{
+		MemberAccountInteractor: memberAccountInteractor,
+	}
+}
+
+func (h *MemberAccountHandler) Routes(r gin.IRouter) {
+	r = r.Group("member_account")
+	r.GET("/liability", h.LiabilityReport())
+	r.GET("/transaction", h.ListTransactions())
+	r.GET("/:member_id", h.Get())
+	r.POST("/:member_id/adjust", h.Adjust())
+}
+
+func (h *MemberAccountHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Get
+//
+//	@Tags		会员储值
+//	@Security	BearerAuth
+//	@Summary	查询会员储值账户
+//	@Param		member_id	path		string					true	"会员ID"
+//	@Success	200			{object}	domain.MemberAccount	"成功"
+//	@Router		/member_account/{member_id} [get]
+func (h *MemberAccountHandler) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberAccountHandler.Get")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		memberID, err := uuid.Parse(c.Param("member_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		account, err := h.MemberAccountInteractor.GetByMemberID(ctx, user.MerchantID, memberID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to get member account: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, account)
+	}
+}
+
+// Adjust
+//
+//	@Tags		会员储值
+//	@Security	BearerAuth
+//	@Summary	人工调整储值余额
+//	@Param		member_id	path		string							true	"会员ID"
+//	@Param		data		body		types.MemberAccountAdjustReq	true	"请求信息"
+//	@Success	200			{object}	domain.MemberAccountTransaction	"成功"
+//	@Router		/member_account/{member_id}/adjust [post]
+func (h *MemberAccountHandler) Adjust() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberAccountHandler.Adjust")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		memberID, err := uuid.Parse(c.Param("member_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.MemberAccountAdjustReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		txn, err := h.MemberAccountInteractor.Adjust(ctx, domain.MemberAccountAdjustParams{
+			MemberID:    memberID,
+			Amount:      req.Amount,
+			BonusAmount: req.BonusAmount,
+			Remark:      req.Remark,
+			Operator: domain.OrderOperator{
+				ID:   user.ID,
+				Name: user.Nickname,
+			},
+		}, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to adjust member account: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, txn)
+	}
+}
+
+// ListTransactions
+//
+//	@Tags		会员储值
+//	@Security	BearerAuth
+//	@Summary	查询储值流水
+//	@Param		data	query		types.MemberAccountTransactionListReq		true	"请求信息"
+//	@Success	200		{object}	domain.MemberAccountTransactionSearchRes	"成功"
+//	@Router		/member_account/transaction [get]
+func (h *MemberAccountHandler) ListTransactions() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberAccountHandler.ListTransactions")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberAccountTransactionListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		params := domain.MemberAccountTransactionSearchParams{
+			MerchantID: user.MerchantID,
+			StoreID:    req.StoreID,
+			MemberID:   req.MemberID,
+			Type:       req.Type,
+		}
+		if req.StartAt != "" {
+			startAt, err := time.ParseInLocation(time.DateOnly, req.StartAt, time.Local)
+			if err != nil {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			params.StartAt = &startAt
+		}
+		if req.EndAt != "" {
+			endAt, err := time.ParseInLocation(time.DateOnly, req.EndAt, time.Local)
+			if err != nil {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			// 包含结束日期当天
+			endAt = endAt.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			params.EndAt = &endAt
+		}
+
+		page := upagination.New(req.Page, req.Size)
+		res, err := h.MemberAccountInteractor.PagedListTransactions(ctx, page, params)
+		if err != nil {
+			err = fmt.Errorf("failed to list member account transactions: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// LiabilityReport
+//
+//	@Tags		会员储值
+//	@Security	BearerAuth
+//	@Summary	储值负债报表（按门店）
+//	@Success	200	{object}	domain.MemberAccountLiabilityReport	"成功"
+//	@Router		/member_account/liability [get]
+func (h *MemberAccountHandler) LiabilityReport() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberAccountHandler.LiabilityReport")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		user := domain.FromBackendUserContext(ctx)
+		res, err := h.MemberAccountInteractor.LiabilityReport(ctx, user)
+		if err != nil {
+			err = fmt.Errorf("failed to get member account liability report: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
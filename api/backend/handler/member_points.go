@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/backend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type MemberPointsHandler struct {
+	MemberPointsInteractor domain.MemberPointsInteractor
+}
+
+func NewMemberPointsHandler(memberPointsInteractor domain.MemberPointsInteractor) *MemberPointsHandler {
+	return &MemberPointsHandler{
+		MemberPointsInteractor: memberPointsInteractor,
+	}
+}
+
+func (h *MemberPointsHandler) Routes(r gin.IRouter) {
+	r = r.Group("member_points")
+	r.GET("/rule", h.GetRule())
+	r.PUT("/rule", h.SaveRule())
+	r.GET("/transaction", h.ListTransactions())
+	r.GET("/:member_id", h.Get())
+	r.POST("/:member_id/adjust", h.Adjust())
+}
+
+func (h *MemberPointsHandler) NoAuths() []string {
+	return []string{}
+}
+
+// GetRule
+//
+//	@Tags		会员积分
+//	@Security	BearerAuth
+//	@Summary	查询积分规则
+//	@Success	200	{object}	domain.MemberPointsRule	"成功"
+//	@Router		/member_points/rule [get]
+func (h *MemberPointsHandler) GetRule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberPointsHandler.GetRule")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		user := domain.FromBackendUserContext(ctx)
+		rule, err := h.MemberPointsInteractor.GetRule(ctx, user)
+		if err != nil {
+			err = fmt.Errorf("failed to get member points rule: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, rule)
+	}
+}
+
+// SaveRule
+//
+//	@Tags		会员积分
+//	@Security	BearerAuth
+//	@Summary	保存积分规则
+//	@Param		data	body		types.MemberPointsRuleSaveReq	true	"请求信息"
+//	@Success	200		{object}	domain.MemberPointsRule			"成功"
+//	@Router		/member_points/rule [put]
+func (h *MemberPointsHandler) SaveRule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberPointsHandler.SaveRule")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberPointsRuleSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		rule := &domain.MemberPointsRule{
+			Enabled:          req.Enabled,
+			EarnRate:         req.EarnRate,
+			CategoryRates:    req.CategoryRates,
+			TierMultipliers:  req.TierMultipliers,
+			ExpireDays:       req.ExpireDays,
+			RedeemEnabled:    req.RedeemEnabled,
+			RedeemRate:       req.RedeemRate,
+			RedeemMinPoints:  req.RedeemMinPoints,
+			RedeemMaxPercent: req.RedeemMaxPercent,
+			RedeemProducts:   req.RedeemProducts,
+		}
+		user := domain.FromBackendUserContext(ctx)
+		if err := h.MemberPointsInteractor.SaveRule(ctx, rule, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to save member points rule: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, rule)
+	}
+}
+
+// Get
+//
+//	@Tags		会员积分
+//	@Security	BearerAuth
+//	@Summary	查询会员积分账户
+//	@Param		member_id	path		string						true	"会员ID"
+//	@Success	200			{object}	domain.MemberPointsAccount	"成功"
+//	@Router		/member_points/{member_id} [get]
+func (h *MemberPointsHandler) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberPointsHandler.Get")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		memberID, err := uuid.Parse(c.Param("member_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		account, err := h.MemberPointsInteractor.GetByMemberID(ctx, user.MerchantID, memberID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to get member points account: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, account)
+	}
+}
+
+// Adjust
+//
+//	@Tags		会员积分
+//	@Security	BearerAuth
+//	@Summary	人工调整积分
+//	@Param		member_id	path		string							true	"会员ID"
+//	@Param		data		body		types.MemberPointsAdjustReq		true	"请求信息"
+//	@Success	200			{object}	domain.MemberPointsTransaction	"成功"
+//	@Router		/member_points/{member_id}/adjust [post]
+func (h *MemberPointsHandler) Adjust() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberPointsHandler.Adjust")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		memberID, err := uuid.Parse(c.Param("member_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.MemberPointsAdjustReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		txn, err := h.MemberPointsInteractor.Adjust(ctx, domain.MemberPointsAdjustParams{
+			MemberID: memberID,
+			Points:   req.Points,
+			Remark:   req.Remark,
+			Operator: domain.OrderOperator{
+				ID:   user.ID,
+				Name: user.Nickname,
+			},
+		}, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to adjust member points: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, txn)
+	}
+}
+
+// ListTransactions
+//
+//	@Tags		会员积分
+//	@Security	BearerAuth
+//	@Summary	查询积分流水
+//	@Param		data	query		types.MemberPointsTransactionListReq		true	"请求信息"
+//	@Success	200		{object}	domain.MemberPointsTransactionSearchRes	"成功"
+//	@Router		/member_points/transaction [get]
+func (h *MemberPointsHandler) ListTransactions() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberPointsHandler.ListTransactions")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberPointsTransactionListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		params := domain.MemberPointsTransactionSearchParams{
+			MerchantID: user.MerchantID,
+			StoreID:    req.StoreID,
+			MemberID:   req.MemberID,
+			Type:       req.Type,
+		}
+		if req.StartAt != "" {
+			startAt, err := time.ParseInLocation(time.DateOnly, req.StartAt, time.Local)
+			if err != nil {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			params.StartAt = &startAt
+		}
+		if req.EndAt != "" {
+			endAt, err := time.ParseInLocation(time.DateOnly, req.EndAt, time.Local)
+			if err != nil {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			// 包含结束日期当天
+			endAt = endAt.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			params.EndAt = &endAt
+		}
+
+		page := upagination.New(req.Page, req.Size)
+		res, err := h.MemberPointsInteractor.PagedListTransactions(ctx, page, params)
+		if err != nil {
+			err = fmt.Errorf("failed to list member points transactions: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/backend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type PickupHandler struct {
+	PickupRuleInteractor domain.PickupRuleInteractor
+}
+
+func NewPickupHandler(pickupRuleInteractor domain.PickupRuleInteractor) *PickupHandler {
+	return &PickupHandler{
+		PickupRuleInteractor: pickupRuleInteractor,
+	}
+}
+
+func (h *PickupHandler) Routes(r gin.IRouter) {
+	r = r.Group("pickup")
+	r.GET("/rule", h.GetRule())
+	r.PUT("/rule", h.SaveRule())
+}
+
+func (h *PickupHandler) NoAuths() []string {
+	return []string{}
+}
+
+// GetRule
+//
+//	@Tags		取餐叫号
+//	@Security	BearerAuth
+//	@Summary	查询取餐号规则
+//	@Success	200	{object}	domain.PickupRule	"成功"
+//	@Router		/pickup/rule [get]
+func (h *PickupHandler) GetRule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PickupHandler.GetRule")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		user := domain.FromBackendUserContext(ctx)
+		rule, err := h.PickupRuleInteractor.GetRule(ctx, user)
+		if err != nil {
+			err = fmt.Errorf("failed to get pickup rule: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, rule)
+	}
+}
+
+// SaveRule
+//
+//	@Tags		取餐叫号
+//	@Security	BearerAuth
+//	@Summary	保存取餐号规则
+//	@Param		data	body		types.PickupRuleSaveReq	true	"请求信息"
+//	@Success	200		{object}	domain.PickupRule		"成功"
+//	@Router		/pickup/rule [put]
+func (h *PickupHandler) SaveRule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PickupHandler.SaveRule")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PickupRuleSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		rule := &domain.PickupRule{
+			Prefix: req.Prefix,
+			Start:  req.Start,
+			End:    req.End,
+		}
+		user := domain.FromBackendUserContext(ctx)
+		if err := h.PickupRuleInteractor.SaveRule(ctx, rule, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to save pickup rule: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, rule)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/i18n"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
@@ -32,6 +33,9 @@ func (h *ProductHandler) Routes(r gin.IRouter) {
 	r.POST("", h.Create())
 	r.POST("/setmeal", h.CreateSetMeal())
 	r.GET("", h.List())
+	r.GET("/import/template", h.ImportTemplate())
+	r.POST("/import", h.Import())
+	r.GET("/export", h.Export())
 	r.PUT("/:id", h.Update())
 	r.PUT("/setmeal/:id", h.UpdateSetMeal())
 	r.DELETE("/:id", h.Delete())
@@ -77,6 +81,14 @@ func (h *ProductHandler) Create() gin.HandlerFunc {
 			CategoryID:        req.CategoryID,
 			UnitID:            req.UnitID,
 			Mnemonic:          req.Mnemonic,
+			NameI18n:          req.NameI18n,
+			DescriptionI18n:   req.DescriptionI18n,
+			Allergens:         req.Allergens,
+			Halal:             req.Halal,
+			Vegetarian:        req.Vegetarian,
+			Vegan:             req.Vegan,
+			SpicyLevel:        req.SpicyLevel,
+			Nutrition:         req.Nutrition,
 			ShelfLife:         req.ShelfLife,
 			SupportTypes:      req.SupportTypes,
 			SaleStatus:        req.SaleStatus,
@@ -119,6 +131,7 @@ func (h *ProductHandler) Create() gin.HandlerFunc {
 				PackingFeeID: specRelReq.PackingFeeID,
 				Barcode:      specRelReq.Barcode,
 				IsDefault:    specRelReq.IsDefault,
+				PeriodPrices: specRelReq.PeriodPrices,
 			}
 
 			if specRelReq.MemberPrice != nil {
@@ -145,15 +158,17 @@ func (h *ProductHandler) Create() gin.HandlerFunc {
 			product.AttrRelations = make(domain.ProductAttrRelations, 0, len(req.AttrRelations))
 			for _, attrRelReq := range req.AttrRelations {
 				attrRel := &domain.ProductAttrRelation{
-					ID:         uuid.New(),
-					ProductID:  product.ID,
-					AttrID:     attrRelReq.AttrID,
-					AttrItemID: attrRelReq.AttrItemID,
-					IsDefault:  attrRelReq.IsDefault,
+					ID:          uuid.New(),
+					ProductID:   product.ID,
+					AttrID:      attrRelReq.AttrID,
+					AttrItemID:  attrRelReq.AttrItemID,
+					IsDefault:   attrRelReq.IsDefault,
+					MaxQuantity: attrRelReq.MaxQuantity,
 				}
 				product.AttrRelations = append(product.AttrRelations, attrRel)
 			}
 		}
+		product.AttrLimits = req.AttrLimits
 
 		// 转换标签
 		if len(req.TagIDs) > 0 {
@@ -216,6 +231,8 @@ func (h *ProductHandler) CreateSetMeal() gin.HandlerFunc {
 			CategoryID:        req.CategoryID,
 			UnitID:            req.UnitID,
 			Mnemonic:          req.Mnemonic,
+			NameI18n:          req.NameI18n,
+			DescriptionI18n:   req.DescriptionI18n,
 			ShelfLife:         req.ShelfLife,
 			SupportTypes:      req.SupportTypes,
 			SaleStatus:        req.SaleStatus,
@@ -265,6 +282,7 @@ func (h *ProductHandler) CreateSetMeal() gin.HandlerFunc {
 				PackingFeeID: specRelReq.PackingFeeID,
 				Barcode:      specRelReq.Barcode,
 				IsDefault:    specRelReq.IsDefault,
+				PeriodPrices: specRelReq.PeriodPrices,
 			}
 
 			if specRelReq.MemberPrice != nil {
@@ -366,8 +384,16 @@ func (h *ProductHandler) List() gin.HandlerFunc {
 			MerchantID:   user.MerchantID,
 			OnlyMerchant: true,
 			Name:         req.Name,
-			StartAt:      nil,
-			EndAt:        nil,
+			Keyword:      req.Keyword,
+			Dietary: domain.ProductDietaryFilter{
+				ExcludeAllergens: req.ExcludeAllergens,
+				Halal:            req.Halal,
+				Vegetarian:       req.Vegetarian,
+				Vegan:            req.Vegan,
+				MaxSpicyLevel:    req.MaxSpicyLevel,
+			},
+			StartAt: nil,
+			EndAt:   nil,
 		}
 
 		var startAt, endAt time.Time
@@ -429,6 +455,7 @@ func (h *ProductHandler) List() gin.HandlerFunc {
 			return
 		}
 
+		res.Items.Localize(i18n.LocaleFromContext(ctx))
 		response.Ok(c, res)
 	}
 }
@@ -474,6 +501,14 @@ func (h *ProductHandler) Update() gin.HandlerFunc {
 			CategoryID:        req.CategoryID,
 			UnitID:            req.UnitID,
 			Mnemonic:          req.Mnemonic,
+			NameI18n:          req.NameI18n,
+			DescriptionI18n:   req.DescriptionI18n,
+			Allergens:         req.Allergens,
+			Halal:             req.Halal,
+			Vegetarian:        req.Vegetarian,
+			Vegan:             req.Vegan,
+			SpicyLevel:        req.SpicyLevel,
+			Nutrition:         req.Nutrition,
 			ShelfLife:         req.ShelfLife,
 			SupportTypes:      req.SupportTypes,
 			SaleStatus:        req.SaleStatus,
@@ -516,6 +551,7 @@ func (h *ProductHandler) Update() gin.HandlerFunc {
 				PackingFeeID: specRelReq.PackingFeeID,
 				Barcode:      specRelReq.Barcode,
 				IsDefault:    specRelReq.IsDefault,
+				PeriodPrices: specRelReq.PeriodPrices,
 			}
 
 			if specRelReq.MemberPrice != nil {
@@ -542,15 +578,17 @@ func (h *ProductHandler) Update() gin.HandlerFunc {
 			product.AttrRelations = make(domain.ProductAttrRelations, 0, len(req.AttrRelations))
 			for _, attrRelReq := range req.AttrRelations {
 				attrRel := &domain.ProductAttrRelation{
-					ID:         uuid.New(),
-					ProductID:  product.ID,
-					AttrID:     attrRelReq.AttrID,
-					AttrItemID: attrRelReq.AttrItemID,
-					IsDefault:  attrRelReq.IsDefault,
+					ID:          uuid.New(),
+					ProductID:   product.ID,
+					AttrID:      attrRelReq.AttrID,
+					AttrItemID:  attrRelReq.AttrItemID,
+					IsDefault:   attrRelReq.IsDefault,
+					MaxQuantity: attrRelReq.MaxQuantity,
 				}
 				product.AttrRelations = append(product.AttrRelations, attrRel)
 			}
 		}
+		product.AttrLimits = req.AttrLimits
 
 		// 转换标签
 		if len(req.TagIDs) > 0 {
@@ -622,6 +660,8 @@ func (h *ProductHandler) UpdateSetMeal() gin.HandlerFunc {
 			CategoryID:        req.CategoryID,
 			UnitID:            req.UnitID,
 			Mnemonic:          req.Mnemonic,
+			NameI18n:          req.NameI18n,
+			DescriptionI18n:   req.DescriptionI18n,
 			ShelfLife:         req.ShelfLife,
 			SupportTypes:      req.SupportTypes,
 			SaleStatus:        req.SaleStatus,
@@ -671,6 +711,7 @@ func (h *ProductHandler) UpdateSetMeal() gin.HandlerFunc {
 				PackingFeeID: specRelReq.PackingFeeID,
 				Barcode:      specRelReq.Barcode,
 				IsDefault:    specRelReq.IsDefault,
+				PeriodPrices: specRelReq.PeriodPrices,
 			}
 
 			if specRelReq.MemberPrice != nil {
@@ -955,3 +996,128 @@ func (h *ProductHandler) GetDetail() gin.HandlerFunc {
 // 		response.Ok(c, nil)
 // 	}
 // }
+
+// ImportTemplate
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	下载商品导入模板
+//	@Success	200	{object}	types.ProductExcelResp
+//	@Router		/product/import/template [get]
+func (h *ProductHandler) ImportTemplate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ProductHandler.ImportTemplate")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		user := domain.FromBackendUserContext(ctx)
+		url, err := h.ProductInteractor.ImportTemplate(ctx, user)
+		if err != nil {
+			err = fmt.Errorf("failed to export product import template: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, &types.ProductExcelResp{URL: url})
+	}
+}
+
+// Import
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	Excel 批量导入商品（同名商品更新）
+//	@Accept		multipart/form-data
+//	@Param		file	formData	file	true	"Excel 文件"
+//	@Success	200		{object}	domain.ProductImportResult
+//	@Router		/product/import [post]
+func (h *ProductHandler) Import() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ProductHandler.Import")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ProductImportReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		file, err := req.File.Open()
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		defer file.Close()
+
+		user := domain.FromBackendUserContext(ctx)
+		res, err := h.ProductInteractor.Import(ctx, file, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to import products: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Export
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	导出商品（与导入模板格式一致）
+//	@Param		data	query		types.ProductExportReq	true	"导出条件"
+//	@Success	200		{object}	types.ProductExcelResp
+//	@Router		/product/export [get]
+func (h *ProductHandler) Export() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ProductHandler.Export")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ProductExportReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		params := domain.ProductSearchParams{
+			Name:       req.Name,
+			SaleStatus: domain.ProductSaleStatus(req.SaleStatus),
+		}
+		if req.CategoryID != "" {
+			categoryID, err := uuid.Parse(req.CategoryID)
+			if err != nil {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			params.CategoryID = categoryID
+		}
+		if req.StallID != "" {
+			stallID, err := uuid.Parse(req.StallID)
+			if err != nil {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			params.StallID = stallID
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		url, err := h.ProductInteractor.Export(ctx, params, user)
+		if err != nil {
+			err = fmt.Errorf("failed to export products: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, &types.ProductExcelResp{URL: url})
+	}
+}
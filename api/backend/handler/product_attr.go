@@ -64,6 +64,7 @@ func (h *ProductAttrHandler) Create() gin.HandlerFunc {
 		attr := &domain.ProductAttr{
 			ID:         uuid.New(),
 			Name:       req.Name,
+			NameI18n:   req.NameI18n,
 			Channels:   req.Channels,
 			MerchantID: user.MerchantID,
 		}
@@ -83,6 +84,7 @@ func (h *ProductAttrHandler) Create() gin.HandlerFunc {
 					ID:        uuid.New(),
 					AttrID:    attr.ID,
 					Name:      itemReq.Name,
+					NameI18n:  itemReq.NameI18n,
 					Image:     itemReq.Image,
 					BasePrice: itemReq.BasePrice,
 				}
@@ -90,7 +92,7 @@ func (h *ProductAttrHandler) Create() gin.HandlerFunc {
 			}
 		}
 
-		err := h.ProductAttrInteractor.Create(ctx, attr)
+		err := h.ProductAttrInteractor.Create(ctx, attr, user)
 
 		if err != nil {
 			if errors.Is(err, domain.ErrProductAttrNameExists) {
@@ -144,6 +146,7 @@ func (h *ProductAttrHandler) Update() gin.HandlerFunc {
 		attr := &domain.ProductAttr{
 			ID:       id,
 			Name:     req.Name,
+			NameI18n: req.NameI18n,
 			Channels: req.Channels,
 		}
 
@@ -160,6 +163,7 @@ func (h *ProductAttrHandler) Update() gin.HandlerFunc {
 					ID:        itemReq.ID,
 					AttrID:    id,
 					Name:      itemReq.Name,
+					NameI18n:  itemReq.NameI18n,
 					Image:     itemReq.Image,
 					BasePrice: itemReq.BasePrice,
 				}
@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/backend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type ProductVersionHandler struct {
+	ProductVersionInteractor domain.ProductVersionInteractor
+}
+
+func NewProductVersionHandler(productVersionInteractor domain.ProductVersionInteractor) *ProductVersionHandler {
+	return &ProductVersionHandler{
+		ProductVersionInteractor: productVersionInteractor,
+	}
+}
+
+func (h *ProductVersionHandler) Routes(r gin.IRouter) {
+	r = r.Group("product/version")
+	r.GET("", h.List())
+	r.GET("/snapshot", h.Snapshot())
+	r.GET("/diff", h.Diff())
+	r.GET("/:id", h.GetDetail())
+	r.PUT("/:id/restore", h.Restore())
+}
+
+func (h *ProductVersionHandler) NoAuths() []string {
+	return []string{}
+}
+
+// List
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	查询商品历史版本列表
+//	@Param		data	query		types.ProductVersionListReq		true	"请求信息"
+//	@Success	200		{object}	domain.ProductVersionSearchRes	"成功"
+//	@Router		/product/version [get]
+func (h *ProductVersionHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ProductVersionHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ProductVersionListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		page := upagination.New(req.Page, req.Size)
+		user := domain.FromBackendUserContext(ctx)
+		params := domain.ProductVersionSearchParams{
+			MerchantID:  user.MerchantID,
+			SubjectType: req.SubjectType,
+			ProductID:   req.ProductID,
+			SubjectID:   req.SubjectID,
+			Action:      req.Action,
+			StartAt:     req.StartAt,
+			EndAt:       req.EndAt,
+		}
+
+		res, err := h.ProductVersionInteractor.PagedListBySearch(ctx, page, params)
+		if err != nil {
+			err = fmt.Errorf("failed to list product versions: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// GetDetail
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	查询商品历史版本详情
+//	@Param		id	path		string					true	"历史版本ID"
+//	@Success	200	{object}	domain.ProductVersion	"成功"
+//	@Router		/product/version/{id} [get]
+func (h *ProductVersionHandler) GetDetail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ProductVersionHandler.GetDetail")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		res, err := h.ProductVersionInteractor.GetDetail(ctx, id, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to get product version: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Snapshot
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	查询对象在指定时间点的历史版本
+//	@Param		data	query		types.ProductVersionSnapshotReq	true	"请求信息"
+//	@Success	200		{object}	domain.ProductVersion			"成功"
+//	@Router		/product/version/snapshot [get]
+func (h *ProductVersionHandler) Snapshot() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ProductVersionHandler.Snapshot")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ProductVersionSnapshotReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		key := domain.ProductVersionKey{
+			SubjectType: req.SubjectType,
+			ProductID:   req.ProductID,
+			SubjectID:   req.SubjectID,
+		}
+		res, err := h.ProductVersionInteractor.GetSnapshotAt(ctx, key, req.At, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to get product version snapshot: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Diff
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	对比商品历史版本
+//	@Param		data	query		types.ProductVersionDiffReq	true	"请求信息"
+//	@Success	200		{object}	domain.ProductVersionDiff	"成功"
+//	@Router		/product/version/diff [get]
+func (h *ProductVersionHandler) Diff() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ProductVersionHandler.Diff")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ProductVersionDiffReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		res, err := h.ProductVersionInteractor.Diff(ctx, req.From, req.To, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to diff product versions: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Restore
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	恢复商品历史版本
+//	@Param		id	path	string	true	"历史版本ID"
+//	@Success	200
+//	@Router		/product/version/{id}/restore [put]
+func (h *ProductVersionHandler) Restore() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ProductVersionHandler.Restore")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromBackendUserContext(ctx)
+		if err = h.ProductVersionInteractor.Restore(ctx, id, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to restore product version: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
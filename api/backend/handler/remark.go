@@ -64,6 +64,7 @@ func (h *RemarkHandler) Create() gin.HandlerFunc {
 		user := domain.FromBackendUserContext(ctx)
 		remark := &domain.CreateRemarkParams{
 			Name:        req.Name,
+			NameI18n:    req.NameI18n,
 			RemarkType:  domain.RemarkTypeBrand,
 			Enabled:     req.Enabled,
 			SortOrder:   req.SortOrder,
@@ -114,6 +115,7 @@ func (h *RemarkHandler) Update() gin.HandlerFunc {
 		remark := &domain.UpdateRemarkParams{
 			ID:        id,
 			Name:      req.Name,
+			NameI18n:  req.NameI18n,
 			Enabled:   req.Enabled,
 			SortOrder: req.SortOrder,
 		}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// CouponTemplateSaveReq 创建/更新优惠券模板请求
+type CouponTemplateSaveReq struct {
+	Name         string                    `json:"name" binding:"required,max=255"`                             // 券名称（必选）
+	Type         domain.CouponType         `json:"type" binding:"required,oneof=amount_off discount free_item"` // 券类型（必选）
+	Value        decimal.Decimal           `json:"value"`                                                       // 优惠值（代金券为减免金额，折扣券为减免比例）
+	MaxDiscount  decimal.Decimal           `json:"max_discount"`                                                // 折扣券最高优惠金额（0 表示不限）
+	ProductID    uuid.UUID                 `json:"product_id"`                                                  // 兑换商品ID（兑换券必选）
+	MinSpend     decimal.Decimal           `json:"min_spend"`                                                   // 使用门槛（0 表示无门槛）
+	StoreIDs     []uuid.UUID               `json:"store_ids"`                                                   // 适用门店（为空表示全部门店）
+	ValidityType domain.CouponValidityType `json:"validity_type" binding:"required,oneof=fixed relative"`       // 有效期类型（必选）
+	ValidFrom    *time.Time                `json:"valid_from"`                                                  // 有效期开始时间（固定日期）
+	ValidTo      *time.Time                `json:"valid_to"`                                                    // 有效期结束时间（固定日期）
+	ValidDays    int                       `json:"valid_days" binding:"omitempty,min=0"`                        // 有效天数（发放后 N 天内有效）
+	TotalQty     int                       `json:"total_qty" binding:"omitempty,min=0"`                         // 发放总量（0 表示不限）
+	Description  string                    `json:"description" binding:"max=500"`                               // 使用说明
+	Enabled      bool                      `json:"enabled"`                                                     // 是否启用
+}
+
+// CouponTemplateListReq 优惠券模板列表请求
+type CouponTemplateListReq struct {
+	upagination.RequestPagination
+	Name    string            `form:"name"`                                                         // 券名称（模糊匹配）
+	Type    domain.CouponType `form:"type" binding:"omitempty,oneof=amount_off discount free_item"` // 券类型
+	Enabled *bool             `form:"enabled"`                                                      // 是否启用
+}
+
+// CouponIssueReq 发放优惠券请求
+type CouponIssueReq struct {
+	Qty int `json:"qty" binding:"required,min=1,max=10000"` // 发放数量
+}
+
+// CouponListReq 优惠券列表请求
+type CouponListReq struct {
+	upagination.RequestPagination
+	TemplateID uuid.UUID           `form:"template_id"`                                                                      // 模板ID
+	Code       string              `form:"code"`                                                                             // 券码
+	BatchNo    string              `form:"batch_no"`                                                                         // 发放批次号
+	Status     domain.CouponStatus `form:"status" binding:"omitempty,oneof=unused used invalidated redeeming redeem_failed"` // 状态
+}
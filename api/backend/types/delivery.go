@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// DeliveryStoreListReq 外卖平台门店绑定列表请求
+type DeliveryStoreListReq struct {
+	StoreID uuid.UUID `form:"store_id"` // 门店（可选）
+}
+
+// DeliveryStoreSaveReq 保存外卖平台门店绑定请求
+type DeliveryStoreSaveReq struct {
+	ID                  uuid.UUID                   `json:"id"`                                                   // 绑定ID，为空时新增
+	StoreID             uuid.UUID                   `json:"store_id" binding:"required"`                          // 门店ID
+	Platform            domain.DeliveryPlatformCode `json:"platform" binding:"required,oneof=grabfood foodpanda"` // 外卖平台，修改时不可变更
+	PlatformStoreID     string                      `json:"platform_store_id" binding:"required,max=100"`         // 平台门店ID
+	Enabled             bool                        `json:"enabled"`                                              // 是否接收平台订单
+	AutoAccept          bool                        `json:"auto_accept"`                                          // 是否自动接单
+	AutoAcceptMaxAmount decimal.Decimal             `json:"auto_accept_max_amount"`                               // 自动接单金额上限，为0不限制
+}
+
+// DeliveryMappingListReq 外卖商品映射列表请求
+type DeliveryMappingListReq struct {
+	Platform domain.DeliveryPlatformCode `form:"platform" binding:"omitempty,oneof=grabfood foodpanda"` // 外卖平台（可选）
+}
+
+// DeliveryMappingSaveReq 保存外卖商品映射请求
+type DeliveryMappingSaveReq struct {
+	ID          uuid.UUID                   `json:"id"`                                                   // 映射ID，为空时新增
+	Platform    domain.DeliveryPlatformCode `json:"platform" binding:"required,oneof=grabfood foodpanda"` // 外卖平台，修改时不可变更
+	PlatformSKU string                      `json:"platform_sku" binding:"required,max=100"`              // 平台商品编码
+	ProductID   uuid.UUID                   `json:"product_id" binding:"required"`                        // 商品ID
+	SpecID      uuid.UUID                   `json:"spec_id"`                                              // 规格ID，为空表示默认规格
+}
+
+// DeliveryMenuPushReq 推送外卖菜单请求
+type DeliveryMenuPushReq struct {
+	StoreID  uuid.UUID                   `json:"store_id" binding:"required"`                          // 门店ID
+	Platform domain.DeliveryPlatformCode `json:"platform" binding:"required,oneof=grabfood foodpanda"` // 外卖平台
+}
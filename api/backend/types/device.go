@@ -8,17 +8,18 @@ import (
 
 // DeviceCreateReq 创建设备请求
 type DeviceCreateReq struct {
-	StoreID       uuid.UUID             `json:"store_id" binding:"required"`                               // 门店 ID
-	Name          string                `json:"name" binding:"required,max=50"`                            // 设备名称
-	DeviceType    domain.DeviceType     `json:"device_type" binding:"required,oneof=cashier printer"`      // 设备类型
-	DeviceCode    string                `json:"device_code" binding:"required,max=100"`                    // 设备编号
-	DeviceBrand   string                `json:"device_brand" binding:"omitempty,max=100"`                  // 设备品牌
-	DeviceModel   string                `json:"device_model" binding:"omitempty,max=100"`                  // 设备型号
-	Location      domain.DeviceLocation `json:"location" binding:"required,oneof=front_hall back_kitchen"` // 设备位置
-	Enabled       bool                  `json:"enabled"`                                                   // 是否启用
-	SortOrder     int                   `json:"sort_order" binding:"omitempty,gte=0"`                      // 排序值，越小越靠前
-	DevicePrint   DevicePrint           `json:"device_print" binding:"omitempty"`                          // 打印机设备配置
-	DeviceCashier DeviceCashier         `json:"device_cashier" binding:"omitempty"`                        // 收银机设备配置
+	StoreID       uuid.UUID             `json:"store_id" binding:"required"`                                // 门店 ID
+	Name          string                `json:"name" binding:"required,max=50"`                             // 设备名称
+	DeviceType    domain.DeviceType     `json:"device_type" binding:"required,oneof=cashier printer kiosk"` // 设备类型
+	DeviceCode    string                `json:"device_code" binding:"required,max=100"`                     // 设备编号
+	DeviceBrand   string                `json:"device_brand" binding:"omitempty,max=100"`                   // 设备品牌
+	DeviceModel   string                `json:"device_model" binding:"omitempty,max=100"`                   // 设备型号
+	Location      domain.DeviceLocation `json:"location" binding:"required,oneof=front_hall back_kitchen"`  // 设备位置
+	Enabled       bool                  `json:"enabled"`                                                    // 是否启用
+	SortOrder     int                   `json:"sort_order" binding:"omitempty,gte=0"`                       // 排序值，越小越靠前
+	DevicePrint   DevicePrint           `json:"device_print" binding:"omitempty"`                           // 打印机设备配置
+	DeviceCashier DeviceCashier         `json:"device_cashier" binding:"omitempty"`                         // 收银机设备配置
+	DeviceKiosk   DeviceKiosk           `json:"device_kiosk" binding:"omitempty"`                           // 自助点餐机设备配置
 }
 
 // DevicePrint 打印机设备
@@ -31,6 +32,7 @@ type DevicePrint struct {
 	DiningWays             []domain.DiningWay            `json:"dining_ways" binding:"required,dive,oneof=dine_in take_out delivery"`                                             // 用餐方式
 	DeviceStallPrintType   domain.DeviceStallPrintType   `json:"device_stall_print_type" binding:"required,oneof=all combined separate"`                                          // 打印出品部门总分单
 	DeviceStallReceiptType domain.DeviceStallReceiptType `json:"device_stall_receipt_type" binding:"required,oneof=all exclude"`                                                  // 打印出品部门全部票据
+	PrintLocale            string                        `json:"print_locale" binding:"omitempty,oneof=en-US zh-CN ms-MY"`                                                        // 小票和厨房单打印语言（可选，为空时使用默认语言）
 }
 
 // DeviceCashier 收银机设备
@@ -38,19 +40,25 @@ type DeviceCashier struct {
 	OpenCashDrawer bool `json:"open_cash_drawer"` // 开启钱箱
 }
 
+// DeviceKiosk 自助点餐机设备
+type DeviceKiosk struct {
+	PayModes []domain.KioskPayMode `json:"pay_modes" binding:"omitempty,dive,oneof=pay_at_kiosk pay_at_counter"` // 支持的支付方式，为空表示均支持
+}
+
 // DeviceUpdateReq 更新设备请求
 type DeviceUpdateReq struct {
-	StoreID       uuid.UUID             `json:"store_id" binding:"required"`                               // 门店 ID
-	Name          string                `json:"name" binding:"required,max=50"`                            // 设备名称
-	DeviceType    domain.DeviceType     `json:"device_type" binding:"required,oneof=cashier printer"`      // 设备类型
-	DeviceCode    string                `json:"device_code" binding:"omitempty,max=100"`                   // 设备编号
-	DeviceBrand   string                `json:"device_brand" binding:"omitempty,max=100"`                  // 设备品牌
-	DeviceModel   string                `json:"device_model" binding:"omitempty,max=100"`                  // 设备型号
-	Location      domain.DeviceLocation `json:"location" binding:"required,oneof=front_hall back_kitchen"` // 设备位置
-	Enabled       bool                  `json:"enabled"`                                                   // 是否启用
-	SortOrder     int                   `json:"sort_order" binding:"omitempty,gte=0"`                      // 排序值，越小越靠前
-	DevicePrint   DevicePrint           `json:"device_print" binding:"omitempty"`                          // 打印机设备配置
-	DeviceCashier DeviceCashier         `json:"device_cashier" binding:"omitempty"`                        // 收银机设备配置
+	StoreID       uuid.UUID             `json:"store_id" binding:"required"`                                // 门店 ID
+	Name          string                `json:"name" binding:"required,max=50"`                             // 设备名称
+	DeviceType    domain.DeviceType     `json:"device_type" binding:"required,oneof=cashier printer kiosk"` // 设备类型
+	DeviceCode    string                `json:"device_code" binding:"omitempty,max=100"`                    // 设备编号
+	DeviceBrand   string                `json:"device_brand" binding:"omitempty,max=100"`                   // 设备品牌
+	DeviceModel   string                `json:"device_model" binding:"omitempty,max=100"`                   // 设备型号
+	Location      domain.DeviceLocation `json:"location" binding:"required,oneof=front_hall back_kitchen"`  // 设备位置
+	Enabled       bool                  `json:"enabled"`                                                    // 是否启用
+	SortOrder     int                   `json:"sort_order" binding:"omitempty,gte=0"`                       // 排序值，越小越靠前
+	DevicePrint   DevicePrint           `json:"device_print" binding:"omitempty"`                           // 打印机设备配置
+	DeviceCashier DeviceCashier         `json:"device_cashier" binding:"omitempty"`                         // 收银机设备配置
+	DeviceKiosk   DeviceKiosk           `json:"device_kiosk" binding:"omitempty"`                           // 自助点餐机设备配置
 }
 
 // DeviceListReq 设备列表查询
@@ -66,3 +74,8 @@ type DeviceListResp struct {
 	Devices []*domain.Device `json:"devices"` // 设备列表
 	Total   int              `json:"total"`   // 总数
 }
+
+// DeviceKioskTokenResp 自助点餐机访问令牌
+type DeviceKioskTokenResp struct {
+	Token string `json:"token"` // 访问令牌，仅在生成时返回，需配置到自助点餐机
+}
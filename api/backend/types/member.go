@@ -0,0 +1,42 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// MemberTierSaveReq 创建/更新会员等级请求
+type MemberTierSaveReq struct {
+	Name               string `json:"name" binding:"required,max=50"`  // 等级名称（必选）
+	Level              int    `json:"level" binding:"omitempty,min=0"` // 等级序号，值越大等级越高
+	MemberPriceEnabled bool   `json:"member_price_enabled"`            // 是否享受会员价
+	Description        string `json:"description" binding:"max=255"`   // 等级说明
+}
+
+// MemberSaveReq 创建/更新会员请求
+type MemberSaveReq struct {
+	TierID   uuid.UUID     `json:"tier_id"`                                                    // 会员等级ID
+	Name     string        `json:"name" binding:"max=50"`                                      // 姓名
+	Phone    string        `json:"phone" binding:"required,max=20"`                            // 手机号（必选）
+	OpenID   string        `json:"openid" binding:"max=64"`                                    // 微信 openid
+	Gender   domain.Gender `json:"gender" binding:"omitempty,oneof=male female other unknown"` // 性别
+	Birthday *time.Time    `json:"birthday"`                                                   // 生日
+	Enabled  bool          `json:"enabled"`                                                    // 是否启用
+	Remark   string        `json:"remark" binding:"max=255"`                                   // 备注
+}
+
+// MemberListReq 会员列表请求
+type MemberListReq struct {
+	upagination.RequestPagination
+	TierID  uuid.UUID `form:"tier_id"` // 会员等级
+	Keyword string    `form:"keyword"` // 姓名或手机号（模糊匹配）
+	Enabled *bool     `form:"enabled"` // 是否启用
+}
+
+// MemberOrderListReq 会员消费记录请求
+type MemberOrderListReq struct {
+	upagination.RequestPagination
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// MemberAccountAdjustReq 储值余额调整请求
+type MemberAccountAdjustReq struct {
+	Amount      decimal.Decimal `json:"amount"`                            // 本金调整额（正数增加，负数扣减）
+	BonusAmount decimal.Decimal `json:"bonus_amount"`                      // 赠送金调整额（正数增加，负数扣减）
+	Remark      string          `json:"remark" binding:"required,max=255"` // 调整原因（必选）
+}
+
+// MemberAccountTransactionListReq 储值流水列表请求
+type MemberAccountTransactionListReq struct {
+	upagination.RequestPagination
+	StoreID  uuid.UUID                           `form:"store_id"`                                                        // 门店（可选）
+	MemberID uuid.UUID                           `form:"member_id"`                                                       // 会员（可选）
+	Type     domain.MemberAccountTransactionType `form:"type" binding:"omitempty,oneof=top_up payment refund adjustment"` // 流水类型（可选）
+	StartAt  string                              `form:"start_at"`                                                        // 开始日期（可选，格式 2006-01-02）
+	EndAt    string                              `form:"end_at"`                                                          // 结束日期（可选，格式 2006-01-02）
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// MemberPointsRuleSaveReq 保存积分规则请求
+type MemberPointsRuleSaveReq struct {
+	Enabled          bool                                `json:"enabled"`                                   // 是否开启积分获取
+	EarnRate         decimal.Decimal                     `json:"earn_rate"`                                 // 基础比例：每消费1元获得的积分
+	CategoryRates    []domain.MemberPointsCategoryRate   `json:"category_rates" binding:"omitempty,dive"`   // 分类积分比例（可选）
+	TierMultipliers  []domain.MemberPointsTierMultiplier `json:"tier_multipliers" binding:"omitempty,dive"` // 会员等级积分倍率（可选）
+	ExpireDays       int                                 `json:"expire_days" binding:"min=0"`               // 积分有效天数，0 表示永久有效
+	RedeemEnabled    bool                                `json:"redeem_enabled"`                            // 是否开启积分抵扣
+	RedeemRate       int64                               `json:"redeem_rate" binding:"min=0"`               // 抵扣比例：多少积分抵扣1元
+	RedeemMinPoints  int64                               `json:"redeem_min_points" binding:"min=0"`         // 单次最低使用积分
+	RedeemMaxPercent decimal.Decimal                     `json:"redeem_max_percent"`                        // 抵扣金额占订单应收的最高比例（百分比）
+	RedeemProducts   []domain.MemberPointsRedeemProduct  `json:"redeem_products" binding:"omitempty,dive"`  // 积分兑换商品（可选）
+}
+
+// MemberPointsAdjustReq 积分调整请求
+type MemberPointsAdjustReq struct {
+	Points int64  `json:"points" binding:"required"`         // 调整积分（正数增加，负数扣减）
+	Remark string `json:"remark" binding:"required,max=255"` // 调整原因（必选）
+}
+
+// MemberPointsTransactionListReq 积分流水列表请求
+type MemberPointsTransactionListReq struct {
+	upagination.RequestPagination
+	StoreID  uuid.UUID                          `form:"store_id"`                                                                     // 门店（可选）
+	MemberID uuid.UUID                          `form:"member_id"`                                                                    // 会员（可选）
+	Type     domain.MemberPointsTransactionType `form:"type" binding:"omitempty,oneof=earn redeem reverse restore expire adjustment"` // 流水类型（可选）
+	StartAt  string                             `form:"start_at"`                                                                     // 开始日期（可选，格式 2006-01-02）
+	EndAt    string                             `form:"end_at"`                                                                       // 结束日期（可选，格式 2006-01-02）
+}
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
 )
 
@@ -15,9 +18,10 @@ type MenuCreateReq struct {
 
 // MenuItemReq 菜单项请求
 type MenuItemReq struct {
-	ProductID   uuid.UUID        `json:"product_id" binding:"required"` // 菜品ID（必选）
-	BasePrice   *decimal.Decimal `json:"base_price,omitempty"`          // 基础价（可选，单位：分）
-	MemberPrice *decimal.Decimal `json:"member_price,omitempty"`        // 会员价（可选，单位：分）
+	ProductID    uuid.UUID           `json:"product_id" binding:"required"` // 菜品ID（必选）
+	BasePrice    *decimal.Decimal    `json:"base_price,omitempty"`          // 基础价（可选，单位：分）
+	MemberPrice  *decimal.Decimal    `json:"member_price,omitempty"`        // 会员价（可选，单位：分）
+	PeriodPrices domain.PeriodPrices `json:"period_prices,omitempty"`       // 就餐时段价格（可选）
 }
 
 // MenuUpdateReq 更新菜单请求
@@ -32,3 +36,8 @@ type MenuListReq struct {
 	upagination.RequestPagination
 	Name string `json:"name" form:"name"` // 菜单名称（模糊匹配）
 }
+
+// MenuPublishReq 发布菜单草稿请求
+type MenuPublishReq struct {
+	ScheduledAt *time.Time `json:"scheduled_at"` // 定时发布时间（可选，为空时立即发布）
+}
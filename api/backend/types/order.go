@@ -29,6 +29,7 @@ type ProductSalesSummaryReq struct {
 	CategoryID        string `form:"category_id"`                                            // 商品分类ID
 	ProductName       string `form:"product_name"`                                           // 商品名称（模糊搜索）
 	ProductType       string `form:"product_type" binding:"omitempty,oneof=normal set_meal"` // 商品类型
+	SetMealComponents bool   `form:"set_meal_components"`                                    // 是否按套餐子商品汇总
 
 	upagination.RequestPagination
 }
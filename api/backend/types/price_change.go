@@ -0,0 +1,34 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// PriceChangeCreateReq 创建定时调价批次请求
+type PriceChangeCreateReq struct {
+	Name        string                     `json:"name" binding:"required,max=255"`     // 批次名称（必选）
+	EffectiveAt time.Time                  `json:"effective_at" binding:"required"`     // 生效时间（必选）
+	StoreIDs    []uuid.UUID                `json:"store_ids"`                           // 菜单价适用门店ID列表（调整菜单价时必选）
+	Remark      string                     `json:"remark" binding:"max=255"`            // 备注（可选）
+	Items       []PriceChangeItemCreateReq `json:"items" binding:"required,min=1,dive"` // 调价明细（必选，至少一个）
+}
+
+// PriceChangeItemCreateReq 调价明细请求
+type PriceChangeItemCreateReq struct {
+	TargetType domain.PriceChangeTargetType `json:"target_type" binding:"required,oneof=product_spec menu_item"` // 调价对象：product_spec（商品规格价）、menu_item（门店菜单价）
+	ProductID  uuid.UUID                    `json:"product_id" binding:"required"`                               // 商品ID（必选）
+	SpecID     uuid.UUID                    `json:"spec_id"`                                                     // 规格ID（调整商品规格价时必选）
+	NewPrice   decimal.Decimal              `json:"new_price" binding:"required"`                                // 调整后价格（必选）
+}
+
+// PriceChangeListReq 定时调价批次列表请求
+type PriceChangeListReq struct {
+	upagination.RequestPagination
+	Name   string                        `form:"name"`                                                                          // 批次名称（模糊匹配）
+	Status domain.PriceChangeBatchStatus `form:"status" binding:"omitempty,oneof=pending applied cancelled rolled_back failed"` // 状态
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// ProductVersionListReq 商品历史版本列表请求
+type ProductVersionListReq struct {
+	upagination.RequestPagination
+	SubjectType domain.ProductVersionSubjectType `form:"subject_type" binding:"omitempty,oneof=product product_spec attr_item menu_item"` // 对象类型
+	ProductID   uuid.UUID                        `form:"product_id"`                                                                      // 关联商品ID
+	SubjectID   uuid.UUID                        `form:"subject_id"`                                                                      // 对象ID
+	Action      domain.ProductVersionAction      `form:"action" binding:"omitempty,oneof=create update delete restore"`                   // 操作类型
+	StartAt     *time.Time                       `form:"start_at"`                                                                        // 操作时间开始
+	EndAt       *time.Time                       `form:"end_at"`                                                                          // 操作时间结束
+}
+
+// ProductVersionSnapshotReq 查询指定时间点版本请求
+type ProductVersionSnapshotReq struct {
+	SubjectType domain.ProductVersionSubjectType `form:"subject_type" binding:"required,oneof=product product_spec attr_item menu_item"` // 对象类型（必选）
+	ProductID   uuid.UUID                        `form:"product_id"`                                                                     // 关联商品ID（口味做法项不传）
+	SubjectID   uuid.UUID                        `form:"subject_id" binding:"required"`                                                  // 对象ID（必选）
+	At          time.Time                        `form:"at" binding:"required"`                                                          // 时间点（必选）
+}
+
+// ProductVersionDiffReq 对比历史版本请求
+type ProductVersionDiffReq struct {
+	From uuid.UUID `form:"from" binding:"required"` // 对比起始版本ID（必选）
+	To   uuid.UUID `form:"to" binding:"required"`   // 对比目标版本ID（必选）
+}
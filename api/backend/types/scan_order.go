@@ -0,0 +1,9 @@
+package types
+
+import "gitlab.jiguang.dev/pos-dine/dine/domain"
+
+// ScanOrderRuleSaveReq 保存扫码点餐规则请求
+type ScanOrderRuleSaveReq struct {
+	Enabled bool                    `json:"enabled"`                                                    // 是否开启扫码点餐
+	PayMode domain.ScanOrderPayMode `json:"pay_mode" binding:"required,oneof=pay_first pay_at_counter"` // 支付方式
+}
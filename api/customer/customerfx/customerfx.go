@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"gitlab.jiguang.dev/pos-dine/dine/api/customer"
+	"gitlab.jiguang.dev/pos-dine/dine/api/customer/handler"
+	mid "gitlab.jiguang.dev/pos-dine/dine/api/customer/middleware"
 	"gitlab.jiguang.dev/pos-dine/dine/bootstrap/httpserver"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/middleware"
@@ -22,7 +24,9 @@ var Module = fx.Module(
 	fx.Provide(
 		asMiddleware(middleware.NewRecovery),
 		asMiddleware(middleware.NewErrorHandling),
-		asMiddleware(func(c httpserver.Config) *middleware.TimeLimiter { return middleware.NewTimeLimiter(c.RequestTimeout) }),
+		asMiddleware(func(c httpserver.Config) *middleware.TimeLimiter {
+			return middleware.NewTimeLimiter(c.RequestTimeout, pushSkipper)
+		}),
 		asMiddleware(middleware.NewPopulateRequestID),
 		asMiddleware(middleware.NewPopulateLogger),
 		fx.Annotate(
@@ -31,11 +35,31 @@ var Module = fx.Module(
 			fx.ResultTags(`group:"middlewares"`),
 		),
 		asMiddleware(middleware.NewLogger),
+		fx.Annotate(
+			mid.NewAuth,
+			fx.As(new(ugin.Middleware)),
+			fx.ParamTags(`group:"handlers"`),
+			fx.ResultTags(`group:"middlewares"`),
+		),
 	),
 	// handler
-	fx.Provide(),
+	fx.Provide(
+		asHandler(handler.NewCustomerHandler),
+		asHandler(handler.NewMemberHandler),
+		asHandler(handler.NewMemberPointsHandler),
+		asHandler(handler.NewScanOrderHandler),
+		asHandler(handler.NewPushHandler),
+		asHandler(handler.NewOrderReceiptHandler),
+		asHandler(handler.NewDeliveryHandler),
+		asHandler(handler.NewReservationHandler),
+		asHandler(handler.NewWaitlistHandler),
+		asHandler(handler.NewPreorderHandler),
+	),
 )
 
+// pushSkipper 实时推送为长连接，不受请求超时限制
+var pushSkipper = middleware.AllowPathPrefixSkipper(customer.ApiPrefixV1 + "/push")
+
 func asHandler(f any) any {
 	return fx.Annotate(
 		f,
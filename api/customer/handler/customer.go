@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/customer/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type CustomerHandler struct {
+	CustomerInteractor domain.CustomerInteractor
+}
+
+func NewCustomerHandler(customerInteractor domain.CustomerInteractor) *CustomerHandler {
+	return &CustomerHandler{
+		CustomerInteractor: customerInteractor,
+	}
+}
+
+func (h *CustomerHandler) Routes(r gin.IRouter) {
+	r = r.Group("/customer")
+	r.POST("/login", h.Login())
+	r.GET("/profile", h.Profile())
+	r.PUT("/profile", h.UpdateProfile())
+	r.POST("/phone", h.BindPhone())
+}
+
+func (h *CustomerHandler) NoAuths() []string {
+	return []string{
+		"/customer/login",
+	}
+}
+
+// Login
+//
+//	@Tags		客户
+//	@Summary	小程序登录
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.WXLoginReq	true	"请求信息"
+//	@Success	200		{object}	types.WXLoginResp	"成功"
+//	@Router		/customer/login [post]
+func (h *CustomerHandler) Login() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CustomerHandler.Login")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.WXLoginReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		token, expAt, err := h.CustomerInteractor.Login(ctx, req.Code)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to login customer: %w", err))
+			return
+		}
+
+		response.Ok(c, &types.WXLoginResp{
+			Token:  token,
+			Expire: expAt.Unix(),
+		})
+	}
+}
+
+// Profile
+//
+//	@Tags		客户
+//	@Security	BearerAuth
+//	@Summary	查询我的资料
+//	@Produce	json
+//	@Success	200	{object}	domain.Customer	"成功"
+//	@Router		/customer/profile [get]
+func (h *CustomerHandler) Profile() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		response.Ok(c, domain.FromCustomerContext(c.Request.Context()))
+	}
+}
+
+// UpdateProfile
+//
+//	@Tags		客户
+//	@Security	BearerAuth
+//	@Summary	更新我的资料
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.CustomerProfileUpdateReq	true	"请求信息"
+//	@Success	200		{object}	domain.Customer					"成功"
+//	@Router		/customer/profile [put]
+func (h *CustomerHandler) UpdateProfile() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CustomerHandler.UpdateProfile")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.CustomerProfileUpdateReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		current := domain.FromCustomerContext(ctx)
+		res, err := h.CustomerInteractor.UpdateProfile(ctx, domain.CustomerProfileParams{
+			ID:       current.ID,
+			Nickname: req.Nickname,
+			Avatar:   req.Avatar,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to update customer profile: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// BindPhone
+//
+//	@Tags		客户
+//	@Security	BearerAuth
+//	@Summary	绑定手机号
+//	@Description	使用手机号快速验证组件获取的 code 绑定手机号，绑定后可按手机号关联各品牌商的会员
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.CustomerBindPhoneReq	true	"请求信息"
+//	@Success	200		{object}	domain.Customer				"成功"
+//	@Router		/customer/phone [post]
+func (h *CustomerHandler) BindPhone() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CustomerHandler.BindPhone")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.CustomerBindPhoneReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		current := domain.FromCustomerContext(ctx)
+		res, err := h.CustomerInteractor.BindPhone(ctx, current.ID, req.Code)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to bind customer phone: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
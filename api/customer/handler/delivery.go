@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type DeliveryHandler struct {
+	DeliveryOrderInteractor domain.DeliveryOrderInteractor
+}
+
+func NewDeliveryHandler(deliveryOrderInteractor domain.DeliveryOrderInteractor) *DeliveryHandler {
+	return &DeliveryHandler{
+		DeliveryOrderInteractor: deliveryOrderInteractor,
+	}
+}
+
+func (h *DeliveryHandler) Routes(r gin.IRouter) {
+	r = r.Group("/delivery")
+	r.POST("/webhook/:platform", h.Webhook())
+}
+
+func (h *DeliveryHandler) NoAuths() []string {
+	return []string{"/delivery/webhook"}
+}
+
+// Webhook
+//
+//	@Tags		三方外卖
+//	@Summary	接收外卖平台订单推送（新订单、取消订单）
+//	@Description	按平台约定的请求头校验签名，签名无效返回 401；重复推送幂等处理
+//	@Accept		json
+//	@Produce	json
+//	@Param		platform	path	string	true	"外卖平台"	Enums(grabfood, foodpanda)
+//	@Success	200
+//	@Router		/delivery/webhook/{platform} [post]
+func (h *DeliveryHandler) Webhook() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DeliveryHandler.Webhook")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		// 签名基于原始请求体计算，不能先反序列化
+		body, err := c.GetRawData()
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		platform := domain.DeliveryPlatformCode(c.Param("platform"))
+		if err := h.DeliveryOrderInteractor.HandleWebhook(ctx, platform, c.Request.Header, body); err != nil {
+			if errors.Is(err, domain.ErrDeliverySignatureInvalid) {
+				c.Error(errorx.New(http.StatusUnauthorized, errcode.Unauthorized, err))
+				return
+			}
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to handle delivery webhook: %w", err))
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/customer/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type MemberHandler struct {
+	MemberInteractor domain.MemberInteractor
+}
+
+func NewMemberHandler(memberInteractor domain.MemberInteractor) *MemberHandler {
+	return &MemberHandler{
+		MemberInteractor: memberInteractor,
+	}
+}
+
+func (h *MemberHandler) Routes(r gin.IRouter) {
+	r = r.Group("/member")
+	r.GET("", h.Get())
+}
+
+func (h *MemberHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Get
+//
+//	@Tags		会员
+//	@Security	BearerAuth
+//	@Summary	查询我的会员信息
+//	@Description	按 openid 查找会员；未关联时按已绑定的手机号查找并关联
+//	@Produce	json
+//	@Param		data	query		types.MemberGetReq	true	"请求信息"
+//	@Success	200		{object}	domain.Member		"成功"
+//	@Router		/member [get]
+func (h *MemberHandler) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.Get")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberGetReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		res, err := h.MemberInteractor.LookupByCustomer(ctx, req.MerchantID, domain.FromCustomerContext(ctx))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to lookup member: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
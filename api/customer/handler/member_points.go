@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/customer/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type MemberPointsHandler struct {
+	MemberInteractor       domain.MemberInteractor
+	MemberPointsInteractor domain.MemberPointsInteractor
+}
+
+func NewMemberPointsHandler(
+	memberInteractor domain.MemberInteractor,
+	memberPointsInteractor domain.MemberPointsInteractor,
+) *MemberPointsHandler {
+	return &MemberPointsHandler{
+		MemberInteractor:       memberInteractor,
+		MemberPointsInteractor: memberPointsInteractor,
+	}
+}
+
+func (h *MemberPointsHandler) Routes(r gin.IRouter) {
+	r = r.Group("/member_points")
+	r.GET("", h.Get())
+	r.GET("/transaction", h.ListTransactions())
+}
+
+func (h *MemberPointsHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Get
+//
+//	@Tags		会员积分
+//	@Security	BearerAuth
+//	@Summary	查询我的积分
+//	@Produce	json
+//	@Param		data	query		types.MemberPointsReq		true	"请求信息"
+//	@Success	200		{object}	domain.MemberPointsAccount	"成功"
+//	@Router		/member_points [get]
+func (h *MemberPointsHandler) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberPointsHandler.Get")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberPointsReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		member, err := h.MemberInteractor.LookupByCustomer(ctx, req.MerchantID, domain.FromCustomerContext(ctx))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to lookup member: %w", err))
+			return
+		}
+
+		res, err := h.MemberPointsInteractor.GetByMemberID(ctx, member.MerchantID, member.ID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get member points account: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// ListTransactions
+//
+//	@Tags		会员积分
+//	@Security	BearerAuth
+//	@Summary	查询我的积分明细
+//	@Produce	json
+//	@Param		data	query		types.MemberPointsTransactionListReq		true	"请求信息"
+//	@Success	200		{object}	domain.MemberPointsTransactionSearchRes	"成功"
+//	@Router		/member_points/transaction [get]
+func (h *MemberPointsHandler) ListTransactions() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberPointsHandler.ListTransactions")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberPointsTransactionListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		member, err := h.MemberInteractor.LookupByCustomer(ctx, req.MerchantID, domain.FromCustomerContext(ctx))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to lookup member: %w", err))
+			return
+		}
+
+		page := upagination.New(req.Page, req.Size)
+		res, err := h.MemberPointsInteractor.PagedListTransactions(ctx, page, domain.MemberPointsTransactionSearchParams{
+			MerchantID: member.MerchantID,
+			MemberID:   member.ID,
+			Type:       req.Type,
+		})
+		if err != nil {
+			c.Error(fmt.Errorf("failed to list member points transactions: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
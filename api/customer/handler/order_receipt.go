@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/customer/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type OrderReceiptHandler struct {
+	OrderReceiptInteractor domain.OrderReceiptInteractor
+}
+
+func NewOrderReceiptHandler(orderReceiptInteractor domain.OrderReceiptInteractor) *OrderReceiptHandler {
+	return &OrderReceiptHandler{
+		OrderReceiptInteractor: orderReceiptInteractor,
+	}
+}
+
+func (h *OrderReceiptHandler) Routes(r gin.IRouter) {
+	r = r.Group("/receipt/:token")
+	r.GET("", h.Get())
+	r.POST("/request", h.SubmitRequest())
+}
+
+func (h *OrderReceiptHandler) NoAuths() []string {
+	return []string{"/receipt"}
+}
+
+// Get
+//
+//	@Tags		电子小票
+//	@Summary	查询电子小票（订单明细、出品进度、申请记录）
+//	@Produce	json
+//	@Param		token	path		string					true	"电子小票令牌"
+//	@Success	200		{object}	domain.OrderReceipt	"成功"
+//	@Router		/receipt/{token} [get]
+func (h *OrderReceiptHandler) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("OrderReceiptHandler.Get")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		receipt, err := h.OrderReceiptInteractor.Get(ctx, c.Param("token"))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get order receipt: %w", err))
+			return
+		}
+
+		response.Ok(c, receipt)
+	}
+}
+
+// SubmitRequest
+//
+//	@Tags		电子小票
+//	@Summary	提交退款/开票申请
+//	@Accept		json
+//	@Produce	json
+//	@Param		token	path		string							true	"电子小票令牌"
+//	@Param		data	body		types.ReceiptRequestSubmitReq	true	"请求信息"
+//	@Success	200		{object}	domain.ReceiptRequest			"成功"
+//	@Router		/receipt/{token}/request [post]
+func (h *OrderReceiptHandler) SubmitRequest() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("OrderReceiptHandler.SubmitRequest")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ReceiptRequestSubmitReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		params := domain.OrderReceiptSubmitParams{
+			Type:         req.Type,
+			Reason:       req.Reason,
+			ContactPhone: req.ContactPhone,
+			InvoiceTitle: req.InvoiceTitle,
+			InvoiceTaxNo: req.InvoiceTaxNo,
+			InvoiceEmail: req.InvoiceEmail,
+		}
+		request, err := h.OrderReceiptInteractor.SubmitRequest(ctx, c.Param("token"), params)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to submit receipt request: %w", err))
+			return
+		}
+
+		response.Ok(c, request)
+	}
+}
@@ -0,0 +1,277 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/customer/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type PreorderHandler struct {
+	PreorderInteractor domain.PreorderInteractor
+}
+
+func NewPreorderHandler(preorderInteractor domain.PreorderInteractor) *PreorderHandler {
+	return &PreorderHandler{
+		PreorderInteractor: preorderInteractor,
+	}
+}
+
+func (h *PreorderHandler) Routes(r gin.IRouter) {
+	r = r.Group("/preorder")
+	r.GET("/stores/:store_id/slots", h.Slots())
+	r.GET("/stores/:store_id/menu", h.ListMenus())
+	r.POST("", h.Submit())
+	r.GET("", h.List())
+	r.GET("/:id", h.Get())
+	r.POST("/:id/cancel", h.Cancel())
+}
+
+func (h *PreorderHandler) NoAuths() []string {
+	return []string{"/preorder/stores"}
+}
+
+// Slots
+//
+//	@Tags		预点单
+//	@Summary	查询门店可预约时段
+//	@Description	时段由门店营业时间和预点单规则生成，不足下单提前量或已约满的时段不可预约
+//	@Produce	json
+//	@Param		store_id	path		string					true	"门店ID"
+//	@Param		data		query		types.PreorderSlotReq	true	"请求信息"
+//	@Success	200			{object}	[]domain.PreorderSlot	"成功"
+//	@Router		/preorder/stores/{store_id}/slots [get]
+func (h *PreorderHandler) Slots() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PreorderHandler.Slots")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		storeID, err := uuid.Parse(c.Param("store_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.PreorderSlotReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		res, err := h.PreorderInteractor.Slots(ctx, storeID, req.Date)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list preorder slots: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// ListMenus
+//
+//	@Tags		预点单
+//	@Summary	查询门店预点单菜单，按预约时间所在时段取价
+//	@Produce	json
+//	@Param		store_id	path		string					true	"门店ID"
+//	@Param		data		query		types.PreorderMenuReq	false	"请求信息"
+//	@Success	200			{object}	domain.Menus			"成功"
+//	@Router		/preorder/stores/{store_id}/menu [get]
+func (h *PreorderHandler) ListMenus() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PreorderHandler.ListMenus")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		storeID, err := uuid.Parse(c.Param("store_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.PreorderMenuReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		menus, err := h.PreorderInteractor.ListMenus(ctx, storeID, req.At)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list preorder menus: %w", err))
+			return
+		}
+
+		response.Ok(c, menus)
+	}
+}
+
+// Submit
+//
+//	@Tags		预点单
+//	@Security	BearerAuth
+//	@Summary	预点单下单
+//	@Description	订单在预约时间前按门店备餐提前量自动下发后厨，到店或送达时付款
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.PreorderSubmitReq	true	"请求信息"
+//	@Success	200		{object}	domain.Preorder			"成功"
+//	@Router		/preorder [post]
+func (h *PreorderHandler) Submit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PreorderHandler.Submit")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PreorderSubmitReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		items := make([]domain.PreorderItemParams, 0, len(req.Items))
+		for _, item := range req.Items {
+			items = append(items, domain.PreorderItemParams{
+				ProductID: item.ProductID,
+				SpecID:    item.SpecID,
+				Attrs:     item.Attrs,
+				Groups:    item.Groups,
+				Qty:       item.Qty,
+				Remark:    item.Remark,
+			})
+		}
+		res, err := h.PreorderInteractor.Submit(ctx, domain.FromCustomerContext(ctx), domain.PreorderSubmitParams{
+			StoreID:         req.StoreID,
+			Fulfilment:      req.Fulfilment,
+			ScheduledAt:     req.ScheduledAt,
+			ContactName:     req.ContactName,
+			ContactPhone:    req.ContactPhone,
+			DeliveryAddress: req.DeliveryAddress,
+			Items:           items,
+			Remark:          req.Remark,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to submit preorder: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// List
+//
+//	@Tags		预点单
+//	@Security	BearerAuth
+//	@Summary	我的预点单：今天及以后的预点单，按预约时间升序
+//	@Produce	json
+//	@Success	200	{object}	[]domain.Preorder	"成功"
+//	@Router		/preorder [get]
+func (h *PreorderHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PreorderHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		res, err := h.PreorderInteractor.ListByCustomer(ctx, domain.FromCustomerContext(ctx).ID)
+		if err != nil {
+			c.Error(fmt.Errorf("failed to list preorders: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Get
+//
+//	@Tags		预点单
+//	@Security	BearerAuth
+//	@Summary	查询我的预点单详情，含订单商品
+//	@Produce	json
+//	@Param		id	path		string			true	"预点单ID"
+//	@Success	200	{object}	domain.Preorder	"成功"
+//	@Router		/preorder/{id} [get]
+func (h *PreorderHandler) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PreorderHandler.Get")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		res, err := h.PreorderInteractor.GetByCustomer(ctx, domain.FromCustomerContext(ctx).ID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get preorder: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Cancel
+//
+//	@Tags		预点单
+//	@Security	BearerAuth
+//	@Summary	取消我的预点单，需在下发后厨前取消
+//	@Produce	json
+//	@Param		id	path		string			true	"预点单ID"
+//	@Success	200	{object}	domain.Preorder	"成功"
+//	@Router		/preorder/{id}/cancel [post]
+func (h *PreorderHandler) Cancel() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PreorderHandler.Cancel")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		res, err := h.PreorderInteractor.CancelByCustomer(ctx, domain.FromCustomerContext(ctx).ID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to cancel preorder: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/push"
+)
+
+type PushHandler struct {
+	Hub            *push.Hub
+	PushInteractor domain.PushInteractor
+}
+
+func NewPushHandler(hub *push.Hub, pushInteractor domain.PushInteractor) *PushHandler {
+	return &PushHandler{
+		Hub:            hub,
+		PushInteractor: pushInteractor,
+	}
+}
+
+func (h *PushHandler) Routes(r gin.IRouter) {
+	r = r.Group("/push")
+	r.GET("/table/:token", h.Subscribe())
+	r.GET("/receipt/:token", h.SubscribeReceipt())
+	r.GET("/waitlist/:id", h.SubscribeWaitlist())
+}
+
+func (h *PushHandler) NoAuths() []string {
+	return []string{"/push/receipt", "/push/waitlist"}
+}
+
+// Subscribe
+//
+//	@Tags		实时推送
+//	@Security	BearerAuth
+//	@Summary	订阅桌台实时事件（购物车变更、订单状态）
+//	@Description	以 Server-Sent Events 推送事件，事件 ID 作为断线重连游标（Last-Event-ID 请求头或 last_event_id 参数）
+//	@Produce	text/event-stream
+//	@Param		token	path	string	true	"桌台二维码令牌"
+//	@Param		last_event_id	query	string	false	"重连游标"
+//	@Success	200
+//	@Router		/push/table/{token} [get]
+func (h *PushHandler) Subscribe() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PushHandler.Subscribe")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		h.serve(c, domain.PushSubscribeParams{
+			TableToken: c.Param("token"),
+		})
+	}
+}
+
+// SubscribeReceipt
+//
+//	@Tags		实时推送
+//	@Summary	订阅电子小票订单实时事件（出品进度、申请处理结果）
+//	@Description	无需登录，凭电子小票令牌订阅；事件 ID 作为断线重连游标（Last-Event-ID 请求头或 last_event_id 参数）
+//	@Produce	text/event-stream
+//	@Param		token	path	string	true	"电子小票令牌"
+//	@Param		last_event_id	query	string	false	"重连游标"
+//	@Success	200
+//	@Router		/push/receipt/{token} [get]
+func (h *PushHandler) SubscribeReceipt() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PushHandler.SubscribeReceipt")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		h.serve(c, domain.PushSubscribeParams{
+			ReceiptToken: c.Param("token"),
+		})
+	}
+}
+
+// SubscribeWaitlist
+//
+//	@Tags		实时推送
+//	@Summary	订阅排队号所在门店的排队事件（取号、叫号、入座、过号）
+//	@Description	无需登录，凭排队号ID订阅；推送内容不含顾客信息，收到后重新拉取排队状态
+//	@Produce	text/event-stream
+//	@Param		id	path	string	true	"排队号ID"
+//	@Param		last_event_id	query	string	false	"重连游标"
+//	@Success	200
+//	@Router		/push/waitlist/{id} [get]
+func (h *PushHandler) SubscribeWaitlist() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PushHandler.SubscribeWaitlist")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		h.serve(c, domain.PushSubscribeParams{
+			WaitlistID: id,
+		})
+	}
+}
+
+func (h *PushHandler) serve(c *gin.Context, params domain.PushSubscribeParams) {
+	ctx := c.Request.Context()
+	topics, err := h.PushInteractor.Topics(ctx, params)
+	if err != nil {
+		if domain.IsParamsError(err) {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		c.Error(fmt.Errorf("failed to resolve push topics: %w", err))
+		return
+	}
+
+	channels := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		channels = append(channels, topic.String())
+	}
+	sub, err := h.Hub.Subscribe(ctx, channels, push.LastEventID(c))
+	if err != nil {
+		c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+		return
+	}
+
+	push.ServeSSE(c, sub)
+}
@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/customer/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type ReservationHandler struct {
+	ReservationInteractor domain.ReservationInteractor
+}
+
+func NewReservationHandler(reservationInteractor domain.ReservationInteractor) *ReservationHandler {
+	return &ReservationHandler{
+		ReservationInteractor: reservationInteractor,
+	}
+}
+
+func (h *ReservationHandler) Routes(r gin.IRouter) {
+	r = r.Group("/reservation")
+	r.GET("/stores/:store_id/slots", h.Slots())
+	r.POST("", h.Create())
+	r.GET("", h.List())
+	r.POST("/:id/cancel", h.Cancel())
+}
+
+func (h *ReservationHandler) NoAuths() []string {
+	return []string{"/reservation/stores"}
+}
+
+// Slots
+//
+//	@Tags		预订
+//	@Summary	查询门店可预订时段
+//	@Description	门店需开放线上预订，距到店时间不足截止时间的时段不可预订
+//	@Produce	json
+//	@Param		store_id	path		string						true	"门店ID"
+//	@Param		data		query		types.ReservationSlotReq	true	"请求信息"
+//	@Success	200			{object}	[]domain.ReservationSlot	"成功"
+//	@Router		/reservation/stores/{store_id}/slots [get]
+func (h *ReservationHandler) Slots() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.Slots")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		storeID, err := uuid.Parse(c.Param("store_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.ReservationSlotReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		res, err := h.ReservationInteractor.Slots(ctx, domain.ReservationSlotParams{
+			StoreID:   storeID,
+			Date:      req.Date,
+			PartySize: req.PartySize,
+			Online:    true,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list reservation slots: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Create
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	线上预订
+//	@Description	门店设置了每位定金时，预订的定金状态为待支付，到店后由门店收取
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.ReservationCreateReq	true	"请求信息"
+//	@Success	200		{object}	domain.Reservation			"成功"
+//	@Router		/reservation [post]
+func (h *ReservationHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.Create")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ReservationCreateReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		res, err := h.ReservationInteractor.Create(ctx, domain.ReservationCreateParams{
+			StoreID:        req.StoreID,
+			Customer:       domain.FromCustomerContext(ctx),
+			GuestName:      req.GuestName,
+			GuestPhone:     req.GuestPhone,
+			PartySize:      req.PartySize,
+			ReservedAt:     req.ReservedAt,
+			AreaPreference: req.AreaPreference,
+			Remark:         req.Remark,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to create reservation: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// List
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	我的预订：今天及以后的预订，按到店时间升序
+//	@Produce	json
+//	@Success	200	{object}	[]domain.Reservation	"成功"
+//	@Router		/reservation [get]
+func (h *ReservationHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		res, err := h.ReservationInteractor.ListByCustomer(ctx, domain.FromCustomerContext(ctx).ID)
+		if err != nil {
+			c.Error(fmt.Errorf("failed to list reservations: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Cancel
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	取消我的预订，需在到店时间前取消
+//	@Produce	json
+//	@Param		id	path		string				true	"预订ID"
+//	@Success	200	{object}	domain.Reservation	"成功"
+//	@Router		/reservation/{id}/cancel [post]
+func (h *ReservationHandler) Cancel() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.Cancel")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		res, err := h.ReservationInteractor.CancelByCustomer(ctx, domain.FromCustomerContext(ctx).ID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to cancel reservation: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
@@ -0,0 +1,290 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/customer/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type ScanOrderHandler struct {
+	ScanOrderInteractor domain.ScanOrderInteractor
+}
+
+func NewScanOrderHandler(scanOrderInteractor domain.ScanOrderInteractor) *ScanOrderHandler {
+	return &ScanOrderHandler{
+		ScanOrderInteractor: scanOrderInteractor,
+	}
+}
+
+func (h *ScanOrderHandler) Routes(r gin.IRouter) {
+	r = r.Group("/scan_order/:token")
+	r.GET("", h.GetTable())
+	r.GET("/menu", h.ListMenus())
+	r.GET("/cart", h.GetCart())
+	r.POST("/cart/items", h.AddCartItem())
+	r.PUT("/cart/items/:item_id", h.UpdateCartItem())
+	r.DELETE("/cart", h.ClearCart())
+	r.POST("/submit", h.Submit())
+}
+
+func (h *ScanOrderHandler) NoAuths() []string {
+	return []string{}
+}
+
+// GetTable
+//
+//	@Tags		扫码点餐
+//	@Security	BearerAuth
+//	@Summary	扫码查询桌台信息
+//	@Produce	json
+//	@Param		token	path		string	true	"桌台二维码令牌"
+//	@Success	200		{object}	domain.ScanOrderTable	"成功"
+//	@Router		/scan_order/{token} [get]
+func (h *ScanOrderHandler) GetTable() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ScanOrderHandler.GetTable")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		table, err := h.ScanOrderInteractor.GetTable(ctx, c.Param("token"))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get scan order table: %w", err))
+			return
+		}
+
+		response.Ok(c, table)
+	}
+}
+
+// ListMenus
+//
+//	@Tags		扫码点餐
+//	@Security	BearerAuth
+//	@Summary	查询桌台所在门店的点餐菜单
+//	@Produce	json
+//	@Param		token	path		string	true	"桌台二维码令牌"
+//	@Success	200		{object}	domain.Menus	"成功"
+//	@Router		/scan_order/{token}/menu [get]
+func (h *ScanOrderHandler) ListMenus() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ScanOrderHandler.ListMenus")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		menus, err := h.ScanOrderInteractor.ListMenus(ctx, c.Param("token"))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list scan order menus: %w", err))
+			return
+		}
+
+		response.Ok(c, menus)
+	}
+}
+
+// GetCart
+//
+//	@Tags		扫码点餐
+//	@Security	BearerAuth
+//	@Summary	查询桌台购物车
+//	@Produce	json
+//	@Param		token	path		string	true	"桌台二维码令牌"
+//	@Success	200		{object}	domain.TableCart	"成功"
+//	@Router		/scan_order/{token}/cart [get]
+func (h *ScanOrderHandler) GetCart() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ScanOrderHandler.GetCart")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		cart, err := h.ScanOrderInteractor.GetCart(ctx, c.Param("token"))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get table cart: %w", err))
+			return
+		}
+
+		response.Ok(c, cart)
+	}
+}
+
+// AddCartItem
+//
+//	@Tags		扫码点餐
+//	@Security	BearerAuth
+//	@Summary	加入购物车
+//	@Produce	json
+//	@Param		token	path		string	true	"桌台二维码令牌"
+//	@Param		data	body		types.ScanOrderCartItemAddReq	true	"请求信息"
+//	@Success	200		{object}	domain.TableCart	"成功"
+//	@Router		/scan_order/{token}/cart/items [post]
+func (h *ScanOrderHandler) AddCartItem() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ScanOrderHandler.AddCartItem")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ScanOrderCartItemAddReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		params := domain.ScanOrderCartItemParams{
+			ProductID: req.ProductID,
+			SpecID:    req.SpecID,
+			Attrs:     req.Attrs,
+			Qty:       req.Qty,
+			Remark:    req.Remark,
+		}
+
+		cart, err := h.ScanOrderInteractor.AddCartItem(ctx, c.Param("token"), params, domain.FromCustomerContext(ctx))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to add table cart item: %w", err))
+			return
+		}
+
+		response.Ok(c, cart)
+	}
+}
+
+// UpdateCartItem
+//
+//	@Tags		扫码点餐
+//	@Security	BearerAuth
+//	@Summary	修改购物车商品数量
+//	@Produce	json
+//	@Param		token	path		string	true	"桌台二维码令牌"
+//	@Param		item_id	path		string	true	"购物车商品ID"
+//	@Param		data	body		types.ScanOrderCartItemUpdateReq	true	"请求信息"
+//	@Success	200		{object}	domain.TableCart	"成功"
+//	@Router		/scan_order/{token}/cart/items/{item_id} [put]
+func (h *ScanOrderHandler) UpdateCartItem() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ScanOrderHandler.UpdateCartItem")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		itemID, err := uuid.Parse(c.Param("item_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.ScanOrderCartItemUpdateReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		cart, err := h.ScanOrderInteractor.UpdateCartItem(ctx, c.Param("token"), itemID, req.Qty)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to update table cart item: %w", err))
+			return
+		}
+
+		response.Ok(c, cart)
+	}
+}
+
+// ClearCart
+//
+//	@Tags		扫码点餐
+//	@Security	BearerAuth
+//	@Summary	清空购物车
+//	@Produce	json
+//	@Param		token	path		string	true	"桌台二维码令牌"
+//	@Success	200
+//	@Router		/scan_order/{token}/cart [delete]
+func (h *ScanOrderHandler) ClearCart() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ScanOrderHandler.ClearCart")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		if err := h.ScanOrderInteractor.ClearCart(ctx, c.Param("token")); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to clear table cart: %w", err))
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// Submit
+//
+//	@Tags		扫码点餐
+//	@Security	BearerAuth
+//	@Summary	提交购物车下单
+//	@Produce	json
+//	@Param		token	path		string	true	"桌台二维码令牌"
+//	@Param		data	body		types.ScanOrderSubmitReq	true	"请求信息"
+//	@Success	200		{object}	domain.Order	"成功"
+//	@Router		/scan_order/{token}/submit [post]
+func (h *ScanOrderHandler) Submit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ScanOrderHandler.Submit")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ScanOrderSubmitReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		params := domain.ScanOrderSubmitParams{
+			GuestCount: req.GuestCount,
+			Remark:     req.Remark,
+		}
+
+		order, err := h.ScanOrderInteractor.Submit(ctx, c.Param("token"), params, domain.FromCustomerContext(ctx))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to submit table cart: %w", err))
+			return
+		}
+
+		response.Ok(c, order)
+	}
+}
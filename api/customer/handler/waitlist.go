@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/customer/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type WaitlistHandler struct {
+	WaitlistInteractor domain.WaitlistInteractor
+}
+
+func NewWaitlistHandler(waitlistInteractor domain.WaitlistInteractor) *WaitlistHandler {
+	return &WaitlistHandler{
+		WaitlistInteractor: waitlistInteractor,
+	}
+}
+
+func (h *WaitlistHandler) Routes(r gin.IRouter) {
+	r = r.Group("/waitlist")
+	r.GET("/stores/:store_id", h.Queue())
+	r.GET("/status/:id", h.Status())
+	r.POST("", h.Take())
+	r.GET("", h.List())
+	r.POST("/:id/cancel", h.Cancel())
+}
+
+func (h *WaitlistHandler) NoAuths() []string {
+	return []string{"/waitlist/stores", "/waitlist/status"}
+}
+
+// Queue
+//
+//	@Tags		排队
+//	@Summary	门店排队概况：门口扫码后展示各分组排队桌数和预计等待时间
+//	@Produce	json
+//	@Param		store_id	path		string					true	"门店ID"
+//	@Success	200			{object}	domain.WaitlistQueue	"成功"
+//	@Router		/waitlist/stores/{store_id} [get]
+func (h *WaitlistHandler) Queue() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.Queue")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		storeID, err := uuid.Parse(c.Param("store_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		res, err := h.WaitlistInteractor.Queue(ctx, uuid.Nil, storeID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get waitlist queue: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Status
+//
+//	@Tags		排队
+//	@Summary	排队状态页：前面还有几桌、预计等待时间
+//	@Description	门店取号的小票二维码也指向此页面；订阅 /push/waitlist/{id} 收到变更后重新拉取
+//	@Produce	json
+//	@Param		id	path		string						true	"排队号ID"
+//	@Success	200	{object}	domain.WaitlistTicketView	"成功"
+//	@Router		/waitlist/status/{id} [get]
+func (h *WaitlistHandler) Status() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.Status")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		res, err := h.WaitlistInteractor.GetStatus(ctx, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get waitlist status: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Take
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	扫码取号
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.WaitlistTakeReq	true	"请求信息"
+//	@Success	200		{object}	domain.WaitlistTicketView	"成功"
+//	@Router		/waitlist [post]
+func (h *WaitlistHandler) Take() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.Take")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.WaitlistTakeReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		ticket, err := h.WaitlistInteractor.Take(ctx, domain.WaitlistTakeParams{
+			StoreID:    req.StoreID,
+			Customer:   domain.FromCustomerContext(ctx),
+			PartySize:  req.PartySize,
+			GuestPhone: req.GuestPhone,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to take waitlist ticket: %w", err))
+			return
+		}
+
+		res, err := h.WaitlistInteractor.GetStatus(ctx, ticket.ID)
+		if err != nil {
+			c.Error(fmt.Errorf("failed to get waitlist status: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// List
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	我的排队：当天取的排队号
+//	@Produce	json
+//	@Success	200	{object}	[]domain.WaitlistTicketView	"成功"
+//	@Router		/waitlist [get]
+func (h *WaitlistHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		res, err := h.WaitlistInteractor.ListByCustomer(ctx, domain.FromCustomerContext(ctx).ID)
+		if err != nil {
+			c.Error(fmt.Errorf("failed to list waitlist tickets: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Cancel
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	取消我的排队号
+//	@Produce	json
+//	@Param		id	path		string						true	"排队号ID"
+//	@Success	200	{object}	domain.WaitlistTicketView	"成功"
+//	@Router		/waitlist/{id}/cancel [post]
+func (h *WaitlistHandler) Cancel() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.Cancel")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		res, err := h.WaitlistInteractor.CancelByCustomer(ctx, domain.FromCustomerContext(ctx).ID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to cancel waitlist ticket: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
@@ -1,10 +1,21 @@
 package types
 
 type WXLoginReq struct {
-	Code string `json:"code"`
+	Code string `json:"code" binding:"required"` // wx.login 获取的临时登录凭证
 }
 
 type WXLoginResp struct {
 	Token  string `json:"token"`
 	Expire int64  `json:"expire"`
 }
+
+// CustomerProfileUpdateReq 更新客户资料请求
+type CustomerProfileUpdateReq struct {
+	Nickname string `json:"nickname" binding:"max=50"` // 昵称
+	Avatar   string `json:"avatar" binding:"max=500"`  // 头像
+}
+
+// CustomerBindPhoneReq 绑定手机号请求
+type CustomerBindPhoneReq struct {
+	Code string `json:"code" binding:"required"` // 手机号快速验证组件获取的凭证
+}
@@ -0,0 +1,8 @@
+package types
+
+import "github.com/google/uuid"
+
+// MemberGetReq 查询会员信息请求
+type MemberGetReq struct {
+	MerchantID uuid.UUID `form:"merchant_id" binding:"required"` // 品牌商ID
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// MemberPointsReq 查询会员积分请求
+type MemberPointsReq struct {
+	MerchantID uuid.UUID `form:"merchant_id" binding:"required"` // 品牌商ID
+}
+
+// MemberPointsTransactionListReq 积分流水列表请求
+type MemberPointsTransactionListReq struct {
+	upagination.RequestPagination
+	MemberPointsReq
+	Type domain.MemberPointsTransactionType `form:"type" binding:"omitempty,oneof=earn redeem reverse restore expire adjustment"` // 流水类型（可选）
+}
@@ -0,0 +1,13 @@
+package types
+
+import "gitlab.jiguang.dev/pos-dine/dine/domain"
+
+// ReceiptRequestSubmitReq 提交退款/开票申请请求
+type ReceiptRequestSubmitReq struct {
+	Type         domain.ReceiptRequestType `json:"type" binding:"required,oneof=refund invoice"` // 申请类型
+	Reason       string                    `json:"reason" binding:"omitempty,max=255"`           // 申请原因
+	ContactPhone string                    `json:"contact_phone" binding:"omitempty,max=20"`     // 联系电话
+	InvoiceTitle string                    `json:"invoice_title" binding:"omitempty,max=100"`    // 发票抬头（开票必填）
+	InvoiceTaxNo string                    `json:"invoice_tax_no" binding:"omitempty,max=50"`    // 纳税人识别号
+	InvoiceEmail string                    `json:"invoice_email" binding:"omitempty,email"`      // 接收发票邮箱
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// PreorderSlotReq 可预约时段请求
+type PreorderSlotReq struct {
+	Date string `form:"date" binding:"required,datetime=2006-01-02"` // 日期
+}
+
+// PreorderMenuReq 预点单菜单请求
+type PreorderMenuReq struct {
+	At time.Time `form:"at" time_format:"2006-01-02T15:04:05Z07:00"` // 预约时间，用于按时段取价，为空时取当前时间
+}
+
+// PreorderItem 预点单商品
+type PreorderItem struct {
+	ProductID uuid.UUID              `json:"product_id" binding:"required"`       // 商品ID
+	SpecID    uuid.UUID              `json:"spec_id"`                             // 规格ID（为空时使用默认规格）
+	Attrs     []domain.TableCartAttr `json:"attrs" binding:"omitempty,dive"`      // 口味做法
+	Groups    domain.SetMealGroups   `json:"groups" binding:"omitempty"`          // 套餐分组选择（套餐商品必填）
+	Qty       int                    `json:"qty" binding:"required,min=1,max=99"` // 数量
+	Remark    string                 `json:"remark" binding:"omitempty,max=50"`   // 备注
+}
+
+// PreorderSubmitReq 预点单下单请求
+type PreorderSubmitReq struct {
+	StoreID         uuid.UUID                 `json:"store_id" binding:"required"`                         // 门店ID
+	Fulfilment      domain.PreorderFulfilment `json:"fulfilment" binding:"required,oneof=pickup delivery"` // 取餐方式：到店自取/门店配送
+	ScheduledAt     time.Time                 `json:"scheduled_at" binding:"required"`                     // 预约取餐/送达时间，需为可预约时段
+	ContactName     string                    `json:"contact_name" binding:"max=50"`                       // 联系人，为空时使用昵称
+	ContactPhone    string                    `json:"contact_phone" binding:"max=20"`                      // 联系电话，为空时使用已绑定的手机号
+	DeliveryAddress string                    `json:"delivery_address" binding:"max=255"`                  // 配送地址，门店配送时必填
+	Items           []PreorderItem            `json:"items" binding:"required,min=1,max=50,dive"`          // 商品列表
+	Remark          string                    `json:"remark" binding:"omitempty,max=100"`                  // 整单备注
+}
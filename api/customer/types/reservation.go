@@ -0,0 +1,24 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReservationSlotReq 可预订时段请求
+type ReservationSlotReq struct {
+	Date      string `form:"date" binding:"required,datetime=2006-01-02"` // 日期
+	PartySize int    `form:"party_size" binding:"required,min=1"`         // 用餐人数
+}
+
+// ReservationCreateReq 线上预订请求
+type ReservationCreateReq struct {
+	StoreID        uuid.UUID `json:"store_id" binding:"required"`         // 门店ID
+	GuestName      string    `json:"guest_name" binding:"max=50"`         // 预订人姓名，为空时使用昵称
+	GuestPhone     string    `json:"guest_phone" binding:"max=20"`        // 预订人手机号，为空时使用已绑定的手机号
+	PartySize      int       `json:"party_size" binding:"required,min=1"` // 用餐人数
+	ReservedAt     time.Time `json:"reserved_at" binding:"required"`      // 到店时间，需为可预订时段
+	AreaPreference string    `json:"area_preference" binding:"max=50"`    // 区域偏好（可选）
+	Remark         string    `json:"remark" binding:"max=255"`            // 备注（可选）
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// ScanOrderCartItemAddReq 加入购物车请求
+type ScanOrderCartItemAddReq struct {
+	ProductID uuid.UUID              `json:"product_id" binding:"required"`       // 商品ID
+	SpecID    uuid.UUID              `json:"spec_id"`                             // 规格ID（为空时使用默认规格）
+	Attrs     []domain.TableCartAttr `json:"attrs" binding:"omitempty,dive"`      // 口味做法
+	Qty       int                    `json:"qty" binding:"required,min=1,max=99"` // 数量
+	Remark    string                 `json:"remark" binding:"omitempty,max=50"`   // 备注
+}
+
+// ScanOrderCartItemUpdateReq 修改购物车商品数量请求
+type ScanOrderCartItemUpdateReq struct {
+	Qty int `json:"qty" binding:"min=0,max=99"` // 数量，为 0 时移除
+}
+
+// ScanOrderSubmitReq 提交购物车请求
+type ScanOrderSubmitReq struct {
+	GuestCount int    `json:"guest_count" binding:"omitempty,min=0"` // 就餐人数
+	Remark     string `json:"remark" binding:"omitempty,max=100"`    // 整单备注
+}
@@ -0,0 +1,10 @@
+package types
+
+import "github.com/google/uuid"
+
+// WaitlistTakeReq 扫码取号请求
+type WaitlistTakeReq struct {
+	StoreID    uuid.UUID `json:"store_id" binding:"required"`         // 门店ID
+	PartySize  int       `json:"party_size" binding:"required,min=1"` // 用餐人数
+	GuestPhone string    `json:"guest_phone" binding:"max=20"`        // 手机号，为空时使用已绑定的手机号
+}
@@ -24,9 +24,12 @@ var Module = fx.Module(
 	fx.Provide(
 		asMiddleware(middleware.NewRecovery),
 		asMiddleware(middleware.NewErrorHandling),
-		asMiddleware(func(c httpserver.Config) *middleware.TimeLimiter { return middleware.NewTimeLimiter(c.RequestTimeout) }),
+		asMiddleware(func(c httpserver.Config) *middleware.TimeLimiter {
+			return middleware.NewTimeLimiter(c.RequestTimeout, pushSkipper)
+		}),
 		asMiddleware(middleware.NewPopulateRequestID),
 		asMiddleware(middleware.NewPopulateLogger),
+		asMiddleware(middleware.NewLocale),
 		fx.Annotate(
 			middleware.NewObservability,
 			fx.As(new(ugin.Middleware)),
@@ -34,6 +37,7 @@ var Module = fx.Module(
 		),
 		asMiddleware(middleware.NewLogger),
 		asMiddleware(frontendmiddleware.NewAuth),
+		asMiddleware(frontendmiddleware.NewKioskAuth),
 	),
 	// handler
 	fx.Provide(
@@ -47,9 +51,26 @@ var Module = fx.Module(
 		asHandler(handler.NewStallHandler),
 		asHandler(handler.NewStoreHandler),
 		asHandler(handler.NewUserHandler),
+		asHandler(handler.NewPromotionHandler),
+		asHandler(handler.NewCouponHandler),
+		asHandler(handler.NewMemberHandler),
+		asHandler(handler.NewMemberAccountHandler),
+		asHandler(handler.NewMemberPointsHandler),
+		asHandler(handler.NewKitchenTicketHandler),
+		asHandler(handler.NewPickupHandler),
+		asHandler(handler.NewPushHandler),
+		asHandler(handler.NewOrderReceiptHandler),
+		asHandler(handler.NewKioskHandler),
+		asHandler(handler.NewDeliveryHandler),
+		asHandler(handler.NewReservationHandler),
+		asHandler(handler.NewWaitlistHandler),
+		asHandler(handler.NewPreorderHandler),
 	),
 )
 
+// pushSkipper 实时推送为长连接，不受请求超时限制
+var pushSkipper = middleware.AllowPathPrefixSkipper(frontend.ApiPrefixV1 + "/push")
+
 func asHandler(f any) any {
 	return fx.Annotate(
 		f,
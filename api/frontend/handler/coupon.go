@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type CouponHandler struct {
+	CouponInteractor domain.CouponInteractor
+}
+
+func NewCouponHandler(couponInteractor domain.CouponInteractor) *CouponHandler {
+	return &CouponHandler{
+		CouponInteractor: couponInteractor,
+	}
+}
+
+func (h *CouponHandler) Routes(r gin.IRouter) {
+	r = r.Group("/coupon")
+	r.POST("/verify", h.Verify())
+	r.POST("/redeem", h.Redeem())
+	r.POST("/partner/verify", h.VerifyPartner())
+	r.POST("/partner/redeem", h.RedeemPartner())
+	r.GET("/order/:order_id", h.ListByOrder())
+}
+
+func (h *CouponHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Verify
+//
+//	@Tags		优惠券
+//	@Security	BearerAuth
+//	@Summary	校验优惠券并试算优惠金额
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.CouponRedeemReq	true	"请求信息"
+//	@Success	200		{object}	domain.CouponRedeemRes	"成功"
+//	@Router		/coupon/verify [post]
+func (h *CouponHandler) Verify() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.Verify")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.CouponRedeemReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.CouponInteractor.Verify(ctx, toCouponRedeemParams(user.MerchantID, req))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to verify coupon: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Redeem
+//
+//	@Tags		优惠券
+//	@Security	BearerAuth
+//	@Summary	核销优惠券
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.CouponRedeemReq	true	"请求信息"
+//	@Success	200		{object}	domain.CouponRedeemRes	"成功"
+//	@Router		/coupon/redeem [post]
+func (h *CouponHandler) Redeem() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.Redeem")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.CouponRedeemReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.CouponInteractor.Redeem(ctx, toCouponRedeemParams(user.MerchantID, req))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to redeem coupon: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// VerifyPartner
+//
+//	@Tags		优惠券
+//	@Security	BearerAuth
+//	@Summary	校验三方券
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.PartnerCouponVerifyReq	true	"请求信息"
+//	@Success	200		{object}	domain.PartnerCoupon			"成功"
+//	@Router		/coupon/partner/verify [post]
+func (h *CouponHandler) VerifyPartner() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.VerifyPartner")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PartnerCouponVerifyReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.CouponInteractor.VerifyPartner(ctx, domain.PartnerCouponRedeemParams{
+			MerchantID: user.MerchantID,
+			StoreID:    req.StoreID,
+			Provider:   req.Provider,
+			Code:       req.Code,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to verify partner coupon: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// RedeemPartner
+//
+//	@Tags		优惠券
+//	@Security	BearerAuth
+//	@Summary	核销三方券
+//	@Description	三方平台核销失败时券码标记为核销失败，可重新核销；核销中的券码不能重复提交
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.PartnerCouponRedeemReq	true	"请求信息"
+//	@Success	200		{object}	domain.Coupon					"成功"
+//	@Router		/coupon/partner/redeem [post]
+func (h *CouponHandler) RedeemPartner() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.RedeemPartner")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PartnerCouponRedeemReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.CouponInteractor.RedeemPartner(ctx, domain.PartnerCouponRedeemParams{
+			MerchantID: user.MerchantID,
+			StoreID:    req.StoreID,
+			OrderID:    req.OrderID,
+			Provider:   req.Provider,
+			Code:       req.Code,
+			Operator: domain.OrderOperator{
+				ID:   req.OperatorID,
+				Name: req.OperatorName,
+			},
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to redeem partner coupon: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// ListByOrder
+//
+//	@Tags		优惠券
+//	@Security	BearerAuth
+//	@Summary	查询订单使用的优惠券
+//	@Produce	json
+//	@Param		order_id	path		string			true	"订单ID"
+//	@Success	200			{object}	domain.Coupons	"成功"
+//	@Router		/coupon/order/{order_id} [get]
+func (h *CouponHandler) ListByOrder() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.ListByOrder")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		orderID, err := uuid.Parse(c.Param("order_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		res, err := h.CouponInteractor.ListByOrderID(ctx, orderID)
+		if err != nil {
+			c.Error(fmt.Errorf("failed to list order coupons: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+func toCouponRedeemParams(merchantID uuid.UUID, req types.CouponRedeemReq) domain.CouponRedeemParams {
+	return domain.CouponRedeemParams{
+		MerchantID: merchantID,
+		StoreID:    req.StoreID,
+		OrderID:    req.OrderID,
+		Code:       req.Code,
+		Operator: domain.OrderOperator{
+			ID:   req.OperatorID,
+			Name: req.OperatorName,
+		},
+	}
+}
@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type DeliveryHandler struct {
+	DeliveryOrderInteractor domain.DeliveryOrderInteractor
+}
+
+func NewDeliveryHandler(deliveryOrderInteractor domain.DeliveryOrderInteractor) *DeliveryHandler {
+	return &DeliveryHandler{
+		DeliveryOrderInteractor: deliveryOrderInteractor,
+	}
+}
+
+func (h *DeliveryHandler) Routes(r gin.IRouter) {
+	r = r.Group("/delivery/orders")
+	r.GET("", h.List())
+	r.POST("/:id/accept", h.Accept())
+	r.POST("/:id/reject", h.Reject())
+	r.POST("/:id/ready", h.MarkReady())
+}
+
+func (h *DeliveryHandler) NoAuths() []string {
+	return []string{}
+}
+
+// List
+//
+//	@Tags		三方外卖
+//	@Security	BearerAuth
+//	@Summary	外卖订单列表
+//	@Description	门店订阅实时推送（delivery_order.created、delivery_order.updated 事件）后重新拉取
+//	@Produce	json
+//	@Param		data	query		types.DeliveryOrderListReq	true	"请求信息"
+//	@Success	200		{object}	[]domain.DeliveryOrder		"成功"
+//	@Router		/delivery/orders [get]
+func (h *DeliveryHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DeliveryHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.DeliveryOrderListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		params := domain.DeliveryOrderListParams{
+			StoreID:  req.StoreID,
+			Statuses: req.Statuses,
+		}
+		if req.Date != "" {
+			params.PlacedFrom, _ = time.ParseInLocation(time.DateOnly, req.Date, time.Local)
+		}
+		user := domain.FromFrontendContext(ctx)
+		orders, err := h.DeliveryOrderInteractor.List(ctx, user.MerchantID, params)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list delivery orders: %w", err))
+			return
+		}
+
+		response.Ok(c, orders)
+	}
+}
+
+// Accept
+//
+//	@Tags		三方外卖
+//	@Security	BearerAuth
+//	@Summary	接单：通知平台并生成门店订单送厨
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string						true	"外卖订单ID"
+//	@Param		data	body		types.DeliveryOrderActionReq	true	"请求信息"
+//	@Success	200		{object}	domain.DeliveryOrder		"成功"
+//	@Router		/delivery/orders/{id}/accept [post]
+func (h *DeliveryHandler) Accept() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DeliveryHandler.Accept")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.DeliveryOrderActionReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		order, err := h.DeliveryOrderInteractor.Accept(ctx, user.MerchantID, req.StoreID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to accept delivery order: %w", err))
+			return
+		}
+
+		response.Ok(c, order)
+	}
+}
+
+// Reject
+//
+//	@Tags		三方外卖
+//	@Security	BearerAuth
+//	@Summary	拒单并通知平台
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string						true	"外卖订单ID"
+//	@Param		data	body		types.DeliveryOrderRejectReq	true	"请求信息"
+//	@Success	200		{object}	domain.DeliveryOrder		"成功"
+//	@Router		/delivery/orders/{id}/reject [post]
+func (h *DeliveryHandler) Reject() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DeliveryHandler.Reject")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.DeliveryOrderRejectReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		order, err := h.DeliveryOrderInteractor.Reject(ctx, user.MerchantID, req.StoreID, id, req.Reason)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to reject delivery order: %w", err))
+			return
+		}
+
+		response.Ok(c, order)
+	}
+}
+
+// MarkReady
+//
+//	@Tags		三方外卖
+//	@Security	BearerAuth
+//	@Summary	出餐并通知平台
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string						true	"外卖订单ID"
+//	@Param		data	body		types.DeliveryOrderActionReq	true	"请求信息"
+//	@Success	200		{object}	domain.DeliveryOrder		"成功"
+//	@Router		/delivery/orders/{id}/ready [post]
+func (h *DeliveryHandler) MarkReady() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DeliveryHandler.MarkReady")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.DeliveryOrderActionReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		order, err := h.DeliveryOrderInteractor.MarkReady(ctx, user.MerchantID, req.StoreID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to mark delivery order ready: %w", err))
+			return
+		}
+
+		response.Ok(c, order)
+	}
+}
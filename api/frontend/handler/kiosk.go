@@ -0,0 +1,267 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+// KioskHandler 自助点餐机接口，设备通过访问令牌认证
+type KioskHandler struct {
+	KioskInteractor domain.KioskInteractor
+}
+
+func NewKioskHandler(kioskInteractor domain.KioskInteractor) *KioskHandler {
+	return &KioskHandler{
+		KioskInteractor: kioskInteractor,
+	}
+}
+
+func (h *KioskHandler) Routes(r gin.IRouter) {
+	r = r.Group("/kiosk")
+	r.GET("", h.GetInfo())
+	r.GET("/menu", h.ListMenus())
+	r.GET("/upsell", h.ListUpsells())
+	r.POST("/order", h.Submit())
+	r.POST("/order/:id/pay", h.Pay())
+	r.GET("/order/:id/payment", h.QueryPayment())
+}
+
+func (h *KioskHandler) NoAuths() []string {
+	return []string{}
+}
+
+// GetInfo
+//
+//	@Tags		自助点餐
+//	@Security	BearerAuth
+//	@Summary	查询自助点餐机信息
+//	@Produce	json
+//	@Success	200	{object}	domain.KioskInfo	"成功"
+//	@Router		/kiosk [get]
+func (h *KioskHandler) GetInfo() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("KioskHandler.GetInfo")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		info, err := h.KioskInteractor.GetInfo(ctx, domain.FromKioskContext(ctx))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get kiosk info: %w", err))
+			return
+		}
+
+		response.Ok(c, info)
+	}
+}
+
+// ListMenus
+//
+//	@Tags		自助点餐
+//	@Security	BearerAuth
+//	@Summary	查询门店可自助点餐的菜单
+//	@Produce	json
+//	@Success	200	{object}	domain.Menus	"成功"
+//	@Router		/kiosk/menu [get]
+func (h *KioskHandler) ListMenus() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("KioskHandler.ListMenus")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		menus, err := h.KioskInteractor.ListMenus(ctx, domain.FromKioskContext(ctx))
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list kiosk menus: %w", err))
+			return
+		}
+
+		response.Ok(c, menus)
+	}
+}
+
+// ListUpsells
+//
+//	@Tags		自助点餐
+//	@Security	BearerAuth
+//	@Summary	根据已选商品推荐套餐
+//	@Produce	json
+//	@Param		data	query		types.KioskUpsellReq	true	"请求信息"
+//	@Success	200		{object}	[]domain.KioskUpsell	"成功"
+//	@Router		/kiosk/upsell [get]
+func (h *KioskHandler) ListUpsells() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("KioskHandler.ListUpsells")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.KioskUpsellReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		upsells, err := h.KioskInteractor.ListUpsells(ctx, domain.FromKioskContext(ctx), req.ProductIDs)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list kiosk upsells: %w", err))
+			return
+		}
+
+		response.Ok(c, upsells)
+	}
+}
+
+// Submit
+//
+//	@Tags		自助点餐
+//	@Security	BearerAuth
+//	@Summary	自助点餐下单
+//	@Description	下单后分配取餐号；机上支付需继续调用支付接口，前台付款凭取餐号到前台付款，支付后送厨
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.KioskSubmitReq	true	"请求信息"
+//	@Success	200		{object}	domain.Order			"成功"
+//	@Router		/kiosk/order [post]
+func (h *KioskHandler) Submit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("KioskHandler.Submit")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.KioskSubmitReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		params := domain.KioskOrderParams{
+			Items:   make([]domain.KioskOrderItemParams, 0, len(req.Items)),
+			PayMode: req.PayMode,
+			Remark:  req.Remark,
+		}
+		for _, item := range req.Items {
+			params.Items = append(params.Items, domain.KioskOrderItemParams{
+				ProductID: item.ProductID,
+				SpecID:    item.SpecID,
+				Attrs:     item.Attrs,
+				Groups:    item.Groups,
+				Qty:       item.Qty,
+				Remark:    item.Remark,
+			})
+		}
+		order, err := h.KioskInteractor.Submit(ctx, domain.FromKioskContext(ctx), params)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to submit kiosk order: %w", err))
+			return
+		}
+
+		response.Ok(c, order)
+	}
+}
+
+// Pay
+//
+//	@Tags		自助点餐
+//	@Security	BearerAuth
+//	@Summary	扫描顾客付款码支付
+//	@Description	订单支付状态为 PAYING 时表示等待顾客确认，需轮询查询支付结果
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string				true	"订单ID"
+//	@Param		data	body		types.KioskPayReq	true	"请求信息"
+//	@Success	200		{object}	domain.Order		"成功"
+//	@Router		/kiosk/order/{id}/pay [post]
+func (h *KioskHandler) Pay() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("KioskHandler.Pay")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.KioskPayReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		order, err := h.KioskInteractor.Pay(ctx, domain.FromKioskContext(ctx), id, req.AuthCode)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to pay kiosk order: %w", err))
+			return
+		}
+
+		response.Ok(c, order)
+	}
+}
+
+// QueryPayment
+//
+//	@Tags		自助点餐
+//	@Security	BearerAuth
+//	@Summary	查询订单支付结果
+//	@Produce	json
+//	@Param		id	path		string			true	"订单ID"
+//	@Success	200	{object}	domain.Order	"成功"
+//	@Router		/kiosk/order/{id}/payment [get]
+func (h *KioskHandler) QueryPayment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("KioskHandler.QueryPayment")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		order, err := h.KioskInteractor.QueryPayment(ctx, domain.FromKioskContext(ctx), id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to query kiosk payment: %w", err))
+			return
+		}
+
+		response.Ok(c, order)
+	}
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type KitchenTicketHandler struct {
+	KitchenTicketInteractor domain.KitchenTicketInteractor
+}
+
+func NewKitchenTicketHandler(kitchenTicketInteractor domain.KitchenTicketInteractor) *KitchenTicketHandler {
+	return &KitchenTicketHandler{
+		KitchenTicketInteractor: kitchenTicketInteractor,
+	}
+}
+
+func (h *KitchenTicketHandler) Routes(r gin.IRouter) {
+	r = r.Group("/kitchen_ticket")
+	r.GET("", h.ListPending())
+	r.POST("/printed", h.MarkPrinted())
+}
+
+func (h *KitchenTicketHandler) NoAuths() []string {
+	return []string{}
+}
+
+// ListPending
+//
+//	@Tags		厨房单
+//	@Security	BearerAuth
+//	@Summary	查询门店待出单的厨房单
+//	@Produce	json
+//	@Param		data	query		types.KitchenTicketListReq	true	"请求信息"
+//	@Success	200		{object}	[]domain.KitchenTicket		"成功"
+//	@Router		/kitchen_ticket [get]
+func (h *KitchenTicketHandler) ListPending() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("KitchenTicketHandler.ListPending")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.KitchenTicketListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		tickets, err := h.KitchenTicketInteractor.ListPending(ctx, user.MerchantID, req.StoreID, req.DeviceID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list pending kitchen tickets: %w", err))
+			return
+		}
+
+		response.Ok(c, tickets)
+	}
+}
+
+// MarkPrinted
+//
+//	@Tags		厨房单
+//	@Security	BearerAuth
+//	@Summary	标记厨房单已出单
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.KitchenTicketPrintedReq	true	"请求信息"
+//	@Success	200		{object}	types.KitchenTicketPrintedResp	"成功"
+//	@Router		/kitchen_ticket/printed [post]
+func (h *KitchenTicketHandler) MarkPrinted() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("KitchenTicketHandler.MarkPrinted")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.KitchenTicketPrintedReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		count, err := h.KitchenTicketInteractor.MarkPrinted(ctx, user.MerchantID, req.StoreID, req.IDs)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to mark kitchen tickets printed: %w", err))
+			return
+		}
+
+		response.Ok(c, &types.KitchenTicketPrintedResp{Count: count})
+	}
+}
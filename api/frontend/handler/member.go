@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type MemberHandler struct {
+	MemberInteractor domain.MemberInteractor
+}
+
+func NewMemberHandler(memberInteractor domain.MemberInteractor) *MemberHandler {
+	return &MemberHandler{
+		MemberInteractor: memberInteractor,
+	}
+}
+
+func (h *MemberHandler) Routes(r gin.IRouter) {
+	r = r.Group("/member")
+	r.GET("/lookup", h.Lookup())
+	r.POST("/attach", h.Attach())
+}
+
+func (h *MemberHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Lookup
+//
+//	@Tags		会员
+//	@Security	BearerAuth
+//	@Summary	按手机号或会员码查找会员
+//	@Produce	json
+//	@Param		data	query		types.MemberLookupReq	true	"请求信息"
+//	@Success	200		{object}	domain.Member			"成功"
+//	@Router		/member/lookup [get]
+func (h *MemberHandler) Lookup() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.Lookup")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberLookupReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.MemberInteractor.Lookup(ctx, domain.MemberLookupParams{
+			MerchantID: user.MerchantID,
+			Phone:      req.Phone,
+			Code:       req.Code,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to lookup member: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Attach
+//
+//	@Tags		会员
+//	@Security	BearerAuth
+//	@Summary	订单关联会员，按会员价重新计算优惠
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.MemberAttachReq	true	"请求信息"
+//	@Success	200		{object}	domain.Order			"成功"
+//	@Router		/member/attach [post]
+func (h *MemberHandler) Attach() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberHandler.Attach")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberAttachReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.MemberInteractor.AttachToOrder(ctx, domain.MemberAttachParams{
+			MerchantID: user.MerchantID,
+			StoreID:    req.StoreID,
+			OrderID:    req.OrderID,
+			MemberID:   req.MemberID,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to attach member to order: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
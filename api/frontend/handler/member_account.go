@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type MemberAccountHandler struct {
+	MemberAccountInteractor domain.MemberAccountInteractor
+}
+
+func NewMemberAccountHandler(memberAccountInteractor domain.MemberAccountInteractor) *MemberAccountHandler {
+	return &MemberAccountHandler{
+		MemberAccountInteractor: memberAccountInteractor,
+	}
+}
+
+func (h *MemberAccountHandler) Routes(r gin.IRouter) {
+	r = r.Group("/member_account")
+	r.GET("/:member_id", h.Get())
+	r.POST("/top_up", h.TopUp())
+	r.POST("/pay", h.Pay())
+}
+
+func (h *MemberAccountHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Get
+//
+//	@Tags		会员储值
+//	@Security	BearerAuth
+//	@Summary	查询会员储值余额
+//	@Produce	json
+//	@Param		member_id	path		string					true	"会员ID"
+//	@Success	200			{object}	domain.MemberAccount	"成功"
+//	@Router		/member_account/{member_id} [get]
+func (h *MemberAccountHandler) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberAccountHandler.Get")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		memberID, err := uuid.Parse(c.Param("member_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.MemberAccountInteractor.GetByMemberID(ctx, user.MerchantID, memberID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get member account: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// TopUp
+//
+//	@Tags		会员储值
+//	@Security	BearerAuth
+//	@Summary	储值充值
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.MemberAccountTopUpReq		true	"请求信息"
+//	@Success	200		{object}	domain.MemberAccountTransaction	"成功"
+//	@Router		/member_account/top_up [post]
+func (h *MemberAccountHandler) TopUp() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberAccountHandler.TopUp")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberAccountTopUpReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.MemberAccountInteractor.TopUp(ctx, domain.MemberAccountTopUpParams{
+			MerchantID:    user.MerchantID,
+			StoreID:       req.StoreID,
+			MemberID:      req.MemberID,
+			Amount:        req.Amount,
+			BonusAmount:   req.BonusAmount,
+			PaymentMethod: req.PaymentMethod,
+			Operator: domain.OrderOperator{
+				ID:   req.OperatorID,
+				Name: req.OperatorName,
+			},
+			Remark: req.Remark,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to top up member account: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Pay
+//
+//	@Tags		会员储值
+//	@Security	BearerAuth
+//	@Summary	储值余额支付订单
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.MemberAccountPayReq	true	"请求信息"
+//	@Success	200		{object}	domain.Order				"成功"
+//	@Router		/member_account/pay [post]
+func (h *MemberAccountHandler) Pay() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberAccountHandler.Pay")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberAccountPayReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.MemberAccountInteractor.PayOrder(ctx, domain.MemberAccountPayParams{
+			MerchantID: user.MerchantID,
+			StoreID:    req.StoreID,
+			OrderID:    req.OrderID,
+			MemberID:   req.MemberID,
+			Amount:     req.Amount,
+			Operator: domain.OrderOperator{
+				ID:   req.Cashier.CashierID,
+				Name: req.Cashier.CashierName,
+			},
+			POS:     req.POS,
+			Cashier: req.Cashier,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to pay order by member account: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
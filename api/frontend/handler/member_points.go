@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type MemberPointsHandler struct {
+	MemberPointsInteractor domain.MemberPointsInteractor
+}
+
+func NewMemberPointsHandler(memberPointsInteractor domain.MemberPointsInteractor) *MemberPointsHandler {
+	return &MemberPointsHandler{
+		MemberPointsInteractor: memberPointsInteractor,
+	}
+}
+
+func (h *MemberPointsHandler) Routes(r gin.IRouter) {
+	r = r.Group("/member_points")
+	r.GET("/:member_id", h.Get())
+	r.POST("/redeem", h.Redeem())
+}
+
+func (h *MemberPointsHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Get
+//
+//	@Tags		会员积分
+//	@Security	BearerAuth
+//	@Summary	查询会员积分余额
+//	@Produce	json
+//	@Param		member_id	path		string						true	"会员ID"
+//	@Success	200			{object}	domain.MemberPointsAccount	"成功"
+//	@Router		/member_points/{member_id} [get]
+func (h *MemberPointsHandler) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberPointsHandler.Get")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		memberID, err := uuid.Parse(c.Param("member_id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.MemberPointsInteractor.GetByMemberID(ctx, user.MerchantID, memberID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get member points account: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Redeem
+//
+//	@Tags		会员积分
+//	@Security	BearerAuth
+//	@Summary	使用积分抵扣订单金额或兑换商品
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.MemberPointsRedeemReq		true	"请求信息"
+//	@Success	200		{object}	domain.MemberPointsTransaction	"成功"
+//	@Router		/member_points/redeem [post]
+func (h *MemberPointsHandler) Redeem() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MemberPointsHandler.Redeem")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.MemberPointsRedeemReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.MemberPointsInteractor.Redeem(ctx, domain.MemberPointsRedeemParams{
+			MerchantID: user.MerchantID,
+			StoreID:    req.StoreID,
+			OrderID:    req.OrderID,
+			Type:       req.Type,
+			Points:     req.Points,
+			ProductID:  req.ProductID,
+			Operator: domain.OrderOperator{
+				ID:   req.OperatorID,
+				Name: req.OperatorName,
+			},
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to redeem member points: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
@@ -3,12 +3,15 @@ package handler
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/i18n"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
 )
@@ -38,6 +41,9 @@ func (h *MenuHandler) NoAuths() []string {
 //	@Security	BearerAuth
 //	@Summary	查询所有菜单
 //	@Param		store_id	query		string					false	"门店ID"
+//	@Param		at			query		string					false	"计算生效价格的时间（RFC3339，默认当前时间）"
+//	@Param		keyword		query		string					false	"助记搜索关键字（匹配助记词、拼音首字母、拼音全拼和名称，只返回匹配的菜单项）"
+//	@Param		data		query		types.MenuDietaryFilterReq	false	"过敏原与饮食属性筛选（只返回满足条件的菜单项）"
 //	@Success	200			{object}	domain.MenuSearchRes	"成功"
 //	@Router		/menu [get]
 func (h *MenuHandler) ListAll() gin.HandlerFunc {
@@ -58,10 +64,31 @@ func (h *MenuHandler) ListAll() gin.HandlerFunc {
 			return
 		}
 
+		var dietaryReq types.MenuDietaryFilterReq
+		if err := c.ShouldBindQuery(&dietaryReq); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
 		user := domain.FromFrontendContext(ctx)
 		params := domain.MenuListAllParams{
 			MerchantID: user.MerchantID,
 			StoreID:    storeID,
+			Keyword:    c.Query("keyword"),
+			Dietary: domain.ProductDietaryFilter{
+				ExcludeAllergens: dietaryReq.ExcludeAllergens,
+				Halal:            dietaryReq.Halal,
+				Vegetarian:       dietaryReq.Vegetarian,
+				Vegan:            dietaryReq.Vegan,
+				MaxSpicyLevel:    dietaryReq.MaxSpicyLevel,
+			},
+		}
+		if atStr := c.Query("at"); atStr != "" {
+			params.At, err = time.Parse(time.RFC3339, atStr)
+			if err != nil {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, fmt.Errorf("invalid at: %w", err)))
+				return
+			}
 		}
 
 		res, err := h.MenuInteractor.ListAllStoreMenus(ctx, params)
@@ -75,6 +102,7 @@ func (h *MenuHandler) ListAll() gin.HandlerFunc {
 			return
 		}
 
+		res.Localize(i18n.LocaleFromContext(ctx))
 		response.Ok(c, res)
 	}
 }
@@ -98,6 +98,9 @@ func (h *OrderHandler) Create() gin.HandlerFunc {
 		if req.PaymentStatus != "" {
 			o.PaymentStatus = domain.PaymentStatus(req.PaymentStatus)
 		}
+		if req.MemberID != uuid.Nil {
+			o.Member = &domain.OrderMember{ID: req.MemberID}
+		}
 		if req.Channel != "" {
 			o.Channel = domain.Channel(req.Channel)
 		}
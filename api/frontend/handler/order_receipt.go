@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type OrderReceiptHandler struct {
+	OrderReceiptInteractor domain.OrderReceiptInteractor
+}
+
+func NewOrderReceiptHandler(orderReceiptInteractor domain.OrderReceiptInteractor) *OrderReceiptHandler {
+	return &OrderReceiptHandler{
+		OrderReceiptInteractor: orderReceiptInteractor,
+	}
+}
+
+func (h *OrderReceiptHandler) Routes(r gin.IRouter) {
+	r = r.Group("/order_receipt")
+	r.POST("/link", h.CreateLink())
+	r.GET("/print", h.GetPrint())
+}
+
+func (h *OrderReceiptHandler) NoAuths() []string {
+	return []string{}
+}
+
+// CreateLink
+//
+//	@Tags		电子小票
+//	@Security	BearerAuth
+//	@Summary	生成订单电子小票链接
+//	@Description	返回的 url 为二维码的文本内容，服务端不生成二维码图片：客户端需自行将 url 编码为二维码，或改用打印小票接口返回的 qr_code 由打印机原生二维码指令（如 ESC/POS GS ( k）打印。顾客扫码后可查看出品进度并申请退款/开票
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.OrderReceiptLinkReq	true	"请求信息"
+//	@Success	200		{object}	domain.ReceiptLink			"成功"
+//	@Router		/order_receipt/link [post]
+func (h *OrderReceiptHandler) CreateLink() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("OrderReceiptHandler.CreateLink")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.OrderReceiptLinkReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		link, err := h.OrderReceiptInteractor.CreateLink(ctx, user.MerchantID, req.OrderID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to create receipt link: %w", err))
+			return
+		}
+
+		response.Ok(c, link)
+	}
+}
+
+// GetPrint
+//
+//	@Tags		电子小票
+//	@Security	BearerAuth
+//	@Summary	查询打印小票内容
+//	@Description	传入打印机设备ID时，商品名称按该打印机的打印语言输出。qr_code 为电子小票链接，可直接交给打印机原生二维码指令（如 ESC/POS GS ( k）打印，无需客户端生成二维码图片
+//	@Produce	json
+//	@Param		data	query		types.OrderReceiptPrintReq	true	"请求信息"
+//	@Success	200		{object}	domain.OrderReceipt			"成功"
+//	@Router		/order_receipt/print [get]
+func (h *OrderReceiptHandler) GetPrint() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("OrderReceiptHandler.GetPrint")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.OrderReceiptPrintReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		receipt, err := h.OrderReceiptInteractor.GetPrint(ctx, user.MerchantID, req.OrderID, req.DeviceID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get print receipt: %w", err))
+			return
+		}
+
+		response.Ok(c, receipt)
+	}
+}
@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type PickupHandler struct {
+	PickupCallInteractor domain.PickupCallInteractor
+}
+
+func NewPickupHandler(pickupCallInteractor domain.PickupCallInteractor) *PickupHandler {
+	return &PickupHandler{
+		PickupCallInteractor: pickupCallInteractor,
+	}
+}
+
+func (h *PickupHandler) Routes(r gin.IRouter) {
+	r = r.Group("/pickup")
+	r.POST("", h.Assign())
+	r.POST("/:id/call", h.Call())
+	r.POST("/:id/picked_up", h.PickUp())
+	r.GET("/display", h.Display())
+}
+
+func (h *PickupHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Assign
+//
+//	@Tags		取餐叫号
+//	@Security	BearerAuth
+//	@Summary	为订单分配取餐号，已分配时返回原取餐号
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.PickupAssignReq	true	"请求信息"
+//	@Success	200		{object}	domain.PickupCall		"成功"
+//	@Router		/pickup [post]
+func (h *PickupHandler) Assign() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PickupHandler.Assign")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PickupAssignReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		call, err := h.PickupCallInteractor.Assign(ctx, user.MerchantID, req.StoreID, req.OrderID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to assign pickup number: %w", err))
+			return
+		}
+
+		response.Ok(c, call)
+	}
+}
+
+// Call
+//
+//	@Tags		取餐叫号
+//	@Security	BearerAuth
+//	@Summary	出餐叫号，已叫号的可重复叫号
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string				true	"叫号记录ID"
+//	@Param		data	body		types.PickupCallReq	true	"请求信息"
+//	@Success	200		{object}	domain.PickupCall	"成功"
+//	@Router		/pickup/{id}/call [post]
+func (h *PickupHandler) Call() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PickupHandler.Call")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.PickupCallReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		call, err := h.PickupCallInteractor.Call(ctx, user.MerchantID, req.StoreID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to call pickup number: %w", err))
+			return
+		}
+
+		response.Ok(c, call)
+	}
+}
+
+// PickUp
+//
+//	@Tags		取餐叫号
+//	@Security	BearerAuth
+//	@Summary	确认顾客已取餐
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string				true	"叫号记录ID"
+//	@Param		data	body		types.PickupCallReq	true	"请求信息"
+//	@Success	200		{object}	domain.PickupCall	"成功"
+//	@Router		/pickup/{id}/picked_up [post]
+func (h *PickupHandler) PickUp() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PickupHandler.PickUp")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.PickupCallReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		call, err := h.PickupCallInteractor.PickUp(ctx, user.MerchantID, req.StoreID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to mark pickup number picked up: %w", err))
+			return
+		}
+
+		response.Ok(c, call)
+	}
+}
+
+// Display
+//
+//	@Tags		取餐叫号
+//	@Security	BearerAuth
+//	@Summary	叫号屏展示内容：制作中、请取餐及最近已取餐的号码
+//	@Description	叫号屏订阅门店实时推送（pickup.called、pickup.picked_up 事件）后重新拉取
+//	@Produce	json
+//	@Param		data	query		types.PickupDisplayReq	true	"请求信息"
+//	@Success	200		{object}	domain.PickupDisplay	"成功"
+//	@Router		/pickup/display [get]
+func (h *PickupHandler) Display() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PickupHandler.Display")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PickupDisplayReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		display, err := h.PickupCallInteractor.Display(ctx, user.MerchantID, req.StoreID, req.BusinessDate)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get pickup display: %w", err))
+			return
+		}
+
+		response.Ok(c, display)
+	}
+}
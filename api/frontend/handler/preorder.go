@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type PreorderHandler struct {
+	PreorderInteractor domain.PreorderInteractor
+}
+
+func NewPreorderHandler(preorderInteractor domain.PreorderInteractor) *PreorderHandler {
+	return &PreorderHandler{
+		PreorderInteractor: preorderInteractor,
+	}
+}
+
+func (h *PreorderHandler) Routes(r gin.IRouter) {
+	r = r.Group("/preorders")
+	r.GET("", h.List())
+	r.POST("/:id/release", h.Release())
+	r.POST("/:id/cancel", h.Cancel())
+}
+
+func (h *PreorderHandler) NoAuths() []string {
+	return []string{}
+}
+
+// List
+//
+//	@Tags		预点单
+//	@Security	BearerAuth
+//	@Summary	预点单列表：查询指定日期的预点单，按预约时间升序
+//	@Produce	json
+//	@Param		data	query		types.PreorderListReq	true	"请求信息"
+//	@Success	200		{object}	[]domain.Preorder		"成功"
+//	@Router		/preorders [get]
+func (h *PreorderHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PreorderHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PreorderListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.PreorderInteractor.List(ctx, user.MerchantID, req.StoreID, req.Date, req.Statuses)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list preorders: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Release
+//
+//	@Tags		预点单
+//	@Security	BearerAuth
+//	@Summary	提前下发预点单
+//	@Description	预点单默认在预约时间前按备餐提前量自动下发后厨，门店可手动提前下发
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string					true	"预点单ID"
+//	@Param		data	body		types.PreorderActionReq	true	"请求信息"
+//	@Success	200		{object}	domain.Preorder			"成功"
+//	@Router		/preorders/{id}/release [post]
+func (h *PreorderHandler) Release() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PreorderHandler.Release")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.PreorderActionReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.PreorderInteractor.Release(ctx, user.MerchantID, req.StoreID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to release preorder: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Cancel
+//
+//	@Tags		预点单
+//	@Security	BearerAuth
+//	@Summary	取消预点单
+//	@Description	仅未下发且未支付的预点单可取消，关联订单同步取消
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string					true	"预点单ID"
+//	@Param		data	body		types.PreorderCancelReq	true	"请求信息"
+//	@Success	200		{object}	domain.Preorder			"成功"
+//	@Router		/preorders/{id}/cancel [post]
+func (h *PreorderHandler) Cancel() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PreorderHandler.Cancel")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.PreorderCancelReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.PreorderInteractor.Cancel(ctx, user.MerchantID, req.StoreID, id, req.Reason)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to cancel preorder: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type PromotionHandler struct {
+	PromotionInteractor domain.PromotionInteractor
+}
+
+func NewPromotionHandler(promotionInteractor domain.PromotionInteractor) *PromotionHandler {
+	return &PromotionHandler{
+		PromotionInteractor: promotionInteractor,
+	}
+}
+
+func (h *PromotionHandler) Routes(r gin.IRouter) {
+	r = r.Group("/promotion")
+	r.POST("/calculate", h.Calculate())
+}
+
+func (h *PromotionHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Calculate
+//
+//	@Tags		促销
+//	@Security	BearerAuth
+//	@Summary	促销优惠试算
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.PromotionCalculateReq		true	"请求信息"
+//	@Success	200		{object}	types.PromotionCalculateResp	"成功"
+//	@Router		/promotion/calculate [post]
+func (h *PromotionHandler) Calculate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PromotionHandler.Calculate")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PromotionCalculateReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		o := &domain.Order{
+			MerchantID:    user.MerchantID,
+			StoreID:       req.StoreID,
+			DiningMode:    domain.DiningMode(req.DiningMode),
+			Channel:       domain.Channel(req.Channel),
+			PlacedAt:      req.PlacedAt,
+			OrderProducts: req.OrderProducts,
+			Amount:        req.Amount,
+		}
+
+		if err := h.PromotionInteractor.Calculate(ctx, o); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to calculate promotions: %w", err))
+			return
+		}
+
+		response.Ok(c, &types.PromotionCalculateResp{
+			Promotions:    o.Promotions,
+			OrderProducts: o.OrderProducts,
+			Amount:        o.Amount,
+		})
+	}
+}
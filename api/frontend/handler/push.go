@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/push"
+)
+
+type PushHandler struct {
+	Hub            *push.Hub
+	PushInteractor domain.PushInteractor
+}
+
+func NewPushHandler(hub *push.Hub, pushInteractor domain.PushInteractor) *PushHandler {
+	return &PushHandler{
+		Hub:            hub,
+		PushInteractor: pushInteractor,
+	}
+}
+
+func (h *PushHandler) Routes(r gin.IRouter) {
+	r = r.Group("/push")
+	r.GET("", h.Subscribe())
+}
+
+func (h *PushHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Subscribe
+//
+//	@Tags		实时推送
+//	@Security	BearerAuth
+//	@Summary	订阅门店实时事件（新订单、订单状态、售罄、配置变更等）
+//	@Description	以 Server-Sent Events 推送事件，事件 ID 作为断线重连游标（Last-Event-ID 请求头或 last_event_id 参数）
+//	@Produce	text/event-stream
+//	@Param		data	query	types.PushSubscribeReq	true	"请求信息"
+//	@Param		last_event_id	query	string	false	"重连游标"
+//	@Success	200
+//	@Router		/push [get]
+func (h *PushHandler) Subscribe() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PushHandler.Subscribe")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PushSubscribeReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		params := domain.PushSubscribeParams{
+			MerchantID: domain.FromFrontendContext(ctx).MerchantID,
+			StoreID:    req.StoreID,
+			DeviceID:   req.DeviceID,
+		}
+		topics, err := h.PushInteractor.Topics(ctx, params)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to resolve push topics: %w", err))
+			return
+		}
+
+		channels := make([]string, 0, len(topics))
+		for _, topic := range topics {
+			channels = append(channels, topic.String())
+		}
+		sub, err := h.Hub.Subscribe(ctx, channels, push.LastEventID(c))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		push.ServeSSE(c, sub)
+	}
+}
@@ -46,6 +46,7 @@ func (h *RefundOrderHandler) NoAuths() []string {
 //	@Tags		退款订单
 //	@Security	BearerAuth
 //	@Summary	创建退款订单
+//	@Description	退款完成时：全额退款恢复原订单使用的系统优惠券；部分退款不恢复优惠券（券按整单抵扣，不按退款比例退回）；三方券均不恢复，需在三方平台处理
 //	@Accept		json
 //	@Produce	json
 //	@Param		data	body		types.CreateRefundOrderReq	true	"请求信息"
@@ -209,6 +210,7 @@ func (h *RefundOrderHandler) Get() gin.HandlerFunc {
 //	@Tags		退款订单
 //	@Security	BearerAuth
 //	@Summary	更新退款订单
+//	@Description	退款完成时：全额退款恢复原订单使用的系统优惠券；部分退款不恢复优惠券（券按整单抵扣，不按退款比例退回）；三方券均不恢复，需在三方平台处理
 //	@Accept		json
 //	@Produce	json
 //	@Param		id		path		string						true	"退款订单ID"
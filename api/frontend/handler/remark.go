@@ -11,6 +11,7 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/i18n"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
@@ -65,6 +66,7 @@ func (h *RemarkHandler) Get() gin.HandlerFunc {
 			return
 		}
 
+		remark.Localize(i18n.LocaleFromContext(ctx))
 		response.Ok(c, remark)
 	}
 }
@@ -109,6 +111,7 @@ func (h *RemarkHandler) List() gin.HandlerFunc {
 			return
 		}
 
+		domain.Remarks(remarks).Localize(i18n.LocaleFromContext(ctx))
 		response.Ok(c, types.RemarkListResp{
 			Remarks: remarks,
 			Total:   total,
@@ -0,0 +1,348 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type ReservationHandler struct {
+	ReservationInteractor domain.ReservationInteractor
+}
+
+func NewReservationHandler(reservationInteractor domain.ReservationInteractor) *ReservationHandler {
+	return &ReservationHandler{
+		ReservationInteractor: reservationInteractor,
+	}
+}
+
+func (h *ReservationHandler) Routes(r gin.IRouter) {
+	r = r.Group("/reservations")
+	r.GET("", h.List())
+	r.GET("/slots", h.Slots())
+	r.POST("", h.Create())
+	r.POST("/:id/deposit", h.CollectDeposit())
+	r.POST("/:id/cancel", h.Cancel())
+	r.POST("/:id/no_show", h.MarkNoShow())
+	r.POST("/:id/seat", h.Seat())
+}
+
+func (h *ReservationHandler) NoAuths() []string {
+	return []string{}
+}
+
+// List
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	预订列表：查询指定日期的预订，按到店时间升序
+//	@Produce	json
+//	@Param		data	query		types.ReservationListReq	true	"请求信息"
+//	@Success	200		{object}	[]domain.Reservation		"成功"
+//	@Router		/reservations [get]
+func (h *ReservationHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ReservationListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.ReservationInteractor.List(ctx, user.MerchantID, req.StoreID, req.Date, req.Statuses)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list reservations: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Slots
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	查询可预订时段
+//	@Description	时段由门店营业时间、就餐时段和预订规则生成，按桌台座位数和已有预订计算空闲桌台
+//	@Produce	json
+//	@Param		data	query		types.ReservationSlotReq	true	"请求信息"
+//	@Success	200		{object}	[]domain.ReservationSlot	"成功"
+//	@Router		/reservations/slots [get]
+func (h *ReservationHandler) Slots() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.Slots")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ReservationSlotReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.ReservationInteractor.Slots(ctx, domain.ReservationSlotParams{
+			MerchantID: user.MerchantID,
+			StoreID:    req.StoreID,
+			Date:       req.Date,
+			PartySize:  req.PartySize,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list reservation slots: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Create
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	代客预订
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.ReservationCreateReq	true	"请求信息"
+//	@Success	200		{object}	domain.Reservation			"成功"
+//	@Router		/reservations [post]
+func (h *ReservationHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.Create")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ReservationCreateReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.ReservationInteractor.Create(ctx, domain.ReservationCreateParams{
+			MerchantID:     user.MerchantID,
+			StoreID:        req.StoreID,
+			GuestName:      req.GuestName,
+			GuestPhone:     req.GuestPhone,
+			PartySize:      req.PartySize,
+			ReservedAt:     req.ReservedAt,
+			TableID:        req.TableID,
+			AreaPreference: req.AreaPreference,
+			Remark:         req.Remark,
+			Deposit:        req.Deposit,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to create reservation: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// CollectDeposit
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	收取预订定金
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string						true	"预订ID"
+//	@Param		data	body		types.ReservationDepositReq	true	"请求信息"
+//	@Success	200		{object}	domain.Reservation			"成功"
+//	@Router		/reservations/{id}/deposit [post]
+func (h *ReservationHandler) CollectDeposit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.CollectDeposit")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.ReservationDepositReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.ReservationInteractor.CollectDeposit(ctx, user.MerchantID, req.StoreID, id, req.PaymentMethod)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to collect reservation deposit: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Cancel
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	取消预订
+//	@Description	已收取的定金标记为已退还，由门店线下退还
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string						true	"预订ID"
+//	@Param		data	body		types.ReservationCancelReq	true	"请求信息"
+//	@Success	200		{object}	domain.Reservation			"成功"
+//	@Router		/reservations/{id}/cancel [post]
+func (h *ReservationHandler) Cancel() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.Cancel")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.ReservationCancelReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.ReservationInteractor.Cancel(ctx, user.MerchantID, req.StoreID, id, req.Reason)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to cancel reservation: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// MarkNoShow
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	标记未到店，已收取的定金不予退还
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string						true	"预订ID"
+//	@Param		data	body		types.ReservationActionReq	true	"请求信息"
+//	@Success	200		{object}	domain.Reservation			"成功"
+//	@Router		/reservations/{id}/no_show [post]
+func (h *ReservationHandler) MarkNoShow() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.MarkNoShow")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.ReservationActionReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.ReservationInteractor.MarkNoShow(ctx, user.MerchantID, req.StoreID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to mark reservation no-show: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Seat
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	预订入座：在桌台开单，已收取的定金记为订单支付
+//	@Description	只能为当天的预订入座，桌台需空闲且座位数满足用餐人数
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string					true	"预订ID"
+//	@Param		data	body		types.ReservationSeatReq	true	"请求信息"
+//	@Success	200		{object}	domain.Reservation		"成功"
+//	@Router		/reservations/{id}/seat [post]
+func (h *ReservationHandler) Seat() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.Seat")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.ReservationSeatReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.ReservationInteractor.Seat(ctx, user.MerchantID, req.StoreID, id, req.TableID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to seat reservation: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
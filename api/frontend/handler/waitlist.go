@@ -0,0 +1,335 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type WaitlistHandler struct {
+	WaitlistInteractor domain.WaitlistInteractor
+}
+
+func NewWaitlistHandler(waitlistInteractor domain.WaitlistInteractor) *WaitlistHandler {
+	return &WaitlistHandler{
+		WaitlistInteractor: waitlistInteractor,
+	}
+}
+
+func (h *WaitlistHandler) Routes(r gin.IRouter) {
+	r = r.Group("/waitlist")
+	r.GET("/queue", h.Queue())
+	r.GET("/tickets", h.List())
+	r.POST("/tickets", h.Take())
+	r.POST("/tickets/:id/call", h.Call())
+	r.POST("/tickets/:id/skip", h.Skip())
+	r.POST("/tickets/:id/cancel", h.Cancel())
+	r.POST("/tickets/:id/seat", h.Seat())
+}
+
+func (h *WaitlistHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Queue
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	排队概况：各分组排队桌数、预计等待时间和最近叫号
+//	@Description	门店订阅实时推送（waitlist.taken、waitlist.called、waitlist.updated 事件）后重新拉取
+//	@Produce	json
+//	@Param		data	query		types.WaitlistQueueReq	true	"请求信息"
+//	@Success	200		{object}	domain.WaitlistQueue	"成功"
+//	@Router		/waitlist/queue [get]
+func (h *WaitlistHandler) Queue() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.Queue")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.WaitlistQueueReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.WaitlistInteractor.Queue(ctx, user.MerchantID, req.StoreID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get waitlist queue: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// List
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	当天排队号列表，按取号时间升序
+//	@Produce	json
+//	@Param		data	query		types.WaitlistTicketListReq	true	"请求信息"
+//	@Success	200		{object}	[]domain.WaitlistTicket		"成功"
+//	@Router		/waitlist/tickets [get]
+func (h *WaitlistHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.WaitlistTicketListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.WaitlistInteractor.List(ctx, user.MerchantID, req.StoreID, "", req.Statuses)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list waitlist tickets: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Take
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	取号：按人数分配小桌、中桌或大桌排队号
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.WaitlistTakeReq	true	"请求信息"
+//	@Success	200		{object}	domain.WaitlistTicket	"成功"
+//	@Router		/waitlist/tickets [post]
+func (h *WaitlistHandler) Take() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.Take")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.WaitlistTakeReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.WaitlistInteractor.Take(ctx, domain.WaitlistTakeParams{
+			MerchantID: user.MerchantID,
+			StoreID:    req.StoreID,
+			PartySize:  req.PartySize,
+			GuestPhone: req.GuestPhone,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to take waitlist ticket: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Call
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	叫号，可重复叫号
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string					true	"排队号ID"
+//	@Param		data	body		types.WaitlistActionReq	true	"请求信息"
+//	@Success	200		{object}	domain.WaitlistTicket	"成功"
+//	@Router		/waitlist/tickets/{id}/call [post]
+func (h *WaitlistHandler) Call() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.Call")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.WaitlistActionReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.WaitlistInteractor.Call(ctx, user.MerchantID, req.StoreID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to call waitlist ticket: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Skip
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	过号
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string					true	"排队号ID"
+//	@Param		data	body		types.WaitlistActionReq	true	"请求信息"
+//	@Success	200		{object}	domain.WaitlistTicket	"成功"
+//	@Router		/waitlist/tickets/{id}/skip [post]
+func (h *WaitlistHandler) Skip() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.Skip")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.WaitlistActionReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.WaitlistInteractor.Skip(ctx, user.MerchantID, req.StoreID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to skip waitlist ticket: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Cancel
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	取消排队
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string					true	"排队号ID"
+//	@Param		data	body		types.WaitlistActionReq	true	"请求信息"
+//	@Success	200		{object}	domain.WaitlistTicket	"成功"
+//	@Router		/waitlist/tickets/{id}/cancel [post]
+func (h *WaitlistHandler) Cancel() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.Cancel")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.WaitlistActionReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.WaitlistInteractor.Cancel(ctx, user.MerchantID, req.StoreID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to cancel waitlist ticket: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Seat
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	排队入座：在桌台开一笔堂食订单
+//	@Description	桌台需空闲且座位数满足用餐人数
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string					true	"排队号ID"
+//	@Param		data	body		types.WaitlistSeatReq	true	"请求信息"
+//	@Success	200		{object}	domain.WaitlistTicket	"成功"
+//	@Router		/waitlist/tickets/{id}/seat [post]
+func (h *WaitlistHandler) Seat() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.Seat")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.WaitlistSeatReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromFrontendContext(ctx)
+		res, err := h.WaitlistInteractor.Seat(ctx, user.MerchantID, req.StoreID, id, req.TableID)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to seat waitlist ticket: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
@@ -7,20 +7,31 @@ import (
 	"github.com/google/uuid"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/middleware"
 )
 
-type Auth struct{}
+type Auth struct {
+	skipper middleware.SkipperFunc
+}
 
 func (u *Auth) Name() string {
 	return "Auth"
 }
 
 func NewAuth(handlers []ugin.Handler) *Auth {
-	return &Auth{}
+	// 自助点餐机由 KioskAuth 按设备令牌认证
+	return &Auth{
+		skipper: middleware.AllowPathPrefixSkipper(KioskPathPrefix),
+	}
 }
 
 func (u *Auth) Middleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if middleware.SkipHandler(c, u.skipper) {
+			c.Next()
+			return
+		}
+
 		ctx := c.Request.Context()
 		merchantIDStr := c.GetHeader("X-Merchant-ID")
 		merchantID, err := uuid.Parse(merchantIDStr)
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/frontend"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/middleware"
+)
+
+// KioskPathPrefix 自助点餐机接口前缀，使用设备访问令牌认证
+const KioskPathPrefix = frontend.ApiPrefixV1 + "/kiosk"
+
+// KioskAuth 自助点餐机认证，仅作用于自助点餐机接口
+type KioskAuth struct {
+	kioskInteractor domain.KioskInteractor
+	skipper         middleware.SkipperFunc
+}
+
+func (u *KioskAuth) Name() string {
+	return "KioskAuth"
+}
+
+func NewKioskAuth(kioskInteractor domain.KioskInteractor) *KioskAuth {
+	return &KioskAuth{
+		kioskInteractor: kioskInteractor,
+		skipper:         middleware.AllowPathPrefixNoSkipper(KioskPathPrefix),
+	}
+}
+
+func (u *KioskAuth) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if middleware.SkipHandler(c, u.skipper) {
+			c.Next()
+			return
+		}
+
+		auths := strings.SplitN(c.GetHeader("Authorization"), " ", 2)
+		if len(auths) != 2 || !strings.EqualFold(auths[0], "Bearer") {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		token := auths[1]
+		ctx := c.Request.Context()
+
+		device, err := u.kioskInteractor.Authenticate(ctx, token)
+		if err != nil {
+			if errors.Is(err, domain.ErrKioskTokenInvalid) || errors.Is(err, domain.ErrKioskDeviceDisabled) {
+				c.AbortWithStatus(http.StatusUnauthorized)
+				return
+			}
+
+			err = fmt.Errorf("failed to authenticate kiosk: %w", err)
+			c.Error(err)
+			c.Abort()
+			return
+		}
+
+		ctx = domain.NewKioskContext(ctx, device)
+		c.Request = c.Request.Clone(ctx)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+// CouponRedeemReq 优惠券校验/核销请求
+type CouponRedeemReq struct {
+	StoreID      uuid.UUID `json:"store_id" binding:"required"`    // 门店ID
+	OrderID      uuid.UUID `json:"order_id" binding:"required"`    // 订单ID
+	Code         string    `json:"code" binding:"required,max=64"` // 券码
+	OperatorID   uuid.UUID `json:"operator_id"`                    // 操作人ID
+	OperatorName string    `json:"operator_name"`                  // 操作人名称
+}
+
+// PartnerCouponVerifyReq 三方券校验请求
+type PartnerCouponVerifyReq struct {
+	StoreID  uuid.UUID `json:"store_id" binding:"required"`        // 门店ID
+	Provider string    `json:"provider" binding:"required,max=50"` // 三方券平台
+	Code     string    `json:"code" binding:"required,max=64"`     // 券码
+}
+
+// PartnerCouponRedeemReq 三方券核销请求
+type PartnerCouponRedeemReq struct {
+	PartnerCouponVerifyReq
+	OrderID      uuid.UUID `json:"order_id" binding:"required"` // 订单ID
+	OperatorID   uuid.UUID `json:"operator_id"`                 // 操作人ID
+	OperatorName string    `json:"operator_name"`               // 操作人名称
+}
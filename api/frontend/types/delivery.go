@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// DeliveryOrderListReq 外卖订单列表请求
+type DeliveryOrderListReq struct {
+	StoreID  uuid.UUID                    `form:"store_id" binding:"required"`                                                       // 门店ID
+	Statuses []domain.DeliveryOrderStatus `form:"statuses" binding:"omitempty,dive,oneof=pending accepted ready rejected cancelled"` // 状态（可选，可多选）
+	Date     string                       `form:"date" binding:"omitempty,datetime=2006-01-02"`                                      // 下单日期起，默认不限制
+}
+
+// DeliveryOrderActionReq 外卖订单接单/出餐请求
+type DeliveryOrderActionReq struct {
+	StoreID uuid.UUID `json:"store_id" binding:"required"` // 门店ID
+}
+
+// DeliveryOrderRejectReq 外卖订单拒单请求
+type DeliveryOrderRejectReq struct {
+	StoreID uuid.UUID `json:"store_id" binding:"required"`       // 门店ID
+	Reason  string    `json:"reason" binding:"required,max=255"` // 拒单原因
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// KioskUpsellReq 套餐推荐请求
+type KioskUpsellReq struct {
+	ProductIDs []uuid.UUID `form:"product_ids" binding:"required,min=1,max=50"` // 已选商品ID列表
+}
+
+// KioskOrderItem 自助点餐商品
+type KioskOrderItem struct {
+	ProductID uuid.UUID              `json:"product_id" binding:"required"`       // 商品ID
+	SpecID    uuid.UUID              `json:"spec_id"`                             // 规格ID（为空时使用默认规格）
+	Attrs     []domain.TableCartAttr `json:"attrs" binding:"omitempty,dive"`      // 口味做法
+	Groups    domain.SetMealGroups   `json:"groups" binding:"omitempty"`          // 套餐分组选择（套餐商品必填）
+	Qty       int                    `json:"qty" binding:"required,min=1,max=99"` // 数量
+	Remark    string                 `json:"remark" binding:"omitempty,max=50"`   // 备注
+}
+
+// KioskSubmitReq 自助点餐下单请求
+type KioskSubmitReq struct {
+	Items   []KioskOrderItem    `json:"items" binding:"required,min=1,max=50,dive"`                    // 商品列表
+	PayMode domain.KioskPayMode `json:"pay_mode" binding:"required,oneof=pay_at_kiosk pay_at_counter"` // 支付方式
+	Remark  string              `json:"remark" binding:"omitempty,max=100"`                            // 整单备注
+}
+
+// KioskPayReq 机上支付请求
+type KioskPayReq struct {
+	AuthCode string `json:"auth_code" binding:"required,max=64"` // 顾客付款码
+}
@@ -0,0 +1,20 @@
+package types
+
+import "github.com/google/uuid"
+
+// KitchenTicketListReq 查询待出单厨房单请求
+type KitchenTicketListReq struct {
+	StoreID  uuid.UUID `form:"store_id" binding:"required"` // 门店ID
+	DeviceID uuid.UUID `form:"device_id"`                   // 打印机设备ID（按其打印语言输出商品名称，为空时使用商品原始名称）
+}
+
+// KitchenTicketPrintedReq 标记厨房单已出单请求
+type KitchenTicketPrintedReq struct {
+	StoreID uuid.UUID   `json:"store_id" binding:"required"`       // 门店ID
+	IDs     []uuid.UUID `json:"ids" binding:"required,min=1,dive"` // 厨房单ID列表
+}
+
+// KitchenTicketPrintedResp 标记厨房单已出单响应
+type KitchenTicketPrintedResp struct {
+	Count int `json:"count"` // 标记数量
+}
@@ -0,0 +1,16 @@
+package types
+
+import "github.com/google/uuid"
+
+// MemberLookupReq 查找会员请求，手机号和会员码任选其一
+type MemberLookupReq struct {
+	Phone string `form:"phone" binding:"max=20"` // 手机号
+	Code  string `form:"code" binding:"max=32"`  // 会员码（扫描会员二维码获得）
+}
+
+// MemberAttachReq 订单关联会员请求
+type MemberAttachReq struct {
+	StoreID  uuid.UUID `json:"store_id" binding:"required"`  // 门店ID
+	OrderID  uuid.UUID `json:"order_id" binding:"required"`  // 订单ID
+	MemberID uuid.UUID `json:"member_id" binding:"required"` // 会员ID
+}
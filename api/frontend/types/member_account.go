@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// MemberAccountTopUpReq 储值充值请求
+type MemberAccountTopUpReq struct {
+	StoreID       uuid.UUID                   `json:"store_id" binding:"required"`                                           // 门店ID
+	MemberID      uuid.UUID                   `json:"member_id" binding:"required"`                                          // 会员ID
+	Amount        decimal.Decimal             `json:"amount"`                                                                // 充值金额（精确到分）
+	BonusAmount   decimal.Decimal             `json:"bonus_amount"`                                                          // 赠送金额（精确到分）
+	PaymentMethod domain.PaymentMethodPayType `json:"payment_method" binding:"required,oneof=cash online_payment bank_card"` // 充值支付方式
+	OperatorID    uuid.UUID                   `json:"operator_id"`                                                           // 操作人ID
+	OperatorName  string                      `json:"operator_name"`                                                         // 操作人名称
+	Remark        string                      `json:"remark" binding:"max=255"`                                              // 备注
+}
+
+// MemberAccountPayReq 储值支付请求
+type MemberAccountPayReq struct {
+	StoreID  uuid.UUID           `json:"store_id" binding:"required"` // 门店ID
+	OrderID  uuid.UUID           `json:"order_id" binding:"required"` // 订单ID
+	MemberID uuid.UUID           `json:"member_id"`                   // 会员ID（订单已关联会员时可不传）
+	Amount   decimal.Decimal     `json:"amount"`                      // 支付金额（精确到分）
+	POS      domain.OrderPOS     `json:"pos"`                         // POS 终端信息
+	Cashier  domain.OrderCashier `json:"cashier"`                     // 收银员信息
+}
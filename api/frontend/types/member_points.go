@@ -0,0 +1,17 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// MemberPointsRedeemReq 积分使用请求
+type MemberPointsRedeemReq struct {
+	StoreID      uuid.UUID                     `json:"store_id" binding:"required"`                      // 门店ID
+	OrderID      uuid.UUID                     `json:"order_id" binding:"required"`                      // 订单ID（需已关联会员）
+	Type         domain.MemberPointsRedeemType `json:"type" binding:"required,oneof=discount product"`   // 使用方式：discount（抵扣金额）、product（兑换商品）
+	Points       int64                         `json:"points" binding:"required_if=Type discount,min=0"` // 抵扣使用的积分（抵扣金额时必填）
+	ProductID    uuid.UUID                     `json:"product_id" binding:"required_if=Type product"`    // 兑换商品ID（兑换商品时必填）
+	OperatorID   uuid.UUID                     `json:"operator_id"`                                      // 操作人ID
+	OperatorName string                        `json:"operator_name"`                                    // 操作人名称
+}
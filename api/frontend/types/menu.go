@@ -0,0 +1,12 @@
+package types
+
+import "gitlab.jiguang.dev/pos-dine/dine/domain"
+
+// MenuDietaryFilterReq 菜单过敏原与饮食属性筛选
+type MenuDietaryFilterReq struct {
+	ExcludeAllergens []domain.Allergen  `form:"exclude_allergens" binding:"omitempty,dive,oneof=gluten crustacean mollusc fish egg milk peanut tree_nut soy sesame celery mustard sulphite lupin"` // 排除含有任一过敏原的商品（可选，可多选）
+	Halal            bool               `form:"halal"`                                                                                                                                             // 仅清真商品（可选）
+	Vegetarian       bool               `form:"vegetarian"`                                                                                                                                        // 仅素食商品（可选）
+	Vegan            bool               `form:"vegan"`                                                                                                                                             // 仅纯素商品（可选）
+	MaxSpicyLevel    *domain.SpicyLevel `form:"max_spicy_level" binding:"omitempty,min=0,max=3"`                                                                                                   // 最高辣度（可选）
+}
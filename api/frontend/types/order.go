@@ -21,7 +21,7 @@ type CreateOrderReq struct {
 	DiningMode    string `json:"dining_mode" binding:"required,oneof=DINE_IN"`                         // 就餐模式
 	OrderStatus   string `json:"order_status" binding:"omitempty,oneof=PLACED COMPLETED CANCELLED"`    // 订单状态
 	PaymentStatus string `json:"payment_status" binding:"omitempty,oneof=UNPAID PAYING PAID REFUNDED"` // 支付状态
-	Channel       string `json:"channel" binding:"omitempty,oneof=POS H5 APP"`                         // 下单渠道
+	Channel       string `json:"channel" binding:"omitempty,oneof=POS H5 APP KIOSK"`                   // 下单渠道
 
 	TableID    uuid.UUID `json:"table_id"`    // 桌位ID
 	TableName  string    `json:"table_name"`  // 桌位名称
@@ -30,6 +30,8 @@ type CreateOrderReq struct {
 	PlacedAt time.Time `json:"placed_at"` // 下单时间
 	PlacedBy uuid.UUID `json:"placed_by"` // 下单人
 
+	MemberID uuid.UUID `json:"member_id"` // 会员ID（可选，关联会员后自动按会员价计算优惠）
+
 	Store   domain.OrderStore   `json:"store"`   // 门店信息
 	Pos     domain.OrderPOS     `json:"pos"`     // POS终端信息
 	Cashier domain.OrderCashier `json:"cashier"` // 收银员信息
@@ -56,7 +58,7 @@ type UpdateOrderReq struct {
 	DiningMode    string `json:"dining_mode" binding:"omitempty,oneof=DINE_IN"`                        // 就餐模式
 	OrderStatus   string `json:"order_status" binding:"omitempty,oneof=PLACED COMPLETED CANCELLED"`    // 订单状态
 	PaymentStatus string `json:"payment_status" binding:"omitempty,oneof=UNPAID PAYING PAID REFUNDED"` // 支付状态
-	Channel       string `json:"channel" binding:"omitempty,oneof=POS H5 APP"`                         // 下单渠道
+	Channel       string `json:"channel" binding:"omitempty,oneof=POS H5 APP KIOSK"`                   // 下单渠道
 
 	TableID    uuid.UUID `json:"table_id"`    // 桌位ID
 	TableName  string    `json:"table_name"`  // 桌位名称
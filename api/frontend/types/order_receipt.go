@@ -0,0 +1,14 @@
+package types
+
+import "github.com/google/uuid"
+
+// OrderReceiptLinkReq 生成电子小票链接请求
+type OrderReceiptLinkReq struct {
+	OrderID uuid.UUID `json:"order_id" binding:"required"` // 订单ID
+}
+
+// OrderReceiptPrintReq 查询打印小票请求
+type OrderReceiptPrintReq struct {
+	OrderID  uuid.UUID `form:"order_id" binding:"required"` // 订单ID
+	DeviceID uuid.UUID `form:"device_id"`                   // 打印机设备ID（按其打印语言输出商品名称，为空时使用商品原始名称）
+}
@@ -0,0 +1,20 @@
+package types
+
+import "github.com/google/uuid"
+
+// PickupAssignReq 分配取餐号请求
+type PickupAssignReq struct {
+	StoreID uuid.UUID `json:"store_id" binding:"required"` // 门店ID
+	OrderID uuid.UUID `json:"order_id" binding:"required"` // 订单ID
+}
+
+// PickupCallReq 叫号/确认取餐请求
+type PickupCallReq struct {
+	StoreID uuid.UUID `json:"store_id" binding:"required"` // 门店ID
+}
+
+// PickupDisplayReq 叫号屏查询请求
+type PickupDisplayReq struct {
+	StoreID      uuid.UUID `form:"store_id" binding:"required"`                           // 门店ID
+	BusinessDate string    `form:"business_date" binding:"omitempty,datetime=2006-01-02"` // 营业日，默认当天
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// PreorderListReq 预点单列表请求
+type PreorderListReq struct {
+	StoreID  uuid.UUID               `form:"store_id" binding:"required"`                                          // 门店ID
+	Date     string                  `form:"date" binding:"required,datetime=2006-01-02"`                          // 取餐/送达日期
+	Statuses []domain.PreorderStatus `form:"statuses" binding:"omitempty,dive,oneof=scheduled released cancelled"` // 状态（可选，可多选）
+}
+
+// PreorderActionReq 预点单操作请求
+type PreorderActionReq struct {
+	StoreID uuid.UUID `json:"store_id" binding:"required"` // 门店ID
+}
+
+// PreorderCancelReq 取消预点单请求
+type PreorderCancelReq struct {
+	StoreID uuid.UUID `json:"store_id" binding:"required"` // 门店ID
+	Reason  string    `json:"reason" binding:"max=255"`    // 取消原因（可选）
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// PromotionCalculateReq 促销优惠试算请求
+type PromotionCalculateReq struct {
+	StoreID       uuid.UUID             `json:"store_id" binding:"required"`                        // 门店ID
+	DiningMode    string                `json:"dining_mode" binding:"required,oneof=DINE_IN"`       // 就餐模式
+	Channel       string                `json:"channel" binding:"omitempty,oneof=POS H5 APP KIOSK"` // 下单渠道
+	PlacedAt      time.Time             `json:"placed_at"`                                          // 下单时间（为空表示当前时间）
+	OrderProducts []domain.OrderProduct `json:"order_products" binding:"required,min=1"`            // 订单商品明细
+	Amount        domain.OrderAmount    `json:"amount"`                                             // 金额汇总
+}
+
+// PromotionCalculateResp 促销优惠试算结果
+type PromotionCalculateResp struct {
+	Promotions    []domain.OrderPromotion `json:"promotions"`     // 享受的促销活动
+	OrderProducts []domain.OrderProduct   `json:"order_products"` // 订单商品明细（含促销优惠金额）
+	Amount        domain.OrderAmount      `json:"amount"`         // 金额汇总
+}
@@ -0,0 +1,9 @@
+package types
+
+import "github.com/google/uuid"
+
+// PushSubscribeReq 订阅实时推送请求
+type PushSubscribeReq struct {
+	StoreID  uuid.UUID `form:"store_id" binding:"required"` // 门店ID
+	DeviceID uuid.UUID `form:"device_id"`                   // 设备ID（可选，订阅发往该设备的事件）
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// ReservationListReq 预订列表请求
+type ReservationListReq struct {
+	StoreID  uuid.UUID                  `form:"store_id" binding:"required"`                                             // 门店ID
+	Date     string                     `form:"date" binding:"required,datetime=2006-01-02"`                             // 日期
+	Statuses []domain.ReservationStatus `form:"statuses" binding:"omitempty,dive,oneof=booked seated no_show cancelled"` // 状态（可选，可多选）
+}
+
+// ReservationSlotReq 可预订时段请求
+type ReservationSlotReq struct {
+	StoreID   uuid.UUID `form:"store_id" binding:"required"`                 // 门店ID
+	Date      string    `form:"date" binding:"required,datetime=2006-01-02"` // 日期
+	PartySize int       `form:"party_size" binding:"required,min=1"`         // 用餐人数
+}
+
+// ReservationCreateReq 代客预订请求
+type ReservationCreateReq struct {
+	StoreID        uuid.UUID       `json:"store_id" binding:"required"`           // 门店ID
+	GuestName      string          `json:"guest_name" binding:"required,max=50"`  // 预订人姓名
+	GuestPhone     string          `json:"guest_phone" binding:"required,max=20"` // 预订人手机号
+	PartySize      int             `json:"party_size" binding:"required,min=1"`   // 用餐人数
+	ReservedAt     time.Time       `json:"reserved_at" binding:"required"`        // 到店时间，需为可预订时段
+	TableID        uuid.UUID       `json:"table_id"`                              // 偏好桌台（可选）
+	AreaPreference string          `json:"area_preference" binding:"max=50"`      // 区域偏好（可选）
+	Remark         string          `json:"remark" binding:"max=255"`              // 备注（可选）
+	Deposit        decimal.Decimal `json:"deposit"`                               // 定金金额，为0不收定金
+}
+
+// ReservationActionReq 预订操作请求
+type ReservationActionReq struct {
+	StoreID uuid.UUID `json:"store_id" binding:"required"` // 门店ID
+}
+
+// ReservationDepositReq 收取预订定金请求
+type ReservationDepositReq struct {
+	StoreID       uuid.UUID                   `json:"store_id" binding:"required"`                                           // 门店ID
+	PaymentMethod domain.PaymentMethodPayType `json:"payment_method" binding:"required,oneof=cash online_payment bank_card"` // 定金支付方式
+}
+
+// ReservationCancelReq 取消预订请求
+type ReservationCancelReq struct {
+	StoreID uuid.UUID `json:"store_id" binding:"required"` // 门店ID
+	Reason  string    `json:"reason" binding:"max=255"`    // 取消原因（可选）
+}
+
+// ReservationSeatReq 预订入座请求
+type ReservationSeatReq struct {
+	StoreID uuid.UUID `json:"store_id" binding:"required"` // 门店ID
+	TableID uuid.UUID `json:"table_id"`                    // 入座桌台，为空时使用预订分配的桌台
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// WaitlistQueueReq 排队概况请求
+type WaitlistQueueReq struct {
+	StoreID uuid.UUID `form:"store_id" binding:"required"` // 门店ID
+}
+
+// WaitlistTicketListReq 排队号列表请求
+type WaitlistTicketListReq struct {
+	StoreID  uuid.UUID                     `form:"store_id" binding:"required"`                                                     // 门店ID
+	Statuses []domain.WaitlistTicketStatus `form:"statuses" binding:"omitempty,dive,oneof=waiting called seated skipped cancelled"` // 状态（可选，可多选）
+}
+
+// WaitlistTakeReq 取号请求
+type WaitlistTakeReq struct {
+	StoreID    uuid.UUID `json:"store_id" binding:"required"`         // 门店ID
+	PartySize  int       `json:"party_size" binding:"required,min=1"` // 用餐人数
+	GuestPhone string    `json:"guest_phone" binding:"max=20"`        // 顾客手机号（可选）
+}
+
+// WaitlistActionReq 排队号操作请求
+type WaitlistActionReq struct {
+	StoreID uuid.UUID `json:"store_id" binding:"required"` // 门店ID
+}
+
+// WaitlistSeatReq 排队号入座请求
+type WaitlistSeatReq struct {
+	StoreID uuid.UUID `json:"store_id" binding:"required"` // 门店ID
+	TableID uuid.UUID `json:"table_id" binding:"required"` // 入座桌台
+}
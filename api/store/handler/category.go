@@ -66,6 +66,7 @@ func (h *CategoryHandler) CreateRoot() gin.HandlerFunc {
 		category := &domain.Category{
 			ID:         uuid.New(),
 			Name:       req.Name,
+			NameI18n:   req.NameI18n,
 			MerchantID: user.MerchantID,
 			StoreID:    user.StoreID,
 		}
@@ -160,6 +161,7 @@ func (h *CategoryHandler) CreateChild() gin.HandlerFunc {
 		category := &domain.Category{
 			ID:             uuid.New(),
 			Name:           req.Name,
+			NameI18n:       req.NameI18n,
 			ParentID:       parentID,
 			MerchantID:     user.MerchantID,
 			StoreID:        user.StoreID,
@@ -235,6 +237,7 @@ func (h *CategoryHandler) Update() gin.HandlerFunc {
 		category := &domain.Category{
 			ID:             id,
 			Name:           req.Name,
+			NameI18n:       req.NameI18n,
 			InheritTaxRate: req.InheritTaxRate,
 			InheritStall:   req.InheritStall,
 		}
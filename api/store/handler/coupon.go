@@ -0,0 +1,384 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/store/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type CouponHandler struct {
+	CouponTemplateInteractor domain.CouponTemplateInteractor
+	CouponInteractor         domain.CouponInteractor
+}
+
+func NewCouponHandler(
+	couponTemplateInteractor domain.CouponTemplateInteractor,
+	couponInteractor domain.CouponInteractor,
+) *CouponHandler {
+	return &CouponHandler{
+		CouponTemplateInteractor: couponTemplateInteractor,
+		CouponInteractor:         couponInteractor,
+	}
+}
+
+func (h *CouponHandler) Routes(r gin.IRouter) {
+	r = r.Group("coupon")
+	r.POST("/template", h.CreateTemplate())
+	r.PUT("/template/:id", h.UpdateTemplate())
+	r.DELETE("/template/:id", h.DeleteTemplate())
+	r.GET("/template/:id", h.GetTemplateDetail())
+	r.GET("/template", h.ListTemplates())
+	r.POST("/template/:id/issue", h.Issue())
+	r.GET("", h.List())
+	r.PUT("/:id/invalidate", h.Invalidate())
+}
+
+func (h *CouponHandler) NoAuths() []string {
+	return []string{}
+}
+
+// CreateTemplate
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	创建优惠券模板
+//	@Param		data	body	types.CouponTemplateSaveReq	true	"请求信息"
+//	@Success	200
+//	@Router		/coupon/template [post]
+func (h *CouponHandler) CreateTemplate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.CreateTemplate")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.CouponTemplateSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		template := toCouponTemplate(uuid.New(), req)
+		if err := h.CouponTemplateInteractor.Create(ctx, template, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to create coupon template: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// UpdateTemplate
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	更新优惠券模板
+//	@Param		id		path	string						true	"模板ID"
+//	@Param		data	body	types.CouponTemplateSaveReq	true	"请求信息"
+//	@Success	200
+//	@Router		/coupon/template/{id} [put]
+func (h *CouponHandler) UpdateTemplate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.UpdateTemplate")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.CouponTemplateSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		template := toCouponTemplate(id, req)
+		if err = h.CouponTemplateInteractor.Update(ctx, template, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to update coupon template: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// DeleteTemplate
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	删除优惠券模板
+//	@Param		id	path	string	true	"模板ID"
+//	@Success	200
+//	@Router		/coupon/template/{id} [delete]
+func (h *CouponHandler) DeleteTemplate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.DeleteTemplate")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		if err = h.CouponTemplateInteractor.Delete(ctx, id, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to delete coupon template: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// GetTemplateDetail
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	获取优惠券模板详情
+//	@Param		id	path		string					true	"模板ID"
+//	@Success	200	{object}	domain.CouponTemplate	"成功"
+//	@Router		/coupon/template/{id} [get]
+func (h *CouponHandler) GetTemplateDetail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.GetTemplateDetail")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		template, err := h.CouponTemplateInteractor.GetDetail(ctx, id, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to get coupon template: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, template)
+	}
+}
+
+// ListTemplates
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	查询优惠券模板列表
+//	@Param		data	query		types.CouponTemplateListReq		true	"请求信息"
+//	@Success	200		{object}	domain.CouponTemplateSearchRes	"成功"
+//	@Router		/coupon/template [get]
+func (h *CouponHandler) ListTemplates() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.ListTemplates")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.CouponTemplateListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		page := upagination.New(req.Page, req.Size)
+		user := domain.FromStoreUserContext(ctx)
+		params := domain.CouponTemplateSearchParams{
+			MerchantID: user.MerchantID,
+			StoreID:    user.StoreID,
+			Name:       req.Name,
+			Type:       req.Type,
+			Enabled:    req.Enabled,
+		}
+
+		res, err := h.CouponTemplateInteractor.PagedListBySearch(ctx, page, params)
+		if err != nil {
+			err = fmt.Errorf("failed to list coupon templates: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Issue
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	发放优惠券
+//	@Param		id		path		string					true	"模板ID"
+//	@Param		data	body		types.CouponIssueReq	true	"请求信息"
+//	@Success	200		{object}	domain.Coupons			"成功"
+//	@Router		/coupon/template/{id}/issue [post]
+func (h *CouponHandler) Issue() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.Issue")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.CouponIssueReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		coupons, err := h.CouponTemplateInteractor.Issue(ctx, id, req.Qty, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to issue coupons: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, coupons)
+	}
+}
+
+// List
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	查询优惠券列表
+//	@Param		data	query		types.CouponListReq		true	"请求信息"
+//	@Success	200		{object}	domain.CouponSearchRes	"成功"
+//	@Router		/coupon [get]
+func (h *CouponHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.CouponListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		page := upagination.New(req.Page, req.Size)
+		user := domain.FromStoreUserContext(ctx)
+		params := domain.CouponSearchParams{
+			MerchantID: user.MerchantID,
+			TemplateID: req.TemplateID,
+			Code:       req.Code,
+			BatchNo:    req.BatchNo,
+			Status:     req.Status,
+		}
+
+		res, err := h.CouponInteractor.PagedListBySearch(ctx, page, params)
+		if err != nil {
+			err = fmt.Errorf("failed to list coupons: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Invalidate
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	作废优惠券
+//	@Param		id	path	string	true	"券ID"
+//	@Success	200
+//	@Router		/coupon/{id}/invalidate [put]
+func (h *CouponHandler) Invalidate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("CouponHandler.Invalidate")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		if err = h.CouponInteractor.Invalidate(ctx, id, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to invalidate coupon: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+func toCouponTemplate(id uuid.UUID, req types.CouponTemplateSaveReq) *domain.CouponTemplate {
+	return &domain.CouponTemplate{
+		ID:           id,
+		Name:         req.Name,
+		Type:         req.Type,
+		Value:        req.Value,
+		MaxDiscount:  req.MaxDiscount,
+		ProductID:    req.ProductID,
+		MinSpend:     req.MinSpend,
+		ValidityType: req.ValidityType,
+		ValidFrom:    req.ValidFrom,
+		ValidTo:      req.ValidTo,
+		ValidDays:    req.ValidDays,
+		TotalQty:     req.TotalQty,
+		Description:  req.Description,
+		Enabled:      req.Enabled,
+	}
+}
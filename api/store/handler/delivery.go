@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/store/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type DeliveryHandler struct {
+	DeliverySettingInteractor domain.DeliverySettingInteractor
+}
+
+func NewDeliveryHandler(deliverySettingInteractor domain.DeliverySettingInteractor) *DeliveryHandler {
+	return &DeliveryHandler{
+		DeliverySettingInteractor: deliverySettingInteractor,
+	}
+}
+
+func (h *DeliveryHandler) Routes(r gin.IRouter) {
+	r = r.Group("delivery")
+	r.GET("/platforms", h.Platforms())
+	r.GET("/stores", h.ListStores())
+	r.PUT("/stores", h.SaveStore())
+	r.DELETE("/stores/:id", h.DeleteStore())
+	r.POST("/menu/push", h.PushMenu())
+}
+
+func (h *DeliveryHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Platforms
+//
+//	@Tags		三方外卖
+//	@Security	BearerAuth
+//	@Summary	已接入的外卖平台
+//	@Success	200	{object}	[]domain.DeliveryPlatformCode	"成功"
+//	@Router		/delivery/platforms [get]
+func (h *DeliveryHandler) Platforms() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DeliveryHandler.Platforms")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		response.Ok(c, h.DeliverySettingInteractor.Platforms(ctx))
+	}
+}
+
+// ListStores
+//
+//	@Tags		三方外卖
+//	@Security	BearerAuth
+//	@Summary	查询本门店的外卖平台绑定
+//	@Success	200	{object}	[]domain.DeliveryStore	"成功"
+//	@Router		/delivery/stores [get]
+func (h *DeliveryHandler) ListStores() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DeliveryHandler.ListStores")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		user := domain.FromStoreUserContext(ctx)
+		stores, err := h.DeliverySettingInteractor.ListStores(ctx, user.MerchantID, user.StoreID)
+		if err != nil {
+			err = fmt.Errorf("failed to list delivery stores: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, stores)
+	}
+}
+
+// SaveStore
+//
+//	@Tags		三方外卖
+//	@Security	BearerAuth
+//	@Summary	保存外卖平台绑定及自动接单规则
+//	@Description	保存前会向平台校验店铺；同一平台每个门店只能绑定一个店铺
+//	@Param		data	body		types.DeliveryStoreSaveReq	true	"请求信息"
+//	@Success	200		{object}	domain.DeliveryStore		"成功"
+//	@Router		/delivery/stores [put]
+func (h *DeliveryHandler) SaveStore() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DeliveryHandler.SaveStore")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.DeliveryStoreSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		store := &domain.DeliveryStore{
+			ID:                  req.ID,
+			MerchantID:          user.MerchantID,
+			StoreID:             user.StoreID,
+			Platform:            req.Platform,
+			PlatformStoreID:     req.PlatformStoreID,
+			Enabled:             req.Enabled,
+			AutoAccept:          req.AutoAccept,
+			AutoAcceptMaxAmount: req.AutoAcceptMaxAmount,
+		}
+		if err := h.DeliverySettingInteractor.SaveStore(ctx, store); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to save delivery store: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, store)
+	}
+}
+
+// DeleteStore
+//
+//	@Tags		三方外卖
+//	@Security	BearerAuth
+//	@Summary	解除外卖平台绑定
+//	@Param		id	path	string	true	"绑定ID"
+//	@Success	200
+//	@Router		/delivery/stores/{id} [delete]
+func (h *DeliveryHandler) DeleteStore() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DeliveryHandler.DeleteStore")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		if err := h.DeliverySettingInteractor.DeleteStore(ctx, user.MerchantID, user.StoreID, id); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to delete delivery store: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// PushMenu
+//
+//	@Tags		三方外卖
+//	@Security	BearerAuth
+//	@Summary	将本门店三方外卖渠道菜单全量推送到平台
+//	@Description	只推送已配置商品映射的商品
+//	@Param		data	body	types.DeliveryMenuPushReq	true	"请求信息"
+//	@Success	200
+//	@Router		/delivery/menu/push [post]
+func (h *DeliveryHandler) PushMenu() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DeliveryHandler.PushMenu")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.DeliveryMenuPushReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		if err := h.DeliverySettingInteractor.PushMenu(ctx, user.MerchantID, user.StoreID, req.Platform); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to push delivery menu: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
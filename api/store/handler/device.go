@@ -33,6 +33,7 @@ func (h *DeviceHandler) Routes(r gin.IRouter) {
 	r.GET("", h.List())
 	r.PUT("/:id/enable", h.Enable())
 	r.PUT("/:id/disable", h.Disable())
+	r.PUT("/:id/kiosk_token", h.ResetKioskToken())
 }
 
 // Create 创建设备
@@ -86,6 +87,9 @@ func (h *DeviceHandler) Create() gin.HandlerFunc {
 			device.DiningWays = req.DevicePrint.DiningWays
 			device.DeviceStallPrintType = req.DevicePrint.DeviceStallPrintType
 			device.DeviceStallReceiptType = req.DevicePrint.DeviceStallReceiptType
+			device.PrintLocale = req.DevicePrint.PrintLocale
+		case domain.DeviceTypeKiosk:
+			device.KioskPayModes = req.DeviceKiosk.PayModes
 		default:
 			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, fmt.Errorf("device type %s is not supported", req.DeviceType)))
 			return
@@ -155,6 +159,9 @@ func (h *DeviceHandler) Update() gin.HandlerFunc {
 			device.DiningWays = req.DevicePrint.DiningWays
 			device.DeviceStallPrintType = req.DevicePrint.DeviceStallPrintType
 			device.DeviceStallReceiptType = req.DevicePrint.DeviceStallReceiptType
+			device.PrintLocale = req.DevicePrint.PrintLocale
+		case domain.DeviceTypeKiosk:
+			device.KioskPayModes = req.DeviceKiosk.PayModes
 		default:
 			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, fmt.Errorf("device type %s is not supported", req.DeviceType)))
 			return
@@ -360,6 +367,41 @@ func (h *DeviceHandler) Disable() gin.HandlerFunc {
 		response.Ok(c, nil)
 	}
 }
+
+// ResetKioskToken 重新生成自助点餐机访问令牌
+//
+//	@Tags			设备管理
+//	@Security		BearerAuth
+//	@Summary		重新生成自助点餐机访问令牌
+//	@Description	生成后旧令牌立即失效，令牌仅在生成时返回一次
+//	@Produce		json
+//	@Param			id	path		string	true	"设备ID"
+//	@Success		200	{object}	response.Response{data=types.DeviceKioskTokenResp}
+//	@Router			/restaurant/device/{id}/kiosk_token [put]
+func (h *DeviceHandler) ResetKioskToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DeviceHandler.ResetKioskToken")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		token, err := h.DeviceInteractor.ResetKioskToken(ctx, id, user)
+		if err != nil {
+			c.Error(h.checkErr(err))
+			return
+		}
+
+		response.Ok(c, types.DeviceKioskTokenResp{Token: token})
+	}
+}
+
 func (h *DeviceHandler) checkErr(err error) error {
 	switch {
 	case errors.Is(err, domain.ErrDeviceNotExists):
@@ -368,6 +410,8 @@ func (h *DeviceHandler) checkErr(err error) error {
 		return errorx.New(http.StatusConflict, errcode.DeviceNameExists, err)
 	case errors.Is(err, domain.ErrDeviceCodeExists):
 		return errorx.New(http.StatusConflict, errcode.DeviceCodeExists, err)
+	case errors.Is(err, domain.ErrDeviceNotKiosk):
+		return errorx.New(http.StatusBadRequest, errcode.DeviceNotKiosk, err)
 	case domain.IsNotFound(err):
 		return errorx.New(http.StatusNotFound, errcode.NotFound, err)
 	case domain.IsParamsError(err):
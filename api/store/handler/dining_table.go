@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/store/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type DiningTableHandler struct {
+	DiningTableInteractor domain.DiningTableInteractor
+}
+
+func NewDiningTableHandler(diningTableInteractor domain.DiningTableInteractor) *DiningTableHandler {
+	return &DiningTableHandler{
+		DiningTableInteractor: diningTableInteractor,
+	}
+}
+
+func (h *DiningTableHandler) Routes(r gin.IRouter) {
+	r = r.Group("dining_table")
+	r.POST("", h.Create())
+	r.PUT("/:id", h.Update())
+	r.DELETE("/:id", h.Delete())
+	r.GET("", h.List())
+	r.PUT("/:id/qr_token", h.ResetQRToken())
+}
+
+func (h *DiningTableHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Create
+//
+//	@Tags		桌台管理
+//	@Security	BearerAuth
+//	@Summary	创建桌台
+//	@Param		data	body		types.DiningTableSaveReq	true	"请求信息"
+//	@Success	200		{object}	domain.DiningTable			"成功"
+//	@Router		/dining_table [post]
+func (h *DiningTableHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DiningTableHandler.Create")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.DiningTableSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		table := &domain.DiningTable{
+			Name:      req.Name,
+			Seats:     req.Seats,
+			Enabled:   req.Enabled,
+			SortOrder: req.SortOrder,
+		}
+		if err := h.DiningTableInteractor.Create(ctx, table, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to create dining table: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, table)
+	}
+}
+
+// Update
+//
+//	@Tags		桌台管理
+//	@Security	BearerAuth
+//	@Summary	更新桌台
+//	@Param		id		path	string						true	"桌台ID"
+//	@Param		data	body	types.DiningTableSaveReq	true	"请求信息"
+//	@Success	200
+//	@Router		/dining_table/{id} [put]
+func (h *DiningTableHandler) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DiningTableHandler.Update")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.DiningTableSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		table := &domain.DiningTable{
+			ID:        id,
+			Name:      req.Name,
+			Seats:     req.Seats,
+			Enabled:   req.Enabled,
+			SortOrder: req.SortOrder,
+		}
+		if err := h.DiningTableInteractor.Update(ctx, table, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to update dining table: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// Delete
+//
+//	@Tags		桌台管理
+//	@Security	BearerAuth
+//	@Summary	删除桌台
+//	@Param		id	path	string	true	"桌台ID"
+//	@Success	200
+//	@Router		/dining_table/{id} [delete]
+func (h *DiningTableHandler) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DiningTableHandler.Delete")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		if err := h.DiningTableInteractor.Delete(ctx, id, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to delete dining table: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// List
+//
+//	@Tags		桌台管理
+//	@Security	BearerAuth
+//	@Summary	查询门店桌台
+//	@Success	200	{object}	[]domain.DiningTable	"成功"
+//	@Router		/dining_table [get]
+func (h *DiningTableHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DiningTableHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		user := domain.FromStoreUserContext(ctx)
+		tables, err := h.DiningTableInteractor.List(ctx, user)
+		if err != nil {
+			err = fmt.Errorf("failed to list dining tables: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, tables)
+	}
+}
+
+// ResetQRToken
+//
+//	@Tags		桌台管理
+//	@Security	BearerAuth
+//	@Summary	重新生成桌台二维码
+//	@Param		id	path		string				true	"桌台ID"
+//	@Success	200	{object}	domain.DiningTable	"成功"
+//	@Router		/dining_table/{id}/qr_token [put]
+func (h *DiningTableHandler) ResetQRToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("DiningTableHandler.ResetQRToken")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		table, err := h.DiningTableInteractor.ResetQRToken(ctx, id, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to reset dining table qr token: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, table)
+	}
+}
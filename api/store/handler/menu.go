@@ -33,6 +33,11 @@ func (h *MenuHandler) Routes(r gin.IRouter) {
 	r.DELETE("/:id", h.Delete())
 	r.GET("/:id", h.GetDetail())
 	r.GET("", h.List())
+	r.GET("/:id/draft", h.GetDraft())
+	r.POST("/:id/publish", h.Publish())
+	r.DELETE("/:id/publish", h.CancelScheduledPublish())
+	r.POST("/:id/rollback", h.Rollback())
+	r.GET("/:id/versions", h.ListVersions())
 }
 
 func (h *MenuHandler) NoAuths() []string {
@@ -82,10 +87,11 @@ func (h *MenuHandler) Create() gin.HandlerFunc {
 			}
 			productIDMap[itemReq.ProductID] = struct{}{}
 			menu.Items = append(menu.Items, &domain.MenuItem{
-				ID:          uuid.New(),
-				ProductID:   itemReq.ProductID,
-				BasePrice:   itemReq.BasePrice,
-				MemberPrice: itemReq.MemberPrice,
+				ID:           uuid.New(),
+				ProductID:    itemReq.ProductID,
+				BasePrice:    itemReq.BasePrice,
+				MemberPrice:  itemReq.MemberPrice,
+				PeriodPrices: itemReq.PeriodPrices,
 			})
 		}
 
@@ -112,7 +118,7 @@ func (h *MenuHandler) Create() gin.HandlerFunc {
 //
 //	@Tags		菜单管理
 //	@Security	BearerAuth
-//	@Summary	更新菜单
+//	@Summary	更新菜单（保存为草稿，发布后门店生效）
 //	@Param		id		path	string				true	"菜单ID"
 //	@Param		data	body	types.MenuUpdateReq	true	"请求信息"
 //	@Success	200
@@ -160,10 +166,11 @@ func (h *MenuHandler) Update() gin.HandlerFunc {
 			}
 			productIDMap[itemReq.ProductID] = struct{}{}
 			menu.Items = append(menu.Items, &domain.MenuItem{
-				ID:          uuid.New(),
-				ProductID:   itemReq.ProductID,
-				BasePrice:   itemReq.BasePrice,
-				MemberPrice: itemReq.MemberPrice,
+				ID:           uuid.New(),
+				ProductID:    itemReq.ProductID,
+				BasePrice:    itemReq.BasePrice,
+				MemberPrice:  itemReq.MemberPrice,
+				PeriodPrices: itemReq.PeriodPrices,
 			})
 		}
 
@@ -310,3 +317,205 @@ func (h *MenuHandler) List() gin.HandlerFunc {
 		response.Ok(c, res)
 	}
 }
+
+// GetDraft
+//
+//	@Tags		菜单管理
+//	@Security	BearerAuth
+//	@Summary	预览菜单草稿
+//	@Param		id	path		string				true	"菜单ID"
+//	@Success	200	{object}	domain.MenuVersion	"成功"
+//	@Router		/menu/{id}/draft [get]
+func (h *MenuHandler) GetDraft() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MenuHandler.GetDraft")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		// 从路径参数获取菜单ID
+		idStr := c.Param("id")
+		menuID, err := uuid.Parse(idStr)
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		draft, err := h.MenuInteractor.GetDraft(ctx, menuID, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to get menu draft: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, draft)
+	}
+}
+
+// Publish
+//
+//	@Tags		菜单管理
+//	@Security	BearerAuth
+//	@Summary	发布菜单草稿（立即发布或定时发布）
+//	@Param		id		path	string					true	"菜单ID"
+//	@Param		data	body	types.MenuPublishReq	true	"请求信息"
+//	@Success	200
+//	@Router		/menu/{id}/publish [post]
+func (h *MenuHandler) Publish() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MenuHandler.Publish")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		// 从路径参数获取菜单ID
+		idStr := c.Param("id")
+		menuID, err := uuid.Parse(idStr)
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.MenuPublishReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		err = h.MenuInteractor.Publish(ctx, menuID, req.ScheduledAt, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to publish menu: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// CancelScheduledPublish
+//
+//	@Tags		菜单管理
+//	@Security	BearerAuth
+//	@Summary	取消菜单定时发布
+//	@Param		id	path	string	true	"菜单ID"
+//	@Success	200
+//	@Router		/menu/{id}/publish [delete]
+func (h *MenuHandler) CancelScheduledPublish() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MenuHandler.CancelScheduledPublish")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		// 从路径参数获取菜单ID
+		idStr := c.Param("id")
+		menuID, err := uuid.Parse(idStr)
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		err = h.MenuInteractor.CancelScheduledPublish(ctx, menuID, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to cancel scheduled menu publish: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// Rollback
+//
+//	@Tags		菜单管理
+//	@Security	BearerAuth
+//	@Summary	回滚到上一个发布版本
+//	@Param		id	path	string	true	"菜单ID"
+//	@Success	200
+//	@Router		/menu/{id}/rollback [post]
+func (h *MenuHandler) Rollback() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MenuHandler.Rollback")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		// 从路径参数获取菜单ID
+		idStr := c.Param("id")
+		menuID, err := uuid.Parse(idStr)
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		err = h.MenuInteractor.Rollback(ctx, menuID, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to rollback menu: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// ListVersions
+//
+//	@Tags		菜单管理
+//	@Security	BearerAuth
+//	@Summary	查询菜单版本列表
+//	@Param		id	path		string				true	"菜单ID"
+//	@Success	200	{object}	domain.MenuVersions	"成功"
+//	@Router		/menu/{id}/versions [get]
+func (h *MenuHandler) ListVersions() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("MenuHandler.ListVersions")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		// 从路径参数获取菜单ID
+		idStr := c.Param("id")
+		menuID, err := uuid.Parse(idStr)
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		versions, err := h.MenuInteractor.ListVersions(ctx, menuID, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to list menu versions: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, versions)
+	}
+}
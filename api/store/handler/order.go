@@ -102,6 +102,7 @@ func (h *OrderHandler) SalesReport() gin.HandlerFunc {
 //	@Param		category_id			query		string							false	"商品分类ID"
 //	@Param		product_name		query		string							false	"商品名称（模糊搜索）"
 //	@Param		product_type		query		string							false	"商品类型：normal/set_meal"
+//	@Param		set_meal_components	query		bool							false	"是否按套餐子商品汇总"
 //	@Param		page				query		int								false	"页码"
 //	@Param		size				query		int								false	"每页数量"
 //	@Success	200					{object}	types.ProductSalesSummaryResp	"成功"
@@ -133,6 +134,7 @@ func (h *OrderHandler) ProductSalesSummary() gin.HandlerFunc {
 			CategoryID:        categoryID,
 			ProductName:       req.ProductName,
 			ProductType:       domain.ProductType(req.ProductType),
+			SetMealComponents: req.SetMealComponents,
 			Page:              req.Page,
 			Size:              req.Size,
 		}
@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/api/store/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type PriceChangeHandler struct {
+	PriceChangeInteractor domain.PriceChangeInteractor
+}
+
+func NewPriceChangeHandler(priceChangeInteractor domain.PriceChangeInteractor) *PriceChangeHandler {
+	return &PriceChangeHandler{
+		PriceChangeInteractor: priceChangeInteractor,
+	}
+}
+
+func (h *PriceChangeHandler) Routes(r gin.IRouter) {
+	r = r.Group("product/price_change")
+	r.POST("", h.Create())
+	r.GET("", h.List())
+	r.GET("/:id", h.GetDetail())
+	r.PUT("/:id/cancel", h.Cancel())
+	r.PUT("/:id/rollback", h.Rollback())
+}
+
+func (h *PriceChangeHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Create
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	创建定时调价批次
+//	@Param		data	body	types.PriceChangeCreateReq	true	"请求信息"
+//	@Success	200
+//	@Router		/product/price_change [post]
+func (h *PriceChangeHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PriceChangeHandler.Create")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PriceChangeCreateReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		batch := &domain.PriceChangeBatch{
+			ID:           uuid.New(),
+			Name:         req.Name,
+			MerchantID:   user.MerchantID,
+			StoreID:      user.StoreID,
+			EffectiveAt:  req.EffectiveAt,
+			Remark:       req.Remark,
+			OperatorID:   user.ID,
+			OperatorName: user.Nickname,
+			Items: lo.Map(req.Items, func(item types.PriceChangeItemCreateReq, _ int) *domain.PriceChangeItem {
+				return &domain.PriceChangeItem{
+					TargetType: item.TargetType,
+					ProductID:  item.ProductID,
+					SpecID:     item.SpecID,
+					NewPrice:   item.NewPrice,
+				}
+			}),
+		}
+
+		if err := h.PriceChangeInteractor.Create(ctx, batch, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to create price change batch: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// List
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	查询定时调价批次列表
+//	@Param		data	query		types.PriceChangeListReq		true	"请求信息"
+//	@Success	200		{object}	domain.PriceChangeSearchRes	"成功"
+//	@Router		/product/price_change [get]
+func (h *PriceChangeHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PriceChangeHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PriceChangeListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		page := upagination.New(req.Page, req.Size)
+		user := domain.FromStoreUserContext(ctx)
+		params := domain.PriceChangeSearchParams{
+			MerchantID: user.MerchantID,
+			StoreID:    user.StoreID,
+			Name:       req.Name,
+			Status:     req.Status,
+		}
+
+		res, err := h.PriceChangeInteractor.PagedListBySearch(ctx, page, params)
+		if err != nil {
+			err = fmt.Errorf("failed to list price change batches: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// GetDetail
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	获取定时调价批次详情
+//	@Param		id	path		string					true	"调价批次ID"
+//	@Success	200	{object}	domain.PriceChangeBatch	"成功"
+//	@Router		/product/price_change/{id} [get]
+func (h *PriceChangeHandler) GetDetail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PriceChangeHandler.GetDetail")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		batch, err := h.PriceChangeInteractor.GetDetail(ctx, id, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to get price change batch: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, batch)
+	}
+}
+
+// Cancel
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	取消定时调价批次
+//	@Param		id	path	string	true	"调价批次ID"
+//	@Success	200
+//	@Router		/product/price_change/{id}/cancel [put]
+func (h *PriceChangeHandler) Cancel() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PriceChangeHandler.Cancel")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		operator := domain.PriceChangeOperator{ID: user.ID, Name: user.Nickname}
+		if err = h.PriceChangeInteractor.Cancel(ctx, id, user, operator); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to cancel price change batch: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// Rollback
+//
+//	@Tags		商品管理
+//	@Security	BearerAuth
+//	@Summary	回滚定时调价批次
+//	@Param		id	path	string	true	"调价批次ID"
+//	@Success	200
+//	@Router		/product/price_change/{id}/rollback [put]
+func (h *PriceChangeHandler) Rollback() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PriceChangeHandler.Rollback")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		operator := domain.PriceChangeOperator{ID: user.ID, Name: user.Nickname}
+		if err = h.PriceChangeInteractor.Rollback(ctx, id, user, operator); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to rollback price change batch: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
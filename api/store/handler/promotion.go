@@ -0,0 +1,306 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/store/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type PromotionHandler struct {
+	PromotionInteractor domain.PromotionInteractor
+}
+
+func NewPromotionHandler(promotionInteractor domain.PromotionInteractor) *PromotionHandler {
+	return &PromotionHandler{
+		PromotionInteractor: promotionInteractor,
+	}
+}
+
+func (h *PromotionHandler) Routes(r gin.IRouter) {
+	r = r.Group("promotion")
+	r.POST("", h.Create())
+	r.PUT("/:id", h.Update())
+	r.DELETE("/:id", h.Delete())
+	r.GET("/:id", h.GetDetail())
+	r.GET("", h.List())
+	r.PUT("/:id/enable", h.Enable())
+	r.PUT("/:id/disable", h.Disable())
+}
+
+func (h *PromotionHandler) NoAuths() []string {
+	return []string{}
+}
+
+// Create
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	创建促销活动
+//	@Param		data	body	types.PromotionSaveReq	true	"请求信息"
+//	@Success	200
+//	@Router		/promotion [post]
+func (h *PromotionHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PromotionHandler.Create")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PromotionSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		promotion := toPromotion(uuid.New(), req)
+		if err := h.PromotionInteractor.Create(ctx, promotion, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to create promotion: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// Update
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	更新促销活动
+//	@Param		id		path	string					true	"活动ID"
+//	@Param		data	body	types.PromotionSaveReq	true	"请求信息"
+//	@Success	200
+//	@Router		/promotion/{id} [put]
+func (h *PromotionHandler) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PromotionHandler.Update")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.PromotionSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		promotion := toPromotion(id, req)
+		if err = h.PromotionInteractor.Update(ctx, promotion, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to update promotion: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// Delete
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	删除促销活动
+//	@Param		id	path	string	true	"活动ID"
+//	@Success	200
+//	@Router		/promotion/{id} [delete]
+func (h *PromotionHandler) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PromotionHandler.Delete")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		if err = h.PromotionInteractor.Delete(ctx, id, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to delete promotion: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+// GetDetail
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	获取促销活动详情
+//	@Param		id	path		string				true	"活动ID"
+//	@Success	200	{object}	domain.Promotion	"成功"
+//	@Router		/promotion/{id} [get]
+func (h *PromotionHandler) GetDetail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PromotionHandler.GetDetail")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		promotion, err := h.PromotionInteractor.GetDetail(ctx, id, user)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to get promotion: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, promotion)
+	}
+}
+
+// List
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	查询促销活动列表
+//	@Param		data	query		types.PromotionListReq		true	"请求信息"
+//	@Success	200		{object}	domain.PromotionSearchRes	"成功"
+//	@Router		/promotion [get]
+func (h *PromotionHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("PromotionHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.PromotionListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		page := upagination.New(req.Page, req.Size)
+		user := domain.FromStoreUserContext(ctx)
+		params := domain.PromotionSearchParams{
+			MerchantID: user.MerchantID,
+			StoreID:    user.StoreID,
+			Name:       req.Name,
+			Type:       req.Type,
+			Enabled:    req.Enabled,
+		}
+
+		res, err := h.PromotionInteractor.PagedListBySearch(ctx, page, params)
+		if err != nil {
+			err = fmt.Errorf("failed to list promotions: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Enable
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	启用促销活动
+//	@Param		id	path	string	true	"活动ID"
+//	@Success	200
+//	@Router		/promotion/{id}/enable [put]
+func (h *PromotionHandler) Enable() gin.HandlerFunc {
+	return h.setEnabled("PromotionHandler.Enable", true)
+}
+
+// Disable
+//
+//	@Tags		营销管理
+//	@Security	BearerAuth
+//	@Summary	停用促销活动
+//	@Param		id	path	string	true	"活动ID"
+//	@Success	200
+//	@Router		/promotion/{id}/disable [put]
+func (h *PromotionHandler) Disable() gin.HandlerFunc {
+	return h.setEnabled("PromotionHandler.Disable", false)
+}
+
+func (h *PromotionHandler) setEnabled(name string, enabled bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named(name)
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		if err = h.PromotionInteractor.SetEnabled(ctx, id, enabled, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to set promotion enabled: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
+
+func toPromotion(id uuid.UUID, req types.PromotionSaveReq) *domain.Promotion {
+	return &domain.Promotion{
+		ID:          id,
+		Name:        req.Name,
+		Type:        req.Type,
+		Rule:        req.Rule,
+		Channels:    req.Channels,
+		DiningWays:  req.DiningWays,
+		StartAt:     req.StartAt,
+		EndAt:       req.EndAt,
+		TimeWindows: req.TimeWindows,
+		Priority:    req.Priority,
+		Stackable:   req.Stackable,
+		Enabled:     req.Enabled,
+	}
+}
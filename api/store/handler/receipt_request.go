@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/store/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type ReceiptRequestHandler struct {
+	OrderReceiptInteractor domain.OrderReceiptInteractor
+}
+
+func NewReceiptRequestHandler(orderReceiptInteractor domain.OrderReceiptInteractor) *ReceiptRequestHandler {
+	return &ReceiptRequestHandler{
+		OrderReceiptInteractor: orderReceiptInteractor,
+	}
+}
+
+func (h *ReceiptRequestHandler) Routes(r gin.IRouter) {
+	r = r.Group("/receipt_request")
+	r.GET("", h.List())
+	r.PUT("/:id/handle", h.Handle())
+}
+
+func (h *ReceiptRequestHandler) NoAuths() []string {
+	return []string{}
+}
+
+// List
+//
+//	@Tags		电子小票
+//	@Security	BearerAuth
+//	@Summary	查询顾客退款/开票申请列表
+//	@Param		data	query		types.ReceiptRequestListReq		true	"请求信息"
+//	@Success	200		{object}	domain.ReceiptRequestSearchRes	"成功"
+//	@Router		/receipt_request [get]
+func (h *ReceiptRequestHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReceiptRequestHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ReceiptRequestListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		page := upagination.New(req.Page, req.Size)
+		user := domain.FromStoreUserContext(ctx)
+		params := domain.ReceiptRequestSearchParams{
+			Type:   req.Type,
+			Status: req.Status,
+		}
+
+		res, err := h.OrderReceiptInteractor.PagedListRequests(ctx, page, params, user)
+		if err != nil {
+			c.Error(fmt.Errorf("failed to list receipt requests: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Handle
+//
+//	@Tags		电子小票
+//	@Security	BearerAuth
+//	@Summary	处理顾客退款/开票申请
+//	@Description	同意退款申请后需在订单中按原流程发起退款；同意开票申请后需线下开具发票
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path	string							true	"申请ID"
+//	@Param		data	body	types.ReceiptRequestHandleReq	true	"请求信息"
+//	@Success	200		"No Content"
+//	@Router		/receipt_request/{id}/handle [put]
+func (h *ReceiptRequestHandler) Handle() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReceiptRequestHandler.Handle")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		var req types.ReceiptRequestHandleReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		if err := h.OrderReceiptInteractor.HandleRequest(ctx, id, req.Accepted, req.Remark, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to handle receipt request: %w", err))
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
@@ -64,6 +64,7 @@ func (h *RemarkHandler) Create() gin.HandlerFunc {
 		user := domain.FromStoreUserContext(ctx)
 		remark := &domain.CreateRemarkParams{
 			Name:        req.Name,
+			NameI18n:    req.NameI18n,
 			RemarkType:  domain.RemarkTypeStore,
 			Enabled:     req.Enabled,
 			SortOrder:   req.SortOrder,
@@ -115,6 +116,7 @@ func (h *RemarkHandler) Update() gin.HandlerFunc {
 		remark := &domain.UpdateRemarkParams{
 			ID:        id,
 			Name:      req.Name,
+			NameI18n:  req.NameI18n,
 			Enabled:   req.Enabled,
 			SortOrder: req.SortOrder,
 		}
@@ -0,0 +1,408 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/api/store/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type ReservationHandler struct {
+	ReservationRuleInteractor domain.ReservationRuleInteractor
+	ReservationInteractor     domain.ReservationInteractor
+}
+
+func NewReservationHandler(
+	reservationRuleInteractor domain.ReservationRuleInteractor,
+	reservationInteractor domain.ReservationInteractor,
+) *ReservationHandler {
+	return &ReservationHandler{
+		ReservationRuleInteractor: reservationRuleInteractor,
+		ReservationInteractor:     reservationInteractor,
+	}
+}
+
+func (h *ReservationHandler) Routes(r gin.IRouter) {
+	r = r.Group("/reservations")
+	r.GET("/rule", h.GetRule())
+	r.PUT("/rule", h.SaveRule())
+	r.GET("", h.List())
+	r.GET("/calendar", h.Calendar())
+	r.GET("/slots", h.Slots())
+	r.POST("", h.Create())
+	r.POST("/:id/deposit", h.CollectDeposit())
+	r.POST("/:id/cancel", h.Cancel())
+	r.POST("/:id/no_show", h.MarkNoShow())
+}
+
+func (h *ReservationHandler) NoAuths() []string {
+	return []string{}
+}
+
+// GetRule
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	查询预订规则，未配置时返回默认规则
+//	@Success	200	{object}	domain.ReservationRule	"成功"
+//	@Router		/reservations/rule [get]
+func (h *ReservationHandler) GetRule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.GetRule")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		user := domain.FromStoreUserContext(ctx)
+		rule, err := h.ReservationRuleInteractor.GetRule(ctx, user)
+		if err != nil {
+			c.Error(fmt.Errorf("failed to get reservation rule: %w", err))
+			return
+		}
+
+		response.Ok(c, rule)
+	}
+}
+
+// SaveRule
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	保存预订规则
+//	@Param		data	body		types.ReservationRuleSaveReq	true	"请求信息"
+//	@Success	200		{object}	domain.ReservationRule			"成功"
+//	@Router		/reservations/rule [put]
+func (h *ReservationHandler) SaveRule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.SaveRule")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ReservationRuleSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		rule := &domain.ReservationRule{
+			OnlineEnabled:     req.OnlineEnabled,
+			SlotMinutes:       req.SlotMinutes,
+			DiningMinutes:     req.DiningMinutes,
+			MinAdvanceMinutes: req.MinAdvanceMinutes,
+			MaxAdvanceDays:    req.MaxAdvanceDays,
+			MaxPartySize:      req.MaxPartySize,
+			DepositPerGuest:   req.DepositPerGuest,
+		}
+		user := domain.FromStoreUserContext(ctx)
+		if err := h.ReservationRuleInteractor.SaveRule(ctx, rule, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to save reservation rule: %w", err))
+			return
+		}
+
+		response.Ok(c, rule)
+	}
+}
+
+// List
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	预订列表：查询指定日期的预订，按到店时间升序
+//	@Produce	json
+//	@Param		data	query		types.ReservationListReq	true	"请求信息"
+//	@Success	200		{object}	[]domain.Reservation		"成功"
+//	@Router		/reservations [get]
+func (h *ReservationHandler) List() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.List")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ReservationListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		res, err := h.ReservationInteractor.List(ctx, user.MerchantID, user.StoreID, req.Date, req.Statuses)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list reservations: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Calendar
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	预订日历：按天汇总指定月份的预订数、人数和未到店数
+//	@Produce	json
+//	@Param		data	query		types.ReservationCalendarReq	true	"请求信息"
+//	@Success	200		{object}	[]domain.ReservationCalendarDay	"成功"
+//	@Router		/reservations/calendar [get]
+func (h *ReservationHandler) Calendar() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.Calendar")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ReservationCalendarReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		res, err := h.ReservationInteractor.Calendar(ctx, user.MerchantID, user.StoreID, req.Month)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to get reservation calendar: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Slots
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	查询可预订时段
+//	@Description	时段由门店营业时间、就餐时段和预订规则生成，按桌台座位数和已有预订计算空闲桌台
+//	@Produce	json
+//	@Param		data	query		types.ReservationSlotReq	true	"请求信息"
+//	@Success	200		{object}	[]domain.ReservationSlot	"成功"
+//	@Router		/reservations/slots [get]
+func (h *ReservationHandler) Slots() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.Slots")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ReservationSlotReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		res, err := h.ReservationInteractor.Slots(ctx, domain.ReservationSlotParams{
+			MerchantID: user.MerchantID,
+			StoreID:    user.StoreID,
+			Date:       req.Date,
+			PartySize:  req.PartySize,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list reservation slots: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Create
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	代客预订
+//	@Accept		json
+//	@Produce	json
+//	@Param		data	body		types.ReservationCreateReq	true	"请求信息"
+//	@Success	200		{object}	domain.Reservation			"成功"
+//	@Router		/reservations [post]
+func (h *ReservationHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.Create")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ReservationCreateReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		res, err := h.ReservationInteractor.Create(ctx, domain.ReservationCreateParams{
+			MerchantID:     user.MerchantID,
+			StoreID:        user.StoreID,
+			GuestName:      req.GuestName,
+			GuestPhone:     req.GuestPhone,
+			PartySize:      req.PartySize,
+			ReservedAt:     req.ReservedAt,
+			TableID:        req.TableID,
+			AreaPreference: req.AreaPreference,
+			Remark:         req.Remark,
+			Deposit:        req.Deposit,
+		})
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to create reservation: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// CollectDeposit
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	收取预订定金
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string						true	"预订ID"
+//	@Param		data	body		types.ReservationDepositReq	true	"请求信息"
+//	@Success	200		{object}	domain.Reservation			"成功"
+//	@Router		/reservations/{id}/deposit [post]
+func (h *ReservationHandler) CollectDeposit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.CollectDeposit")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.ReservationDepositReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		res, err := h.ReservationInteractor.CollectDeposit(ctx, user.MerchantID, user.StoreID, id, req.PaymentMethod)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to collect reservation deposit: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// Cancel
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	取消预订
+//	@Description	已收取的定金标记为已退还，由门店线下退还
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string						true	"预订ID"
+//	@Param		data	body		types.ReservationCancelReq	true	"请求信息"
+//	@Success	200		{object}	domain.Reservation			"成功"
+//	@Router		/reservations/{id}/cancel [post]
+func (h *ReservationHandler) Cancel() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.Cancel")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+		var req types.ReservationCancelReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		res, err := h.ReservationInteractor.Cancel(ctx, user.MerchantID, user.StoreID, id, req.Reason)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to cancel reservation: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
+
+// MarkNoShow
+//
+//	@Tags		预订
+//	@Security	BearerAuth
+//	@Summary	标记未到店，已收取的定金不予退还
+//	@Produce	json
+//	@Param		id	path		string				true	"预订ID"
+//	@Success	200	{object}	domain.Reservation	"成功"
+//	@Router		/reservations/{id}/no_show [post]
+func (h *ReservationHandler) MarkNoShow() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ReservationHandler.MarkNoShow")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		res, err := h.ReservationInteractor.MarkNoShow(ctx, user.MerchantID, user.StoreID, id)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to mark reservation no-show: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/store/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type ScanOrderHandler struct {
+	ScanOrderRuleInteractor domain.ScanOrderRuleInteractor
+}
+
+func NewScanOrderHandler(scanOrderRuleInteractor domain.ScanOrderRuleInteractor) *ScanOrderHandler {
+	return &ScanOrderHandler{
+		ScanOrderRuleInteractor: scanOrderRuleInteractor,
+	}
+}
+
+func (h *ScanOrderHandler) Routes(r gin.IRouter) {
+	r = r.Group("scan_order")
+	r.GET("/rule", h.GetRule())
+	r.PUT("/rule", h.SaveRule())
+	r.DELETE("/rule", h.DeleteRule())
+}
+
+func (h *ScanOrderHandler) NoAuths() []string {
+	return []string{}
+}
+
+// GetRule
+//
+//	@Tags		扫码点餐
+//	@Security	BearerAuth
+//	@Summary	查询扫码点餐规则
+//	@Success	200	{object}	domain.ScanOrderRule	"成功"
+//	@Router		/scan_order/rule [get]
+func (h *ScanOrderHandler) GetRule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ScanOrderHandler.GetRule")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		user := domain.FromStoreUserContext(ctx)
+		rule, err := h.ScanOrderRuleInteractor.GetRule(ctx, user)
+		if err != nil {
+			err = fmt.Errorf("failed to get scan order rule: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, rule)
+	}
+}
+
+// SaveRule
+//
+//	@Tags		扫码点餐
+//	@Security	BearerAuth
+//	@Summary	保存扫码点餐规则
+//	@Param		data	body		types.ScanOrderRuleSaveReq	true	"请求信息"
+//	@Success	200		{object}	domain.ScanOrderRule		"成功"
+//	@Router		/scan_order/rule [put]
+func (h *ScanOrderHandler) SaveRule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ScanOrderHandler.SaveRule")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.ScanOrderRuleSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		rule := &domain.ScanOrderRule{
+			Enabled: req.Enabled,
+			PayMode: req.PayMode,
+		}
+		user := domain.FromStoreUserContext(ctx)
+		if err := h.ScanOrderRuleInteractor.SaveRule(ctx, rule, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to save scan order rule: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, rule)
+	}
+}
+
+// DeleteRule
+//
+//	@Tags		扫码点餐
+//	@Security	BearerAuth
+//	@Summary	删除门店扫码点餐规则，恢复使用品牌默认规则
+//	@Success	200
+//	@Router		/scan_order/rule [delete]
+func (h *ScanOrderHandler) DeleteRule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("ScanOrderHandler.DeleteRule")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		user := domain.FromStoreUserContext(ctx)
+		if err := h.ScanOrderRuleInteractor.DeleteRule(ctx, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			err = fmt.Errorf("failed to delete scan order rule: %w", err)
+			c.Error(err)
+			return
+		}
+
+		response.Ok(c, nil)
+	}
+}
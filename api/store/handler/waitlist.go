@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.jiguang.dev/pos-dine/dine/api/store/types"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/errorx/errcode"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/ugin/response"
+)
+
+type WaitlistHandler struct {
+	WaitlistRuleInteractor domain.WaitlistRuleInteractor
+	WaitlistInteractor     domain.WaitlistInteractor
+}
+
+func NewWaitlistHandler(
+	waitlistRuleInteractor domain.WaitlistRuleInteractor,
+	waitlistInteractor domain.WaitlistInteractor,
+) *WaitlistHandler {
+	return &WaitlistHandler{
+		WaitlistRuleInteractor: waitlistRuleInteractor,
+		WaitlistInteractor:     waitlistInteractor,
+	}
+}
+
+func (h *WaitlistHandler) Routes(r gin.IRouter) {
+	r = r.Group("/waitlist")
+	r.GET("/rule", h.GetRule())
+	r.PUT("/rule", h.SaveRule())
+	r.GET("/tickets", h.ListTickets())
+}
+
+func (h *WaitlistHandler) NoAuths() []string {
+	return []string{}
+}
+
+// GetRule
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	查询排队规则，未配置时返回默认规则
+//	@Success	200	{object}	domain.WaitlistRule	"成功"
+//	@Router		/waitlist/rule [get]
+func (h *WaitlistHandler) GetRule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.GetRule")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		user := domain.FromStoreUserContext(ctx)
+		rule, err := h.WaitlistRuleInteractor.GetRule(ctx, user)
+		if err != nil {
+			c.Error(fmt.Errorf("failed to get waitlist rule: %w", err))
+			return
+		}
+
+		response.Ok(c, rule)
+	}
+}
+
+// SaveRule
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	保存排队规则
+//	@Param		data	body		types.WaitlistRuleSaveReq	true	"请求信息"
+//	@Success	200		{object}	domain.WaitlistRule			"成功"
+//	@Router		/waitlist/rule [put]
+func (h *WaitlistHandler) SaveRule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.SaveRule")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.WaitlistRuleSaveReq
+		if err := c.ShouldBind(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		rule := &domain.WaitlistRule{
+			OnlineEnabled:      req.OnlineEnabled,
+			SmallMaxSize:       req.SmallMaxSize,
+			MediumMaxSize:      req.MediumMaxSize,
+			MaxPartySize:       req.MaxPartySize,
+			DefaultTurnMinutes: req.DefaultTurnMinutes,
+		}
+		user := domain.FromStoreUserContext(ctx)
+		if err := h.WaitlistRuleInteractor.SaveRule(ctx, rule, user); err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to save waitlist rule: %w", err))
+			return
+		}
+
+		response.Ok(c, rule)
+	}
+}
+
+// ListTickets
+//
+//	@Tags		排队
+//	@Security	BearerAuth
+//	@Summary	排队记录：查询指定营业日的排队号，按取号时间升序
+//	@Produce	json
+//	@Param		data	query		types.WaitlistTicketListReq	true	"请求信息"
+//	@Success	200		{object}	[]domain.WaitlistTicket		"成功"
+//	@Router		/waitlist/tickets [get]
+func (h *WaitlistHandler) ListTickets() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger := logging.FromContext(ctx).Named("WaitlistHandler.ListTickets")
+		ctx = logging.NewContext(ctx, logger)
+		c.Request = c.Request.Clone(ctx)
+
+		var req types.WaitlistTicketListReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+			return
+		}
+
+		user := domain.FromStoreUserContext(ctx)
+		res, err := h.WaitlistInteractor.List(ctx, user.MerchantID, user.StoreID, req.Date, req.Statuses)
+		if err != nil {
+			if domain.IsParamsError(err) {
+				c.Error(errorx.New(http.StatusBadRequest, errcode.InvalidParams, err))
+				return
+			}
+			c.Error(fmt.Errorf("failed to list waitlist tickets: %w", err))
+			return
+		}
+
+		response.Ok(c, res)
+	}
+}
@@ -62,12 +62,24 @@ var Module = fx.Module(
 		asHandler(handler.NewPaymentMethodHandler),
 		asHandler(handler.NewBusinessConfigHandler),
 		asHandler(handler.NewRouterMenuHandler),
+		asHandler(handler.NewPriceChangeHandler),
+		asHandler(handler.NewPromotionHandler),
+		asHandler(handler.NewCouponHandler),
+		asHandler(handler.NewProductVersionHandler),
 		asHandler(handler.NewAdditionalFeeHandler),
 		asHandler(handler.NewTaxFeeHandler),
 		asHandler(handler.NewRemarkHandler),
 		asHandler(handler.NewStallHandler),
 		asHandler(handler.NewOrderHandler),
 		asHandler(handler.NewOrderHandler),
+		asHandler(handler.NewDiningTableHandler),
+		asHandler(handler.NewScanOrderHandler),
+		asHandler(handler.NewPickupHandler),
+		asHandler(handler.NewReceiptRequestHandler),
+		asHandler(handler.NewDeliveryHandler),
+		asHandler(handler.NewReservationHandler),
+		asHandler(handler.NewWaitlistHandler),
+		asHandler(handler.NewPreorderHandler),
 	),
 )
 
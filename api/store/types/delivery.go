@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// DeliveryStoreSaveReq 保存外卖平台门店绑定请求
+type DeliveryStoreSaveReq struct {
+	ID                  uuid.UUID                   `json:"id"`                                                   // 绑定ID，为空时新增
+	Platform            domain.DeliveryPlatformCode `json:"platform" binding:"required,oneof=grabfood foodpanda"` // 外卖平台，修改时不可变更
+	PlatformStoreID     string                      `json:"platform_store_id" binding:"required,max=100"`         // 平台门店ID
+	Enabled             bool                        `json:"enabled"`                                              // 是否接收平台订单
+	AutoAccept          bool                        `json:"auto_accept"`                                          // 是否自动接单
+	AutoAcceptMaxAmount decimal.Decimal             `json:"auto_accept_max_amount"`                               // 自动接单金额上限，为0不限制
+}
+
+// DeliveryMenuPushReq 推送外卖菜单请求
+type DeliveryMenuPushReq struct {
+	Platform domain.DeliveryPlatformCode `json:"platform" binding:"required,oneof=grabfood foodpanda"` // 外卖平台
+}
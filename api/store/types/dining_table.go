@@ -0,0 +1,9 @@
+package types
+
+// DiningTableSaveReq 创建/更新桌台请求
+type DiningTableSaveReq struct {
+	Name      string `json:"name" binding:"required,max=20"`       // 桌台名称
+	Seats     int    `json:"seats" binding:"omitempty,gte=0"`      // 座位数
+	Enabled   bool   `json:"enabled"`                              // 是否启用
+	SortOrder int    `json:"sort_order" binding:"omitempty,gte=0"` // 排序
+}
@@ -0,0 +1,8 @@
+package types
+
+// PickupRuleSaveReq 保存取餐号规则请求
+type PickupRuleSaveReq struct {
+	Prefix string `json:"prefix" binding:"omitempty,max=4,alphanum"`     // 号码前缀，最多4位字母或数字
+	Start  int    `json:"start" binding:"required,min=1"`                // 起始号
+	End    int    `json:"end" binding:"required,gtfield=Start,max=9999"` // 结束号
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// PreorderRuleSaveReq 保存预点单规则请求
+type PreorderRuleSaveReq struct {
+	Enabled         bool `json:"enabled"`                                         // 是否开放顾客预点单
+	DeliveryEnabled bool `json:"delivery_enabled"`                                // 是否支持预约外送
+	SlotMinutes     int  `json:"slot_minutes" binding:"required,min=5,max=120"`   // 时段间隔（分钟），需为5的倍数
+	SlotCapacity    int  `json:"slot_capacity" binding:"required,min=1,max=1000"` // 每个时段可接单数
+	LeadMinutes     int  `json:"lead_minutes" binding:"min=0,max=1440"`           // 下单需至少提前的分钟数
+	PrepMinutes     int  `json:"prep_minutes" binding:"min=0,max=240"`            // 备餐提前量：预约时间前多少分钟下发后厨
+	MaxAdvanceDays  int  `json:"max_advance_days" binding:"min=0,max=14"`         // 最多可提前预约的天数，0为仅限当天
+}
+
+// PreorderListReq 预点单列表请求
+type PreorderListReq struct {
+	Date     string                  `form:"date" binding:"required,datetime=2006-01-02"`                          // 取餐/送达日期
+	Statuses []domain.PreorderStatus `form:"statuses" binding:"omitempty,dive,oneof=scheduled released cancelled"` // 状态（可选，可多选）
+}
+
+// PreorderCancelReq 取消预点单请求
+type PreorderCancelReq struct {
+	Reason string `json:"reason" binding:"max=255"` // 取消原因（可选）
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"mime/multipart"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,12 +12,13 @@ import (
 // ProductCreateReq 创建商品请求
 type ProductCreateReq struct {
 	// 基础信息
-	Name         string                      `json:"name" binding:"required,max=255"` // 商品名称（必选）
-	CategoryID   uuid.UUID                   `json:"category_id" binding:"required"`  // 分类ID（必选）
-	MenuID       *uuid.UUID                  `json:"menu_id,omitempty"`               // 菜单ID（可选）
-	Mnemonic     string                      `json:"mnemonic,omitempty"`              // 助记词（可选）
-	ShelfLife    int                         `json:"shelf_life,omitempty"`            // 保质期（可选，单位：天）
-	SupportTypes []domain.ProductSupportType `json:"support_types,omitempty"`         // 支持类型（可选，堂食、外带）
+	Name         string                      `json:"name" binding:"required,max=255"`                                                           // 商品名称（必选）
+	NameI18n     domain.LocalizedText        `json:"name_i18n,omitempty" binding:"omitempty,dive,keys,oneof=en-US zh-CN ms-MY,endkeys,max=255"` // 商品名称多语言（可选，键为 en-US、zh-CN、ms-MY）
+	CategoryID   uuid.UUID                   `json:"category_id" binding:"required"`                                                            // 分类ID（必选）
+	MenuID       *uuid.UUID                  `json:"menu_id,omitempty"`                                                                         // 菜单ID（可选）
+	Mnemonic     string                      `json:"mnemonic,omitempty"`                                                                        // 助记词（可选）
+	ShelfLife    int                         `json:"shelf_life,omitempty"`                                                                      // 保质期（可选，单位：天）
+	SupportTypes []domain.ProductSupportType `json:"support_types,omitempty"`                                                                   // 支持类型（可选，堂食、外带）
 
 	// 属性关联
 	UnitID uuid.UUID `json:"unit_id" binding:"required"` // 单位ID（必选）
@@ -37,35 +39,47 @@ type ProductCreateReq struct {
 	StallID        *uuid.UUID `json:"stall_id,omitempty"`    // 指定出品部门ID（可选）
 
 	// 展示信息
-	MainImage    string   `json:"main_image,omitempty"`    // 主图（可选）
-	DetailImages []string `json:"detail_images,omitempty"` // 详情图片（可选，多张）
-	Description  string   `json:"description,omitempty"`   // 菜品描述（可选）
+	MainImage       string               `json:"main_image,omitempty"`                                                                              // 主图（可选）
+	DetailImages    []string             `json:"detail_images,omitempty"`                                                                           // 详情图片（可选，多张）
+	Description     string               `json:"description,omitempty"`                                                                             // 菜品描述（可选）
+	DescriptionI18n domain.LocalizedText `json:"description_i18n,omitempty" binding:"omitempty,dive,keys,oneof=en-US zh-CN ms-MY,endkeys,max=2000"` // 菜品描述多语言（可选）
+
+	// 过敏原与饮食属性
+	Allergens  []domain.Allergen        `json:"allergens,omitempty" binding:"omitempty,dive,oneof=gluten crustacean mollusc fish egg milk peanut tree_nut soy sesame celery mustard sulphite lupin"` // 过敏原（可选，可多选）
+	Halal      bool                     `json:"halal"`                                                                                                                                               // 是否清真
+	Vegetarian bool                     `json:"vegetarian"`                                                                                                                                          // 是否素食
+	Vegan      bool                     `json:"vegan"`                                                                                                                                               // 是否纯素
+	SpicyLevel domain.SpicyLevel        `json:"spicy_level" binding:"omitempty,min=0,max=3"`                                                                                                         // 辣度：0（不辣）、1（微辣）、2（中辣）、3（特辣）
+	Nutrition  *domain.ProductNutrition `json:"nutrition,omitempty"`                                                                                                                                 // 营养成分（可选，每份）
 
 	// 关联信息
 	SpecRelations []ProductSpecRelationReq `json:"spec_relations" binding:"required,min=1,dive"` // 商品规格关联列表（必选，至少一个）
 	AttrRelations []ProductAttrRelationReq `json:"attr_relations,omitempty"`                     // 商品口味做法关联列表（可选）
+	AttrLimits    domain.ProductAttrLimits `json:"attr_limits,omitempty"`                        // 口味做法分组点单限制（可选）
 	TagIDs        []uuid.UUID              `json:"tag_ids,omitempty"`                            // 商品标签ID列表（可选）
 }
 
 // ProductSpecRelationReq 商品规格关联请求
 type ProductSpecRelationReq struct {
-	SpecID             uuid.UUID        `json:"spec_id" binding:"required"`     // 规格ID（必选）
-	BasePrice          decimal.Decimal  `json:"base_price" binding:"required"`  // 基础价格（必选，单位：分）
-	MemberPrice        *decimal.Decimal `json:"member_price,omitempty"`         // 会员价（可选，单位：分）
-	PackingFeeID       uuid.UUID        `json:"packing_fee_id"`                 // 打包费ID（引用费用配置）
-	EstimatedCostPrice *decimal.Decimal `json:"estimated_cost_price,omitempty"` // 预估成本价（可选，单位：分）
-	OtherPrice1        *decimal.Decimal `json:"other_price1,omitempty"`         // 其他价格1（可选，单位：分）
-	OtherPrice2        *decimal.Decimal `json:"other_price2,omitempty"`         // 其他价格2（可选，单位：分）
-	OtherPrice3        *decimal.Decimal `json:"other_price3,omitempty"`         // 其他价格3（可选，单位：分）
-	Barcode            string           `json:"barcode,omitempty"`              // 条形码（可选）
-	IsDefault          bool             `json:"is_default"`                     // 是否默认项
+	SpecID             uuid.UUID           `json:"spec_id" binding:"required"`     // 规格ID（必选）
+	BasePrice          decimal.Decimal     `json:"base_price" binding:"required"`  // 基础价格（必选，单位：分）
+	MemberPrice        *decimal.Decimal    `json:"member_price,omitempty"`         // 会员价（可选，单位：分）
+	PackingFeeID       uuid.UUID           `json:"packing_fee_id"`                 // 打包费ID（引用费用配置）
+	EstimatedCostPrice *decimal.Decimal    `json:"estimated_cost_price,omitempty"` // 预估成本价（可选，单位：分）
+	OtherPrice1        *decimal.Decimal    `json:"other_price1,omitempty"`         // 其他价格1（可选，单位：分）
+	OtherPrice2        *decimal.Decimal    `json:"other_price2,omitempty"`         // 其他价格2（可选，单位：分）
+	OtherPrice3        *decimal.Decimal    `json:"other_price3,omitempty"`         // 其他价格3（可选，单位：分）
+	Barcode            string              `json:"barcode,omitempty"`              // 条形码（可选）
+	IsDefault          bool                `json:"is_default"`                     // 是否默认项
+	PeriodPrices       domain.PeriodPrices `json:"period_prices,omitempty"`        // 就餐时段价格（可选）
 }
 
 // ProductAttrRelationReq 商品口味做法关联请求
 type ProductAttrRelationReq struct {
-	AttrID     uuid.UUID `json:"attr_id" binding:"required"`      // 口味做法ID（必选）
-	AttrItemID uuid.UUID `json:"attr_item_id" binding:"required"` // 口味做法项ID（必选）
-	IsDefault  bool      `json:"is_default"`                      // 是否默认项
+	AttrID      uuid.UUID `json:"attr_id" binding:"required"`             // 口味做法ID（必选）
+	AttrItemID  uuid.UUID `json:"attr_item_id" binding:"required"`        // 口味做法项ID（必选）
+	IsDefault   bool      `json:"is_default"`                             // 是否默认项
+	MaxQuantity int       `json:"max_quantity" binding:"omitempty,min=1"` // 单项最多可选数量（可选，默认 1）
 }
 
 // ProductUpdateReq 更新商品请求
@@ -74,12 +88,13 @@ type ProductUpdateReq *ProductCreateReq
 // SetMealCreateReq 创建套餐商品请求
 type SetMealCreateReq struct {
 	// 基础信息
-	Name         string                      `json:"name" binding:"required,max=255"` // 商品名称（必选）
-	CategoryID   uuid.UUID                   `json:"category_id" binding:"required"`  // 分类ID（必选）
-	MenuID       *uuid.UUID                  `json:"menu_id,omitempty"`               // 菜单ID（可选）
-	Mnemonic     string                      `json:"mnemonic,omitempty"`              // 助记词（可选）
-	ShelfLife    int                         `json:"shelf_life,omitempty"`            // 保质期（可选，单位：天）
-	SupportTypes []domain.ProductSupportType `json:"support_types,omitempty"`         // 支持类型（可选，堂食、外带）
+	Name         string                      `json:"name" binding:"required,max=255"`                                                           // 商品名称（必选）
+	NameI18n     domain.LocalizedText        `json:"name_i18n,omitempty" binding:"omitempty,dive,keys,oneof=en-US zh-CN ms-MY,endkeys,max=255"` // 商品名称多语言（可选，键为 en-US、zh-CN、ms-MY）
+	CategoryID   uuid.UUID                   `json:"category_id" binding:"required"`                                                            // 分类ID（必选）
+	MenuID       *uuid.UUID                  `json:"menu_id,omitempty"`                                                                         // 菜单ID（可选）
+	Mnemonic     string                      `json:"mnemonic,omitempty"`                                                                        // 助记词（可选）
+	ShelfLife    int                         `json:"shelf_life,omitempty"`                                                                      // 保质期（可选，单位：天）
+	SupportTypes []domain.ProductSupportType `json:"support_types,omitempty"`                                                                   // 支持类型（可选，堂食、外带）
 
 	// 属性关联
 	UnitID uuid.UUID `json:"unit_id" binding:"required"` // 单位ID（必选）
@@ -100,9 +115,10 @@ type SetMealCreateReq struct {
 	StallID        *uuid.UUID `json:"stall_id,omitempty"`    // 指定出品部门ID（可选）
 
 	// 展示信息
-	MainImage    string   `json:"main_image,omitempty"`    // 主图（可选）
-	DetailImages []string `json:"detail_images,omitempty"` // 详情图片（可选，多张）
-	Description  string   `json:"description,omitempty"`   // 菜品描述（可选）
+	MainImage       string               `json:"main_image,omitempty"`                                                                              // 主图（可选）
+	DetailImages    []string             `json:"detail_images,omitempty"`                                                                           // 详情图片（可选，多张）
+	Description     string               `json:"description,omitempty"`                                                                             // 菜品描述（可选）
+	DescriptionI18n domain.LocalizedText `json:"description_i18n,omitempty" binding:"omitempty,dive,keys,oneof=en-US zh-CN ms-MY,endkeys,max=2000"` // 菜品描述多语言（可选）
 
 	// 关联信息
 	SpecRelations []ProductSpecRelationReq `json:"spec_relations" binding:"required,min=1,dive"` // 商品规格关联列表（必选，至少一个）
@@ -125,15 +141,17 @@ type SetMealGroupReq struct {
 
 // SetMealDetailReq 套餐组详情请求
 type SetMealDetailReq struct {
-	ProductID          uuid.UUID   `json:"product_id" binding:"required"`     // 商品ID（必选）
-	Quantity           int         `json:"quantity" binding:"required,min=1"` // 数量（必选，必须为正整数）
-	IsDefault          bool        `json:"is_default"`                        // 是否默认（必选，每个套餐组中只能有一个默认项）
-	OptionalProductIDs []uuid.UUID `json:"optional_product_ids,omitempty"`    // 备选商品ID列表（可选，多选）
+	ProductID          uuid.UUID                     `json:"product_id" binding:"required"`     // 商品ID（必选）
+	Quantity           int                           `json:"quantity" binding:"required,min=1"` // 数量（必选，必须为正整数）
+	IsDefault          bool                          `json:"is_default"`                        // 是否默认（必选，每个套餐组中只能有一个默认项）
+	OptionalProductIDs []uuid.UUID                   `json:"optional_product_ids,omitempty"`    // 备选商品ID列表（可选，多选）
+	OptionalSurcharges map[uuid.UUID]decimal.Decimal `json:"optional_surcharges,omitempty"`     // 备选商品加价（可选，备选商品ID -> 每份加价金额）
 }
 
 // ProductListReq 查询商品列表请求
 type ProductListReq struct {
 	Name       string `form:"name" binding:"omitempty,max=255"`                       // 商品名称（可选，模糊匹配）
+	Keyword    string `form:"keyword" binding:"omitempty,max=255"`                    // 助记搜索关键字（可选，匹配助记词、拼音首字母、拼音全拼和名称，结果按匹配程度排序）
 	SaleStatus string `form:"sale_status" binding:"omitempty,oneof=on_sale off_sale"` // 售卖状态（可选：on_sale-在售、off_sale-停售，空字符串表示全部）
 	Type       string `form:"type" binding:"omitempty,oneof=normal set_meal"`         // 商品类型（可选：normal-普通商品、set_meal-套餐商品，空字符串表示全部）
 	CategoryID string `form:"category_id"`                                            // 分类ID（可选，支持一级分类和二级分类）
@@ -142,7 +160,32 @@ type ProductListReq struct {
 	EndAt      string `form:"end_at"`                                                 // 创建结束时间（可选，最长支持1年跨度）
 	Page       int    `form:"page" binding:"omitempty,min=1"`                         // 页码
 	Size       int    `form:"size" binding:"omitempty,min=1"`                         // 每页数量
+
+	// 过敏原与饮食属性筛选
+	ExcludeAllergens []domain.Allergen  `form:"exclude_allergens" binding:"omitempty,dive,oneof=gluten crustacean mollusc fish egg milk peanut tree_nut soy sesame celery mustard sulphite lupin"` // 排除含有任一过敏原的商品（可选，可多选）
+	Halal            bool               `form:"halal"`                                                                                                                                             // 仅清真商品（可选）
+	Vegetarian       bool               `form:"vegetarian"`                                                                                                                                        // 仅素食商品（可选）
+	Vegan            bool               `form:"vegan"`                                                                                                                                             // 仅纯素商品（可选）
+	MaxSpicyLevel    *domain.SpicyLevel `form:"max_spicy_level" binding:"omitempty,min=0,max=3"`                                                                                                   // 最高辣度（可选）
 }
 
 // SetMealUpdateReq 更新套餐商品请求
 type SetMealUpdateReq *SetMealCreateReq
+
+// ProductExportReq 导出商品请求
+type ProductExportReq struct {
+	Name       string `form:"name" binding:"omitempty,max=255"`                       // 商品名称（可选，模糊匹配）
+	SaleStatus string `form:"sale_status" binding:"omitempty,oneof=on_sale off_sale"` // 售卖状态（可选）
+	CategoryID string `form:"category_id"`                                            // 分类ID（可选，支持一级分类和二级分类）
+	StallID    string `form:"stall_id"`                                               // 出品部门ID（可选）
+}
+
+// ProductImportReq 导入商品请求
+type ProductImportReq struct {
+	File *multipart.FileHeader `form:"file" binding:"required"` // Excel 文件（使用导入模板填写）
+}
+
+// ProductExcelResp 商品 Excel 文件下载地址
+type ProductExcelResp struct {
+	URL string `json:"url"` // 文件下载地址
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// PromotionSaveReq 创建/更新促销活动请求
+type PromotionSaveReq struct {
+	Name        string                       `json:"name" binding:"required,max=255"`                                                                            // 活动名称（必选）
+	Type        domain.PromotionType         `json:"type" binding:"required,oneof=order_discount item_discount buy_x_get_y second_item_discount"`                // 促销类型（必选）
+	Rule        domain.PromotionRule         `json:"rule"`                                                                                                       // 优惠规则
+	Channels    []domain.OrderChannel        `json:"channels" binding:"omitempty,dive,oneof=pos self_order mini_program mobile_order scan_order third_delivery"` // 适用订单渠道（为空表示全部渠道）
+	DiningWays  []domain.DiningWay           `json:"dining_ways" binding:"omitempty,dive,oneof=dine_in take_out delivery"`                                       // 适用就餐方式（为空表示全部）
+	StartAt     *time.Time                   `json:"start_at"`                                                                                                   // 活动开始时间（可选）
+	EndAt       *time.Time                   `json:"end_at"`                                                                                                     // 活动结束时间（可选）
+	TimeWindows []domain.PromotionTimeWindow `json:"time_windows"`                                                                                               // 活动时段（为空表示全天）
+	Priority    int                          `json:"priority"`                                                                                                   // 优先级，值越大越先计算
+	Stackable   bool                         `json:"stackable"`                                                                                                  // 是否可与其他可叠加活动同时享受
+	Enabled     bool                         `json:"enabled"`                                                                                                    // 是否启用
+}
+
+// PromotionListReq 促销活动列表请求
+type PromotionListReq struct {
+	upagination.RequestPagination
+	Name    string               `form:"name"`                                                                                         // 活动名称（模糊匹配）
+	Type    domain.PromotionType `form:"type" binding:"omitempty,oneof=order_discount item_discount buy_x_get_y second_item_discount"` // 促销类型
+	Enabled *bool                `form:"enabled"`                                                                                      // 是否启用
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// ReceiptRequestListReq 退款/开票申请列表请求
+type ReceiptRequestListReq struct {
+	upagination.RequestPagination
+	Type   domain.ReceiptRequestType   `form:"type" binding:"omitempty,oneof=refund invoice"`              // 申请类型
+	Status domain.ReceiptRequestStatus `form:"status" binding:"omitempty,oneof=pending accepted rejected"` // 状态
+}
+
+// ReceiptRequestHandleReq 处理申请请求
+type ReceiptRequestHandleReq struct {
+	Accepted bool   `json:"accepted"`                           // 是否同意
+	Remark   string `json:"remark" binding:"omitempty,max=255"` // 处理备注
+}
@@ -7,17 +7,19 @@ import (
 
 // RemarkCreateReq 创建备注请求
 type RemarkCreateReq struct {
-	Name        string             `json:"name" binding:"required,max=255"`                                                                         // 备注名称
-	Enabled     bool               `json:"enabled"`                                                                                                 // 是否启用
-	SortOrder   int                `json:"sort_order" binding:"omitempty,gte=0"`                                                                    // 排序，越小越靠前
-	RemarkScene domain.RemarkScene `json:"remark_scene" binding:"required,oneof=whole_order item cancel_reason discount gift rebill refund_reject"` // 使用场景
+	Name        string               `json:"name" binding:"required,max=255"`                                                                         // 备注名称
+	NameI18n    domain.LocalizedText `json:"name_i18n,omitempty" binding:"omitempty,dive,keys,oneof=en-US zh-CN ms-MY,endkeys,max=255"`               // 备注名称多语言（可选，键为 en-US、zh-CN、ms-MY）
+	Enabled     bool                 `json:"enabled"`                                                                                                 // 是否启用
+	SortOrder   int                  `json:"sort_order" binding:"omitempty,gte=0"`                                                                    // 排序，越小越靠前
+	RemarkScene domain.RemarkScene   `json:"remark_scene" binding:"required,oneof=whole_order item cancel_reason discount gift rebill refund_reject"` // 使用场景
 }
 
 // RemarkUpdateReq 更新备注请求
 type RemarkUpdateReq struct {
-	Name      string `json:"name" binding:"required,max=255"`      // 备注名称
-	Enabled   bool   `json:"enabled"`                              // 是否启用
-	SortOrder int    `json:"sort_order" binding:"omitempty,gte=0"` // 排序，越小越靠前
+	Name      string               `json:"name" binding:"required,max=255"`                                                           // 备注名称
+	NameI18n  domain.LocalizedText `json:"name_i18n,omitempty" binding:"omitempty,dive,keys,oneof=en-US zh-CN ms-MY,endkeys,max=255"` // 备注名称多语言（可选，键为 en-US、zh-CN、ms-MY）
+	Enabled   bool                 `json:"enabled"`                                                                                   // 是否启用
+	SortOrder int                  `json:"sort_order" binding:"omitempty,gte=0"`                                                      // 排序，越小越靠前
 }
 
 // RemarkListReq 备注列表查询
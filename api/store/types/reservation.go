@@ -0,0 +1,59 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// ReservationRuleSaveReq 保存预订规则请求
+type ReservationRuleSaveReq struct {
+	OnlineEnabled     bool            `json:"online_enabled"`                                   // 是否开放顾客线上预订
+	SlotMinutes       int             `json:"slot_minutes" binding:"required,min=15,max=120"`   // 时段间隔（分钟）
+	DiningMinutes     int             `json:"dining_minutes" binding:"required,min=30,max=480"` // 用餐时长（分钟）
+	MinAdvanceMinutes int             `json:"min_advance_minutes" binding:"min=0,max=1440"`     // 线上预订需至少提前的分钟数
+	MaxAdvanceDays    int             `json:"max_advance_days" binding:"required,min=1,max=90"` // 最多可提前预订的天数
+	MaxPartySize      int             `json:"max_party_size" binding:"required,min=1,max=100"`  // 单笔预订人数上限
+	DepositPerGuest   decimal.Decimal `json:"deposit_per_guest"`                                // 线上预订每位定金，为0不收定金
+}
+
+// ReservationListReq 预订列表请求
+type ReservationListReq struct {
+	Date     string                     `form:"date" binding:"required,datetime=2006-01-02"`                             // 日期
+	Statuses []domain.ReservationStatus `form:"statuses" binding:"omitempty,dive,oneof=booked seated no_show cancelled"` // 状态（可选，可多选）
+}
+
+// ReservationCalendarReq 预订日历请求
+type ReservationCalendarReq struct {
+	Month string `form:"month" binding:"required,datetime=2006-01"` // 月份 YYYY-MM
+}
+
+// ReservationSlotReq 可预订时段请求
+type ReservationSlotReq struct {
+	Date      string `form:"date" binding:"required,datetime=2006-01-02"` // 日期
+	PartySize int    `form:"party_size" binding:"required,min=1"`         // 用餐人数
+}
+
+// ReservationCreateReq 代客预订请求
+type ReservationCreateReq struct {
+	GuestName      string          `json:"guest_name" binding:"required,max=50"`  // 预订人姓名
+	GuestPhone     string          `json:"guest_phone" binding:"required,max=20"` // 预订人手机号
+	PartySize      int             `json:"party_size" binding:"required,min=1"`   // 用餐人数
+	ReservedAt     time.Time       `json:"reserved_at" binding:"required"`        // 到店时间，需为可预订时段
+	TableID        uuid.UUID       `json:"table_id"`                              // 偏好桌台（可选）
+	AreaPreference string          `json:"area_preference" binding:"max=50"`      // 区域偏好（可选）
+	Remark         string          `json:"remark" binding:"max=255"`              // 备注（可选）
+	Deposit        decimal.Decimal `json:"deposit"`                               // 定金金额，为0不收定金
+}
+
+// ReservationDepositReq 收取预订定金请求
+type ReservationDepositReq struct {
+	PaymentMethod domain.PaymentMethodPayType `json:"payment_method" binding:"required,oneof=cash online_payment bank_card"` // 定金支付方式
+}
+
+// ReservationCancelReq 取消预订请求
+type ReservationCancelReq struct {
+	Reason string `json:"reason" binding:"max=255"` // 取消原因（可选）
+}
@@ -0,0 +1,18 @@
+package types
+
+import "gitlab.jiguang.dev/pos-dine/dine/domain"
+
+// WaitlistRuleSaveReq 保存排队规则请求
+type WaitlistRuleSaveReq struct {
+	OnlineEnabled      bool `json:"online_enabled"`                                          // 是否开放顾客扫码取号
+	SmallMaxSize       int  `json:"small_max_size" binding:"required,min=1"`                 // 小桌人数上限
+	MediumMaxSize      int  `json:"medium_max_size" binding:"required,gtfield=SmallMaxSize"` // 中桌人数上限，超过为大桌
+	MaxPartySize       int  `json:"max_party_size" binding:"required,max=100"`               // 单次取号人数上限
+	DefaultTurnMinutes int  `json:"default_turn_minutes" binding:"required,min=10,max=480"`  // 默认翻台时长（分钟），近期无翻台记录时用于预估等待时间
+}
+
+// WaitlistTicketListReq 排队记录请求
+type WaitlistTicketListReq struct {
+	Date     string                        `form:"date" binding:"omitempty,datetime=2006-01-02"`                                    // 营业日，默认当天
+	Statuses []domain.WaitlistTicketStatus `form:"statuses" binding:"omitempty,dive,oneof=waiting called seated skipped cancelled"` // 状态（可选，可多选）
+}
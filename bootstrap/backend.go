@@ -26,6 +26,7 @@ type BackendConfig struct {
 	Tracing            tracing.Config
 	Oss                oss.Config
 	ProfitDistribution domain.ProfitDistributionConfig
+	Delivery           domain.DeliveryConfig
 }
 
 func NewBackendConfig(files []string) (cfg BackendConfig, err error) {
@@ -24,6 +24,8 @@ type CustomerConfig struct {
 	Redis    rdb.Config
 	Alert    alert.Config
 	Auth     domain.AuthConfig
+	Receipt  domain.ReceiptConfig
+	Delivery domain.DeliveryConfig
 	Huifu    huifu.MerchSysConfig
 	Oss      oss.Config
 	Tracing  tracing.Config
@@ -22,6 +22,8 @@ type FrontendConfig struct {
 	Redis    rdb.Config
 	Alert    alert.Config
 	Auth     domain.AuthConfig
+	Receipt  domain.ReceiptConfig
+	Delivery domain.DeliveryConfig
 	Huifu    huifu.MerchSysConfig
 	Oss      oss.Config
 	Tracing  tracing.Config
@@ -20,7 +20,11 @@ type SchedulerConfig struct {
 	Oss      oss.Config
 	Huifu    huifu.MerchSysConfig
 
-	ProfitDistribution periodic.ProfitDistributionConfig
+	ProfitDistribution    periodic.ProfitDistributionConfig
+	PriceChange           periodic.PriceChangeConfig
+	MenuPublish           periodic.MenuPublishConfig
+	MemberPointsExpire    periodic.MemberPointsExpireConfig
+	ProductPinyinBackfill periodic.ProductPinyinBackfillConfig
 }
 
 func NewSchedulerConfig(files []string) (cfg SchedulerConfig, err error) {
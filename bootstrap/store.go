@@ -22,6 +22,8 @@ type StoreConfig struct {
 	Redis    rdb.Config
 	Alert    alert.Config
 	Auth     domain.AuthConfig
+	Receipt  domain.ReceiptConfig
+	Delivery domain.DeliveryConfig
 	Huifu    huifu.MerchSysConfig
 	Tracing  tracing.Config
 	Oss      oss.Config
@@ -14,6 +14,7 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/bootstrap/asynq/asynqfx"
 	"gitlab.jiguang.dev/pos-dine/dine/bootstrap/db/dbfx"
 	"gitlab.jiguang.dev/pos-dine/dine/bootstrap/httpserver/httpserverfx"
+	"gitlab.jiguang.dev/pos-dine/dine/bootstrap/huifu"
 	"gitlab.jiguang.dev/pos-dine/dine/bootstrap/rdb/rdbfx"
 	"gitlab.jiguang.dev/pos-dine/dine/bootstrap/tracing/tracingfx"
 	"gitlab.jiguang.dev/pos-dine/dine/buildinfo"
@@ -75,6 +76,7 @@ func main() {
 				fx.As(new(domain.DailySequence)),
 			),
 			oss.New,
+			huifu.New,
 		),
 		dbfx.Module,
 		tracingfx.Module,
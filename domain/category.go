@@ -49,19 +49,20 @@ type CategoryInteractor interface {
 
 // Category 商品分类实体
 type Category struct {
-	ID             uuid.UUID `json:"id"`               // 分类ID
-	Name           string    `json:"name"`             // 分类名称
-	MerchantID     uuid.UUID `json:"merchant_id"`      // 品牌商ID
-	StoreID        uuid.UUID `json:"store_id"`         // 门店ID
-	ParentID       uuid.UUID `json:"parent_id"`        // 父分类ID
-	InheritTaxRate bool      `json:"inherit_tax_rate"` // 是否继承父分类的税率ID
-	TaxRateID      uuid.UUID `json:"tax_rate_id"`      // 税率ID
-	InheritStall   bool      `json:"inherit_stall"`    // 是否继承父分类的出品部门ID
-	StallID        uuid.UUID `json:"stall_id"`         // 出品部门ID
-	ProductCount   int       `json:"product_count"`    // 关联的商品数量
-	SortOrder      int       `json:"sort_order"`       // 排序，值越小越靠前
-	CreatedAt      time.Time `json:"created_at"`       // 创建时间
-	UpdatedAt      time.Time `json:"updated_at"`       // 更新时间
+	ID             uuid.UUID     `json:"id"`               // 分类ID
+	Name           string        `json:"name"`             // 分类名称
+	NameI18n       LocalizedText `json:"name_i18n"`        // 分类名称翻译
+	MerchantID     uuid.UUID     `json:"merchant_id"`      // 品牌商ID
+	StoreID        uuid.UUID     `json:"store_id"`         // 门店ID
+	ParentID       uuid.UUID     `json:"parent_id"`        // 父分类ID
+	InheritTaxRate bool          `json:"inherit_tax_rate"` // 是否继承父分类的税率ID
+	TaxRateID      uuid.UUID     `json:"tax_rate_id"`      // 税率ID
+	InheritStall   bool          `json:"inherit_stall"`    // 是否继承父分类的出品部门ID
+	StallID        uuid.UUID     `json:"stall_id"`         // 出品部门ID
+	ProductCount   int           `json:"product_count"`    // 关联的商品数量
+	SortOrder      int           `json:"sort_order"`       // 排序，值越小越靠前
+	CreatedAt      time.Time     `json:"created_at"`       // 创建时间
+	UpdatedAt      time.Time     `json:"updated_at"`       // 更新时间
 
 	// 关联信息
 	Childrens []*Category `json:"children,omitempty"` // 子分类列表
@@ -0,0 +1,608 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrCouponTemplateNotExists      = errors.New("优惠券模板不存在")
+	ErrCouponTemplateNameExists     = errors.New("优惠券模板名称已存在")
+	ErrCouponTemplateDisabled       = errors.New("优惠券模板已停用")
+	ErrCouponTemplateHasIssued      = errors.New("优惠券模板已发放，不能删除")
+	ErrCouponValueInvalid           = errors.New("优惠设置无效，减免金额须大于 0，折扣比例须在 0-100 之间")
+	ErrCouponProductRequired        = errors.New("请选择兑换商品")
+	ErrCouponMinSpendInvalid        = errors.New("使用门槛不能小于 0")
+	ErrCouponValidityInvalid        = errors.New("有效期设置无效")
+	ErrCouponStoreInvalid           = errors.New("门店无效，必须属于当前品牌商")
+	ErrCouponIssueQtyInvalid        = errors.New("发放数量必须在 1-10000 之间")
+	ErrCouponStockNotEnough         = errors.New("优惠券库存不足")
+	ErrCouponNotExists              = errors.New("优惠券不存在")
+	ErrCouponUsed                   = errors.New("优惠券已使用")
+	ErrCouponInvalidated            = errors.New("优惠券已作废")
+	ErrCouponNotStarted             = errors.New("优惠券未到使用时间")
+	ErrCouponExpired                = errors.New("优惠券已过期")
+	ErrCouponStoreNotApplicable     = errors.New("优惠券不适用于当前门店")
+	ErrCouponMinSpendNotMet         = errors.New("订单金额未达到优惠券使用门槛")
+	ErrCouponProductNotInOrder      = errors.New("订单中没有可兑换的商品")
+	ErrCouponOrderNotExists         = errors.New("订单不存在")
+	ErrCouponOrderPaid              = errors.New("订单已支付，不能使用优惠券")
+	ErrCouponOrderFullyDiscounted   = errors.New("订单应收金额已全部抵扣，不能再使用优惠券")
+	ErrCouponRedeemBusy             = errors.New("优惠券正在核销中，请稍后重试")
+	ErrPartnerCouponProviderInvalid = errors.New("不支持的三方券平台")
+	ErrPartnerCouponRedeemed        = errors.New("三方券已核销")
+)
+
+// ------------------------------------------------------------
+// 枚举定义
+// ------------------------------------------------------------
+
+// CouponType 优惠券类型
+type CouponType string
+
+const (
+	CouponTypeAmountOff CouponType = "amount_off" // 代金券（减免固定金额）
+	CouponTypeDiscount  CouponType = "discount"   // 折扣券（按比例减免）
+	CouponTypeFreeItem  CouponType = "free_item"  // 兑换券（免费兑换指定商品一份）
+)
+
+func (CouponType) Values() []string {
+	return []string{
+		string(CouponTypeAmountOff),
+		string(CouponTypeDiscount),
+		string(CouponTypeFreeItem),
+	}
+}
+
+// CouponValidityType 有效期类型
+type CouponValidityType string
+
+const (
+	CouponValidityTypeFixed    CouponValidityType = "fixed"    // 固定日期
+	CouponValidityTypeRelative CouponValidityType = "relative" // 发放后 N 天内有效
+)
+
+func (CouponValidityType) Values() []string {
+	return []string{
+		string(CouponValidityTypeFixed),
+		string(CouponValidityTypeRelative),
+	}
+}
+
+// CouponStatus 优惠券状态
+type CouponStatus string
+
+const (
+	CouponStatusUnused       CouponStatus = "unused"        // 未使用
+	CouponStatusUsed         CouponStatus = "used"          // 已使用
+	CouponStatusInvalidated  CouponStatus = "invalidated"   // 已作废
+	CouponStatusRedeeming    CouponStatus = "redeeming"     // 三方核销中（已记录本地核销，等待三方平台核销结果）
+	CouponStatusRedeemFailed CouponStatus = "redeem_failed" // 三方核销失败（可重新核销）
+)
+
+func (CouponStatus) Values() []string {
+	return []string{
+		string(CouponStatusUnused),
+		string(CouponStatusUsed),
+		string(CouponStatusInvalidated),
+		string(CouponStatusRedeeming),
+		string(CouponStatusRedeemFailed),
+	}
+}
+
+// CouponSource 优惠券来源
+type CouponSource string
+
+const (
+	CouponSourceSystem  CouponSource = "system"  // 系统自定义券
+	CouponSourcePartner CouponSource = "partner" // 三方合作券
+)
+
+func (CouponSource) Values() []string {
+	return []string{
+		string(CouponSourceSystem),
+		string(CouponSourcePartner),
+	}
+}
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// CouponTemplate 优惠券模板
+type CouponTemplate struct {
+	ID           uuid.UUID          `json:"id"`            // 模板ID
+	MerchantID   uuid.UUID          `json:"merchant_id"`   // 品牌商ID
+	StoreID      uuid.UUID          `json:"store_id"`      // 门店ID（门店创建的券仅本门店可用）
+	Name         string             `json:"name"`          // 券名称
+	Type         CouponType         `json:"type"`          // 券类型
+	Value        decimal.Decimal    `json:"value"`         // 优惠值（代金券为减免金额，折扣券为减免比例，20 表示减免 20%）
+	MaxDiscount  decimal.Decimal    `json:"max_discount"`  // 折扣券最高优惠金额（0 表示不限）
+	ProductID    uuid.UUID          `json:"product_id"`    // 兑换商品ID（兑换券）
+	MinSpend     decimal.Decimal    `json:"min_spend"`     // 使用门槛（订单应收金额，0 表示无门槛）
+	StoreIDs     []uuid.UUID        `json:"store_ids"`     // 适用门店（品牌券，为空表示全部门店）
+	ValidityType CouponValidityType `json:"validity_type"` // 有效期类型
+	ValidFrom    *time.Time         `json:"valid_from"`    // 有效期开始时间（固定日期）
+	ValidTo      *time.Time         `json:"valid_to"`      // 有效期结束时间（固定日期）
+	ValidDays    int                `json:"valid_days"`    // 有效天数（发放后 N 天内有效）
+	TotalQty     int                `json:"total_qty"`     // 发放总量（0 表示不限）
+	IssuedQty    int                `json:"issued_qty"`    // 已发放数量
+	Description  string             `json:"description"`   // 使用说明
+	Enabled      bool               `json:"enabled"`       // 是否启用
+	CreatedAt    time.Time          `json:"created_at"`    // 创建时间
+	UpdatedAt    time.Time          `json:"updated_at"`    // 更新时间
+}
+
+// CouponTemplates 优惠券模板集合
+type CouponTemplates []*CouponTemplate
+
+// Coupon 优惠券（券码）
+type Coupon struct {
+	ID             uuid.UUID       `json:"id"`              // 券ID
+	MerchantID     uuid.UUID       `json:"merchant_id"`     // 品牌商ID
+	TemplateID     uuid.UUID       `json:"template_id"`     // 模板ID（三方券为空）
+	Source         CouponSource    `json:"source"`          // 来源
+	Provider       string          `json:"provider"`        // 三方券平台
+	Code           string          `json:"code"`            // 券码
+	BatchNo        string          `json:"batch_no"`        // 发放批次号
+	Name           string          `json:"name"`            // 券名称
+	Type           CouponType      `json:"type"`            // 券类型
+	Status         CouponStatus    `json:"status"`          // 状态
+	ValidFrom      time.Time       `json:"valid_from"`      // 有效期开始时间
+	ValidTo        time.Time       `json:"valid_to"`        // 有效期结束时间
+	UsedAt         *time.Time      `json:"used_at"`         // 使用时间
+	UsedStoreID    uuid.UUID       `json:"used_store_id"`   // 使用门店ID
+	OrderID        uuid.UUID       `json:"order_id"`        // 使用订单ID
+	OrderNo        string          `json:"order_no"`        // 使用订单号
+	DiscountAmount decimal.Decimal `json:"discount_amount"` // 优惠金额
+	CreatedAt      time.Time       `json:"created_at"`      // 发放时间
+	UpdatedAt      time.Time       `json:"updated_at"`      // 更新时间
+}
+
+// Coupons 优惠券集合
+type Coupons []*Coupon
+
+// Validate 校验模板设置
+func (t *CouponTemplate) Validate() error {
+	switch t.Type {
+	case CouponTypeAmountOff:
+		if !t.Value.IsPositive() {
+			return ErrCouponValueInvalid
+		}
+	case CouponTypeDiscount:
+		if !t.Value.IsPositive() || t.Value.GreaterThanOrEqual(decimal.NewFromInt(100)) || t.MaxDiscount.IsNegative() {
+			return ErrCouponValueInvalid
+		}
+	case CouponTypeFreeItem:
+		if t.ProductID == uuid.Nil {
+			return ErrCouponProductRequired
+		}
+	default:
+		return fmt.Errorf("不支持的优惠券类型：%s", t.Type)
+	}
+	if t.MinSpend.IsNegative() {
+		return ErrCouponMinSpendInvalid
+	}
+	if t.TotalQty < 0 {
+		return ErrCouponStockNotEnough
+	}
+
+	switch t.ValidityType {
+	case CouponValidityTypeFixed:
+		if t.ValidFrom == nil || t.ValidTo == nil || !t.ValidTo.After(*t.ValidFrom) {
+			return ErrCouponValidityInvalid
+		}
+	case CouponValidityTypeRelative:
+		if t.ValidDays <= 0 {
+			return ErrCouponValidityInvalid
+		}
+	default:
+		return ErrCouponValidityInvalid
+	}
+	return nil
+}
+
+// Issue 按模板批量生成券码，并累加已发放数量
+func (t *CouponTemplate) Issue(qty int, batchNo string, now time.Time) (Coupons, error) {
+	if !t.Enabled {
+		return nil, ErrCouponTemplateDisabled
+	}
+	if qty <= 0 || qty > MaxCouponIssueQty {
+		return nil, ErrCouponIssueQtyInvalid
+	}
+	if t.TotalQty > 0 && t.IssuedQty+qty > t.TotalQty {
+		return nil, ErrCouponStockNotEnough
+	}
+
+	var validFrom, validTo time.Time
+	if t.ValidityType == CouponValidityTypeFixed {
+		validFrom, validTo = *t.ValidFrom, *t.ValidTo
+		if !validTo.After(now) {
+			return nil, ErrCouponExpired
+		}
+	} else {
+		validFrom, validTo = now, now.AddDate(0, 0, t.ValidDays)
+	}
+
+	codes, err := generateCouponCodes(qty)
+	if err != nil {
+		return nil, err
+	}
+	coupons := make(Coupons, 0, qty)
+	for _, code := range codes {
+		coupons = append(coupons, &Coupon{
+			ID:         uuid.New(),
+			MerchantID: t.MerchantID,
+			TemplateID: t.ID,
+			Source:     CouponSourceSystem,
+			Code:       code,
+			BatchNo:    batchNo,
+			Name:       t.Name,
+			Type:       t.Type,
+			Status:     CouponStatusUnused,
+			ValidFrom:  validFrom,
+			ValidTo:    validTo,
+		})
+	}
+	t.IssuedQty += qty
+	return coupons, nil
+}
+
+// Applicable 模板是否适用于门店
+func (t *CouponTemplate) Applicable(storeID uuid.UUID) bool {
+	if t.StoreID != uuid.Nil {
+		return t.StoreID == storeID
+	}
+	return len(t.StoreIDs) == 0 || slices.Contains(t.StoreIDs, storeID)
+}
+
+// CouponDiscount 券在订单上的优惠
+type CouponDiscount struct {
+	Amount decimal.Decimal // 优惠金额
+	Line   int             // 兑换券抵扣的订单商品下标，其他券为 -1
+}
+
+// CalculateDiscount 计算订单使用该模板券可优惠的金额
+//
+// 同一订单已核销的券已计入订单折扣并扣减应收，门槛和优惠按剩余应收计算，优惠不超过剩余应收；
+// 兑换券每次兑换一份，优惠不超过该商品行扣除已有优惠后的金额。
+func (t *CouponTemplate) CalculateDiscount(order *Order) (*CouponDiscount, error) {
+	amount := order.Amount.AmountDue
+	if !amount.IsPositive() {
+		return nil, ErrCouponOrderFullyDiscounted
+	}
+	if amount.LessThan(t.MinSpend) {
+		return nil, ErrCouponMinSpendNotMet
+	}
+
+	res := &CouponDiscount{Line: -1}
+	switch t.Type {
+	case CouponTypeAmountOff:
+		res.Amount = t.Value
+	case CouponTypeDiscount:
+		res.Amount = amount.Mul(t.Value).Div(decimal.NewFromInt(100)).Round(2)
+		if t.MaxDiscount.IsPositive() {
+			res.Amount = decimal.Min(res.Amount, t.MaxDiscount)
+		}
+	case CouponTypeFreeItem:
+		// 兑换订单中该商品单价最高的一份
+		for i, op := range order.OrderProducts {
+			qty := op.Qty - op.GiftQty - op.VoidQty
+			if op.ProductID != t.ProductID || op.IsGift || qty <= 0 {
+				continue
+			}
+			price := decimal.Min(
+				op.Subtotal.Div(decimal.NewFromInt(int64(op.Qty))),
+				op.Subtotal.Sub(op.DiscountAmount),
+			).RoundDown(2)
+			if price.GreaterThan(res.Amount) {
+				res.Amount = price
+				res.Line = i
+			}
+		}
+		if !res.Amount.IsPositive() {
+			return nil, ErrCouponProductNotInOrder
+		}
+	}
+	res.Amount = decimal.Min(res.Amount, amount)
+	return res, nil
+}
+
+// ApplyCoupon 将券优惠计入订单折扣合计并扣减应收，兑换券的优惠同时计入兑换商品行的优惠金额
+func (o *Order) ApplyCoupon(discount *CouponDiscount) {
+	if discount.Line >= 0 {
+		op := &o.OrderProducts[discount.Line]
+		op.DiscountAmount = op.DiscountAmount.Add(discount.Amount)
+	}
+	o.Amount.DiscountTotal = o.Amount.DiscountTotal.Add(discount.Amount)
+	o.Amount.AmountDue = o.Amount.AmountDue.Sub(discount.Amount)
+}
+
+// CheckUsable 校验券在指定时间是否可用
+func (c *Coupon) CheckUsable(at time.Time) error {
+	switch c.Status {
+	case CouponStatusUsed:
+		return ErrCouponUsed
+	case CouponStatusInvalidated:
+		return ErrCouponInvalidated
+	}
+	if at.Before(c.ValidFrom) {
+		return ErrCouponNotStarted
+	}
+	if at.After(c.ValidTo) {
+		return ErrCouponExpired
+	}
+	return nil
+}
+
+// MarkUsed 核销券并关联订单
+func (c *Coupon) MarkUsed(order *Order, discount decimal.Decimal, at time.Time) {
+	c.Status = CouponStatusUsed
+	c.UsedAt = &at
+	c.UsedStoreID = order.StoreID
+	c.OrderID = order.ID
+	c.OrderNo = order.OrderNo
+	c.DiscountAmount = discount
+}
+
+// MarkRedeeming 记录三方券待核销，三方平台核销成功后再调用 MarkUsed
+func (c *Coupon) MarkRedeeming(order *Order, discount decimal.Decimal, at time.Time) {
+	c.MarkUsed(order, discount, at)
+	c.Status = CouponStatusRedeeming
+}
+
+// MarkRedeemFailed 三方平台核销失败，解除订单关联，券码可重新核销
+func (c *Coupon) MarkRedeemFailed() {
+	c.Restore()
+	c.Status = CouponStatusRedeemFailed
+}
+
+// Restore 撤销核销，券恢复为未使用
+func (c *Coupon) Restore() {
+	c.Status = CouponStatusUnused
+	c.UsedAt = nil
+	c.UsedStoreID = uuid.Nil
+	c.OrderID = uuid.Nil
+	c.OrderNo = ""
+	c.DiscountAmount = decimal.Zero
+}
+
+// AddCouponLog 记录订单使用优惠券的操作日志
+func (o *Order) AddCouponLog(operator OrderOperator, content CouponContent, at time.Time) {
+	o.OperationLogs = append(o.OperationLogs, OrderOperationLog{
+		OperatedAt:    at,
+		Source:        o.Channel,
+		OperatorID:    operator.ID,
+		OperatorName:  operator.Name,
+		OperationType: OrderOperationTypeCoupon,
+		Content: map[string]interface{}{
+			"coupon_id":   content.CouponID,
+			"coupon_name": content.CouponName,
+			"amount":      content.Amount,
+		},
+	})
+}
+
+// OrderOperator 订单操作人
+type OrderOperator struct {
+	ID   uuid.UUID `json:"id"`   // 操作人ID
+	Name string    `json:"name"` // 操作人名称
+}
+
+// MaxCouponIssueQty 单次最多发放数量
+const MaxCouponIssueQty = 10000
+
+// couponCodeAlphabet 券码字符集（去除易混淆的 0/O、1/I/L）
+const couponCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+// couponCodeLength 券码长度
+const couponCodeLength = 12
+
+// generateCouponCodes 生成不重复的随机券码
+func generateCouponCodes(n int) ([]string, error) {
+	seen := make(map[string]struct{}, n)
+	codes := make([]string, 0, n)
+	for len(codes) < n {
+		code, err := randomCode(couponCodeLength)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate coupon code: %w", err)
+		}
+		if _, ok := seen[code]; ok {
+			continue
+		}
+		seen[code] = struct{}{}
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+// randomCode 从券码字符集生成指定长度的随机码
+func randomCode(length int) (string, error) {
+	alphabetLen := big.NewInt(int64(len(couponCodeAlphabet)))
+	b := make([]byte, length)
+	for i := range b {
+		idx, err := rand.Int(rand.Reader, alphabetLen)
+		if err != nil {
+			return "", err
+		}
+		b[i] = couponCodeAlphabet[idx.Int64()]
+	}
+	return string(b), nil
+}
+
+// ------------------------------------------------------------
+// 三方券核销
+// ------------------------------------------------------------
+
+// PartnerCoupon 三方券信息
+type PartnerCoupon struct {
+	Provider  string          `json:"provider"`   // 三方券平台
+	Code      string          `json:"code"`       // 券码
+	Name      string          `json:"name"`       // 券名称
+	Amount    decimal.Decimal `json:"amount"`     // 抵扣金额
+	ValidFrom time.Time       `json:"valid_from"` // 有效期开始时间
+	ValidTo   time.Time       `json:"valid_to"`   // 有效期结束时间
+}
+
+// PartnerCouponVerifier 三方券核销器，每个三方券平台实现一个
+type PartnerCouponVerifier interface {
+	// Provider 三方券平台标识
+	Provider() string
+	// Verify 查询券信息并校验是否可用（不核销）
+	Verify(ctx context.Context, storeID uuid.UUID, code string) (*PartnerCoupon, error)
+	// Redeem 在三方平台核销券
+	Redeem(ctx context.Context, storeID uuid.UUID, code string, orderNo string) error
+}
+
+// PartnerCouponVerifierRegistry 三方券核销器注册表
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/partner_coupon_verifier_registry.go -package=mock . PartnerCouponVerifierRegistry
+type PartnerCouponVerifierRegistry interface {
+	// Get 获取三方券平台的核销器，平台不存在时返回 ErrPartnerCouponProviderInvalid
+	Get(provider string) (PartnerCouponVerifier, error)
+	// Providers 已注册的三方券平台
+	Providers() []string
+}
+
+// ------------------------------------------------------------
+// 仓储和用例接口
+// ------------------------------------------------------------
+
+// CouponTemplateRepository 优惠券模板仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/coupon_template_repository.go -package=mock . CouponTemplateRepository
+type CouponTemplateRepository interface {
+	Create(ctx context.Context, template *CouponTemplate) error
+	Update(ctx context.Context, template *CouponTemplate) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*CouponTemplate, error)
+	// FindByIDForUpdate 查询模板并加行锁（发放时防止超发）
+	FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*CouponTemplate, error)
+	Exists(ctx context.Context, params CouponTemplateExistsParams) (bool, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params CouponTemplateSearchParams) (*CouponTemplateSearchRes, error)
+}
+
+// CouponRepository 优惠券仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/coupon_repository.go -package=mock . CouponRepository
+type CouponRepository interface {
+	CreateBulk(ctx context.Context, coupons Coupons) error
+	Update(ctx context.Context, coupon *Coupon) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Coupon, error)
+	// FindByCode 根据券码查询（provider 为空表示系统券）
+	FindByCode(ctx context.Context, merchantID uuid.UUID, provider, code string) (*Coupon, error)
+	ListByOrderID(ctx context.Context, orderID uuid.UUID) (Coupons, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params CouponSearchParams) (*CouponSearchRes, error)
+}
+
+// CouponTemplateInteractor 优惠券模板用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/coupon_template_interactor.go -package=mock . CouponTemplateInteractor
+type CouponTemplateInteractor interface {
+	Create(ctx context.Context, template *CouponTemplate, user User) error
+	Update(ctx context.Context, template *CouponTemplate, user User) error
+	Delete(ctx context.Context, id uuid.UUID, user User) error
+	GetDetail(ctx context.Context, id uuid.UUID, user User) (*CouponTemplate, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params CouponTemplateSearchParams) (*CouponTemplateSearchRes, error)
+	// Issue 按模板批量生成券码
+	Issue(ctx context.Context, id uuid.UUID, qty int, user User) (Coupons, error)
+}
+
+// CouponInteractor 优惠券用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/coupon_interactor.go -package=mock . CouponInteractor
+type CouponInteractor interface {
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params CouponSearchParams) (*CouponSearchRes, error)
+	// Invalidate 作废未使用的券
+	Invalidate(ctx context.Context, id uuid.UUID, user User) error
+	// Verify 校验券码并试算优惠金额（不核销）
+	Verify(ctx context.Context, params CouponRedeemParams) (*CouponRedeemRes, error)
+	// Redeem 核销券码，券只能使用一次，核销记录关联到订单
+	Redeem(ctx context.Context, params CouponRedeemParams) (*CouponRedeemRes, error)
+	// VerifyPartner 通过三方平台校验三方券（不核销）
+	VerifyPartner(ctx context.Context, params PartnerCouponRedeemParams) (*PartnerCoupon, error)
+	// RedeemPartner 在三方平台核销三方券，核销记录关联到订单；三方核销失败时记录标记为核销失败，可重新核销
+	RedeemPartner(ctx context.Context, params PartnerCouponRedeemParams) (*Coupon, error)
+	// ListByOrderID 查询订单使用的优惠券
+	ListByOrderID(ctx context.Context, orderID uuid.UUID) (Coupons, error)
+}
+
+// ------------------------------------------------------------
+// 参数定义（DTO）
+// ------------------------------------------------------------
+
+// CouponTemplateExistsParams 存在性检查参数
+type CouponTemplateExistsParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID
+	Name       string
+	ExcludeID  uuid.UUID // 排除的ID（用于更新时检查名称唯一性）
+}
+
+// CouponTemplateSearchParams 模板查询参数
+type CouponTemplateSearchParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID
+	Name       string     // 券名称（模糊匹配）
+	Type       CouponType // 券类型（可选）
+	Enabled    *bool      // 是否启用（可选）
+}
+
+// CouponTemplateSearchRes 模板查询结果
+type CouponTemplateSearchRes struct {
+	*upagination.Pagination
+	Items CouponTemplates `json:"items"`
+}
+
+// CouponSearchParams 券查询参数
+type CouponSearchParams struct {
+	MerchantID uuid.UUID
+	TemplateID uuid.UUID    // 模板ID（可选）
+	Code       string       // 券码（可选）
+	BatchNo    string       // 发放批次号（可选）
+	Status     CouponStatus // 状态（可选）
+}
+
+// CouponSearchRes 券查询结果
+type CouponSearchRes struct {
+	*upagination.Pagination
+	Items Coupons `json:"items"`
+}
+
+// CouponRedeemParams 核销参数
+type CouponRedeemParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID
+	OrderID    uuid.UUID
+	Code       string
+	Operator   OrderOperator
+}
+
+// CouponRedeemRes 核销/试算结果
+type CouponRedeemRes struct {
+	Coupon         *Coupon         `json:"coupon"`          // 优惠券
+	DiscountAmount decimal.Decimal `json:"discount_amount"` // 优惠金额
+}
+
+// PartnerCouponRedeemParams 三方券核销参数
+type PartnerCouponRedeemParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID
+	OrderID    uuid.UUID // 订单ID（核销时必填）
+	Provider   string
+	Code       string
+	Operator   OrderOperator
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrCustomerNotExists = errors.New("客户不存在")
+)
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// Customer 客户（微信小程序用户），跨品牌商唯一，按品牌商通过 openid 或手机号关联会员
+type Customer struct {
+	ID          uuid.UUID  `json:"id"`            // 客户ID
+	OpenID      string     `json:"openid"`        // 微信小程序 openid
+	UnionID     string     `json:"unionid"`       // 微信开放平台 unionid
+	Nickname    string     `json:"nickname"`      // 昵称
+	Avatar      string     `json:"avatar"`        // 头像
+	Phone       string     `json:"phone"`         // 手机号
+	LastLoginAt *time.Time `json:"last_login_at"` // 最后登录时间
+	CreatedAt   time.Time  `json:"created_at"`    // 创建时间
+	UpdatedAt   time.Time  `json:"updated_at"`    // 更新时间
+}
+
+// NewCustomer 根据小程序登录信息创建客户
+func NewCustomer(session *WechatSession) *Customer {
+	return &Customer{
+		ID:      uuid.New(),
+		OpenID:  session.OpenID,
+		UnionID: session.UnionID,
+	}
+}
+
+// Login 记录一次登录，同步开放平台 unionid
+func (c *Customer) Login(session *WechatSession, at time.Time) {
+	if session.UnionID != "" {
+		c.UnionID = session.UnionID
+	}
+	c.LastLoginAt = &at
+}
+
+type (
+	customerKey struct{}
+)
+
+func NewCustomerContext(ctx context.Context, c *Customer) context.Context {
+	return context.WithValue(ctx, customerKey{}, c)
+}
+
+func FromCustomerContext(ctx context.Context) *Customer {
+	if v, ok := ctx.Value(customerKey{}).(*Customer); ok {
+		return v
+	}
+	return nil
+}
+
+// ------------------------------------------------------------
+// 仓储和用例接口
+// ------------------------------------------------------------
+
+// CustomerRepository 客户仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/customer_repository.go -package=mock . CustomerRepository
+type CustomerRepository interface {
+	Create(ctx context.Context, customer *Customer) error
+	Update(ctx context.Context, customer *Customer) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Customer, error)
+	FindByOpenID(ctx context.Context, openID string) (*Customer, error)
+}
+
+// CustomerInteractor 客户用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/customer_interactor.go -package=mock . CustomerInteractor
+type CustomerInteractor interface {
+	// Login 小程序登录：code 换取 openid，首次登录时创建客户，返回访问令牌
+	Login(ctx context.Context, code string) (token string, expAt time.Time, err error)
+	// Authenticate 校验访问令牌，返回当前客户
+	Authenticate(ctx context.Context, token string) (*Customer, error)
+	// UpdateProfile 更新昵称和头像
+	UpdateProfile(ctx context.Context, params CustomerProfileParams) (*Customer, error)
+	// BindPhone 通过手机号快速验证组件绑定手机号
+	BindPhone(ctx context.Context, id uuid.UUID, code string) (*Customer, error)
+}
+
+// ------------------------------------------------------------
+// 参数定义（DTO）
+// ------------------------------------------------------------
+
+// CustomerProfileParams 客户资料更新参数
+type CustomerProfileParams struct {
+	ID       uuid.UUID
+	Nickname string
+	Avatar   string
+}
@@ -42,6 +42,35 @@ type DataStore interface {
 	UserRoleRepo() UserRoleRepository
 	ProfitDistributionRuleRepo() ProfitDistributionRuleRepository
 	BusinessConfigRepo() BusinessConfigRepository
+	PriceChangeRepo() PriceChangeRepository
+	ProductVersionRepo() ProductVersionRepository
+	MenuVersionRepo() MenuVersionRepository
+	PromotionRepo() PromotionRepository
+	CouponTemplateRepo() CouponTemplateRepository
+	CouponRepo() CouponRepository
+	MemberTierRepo() MemberTierRepository
+	MemberRepo() MemberRepository
+	MemberAccountRepo() MemberAccountRepository
+	MemberAccountTransactionRepo() MemberAccountTransactionRepository
+	MemberPointsRuleRepo() MemberPointsRuleRepository
+	MemberPointsAccountRepo() MemberPointsAccountRepository
+	MemberPointsTransactionRepo() MemberPointsTransactionRepository
+	CustomerRepo() CustomerRepository
+	DiningTableRepo() DiningTableRepository
+	ScanOrderRuleRepo() ScanOrderRuleRepository
+	KitchenTicketRepo() KitchenTicketRepository
+	ReceiptRequestRepo() ReceiptRequestRepository
+	PickupRuleRepo() PickupRuleRepository
+	PickupCallRepo() PickupCallRepository
+	DeliveryStoreRepo() DeliveryStoreRepository
+	DeliveryProductMappingRepo() DeliveryProductMappingRepository
+	DeliveryOrderRepo() DeliveryOrderRepository
+	ReservationRuleRepo() ReservationRuleRepository
+	ReservationRepo() ReservationRepository
+	WaitlistRuleRepo() WaitlistRuleRepository
+	WaitlistTicketRepo() WaitlistTicketRepository
+	PreorderRuleRepo() PreorderRuleRepository
+	PreorderRepo() PreorderRepository
 }
 
 //go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/datacache.go -package=mock . DataCache
@@ -0,0 +1,549 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrDeliveryPlatformInvalid     = errors.New("外卖平台不存在")
+	ErrDeliverySignatureInvalid    = errors.New("外卖平台推送签名无效")
+	ErrDeliveryPayloadInvalid      = errors.New("外卖平台推送内容无效")
+	ErrDeliveryStoreNotExists      = errors.New("门店未绑定该外卖平台")
+	ErrDeliveryStoreExists         = errors.New("门店已绑定该外卖平台或平台门店已被绑定")
+	ErrDeliveryStoreIDRequired     = errors.New("请填写平台门店ID")
+	ErrDeliveryAutoAcceptInvalid   = errors.New("自动接单金额上限不能为负数")
+	ErrDeliveryMappingNotExists    = errors.New("外卖商品映射不存在")
+	ErrDeliveryMappingExists       = errors.New("平台商品编码已映射")
+	ErrDeliveryMappingSKURequired  = errors.New("请填写平台商品编码")
+	ErrDeliveryOrderNotExists      = errors.New("外卖订单不存在")
+	ErrDeliveryOrderStatusInvalid  = errors.New("外卖订单当前状态不允许该操作")
+	ErrDeliveryOrderBusy           = errors.New("外卖订单正在处理中，请稍后再试")
+	ErrDeliveryOrderUnmapped       = errors.New("外卖订单存在未映射的商品，请先配置商品映射")
+	ErrDeliveryProductUnavailable  = errors.New("商品未开放三方外卖渠道或已停售")
+	ErrDeliveryRejectReasonMissing = errors.New("请填写拒单原因")
+	ErrDeliveryPlatformFailed      = errors.New("外卖平台请求失败，请稍后重试")
+)
+
+// ------------------------------------------------------------
+// 外卖平台
+// ------------------------------------------------------------
+
+// DeliveryPlatformCode 外卖平台
+type DeliveryPlatformCode string
+
+const (
+	DeliveryPlatformGrabFood  DeliveryPlatformCode = "grabfood"  // GrabFood
+	DeliveryPlatformFoodpanda DeliveryPlatformCode = "foodpanda" // Foodpanda
+)
+
+func (DeliveryPlatformCode) Values() []string {
+	return []string{
+		string(DeliveryPlatformGrabFood),
+		string(DeliveryPlatformFoodpanda),
+	}
+}
+
+func (c DeliveryPlatformCode) Valid() bool {
+	return slices.Contains(DeliveryPlatformCode("").Values(), string(c))
+}
+
+func (c DeliveryPlatformCode) Label() string {
+	switch c {
+	case DeliveryPlatformGrabFood:
+		return "GrabFood"
+	case DeliveryPlatformFoodpanda:
+		return "Foodpanda"
+	default:
+		return string(c)
+	}
+}
+
+// DeliveryConfig 外卖平台接入配置
+type DeliveryConfig struct {
+	GrabFood  DeliveryPlatformConfig
+	Foodpanda DeliveryPlatformConfig
+}
+
+// DeliveryPlatformConfig 单个外卖平台的开放接口配置
+type DeliveryPlatformConfig struct {
+	BaseURL       string // 开放平台接口地址
+	ClientID      string // 合作方应用ID
+	ClientSecret  string // 合作方应用密钥
+	WebhookSecret string // 推送签名密钥
+	Timeout       int    `default:"10"` // 请求超时时间（秒）
+}
+
+// DeliveryWebhookEventType 外卖平台推送事件类型
+type DeliveryWebhookEventType string
+
+const (
+	DeliveryWebhookOrderCreated   DeliveryWebhookEventType = "order_created"   // 新订单
+	DeliveryWebhookOrderCancelled DeliveryWebhookEventType = "order_cancelled" // 顾客或平台取消订单
+)
+
+// DeliveryWebhookEvent 外卖平台推送事件，由各平台适配器解析为统一格式
+type DeliveryWebhookEvent struct {
+	Type            DeliveryWebhookEventType
+	PlatformStoreID string                 // 平台门店ID
+	PlatformOrderID string                 // 平台订单ID
+	Order           *DeliveryPlatformOrder // 新订单内容，取消事件为空
+}
+
+// DeliveryPlatformOrder 外卖平台订单内容，金额均为平台结算金额
+type DeliveryPlatformOrder struct {
+	ShortCode     string              // 平台取餐码
+	Items         []DeliveryOrderItem // 商品
+	Subtotal      decimal.Decimal     // 商品小计
+	DeliveryFee   decimal.Decimal     // 配送费
+	Total         decimal.Decimal     // 订单合计
+	CustomerName  string              // 顾客姓名
+	CustomerPhone string              // 顾客电话
+	Address       string              // 配送地址
+	Remark        string              // 订单备注
+	PlacedAt      time.Time           // 下单时间
+}
+
+// DeliveryMenu 推送到外卖平台的门店菜单
+type DeliveryMenu struct {
+	Categories []DeliveryMenuCategory
+}
+
+// DeliveryMenuCategory 外卖菜单分类
+type DeliveryMenuCategory struct {
+	Name  string
+	Items []DeliveryMenuItem
+}
+
+// DeliveryMenuItem 外卖菜单商品，以平台商品编码标识
+type DeliveryMenuItem struct {
+	SKU         string
+	Name        string
+	Description string
+	Image       string
+	Price       decimal.Decimal
+	Available   bool
+}
+
+// DeliverySKUAvailability 平台商品售卖状态
+type DeliverySKUAvailability struct {
+	SKU       string
+	Available bool
+}
+
+// DeliveryPlatform 外卖平台适配器，每个平台实现一个
+type DeliveryPlatform interface {
+	// Code 平台标识
+	Code() DeliveryPlatformCode
+	// ParseWebhook 校验推送签名并解析事件，签名无效时返回 ErrDeliverySignatureInvalid，无需处理的事件返回 nil
+	ParseWebhook(ctx context.Context, header http.Header, body []byte) (*DeliveryWebhookEvent, error)
+	// AcceptOrder 接单
+	AcceptOrder(ctx context.Context, platformStoreID, platformOrderID string) error
+	// RejectOrder 拒单
+	RejectOrder(ctx context.Context, platformStoreID, platformOrderID, reason string) error
+	// MarkReady 通知平台已出餐，等待骑手取餐
+	MarkReady(ctx context.Context, platformStoreID, platformOrderID string) error
+	// PushMenu 全量推送门店菜单
+	PushMenu(ctx context.Context, platformStoreID string, menu *DeliveryMenu) error
+	// UpdateAvailability 更新商品售卖状态（售罄/恢复售卖）
+	UpdateAvailability(ctx context.Context, platformStoreID string, items []DeliverySKUAvailability) error
+}
+
+// DeliveryPlatformRegistry 外卖平台适配器注册表
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/delivery_platform_registry.go -package=mock . DeliveryPlatformRegistry
+type DeliveryPlatformRegistry interface {
+	// Get 获取外卖平台适配器，平台不存在时返回 ErrDeliveryPlatformInvalid
+	Get(code DeliveryPlatformCode) (DeliveryPlatform, error)
+	// Codes 已注册的外卖平台
+	Codes() []DeliveryPlatformCode
+}
+
+// ------------------------------------------------------------
+// 门店绑定与商品映射
+// ------------------------------------------------------------
+
+// DeliveryStore 门店绑定的外卖平台店铺及接单规则
+type DeliveryStore struct {
+	ID                  uuid.UUID            `json:"id"`
+	MerchantID          uuid.UUID            `json:"merchant_id"`            // 品牌商ID
+	StoreID             uuid.UUID            `json:"store_id"`               // 门店ID
+	Platform            DeliveryPlatformCode `json:"platform"`               // 外卖平台
+	PlatformStoreID     string               `json:"platform_store_id"`      // 平台门店ID
+	Enabled             bool                 `json:"enabled"`                // 是否接收平台订单
+	AutoAccept          bool                 `json:"auto_accept"`            // 是否自动接单
+	AutoAcceptMaxAmount decimal.Decimal      `json:"auto_accept_max_amount"` // 自动接单金额上限，为0不限制
+	CreatedAt           time.Time            `json:"created_at"`
+	UpdatedAt           time.Time            `json:"updated_at"`
+}
+
+func (s *DeliveryStore) Validate() error {
+	if !s.Platform.Valid() {
+		return ErrDeliveryPlatformInvalid
+	}
+	if s.PlatformStoreID == "" {
+		return ErrDeliveryStoreIDRequired
+	}
+	if s.AutoAcceptMaxAmount.IsNegative() {
+		return ErrDeliveryAutoAcceptInvalid
+	}
+	return nil
+}
+
+// CanAutoAccept 订单是否满足自动接单规则：开启自动接单、商品均已映射且未超过金额上限
+func (s *DeliveryStore) CanAutoAccept(order *DeliveryOrder) bool {
+	if !s.AutoAccept || !order.Mapped() {
+		return false
+	}
+	return s.AutoAcceptMaxAmount.IsZero() || order.Total.LessThanOrEqual(s.AutoAcceptMaxAmount)
+}
+
+// DeliveryStoreListParams 门店绑定查询参数
+type DeliveryStoreListParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID // 为空时查询品牌商全部门店
+	Platform   DeliveryPlatformCode
+	Enabled    *bool
+}
+
+// DeliveryProductMapping 平台商品编码与本地商品规格的映射，品牌商内各门店共用
+type DeliveryProductMapping struct {
+	ID          uuid.UUID            `json:"id"`
+	MerchantID  uuid.UUID            `json:"merchant_id"`  // 品牌商ID
+	Platform    DeliveryPlatformCode `json:"platform"`     // 外卖平台
+	PlatformSKU string               `json:"platform_sku"` // 平台商品编码
+	ProductID   uuid.UUID            `json:"product_id"`   // 商品ID
+	SpecID      uuid.UUID            `json:"spec_id"`      // 规格ID，为空表示默认规格
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+func (m *DeliveryProductMapping) Validate() error {
+	if !m.Platform.Valid() {
+		return ErrDeliveryPlatformInvalid
+	}
+	if m.PlatformSKU == "" {
+		return ErrDeliveryMappingSKURequired
+	}
+	return nil
+}
+
+// DeliveryProductMappingListParams 商品映射查询参数
+type DeliveryProductMappingListParams struct {
+	MerchantID   uuid.UUID
+	Platform     DeliveryPlatformCode
+	PlatformSKUs []string
+	ProductIDs   []uuid.UUID
+}
+
+// ------------------------------------------------------------
+// 外卖订单
+// ------------------------------------------------------------
+
+// DeliveryOrderStatus 外卖订单状态
+type DeliveryOrderStatus string
+
+const (
+	DeliveryOrderStatusPending   DeliveryOrderStatus = "pending"   // 待接单
+	DeliveryOrderStatusAccepted  DeliveryOrderStatus = "accepted"  // 已接单，制作中
+	DeliveryOrderStatusReady     DeliveryOrderStatus = "ready"     // 已出餐，待骑手取餐
+	DeliveryOrderStatusRejected  DeliveryOrderStatus = "rejected"  // 已拒单
+	DeliveryOrderStatusCancelled DeliveryOrderStatus = "cancelled" // 平台已取消
+)
+
+func (DeliveryOrderStatus) Values() []string {
+	return []string{
+		string(DeliveryOrderStatusPending),
+		string(DeliveryOrderStatusAccepted),
+		string(DeliveryOrderStatusReady),
+		string(DeliveryOrderStatusRejected),
+		string(DeliveryOrderStatusCancelled),
+	}
+}
+
+// DeliveryOrderItem 外卖订单商品，接收时按商品映射补全本地商品与规格
+type DeliveryOrderItem struct {
+	SKU       string          `json:"sku"`        // 平台商品编码
+	Name      string          `json:"name"`       // 平台商品名称
+	Qty       int             `json:"qty"`        // 数量
+	Price     decimal.Decimal `json:"price"`      // 平台单价
+	Remark    string          `json:"remark"`     // 备注（含平台加料/做法说明）
+	ProductID uuid.UUID       `json:"product_id"` // 映射的商品ID，未映射为空
+	SpecID    uuid.UUID       `json:"spec_id"`    // 映射的规格ID
+}
+
+// DeliveryOrder 外卖平台订单，接单后生成门店订单并送厨
+type DeliveryOrder struct {
+	ID              uuid.UUID            `json:"id"`
+	MerchantID      uuid.UUID            `json:"merchant_id"`       // 品牌商ID
+	StoreID         uuid.UUID            `json:"store_id"`          // 门店ID
+	Platform        DeliveryPlatformCode `json:"platform"`          // 外卖平台
+	PlatformOrderID string               `json:"platform_order_id"` // 平台订单ID
+	ShortCode       string               `json:"short_code"`        // 平台取餐码
+	Status          DeliveryOrderStatus  `json:"status"`            // 状态
+	OrderID         uuid.UUID            `json:"order_id"`          // 接单后生成的门店订单ID
+	Items           []DeliveryOrderItem  `json:"items"`             // 商品
+	Subtotal        decimal.Decimal      `json:"subtotal"`          // 商品小计
+	DeliveryFee     decimal.Decimal      `json:"delivery_fee"`      // 配送费
+	Total           decimal.Decimal      `json:"total"`             // 订单合计
+	CustomerName    string               `json:"customer_name"`     // 顾客姓名
+	CustomerPhone   string               `json:"customer_phone"`    // 顾客电话
+	Address         string               `json:"address"`           // 配送地址
+	Remark          string               `json:"remark"`            // 订单备注
+	RejectReason    string               `json:"reject_reason"`     // 拒单原因
+	AutoAccepted    bool                 `json:"auto_accepted"`     // 是否自动接单
+	PlacedAt        time.Time            `json:"placed_at"`         // 下单时间
+	AcceptedAt      *time.Time           `json:"accepted_at"`       // 接单时间
+	ReadyAt         *time.Time           `json:"ready_at"`          // 出餐时间
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+}
+
+// NewDeliveryOrder 根据平台推送创建待接单的外卖订单
+func NewDeliveryOrder(store *DeliveryStore, event *DeliveryWebhookEvent) *DeliveryOrder {
+	o := event.Order
+	return &DeliveryOrder{
+		ID:              uuid.New(),
+		MerchantID:      store.MerchantID,
+		StoreID:         store.StoreID,
+		Platform:        store.Platform,
+		PlatformOrderID: event.PlatformOrderID,
+		ShortCode:       o.ShortCode,
+		Status:          DeliveryOrderStatusPending,
+		Items:           o.Items,
+		Subtotal:        o.Subtotal,
+		DeliveryFee:     o.DeliveryFee,
+		Total:           o.Total,
+		CustomerName:    o.CustomerName,
+		CustomerPhone:   o.CustomerPhone,
+		Address:         o.Address,
+		Remark:          o.Remark,
+		PlacedAt:        o.PlacedAt,
+	}
+}
+
+// ApplyMappings 按商品映射补全商品与规格，已映射的保留原映射
+func (o *DeliveryOrder) ApplyMappings(mappings []*DeliveryProductMapping) {
+	bySKU := make(map[string]*DeliveryProductMapping, len(mappings))
+	for _, m := range mappings {
+		bySKU[m.PlatformSKU] = m
+	}
+	for idx := range o.Items {
+		item := &o.Items[idx]
+		if item.ProductID != uuid.Nil {
+			continue
+		}
+		if m, ok := bySKU[item.SKU]; ok {
+			item.ProductID = m.ProductID
+			item.SpecID = m.SpecID
+		}
+	}
+}
+
+// Mapped 商品是否均已映射
+func (o *DeliveryOrder) Mapped() bool {
+	for _, item := range o.Items {
+		if item.ProductID == uuid.Nil {
+			return false
+		}
+	}
+	return true
+}
+
+// SKUs 订单商品的平台编码
+func (o *DeliveryOrder) SKUs() []string {
+	skus := make([]string, 0, len(o.Items))
+	for _, item := range o.Items {
+		if !slices.Contains(skus, item.SKU) {
+			skus = append(skus, item.SKU)
+		}
+	}
+	return skus
+}
+
+// Accept 接单，记录生成的门店订单
+func (o *DeliveryOrder) Accept(orderID uuid.UUID, auto bool, now time.Time) error {
+	if o.Status != DeliveryOrderStatusPending {
+		return ErrDeliveryOrderStatusInvalid
+	}
+	o.Status = DeliveryOrderStatusAccepted
+	o.OrderID = orderID
+	o.AutoAccepted = auto
+	o.AcceptedAt = &now
+	return nil
+}
+
+// Reject 拒单
+func (o *DeliveryOrder) Reject(reason string) error {
+	if o.Status != DeliveryOrderStatusPending {
+		return ErrDeliveryOrderStatusInvalid
+	}
+	o.Status = DeliveryOrderStatusRejected
+	o.RejectReason = reason
+	return nil
+}
+
+// Ready 出餐
+func (o *DeliveryOrder) Ready(now time.Time) error {
+	if o.Status != DeliveryOrderStatusAccepted {
+		return ErrDeliveryOrderStatusInvalid
+	}
+	o.Status = DeliveryOrderStatusReady
+	o.ReadyAt = &now
+	return nil
+}
+
+// Cancel 平台取消订单，已拒单或已取消的不再处理
+func (o *DeliveryOrder) Cancel() bool {
+	if o.Status == DeliveryOrderStatusRejected || o.Status == DeliveryOrderStatusCancelled {
+		return false
+	}
+	o.Status = DeliveryOrderStatusCancelled
+	return true
+}
+
+// ApplyDeliveryAmount 按平台结算金额补充配送费，商品小计与平台合计的差额计为平台优惠或其他费用，应收与平台合计一致
+func (o *Order) ApplyDeliveryAmount(deliveryFee, total decimal.Decimal) {
+	o.Amount.DeliveryFee = deliveryFee
+	due := o.Amount.ItemsSubtotal.Add(deliveryFee)
+	switch {
+	case due.GreaterThan(total):
+		o.Amount.DiscountTotal = o.Amount.DiscountTotal.Add(due.Sub(total))
+	case due.LessThan(total):
+		o.Amount.FeeTotal = o.Amount.FeeTotal.Add(total.Sub(due))
+	}
+	o.Amount.AmountDue = total
+}
+
+// PayByDeliveryPlatform 顾客已在外卖平台付款，以平台订单ID记录在线支付流水并标记订单已支付
+func (o *Order) PayByDeliveryPlatform(platformOrderID string, paidAt time.Time) {
+	o.Payments = append(o.Payments, OrderPayment{
+		PaymentNo:     platformOrderID,
+		PaymentMethod: PaymentMethodPayTypeOnlinePayment,
+		PaymentStatus: PaymentStatusPaid,
+		PaymentAmount: o.Amount.AmountDue,
+		RefundAmount:  decimal.Zero,
+		ChangeAmount:  decimal.Zero,
+		PaidAt:        paidAt,
+		RequestedAt:   paidAt,
+	})
+	o.Amount.AmountPaid = o.Amount.AmountDue
+	o.PaymentStatus = PaymentStatusPaid
+	o.PaidAt = paidAt
+}
+
+// DeliveryOrderListParams 外卖订单查询参数
+type DeliveryOrderListParams struct {
+	StoreID    uuid.UUID
+	Statuses   []DeliveryOrderStatus
+	PlacedFrom time.Time // 为空时不限制
+}
+
+// DeliveryOrderPush 外卖订单推送内容，门店 POS 收到后提示接单或刷新列表
+type DeliveryOrderPush struct {
+	ID        uuid.UUID            `json:"id"`         // 外卖订单ID
+	Platform  DeliveryPlatformCode `json:"platform"`   // 外卖平台
+	ShortCode string               `json:"short_code"` // 平台取餐码
+	Status    DeliveryOrderStatus  `json:"status"`     // 状态
+	OrderID   uuid.UUID            `json:"order_id"`   // 门店订单ID
+}
+
+func NewDeliveryOrderPush(o *DeliveryOrder) DeliveryOrderPush {
+	return DeliveryOrderPush{
+		ID:        o.ID,
+		Platform:  o.Platform,
+		ShortCode: o.ShortCode,
+		Status:    o.Status,
+		OrderID:   o.OrderID,
+	}
+}
+
+// ------------------------------------------------------------
+// 仓储和用例接口
+// ------------------------------------------------------------
+
+// DeliveryStoreRepository 门店外卖平台绑定仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/delivery_store_repository.go -package=mock . DeliveryStoreRepository
+type DeliveryStoreRepository interface {
+	Create(ctx context.Context, store *DeliveryStore) error
+	Update(ctx context.Context, store *DeliveryStore) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*DeliveryStore, error)
+	// FindByPlatformStore 按平台门店ID查询绑定，用于匹配平台推送
+	FindByPlatformStore(ctx context.Context, platform DeliveryPlatformCode, platformStoreID string) (*DeliveryStore, error)
+	FindByStore(ctx context.Context, storeID uuid.UUID, platform DeliveryPlatformCode) (*DeliveryStore, error)
+	List(ctx context.Context, params DeliveryStoreListParams) ([]*DeliveryStore, error)
+}
+
+// DeliveryProductMappingRepository 外卖商品映射仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/delivery_product_mapping_repository.go -package=mock . DeliveryProductMappingRepository
+type DeliveryProductMappingRepository interface {
+	Create(ctx context.Context, mapping *DeliveryProductMapping) error
+	Update(ctx context.Context, mapping *DeliveryProductMapping) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*DeliveryProductMapping, error)
+	List(ctx context.Context, params DeliveryProductMappingListParams) ([]*DeliveryProductMapping, error)
+}
+
+// DeliveryOrderRepository 外卖订单仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/delivery_order_repository.go -package=mock . DeliveryOrderRepository
+type DeliveryOrderRepository interface {
+	Create(ctx context.Context, order *DeliveryOrder) error
+	// Update 更新状态、商品映射、关联订单及接单/出餐时间
+	Update(ctx context.Context, order *DeliveryOrder) error
+	FindByID(ctx context.Context, id uuid.UUID) (*DeliveryOrder, error)
+	FindByPlatformOrder(ctx context.Context, platform DeliveryPlatformCode, platformOrderID string) (*DeliveryOrder, error)
+	// List 按下单时间倒序查询门店外卖订单
+	List(ctx context.Context, params DeliveryOrderListParams) ([]*DeliveryOrder, error)
+}
+
+// DeliverySettingInteractor 外卖平台接入配置用例接口：门店绑定、商品映射、菜单与售罄同步
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/delivery_setting_interactor.go -package=mock . DeliverySettingInteractor
+type DeliverySettingInteractor interface {
+	// Platforms 已接入的外卖平台
+	Platforms(ctx context.Context) []DeliveryPlatformCode
+	// ListStores 查询门店绑定，storeID 为空时查询品牌商全部门店
+	ListStores(ctx context.Context, merchantID, storeID uuid.UUID) ([]*DeliveryStore, error)
+	// SaveStore 保存门店绑定，门店在同一平台只能绑定一个店铺
+	SaveStore(ctx context.Context, store *DeliveryStore) error
+	DeleteStore(ctx context.Context, merchantID, storeID, id uuid.UUID) error
+
+	ListMappings(ctx context.Context, merchantID uuid.UUID, platform DeliveryPlatformCode) ([]*DeliveryProductMapping, error)
+	SaveMapping(ctx context.Context, mapping *DeliveryProductMapping) error
+	DeleteMapping(ctx context.Context, merchantID, id uuid.UUID) error
+
+	// PushMenu 将门店三方外卖渠道的菜单全量推送到平台，只推送已映射的商品
+	PushMenu(ctx context.Context, merchantID, storeID uuid.UUID, platform DeliveryPlatformCode) error
+	// SyncAvailability 商品停售/恢复售卖后同步到已绑定的平台，失败只记录日志
+	SyncAvailability(ctx context.Context, product *Product)
+}
+
+// DeliveryOrderInteractor 外卖订单用例接口：接收平台推送、接单/拒单、出餐通知
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/delivery_order_interactor.go -package=mock . DeliveryOrderInteractor
+type DeliveryOrderInteractor interface {
+	// HandleWebhook 校验并处理平台推送，重复推送幂等处理；满足自动接单规则时自动接单
+	HandleWebhook(ctx context.Context, platform DeliveryPlatformCode, header http.Header, body []byte) error
+	// List 查询门店外卖订单
+	List(ctx context.Context, merchantID uuid.UUID, params DeliveryOrderListParams) ([]*DeliveryOrder, error)
+	// Accept 接单：通知平台并生成门店订单送厨
+	Accept(ctx context.Context, merchantID, storeID, id uuid.UUID) (*DeliveryOrder, error)
+	// Reject 拒单并通知平台
+	Reject(ctx context.Context, merchantID, storeID, id uuid.UUID, reason string) (*DeliveryOrder, error)
+	// MarkReady 出餐并通知平台
+	MarkReady(ctx context.Context, merchantID, storeID, id uuid.UUID) (*DeliveryOrder, error)
+}
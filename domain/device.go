@@ -13,6 +13,7 @@ var (
 	ErrDeviceNotExists  = errors.New("设备不存在")
 	ErrDeviceNameExists = errors.New("设备名称已存在")
 	ErrDeviceCodeExists = errors.New("设备编号已存在")
+	ErrDeviceNotKiosk   = errors.New("设备不是自助点餐机")
 )
 
 // DeviceRepository 设备仓储接口
@@ -29,6 +30,10 @@ type DeviceRepository interface {
 	Exists(ctx context.Context, params DeviceExistsParams) (exists bool, err error)
 	OnLine(ctx context.Context, ids ...uuid.UUID) (err error)
 	OffLine(ctx context.Context, ids ...uuid.UUID) (err error)
+	// FindByKioskToken 根据自助点餐机访问令牌查询设备
+	FindByKioskToken(ctx context.Context, token string) (device *Device, err error)
+	// UpdateKioskToken 更新自助点餐机访问令牌，旧令牌立即失效
+	UpdateKioskToken(ctx context.Context, id uuid.UUID, token string) (err error)
 }
 
 // DeviceInteractor 设备用例接口
@@ -42,19 +47,30 @@ type DeviceInteractor interface {
 	GetDevices(ctx context.Context, pager *upagination.Pagination, filter *DeviceListFilter, orderBys ...DeviceOrderBy) (devices []*Device, total int, err error)
 	DeviceSimpleUpdate(ctx context.Context, updateField DeviceSimpleUpdateType, device *Device, user User) (err error)
 	SyncStoreDeviceStatus(ctx context.Context, merchantID, storeID uuid.UUID, deviceCodes ...string) (err error)
+	// ResetKioskToken 重新生成自助点餐机访问令牌，令牌仅在生成时返回一次
+	ResetKioskToken(ctx context.Context, id uuid.UUID, user User) (token string, err error)
 }
 
 // DeviceType 设备类型
-// 收银机用于收银/展示，打印机用于小票或标签打印。
+// 收银机用于收银/展示，打印机用于小票或标签打印，自助点餐机供顾客自助下单。
 type DeviceType string
 
 const (
 	DeviceTypeCashier DeviceType = "cashier" // 收银机
 	DeviceTypePrinter DeviceType = "printer" // 打印机
+	DeviceTypeKiosk   DeviceType = "kiosk"   // 自助点餐机
 )
 
 func (DeviceType) Values() []string {
-	return []string{string(DeviceTypeCashier), string(DeviceTypePrinter)}
+	return []string{string(DeviceTypeCashier), string(DeviceTypePrinter), string(DeviceTypeKiosk)}
+}
+
+// deviceKioskTokenLength 自助点餐机访问令牌长度
+const deviceKioskTokenLength = 32
+
+// NewDeviceKioskToken 生成自助点餐机访问令牌
+func NewDeviceKioskToken() (string, error) {
+	return randomCode(deviceKioskTokenLength)
 }
 
 // DeviceStatus 在线/离线状态
@@ -158,6 +174,7 @@ type Device struct {
 	IP                     string                 `json:"ip"`                        // 设备 IP 地址
 	Status                 DeviceStatus           `json:"status"`                    // 设备状态
 	PaperSize              PaperSize              `json:"paper_size"`                // 打印纸张尺寸
+	PrintLocale            string                 `json:"print_locale"`              // 打印语言（en-US、zh-CN、ms-MY，为空时使用商品原始名称）
 	ConnectType            DeviceConnectType      `json:"connect_type"`              // 设备连接类型 inside内置 / outside外置
 	StallID                uuid.UUID              `json:"stall_id"`                  // 出品部门 ID
 	OrderChannels          []OrderChannel         `json:"order_channels"`            // 订单来源
@@ -165,6 +182,8 @@ type Device struct {
 	DeviceStallPrintType   DeviceStallPrintType   `json:"device_stall_print_type"`   // 出品部门总分单
 	DeviceStallReceiptType DeviceStallReceiptType `json:"device_stall_receipt_type"` // 打印出品部门全部票据
 	OpenCashDrawer         bool                   `json:"open_cash_drawer"`          // 收银机 开钱箱
+	KioskPayModes          []KioskPayMode         `json:"kiosk_pay_modes"`           // 自助点餐机 支持的支付方式
+	KioskToken             string                 `json:"-"`                         // 自助点餐机 访问令牌
 	SortOrder              int                    `json:"sort_order"`                // 排序
 	CreatedAt              time.Time              `json:"created_at"`                // 创建时间
 	UpdatedAt              time.Time              `json:"updated_at"`                // 更新时间
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDiningTableNotExists  = errors.New("桌台不存在")
+	ErrDiningTableNameExists = errors.New("桌台名称已存在")
+	ErrDiningTableDisabled   = errors.New("桌台已停用")
+)
+
+// diningTableQRTokenLength 桌台二维码令牌长度
+const diningTableQRTokenLength = 16
+
+// DiningTable 门店桌台，顾客扫描桌台二维码点餐
+type DiningTable struct {
+	ID         uuid.UUID `json:"id"`
+	MerchantID uuid.UUID `json:"merchant_id"` // 品牌商ID
+	StoreID    uuid.UUID `json:"store_id"`    // 门店ID
+	Name       string    `json:"name"`        // 桌台名称
+	Seats      int       `json:"seats"`       // 座位数
+	QRToken    string    `json:"qr_token"`    // 二维码令牌
+	Enabled    bool      `json:"enabled"`     // 是否启用
+	SortOrder  int       `json:"sort_order"`  // 排序，值越小越靠前
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// NewDiningTableQRToken 生成桌台二维码令牌
+func NewDiningTableQRToken() (string, error) {
+	return randomCode(diningTableQRTokenLength)
+}
+
+type DiningTableExistsParams struct {
+	StoreID   uuid.UUID
+	Name      string
+	ExcludeID uuid.UUID
+}
+
+// DiningTableRepository 桌台仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/dining_table_repository.go -package=mock . DiningTableRepository
+type DiningTableRepository interface {
+	Create(ctx context.Context, table *DiningTable) error
+	Update(ctx context.Context, table *DiningTable) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*DiningTable, error)
+	FindByQRToken(ctx context.Context, token string) (*DiningTable, error)
+	Exists(ctx context.Context, params DiningTableExistsParams) (bool, error)
+	// ListByStoreID 查询门店全部桌台，按排序升序
+	ListByStoreID(ctx context.Context, storeID uuid.UUID) ([]*DiningTable, error)
+}
+
+// DiningTableInteractor 桌台用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/dining_table_interactor.go -package=mock . DiningTableInteractor
+type DiningTableInteractor interface {
+	Create(ctx context.Context, table *DiningTable, user User) error
+	Update(ctx context.Context, table *DiningTable, user User) error
+	Delete(ctx context.Context, id uuid.UUID, user User) error
+	List(ctx context.Context, user User) ([]*DiningTable, error)
+	// ResetQRToken 重新生成桌台二维码，旧二维码立即失效
+	ResetQRToken(ctx context.Context, id uuid.UUID, user User) (*DiningTable, error)
+}
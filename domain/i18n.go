@@ -0,0 +1,115 @@
+package domain
+
+// LocalizedText 多语言文本，键为语言（en-US、zh-CN、ms-MY），值为该语言下的文本
+//
+// 实体的原始字段（如 Name）作为未配置翻译时的默认文本。
+type LocalizedText map[string]string
+
+// Get 返回指定语言的文本，未配置该语言时返回 fallback
+func (t LocalizedText) Get(locale, fallback string) string {
+	if text := t[locale]; text != "" {
+		return text
+	}
+	return fallback
+}
+
+// Localize 将商品名称、描述及分类、口味做法名称替换为指定语言的文本
+//
+// 仅用于展示接口的响应，替换后的商品不应再保存。
+func (p *Product) Localize(locale string) {
+	if p == nil || locale == "" {
+		return
+	}
+	p.Name = p.NameI18n.Get(locale, p.Name)
+	p.Description = p.DescriptionI18n.Get(locale, p.Description)
+	p.Category.Localize(locale)
+	for _, rel := range p.AttrRelations {
+		rel.Attr.Localize(locale)
+		rel.AttrItem.Localize(locale)
+	}
+}
+
+// Localize 将商品集合中的文本替换为指定语言
+func (ps Products) Localize(locale string) {
+	for _, p := range ps {
+		p.Localize(locale)
+	}
+}
+
+// Localize 将分类及其父、子分类名称替换为指定语言的文本
+func (c *Category) Localize(locale string) {
+	if c == nil || locale == "" {
+		return
+	}
+	c.Name = c.NameI18n.Get(locale, c.Name)
+	c.Parent.Localize(locale)
+	for _, child := range c.Childrens {
+		child.Localize(locale)
+	}
+}
+
+// Localize 将口味做法及其做法项名称替换为指定语言的文本
+func (a *ProductAttr) Localize(locale string) {
+	if a == nil || locale == "" {
+		return
+	}
+	a.Name = a.NameI18n.Get(locale, a.Name)
+	for _, item := range a.Items {
+		item.Localize(locale)
+	}
+}
+
+// Localize 将口味做法项名称替换为指定语言的文本
+func (i *ProductAttrItem) Localize(locale string) {
+	if i == nil || locale == "" {
+		return
+	}
+	i.Name = i.NameI18n.Get(locale, i.Name)
+}
+
+// Localize 将菜单中商品的文本替换为指定语言
+func (ms Menus) Localize(locale string) {
+	for _, m := range ms {
+		for _, item := range m.Items {
+			item.Product.Localize(locale)
+		}
+	}
+}
+
+// Localize 将备注名称替换为指定语言的文本
+func (r *Remark) Localize(locale string) {
+	if r == nil || locale == "" {
+		return
+	}
+	r.Name = r.NameI18n.Get(locale, r.Name)
+}
+
+// Localize 将备注集合中的名称替换为指定语言
+func (rs Remarks) Localize(locale string) {
+	for _, r := range rs {
+		r.Localize(locale)
+	}
+}
+
+// Localize 将厨房单商品及所属套餐名称替换为指定语言的文本（按打印机的打印语言出单）
+func (t *KitchenTicket) Localize(locale string) {
+	if t == nil || locale == "" {
+		return
+	}
+	for i := range t.Items {
+		item := &t.Items[i]
+		item.ProductName = item.ProductNameI18n.Get(locale, item.ProductName)
+		item.SetMealName = item.SetMealNameI18n.Get(locale, item.SetMealName)
+	}
+}
+
+// Localize 将小票商品名称替换为指定语言的文本（按打印机的打印语言出单）
+func (r *OrderReceipt) Localize(locale string) {
+	if r == nil || locale == "" {
+		return
+	}
+	for i := range r.Items {
+		item := &r.Items[i]
+		item.ProductName = item.ProductNameI18n.Get(locale, item.ProductName)
+	}
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKitchenTicketLocalize(t *testing.T) {
+	order := &Order{
+		OrderProducts: []OrderProduct{
+			{
+				Index:           1,
+				ProductID:       uuid.New(),
+				ProductName:     "宫保鸡丁",
+				ProductNameI18n: LocalizedText{"en-US": "Kung Pao Chicken"},
+				Qty:             1,
+			},
+			{
+				Index:           1,
+				ProductID:       uuid.New(),
+				ProductName:     "双人套餐",
+				ProductNameI18n: LocalizedText{"en-US": "Combo for Two"},
+				ProductType:     ProductTypeSetMeal,
+				Qty:             1,
+				Groups: SetMealGroups{
+					{Details: []*SetMealDetail{
+						{ProductID: uuid.New(), Quantity: 1, SelectedProductName: "米饭", SelectedProductNameI18n: LocalizedText{"en-US": "Rice"}},
+						{ProductID: uuid.New(), Quantity: 1, SelectedProductName: "可乐"},
+					}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		locale   string
+		products []string
+		setMeals []string
+	}{
+		{locale: "", products: []string{"米饭", "可乐", "宫保鸡丁"}, setMeals: []string{"双人套餐", "双人套餐", ""}},
+		{locale: "en-US", products: []string{"Rice", "可乐", "Kung Pao Chicken"}, setMeals: []string{"Combo for Two", "Combo for Two", ""}},
+		{locale: "ms-MY", products: []string{"米饭", "可乐", "宫保鸡丁"}, setMeals: []string{"双人套餐", "双人套餐", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			ticket := NewKitchenTicket(order, 1, false, nil)
+			ticket.Localize(tt.locale)
+			var products, setMeals []string
+			for _, item := range ticket.Items {
+				products = append(products, item.ProductName)
+				setMeals = append(setMeals, item.SetMealName)
+			}
+			require.ElementsMatch(t, tt.products, products)
+			require.ElementsMatch(t, tt.setMeals, setMeals)
+
+			receipt := NewOrderReceipt(order, nil, nil)
+			receipt.Localize(tt.locale)
+			require.Equal(t, order.OrderProducts[0].ProductNameI18n.Get(tt.locale, "宫保鸡丁"), receipt.Items[0].ProductName)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrKioskTokenInvalid          = errors.New("自助点餐机令牌无效")
+	ErrKioskDeviceDisabled        = errors.New("自助点餐机已停用")
+	ErrKioskStoreClosed           = errors.New("门店已停业")
+	ErrKioskProductUnavailable    = errors.New("商品暂不可自助点餐")
+	ErrKioskCartEmpty             = errors.New("请选择商品")
+	ErrKioskItemQtyInvalid        = errors.New("商品数量无效")
+	ErrKioskPayModeInvalid        = errors.New("自助点餐机不支持该支付方式")
+	ErrKioskOrderNotExists        = errors.New("订单不存在")
+	ErrKioskOrderPaid             = errors.New("订单已支付")
+	ErrKioskPaymentAccountMissing = errors.New("门店未配置支付账户")
+	ErrKioskPaymentFailed         = errors.New("支付失败")
+	ErrKioskPaymentBusy           = errors.New("订单正在支付，请稍后再试")
+)
+
+// ------------------------------------------------------------
+// 自助点餐机支付方式
+// ------------------------------------------------------------
+
+// KioskPayMode 自助点餐机支付方式
+type KioskPayMode string
+
+const (
+	KioskPayModePayAtKiosk   KioskPayMode = "pay_at_kiosk"   // 机上支付：扫描顾客付款码，支付成功后送厨
+	KioskPayModePayAtCounter KioskPayMode = "pay_at_counter" // 前台付款：凭取餐号到前台付款，付款后送厨
+)
+
+func (KioskPayMode) Values() []string {
+	return []string{
+		string(KioskPayModePayAtKiosk),
+		string(KioskPayModePayAtCounter),
+	}
+}
+
+// SupportsKioskPayMode 自助点餐机是否支持该支付方式，未配置时两种方式均支持
+func (d *Device) SupportsKioskPayMode(mode KioskPayMode) bool {
+	if !slices.Contains(KioskPayMode("").Values(), string(mode)) {
+		return false
+	}
+	return len(d.KioskPayModes) == 0 || slices.Contains(d.KioskPayModes, mode)
+}
+
+// ------------------------------------------------------------
+// 自助点餐机上下文
+// ------------------------------------------------------------
+
+type (
+	kioskKey struct{}
+)
+
+// NewKioskContext 保存通过令牌认证的自助点餐机
+func NewKioskContext(ctx context.Context, d *Device) context.Context {
+	return context.WithValue(ctx, kioskKey{}, d)
+}
+
+func FromKioskContext(ctx context.Context) *Device {
+	if v, ok := ctx.Value(kioskKey{}).(*Device); ok {
+		return v
+	}
+	return nil
+}
+
+// ------------------------------------------------------------
+// 订单在线支付
+// ------------------------------------------------------------
+
+// NewOnlinePaymentNo 生成在线支付交易号：订单号 + 2位支付序号，重新发起支付时序号递增
+func (o *Order) NewOnlinePaymentNo() string {
+	return fmt.Sprintf("%s%02d", o.OrderNo, len(o.Payments)+1)
+}
+
+// PendingOnlinePayment 支付中的在线支付
+func (o *Order) PendingOnlinePayment() *OrderPayment {
+	for idx := range o.Payments {
+		p := &o.Payments[idx]
+		if p.PaymentMethod == PaymentMethodPayTypeOnlinePayment && p.PaymentStatus == PaymentStatusPaying {
+			return p
+		}
+	}
+	return nil
+}
+
+// StartOnlinePayment 发起在线支付，记录支付中的支付流水，待网关返回结果后完成或撤销
+func (o *Order) StartOnlinePayment(paymentNo string, amount decimal.Decimal, pos OrderPOS, now time.Time) {
+	o.Payments = append(o.Payments, OrderPayment{
+		PaymentNo:     paymentNo,
+		PaymentMethod: PaymentMethodPayTypeOnlinePayment,
+		PaymentStatus: PaymentStatusPaying,
+		PaymentAmount: amount,
+		RefundAmount:  decimal.Zero,
+		ChangeAmount:  decimal.Zero,
+		POS:           pos,
+		RequestedAt:   now,
+	})
+	o.PaymentStatus = PaymentStatusPaying
+}
+
+// CompleteOnlinePayment 在线支付成功，累加实收并在付清时标记订单已支付
+func (o *Order) CompleteOnlinePayment(paymentNo string, paidAt time.Time) {
+	idx := slices.IndexFunc(o.Payments, func(p OrderPayment) bool { return p.PaymentNo == paymentNo })
+	if idx < 0 || o.Payments[idx].PaymentStatus != PaymentStatusPaying {
+		return
+	}
+	o.Payments[idx].PaymentStatus = PaymentStatusPaid
+	o.Payments[idx].PaidAt = paidAt
+	o.Amount.AmountPaid = o.Amount.AmountPaid.Add(o.Payments[idx].PaymentAmount)
+	if o.Amount.AmountPaid.GreaterThanOrEqual(o.Amount.AmountDue) {
+		o.PaymentStatus = PaymentStatusPaid
+		o.PaidAt = paidAt
+	} else {
+		o.PaymentStatus = PaymentStatusUnpaid
+	}
+}
+
+// CancelOnlinePayment 在线支付失败，支付流水保留为未支付便于对账，交易号不再复用
+func (o *Order) CancelOnlinePayment(paymentNo string) {
+	for idx := range o.Payments {
+		p := &o.Payments[idx]
+		if p.PaymentNo == paymentNo && p.PaymentStatus == PaymentStatusPaying {
+			p.PaymentStatus = PaymentStatusUnpaid
+		}
+	}
+	if o.PaymentStatus == PaymentStatusPaying {
+		o.PaymentStatus = PaymentStatusUnpaid
+	}
+}
+
+// ------------------------------------------------------------
+// 用例接口
+// ------------------------------------------------------------
+
+// KioskInfo 自助点餐机信息
+type KioskInfo struct {
+	DeviceID   uuid.UUID      `json:"device_id"`   // 设备ID
+	DeviceName string         `json:"device_name"` // 设备名称
+	MerchantID uuid.UUID      `json:"merchant_id"` // 品牌商ID
+	StoreID    uuid.UUID      `json:"store_id"`    // 门店ID
+	StoreName  string         `json:"store_name"`  // 门店名称
+	PayModes   []KioskPayMode `json:"pay_modes"`   // 支持的支付方式
+}
+
+// KioskOrderItemParams 自助点餐商品参数
+type KioskOrderItemParams struct {
+	ProductID uuid.UUID
+	SpecID    uuid.UUID // 为空时使用默认规格
+	Attrs     []TableCartAttr
+	Groups    SetMealGroups // 套餐商品的分组选择
+	Qty       int
+	Remark    string
+}
+
+// KioskOrderParams 自助点餐下单参数
+type KioskOrderParams struct {
+	Items   []KioskOrderItemParams
+	PayMode KioskPayMode
+	Remark  string
+}
+
+// KioskUpsell 套餐推荐：已选单品可组成的套餐及优惠金额
+type KioskUpsell struct {
+	SetMeal    *Product        `json:"set_meal"`    // 推荐套餐
+	Price      decimal.Decimal `json:"price"`       // 套餐价
+	ItemsPrice decimal.Decimal `json:"items_price"` // 套餐内商品单点合计
+	Saving     decimal.Decimal `json:"saving"`      // 选择套餐可节省金额
+	ProductIDs []uuid.UUID     `json:"product_ids"` // 套餐中包含的已选商品ID
+}
+
+// KioskInteractor 自助点餐机用例接口，设备通过访问令牌认证
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/kiosk_interactor.go -package=mock . KioskInteractor
+type KioskInteractor interface {
+	// Authenticate 校验访问令牌，返回已启用的自助点餐机
+	Authenticate(ctx context.Context, token string) (*Device, error)
+	GetInfo(ctx context.Context, device *Device) (*KioskInfo, error)
+	// ListMenus 查询门店可自助点餐的菜单
+	ListMenus(ctx context.Context, device *Device) (Menus, error)
+	// ListUpsells 根据已选单品推荐可组成的套餐
+	ListUpsells(ctx context.Context, device *Device, productIDs []uuid.UUID) ([]*KioskUpsell, error)
+	// Submit 下单并分配取餐号，机上支付的订单待支付成功后送厨，前台付款的订单待前台收款后送厨
+	Submit(ctx context.Context, device *Device, params KioskOrderParams) (*Order, error)
+	// Pay 扫描顾客付款码发起支付，网关处理中时需调用 QueryPayment 查询结果
+	Pay(ctx context.Context, device *Device, orderID uuid.UUID, authCode string) (*Order, error)
+	// QueryPayment 查询支付中订单的支付结果
+	QueryPayment(ctx context.Context, device *Device, orderID uuid.UUID) (*Order, error)
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrKitchenTicketNotExists = errors.New("厨房单不存在")
+)
+
+// KitchenTicketStatus 厨房单状态
+type KitchenTicketStatus string
+
+const (
+	KitchenTicketStatusHeld    KitchenTicketStatus = "held"    // 待支付：先付后吃的订单支付后才送厨
+	KitchenTicketStatusPending KitchenTicketStatus = "pending" // 待出单
+	KitchenTicketStatusPrinted KitchenTicketStatus = "printed" // 已出单
+)
+
+func (KitchenTicketStatus) Values() []string {
+	return []string{
+		string(KitchenTicketStatusHeld),
+		string(KitchenTicketStatusPending),
+		string(KitchenTicketStatusPrinted),
+	}
+}
+
+// KitchenTicket 厨房单，记录一次下单需要出品的商品，由门店 POS 拉取后按出品部门打印
+type KitchenTicket struct {
+	ID         uuid.UUID           `json:"id"`
+	MerchantID uuid.UUID           `json:"merchant_id"` // 品牌商ID
+	StoreID    uuid.UUID           `json:"store_id"`    // 门店ID
+	OrderID    uuid.UUID           `json:"order_id"`    // 订单ID
+	OrderNo    string              `json:"order_no"`    // 订单号
+	TableID    uuid.UUID           `json:"table_id"`    // 桌台ID
+	TableName  string              `json:"table_name"`  // 桌台名称
+	PickupNo   string              `json:"pickup_no"`   // 取餐号
+	Batch      int                 `json:"batch"`       // 下单序号（对应订单商品 Index）
+	Source     Channel             `json:"source"`      // 下单来源
+	Status     KitchenTicketStatus `json:"status"`      // 状态
+	Items      []KitchenTicketItem `json:"items"`       // 出品商品
+	Remark     string              `json:"remark"`      // 整单备注
+	PrintedAt  *time.Time          `json:"printed_at"`  // 出单时间
+	CreatedAt  time.Time           `json:"created_at"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+// KitchenTicketItem 厨房单商品
+type KitchenTicketItem struct {
+	ProductID       uuid.UUID     `json:"product_id"`                   // 商品ID
+	ProductName     string        `json:"product_name"`                 // 商品名称
+	ProductNameI18n LocalizedText `json:"product_name_i18n,omitempty"`  // 商品名称翻译
+	SpecName        string        `json:"spec_name,omitempty"`          // 规格名称
+	AttrNames       []string      `json:"attr_names,omitempty"`         // 口味做法名称
+	Qty             int           `json:"qty"`                          // 数量
+	Note            string        `json:"note,omitempty"`               // 备注
+	StallID         uuid.UUID     `json:"stall_id"`                     // 出品部门ID
+	SetMealName     string        `json:"set_meal_name,omitempty"`      // 所属套餐名称（套餐按子商品出品）
+	SetMealNameI18n LocalizedText `json:"set_meal_name_i18n,omitempty"` // 所属套餐名称翻译
+}
+
+// NewKitchenTicket 根据订单中指定下单序号的商品生成厨房单，held 为 true 时待订单支付后再送厨
+func NewKitchenTicket(order *Order, batch int, held bool, stallIDs map[uuid.UUID]uuid.UUID) *KitchenTicket {
+	ticket := &KitchenTicket{
+		ID:         uuid.New(),
+		MerchantID: order.MerchantID,
+		StoreID:    order.StoreID,
+		OrderID:    order.ID,
+		OrderNo:    order.OrderNo,
+		TableID:    order.TableID,
+		TableName:  order.TableName,
+		PickupNo:   order.PickupNo,
+		Batch:      batch,
+		Source:     order.Channel,
+		Status:     KitchenTicketStatusPending,
+		Remark:     order.Remark,
+	}
+	if held {
+		ticket.Status = KitchenTicketStatusHeld
+	}
+	for _, op := range order.OrderProducts {
+		if op.Index != batch {
+			continue
+		}
+		// 套餐按实际出品的子商品分别送至各出品部门
+		if op.ProductType == ProductTypeSetMeal && len(op.Groups) > 0 {
+			for _, group := range op.Groups {
+				for _, detail := range group.Details {
+					ticket.Items = append(ticket.Items, KitchenTicketItem{
+						ProductID:       detail.ServedProductID(),
+						ProductName:     detail.SelectedProductName,
+						ProductNameI18n: detail.SelectedProductNameI18n,
+						Qty:             detail.Quantity * op.Qty,
+						Note:            op.Note,
+						StallID:         detail.StallID,
+						SetMealName:     op.ProductName,
+						SetMealNameI18n: op.ProductNameI18n,
+					})
+				}
+			}
+			continue
+		}
+		item := KitchenTicketItem{
+			ProductID:       op.ProductID,
+			ProductName:     op.ProductName,
+			ProductNameI18n: op.ProductNameI18n,
+			Qty:             op.Qty,
+			Note:            op.Note,
+			StallID:         stallIDs[op.ProductID],
+		}
+		if len(op.SpecRelations) > 0 {
+			item.SpecName = op.SpecRelations[0].SpecName
+		}
+		for _, attr := range op.AttrRelations {
+			if attr.AttrItem != nil {
+				item.AttrNames = append(item.AttrNames, attr.AttrItem.Name)
+			}
+		}
+		ticket.Items = append(ticket.Items, item)
+	}
+	return ticket
+}
+
+// KitchenTicketRepository 厨房单仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/kitchen_ticket_repository.go -package=mock . KitchenTicketRepository
+type KitchenTicketRepository interface {
+	Create(ctx context.Context, ticket *KitchenTicket) error
+	// ListByStatus 查询门店指定状态的厨房单，按创建时间升序
+	ListByStatus(ctx context.Context, storeID uuid.UUID, status KitchenTicketStatus) ([]*KitchenTicket, error)
+	// ListByOrderID 查询订单的全部厨房单，按下单序号升序
+	ListByOrderID(ctx context.Context, orderID uuid.UUID) ([]*KitchenTicket, error)
+	// ReleaseByOrderID 订单支付后将待支付的厨房单转为待出单，返回转换的数量
+	ReleaseByOrderID(ctx context.Context, orderID uuid.UUID) (int, error)
+	// MarkPrinted 将门店待出单的厨房单标记为已出单，返回标记的数量
+	MarkPrinted(ctx context.Context, storeID uuid.UUID, ids []uuid.UUID, at time.Time) (int, error)
+}
+
+// KitchenTicketInteractor 厨房单用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/kitchen_ticket_interactor.go -package=mock . KitchenTicketInteractor
+type KitchenTicketInteractor interface {
+	// ListPending 查询门店待出单的厨房单，deviceID 不为空时按该打印机的打印语言输出商品名称
+	ListPending(ctx context.Context, merchantID, storeID, deviceID uuid.UUID) ([]*KitchenTicket, error)
+	MarkPrinted(ctx context.Context, merchantID, storeID uuid.UUID, ids []uuid.UUID) (int, error)
+}
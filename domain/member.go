@@ -0,0 +1,271 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrMemberTierNotExists     = errors.New("会员等级不存在")
+	ErrMemberTierNameExists    = errors.New("会员等级名称已存在")
+	ErrMemberTierInUse         = errors.New("会员等级下有会员，不能删除")
+	ErrMemberNotExists         = errors.New("会员不存在")
+	ErrMemberPhoneInvalid      = errors.New("手机号格式不正确")
+	ErrMemberPhoneExists       = errors.New("手机号已注册会员")
+	ErrMemberOpenIDExists      = errors.New("微信已绑定其他会员")
+	ErrMemberWechatBound       = errors.New("会员已绑定其他微信")
+	ErrMemberDisabled          = errors.New("会员已停用")
+	ErrMemberLookupKeyRequired = errors.New("请提供手机号或会员码")
+	ErrMemberOrderNotExists    = errors.New("订单不存在")
+	ErrMemberOrderPaid         = errors.New("订单已支付，不能关联会员")
+)
+
+// memberPhonePattern 手机号格式（支持国际区号前缀）
+var memberPhonePattern = regexp.MustCompile(`^\+?[0-9]{6,20}$`)
+
+// memberCodeLength 会员码长度
+const memberCodeLength = 16
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// MemberTier 会员等级
+type MemberTier struct {
+	ID                 uuid.UUID `json:"id"`                   // 等级ID
+	MerchantID         uuid.UUID `json:"merchant_id"`          // 品牌商ID
+	Name               string    `json:"name"`                 // 等级名称
+	Level              int       `json:"level"`                // 等级序号，值越大等级越高
+	MemberPriceEnabled bool      `json:"member_price_enabled"` // 是否享受会员价
+	Description        string    `json:"description"`          // 等级说明
+	CreatedAt          time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt          time.Time `json:"updated_at"`           // 更新时间
+}
+
+// MemberTiers 会员等级集合
+type MemberTiers []*MemberTier
+
+// Member 会员
+type Member struct {
+	ID         uuid.UUID  `json:"id"`          // 会员ID
+	MerchantID uuid.UUID  `json:"merchant_id"` // 品牌商ID
+	TierID     uuid.UUID  `json:"tier_id"`     // 会员等级ID
+	Code       string     `json:"code"`        // 会员码（会员二维码内容）
+	Name       string     `json:"name"`        // 姓名
+	Phone      string     `json:"phone"`       // 手机号
+	OpenID     string     `json:"openid"`      // 微信 openid
+	Gender     Gender     `json:"gender"`      // 性别
+	Birthday   *time.Time `json:"birthday"`    // 生日
+	Enabled    bool       `json:"enabled"`     // 是否启用
+	Remark     string     `json:"remark"`      // 备注
+	CreatedAt  time.Time  `json:"created_at"`  // 注册时间
+	UpdatedAt  time.Time  `json:"updated_at"`  // 更新时间
+
+	// 关联信息
+	Tier *MemberTier `json:"tier,omitempty"` // 会员等级
+}
+
+// Members 会员集合
+type Members []*Member
+
+// OrderMember 订单关联的会员信息
+type OrderMember struct {
+	ID       uuid.UUID `json:"id"`        // 会员ID
+	Name     string    `json:"name"`      // 姓名
+	Phone    string    `json:"phone"`     // 手机号
+	TierID   uuid.UUID `json:"tier_id"`   // 会员等级ID
+	TierName string    `json:"tier_name"` // 会员等级名称
+}
+
+// Validate 校验会员信息
+func (m *Member) Validate() error {
+	if !memberPhonePattern.MatchString(m.Phone) {
+		return ErrMemberPhoneInvalid
+	}
+	return nil
+}
+
+// GenerateCode 生成会员码
+func (m *Member) GenerateCode() error {
+	code, err := randomCode(memberCodeLength)
+	if err != nil {
+		return err
+	}
+	m.Code = code
+	return nil
+}
+
+// MemberPriceEnabled 会员是否享受会员价，未设置等级时默认享受
+func (m *Member) MemberPriceEnabled() bool {
+	if !m.Enabled {
+		return false
+	}
+	return m.Tier == nil || m.Tier.MemberPriceEnabled
+}
+
+// ToOrderMember 生成订单关联的会员信息
+func (m *Member) ToOrderMember() *OrderMember {
+	om := &OrderMember{
+		ID:     m.ID,
+		Name:   m.Name,
+		Phone:  m.Phone,
+		TierID: m.TierID,
+	}
+	if m.Tier != nil {
+		om.TierName = m.Tier.Name
+	}
+	return om
+}
+
+// ApplyMemberPrices 按会员单价计算订单商品的会员优惠，prices 的键为订单商品下标
+//
+// 会员价低于售价时，优惠金额为差价乘以有效数量；prices 为空表示清除会员优惠。
+func (o *Order) ApplyMemberPrices(prices map[int]decimal.Decimal) {
+	delta := decimal.Zero
+	for i := range o.OrderProducts {
+		op := &o.OrderProducts[i]
+		d := decimal.Zero
+		if price, ok := prices[i]; ok && !op.IsGift && price.LessThan(op.Price) {
+			qty := op.Qty - op.GiftQty - op.VoidQty
+			if qty > 0 {
+				d = op.Price.Sub(price).Mul(decimal.NewFromInt(int64(qty)))
+			}
+		}
+		lineDelta := d.Sub(op.MemberDiscount)
+		op.MemberDiscount = d
+		op.DiscountAmount = op.DiscountAmount.Add(lineDelta)
+		delta = delta.Add(lineDelta)
+	}
+	o.Amount.DiscountTotal = o.Amount.DiscountTotal.Add(delta)
+	o.Amount.AmountDue = o.Amount.AmountDue.Sub(delta)
+}
+
+// ------------------------------------------------------------
+// 仓储和用例接口
+// ------------------------------------------------------------
+
+// MemberTierRepository 会员等级仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/member_tier_repository.go -package=mock . MemberTierRepository
+type MemberTierRepository interface {
+	Create(ctx context.Context, tier *MemberTier) error
+	Update(ctx context.Context, tier *MemberTier) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*MemberTier, error)
+	Exists(ctx context.Context, params MemberTierExistsParams) (bool, error)
+	// ListByMerchantID 查询品牌商的全部会员等级，按等级序号升序
+	ListByMerchantID(ctx context.Context, merchantID uuid.UUID) (MemberTiers, error)
+}
+
+// MemberRepository 会员仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/member_repository.go -package=mock . MemberRepository
+type MemberRepository interface {
+	Create(ctx context.Context, member *Member) error
+	Update(ctx context.Context, member *Member) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// FindByID 查询会员（含会员等级）
+	FindByID(ctx context.Context, id uuid.UUID) (*Member, error)
+	// FindByPhone 根据手机号查询会员（含会员等级）
+	FindByPhone(ctx context.Context, merchantID uuid.UUID, phone string) (*Member, error)
+	// FindByCode 根据会员码查询会员（含会员等级）
+	FindByCode(ctx context.Context, merchantID uuid.UUID, code string) (*Member, error)
+	// FindByOpenID 根据微信 openid 查询会员（含会员等级）
+	FindByOpenID(ctx context.Context, merchantID uuid.UUID, openID string) (*Member, error)
+	Exists(ctx context.Context, params MemberExistsParams) (bool, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params MemberSearchParams) (*MemberSearchRes, error)
+}
+
+// MemberTierInteractor 会员等级用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/member_tier_interactor.go -package=mock . MemberTierInteractor
+type MemberTierInteractor interface {
+	Create(ctx context.Context, tier *MemberTier, user User) error
+	Update(ctx context.Context, tier *MemberTier, user User) error
+	Delete(ctx context.Context, id uuid.UUID, user User) error
+	List(ctx context.Context, user User) (MemberTiers, error)
+}
+
+// MemberInteractor 会员用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/member_interactor.go -package=mock . MemberInteractor
+type MemberInteractor interface {
+	Create(ctx context.Context, member *Member, user User) error
+	Update(ctx context.Context, member *Member, user User) error
+	Delete(ctx context.Context, id uuid.UUID, user User) error
+	GetDetail(ctx context.Context, id uuid.UUID, user User) (*Member, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params MemberSearchParams) (*MemberSearchRes, error)
+	// Lookup 按手机号或会员码（扫码）查找会员
+	Lookup(ctx context.Context, params MemberLookupParams) (*Member, error)
+	// LookupByCustomer 查找小程序客户在品牌商下的会员
+	LookupByCustomer(ctx context.Context, merchantID uuid.UUID, customer *Customer) (*Member, error)
+	// AttachToOrder 为未支付订单关联会员，并按会员价重新计算订单优惠
+	AttachToOrder(ctx context.Context, params MemberAttachParams) (*Order, error)
+	// ListOrders 查询会员消费记录
+	ListOrders(ctx context.Context, page *upagination.Pagination, id uuid.UUID, user User) (*MemberOrderSearchRes, error)
+}
+
+// ------------------------------------------------------------
+// 参数定义（DTO）
+// ------------------------------------------------------------
+
+// MemberTierExistsParams 等级存在性检查参数
+type MemberTierExistsParams struct {
+	MerchantID uuid.UUID
+	Name       string
+	ExcludeID  uuid.UUID // 排除的ID（用于更新时检查名称唯一性）
+}
+
+// MemberExistsParams 会员存在性检查参数（手机号和 openid 任选其一）
+type MemberExistsParams struct {
+	MerchantID uuid.UUID
+	Phone      string
+	OpenID     string
+	TierID     uuid.UUID
+	ExcludeID  uuid.UUID // 排除的ID（用于更新时检查唯一性）
+}
+
+// MemberSearchParams 会员查询参数
+type MemberSearchParams struct {
+	MerchantID uuid.UUID
+	TierID     uuid.UUID // 会员等级（可选）
+	Keyword    string    // 姓名或手机号（模糊匹配）
+	Enabled    *bool     // 是否启用（可选）
+}
+
+// MemberSearchRes 会员查询结果
+type MemberSearchRes struct {
+	*upagination.Pagination
+	Items Members `json:"items"`
+}
+
+// MemberLookupParams 会员查找参数
+type MemberLookupParams struct {
+	MerchantID uuid.UUID
+	Phone      string // 手机号
+	Code       string // 会员码（扫描会员二维码获得）
+}
+
+// MemberAttachParams 订单关联会员参数
+type MemberAttachParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID
+	OrderID    uuid.UUID
+	MemberID   uuid.UUID
+}
+
+// MemberOrderSearchRes 会员消费记录查询结果
+type MemberOrderSearchRes struct {
+	*upagination.Pagination
+	Items []*Order `json:"items"`
+}
@@ -0,0 +1,404 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrMemberAccountNotExists          = errors.New("会员储值账户不存在")
+	ErrMemberAccountAmountInvalid      = errors.New("金额必须大于0")
+	ErrMemberAccountAmountPrecision    = errors.New("金额最多保留两位小数")
+	ErrMemberAccountBalanceNotEnough   = errors.New("储值余额不足")
+	ErrMemberAccountAdjustRemark       = errors.New("余额调整必须填写原因")
+	ErrMemberAccountAdjustZero         = errors.New("调整金额不能为0")
+	ErrMemberAccountPaymentExceeded    = errors.New("支付金额超过订单待付金额")
+	ErrMemberAccountOrderNotExists     = errors.New("订单不存在")
+	ErrMemberAccountOrderPaid          = errors.New("订单已支付")
+	ErrMemberAccountOrderMemberInvalid = errors.New("订单已关联其他会员")
+	ErrMemberAccountRefundExceeded     = errors.New("退回储值金额超过订单储值支付金额")
+	ErrMemberAccountRefundNoMember     = errors.New("订单未关联会员，不能退回储值余额")
+	ErrMemberAccountPaymentBusy        = errors.New("订单正在支付，请稍后重试")
+)
+
+// ------------------------------------------------------------
+// 枚举定义
+// ------------------------------------------------------------
+
+// MemberAccountTransactionType 储值流水类型
+type MemberAccountTransactionType string
+
+const (
+	MemberAccountTransactionTypeTopUp      MemberAccountTransactionType = "top_up"     // 充值
+	MemberAccountTransactionTypePayment    MemberAccountTransactionType = "payment"    // 消费
+	MemberAccountTransactionTypeRefund     MemberAccountTransactionType = "refund"     // 退款退回
+	MemberAccountTransactionTypeAdjustment MemberAccountTransactionType = "adjustment" // 人工调整
+)
+
+func (MemberAccountTransactionType) Values() []string {
+	return []string{
+		string(MemberAccountTransactionTypeTopUp),
+		string(MemberAccountTransactionTypePayment),
+		string(MemberAccountTransactionTypeRefund),
+		string(MemberAccountTransactionTypeAdjustment),
+	}
+}
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// MemberAccount 会员储值账户
+//
+// 余额分为本金和赠送金两部分，消费时先扣本金再扣赠送金。
+// 账户余额的每一次变动都有对应的储值流水，流水只追加不修改。
+type MemberAccount struct {
+	ID           uuid.UUID       `json:"id"`            // 账户ID
+	MerchantID   uuid.UUID       `json:"merchant_id"`   // 品牌商ID
+	MemberID     uuid.UUID       `json:"member_id"`     // 会员ID
+	Balance      decimal.Decimal `json:"balance"`       // 本金余额
+	BonusBalance decimal.Decimal `json:"bonus_balance"` // 赠送金余额
+	CreatedAt    time.Time       `json:"created_at"`    // 开户时间
+	UpdatedAt    time.Time       `json:"updated_at"`    // 更新时间
+}
+
+// MemberAccountTransaction 储值流水
+//
+// Amount 和 BonusAmount 为本金和赠送金的变动额，增加为正、减少为负。
+type MemberAccountTransaction struct {
+	ID                uuid.UUID                    `json:"id"`                  // 流水ID
+	MerchantID        uuid.UUID                    `json:"merchant_id"`         // 品牌商ID
+	StoreID           uuid.UUID                    `json:"store_id"`            // 发生门店ID（品牌后台调整为空）
+	MemberID          uuid.UUID                    `json:"member_id"`           // 会员ID
+	AccountID         uuid.UUID                    `json:"account_id"`          // 账户ID
+	TransactionNo     string                       `json:"transaction_no"`      // 流水号
+	Type              MemberAccountTransactionType `json:"type"`                // 流水类型
+	Amount            decimal.Decimal              `json:"amount"`              // 本金变动
+	BonusAmount       decimal.Decimal              `json:"bonus_amount"`        // 赠送金变动
+	BalanceAfter      decimal.Decimal              `json:"balance_after"`       // 变动后本金余额
+	BonusBalanceAfter decimal.Decimal              `json:"bonus_balance_after"` // 变动后赠送金余额
+	OrderID           uuid.UUID                    `json:"order_id"`            // 关联订单ID（消费、退款）
+	OrderNo           string                       `json:"order_no"`            // 关联订单号
+	RefundOrderID     uuid.UUID                    `json:"refund_order_id"`     // 关联退款单ID（退款）
+	PaymentMethod     PaymentMethodPayType         `json:"payment_method"`      // 充值支付方式
+	Operator          OrderOperator                `json:"operator"`            // 操作人
+	Remark            string                       `json:"remark"`              // 备注
+	CreatedAt         time.Time                    `json:"created_at"`          // 发生时间
+}
+
+// MemberAccountTransactions 储值流水集合
+type MemberAccountTransactions []*MemberAccountTransaction
+
+// NewMemberAccount 为会员开立储值账户
+func NewMemberAccount(member *Member) *MemberAccount {
+	return &MemberAccount{
+		ID:           uuid.New(),
+		MerchantID:   member.MerchantID,
+		MemberID:     member.ID,
+		Balance:      decimal.Zero,
+		BonusBalance: decimal.Zero,
+	}
+}
+
+// TotalBalance 可用余额（本金 + 赠送金）
+func (a *MemberAccount) TotalBalance() decimal.Decimal {
+	return a.Balance.Add(a.BonusBalance)
+}
+
+// TopUp 充值，bonus 为赠送金额
+func (a *MemberAccount) TopUp(amount, bonus decimal.Decimal) (*MemberAccountTransaction, error) {
+	if err := checkAccountAmount(amount); err != nil {
+		return nil, err
+	}
+	if err := checkAccountPrecision(bonus); err != nil {
+		return nil, err
+	}
+	if bonus.IsNegative() {
+		return nil, ErrMemberAccountAmountInvalid
+	}
+	return a.apply(MemberAccountTransactionTypeTopUp, amount, bonus)
+}
+
+// Pay 消费扣款，先扣本金再扣赠送金
+func (a *MemberAccount) Pay(amount decimal.Decimal) (*MemberAccountTransaction, error) {
+	if err := checkAccountAmount(amount); err != nil {
+		return nil, err
+	}
+	if a.TotalBalance().LessThan(amount) {
+		return nil, ErrMemberAccountBalanceNotEnough
+	}
+	principal := decimal.Min(amount, a.Balance)
+	bonus := amount.Sub(principal)
+	return a.apply(MemberAccountTransactionTypePayment, principal.Neg(), bonus.Neg())
+}
+
+// Refund 退款退回，principal 和 bonus 分别退回本金和赠送金
+func (a *MemberAccount) Refund(principal, bonus decimal.Decimal) (*MemberAccountTransaction, error) {
+	if err := checkAccountAmount(principal.Add(bonus)); err != nil {
+		return nil, err
+	}
+	if principal.IsNegative() || bonus.IsNegative() {
+		return nil, ErrMemberAccountAmountInvalid
+	}
+	return a.apply(MemberAccountTransactionTypeRefund, principal, bonus)
+}
+
+// Adjust 人工调整余额，amount 和 bonus 可正可负，调整后余额不能为负
+func (a *MemberAccount) Adjust(amount, bonus decimal.Decimal, remark string) (*MemberAccountTransaction, error) {
+	if remark == "" {
+		return nil, ErrMemberAccountAdjustRemark
+	}
+	if amount.IsZero() && bonus.IsZero() {
+		return nil, ErrMemberAccountAdjustZero
+	}
+	if err := checkAccountPrecision(amount); err != nil {
+		return nil, err
+	}
+	if err := checkAccountPrecision(bonus); err != nil {
+		return nil, err
+	}
+	txn, err := a.apply(MemberAccountTransactionTypeAdjustment, amount, bonus)
+	if err != nil {
+		return nil, err
+	}
+	txn.Remark = remark
+	return txn, nil
+}
+
+// apply 变动余额并生成流水，余额不足时不修改账户
+func (a *MemberAccount) apply(typ MemberAccountTransactionType, amount, bonus decimal.Decimal) (*MemberAccountTransaction, error) {
+	balance := a.Balance.Add(amount)
+	bonusBalance := a.BonusBalance.Add(bonus)
+	if balance.IsNegative() || bonusBalance.IsNegative() {
+		return nil, ErrMemberAccountBalanceNotEnough
+	}
+	a.Balance = balance
+	a.BonusBalance = bonusBalance
+	return &MemberAccountTransaction{
+		ID:                uuid.New(),
+		MerchantID:        a.MerchantID,
+		MemberID:          a.MemberID,
+		AccountID:         a.ID,
+		Type:              typ,
+		Amount:            amount,
+		BonusAmount:       bonus,
+		BalanceAfter:      balance,
+		BonusBalanceAfter: bonusBalance,
+	}, nil
+}
+
+// RefundableSplit 按订单的储值消费和已退回流水，计算本次退回金额中本金和赠送金的部分
+//
+// 先退本金再退赠送金，退回总额不能超过订单储值支付的剩余金额。
+func (txns MemberAccountTransactions) RefundableSplit(amount decimal.Decimal) (principal, bonus decimal.Decimal, err error) {
+	principalLeft, bonusLeft := decimal.Zero, decimal.Zero
+	for _, txn := range txns {
+		switch txn.Type {
+		case MemberAccountTransactionTypePayment, MemberAccountTransactionTypeRefund:
+			// 消费为负、退回为正，取反后即为剩余可退金额
+			principalLeft = principalLeft.Sub(txn.Amount)
+			bonusLeft = bonusLeft.Sub(txn.BonusAmount)
+		}
+	}
+	if amount.GreaterThan(principalLeft.Add(bonusLeft)) {
+		return decimal.Zero, decimal.Zero, ErrMemberAccountRefundExceeded
+	}
+	principal = decimal.Min(amount, principalLeft)
+	return principal, amount.Sub(principal), nil
+}
+
+// PayByMemberAccount 记录订单的储值支付，支付金额覆盖待付金额时订单变为已支付
+func (o *Order) PayByMemberAccount(txn *MemberAccountTransaction, pos OrderPOS, cashier OrderCashier, now time.Time) {
+	amount := txn.Amount.Add(txn.BonusAmount).Neg()
+	o.Payments = append(o.Payments, OrderPayment{
+		PaymentNo:     txn.TransactionNo,
+		PaymentMethod: PaymentMethodPayTypeMemberCard,
+		PaymentStatus: PaymentStatusPaid,
+		PaymentAmount: amount,
+		RefundAmount:  decimal.Zero,
+		ChangeAmount:  decimal.Zero,
+		POS:           pos,
+		Cashier:       cashier,
+		PaidAt:        now,
+	})
+	o.Amount.AmountPaid = o.Amount.AmountPaid.Add(amount)
+	if o.Amount.AmountPaid.GreaterThanOrEqual(o.Amount.AmountDue) {
+		o.PaymentStatus = PaymentStatusPaid
+		o.PaidAt = now
+	}
+}
+
+// AmountUnpaid 订单待付金额
+func (o *Order) AmountUnpaid() decimal.Decimal {
+	return decimal.Max(o.Amount.AmountDue.Sub(o.Amount.AmountPaid), decimal.Zero)
+}
+
+// MemberAccountRefundAmount 退款单中退回储值余额的金额
+//
+// 原路退回的储值支付退回储值；其他支付方式选择余额退款的，以本金形式存入储值。
+func (r *RefundOrder) MemberAccountRefundAmount() (original, balance decimal.Decimal) {
+	original, balance = decimal.Zero, decimal.Zero
+	for _, p := range r.RefundPayments {
+		if p.RefundStatus == RefundPaymentStatusFailed {
+			continue
+		}
+		switch {
+		case p.PaymentMethod == PaymentMethodPayTypeMemberCard:
+			original = original.Add(p.RefundAmount)
+		case p.RefundChannel == RefundChannelBalance:
+			balance = balance.Add(p.RefundAmount)
+		}
+	}
+	return original, balance
+}
+
+func checkAccountAmount(amount decimal.Decimal) error {
+	if !amount.IsPositive() {
+		return ErrMemberAccountAmountInvalid
+	}
+	return checkAccountPrecision(amount)
+}
+
+// checkAccountPrecision 储值金额精确到分
+func checkAccountPrecision(amount decimal.Decimal) error {
+	if !amount.Equal(amount.Round(2)) {
+		return ErrMemberAccountAmountPrecision
+	}
+	return nil
+}
+
+// ------------------------------------------------------------
+// 仓储和用例接口
+// ------------------------------------------------------------
+
+// MemberAccountRepository 会员储值账户仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/member_account_repository.go -package=mock . MemberAccountRepository
+type MemberAccountRepository interface {
+	Create(ctx context.Context, account *MemberAccount) error
+	// UpdateBalance 更新账户余额
+	UpdateBalance(ctx context.Context, account *MemberAccount) error
+	FindByMemberID(ctx context.Context, memberID uuid.UUID) (*MemberAccount, error)
+	// FindByMemberIDForUpdate 锁定查询会员储值账户（用于余额变动的并发控制）
+	FindByMemberIDForUpdate(ctx context.Context, memberID uuid.UUID) (*MemberAccount, error)
+	// SumBalance 汇总品牌商全部储值账户余额
+	SumBalance(ctx context.Context, merchantID uuid.UUID) (balance, bonusBalance decimal.Decimal, err error)
+}
+
+// MemberAccountTransactionRepository 储值流水仓储接口，流水只追加不修改
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/member_account_transaction_repository.go -package=mock . MemberAccountTransactionRepository
+type MemberAccountTransactionRepository interface {
+	Create(ctx context.Context, txn *MemberAccountTransaction) error
+	ListByOrderID(ctx context.Context, orderID uuid.UUID) (MemberAccountTransactions, error)
+	ExistsByRefundOrderID(ctx context.Context, refundOrderID uuid.UUID) (bool, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params MemberAccountTransactionSearchParams) (*MemberAccountTransactionSearchRes, error)
+	// LiabilityByStore 按门店汇总储值流水
+	LiabilityByStore(ctx context.Context, merchantID uuid.UUID) ([]*MemberAccountLiabilityItem, error)
+}
+
+// MemberAccountInteractor 会员储值用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/member_account_interactor.go -package=mock . MemberAccountInteractor
+type MemberAccountInteractor interface {
+	// GetByMemberID 查询会员储值账户，未开户时返回零余额账户
+	GetByMemberID(ctx context.Context, merchantID, memberID uuid.UUID) (*MemberAccount, error)
+	// TopUp 充值
+	TopUp(ctx context.Context, params MemberAccountTopUpParams) (*MemberAccountTransaction, error)
+	// PayOrder 使用储值余额支付订单
+	PayOrder(ctx context.Context, params MemberAccountPayParams) (*Order, error)
+	// Adjust 人工调整余额
+	Adjust(ctx context.Context, params MemberAccountAdjustParams, user User) (*MemberAccountTransaction, error)
+	// PagedListTransactions 查询储值流水
+	PagedListTransactions(ctx context.Context, page *upagination.Pagination, params MemberAccountTransactionSearchParams) (*MemberAccountTransactionSearchRes, error)
+	// LiabilityReport 储值负债报表（按门店）
+	LiabilityReport(ctx context.Context, user User) (*MemberAccountLiabilityReport, error)
+}
+
+// ------------------------------------------------------------
+// 参数定义（DTO）
+// ------------------------------------------------------------
+
+// MemberAccountTopUpParams 充值参数
+type MemberAccountTopUpParams struct {
+	MerchantID    uuid.UUID
+	StoreID       uuid.UUID
+	MemberID      uuid.UUID
+	Amount        decimal.Decimal      // 充值金额
+	BonusAmount   decimal.Decimal      // 赠送金额
+	PaymentMethod PaymentMethodPayType // 充值支付方式
+	Operator      OrderOperator
+	Remark        string
+}
+
+// MemberAccountPayParams 储值支付参数
+type MemberAccountPayParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID
+	OrderID    uuid.UUID
+	MemberID   uuid.UUID       // 订单未关联会员时使用
+	Amount     decimal.Decimal // 支付金额
+	Operator   OrderOperator
+	POS        OrderPOS
+	Cashier    OrderCashier
+}
+
+// MemberAccountAdjustParams 余额调整参数
+type MemberAccountAdjustParams struct {
+	MemberID    uuid.UUID
+	Amount      decimal.Decimal // 本金调整额（可为负）
+	BonusAmount decimal.Decimal // 赠送金调整额（可为负）
+	Remark      string          // 调整原因
+	Operator    OrderOperator
+}
+
+// MemberAccountTransactionSearchParams 储值流水查询参数
+type MemberAccountTransactionSearchParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID                    // 门店（可选）
+	MemberID   uuid.UUID                    // 会员（可选）
+	Type       MemberAccountTransactionType // 流水类型（可选）
+	StartAt    *time.Time                   // 开始时间（可选）
+	EndAt      *time.Time                   // 结束时间（可选）
+}
+
+// MemberAccountTransactionSearchRes 储值流水查询结果
+type MemberAccountTransactionSearchRes struct {
+	*upagination.Pagination
+	Items MemberAccountTransactions `json:"items"`
+}
+
+// MemberAccountLiabilityItem 门店储值负债
+//
+// 负债按流水发生门店归集：门店充值增加负债，其他门店消费时冲减负债，
+// 因此单个门店的余额可能为负，全部门店合计等于账户余额合计。
+type MemberAccountLiabilityItem struct {
+	StoreID          uuid.UUID       `json:"store_id"`          // 门店ID（品牌后台调整为空）
+	StoreName        string          `json:"store_name"`        // 门店名称
+	TopUpAmount      decimal.Decimal `json:"top_up_amount"`     // 充值本金
+	TopUpBonus       decimal.Decimal `json:"top_up_bonus"`      // 充值赠送
+	PaymentAmount    decimal.Decimal `json:"payment_amount"`    // 消费（本金 + 赠送）
+	RefundAmount     decimal.Decimal `json:"refund_amount"`     // 退款退回（本金 + 赠送）
+	AdjustmentAmount decimal.Decimal `json:"adjustment_amount"` // 人工调整（本金 + 赠送）
+	Balance          decimal.Decimal `json:"balance"`           // 本金负债
+	BonusBalance     decimal.Decimal `json:"bonus_balance"`     // 赠送金负债
+}
+
+// MemberAccountLiabilityReport 储值负债报表
+type MemberAccountLiabilityReport struct {
+	Items          []*MemberAccountLiabilityItem `json:"items"`           // 门店明细
+	Balance        decimal.Decimal               `json:"balance"`         // 流水合计本金负债
+	BonusBalance   decimal.Decimal               `json:"bonus_balance"`   // 流水合计赠送金负债
+	AccountBalance decimal.Decimal               `json:"account_balance"` // 账户本金余额合计
+	AccountBonus   decimal.Decimal               `json:"account_bonus"`   // 账户赠送金余额合计
+	Reconciled     bool                          `json:"reconciled"`      // 流水合计与账户余额是否一致
+}
@@ -0,0 +1,624 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrMemberPointsRuleNotExists        = errors.New("积分规则不存在")
+	ErrMemberPointsRuleEarnRate         = errors.New("积分获取比例不能为负数")
+	ErrMemberPointsRuleCategoryRepeat   = errors.New("分类积分规则重复")
+	ErrMemberPointsRuleTierRepeat       = errors.New("会员等级积分倍率重复")
+	ErrMemberPointsRuleMultiplier       = errors.New("积分倍率必须大于0")
+	ErrMemberPointsRuleExpireDays       = errors.New("积分有效天数不能为负数")
+	ErrMemberPointsRuleRedeemRate       = errors.New("积分抵扣比例必须大于0")
+	ErrMemberPointsRuleRedeemPercent    = errors.New("积分抵扣上限比例必须在0到100之间")
+	ErrMemberPointsRuleProductRepeat    = errors.New("积分兑换商品重复")
+	ErrMemberPointsRuleProductPoints    = errors.New("兑换商品所需积分必须大于0")
+	ErrMemberPointsRuleProductNotExists = errors.New("积分兑换商品不存在")
+	ErrMemberPointsAccountNotExists     = errors.New("会员积分账户不存在")
+	ErrMemberPointsInvalid              = errors.New("积分必须大于0")
+	ErrMemberPointsNotEnough            = errors.New("积分余额不足")
+	ErrMemberPointsAdjustRemark         = errors.New("积分调整必须填写原因")
+	ErrMemberPointsAdjustZero           = errors.New("调整积分不能为0")
+	ErrMemberPointsRedeemDisabled       = errors.New("积分抵扣未开启")
+	ErrMemberPointsRedeemMin            = errors.New("未达到单次最低使用积分")
+	ErrMemberPointsRedeemTooSmall       = errors.New("积分不足以抵扣0.01元")
+	ErrMemberPointsRedeemExceeded       = errors.New("积分抵扣金额超过订单可抵扣上限")
+	ErrMemberPointsRedeemProductInvalid = errors.New("商品不支持积分兑换")
+	ErrMemberPointsRedeemProductMissing = errors.New("订单中没有可兑换的该商品")
+	ErrMemberPointsOrderNotExists       = errors.New("订单不存在")
+	ErrMemberPointsOrderPaid            = errors.New("订单已支付，不能使用积分")
+	ErrMemberPointsOrderNoMember        = errors.New("订单未关联会员")
+	ErrMemberPointsRedeemBusy           = errors.New("订单正在使用积分，请稍后重试")
+)
+
+// ------------------------------------------------------------
+// 枚举定义
+// ------------------------------------------------------------
+
+// MemberPointsTransactionType 积分流水类型
+type MemberPointsTransactionType string
+
+const (
+	MemberPointsTransactionTypeEarn       MemberPointsTransactionType = "earn"       // 消费获得
+	MemberPointsTransactionTypeRedeem     MemberPointsTransactionType = "redeem"     // 积分抵扣/兑换
+	MemberPointsTransactionTypeReverse    MemberPointsTransactionType = "reverse"    // 退款扣回
+	MemberPointsTransactionTypeRestore    MemberPointsTransactionType = "restore"    // 退款退回已使用积分
+	MemberPointsTransactionTypeExpire     MemberPointsTransactionType = "expire"     // 过期
+	MemberPointsTransactionTypeAdjustment MemberPointsTransactionType = "adjustment" // 人工调整
+)
+
+func (MemberPointsTransactionType) Values() []string {
+	return []string{
+		string(MemberPointsTransactionTypeEarn),
+		string(MemberPointsTransactionTypeRedeem),
+		string(MemberPointsTransactionTypeReverse),
+		string(MemberPointsTransactionTypeRestore),
+		string(MemberPointsTransactionTypeExpire),
+		string(MemberPointsTransactionTypeAdjustment),
+	}
+}
+
+// MemberPointsRedeemType 积分使用方式
+type MemberPointsRedeemType string
+
+const (
+	MemberPointsRedeemTypeDiscount MemberPointsRedeemType = "discount" // 抵扣订单金额
+	MemberPointsRedeemTypeProduct  MemberPointsRedeemType = "product"  // 兑换指定商品
+)
+
+func (MemberPointsRedeemType) Values() []string {
+	return []string{
+		string(MemberPointsRedeemTypeDiscount),
+		string(MemberPointsRedeemTypeProduct),
+	}
+}
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// MemberPointsRule 品牌商积分规则，每个品牌商一条
+//
+// 获取积分 = 商品实付金额 × 分类积分比例（未单独设置的分类使用基础比例）× 会员等级倍率，向下取整。
+type MemberPointsRule struct {
+	ID               uuid.UUID                    `json:"id"`                 // 规则ID
+	MerchantID       uuid.UUID                    `json:"merchant_id"`        // 品牌商ID
+	Enabled          bool                         `json:"enabled"`            // 是否开启积分获取
+	EarnRate         decimal.Decimal              `json:"earn_rate"`          // 基础比例：每消费1元获得的积分
+	CategoryRates    []MemberPointsCategoryRate   `json:"category_rates"`     // 分类积分比例
+	TierMultipliers  []MemberPointsTierMultiplier `json:"tier_multipliers"`   // 会员等级积分倍率
+	ExpireDays       int                          `json:"expire_days"`        // 积分有效天数，0 表示永久有效
+	RedeemEnabled    bool                         `json:"redeem_enabled"`     // 是否开启积分抵扣
+	RedeemRate       int64                        `json:"redeem_rate"`        // 抵扣比例：多少积分抵扣1元
+	RedeemMinPoints  int64                        `json:"redeem_min_points"`  // 单次最低使用积分
+	RedeemMaxPercent decimal.Decimal              `json:"redeem_max_percent"` // 抵扣金额占订单应收的最高比例（百分比）
+	RedeemProducts   []MemberPointsRedeemProduct  `json:"redeem_products"`    // 积分兑换商品
+	CreatedAt        time.Time                    `json:"created_at"`         // 创建时间
+	UpdatedAt        time.Time                    `json:"updated_at"`         // 更新时间
+}
+
+// MemberPointsCategoryRate 分类积分比例
+type MemberPointsCategoryRate struct {
+	CategoryID uuid.UUID       `json:"category_id"` // 分类ID（一级或二级分类）
+	Rate       decimal.Decimal `json:"rate"`        // 每消费1元获得的积分，0 表示该分类不积分
+}
+
+// MemberPointsTierMultiplier 会员等级积分倍率
+type MemberPointsTierMultiplier struct {
+	TierID     uuid.UUID       `json:"tier_id"`    // 会员等级ID
+	Multiplier decimal.Decimal `json:"multiplier"` // 倍率，如 1.5 表示 1.5 倍积分
+}
+
+// MemberPointsRedeemProduct 积分兑换商品
+type MemberPointsRedeemProduct struct {
+	ProductID   uuid.UUID `json:"product_id"`   // 商品ID
+	ProductName string    `json:"product_name"` // 商品名称
+	Points      int64     `json:"points"`       // 兑换一份所需积分
+}
+
+// MemberPointsAccount 会员积分账户
+//
+// 入账流水（获得、退回、调增）记录剩余可用积分和过期时间，
+// 扣减时按过期时间先到先扣，账户余额始终等于全部入账流水的剩余积分之和。
+type MemberPointsAccount struct {
+	ID         uuid.UUID `json:"id"`          // 账户ID
+	MerchantID uuid.UUID `json:"merchant_id"` // 品牌商ID
+	MemberID   uuid.UUID `json:"member_id"`   // 会员ID
+	Balance    int64     `json:"balance"`     // 可用积分
+	CreatedAt  time.Time `json:"created_at"`  // 开户时间
+	UpdatedAt  time.Time `json:"updated_at"`  // 更新时间
+}
+
+// MemberPointsTransaction 积分流水
+//
+// Points 为积分变动，增加为正、减少为负；Remaining 和 ExpiresAt 仅对入账流水有效。
+type MemberPointsTransaction struct {
+	ID            uuid.UUID                   `json:"id"`              // 流水ID
+	MerchantID    uuid.UUID                   `json:"merchant_id"`     // 品牌商ID
+	StoreID       uuid.UUID                   `json:"store_id"`        // 发生门店ID（品牌后台调整和过期为空）
+	MemberID      uuid.UUID                   `json:"member_id"`       // 会员ID
+	AccountID     uuid.UUID                   `json:"account_id"`      // 账户ID
+	Type          MemberPointsTransactionType `json:"type"`            // 流水类型
+	Points        int64                       `json:"points"`          // 积分变动
+	BalanceAfter  int64                       `json:"balance_after"`   // 变动后积分余额
+	Remaining     int64                       `json:"remaining"`       // 入账积分剩余未使用部分
+	ExpiresAt     *time.Time                  `json:"expires_at"`      // 入账积分过期时间，为空表示永久有效
+	Amount        decimal.Decimal             `json:"amount"`          // 获得积分的消费金额或积分抵扣金额
+	OrderID       uuid.UUID                   `json:"order_id"`        // 关联订单ID
+	OrderNo       string                      `json:"order_no"`        // 关联订单号
+	RefundOrderID uuid.UUID                   `json:"refund_order_id"` // 关联退款单ID
+	ProductID     uuid.UUID                   `json:"product_id"`      // 兑换商品ID
+	Operator      OrderOperator               `json:"operator"`        // 操作人
+	Remark        string                      `json:"remark"`          // 备注
+	CreatedAt     time.Time                   `json:"created_at"`      // 发生时间
+}
+
+// MemberPointsTransactions 积分流水集合
+type MemberPointsTransactions []*MemberPointsTransaction
+
+// Validate 校验积分规则
+func (r *MemberPointsRule) Validate() error {
+	if r.EarnRate.IsNegative() {
+		return ErrMemberPointsRuleEarnRate
+	}
+	categoryIDs := make(map[uuid.UUID]struct{}, len(r.CategoryRates))
+	for _, cr := range r.CategoryRates {
+		if cr.Rate.IsNegative() {
+			return ErrMemberPointsRuleEarnRate
+		}
+		if _, ok := categoryIDs[cr.CategoryID]; ok {
+			return ErrMemberPointsRuleCategoryRepeat
+		}
+		categoryIDs[cr.CategoryID] = struct{}{}
+	}
+	tierIDs := make(map[uuid.UUID]struct{}, len(r.TierMultipliers))
+	for _, tm := range r.TierMultipliers {
+		if !tm.Multiplier.IsPositive() {
+			return ErrMemberPointsRuleMultiplier
+		}
+		if _, ok := tierIDs[tm.TierID]; ok {
+			return ErrMemberPointsRuleTierRepeat
+		}
+		tierIDs[tm.TierID] = struct{}{}
+	}
+	if r.ExpireDays < 0 {
+		return ErrMemberPointsRuleExpireDays
+	}
+	if r.RedeemEnabled {
+		if r.RedeemRate <= 0 {
+			return ErrMemberPointsRuleRedeemRate
+		}
+		if !r.RedeemMaxPercent.IsPositive() || r.RedeemMaxPercent.GreaterThan(decimal.NewFromInt(100)) {
+			return ErrMemberPointsRuleRedeemPercent
+		}
+	}
+	productIDs := make(map[uuid.UUID]struct{}, len(r.RedeemProducts))
+	for _, p := range r.RedeemProducts {
+		if p.Points <= 0 {
+			return ErrMemberPointsRuleProductPoints
+		}
+		if _, ok := productIDs[p.ProductID]; ok {
+			return ErrMemberPointsRuleProductRepeat
+		}
+		productIDs[p.ProductID] = struct{}{}
+	}
+	return nil
+}
+
+// ExpiresAt 计算在指定时间入账的积分的过期时间，永久有效时返回 nil
+func (r *MemberPointsRule) ExpiresAt(at time.Time) *time.Time {
+	if r == nil || r.ExpireDays <= 0 {
+		return nil
+	}
+	expiresAt := at.AddDate(0, 0, r.ExpireDays)
+	return &expiresAt
+}
+
+// CalculateEarn 计算订单可获得的积分
+//
+// 商品实付金额按分类比例累计积分；spend 为订单实际消费金额，
+// 小于商品实付合计时（整单优惠、券抵扣等）按比例缩减，最后乘以会员等级倍率。
+func (r *MemberPointsRule) CalculateEarn(order *Order, tierID uuid.UUID, spend decimal.Decimal) int64 {
+	if !r.Enabled || !spend.IsPositive() {
+		return 0
+	}
+	base, weighted := decimal.Zero, decimal.Zero
+	for _, op := range order.OrderProducts {
+		if op.IsGift {
+			continue
+		}
+		amount := op.Subtotal.Sub(op.DiscountAmount)
+		if !amount.IsPositive() {
+			continue
+		}
+		base = base.Add(amount)
+		weighted = weighted.Add(amount.Mul(r.categoryRate(op.Category)))
+	}
+	if !base.IsPositive() {
+		return 0
+	}
+	if spend.LessThan(base) {
+		weighted = weighted.Mul(spend).Div(base)
+	}
+	return weighted.Mul(r.tierMultiplier(tierID)).Floor().IntPart()
+}
+
+// DiscountForPoints 计算使用积分抵扣订单的金额，redeemed 为订单已使用积分抵扣的金额
+func (r *MemberPointsRule) DiscountForPoints(order *Order, points int64, redeemed decimal.Decimal) (decimal.Decimal, error) {
+	if !r.RedeemEnabled {
+		return decimal.Zero, ErrMemberPointsRedeemDisabled
+	}
+	if points <= 0 {
+		return decimal.Zero, ErrMemberPointsInvalid
+	}
+	if points < r.RedeemMinPoints {
+		return decimal.Zero, ErrMemberPointsRedeemMin
+	}
+	discount := decimal.NewFromInt(points).Div(decimal.NewFromInt(r.RedeemRate)).RoundDown(2)
+	if !discount.IsPositive() {
+		return decimal.Zero, ErrMemberPointsRedeemTooSmall
+	}
+	limit := order.Amount.AmountDue.Mul(r.RedeemMaxPercent).Div(decimal.NewFromInt(100)).RoundDown(2)
+	if discount.Add(redeemed).GreaterThan(limit) {
+		return decimal.Zero, ErrMemberPointsRedeemExceeded
+	}
+	return discount, nil
+}
+
+// ProductForPoints 计算使用积分兑换订单中指定商品的所需积分和抵扣金额
+//
+// 兑换该商品单价最高的一份，redeemedQty 为订单中该商品已兑换的份数。
+func (r *MemberPointsRule) ProductForPoints(order *Order, productID uuid.UUID, redeemedQty int) (points int64, discount decimal.Decimal, err error) {
+	if !r.RedeemEnabled {
+		return 0, decimal.Zero, ErrMemberPointsRedeemDisabled
+	}
+	idx := slices.IndexFunc(r.RedeemProducts, func(p MemberPointsRedeemProduct) bool {
+		return p.ProductID == productID
+	})
+	if idx < 0 {
+		return 0, decimal.Zero, ErrMemberPointsRedeemProductInvalid
+	}
+
+	qty := 0
+	for _, op := range order.OrderProducts {
+		n := op.Qty - op.GiftQty - op.VoidQty
+		if op.ProductID != productID || op.IsGift || n <= 0 {
+			continue
+		}
+		qty += n
+		price := op.Subtotal.Sub(op.DiscountAmount).Div(decimal.NewFromInt(int64(n))).RoundDown(2)
+		discount = decimal.Max(discount, price)
+	}
+	if qty <= redeemedQty || !discount.IsPositive() {
+		return 0, decimal.Zero, ErrMemberPointsRedeemProductMissing
+	}
+	return r.RedeemProducts[idx].Points, discount, nil
+}
+
+func (r *MemberPointsRule) categoryRate(category Category) decimal.Decimal {
+	for _, cr := range r.CategoryRates {
+		if cr.CategoryID == category.ID {
+			return cr.Rate
+		}
+	}
+	// 二级分类未单独设置时使用一级分类的比例
+	if category.ParentID != uuid.Nil {
+		for _, cr := range r.CategoryRates {
+			if cr.CategoryID == category.ParentID {
+				return cr.Rate
+			}
+		}
+	}
+	return r.EarnRate
+}
+
+func (r *MemberPointsRule) tierMultiplier(tierID uuid.UUID) decimal.Decimal {
+	for _, tm := range r.TierMultipliers {
+		if tm.TierID == tierID {
+			return tm.Multiplier
+		}
+	}
+	return decimal.NewFromInt(1)
+}
+
+// NewMemberPointsAccount 为会员开立积分账户
+func NewMemberPointsAccount(member *Member) *MemberPointsAccount {
+	return &MemberPointsAccount{
+		ID:         uuid.New(),
+		MerchantID: member.MerchantID,
+		MemberID:   member.ID,
+	}
+}
+
+// Credit 积分入账（获得、退回、调增），expiresAt 为空表示永久有效
+func (a *MemberPointsAccount) Credit(typ MemberPointsTransactionType, points int64, expiresAt *time.Time) (*MemberPointsTransaction, error) {
+	if points <= 0 {
+		return nil, ErrMemberPointsInvalid
+	}
+	a.Balance += points
+	txn := a.newTransaction(typ, points)
+	txn.Remaining = points
+	txn.ExpiresAt = expiresAt
+	return txn, nil
+}
+
+// Debit 积分扣减（使用、扣回、调减），按 credits 的顺序依次扣减入账流水的剩余积分
+//
+// 返回扣减流水和剩余积分发生变化的入账流水；余额不足时不修改账户。
+func (a *MemberPointsAccount) Debit(
+	typ MemberPointsTransactionType,
+	points int64,
+	credits MemberPointsTransactions,
+) (*MemberPointsTransaction, MemberPointsTransactions, error) {
+	if points <= 0 {
+		return nil, nil, ErrMemberPointsInvalid
+	}
+	if a.Balance < points {
+		return nil, nil, ErrMemberPointsNotEnough
+	}
+	changed := make(MemberPointsTransactions, 0)
+	left := points
+	for _, credit := range credits {
+		if left == 0 {
+			break
+		}
+		if credit.Remaining <= 0 {
+			continue
+		}
+		n := min(left, credit.Remaining)
+		credit.Remaining -= n
+		left -= n
+		changed = append(changed, credit)
+	}
+	a.Balance -= points
+	return a.newTransaction(typ, -points), changed, nil
+}
+
+// Expire 入账积分过期，扣减其全部剩余积分
+func (a *MemberPointsAccount) Expire(credit *MemberPointsTransaction) *MemberPointsTransaction {
+	points := credit.Remaining
+	credit.Remaining = 0
+	a.Balance -= points
+	return a.newTransaction(MemberPointsTransactionTypeExpire, -points)
+}
+
+// Adjust 人工调整积分，调增按规则设置有效期，调减按过期时间先到先扣
+func (a *MemberPointsAccount) Adjust(
+	points int64,
+	remark string,
+	credits MemberPointsTransactions,
+	expiresAt *time.Time,
+) (txn *MemberPointsTransaction, changed MemberPointsTransactions, err error) {
+	if remark == "" {
+		return nil, nil, ErrMemberPointsAdjustRemark
+	}
+	if points == 0 {
+		return nil, nil, ErrMemberPointsAdjustZero
+	}
+	if points > 0 {
+		txn, err = a.Credit(MemberPointsTransactionTypeAdjustment, points, expiresAt)
+	} else {
+		txn, changed, err = a.Debit(MemberPointsTransactionTypeAdjustment, -points, credits)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	txn.Remark = remark
+	return txn, changed, nil
+}
+
+func (a *MemberPointsAccount) newTransaction(typ MemberPointsTransactionType, points int64) *MemberPointsTransaction {
+	return &MemberPointsTransaction{
+		ID:           uuid.New(),
+		MerchantID:   a.MerchantID,
+		MemberID:     a.MemberID,
+		AccountID:    a.ID,
+		Type:         typ,
+		Points:       points,
+		BalanceAfter: a.Balance,
+		Amount:       decimal.Zero,
+	}
+}
+
+// SortForDebit 按扣减顺序排列入账流水：先到期的在前，永久有效的在后，同一到期时间按入账时间
+func (txns MemberPointsTransactions) SortForDebit() {
+	slices.SortStableFunc(txns, func(a, b *MemberPointsTransaction) int {
+		switch {
+		case a.ExpiresAt == nil && b.ExpiresAt == nil:
+		case a.ExpiresAt == nil:
+			return 1
+		case b.ExpiresAt == nil:
+			return -1
+		default:
+			if c := a.ExpiresAt.Compare(*b.ExpiresAt); c != 0 {
+				return c
+			}
+		}
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+}
+
+// OrderSummary 汇总订单关联的积分流水
+func (txns MemberPointsTransactions) OrderSummary() (summary MemberPointsOrderSummary) {
+	summary.RedeemedAmount = decimal.Zero
+	summary.RedeemedProducts = make(map[uuid.UUID]int)
+	for _, txn := range txns {
+		switch txn.Type {
+		case MemberPointsTransactionTypeEarn:
+			summary.Earn = txn
+		case MemberPointsTransactionTypeReverse:
+			summary.Reversed -= txn.Points
+		case MemberPointsTransactionTypeRedeem:
+			summary.Redeemed -= txn.Points
+			if txn.ProductID != uuid.Nil {
+				summary.RedeemedProducts[txn.ProductID]++
+			} else {
+				summary.RedeemedAmount = summary.RedeemedAmount.Add(txn.Amount)
+			}
+		case MemberPointsTransactionTypeRestore:
+			summary.Restored += txn.Points
+		}
+	}
+	return summary
+}
+
+// MemberPointsOrderSummary 订单积分汇总
+type MemberPointsOrderSummary struct {
+	Earn             *MemberPointsTransaction // 获得积分流水
+	Reversed         int64                    // 已扣回积分
+	Redeemed         int64                    // 已使用积分
+	RedeemedAmount   decimal.Decimal          // 已抵扣金额（不含兑换商品）
+	RedeemedProducts map[uuid.UUID]int        // 已兑换商品份数
+	Restored         int64                    // 已退回的使用积分
+}
+
+// ReversePoints 计算退款应扣回的积分
+//
+// 全额退款扣回全部剩余获得积分；部分退款按退款金额占获得积分消费金额的比例扣回。
+func (s MemberPointsOrderSummary) ReversePoints(refundOrder *RefundOrder) int64 {
+	if s.Earn == nil {
+		return 0
+	}
+	left := s.Earn.Points - s.Reversed
+	if left <= 0 {
+		return 0
+	}
+	if refundOrder.RefundType == RefundTypeFull || !s.Earn.Amount.IsPositive() {
+		return left
+	}
+	points := decimal.NewFromInt(s.Earn.Points).
+		Mul(refundOrder.RefundAmount.RefundTotal).
+		Div(s.Earn.Amount).
+		Floor().
+		IntPart()
+	return max(min(points, left), 0)
+}
+
+// AddPointsLog 记录订单使用积分的操作日志
+func (o *Order) AddPointsLog(operator OrderOperator, txn *MemberPointsTransaction, at time.Time) {
+	o.OperationLogs = append(o.OperationLogs, OrderOperationLog{
+		OperatedAt:    at,
+		Source:        o.Channel,
+		OperatorID:    operator.ID,
+		OperatorName:  operator.Name,
+		OperationType: OrderOperationTypePoints,
+		Content: map[string]interface{}{
+			"points":     -txn.Points,
+			"product_id": txn.ProductID,
+			"amount":     txn.Amount,
+		},
+	})
+}
+
+// ------------------------------------------------------------
+// 仓储和用例接口
+// ------------------------------------------------------------
+
+// MemberPointsRuleRepository 积分规则仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/member_points_rule_repository.go -package=mock . MemberPointsRuleRepository
+type MemberPointsRuleRepository interface {
+	Create(ctx context.Context, rule *MemberPointsRule) error
+	Update(ctx context.Context, rule *MemberPointsRule) error
+	FindByMerchantID(ctx context.Context, merchantID uuid.UUID) (*MemberPointsRule, error)
+}
+
+// MemberPointsAccountRepository 会员积分账户仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/member_points_account_repository.go -package=mock . MemberPointsAccountRepository
+type MemberPointsAccountRepository interface {
+	Create(ctx context.Context, account *MemberPointsAccount) error
+	// UpdateBalance 更新积分余额
+	UpdateBalance(ctx context.Context, account *MemberPointsAccount) error
+	FindByMemberID(ctx context.Context, memberID uuid.UUID) (*MemberPointsAccount, error)
+	// FindByMemberIDForUpdate 锁定查询会员积分账户（用于积分变动的并发控制）
+	FindByMemberIDForUpdate(ctx context.Context, memberID uuid.UUID) (*MemberPointsAccount, error)
+}
+
+// MemberPointsTransactionRepository 积分流水仓储接口，流水仅入账剩余积分可修改
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/member_points_transaction_repository.go -package=mock . MemberPointsTransactionRepository
+type MemberPointsTransactionRepository interface {
+	Create(ctx context.Context, txn *MemberPointsTransaction) error
+	// UpdateRemaining 更新入账流水的剩余积分
+	UpdateRemaining(ctx context.Context, txn *MemberPointsTransaction) error
+	ListByOrderID(ctx context.Context, orderID uuid.UUID) (MemberPointsTransactions, error)
+	// ListAvailable 查询账户剩余积分大于0的入账流水，按扣减顺序排列
+	ListAvailable(ctx context.Context, accountID uuid.UUID) (MemberPointsTransactions, error)
+	// ListExpiredMemberIDs 查询有已过期未处理积分的会员
+	ListExpiredMemberIDs(ctx context.Context, at time.Time, limit int) ([]uuid.UUID, error)
+	ExistsByRefundOrderID(ctx context.Context, refundOrderID uuid.UUID) (bool, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params MemberPointsTransactionSearchParams) (*MemberPointsTransactionSearchRes, error)
+}
+
+// MemberPointsInteractor 会员积分用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/member_points_interactor.go -package=mock . MemberPointsInteractor
+type MemberPointsInteractor interface {
+	// GetRule 查询积分规则，未设置时返回未开启的默认规则
+	GetRule(ctx context.Context, user User) (*MemberPointsRule, error)
+	// SaveRule 保存积分规则
+	SaveRule(ctx context.Context, rule *MemberPointsRule, user User) error
+	// GetByMemberID 查询会员积分账户，未开户时返回零积分账户
+	GetByMemberID(ctx context.Context, merchantID, memberID uuid.UUID) (*MemberPointsAccount, error)
+	// Redeem 使用积分抵扣订单金额或兑换订单中的商品
+	Redeem(ctx context.Context, params MemberPointsRedeemParams) (*MemberPointsTransaction, error)
+	// Adjust 人工调整积分
+	Adjust(ctx context.Context, params MemberPointsAdjustParams, user User) (*MemberPointsTransaction, error)
+	// PagedListTransactions 查询积分流水
+	PagedListTransactions(ctx context.Context, page *upagination.Pagination, params MemberPointsTransactionSearchParams) (*MemberPointsTransactionSearchRes, error)
+	// ExpireDue 处理已到过期时间的积分
+	ExpireDue(ctx context.Context) error
+}
+
+// ------------------------------------------------------------
+// 参数定义（DTO）
+// ------------------------------------------------------------
+
+// MemberPointsRedeemParams 积分使用参数
+type MemberPointsRedeemParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID
+	OrderID    uuid.UUID              // 订单（需已关联会员）
+	Type       MemberPointsRedeemType // 使用方式
+	Points     int64                  // 抵扣使用的积分（抵扣订单金额时必填）
+	ProductID  uuid.UUID              // 兑换商品ID（兑换商品时必填）
+	Operator   OrderOperator
+}
+
+// MemberPointsAdjustParams 积分调整参数
+type MemberPointsAdjustParams struct {
+	MemberID uuid.UUID
+	Points   int64  // 调整积分（可为负）
+	Remark   string // 调整原因
+	Operator OrderOperator
+}
+
+// MemberPointsTransactionSearchParams 积分流水查询参数
+type MemberPointsTransactionSearchParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID                   // 门店（可选）
+	MemberID   uuid.UUID                   // 会员（可选）
+	Type       MemberPointsTransactionType // 流水类型（可选）
+	StartAt    *time.Time                  // 开始时间（可选）
+	EndAt      *time.Time                  // 结束时间（可选）
+}
+
+// MemberPointsTransactionSearchRes 积分流水查询结果
+type MemberPointsTransactionSearchRes struct {
+	*upagination.Pagination
+	Items MemberPointsTransactions `json:"items"`
+}
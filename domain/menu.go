@@ -40,6 +40,7 @@ type Menu struct {
 	// 关联信息
 	Stores []*StoreSimple `json:"stores,omitempty"` // 关联门店列表
 	Items  MenuItems      `json:"items,omitempty"`  // 菜单项列表
+	Draft  *MenuVersion   `json:"draft,omitempty"`  // 待发布草稿（仅详情返回）
 }
 
 // Menus 菜单集合
@@ -47,16 +48,18 @@ type Menus []*Menu
 
 // MenuItem 菜单项实体
 type MenuItem struct {
-	ID          uuid.UUID        `json:"id"`           // 菜单项ID
-	MenuID      uuid.UUID        `json:"menu_id"`      // 菜单ID
-	ProductID   uuid.UUID        `json:"product_id"`   // 菜品ID
-	BasePrice   *decimal.Decimal `json:"base_price"`   // 基础价（可选，单位：分）
-	MemberPrice *decimal.Decimal `json:"member_price"` // 会员价（可选，单位：分）
-	CreatedAt   time.Time        `json:"created_at"`   // 创建时间
-	UpdatedAt   time.Time        `json:"updated_at"`   // 更新时间
+	ID           uuid.UUID        `json:"id"`            // 菜单项ID
+	MenuID       uuid.UUID        `json:"menu_id"`       // 菜单ID
+	ProductID    uuid.UUID        `json:"product_id"`    // 菜品ID
+	BasePrice    *decimal.Decimal `json:"base_price"`    // 基础价（可选，单位：分）
+	MemberPrice  *decimal.Decimal `json:"member_price"`  // 会员价（可选，单位：分）
+	PeriodPrices PeriodPrices     `json:"period_prices"` // 就餐时段价格（可选）
+	CreatedAt    time.Time        `json:"created_at"`    // 创建时间
+	UpdatedAt    time.Time        `json:"updated_at"`    // 更新时间
 
 	// 关联信息
-	Product *Product `json:"product,omitempty"` // 关联商品
+	Product      *Product      `json:"product,omitempty"`       // 关联商品
+	CurrentPrice *CurrentPrice `json:"current_price,omitempty"` // 当前生效价格（仅门店菜单查询返回）
 }
 
 // MenuItems 菜单项集合
@@ -79,6 +82,12 @@ type MenuRepository interface {
 	PagedListMerchantMenusBySearch(ctx context.Context, page *upagination.Pagination, params MenuSearchParams) (*MenuSearchRes, error)
 	PagedListStoreMenusBySearch(ctx context.Context, page *upagination.Pagination, params MenuSearchParams) (*MenuSearchRes, error)
 	ListAllStoreMenus(ctx context.Context, params MenuListAllParams) (Menus, error)
+	// ListStoreMenuItemsByProductID 查询门店生效菜单中指定商品的菜单项
+	ListStoreMenuItemsByProductID(ctx context.Context, storeID, productID uuid.UUID) (MenuItems, error)
+	// UpdateItemBasePrice 更新菜单项基础价（为空时清除菜单价，使用商品规格价）
+	UpdateItemBasePrice(ctx context.Context, id uuid.UUID, basePrice *decimal.Decimal) error
+	// UpdateItemPrices 更新菜单项基础价、会员价及就餐时段价格（用于恢复历史版本）
+	UpdateItemPrices(ctx context.Context, item *MenuItem) error
 }
 
 // MenuInteractor 菜单用例接口
@@ -91,6 +100,17 @@ type MenuInteractor interface {
 	GetDetail(ctx context.Context, id uuid.UUID, user User) (*Menu, error)
 	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params MenuSearchParams) (*MenuSearchRes, error)
 	ListAllStoreMenus(ctx context.Context, params MenuListAllParams) (Menus, error)
+	// GetDraft 预览菜单草稿
+	GetDraft(ctx context.Context, id uuid.UUID, user User) (*MenuVersion, error)
+	// Publish 发布菜单草稿，scheduledAt 为空时立即发布，否则在指定时间发布
+	Publish(ctx context.Context, id uuid.UUID, scheduledAt *time.Time, user User) error
+	// CancelScheduledPublish 取消草稿的定时发布
+	CancelScheduledPublish(ctx context.Context, id uuid.UUID, user User) error
+	// Rollback 回滚到上一个发布版本
+	Rollback(ctx context.Context, id uuid.UUID, user User) error
+	ListVersions(ctx context.Context, id uuid.UUID, user User) (MenuVersions, error)
+	// PublishDueDrafts 发布已到定时发布时间的菜单草稿（定时任务调用）
+	PublishDueDrafts(ctx context.Context) error
 }
 
 // ------------------------------------------------------------
@@ -122,4 +142,7 @@ type MenuSearchRes struct {
 type MenuListAllParams struct {
 	MerchantID uuid.UUID
 	StoreID    uuid.UUID
+	At         time.Time            // 计算生效价格的时间（为空时使用当前时间）
+	Keyword    string               // 助记搜索关键字（可选，只返回匹配的菜单项，按匹配程度排序）
+	Dietary    ProductDietaryFilter // 过敏原与饮食属性筛选（可选，只返回满足条件的菜单项）
 }
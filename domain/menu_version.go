@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrMenuVersionNotExists   = errors.New("菜单版本不存在")
+	ErrMenuDraftNotExists     = errors.New("菜单没有待发布的草稿")
+	ErrMenuPublishTimeInvalid = errors.New("定时发布时间必须晚于当前时间")
+	ErrMenuDraftNotScheduled  = errors.New("菜单草稿未设置定时发布")
+	ErrMenuNoPreviousVersion  = errors.New("没有可回滚的历史发布版本")
+)
+
+// ------------------------------------------------------------
+// 枚举定义
+// ------------------------------------------------------------
+
+// MenuVersionStatus 菜单版本状态
+type MenuVersionStatus string
+
+const (
+	MenuVersionStatusDraft     MenuVersionStatus = "draft"     // 草稿（编辑中或待定时发布）
+	MenuVersionStatusPublished MenuVersionStatus = "published" // 已发布（门店当前生效）
+	MenuVersionStatusArchived  MenuVersionStatus = "archived"  // 已归档（历史发布版本）
+)
+
+func (MenuVersionStatus) Values() []string {
+	return []string{
+		string(MenuVersionStatusDraft),
+		string(MenuVersionStatusPublished),
+		string(MenuVersionStatusArchived),
+	}
+}
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// MenuVersion 菜单版本
+//
+// 菜单表及菜单项表始终保存已发布版本的内容，门店只读取已发布版本；
+// 编辑菜单时只保存草稿，发布后才覆盖到门店菜单。
+type MenuVersion struct {
+	ID           uuid.UUID         `json:"id"`            // 版本ID
+	MenuID       uuid.UUID         `json:"menu_id"`       // 菜单ID
+	MerchantID   uuid.UUID         `json:"merchant_id"`   // 品牌商ID
+	StoreID      uuid.UUID         `json:"store_id"`      // 门店ID
+	Version      int               `json:"version"`       // 版本号（同一菜单内递增）
+	Status       MenuVersionStatus `json:"status"`        // 状态
+	Name         string            `json:"name"`          // 菜单名称
+	StoreIDs     []uuid.UUID       `json:"store_ids"`     // 适用门店ID列表
+	Items        MenuVersionItems  `json:"items"`         // 菜单项
+	ScheduledAt  *time.Time        `json:"scheduled_at"`  // 定时发布时间（仅草稿）
+	PublishedAt  *time.Time        `json:"published_at"`  // 发布时间
+	PublishError string            `json:"publish_error"` // 最近一次定时发布失败原因
+	OperatorID   uuid.UUID         `json:"operator_id"`   // 最后操作人ID（系统执行时为空）
+	OperatorName string            `json:"operator_name"` // 最后操作人名称
+	CreatedAt    time.Time         `json:"created_at"`    // 创建时间
+	UpdatedAt    time.Time         `json:"updated_at"`    // 更新时间
+}
+
+// MenuVersions 菜单版本集合
+type MenuVersions []*MenuVersion
+
+// MenuVersionItem 菜单版本中的菜单项
+type MenuVersionItem struct {
+	ProductID    uuid.UUID        `json:"product_id"`    // 菜品ID
+	BasePrice    *decimal.Decimal `json:"base_price"`    // 基础价（可选）
+	MemberPrice  *decimal.Decimal `json:"member_price"`  // 会员价（可选）
+	PeriodPrices PeriodPrices     `json:"period_prices"` // 就餐时段价格（可选）
+
+	// 关联信息（仅预览返回）
+	Product *Product `json:"product,omitempty"` // 关联商品
+}
+
+// MenuVersionItems 菜单版本菜单项集合
+type MenuVersionItems []*MenuVersionItem
+
+// NewMenuVersion 根据菜单内容生成版本快照
+func NewMenuVersion(menu *Menu, version int, status MenuVersionStatus, operator ProductVersionOperator) *MenuVersion {
+	v := &MenuVersion{
+		ID:           uuid.New(),
+		MenuID:       menu.ID,
+		MerchantID:   menu.MerchantID,
+		StoreID:      menu.StoreID,
+		Version:      version,
+		Status:       status,
+		OperatorID:   operator.ID,
+		OperatorName: operator.Name,
+	}
+	v.SetContent(menu)
+	return v
+}
+
+// SetContent 使用菜单内容覆盖版本快照
+func (v *MenuVersion) SetContent(menu *Menu) {
+	v.Name = menu.Name
+	v.StoreIDs = make([]uuid.UUID, 0, len(menu.Stores))
+	for _, store := range menu.Stores {
+		v.StoreIDs = append(v.StoreIDs, store.ID)
+	}
+	v.Items = make(MenuVersionItems, 0, len(menu.Items))
+	for _, item := range menu.Items {
+		v.Items = append(v.Items, &MenuVersionItem{
+			ProductID:    item.ProductID,
+			BasePrice:    item.BasePrice,
+			MemberPrice:  item.MemberPrice,
+			PeriodPrices: item.PeriodPrices,
+		})
+	}
+}
+
+// ToMenu 将版本快照还原为菜单（菜单项生成新的ID）
+func (v *MenuVersion) ToMenu() *Menu {
+	menu := &Menu{
+		ID:         v.MenuID,
+		MerchantID: v.MerchantID,
+		StoreID:    v.StoreID,
+		Name:       v.Name,
+		StoreCount: len(v.StoreIDs),
+		ItemCount:  len(v.Items),
+		Stores:     make([]*StoreSimple, 0, len(v.StoreIDs)),
+		Items:      make(MenuItems, 0, len(v.Items)),
+	}
+	for _, id := range v.StoreIDs {
+		menu.Stores = append(menu.Stores, &StoreSimple{ID: id})
+	}
+	// 门店菜单仅适用于本门店
+	if v.StoreID != uuid.Nil {
+		menu.StoreCount = 1
+	}
+	for _, item := range v.Items {
+		menu.Items = append(menu.Items, &MenuItem{
+			ID:           uuid.New(),
+			MenuID:       v.MenuID,
+			ProductID:    item.ProductID,
+			BasePrice:    item.BasePrice,
+			MemberPrice:  item.MemberPrice,
+			PeriodPrices: item.PeriodPrices,
+		})
+	}
+	return menu
+}
+
+// SetItemPrices 使用门店菜单项的当前价格更新快照中对应菜品的价格，快照不包含该菜品时返回 false
+//
+// 批量调价、恢复历史版本等直接修改门店菜单项价格时同步到草稿，避免草稿发布后覆盖这些修改。
+func (v *MenuVersion) SetItemPrices(item *MenuItem) bool {
+	for _, vi := range v.Items {
+		if vi.ProductID == item.ProductID {
+			vi.BasePrice = item.BasePrice
+			vi.MemberPrice = item.MemberPrice
+			vi.PeriodPrices = item.PeriodPrices
+			return true
+		}
+	}
+	return false
+}
+
+// KeepLivePrices 发布或回滚前保留版本之外对门店菜单价格的修改
+//
+// base 为当前发布版本：本版本中与 base 相同的价格说明本版本未修改该价格，沿用门店菜单的当前价格；
+// 本版本修改过的价格仍以本版本为准。
+func (v *MenuVersion) KeepLivePrices(base *MenuVersion, live *Menu) {
+	if base == nil || live == nil {
+		return
+	}
+	baseItems := make(map[uuid.UUID]*MenuVersionItem, len(base.Items))
+	for _, item := range base.Items {
+		baseItems[item.ProductID] = item
+	}
+	liveItems := make(map[uuid.UUID]*MenuItem, len(live.Items))
+	for _, item := range live.Items {
+		liveItems[item.ProductID] = item
+	}
+	for _, item := range v.Items {
+		b, ok := baseItems[item.ProductID]
+		if !ok {
+			continue
+		}
+		l, ok := liveItems[item.ProductID]
+		if !ok {
+			continue
+		}
+		if decimalPtrEqual(item.BasePrice, b.BasePrice) {
+			item.BasePrice = l.BasePrice
+		}
+		if decimalPtrEqual(item.MemberPrice, b.MemberPrice) {
+			item.MemberPrice = l.MemberPrice
+		}
+		if item.PeriodPrices.Equal(b.PeriodPrices) {
+			item.PeriodPrices = l.PeriodPrices
+		}
+	}
+}
+
+// decimalPtrEqual 比较两个可选金额是否相等（均为空视为相等）
+func decimalPtrEqual(a, b *decimal.Decimal) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// ------------------------------------------------------------
+// 仓储接口
+// ------------------------------------------------------------
+
+// MenuVersionRepository 菜单版本仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/menu_version_repository.go -package=mock . MenuVersionRepository
+type MenuVersionRepository interface {
+	Create(ctx context.Context, version *MenuVersion) error
+	Update(ctx context.Context, version *MenuVersion) error
+	// FindByStatus 查询菜单指定状态的最新版本（草稿、已发布版本各至多一个）
+	FindByStatus(ctx context.Context, menuID uuid.UUID, status MenuVersionStatus) (*MenuVersion, error)
+	// FindDraftForUpdate 锁定查询菜单草稿（用于发布的并发控制）
+	FindDraftForUpdate(ctx context.Context, menuID uuid.UUID) (*MenuVersion, error)
+	// FindPreviousArchived 查询版本号小于指定版本的最新归档版本（用于回滚）
+	FindPreviousArchived(ctx context.Context, menuID uuid.UUID, beforeVersion int) (*MenuVersion, error)
+	// MaxVersion 查询菜单当前最大版本号（没有版本时返回 0）
+	MaxVersion(ctx context.Context, menuID uuid.UUID) (int, error)
+	ListByMenuID(ctx context.Context, menuID uuid.UUID) (MenuVersions, error)
+	// ListDueDraftMenuIDs 查询已到定时发布时间的草稿所属菜单ID
+	ListDueDraftMenuIDs(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+	DeleteByMenuID(ctx context.Context, menuID uuid.UUID) error
+}
@@ -63,3 +63,18 @@ func (mr *MockDailySequenceMockRecorder) Next(arg0, arg1 interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockDailySequence)(nil).Next), arg0, arg1)
 }
+
+// NextInRange mocks base method.
+func (m *MockDailySequence) NextInRange(arg0 context.Context, arg1 string, arg2, arg3 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextInRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextInRange indicates an expected call of NextInRange.
+func (mr *MockDailySequenceMockRecorder) NextInRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextInRange", reflect.TypeOf((*MockDailySequence)(nil).NextInRange), arg0, arg1, arg2, arg3)
+}
@@ -131,6 +131,90 @@ func (mr *MockDataStoreMockRecorder) CategoryRepo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategoryRepo", reflect.TypeOf((*MockDataStore)(nil).CategoryRepo))
 }
 
+// CouponRepo mocks base method.
+func (m *MockDataStore) CouponRepo() domain.CouponRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CouponRepo")
+	ret0, _ := ret[0].(domain.CouponRepository)
+	return ret0
+}
+
+// CouponRepo indicates an expected call of CouponRepo.
+func (mr *MockDataStoreMockRecorder) CouponRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CouponRepo", reflect.TypeOf((*MockDataStore)(nil).CouponRepo))
+}
+
+// CouponTemplateRepo mocks base method.
+func (m *MockDataStore) CouponTemplateRepo() domain.CouponTemplateRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CouponTemplateRepo")
+	ret0, _ := ret[0].(domain.CouponTemplateRepository)
+	return ret0
+}
+
+// CouponTemplateRepo indicates an expected call of CouponTemplateRepo.
+func (mr *MockDataStoreMockRecorder) CouponTemplateRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CouponTemplateRepo", reflect.TypeOf((*MockDataStore)(nil).CouponTemplateRepo))
+}
+
+// CustomerRepo mocks base method.
+func (m *MockDataStore) CustomerRepo() domain.CustomerRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomerRepo")
+	ret0, _ := ret[0].(domain.CustomerRepository)
+	return ret0
+}
+
+// CustomerRepo indicates an expected call of CustomerRepo.
+func (mr *MockDataStoreMockRecorder) CustomerRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomerRepo", reflect.TypeOf((*MockDataStore)(nil).CustomerRepo))
+}
+
+// DeliveryOrderRepo mocks base method.
+func (m *MockDataStore) DeliveryOrderRepo() domain.DeliveryOrderRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliveryOrderRepo")
+	ret0, _ := ret[0].(domain.DeliveryOrderRepository)
+	return ret0
+}
+
+// DeliveryOrderRepo indicates an expected call of DeliveryOrderRepo.
+func (mr *MockDataStoreMockRecorder) DeliveryOrderRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliveryOrderRepo", reflect.TypeOf((*MockDataStore)(nil).DeliveryOrderRepo))
+}
+
+// DeliveryProductMappingRepo mocks base method.
+func (m *MockDataStore) DeliveryProductMappingRepo() domain.DeliveryProductMappingRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliveryProductMappingRepo")
+	ret0, _ := ret[0].(domain.DeliveryProductMappingRepository)
+	return ret0
+}
+
+// DeliveryProductMappingRepo indicates an expected call of DeliveryProductMappingRepo.
+func (mr *MockDataStoreMockRecorder) DeliveryProductMappingRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliveryProductMappingRepo", reflect.TypeOf((*MockDataStore)(nil).DeliveryProductMappingRepo))
+}
+
+// DeliveryStoreRepo mocks base method.
+func (m *MockDataStore) DeliveryStoreRepo() domain.DeliveryStoreRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliveryStoreRepo")
+	ret0, _ := ret[0].(domain.DeliveryStoreRepository)
+	return ret0
+}
+
+// DeliveryStoreRepo indicates an expected call of DeliveryStoreRepo.
+func (mr *MockDataStoreMockRecorder) DeliveryStoreRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliveryStoreRepo", reflect.TypeOf((*MockDataStore)(nil).DeliveryStoreRepo))
+}
+
 // DepartmentRepo mocks base method.
 func (m *MockDataStore) DepartmentRepo() domain.DepartmentRepository {
 	m.ctrl.T.Helper()
@@ -159,6 +243,20 @@ func (mr *MockDataStoreMockRecorder) DeviceRepo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceRepo", reflect.TypeOf((*MockDataStore)(nil).DeviceRepo))
 }
 
+// DiningTableRepo mocks base method.
+func (m *MockDataStore) DiningTableRepo() domain.DiningTableRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiningTableRepo")
+	ret0, _ := ret[0].(domain.DiningTableRepository)
+	return ret0
+}
+
+// DiningTableRepo indicates an expected call of DiningTableRepo.
+func (mr *MockDataStoreMockRecorder) DiningTableRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiningTableRepo", reflect.TypeOf((*MockDataStore)(nil).DiningTableRepo))
+}
+
 // IsTransactionActive mocks base method.
 func (m *MockDataStore) IsTransactionActive() bool {
 	m.ctrl.T.Helper()
@@ -173,6 +271,118 @@ func (mr *MockDataStoreMockRecorder) IsTransactionActive() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTransactionActive", reflect.TypeOf((*MockDataStore)(nil).IsTransactionActive))
 }
 
+// KitchenTicketRepo mocks base method.
+func (m *MockDataStore) KitchenTicketRepo() domain.KitchenTicketRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KitchenTicketRepo")
+	ret0, _ := ret[0].(domain.KitchenTicketRepository)
+	return ret0
+}
+
+// KitchenTicketRepo indicates an expected call of KitchenTicketRepo.
+func (mr *MockDataStoreMockRecorder) KitchenTicketRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KitchenTicketRepo", reflect.TypeOf((*MockDataStore)(nil).KitchenTicketRepo))
+}
+
+// MemberAccountRepo mocks base method.
+func (m *MockDataStore) MemberAccountRepo() domain.MemberAccountRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemberAccountRepo")
+	ret0, _ := ret[0].(domain.MemberAccountRepository)
+	return ret0
+}
+
+// MemberAccountRepo indicates an expected call of MemberAccountRepo.
+func (mr *MockDataStoreMockRecorder) MemberAccountRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemberAccountRepo", reflect.TypeOf((*MockDataStore)(nil).MemberAccountRepo))
+}
+
+// MemberAccountTransactionRepo mocks base method.
+func (m *MockDataStore) MemberAccountTransactionRepo() domain.MemberAccountTransactionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemberAccountTransactionRepo")
+	ret0, _ := ret[0].(domain.MemberAccountTransactionRepository)
+	return ret0
+}
+
+// MemberAccountTransactionRepo indicates an expected call of MemberAccountTransactionRepo.
+func (mr *MockDataStoreMockRecorder) MemberAccountTransactionRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemberAccountTransactionRepo", reflect.TypeOf((*MockDataStore)(nil).MemberAccountTransactionRepo))
+}
+
+// MemberPointsAccountRepo mocks base method.
+func (m *MockDataStore) MemberPointsAccountRepo() domain.MemberPointsAccountRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemberPointsAccountRepo")
+	ret0, _ := ret[0].(domain.MemberPointsAccountRepository)
+	return ret0
+}
+
+// MemberPointsAccountRepo indicates an expected call of MemberPointsAccountRepo.
+func (mr *MockDataStoreMockRecorder) MemberPointsAccountRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemberPointsAccountRepo", reflect.TypeOf((*MockDataStore)(nil).MemberPointsAccountRepo))
+}
+
+// MemberPointsRuleRepo mocks base method.
+func (m *MockDataStore) MemberPointsRuleRepo() domain.MemberPointsRuleRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemberPointsRuleRepo")
+	ret0, _ := ret[0].(domain.MemberPointsRuleRepository)
+	return ret0
+}
+
+// MemberPointsRuleRepo indicates an expected call of MemberPointsRuleRepo.
+func (mr *MockDataStoreMockRecorder) MemberPointsRuleRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemberPointsRuleRepo", reflect.TypeOf((*MockDataStore)(nil).MemberPointsRuleRepo))
+}
+
+// MemberPointsTransactionRepo mocks base method.
+func (m *MockDataStore) MemberPointsTransactionRepo() domain.MemberPointsTransactionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemberPointsTransactionRepo")
+	ret0, _ := ret[0].(domain.MemberPointsTransactionRepository)
+	return ret0
+}
+
+// MemberPointsTransactionRepo indicates an expected call of MemberPointsTransactionRepo.
+func (mr *MockDataStoreMockRecorder) MemberPointsTransactionRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemberPointsTransactionRepo", reflect.TypeOf((*MockDataStore)(nil).MemberPointsTransactionRepo))
+}
+
+// MemberRepo mocks base method.
+func (m *MockDataStore) MemberRepo() domain.MemberRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemberRepo")
+	ret0, _ := ret[0].(domain.MemberRepository)
+	return ret0
+}
+
+// MemberRepo indicates an expected call of MemberRepo.
+func (mr *MockDataStoreMockRecorder) MemberRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemberRepo", reflect.TypeOf((*MockDataStore)(nil).MemberRepo))
+}
+
+// MemberTierRepo mocks base method.
+func (m *MockDataStore) MemberTierRepo() domain.MemberTierRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemberTierRepo")
+	ret0, _ := ret[0].(domain.MemberTierRepository)
+	return ret0
+}
+
+// MemberTierRepo indicates an expected call of MemberTierRepo.
+func (mr *MockDataStoreMockRecorder) MemberTierRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemberTierRepo", reflect.TypeOf((*MockDataStore)(nil).MemberTierRepo))
+}
+
 // MenuRepo mocks base method.
 func (m *MockDataStore) MenuRepo() domain.MenuRepository {
 	m.ctrl.T.Helper()
@@ -187,6 +397,20 @@ func (mr *MockDataStoreMockRecorder) MenuRepo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MenuRepo", reflect.TypeOf((*MockDataStore)(nil).MenuRepo))
 }
 
+// MenuVersionRepo mocks base method.
+func (m *MockDataStore) MenuVersionRepo() domain.MenuVersionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MenuVersionRepo")
+	ret0, _ := ret[0].(domain.MenuVersionRepository)
+	return ret0
+}
+
+// MenuVersionRepo indicates an expected call of MenuVersionRepo.
+func (mr *MockDataStoreMockRecorder) MenuVersionRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MenuVersionRepo", reflect.TypeOf((*MockDataStore)(nil).MenuVersionRepo))
+}
+
 // MerchantRenewalRepo mocks base method.
 func (m *MockDataStore) MerchantRenewalRepo() domain.MerchantRenewalRepository {
 	m.ctrl.T.Helper()
@@ -271,6 +495,76 @@ func (mr *MockDataStoreMockRecorder) PermissionRepo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermissionRepo", reflect.TypeOf((*MockDataStore)(nil).PermissionRepo))
 }
 
+// PickupCallRepo mocks base method.
+func (m *MockDataStore) PickupCallRepo() domain.PickupCallRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickupCallRepo")
+	ret0, _ := ret[0].(domain.PickupCallRepository)
+	return ret0
+}
+
+// PickupCallRepo indicates an expected call of PickupCallRepo.
+func (mr *MockDataStoreMockRecorder) PickupCallRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickupCallRepo", reflect.TypeOf((*MockDataStore)(nil).PickupCallRepo))
+}
+
+// PickupRuleRepo mocks base method.
+func (m *MockDataStore) PickupRuleRepo() domain.PickupRuleRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickupRuleRepo")
+	ret0, _ := ret[0].(domain.PickupRuleRepository)
+	return ret0
+}
+
+// PickupRuleRepo indicates an expected call of PickupRuleRepo.
+func (mr *MockDataStoreMockRecorder) PickupRuleRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickupRuleRepo", reflect.TypeOf((*MockDataStore)(nil).PickupRuleRepo))
+}
+
+// PreorderRepo mocks base method.
+func (m *MockDataStore) PreorderRepo() domain.PreorderRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreorderRepo")
+	ret0, _ := ret[0].(domain.PreorderRepository)
+	return ret0
+}
+
+// PreorderRepo indicates an expected call of PreorderRepo.
+func (mr *MockDataStoreMockRecorder) PreorderRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreorderRepo", reflect.TypeOf((*MockDataStore)(nil).PreorderRepo))
+}
+
+// PreorderRuleRepo mocks base method.
+func (m *MockDataStore) PreorderRuleRepo() domain.PreorderRuleRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreorderRuleRepo")
+	ret0, _ := ret[0].(domain.PreorderRuleRepository)
+	return ret0
+}
+
+// PreorderRuleRepo indicates an expected call of PreorderRuleRepo.
+func (mr *MockDataStoreMockRecorder) PreorderRuleRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreorderRuleRepo", reflect.TypeOf((*MockDataStore)(nil).PreorderRuleRepo))
+}
+
+// PriceChangeRepo mocks base method.
+func (m *MockDataStore) PriceChangeRepo() domain.PriceChangeRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceChangeRepo")
+	ret0, _ := ret[0].(domain.PriceChangeRepository)
+	return ret0
+}
+
+// PriceChangeRepo indicates an expected call of PriceChangeRepo.
+func (mr *MockDataStoreMockRecorder) PriceChangeRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceChangeRepo", reflect.TypeOf((*MockDataStore)(nil).PriceChangeRepo))
+}
+
 // ProductAttrRelRepo mocks base method.
 func (m *MockDataStore) ProductAttrRelRepo() domain.ProductAttrRelRepository {
 	m.ctrl.T.Helper()
@@ -369,6 +663,20 @@ func (mr *MockDataStoreMockRecorder) ProductUnitRepo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductUnitRepo", reflect.TypeOf((*MockDataStore)(nil).ProductUnitRepo))
 }
 
+// ProductVersionRepo mocks base method.
+func (m *MockDataStore) ProductVersionRepo() domain.ProductVersionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductVersionRepo")
+	ret0, _ := ret[0].(domain.ProductVersionRepository)
+	return ret0
+}
+
+// ProductVersionRepo indicates an expected call of ProductVersionRepo.
+func (mr *MockDataStoreMockRecorder) ProductVersionRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductVersionRepo", reflect.TypeOf((*MockDataStore)(nil).ProductVersionRepo))
+}
+
 // ProfitDistributionBillRepo mocks base method.
 func (m *MockDataStore) ProfitDistributionBillRepo() domain.ProfitDistributionBillRepository {
 	m.ctrl.T.Helper()
@@ -397,6 +705,34 @@ func (mr *MockDataStoreMockRecorder) ProfitDistributionRuleRepo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProfitDistributionRuleRepo", reflect.TypeOf((*MockDataStore)(nil).ProfitDistributionRuleRepo))
 }
 
+// PromotionRepo mocks base method.
+func (m *MockDataStore) PromotionRepo() domain.PromotionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromotionRepo")
+	ret0, _ := ret[0].(domain.PromotionRepository)
+	return ret0
+}
+
+// PromotionRepo indicates an expected call of PromotionRepo.
+func (mr *MockDataStoreMockRecorder) PromotionRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromotionRepo", reflect.TypeOf((*MockDataStore)(nil).PromotionRepo))
+}
+
+// ReceiptRequestRepo mocks base method.
+func (m *MockDataStore) ReceiptRequestRepo() domain.ReceiptRequestRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiptRequestRepo")
+	ret0, _ := ret[0].(domain.ReceiptRequestRepository)
+	return ret0
+}
+
+// ReceiptRequestRepo indicates an expected call of ReceiptRequestRepo.
+func (mr *MockDataStoreMockRecorder) ReceiptRequestRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiptRequestRepo", reflect.TypeOf((*MockDataStore)(nil).ReceiptRequestRepo))
+}
+
 // RefundOrderRepo mocks base method.
 func (m *MockDataStore) RefundOrderRepo() domain.RefundOrderRepository {
 	m.ctrl.T.Helper()
@@ -425,6 +761,34 @@ func (mr *MockDataStoreMockRecorder) RemarkRepo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemarkRepo", reflect.TypeOf((*MockDataStore)(nil).RemarkRepo))
 }
 
+// ReservationRepo mocks base method.
+func (m *MockDataStore) ReservationRepo() domain.ReservationRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReservationRepo")
+	ret0, _ := ret[0].(domain.ReservationRepository)
+	return ret0
+}
+
+// ReservationRepo indicates an expected call of ReservationRepo.
+func (mr *MockDataStoreMockRecorder) ReservationRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReservationRepo", reflect.TypeOf((*MockDataStore)(nil).ReservationRepo))
+}
+
+// ReservationRuleRepo mocks base method.
+func (m *MockDataStore) ReservationRuleRepo() domain.ReservationRuleRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReservationRuleRepo")
+	ret0, _ := ret[0].(domain.ReservationRuleRepository)
+	return ret0
+}
+
+// ReservationRuleRepo indicates an expected call of ReservationRuleRepo.
+func (mr *MockDataStoreMockRecorder) ReservationRuleRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReservationRuleRepo", reflect.TypeOf((*MockDataStore)(nil).ReservationRuleRepo))
+}
+
 // RoleMenuRepo mocks base method.
 func (m *MockDataStore) RoleMenuRepo() domain.RoleMenuRepository {
 	m.ctrl.T.Helper()
@@ -467,6 +831,20 @@ func (mr *MockDataStoreMockRecorder) RouterMenuRepo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RouterMenuRepo", reflect.TypeOf((*MockDataStore)(nil).RouterMenuRepo))
 }
 
+// ScanOrderRuleRepo mocks base method.
+func (m *MockDataStore) ScanOrderRuleRepo() domain.ScanOrderRuleRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanOrderRuleRepo")
+	ret0, _ := ret[0].(domain.ScanOrderRuleRepository)
+	return ret0
+}
+
+// ScanOrderRuleRepo indicates an expected call of ScanOrderRuleRepo.
+func (mr *MockDataStoreMockRecorder) ScanOrderRuleRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanOrderRuleRepo", reflect.TypeOf((*MockDataStore)(nil).ScanOrderRuleRepo))
+}
+
 // SetMealGroupRepo mocks base method.
 func (m *MockDataStore) SetMealGroupRepo() domain.SetMealGroupRepository {
 	m.ctrl.T.Helper()
@@ -564,3 +942,31 @@ func (mr *MockDataStoreMockRecorder) UserRoleRepo() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserRoleRepo", reflect.TypeOf((*MockDataStore)(nil).UserRoleRepo))
 }
+
+// WaitlistRuleRepo mocks base method.
+func (m *MockDataStore) WaitlistRuleRepo() domain.WaitlistRuleRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitlistRuleRepo")
+	ret0, _ := ret[0].(domain.WaitlistRuleRepository)
+	return ret0
+}
+
+// WaitlistRuleRepo indicates an expected call of WaitlistRuleRepo.
+func (mr *MockDataStoreMockRecorder) WaitlistRuleRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitlistRuleRepo", reflect.TypeOf((*MockDataStore)(nil).WaitlistRuleRepo))
+}
+
+// WaitlistTicketRepo mocks base method.
+func (m *MockDataStore) WaitlistTicketRepo() domain.WaitlistTicketRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitlistTicketRepo")
+	ret0, _ := ret[0].(domain.WaitlistTicketRepository)
+	return ret0
+}
+
+// WaitlistTicketRepo indicates an expected call of WaitlistTicketRepo.
+func (mr *MockDataStoreMockRecorder) WaitlistTicketRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitlistTicketRepo", reflect.TypeOf((*MockDataStore)(nil).WaitlistTicketRepo))
+}
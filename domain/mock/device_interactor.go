@@ -115,6 +115,40 @@ func (mr *MockDeviceInteractorMockRecorder) GetDevices(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevices", reflect.TypeOf((*MockDeviceInteractor)(nil).GetDevices), varargs...)
 }
 
+// ResetKioskToken mocks base method.
+func (m *MockDeviceInteractor) ResetKioskToken(arg0 context.Context, arg1 uuid.UUID, arg2 domain.User) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetKioskToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetKioskToken indicates an expected call of ResetKioskToken.
+func (mr *MockDeviceInteractorMockRecorder) ResetKioskToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetKioskToken", reflect.TypeOf((*MockDeviceInteractor)(nil).ResetKioskToken), arg0, arg1, arg2)
+}
+
+// SyncStoreDeviceStatus mocks base method.
+func (m *MockDeviceInteractor) SyncStoreDeviceStatus(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncStoreDeviceStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncStoreDeviceStatus indicates an expected call of SyncStoreDeviceStatus.
+func (mr *MockDeviceInteractorMockRecorder) SyncStoreDeviceStatus(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStoreDeviceStatus", reflect.TypeOf((*MockDeviceInteractor)(nil).SyncStoreDeviceStatus), varargs...)
+}
+
 // Update mocks base method.
 func (m *MockDeviceInteractor) Update(arg0 context.Context, arg1 *domain.Device, arg2 domain.User) error {
 	m.ctrl.T.Helper()
@@ -95,6 +95,36 @@ func (mr *MockDeviceRepositoryMockRecorder) FindByID(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDeviceRepository)(nil).FindByID), arg0, arg1)
 }
 
+// FindByKioskToken mocks base method.
+func (m *MockDeviceRepository) FindByKioskToken(arg0 context.Context, arg1 string) (*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByKioskToken", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByKioskToken indicates an expected call of FindByKioskToken.
+func (mr *MockDeviceRepositoryMockRecorder) FindByKioskToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKioskToken", reflect.TypeOf((*MockDeviceRepository)(nil).FindByKioskToken), arg0, arg1)
+}
+
+// GetDetail mocks base method.
+func (m *MockDeviceRepository) GetDetail(arg0 context.Context, arg1 uuid.UUID) (*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDetail", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDetail indicates an expected call of GetDetail.
+func (mr *MockDeviceRepositoryMockRecorder) GetDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetail", reflect.TypeOf((*MockDeviceRepository)(nil).GetDetail), arg0, arg1)
+}
+
 // GetDevices mocks base method.
 func (m *MockDeviceRepository) GetDevices(arg0 context.Context, arg1 *upagination.Pagination, arg2 *domain.DeviceListFilter, arg3 ...domain.DeviceOrderBy) ([]*domain.Device, int, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +146,59 @@ func (mr *MockDeviceRepositoryMockRecorder) GetDevices(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevices", reflect.TypeOf((*MockDeviceRepository)(nil).GetDevices), varargs...)
 }
 
+// ListBySearch mocks base method.
+func (m *MockDeviceRepository) ListBySearch(arg0 context.Context, arg1 *domain.DeviceListFilter) ([]*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySearch", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBySearch indicates an expected call of ListBySearch.
+func (mr *MockDeviceRepositoryMockRecorder) ListBySearch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySearch", reflect.TypeOf((*MockDeviceRepository)(nil).ListBySearch), arg0, arg1)
+}
+
+// OffLine mocks base method.
+func (m *MockDeviceRepository) OffLine(arg0 context.Context, arg1 ...uuid.UUID) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OffLine", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OffLine indicates an expected call of OffLine.
+func (mr *MockDeviceRepositoryMockRecorder) OffLine(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OffLine", reflect.TypeOf((*MockDeviceRepository)(nil).OffLine), varargs...)
+}
+
+// OnLine mocks base method.
+func (m *MockDeviceRepository) OnLine(arg0 context.Context, arg1 ...uuid.UUID) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OnLine", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnLine indicates an expected call of OnLine.
+func (mr *MockDeviceRepositoryMockRecorder) OnLine(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnLine", reflect.TypeOf((*MockDeviceRepository)(nil).OnLine), varargs...)
+}
+
 // Update mocks base method.
 func (m *MockDeviceRepository) Update(arg0 context.Context, arg1 *domain.Device) error {
 	m.ctrl.T.Helper()
@@ -129,3 +212,17 @@ func (mr *MockDeviceRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDeviceRepository)(nil).Update), arg0, arg1)
 }
+
+// UpdateKioskToken mocks base method.
+func (m *MockDeviceRepository) UpdateKioskToken(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKioskToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateKioskToken indicates an expected call of UpdateKioskToken.
+func (mr *MockDeviceRepositoryMockRecorder) UpdateKioskToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKioskToken", reflect.TypeOf((*MockDeviceRepository)(nil).UpdateKioskToken), arg0, arg1, arg2)
+}
@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -37,6 +38,20 @@ func (m *MockMenuInteractor) EXPECT() *MockMenuInteractorMockRecorder {
 	return m.recorder
 }
 
+// CancelScheduledPublish mocks base method.
+func (m *MockMenuInteractor) CancelScheduledPublish(arg0 context.Context, arg1 uuid.UUID, arg2 domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledPublish", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelScheduledPublish indicates an expected call of CancelScheduledPublish.
+func (mr *MockMenuInteractorMockRecorder) CancelScheduledPublish(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledPublish", reflect.TypeOf((*MockMenuInteractor)(nil).CancelScheduledPublish), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
 func (m *MockMenuInteractor) Create(arg0 context.Context, arg1 *domain.Menu, arg2 domain.User) error {
 	m.ctrl.T.Helper()
@@ -80,6 +95,21 @@ func (mr *MockMenuInteractorMockRecorder) GetDetail(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetail", reflect.TypeOf((*MockMenuInteractor)(nil).GetDetail), arg0, arg1, arg2)
 }
 
+// GetDraft mocks base method.
+func (m *MockMenuInteractor) GetDraft(arg0 context.Context, arg1 uuid.UUID, arg2 domain.User) (*domain.MenuVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDraft", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.MenuVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDraft indicates an expected call of GetDraft.
+func (mr *MockMenuInteractorMockRecorder) GetDraft(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraft", reflect.TypeOf((*MockMenuInteractor)(nil).GetDraft), arg0, arg1, arg2)
+}
+
 // ListAllStoreMenus mocks base method.
 func (m *MockMenuInteractor) ListAllStoreMenus(arg0 context.Context, arg1 domain.MenuListAllParams) (domain.Menus, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +125,21 @@ func (mr *MockMenuInteractorMockRecorder) ListAllStoreMenus(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllStoreMenus", reflect.TypeOf((*MockMenuInteractor)(nil).ListAllStoreMenus), arg0, arg1)
 }
 
+// ListVersions mocks base method.
+func (m *MockMenuInteractor) ListVersions(arg0 context.Context, arg1 uuid.UUID, arg2 domain.User) (domain.MenuVersions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(domain.MenuVersions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockMenuInteractorMockRecorder) ListVersions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockMenuInteractor)(nil).ListVersions), arg0, arg1, arg2)
+}
+
 // PagedListBySearch mocks base method.
 func (m *MockMenuInteractor) PagedListBySearch(arg0 context.Context, arg1 *upagination.Pagination, arg2 domain.MenuSearchParams) (*domain.MenuSearchRes, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +155,48 @@ func (mr *MockMenuInteractorMockRecorder) PagedListBySearch(arg0, arg1, arg2 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PagedListBySearch", reflect.TypeOf((*MockMenuInteractor)(nil).PagedListBySearch), arg0, arg1, arg2)
 }
 
+// Publish mocks base method.
+func (m *MockMenuInteractor) Publish(arg0 context.Context, arg1 uuid.UUID, arg2 *time.Time, arg3 domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockMenuInteractorMockRecorder) Publish(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockMenuInteractor)(nil).Publish), arg0, arg1, arg2, arg3)
+}
+
+// PublishDueDrafts mocks base method.
+func (m *MockMenuInteractor) PublishDueDrafts(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDueDrafts", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishDueDrafts indicates an expected call of PublishDueDrafts.
+func (mr *MockMenuInteractorMockRecorder) PublishDueDrafts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDueDrafts", reflect.TypeOf((*MockMenuInteractor)(nil).PublishDueDrafts), arg0)
+}
+
+// Rollback mocks base method.
+func (m *MockMenuInteractor) Rollback(arg0 context.Context, arg1 uuid.UUID, arg2 domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockMenuInteractorMockRecorder) Rollback(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockMenuInteractor)(nil).Rollback), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockMenuInteractor) Update(arg0 context.Context, arg1 *domain.Menu, arg2 domain.User) error {
 	m.ctrl.T.Helper()
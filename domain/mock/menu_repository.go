@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
 	domain "gitlab.jiguang.dev/pos-dine/dine/domain"
 	upagination "gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
 )
@@ -125,6 +126,21 @@ func (mr *MockMenuRepositoryMockRecorder) ListAllStoreMenus(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllStoreMenus", reflect.TypeOf((*MockMenuRepository)(nil).ListAllStoreMenus), arg0, arg1)
 }
 
+// ListStoreMenuItemsByProductID mocks base method.
+func (m *MockMenuRepository) ListStoreMenuItemsByProductID(arg0 context.Context, arg1, arg2 uuid.UUID) (domain.MenuItems, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoreMenuItemsByProductID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(domain.MenuItems)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoreMenuItemsByProductID indicates an expected call of ListStoreMenuItemsByProductID.
+func (mr *MockMenuRepositoryMockRecorder) ListStoreMenuItemsByProductID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoreMenuItemsByProductID", reflect.TypeOf((*MockMenuRepository)(nil).ListStoreMenuItemsByProductID), arg0, arg1, arg2)
+}
+
 // PagedListMerchantMenusBySearch mocks base method.
 func (m *MockMenuRepository) PagedListMerchantMenusBySearch(arg0 context.Context, arg1 *upagination.Pagination, arg2 domain.MenuSearchParams) (*domain.MenuSearchRes, error) {
 	m.ctrl.T.Helper()
@@ -168,3 +184,31 @@ func (mr *MockMenuRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMenuRepository)(nil).Update), arg0, arg1)
 }
+
+// UpdateItemBasePrice mocks base method.
+func (m *MockMenuRepository) UpdateItemBasePrice(arg0 context.Context, arg1 uuid.UUID, arg2 *decimal.Decimal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemBasePrice", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItemBasePrice indicates an expected call of UpdateItemBasePrice.
+func (mr *MockMenuRepositoryMockRecorder) UpdateItemBasePrice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemBasePrice", reflect.TypeOf((*MockMenuRepository)(nil).UpdateItemBasePrice), arg0, arg1, arg2)
+}
+
+// UpdateItemPrices mocks base method.
+func (m *MockMenuRepository) UpdateItemPrices(arg0 context.Context, arg1 *domain.MenuItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemPrices", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItemPrices indicates an expected call of UpdateItemPrices.
+func (mr *MockMenuRepositoryMockRecorder) UpdateItemPrices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemPrices", reflect.TypeOf((*MockMenuRepository)(nil).UpdateItemPrices), arg0, arg1)
+}
@@ -37,17 +37,17 @@ func (m *MockProductAttrInteractor) EXPECT() *MockProductAttrInteractorMockRecor
 }
 
 // Create mocks base method.
-func (m *MockProductAttrInteractor) Create(arg0 context.Context, arg1 *domain.ProductAttr) error {
+func (m *MockProductAttrInteractor) Create(arg0 context.Context, arg1 *domain.ProductAttr, arg2 domain.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockProductAttrInteractorMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockProductAttrInteractorMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProductAttrInteractor)(nil).Create), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProductAttrInteractor)(nil).Create), arg0, arg1, arg2)
 }
 
 // Delete mocks base method.
@@ -6,6 +6,7 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -37,6 +38,21 @@ func (m *MockProductInteractor) EXPECT() *MockProductInteractorMockRecorder {
 	return m.recorder
 }
 
+// BackfillPinyin mocks base method.
+func (m *MockProductInteractor) BackfillPinyin(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillPinyin", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillPinyin indicates an expected call of BackfillPinyin.
+func (mr *MockProductInteractorMockRecorder) BackfillPinyin(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillPinyin", reflect.TypeOf((*MockProductInteractor)(nil).BackfillPinyin), arg0)
+}
+
 // Create mocks base method.
 func (m *MockProductInteractor) Create(arg0 context.Context, arg1 *domain.Product, arg2 domain.User) error {
 	m.ctrl.T.Helper()
@@ -93,6 +109,21 @@ func (mr *MockProductInteractorMockRecorder) Distribute(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Distribute", reflect.TypeOf((*MockProductInteractor)(nil).Distribute), arg0, arg1, arg2)
 }
 
+// Export mocks base method.
+func (m *MockProductInteractor) Export(arg0 context.Context, arg1 domain.ProductSearchParams, arg2 domain.User) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockProductInteractorMockRecorder) Export(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockProductInteractor)(nil).Export), arg0, arg1, arg2)
+}
+
 // GetDetail mocks base method.
 func (m *MockProductInteractor) GetDetail(arg0 context.Context, arg1 uuid.UUID, arg2 domain.User) (*domain.Product, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +139,36 @@ func (mr *MockProductInteractorMockRecorder) GetDetail(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetail", reflect.TypeOf((*MockProductInteractor)(nil).GetDetail), arg0, arg1, arg2)
 }
 
+// Import mocks base method.
+func (m *MockProductInteractor) Import(arg0 context.Context, arg1 io.Reader, arg2 domain.User) (*domain.ProductImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.ProductImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockProductInteractorMockRecorder) Import(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockProductInteractor)(nil).Import), arg0, arg1, arg2)
+}
+
+// ImportTemplate mocks base method.
+func (m *MockProductInteractor) ImportTemplate(arg0 context.Context, arg1 domain.User) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTemplate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportTemplate indicates an expected call of ImportTemplate.
+func (mr *MockProductInteractorMockRecorder) ImportTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTemplate", reflect.TypeOf((*MockProductInteractor)(nil).ImportTemplate), arg0, arg1)
+}
+
 // OffSale mocks base method.
 func (m *MockProductInteractor) OffSale(arg0 context.Context, arg1 uuid.UUID, arg2 domain.User) error {
 	m.ctrl.T.Helper()
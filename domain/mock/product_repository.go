@@ -215,6 +215,36 @@ func (mr *MockProductRepositoryMockRecorder) ListByIDs(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockProductRepository)(nil).ListByIDs), arg0, arg1)
 }
 
+// ListDetailsBySearch mocks base method.
+func (m *MockProductRepository) ListDetailsBySearch(arg0 context.Context, arg1 domain.ProductSearchParams) (domain.Products, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDetailsBySearch", arg0, arg1)
+	ret0, _ := ret[0].(domain.Products)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDetailsBySearch indicates an expected call of ListDetailsBySearch.
+func (mr *MockProductRepositoryMockRecorder) ListDetailsBySearch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDetailsBySearch", reflect.TypeOf((*MockProductRepository)(nil).ListDetailsBySearch), arg0, arg1)
+}
+
+// ListMissingPinyin mocks base method.
+func (m *MockProductRepository) ListMissingPinyin(arg0 context.Context, arg1 uuid.UUID, arg2 int) (domain.Products, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMissingPinyin", arg0, arg1, arg2)
+	ret0, _ := ret[0].(domain.Products)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMissingPinyin indicates an expected call of ListMissingPinyin.
+func (mr *MockProductRepositoryMockRecorder) ListMissingPinyin(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMissingPinyin", reflect.TypeOf((*MockProductRepository)(nil).ListMissingPinyin), arg0, arg1, arg2)
+}
+
 // PagedListBySearch mocks base method.
 func (m *MockProductRepository) PagedListBySearch(arg0 context.Context, arg1 *upagination.Pagination, arg2 domain.ProductSearchParams) (*domain.ProductSearchRes, error) {
 	m.ctrl.T.Helper()
@@ -243,3 +273,31 @@ func (mr *MockProductRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProductRepository)(nil).Update), arg0, arg1)
 }
+
+// UpdateDietary mocks base method.
+func (m *MockProductRepository) UpdateDietary(arg0 context.Context, arg1 *domain.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDietary", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDietary indicates an expected call of UpdateDietary.
+func (mr *MockProductRepositoryMockRecorder) UpdateDietary(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDietary", reflect.TypeOf((*MockProductRepository)(nil).UpdateDietary), arg0, arg1)
+}
+
+// UpdatePinyin mocks base method.
+func (m *MockProductRepository) UpdatePinyin(arg0 context.Context, arg1 *domain.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePinyin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePinyin indicates an expected call of UpdatePinyin.
+func (mr *MockProductRepositoryMockRecorder) UpdatePinyin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePinyin", reflect.TypeOf((*MockProductRepository)(nil).UpdatePinyin), arg0, arg1)
+}
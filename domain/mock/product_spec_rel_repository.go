@@ -9,6 +9,8 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
 	domain "gitlab.jiguang.dev/pos-dine/dine/domain"
 )
 
@@ -48,3 +50,46 @@ func (mr *MockProductSpecRelRepositoryMockRecorder) CreateBulk(arg0, arg1 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBulk", reflect.TypeOf((*MockProductSpecRelRepository)(nil).CreateBulk), arg0, arg1)
 }
+
+// FindByProductAndSpec mocks base method.
+func (m *MockProductSpecRelRepository) FindByProductAndSpec(arg0 context.Context, arg1, arg2 uuid.UUID) (*domain.ProductSpecRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProductAndSpec", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.ProductSpecRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProductAndSpec indicates an expected call of FindByProductAndSpec.
+func (mr *MockProductSpecRelRepositoryMockRecorder) FindByProductAndSpec(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProductAndSpec", reflect.TypeOf((*MockProductSpecRelRepository)(nil).FindByProductAndSpec), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockProductSpecRelRepository) Update(arg0 context.Context, arg1 *domain.ProductSpecRelation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProductSpecRelRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProductSpecRelRepository)(nil).Update), arg0, arg1)
+}
+
+// UpdateBasePrice mocks base method.
+func (m *MockProductSpecRelRepository) UpdateBasePrice(arg0 context.Context, arg1 uuid.UUID, arg2 decimal.Decimal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBasePrice", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBasePrice indicates an expected call of UpdateBasePrice.
+func (mr *MockProductSpecRelRepositoryMockRecorder) UpdateBasePrice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBasePrice", reflect.TypeOf((*MockProductSpecRelRepository)(nil).UpdateBasePrice), arg0, arg1, arg2)
+}
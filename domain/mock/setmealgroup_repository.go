@@ -92,3 +92,18 @@ func (mr *MockSetMealGroupRepositoryMockRecorder) DeleteByProductID(arg0, arg1 i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByProductID", reflect.TypeOf((*MockSetMealGroupRepository)(nil).DeleteByProductID), arg0, arg1)
 }
+
+// ListSetMealIDsByProductID mocks base method.
+func (m *MockSetMealGroupRepository) ListSetMealIDsByProductID(arg0 context.Context, arg1 uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSetMealIDsByProductID", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSetMealIDsByProductID indicates an expected call of ListSetMealIDsByProductID.
+func (mr *MockSetMealGroupRepositoryMockRecorder) ListSetMealIDsByProductID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSetMealIDsByProductID", reflect.TypeOf((*MockSetMealGroupRepository)(nil).ListSetMealIDsByProductID), arg0, arg1)
+}
@@ -125,6 +125,21 @@ func (mr *MockStoreRepositoryMockRecorder) FindStoreMerchant(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStoreMerchant", reflect.TypeOf((*MockStoreRepository)(nil).FindStoreMerchant), arg0, arg1)
 }
 
+// GetDetail mocks base method.
+func (m *MockStoreRepository) GetDetail(arg0 context.Context, arg1 uuid.UUID) (*domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDetail", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDetail indicates an expected call of GetDetail.
+func (mr *MockStoreRepositoryMockRecorder) GetDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetail", reflect.TypeOf((*MockStoreRepository)(nil).GetDetail), arg0, arg1)
+}
+
 // GetStores mocks base method.
 func (m *MockStoreRepository) GetStores(arg0 context.Context, arg1 *upagination.Pagination, arg2 *domain.StoreListFilter, arg3 ...domain.StoreListOrderBy) ([]*domain.Store, int, error) {
 	m.ctrl.T.Helper()
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 const (
@@ -11,6 +13,12 @@ const (
 	mutexKeyPaymentPrefix    = "mutex:payment"
 	mutexKeyDataExportPrefix = "mutex:data_export"
 	mutexKeyCartPrefix       = "mutex:cart"
+	mutexKeyCouponPrefix     = "mutex:coupon"
+
+	mutexKeyDeliveryOrderPrefix = "mutex:delivery_order"
+	mutexKeyReservationPrefix   = "mutex:reservation"
+	mutexKeyWaitlistPrefix      = "mutex:waitlist"
+	mutexKeyPreorderPrefix      = "mutex:preorder"
 )
 
 type MutexOption func(*MutexConfig)
@@ -56,6 +64,29 @@ func NewMutexDataExportKey(id int) string {
 	return fmt.Sprintf("%s:%d", mutexKeyDataExportPrefix, id)
 }
 
-func NewMutexCartKey(tableID int) string {
-	return fmt.Sprintf("%s:%d", mutexKeyCartPrefix, tableID)
+func NewMutexCartKey(tableID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", mutexKeyCartPrefix, tableID)
+}
+
+func NewMutexCouponKey(merchantID uuid.UUID, provider, code string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", mutexKeyCouponPrefix, merchantID, provider, code)
+}
+
+func NewMutexDeliveryOrderKey(id uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", mutexKeyDeliveryOrderPrefix, id)
+}
+
+// NewMutexReservationKey 门店预订锁，分配桌台与入座串行处理
+func NewMutexReservationKey(storeID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", mutexKeyReservationPrefix, storeID)
+}
+
+// NewMutexWaitlistKey 门店排队锁，取号与状态变更串行处理
+func NewMutexWaitlistKey(storeID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", mutexKeyWaitlistPrefix, storeID)
+}
+
+// NewMutexPreorderKey 门店预点单锁，校验时段容量与下单、送厨串行处理
+func NewMutexPreorderKey(storeID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", mutexKeyPreorderPrefix, storeID)
 }
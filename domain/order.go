@@ -29,12 +29,14 @@ func (OrderType) Values() []string {
 type DiningMode string
 
 const (
-	DiningModeDineIn DiningMode = "DINE_IN" // 堂食
+	DiningModeDineIn   DiningMode = "DINE_IN"  // 堂食
+	DiningModeDelivery DiningMode = "DELIVERY" // 外卖配送
 )
 
 func (DiningMode) Values() []string {
 	return []string{
 		string(DiningModeDineIn),
+		string(DiningModeDelivery),
 	}
 }
 
@@ -74,13 +76,27 @@ func (PaymentStatus) Values() []string {
 	}
 }
 
+// DiningWay 对应的就餐方式（用于匹配按就餐方式配置的规则）
+func (m DiningMode) DiningWay() DiningWay {
+	switch m {
+	case DiningModeDineIn:
+		return DiningWayDineIn
+	case DiningModeDelivery:
+		return DiningWayDelivery
+	default:
+		return ""
+	}
+}
+
 // Channel 下单渠道/操作来源
 type Channel string
 
 const (
-	ChannelPOS Channel = "POS" // POS收银
-	ChannelH5  Channel = "H5"  // 扫码点餐H5
-	ChannelApp Channel = "APP" // 移动点餐APP
+	ChannelPOS      Channel = "POS"      // POS收银
+	ChannelH5       Channel = "H5"       // 扫码点餐H5
+	ChannelApp      Channel = "APP"      // 移动点餐APP
+	ChannelKiosk    Channel = "KIOSK"    // 自助点餐机
+	ChannelDelivery Channel = "DELIVERY" // 三方外卖平台
 )
 
 func (Channel) Values() []string {
@@ -88,6 +104,8 @@ func (Channel) Values() []string {
 		string(ChannelPOS),
 		string(ChannelH5),
 		string(ChannelApp),
+		string(ChannelKiosk),
+		string(ChannelDelivery),
 	}
 }
 
@@ -99,11 +117,33 @@ func (c Channel) Label() string {
 		return "扫码点餐H5"
 	case ChannelApp:
 		return "移动点餐APP"
+	case ChannelKiosk:
+		return "自助点餐机"
+	case ChannelDelivery:
+		return "三方外卖"
 	default:
 		return string(c)
 	}
 }
 
+// OrderChannel 对应的订单渠道（用于匹配按订单渠道配置的规则）
+func (c Channel) OrderChannel() OrderChannel {
+	switch c {
+	case ChannelPOS:
+		return OrderChannelPOS
+	case ChannelH5:
+		return OrderChannelScanOrder
+	case ChannelApp:
+		return OrderChannelMobileOrder
+	case ChannelKiosk:
+		return OrderChannelSelfOrder
+	case ChannelDelivery:
+		return OrderChannelThirdDelivery
+	default:
+		return ""
+	}
+}
+
 // FeeType 费用类型
 type FeeType string
 
@@ -119,19 +159,37 @@ func (FeeType) Values() []string {
 	}
 }
 
+// OrderRepository 订单仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/order_repository.go -package=mock . OrderRepository
 type OrderRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*Order, error)
 	Create(ctx context.Context, order *Order) error
 	Update(ctx context.Context, order *Order) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, params OrderListParams) ([]*Order, int, error)
+	// FindOpenByTableID 查询桌台最近一笔已下单且未支付的订单
+	FindOpenByTableID(ctx context.Context, tableID uuid.UUID) (*Order, error)
+	// ListTableTurns 查询门店 since 之后结账的堂食桌台订单，按结账时间倒序，最多 limit 条
+	ListTableTurns(ctx context.Context, storeID uuid.UUID, since time.Time, limit int) ([]*TableTurn, error)
 	SalesReport(ctx context.Context, params OrderSalesReportParams) ([]*OrderSalesReportItem, int, error)
 	ProductSalesSummary(ctx context.Context, params ProductSalesSummaryParams) ([]*ProductSalesSummaryItem, int, error)
 	ProductSalesDetail(ctx context.Context, params ProductSalesDetailParams) ([]*ProductSalesDetailItem, int, error)
 }
 
+// TableTurn 桌台一次翻台：堂食订单从下单到结账
+type TableTurn struct {
+	TableID  uuid.UUID // 桌台ID
+	PlacedAt time.Time // 下单时间
+	PaidAt   time.Time // 结账时间
+}
+
+// OrderCreateHook 下单事务内随订单一起写入的数据，如厨房单
+type OrderCreateHook func(ctx context.Context, ds DataStore, order *Order) error
+
 type OrderInteractor interface {
-	Create(ctx context.Context, order *Order) error
+	// Create 计价、校验点单选择并计算优惠后创建订单，hooks 与订单在同一事务内执行
+	Create(ctx context.Context, order *Order, hooks ...OrderCreateHook) error
 	Get(ctx context.Context, id uuid.UUID) (*Order, error)
 	Update(ctx context.Context, order *Order) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -212,7 +270,8 @@ type OrderPayment struct {
 	POS           OrderPOS             `json:"pos"`            // POS 终端信息
 	Cashier       OrderCashier         `json:"cashier"`        // 收银员信息
 
-	PaidAt time.Time `json:"paid_at,omitempty"` // 支付时间
+	PaidAt      time.Time `json:"paid_at,omitempty"`      // 支付时间
+	RequestedAt time.Time `json:"requested_at,omitempty"` // 发起支付时间（在线支付查询交易结果时使用）
 }
 
 // Order 订单
@@ -227,6 +286,7 @@ type Order struct {
 	BusinessDate string `json:"business_date"` // 营业日
 	ShiftNo      string `json:"shift_no"`      // 班次号
 	OrderNo      string `json:"order_no"`      // 订单号
+	PickupNo     string `json:"pickup_no"`     // 取餐号
 
 	OrderType OrderType `json:"order_type"` // 订单类型
 
@@ -255,6 +315,10 @@ type Order struct {
 	Payments []OrderPayment `json:"payments"`  // 支付记录
 	Amount   OrderAmount    `json:"amount"`    // 金额汇总
 
+	Promotions []OrderPromotion `json:"promotions"` // 享受的促销活动
+
+	Member *OrderMember `json:"member"` // 关联会员
+
 	Remark string `json:"remark"` // 整单备注
 
 	OperationLogs []OrderOperationLog `json:"operation_logs"` // 操作日志
@@ -262,6 +326,43 @@ type Order struct {
 	OrderProducts []OrderProduct `json:"order_products"` // 订单商品明细
 }
 
+// FillFrom 用已保存的订单补全更新请求中未携带的字段，用于更新时计价、校验和支付后的处理
+func (o *Order) FillFrom(current *Order) {
+	o.MerchantID = current.MerchantID
+	o.StoreID = current.StoreID
+	if o.PickupNo == "" {
+		o.PickupNo = current.PickupNo
+	}
+	if o.OrderType == "" {
+		o.OrderType = current.OrderType
+	}
+	if o.PlacedAt.IsZero() {
+		o.PlacedAt = current.PlacedAt
+	}
+	if o.DiningMode == "" {
+		o.DiningMode = current.DiningMode
+	}
+	if o.OrderStatus == "" {
+		o.OrderStatus = current.OrderStatus
+	}
+	if o.PaymentStatus == "" {
+		o.PaymentStatus = current.PaymentStatus
+	}
+	if o.TableID == uuid.Nil {
+		o.TableID = current.TableID
+		o.TableName = current.TableName
+	}
+	if o.Channel == "" {
+		o.Channel = current.Channel
+	}
+	if len(o.Fees) == 0 {
+		o.Fees = current.Fees
+	}
+	if o.Member == nil {
+		o.Member = current.Member
+	}
+}
+
 type OrderListParams struct {
 	MerchantID uuid.UUID
 	StoreID    uuid.UUID
@@ -274,6 +375,8 @@ type OrderListParams struct {
 	OrderStatus   OrderStatus
 	PaymentStatus PaymentStatus
 
+	MemberID uuid.UUID
+
 	Page int
 	Size int
 }
@@ -319,6 +422,7 @@ type ProductSalesSummaryParams struct {
 	CategoryID        uuid.UUID   // 商品分类ID
 	ProductName       string      // 商品名称（模糊搜索）
 	ProductType       ProductType // 商品类型
+	SetMealComponents bool        // 是否按套餐子商品汇总（展开套餐，按实际出品的子商品统计分摊金额）
 
 	Page int
 	Size int
@@ -390,6 +494,7 @@ const (
 	OrderOperationTypePlaceOrder    OrderOperationType = "PLACE_ORDER"    // 点餐（下单）
 	OrderOperationTypeGiftItem      OrderOperationType = "GIFT_ITEM"      // 点餐（赠菜）
 	OrderOperationTypeCoupon        OrderOperationType = "COUPON"         // 结账（优惠券）
+	OrderOperationTypePoints        OrderOperationType = "POINTS"         // 结账（积分）
 	OrderOperationTypeDiscount      OrderOperationType = "DISCOUNT"       // 结账（折扣）
 	OrderOperationTypeCheckout      OrderOperationType = "CHECKOUT"       // 结账（支付）
 	OrderOperationTypeReverseSettle OrderOperationType = "REVERSE_SETTLE" // 反结账
@@ -402,6 +507,7 @@ func (OrderOperationType) Values() []string {
 		string(OrderOperationTypePlaceOrder),
 		string(OrderOperationTypeGiftItem),
 		string(OrderOperationTypeCoupon),
+		string(OrderOperationTypePoints),
 		string(OrderOperationTypeDiscount),
 		string(OrderOperationTypeCheckout),
 		string(OrderOperationTypeReverseSettle),
@@ -414,7 +520,7 @@ func (t OrderOperationType) Label() string {
 	switch t {
 	case OrderOperationTypePlaceOrder, OrderOperationTypeGiftItem:
 		return "点餐"
-	case OrderOperationTypeCoupon, OrderOperationTypeDiscount, OrderOperationTypeCheckout:
+	case OrderOperationTypeCoupon, OrderOperationTypePoints, OrderOperationTypeDiscount, OrderOperationTypeCheckout:
 		return "结账"
 	case OrderOperationTypeReverseSettle:
 		return "反结账"
@@ -0,0 +1,296 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+var (
+	ErrReceiptTokenInvalid        = errors.New("电子小票链接无效或已过期")
+	ErrReceiptOrderNotExists      = errors.New("订单不存在")
+	ErrReceiptRequestNotExists    = errors.New("申请不存在")
+	ErrReceiptRequestExists       = errors.New("已提交过该申请，请等待门店处理")
+	ErrReceiptRequestHandled      = errors.New("申请已处理")
+	ErrReceiptRefundNotAllowed    = errors.New("订单未支付或已全额退款，无法申请退款")
+	ErrReceiptInvoiceNotAllowed   = errors.New("订单未支付，无法申请开票")
+	ErrReceiptInvoiceTitleMissing = errors.New("请填写发票抬头")
+)
+
+// ReceiptConfig 电子小票配置，前台生成链接与顾客端校验链接需使用相同的密钥
+type ReceiptConfig struct {
+	Secret     string
+	BaseURL    string `default:"http://localhost:8080/api/v1"` // 顾客端 API 地址
+	ExpireDays int    `default:"90"`                           // 链接有效天数
+}
+
+// ReceiptLink 电子小票链接
+type ReceiptLink struct {
+	URL       string    `json:"url"`        // 公开访问地址，同时作为纸质小票二维码内容（文本，需由打印机或客户端编码为二维码）
+	Token     string    `json:"token"`      // 签名令牌
+	ExpiresAt time.Time `json:"expires_at"` // 过期时间
+}
+
+// OrderPreparationStatus 订单出品进度
+type OrderPreparationStatus string
+
+const (
+	OrderPreparationStatusAwaitingPayment OrderPreparationStatus = "awaiting_payment" // 待支付后送厨
+	OrderPreparationStatusQueued          OrderPreparationStatus = "queued"           // 已送厨，等待出单
+	OrderPreparationStatusPreparing       OrderPreparationStatus = "preparing"        // 制作中
+	OrderPreparationStatusCompleted       OrderPreparationStatus = "completed"        // 已完成
+	OrderPreparationStatusCancelled       OrderPreparationStatus = "cancelled"        // 已取消
+)
+
+// NewOrderPreparationStatus 根据订单状态与厨房单状态计算出品进度，任一厨房单未出单即视为未全部送厨
+func NewOrderPreparationStatus(order *Order, tickets []*KitchenTicket) OrderPreparationStatus {
+	switch order.OrderStatus {
+	case OrderStatusCompleted:
+		return OrderPreparationStatusCompleted
+	case OrderStatusCancelled:
+		return OrderPreparationStatusCancelled
+	}
+	status := OrderPreparationStatusPreparing
+	for _, ticket := range tickets {
+		switch ticket.Status {
+		case KitchenTicketStatusHeld:
+			return OrderPreparationStatusAwaitingPayment
+		case KitchenTicketStatusPending:
+			status = OrderPreparationStatusQueued
+		}
+	}
+	return status
+}
+
+// OrderReceipt 电子小票，只包含顾客可见的信息
+type OrderReceipt struct {
+	OrderNo       string                 `json:"order_no"`          // 订单号
+	OrderType     OrderType              `json:"order_type"`        // 订单类型
+	BusinessDate  string                 `json:"business_date"`     // 营业日
+	PlacedAt      time.Time              `json:"placed_at"`         // 下单时间
+	PaidAt        time.Time              `json:"paid_at"`           // 支付完成时间
+	Store         OrderStore             `json:"store"`             // 门店信息
+	TableName     string                 `json:"table_name"`        // 桌位名称
+	GuestCount    int                    `json:"guest_count"`       // 用餐人数
+	OrderStatus   OrderStatus            `json:"order_status"`      // 订单状态
+	PaymentStatus PaymentStatus          `json:"payment_status"`    // 支付状态
+	Preparation   OrderPreparationStatus `json:"preparation"`       // 出品进度
+	Items         []OrderReceiptItem     `json:"items"`             // 商品明细
+	TaxRates      []OrderTaxRate         `json:"tax_rates"`         // 税费
+	Fees          []OrderFee             `json:"fees"`              // 费用
+	Payments      []OrderReceiptPayment  `json:"payments"`          // 支付记录
+	Amount        OrderAmount            `json:"amount"`            // 金额汇总
+	Remark        string                 `json:"remark"`            // 整单备注
+	Requests      []*ReceiptRequest      `json:"requests"`          // 退款/开票申请
+	QRCode        string                 `json:"qr_code,omitempty"` // 纸质小票二维码内容，仅打印小票返回，打印机使用原生二维码指令直接打印
+}
+
+// OrderReceiptItem 电子小票商品
+type OrderReceiptItem struct {
+	ProductName     string          `json:"product_name"` // 商品名称
+	ProductNameI18n LocalizedText   `json:"-"`            // 商品名称翻译
+	Qty             int             `json:"qty"`          // 数量
+	Price           decimal.Decimal `json:"price"`        // 单价
+	Subtotal        decimal.Decimal `json:"subtotal"`     // 小计
+	Discount        decimal.Decimal `json:"discount"`     // 优惠金额
+	Total           decimal.Decimal `json:"total"`        // 合计
+	IsGift          bool            `json:"is_gift"`      // 是否赠送
+	VoidQty         int             `json:"void_qty"`     // 退菜数量
+}
+
+// OrderReceiptPayment 电子小票支付记录
+type OrderReceiptPayment struct {
+	PaymentMethod PaymentMethodPayType `json:"payment_method"` // 支付方式
+	PaymentAmount decimal.Decimal      `json:"payment_amount"` // 支付金额
+	RefundAmount  decimal.Decimal      `json:"refund_amount"`  // 退款金额
+	PaidAt        time.Time            `json:"paid_at"`        // 支付时间
+}
+
+// NewOrderReceipt 由订单生成电子小票
+func NewOrderReceipt(order *Order, tickets []*KitchenTicket, requests []*ReceiptRequest) *OrderReceipt {
+	receipt := &OrderReceipt{
+		OrderNo:       order.OrderNo,
+		OrderType:     order.OrderType,
+		BusinessDate:  order.BusinessDate,
+		PlacedAt:      order.PlacedAt,
+		PaidAt:        order.PaidAt,
+		Store:         order.Store,
+		TableName:     order.TableName,
+		GuestCount:    order.GuestCount,
+		OrderStatus:   order.OrderStatus,
+		PaymentStatus: order.PaymentStatus,
+		Preparation:   NewOrderPreparationStatus(order, tickets),
+		TaxRates:      order.TaxRates,
+		Fees:          order.Fees,
+		Amount:        order.Amount,
+		Remark:        order.Remark,
+		Requests:      requests,
+	}
+	for _, op := range order.OrderProducts {
+		receipt.Items = append(receipt.Items, OrderReceiptItem{
+			ProductName:     op.ProductName,
+			ProductNameI18n: op.ProductNameI18n,
+			Qty:             op.Qty,
+			Price:           op.Price,
+			Subtotal:        op.Subtotal,
+			Discount:        op.DiscountAmount,
+			Total:           op.Total,
+			IsGift:          op.IsGift,
+			VoidQty:         op.VoidQty,
+		})
+	}
+	for _, p := range order.Payments {
+		// 支付中或支付失败的在线支付不展示
+		if p.PaymentStatus == PaymentStatusPaying || p.PaymentStatus == PaymentStatusUnpaid {
+			continue
+		}
+		receipt.Payments = append(receipt.Payments, OrderReceiptPayment{
+			PaymentMethod: p.PaymentMethod,
+			PaymentAmount: p.PaymentAmount,
+			RefundAmount:  p.RefundAmount,
+			PaidAt:        p.PaidAt,
+		})
+	}
+	return receipt
+}
+
+// ReceiptRequestType 电子小票申请类型
+type ReceiptRequestType string
+
+const (
+	ReceiptRequestTypeRefund  ReceiptRequestType = "refund"  // 申请退款
+	ReceiptRequestTypeInvoice ReceiptRequestType = "invoice" // 申请开票
+)
+
+func (ReceiptRequestType) Values() []string {
+	return []string{
+		string(ReceiptRequestTypeRefund),
+		string(ReceiptRequestTypeInvoice),
+	}
+}
+
+// ReceiptRequestStatus 申请状态
+type ReceiptRequestStatus string
+
+const (
+	ReceiptRequestStatusPending  ReceiptRequestStatus = "pending"  // 待处理
+	ReceiptRequestStatusAccepted ReceiptRequestStatus = "accepted" // 已受理
+	ReceiptRequestStatusRejected ReceiptRequestStatus = "rejected" // 已拒绝
+)
+
+func (ReceiptRequestStatus) Values() []string {
+	return []string{
+		string(ReceiptRequestStatusPending),
+		string(ReceiptRequestStatusAccepted),
+		string(ReceiptRequestStatusRejected),
+	}
+}
+
+// ReceiptRequest 顾客通过电子小票提交的退款/开票申请，由门店处理
+type ReceiptRequest struct {
+	ID           uuid.UUID            `json:"id"`
+	MerchantID   uuid.UUID            `json:"merchant_id"`    // 品牌商ID
+	StoreID      uuid.UUID            `json:"store_id"`       // 门店ID
+	OrderID      uuid.UUID            `json:"order_id"`       // 订单ID
+	OrderNo      string               `json:"order_no"`       // 订单号
+	Type         ReceiptRequestType   `json:"type"`           // 申请类型
+	Status       ReceiptRequestStatus `json:"status"`         // 状态
+	Reason       string               `json:"reason"`         // 退款原因/开票备注
+	ContactPhone string               `json:"contact_phone"`  // 联系电话
+	InvoiceTitle string               `json:"invoice_title"`  // 发票抬头
+	InvoiceTaxNo string               `json:"invoice_tax_no"` // 纳税人识别号
+	InvoiceEmail string               `json:"invoice_email"`  // 接收发票邮箱
+	HandleRemark string               `json:"handle_remark"`  // 处理说明
+	HandledBy    uuid.UUID            `json:"handled_by"`     // 处理人ID
+	HandledAt    *time.Time           `json:"handled_at"`     // 处理时间
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
+
+// Validate 校验订单是否可提交该申请
+func (r *ReceiptRequest) Validate(order *Order) error {
+	switch r.Type {
+	case ReceiptRequestTypeRefund:
+		if order.OrderType != OrderTypeSale || order.PaymentStatus != PaymentStatusPaid {
+			return ErrReceiptRefundNotAllowed
+		}
+	case ReceiptRequestTypeInvoice:
+		if order.PaymentStatus != PaymentStatusPaid {
+			return ErrReceiptInvoiceNotAllowed
+		}
+		if r.InvoiceTitle == "" {
+			return ErrReceiptInvoiceTitleMissing
+		}
+	}
+	return nil
+}
+
+// Handle 门店处理申请
+func (r *ReceiptRequest) Handle(accepted bool, remark string, handledBy uuid.UUID, at time.Time) error {
+	if r.Status != ReceiptRequestStatusPending {
+		return ErrReceiptRequestHandled
+	}
+	r.Status = ReceiptRequestStatusRejected
+	if accepted {
+		r.Status = ReceiptRequestStatusAccepted
+	}
+	r.HandleRemark = remark
+	r.HandledBy = handledBy
+	r.HandledAt = &at
+	return nil
+}
+
+// ReceiptRequestSearchParams 申请查询参数
+type ReceiptRequestSearchParams struct {
+	StoreID uuid.UUID
+	Type    ReceiptRequestType   // 申请类型（可选）
+	Status  ReceiptRequestStatus // 状态（可选）
+}
+
+// ReceiptRequestSearchRes 申请查询结果
+type ReceiptRequestSearchRes struct {
+	*upagination.Pagination
+	Items []*ReceiptRequest `json:"items"`
+}
+
+// ReceiptRequestRepository 电子小票申请仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/receipt_request_repository.go -package=mock . ReceiptRequestRepository
+type ReceiptRequestRepository interface {
+	Create(ctx context.Context, request *ReceiptRequest) error
+	Update(ctx context.Context, request *ReceiptRequest) error
+	FindByID(ctx context.Context, id uuid.UUID) (*ReceiptRequest, error)
+	// ListByOrderID 查询订单的全部申请，按创建时间升序
+	ListByOrderID(ctx context.Context, orderID uuid.UUID) ([]*ReceiptRequest, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params ReceiptRequestSearchParams) (*ReceiptRequestSearchRes, error)
+}
+
+// OrderReceiptSubmitParams 顾客提交申请参数
+type OrderReceiptSubmitParams struct {
+	Type         ReceiptRequestType
+	Reason       string
+	ContactPhone string
+	InvoiceTitle string
+	InvoiceTaxNo string
+	InvoiceEmail string
+}
+
+// OrderReceiptInteractor 电子小票用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/order_receipt_interactor.go -package=mock . OrderReceiptInteractor
+type OrderReceiptInteractor interface {
+	// CreateLink 生成订单电子小票链接（前台收银后打印在纸质小票上）
+	CreateLink(ctx context.Context, merchantID, orderID uuid.UUID) (*ReceiptLink, error)
+	// GetPrint 查询前台打印的纸质小票内容（含电子小票链接二维码内容），deviceID 不为空时按该打印机的打印语言输出商品名称
+	GetPrint(ctx context.Context, merchantID, orderID, deviceID uuid.UUID) (*OrderReceipt, error)
+	// Get 通过链接令牌查询电子小票（顾客端，无需登录）
+	Get(ctx context.Context, token string) (*OrderReceipt, error)
+	// SubmitRequest 通过链接令牌提交退款/开票申请（顾客端，无需登录）
+	SubmitRequest(ctx context.Context, token string, params OrderReceiptSubmitParams) (*ReceiptRequest, error)
+	PagedListRequests(ctx context.Context, page *upagination.Pagination, params ReceiptRequestSearchParams, user User) (*ReceiptRequestSearchRes, error)
+	HandleRequest(ctx context.Context, id uuid.UUID, accepted bool, remark string, user User) error
+}
@@ -19,13 +19,14 @@ type OrderProduct struct {
 	Index       int       `json:"index"`         // 下单序号（同订单内第几次下单）
 
 	// 商品基础信息
-	ProductID   uuid.UUID   `json:"product_id"`   // 商品ID
-	ProductName string      `json:"product_name"` // 商品名称
-	ProductType ProductType `json:"product_type"` // 商品类型
-	Category    Category    `json:"category"`     // 分类信息
-	ProductUnit ProductUnit `json:"product_unit"` // 商品单位信息
-	MainImage   string      `json:"main_image"`   // 商品主图
-	Description string      `json:"description"`  // 菜品描述
+	ProductID       uuid.UUID     `json:"product_id"`        // 商品ID
+	ProductName     string        `json:"product_name"`      // 商品名称
+	ProductNameI18n LocalizedText `json:"product_name_i18n"` // 商品名称翻译（用于按打印机语言打印小票和厨房单）
+	ProductType     ProductType   `json:"product_type"`      // 商品类型
+	Category        Category      `json:"category"`          // 分类信息
+	ProductUnit     ProductUnit   `json:"product_unit"`      // 商品单位信息
+	MainImage       string        `json:"main_image"`        // 商品主图
+	Description     string        `json:"description"`       // 菜品描述
 
 	// 数量与金额
 	Price           decimal.Decimal `json:"price"`             // 单价
@@ -43,6 +44,9 @@ type OrderProduct struct {
 	// 促销信息
 	PromotionDiscount decimal.Decimal `json:"promotion_discount"` // 促销优惠金额
 
+	// 会员信息
+	MemberDiscount decimal.Decimal `json:"member_discount"` // 会员价优惠金额
+
 	// 做法金额与赠送金额
 	AttrAmount decimal.Decimal `json:"attr_amount"` // 做法金额
 	GiftAmount decimal.Decimal `json:"gift_amount"` // 赠送金额
@@ -66,3 +70,115 @@ type OrderProduct struct {
 	// 口味做法信息
 	AttrRelations ProductAttrRelations `json:"attr_relations"` // 商品口味做法关联信息
 }
+
+// TaxInclusive 商品是否按含税价计价：税额包含在折后小计中，税后金额等于折后小计
+func (op *OrderProduct) TaxInclusive() bool {
+	return !op.Tax.IsZero() && op.AmountAfterTax.Equal(op.Subtotal.Sub(op.DiscountAmount))
+}
+
+// AdjustProductSubtotal 按差额调整第 i 个订单商品的小计，并同步商品优惠、税额、合计及订单商品小计、折扣合计、税费合计和应收
+//
+// 优惠金额不超过调整后的小计；不含税商品按税率计算税额差额，含税商品从税后金额中重新拆分税额。
+// 按比例收取的附加费和舍入金额由 SettleAmount 在全部调整完成后统一重算。
+func (o *Order) AdjustProductSubtotal(i int, delta decimal.Decimal) {
+	if delta.IsZero() {
+		return
+	}
+	op := &o.OrderProducts[i]
+	inclusive := op.TaxInclusive()
+
+	op.Subtotal = op.Subtotal.Add(delta)
+	discountDelta := decimal.Zero
+	if op.DiscountAmount.GreaterThan(op.Subtotal) {
+		discountDelta = op.Subtotal.Sub(op.DiscountAmount)
+		op.DiscountAmount = op.Subtotal
+	}
+	// 折后金额差额
+	netDelta := delta.Sub(discountDelta)
+
+	var taxDelta, dueDelta decimal.Decimal
+	if inclusive {
+		op.AmountAfterTax = op.AmountAfterTax.Add(netDelta)
+		tax := op.AmountAfterTax.Mul(op.TaxRate).Div(op.TaxRate.Add(decimal.NewFromInt(100))).Round(2)
+		taxDelta = tax.Sub(op.Tax)
+		op.Tax = tax
+		op.AmountBeforeTax = op.AmountAfterTax.Sub(tax)
+		op.Total = op.Total.Add(netDelta)
+		dueDelta = netDelta
+	} else {
+		taxDelta = netDelta.Mul(op.TaxRate).Div(decimal.NewFromInt(100)).Round(2)
+		op.AmountBeforeTax = op.AmountBeforeTax.Add(netDelta)
+		op.Tax = op.Tax.Add(taxDelta)
+		op.AmountAfterTax = op.AmountAfterTax.Add(netDelta).Add(taxDelta)
+		op.Total = op.Total.Add(netDelta).Add(taxDelta)
+		dueDelta = netDelta.Add(taxDelta)
+	}
+
+	o.Amount.ItemsSubtotal = o.Amount.ItemsSubtotal.Add(delta)
+	o.Amount.DiscountTotal = o.Amount.DiscountTotal.Add(discountDelta)
+	o.Amount.TaxTotal = o.Amount.TaxTotal.Add(taxDelta)
+	o.Amount.AmountDue = o.Amount.AmountDue.Add(dueDelta)
+}
+
+// SettleAmount 商品金额或优惠调整后，重算按比例收取的附加费和舍入金额，差额同步到服务费合计、其他费用合计和应收
+//
+// before 为调整前的订单金额，fees 为订单附加费配置（键为附加费ID）。固定金额附加费和未找到配置的费用保持不变；
+// 舍入单位和方式按调整前的舍入金额推断，调整前未舍入时不舍入。
+func (o *Order) SettleAmount(before OrderAmount, fees map[uuid.UUID]*AdditionalFee) {
+	due := o.Amount.AmountDue.Sub(o.Amount.RoundingAmount)
+	for i := range o.Fees {
+		fee := &o.Fees[i]
+		config, ok := fees[fee.FeeID]
+		if !ok || config.ChargeMode != AdditionalFeeChargeModePercent {
+			continue
+		}
+		base := o.Amount.ItemsSubtotal
+		if config.DiscountScope == AdditionalFeeDiscountScopeAfter {
+			base = base.Sub(o.Amount.DiscountTotal)
+		}
+		amount := base.Mul(config.FeeValue).Round(2)
+		d := amount.Sub(fee.Amount)
+		if d.IsZero() {
+			continue
+		}
+		fee.Amount = amount
+		if fee.FeeType == FeeTypeService {
+			o.Amount.ServiceFeeTotal = o.Amount.ServiceFeeTotal.Add(d)
+		} else {
+			o.Amount.FeeTotal = o.Amount.FeeTotal.Add(d)
+		}
+		if config.IncludeInReceivable {
+			due = due.Add(d)
+		}
+	}
+
+	o.Amount.RoundingAmount = reround(due, before.AmountDue, before.RoundingAmount)
+	o.Amount.AmountDue = due.Add(o.Amount.RoundingAmount)
+}
+
+// roundingUnits 可推断的舍入单位，从大到小依次为抹零到元、抹零到角、按 0.05 现金舍入
+var roundingUnits = []decimal.Decimal{
+	decimal.NewFromInt(1),
+	decimal.RequireFromString("0.1"),
+	decimal.RequireFromString("0.05"),
+}
+
+// reround 按调整前的应收与舍入金额推断舍入单位和方式，计算 amount 的舍入金额
+//
+// 调整前的应收是该单位的整数倍且舍入金额小于一个单位时采用该单位；调整前按四舍五入得不到原应收时视为向下抹零。
+func reround(amount, beforeDue, beforeRounding decimal.Decimal) decimal.Decimal {
+	if beforeRounding.IsZero() {
+		return decimal.Zero
+	}
+	beforeAmount := beforeDue.Sub(beforeRounding)
+	for _, unit := range roundingUnits {
+		if !beforeDue.Mod(unit).IsZero() || beforeRounding.Abs().GreaterThanOrEqual(unit) {
+			continue
+		}
+		if beforeAmount.Div(unit).Round(0).Mul(unit).Equal(beforeDue) {
+			return amount.Div(unit).Round(0).Mul(unit).Sub(amount)
+		}
+		return amount.Div(unit).Floor().Mul(unit).Sub(amount)
+	}
+	return decimal.Zero
+}
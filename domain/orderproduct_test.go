@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderAdjustProductSubtotal(t *testing.T) {
+	dec := decimal.RequireFromString
+	newOrder := func() *Order {
+		return &Order{
+			OrderProducts: []OrderProduct{
+				{Subtotal: dec("20"), AmountBeforeTax: dec("20"), AmountAfterTax: dec("20"), Total: dec("20")},
+				{Subtotal: dec("10"), AmountBeforeTax: dec("10"), TaxRate: dec("6"), Tax: dec("0.6"), AmountAfterTax: dec("10.6"), Total: dec("10.6")},
+			},
+			Amount: OrderAmount{ItemsSubtotal: dec("30"), TaxTotal: dec("0.6"), AmountDue: dec("30.6")},
+		}
+	}
+
+	t.Run("无税商品", func(t *testing.T) {
+		o := newOrder()
+		o.AdjustProductSubtotal(0, dec("5"))
+		op := o.OrderProducts[0]
+		require.True(t, op.Subtotal.Equal(dec("25")))
+		require.True(t, op.AmountAfterTax.Equal(dec("25")))
+		require.True(t, op.Total.Equal(dec("25")))
+		require.True(t, o.Amount.ItemsSubtotal.Equal(dec("35")))
+		require.True(t, o.Amount.TaxTotal.Equal(dec("0.6")))
+		require.True(t, o.Amount.AmountDue.Equal(dec("35.6")))
+	})
+
+	t.Run("含税商品按税率调整税额", func(t *testing.T) {
+		o := newOrder()
+		o.AdjustProductSubtotal(1, dec("-5"))
+		op := o.OrderProducts[1]
+		require.True(t, op.Subtotal.Equal(dec("5")))
+		require.True(t, op.Tax.Equal(dec("0.3")))
+		require.True(t, op.AmountAfterTax.Equal(dec("5.3")))
+		require.True(t, op.Total.Equal(dec("5.3")))
+		require.True(t, o.Amount.ItemsSubtotal.Equal(dec("25")))
+		require.True(t, o.Amount.TaxTotal.Equal(dec("0.3")))
+		require.True(t, o.Amount.AmountDue.Equal(dec("25.3")))
+	})
+
+	t.Run("含税商品从税后金额拆分税额", func(t *testing.T) {
+		o := &Order{
+			OrderProducts: []OrderProduct{
+				{Subtotal: dec("106"), TaxRate: dec("6"), Tax: dec("6"), AmountBeforeTax: dec("100"), AmountAfterTax: dec("106"), Total: dec("106")},
+			},
+			Amount: OrderAmount{ItemsSubtotal: dec("106"), TaxTotal: dec("6"), AmountDue: dec("106")},
+		}
+		o.AdjustProductSubtotal(0, dec("-53"))
+		op := o.OrderProducts[0]
+		require.True(t, op.Subtotal.Equal(dec("53")))
+		require.True(t, op.Tax.Equal(dec("3")))
+		require.True(t, op.AmountBeforeTax.Equal(dec("50")))
+		require.True(t, op.AmountAfterTax.Equal(dec("53")))
+		require.True(t, o.Amount.TaxTotal.Equal(dec("3")))
+		require.True(t, o.Amount.AmountDue.Equal(dec("53")))
+	})
+
+	t.Run("优惠金额不超过调整后的小计", func(t *testing.T) {
+		o := &Order{
+			OrderProducts: []OrderProduct{
+				{Subtotal: dec("20"), DiscountAmount: dec("8"), AmountBeforeTax: dec("12"), AmountAfterTax: dec("12"), Total: dec("12")},
+			},
+			Amount: OrderAmount{ItemsSubtotal: dec("20"), DiscountTotal: dec("8"), AmountDue: dec("12")},
+		}
+		o.AdjustProductSubtotal(0, dec("-15"))
+		op := o.OrderProducts[0]
+		require.True(t, op.Subtotal.Equal(dec("5")))
+		require.True(t, op.DiscountAmount.Equal(dec("5")))
+		require.True(t, op.Total.Equal(dec("0")))
+		require.True(t, o.Amount.DiscountTotal.Equal(dec("5")))
+		require.True(t, o.Amount.AmountDue.Equal(dec("0")))
+	})
+}
+
+func TestOrderSettleAmount(t *testing.T) {
+	dec := decimal.RequireFromString
+	serviceFee := &AdditionalFee{ID: uuid.New(), ChargeMode: AdditionalFeeChargeModePercent, FeeValue: dec("0.1"), DiscountScope: AdditionalFeeDiscountScopeAfter, IncludeInReceivable: true}
+	packingFee := &AdditionalFee{ID: uuid.New(), ChargeMode: AdditionalFeeChargeModeFixed, FeeValue: dec("2"), IncludeInReceivable: true}
+	fees := map[uuid.UUID]*AdditionalFee{serviceFee.ID: serviceFee, packingFee.ID: packingFee}
+
+	tests := []struct {
+		name           string
+		before         OrderAmount // 调整前：折扣 10，服务费 9，打包费 2
+		itemsSubtotal  string      // 调整后商品小计
+		wantServiceFee string
+		wantRounding   string
+		wantDue        string
+	}{
+		{
+			name:           "未舍入",
+			before:         OrderAmount{ItemsSubtotal: dec("100"), DiscountTotal: dec("10"), AmountDue: dec("101")},
+			itemsSubtotal:  "103.3",
+			wantServiceFee: "9.33",
+			wantRounding:   "0",
+			wantDue:        "104.63",
+		},
+		{
+			name:           "向下抹零到元",
+			before:         OrderAmount{ItemsSubtotal: dec("100.66"), DiscountTotal: dec("10"), RoundingAmount: dec("-0.66"), AmountDue: dec("101")},
+			itemsSubtotal:  "103.3",
+			wantServiceFee: "9.33",
+			wantRounding:   "-0.63",
+			wantDue:        "104",
+		},
+		{
+			name:           "按 0.05 四舍五入",
+			before:         OrderAmount{ItemsSubtotal: dec("100.37"), DiscountTotal: dec("10"), RoundingAmount: dec("-0.02"), AmountDue: dec("101.35")},
+			itemsSubtotal:  "103.3",
+			wantServiceFee: "9.33",
+			wantRounding:   "0.02",
+			wantDue:        "104.65",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Order{
+				Fees: []OrderFee{
+					{FeeID: serviceFee.ID, FeeType: FeeTypeService, Amount: dec("9")},
+					{FeeID: packingFee.ID, FeeType: FeeTypePackaging, Amount: dec("2")},
+				},
+				Amount: tt.before,
+			}
+			// 商品小计调整，应收同步增加差额
+			delta := dec(tt.itemsSubtotal).Sub(tt.before.ItemsSubtotal)
+			o.Amount.ItemsSubtotal = dec(tt.itemsSubtotal)
+			o.Amount.AmountDue = o.Amount.AmountDue.Add(delta)
+
+			o.SettleAmount(tt.before, fees)
+			require.True(t, o.Fees[0].Amount.Equal(dec(tt.wantServiceFee)), o.Fees[0].Amount.String())
+			require.True(t, o.Fees[1].Amount.Equal(dec("2")))
+			require.True(t, o.Amount.RoundingAmount.Equal(dec(tt.wantRounding)), o.Amount.RoundingAmount.String())
+			require.True(t, o.Amount.AmountDue.Equal(dec(tt.wantDue)), o.Amount.AmountDue.String())
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PaymentGatewayStatus 支付网关交易状态
+type PaymentGatewayStatus string
+
+const (
+	PaymentGatewayStatusProcessing PaymentGatewayStatus = "processing" // 处理中（等待顾客确认或网关处理）
+	PaymentGatewayStatusSuccess    PaymentGatewayStatus = "success"    // 支付成功
+	PaymentGatewayStatusFailed     PaymentGatewayStatus = "failed"     // 支付失败
+)
+
+// PaymentGatewayMicropayParams 付款码支付参数
+type PaymentGatewayMicropayParams struct {
+	MerchantNumber string          // 支付商户号
+	PaymentNo      string          // 商户交易号
+	RequestedAt    time.Time       // 发起支付时间
+	Amount         decimal.Decimal // 支付金额
+	Description    string          // 商品描述
+	AuthCode       string          // 顾客付款码
+}
+
+// PaymentGatewayQueryParams 交易查询参数
+type PaymentGatewayQueryParams struct {
+	MerchantNumber string    // 支付商户号
+	PaymentNo      string    // 商户交易号
+	RequestedAt    time.Time // 原交易发起时间
+}
+
+// PaymentGatewayResult 支付网关交易结果
+type PaymentGatewayResult struct {
+	Status  PaymentGatewayStatus // 交易状态
+	TradeNo string               // 网关交易号
+	PaidAt  time.Time            // 支付完成时间
+	Message string               // 失败原因
+}
+
+// PaymentGateway 第三方支付网关
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/payment_gateway.go -package=mock . PaymentGateway
+type PaymentGateway interface {
+	// Micropay 付款码支付：设备扫描顾客出示的付款码发起扣款
+	Micropay(ctx context.Context, params PaymentGatewayMicropayParams) (*PaymentGatewayResult, error)
+	// Query 查询交易结果，付款码支付返回处理中时需轮询
+	Query(ctx context.Context, params PaymentGatewayQueryParams) (*PaymentGatewayResult, error)
+}
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrPeriodPriceDiningPeriodEmpty = errors.New("时段价格必须指定就餐时段")
+	ErrPeriodPriceInvalid           = errors.New("时段价格必须为非负数")
+	ErrPeriodPriceWeekdayInvalid    = errors.New("时段价格适用星期无效")
+	ErrPeriodPriceConflict          = errors.New("同一就餐时段的适用星期重复")
+)
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// PeriodPrice 就餐时段价格，按门店就餐时段和星期覆盖基础价
+type PeriodPrice struct {
+	DiningPeriod string           `json:"dining_period"`          // 就餐时段名称（对应门店 DiningPeriods 的名称）
+	Weekdays     []time.Weekday   `json:"weekdays"`               // 适用的星期几，0=星期日，1=星期一，依此类推；为空表示每天
+	BasePrice    decimal.Decimal  `json:"base_price"`             // 时段基础价
+	MemberPrice  *decimal.Decimal `json:"member_price,omitempty"` // 时段会员价（可选）
+}
+
+// PeriodPrices 就餐时段价格集合
+type PeriodPrices []PeriodPrice
+
+// Equal 比较两组时段价格配置是否相同
+func (ps PeriodPrices) Equal(other PeriodPrices) bool {
+	if len(ps) != len(other) {
+		return false
+	}
+	for i, p := range ps {
+		o := other[i]
+		if p.DiningPeriod != o.DiningPeriod || !slices.Equal(p.Weekdays, o.Weekdays) ||
+			!p.BasePrice.Equal(o.BasePrice) || !decimalPtrEqual(p.MemberPrice, o.MemberPrice) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate 校验时段价格配置
+func (ps PeriodPrices) Validate() error {
+	// 就餐时段 -> 星期 -> 是否已配置，-1 表示每天
+	seen := make(map[string]map[time.Weekday]bool)
+	for _, p := range ps {
+		if p.DiningPeriod == "" {
+			return ErrPeriodPriceDiningPeriodEmpty
+		}
+		if p.BasePrice.IsNegative() || (p.MemberPrice != nil && p.MemberPrice.IsNegative()) {
+			return ErrPeriodPriceInvalid
+		}
+		if seen[p.DiningPeriod] == nil {
+			seen[p.DiningPeriod] = make(map[time.Weekday]bool)
+		}
+		days := seen[p.DiningPeriod]
+		if len(p.Weekdays) == 0 {
+			if len(days) > 0 {
+				return ErrPeriodPriceConflict
+			}
+			days[-1] = true
+			continue
+		}
+		if days[-1] {
+			return ErrPeriodPriceConflict
+		}
+		for _, wd := range p.Weekdays {
+			if wd < time.Sunday || wd > time.Saturday {
+				return ErrPeriodPriceWeekdayInvalid
+			}
+			if days[wd] {
+				return ErrPeriodPriceConflict
+			}
+			days[wd] = true
+		}
+	}
+	return nil
+}
+
+// Match 查找指定时间生效的时段价格，未命中时返回 nil
+func (ps PeriodPrices) Match(periods []DiningPeriod, at time.Time) *PeriodPrice {
+	if len(ps) == 0 {
+		return nil
+	}
+	period := ActiveDiningPeriod(periods, at)
+	if period == nil {
+		return nil
+	}
+
+	// 跨零点时段在次日的部分仍按时段开始当天的星期匹配
+	weekday := at.Weekday()
+	if period.CrossesMidnight() && at.Format(time.TimeOnly) < period.EndTime {
+		weekday = (weekday + 6) % 7
+	}
+
+	// 指定星期的配置优先于每天生效的配置
+	var daily *PeriodPrice
+	for i := range ps {
+		p := &ps[i]
+		if p.DiningPeriod != period.Name {
+			continue
+		}
+		if len(p.Weekdays) == 0 {
+			daily = p
+			continue
+		}
+		for _, wd := range p.Weekdays {
+			if wd == weekday {
+				return p
+			}
+		}
+	}
+	return daily
+}
+
+// ActiveDiningPeriod 返回指定时间所在的就餐时段，时段为左闭右开区间，支持跨零点时段
+func ActiveDiningPeriod(periods []DiningPeriod, at time.Time) *DiningPeriod {
+	clock := at.Format(time.TimeOnly)
+	for i := range periods {
+		if periods[i].Contains(clock) {
+			return &periods[i]
+		}
+	}
+	return nil
+}
+
+// CurrentPrice 当前生效价格
+type CurrentPrice struct {
+	DiningPeriod string           `json:"dining_period"` // 命中的就餐时段名称（未命中时段价为空）
+	BasePrice    *decimal.Decimal `json:"base_price"`    // 当前基础价（为空表示沿用下级价格）
+	MemberPrice  *decimal.Decimal `json:"member_price"`  // 当前会员价
+}
+
+// ResolvePrice 计算指定时间的生效价格：命中时段价时使用时段价，否则使用基础价
+func ResolvePrice(
+	basePrice, memberPrice *decimal.Decimal,
+	periodPrices PeriodPrices,
+	periods []DiningPeriod,
+	at time.Time,
+) *CurrentPrice {
+	if p := periodPrices.Match(periods, at); p != nil {
+		price := p.BasePrice
+		return &CurrentPrice{
+			DiningPeriod: p.DiningPeriod,
+			BasePrice:    &price,
+			MemberPrice:  p.MemberPrice,
+		}
+	}
+	return &CurrentPrice{
+		BasePrice:   basePrice,
+		MemberPrice: memberPrice,
+	}
+}
+
+// ApplyMenuCurrentPrices 为菜单项及其商品规格计算指定时间的生效价格
+//
+// 价格优先级：菜单项时段价 > 菜单项基础价 > 规格时段价 > 规格基础价
+func ApplyMenuCurrentPrices(menus Menus, periods []DiningPeriod, at time.Time) {
+	for _, menu := range menus {
+		for _, item := range menu.Items {
+			item.CurrentPrice = ResolvePrice(item.BasePrice, item.MemberPrice, item.PeriodPrices, periods, at)
+			if item.Product == nil {
+				continue
+			}
+			for _, specRel := range item.Product.SpecRelations {
+				basePrice := specRel.BasePrice
+				specRel.CurrentPrice = ResolvePrice(&basePrice, specRel.MemberPrice, specRel.PeriodPrices, periods, at)
+			}
+		}
+	}
+}
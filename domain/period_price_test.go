@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveDiningPeriod(t *testing.T) {
+	periods := []DiningPeriod{
+		{Name: "午市", StartTime: "11:00:00", EndTime: "14:00:00"},
+		{Name: "宵夜", StartTime: "22:00:00", EndTime: "02:00:00"},
+	}
+
+	tests := []struct {
+		name  string
+		clock string
+		want  string
+	}{
+		{name: "普通时段开始时间", clock: "11:00:00", want: "午市"},
+		{name: "普通时段结束时间不含", clock: "14:00:00", want: ""},
+		{name: "跨零点时段当天", clock: "23:30:00", want: "宵夜"},
+		{name: "跨零点时段零点", clock: "00:00:00", want: "宵夜"},
+		{name: "跨零点时段次日", clock: "01:59:59", want: "宵夜"},
+		{name: "跨零点时段结束时间不含", clock: "02:00:00", want: ""},
+		{name: "跨零点时段开始前", clock: "21:59:59", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, err := time.Parse(time.DateTime, "2026-10-23 "+tt.clock)
+			require.NoError(t, err)
+			period := ActiveDiningPeriod(periods, at)
+			if tt.want == "" {
+				require.Nil(t, period)
+				return
+			}
+			require.NotNil(t, period)
+			require.Equal(t, tt.want, period.Name)
+		})
+	}
+}
+
+func TestPeriodPricesMatch(t *testing.T) {
+	periods := []DiningPeriod{
+		{Name: "宵夜", StartTime: "22:00:00", EndTime: "02:00:00"},
+	}
+	prices := PeriodPrices{
+		{DiningPeriod: "宵夜", BasePrice: decimal.NewFromInt(10)},
+		{DiningPeriod: "宵夜", Weekdays: []time.Weekday{time.Friday}, BasePrice: decimal.NewFromInt(8)},
+	}
+
+	tests := []struct {
+		name string
+		at   string
+		want string // 命中的时段价，为空表示未命中
+	}{
+		{name: "周五晚命中星期价", at: "2026-10-23 23:00:00", want: "8"},
+		{name: "周六凌晨仍属周五时段", at: "2026-10-24 01:00:00", want: "8"},
+		{name: "周六晚使用每天价格", at: "2026-10-24 23:00:00", want: "10"},
+		{name: "周五凌晨属周四时段", at: "2026-10-23 01:00:00", want: "10"},
+		{name: "不在时段内", at: "2026-10-23 12:00:00", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, err := time.Parse(time.DateTime, tt.at)
+			require.NoError(t, err)
+			p := prices.Match(periods, at)
+			if tt.want == "" {
+				require.Nil(t, p)
+				return
+			}
+			require.NotNil(t, p)
+			require.True(t, decimal.RequireFromString(tt.want).Equal(p.BasePrice), "got %s", p.BasePrice)
+		})
+	}
+}
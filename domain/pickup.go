@@ -0,0 +1,233 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrPickupRuleNotExists     = errors.New("取餐号规则不存在")
+	ErrPickupRuleRangeInvalid  = errors.New("取餐号范围无效，起始号需大于0且小于结束号，结束号不超过9999")
+	ErrPickupRulePrefixInvalid = errors.New("取餐号前缀最多4位字母或数字")
+	ErrPickupCallNotExists     = errors.New("取餐号不存在")
+	ErrPickupCallPickedUp      = errors.New("该取餐号已取餐")
+	ErrPickupCallNotReady      = errors.New("该取餐号尚未叫号")
+	ErrPickupOrderInvalid      = errors.New("订单不存在或已取消")
+)
+
+// ------------------------------------------------------------
+// 取餐号规则
+// ------------------------------------------------------------
+
+const (
+	PickupNoMin = 1    // 取餐号最小值
+	PickupNoMax = 9999 // 取餐号最大值
+
+	PickupRecentCallSize = 10 // 叫号屏展示最近已取餐的号码数量
+)
+
+var pickupPrefixRegexp = regexp.MustCompile(`^[A-Za-z0-9]{0,4}$`)
+
+// PickupRule 取餐号规则，品牌商配置默认规则，门店可单独覆盖；号码按门店、营业日从起始号递增，超过结束号后从头循环
+type PickupRule struct {
+	StoreRuleMeta
+	Prefix string `json:"prefix"` // 号码前缀，如 A
+	Start  int    `json:"start"`  // 起始号
+	End    int    `json:"end"`    // 结束号
+}
+
+// DefaultPickupRule 未配置规则时的默认规则：001-999 循环，无前缀
+func DefaultPickupRule(merchantID, storeID uuid.UUID) *PickupRule {
+	return &PickupRule{
+		StoreRuleMeta: StoreRuleMeta{MerchantID: merchantID, StoreID: storeID},
+		Start:         1,
+		End:           999,
+	}
+}
+
+func (r *PickupRule) Validate() error {
+	if r.Start < PickupNoMin || r.End > PickupNoMax || r.Start >= r.End {
+		return ErrPickupRuleRangeInvalid
+	}
+	if !pickupPrefixRegexp.MatchString(r.Prefix) {
+		return ErrPickupRulePrefixInvalid
+	}
+	return nil
+}
+
+// Format 格式化取餐号：前缀 + 按结束号位数补零的号码
+func (r *PickupRule) Format(n int64) string {
+	width := len(strconv.Itoa(r.End))
+	return fmt.Sprintf("%s%0*d", r.Prefix, width, n)
+}
+
+// PickupSequencePrefix 门店营业日取餐号序列前缀
+func PickupSequencePrefix(storeID uuid.UUID, businessDate string) string {
+	return fmt.Sprintf("%s:%s:%s", DailySequencePrefixPickupNo, storeID, businessDate)
+}
+
+// PickupRuleRepository 取餐号规则仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/pickup_rule_repository.go -package=mock . PickupRuleRepository
+type PickupRuleRepository interface {
+	StoreRuleRepository[*PickupRule]
+}
+
+// PickupRuleInteractor 取餐号规则用例接口，品牌后台维护默认规则，门店后台维护门店规则
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/pickup_rule_interactor.go -package=mock . PickupRuleInteractor
+type PickupRuleInteractor interface {
+	// GetRule 查询当前用户所属层级生效的规则，门店未单独配置时返回品牌商默认规则
+	GetRule(ctx context.Context, user User) (*PickupRule, error)
+	SaveRule(ctx context.Context, rule *PickupRule, user User) error
+	// DeleteRule 删除门店规则，恢复使用品牌商默认规则
+	DeleteRule(ctx context.Context, user User) error
+}
+
+// ------------------------------------------------------------
+// 叫号
+// ------------------------------------------------------------
+
+// PickupCallStatus 取餐号状态
+type PickupCallStatus string
+
+const (
+	PickupCallStatusWaiting  PickupCallStatus = "waiting"   // 制作中
+	PickupCallStatusReady    PickupCallStatus = "ready"     // 已叫号，待取餐
+	PickupCallStatusPickedUp PickupCallStatus = "picked_up" // 已取餐
+)
+
+func (PickupCallStatus) Values() []string {
+	return []string{
+		string(PickupCallStatusWaiting),
+		string(PickupCallStatusReady),
+		string(PickupCallStatusPickedUp),
+	}
+}
+
+// PickupCall 取餐号叫号记录，订单分配取餐号时创建，厨房出餐后叫号，前台确认取餐后完成
+type PickupCall struct {
+	ID           uuid.UUID        `json:"id"`
+	MerchantID   uuid.UUID        `json:"merchant_id"`   // 品牌商ID
+	StoreID      uuid.UUID        `json:"store_id"`      // 门店ID
+	OrderID      uuid.UUID        `json:"order_id"`      // 订单ID
+	OrderNo      string           `json:"order_no"`      // 订单号
+	PickupNo     string           `json:"pickup_no"`     // 取餐号
+	BusinessDate string           `json:"business_date"` // 营业日
+	Status       PickupCallStatus `json:"status"`        // 状态
+	CallCount    int              `json:"call_count"`    // 叫号次数
+	ReadyAt      *time.Time       `json:"ready_at"`      // 最近叫号时间
+	PickedUpAt   *time.Time       `json:"picked_up_at"`  // 取餐时间
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+// NewPickupCall 为订单创建待叫号记录
+func NewPickupCall(order *Order) *PickupCall {
+	return &PickupCall{
+		ID:           uuid.New(),
+		MerchantID:   order.MerchantID,
+		StoreID:      order.StoreID,
+		OrderID:      order.ID,
+		OrderNo:      order.OrderNo,
+		PickupNo:     order.PickupNo,
+		BusinessDate: order.BusinessDate,
+		Status:       PickupCallStatusWaiting,
+	}
+}
+
+// Call 叫号，已叫号的可重复叫号
+func (c *PickupCall) Call(now time.Time) error {
+	if c.Status == PickupCallStatusPickedUp {
+		return ErrPickupCallPickedUp
+	}
+	c.Status = PickupCallStatusReady
+	c.CallCount++
+	c.ReadyAt = &now
+	return nil
+}
+
+// PickUp 确认取餐
+func (c *PickupCall) PickUp(now time.Time) error {
+	switch c.Status {
+	case PickupCallStatusPickedUp:
+		return ErrPickupCallPickedUp
+	case PickupCallStatusWaiting:
+		return ErrPickupCallNotReady
+	}
+	c.Status = PickupCallStatusPickedUp
+	c.PickedUpAt = &now
+	return nil
+}
+
+// PickupCallPush 叫号推送内容，叫号屏和电子小票页面据此刷新
+type PickupCallPush struct {
+	ID        uuid.UUID        `json:"id"`         // 叫号记录ID
+	OrderID   uuid.UUID        `json:"order_id"`   // 订单ID
+	PickupNo  string           `json:"pickup_no"`  // 取餐号
+	Status    PickupCallStatus `json:"status"`     // 状态
+	CallCount int              `json:"call_count"` // 叫号次数
+}
+
+func NewPickupCallPush(c *PickupCall) PickupCallPush {
+	return PickupCallPush{
+		ID:        c.ID,
+		OrderID:   c.OrderID,
+		PickupNo:  c.PickupNo,
+		Status:    c.Status,
+		CallCount: c.CallCount,
+	}
+}
+
+// PickupDisplay 叫号屏展示内容
+type PickupDisplay struct {
+	BusinessDate string        `json:"business_date"` // 营业日
+	Waiting      []*PickupCall `json:"waiting"`       // 制作中，按下单顺序
+	Ready        []*PickupCall `json:"ready"`         // 请取餐，最近叫号在前
+	Recent       []*PickupCall `json:"recent"`        // 最近已取餐，最近取餐在前
+}
+
+// PickupCallListParams 叫号记录查询参数
+type PickupCallListParams struct {
+	StoreID      uuid.UUID
+	BusinessDate string
+	Status       PickupCallStatus
+	Limit        int // 为 0 时不限制
+}
+
+// PickupCallRepository 叫号记录仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/pickup_call_repository.go -package=mock . PickupCallRepository
+type PickupCallRepository interface {
+	Create(ctx context.Context, call *PickupCall) error
+	// Update 更新状态、叫号次数及叫号/取餐时间
+	Update(ctx context.Context, call *PickupCall) error
+	FindByID(ctx context.Context, id uuid.UUID) (*PickupCall, error)
+	FindByOrderID(ctx context.Context, orderID uuid.UUID) (*PickupCall, error)
+	// List 按状态查询营业日叫号记录：制作中按创建时间正序，待取餐按叫号时间倒序，已取餐按取餐时间倒序
+	List(ctx context.Context, params PickupCallListParams) ([]*PickupCall, error)
+}
+
+// PickupCallInteractor 叫号用例接口：前台为订单分配取餐号，厨房出餐叫号，前台确认取餐，叫号屏展示
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/pickup_call_interactor.go -package=mock . PickupCallInteractor
+type PickupCallInteractor interface {
+	// Assign 为订单分配取餐号，已分配时返回原记录
+	Assign(ctx context.Context, merchantID, storeID, orderID uuid.UUID) (*PickupCall, error)
+	// Call 厨房出餐叫号，推送到门店叫号屏和订单电子小票
+	Call(ctx context.Context, merchantID, storeID, id uuid.UUID) (*PickupCall, error)
+	// PickUp 前台确认顾客已取餐
+	PickUp(ctx context.Context, merchantID, storeID, id uuid.UUID) (*PickupCall, error)
+	// Display 叫号屏展示内容：制作中、待取餐及最近已取餐的号码
+	Display(ctx context.Context, merchantID, storeID uuid.UUID, businessDate string) (*PickupDisplay, error)
+}
@@ -0,0 +1,328 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrPreorderRuleNotExists       = errors.New("预点单规则不存在")
+	ErrPreorderRuleSlotInvalid     = errors.New("预约时段间隔需为5的倍数，且在5-120分钟之间")
+	ErrPreorderRuleCapacityInvalid = errors.New("每个时段可接单数需在1-1000之间")
+	ErrPreorderRuleLeadInvalid     = errors.New("最少提前下单时间需在0-1440分钟之间，提前送厨时间需在0-240分钟之间")
+	ErrPreorderRuleAdvanceInvalid  = errors.New("可提前预约天数需在0-14天之间")
+	ErrPreorderNotExists           = errors.New("预点单不存在")
+	ErrPreorderDisabled            = errors.New("门店暂未开放预点单")
+	ErrPreorderDeliveryDisabled    = errors.New("门店暂未开放预约配送")
+	ErrPreorderFulfilmentInvalid   = errors.New("取餐方式无效")
+	ErrPreorderStoreClosed         = errors.New("门店已停业")
+	ErrPreorderContactRequired     = errors.New("请填写联系人姓名和手机号")
+	ErrPreorderAddressRequired     = errors.New("请填写配送地址")
+	ErrPreorderCartEmpty           = errors.New("请选择商品")
+	ErrPreorderItemQtyInvalid      = errors.New("商品数量无效")
+	ErrPreorderProductUnavailable  = errors.New("商品暂不可预点")
+	ErrPreorderDateInvalid         = errors.New("预约日期超出可预约范围")
+	ErrPreorderTimeInvalid         = errors.New("预约时间不在门店营业时段内")
+	ErrPreorderTooLate             = errors.New("距预约时间过近，请选择更晚的时段")
+	ErrPreorderSlotFull            = errors.New("该时段已约满，请选择其他时段")
+	ErrPreorderStatusInvalid       = errors.New("预点单当前状态不允许该操作")
+	ErrPreorderOrderPaid           = errors.New("订单已支付，请联系门店处理")
+	ErrPreorderBusy                = errors.New("门店预点单正在处理中，请稍后再试")
+)
+
+// ------------------------------------------------------------
+// 预点单规则
+// ------------------------------------------------------------
+
+// PreorderRule 门店预点单规则：顾客提前下单并预约取餐/送达时间，订单在预约时间前按备餐时长送厨
+type PreorderRule struct {
+	StoreRuleMeta
+	Enabled         bool `json:"enabled"`          // 是否开放预点单
+	DeliveryEnabled bool `json:"delivery_enabled"` // 是否支持预约配送
+	SlotMinutes     int  `json:"slot_minutes"`     // 时段间隔（分钟），从营业开始时间起按间隔生成可预约时间
+	SlotCapacity    int  `json:"slot_capacity"`    // 每个时段可接单数
+	LeadMinutes     int  `json:"lead_minutes"`     // 需至少提前下单的分钟数
+	PrepMinutes     int  `json:"prep_minutes"`     // 提前送厨的分钟数，即预约时间前多久开始制作
+	MaxAdvanceDays  int  `json:"max_advance_days"` // 最多可提前预约的天数，0 表示仅限当天
+}
+
+// DefaultPreorderRule 未配置规则时的默认规则：不开放预点单，每15分钟一个时段，每时段10单，提前30分钟下单、20分钟送厨
+func DefaultPreorderRule(merchantID, storeID uuid.UUID) *PreorderRule {
+	return &PreorderRule{
+		StoreRuleMeta:  StoreRuleMeta{MerchantID: merchantID, StoreID: storeID},
+		SlotMinutes:    15,
+		SlotCapacity:   10,
+		LeadMinutes:    30,
+		PrepMinutes:    20,
+		MaxAdvanceDays: 1,
+	}
+}
+
+func (r *PreorderRule) Validate() error {
+	if r.SlotMinutes < 5 || r.SlotMinutes > 120 || r.SlotMinutes%5 != 0 {
+		return ErrPreorderRuleSlotInvalid
+	}
+	if r.SlotCapacity < 1 || r.SlotCapacity > 1000 {
+		return ErrPreorderRuleCapacityInvalid
+	}
+	if r.LeadMinutes < 0 || r.LeadMinutes > 1440 || r.PrepMinutes < 0 || r.PrepMinutes > 240 {
+		return ErrPreorderRuleLeadInvalid
+	}
+	if r.MaxAdvanceDays < 0 || r.MaxAdvanceDays > 14 {
+		return ErrPreorderRuleAdvanceInvalid
+	}
+	return nil
+}
+
+// SlotTimes 生成指定日期的可预约时间：在营业时间段内从开始时间起按间隔生成，不含结束时间
+func (r *PreorderRule) SlotTimes(store *Store, date time.Time) []time.Time {
+	var res []time.Time
+	for _, h := range BusinessHoursOn(store.BusinessHours, date.Weekday()) {
+		start, ok := clockOn(date, h.StartTime)
+		if !ok {
+			continue
+		}
+		end, ok := clockOn(date, h.EndTime)
+		if !ok {
+			continue
+		}
+		for at := start; at.Before(end); at = at.Add(r.SlotDuration()) {
+			res = append(res, at)
+		}
+	}
+	return res
+}
+
+// SlotDuration 时段间隔
+func (r *PreorderRule) SlotDuration() time.Duration {
+	return time.Duration(r.SlotMinutes) * time.Minute
+}
+
+// Earliest 当前可预约的最早时间
+func (r *PreorderRule) Earliest(now time.Time) time.Time {
+	return now.Add(time.Duration(r.LeadMinutes) * time.Minute)
+}
+
+// ReleaseAt 预约时间对应的送厨时间
+func (r *PreorderRule) ReleaseAt(scheduledAt time.Time) time.Time {
+	return scheduledAt.Add(-time.Duration(r.PrepMinutes) * time.Minute)
+}
+
+// PreorderRuleRepository 预点单规则仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/preorder_rule_repository.go -package=mock . PreorderRuleRepository
+type PreorderRuleRepository interface {
+	StoreRuleRepository[*PreorderRule]
+}
+
+// PreorderRuleInteractor 预点单规则用例接口，由门店后台维护
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/preorder_rule_interactor.go -package=mock . PreorderRuleInteractor
+type PreorderRuleInteractor interface {
+	// GetRule 查询门店预点单规则，未配置时返回默认规则
+	GetRule(ctx context.Context, user User) (*PreorderRule, error)
+	SaveRule(ctx context.Context, rule *PreorderRule, user User) error
+}
+
+// ------------------------------------------------------------
+// 预点单
+// ------------------------------------------------------------
+
+// PreorderFulfilment 预点单取餐方式
+type PreorderFulfilment string
+
+const (
+	PreorderFulfilmentPickup   PreorderFulfilment = "pickup"   // 到店自取
+	PreorderFulfilmentDelivery PreorderFulfilment = "delivery" // 门店配送
+)
+
+func (PreorderFulfilment) Values() []string {
+	return []string{
+		string(PreorderFulfilmentPickup),
+		string(PreorderFulfilmentDelivery),
+	}
+}
+
+// Valid 取餐方式是否有效
+func (f PreorderFulfilment) Valid() bool {
+	return slices.Contains(PreorderFulfilment("").Values(), string(f))
+}
+
+// DiningMode 对应的订单就餐模式
+func (f PreorderFulfilment) DiningMode() DiningMode {
+	if f == PreorderFulfilmentDelivery {
+		return DiningModeDelivery
+	}
+	return DiningModeDineIn
+}
+
+// PreorderStatus 预点单状态
+type PreorderStatus string
+
+const (
+	PreorderStatusScheduled PreorderStatus = "scheduled" // 待送厨：订单已生成，暂不进入厨房队列
+	PreorderStatusReleased  PreorderStatus = "released"  // 已送厨
+	PreorderStatusCancelled PreorderStatus = "cancelled" // 已取消
+)
+
+func (PreorderStatus) Values() []string {
+	return []string{
+		string(PreorderStatusScheduled),
+		string(PreorderStatusReleased),
+		string(PreorderStatusCancelled),
+	}
+}
+
+// Preorder 预点单，记录订单的预约取餐/送达时间，送厨前订单不生成厨房单
+type Preorder struct {
+	ID              uuid.UUID          `json:"id"`
+	MerchantID      uuid.UUID          `json:"merchant_id"`      // 品牌商ID
+	StoreID         uuid.UUID          `json:"store_id"`         // 门店ID
+	CustomerID      uuid.UUID          `json:"customer_id"`      // 顾客ID
+	OrderID         uuid.UUID          `json:"order_id"`         // 订单ID
+	OrderNo         string             `json:"order_no"`         // 订单号
+	Fulfilment      PreorderFulfilment `json:"fulfilment"`       // 取餐方式
+	ScheduledAt     time.Time          `json:"scheduled_at"`     // 预约取餐/送达时间
+	ReleaseAt       time.Time          `json:"release_at"`       // 计划送厨时间
+	ContactName     string             `json:"contact_name"`     // 联系人
+	ContactPhone    string             `json:"contact_phone"`    // 联系电话
+	DeliveryAddress string             `json:"delivery_address"` // 配送地址
+	Status          PreorderStatus     `json:"status"`           // 状态
+	ReleasedAt      *time.Time         `json:"released_at"`      // 实际送厨时间
+	CancelledAt     *time.Time         `json:"cancelled_at"`     // 取消时间
+	CancelReason    string             `json:"cancel_reason"`    // 取消原因
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+
+	Order *Order `json:"order,omitempty"` // 订单详情，查询单个预点单时返回
+}
+
+// Active 待送厨与已送厨的预点单占用时段容量
+func (p *Preorder) Active() bool {
+	return p.Status == PreorderStatusScheduled || p.Status == PreorderStatusReleased
+}
+
+// Release 送厨
+func (p *Preorder) Release(now time.Time) error {
+	if p.Status != PreorderStatusScheduled {
+		return ErrPreorderStatusInvalid
+	}
+	p.Status = PreorderStatusReleased
+	p.ReleasedAt = &now
+	return nil
+}
+
+// Cancel 取消送厨前的预点单
+func (p *Preorder) Cancel(reason string, now time.Time) error {
+	if p.Status != PreorderStatusScheduled {
+		return ErrPreorderStatusInvalid
+	}
+	p.Status = PreorderStatusCancelled
+	p.CancelReason = reason
+	p.CancelledAt = &now
+	return nil
+}
+
+// PreorderSlot 可预约时段
+type PreorderSlot struct {
+	At        time.Time `json:"at"`        // 预约时间
+	Time      string    `json:"time"`      // 预约时间 HH:MM
+	Capacity  int       `json:"capacity"`  // 可接单数
+	Remaining int       `json:"remaining"` // 剩余可接单数
+	Available bool      `json:"available"` // 是否可预约
+}
+
+// PreorderItemParams 预点单商品参数
+type PreorderItemParams struct {
+	ProductID uuid.UUID
+	SpecID    uuid.UUID // 为空时使用默认规格
+	Attrs     []TableCartAttr
+	Groups    SetMealGroups // 套餐商品的分组选择
+	Qty       int
+	Remark    string
+}
+
+// PreorderSubmitParams 预点单下单参数
+type PreorderSubmitParams struct {
+	StoreID         uuid.UUID
+	Fulfilment      PreorderFulfilment
+	ScheduledAt     time.Time
+	ContactName     string
+	ContactPhone    string
+	DeliveryAddress string // 门店配送时必填
+	Items           []PreorderItemParams
+	Remark          string
+}
+
+// PreorderListParams 预点单查询参数
+type PreorderListParams struct {
+	StoreID    uuid.UUID
+	CustomerID uuid.UUID
+	Statuses   []PreorderStatus
+	From       time.Time // 预约时间起，为空不限制
+	To         time.Time // 预约时间止（不含），为空不限制
+}
+
+// CountInSlot 统计预约时间在 [start, end) 内占用容量的预点单数
+func CountInSlot(preorders []*Preorder, start, end time.Time) int {
+	count := 0
+	for _, p := range preorders {
+		if p.Active() && !p.ScheduledAt.Before(start) && p.ScheduledAt.Before(end) {
+			count++
+		}
+	}
+	return count
+}
+
+// PreorderRepository 预点单仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/preorder_repository.go -package=mock . PreorderRepository
+type PreorderRepository interface {
+	Create(ctx context.Context, preorder *Preorder) error
+	// Update 更新状态、送厨及取消信息
+	Update(ctx context.Context, preorder *Preorder) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Preorder, error)
+	// List 按预约时间升序查询
+	List(ctx context.Context, params PreorderListParams) ([]*Preorder, error)
+}
+
+// PreorderScheduler 预点单送厨调度，到达计划送厨时间后调用 PreorderInteractor.ReleaseDue
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/preorder_scheduler.go -package=mock . PreorderScheduler
+type PreorderScheduler interface {
+	ScheduleRelease(ctx context.Context, preorder *Preorder) error
+}
+
+// PreorderInteractor 预点单用例接口：顾客预约下单，门店 POS 查看、提前送厨与取消，定时任务到点送厨
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/preorder_interactor.go -package=mock . PreorderInteractor
+type PreorderInteractor interface {
+	// Slots 查询门店指定日期的可预约时段及剩余容量
+	Slots(ctx context.Context, storeID uuid.UUID, date string) ([]*PreorderSlot, error)
+	// ListMenus 查询门店在预约时间可预点的菜单，at 为空时按当前时间
+	ListMenus(ctx context.Context, storeID uuid.UUID, at time.Time) (Menus, error)
+	// Submit 预约下单：按预约时间计价生成订单，到送厨时间前不进入厨房队列
+	Submit(ctx context.Context, customer *Customer, params PreorderSubmitParams) (*Preorder, error)
+	// ListByCustomer 顾客查询自己今天及以后的预点单
+	ListByCustomer(ctx context.Context, customerID uuid.UUID) ([]*Preorder, error)
+	// GetByCustomer 顾客查询自己的预点单及订单详情
+	GetByCustomer(ctx context.Context, customerID, id uuid.UUID) (*Preorder, error)
+	// CancelByCustomer 顾客在送厨前取消自己的预点单
+	CancelByCustomer(ctx context.Context, customerID, id uuid.UUID) (*Preorder, error)
+
+	// List 查询门店指定日期的预点单
+	List(ctx context.Context, merchantID, storeID uuid.UUID, date string, statuses []PreorderStatus) ([]*Preorder, error)
+	// Release 门店提前送厨
+	Release(ctx context.Context, merchantID, storeID, id uuid.UUID) (*Preorder, error)
+	Cancel(ctx context.Context, merchantID, storeID, id uuid.UUID, reason string) (*Preorder, error)
+	// ReleaseDue 定时任务到点送厨，预点单已送厨、已取消或不存在时跳过
+	ReleaseDue(ctx context.Context, id uuid.UUID) error
+}
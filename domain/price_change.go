@@ -0,0 +1,213 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrPriceChangeBatchNotExists       = errors.New("调价批次不存在")
+	ErrPriceChangeEffectiveAtInvalid   = errors.New("生效时间必须晚于当前时间")
+	ErrPriceChangeItemsEmpty           = errors.New("调价明细不能为空")
+	ErrPriceChangeItemInvalid          = errors.New("调价明细无效")
+	ErrPriceChangeStoreInvalid         = errors.New("门店无效，必须属于当前品牌商")
+	ErrPriceChangeMenuItemNoStore      = errors.New("调整菜单价必须选择门店")
+	ErrPriceChangeBatchCannotCancel    = errors.New("只有待生效的调价批次才能取消")
+	ErrPriceChangeBatchCannotRollback  = errors.New("只有已生效的调价批次才能回滚")
+	ErrPriceChangeProductSpecNotExists = errors.New("商品规格不存在")
+)
+
+// ------------------------------------------------------------
+// 枚举定义
+// ------------------------------------------------------------
+
+// PriceChangeBatchStatus 调价批次状态
+type PriceChangeBatchStatus string
+
+const (
+	PriceChangeBatchStatusPending    PriceChangeBatchStatus = "pending"     // 待生效
+	PriceChangeBatchStatusApplied    PriceChangeBatchStatus = "applied"     // 已生效
+	PriceChangeBatchStatusCancelled  PriceChangeBatchStatus = "cancelled"   // 已取消
+	PriceChangeBatchStatusRolledBack PriceChangeBatchStatus = "rolled_back" // 已回滚
+	PriceChangeBatchStatusFailed     PriceChangeBatchStatus = "failed"      // 执行失败
+)
+
+func (PriceChangeBatchStatus) Values() []string {
+	return []string{
+		string(PriceChangeBatchStatusPending),
+		string(PriceChangeBatchStatusApplied),
+		string(PriceChangeBatchStatusCancelled),
+		string(PriceChangeBatchStatusRolledBack),
+		string(PriceChangeBatchStatusFailed),
+	}
+}
+
+// PriceChangeTargetType 调价对象类型
+type PriceChangeTargetType string
+
+const (
+	PriceChangeTargetTypeProductSpec PriceChangeTargetType = "product_spec" // 商品规格价
+	PriceChangeTargetTypeMenuItem    PriceChangeTargetType = "menu_item"    // 门店菜单价
+)
+
+func (PriceChangeTargetType) Values() []string {
+	return []string{
+		string(PriceChangeTargetTypeProductSpec),
+		string(PriceChangeTargetTypeMenuItem),
+	}
+}
+
+// PriceChangeOperationType 调价批次操作类型
+type PriceChangeOperationType string
+
+const (
+	PriceChangeOperationTypeCreate   PriceChangeOperationType = "create"   // 创建
+	PriceChangeOperationTypeApply    PriceChangeOperationType = "apply"    // 生效
+	PriceChangeOperationTypeCancel   PriceChangeOperationType = "cancel"   // 取消
+	PriceChangeOperationTypeRollback PriceChangeOperationType = "rollback" // 回滚
+	PriceChangeOperationTypeFail     PriceChangeOperationType = "fail"     // 执行失败
+)
+
+// ------------------------------------------------------------
+// 仓储和用例接口
+// ------------------------------------------------------------
+
+// PriceChangeRepository 定时调价仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/price_change_repository.go -package=mock . PriceChangeRepository
+type PriceChangeRepository interface {
+	Create(ctx context.Context, batch *PriceChangeBatch) error
+	FindByID(ctx context.Context, id uuid.UUID) (*PriceChangeBatch, error)
+	FindForUpdate(ctx context.Context, id uuid.UUID) (*PriceChangeBatch, error)
+	Update(ctx context.Context, batch *PriceChangeBatch) error
+	UpdateItem(ctx context.Context, item *PriceChangeItem) error
+	ListDueIDs(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params PriceChangeSearchParams) (*PriceChangeSearchRes, error)
+}
+
+// PriceChangeInteractor 定时调价用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/price_change_interactor.go -package=mock . PriceChangeInteractor
+type PriceChangeInteractor interface {
+	Create(ctx context.Context, batch *PriceChangeBatch, user User) error
+	GetDetail(ctx context.Context, id uuid.UUID, user User) (*PriceChangeBatch, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params PriceChangeSearchParams) (*PriceChangeSearchRes, error)
+	Cancel(ctx context.Context, id uuid.UUID, user User, operator PriceChangeOperator) error
+	Rollback(ctx context.Context, id uuid.UUID, user User, operator PriceChangeOperator) error
+	// ApplyDueBatches 执行已到生效时间的调价批次（定时任务调用）
+	ApplyDueBatches(ctx context.Context) error
+}
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// PriceChangeBatch 定时调价批次
+type PriceChangeBatch struct {
+	ID            uuid.UUID                 `json:"id"`             // 调价批次ID
+	Name          string                    `json:"name"`           // 批次名称
+	MerchantID    uuid.UUID                 `json:"merchant_id"`    // 品牌商ID
+	StoreID       uuid.UUID                 `json:"store_id"`       // 门店ID（品牌商创建时为空）
+	StoreIDs      []uuid.UUID               `json:"store_ids"`      // 菜单价格适用门店ID列表
+	EffectiveAt   time.Time                 `json:"effective_at"`   // 生效时间
+	Status        PriceChangeBatchStatus    `json:"status"`         // 状态
+	Remark        string                    `json:"remark"`         // 备注
+	FailReason    string                    `json:"fail_reason"`    // 执行失败原因
+	AppliedAt     *time.Time                `json:"applied_at"`     // 实际生效时间
+	OperatorID    uuid.UUID                 `json:"operator_id"`    // 创建人ID
+	OperatorName  string                    `json:"operator_name"`  // 创建人名称
+	OperationLogs []PriceChangeOperationLog `json:"operation_logs"` // 操作日志
+	CreatedAt     time.Time                 `json:"created_at"`     // 创建时间
+	UpdatedAt     time.Time                 `json:"updated_at"`     // 更新时间
+
+	// 关联信息
+	Items PriceChangeItems `json:"items,omitempty"` // 调价明细
+}
+
+// AddLog 追加操作日志
+func (b *PriceChangeBatch) AddLog(operationType PriceChangeOperationType, operator PriceChangeOperator, content string) {
+	b.OperationLogs = append(b.OperationLogs, PriceChangeOperationLog{
+		OperatedAt:    time.Now(),
+		OperatorID:    operator.ID,
+		OperatorName:  operator.Name,
+		OperationType: operationType,
+		Content:       content,
+	})
+}
+
+// PriceChangeBatches 调价批次集合
+type PriceChangeBatches []*PriceChangeBatch
+
+// PriceChangeItem 定时调价明细
+type PriceChangeItem struct {
+	ID          uuid.UUID             `json:"id"`           // 明细ID
+	BatchID     uuid.UUID             `json:"batch_id"`     // 调价批次ID
+	TargetType  PriceChangeTargetType `json:"target_type"`  // 调价对象
+	ProductID   uuid.UUID             `json:"product_id"`   // 商品ID
+	SpecID      uuid.UUID             `json:"spec_id"`      // 规格ID（商品规格价）
+	StoreID     uuid.UUID             `json:"store_id"`     // 门店ID（菜单价）
+	ProductName string                `json:"product_name"` // 商品名称快照
+	SpecName    string                `json:"spec_name"`    // 规格名称快照
+	NewPrice    decimal.Decimal       `json:"new_price"`    // 调整后价格
+	OldPrice    *decimal.Decimal      `json:"old_price"`    // 调整前价格（生效时记录）
+	TargetID    uuid.UUID             `json:"target_id"`    // 实际调整的规格关联ID或菜单项ID
+	Applied     bool                  `json:"applied"`      // 是否已生效
+	CreatedAt   time.Time             `json:"created_at"`   // 创建时间
+	UpdatedAt   time.Time             `json:"updated_at"`   // 更新时间
+
+	MenuItemOldPrices []PriceChangeMenuItemOldPrice `json:"menu_item_old_prices,omitempty"` // 菜单价：各菜单项调整前价格（生效时记录）
+}
+
+// PriceChangeMenuItemOldPrice 菜单项调整前价格，门店多个菜单包含同一商品时逐项记录，回滚时逐项恢复
+type PriceChangeMenuItemOldPrice struct {
+	MenuItemID uuid.UUID        `json:"menu_item_id"` // 菜单项ID
+	OldPrice   *decimal.Decimal `json:"old_price"`    // 调整前菜单价，为空表示原先未设置菜单价
+}
+
+// PriceChangeItems 调价明细集合
+type PriceChangeItems []*PriceChangeItem
+
+// PriceChangeOperationLog 调价批次操作日志
+type PriceChangeOperationLog struct {
+	OperatedAt    time.Time                `json:"operated_at"`    // 操作时间
+	OperatorID    uuid.UUID                `json:"operator_id"`    // 操作人ID（系统执行时为空）
+	OperatorName  string                   `json:"operator_name"`  // 操作人名称
+	OperationType PriceChangeOperationType `json:"operation_type"` // 操作类型
+	Content       string                   `json:"content"`        // 操作内容
+}
+
+// PriceChangeOperator 调价操作人
+type PriceChangeOperator struct {
+	ID   uuid.UUID
+	Name string
+}
+
+// PriceChangeSystemOperator 定时任务执行时的操作人
+var PriceChangeSystemOperator = PriceChangeOperator{Name: "系统"}
+
+// ------------------------------------------------------------
+// 参数定义（DTO）
+// ------------------------------------------------------------
+
+// PriceChangeSearchParams 查询参数
+type PriceChangeSearchParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID
+	Name       string                 // 批次名称（模糊匹配）
+	Status     PriceChangeBatchStatus // 状态（可选）
+}
+
+// PriceChangeSearchRes 查询结果
+type PriceChangeSearchRes struct {
+	*upagination.Pagination
+	Items PriceChangeBatches `json:"items"`
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"errors"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -49,6 +50,13 @@ var (
 
 	// 商品下发相关错误
 	ErrProductDistributeStoreInvalid = errors.New("门店无效，必须属于当前品牌商")
+
+	// 商品导入相关错误
+	ErrProductImportFileInvalid  = errors.New("导入文件格式错误，请使用商品导入模板")
+	ErrProductImportEmpty        = errors.New("导入文件没有商品数据")
+	ErrProductImportTooManyRows  = errors.New("单次导入商品数量超过上限")
+	ErrProductImportNameRepeated = errors.New("商品名称在导入文件中重复")
+	ErrProductImportSetMeal      = errors.New("套餐商品不支持导入更新")
 )
 
 // ------------------------------------------------------------
@@ -63,10 +71,12 @@ type ProductRepository interface {
 	GetDetail(ctx context.Context, id uuid.UUID) (*Product, error)
 	Create(ctx context.Context, product *Product) error
 	Update(ctx context.Context, product *Product) error
+	UpdateDietary(ctx context.Context, product *Product) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	Exists(ctx context.Context, params ProductExistsParams) (bool, error)
 	ListByIDs(ctx context.Context, ids []uuid.UUID) (Products, error)
 	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params ProductSearchParams) (*ProductSearchRes, error)
+	ListDetailsBySearch(ctx context.Context, params ProductSearchParams) (Products, error)
 	FindByNameInStore(ctx context.Context, storeID uuid.UUID, name string) (*Product, error)
 	// 统计商品数量
 	CountByCategoryIDs(ctx context.Context, categoryIDs []uuid.UUID) (map[uuid.UUID]int, error)
@@ -74,6 +84,10 @@ type ProductRepository interface {
 	CountBySpecIDs(ctx context.Context, specIDs []uuid.UUID) (map[uuid.UUID]int, error)
 	CountByTagIDs(ctx context.Context, tagIDs []uuid.UUID) (map[uuid.UUID]int, error)
 	CountByAttrItemIDs(ctx context.Context, attrItemIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	// ListMissingPinyin 按ID升序查询ID大于 afterID 且尚未生成名称拼音的商品
+	ListMissingPinyin(ctx context.Context, afterID uuid.UUID, limit int) (Products, error)
+	// UpdatePinyin 按商品当前名称重新生成名称拼音
+	UpdatePinyin(ctx context.Context, product *Product) error
 }
 
 // ProductInteractor 商品用例接口
@@ -90,6 +104,12 @@ type ProductInteractor interface {
 	OnSale(ctx context.Context, id uuid.UUID, user User) error
 	GetDetail(ctx context.Context, id uuid.UUID, user User) (*Product, error)
 	Distribute(ctx context.Context, params ProductDistributeParams, user User) error
+	// Excel 导入导出
+	ImportTemplate(ctx context.Context, user User) (url string, err error)
+	Import(ctx context.Context, reader io.Reader, user User) (*ProductImportResult, error)
+	Export(ctx context.Context, params ProductSearchParams, user User) (url string, err error)
+	// BackfillPinyin 为尚未生成名称拼音的历史商品补全拼音，返回补全的商品数
+	BackfillPinyin(ctx context.Context) (int, error)
 }
 
 // ------------------------------------------------------------
@@ -194,6 +214,10 @@ type Product struct {
 	ShelfLife    int                  `json:"shelf_life"`    // 保质期（单位：天）
 	SupportTypes []ProductSupportType `json:"support_types"` // 支持类型（堂食、外带）
 
+	// 名称拼音（保存时根据商品名称生成，用于助记搜索）
+	Pinyin         string `json:"pinyin"`          // 拼音全拼（小写）
+	PinyinInitials string `json:"pinyin_initials"` // 拼音首字母（小写）
+
 	// 属性关联
 	UnitID uuid.UUID `json:"unit_id"` // 单位ID
 
@@ -217,6 +241,18 @@ type Product struct {
 	DetailImages []string `json:"detail_images"` // 详情图片
 	Description  string   `json:"description"`   // 菜品描述
 
+	// 多语言
+	NameI18n        LocalizedText `json:"name_i18n"`        // 商品名称翻译
+	DescriptionI18n LocalizedText `json:"description_i18n"` // 菜品描述翻译
+
+	// 过敏原与饮食属性（套餐商品根据子商品自动计算）
+	Allergens  []Allergen        `json:"allergens"`           // 过敏原
+	Halal      bool              `json:"halal"`               // 是否清真
+	Vegetarian bool              `json:"vegetarian"`          // 是否素食
+	Vegan      bool              `json:"vegan"`               // 是否纯素
+	SpicyLevel SpicyLevel        `json:"spicy_level"`         // 辣度：0（不辣）、1（微辣）、2（中辣）、3（特辣）
+	Nutrition  *ProductNutrition `json:"nutrition,omitempty"` // 营养成分（可选，每份）
+
 	// 套餐属性（仅套餐商品使用）
 	EstimatedCostPrice *decimal.Decimal `json:"estimated_cost_price,omitempty"` // 预估成本价（可选，单位：分，仅套餐商品使用）
 	DeliveryCostPrice  *decimal.Decimal `json:"delivery_cost_price,omitempty"`  // 外卖成本价（可选，单位：分，仅套餐商品使用）
@@ -228,6 +264,7 @@ type Product struct {
 	// 关联信息
 	SpecRelations ProductSpecRelations `json:"spec_relations,omitempty"` // 商品规格关联列表
 	AttrRelations ProductAttrRelations `json:"attr_relations,omitempty"` // 商品口味做法关联列表
+	AttrLimits    ProductAttrLimits    `json:"attr_limits,omitempty"`    // 口味做法分组点单限制
 	Tags          ProductTags          `json:"tags,omitempty"`           // 商品标签列表
 	Groups        SetMealGroups        `json:"groups,omitempty"`         // 套餐组列表
 	Category      *Category            `json:"category,omitempty"`       // 分类
@@ -253,16 +290,19 @@ type ProductExistsParams struct {
 
 // ProductSearchParams 查询参数
 type ProductSearchParams struct {
-	MerchantID   uuid.UUID         // 品牌商ID（必填）
-	StoreID      uuid.UUID         // 门店ID（可选）
-	OnlyMerchant bool              // 是否只查询品牌商ID（可选）
-	Name         string            // 商品名称（可选，模糊匹配）
-	SaleStatus   ProductSaleStatus // 售卖状态（可选，空字符串表示全部）
-	Type         ProductType       // 商品类型（可选，空字符串表示全部）
-	CategoryID   uuid.UUID         // 分类ID（可选，支持一级分类和二级分类）
-	StallID      uuid.UUID         // 出品部门ID（可选）
-	StartAt      *time.Time        // 创建开始时间（可选）
-	EndAt        *time.Time        // 创建结束时间（可选，最长支持1年跨度）
+	MerchantID   uuid.UUID            // 品牌商ID（必填）
+	IDs          []uuid.UUID          // 商品ID列表（可选）
+	StoreID      uuid.UUID            // 门店ID（可选）
+	OnlyMerchant bool                 // 是否只查询品牌商ID（可选）
+	Name         string               // 商品名称（可选，模糊匹配）
+	Keyword      string               // 助记搜索关键字（可选，匹配助记词、拼音首字母、拼音全拼和名称，结果按匹配程度排序）
+	SaleStatus   ProductSaleStatus    // 售卖状态（可选，空字符串表示全部）
+	Type         ProductType          // 商品类型（可选，空字符串表示全部）
+	CategoryID   uuid.UUID            // 分类ID（可选，支持一级分类和二级分类）
+	StallID      uuid.UUID            // 出品部门ID（可选）
+	StartAt      *time.Time           // 创建开始时间（可选）
+	EndAt        *time.Time           // 创建结束时间（可选，最长支持1年跨度）
+	Dietary      ProductDietaryFilter // 过敏原与饮食属性筛选（可选）
 }
 
 // ProductSearchRes 查询结果
@@ -277,3 +317,19 @@ type ProductDistributeParams struct {
 	MerchantID uuid.UUID   // 品牌商ID
 	StoreIDs   []uuid.UUID // 门店ID列表（必选，多选）
 }
+
+// ProductImportRowError 商品导入行级错误
+type ProductImportRowError struct {
+	Row     int    `json:"row"`     // Excel 行号（表头为第1行）
+	Name    string `json:"name"`    // 商品名称
+	Message string `json:"message"` // 错误信息
+}
+
+// ProductImportResult 商品导入结果
+type ProductImportResult struct {
+	Total   int                      `json:"total"`   // 数据总行数
+	Created int                      `json:"created"` // 新建商品数
+	Updated int                      `json:"updated"` // 更新商品数
+	Failed  int                      `json:"failed"`  // 失败行数
+	Errors  []*ProductImportRowError `json:"errors"`  // 行级错误列表
+}
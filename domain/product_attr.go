@@ -49,7 +49,7 @@ type ProductAttrRepository interface {
 //
 //go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/product_attr_interactor.go -package=mock . ProductAttrInteractor
 type ProductAttrInteractor interface {
-	Create(ctx context.Context, attr *ProductAttr) error
+	Create(ctx context.Context, attr *ProductAttr, user User) error
 	Update(ctx context.Context, attr *ProductAttr, user User) error
 	Delete(ctx context.Context, id uuid.UUID, user User) error
 	DeleteItem(ctx context.Context, id uuid.UUID, user User) error
@@ -81,6 +81,7 @@ func (SaleChannel) Values() []string {
 type ProductAttr struct {
 	ID           uuid.UUID     `json:"id"`            // 口味做法ID
 	Name         string        `json:"name"`          // 口味做法名称
+	NameI18n     LocalizedText `json:"name_i18n"`     // 口味做法名称翻译
 	Channels     []SaleChannel `json:"channels"`      // 售卖渠道列表
 	MerchantID   uuid.UUID     `json:"merchant_id"`   // 品牌商ID
 	StoreID      uuid.UUID     `json:"store_id"`      // 门店ID
@@ -100,6 +101,7 @@ type ProductAttrItem struct {
 	ID           uuid.UUID       `json:"id"`            // 口味做法项ID
 	AttrID       uuid.UUID       `json:"attr_id"`       // 口味做法ID（外键）
 	Name         string          `json:"name"`          // 口味做法项名称
+	NameI18n     LocalizedText   `json:"name_i18n"`     // 口味做法项名称翻译
 	Image        string          `json:"image"`         // 图片URL（可选）
 	BasePrice    decimal.Decimal `json:"base_price"`    // 基础加价（单位：分）
 	ProductCount int             `json:"product_count"` // 关联的商品数量
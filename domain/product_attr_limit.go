@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrProductAttrLimitInvalid          = errors.New("口味做法分组点单限制无效")
+	ErrProductAttrMaxQuantityInvalid    = errors.New("口味做法项最多可选数量必须为正整数")
+	ErrProductAttrSelectionInvalid      = errors.New("口味做法项不属于该商品或重复选择")
+	ErrProductAttrSelectionCountInvalid = errors.New("口味做法分组选择数量不符合点单限制")
+	ErrProductAttrQuantityInvalid       = errors.New("口味做法项数量超出可选范围")
+	ErrProductAttrAmountMismatch        = errors.New("已支付订单的做法金额与口味做法配置不符")
+)
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// ProductAttrLimit 商品口味做法分组点单限制
+type ProductAttrLimit struct {
+	AttrID        uuid.UUID                `json:"attr_id"`        // 口味做法ID
+	SelectionType ProductAttrSelectionType `json:"selection_type"` // 点单限制类型
+	MinSelect     int                      `json:"min_select"`     // 最少选择数量（仅可多选时使用，0 表示可不选）
+	MaxSelect     int                      `json:"max_select"`     // 最多选择数量（仅可多选时使用，0 表示不限）
+}
+
+// Bounds 返回分组的最少/最多选择数量，最多为 0 表示不限
+func (l *ProductAttrLimit) Bounds() (minSelect, maxSelect int) {
+	if l.SelectionType == ProductAttrSelectionTypeRequiredOne {
+		return 1, 1
+	}
+	return l.MinSelect, l.MaxSelect
+}
+
+// ProductAttrLimits 商品口味做法分组点单限制集合
+type ProductAttrLimits []*ProductAttrLimit
+
+// Validate 校验点单限制配置，分组必须为商品已关联的口味做法且不能重复
+func (ls ProductAttrLimits) Validate(relations ProductAttrRelations) error {
+	attrIDs := make(map[uuid.UUID]bool, len(relations))
+	for _, rel := range relations {
+		if rel.MaxQuantity < 0 {
+			return ErrProductAttrMaxQuantityInvalid
+		}
+		attrIDs[rel.AttrID] = true
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ls))
+	for _, l := range ls {
+		if l == nil || !attrIDs[l.AttrID] || seen[l.AttrID] {
+			return ErrProductAttrLimitInvalid
+		}
+		seen[l.AttrID] = true
+
+		switch l.SelectionType {
+		case ProductAttrSelectionTypeRequiredOne:
+		case ProductAttrSelectionTypeMultiple:
+			if l.MinSelect < 0 || l.MaxSelect < 0 || (l.MaxSelect > 0 && l.MinSelect > l.MaxSelect) {
+				return ErrProductAttrLimitInvalid
+			}
+		default:
+			return ErrProductAttrLimitInvalid
+		}
+	}
+	return nil
+}
+
+// ------------------------------------------------------------
+// 下单校验
+// ------------------------------------------------------------
+
+// ValidateAttrSelection 以商品当前口味做法配置校验下单选择，并返回单份商品的做法金额
+//
+// 选择数量按口味做法项数量累计；未配置点单限制的分组不限制选择数量。
+// 校验通过后，选择项的口味做法与做法项信息会以商品配置覆盖（含加价）。
+func (p *Product) ValidateAttrSelection(selected ProductAttrRelations) (decimal.Decimal, error) {
+	configs := make(map[uuid.UUID]*ProductAttrRelation, len(p.AttrRelations))
+	for _, rel := range p.AttrRelations {
+		configs[rel.AttrItemID] = rel
+	}
+
+	amount := decimal.Zero
+	counts := make(map[uuid.UUID]int)
+	seen := make(map[uuid.UUID]bool, len(selected))
+	for _, sel := range selected {
+		if sel == nil {
+			return decimal.Zero, ErrProductAttrSelectionInvalid
+		}
+		config, ok := configs[sel.AttrItemID]
+		if !ok || seen[sel.AttrItemID] {
+			return decimal.Zero, ErrProductAttrSelectionInvalid
+		}
+		seen[sel.AttrItemID] = true
+
+		if sel.Quantity == 0 {
+			sel.Quantity = 1
+		}
+		if sel.Quantity < 0 || sel.Quantity > max(config.MaxQuantity, 1) {
+			return decimal.Zero, ErrProductAttrQuantityInvalid
+		}
+		counts[config.AttrID] += sel.Quantity
+
+		sel.AttrID = config.AttrID
+		sel.MaxQuantity = config.MaxQuantity
+		sel.Attr = config.Attr
+		sel.AttrItem = config.AttrItem
+		if config.AttrItem != nil {
+			amount = amount.Add(config.AttrItem.BasePrice.Mul(decimal.NewFromInt(int64(sel.Quantity))))
+		}
+	}
+
+	for _, l := range p.AttrLimits {
+		minSelect, maxSelect := l.Bounds()
+		count := counts[l.AttrID]
+		if count < minSelect || (maxSelect > 0 && count > maxSelect) {
+			return decimal.Zero, ErrProductAttrSelectionCountInvalid
+		}
+	}
+	return amount, nil
+}
@@ -14,13 +14,15 @@ type ProductAttrRelRepository interface {
 
 // ProductAttrRelation 商品口味做法关联实体
 type ProductAttrRelation struct {
-	ID         uuid.UUID `json:"id"`           // 关联ID
-	ProductID  uuid.UUID `json:"product_id"`   // 商品ID
-	AttrID     uuid.UUID `json:"attr_id"`      // 口味做法ID
-	AttrItemID uuid.UUID `json:"attr_item_id"` // 口味做法项ID
-	IsDefault  bool      `json:"is_default"`   // 是否默认项
-	CreatedAt  time.Time `json:"created_at"`   // 创建时间
-	UpdatedAt  time.Time `json:"updated_at"`   // 更新时间
+	ID          uuid.UUID `json:"id"`                 // 关联ID
+	ProductID   uuid.UUID `json:"product_id"`         // 商品ID
+	AttrID      uuid.UUID `json:"attr_id"`            // 口味做法ID
+	AttrItemID  uuid.UUID `json:"attr_item_id"`       // 口味做法项ID
+	IsDefault   bool      `json:"is_default"`         // 是否默认项
+	MaxQuantity int       `json:"max_quantity"`       // 单项最多可选数量（默认 1）
+	Quantity    int       `json:"quantity,omitempty"` // 下单数量（仅订单商品的口味做法快照使用）
+	CreatedAt   time.Time `json:"created_at"`         // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`         // 更新时间
 
 	// 关联信息
 	Attr     *ProductAttr     `json:"attr"`      // 口味做法
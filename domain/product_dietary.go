@@ -0,0 +1,229 @@
+package domain
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// 枚举定义
+// ------------------------------------------------------------
+
+// Allergen 过敏原
+type Allergen string
+
+const (
+	AllergenGluten     Allergen = "gluten"     // 含麸质谷物
+	AllergenCrustacean Allergen = "crustacean" // 甲壳类（虾、蟹等）
+	AllergenMollusc    Allergen = "mollusc"    // 软体动物（贝类、鱿鱼等）
+	AllergenFish       Allergen = "fish"       // 鱼类
+	AllergenEgg        Allergen = "egg"        // 蛋类
+	AllergenMilk       Allergen = "milk"       // 乳制品
+	AllergenPeanut     Allergen = "peanut"     // 花生
+	AllergenTreeNut    Allergen = "tree_nut"   // 坚果
+	AllergenSoy        Allergen = "soy"        // 大豆
+	AllergenSesame     Allergen = "sesame"     // 芝麻
+	AllergenCelery     Allergen = "celery"     // 芹菜
+	AllergenMustard    Allergen = "mustard"    // 芥末
+	AllergenSulphite   Allergen = "sulphite"   // 亚硫酸盐
+	AllergenLupin      Allergen = "lupin"      // 羽扇豆
+)
+
+func (Allergen) Values() []string {
+	return []string{
+		string(AllergenGluten),
+		string(AllergenCrustacean),
+		string(AllergenMollusc),
+		string(AllergenFish),
+		string(AllergenEgg),
+		string(AllergenMilk),
+		string(AllergenPeanut),
+		string(AllergenTreeNut),
+		string(AllergenSoy),
+		string(AllergenSesame),
+		string(AllergenCelery),
+		string(AllergenMustard),
+		string(AllergenSulphite),
+		string(AllergenLupin),
+	}
+}
+
+// SpicyLevel 辣度
+type SpicyLevel int
+
+const (
+	SpicyLevelNone   SpicyLevel = 0 // 不辣
+	SpicyLevelMild   SpicyLevel = 1 // 微辣
+	SpicyLevelMedium SpicyLevel = 2 // 中辣
+	SpicyLevelHot    SpicyLevel = 3 // 特辣
+)
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// ProductNutrition 营养成分（每份），未填写的项为空
+type ProductNutrition struct {
+	Energy       *decimal.Decimal `json:"energy,omitempty"`       // 能量（千卡）
+	Protein      *decimal.Decimal `json:"protein,omitempty"`      // 蛋白质（克）
+	Fat          *decimal.Decimal `json:"fat,omitempty"`          // 脂肪（克）
+	Carbohydrate *decimal.Decimal `json:"carbohydrate,omitempty"` // 碳水化合物（克）
+	Sugar        *decimal.Decimal `json:"sugar,omitempty"`        // 糖（克）
+	Sodium       *decimal.Decimal `json:"sodium,omitempty"`       // 钠（毫克）
+}
+
+// ProductDietaryFilter 过敏原与饮食属性筛选条件
+type ProductDietaryFilter struct {
+	ExcludeAllergens []Allergen  // 排除含有任一过敏原的商品（可选）
+	Halal            bool        // 仅清真商品（可选）
+	Vegetarian       bool        // 仅素食商品（可选）
+	Vegan            bool        // 仅纯素商品（可选）
+	MaxSpicyLevel    *SpicyLevel // 最高辣度（可选）
+}
+
+// IsEmpty 是否未设置任何筛选条件
+func (f ProductDietaryFilter) IsEmpty() bool {
+	return len(f.ExcludeAllergens) == 0 && !f.Halal && !f.Vegetarian && !f.Vegan && f.MaxSpicyLevel == nil
+}
+
+// Match 商品是否满足筛选条件
+func (f ProductDietaryFilter) Match(p *Product) bool {
+	if p == nil {
+		return false
+	}
+	for _, a := range f.ExcludeAllergens {
+		if slices.Contains(p.Allergens, a) {
+			return false
+		}
+	}
+	if (f.Halal && !p.Halal) || (f.Vegetarian && !p.Vegetarian) || (f.Vegan && !p.Vegan) {
+		return false
+	}
+	return f.MaxSpicyLevel == nil || p.SpicyLevel <= *f.MaxSpicyLevel
+}
+
+// ------------------------------------------------------------
+// 套餐继承
+// ------------------------------------------------------------
+
+// InheritDietary 根据套餐组中的子商品计算套餐的过敏原与饮食属性
+//
+// 过敏原取所有可能出品的子商品（含备选商品）的并集；清真、素食、纯素需全部子商品满足；辣度取最高值；
+// 营养成分按默认搭配（固定分组全部商品、可选套餐组的默认商品）乘以数量累加，任一子商品未填写的项不计算。
+// components 为子商品集合，缺失的子商品按未填写任何属性处理。
+func (p *Product) InheritDietary(components map[uuid.UUID]*Product) {
+	var (
+		allergens  = make([]Allergen, 0)
+		halal      = true
+		vegetarian = true
+		vegan      = true
+		spicy      = SpicyLevelNone
+		seen       = make(map[uuid.UUID]bool)
+	)
+	visit := func(id uuid.UUID) {
+		if seen[id] {
+			return
+		}
+		seen[id] = true
+		c := components[id]
+		if c == nil {
+			halal, vegetarian, vegan = false, false, false
+			return
+		}
+		for _, a := range c.Allergens {
+			if !slices.Contains(allergens, a) {
+				allergens = append(allergens, a)
+			}
+		}
+		halal = halal && c.Halal
+		vegetarian = vegetarian && c.Vegetarian
+		vegan = vegan && c.Vegan
+		spicy = max(spicy, c.SpicyLevel)
+	}
+
+	var (
+		parts          []nutritionPart
+		nutritionKnown = true
+	)
+	for _, group := range p.Groups {
+		for _, detail := range group.Details {
+			visit(detail.ProductID)
+			for _, id := range detail.OptionalProductIDs {
+				visit(id)
+			}
+			if group.SelectionType == SetMealGroupSelectionTypeOptional && !detail.IsDefault {
+				continue
+			}
+			c := components[detail.ProductID]
+			if c == nil || c.Nutrition == nil {
+				nutritionKnown = false
+				continue
+			}
+			parts = append(parts, nutritionPart{nutrition: c.Nutrition, quantity: detail.Quantity})
+		}
+	}
+
+	if len(seen) == 0 {
+		halal, vegetarian, vegan = false, false, false
+	}
+	slices.Sort(allergens)
+	p.Allergens = allergens
+	p.Halal = halal
+	p.Vegetarian = vegetarian
+	p.Vegan = vegan
+	p.SpicyLevel = spicy
+	p.Nutrition = nil
+	if nutritionKnown {
+		p.Nutrition = sumNutrition(parts)
+	}
+}
+
+// ComponentIDs 返回套餐组中所有可能出品的子商品ID（含备选商品）
+func (groups SetMealGroups) ComponentIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0)
+	for _, group := range groups {
+		for _, detail := range group.Details {
+			ids = append(ids, detail.ProductID)
+			ids = append(ids, detail.OptionalProductIDs...)
+		}
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	return slices.Compact(ids)
+}
+
+type nutritionPart struct {
+	nutrition *ProductNutrition
+	quantity  int
+}
+
+// sumNutrition 按数量累加各部分的营养成分，任一部分未填写的项不计算，全部项均未计算时返回 nil
+func sumNutrition(parts []nutritionPart) *ProductNutrition {
+	if len(parts) == 0 {
+		return nil
+	}
+	sum := func(value func(*ProductNutrition) *decimal.Decimal) *decimal.Decimal {
+		total := decimal.Zero
+		for _, part := range parts {
+			v := value(part.nutrition)
+			if v == nil {
+				return nil
+			}
+			total = total.Add(v.Mul(decimal.NewFromInt(int64(part.quantity))))
+		}
+		return &total
+	}
+	n := &ProductNutrition{
+		Energy:       sum(func(n *ProductNutrition) *decimal.Decimal { return n.Energy }),
+		Protein:      sum(func(n *ProductNutrition) *decimal.Decimal { return n.Protein }),
+		Fat:          sum(func(n *ProductNutrition) *decimal.Decimal { return n.Fat }),
+		Carbohydrate: sum(func(n *ProductNutrition) *decimal.Decimal { return n.Carbohydrate }),
+		Sugar:        sum(func(n *ProductNutrition) *decimal.Decimal { return n.Sugar }),
+		Sodium:       sum(func(n *ProductNutrition) *decimal.Decimal { return n.Sodium }),
+	}
+	if n.Energy == nil && n.Protein == nil && n.Fat == nil && n.Carbohydrate == nil && n.Sugar == nil && n.Sodium == nil {
+		return nil
+	}
+	return n
+}
@@ -11,6 +11,10 @@ import (
 //go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/product_spec_rel_repository.go -package=mock . ProductSpecRelRepository
 type ProductSpecRelRepository interface {
 	CreateBulk(ctx context.Context, relations ProductSpecRelations) error
+	FindByProductAndSpec(ctx context.Context, productID, specID uuid.UUID) (*ProductSpecRelation, error)
+	UpdateBasePrice(ctx context.Context, id uuid.UUID, basePrice decimal.Decimal) error
+	// Update 更新规格关联的价格、打包费及条码信息（用于恢复历史版本）
+	Update(ctx context.Context, relation *ProductSpecRelation) error
 }
 
 // ProductSpecRelation 商品-规格关联实体
@@ -27,12 +31,14 @@ type ProductSpecRelation struct {
 	OtherPrice3        *decimal.Decimal `json:"other_price3"`         // 其他价格3（单位：分，可选）
 	Barcode            string           `json:"barcode"`              // 条形码
 	IsDefault          bool             `json:"is_default"`           // 是否默认项
+	PeriodPrices       PeriodPrices     `json:"period_prices"`        // 就餐时段价格（可选）
 	CreatedAt          time.Time        `json:"created_at"`           // 创建时间
 	UpdatedAt          time.Time        `json:"updated_at"`           // 更新时间
 
 	// 关联信息
-	SpecName   string         `json:"spec_name"`             // 规格名称
-	PackingFee *AdditionalFee `json:"packing_fee,omitempty"` // 打包费
+	SpecName     string         `json:"spec_name"`               // 规格名称
+	PackingFee   *AdditionalFee `json:"packing_fee,omitempty"`   // 打包费
+	CurrentPrice *CurrentPrice  `json:"current_price,omitempty"` // 当前生效价格（仅门店菜单查询返回）
 }
 
 type ProductSpecRelations []*ProductSpecRelation
@@ -0,0 +1,263 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrProductVersionNotExists        = errors.New("商品历史版本不存在")
+	ErrProductVersionSubjectMismatch  = errors.New("只能对比同一对象的历史版本")
+	ErrProductVersionCannotRestore    = errors.New("删除记录不能恢复")
+	ErrProductVersionSubjectNotExists = errors.New("历史版本对应的对象已不存在，无法恢复")
+)
+
+// ------------------------------------------------------------
+// 枚举定义
+// ------------------------------------------------------------
+
+// ProductVersionSubjectType 历史版本对象类型
+type ProductVersionSubjectType string
+
+const (
+	ProductVersionSubjectTypeProduct     ProductVersionSubjectType = "product"      // 商品
+	ProductVersionSubjectTypeProductSpec ProductVersionSubjectType = "product_spec" // 商品规格
+	ProductVersionSubjectTypeAttrItem    ProductVersionSubjectType = "attr_item"    // 口味做法项
+	ProductVersionSubjectTypeMenuItem    ProductVersionSubjectType = "menu_item"    // 菜单项
+)
+
+func (ProductVersionSubjectType) Values() []string {
+	return []string{
+		string(ProductVersionSubjectTypeProduct),
+		string(ProductVersionSubjectTypeProductSpec),
+		string(ProductVersionSubjectTypeAttrItem),
+		string(ProductVersionSubjectTypeMenuItem),
+	}
+}
+
+// ProductVersionAction 历史版本操作类型
+type ProductVersionAction string
+
+const (
+	ProductVersionActionCreate  ProductVersionAction = "create"  // 创建
+	ProductVersionActionUpdate  ProductVersionAction = "update"  // 修改
+	ProductVersionActionDelete  ProductVersionAction = "delete"  // 删除
+	ProductVersionActionRestore ProductVersionAction = "restore" // 恢复历史版本
+)
+
+func (ProductVersionAction) Values() []string {
+	return []string{
+		string(ProductVersionActionCreate),
+		string(ProductVersionActionUpdate),
+		string(ProductVersionActionDelete),
+		string(ProductVersionActionRestore),
+	}
+}
+
+// ------------------------------------------------------------
+// 仓储和用例接口
+// ------------------------------------------------------------
+
+// ProductVersionRepository 商品历史版本仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/product_version_repository.go -package=mock . ProductVersionRepository
+type ProductVersionRepository interface {
+	Create(ctx context.Context, version *ProductVersion) error
+	FindByID(ctx context.Context, id uuid.UUID) (*ProductVersion, error)
+	// FindLatest 查询对象在指定时间及之前的最新版本，at 为空时查询当前最新版本
+	FindLatest(ctx context.Context, key ProductVersionKey, at *time.Time) (*ProductVersion, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params ProductVersionSearchParams) (*ProductVersionSearchRes, error)
+}
+
+// ProductVersionInteractor 商品历史版本用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/product_version_interactor.go -package=mock . ProductVersionInteractor
+type ProductVersionInteractor interface {
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params ProductVersionSearchParams) (*ProductVersionSearchRes, error)
+	GetDetail(ctx context.Context, id uuid.UUID, user User) (*ProductVersion, error)
+	// GetSnapshotAt 查询对象在指定时间点的版本
+	GetSnapshotAt(ctx context.Context, key ProductVersionKey, at time.Time, user User) (*ProductVersion, error)
+	Diff(ctx context.Context, fromID, toID uuid.UUID, user User) (*ProductVersionDiff, error)
+	Restore(ctx context.Context, id uuid.UUID, user User) error
+}
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// ProductVersionKey 历史版本对象标识
+//
+// 商品：ProductID = SubjectID = 商品ID；商品规格：ProductID 为商品ID，SubjectID 为规格ID；
+// 口味做法项：ProductID 为空，SubjectID 为口味做法项ID；菜单项：ProductID 为商品ID，SubjectID 为菜单ID。
+type ProductVersionKey struct {
+	SubjectType ProductVersionSubjectType `json:"subject_type"` // 对象类型
+	ProductID   uuid.UUID                 `json:"product_id"`   // 关联商品ID
+	SubjectID   uuid.UUID                 `json:"subject_id"`   // 对象ID
+}
+
+// ProductVersion 商品历史版本
+type ProductVersion struct {
+	ID uuid.UUID `json:"id"` // 版本ID
+	ProductVersionKey
+	MerchantID   uuid.UUID             `json:"merchant_id"`   // 品牌商ID
+	StoreID      uuid.UUID             `json:"store_id"`      // 门店ID
+	Version      int                   `json:"version"`       // 版本号（同一对象内递增）
+	Action       ProductVersionAction  `json:"action"`        // 操作类型
+	Name         string                `json:"name"`          // 对象名称快照（如商品名称、规格名称）
+	Snapshot     json.RawMessage       `json:"snapshot"`      // 对象完整快照
+	Changes      ProductVersionChanges `json:"changes"`       // 相比上一版本变更的字段
+	RestoredFrom int                   `json:"restored_from"` // 恢复来源版本号（仅恢复操作）
+	OperatorID   uuid.UUID             `json:"operator_id"`   // 操作人ID（系统执行时为空）
+	OperatorType UserType              `json:"operator_type"` // 操作人类型
+	OperatorName string                `json:"operator_name"` // 操作人名称
+	CreatedAt    time.Time             `json:"created_at"`    // 操作时间
+}
+
+// ProductVersions 历史版本集合
+type ProductVersions []*ProductVersion
+
+// ProductVersionChange 字段变更
+type ProductVersionChange struct {
+	Field string          `json:"field"` // 字段名（快照 JSON 字段）
+	Old   json.RawMessage `json:"old"`   // 变更前的值
+	New   json.RawMessage `json:"new"`   // 变更后的值
+}
+
+// ProductVersionChanges 字段变更集合
+type ProductVersionChanges []ProductVersionChange
+
+// ProductVersionDiff 两个版本的对比结果
+type ProductVersionDiff struct {
+	From    *ProductVersion       `json:"from"`    // 对比起始版本
+	To      *ProductVersion       `json:"to"`      // 对比目标版本
+	Changes ProductVersionChanges `json:"changes"` // 变更字段
+}
+
+// ProductVersionOperator 历史版本操作人
+type ProductVersionOperator struct {
+	ID   uuid.UUID
+	Type UserType
+	Name string
+}
+
+// ProductVersionSystemOperator 定时任务等系统操作的操作人
+var ProductVersionSystemOperator = ProductVersionOperator{Name: "系统"}
+
+// productVersionVolatileFields 对比时忽略的字段：时间戳及统计、展示用的派生值
+var productVersionVolatileFields = map[string]bool{
+	"created_at":    true,
+	"updated_at":    true,
+	"product_count": true,
+	"current_price": true,
+}
+
+// DiffProductSnapshots 对比两个快照的顶层字段，返回按字段名排序的变更列表
+func DiffProductSnapshots(from, to json.RawMessage) (ProductVersionChanges, error) {
+	fromFields, err := normalizeProductSnapshot(from)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := normalizeProductSnapshot(to)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]bool, len(fromFields)+len(toFields))
+	for k := range fromFields {
+		fields[k] = true
+	}
+	for k := range toFields {
+		fields[k] = true
+	}
+
+	changes := make(ProductVersionChanges, 0)
+	for field := range fields {
+		oldValue, newValue := fromFields[field], toFields[field]
+		if bytes.Equal(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, ProductVersionChange{Field: field, Old: oldValue, New: newValue})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes, nil
+}
+
+// normalizeProductSnapshot 解析快照顶层字段，并以忽略易变字段后的规范化 JSON 表示各字段值
+func normalizeProductSnapshot(snapshot json.RawMessage) (map[string]json.RawMessage, error) {
+	fields := make(map[string]json.RawMessage)
+	if len(snapshot) == 0 {
+		return fields, nil
+	}
+
+	var top map[string]any
+	if err := json.Unmarshal(snapshot, &top); err != nil {
+		return nil, err
+	}
+	for k, v := range top {
+		if productVersionVolatileFields[k] {
+			continue
+		}
+		b, err := json.Marshal(stripProductSnapshotValue(v))
+		if err != nil {
+			return nil, err
+		}
+		fields[k] = b
+	}
+	return fields, nil
+}
+
+func stripProductSnapshotValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			// 嵌套对象的 id 在重建关联时会重新生成，不作为变更依据
+			if k == "id" || productVersionVolatileFields[k] {
+				continue
+			}
+			out[k] = stripProductSnapshotValue(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = stripProductSnapshotValue(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// ------------------------------------------------------------
+// 参数定义（DTO）
+// ------------------------------------------------------------
+
+// ProductVersionSearchParams 查询参数
+type ProductVersionSearchParams struct {
+	MerchantID  uuid.UUID
+	StoreID     uuid.UUID
+	SubjectType ProductVersionSubjectType // 对象类型（可选）
+	ProductID   uuid.UUID                 // 关联商品ID（可选）
+	SubjectID   uuid.UUID                 // 对象ID（可选）
+	Action      ProductVersionAction      // 操作类型（可选）
+	StartAt     *time.Time                // 操作时间开始（可选）
+	EndAt       *time.Time                // 操作时间结束（可选）
+}
+
+// ProductVersionSearchRes 查询结果
+type ProductVersionSearchRes struct {
+	*upagination.Pagination
+	Items ProductVersions `json:"items"`
+}
@@ -0,0 +1,282 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrPromotionNotExists         = errors.New("促销活动不存在")
+	ErrPromotionNameExists        = errors.New("促销活动名称已存在")
+	ErrPromotionTimeRangeInvalid  = errors.New("活动结束时间必须晚于开始时间")
+	ErrPromotionTimeWindowInvalid = errors.New("活动时段无效，格式为 HH:MM 且结束时间必须晚于开始时间")
+	ErrPromotionDiscountInvalid   = errors.New("优惠设置无效，折扣比例须在 0-100 之间，减免金额须大于 0")
+	ErrPromotionTargetRequired    = errors.New("请选择参与活动的商品或分类")
+	ErrPromotionBuyGetInvalid     = errors.New("买赠数量必须大于 0")
+	ErrPromotionThresholdInvalid  = errors.New("满减门槛不能小于 0")
+	ErrPromotionStoreInvalid      = errors.New("门店无效，必须属于当前品牌商")
+)
+
+// ------------------------------------------------------------
+// 枚举定义
+// ------------------------------------------------------------
+
+// PromotionType 促销类型
+type PromotionType string
+
+const (
+	PromotionTypeOrderDiscount      PromotionType = "order_discount"       // 满减/满折（订单或指定商品满门槛后减免）
+	PromotionTypeItemDiscount       PromotionType = "item_discount"        // 单品/分类折扣
+	PromotionTypeBuyXGetY           PromotionType = "buy_x_get_y"          // 买X送Y（赠送最低价的Y份）
+	PromotionTypeSecondItemDiscount PromotionType = "second_item_discount" // 第二份折扣（如第二份半价）
+)
+
+func (PromotionType) Values() []string {
+	return []string{
+		string(PromotionTypeOrderDiscount),
+		string(PromotionTypeItemDiscount),
+		string(PromotionTypeBuyXGetY),
+		string(PromotionTypeSecondItemDiscount),
+	}
+}
+
+// PromotionDiscountType 优惠方式
+type PromotionDiscountType string
+
+const (
+	PromotionDiscountTypePercent PromotionDiscountType = "percent" // 按比例减免（20 表示减免 20%）
+	PromotionDiscountTypeAmount  PromotionDiscountType = "amount"  // 减免固定金额
+)
+
+func (PromotionDiscountType) Values() []string {
+	return []string{
+		string(PromotionDiscountTypePercent),
+		string(PromotionDiscountTypeAmount),
+	}
+}
+
+// ------------------------------------------------------------
+// 实体定义
+// ------------------------------------------------------------
+
+// Promotion 促销活动
+type Promotion struct {
+	ID          uuid.UUID             `json:"id"`           // 活动ID
+	MerchantID  uuid.UUID             `json:"merchant_id"`  // 品牌商ID
+	StoreID     uuid.UUID             `json:"store_id"`     // 门店ID（门店创建的活动仅本门店适用）
+	Name        string                `json:"name"`         // 活动名称
+	Type        PromotionType         `json:"type"`         // 促销类型
+	Rule        PromotionRule         `json:"rule"`         // 优惠规则
+	StoreIDs    []uuid.UUID           `json:"store_ids"`    // 适用门店（品牌活动，为空表示全部门店）
+	Channels    []OrderChannel        `json:"channels"`     // 适用订单渠道（为空表示全部渠道）
+	DiningWays  []DiningWay           `json:"dining_ways"`  // 适用就餐方式（为空表示全部）
+	StartAt     *time.Time            `json:"start_at"`     // 活动开始时间（可选）
+	EndAt       *time.Time            `json:"end_at"`       // 活动结束时间（可选）
+	TimeWindows []PromotionTimeWindow `json:"time_windows"` // 活动时段（为空表示全天）
+	Priority    int                   `json:"priority"`     // 优先级，值越大越先计算
+	Stackable   bool                  `json:"stackable"`    // 是否可与其他可叠加活动同时享受
+	Enabled     bool                  `json:"enabled"`      // 是否启用
+	CreatedAt   time.Time             `json:"created_at"`   // 创建时间
+	UpdatedAt   time.Time             `json:"updated_at"`   // 更新时间
+}
+
+// Promotions 促销活动集合
+type Promotions []*Promotion
+
+// PromotionRule 优惠规则
+//
+//   - order_discount：参与商品（为空表示整单）金额满 Threshold 后按 DiscountType/DiscountValue 减免
+//   - item_discount：参与商品按 DiscountType/DiscountValue 减免（减免金额按每份计算）
+//   - buy_x_get_y：参与商品每买 BuyQty 份赠送 GetQty 份，赠送价格最低的商品
+//   - second_item_discount：同一参与商品每第二份减免 DiscountValue%
+type PromotionRule struct {
+	Threshold     decimal.Decimal       `json:"threshold"`      // 满减门槛
+	DiscountType  PromotionDiscountType `json:"discount_type"`  // 优惠方式
+	DiscountValue decimal.Decimal       `json:"discount_value"` // 优惠值（比例或金额）
+	ProductIDs    []uuid.UUID           `json:"product_ids"`    // 参与商品
+	CategoryIDs   []uuid.UUID           `json:"category_ids"`   // 参与分类（含子分类）
+	BuyQty        int                   `json:"buy_qty"`        // 买X
+	GetQty        int                   `json:"get_qty"`        // 送Y
+}
+
+// PromotionTimeWindow 活动时段
+type PromotionTimeWindow struct {
+	Weekdays  []int  `json:"weekdays"`   // 适用星期（0 表示周日，为空表示每天）
+	StartTime string `json:"start_time"` // 开始时间（HH:MM）
+	EndTime   string `json:"end_time"`   // 结束时间（HH:MM，不含）
+}
+
+// OrderPromotion 订单享受的促销活动
+type OrderPromotion struct {
+	PromotionID    uuid.UUID       `json:"promotion_id"`    // 活动ID
+	Name           string          `json:"name"`            // 活动名称
+	Type           PromotionType   `json:"type"`            // 促销类型
+	DiscountAmount decimal.Decimal `json:"discount_amount"` // 优惠金额
+}
+
+// Validate 校验活动设置
+func (p *Promotion) Validate() error {
+	if p.StartAt != nil && p.EndAt != nil && !p.EndAt.After(*p.StartAt) {
+		return ErrPromotionTimeRangeInvalid
+	}
+	for _, w := range p.TimeWindows {
+		if err := w.validate(); err != nil {
+			return err
+		}
+	}
+	return p.Rule.validate(p.Type)
+}
+
+func (r PromotionRule) validate(t PromotionType) error {
+	hasTarget := len(r.ProductIDs) > 0 || len(r.CategoryIDs) > 0
+	switch t {
+	case PromotionTypeOrderDiscount:
+		if r.Threshold.IsNegative() {
+			return ErrPromotionThresholdInvalid
+		}
+		return r.validateDiscount()
+	case PromotionTypeItemDiscount:
+		if !hasTarget {
+			return ErrPromotionTargetRequired
+		}
+		return r.validateDiscount()
+	case PromotionTypeBuyXGetY:
+		if !hasTarget {
+			return ErrPromotionTargetRequired
+		}
+		if r.BuyQty <= 0 || r.GetQty <= 0 {
+			return ErrPromotionBuyGetInvalid
+		}
+		return nil
+	case PromotionTypeSecondItemDiscount:
+		if !hasTarget {
+			return ErrPromotionTargetRequired
+		}
+		if r.DiscountType != PromotionDiscountTypePercent {
+			return ErrPromotionDiscountInvalid
+		}
+		return r.validateDiscount()
+	default:
+		return fmt.Errorf("不支持的促销类型：%s", t)
+	}
+}
+
+func (r PromotionRule) validateDiscount() error {
+	switch r.DiscountType {
+	case PromotionDiscountTypePercent:
+		if !r.DiscountValue.IsPositive() || r.DiscountValue.GreaterThan(decimal.NewFromInt(100)) {
+			return ErrPromotionDiscountInvalid
+		}
+	case PromotionDiscountTypeAmount:
+		if !r.DiscountValue.IsPositive() {
+			return ErrPromotionDiscountInvalid
+		}
+	default:
+		return ErrPromotionDiscountInvalid
+	}
+	return nil
+}
+
+func (w PromotionTimeWindow) validate() error {
+	start, ok1 := parseClock(w.StartTime)
+	end, ok2 := parseClock(w.EndTime)
+	if !ok1 || !ok2 || end <= start {
+		return ErrPromotionTimeWindowInvalid
+	}
+	for _, d := range w.Weekdays {
+		if d < 0 || d > 6 {
+			return ErrPromotionTimeWindowInvalid
+		}
+	}
+	return nil
+}
+
+// contains 时间是否落在时段内
+func (w PromotionTimeWindow) contains(at time.Time) bool {
+	if len(w.Weekdays) > 0 && !slices.Contains(w.Weekdays, int(at.Weekday())) {
+		return false
+	}
+	start, _ := parseClock(w.StartTime)
+	end, _ := parseClock(w.EndTime)
+	minute := at.Hour()*60 + at.Minute()
+	return minute >= start && minute < end
+}
+
+// parseClock 解析 HH:MM，返回当天的分钟数
+func parseClock(s string) (int, bool) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// ------------------------------------------------------------
+// 仓储和用例接口
+// ------------------------------------------------------------
+
+// PromotionRepository 促销活动仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/promotion_repository.go -package=mock . PromotionRepository
+type PromotionRepository interface {
+	Create(ctx context.Context, promotion *Promotion) error
+	Update(ctx context.Context, promotion *Promotion) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Promotion, error)
+	Exists(ctx context.Context, params PromotionExistsParams) (bool, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params PromotionSearchParams) (*PromotionSearchRes, error)
+	// ListAvailable 查询门店在指定时间可能生效的已启用活动（品牌活动及本门店活动，渠道、时段等条件由调用方判断）
+	ListAvailable(ctx context.Context, merchantID, storeID uuid.UUID, at time.Time) (Promotions, error)
+}
+
+// PromotionInteractor 促销活动用例接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/promotion_interactor.go -package=mock . PromotionInteractor
+type PromotionInteractor interface {
+	Create(ctx context.Context, promotion *Promotion, user User) error
+	Update(ctx context.Context, promotion *Promotion, user User) error
+	Delete(ctx context.Context, id uuid.UUID, user User) error
+	GetDetail(ctx context.Context, id uuid.UUID, user User) (*Promotion, error)
+	PagedListBySearch(ctx context.Context, page *upagination.Pagination, params PromotionSearchParams) (*PromotionSearchRes, error)
+	SetEnabled(ctx context.Context, id uuid.UUID, enabled bool, user User) error
+	// Calculate 计算订单可享受的促销优惠（不保存订单）
+	Calculate(ctx context.Context, order *Order) error
+}
+
+// ------------------------------------------------------------
+// 参数定义（DTO）
+// ------------------------------------------------------------
+
+// PromotionExistsParams 存在性检查参数
+type PromotionExistsParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID
+	Name       string
+	ExcludeID  uuid.UUID // 排除的ID（用于更新时检查名称唯一性）
+}
+
+// PromotionSearchParams 查询参数
+type PromotionSearchParams struct {
+	MerchantID uuid.UUID
+	StoreID    uuid.UUID
+	Name       string        // 活动名称（模糊匹配）
+	Type       PromotionType // 促销类型（可选）
+	Enabled    *bool         // 是否启用（可选）
+}
+
+// PromotionSearchRes 查询结果
+type PromotionSearchRes struct {
+	*upagination.Pagination
+	Items Promotions `json:"items"`
+}
@@ -0,0 +1,314 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PromotionContext 促销计算上下文
+type PromotionContext struct {
+	StoreID   uuid.UUID
+	Channel   OrderChannel
+	DiningWay DiningWay
+	At        time.Time
+}
+
+// PromotionLine 参与促销计算的订单商品行
+type PromotionLine struct {
+	ProductID   uuid.UUID
+	CategoryIDs []uuid.UUID     // 商品分类及其父分类
+	Qty         int             // 数量
+	Amount      decimal.Decimal // 可优惠金额
+	Discount    decimal.Decimal // 已分摊的促销优惠
+}
+
+func (l *PromotionLine) remaining() decimal.Decimal {
+	return l.Amount.Sub(l.Discount)
+}
+
+// Available 活动在当前上下文是否可用（不含商品门槛判断）
+func (p *Promotion) Available(pctx PromotionContext) bool {
+	if !p.Enabled {
+		return false
+	}
+	if p.StartAt != nil && pctx.At.Before(*p.StartAt) {
+		return false
+	}
+	if p.EndAt != nil && pctx.At.After(*p.EndAt) {
+		return false
+	}
+	if p.StoreID != uuid.Nil {
+		if p.StoreID != pctx.StoreID {
+			return false
+		}
+	} else if len(p.StoreIDs) > 0 && !slices.Contains(p.StoreIDs, pctx.StoreID) {
+		return false
+	}
+	if len(p.Channels) > 0 && !slices.Contains(p.Channels, pctx.Channel) {
+		return false
+	}
+	if len(p.DiningWays) > 0 && !slices.Contains(p.DiningWays, pctx.DiningWay) {
+		return false
+	}
+	if len(p.TimeWindows) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(p.TimeWindows, func(w PromotionTimeWindow) bool {
+		return w.contains(pctx.At)
+	})
+}
+
+// Calculate 按优先级计算促销优惠，并将优惠分摊到商品行（累加到 PromotionLine.Discount）
+//
+// 叠加规则：按优先级从高到低依次计算，第一个产生优惠的活动若不可叠加则只享受该活动；
+// 若可叠加，则继续叠加其他可叠加的活动，不可叠加的活动不再参与。
+// 每个活动都基于商品行扣除已享受优惠后的剩余金额计算，优惠不会超过商品金额。
+func (ps Promotions) Calculate(pctx PromotionContext, lines []*PromotionLine) []OrderPromotion {
+	sorted := slices.Clone(ps)
+	slices.SortStableFunc(sorted, func(a, b *Promotion) int {
+		if c := cmp.Compare(b.Priority, a.Priority); c != 0 {
+			return c
+		}
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	applied := make([]OrderPromotion, 0)
+	for _, p := range sorted {
+		if len(applied) > 0 && !p.Stackable {
+			continue
+		}
+		if !p.Available(pctx) {
+			continue
+		}
+
+		discounts := p.calculate(lines)
+		total := decimal.Zero
+		for i, d := range discounts {
+			d = decimal.Min(d, lines[i].remaining())
+			if !d.IsPositive() {
+				continue
+			}
+			lines[i].Discount = lines[i].Discount.Add(d)
+			total = total.Add(d)
+		}
+		if !total.IsPositive() {
+			continue
+		}
+		applied = append(applied, OrderPromotion{
+			PromotionID:    p.ID,
+			Name:           p.Name,
+			Type:           p.Type,
+			DiscountAmount: total,
+		})
+		if !p.Stackable {
+			break
+		}
+	}
+	return applied
+}
+
+// calculate 计算活动在各商品行上的优惠金额（key 为商品行下标）
+func (p *Promotion) calculate(lines []*PromotionLine) map[int]decimal.Decimal {
+	targets := make([]int, 0)
+	for i, line := range lines {
+		if line.Qty > 0 && line.remaining().IsPositive() && p.Rule.matches(line) {
+			targets = append(targets, i)
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	switch p.Type {
+	case PromotionTypeOrderDiscount:
+		return p.Rule.orderDiscount(lines, targets)
+	case PromotionTypeItemDiscount:
+		return p.Rule.itemDiscount(lines, targets)
+	case PromotionTypeBuyXGetY:
+		return p.Rule.buyXGetY(lines, targets)
+	case PromotionTypeSecondItemDiscount:
+		return p.Rule.secondItemDiscount(lines, targets)
+	}
+	return nil
+}
+
+// matches 商品行是否参与活动（未指定商品和分类时全部参与）
+func (r PromotionRule) matches(line *PromotionLine) bool {
+	if len(r.ProductIDs) == 0 && len(r.CategoryIDs) == 0 {
+		return true
+	}
+	if slices.Contains(r.ProductIDs, line.ProductID) {
+		return true
+	}
+	return slices.ContainsFunc(line.CategoryIDs, func(id uuid.UUID) bool {
+		return id != uuid.Nil && slices.Contains(r.CategoryIDs, id)
+	})
+}
+
+func (r PromotionRule) percentOf(amount decimal.Decimal) decimal.Decimal {
+	return amount.Mul(r.DiscountValue).Div(decimal.NewFromInt(100)).Round(2)
+}
+
+// orderDiscount 满减/满折：参与商品金额满门槛后减免，按剩余金额比例分摊到商品行
+func (r PromotionRule) orderDiscount(lines []*PromotionLine, targets []int) map[int]decimal.Decimal {
+	base := decimal.Zero
+	for _, i := range targets {
+		base = base.Add(lines[i].remaining())
+	}
+	if base.LessThan(r.Threshold) {
+		return nil
+	}
+
+	var discount decimal.Decimal
+	if r.DiscountType == PromotionDiscountTypePercent {
+		discount = r.percentOf(base)
+	} else {
+		discount = decimal.Min(r.DiscountValue, base)
+	}
+	return allocateDiscount(lines, targets, base, discount)
+}
+
+// itemDiscount 单品/分类折扣：按比例或每份减免固定金额
+func (r PromotionRule) itemDiscount(lines []*PromotionLine, targets []int) map[int]decimal.Decimal {
+	res := make(map[int]decimal.Decimal, len(targets))
+	for _, i := range targets {
+		line := lines[i]
+		if r.DiscountType == PromotionDiscountTypePercent {
+			res[i] = r.percentOf(line.remaining())
+		} else {
+			res[i] = r.DiscountValue.Mul(decimal.NewFromInt(int64(line.Qty)))
+		}
+	}
+	return res
+}
+
+// buyXGetY 买X送Y：参与商品按单价从高到低排列，每 X+Y 份中价格最低的 Y 份免单
+func (r PromotionRule) buyXGetY(lines []*PromotionLine, targets []int) map[int]decimal.Decimal {
+	units := expandUnits(lines, targets)
+	groups := len(units) / (r.BuyQty + r.GetQty)
+	free := groups * r.GetQty
+	if free == 0 {
+		return nil
+	}
+
+	res := make(map[int]decimal.Decimal)
+	for _, u := range units[len(units)-free:] {
+		res[u.line] = res[u.line].Add(u.price)
+	}
+	return res
+}
+
+// secondItemDiscount 第二份折扣：同一商品按单价从高到低两两配对，每对中价格较低的一份按比例减免
+func (r PromotionRule) secondItemDiscount(lines []*PromotionLine, targets []int) map[int]decimal.Decimal {
+	byProduct := make(map[uuid.UUID][]int)
+	order := make([]uuid.UUID, 0)
+	for _, i := range targets {
+		id := lines[i].ProductID
+		if _, ok := byProduct[id]; !ok {
+			order = append(order, id)
+		}
+		byProduct[id] = append(byProduct[id], i)
+	}
+
+	res := make(map[int]decimal.Decimal)
+	for _, id := range order {
+		units := expandUnits(lines, byProduct[id])
+		for k := 1; k < len(units); k += 2 {
+			u := units[k]
+			res[u.line] = res[u.line].Add(r.percentOf(u.price))
+		}
+	}
+	return res
+}
+
+type promotionUnit struct {
+	line  int
+	price decimal.Decimal
+}
+
+// expandUnits 将商品行按份展开并按单价从高到低排序（单价按剩余金额平均计算）
+func expandUnits(lines []*PromotionLine, targets []int) []promotionUnit {
+	units := make([]promotionUnit, 0)
+	for _, i := range targets {
+		line := lines[i]
+		qty := decimal.NewFromInt(int64(line.Qty))
+		price := line.remaining().Div(qty).RoundDown(2)
+		for range line.Qty {
+			units = append(units, promotionUnit{line: i, price: price})
+		}
+	}
+	slices.SortStableFunc(units, func(a, b promotionUnit) int {
+		return b.price.Cmp(a.price)
+	})
+	return units
+}
+
+// allocateDiscount 按商品行剩余金额比例分摊优惠，尾差计入最后一行
+func allocateDiscount(lines []*PromotionLine, targets []int, base, discount decimal.Decimal) map[int]decimal.Decimal {
+	res := make(map[int]decimal.Decimal, len(targets))
+	if !base.IsPositive() || !discount.IsPositive() {
+		return res
+	}
+	remaining := discount
+	for k, i := range targets {
+		if k == len(targets)-1 {
+			res[i] = remaining
+			break
+		}
+		share := discount.Mul(lines[i].remaining()).Div(base).Round(2)
+		res[i] = share
+		remaining = remaining.Sub(share)
+	}
+	return res
+}
+
+// ApplyPromotions 计算订单促销优惠并写回订单商品的促销优惠金额
+//
+// 订单中原有的促销优惠会被重新计算的结果替换，差额同步调整商品行优惠金额、订单折扣合计和应收金额。
+// 赠送的商品不参与促销。
+func (o *Order) ApplyPromotions(promotions Promotions, at time.Time) {
+	pctx := PromotionContext{
+		StoreID:   o.StoreID,
+		Channel:   o.Channel.OrderChannel(),
+		DiningWay: o.DiningMode.DiningWay(),
+		At:        at,
+	}
+
+	lines := make([]*PromotionLine, 0, len(o.OrderProducts))
+	index := make([]int, 0, len(o.OrderProducts))
+	for i := range o.OrderProducts {
+		op := &o.OrderProducts[i]
+		if op.IsGift {
+			continue
+		}
+		lines = append(lines, &PromotionLine{
+			ProductID:   op.ProductID,
+			CategoryIDs: []uuid.UUID{op.Category.ID, op.Category.ParentID},
+			Qty:         op.Qty - op.GiftQty - op.VoidQty,
+			Amount:      op.Subtotal.Sub(op.MemberDiscount),
+		})
+		index = append(index, i)
+	}
+
+	o.Promotions = promotions.Calculate(pctx, lines)
+
+	delta := decimal.Zero
+	discounts := make(map[int]decimal.Decimal, len(lines))
+	for k, line := range lines {
+		discounts[index[k]] = line.Discount
+	}
+	for i := range o.OrderProducts {
+		op := &o.OrderProducts[i]
+		d := discounts[i]
+		lineDelta := d.Sub(op.PromotionDiscount)
+		op.PromotionDiscount = d
+		op.DiscountAmount = op.DiscountAmount.Add(lineDelta)
+		delta = delta.Add(lineDelta)
+	}
+	o.Amount.DiscountTotal = o.Amount.DiscountTotal.Add(delta)
+	o.Amount.AmountDue = o.Amount.AmountDue.Sub(delta)
+}
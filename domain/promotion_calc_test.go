@@ -0,0 +1,292 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	promoProductA = uuid.New()
+	promoProductB = uuid.New()
+	promoProductC = uuid.New()
+	promoCategory = uuid.New()
+)
+
+func promoLine(productID uuid.UUID, qty int, amount string, categoryIDs ...uuid.UUID) *PromotionLine {
+	return &PromotionLine{
+		ProductID:   productID,
+		CategoryIDs: categoryIDs,
+		Qty:         qty,
+		Amount:      decimal.RequireFromString(amount),
+	}
+}
+
+func promo(name string, typ PromotionType, rule PromotionRule, priority int, stackable bool) *Promotion {
+	return &Promotion{
+		ID:        uuid.New(),
+		Name:      name,
+		Type:      typ,
+		Rule:      rule,
+		Priority:  priority,
+		Stackable: stackable,
+		Enabled:   true,
+	}
+}
+
+// requirePromotionResult 校验享受的活动（名称 -> 优惠金额）及各商品行分摊的优惠
+func requirePromotionResult(t *testing.T, applied []OrderPromotion, lines []*PromotionLine, wantApplied map[string]string, wantLines []string) {
+	t.Helper()
+	got := make(map[string]string, len(applied))
+	for _, a := range applied {
+		got[a.Name] = a.DiscountAmount.String()
+	}
+	if wantApplied == nil {
+		wantApplied = map[string]string{}
+	}
+	require.Equal(t, wantApplied, got)
+	for i, want := range wantLines {
+		require.True(t, lines[i].Discount.Equal(decimal.RequireFromString(want)),
+			"line %d discount = %s, want %s", i, lines[i].Discount, want)
+	}
+}
+
+func TestPromotionsCalculateTypes(t *testing.T) {
+	dec := decimal.RequireFromString
+	tests := []struct {
+		name        string
+		promotion   *Promotion
+		lines       []*PromotionLine
+		wantApplied map[string]string
+		wantLines   []string
+	}{
+		{
+			name: "满减按剩余金额比例分摊",
+			promotion: promo("满100减20", PromotionTypeOrderDiscount, PromotionRule{
+				Threshold: dec("100"), DiscountType: PromotionDiscountTypeAmount, DiscountValue: dec("20"),
+			}, 0, false),
+			lines:       []*PromotionLine{promoLine(promoProductA, 1, "60"), promoLine(promoProductB, 2, "60")},
+			wantApplied: map[string]string{"满100减20": "20"},
+			wantLines:   []string{"10", "10"},
+		},
+		{
+			name: "满折尾差计入最后一行",
+			promotion: promo("整单9折", PromotionTypeOrderDiscount, PromotionRule{
+				DiscountType: PromotionDiscountTypePercent, DiscountValue: dec("10"),
+			}, 0, false),
+			lines:       []*PromotionLine{promoLine(promoProductA, 1, "33.33"), promoLine(promoProductB, 1, "33.34")},
+			wantApplied: map[string]string{"整单9折": "6.67"},
+			wantLines:   []string{"3.33", "3.34"},
+		},
+		{
+			name: "满减金额不超过参与商品金额",
+			promotion: promo("满10减50", PromotionTypeOrderDiscount, PromotionRule{
+				Threshold: dec("10"), DiscountType: PromotionDiscountTypeAmount, DiscountValue: dec("50"),
+			}, 0, false),
+			lines:       []*PromotionLine{promoLine(promoProductA, 1, "30")},
+			wantApplied: map[string]string{"满10减50": "30"},
+			wantLines:   []string{"30"},
+		},
+		{
+			name: "单品折扣仅指定商品参与",
+			promotion: promo("A商品8折", PromotionTypeItemDiscount, PromotionRule{
+				DiscountType: PromotionDiscountTypePercent, DiscountValue: dec("20"), ProductIDs: []uuid.UUID{promoProductA},
+			}, 0, false),
+			lines:       []*PromotionLine{promoLine(promoProductA, 2, "50"), promoLine(promoProductB, 1, "30")},
+			wantApplied: map[string]string{"A商品8折": "10"},
+			wantLines:   []string{"10", "0"},
+		},
+		{
+			name: "分类折扣按每份减免固定金额",
+			promotion: promo("分类每份减3", PromotionTypeItemDiscount, PromotionRule{
+				DiscountType: PromotionDiscountTypeAmount, DiscountValue: dec("3"), CategoryIDs: []uuid.UUID{promoCategory},
+			}, 0, false),
+			lines:       []*PromotionLine{promoLine(promoProductA, 2, "50", uuid.New(), promoCategory), promoLine(promoProductB, 1, "30", uuid.New())},
+			wantApplied: map[string]string{"分类每份减3": "6"},
+			wantLines:   []string{"6", "0"},
+		},
+		{
+			name: "单品减免不超过商品金额",
+			promotion: promo("每份减30", PromotionTypeItemDiscount, PromotionRule{
+				DiscountType: PromotionDiscountTypeAmount, DiscountValue: dec("30"),
+			}, 0, false),
+			lines:       []*PromotionLine{promoLine(promoProductA, 1, "20")},
+			wantApplied: map[string]string{"每份减30": "20"},
+			wantLines:   []string{"20"},
+		},
+		{
+			name: "买二送一赠送最低价的一份",
+			promotion: promo("买二送一", PromotionTypeBuyXGetY, PromotionRule{
+				BuyQty: 2, GetQty: 1,
+			}, 0, false),
+			lines: []*PromotionLine{
+				promoLine(promoProductA, 2, "40"),
+				promoLine(promoProductB, 1, "12"),
+				promoLine(promoProductC, 1, "30"),
+			},
+			wantApplied: map[string]string{"买二送一": "12"},
+			wantLines:   []string{"0", "12", "0"},
+		},
+		{
+			name: "买二送一数量不足不享受",
+			promotion: promo("买二送一", PromotionTypeBuyXGetY, PromotionRule{
+				BuyQty: 2, GetQty: 1,
+			}, 0, false),
+			lines:     []*PromotionLine{promoLine(promoProductA, 2, "40")},
+			wantLines: []string{"0"},
+		},
+		{
+			name: "第二份半价按同一商品配对",
+			promotion: promo("第二份半价", PromotionTypeSecondItemDiscount, PromotionRule{
+				DiscountValue: dec("50"),
+			}, 0, false),
+			lines:       []*PromotionLine{promoLine(promoProductA, 3, "30"), promoLine(promoProductB, 1, "20")},
+			wantApplied: map[string]string{"第二份半价": "5"},
+			wantLines:   []string{"5", "0"},
+		},
+		{
+			name: "第二份半价不同商品不配对",
+			promotion: promo("第二份半价", PromotionTypeSecondItemDiscount, PromotionRule{
+				DiscountValue: dec("50"),
+			}, 0, false),
+			lines:     []*PromotionLine{promoLine(promoProductA, 1, "10"), promoLine(promoProductB, 1, "10")},
+			wantLines: []string{"0", "0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applied := Promotions{tt.promotion}.Calculate(PromotionContext{At: time.Now()}, tt.lines)
+			requirePromotionResult(t, applied, tt.lines, tt.wantApplied, tt.wantLines)
+		})
+	}
+}
+
+func TestPromotionsCalculateStacking(t *testing.T) {
+	dec := decimal.RequireFromString
+	orderOff := func(name, amount string, priority int, stackable bool) *Promotion {
+		return promo(name, PromotionTypeOrderDiscount, PromotionRule{
+			DiscountType: PromotionDiscountTypeAmount, DiscountValue: dec(amount),
+		}, priority, stackable)
+	}
+	itemPercent := func(name, percent string, priority int, stackable bool) *Promotion {
+		return promo(name, PromotionTypeItemDiscount, PromotionRule{
+			DiscountType: PromotionDiscountTypePercent, DiscountValue: dec(percent),
+		}, priority, stackable)
+	}
+	disabled := orderOff("已停用", "50", 100, false)
+	disabled.Enabled = false
+	unmatched := promo("其他商品折扣", PromotionTypeItemDiscount, PromotionRule{
+		DiscountType: PromotionDiscountTypePercent, DiscountValue: dec("50"), ProductIDs: []uuid.UUID{promoProductB},
+	}, 100, false)
+	earlier := orderOff("先创建", "5", 1, false)
+	earlier.CreatedAt = time.Now().Add(-time.Hour)
+	later := orderOff("后创建", "8", 1, false)
+	later.CreatedAt = time.Now()
+
+	tests := []struct {
+		name        string
+		promotions  Promotions
+		wantApplied map[string]string
+		wantLine    string
+	}{
+		{
+			name:        "高优先级不可叠加活动独享",
+			promotions:  Promotions{orderOff("可叠加满减", "5", 5, true), itemPercent("不可叠加9折", "10", 10, false), itemPercent("可叠加95折", "5", 1, true)},
+			wantApplied: map[string]string{"不可叠加9折": "10"},
+			wantLine:    "10",
+		},
+		{
+			name:        "可叠加活动按剩余金额依次叠加",
+			promotions:  Promotions{orderOff("可叠加满减", "5", 10, true), itemPercent("可叠加9折", "10", 5, true), itemPercent("不可叠加8折", "20", 1, false)},
+			wantApplied: map[string]string{"可叠加满减": "5", "可叠加9折": "9.5"},
+			wantLine:    "14.5",
+		},
+		{
+			name:        "叠加优惠不超过商品金额",
+			promotions:  Promotions{orderOff("减80", "80", 10, true), orderOff("再减80", "80", 5, true)},
+			wantApplied: map[string]string{"减80": "80", "再减80": "20"},
+			wantLine:    "100",
+		},
+		{
+			name:        "未产生优惠的活动不影响后续活动",
+			promotions:  Promotions{unmatched, orderOff("不可叠加满减", "10", 5, false)},
+			wantApplied: map[string]string{"不可叠加满减": "10"},
+			wantLine:    "10",
+		},
+		{
+			name:        "停用的活动不参与",
+			promotions:  Promotions{disabled, orderOff("满减", "10", 5, false)},
+			wantApplied: map[string]string{"满减": "10"},
+			wantLine:    "10",
+		},
+		{
+			name:        "相同优先级先创建的活动优先",
+			promotions:  Promotions{later, earlier},
+			wantApplied: map[string]string{"先创建": "5"},
+			wantLine:    "5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := []*PromotionLine{promoLine(promoProductA, 1, "100")}
+			applied := tt.promotions.Calculate(PromotionContext{At: time.Now()}, lines)
+			requirePromotionResult(t, applied, lines, tt.wantApplied, []string{tt.wantLine})
+		})
+	}
+}
+
+func TestPromotionsCalculateThreshold(t *testing.T) {
+	dec := decimal.RequireFromString
+	fullReduction := func() *Promotion {
+		return promo("满100减10", PromotionTypeOrderDiscount, PromotionRule{
+			Threshold: dec("100"), DiscountType: PromotionDiscountTypeAmount, DiscountValue: dec("10"),
+			ProductIDs: []uuid.UUID{promoProductA},
+		}, 0, true)
+	}
+	tests := []struct {
+		name        string
+		promotions  Promotions
+		lines       []*PromotionLine
+		wantApplied map[string]string
+	}{
+		{
+			name:       "低于门槛",
+			promotions: Promotions{fullReduction()},
+			lines:      []*PromotionLine{promoLine(promoProductA, 1, "99.99")},
+		},
+		{
+			name:        "恰好达到门槛",
+			promotions:  Promotions{fullReduction()},
+			lines:       []*PromotionLine{promoLine(promoProductA, 1, "100")},
+			wantApplied: map[string]string{"满100减10": "10"},
+		},
+		{
+			name:        "超过门槛",
+			promotions:  Promotions{fullReduction()},
+			lines:       []*PromotionLine{promoLine(promoProductA, 2, "100.01")},
+			wantApplied: map[string]string{"满100减10": "10"},
+		},
+		{
+			name:       "门槛只统计参与商品",
+			promotions: Promotions{fullReduction()},
+			lines:      []*PromotionLine{promoLine(promoProductA, 1, "60"), promoLine(promoProductB, 1, "60")},
+		},
+		{
+			name: "门槛按扣除先享受优惠后的金额计算",
+			promotions: Promotions{fullReduction(), promo("可叠加9折", PromotionTypeItemDiscount, PromotionRule{
+				DiscountType: PromotionDiscountTypePercent, DiscountValue: dec("10"),
+			}, 10, true)},
+			lines:       []*PromotionLine{promoLine(promoProductA, 1, "105")},
+			wantApplied: map[string]string{"可叠加9折": "10.5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applied := tt.promotions.Calculate(PromotionContext{At: time.Now()}, tt.lines)
+			requirePromotionResult(t, applied, tt.lines, tt.wantApplied, nil)
+		})
+	}
+}
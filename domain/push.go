@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPushTopicRequired = errors.New("请指定订阅范围")
+	ErrPushDeviceInvalid = errors.New("设备不存在或不属于该门店")
+)
+
+// PushScope 推送范围
+type PushScope string
+
+const (
+	PushScopeMerchant PushScope = "merchant" // 品牌商
+	PushScopeStore    PushScope = "store"    // 门店
+	PushScopeTable    PushScope = "table"    // 桌台
+	PushScopeDevice   PushScope = "device"   // 设备
+	PushScopeOrder    PushScope = "order"    // 订单（电子小票）
+	PushScopeWaitlist PushScope = "waitlist" // 门店排队（顾客端排队状态页）
+)
+
+// PushTopic 推送主题，客户端按主题订阅
+type PushTopic struct {
+	Scope PushScope
+	ID    uuid.UUID
+}
+
+func (t PushTopic) String() string {
+	return fmt.Sprintf("%s:%s", t.Scope, t.ID)
+}
+
+func MerchantPushTopic(merchantID uuid.UUID) PushTopic {
+	return PushTopic{Scope: PushScopeMerchant, ID: merchantID}
+}
+
+func StorePushTopic(storeID uuid.UUID) PushTopic {
+	return PushTopic{Scope: PushScopeStore, ID: storeID}
+}
+
+func TablePushTopic(tableID uuid.UUID) PushTopic {
+	return PushTopic{Scope: PushScopeTable, ID: tableID}
+}
+
+func DevicePushTopic(deviceID uuid.UUID) PushTopic {
+	return PushTopic{Scope: PushScopeDevice, ID: deviceID}
+}
+
+func OrderPushTopic(orderID uuid.UUID) PushTopic {
+	return PushTopic{Scope: PushScopeOrder, ID: orderID}
+}
+
+// WaitlistPushTopic 门店排队主题，只推送不含顾客信息的排队号变更
+func WaitlistPushTopic(storeID uuid.UUID) PushTopic {
+	return PushTopic{Scope: PushScopeWaitlist, ID: storeID}
+}
+
+// OrderPushTopics 订单相关推送主题：门店、订单，以及堂食桌台
+func OrderPushTopics(order *Order) []PushTopic {
+	topics := []PushTopic{StorePushTopic(order.StoreID), OrderPushTopic(order.ID)}
+	if order.TableID != uuid.Nil {
+		topics = append(topics, TablePushTopic(order.TableID))
+	}
+	return topics
+}
+
+// ScopedPushTopic 门店或品牌商级数据变更的推送主题，门店为空时推送到品牌商
+func ScopedPushTopic(merchantID, storeID uuid.UUID) PushTopic {
+	if storeID != uuid.Nil {
+		return StorePushTopic(storeID)
+	}
+	return MerchantPushTopic(merchantID)
+}
+
+// PushEventType 推送事件类型
+type PushEventType string
+
+const (
+	PushEventOrderCreated          PushEventType = "order.created"           // 新订单
+	PushEventOrderUpdated          PushEventType = "order.updated"           // 订单状态变更/加菜
+	PushEventCartUpdated           PushEventType = "cart.updated"            // 桌台购物车变更
+	PushEventKitchenTicketCreated  PushEventType = "kitchen_ticket.created"  // 新厨房单
+	PushEventKitchenTicketPrinted  PushEventType = "kitchen_ticket.printed"  // 厨房单已出单（开始制作）
+	PushEventProductSaleStatus     PushEventType = "product.sale_status"     // 商品售罄/恢复售卖
+	PushEventConfigUpdated         PushEventType = "config.updated"          // 经营配置变更
+	PushEventReceiptRequestCreated PushEventType = "receipt_request.created" // 顾客提交退款/开票申请
+	PushEventReceiptRequestHandled PushEventType = "receipt_request.handled" // 门店已处理申请
+	PushEventPickupCalled          PushEventType = "pickup.called"           // 取餐叫号
+	PushEventPickupPickedUp        PushEventType = "pickup.picked_up"        // 已取餐
+	PushEventDeliveryOrderCreated  PushEventType = "delivery_order.created"  // 新外卖订单（待接单）
+	PushEventDeliveryOrderUpdated  PushEventType = "delivery_order.updated"  // 外卖订单状态变更
+	PushEventReservationCreated    PushEventType = "reservation.created"     // 新预订
+	PushEventReservationUpdated    PushEventType = "reservation.updated"     // 预订状态变更（入座、取消、未到店、收取定金）
+	PushEventWaitlistTaken         PushEventType = "waitlist.taken"          // 新排队号
+	PushEventWaitlistCalled        PushEventType = "waitlist.called"         // 排队叫号
+	PushEventWaitlistUpdated       PushEventType = "waitlist.updated"        // 排队号状态变更（入座、过号、取消）
+	PushEventPreorderCreated       PushEventType = "preorder.created"        // 新预点单
+	PushEventPreorderUpdated       PushEventType = "preorder.updated"        // 预点单状态变更（送厨、取消）
+)
+
+// ProductSaleStatusPush 商品售卖状态变更推送内容
+type ProductSaleStatusPush struct {
+	ProductID  uuid.UUID         `json:"product_id"`  // 商品ID
+	SaleStatus ProductSaleStatus `json:"sale_status"` // 售卖状态
+}
+
+// KitchenTicketPrintedPush 厨房单出单推送内容
+type KitchenTicketPrintedPush struct {
+	OrderID   uuid.UUID   `json:"order_id"`   // 订单ID
+	TicketIDs []uuid.UUID `json:"ticket_ids"` // 出单的厨房单ID
+}
+
+// ConfigUpdatedPush 经营配置变更推送内容，客户端收到后重新拉取配置
+type ConfigUpdatedPush struct {
+	Keys []string `json:"keys"` // 变更的参数键名
+}
+
+// Pusher 实时推送，推送为尽力而为，失败只记录日志不影响业务
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/pusher.go -package=mock . Pusher
+type Pusher interface {
+	Push(ctx context.Context, event PushEventType, data any, topics ...PushTopic)
+}
+
+// PushSubscribeParams 订阅参数，至少指定门店、桌台、电子小票或排队号
+type PushSubscribeParams struct {
+	MerchantID   uuid.UUID // 品牌商ID（前台）
+	StoreID      uuid.UUID // 门店ID（前台）
+	DeviceID     uuid.UUID // 设备ID（前台，可选）
+	TableToken   string    // 桌台二维码令牌（顾客端）
+	ReceiptToken string    // 电子小票令牌（顾客端）
+	WaitlistID   uuid.UUID // 排队号ID（顾客端排队状态页）
+}
+
+// PushInteractor 推送订阅用例接口，校验订阅方可访问的主题
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/push_interactor.go -package=mock . PushInteractor
+type PushInteractor interface {
+	// Topics 返回订阅方可订阅的主题：前台订阅品牌商、门店和设备，顾客端订阅桌台、电子小票对应的订单或排队号所在门店的排队
+	Topics(ctx context.Context, params PushSubscribeParams) ([]PushTopic, error)
+}
@@ -130,6 +130,8 @@ type RefundPayment struct {
 }
 
 // RefundOrderRepository 退款订单仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/refund_order_repository.go -package=mock . RefundOrderRepository
 type RefundOrderRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*RefundOrder, error)
 	Create(ctx context.Context, refundOrder *RefundOrder) error
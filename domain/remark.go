@@ -132,33 +132,36 @@ func NewRemarkOrderBySortOrder(desc bool) RemarkOrderBy {
 }
 
 type Remark struct {
-	ID          uuid.UUID   `json:"id"`
-	Name        string      `json:"name"`         // 备注名称
-	RemarkType  RemarkType  `json:"remark_type"`  // 备注类型：系统/品牌
-	Enabled     bool        `json:"enabled"`      // 是否启用
-	SortOrder   int         `json:"sort_order"`   // 排序，值越小越靠前
-	RemarkScene RemarkScene `json:"remark_scene"` // 使用场景：整单备注/单品备注/退菜原因等
-	MerchantID  uuid.UUID   `json:"merchant_id"`  // 品牌商ID，仅品牌备注需要
-	StoreID     uuid.UUID   `json:"store_id"`     // 门店 ID
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	ID          uuid.UUID     `json:"id"`
+	Name        string        `json:"name"`         // 备注名称
+	NameI18n    LocalizedText `json:"name_i18n"`    // 备注名称翻译
+	RemarkType  RemarkType    `json:"remark_type"`  // 备注类型：系统/品牌
+	Enabled     bool          `json:"enabled"`      // 是否启用
+	SortOrder   int           `json:"sort_order"`   // 排序，值越小越靠前
+	RemarkScene RemarkScene   `json:"remark_scene"` // 使用场景：整单备注/单品备注/退菜原因等
+	MerchantID  uuid.UUID     `json:"merchant_id"`  // 品牌商ID，仅品牌备注需要
+	StoreID     uuid.UUID     `json:"store_id"`     // 门店 ID
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
 }
 
 type CreateRemarkParams struct {
-	RemarkType  RemarkType  `json:"remark_type"`  // 备注归属方
-	Name        string      `json:"name"`         // 备注名称
-	Enabled     bool        `json:"enabled"`      // 是否启用
-	SortOrder   int         `json:"sort_order"`   // 排序，越小越靠前
-	RemarkScene RemarkScene `json:"remark_scene"` // 使用场景：整单备注/单品备注/退菜原因等
-	MerchantID  uuid.UUID   `json:"merchant_id"`  // 商户 ID
-	StoreID     uuid.UUID   `json:"store_id"`     // 门店 ID
+	RemarkType  RemarkType    `json:"remark_type"`  // 备注归属方
+	Name        string        `json:"name"`         // 备注名称
+	NameI18n    LocalizedText `json:"name_i18n"`    // 备注名称翻译
+	Enabled     bool          `json:"enabled"`      // 是否启用
+	SortOrder   int           `json:"sort_order"`   // 排序，越小越靠前
+	RemarkScene RemarkScene   `json:"remark_scene"` // 使用场景：整单备注/单品备注/退菜原因等
+	MerchantID  uuid.UUID     `json:"merchant_id"`  // 商户 ID
+	StoreID     uuid.UUID     `json:"store_id"`     // 门店 ID
 }
 
 type UpdateRemarkParams struct {
-	ID        uuid.UUID `json:"id"`         // 备注 ID
-	Name      string    `json:"name"`       // 备注名称
-	Enabled   bool      `json:"enabled"`    // 是否启用
-	SortOrder int       `json:"sort_order"` // 排序，越小越靠前
+	ID        uuid.UUID     `json:"id"`         // 备注 ID
+	Name      string        `json:"name"`       // 备注名称
+	NameI18n  LocalizedText `json:"name_i18n"`  // 备注名称翻译
+	Enabled   bool          `json:"enabled"`    // 是否启用
+	SortOrder int           `json:"sort_order"` // 排序，越小越靠前
 }
 type RemarkExistsParams struct {
 	RemarkType  RemarkType  `json:"remark_type"`  // 备注归属方
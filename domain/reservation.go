@@ -0,0 +1,454 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrReservationRuleNotExists        = errors.New("预订规则不存在")
+	ErrReservationRuleSlotInvalid      = errors.New("预订时段间隔需为5的倍数，且在15-120分钟之间")
+	ErrReservationRuleDiningInvalid    = errors.New("用餐时长需在30-480分钟之间，且不小于时段间隔")
+	ErrReservationRuleAdvanceInvalid   = errors.New("可提前预订天数需在1-90天之间，线上预订截止时间不超过1440分钟")
+	ErrReservationRulePartySizeInvalid = errors.New("单笔预订人数上限需在1-100之间")
+	ErrReservationRuleDepositInvalid   = errors.New("每位定金不能小于0")
+	ErrReservationNotExists            = errors.New("预订不存在")
+	ErrReservationGuestRequired        = errors.New("请填写预订人姓名和手机号")
+	ErrReservationPartySizeInvalid     = errors.New("用餐人数超出可预订范围")
+	ErrReservationDateInvalid          = errors.New("预订日期超出可预订范围")
+	ErrReservationSlotInvalid          = errors.New("预订时间不在可预订时段内")
+	ErrReservationSlotFull             = errors.New("该时段已无合适桌台，请选择其他时段")
+	ErrReservationTooLate              = errors.New("已超过线上预订截止时间，请致电门店预订")
+	ErrReservationOnlineDisabled       = errors.New("门店暂未开放线上预订")
+	ErrReservationStoreClosed          = errors.New("门店已停业")
+	ErrReservationStatusInvalid        = errors.New("预订当前状态不允许该操作")
+	ErrReservationDepositInvalid       = errors.New("定金金额不能小于0")
+	ErrReservationDepositStatusInvalid = errors.New("定金当前状态不允许该操作")
+	ErrReservationTableInvalid         = errors.New("桌台不存在、已停用或座位数不足")
+	ErrReservationTableOccupied        = errors.New("桌台有未结账订单，请选择其他桌台")
+	ErrReservationNotToday             = errors.New("只能为当天的预订安排入座")
+	ErrReservationBusy                 = errors.New("门店预订正在处理中，请稍后再试")
+)
+
+// ------------------------------------------------------------
+// 预订规则
+// ------------------------------------------------------------
+
+// ReservationRule 门店预订规则，可预订时段由门店营业时间、就餐时段和本规则共同决定
+type ReservationRule struct {
+	StoreRuleMeta
+	OnlineEnabled     bool            `json:"online_enabled"`      // 是否开放顾客线上预订
+	SlotMinutes       int             `json:"slot_minutes"`        // 时段间隔（分钟），从营业开始时间起按间隔生成可预订时间
+	DiningMinutes     int             `json:"dining_minutes"`      // 用餐时长（分钟），预订占用桌台的时长
+	MinAdvanceMinutes int             `json:"min_advance_minutes"` // 线上预订需至少提前的分钟数
+	MaxAdvanceDays    int             `json:"max_advance_days"`    // 最多可提前预订的天数
+	MaxPartySize      int             `json:"max_party_size"`      // 单笔预订人数上限
+	DepositPerGuest   decimal.Decimal `json:"deposit_per_guest"`   // 线上预订每位定金，为0不收定金
+}
+
+// DefaultReservationRule 未配置规则时的默认规则：不开放线上预订，每30分钟一个时段，用餐90分钟，可提前30天预订
+func DefaultReservationRule(merchantID, storeID uuid.UUID) *ReservationRule {
+	return &ReservationRule{
+		StoreRuleMeta:     StoreRuleMeta{MerchantID: merchantID, StoreID: storeID},
+		SlotMinutes:       30,
+		DiningMinutes:     90,
+		MinAdvanceMinutes: 60,
+		MaxAdvanceDays:    30,
+		MaxPartySize:      20,
+		DepositPerGuest:   decimal.Zero,
+	}
+}
+
+func (r *ReservationRule) Validate() error {
+	if r.SlotMinutes < 15 || r.SlotMinutes > 120 || r.SlotMinutes%5 != 0 {
+		return ErrReservationRuleSlotInvalid
+	}
+	if r.DiningMinutes < 30 || r.DiningMinutes > 480 || r.DiningMinutes < r.SlotMinutes {
+		return ErrReservationRuleDiningInvalid
+	}
+	if r.MaxAdvanceDays < 1 || r.MaxAdvanceDays > 90 || r.MinAdvanceMinutes < 0 || r.MinAdvanceMinutes > 1440 {
+		return ErrReservationRuleAdvanceInvalid
+	}
+	if r.MaxPartySize < 1 || r.MaxPartySize > 100 {
+		return ErrReservationRulePartySizeInvalid
+	}
+	if r.DepositPerGuest.IsNegative() {
+		return ErrReservationRuleDepositInvalid
+	}
+	return nil
+}
+
+// DiningDuration 预订占用桌台的时长
+func (r *ReservationRule) DiningDuration() time.Duration {
+	return time.Duration(r.DiningMinutes) * time.Minute
+}
+
+// ReservationSlotTime 可预订时间
+type ReservationSlotTime struct {
+	At           time.Time // 开始时间
+	DiningPeriod string    // 所在就餐时段名称
+}
+
+// SlotTimes 生成指定日期的可预订时间：在营业时间段内从开始时间起按间隔生成，需在结束前完成用餐；
+// 门店配置了就餐时段时只保留落在就餐时段内的时间
+func (r *ReservationRule) SlotTimes(store *Store, date time.Time) []ReservationSlotTime {
+	var res []ReservationSlotTime
+	step := time.Duration(r.SlotMinutes) * time.Minute
+	for _, h := range BusinessHoursOn(store.BusinessHours, date.Weekday()) {
+		start, ok := clockOn(date, h.StartTime)
+		if !ok {
+			continue
+		}
+		end, ok := clockOn(date, h.EndTime)
+		if !ok {
+			continue
+		}
+		for at := start; !at.Add(r.DiningDuration()).After(end); at = at.Add(step) {
+			slot := ReservationSlotTime{At: at}
+			if len(store.DiningPeriods) > 0 {
+				period := ActiveDiningPeriod(store.DiningPeriods, at)
+				if period == nil {
+					continue
+				}
+				slot.DiningPeriod = period.Name
+			}
+			res = append(res, slot)
+		}
+	}
+	return res
+}
+
+// clockOn 将 HH:MM:SS 格式的时间与日期组合
+func clockOn(date time.Time, clock string) (time.Time, bool) {
+	t, err := time.Parse(time.TimeOnly, clock)
+	if err != nil {
+		return time.Time{}, false
+	}
+	y, m, d := date.Date()
+	return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, date.Location()), true
+}
+
+// ReservationRuleRepository 预订规则仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/reservation_rule_repository.go -package=mock . ReservationRuleRepository
+type ReservationRuleRepository interface {
+	StoreRuleRepository[*ReservationRule]
+}
+
+// ReservationRuleInteractor 预订规则用例接口，由门店后台维护
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/reservation_rule_interactor.go -package=mock . ReservationRuleInteractor
+type ReservationRuleInteractor interface {
+	// GetRule 查询门店预订规则，未配置时返回默认规则
+	GetRule(ctx context.Context, user User) (*ReservationRule, error)
+	SaveRule(ctx context.Context, rule *ReservationRule, user User) error
+}
+
+// ------------------------------------------------------------
+// 预订
+// ------------------------------------------------------------
+
+// ReservationStatus 预订状态
+type ReservationStatus string
+
+const (
+	ReservationStatusBooked    ReservationStatus = "booked"    // 已预订
+	ReservationStatusSeated    ReservationStatus = "seated"    // 已入座
+	ReservationStatusNoShow    ReservationStatus = "no_show"   // 未到店
+	ReservationStatusCancelled ReservationStatus = "cancelled" // 已取消
+)
+
+func (ReservationStatus) Values() []string {
+	return []string{
+		string(ReservationStatusBooked),
+		string(ReservationStatusSeated),
+		string(ReservationStatusNoShow),
+		string(ReservationStatusCancelled),
+	}
+}
+
+// ReservationSource 预订来源
+type ReservationSource string
+
+const (
+	ReservationSourceStore  ReservationSource = "store"  // 门店代客预订（电话、到店）
+	ReservationSourceOnline ReservationSource = "online" // 顾客线上预订
+)
+
+func (ReservationSource) Values() []string {
+	return []string{
+		string(ReservationSourceStore),
+		string(ReservationSourceOnline),
+	}
+}
+
+// ReservationDepositStatus 定金状态
+type ReservationDepositStatus string
+
+const (
+	ReservationDepositStatusNone     ReservationDepositStatus = "none"     // 无需定金
+	ReservationDepositStatusUnpaid   ReservationDepositStatus = "unpaid"   // 待收取
+	ReservationDepositStatusPaid     ReservationDepositStatus = "paid"     // 已收取
+	ReservationDepositStatusRefunded ReservationDepositStatus = "refunded" // 已退还
+)
+
+func (ReservationDepositStatus) Values() []string {
+	return []string{
+		string(ReservationDepositStatusNone),
+		string(ReservationDepositStatusUnpaid),
+		string(ReservationDepositStatusPaid),
+		string(ReservationDepositStatusRefunded),
+	}
+}
+
+// Reservation 桌台预订
+type Reservation struct {
+	ID             uuid.UUID                `json:"id"`
+	MerchantID     uuid.UUID                `json:"merchant_id"`     // 品牌商ID
+	StoreID        uuid.UUID                `json:"store_id"`        // 门店ID
+	Source         ReservationSource        `json:"source"`          // 预订来源
+	CustomerID     uuid.UUID                `json:"customer_id"`     // 线上预订的顾客ID
+	GuestName      string                   `json:"guest_name"`      // 预订人姓名
+	GuestPhone     string                   `json:"guest_phone"`     // 预订人手机号
+	PartySize      int                      `json:"party_size"`      // 用餐人数
+	ReservedAt     time.Time                `json:"reserved_at"`     // 预订到店时间
+	EndAt          time.Time                `json:"end_at"`          // 预计用餐结束时间，此前桌台不再分配给其他预订
+	DiningPeriod   string                   `json:"dining_period"`   // 就餐时段名称
+	TableID        uuid.UUID                `json:"table_id"`        // 分配的桌台ID
+	TableName      string                   `json:"table_name"`      // 分配的桌台名称
+	AreaPreference string                   `json:"area_preference"` // 区域偏好，如靠窗、包间
+	Remark         string                   `json:"remark"`          // 备注
+	Status         ReservationStatus        `json:"status"`          // 状态
+	Deposit        decimal.Decimal          `json:"deposit"`         // 定金金额
+	DepositStatus  ReservationDepositStatus `json:"deposit_status"`  // 定金状态
+	DepositMethod  PaymentMethodPayType     `json:"deposit_method"`  // 定金支付方式
+	DepositPaidAt  *time.Time               `json:"deposit_paid_at"` // 定金收取时间
+	OrderID        uuid.UUID                `json:"order_id"`        // 入座后生成的订单ID
+	SeatedAt       *time.Time               `json:"seated_at"`       // 入座时间
+	CancelledAt    *time.Time               `json:"cancelled_at"`    // 取消时间
+	CancelReason   string                   `json:"cancel_reason"`   // 取消原因
+	CreatedAt      time.Time                `json:"created_at"`
+	UpdatedAt      time.Time                `json:"updated_at"`
+}
+
+// HoldsTable 已预订和已入座的预订在用餐时间内占用桌台
+func (r *Reservation) HoldsTable() bool {
+	return r.Status == ReservationStatusBooked || r.Status == ReservationStatusSeated
+}
+
+// Overlaps 预订占用桌台的时间是否与 [start, end) 重叠
+func (r *Reservation) Overlaps(start, end time.Time) bool {
+	return r.ReservedAt.Before(end) && start.Before(r.EndAt)
+}
+
+// CollectDeposit 收取定金
+func (r *Reservation) CollectDeposit(method PaymentMethodPayType, now time.Time) error {
+	if r.Status != ReservationStatusBooked {
+		return ErrReservationStatusInvalid
+	}
+	if r.DepositStatus != ReservationDepositStatusUnpaid {
+		return ErrReservationDepositStatusInvalid
+	}
+	r.DepositStatus = ReservationDepositStatusPaid
+	r.DepositMethod = method
+	r.DepositPaidAt = &now
+	return nil
+}
+
+// Cancel 取消预订，已收取的定金标记为已退还，由门店线下退还
+func (r *Reservation) Cancel(reason string, now time.Time) error {
+	if r.Status != ReservationStatusBooked {
+		return ErrReservationStatusInvalid
+	}
+	r.Status = ReservationStatusCancelled
+	r.CancelReason = reason
+	r.CancelledAt = &now
+	if r.DepositStatus == ReservationDepositStatusPaid {
+		r.DepositStatus = ReservationDepositStatusRefunded
+	}
+	return nil
+}
+
+// MarkNoShow 标记未到店，已收取的定金不予退还
+func (r *Reservation) MarkNoShow() error {
+	if r.Status != ReservationStatusBooked {
+		return ErrReservationStatusInvalid
+	}
+	r.Status = ReservationStatusNoShow
+	return nil
+}
+
+// Seat 安排入座
+func (r *Reservation) Seat(table *DiningTable, orderID uuid.UUID, now time.Time) error {
+	if r.Status != ReservationStatusBooked {
+		return ErrReservationStatusInvalid
+	}
+	r.Status = ReservationStatusSeated
+	r.TableID = table.ID
+	r.TableName = table.Name
+	r.OrderID = orderID
+	r.SeatedAt = &now
+	return nil
+}
+
+// PayByDeposit 入座时将已收取的预订定金记为订单的一笔支付，结账时抵扣
+func (o *Order) PayByDeposit(r *Reservation) {
+	if r.DepositStatus != ReservationDepositStatusPaid || !r.Deposit.IsPositive() {
+		return
+	}
+	paidAt := r.CreatedAt
+	if r.DepositPaidAt != nil {
+		paidAt = *r.DepositPaidAt
+	}
+	o.Payments = append(o.Payments, OrderPayment{
+		PaymentNo:     r.ID.String(),
+		PaymentMethod: r.DepositMethod,
+		PaymentStatus: PaymentStatusPaid,
+		PaymentAmount: r.Deposit,
+		RefundAmount:  decimal.Zero,
+		ChangeAmount:  decimal.Zero,
+		PaidAt:        paidAt,
+		RequestedAt:   paidAt,
+	})
+	o.Amount.AmountPaid = o.Amount.AmountPaid.Add(r.Deposit)
+}
+
+// AllocateTable 为预订分配桌台：优先使用指定桌台，否则选择座位数满足人数的最小空闲桌台；无可用桌台时返回 nil
+//
+// tables 需按排序升序，reservations 为同一天占用桌台的预订
+func AllocateTable(tables []*DiningTable, reservations []*Reservation, start, end time.Time, partySize int, preferredID uuid.UUID) *DiningTable {
+	free := FreeTables(tables, reservations, start, end, partySize)
+	if len(free) == 0 {
+		return nil
+	}
+	if preferredID != uuid.Nil {
+		if idx := slices.IndexFunc(free, func(t *DiningTable) bool { return t.ID == preferredID }); idx >= 0 {
+			return free[idx]
+		}
+	}
+	return slices.MinFunc(free, func(a, b *DiningTable) int {
+		return a.Seats - b.Seats
+	})
+}
+
+// FreeTables 返回 [start, end) 内未被占用且座位数满足人数的启用桌台
+func FreeTables(tables []*DiningTable, reservations []*Reservation, start, end time.Time, partySize int) []*DiningTable {
+	var res []*DiningTable
+	for _, t := range tables {
+		if !t.Enabled || t.Seats < partySize {
+			continue
+		}
+		if slices.ContainsFunc(reservations, func(r *Reservation) bool {
+			return r.TableID == t.ID && r.HoldsTable() && r.Overlaps(start, end)
+		}) {
+			continue
+		}
+		res = append(res, t)
+	}
+	return res
+}
+
+// ReservationSlot 可预订时段
+type ReservationSlot struct {
+	At           time.Time `json:"at"`            // 到店时间
+	Time         string    `json:"time"`          // 到店时间 HH:MM
+	DiningPeriod string    `json:"dining_period"` // 就餐时段名称
+	Available    bool      `json:"available"`     // 是否可预订
+	FreeTables   int       `json:"free_tables"`   // 满足人数的空闲桌台数
+}
+
+// ReservationSlotParams 可预订时段查询参数
+type ReservationSlotParams struct {
+	MerchantID uuid.UUID // 品牌商ID，顾客线上查询时为空
+	StoreID    uuid.UUID
+	Date       string // 日期 YYYY-MM-DD
+	PartySize  int
+	Online     bool // 顾客线上查询，需门店开放线上预订，并按截止时间过滤
+}
+
+// ReservationCreateParams 创建预订参数
+type ReservationCreateParams struct {
+	MerchantID     uuid.UUID // 品牌商ID，顾客线上预订时为空
+	StoreID        uuid.UUID
+	Customer       *Customer // 线上预订的顾客，为空表示门店代客预订
+	GuestName      string
+	GuestPhone     string
+	PartySize      int
+	ReservedAt     time.Time
+	TableID        uuid.UUID       // 偏好桌台，为空或不可用时自动分配
+	AreaPreference string          // 区域偏好
+	Remark         string          // 备注
+	Deposit        decimal.Decimal // 门店代客预订的定金金额，线上预订按规则计算
+}
+
+// ReservationListParams 预订查询参数
+type ReservationListParams struct {
+	StoreID    uuid.UUID
+	CustomerID uuid.UUID
+	Statuses   []ReservationStatus
+	From       time.Time // 预订到店时间起，为空不限制
+	To         time.Time // 预订到店时间止（不含），为空不限制
+}
+
+// ReservationCalendarDay 预订日历单日汇总
+type ReservationCalendarDay struct {
+	Date        string `json:"date"`          // 日期
+	Count       int    `json:"count"`         // 有效预订数（已预订、已入座）
+	Guests      int    `json:"guests"`        // 预订人数
+	NoShowCount int    `json:"no_show_count"` // 未到店数
+}
+
+// ReservationDay 解析预订日期，返回当天零点与次日零点
+func ReservationDay(date string) (start, end time.Time, err error) {
+	start, err = time.ParseInLocation(time.DateOnly, date, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid reservation date %q: %w", date, err)
+	}
+	return start, start.AddDate(0, 0, 1), nil
+}
+
+// ReservationRepository 预订仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/reservation_repository.go -package=mock . ReservationRepository
+type ReservationRepository interface {
+	Create(ctx context.Context, reservation *Reservation) error
+	// Update 更新状态、桌台、定金、入座及取消信息
+	Update(ctx context.Context, reservation *Reservation) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Reservation, error)
+	// List 按预订到店时间升序查询
+	List(ctx context.Context, params ReservationListParams) ([]*Reservation, error)
+}
+
+// ReservationInteractor 预订用例接口：门店后台、POS 代客预订与入座，顾客线上预订
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/reservation_interactor.go -package=mock . ReservationInteractor
+type ReservationInteractor interface {
+	// Slots 查询指定日期的可预订时段及空闲桌台数
+	Slots(ctx context.Context, params ReservationSlotParams) ([]*ReservationSlot, error)
+	// Create 创建预订并分配桌台
+	Create(ctx context.Context, params ReservationCreateParams) (*Reservation, error)
+	// List 查询门店指定日期的预订
+	List(ctx context.Context, merchantID, storeID uuid.UUID, date string, statuses []ReservationStatus) ([]*Reservation, error)
+	// Calendar 预订日历：按天汇总指定月份的预订
+	Calendar(ctx context.Context, merchantID, storeID uuid.UUID, month string) ([]*ReservationCalendarDay, error)
+	CollectDeposit(ctx context.Context, merchantID, storeID, id uuid.UUID, method PaymentMethodPayType) (*Reservation, error)
+	Cancel(ctx context.Context, merchantID, storeID, id uuid.UUID, reason string) (*Reservation, error)
+	MarkNoShow(ctx context.Context, merchantID, storeID, id uuid.UUID) (*Reservation, error)
+	// Seat 安排入座：在桌台开单，已收取的定金记为订单支付；tableID 为空时使用预订分配的桌台
+	Seat(ctx context.Context, merchantID, storeID, id, tableID uuid.UUID) (*Reservation, error)
+
+	// ListByCustomer 顾客查询自己今天及以后的预订
+	ListByCustomer(ctx context.Context, customerID uuid.UUID) ([]*Reservation, error)
+	// CancelByCustomer 顾客在到店时间前取消自己的预订
+	CancelByCustomer(ctx context.Context, customerID, id uuid.UUID) (*Reservation, error)
+}
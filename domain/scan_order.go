@@ -0,0 +1,297 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrScanOrderRuleNotExists      = errors.New("扫码点餐规则不存在")
+	ErrScanOrderPayModeInvalid     = errors.New("扫码点餐支付方式无效")
+	ErrScanOrderDisabled           = errors.New("门店未开启扫码点餐")
+	ErrScanOrderStoreClosed        = errors.New("门店已停业")
+	ErrScanOrderProductUnavailable = errors.New("商品暂不可扫码点餐")
+	ErrScanOrderSpecInvalid        = errors.New("商品规格无效")
+	ErrScanOrderSetMealUnsupported = errors.New("扫码点餐暂不支持套餐")
+	ErrScanOrderBusy               = errors.New("同桌顾客正在操作购物车，请稍后再试")
+	ErrTableCartEmpty              = errors.New("购物车为空")
+	ErrTableCartFull               = errors.New("购物车商品过多，请先下单")
+	ErrTableCartItemNotExists      = errors.New("购物车商品不存在")
+	ErrTableCartItemQtyInvalid     = errors.New("商品数量无效")
+)
+
+// ------------------------------------------------------------
+// 扫码点餐规则
+// ------------------------------------------------------------
+
+// ScanOrderPayMode 扫码点餐支付方式
+type ScanOrderPayMode string
+
+const (
+	ScanOrderPayModePayFirst     ScanOrderPayMode = "pay_first"      // 先付后吃：每次下单生成新订单，支付后才送厨
+	ScanOrderPayModePayAtCounter ScanOrderPayMode = "pay_at_counter" // 吃完前台结账：同桌加菜追加到当前订单，下单即送厨
+)
+
+func (ScanOrderPayMode) Values() []string {
+	return []string{
+		string(ScanOrderPayModePayFirst),
+		string(ScanOrderPayModePayAtCounter),
+	}
+}
+
+// ScanOrderRule 扫码点餐规则，品牌商配置默认规则，门店可单独覆盖
+type ScanOrderRule struct {
+	StoreRuleMeta
+	Enabled bool             `json:"enabled"`  // 是否开启扫码点餐
+	PayMode ScanOrderPayMode `json:"pay_mode"` // 支付方式
+}
+
+// DefaultScanOrderRule 未配置规则时的默认规则：不开启扫码点餐，开启后默认前台结账
+func DefaultScanOrderRule(merchantID, storeID uuid.UUID) *ScanOrderRule {
+	return &ScanOrderRule{
+		StoreRuleMeta: StoreRuleMeta{MerchantID: merchantID, StoreID: storeID},
+		PayMode:       ScanOrderPayModePayAtCounter,
+	}
+}
+
+func (r *ScanOrderRule) Validate() error {
+	if !slices.Contains(ScanOrderPayMode("").Values(), string(r.PayMode)) {
+		return ErrScanOrderPayModeInvalid
+	}
+	return nil
+}
+
+// ------------------------------------------------------------
+// 桌台购物车
+// ------------------------------------------------------------
+
+// maxTableCartItems 单个桌台购物车最多商品行数
+const maxTableCartItems = 100
+
+// TableCart 桌台共享购物车，同桌顾客共同加菜，下单后清空
+type TableCart struct {
+	TableID   uuid.UUID        `json:"table_id"`   // 桌台ID
+	Items     []*TableCartItem `json:"items"`      // 商品列表
+	UpdatedAt time.Time        `json:"updated_at"` // 最后修改时间
+}
+
+// TableCartAttr 购物车商品口味做法选择
+type TableCartAttr struct {
+	AttrItemID uuid.UUID `json:"attr_item_id"` // 口味做法项ID
+	Quantity   int       `json:"quantity"`     // 数量（默认 1）
+}
+
+// TableCartItem 购物车商品行
+type TableCartItem struct {
+	ID           uuid.UUID       `json:"id"`
+	ProductID    uuid.UUID       `json:"product_id"`    // 商品ID
+	ProductName  string          `json:"product_name"`  // 商品名称
+	SpecID       uuid.UUID       `json:"spec_id"`       // 规格ID
+	SpecName     string          `json:"spec_name"`     // 规格名称
+	Attrs        []TableCartAttr `json:"attrs"`         // 口味做法选择
+	AttrNames    []string        `json:"attr_names"`    // 口味做法名称
+	Price        decimal.Decimal `json:"price"`         // 加入时的单价（含做法加价，仅供展示，下单时重新计价）
+	Qty          int             `json:"qty"`           // 数量
+	Remark       string          `json:"remark"`        // 备注
+	CustomerID   uuid.UUID       `json:"customer_id"`   // 加菜顾客ID
+	CustomerName string          `json:"customer_name"` // 加菜顾客昵称
+	AddedAt      time.Time       `json:"added_at"`      // 加入时间
+}
+
+// NewTableCart 创建空购物车
+func NewTableCart(tableID uuid.UUID) *TableCart {
+	return &TableCart{
+		TableID: tableID,
+		Items:   []*TableCartItem{},
+	}
+}
+
+// sameSelection 是否为同一顾客的相同商品选择（规格、做法、备注均相同）
+func (item *TableCartItem) sameSelection(other *TableCartItem) bool {
+	return item.CustomerID == other.CustomerID &&
+		item.ProductID == other.ProductID &&
+		item.SpecID == other.SpecID &&
+		item.Remark == other.Remark &&
+		slices.Equal(item.Attrs, other.Attrs)
+}
+
+// AddItem 加入商品，同一顾客的相同选择合并数量
+func (c *TableCart) AddItem(item *TableCartItem, at time.Time) error {
+	if item.Qty <= 0 {
+		return ErrTableCartItemQtyInvalid
+	}
+	for _, existing := range c.Items {
+		if existing.sameSelection(item) {
+			existing.Qty += item.Qty
+			existing.Price = item.Price
+			c.UpdatedAt = at
+			return nil
+		}
+	}
+	if len(c.Items) >= maxTableCartItems {
+		return ErrTableCartFull
+	}
+	item.ID = uuid.New()
+	item.AddedAt = at
+	c.Items = append(c.Items, item)
+	c.UpdatedAt = at
+	return nil
+}
+
+// UpdateItemQty 修改商品数量，数量为 0 时移除
+func (c *TableCart) UpdateItemQty(id uuid.UUID, qty int, at time.Time) error {
+	if qty < 0 {
+		return ErrTableCartItemQtyInvalid
+	}
+	idx := slices.IndexFunc(c.Items, func(item *TableCartItem) bool { return item.ID == id })
+	if idx < 0 {
+		return ErrTableCartItemNotExists
+	}
+	if qty == 0 {
+		c.Items = slices.Delete(c.Items, idx, idx+1)
+	} else {
+		c.Items[idx].Qty = qty
+	}
+	c.UpdatedAt = at
+	return nil
+}
+
+// IsEmpty 购物车是否为空
+func (c *TableCart) IsEmpty() bool {
+	return len(c.Items) == 0
+}
+
+// TableCartStore 桌台购物车存储（Redis），调用方需持有 NewMutexCartKey 锁后再修改
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/table_cart_store.go -package=mock . TableCartStore
+type TableCartStore interface {
+	// Get 查询桌台购物车，不存在时返回空购物车
+	Get(ctx context.Context, tableID uuid.UUID) (*TableCart, error)
+	Save(ctx context.Context, cart *TableCart) error
+	Delete(ctx context.Context, tableID uuid.UUID) error
+}
+
+// ------------------------------------------------------------
+// 订单
+// ------------------------------------------------------------
+
+// NextBatchIndex 订单下一次下单的序号（加菜批次）
+func (o *Order) NextBatchIndex() int {
+	index := 0
+	for _, op := range o.OrderProducts {
+		index = max(index, op.Index)
+	}
+	return index + 1
+}
+
+// AddProducts 追加一批订单商品并累加商品小计与应收，调用方需随后重新计算会员价与促销优惠
+func (o *Order) AddProducts(products []OrderProduct) {
+	for _, op := range products {
+		o.Amount.ItemsSubtotal = o.Amount.ItemsSubtotal.Add(op.Subtotal)
+		o.Amount.AmountDue = o.Amount.AmountDue.Add(op.Subtotal)
+	}
+	o.OrderProducts = append(o.OrderProducts, products...)
+}
+
+// AddPlaceOrderLog 记录点餐操作日志
+func (o *Order) AddPlaceOrderLog(operator OrderOperator, products []OrderProduct, at time.Time) {
+	items := make([]PlaceOrderItem, 0, len(products))
+	for _, op := range products {
+		item := PlaceOrderItem{
+			ProductID:   op.ProductID,
+			ProductName: op.ProductName,
+			Qty:         op.Qty,
+		}
+		if len(op.SpecRelations) > 0 {
+			item.SpecName = op.SpecRelations[0].SpecName
+		}
+		for _, attr := range op.AttrRelations {
+			if attr.AttrItem != nil {
+				item.AttrNames = append(item.AttrNames, attr.AttrItem.Name)
+			}
+		}
+		items = append(items, item)
+	}
+	o.OperationLogs = append(o.OperationLogs, OrderOperationLog{
+		OperatedAt:    at,
+		Source:        o.Channel,
+		OperatorID:    operator.ID,
+		OperatorName:  operator.Name,
+		OperationType: OrderOperationTypePlaceOrder,
+		Content: map[string]interface{}{
+			"items": items,
+		},
+	})
+}
+
+// ------------------------------------------------------------
+// 仓储和用例接口
+// ------------------------------------------------------------
+
+// ScanOrderRuleRepository 扫码点餐规则仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/scan_order_rule_repository.go -package=mock . ScanOrderRuleRepository
+type ScanOrderRuleRepository interface {
+	StoreRuleRepository[*ScanOrderRule]
+}
+
+// ScanOrderRuleInteractor 扫码点餐规则用例接口，品牌后台维护默认规则，门店后台维护门店规则
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/scan_order_rule_interactor.go -package=mock . ScanOrderRuleInteractor
+type ScanOrderRuleInteractor interface {
+	// GetRule 查询当前用户所属层级生效的规则，门店未单独配置时返回品牌商默认规则
+	GetRule(ctx context.Context, user User) (*ScanOrderRule, error)
+	SaveRule(ctx context.Context, rule *ScanOrderRule, user User) error
+	// DeleteRule 删除门店规则，恢复使用品牌商默认规则
+	DeleteRule(ctx context.Context, user User) error
+}
+
+// ScanOrderTable 扫码解析出的桌台信息
+type ScanOrderTable struct {
+	TableID    uuid.UUID        `json:"table_id"`    // 桌台ID
+	TableName  string           `json:"table_name"`  // 桌台名称
+	Seats      int              `json:"seats"`       // 座位数
+	MerchantID uuid.UUID        `json:"merchant_id"` // 品牌商ID
+	StoreID    uuid.UUID        `json:"store_id"`    // 门店ID
+	StoreName  string           `json:"store_name"`  // 门店名称
+	PayMode    ScanOrderPayMode `json:"pay_mode"`    // 支付方式
+}
+
+// ScanOrderCartItemParams 加入购物车参数
+type ScanOrderCartItemParams struct {
+	ProductID uuid.UUID
+	SpecID    uuid.UUID // 为空时使用默认规格
+	Attrs     []TableCartAttr
+	Qty       int
+	Remark    string
+}
+
+// ScanOrderSubmitParams 提交购物车参数
+type ScanOrderSubmitParams struct {
+	GuestCount int
+	Remark     string
+}
+
+// ScanOrderInteractor 扫码点餐用例接口，桌台通过二维码令牌定位
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/scan_order_interactor.go -package=mock . ScanOrderInteractor
+type ScanOrderInteractor interface {
+	GetTable(ctx context.Context, token string) (*ScanOrderTable, error)
+	// ListMenus 查询桌台所在门店可扫码点餐的菜单
+	ListMenus(ctx context.Context, token string) (Menus, error)
+	GetCart(ctx context.Context, token string) (*TableCart, error)
+	AddCartItem(ctx context.Context, token string, params ScanOrderCartItemParams, customer *Customer) (*TableCart, error)
+	UpdateCartItem(ctx context.Context, token string, itemID uuid.UUID, qty int) (*TableCart, error)
+	ClearCart(ctx context.Context, token string) error
+	// Submit 提交购物车：前台结账时追加到桌台未结账订单，先付后吃时生成新订单
+	Submit(ctx context.Context, token string, params ScanOrderSubmitParams, customer *Customer) (*Order, error)
+}
@@ -7,12 +7,17 @@ const (
 	DailySequencePrefixPayNo                    = "seq:payment_no"
 	DailySequencePrefixStoreWithdrawNo          = "seq:store_withdraw_no"
 	DailySequencePrefixProfitDistributionBillNo = "seq:profit_distribution_bill_no" // 分账账单编号前缀
+	DailySequencePrefixMemberAccountTxnNo       = "seq:member_account_txn_no"       // 储值流水号前缀
+	DailySequencePrefixPickupNo                 = "seq:pickup_no"                   // 取餐号前缀
+	DailySequencePrefixWaitlistNo               = "seq:waitlist_no"                 // 排队号前缀
 )
 
 //go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/daily_sequence.go -package=mock . DailySequence
 type DailySequence interface {
 	Next(ctx context.Context, prefix string) (int64, error)
 	Current(ctx context.Context, prefix string) (int64, error)
+	// NextInRange 获取 [start, end] 范围内的下一个序列号，超过 end 后从 start 循环
+	NextInRange(ctx context.Context, prefix string, start, end int64) (int64, error)
 }
 
 const (
@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrSetMealSelectionProductInvalid    = errors.New("套餐商品无效")
+	ErrSetMealSelectionGroupMissing      = errors.New("套餐组未选择")
+	ErrSetMealSelectionGroupInvalid      = errors.New("套餐组不存在或重复选择")
+	ErrSetMealSelectionDetailInvalid     = errors.New("套餐组商品不存在或重复选择")
+	ErrSetMealSelectionFixedIncomplete   = errors.New("固定分组必须选择组内全部商品")
+	ErrSetMealSelectionOptionalCount     = errors.New("可选套餐组必须且只能选择一个商品")
+	ErrSetMealSelectionQuantityInvalid   = errors.New("套餐子商品数量与配置不一致")
+	ErrSetMealSelectionSubstituteInvalid = errors.New("替换商品不在备选商品范围内")
+	ErrSetMealSelectionSurchargeMismatch = errors.New("替换加价与配置不一致")
+)
+
+// ------------------------------------------------------------
+// 套餐选择校验
+// ------------------------------------------------------------
+
+// ValidateSelection 以当前套餐配置校验订单中的套餐选择
+//
+// 固定分组必须选择组内全部商品，可选套餐组必须且只能选择一个商品；
+// 子商品数量需与配置一致，替换商品必须在备选商品范围内且加价与配置一致。
+func (groups SetMealGroups) ValidateSelection(selected SetMealGroups) error {
+	configGroups := make(map[uuid.UUID]*SetMealGroup, len(groups))
+	for _, group := range groups {
+		configGroups[group.ID] = group
+	}
+
+	selectedGroups := make(map[uuid.UUID]bool, len(selected))
+	for _, sg := range selected {
+		if sg == nil {
+			return ErrSetMealSelectionGroupInvalid
+		}
+		group, ok := configGroups[sg.ID]
+		if !ok || selectedGroups[sg.ID] {
+			return ErrSetMealSelectionGroupInvalid
+		}
+		selectedGroups[sg.ID] = true
+
+		if err := group.validateSelectedDetails(sg.Details); err != nil {
+			return err
+		}
+	}
+
+	if len(selectedGroups) != len(configGroups) {
+		return ErrSetMealSelectionGroupMissing
+	}
+	return nil
+}
+
+func (group *SetMealGroup) validateSelectedDetails(selected []*SetMealDetail) error {
+	configDetails := make(map[uuid.UUID]*SetMealDetail, len(group.Details))
+	for _, detail := range group.Details {
+		configDetails[detail.ID] = detail
+	}
+
+	switch group.SelectionType {
+	case SetMealGroupSelectionTypeOptional:
+		if len(selected) != 1 {
+			return ErrSetMealSelectionOptionalCount
+		}
+	default:
+		if len(selected) != len(configDetails) {
+			return ErrSetMealSelectionFixedIncomplete
+		}
+	}
+
+	selectedDetails := make(map[uuid.UUID]bool, len(selected))
+	for _, sd := range selected {
+		if sd == nil {
+			return ErrSetMealSelectionDetailInvalid
+		}
+		detail, ok := configDetails[sd.ID]
+		if !ok || selectedDetails[sd.ID] || sd.ProductID != detail.ProductID {
+			return ErrSetMealSelectionDetailInvalid
+		}
+		selectedDetails[sd.ID] = true
+
+		if sd.Quantity != detail.Quantity {
+			return ErrSetMealSelectionQuantityInvalid
+		}
+
+		surcharge, err := detail.substituteSurcharge(sd.ServedProductID())
+		if err != nil {
+			return err
+		}
+		if !sd.Surcharge.Equal(surcharge) {
+			return ErrSetMealSelectionSurchargeMismatch
+		}
+	}
+	return nil
+}
+
+// substituteSurcharge 返回选择指定商品时的每份加价，未替换时为零
+func (d *SetMealDetail) substituteSurcharge(productID uuid.UUID) (decimal.Decimal, error) {
+	if productID == d.ProductID {
+		return decimal.Zero, nil
+	}
+	for _, id := range d.OptionalProductIDs {
+		if id == productID {
+			return d.OptionalSurcharges[id], nil
+		}
+	}
+	return decimal.Zero, ErrSetMealSelectionSubstituteInvalid
+}
+
+// SurchargeTotal 单份套餐的替换加价合计
+func (groups SetMealGroups) SurchargeTotal() decimal.Decimal {
+	total := decimal.Zero
+	for _, group := range groups {
+		for _, detail := range group.Details {
+			total = total.Add(detail.Surcharge.Mul(decimal.NewFromInt(int64(detail.Quantity))))
+		}
+	}
+	return total
+}
+
+// ------------------------------------------------------------
+// 套餐金额分摊
+// ------------------------------------------------------------
+
+// AllocateSetMealAmount 将套餐行金额按子商品权重分摊到各子商品
+//
+// 权重为子商品默认规格单价乘以数量：所有子商品均配置了预估成本价时使用成本价，否则使用基础价；
+// 权重全为零时按数量分摊。分摊结果保留两位小数，尾差计入最后一个子商品。
+func AllocateSetMealAmount(groups SetMealGroups, amount decimal.Decimal, products map[uuid.UUID]*Product) {
+	details := make([]*SetMealDetail, 0)
+	for _, group := range groups {
+		details = append(details, group.Details...)
+	}
+	if len(details) == 0 {
+		return
+	}
+
+	useCost := true
+	for _, detail := range details {
+		spec := products[detail.ServedProductID()].defaultSpecRelation()
+		if spec == nil || spec.EstimatedCostPrice == nil || !spec.EstimatedCostPrice.IsPositive() {
+			useCost = false
+			break
+		}
+	}
+
+	weights := make([]decimal.Decimal, len(details))
+	totalWeight := decimal.Zero
+	for i, detail := range details {
+		price := decimal.Zero
+		if spec := products[detail.ServedProductID()].defaultSpecRelation(); spec != nil {
+			price = spec.BasePrice
+			if useCost {
+				price = *spec.EstimatedCostPrice
+			}
+		}
+		weights[i] = price.Mul(decimal.NewFromInt(int64(detail.Quantity)))
+		totalWeight = totalWeight.Add(weights[i])
+	}
+	if !totalWeight.IsPositive() {
+		totalWeight = decimal.Zero
+		for i, detail := range details {
+			weights[i] = decimal.NewFromInt(int64(detail.Quantity))
+			totalWeight = totalWeight.Add(weights[i])
+		}
+	}
+
+	remaining := amount
+	for i, detail := range details {
+		if i == len(details)-1 {
+			detail.AllocatedAmount = remaining
+			break
+		}
+		detail.AllocatedAmount = amount.Mul(weights[i]).Div(totalWeight).Round(2)
+		remaining = remaining.Sub(detail.AllocatedAmount)
+	}
+}
+
+// defaultSpecRelation 返回商品的默认规格，未设置默认时返回第一个规格
+func (p *Product) defaultSpecRelation() *ProductSpecRelation {
+	if p == nil || len(p.SpecRelations) == 0 {
+		return nil
+	}
+	for _, rel := range p.SpecRelations {
+		if rel.IsDefault {
+			return rel
+		}
+	}
+	return p.SpecRelations[0]
+}
+
+// EffectiveStallID 返回商品实际的出品部门ID（继承分类时取分类的出品部门）
+func (p *Product) EffectiveStallID() uuid.UUID {
+	if p == nil {
+		return uuid.Nil
+	}
+	if !p.InheritStall {
+		return p.StallID
+	}
+	if p.Category == nil {
+		return uuid.Nil
+	}
+	if p.Category.InheritStall && p.Category.Parent != nil {
+		return p.Category.Parent.StallID
+	}
+	return p.Category.StallID
+}
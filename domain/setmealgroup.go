@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // ------------------------------------------------------------
@@ -18,6 +19,7 @@ var (
 	ErrSetMealGroupDetailInvalid           = errors.New("套餐组详情商品无效")
 	ErrSetMealGroupOptionalProductInvalid  = errors.New("备选商品无效")
 	ErrSetMealGroupOptionalProductConflict = errors.New("备选商品不能是当前套餐组详情中的商品")
+	ErrSetMealGroupSurchargeInvalid        = errors.New("备选商品加价无效")
 )
 
 // ------------------------------------------------------------
@@ -61,20 +63,37 @@ type SetMealGroups []*SetMealGroup
 
 // SetMealDetail 套餐组详情
 type SetMealDetail struct {
-	ID                 uuid.UUID   `json:"id"`                             // 详情ID
-	GroupID            uuid.UUID   `json:"group_id"`                       // 套餐组ID（外键）
-	ProductID          uuid.UUID   `json:"product_id"`                     // 商品ID（外键，引用普通商品）
-	Quantity           int         `json:"quantity"`                       // 数量（必选，必须为正整数）
-	IsDefault          bool        `json:"is_default"`                     // 是否默认（必选，每个套餐组中只能有一个默认项）
-	OptionalProductIDs []uuid.UUID `json:"optional_product_ids,omitempty"` // 备选商品ID列表（可选，多选）
-	CreatedAt          time.Time   `json:"created_at"`                     // 创建时间
-	UpdatedAt          time.Time   `json:"updated_at"`                     // 更新时间
+	ID                 uuid.UUID                     `json:"id"`                             // 详情ID
+	GroupID            uuid.UUID                     `json:"group_id"`                       // 套餐组ID（外键）
+	ProductID          uuid.UUID                     `json:"product_id"`                     // 商品ID（外键，引用普通商品）
+	Quantity           int                           `json:"quantity"`                       // 数量（必选，必须为正整数）
+	IsDefault          bool                          `json:"is_default"`                     // 是否默认（必选，每个套餐组中只能有一个默认项）
+	OptionalProductIDs []uuid.UUID                   `json:"optional_product_ids,omitempty"` // 备选商品ID列表（可选，多选）
+	OptionalSurcharges map[uuid.UUID]decimal.Decimal `json:"optional_surcharges,omitempty"`  // 备选商品加价（备选商品ID -> 每份加价金额，未配置表示不加价）
+	CreatedAt          time.Time                     `json:"created_at"`                     // 创建时间
+	UpdatedAt          time.Time                     `json:"updated_at"`                     // 更新时间
+
+	// 下单信息（仅订单商品的套餐组快照使用）
+	SelectedProductID       uuid.UUID       `json:"selected_product_id,omitempty"`        // 实际选择的商品ID（替换为备选商品时为备选商品ID，为空表示 ProductID）
+	SelectedProductName     string          `json:"selected_product_name,omitempty"`      // 实际选择的商品名称
+	SelectedProductNameI18n LocalizedText   `json:"selected_product_name_i18n,omitempty"` // 实际选择的商品名称翻译
+	Surcharge               decimal.Decimal `json:"surcharge"`                            // 替换加价（每份）
+	AllocatedAmount         decimal.Decimal `json:"allocated_amount"`                     // 套餐金额分摊到该子商品的金额（整行）
+	StallID                 uuid.UUID       `json:"stall_id,omitempty"`                   // 出品部门ID
 
 	// 关联信息
 	Product          *Product `json:"product,omitempty"`           // 关联商品
 	OptionalProducts Products `json:"optional_products,omitempty"` // 备选商品
 }
 
+// ServedProductID 返回实际出品的商品ID
+func (d *SetMealDetail) ServedProductID() uuid.UUID {
+	if d.SelectedProductID != uuid.Nil {
+		return d.SelectedProductID
+	}
+	return d.ProductID
+}
+
 // SetMealDetails 套餐组详情集合
 type SetMealDetails []*SetMealDetail
 
@@ -92,4 +111,5 @@ type SetMealGroupRepository interface {
 	// 套餐组详情相关操作
 	CreateDetails(ctx context.Context, details []*SetMealDetail) error
 	CheckProductBelongToSetMeal(ctx context.Context, productID uuid.UUID) (bool, error)
+	ListSetMealIDsByProductID(ctx context.Context, productID uuid.UUID) ([]uuid.UUID, error)
 }
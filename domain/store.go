@@ -3,6 +3,8 @@ package domain
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,7 +17,7 @@ var (
 	ErrStoreBusinessHoursConflict    = errors.New("门店营业时间段时间重复")
 	ErrStoreBusinessHoursTimeInvalid = errors.New("门店营业时间段开始时间需早于结束时间")
 	ErrStoreDiningPeriodConflict     = errors.New("门店就餐时段时间重复")
-	ErrStoreDiningPeriodTimeInvalid  = errors.New("门店就餐时段开始时间需早于结束时间")
+	ErrStoreDiningPeriodTimeInvalid  = errors.New("门店就餐时段开始时间不能与结束时间相同")
 	ErrStoreDiningPeriodNameExists   = errors.New("门店就餐时段名称已存在")
 	ErrStoreShiftTimeConflict        = errors.New("门店班次时间重复")
 	ErrStoreShiftTimeTimeInvalid     = errors.New("门店班次开始时间需早于结束时间")
@@ -258,11 +260,38 @@ type BusinessHour struct {
 	EndTime   string `json:"end_time"`   // 结束时间，格式 HH:MM:SS
 }
 
+// BusinessHoursOn 返回指定星期的营业时间段，按开始时间升序
+func BusinessHoursOn(hours []BusinessHours, weekday time.Weekday) []BusinessHour {
+	var res []BusinessHour
+	for _, h := range hours {
+		if slices.Contains(h.Weekdays, weekday) {
+			res = append(res, h.BusinessHours...)
+		}
+	}
+	slices.SortFunc(res, func(a, b BusinessHour) int {
+		return strings.Compare(a.StartTime, b.StartTime)
+	})
+	return res
+}
+
 // DiningPeriod 表示用餐时段
 type DiningPeriod struct {
 	Name      string `json:"name"`       // 名称，如“用餐时段1”
 	StartTime string `json:"start_time"` // 开始时间，格式 HH:MM:SS
-	EndTime   string `json:"end_time"`   // 结束时间，格式 HH:MM:SS
+	EndTime   string `json:"end_time"`   // 结束时间，格式 HH:MM:SS，早于开始时间表示结束于次日
+}
+
+// CrossesMidnight 时段是否跨零点，如 22:00:00-02:00:00
+func (p DiningPeriod) CrossesMidnight() bool {
+	return p.EndTime < p.StartTime
+}
+
+// Contains 指定时刻（HH:MM:SS）是否在时段内，时段为左闭右开区间
+func (p DiningPeriod) Contains(clock string) bool {
+	if p.CrossesMidnight() {
+		return p.StartTime <= clock || clock < p.EndTime
+	}
+	return p.StartTime <= clock && clock < p.EndTime
 }
 
 // ShiftTime 表示班次时间
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StoreRuleMeta 门店规则的归属与时间信息，每个门店一条规则，门店ID为空表示品牌商默认规则
+type StoreRuleMeta struct {
+	ID         uuid.UUID `json:"id"`
+	MerchantID uuid.UUID `json:"merchant_id"` // 品牌商ID
+	StoreID    uuid.UUID `json:"store_id"`    // 门店ID，为空表示品牌商默认规则
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Meta 规则的归属与时间信息
+func (m *StoreRuleMeta) Meta() *StoreRuleMeta {
+	return m
+}
+
+// StoreRule 按门店维护的规则，如扫码点餐、取餐号、预订、排队与预点单规则
+type StoreRule interface {
+	Meta() *StoreRuleMeta
+	// Validate 校验规则内容
+	Validate() error
+}
+
+// StoreRuleRepository 门店规则仓储接口
+type StoreRuleRepository[R StoreRule] interface {
+	Create(ctx context.Context, rule R) error
+	Update(ctx context.Context, rule R) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// FindByStore 查询门店规则，storeID 为空时查询品牌商默认规则
+	FindByStore(ctx context.Context, merchantID, storeID uuid.UUID) (R, error)
+}
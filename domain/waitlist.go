@@ -0,0 +1,428 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ------------------------------------------------------------
+// 错误定义
+// ------------------------------------------------------------
+
+var (
+	ErrWaitlistRuleNotExists        = errors.New("排队规则不存在")
+	ErrWaitlistRuleBucketInvalid    = errors.New("小桌、中桌人数上限需递增，且不超过单次取号人数上限")
+	ErrWaitlistRulePartySizeInvalid = errors.New("单次取号人数上限需在1-100之间")
+	ErrWaitlistRuleTurnInvalid      = errors.New("默认翻台时长需在10-480分钟之间")
+	ErrWaitlistTicketNotExists      = errors.New("排队号不存在")
+	ErrWaitlistPartySizeInvalid     = errors.New("用餐人数超出可取号范围")
+	ErrWaitlistOnlineDisabled       = errors.New("门店暂未开放扫码取号")
+	ErrWaitlistStoreClosed          = errors.New("门店已停业")
+	ErrWaitlistTicketExists         = errors.New("您在该门店已有排队中的号码")
+	ErrWaitlistStatusInvalid        = errors.New("排队号当前状态不允许该操作")
+	ErrWaitlistTableInvalid         = errors.New("桌台不存在、已停用或座位数不足")
+	ErrWaitlistTableOccupied        = errors.New("桌台有未结账订单，请选择其他桌台")
+	ErrWaitlistBusy                 = errors.New("门店排队正在处理中，请稍后再试")
+)
+
+// ------------------------------------------------------------
+// 排队规则
+// ------------------------------------------------------------
+
+// WaitlistRule 门店排队规则，按用餐人数分为小桌、中桌、大桌三组分别取号
+type WaitlistRule struct {
+	StoreRuleMeta
+	OnlineEnabled      bool `json:"online_enabled"`       // 是否开放顾客扫码取号
+	SmallMaxSize       int  `json:"small_max_size"`       // 小桌人数上限
+	MediumMaxSize      int  `json:"medium_max_size"`      // 中桌人数上限，超过为大桌
+	MaxPartySize       int  `json:"max_party_size"`       // 单次取号人数上限
+	DefaultTurnMinutes int  `json:"default_turn_minutes"` // 默认翻台时长（分钟），近期无翻台记录时用于预估等待时间
+}
+
+// DefaultWaitlistRule 未配置规则时的默认规则：1-2人小桌，3-4人中桌，5人及以上大桌，默认翻台45分钟
+func DefaultWaitlistRule(merchantID, storeID uuid.UUID) *WaitlistRule {
+	return &WaitlistRule{
+		StoreRuleMeta:      StoreRuleMeta{MerchantID: merchantID, StoreID: storeID},
+		SmallMaxSize:       2,
+		MediumMaxSize:      4,
+		MaxPartySize:       20,
+		DefaultTurnMinutes: 45,
+	}
+}
+
+func (r *WaitlistRule) Validate() error {
+	if r.MaxPartySize < 1 || r.MaxPartySize > 100 {
+		return ErrWaitlistRulePartySizeInvalid
+	}
+	if r.SmallMaxSize < 1 || r.MediumMaxSize <= r.SmallMaxSize || r.MediumMaxSize >= r.MaxPartySize {
+		return ErrWaitlistRuleBucketInvalid
+	}
+	if r.DefaultTurnMinutes < 10 || r.DefaultTurnMinutes > 480 {
+		return ErrWaitlistRuleTurnInvalid
+	}
+	return nil
+}
+
+// Bucket 按人数确定分组，桌台按座位数使用相同的分组
+func (r *WaitlistRule) Bucket(size int) WaitlistBucket {
+	switch {
+	case size <= r.SmallMaxSize:
+		return WaitlistBucketSmall
+	case size <= r.MediumMaxSize:
+		return WaitlistBucketMedium
+	default:
+		return WaitlistBucketLarge
+	}
+}
+
+// SizeRange 分组的人数范围
+func (r *WaitlistRule) SizeRange(bucket WaitlistBucket) (minSize, maxSize int) {
+	switch bucket {
+	case WaitlistBucketSmall:
+		return 1, r.SmallMaxSize
+	case WaitlistBucketMedium:
+		return r.SmallMaxSize + 1, r.MediumMaxSize
+	default:
+		return r.MediumMaxSize + 1, r.MaxPartySize
+	}
+}
+
+// WaitlistRuleRepository 排队规则仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/waitlist_rule_repository.go -package=mock . WaitlistRuleRepository
+type WaitlistRuleRepository interface {
+	StoreRuleRepository[*WaitlistRule]
+}
+
+// WaitlistRuleInteractor 排队规则用例接口（门店后台）
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/waitlist_rule_interactor.go -package=mock . WaitlistRuleInteractor
+type WaitlistRuleInteractor interface {
+	// GetRule 查询门店排队规则，未配置时返回默认规则
+	GetRule(ctx context.Context, user User) (*WaitlistRule, error)
+	SaveRule(ctx context.Context, rule *WaitlistRule, user User) error
+}
+
+// ------------------------------------------------------------
+// 排队号
+// ------------------------------------------------------------
+
+// WaitlistBucket 排队分组
+type WaitlistBucket string
+
+const (
+	WaitlistBucketSmall  WaitlistBucket = "small"  // 小桌
+	WaitlistBucketMedium WaitlistBucket = "medium" // 中桌
+	WaitlistBucketLarge  WaitlistBucket = "large"  // 大桌
+)
+
+func (WaitlistBucket) Values() []string {
+	return []string{
+		string(WaitlistBucketSmall),
+		string(WaitlistBucketMedium),
+		string(WaitlistBucketLarge),
+	}
+}
+
+// WaitlistBuckets 全部分组，按桌型从小到大
+var WaitlistBuckets = []WaitlistBucket{WaitlistBucketSmall, WaitlistBucketMedium, WaitlistBucketLarge}
+
+// Prefix 分组号码前缀：小桌 A、中桌 B、大桌 C
+func (b WaitlistBucket) Prefix() string {
+	switch b {
+	case WaitlistBucketSmall:
+		return "A"
+	case WaitlistBucketMedium:
+		return "B"
+	default:
+		return "C"
+	}
+}
+
+// FormatTicketNo 格式化排队号：分组前缀 + 3位当日序号
+func (b WaitlistBucket) FormatTicketNo(n int64) string {
+	return fmt.Sprintf("%s%03d", b.Prefix(), n)
+}
+
+// WaitlistSequencePrefix 门店营业日分组排队号序列前缀
+func WaitlistSequencePrefix(storeID uuid.UUID, businessDate string, bucket WaitlistBucket) string {
+	return fmt.Sprintf("%s:%s:%s:%s", DailySequencePrefixWaitlistNo, storeID, businessDate, bucket)
+}
+
+// WaitlistTicketStatus 排队号状态
+type WaitlistTicketStatus string
+
+const (
+	WaitlistTicketStatusWaiting   WaitlistTicketStatus = "waiting"   // 等待中
+	WaitlistTicketStatusCalled    WaitlistTicketStatus = "called"    // 已叫号
+	WaitlistTicketStatusSeated    WaitlistTicketStatus = "seated"    // 已入座
+	WaitlistTicketStatusSkipped   WaitlistTicketStatus = "skipped"   // 已过号
+	WaitlistTicketStatusCancelled WaitlistTicketStatus = "cancelled" // 已取消
+)
+
+func (WaitlistTicketStatus) Values() []string {
+	return []string{
+		string(WaitlistTicketStatusWaiting),
+		string(WaitlistTicketStatusCalled),
+		string(WaitlistTicketStatusSeated),
+		string(WaitlistTicketStatusSkipped),
+		string(WaitlistTicketStatusCancelled),
+	}
+}
+
+// WaitlistActiveStatuses 仍在排队的状态
+var WaitlistActiveStatuses = []WaitlistTicketStatus{WaitlistTicketStatusWaiting, WaitlistTicketStatusCalled}
+
+// WaitlistTicketSource 取号来源
+type WaitlistTicketSource string
+
+const (
+	WaitlistTicketSourceStore  WaitlistTicketSource = "store"  // 门店 POS 取号
+	WaitlistTicketSourceOnline WaitlistTicketSource = "online" // 顾客扫码取号
+)
+
+func (WaitlistTicketSource) Values() []string {
+	return []string{
+		string(WaitlistTicketSourceStore),
+		string(WaitlistTicketSourceOnline),
+	}
+}
+
+// WaitlistTicket 排队号
+type WaitlistTicket struct {
+	ID           uuid.UUID            `json:"id"`
+	MerchantID   uuid.UUID            `json:"merchant_id"`   // 品牌商ID
+	StoreID      uuid.UUID            `json:"store_id"`      // 门店ID
+	BusinessDate string               `json:"business_date"` // 营业日
+	Bucket       WaitlistBucket       `json:"bucket"`        // 分组
+	TicketNo     string               `json:"ticket_no"`     // 排队号
+	PartySize    int                  `json:"party_size"`    // 用餐人数
+	GuestPhone   string               `json:"guest_phone"`   // 顾客手机号
+	Source       WaitlistTicketSource `json:"source"`        // 取号来源
+	CustomerID   uuid.UUID            `json:"customer_id"`   // 扫码取号的顾客ID
+	Status       WaitlistTicketStatus `json:"status"`        // 状态
+	CallCount    int                  `json:"call_count"`    // 叫号次数
+	CalledAt     *time.Time           `json:"called_at"`     // 最近叫号时间
+	SeatedAt     *time.Time           `json:"seated_at"`     // 入座时间
+	CancelledAt  *time.Time           `json:"cancelled_at"`  // 取消或过号时间
+	TableID      uuid.UUID            `json:"table_id"`      // 入座桌台ID
+	TableName    string               `json:"table_name"`    // 入座桌台名称
+	OrderID      uuid.UUID            `json:"order_id"`      // 入座后生成的订单ID
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
+
+// Active 是否仍在排队
+func (t *WaitlistTicket) Active() bool {
+	return t.Status == WaitlistTicketStatusWaiting || t.Status == WaitlistTicketStatusCalled
+}
+
+// Call 叫号，可重复叫号
+func (t *WaitlistTicket) Call(now time.Time) error {
+	if !t.Active() {
+		return ErrWaitlistStatusInvalid
+	}
+	t.Status = WaitlistTicketStatusCalled
+	t.CallCount++
+	t.CalledAt = &now
+	return nil
+}
+
+// Skip 过号
+func (t *WaitlistTicket) Skip(now time.Time) error {
+	if !t.Active() {
+		return ErrWaitlistStatusInvalid
+	}
+	t.Status = WaitlistTicketStatusSkipped
+	t.CancelledAt = &now
+	return nil
+}
+
+// Cancel 取消排队
+func (t *WaitlistTicket) Cancel(now time.Time) error {
+	if !t.Active() {
+		return ErrWaitlistStatusInvalid
+	}
+	t.Status = WaitlistTicketStatusCancelled
+	t.CancelledAt = &now
+	return nil
+}
+
+// Seat 安排入座
+func (t *WaitlistTicket) Seat(table *DiningTable, orderID uuid.UUID, now time.Time) error {
+	if !t.Active() {
+		return ErrWaitlistStatusInvalid
+	}
+	t.Status = WaitlistTicketStatusSeated
+	t.TableID = table.ID
+	t.TableName = table.Name
+	t.OrderID = orderID
+	t.SeatedAt = &now
+	return nil
+}
+
+// WaitlistTicketListParams 排队号查询参数
+type WaitlistTicketListParams struct {
+	StoreID      uuid.UUID
+	CustomerID   uuid.UUID
+	BusinessDate string
+	Bucket       WaitlistBucket
+	Statuses     []WaitlistTicketStatus
+}
+
+// WaitlistTicketRepository 排队号仓储接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/waitlist_ticket_repository.go -package=mock . WaitlistTicketRepository
+type WaitlistTicketRepository interface {
+	Create(ctx context.Context, ticket *WaitlistTicket) error
+	// Update 更新状态、叫号、入座及取消信息
+	Update(ctx context.Context, ticket *WaitlistTicket) error
+	FindByID(ctx context.Context, id uuid.UUID) (*WaitlistTicket, error)
+	// List 按取号时间升序查询
+	List(ctx context.Context, params WaitlistTicketListParams) ([]*WaitlistTicket, error)
+}
+
+// ------------------------------------------------------------
+// 等待时间预估
+// ------------------------------------------------------------
+
+// WaitlistTurnover 分组桌台的翻台情况
+type WaitlistTurnover struct {
+	Tables      int // 分组内启用的桌台数
+	TurnMinutes int // 平均翻台时长（分钟）
+}
+
+// EstimateMinutes 预估排在第 position 位（从1开始）的等待分钟数：每轮翻台可入座的桌数等于分组桌台数
+func (t WaitlistTurnover) EstimateMinutes(position int) int {
+	if position <= 0 {
+		return 0
+	}
+	tables := max(t.Tables, 1)
+	return int(math.Ceil(float64(position) * float64(t.TurnMinutes) / float64(tables)))
+}
+
+// WaitlistTurnovers 按分组统计桌台数和近期平均翻台时长，无翻台记录的分组使用规则的默认翻台时长
+func WaitlistTurnovers(rule *WaitlistRule, tables []*DiningTable, turns []*TableTurn) map[WaitlistBucket]WaitlistTurnover {
+	tableBuckets := make(map[uuid.UUID]WaitlistBucket, len(tables))
+	res := make(map[WaitlistBucket]WaitlistTurnover, len(WaitlistBuckets))
+	for _, t := range tables {
+		if !t.Enabled {
+			continue
+		}
+		bucket := rule.Bucket(t.Seats)
+		tableBuckets[t.ID] = bucket
+		stat := res[bucket]
+		stat.Tables++
+		res[bucket] = stat
+	}
+
+	total := make(map[WaitlistBucket]time.Duration)
+	count := make(map[WaitlistBucket]int)
+	for _, turn := range turns {
+		bucket, ok := tableBuckets[turn.TableID]
+		if !ok || !turn.PaidAt.After(turn.PlacedAt) {
+			continue
+		}
+		total[bucket] += turn.PaidAt.Sub(turn.PlacedAt)
+		count[bucket]++
+	}
+
+	for _, bucket := range WaitlistBuckets {
+		stat := res[bucket]
+		stat.TurnMinutes = rule.DefaultTurnMinutes
+		if count[bucket] > 0 {
+			stat.TurnMinutes = int(math.Ceil((total[bucket] / time.Duration(count[bucket])).Minutes()))
+		}
+		res[bucket] = stat
+	}
+	return res
+}
+
+// WaitlistQueueBucket 分组排队概况
+type WaitlistQueueBucket struct {
+	Bucket           WaitlistBucket `json:"bucket"`            // 分组
+	MinSize          int            `json:"min_size"`          // 人数下限
+	MaxSize          int            `json:"max_size"`          // 人数上限
+	Waiting          int            `json:"waiting"`           // 排队中的桌数
+	EstimatedMinutes int            `json:"estimated_minutes"` // 现在取号的预计等待分钟数
+	CalledTicketNo   string         `json:"called_ticket_no"`  // 最近叫到的号码
+}
+
+// WaitlistQueue 门店排队概况
+type WaitlistQueue struct {
+	StoreID       uuid.UUID              `json:"store_id"`       // 门店ID
+	StoreName     string                 `json:"store_name"`     // 门店名称
+	OnlineEnabled bool                   `json:"online_enabled"` // 是否开放扫码取号
+	Buckets       []*WaitlistQueueBucket `json:"buckets"`        // 分组排队概况
+}
+
+// WaitlistTicketView 顾客端排队状态，不含手机号
+type WaitlistTicketView struct {
+	ID               uuid.UUID            `json:"id"`
+	StoreID          uuid.UUID            `json:"store_id"`          // 门店ID
+	StoreName        string               `json:"store_name"`        // 门店名称
+	Bucket           WaitlistBucket       `json:"bucket"`            // 分组
+	TicketNo         string               `json:"ticket_no"`         // 排队号
+	PartySize        int                  `json:"party_size"`        // 用餐人数
+	Status           WaitlistTicketStatus `json:"status"`            // 状态
+	Ahead            int                  `json:"ahead"`             // 前面还有几桌
+	EstimatedMinutes int                  `json:"estimated_minutes"` // 预计等待分钟数
+	CalledAt         *time.Time           `json:"called_at"`         // 最近叫号时间
+	TableName        string               `json:"table_name"`        // 入座桌台名称
+	CreatedAt        time.Time            `json:"created_at"`        // 取号时间
+}
+
+// WaitlistTicketPush 排队号变更推送内容，推送到门店排队主题供顾客端刷新，不含手机号
+type WaitlistTicketPush struct {
+	ID       uuid.UUID            `json:"id"`
+	Bucket   WaitlistBucket       `json:"bucket"`    // 分组
+	TicketNo string               `json:"ticket_no"` // 排队号
+	Status   WaitlistTicketStatus `json:"status"`    // 状态
+}
+
+func NewWaitlistTicketPush(t *WaitlistTicket) *WaitlistTicketPush {
+	return &WaitlistTicketPush{
+		ID:       t.ID,
+		Bucket:   t.Bucket,
+		TicketNo: t.TicketNo,
+		Status:   t.Status,
+	}
+}
+
+// WaitlistTakeParams 取号参数
+type WaitlistTakeParams struct {
+	MerchantID uuid.UUID // 品牌商ID，顾客扫码取号时为空
+	StoreID    uuid.UUID
+	Customer   *Customer // 扫码取号的顾客，为空表示门店 POS 取号
+	PartySize  int
+	GuestPhone string // 顾客手机号，扫码取号为空时使用已绑定的手机号
+}
+
+// WaitlistInteractor 排队用例接口：POS 取号、叫号、过号与入座，顾客扫码取号与查看排队状态
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/waitlist_interactor.go -package=mock . WaitlistInteractor
+type WaitlistInteractor interface {
+	// Queue 门店当日排队概况，merchantID 为空时为顾客端查询
+	Queue(ctx context.Context, merchantID, storeID uuid.UUID) (*WaitlistQueue, error)
+	// Take 取号
+	Take(ctx context.Context, params WaitlistTakeParams) (*WaitlistTicket, error)
+	// List 查询门店指定营业日的排队号
+	List(ctx context.Context, merchantID, storeID uuid.UUID, businessDate string, statuses []WaitlistTicketStatus) ([]*WaitlistTicket, error)
+	Call(ctx context.Context, merchantID, storeID, id uuid.UUID) (*WaitlistTicket, error)
+	Skip(ctx context.Context, merchantID, storeID, id uuid.UUID) (*WaitlistTicket, error)
+	Cancel(ctx context.Context, merchantID, storeID, id uuid.UUID) (*WaitlistTicket, error)
+	// Seat 安排入座：在桌台开一笔堂食订单
+	Seat(ctx context.Context, merchantID, storeID, id, tableID uuid.UUID) (*WaitlistTicket, error)
+
+	// GetStatus 顾客查看排队状态
+	GetStatus(ctx context.Context, id uuid.UUID) (*WaitlistTicketView, error)
+	// ListByCustomer 顾客查询自己当天的排队号
+	ListByCustomer(ctx context.Context, customerID uuid.UUID) ([]*WaitlistTicketView, error)
+	// CancelByCustomer 顾客取消自己的排队号
+	CancelByCustomer(ctx context.Context, customerID, id uuid.UUID) (*WaitlistTicketView, error)
+}
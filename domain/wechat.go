@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrWechatLoginCodeInvalid = errors.New("微信登录凭证无效或已过期")
+	ErrWechatPhoneCodeInvalid = errors.New("微信手机号凭证无效或已过期")
+)
+
+// WechatSession 小程序登录凭证校验结果
+type WechatSession struct {
+	OpenID     string // 用户在小程序的唯一标识
+	UnionID    string // 用户在开放平台的唯一标识（满足下发条件时返回）
+	SessionKey string // 会话密钥
+}
+
+// WechatMiniProgram 微信小程序服务接口
+//
+//go:generate go run -mod=mod github.com/golang/mock/mockgen -destination=mock/wechat_mini_program.go -package=mock . WechatMiniProgram
+type WechatMiniProgram interface {
+	// Code2Session 使用 wx.login 获得的 code 换取 openid 等登录信息，code 无效时返回 ErrWechatLoginCodeInvalid
+	Code2Session(ctx context.Context, code string) (*WechatSession, error)
+	// GetPhoneNumber 使用手机号快速验证组件获得的 code 换取用户手机号，code 无效时返回 ErrWechatPhoneCodeInvalid
+	GetPhoneNumber(ctx context.Context, code string) (string, error)
+}
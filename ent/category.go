@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/category"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/stall"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/taxfee"
@@ -29,6 +31,8 @@ type Category struct {
 	DeletedAt int64 `json:"deleted_at,omitempty"`
 	// 分类名称
 	Name string `json:"name,omitempty"`
+	// 分类名称翻译（按语言）
+	NameI18n domain.LocalizedText `json:"name_i18n,omitempty"`
 	// 品牌商ID
 	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
 	// 门店ID
@@ -128,6 +132,8 @@ func (*Category) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case category.FieldNameI18n:
+			values[i] = new([]byte)
 		case category.FieldInheritTaxRate, category.FieldInheritStall:
 			values[i] = new(sql.NullBool)
 		case category.FieldDeletedAt, category.FieldProductCount, category.FieldSortOrder:
@@ -187,6 +193,14 @@ func (c *Category) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.Name = value.String
 			}
+		case category.FieldNameI18n:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field name_i18n", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.NameI18n); err != nil {
+					return fmt.Errorf("unmarshal field name_i18n: %w", err)
+				}
+			}
 		case category.FieldMerchantID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
@@ -328,6 +342,9 @@ func (c *Category) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(c.Name)
 	builder.WriteString(", ")
+	builder.WriteString("name_i18n=")
+	builder.WriteString(fmt.Sprintf("%v", c.NameI18n))
+	builder.WriteString(", ")
 	builder.WriteString("merchant_id=")
 	builder.WriteString(fmt.Sprintf("%v", c.MerchantID))
 	builder.WriteString(", ")
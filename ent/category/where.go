@@ -306,6 +306,16 @@ func NameContainsFold(v string) predicate.Category {
 	return predicate.Category(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameI18nIsNil applies the IsNil predicate on the "name_i18n" field.
+func NameI18nIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldNameI18n))
+}
+
+// NameI18nNotNil applies the NotNil predicate on the "name_i18n" field.
+func NameI18nNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldNameI18n))
+}
+
 // MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
 func MerchantIDEQ(v uuid.UUID) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldMerchantID, v))
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/category"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/stall"
@@ -75,6 +76,12 @@ func (cc *CategoryCreate) SetName(s string) *CategoryCreate {
 	return cc
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (cc *CategoryCreate) SetNameI18n(dt domain.LocalizedText) *CategoryCreate {
+	cc.mutation.SetNameI18n(dt)
+	return cc
+}
+
 // SetMerchantID sets the "merchant_id" field.
 func (cc *CategoryCreate) SetMerchantID(u uuid.UUID) *CategoryCreate {
 	cc.mutation.SetMerchantID(u)
@@ -442,6 +449,10 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := cc.mutation.NameI18n(); ok {
+		_spec.SetField(category.FieldNameI18n, field.TypeJSON, value)
+		_node.NameI18n = value
+	}
 	if value, ok := cc.mutation.MerchantID(); ok {
 		_spec.SetField(category.FieldMerchantID, field.TypeUUID, value)
 		_node.MerchantID = value
@@ -643,6 +654,24 @@ func (u *CategoryUpsert) UpdateName() *CategoryUpsert {
 	return u
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *CategoryUpsert) SetNameI18n(v domain.LocalizedText) *CategoryUpsert {
+	u.Set(category.FieldNameI18n, v)
+	return u
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateNameI18n() *CategoryUpsert {
+	u.SetExcluded(category.FieldNameI18n)
+	return u
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *CategoryUpsert) ClearNameI18n() *CategoryUpsert {
+	u.SetNull(category.FieldNameI18n)
+	return u
+}
+
 // SetParentID sets the "parent_id" field.
 func (u *CategoryUpsert) SetParentID(v uuid.UUID) *CategoryUpsert {
 	u.Set(category.FieldParentID, v)
@@ -857,6 +886,27 @@ func (u *CategoryUpsertOne) UpdateName() *CategoryUpsertOne {
 	})
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *CategoryUpsertOne) SetNameI18n(v domain.LocalizedText) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetNameI18n(v)
+	})
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateNameI18n() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateNameI18n()
+	})
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *CategoryUpsertOne) ClearNameI18n() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearNameI18n()
+	})
+}
+
 // SetParentID sets the "parent_id" field.
 func (u *CategoryUpsertOne) SetParentID(v uuid.UUID) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
@@ -1256,6 +1306,27 @@ func (u *CategoryUpsertBulk) UpdateName() *CategoryUpsertBulk {
 	})
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *CategoryUpsertBulk) SetNameI18n(v domain.LocalizedText) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetNameI18n(v)
+	})
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateNameI18n() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateNameI18n()
+	})
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *CategoryUpsertBulk) ClearNameI18n() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearNameI18n()
+	})
+}
+
 // SetParentID sets the "parent_id" field.
 func (u *CategoryUpsertBulk) SetParentID(v uuid.UUID) *CategoryUpsertBulk {
 	return u.Update(func(s *CategoryUpsert) {
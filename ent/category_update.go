@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/category"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
@@ -74,6 +75,18 @@ func (cu *CategoryUpdate) SetNillableName(s *string) *CategoryUpdate {
 	return cu
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (cu *CategoryUpdate) SetNameI18n(dt domain.LocalizedText) *CategoryUpdate {
+	cu.mutation.SetNameI18n(dt)
+	return cu
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (cu *CategoryUpdate) ClearNameI18n() *CategoryUpdate {
+	cu.mutation.ClearNameI18n()
+	return cu
+}
+
 // SetParentID sets the "parent_id" field.
 func (cu *CategoryUpdate) SetParentID(u uuid.UUID) *CategoryUpdate {
 	cu.mutation.SetParentID(u)
@@ -393,6 +406,12 @@ func (cu *CategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.Name(); ok {
 		_spec.SetField(category.FieldName, field.TypeString, value)
 	}
+	if value, ok := cu.mutation.NameI18n(); ok {
+		_spec.SetField(category.FieldNameI18n, field.TypeJSON, value)
+	}
+	if cu.mutation.NameI18nCleared() {
+		_spec.ClearField(category.FieldNameI18n, field.TypeJSON)
+	}
 	if value, ok := cu.mutation.InheritTaxRate(); ok {
 		_spec.SetField(category.FieldInheritTaxRate, field.TypeBool, value)
 	}
@@ -651,6 +670,18 @@ func (cuo *CategoryUpdateOne) SetNillableName(s *string) *CategoryUpdateOne {
 	return cuo
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (cuo *CategoryUpdateOne) SetNameI18n(dt domain.LocalizedText) *CategoryUpdateOne {
+	cuo.mutation.SetNameI18n(dt)
+	return cuo
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (cuo *CategoryUpdateOne) ClearNameI18n() *CategoryUpdateOne {
+	cuo.mutation.ClearNameI18n()
+	return cuo
+}
+
 // SetParentID sets the "parent_id" field.
 func (cuo *CategoryUpdateOne) SetParentID(u uuid.UUID) *CategoryUpdateOne {
 	cuo.mutation.SetParentID(u)
@@ -1000,6 +1031,12 @@ func (cuo *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if value, ok := cuo.mutation.Name(); ok {
 		_spec.SetField(category.FieldName, field.TypeString, value)
 	}
+	if value, ok := cuo.mutation.NameI18n(); ok {
+		_spec.SetField(category.FieldNameI18n, field.TypeJSON, value)
+	}
+	if cuo.mutation.NameI18nCleared() {
+		_spec.ClearField(category.FieldNameI18n, field.TypeJSON)
+	}
 	if value, ok := cuo.mutation.InheritTaxRate(); ok {
 		_spec.SetField(category.FieldInheritTaxRate, field.TypeBool, value)
 	}
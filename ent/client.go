@@ -21,10 +21,26 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/backenduser"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/businessconfig"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/category"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupon"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupontemplate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/customer"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryorder"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryproductmapping"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliverystore"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/department"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/device"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/diningtable"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/kitchenticket"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/member"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccounttransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointstransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/membertier"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menu"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menuitem"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/menuversion"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchant"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchantbusinesstype"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchantrenewal"
@@ -33,6 +49,12 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/paymentaccount"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/paymentmethod"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/permission"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickupcall"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickuprule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorder"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorderrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattr"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattritem"
@@ -41,15 +63,21 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productspecrelation"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/producttag"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productunit"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/productversion"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/profitdistributionbill"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/profitdistributionrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/promotion"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/receiptrequest"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/refundorder"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/refundorderproduct"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/remark"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservation"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservationrule"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/role"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/rolemenu"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/rolepermission"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/routermenu"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/scanorderrule"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealdetail"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealgroup"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/stall"
@@ -58,6 +86,8 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/storeuser"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/taxfee"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/userrole"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistticket"
 
 	stdsql "database/sql"
 )
@@ -77,14 +107,46 @@ type Client struct {
 	BusinessConfig *BusinessConfigClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// Coupon is the client for interacting with the Coupon builders.
+	Coupon *CouponClient
+	// CouponTemplate is the client for interacting with the CouponTemplate builders.
+	CouponTemplate *CouponTemplateClient
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
+	// DeliveryOrder is the client for interacting with the DeliveryOrder builders.
+	DeliveryOrder *DeliveryOrderClient
+	// DeliveryProductMapping is the client for interacting with the DeliveryProductMapping builders.
+	DeliveryProductMapping *DeliveryProductMappingClient
+	// DeliveryStore is the client for interacting with the DeliveryStore builders.
+	DeliveryStore *DeliveryStoreClient
 	// Department is the client for interacting with the Department builders.
 	Department *DepartmentClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// DiningTable is the client for interacting with the DiningTable builders.
+	DiningTable *DiningTableClient
+	// KitchenTicket is the client for interacting with the KitchenTicket builders.
+	KitchenTicket *KitchenTicketClient
+	// Member is the client for interacting with the Member builders.
+	Member *MemberClient
+	// MemberAccount is the client for interacting with the MemberAccount builders.
+	MemberAccount *MemberAccountClient
+	// MemberAccountTransaction is the client for interacting with the MemberAccountTransaction builders.
+	MemberAccountTransaction *MemberAccountTransactionClient
+	// MemberPointsAccount is the client for interacting with the MemberPointsAccount builders.
+	MemberPointsAccount *MemberPointsAccountClient
+	// MemberPointsRule is the client for interacting with the MemberPointsRule builders.
+	MemberPointsRule *MemberPointsRuleClient
+	// MemberPointsTransaction is the client for interacting with the MemberPointsTransaction builders.
+	MemberPointsTransaction *MemberPointsTransactionClient
+	// MemberTier is the client for interacting with the MemberTier builders.
+	MemberTier *MemberTierClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
 	// MenuItem is the client for interacting with the MenuItem builders.
 	MenuItem *MenuItemClient
+	// MenuVersion is the client for interacting with the MenuVersion builders.
+	MenuVersion *MenuVersionClient
 	// Merchant is the client for interacting with the Merchant builders.
 	Merchant *MerchantClient
 	// MerchantBusinessType is the client for interacting with the MerchantBusinessType builders.
@@ -101,6 +163,18 @@ type Client struct {
 	PaymentMethod *PaymentMethodClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
+	// PickupCall is the client for interacting with the PickupCall builders.
+	PickupCall *PickupCallClient
+	// PickupRule is the client for interacting with the PickupRule builders.
+	PickupRule *PickupRuleClient
+	// Preorder is the client for interacting with the Preorder builders.
+	Preorder *PreorderClient
+	// PreorderRule is the client for interacting with the PreorderRule builders.
+	PreorderRule *PreorderRuleClient
+	// PriceChangeBatch is the client for interacting with the PriceChangeBatch builders.
+	PriceChangeBatch *PriceChangeBatchClient
+	// PriceChangeItem is the client for interacting with the PriceChangeItem builders.
+	PriceChangeItem *PriceChangeItemClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
 	// ProductAttr is the client for interacting with the ProductAttr builders.
@@ -117,16 +191,26 @@ type Client struct {
 	ProductTag *ProductTagClient
 	// ProductUnit is the client for interacting with the ProductUnit builders.
 	ProductUnit *ProductUnitClient
+	// ProductVersion is the client for interacting with the ProductVersion builders.
+	ProductVersion *ProductVersionClient
 	// ProfitDistributionBill is the client for interacting with the ProfitDistributionBill builders.
 	ProfitDistributionBill *ProfitDistributionBillClient
 	// ProfitDistributionRule is the client for interacting with the ProfitDistributionRule builders.
 	ProfitDistributionRule *ProfitDistributionRuleClient
+	// Promotion is the client for interacting with the Promotion builders.
+	Promotion *PromotionClient
+	// ReceiptRequest is the client for interacting with the ReceiptRequest builders.
+	ReceiptRequest *ReceiptRequestClient
 	// RefundOrder is the client for interacting with the RefundOrder builders.
 	RefundOrder *RefundOrderClient
 	// RefundOrderProduct is the client for interacting with the RefundOrderProduct builders.
 	RefundOrderProduct *RefundOrderProductClient
 	// Remark is the client for interacting with the Remark builders.
 	Remark *RemarkClient
+	// Reservation is the client for interacting with the Reservation builders.
+	Reservation *ReservationClient
+	// ReservationRule is the client for interacting with the ReservationRule builders.
+	ReservationRule *ReservationRuleClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RoleMenu is the client for interacting with the RoleMenu builders.
@@ -135,6 +219,8 @@ type Client struct {
 	RolePermission *RolePermissionClient
 	// RouterMenu is the client for interacting with the RouterMenu builders.
 	RouterMenu *RouterMenuClient
+	// ScanOrderRule is the client for interacting with the ScanOrderRule builders.
+	ScanOrderRule *ScanOrderRuleClient
 	// SetMealDetail is the client for interacting with the SetMealDetail builders.
 	SetMealDetail *SetMealDetailClient
 	// SetMealGroup is the client for interacting with the SetMealGroup builders.
@@ -151,6 +237,10 @@ type Client struct {
 	TaxFee *TaxFeeClient
 	// UserRole is the client for interacting with the UserRole builders.
 	UserRole *UserRoleClient
+	// WaitlistRule is the client for interacting with the WaitlistRule builders.
+	WaitlistRule *WaitlistRuleClient
+	// WaitlistTicket is the client for interacting with the WaitlistTicket builders.
+	WaitlistTicket *WaitlistTicketClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -167,10 +257,26 @@ func (c *Client) init() {
 	c.BackendUser = NewBackendUserClient(c.config)
 	c.BusinessConfig = NewBusinessConfigClient(c.config)
 	c.Category = NewCategoryClient(c.config)
+	c.Coupon = NewCouponClient(c.config)
+	c.CouponTemplate = NewCouponTemplateClient(c.config)
+	c.Customer = NewCustomerClient(c.config)
+	c.DeliveryOrder = NewDeliveryOrderClient(c.config)
+	c.DeliveryProductMapping = NewDeliveryProductMappingClient(c.config)
+	c.DeliveryStore = NewDeliveryStoreClient(c.config)
 	c.Department = NewDepartmentClient(c.config)
 	c.Device = NewDeviceClient(c.config)
+	c.DiningTable = NewDiningTableClient(c.config)
+	c.KitchenTicket = NewKitchenTicketClient(c.config)
+	c.Member = NewMemberClient(c.config)
+	c.MemberAccount = NewMemberAccountClient(c.config)
+	c.MemberAccountTransaction = NewMemberAccountTransactionClient(c.config)
+	c.MemberPointsAccount = NewMemberPointsAccountClient(c.config)
+	c.MemberPointsRule = NewMemberPointsRuleClient(c.config)
+	c.MemberPointsTransaction = NewMemberPointsTransactionClient(c.config)
+	c.MemberTier = NewMemberTierClient(c.config)
 	c.Menu = NewMenuClient(c.config)
 	c.MenuItem = NewMenuItemClient(c.config)
+	c.MenuVersion = NewMenuVersionClient(c.config)
 	c.Merchant = NewMerchantClient(c.config)
 	c.MerchantBusinessType = NewMerchantBusinessTypeClient(c.config)
 	c.MerchantRenewal = NewMerchantRenewalClient(c.config)
@@ -179,6 +285,12 @@ func (c *Client) init() {
 	c.PaymentAccount = NewPaymentAccountClient(c.config)
 	c.PaymentMethod = NewPaymentMethodClient(c.config)
 	c.Permission = NewPermissionClient(c.config)
+	c.PickupCall = NewPickupCallClient(c.config)
+	c.PickupRule = NewPickupRuleClient(c.config)
+	c.Preorder = NewPreorderClient(c.config)
+	c.PreorderRule = NewPreorderRuleClient(c.config)
+	c.PriceChangeBatch = NewPriceChangeBatchClient(c.config)
+	c.PriceChangeItem = NewPriceChangeItemClient(c.config)
 	c.Product = NewProductClient(c.config)
 	c.ProductAttr = NewProductAttrClient(c.config)
 	c.ProductAttrItem = NewProductAttrItemClient(c.config)
@@ -187,15 +299,21 @@ func (c *Client) init() {
 	c.ProductSpecRelation = NewProductSpecRelationClient(c.config)
 	c.ProductTag = NewProductTagClient(c.config)
 	c.ProductUnit = NewProductUnitClient(c.config)
+	c.ProductVersion = NewProductVersionClient(c.config)
 	c.ProfitDistributionBill = NewProfitDistributionBillClient(c.config)
 	c.ProfitDistributionRule = NewProfitDistributionRuleClient(c.config)
+	c.Promotion = NewPromotionClient(c.config)
+	c.ReceiptRequest = NewReceiptRequestClient(c.config)
 	c.RefundOrder = NewRefundOrderClient(c.config)
 	c.RefundOrderProduct = NewRefundOrderProductClient(c.config)
 	c.Remark = NewRemarkClient(c.config)
+	c.Reservation = NewReservationClient(c.config)
+	c.ReservationRule = NewReservationRuleClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.RoleMenu = NewRoleMenuClient(c.config)
 	c.RolePermission = NewRolePermissionClient(c.config)
 	c.RouterMenu = NewRouterMenuClient(c.config)
+	c.ScanOrderRule = NewScanOrderRuleClient(c.config)
 	c.SetMealDetail = NewSetMealDetailClient(c.config)
 	c.SetMealGroup = NewSetMealGroupClient(c.config)
 	c.Stall = NewStallClient(c.config)
@@ -204,6 +322,8 @@ func (c *Client) init() {
 	c.StoreUser = NewStoreUserClient(c.config)
 	c.TaxFee = NewTaxFeeClient(c.config)
 	c.UserRole = NewUserRoleClient(c.config)
+	c.WaitlistRule = NewWaitlistRuleClient(c.config)
+	c.WaitlistTicket = NewWaitlistTicketClient(c.config)
 }
 
 type (
@@ -294,50 +414,80 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                    ctx,
-		config:                 cfg,
-		AdditionalFee:          NewAdditionalFeeClient(cfg),
-		AdminUser:              NewAdminUserClient(cfg),
-		BackendUser:            NewBackendUserClient(cfg),
-		BusinessConfig:         NewBusinessConfigClient(cfg),
-		Category:               NewCategoryClient(cfg),
-		Department:             NewDepartmentClient(cfg),
-		Device:                 NewDeviceClient(cfg),
-		Menu:                   NewMenuClient(cfg),
-		MenuItem:               NewMenuItemClient(cfg),
-		Merchant:               NewMerchantClient(cfg),
-		MerchantBusinessType:   NewMerchantBusinessTypeClient(cfg),
-		MerchantRenewal:        NewMerchantRenewalClient(cfg),
-		Order:                  NewOrderClient(cfg),
-		OrderProduct:           NewOrderProductClient(cfg),
-		PaymentAccount:         NewPaymentAccountClient(cfg),
-		PaymentMethod:          NewPaymentMethodClient(cfg),
-		Permission:             NewPermissionClient(cfg),
-		Product:                NewProductClient(cfg),
-		ProductAttr:            NewProductAttrClient(cfg),
-		ProductAttrItem:        NewProductAttrItemClient(cfg),
-		ProductAttrRelation:    NewProductAttrRelationClient(cfg),
-		ProductSpec:            NewProductSpecClient(cfg),
-		ProductSpecRelation:    NewProductSpecRelationClient(cfg),
-		ProductTag:             NewProductTagClient(cfg),
-		ProductUnit:            NewProductUnitClient(cfg),
-		ProfitDistributionBill: NewProfitDistributionBillClient(cfg),
-		ProfitDistributionRule: NewProfitDistributionRuleClient(cfg),
-		RefundOrder:            NewRefundOrderClient(cfg),
-		RefundOrderProduct:     NewRefundOrderProductClient(cfg),
-		Remark:                 NewRemarkClient(cfg),
-		Role:                   NewRoleClient(cfg),
-		RoleMenu:               NewRoleMenuClient(cfg),
-		RolePermission:         NewRolePermissionClient(cfg),
-		RouterMenu:             NewRouterMenuClient(cfg),
-		SetMealDetail:          NewSetMealDetailClient(cfg),
-		SetMealGroup:           NewSetMealGroupClient(cfg),
-		Stall:                  NewStallClient(cfg),
-		Store:                  NewStoreClient(cfg),
-		StorePaymentAccount:    NewStorePaymentAccountClient(cfg),
-		StoreUser:              NewStoreUserClient(cfg),
-		TaxFee:                 NewTaxFeeClient(cfg),
-		UserRole:               NewUserRoleClient(cfg),
+		ctx:                      ctx,
+		config:                   cfg,
+		AdditionalFee:            NewAdditionalFeeClient(cfg),
+		AdminUser:                NewAdminUserClient(cfg),
+		BackendUser:              NewBackendUserClient(cfg),
+		BusinessConfig:           NewBusinessConfigClient(cfg),
+		Category:                 NewCategoryClient(cfg),
+		Coupon:                   NewCouponClient(cfg),
+		CouponTemplate:           NewCouponTemplateClient(cfg),
+		Customer:                 NewCustomerClient(cfg),
+		DeliveryOrder:            NewDeliveryOrderClient(cfg),
+		DeliveryProductMapping:   NewDeliveryProductMappingClient(cfg),
+		DeliveryStore:            NewDeliveryStoreClient(cfg),
+		Department:               NewDepartmentClient(cfg),
+		Device:                   NewDeviceClient(cfg),
+		DiningTable:              NewDiningTableClient(cfg),
+		KitchenTicket:            NewKitchenTicketClient(cfg),
+		Member:                   NewMemberClient(cfg),
+		MemberAccount:            NewMemberAccountClient(cfg),
+		MemberAccountTransaction: NewMemberAccountTransactionClient(cfg),
+		MemberPointsAccount:      NewMemberPointsAccountClient(cfg),
+		MemberPointsRule:         NewMemberPointsRuleClient(cfg),
+		MemberPointsTransaction:  NewMemberPointsTransactionClient(cfg),
+		MemberTier:               NewMemberTierClient(cfg),
+		Menu:                     NewMenuClient(cfg),
+		MenuItem:                 NewMenuItemClient(cfg),
+		MenuVersion:              NewMenuVersionClient(cfg),
+		Merchant:                 NewMerchantClient(cfg),
+		MerchantBusinessType:     NewMerchantBusinessTypeClient(cfg),
+		MerchantRenewal:          NewMerchantRenewalClient(cfg),
+		Order:                    NewOrderClient(cfg),
+		OrderProduct:             NewOrderProductClient(cfg),
+		PaymentAccount:           NewPaymentAccountClient(cfg),
+		PaymentMethod:            NewPaymentMethodClient(cfg),
+		Permission:               NewPermissionClient(cfg),
+		PickupCall:               NewPickupCallClient(cfg),
+		PickupRule:               NewPickupRuleClient(cfg),
+		Preorder:                 NewPreorderClient(cfg),
+		PreorderRule:             NewPreorderRuleClient(cfg),
+		PriceChangeBatch:         NewPriceChangeBatchClient(cfg),
+		PriceChangeItem:          NewPriceChangeItemClient(cfg),
+		Product:                  NewProductClient(cfg),
+		ProductAttr:              NewProductAttrClient(cfg),
+		ProductAttrItem:          NewProductAttrItemClient(cfg),
+		ProductAttrRelation:      NewProductAttrRelationClient(cfg),
+		ProductSpec:              NewProductSpecClient(cfg),
+		ProductSpecRelation:      NewProductSpecRelationClient(cfg),
+		ProductTag:               NewProductTagClient(cfg),
+		ProductUnit:              NewProductUnitClient(cfg),
+		ProductVersion:           NewProductVersionClient(cfg),
+		ProfitDistributionBill:   NewProfitDistributionBillClient(cfg),
+		ProfitDistributionRule:   NewProfitDistributionRuleClient(cfg),
+		Promotion:                NewPromotionClient(cfg),
+		ReceiptRequest:           NewReceiptRequestClient(cfg),
+		RefundOrder:              NewRefundOrderClient(cfg),
+		RefundOrderProduct:       NewRefundOrderProductClient(cfg),
+		Remark:                   NewRemarkClient(cfg),
+		Reservation:              NewReservationClient(cfg),
+		ReservationRule:          NewReservationRuleClient(cfg),
+		Role:                     NewRoleClient(cfg),
+		RoleMenu:                 NewRoleMenuClient(cfg),
+		RolePermission:           NewRolePermissionClient(cfg),
+		RouterMenu:               NewRouterMenuClient(cfg),
+		ScanOrderRule:            NewScanOrderRuleClient(cfg),
+		SetMealDetail:            NewSetMealDetailClient(cfg),
+		SetMealGroup:             NewSetMealGroupClient(cfg),
+		Stall:                    NewStallClient(cfg),
+		Store:                    NewStoreClient(cfg),
+		StorePaymentAccount:      NewStorePaymentAccountClient(cfg),
+		StoreUser:                NewStoreUserClient(cfg),
+		TaxFee:                   NewTaxFeeClient(cfg),
+		UserRole:                 NewUserRoleClient(cfg),
+		WaitlistRule:             NewWaitlistRuleClient(cfg),
+		WaitlistTicket:           NewWaitlistTicketClient(cfg),
 	}, nil
 }
 
@@ -355,50 +505,80 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                    ctx,
-		config:                 cfg,
-		AdditionalFee:          NewAdditionalFeeClient(cfg),
-		AdminUser:              NewAdminUserClient(cfg),
-		BackendUser:            NewBackendUserClient(cfg),
-		BusinessConfig:         NewBusinessConfigClient(cfg),
-		Category:               NewCategoryClient(cfg),
-		Department:             NewDepartmentClient(cfg),
-		Device:                 NewDeviceClient(cfg),
-		Menu:                   NewMenuClient(cfg),
-		MenuItem:               NewMenuItemClient(cfg),
-		Merchant:               NewMerchantClient(cfg),
-		MerchantBusinessType:   NewMerchantBusinessTypeClient(cfg),
-		MerchantRenewal:        NewMerchantRenewalClient(cfg),
-		Order:                  NewOrderClient(cfg),
-		OrderProduct:           NewOrderProductClient(cfg),
-		PaymentAccount:         NewPaymentAccountClient(cfg),
-		PaymentMethod:          NewPaymentMethodClient(cfg),
-		Permission:             NewPermissionClient(cfg),
-		Product:                NewProductClient(cfg),
-		ProductAttr:            NewProductAttrClient(cfg),
-		ProductAttrItem:        NewProductAttrItemClient(cfg),
-		ProductAttrRelation:    NewProductAttrRelationClient(cfg),
-		ProductSpec:            NewProductSpecClient(cfg),
-		ProductSpecRelation:    NewProductSpecRelationClient(cfg),
-		ProductTag:             NewProductTagClient(cfg),
-		ProductUnit:            NewProductUnitClient(cfg),
-		ProfitDistributionBill: NewProfitDistributionBillClient(cfg),
-		ProfitDistributionRule: NewProfitDistributionRuleClient(cfg),
-		RefundOrder:            NewRefundOrderClient(cfg),
-		RefundOrderProduct:     NewRefundOrderProductClient(cfg),
-		Remark:                 NewRemarkClient(cfg),
-		Role:                   NewRoleClient(cfg),
-		RoleMenu:               NewRoleMenuClient(cfg),
-		RolePermission:         NewRolePermissionClient(cfg),
-		RouterMenu:             NewRouterMenuClient(cfg),
-		SetMealDetail:          NewSetMealDetailClient(cfg),
-		SetMealGroup:           NewSetMealGroupClient(cfg),
-		Stall:                  NewStallClient(cfg),
-		Store:                  NewStoreClient(cfg),
-		StorePaymentAccount:    NewStorePaymentAccountClient(cfg),
-		StoreUser:              NewStoreUserClient(cfg),
-		TaxFee:                 NewTaxFeeClient(cfg),
-		UserRole:               NewUserRoleClient(cfg),
+		ctx:                      ctx,
+		config:                   cfg,
+		AdditionalFee:            NewAdditionalFeeClient(cfg),
+		AdminUser:                NewAdminUserClient(cfg),
+		BackendUser:              NewBackendUserClient(cfg),
+		BusinessConfig:           NewBusinessConfigClient(cfg),
+		Category:                 NewCategoryClient(cfg),
+		Coupon:                   NewCouponClient(cfg),
+		CouponTemplate:           NewCouponTemplateClient(cfg),
+		Customer:                 NewCustomerClient(cfg),
+		DeliveryOrder:            NewDeliveryOrderClient(cfg),
+		DeliveryProductMapping:   NewDeliveryProductMappingClient(cfg),
+		DeliveryStore:            NewDeliveryStoreClient(cfg),
+		Department:               NewDepartmentClient(cfg),
+		Device:                   NewDeviceClient(cfg),
+		DiningTable:              NewDiningTableClient(cfg),
+		KitchenTicket:            NewKitchenTicketClient(cfg),
+		Member:                   NewMemberClient(cfg),
+		MemberAccount:            NewMemberAccountClient(cfg),
+		MemberAccountTransaction: NewMemberAccountTransactionClient(cfg),
+		MemberPointsAccount:      NewMemberPointsAccountClient(cfg),
+		MemberPointsRule:         NewMemberPointsRuleClient(cfg),
+		MemberPointsTransaction:  NewMemberPointsTransactionClient(cfg),
+		MemberTier:               NewMemberTierClient(cfg),
+		Menu:                     NewMenuClient(cfg),
+		MenuItem:                 NewMenuItemClient(cfg),
+		MenuVersion:              NewMenuVersionClient(cfg),
+		Merchant:                 NewMerchantClient(cfg),
+		MerchantBusinessType:     NewMerchantBusinessTypeClient(cfg),
+		MerchantRenewal:          NewMerchantRenewalClient(cfg),
+		Order:                    NewOrderClient(cfg),
+		OrderProduct:             NewOrderProductClient(cfg),
+		PaymentAccount:           NewPaymentAccountClient(cfg),
+		PaymentMethod:            NewPaymentMethodClient(cfg),
+		Permission:               NewPermissionClient(cfg),
+		PickupCall:               NewPickupCallClient(cfg),
+		PickupRule:               NewPickupRuleClient(cfg),
+		Preorder:                 NewPreorderClient(cfg),
+		PreorderRule:             NewPreorderRuleClient(cfg),
+		PriceChangeBatch:         NewPriceChangeBatchClient(cfg),
+		PriceChangeItem:          NewPriceChangeItemClient(cfg),
+		Product:                  NewProductClient(cfg),
+		ProductAttr:              NewProductAttrClient(cfg),
+		ProductAttrItem:          NewProductAttrItemClient(cfg),
+		ProductAttrRelation:      NewProductAttrRelationClient(cfg),
+		ProductSpec:              NewProductSpecClient(cfg),
+		ProductSpecRelation:      NewProductSpecRelationClient(cfg),
+		ProductTag:               NewProductTagClient(cfg),
+		ProductUnit:              NewProductUnitClient(cfg),
+		ProductVersion:           NewProductVersionClient(cfg),
+		ProfitDistributionBill:   NewProfitDistributionBillClient(cfg),
+		ProfitDistributionRule:   NewProfitDistributionRuleClient(cfg),
+		Promotion:                NewPromotionClient(cfg),
+		ReceiptRequest:           NewReceiptRequestClient(cfg),
+		RefundOrder:              NewRefundOrderClient(cfg),
+		RefundOrderProduct:       NewRefundOrderProductClient(cfg),
+		Remark:                   NewRemarkClient(cfg),
+		Reservation:              NewReservationClient(cfg),
+		ReservationRule:          NewReservationRuleClient(cfg),
+		Role:                     NewRoleClient(cfg),
+		RoleMenu:                 NewRoleMenuClient(cfg),
+		RolePermission:           NewRolePermissionClient(cfg),
+		RouterMenu:               NewRouterMenuClient(cfg),
+		ScanOrderRule:            NewScanOrderRuleClient(cfg),
+		SetMealDetail:            NewSetMealDetailClient(cfg),
+		SetMealGroup:             NewSetMealGroupClient(cfg),
+		Stall:                    NewStallClient(cfg),
+		Store:                    NewStoreClient(cfg),
+		StorePaymentAccount:      NewStorePaymentAccountClient(cfg),
+		StoreUser:                NewStoreUserClient(cfg),
+		TaxFee:                   NewTaxFeeClient(cfg),
+		UserRole:                 NewUserRoleClient(cfg),
+		WaitlistRule:             NewWaitlistRuleClient(cfg),
+		WaitlistTicket:           NewWaitlistTicketClient(cfg),
 	}, nil
 }
 
@@ -429,14 +609,22 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.AdditionalFee, c.AdminUser, c.BackendUser, c.BusinessConfig, c.Category,
-		c.Department, c.Device, c.Menu, c.MenuItem, c.Merchant, c.MerchantBusinessType,
-		c.MerchantRenewal, c.Order, c.OrderProduct, c.PaymentAccount, c.PaymentMethod,
-		c.Permission, c.Product, c.ProductAttr, c.ProductAttrItem,
-		c.ProductAttrRelation, c.ProductSpec, c.ProductSpecRelation, c.ProductTag,
-		c.ProductUnit, c.ProfitDistributionBill, c.ProfitDistributionRule,
-		c.RefundOrder, c.RefundOrderProduct, c.Remark, c.Role, c.RoleMenu,
-		c.RolePermission, c.RouterMenu, c.SetMealDetail, c.SetMealGroup, c.Stall,
-		c.Store, c.StorePaymentAccount, c.StoreUser, c.TaxFee, c.UserRole,
+		c.Coupon, c.CouponTemplate, c.Customer, c.DeliveryOrder,
+		c.DeliveryProductMapping, c.DeliveryStore, c.Department, c.Device,
+		c.DiningTable, c.KitchenTicket, c.Member, c.MemberAccount,
+		c.MemberAccountTransaction, c.MemberPointsAccount, c.MemberPointsRule,
+		c.MemberPointsTransaction, c.MemberTier, c.Menu, c.MenuItem, c.MenuVersion,
+		c.Merchant, c.MerchantBusinessType, c.MerchantRenewal, c.Order, c.OrderProduct,
+		c.PaymentAccount, c.PaymentMethod, c.Permission, c.PickupCall, c.PickupRule,
+		c.Preorder, c.PreorderRule, c.PriceChangeBatch, c.PriceChangeItem, c.Product,
+		c.ProductAttr, c.ProductAttrItem, c.ProductAttrRelation, c.ProductSpec,
+		c.ProductSpecRelation, c.ProductTag, c.ProductUnit, c.ProductVersion,
+		c.ProfitDistributionBill, c.ProfitDistributionRule, c.Promotion,
+		c.ReceiptRequest, c.RefundOrder, c.RefundOrderProduct, c.Remark, c.Reservation,
+		c.ReservationRule, c.Role, c.RoleMenu, c.RolePermission, c.RouterMenu,
+		c.ScanOrderRule, c.SetMealDetail, c.SetMealGroup, c.Stall, c.Store,
+		c.StorePaymentAccount, c.StoreUser, c.TaxFee, c.UserRole, c.WaitlistRule,
+		c.WaitlistTicket,
 	} {
 		n.Use(hooks...)
 	}
@@ -447,14 +635,22 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.AdditionalFee, c.AdminUser, c.BackendUser, c.BusinessConfig, c.Category,
-		c.Department, c.Device, c.Menu, c.MenuItem, c.Merchant, c.MerchantBusinessType,
-		c.MerchantRenewal, c.Order, c.OrderProduct, c.PaymentAccount, c.PaymentMethod,
-		c.Permission, c.Product, c.ProductAttr, c.ProductAttrItem,
-		c.ProductAttrRelation, c.ProductSpec, c.ProductSpecRelation, c.ProductTag,
-		c.ProductUnit, c.ProfitDistributionBill, c.ProfitDistributionRule,
-		c.RefundOrder, c.RefundOrderProduct, c.Remark, c.Role, c.RoleMenu,
-		c.RolePermission, c.RouterMenu, c.SetMealDetail, c.SetMealGroup, c.Stall,
-		c.Store, c.StorePaymentAccount, c.StoreUser, c.TaxFee, c.UserRole,
+		c.Coupon, c.CouponTemplate, c.Customer, c.DeliveryOrder,
+		c.DeliveryProductMapping, c.DeliveryStore, c.Department, c.Device,
+		c.DiningTable, c.KitchenTicket, c.Member, c.MemberAccount,
+		c.MemberAccountTransaction, c.MemberPointsAccount, c.MemberPointsRule,
+		c.MemberPointsTransaction, c.MemberTier, c.Menu, c.MenuItem, c.MenuVersion,
+		c.Merchant, c.MerchantBusinessType, c.MerchantRenewal, c.Order, c.OrderProduct,
+		c.PaymentAccount, c.PaymentMethod, c.Permission, c.PickupCall, c.PickupRule,
+		c.Preorder, c.PreorderRule, c.PriceChangeBatch, c.PriceChangeItem, c.Product,
+		c.ProductAttr, c.ProductAttrItem, c.ProductAttrRelation, c.ProductSpec,
+		c.ProductSpecRelation, c.ProductTag, c.ProductUnit, c.ProductVersion,
+		c.ProfitDistributionBill, c.ProfitDistributionRule, c.Promotion,
+		c.ReceiptRequest, c.RefundOrder, c.RefundOrderProduct, c.Remark, c.Reservation,
+		c.ReservationRule, c.Role, c.RoleMenu, c.RolePermission, c.RouterMenu,
+		c.ScanOrderRule, c.SetMealDetail, c.SetMealGroup, c.Stall, c.Store,
+		c.StorePaymentAccount, c.StoreUser, c.TaxFee, c.UserRole, c.WaitlistRule,
+		c.WaitlistTicket,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -473,14 +669,46 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.BusinessConfig.mutate(ctx, m)
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
+	case *CouponMutation:
+		return c.Coupon.mutate(ctx, m)
+	case *CouponTemplateMutation:
+		return c.CouponTemplate.mutate(ctx, m)
+	case *CustomerMutation:
+		return c.Customer.mutate(ctx, m)
+	case *DeliveryOrderMutation:
+		return c.DeliveryOrder.mutate(ctx, m)
+	case *DeliveryProductMappingMutation:
+		return c.DeliveryProductMapping.mutate(ctx, m)
+	case *DeliveryStoreMutation:
+		return c.DeliveryStore.mutate(ctx, m)
 	case *DepartmentMutation:
 		return c.Department.mutate(ctx, m)
 	case *DeviceMutation:
 		return c.Device.mutate(ctx, m)
+	case *DiningTableMutation:
+		return c.DiningTable.mutate(ctx, m)
+	case *KitchenTicketMutation:
+		return c.KitchenTicket.mutate(ctx, m)
+	case *MemberMutation:
+		return c.Member.mutate(ctx, m)
+	case *MemberAccountMutation:
+		return c.MemberAccount.mutate(ctx, m)
+	case *MemberAccountTransactionMutation:
+		return c.MemberAccountTransaction.mutate(ctx, m)
+	case *MemberPointsAccountMutation:
+		return c.MemberPointsAccount.mutate(ctx, m)
+	case *MemberPointsRuleMutation:
+		return c.MemberPointsRule.mutate(ctx, m)
+	case *MemberPointsTransactionMutation:
+		return c.MemberPointsTransaction.mutate(ctx, m)
+	case *MemberTierMutation:
+		return c.MemberTier.mutate(ctx, m)
 	case *MenuMutation:
 		return c.Menu.mutate(ctx, m)
 	case *MenuItemMutation:
 		return c.MenuItem.mutate(ctx, m)
+	case *MenuVersionMutation:
+		return c.MenuVersion.mutate(ctx, m)
 	case *MerchantMutation:
 		return c.Merchant.mutate(ctx, m)
 	case *MerchantBusinessTypeMutation:
@@ -497,6 +725,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PaymentMethod.mutate(ctx, m)
 	case *PermissionMutation:
 		return c.Permission.mutate(ctx, m)
+	case *PickupCallMutation:
+		return c.PickupCall.mutate(ctx, m)
+	case *PickupRuleMutation:
+		return c.PickupRule.mutate(ctx, m)
+	case *PreorderMutation:
+		return c.Preorder.mutate(ctx, m)
+	case *PreorderRuleMutation:
+		return c.PreorderRule.mutate(ctx, m)
+	case *PriceChangeBatchMutation:
+		return c.PriceChangeBatch.mutate(ctx, m)
+	case *PriceChangeItemMutation:
+		return c.PriceChangeItem.mutate(ctx, m)
 	case *ProductMutation:
 		return c.Product.mutate(ctx, m)
 	case *ProductAttrMutation:
@@ -513,16 +753,26 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ProductTag.mutate(ctx, m)
 	case *ProductUnitMutation:
 		return c.ProductUnit.mutate(ctx, m)
+	case *ProductVersionMutation:
+		return c.ProductVersion.mutate(ctx, m)
 	case *ProfitDistributionBillMutation:
 		return c.ProfitDistributionBill.mutate(ctx, m)
 	case *ProfitDistributionRuleMutation:
 		return c.ProfitDistributionRule.mutate(ctx, m)
+	case *PromotionMutation:
+		return c.Promotion.mutate(ctx, m)
+	case *ReceiptRequestMutation:
+		return c.ReceiptRequest.mutate(ctx, m)
 	case *RefundOrderMutation:
 		return c.RefundOrder.mutate(ctx, m)
 	case *RefundOrderProductMutation:
 		return c.RefundOrderProduct.mutate(ctx, m)
 	case *RemarkMutation:
 		return c.Remark.mutate(ctx, m)
+	case *ReservationMutation:
+		return c.Reservation.mutate(ctx, m)
+	case *ReservationRuleMutation:
+		return c.ReservationRule.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
 	case *RoleMenuMutation:
@@ -531,6 +781,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.RolePermission.mutate(ctx, m)
 	case *RouterMenuMutation:
 		return c.RouterMenu.mutate(ctx, m)
+	case *ScanOrderRuleMutation:
+		return c.ScanOrderRule.mutate(ctx, m)
 	case *SetMealDetailMutation:
 		return c.SetMealDetail.mutate(ctx, m)
 	case *SetMealGroupMutation:
@@ -547,6 +799,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.TaxFee.mutate(ctx, m)
 	case *UserRoleMutation:
 		return c.UserRole.mutate(ctx, m)
+	case *WaitlistRuleMutation:
+		return c.WaitlistRule.mutate(ctx, m)
+	case *WaitlistTicketMutation:
+		return c.WaitlistTicket.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -1403,107 +1659,107 @@ func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value
 	}
 }
 
-// DepartmentClient is a client for the Department schema.
-type DepartmentClient struct {
+// CouponClient is a client for the Coupon schema.
+type CouponClient struct {
 	config
 }
 
-// NewDepartmentClient returns a client for the Department from the given config.
-func NewDepartmentClient(c config) *DepartmentClient {
-	return &DepartmentClient{config: c}
+// NewCouponClient returns a client for the Coupon from the given config.
+func NewCouponClient(c config) *CouponClient {
+	return &CouponClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `department.Hooks(f(g(h())))`.
-func (c *DepartmentClient) Use(hooks ...Hook) {
-	c.hooks.Department = append(c.hooks.Department, hooks...)
+// A call to `Use(f, g, h)` equals to `coupon.Hooks(f(g(h())))`.
+func (c *CouponClient) Use(hooks ...Hook) {
+	c.hooks.Coupon = append(c.hooks.Coupon, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `department.Intercept(f(g(h())))`.
-func (c *DepartmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Department = append(c.inters.Department, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `coupon.Intercept(f(g(h())))`.
+func (c *CouponClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Coupon = append(c.inters.Coupon, interceptors...)
 }
 
-// Create returns a builder for creating a Department entity.
-func (c *DepartmentClient) Create() *DepartmentCreate {
-	mutation := newDepartmentMutation(c.config, OpCreate)
-	return &DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Coupon entity.
+func (c *CouponClient) Create() *CouponCreate {
+	mutation := newCouponMutation(c.config, OpCreate)
+	return &CouponCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Department entities.
-func (c *DepartmentClient) CreateBulk(builders ...*DepartmentCreate) *DepartmentCreateBulk {
-	return &DepartmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Coupon entities.
+func (c *CouponClient) CreateBulk(builders ...*CouponCreate) *CouponCreateBulk {
+	return &CouponCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DepartmentClient) MapCreateBulk(slice any, setFunc func(*DepartmentCreate, int)) *DepartmentCreateBulk {
+func (c *CouponClient) MapCreateBulk(slice any, setFunc func(*CouponCreate, int)) *CouponCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DepartmentCreateBulk{err: fmt.Errorf("calling to DepartmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CouponCreateBulk{err: fmt.Errorf("calling to CouponClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DepartmentCreate, rv.Len())
+	builders := make([]*CouponCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DepartmentCreateBulk{config: c.config, builders: builders}
+	return &CouponCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Department.
-func (c *DepartmentClient) Update() *DepartmentUpdate {
-	mutation := newDepartmentMutation(c.config, OpUpdate)
-	return &DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Coupon.
+func (c *CouponClient) Update() *CouponUpdate {
+	mutation := newCouponMutation(c.config, OpUpdate)
+	return &CouponUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DepartmentClient) UpdateOne(d *Department) *DepartmentUpdateOne {
-	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartment(d))
-	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponClient) UpdateOne(co *Coupon) *CouponUpdateOne {
+	mutation := newCouponMutation(c.config, OpUpdateOne, withCoupon(co))
+	return &CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DepartmentClient) UpdateOneID(id uuid.UUID) *DepartmentUpdateOne {
-	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartmentID(id))
-	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponClient) UpdateOneID(id uuid.UUID) *CouponUpdateOne {
+	mutation := newCouponMutation(c.config, OpUpdateOne, withCouponID(id))
+	return &CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Department.
-func (c *DepartmentClient) Delete() *DepartmentDelete {
-	mutation := newDepartmentMutation(c.config, OpDelete)
-	return &DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Coupon.
+func (c *CouponClient) Delete() *CouponDelete {
+	mutation := newCouponMutation(c.config, OpDelete)
+	return &CouponDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DepartmentClient) DeleteOne(d *Department) *DepartmentDeleteOne {
-	return c.DeleteOneID(d.ID)
+func (c *CouponClient) DeleteOne(co *Coupon) *CouponDeleteOne {
+	return c.DeleteOneID(co.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DepartmentClient) DeleteOneID(id uuid.UUID) *DepartmentDeleteOne {
-	builder := c.Delete().Where(department.ID(id))
+func (c *CouponClient) DeleteOneID(id uuid.UUID) *CouponDeleteOne {
+	builder := c.Delete().Where(coupon.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DepartmentDeleteOne{builder}
+	return &CouponDeleteOne{builder}
 }
 
-// Query returns a query builder for Department.
-func (c *DepartmentClient) Query() *DepartmentQuery {
-	return &DepartmentQuery{
+// Query returns a query builder for Coupon.
+func (c *CouponClient) Query() *CouponQuery {
+	return &CouponQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDepartment},
+		ctx:    &QueryContext{Type: TypeCoupon},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Department entity by its id.
-func (c *DepartmentClient) Get(ctx context.Context, id uuid.UUID) (*Department, error) {
-	return c.Query().Where(department.ID(id)).Only(ctx)
+// Get returns a Coupon entity by its id.
+func (c *CouponClient) Get(ctx context.Context, id uuid.UUID) (*Coupon, error) {
+	return c.Query().Where(coupon.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DepartmentClient) GetX(ctx context.Context, id uuid.UUID) *Department {
+func (c *CouponClient) GetX(ctx context.Context, id uuid.UUID) *Coupon {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1511,214 +1767,132 @@ func (c *DepartmentClient) GetX(ctx context.Context, id uuid.UUID) *Department {
 	return obj
 }
 
-// QueryMerchant queries the merchant edge of a Department.
-func (c *DepartmentClient) QueryMerchant(d *Department) *MerchantQuery {
-	query := (&MerchantClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(department.Table, department.FieldID, id),
-			sqlgraph.To(merchant.Table, merchant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, department.MerchantTable, department.MerchantColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryStore queries the store edge of a Department.
-func (c *DepartmentClient) QueryStore(d *Department) *StoreQuery {
-	query := (&StoreClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(department.Table, department.FieldID, id),
-			sqlgraph.To(store.Table, store.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, department.StoreTable, department.StoreColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAdminUsers queries the admin_users edge of a Department.
-func (c *DepartmentClient) QueryAdminUsers(d *Department) *AdminUserQuery {
-	query := (&AdminUserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(department.Table, department.FieldID, id),
-			sqlgraph.To(adminuser.Table, adminuser.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, department.AdminUsersTable, department.AdminUsersColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryBackendUsers queries the backend_users edge of a Department.
-func (c *DepartmentClient) QueryBackendUsers(d *Department) *BackendUserQuery {
-	query := (&BackendUserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(department.Table, department.FieldID, id),
-			sqlgraph.To(backenduser.Table, backenduser.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, department.BackendUsersTable, department.BackendUsersColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryStoreUsers queries the store_users edge of a Department.
-func (c *DepartmentClient) QueryStoreUsers(d *Department) *StoreUserQuery {
-	query := (&StoreUserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(department.Table, department.FieldID, id),
-			sqlgraph.To(storeuser.Table, storeuser.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, department.StoreUsersTable, department.StoreUsersColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DepartmentClient) Hooks() []Hook {
-	hooks := c.hooks.Department
-	return append(hooks[:len(hooks):len(hooks)], department.Hooks[:]...)
+func (c *CouponClient) Hooks() []Hook {
+	return c.hooks.Coupon
 }
 
 // Interceptors returns the client interceptors.
-func (c *DepartmentClient) Interceptors() []Interceptor {
-	inters := c.inters.Department
-	return append(inters[:len(inters):len(inters)], department.Interceptors[:]...)
+func (c *CouponClient) Interceptors() []Interceptor {
+	return c.inters.Coupon
 }
 
-func (c *DepartmentClient) mutate(ctx context.Context, m *DepartmentMutation) (Value, error) {
+func (c *CouponClient) mutate(ctx context.Context, m *CouponMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CouponDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Department mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Coupon mutation op: %q", m.Op())
 	}
 }
 
-// DeviceClient is a client for the Device schema.
-type DeviceClient struct {
+// CouponTemplateClient is a client for the CouponTemplate schema.
+type CouponTemplateClient struct {
 	config
 }
 
-// NewDeviceClient returns a client for the Device from the given config.
-func NewDeviceClient(c config) *DeviceClient {
-	return &DeviceClient{config: c}
+// NewCouponTemplateClient returns a client for the CouponTemplate from the given config.
+func NewCouponTemplateClient(c config) *CouponTemplateClient {
+	return &CouponTemplateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `device.Hooks(f(g(h())))`.
-func (c *DeviceClient) Use(hooks ...Hook) {
-	c.hooks.Device = append(c.hooks.Device, hooks...)
+// A call to `Use(f, g, h)` equals to `coupontemplate.Hooks(f(g(h())))`.
+func (c *CouponTemplateClient) Use(hooks ...Hook) {
+	c.hooks.CouponTemplate = append(c.hooks.CouponTemplate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `device.Intercept(f(g(h())))`.
-func (c *DeviceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Device = append(c.inters.Device, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `coupontemplate.Intercept(f(g(h())))`.
+func (c *CouponTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CouponTemplate = append(c.inters.CouponTemplate, interceptors...)
 }
 
-// Create returns a builder for creating a Device entity.
-func (c *DeviceClient) Create() *DeviceCreate {
-	mutation := newDeviceMutation(c.config, OpCreate)
-	return &DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CouponTemplate entity.
+func (c *CouponTemplateClient) Create() *CouponTemplateCreate {
+	mutation := newCouponTemplateMutation(c.config, OpCreate)
+	return &CouponTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Device entities.
-func (c *DeviceClient) CreateBulk(builders ...*DeviceCreate) *DeviceCreateBulk {
-	return &DeviceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CouponTemplate entities.
+func (c *CouponTemplateClient) CreateBulk(builders ...*CouponTemplateCreate) *CouponTemplateCreateBulk {
+	return &CouponTemplateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DeviceClient) MapCreateBulk(slice any, setFunc func(*DeviceCreate, int)) *DeviceCreateBulk {
+func (c *CouponTemplateClient) MapCreateBulk(slice any, setFunc func(*CouponTemplateCreate, int)) *CouponTemplateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DeviceCreateBulk{err: fmt.Errorf("calling to DeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CouponTemplateCreateBulk{err: fmt.Errorf("calling to CouponTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DeviceCreate, rv.Len())
+	builders := make([]*CouponTemplateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DeviceCreateBulk{config: c.config, builders: builders}
+	return &CouponTemplateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Device.
-func (c *DeviceClient) Update() *DeviceUpdate {
-	mutation := newDeviceMutation(c.config, OpUpdate)
-	return &DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CouponTemplate.
+func (c *CouponTemplateClient) Update() *CouponTemplateUpdate {
+	mutation := newCouponTemplateMutation(c.config, OpUpdate)
+	return &CouponTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DeviceClient) UpdateOne(d *Device) *DeviceUpdateOne {
-	mutation := newDeviceMutation(c.config, OpUpdateOne, withDevice(d))
-	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponTemplateClient) UpdateOne(ct *CouponTemplate) *CouponTemplateUpdateOne {
+	mutation := newCouponTemplateMutation(c.config, OpUpdateOne, withCouponTemplate(ct))
+	return &CouponTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DeviceClient) UpdateOneID(id uuid.UUID) *DeviceUpdateOne {
-	mutation := newDeviceMutation(c.config, OpUpdateOne, withDeviceID(id))
-	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponTemplateClient) UpdateOneID(id uuid.UUID) *CouponTemplateUpdateOne {
+	mutation := newCouponTemplateMutation(c.config, OpUpdateOne, withCouponTemplateID(id))
+	return &CouponTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Device.
-func (c *DeviceClient) Delete() *DeviceDelete {
-	mutation := newDeviceMutation(c.config, OpDelete)
-	return &DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CouponTemplate.
+func (c *CouponTemplateClient) Delete() *CouponTemplateDelete {
+	mutation := newCouponTemplateMutation(c.config, OpDelete)
+	return &CouponTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DeviceClient) DeleteOne(d *Device) *DeviceDeleteOne {
-	return c.DeleteOneID(d.ID)
+func (c *CouponTemplateClient) DeleteOne(ct *CouponTemplate) *CouponTemplateDeleteOne {
+	return c.DeleteOneID(ct.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DeviceClient) DeleteOneID(id uuid.UUID) *DeviceDeleteOne {
-	builder := c.Delete().Where(device.ID(id))
+func (c *CouponTemplateClient) DeleteOneID(id uuid.UUID) *CouponTemplateDeleteOne {
+	builder := c.Delete().Where(coupontemplate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DeviceDeleteOne{builder}
+	return &CouponTemplateDeleteOne{builder}
 }
 
-// Query returns a query builder for Device.
-func (c *DeviceClient) Query() *DeviceQuery {
-	return &DeviceQuery{
+// Query returns a query builder for CouponTemplate.
+func (c *CouponTemplateClient) Query() *CouponTemplateQuery {
+	return &CouponTemplateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDevice},
+		ctx:    &QueryContext{Type: TypeCouponTemplate},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Device entity by its id.
-func (c *DeviceClient) Get(ctx context.Context, id uuid.UUID) (*Device, error) {
-	return c.Query().Where(device.ID(id)).Only(ctx)
+// Get returns a CouponTemplate entity by its id.
+func (c *CouponTemplateClient) Get(ctx context.Context, id uuid.UUID) (*CouponTemplate, error) {
+	return c.Query().Where(coupontemplate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DeviceClient) GetX(ctx context.Context, id uuid.UUID) *Device {
+func (c *CouponTemplateClient) GetX(ctx context.Context, id uuid.UUID) *CouponTemplate {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1726,182 +1900,134 @@ func (c *DeviceClient) GetX(ctx context.Context, id uuid.UUID) *Device {
 	return obj
 }
 
-// QueryMerchant queries the merchant edge of a Device.
-func (c *DeviceClient) QueryMerchant(d *Device) *MerchantQuery {
-	query := (&MerchantClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(device.Table, device.FieldID, id),
-			sqlgraph.To(merchant.Table, merchant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, device.MerchantTable, device.MerchantColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryStore queries the store edge of a Device.
-func (c *DeviceClient) QueryStore(d *Device) *StoreQuery {
-	query := (&StoreClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(device.Table, device.FieldID, id),
-			sqlgraph.To(store.Table, store.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, device.StoreTable, device.StoreColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryStall queries the stall edge of a Device.
-func (c *DeviceClient) QueryStall(d *Device) *StallQuery {
-	query := (&StallClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(device.Table, device.FieldID, id),
-			sqlgraph.To(stall.Table, stall.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, device.StallTable, device.StallColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DeviceClient) Hooks() []Hook {
-	hooks := c.hooks.Device
-	return append(hooks[:len(hooks):len(hooks)], device.Hooks[:]...)
+func (c *CouponTemplateClient) Hooks() []Hook {
+	hooks := c.hooks.CouponTemplate
+	return append(hooks[:len(hooks):len(hooks)], coupontemplate.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *DeviceClient) Interceptors() []Interceptor {
-	inters := c.inters.Device
-	return append(inters[:len(inters):len(inters)], device.Interceptors[:]...)
+func (c *CouponTemplateClient) Interceptors() []Interceptor {
+	inters := c.inters.CouponTemplate
+	return append(inters[:len(inters):len(inters)], coupontemplate.Interceptors[:]...)
 }
 
-func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, error) {
+func (c *CouponTemplateClient) mutate(ctx context.Context, m *CouponTemplateMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CouponTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Device mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CouponTemplate mutation op: %q", m.Op())
 	}
 }
 
-// MenuClient is a client for the Menu schema.
-type MenuClient struct {
+// CustomerClient is a client for the Customer schema.
+type CustomerClient struct {
 	config
 }
 
-// NewMenuClient returns a client for the Menu from the given config.
-func NewMenuClient(c config) *MenuClient {
-	return &MenuClient{config: c}
+// NewCustomerClient returns a client for the Customer from the given config.
+func NewCustomerClient(c config) *CustomerClient {
+	return &CustomerClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `menu.Hooks(f(g(h())))`.
-func (c *MenuClient) Use(hooks ...Hook) {
-	c.hooks.Menu = append(c.hooks.Menu, hooks...)
+// A call to `Use(f, g, h)` equals to `customer.Hooks(f(g(h())))`.
+func (c *CustomerClient) Use(hooks ...Hook) {
+	c.hooks.Customer = append(c.hooks.Customer, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `menu.Intercept(f(g(h())))`.
-func (c *MenuClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Menu = append(c.inters.Menu, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `customer.Intercept(f(g(h())))`.
+func (c *CustomerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Customer = append(c.inters.Customer, interceptors...)
 }
 
-// Create returns a builder for creating a Menu entity.
-func (c *MenuClient) Create() *MenuCreate {
-	mutation := newMenuMutation(c.config, OpCreate)
-	return &MenuCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Customer entity.
+func (c *CustomerClient) Create() *CustomerCreate {
+	mutation := newCustomerMutation(c.config, OpCreate)
+	return &CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Menu entities.
-func (c *MenuClient) CreateBulk(builders ...*MenuCreate) *MenuCreateBulk {
-	return &MenuCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Customer entities.
+func (c *CustomerClient) CreateBulk(builders ...*CustomerCreate) *CustomerCreateBulk {
+	return &CustomerCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MenuClient) MapCreateBulk(slice any, setFunc func(*MenuCreate, int)) *MenuCreateBulk {
+func (c *CustomerClient) MapCreateBulk(slice any, setFunc func(*CustomerCreate, int)) *CustomerCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MenuCreateBulk{err: fmt.Errorf("calling to MenuClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CustomerCreateBulk{err: fmt.Errorf("calling to CustomerClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MenuCreate, rv.Len())
+	builders := make([]*CustomerCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MenuCreateBulk{config: c.config, builders: builders}
+	return &CustomerCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Menu.
-func (c *MenuClient) Update() *MenuUpdate {
-	mutation := newMenuMutation(c.config, OpUpdate)
-	return &MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Customer.
+func (c *CustomerClient) Update() *CustomerUpdate {
+	mutation := newCustomerMutation(c.config, OpUpdate)
+	return &CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MenuClient) UpdateOne(m *Menu) *MenuUpdateOne {
-	mutation := newMenuMutation(c.config, OpUpdateOne, withMenu(m))
-	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CustomerClient) UpdateOne(cu *Customer) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomer(cu))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MenuClient) UpdateOneID(id uuid.UUID) *MenuUpdateOne {
-	mutation := newMenuMutation(c.config, OpUpdateOne, withMenuID(id))
-	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CustomerClient) UpdateOneID(id uuid.UUID) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomerID(id))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Menu.
-func (c *MenuClient) Delete() *MenuDelete {
-	mutation := newMenuMutation(c.config, OpDelete)
-	return &MenuDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Customer.
+func (c *CustomerClient) Delete() *CustomerDelete {
+	mutation := newCustomerMutation(c.config, OpDelete)
+	return &CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MenuClient) DeleteOne(m *Menu) *MenuDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *CustomerClient) DeleteOne(cu *Customer) *CustomerDeleteOne {
+	return c.DeleteOneID(cu.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MenuClient) DeleteOneID(id uuid.UUID) *MenuDeleteOne {
-	builder := c.Delete().Where(menu.ID(id))
+func (c *CustomerClient) DeleteOneID(id uuid.UUID) *CustomerDeleteOne {
+	builder := c.Delete().Where(customer.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MenuDeleteOne{builder}
+	return &CustomerDeleteOne{builder}
 }
 
-// Query returns a query builder for Menu.
-func (c *MenuClient) Query() *MenuQuery {
-	return &MenuQuery{
+// Query returns a query builder for Customer.
+func (c *CustomerClient) Query() *CustomerQuery {
+	return &CustomerQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMenu},
+		ctx:    &QueryContext{Type: TypeCustomer},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Menu entity by its id.
-func (c *MenuClient) Get(ctx context.Context, id uuid.UUID) (*Menu, error) {
-	return c.Query().Where(menu.ID(id)).Only(ctx)
+// Get returns a Customer entity by its id.
+func (c *CustomerClient) Get(ctx context.Context, id uuid.UUID) (*Customer, error) {
+	return c.Query().Where(customer.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MenuClient) GetX(ctx context.Context, id uuid.UUID) *Menu {
+func (c *CustomerClient) GetX(ctx context.Context, id uuid.UUID) *Customer {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1909,166 +2035,132 @@ func (c *MenuClient) GetX(ctx context.Context, id uuid.UUID) *Menu {
 	return obj
 }
 
-// QueryItems queries the items edge of a Menu.
-func (c *MenuClient) QueryItems(m *Menu) *MenuItemQuery {
-	query := (&MenuItemClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(menu.Table, menu.FieldID, id),
-			sqlgraph.To(menuitem.Table, menuitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, menu.ItemsTable, menu.ItemsColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryStores queries the stores edge of a Menu.
-func (c *MenuClient) QueryStores(m *Menu) *StoreQuery {
-	query := (&StoreClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(menu.Table, menu.FieldID, id),
-			sqlgraph.To(store.Table, store.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, menu.StoresTable, menu.StoresPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *MenuClient) Hooks() []Hook {
-	hooks := c.hooks.Menu
-	return append(hooks[:len(hooks):len(hooks)], menu.Hooks[:]...)
+func (c *CustomerClient) Hooks() []Hook {
+	return c.hooks.Customer
 }
 
 // Interceptors returns the client interceptors.
-func (c *MenuClient) Interceptors() []Interceptor {
-	inters := c.inters.Menu
-	return append(inters[:len(inters):len(inters)], menu.Interceptors[:]...)
+func (c *CustomerClient) Interceptors() []Interceptor {
+	return c.inters.Customer
 }
 
-func (c *MenuClient) mutate(ctx context.Context, m *MenuMutation) (Value, error) {
+func (c *CustomerClient) mutate(ctx context.Context, m *CustomerMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MenuCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MenuDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Menu mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Customer mutation op: %q", m.Op())
 	}
 }
 
-// MenuItemClient is a client for the MenuItem schema.
-type MenuItemClient struct {
+// DeliveryOrderClient is a client for the DeliveryOrder schema.
+type DeliveryOrderClient struct {
 	config
 }
 
-// NewMenuItemClient returns a client for the MenuItem from the given config.
-func NewMenuItemClient(c config) *MenuItemClient {
-	return &MenuItemClient{config: c}
+// NewDeliveryOrderClient returns a client for the DeliveryOrder from the given config.
+func NewDeliveryOrderClient(c config) *DeliveryOrderClient {
+	return &DeliveryOrderClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `menuitem.Hooks(f(g(h())))`.
-func (c *MenuItemClient) Use(hooks ...Hook) {
-	c.hooks.MenuItem = append(c.hooks.MenuItem, hooks...)
+// A call to `Use(f, g, h)` equals to `deliveryorder.Hooks(f(g(h())))`.
+func (c *DeliveryOrderClient) Use(hooks ...Hook) {
+	c.hooks.DeliveryOrder = append(c.hooks.DeliveryOrder, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `menuitem.Intercept(f(g(h())))`.
-func (c *MenuItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MenuItem = append(c.inters.MenuItem, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `deliveryorder.Intercept(f(g(h())))`.
+func (c *DeliveryOrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeliveryOrder = append(c.inters.DeliveryOrder, interceptors...)
 }
 
-// Create returns a builder for creating a MenuItem entity.
-func (c *MenuItemClient) Create() *MenuItemCreate {
-	mutation := newMenuItemMutation(c.config, OpCreate)
-	return &MenuItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeliveryOrder entity.
+func (c *DeliveryOrderClient) Create() *DeliveryOrderCreate {
+	mutation := newDeliveryOrderMutation(c.config, OpCreate)
+	return &DeliveryOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MenuItem entities.
-func (c *MenuItemClient) CreateBulk(builders ...*MenuItemCreate) *MenuItemCreateBulk {
-	return &MenuItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeliveryOrder entities.
+func (c *DeliveryOrderClient) CreateBulk(builders ...*DeliveryOrderCreate) *DeliveryOrderCreateBulk {
+	return &DeliveryOrderCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MenuItemClient) MapCreateBulk(slice any, setFunc func(*MenuItemCreate, int)) *MenuItemCreateBulk {
+func (c *DeliveryOrderClient) MapCreateBulk(slice any, setFunc func(*DeliveryOrderCreate, int)) *DeliveryOrderCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MenuItemCreateBulk{err: fmt.Errorf("calling to MenuItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeliveryOrderCreateBulk{err: fmt.Errorf("calling to DeliveryOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MenuItemCreate, rv.Len())
+	builders := make([]*DeliveryOrderCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MenuItemCreateBulk{config: c.config, builders: builders}
+	return &DeliveryOrderCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MenuItem.
-func (c *MenuItemClient) Update() *MenuItemUpdate {
-	mutation := newMenuItemMutation(c.config, OpUpdate)
-	return &MenuItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeliveryOrder.
+func (c *DeliveryOrderClient) Update() *DeliveryOrderUpdate {
+	mutation := newDeliveryOrderMutation(c.config, OpUpdate)
+	return &DeliveryOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MenuItemClient) UpdateOne(mi *MenuItem) *MenuItemUpdateOne {
-	mutation := newMenuItemMutation(c.config, OpUpdateOne, withMenuItem(mi))
-	return &MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeliveryOrderClient) UpdateOne(do *DeliveryOrder) *DeliveryOrderUpdateOne {
+	mutation := newDeliveryOrderMutation(c.config, OpUpdateOne, withDeliveryOrder(do))
+	return &DeliveryOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MenuItemClient) UpdateOneID(id uuid.UUID) *MenuItemUpdateOne {
-	mutation := newMenuItemMutation(c.config, OpUpdateOne, withMenuItemID(id))
-	return &MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeliveryOrderClient) UpdateOneID(id uuid.UUID) *DeliveryOrderUpdateOne {
+	mutation := newDeliveryOrderMutation(c.config, OpUpdateOne, withDeliveryOrderID(id))
+	return &DeliveryOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MenuItem.
-func (c *MenuItemClient) Delete() *MenuItemDelete {
-	mutation := newMenuItemMutation(c.config, OpDelete)
-	return &MenuItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeliveryOrder.
+func (c *DeliveryOrderClient) Delete() *DeliveryOrderDelete {
+	mutation := newDeliveryOrderMutation(c.config, OpDelete)
+	return &DeliveryOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MenuItemClient) DeleteOne(mi *MenuItem) *MenuItemDeleteOne {
-	return c.DeleteOneID(mi.ID)
+func (c *DeliveryOrderClient) DeleteOne(do *DeliveryOrder) *DeliveryOrderDeleteOne {
+	return c.DeleteOneID(do.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MenuItemClient) DeleteOneID(id uuid.UUID) *MenuItemDeleteOne {
-	builder := c.Delete().Where(menuitem.ID(id))
+func (c *DeliveryOrderClient) DeleteOneID(id uuid.UUID) *DeliveryOrderDeleteOne {
+	builder := c.Delete().Where(deliveryorder.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MenuItemDeleteOne{builder}
+	return &DeliveryOrderDeleteOne{builder}
 }
 
-// Query returns a query builder for MenuItem.
-func (c *MenuItemClient) Query() *MenuItemQuery {
-	return &MenuItemQuery{
+// Query returns a query builder for DeliveryOrder.
+func (c *DeliveryOrderClient) Query() *DeliveryOrderQuery {
+	return &DeliveryOrderQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMenuItem},
+		ctx:    &QueryContext{Type: TypeDeliveryOrder},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MenuItem entity by its id.
-func (c *MenuItemClient) Get(ctx context.Context, id uuid.UUID) (*MenuItem, error) {
-	return c.Query().Where(menuitem.ID(id)).Only(ctx)
+// Get returns a DeliveryOrder entity by its id.
+func (c *DeliveryOrderClient) Get(ctx context.Context, id uuid.UUID) (*DeliveryOrder, error) {
+	return c.Query().Where(deliveryorder.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MenuItemClient) GetX(ctx context.Context, id uuid.UUID) *MenuItem {
+func (c *DeliveryOrderClient) GetX(ctx context.Context, id uuid.UUID) *DeliveryOrder {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2076,166 +2168,132 @@ func (c *MenuItemClient) GetX(ctx context.Context, id uuid.UUID) *MenuItem {
 	return obj
 }
 
-// QueryMenu queries the menu edge of a MenuItem.
-func (c *MenuItemClient) QueryMenu(mi *MenuItem) *MenuQuery {
-	query := (&MenuClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := mi.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
-			sqlgraph.To(menu.Table, menu.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, menuitem.MenuTable, menuitem.MenuColumn),
-		)
-		fromV = sqlgraph.Neighbors(mi.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProduct queries the product edge of a MenuItem.
-func (c *MenuItemClient) QueryProduct(mi *MenuItem) *ProductQuery {
-	query := (&ProductClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := mi.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
-			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, menuitem.ProductTable, menuitem.ProductColumn),
-		)
-		fromV = sqlgraph.Neighbors(mi.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *MenuItemClient) Hooks() []Hook {
-	hooks := c.hooks.MenuItem
-	return append(hooks[:len(hooks):len(hooks)], menuitem.Hooks[:]...)
+func (c *DeliveryOrderClient) Hooks() []Hook {
+	return c.hooks.DeliveryOrder
 }
 
 // Interceptors returns the client interceptors.
-func (c *MenuItemClient) Interceptors() []Interceptor {
-	inters := c.inters.MenuItem
-	return append(inters[:len(inters):len(inters)], menuitem.Interceptors[:]...)
+func (c *DeliveryOrderClient) Interceptors() []Interceptor {
+	return c.inters.DeliveryOrder
 }
 
-func (c *MenuItemClient) mutate(ctx context.Context, m *MenuItemMutation) (Value, error) {
+func (c *DeliveryOrderClient) mutate(ctx context.Context, m *DeliveryOrderMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MenuItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeliveryOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MenuItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeliveryOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeliveryOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MenuItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeliveryOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MenuItem mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DeliveryOrder mutation op: %q", m.Op())
 	}
 }
 
-// MerchantClient is a client for the Merchant schema.
-type MerchantClient struct {
+// DeliveryProductMappingClient is a client for the DeliveryProductMapping schema.
+type DeliveryProductMappingClient struct {
 	config
 }
 
-// NewMerchantClient returns a client for the Merchant from the given config.
-func NewMerchantClient(c config) *MerchantClient {
-	return &MerchantClient{config: c}
+// NewDeliveryProductMappingClient returns a client for the DeliveryProductMapping from the given config.
+func NewDeliveryProductMappingClient(c config) *DeliveryProductMappingClient {
+	return &DeliveryProductMappingClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `merchant.Hooks(f(g(h())))`.
-func (c *MerchantClient) Use(hooks ...Hook) {
-	c.hooks.Merchant = append(c.hooks.Merchant, hooks...)
+// A call to `Use(f, g, h)` equals to `deliveryproductmapping.Hooks(f(g(h())))`.
+func (c *DeliveryProductMappingClient) Use(hooks ...Hook) {
+	c.hooks.DeliveryProductMapping = append(c.hooks.DeliveryProductMapping, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `merchant.Intercept(f(g(h())))`.
-func (c *MerchantClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Merchant = append(c.inters.Merchant, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `deliveryproductmapping.Intercept(f(g(h())))`.
+func (c *DeliveryProductMappingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeliveryProductMapping = append(c.inters.DeliveryProductMapping, interceptors...)
 }
 
-// Create returns a builder for creating a Merchant entity.
-func (c *MerchantClient) Create() *MerchantCreate {
-	mutation := newMerchantMutation(c.config, OpCreate)
-	return &MerchantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeliveryProductMapping entity.
+func (c *DeliveryProductMappingClient) Create() *DeliveryProductMappingCreate {
+	mutation := newDeliveryProductMappingMutation(c.config, OpCreate)
+	return &DeliveryProductMappingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Merchant entities.
-func (c *MerchantClient) CreateBulk(builders ...*MerchantCreate) *MerchantCreateBulk {
-	return &MerchantCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeliveryProductMapping entities.
+func (c *DeliveryProductMappingClient) CreateBulk(builders ...*DeliveryProductMappingCreate) *DeliveryProductMappingCreateBulk {
+	return &DeliveryProductMappingCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MerchantClient) MapCreateBulk(slice any, setFunc func(*MerchantCreate, int)) *MerchantCreateBulk {
+func (c *DeliveryProductMappingClient) MapCreateBulk(slice any, setFunc func(*DeliveryProductMappingCreate, int)) *DeliveryProductMappingCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MerchantCreateBulk{err: fmt.Errorf("calling to MerchantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeliveryProductMappingCreateBulk{err: fmt.Errorf("calling to DeliveryProductMappingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MerchantCreate, rv.Len())
+	builders := make([]*DeliveryProductMappingCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MerchantCreateBulk{config: c.config, builders: builders}
+	return &DeliveryProductMappingCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Merchant.
-func (c *MerchantClient) Update() *MerchantUpdate {
-	mutation := newMerchantMutation(c.config, OpUpdate)
-	return &MerchantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeliveryProductMapping.
+func (c *DeliveryProductMappingClient) Update() *DeliveryProductMappingUpdate {
+	mutation := newDeliveryProductMappingMutation(c.config, OpUpdate)
+	return &DeliveryProductMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MerchantClient) UpdateOne(m *Merchant) *MerchantUpdateOne {
-	mutation := newMerchantMutation(c.config, OpUpdateOne, withMerchant(m))
-	return &MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeliveryProductMappingClient) UpdateOne(dpm *DeliveryProductMapping) *DeliveryProductMappingUpdateOne {
+	mutation := newDeliveryProductMappingMutation(c.config, OpUpdateOne, withDeliveryProductMapping(dpm))
+	return &DeliveryProductMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MerchantClient) UpdateOneID(id uuid.UUID) *MerchantUpdateOne {
-	mutation := newMerchantMutation(c.config, OpUpdateOne, withMerchantID(id))
-	return &MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeliveryProductMappingClient) UpdateOneID(id uuid.UUID) *DeliveryProductMappingUpdateOne {
+	mutation := newDeliveryProductMappingMutation(c.config, OpUpdateOne, withDeliveryProductMappingID(id))
+	return &DeliveryProductMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Merchant.
-func (c *MerchantClient) Delete() *MerchantDelete {
-	mutation := newMerchantMutation(c.config, OpDelete)
-	return &MerchantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeliveryProductMapping.
+func (c *DeliveryProductMappingClient) Delete() *DeliveryProductMappingDelete {
+	mutation := newDeliveryProductMappingMutation(c.config, OpDelete)
+	return &DeliveryProductMappingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MerchantClient) DeleteOne(m *Merchant) *MerchantDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *DeliveryProductMappingClient) DeleteOne(dpm *DeliveryProductMapping) *DeliveryProductMappingDeleteOne {
+	return c.DeleteOneID(dpm.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MerchantClient) DeleteOneID(id uuid.UUID) *MerchantDeleteOne {
-	builder := c.Delete().Where(merchant.ID(id))
+func (c *DeliveryProductMappingClient) DeleteOneID(id uuid.UUID) *DeliveryProductMappingDeleteOne {
+	builder := c.Delete().Where(deliveryproductmapping.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MerchantDeleteOne{builder}
+	return &DeliveryProductMappingDeleteOne{builder}
 }
 
-// Query returns a query builder for Merchant.
-func (c *MerchantClient) Query() *MerchantQuery {
-	return &MerchantQuery{
+// Query returns a query builder for DeliveryProductMapping.
+func (c *DeliveryProductMappingClient) Query() *DeliveryProductMappingQuery {
+	return &DeliveryProductMappingQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMerchant},
+		ctx:    &QueryContext{Type: TypeDeliveryProductMapping},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Merchant entity by its id.
-func (c *MerchantClient) Get(ctx context.Context, id uuid.UUID) (*Merchant, error) {
-	return c.Query().Where(merchant.ID(id)).Only(ctx)
+// Get returns a DeliveryProductMapping entity by its id.
+func (c *DeliveryProductMappingClient) Get(ctx context.Context, id uuid.UUID) (*DeliveryProductMapping, error) {
+	return c.Query().Where(deliveryproductmapping.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MerchantClient) GetX(ctx context.Context, id uuid.UUID) *Merchant {
+func (c *DeliveryProductMappingClient) GetX(ctx context.Context, id uuid.UUID) *DeliveryProductMapping {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2243,326 +2301,3951 @@ func (c *MerchantClient) GetX(ctx context.Context, id uuid.UUID) *Merchant {
 	return obj
 }
 
-// QueryBackendUsers queries the backend_users edge of a Merchant.
-func (c *MerchantClient) QueryBackendUsers(m *Merchant) *BackendUserQuery {
-	query := (&BackendUserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
-			sqlgraph.To(backenduser.Table, backenduser.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.BackendUsersTable, merchant.BackendUsersColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *DeliveryProductMappingClient) Hooks() []Hook {
+	return c.hooks.DeliveryProductMapping
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeliveryProductMappingClient) Interceptors() []Interceptor {
+	return c.inters.DeliveryProductMapping
+}
+
+func (c *DeliveryProductMappingClient) mutate(ctx context.Context, m *DeliveryProductMappingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeliveryProductMappingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeliveryProductMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeliveryProductMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeliveryProductMappingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeliveryProductMapping mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryStores queries the stores edge of a Merchant.
-func (c *MerchantClient) QueryStores(m *Merchant) *StoreQuery {
+// DeliveryStoreClient is a client for the DeliveryStore schema.
+type DeliveryStoreClient struct {
+	config
+}
+
+// NewDeliveryStoreClient returns a client for the DeliveryStore from the given config.
+func NewDeliveryStoreClient(c config) *DeliveryStoreClient {
+	return &DeliveryStoreClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deliverystore.Hooks(f(g(h())))`.
+func (c *DeliveryStoreClient) Use(hooks ...Hook) {
+	c.hooks.DeliveryStore = append(c.hooks.DeliveryStore, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deliverystore.Intercept(f(g(h())))`.
+func (c *DeliveryStoreClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeliveryStore = append(c.inters.DeliveryStore, interceptors...)
+}
+
+// Create returns a builder for creating a DeliveryStore entity.
+func (c *DeliveryStoreClient) Create() *DeliveryStoreCreate {
+	mutation := newDeliveryStoreMutation(c.config, OpCreate)
+	return &DeliveryStoreCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeliveryStore entities.
+func (c *DeliveryStoreClient) CreateBulk(builders ...*DeliveryStoreCreate) *DeliveryStoreCreateBulk {
+	return &DeliveryStoreCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeliveryStoreClient) MapCreateBulk(slice any, setFunc func(*DeliveryStoreCreate, int)) *DeliveryStoreCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeliveryStoreCreateBulk{err: fmt.Errorf("calling to DeliveryStoreClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeliveryStoreCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeliveryStoreCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeliveryStore.
+func (c *DeliveryStoreClient) Update() *DeliveryStoreUpdate {
+	mutation := newDeliveryStoreMutation(c.config, OpUpdate)
+	return &DeliveryStoreUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeliveryStoreClient) UpdateOne(ds *DeliveryStore) *DeliveryStoreUpdateOne {
+	mutation := newDeliveryStoreMutation(c.config, OpUpdateOne, withDeliveryStore(ds))
+	return &DeliveryStoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeliveryStoreClient) UpdateOneID(id uuid.UUID) *DeliveryStoreUpdateOne {
+	mutation := newDeliveryStoreMutation(c.config, OpUpdateOne, withDeliveryStoreID(id))
+	return &DeliveryStoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeliveryStore.
+func (c *DeliveryStoreClient) Delete() *DeliveryStoreDelete {
+	mutation := newDeliveryStoreMutation(c.config, OpDelete)
+	return &DeliveryStoreDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeliveryStoreClient) DeleteOne(ds *DeliveryStore) *DeliveryStoreDeleteOne {
+	return c.DeleteOneID(ds.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeliveryStoreClient) DeleteOneID(id uuid.UUID) *DeliveryStoreDeleteOne {
+	builder := c.Delete().Where(deliverystore.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeliveryStoreDeleteOne{builder}
+}
+
+// Query returns a query builder for DeliveryStore.
+func (c *DeliveryStoreClient) Query() *DeliveryStoreQuery {
+	return &DeliveryStoreQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeliveryStore},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeliveryStore entity by its id.
+func (c *DeliveryStoreClient) Get(ctx context.Context, id uuid.UUID) (*DeliveryStore, error) {
+	return c.Query().Where(deliverystore.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeliveryStoreClient) GetX(ctx context.Context, id uuid.UUID) *DeliveryStore {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeliveryStoreClient) Hooks() []Hook {
+	return c.hooks.DeliveryStore
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeliveryStoreClient) Interceptors() []Interceptor {
+	return c.inters.DeliveryStore
+}
+
+func (c *DeliveryStoreClient) mutate(ctx context.Context, m *DeliveryStoreMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeliveryStoreCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeliveryStoreUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeliveryStoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeliveryStoreDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeliveryStore mutation op: %q", m.Op())
+	}
+}
+
+// DepartmentClient is a client for the Department schema.
+type DepartmentClient struct {
+	config
+}
+
+// NewDepartmentClient returns a client for the Department from the given config.
+func NewDepartmentClient(c config) *DepartmentClient {
+	return &DepartmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `department.Hooks(f(g(h())))`.
+func (c *DepartmentClient) Use(hooks ...Hook) {
+	c.hooks.Department = append(c.hooks.Department, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `department.Intercept(f(g(h())))`.
+func (c *DepartmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Department = append(c.inters.Department, interceptors...)
+}
+
+// Create returns a builder for creating a Department entity.
+func (c *DepartmentClient) Create() *DepartmentCreate {
+	mutation := newDepartmentMutation(c.config, OpCreate)
+	return &DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Department entities.
+func (c *DepartmentClient) CreateBulk(builders ...*DepartmentCreate) *DepartmentCreateBulk {
+	return &DepartmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DepartmentClient) MapCreateBulk(slice any, setFunc func(*DepartmentCreate, int)) *DepartmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DepartmentCreateBulk{err: fmt.Errorf("calling to DepartmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DepartmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DepartmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Department.
+func (c *DepartmentClient) Update() *DepartmentUpdate {
+	mutation := newDepartmentMutation(c.config, OpUpdate)
+	return &DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DepartmentClient) UpdateOne(d *Department) *DepartmentUpdateOne {
+	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartment(d))
+	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DepartmentClient) UpdateOneID(id uuid.UUID) *DepartmentUpdateOne {
+	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartmentID(id))
+	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Department.
+func (c *DepartmentClient) Delete() *DepartmentDelete {
+	mutation := newDepartmentMutation(c.config, OpDelete)
+	return &DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DepartmentClient) DeleteOne(d *Department) *DepartmentDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DepartmentClient) DeleteOneID(id uuid.UUID) *DepartmentDeleteOne {
+	builder := c.Delete().Where(department.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DepartmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Department.
+func (c *DepartmentClient) Query() *DepartmentQuery {
+	return &DepartmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDepartment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Department entity by its id.
+func (c *DepartmentClient) Get(ctx context.Context, id uuid.UUID) (*Department, error) {
+	return c.Query().Where(department.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DepartmentClient) GetX(ctx context.Context, id uuid.UUID) *Department {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMerchant queries the merchant edge of a Department.
+func (c *DepartmentClient) QueryMerchant(d *Department) *MerchantQuery {
+	query := (&MerchantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(merchant.Table, merchant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, department.MerchantTable, department.MerchantColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStore queries the store edge of a Department.
+func (c *DepartmentClient) QueryStore(d *Department) *StoreQuery {
 	query := (&StoreClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := d.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.From(department.Table, department.FieldID, id),
 			sqlgraph.To(store.Table, store.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.StoresTable, merchant.StoresColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, department.StoreTable, department.StoreColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryMerchantRenewals queries the merchant_renewals edge of a Merchant.
-func (c *MerchantClient) QueryMerchantRenewals(m *Merchant) *MerchantRenewalQuery {
-	query := (&MerchantRenewalClient{config: c.config}).Query()
+// QueryAdminUsers queries the admin_users edge of a Department.
+func (c *DepartmentClient) QueryAdminUsers(d *Department) *AdminUserQuery {
+	query := (&AdminUserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := d.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
-			sqlgraph.To(merchantrenewal.Table, merchantrenewal.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.MerchantRenewalsTable, merchant.MerchantRenewalsColumn),
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(adminuser.Table, adminuser.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, department.AdminUsersTable, department.AdminUsersColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryRemarks queries the remarks edge of a Merchant.
-func (c *MerchantClient) QueryRemarks(m *Merchant) *RemarkQuery {
-	query := (&RemarkClient{config: c.config}).Query()
+// QueryBackendUsers queries the backend_users edge of a Department.
+func (c *DepartmentClient) QueryBackendUsers(d *Department) *BackendUserQuery {
+	query := (&BackendUserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := d.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
-			sqlgraph.To(remark.Table, remark.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.RemarksTable, merchant.RemarksColumn),
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(backenduser.Table, backenduser.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, department.BackendUsersTable, department.BackendUsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStoreUsers queries the store_users edge of a Department.
+func (c *DepartmentClient) QueryStoreUsers(d *Department) *StoreUserQuery {
+	query := (&StoreUserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(storeuser.Table, storeuser.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, department.StoreUsersTable, department.StoreUsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DepartmentClient) Hooks() []Hook {
+	hooks := c.hooks.Department
+	return append(hooks[:len(hooks):len(hooks)], department.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DepartmentClient) Interceptors() []Interceptor {
+	inters := c.inters.Department
+	return append(inters[:len(inters):len(inters)], department.Interceptors[:]...)
+}
+
+func (c *DepartmentClient) mutate(ctx context.Context, m *DepartmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Department mutation op: %q", m.Op())
+	}
+}
+
+// DeviceClient is a client for the Device schema.
+type DeviceClient struct {
+	config
+}
+
+// NewDeviceClient returns a client for the Device from the given config.
+func NewDeviceClient(c config) *DeviceClient {
+	return &DeviceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `device.Hooks(f(g(h())))`.
+func (c *DeviceClient) Use(hooks ...Hook) {
+	c.hooks.Device = append(c.hooks.Device, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `device.Intercept(f(g(h())))`.
+func (c *DeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Device = append(c.inters.Device, interceptors...)
+}
+
+// Create returns a builder for creating a Device entity.
+func (c *DeviceClient) Create() *DeviceCreate {
+	mutation := newDeviceMutation(c.config, OpCreate)
+	return &DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Device entities.
+func (c *DeviceClient) CreateBulk(builders ...*DeviceCreate) *DeviceCreateBulk {
+	return &DeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceClient) MapCreateBulk(slice any, setFunc func(*DeviceCreate, int)) *DeviceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceCreateBulk{err: fmt.Errorf("calling to DeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Device.
+func (c *DeviceClient) Update() *DeviceUpdate {
+	mutation := newDeviceMutation(c.config, OpUpdate)
+	return &DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceClient) UpdateOne(d *Device) *DeviceUpdateOne {
+	mutation := newDeviceMutation(c.config, OpUpdateOne, withDevice(d))
+	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceClient) UpdateOneID(id uuid.UUID) *DeviceUpdateOne {
+	mutation := newDeviceMutation(c.config, OpUpdateOne, withDeviceID(id))
+	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Device.
+func (c *DeviceClient) Delete() *DeviceDelete {
+	mutation := newDeviceMutation(c.config, OpDelete)
+	return &DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceClient) DeleteOne(d *Device) *DeviceDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceClient) DeleteOneID(id uuid.UUID) *DeviceDeleteOne {
+	builder := c.Delete().Where(device.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceDeleteOne{builder}
+}
+
+// Query returns a query builder for Device.
+func (c *DeviceClient) Query() *DeviceQuery {
+	return &DeviceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDevice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Device entity by its id.
+func (c *DeviceClient) Get(ctx context.Context, id uuid.UUID) (*Device, error) {
+	return c.Query().Where(device.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceClient) GetX(ctx context.Context, id uuid.UUID) *Device {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMerchant queries the merchant edge of a Device.
+func (c *DeviceClient) QueryMerchant(d *Device) *MerchantQuery {
+	query := (&MerchantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(merchant.Table, merchant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.MerchantTable, device.MerchantColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStore queries the store edge of a Device.
+func (c *DeviceClient) QueryStore(d *Device) *StoreQuery {
+	query := (&StoreClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(store.Table, store.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.StoreTable, device.StoreColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStall queries the stall edge of a Device.
+func (c *DeviceClient) QueryStall(d *Device) *StallQuery {
+	query := (&StallClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(stall.Table, stall.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.StallTable, device.StallColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceClient) Hooks() []Hook {
+	hooks := c.hooks.Device
+	return append(hooks[:len(hooks):len(hooks)], device.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceClient) Interceptors() []Interceptor {
+	inters := c.inters.Device
+	return append(inters[:len(inters):len(inters)], device.Interceptors[:]...)
+}
+
+func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Device mutation op: %q", m.Op())
+	}
+}
+
+// DiningTableClient is a client for the DiningTable schema.
+type DiningTableClient struct {
+	config
+}
+
+// NewDiningTableClient returns a client for the DiningTable from the given config.
+func NewDiningTableClient(c config) *DiningTableClient {
+	return &DiningTableClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `diningtable.Hooks(f(g(h())))`.
+func (c *DiningTableClient) Use(hooks ...Hook) {
+	c.hooks.DiningTable = append(c.hooks.DiningTable, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `diningtable.Intercept(f(g(h())))`.
+func (c *DiningTableClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiningTable = append(c.inters.DiningTable, interceptors...)
+}
+
+// Create returns a builder for creating a DiningTable entity.
+func (c *DiningTableClient) Create() *DiningTableCreate {
+	mutation := newDiningTableMutation(c.config, OpCreate)
+	return &DiningTableCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiningTable entities.
+func (c *DiningTableClient) CreateBulk(builders ...*DiningTableCreate) *DiningTableCreateBulk {
+	return &DiningTableCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiningTableClient) MapCreateBulk(slice any, setFunc func(*DiningTableCreate, int)) *DiningTableCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiningTableCreateBulk{err: fmt.Errorf("calling to DiningTableClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiningTableCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiningTableCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiningTable.
+func (c *DiningTableClient) Update() *DiningTableUpdate {
+	mutation := newDiningTableMutation(c.config, OpUpdate)
+	return &DiningTableUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiningTableClient) UpdateOne(dt *DiningTable) *DiningTableUpdateOne {
+	mutation := newDiningTableMutation(c.config, OpUpdateOne, withDiningTable(dt))
+	return &DiningTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiningTableClient) UpdateOneID(id uuid.UUID) *DiningTableUpdateOne {
+	mutation := newDiningTableMutation(c.config, OpUpdateOne, withDiningTableID(id))
+	return &DiningTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiningTable.
+func (c *DiningTableClient) Delete() *DiningTableDelete {
+	mutation := newDiningTableMutation(c.config, OpDelete)
+	return &DiningTableDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiningTableClient) DeleteOne(dt *DiningTable) *DiningTableDeleteOne {
+	return c.DeleteOneID(dt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiningTableClient) DeleteOneID(id uuid.UUID) *DiningTableDeleteOne {
+	builder := c.Delete().Where(diningtable.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiningTableDeleteOne{builder}
+}
+
+// Query returns a query builder for DiningTable.
+func (c *DiningTableClient) Query() *DiningTableQuery {
+	return &DiningTableQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiningTable},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiningTable entity by its id.
+func (c *DiningTableClient) Get(ctx context.Context, id uuid.UUID) (*DiningTable, error) {
+	return c.Query().Where(diningtable.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiningTableClient) GetX(ctx context.Context, id uuid.UUID) *DiningTable {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DiningTableClient) Hooks() []Hook {
+	hooks := c.hooks.DiningTable
+	return append(hooks[:len(hooks):len(hooks)], diningtable.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiningTableClient) Interceptors() []Interceptor {
+	inters := c.inters.DiningTable
+	return append(inters[:len(inters):len(inters)], diningtable.Interceptors[:]...)
+}
+
+func (c *DiningTableClient) mutate(ctx context.Context, m *DiningTableMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiningTableCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiningTableUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiningTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiningTableDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiningTable mutation op: %q", m.Op())
+	}
+}
+
+// KitchenTicketClient is a client for the KitchenTicket schema.
+type KitchenTicketClient struct {
+	config
+}
+
+// NewKitchenTicketClient returns a client for the KitchenTicket from the given config.
+func NewKitchenTicketClient(c config) *KitchenTicketClient {
+	return &KitchenTicketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `kitchenticket.Hooks(f(g(h())))`.
+func (c *KitchenTicketClient) Use(hooks ...Hook) {
+	c.hooks.KitchenTicket = append(c.hooks.KitchenTicket, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `kitchenticket.Intercept(f(g(h())))`.
+func (c *KitchenTicketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KitchenTicket = append(c.inters.KitchenTicket, interceptors...)
+}
+
+// Create returns a builder for creating a KitchenTicket entity.
+func (c *KitchenTicketClient) Create() *KitchenTicketCreate {
+	mutation := newKitchenTicketMutation(c.config, OpCreate)
+	return &KitchenTicketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KitchenTicket entities.
+func (c *KitchenTicketClient) CreateBulk(builders ...*KitchenTicketCreate) *KitchenTicketCreateBulk {
+	return &KitchenTicketCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KitchenTicketClient) MapCreateBulk(slice any, setFunc func(*KitchenTicketCreate, int)) *KitchenTicketCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KitchenTicketCreateBulk{err: fmt.Errorf("calling to KitchenTicketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KitchenTicketCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KitchenTicketCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KitchenTicket.
+func (c *KitchenTicketClient) Update() *KitchenTicketUpdate {
+	mutation := newKitchenTicketMutation(c.config, OpUpdate)
+	return &KitchenTicketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KitchenTicketClient) UpdateOne(kt *KitchenTicket) *KitchenTicketUpdateOne {
+	mutation := newKitchenTicketMutation(c.config, OpUpdateOne, withKitchenTicket(kt))
+	return &KitchenTicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KitchenTicketClient) UpdateOneID(id uuid.UUID) *KitchenTicketUpdateOne {
+	mutation := newKitchenTicketMutation(c.config, OpUpdateOne, withKitchenTicketID(id))
+	return &KitchenTicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KitchenTicket.
+func (c *KitchenTicketClient) Delete() *KitchenTicketDelete {
+	mutation := newKitchenTicketMutation(c.config, OpDelete)
+	return &KitchenTicketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KitchenTicketClient) DeleteOne(kt *KitchenTicket) *KitchenTicketDeleteOne {
+	return c.DeleteOneID(kt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KitchenTicketClient) DeleteOneID(id uuid.UUID) *KitchenTicketDeleteOne {
+	builder := c.Delete().Where(kitchenticket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KitchenTicketDeleteOne{builder}
+}
+
+// Query returns a query builder for KitchenTicket.
+func (c *KitchenTicketClient) Query() *KitchenTicketQuery {
+	return &KitchenTicketQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKitchenTicket},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KitchenTicket entity by its id.
+func (c *KitchenTicketClient) Get(ctx context.Context, id uuid.UUID) (*KitchenTicket, error) {
+	return c.Query().Where(kitchenticket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KitchenTicketClient) GetX(ctx context.Context, id uuid.UUID) *KitchenTicket {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KitchenTicketClient) Hooks() []Hook {
+	return c.hooks.KitchenTicket
+}
+
+// Interceptors returns the client interceptors.
+func (c *KitchenTicketClient) Interceptors() []Interceptor {
+	return c.inters.KitchenTicket
+}
+
+func (c *KitchenTicketClient) mutate(ctx context.Context, m *KitchenTicketMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KitchenTicketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KitchenTicketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KitchenTicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KitchenTicketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KitchenTicket mutation op: %q", m.Op())
+	}
+}
+
+// MemberClient is a client for the Member schema.
+type MemberClient struct {
+	config
+}
+
+// NewMemberClient returns a client for the Member from the given config.
+func NewMemberClient(c config) *MemberClient {
+	return &MemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `member.Hooks(f(g(h())))`.
+func (c *MemberClient) Use(hooks ...Hook) {
+	c.hooks.Member = append(c.hooks.Member, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `member.Intercept(f(g(h())))`.
+func (c *MemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Member = append(c.inters.Member, interceptors...)
+}
+
+// Create returns a builder for creating a Member entity.
+func (c *MemberClient) Create() *MemberCreate {
+	mutation := newMemberMutation(c.config, OpCreate)
+	return &MemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Member entities.
+func (c *MemberClient) CreateBulk(builders ...*MemberCreate) *MemberCreateBulk {
+	return &MemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberClient) MapCreateBulk(slice any, setFunc func(*MemberCreate, int)) *MemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberCreateBulk{err: fmt.Errorf("calling to MemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Member.
+func (c *MemberClient) Update() *MemberUpdate {
+	mutation := newMemberMutation(c.config, OpUpdate)
+	return &MemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberClient) UpdateOne(m *Member) *MemberUpdateOne {
+	mutation := newMemberMutation(c.config, OpUpdateOne, withMember(m))
+	return &MemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberClient) UpdateOneID(id uuid.UUID) *MemberUpdateOne {
+	mutation := newMemberMutation(c.config, OpUpdateOne, withMemberID(id))
+	return &MemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Member.
+func (c *MemberClient) Delete() *MemberDelete {
+	mutation := newMemberMutation(c.config, OpDelete)
+	return &MemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberClient) DeleteOne(m *Member) *MemberDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberClient) DeleteOneID(id uuid.UUID) *MemberDeleteOne {
+	builder := c.Delete().Where(member.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberDeleteOne{builder}
+}
+
+// Query returns a query builder for Member.
+func (c *MemberClient) Query() *MemberQuery {
+	return &MemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Member entity by its id.
+func (c *MemberClient) Get(ctx context.Context, id uuid.UUID) (*Member, error) {
+	return c.Query().Where(member.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberClient) GetX(ctx context.Context, id uuid.UUID) *Member {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MemberClient) Hooks() []Hook {
+	hooks := c.hooks.Member
+	return append(hooks[:len(hooks):len(hooks)], member.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberClient) Interceptors() []Interceptor {
+	inters := c.inters.Member
+	return append(inters[:len(inters):len(inters)], member.Interceptors[:]...)
+}
+
+func (c *MemberClient) mutate(ctx context.Context, m *MemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Member mutation op: %q", m.Op())
+	}
+}
+
+// MemberAccountClient is a client for the MemberAccount schema.
+type MemberAccountClient struct {
+	config
+}
+
+// NewMemberAccountClient returns a client for the MemberAccount from the given config.
+func NewMemberAccountClient(c config) *MemberAccountClient {
+	return &MemberAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberaccount.Hooks(f(g(h())))`.
+func (c *MemberAccountClient) Use(hooks ...Hook) {
+	c.hooks.MemberAccount = append(c.hooks.MemberAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberaccount.Intercept(f(g(h())))`.
+func (c *MemberAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberAccount = append(c.inters.MemberAccount, interceptors...)
+}
+
+// Create returns a builder for creating a MemberAccount entity.
+func (c *MemberAccountClient) Create() *MemberAccountCreate {
+	mutation := newMemberAccountMutation(c.config, OpCreate)
+	return &MemberAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberAccount entities.
+func (c *MemberAccountClient) CreateBulk(builders ...*MemberAccountCreate) *MemberAccountCreateBulk {
+	return &MemberAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberAccountClient) MapCreateBulk(slice any, setFunc func(*MemberAccountCreate, int)) *MemberAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberAccountCreateBulk{err: fmt.Errorf("calling to MemberAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberAccount.
+func (c *MemberAccountClient) Update() *MemberAccountUpdate {
+	mutation := newMemberAccountMutation(c.config, OpUpdate)
+	return &MemberAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberAccountClient) UpdateOne(ma *MemberAccount) *MemberAccountUpdateOne {
+	mutation := newMemberAccountMutation(c.config, OpUpdateOne, withMemberAccount(ma))
+	return &MemberAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberAccountClient) UpdateOneID(id uuid.UUID) *MemberAccountUpdateOne {
+	mutation := newMemberAccountMutation(c.config, OpUpdateOne, withMemberAccountID(id))
+	return &MemberAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberAccount.
+func (c *MemberAccountClient) Delete() *MemberAccountDelete {
+	mutation := newMemberAccountMutation(c.config, OpDelete)
+	return &MemberAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberAccountClient) DeleteOne(ma *MemberAccount) *MemberAccountDeleteOne {
+	return c.DeleteOneID(ma.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberAccountClient) DeleteOneID(id uuid.UUID) *MemberAccountDeleteOne {
+	builder := c.Delete().Where(memberaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberAccount.
+func (c *MemberAccountClient) Query() *MemberAccountQuery {
+	return &MemberAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberAccount entity by its id.
+func (c *MemberAccountClient) Get(ctx context.Context, id uuid.UUID) (*MemberAccount, error) {
+	return c.Query().Where(memberaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberAccountClient) GetX(ctx context.Context, id uuid.UUID) *MemberAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MemberAccountClient) Hooks() []Hook {
+	return c.hooks.MemberAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberAccountClient) Interceptors() []Interceptor {
+	return c.inters.MemberAccount
+}
+
+func (c *MemberAccountClient) mutate(ctx context.Context, m *MemberAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberAccount mutation op: %q", m.Op())
+	}
+}
+
+// MemberAccountTransactionClient is a client for the MemberAccountTransaction schema.
+type MemberAccountTransactionClient struct {
+	config
+}
+
+// NewMemberAccountTransactionClient returns a client for the MemberAccountTransaction from the given config.
+func NewMemberAccountTransactionClient(c config) *MemberAccountTransactionClient {
+	return &MemberAccountTransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberaccounttransaction.Hooks(f(g(h())))`.
+func (c *MemberAccountTransactionClient) Use(hooks ...Hook) {
+	c.hooks.MemberAccountTransaction = append(c.hooks.MemberAccountTransaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberaccounttransaction.Intercept(f(g(h())))`.
+func (c *MemberAccountTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberAccountTransaction = append(c.inters.MemberAccountTransaction, interceptors...)
+}
+
+// Create returns a builder for creating a MemberAccountTransaction entity.
+func (c *MemberAccountTransactionClient) Create() *MemberAccountTransactionCreate {
+	mutation := newMemberAccountTransactionMutation(c.config, OpCreate)
+	return &MemberAccountTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberAccountTransaction entities.
+func (c *MemberAccountTransactionClient) CreateBulk(builders ...*MemberAccountTransactionCreate) *MemberAccountTransactionCreateBulk {
+	return &MemberAccountTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberAccountTransactionClient) MapCreateBulk(slice any, setFunc func(*MemberAccountTransactionCreate, int)) *MemberAccountTransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberAccountTransactionCreateBulk{err: fmt.Errorf("calling to MemberAccountTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberAccountTransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberAccountTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberAccountTransaction.
+func (c *MemberAccountTransactionClient) Update() *MemberAccountTransactionUpdate {
+	mutation := newMemberAccountTransactionMutation(c.config, OpUpdate)
+	return &MemberAccountTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberAccountTransactionClient) UpdateOne(mat *MemberAccountTransaction) *MemberAccountTransactionUpdateOne {
+	mutation := newMemberAccountTransactionMutation(c.config, OpUpdateOne, withMemberAccountTransaction(mat))
+	return &MemberAccountTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberAccountTransactionClient) UpdateOneID(id uuid.UUID) *MemberAccountTransactionUpdateOne {
+	mutation := newMemberAccountTransactionMutation(c.config, OpUpdateOne, withMemberAccountTransactionID(id))
+	return &MemberAccountTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberAccountTransaction.
+func (c *MemberAccountTransactionClient) Delete() *MemberAccountTransactionDelete {
+	mutation := newMemberAccountTransactionMutation(c.config, OpDelete)
+	return &MemberAccountTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberAccountTransactionClient) DeleteOne(mat *MemberAccountTransaction) *MemberAccountTransactionDeleteOne {
+	return c.DeleteOneID(mat.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberAccountTransactionClient) DeleteOneID(id uuid.UUID) *MemberAccountTransactionDeleteOne {
+	builder := c.Delete().Where(memberaccounttransaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberAccountTransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberAccountTransaction.
+func (c *MemberAccountTransactionClient) Query() *MemberAccountTransactionQuery {
+	return &MemberAccountTransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberAccountTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberAccountTransaction entity by its id.
+func (c *MemberAccountTransactionClient) Get(ctx context.Context, id uuid.UUID) (*MemberAccountTransaction, error) {
+	return c.Query().Where(memberaccounttransaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberAccountTransactionClient) GetX(ctx context.Context, id uuid.UUID) *MemberAccountTransaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MemberAccountTransactionClient) Hooks() []Hook {
+	return c.hooks.MemberAccountTransaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberAccountTransactionClient) Interceptors() []Interceptor {
+	return c.inters.MemberAccountTransaction
+}
+
+func (c *MemberAccountTransactionClient) mutate(ctx context.Context, m *MemberAccountTransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberAccountTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberAccountTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberAccountTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberAccountTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberAccountTransaction mutation op: %q", m.Op())
+	}
+}
+
+// MemberPointsAccountClient is a client for the MemberPointsAccount schema.
+type MemberPointsAccountClient struct {
+	config
+}
+
+// NewMemberPointsAccountClient returns a client for the MemberPointsAccount from the given config.
+func NewMemberPointsAccountClient(c config) *MemberPointsAccountClient {
+	return &MemberPointsAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberpointsaccount.Hooks(f(g(h())))`.
+func (c *MemberPointsAccountClient) Use(hooks ...Hook) {
+	c.hooks.MemberPointsAccount = append(c.hooks.MemberPointsAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberpointsaccount.Intercept(f(g(h())))`.
+func (c *MemberPointsAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberPointsAccount = append(c.inters.MemberPointsAccount, interceptors...)
+}
+
+// Create returns a builder for creating a MemberPointsAccount entity.
+func (c *MemberPointsAccountClient) Create() *MemberPointsAccountCreate {
+	mutation := newMemberPointsAccountMutation(c.config, OpCreate)
+	return &MemberPointsAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberPointsAccount entities.
+func (c *MemberPointsAccountClient) CreateBulk(builders ...*MemberPointsAccountCreate) *MemberPointsAccountCreateBulk {
+	return &MemberPointsAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberPointsAccountClient) MapCreateBulk(slice any, setFunc func(*MemberPointsAccountCreate, int)) *MemberPointsAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberPointsAccountCreateBulk{err: fmt.Errorf("calling to MemberPointsAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberPointsAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberPointsAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberPointsAccount.
+func (c *MemberPointsAccountClient) Update() *MemberPointsAccountUpdate {
+	mutation := newMemberPointsAccountMutation(c.config, OpUpdate)
+	return &MemberPointsAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberPointsAccountClient) UpdateOne(mpa *MemberPointsAccount) *MemberPointsAccountUpdateOne {
+	mutation := newMemberPointsAccountMutation(c.config, OpUpdateOne, withMemberPointsAccount(mpa))
+	return &MemberPointsAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberPointsAccountClient) UpdateOneID(id uuid.UUID) *MemberPointsAccountUpdateOne {
+	mutation := newMemberPointsAccountMutation(c.config, OpUpdateOne, withMemberPointsAccountID(id))
+	return &MemberPointsAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberPointsAccount.
+func (c *MemberPointsAccountClient) Delete() *MemberPointsAccountDelete {
+	mutation := newMemberPointsAccountMutation(c.config, OpDelete)
+	return &MemberPointsAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberPointsAccountClient) DeleteOne(mpa *MemberPointsAccount) *MemberPointsAccountDeleteOne {
+	return c.DeleteOneID(mpa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberPointsAccountClient) DeleteOneID(id uuid.UUID) *MemberPointsAccountDeleteOne {
+	builder := c.Delete().Where(memberpointsaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberPointsAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberPointsAccount.
+func (c *MemberPointsAccountClient) Query() *MemberPointsAccountQuery {
+	return &MemberPointsAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberPointsAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberPointsAccount entity by its id.
+func (c *MemberPointsAccountClient) Get(ctx context.Context, id uuid.UUID) (*MemberPointsAccount, error) {
+	return c.Query().Where(memberpointsaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberPointsAccountClient) GetX(ctx context.Context, id uuid.UUID) *MemberPointsAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MemberPointsAccountClient) Hooks() []Hook {
+	return c.hooks.MemberPointsAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberPointsAccountClient) Interceptors() []Interceptor {
+	return c.inters.MemberPointsAccount
+}
+
+func (c *MemberPointsAccountClient) mutate(ctx context.Context, m *MemberPointsAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberPointsAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberPointsAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberPointsAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberPointsAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberPointsAccount mutation op: %q", m.Op())
+	}
+}
+
+// MemberPointsRuleClient is a client for the MemberPointsRule schema.
+type MemberPointsRuleClient struct {
+	config
+}
+
+// NewMemberPointsRuleClient returns a client for the MemberPointsRule from the given config.
+func NewMemberPointsRuleClient(c config) *MemberPointsRuleClient {
+	return &MemberPointsRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberpointsrule.Hooks(f(g(h())))`.
+func (c *MemberPointsRuleClient) Use(hooks ...Hook) {
+	c.hooks.MemberPointsRule = append(c.hooks.MemberPointsRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberpointsrule.Intercept(f(g(h())))`.
+func (c *MemberPointsRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberPointsRule = append(c.inters.MemberPointsRule, interceptors...)
+}
+
+// Create returns a builder for creating a MemberPointsRule entity.
+func (c *MemberPointsRuleClient) Create() *MemberPointsRuleCreate {
+	mutation := newMemberPointsRuleMutation(c.config, OpCreate)
+	return &MemberPointsRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberPointsRule entities.
+func (c *MemberPointsRuleClient) CreateBulk(builders ...*MemberPointsRuleCreate) *MemberPointsRuleCreateBulk {
+	return &MemberPointsRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberPointsRuleClient) MapCreateBulk(slice any, setFunc func(*MemberPointsRuleCreate, int)) *MemberPointsRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberPointsRuleCreateBulk{err: fmt.Errorf("calling to MemberPointsRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberPointsRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberPointsRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberPointsRule.
+func (c *MemberPointsRuleClient) Update() *MemberPointsRuleUpdate {
+	mutation := newMemberPointsRuleMutation(c.config, OpUpdate)
+	return &MemberPointsRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberPointsRuleClient) UpdateOne(mpr *MemberPointsRule) *MemberPointsRuleUpdateOne {
+	mutation := newMemberPointsRuleMutation(c.config, OpUpdateOne, withMemberPointsRule(mpr))
+	return &MemberPointsRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberPointsRuleClient) UpdateOneID(id uuid.UUID) *MemberPointsRuleUpdateOne {
+	mutation := newMemberPointsRuleMutation(c.config, OpUpdateOne, withMemberPointsRuleID(id))
+	return &MemberPointsRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberPointsRule.
+func (c *MemberPointsRuleClient) Delete() *MemberPointsRuleDelete {
+	mutation := newMemberPointsRuleMutation(c.config, OpDelete)
+	return &MemberPointsRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberPointsRuleClient) DeleteOne(mpr *MemberPointsRule) *MemberPointsRuleDeleteOne {
+	return c.DeleteOneID(mpr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberPointsRuleClient) DeleteOneID(id uuid.UUID) *MemberPointsRuleDeleteOne {
+	builder := c.Delete().Where(memberpointsrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberPointsRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberPointsRule.
+func (c *MemberPointsRuleClient) Query() *MemberPointsRuleQuery {
+	return &MemberPointsRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberPointsRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberPointsRule entity by its id.
+func (c *MemberPointsRuleClient) Get(ctx context.Context, id uuid.UUID) (*MemberPointsRule, error) {
+	return c.Query().Where(memberpointsrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberPointsRuleClient) GetX(ctx context.Context, id uuid.UUID) *MemberPointsRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MemberPointsRuleClient) Hooks() []Hook {
+	return c.hooks.MemberPointsRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberPointsRuleClient) Interceptors() []Interceptor {
+	return c.inters.MemberPointsRule
+}
+
+func (c *MemberPointsRuleClient) mutate(ctx context.Context, m *MemberPointsRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberPointsRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberPointsRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberPointsRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberPointsRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberPointsRule mutation op: %q", m.Op())
+	}
+}
+
+// MemberPointsTransactionClient is a client for the MemberPointsTransaction schema.
+type MemberPointsTransactionClient struct {
+	config
+}
+
+// NewMemberPointsTransactionClient returns a client for the MemberPointsTransaction from the given config.
+func NewMemberPointsTransactionClient(c config) *MemberPointsTransactionClient {
+	return &MemberPointsTransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberpointstransaction.Hooks(f(g(h())))`.
+func (c *MemberPointsTransactionClient) Use(hooks ...Hook) {
+	c.hooks.MemberPointsTransaction = append(c.hooks.MemberPointsTransaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberpointstransaction.Intercept(f(g(h())))`.
+func (c *MemberPointsTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberPointsTransaction = append(c.inters.MemberPointsTransaction, interceptors...)
+}
+
+// Create returns a builder for creating a MemberPointsTransaction entity.
+func (c *MemberPointsTransactionClient) Create() *MemberPointsTransactionCreate {
+	mutation := newMemberPointsTransactionMutation(c.config, OpCreate)
+	return &MemberPointsTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberPointsTransaction entities.
+func (c *MemberPointsTransactionClient) CreateBulk(builders ...*MemberPointsTransactionCreate) *MemberPointsTransactionCreateBulk {
+	return &MemberPointsTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberPointsTransactionClient) MapCreateBulk(slice any, setFunc func(*MemberPointsTransactionCreate, int)) *MemberPointsTransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberPointsTransactionCreateBulk{err: fmt.Errorf("calling to MemberPointsTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberPointsTransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberPointsTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberPointsTransaction.
+func (c *MemberPointsTransactionClient) Update() *MemberPointsTransactionUpdate {
+	mutation := newMemberPointsTransactionMutation(c.config, OpUpdate)
+	return &MemberPointsTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberPointsTransactionClient) UpdateOne(mpt *MemberPointsTransaction) *MemberPointsTransactionUpdateOne {
+	mutation := newMemberPointsTransactionMutation(c.config, OpUpdateOne, withMemberPointsTransaction(mpt))
+	return &MemberPointsTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberPointsTransactionClient) UpdateOneID(id uuid.UUID) *MemberPointsTransactionUpdateOne {
+	mutation := newMemberPointsTransactionMutation(c.config, OpUpdateOne, withMemberPointsTransactionID(id))
+	return &MemberPointsTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberPointsTransaction.
+func (c *MemberPointsTransactionClient) Delete() *MemberPointsTransactionDelete {
+	mutation := newMemberPointsTransactionMutation(c.config, OpDelete)
+	return &MemberPointsTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberPointsTransactionClient) DeleteOne(mpt *MemberPointsTransaction) *MemberPointsTransactionDeleteOne {
+	return c.DeleteOneID(mpt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberPointsTransactionClient) DeleteOneID(id uuid.UUID) *MemberPointsTransactionDeleteOne {
+	builder := c.Delete().Where(memberpointstransaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberPointsTransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberPointsTransaction.
+func (c *MemberPointsTransactionClient) Query() *MemberPointsTransactionQuery {
+	return &MemberPointsTransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberPointsTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberPointsTransaction entity by its id.
+func (c *MemberPointsTransactionClient) Get(ctx context.Context, id uuid.UUID) (*MemberPointsTransaction, error) {
+	return c.Query().Where(memberpointstransaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberPointsTransactionClient) GetX(ctx context.Context, id uuid.UUID) *MemberPointsTransaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MemberPointsTransactionClient) Hooks() []Hook {
+	return c.hooks.MemberPointsTransaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberPointsTransactionClient) Interceptors() []Interceptor {
+	return c.inters.MemberPointsTransaction
+}
+
+func (c *MemberPointsTransactionClient) mutate(ctx context.Context, m *MemberPointsTransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberPointsTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberPointsTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberPointsTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberPointsTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberPointsTransaction mutation op: %q", m.Op())
+	}
+}
+
+// MemberTierClient is a client for the MemberTier schema.
+type MemberTierClient struct {
+	config
+}
+
+// NewMemberTierClient returns a client for the MemberTier from the given config.
+func NewMemberTierClient(c config) *MemberTierClient {
+	return &MemberTierClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `membertier.Hooks(f(g(h())))`.
+func (c *MemberTierClient) Use(hooks ...Hook) {
+	c.hooks.MemberTier = append(c.hooks.MemberTier, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `membertier.Intercept(f(g(h())))`.
+func (c *MemberTierClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberTier = append(c.inters.MemberTier, interceptors...)
+}
+
+// Create returns a builder for creating a MemberTier entity.
+func (c *MemberTierClient) Create() *MemberTierCreate {
+	mutation := newMemberTierMutation(c.config, OpCreate)
+	return &MemberTierCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberTier entities.
+func (c *MemberTierClient) CreateBulk(builders ...*MemberTierCreate) *MemberTierCreateBulk {
+	return &MemberTierCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberTierClient) MapCreateBulk(slice any, setFunc func(*MemberTierCreate, int)) *MemberTierCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberTierCreateBulk{err: fmt.Errorf("calling to MemberTierClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberTierCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberTierCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberTier.
+func (c *MemberTierClient) Update() *MemberTierUpdate {
+	mutation := newMemberTierMutation(c.config, OpUpdate)
+	return &MemberTierUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberTierClient) UpdateOne(mt *MemberTier) *MemberTierUpdateOne {
+	mutation := newMemberTierMutation(c.config, OpUpdateOne, withMemberTier(mt))
+	return &MemberTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberTierClient) UpdateOneID(id uuid.UUID) *MemberTierUpdateOne {
+	mutation := newMemberTierMutation(c.config, OpUpdateOne, withMemberTierID(id))
+	return &MemberTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberTier.
+func (c *MemberTierClient) Delete() *MemberTierDelete {
+	mutation := newMemberTierMutation(c.config, OpDelete)
+	return &MemberTierDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberTierClient) DeleteOne(mt *MemberTier) *MemberTierDeleteOne {
+	return c.DeleteOneID(mt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberTierClient) DeleteOneID(id uuid.UUID) *MemberTierDeleteOne {
+	builder := c.Delete().Where(membertier.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberTierDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberTier.
+func (c *MemberTierClient) Query() *MemberTierQuery {
+	return &MemberTierQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberTier},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberTier entity by its id.
+func (c *MemberTierClient) Get(ctx context.Context, id uuid.UUID) (*MemberTier, error) {
+	return c.Query().Where(membertier.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberTierClient) GetX(ctx context.Context, id uuid.UUID) *MemberTier {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MemberTierClient) Hooks() []Hook {
+	hooks := c.hooks.MemberTier
+	return append(hooks[:len(hooks):len(hooks)], membertier.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberTierClient) Interceptors() []Interceptor {
+	inters := c.inters.MemberTier
+	return append(inters[:len(inters):len(inters)], membertier.Interceptors[:]...)
+}
+
+func (c *MemberTierClient) mutate(ctx context.Context, m *MemberTierMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberTierCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberTierUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberTierDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberTier mutation op: %q", m.Op())
+	}
+}
+
+// MenuClient is a client for the Menu schema.
+type MenuClient struct {
+	config
+}
+
+// NewMenuClient returns a client for the Menu from the given config.
+func NewMenuClient(c config) *MenuClient {
+	return &MenuClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `menu.Hooks(f(g(h())))`.
+func (c *MenuClient) Use(hooks ...Hook) {
+	c.hooks.Menu = append(c.hooks.Menu, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `menu.Intercept(f(g(h())))`.
+func (c *MenuClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Menu = append(c.inters.Menu, interceptors...)
+}
+
+// Create returns a builder for creating a Menu entity.
+func (c *MenuClient) Create() *MenuCreate {
+	mutation := newMenuMutation(c.config, OpCreate)
+	return &MenuCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Menu entities.
+func (c *MenuClient) CreateBulk(builders ...*MenuCreate) *MenuCreateBulk {
+	return &MenuCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MenuClient) MapCreateBulk(slice any, setFunc func(*MenuCreate, int)) *MenuCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MenuCreateBulk{err: fmt.Errorf("calling to MenuClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MenuCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MenuCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Menu.
+func (c *MenuClient) Update() *MenuUpdate {
+	mutation := newMenuMutation(c.config, OpUpdate)
+	return &MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MenuClient) UpdateOne(m *Menu) *MenuUpdateOne {
+	mutation := newMenuMutation(c.config, OpUpdateOne, withMenu(m))
+	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MenuClient) UpdateOneID(id uuid.UUID) *MenuUpdateOne {
+	mutation := newMenuMutation(c.config, OpUpdateOne, withMenuID(id))
+	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Menu.
+func (c *MenuClient) Delete() *MenuDelete {
+	mutation := newMenuMutation(c.config, OpDelete)
+	return &MenuDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MenuClient) DeleteOne(m *Menu) *MenuDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MenuClient) DeleteOneID(id uuid.UUID) *MenuDeleteOne {
+	builder := c.Delete().Where(menu.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MenuDeleteOne{builder}
+}
+
+// Query returns a query builder for Menu.
+func (c *MenuClient) Query() *MenuQuery {
+	return &MenuQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMenu},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Menu entity by its id.
+func (c *MenuClient) Get(ctx context.Context, id uuid.UUID) (*Menu, error) {
+	return c.Query().Where(menu.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MenuClient) GetX(ctx context.Context, id uuid.UUID) *Menu {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItems queries the items edge of a Menu.
+func (c *MenuClient) QueryItems(m *Menu) *MenuItemQuery {
+	query := (&MenuItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menu.Table, menu.FieldID, id),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menu.ItemsTable, menu.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStores queries the stores edge of a Menu.
+func (c *MenuClient) QueryStores(m *Menu) *StoreQuery {
+	query := (&StoreClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menu.Table, menu.FieldID, id),
+			sqlgraph.To(store.Table, store.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, menu.StoresTable, menu.StoresPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MenuClient) Hooks() []Hook {
+	hooks := c.hooks.Menu
+	return append(hooks[:len(hooks):len(hooks)], menu.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MenuClient) Interceptors() []Interceptor {
+	inters := c.inters.Menu
+	return append(inters[:len(inters):len(inters)], menu.Interceptors[:]...)
+}
+
+func (c *MenuClient) mutate(ctx context.Context, m *MenuMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MenuCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MenuDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Menu mutation op: %q", m.Op())
+	}
+}
+
+// MenuItemClient is a client for the MenuItem schema.
+type MenuItemClient struct {
+	config
+}
+
+// NewMenuItemClient returns a client for the MenuItem from the given config.
+func NewMenuItemClient(c config) *MenuItemClient {
+	return &MenuItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `menuitem.Hooks(f(g(h())))`.
+func (c *MenuItemClient) Use(hooks ...Hook) {
+	c.hooks.MenuItem = append(c.hooks.MenuItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `menuitem.Intercept(f(g(h())))`.
+func (c *MenuItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MenuItem = append(c.inters.MenuItem, interceptors...)
+}
+
+// Create returns a builder for creating a MenuItem entity.
+func (c *MenuItemClient) Create() *MenuItemCreate {
+	mutation := newMenuItemMutation(c.config, OpCreate)
+	return &MenuItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MenuItem entities.
+func (c *MenuItemClient) CreateBulk(builders ...*MenuItemCreate) *MenuItemCreateBulk {
+	return &MenuItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MenuItemClient) MapCreateBulk(slice any, setFunc func(*MenuItemCreate, int)) *MenuItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MenuItemCreateBulk{err: fmt.Errorf("calling to MenuItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MenuItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MenuItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MenuItem.
+func (c *MenuItemClient) Update() *MenuItemUpdate {
+	mutation := newMenuItemMutation(c.config, OpUpdate)
+	return &MenuItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MenuItemClient) UpdateOne(mi *MenuItem) *MenuItemUpdateOne {
+	mutation := newMenuItemMutation(c.config, OpUpdateOne, withMenuItem(mi))
+	return &MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MenuItemClient) UpdateOneID(id uuid.UUID) *MenuItemUpdateOne {
+	mutation := newMenuItemMutation(c.config, OpUpdateOne, withMenuItemID(id))
+	return &MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MenuItem.
+func (c *MenuItemClient) Delete() *MenuItemDelete {
+	mutation := newMenuItemMutation(c.config, OpDelete)
+	return &MenuItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MenuItemClient) DeleteOne(mi *MenuItem) *MenuItemDeleteOne {
+	return c.DeleteOneID(mi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MenuItemClient) DeleteOneID(id uuid.UUID) *MenuItemDeleteOne {
+	builder := c.Delete().Where(menuitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MenuItemDeleteOne{builder}
+}
+
+// Query returns a query builder for MenuItem.
+func (c *MenuItemClient) Query() *MenuItemQuery {
+	return &MenuItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMenuItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MenuItem entity by its id.
+func (c *MenuItemClient) Get(ctx context.Context, id uuid.UUID) (*MenuItem, error) {
+	return c.Query().Where(menuitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MenuItemClient) GetX(ctx context.Context, id uuid.UUID) *MenuItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMenu queries the menu edge of a MenuItem.
+func (c *MenuItemClient) QueryMenu(mi *MenuItem) *MenuQuery {
+	query := (&MenuClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.To(menu.Table, menu.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menuitem.MenuTable, menuitem.MenuColumn),
+		)
+		fromV = sqlgraph.Neighbors(mi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProduct queries the product edge of a MenuItem.
+func (c *MenuItemClient) QueryProduct(mi *MenuItem) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menuitem.ProductTable, menuitem.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(mi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MenuItemClient) Hooks() []Hook {
+	hooks := c.hooks.MenuItem
+	return append(hooks[:len(hooks):len(hooks)], menuitem.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MenuItemClient) Interceptors() []Interceptor {
+	inters := c.inters.MenuItem
+	return append(inters[:len(inters):len(inters)], menuitem.Interceptors[:]...)
+}
+
+func (c *MenuItemClient) mutate(ctx context.Context, m *MenuItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MenuItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MenuItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MenuItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MenuItem mutation op: %q", m.Op())
+	}
+}
+
+// MenuVersionClient is a client for the MenuVersion schema.
+type MenuVersionClient struct {
+	config
+}
+
+// NewMenuVersionClient returns a client for the MenuVersion from the given config.
+func NewMenuVersionClient(c config) *MenuVersionClient {
+	return &MenuVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `menuversion.Hooks(f(g(h())))`.
+func (c *MenuVersionClient) Use(hooks ...Hook) {
+	c.hooks.MenuVersion = append(c.hooks.MenuVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `menuversion.Intercept(f(g(h())))`.
+func (c *MenuVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MenuVersion = append(c.inters.MenuVersion, interceptors...)
+}
+
+// Create returns a builder for creating a MenuVersion entity.
+func (c *MenuVersionClient) Create() *MenuVersionCreate {
+	mutation := newMenuVersionMutation(c.config, OpCreate)
+	return &MenuVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MenuVersion entities.
+func (c *MenuVersionClient) CreateBulk(builders ...*MenuVersionCreate) *MenuVersionCreateBulk {
+	return &MenuVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MenuVersionClient) MapCreateBulk(slice any, setFunc func(*MenuVersionCreate, int)) *MenuVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MenuVersionCreateBulk{err: fmt.Errorf("calling to MenuVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MenuVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MenuVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MenuVersion.
+func (c *MenuVersionClient) Update() *MenuVersionUpdate {
+	mutation := newMenuVersionMutation(c.config, OpUpdate)
+	return &MenuVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MenuVersionClient) UpdateOne(mv *MenuVersion) *MenuVersionUpdateOne {
+	mutation := newMenuVersionMutation(c.config, OpUpdateOne, withMenuVersion(mv))
+	return &MenuVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MenuVersionClient) UpdateOneID(id uuid.UUID) *MenuVersionUpdateOne {
+	mutation := newMenuVersionMutation(c.config, OpUpdateOne, withMenuVersionID(id))
+	return &MenuVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MenuVersion.
+func (c *MenuVersionClient) Delete() *MenuVersionDelete {
+	mutation := newMenuVersionMutation(c.config, OpDelete)
+	return &MenuVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MenuVersionClient) DeleteOne(mv *MenuVersion) *MenuVersionDeleteOne {
+	return c.DeleteOneID(mv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MenuVersionClient) DeleteOneID(id uuid.UUID) *MenuVersionDeleteOne {
+	builder := c.Delete().Where(menuversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MenuVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for MenuVersion.
+func (c *MenuVersionClient) Query() *MenuVersionQuery {
+	return &MenuVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMenuVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MenuVersion entity by its id.
+func (c *MenuVersionClient) Get(ctx context.Context, id uuid.UUID) (*MenuVersion, error) {
+	return c.Query().Where(menuversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MenuVersionClient) GetX(ctx context.Context, id uuid.UUID) *MenuVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MenuVersionClient) Hooks() []Hook {
+	return c.hooks.MenuVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *MenuVersionClient) Interceptors() []Interceptor {
+	return c.inters.MenuVersion
+}
+
+func (c *MenuVersionClient) mutate(ctx context.Context, m *MenuVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MenuVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MenuVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MenuVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MenuVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MenuVersion mutation op: %q", m.Op())
+	}
+}
+
+// MerchantClient is a client for the Merchant schema.
+type MerchantClient struct {
+	config
+}
+
+// NewMerchantClient returns a client for the Merchant from the given config.
+func NewMerchantClient(c config) *MerchantClient {
+	return &MerchantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `merchant.Hooks(f(g(h())))`.
+func (c *MerchantClient) Use(hooks ...Hook) {
+	c.hooks.Merchant = append(c.hooks.Merchant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `merchant.Intercept(f(g(h())))`.
+func (c *MerchantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Merchant = append(c.inters.Merchant, interceptors...)
+}
+
+// Create returns a builder for creating a Merchant entity.
+func (c *MerchantClient) Create() *MerchantCreate {
+	mutation := newMerchantMutation(c.config, OpCreate)
+	return &MerchantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Merchant entities.
+func (c *MerchantClient) CreateBulk(builders ...*MerchantCreate) *MerchantCreateBulk {
+	return &MerchantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MerchantClient) MapCreateBulk(slice any, setFunc func(*MerchantCreate, int)) *MerchantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MerchantCreateBulk{err: fmt.Errorf("calling to MerchantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MerchantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MerchantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Merchant.
+func (c *MerchantClient) Update() *MerchantUpdate {
+	mutation := newMerchantMutation(c.config, OpUpdate)
+	return &MerchantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MerchantClient) UpdateOne(m *Merchant) *MerchantUpdateOne {
+	mutation := newMerchantMutation(c.config, OpUpdateOne, withMerchant(m))
+	return &MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MerchantClient) UpdateOneID(id uuid.UUID) *MerchantUpdateOne {
+	mutation := newMerchantMutation(c.config, OpUpdateOne, withMerchantID(id))
+	return &MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Merchant.
+func (c *MerchantClient) Delete() *MerchantDelete {
+	mutation := newMerchantMutation(c.config, OpDelete)
+	return &MerchantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MerchantClient) DeleteOne(m *Merchant) *MerchantDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MerchantClient) DeleteOneID(id uuid.UUID) *MerchantDeleteOne {
+	builder := c.Delete().Where(merchant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MerchantDeleteOne{builder}
+}
+
+// Query returns a query builder for Merchant.
+func (c *MerchantClient) Query() *MerchantQuery {
+	return &MerchantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMerchant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Merchant entity by its id.
+func (c *MerchantClient) Get(ctx context.Context, id uuid.UUID) (*Merchant, error) {
+	return c.Query().Where(merchant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MerchantClient) GetX(ctx context.Context, id uuid.UUID) *Merchant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBackendUsers queries the backend_users edge of a Merchant.
+func (c *MerchantClient) QueryBackendUsers(m *Merchant) *BackendUserQuery {
+	query := (&BackendUserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(backenduser.Table, backenduser.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.BackendUsersTable, merchant.BackendUsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStores queries the stores edge of a Merchant.
+func (c *MerchantClient) QueryStores(m *Merchant) *StoreQuery {
+	query := (&StoreClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(store.Table, store.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.StoresTable, merchant.StoresColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMerchantRenewals queries the merchant_renewals edge of a Merchant.
+func (c *MerchantClient) QueryMerchantRenewals(m *Merchant) *MerchantRenewalQuery {
+	query := (&MerchantRenewalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(merchantrenewal.Table, merchantrenewal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.MerchantRenewalsTable, merchant.MerchantRenewalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRemarks queries the remarks edge of a Merchant.
+func (c *MerchantClient) QueryRemarks(m *Merchant) *RemarkQuery {
+	query := (&RemarkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(remark.Table, remark.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.RemarksTable, merchant.RemarksColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStalls queries the stalls edge of a Merchant.
+func (c *MerchantClient) QueryStalls(m *Merchant) *StallQuery {
+	query := (&StallClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(stall.Table, stall.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.StallsTable, merchant.StallsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAdditionalFees queries the additional_fees edge of a Merchant.
+func (c *MerchantClient) QueryAdditionalFees(m *Merchant) *AdditionalFeeQuery {
+	query := (&AdditionalFeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(additionalfee.Table, additionalfee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.AdditionalFeesTable, merchant.AdditionalFeesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTaxFees queries the tax_fees edge of a Merchant.
+func (c *MerchantClient) QueryTaxFees(m *Merchant) *TaxFeeQuery {
+	query := (&TaxFeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(taxfee.Table, taxfee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.TaxFeesTable, merchant.TaxFeesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevices queries the devices edge of a Merchant.
+func (c *MerchantClient) QueryDevices(m *Merchant) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.DevicesTable, merchant.DevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDepartments queries the departments edge of a Merchant.
+func (c *MerchantClient) QueryDepartments(m *Merchant) *DepartmentQuery {
+	query := (&DepartmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.DepartmentsTable, merchant.DepartmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoles queries the roles edge of a Merchant.
+func (c *MerchantClient) QueryRoles(m *Merchant) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.RolesTable, merchant.RolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStoreUsers queries the store_users edge of a Merchant.
+func (c *MerchantClient) QueryStoreUsers(m *Merchant) *StoreUserQuery {
+	query := (&StoreUserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(storeuser.Table, storeuser.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.StoreUsersTable, merchant.StoreUsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProfitDistributionBills queries the profit_distribution_bills edge of a Merchant.
+func (c *MerchantClient) QueryProfitDistributionBills(m *Merchant) *ProfitDistributionBillQuery {
+	query := (&ProfitDistributionBillClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(profitdistributionbill.Table, profitdistributionbill.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.ProfitDistributionBillsTable, merchant.ProfitDistributionBillsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MerchantClient) Hooks() []Hook {
+	hooks := c.hooks.Merchant
+	return append(hooks[:len(hooks):len(hooks)], merchant.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MerchantClient) Interceptors() []Interceptor {
+	inters := c.inters.Merchant
+	return append(inters[:len(inters):len(inters)], merchant.Interceptors[:]...)
+}
+
+func (c *MerchantClient) mutate(ctx context.Context, m *MerchantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MerchantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MerchantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MerchantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Merchant mutation op: %q", m.Op())
+	}
+}
+
+// MerchantBusinessTypeClient is a client for the MerchantBusinessType schema.
+type MerchantBusinessTypeClient struct {
+	config
+}
+
+// NewMerchantBusinessTypeClient returns a client for the MerchantBusinessType from the given config.
+func NewMerchantBusinessTypeClient(c config) *MerchantBusinessTypeClient {
+	return &MerchantBusinessTypeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `merchantbusinesstype.Hooks(f(g(h())))`.
+func (c *MerchantBusinessTypeClient) Use(hooks ...Hook) {
+	c.hooks.MerchantBusinessType = append(c.hooks.MerchantBusinessType, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `merchantbusinesstype.Intercept(f(g(h())))`.
+func (c *MerchantBusinessTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MerchantBusinessType = append(c.inters.MerchantBusinessType, interceptors...)
+}
+
+// Create returns a builder for creating a MerchantBusinessType entity.
+func (c *MerchantBusinessTypeClient) Create() *MerchantBusinessTypeCreate {
+	mutation := newMerchantBusinessTypeMutation(c.config, OpCreate)
+	return &MerchantBusinessTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MerchantBusinessType entities.
+func (c *MerchantBusinessTypeClient) CreateBulk(builders ...*MerchantBusinessTypeCreate) *MerchantBusinessTypeCreateBulk {
+	return &MerchantBusinessTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MerchantBusinessTypeClient) MapCreateBulk(slice any, setFunc func(*MerchantBusinessTypeCreate, int)) *MerchantBusinessTypeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MerchantBusinessTypeCreateBulk{err: fmt.Errorf("calling to MerchantBusinessTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MerchantBusinessTypeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MerchantBusinessTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MerchantBusinessType.
+func (c *MerchantBusinessTypeClient) Update() *MerchantBusinessTypeUpdate {
+	mutation := newMerchantBusinessTypeMutation(c.config, OpUpdate)
+	return &MerchantBusinessTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MerchantBusinessTypeClient) UpdateOne(mbt *MerchantBusinessType) *MerchantBusinessTypeUpdateOne {
+	mutation := newMerchantBusinessTypeMutation(c.config, OpUpdateOne, withMerchantBusinessType(mbt))
+	return &MerchantBusinessTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MerchantBusinessTypeClient) UpdateOneID(id uuid.UUID) *MerchantBusinessTypeUpdateOne {
+	mutation := newMerchantBusinessTypeMutation(c.config, OpUpdateOne, withMerchantBusinessTypeID(id))
+	return &MerchantBusinessTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MerchantBusinessType.
+func (c *MerchantBusinessTypeClient) Delete() *MerchantBusinessTypeDelete {
+	mutation := newMerchantBusinessTypeMutation(c.config, OpDelete)
+	return &MerchantBusinessTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MerchantBusinessTypeClient) DeleteOne(mbt *MerchantBusinessType) *MerchantBusinessTypeDeleteOne {
+	return c.DeleteOneID(mbt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MerchantBusinessTypeClient) DeleteOneID(id uuid.UUID) *MerchantBusinessTypeDeleteOne {
+	builder := c.Delete().Where(merchantbusinesstype.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MerchantBusinessTypeDeleteOne{builder}
+}
+
+// Query returns a query builder for MerchantBusinessType.
+func (c *MerchantBusinessTypeClient) Query() *MerchantBusinessTypeQuery {
+	return &MerchantBusinessTypeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMerchantBusinessType},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MerchantBusinessType entity by its id.
+func (c *MerchantBusinessTypeClient) Get(ctx context.Context, id uuid.UUID) (*MerchantBusinessType, error) {
+	return c.Query().Where(merchantbusinesstype.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MerchantBusinessTypeClient) GetX(ctx context.Context, id uuid.UUID) *MerchantBusinessType {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MerchantBusinessTypeClient) Hooks() []Hook {
+	hooks := c.hooks.MerchantBusinessType
+	return append(hooks[:len(hooks):len(hooks)], merchantbusinesstype.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MerchantBusinessTypeClient) Interceptors() []Interceptor {
+	inters := c.inters.MerchantBusinessType
+	return append(inters[:len(inters):len(inters)], merchantbusinesstype.Interceptors[:]...)
+}
+
+func (c *MerchantBusinessTypeClient) mutate(ctx context.Context, m *MerchantBusinessTypeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MerchantBusinessTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MerchantBusinessTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MerchantBusinessTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MerchantBusinessTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MerchantBusinessType mutation op: %q", m.Op())
+	}
+}
+
+// MerchantRenewalClient is a client for the MerchantRenewal schema.
+type MerchantRenewalClient struct {
+	config
+}
+
+// NewMerchantRenewalClient returns a client for the MerchantRenewal from the given config.
+func NewMerchantRenewalClient(c config) *MerchantRenewalClient {
+	return &MerchantRenewalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `merchantrenewal.Hooks(f(g(h())))`.
+func (c *MerchantRenewalClient) Use(hooks ...Hook) {
+	c.hooks.MerchantRenewal = append(c.hooks.MerchantRenewal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `merchantrenewal.Intercept(f(g(h())))`.
+func (c *MerchantRenewalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MerchantRenewal = append(c.inters.MerchantRenewal, interceptors...)
+}
+
+// Create returns a builder for creating a MerchantRenewal entity.
+func (c *MerchantRenewalClient) Create() *MerchantRenewalCreate {
+	mutation := newMerchantRenewalMutation(c.config, OpCreate)
+	return &MerchantRenewalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MerchantRenewal entities.
+func (c *MerchantRenewalClient) CreateBulk(builders ...*MerchantRenewalCreate) *MerchantRenewalCreateBulk {
+	return &MerchantRenewalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MerchantRenewalClient) MapCreateBulk(slice any, setFunc func(*MerchantRenewalCreate, int)) *MerchantRenewalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MerchantRenewalCreateBulk{err: fmt.Errorf("calling to MerchantRenewalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MerchantRenewalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MerchantRenewalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MerchantRenewal.
+func (c *MerchantRenewalClient) Update() *MerchantRenewalUpdate {
+	mutation := newMerchantRenewalMutation(c.config, OpUpdate)
+	return &MerchantRenewalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MerchantRenewalClient) UpdateOne(mr *MerchantRenewal) *MerchantRenewalUpdateOne {
+	mutation := newMerchantRenewalMutation(c.config, OpUpdateOne, withMerchantRenewal(mr))
+	return &MerchantRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MerchantRenewalClient) UpdateOneID(id uuid.UUID) *MerchantRenewalUpdateOne {
+	mutation := newMerchantRenewalMutation(c.config, OpUpdateOne, withMerchantRenewalID(id))
+	return &MerchantRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MerchantRenewal.
+func (c *MerchantRenewalClient) Delete() *MerchantRenewalDelete {
+	mutation := newMerchantRenewalMutation(c.config, OpDelete)
+	return &MerchantRenewalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MerchantRenewalClient) DeleteOne(mr *MerchantRenewal) *MerchantRenewalDeleteOne {
+	return c.DeleteOneID(mr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MerchantRenewalClient) DeleteOneID(id uuid.UUID) *MerchantRenewalDeleteOne {
+	builder := c.Delete().Where(merchantrenewal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MerchantRenewalDeleteOne{builder}
+}
+
+// Query returns a query builder for MerchantRenewal.
+func (c *MerchantRenewalClient) Query() *MerchantRenewalQuery {
+	return &MerchantRenewalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMerchantRenewal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MerchantRenewal entity by its id.
+func (c *MerchantRenewalClient) Get(ctx context.Context, id uuid.UUID) (*MerchantRenewal, error) {
+	return c.Query().Where(merchantrenewal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MerchantRenewalClient) GetX(ctx context.Context, id uuid.UUID) *MerchantRenewal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMerchant queries the merchant edge of a MerchantRenewal.
+func (c *MerchantRenewalClient) QueryMerchant(mr *MerchantRenewal) *MerchantQuery {
+	query := (&MerchantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchantrenewal.Table, merchantrenewal.FieldID, id),
+			sqlgraph.To(merchant.Table, merchant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, merchantrenewal.MerchantTable, merchantrenewal.MerchantColumn),
+		)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MerchantRenewalClient) Hooks() []Hook {
+	hooks := c.hooks.MerchantRenewal
+	return append(hooks[:len(hooks):len(hooks)], merchantrenewal.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MerchantRenewalClient) Interceptors() []Interceptor {
+	inters := c.inters.MerchantRenewal
+	return append(inters[:len(inters):len(inters)], merchantrenewal.Interceptors[:]...)
+}
+
+func (c *MerchantRenewalClient) mutate(ctx context.Context, m *MerchantRenewalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MerchantRenewalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MerchantRenewalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MerchantRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MerchantRenewalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MerchantRenewal mutation op: %q", m.Op())
+	}
+}
+
+// OrderClient is a client for the Order schema.
+type OrderClient struct {
+	config
+}
+
+// NewOrderClient returns a client for the Order from the given config.
+func NewOrderClient(c config) *OrderClient {
+	return &OrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `order.Hooks(f(g(h())))`.
+func (c *OrderClient) Use(hooks ...Hook) {
+	c.hooks.Order = append(c.hooks.Order, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `order.Intercept(f(g(h())))`.
+func (c *OrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Order = append(c.inters.Order, interceptors...)
+}
+
+// Create returns a builder for creating a Order entity.
+func (c *OrderClient) Create() *OrderCreate {
+	mutation := newOrderMutation(c.config, OpCreate)
+	return &OrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Order entities.
+func (c *OrderClient) CreateBulk(builders ...*OrderCreate) *OrderCreateBulk {
+	return &OrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderClient) MapCreateBulk(slice any, setFunc func(*OrderCreate, int)) *OrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderCreateBulk{err: fmt.Errorf("calling to OrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Order.
+func (c *OrderClient) Update() *OrderUpdate {
+	mutation := newOrderMutation(c.config, OpUpdate)
+	return &OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderClient) UpdateOne(o *Order) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrder(o))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderClient) UpdateOneID(id uuid.UUID) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrderID(id))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Order.
+func (c *OrderClient) Delete() *OrderDelete {
+	mutation := newOrderMutation(c.config, OpDelete)
+	return &OrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderClient) DeleteOne(o *Order) *OrderDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderClient) DeleteOneID(id uuid.UUID) *OrderDeleteOne {
+	builder := c.Delete().Where(order.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderDeleteOne{builder}
+}
+
+// Query returns a query builder for Order.
+func (c *OrderClient) Query() *OrderQuery {
+	return &OrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Order entity by its id.
+func (c *OrderClient) Get(ctx context.Context, id uuid.UUID) (*Order, error) {
+	return c.Query().Where(order.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderClient) GetX(ctx context.Context, id uuid.UUID) *Order {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrderProducts queries the order_products edge of a Order.
+func (c *OrderClient) QueryOrderProducts(o *Order) *OrderProductQuery {
+	query := (&OrderProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(orderproduct.Table, orderproduct.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.OrderProductsTable, order.OrderProductsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderClient) Hooks() []Hook {
+	hooks := c.hooks.Order
+	return append(hooks[:len(hooks):len(hooks)], order.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderClient) Interceptors() []Interceptor {
+	inters := c.inters.Order
+	return append(inters[:len(inters):len(inters)], order.Interceptors[:]...)
+}
+
+func (c *OrderClient) mutate(ctx context.Context, m *OrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Order mutation op: %q", m.Op())
+	}
+}
+
+// OrderProductClient is a client for the OrderProduct schema.
+type OrderProductClient struct {
+	config
+}
+
+// NewOrderProductClient returns a client for the OrderProduct from the given config.
+func NewOrderProductClient(c config) *OrderProductClient {
+	return &OrderProductClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orderproduct.Hooks(f(g(h())))`.
+func (c *OrderProductClient) Use(hooks ...Hook) {
+	c.hooks.OrderProduct = append(c.hooks.OrderProduct, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orderproduct.Intercept(f(g(h())))`.
+func (c *OrderProductClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderProduct = append(c.inters.OrderProduct, interceptors...)
+}
+
+// Create returns a builder for creating a OrderProduct entity.
+func (c *OrderProductClient) Create() *OrderProductCreate {
+	mutation := newOrderProductMutation(c.config, OpCreate)
+	return &OrderProductCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderProduct entities.
+func (c *OrderProductClient) CreateBulk(builders ...*OrderProductCreate) *OrderProductCreateBulk {
+	return &OrderProductCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderProductClient) MapCreateBulk(slice any, setFunc func(*OrderProductCreate, int)) *OrderProductCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderProductCreateBulk{err: fmt.Errorf("calling to OrderProductClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderProductCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderProductCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderProduct.
+func (c *OrderProductClient) Update() *OrderProductUpdate {
+	mutation := newOrderProductMutation(c.config, OpUpdate)
+	return &OrderProductUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderProductClient) UpdateOne(op *OrderProduct) *OrderProductUpdateOne {
+	mutation := newOrderProductMutation(c.config, OpUpdateOne, withOrderProduct(op))
+	return &OrderProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderProductClient) UpdateOneID(id uuid.UUID) *OrderProductUpdateOne {
+	mutation := newOrderProductMutation(c.config, OpUpdateOne, withOrderProductID(id))
+	return &OrderProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderProduct.
+func (c *OrderProductClient) Delete() *OrderProductDelete {
+	mutation := newOrderProductMutation(c.config, OpDelete)
+	return &OrderProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderProductClient) DeleteOne(op *OrderProduct) *OrderProductDeleteOne {
+	return c.DeleteOneID(op.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderProductClient) DeleteOneID(id uuid.UUID) *OrderProductDeleteOne {
+	builder := c.Delete().Where(orderproduct.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderProductDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderProduct.
+func (c *OrderProductClient) Query() *OrderProductQuery {
+	return &OrderProductQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderProduct},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderProduct entity by its id.
+func (c *OrderProductClient) Get(ctx context.Context, id uuid.UUID) (*OrderProduct, error) {
+	return c.Query().Where(orderproduct.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderProductClient) GetX(ctx context.Context, id uuid.UUID) *OrderProduct {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a OrderProduct.
+func (c *OrderProductClient) QueryOrder(op *OrderProduct) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := op.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderproduct.Table, orderproduct.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderproduct.OrderTable, orderproduct.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(op.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderProductClient) Hooks() []Hook {
+	hooks := c.hooks.OrderProduct
+	return append(hooks[:len(hooks):len(hooks)], orderproduct.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderProductClient) Interceptors() []Interceptor {
+	inters := c.inters.OrderProduct
+	return append(inters[:len(inters):len(inters)], orderproduct.Interceptors[:]...)
+}
+
+func (c *OrderProductClient) mutate(ctx context.Context, m *OrderProductMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderProductCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderProductUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderProductDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrderProduct mutation op: %q", m.Op())
+	}
+}
+
+// PaymentAccountClient is a client for the PaymentAccount schema.
+type PaymentAccountClient struct {
+	config
+}
+
+// NewPaymentAccountClient returns a client for the PaymentAccount from the given config.
+func NewPaymentAccountClient(c config) *PaymentAccountClient {
+	return &PaymentAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentaccount.Hooks(f(g(h())))`.
+func (c *PaymentAccountClient) Use(hooks ...Hook) {
+	c.hooks.PaymentAccount = append(c.hooks.PaymentAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentaccount.Intercept(f(g(h())))`.
+func (c *PaymentAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentAccount = append(c.inters.PaymentAccount, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentAccount entity.
+func (c *PaymentAccountClient) Create() *PaymentAccountCreate {
+	mutation := newPaymentAccountMutation(c.config, OpCreate)
+	return &PaymentAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentAccount entities.
+func (c *PaymentAccountClient) CreateBulk(builders ...*PaymentAccountCreate) *PaymentAccountCreateBulk {
+	return &PaymentAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentAccountClient) MapCreateBulk(slice any, setFunc func(*PaymentAccountCreate, int)) *PaymentAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentAccountCreateBulk{err: fmt.Errorf("calling to PaymentAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentAccount.
+func (c *PaymentAccountClient) Update() *PaymentAccountUpdate {
+	mutation := newPaymentAccountMutation(c.config, OpUpdate)
+	return &PaymentAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentAccountClient) UpdateOne(pa *PaymentAccount) *PaymentAccountUpdateOne {
+	mutation := newPaymentAccountMutation(c.config, OpUpdateOne, withPaymentAccount(pa))
+	return &PaymentAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentAccountClient) UpdateOneID(id uuid.UUID) *PaymentAccountUpdateOne {
+	mutation := newPaymentAccountMutation(c.config, OpUpdateOne, withPaymentAccountID(id))
+	return &PaymentAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentAccount.
+func (c *PaymentAccountClient) Delete() *PaymentAccountDelete {
+	mutation := newPaymentAccountMutation(c.config, OpDelete)
+	return &PaymentAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentAccountClient) DeleteOne(pa *PaymentAccount) *PaymentAccountDeleteOne {
+	return c.DeleteOneID(pa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentAccountClient) DeleteOneID(id uuid.UUID) *PaymentAccountDeleteOne {
+	builder := c.Delete().Where(paymentaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentAccount.
+func (c *PaymentAccountClient) Query() *PaymentAccountQuery {
+	return &PaymentAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentAccount entity by its id.
+func (c *PaymentAccountClient) Get(ctx context.Context, id uuid.UUID) (*PaymentAccount, error) {
+	return c.Query().Where(paymentaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentAccountClient) GetX(ctx context.Context, id uuid.UUID) *PaymentAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStorePaymentAccounts queries the store_payment_accounts edge of a PaymentAccount.
+func (c *PaymentAccountClient) QueryStorePaymentAccounts(pa *PaymentAccount) *StorePaymentAccountQuery {
+	query := (&StorePaymentAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentaccount.Table, paymentaccount.FieldID, id),
+			sqlgraph.To(storepaymentaccount.Table, storepaymentaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentaccount.StorePaymentAccountsTable, paymentaccount.StorePaymentAccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentAccountClient) Hooks() []Hook {
+	hooks := c.hooks.PaymentAccount
+	return append(hooks[:len(hooks):len(hooks)], paymentaccount.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentAccountClient) Interceptors() []Interceptor {
+	inters := c.inters.PaymentAccount
+	return append(inters[:len(inters):len(inters)], paymentaccount.Interceptors[:]...)
+}
+
+func (c *PaymentAccountClient) mutate(ctx context.Context, m *PaymentAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentAccount mutation op: %q", m.Op())
+	}
+}
+
+// PaymentMethodClient is a client for the PaymentMethod schema.
+type PaymentMethodClient struct {
+	config
+}
+
+// NewPaymentMethodClient returns a client for the PaymentMethod from the given config.
+func NewPaymentMethodClient(c config) *PaymentMethodClient {
+	return &PaymentMethodClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentmethod.Hooks(f(g(h())))`.
+func (c *PaymentMethodClient) Use(hooks ...Hook) {
+	c.hooks.PaymentMethod = append(c.hooks.PaymentMethod, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentmethod.Intercept(f(g(h())))`.
+func (c *PaymentMethodClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentMethod = append(c.inters.PaymentMethod, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentMethod entity.
+func (c *PaymentMethodClient) Create() *PaymentMethodCreate {
+	mutation := newPaymentMethodMutation(c.config, OpCreate)
+	return &PaymentMethodCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentMethod entities.
+func (c *PaymentMethodClient) CreateBulk(builders ...*PaymentMethodCreate) *PaymentMethodCreateBulk {
+	return &PaymentMethodCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentMethodClient) MapCreateBulk(slice any, setFunc func(*PaymentMethodCreate, int)) *PaymentMethodCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentMethodCreateBulk{err: fmt.Errorf("calling to PaymentMethodClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentMethodCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentMethodCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentMethod.
+func (c *PaymentMethodClient) Update() *PaymentMethodUpdate {
+	mutation := newPaymentMethodMutation(c.config, OpUpdate)
+	return &PaymentMethodUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentMethodClient) UpdateOne(pm *PaymentMethod) *PaymentMethodUpdateOne {
+	mutation := newPaymentMethodMutation(c.config, OpUpdateOne, withPaymentMethod(pm))
+	return &PaymentMethodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentMethodClient) UpdateOneID(id uuid.UUID) *PaymentMethodUpdateOne {
+	mutation := newPaymentMethodMutation(c.config, OpUpdateOne, withPaymentMethodID(id))
+	return &PaymentMethodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentMethod.
+func (c *PaymentMethodClient) Delete() *PaymentMethodDelete {
+	mutation := newPaymentMethodMutation(c.config, OpDelete)
+	return &PaymentMethodDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentMethodClient) DeleteOne(pm *PaymentMethod) *PaymentMethodDeleteOne {
+	return c.DeleteOneID(pm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentMethodClient) DeleteOneID(id uuid.UUID) *PaymentMethodDeleteOne {
+	builder := c.Delete().Where(paymentmethod.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentMethodDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentMethod.
+func (c *PaymentMethodClient) Query() *PaymentMethodQuery {
+	return &PaymentMethodQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentMethod},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentMethod entity by its id.
+func (c *PaymentMethodClient) Get(ctx context.Context, id uuid.UUID) (*PaymentMethod, error) {
+	return c.Query().Where(paymentmethod.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentMethodClient) GetX(ctx context.Context, id uuid.UUID) *PaymentMethod {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentMethodClient) Hooks() []Hook {
+	hooks := c.hooks.PaymentMethod
+	return append(hooks[:len(hooks):len(hooks)], paymentmethod.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentMethodClient) Interceptors() []Interceptor {
+	inters := c.inters.PaymentMethod
+	return append(inters[:len(inters):len(inters)], paymentmethod.Interceptors[:]...)
+}
+
+func (c *PaymentMethodClient) mutate(ctx context.Context, m *PaymentMethodMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentMethodCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentMethodUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentMethodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentMethodDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentMethod mutation op: %q", m.Op())
+	}
+}
+
+// PermissionClient is a client for the Permission schema.
+type PermissionClient struct {
+	config
+}
+
+// NewPermissionClient returns a client for the Permission from the given config.
+func NewPermissionClient(c config) *PermissionClient {
+	return &PermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `permission.Hooks(f(g(h())))`.
+func (c *PermissionClient) Use(hooks ...Hook) {
+	c.hooks.Permission = append(c.hooks.Permission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `permission.Intercept(f(g(h())))`.
+func (c *PermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Permission = append(c.inters.Permission, interceptors...)
+}
+
+// Create returns a builder for creating a Permission entity.
+func (c *PermissionClient) Create() *PermissionCreate {
+	mutation := newPermissionMutation(c.config, OpCreate)
+	return &PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Permission entities.
+func (c *PermissionClient) CreateBulk(builders ...*PermissionCreate) *PermissionCreateBulk {
+	return &PermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PermissionClient) MapCreateBulk(slice any, setFunc func(*PermissionCreate, int)) *PermissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PermissionCreateBulk{err: fmt.Errorf("calling to PermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PermissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Permission.
+func (c *PermissionClient) Update() *PermissionUpdate {
+	mutation := newPermissionMutation(c.config, OpUpdate)
+	return &PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PermissionClient) UpdateOne(pe *Permission) *PermissionUpdateOne {
+	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermission(pe))
+	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PermissionClient) UpdateOneID(id uuid.UUID) *PermissionUpdateOne {
+	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermissionID(id))
+	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Permission.
+func (c *PermissionClient) Delete() *PermissionDelete {
+	mutation := newPermissionMutation(c.config, OpDelete)
+	return &PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PermissionClient) DeleteOne(pe *Permission) *PermissionDeleteOne {
+	return c.DeleteOneID(pe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PermissionClient) DeleteOneID(id uuid.UUID) *PermissionDeleteOne {
+	builder := c.Delete().Where(permission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PermissionDeleteOne{builder}
+}
+
+// Query returns a query builder for Permission.
+func (c *PermissionClient) Query() *PermissionQuery {
+	return &PermissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePermission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Permission entity by its id.
+func (c *PermissionClient) Get(ctx context.Context, id uuid.UUID) (*Permission, error) {
+	return c.Query().Where(permission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PermissionClient) GetX(ctx context.Context, id uuid.UUID) *Permission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMenu queries the menu edge of a Permission.
+func (c *PermissionClient) QueryMenu(pe *Permission) *RouterMenuQuery {
+	query := (&RouterMenuClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permission.Table, permission.FieldID, id),
+			sqlgraph.To(routermenu.Table, routermenu.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, permission.MenuTable, permission.MenuColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryStalls queries the stalls edge of a Merchant.
-func (c *MerchantClient) QueryStalls(m *Merchant) *StallQuery {
-	query := (&StallClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
-			sqlgraph.To(stall.Table, stall.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.StallsTable, merchant.StallsColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *PermissionClient) Hooks() []Hook {
+	hooks := c.hooks.Permission
+	return append(hooks[:len(hooks):len(hooks)], permission.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PermissionClient) Interceptors() []Interceptor {
+	inters := c.inters.Permission
+	return append(inters[:len(inters):len(inters)], permission.Interceptors[:]...)
+}
+
+func (c *PermissionClient) mutate(ctx context.Context, m *PermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Permission mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryAdditionalFees queries the additional_fees edge of a Merchant.
-func (c *MerchantClient) QueryAdditionalFees(m *Merchant) *AdditionalFeeQuery {
-	query := (&AdditionalFeeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
-			sqlgraph.To(additionalfee.Table, additionalfee.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.AdditionalFeesTable, merchant.AdditionalFeesColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
+// PickupCallClient is a client for the PickupCall schema.
+type PickupCallClient struct {
+	config
+}
+
+// NewPickupCallClient returns a client for the PickupCall from the given config.
+func NewPickupCallClient(c config) *PickupCallClient {
+	return &PickupCallClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pickupcall.Hooks(f(g(h())))`.
+func (c *PickupCallClient) Use(hooks ...Hook) {
+	c.hooks.PickupCall = append(c.hooks.PickupCall, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pickupcall.Intercept(f(g(h())))`.
+func (c *PickupCallClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PickupCall = append(c.inters.PickupCall, interceptors...)
+}
+
+// Create returns a builder for creating a PickupCall entity.
+func (c *PickupCallClient) Create() *PickupCallCreate {
+	mutation := newPickupCallMutation(c.config, OpCreate)
+	return &PickupCallCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PickupCall entities.
+func (c *PickupCallClient) CreateBulk(builders ...*PickupCallCreate) *PickupCallCreateBulk {
+	return &PickupCallCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PickupCallClient) MapCreateBulk(slice any, setFunc func(*PickupCallCreate, int)) *PickupCallCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PickupCallCreateBulk{err: fmt.Errorf("calling to PickupCallClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*PickupCallCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PickupCallCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryTaxFees queries the tax_fees edge of a Merchant.
-func (c *MerchantClient) QueryTaxFees(m *Merchant) *TaxFeeQuery {
-	query := (&TaxFeeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
-			sqlgraph.To(taxfee.Table, taxfee.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.TaxFeesTable, merchant.TaxFeesColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
+// Update returns an update builder for PickupCall.
+func (c *PickupCallClient) Update() *PickupCallUpdate {
+	mutation := newPickupCallMutation(c.config, OpUpdate)
+	return &PickupCallUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PickupCallClient) UpdateOne(pc *PickupCall) *PickupCallUpdateOne {
+	mutation := newPickupCallMutation(c.config, OpUpdateOne, withPickupCall(pc))
+	return &PickupCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PickupCallClient) UpdateOneID(id uuid.UUID) *PickupCallUpdateOne {
+	mutation := newPickupCallMutation(c.config, OpUpdateOne, withPickupCallID(id))
+	return &PickupCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PickupCall.
+func (c *PickupCallClient) Delete() *PickupCallDelete {
+	mutation := newPickupCallMutation(c.config, OpDelete)
+	return &PickupCallDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PickupCallClient) DeleteOne(pc *PickupCall) *PickupCallDeleteOne {
+	return c.DeleteOneID(pc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PickupCallClient) DeleteOneID(id uuid.UUID) *PickupCallDeleteOne {
+	builder := c.Delete().Where(pickupcall.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PickupCallDeleteOne{builder}
+}
+
+// Query returns a query builder for PickupCall.
+func (c *PickupCallClient) Query() *PickupCallQuery {
+	return &PickupCallQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePickupCall},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryDevices queries the devices edge of a Merchant.
-func (c *MerchantClient) QueryDevices(m *Merchant) *DeviceQuery {
-	query := (&DeviceClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
-			sqlgraph.To(device.Table, device.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.DevicesTable, merchant.DevicesColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a PickupCall entity by its id.
+func (c *PickupCallClient) Get(ctx context.Context, id uuid.UUID) (*PickupCall, error) {
+	return c.Query().Where(pickupcall.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PickupCallClient) GetX(ctx context.Context, id uuid.UUID) *PickupCall {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryDepartments queries the departments edge of a Merchant.
-func (c *MerchantClient) QueryDepartments(m *Merchant) *DepartmentQuery {
-	query := (&DepartmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
-			sqlgraph.To(department.Table, department.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.DepartmentsTable, merchant.DepartmentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *PickupCallClient) Hooks() []Hook {
+	return c.hooks.PickupCall
+}
+
+// Interceptors returns the client interceptors.
+func (c *PickupCallClient) Interceptors() []Interceptor {
+	return c.inters.PickupCall
+}
+
+func (c *PickupCallClient) mutate(ctx context.Context, m *PickupCallMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PickupCallCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PickupCallUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PickupCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PickupCallDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PickupCall mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryRoles queries the roles edge of a Merchant.
-func (c *MerchantClient) QueryRoles(m *Merchant) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.RolesTable, merchant.RolesColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
+// PickupRuleClient is a client for the PickupRule schema.
+type PickupRuleClient struct {
+	config
+}
+
+// NewPickupRuleClient returns a client for the PickupRule from the given config.
+func NewPickupRuleClient(c config) *PickupRuleClient {
+	return &PickupRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pickuprule.Hooks(f(g(h())))`.
+func (c *PickupRuleClient) Use(hooks ...Hook) {
+	c.hooks.PickupRule = append(c.hooks.PickupRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pickuprule.Intercept(f(g(h())))`.
+func (c *PickupRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PickupRule = append(c.inters.PickupRule, interceptors...)
+}
+
+// Create returns a builder for creating a PickupRule entity.
+func (c *PickupRuleClient) Create() *PickupRuleCreate {
+	mutation := newPickupRuleMutation(c.config, OpCreate)
+	return &PickupRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PickupRule entities.
+func (c *PickupRuleClient) CreateBulk(builders ...*PickupRuleCreate) *PickupRuleCreateBulk {
+	return &PickupRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PickupRuleClient) MapCreateBulk(slice any, setFunc func(*PickupRuleCreate, int)) *PickupRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PickupRuleCreateBulk{err: fmt.Errorf("calling to PickupRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*PickupRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PickupRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryStoreUsers queries the store_users edge of a Merchant.
-func (c *MerchantClient) QueryStoreUsers(m *Merchant) *StoreUserQuery {
-	query := (&StoreUserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
-			sqlgraph.To(storeuser.Table, storeuser.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.StoreUsersTable, merchant.StoreUsersColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
+// Update returns an update builder for PickupRule.
+func (c *PickupRuleClient) Update() *PickupRuleUpdate {
+	mutation := newPickupRuleMutation(c.config, OpUpdate)
+	return &PickupRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PickupRuleClient) UpdateOne(pr *PickupRule) *PickupRuleUpdateOne {
+	mutation := newPickupRuleMutation(c.config, OpUpdateOne, withPickupRule(pr))
+	return &PickupRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PickupRuleClient) UpdateOneID(id uuid.UUID) *PickupRuleUpdateOne {
+	mutation := newPickupRuleMutation(c.config, OpUpdateOne, withPickupRuleID(id))
+	return &PickupRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PickupRule.
+func (c *PickupRuleClient) Delete() *PickupRuleDelete {
+	mutation := newPickupRuleMutation(c.config, OpDelete)
+	return &PickupRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PickupRuleClient) DeleteOne(pr *PickupRule) *PickupRuleDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PickupRuleClient) DeleteOneID(id uuid.UUID) *PickupRuleDeleteOne {
+	builder := c.Delete().Where(pickuprule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PickupRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for PickupRule.
+func (c *PickupRuleClient) Query() *PickupRuleQuery {
+	return &PickupRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePickupRule},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryProfitDistributionBills queries the profit_distribution_bills edge of a Merchant.
-func (c *MerchantClient) QueryProfitDistributionBills(m *Merchant) *ProfitDistributionBillQuery {
-	query := (&ProfitDistributionBillClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(merchant.Table, merchant.FieldID, id),
-			sqlgraph.To(profitdistributionbill.Table, profitdistributionbill.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, merchant.ProfitDistributionBillsTable, merchant.ProfitDistributionBillsColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a PickupRule entity by its id.
+func (c *PickupRuleClient) Get(ctx context.Context, id uuid.UUID) (*PickupRule, error) {
+	return c.Query().Where(pickuprule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PickupRuleClient) GetX(ctx context.Context, id uuid.UUID) *PickupRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *MerchantClient) Hooks() []Hook {
-	hooks := c.hooks.Merchant
-	return append(hooks[:len(hooks):len(hooks)], merchant.Hooks[:]...)
+func (c *PickupRuleClient) Hooks() []Hook {
+	return c.hooks.PickupRule
 }
 
 // Interceptors returns the client interceptors.
-func (c *MerchantClient) Interceptors() []Interceptor {
-	inters := c.inters.Merchant
-	return append(inters[:len(inters):len(inters)], merchant.Interceptors[:]...)
+func (c *PickupRuleClient) Interceptors() []Interceptor {
+	return c.inters.PickupRule
 }
 
-func (c *MerchantClient) mutate(ctx context.Context, m *MerchantMutation) (Value, error) {
+func (c *PickupRuleClient) mutate(ctx context.Context, m *PickupRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MerchantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PickupRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MerchantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PickupRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PickupRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MerchantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PickupRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Merchant mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PickupRule mutation op: %q", m.Op())
 	}
 }
 
-// MerchantBusinessTypeClient is a client for the MerchantBusinessType schema.
-type MerchantBusinessTypeClient struct {
+// PreorderClient is a client for the Preorder schema.
+type PreorderClient struct {
 	config
 }
 
-// NewMerchantBusinessTypeClient returns a client for the MerchantBusinessType from the given config.
-func NewMerchantBusinessTypeClient(c config) *MerchantBusinessTypeClient {
-	return &MerchantBusinessTypeClient{config: c}
+// NewPreorderClient returns a client for the Preorder from the given config.
+func NewPreorderClient(c config) *PreorderClient {
+	return &PreorderClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `merchantbusinesstype.Hooks(f(g(h())))`.
-func (c *MerchantBusinessTypeClient) Use(hooks ...Hook) {
-	c.hooks.MerchantBusinessType = append(c.hooks.MerchantBusinessType, hooks...)
+// A call to `Use(f, g, h)` equals to `preorder.Hooks(f(g(h())))`.
+func (c *PreorderClient) Use(hooks ...Hook) {
+	c.hooks.Preorder = append(c.hooks.Preorder, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `merchantbusinesstype.Intercept(f(g(h())))`.
-func (c *MerchantBusinessTypeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MerchantBusinessType = append(c.inters.MerchantBusinessType, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `preorder.Intercept(f(g(h())))`.
+func (c *PreorderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Preorder = append(c.inters.Preorder, interceptors...)
 }
 
-// Create returns a builder for creating a MerchantBusinessType entity.
-func (c *MerchantBusinessTypeClient) Create() *MerchantBusinessTypeCreate {
-	mutation := newMerchantBusinessTypeMutation(c.config, OpCreate)
-	return &MerchantBusinessTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Preorder entity.
+func (c *PreorderClient) Create() *PreorderCreate {
+	mutation := newPreorderMutation(c.config, OpCreate)
+	return &PreorderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MerchantBusinessType entities.
-func (c *MerchantBusinessTypeClient) CreateBulk(builders ...*MerchantBusinessTypeCreate) *MerchantBusinessTypeCreateBulk {
-	return &MerchantBusinessTypeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Preorder entities.
+func (c *PreorderClient) CreateBulk(builders ...*PreorderCreate) *PreorderCreateBulk {
+	return &PreorderCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MerchantBusinessTypeClient) MapCreateBulk(slice any, setFunc func(*MerchantBusinessTypeCreate, int)) *MerchantBusinessTypeCreateBulk {
+func (c *PreorderClient) MapCreateBulk(slice any, setFunc func(*PreorderCreate, int)) *PreorderCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MerchantBusinessTypeCreateBulk{err: fmt.Errorf("calling to MerchantBusinessTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PreorderCreateBulk{err: fmt.Errorf("calling to PreorderClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MerchantBusinessTypeCreate, rv.Len())
+	builders := make([]*PreorderCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MerchantBusinessTypeCreateBulk{config: c.config, builders: builders}
+	return &PreorderCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MerchantBusinessType.
-func (c *MerchantBusinessTypeClient) Update() *MerchantBusinessTypeUpdate {
-	mutation := newMerchantBusinessTypeMutation(c.config, OpUpdate)
-	return &MerchantBusinessTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Preorder.
+func (c *PreorderClient) Update() *PreorderUpdate {
+	mutation := newPreorderMutation(c.config, OpUpdate)
+	return &PreorderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MerchantBusinessTypeClient) UpdateOne(mbt *MerchantBusinessType) *MerchantBusinessTypeUpdateOne {
-	mutation := newMerchantBusinessTypeMutation(c.config, OpUpdateOne, withMerchantBusinessType(mbt))
-	return &MerchantBusinessTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PreorderClient) UpdateOne(pr *Preorder) *PreorderUpdateOne {
+	mutation := newPreorderMutation(c.config, OpUpdateOne, withPreorder(pr))
+	return &PreorderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MerchantBusinessTypeClient) UpdateOneID(id uuid.UUID) *MerchantBusinessTypeUpdateOne {
-	mutation := newMerchantBusinessTypeMutation(c.config, OpUpdateOne, withMerchantBusinessTypeID(id))
-	return &MerchantBusinessTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PreorderClient) UpdateOneID(id uuid.UUID) *PreorderUpdateOne {
+	mutation := newPreorderMutation(c.config, OpUpdateOne, withPreorderID(id))
+	return &PreorderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MerchantBusinessType.
-func (c *MerchantBusinessTypeClient) Delete() *MerchantBusinessTypeDelete {
-	mutation := newMerchantBusinessTypeMutation(c.config, OpDelete)
-	return &MerchantBusinessTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Preorder.
+func (c *PreorderClient) Delete() *PreorderDelete {
+	mutation := newPreorderMutation(c.config, OpDelete)
+	return &PreorderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MerchantBusinessTypeClient) DeleteOne(mbt *MerchantBusinessType) *MerchantBusinessTypeDeleteOne {
-	return c.DeleteOneID(mbt.ID)
+func (c *PreorderClient) DeleteOne(pr *Preorder) *PreorderDeleteOne {
+	return c.DeleteOneID(pr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MerchantBusinessTypeClient) DeleteOneID(id uuid.UUID) *MerchantBusinessTypeDeleteOne {
-	builder := c.Delete().Where(merchantbusinesstype.ID(id))
+func (c *PreorderClient) DeleteOneID(id uuid.UUID) *PreorderDeleteOne {
+	builder := c.Delete().Where(preorder.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MerchantBusinessTypeDeleteOne{builder}
+	return &PreorderDeleteOne{builder}
 }
 
-// Query returns a query builder for MerchantBusinessType.
-func (c *MerchantBusinessTypeClient) Query() *MerchantBusinessTypeQuery {
-	return &MerchantBusinessTypeQuery{
+// Query returns a query builder for Preorder.
+func (c *PreorderClient) Query() *PreorderQuery {
+	return &PreorderQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMerchantBusinessType},
+		ctx:    &QueryContext{Type: TypePreorder},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MerchantBusinessType entity by its id.
-func (c *MerchantBusinessTypeClient) Get(ctx context.Context, id uuid.UUID) (*MerchantBusinessType, error) {
-	return c.Query().Where(merchantbusinesstype.ID(id)).Only(ctx)
+// Get returns a Preorder entity by its id.
+func (c *PreorderClient) Get(ctx context.Context, id uuid.UUID) (*Preorder, error) {
+	return c.Query().Where(preorder.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MerchantBusinessTypeClient) GetX(ctx context.Context, id uuid.UUID) *MerchantBusinessType {
+func (c *PreorderClient) GetX(ctx context.Context, id uuid.UUID) *Preorder {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2571,133 +6254,131 @@ func (c *MerchantBusinessTypeClient) GetX(ctx context.Context, id uuid.UUID) *Me
 }
 
 // Hooks returns the client hooks.
-func (c *MerchantBusinessTypeClient) Hooks() []Hook {
-	hooks := c.hooks.MerchantBusinessType
-	return append(hooks[:len(hooks):len(hooks)], merchantbusinesstype.Hooks[:]...)
+func (c *PreorderClient) Hooks() []Hook {
+	return c.hooks.Preorder
 }
 
 // Interceptors returns the client interceptors.
-func (c *MerchantBusinessTypeClient) Interceptors() []Interceptor {
-	inters := c.inters.MerchantBusinessType
-	return append(inters[:len(inters):len(inters)], merchantbusinesstype.Interceptors[:]...)
+func (c *PreorderClient) Interceptors() []Interceptor {
+	return c.inters.Preorder
 }
 
-func (c *MerchantBusinessTypeClient) mutate(ctx context.Context, m *MerchantBusinessTypeMutation) (Value, error) {
+func (c *PreorderClient) mutate(ctx context.Context, m *PreorderMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MerchantBusinessTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PreorderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MerchantBusinessTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PreorderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MerchantBusinessTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PreorderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MerchantBusinessTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PreorderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MerchantBusinessType mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Preorder mutation op: %q", m.Op())
 	}
 }
 
-// MerchantRenewalClient is a client for the MerchantRenewal schema.
-type MerchantRenewalClient struct {
+// PreorderRuleClient is a client for the PreorderRule schema.
+type PreorderRuleClient struct {
 	config
 }
 
-// NewMerchantRenewalClient returns a client for the MerchantRenewal from the given config.
-func NewMerchantRenewalClient(c config) *MerchantRenewalClient {
-	return &MerchantRenewalClient{config: c}
+// NewPreorderRuleClient returns a client for the PreorderRule from the given config.
+func NewPreorderRuleClient(c config) *PreorderRuleClient {
+	return &PreorderRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `merchantrenewal.Hooks(f(g(h())))`.
-func (c *MerchantRenewalClient) Use(hooks ...Hook) {
-	c.hooks.MerchantRenewal = append(c.hooks.MerchantRenewal, hooks...)
+// A call to `Use(f, g, h)` equals to `preorderrule.Hooks(f(g(h())))`.
+func (c *PreorderRuleClient) Use(hooks ...Hook) {
+	c.hooks.PreorderRule = append(c.hooks.PreorderRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `merchantrenewal.Intercept(f(g(h())))`.
-func (c *MerchantRenewalClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MerchantRenewal = append(c.inters.MerchantRenewal, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `preorderrule.Intercept(f(g(h())))`.
+func (c *PreorderRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PreorderRule = append(c.inters.PreorderRule, interceptors...)
 }
 
-// Create returns a builder for creating a MerchantRenewal entity.
-func (c *MerchantRenewalClient) Create() *MerchantRenewalCreate {
-	mutation := newMerchantRenewalMutation(c.config, OpCreate)
-	return &MerchantRenewalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PreorderRule entity.
+func (c *PreorderRuleClient) Create() *PreorderRuleCreate {
+	mutation := newPreorderRuleMutation(c.config, OpCreate)
+	return &PreorderRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MerchantRenewal entities.
-func (c *MerchantRenewalClient) CreateBulk(builders ...*MerchantRenewalCreate) *MerchantRenewalCreateBulk {
-	return &MerchantRenewalCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PreorderRule entities.
+func (c *PreorderRuleClient) CreateBulk(builders ...*PreorderRuleCreate) *PreorderRuleCreateBulk {
+	return &PreorderRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MerchantRenewalClient) MapCreateBulk(slice any, setFunc func(*MerchantRenewalCreate, int)) *MerchantRenewalCreateBulk {
+func (c *PreorderRuleClient) MapCreateBulk(slice any, setFunc func(*PreorderRuleCreate, int)) *PreorderRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MerchantRenewalCreateBulk{err: fmt.Errorf("calling to MerchantRenewalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PreorderRuleCreateBulk{err: fmt.Errorf("calling to PreorderRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MerchantRenewalCreate, rv.Len())
+	builders := make([]*PreorderRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MerchantRenewalCreateBulk{config: c.config, builders: builders}
+	return &PreorderRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MerchantRenewal.
-func (c *MerchantRenewalClient) Update() *MerchantRenewalUpdate {
-	mutation := newMerchantRenewalMutation(c.config, OpUpdate)
-	return &MerchantRenewalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PreorderRule.
+func (c *PreorderRuleClient) Update() *PreorderRuleUpdate {
+	mutation := newPreorderRuleMutation(c.config, OpUpdate)
+	return &PreorderRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MerchantRenewalClient) UpdateOne(mr *MerchantRenewal) *MerchantRenewalUpdateOne {
-	mutation := newMerchantRenewalMutation(c.config, OpUpdateOne, withMerchantRenewal(mr))
-	return &MerchantRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PreorderRuleClient) UpdateOne(pr *PreorderRule) *PreorderRuleUpdateOne {
+	mutation := newPreorderRuleMutation(c.config, OpUpdateOne, withPreorderRule(pr))
+	return &PreorderRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MerchantRenewalClient) UpdateOneID(id uuid.UUID) *MerchantRenewalUpdateOne {
-	mutation := newMerchantRenewalMutation(c.config, OpUpdateOne, withMerchantRenewalID(id))
-	return &MerchantRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PreorderRuleClient) UpdateOneID(id uuid.UUID) *PreorderRuleUpdateOne {
+	mutation := newPreorderRuleMutation(c.config, OpUpdateOne, withPreorderRuleID(id))
+	return &PreorderRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MerchantRenewal.
-func (c *MerchantRenewalClient) Delete() *MerchantRenewalDelete {
-	mutation := newMerchantRenewalMutation(c.config, OpDelete)
-	return &MerchantRenewalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PreorderRule.
+func (c *PreorderRuleClient) Delete() *PreorderRuleDelete {
+	mutation := newPreorderRuleMutation(c.config, OpDelete)
+	return &PreorderRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MerchantRenewalClient) DeleteOne(mr *MerchantRenewal) *MerchantRenewalDeleteOne {
-	return c.DeleteOneID(mr.ID)
+func (c *PreorderRuleClient) DeleteOne(pr *PreorderRule) *PreorderRuleDeleteOne {
+	return c.DeleteOneID(pr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MerchantRenewalClient) DeleteOneID(id uuid.UUID) *MerchantRenewalDeleteOne {
-	builder := c.Delete().Where(merchantrenewal.ID(id))
+func (c *PreorderRuleClient) DeleteOneID(id uuid.UUID) *PreorderRuleDeleteOne {
+	builder := c.Delete().Where(preorderrule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MerchantRenewalDeleteOne{builder}
+	return &PreorderRuleDeleteOne{builder}
 }
 
-// Query returns a query builder for MerchantRenewal.
-func (c *MerchantRenewalClient) Query() *MerchantRenewalQuery {
-	return &MerchantRenewalQuery{
+// Query returns a query builder for PreorderRule.
+func (c *PreorderRuleClient) Query() *PreorderRuleQuery {
+	return &PreorderRuleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMerchantRenewal},
+		ctx:    &QueryContext{Type: TypePreorderRule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MerchantRenewal entity by its id.
-func (c *MerchantRenewalClient) Get(ctx context.Context, id uuid.UUID) (*MerchantRenewal, error) {
-	return c.Query().Where(merchantrenewal.ID(id)).Only(ctx)
+// Get returns a PreorderRule entity by its id.
+func (c *PreorderRuleClient) Get(ctx context.Context, id uuid.UUID) (*PreorderRule, error) {
+	return c.Query().Where(preorderrule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MerchantRenewalClient) GetX(ctx context.Context, id uuid.UUID) *MerchantRenewal {
+func (c *PreorderRuleClient) GetX(ctx context.Context, id uuid.UUID) *PreorderRule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2705,150 +6386,132 @@ func (c *MerchantRenewalClient) GetX(ctx context.Context, id uuid.UUID) *Merchan
 	return obj
 }
 
-// QueryMerchant queries the merchant edge of a MerchantRenewal.
-func (c *MerchantRenewalClient) QueryMerchant(mr *MerchantRenewal) *MerchantQuery {
-	query := (&MerchantClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := mr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(merchantrenewal.Table, merchantrenewal.FieldID, id),
-			sqlgraph.To(merchant.Table, merchant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, merchantrenewal.MerchantTable, merchantrenewal.MerchantColumn),
-		)
-		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *MerchantRenewalClient) Hooks() []Hook {
-	hooks := c.hooks.MerchantRenewal
-	return append(hooks[:len(hooks):len(hooks)], merchantrenewal.Hooks[:]...)
+func (c *PreorderRuleClient) Hooks() []Hook {
+	return c.hooks.PreorderRule
 }
 
 // Interceptors returns the client interceptors.
-func (c *MerchantRenewalClient) Interceptors() []Interceptor {
-	inters := c.inters.MerchantRenewal
-	return append(inters[:len(inters):len(inters)], merchantrenewal.Interceptors[:]...)
+func (c *PreorderRuleClient) Interceptors() []Interceptor {
+	return c.inters.PreorderRule
 }
 
-func (c *MerchantRenewalClient) mutate(ctx context.Context, m *MerchantRenewalMutation) (Value, error) {
+func (c *PreorderRuleClient) mutate(ctx context.Context, m *PreorderRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MerchantRenewalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PreorderRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MerchantRenewalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PreorderRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MerchantRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PreorderRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MerchantRenewalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PreorderRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MerchantRenewal mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PreorderRule mutation op: %q", m.Op())
 	}
 }
 
-// OrderClient is a client for the Order schema.
-type OrderClient struct {
+// PriceChangeBatchClient is a client for the PriceChangeBatch schema.
+type PriceChangeBatchClient struct {
 	config
 }
 
-// NewOrderClient returns a client for the Order from the given config.
-func NewOrderClient(c config) *OrderClient {
-	return &OrderClient{config: c}
+// NewPriceChangeBatchClient returns a client for the PriceChangeBatch from the given config.
+func NewPriceChangeBatchClient(c config) *PriceChangeBatchClient {
+	return &PriceChangeBatchClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `order.Hooks(f(g(h())))`.
-func (c *OrderClient) Use(hooks ...Hook) {
-	c.hooks.Order = append(c.hooks.Order, hooks...)
+// A call to `Use(f, g, h)` equals to `pricechangebatch.Hooks(f(g(h())))`.
+func (c *PriceChangeBatchClient) Use(hooks ...Hook) {
+	c.hooks.PriceChangeBatch = append(c.hooks.PriceChangeBatch, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `order.Intercept(f(g(h())))`.
-func (c *OrderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Order = append(c.inters.Order, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `pricechangebatch.Intercept(f(g(h())))`.
+func (c *PriceChangeBatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PriceChangeBatch = append(c.inters.PriceChangeBatch, interceptors...)
 }
 
-// Create returns a builder for creating a Order entity.
-func (c *OrderClient) Create() *OrderCreate {
-	mutation := newOrderMutation(c.config, OpCreate)
-	return &OrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PriceChangeBatch entity.
+func (c *PriceChangeBatchClient) Create() *PriceChangeBatchCreate {
+	mutation := newPriceChangeBatchMutation(c.config, OpCreate)
+	return &PriceChangeBatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Order entities.
-func (c *OrderClient) CreateBulk(builders ...*OrderCreate) *OrderCreateBulk {
-	return &OrderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PriceChangeBatch entities.
+func (c *PriceChangeBatchClient) CreateBulk(builders ...*PriceChangeBatchCreate) *PriceChangeBatchCreateBulk {
+	return &PriceChangeBatchCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrderClient) MapCreateBulk(slice any, setFunc func(*OrderCreate, int)) *OrderCreateBulk {
+func (c *PriceChangeBatchClient) MapCreateBulk(slice any, setFunc func(*PriceChangeBatchCreate, int)) *PriceChangeBatchCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrderCreateBulk{err: fmt.Errorf("calling to OrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PriceChangeBatchCreateBulk{err: fmt.Errorf("calling to PriceChangeBatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrderCreate, rv.Len())
+	builders := make([]*PriceChangeBatchCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrderCreateBulk{config: c.config, builders: builders}
+	return &PriceChangeBatchCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Order.
-func (c *OrderClient) Update() *OrderUpdate {
-	mutation := newOrderMutation(c.config, OpUpdate)
-	return &OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PriceChangeBatch.
+func (c *PriceChangeBatchClient) Update() *PriceChangeBatchUpdate {
+	mutation := newPriceChangeBatchMutation(c.config, OpUpdate)
+	return &PriceChangeBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrderClient) UpdateOne(o *Order) *OrderUpdateOne {
-	mutation := newOrderMutation(c.config, OpUpdateOne, withOrder(o))
-	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PriceChangeBatchClient) UpdateOne(pcb *PriceChangeBatch) *PriceChangeBatchUpdateOne {
+	mutation := newPriceChangeBatchMutation(c.config, OpUpdateOne, withPriceChangeBatch(pcb))
+	return &PriceChangeBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrderClient) UpdateOneID(id uuid.UUID) *OrderUpdateOne {
-	mutation := newOrderMutation(c.config, OpUpdateOne, withOrderID(id))
-	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PriceChangeBatchClient) UpdateOneID(id uuid.UUID) *PriceChangeBatchUpdateOne {
+	mutation := newPriceChangeBatchMutation(c.config, OpUpdateOne, withPriceChangeBatchID(id))
+	return &PriceChangeBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Order.
-func (c *OrderClient) Delete() *OrderDelete {
-	mutation := newOrderMutation(c.config, OpDelete)
-	return &OrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PriceChangeBatch.
+func (c *PriceChangeBatchClient) Delete() *PriceChangeBatchDelete {
+	mutation := newPriceChangeBatchMutation(c.config, OpDelete)
+	return &PriceChangeBatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrderClient) DeleteOne(o *Order) *OrderDeleteOne {
-	return c.DeleteOneID(o.ID)
+func (c *PriceChangeBatchClient) DeleteOne(pcb *PriceChangeBatch) *PriceChangeBatchDeleteOne {
+	return c.DeleteOneID(pcb.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrderClient) DeleteOneID(id uuid.UUID) *OrderDeleteOne {
-	builder := c.Delete().Where(order.ID(id))
+func (c *PriceChangeBatchClient) DeleteOneID(id uuid.UUID) *PriceChangeBatchDeleteOne {
+	builder := c.Delete().Where(pricechangebatch.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrderDeleteOne{builder}
+	return &PriceChangeBatchDeleteOne{builder}
 }
 
-// Query returns a query builder for Order.
-func (c *OrderClient) Query() *OrderQuery {
-	return &OrderQuery{
+// Query returns a query builder for PriceChangeBatch.
+func (c *PriceChangeBatchClient) Query() *PriceChangeBatchQuery {
+	return &PriceChangeBatchQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrder},
+		ctx:    &QueryContext{Type: TypePriceChangeBatch},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Order entity by its id.
-func (c *OrderClient) Get(ctx context.Context, id uuid.UUID) (*Order, error) {
-	return c.Query().Where(order.ID(id)).Only(ctx)
+// Get returns a PriceChangeBatch entity by its id.
+func (c *PriceChangeBatchClient) Get(ctx context.Context, id uuid.UUID) (*PriceChangeBatch, error) {
+	return c.Query().Where(pricechangebatch.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrderClient) GetX(ctx context.Context, id uuid.UUID) *Order {
+func (c *PriceChangeBatchClient) GetX(ctx context.Context, id uuid.UUID) *PriceChangeBatch {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2856,150 +6519,150 @@ func (c *OrderClient) GetX(ctx context.Context, id uuid.UUID) *Order {
 	return obj
 }
 
-// QueryOrderProducts queries the order_products edge of a Order.
-func (c *OrderClient) QueryOrderProducts(o *Order) *OrderProductQuery {
-	query := (&OrderProductClient{config: c.config}).Query()
+// QueryItems queries the items edge of a PriceChangeBatch.
+func (c *PriceChangeBatchClient) QueryItems(pcb *PriceChangeBatch) *PriceChangeItemQuery {
+	query := (&PriceChangeItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := pcb.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(order.Table, order.FieldID, id),
-			sqlgraph.To(orderproduct.Table, orderproduct.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, order.OrderProductsTable, order.OrderProductsColumn),
+			sqlgraph.From(pricechangebatch.Table, pricechangebatch.FieldID, id),
+			sqlgraph.To(pricechangeitem.Table, pricechangeitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, pricechangebatch.ItemsTable, pricechangebatch.ItemsColumn),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pcb.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *OrderClient) Hooks() []Hook {
-	hooks := c.hooks.Order
-	return append(hooks[:len(hooks):len(hooks)], order.Hooks[:]...)
+func (c *PriceChangeBatchClient) Hooks() []Hook {
+	hooks := c.hooks.PriceChangeBatch
+	return append(hooks[:len(hooks):len(hooks)], pricechangebatch.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrderClient) Interceptors() []Interceptor {
-	inters := c.inters.Order
-	return append(inters[:len(inters):len(inters)], order.Interceptors[:]...)
+func (c *PriceChangeBatchClient) Interceptors() []Interceptor {
+	inters := c.inters.PriceChangeBatch
+	return append(inters[:len(inters):len(inters)], pricechangebatch.Interceptors[:]...)
 }
 
-func (c *OrderClient) mutate(ctx context.Context, m *OrderMutation) (Value, error) {
+func (c *PriceChangeBatchClient) mutate(ctx context.Context, m *PriceChangeBatchMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PriceChangeBatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PriceChangeBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PriceChangeBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PriceChangeBatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Order mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PriceChangeBatch mutation op: %q", m.Op())
 	}
 }
 
-// OrderProductClient is a client for the OrderProduct schema.
-type OrderProductClient struct {
+// PriceChangeItemClient is a client for the PriceChangeItem schema.
+type PriceChangeItemClient struct {
 	config
 }
 
-// NewOrderProductClient returns a client for the OrderProduct from the given config.
-func NewOrderProductClient(c config) *OrderProductClient {
-	return &OrderProductClient{config: c}
+// NewPriceChangeItemClient returns a client for the PriceChangeItem from the given config.
+func NewPriceChangeItemClient(c config) *PriceChangeItemClient {
+	return &PriceChangeItemClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `orderproduct.Hooks(f(g(h())))`.
-func (c *OrderProductClient) Use(hooks ...Hook) {
-	c.hooks.OrderProduct = append(c.hooks.OrderProduct, hooks...)
+// A call to `Use(f, g, h)` equals to `pricechangeitem.Hooks(f(g(h())))`.
+func (c *PriceChangeItemClient) Use(hooks ...Hook) {
+	c.hooks.PriceChangeItem = append(c.hooks.PriceChangeItem, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `orderproduct.Intercept(f(g(h())))`.
-func (c *OrderProductClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OrderProduct = append(c.inters.OrderProduct, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `pricechangeitem.Intercept(f(g(h())))`.
+func (c *PriceChangeItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PriceChangeItem = append(c.inters.PriceChangeItem, interceptors...)
 }
 
-// Create returns a builder for creating a OrderProduct entity.
-func (c *OrderProductClient) Create() *OrderProductCreate {
-	mutation := newOrderProductMutation(c.config, OpCreate)
-	return &OrderProductCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PriceChangeItem entity.
+func (c *PriceChangeItemClient) Create() *PriceChangeItemCreate {
+	mutation := newPriceChangeItemMutation(c.config, OpCreate)
+	return &PriceChangeItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OrderProduct entities.
-func (c *OrderProductClient) CreateBulk(builders ...*OrderProductCreate) *OrderProductCreateBulk {
-	return &OrderProductCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PriceChangeItem entities.
+func (c *PriceChangeItemClient) CreateBulk(builders ...*PriceChangeItemCreate) *PriceChangeItemCreateBulk {
+	return &PriceChangeItemCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrderProductClient) MapCreateBulk(slice any, setFunc func(*OrderProductCreate, int)) *OrderProductCreateBulk {
+func (c *PriceChangeItemClient) MapCreateBulk(slice any, setFunc func(*PriceChangeItemCreate, int)) *PriceChangeItemCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrderProductCreateBulk{err: fmt.Errorf("calling to OrderProductClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PriceChangeItemCreateBulk{err: fmt.Errorf("calling to PriceChangeItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrderProductCreate, rv.Len())
+	builders := make([]*PriceChangeItemCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrderProductCreateBulk{config: c.config, builders: builders}
+	return &PriceChangeItemCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OrderProduct.
-func (c *OrderProductClient) Update() *OrderProductUpdate {
-	mutation := newOrderProductMutation(c.config, OpUpdate)
-	return &OrderProductUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PriceChangeItem.
+func (c *PriceChangeItemClient) Update() *PriceChangeItemUpdate {
+	mutation := newPriceChangeItemMutation(c.config, OpUpdate)
+	return &PriceChangeItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrderProductClient) UpdateOne(op *OrderProduct) *OrderProductUpdateOne {
-	mutation := newOrderProductMutation(c.config, OpUpdateOne, withOrderProduct(op))
-	return &OrderProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PriceChangeItemClient) UpdateOne(pci *PriceChangeItem) *PriceChangeItemUpdateOne {
+	mutation := newPriceChangeItemMutation(c.config, OpUpdateOne, withPriceChangeItem(pci))
+	return &PriceChangeItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrderProductClient) UpdateOneID(id uuid.UUID) *OrderProductUpdateOne {
-	mutation := newOrderProductMutation(c.config, OpUpdateOne, withOrderProductID(id))
-	return &OrderProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PriceChangeItemClient) UpdateOneID(id uuid.UUID) *PriceChangeItemUpdateOne {
+	mutation := newPriceChangeItemMutation(c.config, OpUpdateOne, withPriceChangeItemID(id))
+	return &PriceChangeItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OrderProduct.
-func (c *OrderProductClient) Delete() *OrderProductDelete {
-	mutation := newOrderProductMutation(c.config, OpDelete)
-	return &OrderProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PriceChangeItem.
+func (c *PriceChangeItemClient) Delete() *PriceChangeItemDelete {
+	mutation := newPriceChangeItemMutation(c.config, OpDelete)
+	return &PriceChangeItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrderProductClient) DeleteOne(op *OrderProduct) *OrderProductDeleteOne {
-	return c.DeleteOneID(op.ID)
+func (c *PriceChangeItemClient) DeleteOne(pci *PriceChangeItem) *PriceChangeItemDeleteOne {
+	return c.DeleteOneID(pci.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrderProductClient) DeleteOneID(id uuid.UUID) *OrderProductDeleteOne {
-	builder := c.Delete().Where(orderproduct.ID(id))
+func (c *PriceChangeItemClient) DeleteOneID(id uuid.UUID) *PriceChangeItemDeleteOne {
+	builder := c.Delete().Where(pricechangeitem.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrderProductDeleteOne{builder}
-}
-
-// Query returns a query builder for OrderProduct.
-func (c *OrderProductClient) Query() *OrderProductQuery {
-	return &OrderProductQuery{
+	return &PriceChangeItemDeleteOne{builder}
+}
+
+// Query returns a query builder for PriceChangeItem.
+func (c *PriceChangeItemClient) Query() *PriceChangeItemQuery {
+	return &PriceChangeItemQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrderProduct},
+		ctx:    &QueryContext{Type: TypePriceChangeItem},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OrderProduct entity by its id.
-func (c *OrderProductClient) Get(ctx context.Context, id uuid.UUID) (*OrderProduct, error) {
-	return c.Query().Where(orderproduct.ID(id)).Only(ctx)
+// Get returns a PriceChangeItem entity by its id.
+func (c *PriceChangeItemClient) Get(ctx context.Context, id uuid.UUID) (*PriceChangeItem, error) {
+	return c.Query().Where(pricechangeitem.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrderProductClient) GetX(ctx context.Context, id uuid.UUID) *OrderProduct {
+func (c *PriceChangeItemClient) GetX(ctx context.Context, id uuid.UUID) *PriceChangeItem {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3007,301 +6670,445 @@ func (c *OrderProductClient) GetX(ctx context.Context, id uuid.UUID) *OrderProdu
 	return obj
 }
 
-// QueryOrder queries the order edge of a OrderProduct.
-func (c *OrderProductClient) QueryOrder(op *OrderProduct) *OrderQuery {
-	query := (&OrderClient{config: c.config}).Query()
+// QueryBatch queries the batch edge of a PriceChangeItem.
+func (c *PriceChangeItemClient) QueryBatch(pci *PriceChangeItem) *PriceChangeBatchQuery {
+	query := (&PriceChangeBatchClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := op.ID
+		id := pci.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(orderproduct.Table, orderproduct.FieldID, id),
-			sqlgraph.To(order.Table, order.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, orderproduct.OrderTable, orderproduct.OrderColumn),
+			sqlgraph.From(pricechangeitem.Table, pricechangeitem.FieldID, id),
+			sqlgraph.To(pricechangebatch.Table, pricechangebatch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pricechangeitem.BatchTable, pricechangeitem.BatchColumn),
 		)
-		fromV = sqlgraph.Neighbors(op.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pci.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *OrderProductClient) Hooks() []Hook {
-	hooks := c.hooks.OrderProduct
-	return append(hooks[:len(hooks):len(hooks)], orderproduct.Hooks[:]...)
+func (c *PriceChangeItemClient) Hooks() []Hook {
+	hooks := c.hooks.PriceChangeItem
+	return append(hooks[:len(hooks):len(hooks)], pricechangeitem.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrderProductClient) Interceptors() []Interceptor {
-	inters := c.inters.OrderProduct
-	return append(inters[:len(inters):len(inters)], orderproduct.Interceptors[:]...)
+func (c *PriceChangeItemClient) Interceptors() []Interceptor {
+	inters := c.inters.PriceChangeItem
+	return append(inters[:len(inters):len(inters)], pricechangeitem.Interceptors[:]...)
 }
 
-func (c *OrderProductClient) mutate(ctx context.Context, m *OrderProductMutation) (Value, error) {
+func (c *PriceChangeItemClient) mutate(ctx context.Context, m *PriceChangeItemMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrderProductCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PriceChangeItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrderProductUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PriceChangeItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrderProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PriceChangeItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrderProductDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PriceChangeItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OrderProduct mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PriceChangeItem mutation op: %q", m.Op())
 	}
 }
 
-// PaymentAccountClient is a client for the PaymentAccount schema.
-type PaymentAccountClient struct {
+// ProductClient is a client for the Product schema.
+type ProductClient struct {
 	config
 }
 
-// NewPaymentAccountClient returns a client for the PaymentAccount from the given config.
-func NewPaymentAccountClient(c config) *PaymentAccountClient {
-	return &PaymentAccountClient{config: c}
+// NewProductClient returns a client for the Product from the given config.
+func NewProductClient(c config) *ProductClient {
+	return &ProductClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentaccount.Hooks(f(g(h())))`.
-func (c *PaymentAccountClient) Use(hooks ...Hook) {
-	c.hooks.PaymentAccount = append(c.hooks.PaymentAccount, hooks...)
+// A call to `Use(f, g, h)` equals to `product.Hooks(f(g(h())))`.
+func (c *ProductClient) Use(hooks ...Hook) {
+	c.hooks.Product = append(c.hooks.Product, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymentaccount.Intercept(f(g(h())))`.
-func (c *PaymentAccountClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentAccount = append(c.inters.PaymentAccount, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `product.Intercept(f(g(h())))`.
+func (c *ProductClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Product = append(c.inters.Product, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentAccount entity.
-func (c *PaymentAccountClient) Create() *PaymentAccountCreate {
-	mutation := newPaymentAccountMutation(c.config, OpCreate)
-	return &PaymentAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Product entity.
+func (c *ProductClient) Create() *ProductCreate {
+	mutation := newProductMutation(c.config, OpCreate)
+	return &ProductCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentAccount entities.
-func (c *PaymentAccountClient) CreateBulk(builders ...*PaymentAccountCreate) *PaymentAccountCreateBulk {
-	return &PaymentAccountCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Product entities.
+func (c *ProductClient) CreateBulk(builders ...*ProductCreate) *ProductCreateBulk {
+	return &ProductCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentAccountClient) MapCreateBulk(slice any, setFunc func(*PaymentAccountCreate, int)) *PaymentAccountCreateBulk {
+func (c *ProductClient) MapCreateBulk(slice any, setFunc func(*ProductCreate, int)) *ProductCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentAccountCreateBulk{err: fmt.Errorf("calling to PaymentAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProductCreateBulk{err: fmt.Errorf("calling to ProductClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentAccountCreate, rv.Len())
+	builders := make([]*ProductCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentAccountCreateBulk{config: c.config, builders: builders}
+	return &ProductCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentAccount.
-func (c *PaymentAccountClient) Update() *PaymentAccountUpdate {
-	mutation := newPaymentAccountMutation(c.config, OpUpdate)
-	return &PaymentAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Product.
+func (c *ProductClient) Update() *ProductUpdate {
+	mutation := newProductMutation(c.config, OpUpdate)
+	return &ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentAccountClient) UpdateOne(pa *PaymentAccount) *PaymentAccountUpdateOne {
-	mutation := newPaymentAccountMutation(c.config, OpUpdateOne, withPaymentAccount(pa))
-	return &PaymentAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductClient) UpdateOne(pr *Product) *ProductUpdateOne {
+	mutation := newProductMutation(c.config, OpUpdateOne, withProduct(pr))
+	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentAccountClient) UpdateOneID(id uuid.UUID) *PaymentAccountUpdateOne {
-	mutation := newPaymentAccountMutation(c.config, OpUpdateOne, withPaymentAccountID(id))
-	return &PaymentAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductClient) UpdateOneID(id uuid.UUID) *ProductUpdateOne {
+	mutation := newProductMutation(c.config, OpUpdateOne, withProductID(id))
+	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PaymentAccount.
-func (c *PaymentAccountClient) Delete() *PaymentAccountDelete {
-	mutation := newPaymentAccountMutation(c.config, OpDelete)
-	return &PaymentAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Product.
+func (c *ProductClient) Delete() *ProductDelete {
+	mutation := newProductMutation(c.config, OpDelete)
+	return &ProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentAccountClient) DeleteOne(pa *PaymentAccount) *PaymentAccountDeleteOne {
-	return c.DeleteOneID(pa.ID)
+func (c *ProductClient) DeleteOne(pr *Product) *ProductDeleteOne {
+	return c.DeleteOneID(pr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentAccountClient) DeleteOneID(id uuid.UUID) *PaymentAccountDeleteOne {
-	builder := c.Delete().Where(paymentaccount.ID(id))
+func (c *ProductClient) DeleteOneID(id uuid.UUID) *ProductDeleteOne {
+	builder := c.Delete().Where(product.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentAccountDeleteOne{builder}
+	return &ProductDeleteOne{builder}
 }
 
-// Query returns a query builder for PaymentAccount.
-func (c *PaymentAccountClient) Query() *PaymentAccountQuery {
-	return &PaymentAccountQuery{
+// Query returns a query builder for Product.
+func (c *ProductClient) Query() *ProductQuery {
+	return &ProductQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePaymentAccount},
+		ctx:    &QueryContext{Type: TypeProduct},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PaymentAccount entity by its id.
-func (c *PaymentAccountClient) Get(ctx context.Context, id uuid.UUID) (*PaymentAccount, error) {
-	return c.Query().Where(paymentaccount.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *PaymentAccountClient) GetX(ctx context.Context, id uuid.UUID) *PaymentAccount {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
+// Get returns a Product entity by its id.
+func (c *ProductClient) Get(ctx context.Context, id uuid.UUID) (*Product, error) {
+	return c.Query().Where(product.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductClient) GetX(ctx context.Context, id uuid.UUID) *Product {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCategory queries the category edge of a Product.
+func (c *ProductClient) QueryCategory(pr *Product) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, product.CategoryTable, product.CategoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUnit queries the unit edge of a Product.
+func (c *ProductClient) QueryUnit(pr *Product) *ProductUnitQuery {
+	query := (&ProductUnitClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(productunit.Table, productunit.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, product.UnitTable, product.UnitColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTaxRate queries the tax_rate edge of a Product.
+func (c *ProductClient) QueryTaxRate(pr *Product) *TaxFeeQuery {
+	query := (&TaxFeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(taxfee.Table, taxfee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, product.TaxRateTable, product.TaxRateColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStall queries the stall edge of a Product.
+func (c *ProductClient) QueryStall(pr *Product) *StallQuery {
+	query := (&StallClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(stall.Table, stall.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, product.StallTable, product.StallColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Product.
+func (c *ProductClient) QueryTags(pr *Product) *ProductTagQuery {
+	query := (&ProductTagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(producttag.Table, producttag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, product.TagsTable, product.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProductSpecs queries the product_specs edge of a Product.
+func (c *ProductClient) QueryProductSpecs(pr *Product) *ProductSpecRelationQuery {
+	query := (&ProductSpecRelationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(productspecrelation.Table, productspecrelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.ProductSpecsTable, product.ProductSpecsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProductAttrs queries the product_attrs edge of a Product.
+func (c *ProductClient) QueryProductAttrs(pr *Product) *ProductAttrRelationQuery {
+	query := (&ProductAttrRelationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(productattrrelation.Table, productattrrelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.ProductAttrsTable, product.ProductAttrsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySetMealGroups queries the set_meal_groups edge of a Product.
+func (c *ProductClient) QuerySetMealGroups(pr *Product) *SetMealGroupQuery {
+	query := (&SetMealGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(setmealgroup.Table, setmealgroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.SetMealGroupsTable, product.SetMealGroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySetMealDetails queries the set_meal_details edge of a Product.
+func (c *ProductClient) QuerySetMealDetails(pr *Product) *SetMealDetailQuery {
+	query := (&SetMealDetailClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(setmealdetail.Table, setmealdetail.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.SetMealDetailsTable, product.SetMealDetailsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
 	}
-	return obj
+	return query
 }
 
-// QueryStorePaymentAccounts queries the store_payment_accounts edge of a PaymentAccount.
-func (c *PaymentAccountClient) QueryStorePaymentAccounts(pa *PaymentAccount) *StorePaymentAccountQuery {
-	query := (&StorePaymentAccountClient{config: c.config}).Query()
+// QueryMenuItems queries the menu_items edge of a Product.
+func (c *ProductClient) QueryMenuItems(pr *Product) *MenuItemQuery {
+	query := (&MenuItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pa.ID
+		id := pr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentaccount.Table, paymentaccount.FieldID, id),
-			sqlgraph.To(storepaymentaccount.Table, storepaymentaccount.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, paymentaccount.StorePaymentAccountsTable, paymentaccount.StorePaymentAccountsColumn),
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.MenuItemsTable, product.MenuItemsColumn),
 		)
-		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PaymentAccountClient) Hooks() []Hook {
-	hooks := c.hooks.PaymentAccount
-	return append(hooks[:len(hooks):len(hooks)], paymentaccount.Hooks[:]...)
+func (c *ProductClient) Hooks() []Hook {
+	hooks := c.hooks.Product
+	return append(hooks[:len(hooks):len(hooks)], product.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentAccountClient) Interceptors() []Interceptor {
-	inters := c.inters.PaymentAccount
-	return append(inters[:len(inters):len(inters)], paymentaccount.Interceptors[:]...)
+func (c *ProductClient) Interceptors() []Interceptor {
+	inters := c.inters.Product
+	return append(inters[:len(inters):len(inters)], product.Interceptors[:]...)
 }
 
-func (c *PaymentAccountClient) mutate(ctx context.Context, m *PaymentAccountMutation) (Value, error) {
+func (c *ProductClient) mutate(ctx context.Context, m *ProductMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProductDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PaymentAccount mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Product mutation op: %q", m.Op())
 	}
 }
 
-// PaymentMethodClient is a client for the PaymentMethod schema.
-type PaymentMethodClient struct {
+// ProductAttrClient is a client for the ProductAttr schema.
+type ProductAttrClient struct {
 	config
 }
 
-// NewPaymentMethodClient returns a client for the PaymentMethod from the given config.
-func NewPaymentMethodClient(c config) *PaymentMethodClient {
-	return &PaymentMethodClient{config: c}
+// NewProductAttrClient returns a client for the ProductAttr from the given config.
+func NewProductAttrClient(c config) *ProductAttrClient {
+	return &ProductAttrClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentmethod.Hooks(f(g(h())))`.
-func (c *PaymentMethodClient) Use(hooks ...Hook) {
-	c.hooks.PaymentMethod = append(c.hooks.PaymentMethod, hooks...)
+// A call to `Use(f, g, h)` equals to `productattr.Hooks(f(g(h())))`.
+func (c *ProductAttrClient) Use(hooks ...Hook) {
+	c.hooks.ProductAttr = append(c.hooks.ProductAttr, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymentmethod.Intercept(f(g(h())))`.
-func (c *PaymentMethodClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentMethod = append(c.inters.PaymentMethod, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `productattr.Intercept(f(g(h())))`.
+func (c *ProductAttrClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductAttr = append(c.inters.ProductAttr, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentMethod entity.
-func (c *PaymentMethodClient) Create() *PaymentMethodCreate {
-	mutation := newPaymentMethodMutation(c.config, OpCreate)
-	return &PaymentMethodCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProductAttr entity.
+func (c *ProductAttrClient) Create() *ProductAttrCreate {
+	mutation := newProductAttrMutation(c.config, OpCreate)
+	return &ProductAttrCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentMethod entities.
-func (c *PaymentMethodClient) CreateBulk(builders ...*PaymentMethodCreate) *PaymentMethodCreateBulk {
-	return &PaymentMethodCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProductAttr entities.
+func (c *ProductAttrClient) CreateBulk(builders ...*ProductAttrCreate) *ProductAttrCreateBulk {
+	return &ProductAttrCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentMethodClient) MapCreateBulk(slice any, setFunc func(*PaymentMethodCreate, int)) *PaymentMethodCreateBulk {
+func (c *ProductAttrClient) MapCreateBulk(slice any, setFunc func(*ProductAttrCreate, int)) *ProductAttrCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentMethodCreateBulk{err: fmt.Errorf("calling to PaymentMethodClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProductAttrCreateBulk{err: fmt.Errorf("calling to ProductAttrClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentMethodCreate, rv.Len())
+	builders := make([]*ProductAttrCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentMethodCreateBulk{config: c.config, builders: builders}
+	return &ProductAttrCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentMethod.
-func (c *PaymentMethodClient) Update() *PaymentMethodUpdate {
-	mutation := newPaymentMethodMutation(c.config, OpUpdate)
-	return &PaymentMethodUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProductAttr.
+func (c *ProductAttrClient) Update() *ProductAttrUpdate {
+	mutation := newProductAttrMutation(c.config, OpUpdate)
+	return &ProductAttrUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentMethodClient) UpdateOne(pm *PaymentMethod) *PaymentMethodUpdateOne {
-	mutation := newPaymentMethodMutation(c.config, OpUpdateOne, withPaymentMethod(pm))
-	return &PaymentMethodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductAttrClient) UpdateOne(pa *ProductAttr) *ProductAttrUpdateOne {
+	mutation := newProductAttrMutation(c.config, OpUpdateOne, withProductAttr(pa))
+	return &ProductAttrUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentMethodClient) UpdateOneID(id uuid.UUID) *PaymentMethodUpdateOne {
-	mutation := newPaymentMethodMutation(c.config, OpUpdateOne, withPaymentMethodID(id))
-	return &PaymentMethodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductAttrClient) UpdateOneID(id uuid.UUID) *ProductAttrUpdateOne {
+	mutation := newProductAttrMutation(c.config, OpUpdateOne, withProductAttrID(id))
+	return &ProductAttrUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PaymentMethod.
-func (c *PaymentMethodClient) Delete() *PaymentMethodDelete {
-	mutation := newPaymentMethodMutation(c.config, OpDelete)
-	return &PaymentMethodDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProductAttr.
+func (c *ProductAttrClient) Delete() *ProductAttrDelete {
+	mutation := newProductAttrMutation(c.config, OpDelete)
+	return &ProductAttrDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentMethodClient) DeleteOne(pm *PaymentMethod) *PaymentMethodDeleteOne {
-	return c.DeleteOneID(pm.ID)
+func (c *ProductAttrClient) DeleteOne(pa *ProductAttr) *ProductAttrDeleteOne {
+	return c.DeleteOneID(pa.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentMethodClient) DeleteOneID(id uuid.UUID) *PaymentMethodDeleteOne {
-	builder := c.Delete().Where(paymentmethod.ID(id))
+func (c *ProductAttrClient) DeleteOneID(id uuid.UUID) *ProductAttrDeleteOne {
+	builder := c.Delete().Where(productattr.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentMethodDeleteOne{builder}
+	return &ProductAttrDeleteOne{builder}
 }
 
-// Query returns a query builder for PaymentMethod.
-func (c *PaymentMethodClient) Query() *PaymentMethodQuery {
-	return &PaymentMethodQuery{
+// Query returns a query builder for ProductAttr.
+func (c *ProductAttrClient) Query() *ProductAttrQuery {
+	return &ProductAttrQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePaymentMethod},
+		ctx:    &QueryContext{Type: TypeProductAttr},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PaymentMethod entity by its id.
-func (c *PaymentMethodClient) Get(ctx context.Context, id uuid.UUID) (*PaymentMethod, error) {
-	return c.Query().Where(paymentmethod.ID(id)).Only(ctx)
+// Get returns a ProductAttr entity by its id.
+func (c *ProductAttrClient) Get(ctx context.Context, id uuid.UUID) (*ProductAttr, error) {
+	return c.Query().Where(productattr.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentMethodClient) GetX(ctx context.Context, id uuid.UUID) *PaymentMethod {
+func (c *ProductAttrClient) GetX(ctx context.Context, id uuid.UUID) *ProductAttr {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3309,134 +7116,166 @@ func (c *PaymentMethodClient) GetX(ctx context.Context, id uuid.UUID) *PaymentMe
 	return obj
 }
 
+// QueryItems queries the items edge of a ProductAttr.
+func (c *ProductAttrClient) QueryItems(pa *ProductAttr) *ProductAttrItemQuery {
+	query := (&ProductAttrItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productattr.Table, productattr.FieldID, id),
+			sqlgraph.To(productattritem.Table, productattritem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, productattr.ItemsTable, productattr.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProductAttrs queries the product_attrs edge of a ProductAttr.
+func (c *ProductAttrClient) QueryProductAttrs(pa *ProductAttr) *ProductAttrRelationQuery {
+	query := (&ProductAttrRelationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productattr.Table, productattr.FieldID, id),
+			sqlgraph.To(productattrrelation.Table, productattrrelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, productattr.ProductAttrsTable, productattr.ProductAttrsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *PaymentMethodClient) Hooks() []Hook {
-	hooks := c.hooks.PaymentMethod
-	return append(hooks[:len(hooks):len(hooks)], paymentmethod.Hooks[:]...)
+func (c *ProductAttrClient) Hooks() []Hook {
+	hooks := c.hooks.ProductAttr
+	return append(hooks[:len(hooks):len(hooks)], productattr.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentMethodClient) Interceptors() []Interceptor {
-	inters := c.inters.PaymentMethod
-	return append(inters[:len(inters):len(inters)], paymentmethod.Interceptors[:]...)
+func (c *ProductAttrClient) Interceptors() []Interceptor {
+	inters := c.inters.ProductAttr
+	return append(inters[:len(inters):len(inters)], productattr.Interceptors[:]...)
 }
 
-func (c *PaymentMethodClient) mutate(ctx context.Context, m *PaymentMethodMutation) (Value, error) {
+func (c *ProductAttrClient) mutate(ctx context.Context, m *ProductAttrMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentMethodCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductAttrCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentMethodUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductAttrUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentMethodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductAttrUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentMethodDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProductAttrDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PaymentMethod mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProductAttr mutation op: %q", m.Op())
 	}
 }
 
-// PermissionClient is a client for the Permission schema.
-type PermissionClient struct {
+// ProductAttrItemClient is a client for the ProductAttrItem schema.
+type ProductAttrItemClient struct {
 	config
 }
 
-// NewPermissionClient returns a client for the Permission from the given config.
-func NewPermissionClient(c config) *PermissionClient {
-	return &PermissionClient{config: c}
+// NewProductAttrItemClient returns a client for the ProductAttrItem from the given config.
+func NewProductAttrItemClient(c config) *ProductAttrItemClient {
+	return &ProductAttrItemClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `permission.Hooks(f(g(h())))`.
-func (c *PermissionClient) Use(hooks ...Hook) {
-	c.hooks.Permission = append(c.hooks.Permission, hooks...)
+// A call to `Use(f, g, h)` equals to `productattritem.Hooks(f(g(h())))`.
+func (c *ProductAttrItemClient) Use(hooks ...Hook) {
+	c.hooks.ProductAttrItem = append(c.hooks.ProductAttrItem, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `permission.Intercept(f(g(h())))`.
-func (c *PermissionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Permission = append(c.inters.Permission, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `productattritem.Intercept(f(g(h())))`.
+func (c *ProductAttrItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductAttrItem = append(c.inters.ProductAttrItem, interceptors...)
 }
 
-// Create returns a builder for creating a Permission entity.
-func (c *PermissionClient) Create() *PermissionCreate {
-	mutation := newPermissionMutation(c.config, OpCreate)
-	return &PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProductAttrItem entity.
+func (c *ProductAttrItemClient) Create() *ProductAttrItemCreate {
+	mutation := newProductAttrItemMutation(c.config, OpCreate)
+	return &ProductAttrItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Permission entities.
-func (c *PermissionClient) CreateBulk(builders ...*PermissionCreate) *PermissionCreateBulk {
-	return &PermissionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProductAttrItem entities.
+func (c *ProductAttrItemClient) CreateBulk(builders ...*ProductAttrItemCreate) *ProductAttrItemCreateBulk {
+	return &ProductAttrItemCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PermissionClient) MapCreateBulk(slice any, setFunc func(*PermissionCreate, int)) *PermissionCreateBulk {
+func (c *ProductAttrItemClient) MapCreateBulk(slice any, setFunc func(*ProductAttrItemCreate, int)) *ProductAttrItemCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PermissionCreateBulk{err: fmt.Errorf("calling to PermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProductAttrItemCreateBulk{err: fmt.Errorf("calling to ProductAttrItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PermissionCreate, rv.Len())
+	builders := make([]*ProductAttrItemCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PermissionCreateBulk{config: c.config, builders: builders}
+	return &ProductAttrItemCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Permission.
-func (c *PermissionClient) Update() *PermissionUpdate {
-	mutation := newPermissionMutation(c.config, OpUpdate)
-	return &PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProductAttrItem.
+func (c *ProductAttrItemClient) Update() *ProductAttrItemUpdate {
+	mutation := newProductAttrItemMutation(c.config, OpUpdate)
+	return &ProductAttrItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PermissionClient) UpdateOne(pe *Permission) *PermissionUpdateOne {
-	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermission(pe))
-	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductAttrItemClient) UpdateOne(pai *ProductAttrItem) *ProductAttrItemUpdateOne {
+	mutation := newProductAttrItemMutation(c.config, OpUpdateOne, withProductAttrItem(pai))
+	return &ProductAttrItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PermissionClient) UpdateOneID(id uuid.UUID) *PermissionUpdateOne {
-	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermissionID(id))
-	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductAttrItemClient) UpdateOneID(id uuid.UUID) *ProductAttrItemUpdateOne {
+	mutation := newProductAttrItemMutation(c.config, OpUpdateOne, withProductAttrItemID(id))
+	return &ProductAttrItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Permission.
-func (c *PermissionClient) Delete() *PermissionDelete {
-	mutation := newPermissionMutation(c.config, OpDelete)
-	return &PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProductAttrItem.
+func (c *ProductAttrItemClient) Delete() *ProductAttrItemDelete {
+	mutation := newProductAttrItemMutation(c.config, OpDelete)
+	return &ProductAttrItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PermissionClient) DeleteOne(pe *Permission) *PermissionDeleteOne {
-	return c.DeleteOneID(pe.ID)
+func (c *ProductAttrItemClient) DeleteOne(pai *ProductAttrItem) *ProductAttrItemDeleteOne {
+	return c.DeleteOneID(pai.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PermissionClient) DeleteOneID(id uuid.UUID) *PermissionDeleteOne {
-	builder := c.Delete().Where(permission.ID(id))
+func (c *ProductAttrItemClient) DeleteOneID(id uuid.UUID) *ProductAttrItemDeleteOne {
+	builder := c.Delete().Where(productattritem.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PermissionDeleteOne{builder}
+	return &ProductAttrItemDeleteOne{builder}
 }
 
-// Query returns a query builder for Permission.
-func (c *PermissionClient) Query() *PermissionQuery {
-	return &PermissionQuery{
+// Query returns a query builder for ProductAttrItem.
+func (c *ProductAttrItemClient) Query() *ProductAttrItemQuery {
+	return &ProductAttrItemQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePermission},
+		ctx:    &QueryContext{Type: TypeProductAttrItem},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Permission entity by its id.
-func (c *PermissionClient) Get(ctx context.Context, id uuid.UUID) (*Permission, error) {
-	return c.Query().Where(permission.ID(id)).Only(ctx)
+// Get returns a ProductAttrItem entity by its id.
+func (c *ProductAttrItemClient) Get(ctx context.Context, id uuid.UUID) (*ProductAttrItem, error) {
+	return c.Query().Where(productattritem.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PermissionClient) GetX(ctx context.Context, id uuid.UUID) *Permission {
+func (c *ProductAttrItemClient) GetX(ctx context.Context, id uuid.UUID) *ProductAttrItem {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3444,445 +7283,349 @@ func (c *PermissionClient) GetX(ctx context.Context, id uuid.UUID) *Permission {
 	return obj
 }
 
-// QueryMenu queries the menu edge of a Permission.
-func (c *PermissionClient) QueryMenu(pe *Permission) *RouterMenuQuery {
-	query := (&RouterMenuClient{config: c.config}).Query()
+// QueryAttr queries the attr edge of a ProductAttrItem.
+func (c *ProductAttrItemClient) QueryAttr(pai *ProductAttrItem) *ProductAttrQuery {
+	query := (&ProductAttrClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pe.ID
+		id := pai.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(permission.Table, permission.FieldID, id),
-			sqlgraph.To(routermenu.Table, routermenu.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, permission.MenuTable, permission.MenuColumn),
+			sqlgraph.From(productattritem.Table, productattritem.FieldID, id),
+			sqlgraph.To(productattr.Table, productattr.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productattritem.AttrTable, productattritem.AttrColumn),
 		)
-		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pai.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProductAttrs queries the product_attrs edge of a ProductAttrItem.
+func (c *ProductAttrItemClient) QueryProductAttrs(pai *ProductAttrItem) *ProductAttrRelationQuery {
+	query := (&ProductAttrRelationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pai.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productattritem.Table, productattritem.FieldID, id),
+			sqlgraph.To(productattrrelation.Table, productattrrelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, productattritem.ProductAttrsTable, productattritem.ProductAttrsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pai.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PermissionClient) Hooks() []Hook {
-	hooks := c.hooks.Permission
-	return append(hooks[:len(hooks):len(hooks)], permission.Hooks[:]...)
+func (c *ProductAttrItemClient) Hooks() []Hook {
+	hooks := c.hooks.ProductAttrItem
+	return append(hooks[:len(hooks):len(hooks)], productattritem.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PermissionClient) Interceptors() []Interceptor {
-	inters := c.inters.Permission
-	return append(inters[:len(inters):len(inters)], permission.Interceptors[:]...)
+func (c *ProductAttrItemClient) Interceptors() []Interceptor {
+	inters := c.inters.ProductAttrItem
+	return append(inters[:len(inters):len(inters)], productattritem.Interceptors[:]...)
 }
 
-func (c *PermissionClient) mutate(ctx context.Context, m *PermissionMutation) (Value, error) {
+func (c *ProductAttrItemClient) mutate(ctx context.Context, m *ProductAttrItemMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductAttrItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductAttrItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductAttrItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProductAttrItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Permission mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProductAttrItem mutation op: %q", m.Op())
 	}
 }
 
-// ProductClient is a client for the Product schema.
-type ProductClient struct {
+// ProductAttrRelationClient is a client for the ProductAttrRelation schema.
+type ProductAttrRelationClient struct {
 	config
 }
 
-// NewProductClient returns a client for the Product from the given config.
-func NewProductClient(c config) *ProductClient {
-	return &ProductClient{config: c}
+// NewProductAttrRelationClient returns a client for the ProductAttrRelation from the given config.
+func NewProductAttrRelationClient(c config) *ProductAttrRelationClient {
+	return &ProductAttrRelationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `product.Hooks(f(g(h())))`.
-func (c *ProductClient) Use(hooks ...Hook) {
-	c.hooks.Product = append(c.hooks.Product, hooks...)
+// A call to `Use(f, g, h)` equals to `productattrrelation.Hooks(f(g(h())))`.
+func (c *ProductAttrRelationClient) Use(hooks ...Hook) {
+	c.hooks.ProductAttrRelation = append(c.hooks.ProductAttrRelation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `product.Intercept(f(g(h())))`.
-func (c *ProductClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Product = append(c.inters.Product, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `productattrrelation.Intercept(f(g(h())))`.
+func (c *ProductAttrRelationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductAttrRelation = append(c.inters.ProductAttrRelation, interceptors...)
 }
 
-// Create returns a builder for creating a Product entity.
-func (c *ProductClient) Create() *ProductCreate {
-	mutation := newProductMutation(c.config, OpCreate)
-	return &ProductCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProductAttrRelation entity.
+func (c *ProductAttrRelationClient) Create() *ProductAttrRelationCreate {
+	mutation := newProductAttrRelationMutation(c.config, OpCreate)
+	return &ProductAttrRelationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Product entities.
-func (c *ProductClient) CreateBulk(builders ...*ProductCreate) *ProductCreateBulk {
-	return &ProductCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProductAttrRelation entities.
+func (c *ProductAttrRelationClient) CreateBulk(builders ...*ProductAttrRelationCreate) *ProductAttrRelationCreateBulk {
+	return &ProductAttrRelationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductClient) MapCreateBulk(slice any, setFunc func(*ProductCreate, int)) *ProductCreateBulk {
+func (c *ProductAttrRelationClient) MapCreateBulk(slice any, setFunc func(*ProductAttrRelationCreate, int)) *ProductAttrRelationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductCreateBulk{err: fmt.Errorf("calling to ProductClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProductAttrRelationCreateBulk{err: fmt.Errorf("calling to ProductAttrRelationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductCreate, rv.Len())
+	builders := make([]*ProductAttrRelationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductCreateBulk{config: c.config, builders: builders}
+	return &ProductAttrRelationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Product.
-func (c *ProductClient) Update() *ProductUpdate {
-	mutation := newProductMutation(c.config, OpUpdate)
-	return &ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProductAttrRelation.
+func (c *ProductAttrRelationClient) Update() *ProductAttrRelationUpdate {
+	mutation := newProductAttrRelationMutation(c.config, OpUpdate)
+	return &ProductAttrRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductClient) UpdateOne(pr *Product) *ProductUpdateOne {
-	mutation := newProductMutation(c.config, OpUpdateOne, withProduct(pr))
-	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductAttrRelationClient) UpdateOne(par *ProductAttrRelation) *ProductAttrRelationUpdateOne {
+	mutation := newProductAttrRelationMutation(c.config, OpUpdateOne, withProductAttrRelation(par))
+	return &ProductAttrRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductClient) UpdateOneID(id uuid.UUID) *ProductUpdateOne {
-	mutation := newProductMutation(c.config, OpUpdateOne, withProductID(id))
-	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// Delete returns a delete builder for Product.
-func (c *ProductClient) Delete() *ProductDelete {
-	mutation := newProductMutation(c.config, OpDelete)
-	return &ProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// DeleteOne returns a builder for deleting the given entity.
-func (c *ProductClient) DeleteOne(pr *Product) *ProductDeleteOne {
-	return c.DeleteOneID(pr.ID)
-}
-
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductClient) DeleteOneID(id uuid.UUID) *ProductDeleteOne {
-	builder := c.Delete().Where(product.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &ProductDeleteOne{builder}
-}
-
-// Query returns a query builder for Product.
-func (c *ProductClient) Query() *ProductQuery {
-	return &ProductQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypeProduct},
-		inters: c.Interceptors(),
-	}
-}
-
-// Get returns a Product entity by its id.
-func (c *ProductClient) Get(ctx context.Context, id uuid.UUID) (*Product, error) {
-	return c.Query().Where(product.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *ProductClient) GetX(ctx context.Context, id uuid.UUID) *Product {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// QueryCategory queries the category edge of a Product.
-func (c *ProductClient) QueryCategory(pr *Product) *CategoryQuery {
-	query := (&CategoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(category.Table, category.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, product.CategoryTable, product.CategoryColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+func (c *ProductAttrRelationClient) UpdateOneID(id uuid.UUID) *ProductAttrRelationUpdateOne {
+	mutation := newProductAttrRelationMutation(c.config, OpUpdateOne, withProductAttrRelationID(id))
+	return &ProductAttrRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
-
-// QueryUnit queries the unit edge of a Product.
-func (c *ProductClient) QueryUnit(pr *Product) *ProductUnitQuery {
-	query := (&ProductUnitClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(productunit.Table, productunit.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, product.UnitTable, product.UnitColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+
+// Delete returns a delete builder for ProductAttrRelation.
+func (c *ProductAttrRelationClient) Delete() *ProductAttrRelationDelete {
+	mutation := newProductAttrRelationMutation(c.config, OpDelete)
+	return &ProductAttrRelationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// QueryTaxRate queries the tax_rate edge of a Product.
-func (c *ProductClient) QueryTaxRate(pr *Product) *TaxFeeQuery {
-	query := (&TaxFeeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(taxfee.Table, taxfee.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, product.TaxRateTable, product.TaxRateColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductAttrRelationClient) DeleteOne(par *ProductAttrRelation) *ProductAttrRelationDeleteOne {
+	return c.DeleteOneID(par.ID)
 }
 
-// QueryStall queries the stall edge of a Product.
-func (c *ProductClient) QueryStall(pr *Product) *StallQuery {
-	query := (&StallClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(stall.Table, stall.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, product.StallTable, product.StallColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProductAttrRelationClient) DeleteOneID(id uuid.UUID) *ProductAttrRelationDeleteOne {
+	builder := c.Delete().Where(productattrrelation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductAttrRelationDeleteOne{builder}
 }
 
-// QueryTags queries the tags edge of a Product.
-func (c *ProductClient) QueryTags(pr *Product) *ProductTagQuery {
-	query := (&ProductTagClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(producttag.Table, producttag.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, product.TagsTable, product.TagsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// Query returns a query builder for ProductAttrRelation.
+func (c *ProductAttrRelationClient) Query() *ProductAttrRelationQuery {
+	return &ProductAttrRelationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProductAttrRelation},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryProductSpecs queries the product_specs edge of a Product.
-func (c *ProductClient) QueryProductSpecs(pr *Product) *ProductSpecRelationQuery {
-	query := (&ProductSpecRelationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(productspecrelation.Table, productspecrelation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.ProductSpecsTable, product.ProductSpecsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Get returns a ProductAttrRelation entity by its id.
+func (c *ProductAttrRelationClient) Get(ctx context.Context, id uuid.UUID) (*ProductAttrRelation, error) {
+	return c.Query().Where(productattrrelation.ID(id)).Only(ctx)
 }
 
-// QueryProductAttrs queries the product_attrs edge of a Product.
-func (c *ProductClient) QueryProductAttrs(pr *Product) *ProductAttrRelationQuery {
-	query := (&ProductAttrRelationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(productattrrelation.Table, productattrrelation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.ProductAttrsTable, product.ProductAttrsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductAttrRelationClient) GetX(ctx context.Context, id uuid.UUID) *ProductAttrRelation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QuerySetMealGroups queries the set_meal_groups edge of a Product.
-func (c *ProductClient) QuerySetMealGroups(pr *Product) *SetMealGroupQuery {
-	query := (&SetMealGroupClient{config: c.config}).Query()
+// QueryProduct queries the product edge of a ProductAttrRelation.
+func (c *ProductAttrRelationClient) QueryProduct(par *ProductAttrRelation) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := par.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(setmealgroup.Table, setmealgroup.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.SetMealGroupsTable, product.SetMealGroupsColumn),
+			sqlgraph.From(productattrrelation.Table, productattrrelation.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productattrrelation.ProductTable, productattrrelation.ProductColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(par.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QuerySetMealDetails queries the set_meal_details edge of a Product.
-func (c *ProductClient) QuerySetMealDetails(pr *Product) *SetMealDetailQuery {
-	query := (&SetMealDetailClient{config: c.config}).Query()
+// QueryAttr queries the attr edge of a ProductAttrRelation.
+func (c *ProductAttrRelationClient) QueryAttr(par *ProductAttrRelation) *ProductAttrQuery {
+	query := (&ProductAttrClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := par.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(setmealdetail.Table, setmealdetail.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.SetMealDetailsTable, product.SetMealDetailsColumn),
+			sqlgraph.From(productattrrelation.Table, productattrrelation.FieldID, id),
+			sqlgraph.To(productattr.Table, productattr.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productattrrelation.AttrTable, productattrrelation.AttrColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(par.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryMenuItems queries the menu_items edge of a Product.
-func (c *ProductClient) QueryMenuItems(pr *Product) *MenuItemQuery {
-	query := (&MenuItemClient{config: c.config}).Query()
+// QueryAttrItem queries the attr_item edge of a ProductAttrRelation.
+func (c *ProductAttrRelationClient) QueryAttrItem(par *ProductAttrRelation) *ProductAttrItemQuery {
+	query := (&ProductAttrItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := par.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(menuitem.Table, menuitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.MenuItemsTable, product.MenuItemsColumn),
+			sqlgraph.From(productattrrelation.Table, productattrrelation.FieldID, id),
+			sqlgraph.To(productattritem.Table, productattritem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productattrrelation.AttrItemTable, productattrrelation.AttrItemColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(par.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProductClient) Hooks() []Hook {
-	hooks := c.hooks.Product
-	return append(hooks[:len(hooks):len(hooks)], product.Hooks[:]...)
+func (c *ProductAttrRelationClient) Hooks() []Hook {
+	hooks := c.hooks.ProductAttrRelation
+	return append(hooks[:len(hooks):len(hooks)], productattrrelation.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductClient) Interceptors() []Interceptor {
-	inters := c.inters.Product
-	return append(inters[:len(inters):len(inters)], product.Interceptors[:]...)
+func (c *ProductAttrRelationClient) Interceptors() []Interceptor {
+	inters := c.inters.ProductAttrRelation
+	return append(inters[:len(inters):len(inters)], productattrrelation.Interceptors[:]...)
 }
 
-func (c *ProductClient) mutate(ctx context.Context, m *ProductMutation) (Value, error) {
+func (c *ProductAttrRelationClient) mutate(ctx context.Context, m *ProductAttrRelationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductAttrRelationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductAttrRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductAttrRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProductAttrRelationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Product mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProductAttrRelation mutation op: %q", m.Op())
 	}
 }
 
-// ProductAttrClient is a client for the ProductAttr schema.
-type ProductAttrClient struct {
+// ProductSpecClient is a client for the ProductSpec schema.
+type ProductSpecClient struct {
 	config
 }
 
-// NewProductAttrClient returns a client for the ProductAttr from the given config.
-func NewProductAttrClient(c config) *ProductAttrClient {
-	return &ProductAttrClient{config: c}
+// NewProductSpecClient returns a client for the ProductSpec from the given config.
+func NewProductSpecClient(c config) *ProductSpecClient {
+	return &ProductSpecClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `productattr.Hooks(f(g(h())))`.
-func (c *ProductAttrClient) Use(hooks ...Hook) {
-	c.hooks.ProductAttr = append(c.hooks.ProductAttr, hooks...)
+// A call to `Use(f, g, h)` equals to `productspec.Hooks(f(g(h())))`.
+func (c *ProductSpecClient) Use(hooks ...Hook) {
+	c.hooks.ProductSpec = append(c.hooks.ProductSpec, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `productattr.Intercept(f(g(h())))`.
-func (c *ProductAttrClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProductAttr = append(c.inters.ProductAttr, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `productspec.Intercept(f(g(h())))`.
+func (c *ProductSpecClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductSpec = append(c.inters.ProductSpec, interceptors...)
 }
 
-// Create returns a builder for creating a ProductAttr entity.
-func (c *ProductAttrClient) Create() *ProductAttrCreate {
-	mutation := newProductAttrMutation(c.config, OpCreate)
-	return &ProductAttrCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProductSpec entity.
+func (c *ProductSpecClient) Create() *ProductSpecCreate {
+	mutation := newProductSpecMutation(c.config, OpCreate)
+	return &ProductSpecCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProductAttr entities.
-func (c *ProductAttrClient) CreateBulk(builders ...*ProductAttrCreate) *ProductAttrCreateBulk {
-	return &ProductAttrCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProductSpec entities.
+func (c *ProductSpecClient) CreateBulk(builders ...*ProductSpecCreate) *ProductSpecCreateBulk {
+	return &ProductSpecCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductAttrClient) MapCreateBulk(slice any, setFunc func(*ProductAttrCreate, int)) *ProductAttrCreateBulk {
+func (c *ProductSpecClient) MapCreateBulk(slice any, setFunc func(*ProductSpecCreate, int)) *ProductSpecCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductAttrCreateBulk{err: fmt.Errorf("calling to ProductAttrClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProductSpecCreateBulk{err: fmt.Errorf("calling to ProductSpecClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductAttrCreate, rv.Len())
+	builders := make([]*ProductSpecCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductAttrCreateBulk{config: c.config, builders: builders}
+	return &ProductSpecCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProductAttr.
-func (c *ProductAttrClient) Update() *ProductAttrUpdate {
-	mutation := newProductAttrMutation(c.config, OpUpdate)
-	return &ProductAttrUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProductSpec.
+func (c *ProductSpecClient) Update() *ProductSpecUpdate {
+	mutation := newProductSpecMutation(c.config, OpUpdate)
+	return &ProductSpecUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductAttrClient) UpdateOne(pa *ProductAttr) *ProductAttrUpdateOne {
-	mutation := newProductAttrMutation(c.config, OpUpdateOne, withProductAttr(pa))
-	return &ProductAttrUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductSpecClient) UpdateOne(ps *ProductSpec) *ProductSpecUpdateOne {
+	mutation := newProductSpecMutation(c.config, OpUpdateOne, withProductSpec(ps))
+	return &ProductSpecUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductAttrClient) UpdateOneID(id uuid.UUID) *ProductAttrUpdateOne {
-	mutation := newProductAttrMutation(c.config, OpUpdateOne, withProductAttrID(id))
-	return &ProductAttrUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductSpecClient) UpdateOneID(id uuid.UUID) *ProductSpecUpdateOne {
+	mutation := newProductSpecMutation(c.config, OpUpdateOne, withProductSpecID(id))
+	return &ProductSpecUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProductAttr.
-func (c *ProductAttrClient) Delete() *ProductAttrDelete {
-	mutation := newProductAttrMutation(c.config, OpDelete)
-	return &ProductAttrDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProductSpec.
+func (c *ProductSpecClient) Delete() *ProductSpecDelete {
+	mutation := newProductSpecMutation(c.config, OpDelete)
+	return &ProductSpecDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductAttrClient) DeleteOne(pa *ProductAttr) *ProductAttrDeleteOne {
-	return c.DeleteOneID(pa.ID)
+func (c *ProductSpecClient) DeleteOne(ps *ProductSpec) *ProductSpecDeleteOne {
+	return c.DeleteOneID(ps.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductAttrClient) DeleteOneID(id uuid.UUID) *ProductAttrDeleteOne {
-	builder := c.Delete().Where(productattr.ID(id))
+func (c *ProductSpecClient) DeleteOneID(id uuid.UUID) *ProductSpecDeleteOne {
+	builder := c.Delete().Where(productspec.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductAttrDeleteOne{builder}
+	return &ProductSpecDeleteOne{builder}
 }
 
-// Query returns a query builder for ProductAttr.
-func (c *ProductAttrClient) Query() *ProductAttrQuery {
-	return &ProductAttrQuery{
+// Query returns a query builder for ProductSpec.
+func (c *ProductSpecClient) Query() *ProductSpecQuery {
+	return &ProductSpecQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProductAttr},
+		ctx:    &QueryContext{Type: TypeProductSpec},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProductAttr entity by its id.
-func (c *ProductAttrClient) Get(ctx context.Context, id uuid.UUID) (*ProductAttr, error) {
-	return c.Query().Where(productattr.ID(id)).Only(ctx)
+// Get returns a ProductSpec entity by its id.
+func (c *ProductSpecClient) Get(ctx context.Context, id uuid.UUID) (*ProductSpec, error) {
+	return c.Query().Where(productspec.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProductAttrClient) GetX(ctx context.Context, id uuid.UUID) *ProductAttr {
+func (c *ProductSpecClient) GetX(ctx context.Context, id uuid.UUID) *ProductSpec {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3890,166 +7633,150 @@ func (c *ProductAttrClient) GetX(ctx context.Context, id uuid.UUID) *ProductAttr
 	return obj
 }
 
-// QueryItems queries the items edge of a ProductAttr.
-func (c *ProductAttrClient) QueryItems(pa *ProductAttr) *ProductAttrItemQuery {
-	query := (&ProductAttrItemClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pa.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(productattr.Table, productattr.FieldID, id),
-			sqlgraph.To(productattritem.Table, productattritem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, productattr.ItemsTable, productattr.ItemsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProductAttrs queries the product_attrs edge of a ProductAttr.
-func (c *ProductAttrClient) QueryProductAttrs(pa *ProductAttr) *ProductAttrRelationQuery {
-	query := (&ProductAttrRelationClient{config: c.config}).Query()
+// QueryProductSpecs queries the product_specs edge of a ProductSpec.
+func (c *ProductSpecClient) QueryProductSpecs(ps *ProductSpec) *ProductSpecRelationQuery {
+	query := (&ProductSpecRelationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pa.ID
+		id := ps.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(productattr.Table, productattr.FieldID, id),
-			sqlgraph.To(productattrrelation.Table, productattrrelation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, productattr.ProductAttrsTable, productattr.ProductAttrsColumn),
+			sqlgraph.From(productspec.Table, productspec.FieldID, id),
+			sqlgraph.To(productspecrelation.Table, productspecrelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, productspec.ProductSpecsTable, productspec.ProductSpecsColumn),
 		)
-		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ps.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProductAttrClient) Hooks() []Hook {
-	hooks := c.hooks.ProductAttr
-	return append(hooks[:len(hooks):len(hooks)], productattr.Hooks[:]...)
+func (c *ProductSpecClient) Hooks() []Hook {
+	hooks := c.hooks.ProductSpec
+	return append(hooks[:len(hooks):len(hooks)], productspec.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductAttrClient) Interceptors() []Interceptor {
-	inters := c.inters.ProductAttr
-	return append(inters[:len(inters):len(inters)], productattr.Interceptors[:]...)
+func (c *ProductSpecClient) Interceptors() []Interceptor {
+	inters := c.inters.ProductSpec
+	return append(inters[:len(inters):len(inters)], productspec.Interceptors[:]...)
 }
 
-func (c *ProductAttrClient) mutate(ctx context.Context, m *ProductAttrMutation) (Value, error) {
+func (c *ProductSpecClient) mutate(ctx context.Context, m *ProductSpecMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductAttrCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductSpecCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductAttrUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductSpecUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductAttrUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductSpecUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductAttrDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProductSpecDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProductAttr mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProductSpec mutation op: %q", m.Op())
 	}
 }
 
-// ProductAttrItemClient is a client for the ProductAttrItem schema.
-type ProductAttrItemClient struct {
+// ProductSpecRelationClient is a client for the ProductSpecRelation schema.
+type ProductSpecRelationClient struct {
 	config
 }
 
-// NewProductAttrItemClient returns a client for the ProductAttrItem from the given config.
-func NewProductAttrItemClient(c config) *ProductAttrItemClient {
-	return &ProductAttrItemClient{config: c}
+// NewProductSpecRelationClient returns a client for the ProductSpecRelation from the given config.
+func NewProductSpecRelationClient(c config) *ProductSpecRelationClient {
+	return &ProductSpecRelationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `productattritem.Hooks(f(g(h())))`.
-func (c *ProductAttrItemClient) Use(hooks ...Hook) {
-	c.hooks.ProductAttrItem = append(c.hooks.ProductAttrItem, hooks...)
+// A call to `Use(f, g, h)` equals to `productspecrelation.Hooks(f(g(h())))`.
+func (c *ProductSpecRelationClient) Use(hooks ...Hook) {
+	c.hooks.ProductSpecRelation = append(c.hooks.ProductSpecRelation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `productattritem.Intercept(f(g(h())))`.
-func (c *ProductAttrItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProductAttrItem = append(c.inters.ProductAttrItem, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `productspecrelation.Intercept(f(g(h())))`.
+func (c *ProductSpecRelationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductSpecRelation = append(c.inters.ProductSpecRelation, interceptors...)
 }
 
-// Create returns a builder for creating a ProductAttrItem entity.
-func (c *ProductAttrItemClient) Create() *ProductAttrItemCreate {
-	mutation := newProductAttrItemMutation(c.config, OpCreate)
-	return &ProductAttrItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProductSpecRelation entity.
+func (c *ProductSpecRelationClient) Create() *ProductSpecRelationCreate {
+	mutation := newProductSpecRelationMutation(c.config, OpCreate)
+	return &ProductSpecRelationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProductAttrItem entities.
-func (c *ProductAttrItemClient) CreateBulk(builders ...*ProductAttrItemCreate) *ProductAttrItemCreateBulk {
-	return &ProductAttrItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProductSpecRelation entities.
+func (c *ProductSpecRelationClient) CreateBulk(builders ...*ProductSpecRelationCreate) *ProductSpecRelationCreateBulk {
+	return &ProductSpecRelationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductAttrItemClient) MapCreateBulk(slice any, setFunc func(*ProductAttrItemCreate, int)) *ProductAttrItemCreateBulk {
+func (c *ProductSpecRelationClient) MapCreateBulk(slice any, setFunc func(*ProductSpecRelationCreate, int)) *ProductSpecRelationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductAttrItemCreateBulk{err: fmt.Errorf("calling to ProductAttrItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProductSpecRelationCreateBulk{err: fmt.Errorf("calling to ProductSpecRelationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductAttrItemCreate, rv.Len())
+	builders := make([]*ProductSpecRelationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductAttrItemCreateBulk{config: c.config, builders: builders}
+	return &ProductSpecRelationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProductAttrItem.
-func (c *ProductAttrItemClient) Update() *ProductAttrItemUpdate {
-	mutation := newProductAttrItemMutation(c.config, OpUpdate)
-	return &ProductAttrItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProductSpecRelation.
+func (c *ProductSpecRelationClient) Update() *ProductSpecRelationUpdate {
+	mutation := newProductSpecRelationMutation(c.config, OpUpdate)
+	return &ProductSpecRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductAttrItemClient) UpdateOne(pai *ProductAttrItem) *ProductAttrItemUpdateOne {
-	mutation := newProductAttrItemMutation(c.config, OpUpdateOne, withProductAttrItem(pai))
-	return &ProductAttrItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductSpecRelationClient) UpdateOne(psr *ProductSpecRelation) *ProductSpecRelationUpdateOne {
+	mutation := newProductSpecRelationMutation(c.config, OpUpdateOne, withProductSpecRelation(psr))
+	return &ProductSpecRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductAttrItemClient) UpdateOneID(id uuid.UUID) *ProductAttrItemUpdateOne {
-	mutation := newProductAttrItemMutation(c.config, OpUpdateOne, withProductAttrItemID(id))
-	return &ProductAttrItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductSpecRelationClient) UpdateOneID(id uuid.UUID) *ProductSpecRelationUpdateOne {
+	mutation := newProductSpecRelationMutation(c.config, OpUpdateOne, withProductSpecRelationID(id))
+	return &ProductSpecRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProductAttrItem.
-func (c *ProductAttrItemClient) Delete() *ProductAttrItemDelete {
-	mutation := newProductAttrItemMutation(c.config, OpDelete)
-	return &ProductAttrItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProductSpecRelation.
+func (c *ProductSpecRelationClient) Delete() *ProductSpecRelationDelete {
+	mutation := newProductSpecRelationMutation(c.config, OpDelete)
+	return &ProductSpecRelationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductAttrItemClient) DeleteOne(pai *ProductAttrItem) *ProductAttrItemDeleteOne {
-	return c.DeleteOneID(pai.ID)
+func (c *ProductSpecRelationClient) DeleteOne(psr *ProductSpecRelation) *ProductSpecRelationDeleteOne {
+	return c.DeleteOneID(psr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductAttrItemClient) DeleteOneID(id uuid.UUID) *ProductAttrItemDeleteOne {
-	builder := c.Delete().Where(productattritem.ID(id))
+func (c *ProductSpecRelationClient) DeleteOneID(id uuid.UUID) *ProductSpecRelationDeleteOne {
+	builder := c.Delete().Where(productspecrelation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductAttrItemDeleteOne{builder}
+	return &ProductSpecRelationDeleteOne{builder}
 }
 
-// Query returns a query builder for ProductAttrItem.
-func (c *ProductAttrItemClient) Query() *ProductAttrItemQuery {
-	return &ProductAttrItemQuery{
+// Query returns a query builder for ProductSpecRelation.
+func (c *ProductSpecRelationClient) Query() *ProductSpecRelationQuery {
+	return &ProductSpecRelationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProductAttrItem},
+		ctx:    &QueryContext{Type: TypeProductSpecRelation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProductAttrItem entity by its id.
-func (c *ProductAttrItemClient) Get(ctx context.Context, id uuid.UUID) (*ProductAttrItem, error) {
-	return c.Query().Where(productattritem.ID(id)).Only(ctx)
+// Get returns a ProductSpecRelation entity by its id.
+func (c *ProductSpecRelationClient) Get(ctx context.Context, id uuid.UUID) (*ProductSpecRelation, error) {
+	return c.Query().Where(productspecrelation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProductAttrItemClient) GetX(ctx context.Context, id uuid.UUID) *ProductAttrItem {
+func (c *ProductSpecRelationClient) GetX(ctx context.Context, id uuid.UUID) *ProductSpecRelation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4057,166 +7784,182 @@ func (c *ProductAttrItemClient) GetX(ctx context.Context, id uuid.UUID) *Product
 	return obj
 }
 
-// QueryAttr queries the attr edge of a ProductAttrItem.
-func (c *ProductAttrItemClient) QueryAttr(pai *ProductAttrItem) *ProductAttrQuery {
-	query := (&ProductAttrClient{config: c.config}).Query()
+// QueryProduct queries the product edge of a ProductSpecRelation.
+func (c *ProductSpecRelationClient) QueryProduct(psr *ProductSpecRelation) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pai.ID
+		id := psr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(productattritem.Table, productattritem.FieldID, id),
-			sqlgraph.To(productattr.Table, productattr.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, productattritem.AttrTable, productattritem.AttrColumn),
+			sqlgraph.From(productspecrelation.Table, productspecrelation.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productspecrelation.ProductTable, productspecrelation.ProductColumn),
 		)
-		fromV = sqlgraph.Neighbors(pai.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(psr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryProductAttrs queries the product_attrs edge of a ProductAttrItem.
-func (c *ProductAttrItemClient) QueryProductAttrs(pai *ProductAttrItem) *ProductAttrRelationQuery {
-	query := (&ProductAttrRelationClient{config: c.config}).Query()
+// QuerySpec queries the spec edge of a ProductSpecRelation.
+func (c *ProductSpecRelationClient) QuerySpec(psr *ProductSpecRelation) *ProductSpecQuery {
+	query := (&ProductSpecClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pai.ID
+		id := psr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(productattritem.Table, productattritem.FieldID, id),
-			sqlgraph.To(productattrrelation.Table, productattrrelation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, productattritem.ProductAttrsTable, productattritem.ProductAttrsColumn),
+			sqlgraph.From(productspecrelation.Table, productspecrelation.FieldID, id),
+			sqlgraph.To(productspec.Table, productspec.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productspecrelation.SpecTable, productspecrelation.SpecColumn),
 		)
-		fromV = sqlgraph.Neighbors(pai.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(psr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPackingFee queries the packing_fee edge of a ProductSpecRelation.
+func (c *ProductSpecRelationClient) QueryPackingFee(psr *ProductSpecRelation) *AdditionalFeeQuery {
+	query := (&AdditionalFeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := psr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productspecrelation.Table, productspecrelation.FieldID, id),
+			sqlgraph.To(additionalfee.Table, additionalfee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productspecrelation.PackingFeeTable, productspecrelation.PackingFeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(psr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProductAttrItemClient) Hooks() []Hook {
-	hooks := c.hooks.ProductAttrItem
-	return append(hooks[:len(hooks):len(hooks)], productattritem.Hooks[:]...)
+func (c *ProductSpecRelationClient) Hooks() []Hook {
+	hooks := c.hooks.ProductSpecRelation
+	return append(hooks[:len(hooks):len(hooks)], productspecrelation.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductAttrItemClient) Interceptors() []Interceptor {
-	inters := c.inters.ProductAttrItem
-	return append(inters[:len(inters):len(inters)], productattritem.Interceptors[:]...)
+func (c *ProductSpecRelationClient) Interceptors() []Interceptor {
+	inters := c.inters.ProductSpecRelation
+	return append(inters[:len(inters):len(inters)], productspecrelation.Interceptors[:]...)
 }
 
-func (c *ProductAttrItemClient) mutate(ctx context.Context, m *ProductAttrItemMutation) (Value, error) {
+func (c *ProductSpecRelationClient) mutate(ctx context.Context, m *ProductSpecRelationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductAttrItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductSpecRelationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductAttrItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductSpecRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductAttrItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductSpecRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductAttrItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProductSpecRelationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProductAttrItem mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProductSpecRelation mutation op: %q", m.Op())
 	}
 }
 
-// ProductAttrRelationClient is a client for the ProductAttrRelation schema.
-type ProductAttrRelationClient struct {
+// ProductTagClient is a client for the ProductTag schema.
+type ProductTagClient struct {
 	config
 }
 
-// NewProductAttrRelationClient returns a client for the ProductAttrRelation from the given config.
-func NewProductAttrRelationClient(c config) *ProductAttrRelationClient {
-	return &ProductAttrRelationClient{config: c}
+// NewProductTagClient returns a client for the ProductTag from the given config.
+func NewProductTagClient(c config) *ProductTagClient {
+	return &ProductTagClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `productattrrelation.Hooks(f(g(h())))`.
-func (c *ProductAttrRelationClient) Use(hooks ...Hook) {
-	c.hooks.ProductAttrRelation = append(c.hooks.ProductAttrRelation, hooks...)
+// A call to `Use(f, g, h)` equals to `producttag.Hooks(f(g(h())))`.
+func (c *ProductTagClient) Use(hooks ...Hook) {
+	c.hooks.ProductTag = append(c.hooks.ProductTag, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `productattrrelation.Intercept(f(g(h())))`.
-func (c *ProductAttrRelationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProductAttrRelation = append(c.inters.ProductAttrRelation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `producttag.Intercept(f(g(h())))`.
+func (c *ProductTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductTag = append(c.inters.ProductTag, interceptors...)
 }
 
-// Create returns a builder for creating a ProductAttrRelation entity.
-func (c *ProductAttrRelationClient) Create() *ProductAttrRelationCreate {
-	mutation := newProductAttrRelationMutation(c.config, OpCreate)
-	return &ProductAttrRelationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProductTag entity.
+func (c *ProductTagClient) Create() *ProductTagCreate {
+	mutation := newProductTagMutation(c.config, OpCreate)
+	return &ProductTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProductAttrRelation entities.
-func (c *ProductAttrRelationClient) CreateBulk(builders ...*ProductAttrRelationCreate) *ProductAttrRelationCreateBulk {
-	return &ProductAttrRelationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProductTag entities.
+func (c *ProductTagClient) CreateBulk(builders ...*ProductTagCreate) *ProductTagCreateBulk {
+	return &ProductTagCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductAttrRelationClient) MapCreateBulk(slice any, setFunc func(*ProductAttrRelationCreate, int)) *ProductAttrRelationCreateBulk {
+func (c *ProductTagClient) MapCreateBulk(slice any, setFunc func(*ProductTagCreate, int)) *ProductTagCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductAttrRelationCreateBulk{err: fmt.Errorf("calling to ProductAttrRelationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProductTagCreateBulk{err: fmt.Errorf("calling to ProductTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductAttrRelationCreate, rv.Len())
+	builders := make([]*ProductTagCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductAttrRelationCreateBulk{config: c.config, builders: builders}
+	return &ProductTagCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProductAttrRelation.
-func (c *ProductAttrRelationClient) Update() *ProductAttrRelationUpdate {
-	mutation := newProductAttrRelationMutation(c.config, OpUpdate)
-	return &ProductAttrRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProductTag.
+func (c *ProductTagClient) Update() *ProductTagUpdate {
+	mutation := newProductTagMutation(c.config, OpUpdate)
+	return &ProductTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductAttrRelationClient) UpdateOne(par *ProductAttrRelation) *ProductAttrRelationUpdateOne {
-	mutation := newProductAttrRelationMutation(c.config, OpUpdateOne, withProductAttrRelation(par))
-	return &ProductAttrRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductTagClient) UpdateOne(pt *ProductTag) *ProductTagUpdateOne {
+	mutation := newProductTagMutation(c.config, OpUpdateOne, withProductTag(pt))
+	return &ProductTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductAttrRelationClient) UpdateOneID(id uuid.UUID) *ProductAttrRelationUpdateOne {
-	mutation := newProductAttrRelationMutation(c.config, OpUpdateOne, withProductAttrRelationID(id))
-	return &ProductAttrRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductTagClient) UpdateOneID(id uuid.UUID) *ProductTagUpdateOne {
+	mutation := newProductTagMutation(c.config, OpUpdateOne, withProductTagID(id))
+	return &ProductTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProductAttrRelation.
-func (c *ProductAttrRelationClient) Delete() *ProductAttrRelationDelete {
-	mutation := newProductAttrRelationMutation(c.config, OpDelete)
-	return &ProductAttrRelationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProductTag.
+func (c *ProductTagClient) Delete() *ProductTagDelete {
+	mutation := newProductTagMutation(c.config, OpDelete)
+	return &ProductTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductAttrRelationClient) DeleteOne(par *ProductAttrRelation) *ProductAttrRelationDeleteOne {
-	return c.DeleteOneID(par.ID)
+func (c *ProductTagClient) DeleteOne(pt *ProductTag) *ProductTagDeleteOne {
+	return c.DeleteOneID(pt.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductAttrRelationClient) DeleteOneID(id uuid.UUID) *ProductAttrRelationDeleteOne {
-	builder := c.Delete().Where(productattrrelation.ID(id))
+func (c *ProductTagClient) DeleteOneID(id uuid.UUID) *ProductTagDeleteOne {
+	builder := c.Delete().Where(producttag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductAttrRelationDeleteOne{builder}
+	return &ProductTagDeleteOne{builder}
 }
 
-// Query returns a query builder for ProductAttrRelation.
-func (c *ProductAttrRelationClient) Query() *ProductAttrRelationQuery {
-	return &ProductAttrRelationQuery{
+// Query returns a query builder for ProductTag.
+func (c *ProductTagClient) Query() *ProductTagQuery {
+	return &ProductTagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProductAttrRelation},
+		ctx:    &QueryContext{Type: TypeProductTag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProductAttrRelation entity by its id.
-func (c *ProductAttrRelationClient) Get(ctx context.Context, id uuid.UUID) (*ProductAttrRelation, error) {
-	return c.Query().Where(productattrrelation.ID(id)).Only(ctx)
+// Get returns a ProductTag entity by its id.
+func (c *ProductTagClient) Get(ctx context.Context, id uuid.UUID) (*ProductTag, error) {
+	return c.Query().Where(producttag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProductAttrRelationClient) GetX(ctx context.Context, id uuid.UUID) *ProductAttrRelation {
+func (c *ProductTagClient) GetX(ctx context.Context, id uuid.UUID) *ProductTag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4224,182 +7967,150 @@ func (c *ProductAttrRelationClient) GetX(ctx context.Context, id uuid.UUID) *Pro
 	return obj
 }
 
-// QueryProduct queries the product edge of a ProductAttrRelation.
-func (c *ProductAttrRelationClient) QueryProduct(par *ProductAttrRelation) *ProductQuery {
+// QueryProducts queries the products edge of a ProductTag.
+func (c *ProductTagClient) QueryProducts(pt *ProductTag) *ProductQuery {
 	query := (&ProductClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := par.ID
+		id := pt.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(productattrrelation.Table, productattrrelation.FieldID, id),
+			sqlgraph.From(producttag.Table, producttag.FieldID, id),
 			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, productattrrelation.ProductTable, productattrrelation.ProductColumn),
-		)
-		fromV = sqlgraph.Neighbors(par.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAttr queries the attr edge of a ProductAttrRelation.
-func (c *ProductAttrRelationClient) QueryAttr(par *ProductAttrRelation) *ProductAttrQuery {
-	query := (&ProductAttrClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := par.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(productattrrelation.Table, productattrrelation.FieldID, id),
-			sqlgraph.To(productattr.Table, productattr.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, productattrrelation.AttrTable, productattrrelation.AttrColumn),
-		)
-		fromV = sqlgraph.Neighbors(par.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAttrItem queries the attr_item edge of a ProductAttrRelation.
-func (c *ProductAttrRelationClient) QueryAttrItem(par *ProductAttrRelation) *ProductAttrItemQuery {
-	query := (&ProductAttrItemClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := par.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(productattrrelation.Table, productattrrelation.FieldID, id),
-			sqlgraph.To(productattritem.Table, productattritem.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, productattrrelation.AttrItemTable, productattrrelation.AttrItemColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, producttag.ProductsTable, producttag.ProductsPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(par.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProductAttrRelationClient) Hooks() []Hook {
-	hooks := c.hooks.ProductAttrRelation
-	return append(hooks[:len(hooks):len(hooks)], productattrrelation.Hooks[:]...)
+func (c *ProductTagClient) Hooks() []Hook {
+	hooks := c.hooks.ProductTag
+	return append(hooks[:len(hooks):len(hooks)], producttag.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductAttrRelationClient) Interceptors() []Interceptor {
-	inters := c.inters.ProductAttrRelation
-	return append(inters[:len(inters):len(inters)], productattrrelation.Interceptors[:]...)
+func (c *ProductTagClient) Interceptors() []Interceptor {
+	inters := c.inters.ProductTag
+	return append(inters[:len(inters):len(inters)], producttag.Interceptors[:]...)
 }
 
-func (c *ProductAttrRelationClient) mutate(ctx context.Context, m *ProductAttrRelationMutation) (Value, error) {
+func (c *ProductTagClient) mutate(ctx context.Context, m *ProductTagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductAttrRelationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductAttrRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductAttrRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductAttrRelationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProductTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProductAttrRelation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProductTag mutation op: %q", m.Op())
 	}
 }
 
-// ProductSpecClient is a client for the ProductSpec schema.
-type ProductSpecClient struct {
+// ProductUnitClient is a client for the ProductUnit schema.
+type ProductUnitClient struct {
 	config
 }
 
-// NewProductSpecClient returns a client for the ProductSpec from the given config.
-func NewProductSpecClient(c config) *ProductSpecClient {
-	return &ProductSpecClient{config: c}
+// NewProductUnitClient returns a client for the ProductUnit from the given config.
+func NewProductUnitClient(c config) *ProductUnitClient {
+	return &ProductUnitClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `productspec.Hooks(f(g(h())))`.
-func (c *ProductSpecClient) Use(hooks ...Hook) {
-	c.hooks.ProductSpec = append(c.hooks.ProductSpec, hooks...)
+// A call to `Use(f, g, h)` equals to `productunit.Hooks(f(g(h())))`.
+func (c *ProductUnitClient) Use(hooks ...Hook) {
+	c.hooks.ProductUnit = append(c.hooks.ProductUnit, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `productspec.Intercept(f(g(h())))`.
-func (c *ProductSpecClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProductSpec = append(c.inters.ProductSpec, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `productunit.Intercept(f(g(h())))`.
+func (c *ProductUnitClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductUnit = append(c.inters.ProductUnit, interceptors...)
 }
 
-// Create returns a builder for creating a ProductSpec entity.
-func (c *ProductSpecClient) Create() *ProductSpecCreate {
-	mutation := newProductSpecMutation(c.config, OpCreate)
-	return &ProductSpecCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProductUnit entity.
+func (c *ProductUnitClient) Create() *ProductUnitCreate {
+	mutation := newProductUnitMutation(c.config, OpCreate)
+	return &ProductUnitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProductSpec entities.
-func (c *ProductSpecClient) CreateBulk(builders ...*ProductSpecCreate) *ProductSpecCreateBulk {
-	return &ProductSpecCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProductUnit entities.
+func (c *ProductUnitClient) CreateBulk(builders ...*ProductUnitCreate) *ProductUnitCreateBulk {
+	return &ProductUnitCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductSpecClient) MapCreateBulk(slice any, setFunc func(*ProductSpecCreate, int)) *ProductSpecCreateBulk {
+func (c *ProductUnitClient) MapCreateBulk(slice any, setFunc func(*ProductUnitCreate, int)) *ProductUnitCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductSpecCreateBulk{err: fmt.Errorf("calling to ProductSpecClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProductUnitCreateBulk{err: fmt.Errorf("calling to ProductUnitClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductSpecCreate, rv.Len())
+	builders := make([]*ProductUnitCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductSpecCreateBulk{config: c.config, builders: builders}
+	return &ProductUnitCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProductSpec.
-func (c *ProductSpecClient) Update() *ProductSpecUpdate {
-	mutation := newProductSpecMutation(c.config, OpUpdate)
-	return &ProductSpecUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProductUnit.
+func (c *ProductUnitClient) Update() *ProductUnitUpdate {
+	mutation := newProductUnitMutation(c.config, OpUpdate)
+	return &ProductUnitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductSpecClient) UpdateOne(ps *ProductSpec) *ProductSpecUpdateOne {
-	mutation := newProductSpecMutation(c.config, OpUpdateOne, withProductSpec(ps))
-	return &ProductSpecUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductUnitClient) UpdateOne(pu *ProductUnit) *ProductUnitUpdateOne {
+	mutation := newProductUnitMutation(c.config, OpUpdateOne, withProductUnit(pu))
+	return &ProductUnitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductSpecClient) UpdateOneID(id uuid.UUID) *ProductSpecUpdateOne {
-	mutation := newProductSpecMutation(c.config, OpUpdateOne, withProductSpecID(id))
-	return &ProductSpecUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductUnitClient) UpdateOneID(id uuid.UUID) *ProductUnitUpdateOne {
+	mutation := newProductUnitMutation(c.config, OpUpdateOne, withProductUnitID(id))
+	return &ProductUnitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProductSpec.
-func (c *ProductSpecClient) Delete() *ProductSpecDelete {
-	mutation := newProductSpecMutation(c.config, OpDelete)
-	return &ProductSpecDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProductUnit.
+func (c *ProductUnitClient) Delete() *ProductUnitDelete {
+	mutation := newProductUnitMutation(c.config, OpDelete)
+	return &ProductUnitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductSpecClient) DeleteOne(ps *ProductSpec) *ProductSpecDeleteOne {
-	return c.DeleteOneID(ps.ID)
+func (c *ProductUnitClient) DeleteOne(pu *ProductUnit) *ProductUnitDeleteOne {
+	return c.DeleteOneID(pu.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductSpecClient) DeleteOneID(id uuid.UUID) *ProductSpecDeleteOne {
-	builder := c.Delete().Where(productspec.ID(id))
+func (c *ProductUnitClient) DeleteOneID(id uuid.UUID) *ProductUnitDeleteOne {
+	builder := c.Delete().Where(productunit.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductSpecDeleteOne{builder}
+	return &ProductUnitDeleteOne{builder}
 }
 
-// Query returns a query builder for ProductSpec.
-func (c *ProductSpecClient) Query() *ProductSpecQuery {
-	return &ProductSpecQuery{
+// Query returns a query builder for ProductUnit.
+func (c *ProductUnitClient) Query() *ProductUnitQuery {
+	return &ProductUnitQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProductSpec},
+		ctx:    &QueryContext{Type: TypeProductUnit},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProductSpec entity by its id.
-func (c *ProductSpecClient) Get(ctx context.Context, id uuid.UUID) (*ProductSpec, error) {
-	return c.Query().Where(productspec.ID(id)).Only(ctx)
+// Get returns a ProductUnit entity by its id.
+func (c *ProductUnitClient) Get(ctx context.Context, id uuid.UUID) (*ProductUnit, error) {
+	return c.Query().Where(productunit.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProductSpecClient) GetX(ctx context.Context, id uuid.UUID) *ProductSpec {
+func (c *ProductUnitClient) GetX(ctx context.Context, id uuid.UUID) *ProductUnit {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4407,150 +8118,150 @@ func (c *ProductSpecClient) GetX(ctx context.Context, id uuid.UUID) *ProductSpec
 	return obj
 }
 
-// QueryProductSpecs queries the product_specs edge of a ProductSpec.
-func (c *ProductSpecClient) QueryProductSpecs(ps *ProductSpec) *ProductSpecRelationQuery {
-	query := (&ProductSpecRelationClient{config: c.config}).Query()
+// QueryProducts queries the products edge of a ProductUnit.
+func (c *ProductUnitClient) QueryProducts(pu *ProductUnit) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ps.ID
+		id := pu.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(productspec.Table, productspec.FieldID, id),
-			sqlgraph.To(productspecrelation.Table, productspecrelation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, productspec.ProductSpecsTable, productspec.ProductSpecsColumn),
+			sqlgraph.From(productunit.Table, productunit.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, productunit.ProductsTable, productunit.ProductsColumn),
 		)
-		fromV = sqlgraph.Neighbors(ps.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pu.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProductSpecClient) Hooks() []Hook {
-	hooks := c.hooks.ProductSpec
-	return append(hooks[:len(hooks):len(hooks)], productspec.Hooks[:]...)
+func (c *ProductUnitClient) Hooks() []Hook {
+	hooks := c.hooks.ProductUnit
+	return append(hooks[:len(hooks):len(hooks)], productunit.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductSpecClient) Interceptors() []Interceptor {
-	inters := c.inters.ProductSpec
-	return append(inters[:len(inters):len(inters)], productspec.Interceptors[:]...)
+func (c *ProductUnitClient) Interceptors() []Interceptor {
+	inters := c.inters.ProductUnit
+	return append(inters[:len(inters):len(inters)], productunit.Interceptors[:]...)
 }
 
-func (c *ProductSpecClient) mutate(ctx context.Context, m *ProductSpecMutation) (Value, error) {
+func (c *ProductUnitClient) mutate(ctx context.Context, m *ProductUnitMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductSpecCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductUnitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductSpecUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductUnitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductSpecUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductUnitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductSpecDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProductUnitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProductSpec mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProductUnit mutation op: %q", m.Op())
 	}
 }
 
-// ProductSpecRelationClient is a client for the ProductSpecRelation schema.
-type ProductSpecRelationClient struct {
+// ProductVersionClient is a client for the ProductVersion schema.
+type ProductVersionClient struct {
 	config
 }
 
-// NewProductSpecRelationClient returns a client for the ProductSpecRelation from the given config.
-func NewProductSpecRelationClient(c config) *ProductSpecRelationClient {
-	return &ProductSpecRelationClient{config: c}
+// NewProductVersionClient returns a client for the ProductVersion from the given config.
+func NewProductVersionClient(c config) *ProductVersionClient {
+	return &ProductVersionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `productspecrelation.Hooks(f(g(h())))`.
-func (c *ProductSpecRelationClient) Use(hooks ...Hook) {
-	c.hooks.ProductSpecRelation = append(c.hooks.ProductSpecRelation, hooks...)
+// A call to `Use(f, g, h)` equals to `productversion.Hooks(f(g(h())))`.
+func (c *ProductVersionClient) Use(hooks ...Hook) {
+	c.hooks.ProductVersion = append(c.hooks.ProductVersion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `productspecrelation.Intercept(f(g(h())))`.
-func (c *ProductSpecRelationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProductSpecRelation = append(c.inters.ProductSpecRelation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `productversion.Intercept(f(g(h())))`.
+func (c *ProductVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductVersion = append(c.inters.ProductVersion, interceptors...)
 }
 
-// Create returns a builder for creating a ProductSpecRelation entity.
-func (c *ProductSpecRelationClient) Create() *ProductSpecRelationCreate {
-	mutation := newProductSpecRelationMutation(c.config, OpCreate)
-	return &ProductSpecRelationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProductVersion entity.
+func (c *ProductVersionClient) Create() *ProductVersionCreate {
+	mutation := newProductVersionMutation(c.config, OpCreate)
+	return &ProductVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProductSpecRelation entities.
-func (c *ProductSpecRelationClient) CreateBulk(builders ...*ProductSpecRelationCreate) *ProductSpecRelationCreateBulk {
-	return &ProductSpecRelationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProductVersion entities.
+func (c *ProductVersionClient) CreateBulk(builders ...*ProductVersionCreate) *ProductVersionCreateBulk {
+	return &ProductVersionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductSpecRelationClient) MapCreateBulk(slice any, setFunc func(*ProductSpecRelationCreate, int)) *ProductSpecRelationCreateBulk {
+func (c *ProductVersionClient) MapCreateBulk(slice any, setFunc func(*ProductVersionCreate, int)) *ProductVersionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductSpecRelationCreateBulk{err: fmt.Errorf("calling to ProductSpecRelationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProductVersionCreateBulk{err: fmt.Errorf("calling to ProductVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductSpecRelationCreate, rv.Len())
+	builders := make([]*ProductVersionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductSpecRelationCreateBulk{config: c.config, builders: builders}
+	return &ProductVersionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProductSpecRelation.
-func (c *ProductSpecRelationClient) Update() *ProductSpecRelationUpdate {
-	mutation := newProductSpecRelationMutation(c.config, OpUpdate)
-	return &ProductSpecRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProductVersion.
+func (c *ProductVersionClient) Update() *ProductVersionUpdate {
+	mutation := newProductVersionMutation(c.config, OpUpdate)
+	return &ProductVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductSpecRelationClient) UpdateOne(psr *ProductSpecRelation) *ProductSpecRelationUpdateOne {
-	mutation := newProductSpecRelationMutation(c.config, OpUpdateOne, withProductSpecRelation(psr))
-	return &ProductSpecRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductVersionClient) UpdateOne(pv *ProductVersion) *ProductVersionUpdateOne {
+	mutation := newProductVersionMutation(c.config, OpUpdateOne, withProductVersion(pv))
+	return &ProductVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductSpecRelationClient) UpdateOneID(id uuid.UUID) *ProductSpecRelationUpdateOne {
-	mutation := newProductSpecRelationMutation(c.config, OpUpdateOne, withProductSpecRelationID(id))
-	return &ProductSpecRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductVersionClient) UpdateOneID(id uuid.UUID) *ProductVersionUpdateOne {
+	mutation := newProductVersionMutation(c.config, OpUpdateOne, withProductVersionID(id))
+	return &ProductVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProductSpecRelation.
-func (c *ProductSpecRelationClient) Delete() *ProductSpecRelationDelete {
-	mutation := newProductSpecRelationMutation(c.config, OpDelete)
-	return &ProductSpecRelationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProductVersion.
+func (c *ProductVersionClient) Delete() *ProductVersionDelete {
+	mutation := newProductVersionMutation(c.config, OpDelete)
+	return &ProductVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductSpecRelationClient) DeleteOne(psr *ProductSpecRelation) *ProductSpecRelationDeleteOne {
-	return c.DeleteOneID(psr.ID)
+func (c *ProductVersionClient) DeleteOne(pv *ProductVersion) *ProductVersionDeleteOne {
+	return c.DeleteOneID(pv.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductSpecRelationClient) DeleteOneID(id uuid.UUID) *ProductSpecRelationDeleteOne {
-	builder := c.Delete().Where(productspecrelation.ID(id))
+func (c *ProductVersionClient) DeleteOneID(id uuid.UUID) *ProductVersionDeleteOne {
+	builder := c.Delete().Where(productversion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductSpecRelationDeleteOne{builder}
+	return &ProductVersionDeleteOne{builder}
 }
 
-// Query returns a query builder for ProductSpecRelation.
-func (c *ProductSpecRelationClient) Query() *ProductSpecRelationQuery {
-	return &ProductSpecRelationQuery{
+// Query returns a query builder for ProductVersion.
+func (c *ProductVersionClient) Query() *ProductVersionQuery {
+	return &ProductVersionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProductSpecRelation},
+		ctx:    &QueryContext{Type: TypeProductVersion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProductSpecRelation entity by its id.
-func (c *ProductSpecRelationClient) Get(ctx context.Context, id uuid.UUID) (*ProductSpecRelation, error) {
-	return c.Query().Where(productspecrelation.ID(id)).Only(ctx)
+// Get returns a ProductVersion entity by its id.
+func (c *ProductVersionClient) Get(ctx context.Context, id uuid.UUID) (*ProductVersion, error) {
+	return c.Query().Where(productversion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProductSpecRelationClient) GetX(ctx context.Context, id uuid.UUID) *ProductSpecRelation {
+func (c *ProductVersionClient) GetX(ctx context.Context, id uuid.UUID) *ProductVersion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4558,182 +8269,132 @@ func (c *ProductSpecRelationClient) GetX(ctx context.Context, id uuid.UUID) *Pro
 	return obj
 }
 
-// QueryProduct queries the product edge of a ProductSpecRelation.
-func (c *ProductSpecRelationClient) QueryProduct(psr *ProductSpecRelation) *ProductQuery {
-	query := (&ProductClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := psr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(productspecrelation.Table, productspecrelation.FieldID, id),
-			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, productspecrelation.ProductTable, productspecrelation.ProductColumn),
-		)
-		fromV = sqlgraph.Neighbors(psr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QuerySpec queries the spec edge of a ProductSpecRelation.
-func (c *ProductSpecRelationClient) QuerySpec(psr *ProductSpecRelation) *ProductSpecQuery {
-	query := (&ProductSpecClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := psr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(productspecrelation.Table, productspecrelation.FieldID, id),
-			sqlgraph.To(productspec.Table, productspec.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, productspecrelation.SpecTable, productspecrelation.SpecColumn),
-		)
-		fromV = sqlgraph.Neighbors(psr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPackingFee queries the packing_fee edge of a ProductSpecRelation.
-func (c *ProductSpecRelationClient) QueryPackingFee(psr *ProductSpecRelation) *AdditionalFeeQuery {
-	query := (&AdditionalFeeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := psr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(productspecrelation.Table, productspecrelation.FieldID, id),
-			sqlgraph.To(additionalfee.Table, additionalfee.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, productspecrelation.PackingFeeTable, productspecrelation.PackingFeeColumn),
-		)
-		fromV = sqlgraph.Neighbors(psr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ProductSpecRelationClient) Hooks() []Hook {
-	hooks := c.hooks.ProductSpecRelation
-	return append(hooks[:len(hooks):len(hooks)], productspecrelation.Hooks[:]...)
+func (c *ProductVersionClient) Hooks() []Hook {
+	return c.hooks.ProductVersion
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductSpecRelationClient) Interceptors() []Interceptor {
-	inters := c.inters.ProductSpecRelation
-	return append(inters[:len(inters):len(inters)], productspecrelation.Interceptors[:]...)
+func (c *ProductVersionClient) Interceptors() []Interceptor {
+	return c.inters.ProductVersion
 }
 
-func (c *ProductSpecRelationClient) mutate(ctx context.Context, m *ProductSpecRelationMutation) (Value, error) {
+func (c *ProductVersionClient) mutate(ctx context.Context, m *ProductVersionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductSpecRelationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductSpecRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductSpecRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductSpecRelationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProductVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProductSpecRelation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProductVersion mutation op: %q", m.Op())
 	}
 }
 
-// ProductTagClient is a client for the ProductTag schema.
-type ProductTagClient struct {
+// ProfitDistributionBillClient is a client for the ProfitDistributionBill schema.
+type ProfitDistributionBillClient struct {
 	config
 }
 
-// NewProductTagClient returns a client for the ProductTag from the given config.
-func NewProductTagClient(c config) *ProductTagClient {
-	return &ProductTagClient{config: c}
+// NewProfitDistributionBillClient returns a client for the ProfitDistributionBill from the given config.
+func NewProfitDistributionBillClient(c config) *ProfitDistributionBillClient {
+	return &ProfitDistributionBillClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `producttag.Hooks(f(g(h())))`.
-func (c *ProductTagClient) Use(hooks ...Hook) {
-	c.hooks.ProductTag = append(c.hooks.ProductTag, hooks...)
+// A call to `Use(f, g, h)` equals to `profitdistributionbill.Hooks(f(g(h())))`.
+func (c *ProfitDistributionBillClient) Use(hooks ...Hook) {
+	c.hooks.ProfitDistributionBill = append(c.hooks.ProfitDistributionBill, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `producttag.Intercept(f(g(h())))`.
-func (c *ProductTagClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProductTag = append(c.inters.ProductTag, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `profitdistributionbill.Intercept(f(g(h())))`.
+func (c *ProfitDistributionBillClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProfitDistributionBill = append(c.inters.ProfitDistributionBill, interceptors...)
 }
 
-// Create returns a builder for creating a ProductTag entity.
-func (c *ProductTagClient) Create() *ProductTagCreate {
-	mutation := newProductTagMutation(c.config, OpCreate)
-	return &ProductTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProfitDistributionBill entity.
+func (c *ProfitDistributionBillClient) Create() *ProfitDistributionBillCreate {
+	mutation := newProfitDistributionBillMutation(c.config, OpCreate)
+	return &ProfitDistributionBillCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProductTag entities.
-func (c *ProductTagClient) CreateBulk(builders ...*ProductTagCreate) *ProductTagCreateBulk {
-	return &ProductTagCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProfitDistributionBill entities.
+func (c *ProfitDistributionBillClient) CreateBulk(builders ...*ProfitDistributionBillCreate) *ProfitDistributionBillCreateBulk {
+	return &ProfitDistributionBillCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductTagClient) MapCreateBulk(slice any, setFunc func(*ProductTagCreate, int)) *ProductTagCreateBulk {
+func (c *ProfitDistributionBillClient) MapCreateBulk(slice any, setFunc func(*ProfitDistributionBillCreate, int)) *ProfitDistributionBillCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductTagCreateBulk{err: fmt.Errorf("calling to ProductTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProfitDistributionBillCreateBulk{err: fmt.Errorf("calling to ProfitDistributionBillClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductTagCreate, rv.Len())
+	builders := make([]*ProfitDistributionBillCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductTagCreateBulk{config: c.config, builders: builders}
+	return &ProfitDistributionBillCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProductTag.
-func (c *ProductTagClient) Update() *ProductTagUpdate {
-	mutation := newProductTagMutation(c.config, OpUpdate)
-	return &ProductTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProfitDistributionBill.
+func (c *ProfitDistributionBillClient) Update() *ProfitDistributionBillUpdate {
+	mutation := newProfitDistributionBillMutation(c.config, OpUpdate)
+	return &ProfitDistributionBillUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductTagClient) UpdateOne(pt *ProductTag) *ProductTagUpdateOne {
-	mutation := newProductTagMutation(c.config, OpUpdateOne, withProductTag(pt))
-	return &ProductTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProfitDistributionBillClient) UpdateOne(pdb *ProfitDistributionBill) *ProfitDistributionBillUpdateOne {
+	mutation := newProfitDistributionBillMutation(c.config, OpUpdateOne, withProfitDistributionBill(pdb))
+	return &ProfitDistributionBillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductTagClient) UpdateOneID(id uuid.UUID) *ProductTagUpdateOne {
-	mutation := newProductTagMutation(c.config, OpUpdateOne, withProductTagID(id))
-	return &ProductTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProfitDistributionBillClient) UpdateOneID(id uuid.UUID) *ProfitDistributionBillUpdateOne {
+	mutation := newProfitDistributionBillMutation(c.config, OpUpdateOne, withProfitDistributionBillID(id))
+	return &ProfitDistributionBillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProductTag.
-func (c *ProductTagClient) Delete() *ProductTagDelete {
-	mutation := newProductTagMutation(c.config, OpDelete)
-	return &ProductTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProfitDistributionBill.
+func (c *ProfitDistributionBillClient) Delete() *ProfitDistributionBillDelete {
+	mutation := newProfitDistributionBillMutation(c.config, OpDelete)
+	return &ProfitDistributionBillDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductTagClient) DeleteOne(pt *ProductTag) *ProductTagDeleteOne {
-	return c.DeleteOneID(pt.ID)
+func (c *ProfitDistributionBillClient) DeleteOne(pdb *ProfitDistributionBill) *ProfitDistributionBillDeleteOne {
+	return c.DeleteOneID(pdb.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductTagClient) DeleteOneID(id uuid.UUID) *ProductTagDeleteOne {
-	builder := c.Delete().Where(producttag.ID(id))
+func (c *ProfitDistributionBillClient) DeleteOneID(id uuid.UUID) *ProfitDistributionBillDeleteOne {
+	builder := c.Delete().Where(profitdistributionbill.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductTagDeleteOne{builder}
+	return &ProfitDistributionBillDeleteOne{builder}
 }
 
-// Query returns a query builder for ProductTag.
-func (c *ProductTagClient) Query() *ProductTagQuery {
-	return &ProductTagQuery{
+// Query returns a query builder for ProfitDistributionBill.
+func (c *ProfitDistributionBillClient) Query() *ProfitDistributionBillQuery {
+	return &ProfitDistributionBillQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProductTag},
+		ctx:    &QueryContext{Type: TypeProfitDistributionBill},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProductTag entity by its id.
-func (c *ProductTagClient) Get(ctx context.Context, id uuid.UUID) (*ProductTag, error) {
-	return c.Query().Where(producttag.ID(id)).Only(ctx)
+// Get returns a ProfitDistributionBill entity by its id.
+func (c *ProfitDistributionBillClient) Get(ctx context.Context, id uuid.UUID) (*ProfitDistributionBill, error) {
+	return c.Query().Where(profitdistributionbill.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProductTagClient) GetX(ctx context.Context, id uuid.UUID) *ProductTag {
+func (c *ProfitDistributionBillClient) GetX(ctx context.Context, id uuid.UUID) *ProfitDistributionBill {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4741,150 +8402,166 @@ func (c *ProductTagClient) GetX(ctx context.Context, id uuid.UUID) *ProductTag {
 	return obj
 }
 
-// QueryProducts queries the products edge of a ProductTag.
-func (c *ProductTagClient) QueryProducts(pt *ProductTag) *ProductQuery {
-	query := (&ProductClient{config: c.config}).Query()
+// QueryMerchant queries the merchant edge of a ProfitDistributionBill.
+func (c *ProfitDistributionBillClient) QueryMerchant(pdb *ProfitDistributionBill) *MerchantQuery {
+	query := (&MerchantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pdb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profitdistributionbill.Table, profitdistributionbill.FieldID, id),
+			sqlgraph.To(merchant.Table, merchant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profitdistributionbill.MerchantTable, profitdistributionbill.MerchantColumn),
+		)
+		fromV = sqlgraph.Neighbors(pdb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStore queries the store edge of a ProfitDistributionBill.
+func (c *ProfitDistributionBillClient) QueryStore(pdb *ProfitDistributionBill) *StoreQuery {
+	query := (&StoreClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pt.ID
+		id := pdb.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(producttag.Table, producttag.FieldID, id),
-			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, producttag.ProductsTable, producttag.ProductsPrimaryKey...),
+			sqlgraph.From(profitdistributionbill.Table, profitdistributionbill.FieldID, id),
+			sqlgraph.To(store.Table, store.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profitdistributionbill.StoreTable, profitdistributionbill.StoreColumn),
 		)
-		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pdb.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProductTagClient) Hooks() []Hook {
-	hooks := c.hooks.ProductTag
-	return append(hooks[:len(hooks):len(hooks)], producttag.Hooks[:]...)
+func (c *ProfitDistributionBillClient) Hooks() []Hook {
+	hooks := c.hooks.ProfitDistributionBill
+	return append(hooks[:len(hooks):len(hooks)], profitdistributionbill.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductTagClient) Interceptors() []Interceptor {
-	inters := c.inters.ProductTag
-	return append(inters[:len(inters):len(inters)], producttag.Interceptors[:]...)
+func (c *ProfitDistributionBillClient) Interceptors() []Interceptor {
+	inters := c.inters.ProfitDistributionBill
+	return append(inters[:len(inters):len(inters)], profitdistributionbill.Interceptors[:]...)
 }
 
-func (c *ProductTagClient) mutate(ctx context.Context, m *ProductTagMutation) (Value, error) {
+func (c *ProfitDistributionBillClient) mutate(ctx context.Context, m *ProfitDistributionBillMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfitDistributionBillCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfitDistributionBillUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfitDistributionBillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProfitDistributionBillDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProductTag mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProfitDistributionBill mutation op: %q", m.Op())
 	}
 }
 
-// ProductUnitClient is a client for the ProductUnit schema.
-type ProductUnitClient struct {
+// ProfitDistributionRuleClient is a client for the ProfitDistributionRule schema.
+type ProfitDistributionRuleClient struct {
 	config
 }
 
-// NewProductUnitClient returns a client for the ProductUnit from the given config.
-func NewProductUnitClient(c config) *ProductUnitClient {
-	return &ProductUnitClient{config: c}
+// NewProfitDistributionRuleClient returns a client for the ProfitDistributionRule from the given config.
+func NewProfitDistributionRuleClient(c config) *ProfitDistributionRuleClient {
+	return &ProfitDistributionRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `productunit.Hooks(f(g(h())))`.
-func (c *ProductUnitClient) Use(hooks ...Hook) {
-	c.hooks.ProductUnit = append(c.hooks.ProductUnit, hooks...)
+// A call to `Use(f, g, h)` equals to `profitdistributionrule.Hooks(f(g(h())))`.
+func (c *ProfitDistributionRuleClient) Use(hooks ...Hook) {
+	c.hooks.ProfitDistributionRule = append(c.hooks.ProfitDistributionRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `productunit.Intercept(f(g(h())))`.
-func (c *ProductUnitClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProductUnit = append(c.inters.ProductUnit, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `profitdistributionrule.Intercept(f(g(h())))`.
+func (c *ProfitDistributionRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProfitDistributionRule = append(c.inters.ProfitDistributionRule, interceptors...)
 }
 
-// Create returns a builder for creating a ProductUnit entity.
-func (c *ProductUnitClient) Create() *ProductUnitCreate {
-	mutation := newProductUnitMutation(c.config, OpCreate)
-	return &ProductUnitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProfitDistributionRule entity.
+func (c *ProfitDistributionRuleClient) Create() *ProfitDistributionRuleCreate {
+	mutation := newProfitDistributionRuleMutation(c.config, OpCreate)
+	return &ProfitDistributionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProductUnit entities.
-func (c *ProductUnitClient) CreateBulk(builders ...*ProductUnitCreate) *ProductUnitCreateBulk {
-	return &ProductUnitCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProfitDistributionRule entities.
+func (c *ProfitDistributionRuleClient) CreateBulk(builders ...*ProfitDistributionRuleCreate) *ProfitDistributionRuleCreateBulk {
+	return &ProfitDistributionRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductUnitClient) MapCreateBulk(slice any, setFunc func(*ProductUnitCreate, int)) *ProductUnitCreateBulk {
+func (c *ProfitDistributionRuleClient) MapCreateBulk(slice any, setFunc func(*ProfitDistributionRuleCreate, int)) *ProfitDistributionRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductUnitCreateBulk{err: fmt.Errorf("calling to ProductUnitClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProfitDistributionRuleCreateBulk{err: fmt.Errorf("calling to ProfitDistributionRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductUnitCreate, rv.Len())
+	builders := make([]*ProfitDistributionRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductUnitCreateBulk{config: c.config, builders: builders}
+	return &ProfitDistributionRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProductUnit.
-func (c *ProductUnitClient) Update() *ProductUnitUpdate {
-	mutation := newProductUnitMutation(c.config, OpUpdate)
-	return &ProductUnitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProfitDistributionRule.
+func (c *ProfitDistributionRuleClient) Update() *ProfitDistributionRuleUpdate {
+	mutation := newProfitDistributionRuleMutation(c.config, OpUpdate)
+	return &ProfitDistributionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductUnitClient) UpdateOne(pu *ProductUnit) *ProductUnitUpdateOne {
-	mutation := newProductUnitMutation(c.config, OpUpdateOne, withProductUnit(pu))
-	return &ProductUnitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProfitDistributionRuleClient) UpdateOne(pdr *ProfitDistributionRule) *ProfitDistributionRuleUpdateOne {
+	mutation := newProfitDistributionRuleMutation(c.config, OpUpdateOne, withProfitDistributionRule(pdr))
+	return &ProfitDistributionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductUnitClient) UpdateOneID(id uuid.UUID) *ProductUnitUpdateOne {
-	mutation := newProductUnitMutation(c.config, OpUpdateOne, withProductUnitID(id))
-	return &ProductUnitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProfitDistributionRuleClient) UpdateOneID(id uuid.UUID) *ProfitDistributionRuleUpdateOne {
+	mutation := newProfitDistributionRuleMutation(c.config, OpUpdateOne, withProfitDistributionRuleID(id))
+	return &ProfitDistributionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProductUnit.
-func (c *ProductUnitClient) Delete() *ProductUnitDelete {
-	mutation := newProductUnitMutation(c.config, OpDelete)
-	return &ProductUnitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProfitDistributionRule.
+func (c *ProfitDistributionRuleClient) Delete() *ProfitDistributionRuleDelete {
+	mutation := newProfitDistributionRuleMutation(c.config, OpDelete)
+	return &ProfitDistributionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductUnitClient) DeleteOne(pu *ProductUnit) *ProductUnitDeleteOne {
-	return c.DeleteOneID(pu.ID)
+func (c *ProfitDistributionRuleClient) DeleteOne(pdr *ProfitDistributionRule) *ProfitDistributionRuleDeleteOne {
+	return c.DeleteOneID(pdr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductUnitClient) DeleteOneID(id uuid.UUID) *ProductUnitDeleteOne {
-	builder := c.Delete().Where(productunit.ID(id))
+func (c *ProfitDistributionRuleClient) DeleteOneID(id uuid.UUID) *ProfitDistributionRuleDeleteOne {
+	builder := c.Delete().Where(profitdistributionrule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductUnitDeleteOne{builder}
+	return &ProfitDistributionRuleDeleteOne{builder}
 }
 
-// Query returns a query builder for ProductUnit.
-func (c *ProductUnitClient) Query() *ProductUnitQuery {
-	return &ProductUnitQuery{
+// Query returns a query builder for ProfitDistributionRule.
+func (c *ProfitDistributionRuleClient) Query() *ProfitDistributionRuleQuery {
+	return &ProfitDistributionRuleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProductUnit},
+		ctx:    &QueryContext{Type: TypeProfitDistributionRule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProductUnit entity by its id.
-func (c *ProductUnitClient) Get(ctx context.Context, id uuid.UUID) (*ProductUnit, error) {
-	return c.Query().Where(productunit.ID(id)).Only(ctx)
+// Get returns a ProfitDistributionRule entity by its id.
+func (c *ProfitDistributionRuleClient) Get(ctx context.Context, id uuid.UUID) (*ProfitDistributionRule, error) {
+	return c.Query().Where(profitdistributionrule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProductUnitClient) GetX(ctx context.Context, id uuid.UUID) *ProductUnit {
+func (c *ProfitDistributionRuleClient) GetX(ctx context.Context, id uuid.UUID) *ProfitDistributionRule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4892,150 +8569,150 @@ func (c *ProductUnitClient) GetX(ctx context.Context, id uuid.UUID) *ProductUnit
 	return obj
 }
 
-// QueryProducts queries the products edge of a ProductUnit.
-func (c *ProductUnitClient) QueryProducts(pu *ProductUnit) *ProductQuery {
-	query := (&ProductClient{config: c.config}).Query()
+// QueryStores queries the stores edge of a ProfitDistributionRule.
+func (c *ProfitDistributionRuleClient) QueryStores(pdr *ProfitDistributionRule) *StoreQuery {
+	query := (&StoreClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pu.ID
+		id := pdr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(productunit.Table, productunit.FieldID, id),
-			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, productunit.ProductsTable, productunit.ProductsColumn),
+			sqlgraph.From(profitdistributionrule.Table, profitdistributionrule.FieldID, id),
+			sqlgraph.To(store.Table, store.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, profitdistributionrule.StoresTable, profitdistributionrule.StoresPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(pu.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pdr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProductUnitClient) Hooks() []Hook {
-	hooks := c.hooks.ProductUnit
-	return append(hooks[:len(hooks):len(hooks)], productunit.Hooks[:]...)
+func (c *ProfitDistributionRuleClient) Hooks() []Hook {
+	hooks := c.hooks.ProfitDistributionRule
+	return append(hooks[:len(hooks):len(hooks)], profitdistributionrule.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductUnitClient) Interceptors() []Interceptor {
-	inters := c.inters.ProductUnit
-	return append(inters[:len(inters):len(inters)], productunit.Interceptors[:]...)
+func (c *ProfitDistributionRuleClient) Interceptors() []Interceptor {
+	inters := c.inters.ProfitDistributionRule
+	return append(inters[:len(inters):len(inters)], profitdistributionrule.Interceptors[:]...)
 }
 
-func (c *ProductUnitClient) mutate(ctx context.Context, m *ProductUnitMutation) (Value, error) {
+func (c *ProfitDistributionRuleClient) mutate(ctx context.Context, m *ProfitDistributionRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductUnitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfitDistributionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductUnitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfitDistributionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductUnitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfitDistributionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductUnitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProfitDistributionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProductUnit mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProfitDistributionRule mutation op: %q", m.Op())
 	}
 }
 
-// ProfitDistributionBillClient is a client for the ProfitDistributionBill schema.
-type ProfitDistributionBillClient struct {
+// PromotionClient is a client for the Promotion schema.
+type PromotionClient struct {
 	config
 }
 
-// NewProfitDistributionBillClient returns a client for the ProfitDistributionBill from the given config.
-func NewProfitDistributionBillClient(c config) *ProfitDistributionBillClient {
-	return &ProfitDistributionBillClient{config: c}
+// NewPromotionClient returns a client for the Promotion from the given config.
+func NewPromotionClient(c config) *PromotionClient {
+	return &PromotionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `profitdistributionbill.Hooks(f(g(h())))`.
-func (c *ProfitDistributionBillClient) Use(hooks ...Hook) {
-	c.hooks.ProfitDistributionBill = append(c.hooks.ProfitDistributionBill, hooks...)
+// A call to `Use(f, g, h)` equals to `promotion.Hooks(f(g(h())))`.
+func (c *PromotionClient) Use(hooks ...Hook) {
+	c.hooks.Promotion = append(c.hooks.Promotion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `profitdistributionbill.Intercept(f(g(h())))`.
-func (c *ProfitDistributionBillClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProfitDistributionBill = append(c.inters.ProfitDistributionBill, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `promotion.Intercept(f(g(h())))`.
+func (c *PromotionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Promotion = append(c.inters.Promotion, interceptors...)
 }
 
-// Create returns a builder for creating a ProfitDistributionBill entity.
-func (c *ProfitDistributionBillClient) Create() *ProfitDistributionBillCreate {
-	mutation := newProfitDistributionBillMutation(c.config, OpCreate)
-	return &ProfitDistributionBillCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Promotion entity.
+func (c *PromotionClient) Create() *PromotionCreate {
+	mutation := newPromotionMutation(c.config, OpCreate)
+	return &PromotionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProfitDistributionBill entities.
-func (c *ProfitDistributionBillClient) CreateBulk(builders ...*ProfitDistributionBillCreate) *ProfitDistributionBillCreateBulk {
-	return &ProfitDistributionBillCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Promotion entities.
+func (c *PromotionClient) CreateBulk(builders ...*PromotionCreate) *PromotionCreateBulk {
+	return &PromotionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProfitDistributionBillClient) MapCreateBulk(slice any, setFunc func(*ProfitDistributionBillCreate, int)) *ProfitDistributionBillCreateBulk {
+func (c *PromotionClient) MapCreateBulk(slice any, setFunc func(*PromotionCreate, int)) *PromotionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProfitDistributionBillCreateBulk{err: fmt.Errorf("calling to ProfitDistributionBillClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PromotionCreateBulk{err: fmt.Errorf("calling to PromotionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProfitDistributionBillCreate, rv.Len())
+	builders := make([]*PromotionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProfitDistributionBillCreateBulk{config: c.config, builders: builders}
+	return &PromotionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProfitDistributionBill.
-func (c *ProfitDistributionBillClient) Update() *ProfitDistributionBillUpdate {
-	mutation := newProfitDistributionBillMutation(c.config, OpUpdate)
-	return &ProfitDistributionBillUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Promotion.
+func (c *PromotionClient) Update() *PromotionUpdate {
+	mutation := newPromotionMutation(c.config, OpUpdate)
+	return &PromotionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProfitDistributionBillClient) UpdateOne(pdb *ProfitDistributionBill) *ProfitDistributionBillUpdateOne {
-	mutation := newProfitDistributionBillMutation(c.config, OpUpdateOne, withProfitDistributionBill(pdb))
-	return &ProfitDistributionBillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PromotionClient) UpdateOne(pr *Promotion) *PromotionUpdateOne {
+	mutation := newPromotionMutation(c.config, OpUpdateOne, withPromotion(pr))
+	return &PromotionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProfitDistributionBillClient) UpdateOneID(id uuid.UUID) *ProfitDistributionBillUpdateOne {
-	mutation := newProfitDistributionBillMutation(c.config, OpUpdateOne, withProfitDistributionBillID(id))
-	return &ProfitDistributionBillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PromotionClient) UpdateOneID(id uuid.UUID) *PromotionUpdateOne {
+	mutation := newPromotionMutation(c.config, OpUpdateOne, withPromotionID(id))
+	return &PromotionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProfitDistributionBill.
-func (c *ProfitDistributionBillClient) Delete() *ProfitDistributionBillDelete {
-	mutation := newProfitDistributionBillMutation(c.config, OpDelete)
-	return &ProfitDistributionBillDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Promotion.
+func (c *PromotionClient) Delete() *PromotionDelete {
+	mutation := newPromotionMutation(c.config, OpDelete)
+	return &PromotionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProfitDistributionBillClient) DeleteOne(pdb *ProfitDistributionBill) *ProfitDistributionBillDeleteOne {
-	return c.DeleteOneID(pdb.ID)
+func (c *PromotionClient) DeleteOne(pr *Promotion) *PromotionDeleteOne {
+	return c.DeleteOneID(pr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProfitDistributionBillClient) DeleteOneID(id uuid.UUID) *ProfitDistributionBillDeleteOne {
-	builder := c.Delete().Where(profitdistributionbill.ID(id))
+func (c *PromotionClient) DeleteOneID(id uuid.UUID) *PromotionDeleteOne {
+	builder := c.Delete().Where(promotion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProfitDistributionBillDeleteOne{builder}
+	return &PromotionDeleteOne{builder}
 }
 
-// Query returns a query builder for ProfitDistributionBill.
-func (c *ProfitDistributionBillClient) Query() *ProfitDistributionBillQuery {
-	return &ProfitDistributionBillQuery{
+// Query returns a query builder for Promotion.
+func (c *PromotionClient) Query() *PromotionQuery {
+	return &PromotionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProfitDistributionBill},
+		ctx:    &QueryContext{Type: TypePromotion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProfitDistributionBill entity by its id.
-func (c *ProfitDistributionBillClient) Get(ctx context.Context, id uuid.UUID) (*ProfitDistributionBill, error) {
-	return c.Query().Where(profitdistributionbill.ID(id)).Only(ctx)
+// Get returns a Promotion entity by its id.
+func (c *PromotionClient) Get(ctx context.Context, id uuid.UUID) (*Promotion, error) {
+	return c.Query().Where(promotion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProfitDistributionBillClient) GetX(ctx context.Context, id uuid.UUID) *ProfitDistributionBill {
+func (c *PromotionClient) GetX(ctx context.Context, id uuid.UUID) *Promotion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5043,166 +8720,134 @@ func (c *ProfitDistributionBillClient) GetX(ctx context.Context, id uuid.UUID) *
 	return obj
 }
 
-// QueryMerchant queries the merchant edge of a ProfitDistributionBill.
-func (c *ProfitDistributionBillClient) QueryMerchant(pdb *ProfitDistributionBill) *MerchantQuery {
-	query := (&MerchantClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pdb.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profitdistributionbill.Table, profitdistributionbill.FieldID, id),
-			sqlgraph.To(merchant.Table, merchant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, profitdistributionbill.MerchantTable, profitdistributionbill.MerchantColumn),
-		)
-		fromV = sqlgraph.Neighbors(pdb.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryStore queries the store edge of a ProfitDistributionBill.
-func (c *ProfitDistributionBillClient) QueryStore(pdb *ProfitDistributionBill) *StoreQuery {
-	query := (&StoreClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pdb.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profitdistributionbill.Table, profitdistributionbill.FieldID, id),
-			sqlgraph.To(store.Table, store.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, profitdistributionbill.StoreTable, profitdistributionbill.StoreColumn),
-		)
-		fromV = sqlgraph.Neighbors(pdb.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ProfitDistributionBillClient) Hooks() []Hook {
-	hooks := c.hooks.ProfitDistributionBill
-	return append(hooks[:len(hooks):len(hooks)], profitdistributionbill.Hooks[:]...)
+func (c *PromotionClient) Hooks() []Hook {
+	hooks := c.hooks.Promotion
+	return append(hooks[:len(hooks):len(hooks)], promotion.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProfitDistributionBillClient) Interceptors() []Interceptor {
-	inters := c.inters.ProfitDistributionBill
-	return append(inters[:len(inters):len(inters)], profitdistributionbill.Interceptors[:]...)
+func (c *PromotionClient) Interceptors() []Interceptor {
+	inters := c.inters.Promotion
+	return append(inters[:len(inters):len(inters)], promotion.Interceptors[:]...)
 }
 
-func (c *ProfitDistributionBillClient) mutate(ctx context.Context, m *ProfitDistributionBillMutation) (Value, error) {
+func (c *PromotionClient) mutate(ctx context.Context, m *PromotionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProfitDistributionBillCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PromotionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProfitDistributionBillUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PromotionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProfitDistributionBillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PromotionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProfitDistributionBillDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PromotionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProfitDistributionBill mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Promotion mutation op: %q", m.Op())
 	}
 }
 
-// ProfitDistributionRuleClient is a client for the ProfitDistributionRule schema.
-type ProfitDistributionRuleClient struct {
+// ReceiptRequestClient is a client for the ReceiptRequest schema.
+type ReceiptRequestClient struct {
 	config
 }
 
-// NewProfitDistributionRuleClient returns a client for the ProfitDistributionRule from the given config.
-func NewProfitDistributionRuleClient(c config) *ProfitDistributionRuleClient {
-	return &ProfitDistributionRuleClient{config: c}
+// NewReceiptRequestClient returns a client for the ReceiptRequest from the given config.
+func NewReceiptRequestClient(c config) *ReceiptRequestClient {
+	return &ReceiptRequestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `profitdistributionrule.Hooks(f(g(h())))`.
-func (c *ProfitDistributionRuleClient) Use(hooks ...Hook) {
-	c.hooks.ProfitDistributionRule = append(c.hooks.ProfitDistributionRule, hooks...)
+// A call to `Use(f, g, h)` equals to `receiptrequest.Hooks(f(g(h())))`.
+func (c *ReceiptRequestClient) Use(hooks ...Hook) {
+	c.hooks.ReceiptRequest = append(c.hooks.ReceiptRequest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `profitdistributionrule.Intercept(f(g(h())))`.
-func (c *ProfitDistributionRuleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProfitDistributionRule = append(c.inters.ProfitDistributionRule, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `receiptrequest.Intercept(f(g(h())))`.
+func (c *ReceiptRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReceiptRequest = append(c.inters.ReceiptRequest, interceptors...)
 }
 
-// Create returns a builder for creating a ProfitDistributionRule entity.
-func (c *ProfitDistributionRuleClient) Create() *ProfitDistributionRuleCreate {
-	mutation := newProfitDistributionRuleMutation(c.config, OpCreate)
-	return &ProfitDistributionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ReceiptRequest entity.
+func (c *ReceiptRequestClient) Create() *ReceiptRequestCreate {
+	mutation := newReceiptRequestMutation(c.config, OpCreate)
+	return &ReceiptRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProfitDistributionRule entities.
-func (c *ProfitDistributionRuleClient) CreateBulk(builders ...*ProfitDistributionRuleCreate) *ProfitDistributionRuleCreateBulk {
-	return &ProfitDistributionRuleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ReceiptRequest entities.
+func (c *ReceiptRequestClient) CreateBulk(builders ...*ReceiptRequestCreate) *ReceiptRequestCreateBulk {
+	return &ReceiptRequestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProfitDistributionRuleClient) MapCreateBulk(slice any, setFunc func(*ProfitDistributionRuleCreate, int)) *ProfitDistributionRuleCreateBulk {
+func (c *ReceiptRequestClient) MapCreateBulk(slice any, setFunc func(*ReceiptRequestCreate, int)) *ReceiptRequestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProfitDistributionRuleCreateBulk{err: fmt.Errorf("calling to ProfitDistributionRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReceiptRequestCreateBulk{err: fmt.Errorf("calling to ReceiptRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProfitDistributionRuleCreate, rv.Len())
+	builders := make([]*ReceiptRequestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProfitDistributionRuleCreateBulk{config: c.config, builders: builders}
+	return &ReceiptRequestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProfitDistributionRule.
-func (c *ProfitDistributionRuleClient) Update() *ProfitDistributionRuleUpdate {
-	mutation := newProfitDistributionRuleMutation(c.config, OpUpdate)
-	return &ProfitDistributionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ReceiptRequest.
+func (c *ReceiptRequestClient) Update() *ReceiptRequestUpdate {
+	mutation := newReceiptRequestMutation(c.config, OpUpdate)
+	return &ReceiptRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProfitDistributionRuleClient) UpdateOne(pdr *ProfitDistributionRule) *ProfitDistributionRuleUpdateOne {
-	mutation := newProfitDistributionRuleMutation(c.config, OpUpdateOne, withProfitDistributionRule(pdr))
-	return &ProfitDistributionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReceiptRequestClient) UpdateOne(rr *ReceiptRequest) *ReceiptRequestUpdateOne {
+	mutation := newReceiptRequestMutation(c.config, OpUpdateOne, withReceiptRequest(rr))
+	return &ReceiptRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProfitDistributionRuleClient) UpdateOneID(id uuid.UUID) *ProfitDistributionRuleUpdateOne {
-	mutation := newProfitDistributionRuleMutation(c.config, OpUpdateOne, withProfitDistributionRuleID(id))
-	return &ProfitDistributionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReceiptRequestClient) UpdateOneID(id uuid.UUID) *ReceiptRequestUpdateOne {
+	mutation := newReceiptRequestMutation(c.config, OpUpdateOne, withReceiptRequestID(id))
+	return &ReceiptRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProfitDistributionRule.
-func (c *ProfitDistributionRuleClient) Delete() *ProfitDistributionRuleDelete {
-	mutation := newProfitDistributionRuleMutation(c.config, OpDelete)
-	return &ProfitDistributionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ReceiptRequest.
+func (c *ReceiptRequestClient) Delete() *ReceiptRequestDelete {
+	mutation := newReceiptRequestMutation(c.config, OpDelete)
+	return &ReceiptRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProfitDistributionRuleClient) DeleteOne(pdr *ProfitDistributionRule) *ProfitDistributionRuleDeleteOne {
-	return c.DeleteOneID(pdr.ID)
+func (c *ReceiptRequestClient) DeleteOne(rr *ReceiptRequest) *ReceiptRequestDeleteOne {
+	return c.DeleteOneID(rr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProfitDistributionRuleClient) DeleteOneID(id uuid.UUID) *ProfitDistributionRuleDeleteOne {
-	builder := c.Delete().Where(profitdistributionrule.ID(id))
+func (c *ReceiptRequestClient) DeleteOneID(id uuid.UUID) *ReceiptRequestDeleteOne {
+	builder := c.Delete().Where(receiptrequest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProfitDistributionRuleDeleteOne{builder}
+	return &ReceiptRequestDeleteOne{builder}
 }
 
-// Query returns a query builder for ProfitDistributionRule.
-func (c *ProfitDistributionRuleClient) Query() *ProfitDistributionRuleQuery {
-	return &ProfitDistributionRuleQuery{
+// Query returns a query builder for ReceiptRequest.
+func (c *ReceiptRequestClient) Query() *ReceiptRequestQuery {
+	return &ReceiptRequestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProfitDistributionRule},
+		ctx:    &QueryContext{Type: TypeReceiptRequest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProfitDistributionRule entity by its id.
-func (c *ProfitDistributionRuleClient) Get(ctx context.Context, id uuid.UUID) (*ProfitDistributionRule, error) {
-	return c.Query().Where(profitdistributionrule.ID(id)).Only(ctx)
+// Get returns a ReceiptRequest entity by its id.
+func (c *ReceiptRequestClient) Get(ctx context.Context, id uuid.UUID) (*ReceiptRequest, error) {
+	return c.Query().Where(receiptrequest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProfitDistributionRuleClient) GetX(ctx context.Context, id uuid.UUID) *ProfitDistributionRule {
+func (c *ReceiptRequestClient) GetX(ctx context.Context, id uuid.UUID) *ReceiptRequest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5210,46 +8855,28 @@ func (c *ProfitDistributionRuleClient) GetX(ctx context.Context, id uuid.UUID) *
 	return obj
 }
 
-// QueryStores queries the stores edge of a ProfitDistributionRule.
-func (c *ProfitDistributionRuleClient) QueryStores(pdr *ProfitDistributionRule) *StoreQuery {
-	query := (&StoreClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pdr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profitdistributionrule.Table, profitdistributionrule.FieldID, id),
-			sqlgraph.To(store.Table, store.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, profitdistributionrule.StoresTable, profitdistributionrule.StoresPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(pdr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ProfitDistributionRuleClient) Hooks() []Hook {
-	hooks := c.hooks.ProfitDistributionRule
-	return append(hooks[:len(hooks):len(hooks)], profitdistributionrule.Hooks[:]...)
+func (c *ReceiptRequestClient) Hooks() []Hook {
+	return c.hooks.ReceiptRequest
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProfitDistributionRuleClient) Interceptors() []Interceptor {
-	inters := c.inters.ProfitDistributionRule
-	return append(inters[:len(inters):len(inters)], profitdistributionrule.Interceptors[:]...)
+func (c *ReceiptRequestClient) Interceptors() []Interceptor {
+	return c.inters.ReceiptRequest
 }
 
-func (c *ProfitDistributionRuleClient) mutate(ctx context.Context, m *ProfitDistributionRuleMutation) (Value, error) {
+func (c *ReceiptRequestClient) mutate(ctx context.Context, m *ReceiptRequestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProfitDistributionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiptRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProfitDistributionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiptRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProfitDistributionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiptRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProfitDistributionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReceiptRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProfitDistributionRule mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReceiptRequest mutation op: %q", m.Op())
 	}
 }
 
@@ -5676,49 +9303,315 @@ func (c *RemarkClient) QueryMerchant(r *Remark) *MerchantQuery {
 		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// QueryStore queries the store edge of a Remark.
+func (c *RemarkClient) QueryStore(r *Remark) *StoreQuery {
+	query := (&StoreClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(remark.Table, remark.FieldID, id),
+			sqlgraph.To(store.Table, store.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, remark.StoreTable, remark.StoreColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RemarkClient) Hooks() []Hook {
+	hooks := c.hooks.Remark
+	return append(hooks[:len(hooks):len(hooks)], remark.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RemarkClient) Interceptors() []Interceptor {
+	inters := c.inters.Remark
+	return append(inters[:len(inters):len(inters)], remark.Interceptors[:]...)
+}
+
+func (c *RemarkClient) mutate(ctx context.Context, m *RemarkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RemarkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RemarkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RemarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RemarkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Remark mutation op: %q", m.Op())
+	}
+}
+
+// ReservationClient is a client for the Reservation schema.
+type ReservationClient struct {
+	config
+}
+
+// NewReservationClient returns a client for the Reservation from the given config.
+func NewReservationClient(c config) *ReservationClient {
+	return &ReservationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reservation.Hooks(f(g(h())))`.
+func (c *ReservationClient) Use(hooks ...Hook) {
+	c.hooks.Reservation = append(c.hooks.Reservation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reservation.Intercept(f(g(h())))`.
+func (c *ReservationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Reservation = append(c.inters.Reservation, interceptors...)
+}
+
+// Create returns a builder for creating a Reservation entity.
+func (c *ReservationClient) Create() *ReservationCreate {
+	mutation := newReservationMutation(c.config, OpCreate)
+	return &ReservationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Reservation entities.
+func (c *ReservationClient) CreateBulk(builders ...*ReservationCreate) *ReservationCreateBulk {
+	return &ReservationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReservationClient) MapCreateBulk(slice any, setFunc func(*ReservationCreate, int)) *ReservationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReservationCreateBulk{err: fmt.Errorf("calling to ReservationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReservationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReservationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Reservation.
+func (c *ReservationClient) Update() *ReservationUpdate {
+	mutation := newReservationMutation(c.config, OpUpdate)
+	return &ReservationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReservationClient) UpdateOne(r *Reservation) *ReservationUpdateOne {
+	mutation := newReservationMutation(c.config, OpUpdateOne, withReservation(r))
+	return &ReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReservationClient) UpdateOneID(id uuid.UUID) *ReservationUpdateOne {
+	mutation := newReservationMutation(c.config, OpUpdateOne, withReservationID(id))
+	return &ReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Reservation.
+func (c *ReservationClient) Delete() *ReservationDelete {
+	mutation := newReservationMutation(c.config, OpDelete)
+	return &ReservationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReservationClient) DeleteOne(r *Reservation) *ReservationDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReservationClient) DeleteOneID(id uuid.UUID) *ReservationDeleteOne {
+	builder := c.Delete().Where(reservation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReservationDeleteOne{builder}
+}
+
+// Query returns a query builder for Reservation.
+func (c *ReservationClient) Query() *ReservationQuery {
+	return &ReservationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReservation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Reservation entity by its id.
+func (c *ReservationClient) Get(ctx context.Context, id uuid.UUID) (*Reservation, error) {
+	return c.Query().Where(reservation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReservationClient) GetX(ctx context.Context, id uuid.UUID) *Reservation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReservationClient) Hooks() []Hook {
+	return c.hooks.Reservation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReservationClient) Interceptors() []Interceptor {
+	return c.inters.Reservation
+}
+
+func (c *ReservationClient) mutate(ctx context.Context, m *ReservationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReservationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReservationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReservationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Reservation mutation op: %q", m.Op())
+	}
+}
+
+// ReservationRuleClient is a client for the ReservationRule schema.
+type ReservationRuleClient struct {
+	config
+}
+
+// NewReservationRuleClient returns a client for the ReservationRule from the given config.
+func NewReservationRuleClient(c config) *ReservationRuleClient {
+	return &ReservationRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reservationrule.Hooks(f(g(h())))`.
+func (c *ReservationRuleClient) Use(hooks ...Hook) {
+	c.hooks.ReservationRule = append(c.hooks.ReservationRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reservationrule.Intercept(f(g(h())))`.
+func (c *ReservationRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReservationRule = append(c.inters.ReservationRule, interceptors...)
+}
+
+// Create returns a builder for creating a ReservationRule entity.
+func (c *ReservationRuleClient) Create() *ReservationRuleCreate {
+	mutation := newReservationRuleMutation(c.config, OpCreate)
+	return &ReservationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReservationRule entities.
+func (c *ReservationRuleClient) CreateBulk(builders ...*ReservationRuleCreate) *ReservationRuleCreateBulk {
+	return &ReservationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReservationRuleClient) MapCreateBulk(slice any, setFunc func(*ReservationRuleCreate, int)) *ReservationRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReservationRuleCreateBulk{err: fmt.Errorf("calling to ReservationRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReservationRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReservationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReservationRule.
+func (c *ReservationRuleClient) Update() *ReservationRuleUpdate {
+	mutation := newReservationRuleMutation(c.config, OpUpdate)
+	return &ReservationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReservationRuleClient) UpdateOne(rr *ReservationRule) *ReservationRuleUpdateOne {
+	mutation := newReservationRuleMutation(c.config, OpUpdateOne, withReservationRule(rr))
+	return &ReservationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReservationRuleClient) UpdateOneID(id uuid.UUID) *ReservationRuleUpdateOne {
+	mutation := newReservationRuleMutation(c.config, OpUpdateOne, withReservationRuleID(id))
+	return &ReservationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReservationRule.
+func (c *ReservationRuleClient) Delete() *ReservationRuleDelete {
+	mutation := newReservationRuleMutation(c.config, OpDelete)
+	return &ReservationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReservationRuleClient) DeleteOne(rr *ReservationRule) *ReservationRuleDeleteOne {
+	return c.DeleteOneID(rr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReservationRuleClient) DeleteOneID(id uuid.UUID) *ReservationRuleDeleteOne {
+	builder := c.Delete().Where(reservationrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReservationRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for ReservationRule.
+func (c *ReservationRuleClient) Query() *ReservationRuleQuery {
+	return &ReservationRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReservationRule},
+		inters: c.Interceptors(),
+	}
 }
 
-// QueryStore queries the store edge of a Remark.
-func (c *RemarkClient) QueryStore(r *Remark) *StoreQuery {
-	query := (&StoreClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(remark.Table, remark.FieldID, id),
-			sqlgraph.To(store.Table, store.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, remark.StoreTable, remark.StoreColumn),
-		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a ReservationRule entity by its id.
+func (c *ReservationRuleClient) Get(ctx context.Context, id uuid.UUID) (*ReservationRule, error) {
+	return c.Query().Where(reservationrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReservationRuleClient) GetX(ctx context.Context, id uuid.UUID) *ReservationRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *RemarkClient) Hooks() []Hook {
-	hooks := c.hooks.Remark
-	return append(hooks[:len(hooks):len(hooks)], remark.Hooks[:]...)
+func (c *ReservationRuleClient) Hooks() []Hook {
+	return c.hooks.ReservationRule
 }
 
 // Interceptors returns the client interceptors.
-func (c *RemarkClient) Interceptors() []Interceptor {
-	inters := c.inters.Remark
-	return append(inters[:len(inters):len(inters)], remark.Interceptors[:]...)
+func (c *ReservationRuleClient) Interceptors() []Interceptor {
+	return c.inters.ReservationRule
 }
 
-func (c *RemarkClient) mutate(ctx context.Context, m *RemarkMutation) (Value, error) {
+func (c *ReservationRuleClient) mutate(ctx context.Context, m *ReservationRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RemarkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReservationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RemarkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReservationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RemarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReservationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RemarkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReservationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Remark mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReservationRule mutation op: %q", m.Op())
 	}
 }
 
@@ -6326,6 +10219,139 @@ func (c *RouterMenuClient) mutate(ctx context.Context, m *RouterMenuMutation) (V
 	}
 }
 
+// ScanOrderRuleClient is a client for the ScanOrderRule schema.
+type ScanOrderRuleClient struct {
+	config
+}
+
+// NewScanOrderRuleClient returns a client for the ScanOrderRule from the given config.
+func NewScanOrderRuleClient(c config) *ScanOrderRuleClient {
+	return &ScanOrderRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scanorderrule.Hooks(f(g(h())))`.
+func (c *ScanOrderRuleClient) Use(hooks ...Hook) {
+	c.hooks.ScanOrderRule = append(c.hooks.ScanOrderRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scanorderrule.Intercept(f(g(h())))`.
+func (c *ScanOrderRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScanOrderRule = append(c.inters.ScanOrderRule, interceptors...)
+}
+
+// Create returns a builder for creating a ScanOrderRule entity.
+func (c *ScanOrderRuleClient) Create() *ScanOrderRuleCreate {
+	mutation := newScanOrderRuleMutation(c.config, OpCreate)
+	return &ScanOrderRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScanOrderRule entities.
+func (c *ScanOrderRuleClient) CreateBulk(builders ...*ScanOrderRuleCreate) *ScanOrderRuleCreateBulk {
+	return &ScanOrderRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScanOrderRuleClient) MapCreateBulk(slice any, setFunc func(*ScanOrderRuleCreate, int)) *ScanOrderRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScanOrderRuleCreateBulk{err: fmt.Errorf("calling to ScanOrderRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScanOrderRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScanOrderRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScanOrderRule.
+func (c *ScanOrderRuleClient) Update() *ScanOrderRuleUpdate {
+	mutation := newScanOrderRuleMutation(c.config, OpUpdate)
+	return &ScanOrderRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScanOrderRuleClient) UpdateOne(sor *ScanOrderRule) *ScanOrderRuleUpdateOne {
+	mutation := newScanOrderRuleMutation(c.config, OpUpdateOne, withScanOrderRule(sor))
+	return &ScanOrderRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScanOrderRuleClient) UpdateOneID(id uuid.UUID) *ScanOrderRuleUpdateOne {
+	mutation := newScanOrderRuleMutation(c.config, OpUpdateOne, withScanOrderRuleID(id))
+	return &ScanOrderRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScanOrderRule.
+func (c *ScanOrderRuleClient) Delete() *ScanOrderRuleDelete {
+	mutation := newScanOrderRuleMutation(c.config, OpDelete)
+	return &ScanOrderRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScanOrderRuleClient) DeleteOne(sor *ScanOrderRule) *ScanOrderRuleDeleteOne {
+	return c.DeleteOneID(sor.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScanOrderRuleClient) DeleteOneID(id uuid.UUID) *ScanOrderRuleDeleteOne {
+	builder := c.Delete().Where(scanorderrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScanOrderRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for ScanOrderRule.
+func (c *ScanOrderRuleClient) Query() *ScanOrderRuleQuery {
+	return &ScanOrderRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScanOrderRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScanOrderRule entity by its id.
+func (c *ScanOrderRuleClient) Get(ctx context.Context, id uuid.UUID) (*ScanOrderRule, error) {
+	return c.Query().Where(scanorderrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScanOrderRuleClient) GetX(ctx context.Context, id uuid.UUID) *ScanOrderRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScanOrderRuleClient) Hooks() []Hook {
+	return c.hooks.ScanOrderRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScanOrderRuleClient) Interceptors() []Interceptor {
+	return c.inters.ScanOrderRule
+}
+
+func (c *ScanOrderRuleClient) mutate(ctx context.Context, m *ScanOrderRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScanOrderRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScanOrderRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScanOrderRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScanOrderRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScanOrderRule mutation op: %q", m.Op())
+	}
+}
+
 // SetMealDetailClient is a client for the SetMealDetail schema.
 type SetMealDetailClient struct {
 	config
@@ -7918,27 +11944,305 @@ func (c *UserRoleClient) mutate(ctx context.Context, m *UserRoleMutation) (Value
 	}
 }
 
+// WaitlistRuleClient is a client for the WaitlistRule schema.
+type WaitlistRuleClient struct {
+	config
+}
+
+// NewWaitlistRuleClient returns a client for the WaitlistRule from the given config.
+func NewWaitlistRuleClient(c config) *WaitlistRuleClient {
+	return &WaitlistRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `waitlistrule.Hooks(f(g(h())))`.
+func (c *WaitlistRuleClient) Use(hooks ...Hook) {
+	c.hooks.WaitlistRule = append(c.hooks.WaitlistRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `waitlistrule.Intercept(f(g(h())))`.
+func (c *WaitlistRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WaitlistRule = append(c.inters.WaitlistRule, interceptors...)
+}
+
+// Create returns a builder for creating a WaitlistRule entity.
+func (c *WaitlistRuleClient) Create() *WaitlistRuleCreate {
+	mutation := newWaitlistRuleMutation(c.config, OpCreate)
+	return &WaitlistRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WaitlistRule entities.
+func (c *WaitlistRuleClient) CreateBulk(builders ...*WaitlistRuleCreate) *WaitlistRuleCreateBulk {
+	return &WaitlistRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WaitlistRuleClient) MapCreateBulk(slice any, setFunc func(*WaitlistRuleCreate, int)) *WaitlistRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WaitlistRuleCreateBulk{err: fmt.Errorf("calling to WaitlistRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WaitlistRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WaitlistRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WaitlistRule.
+func (c *WaitlistRuleClient) Update() *WaitlistRuleUpdate {
+	mutation := newWaitlistRuleMutation(c.config, OpUpdate)
+	return &WaitlistRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WaitlistRuleClient) UpdateOne(wr *WaitlistRule) *WaitlistRuleUpdateOne {
+	mutation := newWaitlistRuleMutation(c.config, OpUpdateOne, withWaitlistRule(wr))
+	return &WaitlistRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WaitlistRuleClient) UpdateOneID(id uuid.UUID) *WaitlistRuleUpdateOne {
+	mutation := newWaitlistRuleMutation(c.config, OpUpdateOne, withWaitlistRuleID(id))
+	return &WaitlistRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WaitlistRule.
+func (c *WaitlistRuleClient) Delete() *WaitlistRuleDelete {
+	mutation := newWaitlistRuleMutation(c.config, OpDelete)
+	return &WaitlistRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WaitlistRuleClient) DeleteOne(wr *WaitlistRule) *WaitlistRuleDeleteOne {
+	return c.DeleteOneID(wr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WaitlistRuleClient) DeleteOneID(id uuid.UUID) *WaitlistRuleDeleteOne {
+	builder := c.Delete().Where(waitlistrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WaitlistRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for WaitlistRule.
+func (c *WaitlistRuleClient) Query() *WaitlistRuleQuery {
+	return &WaitlistRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWaitlistRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WaitlistRule entity by its id.
+func (c *WaitlistRuleClient) Get(ctx context.Context, id uuid.UUID) (*WaitlistRule, error) {
+	return c.Query().Where(waitlistrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WaitlistRuleClient) GetX(ctx context.Context, id uuid.UUID) *WaitlistRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WaitlistRuleClient) Hooks() []Hook {
+	return c.hooks.WaitlistRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *WaitlistRuleClient) Interceptors() []Interceptor {
+	return c.inters.WaitlistRule
+}
+
+func (c *WaitlistRuleClient) mutate(ctx context.Context, m *WaitlistRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WaitlistRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WaitlistRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WaitlistRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WaitlistRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WaitlistRule mutation op: %q", m.Op())
+	}
+}
+
+// WaitlistTicketClient is a client for the WaitlistTicket schema.
+type WaitlistTicketClient struct {
+	config
+}
+
+// NewWaitlistTicketClient returns a client for the WaitlistTicket from the given config.
+func NewWaitlistTicketClient(c config) *WaitlistTicketClient {
+	return &WaitlistTicketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `waitlistticket.Hooks(f(g(h())))`.
+func (c *WaitlistTicketClient) Use(hooks ...Hook) {
+	c.hooks.WaitlistTicket = append(c.hooks.WaitlistTicket, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `waitlistticket.Intercept(f(g(h())))`.
+func (c *WaitlistTicketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WaitlistTicket = append(c.inters.WaitlistTicket, interceptors...)
+}
+
+// Create returns a builder for creating a WaitlistTicket entity.
+func (c *WaitlistTicketClient) Create() *WaitlistTicketCreate {
+	mutation := newWaitlistTicketMutation(c.config, OpCreate)
+	return &WaitlistTicketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WaitlistTicket entities.
+func (c *WaitlistTicketClient) CreateBulk(builders ...*WaitlistTicketCreate) *WaitlistTicketCreateBulk {
+	return &WaitlistTicketCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WaitlistTicketClient) MapCreateBulk(slice any, setFunc func(*WaitlistTicketCreate, int)) *WaitlistTicketCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WaitlistTicketCreateBulk{err: fmt.Errorf("calling to WaitlistTicketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WaitlistTicketCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WaitlistTicketCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WaitlistTicket.
+func (c *WaitlistTicketClient) Update() *WaitlistTicketUpdate {
+	mutation := newWaitlistTicketMutation(c.config, OpUpdate)
+	return &WaitlistTicketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WaitlistTicketClient) UpdateOne(wt *WaitlistTicket) *WaitlistTicketUpdateOne {
+	mutation := newWaitlistTicketMutation(c.config, OpUpdateOne, withWaitlistTicket(wt))
+	return &WaitlistTicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WaitlistTicketClient) UpdateOneID(id uuid.UUID) *WaitlistTicketUpdateOne {
+	mutation := newWaitlistTicketMutation(c.config, OpUpdateOne, withWaitlistTicketID(id))
+	return &WaitlistTicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WaitlistTicket.
+func (c *WaitlistTicketClient) Delete() *WaitlistTicketDelete {
+	mutation := newWaitlistTicketMutation(c.config, OpDelete)
+	return &WaitlistTicketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WaitlistTicketClient) DeleteOne(wt *WaitlistTicket) *WaitlistTicketDeleteOne {
+	return c.DeleteOneID(wt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WaitlistTicketClient) DeleteOneID(id uuid.UUID) *WaitlistTicketDeleteOne {
+	builder := c.Delete().Where(waitlistticket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WaitlistTicketDeleteOne{builder}
+}
+
+// Query returns a query builder for WaitlistTicket.
+func (c *WaitlistTicketClient) Query() *WaitlistTicketQuery {
+	return &WaitlistTicketQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWaitlistTicket},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WaitlistTicket entity by its id.
+func (c *WaitlistTicketClient) Get(ctx context.Context, id uuid.UUID) (*WaitlistTicket, error) {
+	return c.Query().Where(waitlistticket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WaitlistTicketClient) GetX(ctx context.Context, id uuid.UUID) *WaitlistTicket {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WaitlistTicketClient) Hooks() []Hook {
+	return c.hooks.WaitlistTicket
+}
+
+// Interceptors returns the client interceptors.
+func (c *WaitlistTicketClient) Interceptors() []Interceptor {
+	return c.inters.WaitlistTicket
+}
+
+func (c *WaitlistTicketClient) mutate(ctx context.Context, m *WaitlistTicketMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WaitlistTicketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WaitlistTicketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WaitlistTicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WaitlistTicketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WaitlistTicket mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AdditionalFee, AdminUser, BackendUser, BusinessConfig, Category, Department,
-		Device, Menu, MenuItem, Merchant, MerchantBusinessType, MerchantRenewal, Order,
-		OrderProduct, PaymentAccount, PaymentMethod, Permission, Product, ProductAttr,
-		ProductAttrItem, ProductAttrRelation, ProductSpec, ProductSpecRelation,
-		ProductTag, ProductUnit, ProfitDistributionBill, ProfitDistributionRule,
-		RefundOrder, RefundOrderProduct, Remark, Role, RoleMenu, RolePermission,
-		RouterMenu, SetMealDetail, SetMealGroup, Stall, Store, StorePaymentAccount,
-		StoreUser, TaxFee, UserRole []ent.Hook
+		AdditionalFee, AdminUser, BackendUser, BusinessConfig, Category, Coupon,
+		CouponTemplate, Customer, DeliveryOrder, DeliveryProductMapping, DeliveryStore,
+		Department, Device, DiningTable, KitchenTicket, Member, MemberAccount,
+		MemberAccountTransaction, MemberPointsAccount, MemberPointsRule,
+		MemberPointsTransaction, MemberTier, Menu, MenuItem, MenuVersion, Merchant,
+		MerchantBusinessType, MerchantRenewal, Order, OrderProduct, PaymentAccount,
+		PaymentMethod, Permission, PickupCall, PickupRule, Preorder, PreorderRule,
+		PriceChangeBatch, PriceChangeItem, Product, ProductAttr, ProductAttrItem,
+		ProductAttrRelation, ProductSpec, ProductSpecRelation, ProductTag, ProductUnit,
+		ProductVersion, ProfitDistributionBill, ProfitDistributionRule, Promotion,
+		ReceiptRequest, RefundOrder, RefundOrderProduct, Remark, Reservation,
+		ReservationRule, Role, RoleMenu, RolePermission, RouterMenu, ScanOrderRule,
+		SetMealDetail, SetMealGroup, Stall, Store, StorePaymentAccount, StoreUser,
+		TaxFee, UserRole, WaitlistRule, WaitlistTicket []ent.Hook
 	}
 	inters struct {
-		AdditionalFee, AdminUser, BackendUser, BusinessConfig, Category, Department,
-		Device, Menu, MenuItem, Merchant, MerchantBusinessType, MerchantRenewal, Order,
-		OrderProduct, PaymentAccount, PaymentMethod, Permission, Product, ProductAttr,
-		ProductAttrItem, ProductAttrRelation, ProductSpec, ProductSpecRelation,
-		ProductTag, ProductUnit, ProfitDistributionBill, ProfitDistributionRule,
-		RefundOrder, RefundOrderProduct, Remark, Role, RoleMenu, RolePermission,
-		RouterMenu, SetMealDetail, SetMealGroup, Stall, Store, StorePaymentAccount,
-		StoreUser, TaxFee, UserRole []ent.Interceptor
+		AdditionalFee, AdminUser, BackendUser, BusinessConfig, Category, Coupon,
+		CouponTemplate, Customer, DeliveryOrder, DeliveryProductMapping, DeliveryStore,
+		Department, Device, DiningTable, KitchenTicket, Member, MemberAccount,
+		MemberAccountTransaction, MemberPointsAccount, MemberPointsRule,
+		MemberPointsTransaction, MemberTier, Menu, MenuItem, MenuVersion, Merchant,
+		MerchantBusinessType, MerchantRenewal, Order, OrderProduct, PaymentAccount,
+		PaymentMethod, Permission, PickupCall, PickupRule, Preorder, PreorderRule,
+		PriceChangeBatch, PriceChangeItem, Product, ProductAttr, ProductAttrItem,
+		ProductAttrRelation, ProductSpec, ProductSpecRelation, ProductTag, ProductUnit,
+		ProductVersion, ProfitDistributionBill, ProfitDistributionRule, Promotion,
+		ReceiptRequest, RefundOrder, RefundOrderProduct, Remark, Reservation,
+		ReservationRule, Role, RoleMenu, RolePermission, RouterMenu, ScanOrderRule,
+		SetMealDetail, SetMealGroup, Stall, Store, StorePaymentAccount, StoreUser,
+		TaxFee, UserRole, WaitlistRule, WaitlistTicket []ent.Interceptor
 	}
 )
 
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupon"
+)
+
+// Coupon is the model entity for the Coupon schema.
+type Coupon struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 模板ID（三方券为空）
+	TemplateID uuid.UUID `json:"template_id,omitempty"`
+	// 来源：system（系统自定义券）、partner（三方合作券）
+	Source domain.CouponSource `json:"source,omitempty"`
+	// 三方券平台（系统券为空）
+	Provider string `json:"provider,omitempty"`
+	// 券码
+	Code string `json:"code,omitempty"`
+	// 发放批次号
+	BatchNo string `json:"batch_no,omitempty"`
+	// 券名称
+	Name string `json:"name,omitempty"`
+	// 券类型
+	Type domain.CouponType `json:"type,omitempty"`
+	// 状态：unused（未使用）、used（已使用）、invalidated（已作废）、redeeming（三方核销中）、redeem_failed（三方核销失败）
+	Status domain.CouponStatus `json:"status,omitempty"`
+	// 有效期开始时间
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// 有效期结束时间
+	ValidTo time.Time `json:"valid_to,omitempty"`
+	// 使用时间
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// 使用门店ID
+	UsedStoreID uuid.UUID `json:"used_store_id,omitempty"`
+	// 使用订单ID
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// 使用订单号
+	OrderNo string `json:"order_no,omitempty"`
+	// 优惠金额
+	DiscountAmount decimal.Decimal `json:"discount_amount,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Coupon) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coupon.FieldDiscountAmount:
+			values[i] = new(decimal.Decimal)
+		case coupon.FieldSource, coupon.FieldProvider, coupon.FieldCode, coupon.FieldBatchNo, coupon.FieldName, coupon.FieldType, coupon.FieldStatus, coupon.FieldOrderNo:
+			values[i] = new(sql.NullString)
+		case coupon.FieldCreatedAt, coupon.FieldUpdatedAt, coupon.FieldValidFrom, coupon.FieldValidTo, coupon.FieldUsedAt:
+			values[i] = new(sql.NullTime)
+		case coupon.FieldID, coupon.FieldMerchantID, coupon.FieldTemplateID, coupon.FieldUsedStoreID, coupon.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Coupon fields.
+func (c *Coupon) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coupon.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case coupon.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case coupon.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case coupon.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				c.MerchantID = *value
+			}
+		case coupon.FieldTemplateID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field template_id", values[i])
+			} else if value != nil {
+				c.TemplateID = *value
+			}
+		case coupon.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				c.Source = domain.CouponSource(value.String)
+			}
+		case coupon.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				c.Provider = value.String
+			}
+		case coupon.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				c.Code = value.String
+			}
+		case coupon.FieldBatchNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_no", values[i])
+			} else if value.Valid {
+				c.BatchNo = value.String
+			}
+		case coupon.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case coupon.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				c.Type = domain.CouponType(value.String)
+			}
+		case coupon.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				c.Status = domain.CouponStatus(value.String)
+			}
+		case coupon.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				c.ValidFrom = value.Time
+			}
+		case coupon.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				c.ValidTo = value.Time
+			}
+		case coupon.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				c.UsedAt = new(time.Time)
+				*c.UsedAt = value.Time
+			}
+		case coupon.FieldUsedStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field used_store_id", values[i])
+			} else if value != nil {
+				c.UsedStoreID = *value
+			}
+		case coupon.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				c.OrderID = *value
+			}
+		case coupon.FieldOrderNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_no", values[i])
+			} else if value.Valid {
+				c.OrderNo = value.String
+			}
+		case coupon.FieldDiscountAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value != nil {
+				c.DiscountAmount = *value
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Coupon.
+// This includes values selected through modifiers, order, etc.
+func (c *Coupon) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Coupon.
+// Note that you need to call Coupon.Unwrap() before calling this method if this Coupon
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Coupon) Update() *CouponUpdateOne {
+	return NewCouponClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Coupon entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Coupon) Unwrap() *Coupon {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Coupon is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Coupon) String() string {
+	var builder strings.Builder
+	builder.WriteString("Coupon(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("template_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.TemplateID))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", c.Source))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(c.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(c.Code)
+	builder.WriteString(", ")
+	builder.WriteString("batch_no=")
+	builder.WriteString(c.BatchNo)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", c.Type))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", c.Status))
+	builder.WriteString(", ")
+	builder.WriteString("valid_from=")
+	builder.WriteString(c.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("valid_to=")
+	builder.WriteString(c.ValidTo.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := c.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("used_store_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.UsedStoreID))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("order_no=")
+	builder.WriteString(c.OrderNo)
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", c.DiscountAmount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Coupons is a parsable slice of Coupon.
+type Coupons []*Coupon
@@ -0,0 +1,1371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupon"
+)
+
+// CouponCreate is the builder for creating a Coupon entity.
+type CouponCreate struct {
+	config
+	mutation *CouponMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CouponCreate) SetCreatedAt(t time.Time) *CouponCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableCreatedAt(t *time.Time) *CouponCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CouponCreate) SetUpdatedAt(t time.Time) *CouponCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableUpdatedAt(t *time.Time) *CouponCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (cc *CouponCreate) SetMerchantID(u uuid.UUID) *CouponCreate {
+	cc.mutation.SetMerchantID(u)
+	return cc
+}
+
+// SetTemplateID sets the "template_id" field.
+func (cc *CouponCreate) SetTemplateID(u uuid.UUID) *CouponCreate {
+	cc.mutation.SetTemplateID(u)
+	return cc
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableTemplateID(u *uuid.UUID) *CouponCreate {
+	if u != nil {
+		cc.SetTemplateID(*u)
+	}
+	return cc
+}
+
+// SetSource sets the "source" field.
+func (cc *CouponCreate) SetSource(ds domain.CouponSource) *CouponCreate {
+	cc.mutation.SetSource(ds)
+	return cc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableSource(ds *domain.CouponSource) *CouponCreate {
+	if ds != nil {
+		cc.SetSource(*ds)
+	}
+	return cc
+}
+
+// SetProvider sets the "provider" field.
+func (cc *CouponCreate) SetProvider(s string) *CouponCreate {
+	cc.mutation.SetProvider(s)
+	return cc
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableProvider(s *string) *CouponCreate {
+	if s != nil {
+		cc.SetProvider(*s)
+	}
+	return cc
+}
+
+// SetCode sets the "code" field.
+func (cc *CouponCreate) SetCode(s string) *CouponCreate {
+	cc.mutation.SetCode(s)
+	return cc
+}
+
+// SetBatchNo sets the "batch_no" field.
+func (cc *CouponCreate) SetBatchNo(s string) *CouponCreate {
+	cc.mutation.SetBatchNo(s)
+	return cc
+}
+
+// SetNillableBatchNo sets the "batch_no" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableBatchNo(s *string) *CouponCreate {
+	if s != nil {
+		cc.SetBatchNo(*s)
+	}
+	return cc
+}
+
+// SetName sets the "name" field.
+func (cc *CouponCreate) SetName(s string) *CouponCreate {
+	cc.mutation.SetName(s)
+	return cc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableName(s *string) *CouponCreate {
+	if s != nil {
+		cc.SetName(*s)
+	}
+	return cc
+}
+
+// SetType sets the "type" field.
+func (cc *CouponCreate) SetType(dt domain.CouponType) *CouponCreate {
+	cc.mutation.SetType(dt)
+	return cc
+}
+
+// SetStatus sets the "status" field.
+func (cc *CouponCreate) SetStatus(ds domain.CouponStatus) *CouponCreate {
+	cc.mutation.SetStatus(ds)
+	return cc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableStatus(ds *domain.CouponStatus) *CouponCreate {
+	if ds != nil {
+		cc.SetStatus(*ds)
+	}
+	return cc
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (cc *CouponCreate) SetValidFrom(t time.Time) *CouponCreate {
+	cc.mutation.SetValidFrom(t)
+	return cc
+}
+
+// SetValidTo sets the "valid_to" field.
+func (cc *CouponCreate) SetValidTo(t time.Time) *CouponCreate {
+	cc.mutation.SetValidTo(t)
+	return cc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (cc *CouponCreate) SetUsedAt(t time.Time) *CouponCreate {
+	cc.mutation.SetUsedAt(t)
+	return cc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableUsedAt(t *time.Time) *CouponCreate {
+	if t != nil {
+		cc.SetUsedAt(*t)
+	}
+	return cc
+}
+
+// SetUsedStoreID sets the "used_store_id" field.
+func (cc *CouponCreate) SetUsedStoreID(u uuid.UUID) *CouponCreate {
+	cc.mutation.SetUsedStoreID(u)
+	return cc
+}
+
+// SetNillableUsedStoreID sets the "used_store_id" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableUsedStoreID(u *uuid.UUID) *CouponCreate {
+	if u != nil {
+		cc.SetUsedStoreID(*u)
+	}
+	return cc
+}
+
+// SetOrderID sets the "order_id" field.
+func (cc *CouponCreate) SetOrderID(u uuid.UUID) *CouponCreate {
+	cc.mutation.SetOrderID(u)
+	return cc
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableOrderID(u *uuid.UUID) *CouponCreate {
+	if u != nil {
+		cc.SetOrderID(*u)
+	}
+	return cc
+}
+
+// SetOrderNo sets the "order_no" field.
+func (cc *CouponCreate) SetOrderNo(s string) *CouponCreate {
+	cc.mutation.SetOrderNo(s)
+	return cc
+}
+
+// SetNillableOrderNo sets the "order_no" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableOrderNo(s *string) *CouponCreate {
+	if s != nil {
+		cc.SetOrderNo(*s)
+	}
+	return cc
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (cc *CouponCreate) SetDiscountAmount(d decimal.Decimal) *CouponCreate {
+	cc.mutation.SetDiscountAmount(d)
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CouponCreate) SetID(u uuid.UUID) *CouponCreate {
+	cc.mutation.SetID(u)
+	return cc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableID(u *uuid.UUID) *CouponCreate {
+	if u != nil {
+		cc.SetID(*u)
+	}
+	return cc
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (cc *CouponCreate) Mutation() *CouponMutation {
+	return cc.mutation
+}
+
+// Save creates the Coupon in the database.
+func (cc *CouponCreate) Save(ctx context.Context) (*Coupon, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CouponCreate) SaveX(ctx context.Context) *Coupon {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CouponCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CouponCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CouponCreate) defaults() {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := coupon.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := coupon.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cc.mutation.TemplateID(); !ok {
+		v := coupon.DefaultTemplateID()
+		cc.mutation.SetTemplateID(v)
+	}
+	if _, ok := cc.mutation.Source(); !ok {
+		v := coupon.DefaultSource
+		cc.mutation.SetSource(v)
+	}
+	if _, ok := cc.mutation.Provider(); !ok {
+		v := coupon.DefaultProvider
+		cc.mutation.SetProvider(v)
+	}
+	if _, ok := cc.mutation.BatchNo(); !ok {
+		v := coupon.DefaultBatchNo
+		cc.mutation.SetBatchNo(v)
+	}
+	if _, ok := cc.mutation.Name(); !ok {
+		v := coupon.DefaultName
+		cc.mutation.SetName(v)
+	}
+	if _, ok := cc.mutation.Status(); !ok {
+		v := coupon.DefaultStatus
+		cc.mutation.SetStatus(v)
+	}
+	if _, ok := cc.mutation.UsedStoreID(); !ok {
+		v := coupon.DefaultUsedStoreID()
+		cc.mutation.SetUsedStoreID(v)
+	}
+	if _, ok := cc.mutation.OrderID(); !ok {
+		v := coupon.DefaultOrderID()
+		cc.mutation.SetOrderID(v)
+	}
+	if _, ok := cc.mutation.OrderNo(); !ok {
+		v := coupon.DefaultOrderNo
+		cc.mutation.SetOrderNo(v)
+	}
+	if _, ok := cc.mutation.ID(); !ok {
+		v := coupon.DefaultID()
+		cc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CouponCreate) check() error {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Coupon.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Coupon.updated_at"`)}
+	}
+	if _, ok := cc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "Coupon.merchant_id"`)}
+	}
+	if _, ok := cc.mutation.TemplateID(); !ok {
+		return &ValidationError{Name: "template_id", err: errors.New(`ent: missing required field "Coupon.template_id"`)}
+	}
+	if _, ok := cc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Coupon.source"`)}
+	}
+	if v, ok := cc.mutation.Source(); ok {
+		if err := coupon.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Coupon.source": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "Coupon.provider"`)}
+	}
+	if v, ok := cc.mutation.Provider(); ok {
+		if err := coupon.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Coupon.provider": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Coupon.code"`)}
+	}
+	if v, ok := cc.mutation.Code(); ok {
+		if err := coupon.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Coupon.code": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.BatchNo(); !ok {
+		return &ValidationError{Name: "batch_no", err: errors.New(`ent: missing required field "Coupon.batch_no"`)}
+	}
+	if v, ok := cc.mutation.BatchNo(); ok {
+		if err := coupon.BatchNoValidator(v); err != nil {
+			return &ValidationError{Name: "batch_no", err: fmt.Errorf(`ent: validator failed for field "Coupon.batch_no": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Coupon.name"`)}
+	}
+	if v, ok := cc.mutation.Name(); ok {
+		if err := coupon.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Coupon.name": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Coupon.type"`)}
+	}
+	if v, ok := cc.mutation.GetType(); ok {
+		if err := coupon.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Coupon.type": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Coupon.status"`)}
+	}
+	if v, ok := cc.mutation.Status(); ok {
+		if err := coupon.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Coupon.status": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ent: missing required field "Coupon.valid_from"`)}
+	}
+	if _, ok := cc.mutation.ValidTo(); !ok {
+		return &ValidationError{Name: "valid_to", err: errors.New(`ent: missing required field "Coupon.valid_to"`)}
+	}
+	if _, ok := cc.mutation.UsedStoreID(); !ok {
+		return &ValidationError{Name: "used_store_id", err: errors.New(`ent: missing required field "Coupon.used_store_id"`)}
+	}
+	if _, ok := cc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "Coupon.order_id"`)}
+	}
+	if _, ok := cc.mutation.OrderNo(); !ok {
+		return &ValidationError{Name: "order_no", err: errors.New(`ent: missing required field "Coupon.order_no"`)}
+	}
+	if v, ok := cc.mutation.OrderNo(); ok {
+		if err := coupon.OrderNoValidator(v); err != nil {
+			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "Coupon.order_no": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`ent: missing required field "Coupon.discount_amount"`)}
+	}
+	return nil
+}
+
+func (cc *CouponCreate) sqlSave(ctx context.Context) (*Coupon, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CouponCreate) createSpec() (*Coupon, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Coupon{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(coupon.Table, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = cc.conflict
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(coupon.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(coupon.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cc.mutation.MerchantID(); ok {
+		_spec.SetField(coupon.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := cc.mutation.TemplateID(); ok {
+		_spec.SetField(coupon.FieldTemplateID, field.TypeUUID, value)
+		_node.TemplateID = value
+	}
+	if value, ok := cc.mutation.Source(); ok {
+		_spec.SetField(coupon.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := cc.mutation.Provider(); ok {
+		_spec.SetField(coupon.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := cc.mutation.Code(); ok {
+		_spec.SetField(coupon.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := cc.mutation.BatchNo(); ok {
+		_spec.SetField(coupon.FieldBatchNo, field.TypeString, value)
+		_node.BatchNo = value
+	}
+	if value, ok := cc.mutation.Name(); ok {
+		_spec.SetField(coupon.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cc.mutation.GetType(); ok {
+		_spec.SetField(coupon.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := cc.mutation.Status(); ok {
+		_spec.SetField(coupon.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := cc.mutation.ValidFrom(); ok {
+		_spec.SetField(coupon.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := cc.mutation.ValidTo(); ok {
+		_spec.SetField(coupon.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = value
+	}
+	if value, ok := cc.mutation.UsedAt(); ok {
+		_spec.SetField(coupon.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := cc.mutation.UsedStoreID(); ok {
+		_spec.SetField(coupon.FieldUsedStoreID, field.TypeUUID, value)
+		_node.UsedStoreID = value
+	}
+	if value, ok := cc.mutation.OrderID(); ok {
+		_spec.SetField(coupon.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := cc.mutation.OrderNo(); ok {
+		_spec.SetField(coupon.FieldOrderNo, field.TypeString, value)
+		_node.OrderNo = value
+	}
+	if value, ok := cc.mutation.DiscountAmount(); ok {
+		_spec.SetField(coupon.FieldDiscountAmount, field.TypeOther, value)
+		_node.DiscountAmount = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Coupon.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CouponUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CouponCreate) OnConflict(opts ...sql.ConflictOption) *CouponUpsertOne {
+	cc.conflict = opts
+	return &CouponUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Coupon.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CouponCreate) OnConflictColumns(columns ...string) *CouponUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CouponUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CouponUpsertOne is the builder for "upsert"-ing
+	//  one Coupon node.
+	CouponUpsertOne struct {
+		create *CouponCreate
+	}
+
+	// CouponUpsert is the "OnConflict" setter.
+	CouponUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CouponUpsert) SetUpdatedAt(v time.Time) *CouponUpsert {
+	u.Set(coupon.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CouponUpsert) UpdateUpdatedAt() *CouponUpsert {
+	u.SetExcluded(coupon.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CouponUpsert) SetName(v string) *CouponUpsert {
+	u.Set(coupon.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CouponUpsert) UpdateName() *CouponUpsert {
+	u.SetExcluded(coupon.FieldName)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *CouponUpsert) SetType(v domain.CouponType) *CouponUpsert {
+	u.Set(coupon.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CouponUpsert) UpdateType() *CouponUpsert {
+	u.SetExcluded(coupon.FieldType)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CouponUpsert) SetStatus(v domain.CouponStatus) *CouponUpsert {
+	u.Set(coupon.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CouponUpsert) UpdateStatus() *CouponUpsert {
+	u.SetExcluded(coupon.FieldStatus)
+	return u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (u *CouponUpsert) SetValidFrom(v time.Time) *CouponUpsert {
+	u.Set(coupon.FieldValidFrom, v)
+	return u
+}
+
+// UpdateValidFrom sets the "valid_from" field to the value that was provided on create.
+func (u *CouponUpsert) UpdateValidFrom() *CouponUpsert {
+	u.SetExcluded(coupon.FieldValidFrom)
+	return u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (u *CouponUpsert) SetValidTo(v time.Time) *CouponUpsert {
+	u.Set(coupon.FieldValidTo, v)
+	return u
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *CouponUpsert) UpdateValidTo() *CouponUpsert {
+	u.SetExcluded(coupon.FieldValidTo)
+	return u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *CouponUpsert) SetUsedAt(v time.Time) *CouponUpsert {
+	u.Set(coupon.FieldUsedAt, v)
+	return u
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *CouponUpsert) UpdateUsedAt() *CouponUpsert {
+	u.SetExcluded(coupon.FieldUsedAt)
+	return u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *CouponUpsert) ClearUsedAt() *CouponUpsert {
+	u.SetNull(coupon.FieldUsedAt)
+	return u
+}
+
+// SetUsedStoreID sets the "used_store_id" field.
+func (u *CouponUpsert) SetUsedStoreID(v uuid.UUID) *CouponUpsert {
+	u.Set(coupon.FieldUsedStoreID, v)
+	return u
+}
+
+// UpdateUsedStoreID sets the "used_store_id" field to the value that was provided on create.
+func (u *CouponUpsert) UpdateUsedStoreID() *CouponUpsert {
+	u.SetExcluded(coupon.FieldUsedStoreID)
+	return u
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *CouponUpsert) SetOrderID(v uuid.UUID) *CouponUpsert {
+	u.Set(coupon.FieldOrderID, v)
+	return u
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *CouponUpsert) UpdateOrderID() *CouponUpsert {
+	u.SetExcluded(coupon.FieldOrderID)
+	return u
+}
+
+// SetOrderNo sets the "order_no" field.
+func (u *CouponUpsert) SetOrderNo(v string) *CouponUpsert {
+	u.Set(coupon.FieldOrderNo, v)
+	return u
+}
+
+// UpdateOrderNo sets the "order_no" field to the value that was provided on create.
+func (u *CouponUpsert) UpdateOrderNo() *CouponUpsert {
+	u.SetExcluded(coupon.FieldOrderNo)
+	return u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *CouponUpsert) SetDiscountAmount(v decimal.Decimal) *CouponUpsert {
+	u.Set(coupon.FieldDiscountAmount, v)
+	return u
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *CouponUpsert) UpdateDiscountAmount() *CouponUpsert {
+	u.SetExcluded(coupon.FieldDiscountAmount)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Coupon.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coupon.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CouponUpsertOne) UpdateNewValues() *CouponUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(coupon.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(coupon.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(coupon.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.TemplateID(); exists {
+			s.SetIgnore(coupon.FieldTemplateID)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(coupon.FieldSource)
+		}
+		if _, exists := u.create.mutation.Provider(); exists {
+			s.SetIgnore(coupon.FieldProvider)
+		}
+		if _, exists := u.create.mutation.Code(); exists {
+			s.SetIgnore(coupon.FieldCode)
+		}
+		if _, exists := u.create.mutation.BatchNo(); exists {
+			s.SetIgnore(coupon.FieldBatchNo)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Coupon.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CouponUpsertOne) Ignore() *CouponUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CouponUpsertOne) DoNothing() *CouponUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CouponCreate.OnConflict
+// documentation for more info.
+func (u *CouponUpsertOne) Update(set func(*CouponUpsert)) *CouponUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CouponUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CouponUpsertOne) SetUpdatedAt(v time.Time) *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CouponUpsertOne) UpdateUpdatedAt() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CouponUpsertOne) SetName(v string) *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CouponUpsertOne) UpdateName() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *CouponUpsertOne) SetType(v domain.CouponType) *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CouponUpsertOne) UpdateType() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CouponUpsertOne) SetStatus(v domain.CouponStatus) *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CouponUpsertOne) UpdateStatus() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (u *CouponUpsertOne) SetValidFrom(v time.Time) *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetValidFrom(v)
+	})
+}
+
+// UpdateValidFrom sets the "valid_from" field to the value that was provided on create.
+func (u *CouponUpsertOne) UpdateValidFrom() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateValidFrom()
+	})
+}
+
+// SetValidTo sets the "valid_to" field.
+func (u *CouponUpsertOne) SetValidTo(v time.Time) *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetValidTo(v)
+	})
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *CouponUpsertOne) UpdateValidTo() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateValidTo()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *CouponUpsertOne) SetUsedAt(v time.Time) *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *CouponUpsertOne) UpdateUsedAt() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *CouponUpsertOne) ClearUsedAt() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// SetUsedStoreID sets the "used_store_id" field.
+func (u *CouponUpsertOne) SetUsedStoreID(v uuid.UUID) *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetUsedStoreID(v)
+	})
+}
+
+// UpdateUsedStoreID sets the "used_store_id" field to the value that was provided on create.
+func (u *CouponUpsertOne) UpdateUsedStoreID() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateUsedStoreID()
+	})
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *CouponUpsertOne) SetOrderID(v uuid.UUID) *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetOrderID(v)
+	})
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *CouponUpsertOne) UpdateOrderID() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateOrderID()
+	})
+}
+
+// SetOrderNo sets the "order_no" field.
+func (u *CouponUpsertOne) SetOrderNo(v string) *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetOrderNo(v)
+	})
+}
+
+// UpdateOrderNo sets the "order_no" field to the value that was provided on create.
+func (u *CouponUpsertOne) UpdateOrderNo() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateOrderNo()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *CouponUpsertOne) SetDiscountAmount(v decimal.Decimal) *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *CouponUpsertOne) UpdateDiscountAmount() *CouponUpsertOne {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *CouponUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CouponCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CouponUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CouponUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CouponUpsertOne.ID is not supported by MySQL driver. Use CouponUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CouponUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CouponCreateBulk is the builder for creating many Coupon entities in bulk.
+type CouponCreateBulk struct {
+	config
+	err      error
+	builders []*CouponCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Coupon entities in the database.
+func (ccb *CouponCreateBulk) Save(ctx context.Context) ([]*Coupon, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Coupon, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CouponMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CouponCreateBulk) SaveX(ctx context.Context) []*Coupon {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CouponCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CouponCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Coupon.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CouponUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CouponCreateBulk) OnConflict(opts ...sql.ConflictOption) *CouponUpsertBulk {
+	ccb.conflict = opts
+	return &CouponUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Coupon.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CouponCreateBulk) OnConflictColumns(columns ...string) *CouponUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CouponUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CouponUpsertBulk is the builder for "upsert"-ing
+// a bulk of Coupon nodes.
+type CouponUpsertBulk struct {
+	create *CouponCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Coupon.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coupon.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CouponUpsertBulk) UpdateNewValues() *CouponUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(coupon.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(coupon.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(coupon.FieldMerchantID)
+			}
+			if _, exists := b.mutation.TemplateID(); exists {
+				s.SetIgnore(coupon.FieldTemplateID)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(coupon.FieldSource)
+			}
+			if _, exists := b.mutation.Provider(); exists {
+				s.SetIgnore(coupon.FieldProvider)
+			}
+			if _, exists := b.mutation.Code(); exists {
+				s.SetIgnore(coupon.FieldCode)
+			}
+			if _, exists := b.mutation.BatchNo(); exists {
+				s.SetIgnore(coupon.FieldBatchNo)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Coupon.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CouponUpsertBulk) Ignore() *CouponUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CouponUpsertBulk) DoNothing() *CouponUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CouponCreateBulk.OnConflict
+// documentation for more info.
+func (u *CouponUpsertBulk) Update(set func(*CouponUpsert)) *CouponUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CouponUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CouponUpsertBulk) SetUpdatedAt(v time.Time) *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CouponUpsertBulk) UpdateUpdatedAt() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CouponUpsertBulk) SetName(v string) *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CouponUpsertBulk) UpdateName() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *CouponUpsertBulk) SetType(v domain.CouponType) *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CouponUpsertBulk) UpdateType() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CouponUpsertBulk) SetStatus(v domain.CouponStatus) *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CouponUpsertBulk) UpdateStatus() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (u *CouponUpsertBulk) SetValidFrom(v time.Time) *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetValidFrom(v)
+	})
+}
+
+// UpdateValidFrom sets the "valid_from" field to the value that was provided on create.
+func (u *CouponUpsertBulk) UpdateValidFrom() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateValidFrom()
+	})
+}
+
+// SetValidTo sets the "valid_to" field.
+func (u *CouponUpsertBulk) SetValidTo(v time.Time) *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetValidTo(v)
+	})
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *CouponUpsertBulk) UpdateValidTo() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateValidTo()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *CouponUpsertBulk) SetUsedAt(v time.Time) *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *CouponUpsertBulk) UpdateUsedAt() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *CouponUpsertBulk) ClearUsedAt() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// SetUsedStoreID sets the "used_store_id" field.
+func (u *CouponUpsertBulk) SetUsedStoreID(v uuid.UUID) *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetUsedStoreID(v)
+	})
+}
+
+// UpdateUsedStoreID sets the "used_store_id" field to the value that was provided on create.
+func (u *CouponUpsertBulk) UpdateUsedStoreID() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateUsedStoreID()
+	})
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *CouponUpsertBulk) SetOrderID(v uuid.UUID) *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetOrderID(v)
+	})
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *CouponUpsertBulk) UpdateOrderID() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateOrderID()
+	})
+}
+
+// SetOrderNo sets the "order_no" field.
+func (u *CouponUpsertBulk) SetOrderNo(v string) *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetOrderNo(v)
+	})
+}
+
+// UpdateOrderNo sets the "order_no" field to the value that was provided on create.
+func (u *CouponUpsertBulk) UpdateOrderNo() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateOrderNo()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *CouponUpsertBulk) SetDiscountAmount(v decimal.Decimal) *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *CouponUpsertBulk) UpdateDiscountAmount() *CouponUpsertBulk {
+	return u.Update(func(s *CouponUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *CouponUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CouponCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CouponCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CouponUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupon"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// CouponUpdate is the builder for updating Coupon entities.
+type CouponUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CouponMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CouponUpdate builder.
+func (cu *CouponUpdate) Where(ps ...predicate.Coupon) *CouponUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CouponUpdate) SetUpdatedAt(t time.Time) *CouponUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CouponUpdate) SetName(s string) *CouponUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableName(s *string) *CouponUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetType sets the "type" field.
+func (cu *CouponUpdate) SetType(dt domain.CouponType) *CouponUpdate {
+	cu.mutation.SetType(dt)
+	return cu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableType(dt *domain.CouponType) *CouponUpdate {
+	if dt != nil {
+		cu.SetType(*dt)
+	}
+	return cu
+}
+
+// SetStatus sets the "status" field.
+func (cu *CouponUpdate) SetStatus(ds domain.CouponStatus) *CouponUpdate {
+	cu.mutation.SetStatus(ds)
+	return cu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableStatus(ds *domain.CouponStatus) *CouponUpdate {
+	if ds != nil {
+		cu.SetStatus(*ds)
+	}
+	return cu
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (cu *CouponUpdate) SetValidFrom(t time.Time) *CouponUpdate {
+	cu.mutation.SetValidFrom(t)
+	return cu
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableValidFrom(t *time.Time) *CouponUpdate {
+	if t != nil {
+		cu.SetValidFrom(*t)
+	}
+	return cu
+}
+
+// SetValidTo sets the "valid_to" field.
+func (cu *CouponUpdate) SetValidTo(t time.Time) *CouponUpdate {
+	cu.mutation.SetValidTo(t)
+	return cu
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableValidTo(t *time.Time) *CouponUpdate {
+	if t != nil {
+		cu.SetValidTo(*t)
+	}
+	return cu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (cu *CouponUpdate) SetUsedAt(t time.Time) *CouponUpdate {
+	cu.mutation.SetUsedAt(t)
+	return cu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableUsedAt(t *time.Time) *CouponUpdate {
+	if t != nil {
+		cu.SetUsedAt(*t)
+	}
+	return cu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (cu *CouponUpdate) ClearUsedAt() *CouponUpdate {
+	cu.mutation.ClearUsedAt()
+	return cu
+}
+
+// SetUsedStoreID sets the "used_store_id" field.
+func (cu *CouponUpdate) SetUsedStoreID(u uuid.UUID) *CouponUpdate {
+	cu.mutation.SetUsedStoreID(u)
+	return cu
+}
+
+// SetNillableUsedStoreID sets the "used_store_id" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableUsedStoreID(u *uuid.UUID) *CouponUpdate {
+	if u != nil {
+		cu.SetUsedStoreID(*u)
+	}
+	return cu
+}
+
+// SetOrderID sets the "order_id" field.
+func (cu *CouponUpdate) SetOrderID(u uuid.UUID) *CouponUpdate {
+	cu.mutation.SetOrderID(u)
+	return cu
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableOrderID(u *uuid.UUID) *CouponUpdate {
+	if u != nil {
+		cu.SetOrderID(*u)
+	}
+	return cu
+}
+
+// SetOrderNo sets the "order_no" field.
+func (cu *CouponUpdate) SetOrderNo(s string) *CouponUpdate {
+	cu.mutation.SetOrderNo(s)
+	return cu
+}
+
+// SetNillableOrderNo sets the "order_no" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableOrderNo(s *string) *CouponUpdate {
+	if s != nil {
+		cu.SetOrderNo(*s)
+	}
+	return cu
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (cu *CouponUpdate) SetDiscountAmount(d decimal.Decimal) *CouponUpdate {
+	cu.mutation.SetDiscountAmount(d)
+	return cu
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableDiscountAmount(d *decimal.Decimal) *CouponUpdate {
+	if d != nil {
+		cu.SetDiscountAmount(*d)
+	}
+	return cu
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (cu *CouponUpdate) Mutation() *CouponMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CouponUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CouponUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CouponUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CouponUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CouponUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := coupon.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CouponUpdate) check() error {
+	if v, ok := cu.mutation.Name(); ok {
+		if err := coupon.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Coupon.name": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.GetType(); ok {
+		if err := coupon.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Coupon.type": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Status(); ok {
+		if err := coupon.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Coupon.status": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.OrderNo(); ok {
+		if err := coupon.OrderNoValidator(v); err != nil {
+			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "Coupon.order_no": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cu *CouponUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CouponUpdate {
+	cu.modifiers = append(cu.modifiers, modifiers...)
+	return cu
+}
+
+func (cu *CouponUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupon.Table, coupon.Columns, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(coupon.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(coupon.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.GetType(); ok {
+		_spec.SetField(coupon.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.Status(); ok {
+		_spec.SetField(coupon.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.ValidFrom(); ok {
+		_spec.SetField(coupon.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.ValidTo(); ok {
+		_spec.SetField(coupon.FieldValidTo, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.UsedAt(); ok {
+		_spec.SetField(coupon.FieldUsedAt, field.TypeTime, value)
+	}
+	if cu.mutation.UsedAtCleared() {
+		_spec.ClearField(coupon.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.UsedStoreID(); ok {
+		_spec.SetField(coupon.FieldUsedStoreID, field.TypeUUID, value)
+	}
+	if value, ok := cu.mutation.OrderID(); ok {
+		_spec.SetField(coupon.FieldOrderID, field.TypeUUID, value)
+	}
+	if value, ok := cu.mutation.OrderNo(); ok {
+		_spec.SetField(coupon.FieldOrderNo, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.DiscountAmount(); ok {
+		_spec.SetField(coupon.FieldDiscountAmount, field.TypeOther, value)
+	}
+	_spec.AddModifiers(cu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CouponUpdateOne is the builder for updating a single Coupon entity.
+type CouponUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CouponMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CouponUpdateOne) SetUpdatedAt(t time.Time) *CouponUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetName sets the "name" field.
+func (cuo *CouponUpdateOne) SetName(s string) *CouponUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableName(s *string) *CouponUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetType sets the "type" field.
+func (cuo *CouponUpdateOne) SetType(dt domain.CouponType) *CouponUpdateOne {
+	cuo.mutation.SetType(dt)
+	return cuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableType(dt *domain.CouponType) *CouponUpdateOne {
+	if dt != nil {
+		cuo.SetType(*dt)
+	}
+	return cuo
+}
+
+// SetStatus sets the "status" field.
+func (cuo *CouponUpdateOne) SetStatus(ds domain.CouponStatus) *CouponUpdateOne {
+	cuo.mutation.SetStatus(ds)
+	return cuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableStatus(ds *domain.CouponStatus) *CouponUpdateOne {
+	if ds != nil {
+		cuo.SetStatus(*ds)
+	}
+	return cuo
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (cuo *CouponUpdateOne) SetValidFrom(t time.Time) *CouponUpdateOne {
+	cuo.mutation.SetValidFrom(t)
+	return cuo
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableValidFrom(t *time.Time) *CouponUpdateOne {
+	if t != nil {
+		cuo.SetValidFrom(*t)
+	}
+	return cuo
+}
+
+// SetValidTo sets the "valid_to" field.
+func (cuo *CouponUpdateOne) SetValidTo(t time.Time) *CouponUpdateOne {
+	cuo.mutation.SetValidTo(t)
+	return cuo
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableValidTo(t *time.Time) *CouponUpdateOne {
+	if t != nil {
+		cuo.SetValidTo(*t)
+	}
+	return cuo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (cuo *CouponUpdateOne) SetUsedAt(t time.Time) *CouponUpdateOne {
+	cuo.mutation.SetUsedAt(t)
+	return cuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableUsedAt(t *time.Time) *CouponUpdateOne {
+	if t != nil {
+		cuo.SetUsedAt(*t)
+	}
+	return cuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (cuo *CouponUpdateOne) ClearUsedAt() *CouponUpdateOne {
+	cuo.mutation.ClearUsedAt()
+	return cuo
+}
+
+// SetUsedStoreID sets the "used_store_id" field.
+func (cuo *CouponUpdateOne) SetUsedStoreID(u uuid.UUID) *CouponUpdateOne {
+	cuo.mutation.SetUsedStoreID(u)
+	return cuo
+}
+
+// SetNillableUsedStoreID sets the "used_store_id" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableUsedStoreID(u *uuid.UUID) *CouponUpdateOne {
+	if u != nil {
+		cuo.SetUsedStoreID(*u)
+	}
+	return cuo
+}
+
+// SetOrderID sets the "order_id" field.
+func (cuo *CouponUpdateOne) SetOrderID(u uuid.UUID) *CouponUpdateOne {
+	cuo.mutation.SetOrderID(u)
+	return cuo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableOrderID(u *uuid.UUID) *CouponUpdateOne {
+	if u != nil {
+		cuo.SetOrderID(*u)
+	}
+	return cuo
+}
+
+// SetOrderNo sets the "order_no" field.
+func (cuo *CouponUpdateOne) SetOrderNo(s string) *CouponUpdateOne {
+	cuo.mutation.SetOrderNo(s)
+	return cuo
+}
+
+// SetNillableOrderNo sets the "order_no" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableOrderNo(s *string) *CouponUpdateOne {
+	if s != nil {
+		cuo.SetOrderNo(*s)
+	}
+	return cuo
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (cuo *CouponUpdateOne) SetDiscountAmount(d decimal.Decimal) *CouponUpdateOne {
+	cuo.mutation.SetDiscountAmount(d)
+	return cuo
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableDiscountAmount(d *decimal.Decimal) *CouponUpdateOne {
+	if d != nil {
+		cuo.SetDiscountAmount(*d)
+	}
+	return cuo
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (cuo *CouponUpdateOne) Mutation() *CouponMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CouponUpdate builder.
+func (cuo *CouponUpdateOne) Where(ps ...predicate.Coupon) *CouponUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CouponUpdateOne) Select(field string, fields ...string) *CouponUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Coupon entity.
+func (cuo *CouponUpdateOne) Save(ctx context.Context) (*Coupon, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CouponUpdateOne) SaveX(ctx context.Context) *Coupon {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CouponUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CouponUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CouponUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := coupon.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CouponUpdateOne) check() error {
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := coupon.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Coupon.name": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.GetType(); ok {
+		if err := coupon.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Coupon.type": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Status(); ok {
+		if err := coupon.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Coupon.status": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.OrderNo(); ok {
+		if err := coupon.OrderNoValidator(v); err != nil {
+			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "Coupon.order_no": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cuo *CouponUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CouponUpdateOne {
+	cuo.modifiers = append(cuo.modifiers, modifiers...)
+	return cuo
+}
+
+func (cuo *CouponUpdateOne) sqlSave(ctx context.Context) (_node *Coupon, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupon.Table, coupon.Columns, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Coupon.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coupon.FieldID)
+		for _, f := range fields {
+			if !coupon.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coupon.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(coupon.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(coupon.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.GetType(); ok {
+		_spec.SetField(coupon.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.Status(); ok {
+		_spec.SetField(coupon.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.ValidFrom(); ok {
+		_spec.SetField(coupon.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.ValidTo(); ok {
+		_spec.SetField(coupon.FieldValidTo, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.UsedAt(); ok {
+		_spec.SetField(coupon.FieldUsedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.UsedAtCleared() {
+		_spec.ClearField(coupon.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.UsedStoreID(); ok {
+		_spec.SetField(coupon.FieldUsedStoreID, field.TypeUUID, value)
+	}
+	if value, ok := cuo.mutation.OrderID(); ok {
+		_spec.SetField(coupon.FieldOrderID, field.TypeUUID, value)
+	}
+	if value, ok := cuo.mutation.OrderNo(); ok {
+		_spec.SetField(coupon.FieldOrderNo, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.DiscountAmount(); ok {
+		_spec.SetField(coupon.FieldDiscountAmount, field.TypeOther, value)
+	}
+	_spec.AddModifiers(cuo.modifiers...)
+	_node = &Coupon{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
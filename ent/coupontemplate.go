@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupontemplate"
+)
+
+// CouponTemplate is the model entity for the CouponTemplate schema.
+type CouponTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID（门店创建的券仅本门店可用）
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 券名称
+	Name string `json:"name,omitempty"`
+	// 券类型：amount_off（代金券）、discount（折扣券）、free_item（兑换券）
+	Type domain.CouponType `json:"type,omitempty"`
+	// 优惠值（代金券为减免金额，折扣券为减免比例）
+	Value decimal.Decimal `json:"value,omitempty"`
+	// 折扣券最高优惠金额（0 表示不限）
+	MaxDiscount decimal.Decimal `json:"max_discount,omitempty"`
+	// 兑换商品ID（兑换券）
+	ProductID uuid.UUID `json:"product_id,omitempty"`
+	// 使用门槛（0 表示无门槛）
+	MinSpend decimal.Decimal `json:"min_spend,omitempty"`
+	// 适用门店ID列表（为空表示全部门店）
+	StoreIds []uuid.UUID `json:"store_ids,omitempty"`
+	// 有效期类型：fixed（固定日期）、relative（发放后 N 天内有效）
+	ValidityType domain.CouponValidityType `json:"validity_type,omitempty"`
+	// 有效期开始时间
+	ValidFrom *time.Time `json:"valid_from,omitempty"`
+	// 有效期结束时间
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// 有效天数
+	ValidDays int `json:"valid_days,omitempty"`
+	// 发放总量（0 表示不限）
+	TotalQty int `json:"total_qty,omitempty"`
+	// 已发放数量
+	IssuedQty int `json:"issued_qty,omitempty"`
+	// 使用说明
+	Description string `json:"description,omitempty"`
+	// 是否启用
+	Enabled      bool `json:"enabled,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CouponTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coupontemplate.FieldStoreIds:
+			values[i] = new([]byte)
+		case coupontemplate.FieldValue, coupontemplate.FieldMaxDiscount, coupontemplate.FieldMinSpend:
+			values[i] = new(decimal.Decimal)
+		case coupontemplate.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case coupontemplate.FieldDeletedAt, coupontemplate.FieldValidDays, coupontemplate.FieldTotalQty, coupontemplate.FieldIssuedQty:
+			values[i] = new(sql.NullInt64)
+		case coupontemplate.FieldName, coupontemplate.FieldType, coupontemplate.FieldValidityType, coupontemplate.FieldDescription:
+			values[i] = new(sql.NullString)
+		case coupontemplate.FieldCreatedAt, coupontemplate.FieldUpdatedAt, coupontemplate.FieldValidFrom, coupontemplate.FieldValidTo:
+			values[i] = new(sql.NullTime)
+		case coupontemplate.FieldID, coupontemplate.FieldMerchantID, coupontemplate.FieldStoreID, coupontemplate.FieldProductID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CouponTemplate fields.
+func (ct *CouponTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coupontemplate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ct.ID = *value
+			}
+		case coupontemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ct.CreatedAt = value.Time
+			}
+		case coupontemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ct.UpdatedAt = value.Time
+			}
+		case coupontemplate.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ct.DeletedAt = value.Int64
+			}
+		case coupontemplate.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				ct.MerchantID = *value
+			}
+		case coupontemplate.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				ct.StoreID = *value
+			}
+		case coupontemplate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ct.Name = value.String
+			}
+		case coupontemplate.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ct.Type = domain.CouponType(value.String)
+			}
+		case coupontemplate.FieldValue:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value != nil {
+				ct.Value = *value
+			}
+		case coupontemplate.FieldMaxDiscount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field max_discount", values[i])
+			} else if value != nil {
+				ct.MaxDiscount = *value
+			}
+		case coupontemplate.FieldProductID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value != nil {
+				ct.ProductID = *value
+			}
+		case coupontemplate.FieldMinSpend:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field min_spend", values[i])
+			} else if value != nil {
+				ct.MinSpend = *value
+			}
+		case coupontemplate.FieldStoreIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field store_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ct.StoreIds); err != nil {
+					return fmt.Errorf("unmarshal field store_ids: %w", err)
+				}
+			}
+		case coupontemplate.FieldValidityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field validity_type", values[i])
+			} else if value.Valid {
+				ct.ValidityType = domain.CouponValidityType(value.String)
+			}
+		case coupontemplate.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				ct.ValidFrom = new(time.Time)
+				*ct.ValidFrom = value.Time
+			}
+		case coupontemplate.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				ct.ValidTo = new(time.Time)
+				*ct.ValidTo = value.Time
+			}
+		case coupontemplate.FieldValidDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_days", values[i])
+			} else if value.Valid {
+				ct.ValidDays = int(value.Int64)
+			}
+		case coupontemplate.FieldTotalQty:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_qty", values[i])
+			} else if value.Valid {
+				ct.TotalQty = int(value.Int64)
+			}
+		case coupontemplate.FieldIssuedQty:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_qty", values[i])
+			} else if value.Valid {
+				ct.IssuedQty = int(value.Int64)
+			}
+		case coupontemplate.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ct.Description = value.String
+			}
+		case coupontemplate.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				ct.Enabled = value.Bool
+			}
+		default:
+			ct.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the CouponTemplate.
+// This includes values selected through modifiers, order, etc.
+func (ct *CouponTemplate) GetValue(name string) (ent.Value, error) {
+	return ct.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CouponTemplate.
+// Note that you need to call CouponTemplate.Unwrap() before calling this method if this CouponTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ct *CouponTemplate) Update() *CouponTemplateUpdateOne {
+	return NewCouponTemplateClient(ct.config).UpdateOne(ct)
+}
+
+// Unwrap unwraps the CouponTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ct *CouponTemplate) Unwrap() *CouponTemplate {
+	_tx, ok := ct.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CouponTemplate is not a transactional entity")
+	}
+	ct.config.driver = _tx.drv
+	return ct
+}
+
+// String implements the fmt.Stringer.
+func (ct *CouponTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("CouponTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ct.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ct.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ct.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", ct.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ct.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", ct.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ct.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", ct.Type))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", ct.Value))
+	builder.WriteString(", ")
+	builder.WriteString("max_discount=")
+	builder.WriteString(fmt.Sprintf("%v", ct.MaxDiscount))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", ct.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("min_spend=")
+	builder.WriteString(fmt.Sprintf("%v", ct.MinSpend))
+	builder.WriteString(", ")
+	builder.WriteString("store_ids=")
+	builder.WriteString(fmt.Sprintf("%v", ct.StoreIds))
+	builder.WriteString(", ")
+	builder.WriteString("validity_type=")
+	builder.WriteString(fmt.Sprintf("%v", ct.ValidityType))
+	builder.WriteString(", ")
+	if v := ct.ValidFrom; v != nil {
+		builder.WriteString("valid_from=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ct.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("valid_days=")
+	builder.WriteString(fmt.Sprintf("%v", ct.ValidDays))
+	builder.WriteString(", ")
+	builder.WriteString("total_qty=")
+	builder.WriteString(fmt.Sprintf("%v", ct.TotalQty))
+	builder.WriteString(", ")
+	builder.WriteString("issued_qty=")
+	builder.WriteString(fmt.Sprintf("%v", ct.IssuedQty))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ct.Description)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ct.Enabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CouponTemplates is a parsable slice of CouponTemplate.
+type CouponTemplates []*CouponTemplate
@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coupontemplate
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	// Label holds the string label denoting the coupontemplate type in the database.
+	Label = "coupon_template"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldMaxDiscount holds the string denoting the max_discount field in the database.
+	FieldMaxDiscount = "max_discount"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldMinSpend holds the string denoting the min_spend field in the database.
+	FieldMinSpend = "min_spend"
+	// FieldStoreIds holds the string denoting the store_ids field in the database.
+	FieldStoreIds = "store_ids"
+	// FieldValidityType holds the string denoting the validity_type field in the database.
+	FieldValidityType = "validity_type"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidTo holds the string denoting the valid_to field in the database.
+	FieldValidTo = "valid_to"
+	// FieldValidDays holds the string denoting the valid_days field in the database.
+	FieldValidDays = "valid_days"
+	// FieldTotalQty holds the string denoting the total_qty field in the database.
+	FieldTotalQty = "total_qty"
+	// FieldIssuedQty holds the string denoting the issued_qty field in the database.
+	FieldIssuedQty = "issued_qty"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// Table holds the table name of the coupontemplate in the database.
+	Table = "coupon_templates"
+)
+
+// Columns holds all SQL columns for coupontemplate fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldName,
+	FieldType,
+	FieldValue,
+	FieldMaxDiscount,
+	FieldProductID,
+	FieldMinSpend,
+	FieldStoreIds,
+	FieldValidityType,
+	FieldValidFrom,
+	FieldValidTo,
+	FieldValidDays,
+	FieldTotalQty,
+	FieldIssuedQty,
+	FieldDescription,
+	FieldEnabled,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "gitlab.jiguang.dev/pos-dine/dine/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultDeletedAt holds the default value on creation for the "deleted_at" field.
+	DefaultDeletedAt int64
+	// DefaultStoreID holds the default value on creation for the "store_id" field.
+	DefaultStoreID func() uuid.UUID
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultProductID holds the default value on creation for the "product_id" field.
+	DefaultProductID func() uuid.UUID
+	// DefaultValidDays holds the default value on creation for the "valid_days" field.
+	DefaultValidDays int
+	// DefaultTotalQty holds the default value on creation for the "total_qty" field.
+	DefaultTotalQty int
+	// DefaultIssuedQty holds the default value on creation for the "issued_qty" field.
+	DefaultIssuedQty int
+	// DefaultDescription holds the default value on creation for the "description" field.
+	DefaultDescription string
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type domain.CouponType) error {
+	switch _type {
+	case "amount_off", "discount", "free_item":
+		return nil
+	default:
+		return fmt.Errorf("coupontemplate: invalid enum value for type field: %q", _type)
+	}
+}
+
+// ValidityTypeValidator is a validator for the "validity_type" field enum values. It is called by the builders before save.
+func ValidityTypeValidator(vt domain.CouponValidityType) error {
+	switch vt {
+	case "fixed", "relative":
+		return nil
+	default:
+		return fmt.Errorf("coupontemplate: invalid enum value for validity_type field: %q", vt)
+	}
+}
+
+// OrderOption defines the ordering options for the CouponTemplate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByMaxDiscount orders the results by the max_discount field.
+func ByMaxDiscount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDiscount, opts...).ToFunc()
+}
+
+// ByProductID orders the results by the product_id field.
+func ByProductID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductID, opts...).ToFunc()
+}
+
+// ByMinSpend orders the results by the min_spend field.
+func ByMinSpend(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinSpend, opts...).ToFunc()
+}
+
+// ByValidityType orders the results by the validity_type field.
+func ByValidityType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidityType, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidTo orders the results by the valid_to field.
+func ByValidTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidTo, opts...).ToFunc()
+}
+
+// ByValidDays orders the results by the valid_days field.
+func ByValidDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidDays, opts...).ToFunc()
+}
+
+// ByTotalQty orders the results by the total_qty field.
+func ByTotalQty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalQty, opts...).ToFunc()
+}
+
+// ByIssuedQty orders the results by the issued_qty field.
+func ByIssuedQty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuedQty, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
@@ -0,0 +1,948 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coupontemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldStoreID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldValue, v))
+}
+
+// MaxDiscount applies equality check predicate on the "max_discount" field. It's identical to MaxDiscountEQ.
+func MaxDiscount(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldMaxDiscount, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldProductID, v))
+}
+
+// MinSpend applies equality check predicate on the "min_spend" field. It's identical to MinSpendEQ.
+func MinSpend(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldMinSpend, v))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidDays applies equality check predicate on the "valid_days" field. It's identical to ValidDaysEQ.
+func ValidDays(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldValidDays, v))
+}
+
+// TotalQty applies equality check predicate on the "total_qty" field. It's identical to TotalQtyEQ.
+func TotalQty(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldTotalQty, v))
+}
+
+// IssuedQty applies equality check predicate on the "issued_qty" field. It's identical to IssuedQtyEQ.
+func IssuedQty(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldIssuedQty, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldStoreID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldContainsFold(FieldName, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v domain.CouponType) predicate.CouponTemplate {
+	vc := v
+	return predicate.CouponTemplate(sql.FieldEQ(FieldType, vc))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v domain.CouponType) predicate.CouponTemplate {
+	vc := v
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldType, vc))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...domain.CouponType) predicate.CouponTemplate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CouponTemplate(sql.FieldIn(FieldType, v...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...domain.CouponType) predicate.CouponTemplate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldType, v...))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldValue, v))
+}
+
+// MaxDiscountEQ applies the EQ predicate on the "max_discount" field.
+func MaxDiscountEQ(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldMaxDiscount, v))
+}
+
+// MaxDiscountNEQ applies the NEQ predicate on the "max_discount" field.
+func MaxDiscountNEQ(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldMaxDiscount, v))
+}
+
+// MaxDiscountIn applies the In predicate on the "max_discount" field.
+func MaxDiscountIn(vs ...decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldMaxDiscount, vs...))
+}
+
+// MaxDiscountNotIn applies the NotIn predicate on the "max_discount" field.
+func MaxDiscountNotIn(vs ...decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldMaxDiscount, vs...))
+}
+
+// MaxDiscountGT applies the GT predicate on the "max_discount" field.
+func MaxDiscountGT(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldMaxDiscount, v))
+}
+
+// MaxDiscountGTE applies the GTE predicate on the "max_discount" field.
+func MaxDiscountGTE(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldMaxDiscount, v))
+}
+
+// MaxDiscountLT applies the LT predicate on the "max_discount" field.
+func MaxDiscountLT(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldMaxDiscount, v))
+}
+
+// MaxDiscountLTE applies the LTE predicate on the "max_discount" field.
+func MaxDiscountLTE(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldMaxDiscount, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldProductID, v))
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldProductID, v))
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldProductID, v))
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v uuid.UUID) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldProductID, v))
+}
+
+// MinSpendEQ applies the EQ predicate on the "min_spend" field.
+func MinSpendEQ(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldMinSpend, v))
+}
+
+// MinSpendNEQ applies the NEQ predicate on the "min_spend" field.
+func MinSpendNEQ(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldMinSpend, v))
+}
+
+// MinSpendIn applies the In predicate on the "min_spend" field.
+func MinSpendIn(vs ...decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldMinSpend, vs...))
+}
+
+// MinSpendNotIn applies the NotIn predicate on the "min_spend" field.
+func MinSpendNotIn(vs ...decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldMinSpend, vs...))
+}
+
+// MinSpendGT applies the GT predicate on the "min_spend" field.
+func MinSpendGT(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldMinSpend, v))
+}
+
+// MinSpendGTE applies the GTE predicate on the "min_spend" field.
+func MinSpendGTE(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldMinSpend, v))
+}
+
+// MinSpendLT applies the LT predicate on the "min_spend" field.
+func MinSpendLT(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldMinSpend, v))
+}
+
+// MinSpendLTE applies the LTE predicate on the "min_spend" field.
+func MinSpendLTE(v decimal.Decimal) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldMinSpend, v))
+}
+
+// StoreIdsIsNil applies the IsNil predicate on the "store_ids" field.
+func StoreIdsIsNil() predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIsNull(FieldStoreIds))
+}
+
+// StoreIdsNotNil applies the NotNil predicate on the "store_ids" field.
+func StoreIdsNotNil() predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotNull(FieldStoreIds))
+}
+
+// ValidityTypeEQ applies the EQ predicate on the "validity_type" field.
+func ValidityTypeEQ(v domain.CouponValidityType) predicate.CouponTemplate {
+	vc := v
+	return predicate.CouponTemplate(sql.FieldEQ(FieldValidityType, vc))
+}
+
+// ValidityTypeNEQ applies the NEQ predicate on the "validity_type" field.
+func ValidityTypeNEQ(v domain.CouponValidityType) predicate.CouponTemplate {
+	vc := v
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldValidityType, vc))
+}
+
+// ValidityTypeIn applies the In predicate on the "validity_type" field.
+func ValidityTypeIn(vs ...domain.CouponValidityType) predicate.CouponTemplate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CouponTemplate(sql.FieldIn(FieldValidityType, v...))
+}
+
+// ValidityTypeNotIn applies the NotIn predicate on the "validity_type" field.
+func ValidityTypeNotIn(vs ...domain.CouponValidityType) predicate.CouponTemplate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldValidityType, v...))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidFromIsNil applies the IsNil predicate on the "valid_from" field.
+func ValidFromIsNil() predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIsNull(FieldValidFrom))
+}
+
+// ValidFromNotNil applies the NotNil predicate on the "valid_from" field.
+func ValidFromNotNil() predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotNull(FieldValidFrom))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotNull(FieldValidTo))
+}
+
+// ValidDaysEQ applies the EQ predicate on the "valid_days" field.
+func ValidDaysEQ(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldValidDays, v))
+}
+
+// ValidDaysNEQ applies the NEQ predicate on the "valid_days" field.
+func ValidDaysNEQ(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldValidDays, v))
+}
+
+// ValidDaysIn applies the In predicate on the "valid_days" field.
+func ValidDaysIn(vs ...int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldValidDays, vs...))
+}
+
+// ValidDaysNotIn applies the NotIn predicate on the "valid_days" field.
+func ValidDaysNotIn(vs ...int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldValidDays, vs...))
+}
+
+// ValidDaysGT applies the GT predicate on the "valid_days" field.
+func ValidDaysGT(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldValidDays, v))
+}
+
+// ValidDaysGTE applies the GTE predicate on the "valid_days" field.
+func ValidDaysGTE(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldValidDays, v))
+}
+
+// ValidDaysLT applies the LT predicate on the "valid_days" field.
+func ValidDaysLT(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldValidDays, v))
+}
+
+// ValidDaysLTE applies the LTE predicate on the "valid_days" field.
+func ValidDaysLTE(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldValidDays, v))
+}
+
+// TotalQtyEQ applies the EQ predicate on the "total_qty" field.
+func TotalQtyEQ(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldTotalQty, v))
+}
+
+// TotalQtyNEQ applies the NEQ predicate on the "total_qty" field.
+func TotalQtyNEQ(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldTotalQty, v))
+}
+
+// TotalQtyIn applies the In predicate on the "total_qty" field.
+func TotalQtyIn(vs ...int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldTotalQty, vs...))
+}
+
+// TotalQtyNotIn applies the NotIn predicate on the "total_qty" field.
+func TotalQtyNotIn(vs ...int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldTotalQty, vs...))
+}
+
+// TotalQtyGT applies the GT predicate on the "total_qty" field.
+func TotalQtyGT(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldTotalQty, v))
+}
+
+// TotalQtyGTE applies the GTE predicate on the "total_qty" field.
+func TotalQtyGTE(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldTotalQty, v))
+}
+
+// TotalQtyLT applies the LT predicate on the "total_qty" field.
+func TotalQtyLT(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldTotalQty, v))
+}
+
+// TotalQtyLTE applies the LTE predicate on the "total_qty" field.
+func TotalQtyLTE(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldTotalQty, v))
+}
+
+// IssuedQtyEQ applies the EQ predicate on the "issued_qty" field.
+func IssuedQtyEQ(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldIssuedQty, v))
+}
+
+// IssuedQtyNEQ applies the NEQ predicate on the "issued_qty" field.
+func IssuedQtyNEQ(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldIssuedQty, v))
+}
+
+// IssuedQtyIn applies the In predicate on the "issued_qty" field.
+func IssuedQtyIn(vs ...int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldIssuedQty, vs...))
+}
+
+// IssuedQtyNotIn applies the NotIn predicate on the "issued_qty" field.
+func IssuedQtyNotIn(vs ...int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldIssuedQty, vs...))
+}
+
+// IssuedQtyGT applies the GT predicate on the "issued_qty" field.
+func IssuedQtyGT(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldIssuedQty, v))
+}
+
+// IssuedQtyGTE applies the GTE predicate on the "issued_qty" field.
+func IssuedQtyGTE(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldIssuedQty, v))
+}
+
+// IssuedQtyLT applies the LT predicate on the "issued_qty" field.
+func IssuedQtyLT(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldIssuedQty, v))
+}
+
+// IssuedQtyLTE applies the LTE predicate on the "issued_qty" field.
+func IssuedQtyLTE(v int) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldIssuedQty, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CouponTemplate) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CouponTemplate) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CouponTemplate) predicate.CouponTemplate {
+	return predicate.CouponTemplate(sql.NotPredicates(p))
+}
@@ -0,0 +1,1721 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupontemplate"
+)
+
+// CouponTemplateCreate is the builder for creating a CouponTemplate entity.
+type CouponTemplateCreate struct {
+	config
+	mutation *CouponTemplateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ctc *CouponTemplateCreate) SetCreatedAt(t time.Time) *CouponTemplateCreate {
+	ctc.mutation.SetCreatedAt(t)
+	return ctc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableCreatedAt(t *time.Time) *CouponTemplateCreate {
+	if t != nil {
+		ctc.SetCreatedAt(*t)
+	}
+	return ctc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ctc *CouponTemplateCreate) SetUpdatedAt(t time.Time) *CouponTemplateCreate {
+	ctc.mutation.SetUpdatedAt(t)
+	return ctc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableUpdatedAt(t *time.Time) *CouponTemplateCreate {
+	if t != nil {
+		ctc.SetUpdatedAt(*t)
+	}
+	return ctc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ctc *CouponTemplateCreate) SetDeletedAt(i int64) *CouponTemplateCreate {
+	ctc.mutation.SetDeletedAt(i)
+	return ctc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableDeletedAt(i *int64) *CouponTemplateCreate {
+	if i != nil {
+		ctc.SetDeletedAt(*i)
+	}
+	return ctc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (ctc *CouponTemplateCreate) SetMerchantID(u uuid.UUID) *CouponTemplateCreate {
+	ctc.mutation.SetMerchantID(u)
+	return ctc
+}
+
+// SetStoreID sets the "store_id" field.
+func (ctc *CouponTemplateCreate) SetStoreID(u uuid.UUID) *CouponTemplateCreate {
+	ctc.mutation.SetStoreID(u)
+	return ctc
+}
+
+// SetNillableStoreID sets the "store_id" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableStoreID(u *uuid.UUID) *CouponTemplateCreate {
+	if u != nil {
+		ctc.SetStoreID(*u)
+	}
+	return ctc
+}
+
+// SetName sets the "name" field.
+func (ctc *CouponTemplateCreate) SetName(s string) *CouponTemplateCreate {
+	ctc.mutation.SetName(s)
+	return ctc
+}
+
+// SetType sets the "type" field.
+func (ctc *CouponTemplateCreate) SetType(dt domain.CouponType) *CouponTemplateCreate {
+	ctc.mutation.SetType(dt)
+	return ctc
+}
+
+// SetValue sets the "value" field.
+func (ctc *CouponTemplateCreate) SetValue(d decimal.Decimal) *CouponTemplateCreate {
+	ctc.mutation.SetValue(d)
+	return ctc
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (ctc *CouponTemplateCreate) SetMaxDiscount(d decimal.Decimal) *CouponTemplateCreate {
+	ctc.mutation.SetMaxDiscount(d)
+	return ctc
+}
+
+// SetProductID sets the "product_id" field.
+func (ctc *CouponTemplateCreate) SetProductID(u uuid.UUID) *CouponTemplateCreate {
+	ctc.mutation.SetProductID(u)
+	return ctc
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableProductID(u *uuid.UUID) *CouponTemplateCreate {
+	if u != nil {
+		ctc.SetProductID(*u)
+	}
+	return ctc
+}
+
+// SetMinSpend sets the "min_spend" field.
+func (ctc *CouponTemplateCreate) SetMinSpend(d decimal.Decimal) *CouponTemplateCreate {
+	ctc.mutation.SetMinSpend(d)
+	return ctc
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (ctc *CouponTemplateCreate) SetStoreIds(u []uuid.UUID) *CouponTemplateCreate {
+	ctc.mutation.SetStoreIds(u)
+	return ctc
+}
+
+// SetValidityType sets the "validity_type" field.
+func (ctc *CouponTemplateCreate) SetValidityType(dvt domain.CouponValidityType) *CouponTemplateCreate {
+	ctc.mutation.SetValidityType(dvt)
+	return ctc
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (ctc *CouponTemplateCreate) SetValidFrom(t time.Time) *CouponTemplateCreate {
+	ctc.mutation.SetValidFrom(t)
+	return ctc
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableValidFrom(t *time.Time) *CouponTemplateCreate {
+	if t != nil {
+		ctc.SetValidFrom(*t)
+	}
+	return ctc
+}
+
+// SetValidTo sets the "valid_to" field.
+func (ctc *CouponTemplateCreate) SetValidTo(t time.Time) *CouponTemplateCreate {
+	ctc.mutation.SetValidTo(t)
+	return ctc
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableValidTo(t *time.Time) *CouponTemplateCreate {
+	if t != nil {
+		ctc.SetValidTo(*t)
+	}
+	return ctc
+}
+
+// SetValidDays sets the "valid_days" field.
+func (ctc *CouponTemplateCreate) SetValidDays(i int) *CouponTemplateCreate {
+	ctc.mutation.SetValidDays(i)
+	return ctc
+}
+
+// SetNillableValidDays sets the "valid_days" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableValidDays(i *int) *CouponTemplateCreate {
+	if i != nil {
+		ctc.SetValidDays(*i)
+	}
+	return ctc
+}
+
+// SetTotalQty sets the "total_qty" field.
+func (ctc *CouponTemplateCreate) SetTotalQty(i int) *CouponTemplateCreate {
+	ctc.mutation.SetTotalQty(i)
+	return ctc
+}
+
+// SetNillableTotalQty sets the "total_qty" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableTotalQty(i *int) *CouponTemplateCreate {
+	if i != nil {
+		ctc.SetTotalQty(*i)
+	}
+	return ctc
+}
+
+// SetIssuedQty sets the "issued_qty" field.
+func (ctc *CouponTemplateCreate) SetIssuedQty(i int) *CouponTemplateCreate {
+	ctc.mutation.SetIssuedQty(i)
+	return ctc
+}
+
+// SetNillableIssuedQty sets the "issued_qty" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableIssuedQty(i *int) *CouponTemplateCreate {
+	if i != nil {
+		ctc.SetIssuedQty(*i)
+	}
+	return ctc
+}
+
+// SetDescription sets the "description" field.
+func (ctc *CouponTemplateCreate) SetDescription(s string) *CouponTemplateCreate {
+	ctc.mutation.SetDescription(s)
+	return ctc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableDescription(s *string) *CouponTemplateCreate {
+	if s != nil {
+		ctc.SetDescription(*s)
+	}
+	return ctc
+}
+
+// SetEnabled sets the "enabled" field.
+func (ctc *CouponTemplateCreate) SetEnabled(b bool) *CouponTemplateCreate {
+	ctc.mutation.SetEnabled(b)
+	return ctc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableEnabled(b *bool) *CouponTemplateCreate {
+	if b != nil {
+		ctc.SetEnabled(*b)
+	}
+	return ctc
+}
+
+// SetID sets the "id" field.
+func (ctc *CouponTemplateCreate) SetID(u uuid.UUID) *CouponTemplateCreate {
+	ctc.mutation.SetID(u)
+	return ctc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ctc *CouponTemplateCreate) SetNillableID(u *uuid.UUID) *CouponTemplateCreate {
+	if u != nil {
+		ctc.SetID(*u)
+	}
+	return ctc
+}
+
+// Mutation returns the CouponTemplateMutation object of the builder.
+func (ctc *CouponTemplateCreate) Mutation() *CouponTemplateMutation {
+	return ctc.mutation
+}
+
+// Save creates the CouponTemplate in the database.
+func (ctc *CouponTemplateCreate) Save(ctx context.Context) (*CouponTemplate, error) {
+	if err := ctc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ctc.sqlSave, ctc.mutation, ctc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ctc *CouponTemplateCreate) SaveX(ctx context.Context) *CouponTemplate {
+	v, err := ctc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctc *CouponTemplateCreate) Exec(ctx context.Context) error {
+	_, err := ctc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctc *CouponTemplateCreate) ExecX(ctx context.Context) {
+	if err := ctc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ctc *CouponTemplateCreate) defaults() error {
+	if _, ok := ctc.mutation.CreatedAt(); !ok {
+		if coupontemplate.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coupontemplate.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := coupontemplate.DefaultCreatedAt()
+		ctc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ctc.mutation.UpdatedAt(); !ok {
+		if coupontemplate.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coupontemplate.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coupontemplate.DefaultUpdatedAt()
+		ctc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ctc.mutation.DeletedAt(); !ok {
+		v := coupontemplate.DefaultDeletedAt
+		ctc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := ctc.mutation.StoreID(); !ok {
+		if coupontemplate.DefaultStoreID == nil {
+			return fmt.Errorf("ent: uninitialized coupontemplate.DefaultStoreID (forgotten import ent/runtime?)")
+		}
+		v := coupontemplate.DefaultStoreID()
+		ctc.mutation.SetStoreID(v)
+	}
+	if _, ok := ctc.mutation.ProductID(); !ok {
+		if coupontemplate.DefaultProductID == nil {
+			return fmt.Errorf("ent: uninitialized coupontemplate.DefaultProductID (forgotten import ent/runtime?)")
+		}
+		v := coupontemplate.DefaultProductID()
+		ctc.mutation.SetProductID(v)
+	}
+	if _, ok := ctc.mutation.ValidDays(); !ok {
+		v := coupontemplate.DefaultValidDays
+		ctc.mutation.SetValidDays(v)
+	}
+	if _, ok := ctc.mutation.TotalQty(); !ok {
+		v := coupontemplate.DefaultTotalQty
+		ctc.mutation.SetTotalQty(v)
+	}
+	if _, ok := ctc.mutation.IssuedQty(); !ok {
+		v := coupontemplate.DefaultIssuedQty
+		ctc.mutation.SetIssuedQty(v)
+	}
+	if _, ok := ctc.mutation.Description(); !ok {
+		v := coupontemplate.DefaultDescription
+		ctc.mutation.SetDescription(v)
+	}
+	if _, ok := ctc.mutation.Enabled(); !ok {
+		v := coupontemplate.DefaultEnabled
+		ctc.mutation.SetEnabled(v)
+	}
+	if _, ok := ctc.mutation.ID(); !ok {
+		if coupontemplate.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized coupontemplate.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := coupontemplate.DefaultID()
+		ctc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctc *CouponTemplateCreate) check() error {
+	if _, ok := ctc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CouponTemplate.created_at"`)}
+	}
+	if _, ok := ctc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CouponTemplate.updated_at"`)}
+	}
+	if _, ok := ctc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "CouponTemplate.deleted_at"`)}
+	}
+	if _, ok := ctc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "CouponTemplate.merchant_id"`)}
+	}
+	if _, ok := ctc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "CouponTemplate.store_id"`)}
+	}
+	if _, ok := ctc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CouponTemplate.name"`)}
+	}
+	if v, ok := ctc.mutation.Name(); ok {
+		if err := coupontemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "CouponTemplate.type"`)}
+	}
+	if v, ok := ctc.mutation.GetType(); ok {
+		if err := coupontemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.type": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "CouponTemplate.value"`)}
+	}
+	if _, ok := ctc.mutation.MaxDiscount(); !ok {
+		return &ValidationError{Name: "max_discount", err: errors.New(`ent: missing required field "CouponTemplate.max_discount"`)}
+	}
+	if _, ok := ctc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "CouponTemplate.product_id"`)}
+	}
+	if _, ok := ctc.mutation.MinSpend(); !ok {
+		return &ValidationError{Name: "min_spend", err: errors.New(`ent: missing required field "CouponTemplate.min_spend"`)}
+	}
+	if _, ok := ctc.mutation.ValidityType(); !ok {
+		return &ValidationError{Name: "validity_type", err: errors.New(`ent: missing required field "CouponTemplate.validity_type"`)}
+	}
+	if v, ok := ctc.mutation.ValidityType(); ok {
+		if err := coupontemplate.ValidityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "validity_type", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.validity_type": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.ValidDays(); !ok {
+		return &ValidationError{Name: "valid_days", err: errors.New(`ent: missing required field "CouponTemplate.valid_days"`)}
+	}
+	if _, ok := ctc.mutation.TotalQty(); !ok {
+		return &ValidationError{Name: "total_qty", err: errors.New(`ent: missing required field "CouponTemplate.total_qty"`)}
+	}
+	if _, ok := ctc.mutation.IssuedQty(); !ok {
+		return &ValidationError{Name: "issued_qty", err: errors.New(`ent: missing required field "CouponTemplate.issued_qty"`)}
+	}
+	if _, ok := ctc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "CouponTemplate.description"`)}
+	}
+	if v, ok := ctc.mutation.Description(); ok {
+		if err := coupontemplate.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.description": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "CouponTemplate.enabled"`)}
+	}
+	return nil
+}
+
+func (ctc *CouponTemplateCreate) sqlSave(ctx context.Context) (*CouponTemplate, error) {
+	if err := ctc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ctc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ctc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ctc.mutation.id = &_node.ID
+	ctc.mutation.done = true
+	return _node, nil
+}
+
+func (ctc *CouponTemplateCreate) createSpec() (*CouponTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CouponTemplate{config: ctc.config}
+		_spec = sqlgraph.NewCreateSpec(coupontemplate.Table, sqlgraph.NewFieldSpec(coupontemplate.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ctc.conflict
+	if id, ok := ctc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ctc.mutation.CreatedAt(); ok {
+		_spec.SetField(coupontemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ctc.mutation.UpdatedAt(); ok {
+		_spec.SetField(coupontemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ctc.mutation.DeletedAt(); ok {
+		_spec.SetField(coupontemplate.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := ctc.mutation.MerchantID(); ok {
+		_spec.SetField(coupontemplate.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := ctc.mutation.StoreID(); ok {
+		_spec.SetField(coupontemplate.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := ctc.mutation.Name(); ok {
+		_spec.SetField(coupontemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ctc.mutation.GetType(); ok {
+		_spec.SetField(coupontemplate.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := ctc.mutation.Value(); ok {
+		_spec.SetField(coupontemplate.FieldValue, field.TypeOther, value)
+		_node.Value = value
+	}
+	if value, ok := ctc.mutation.MaxDiscount(); ok {
+		_spec.SetField(coupontemplate.FieldMaxDiscount, field.TypeOther, value)
+		_node.MaxDiscount = value
+	}
+	if value, ok := ctc.mutation.ProductID(); ok {
+		_spec.SetField(coupontemplate.FieldProductID, field.TypeUUID, value)
+		_node.ProductID = value
+	}
+	if value, ok := ctc.mutation.MinSpend(); ok {
+		_spec.SetField(coupontemplate.FieldMinSpend, field.TypeOther, value)
+		_node.MinSpend = value
+	}
+	if value, ok := ctc.mutation.StoreIds(); ok {
+		_spec.SetField(coupontemplate.FieldStoreIds, field.TypeJSON, value)
+		_node.StoreIds = value
+	}
+	if value, ok := ctc.mutation.ValidityType(); ok {
+		_spec.SetField(coupontemplate.FieldValidityType, field.TypeEnum, value)
+		_node.ValidityType = value
+	}
+	if value, ok := ctc.mutation.ValidFrom(); ok {
+		_spec.SetField(coupontemplate.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = &value
+	}
+	if value, ok := ctc.mutation.ValidTo(); ok {
+		_spec.SetField(coupontemplate.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := ctc.mutation.ValidDays(); ok {
+		_spec.SetField(coupontemplate.FieldValidDays, field.TypeInt, value)
+		_node.ValidDays = value
+	}
+	if value, ok := ctc.mutation.TotalQty(); ok {
+		_spec.SetField(coupontemplate.FieldTotalQty, field.TypeInt, value)
+		_node.TotalQty = value
+	}
+	if value, ok := ctc.mutation.IssuedQty(); ok {
+		_spec.SetField(coupontemplate.FieldIssuedQty, field.TypeInt, value)
+		_node.IssuedQty = value
+	}
+	if value, ok := ctc.mutation.Description(); ok {
+		_spec.SetField(coupontemplate.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := ctc.mutation.Enabled(); ok {
+		_spec.SetField(coupontemplate.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CouponTemplate.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CouponTemplateUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ctc *CouponTemplateCreate) OnConflict(opts ...sql.ConflictOption) *CouponTemplateUpsertOne {
+	ctc.conflict = opts
+	return &CouponTemplateUpsertOne{
+		create: ctc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CouponTemplate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ctc *CouponTemplateCreate) OnConflictColumns(columns ...string) *CouponTemplateUpsertOne {
+	ctc.conflict = append(ctc.conflict, sql.ConflictColumns(columns...))
+	return &CouponTemplateUpsertOne{
+		create: ctc,
+	}
+}
+
+type (
+	// CouponTemplateUpsertOne is the builder for "upsert"-ing
+	//  one CouponTemplate node.
+	CouponTemplateUpsertOne struct {
+		create *CouponTemplateCreate
+	}
+
+	// CouponTemplateUpsert is the "OnConflict" setter.
+	CouponTemplateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CouponTemplateUpsert) SetUpdatedAt(v time.Time) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateUpdatedAt() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CouponTemplateUpsert) SetDeletedAt(v int64) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateDeletedAt() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *CouponTemplateUpsert) AddDeletedAt(v int64) *CouponTemplateUpsert {
+	u.Add(coupontemplate.FieldDeletedAt, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CouponTemplateUpsert) SetName(v string) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateName() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldName)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *CouponTemplateUpsert) SetType(v domain.CouponType) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateType() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldType)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *CouponTemplateUpsert) SetValue(v decimal.Decimal) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateValue() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldValue)
+	return u
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (u *CouponTemplateUpsert) SetMaxDiscount(v decimal.Decimal) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldMaxDiscount, v)
+	return u
+}
+
+// UpdateMaxDiscount sets the "max_discount" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateMaxDiscount() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldMaxDiscount)
+	return u
+}
+
+// SetProductID sets the "product_id" field.
+func (u *CouponTemplateUpsert) SetProductID(v uuid.UUID) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldProductID, v)
+	return u
+}
+
+// UpdateProductID sets the "product_id" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateProductID() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldProductID)
+	return u
+}
+
+// SetMinSpend sets the "min_spend" field.
+func (u *CouponTemplateUpsert) SetMinSpend(v decimal.Decimal) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldMinSpend, v)
+	return u
+}
+
+// UpdateMinSpend sets the "min_spend" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateMinSpend() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldMinSpend)
+	return u
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *CouponTemplateUpsert) SetStoreIds(v []uuid.UUID) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldStoreIds, v)
+	return u
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateStoreIds() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldStoreIds)
+	return u
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *CouponTemplateUpsert) ClearStoreIds() *CouponTemplateUpsert {
+	u.SetNull(coupontemplate.FieldStoreIds)
+	return u
+}
+
+// SetValidityType sets the "validity_type" field.
+func (u *CouponTemplateUpsert) SetValidityType(v domain.CouponValidityType) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldValidityType, v)
+	return u
+}
+
+// UpdateValidityType sets the "validity_type" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateValidityType() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldValidityType)
+	return u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (u *CouponTemplateUpsert) SetValidFrom(v time.Time) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldValidFrom, v)
+	return u
+}
+
+// UpdateValidFrom sets the "valid_from" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateValidFrom() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldValidFrom)
+	return u
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (u *CouponTemplateUpsert) ClearValidFrom() *CouponTemplateUpsert {
+	u.SetNull(coupontemplate.FieldValidFrom)
+	return u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (u *CouponTemplateUpsert) SetValidTo(v time.Time) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldValidTo, v)
+	return u
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateValidTo() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldValidTo)
+	return u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (u *CouponTemplateUpsert) ClearValidTo() *CouponTemplateUpsert {
+	u.SetNull(coupontemplate.FieldValidTo)
+	return u
+}
+
+// SetValidDays sets the "valid_days" field.
+func (u *CouponTemplateUpsert) SetValidDays(v int) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldValidDays, v)
+	return u
+}
+
+// UpdateValidDays sets the "valid_days" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateValidDays() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldValidDays)
+	return u
+}
+
+// AddValidDays adds v to the "valid_days" field.
+func (u *CouponTemplateUpsert) AddValidDays(v int) *CouponTemplateUpsert {
+	u.Add(coupontemplate.FieldValidDays, v)
+	return u
+}
+
+// SetTotalQty sets the "total_qty" field.
+func (u *CouponTemplateUpsert) SetTotalQty(v int) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldTotalQty, v)
+	return u
+}
+
+// UpdateTotalQty sets the "total_qty" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateTotalQty() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldTotalQty)
+	return u
+}
+
+// AddTotalQty adds v to the "total_qty" field.
+func (u *CouponTemplateUpsert) AddTotalQty(v int) *CouponTemplateUpsert {
+	u.Add(coupontemplate.FieldTotalQty, v)
+	return u
+}
+
+// SetIssuedQty sets the "issued_qty" field.
+func (u *CouponTemplateUpsert) SetIssuedQty(v int) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldIssuedQty, v)
+	return u
+}
+
+// UpdateIssuedQty sets the "issued_qty" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateIssuedQty() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldIssuedQty)
+	return u
+}
+
+// AddIssuedQty adds v to the "issued_qty" field.
+func (u *CouponTemplateUpsert) AddIssuedQty(v int) *CouponTemplateUpsert {
+	u.Add(coupontemplate.FieldIssuedQty, v)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *CouponTemplateUpsert) SetDescription(v string) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateDescription() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldDescription)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CouponTemplateUpsert) SetEnabled(v bool) *CouponTemplateUpsert {
+	u.Set(coupontemplate.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CouponTemplateUpsert) UpdateEnabled() *CouponTemplateUpsert {
+	u.SetExcluded(coupontemplate.FieldEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CouponTemplate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coupontemplate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CouponTemplateUpsertOne) UpdateNewValues() *CouponTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(coupontemplate.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(coupontemplate.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(coupontemplate.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(coupontemplate.FieldStoreID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CouponTemplate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CouponTemplateUpsertOne) Ignore() *CouponTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CouponTemplateUpsertOne) DoNothing() *CouponTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CouponTemplateCreate.OnConflict
+// documentation for more info.
+func (u *CouponTemplateUpsertOne) Update(set func(*CouponTemplateUpsert)) *CouponTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CouponTemplateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CouponTemplateUpsertOne) SetUpdatedAt(v time.Time) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateUpdatedAt() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CouponTemplateUpsertOne) SetDeletedAt(v int64) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *CouponTemplateUpsertOne) AddDeletedAt(v int64) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateDeletedAt() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CouponTemplateUpsertOne) SetName(v string) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateName() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *CouponTemplateUpsertOne) SetType(v domain.CouponType) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateType() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *CouponTemplateUpsertOne) SetValue(v decimal.Decimal) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateValue() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (u *CouponTemplateUpsertOne) SetMaxDiscount(v decimal.Decimal) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetMaxDiscount(v)
+	})
+}
+
+// UpdateMaxDiscount sets the "max_discount" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateMaxDiscount() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateMaxDiscount()
+	})
+}
+
+// SetProductID sets the "product_id" field.
+func (u *CouponTemplateUpsertOne) SetProductID(v uuid.UUID) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetProductID(v)
+	})
+}
+
+// UpdateProductID sets the "product_id" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateProductID() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateProductID()
+	})
+}
+
+// SetMinSpend sets the "min_spend" field.
+func (u *CouponTemplateUpsertOne) SetMinSpend(v decimal.Decimal) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetMinSpend(v)
+	})
+}
+
+// UpdateMinSpend sets the "min_spend" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateMinSpend() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateMinSpend()
+	})
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *CouponTemplateUpsertOne) SetStoreIds(v []uuid.UUID) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetStoreIds(v)
+	})
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateStoreIds() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateStoreIds()
+	})
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *CouponTemplateUpsertOne) ClearStoreIds() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.ClearStoreIds()
+	})
+}
+
+// SetValidityType sets the "validity_type" field.
+func (u *CouponTemplateUpsertOne) SetValidityType(v domain.CouponValidityType) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetValidityType(v)
+	})
+}
+
+// UpdateValidityType sets the "validity_type" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateValidityType() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateValidityType()
+	})
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (u *CouponTemplateUpsertOne) SetValidFrom(v time.Time) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetValidFrom(v)
+	})
+}
+
+// UpdateValidFrom sets the "valid_from" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateValidFrom() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateValidFrom()
+	})
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (u *CouponTemplateUpsertOne) ClearValidFrom() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.ClearValidFrom()
+	})
+}
+
+// SetValidTo sets the "valid_to" field.
+func (u *CouponTemplateUpsertOne) SetValidTo(v time.Time) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetValidTo(v)
+	})
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateValidTo() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateValidTo()
+	})
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (u *CouponTemplateUpsertOne) ClearValidTo() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.ClearValidTo()
+	})
+}
+
+// SetValidDays sets the "valid_days" field.
+func (u *CouponTemplateUpsertOne) SetValidDays(v int) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetValidDays(v)
+	})
+}
+
+// AddValidDays adds v to the "valid_days" field.
+func (u *CouponTemplateUpsertOne) AddValidDays(v int) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.AddValidDays(v)
+	})
+}
+
+// UpdateValidDays sets the "valid_days" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateValidDays() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateValidDays()
+	})
+}
+
+// SetTotalQty sets the "total_qty" field.
+func (u *CouponTemplateUpsertOne) SetTotalQty(v int) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetTotalQty(v)
+	})
+}
+
+// AddTotalQty adds v to the "total_qty" field.
+func (u *CouponTemplateUpsertOne) AddTotalQty(v int) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.AddTotalQty(v)
+	})
+}
+
+// UpdateTotalQty sets the "total_qty" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateTotalQty() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateTotalQty()
+	})
+}
+
+// SetIssuedQty sets the "issued_qty" field.
+func (u *CouponTemplateUpsertOne) SetIssuedQty(v int) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetIssuedQty(v)
+	})
+}
+
+// AddIssuedQty adds v to the "issued_qty" field.
+func (u *CouponTemplateUpsertOne) AddIssuedQty(v int) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.AddIssuedQty(v)
+	})
+}
+
+// UpdateIssuedQty sets the "issued_qty" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateIssuedQty() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateIssuedQty()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CouponTemplateUpsertOne) SetDescription(v string) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateDescription() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CouponTemplateUpsertOne) SetEnabled(v bool) *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CouponTemplateUpsertOne) UpdateEnabled() *CouponTemplateUpsertOne {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *CouponTemplateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CouponTemplateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CouponTemplateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CouponTemplateUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CouponTemplateUpsertOne.ID is not supported by MySQL driver. Use CouponTemplateUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CouponTemplateUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CouponTemplateCreateBulk is the builder for creating many CouponTemplate entities in bulk.
+type CouponTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*CouponTemplateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CouponTemplate entities in the database.
+func (ctcb *CouponTemplateCreateBulk) Save(ctx context.Context) ([]*CouponTemplate, error) {
+	if ctcb.err != nil {
+		return nil, ctcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ctcb.builders))
+	nodes := make([]*CouponTemplate, len(ctcb.builders))
+	mutators := make([]Mutator, len(ctcb.builders))
+	for i := range ctcb.builders {
+		func(i int, root context.Context) {
+			builder := ctcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CouponTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ctcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ctcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ctcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ctcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctcb *CouponTemplateCreateBulk) SaveX(ctx context.Context) []*CouponTemplate {
+	v, err := ctcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctcb *CouponTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ctcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctcb *CouponTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := ctcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CouponTemplate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CouponTemplateUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ctcb *CouponTemplateCreateBulk) OnConflict(opts ...sql.ConflictOption) *CouponTemplateUpsertBulk {
+	ctcb.conflict = opts
+	return &CouponTemplateUpsertBulk{
+		create: ctcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CouponTemplate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ctcb *CouponTemplateCreateBulk) OnConflictColumns(columns ...string) *CouponTemplateUpsertBulk {
+	ctcb.conflict = append(ctcb.conflict, sql.ConflictColumns(columns...))
+	return &CouponTemplateUpsertBulk{
+		create: ctcb,
+	}
+}
+
+// CouponTemplateUpsertBulk is the builder for "upsert"-ing
+// a bulk of CouponTemplate nodes.
+type CouponTemplateUpsertBulk struct {
+	create *CouponTemplateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CouponTemplate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coupontemplate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CouponTemplateUpsertBulk) UpdateNewValues() *CouponTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(coupontemplate.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(coupontemplate.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(coupontemplate.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(coupontemplate.FieldStoreID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CouponTemplate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CouponTemplateUpsertBulk) Ignore() *CouponTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CouponTemplateUpsertBulk) DoNothing() *CouponTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CouponTemplateCreateBulk.OnConflict
+// documentation for more info.
+func (u *CouponTemplateUpsertBulk) Update(set func(*CouponTemplateUpsert)) *CouponTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CouponTemplateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CouponTemplateUpsertBulk) SetUpdatedAt(v time.Time) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateUpdatedAt() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CouponTemplateUpsertBulk) SetDeletedAt(v int64) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *CouponTemplateUpsertBulk) AddDeletedAt(v int64) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateDeletedAt() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CouponTemplateUpsertBulk) SetName(v string) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateName() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *CouponTemplateUpsertBulk) SetType(v domain.CouponType) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateType() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *CouponTemplateUpsertBulk) SetValue(v decimal.Decimal) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateValue() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (u *CouponTemplateUpsertBulk) SetMaxDiscount(v decimal.Decimal) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetMaxDiscount(v)
+	})
+}
+
+// UpdateMaxDiscount sets the "max_discount" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateMaxDiscount() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateMaxDiscount()
+	})
+}
+
+// SetProductID sets the "product_id" field.
+func (u *CouponTemplateUpsertBulk) SetProductID(v uuid.UUID) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetProductID(v)
+	})
+}
+
+// UpdateProductID sets the "product_id" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateProductID() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateProductID()
+	})
+}
+
+// SetMinSpend sets the "min_spend" field.
+func (u *CouponTemplateUpsertBulk) SetMinSpend(v decimal.Decimal) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetMinSpend(v)
+	})
+}
+
+// UpdateMinSpend sets the "min_spend" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateMinSpend() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateMinSpend()
+	})
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *CouponTemplateUpsertBulk) SetStoreIds(v []uuid.UUID) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetStoreIds(v)
+	})
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateStoreIds() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateStoreIds()
+	})
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *CouponTemplateUpsertBulk) ClearStoreIds() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.ClearStoreIds()
+	})
+}
+
+// SetValidityType sets the "validity_type" field.
+func (u *CouponTemplateUpsertBulk) SetValidityType(v domain.CouponValidityType) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetValidityType(v)
+	})
+}
+
+// UpdateValidityType sets the "validity_type" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateValidityType() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateValidityType()
+	})
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (u *CouponTemplateUpsertBulk) SetValidFrom(v time.Time) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetValidFrom(v)
+	})
+}
+
+// UpdateValidFrom sets the "valid_from" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateValidFrom() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateValidFrom()
+	})
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (u *CouponTemplateUpsertBulk) ClearValidFrom() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.ClearValidFrom()
+	})
+}
+
+// SetValidTo sets the "valid_to" field.
+func (u *CouponTemplateUpsertBulk) SetValidTo(v time.Time) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetValidTo(v)
+	})
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateValidTo() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateValidTo()
+	})
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (u *CouponTemplateUpsertBulk) ClearValidTo() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.ClearValidTo()
+	})
+}
+
+// SetValidDays sets the "valid_days" field.
+func (u *CouponTemplateUpsertBulk) SetValidDays(v int) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetValidDays(v)
+	})
+}
+
+// AddValidDays adds v to the "valid_days" field.
+func (u *CouponTemplateUpsertBulk) AddValidDays(v int) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.AddValidDays(v)
+	})
+}
+
+// UpdateValidDays sets the "valid_days" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateValidDays() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateValidDays()
+	})
+}
+
+// SetTotalQty sets the "total_qty" field.
+func (u *CouponTemplateUpsertBulk) SetTotalQty(v int) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetTotalQty(v)
+	})
+}
+
+// AddTotalQty adds v to the "total_qty" field.
+func (u *CouponTemplateUpsertBulk) AddTotalQty(v int) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.AddTotalQty(v)
+	})
+}
+
+// UpdateTotalQty sets the "total_qty" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateTotalQty() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateTotalQty()
+	})
+}
+
+// SetIssuedQty sets the "issued_qty" field.
+func (u *CouponTemplateUpsertBulk) SetIssuedQty(v int) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetIssuedQty(v)
+	})
+}
+
+// AddIssuedQty adds v to the "issued_qty" field.
+func (u *CouponTemplateUpsertBulk) AddIssuedQty(v int) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.AddIssuedQty(v)
+	})
+}
+
+// UpdateIssuedQty sets the "issued_qty" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateIssuedQty() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateIssuedQty()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CouponTemplateUpsertBulk) SetDescription(v string) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateDescription() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CouponTemplateUpsertBulk) SetEnabled(v bool) *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CouponTemplateUpsertBulk) UpdateEnabled() *CouponTemplateUpsertBulk {
+	return u.Update(func(s *CouponTemplateUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *CouponTemplateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CouponTemplateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CouponTemplateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CouponTemplateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupontemplate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// CouponTemplateDelete is the builder for deleting a CouponTemplate entity.
+type CouponTemplateDelete struct {
+	config
+	hooks    []Hook
+	mutation *CouponTemplateMutation
+}
+
+// Where appends a list predicates to the CouponTemplateDelete builder.
+func (ctd *CouponTemplateDelete) Where(ps ...predicate.CouponTemplate) *CouponTemplateDelete {
+	ctd.mutation.Where(ps...)
+	return ctd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ctd *CouponTemplateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ctd.sqlExec, ctd.mutation, ctd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctd *CouponTemplateDelete) ExecX(ctx context.Context) int {
+	n, err := ctd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ctd *CouponTemplateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(coupontemplate.Table, sqlgraph.NewFieldSpec(coupontemplate.FieldID, field.TypeUUID))
+	if ps := ctd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ctd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ctd.mutation.done = true
+	return affected, err
+}
+
+// CouponTemplateDeleteOne is the builder for deleting a single CouponTemplate entity.
+type CouponTemplateDeleteOne struct {
+	ctd *CouponTemplateDelete
+}
+
+// Where appends a list predicates to the CouponTemplateDelete builder.
+func (ctdo *CouponTemplateDeleteOne) Where(ps ...predicate.CouponTemplate) *CouponTemplateDeleteOne {
+	ctdo.ctd.mutation.Where(ps...)
+	return ctdo
+}
+
+// Exec executes the deletion query.
+func (ctdo *CouponTemplateDeleteOne) Exec(ctx context.Context) error {
+	n, err := ctdo.ctd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{coupontemplate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctdo *CouponTemplateDeleteOne) ExecX(ctx context.Context) {
+	if err := ctdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
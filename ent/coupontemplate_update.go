@@ -0,0 +1,984 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupontemplate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// CouponTemplateUpdate is the builder for updating CouponTemplate entities.
+type CouponTemplateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CouponTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CouponTemplateUpdate builder.
+func (ctu *CouponTemplateUpdate) Where(ps ...predicate.CouponTemplate) *CouponTemplateUpdate {
+	ctu.mutation.Where(ps...)
+	return ctu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ctu *CouponTemplateUpdate) SetUpdatedAt(t time.Time) *CouponTemplateUpdate {
+	ctu.mutation.SetUpdatedAt(t)
+	return ctu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ctu *CouponTemplateUpdate) SetDeletedAt(i int64) *CouponTemplateUpdate {
+	ctu.mutation.ResetDeletedAt()
+	ctu.mutation.SetDeletedAt(i)
+	return ctu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableDeletedAt(i *int64) *CouponTemplateUpdate {
+	if i != nil {
+		ctu.SetDeletedAt(*i)
+	}
+	return ctu
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (ctu *CouponTemplateUpdate) AddDeletedAt(i int64) *CouponTemplateUpdate {
+	ctu.mutation.AddDeletedAt(i)
+	return ctu
+}
+
+// SetName sets the "name" field.
+func (ctu *CouponTemplateUpdate) SetName(s string) *CouponTemplateUpdate {
+	ctu.mutation.SetName(s)
+	return ctu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableName(s *string) *CouponTemplateUpdate {
+	if s != nil {
+		ctu.SetName(*s)
+	}
+	return ctu
+}
+
+// SetType sets the "type" field.
+func (ctu *CouponTemplateUpdate) SetType(dt domain.CouponType) *CouponTemplateUpdate {
+	ctu.mutation.SetType(dt)
+	return ctu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableType(dt *domain.CouponType) *CouponTemplateUpdate {
+	if dt != nil {
+		ctu.SetType(*dt)
+	}
+	return ctu
+}
+
+// SetValue sets the "value" field.
+func (ctu *CouponTemplateUpdate) SetValue(d decimal.Decimal) *CouponTemplateUpdate {
+	ctu.mutation.SetValue(d)
+	return ctu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableValue(d *decimal.Decimal) *CouponTemplateUpdate {
+	if d != nil {
+		ctu.SetValue(*d)
+	}
+	return ctu
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (ctu *CouponTemplateUpdate) SetMaxDiscount(d decimal.Decimal) *CouponTemplateUpdate {
+	ctu.mutation.SetMaxDiscount(d)
+	return ctu
+}
+
+// SetNillableMaxDiscount sets the "max_discount" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableMaxDiscount(d *decimal.Decimal) *CouponTemplateUpdate {
+	if d != nil {
+		ctu.SetMaxDiscount(*d)
+	}
+	return ctu
+}
+
+// SetProductID sets the "product_id" field.
+func (ctu *CouponTemplateUpdate) SetProductID(u uuid.UUID) *CouponTemplateUpdate {
+	ctu.mutation.SetProductID(u)
+	return ctu
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableProductID(u *uuid.UUID) *CouponTemplateUpdate {
+	if u != nil {
+		ctu.SetProductID(*u)
+	}
+	return ctu
+}
+
+// SetMinSpend sets the "min_spend" field.
+func (ctu *CouponTemplateUpdate) SetMinSpend(d decimal.Decimal) *CouponTemplateUpdate {
+	ctu.mutation.SetMinSpend(d)
+	return ctu
+}
+
+// SetNillableMinSpend sets the "min_spend" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableMinSpend(d *decimal.Decimal) *CouponTemplateUpdate {
+	if d != nil {
+		ctu.SetMinSpend(*d)
+	}
+	return ctu
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (ctu *CouponTemplateUpdate) SetStoreIds(u []uuid.UUID) *CouponTemplateUpdate {
+	ctu.mutation.SetStoreIds(u)
+	return ctu
+}
+
+// AppendStoreIds appends u to the "store_ids" field.
+func (ctu *CouponTemplateUpdate) AppendStoreIds(u []uuid.UUID) *CouponTemplateUpdate {
+	ctu.mutation.AppendStoreIds(u)
+	return ctu
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (ctu *CouponTemplateUpdate) ClearStoreIds() *CouponTemplateUpdate {
+	ctu.mutation.ClearStoreIds()
+	return ctu
+}
+
+// SetValidityType sets the "validity_type" field.
+func (ctu *CouponTemplateUpdate) SetValidityType(dvt domain.CouponValidityType) *CouponTemplateUpdate {
+	ctu.mutation.SetValidityType(dvt)
+	return ctu
+}
+
+// SetNillableValidityType sets the "validity_type" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableValidityType(dvt *domain.CouponValidityType) *CouponTemplateUpdate {
+	if dvt != nil {
+		ctu.SetValidityType(*dvt)
+	}
+	return ctu
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (ctu *CouponTemplateUpdate) SetValidFrom(t time.Time) *CouponTemplateUpdate {
+	ctu.mutation.SetValidFrom(t)
+	return ctu
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableValidFrom(t *time.Time) *CouponTemplateUpdate {
+	if t != nil {
+		ctu.SetValidFrom(*t)
+	}
+	return ctu
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (ctu *CouponTemplateUpdate) ClearValidFrom() *CouponTemplateUpdate {
+	ctu.mutation.ClearValidFrom()
+	return ctu
+}
+
+// SetValidTo sets the "valid_to" field.
+func (ctu *CouponTemplateUpdate) SetValidTo(t time.Time) *CouponTemplateUpdate {
+	ctu.mutation.SetValidTo(t)
+	return ctu
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableValidTo(t *time.Time) *CouponTemplateUpdate {
+	if t != nil {
+		ctu.SetValidTo(*t)
+	}
+	return ctu
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (ctu *CouponTemplateUpdate) ClearValidTo() *CouponTemplateUpdate {
+	ctu.mutation.ClearValidTo()
+	return ctu
+}
+
+// SetValidDays sets the "valid_days" field.
+func (ctu *CouponTemplateUpdate) SetValidDays(i int) *CouponTemplateUpdate {
+	ctu.mutation.ResetValidDays()
+	ctu.mutation.SetValidDays(i)
+	return ctu
+}
+
+// SetNillableValidDays sets the "valid_days" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableValidDays(i *int) *CouponTemplateUpdate {
+	if i != nil {
+		ctu.SetValidDays(*i)
+	}
+	return ctu
+}
+
+// AddValidDays adds i to the "valid_days" field.
+func (ctu *CouponTemplateUpdate) AddValidDays(i int) *CouponTemplateUpdate {
+	ctu.mutation.AddValidDays(i)
+	return ctu
+}
+
+// SetTotalQty sets the "total_qty" field.
+func (ctu *CouponTemplateUpdate) SetTotalQty(i int) *CouponTemplateUpdate {
+	ctu.mutation.ResetTotalQty()
+	ctu.mutation.SetTotalQty(i)
+	return ctu
+}
+
+// SetNillableTotalQty sets the "total_qty" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableTotalQty(i *int) *CouponTemplateUpdate {
+	if i != nil {
+		ctu.SetTotalQty(*i)
+	}
+	return ctu
+}
+
+// AddTotalQty adds i to the "total_qty" field.
+func (ctu *CouponTemplateUpdate) AddTotalQty(i int) *CouponTemplateUpdate {
+	ctu.mutation.AddTotalQty(i)
+	return ctu
+}
+
+// SetIssuedQty sets the "issued_qty" field.
+func (ctu *CouponTemplateUpdate) SetIssuedQty(i int) *CouponTemplateUpdate {
+	ctu.mutation.ResetIssuedQty()
+	ctu.mutation.SetIssuedQty(i)
+	return ctu
+}
+
+// SetNillableIssuedQty sets the "issued_qty" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableIssuedQty(i *int) *CouponTemplateUpdate {
+	if i != nil {
+		ctu.SetIssuedQty(*i)
+	}
+	return ctu
+}
+
+// AddIssuedQty adds i to the "issued_qty" field.
+func (ctu *CouponTemplateUpdate) AddIssuedQty(i int) *CouponTemplateUpdate {
+	ctu.mutation.AddIssuedQty(i)
+	return ctu
+}
+
+// SetDescription sets the "description" field.
+func (ctu *CouponTemplateUpdate) SetDescription(s string) *CouponTemplateUpdate {
+	ctu.mutation.SetDescription(s)
+	return ctu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableDescription(s *string) *CouponTemplateUpdate {
+	if s != nil {
+		ctu.SetDescription(*s)
+	}
+	return ctu
+}
+
+// SetEnabled sets the "enabled" field.
+func (ctu *CouponTemplateUpdate) SetEnabled(b bool) *CouponTemplateUpdate {
+	ctu.mutation.SetEnabled(b)
+	return ctu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ctu *CouponTemplateUpdate) SetNillableEnabled(b *bool) *CouponTemplateUpdate {
+	if b != nil {
+		ctu.SetEnabled(*b)
+	}
+	return ctu
+}
+
+// Mutation returns the CouponTemplateMutation object of the builder.
+func (ctu *CouponTemplateUpdate) Mutation() *CouponTemplateMutation {
+	return ctu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ctu *CouponTemplateUpdate) Save(ctx context.Context) (int, error) {
+	if err := ctu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, ctu.sqlSave, ctu.mutation, ctu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctu *CouponTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := ctu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ctu *CouponTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := ctu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctu *CouponTemplateUpdate) ExecX(ctx context.Context) {
+	if err := ctu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ctu *CouponTemplateUpdate) defaults() error {
+	if _, ok := ctu.mutation.UpdatedAt(); !ok {
+		if coupontemplate.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coupontemplate.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coupontemplate.UpdateDefaultUpdatedAt()
+		ctu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctu *CouponTemplateUpdate) check() error {
+	if v, ok := ctu.mutation.Name(); ok {
+		if err := coupontemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := ctu.mutation.GetType(); ok {
+		if err := coupontemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.type": %w`, err)}
+		}
+	}
+	if v, ok := ctu.mutation.ValidityType(); ok {
+		if err := coupontemplate.ValidityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "validity_type", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.validity_type": %w`, err)}
+		}
+	}
+	if v, ok := ctu.mutation.Description(); ok {
+		if err := coupontemplate.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ctu *CouponTemplateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CouponTemplateUpdate {
+	ctu.modifiers = append(ctu.modifiers, modifiers...)
+	return ctu
+}
+
+func (ctu *CouponTemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ctu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupontemplate.Table, coupontemplate.Columns, sqlgraph.NewFieldSpec(coupontemplate.FieldID, field.TypeUUID))
+	if ps := ctu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ctu.mutation.UpdatedAt(); ok {
+		_spec.SetField(coupontemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ctu.mutation.DeletedAt(); ok {
+		_spec.SetField(coupontemplate.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := ctu.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(coupontemplate.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := ctu.mutation.Name(); ok {
+		_spec.SetField(coupontemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := ctu.mutation.GetType(); ok {
+		_spec.SetField(coupontemplate.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ctu.mutation.Value(); ok {
+		_spec.SetField(coupontemplate.FieldValue, field.TypeOther, value)
+	}
+	if value, ok := ctu.mutation.MaxDiscount(); ok {
+		_spec.SetField(coupontemplate.FieldMaxDiscount, field.TypeOther, value)
+	}
+	if value, ok := ctu.mutation.ProductID(); ok {
+		_spec.SetField(coupontemplate.FieldProductID, field.TypeUUID, value)
+	}
+	if value, ok := ctu.mutation.MinSpend(); ok {
+		_spec.SetField(coupontemplate.FieldMinSpend, field.TypeOther, value)
+	}
+	if value, ok := ctu.mutation.StoreIds(); ok {
+		_spec.SetField(coupontemplate.FieldStoreIds, field.TypeJSON, value)
+	}
+	if value, ok := ctu.mutation.AppendedStoreIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coupontemplate.FieldStoreIds, value)
+		})
+	}
+	if ctu.mutation.StoreIdsCleared() {
+		_spec.ClearField(coupontemplate.FieldStoreIds, field.TypeJSON)
+	}
+	if value, ok := ctu.mutation.ValidityType(); ok {
+		_spec.SetField(coupontemplate.FieldValidityType, field.TypeEnum, value)
+	}
+	if value, ok := ctu.mutation.ValidFrom(); ok {
+		_spec.SetField(coupontemplate.FieldValidFrom, field.TypeTime, value)
+	}
+	if ctu.mutation.ValidFromCleared() {
+		_spec.ClearField(coupontemplate.FieldValidFrom, field.TypeTime)
+	}
+	if value, ok := ctu.mutation.ValidTo(); ok {
+		_spec.SetField(coupontemplate.FieldValidTo, field.TypeTime, value)
+	}
+	if ctu.mutation.ValidToCleared() {
+		_spec.ClearField(coupontemplate.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := ctu.mutation.ValidDays(); ok {
+		_spec.SetField(coupontemplate.FieldValidDays, field.TypeInt, value)
+	}
+	if value, ok := ctu.mutation.AddedValidDays(); ok {
+		_spec.AddField(coupontemplate.FieldValidDays, field.TypeInt, value)
+	}
+	if value, ok := ctu.mutation.TotalQty(); ok {
+		_spec.SetField(coupontemplate.FieldTotalQty, field.TypeInt, value)
+	}
+	if value, ok := ctu.mutation.AddedTotalQty(); ok {
+		_spec.AddField(coupontemplate.FieldTotalQty, field.TypeInt, value)
+	}
+	if value, ok := ctu.mutation.IssuedQty(); ok {
+		_spec.SetField(coupontemplate.FieldIssuedQty, field.TypeInt, value)
+	}
+	if value, ok := ctu.mutation.AddedIssuedQty(); ok {
+		_spec.AddField(coupontemplate.FieldIssuedQty, field.TypeInt, value)
+	}
+	if value, ok := ctu.mutation.Description(); ok {
+		_spec.SetField(coupontemplate.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := ctu.mutation.Enabled(); ok {
+		_spec.SetField(coupontemplate.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(ctu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ctu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupontemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ctu.mutation.done = true
+	return n, nil
+}
+
+// CouponTemplateUpdateOne is the builder for updating a single CouponTemplate entity.
+type CouponTemplateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CouponTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ctuo *CouponTemplateUpdateOne) SetUpdatedAt(t time.Time) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetUpdatedAt(t)
+	return ctuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ctuo *CouponTemplateUpdateOne) SetDeletedAt(i int64) *CouponTemplateUpdateOne {
+	ctuo.mutation.ResetDeletedAt()
+	ctuo.mutation.SetDeletedAt(i)
+	return ctuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableDeletedAt(i *int64) *CouponTemplateUpdateOne {
+	if i != nil {
+		ctuo.SetDeletedAt(*i)
+	}
+	return ctuo
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (ctuo *CouponTemplateUpdateOne) AddDeletedAt(i int64) *CouponTemplateUpdateOne {
+	ctuo.mutation.AddDeletedAt(i)
+	return ctuo
+}
+
+// SetName sets the "name" field.
+func (ctuo *CouponTemplateUpdateOne) SetName(s string) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetName(s)
+	return ctuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableName(s *string) *CouponTemplateUpdateOne {
+	if s != nil {
+		ctuo.SetName(*s)
+	}
+	return ctuo
+}
+
+// SetType sets the "type" field.
+func (ctuo *CouponTemplateUpdateOne) SetType(dt domain.CouponType) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetType(dt)
+	return ctuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableType(dt *domain.CouponType) *CouponTemplateUpdateOne {
+	if dt != nil {
+		ctuo.SetType(*dt)
+	}
+	return ctuo
+}
+
+// SetValue sets the "value" field.
+func (ctuo *CouponTemplateUpdateOne) SetValue(d decimal.Decimal) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetValue(d)
+	return ctuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableValue(d *decimal.Decimal) *CouponTemplateUpdateOne {
+	if d != nil {
+		ctuo.SetValue(*d)
+	}
+	return ctuo
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (ctuo *CouponTemplateUpdateOne) SetMaxDiscount(d decimal.Decimal) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetMaxDiscount(d)
+	return ctuo
+}
+
+// SetNillableMaxDiscount sets the "max_discount" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableMaxDiscount(d *decimal.Decimal) *CouponTemplateUpdateOne {
+	if d != nil {
+		ctuo.SetMaxDiscount(*d)
+	}
+	return ctuo
+}
+
+// SetProductID sets the "product_id" field.
+func (ctuo *CouponTemplateUpdateOne) SetProductID(u uuid.UUID) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetProductID(u)
+	return ctuo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableProductID(u *uuid.UUID) *CouponTemplateUpdateOne {
+	if u != nil {
+		ctuo.SetProductID(*u)
+	}
+	return ctuo
+}
+
+// SetMinSpend sets the "min_spend" field.
+func (ctuo *CouponTemplateUpdateOne) SetMinSpend(d decimal.Decimal) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetMinSpend(d)
+	return ctuo
+}
+
+// SetNillableMinSpend sets the "min_spend" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableMinSpend(d *decimal.Decimal) *CouponTemplateUpdateOne {
+	if d != nil {
+		ctuo.SetMinSpend(*d)
+	}
+	return ctuo
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (ctuo *CouponTemplateUpdateOne) SetStoreIds(u []uuid.UUID) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetStoreIds(u)
+	return ctuo
+}
+
+// AppendStoreIds appends u to the "store_ids" field.
+func (ctuo *CouponTemplateUpdateOne) AppendStoreIds(u []uuid.UUID) *CouponTemplateUpdateOne {
+	ctuo.mutation.AppendStoreIds(u)
+	return ctuo
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (ctuo *CouponTemplateUpdateOne) ClearStoreIds() *CouponTemplateUpdateOne {
+	ctuo.mutation.ClearStoreIds()
+	return ctuo
+}
+
+// SetValidityType sets the "validity_type" field.
+func (ctuo *CouponTemplateUpdateOne) SetValidityType(dvt domain.CouponValidityType) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetValidityType(dvt)
+	return ctuo
+}
+
+// SetNillableValidityType sets the "validity_type" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableValidityType(dvt *domain.CouponValidityType) *CouponTemplateUpdateOne {
+	if dvt != nil {
+		ctuo.SetValidityType(*dvt)
+	}
+	return ctuo
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (ctuo *CouponTemplateUpdateOne) SetValidFrom(t time.Time) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetValidFrom(t)
+	return ctuo
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableValidFrom(t *time.Time) *CouponTemplateUpdateOne {
+	if t != nil {
+		ctuo.SetValidFrom(*t)
+	}
+	return ctuo
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (ctuo *CouponTemplateUpdateOne) ClearValidFrom() *CouponTemplateUpdateOne {
+	ctuo.mutation.ClearValidFrom()
+	return ctuo
+}
+
+// SetValidTo sets the "valid_to" field.
+func (ctuo *CouponTemplateUpdateOne) SetValidTo(t time.Time) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetValidTo(t)
+	return ctuo
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableValidTo(t *time.Time) *CouponTemplateUpdateOne {
+	if t != nil {
+		ctuo.SetValidTo(*t)
+	}
+	return ctuo
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (ctuo *CouponTemplateUpdateOne) ClearValidTo() *CouponTemplateUpdateOne {
+	ctuo.mutation.ClearValidTo()
+	return ctuo
+}
+
+// SetValidDays sets the "valid_days" field.
+func (ctuo *CouponTemplateUpdateOne) SetValidDays(i int) *CouponTemplateUpdateOne {
+	ctuo.mutation.ResetValidDays()
+	ctuo.mutation.SetValidDays(i)
+	return ctuo
+}
+
+// SetNillableValidDays sets the "valid_days" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableValidDays(i *int) *CouponTemplateUpdateOne {
+	if i != nil {
+		ctuo.SetValidDays(*i)
+	}
+	return ctuo
+}
+
+// AddValidDays adds i to the "valid_days" field.
+func (ctuo *CouponTemplateUpdateOne) AddValidDays(i int) *CouponTemplateUpdateOne {
+	ctuo.mutation.AddValidDays(i)
+	return ctuo
+}
+
+// SetTotalQty sets the "total_qty" field.
+func (ctuo *CouponTemplateUpdateOne) SetTotalQty(i int) *CouponTemplateUpdateOne {
+	ctuo.mutation.ResetTotalQty()
+	ctuo.mutation.SetTotalQty(i)
+	return ctuo
+}
+
+// SetNillableTotalQty sets the "total_qty" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableTotalQty(i *int) *CouponTemplateUpdateOne {
+	if i != nil {
+		ctuo.SetTotalQty(*i)
+	}
+	return ctuo
+}
+
+// AddTotalQty adds i to the "total_qty" field.
+func (ctuo *CouponTemplateUpdateOne) AddTotalQty(i int) *CouponTemplateUpdateOne {
+	ctuo.mutation.AddTotalQty(i)
+	return ctuo
+}
+
+// SetIssuedQty sets the "issued_qty" field.
+func (ctuo *CouponTemplateUpdateOne) SetIssuedQty(i int) *CouponTemplateUpdateOne {
+	ctuo.mutation.ResetIssuedQty()
+	ctuo.mutation.SetIssuedQty(i)
+	return ctuo
+}
+
+// SetNillableIssuedQty sets the "issued_qty" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableIssuedQty(i *int) *CouponTemplateUpdateOne {
+	if i != nil {
+		ctuo.SetIssuedQty(*i)
+	}
+	return ctuo
+}
+
+// AddIssuedQty adds i to the "issued_qty" field.
+func (ctuo *CouponTemplateUpdateOne) AddIssuedQty(i int) *CouponTemplateUpdateOne {
+	ctuo.mutation.AddIssuedQty(i)
+	return ctuo
+}
+
+// SetDescription sets the "description" field.
+func (ctuo *CouponTemplateUpdateOne) SetDescription(s string) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetDescription(s)
+	return ctuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableDescription(s *string) *CouponTemplateUpdateOne {
+	if s != nil {
+		ctuo.SetDescription(*s)
+	}
+	return ctuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (ctuo *CouponTemplateUpdateOne) SetEnabled(b bool) *CouponTemplateUpdateOne {
+	ctuo.mutation.SetEnabled(b)
+	return ctuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ctuo *CouponTemplateUpdateOne) SetNillableEnabled(b *bool) *CouponTemplateUpdateOne {
+	if b != nil {
+		ctuo.SetEnabled(*b)
+	}
+	return ctuo
+}
+
+// Mutation returns the CouponTemplateMutation object of the builder.
+func (ctuo *CouponTemplateUpdateOne) Mutation() *CouponTemplateMutation {
+	return ctuo.mutation
+}
+
+// Where appends a list predicates to the CouponTemplateUpdate builder.
+func (ctuo *CouponTemplateUpdateOne) Where(ps ...predicate.CouponTemplate) *CouponTemplateUpdateOne {
+	ctuo.mutation.Where(ps...)
+	return ctuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ctuo *CouponTemplateUpdateOne) Select(field string, fields ...string) *CouponTemplateUpdateOne {
+	ctuo.fields = append([]string{field}, fields...)
+	return ctuo
+}
+
+// Save executes the query and returns the updated CouponTemplate entity.
+func (ctuo *CouponTemplateUpdateOne) Save(ctx context.Context) (*CouponTemplate, error) {
+	if err := ctuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ctuo.sqlSave, ctuo.mutation, ctuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctuo *CouponTemplateUpdateOne) SaveX(ctx context.Context) *CouponTemplate {
+	node, err := ctuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ctuo *CouponTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := ctuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctuo *CouponTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := ctuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ctuo *CouponTemplateUpdateOne) defaults() error {
+	if _, ok := ctuo.mutation.UpdatedAt(); !ok {
+		if coupontemplate.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coupontemplate.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coupontemplate.UpdateDefaultUpdatedAt()
+		ctuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctuo *CouponTemplateUpdateOne) check() error {
+	if v, ok := ctuo.mutation.Name(); ok {
+		if err := coupontemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := ctuo.mutation.GetType(); ok {
+		if err := coupontemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.type": %w`, err)}
+		}
+	}
+	if v, ok := ctuo.mutation.ValidityType(); ok {
+		if err := coupontemplate.ValidityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "validity_type", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.validity_type": %w`, err)}
+		}
+	}
+	if v, ok := ctuo.mutation.Description(); ok {
+		if err := coupontemplate.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "CouponTemplate.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ctuo *CouponTemplateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CouponTemplateUpdateOne {
+	ctuo.modifiers = append(ctuo.modifiers, modifiers...)
+	return ctuo
+}
+
+func (ctuo *CouponTemplateUpdateOne) sqlSave(ctx context.Context) (_node *CouponTemplate, err error) {
+	if err := ctuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupontemplate.Table, coupontemplate.Columns, sqlgraph.NewFieldSpec(coupontemplate.FieldID, field.TypeUUID))
+	id, ok := ctuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CouponTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ctuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coupontemplate.FieldID)
+		for _, f := range fields {
+			if !coupontemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coupontemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ctuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ctuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(coupontemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ctuo.mutation.DeletedAt(); ok {
+		_spec.SetField(coupontemplate.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := ctuo.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(coupontemplate.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := ctuo.mutation.Name(); ok {
+		_spec.SetField(coupontemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := ctuo.mutation.GetType(); ok {
+		_spec.SetField(coupontemplate.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ctuo.mutation.Value(); ok {
+		_spec.SetField(coupontemplate.FieldValue, field.TypeOther, value)
+	}
+	if value, ok := ctuo.mutation.MaxDiscount(); ok {
+		_spec.SetField(coupontemplate.FieldMaxDiscount, field.TypeOther, value)
+	}
+	if value, ok := ctuo.mutation.ProductID(); ok {
+		_spec.SetField(coupontemplate.FieldProductID, field.TypeUUID, value)
+	}
+	if value, ok := ctuo.mutation.MinSpend(); ok {
+		_spec.SetField(coupontemplate.FieldMinSpend, field.TypeOther, value)
+	}
+	if value, ok := ctuo.mutation.StoreIds(); ok {
+		_spec.SetField(coupontemplate.FieldStoreIds, field.TypeJSON, value)
+	}
+	if value, ok := ctuo.mutation.AppendedStoreIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coupontemplate.FieldStoreIds, value)
+		})
+	}
+	if ctuo.mutation.StoreIdsCleared() {
+		_spec.ClearField(coupontemplate.FieldStoreIds, field.TypeJSON)
+	}
+	if value, ok := ctuo.mutation.ValidityType(); ok {
+		_spec.SetField(coupontemplate.FieldValidityType, field.TypeEnum, value)
+	}
+	if value, ok := ctuo.mutation.ValidFrom(); ok {
+		_spec.SetField(coupontemplate.FieldValidFrom, field.TypeTime, value)
+	}
+	if ctuo.mutation.ValidFromCleared() {
+		_spec.ClearField(coupontemplate.FieldValidFrom, field.TypeTime)
+	}
+	if value, ok := ctuo.mutation.ValidTo(); ok {
+		_spec.SetField(coupontemplate.FieldValidTo, field.TypeTime, value)
+	}
+	if ctuo.mutation.ValidToCleared() {
+		_spec.ClearField(coupontemplate.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := ctuo.mutation.ValidDays(); ok {
+		_spec.SetField(coupontemplate.FieldValidDays, field.TypeInt, value)
+	}
+	if value, ok := ctuo.mutation.AddedValidDays(); ok {
+		_spec.AddField(coupontemplate.FieldValidDays, field.TypeInt, value)
+	}
+	if value, ok := ctuo.mutation.TotalQty(); ok {
+		_spec.SetField(coupontemplate.FieldTotalQty, field.TypeInt, value)
+	}
+	if value, ok := ctuo.mutation.AddedTotalQty(); ok {
+		_spec.AddField(coupontemplate.FieldTotalQty, field.TypeInt, value)
+	}
+	if value, ok := ctuo.mutation.IssuedQty(); ok {
+		_spec.SetField(coupontemplate.FieldIssuedQty, field.TypeInt, value)
+	}
+	if value, ok := ctuo.mutation.AddedIssuedQty(); ok {
+		_spec.AddField(coupontemplate.FieldIssuedQty, field.TypeInt, value)
+	}
+	if value, ok := ctuo.mutation.Description(); ok {
+		_spec.SetField(coupontemplate.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := ctuo.mutation.Enabled(); ok {
+		_spec.SetField(coupontemplate.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(ctuo.modifiers...)
+	_node = &CouponTemplate{config: ctuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ctuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupontemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ctuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/customer"
+)
+
+// Customer is the model entity for the Customer schema.
+type Customer struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 微信小程序 openid
+	Openid string `json:"openid,omitempty"`
+	// 微信开放平台 unionid
+	Unionid string `json:"unionid,omitempty"`
+	// 昵称
+	Nickname string `json:"nickname,omitempty"`
+	// 头像
+	Avatar string `json:"avatar,omitempty"`
+	// 手机号
+	Phone string `json:"phone,omitempty"`
+	// 最后登录时间
+	LastLoginAt  *time.Time `json:"last_login_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Customer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case customer.FieldOpenid, customer.FieldUnionid, customer.FieldNickname, customer.FieldAvatar, customer.FieldPhone:
+			values[i] = new(sql.NullString)
+		case customer.FieldCreatedAt, customer.FieldUpdatedAt, customer.FieldLastLoginAt:
+			values[i] = new(sql.NullTime)
+		case customer.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Customer fields.
+func (c *Customer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case customer.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case customer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case customer.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case customer.FieldOpenid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field openid", values[i])
+			} else if value.Valid {
+				c.Openid = value.String
+			}
+		case customer.FieldUnionid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unionid", values[i])
+			} else if value.Valid {
+				c.Unionid = value.String
+			}
+		case customer.FieldNickname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nickname", values[i])
+			} else if value.Valid {
+				c.Nickname = value.String
+			}
+		case customer.FieldAvatar:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar", values[i])
+			} else if value.Valid {
+				c.Avatar = value.String
+			}
+		case customer.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				c.Phone = value.String
+			}
+		case customer.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				c.LastLoginAt = new(time.Time)
+				*c.LastLoginAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Customer.
+// This includes values selected through modifiers, order, etc.
+func (c *Customer) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Customer.
+// Note that you need to call Customer.Unwrap() before calling this method if this Customer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Customer) Update() *CustomerUpdateOne {
+	return NewCustomerClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Customer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Customer) Unwrap() *Customer {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Customer is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Customer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Customer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("openid=")
+	builder.WriteString(c.Openid)
+	builder.WriteString(", ")
+	builder.WriteString("unionid=")
+	builder.WriteString(c.Unionid)
+	builder.WriteString(", ")
+	builder.WriteString("nickname=")
+	builder.WriteString(c.Nickname)
+	builder.WriteString(", ")
+	builder.WriteString("avatar=")
+	builder.WriteString(c.Avatar)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(c.Phone)
+	builder.WriteString(", ")
+	if v := c.LastLoginAt; v != nil {
+		builder.WriteString("last_login_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Customers is a parsable slice of Customer.
+type Customers []*Customer
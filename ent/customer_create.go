@@ -0,0 +1,934 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/customer"
+)
+
+// CustomerCreate is the builder for creating a Customer entity.
+type CustomerCreate struct {
+	config
+	mutation *CustomerMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CustomerCreate) SetCreatedAt(t time.Time) *CustomerCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableCreatedAt(t *time.Time) *CustomerCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CustomerCreate) SetUpdatedAt(t time.Time) *CustomerCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableUpdatedAt(t *time.Time) *CustomerCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetOpenid sets the "openid" field.
+func (cc *CustomerCreate) SetOpenid(s string) *CustomerCreate {
+	cc.mutation.SetOpenid(s)
+	return cc
+}
+
+// SetUnionid sets the "unionid" field.
+func (cc *CustomerCreate) SetUnionid(s string) *CustomerCreate {
+	cc.mutation.SetUnionid(s)
+	return cc
+}
+
+// SetNillableUnionid sets the "unionid" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableUnionid(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetUnionid(*s)
+	}
+	return cc
+}
+
+// SetNickname sets the "nickname" field.
+func (cc *CustomerCreate) SetNickname(s string) *CustomerCreate {
+	cc.mutation.SetNickname(s)
+	return cc
+}
+
+// SetNillableNickname sets the "nickname" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableNickname(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetNickname(*s)
+	}
+	return cc
+}
+
+// SetAvatar sets the "avatar" field.
+func (cc *CustomerCreate) SetAvatar(s string) *CustomerCreate {
+	cc.mutation.SetAvatar(s)
+	return cc
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableAvatar(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetAvatar(*s)
+	}
+	return cc
+}
+
+// SetPhone sets the "phone" field.
+func (cc *CustomerCreate) SetPhone(s string) *CustomerCreate {
+	cc.mutation.SetPhone(s)
+	return cc
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillablePhone(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetPhone(*s)
+	}
+	return cc
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (cc *CustomerCreate) SetLastLoginAt(t time.Time) *CustomerCreate {
+	cc.mutation.SetLastLoginAt(t)
+	return cc
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableLastLoginAt(t *time.Time) *CustomerCreate {
+	if t != nil {
+		cc.SetLastLoginAt(*t)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CustomerCreate) SetID(u uuid.UUID) *CustomerCreate {
+	cc.mutation.SetID(u)
+	return cc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableID(u *uuid.UUID) *CustomerCreate {
+	if u != nil {
+		cc.SetID(*u)
+	}
+	return cc
+}
+
+// Mutation returns the CustomerMutation object of the builder.
+func (cc *CustomerCreate) Mutation() *CustomerMutation {
+	return cc.mutation
+}
+
+// Save creates the Customer in the database.
+func (cc *CustomerCreate) Save(ctx context.Context) (*Customer, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CustomerCreate) SaveX(ctx context.Context) *Customer {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CustomerCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CustomerCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CustomerCreate) defaults() {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := customer.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := customer.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cc.mutation.Unionid(); !ok {
+		v := customer.DefaultUnionid
+		cc.mutation.SetUnionid(v)
+	}
+	if _, ok := cc.mutation.Nickname(); !ok {
+		v := customer.DefaultNickname
+		cc.mutation.SetNickname(v)
+	}
+	if _, ok := cc.mutation.Avatar(); !ok {
+		v := customer.DefaultAvatar
+		cc.mutation.SetAvatar(v)
+	}
+	if _, ok := cc.mutation.Phone(); !ok {
+		v := customer.DefaultPhone
+		cc.mutation.SetPhone(v)
+	}
+	if _, ok := cc.mutation.ID(); !ok {
+		v := customer.DefaultID()
+		cc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CustomerCreate) check() error {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Customer.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Customer.updated_at"`)}
+	}
+	if _, ok := cc.mutation.Openid(); !ok {
+		return &ValidationError{Name: "openid", err: errors.New(`ent: missing required field "Customer.openid"`)}
+	}
+	if v, ok := cc.mutation.Openid(); ok {
+		if err := customer.OpenidValidator(v); err != nil {
+			return &ValidationError{Name: "openid", err: fmt.Errorf(`ent: validator failed for field "Customer.openid": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Unionid(); !ok {
+		return &ValidationError{Name: "unionid", err: errors.New(`ent: missing required field "Customer.unionid"`)}
+	}
+	if v, ok := cc.mutation.Unionid(); ok {
+		if err := customer.UnionidValidator(v); err != nil {
+			return &ValidationError{Name: "unionid", err: fmt.Errorf(`ent: validator failed for field "Customer.unionid": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Nickname(); !ok {
+		return &ValidationError{Name: "nickname", err: errors.New(`ent: missing required field "Customer.nickname"`)}
+	}
+	if v, ok := cc.mutation.Nickname(); ok {
+		if err := customer.NicknameValidator(v); err != nil {
+			return &ValidationError{Name: "nickname", err: fmt.Errorf(`ent: validator failed for field "Customer.nickname": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Avatar(); !ok {
+		return &ValidationError{Name: "avatar", err: errors.New(`ent: missing required field "Customer.avatar"`)}
+	}
+	if v, ok := cc.mutation.Avatar(); ok {
+		if err := customer.AvatarValidator(v); err != nil {
+			return &ValidationError{Name: "avatar", err: fmt.Errorf(`ent: validator failed for field "Customer.avatar": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Phone(); !ok {
+		return &ValidationError{Name: "phone", err: errors.New(`ent: missing required field "Customer.phone"`)}
+	}
+	if v, ok := cc.mutation.Phone(); ok {
+		if err := customer.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Customer.phone": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cc *CustomerCreate) sqlSave(ctx context.Context) (*Customer, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CustomerCreate) createSpec() (*Customer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Customer{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(customer.Table, sqlgraph.NewFieldSpec(customer.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = cc.conflict
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(customer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(customer.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cc.mutation.Openid(); ok {
+		_spec.SetField(customer.FieldOpenid, field.TypeString, value)
+		_node.Openid = value
+	}
+	if value, ok := cc.mutation.Unionid(); ok {
+		_spec.SetField(customer.FieldUnionid, field.TypeString, value)
+		_node.Unionid = value
+	}
+	if value, ok := cc.mutation.Nickname(); ok {
+		_spec.SetField(customer.FieldNickname, field.TypeString, value)
+		_node.Nickname = value
+	}
+	if value, ok := cc.mutation.Avatar(); ok {
+		_spec.SetField(customer.FieldAvatar, field.TypeString, value)
+		_node.Avatar = value
+	}
+	if value, ok := cc.mutation.Phone(); ok {
+		_spec.SetField(customer.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := cc.mutation.LastLoginAt(); ok {
+		_spec.SetField(customer.FieldLastLoginAt, field.TypeTime, value)
+		_node.LastLoginAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Customer.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CustomerUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CustomerCreate) OnConflict(opts ...sql.ConflictOption) *CustomerUpsertOne {
+	cc.conflict = opts
+	return &CustomerUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CustomerCreate) OnConflictColumns(columns ...string) *CustomerUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CustomerUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CustomerUpsertOne is the builder for "upsert"-ing
+	//  one Customer node.
+	CustomerUpsertOne struct {
+		create *CustomerCreate
+	}
+
+	// CustomerUpsert is the "OnConflict" setter.
+	CustomerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomerUpsert) SetUpdatedAt(v time.Time) *CustomerUpsert {
+	u.Set(customer.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateUpdatedAt() *CustomerUpsert {
+	u.SetExcluded(customer.FieldUpdatedAt)
+	return u
+}
+
+// SetUnionid sets the "unionid" field.
+func (u *CustomerUpsert) SetUnionid(v string) *CustomerUpsert {
+	u.Set(customer.FieldUnionid, v)
+	return u
+}
+
+// UpdateUnionid sets the "unionid" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateUnionid() *CustomerUpsert {
+	u.SetExcluded(customer.FieldUnionid)
+	return u
+}
+
+// SetNickname sets the "nickname" field.
+func (u *CustomerUpsert) SetNickname(v string) *CustomerUpsert {
+	u.Set(customer.FieldNickname, v)
+	return u
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateNickname() *CustomerUpsert {
+	u.SetExcluded(customer.FieldNickname)
+	return u
+}
+
+// SetAvatar sets the "avatar" field.
+func (u *CustomerUpsert) SetAvatar(v string) *CustomerUpsert {
+	u.Set(customer.FieldAvatar, v)
+	return u
+}
+
+// UpdateAvatar sets the "avatar" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateAvatar() *CustomerUpsert {
+	u.SetExcluded(customer.FieldAvatar)
+	return u
+}
+
+// SetPhone sets the "phone" field.
+func (u *CustomerUpsert) SetPhone(v string) *CustomerUpsert {
+	u.Set(customer.FieldPhone, v)
+	return u
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdatePhone() *CustomerUpsert {
+	u.SetExcluded(customer.FieldPhone)
+	return u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (u *CustomerUpsert) SetLastLoginAt(v time.Time) *CustomerUpsert {
+	u.Set(customer.FieldLastLoginAt, v)
+	return u
+}
+
+// UpdateLastLoginAt sets the "last_login_at" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateLastLoginAt() *CustomerUpsert {
+	u.SetExcluded(customer.FieldLastLoginAt)
+	return u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (u *CustomerUpsert) ClearLastLoginAt() *CustomerUpsert {
+	u.SetNull(customer.FieldLastLoginAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(customer.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CustomerUpsertOne) UpdateNewValues() *CustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(customer.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(customer.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Openid(); exists {
+			s.SetIgnore(customer.FieldOpenid)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CustomerUpsertOne) Ignore() *CustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CustomerUpsertOne) DoNothing() *CustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CustomerCreate.OnConflict
+// documentation for more info.
+func (u *CustomerUpsertOne) Update(set func(*CustomerUpsert)) *CustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CustomerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomerUpsertOne) SetUpdatedAt(v time.Time) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateUpdatedAt() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUnionid sets the "unionid" field.
+func (u *CustomerUpsertOne) SetUnionid(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetUnionid(v)
+	})
+}
+
+// UpdateUnionid sets the "unionid" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateUnionid() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateUnionid()
+	})
+}
+
+// SetNickname sets the "nickname" field.
+func (u *CustomerUpsertOne) SetNickname(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetNickname(v)
+	})
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateNickname() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateNickname()
+	})
+}
+
+// SetAvatar sets the "avatar" field.
+func (u *CustomerUpsertOne) SetAvatar(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetAvatar(v)
+	})
+}
+
+// UpdateAvatar sets the "avatar" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateAvatar() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateAvatar()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *CustomerUpsertOne) SetPhone(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdatePhone() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (u *CustomerUpsertOne) SetLastLoginAt(v time.Time) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetLastLoginAt(v)
+	})
+}
+
+// UpdateLastLoginAt sets the "last_login_at" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateLastLoginAt() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateLastLoginAt()
+	})
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (u *CustomerUpsertOne) ClearLastLoginAt() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearLastLoginAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CustomerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CustomerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CustomerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CustomerUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CustomerUpsertOne.ID is not supported by MySQL driver. Use CustomerUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CustomerUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CustomerCreateBulk is the builder for creating many Customer entities in bulk.
+type CustomerCreateBulk struct {
+	config
+	err      error
+	builders []*CustomerCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Customer entities in the database.
+func (ccb *CustomerCreateBulk) Save(ctx context.Context) ([]*Customer, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Customer, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CustomerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CustomerCreateBulk) SaveX(ctx context.Context) []*Customer {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CustomerCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CustomerCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Customer.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CustomerUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CustomerCreateBulk) OnConflict(opts ...sql.ConflictOption) *CustomerUpsertBulk {
+	ccb.conflict = opts
+	return &CustomerUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CustomerCreateBulk) OnConflictColumns(columns ...string) *CustomerUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CustomerUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CustomerUpsertBulk is the builder for "upsert"-ing
+// a bulk of Customer nodes.
+type CustomerUpsertBulk struct {
+	create *CustomerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(customer.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CustomerUpsertBulk) UpdateNewValues() *CustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(customer.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(customer.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Openid(); exists {
+				s.SetIgnore(customer.FieldOpenid)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CustomerUpsertBulk) Ignore() *CustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CustomerUpsertBulk) DoNothing() *CustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CustomerCreateBulk.OnConflict
+// documentation for more info.
+func (u *CustomerUpsertBulk) Update(set func(*CustomerUpsert)) *CustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CustomerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomerUpsertBulk) SetUpdatedAt(v time.Time) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateUpdatedAt() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUnionid sets the "unionid" field.
+func (u *CustomerUpsertBulk) SetUnionid(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetUnionid(v)
+	})
+}
+
+// UpdateUnionid sets the "unionid" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateUnionid() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateUnionid()
+	})
+}
+
+// SetNickname sets the "nickname" field.
+func (u *CustomerUpsertBulk) SetNickname(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetNickname(v)
+	})
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateNickname() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateNickname()
+	})
+}
+
+// SetAvatar sets the "avatar" field.
+func (u *CustomerUpsertBulk) SetAvatar(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetAvatar(v)
+	})
+}
+
+// UpdateAvatar sets the "avatar" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateAvatar() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateAvatar()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *CustomerUpsertBulk) SetPhone(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdatePhone() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (u *CustomerUpsertBulk) SetLastLoginAt(v time.Time) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetLastLoginAt(v)
+	})
+}
+
+// UpdateLastLoginAt sets the "last_login_at" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateLastLoginAt() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateLastLoginAt()
+	})
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (u *CustomerUpsertBulk) ClearLastLoginAt() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearLastLoginAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CustomerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CustomerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CustomerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CustomerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/customer"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// CustomerUpdate is the builder for updating Customer entities.
+type CustomerUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CustomerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CustomerUpdate builder.
+func (cu *CustomerUpdate) Where(ps ...predicate.Customer) *CustomerUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CustomerUpdate) SetUpdatedAt(t time.Time) *CustomerUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetUnionid sets the "unionid" field.
+func (cu *CustomerUpdate) SetUnionid(s string) *CustomerUpdate {
+	cu.mutation.SetUnionid(s)
+	return cu
+}
+
+// SetNillableUnionid sets the "unionid" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableUnionid(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetUnionid(*s)
+	}
+	return cu
+}
+
+// SetNickname sets the "nickname" field.
+func (cu *CustomerUpdate) SetNickname(s string) *CustomerUpdate {
+	cu.mutation.SetNickname(s)
+	return cu
+}
+
+// SetNillableNickname sets the "nickname" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableNickname(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetNickname(*s)
+	}
+	return cu
+}
+
+// SetAvatar sets the "avatar" field.
+func (cu *CustomerUpdate) SetAvatar(s string) *CustomerUpdate {
+	cu.mutation.SetAvatar(s)
+	return cu
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableAvatar(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetAvatar(*s)
+	}
+	return cu
+}
+
+// SetPhone sets the "phone" field.
+func (cu *CustomerUpdate) SetPhone(s string) *CustomerUpdate {
+	cu.mutation.SetPhone(s)
+	return cu
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillablePhone(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetPhone(*s)
+	}
+	return cu
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (cu *CustomerUpdate) SetLastLoginAt(t time.Time) *CustomerUpdate {
+	cu.mutation.SetLastLoginAt(t)
+	return cu
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableLastLoginAt(t *time.Time) *CustomerUpdate {
+	if t != nil {
+		cu.SetLastLoginAt(*t)
+	}
+	return cu
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (cu *CustomerUpdate) ClearLastLoginAt() *CustomerUpdate {
+	cu.mutation.ClearLastLoginAt()
+	return cu
+}
+
+// Mutation returns the CustomerMutation object of the builder.
+func (cu *CustomerUpdate) Mutation() *CustomerMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CustomerUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CustomerUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CustomerUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CustomerUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CustomerUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := customer.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CustomerUpdate) check() error {
+	if v, ok := cu.mutation.Unionid(); ok {
+		if err := customer.UnionidValidator(v); err != nil {
+			return &ValidationError{Name: "unionid", err: fmt.Errorf(`ent: validator failed for field "Customer.unionid": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Nickname(); ok {
+		if err := customer.NicknameValidator(v); err != nil {
+			return &ValidationError{Name: "nickname", err: fmt.Errorf(`ent: validator failed for field "Customer.nickname": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Avatar(); ok {
+		if err := customer.AvatarValidator(v); err != nil {
+			return &ValidationError{Name: "avatar", err: fmt.Errorf(`ent: validator failed for field "Customer.avatar": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Phone(); ok {
+		if err := customer.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Customer.phone": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cu *CustomerUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CustomerUpdate {
+	cu.modifiers = append(cu.modifiers, modifiers...)
+	return cu
+}
+
+func (cu *CustomerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customer.Table, customer.Columns, sqlgraph.NewFieldSpec(customer.FieldID, field.TypeUUID))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(customer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.Unionid(); ok {
+		_spec.SetField(customer.FieldUnionid, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Nickname(); ok {
+		_spec.SetField(customer.FieldNickname, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Avatar(); ok {
+		_spec.SetField(customer.FieldAvatar, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Phone(); ok {
+		_spec.SetField(customer.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.LastLoginAt(); ok {
+		_spec.SetField(customer.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if cu.mutation.LastLoginAtCleared() {
+		_spec.ClearField(customer.FieldLastLoginAt, field.TypeTime)
+	}
+	_spec.AddModifiers(cu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CustomerUpdateOne is the builder for updating a single Customer entity.
+type CustomerUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CustomerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CustomerUpdateOne) SetUpdatedAt(t time.Time) *CustomerUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetUnionid sets the "unionid" field.
+func (cuo *CustomerUpdateOne) SetUnionid(s string) *CustomerUpdateOne {
+	cuo.mutation.SetUnionid(s)
+	return cuo
+}
+
+// SetNillableUnionid sets the "unionid" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableUnionid(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetUnionid(*s)
+	}
+	return cuo
+}
+
+// SetNickname sets the "nickname" field.
+func (cuo *CustomerUpdateOne) SetNickname(s string) *CustomerUpdateOne {
+	cuo.mutation.SetNickname(s)
+	return cuo
+}
+
+// SetNillableNickname sets the "nickname" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableNickname(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetNickname(*s)
+	}
+	return cuo
+}
+
+// SetAvatar sets the "avatar" field.
+func (cuo *CustomerUpdateOne) SetAvatar(s string) *CustomerUpdateOne {
+	cuo.mutation.SetAvatar(s)
+	return cuo
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableAvatar(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetAvatar(*s)
+	}
+	return cuo
+}
+
+// SetPhone sets the "phone" field.
+func (cuo *CustomerUpdateOne) SetPhone(s string) *CustomerUpdateOne {
+	cuo.mutation.SetPhone(s)
+	return cuo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillablePhone(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetPhone(*s)
+	}
+	return cuo
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (cuo *CustomerUpdateOne) SetLastLoginAt(t time.Time) *CustomerUpdateOne {
+	cuo.mutation.SetLastLoginAt(t)
+	return cuo
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableLastLoginAt(t *time.Time) *CustomerUpdateOne {
+	if t != nil {
+		cuo.SetLastLoginAt(*t)
+	}
+	return cuo
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (cuo *CustomerUpdateOne) ClearLastLoginAt() *CustomerUpdateOne {
+	cuo.mutation.ClearLastLoginAt()
+	return cuo
+}
+
+// Mutation returns the CustomerMutation object of the builder.
+func (cuo *CustomerUpdateOne) Mutation() *CustomerMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CustomerUpdate builder.
+func (cuo *CustomerUpdateOne) Where(ps ...predicate.Customer) *CustomerUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CustomerUpdateOne) Select(field string, fields ...string) *CustomerUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Customer entity.
+func (cuo *CustomerUpdateOne) Save(ctx context.Context) (*Customer, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CustomerUpdateOne) SaveX(ctx context.Context) *Customer {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CustomerUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CustomerUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CustomerUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := customer.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CustomerUpdateOne) check() error {
+	if v, ok := cuo.mutation.Unionid(); ok {
+		if err := customer.UnionidValidator(v); err != nil {
+			return &ValidationError{Name: "unionid", err: fmt.Errorf(`ent: validator failed for field "Customer.unionid": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Nickname(); ok {
+		if err := customer.NicknameValidator(v); err != nil {
+			return &ValidationError{Name: "nickname", err: fmt.Errorf(`ent: validator failed for field "Customer.nickname": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Avatar(); ok {
+		if err := customer.AvatarValidator(v); err != nil {
+			return &ValidationError{Name: "avatar", err: fmt.Errorf(`ent: validator failed for field "Customer.avatar": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Phone(); ok {
+		if err := customer.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Customer.phone": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cuo *CustomerUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CustomerUpdateOne {
+	cuo.modifiers = append(cuo.modifiers, modifiers...)
+	return cuo
+}
+
+func (cuo *CustomerUpdateOne) sqlSave(ctx context.Context) (_node *Customer, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customer.Table, customer.Columns, sqlgraph.NewFieldSpec(customer.FieldID, field.TypeUUID))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Customer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customer.FieldID)
+		for _, f := range fields {
+			if !customer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(customer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.Unionid(); ok {
+		_spec.SetField(customer.FieldUnionid, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Nickname(); ok {
+		_spec.SetField(customer.FieldNickname, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Avatar(); ok {
+		_spec.SetField(customer.FieldAvatar, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Phone(); ok {
+		_spec.SetField(customer.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.LastLoginAt(); ok {
+		_spec.SetField(customer.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if cuo.mutation.LastLoginAtCleared() {
+		_spec.ClearField(customer.FieldLastLoginAt, field.TypeTime)
+	}
+	_spec.AddModifiers(cuo.modifiers...)
+	_node = &Customer{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryorder"
+)
+
+// DeliveryOrder is the model entity for the DeliveryOrder schema.
+type DeliveryOrder struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 外卖平台
+	Platform domain.DeliveryPlatformCode `json:"platform,omitempty"`
+	// 平台订单ID
+	PlatformOrderID string `json:"platform_order_id,omitempty"`
+	// 平台取餐码
+	ShortCode string `json:"short_code,omitempty"`
+	// 状态：pending=待接单；accepted=已接单；ready=已出餐；rejected=已拒单；cancelled=已取消
+	Status domain.DeliveryOrderStatus `json:"status,omitempty"`
+	// 门店订单ID
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// 商品
+	Items []domain.DeliveryOrderItem `json:"items,omitempty"`
+	// 商品小计
+	Subtotal decimal.Decimal `json:"subtotal,omitempty"`
+	// 配送费
+	DeliveryFee decimal.Decimal `json:"delivery_fee,omitempty"`
+	// 订单合计
+	Total decimal.Decimal `json:"total,omitempty"`
+	// 顾客姓名
+	CustomerName string `json:"customer_name,omitempty"`
+	// 顾客电话
+	CustomerPhone string `json:"customer_phone,omitempty"`
+	// 配送地址
+	Address string `json:"address,omitempty"`
+	// 订单备注
+	Remark string `json:"remark,omitempty"`
+	// 拒单原因
+	RejectReason string `json:"reject_reason,omitempty"`
+	// 是否自动接单
+	AutoAccepted bool `json:"auto_accepted,omitempty"`
+	// 下单时间
+	PlacedAt time.Time `json:"placed_at,omitempty"`
+	// 接单时间
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	// 出餐时间
+	ReadyAt      *time.Time `json:"ready_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeliveryOrder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deliveryorder.FieldItems:
+			values[i] = new([]byte)
+		case deliveryorder.FieldSubtotal, deliveryorder.FieldDeliveryFee, deliveryorder.FieldTotal:
+			values[i] = new(decimal.Decimal)
+		case deliveryorder.FieldAutoAccepted:
+			values[i] = new(sql.NullBool)
+		case deliveryorder.FieldPlatform, deliveryorder.FieldPlatformOrderID, deliveryorder.FieldShortCode, deliveryorder.FieldStatus, deliveryorder.FieldCustomerName, deliveryorder.FieldCustomerPhone, deliveryorder.FieldAddress, deliveryorder.FieldRemark, deliveryorder.FieldRejectReason:
+			values[i] = new(sql.NullString)
+		case deliveryorder.FieldCreatedAt, deliveryorder.FieldUpdatedAt, deliveryorder.FieldPlacedAt, deliveryorder.FieldAcceptedAt, deliveryorder.FieldReadyAt:
+			values[i] = new(sql.NullTime)
+		case deliveryorder.FieldID, deliveryorder.FieldMerchantID, deliveryorder.FieldStoreID, deliveryorder.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeliveryOrder fields.
+func (do *DeliveryOrder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deliveryorder.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				do.ID = *value
+			}
+		case deliveryorder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				do.CreatedAt = value.Time
+			}
+		case deliveryorder.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				do.UpdatedAt = value.Time
+			}
+		case deliveryorder.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				do.MerchantID = *value
+			}
+		case deliveryorder.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				do.StoreID = *value
+			}
+		case deliveryorder.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				do.Platform = domain.DeliveryPlatformCode(value.String)
+			}
+		case deliveryorder.FieldPlatformOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform_order_id", values[i])
+			} else if value.Valid {
+				do.PlatformOrderID = value.String
+			}
+		case deliveryorder.FieldShortCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field short_code", values[i])
+			} else if value.Valid {
+				do.ShortCode = value.String
+			}
+		case deliveryorder.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				do.Status = domain.DeliveryOrderStatus(value.String)
+			}
+		case deliveryorder.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				do.OrderID = *value
+			}
+		case deliveryorder.FieldItems:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field items", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &do.Items); err != nil {
+					return fmt.Errorf("unmarshal field items: %w", err)
+				}
+			}
+		case deliveryorder.FieldSubtotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
+			} else if value != nil {
+				do.Subtotal = *value
+			}
+		case deliveryorder.FieldDeliveryFee:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_fee", values[i])
+			} else if value != nil {
+				do.DeliveryFee = *value
+			}
+		case deliveryorder.FieldTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value != nil {
+				do.Total = *value
+			}
+		case deliveryorder.FieldCustomerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_name", values[i])
+			} else if value.Valid {
+				do.CustomerName = value.String
+			}
+		case deliveryorder.FieldCustomerPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_phone", values[i])
+			} else if value.Valid {
+				do.CustomerPhone = value.String
+			}
+		case deliveryorder.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				do.Address = value.String
+			}
+		case deliveryorder.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				do.Remark = value.String
+			}
+		case deliveryorder.FieldRejectReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reject_reason", values[i])
+			} else if value.Valid {
+				do.RejectReason = value.String
+			}
+		case deliveryorder.FieldAutoAccepted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_accepted", values[i])
+			} else if value.Valid {
+				do.AutoAccepted = value.Bool
+			}
+		case deliveryorder.FieldPlacedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field placed_at", values[i])
+			} else if value.Valid {
+				do.PlacedAt = value.Time
+			}
+		case deliveryorder.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				do.AcceptedAt = new(time.Time)
+				*do.AcceptedAt = value.Time
+			}
+		case deliveryorder.FieldReadyAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ready_at", values[i])
+			} else if value.Valid {
+				do.ReadyAt = new(time.Time)
+				*do.ReadyAt = value.Time
+			}
+		default:
+			do.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeliveryOrder.
+// This includes values selected through modifiers, order, etc.
+func (do *DeliveryOrder) Value(name string) (ent.Value, error) {
+	return do.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeliveryOrder.
+// Note that you need to call DeliveryOrder.Unwrap() before calling this method if this DeliveryOrder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (do *DeliveryOrder) Update() *DeliveryOrderUpdateOne {
+	return NewDeliveryOrderClient(do.config).UpdateOne(do)
+}
+
+// Unwrap unwraps the DeliveryOrder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (do *DeliveryOrder) Unwrap() *DeliveryOrder {
+	_tx, ok := do.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeliveryOrder is not a transactional entity")
+	}
+	do.config.driver = _tx.drv
+	return do
+}
+
+// String implements the fmt.Stringer.
+func (do *DeliveryOrder) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeliveryOrder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", do.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(do.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(do.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", do.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", do.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("platform=")
+	builder.WriteString(fmt.Sprintf("%v", do.Platform))
+	builder.WriteString(", ")
+	builder.WriteString("platform_order_id=")
+	builder.WriteString(do.PlatformOrderID)
+	builder.WriteString(", ")
+	builder.WriteString("short_code=")
+	builder.WriteString(do.ShortCode)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", do.Status))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", do.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("items=")
+	builder.WriteString(fmt.Sprintf("%v", do.Items))
+	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", do.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("delivery_fee=")
+	builder.WriteString(fmt.Sprintf("%v", do.DeliveryFee))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", do.Total))
+	builder.WriteString(", ")
+	builder.WriteString("customer_name=")
+	builder.WriteString(do.CustomerName)
+	builder.WriteString(", ")
+	builder.WriteString("customer_phone=")
+	builder.WriteString(do.CustomerPhone)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(do.Address)
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(do.Remark)
+	builder.WriteString(", ")
+	builder.WriteString("reject_reason=")
+	builder.WriteString(do.RejectReason)
+	builder.WriteString(", ")
+	builder.WriteString("auto_accepted=")
+	builder.WriteString(fmt.Sprintf("%v", do.AutoAccepted))
+	builder.WriteString(", ")
+	builder.WriteString("placed_at=")
+	builder.WriteString(do.PlacedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := do.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := do.ReadyAt; v != nil {
+		builder.WriteString("ready_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeliveryOrders is a parsable slice of DeliveryOrder.
+type DeliveryOrders []*DeliveryOrder
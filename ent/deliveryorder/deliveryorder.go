@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deliveryorder
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	// Label holds the string label denoting the deliveryorder type in the database.
+	Label = "delivery_order"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldPlatform holds the string denoting the platform field in the database.
+	FieldPlatform = "platform"
+	// FieldPlatformOrderID holds the string denoting the platform_order_id field in the database.
+	FieldPlatformOrderID = "platform_order_id"
+	// FieldShortCode holds the string denoting the short_code field in the database.
+	FieldShortCode = "short_code"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldItems holds the string denoting the items field in the database.
+	FieldItems = "items"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldDeliveryFee holds the string denoting the delivery_fee field in the database.
+	FieldDeliveryFee = "delivery_fee"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldCustomerName holds the string denoting the customer_name field in the database.
+	FieldCustomerName = "customer_name"
+	// FieldCustomerPhone holds the string denoting the customer_phone field in the database.
+	FieldCustomerPhone = "customer_phone"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldRemark holds the string denoting the remark field in the database.
+	FieldRemark = "remark"
+	// FieldRejectReason holds the string denoting the reject_reason field in the database.
+	FieldRejectReason = "reject_reason"
+	// FieldAutoAccepted holds the string denoting the auto_accepted field in the database.
+	FieldAutoAccepted = "auto_accepted"
+	// FieldPlacedAt holds the string denoting the placed_at field in the database.
+	FieldPlacedAt = "placed_at"
+	// FieldAcceptedAt holds the string denoting the accepted_at field in the database.
+	FieldAcceptedAt = "accepted_at"
+	// FieldReadyAt holds the string denoting the ready_at field in the database.
+	FieldReadyAt = "ready_at"
+	// Table holds the table name of the deliveryorder in the database.
+	Table = "delivery_orders"
+)
+
+// Columns holds all SQL columns for deliveryorder fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldPlatform,
+	FieldPlatformOrderID,
+	FieldShortCode,
+	FieldStatus,
+	FieldOrderID,
+	FieldItems,
+	FieldSubtotal,
+	FieldDeliveryFee,
+	FieldTotal,
+	FieldCustomerName,
+	FieldCustomerPhone,
+	FieldAddress,
+	FieldRemark,
+	FieldRejectReason,
+	FieldAutoAccepted,
+	FieldPlacedAt,
+	FieldAcceptedAt,
+	FieldReadyAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// PlatformOrderIDValidator is a validator for the "platform_order_id" field. It is called by the builders before save.
+	PlatformOrderIDValidator func(string) error
+	// DefaultShortCode holds the default value on creation for the "short_code" field.
+	DefaultShortCode string
+	// ShortCodeValidator is a validator for the "short_code" field. It is called by the builders before save.
+	ShortCodeValidator func(string) error
+	// DefaultOrderID holds the default value on creation for the "order_id" field.
+	DefaultOrderID func() uuid.UUID
+	// DefaultCustomerName holds the default value on creation for the "customer_name" field.
+	DefaultCustomerName string
+	// CustomerNameValidator is a validator for the "customer_name" field. It is called by the builders before save.
+	CustomerNameValidator func(string) error
+	// DefaultCustomerPhone holds the default value on creation for the "customer_phone" field.
+	DefaultCustomerPhone string
+	// CustomerPhoneValidator is a validator for the "customer_phone" field. It is called by the builders before save.
+	CustomerPhoneValidator func(string) error
+	// DefaultAddress holds the default value on creation for the "address" field.
+	DefaultAddress string
+	// AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	AddressValidator func(string) error
+	// DefaultRemark holds the default value on creation for the "remark" field.
+	DefaultRemark string
+	// RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	RemarkValidator func(string) error
+	// DefaultRejectReason holds the default value on creation for the "reject_reason" field.
+	DefaultRejectReason string
+	// RejectReasonValidator is a validator for the "reject_reason" field. It is called by the builders before save.
+	RejectReasonValidator func(string) error
+	// DefaultAutoAccepted holds the default value on creation for the "auto_accepted" field.
+	DefaultAutoAccepted bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// PlatformValidator is a validator for the "platform" field enum values. It is called by the builders before save.
+func PlatformValidator(pl domain.DeliveryPlatformCode) error {
+	switch pl {
+	case "grabfood", "foodpanda":
+		return nil
+	default:
+		return fmt.Errorf("deliveryorder: invalid enum value for platform field: %q", pl)
+	}
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s domain.DeliveryOrderStatus) error {
+	switch s {
+	case "pending", "accepted", "ready", "rejected", "cancelled":
+		return nil
+	default:
+		return fmt.Errorf("deliveryorder: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the DeliveryOrder queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByPlatform orders the results by the platform field.
+func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
+}
+
+// ByPlatformOrderID orders the results by the platform_order_id field.
+func ByPlatformOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatformOrderID, opts...).ToFunc()
+}
+
+// ByShortCode orders the results by the short_code field.
+func ByShortCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShortCode, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// BySubtotal orders the results by the subtotal field.
+func BySubtotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtotal, opts...).ToFunc()
+}
+
+// ByDeliveryFee orders the results by the delivery_fee field.
+func ByDeliveryFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryFee, opts...).ToFunc()
+}
+
+// ByTotal orders the results by the total field.
+func ByTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotal, opts...).ToFunc()
+}
+
+// ByCustomerName orders the results by the customer_name field.
+func ByCustomerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerName, opts...).ToFunc()
+}
+
+// ByCustomerPhone orders the results by the customer_phone field.
+func ByCustomerPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerPhone, opts...).ToFunc()
+}
+
+// ByAddress orders the results by the address field.
+func ByAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddress, opts...).ToFunc()
+}
+
+// ByRemark orders the results by the remark field.
+func ByRemark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemark, opts...).ToFunc()
+}
+
+// ByRejectReason orders the results by the reject_reason field.
+func ByRejectReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectReason, opts...).ToFunc()
+}
+
+// ByAutoAccepted orders the results by the auto_accepted field.
+func ByAutoAccepted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoAccepted, opts...).ToFunc()
+}
+
+// ByPlacedAt orders the results by the placed_at field.
+func ByPlacedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlacedAt, opts...).ToFunc()
+}
+
+// ByAcceptedAt orders the results by the accepted_at field.
+func ByAcceptedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcceptedAt, opts...).ToFunc()
+}
+
+// ByReadyAt orders the results by the ready_at field.
+func ByReadyAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadyAt, opts...).ToFunc()
+}
@@ -0,0 +1,1153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deliveryorder
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldStoreID, v))
+}
+
+// PlatformOrderID applies equality check predicate on the "platform_order_id" field. It's identical to PlatformOrderIDEQ.
+func PlatformOrderID(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldPlatformOrderID, v))
+}
+
+// ShortCode applies equality check predicate on the "short_code" field. It's identical to ShortCodeEQ.
+func ShortCode(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldShortCode, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldOrderID, v))
+}
+
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// DeliveryFee applies equality check predicate on the "delivery_fee" field. It's identical to DeliveryFeeEQ.
+func DeliveryFee(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldDeliveryFee, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldTotal, v))
+}
+
+// CustomerName applies equality check predicate on the "customer_name" field. It's identical to CustomerNameEQ.
+func CustomerName(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldCustomerName, v))
+}
+
+// CustomerPhone applies equality check predicate on the "customer_phone" field. It's identical to CustomerPhoneEQ.
+func CustomerPhone(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldCustomerPhone, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldAddress, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldRemark, v))
+}
+
+// RejectReason applies equality check predicate on the "reject_reason" field. It's identical to RejectReasonEQ.
+func RejectReason(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldRejectReason, v))
+}
+
+// AutoAccepted applies equality check predicate on the "auto_accepted" field. It's identical to AutoAcceptedEQ.
+func AutoAccepted(v bool) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldAutoAccepted, v))
+}
+
+// PlacedAt applies equality check predicate on the "placed_at" field. It's identical to PlacedAtEQ.
+func PlacedAt(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldPlacedAt, v))
+}
+
+// AcceptedAt applies equality check predicate on the "accepted_at" field. It's identical to AcceptedAtEQ.
+func AcceptedAt(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// ReadyAt applies equality check predicate on the "ready_at" field. It's identical to ReadyAtEQ.
+func ReadyAt(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldReadyAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldStoreID, v))
+}
+
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v domain.DeliveryPlatformCode) predicate.DeliveryOrder {
+	vc := v
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldPlatform, vc))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v domain.DeliveryPlatformCode) predicate.DeliveryOrder {
+	vc := v
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldPlatform, vc))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...domain.DeliveryPlatformCode) predicate.DeliveryOrder {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryOrder(sql.FieldIn(FieldPlatform, v...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...domain.DeliveryPlatformCode) predicate.DeliveryOrder {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldPlatform, v...))
+}
+
+// PlatformOrderIDEQ applies the EQ predicate on the "platform_order_id" field.
+func PlatformOrderIDEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldPlatformOrderID, v))
+}
+
+// PlatformOrderIDNEQ applies the NEQ predicate on the "platform_order_id" field.
+func PlatformOrderIDNEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldPlatformOrderID, v))
+}
+
+// PlatformOrderIDIn applies the In predicate on the "platform_order_id" field.
+func PlatformOrderIDIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldPlatformOrderID, vs...))
+}
+
+// PlatformOrderIDNotIn applies the NotIn predicate on the "platform_order_id" field.
+func PlatformOrderIDNotIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldPlatformOrderID, vs...))
+}
+
+// PlatformOrderIDGT applies the GT predicate on the "platform_order_id" field.
+func PlatformOrderIDGT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldPlatformOrderID, v))
+}
+
+// PlatformOrderIDGTE applies the GTE predicate on the "platform_order_id" field.
+func PlatformOrderIDGTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldPlatformOrderID, v))
+}
+
+// PlatformOrderIDLT applies the LT predicate on the "platform_order_id" field.
+func PlatformOrderIDLT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldPlatformOrderID, v))
+}
+
+// PlatformOrderIDLTE applies the LTE predicate on the "platform_order_id" field.
+func PlatformOrderIDLTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldPlatformOrderID, v))
+}
+
+// PlatformOrderIDContains applies the Contains predicate on the "platform_order_id" field.
+func PlatformOrderIDContains(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContains(FieldPlatformOrderID, v))
+}
+
+// PlatformOrderIDHasPrefix applies the HasPrefix predicate on the "platform_order_id" field.
+func PlatformOrderIDHasPrefix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasPrefix(FieldPlatformOrderID, v))
+}
+
+// PlatformOrderIDHasSuffix applies the HasSuffix predicate on the "platform_order_id" field.
+func PlatformOrderIDHasSuffix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasSuffix(FieldPlatformOrderID, v))
+}
+
+// PlatformOrderIDEqualFold applies the EqualFold predicate on the "platform_order_id" field.
+func PlatformOrderIDEqualFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEqualFold(FieldPlatformOrderID, v))
+}
+
+// PlatformOrderIDContainsFold applies the ContainsFold predicate on the "platform_order_id" field.
+func PlatformOrderIDContainsFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContainsFold(FieldPlatformOrderID, v))
+}
+
+// ShortCodeEQ applies the EQ predicate on the "short_code" field.
+func ShortCodeEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldShortCode, v))
+}
+
+// ShortCodeNEQ applies the NEQ predicate on the "short_code" field.
+func ShortCodeNEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldShortCode, v))
+}
+
+// ShortCodeIn applies the In predicate on the "short_code" field.
+func ShortCodeIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldShortCode, vs...))
+}
+
+// ShortCodeNotIn applies the NotIn predicate on the "short_code" field.
+func ShortCodeNotIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldShortCode, vs...))
+}
+
+// ShortCodeGT applies the GT predicate on the "short_code" field.
+func ShortCodeGT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldShortCode, v))
+}
+
+// ShortCodeGTE applies the GTE predicate on the "short_code" field.
+func ShortCodeGTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldShortCode, v))
+}
+
+// ShortCodeLT applies the LT predicate on the "short_code" field.
+func ShortCodeLT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldShortCode, v))
+}
+
+// ShortCodeLTE applies the LTE predicate on the "short_code" field.
+func ShortCodeLTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldShortCode, v))
+}
+
+// ShortCodeContains applies the Contains predicate on the "short_code" field.
+func ShortCodeContains(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContains(FieldShortCode, v))
+}
+
+// ShortCodeHasPrefix applies the HasPrefix predicate on the "short_code" field.
+func ShortCodeHasPrefix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasPrefix(FieldShortCode, v))
+}
+
+// ShortCodeHasSuffix applies the HasSuffix predicate on the "short_code" field.
+func ShortCodeHasSuffix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasSuffix(FieldShortCode, v))
+}
+
+// ShortCodeEqualFold applies the EqualFold predicate on the "short_code" field.
+func ShortCodeEqualFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEqualFold(FieldShortCode, v))
+}
+
+// ShortCodeContainsFold applies the ContainsFold predicate on the "short_code" field.
+func ShortCodeContainsFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContainsFold(FieldShortCode, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v domain.DeliveryOrderStatus) predicate.DeliveryOrder {
+	vc := v
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v domain.DeliveryOrderStatus) predicate.DeliveryOrder {
+	vc := v
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...domain.DeliveryOrderStatus) predicate.DeliveryOrder {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryOrder(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...domain.DeliveryOrderStatus) predicate.DeliveryOrder {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v uuid.UUID) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldOrderID, v))
+}
+
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldSubtotal, v))
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldSubtotal, vs...))
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldSubtotal, vs...))
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldSubtotal, v))
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldSubtotal, v))
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldSubtotal, v))
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldSubtotal, v))
+}
+
+// DeliveryFeeEQ applies the EQ predicate on the "delivery_fee" field.
+func DeliveryFeeEQ(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeNEQ applies the NEQ predicate on the "delivery_fee" field.
+func DeliveryFeeNEQ(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeIn applies the In predicate on the "delivery_fee" field.
+func DeliveryFeeIn(vs ...decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldDeliveryFee, vs...))
+}
+
+// DeliveryFeeNotIn applies the NotIn predicate on the "delivery_fee" field.
+func DeliveryFeeNotIn(vs ...decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldDeliveryFee, vs...))
+}
+
+// DeliveryFeeGT applies the GT predicate on the "delivery_fee" field.
+func DeliveryFeeGT(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeGTE applies the GTE predicate on the "delivery_fee" field.
+func DeliveryFeeGTE(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeLT applies the LT predicate on the "delivery_fee" field.
+func DeliveryFeeLT(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeLTE applies the LTE predicate on the "delivery_fee" field.
+func DeliveryFeeLTE(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldDeliveryFee, v))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v decimal.Decimal) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldTotal, v))
+}
+
+// CustomerNameEQ applies the EQ predicate on the "customer_name" field.
+func CustomerNameEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldCustomerName, v))
+}
+
+// CustomerNameNEQ applies the NEQ predicate on the "customer_name" field.
+func CustomerNameNEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldCustomerName, v))
+}
+
+// CustomerNameIn applies the In predicate on the "customer_name" field.
+func CustomerNameIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldCustomerName, vs...))
+}
+
+// CustomerNameNotIn applies the NotIn predicate on the "customer_name" field.
+func CustomerNameNotIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldCustomerName, vs...))
+}
+
+// CustomerNameGT applies the GT predicate on the "customer_name" field.
+func CustomerNameGT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldCustomerName, v))
+}
+
+// CustomerNameGTE applies the GTE predicate on the "customer_name" field.
+func CustomerNameGTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldCustomerName, v))
+}
+
+// CustomerNameLT applies the LT predicate on the "customer_name" field.
+func CustomerNameLT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldCustomerName, v))
+}
+
+// CustomerNameLTE applies the LTE predicate on the "customer_name" field.
+func CustomerNameLTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldCustomerName, v))
+}
+
+// CustomerNameContains applies the Contains predicate on the "customer_name" field.
+func CustomerNameContains(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContains(FieldCustomerName, v))
+}
+
+// CustomerNameHasPrefix applies the HasPrefix predicate on the "customer_name" field.
+func CustomerNameHasPrefix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasPrefix(FieldCustomerName, v))
+}
+
+// CustomerNameHasSuffix applies the HasSuffix predicate on the "customer_name" field.
+func CustomerNameHasSuffix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasSuffix(FieldCustomerName, v))
+}
+
+// CustomerNameEqualFold applies the EqualFold predicate on the "customer_name" field.
+func CustomerNameEqualFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEqualFold(FieldCustomerName, v))
+}
+
+// CustomerNameContainsFold applies the ContainsFold predicate on the "customer_name" field.
+func CustomerNameContainsFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContainsFold(FieldCustomerName, v))
+}
+
+// CustomerPhoneEQ applies the EQ predicate on the "customer_phone" field.
+func CustomerPhoneEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldCustomerPhone, v))
+}
+
+// CustomerPhoneNEQ applies the NEQ predicate on the "customer_phone" field.
+func CustomerPhoneNEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldCustomerPhone, v))
+}
+
+// CustomerPhoneIn applies the In predicate on the "customer_phone" field.
+func CustomerPhoneIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldCustomerPhone, vs...))
+}
+
+// CustomerPhoneNotIn applies the NotIn predicate on the "customer_phone" field.
+func CustomerPhoneNotIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldCustomerPhone, vs...))
+}
+
+// CustomerPhoneGT applies the GT predicate on the "customer_phone" field.
+func CustomerPhoneGT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldCustomerPhone, v))
+}
+
+// CustomerPhoneGTE applies the GTE predicate on the "customer_phone" field.
+func CustomerPhoneGTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldCustomerPhone, v))
+}
+
+// CustomerPhoneLT applies the LT predicate on the "customer_phone" field.
+func CustomerPhoneLT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldCustomerPhone, v))
+}
+
+// CustomerPhoneLTE applies the LTE predicate on the "customer_phone" field.
+func CustomerPhoneLTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldCustomerPhone, v))
+}
+
+// CustomerPhoneContains applies the Contains predicate on the "customer_phone" field.
+func CustomerPhoneContains(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContains(FieldCustomerPhone, v))
+}
+
+// CustomerPhoneHasPrefix applies the HasPrefix predicate on the "customer_phone" field.
+func CustomerPhoneHasPrefix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasPrefix(FieldCustomerPhone, v))
+}
+
+// CustomerPhoneHasSuffix applies the HasSuffix predicate on the "customer_phone" field.
+func CustomerPhoneHasSuffix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasSuffix(FieldCustomerPhone, v))
+}
+
+// CustomerPhoneEqualFold applies the EqualFold predicate on the "customer_phone" field.
+func CustomerPhoneEqualFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEqualFold(FieldCustomerPhone, v))
+}
+
+// CustomerPhoneContainsFold applies the ContainsFold predicate on the "customer_phone" field.
+func CustomerPhoneContainsFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContainsFold(FieldCustomerPhone, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// RejectReasonEQ applies the EQ predicate on the "reject_reason" field.
+func RejectReasonEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldRejectReason, v))
+}
+
+// RejectReasonNEQ applies the NEQ predicate on the "reject_reason" field.
+func RejectReasonNEQ(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldRejectReason, v))
+}
+
+// RejectReasonIn applies the In predicate on the "reject_reason" field.
+func RejectReasonIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldRejectReason, vs...))
+}
+
+// RejectReasonNotIn applies the NotIn predicate on the "reject_reason" field.
+func RejectReasonNotIn(vs ...string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldRejectReason, vs...))
+}
+
+// RejectReasonGT applies the GT predicate on the "reject_reason" field.
+func RejectReasonGT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldRejectReason, v))
+}
+
+// RejectReasonGTE applies the GTE predicate on the "reject_reason" field.
+func RejectReasonGTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldRejectReason, v))
+}
+
+// RejectReasonLT applies the LT predicate on the "reject_reason" field.
+func RejectReasonLT(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldRejectReason, v))
+}
+
+// RejectReasonLTE applies the LTE predicate on the "reject_reason" field.
+func RejectReasonLTE(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldRejectReason, v))
+}
+
+// RejectReasonContains applies the Contains predicate on the "reject_reason" field.
+func RejectReasonContains(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContains(FieldRejectReason, v))
+}
+
+// RejectReasonHasPrefix applies the HasPrefix predicate on the "reject_reason" field.
+func RejectReasonHasPrefix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasPrefix(FieldRejectReason, v))
+}
+
+// RejectReasonHasSuffix applies the HasSuffix predicate on the "reject_reason" field.
+func RejectReasonHasSuffix(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldHasSuffix(FieldRejectReason, v))
+}
+
+// RejectReasonEqualFold applies the EqualFold predicate on the "reject_reason" field.
+func RejectReasonEqualFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEqualFold(FieldRejectReason, v))
+}
+
+// RejectReasonContainsFold applies the ContainsFold predicate on the "reject_reason" field.
+func RejectReasonContainsFold(v string) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldContainsFold(FieldRejectReason, v))
+}
+
+// AutoAcceptedEQ applies the EQ predicate on the "auto_accepted" field.
+func AutoAcceptedEQ(v bool) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldAutoAccepted, v))
+}
+
+// AutoAcceptedNEQ applies the NEQ predicate on the "auto_accepted" field.
+func AutoAcceptedNEQ(v bool) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldAutoAccepted, v))
+}
+
+// PlacedAtEQ applies the EQ predicate on the "placed_at" field.
+func PlacedAtEQ(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldPlacedAt, v))
+}
+
+// PlacedAtNEQ applies the NEQ predicate on the "placed_at" field.
+func PlacedAtNEQ(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldPlacedAt, v))
+}
+
+// PlacedAtIn applies the In predicate on the "placed_at" field.
+func PlacedAtIn(vs ...time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldPlacedAt, vs...))
+}
+
+// PlacedAtNotIn applies the NotIn predicate on the "placed_at" field.
+func PlacedAtNotIn(vs ...time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldPlacedAt, vs...))
+}
+
+// PlacedAtGT applies the GT predicate on the "placed_at" field.
+func PlacedAtGT(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldPlacedAt, v))
+}
+
+// PlacedAtGTE applies the GTE predicate on the "placed_at" field.
+func PlacedAtGTE(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldPlacedAt, v))
+}
+
+// PlacedAtLT applies the LT predicate on the "placed_at" field.
+func PlacedAtLT(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldPlacedAt, v))
+}
+
+// PlacedAtLTE applies the LTE predicate on the "placed_at" field.
+func PlacedAtLTE(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldPlacedAt, v))
+}
+
+// AcceptedAtEQ applies the EQ predicate on the "accepted_at" field.
+func AcceptedAtEQ(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtNEQ applies the NEQ predicate on the "accepted_at" field.
+func AcceptedAtNEQ(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIn applies the In predicate on the "accepted_at" field.
+func AcceptedAtIn(vs ...time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtNotIn applies the NotIn predicate on the "accepted_at" field.
+func AcceptedAtNotIn(vs ...time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtGT applies the GT predicate on the "accepted_at" field.
+func AcceptedAtGT(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtGTE applies the GTE predicate on the "accepted_at" field.
+func AcceptedAtGTE(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLT applies the LT predicate on the "accepted_at" field.
+func AcceptedAtLT(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLTE applies the LTE predicate on the "accepted_at" field.
+func AcceptedAtLTE(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIsNil applies the IsNil predicate on the "accepted_at" field.
+func AcceptedAtIsNil() predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIsNull(FieldAcceptedAt))
+}
+
+// AcceptedAtNotNil applies the NotNil predicate on the "accepted_at" field.
+func AcceptedAtNotNil() predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotNull(FieldAcceptedAt))
+}
+
+// ReadyAtEQ applies the EQ predicate on the "ready_at" field.
+func ReadyAtEQ(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldEQ(FieldReadyAt, v))
+}
+
+// ReadyAtNEQ applies the NEQ predicate on the "ready_at" field.
+func ReadyAtNEQ(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNEQ(FieldReadyAt, v))
+}
+
+// ReadyAtIn applies the In predicate on the "ready_at" field.
+func ReadyAtIn(vs ...time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIn(FieldReadyAt, vs...))
+}
+
+// ReadyAtNotIn applies the NotIn predicate on the "ready_at" field.
+func ReadyAtNotIn(vs ...time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotIn(FieldReadyAt, vs...))
+}
+
+// ReadyAtGT applies the GT predicate on the "ready_at" field.
+func ReadyAtGT(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGT(FieldReadyAt, v))
+}
+
+// ReadyAtGTE applies the GTE predicate on the "ready_at" field.
+func ReadyAtGTE(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldGTE(FieldReadyAt, v))
+}
+
+// ReadyAtLT applies the LT predicate on the "ready_at" field.
+func ReadyAtLT(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLT(FieldReadyAt, v))
+}
+
+// ReadyAtLTE applies the LTE predicate on the "ready_at" field.
+func ReadyAtLTE(v time.Time) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldLTE(FieldReadyAt, v))
+}
+
+// ReadyAtIsNil applies the IsNil predicate on the "ready_at" field.
+func ReadyAtIsNil() predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldIsNull(FieldReadyAt))
+}
+
+// ReadyAtNotNil applies the NotNil predicate on the "ready_at" field.
+func ReadyAtNotNil() predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.FieldNotNull(FieldReadyAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeliveryOrder) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeliveryOrder) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeliveryOrder) predicate.DeliveryOrder {
+	return predicate.DeliveryOrder(sql.NotPredicates(p))
+}
@@ -0,0 +1,1363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryorder"
+)
+
+// DeliveryOrderCreate is the builder for creating a DeliveryOrder entity.
+type DeliveryOrderCreate struct {
+	config
+	mutation *DeliveryOrderMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (doc *DeliveryOrderCreate) SetCreatedAt(t time.Time) *DeliveryOrderCreate {
+	doc.mutation.SetCreatedAt(t)
+	return doc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableCreatedAt(t *time.Time) *DeliveryOrderCreate {
+	if t != nil {
+		doc.SetCreatedAt(*t)
+	}
+	return doc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (doc *DeliveryOrderCreate) SetUpdatedAt(t time.Time) *DeliveryOrderCreate {
+	doc.mutation.SetUpdatedAt(t)
+	return doc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableUpdatedAt(t *time.Time) *DeliveryOrderCreate {
+	if t != nil {
+		doc.SetUpdatedAt(*t)
+	}
+	return doc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (doc *DeliveryOrderCreate) SetMerchantID(u uuid.UUID) *DeliveryOrderCreate {
+	doc.mutation.SetMerchantID(u)
+	return doc
+}
+
+// SetStoreID sets the "store_id" field.
+func (doc *DeliveryOrderCreate) SetStoreID(u uuid.UUID) *DeliveryOrderCreate {
+	doc.mutation.SetStoreID(u)
+	return doc
+}
+
+// SetPlatform sets the "platform" field.
+func (doc *DeliveryOrderCreate) SetPlatform(dpc domain.DeliveryPlatformCode) *DeliveryOrderCreate {
+	doc.mutation.SetPlatform(dpc)
+	return doc
+}
+
+// SetPlatformOrderID sets the "platform_order_id" field.
+func (doc *DeliveryOrderCreate) SetPlatformOrderID(s string) *DeliveryOrderCreate {
+	doc.mutation.SetPlatformOrderID(s)
+	return doc
+}
+
+// SetShortCode sets the "short_code" field.
+func (doc *DeliveryOrderCreate) SetShortCode(s string) *DeliveryOrderCreate {
+	doc.mutation.SetShortCode(s)
+	return doc
+}
+
+// SetNillableShortCode sets the "short_code" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableShortCode(s *string) *DeliveryOrderCreate {
+	if s != nil {
+		doc.SetShortCode(*s)
+	}
+	return doc
+}
+
+// SetStatus sets the "status" field.
+func (doc *DeliveryOrderCreate) SetStatus(dos domain.DeliveryOrderStatus) *DeliveryOrderCreate {
+	doc.mutation.SetStatus(dos)
+	return doc
+}
+
+// SetOrderID sets the "order_id" field.
+func (doc *DeliveryOrderCreate) SetOrderID(u uuid.UUID) *DeliveryOrderCreate {
+	doc.mutation.SetOrderID(u)
+	return doc
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableOrderID(u *uuid.UUID) *DeliveryOrderCreate {
+	if u != nil {
+		doc.SetOrderID(*u)
+	}
+	return doc
+}
+
+// SetItems sets the "items" field.
+func (doc *DeliveryOrderCreate) SetItems(doi []domain.DeliveryOrderItem) *DeliveryOrderCreate {
+	doc.mutation.SetItems(doi)
+	return doc
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (doc *DeliveryOrderCreate) SetSubtotal(d decimal.Decimal) *DeliveryOrderCreate {
+	doc.mutation.SetSubtotal(d)
+	return doc
+}
+
+// SetDeliveryFee sets the "delivery_fee" field.
+func (doc *DeliveryOrderCreate) SetDeliveryFee(d decimal.Decimal) *DeliveryOrderCreate {
+	doc.mutation.SetDeliveryFee(d)
+	return doc
+}
+
+// SetTotal sets the "total" field.
+func (doc *DeliveryOrderCreate) SetTotal(d decimal.Decimal) *DeliveryOrderCreate {
+	doc.mutation.SetTotal(d)
+	return doc
+}
+
+// SetCustomerName sets the "customer_name" field.
+func (doc *DeliveryOrderCreate) SetCustomerName(s string) *DeliveryOrderCreate {
+	doc.mutation.SetCustomerName(s)
+	return doc
+}
+
+// SetNillableCustomerName sets the "customer_name" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableCustomerName(s *string) *DeliveryOrderCreate {
+	if s != nil {
+		doc.SetCustomerName(*s)
+	}
+	return doc
+}
+
+// SetCustomerPhone sets the "customer_phone" field.
+func (doc *DeliveryOrderCreate) SetCustomerPhone(s string) *DeliveryOrderCreate {
+	doc.mutation.SetCustomerPhone(s)
+	return doc
+}
+
+// SetNillableCustomerPhone sets the "customer_phone" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableCustomerPhone(s *string) *DeliveryOrderCreate {
+	if s != nil {
+		doc.SetCustomerPhone(*s)
+	}
+	return doc
+}
+
+// SetAddress sets the "address" field.
+func (doc *DeliveryOrderCreate) SetAddress(s string) *DeliveryOrderCreate {
+	doc.mutation.SetAddress(s)
+	return doc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableAddress(s *string) *DeliveryOrderCreate {
+	if s != nil {
+		doc.SetAddress(*s)
+	}
+	return doc
+}
+
+// SetRemark sets the "remark" field.
+func (doc *DeliveryOrderCreate) SetRemark(s string) *DeliveryOrderCreate {
+	doc.mutation.SetRemark(s)
+	return doc
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableRemark(s *string) *DeliveryOrderCreate {
+	if s != nil {
+		doc.SetRemark(*s)
+	}
+	return doc
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (doc *DeliveryOrderCreate) SetRejectReason(s string) *DeliveryOrderCreate {
+	doc.mutation.SetRejectReason(s)
+	return doc
+}
+
+// SetNillableRejectReason sets the "reject_reason" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableRejectReason(s *string) *DeliveryOrderCreate {
+	if s != nil {
+		doc.SetRejectReason(*s)
+	}
+	return doc
+}
+
+// SetAutoAccepted sets the "auto_accepted" field.
+func (doc *DeliveryOrderCreate) SetAutoAccepted(b bool) *DeliveryOrderCreate {
+	doc.mutation.SetAutoAccepted(b)
+	return doc
+}
+
+// SetNillableAutoAccepted sets the "auto_accepted" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableAutoAccepted(b *bool) *DeliveryOrderCreate {
+	if b != nil {
+		doc.SetAutoAccepted(*b)
+	}
+	return doc
+}
+
+// SetPlacedAt sets the "placed_at" field.
+func (doc *DeliveryOrderCreate) SetPlacedAt(t time.Time) *DeliveryOrderCreate {
+	doc.mutation.SetPlacedAt(t)
+	return doc
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (doc *DeliveryOrderCreate) SetAcceptedAt(t time.Time) *DeliveryOrderCreate {
+	doc.mutation.SetAcceptedAt(t)
+	return doc
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableAcceptedAt(t *time.Time) *DeliveryOrderCreate {
+	if t != nil {
+		doc.SetAcceptedAt(*t)
+	}
+	return doc
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (doc *DeliveryOrderCreate) SetReadyAt(t time.Time) *DeliveryOrderCreate {
+	doc.mutation.SetReadyAt(t)
+	return doc
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableReadyAt(t *time.Time) *DeliveryOrderCreate {
+	if t != nil {
+		doc.SetReadyAt(*t)
+	}
+	return doc
+}
+
+// SetID sets the "id" field.
+func (doc *DeliveryOrderCreate) SetID(u uuid.UUID) *DeliveryOrderCreate {
+	doc.mutation.SetID(u)
+	return doc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (doc *DeliveryOrderCreate) SetNillableID(u *uuid.UUID) *DeliveryOrderCreate {
+	if u != nil {
+		doc.SetID(*u)
+	}
+	return doc
+}
+
+// Mutation returns the DeliveryOrderMutation object of the builder.
+func (doc *DeliveryOrderCreate) Mutation() *DeliveryOrderMutation {
+	return doc.mutation
+}
+
+// Save creates the DeliveryOrder in the database.
+func (doc *DeliveryOrderCreate) Save(ctx context.Context) (*DeliveryOrder, error) {
+	doc.defaults()
+	return withHooks(ctx, doc.sqlSave, doc.mutation, doc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (doc *DeliveryOrderCreate) SaveX(ctx context.Context) *DeliveryOrder {
+	v, err := doc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (doc *DeliveryOrderCreate) Exec(ctx context.Context) error {
+	_, err := doc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (doc *DeliveryOrderCreate) ExecX(ctx context.Context) {
+	if err := doc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (doc *DeliveryOrderCreate) defaults() {
+	if _, ok := doc.mutation.CreatedAt(); !ok {
+		v := deliveryorder.DefaultCreatedAt()
+		doc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := doc.mutation.UpdatedAt(); !ok {
+		v := deliveryorder.DefaultUpdatedAt()
+		doc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := doc.mutation.ShortCode(); !ok {
+		v := deliveryorder.DefaultShortCode
+		doc.mutation.SetShortCode(v)
+	}
+	if _, ok := doc.mutation.OrderID(); !ok {
+		v := deliveryorder.DefaultOrderID()
+		doc.mutation.SetOrderID(v)
+	}
+	if _, ok := doc.mutation.CustomerName(); !ok {
+		v := deliveryorder.DefaultCustomerName
+		doc.mutation.SetCustomerName(v)
+	}
+	if _, ok := doc.mutation.CustomerPhone(); !ok {
+		v := deliveryorder.DefaultCustomerPhone
+		doc.mutation.SetCustomerPhone(v)
+	}
+	if _, ok := doc.mutation.Address(); !ok {
+		v := deliveryorder.DefaultAddress
+		doc.mutation.SetAddress(v)
+	}
+	if _, ok := doc.mutation.Remark(); !ok {
+		v := deliveryorder.DefaultRemark
+		doc.mutation.SetRemark(v)
+	}
+	if _, ok := doc.mutation.RejectReason(); !ok {
+		v := deliveryorder.DefaultRejectReason
+		doc.mutation.SetRejectReason(v)
+	}
+	if _, ok := doc.mutation.AutoAccepted(); !ok {
+		v := deliveryorder.DefaultAutoAccepted
+		doc.mutation.SetAutoAccepted(v)
+	}
+	if _, ok := doc.mutation.ID(); !ok {
+		v := deliveryorder.DefaultID()
+		doc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (doc *DeliveryOrderCreate) check() error {
+	if _, ok := doc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeliveryOrder.created_at"`)}
+	}
+	if _, ok := doc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeliveryOrder.updated_at"`)}
+	}
+	if _, ok := doc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "DeliveryOrder.merchant_id"`)}
+	}
+	if _, ok := doc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "DeliveryOrder.store_id"`)}
+	}
+	if _, ok := doc.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "DeliveryOrder.platform"`)}
+	}
+	if v, ok := doc.mutation.Platform(); ok {
+		if err := deliveryorder.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.platform": %w`, err)}
+		}
+	}
+	if _, ok := doc.mutation.PlatformOrderID(); !ok {
+		return &ValidationError{Name: "platform_order_id", err: errors.New(`ent: missing required field "DeliveryOrder.platform_order_id"`)}
+	}
+	if v, ok := doc.mutation.PlatformOrderID(); ok {
+		if err := deliveryorder.PlatformOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "platform_order_id", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.platform_order_id": %w`, err)}
+		}
+	}
+	if _, ok := doc.mutation.ShortCode(); !ok {
+		return &ValidationError{Name: "short_code", err: errors.New(`ent: missing required field "DeliveryOrder.short_code"`)}
+	}
+	if v, ok := doc.mutation.ShortCode(); ok {
+		if err := deliveryorder.ShortCodeValidator(v); err != nil {
+			return &ValidationError{Name: "short_code", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.short_code": %w`, err)}
+		}
+	}
+	if _, ok := doc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DeliveryOrder.status"`)}
+	}
+	if v, ok := doc.mutation.Status(); ok {
+		if err := deliveryorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.status": %w`, err)}
+		}
+	}
+	if _, ok := doc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "DeliveryOrder.order_id"`)}
+	}
+	if _, ok := doc.mutation.Items(); !ok {
+		return &ValidationError{Name: "items", err: errors.New(`ent: missing required field "DeliveryOrder.items"`)}
+	}
+	if _, ok := doc.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "DeliveryOrder.subtotal"`)}
+	}
+	if _, ok := doc.mutation.DeliveryFee(); !ok {
+		return &ValidationError{Name: "delivery_fee", err: errors.New(`ent: missing required field "DeliveryOrder.delivery_fee"`)}
+	}
+	if _, ok := doc.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "DeliveryOrder.total"`)}
+	}
+	if _, ok := doc.mutation.CustomerName(); !ok {
+		return &ValidationError{Name: "customer_name", err: errors.New(`ent: missing required field "DeliveryOrder.customer_name"`)}
+	}
+	if v, ok := doc.mutation.CustomerName(); ok {
+		if err := deliveryorder.CustomerNameValidator(v); err != nil {
+			return &ValidationError{Name: "customer_name", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.customer_name": %w`, err)}
+		}
+	}
+	if _, ok := doc.mutation.CustomerPhone(); !ok {
+		return &ValidationError{Name: "customer_phone", err: errors.New(`ent: missing required field "DeliveryOrder.customer_phone"`)}
+	}
+	if v, ok := doc.mutation.CustomerPhone(); ok {
+		if err := deliveryorder.CustomerPhoneValidator(v); err != nil {
+			return &ValidationError{Name: "customer_phone", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.customer_phone": %w`, err)}
+		}
+	}
+	if _, ok := doc.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "DeliveryOrder.address"`)}
+	}
+	if v, ok := doc.mutation.Address(); ok {
+		if err := deliveryorder.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.address": %w`, err)}
+		}
+	}
+	if _, ok := doc.mutation.Remark(); !ok {
+		return &ValidationError{Name: "remark", err: errors.New(`ent: missing required field "DeliveryOrder.remark"`)}
+	}
+	if v, ok := doc.mutation.Remark(); ok {
+		if err := deliveryorder.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.remark": %w`, err)}
+		}
+	}
+	if _, ok := doc.mutation.RejectReason(); !ok {
+		return &ValidationError{Name: "reject_reason", err: errors.New(`ent: missing required field "DeliveryOrder.reject_reason"`)}
+	}
+	if v, ok := doc.mutation.RejectReason(); ok {
+		if err := deliveryorder.RejectReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reject_reason", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.reject_reason": %w`, err)}
+		}
+	}
+	if _, ok := doc.mutation.AutoAccepted(); !ok {
+		return &ValidationError{Name: "auto_accepted", err: errors.New(`ent: missing required field "DeliveryOrder.auto_accepted"`)}
+	}
+	if _, ok := doc.mutation.PlacedAt(); !ok {
+		return &ValidationError{Name: "placed_at", err: errors.New(`ent: missing required field "DeliveryOrder.placed_at"`)}
+	}
+	return nil
+}
+
+func (doc *DeliveryOrderCreate) sqlSave(ctx context.Context) (*DeliveryOrder, error) {
+	if err := doc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := doc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, doc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	doc.mutation.id = &_node.ID
+	doc.mutation.done = true
+	return _node, nil
+}
+
+func (doc *DeliveryOrderCreate) createSpec() (*DeliveryOrder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeliveryOrder{config: doc.config}
+		_spec = sqlgraph.NewCreateSpec(deliveryorder.Table, sqlgraph.NewFieldSpec(deliveryorder.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = doc.conflict
+	if id, ok := doc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := doc.mutation.CreatedAt(); ok {
+		_spec.SetField(deliveryorder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := doc.mutation.UpdatedAt(); ok {
+		_spec.SetField(deliveryorder.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := doc.mutation.MerchantID(); ok {
+		_spec.SetField(deliveryorder.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := doc.mutation.StoreID(); ok {
+		_spec.SetField(deliveryorder.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := doc.mutation.Platform(); ok {
+		_spec.SetField(deliveryorder.FieldPlatform, field.TypeEnum, value)
+		_node.Platform = value
+	}
+	if value, ok := doc.mutation.PlatformOrderID(); ok {
+		_spec.SetField(deliveryorder.FieldPlatformOrderID, field.TypeString, value)
+		_node.PlatformOrderID = value
+	}
+	if value, ok := doc.mutation.ShortCode(); ok {
+		_spec.SetField(deliveryorder.FieldShortCode, field.TypeString, value)
+		_node.ShortCode = value
+	}
+	if value, ok := doc.mutation.Status(); ok {
+		_spec.SetField(deliveryorder.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := doc.mutation.OrderID(); ok {
+		_spec.SetField(deliveryorder.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := doc.mutation.Items(); ok {
+		_spec.SetField(deliveryorder.FieldItems, field.TypeJSON, value)
+		_node.Items = value
+	}
+	if value, ok := doc.mutation.Subtotal(); ok {
+		_spec.SetField(deliveryorder.FieldSubtotal, field.TypeOther, value)
+		_node.Subtotal = value
+	}
+	if value, ok := doc.mutation.DeliveryFee(); ok {
+		_spec.SetField(deliveryorder.FieldDeliveryFee, field.TypeOther, value)
+		_node.DeliveryFee = value
+	}
+	if value, ok := doc.mutation.Total(); ok {
+		_spec.SetField(deliveryorder.FieldTotal, field.TypeOther, value)
+		_node.Total = value
+	}
+	if value, ok := doc.mutation.CustomerName(); ok {
+		_spec.SetField(deliveryorder.FieldCustomerName, field.TypeString, value)
+		_node.CustomerName = value
+	}
+	if value, ok := doc.mutation.CustomerPhone(); ok {
+		_spec.SetField(deliveryorder.FieldCustomerPhone, field.TypeString, value)
+		_node.CustomerPhone = value
+	}
+	if value, ok := doc.mutation.Address(); ok {
+		_spec.SetField(deliveryorder.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := doc.mutation.Remark(); ok {
+		_spec.SetField(deliveryorder.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	if value, ok := doc.mutation.RejectReason(); ok {
+		_spec.SetField(deliveryorder.FieldRejectReason, field.TypeString, value)
+		_node.RejectReason = value
+	}
+	if value, ok := doc.mutation.AutoAccepted(); ok {
+		_spec.SetField(deliveryorder.FieldAutoAccepted, field.TypeBool, value)
+		_node.AutoAccepted = value
+	}
+	if value, ok := doc.mutation.PlacedAt(); ok {
+		_spec.SetField(deliveryorder.FieldPlacedAt, field.TypeTime, value)
+		_node.PlacedAt = value
+	}
+	if value, ok := doc.mutation.AcceptedAt(); ok {
+		_spec.SetField(deliveryorder.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = &value
+	}
+	if value, ok := doc.mutation.ReadyAt(); ok {
+		_spec.SetField(deliveryorder.FieldReadyAt, field.TypeTime, value)
+		_node.ReadyAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeliveryOrder.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeliveryOrderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (doc *DeliveryOrderCreate) OnConflict(opts ...sql.ConflictOption) *DeliveryOrderUpsertOne {
+	doc.conflict = opts
+	return &DeliveryOrderUpsertOne{
+		create: doc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeliveryOrder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (doc *DeliveryOrderCreate) OnConflictColumns(columns ...string) *DeliveryOrderUpsertOne {
+	doc.conflict = append(doc.conflict, sql.ConflictColumns(columns...))
+	return &DeliveryOrderUpsertOne{
+		create: doc,
+	}
+}
+
+type (
+	// DeliveryOrderUpsertOne is the builder for "upsert"-ing
+	//  one DeliveryOrder node.
+	DeliveryOrderUpsertOne struct {
+		create *DeliveryOrderCreate
+	}
+
+	// DeliveryOrderUpsert is the "OnConflict" setter.
+	DeliveryOrderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeliveryOrderUpsert) SetUpdatedAt(v time.Time) *DeliveryOrderUpsert {
+	u.Set(deliveryorder.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeliveryOrderUpsert) UpdateUpdatedAt() *DeliveryOrderUpsert {
+	u.SetExcluded(deliveryorder.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DeliveryOrderUpsert) SetStatus(v domain.DeliveryOrderStatus) *DeliveryOrderUpsert {
+	u.Set(deliveryorder.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeliveryOrderUpsert) UpdateStatus() *DeliveryOrderUpsert {
+	u.SetExcluded(deliveryorder.FieldStatus)
+	return u
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *DeliveryOrderUpsert) SetOrderID(v uuid.UUID) *DeliveryOrderUpsert {
+	u.Set(deliveryorder.FieldOrderID, v)
+	return u
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *DeliveryOrderUpsert) UpdateOrderID() *DeliveryOrderUpsert {
+	u.SetExcluded(deliveryorder.FieldOrderID)
+	return u
+}
+
+// SetItems sets the "items" field.
+func (u *DeliveryOrderUpsert) SetItems(v []domain.DeliveryOrderItem) *DeliveryOrderUpsert {
+	u.Set(deliveryorder.FieldItems, v)
+	return u
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *DeliveryOrderUpsert) UpdateItems() *DeliveryOrderUpsert {
+	u.SetExcluded(deliveryorder.FieldItems)
+	return u
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (u *DeliveryOrderUpsert) SetRejectReason(v string) *DeliveryOrderUpsert {
+	u.Set(deliveryorder.FieldRejectReason, v)
+	return u
+}
+
+// UpdateRejectReason sets the "reject_reason" field to the value that was provided on create.
+func (u *DeliveryOrderUpsert) UpdateRejectReason() *DeliveryOrderUpsert {
+	u.SetExcluded(deliveryorder.FieldRejectReason)
+	return u
+}
+
+// SetAutoAccepted sets the "auto_accepted" field.
+func (u *DeliveryOrderUpsert) SetAutoAccepted(v bool) *DeliveryOrderUpsert {
+	u.Set(deliveryorder.FieldAutoAccepted, v)
+	return u
+}
+
+// UpdateAutoAccepted sets the "auto_accepted" field to the value that was provided on create.
+func (u *DeliveryOrderUpsert) UpdateAutoAccepted() *DeliveryOrderUpsert {
+	u.SetExcluded(deliveryorder.FieldAutoAccepted)
+	return u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *DeliveryOrderUpsert) SetAcceptedAt(v time.Time) *DeliveryOrderUpsert {
+	u.Set(deliveryorder.FieldAcceptedAt, v)
+	return u
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *DeliveryOrderUpsert) UpdateAcceptedAt() *DeliveryOrderUpsert {
+	u.SetExcluded(deliveryorder.FieldAcceptedAt)
+	return u
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *DeliveryOrderUpsert) ClearAcceptedAt() *DeliveryOrderUpsert {
+	u.SetNull(deliveryorder.FieldAcceptedAt)
+	return u
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (u *DeliveryOrderUpsert) SetReadyAt(v time.Time) *DeliveryOrderUpsert {
+	u.Set(deliveryorder.FieldReadyAt, v)
+	return u
+}
+
+// UpdateReadyAt sets the "ready_at" field to the value that was provided on create.
+func (u *DeliveryOrderUpsert) UpdateReadyAt() *DeliveryOrderUpsert {
+	u.SetExcluded(deliveryorder.FieldReadyAt)
+	return u
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (u *DeliveryOrderUpsert) ClearReadyAt() *DeliveryOrderUpsert {
+	u.SetNull(deliveryorder.FieldReadyAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeliveryOrder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deliveryorder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeliveryOrderUpsertOne) UpdateNewValues() *DeliveryOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deliveryorder.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(deliveryorder.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(deliveryorder.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(deliveryorder.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.Platform(); exists {
+			s.SetIgnore(deliveryorder.FieldPlatform)
+		}
+		if _, exists := u.create.mutation.PlatformOrderID(); exists {
+			s.SetIgnore(deliveryorder.FieldPlatformOrderID)
+		}
+		if _, exists := u.create.mutation.ShortCode(); exists {
+			s.SetIgnore(deliveryorder.FieldShortCode)
+		}
+		if _, exists := u.create.mutation.Subtotal(); exists {
+			s.SetIgnore(deliveryorder.FieldSubtotal)
+		}
+		if _, exists := u.create.mutation.DeliveryFee(); exists {
+			s.SetIgnore(deliveryorder.FieldDeliveryFee)
+		}
+		if _, exists := u.create.mutation.Total(); exists {
+			s.SetIgnore(deliveryorder.FieldTotal)
+		}
+		if _, exists := u.create.mutation.CustomerName(); exists {
+			s.SetIgnore(deliveryorder.FieldCustomerName)
+		}
+		if _, exists := u.create.mutation.CustomerPhone(); exists {
+			s.SetIgnore(deliveryorder.FieldCustomerPhone)
+		}
+		if _, exists := u.create.mutation.Address(); exists {
+			s.SetIgnore(deliveryorder.FieldAddress)
+		}
+		if _, exists := u.create.mutation.Remark(); exists {
+			s.SetIgnore(deliveryorder.FieldRemark)
+		}
+		if _, exists := u.create.mutation.PlacedAt(); exists {
+			s.SetIgnore(deliveryorder.FieldPlacedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeliveryOrder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeliveryOrderUpsertOne) Ignore() *DeliveryOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeliveryOrderUpsertOne) DoNothing() *DeliveryOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeliveryOrderCreate.OnConflict
+// documentation for more info.
+func (u *DeliveryOrderUpsertOne) Update(set func(*DeliveryOrderUpsert)) *DeliveryOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeliveryOrderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeliveryOrderUpsertOne) SetUpdatedAt(v time.Time) *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertOne) UpdateUpdatedAt() *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DeliveryOrderUpsertOne) SetStatus(v domain.DeliveryOrderStatus) *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertOne) UpdateStatus() *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *DeliveryOrderUpsertOne) SetOrderID(v uuid.UUID) *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetOrderID(v)
+	})
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertOne) UpdateOrderID() *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateOrderID()
+	})
+}
+
+// SetItems sets the "items" field.
+func (u *DeliveryOrderUpsertOne) SetItems(v []domain.DeliveryOrderItem) *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetItems(v)
+	})
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertOne) UpdateItems() *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateItems()
+	})
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (u *DeliveryOrderUpsertOne) SetRejectReason(v string) *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetRejectReason(v)
+	})
+}
+
+// UpdateRejectReason sets the "reject_reason" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertOne) UpdateRejectReason() *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateRejectReason()
+	})
+}
+
+// SetAutoAccepted sets the "auto_accepted" field.
+func (u *DeliveryOrderUpsertOne) SetAutoAccepted(v bool) *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetAutoAccepted(v)
+	})
+}
+
+// UpdateAutoAccepted sets the "auto_accepted" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertOne) UpdateAutoAccepted() *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateAutoAccepted()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *DeliveryOrderUpsertOne) SetAcceptedAt(v time.Time) *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertOne) UpdateAcceptedAt() *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *DeliveryOrderUpsertOne) ClearAcceptedAt() *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (u *DeliveryOrderUpsertOne) SetReadyAt(v time.Time) *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetReadyAt(v)
+	})
+}
+
+// UpdateReadyAt sets the "ready_at" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertOne) UpdateReadyAt() *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateReadyAt()
+	})
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (u *DeliveryOrderUpsertOne) ClearReadyAt() *DeliveryOrderUpsertOne {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.ClearReadyAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeliveryOrderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeliveryOrderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeliveryOrderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeliveryOrderUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DeliveryOrderUpsertOne.ID is not supported by MySQL driver. Use DeliveryOrderUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeliveryOrderUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeliveryOrderCreateBulk is the builder for creating many DeliveryOrder entities in bulk.
+type DeliveryOrderCreateBulk struct {
+	config
+	err      error
+	builders []*DeliveryOrderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeliveryOrder entities in the database.
+func (docb *DeliveryOrderCreateBulk) Save(ctx context.Context) ([]*DeliveryOrder, error) {
+	if docb.err != nil {
+		return nil, docb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(docb.builders))
+	nodes := make([]*DeliveryOrder, len(docb.builders))
+	mutators := make([]Mutator, len(docb.builders))
+	for i := range docb.builders {
+		func(i int, root context.Context) {
+			builder := docb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeliveryOrderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, docb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = docb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, docb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, docb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (docb *DeliveryOrderCreateBulk) SaveX(ctx context.Context) []*DeliveryOrder {
+	v, err := docb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (docb *DeliveryOrderCreateBulk) Exec(ctx context.Context) error {
+	_, err := docb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (docb *DeliveryOrderCreateBulk) ExecX(ctx context.Context) {
+	if err := docb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeliveryOrder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeliveryOrderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (docb *DeliveryOrderCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeliveryOrderUpsertBulk {
+	docb.conflict = opts
+	return &DeliveryOrderUpsertBulk{
+		create: docb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeliveryOrder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (docb *DeliveryOrderCreateBulk) OnConflictColumns(columns ...string) *DeliveryOrderUpsertBulk {
+	docb.conflict = append(docb.conflict, sql.ConflictColumns(columns...))
+	return &DeliveryOrderUpsertBulk{
+		create: docb,
+	}
+}
+
+// DeliveryOrderUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeliveryOrder nodes.
+type DeliveryOrderUpsertBulk struct {
+	create *DeliveryOrderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeliveryOrder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deliveryorder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeliveryOrderUpsertBulk) UpdateNewValues() *DeliveryOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deliveryorder.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(deliveryorder.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(deliveryorder.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(deliveryorder.FieldStoreID)
+			}
+			if _, exists := b.mutation.Platform(); exists {
+				s.SetIgnore(deliveryorder.FieldPlatform)
+			}
+			if _, exists := b.mutation.PlatformOrderID(); exists {
+				s.SetIgnore(deliveryorder.FieldPlatformOrderID)
+			}
+			if _, exists := b.mutation.ShortCode(); exists {
+				s.SetIgnore(deliveryorder.FieldShortCode)
+			}
+			if _, exists := b.mutation.Subtotal(); exists {
+				s.SetIgnore(deliveryorder.FieldSubtotal)
+			}
+			if _, exists := b.mutation.DeliveryFee(); exists {
+				s.SetIgnore(deliveryorder.FieldDeliveryFee)
+			}
+			if _, exists := b.mutation.Total(); exists {
+				s.SetIgnore(deliveryorder.FieldTotal)
+			}
+			if _, exists := b.mutation.CustomerName(); exists {
+				s.SetIgnore(deliveryorder.FieldCustomerName)
+			}
+			if _, exists := b.mutation.CustomerPhone(); exists {
+				s.SetIgnore(deliveryorder.FieldCustomerPhone)
+			}
+			if _, exists := b.mutation.Address(); exists {
+				s.SetIgnore(deliveryorder.FieldAddress)
+			}
+			if _, exists := b.mutation.Remark(); exists {
+				s.SetIgnore(deliveryorder.FieldRemark)
+			}
+			if _, exists := b.mutation.PlacedAt(); exists {
+				s.SetIgnore(deliveryorder.FieldPlacedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeliveryOrder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeliveryOrderUpsertBulk) Ignore() *DeliveryOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeliveryOrderUpsertBulk) DoNothing() *DeliveryOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeliveryOrderCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeliveryOrderUpsertBulk) Update(set func(*DeliveryOrderUpsert)) *DeliveryOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeliveryOrderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeliveryOrderUpsertBulk) SetUpdatedAt(v time.Time) *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertBulk) UpdateUpdatedAt() *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DeliveryOrderUpsertBulk) SetStatus(v domain.DeliveryOrderStatus) *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertBulk) UpdateStatus() *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *DeliveryOrderUpsertBulk) SetOrderID(v uuid.UUID) *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetOrderID(v)
+	})
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertBulk) UpdateOrderID() *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateOrderID()
+	})
+}
+
+// SetItems sets the "items" field.
+func (u *DeliveryOrderUpsertBulk) SetItems(v []domain.DeliveryOrderItem) *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetItems(v)
+	})
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertBulk) UpdateItems() *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateItems()
+	})
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (u *DeliveryOrderUpsertBulk) SetRejectReason(v string) *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetRejectReason(v)
+	})
+}
+
+// UpdateRejectReason sets the "reject_reason" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertBulk) UpdateRejectReason() *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateRejectReason()
+	})
+}
+
+// SetAutoAccepted sets the "auto_accepted" field.
+func (u *DeliveryOrderUpsertBulk) SetAutoAccepted(v bool) *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetAutoAccepted(v)
+	})
+}
+
+// UpdateAutoAccepted sets the "auto_accepted" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertBulk) UpdateAutoAccepted() *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateAutoAccepted()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *DeliveryOrderUpsertBulk) SetAcceptedAt(v time.Time) *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertBulk) UpdateAcceptedAt() *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *DeliveryOrderUpsertBulk) ClearAcceptedAt() *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (u *DeliveryOrderUpsertBulk) SetReadyAt(v time.Time) *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.SetReadyAt(v)
+	})
+}
+
+// UpdateReadyAt sets the "ready_at" field to the value that was provided on create.
+func (u *DeliveryOrderUpsertBulk) UpdateReadyAt() *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.UpdateReadyAt()
+	})
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (u *DeliveryOrderUpsertBulk) ClearReadyAt() *DeliveryOrderUpsertBulk {
+	return u.Update(func(s *DeliveryOrderUpsert) {
+		s.ClearReadyAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeliveryOrderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeliveryOrderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeliveryOrderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeliveryOrderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
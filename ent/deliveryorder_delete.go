@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryorder"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DeliveryOrderDelete is the builder for deleting a DeliveryOrder entity.
+type DeliveryOrderDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeliveryOrderMutation
+}
+
+// Where appends a list predicates to the DeliveryOrderDelete builder.
+func (dod *DeliveryOrderDelete) Where(ps ...predicate.DeliveryOrder) *DeliveryOrderDelete {
+	dod.mutation.Where(ps...)
+	return dod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dod *DeliveryOrderDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dod.sqlExec, dod.mutation, dod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dod *DeliveryOrderDelete) ExecX(ctx context.Context) int {
+	n, err := dod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dod *DeliveryOrderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deliveryorder.Table, sqlgraph.NewFieldSpec(deliveryorder.FieldID, field.TypeUUID))
+	if ps := dod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dod.mutation.done = true
+	return affected, err
+}
+
+// DeliveryOrderDeleteOne is the builder for deleting a single DeliveryOrder entity.
+type DeliveryOrderDeleteOne struct {
+	dod *DeliveryOrderDelete
+}
+
+// Where appends a list predicates to the DeliveryOrderDelete builder.
+func (dodo *DeliveryOrderDeleteOne) Where(ps ...predicate.DeliveryOrder) *DeliveryOrderDeleteOne {
+	dodo.dod.mutation.Where(ps...)
+	return dodo
+}
+
+// Exec executes the deletion query.
+func (dodo *DeliveryOrderDeleteOne) Exec(ctx context.Context) error {
+	n, err := dodo.dod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deliveryorder.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dodo *DeliveryOrderDeleteOne) ExecX(ctx context.Context) {
+	if err := dodo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryorder"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DeliveryOrderQuery is the builder for querying DeliveryOrder entities.
+type DeliveryOrderQuery struct {
+	config
+	ctx        *QueryContext
+	order      []deliveryorder.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DeliveryOrder
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DeliveryOrderQuery builder.
+func (doq *DeliveryOrderQuery) Where(ps ...predicate.DeliveryOrder) *DeliveryOrderQuery {
+	doq.predicates = append(doq.predicates, ps...)
+	return doq
+}
+
+// Limit the number of records to be returned by this query.
+func (doq *DeliveryOrderQuery) Limit(limit int) *DeliveryOrderQuery {
+	doq.ctx.Limit = &limit
+	return doq
+}
+
+// Offset to start from.
+func (doq *DeliveryOrderQuery) Offset(offset int) *DeliveryOrderQuery {
+	doq.ctx.Offset = &offset
+	return doq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (doq *DeliveryOrderQuery) Unique(unique bool) *DeliveryOrderQuery {
+	doq.ctx.Unique = &unique
+	return doq
+}
+
+// Order specifies how the records should be ordered.
+func (doq *DeliveryOrderQuery) Order(o ...deliveryorder.OrderOption) *DeliveryOrderQuery {
+	doq.order = append(doq.order, o...)
+	return doq
+}
+
+// First returns the first DeliveryOrder entity from the query.
+// Returns a *NotFoundError when no DeliveryOrder was found.
+func (doq *DeliveryOrderQuery) First(ctx context.Context) (*DeliveryOrder, error) {
+	nodes, err := doq.Limit(1).All(setContextOp(ctx, doq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{deliveryorder.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (doq *DeliveryOrderQuery) FirstX(ctx context.Context) *DeliveryOrder {
+	node, err := doq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DeliveryOrder ID from the query.
+// Returns a *NotFoundError when no DeliveryOrder ID was found.
+func (doq *DeliveryOrderQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = doq.Limit(1).IDs(setContextOp(ctx, doq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{deliveryorder.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (doq *DeliveryOrderQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := doq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DeliveryOrder entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DeliveryOrder entity is found.
+// Returns a *NotFoundError when no DeliveryOrder entities are found.
+func (doq *DeliveryOrderQuery) Only(ctx context.Context) (*DeliveryOrder, error) {
+	nodes, err := doq.Limit(2).All(setContextOp(ctx, doq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{deliveryorder.Label}
+	default:
+		return nil, &NotSingularError{deliveryorder.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (doq *DeliveryOrderQuery) OnlyX(ctx context.Context) *DeliveryOrder {
+	node, err := doq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DeliveryOrder ID in the query.
+// Returns a *NotSingularError when more than one DeliveryOrder ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (doq *DeliveryOrderQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = doq.Limit(2).IDs(setContextOp(ctx, doq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{deliveryorder.Label}
+	default:
+		err = &NotSingularError{deliveryorder.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (doq *DeliveryOrderQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := doq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DeliveryOrders.
+func (doq *DeliveryOrderQuery) All(ctx context.Context) ([]*DeliveryOrder, error) {
+	ctx = setContextOp(ctx, doq.ctx, ent.OpQueryAll)
+	if err := doq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DeliveryOrder, *DeliveryOrderQuery]()
+	return withInterceptors[[]*DeliveryOrder](ctx, doq, qr, doq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (doq *DeliveryOrderQuery) AllX(ctx context.Context) []*DeliveryOrder {
+	nodes, err := doq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DeliveryOrder IDs.
+func (doq *DeliveryOrderQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if doq.ctx.Unique == nil && doq.path != nil {
+		doq.Unique(true)
+	}
+	ctx = setContextOp(ctx, doq.ctx, ent.OpQueryIDs)
+	if err = doq.Select(deliveryorder.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (doq *DeliveryOrderQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := doq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (doq *DeliveryOrderQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, doq.ctx, ent.OpQueryCount)
+	if err := doq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, doq, querierCount[*DeliveryOrderQuery](), doq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (doq *DeliveryOrderQuery) CountX(ctx context.Context) int {
+	count, err := doq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (doq *DeliveryOrderQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, doq.ctx, ent.OpQueryExist)
+	switch _, err := doq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (doq *DeliveryOrderQuery) ExistX(ctx context.Context) bool {
+	exist, err := doq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DeliveryOrderQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (doq *DeliveryOrderQuery) Clone() *DeliveryOrderQuery {
+	if doq == nil {
+		return nil
+	}
+	return &DeliveryOrderQuery{
+		config:     doq.config,
+		ctx:        doq.ctx.Clone(),
+		order:      append([]deliveryorder.OrderOption{}, doq.order...),
+		inters:     append([]Interceptor{}, doq.inters...),
+		predicates: append([]predicate.DeliveryOrder{}, doq.predicates...),
+		// clone intermediate query.
+		sql:       doq.sql.Clone(),
+		path:      doq.path,
+		modifiers: append([]func(*sql.Selector){}, doq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DeliveryOrder.Query().
+//		GroupBy(deliveryorder.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (doq *DeliveryOrderQuery) GroupBy(field string, fields ...string) *DeliveryOrderGroupBy {
+	doq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DeliveryOrderGroupBy{build: doq}
+	grbuild.flds = &doq.ctx.Fields
+	grbuild.label = deliveryorder.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DeliveryOrder.Query().
+//		Select(deliveryorder.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (doq *DeliveryOrderQuery) Select(fields ...string) *DeliveryOrderSelect {
+	doq.ctx.Fields = append(doq.ctx.Fields, fields...)
+	sbuild := &DeliveryOrderSelect{DeliveryOrderQuery: doq}
+	sbuild.label = deliveryorder.Label
+	sbuild.flds, sbuild.scan = &doq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DeliveryOrderSelect configured with the given aggregations.
+func (doq *DeliveryOrderQuery) Aggregate(fns ...AggregateFunc) *DeliveryOrderSelect {
+	return doq.Select().Aggregate(fns...)
+}
+
+func (doq *DeliveryOrderQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range doq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, doq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range doq.ctx.Fields {
+		if !deliveryorder.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if doq.path != nil {
+		prev, err := doq.path(ctx)
+		if err != nil {
+			return err
+		}
+		doq.sql = prev
+	}
+	return nil
+}
+
+func (doq *DeliveryOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DeliveryOrder, error) {
+	var (
+		nodes = []*DeliveryOrder{}
+		_spec = doq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DeliveryOrder).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DeliveryOrder{config: doq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(doq.modifiers) > 0 {
+		_spec.Modifiers = doq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, doq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (doq *DeliveryOrderQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := doq.querySpec()
+	if len(doq.modifiers) > 0 {
+		_spec.Modifiers = doq.modifiers
+	}
+	_spec.Node.Columns = doq.ctx.Fields
+	if len(doq.ctx.Fields) > 0 {
+		_spec.Unique = doq.ctx.Unique != nil && *doq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, doq.driver, _spec)
+}
+
+func (doq *DeliveryOrderQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(deliveryorder.Table, deliveryorder.Columns, sqlgraph.NewFieldSpec(deliveryorder.FieldID, field.TypeUUID))
+	_spec.From = doq.sql
+	if unique := doq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if doq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := doq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deliveryorder.FieldID)
+		for i := range fields {
+			if fields[i] != deliveryorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := doq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := doq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := doq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := doq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (doq *DeliveryOrderQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(doq.driver.Dialect())
+	t1 := builder.Table(deliveryorder.Table)
+	columns := doq.ctx.Fields
+	if len(columns) == 0 {
+		columns = deliveryorder.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if doq.sql != nil {
+		selector = doq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if doq.ctx.Unique != nil && *doq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range doq.modifiers {
+		m(selector)
+	}
+	for _, p := range doq.predicates {
+		p(selector)
+	}
+	for _, p := range doq.order {
+		p(selector)
+	}
+	if offset := doq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := doq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (doq *DeliveryOrderQuery) ForUpdate(opts ...sql.LockOption) *DeliveryOrderQuery {
+	if doq.driver.Dialect() == dialect.Postgres {
+		doq.Unique(false)
+	}
+	doq.modifiers = append(doq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return doq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (doq *DeliveryOrderQuery) ForShare(opts ...sql.LockOption) *DeliveryOrderQuery {
+	if doq.driver.Dialect() == dialect.Postgres {
+		doq.Unique(false)
+	}
+	doq.modifiers = append(doq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return doq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (doq *DeliveryOrderQuery) Modify(modifiers ...func(s *sql.Selector)) *DeliveryOrderSelect {
+	doq.modifiers = append(doq.modifiers, modifiers...)
+	return doq.Select()
+}
+
+// DeliveryOrderGroupBy is the group-by builder for DeliveryOrder entities.
+type DeliveryOrderGroupBy struct {
+	selector
+	build *DeliveryOrderQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dogb *DeliveryOrderGroupBy) Aggregate(fns ...AggregateFunc) *DeliveryOrderGroupBy {
+	dogb.fns = append(dogb.fns, fns...)
+	return dogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dogb *DeliveryOrderGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dogb.build.ctx, ent.OpQueryGroupBy)
+	if err := dogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeliveryOrderQuery, *DeliveryOrderGroupBy](ctx, dogb.build, dogb, dogb.build.inters, v)
+}
+
+func (dogb *DeliveryOrderGroupBy) sqlScan(ctx context.Context, root *DeliveryOrderQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dogb.fns))
+	for _, fn := range dogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dogb.flds)+len(dogb.fns))
+		for _, f := range *dogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DeliveryOrderSelect is the builder for selecting fields of DeliveryOrder entities.
+type DeliveryOrderSelect struct {
+	*DeliveryOrderQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dos *DeliveryOrderSelect) Aggregate(fns ...AggregateFunc) *DeliveryOrderSelect {
+	dos.fns = append(dos.fns, fns...)
+	return dos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dos *DeliveryOrderSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dos.ctx, ent.OpQuerySelect)
+	if err := dos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeliveryOrderQuery, *DeliveryOrderSelect](ctx, dos.DeliveryOrderQuery, dos, dos.inters, v)
+}
+
+func (dos *DeliveryOrderSelect) sqlScan(ctx context.Context, root *DeliveryOrderQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dos.fns))
+	for _, fn := range dos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dos *DeliveryOrderSelect) Modify(modifiers ...func(s *sql.Selector)) *DeliveryOrderSelect {
+	dos.modifiers = append(dos.modifiers, modifiers...)
+	return dos
+}
@@ -0,0 +1,547 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryorder"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DeliveryOrderUpdate is the builder for updating DeliveryOrder entities.
+type DeliveryOrderUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DeliveryOrderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DeliveryOrderUpdate builder.
+func (dou *DeliveryOrderUpdate) Where(ps ...predicate.DeliveryOrder) *DeliveryOrderUpdate {
+	dou.mutation.Where(ps...)
+	return dou
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dou *DeliveryOrderUpdate) SetUpdatedAt(t time.Time) *DeliveryOrderUpdate {
+	dou.mutation.SetUpdatedAt(t)
+	return dou
+}
+
+// SetStatus sets the "status" field.
+func (dou *DeliveryOrderUpdate) SetStatus(dos domain.DeliveryOrderStatus) *DeliveryOrderUpdate {
+	dou.mutation.SetStatus(dos)
+	return dou
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dou *DeliveryOrderUpdate) SetNillableStatus(dos *domain.DeliveryOrderStatus) *DeliveryOrderUpdate {
+	if dos != nil {
+		dou.SetStatus(*dos)
+	}
+	return dou
+}
+
+// SetOrderID sets the "order_id" field.
+func (dou *DeliveryOrderUpdate) SetOrderID(u uuid.UUID) *DeliveryOrderUpdate {
+	dou.mutation.SetOrderID(u)
+	return dou
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (dou *DeliveryOrderUpdate) SetNillableOrderID(u *uuid.UUID) *DeliveryOrderUpdate {
+	if u != nil {
+		dou.SetOrderID(*u)
+	}
+	return dou
+}
+
+// SetItems sets the "items" field.
+func (dou *DeliveryOrderUpdate) SetItems(doi []domain.DeliveryOrderItem) *DeliveryOrderUpdate {
+	dou.mutation.SetItems(doi)
+	return dou
+}
+
+// AppendItems appends doi to the "items" field.
+func (dou *DeliveryOrderUpdate) AppendItems(doi []domain.DeliveryOrderItem) *DeliveryOrderUpdate {
+	dou.mutation.AppendItems(doi)
+	return dou
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (dou *DeliveryOrderUpdate) SetRejectReason(s string) *DeliveryOrderUpdate {
+	dou.mutation.SetRejectReason(s)
+	return dou
+}
+
+// SetNillableRejectReason sets the "reject_reason" field if the given value is not nil.
+func (dou *DeliveryOrderUpdate) SetNillableRejectReason(s *string) *DeliveryOrderUpdate {
+	if s != nil {
+		dou.SetRejectReason(*s)
+	}
+	return dou
+}
+
+// SetAutoAccepted sets the "auto_accepted" field.
+func (dou *DeliveryOrderUpdate) SetAutoAccepted(b bool) *DeliveryOrderUpdate {
+	dou.mutation.SetAutoAccepted(b)
+	return dou
+}
+
+// SetNillableAutoAccepted sets the "auto_accepted" field if the given value is not nil.
+func (dou *DeliveryOrderUpdate) SetNillableAutoAccepted(b *bool) *DeliveryOrderUpdate {
+	if b != nil {
+		dou.SetAutoAccepted(*b)
+	}
+	return dou
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (dou *DeliveryOrderUpdate) SetAcceptedAt(t time.Time) *DeliveryOrderUpdate {
+	dou.mutation.SetAcceptedAt(t)
+	return dou
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (dou *DeliveryOrderUpdate) SetNillableAcceptedAt(t *time.Time) *DeliveryOrderUpdate {
+	if t != nil {
+		dou.SetAcceptedAt(*t)
+	}
+	return dou
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (dou *DeliveryOrderUpdate) ClearAcceptedAt() *DeliveryOrderUpdate {
+	dou.mutation.ClearAcceptedAt()
+	return dou
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (dou *DeliveryOrderUpdate) SetReadyAt(t time.Time) *DeliveryOrderUpdate {
+	dou.mutation.SetReadyAt(t)
+	return dou
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (dou *DeliveryOrderUpdate) SetNillableReadyAt(t *time.Time) *DeliveryOrderUpdate {
+	if t != nil {
+		dou.SetReadyAt(*t)
+	}
+	return dou
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (dou *DeliveryOrderUpdate) ClearReadyAt() *DeliveryOrderUpdate {
+	dou.mutation.ClearReadyAt()
+	return dou
+}
+
+// Mutation returns the DeliveryOrderMutation object of the builder.
+func (dou *DeliveryOrderUpdate) Mutation() *DeliveryOrderMutation {
+	return dou.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dou *DeliveryOrderUpdate) Save(ctx context.Context) (int, error) {
+	dou.defaults()
+	return withHooks(ctx, dou.sqlSave, dou.mutation, dou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dou *DeliveryOrderUpdate) SaveX(ctx context.Context) int {
+	affected, err := dou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dou *DeliveryOrderUpdate) Exec(ctx context.Context) error {
+	_, err := dou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dou *DeliveryOrderUpdate) ExecX(ctx context.Context) {
+	if err := dou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dou *DeliveryOrderUpdate) defaults() {
+	if _, ok := dou.mutation.UpdatedAt(); !ok {
+		v := deliveryorder.UpdateDefaultUpdatedAt()
+		dou.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dou *DeliveryOrderUpdate) check() error {
+	if v, ok := dou.mutation.Status(); ok {
+		if err := deliveryorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.status": %w`, err)}
+		}
+	}
+	if v, ok := dou.mutation.RejectReason(); ok {
+		if err := deliveryorder.RejectReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reject_reason", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.reject_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dou *DeliveryOrderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeliveryOrderUpdate {
+	dou.modifiers = append(dou.modifiers, modifiers...)
+	return dou
+}
+
+func (dou *DeliveryOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deliveryorder.Table, deliveryorder.Columns, sqlgraph.NewFieldSpec(deliveryorder.FieldID, field.TypeUUID))
+	if ps := dou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dou.mutation.UpdatedAt(); ok {
+		_spec.SetField(deliveryorder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dou.mutation.Status(); ok {
+		_spec.SetField(deliveryorder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dou.mutation.OrderID(); ok {
+		_spec.SetField(deliveryorder.FieldOrderID, field.TypeUUID, value)
+	}
+	if value, ok := dou.mutation.Items(); ok {
+		_spec.SetField(deliveryorder.FieldItems, field.TypeJSON, value)
+	}
+	if value, ok := dou.mutation.AppendedItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deliveryorder.FieldItems, value)
+		})
+	}
+	if value, ok := dou.mutation.RejectReason(); ok {
+		_spec.SetField(deliveryorder.FieldRejectReason, field.TypeString, value)
+	}
+	if value, ok := dou.mutation.AutoAccepted(); ok {
+		_spec.SetField(deliveryorder.FieldAutoAccepted, field.TypeBool, value)
+	}
+	if value, ok := dou.mutation.AcceptedAt(); ok {
+		_spec.SetField(deliveryorder.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if dou.mutation.AcceptedAtCleared() {
+		_spec.ClearField(deliveryorder.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := dou.mutation.ReadyAt(); ok {
+		_spec.SetField(deliveryorder.FieldReadyAt, field.TypeTime, value)
+	}
+	if dou.mutation.ReadyAtCleared() {
+		_spec.ClearField(deliveryorder.FieldReadyAt, field.TypeTime)
+	}
+	_spec.AddModifiers(dou.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, dou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deliveryorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dou.mutation.done = true
+	return n, nil
+}
+
+// DeliveryOrderUpdateOne is the builder for updating a single DeliveryOrder entity.
+type DeliveryOrderUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DeliveryOrderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (douo *DeliveryOrderUpdateOne) SetUpdatedAt(t time.Time) *DeliveryOrderUpdateOne {
+	douo.mutation.SetUpdatedAt(t)
+	return douo
+}
+
+// SetStatus sets the "status" field.
+func (douo *DeliveryOrderUpdateOne) SetStatus(dos domain.DeliveryOrderStatus) *DeliveryOrderUpdateOne {
+	douo.mutation.SetStatus(dos)
+	return douo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (douo *DeliveryOrderUpdateOne) SetNillableStatus(dos *domain.DeliveryOrderStatus) *DeliveryOrderUpdateOne {
+	if dos != nil {
+		douo.SetStatus(*dos)
+	}
+	return douo
+}
+
+// SetOrderID sets the "order_id" field.
+func (douo *DeliveryOrderUpdateOne) SetOrderID(u uuid.UUID) *DeliveryOrderUpdateOne {
+	douo.mutation.SetOrderID(u)
+	return douo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (douo *DeliveryOrderUpdateOne) SetNillableOrderID(u *uuid.UUID) *DeliveryOrderUpdateOne {
+	if u != nil {
+		douo.SetOrderID(*u)
+	}
+	return douo
+}
+
+// SetItems sets the "items" field.
+func (douo *DeliveryOrderUpdateOne) SetItems(doi []domain.DeliveryOrderItem) *DeliveryOrderUpdateOne {
+	douo.mutation.SetItems(doi)
+	return douo
+}
+
+// AppendItems appends doi to the "items" field.
+func (douo *DeliveryOrderUpdateOne) AppendItems(doi []domain.DeliveryOrderItem) *DeliveryOrderUpdateOne {
+	douo.mutation.AppendItems(doi)
+	return douo
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (douo *DeliveryOrderUpdateOne) SetRejectReason(s string) *DeliveryOrderUpdateOne {
+	douo.mutation.SetRejectReason(s)
+	return douo
+}
+
+// SetNillableRejectReason sets the "reject_reason" field if the given value is not nil.
+func (douo *DeliveryOrderUpdateOne) SetNillableRejectReason(s *string) *DeliveryOrderUpdateOne {
+	if s != nil {
+		douo.SetRejectReason(*s)
+	}
+	return douo
+}
+
+// SetAutoAccepted sets the "auto_accepted" field.
+func (douo *DeliveryOrderUpdateOne) SetAutoAccepted(b bool) *DeliveryOrderUpdateOne {
+	douo.mutation.SetAutoAccepted(b)
+	return douo
+}
+
+// SetNillableAutoAccepted sets the "auto_accepted" field if the given value is not nil.
+func (douo *DeliveryOrderUpdateOne) SetNillableAutoAccepted(b *bool) *DeliveryOrderUpdateOne {
+	if b != nil {
+		douo.SetAutoAccepted(*b)
+	}
+	return douo
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (douo *DeliveryOrderUpdateOne) SetAcceptedAt(t time.Time) *DeliveryOrderUpdateOne {
+	douo.mutation.SetAcceptedAt(t)
+	return douo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (douo *DeliveryOrderUpdateOne) SetNillableAcceptedAt(t *time.Time) *DeliveryOrderUpdateOne {
+	if t != nil {
+		douo.SetAcceptedAt(*t)
+	}
+	return douo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (douo *DeliveryOrderUpdateOne) ClearAcceptedAt() *DeliveryOrderUpdateOne {
+	douo.mutation.ClearAcceptedAt()
+	return douo
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (douo *DeliveryOrderUpdateOne) SetReadyAt(t time.Time) *DeliveryOrderUpdateOne {
+	douo.mutation.SetReadyAt(t)
+	return douo
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (douo *DeliveryOrderUpdateOne) SetNillableReadyAt(t *time.Time) *DeliveryOrderUpdateOne {
+	if t != nil {
+		douo.SetReadyAt(*t)
+	}
+	return douo
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (douo *DeliveryOrderUpdateOne) ClearReadyAt() *DeliveryOrderUpdateOne {
+	douo.mutation.ClearReadyAt()
+	return douo
+}
+
+// Mutation returns the DeliveryOrderMutation object of the builder.
+func (douo *DeliveryOrderUpdateOne) Mutation() *DeliveryOrderMutation {
+	return douo.mutation
+}
+
+// Where appends a list predicates to the DeliveryOrderUpdate builder.
+func (douo *DeliveryOrderUpdateOne) Where(ps ...predicate.DeliveryOrder) *DeliveryOrderUpdateOne {
+	douo.mutation.Where(ps...)
+	return douo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (douo *DeliveryOrderUpdateOne) Select(field string, fields ...string) *DeliveryOrderUpdateOne {
+	douo.fields = append([]string{field}, fields...)
+	return douo
+}
+
+// Save executes the query and returns the updated DeliveryOrder entity.
+func (douo *DeliveryOrderUpdateOne) Save(ctx context.Context) (*DeliveryOrder, error) {
+	douo.defaults()
+	return withHooks(ctx, douo.sqlSave, douo.mutation, douo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (douo *DeliveryOrderUpdateOne) SaveX(ctx context.Context) *DeliveryOrder {
+	node, err := douo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (douo *DeliveryOrderUpdateOne) Exec(ctx context.Context) error {
+	_, err := douo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (douo *DeliveryOrderUpdateOne) ExecX(ctx context.Context) {
+	if err := douo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (douo *DeliveryOrderUpdateOne) defaults() {
+	if _, ok := douo.mutation.UpdatedAt(); !ok {
+		v := deliveryorder.UpdateDefaultUpdatedAt()
+		douo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (douo *DeliveryOrderUpdateOne) check() error {
+	if v, ok := douo.mutation.Status(); ok {
+		if err := deliveryorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.status": %w`, err)}
+		}
+	}
+	if v, ok := douo.mutation.RejectReason(); ok {
+		if err := deliveryorder.RejectReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reject_reason", err: fmt.Errorf(`ent: validator failed for field "DeliveryOrder.reject_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (douo *DeliveryOrderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeliveryOrderUpdateOne {
+	douo.modifiers = append(douo.modifiers, modifiers...)
+	return douo
+}
+
+func (douo *DeliveryOrderUpdateOne) sqlSave(ctx context.Context) (_node *DeliveryOrder, err error) {
+	if err := douo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deliveryorder.Table, deliveryorder.Columns, sqlgraph.NewFieldSpec(deliveryorder.FieldID, field.TypeUUID))
+	id, ok := douo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeliveryOrder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := douo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deliveryorder.FieldID)
+		for _, f := range fields {
+			if !deliveryorder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deliveryorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := douo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := douo.mutation.UpdatedAt(); ok {
+		_spec.SetField(deliveryorder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := douo.mutation.Status(); ok {
+		_spec.SetField(deliveryorder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := douo.mutation.OrderID(); ok {
+		_spec.SetField(deliveryorder.FieldOrderID, field.TypeUUID, value)
+	}
+	if value, ok := douo.mutation.Items(); ok {
+		_spec.SetField(deliveryorder.FieldItems, field.TypeJSON, value)
+	}
+	if value, ok := douo.mutation.AppendedItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deliveryorder.FieldItems, value)
+		})
+	}
+	if value, ok := douo.mutation.RejectReason(); ok {
+		_spec.SetField(deliveryorder.FieldRejectReason, field.TypeString, value)
+	}
+	if value, ok := douo.mutation.AutoAccepted(); ok {
+		_spec.SetField(deliveryorder.FieldAutoAccepted, field.TypeBool, value)
+	}
+	if value, ok := douo.mutation.AcceptedAt(); ok {
+		_spec.SetField(deliveryorder.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if douo.mutation.AcceptedAtCleared() {
+		_spec.ClearField(deliveryorder.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := douo.mutation.ReadyAt(); ok {
+		_spec.SetField(deliveryorder.FieldReadyAt, field.TypeTime, value)
+	}
+	if douo.mutation.ReadyAtCleared() {
+		_spec.ClearField(deliveryorder.FieldReadyAt, field.TypeTime)
+	}
+	_spec.AddModifiers(douo.modifiers...)
+	_node = &DeliveryOrder{config: douo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, douo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deliveryorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	douo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryproductmapping"
+)
+
+// DeliveryProductMapping is the model entity for the DeliveryProductMapping schema.
+type DeliveryProductMapping struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 外卖平台
+	Platform domain.DeliveryPlatformCode `json:"platform,omitempty"`
+	// 平台商品编码
+	PlatformSku string `json:"platform_sku,omitempty"`
+	// 商品ID
+	ProductID uuid.UUID `json:"product_id,omitempty"`
+	// 规格ID，为空表示默认规格
+	SpecID       uuid.UUID `json:"spec_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeliveryProductMapping) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deliveryproductmapping.FieldPlatform, deliveryproductmapping.FieldPlatformSku:
+			values[i] = new(sql.NullString)
+		case deliveryproductmapping.FieldCreatedAt, deliveryproductmapping.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case deliveryproductmapping.FieldID, deliveryproductmapping.FieldMerchantID, deliveryproductmapping.FieldProductID, deliveryproductmapping.FieldSpecID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeliveryProductMapping fields.
+func (dpm *DeliveryProductMapping) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deliveryproductmapping.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				dpm.ID = *value
+			}
+		case deliveryproductmapping.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dpm.CreatedAt = value.Time
+			}
+		case deliveryproductmapping.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dpm.UpdatedAt = value.Time
+			}
+		case deliveryproductmapping.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				dpm.MerchantID = *value
+			}
+		case deliveryproductmapping.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				dpm.Platform = domain.DeliveryPlatformCode(value.String)
+			}
+		case deliveryproductmapping.FieldPlatformSku:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform_sku", values[i])
+			} else if value.Valid {
+				dpm.PlatformSku = value.String
+			}
+		case deliveryproductmapping.FieldProductID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value != nil {
+				dpm.ProductID = *value
+			}
+		case deliveryproductmapping.FieldSpecID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field spec_id", values[i])
+			} else if value != nil {
+				dpm.SpecID = *value
+			}
+		default:
+			dpm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeliveryProductMapping.
+// This includes values selected through modifiers, order, etc.
+func (dpm *DeliveryProductMapping) Value(name string) (ent.Value, error) {
+	return dpm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeliveryProductMapping.
+// Note that you need to call DeliveryProductMapping.Unwrap() before calling this method if this DeliveryProductMapping
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dpm *DeliveryProductMapping) Update() *DeliveryProductMappingUpdateOne {
+	return NewDeliveryProductMappingClient(dpm.config).UpdateOne(dpm)
+}
+
+// Unwrap unwraps the DeliveryProductMapping entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dpm *DeliveryProductMapping) Unwrap() *DeliveryProductMapping {
+	_tx, ok := dpm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeliveryProductMapping is not a transactional entity")
+	}
+	dpm.config.driver = _tx.drv
+	return dpm
+}
+
+// String implements the fmt.Stringer.
+func (dpm *DeliveryProductMapping) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeliveryProductMapping(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dpm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(dpm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(dpm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", dpm.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("platform=")
+	builder.WriteString(fmt.Sprintf("%v", dpm.Platform))
+	builder.WriteString(", ")
+	builder.WriteString("platform_sku=")
+	builder.WriteString(dpm.PlatformSku)
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", dpm.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("spec_id=")
+	builder.WriteString(fmt.Sprintf("%v", dpm.SpecID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeliveryProductMappings is a parsable slice of DeliveryProductMapping.
+type DeliveryProductMappings []*DeliveryProductMapping
@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deliveryproductmapping
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	// Label holds the string label denoting the deliveryproductmapping type in the database.
+	Label = "delivery_product_mapping"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldPlatform holds the string denoting the platform field in the database.
+	FieldPlatform = "platform"
+	// FieldPlatformSku holds the string denoting the platform_sku field in the database.
+	FieldPlatformSku = "platform_sku"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldSpecID holds the string denoting the spec_id field in the database.
+	FieldSpecID = "spec_id"
+	// Table holds the table name of the deliveryproductmapping in the database.
+	Table = "delivery_product_mappings"
+)
+
+// Columns holds all SQL columns for deliveryproductmapping fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldPlatform,
+	FieldPlatformSku,
+	FieldProductID,
+	FieldSpecID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// PlatformSkuValidator is a validator for the "platform_sku" field. It is called by the builders before save.
+	PlatformSkuValidator func(string) error
+	// DefaultSpecID holds the default value on creation for the "spec_id" field.
+	DefaultSpecID func() uuid.UUID
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// PlatformValidator is a validator for the "platform" field enum values. It is called by the builders before save.
+func PlatformValidator(pl domain.DeliveryPlatformCode) error {
+	switch pl {
+	case "grabfood", "foodpanda":
+		return nil
+	default:
+		return fmt.Errorf("deliveryproductmapping: invalid enum value for platform field: %q", pl)
+	}
+}
+
+// OrderOption defines the ordering options for the DeliveryProductMapping queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByPlatform orders the results by the platform field.
+func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
+}
+
+// ByPlatformSku orders the results by the platform_sku field.
+func ByPlatformSku(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatformSku, opts...).ToFunc()
+}
+
+// ByProductID orders the results by the product_id field.
+func ByProductID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductID, opts...).ToFunc()
+}
+
+// BySpecID orders the results by the spec_id field.
+func BySpecID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpecID, opts...).ToFunc()
+}
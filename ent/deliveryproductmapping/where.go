@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deliveryproductmapping
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// PlatformSku applies equality check predicate on the "platform_sku" field. It's identical to PlatformSkuEQ.
+func PlatformSku(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldPlatformSku, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldProductID, v))
+}
+
+// SpecID applies equality check predicate on the "spec_id" field. It's identical to SpecIDEQ.
+func SpecID(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldSpecID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v domain.DeliveryPlatformCode) predicate.DeliveryProductMapping {
+	vc := v
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldPlatform, vc))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v domain.DeliveryPlatformCode) predicate.DeliveryProductMapping {
+	vc := v
+	return predicate.DeliveryProductMapping(sql.FieldNEQ(FieldPlatform, vc))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...domain.DeliveryPlatformCode) predicate.DeliveryProductMapping {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryProductMapping(sql.FieldIn(FieldPlatform, v...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...domain.DeliveryPlatformCode) predicate.DeliveryProductMapping {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryProductMapping(sql.FieldNotIn(FieldPlatform, v...))
+}
+
+// PlatformSkuEQ applies the EQ predicate on the "platform_sku" field.
+func PlatformSkuEQ(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldPlatformSku, v))
+}
+
+// PlatformSkuNEQ applies the NEQ predicate on the "platform_sku" field.
+func PlatformSkuNEQ(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNEQ(FieldPlatformSku, v))
+}
+
+// PlatformSkuIn applies the In predicate on the "platform_sku" field.
+func PlatformSkuIn(vs ...string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldIn(FieldPlatformSku, vs...))
+}
+
+// PlatformSkuNotIn applies the NotIn predicate on the "platform_sku" field.
+func PlatformSkuNotIn(vs ...string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNotIn(FieldPlatformSku, vs...))
+}
+
+// PlatformSkuGT applies the GT predicate on the "platform_sku" field.
+func PlatformSkuGT(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGT(FieldPlatformSku, v))
+}
+
+// PlatformSkuGTE applies the GTE predicate on the "platform_sku" field.
+func PlatformSkuGTE(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGTE(FieldPlatformSku, v))
+}
+
+// PlatformSkuLT applies the LT predicate on the "platform_sku" field.
+func PlatformSkuLT(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLT(FieldPlatformSku, v))
+}
+
+// PlatformSkuLTE applies the LTE predicate on the "platform_sku" field.
+func PlatformSkuLTE(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLTE(FieldPlatformSku, v))
+}
+
+// PlatformSkuContains applies the Contains predicate on the "platform_sku" field.
+func PlatformSkuContains(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldContains(FieldPlatformSku, v))
+}
+
+// PlatformSkuHasPrefix applies the HasPrefix predicate on the "platform_sku" field.
+func PlatformSkuHasPrefix(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldHasPrefix(FieldPlatformSku, v))
+}
+
+// PlatformSkuHasSuffix applies the HasSuffix predicate on the "platform_sku" field.
+func PlatformSkuHasSuffix(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldHasSuffix(FieldPlatformSku, v))
+}
+
+// PlatformSkuEqualFold applies the EqualFold predicate on the "platform_sku" field.
+func PlatformSkuEqualFold(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEqualFold(FieldPlatformSku, v))
+}
+
+// PlatformSkuContainsFold applies the ContainsFold predicate on the "platform_sku" field.
+func PlatformSkuContainsFold(v string) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldContainsFold(FieldPlatformSku, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGT(FieldProductID, v))
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGTE(FieldProductID, v))
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLT(FieldProductID, v))
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLTE(FieldProductID, v))
+}
+
+// SpecIDEQ applies the EQ predicate on the "spec_id" field.
+func SpecIDEQ(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldEQ(FieldSpecID, v))
+}
+
+// SpecIDNEQ applies the NEQ predicate on the "spec_id" field.
+func SpecIDNEQ(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNEQ(FieldSpecID, v))
+}
+
+// SpecIDIn applies the In predicate on the "spec_id" field.
+func SpecIDIn(vs ...uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldIn(FieldSpecID, vs...))
+}
+
+// SpecIDNotIn applies the NotIn predicate on the "spec_id" field.
+func SpecIDNotIn(vs ...uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldNotIn(FieldSpecID, vs...))
+}
+
+// SpecIDGT applies the GT predicate on the "spec_id" field.
+func SpecIDGT(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGT(FieldSpecID, v))
+}
+
+// SpecIDGTE applies the GTE predicate on the "spec_id" field.
+func SpecIDGTE(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldGTE(FieldSpecID, v))
+}
+
+// SpecIDLT applies the LT predicate on the "spec_id" field.
+func SpecIDLT(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLT(FieldSpecID, v))
+}
+
+// SpecIDLTE applies the LTE predicate on the "spec_id" field.
+func SpecIDLTE(v uuid.UUID) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.FieldLTE(FieldSpecID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeliveryProductMapping) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeliveryProductMapping) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeliveryProductMapping) predicate.DeliveryProductMapping {
+	return predicate.DeliveryProductMapping(sql.NotPredicates(p))
+}
@@ -0,0 +1,772 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryproductmapping"
+)
+
+// DeliveryProductMappingCreate is the builder for creating a DeliveryProductMapping entity.
+type DeliveryProductMappingCreate struct {
+	config
+	mutation *DeliveryProductMappingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dpmc *DeliveryProductMappingCreate) SetCreatedAt(t time.Time) *DeliveryProductMappingCreate {
+	dpmc.mutation.SetCreatedAt(t)
+	return dpmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dpmc *DeliveryProductMappingCreate) SetNillableCreatedAt(t *time.Time) *DeliveryProductMappingCreate {
+	if t != nil {
+		dpmc.SetCreatedAt(*t)
+	}
+	return dpmc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dpmc *DeliveryProductMappingCreate) SetUpdatedAt(t time.Time) *DeliveryProductMappingCreate {
+	dpmc.mutation.SetUpdatedAt(t)
+	return dpmc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dpmc *DeliveryProductMappingCreate) SetNillableUpdatedAt(t *time.Time) *DeliveryProductMappingCreate {
+	if t != nil {
+		dpmc.SetUpdatedAt(*t)
+	}
+	return dpmc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (dpmc *DeliveryProductMappingCreate) SetMerchantID(u uuid.UUID) *DeliveryProductMappingCreate {
+	dpmc.mutation.SetMerchantID(u)
+	return dpmc
+}
+
+// SetPlatform sets the "platform" field.
+func (dpmc *DeliveryProductMappingCreate) SetPlatform(dpc domain.DeliveryPlatformCode) *DeliveryProductMappingCreate {
+	dpmc.mutation.SetPlatform(dpc)
+	return dpmc
+}
+
+// SetPlatformSku sets the "platform_sku" field.
+func (dpmc *DeliveryProductMappingCreate) SetPlatformSku(s string) *DeliveryProductMappingCreate {
+	dpmc.mutation.SetPlatformSku(s)
+	return dpmc
+}
+
+// SetProductID sets the "product_id" field.
+func (dpmc *DeliveryProductMappingCreate) SetProductID(u uuid.UUID) *DeliveryProductMappingCreate {
+	dpmc.mutation.SetProductID(u)
+	return dpmc
+}
+
+// SetSpecID sets the "spec_id" field.
+func (dpmc *DeliveryProductMappingCreate) SetSpecID(u uuid.UUID) *DeliveryProductMappingCreate {
+	dpmc.mutation.SetSpecID(u)
+	return dpmc
+}
+
+// SetNillableSpecID sets the "spec_id" field if the given value is not nil.
+func (dpmc *DeliveryProductMappingCreate) SetNillableSpecID(u *uuid.UUID) *DeliveryProductMappingCreate {
+	if u != nil {
+		dpmc.SetSpecID(*u)
+	}
+	return dpmc
+}
+
+// SetID sets the "id" field.
+func (dpmc *DeliveryProductMappingCreate) SetID(u uuid.UUID) *DeliveryProductMappingCreate {
+	dpmc.mutation.SetID(u)
+	return dpmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dpmc *DeliveryProductMappingCreate) SetNillableID(u *uuid.UUID) *DeliveryProductMappingCreate {
+	if u != nil {
+		dpmc.SetID(*u)
+	}
+	return dpmc
+}
+
+// Mutation returns the DeliveryProductMappingMutation object of the builder.
+func (dpmc *DeliveryProductMappingCreate) Mutation() *DeliveryProductMappingMutation {
+	return dpmc.mutation
+}
+
+// Save creates the DeliveryProductMapping in the database.
+func (dpmc *DeliveryProductMappingCreate) Save(ctx context.Context) (*DeliveryProductMapping, error) {
+	dpmc.defaults()
+	return withHooks(ctx, dpmc.sqlSave, dpmc.mutation, dpmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dpmc *DeliveryProductMappingCreate) SaveX(ctx context.Context) *DeliveryProductMapping {
+	v, err := dpmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dpmc *DeliveryProductMappingCreate) Exec(ctx context.Context) error {
+	_, err := dpmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dpmc *DeliveryProductMappingCreate) ExecX(ctx context.Context) {
+	if err := dpmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dpmc *DeliveryProductMappingCreate) defaults() {
+	if _, ok := dpmc.mutation.CreatedAt(); !ok {
+		v := deliveryproductmapping.DefaultCreatedAt()
+		dpmc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dpmc.mutation.UpdatedAt(); !ok {
+		v := deliveryproductmapping.DefaultUpdatedAt()
+		dpmc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dpmc.mutation.SpecID(); !ok {
+		v := deliveryproductmapping.DefaultSpecID()
+		dpmc.mutation.SetSpecID(v)
+	}
+	if _, ok := dpmc.mutation.ID(); !ok {
+		v := deliveryproductmapping.DefaultID()
+		dpmc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dpmc *DeliveryProductMappingCreate) check() error {
+	if _, ok := dpmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeliveryProductMapping.created_at"`)}
+	}
+	if _, ok := dpmc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeliveryProductMapping.updated_at"`)}
+	}
+	if _, ok := dpmc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "DeliveryProductMapping.merchant_id"`)}
+	}
+	if _, ok := dpmc.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "DeliveryProductMapping.platform"`)}
+	}
+	if v, ok := dpmc.mutation.Platform(); ok {
+		if err := deliveryproductmapping.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "DeliveryProductMapping.platform": %w`, err)}
+		}
+	}
+	if _, ok := dpmc.mutation.PlatformSku(); !ok {
+		return &ValidationError{Name: "platform_sku", err: errors.New(`ent: missing required field "DeliveryProductMapping.platform_sku"`)}
+	}
+	if v, ok := dpmc.mutation.PlatformSku(); ok {
+		if err := deliveryproductmapping.PlatformSkuValidator(v); err != nil {
+			return &ValidationError{Name: "platform_sku", err: fmt.Errorf(`ent: validator failed for field "DeliveryProductMapping.platform_sku": %w`, err)}
+		}
+	}
+	if _, ok := dpmc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "DeliveryProductMapping.product_id"`)}
+	}
+	if _, ok := dpmc.mutation.SpecID(); !ok {
+		return &ValidationError{Name: "spec_id", err: errors.New(`ent: missing required field "DeliveryProductMapping.spec_id"`)}
+	}
+	return nil
+}
+
+func (dpmc *DeliveryProductMappingCreate) sqlSave(ctx context.Context) (*DeliveryProductMapping, error) {
+	if err := dpmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dpmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dpmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dpmc.mutation.id = &_node.ID
+	dpmc.mutation.done = true
+	return _node, nil
+}
+
+func (dpmc *DeliveryProductMappingCreate) createSpec() (*DeliveryProductMapping, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeliveryProductMapping{config: dpmc.config}
+		_spec = sqlgraph.NewCreateSpec(deliveryproductmapping.Table, sqlgraph.NewFieldSpec(deliveryproductmapping.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dpmc.conflict
+	if id, ok := dpmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dpmc.mutation.CreatedAt(); ok {
+		_spec.SetField(deliveryproductmapping.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dpmc.mutation.UpdatedAt(); ok {
+		_spec.SetField(deliveryproductmapping.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dpmc.mutation.MerchantID(); ok {
+		_spec.SetField(deliveryproductmapping.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := dpmc.mutation.Platform(); ok {
+		_spec.SetField(deliveryproductmapping.FieldPlatform, field.TypeEnum, value)
+		_node.Platform = value
+	}
+	if value, ok := dpmc.mutation.PlatformSku(); ok {
+		_spec.SetField(deliveryproductmapping.FieldPlatformSku, field.TypeString, value)
+		_node.PlatformSku = value
+	}
+	if value, ok := dpmc.mutation.ProductID(); ok {
+		_spec.SetField(deliveryproductmapping.FieldProductID, field.TypeUUID, value)
+		_node.ProductID = value
+	}
+	if value, ok := dpmc.mutation.SpecID(); ok {
+		_spec.SetField(deliveryproductmapping.FieldSpecID, field.TypeUUID, value)
+		_node.SpecID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeliveryProductMapping.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeliveryProductMappingUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dpmc *DeliveryProductMappingCreate) OnConflict(opts ...sql.ConflictOption) *DeliveryProductMappingUpsertOne {
+	dpmc.conflict = opts
+	return &DeliveryProductMappingUpsertOne{
+		create: dpmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeliveryProductMapping.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dpmc *DeliveryProductMappingCreate) OnConflictColumns(columns ...string) *DeliveryProductMappingUpsertOne {
+	dpmc.conflict = append(dpmc.conflict, sql.ConflictColumns(columns...))
+	return &DeliveryProductMappingUpsertOne{
+		create: dpmc,
+	}
+}
+
+type (
+	// DeliveryProductMappingUpsertOne is the builder for "upsert"-ing
+	//  one DeliveryProductMapping node.
+	DeliveryProductMappingUpsertOne struct {
+		create *DeliveryProductMappingCreate
+	}
+
+	// DeliveryProductMappingUpsert is the "OnConflict" setter.
+	DeliveryProductMappingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeliveryProductMappingUpsert) SetUpdatedAt(v time.Time) *DeliveryProductMappingUpsert {
+	u.Set(deliveryproductmapping.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsert) UpdateUpdatedAt() *DeliveryProductMappingUpsert {
+	u.SetExcluded(deliveryproductmapping.FieldUpdatedAt)
+	return u
+}
+
+// SetPlatformSku sets the "platform_sku" field.
+func (u *DeliveryProductMappingUpsert) SetPlatformSku(v string) *DeliveryProductMappingUpsert {
+	u.Set(deliveryproductmapping.FieldPlatformSku, v)
+	return u
+}
+
+// UpdatePlatformSku sets the "platform_sku" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsert) UpdatePlatformSku() *DeliveryProductMappingUpsert {
+	u.SetExcluded(deliveryproductmapping.FieldPlatformSku)
+	return u
+}
+
+// SetProductID sets the "product_id" field.
+func (u *DeliveryProductMappingUpsert) SetProductID(v uuid.UUID) *DeliveryProductMappingUpsert {
+	u.Set(deliveryproductmapping.FieldProductID, v)
+	return u
+}
+
+// UpdateProductID sets the "product_id" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsert) UpdateProductID() *DeliveryProductMappingUpsert {
+	u.SetExcluded(deliveryproductmapping.FieldProductID)
+	return u
+}
+
+// SetSpecID sets the "spec_id" field.
+func (u *DeliveryProductMappingUpsert) SetSpecID(v uuid.UUID) *DeliveryProductMappingUpsert {
+	u.Set(deliveryproductmapping.FieldSpecID, v)
+	return u
+}
+
+// UpdateSpecID sets the "spec_id" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsert) UpdateSpecID() *DeliveryProductMappingUpsert {
+	u.SetExcluded(deliveryproductmapping.FieldSpecID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeliveryProductMapping.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deliveryproductmapping.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeliveryProductMappingUpsertOne) UpdateNewValues() *DeliveryProductMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deliveryproductmapping.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(deliveryproductmapping.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(deliveryproductmapping.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.Platform(); exists {
+			s.SetIgnore(deliveryproductmapping.FieldPlatform)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeliveryProductMapping.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeliveryProductMappingUpsertOne) Ignore() *DeliveryProductMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeliveryProductMappingUpsertOne) DoNothing() *DeliveryProductMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeliveryProductMappingCreate.OnConflict
+// documentation for more info.
+func (u *DeliveryProductMappingUpsertOne) Update(set func(*DeliveryProductMappingUpsert)) *DeliveryProductMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeliveryProductMappingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeliveryProductMappingUpsertOne) SetUpdatedAt(v time.Time) *DeliveryProductMappingUpsertOne {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsertOne) UpdateUpdatedAt() *DeliveryProductMappingUpsertOne {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPlatformSku sets the "platform_sku" field.
+func (u *DeliveryProductMappingUpsertOne) SetPlatformSku(v string) *DeliveryProductMappingUpsertOne {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.SetPlatformSku(v)
+	})
+}
+
+// UpdatePlatformSku sets the "platform_sku" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsertOne) UpdatePlatformSku() *DeliveryProductMappingUpsertOne {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.UpdatePlatformSku()
+	})
+}
+
+// SetProductID sets the "product_id" field.
+func (u *DeliveryProductMappingUpsertOne) SetProductID(v uuid.UUID) *DeliveryProductMappingUpsertOne {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.SetProductID(v)
+	})
+}
+
+// UpdateProductID sets the "product_id" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsertOne) UpdateProductID() *DeliveryProductMappingUpsertOne {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.UpdateProductID()
+	})
+}
+
+// SetSpecID sets the "spec_id" field.
+func (u *DeliveryProductMappingUpsertOne) SetSpecID(v uuid.UUID) *DeliveryProductMappingUpsertOne {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.SetSpecID(v)
+	})
+}
+
+// UpdateSpecID sets the "spec_id" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsertOne) UpdateSpecID() *DeliveryProductMappingUpsertOne {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.UpdateSpecID()
+	})
+}
+
+// Exec executes the query.
+func (u *DeliveryProductMappingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeliveryProductMappingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeliveryProductMappingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeliveryProductMappingUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DeliveryProductMappingUpsertOne.ID is not supported by MySQL driver. Use DeliveryProductMappingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeliveryProductMappingUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeliveryProductMappingCreateBulk is the builder for creating many DeliveryProductMapping entities in bulk.
+type DeliveryProductMappingCreateBulk struct {
+	config
+	err      error
+	builders []*DeliveryProductMappingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeliveryProductMapping entities in the database.
+func (dpmcb *DeliveryProductMappingCreateBulk) Save(ctx context.Context) ([]*DeliveryProductMapping, error) {
+	if dpmcb.err != nil {
+		return nil, dpmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dpmcb.builders))
+	nodes := make([]*DeliveryProductMapping, len(dpmcb.builders))
+	mutators := make([]Mutator, len(dpmcb.builders))
+	for i := range dpmcb.builders {
+		func(i int, root context.Context) {
+			builder := dpmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeliveryProductMappingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dpmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dpmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dpmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dpmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dpmcb *DeliveryProductMappingCreateBulk) SaveX(ctx context.Context) []*DeliveryProductMapping {
+	v, err := dpmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dpmcb *DeliveryProductMappingCreateBulk) Exec(ctx context.Context) error {
+	_, err := dpmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dpmcb *DeliveryProductMappingCreateBulk) ExecX(ctx context.Context) {
+	if err := dpmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeliveryProductMapping.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeliveryProductMappingUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dpmcb *DeliveryProductMappingCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeliveryProductMappingUpsertBulk {
+	dpmcb.conflict = opts
+	return &DeliveryProductMappingUpsertBulk{
+		create: dpmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeliveryProductMapping.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dpmcb *DeliveryProductMappingCreateBulk) OnConflictColumns(columns ...string) *DeliveryProductMappingUpsertBulk {
+	dpmcb.conflict = append(dpmcb.conflict, sql.ConflictColumns(columns...))
+	return &DeliveryProductMappingUpsertBulk{
+		create: dpmcb,
+	}
+}
+
+// DeliveryProductMappingUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeliveryProductMapping nodes.
+type DeliveryProductMappingUpsertBulk struct {
+	create *DeliveryProductMappingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeliveryProductMapping.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deliveryproductmapping.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeliveryProductMappingUpsertBulk) UpdateNewValues() *DeliveryProductMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deliveryproductmapping.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(deliveryproductmapping.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(deliveryproductmapping.FieldMerchantID)
+			}
+			if _, exists := b.mutation.Platform(); exists {
+				s.SetIgnore(deliveryproductmapping.FieldPlatform)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeliveryProductMapping.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeliveryProductMappingUpsertBulk) Ignore() *DeliveryProductMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeliveryProductMappingUpsertBulk) DoNothing() *DeliveryProductMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeliveryProductMappingCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeliveryProductMappingUpsertBulk) Update(set func(*DeliveryProductMappingUpsert)) *DeliveryProductMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeliveryProductMappingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeliveryProductMappingUpsertBulk) SetUpdatedAt(v time.Time) *DeliveryProductMappingUpsertBulk {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsertBulk) UpdateUpdatedAt() *DeliveryProductMappingUpsertBulk {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPlatformSku sets the "platform_sku" field.
+func (u *DeliveryProductMappingUpsertBulk) SetPlatformSku(v string) *DeliveryProductMappingUpsertBulk {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.SetPlatformSku(v)
+	})
+}
+
+// UpdatePlatformSku sets the "platform_sku" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsertBulk) UpdatePlatformSku() *DeliveryProductMappingUpsertBulk {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.UpdatePlatformSku()
+	})
+}
+
+// SetProductID sets the "product_id" field.
+func (u *DeliveryProductMappingUpsertBulk) SetProductID(v uuid.UUID) *DeliveryProductMappingUpsertBulk {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.SetProductID(v)
+	})
+}
+
+// UpdateProductID sets the "product_id" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsertBulk) UpdateProductID() *DeliveryProductMappingUpsertBulk {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.UpdateProductID()
+	})
+}
+
+// SetSpecID sets the "spec_id" field.
+func (u *DeliveryProductMappingUpsertBulk) SetSpecID(v uuid.UUID) *DeliveryProductMappingUpsertBulk {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.SetSpecID(v)
+	})
+}
+
+// UpdateSpecID sets the "spec_id" field to the value that was provided on create.
+func (u *DeliveryProductMappingUpsertBulk) UpdateSpecID() *DeliveryProductMappingUpsertBulk {
+	return u.Update(func(s *DeliveryProductMappingUpsert) {
+		s.UpdateSpecID()
+	})
+}
+
+// Exec executes the query.
+func (u *DeliveryProductMappingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeliveryProductMappingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeliveryProductMappingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeliveryProductMappingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryproductmapping"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DeliveryProductMappingDelete is the builder for deleting a DeliveryProductMapping entity.
+type DeliveryProductMappingDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeliveryProductMappingMutation
+}
+
+// Where appends a list predicates to the DeliveryProductMappingDelete builder.
+func (dpmd *DeliveryProductMappingDelete) Where(ps ...predicate.DeliveryProductMapping) *DeliveryProductMappingDelete {
+	dpmd.mutation.Where(ps...)
+	return dpmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dpmd *DeliveryProductMappingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dpmd.sqlExec, dpmd.mutation, dpmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dpmd *DeliveryProductMappingDelete) ExecX(ctx context.Context) int {
+	n, err := dpmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dpmd *DeliveryProductMappingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deliveryproductmapping.Table, sqlgraph.NewFieldSpec(deliveryproductmapping.FieldID, field.TypeUUID))
+	if ps := dpmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dpmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dpmd.mutation.done = true
+	return affected, err
+}
+
+// DeliveryProductMappingDeleteOne is the builder for deleting a single DeliveryProductMapping entity.
+type DeliveryProductMappingDeleteOne struct {
+	dpmd *DeliveryProductMappingDelete
+}
+
+// Where appends a list predicates to the DeliveryProductMappingDelete builder.
+func (dpmdo *DeliveryProductMappingDeleteOne) Where(ps ...predicate.DeliveryProductMapping) *DeliveryProductMappingDeleteOne {
+	dpmdo.dpmd.mutation.Where(ps...)
+	return dpmdo
+}
+
+// Exec executes the deletion query.
+func (dpmdo *DeliveryProductMappingDeleteOne) Exec(ctx context.Context) error {
+	n, err := dpmdo.dpmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deliveryproductmapping.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dpmdo *DeliveryProductMappingDeleteOne) ExecX(ctx context.Context) {
+	if err := dpmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
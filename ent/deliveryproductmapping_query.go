@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryproductmapping"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DeliveryProductMappingQuery is the builder for querying DeliveryProductMapping entities.
+type DeliveryProductMappingQuery struct {
+	config
+	ctx        *QueryContext
+	order      []deliveryproductmapping.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DeliveryProductMapping
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DeliveryProductMappingQuery builder.
+func (dpmq *DeliveryProductMappingQuery) Where(ps ...predicate.DeliveryProductMapping) *DeliveryProductMappingQuery {
+	dpmq.predicates = append(dpmq.predicates, ps...)
+	return dpmq
+}
+
+// Limit the number of records to be returned by this query.
+func (dpmq *DeliveryProductMappingQuery) Limit(limit int) *DeliveryProductMappingQuery {
+	dpmq.ctx.Limit = &limit
+	return dpmq
+}
+
+// Offset to start from.
+func (dpmq *DeliveryProductMappingQuery) Offset(offset int) *DeliveryProductMappingQuery {
+	dpmq.ctx.Offset = &offset
+	return dpmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dpmq *DeliveryProductMappingQuery) Unique(unique bool) *DeliveryProductMappingQuery {
+	dpmq.ctx.Unique = &unique
+	return dpmq
+}
+
+// Order specifies how the records should be ordered.
+func (dpmq *DeliveryProductMappingQuery) Order(o ...deliveryproductmapping.OrderOption) *DeliveryProductMappingQuery {
+	dpmq.order = append(dpmq.order, o...)
+	return dpmq
+}
+
+// First returns the first DeliveryProductMapping entity from the query.
+// Returns a *NotFoundError when no DeliveryProductMapping was found.
+func (dpmq *DeliveryProductMappingQuery) First(ctx context.Context) (*DeliveryProductMapping, error) {
+	nodes, err := dpmq.Limit(1).All(setContextOp(ctx, dpmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{deliveryproductmapping.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dpmq *DeliveryProductMappingQuery) FirstX(ctx context.Context) *DeliveryProductMapping {
+	node, err := dpmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DeliveryProductMapping ID from the query.
+// Returns a *NotFoundError when no DeliveryProductMapping ID was found.
+func (dpmq *DeliveryProductMappingQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dpmq.Limit(1).IDs(setContextOp(ctx, dpmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{deliveryproductmapping.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dpmq *DeliveryProductMappingQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := dpmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DeliveryProductMapping entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DeliveryProductMapping entity is found.
+// Returns a *NotFoundError when no DeliveryProductMapping entities are found.
+func (dpmq *DeliveryProductMappingQuery) Only(ctx context.Context) (*DeliveryProductMapping, error) {
+	nodes, err := dpmq.Limit(2).All(setContextOp(ctx, dpmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{deliveryproductmapping.Label}
+	default:
+		return nil, &NotSingularError{deliveryproductmapping.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dpmq *DeliveryProductMappingQuery) OnlyX(ctx context.Context) *DeliveryProductMapping {
+	node, err := dpmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DeliveryProductMapping ID in the query.
+// Returns a *NotSingularError when more than one DeliveryProductMapping ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dpmq *DeliveryProductMappingQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dpmq.Limit(2).IDs(setContextOp(ctx, dpmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{deliveryproductmapping.Label}
+	default:
+		err = &NotSingularError{deliveryproductmapping.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dpmq *DeliveryProductMappingQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := dpmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DeliveryProductMappings.
+func (dpmq *DeliveryProductMappingQuery) All(ctx context.Context) ([]*DeliveryProductMapping, error) {
+	ctx = setContextOp(ctx, dpmq.ctx, ent.OpQueryAll)
+	if err := dpmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DeliveryProductMapping, *DeliveryProductMappingQuery]()
+	return withInterceptors[[]*DeliveryProductMapping](ctx, dpmq, qr, dpmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dpmq *DeliveryProductMappingQuery) AllX(ctx context.Context) []*DeliveryProductMapping {
+	nodes, err := dpmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DeliveryProductMapping IDs.
+func (dpmq *DeliveryProductMappingQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if dpmq.ctx.Unique == nil && dpmq.path != nil {
+		dpmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dpmq.ctx, ent.OpQueryIDs)
+	if err = dpmq.Select(deliveryproductmapping.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dpmq *DeliveryProductMappingQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dpmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dpmq *DeliveryProductMappingQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dpmq.ctx, ent.OpQueryCount)
+	if err := dpmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dpmq, querierCount[*DeliveryProductMappingQuery](), dpmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dpmq *DeliveryProductMappingQuery) CountX(ctx context.Context) int {
+	count, err := dpmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dpmq *DeliveryProductMappingQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dpmq.ctx, ent.OpQueryExist)
+	switch _, err := dpmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dpmq *DeliveryProductMappingQuery) ExistX(ctx context.Context) bool {
+	exist, err := dpmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DeliveryProductMappingQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dpmq *DeliveryProductMappingQuery) Clone() *DeliveryProductMappingQuery {
+	if dpmq == nil {
+		return nil
+	}
+	return &DeliveryProductMappingQuery{
+		config:     dpmq.config,
+		ctx:        dpmq.ctx.Clone(),
+		order:      append([]deliveryproductmapping.OrderOption{}, dpmq.order...),
+		inters:     append([]Interceptor{}, dpmq.inters...),
+		predicates: append([]predicate.DeliveryProductMapping{}, dpmq.predicates...),
+		// clone intermediate query.
+		sql:       dpmq.sql.Clone(),
+		path:      dpmq.path,
+		modifiers: append([]func(*sql.Selector){}, dpmq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DeliveryProductMapping.Query().
+//		GroupBy(deliveryproductmapping.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dpmq *DeliveryProductMappingQuery) GroupBy(field string, fields ...string) *DeliveryProductMappingGroupBy {
+	dpmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DeliveryProductMappingGroupBy{build: dpmq}
+	grbuild.flds = &dpmq.ctx.Fields
+	grbuild.label = deliveryproductmapping.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DeliveryProductMapping.Query().
+//		Select(deliveryproductmapping.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (dpmq *DeliveryProductMappingQuery) Select(fields ...string) *DeliveryProductMappingSelect {
+	dpmq.ctx.Fields = append(dpmq.ctx.Fields, fields...)
+	sbuild := &DeliveryProductMappingSelect{DeliveryProductMappingQuery: dpmq}
+	sbuild.label = deliveryproductmapping.Label
+	sbuild.flds, sbuild.scan = &dpmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DeliveryProductMappingSelect configured with the given aggregations.
+func (dpmq *DeliveryProductMappingQuery) Aggregate(fns ...AggregateFunc) *DeliveryProductMappingSelect {
+	return dpmq.Select().Aggregate(fns...)
+}
+
+func (dpmq *DeliveryProductMappingQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dpmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dpmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dpmq.ctx.Fields {
+		if !deliveryproductmapping.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dpmq.path != nil {
+		prev, err := dpmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dpmq.sql = prev
+	}
+	return nil
+}
+
+func (dpmq *DeliveryProductMappingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DeliveryProductMapping, error) {
+	var (
+		nodes = []*DeliveryProductMapping{}
+		_spec = dpmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DeliveryProductMapping).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DeliveryProductMapping{config: dpmq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(dpmq.modifiers) > 0 {
+		_spec.Modifiers = dpmq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dpmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (dpmq *DeliveryProductMappingQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dpmq.querySpec()
+	if len(dpmq.modifiers) > 0 {
+		_spec.Modifiers = dpmq.modifiers
+	}
+	_spec.Node.Columns = dpmq.ctx.Fields
+	if len(dpmq.ctx.Fields) > 0 {
+		_spec.Unique = dpmq.ctx.Unique != nil && *dpmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dpmq.driver, _spec)
+}
+
+func (dpmq *DeliveryProductMappingQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(deliveryproductmapping.Table, deliveryproductmapping.Columns, sqlgraph.NewFieldSpec(deliveryproductmapping.FieldID, field.TypeUUID))
+	_spec.From = dpmq.sql
+	if unique := dpmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dpmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dpmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deliveryproductmapping.FieldID)
+		for i := range fields {
+			if fields[i] != deliveryproductmapping.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dpmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dpmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dpmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dpmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dpmq *DeliveryProductMappingQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dpmq.driver.Dialect())
+	t1 := builder.Table(deliveryproductmapping.Table)
+	columns := dpmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = deliveryproductmapping.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dpmq.sql != nil {
+		selector = dpmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dpmq.ctx.Unique != nil && *dpmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range dpmq.modifiers {
+		m(selector)
+	}
+	for _, p := range dpmq.predicates {
+		p(selector)
+	}
+	for _, p := range dpmq.order {
+		p(selector)
+	}
+	if offset := dpmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dpmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (dpmq *DeliveryProductMappingQuery) ForUpdate(opts ...sql.LockOption) *DeliveryProductMappingQuery {
+	if dpmq.driver.Dialect() == dialect.Postgres {
+		dpmq.Unique(false)
+	}
+	dpmq.modifiers = append(dpmq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return dpmq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (dpmq *DeliveryProductMappingQuery) ForShare(opts ...sql.LockOption) *DeliveryProductMappingQuery {
+	if dpmq.driver.Dialect() == dialect.Postgres {
+		dpmq.Unique(false)
+	}
+	dpmq.modifiers = append(dpmq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return dpmq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dpmq *DeliveryProductMappingQuery) Modify(modifiers ...func(s *sql.Selector)) *DeliveryProductMappingSelect {
+	dpmq.modifiers = append(dpmq.modifiers, modifiers...)
+	return dpmq.Select()
+}
+
+// DeliveryProductMappingGroupBy is the group-by builder for DeliveryProductMapping entities.
+type DeliveryProductMappingGroupBy struct {
+	selector
+	build *DeliveryProductMappingQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dpmgb *DeliveryProductMappingGroupBy) Aggregate(fns ...AggregateFunc) *DeliveryProductMappingGroupBy {
+	dpmgb.fns = append(dpmgb.fns, fns...)
+	return dpmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dpmgb *DeliveryProductMappingGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dpmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dpmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeliveryProductMappingQuery, *DeliveryProductMappingGroupBy](ctx, dpmgb.build, dpmgb, dpmgb.build.inters, v)
+}
+
+func (dpmgb *DeliveryProductMappingGroupBy) sqlScan(ctx context.Context, root *DeliveryProductMappingQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dpmgb.fns))
+	for _, fn := range dpmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dpmgb.flds)+len(dpmgb.fns))
+		for _, f := range *dpmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dpmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dpmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DeliveryProductMappingSelect is the builder for selecting fields of DeliveryProductMapping entities.
+type DeliveryProductMappingSelect struct {
+	*DeliveryProductMappingQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dpms *DeliveryProductMappingSelect) Aggregate(fns ...AggregateFunc) *DeliveryProductMappingSelect {
+	dpms.fns = append(dpms.fns, fns...)
+	return dpms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dpms *DeliveryProductMappingSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dpms.ctx, ent.OpQuerySelect)
+	if err := dpms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeliveryProductMappingQuery, *DeliveryProductMappingSelect](ctx, dpms.DeliveryProductMappingQuery, dpms, dpms.inters, v)
+}
+
+func (dpms *DeliveryProductMappingSelect) sqlScan(ctx context.Context, root *DeliveryProductMappingQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dpms.fns))
+	for _, fn := range dpms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dpms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dpms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dpms *DeliveryProductMappingSelect) Modify(modifiers ...func(s *sql.Selector)) *DeliveryProductMappingSelect {
+	dpms.modifiers = append(dpms.modifiers, modifiers...)
+	return dpms
+}
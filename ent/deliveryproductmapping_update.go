@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryproductmapping"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DeliveryProductMappingUpdate is the builder for updating DeliveryProductMapping entities.
+type DeliveryProductMappingUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DeliveryProductMappingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DeliveryProductMappingUpdate builder.
+func (dpmu *DeliveryProductMappingUpdate) Where(ps ...predicate.DeliveryProductMapping) *DeliveryProductMappingUpdate {
+	dpmu.mutation.Where(ps...)
+	return dpmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dpmu *DeliveryProductMappingUpdate) SetUpdatedAt(t time.Time) *DeliveryProductMappingUpdate {
+	dpmu.mutation.SetUpdatedAt(t)
+	return dpmu
+}
+
+// SetPlatformSku sets the "platform_sku" field.
+func (dpmu *DeliveryProductMappingUpdate) SetPlatformSku(s string) *DeliveryProductMappingUpdate {
+	dpmu.mutation.SetPlatformSku(s)
+	return dpmu
+}
+
+// SetNillablePlatformSku sets the "platform_sku" field if the given value is not nil.
+func (dpmu *DeliveryProductMappingUpdate) SetNillablePlatformSku(s *string) *DeliveryProductMappingUpdate {
+	if s != nil {
+		dpmu.SetPlatformSku(*s)
+	}
+	return dpmu
+}
+
+// SetProductID sets the "product_id" field.
+func (dpmu *DeliveryProductMappingUpdate) SetProductID(u uuid.UUID) *DeliveryProductMappingUpdate {
+	dpmu.mutation.SetProductID(u)
+	return dpmu
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (dpmu *DeliveryProductMappingUpdate) SetNillableProductID(u *uuid.UUID) *DeliveryProductMappingUpdate {
+	if u != nil {
+		dpmu.SetProductID(*u)
+	}
+	return dpmu
+}
+
+// SetSpecID sets the "spec_id" field.
+func (dpmu *DeliveryProductMappingUpdate) SetSpecID(u uuid.UUID) *DeliveryProductMappingUpdate {
+	dpmu.mutation.SetSpecID(u)
+	return dpmu
+}
+
+// SetNillableSpecID sets the "spec_id" field if the given value is not nil.
+func (dpmu *DeliveryProductMappingUpdate) SetNillableSpecID(u *uuid.UUID) *DeliveryProductMappingUpdate {
+	if u != nil {
+		dpmu.SetSpecID(*u)
+	}
+	return dpmu
+}
+
+// Mutation returns the DeliveryProductMappingMutation object of the builder.
+func (dpmu *DeliveryProductMappingUpdate) Mutation() *DeliveryProductMappingMutation {
+	return dpmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dpmu *DeliveryProductMappingUpdate) Save(ctx context.Context) (int, error) {
+	dpmu.defaults()
+	return withHooks(ctx, dpmu.sqlSave, dpmu.mutation, dpmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dpmu *DeliveryProductMappingUpdate) SaveX(ctx context.Context) int {
+	affected, err := dpmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dpmu *DeliveryProductMappingUpdate) Exec(ctx context.Context) error {
+	_, err := dpmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dpmu *DeliveryProductMappingUpdate) ExecX(ctx context.Context) {
+	if err := dpmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dpmu *DeliveryProductMappingUpdate) defaults() {
+	if _, ok := dpmu.mutation.UpdatedAt(); !ok {
+		v := deliveryproductmapping.UpdateDefaultUpdatedAt()
+		dpmu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dpmu *DeliveryProductMappingUpdate) check() error {
+	if v, ok := dpmu.mutation.PlatformSku(); ok {
+		if err := deliveryproductmapping.PlatformSkuValidator(v); err != nil {
+			return &ValidationError{Name: "platform_sku", err: fmt.Errorf(`ent: validator failed for field "DeliveryProductMapping.platform_sku": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dpmu *DeliveryProductMappingUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeliveryProductMappingUpdate {
+	dpmu.modifiers = append(dpmu.modifiers, modifiers...)
+	return dpmu
+}
+
+func (dpmu *DeliveryProductMappingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dpmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deliveryproductmapping.Table, deliveryproductmapping.Columns, sqlgraph.NewFieldSpec(deliveryproductmapping.FieldID, field.TypeUUID))
+	if ps := dpmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dpmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(deliveryproductmapping.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dpmu.mutation.PlatformSku(); ok {
+		_spec.SetField(deliveryproductmapping.FieldPlatformSku, field.TypeString, value)
+	}
+	if value, ok := dpmu.mutation.ProductID(); ok {
+		_spec.SetField(deliveryproductmapping.FieldProductID, field.TypeUUID, value)
+	}
+	if value, ok := dpmu.mutation.SpecID(); ok {
+		_spec.SetField(deliveryproductmapping.FieldSpecID, field.TypeUUID, value)
+	}
+	_spec.AddModifiers(dpmu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, dpmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deliveryproductmapping.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dpmu.mutation.done = true
+	return n, nil
+}
+
+// DeliveryProductMappingUpdateOne is the builder for updating a single DeliveryProductMapping entity.
+type DeliveryProductMappingUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DeliveryProductMappingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dpmuo *DeliveryProductMappingUpdateOne) SetUpdatedAt(t time.Time) *DeliveryProductMappingUpdateOne {
+	dpmuo.mutation.SetUpdatedAt(t)
+	return dpmuo
+}
+
+// SetPlatformSku sets the "platform_sku" field.
+func (dpmuo *DeliveryProductMappingUpdateOne) SetPlatformSku(s string) *DeliveryProductMappingUpdateOne {
+	dpmuo.mutation.SetPlatformSku(s)
+	return dpmuo
+}
+
+// SetNillablePlatformSku sets the "platform_sku" field if the given value is not nil.
+func (dpmuo *DeliveryProductMappingUpdateOne) SetNillablePlatformSku(s *string) *DeliveryProductMappingUpdateOne {
+	if s != nil {
+		dpmuo.SetPlatformSku(*s)
+	}
+	return dpmuo
+}
+
+// SetProductID sets the "product_id" field.
+func (dpmuo *DeliveryProductMappingUpdateOne) SetProductID(u uuid.UUID) *DeliveryProductMappingUpdateOne {
+	dpmuo.mutation.SetProductID(u)
+	return dpmuo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (dpmuo *DeliveryProductMappingUpdateOne) SetNillableProductID(u *uuid.UUID) *DeliveryProductMappingUpdateOne {
+	if u != nil {
+		dpmuo.SetProductID(*u)
+	}
+	return dpmuo
+}
+
+// SetSpecID sets the "spec_id" field.
+func (dpmuo *DeliveryProductMappingUpdateOne) SetSpecID(u uuid.UUID) *DeliveryProductMappingUpdateOne {
+	dpmuo.mutation.SetSpecID(u)
+	return dpmuo
+}
+
+// SetNillableSpecID sets the "spec_id" field if the given value is not nil.
+func (dpmuo *DeliveryProductMappingUpdateOne) SetNillableSpecID(u *uuid.UUID) *DeliveryProductMappingUpdateOne {
+	if u != nil {
+		dpmuo.SetSpecID(*u)
+	}
+	return dpmuo
+}
+
+// Mutation returns the DeliveryProductMappingMutation object of the builder.
+func (dpmuo *DeliveryProductMappingUpdateOne) Mutation() *DeliveryProductMappingMutation {
+	return dpmuo.mutation
+}
+
+// Where appends a list predicates to the DeliveryProductMappingUpdate builder.
+func (dpmuo *DeliveryProductMappingUpdateOne) Where(ps ...predicate.DeliveryProductMapping) *DeliveryProductMappingUpdateOne {
+	dpmuo.mutation.Where(ps...)
+	return dpmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dpmuo *DeliveryProductMappingUpdateOne) Select(field string, fields ...string) *DeliveryProductMappingUpdateOne {
+	dpmuo.fields = append([]string{field}, fields...)
+	return dpmuo
+}
+
+// Save executes the query and returns the updated DeliveryProductMapping entity.
+func (dpmuo *DeliveryProductMappingUpdateOne) Save(ctx context.Context) (*DeliveryProductMapping, error) {
+	dpmuo.defaults()
+	return withHooks(ctx, dpmuo.sqlSave, dpmuo.mutation, dpmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dpmuo *DeliveryProductMappingUpdateOne) SaveX(ctx context.Context) *DeliveryProductMapping {
+	node, err := dpmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dpmuo *DeliveryProductMappingUpdateOne) Exec(ctx context.Context) error {
+	_, err := dpmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dpmuo *DeliveryProductMappingUpdateOne) ExecX(ctx context.Context) {
+	if err := dpmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dpmuo *DeliveryProductMappingUpdateOne) defaults() {
+	if _, ok := dpmuo.mutation.UpdatedAt(); !ok {
+		v := deliveryproductmapping.UpdateDefaultUpdatedAt()
+		dpmuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dpmuo *DeliveryProductMappingUpdateOne) check() error {
+	if v, ok := dpmuo.mutation.PlatformSku(); ok {
+		if err := deliveryproductmapping.PlatformSkuValidator(v); err != nil {
+			return &ValidationError{Name: "platform_sku", err: fmt.Errorf(`ent: validator failed for field "DeliveryProductMapping.platform_sku": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dpmuo *DeliveryProductMappingUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeliveryProductMappingUpdateOne {
+	dpmuo.modifiers = append(dpmuo.modifiers, modifiers...)
+	return dpmuo
+}
+
+func (dpmuo *DeliveryProductMappingUpdateOne) sqlSave(ctx context.Context) (_node *DeliveryProductMapping, err error) {
+	if err := dpmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deliveryproductmapping.Table, deliveryproductmapping.Columns, sqlgraph.NewFieldSpec(deliveryproductmapping.FieldID, field.TypeUUID))
+	id, ok := dpmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeliveryProductMapping.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dpmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deliveryproductmapping.FieldID)
+		for _, f := range fields {
+			if !deliveryproductmapping.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deliveryproductmapping.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dpmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dpmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(deliveryproductmapping.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dpmuo.mutation.PlatformSku(); ok {
+		_spec.SetField(deliveryproductmapping.FieldPlatformSku, field.TypeString, value)
+	}
+	if value, ok := dpmuo.mutation.ProductID(); ok {
+		_spec.SetField(deliveryproductmapping.FieldProductID, field.TypeUUID, value)
+	}
+	if value, ok := dpmuo.mutation.SpecID(); ok {
+		_spec.SetField(deliveryproductmapping.FieldSpecID, field.TypeUUID, value)
+	}
+	_spec.AddModifiers(dpmuo.modifiers...)
+	_node = &DeliveryProductMapping{config: dpmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dpmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deliveryproductmapping.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dpmuo.mutation.done = true
+	return _node, nil
+}
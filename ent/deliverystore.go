@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliverystore"
+)
+
+// DeliveryStore is the model entity for the DeliveryStore schema.
+type DeliveryStore struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 外卖平台：grabfood；foodpanda
+	Platform domain.DeliveryPlatformCode `json:"platform,omitempty"`
+	// 平台门店ID
+	PlatformStoreID string `json:"platform_store_id,omitempty"`
+	// 是否接收平台订单
+	Enabled bool `json:"enabled,omitempty"`
+	// 是否自动接单
+	AutoAccept bool `json:"auto_accept,omitempty"`
+	// 自动接单金额上限，为0不限制
+	AutoAcceptMaxAmount decimal.Decimal `json:"auto_accept_max_amount,omitempty"`
+	selectValues        sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeliveryStore) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deliverystore.FieldAutoAcceptMaxAmount:
+			values[i] = new(decimal.Decimal)
+		case deliverystore.FieldEnabled, deliverystore.FieldAutoAccept:
+			values[i] = new(sql.NullBool)
+		case deliverystore.FieldPlatform, deliverystore.FieldPlatformStoreID:
+			values[i] = new(sql.NullString)
+		case deliverystore.FieldCreatedAt, deliverystore.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case deliverystore.FieldID, deliverystore.FieldMerchantID, deliverystore.FieldStoreID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeliveryStore fields.
+func (ds *DeliveryStore) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deliverystore.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ds.ID = *value
+			}
+		case deliverystore.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ds.CreatedAt = value.Time
+			}
+		case deliverystore.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ds.UpdatedAt = value.Time
+			}
+		case deliverystore.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				ds.MerchantID = *value
+			}
+		case deliverystore.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				ds.StoreID = *value
+			}
+		case deliverystore.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				ds.Platform = domain.DeliveryPlatformCode(value.String)
+			}
+		case deliverystore.FieldPlatformStoreID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform_store_id", values[i])
+			} else if value.Valid {
+				ds.PlatformStoreID = value.String
+			}
+		case deliverystore.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				ds.Enabled = value.Bool
+			}
+		case deliverystore.FieldAutoAccept:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_accept", values[i])
+			} else if value.Valid {
+				ds.AutoAccept = value.Bool
+			}
+		case deliverystore.FieldAutoAcceptMaxAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_accept_max_amount", values[i])
+			} else if value != nil {
+				ds.AutoAcceptMaxAmount = *value
+			}
+		default:
+			ds.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeliveryStore.
+// This includes values selected through modifiers, order, etc.
+func (ds *DeliveryStore) Value(name string) (ent.Value, error) {
+	return ds.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeliveryStore.
+// Note that you need to call DeliveryStore.Unwrap() before calling this method if this DeliveryStore
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ds *DeliveryStore) Update() *DeliveryStoreUpdateOne {
+	return NewDeliveryStoreClient(ds.config).UpdateOne(ds)
+}
+
+// Unwrap unwraps the DeliveryStore entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ds *DeliveryStore) Unwrap() *DeliveryStore {
+	_tx, ok := ds.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeliveryStore is not a transactional entity")
+	}
+	ds.config.driver = _tx.drv
+	return ds
+}
+
+// String implements the fmt.Stringer.
+func (ds *DeliveryStore) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeliveryStore(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ds.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ds.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ds.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ds.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", ds.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("platform=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Platform))
+	builder.WriteString(", ")
+	builder.WriteString("platform_store_id=")
+	builder.WriteString(ds.PlatformStoreID)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("auto_accept=")
+	builder.WriteString(fmt.Sprintf("%v", ds.AutoAccept))
+	builder.WriteString(", ")
+	builder.WriteString("auto_accept_max_amount=")
+	builder.WriteString(fmt.Sprintf("%v", ds.AutoAcceptMaxAmount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeliveryStores is a parsable slice of DeliveryStore.
+type DeliveryStores []*DeliveryStore
@@ -0,0 +1,143 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deliverystore
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	// Label holds the string label denoting the deliverystore type in the database.
+	Label = "delivery_store"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldPlatform holds the string denoting the platform field in the database.
+	FieldPlatform = "platform"
+	// FieldPlatformStoreID holds the string denoting the platform_store_id field in the database.
+	FieldPlatformStoreID = "platform_store_id"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldAutoAccept holds the string denoting the auto_accept field in the database.
+	FieldAutoAccept = "auto_accept"
+	// FieldAutoAcceptMaxAmount holds the string denoting the auto_accept_max_amount field in the database.
+	FieldAutoAcceptMaxAmount = "auto_accept_max_amount"
+	// Table holds the table name of the deliverystore in the database.
+	Table = "delivery_stores"
+)
+
+// Columns holds all SQL columns for deliverystore fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldPlatform,
+	FieldPlatformStoreID,
+	FieldEnabled,
+	FieldAutoAccept,
+	FieldAutoAcceptMaxAmount,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// PlatformStoreIDValidator is a validator for the "platform_store_id" field. It is called by the builders before save.
+	PlatformStoreIDValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultAutoAccept holds the default value on creation for the "auto_accept" field.
+	DefaultAutoAccept bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// PlatformValidator is a validator for the "platform" field enum values. It is called by the builders before save.
+func PlatformValidator(pl domain.DeliveryPlatformCode) error {
+	switch pl {
+	case "grabfood", "foodpanda":
+		return nil
+	default:
+		return fmt.Errorf("deliverystore: invalid enum value for platform field: %q", pl)
+	}
+}
+
+// OrderOption defines the ordering options for the DeliveryStore queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByPlatform orders the results by the platform field.
+func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
+}
+
+// ByPlatformStoreID orders the results by the platform_store_id field.
+func ByPlatformStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatformStoreID, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByAutoAccept orders the results by the auto_accept field.
+func ByAutoAccept(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoAccept, opts...).ToFunc()
+}
+
+// ByAutoAcceptMaxAmount orders the results by the auto_accept_max_amount field.
+func ByAutoAcceptMaxAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoAcceptMaxAmount, opts...).ToFunc()
+}
@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deliverystore
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldStoreID, v))
+}
+
+// PlatformStoreID applies equality check predicate on the "platform_store_id" field. It's identical to PlatformStoreIDEQ.
+func PlatformStoreID(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldPlatformStoreID, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldEnabled, v))
+}
+
+// AutoAccept applies equality check predicate on the "auto_accept" field. It's identical to AutoAcceptEQ.
+func AutoAccept(v bool) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldAutoAccept, v))
+}
+
+// AutoAcceptMaxAmount applies equality check predicate on the "auto_accept_max_amount" field. It's identical to AutoAcceptMaxAmountEQ.
+func AutoAcceptMaxAmount(v decimal.Decimal) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldAutoAcceptMaxAmount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLTE(FieldStoreID, v))
+}
+
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v domain.DeliveryPlatformCode) predicate.DeliveryStore {
+	vc := v
+	return predicate.DeliveryStore(sql.FieldEQ(FieldPlatform, vc))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v domain.DeliveryPlatformCode) predicate.DeliveryStore {
+	vc := v
+	return predicate.DeliveryStore(sql.FieldNEQ(FieldPlatform, vc))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...domain.DeliveryPlatformCode) predicate.DeliveryStore {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryStore(sql.FieldIn(FieldPlatform, v...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...domain.DeliveryPlatformCode) predicate.DeliveryStore {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryStore(sql.FieldNotIn(FieldPlatform, v...))
+}
+
+// PlatformStoreIDEQ applies the EQ predicate on the "platform_store_id" field.
+func PlatformStoreIDEQ(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldPlatformStoreID, v))
+}
+
+// PlatformStoreIDNEQ applies the NEQ predicate on the "platform_store_id" field.
+func PlatformStoreIDNEQ(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNEQ(FieldPlatformStoreID, v))
+}
+
+// PlatformStoreIDIn applies the In predicate on the "platform_store_id" field.
+func PlatformStoreIDIn(vs ...string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldIn(FieldPlatformStoreID, vs...))
+}
+
+// PlatformStoreIDNotIn applies the NotIn predicate on the "platform_store_id" field.
+func PlatformStoreIDNotIn(vs ...string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNotIn(FieldPlatformStoreID, vs...))
+}
+
+// PlatformStoreIDGT applies the GT predicate on the "platform_store_id" field.
+func PlatformStoreIDGT(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGT(FieldPlatformStoreID, v))
+}
+
+// PlatformStoreIDGTE applies the GTE predicate on the "platform_store_id" field.
+func PlatformStoreIDGTE(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGTE(FieldPlatformStoreID, v))
+}
+
+// PlatformStoreIDLT applies the LT predicate on the "platform_store_id" field.
+func PlatformStoreIDLT(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLT(FieldPlatformStoreID, v))
+}
+
+// PlatformStoreIDLTE applies the LTE predicate on the "platform_store_id" field.
+func PlatformStoreIDLTE(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLTE(FieldPlatformStoreID, v))
+}
+
+// PlatformStoreIDContains applies the Contains predicate on the "platform_store_id" field.
+func PlatformStoreIDContains(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldContains(FieldPlatformStoreID, v))
+}
+
+// PlatformStoreIDHasPrefix applies the HasPrefix predicate on the "platform_store_id" field.
+func PlatformStoreIDHasPrefix(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldHasPrefix(FieldPlatformStoreID, v))
+}
+
+// PlatformStoreIDHasSuffix applies the HasSuffix predicate on the "platform_store_id" field.
+func PlatformStoreIDHasSuffix(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldHasSuffix(FieldPlatformStoreID, v))
+}
+
+// PlatformStoreIDEqualFold applies the EqualFold predicate on the "platform_store_id" field.
+func PlatformStoreIDEqualFold(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEqualFold(FieldPlatformStoreID, v))
+}
+
+// PlatformStoreIDContainsFold applies the ContainsFold predicate on the "platform_store_id" field.
+func PlatformStoreIDContainsFold(v string) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldContainsFold(FieldPlatformStoreID, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// AutoAcceptEQ applies the EQ predicate on the "auto_accept" field.
+func AutoAcceptEQ(v bool) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldAutoAccept, v))
+}
+
+// AutoAcceptNEQ applies the NEQ predicate on the "auto_accept" field.
+func AutoAcceptNEQ(v bool) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNEQ(FieldAutoAccept, v))
+}
+
+// AutoAcceptMaxAmountEQ applies the EQ predicate on the "auto_accept_max_amount" field.
+func AutoAcceptMaxAmountEQ(v decimal.Decimal) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldEQ(FieldAutoAcceptMaxAmount, v))
+}
+
+// AutoAcceptMaxAmountNEQ applies the NEQ predicate on the "auto_accept_max_amount" field.
+func AutoAcceptMaxAmountNEQ(v decimal.Decimal) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNEQ(FieldAutoAcceptMaxAmount, v))
+}
+
+// AutoAcceptMaxAmountIn applies the In predicate on the "auto_accept_max_amount" field.
+func AutoAcceptMaxAmountIn(vs ...decimal.Decimal) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldIn(FieldAutoAcceptMaxAmount, vs...))
+}
+
+// AutoAcceptMaxAmountNotIn applies the NotIn predicate on the "auto_accept_max_amount" field.
+func AutoAcceptMaxAmountNotIn(vs ...decimal.Decimal) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldNotIn(FieldAutoAcceptMaxAmount, vs...))
+}
+
+// AutoAcceptMaxAmountGT applies the GT predicate on the "auto_accept_max_amount" field.
+func AutoAcceptMaxAmountGT(v decimal.Decimal) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGT(FieldAutoAcceptMaxAmount, v))
+}
+
+// AutoAcceptMaxAmountGTE applies the GTE predicate on the "auto_accept_max_amount" field.
+func AutoAcceptMaxAmountGTE(v decimal.Decimal) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldGTE(FieldAutoAcceptMaxAmount, v))
+}
+
+// AutoAcceptMaxAmountLT applies the LT predicate on the "auto_accept_max_amount" field.
+func AutoAcceptMaxAmountLT(v decimal.Decimal) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLT(FieldAutoAcceptMaxAmount, v))
+}
+
+// AutoAcceptMaxAmountLTE applies the LTE predicate on the "auto_accept_max_amount" field.
+func AutoAcceptMaxAmountLTE(v decimal.Decimal) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.FieldLTE(FieldAutoAcceptMaxAmount, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeliveryStore) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeliveryStore) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeliveryStore) predicate.DeliveryStore {
+	return predicate.DeliveryStore(sql.NotPredicates(p))
+}
@@ -0,0 +1,857 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliverystore"
+)
+
+// DeliveryStoreCreate is the builder for creating a DeliveryStore entity.
+type DeliveryStoreCreate struct {
+	config
+	mutation *DeliveryStoreMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dsc *DeliveryStoreCreate) SetCreatedAt(t time.Time) *DeliveryStoreCreate {
+	dsc.mutation.SetCreatedAt(t)
+	return dsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dsc *DeliveryStoreCreate) SetNillableCreatedAt(t *time.Time) *DeliveryStoreCreate {
+	if t != nil {
+		dsc.SetCreatedAt(*t)
+	}
+	return dsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsc *DeliveryStoreCreate) SetUpdatedAt(t time.Time) *DeliveryStoreCreate {
+	dsc.mutation.SetUpdatedAt(t)
+	return dsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dsc *DeliveryStoreCreate) SetNillableUpdatedAt(t *time.Time) *DeliveryStoreCreate {
+	if t != nil {
+		dsc.SetUpdatedAt(*t)
+	}
+	return dsc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (dsc *DeliveryStoreCreate) SetMerchantID(u uuid.UUID) *DeliveryStoreCreate {
+	dsc.mutation.SetMerchantID(u)
+	return dsc
+}
+
+// SetStoreID sets the "store_id" field.
+func (dsc *DeliveryStoreCreate) SetStoreID(u uuid.UUID) *DeliveryStoreCreate {
+	dsc.mutation.SetStoreID(u)
+	return dsc
+}
+
+// SetPlatform sets the "platform" field.
+func (dsc *DeliveryStoreCreate) SetPlatform(dpc domain.DeliveryPlatformCode) *DeliveryStoreCreate {
+	dsc.mutation.SetPlatform(dpc)
+	return dsc
+}
+
+// SetPlatformStoreID sets the "platform_store_id" field.
+func (dsc *DeliveryStoreCreate) SetPlatformStoreID(s string) *DeliveryStoreCreate {
+	dsc.mutation.SetPlatformStoreID(s)
+	return dsc
+}
+
+// SetEnabled sets the "enabled" field.
+func (dsc *DeliveryStoreCreate) SetEnabled(b bool) *DeliveryStoreCreate {
+	dsc.mutation.SetEnabled(b)
+	return dsc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (dsc *DeliveryStoreCreate) SetNillableEnabled(b *bool) *DeliveryStoreCreate {
+	if b != nil {
+		dsc.SetEnabled(*b)
+	}
+	return dsc
+}
+
+// SetAutoAccept sets the "auto_accept" field.
+func (dsc *DeliveryStoreCreate) SetAutoAccept(b bool) *DeliveryStoreCreate {
+	dsc.mutation.SetAutoAccept(b)
+	return dsc
+}
+
+// SetNillableAutoAccept sets the "auto_accept" field if the given value is not nil.
+func (dsc *DeliveryStoreCreate) SetNillableAutoAccept(b *bool) *DeliveryStoreCreate {
+	if b != nil {
+		dsc.SetAutoAccept(*b)
+	}
+	return dsc
+}
+
+// SetAutoAcceptMaxAmount sets the "auto_accept_max_amount" field.
+func (dsc *DeliveryStoreCreate) SetAutoAcceptMaxAmount(d decimal.Decimal) *DeliveryStoreCreate {
+	dsc.mutation.SetAutoAcceptMaxAmount(d)
+	return dsc
+}
+
+// SetID sets the "id" field.
+func (dsc *DeliveryStoreCreate) SetID(u uuid.UUID) *DeliveryStoreCreate {
+	dsc.mutation.SetID(u)
+	return dsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dsc *DeliveryStoreCreate) SetNillableID(u *uuid.UUID) *DeliveryStoreCreate {
+	if u != nil {
+		dsc.SetID(*u)
+	}
+	return dsc
+}
+
+// Mutation returns the DeliveryStoreMutation object of the builder.
+func (dsc *DeliveryStoreCreate) Mutation() *DeliveryStoreMutation {
+	return dsc.mutation
+}
+
+// Save creates the DeliveryStore in the database.
+func (dsc *DeliveryStoreCreate) Save(ctx context.Context) (*DeliveryStore, error) {
+	dsc.defaults()
+	return withHooks(ctx, dsc.sqlSave, dsc.mutation, dsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dsc *DeliveryStoreCreate) SaveX(ctx context.Context) *DeliveryStore {
+	v, err := dsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dsc *DeliveryStoreCreate) Exec(ctx context.Context) error {
+	_, err := dsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsc *DeliveryStoreCreate) ExecX(ctx context.Context) {
+	if err := dsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsc *DeliveryStoreCreate) defaults() {
+	if _, ok := dsc.mutation.CreatedAt(); !ok {
+		v := deliverystore.DefaultCreatedAt()
+		dsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dsc.mutation.UpdatedAt(); !ok {
+		v := deliverystore.DefaultUpdatedAt()
+		dsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dsc.mutation.Enabled(); !ok {
+		v := deliverystore.DefaultEnabled
+		dsc.mutation.SetEnabled(v)
+	}
+	if _, ok := dsc.mutation.AutoAccept(); !ok {
+		v := deliverystore.DefaultAutoAccept
+		dsc.mutation.SetAutoAccept(v)
+	}
+	if _, ok := dsc.mutation.ID(); !ok {
+		v := deliverystore.DefaultID()
+		dsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsc *DeliveryStoreCreate) check() error {
+	if _, ok := dsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeliveryStore.created_at"`)}
+	}
+	if _, ok := dsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeliveryStore.updated_at"`)}
+	}
+	if _, ok := dsc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "DeliveryStore.merchant_id"`)}
+	}
+	if _, ok := dsc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "DeliveryStore.store_id"`)}
+	}
+	if _, ok := dsc.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "DeliveryStore.platform"`)}
+	}
+	if v, ok := dsc.mutation.Platform(); ok {
+		if err := deliverystore.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "DeliveryStore.platform": %w`, err)}
+		}
+	}
+	if _, ok := dsc.mutation.PlatformStoreID(); !ok {
+		return &ValidationError{Name: "platform_store_id", err: errors.New(`ent: missing required field "DeliveryStore.platform_store_id"`)}
+	}
+	if v, ok := dsc.mutation.PlatformStoreID(); ok {
+		if err := deliverystore.PlatformStoreIDValidator(v); err != nil {
+			return &ValidationError{Name: "platform_store_id", err: fmt.Errorf(`ent: validator failed for field "DeliveryStore.platform_store_id": %w`, err)}
+		}
+	}
+	if _, ok := dsc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "DeliveryStore.enabled"`)}
+	}
+	if _, ok := dsc.mutation.AutoAccept(); !ok {
+		return &ValidationError{Name: "auto_accept", err: errors.New(`ent: missing required field "DeliveryStore.auto_accept"`)}
+	}
+	if _, ok := dsc.mutation.AutoAcceptMaxAmount(); !ok {
+		return &ValidationError{Name: "auto_accept_max_amount", err: errors.New(`ent: missing required field "DeliveryStore.auto_accept_max_amount"`)}
+	}
+	return nil
+}
+
+func (dsc *DeliveryStoreCreate) sqlSave(ctx context.Context) (*DeliveryStore, error) {
+	if err := dsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dsc.mutation.id = &_node.ID
+	dsc.mutation.done = true
+	return _node, nil
+}
+
+func (dsc *DeliveryStoreCreate) createSpec() (*DeliveryStore, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeliveryStore{config: dsc.config}
+		_spec = sqlgraph.NewCreateSpec(deliverystore.Table, sqlgraph.NewFieldSpec(deliverystore.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dsc.conflict
+	if id, ok := dsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dsc.mutation.CreatedAt(); ok {
+		_spec.SetField(deliverystore.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(deliverystore.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dsc.mutation.MerchantID(); ok {
+		_spec.SetField(deliverystore.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := dsc.mutation.StoreID(); ok {
+		_spec.SetField(deliverystore.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := dsc.mutation.Platform(); ok {
+		_spec.SetField(deliverystore.FieldPlatform, field.TypeEnum, value)
+		_node.Platform = value
+	}
+	if value, ok := dsc.mutation.PlatformStoreID(); ok {
+		_spec.SetField(deliverystore.FieldPlatformStoreID, field.TypeString, value)
+		_node.PlatformStoreID = value
+	}
+	if value, ok := dsc.mutation.Enabled(); ok {
+		_spec.SetField(deliverystore.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := dsc.mutation.AutoAccept(); ok {
+		_spec.SetField(deliverystore.FieldAutoAccept, field.TypeBool, value)
+		_node.AutoAccept = value
+	}
+	if value, ok := dsc.mutation.AutoAcceptMaxAmount(); ok {
+		_spec.SetField(deliverystore.FieldAutoAcceptMaxAmount, field.TypeOther, value)
+		_node.AutoAcceptMaxAmount = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeliveryStore.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeliveryStoreUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dsc *DeliveryStoreCreate) OnConflict(opts ...sql.ConflictOption) *DeliveryStoreUpsertOne {
+	dsc.conflict = opts
+	return &DeliveryStoreUpsertOne{
+		create: dsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeliveryStore.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dsc *DeliveryStoreCreate) OnConflictColumns(columns ...string) *DeliveryStoreUpsertOne {
+	dsc.conflict = append(dsc.conflict, sql.ConflictColumns(columns...))
+	return &DeliveryStoreUpsertOne{
+		create: dsc,
+	}
+}
+
+type (
+	// DeliveryStoreUpsertOne is the builder for "upsert"-ing
+	//  one DeliveryStore node.
+	DeliveryStoreUpsertOne struct {
+		create *DeliveryStoreCreate
+	}
+
+	// DeliveryStoreUpsert is the "OnConflict" setter.
+	DeliveryStoreUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeliveryStoreUpsert) SetUpdatedAt(v time.Time) *DeliveryStoreUpsert {
+	u.Set(deliverystore.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeliveryStoreUpsert) UpdateUpdatedAt() *DeliveryStoreUpsert {
+	u.SetExcluded(deliverystore.FieldUpdatedAt)
+	return u
+}
+
+// SetPlatformStoreID sets the "platform_store_id" field.
+func (u *DeliveryStoreUpsert) SetPlatformStoreID(v string) *DeliveryStoreUpsert {
+	u.Set(deliverystore.FieldPlatformStoreID, v)
+	return u
+}
+
+// UpdatePlatformStoreID sets the "platform_store_id" field to the value that was provided on create.
+func (u *DeliveryStoreUpsert) UpdatePlatformStoreID() *DeliveryStoreUpsert {
+	u.SetExcluded(deliverystore.FieldPlatformStoreID)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *DeliveryStoreUpsert) SetEnabled(v bool) *DeliveryStoreUpsert {
+	u.Set(deliverystore.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *DeliveryStoreUpsert) UpdateEnabled() *DeliveryStoreUpsert {
+	u.SetExcluded(deliverystore.FieldEnabled)
+	return u
+}
+
+// SetAutoAccept sets the "auto_accept" field.
+func (u *DeliveryStoreUpsert) SetAutoAccept(v bool) *DeliveryStoreUpsert {
+	u.Set(deliverystore.FieldAutoAccept, v)
+	return u
+}
+
+// UpdateAutoAccept sets the "auto_accept" field to the value that was provided on create.
+func (u *DeliveryStoreUpsert) UpdateAutoAccept() *DeliveryStoreUpsert {
+	u.SetExcluded(deliverystore.FieldAutoAccept)
+	return u
+}
+
+// SetAutoAcceptMaxAmount sets the "auto_accept_max_amount" field.
+func (u *DeliveryStoreUpsert) SetAutoAcceptMaxAmount(v decimal.Decimal) *DeliveryStoreUpsert {
+	u.Set(deliverystore.FieldAutoAcceptMaxAmount, v)
+	return u
+}
+
+// UpdateAutoAcceptMaxAmount sets the "auto_accept_max_amount" field to the value that was provided on create.
+func (u *DeliveryStoreUpsert) UpdateAutoAcceptMaxAmount() *DeliveryStoreUpsert {
+	u.SetExcluded(deliverystore.FieldAutoAcceptMaxAmount)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeliveryStore.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deliverystore.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeliveryStoreUpsertOne) UpdateNewValues() *DeliveryStoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deliverystore.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(deliverystore.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(deliverystore.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(deliverystore.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.Platform(); exists {
+			s.SetIgnore(deliverystore.FieldPlatform)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeliveryStore.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeliveryStoreUpsertOne) Ignore() *DeliveryStoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeliveryStoreUpsertOne) DoNothing() *DeliveryStoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeliveryStoreCreate.OnConflict
+// documentation for more info.
+func (u *DeliveryStoreUpsertOne) Update(set func(*DeliveryStoreUpsert)) *DeliveryStoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeliveryStoreUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeliveryStoreUpsertOne) SetUpdatedAt(v time.Time) *DeliveryStoreUpsertOne {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeliveryStoreUpsertOne) UpdateUpdatedAt() *DeliveryStoreUpsertOne {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPlatformStoreID sets the "platform_store_id" field.
+func (u *DeliveryStoreUpsertOne) SetPlatformStoreID(v string) *DeliveryStoreUpsertOne {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.SetPlatformStoreID(v)
+	})
+}
+
+// UpdatePlatformStoreID sets the "platform_store_id" field to the value that was provided on create.
+func (u *DeliveryStoreUpsertOne) UpdatePlatformStoreID() *DeliveryStoreUpsertOne {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.UpdatePlatformStoreID()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *DeliveryStoreUpsertOne) SetEnabled(v bool) *DeliveryStoreUpsertOne {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *DeliveryStoreUpsertOne) UpdateEnabled() *DeliveryStoreUpsertOne {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetAutoAccept sets the "auto_accept" field.
+func (u *DeliveryStoreUpsertOne) SetAutoAccept(v bool) *DeliveryStoreUpsertOne {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.SetAutoAccept(v)
+	})
+}
+
+// UpdateAutoAccept sets the "auto_accept" field to the value that was provided on create.
+func (u *DeliveryStoreUpsertOne) UpdateAutoAccept() *DeliveryStoreUpsertOne {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.UpdateAutoAccept()
+	})
+}
+
+// SetAutoAcceptMaxAmount sets the "auto_accept_max_amount" field.
+func (u *DeliveryStoreUpsertOne) SetAutoAcceptMaxAmount(v decimal.Decimal) *DeliveryStoreUpsertOne {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.SetAutoAcceptMaxAmount(v)
+	})
+}
+
+// UpdateAutoAcceptMaxAmount sets the "auto_accept_max_amount" field to the value that was provided on create.
+func (u *DeliveryStoreUpsertOne) UpdateAutoAcceptMaxAmount() *DeliveryStoreUpsertOne {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.UpdateAutoAcceptMaxAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *DeliveryStoreUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeliveryStoreCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeliveryStoreUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeliveryStoreUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DeliveryStoreUpsertOne.ID is not supported by MySQL driver. Use DeliveryStoreUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeliveryStoreUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeliveryStoreCreateBulk is the builder for creating many DeliveryStore entities in bulk.
+type DeliveryStoreCreateBulk struct {
+	config
+	err      error
+	builders []*DeliveryStoreCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeliveryStore entities in the database.
+func (dscb *DeliveryStoreCreateBulk) Save(ctx context.Context) ([]*DeliveryStore, error) {
+	if dscb.err != nil {
+		return nil, dscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dscb.builders))
+	nodes := make([]*DeliveryStore, len(dscb.builders))
+	mutators := make([]Mutator, len(dscb.builders))
+	for i := range dscb.builders {
+		func(i int, root context.Context) {
+			builder := dscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeliveryStoreMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dscb *DeliveryStoreCreateBulk) SaveX(ctx context.Context) []*DeliveryStore {
+	v, err := dscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dscb *DeliveryStoreCreateBulk) Exec(ctx context.Context) error {
+	_, err := dscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dscb *DeliveryStoreCreateBulk) ExecX(ctx context.Context) {
+	if err := dscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeliveryStore.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeliveryStoreUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dscb *DeliveryStoreCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeliveryStoreUpsertBulk {
+	dscb.conflict = opts
+	return &DeliveryStoreUpsertBulk{
+		create: dscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeliveryStore.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dscb *DeliveryStoreCreateBulk) OnConflictColumns(columns ...string) *DeliveryStoreUpsertBulk {
+	dscb.conflict = append(dscb.conflict, sql.ConflictColumns(columns...))
+	return &DeliveryStoreUpsertBulk{
+		create: dscb,
+	}
+}
+
+// DeliveryStoreUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeliveryStore nodes.
+type DeliveryStoreUpsertBulk struct {
+	create *DeliveryStoreCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeliveryStore.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deliverystore.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeliveryStoreUpsertBulk) UpdateNewValues() *DeliveryStoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deliverystore.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(deliverystore.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(deliverystore.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(deliverystore.FieldStoreID)
+			}
+			if _, exists := b.mutation.Platform(); exists {
+				s.SetIgnore(deliverystore.FieldPlatform)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeliveryStore.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeliveryStoreUpsertBulk) Ignore() *DeliveryStoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeliveryStoreUpsertBulk) DoNothing() *DeliveryStoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeliveryStoreCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeliveryStoreUpsertBulk) Update(set func(*DeliveryStoreUpsert)) *DeliveryStoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeliveryStoreUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeliveryStoreUpsertBulk) SetUpdatedAt(v time.Time) *DeliveryStoreUpsertBulk {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeliveryStoreUpsertBulk) UpdateUpdatedAt() *DeliveryStoreUpsertBulk {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPlatformStoreID sets the "platform_store_id" field.
+func (u *DeliveryStoreUpsertBulk) SetPlatformStoreID(v string) *DeliveryStoreUpsertBulk {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.SetPlatformStoreID(v)
+	})
+}
+
+// UpdatePlatformStoreID sets the "platform_store_id" field to the value that was provided on create.
+func (u *DeliveryStoreUpsertBulk) UpdatePlatformStoreID() *DeliveryStoreUpsertBulk {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.UpdatePlatformStoreID()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *DeliveryStoreUpsertBulk) SetEnabled(v bool) *DeliveryStoreUpsertBulk {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *DeliveryStoreUpsertBulk) UpdateEnabled() *DeliveryStoreUpsertBulk {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetAutoAccept sets the "auto_accept" field.
+func (u *DeliveryStoreUpsertBulk) SetAutoAccept(v bool) *DeliveryStoreUpsertBulk {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.SetAutoAccept(v)
+	})
+}
+
+// UpdateAutoAccept sets the "auto_accept" field to the value that was provided on create.
+func (u *DeliveryStoreUpsertBulk) UpdateAutoAccept() *DeliveryStoreUpsertBulk {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.UpdateAutoAccept()
+	})
+}
+
+// SetAutoAcceptMaxAmount sets the "auto_accept_max_amount" field.
+func (u *DeliveryStoreUpsertBulk) SetAutoAcceptMaxAmount(v decimal.Decimal) *DeliveryStoreUpsertBulk {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.SetAutoAcceptMaxAmount(v)
+	})
+}
+
+// UpdateAutoAcceptMaxAmount sets the "auto_accept_max_amount" field to the value that was provided on create.
+func (u *DeliveryStoreUpsertBulk) UpdateAutoAcceptMaxAmount() *DeliveryStoreUpsertBulk {
+	return u.Update(func(s *DeliveryStoreUpsert) {
+		s.UpdateAutoAcceptMaxAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *DeliveryStoreUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeliveryStoreCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeliveryStoreCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeliveryStoreUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
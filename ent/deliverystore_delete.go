@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliverystore"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DeliveryStoreDelete is the builder for deleting a DeliveryStore entity.
+type DeliveryStoreDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeliveryStoreMutation
+}
+
+// Where appends a list predicates to the DeliveryStoreDelete builder.
+func (dsd *DeliveryStoreDelete) Where(ps ...predicate.DeliveryStore) *DeliveryStoreDelete {
+	dsd.mutation.Where(ps...)
+	return dsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dsd *DeliveryStoreDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dsd.sqlExec, dsd.mutation, dsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsd *DeliveryStoreDelete) ExecX(ctx context.Context) int {
+	n, err := dsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dsd *DeliveryStoreDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deliverystore.Table, sqlgraph.NewFieldSpec(deliverystore.FieldID, field.TypeUUID))
+	if ps := dsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dsd.mutation.done = true
+	return affected, err
+}
+
+// DeliveryStoreDeleteOne is the builder for deleting a single DeliveryStore entity.
+type DeliveryStoreDeleteOne struct {
+	dsd *DeliveryStoreDelete
+}
+
+// Where appends a list predicates to the DeliveryStoreDelete builder.
+func (dsdo *DeliveryStoreDeleteOne) Where(ps ...predicate.DeliveryStore) *DeliveryStoreDeleteOne {
+	dsdo.dsd.mutation.Where(ps...)
+	return dsdo
+}
+
+// Exec executes the deletion query.
+func (dsdo *DeliveryStoreDeleteOne) Exec(ctx context.Context) error {
+	n, err := dsdo.dsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deliverystore.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsdo *DeliveryStoreDeleteOne) ExecX(ctx context.Context) {
+	if err := dsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliverystore"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DeliveryStoreQuery is the builder for querying DeliveryStore entities.
+type DeliveryStoreQuery struct {
+	config
+	ctx        *QueryContext
+	order      []deliverystore.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DeliveryStore
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DeliveryStoreQuery builder.
+func (dsq *DeliveryStoreQuery) Where(ps ...predicate.DeliveryStore) *DeliveryStoreQuery {
+	dsq.predicates = append(dsq.predicates, ps...)
+	return dsq
+}
+
+// Limit the number of records to be returned by this query.
+func (dsq *DeliveryStoreQuery) Limit(limit int) *DeliveryStoreQuery {
+	dsq.ctx.Limit = &limit
+	return dsq
+}
+
+// Offset to start from.
+func (dsq *DeliveryStoreQuery) Offset(offset int) *DeliveryStoreQuery {
+	dsq.ctx.Offset = &offset
+	return dsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dsq *DeliveryStoreQuery) Unique(unique bool) *DeliveryStoreQuery {
+	dsq.ctx.Unique = &unique
+	return dsq
+}
+
+// Order specifies how the records should be ordered.
+func (dsq *DeliveryStoreQuery) Order(o ...deliverystore.OrderOption) *DeliveryStoreQuery {
+	dsq.order = append(dsq.order, o...)
+	return dsq
+}
+
+// First returns the first DeliveryStore entity from the query.
+// Returns a *NotFoundError when no DeliveryStore was found.
+func (dsq *DeliveryStoreQuery) First(ctx context.Context) (*DeliveryStore, error) {
+	nodes, err := dsq.Limit(1).All(setContextOp(ctx, dsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{deliverystore.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dsq *DeliveryStoreQuery) FirstX(ctx context.Context) *DeliveryStore {
+	node, err := dsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DeliveryStore ID from the query.
+// Returns a *NotFoundError when no DeliveryStore ID was found.
+func (dsq *DeliveryStoreQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dsq.Limit(1).IDs(setContextOp(ctx, dsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{deliverystore.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dsq *DeliveryStoreQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := dsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DeliveryStore entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DeliveryStore entity is found.
+// Returns a *NotFoundError when no DeliveryStore entities are found.
+func (dsq *DeliveryStoreQuery) Only(ctx context.Context) (*DeliveryStore, error) {
+	nodes, err := dsq.Limit(2).All(setContextOp(ctx, dsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{deliverystore.Label}
+	default:
+		return nil, &NotSingularError{deliverystore.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dsq *DeliveryStoreQuery) OnlyX(ctx context.Context) *DeliveryStore {
+	node, err := dsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DeliveryStore ID in the query.
+// Returns a *NotSingularError when more than one DeliveryStore ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dsq *DeliveryStoreQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dsq.Limit(2).IDs(setContextOp(ctx, dsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{deliverystore.Label}
+	default:
+		err = &NotSingularError{deliverystore.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dsq *DeliveryStoreQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := dsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DeliveryStores.
+func (dsq *DeliveryStoreQuery) All(ctx context.Context) ([]*DeliveryStore, error) {
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryAll)
+	if err := dsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DeliveryStore, *DeliveryStoreQuery]()
+	return withInterceptors[[]*DeliveryStore](ctx, dsq, qr, dsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dsq *DeliveryStoreQuery) AllX(ctx context.Context) []*DeliveryStore {
+	nodes, err := dsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DeliveryStore IDs.
+func (dsq *DeliveryStoreQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if dsq.ctx.Unique == nil && dsq.path != nil {
+		dsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryIDs)
+	if err = dsq.Select(deliverystore.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dsq *DeliveryStoreQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dsq *DeliveryStoreQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryCount)
+	if err := dsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dsq, querierCount[*DeliveryStoreQuery](), dsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dsq *DeliveryStoreQuery) CountX(ctx context.Context) int {
+	count, err := dsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dsq *DeliveryStoreQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryExist)
+	switch _, err := dsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dsq *DeliveryStoreQuery) ExistX(ctx context.Context) bool {
+	exist, err := dsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DeliveryStoreQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dsq *DeliveryStoreQuery) Clone() *DeliveryStoreQuery {
+	if dsq == nil {
+		return nil
+	}
+	return &DeliveryStoreQuery{
+		config:     dsq.config,
+		ctx:        dsq.ctx.Clone(),
+		order:      append([]deliverystore.OrderOption{}, dsq.order...),
+		inters:     append([]Interceptor{}, dsq.inters...),
+		predicates: append([]predicate.DeliveryStore{}, dsq.predicates...),
+		// clone intermediate query.
+		sql:       dsq.sql.Clone(),
+		path:      dsq.path,
+		modifiers: append([]func(*sql.Selector){}, dsq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DeliveryStore.Query().
+//		GroupBy(deliverystore.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dsq *DeliveryStoreQuery) GroupBy(field string, fields ...string) *DeliveryStoreGroupBy {
+	dsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DeliveryStoreGroupBy{build: dsq}
+	grbuild.flds = &dsq.ctx.Fields
+	grbuild.label = deliverystore.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DeliveryStore.Query().
+//		Select(deliverystore.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (dsq *DeliveryStoreQuery) Select(fields ...string) *DeliveryStoreSelect {
+	dsq.ctx.Fields = append(dsq.ctx.Fields, fields...)
+	sbuild := &DeliveryStoreSelect{DeliveryStoreQuery: dsq}
+	sbuild.label = deliverystore.Label
+	sbuild.flds, sbuild.scan = &dsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DeliveryStoreSelect configured with the given aggregations.
+func (dsq *DeliveryStoreQuery) Aggregate(fns ...AggregateFunc) *DeliveryStoreSelect {
+	return dsq.Select().Aggregate(fns...)
+}
+
+func (dsq *DeliveryStoreQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dsq.ctx.Fields {
+		if !deliverystore.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dsq.path != nil {
+		prev, err := dsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dsq.sql = prev
+	}
+	return nil
+}
+
+func (dsq *DeliveryStoreQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DeliveryStore, error) {
+	var (
+		nodes = []*DeliveryStore{}
+		_spec = dsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DeliveryStore).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DeliveryStore{config: dsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(dsq.modifiers) > 0 {
+		_spec.Modifiers = dsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (dsq *DeliveryStoreQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dsq.querySpec()
+	if len(dsq.modifiers) > 0 {
+		_spec.Modifiers = dsq.modifiers
+	}
+	_spec.Node.Columns = dsq.ctx.Fields
+	if len(dsq.ctx.Fields) > 0 {
+		_spec.Unique = dsq.ctx.Unique != nil && *dsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dsq.driver, _spec)
+}
+
+func (dsq *DeliveryStoreQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(deliverystore.Table, deliverystore.Columns, sqlgraph.NewFieldSpec(deliverystore.FieldID, field.TypeUUID))
+	_spec.From = dsq.sql
+	if unique := dsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deliverystore.FieldID)
+		for i := range fields {
+			if fields[i] != deliverystore.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dsq *DeliveryStoreQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dsq.driver.Dialect())
+	t1 := builder.Table(deliverystore.Table)
+	columns := dsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = deliverystore.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dsq.sql != nil {
+		selector = dsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dsq.ctx.Unique != nil && *dsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range dsq.modifiers {
+		m(selector)
+	}
+	for _, p := range dsq.predicates {
+		p(selector)
+	}
+	for _, p := range dsq.order {
+		p(selector)
+	}
+	if offset := dsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (dsq *DeliveryStoreQuery) ForUpdate(opts ...sql.LockOption) *DeliveryStoreQuery {
+	if dsq.driver.Dialect() == dialect.Postgres {
+		dsq.Unique(false)
+	}
+	dsq.modifiers = append(dsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return dsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (dsq *DeliveryStoreQuery) ForShare(opts ...sql.LockOption) *DeliveryStoreQuery {
+	if dsq.driver.Dialect() == dialect.Postgres {
+		dsq.Unique(false)
+	}
+	dsq.modifiers = append(dsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return dsq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dsq *DeliveryStoreQuery) Modify(modifiers ...func(s *sql.Selector)) *DeliveryStoreSelect {
+	dsq.modifiers = append(dsq.modifiers, modifiers...)
+	return dsq.Select()
+}
+
+// DeliveryStoreGroupBy is the group-by builder for DeliveryStore entities.
+type DeliveryStoreGroupBy struct {
+	selector
+	build *DeliveryStoreQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dsgb *DeliveryStoreGroupBy) Aggregate(fns ...AggregateFunc) *DeliveryStoreGroupBy {
+	dsgb.fns = append(dsgb.fns, fns...)
+	return dsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dsgb *DeliveryStoreGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeliveryStoreQuery, *DeliveryStoreGroupBy](ctx, dsgb.build, dsgb, dsgb.build.inters, v)
+}
+
+func (dsgb *DeliveryStoreGroupBy) sqlScan(ctx context.Context, root *DeliveryStoreQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dsgb.fns))
+	for _, fn := range dsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dsgb.flds)+len(dsgb.fns))
+		for _, f := range *dsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DeliveryStoreSelect is the builder for selecting fields of DeliveryStore entities.
+type DeliveryStoreSelect struct {
+	*DeliveryStoreQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dss *DeliveryStoreSelect) Aggregate(fns ...AggregateFunc) *DeliveryStoreSelect {
+	dss.fns = append(dss.fns, fns...)
+	return dss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dss *DeliveryStoreSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dss.ctx, ent.OpQuerySelect)
+	if err := dss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeliveryStoreQuery, *DeliveryStoreSelect](ctx, dss.DeliveryStoreQuery, dss, dss.inters, v)
+}
+
+func (dss *DeliveryStoreSelect) sqlScan(ctx context.Context, root *DeliveryStoreQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dss.fns))
+	for _, fn := range dss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dss *DeliveryStoreSelect) Modify(modifiers ...func(s *sql.Selector)) *DeliveryStoreSelect {
+	dss.modifiers = append(dss.modifiers, modifiers...)
+	return dss
+}
@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliverystore"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DeliveryStoreUpdate is the builder for updating DeliveryStore entities.
+type DeliveryStoreUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DeliveryStoreMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DeliveryStoreUpdate builder.
+func (dsu *DeliveryStoreUpdate) Where(ps ...predicate.DeliveryStore) *DeliveryStoreUpdate {
+	dsu.mutation.Where(ps...)
+	return dsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsu *DeliveryStoreUpdate) SetUpdatedAt(t time.Time) *DeliveryStoreUpdate {
+	dsu.mutation.SetUpdatedAt(t)
+	return dsu
+}
+
+// SetPlatformStoreID sets the "platform_store_id" field.
+func (dsu *DeliveryStoreUpdate) SetPlatformStoreID(s string) *DeliveryStoreUpdate {
+	dsu.mutation.SetPlatformStoreID(s)
+	return dsu
+}
+
+// SetNillablePlatformStoreID sets the "platform_store_id" field if the given value is not nil.
+func (dsu *DeliveryStoreUpdate) SetNillablePlatformStoreID(s *string) *DeliveryStoreUpdate {
+	if s != nil {
+		dsu.SetPlatformStoreID(*s)
+	}
+	return dsu
+}
+
+// SetEnabled sets the "enabled" field.
+func (dsu *DeliveryStoreUpdate) SetEnabled(b bool) *DeliveryStoreUpdate {
+	dsu.mutation.SetEnabled(b)
+	return dsu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (dsu *DeliveryStoreUpdate) SetNillableEnabled(b *bool) *DeliveryStoreUpdate {
+	if b != nil {
+		dsu.SetEnabled(*b)
+	}
+	return dsu
+}
+
+// SetAutoAccept sets the "auto_accept" field.
+func (dsu *DeliveryStoreUpdate) SetAutoAccept(b bool) *DeliveryStoreUpdate {
+	dsu.mutation.SetAutoAccept(b)
+	return dsu
+}
+
+// SetNillableAutoAccept sets the "auto_accept" field if the given value is not nil.
+func (dsu *DeliveryStoreUpdate) SetNillableAutoAccept(b *bool) *DeliveryStoreUpdate {
+	if b != nil {
+		dsu.SetAutoAccept(*b)
+	}
+	return dsu
+}
+
+// SetAutoAcceptMaxAmount sets the "auto_accept_max_amount" field.
+func (dsu *DeliveryStoreUpdate) SetAutoAcceptMaxAmount(d decimal.Decimal) *DeliveryStoreUpdate {
+	dsu.mutation.SetAutoAcceptMaxAmount(d)
+	return dsu
+}
+
+// SetNillableAutoAcceptMaxAmount sets the "auto_accept_max_amount" field if the given value is not nil.
+func (dsu *DeliveryStoreUpdate) SetNillableAutoAcceptMaxAmount(d *decimal.Decimal) *DeliveryStoreUpdate {
+	if d != nil {
+		dsu.SetAutoAcceptMaxAmount(*d)
+	}
+	return dsu
+}
+
+// Mutation returns the DeliveryStoreMutation object of the builder.
+func (dsu *DeliveryStoreUpdate) Mutation() *DeliveryStoreMutation {
+	return dsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dsu *DeliveryStoreUpdate) Save(ctx context.Context) (int, error) {
+	dsu.defaults()
+	return withHooks(ctx, dsu.sqlSave, dsu.mutation, dsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsu *DeliveryStoreUpdate) SaveX(ctx context.Context) int {
+	affected, err := dsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dsu *DeliveryStoreUpdate) Exec(ctx context.Context) error {
+	_, err := dsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsu *DeliveryStoreUpdate) ExecX(ctx context.Context) {
+	if err := dsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsu *DeliveryStoreUpdate) defaults() {
+	if _, ok := dsu.mutation.UpdatedAt(); !ok {
+		v := deliverystore.UpdateDefaultUpdatedAt()
+		dsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsu *DeliveryStoreUpdate) check() error {
+	if v, ok := dsu.mutation.PlatformStoreID(); ok {
+		if err := deliverystore.PlatformStoreIDValidator(v); err != nil {
+			return &ValidationError{Name: "platform_store_id", err: fmt.Errorf(`ent: validator failed for field "DeliveryStore.platform_store_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dsu *DeliveryStoreUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeliveryStoreUpdate {
+	dsu.modifiers = append(dsu.modifiers, modifiers...)
+	return dsu
+}
+
+func (dsu *DeliveryStoreUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deliverystore.Table, deliverystore.Columns, sqlgraph.NewFieldSpec(deliverystore.FieldID, field.TypeUUID))
+	if ps := dsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(deliverystore.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dsu.mutation.PlatformStoreID(); ok {
+		_spec.SetField(deliverystore.FieldPlatformStoreID, field.TypeString, value)
+	}
+	if value, ok := dsu.mutation.Enabled(); ok {
+		_spec.SetField(deliverystore.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := dsu.mutation.AutoAccept(); ok {
+		_spec.SetField(deliverystore.FieldAutoAccept, field.TypeBool, value)
+	}
+	if value, ok := dsu.mutation.AutoAcceptMaxAmount(); ok {
+		_spec.SetField(deliverystore.FieldAutoAcceptMaxAmount, field.TypeOther, value)
+	}
+	_spec.AddModifiers(dsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, dsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deliverystore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dsu.mutation.done = true
+	return n, nil
+}
+
+// DeliveryStoreUpdateOne is the builder for updating a single DeliveryStore entity.
+type DeliveryStoreUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DeliveryStoreMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsuo *DeliveryStoreUpdateOne) SetUpdatedAt(t time.Time) *DeliveryStoreUpdateOne {
+	dsuo.mutation.SetUpdatedAt(t)
+	return dsuo
+}
+
+// SetPlatformStoreID sets the "platform_store_id" field.
+func (dsuo *DeliveryStoreUpdateOne) SetPlatformStoreID(s string) *DeliveryStoreUpdateOne {
+	dsuo.mutation.SetPlatformStoreID(s)
+	return dsuo
+}
+
+// SetNillablePlatformStoreID sets the "platform_store_id" field if the given value is not nil.
+func (dsuo *DeliveryStoreUpdateOne) SetNillablePlatformStoreID(s *string) *DeliveryStoreUpdateOne {
+	if s != nil {
+		dsuo.SetPlatformStoreID(*s)
+	}
+	return dsuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (dsuo *DeliveryStoreUpdateOne) SetEnabled(b bool) *DeliveryStoreUpdateOne {
+	dsuo.mutation.SetEnabled(b)
+	return dsuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (dsuo *DeliveryStoreUpdateOne) SetNillableEnabled(b *bool) *DeliveryStoreUpdateOne {
+	if b != nil {
+		dsuo.SetEnabled(*b)
+	}
+	return dsuo
+}
+
+// SetAutoAccept sets the "auto_accept" field.
+func (dsuo *DeliveryStoreUpdateOne) SetAutoAccept(b bool) *DeliveryStoreUpdateOne {
+	dsuo.mutation.SetAutoAccept(b)
+	return dsuo
+}
+
+// SetNillableAutoAccept sets the "auto_accept" field if the given value is not nil.
+func (dsuo *DeliveryStoreUpdateOne) SetNillableAutoAccept(b *bool) *DeliveryStoreUpdateOne {
+	if b != nil {
+		dsuo.SetAutoAccept(*b)
+	}
+	return dsuo
+}
+
+// SetAutoAcceptMaxAmount sets the "auto_accept_max_amount" field.
+func (dsuo *DeliveryStoreUpdateOne) SetAutoAcceptMaxAmount(d decimal.Decimal) *DeliveryStoreUpdateOne {
+	dsuo.mutation.SetAutoAcceptMaxAmount(d)
+	return dsuo
+}
+
+// SetNillableAutoAcceptMaxAmount sets the "auto_accept_max_amount" field if the given value is not nil.
+func (dsuo *DeliveryStoreUpdateOne) SetNillableAutoAcceptMaxAmount(d *decimal.Decimal) *DeliveryStoreUpdateOne {
+	if d != nil {
+		dsuo.SetAutoAcceptMaxAmount(*d)
+	}
+	return dsuo
+}
+
+// Mutation returns the DeliveryStoreMutation object of the builder.
+func (dsuo *DeliveryStoreUpdateOne) Mutation() *DeliveryStoreMutation {
+	return dsuo.mutation
+}
+
+// Where appends a list predicates to the DeliveryStoreUpdate builder.
+func (dsuo *DeliveryStoreUpdateOne) Where(ps ...predicate.DeliveryStore) *DeliveryStoreUpdateOne {
+	dsuo.mutation.Where(ps...)
+	return dsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dsuo *DeliveryStoreUpdateOne) Select(field string, fields ...string) *DeliveryStoreUpdateOne {
+	dsuo.fields = append([]string{field}, fields...)
+	return dsuo
+}
+
+// Save executes the query and returns the updated DeliveryStore entity.
+func (dsuo *DeliveryStoreUpdateOne) Save(ctx context.Context) (*DeliveryStore, error) {
+	dsuo.defaults()
+	return withHooks(ctx, dsuo.sqlSave, dsuo.mutation, dsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsuo *DeliveryStoreUpdateOne) SaveX(ctx context.Context) *DeliveryStore {
+	node, err := dsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dsuo *DeliveryStoreUpdateOne) Exec(ctx context.Context) error {
+	_, err := dsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsuo *DeliveryStoreUpdateOne) ExecX(ctx context.Context) {
+	if err := dsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsuo *DeliveryStoreUpdateOne) defaults() {
+	if _, ok := dsuo.mutation.UpdatedAt(); !ok {
+		v := deliverystore.UpdateDefaultUpdatedAt()
+		dsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsuo *DeliveryStoreUpdateOne) check() error {
+	if v, ok := dsuo.mutation.PlatformStoreID(); ok {
+		if err := deliverystore.PlatformStoreIDValidator(v); err != nil {
+			return &ValidationError{Name: "platform_store_id", err: fmt.Errorf(`ent: validator failed for field "DeliveryStore.platform_store_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dsuo *DeliveryStoreUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeliveryStoreUpdateOne {
+	dsuo.modifiers = append(dsuo.modifiers, modifiers...)
+	return dsuo
+}
+
+func (dsuo *DeliveryStoreUpdateOne) sqlSave(ctx context.Context) (_node *DeliveryStore, err error) {
+	if err := dsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deliverystore.Table, deliverystore.Columns, sqlgraph.NewFieldSpec(deliverystore.FieldID, field.TypeUUID))
+	id, ok := dsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeliveryStore.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deliverystore.FieldID)
+		for _, f := range fields {
+			if !deliverystore.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deliverystore.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(deliverystore.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dsuo.mutation.PlatformStoreID(); ok {
+		_spec.SetField(deliverystore.FieldPlatformStoreID, field.TypeString, value)
+	}
+	if value, ok := dsuo.mutation.Enabled(); ok {
+		_spec.SetField(deliverystore.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := dsuo.mutation.AutoAccept(); ok {
+		_spec.SetField(deliverystore.FieldAutoAccept, field.TypeBool, value)
+	}
+	if value, ok := dsuo.mutation.AutoAcceptMaxAmount(); ok {
+		_spec.SetField(deliverystore.FieldAutoAcceptMaxAmount, field.TypeOther, value)
+	}
+	_spec.AddModifiers(dsuo.modifiers...)
+	_node = &DeliveryStore{config: dsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deliverystore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dsuo.mutation.done = true
+	return _node, nil
+}
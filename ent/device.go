@@ -36,7 +36,7 @@ type Device struct {
 	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
 	// 门店 ID
 	StoreID uuid.UUID `json:"store_id,omitempty"`
-	// 设备类型：收银机/打印机
+	// 设备类型：收银机/打印机/自助点餐机
 	DeviceType domain.DeviceType `json:"device_type,omitempty"`
 	// 设备编号/序列号
 	DeviceCode string `json:"device_code,omitempty"`
@@ -56,6 +56,8 @@ type Device struct {
 	SortOrder int `json:"sort_order,omitempty"`
 	// 打印纸张尺寸
 	PaperSize domain.PaperSize `json:"paper_size,omitempty"`
+	// 打印语言（为空时使用商品原始名称）
+	PrintLocale string `json:"print_locale,omitempty"`
 	// 设备连接类型
 	ConnectType domain.DeviceConnectType `json:"connect_type,omitempty"`
 	// 出品部门ID，可为空
@@ -70,6 +72,10 @@ type Device struct {
 	DeviceStallReceiptType domain.DeviceStallReceiptType `json:"device_stall_receipt_type,omitempty"`
 	// 是否开钱箱
 	OpenCashDrawer bool `json:"open_cash_drawer,omitempty"`
+	// 自助点餐机支持的支付方式，多选
+	KioskPayModes []domain.KioskPayMode `json:"kiosk_pay_modes,omitempty"`
+	// 自助点餐机访问令牌
+	KioskToken *string `json:"kiosk_token,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DeviceQuery when eager-loading is set.
 	Edges        DeviceEdges `json:"edges"`
@@ -127,13 +133,13 @@ func (*Device) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case device.FieldOrderChannels, device.FieldDiningWays:
+		case device.FieldOrderChannels, device.FieldDiningWays, device.FieldKioskPayModes:
 			values[i] = new([]byte)
 		case device.FieldEnabled, device.FieldOpenCashDrawer:
 			values[i] = new(sql.NullBool)
 		case device.FieldDeletedAt, device.FieldSortOrder:
 			values[i] = new(sql.NullInt64)
-		case device.FieldName, device.FieldDeviceType, device.FieldDeviceCode, device.FieldDeviceBrand, device.FieldDeviceModel, device.FieldLocation, device.FieldStatus, device.FieldIP, device.FieldPaperSize, device.FieldConnectType, device.FieldDeviceStallPrintType, device.FieldDeviceStallReceiptType:
+		case device.FieldName, device.FieldDeviceType, device.FieldDeviceCode, device.FieldDeviceBrand, device.FieldDeviceModel, device.FieldLocation, device.FieldStatus, device.FieldIP, device.FieldPaperSize, device.FieldPrintLocale, device.FieldConnectType, device.FieldDeviceStallPrintType, device.FieldDeviceStallReceiptType, device.FieldKioskToken:
 			values[i] = new(sql.NullString)
 		case device.FieldCreatedAt, device.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -256,6 +262,12 @@ func (d *Device) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.PaperSize = domain.PaperSize(value.String)
 			}
+		case device.FieldPrintLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field print_locale", values[i])
+			} else if value.Valid {
+				d.PrintLocale = value.String
+			}
 		case device.FieldConnectType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field connect_type", values[i])
@@ -302,6 +314,21 @@ func (d *Device) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.OpenCashDrawer = value.Bool
 			}
+		case device.FieldKioskPayModes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field kiosk_pay_modes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.KioskPayModes); err != nil {
+					return fmt.Errorf("unmarshal field kiosk_pay_modes: %w", err)
+				}
+			}
+		case device.FieldKioskToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kiosk_token", values[i])
+			} else if value.Valid {
+				d.KioskToken = new(string)
+				*d.KioskToken = value.String
+			}
 		default:
 			d.selectValues.Set(columns[i], values[i])
 		}
@@ -401,6 +428,9 @@ func (d *Device) String() string {
 	builder.WriteString("paper_size=")
 	builder.WriteString(fmt.Sprintf("%v", d.PaperSize))
 	builder.WriteString(", ")
+	builder.WriteString("print_locale=")
+	builder.WriteString(d.PrintLocale)
+	builder.WriteString(", ")
 	builder.WriteString("connect_type=")
 	builder.WriteString(fmt.Sprintf("%v", d.ConnectType))
 	builder.WriteString(", ")
@@ -421,6 +451,14 @@ func (d *Device) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("open_cash_drawer=")
 	builder.WriteString(fmt.Sprintf("%v", d.OpenCashDrawer))
+	builder.WriteString(", ")
+	builder.WriteString("kiosk_pay_modes=")
+	builder.WriteString(fmt.Sprintf("%v", d.KioskPayModes))
+	builder.WriteString(", ")
+	if v := d.KioskToken; v != nil {
+		builder.WriteString("kiosk_token=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -50,6 +50,8 @@ const (
 	FieldSortOrder = "sort_order"
 	// FieldPaperSize holds the string denoting the paper_size field in the database.
 	FieldPaperSize = "paper_size"
+	// FieldPrintLocale holds the string denoting the print_locale field in the database.
+	FieldPrintLocale = "print_locale"
 	// FieldConnectType holds the string denoting the connect_type field in the database.
 	FieldConnectType = "connect_type"
 	// FieldStallID holds the string denoting the stall_id field in the database.
@@ -64,6 +66,10 @@ const (
 	FieldDeviceStallReceiptType = "device_stall_receipt_type"
 	// FieldOpenCashDrawer holds the string denoting the open_cash_drawer field in the database.
 	FieldOpenCashDrawer = "open_cash_drawer"
+	// FieldKioskPayModes holds the string denoting the kiosk_pay_modes field in the database.
+	FieldKioskPayModes = "kiosk_pay_modes"
+	// FieldKioskToken holds the string denoting the kiosk_token field in the database.
+	FieldKioskToken = "kiosk_token"
 	// EdgeMerchant holds the string denoting the merchant edge name in mutations.
 	EdgeMerchant = "merchant"
 	// EdgeStore holds the string denoting the store edge name in mutations.
@@ -114,6 +120,7 @@ var Columns = []string{
 	FieldIP,
 	FieldSortOrder,
 	FieldPaperSize,
+	FieldPrintLocale,
 	FieldConnectType,
 	FieldStallID,
 	FieldOrderChannels,
@@ -121,6 +128,8 @@ var Columns = []string{
 	FieldDeviceStallPrintType,
 	FieldDeviceStallReceiptType,
 	FieldOpenCashDrawer,
+	FieldKioskPayModes,
+	FieldKioskToken,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -163,6 +172,12 @@ var (
 	IPValidator func(string) error
 	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
 	DefaultSortOrder int
+	// DefaultPrintLocale holds the default value on creation for the "print_locale" field.
+	DefaultPrintLocale string
+	// PrintLocaleValidator is a validator for the "print_locale" field. It is called by the builders before save.
+	PrintLocaleValidator func(string) error
+	// KioskTokenValidator is a validator for the "kiosk_token" field. It is called by the builders before save.
+	KioskTokenValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -170,7 +185,7 @@ var (
 // DeviceTypeValidator is a validator for the "device_type" field enum values. It is called by the builders before save.
 func DeviceTypeValidator(dt domain.DeviceType) error {
 	switch dt {
-	case "cashier", "printer":
+	case "cashier", "printer", "kiosk":
 		return nil
 	default:
 		return fmt.Errorf("device: invalid enum value for device_type field: %q", dt)
@@ -327,6 +342,11 @@ func ByPaperSize(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPaperSize, opts...).ToFunc()
 }
 
+// ByPrintLocale orders the results by the print_locale field.
+func ByPrintLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrintLocale, opts...).ToFunc()
+}
+
 // ByConnectType orders the results by the connect_type field.
 func ByConnectType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldConnectType, opts...).ToFunc()
@@ -352,6 +372,11 @@ func ByOpenCashDrawer(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOpenCashDrawer, opts...).ToFunc()
 }
 
+// ByKioskToken orders the results by the kiosk_token field.
+func ByKioskToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKioskToken, opts...).ToFunc()
+}
+
 // ByMerchantField orders the results by merchant field.
 func ByMerchantField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -117,6 +117,11 @@ func SortOrder(v int) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldSortOrder, v))
 }
 
+// PrintLocale applies equality check predicate on the "print_locale" field. It's identical to PrintLocaleEQ.
+func PrintLocale(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldPrintLocale, v))
+}
+
 // StallID applies equality check predicate on the "stall_id" field. It's identical to StallIDEQ.
 func StallID(v uuid.UUID) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldStallID, v))
@@ -127,6 +132,11 @@ func OpenCashDrawer(v bool) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldOpenCashDrawer, v))
 }
 
+// KioskToken applies equality check predicate on the "kiosk_token" field. It's identical to KioskTokenEQ.
+func KioskToken(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldKioskToken, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
@@ -842,6 +852,71 @@ func PaperSizeNotNil() predicate.Device {
 	return predicate.Device(sql.FieldNotNull(FieldPaperSize))
 }
 
+// PrintLocaleEQ applies the EQ predicate on the "print_locale" field.
+func PrintLocaleEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldPrintLocale, v))
+}
+
+// PrintLocaleNEQ applies the NEQ predicate on the "print_locale" field.
+func PrintLocaleNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldPrintLocale, v))
+}
+
+// PrintLocaleIn applies the In predicate on the "print_locale" field.
+func PrintLocaleIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldPrintLocale, vs...))
+}
+
+// PrintLocaleNotIn applies the NotIn predicate on the "print_locale" field.
+func PrintLocaleNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldPrintLocale, vs...))
+}
+
+// PrintLocaleGT applies the GT predicate on the "print_locale" field.
+func PrintLocaleGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldPrintLocale, v))
+}
+
+// PrintLocaleGTE applies the GTE predicate on the "print_locale" field.
+func PrintLocaleGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldPrintLocale, v))
+}
+
+// PrintLocaleLT applies the LT predicate on the "print_locale" field.
+func PrintLocaleLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldPrintLocale, v))
+}
+
+// PrintLocaleLTE applies the LTE predicate on the "print_locale" field.
+func PrintLocaleLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldPrintLocale, v))
+}
+
+// PrintLocaleContains applies the Contains predicate on the "print_locale" field.
+func PrintLocaleContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldPrintLocale, v))
+}
+
+// PrintLocaleHasPrefix applies the HasPrefix predicate on the "print_locale" field.
+func PrintLocaleHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldPrintLocale, v))
+}
+
+// PrintLocaleHasSuffix applies the HasSuffix predicate on the "print_locale" field.
+func PrintLocaleHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldPrintLocale, v))
+}
+
+// PrintLocaleEqualFold applies the EqualFold predicate on the "print_locale" field.
+func PrintLocaleEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldPrintLocale, v))
+}
+
+// PrintLocaleContainsFold applies the ContainsFold predicate on the "print_locale" field.
+func PrintLocaleContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldPrintLocale, v))
+}
+
 // ConnectTypeEQ applies the EQ predicate on the "connect_type" field.
 func ConnectTypeEQ(v domain.DeviceConnectType) predicate.Device {
 	vc := v
@@ -1032,6 +1107,91 @@ func OpenCashDrawerNotNil() predicate.Device {
 	return predicate.Device(sql.FieldNotNull(FieldOpenCashDrawer))
 }
 
+// KioskPayModesIsNil applies the IsNil predicate on the "kiosk_pay_modes" field.
+func KioskPayModesIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldKioskPayModes))
+}
+
+// KioskPayModesNotNil applies the NotNil predicate on the "kiosk_pay_modes" field.
+func KioskPayModesNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldKioskPayModes))
+}
+
+// KioskTokenEQ applies the EQ predicate on the "kiosk_token" field.
+func KioskTokenEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldKioskToken, v))
+}
+
+// KioskTokenNEQ applies the NEQ predicate on the "kiosk_token" field.
+func KioskTokenNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldKioskToken, v))
+}
+
+// KioskTokenIn applies the In predicate on the "kiosk_token" field.
+func KioskTokenIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldKioskToken, vs...))
+}
+
+// KioskTokenNotIn applies the NotIn predicate on the "kiosk_token" field.
+func KioskTokenNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldKioskToken, vs...))
+}
+
+// KioskTokenGT applies the GT predicate on the "kiosk_token" field.
+func KioskTokenGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldKioskToken, v))
+}
+
+// KioskTokenGTE applies the GTE predicate on the "kiosk_token" field.
+func KioskTokenGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldKioskToken, v))
+}
+
+// KioskTokenLT applies the LT predicate on the "kiosk_token" field.
+func KioskTokenLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldKioskToken, v))
+}
+
+// KioskTokenLTE applies the LTE predicate on the "kiosk_token" field.
+func KioskTokenLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldKioskToken, v))
+}
+
+// KioskTokenContains applies the Contains predicate on the "kiosk_token" field.
+func KioskTokenContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldKioskToken, v))
+}
+
+// KioskTokenHasPrefix applies the HasPrefix predicate on the "kiosk_token" field.
+func KioskTokenHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldKioskToken, v))
+}
+
+// KioskTokenHasSuffix applies the HasSuffix predicate on the "kiosk_token" field.
+func KioskTokenHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldKioskToken, v))
+}
+
+// KioskTokenIsNil applies the IsNil predicate on the "kiosk_token" field.
+func KioskTokenIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldKioskToken))
+}
+
+// KioskTokenNotNil applies the NotNil predicate on the "kiosk_token" field.
+func KioskTokenNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldKioskToken))
+}
+
+// KioskTokenEqualFold applies the EqualFold predicate on the "kiosk_token" field.
+func KioskTokenEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldKioskToken, v))
+}
+
+// KioskTokenContainsFold applies the ContainsFold predicate on the "kiosk_token" field.
+func KioskTokenContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldKioskToken, v))
+}
+
 // HasMerchant applies the HasEdge predicate on the "merchant" edge.
 func HasMerchant() predicate.Device {
 	return predicate.Device(func(s *sql.Selector) {
@@ -212,6 +212,20 @@ func (dc *DeviceCreate) SetNillablePaperSize(ds *domain.PaperSize) *DeviceCreate
 	return dc
 }
 
+// SetPrintLocale sets the "print_locale" field.
+func (dc *DeviceCreate) SetPrintLocale(s string) *DeviceCreate {
+	dc.mutation.SetPrintLocale(s)
+	return dc
+}
+
+// SetNillablePrintLocale sets the "print_locale" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillablePrintLocale(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetPrintLocale(*s)
+	}
+	return dc
+}
+
 // SetConnectType sets the "connect_type" field.
 func (dc *DeviceCreate) SetConnectType(dct domain.DeviceConnectType) *DeviceCreate {
 	dc.mutation.SetConnectType(dct)
@@ -294,6 +308,26 @@ func (dc *DeviceCreate) SetNillableOpenCashDrawer(b *bool) *DeviceCreate {
 	return dc
 }
 
+// SetKioskPayModes sets the "kiosk_pay_modes" field.
+func (dc *DeviceCreate) SetKioskPayModes(dpm []domain.KioskPayMode) *DeviceCreate {
+	dc.mutation.SetKioskPayModes(dpm)
+	return dc
+}
+
+// SetKioskToken sets the "kiosk_token" field.
+func (dc *DeviceCreate) SetKioskToken(s string) *DeviceCreate {
+	dc.mutation.SetKioskToken(s)
+	return dc
+}
+
+// SetNillableKioskToken sets the "kiosk_token" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableKioskToken(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetKioskToken(*s)
+	}
+	return dc
+}
+
 // SetID sets the "id" field.
 func (dc *DeviceCreate) SetID(u uuid.UUID) *DeviceCreate {
 	dc.mutation.SetID(u)
@@ -398,6 +432,10 @@ func (dc *DeviceCreate) defaults() error {
 		v := device.DefaultSortOrder
 		dc.mutation.SetSortOrder(v)
 	}
+	if _, ok := dc.mutation.PrintLocale(); !ok {
+		v := device.DefaultPrintLocale
+		dc.mutation.SetPrintLocale(v)
+	}
 	if _, ok := dc.mutation.ID(); !ok {
 		if device.DefaultID == nil {
 			return fmt.Errorf("ent: uninitialized device.DefaultID (forgotten import ent/runtime?)")
@@ -475,6 +513,14 @@ func (dc *DeviceCreate) check() error {
 			return &ValidationError{Name: "paper_size", err: fmt.Errorf(`ent: validator failed for field "Device.paper_size": %w`, err)}
 		}
 	}
+	if _, ok := dc.mutation.PrintLocale(); !ok {
+		return &ValidationError{Name: "print_locale", err: errors.New(`ent: missing required field "Device.print_locale"`)}
+	}
+	if v, ok := dc.mutation.PrintLocale(); ok {
+		if err := device.PrintLocaleValidator(v); err != nil {
+			return &ValidationError{Name: "print_locale", err: fmt.Errorf(`ent: validator failed for field "Device.print_locale": %w`, err)}
+		}
+	}
 	if v, ok := dc.mutation.ConnectType(); ok {
 		if err := device.ConnectTypeValidator(v); err != nil {
 			return &ValidationError{Name: "connect_type", err: fmt.Errorf(`ent: validator failed for field "Device.connect_type": %w`, err)}
@@ -490,6 +536,11 @@ func (dc *DeviceCreate) check() error {
 			return &ValidationError{Name: "device_stall_receipt_type", err: fmt.Errorf(`ent: validator failed for field "Device.device_stall_receipt_type": %w`, err)}
 		}
 	}
+	if v, ok := dc.mutation.KioskToken(); ok {
+		if err := device.KioskTokenValidator(v); err != nil {
+			return &ValidationError{Name: "kiosk_token", err: fmt.Errorf(`ent: validator failed for field "Device.kiosk_token": %w`, err)}
+		}
+	}
 	if len(dc.mutation.MerchantIDs()) == 0 {
 		return &ValidationError{Name: "merchant", err: errors.New(`ent: missing required edge "Device.merchant"`)}
 	}
@@ -588,6 +639,10 @@ func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_spec.SetField(device.FieldPaperSize, field.TypeEnum, value)
 		_node.PaperSize = value
 	}
+	if value, ok := dc.mutation.PrintLocale(); ok {
+		_spec.SetField(device.FieldPrintLocale, field.TypeString, value)
+		_node.PrintLocale = value
+	}
 	if value, ok := dc.mutation.ConnectType(); ok {
 		_spec.SetField(device.FieldConnectType, field.TypeEnum, value)
 		_node.ConnectType = value
@@ -612,6 +667,14 @@ func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_spec.SetField(device.FieldOpenCashDrawer, field.TypeBool, value)
 		_node.OpenCashDrawer = value
 	}
+	if value, ok := dc.mutation.KioskPayModes(); ok {
+		_spec.SetField(device.FieldKioskPayModes, field.TypeJSON, value)
+		_node.KioskPayModes = value
+	}
+	if value, ok := dc.mutation.KioskToken(); ok {
+		_spec.SetField(device.FieldKioskToken, field.TypeString, value)
+		_node.KioskToken = &value
+	}
 	if nodes := dc.mutation.MerchantIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -919,6 +982,18 @@ func (u *DeviceUpsert) ClearPaperSize() *DeviceUpsert {
 	return u
 }
 
+// SetPrintLocale sets the "print_locale" field.
+func (u *DeviceUpsert) SetPrintLocale(v string) *DeviceUpsert {
+	u.Set(device.FieldPrintLocale, v)
+	return u
+}
+
+// UpdatePrintLocale sets the "print_locale" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdatePrintLocale() *DeviceUpsert {
+	u.SetExcluded(device.FieldPrintLocale)
+	return u
+}
+
 // SetConnectType sets the "connect_type" field.
 func (u *DeviceUpsert) SetConnectType(v domain.DeviceConnectType) *DeviceUpsert {
 	u.Set(device.FieldConnectType, v)
@@ -1045,6 +1120,42 @@ func (u *DeviceUpsert) ClearOpenCashDrawer() *DeviceUpsert {
 	return u
 }
 
+// SetKioskPayModes sets the "kiosk_pay_modes" field.
+func (u *DeviceUpsert) SetKioskPayModes(v []domain.KioskPayMode) *DeviceUpsert {
+	u.Set(device.FieldKioskPayModes, v)
+	return u
+}
+
+// UpdateKioskPayModes sets the "kiosk_pay_modes" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateKioskPayModes() *DeviceUpsert {
+	u.SetExcluded(device.FieldKioskPayModes)
+	return u
+}
+
+// ClearKioskPayModes clears the value of the "kiosk_pay_modes" field.
+func (u *DeviceUpsert) ClearKioskPayModes() *DeviceUpsert {
+	u.SetNull(device.FieldKioskPayModes)
+	return u
+}
+
+// SetKioskToken sets the "kiosk_token" field.
+func (u *DeviceUpsert) SetKioskToken(v string) *DeviceUpsert {
+	u.Set(device.FieldKioskToken, v)
+	return u
+}
+
+// UpdateKioskToken sets the "kiosk_token" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateKioskToken() *DeviceUpsert {
+	u.SetExcluded(device.FieldKioskToken)
+	return u
+}
+
+// ClearKioskToken clears the value of the "kiosk_token" field.
+func (u *DeviceUpsert) ClearKioskToken() *DeviceUpsert {
+	u.SetNull(device.FieldKioskToken)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1340,6 +1451,20 @@ func (u *DeviceUpsertOne) ClearPaperSize() *DeviceUpsertOne {
 	})
 }
 
+// SetPrintLocale sets the "print_locale" field.
+func (u *DeviceUpsertOne) SetPrintLocale(v string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetPrintLocale(v)
+	})
+}
+
+// UpdatePrintLocale sets the "print_locale" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdatePrintLocale() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdatePrintLocale()
+	})
+}
+
 // SetConnectType sets the "connect_type" field.
 func (u *DeviceUpsertOne) SetConnectType(v domain.DeviceConnectType) *DeviceUpsertOne {
 	return u.Update(func(s *DeviceUpsert) {
@@ -1487,6 +1612,48 @@ func (u *DeviceUpsertOne) ClearOpenCashDrawer() *DeviceUpsertOne {
 	})
 }
 
+// SetKioskPayModes sets the "kiosk_pay_modes" field.
+func (u *DeviceUpsertOne) SetKioskPayModes(v []domain.KioskPayMode) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetKioskPayModes(v)
+	})
+}
+
+// UpdateKioskPayModes sets the "kiosk_pay_modes" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateKioskPayModes() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateKioskPayModes()
+	})
+}
+
+// ClearKioskPayModes clears the value of the "kiosk_pay_modes" field.
+func (u *DeviceUpsertOne) ClearKioskPayModes() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearKioskPayModes()
+	})
+}
+
+// SetKioskToken sets the "kiosk_token" field.
+func (u *DeviceUpsertOne) SetKioskToken(v string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetKioskToken(v)
+	})
+}
+
+// UpdateKioskToken sets the "kiosk_token" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateKioskToken() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateKioskToken()
+	})
+}
+
+// ClearKioskToken clears the value of the "kiosk_token" field.
+func (u *DeviceUpsertOne) ClearKioskToken() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearKioskToken()
+	})
+}
+
 // Exec executes the query.
 func (u *DeviceUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1949,6 +2116,20 @@ func (u *DeviceUpsertBulk) ClearPaperSize() *DeviceUpsertBulk {
 	})
 }
 
+// SetPrintLocale sets the "print_locale" field.
+func (u *DeviceUpsertBulk) SetPrintLocale(v string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetPrintLocale(v)
+	})
+}
+
+// UpdatePrintLocale sets the "print_locale" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdatePrintLocale() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdatePrintLocale()
+	})
+}
+
 // SetConnectType sets the "connect_type" field.
 func (u *DeviceUpsertBulk) SetConnectType(v domain.DeviceConnectType) *DeviceUpsertBulk {
 	return u.Update(func(s *DeviceUpsert) {
@@ -2096,6 +2277,48 @@ func (u *DeviceUpsertBulk) ClearOpenCashDrawer() *DeviceUpsertBulk {
 	})
 }
 
+// SetKioskPayModes sets the "kiosk_pay_modes" field.
+func (u *DeviceUpsertBulk) SetKioskPayModes(v []domain.KioskPayMode) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetKioskPayModes(v)
+	})
+}
+
+// UpdateKioskPayModes sets the "kiosk_pay_modes" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateKioskPayModes() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateKioskPayModes()
+	})
+}
+
+// ClearKioskPayModes clears the value of the "kiosk_pay_modes" field.
+func (u *DeviceUpsertBulk) ClearKioskPayModes() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearKioskPayModes()
+	})
+}
+
+// SetKioskToken sets the "kiosk_token" field.
+func (u *DeviceUpsertBulk) SetKioskToken(v string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetKioskToken(v)
+	})
+}
+
+// UpdateKioskToken sets the "kiosk_token" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateKioskToken() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateKioskToken()
+	})
+}
+
+// ClearKioskToken clears the value of the "kiosk_token" field.
+func (u *DeviceUpsertBulk) ClearKioskToken() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearKioskToken()
+	})
+}
+
 // Exec executes the query.
 func (u *DeviceUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
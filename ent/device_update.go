@@ -257,6 +257,20 @@ func (du *DeviceUpdate) ClearPaperSize() *DeviceUpdate {
 	return du
 }
 
+// SetPrintLocale sets the "print_locale" field.
+func (du *DeviceUpdate) SetPrintLocale(s string) *DeviceUpdate {
+	du.mutation.SetPrintLocale(s)
+	return du
+}
+
+// SetNillablePrintLocale sets the "print_locale" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillablePrintLocale(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetPrintLocale(*s)
+	}
+	return du
+}
+
 // SetConnectType sets the "connect_type" field.
 func (du *DeviceUpdate) SetConnectType(dct domain.DeviceConnectType) *DeviceUpdate {
 	du.mutation.SetConnectType(dct)
@@ -393,6 +407,44 @@ func (du *DeviceUpdate) ClearOpenCashDrawer() *DeviceUpdate {
 	return du
 }
 
+// SetKioskPayModes sets the "kiosk_pay_modes" field.
+func (du *DeviceUpdate) SetKioskPayModes(dpm []domain.KioskPayMode) *DeviceUpdate {
+	du.mutation.SetKioskPayModes(dpm)
+	return du
+}
+
+// AppendKioskPayModes appends dpm to the "kiosk_pay_modes" field.
+func (du *DeviceUpdate) AppendKioskPayModes(dpm []domain.KioskPayMode) *DeviceUpdate {
+	du.mutation.AppendKioskPayModes(dpm)
+	return du
+}
+
+// ClearKioskPayModes clears the value of the "kiosk_pay_modes" field.
+func (du *DeviceUpdate) ClearKioskPayModes() *DeviceUpdate {
+	du.mutation.ClearKioskPayModes()
+	return du
+}
+
+// SetKioskToken sets the "kiosk_token" field.
+func (du *DeviceUpdate) SetKioskToken(s string) *DeviceUpdate {
+	du.mutation.SetKioskToken(s)
+	return du
+}
+
+// SetNillableKioskToken sets the "kiosk_token" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableKioskToken(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetKioskToken(*s)
+	}
+	return du
+}
+
+// ClearKioskToken clears the value of the "kiosk_token" field.
+func (du *DeviceUpdate) ClearKioskToken() *DeviceUpdate {
+	du.mutation.ClearKioskToken()
+	return du
+}
+
 // SetStall sets the "stall" edge to the Stall entity.
 func (du *DeviceUpdate) SetStall(s *Stall) *DeviceUpdate {
 	return du.SetStallID(s.ID)
@@ -488,6 +540,11 @@ func (du *DeviceUpdate) check() error {
 			return &ValidationError{Name: "paper_size", err: fmt.Errorf(`ent: validator failed for field "Device.paper_size": %w`, err)}
 		}
 	}
+	if v, ok := du.mutation.PrintLocale(); ok {
+		if err := device.PrintLocaleValidator(v); err != nil {
+			return &ValidationError{Name: "print_locale", err: fmt.Errorf(`ent: validator failed for field "Device.print_locale": %w`, err)}
+		}
+	}
 	if v, ok := du.mutation.ConnectType(); ok {
 		if err := device.ConnectTypeValidator(v); err != nil {
 			return &ValidationError{Name: "connect_type", err: fmt.Errorf(`ent: validator failed for field "Device.connect_type": %w`, err)}
@@ -503,6 +560,11 @@ func (du *DeviceUpdate) check() error {
 			return &ValidationError{Name: "device_stall_receipt_type", err: fmt.Errorf(`ent: validator failed for field "Device.device_stall_receipt_type": %w`, err)}
 		}
 	}
+	if v, ok := du.mutation.KioskToken(); ok {
+		if err := device.KioskTokenValidator(v); err != nil {
+			return &ValidationError{Name: "kiosk_token", err: fmt.Errorf(`ent: validator failed for field "Device.kiosk_token": %w`, err)}
+		}
+	}
 	if du.mutation.MerchantCleared() && len(du.mutation.MerchantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Device.merchant"`)
 	}
@@ -593,6 +655,9 @@ func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if du.mutation.PaperSizeCleared() {
 		_spec.ClearField(device.FieldPaperSize, field.TypeEnum)
 	}
+	if value, ok := du.mutation.PrintLocale(); ok {
+		_spec.SetField(device.FieldPrintLocale, field.TypeString, value)
+	}
 	if value, ok := du.mutation.ConnectType(); ok {
 		_spec.SetField(device.FieldConnectType, field.TypeEnum, value)
 	}
@@ -639,6 +704,23 @@ func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if du.mutation.OpenCashDrawerCleared() {
 		_spec.ClearField(device.FieldOpenCashDrawer, field.TypeBool)
 	}
+	if value, ok := du.mutation.KioskPayModes(); ok {
+		_spec.SetField(device.FieldKioskPayModes, field.TypeJSON, value)
+	}
+	if value, ok := du.mutation.AppendedKioskPayModes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, device.FieldKioskPayModes, value)
+		})
+	}
+	if du.mutation.KioskPayModesCleared() {
+		_spec.ClearField(device.FieldKioskPayModes, field.TypeJSON)
+	}
+	if value, ok := du.mutation.KioskToken(); ok {
+		_spec.SetField(device.FieldKioskToken, field.TypeString, value)
+	}
+	if du.mutation.KioskTokenCleared() {
+		_spec.ClearField(device.FieldKioskToken, field.TypeString)
+	}
 	if du.mutation.StallCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -914,6 +996,20 @@ func (duo *DeviceUpdateOne) ClearPaperSize() *DeviceUpdateOne {
 	return duo
 }
 
+// SetPrintLocale sets the "print_locale" field.
+func (duo *DeviceUpdateOne) SetPrintLocale(s string) *DeviceUpdateOne {
+	duo.mutation.SetPrintLocale(s)
+	return duo
+}
+
+// SetNillablePrintLocale sets the "print_locale" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillablePrintLocale(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetPrintLocale(*s)
+	}
+	return duo
+}
+
 // SetConnectType sets the "connect_type" field.
 func (duo *DeviceUpdateOne) SetConnectType(dct domain.DeviceConnectType) *DeviceUpdateOne {
 	duo.mutation.SetConnectType(dct)
@@ -1050,6 +1146,44 @@ func (duo *DeviceUpdateOne) ClearOpenCashDrawer() *DeviceUpdateOne {
 	return duo
 }
 
+// SetKioskPayModes sets the "kiosk_pay_modes" field.
+func (duo *DeviceUpdateOne) SetKioskPayModes(dpm []domain.KioskPayMode) *DeviceUpdateOne {
+	duo.mutation.SetKioskPayModes(dpm)
+	return duo
+}
+
+// AppendKioskPayModes appends dpm to the "kiosk_pay_modes" field.
+func (duo *DeviceUpdateOne) AppendKioskPayModes(dpm []domain.KioskPayMode) *DeviceUpdateOne {
+	duo.mutation.AppendKioskPayModes(dpm)
+	return duo
+}
+
+// ClearKioskPayModes clears the value of the "kiosk_pay_modes" field.
+func (duo *DeviceUpdateOne) ClearKioskPayModes() *DeviceUpdateOne {
+	duo.mutation.ClearKioskPayModes()
+	return duo
+}
+
+// SetKioskToken sets the "kiosk_token" field.
+func (duo *DeviceUpdateOne) SetKioskToken(s string) *DeviceUpdateOne {
+	duo.mutation.SetKioskToken(s)
+	return duo
+}
+
+// SetNillableKioskToken sets the "kiosk_token" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableKioskToken(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetKioskToken(*s)
+	}
+	return duo
+}
+
+// ClearKioskToken clears the value of the "kiosk_token" field.
+func (duo *DeviceUpdateOne) ClearKioskToken() *DeviceUpdateOne {
+	duo.mutation.ClearKioskToken()
+	return duo
+}
+
 // SetStall sets the "stall" edge to the Stall entity.
 func (duo *DeviceUpdateOne) SetStall(s *Stall) *DeviceUpdateOne {
 	return duo.SetStallID(s.ID)
@@ -1158,6 +1292,11 @@ func (duo *DeviceUpdateOne) check() error {
 			return &ValidationError{Name: "paper_size", err: fmt.Errorf(`ent: validator failed for field "Device.paper_size": %w`, err)}
 		}
 	}
+	if v, ok := duo.mutation.PrintLocale(); ok {
+		if err := device.PrintLocaleValidator(v); err != nil {
+			return &ValidationError{Name: "print_locale", err: fmt.Errorf(`ent: validator failed for field "Device.print_locale": %w`, err)}
+		}
+	}
 	if v, ok := duo.mutation.ConnectType(); ok {
 		if err := device.ConnectTypeValidator(v); err != nil {
 			return &ValidationError{Name: "connect_type", err: fmt.Errorf(`ent: validator failed for field "Device.connect_type": %w`, err)}
@@ -1173,6 +1312,11 @@ func (duo *DeviceUpdateOne) check() error {
 			return &ValidationError{Name: "device_stall_receipt_type", err: fmt.Errorf(`ent: validator failed for field "Device.device_stall_receipt_type": %w`, err)}
 		}
 	}
+	if v, ok := duo.mutation.KioskToken(); ok {
+		if err := device.KioskTokenValidator(v); err != nil {
+			return &ValidationError{Name: "kiosk_token", err: fmt.Errorf(`ent: validator failed for field "Device.kiosk_token": %w`, err)}
+		}
+	}
 	if duo.mutation.MerchantCleared() && len(duo.mutation.MerchantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Device.merchant"`)
 	}
@@ -1280,6 +1424,9 @@ func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err err
 	if duo.mutation.PaperSizeCleared() {
 		_spec.ClearField(device.FieldPaperSize, field.TypeEnum)
 	}
+	if value, ok := duo.mutation.PrintLocale(); ok {
+		_spec.SetField(device.FieldPrintLocale, field.TypeString, value)
+	}
 	if value, ok := duo.mutation.ConnectType(); ok {
 		_spec.SetField(device.FieldConnectType, field.TypeEnum, value)
 	}
@@ -1326,6 +1473,23 @@ func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err err
 	if duo.mutation.OpenCashDrawerCleared() {
 		_spec.ClearField(device.FieldOpenCashDrawer, field.TypeBool)
 	}
+	if value, ok := duo.mutation.KioskPayModes(); ok {
+		_spec.SetField(device.FieldKioskPayModes, field.TypeJSON, value)
+	}
+	if value, ok := duo.mutation.AppendedKioskPayModes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, device.FieldKioskPayModes, value)
+		})
+	}
+	if duo.mutation.KioskPayModesCleared() {
+		_spec.ClearField(device.FieldKioskPayModes, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.KioskToken(); ok {
+		_spec.SetField(device.FieldKioskToken, field.TypeString, value)
+	}
+	if duo.mutation.KioskTokenCleared() {
+		_spec.ClearField(device.FieldKioskToken, field.TypeString)
+	}
 	if duo.mutation.StallCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/diningtable"
+)
+
+// DiningTable is the model entity for the DiningTable schema.
+type DiningTable struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 桌台名称
+	Name string `json:"name,omitempty"`
+	// 座位数
+	Seats int `json:"seats,omitempty"`
+	// 二维码令牌
+	QrToken string `json:"qr_token,omitempty"`
+	// 是否启用
+	Enabled bool `json:"enabled,omitempty"`
+	// 排序，值越小越靠前
+	SortOrder    int `json:"sort_order,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DiningTable) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case diningtable.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case diningtable.FieldDeletedAt, diningtable.FieldSeats, diningtable.FieldSortOrder:
+			values[i] = new(sql.NullInt64)
+		case diningtable.FieldName, diningtable.FieldQrToken:
+			values[i] = new(sql.NullString)
+		case diningtable.FieldCreatedAt, diningtable.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case diningtable.FieldID, diningtable.FieldMerchantID, diningtable.FieldStoreID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DiningTable fields.
+func (dt *DiningTable) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case diningtable.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				dt.ID = *value
+			}
+		case diningtable.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dt.CreatedAt = value.Time
+			}
+		case diningtable.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dt.UpdatedAt = value.Time
+			}
+		case diningtable.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				dt.DeletedAt = value.Int64
+			}
+		case diningtable.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				dt.MerchantID = *value
+			}
+		case diningtable.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				dt.StoreID = *value
+			}
+		case diningtable.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				dt.Name = value.String
+			}
+		case diningtable.FieldSeats:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seats", values[i])
+			} else if value.Valid {
+				dt.Seats = int(value.Int64)
+			}
+		case diningtable.FieldQrToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field qr_token", values[i])
+			} else if value.Valid {
+				dt.QrToken = value.String
+			}
+		case diningtable.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				dt.Enabled = value.Bool
+			}
+		case diningtable.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				dt.SortOrder = int(value.Int64)
+			}
+		default:
+			dt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DiningTable.
+// This includes values selected through modifiers, order, etc.
+func (dt *DiningTable) Value(name string) (ent.Value, error) {
+	return dt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DiningTable.
+// Note that you need to call DiningTable.Unwrap() before calling this method if this DiningTable
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dt *DiningTable) Update() *DiningTableUpdateOne {
+	return NewDiningTableClient(dt.config).UpdateOne(dt)
+}
+
+// Unwrap unwraps the DiningTable entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dt *DiningTable) Unwrap() *DiningTable {
+	_tx, ok := dt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DiningTable is not a transactional entity")
+	}
+	dt.config.driver = _tx.drv
+	return dt
+}
+
+// String implements the fmt.Stringer.
+func (dt *DiningTable) String() string {
+	var builder strings.Builder
+	builder.WriteString("DiningTable(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(dt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(dt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", dt.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", dt.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", dt.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(dt.Name)
+	builder.WriteString(", ")
+	builder.WriteString("seats=")
+	builder.WriteString(fmt.Sprintf("%v", dt.Seats))
+	builder.WriteString(", ")
+	builder.WriteString("qr_token=")
+	builder.WriteString(dt.QrToken)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", dt.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", dt.SortOrder))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DiningTables is a parsable slice of DiningTable.
+type DiningTables []*DiningTable
@@ -0,0 +1,541 @@
+// Code generated by ent, DO NOT EDIT.
+
+package diningtable
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldStoreID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldName, v))
+}
+
+// Seats applies equality check predicate on the "seats" field. It's identical to SeatsEQ.
+func Seats(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldSeats, v))
+}
+
+// QrToken applies equality check predicate on the "qr_token" field. It's identical to QrTokenEQ.
+func QrToken(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldQrToken, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldEnabled, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLTE(FieldStoreID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldContainsFold(FieldName, v))
+}
+
+// SeatsEQ applies the EQ predicate on the "seats" field.
+func SeatsEQ(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldSeats, v))
+}
+
+// SeatsNEQ applies the NEQ predicate on the "seats" field.
+func SeatsNEQ(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNEQ(FieldSeats, v))
+}
+
+// SeatsIn applies the In predicate on the "seats" field.
+func SeatsIn(vs ...int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldIn(FieldSeats, vs...))
+}
+
+// SeatsNotIn applies the NotIn predicate on the "seats" field.
+func SeatsNotIn(vs ...int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNotIn(FieldSeats, vs...))
+}
+
+// SeatsGT applies the GT predicate on the "seats" field.
+func SeatsGT(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGT(FieldSeats, v))
+}
+
+// SeatsGTE applies the GTE predicate on the "seats" field.
+func SeatsGTE(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGTE(FieldSeats, v))
+}
+
+// SeatsLT applies the LT predicate on the "seats" field.
+func SeatsLT(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLT(FieldSeats, v))
+}
+
+// SeatsLTE applies the LTE predicate on the "seats" field.
+func SeatsLTE(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLTE(FieldSeats, v))
+}
+
+// QrTokenEQ applies the EQ predicate on the "qr_token" field.
+func QrTokenEQ(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldQrToken, v))
+}
+
+// QrTokenNEQ applies the NEQ predicate on the "qr_token" field.
+func QrTokenNEQ(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNEQ(FieldQrToken, v))
+}
+
+// QrTokenIn applies the In predicate on the "qr_token" field.
+func QrTokenIn(vs ...string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldIn(FieldQrToken, vs...))
+}
+
+// QrTokenNotIn applies the NotIn predicate on the "qr_token" field.
+func QrTokenNotIn(vs ...string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNotIn(FieldQrToken, vs...))
+}
+
+// QrTokenGT applies the GT predicate on the "qr_token" field.
+func QrTokenGT(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGT(FieldQrToken, v))
+}
+
+// QrTokenGTE applies the GTE predicate on the "qr_token" field.
+func QrTokenGTE(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGTE(FieldQrToken, v))
+}
+
+// QrTokenLT applies the LT predicate on the "qr_token" field.
+func QrTokenLT(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLT(FieldQrToken, v))
+}
+
+// QrTokenLTE applies the LTE predicate on the "qr_token" field.
+func QrTokenLTE(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLTE(FieldQrToken, v))
+}
+
+// QrTokenContains applies the Contains predicate on the "qr_token" field.
+func QrTokenContains(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldContains(FieldQrToken, v))
+}
+
+// QrTokenHasPrefix applies the HasPrefix predicate on the "qr_token" field.
+func QrTokenHasPrefix(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldHasPrefix(FieldQrToken, v))
+}
+
+// QrTokenHasSuffix applies the HasSuffix predicate on the "qr_token" field.
+func QrTokenHasSuffix(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldHasSuffix(FieldQrToken, v))
+}
+
+// QrTokenEqualFold applies the EqualFold predicate on the "qr_token" field.
+func QrTokenEqualFold(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEqualFold(FieldQrToken, v))
+}
+
+// QrTokenContainsFold applies the ContainsFold predicate on the "qr_token" field.
+func QrTokenContainsFold(v string) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldContainsFold(FieldQrToken, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.DiningTable {
+	return predicate.DiningTable(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DiningTable) predicate.DiningTable {
+	return predicate.DiningTable(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DiningTable) predicate.DiningTable {
+	return predicate.DiningTable(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DiningTable) predicate.DiningTable {
+	return predicate.DiningTable(sql.NotPredicates(p))
+}
@@ -0,0 +1,1038 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/diningtable"
+)
+
+// DiningTableCreate is the builder for creating a DiningTable entity.
+type DiningTableCreate struct {
+	config
+	mutation *DiningTableMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dtc *DiningTableCreate) SetCreatedAt(t time.Time) *DiningTableCreate {
+	dtc.mutation.SetCreatedAt(t)
+	return dtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dtc *DiningTableCreate) SetNillableCreatedAt(t *time.Time) *DiningTableCreate {
+	if t != nil {
+		dtc.SetCreatedAt(*t)
+	}
+	return dtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dtc *DiningTableCreate) SetUpdatedAt(t time.Time) *DiningTableCreate {
+	dtc.mutation.SetUpdatedAt(t)
+	return dtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dtc *DiningTableCreate) SetNillableUpdatedAt(t *time.Time) *DiningTableCreate {
+	if t != nil {
+		dtc.SetUpdatedAt(*t)
+	}
+	return dtc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dtc *DiningTableCreate) SetDeletedAt(i int64) *DiningTableCreate {
+	dtc.mutation.SetDeletedAt(i)
+	return dtc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dtc *DiningTableCreate) SetNillableDeletedAt(i *int64) *DiningTableCreate {
+	if i != nil {
+		dtc.SetDeletedAt(*i)
+	}
+	return dtc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (dtc *DiningTableCreate) SetMerchantID(u uuid.UUID) *DiningTableCreate {
+	dtc.mutation.SetMerchantID(u)
+	return dtc
+}
+
+// SetStoreID sets the "store_id" field.
+func (dtc *DiningTableCreate) SetStoreID(u uuid.UUID) *DiningTableCreate {
+	dtc.mutation.SetStoreID(u)
+	return dtc
+}
+
+// SetName sets the "name" field.
+func (dtc *DiningTableCreate) SetName(s string) *DiningTableCreate {
+	dtc.mutation.SetName(s)
+	return dtc
+}
+
+// SetSeats sets the "seats" field.
+func (dtc *DiningTableCreate) SetSeats(i int) *DiningTableCreate {
+	dtc.mutation.SetSeats(i)
+	return dtc
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (dtc *DiningTableCreate) SetNillableSeats(i *int) *DiningTableCreate {
+	if i != nil {
+		dtc.SetSeats(*i)
+	}
+	return dtc
+}
+
+// SetQrToken sets the "qr_token" field.
+func (dtc *DiningTableCreate) SetQrToken(s string) *DiningTableCreate {
+	dtc.mutation.SetQrToken(s)
+	return dtc
+}
+
+// SetEnabled sets the "enabled" field.
+func (dtc *DiningTableCreate) SetEnabled(b bool) *DiningTableCreate {
+	dtc.mutation.SetEnabled(b)
+	return dtc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (dtc *DiningTableCreate) SetNillableEnabled(b *bool) *DiningTableCreate {
+	if b != nil {
+		dtc.SetEnabled(*b)
+	}
+	return dtc
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (dtc *DiningTableCreate) SetSortOrder(i int) *DiningTableCreate {
+	dtc.mutation.SetSortOrder(i)
+	return dtc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (dtc *DiningTableCreate) SetNillableSortOrder(i *int) *DiningTableCreate {
+	if i != nil {
+		dtc.SetSortOrder(*i)
+	}
+	return dtc
+}
+
+// SetID sets the "id" field.
+func (dtc *DiningTableCreate) SetID(u uuid.UUID) *DiningTableCreate {
+	dtc.mutation.SetID(u)
+	return dtc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dtc *DiningTableCreate) SetNillableID(u *uuid.UUID) *DiningTableCreate {
+	if u != nil {
+		dtc.SetID(*u)
+	}
+	return dtc
+}
+
+// Mutation returns the DiningTableMutation object of the builder.
+func (dtc *DiningTableCreate) Mutation() *DiningTableMutation {
+	return dtc.mutation
+}
+
+// Save creates the DiningTable in the database.
+func (dtc *DiningTableCreate) Save(ctx context.Context) (*DiningTable, error) {
+	if err := dtc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, dtc.sqlSave, dtc.mutation, dtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dtc *DiningTableCreate) SaveX(ctx context.Context) *DiningTable {
+	v, err := dtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dtc *DiningTableCreate) Exec(ctx context.Context) error {
+	_, err := dtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dtc *DiningTableCreate) ExecX(ctx context.Context) {
+	if err := dtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dtc *DiningTableCreate) defaults() error {
+	if _, ok := dtc.mutation.CreatedAt(); !ok {
+		if diningtable.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized diningtable.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := diningtable.DefaultCreatedAt()
+		dtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dtc.mutation.UpdatedAt(); !ok {
+		if diningtable.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized diningtable.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := diningtable.DefaultUpdatedAt()
+		dtc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dtc.mutation.DeletedAt(); !ok {
+		v := diningtable.DefaultDeletedAt
+		dtc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := dtc.mutation.Seats(); !ok {
+		v := diningtable.DefaultSeats
+		dtc.mutation.SetSeats(v)
+	}
+	if _, ok := dtc.mutation.Enabled(); !ok {
+		v := diningtable.DefaultEnabled
+		dtc.mutation.SetEnabled(v)
+	}
+	if _, ok := dtc.mutation.SortOrder(); !ok {
+		v := diningtable.DefaultSortOrder
+		dtc.mutation.SetSortOrder(v)
+	}
+	if _, ok := dtc.mutation.ID(); !ok {
+		if diningtable.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized diningtable.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := diningtable.DefaultID()
+		dtc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dtc *DiningTableCreate) check() error {
+	if _, ok := dtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DiningTable.created_at"`)}
+	}
+	if _, ok := dtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DiningTable.updated_at"`)}
+	}
+	if _, ok := dtc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "DiningTable.deleted_at"`)}
+	}
+	if _, ok := dtc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "DiningTable.merchant_id"`)}
+	}
+	if _, ok := dtc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "DiningTable.store_id"`)}
+	}
+	if _, ok := dtc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DiningTable.name"`)}
+	}
+	if v, ok := dtc.mutation.Name(); ok {
+		if err := diningtable.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DiningTable.name": %w`, err)}
+		}
+	}
+	if _, ok := dtc.mutation.Seats(); !ok {
+		return &ValidationError{Name: "seats", err: errors.New(`ent: missing required field "DiningTable.seats"`)}
+	}
+	if _, ok := dtc.mutation.QrToken(); !ok {
+		return &ValidationError{Name: "qr_token", err: errors.New(`ent: missing required field "DiningTable.qr_token"`)}
+	}
+	if v, ok := dtc.mutation.QrToken(); ok {
+		if err := diningtable.QrTokenValidator(v); err != nil {
+			return &ValidationError{Name: "qr_token", err: fmt.Errorf(`ent: validator failed for field "DiningTable.qr_token": %w`, err)}
+		}
+	}
+	if _, ok := dtc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "DiningTable.enabled"`)}
+	}
+	if _, ok := dtc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "DiningTable.sort_order"`)}
+	}
+	return nil
+}
+
+func (dtc *DiningTableCreate) sqlSave(ctx context.Context) (*DiningTable, error) {
+	if err := dtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dtc.mutation.id = &_node.ID
+	dtc.mutation.done = true
+	return _node, nil
+}
+
+func (dtc *DiningTableCreate) createSpec() (*DiningTable, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiningTable{config: dtc.config}
+		_spec = sqlgraph.NewCreateSpec(diningtable.Table, sqlgraph.NewFieldSpec(diningtable.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dtc.conflict
+	if id, ok := dtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dtc.mutation.CreatedAt(); ok {
+		_spec.SetField(diningtable.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(diningtable.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dtc.mutation.DeletedAt(); ok {
+		_spec.SetField(diningtable.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := dtc.mutation.MerchantID(); ok {
+		_spec.SetField(diningtable.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := dtc.mutation.StoreID(); ok {
+		_spec.SetField(diningtable.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := dtc.mutation.Name(); ok {
+		_spec.SetField(diningtable.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := dtc.mutation.Seats(); ok {
+		_spec.SetField(diningtable.FieldSeats, field.TypeInt, value)
+		_node.Seats = value
+	}
+	if value, ok := dtc.mutation.QrToken(); ok {
+		_spec.SetField(diningtable.FieldQrToken, field.TypeString, value)
+		_node.QrToken = value
+	}
+	if value, ok := dtc.mutation.Enabled(); ok {
+		_spec.SetField(diningtable.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := dtc.mutation.SortOrder(); ok {
+		_spec.SetField(diningtable.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiningTable.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiningTableUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dtc *DiningTableCreate) OnConflict(opts ...sql.ConflictOption) *DiningTableUpsertOne {
+	dtc.conflict = opts
+	return &DiningTableUpsertOne{
+		create: dtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiningTable.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dtc *DiningTableCreate) OnConflictColumns(columns ...string) *DiningTableUpsertOne {
+	dtc.conflict = append(dtc.conflict, sql.ConflictColumns(columns...))
+	return &DiningTableUpsertOne{
+		create: dtc,
+	}
+}
+
+type (
+	// DiningTableUpsertOne is the builder for "upsert"-ing
+	//  one DiningTable node.
+	DiningTableUpsertOne struct {
+		create *DiningTableCreate
+	}
+
+	// DiningTableUpsert is the "OnConflict" setter.
+	DiningTableUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DiningTableUpsert) SetUpdatedAt(v time.Time) *DiningTableUpsert {
+	u.Set(diningtable.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DiningTableUpsert) UpdateUpdatedAt() *DiningTableUpsert {
+	u.SetExcluded(diningtable.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DiningTableUpsert) SetDeletedAt(v int64) *DiningTableUpsert {
+	u.Set(diningtable.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DiningTableUpsert) UpdateDeletedAt() *DiningTableUpsert {
+	u.SetExcluded(diningtable.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *DiningTableUpsert) AddDeletedAt(v int64) *DiningTableUpsert {
+	u.Add(diningtable.FieldDeletedAt, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *DiningTableUpsert) SetName(v string) *DiningTableUpsert {
+	u.Set(diningtable.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DiningTableUpsert) UpdateName() *DiningTableUpsert {
+	u.SetExcluded(diningtable.FieldName)
+	return u
+}
+
+// SetSeats sets the "seats" field.
+func (u *DiningTableUpsert) SetSeats(v int) *DiningTableUpsert {
+	u.Set(diningtable.FieldSeats, v)
+	return u
+}
+
+// UpdateSeats sets the "seats" field to the value that was provided on create.
+func (u *DiningTableUpsert) UpdateSeats() *DiningTableUpsert {
+	u.SetExcluded(diningtable.FieldSeats)
+	return u
+}
+
+// AddSeats adds v to the "seats" field.
+func (u *DiningTableUpsert) AddSeats(v int) *DiningTableUpsert {
+	u.Add(diningtable.FieldSeats, v)
+	return u
+}
+
+// SetQrToken sets the "qr_token" field.
+func (u *DiningTableUpsert) SetQrToken(v string) *DiningTableUpsert {
+	u.Set(diningtable.FieldQrToken, v)
+	return u
+}
+
+// UpdateQrToken sets the "qr_token" field to the value that was provided on create.
+func (u *DiningTableUpsert) UpdateQrToken() *DiningTableUpsert {
+	u.SetExcluded(diningtable.FieldQrToken)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *DiningTableUpsert) SetEnabled(v bool) *DiningTableUpsert {
+	u.Set(diningtable.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *DiningTableUpsert) UpdateEnabled() *DiningTableUpsert {
+	u.SetExcluded(diningtable.FieldEnabled)
+	return u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *DiningTableUpsert) SetSortOrder(v int) *DiningTableUpsert {
+	u.Set(diningtable.FieldSortOrder, v)
+	return u
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *DiningTableUpsert) UpdateSortOrder() *DiningTableUpsert {
+	u.SetExcluded(diningtable.FieldSortOrder)
+	return u
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *DiningTableUpsert) AddSortOrder(v int) *DiningTableUpsert {
+	u.Add(diningtable.FieldSortOrder, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DiningTable.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(diningtable.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiningTableUpsertOne) UpdateNewValues() *DiningTableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(diningtable.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(diningtable.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(diningtable.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(diningtable.FieldStoreID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiningTable.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiningTableUpsertOne) Ignore() *DiningTableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiningTableUpsertOne) DoNothing() *DiningTableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiningTableCreate.OnConflict
+// documentation for more info.
+func (u *DiningTableUpsertOne) Update(set func(*DiningTableUpsert)) *DiningTableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiningTableUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DiningTableUpsertOne) SetUpdatedAt(v time.Time) *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DiningTableUpsertOne) UpdateUpdatedAt() *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DiningTableUpsertOne) SetDeletedAt(v int64) *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *DiningTableUpsertOne) AddDeletedAt(v int64) *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DiningTableUpsertOne) UpdateDeletedAt() *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *DiningTableUpsertOne) SetName(v string) *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DiningTableUpsertOne) UpdateName() *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSeats sets the "seats" field.
+func (u *DiningTableUpsertOne) SetSeats(v int) *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetSeats(v)
+	})
+}
+
+// AddSeats adds v to the "seats" field.
+func (u *DiningTableUpsertOne) AddSeats(v int) *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.AddSeats(v)
+	})
+}
+
+// UpdateSeats sets the "seats" field to the value that was provided on create.
+func (u *DiningTableUpsertOne) UpdateSeats() *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateSeats()
+	})
+}
+
+// SetQrToken sets the "qr_token" field.
+func (u *DiningTableUpsertOne) SetQrToken(v string) *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetQrToken(v)
+	})
+}
+
+// UpdateQrToken sets the "qr_token" field to the value that was provided on create.
+func (u *DiningTableUpsertOne) UpdateQrToken() *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateQrToken()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *DiningTableUpsertOne) SetEnabled(v bool) *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *DiningTableUpsertOne) UpdateEnabled() *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *DiningTableUpsertOne) SetSortOrder(v int) *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *DiningTableUpsertOne) AddSortOrder(v int) *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *DiningTableUpsertOne) UpdateSortOrder() *DiningTableUpsertOne {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// Exec executes the query.
+func (u *DiningTableUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiningTableCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiningTableUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiningTableUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DiningTableUpsertOne.ID is not supported by MySQL driver. Use DiningTableUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiningTableUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiningTableCreateBulk is the builder for creating many DiningTable entities in bulk.
+type DiningTableCreateBulk struct {
+	config
+	err      error
+	builders []*DiningTableCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DiningTable entities in the database.
+func (dtcb *DiningTableCreateBulk) Save(ctx context.Context) ([]*DiningTable, error) {
+	if dtcb.err != nil {
+		return nil, dtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dtcb.builders))
+	nodes := make([]*DiningTable, len(dtcb.builders))
+	mutators := make([]Mutator, len(dtcb.builders))
+	for i := range dtcb.builders {
+		func(i int, root context.Context) {
+			builder := dtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiningTableMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dtcb *DiningTableCreateBulk) SaveX(ctx context.Context) []*DiningTable {
+	v, err := dtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dtcb *DiningTableCreateBulk) Exec(ctx context.Context) error {
+	_, err := dtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dtcb *DiningTableCreateBulk) ExecX(ctx context.Context) {
+	if err := dtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiningTable.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiningTableUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dtcb *DiningTableCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiningTableUpsertBulk {
+	dtcb.conflict = opts
+	return &DiningTableUpsertBulk{
+		create: dtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiningTable.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dtcb *DiningTableCreateBulk) OnConflictColumns(columns ...string) *DiningTableUpsertBulk {
+	dtcb.conflict = append(dtcb.conflict, sql.ConflictColumns(columns...))
+	return &DiningTableUpsertBulk{
+		create: dtcb,
+	}
+}
+
+// DiningTableUpsertBulk is the builder for "upsert"-ing
+// a bulk of DiningTable nodes.
+type DiningTableUpsertBulk struct {
+	create *DiningTableCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DiningTable.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(diningtable.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiningTableUpsertBulk) UpdateNewValues() *DiningTableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(diningtable.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(diningtable.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(diningtable.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(diningtable.FieldStoreID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiningTable.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiningTableUpsertBulk) Ignore() *DiningTableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiningTableUpsertBulk) DoNothing() *DiningTableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiningTableCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiningTableUpsertBulk) Update(set func(*DiningTableUpsert)) *DiningTableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiningTableUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DiningTableUpsertBulk) SetUpdatedAt(v time.Time) *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DiningTableUpsertBulk) UpdateUpdatedAt() *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DiningTableUpsertBulk) SetDeletedAt(v int64) *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *DiningTableUpsertBulk) AddDeletedAt(v int64) *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DiningTableUpsertBulk) UpdateDeletedAt() *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *DiningTableUpsertBulk) SetName(v string) *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DiningTableUpsertBulk) UpdateName() *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSeats sets the "seats" field.
+func (u *DiningTableUpsertBulk) SetSeats(v int) *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetSeats(v)
+	})
+}
+
+// AddSeats adds v to the "seats" field.
+func (u *DiningTableUpsertBulk) AddSeats(v int) *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.AddSeats(v)
+	})
+}
+
+// UpdateSeats sets the "seats" field to the value that was provided on create.
+func (u *DiningTableUpsertBulk) UpdateSeats() *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateSeats()
+	})
+}
+
+// SetQrToken sets the "qr_token" field.
+func (u *DiningTableUpsertBulk) SetQrToken(v string) *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetQrToken(v)
+	})
+}
+
+// UpdateQrToken sets the "qr_token" field to the value that was provided on create.
+func (u *DiningTableUpsertBulk) UpdateQrToken() *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateQrToken()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *DiningTableUpsertBulk) SetEnabled(v bool) *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *DiningTableUpsertBulk) UpdateEnabled() *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *DiningTableUpsertBulk) SetSortOrder(v int) *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *DiningTableUpsertBulk) AddSortOrder(v int) *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *DiningTableUpsertBulk) UpdateSortOrder() *DiningTableUpsertBulk {
+	return u.Update(func(s *DiningTableUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// Exec executes the query.
+func (u *DiningTableUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiningTableCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiningTableCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiningTableUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
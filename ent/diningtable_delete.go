@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/diningtable"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DiningTableDelete is the builder for deleting a DiningTable entity.
+type DiningTableDelete struct {
+	config
+	hooks    []Hook
+	mutation *DiningTableMutation
+}
+
+// Where appends a list predicates to the DiningTableDelete builder.
+func (dtd *DiningTableDelete) Where(ps ...predicate.DiningTable) *DiningTableDelete {
+	dtd.mutation.Where(ps...)
+	return dtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dtd *DiningTableDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dtd.sqlExec, dtd.mutation, dtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dtd *DiningTableDelete) ExecX(ctx context.Context) int {
+	n, err := dtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dtd *DiningTableDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(diningtable.Table, sqlgraph.NewFieldSpec(diningtable.FieldID, field.TypeUUID))
+	if ps := dtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dtd.mutation.done = true
+	return affected, err
+}
+
+// DiningTableDeleteOne is the builder for deleting a single DiningTable entity.
+type DiningTableDeleteOne struct {
+	dtd *DiningTableDelete
+}
+
+// Where appends a list predicates to the DiningTableDelete builder.
+func (dtdo *DiningTableDeleteOne) Where(ps ...predicate.DiningTable) *DiningTableDeleteOne {
+	dtdo.dtd.mutation.Where(ps...)
+	return dtdo
+}
+
+// Exec executes the deletion query.
+func (dtdo *DiningTableDeleteOne) Exec(ctx context.Context) error {
+	n, err := dtdo.dtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{diningtable.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dtdo *DiningTableDeleteOne) ExecX(ctx context.Context) {
+	if err := dtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/diningtable"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DiningTableQuery is the builder for querying DiningTable entities.
+type DiningTableQuery struct {
+	config
+	ctx        *QueryContext
+	order      []diningtable.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DiningTable
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DiningTableQuery builder.
+func (dtq *DiningTableQuery) Where(ps ...predicate.DiningTable) *DiningTableQuery {
+	dtq.predicates = append(dtq.predicates, ps...)
+	return dtq
+}
+
+// Limit the number of records to be returned by this query.
+func (dtq *DiningTableQuery) Limit(limit int) *DiningTableQuery {
+	dtq.ctx.Limit = &limit
+	return dtq
+}
+
+// Offset to start from.
+func (dtq *DiningTableQuery) Offset(offset int) *DiningTableQuery {
+	dtq.ctx.Offset = &offset
+	return dtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dtq *DiningTableQuery) Unique(unique bool) *DiningTableQuery {
+	dtq.ctx.Unique = &unique
+	return dtq
+}
+
+// Order specifies how the records should be ordered.
+func (dtq *DiningTableQuery) Order(o ...diningtable.OrderOption) *DiningTableQuery {
+	dtq.order = append(dtq.order, o...)
+	return dtq
+}
+
+// First returns the first DiningTable entity from the query.
+// Returns a *NotFoundError when no DiningTable was found.
+func (dtq *DiningTableQuery) First(ctx context.Context) (*DiningTable, error) {
+	nodes, err := dtq.Limit(1).All(setContextOp(ctx, dtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{diningtable.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dtq *DiningTableQuery) FirstX(ctx context.Context) *DiningTable {
+	node, err := dtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DiningTable ID from the query.
+// Returns a *NotFoundError when no DiningTable ID was found.
+func (dtq *DiningTableQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dtq.Limit(1).IDs(setContextOp(ctx, dtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{diningtable.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dtq *DiningTableQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := dtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DiningTable entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DiningTable entity is found.
+// Returns a *NotFoundError when no DiningTable entities are found.
+func (dtq *DiningTableQuery) Only(ctx context.Context) (*DiningTable, error) {
+	nodes, err := dtq.Limit(2).All(setContextOp(ctx, dtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{diningtable.Label}
+	default:
+		return nil, &NotSingularError{diningtable.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dtq *DiningTableQuery) OnlyX(ctx context.Context) *DiningTable {
+	node, err := dtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DiningTable ID in the query.
+// Returns a *NotSingularError when more than one DiningTable ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dtq *DiningTableQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dtq.Limit(2).IDs(setContextOp(ctx, dtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{diningtable.Label}
+	default:
+		err = &NotSingularError{diningtable.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dtq *DiningTableQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := dtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DiningTables.
+func (dtq *DiningTableQuery) All(ctx context.Context) ([]*DiningTable, error) {
+	ctx = setContextOp(ctx, dtq.ctx, ent.OpQueryAll)
+	if err := dtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DiningTable, *DiningTableQuery]()
+	return withInterceptors[[]*DiningTable](ctx, dtq, qr, dtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dtq *DiningTableQuery) AllX(ctx context.Context) []*DiningTable {
+	nodes, err := dtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DiningTable IDs.
+func (dtq *DiningTableQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if dtq.ctx.Unique == nil && dtq.path != nil {
+		dtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dtq.ctx, ent.OpQueryIDs)
+	if err = dtq.Select(diningtable.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dtq *DiningTableQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dtq *DiningTableQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dtq.ctx, ent.OpQueryCount)
+	if err := dtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dtq, querierCount[*DiningTableQuery](), dtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dtq *DiningTableQuery) CountX(ctx context.Context) int {
+	count, err := dtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dtq *DiningTableQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dtq.ctx, ent.OpQueryExist)
+	switch _, err := dtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dtq *DiningTableQuery) ExistX(ctx context.Context) bool {
+	exist, err := dtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DiningTableQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dtq *DiningTableQuery) Clone() *DiningTableQuery {
+	if dtq == nil {
+		return nil
+	}
+	return &DiningTableQuery{
+		config:     dtq.config,
+		ctx:        dtq.ctx.Clone(),
+		order:      append([]diningtable.OrderOption{}, dtq.order...),
+		inters:     append([]Interceptor{}, dtq.inters...),
+		predicates: append([]predicate.DiningTable{}, dtq.predicates...),
+		// clone intermediate query.
+		sql:       dtq.sql.Clone(),
+		path:      dtq.path,
+		modifiers: append([]func(*sql.Selector){}, dtq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DiningTable.Query().
+//		GroupBy(diningtable.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dtq *DiningTableQuery) GroupBy(field string, fields ...string) *DiningTableGroupBy {
+	dtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DiningTableGroupBy{build: dtq}
+	grbuild.flds = &dtq.ctx.Fields
+	grbuild.label = diningtable.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DiningTable.Query().
+//		Select(diningtable.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (dtq *DiningTableQuery) Select(fields ...string) *DiningTableSelect {
+	dtq.ctx.Fields = append(dtq.ctx.Fields, fields...)
+	sbuild := &DiningTableSelect{DiningTableQuery: dtq}
+	sbuild.label = diningtable.Label
+	sbuild.flds, sbuild.scan = &dtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DiningTableSelect configured with the given aggregations.
+func (dtq *DiningTableQuery) Aggregate(fns ...AggregateFunc) *DiningTableSelect {
+	return dtq.Select().Aggregate(fns...)
+}
+
+func (dtq *DiningTableQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dtq.ctx.Fields {
+		if !diningtable.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dtq.path != nil {
+		prev, err := dtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dtq.sql = prev
+	}
+	return nil
+}
+
+func (dtq *DiningTableQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DiningTable, error) {
+	var (
+		nodes = []*DiningTable{}
+		_spec = dtq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DiningTable).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DiningTable{config: dtq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(dtq.modifiers) > 0 {
+		_spec.Modifiers = dtq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (dtq *DiningTableQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dtq.querySpec()
+	if len(dtq.modifiers) > 0 {
+		_spec.Modifiers = dtq.modifiers
+	}
+	_spec.Node.Columns = dtq.ctx.Fields
+	if len(dtq.ctx.Fields) > 0 {
+		_spec.Unique = dtq.ctx.Unique != nil && *dtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dtq.driver, _spec)
+}
+
+func (dtq *DiningTableQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(diningtable.Table, diningtable.Columns, sqlgraph.NewFieldSpec(diningtable.FieldID, field.TypeUUID))
+	_spec.From = dtq.sql
+	if unique := dtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, diningtable.FieldID)
+		for i := range fields {
+			if fields[i] != diningtable.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dtq *DiningTableQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dtq.driver.Dialect())
+	t1 := builder.Table(diningtable.Table)
+	columns := dtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = diningtable.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dtq.sql != nil {
+		selector = dtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dtq.ctx.Unique != nil && *dtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range dtq.modifiers {
+		m(selector)
+	}
+	for _, p := range dtq.predicates {
+		p(selector)
+	}
+	for _, p := range dtq.order {
+		p(selector)
+	}
+	if offset := dtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (dtq *DiningTableQuery) ForUpdate(opts ...sql.LockOption) *DiningTableQuery {
+	if dtq.driver.Dialect() == dialect.Postgres {
+		dtq.Unique(false)
+	}
+	dtq.modifiers = append(dtq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return dtq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (dtq *DiningTableQuery) ForShare(opts ...sql.LockOption) *DiningTableQuery {
+	if dtq.driver.Dialect() == dialect.Postgres {
+		dtq.Unique(false)
+	}
+	dtq.modifiers = append(dtq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return dtq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dtq *DiningTableQuery) Modify(modifiers ...func(s *sql.Selector)) *DiningTableSelect {
+	dtq.modifiers = append(dtq.modifiers, modifiers...)
+	return dtq.Select()
+}
+
+// DiningTableGroupBy is the group-by builder for DiningTable entities.
+type DiningTableGroupBy struct {
+	selector
+	build *DiningTableQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dtgb *DiningTableGroupBy) Aggregate(fns ...AggregateFunc) *DiningTableGroupBy {
+	dtgb.fns = append(dtgb.fns, fns...)
+	return dtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dtgb *DiningTableGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DiningTableQuery, *DiningTableGroupBy](ctx, dtgb.build, dtgb, dtgb.build.inters, v)
+}
+
+func (dtgb *DiningTableGroupBy) sqlScan(ctx context.Context, root *DiningTableQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dtgb.fns))
+	for _, fn := range dtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dtgb.flds)+len(dtgb.fns))
+		for _, f := range *dtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DiningTableSelect is the builder for selecting fields of DiningTable entities.
+type DiningTableSelect struct {
+	*DiningTableQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dts *DiningTableSelect) Aggregate(fns ...AggregateFunc) *DiningTableSelect {
+	dts.fns = append(dts.fns, fns...)
+	return dts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dts *DiningTableSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dts.ctx, ent.OpQuerySelect)
+	if err := dts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DiningTableQuery, *DiningTableSelect](ctx, dts.DiningTableQuery, dts, dts.inters, v)
+}
+
+func (dts *DiningTableSelect) sqlScan(ctx context.Context, root *DiningTableQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dts.fns))
+	for _, fn := range dts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dts *DiningTableSelect) Modify(modifiers ...func(s *sql.Selector)) *DiningTableSelect {
+	dts.modifiers = append(dts.modifiers, modifiers...)
+	return dts
+}
@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/diningtable"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// DiningTableUpdate is the builder for updating DiningTable entities.
+type DiningTableUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DiningTableMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DiningTableUpdate builder.
+func (dtu *DiningTableUpdate) Where(ps ...predicate.DiningTable) *DiningTableUpdate {
+	dtu.mutation.Where(ps...)
+	return dtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dtu *DiningTableUpdate) SetUpdatedAt(t time.Time) *DiningTableUpdate {
+	dtu.mutation.SetUpdatedAt(t)
+	return dtu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dtu *DiningTableUpdate) SetDeletedAt(i int64) *DiningTableUpdate {
+	dtu.mutation.ResetDeletedAt()
+	dtu.mutation.SetDeletedAt(i)
+	return dtu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dtu *DiningTableUpdate) SetNillableDeletedAt(i *int64) *DiningTableUpdate {
+	if i != nil {
+		dtu.SetDeletedAt(*i)
+	}
+	return dtu
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (dtu *DiningTableUpdate) AddDeletedAt(i int64) *DiningTableUpdate {
+	dtu.mutation.AddDeletedAt(i)
+	return dtu
+}
+
+// SetName sets the "name" field.
+func (dtu *DiningTableUpdate) SetName(s string) *DiningTableUpdate {
+	dtu.mutation.SetName(s)
+	return dtu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dtu *DiningTableUpdate) SetNillableName(s *string) *DiningTableUpdate {
+	if s != nil {
+		dtu.SetName(*s)
+	}
+	return dtu
+}
+
+// SetSeats sets the "seats" field.
+func (dtu *DiningTableUpdate) SetSeats(i int) *DiningTableUpdate {
+	dtu.mutation.ResetSeats()
+	dtu.mutation.SetSeats(i)
+	return dtu
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (dtu *DiningTableUpdate) SetNillableSeats(i *int) *DiningTableUpdate {
+	if i != nil {
+		dtu.SetSeats(*i)
+	}
+	return dtu
+}
+
+// AddSeats adds i to the "seats" field.
+func (dtu *DiningTableUpdate) AddSeats(i int) *DiningTableUpdate {
+	dtu.mutation.AddSeats(i)
+	return dtu
+}
+
+// SetQrToken sets the "qr_token" field.
+func (dtu *DiningTableUpdate) SetQrToken(s string) *DiningTableUpdate {
+	dtu.mutation.SetQrToken(s)
+	return dtu
+}
+
+// SetNillableQrToken sets the "qr_token" field if the given value is not nil.
+func (dtu *DiningTableUpdate) SetNillableQrToken(s *string) *DiningTableUpdate {
+	if s != nil {
+		dtu.SetQrToken(*s)
+	}
+	return dtu
+}
+
+// SetEnabled sets the "enabled" field.
+func (dtu *DiningTableUpdate) SetEnabled(b bool) *DiningTableUpdate {
+	dtu.mutation.SetEnabled(b)
+	return dtu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (dtu *DiningTableUpdate) SetNillableEnabled(b *bool) *DiningTableUpdate {
+	if b != nil {
+		dtu.SetEnabled(*b)
+	}
+	return dtu
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (dtu *DiningTableUpdate) SetSortOrder(i int) *DiningTableUpdate {
+	dtu.mutation.ResetSortOrder()
+	dtu.mutation.SetSortOrder(i)
+	return dtu
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (dtu *DiningTableUpdate) SetNillableSortOrder(i *int) *DiningTableUpdate {
+	if i != nil {
+		dtu.SetSortOrder(*i)
+	}
+	return dtu
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (dtu *DiningTableUpdate) AddSortOrder(i int) *DiningTableUpdate {
+	dtu.mutation.AddSortOrder(i)
+	return dtu
+}
+
+// Mutation returns the DiningTableMutation object of the builder.
+func (dtu *DiningTableUpdate) Mutation() *DiningTableMutation {
+	return dtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dtu *DiningTableUpdate) Save(ctx context.Context) (int, error) {
+	if err := dtu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, dtu.sqlSave, dtu.mutation, dtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dtu *DiningTableUpdate) SaveX(ctx context.Context) int {
+	affected, err := dtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dtu *DiningTableUpdate) Exec(ctx context.Context) error {
+	_, err := dtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dtu *DiningTableUpdate) ExecX(ctx context.Context) {
+	if err := dtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dtu *DiningTableUpdate) defaults() error {
+	if _, ok := dtu.mutation.UpdatedAt(); !ok {
+		if diningtable.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized diningtable.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := diningtable.UpdateDefaultUpdatedAt()
+		dtu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dtu *DiningTableUpdate) check() error {
+	if v, ok := dtu.mutation.Name(); ok {
+		if err := diningtable.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DiningTable.name": %w`, err)}
+		}
+	}
+	if v, ok := dtu.mutation.QrToken(); ok {
+		if err := diningtable.QrTokenValidator(v); err != nil {
+			return &ValidationError{Name: "qr_token", err: fmt.Errorf(`ent: validator failed for field "DiningTable.qr_token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dtu *DiningTableUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DiningTableUpdate {
+	dtu.modifiers = append(dtu.modifiers, modifiers...)
+	return dtu
+}
+
+func (dtu *DiningTableUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(diningtable.Table, diningtable.Columns, sqlgraph.NewFieldSpec(diningtable.FieldID, field.TypeUUID))
+	if ps := dtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(diningtable.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dtu.mutation.DeletedAt(); ok {
+		_spec.SetField(diningtable.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := dtu.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(diningtable.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := dtu.mutation.Name(); ok {
+		_spec.SetField(diningtable.FieldName, field.TypeString, value)
+	}
+	if value, ok := dtu.mutation.Seats(); ok {
+		_spec.SetField(diningtable.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := dtu.mutation.AddedSeats(); ok {
+		_spec.AddField(diningtable.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := dtu.mutation.QrToken(); ok {
+		_spec.SetField(diningtable.FieldQrToken, field.TypeString, value)
+	}
+	if value, ok := dtu.mutation.Enabled(); ok {
+		_spec.SetField(diningtable.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := dtu.mutation.SortOrder(); ok {
+		_spec.SetField(diningtable.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := dtu.mutation.AddedSortOrder(); ok {
+		_spec.AddField(diningtable.FieldSortOrder, field.TypeInt, value)
+	}
+	_spec.AddModifiers(dtu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, dtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{diningtable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dtu.mutation.done = true
+	return n, nil
+}
+
+// DiningTableUpdateOne is the builder for updating a single DiningTable entity.
+type DiningTableUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DiningTableMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dtuo *DiningTableUpdateOne) SetUpdatedAt(t time.Time) *DiningTableUpdateOne {
+	dtuo.mutation.SetUpdatedAt(t)
+	return dtuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dtuo *DiningTableUpdateOne) SetDeletedAt(i int64) *DiningTableUpdateOne {
+	dtuo.mutation.ResetDeletedAt()
+	dtuo.mutation.SetDeletedAt(i)
+	return dtuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dtuo *DiningTableUpdateOne) SetNillableDeletedAt(i *int64) *DiningTableUpdateOne {
+	if i != nil {
+		dtuo.SetDeletedAt(*i)
+	}
+	return dtuo
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (dtuo *DiningTableUpdateOne) AddDeletedAt(i int64) *DiningTableUpdateOne {
+	dtuo.mutation.AddDeletedAt(i)
+	return dtuo
+}
+
+// SetName sets the "name" field.
+func (dtuo *DiningTableUpdateOne) SetName(s string) *DiningTableUpdateOne {
+	dtuo.mutation.SetName(s)
+	return dtuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dtuo *DiningTableUpdateOne) SetNillableName(s *string) *DiningTableUpdateOne {
+	if s != nil {
+		dtuo.SetName(*s)
+	}
+	return dtuo
+}
+
+// SetSeats sets the "seats" field.
+func (dtuo *DiningTableUpdateOne) SetSeats(i int) *DiningTableUpdateOne {
+	dtuo.mutation.ResetSeats()
+	dtuo.mutation.SetSeats(i)
+	return dtuo
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (dtuo *DiningTableUpdateOne) SetNillableSeats(i *int) *DiningTableUpdateOne {
+	if i != nil {
+		dtuo.SetSeats(*i)
+	}
+	return dtuo
+}
+
+// AddSeats adds i to the "seats" field.
+func (dtuo *DiningTableUpdateOne) AddSeats(i int) *DiningTableUpdateOne {
+	dtuo.mutation.AddSeats(i)
+	return dtuo
+}
+
+// SetQrToken sets the "qr_token" field.
+func (dtuo *DiningTableUpdateOne) SetQrToken(s string) *DiningTableUpdateOne {
+	dtuo.mutation.SetQrToken(s)
+	return dtuo
+}
+
+// SetNillableQrToken sets the "qr_token" field if the given value is not nil.
+func (dtuo *DiningTableUpdateOne) SetNillableQrToken(s *string) *DiningTableUpdateOne {
+	if s != nil {
+		dtuo.SetQrToken(*s)
+	}
+	return dtuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (dtuo *DiningTableUpdateOne) SetEnabled(b bool) *DiningTableUpdateOne {
+	dtuo.mutation.SetEnabled(b)
+	return dtuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (dtuo *DiningTableUpdateOne) SetNillableEnabled(b *bool) *DiningTableUpdateOne {
+	if b != nil {
+		dtuo.SetEnabled(*b)
+	}
+	return dtuo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (dtuo *DiningTableUpdateOne) SetSortOrder(i int) *DiningTableUpdateOne {
+	dtuo.mutation.ResetSortOrder()
+	dtuo.mutation.SetSortOrder(i)
+	return dtuo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (dtuo *DiningTableUpdateOne) SetNillableSortOrder(i *int) *DiningTableUpdateOne {
+	if i != nil {
+		dtuo.SetSortOrder(*i)
+	}
+	return dtuo
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (dtuo *DiningTableUpdateOne) AddSortOrder(i int) *DiningTableUpdateOne {
+	dtuo.mutation.AddSortOrder(i)
+	return dtuo
+}
+
+// Mutation returns the DiningTableMutation object of the builder.
+func (dtuo *DiningTableUpdateOne) Mutation() *DiningTableMutation {
+	return dtuo.mutation
+}
+
+// Where appends a list predicates to the DiningTableUpdate builder.
+func (dtuo *DiningTableUpdateOne) Where(ps ...predicate.DiningTable) *DiningTableUpdateOne {
+	dtuo.mutation.Where(ps...)
+	return dtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dtuo *DiningTableUpdateOne) Select(field string, fields ...string) *DiningTableUpdateOne {
+	dtuo.fields = append([]string{field}, fields...)
+	return dtuo
+}
+
+// Save executes the query and returns the updated DiningTable entity.
+func (dtuo *DiningTableUpdateOne) Save(ctx context.Context) (*DiningTable, error) {
+	if err := dtuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, dtuo.sqlSave, dtuo.mutation, dtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dtuo *DiningTableUpdateOne) SaveX(ctx context.Context) *DiningTable {
+	node, err := dtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dtuo *DiningTableUpdateOne) Exec(ctx context.Context) error {
+	_, err := dtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dtuo *DiningTableUpdateOne) ExecX(ctx context.Context) {
+	if err := dtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dtuo *DiningTableUpdateOne) defaults() error {
+	if _, ok := dtuo.mutation.UpdatedAt(); !ok {
+		if diningtable.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized diningtable.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := diningtable.UpdateDefaultUpdatedAt()
+		dtuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dtuo *DiningTableUpdateOne) check() error {
+	if v, ok := dtuo.mutation.Name(); ok {
+		if err := diningtable.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DiningTable.name": %w`, err)}
+		}
+	}
+	if v, ok := dtuo.mutation.QrToken(); ok {
+		if err := diningtable.QrTokenValidator(v); err != nil {
+			return &ValidationError{Name: "qr_token", err: fmt.Errorf(`ent: validator failed for field "DiningTable.qr_token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dtuo *DiningTableUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DiningTableUpdateOne {
+	dtuo.modifiers = append(dtuo.modifiers, modifiers...)
+	return dtuo
+}
+
+func (dtuo *DiningTableUpdateOne) sqlSave(ctx context.Context) (_node *DiningTable, err error) {
+	if err := dtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(diningtable.Table, diningtable.Columns, sqlgraph.NewFieldSpec(diningtable.FieldID, field.TypeUUID))
+	id, ok := dtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiningTable.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, diningtable.FieldID)
+		for _, f := range fields {
+			if !diningtable.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != diningtable.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(diningtable.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dtuo.mutation.DeletedAt(); ok {
+		_spec.SetField(diningtable.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := dtuo.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(diningtable.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := dtuo.mutation.Name(); ok {
+		_spec.SetField(diningtable.FieldName, field.TypeString, value)
+	}
+	if value, ok := dtuo.mutation.Seats(); ok {
+		_spec.SetField(diningtable.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := dtuo.mutation.AddedSeats(); ok {
+		_spec.AddField(diningtable.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := dtuo.mutation.QrToken(); ok {
+		_spec.SetField(diningtable.FieldQrToken, field.TypeString, value)
+	}
+	if value, ok := dtuo.mutation.Enabled(); ok {
+		_spec.SetField(diningtable.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := dtuo.mutation.SortOrder(); ok {
+		_spec.SetField(diningtable.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := dtuo.mutation.AddedSortOrder(); ok {
+		_spec.AddField(diningtable.FieldSortOrder, field.TypeInt, value)
+	}
+	_spec.AddModifiers(dtuo.modifiers...)
+	_node = &DiningTable{config: dtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{diningtable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dtuo.mutation.done = true
+	return _node, nil
+}
@@ -17,10 +17,26 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/backenduser"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/businessconfig"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/category"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupon"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupontemplate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/customer"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryorder"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryproductmapping"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliverystore"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/department"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/device"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/diningtable"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/kitchenticket"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/member"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccounttransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointstransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/membertier"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menu"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menuitem"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/menuversion"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchant"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchantbusinesstype"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchantrenewal"
@@ -29,6 +45,12 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/paymentaccount"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/paymentmethod"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/permission"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickupcall"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickuprule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorder"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorderrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattr"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattritem"
@@ -37,15 +59,21 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productspecrelation"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/producttag"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productunit"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/productversion"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/profitdistributionbill"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/profitdistributionrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/promotion"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/receiptrequest"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/refundorder"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/refundorderproduct"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/remark"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservation"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservationrule"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/role"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/rolemenu"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/rolepermission"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/routermenu"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/scanorderrule"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealdetail"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealgroup"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/stall"
@@ -54,6 +82,8 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/storeuser"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/taxfee"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/userrole"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistticket"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -114,48 +144,78 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			additionalfee.Table:          additionalfee.ValidColumn,
-			adminuser.Table:              adminuser.ValidColumn,
-			backenduser.Table:            backenduser.ValidColumn,
-			businessconfig.Table:         businessconfig.ValidColumn,
-			category.Table:               category.ValidColumn,
-			department.Table:             department.ValidColumn,
-			device.Table:                 device.ValidColumn,
-			menu.Table:                   menu.ValidColumn,
-			menuitem.Table:               menuitem.ValidColumn,
-			merchant.Table:               merchant.ValidColumn,
-			merchantbusinesstype.Table:   merchantbusinesstype.ValidColumn,
-			merchantrenewal.Table:        merchantrenewal.ValidColumn,
-			order.Table:                  order.ValidColumn,
-			orderproduct.Table:           orderproduct.ValidColumn,
-			paymentaccount.Table:         paymentaccount.ValidColumn,
-			paymentmethod.Table:          paymentmethod.ValidColumn,
-			permission.Table:             permission.ValidColumn,
-			product.Table:                product.ValidColumn,
-			productattr.Table:            productattr.ValidColumn,
-			productattritem.Table:        productattritem.ValidColumn,
-			productattrrelation.Table:    productattrrelation.ValidColumn,
-			productspec.Table:            productspec.ValidColumn,
-			productspecrelation.Table:    productspecrelation.ValidColumn,
-			producttag.Table:             producttag.ValidColumn,
-			productunit.Table:            productunit.ValidColumn,
-			profitdistributionbill.Table: profitdistributionbill.ValidColumn,
-			profitdistributionrule.Table: profitdistributionrule.ValidColumn,
-			refundorder.Table:            refundorder.ValidColumn,
-			refundorderproduct.Table:     refundorderproduct.ValidColumn,
-			remark.Table:                 remark.ValidColumn,
-			role.Table:                   role.ValidColumn,
-			rolemenu.Table:               rolemenu.ValidColumn,
-			rolepermission.Table:         rolepermission.ValidColumn,
-			routermenu.Table:             routermenu.ValidColumn,
-			setmealdetail.Table:          setmealdetail.ValidColumn,
-			setmealgroup.Table:           setmealgroup.ValidColumn,
-			stall.Table:                  stall.ValidColumn,
-			store.Table:                  store.ValidColumn,
-			storepaymentaccount.Table:    storepaymentaccount.ValidColumn,
-			storeuser.Table:              storeuser.ValidColumn,
-			taxfee.Table:                 taxfee.ValidColumn,
-			userrole.Table:               userrole.ValidColumn,
+			additionalfee.Table:            additionalfee.ValidColumn,
+			adminuser.Table:                adminuser.ValidColumn,
+			backenduser.Table:              backenduser.ValidColumn,
+			businessconfig.Table:           businessconfig.ValidColumn,
+			category.Table:                 category.ValidColumn,
+			coupon.Table:                   coupon.ValidColumn,
+			coupontemplate.Table:           coupontemplate.ValidColumn,
+			customer.Table:                 customer.ValidColumn,
+			deliveryorder.Table:            deliveryorder.ValidColumn,
+			deliveryproductmapping.Table:   deliveryproductmapping.ValidColumn,
+			deliverystore.Table:            deliverystore.ValidColumn,
+			department.Table:               department.ValidColumn,
+			device.Table:                   device.ValidColumn,
+			diningtable.Table:              diningtable.ValidColumn,
+			kitchenticket.Table:            kitchenticket.ValidColumn,
+			member.Table:                   member.ValidColumn,
+			memberaccount.Table:            memberaccount.ValidColumn,
+			memberaccounttransaction.Table: memberaccounttransaction.ValidColumn,
+			memberpointsaccount.Table:      memberpointsaccount.ValidColumn,
+			memberpointsrule.Table:         memberpointsrule.ValidColumn,
+			memberpointstransaction.Table:  memberpointstransaction.ValidColumn,
+			membertier.Table:               membertier.ValidColumn,
+			menu.Table:                     menu.ValidColumn,
+			menuitem.Table:                 menuitem.ValidColumn,
+			menuversion.Table:              menuversion.ValidColumn,
+			merchant.Table:                 merchant.ValidColumn,
+			merchantbusinesstype.Table:     merchantbusinesstype.ValidColumn,
+			merchantrenewal.Table:          merchantrenewal.ValidColumn,
+			order.Table:                    order.ValidColumn,
+			orderproduct.Table:             orderproduct.ValidColumn,
+			paymentaccount.Table:           paymentaccount.ValidColumn,
+			paymentmethod.Table:            paymentmethod.ValidColumn,
+			permission.Table:               permission.ValidColumn,
+			pickupcall.Table:               pickupcall.ValidColumn,
+			pickuprule.Table:               pickuprule.ValidColumn,
+			preorder.Table:                 preorder.ValidColumn,
+			preorderrule.Table:             preorderrule.ValidColumn,
+			pricechangebatch.Table:         pricechangebatch.ValidColumn,
+			pricechangeitem.Table:          pricechangeitem.ValidColumn,
+			product.Table:                  product.ValidColumn,
+			productattr.Table:              productattr.ValidColumn,
+			productattritem.Table:          productattritem.ValidColumn,
+			productattrrelation.Table:      productattrrelation.ValidColumn,
+			productspec.Table:              productspec.ValidColumn,
+			productspecrelation.Table:      productspecrelation.ValidColumn,
+			producttag.Table:               producttag.ValidColumn,
+			productunit.Table:              productunit.ValidColumn,
+			productversion.Table:           productversion.ValidColumn,
+			profitdistributionbill.Table:   profitdistributionbill.ValidColumn,
+			profitdistributionrule.Table:   profitdistributionrule.ValidColumn,
+			promotion.Table:                promotion.ValidColumn,
+			receiptrequest.Table:           receiptrequest.ValidColumn,
+			refundorder.Table:              refundorder.ValidColumn,
+			refundorderproduct.Table:       refundorderproduct.ValidColumn,
+			remark.Table:                   remark.ValidColumn,
+			reservation.Table:              reservation.ValidColumn,
+			reservationrule.Table:          reservationrule.ValidColumn,
+			role.Table:                     role.ValidColumn,
+			rolemenu.Table:                 rolemenu.ValidColumn,
+			rolepermission.Table:           rolepermission.ValidColumn,
+			routermenu.Table:               routermenu.ValidColumn,
+			scanorderrule.Table:            scanorderrule.ValidColumn,
+			setmealdetail.Table:            setmealdetail.ValidColumn,
+			setmealgroup.Table:             setmealgroup.ValidColumn,
+			stall.Table:                    stall.ValidColumn,
+			store.Table:                    store.ValidColumn,
+			storepaymentaccount.Table:      storepaymentaccount.ValidColumn,
+			storeuser.Table:                storeuser.ValidColumn,
+			taxfee.Table:                   taxfee.ValidColumn,
+			userrole.Table:                 userrole.ValidColumn,
+			waitlistrule.Table:             waitlistrule.ValidColumn,
+			waitlistticket.Table:           waitlistticket.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -69,6 +69,78 @@ func (f CategoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryMutation", m)
 }
 
+// The CouponFunc type is an adapter to allow the use of ordinary
+// function as Coupon mutator.
+type CouponFunc func(context.Context, *ent.CouponMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CouponFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CouponMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CouponMutation", m)
+}
+
+// The CouponTemplateFunc type is an adapter to allow the use of ordinary
+// function as CouponTemplate mutator.
+type CouponTemplateFunc func(context.Context, *ent.CouponTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CouponTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CouponTemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CouponTemplateMutation", m)
+}
+
+// The CustomerFunc type is an adapter to allow the use of ordinary
+// function as Customer mutator.
+type CustomerFunc func(context.Context, *ent.CustomerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CustomerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CustomerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CustomerMutation", m)
+}
+
+// The DeliveryOrderFunc type is an adapter to allow the use of ordinary
+// function as DeliveryOrder mutator.
+type DeliveryOrderFunc func(context.Context, *ent.DeliveryOrderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeliveryOrderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeliveryOrderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeliveryOrderMutation", m)
+}
+
+// The DeliveryProductMappingFunc type is an adapter to allow the use of ordinary
+// function as DeliveryProductMapping mutator.
+type DeliveryProductMappingFunc func(context.Context, *ent.DeliveryProductMappingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeliveryProductMappingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeliveryProductMappingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeliveryProductMappingMutation", m)
+}
+
+// The DeliveryStoreFunc type is an adapter to allow the use of ordinary
+// function as DeliveryStore mutator.
+type DeliveryStoreFunc func(context.Context, *ent.DeliveryStoreMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeliveryStoreFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeliveryStoreMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeliveryStoreMutation", m)
+}
+
 // The DepartmentFunc type is an adapter to allow the use of ordinary
 // function as Department mutator.
 type DepartmentFunc func(context.Context, *ent.DepartmentMutation) (ent.Value, error)
@@ -93,6 +165,114 @@ func (f DeviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceMutation", m)
 }
 
+// The DiningTableFunc type is an adapter to allow the use of ordinary
+// function as DiningTable mutator.
+type DiningTableFunc func(context.Context, *ent.DiningTableMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiningTableFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiningTableMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiningTableMutation", m)
+}
+
+// The KitchenTicketFunc type is an adapter to allow the use of ordinary
+// function as KitchenTicket mutator.
+type KitchenTicketFunc func(context.Context, *ent.KitchenTicketMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KitchenTicketFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KitchenTicketMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KitchenTicketMutation", m)
+}
+
+// The MemberFunc type is an adapter to allow the use of ordinary
+// function as Member mutator.
+type MemberFunc func(context.Context, *ent.MemberMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberMutation", m)
+}
+
+// The MemberAccountFunc type is an adapter to allow the use of ordinary
+// function as MemberAccount mutator.
+type MemberAccountFunc func(context.Context, *ent.MemberAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberAccountMutation", m)
+}
+
+// The MemberAccountTransactionFunc type is an adapter to allow the use of ordinary
+// function as MemberAccountTransaction mutator.
+type MemberAccountTransactionFunc func(context.Context, *ent.MemberAccountTransactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberAccountTransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberAccountTransactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberAccountTransactionMutation", m)
+}
+
+// The MemberPointsAccountFunc type is an adapter to allow the use of ordinary
+// function as MemberPointsAccount mutator.
+type MemberPointsAccountFunc func(context.Context, *ent.MemberPointsAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberPointsAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberPointsAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberPointsAccountMutation", m)
+}
+
+// The MemberPointsRuleFunc type is an adapter to allow the use of ordinary
+// function as MemberPointsRule mutator.
+type MemberPointsRuleFunc func(context.Context, *ent.MemberPointsRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberPointsRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberPointsRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberPointsRuleMutation", m)
+}
+
+// The MemberPointsTransactionFunc type is an adapter to allow the use of ordinary
+// function as MemberPointsTransaction mutator.
+type MemberPointsTransactionFunc func(context.Context, *ent.MemberPointsTransactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberPointsTransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberPointsTransactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberPointsTransactionMutation", m)
+}
+
+// The MemberTierFunc type is an adapter to allow the use of ordinary
+// function as MemberTier mutator.
+type MemberTierFunc func(context.Context, *ent.MemberTierMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberTierFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberTierMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberTierMutation", m)
+}
+
 // The MenuFunc type is an adapter to allow the use of ordinary
 // function as Menu mutator.
 type MenuFunc func(context.Context, *ent.MenuMutation) (ent.Value, error)
@@ -117,6 +297,18 @@ func (f MenuItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MenuItemMutation", m)
 }
 
+// The MenuVersionFunc type is an adapter to allow the use of ordinary
+// function as MenuVersion mutator.
+type MenuVersionFunc func(context.Context, *ent.MenuVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MenuVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MenuVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MenuVersionMutation", m)
+}
+
 // The MerchantFunc type is an adapter to allow the use of ordinary
 // function as Merchant mutator.
 type MerchantFunc func(context.Context, *ent.MerchantMutation) (ent.Value, error)
@@ -213,6 +405,78 @@ func (f PermissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PermissionMutation", m)
 }
 
+// The PickupCallFunc type is an adapter to allow the use of ordinary
+// function as PickupCall mutator.
+type PickupCallFunc func(context.Context, *ent.PickupCallMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PickupCallFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PickupCallMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PickupCallMutation", m)
+}
+
+// The PickupRuleFunc type is an adapter to allow the use of ordinary
+// function as PickupRule mutator.
+type PickupRuleFunc func(context.Context, *ent.PickupRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PickupRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PickupRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PickupRuleMutation", m)
+}
+
+// The PreorderFunc type is an adapter to allow the use of ordinary
+// function as Preorder mutator.
+type PreorderFunc func(context.Context, *ent.PreorderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PreorderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PreorderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PreorderMutation", m)
+}
+
+// The PreorderRuleFunc type is an adapter to allow the use of ordinary
+// function as PreorderRule mutator.
+type PreorderRuleFunc func(context.Context, *ent.PreorderRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PreorderRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PreorderRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PreorderRuleMutation", m)
+}
+
+// The PriceChangeBatchFunc type is an adapter to allow the use of ordinary
+// function as PriceChangeBatch mutator.
+type PriceChangeBatchFunc func(context.Context, *ent.PriceChangeBatchMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PriceChangeBatchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PriceChangeBatchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PriceChangeBatchMutation", m)
+}
+
+// The PriceChangeItemFunc type is an adapter to allow the use of ordinary
+// function as PriceChangeItem mutator.
+type PriceChangeItemFunc func(context.Context, *ent.PriceChangeItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PriceChangeItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PriceChangeItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PriceChangeItemMutation", m)
+}
+
 // The ProductFunc type is an adapter to allow the use of ordinary
 // function as Product mutator.
 type ProductFunc func(context.Context, *ent.ProductMutation) (ent.Value, error)
@@ -309,6 +573,18 @@ func (f ProductUnitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductUnitMutation", m)
 }
 
+// The ProductVersionFunc type is an adapter to allow the use of ordinary
+// function as ProductVersion mutator.
+type ProductVersionFunc func(context.Context, *ent.ProductVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProductVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductVersionMutation", m)
+}
+
 // The ProfitDistributionBillFunc type is an adapter to allow the use of ordinary
 // function as ProfitDistributionBill mutator.
 type ProfitDistributionBillFunc func(context.Context, *ent.ProfitDistributionBillMutation) (ent.Value, error)
@@ -333,6 +609,30 @@ func (f ProfitDistributionRuleFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfitDistributionRuleMutation", m)
 }
 
+// The PromotionFunc type is an adapter to allow the use of ordinary
+// function as Promotion mutator.
+type PromotionFunc func(context.Context, *ent.PromotionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PromotionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PromotionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PromotionMutation", m)
+}
+
+// The ReceiptRequestFunc type is an adapter to allow the use of ordinary
+// function as ReceiptRequest mutator.
+type ReceiptRequestFunc func(context.Context, *ent.ReceiptRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReceiptRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReceiptRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReceiptRequestMutation", m)
+}
+
 // The RefundOrderFunc type is an adapter to allow the use of ordinary
 // function as RefundOrder mutator.
 type RefundOrderFunc func(context.Context, *ent.RefundOrderMutation) (ent.Value, error)
@@ -369,6 +669,30 @@ func (f RemarkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RemarkMutation", m)
 }
 
+// The ReservationFunc type is an adapter to allow the use of ordinary
+// function as Reservation mutator.
+type ReservationFunc func(context.Context, *ent.ReservationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReservationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReservationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReservationMutation", m)
+}
+
+// The ReservationRuleFunc type is an adapter to allow the use of ordinary
+// function as ReservationRule mutator.
+type ReservationRuleFunc func(context.Context, *ent.ReservationRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReservationRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReservationRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReservationRuleMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
@@ -417,6 +741,18 @@ func (f RouterMenuFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RouterMenuMutation", m)
 }
 
+// The ScanOrderRuleFunc type is an adapter to allow the use of ordinary
+// function as ScanOrderRule mutator.
+type ScanOrderRuleFunc func(context.Context, *ent.ScanOrderRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScanOrderRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScanOrderRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScanOrderRuleMutation", m)
+}
+
 // The SetMealDetailFunc type is an adapter to allow the use of ordinary
 // function as SetMealDetail mutator.
 type SetMealDetailFunc func(context.Context, *ent.SetMealDetailMutation) (ent.Value, error)
@@ -513,6 +849,30 @@ func (f UserRoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserRoleMutation", m)
 }
 
+// The WaitlistRuleFunc type is an adapter to allow the use of ordinary
+// function as WaitlistRule mutator.
+type WaitlistRuleFunc func(context.Context, *ent.WaitlistRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WaitlistRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WaitlistRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WaitlistRuleMutation", m)
+}
+
+// The WaitlistTicketFunc type is an adapter to allow the use of ordinary
+// function as WaitlistTicket mutator.
+type WaitlistTicketFunc func(context.Context, *ent.WaitlistTicketMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WaitlistTicketFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WaitlistTicketMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WaitlistTicketMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
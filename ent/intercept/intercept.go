@@ -13,10 +13,26 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/backenduser"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/businessconfig"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/category"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupon"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupontemplate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/customer"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryorder"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryproductmapping"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliverystore"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/department"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/device"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/diningtable"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/kitchenticket"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/member"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccounttransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointstransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/membertier"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menu"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menuitem"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/menuversion"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchant"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchantbusinesstype"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchantrenewal"
@@ -25,7 +41,13 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/paymentaccount"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/paymentmethod"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/permission"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickupcall"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickuprule"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorder"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorderrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattr"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattritem"
@@ -34,15 +56,21 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productspecrelation"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/producttag"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productunit"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/productversion"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/profitdistributionbill"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/profitdistributionrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/promotion"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/receiptrequest"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/refundorder"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/refundorderproduct"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/remark"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservation"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservationrule"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/role"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/rolemenu"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/rolepermission"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/routermenu"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/scanorderrule"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealdetail"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealgroup"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/stall"
@@ -51,6 +79,8 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/storeuser"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/taxfee"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/userrole"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistticket"
 )
 
 // The Query interface represents an operation that queries a graph.
@@ -244,6 +274,168 @@ func (f TraverseCategory) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.CategoryQuery", q)
 }
 
+// The CouponFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CouponFunc func(context.Context, *ent.CouponQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CouponFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CouponQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CouponQuery", q)
+}
+
+// The TraverseCoupon type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCoupon func(context.Context, *ent.CouponQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCoupon) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCoupon) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CouponQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CouponQuery", q)
+}
+
+// The CouponTemplateFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CouponTemplateFunc func(context.Context, *ent.CouponTemplateQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CouponTemplateFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CouponTemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CouponTemplateQuery", q)
+}
+
+// The TraverseCouponTemplate type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCouponTemplate func(context.Context, *ent.CouponTemplateQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCouponTemplate) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCouponTemplate) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CouponTemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CouponTemplateQuery", q)
+}
+
+// The CustomerFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CustomerFunc func(context.Context, *ent.CustomerQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CustomerFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CustomerQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CustomerQuery", q)
+}
+
+// The TraverseCustomer type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCustomer func(context.Context, *ent.CustomerQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCustomer) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCustomer) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CustomerQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CustomerQuery", q)
+}
+
+// The DeliveryOrderFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DeliveryOrderFunc func(context.Context, *ent.DeliveryOrderQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DeliveryOrderFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DeliveryOrderQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DeliveryOrderQuery", q)
+}
+
+// The TraverseDeliveryOrder type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDeliveryOrder func(context.Context, *ent.DeliveryOrderQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDeliveryOrder) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDeliveryOrder) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DeliveryOrderQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DeliveryOrderQuery", q)
+}
+
+// The DeliveryProductMappingFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DeliveryProductMappingFunc func(context.Context, *ent.DeliveryProductMappingQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DeliveryProductMappingFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DeliveryProductMappingQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DeliveryProductMappingQuery", q)
+}
+
+// The TraverseDeliveryProductMapping type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDeliveryProductMapping func(context.Context, *ent.DeliveryProductMappingQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDeliveryProductMapping) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDeliveryProductMapping) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DeliveryProductMappingQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DeliveryProductMappingQuery", q)
+}
+
+// The DeliveryStoreFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DeliveryStoreFunc func(context.Context, *ent.DeliveryStoreQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DeliveryStoreFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DeliveryStoreQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DeliveryStoreQuery", q)
+}
+
+// The TraverseDeliveryStore type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDeliveryStore func(context.Context, *ent.DeliveryStoreQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDeliveryStore) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDeliveryStore) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DeliveryStoreQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DeliveryStoreQuery", q)
+}
+
 // The DepartmentFunc type is an adapter to allow the use of ordinary function as a Querier.
 type DepartmentFunc func(context.Context, *ent.DepartmentQuery) (ent.Value, error)
 
@@ -298,6 +490,249 @@ func (f TraverseDevice) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.DeviceQuery", q)
 }
 
+// The DiningTableFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DiningTableFunc func(context.Context, *ent.DiningTableQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DiningTableFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DiningTableQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DiningTableQuery", q)
+}
+
+// The TraverseDiningTable type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDiningTable func(context.Context, *ent.DiningTableQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDiningTable) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDiningTable) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DiningTableQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DiningTableQuery", q)
+}
+
+// The KitchenTicketFunc type is an adapter to allow the use of ordinary function as a Querier.
+type KitchenTicketFunc func(context.Context, *ent.KitchenTicketQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f KitchenTicketFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.KitchenTicketQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.KitchenTicketQuery", q)
+}
+
+// The TraverseKitchenTicket type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseKitchenTicket func(context.Context, *ent.KitchenTicketQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseKitchenTicket) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseKitchenTicket) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.KitchenTicketQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.KitchenTicketQuery", q)
+}
+
+// The MemberFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MemberFunc func(context.Context, *ent.MemberQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MemberFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MemberQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MemberQuery", q)
+}
+
+// The TraverseMember type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMember func(context.Context, *ent.MemberQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMember) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMember) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MemberQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MemberQuery", q)
+}
+
+// The MemberAccountFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MemberAccountFunc func(context.Context, *ent.MemberAccountQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MemberAccountFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MemberAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MemberAccountQuery", q)
+}
+
+// The TraverseMemberAccount type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMemberAccount func(context.Context, *ent.MemberAccountQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMemberAccount) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMemberAccount) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MemberAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MemberAccountQuery", q)
+}
+
+// The MemberAccountTransactionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MemberAccountTransactionFunc func(context.Context, *ent.MemberAccountTransactionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MemberAccountTransactionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MemberAccountTransactionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MemberAccountTransactionQuery", q)
+}
+
+// The TraverseMemberAccountTransaction type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMemberAccountTransaction func(context.Context, *ent.MemberAccountTransactionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMemberAccountTransaction) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMemberAccountTransaction) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MemberAccountTransactionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MemberAccountTransactionQuery", q)
+}
+
+// The MemberPointsAccountFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MemberPointsAccountFunc func(context.Context, *ent.MemberPointsAccountQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MemberPointsAccountFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MemberPointsAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MemberPointsAccountQuery", q)
+}
+
+// The TraverseMemberPointsAccount type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMemberPointsAccount func(context.Context, *ent.MemberPointsAccountQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMemberPointsAccount) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMemberPointsAccount) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MemberPointsAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MemberPointsAccountQuery", q)
+}
+
+// The MemberPointsRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MemberPointsRuleFunc func(context.Context, *ent.MemberPointsRuleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MemberPointsRuleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MemberPointsRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MemberPointsRuleQuery", q)
+}
+
+// The TraverseMemberPointsRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMemberPointsRule func(context.Context, *ent.MemberPointsRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMemberPointsRule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMemberPointsRule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MemberPointsRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MemberPointsRuleQuery", q)
+}
+
+// The MemberPointsTransactionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MemberPointsTransactionFunc func(context.Context, *ent.MemberPointsTransactionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MemberPointsTransactionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MemberPointsTransactionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MemberPointsTransactionQuery", q)
+}
+
+// The TraverseMemberPointsTransaction type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMemberPointsTransaction func(context.Context, *ent.MemberPointsTransactionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMemberPointsTransaction) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMemberPointsTransaction) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MemberPointsTransactionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MemberPointsTransactionQuery", q)
+}
+
+// The MemberTierFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MemberTierFunc func(context.Context, *ent.MemberTierQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MemberTierFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MemberTierQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MemberTierQuery", q)
+}
+
+// The TraverseMemberTier type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMemberTier func(context.Context, *ent.MemberTierQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMemberTier) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMemberTier) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MemberTierQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MemberTierQuery", q)
+}
+
 // The MenuFunc type is an adapter to allow the use of ordinary function as a Querier.
 type MenuFunc func(context.Context, *ent.MenuQuery) (ent.Value, error)
 
@@ -352,6 +787,33 @@ func (f TraverseMenuItem) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.MenuItemQuery", q)
 }
 
+// The MenuVersionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MenuVersionFunc func(context.Context, *ent.MenuVersionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MenuVersionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MenuVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MenuVersionQuery", q)
+}
+
+// The TraverseMenuVersion type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMenuVersion func(context.Context, *ent.MenuVersionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMenuVersion) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMenuVersion) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MenuVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MenuVersionQuery", q)
+}
+
 // The MerchantFunc type is an adapter to allow the use of ordinary function as a Querier.
 type MerchantFunc func(context.Context, *ent.MerchantQuery) (ent.Value, error)
 
@@ -568,6 +1030,168 @@ func (f TraversePermission) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.PermissionQuery", q)
 }
 
+// The PickupCallFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PickupCallFunc func(context.Context, *ent.PickupCallQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PickupCallFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PickupCallQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PickupCallQuery", q)
+}
+
+// The TraversePickupCall type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePickupCall func(context.Context, *ent.PickupCallQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePickupCall) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePickupCall) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PickupCallQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PickupCallQuery", q)
+}
+
+// The PickupRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PickupRuleFunc func(context.Context, *ent.PickupRuleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PickupRuleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PickupRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PickupRuleQuery", q)
+}
+
+// The TraversePickupRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePickupRule func(context.Context, *ent.PickupRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePickupRule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePickupRule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PickupRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PickupRuleQuery", q)
+}
+
+// The PreorderFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PreorderFunc func(context.Context, *ent.PreorderQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PreorderFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PreorderQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PreorderQuery", q)
+}
+
+// The TraversePreorder type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePreorder func(context.Context, *ent.PreorderQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePreorder) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePreorder) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PreorderQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PreorderQuery", q)
+}
+
+// The PreorderRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PreorderRuleFunc func(context.Context, *ent.PreorderRuleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PreorderRuleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PreorderRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PreorderRuleQuery", q)
+}
+
+// The TraversePreorderRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePreorderRule func(context.Context, *ent.PreorderRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePreorderRule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePreorderRule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PreorderRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PreorderRuleQuery", q)
+}
+
+// The PriceChangeBatchFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PriceChangeBatchFunc func(context.Context, *ent.PriceChangeBatchQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PriceChangeBatchFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PriceChangeBatchQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PriceChangeBatchQuery", q)
+}
+
+// The TraversePriceChangeBatch type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePriceChangeBatch func(context.Context, *ent.PriceChangeBatchQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePriceChangeBatch) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePriceChangeBatch) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PriceChangeBatchQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PriceChangeBatchQuery", q)
+}
+
+// The PriceChangeItemFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PriceChangeItemFunc func(context.Context, *ent.PriceChangeItemQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PriceChangeItemFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PriceChangeItemQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PriceChangeItemQuery", q)
+}
+
+// The TraversePriceChangeItem type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePriceChangeItem func(context.Context, *ent.PriceChangeItemQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePriceChangeItem) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePriceChangeItem) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PriceChangeItemQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PriceChangeItemQuery", q)
+}
+
 // The ProductFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ProductFunc func(context.Context, *ent.ProductQuery) (ent.Value, error)
 
@@ -784,6 +1408,33 @@ func (f TraverseProductUnit) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.ProductUnitQuery", q)
 }
 
+// The ProductVersionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ProductVersionFunc func(context.Context, *ent.ProductVersionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ProductVersionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ProductVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ProductVersionQuery", q)
+}
+
+// The TraverseProductVersion type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseProductVersion func(context.Context, *ent.ProductVersionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseProductVersion) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseProductVersion) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ProductVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ProductVersionQuery", q)
+}
+
 // The ProfitDistributionBillFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ProfitDistributionBillFunc func(context.Context, *ent.ProfitDistributionBillQuery) (ent.Value, error)
 
@@ -838,6 +1489,60 @@ func (f TraverseProfitDistributionRule) Traverse(ctx context.Context, q ent.Quer
 	return fmt.Errorf("unexpected query type %T. expect *ent.ProfitDistributionRuleQuery", q)
 }
 
+// The PromotionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PromotionFunc func(context.Context, *ent.PromotionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PromotionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PromotionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PromotionQuery", q)
+}
+
+// The TraversePromotion type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePromotion func(context.Context, *ent.PromotionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePromotion) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePromotion) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PromotionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PromotionQuery", q)
+}
+
+// The ReceiptRequestFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ReceiptRequestFunc func(context.Context, *ent.ReceiptRequestQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ReceiptRequestFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ReceiptRequestQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ReceiptRequestQuery", q)
+}
+
+// The TraverseReceiptRequest type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseReceiptRequest func(context.Context, *ent.ReceiptRequestQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseReceiptRequest) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseReceiptRequest) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReceiptRequestQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ReceiptRequestQuery", q)
+}
+
 // The RefundOrderFunc type is an adapter to allow the use of ordinary function as a Querier.
 type RefundOrderFunc func(context.Context, *ent.RefundOrderQuery) (ent.Value, error)
 
@@ -919,6 +1624,60 @@ func (f TraverseRemark) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.RemarkQuery", q)
 }
 
+// The ReservationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ReservationFunc func(context.Context, *ent.ReservationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ReservationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ReservationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ReservationQuery", q)
+}
+
+// The TraverseReservation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseReservation func(context.Context, *ent.ReservationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseReservation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseReservation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReservationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ReservationQuery", q)
+}
+
+// The ReservationRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ReservationRuleFunc func(context.Context, *ent.ReservationRuleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ReservationRuleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ReservationRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ReservationRuleQuery", q)
+}
+
+// The TraverseReservationRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseReservationRule func(context.Context, *ent.ReservationRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseReservationRule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseReservationRule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReservationRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ReservationRuleQuery", q)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary function as a Querier.
 type RoleFunc func(context.Context, *ent.RoleQuery) (ent.Value, error)
 
@@ -1027,6 +1786,33 @@ func (f TraverseRouterMenu) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.RouterMenuQuery", q)
 }
 
+// The ScanOrderRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ScanOrderRuleFunc func(context.Context, *ent.ScanOrderRuleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ScanOrderRuleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ScanOrderRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ScanOrderRuleQuery", q)
+}
+
+// The TraverseScanOrderRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseScanOrderRule func(context.Context, *ent.ScanOrderRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseScanOrderRule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseScanOrderRule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ScanOrderRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ScanOrderRuleQuery", q)
+}
+
 // The SetMealDetailFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SetMealDetailFunc func(context.Context, *ent.SetMealDetailQuery) (ent.Value, error)
 
@@ -1243,6 +2029,60 @@ func (f TraverseUserRole) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.UserRoleQuery", q)
 }
 
+// The WaitlistRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WaitlistRuleFunc func(context.Context, *ent.WaitlistRuleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f WaitlistRuleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.WaitlistRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.WaitlistRuleQuery", q)
+}
+
+// The TraverseWaitlistRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWaitlistRule func(context.Context, *ent.WaitlistRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWaitlistRule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWaitlistRule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WaitlistRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.WaitlistRuleQuery", q)
+}
+
+// The WaitlistTicketFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WaitlistTicketFunc func(context.Context, *ent.WaitlistTicketQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f WaitlistTicketFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.WaitlistTicketQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.WaitlistTicketQuery", q)
+}
+
+// The TraverseWaitlistTicket type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWaitlistTicket func(context.Context, *ent.WaitlistTicketQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWaitlistTicket) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWaitlistTicket) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WaitlistTicketQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.WaitlistTicketQuery", q)
+}
+
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
@@ -1256,14 +2096,46 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.BusinessConfigQuery, predicate.BusinessConfig, businessconfig.OrderOption]{typ: ent.TypeBusinessConfig, tq: q}, nil
 	case *ent.CategoryQuery:
 		return &query[*ent.CategoryQuery, predicate.Category, category.OrderOption]{typ: ent.TypeCategory, tq: q}, nil
+	case *ent.CouponQuery:
+		return &query[*ent.CouponQuery, predicate.Coupon, coupon.OrderOption]{typ: ent.TypeCoupon, tq: q}, nil
+	case *ent.CouponTemplateQuery:
+		return &query[*ent.CouponTemplateQuery, predicate.CouponTemplate, coupontemplate.OrderOption]{typ: ent.TypeCouponTemplate, tq: q}, nil
+	case *ent.CustomerQuery:
+		return &query[*ent.CustomerQuery, predicate.Customer, customer.OrderOption]{typ: ent.TypeCustomer, tq: q}, nil
+	case *ent.DeliveryOrderQuery:
+		return &query[*ent.DeliveryOrderQuery, predicate.DeliveryOrder, deliveryorder.OrderOption]{typ: ent.TypeDeliveryOrder, tq: q}, nil
+	case *ent.DeliveryProductMappingQuery:
+		return &query[*ent.DeliveryProductMappingQuery, predicate.DeliveryProductMapping, deliveryproductmapping.OrderOption]{typ: ent.TypeDeliveryProductMapping, tq: q}, nil
+	case *ent.DeliveryStoreQuery:
+		return &query[*ent.DeliveryStoreQuery, predicate.DeliveryStore, deliverystore.OrderOption]{typ: ent.TypeDeliveryStore, tq: q}, nil
 	case *ent.DepartmentQuery:
 		return &query[*ent.DepartmentQuery, predicate.Department, department.OrderOption]{typ: ent.TypeDepartment, tq: q}, nil
 	case *ent.DeviceQuery:
 		return &query[*ent.DeviceQuery, predicate.Device, device.OrderOption]{typ: ent.TypeDevice, tq: q}, nil
+	case *ent.DiningTableQuery:
+		return &query[*ent.DiningTableQuery, predicate.DiningTable, diningtable.OrderOption]{typ: ent.TypeDiningTable, tq: q}, nil
+	case *ent.KitchenTicketQuery:
+		return &query[*ent.KitchenTicketQuery, predicate.KitchenTicket, kitchenticket.OrderOption]{typ: ent.TypeKitchenTicket, tq: q}, nil
+	case *ent.MemberQuery:
+		return &query[*ent.MemberQuery, predicate.Member, member.OrderOption]{typ: ent.TypeMember, tq: q}, nil
+	case *ent.MemberAccountQuery:
+		return &query[*ent.MemberAccountQuery, predicate.MemberAccount, memberaccount.OrderOption]{typ: ent.TypeMemberAccount, tq: q}, nil
+	case *ent.MemberAccountTransactionQuery:
+		return &query[*ent.MemberAccountTransactionQuery, predicate.MemberAccountTransaction, memberaccounttransaction.OrderOption]{typ: ent.TypeMemberAccountTransaction, tq: q}, nil
+	case *ent.MemberPointsAccountQuery:
+		return &query[*ent.MemberPointsAccountQuery, predicate.MemberPointsAccount, memberpointsaccount.OrderOption]{typ: ent.TypeMemberPointsAccount, tq: q}, nil
+	case *ent.MemberPointsRuleQuery:
+		return &query[*ent.MemberPointsRuleQuery, predicate.MemberPointsRule, memberpointsrule.OrderOption]{typ: ent.TypeMemberPointsRule, tq: q}, nil
+	case *ent.MemberPointsTransactionQuery:
+		return &query[*ent.MemberPointsTransactionQuery, predicate.MemberPointsTransaction, memberpointstransaction.OrderOption]{typ: ent.TypeMemberPointsTransaction, tq: q}, nil
+	case *ent.MemberTierQuery:
+		return &query[*ent.MemberTierQuery, predicate.MemberTier, membertier.OrderOption]{typ: ent.TypeMemberTier, tq: q}, nil
 	case *ent.MenuQuery:
 		return &query[*ent.MenuQuery, predicate.Menu, menu.OrderOption]{typ: ent.TypeMenu, tq: q}, nil
 	case *ent.MenuItemQuery:
 		return &query[*ent.MenuItemQuery, predicate.MenuItem, menuitem.OrderOption]{typ: ent.TypeMenuItem, tq: q}, nil
+	case *ent.MenuVersionQuery:
+		return &query[*ent.MenuVersionQuery, predicate.MenuVersion, menuversion.OrderOption]{typ: ent.TypeMenuVersion, tq: q}, nil
 	case *ent.MerchantQuery:
 		return &query[*ent.MerchantQuery, predicate.Merchant, merchant.OrderOption]{typ: ent.TypeMerchant, tq: q}, nil
 	case *ent.MerchantBusinessTypeQuery:
@@ -1280,6 +2152,18 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.PaymentMethodQuery, predicate.PaymentMethod, paymentmethod.OrderOption]{typ: ent.TypePaymentMethod, tq: q}, nil
 	case *ent.PermissionQuery:
 		return &query[*ent.PermissionQuery, predicate.Permission, permission.OrderOption]{typ: ent.TypePermission, tq: q}, nil
+	case *ent.PickupCallQuery:
+		return &query[*ent.PickupCallQuery, predicate.PickupCall, pickupcall.OrderOption]{typ: ent.TypePickupCall, tq: q}, nil
+	case *ent.PickupRuleQuery:
+		return &query[*ent.PickupRuleQuery, predicate.PickupRule, pickuprule.OrderOption]{typ: ent.TypePickupRule, tq: q}, nil
+	case *ent.PreorderQuery:
+		return &query[*ent.PreorderQuery, predicate.Preorder, preorder.OrderOption]{typ: ent.TypePreorder, tq: q}, nil
+	case *ent.PreorderRuleQuery:
+		return &query[*ent.PreorderRuleQuery, predicate.PreorderRule, preorderrule.OrderOption]{typ: ent.TypePreorderRule, tq: q}, nil
+	case *ent.PriceChangeBatchQuery:
+		return &query[*ent.PriceChangeBatchQuery, predicate.PriceChangeBatch, pricechangebatch.OrderOption]{typ: ent.TypePriceChangeBatch, tq: q}, nil
+	case *ent.PriceChangeItemQuery:
+		return &query[*ent.PriceChangeItemQuery, predicate.PriceChangeItem, pricechangeitem.OrderOption]{typ: ent.TypePriceChangeItem, tq: q}, nil
 	case *ent.ProductQuery:
 		return &query[*ent.ProductQuery, predicate.Product, product.OrderOption]{typ: ent.TypeProduct, tq: q}, nil
 	case *ent.ProductAttrQuery:
@@ -1296,16 +2180,26 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.ProductTagQuery, predicate.ProductTag, producttag.OrderOption]{typ: ent.TypeProductTag, tq: q}, nil
 	case *ent.ProductUnitQuery:
 		return &query[*ent.ProductUnitQuery, predicate.ProductUnit, productunit.OrderOption]{typ: ent.TypeProductUnit, tq: q}, nil
+	case *ent.ProductVersionQuery:
+		return &query[*ent.ProductVersionQuery, predicate.ProductVersion, productversion.OrderOption]{typ: ent.TypeProductVersion, tq: q}, nil
 	case *ent.ProfitDistributionBillQuery:
 		return &query[*ent.ProfitDistributionBillQuery, predicate.ProfitDistributionBill, profitdistributionbill.OrderOption]{typ: ent.TypeProfitDistributionBill, tq: q}, nil
 	case *ent.ProfitDistributionRuleQuery:
 		return &query[*ent.ProfitDistributionRuleQuery, predicate.ProfitDistributionRule, profitdistributionrule.OrderOption]{typ: ent.TypeProfitDistributionRule, tq: q}, nil
+	case *ent.PromotionQuery:
+		return &query[*ent.PromotionQuery, predicate.Promotion, promotion.OrderOption]{typ: ent.TypePromotion, tq: q}, nil
+	case *ent.ReceiptRequestQuery:
+		return &query[*ent.ReceiptRequestQuery, predicate.ReceiptRequest, receiptrequest.OrderOption]{typ: ent.TypeReceiptRequest, tq: q}, nil
 	case *ent.RefundOrderQuery:
 		return &query[*ent.RefundOrderQuery, predicate.RefundOrder, refundorder.OrderOption]{typ: ent.TypeRefundOrder, tq: q}, nil
 	case *ent.RefundOrderProductQuery:
 		return &query[*ent.RefundOrderProductQuery, predicate.RefundOrderProduct, refundorderproduct.OrderOption]{typ: ent.TypeRefundOrderProduct, tq: q}, nil
 	case *ent.RemarkQuery:
 		return &query[*ent.RemarkQuery, predicate.Remark, remark.OrderOption]{typ: ent.TypeRemark, tq: q}, nil
+	case *ent.ReservationQuery:
+		return &query[*ent.ReservationQuery, predicate.Reservation, reservation.OrderOption]{typ: ent.TypeReservation, tq: q}, nil
+	case *ent.ReservationRuleQuery:
+		return &query[*ent.ReservationRuleQuery, predicate.ReservationRule, reservationrule.OrderOption]{typ: ent.TypeReservationRule, tq: q}, nil
 	case *ent.RoleQuery:
 		return &query[*ent.RoleQuery, predicate.Role, role.OrderOption]{typ: ent.TypeRole, tq: q}, nil
 	case *ent.RoleMenuQuery:
@@ -1314,6 +2208,8 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.RolePermissionQuery, predicate.RolePermission, rolepermission.OrderOption]{typ: ent.TypeRolePermission, tq: q}, nil
 	case *ent.RouterMenuQuery:
 		return &query[*ent.RouterMenuQuery, predicate.RouterMenu, routermenu.OrderOption]{typ: ent.TypeRouterMenu, tq: q}, nil
+	case *ent.ScanOrderRuleQuery:
+		return &query[*ent.ScanOrderRuleQuery, predicate.ScanOrderRule, scanorderrule.OrderOption]{typ: ent.TypeScanOrderRule, tq: q}, nil
 	case *ent.SetMealDetailQuery:
 		return &query[*ent.SetMealDetailQuery, predicate.SetMealDetail, setmealdetail.OrderOption]{typ: ent.TypeSetMealDetail, tq: q}, nil
 	case *ent.SetMealGroupQuery:
@@ -1330,6 +2226,10 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.TaxFeeQuery, predicate.TaxFee, taxfee.OrderOption]{typ: ent.TypeTaxFee, tq: q}, nil
 	case *ent.UserRoleQuery:
 		return &query[*ent.UserRoleQuery, predicate.UserRole, userrole.OrderOption]{typ: ent.TypeUserRole, tq: q}, nil
+	case *ent.WaitlistRuleQuery:
+		return &query[*ent.WaitlistRuleQuery, predicate.WaitlistRule, waitlistrule.OrderOption]{typ: ent.TypeWaitlistRule, tq: q}, nil
+	case *ent.WaitlistTicketQuery:
+		return &query[*ent.WaitlistTicketQuery, predicate.WaitlistTicket, waitlistticket.OrderOption]{typ: ent.TypeWaitlistTicket, tq: q}, nil
 	default:
 		return nil, fmt.Errorf("unknown query type %T", q)
 	}
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/kitchenticket"
+)
+
+// KitchenTicket is the model entity for the KitchenTicket schema.
+type KitchenTicket struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 订单ID
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// 订单号
+	OrderNo string `json:"order_no,omitempty"`
+	// 桌台ID
+	TableID uuid.UUID `json:"table_id,omitempty"`
+	// 桌台名称
+	TableName string `json:"table_name,omitempty"`
+	// 取餐号
+	PickupNo string `json:"pickup_no,omitempty"`
+	// 下单序号
+	Batch int `json:"batch,omitempty"`
+	// 下单来源
+	Source domain.Channel `json:"source,omitempty"`
+	// 状态：held=待支付；pending=待出单；printed=已出单
+	Status domain.KitchenTicketStatus `json:"status,omitempty"`
+	// 出品商品
+	Items []domain.KitchenTicketItem `json:"items,omitempty"`
+	// 整单备注
+	Remark string `json:"remark,omitempty"`
+	// 出单时间
+	PrintedAt    *time.Time `json:"printed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KitchenTicket) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case kitchenticket.FieldItems:
+			values[i] = new([]byte)
+		case kitchenticket.FieldBatch:
+			values[i] = new(sql.NullInt64)
+		case kitchenticket.FieldOrderNo, kitchenticket.FieldTableName, kitchenticket.FieldPickupNo, kitchenticket.FieldSource, kitchenticket.FieldStatus, kitchenticket.FieldRemark:
+			values[i] = new(sql.NullString)
+		case kitchenticket.FieldCreatedAt, kitchenticket.FieldUpdatedAt, kitchenticket.FieldPrintedAt:
+			values[i] = new(sql.NullTime)
+		case kitchenticket.FieldID, kitchenticket.FieldMerchantID, kitchenticket.FieldStoreID, kitchenticket.FieldOrderID, kitchenticket.FieldTableID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KitchenTicket fields.
+func (kt *KitchenTicket) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case kitchenticket.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				kt.ID = *value
+			}
+		case kitchenticket.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				kt.CreatedAt = value.Time
+			}
+		case kitchenticket.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				kt.UpdatedAt = value.Time
+			}
+		case kitchenticket.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				kt.MerchantID = *value
+			}
+		case kitchenticket.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				kt.StoreID = *value
+			}
+		case kitchenticket.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				kt.OrderID = *value
+			}
+		case kitchenticket.FieldOrderNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_no", values[i])
+			} else if value.Valid {
+				kt.OrderNo = value.String
+			}
+		case kitchenticket.FieldTableID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field table_id", values[i])
+			} else if value != nil {
+				kt.TableID = *value
+			}
+		case kitchenticket.FieldTableName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field table_name", values[i])
+			} else if value.Valid {
+				kt.TableName = value.String
+			}
+		case kitchenticket.FieldPickupNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pickup_no", values[i])
+			} else if value.Valid {
+				kt.PickupNo = value.String
+			}
+		case kitchenticket.FieldBatch:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch", values[i])
+			} else if value.Valid {
+				kt.Batch = int(value.Int64)
+			}
+		case kitchenticket.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				kt.Source = domain.Channel(value.String)
+			}
+		case kitchenticket.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				kt.Status = domain.KitchenTicketStatus(value.String)
+			}
+		case kitchenticket.FieldItems:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field items", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &kt.Items); err != nil {
+					return fmt.Errorf("unmarshal field items: %w", err)
+				}
+			}
+		case kitchenticket.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				kt.Remark = value.String
+			}
+		case kitchenticket.FieldPrintedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field printed_at", values[i])
+			} else if value.Valid {
+				kt.PrintedAt = new(time.Time)
+				*kt.PrintedAt = value.Time
+			}
+		default:
+			kt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KitchenTicket.
+// This includes values selected through modifiers, order, etc.
+func (kt *KitchenTicket) Value(name string) (ent.Value, error) {
+	return kt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this KitchenTicket.
+// Note that you need to call KitchenTicket.Unwrap() before calling this method if this KitchenTicket
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (kt *KitchenTicket) Update() *KitchenTicketUpdateOne {
+	return NewKitchenTicketClient(kt.config).UpdateOne(kt)
+}
+
+// Unwrap unwraps the KitchenTicket entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (kt *KitchenTicket) Unwrap() *KitchenTicket {
+	_tx, ok := kt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KitchenTicket is not a transactional entity")
+	}
+	kt.config.driver = _tx.drv
+	return kt
+}
+
+// String implements the fmt.Stringer.
+func (kt *KitchenTicket) String() string {
+	var builder strings.Builder
+	builder.WriteString("KitchenTicket(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", kt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(kt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(kt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", kt.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", kt.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", kt.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("order_no=")
+	builder.WriteString(kt.OrderNo)
+	builder.WriteString(", ")
+	builder.WriteString("table_id=")
+	builder.WriteString(fmt.Sprintf("%v", kt.TableID))
+	builder.WriteString(", ")
+	builder.WriteString("table_name=")
+	builder.WriteString(kt.TableName)
+	builder.WriteString(", ")
+	builder.WriteString("pickup_no=")
+	builder.WriteString(kt.PickupNo)
+	builder.WriteString(", ")
+	builder.WriteString("batch=")
+	builder.WriteString(fmt.Sprintf("%v", kt.Batch))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", kt.Source))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", kt.Status))
+	builder.WriteString(", ")
+	builder.WriteString("items=")
+	builder.WriteString(fmt.Sprintf("%v", kt.Items))
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(kt.Remark)
+	builder.WriteString(", ")
+	if v := kt.PrintedAt; v != nil {
+		builder.WriteString("printed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KitchenTickets is a parsable slice of KitchenTicket.
+type KitchenTickets []*KitchenTicket
@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kitchenticket
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	// Label holds the string label denoting the kitchenticket type in the database.
+	Label = "kitchen_ticket"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldOrderNo holds the string denoting the order_no field in the database.
+	FieldOrderNo = "order_no"
+	// FieldTableID holds the string denoting the table_id field in the database.
+	FieldTableID = "table_id"
+	// FieldTableName holds the string denoting the table_name field in the database.
+	FieldTableName = "table_name"
+	// FieldPickupNo holds the string denoting the pickup_no field in the database.
+	FieldPickupNo = "pickup_no"
+	// FieldBatch holds the string denoting the batch field in the database.
+	FieldBatch = "batch"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldItems holds the string denoting the items field in the database.
+	FieldItems = "items"
+	// FieldRemark holds the string denoting the remark field in the database.
+	FieldRemark = "remark"
+	// FieldPrintedAt holds the string denoting the printed_at field in the database.
+	FieldPrintedAt = "printed_at"
+	// Table holds the table name of the kitchenticket in the database.
+	Table = "kitchen_tickets"
+)
+
+// Columns holds all SQL columns for kitchenticket fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldOrderID,
+	FieldOrderNo,
+	FieldTableID,
+	FieldTableName,
+	FieldPickupNo,
+	FieldBatch,
+	FieldSource,
+	FieldStatus,
+	FieldItems,
+	FieldRemark,
+	FieldPrintedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultOrderNo holds the default value on creation for the "order_no" field.
+	DefaultOrderNo string
+	// OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	OrderNoValidator func(string) error
+	// DefaultTableName holds the default value on creation for the "table_name" field.
+	DefaultTableName string
+	// TableNameValidator is a validator for the "table_name" field. It is called by the builders before save.
+	TableNameValidator func(string) error
+	// DefaultPickupNo holds the default value on creation for the "pickup_no" field.
+	DefaultPickupNo string
+	// PickupNoValidator is a validator for the "pickup_no" field. It is called by the builders before save.
+	PickupNoValidator func(string) error
+	// DefaultBatch holds the default value on creation for the "batch" field.
+	DefaultBatch int
+	// DefaultRemark holds the default value on creation for the "remark" field.
+	DefaultRemark string
+	// RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	RemarkValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s domain.Channel) error {
+	switch s {
+	case "POS", "H5", "APP", "KIOSK", "DELIVERY":
+		return nil
+	default:
+		return fmt.Errorf("kitchenticket: invalid enum value for source field: %q", s)
+	}
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s domain.KitchenTicketStatus) error {
+	switch s {
+	case "held", "pending", "printed":
+		return nil
+	default:
+		return fmt.Errorf("kitchenticket: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the KitchenTicket queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByOrderNo orders the results by the order_no field.
+func ByOrderNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderNo, opts...).ToFunc()
+}
+
+// ByTableID orders the results by the table_id field.
+func ByTableID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTableID, opts...).ToFunc()
+}
+
+// ByTableName orders the results by the table_name field.
+func ByTableName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTableName, opts...).ToFunc()
+}
+
+// ByPickupNo orders the results by the pickup_no field.
+func ByPickupNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPickupNo, opts...).ToFunc()
+}
+
+// ByBatch orders the results by the batch field.
+func ByBatch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatch, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRemark orders the results by the remark field.
+func ByRemark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemark, opts...).ToFunc()
+}
+
+// ByPrintedAt orders the results by the printed_at field.
+func ByPrintedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrintedAt, opts...).ToFunc()
+}
@@ -0,0 +1,782 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kitchenticket
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldStoreID, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderNo applies equality check predicate on the "order_no" field. It's identical to OrderNoEQ.
+func OrderNo(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// TableID applies equality check predicate on the "table_id" field. It's identical to TableIDEQ.
+func TableID(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldTableID, v))
+}
+
+// TableName applies equality check predicate on the "table_name" field. It's identical to TableNameEQ.
+func TableName(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldTableName, v))
+}
+
+// PickupNo applies equality check predicate on the "pickup_no" field. It's identical to PickupNoEQ.
+func PickupNo(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldPickupNo, v))
+}
+
+// Batch applies equality check predicate on the "batch" field. It's identical to BatchEQ.
+func Batch(v int) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldBatch, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldRemark, v))
+}
+
+// PrintedAt applies equality check predicate on the "printed_at" field. It's identical to PrintedAtEQ.
+func PrintedAt(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldPrintedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldStoreID, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderNoEQ applies the EQ predicate on the "order_no" field.
+func OrderNoEQ(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// OrderNoNEQ applies the NEQ predicate on the "order_no" field.
+func OrderNoNEQ(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldOrderNo, v))
+}
+
+// OrderNoIn applies the In predicate on the "order_no" field.
+func OrderNoIn(vs ...string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldOrderNo, vs...))
+}
+
+// OrderNoNotIn applies the NotIn predicate on the "order_no" field.
+func OrderNoNotIn(vs ...string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldOrderNo, vs...))
+}
+
+// OrderNoGT applies the GT predicate on the "order_no" field.
+func OrderNoGT(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldOrderNo, v))
+}
+
+// OrderNoGTE applies the GTE predicate on the "order_no" field.
+func OrderNoGTE(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldOrderNo, v))
+}
+
+// OrderNoLT applies the LT predicate on the "order_no" field.
+func OrderNoLT(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldOrderNo, v))
+}
+
+// OrderNoLTE applies the LTE predicate on the "order_no" field.
+func OrderNoLTE(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldOrderNo, v))
+}
+
+// OrderNoContains applies the Contains predicate on the "order_no" field.
+func OrderNoContains(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldContains(FieldOrderNo, v))
+}
+
+// OrderNoHasPrefix applies the HasPrefix predicate on the "order_no" field.
+func OrderNoHasPrefix(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldHasPrefix(FieldOrderNo, v))
+}
+
+// OrderNoHasSuffix applies the HasSuffix predicate on the "order_no" field.
+func OrderNoHasSuffix(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldHasSuffix(FieldOrderNo, v))
+}
+
+// OrderNoEqualFold applies the EqualFold predicate on the "order_no" field.
+func OrderNoEqualFold(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEqualFold(FieldOrderNo, v))
+}
+
+// OrderNoContainsFold applies the ContainsFold predicate on the "order_no" field.
+func OrderNoContainsFold(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldContainsFold(FieldOrderNo, v))
+}
+
+// TableIDEQ applies the EQ predicate on the "table_id" field.
+func TableIDEQ(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldTableID, v))
+}
+
+// TableIDNEQ applies the NEQ predicate on the "table_id" field.
+func TableIDNEQ(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldTableID, v))
+}
+
+// TableIDIn applies the In predicate on the "table_id" field.
+func TableIDIn(vs ...uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldTableID, vs...))
+}
+
+// TableIDNotIn applies the NotIn predicate on the "table_id" field.
+func TableIDNotIn(vs ...uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldTableID, vs...))
+}
+
+// TableIDGT applies the GT predicate on the "table_id" field.
+func TableIDGT(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldTableID, v))
+}
+
+// TableIDGTE applies the GTE predicate on the "table_id" field.
+func TableIDGTE(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldTableID, v))
+}
+
+// TableIDLT applies the LT predicate on the "table_id" field.
+func TableIDLT(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldTableID, v))
+}
+
+// TableIDLTE applies the LTE predicate on the "table_id" field.
+func TableIDLTE(v uuid.UUID) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldTableID, v))
+}
+
+// TableNameEQ applies the EQ predicate on the "table_name" field.
+func TableNameEQ(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldTableName, v))
+}
+
+// TableNameNEQ applies the NEQ predicate on the "table_name" field.
+func TableNameNEQ(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldTableName, v))
+}
+
+// TableNameIn applies the In predicate on the "table_name" field.
+func TableNameIn(vs ...string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldTableName, vs...))
+}
+
+// TableNameNotIn applies the NotIn predicate on the "table_name" field.
+func TableNameNotIn(vs ...string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldTableName, vs...))
+}
+
+// TableNameGT applies the GT predicate on the "table_name" field.
+func TableNameGT(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldTableName, v))
+}
+
+// TableNameGTE applies the GTE predicate on the "table_name" field.
+func TableNameGTE(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldTableName, v))
+}
+
+// TableNameLT applies the LT predicate on the "table_name" field.
+func TableNameLT(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldTableName, v))
+}
+
+// TableNameLTE applies the LTE predicate on the "table_name" field.
+func TableNameLTE(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldTableName, v))
+}
+
+// TableNameContains applies the Contains predicate on the "table_name" field.
+func TableNameContains(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldContains(FieldTableName, v))
+}
+
+// TableNameHasPrefix applies the HasPrefix predicate on the "table_name" field.
+func TableNameHasPrefix(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldHasPrefix(FieldTableName, v))
+}
+
+// TableNameHasSuffix applies the HasSuffix predicate on the "table_name" field.
+func TableNameHasSuffix(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldHasSuffix(FieldTableName, v))
+}
+
+// TableNameEqualFold applies the EqualFold predicate on the "table_name" field.
+func TableNameEqualFold(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEqualFold(FieldTableName, v))
+}
+
+// TableNameContainsFold applies the ContainsFold predicate on the "table_name" field.
+func TableNameContainsFold(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldContainsFold(FieldTableName, v))
+}
+
+// PickupNoEQ applies the EQ predicate on the "pickup_no" field.
+func PickupNoEQ(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldPickupNo, v))
+}
+
+// PickupNoNEQ applies the NEQ predicate on the "pickup_no" field.
+func PickupNoNEQ(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldPickupNo, v))
+}
+
+// PickupNoIn applies the In predicate on the "pickup_no" field.
+func PickupNoIn(vs ...string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldPickupNo, vs...))
+}
+
+// PickupNoNotIn applies the NotIn predicate on the "pickup_no" field.
+func PickupNoNotIn(vs ...string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldPickupNo, vs...))
+}
+
+// PickupNoGT applies the GT predicate on the "pickup_no" field.
+func PickupNoGT(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldPickupNo, v))
+}
+
+// PickupNoGTE applies the GTE predicate on the "pickup_no" field.
+func PickupNoGTE(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldPickupNo, v))
+}
+
+// PickupNoLT applies the LT predicate on the "pickup_no" field.
+func PickupNoLT(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldPickupNo, v))
+}
+
+// PickupNoLTE applies the LTE predicate on the "pickup_no" field.
+func PickupNoLTE(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldPickupNo, v))
+}
+
+// PickupNoContains applies the Contains predicate on the "pickup_no" field.
+func PickupNoContains(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldContains(FieldPickupNo, v))
+}
+
+// PickupNoHasPrefix applies the HasPrefix predicate on the "pickup_no" field.
+func PickupNoHasPrefix(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldHasPrefix(FieldPickupNo, v))
+}
+
+// PickupNoHasSuffix applies the HasSuffix predicate on the "pickup_no" field.
+func PickupNoHasSuffix(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldHasSuffix(FieldPickupNo, v))
+}
+
+// PickupNoEqualFold applies the EqualFold predicate on the "pickup_no" field.
+func PickupNoEqualFold(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEqualFold(FieldPickupNo, v))
+}
+
+// PickupNoContainsFold applies the ContainsFold predicate on the "pickup_no" field.
+func PickupNoContainsFold(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldContainsFold(FieldPickupNo, v))
+}
+
+// BatchEQ applies the EQ predicate on the "batch" field.
+func BatchEQ(v int) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldBatch, v))
+}
+
+// BatchNEQ applies the NEQ predicate on the "batch" field.
+func BatchNEQ(v int) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldBatch, v))
+}
+
+// BatchIn applies the In predicate on the "batch" field.
+func BatchIn(vs ...int) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldBatch, vs...))
+}
+
+// BatchNotIn applies the NotIn predicate on the "batch" field.
+func BatchNotIn(vs ...int) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldBatch, vs...))
+}
+
+// BatchGT applies the GT predicate on the "batch" field.
+func BatchGT(v int) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldBatch, v))
+}
+
+// BatchGTE applies the GTE predicate on the "batch" field.
+func BatchGTE(v int) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldBatch, v))
+}
+
+// BatchLT applies the LT predicate on the "batch" field.
+func BatchLT(v int) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldBatch, v))
+}
+
+// BatchLTE applies the LTE predicate on the "batch" field.
+func BatchLTE(v int) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldBatch, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v domain.Channel) predicate.KitchenTicket {
+	vc := v
+	return predicate.KitchenTicket(sql.FieldEQ(FieldSource, vc))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v domain.Channel) predicate.KitchenTicket {
+	vc := v
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldSource, vc))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...domain.Channel) predicate.KitchenTicket {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.KitchenTicket(sql.FieldIn(FieldSource, v...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...domain.Channel) predicate.KitchenTicket {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldSource, v...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v domain.KitchenTicketStatus) predicate.KitchenTicket {
+	vc := v
+	return predicate.KitchenTicket(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v domain.KitchenTicketStatus) predicate.KitchenTicket {
+	vc := v
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...domain.KitchenTicketStatus) predicate.KitchenTicket {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.KitchenTicket(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...domain.KitchenTicketStatus) predicate.KitchenTicket {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// PrintedAtEQ applies the EQ predicate on the "printed_at" field.
+func PrintedAtEQ(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldEQ(FieldPrintedAt, v))
+}
+
+// PrintedAtNEQ applies the NEQ predicate on the "printed_at" field.
+func PrintedAtNEQ(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNEQ(FieldPrintedAt, v))
+}
+
+// PrintedAtIn applies the In predicate on the "printed_at" field.
+func PrintedAtIn(vs ...time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIn(FieldPrintedAt, vs...))
+}
+
+// PrintedAtNotIn applies the NotIn predicate on the "printed_at" field.
+func PrintedAtNotIn(vs ...time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotIn(FieldPrintedAt, vs...))
+}
+
+// PrintedAtGT applies the GT predicate on the "printed_at" field.
+func PrintedAtGT(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGT(FieldPrintedAt, v))
+}
+
+// PrintedAtGTE applies the GTE predicate on the "printed_at" field.
+func PrintedAtGTE(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldGTE(FieldPrintedAt, v))
+}
+
+// PrintedAtLT applies the LT predicate on the "printed_at" field.
+func PrintedAtLT(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLT(FieldPrintedAt, v))
+}
+
+// PrintedAtLTE applies the LTE predicate on the "printed_at" field.
+func PrintedAtLTE(v time.Time) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldLTE(FieldPrintedAt, v))
+}
+
+// PrintedAtIsNil applies the IsNil predicate on the "printed_at" field.
+func PrintedAtIsNil() predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldIsNull(FieldPrintedAt))
+}
+
+// PrintedAtNotNil applies the NotNil predicate on the "printed_at" field.
+func PrintedAtNotNil() predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.FieldNotNull(FieldPrintedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KitchenTicket) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KitchenTicket) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KitchenTicket) predicate.KitchenTicket {
+	return predicate.KitchenTicket(sql.NotPredicates(p))
+}
@@ -0,0 +1,983 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/kitchenticket"
+)
+
+// KitchenTicketCreate is the builder for creating a KitchenTicket entity.
+type KitchenTicketCreate struct {
+	config
+	mutation *KitchenTicketMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ktc *KitchenTicketCreate) SetCreatedAt(t time.Time) *KitchenTicketCreate {
+	ktc.mutation.SetCreatedAt(t)
+	return ktc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ktc *KitchenTicketCreate) SetNillableCreatedAt(t *time.Time) *KitchenTicketCreate {
+	if t != nil {
+		ktc.SetCreatedAt(*t)
+	}
+	return ktc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ktc *KitchenTicketCreate) SetUpdatedAt(t time.Time) *KitchenTicketCreate {
+	ktc.mutation.SetUpdatedAt(t)
+	return ktc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ktc *KitchenTicketCreate) SetNillableUpdatedAt(t *time.Time) *KitchenTicketCreate {
+	if t != nil {
+		ktc.SetUpdatedAt(*t)
+	}
+	return ktc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (ktc *KitchenTicketCreate) SetMerchantID(u uuid.UUID) *KitchenTicketCreate {
+	ktc.mutation.SetMerchantID(u)
+	return ktc
+}
+
+// SetStoreID sets the "store_id" field.
+func (ktc *KitchenTicketCreate) SetStoreID(u uuid.UUID) *KitchenTicketCreate {
+	ktc.mutation.SetStoreID(u)
+	return ktc
+}
+
+// SetOrderID sets the "order_id" field.
+func (ktc *KitchenTicketCreate) SetOrderID(u uuid.UUID) *KitchenTicketCreate {
+	ktc.mutation.SetOrderID(u)
+	return ktc
+}
+
+// SetOrderNo sets the "order_no" field.
+func (ktc *KitchenTicketCreate) SetOrderNo(s string) *KitchenTicketCreate {
+	ktc.mutation.SetOrderNo(s)
+	return ktc
+}
+
+// SetNillableOrderNo sets the "order_no" field if the given value is not nil.
+func (ktc *KitchenTicketCreate) SetNillableOrderNo(s *string) *KitchenTicketCreate {
+	if s != nil {
+		ktc.SetOrderNo(*s)
+	}
+	return ktc
+}
+
+// SetTableID sets the "table_id" field.
+func (ktc *KitchenTicketCreate) SetTableID(u uuid.UUID) *KitchenTicketCreate {
+	ktc.mutation.SetTableID(u)
+	return ktc
+}
+
+// SetTableName sets the "table_name" field.
+func (ktc *KitchenTicketCreate) SetTableName(s string) *KitchenTicketCreate {
+	ktc.mutation.SetTableName(s)
+	return ktc
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (ktc *KitchenTicketCreate) SetNillableTableName(s *string) *KitchenTicketCreate {
+	if s != nil {
+		ktc.SetTableName(*s)
+	}
+	return ktc
+}
+
+// SetPickupNo sets the "pickup_no" field.
+func (ktc *KitchenTicketCreate) SetPickupNo(s string) *KitchenTicketCreate {
+	ktc.mutation.SetPickupNo(s)
+	return ktc
+}
+
+// SetNillablePickupNo sets the "pickup_no" field if the given value is not nil.
+func (ktc *KitchenTicketCreate) SetNillablePickupNo(s *string) *KitchenTicketCreate {
+	if s != nil {
+		ktc.SetPickupNo(*s)
+	}
+	return ktc
+}
+
+// SetBatch sets the "batch" field.
+func (ktc *KitchenTicketCreate) SetBatch(i int) *KitchenTicketCreate {
+	ktc.mutation.SetBatch(i)
+	return ktc
+}
+
+// SetNillableBatch sets the "batch" field if the given value is not nil.
+func (ktc *KitchenTicketCreate) SetNillableBatch(i *int) *KitchenTicketCreate {
+	if i != nil {
+		ktc.SetBatch(*i)
+	}
+	return ktc
+}
+
+// SetSource sets the "source" field.
+func (ktc *KitchenTicketCreate) SetSource(d domain.Channel) *KitchenTicketCreate {
+	ktc.mutation.SetSource(d)
+	return ktc
+}
+
+// SetStatus sets the "status" field.
+func (ktc *KitchenTicketCreate) SetStatus(dts domain.KitchenTicketStatus) *KitchenTicketCreate {
+	ktc.mutation.SetStatus(dts)
+	return ktc
+}
+
+// SetItems sets the "items" field.
+func (ktc *KitchenTicketCreate) SetItems(dti []domain.KitchenTicketItem) *KitchenTicketCreate {
+	ktc.mutation.SetItems(dti)
+	return ktc
+}
+
+// SetRemark sets the "remark" field.
+func (ktc *KitchenTicketCreate) SetRemark(s string) *KitchenTicketCreate {
+	ktc.mutation.SetRemark(s)
+	return ktc
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (ktc *KitchenTicketCreate) SetNillableRemark(s *string) *KitchenTicketCreate {
+	if s != nil {
+		ktc.SetRemark(*s)
+	}
+	return ktc
+}
+
+// SetPrintedAt sets the "printed_at" field.
+func (ktc *KitchenTicketCreate) SetPrintedAt(t time.Time) *KitchenTicketCreate {
+	ktc.mutation.SetPrintedAt(t)
+	return ktc
+}
+
+// SetNillablePrintedAt sets the "printed_at" field if the given value is not nil.
+func (ktc *KitchenTicketCreate) SetNillablePrintedAt(t *time.Time) *KitchenTicketCreate {
+	if t != nil {
+		ktc.SetPrintedAt(*t)
+	}
+	return ktc
+}
+
+// SetID sets the "id" field.
+func (ktc *KitchenTicketCreate) SetID(u uuid.UUID) *KitchenTicketCreate {
+	ktc.mutation.SetID(u)
+	return ktc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ktc *KitchenTicketCreate) SetNillableID(u *uuid.UUID) *KitchenTicketCreate {
+	if u != nil {
+		ktc.SetID(*u)
+	}
+	return ktc
+}
+
+// Mutation returns the KitchenTicketMutation object of the builder.
+func (ktc *KitchenTicketCreate) Mutation() *KitchenTicketMutation {
+	return ktc.mutation
+}
+
+// Save creates the KitchenTicket in the database.
+func (ktc *KitchenTicketCreate) Save(ctx context.Context) (*KitchenTicket, error) {
+	ktc.defaults()
+	return withHooks(ctx, ktc.sqlSave, ktc.mutation, ktc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ktc *KitchenTicketCreate) SaveX(ctx context.Context) *KitchenTicket {
+	v, err := ktc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ktc *KitchenTicketCreate) Exec(ctx context.Context) error {
+	_, err := ktc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ktc *KitchenTicketCreate) ExecX(ctx context.Context) {
+	if err := ktc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ktc *KitchenTicketCreate) defaults() {
+	if _, ok := ktc.mutation.CreatedAt(); !ok {
+		v := kitchenticket.DefaultCreatedAt()
+		ktc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ktc.mutation.UpdatedAt(); !ok {
+		v := kitchenticket.DefaultUpdatedAt()
+		ktc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ktc.mutation.OrderNo(); !ok {
+		v := kitchenticket.DefaultOrderNo
+		ktc.mutation.SetOrderNo(v)
+	}
+	if _, ok := ktc.mutation.TableName(); !ok {
+		v := kitchenticket.DefaultTableName
+		ktc.mutation.SetTableName(v)
+	}
+	if _, ok := ktc.mutation.PickupNo(); !ok {
+		v := kitchenticket.DefaultPickupNo
+		ktc.mutation.SetPickupNo(v)
+	}
+	if _, ok := ktc.mutation.Batch(); !ok {
+		v := kitchenticket.DefaultBatch
+		ktc.mutation.SetBatch(v)
+	}
+	if _, ok := ktc.mutation.Remark(); !ok {
+		v := kitchenticket.DefaultRemark
+		ktc.mutation.SetRemark(v)
+	}
+	if _, ok := ktc.mutation.ID(); !ok {
+		v := kitchenticket.DefaultID()
+		ktc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ktc *KitchenTicketCreate) check() error {
+	if _, ok := ktc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "KitchenTicket.created_at"`)}
+	}
+	if _, ok := ktc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "KitchenTicket.updated_at"`)}
+	}
+	if _, ok := ktc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "KitchenTicket.merchant_id"`)}
+	}
+	if _, ok := ktc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "KitchenTicket.store_id"`)}
+	}
+	if _, ok := ktc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "KitchenTicket.order_id"`)}
+	}
+	if _, ok := ktc.mutation.OrderNo(); !ok {
+		return &ValidationError{Name: "order_no", err: errors.New(`ent: missing required field "KitchenTicket.order_no"`)}
+	}
+	if v, ok := ktc.mutation.OrderNo(); ok {
+		if err := kitchenticket.OrderNoValidator(v); err != nil {
+			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "KitchenTicket.order_no": %w`, err)}
+		}
+	}
+	if _, ok := ktc.mutation.TableID(); !ok {
+		return &ValidationError{Name: "table_id", err: errors.New(`ent: missing required field "KitchenTicket.table_id"`)}
+	}
+	if _, ok := ktc.mutation.TableName(); !ok {
+		return &ValidationError{Name: "table_name", err: errors.New(`ent: missing required field "KitchenTicket.table_name"`)}
+	}
+	if v, ok := ktc.mutation.TableName(); ok {
+		if err := kitchenticket.TableNameValidator(v); err != nil {
+			return &ValidationError{Name: "table_name", err: fmt.Errorf(`ent: validator failed for field "KitchenTicket.table_name": %w`, err)}
+		}
+	}
+	if _, ok := ktc.mutation.PickupNo(); !ok {
+		return &ValidationError{Name: "pickup_no", err: errors.New(`ent: missing required field "KitchenTicket.pickup_no"`)}
+	}
+	if v, ok := ktc.mutation.PickupNo(); ok {
+		if err := kitchenticket.PickupNoValidator(v); err != nil {
+			return &ValidationError{Name: "pickup_no", err: fmt.Errorf(`ent: validator failed for field "KitchenTicket.pickup_no": %w`, err)}
+		}
+	}
+	if _, ok := ktc.mutation.Batch(); !ok {
+		return &ValidationError{Name: "batch", err: errors.New(`ent: missing required field "KitchenTicket.batch"`)}
+	}
+	if _, ok := ktc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "KitchenTicket.source"`)}
+	}
+	if v, ok := ktc.mutation.Source(); ok {
+		if err := kitchenticket.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "KitchenTicket.source": %w`, err)}
+		}
+	}
+	if _, ok := ktc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "KitchenTicket.status"`)}
+	}
+	if v, ok := ktc.mutation.Status(); ok {
+		if err := kitchenticket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KitchenTicket.status": %w`, err)}
+		}
+	}
+	if _, ok := ktc.mutation.Items(); !ok {
+		return &ValidationError{Name: "items", err: errors.New(`ent: missing required field "KitchenTicket.items"`)}
+	}
+	if _, ok := ktc.mutation.Remark(); !ok {
+		return &ValidationError{Name: "remark", err: errors.New(`ent: missing required field "KitchenTicket.remark"`)}
+	}
+	if v, ok := ktc.mutation.Remark(); ok {
+		if err := kitchenticket.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "KitchenTicket.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ktc *KitchenTicketCreate) sqlSave(ctx context.Context) (*KitchenTicket, error) {
+	if err := ktc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ktc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ktc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ktc.mutation.id = &_node.ID
+	ktc.mutation.done = true
+	return _node, nil
+}
+
+func (ktc *KitchenTicketCreate) createSpec() (*KitchenTicket, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KitchenTicket{config: ktc.config}
+		_spec = sqlgraph.NewCreateSpec(kitchenticket.Table, sqlgraph.NewFieldSpec(kitchenticket.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ktc.conflict
+	if id, ok := ktc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ktc.mutation.CreatedAt(); ok {
+		_spec.SetField(kitchenticket.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ktc.mutation.UpdatedAt(); ok {
+		_spec.SetField(kitchenticket.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ktc.mutation.MerchantID(); ok {
+		_spec.SetField(kitchenticket.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := ktc.mutation.StoreID(); ok {
+		_spec.SetField(kitchenticket.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := ktc.mutation.OrderID(); ok {
+		_spec.SetField(kitchenticket.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := ktc.mutation.OrderNo(); ok {
+		_spec.SetField(kitchenticket.FieldOrderNo, field.TypeString, value)
+		_node.OrderNo = value
+	}
+	if value, ok := ktc.mutation.TableID(); ok {
+		_spec.SetField(kitchenticket.FieldTableID, field.TypeUUID, value)
+		_node.TableID = value
+	}
+	if value, ok := ktc.mutation.TableName(); ok {
+		_spec.SetField(kitchenticket.FieldTableName, field.TypeString, value)
+		_node.TableName = value
+	}
+	if value, ok := ktc.mutation.PickupNo(); ok {
+		_spec.SetField(kitchenticket.FieldPickupNo, field.TypeString, value)
+		_node.PickupNo = value
+	}
+	if value, ok := ktc.mutation.Batch(); ok {
+		_spec.SetField(kitchenticket.FieldBatch, field.TypeInt, value)
+		_node.Batch = value
+	}
+	if value, ok := ktc.mutation.Source(); ok {
+		_spec.SetField(kitchenticket.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := ktc.mutation.Status(); ok {
+		_spec.SetField(kitchenticket.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ktc.mutation.Items(); ok {
+		_spec.SetField(kitchenticket.FieldItems, field.TypeJSON, value)
+		_node.Items = value
+	}
+	if value, ok := ktc.mutation.Remark(); ok {
+		_spec.SetField(kitchenticket.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	if value, ok := ktc.mutation.PrintedAt(); ok {
+		_spec.SetField(kitchenticket.FieldPrintedAt, field.TypeTime, value)
+		_node.PrintedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KitchenTicket.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KitchenTicketUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ktc *KitchenTicketCreate) OnConflict(opts ...sql.ConflictOption) *KitchenTicketUpsertOne {
+	ktc.conflict = opts
+	return &KitchenTicketUpsertOne{
+		create: ktc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KitchenTicket.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ktc *KitchenTicketCreate) OnConflictColumns(columns ...string) *KitchenTicketUpsertOne {
+	ktc.conflict = append(ktc.conflict, sql.ConflictColumns(columns...))
+	return &KitchenTicketUpsertOne{
+		create: ktc,
+	}
+}
+
+type (
+	// KitchenTicketUpsertOne is the builder for "upsert"-ing
+	//  one KitchenTicket node.
+	KitchenTicketUpsertOne struct {
+		create *KitchenTicketCreate
+	}
+
+	// KitchenTicketUpsert is the "OnConflict" setter.
+	KitchenTicketUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *KitchenTicketUpsert) SetUpdatedAt(v time.Time) *KitchenTicketUpsert {
+	u.Set(kitchenticket.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *KitchenTicketUpsert) UpdateUpdatedAt() *KitchenTicketUpsert {
+	u.SetExcluded(kitchenticket.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *KitchenTicketUpsert) SetStatus(v domain.KitchenTicketStatus) *KitchenTicketUpsert {
+	u.Set(kitchenticket.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *KitchenTicketUpsert) UpdateStatus() *KitchenTicketUpsert {
+	u.SetExcluded(kitchenticket.FieldStatus)
+	return u
+}
+
+// SetPrintedAt sets the "printed_at" field.
+func (u *KitchenTicketUpsert) SetPrintedAt(v time.Time) *KitchenTicketUpsert {
+	u.Set(kitchenticket.FieldPrintedAt, v)
+	return u
+}
+
+// UpdatePrintedAt sets the "printed_at" field to the value that was provided on create.
+func (u *KitchenTicketUpsert) UpdatePrintedAt() *KitchenTicketUpsert {
+	u.SetExcluded(kitchenticket.FieldPrintedAt)
+	return u
+}
+
+// ClearPrintedAt clears the value of the "printed_at" field.
+func (u *KitchenTicketUpsert) ClearPrintedAt() *KitchenTicketUpsert {
+	u.SetNull(kitchenticket.FieldPrintedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.KitchenTicket.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(kitchenticket.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *KitchenTicketUpsertOne) UpdateNewValues() *KitchenTicketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(kitchenticket.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(kitchenticket.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(kitchenticket.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(kitchenticket.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(kitchenticket.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.OrderNo(); exists {
+			s.SetIgnore(kitchenticket.FieldOrderNo)
+		}
+		if _, exists := u.create.mutation.TableID(); exists {
+			s.SetIgnore(kitchenticket.FieldTableID)
+		}
+		if _, exists := u.create.mutation.TableName(); exists {
+			s.SetIgnore(kitchenticket.FieldTableName)
+		}
+		if _, exists := u.create.mutation.PickupNo(); exists {
+			s.SetIgnore(kitchenticket.FieldPickupNo)
+		}
+		if _, exists := u.create.mutation.Batch(); exists {
+			s.SetIgnore(kitchenticket.FieldBatch)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(kitchenticket.FieldSource)
+		}
+		if _, exists := u.create.mutation.Items(); exists {
+			s.SetIgnore(kitchenticket.FieldItems)
+		}
+		if _, exists := u.create.mutation.Remark(); exists {
+			s.SetIgnore(kitchenticket.FieldRemark)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KitchenTicket.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *KitchenTicketUpsertOne) Ignore() *KitchenTicketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KitchenTicketUpsertOne) DoNothing() *KitchenTicketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KitchenTicketCreate.OnConflict
+// documentation for more info.
+func (u *KitchenTicketUpsertOne) Update(set func(*KitchenTicketUpsert)) *KitchenTicketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KitchenTicketUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *KitchenTicketUpsertOne) SetUpdatedAt(v time.Time) *KitchenTicketUpsertOne {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *KitchenTicketUpsertOne) UpdateUpdatedAt() *KitchenTicketUpsertOne {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *KitchenTicketUpsertOne) SetStatus(v domain.KitchenTicketStatus) *KitchenTicketUpsertOne {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *KitchenTicketUpsertOne) UpdateStatus() *KitchenTicketUpsertOne {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPrintedAt sets the "printed_at" field.
+func (u *KitchenTicketUpsertOne) SetPrintedAt(v time.Time) *KitchenTicketUpsertOne {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.SetPrintedAt(v)
+	})
+}
+
+// UpdatePrintedAt sets the "printed_at" field to the value that was provided on create.
+func (u *KitchenTicketUpsertOne) UpdatePrintedAt() *KitchenTicketUpsertOne {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.UpdatePrintedAt()
+	})
+}
+
+// ClearPrintedAt clears the value of the "printed_at" field.
+func (u *KitchenTicketUpsertOne) ClearPrintedAt() *KitchenTicketUpsertOne {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.ClearPrintedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *KitchenTicketUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KitchenTicketCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KitchenTicketUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *KitchenTicketUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: KitchenTicketUpsertOne.ID is not supported by MySQL driver. Use KitchenTicketUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *KitchenTicketUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// KitchenTicketCreateBulk is the builder for creating many KitchenTicket entities in bulk.
+type KitchenTicketCreateBulk struct {
+	config
+	err      error
+	builders []*KitchenTicketCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the KitchenTicket entities in the database.
+func (ktcb *KitchenTicketCreateBulk) Save(ctx context.Context) ([]*KitchenTicket, error) {
+	if ktcb.err != nil {
+		return nil, ktcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ktcb.builders))
+	nodes := make([]*KitchenTicket, len(ktcb.builders))
+	mutators := make([]Mutator, len(ktcb.builders))
+	for i := range ktcb.builders {
+		func(i int, root context.Context) {
+			builder := ktcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KitchenTicketMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ktcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ktcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ktcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ktcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ktcb *KitchenTicketCreateBulk) SaveX(ctx context.Context) []*KitchenTicket {
+	v, err := ktcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ktcb *KitchenTicketCreateBulk) Exec(ctx context.Context) error {
+	_, err := ktcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ktcb *KitchenTicketCreateBulk) ExecX(ctx context.Context) {
+	if err := ktcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KitchenTicket.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KitchenTicketUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ktcb *KitchenTicketCreateBulk) OnConflict(opts ...sql.ConflictOption) *KitchenTicketUpsertBulk {
+	ktcb.conflict = opts
+	return &KitchenTicketUpsertBulk{
+		create: ktcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KitchenTicket.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ktcb *KitchenTicketCreateBulk) OnConflictColumns(columns ...string) *KitchenTicketUpsertBulk {
+	ktcb.conflict = append(ktcb.conflict, sql.ConflictColumns(columns...))
+	return &KitchenTicketUpsertBulk{
+		create: ktcb,
+	}
+}
+
+// KitchenTicketUpsertBulk is the builder for "upsert"-ing
+// a bulk of KitchenTicket nodes.
+type KitchenTicketUpsertBulk struct {
+	create *KitchenTicketCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.KitchenTicket.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(kitchenticket.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *KitchenTicketUpsertBulk) UpdateNewValues() *KitchenTicketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(kitchenticket.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(kitchenticket.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(kitchenticket.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(kitchenticket.FieldStoreID)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(kitchenticket.FieldOrderID)
+			}
+			if _, exists := b.mutation.OrderNo(); exists {
+				s.SetIgnore(kitchenticket.FieldOrderNo)
+			}
+			if _, exists := b.mutation.TableID(); exists {
+				s.SetIgnore(kitchenticket.FieldTableID)
+			}
+			if _, exists := b.mutation.TableName(); exists {
+				s.SetIgnore(kitchenticket.FieldTableName)
+			}
+			if _, exists := b.mutation.PickupNo(); exists {
+				s.SetIgnore(kitchenticket.FieldPickupNo)
+			}
+			if _, exists := b.mutation.Batch(); exists {
+				s.SetIgnore(kitchenticket.FieldBatch)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(kitchenticket.FieldSource)
+			}
+			if _, exists := b.mutation.Items(); exists {
+				s.SetIgnore(kitchenticket.FieldItems)
+			}
+			if _, exists := b.mutation.Remark(); exists {
+				s.SetIgnore(kitchenticket.FieldRemark)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KitchenTicket.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *KitchenTicketUpsertBulk) Ignore() *KitchenTicketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KitchenTicketUpsertBulk) DoNothing() *KitchenTicketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KitchenTicketCreateBulk.OnConflict
+// documentation for more info.
+func (u *KitchenTicketUpsertBulk) Update(set func(*KitchenTicketUpsert)) *KitchenTicketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KitchenTicketUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *KitchenTicketUpsertBulk) SetUpdatedAt(v time.Time) *KitchenTicketUpsertBulk {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *KitchenTicketUpsertBulk) UpdateUpdatedAt() *KitchenTicketUpsertBulk {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *KitchenTicketUpsertBulk) SetStatus(v domain.KitchenTicketStatus) *KitchenTicketUpsertBulk {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *KitchenTicketUpsertBulk) UpdateStatus() *KitchenTicketUpsertBulk {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPrintedAt sets the "printed_at" field.
+func (u *KitchenTicketUpsertBulk) SetPrintedAt(v time.Time) *KitchenTicketUpsertBulk {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.SetPrintedAt(v)
+	})
+}
+
+// UpdatePrintedAt sets the "printed_at" field to the value that was provided on create.
+func (u *KitchenTicketUpsertBulk) UpdatePrintedAt() *KitchenTicketUpsertBulk {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.UpdatePrintedAt()
+	})
+}
+
+// ClearPrintedAt clears the value of the "printed_at" field.
+func (u *KitchenTicketUpsertBulk) ClearPrintedAt() *KitchenTicketUpsertBulk {
+	return u.Update(func(s *KitchenTicketUpsert) {
+		s.ClearPrintedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *KitchenTicketUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the KitchenTicketCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KitchenTicketCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KitchenTicketUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
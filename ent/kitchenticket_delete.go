@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/kitchenticket"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// KitchenTicketDelete is the builder for deleting a KitchenTicket entity.
+type KitchenTicketDelete struct {
+	config
+	hooks    []Hook
+	mutation *KitchenTicketMutation
+}
+
+// Where appends a list predicates to the KitchenTicketDelete builder.
+func (ktd *KitchenTicketDelete) Where(ps ...predicate.KitchenTicket) *KitchenTicketDelete {
+	ktd.mutation.Where(ps...)
+	return ktd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ktd *KitchenTicketDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ktd.sqlExec, ktd.mutation, ktd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ktd *KitchenTicketDelete) ExecX(ctx context.Context) int {
+	n, err := ktd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ktd *KitchenTicketDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(kitchenticket.Table, sqlgraph.NewFieldSpec(kitchenticket.FieldID, field.TypeUUID))
+	if ps := ktd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ktd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ktd.mutation.done = true
+	return affected, err
+}
+
+// KitchenTicketDeleteOne is the builder for deleting a single KitchenTicket entity.
+type KitchenTicketDeleteOne struct {
+	ktd *KitchenTicketDelete
+}
+
+// Where appends a list predicates to the KitchenTicketDelete builder.
+func (ktdo *KitchenTicketDeleteOne) Where(ps ...predicate.KitchenTicket) *KitchenTicketDeleteOne {
+	ktdo.ktd.mutation.Where(ps...)
+	return ktdo
+}
+
+// Exec executes the deletion query.
+func (ktdo *KitchenTicketDeleteOne) Exec(ctx context.Context) error {
+	n, err := ktdo.ktd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{kitchenticket.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ktdo *KitchenTicketDeleteOne) ExecX(ctx context.Context) {
+	if err := ktdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/kitchenticket"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// KitchenTicketQuery is the builder for querying KitchenTicket entities.
+type KitchenTicketQuery struct {
+	config
+	ctx        *QueryContext
+	order      []kitchenticket.OrderOption
+	inters     []Interceptor
+	predicates []predicate.KitchenTicket
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KitchenTicketQuery builder.
+func (ktq *KitchenTicketQuery) Where(ps ...predicate.KitchenTicket) *KitchenTicketQuery {
+	ktq.predicates = append(ktq.predicates, ps...)
+	return ktq
+}
+
+// Limit the number of records to be returned by this query.
+func (ktq *KitchenTicketQuery) Limit(limit int) *KitchenTicketQuery {
+	ktq.ctx.Limit = &limit
+	return ktq
+}
+
+// Offset to start from.
+func (ktq *KitchenTicketQuery) Offset(offset int) *KitchenTicketQuery {
+	ktq.ctx.Offset = &offset
+	return ktq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ktq *KitchenTicketQuery) Unique(unique bool) *KitchenTicketQuery {
+	ktq.ctx.Unique = &unique
+	return ktq
+}
+
+// Order specifies how the records should be ordered.
+func (ktq *KitchenTicketQuery) Order(o ...kitchenticket.OrderOption) *KitchenTicketQuery {
+	ktq.order = append(ktq.order, o...)
+	return ktq
+}
+
+// First returns the first KitchenTicket entity from the query.
+// Returns a *NotFoundError when no KitchenTicket was found.
+func (ktq *KitchenTicketQuery) First(ctx context.Context) (*KitchenTicket, error) {
+	nodes, err := ktq.Limit(1).All(setContextOp(ctx, ktq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{kitchenticket.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ktq *KitchenTicketQuery) FirstX(ctx context.Context) *KitchenTicket {
+	node, err := ktq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KitchenTicket ID from the query.
+// Returns a *NotFoundError when no KitchenTicket ID was found.
+func (ktq *KitchenTicketQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ktq.Limit(1).IDs(setContextOp(ctx, ktq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{kitchenticket.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ktq *KitchenTicketQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ktq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KitchenTicket entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KitchenTicket entity is found.
+// Returns a *NotFoundError when no KitchenTicket entities are found.
+func (ktq *KitchenTicketQuery) Only(ctx context.Context) (*KitchenTicket, error) {
+	nodes, err := ktq.Limit(2).All(setContextOp(ctx, ktq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{kitchenticket.Label}
+	default:
+		return nil, &NotSingularError{kitchenticket.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ktq *KitchenTicketQuery) OnlyX(ctx context.Context) *KitchenTicket {
+	node, err := ktq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KitchenTicket ID in the query.
+// Returns a *NotSingularError when more than one KitchenTicket ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ktq *KitchenTicketQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ktq.Limit(2).IDs(setContextOp(ctx, ktq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{kitchenticket.Label}
+	default:
+		err = &NotSingularError{kitchenticket.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ktq *KitchenTicketQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ktq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KitchenTickets.
+func (ktq *KitchenTicketQuery) All(ctx context.Context) ([]*KitchenTicket, error) {
+	ctx = setContextOp(ctx, ktq.ctx, ent.OpQueryAll)
+	if err := ktq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KitchenTicket, *KitchenTicketQuery]()
+	return withInterceptors[[]*KitchenTicket](ctx, ktq, qr, ktq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ktq *KitchenTicketQuery) AllX(ctx context.Context) []*KitchenTicket {
+	nodes, err := ktq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KitchenTicket IDs.
+func (ktq *KitchenTicketQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ktq.ctx.Unique == nil && ktq.path != nil {
+		ktq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ktq.ctx, ent.OpQueryIDs)
+	if err = ktq.Select(kitchenticket.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ktq *KitchenTicketQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ktq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ktq *KitchenTicketQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ktq.ctx, ent.OpQueryCount)
+	if err := ktq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ktq, querierCount[*KitchenTicketQuery](), ktq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ktq *KitchenTicketQuery) CountX(ctx context.Context) int {
+	count, err := ktq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ktq *KitchenTicketQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ktq.ctx, ent.OpQueryExist)
+	switch _, err := ktq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ktq *KitchenTicketQuery) ExistX(ctx context.Context) bool {
+	exist, err := ktq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KitchenTicketQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ktq *KitchenTicketQuery) Clone() *KitchenTicketQuery {
+	if ktq == nil {
+		return nil
+	}
+	return &KitchenTicketQuery{
+		config:     ktq.config,
+		ctx:        ktq.ctx.Clone(),
+		order:      append([]kitchenticket.OrderOption{}, ktq.order...),
+		inters:     append([]Interceptor{}, ktq.inters...),
+		predicates: append([]predicate.KitchenTicket{}, ktq.predicates...),
+		// clone intermediate query.
+		sql:       ktq.sql.Clone(),
+		path:      ktq.path,
+		modifiers: append([]func(*sql.Selector){}, ktq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KitchenTicket.Query().
+//		GroupBy(kitchenticket.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ktq *KitchenTicketQuery) GroupBy(field string, fields ...string) *KitchenTicketGroupBy {
+	ktq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KitchenTicketGroupBy{build: ktq}
+	grbuild.flds = &ktq.ctx.Fields
+	grbuild.label = kitchenticket.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.KitchenTicket.Query().
+//		Select(kitchenticket.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ktq *KitchenTicketQuery) Select(fields ...string) *KitchenTicketSelect {
+	ktq.ctx.Fields = append(ktq.ctx.Fields, fields...)
+	sbuild := &KitchenTicketSelect{KitchenTicketQuery: ktq}
+	sbuild.label = kitchenticket.Label
+	sbuild.flds, sbuild.scan = &ktq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KitchenTicketSelect configured with the given aggregations.
+func (ktq *KitchenTicketQuery) Aggregate(fns ...AggregateFunc) *KitchenTicketSelect {
+	return ktq.Select().Aggregate(fns...)
+}
+
+func (ktq *KitchenTicketQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ktq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ktq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ktq.ctx.Fields {
+		if !kitchenticket.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ktq.path != nil {
+		prev, err := ktq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ktq.sql = prev
+	}
+	return nil
+}
+
+func (ktq *KitchenTicketQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KitchenTicket, error) {
+	var (
+		nodes = []*KitchenTicket{}
+		_spec = ktq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KitchenTicket).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KitchenTicket{config: ktq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ktq.modifiers) > 0 {
+		_spec.Modifiers = ktq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ktq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ktq *KitchenTicketQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ktq.querySpec()
+	if len(ktq.modifiers) > 0 {
+		_spec.Modifiers = ktq.modifiers
+	}
+	_spec.Node.Columns = ktq.ctx.Fields
+	if len(ktq.ctx.Fields) > 0 {
+		_spec.Unique = ktq.ctx.Unique != nil && *ktq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ktq.driver, _spec)
+}
+
+func (ktq *KitchenTicketQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(kitchenticket.Table, kitchenticket.Columns, sqlgraph.NewFieldSpec(kitchenticket.FieldID, field.TypeUUID))
+	_spec.From = ktq.sql
+	if unique := ktq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ktq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ktq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, kitchenticket.FieldID)
+		for i := range fields {
+			if fields[i] != kitchenticket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ktq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ktq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ktq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ktq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ktq *KitchenTicketQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ktq.driver.Dialect())
+	t1 := builder.Table(kitchenticket.Table)
+	columns := ktq.ctx.Fields
+	if len(columns) == 0 {
+		columns = kitchenticket.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ktq.sql != nil {
+		selector = ktq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ktq.ctx.Unique != nil && *ktq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ktq.modifiers {
+		m(selector)
+	}
+	for _, p := range ktq.predicates {
+		p(selector)
+	}
+	for _, p := range ktq.order {
+		p(selector)
+	}
+	if offset := ktq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ktq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ktq *KitchenTicketQuery) ForUpdate(opts ...sql.LockOption) *KitchenTicketQuery {
+	if ktq.driver.Dialect() == dialect.Postgres {
+		ktq.Unique(false)
+	}
+	ktq.modifiers = append(ktq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ktq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ktq *KitchenTicketQuery) ForShare(opts ...sql.LockOption) *KitchenTicketQuery {
+	if ktq.driver.Dialect() == dialect.Postgres {
+		ktq.Unique(false)
+	}
+	ktq.modifiers = append(ktq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ktq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ktq *KitchenTicketQuery) Modify(modifiers ...func(s *sql.Selector)) *KitchenTicketSelect {
+	ktq.modifiers = append(ktq.modifiers, modifiers...)
+	return ktq.Select()
+}
+
+// KitchenTicketGroupBy is the group-by builder for KitchenTicket entities.
+type KitchenTicketGroupBy struct {
+	selector
+	build *KitchenTicketQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ktgb *KitchenTicketGroupBy) Aggregate(fns ...AggregateFunc) *KitchenTicketGroupBy {
+	ktgb.fns = append(ktgb.fns, fns...)
+	return ktgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ktgb *KitchenTicketGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ktgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ktgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KitchenTicketQuery, *KitchenTicketGroupBy](ctx, ktgb.build, ktgb, ktgb.build.inters, v)
+}
+
+func (ktgb *KitchenTicketGroupBy) sqlScan(ctx context.Context, root *KitchenTicketQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ktgb.fns))
+	for _, fn := range ktgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ktgb.flds)+len(ktgb.fns))
+		for _, f := range *ktgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ktgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ktgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KitchenTicketSelect is the builder for selecting fields of KitchenTicket entities.
+type KitchenTicketSelect struct {
+	*KitchenTicketQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (kts *KitchenTicketSelect) Aggregate(fns ...AggregateFunc) *KitchenTicketSelect {
+	kts.fns = append(kts.fns, fns...)
+	return kts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kts *KitchenTicketSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kts.ctx, ent.OpQuerySelect)
+	if err := kts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KitchenTicketQuery, *KitchenTicketSelect](ctx, kts.KitchenTicketQuery, kts, kts.inters, v)
+}
+
+func (kts *KitchenTicketSelect) sqlScan(ctx context.Context, root *KitchenTicketQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(kts.fns))
+	for _, fn := range kts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*kts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (kts *KitchenTicketSelect) Modify(modifiers ...func(s *sql.Selector)) *KitchenTicketSelect {
+	kts.modifiers = append(kts.modifiers, modifiers...)
+	return kts
+}
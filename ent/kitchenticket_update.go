@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/kitchenticket"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// KitchenTicketUpdate is the builder for updating KitchenTicket entities.
+type KitchenTicketUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *KitchenTicketMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the KitchenTicketUpdate builder.
+func (ktu *KitchenTicketUpdate) Where(ps ...predicate.KitchenTicket) *KitchenTicketUpdate {
+	ktu.mutation.Where(ps...)
+	return ktu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ktu *KitchenTicketUpdate) SetUpdatedAt(t time.Time) *KitchenTicketUpdate {
+	ktu.mutation.SetUpdatedAt(t)
+	return ktu
+}
+
+// SetStatus sets the "status" field.
+func (ktu *KitchenTicketUpdate) SetStatus(dts domain.KitchenTicketStatus) *KitchenTicketUpdate {
+	ktu.mutation.SetStatus(dts)
+	return ktu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ktu *KitchenTicketUpdate) SetNillableStatus(dts *domain.KitchenTicketStatus) *KitchenTicketUpdate {
+	if dts != nil {
+		ktu.SetStatus(*dts)
+	}
+	return ktu
+}
+
+// SetPrintedAt sets the "printed_at" field.
+func (ktu *KitchenTicketUpdate) SetPrintedAt(t time.Time) *KitchenTicketUpdate {
+	ktu.mutation.SetPrintedAt(t)
+	return ktu
+}
+
+// SetNillablePrintedAt sets the "printed_at" field if the given value is not nil.
+func (ktu *KitchenTicketUpdate) SetNillablePrintedAt(t *time.Time) *KitchenTicketUpdate {
+	if t != nil {
+		ktu.SetPrintedAt(*t)
+	}
+	return ktu
+}
+
+// ClearPrintedAt clears the value of the "printed_at" field.
+func (ktu *KitchenTicketUpdate) ClearPrintedAt() *KitchenTicketUpdate {
+	ktu.mutation.ClearPrintedAt()
+	return ktu
+}
+
+// Mutation returns the KitchenTicketMutation object of the builder.
+func (ktu *KitchenTicketUpdate) Mutation() *KitchenTicketMutation {
+	return ktu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ktu *KitchenTicketUpdate) Save(ctx context.Context) (int, error) {
+	ktu.defaults()
+	return withHooks(ctx, ktu.sqlSave, ktu.mutation, ktu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ktu *KitchenTicketUpdate) SaveX(ctx context.Context) int {
+	affected, err := ktu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ktu *KitchenTicketUpdate) Exec(ctx context.Context) error {
+	_, err := ktu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ktu *KitchenTicketUpdate) ExecX(ctx context.Context) {
+	if err := ktu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ktu *KitchenTicketUpdate) defaults() {
+	if _, ok := ktu.mutation.UpdatedAt(); !ok {
+		v := kitchenticket.UpdateDefaultUpdatedAt()
+		ktu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ktu *KitchenTicketUpdate) check() error {
+	if v, ok := ktu.mutation.Status(); ok {
+		if err := kitchenticket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KitchenTicket.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ktu *KitchenTicketUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *KitchenTicketUpdate {
+	ktu.modifiers = append(ktu.modifiers, modifiers...)
+	return ktu
+}
+
+func (ktu *KitchenTicketUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ktu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(kitchenticket.Table, kitchenticket.Columns, sqlgraph.NewFieldSpec(kitchenticket.FieldID, field.TypeUUID))
+	if ps := ktu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ktu.mutation.UpdatedAt(); ok {
+		_spec.SetField(kitchenticket.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ktu.mutation.Status(); ok {
+		_spec.SetField(kitchenticket.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ktu.mutation.PrintedAt(); ok {
+		_spec.SetField(kitchenticket.FieldPrintedAt, field.TypeTime, value)
+	}
+	if ktu.mutation.PrintedAtCleared() {
+		_spec.ClearField(kitchenticket.FieldPrintedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(ktu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ktu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kitchenticket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ktu.mutation.done = true
+	return n, nil
+}
+
+// KitchenTicketUpdateOne is the builder for updating a single KitchenTicket entity.
+type KitchenTicketUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *KitchenTicketMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ktuo *KitchenTicketUpdateOne) SetUpdatedAt(t time.Time) *KitchenTicketUpdateOne {
+	ktuo.mutation.SetUpdatedAt(t)
+	return ktuo
+}
+
+// SetStatus sets the "status" field.
+func (ktuo *KitchenTicketUpdateOne) SetStatus(dts domain.KitchenTicketStatus) *KitchenTicketUpdateOne {
+	ktuo.mutation.SetStatus(dts)
+	return ktuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ktuo *KitchenTicketUpdateOne) SetNillableStatus(dts *domain.KitchenTicketStatus) *KitchenTicketUpdateOne {
+	if dts != nil {
+		ktuo.SetStatus(*dts)
+	}
+	return ktuo
+}
+
+// SetPrintedAt sets the "printed_at" field.
+func (ktuo *KitchenTicketUpdateOne) SetPrintedAt(t time.Time) *KitchenTicketUpdateOne {
+	ktuo.mutation.SetPrintedAt(t)
+	return ktuo
+}
+
+// SetNillablePrintedAt sets the "printed_at" field if the given value is not nil.
+func (ktuo *KitchenTicketUpdateOne) SetNillablePrintedAt(t *time.Time) *KitchenTicketUpdateOne {
+	if t != nil {
+		ktuo.SetPrintedAt(*t)
+	}
+	return ktuo
+}
+
+// ClearPrintedAt clears the value of the "printed_at" field.
+func (ktuo *KitchenTicketUpdateOne) ClearPrintedAt() *KitchenTicketUpdateOne {
+	ktuo.mutation.ClearPrintedAt()
+	return ktuo
+}
+
+// Mutation returns the KitchenTicketMutation object of the builder.
+func (ktuo *KitchenTicketUpdateOne) Mutation() *KitchenTicketMutation {
+	return ktuo.mutation
+}
+
+// Where appends a list predicates to the KitchenTicketUpdate builder.
+func (ktuo *KitchenTicketUpdateOne) Where(ps ...predicate.KitchenTicket) *KitchenTicketUpdateOne {
+	ktuo.mutation.Where(ps...)
+	return ktuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ktuo *KitchenTicketUpdateOne) Select(field string, fields ...string) *KitchenTicketUpdateOne {
+	ktuo.fields = append([]string{field}, fields...)
+	return ktuo
+}
+
+// Save executes the query and returns the updated KitchenTicket entity.
+func (ktuo *KitchenTicketUpdateOne) Save(ctx context.Context) (*KitchenTicket, error) {
+	ktuo.defaults()
+	return withHooks(ctx, ktuo.sqlSave, ktuo.mutation, ktuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ktuo *KitchenTicketUpdateOne) SaveX(ctx context.Context) *KitchenTicket {
+	node, err := ktuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ktuo *KitchenTicketUpdateOne) Exec(ctx context.Context) error {
+	_, err := ktuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ktuo *KitchenTicketUpdateOne) ExecX(ctx context.Context) {
+	if err := ktuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ktuo *KitchenTicketUpdateOne) defaults() {
+	if _, ok := ktuo.mutation.UpdatedAt(); !ok {
+		v := kitchenticket.UpdateDefaultUpdatedAt()
+		ktuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ktuo *KitchenTicketUpdateOne) check() error {
+	if v, ok := ktuo.mutation.Status(); ok {
+		if err := kitchenticket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KitchenTicket.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ktuo *KitchenTicketUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *KitchenTicketUpdateOne {
+	ktuo.modifiers = append(ktuo.modifiers, modifiers...)
+	return ktuo
+}
+
+func (ktuo *KitchenTicketUpdateOne) sqlSave(ctx context.Context) (_node *KitchenTicket, err error) {
+	if err := ktuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(kitchenticket.Table, kitchenticket.Columns, sqlgraph.NewFieldSpec(kitchenticket.FieldID, field.TypeUUID))
+	id, ok := ktuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KitchenTicket.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ktuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, kitchenticket.FieldID)
+		for _, f := range fields {
+			if !kitchenticket.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != kitchenticket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ktuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ktuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(kitchenticket.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ktuo.mutation.Status(); ok {
+		_spec.SetField(kitchenticket.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ktuo.mutation.PrintedAt(); ok {
+		_spec.SetField(kitchenticket.FieldPrintedAt, field.TypeTime, value)
+	}
+	if ktuo.mutation.PrintedAtCleared() {
+		_spec.ClearField(kitchenticket.FieldPrintedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(ktuo.modifiers...)
+	_node = &KitchenTicket{config: ktuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ktuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kitchenticket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ktuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/member"
+)
+
+// Member is the model entity for the Member schema.
+type Member struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 会员等级ID
+	TierID uuid.UUID `json:"tier_id,omitempty"`
+	// 会员码（会员二维码内容）
+	Code string `json:"code,omitempty"`
+	// 姓名
+	Name string `json:"name,omitempty"`
+	// 手机号
+	Phone string `json:"phone,omitempty"`
+	// 微信 openid
+	Openid string `json:"openid,omitempty"`
+	// 性别
+	Gender domain.Gender `json:"gender,omitempty"`
+	// 生日
+	Birthday *time.Time `json:"birthday,omitempty"`
+	// 是否启用
+	Enabled bool `json:"enabled,omitempty"`
+	// 备注
+	Remark       string `json:"remark,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Member) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case member.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case member.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case member.FieldCode, member.FieldName, member.FieldPhone, member.FieldOpenid, member.FieldGender, member.FieldRemark:
+			values[i] = new(sql.NullString)
+		case member.FieldCreatedAt, member.FieldUpdatedAt, member.FieldBirthday:
+			values[i] = new(sql.NullTime)
+		case member.FieldID, member.FieldMerchantID, member.FieldTierID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Member fields.
+func (m *Member) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case member.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				m.ID = *value
+			}
+		case member.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case member.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				m.UpdatedAt = value.Time
+			}
+		case member.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				m.DeletedAt = value.Int64
+			}
+		case member.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				m.MerchantID = *value
+			}
+		case member.FieldTierID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tier_id", values[i])
+			} else if value != nil {
+				m.TierID = *value
+			}
+		case member.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				m.Code = value.String
+			}
+		case member.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				m.Name = value.String
+			}
+		case member.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				m.Phone = value.String
+			}
+		case member.FieldOpenid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field openid", values[i])
+			} else if value.Valid {
+				m.Openid = value.String
+			}
+		case member.FieldGender:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gender", values[i])
+			} else if value.Valid {
+				m.Gender = domain.Gender(value.String)
+			}
+		case member.FieldBirthday:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field birthday", values[i])
+			} else if value.Valid {
+				m.Birthday = new(time.Time)
+				*m.Birthday = value.Time
+			}
+		case member.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				m.Enabled = value.Bool
+			}
+		case member.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				m.Remark = value.String
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Member.
+// This includes values selected through modifiers, order, etc.
+func (m *Member) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Member.
+// Note that you need to call Member.Unwrap() before calling this method if this Member
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Member) Update() *MemberUpdateOne {
+	return NewMemberClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Member entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Member) Unwrap() *Member {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Member is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Member) String() string {
+	var builder strings.Builder
+	builder.WriteString("Member(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("tier_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.TierID))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(m.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("openid=")
+	builder.WriteString(m.Openid)
+	builder.WriteString(", ")
+	builder.WriteString("gender=")
+	builder.WriteString(fmt.Sprintf("%v", m.Gender))
+	builder.WriteString(", ")
+	if v := m.Birthday; v != nil {
+		builder.WriteString("birthday=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(m.Remark)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Members is a parsable slice of Member.
+type Members []*Member
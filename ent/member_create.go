@@ -0,0 +1,1242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/member"
+)
+
+// MemberCreate is the builder for creating a Member entity.
+type MemberCreate struct {
+	config
+	mutation *MemberMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *MemberCreate) SetCreatedAt(t time.Time) *MemberCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableCreatedAt(t *time.Time) *MemberCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mc *MemberCreate) SetUpdatedAt(t time.Time) *MemberCreate {
+	mc.mutation.SetUpdatedAt(t)
+	return mc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableUpdatedAt(t *time.Time) *MemberCreate {
+	if t != nil {
+		mc.SetUpdatedAt(*t)
+	}
+	return mc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mc *MemberCreate) SetDeletedAt(i int64) *MemberCreate {
+	mc.mutation.SetDeletedAt(i)
+	return mc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableDeletedAt(i *int64) *MemberCreate {
+	if i != nil {
+		mc.SetDeletedAt(*i)
+	}
+	return mc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (mc *MemberCreate) SetMerchantID(u uuid.UUID) *MemberCreate {
+	mc.mutation.SetMerchantID(u)
+	return mc
+}
+
+// SetTierID sets the "tier_id" field.
+func (mc *MemberCreate) SetTierID(u uuid.UUID) *MemberCreate {
+	mc.mutation.SetTierID(u)
+	return mc
+}
+
+// SetNillableTierID sets the "tier_id" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableTierID(u *uuid.UUID) *MemberCreate {
+	if u != nil {
+		mc.SetTierID(*u)
+	}
+	return mc
+}
+
+// SetCode sets the "code" field.
+func (mc *MemberCreate) SetCode(s string) *MemberCreate {
+	mc.mutation.SetCode(s)
+	return mc
+}
+
+// SetName sets the "name" field.
+func (mc *MemberCreate) SetName(s string) *MemberCreate {
+	mc.mutation.SetName(s)
+	return mc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableName(s *string) *MemberCreate {
+	if s != nil {
+		mc.SetName(*s)
+	}
+	return mc
+}
+
+// SetPhone sets the "phone" field.
+func (mc *MemberCreate) SetPhone(s string) *MemberCreate {
+	mc.mutation.SetPhone(s)
+	return mc
+}
+
+// SetOpenid sets the "openid" field.
+func (mc *MemberCreate) SetOpenid(s string) *MemberCreate {
+	mc.mutation.SetOpenid(s)
+	return mc
+}
+
+// SetNillableOpenid sets the "openid" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableOpenid(s *string) *MemberCreate {
+	if s != nil {
+		mc.SetOpenid(*s)
+	}
+	return mc
+}
+
+// SetGender sets the "gender" field.
+func (mc *MemberCreate) SetGender(d domain.Gender) *MemberCreate {
+	mc.mutation.SetGender(d)
+	return mc
+}
+
+// SetNillableGender sets the "gender" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableGender(d *domain.Gender) *MemberCreate {
+	if d != nil {
+		mc.SetGender(*d)
+	}
+	return mc
+}
+
+// SetBirthday sets the "birthday" field.
+func (mc *MemberCreate) SetBirthday(t time.Time) *MemberCreate {
+	mc.mutation.SetBirthday(t)
+	return mc
+}
+
+// SetNillableBirthday sets the "birthday" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableBirthday(t *time.Time) *MemberCreate {
+	if t != nil {
+		mc.SetBirthday(*t)
+	}
+	return mc
+}
+
+// SetEnabled sets the "enabled" field.
+func (mc *MemberCreate) SetEnabled(b bool) *MemberCreate {
+	mc.mutation.SetEnabled(b)
+	return mc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableEnabled(b *bool) *MemberCreate {
+	if b != nil {
+		mc.SetEnabled(*b)
+	}
+	return mc
+}
+
+// SetRemark sets the "remark" field.
+func (mc *MemberCreate) SetRemark(s string) *MemberCreate {
+	mc.mutation.SetRemark(s)
+	return mc
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableRemark(s *string) *MemberCreate {
+	if s != nil {
+		mc.SetRemark(*s)
+	}
+	return mc
+}
+
+// SetID sets the "id" field.
+func (mc *MemberCreate) SetID(u uuid.UUID) *MemberCreate {
+	mc.mutation.SetID(u)
+	return mc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableID(u *uuid.UUID) *MemberCreate {
+	if u != nil {
+		mc.SetID(*u)
+	}
+	return mc
+}
+
+// Mutation returns the MemberMutation object of the builder.
+func (mc *MemberCreate) Mutation() *MemberMutation {
+	return mc.mutation
+}
+
+// Save creates the Member in the database.
+func (mc *MemberCreate) Save(ctx context.Context) (*Member, error) {
+	if err := mc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MemberCreate) SaveX(ctx context.Context) *Member {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MemberCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MemberCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MemberCreate) defaults() error {
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		if member.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized member.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := member.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		if member.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized member.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := member.DefaultUpdatedAt()
+		mc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mc.mutation.DeletedAt(); !ok {
+		v := member.DefaultDeletedAt
+		mc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := mc.mutation.TierID(); !ok {
+		if member.DefaultTierID == nil {
+			return fmt.Errorf("ent: uninitialized member.DefaultTierID (forgotten import ent/runtime?)")
+		}
+		v := member.DefaultTierID()
+		mc.mutation.SetTierID(v)
+	}
+	if _, ok := mc.mutation.Name(); !ok {
+		v := member.DefaultName
+		mc.mutation.SetName(v)
+	}
+	if _, ok := mc.mutation.Openid(); !ok {
+		v := member.DefaultOpenid
+		mc.mutation.SetOpenid(v)
+	}
+	if _, ok := mc.mutation.Gender(); !ok {
+		v := member.DefaultGender
+		mc.mutation.SetGender(v)
+	}
+	if _, ok := mc.mutation.Enabled(); !ok {
+		v := member.DefaultEnabled
+		mc.mutation.SetEnabled(v)
+	}
+	if _, ok := mc.mutation.Remark(); !ok {
+		v := member.DefaultRemark
+		mc.mutation.SetRemark(v)
+	}
+	if _, ok := mc.mutation.ID(); !ok {
+		if member.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized member.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := member.DefaultID()
+		mc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MemberCreate) check() error {
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Member.created_at"`)}
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Member.updated_at"`)}
+	}
+	if _, ok := mc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "Member.deleted_at"`)}
+	}
+	if _, ok := mc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "Member.merchant_id"`)}
+	}
+	if _, ok := mc.mutation.TierID(); !ok {
+		return &ValidationError{Name: "tier_id", err: errors.New(`ent: missing required field "Member.tier_id"`)}
+	}
+	if _, ok := mc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Member.code"`)}
+	}
+	if v, ok := mc.mutation.Code(); ok {
+		if err := member.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Member.code": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Member.name"`)}
+	}
+	if v, ok := mc.mutation.Name(); ok {
+		if err := member.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Member.name": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Phone(); !ok {
+		return &ValidationError{Name: "phone", err: errors.New(`ent: missing required field "Member.phone"`)}
+	}
+	if v, ok := mc.mutation.Phone(); ok {
+		if err := member.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Member.phone": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Openid(); !ok {
+		return &ValidationError{Name: "openid", err: errors.New(`ent: missing required field "Member.openid"`)}
+	}
+	if v, ok := mc.mutation.Openid(); ok {
+		if err := member.OpenidValidator(v); err != nil {
+			return &ValidationError{Name: "openid", err: fmt.Errorf(`ent: validator failed for field "Member.openid": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Gender(); !ok {
+		return &ValidationError{Name: "gender", err: errors.New(`ent: missing required field "Member.gender"`)}
+	}
+	if v, ok := mc.mutation.Gender(); ok {
+		if err := member.GenderValidator(v); err != nil {
+			return &ValidationError{Name: "gender", err: fmt.Errorf(`ent: validator failed for field "Member.gender": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Member.enabled"`)}
+	}
+	if _, ok := mc.mutation.Remark(); !ok {
+		return &ValidationError{Name: "remark", err: errors.New(`ent: missing required field "Member.remark"`)}
+	}
+	if v, ok := mc.mutation.Remark(); ok {
+		if err := member.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "Member.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mc *MemberCreate) sqlSave(ctx context.Context) (*Member, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MemberCreate) createSpec() (*Member, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Member{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(member.Table, sqlgraph.NewFieldSpec(member.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mc.conflict
+	if id, ok := mc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.SetField(member.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mc.mutation.UpdatedAt(); ok {
+		_spec.SetField(member.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mc.mutation.DeletedAt(); ok {
+		_spec.SetField(member.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := mc.mutation.MerchantID(); ok {
+		_spec.SetField(member.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := mc.mutation.TierID(); ok {
+		_spec.SetField(member.FieldTierID, field.TypeUUID, value)
+		_node.TierID = value
+	}
+	if value, ok := mc.mutation.Code(); ok {
+		_spec.SetField(member.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := mc.mutation.Name(); ok {
+		_spec.SetField(member.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := mc.mutation.Phone(); ok {
+		_spec.SetField(member.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := mc.mutation.Openid(); ok {
+		_spec.SetField(member.FieldOpenid, field.TypeString, value)
+		_node.Openid = value
+	}
+	if value, ok := mc.mutation.Gender(); ok {
+		_spec.SetField(member.FieldGender, field.TypeEnum, value)
+		_node.Gender = value
+	}
+	if value, ok := mc.mutation.Birthday(); ok {
+		_spec.SetField(member.FieldBirthday, field.TypeTime, value)
+		_node.Birthday = &value
+	}
+	if value, ok := mc.mutation.Enabled(); ok {
+		_spec.SetField(member.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := mc.mutation.Remark(); ok {
+		_spec.SetField(member.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Member.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mc *MemberCreate) OnConflict(opts ...sql.ConflictOption) *MemberUpsertOne {
+	mc.conflict = opts
+	return &MemberUpsertOne{
+		create: mc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mc *MemberCreate) OnConflictColumns(columns ...string) *MemberUpsertOne {
+	mc.conflict = append(mc.conflict, sql.ConflictColumns(columns...))
+	return &MemberUpsertOne{
+		create: mc,
+	}
+}
+
+type (
+	// MemberUpsertOne is the builder for "upsert"-ing
+	//  one Member node.
+	MemberUpsertOne struct {
+		create *MemberCreate
+	}
+
+	// MemberUpsert is the "OnConflict" setter.
+	MemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberUpsert) SetUpdatedAt(v time.Time) *MemberUpsert {
+	u.Set(member.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateUpdatedAt() *MemberUpsert {
+	u.SetExcluded(member.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MemberUpsert) SetDeletedAt(v int64) *MemberUpsert {
+	u.Set(member.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateDeletedAt() *MemberUpsert {
+	u.SetExcluded(member.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *MemberUpsert) AddDeletedAt(v int64) *MemberUpsert {
+	u.Add(member.FieldDeletedAt, v)
+	return u
+}
+
+// SetTierID sets the "tier_id" field.
+func (u *MemberUpsert) SetTierID(v uuid.UUID) *MemberUpsert {
+	u.Set(member.FieldTierID, v)
+	return u
+}
+
+// UpdateTierID sets the "tier_id" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateTierID() *MemberUpsert {
+	u.SetExcluded(member.FieldTierID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *MemberUpsert) SetName(v string) *MemberUpsert {
+	u.Set(member.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateName() *MemberUpsert {
+	u.SetExcluded(member.FieldName)
+	return u
+}
+
+// SetPhone sets the "phone" field.
+func (u *MemberUpsert) SetPhone(v string) *MemberUpsert {
+	u.Set(member.FieldPhone, v)
+	return u
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *MemberUpsert) UpdatePhone() *MemberUpsert {
+	u.SetExcluded(member.FieldPhone)
+	return u
+}
+
+// SetOpenid sets the "openid" field.
+func (u *MemberUpsert) SetOpenid(v string) *MemberUpsert {
+	u.Set(member.FieldOpenid, v)
+	return u
+}
+
+// UpdateOpenid sets the "openid" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateOpenid() *MemberUpsert {
+	u.SetExcluded(member.FieldOpenid)
+	return u
+}
+
+// SetGender sets the "gender" field.
+func (u *MemberUpsert) SetGender(v domain.Gender) *MemberUpsert {
+	u.Set(member.FieldGender, v)
+	return u
+}
+
+// UpdateGender sets the "gender" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateGender() *MemberUpsert {
+	u.SetExcluded(member.FieldGender)
+	return u
+}
+
+// SetBirthday sets the "birthday" field.
+func (u *MemberUpsert) SetBirthday(v time.Time) *MemberUpsert {
+	u.Set(member.FieldBirthday, v)
+	return u
+}
+
+// UpdateBirthday sets the "birthday" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateBirthday() *MemberUpsert {
+	u.SetExcluded(member.FieldBirthday)
+	return u
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (u *MemberUpsert) ClearBirthday() *MemberUpsert {
+	u.SetNull(member.FieldBirthday)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *MemberUpsert) SetEnabled(v bool) *MemberUpsert {
+	u.Set(member.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateEnabled() *MemberUpsert {
+	u.SetExcluded(member.FieldEnabled)
+	return u
+}
+
+// SetRemark sets the "remark" field.
+func (u *MemberUpsert) SetRemark(v string) *MemberUpsert {
+	u.Set(member.FieldRemark, v)
+	return u
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateRemark() *MemberUpsert {
+	u.SetExcluded(member.FieldRemark)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(member.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberUpsertOne) UpdateNewValues() *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(member.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(member.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(member.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.Code(); exists {
+			s.SetIgnore(member.FieldCode)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MemberUpsertOne) Ignore() *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberUpsertOne) DoNothing() *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberCreate.OnConflict
+// documentation for more info.
+func (u *MemberUpsertOne) Update(set func(*MemberUpsert)) *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberUpsertOne) SetUpdatedAt(v time.Time) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateUpdatedAt() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MemberUpsertOne) SetDeletedAt(v int64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *MemberUpsertOne) AddDeletedAt(v int64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateDeletedAt() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetTierID sets the "tier_id" field.
+func (u *MemberUpsertOne) SetTierID(v uuid.UUID) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTierID(v)
+	})
+}
+
+// UpdateTierID sets the "tier_id" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateTierID() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTierID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MemberUpsertOne) SetName(v string) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateName() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *MemberUpsertOne) SetPhone(v string) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdatePhone() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// SetOpenid sets the "openid" field.
+func (u *MemberUpsertOne) SetOpenid(v string) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetOpenid(v)
+	})
+}
+
+// UpdateOpenid sets the "openid" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateOpenid() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateOpenid()
+	})
+}
+
+// SetGender sets the "gender" field.
+func (u *MemberUpsertOne) SetGender(v domain.Gender) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetGender(v)
+	})
+}
+
+// UpdateGender sets the "gender" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateGender() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateGender()
+	})
+}
+
+// SetBirthday sets the "birthday" field.
+func (u *MemberUpsertOne) SetBirthday(v time.Time) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetBirthday(v)
+	})
+}
+
+// UpdateBirthday sets the "birthday" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateBirthday() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateBirthday()
+	})
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (u *MemberUpsertOne) ClearBirthday() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearBirthday()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *MemberUpsertOne) SetEnabled(v bool) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateEnabled() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *MemberUpsertOne) SetRemark(v string) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateRemark() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MemberUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MemberUpsertOne.ID is not supported by MySQL driver. Use MemberUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MemberUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MemberCreateBulk is the builder for creating many Member entities in bulk.
+type MemberCreateBulk struct {
+	config
+	err      error
+	builders []*MemberCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Member entities in the database.
+func (mcb *MemberCreateBulk) Save(ctx context.Context) ([]*Member, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Member, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MemberCreateBulk) SaveX(ctx context.Context) []*Member {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MemberCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Member.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mcb *MemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *MemberUpsertBulk {
+	mcb.conflict = opts
+	return &MemberUpsertBulk{
+		create: mcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mcb *MemberCreateBulk) OnConflictColumns(columns ...string) *MemberUpsertBulk {
+	mcb.conflict = append(mcb.conflict, sql.ConflictColumns(columns...))
+	return &MemberUpsertBulk{
+		create: mcb,
+	}
+}
+
+// MemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of Member nodes.
+type MemberUpsertBulk struct {
+	create *MemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(member.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberUpsertBulk) UpdateNewValues() *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(member.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(member.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(member.FieldMerchantID)
+			}
+			if _, exists := b.mutation.Code(); exists {
+				s.SetIgnore(member.FieldCode)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MemberUpsertBulk) Ignore() *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberUpsertBulk) DoNothing() *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *MemberUpsertBulk) Update(set func(*MemberUpsert)) *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberUpsertBulk) SetUpdatedAt(v time.Time) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateUpdatedAt() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MemberUpsertBulk) SetDeletedAt(v int64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *MemberUpsertBulk) AddDeletedAt(v int64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateDeletedAt() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetTierID sets the "tier_id" field.
+func (u *MemberUpsertBulk) SetTierID(v uuid.UUID) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTierID(v)
+	})
+}
+
+// UpdateTierID sets the "tier_id" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateTierID() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTierID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MemberUpsertBulk) SetName(v string) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateName() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *MemberUpsertBulk) SetPhone(v string) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdatePhone() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// SetOpenid sets the "openid" field.
+func (u *MemberUpsertBulk) SetOpenid(v string) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetOpenid(v)
+	})
+}
+
+// UpdateOpenid sets the "openid" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateOpenid() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateOpenid()
+	})
+}
+
+// SetGender sets the "gender" field.
+func (u *MemberUpsertBulk) SetGender(v domain.Gender) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetGender(v)
+	})
+}
+
+// UpdateGender sets the "gender" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateGender() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateGender()
+	})
+}
+
+// SetBirthday sets the "birthday" field.
+func (u *MemberUpsertBulk) SetBirthday(v time.Time) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetBirthday(v)
+	})
+}
+
+// UpdateBirthday sets the "birthday" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateBirthday() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateBirthday()
+	})
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (u *MemberUpsertBulk) ClearBirthday() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearBirthday()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *MemberUpsertBulk) SetEnabled(v bool) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateEnabled() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *MemberUpsertBulk) SetRemark(v string) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateRemark() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/member"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberUpdate is the builder for updating Member entities.
+type MemberUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MemberMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MemberUpdate builder.
+func (mu *MemberUpdate) Where(ps ...predicate.Member) *MemberUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MemberUpdate) SetUpdatedAt(t time.Time) *MemberUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mu *MemberUpdate) SetDeletedAt(i int64) *MemberUpdate {
+	mu.mutation.ResetDeletedAt()
+	mu.mutation.SetDeletedAt(i)
+	return mu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableDeletedAt(i *int64) *MemberUpdate {
+	if i != nil {
+		mu.SetDeletedAt(*i)
+	}
+	return mu
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (mu *MemberUpdate) AddDeletedAt(i int64) *MemberUpdate {
+	mu.mutation.AddDeletedAt(i)
+	return mu
+}
+
+// SetTierID sets the "tier_id" field.
+func (mu *MemberUpdate) SetTierID(u uuid.UUID) *MemberUpdate {
+	mu.mutation.SetTierID(u)
+	return mu
+}
+
+// SetNillableTierID sets the "tier_id" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableTierID(u *uuid.UUID) *MemberUpdate {
+	if u != nil {
+		mu.SetTierID(*u)
+	}
+	return mu
+}
+
+// SetName sets the "name" field.
+func (mu *MemberUpdate) SetName(s string) *MemberUpdate {
+	mu.mutation.SetName(s)
+	return mu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableName(s *string) *MemberUpdate {
+	if s != nil {
+		mu.SetName(*s)
+	}
+	return mu
+}
+
+// SetPhone sets the "phone" field.
+func (mu *MemberUpdate) SetPhone(s string) *MemberUpdate {
+	mu.mutation.SetPhone(s)
+	return mu
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillablePhone(s *string) *MemberUpdate {
+	if s != nil {
+		mu.SetPhone(*s)
+	}
+	return mu
+}
+
+// SetOpenid sets the "openid" field.
+func (mu *MemberUpdate) SetOpenid(s string) *MemberUpdate {
+	mu.mutation.SetOpenid(s)
+	return mu
+}
+
+// SetNillableOpenid sets the "openid" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableOpenid(s *string) *MemberUpdate {
+	if s != nil {
+		mu.SetOpenid(*s)
+	}
+	return mu
+}
+
+// SetGender sets the "gender" field.
+func (mu *MemberUpdate) SetGender(d domain.Gender) *MemberUpdate {
+	mu.mutation.SetGender(d)
+	return mu
+}
+
+// SetNillableGender sets the "gender" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableGender(d *domain.Gender) *MemberUpdate {
+	if d != nil {
+		mu.SetGender(*d)
+	}
+	return mu
+}
+
+// SetBirthday sets the "birthday" field.
+func (mu *MemberUpdate) SetBirthday(t time.Time) *MemberUpdate {
+	mu.mutation.SetBirthday(t)
+	return mu
+}
+
+// SetNillableBirthday sets the "birthday" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableBirthday(t *time.Time) *MemberUpdate {
+	if t != nil {
+		mu.SetBirthday(*t)
+	}
+	return mu
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (mu *MemberUpdate) ClearBirthday() *MemberUpdate {
+	mu.mutation.ClearBirthday()
+	return mu
+}
+
+// SetEnabled sets the "enabled" field.
+func (mu *MemberUpdate) SetEnabled(b bool) *MemberUpdate {
+	mu.mutation.SetEnabled(b)
+	return mu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableEnabled(b *bool) *MemberUpdate {
+	if b != nil {
+		mu.SetEnabled(*b)
+	}
+	return mu
+}
+
+// SetRemark sets the "remark" field.
+func (mu *MemberUpdate) SetRemark(s string) *MemberUpdate {
+	mu.mutation.SetRemark(s)
+	return mu
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableRemark(s *string) *MemberUpdate {
+	if s != nil {
+		mu.SetRemark(*s)
+	}
+	return mu
+}
+
+// Mutation returns the MemberMutation object of the builder.
+func (mu *MemberUpdate) Mutation() *MemberMutation {
+	return mu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MemberUpdate) Save(ctx context.Context) (int, error) {
+	if err := mu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MemberUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MemberUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MemberUpdate) defaults() error {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		if member.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized member.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := member.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MemberUpdate) check() error {
+	if v, ok := mu.mutation.Name(); ok {
+		if err := member.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Member.name": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Phone(); ok {
+		if err := member.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Member.phone": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Openid(); ok {
+		if err := member.OpenidValidator(v); err != nil {
+			return &ValidationError{Name: "openid", err: fmt.Errorf(`ent: validator failed for field "Member.openid": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Gender(); ok {
+		if err := member.GenderValidator(v); err != nil {
+			return &ValidationError{Name: "gender", err: fmt.Errorf(`ent: validator failed for field "Member.gender": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Remark(); ok {
+		if err := member.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "Member.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mu *MemberUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberUpdate {
+	mu.modifiers = append(mu.modifiers, modifiers...)
+	return mu
+}
+
+func (mu *MemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(member.Table, member.Columns, sqlgraph.NewFieldSpec(member.FieldID, field.TypeUUID))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.SetField(member.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mu.mutation.DeletedAt(); ok {
+		_spec.SetField(member.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(member.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.TierID(); ok {
+		_spec.SetField(member.FieldTierID, field.TypeUUID, value)
+	}
+	if value, ok := mu.mutation.Name(); ok {
+		_spec.SetField(member.FieldName, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Phone(); ok {
+		_spec.SetField(member.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Openid(); ok {
+		_spec.SetField(member.FieldOpenid, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Gender(); ok {
+		_spec.SetField(member.FieldGender, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.Birthday(); ok {
+		_spec.SetField(member.FieldBirthday, field.TypeTime, value)
+	}
+	if mu.mutation.BirthdayCleared() {
+		_spec.ClearField(member.FieldBirthday, field.TypeTime)
+	}
+	if value, ok := mu.mutation.Enabled(); ok {
+		_spec.SetField(member.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := mu.mutation.Remark(); ok {
+		_spec.SetField(member.FieldRemark, field.TypeString, value)
+	}
+	_spec.AddModifiers(mu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{member.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MemberUpdateOne is the builder for updating a single Member entity.
+type MemberUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MemberMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MemberUpdateOne) SetUpdatedAt(t time.Time) *MemberUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (muo *MemberUpdateOne) SetDeletedAt(i int64) *MemberUpdateOne {
+	muo.mutation.ResetDeletedAt()
+	muo.mutation.SetDeletedAt(i)
+	return muo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableDeletedAt(i *int64) *MemberUpdateOne {
+	if i != nil {
+		muo.SetDeletedAt(*i)
+	}
+	return muo
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (muo *MemberUpdateOne) AddDeletedAt(i int64) *MemberUpdateOne {
+	muo.mutation.AddDeletedAt(i)
+	return muo
+}
+
+// SetTierID sets the "tier_id" field.
+func (muo *MemberUpdateOne) SetTierID(u uuid.UUID) *MemberUpdateOne {
+	muo.mutation.SetTierID(u)
+	return muo
+}
+
+// SetNillableTierID sets the "tier_id" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableTierID(u *uuid.UUID) *MemberUpdateOne {
+	if u != nil {
+		muo.SetTierID(*u)
+	}
+	return muo
+}
+
+// SetName sets the "name" field.
+func (muo *MemberUpdateOne) SetName(s string) *MemberUpdateOne {
+	muo.mutation.SetName(s)
+	return muo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableName(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetName(*s)
+	}
+	return muo
+}
+
+// SetPhone sets the "phone" field.
+func (muo *MemberUpdateOne) SetPhone(s string) *MemberUpdateOne {
+	muo.mutation.SetPhone(s)
+	return muo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillablePhone(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetPhone(*s)
+	}
+	return muo
+}
+
+// SetOpenid sets the "openid" field.
+func (muo *MemberUpdateOne) SetOpenid(s string) *MemberUpdateOne {
+	muo.mutation.SetOpenid(s)
+	return muo
+}
+
+// SetNillableOpenid sets the "openid" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableOpenid(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetOpenid(*s)
+	}
+	return muo
+}
+
+// SetGender sets the "gender" field.
+func (muo *MemberUpdateOne) SetGender(d domain.Gender) *MemberUpdateOne {
+	muo.mutation.SetGender(d)
+	return muo
+}
+
+// SetNillableGender sets the "gender" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableGender(d *domain.Gender) *MemberUpdateOne {
+	if d != nil {
+		muo.SetGender(*d)
+	}
+	return muo
+}
+
+// SetBirthday sets the "birthday" field.
+func (muo *MemberUpdateOne) SetBirthday(t time.Time) *MemberUpdateOne {
+	muo.mutation.SetBirthday(t)
+	return muo
+}
+
+// SetNillableBirthday sets the "birthday" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableBirthday(t *time.Time) *MemberUpdateOne {
+	if t != nil {
+		muo.SetBirthday(*t)
+	}
+	return muo
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (muo *MemberUpdateOne) ClearBirthday() *MemberUpdateOne {
+	muo.mutation.ClearBirthday()
+	return muo
+}
+
+// SetEnabled sets the "enabled" field.
+func (muo *MemberUpdateOne) SetEnabled(b bool) *MemberUpdateOne {
+	muo.mutation.SetEnabled(b)
+	return muo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableEnabled(b *bool) *MemberUpdateOne {
+	if b != nil {
+		muo.SetEnabled(*b)
+	}
+	return muo
+}
+
+// SetRemark sets the "remark" field.
+func (muo *MemberUpdateOne) SetRemark(s string) *MemberUpdateOne {
+	muo.mutation.SetRemark(s)
+	return muo
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableRemark(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetRemark(*s)
+	}
+	return muo
+}
+
+// Mutation returns the MemberMutation object of the builder.
+func (muo *MemberUpdateOne) Mutation() *MemberMutation {
+	return muo.mutation
+}
+
+// Where appends a list predicates to the MemberUpdate builder.
+func (muo *MemberUpdateOne) Where(ps ...predicate.Member) *MemberUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MemberUpdateOne) Select(field string, fields ...string) *MemberUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Member entity.
+func (muo *MemberUpdateOne) Save(ctx context.Context) (*Member, error) {
+	if err := muo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MemberUpdateOne) SaveX(ctx context.Context) *Member {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MemberUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MemberUpdateOne) defaults() error {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		if member.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized member.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := member.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MemberUpdateOne) check() error {
+	if v, ok := muo.mutation.Name(); ok {
+		if err := member.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Member.name": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Phone(); ok {
+		if err := member.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Member.phone": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Openid(); ok {
+		if err := member.OpenidValidator(v); err != nil {
+			return &ValidationError{Name: "openid", err: fmt.Errorf(`ent: validator failed for field "Member.openid": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Gender(); ok {
+		if err := member.GenderValidator(v); err != nil {
+			return &ValidationError{Name: "gender", err: fmt.Errorf(`ent: validator failed for field "Member.gender": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Remark(); ok {
+		if err := member.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "Member.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (muo *MemberUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberUpdateOne {
+	muo.modifiers = append(muo.modifiers, modifiers...)
+	return muo
+}
+
+func (muo *MemberUpdateOne) sqlSave(ctx context.Context) (_node *Member, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(member.Table, member.Columns, sqlgraph.NewFieldSpec(member.FieldID, field.TypeUUID))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Member.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, member.FieldID)
+		for _, f := range fields {
+			if !member.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != member.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(member.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := muo.mutation.DeletedAt(); ok {
+		_spec.SetField(member.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(member.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.TierID(); ok {
+		_spec.SetField(member.FieldTierID, field.TypeUUID, value)
+	}
+	if value, ok := muo.mutation.Name(); ok {
+		_spec.SetField(member.FieldName, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Phone(); ok {
+		_spec.SetField(member.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Openid(); ok {
+		_spec.SetField(member.FieldOpenid, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Gender(); ok {
+		_spec.SetField(member.FieldGender, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.Birthday(); ok {
+		_spec.SetField(member.FieldBirthday, field.TypeTime, value)
+	}
+	if muo.mutation.BirthdayCleared() {
+		_spec.ClearField(member.FieldBirthday, field.TypeTime)
+	}
+	if value, ok := muo.mutation.Enabled(); ok {
+		_spec.SetField(member.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := muo.mutation.Remark(); ok {
+		_spec.SetField(member.FieldRemark, field.TypeString, value)
+	}
+	_spec.AddModifiers(muo.modifiers...)
+	_node = &Member{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{member.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
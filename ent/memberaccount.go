@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccount"
+)
+
+// MemberAccount is the model entity for the MemberAccount schema.
+type MemberAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 会员ID
+	MemberID uuid.UUID `json:"member_id,omitempty"`
+	// 本金余额
+	Balance decimal.Decimal `json:"balance,omitempty"`
+	// 赠送金余额
+	BonusBalance decimal.Decimal `json:"bonus_balance,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MemberAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case memberaccount.FieldBalance, memberaccount.FieldBonusBalance:
+			values[i] = new(decimal.Decimal)
+		case memberaccount.FieldCreatedAt, memberaccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case memberaccount.FieldID, memberaccount.FieldMerchantID, memberaccount.FieldMemberID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MemberAccount fields.
+func (ma *MemberAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case memberaccount.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ma.ID = *value
+			}
+		case memberaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ma.CreatedAt = value.Time
+			}
+		case memberaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ma.UpdatedAt = value.Time
+			}
+		case memberaccount.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				ma.MerchantID = *value
+			}
+		case memberaccount.FieldMemberID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field member_id", values[i])
+			} else if value != nil {
+				ma.MemberID = *value
+			}
+		case memberaccount.FieldBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value != nil {
+				ma.Balance = *value
+			}
+		case memberaccount.FieldBonusBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field bonus_balance", values[i])
+			} else if value != nil {
+				ma.BonusBalance = *value
+			}
+		default:
+			ma.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MemberAccount.
+// This includes values selected through modifiers, order, etc.
+func (ma *MemberAccount) Value(name string) (ent.Value, error) {
+	return ma.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MemberAccount.
+// Note that you need to call MemberAccount.Unwrap() before calling this method if this MemberAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ma *MemberAccount) Update() *MemberAccountUpdateOne {
+	return NewMemberAccountClient(ma.config).UpdateOne(ma)
+}
+
+// Unwrap unwraps the MemberAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ma *MemberAccount) Unwrap() *MemberAccount {
+	_tx, ok := ma.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MemberAccount is not a transactional entity")
+	}
+	ma.config.driver = _tx.drv
+	return ma
+}
+
+// String implements the fmt.Stringer.
+func (ma *MemberAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("MemberAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ma.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ma.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ma.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ma.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("member_id=")
+	builder.WriteString(fmt.Sprintf("%v", ma.MemberID))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", ma.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("bonus_balance=")
+	builder.WriteString(fmt.Sprintf("%v", ma.BonusBalance))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MemberAccounts is a parsable slice of MemberAccount.
+type MemberAccounts []*MemberAccount
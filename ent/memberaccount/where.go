@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package memberaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MemberID applies equality check predicate on the "member_id" field. It's identical to MemberIDEQ.
+func MemberID(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldMemberID, v))
+}
+
+// Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
+func Balance(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldBalance, v))
+}
+
+// BonusBalance applies equality check predicate on the "bonus_balance" field. It's identical to BonusBalanceEQ.
+func BonusBalance(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldBonusBalance, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// MemberIDEQ applies the EQ predicate on the "member_id" field.
+func MemberIDEQ(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldMemberID, v))
+}
+
+// MemberIDNEQ applies the NEQ predicate on the "member_id" field.
+func MemberIDNEQ(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNEQ(FieldMemberID, v))
+}
+
+// MemberIDIn applies the In predicate on the "member_id" field.
+func MemberIDIn(vs ...uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldIn(FieldMemberID, vs...))
+}
+
+// MemberIDNotIn applies the NotIn predicate on the "member_id" field.
+func MemberIDNotIn(vs ...uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNotIn(FieldMemberID, vs...))
+}
+
+// MemberIDGT applies the GT predicate on the "member_id" field.
+func MemberIDGT(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGT(FieldMemberID, v))
+}
+
+// MemberIDGTE applies the GTE predicate on the "member_id" field.
+func MemberIDGTE(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGTE(FieldMemberID, v))
+}
+
+// MemberIDLT applies the LT predicate on the "member_id" field.
+func MemberIDLT(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLT(FieldMemberID, v))
+}
+
+// MemberIDLTE applies the LTE predicate on the "member_id" field.
+func MemberIDLTE(v uuid.UUID) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLTE(FieldMemberID, v))
+}
+
+// BalanceEQ applies the EQ predicate on the "balance" field.
+func BalanceEQ(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldBalance, v))
+}
+
+// BalanceNEQ applies the NEQ predicate on the "balance" field.
+func BalanceNEQ(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNEQ(FieldBalance, v))
+}
+
+// BalanceIn applies the In predicate on the "balance" field.
+func BalanceIn(vs ...decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldIn(FieldBalance, vs...))
+}
+
+// BalanceNotIn applies the NotIn predicate on the "balance" field.
+func BalanceNotIn(vs ...decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNotIn(FieldBalance, vs...))
+}
+
+// BalanceGT applies the GT predicate on the "balance" field.
+func BalanceGT(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGT(FieldBalance, v))
+}
+
+// BalanceGTE applies the GTE predicate on the "balance" field.
+func BalanceGTE(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGTE(FieldBalance, v))
+}
+
+// BalanceLT applies the LT predicate on the "balance" field.
+func BalanceLT(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLT(FieldBalance, v))
+}
+
+// BalanceLTE applies the LTE predicate on the "balance" field.
+func BalanceLTE(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLTE(FieldBalance, v))
+}
+
+// BonusBalanceEQ applies the EQ predicate on the "bonus_balance" field.
+func BonusBalanceEQ(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldEQ(FieldBonusBalance, v))
+}
+
+// BonusBalanceNEQ applies the NEQ predicate on the "bonus_balance" field.
+func BonusBalanceNEQ(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNEQ(FieldBonusBalance, v))
+}
+
+// BonusBalanceIn applies the In predicate on the "bonus_balance" field.
+func BonusBalanceIn(vs ...decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldIn(FieldBonusBalance, vs...))
+}
+
+// BonusBalanceNotIn applies the NotIn predicate on the "bonus_balance" field.
+func BonusBalanceNotIn(vs ...decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldNotIn(FieldBonusBalance, vs...))
+}
+
+// BonusBalanceGT applies the GT predicate on the "bonus_balance" field.
+func BonusBalanceGT(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGT(FieldBonusBalance, v))
+}
+
+// BonusBalanceGTE applies the GTE predicate on the "bonus_balance" field.
+func BonusBalanceGTE(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldGTE(FieldBonusBalance, v))
+}
+
+// BonusBalanceLT applies the LT predicate on the "bonus_balance" field.
+func BonusBalanceLT(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLT(FieldBonusBalance, v))
+}
+
+// BonusBalanceLTE applies the LTE predicate on the "bonus_balance" field.
+func BonusBalanceLTE(v decimal.Decimal) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.FieldLTE(FieldBonusBalance, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MemberAccount) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MemberAccount) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MemberAccount) predicate.MemberAccount {
+	return predicate.MemberAccount(sql.NotPredicates(p))
+}
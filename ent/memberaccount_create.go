@@ -0,0 +1,697 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccount"
+)
+
+// MemberAccountCreate is the builder for creating a MemberAccount entity.
+type MemberAccountCreate struct {
+	config
+	mutation *MemberAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mac *MemberAccountCreate) SetCreatedAt(t time.Time) *MemberAccountCreate {
+	mac.mutation.SetCreatedAt(t)
+	return mac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mac *MemberAccountCreate) SetNillableCreatedAt(t *time.Time) *MemberAccountCreate {
+	if t != nil {
+		mac.SetCreatedAt(*t)
+	}
+	return mac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mac *MemberAccountCreate) SetUpdatedAt(t time.Time) *MemberAccountCreate {
+	mac.mutation.SetUpdatedAt(t)
+	return mac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mac *MemberAccountCreate) SetNillableUpdatedAt(t *time.Time) *MemberAccountCreate {
+	if t != nil {
+		mac.SetUpdatedAt(*t)
+	}
+	return mac
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (mac *MemberAccountCreate) SetMerchantID(u uuid.UUID) *MemberAccountCreate {
+	mac.mutation.SetMerchantID(u)
+	return mac
+}
+
+// SetMemberID sets the "member_id" field.
+func (mac *MemberAccountCreate) SetMemberID(u uuid.UUID) *MemberAccountCreate {
+	mac.mutation.SetMemberID(u)
+	return mac
+}
+
+// SetBalance sets the "balance" field.
+func (mac *MemberAccountCreate) SetBalance(d decimal.Decimal) *MemberAccountCreate {
+	mac.mutation.SetBalance(d)
+	return mac
+}
+
+// SetBonusBalance sets the "bonus_balance" field.
+func (mac *MemberAccountCreate) SetBonusBalance(d decimal.Decimal) *MemberAccountCreate {
+	mac.mutation.SetBonusBalance(d)
+	return mac
+}
+
+// SetID sets the "id" field.
+func (mac *MemberAccountCreate) SetID(u uuid.UUID) *MemberAccountCreate {
+	mac.mutation.SetID(u)
+	return mac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mac *MemberAccountCreate) SetNillableID(u *uuid.UUID) *MemberAccountCreate {
+	if u != nil {
+		mac.SetID(*u)
+	}
+	return mac
+}
+
+// Mutation returns the MemberAccountMutation object of the builder.
+func (mac *MemberAccountCreate) Mutation() *MemberAccountMutation {
+	return mac.mutation
+}
+
+// Save creates the MemberAccount in the database.
+func (mac *MemberAccountCreate) Save(ctx context.Context) (*MemberAccount, error) {
+	mac.defaults()
+	return withHooks(ctx, mac.sqlSave, mac.mutation, mac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mac *MemberAccountCreate) SaveX(ctx context.Context) *MemberAccount {
+	v, err := mac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mac *MemberAccountCreate) Exec(ctx context.Context) error {
+	_, err := mac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mac *MemberAccountCreate) ExecX(ctx context.Context) {
+	if err := mac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mac *MemberAccountCreate) defaults() {
+	if _, ok := mac.mutation.CreatedAt(); !ok {
+		v := memberaccount.DefaultCreatedAt()
+		mac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mac.mutation.UpdatedAt(); !ok {
+		v := memberaccount.DefaultUpdatedAt()
+		mac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mac.mutation.ID(); !ok {
+		v := memberaccount.DefaultID()
+		mac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mac *MemberAccountCreate) check() error {
+	if _, ok := mac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MemberAccount.created_at"`)}
+	}
+	if _, ok := mac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MemberAccount.updated_at"`)}
+	}
+	if _, ok := mac.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "MemberAccount.merchant_id"`)}
+	}
+	if _, ok := mac.mutation.MemberID(); !ok {
+		return &ValidationError{Name: "member_id", err: errors.New(`ent: missing required field "MemberAccount.member_id"`)}
+	}
+	if _, ok := mac.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "MemberAccount.balance"`)}
+	}
+	if _, ok := mac.mutation.BonusBalance(); !ok {
+		return &ValidationError{Name: "bonus_balance", err: errors.New(`ent: missing required field "MemberAccount.bonus_balance"`)}
+	}
+	return nil
+}
+
+func (mac *MemberAccountCreate) sqlSave(ctx context.Context) (*MemberAccount, error) {
+	if err := mac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mac.mutation.id = &_node.ID
+	mac.mutation.done = true
+	return _node, nil
+}
+
+func (mac *MemberAccountCreate) createSpec() (*MemberAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MemberAccount{config: mac.config}
+		_spec = sqlgraph.NewCreateSpec(memberaccount.Table, sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mac.conflict
+	if id, ok := mac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mac.mutation.CreatedAt(); ok {
+		_spec.SetField(memberaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mac.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mac.mutation.MerchantID(); ok {
+		_spec.SetField(memberaccount.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := mac.mutation.MemberID(); ok {
+		_spec.SetField(memberaccount.FieldMemberID, field.TypeUUID, value)
+		_node.MemberID = value
+	}
+	if value, ok := mac.mutation.Balance(); ok {
+		_spec.SetField(memberaccount.FieldBalance, field.TypeOther, value)
+		_node.Balance = value
+	}
+	if value, ok := mac.mutation.BonusBalance(); ok {
+		_spec.SetField(memberaccount.FieldBonusBalance, field.TypeOther, value)
+		_node.BonusBalance = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberAccount.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberAccountUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mac *MemberAccountCreate) OnConflict(opts ...sql.ConflictOption) *MemberAccountUpsertOne {
+	mac.conflict = opts
+	return &MemberAccountUpsertOne{
+		create: mac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mac *MemberAccountCreate) OnConflictColumns(columns ...string) *MemberAccountUpsertOne {
+	mac.conflict = append(mac.conflict, sql.ConflictColumns(columns...))
+	return &MemberAccountUpsertOne{
+		create: mac,
+	}
+}
+
+type (
+	// MemberAccountUpsertOne is the builder for "upsert"-ing
+	//  one MemberAccount node.
+	MemberAccountUpsertOne struct {
+		create *MemberAccountCreate
+	}
+
+	// MemberAccountUpsert is the "OnConflict" setter.
+	MemberAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberAccountUpsert) SetUpdatedAt(v time.Time) *MemberAccountUpsert {
+	u.Set(memberaccount.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberAccountUpsert) UpdateUpdatedAt() *MemberAccountUpsert {
+	u.SetExcluded(memberaccount.FieldUpdatedAt)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *MemberAccountUpsert) SetBalance(v decimal.Decimal) *MemberAccountUpsert {
+	u.Set(memberaccount.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *MemberAccountUpsert) UpdateBalance() *MemberAccountUpsert {
+	u.SetExcluded(memberaccount.FieldBalance)
+	return u
+}
+
+// SetBonusBalance sets the "bonus_balance" field.
+func (u *MemberAccountUpsert) SetBonusBalance(v decimal.Decimal) *MemberAccountUpsert {
+	u.Set(memberaccount.FieldBonusBalance, v)
+	return u
+}
+
+// UpdateBonusBalance sets the "bonus_balance" field to the value that was provided on create.
+func (u *MemberAccountUpsert) UpdateBonusBalance() *MemberAccountUpsert {
+	u.SetExcluded(memberaccount.FieldBonusBalance)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MemberAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(memberaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberAccountUpsertOne) UpdateNewValues() *MemberAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(memberaccount.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(memberaccount.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(memberaccount.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.MemberID(); exists {
+			s.SetIgnore(memberaccount.FieldMemberID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MemberAccountUpsertOne) Ignore() *MemberAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberAccountUpsertOne) DoNothing() *MemberAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberAccountCreate.OnConflict
+// documentation for more info.
+func (u *MemberAccountUpsertOne) Update(set func(*MemberAccountUpsert)) *MemberAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberAccountUpsertOne) SetUpdatedAt(v time.Time) *MemberAccountUpsertOne {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberAccountUpsertOne) UpdateUpdatedAt() *MemberAccountUpsertOne {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *MemberAccountUpsertOne) SetBalance(v decimal.Decimal) *MemberAccountUpsertOne {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *MemberAccountUpsertOne) UpdateBalance() *MemberAccountUpsertOne {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetBonusBalance sets the "bonus_balance" field.
+func (u *MemberAccountUpsertOne) SetBonusBalance(v decimal.Decimal) *MemberAccountUpsertOne {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.SetBonusBalance(v)
+	})
+}
+
+// UpdateBonusBalance sets the "bonus_balance" field to the value that was provided on create.
+func (u *MemberAccountUpsertOne) UpdateBonusBalance() *MemberAccountUpsertOne {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.UpdateBonusBalance()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MemberAccountUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MemberAccountUpsertOne.ID is not supported by MySQL driver. Use MemberAccountUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MemberAccountUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MemberAccountCreateBulk is the builder for creating many MemberAccount entities in bulk.
+type MemberAccountCreateBulk struct {
+	config
+	err      error
+	builders []*MemberAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MemberAccount entities in the database.
+func (macb *MemberAccountCreateBulk) Save(ctx context.Context) ([]*MemberAccount, error) {
+	if macb.err != nil {
+		return nil, macb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(macb.builders))
+	nodes := make([]*MemberAccount, len(macb.builders))
+	mutators := make([]Mutator, len(macb.builders))
+	for i := range macb.builders {
+		func(i int, root context.Context) {
+			builder := macb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MemberAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, macb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = macb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, macb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, macb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (macb *MemberAccountCreateBulk) SaveX(ctx context.Context) []*MemberAccount {
+	v, err := macb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (macb *MemberAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := macb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (macb *MemberAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := macb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberAccountUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (macb *MemberAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *MemberAccountUpsertBulk {
+	macb.conflict = opts
+	return &MemberAccountUpsertBulk{
+		create: macb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (macb *MemberAccountCreateBulk) OnConflictColumns(columns ...string) *MemberAccountUpsertBulk {
+	macb.conflict = append(macb.conflict, sql.ConflictColumns(columns...))
+	return &MemberAccountUpsertBulk{
+		create: macb,
+	}
+}
+
+// MemberAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of MemberAccount nodes.
+type MemberAccountUpsertBulk struct {
+	create *MemberAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MemberAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(memberaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberAccountUpsertBulk) UpdateNewValues() *MemberAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(memberaccount.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(memberaccount.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(memberaccount.FieldMerchantID)
+			}
+			if _, exists := b.mutation.MemberID(); exists {
+				s.SetIgnore(memberaccount.FieldMemberID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MemberAccountUpsertBulk) Ignore() *MemberAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberAccountUpsertBulk) DoNothing() *MemberAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *MemberAccountUpsertBulk) Update(set func(*MemberAccountUpsert)) *MemberAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberAccountUpsertBulk) SetUpdatedAt(v time.Time) *MemberAccountUpsertBulk {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberAccountUpsertBulk) UpdateUpdatedAt() *MemberAccountUpsertBulk {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *MemberAccountUpsertBulk) SetBalance(v decimal.Decimal) *MemberAccountUpsertBulk {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *MemberAccountUpsertBulk) UpdateBalance() *MemberAccountUpsertBulk {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetBonusBalance sets the "bonus_balance" field.
+func (u *MemberAccountUpsertBulk) SetBonusBalance(v decimal.Decimal) *MemberAccountUpsertBulk {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.SetBonusBalance(v)
+	})
+}
+
+// UpdateBonusBalance sets the "bonus_balance" field to the value that was provided on create.
+func (u *MemberAccountUpsertBulk) UpdateBonusBalance() *MemberAccountUpsertBulk {
+	return u.Update(func(s *MemberAccountUpsert) {
+		s.UpdateBonusBalance()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MemberAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
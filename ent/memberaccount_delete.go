@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberAccountDelete is the builder for deleting a MemberAccount entity.
+type MemberAccountDelete struct {
+	config
+	hooks    []Hook
+	mutation *MemberAccountMutation
+}
+
+// Where appends a list predicates to the MemberAccountDelete builder.
+func (mad *MemberAccountDelete) Where(ps ...predicate.MemberAccount) *MemberAccountDelete {
+	mad.mutation.Where(ps...)
+	return mad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mad *MemberAccountDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mad.sqlExec, mad.mutation, mad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mad *MemberAccountDelete) ExecX(ctx context.Context) int {
+	n, err := mad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mad *MemberAccountDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(memberaccount.Table, sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeUUID))
+	if ps := mad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mad.mutation.done = true
+	return affected, err
+}
+
+// MemberAccountDeleteOne is the builder for deleting a single MemberAccount entity.
+type MemberAccountDeleteOne struct {
+	mad *MemberAccountDelete
+}
+
+// Where appends a list predicates to the MemberAccountDelete builder.
+func (mado *MemberAccountDeleteOne) Where(ps ...predicate.MemberAccount) *MemberAccountDeleteOne {
+	mado.mad.mutation.Where(ps...)
+	return mado
+}
+
+// Exec executes the deletion query.
+func (mado *MemberAccountDeleteOne) Exec(ctx context.Context) error {
+	n, err := mado.mad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{memberaccount.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mado *MemberAccountDeleteOne) ExecX(ctx context.Context) {
+	if err := mado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
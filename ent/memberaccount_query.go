@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberAccountQuery is the builder for querying MemberAccount entities.
+type MemberAccountQuery struct {
+	config
+	ctx        *QueryContext
+	order      []memberaccount.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MemberAccount
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MemberAccountQuery builder.
+func (maq *MemberAccountQuery) Where(ps ...predicate.MemberAccount) *MemberAccountQuery {
+	maq.predicates = append(maq.predicates, ps...)
+	return maq
+}
+
+// Limit the number of records to be returned by this query.
+func (maq *MemberAccountQuery) Limit(limit int) *MemberAccountQuery {
+	maq.ctx.Limit = &limit
+	return maq
+}
+
+// Offset to start from.
+func (maq *MemberAccountQuery) Offset(offset int) *MemberAccountQuery {
+	maq.ctx.Offset = &offset
+	return maq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (maq *MemberAccountQuery) Unique(unique bool) *MemberAccountQuery {
+	maq.ctx.Unique = &unique
+	return maq
+}
+
+// Order specifies how the records should be ordered.
+func (maq *MemberAccountQuery) Order(o ...memberaccount.OrderOption) *MemberAccountQuery {
+	maq.order = append(maq.order, o...)
+	return maq
+}
+
+// First returns the first MemberAccount entity from the query.
+// Returns a *NotFoundError when no MemberAccount was found.
+func (maq *MemberAccountQuery) First(ctx context.Context) (*MemberAccount, error) {
+	nodes, err := maq.Limit(1).All(setContextOp(ctx, maq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{memberaccount.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (maq *MemberAccountQuery) FirstX(ctx context.Context) *MemberAccount {
+	node, err := maq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MemberAccount ID from the query.
+// Returns a *NotFoundError when no MemberAccount ID was found.
+func (maq *MemberAccountQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = maq.Limit(1).IDs(setContextOp(ctx, maq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{memberaccount.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (maq *MemberAccountQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := maq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MemberAccount entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MemberAccount entity is found.
+// Returns a *NotFoundError when no MemberAccount entities are found.
+func (maq *MemberAccountQuery) Only(ctx context.Context) (*MemberAccount, error) {
+	nodes, err := maq.Limit(2).All(setContextOp(ctx, maq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{memberaccount.Label}
+	default:
+		return nil, &NotSingularError{memberaccount.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (maq *MemberAccountQuery) OnlyX(ctx context.Context) *MemberAccount {
+	node, err := maq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MemberAccount ID in the query.
+// Returns a *NotSingularError when more than one MemberAccount ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (maq *MemberAccountQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = maq.Limit(2).IDs(setContextOp(ctx, maq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{memberaccount.Label}
+	default:
+		err = &NotSingularError{memberaccount.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (maq *MemberAccountQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := maq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MemberAccounts.
+func (maq *MemberAccountQuery) All(ctx context.Context) ([]*MemberAccount, error) {
+	ctx = setContextOp(ctx, maq.ctx, ent.OpQueryAll)
+	if err := maq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MemberAccount, *MemberAccountQuery]()
+	return withInterceptors[[]*MemberAccount](ctx, maq, qr, maq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (maq *MemberAccountQuery) AllX(ctx context.Context) []*MemberAccount {
+	nodes, err := maq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MemberAccount IDs.
+func (maq *MemberAccountQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if maq.ctx.Unique == nil && maq.path != nil {
+		maq.Unique(true)
+	}
+	ctx = setContextOp(ctx, maq.ctx, ent.OpQueryIDs)
+	if err = maq.Select(memberaccount.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (maq *MemberAccountQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := maq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (maq *MemberAccountQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, maq.ctx, ent.OpQueryCount)
+	if err := maq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, maq, querierCount[*MemberAccountQuery](), maq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (maq *MemberAccountQuery) CountX(ctx context.Context) int {
+	count, err := maq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (maq *MemberAccountQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, maq.ctx, ent.OpQueryExist)
+	switch _, err := maq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (maq *MemberAccountQuery) ExistX(ctx context.Context) bool {
+	exist, err := maq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MemberAccountQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (maq *MemberAccountQuery) Clone() *MemberAccountQuery {
+	if maq == nil {
+		return nil
+	}
+	return &MemberAccountQuery{
+		config:     maq.config,
+		ctx:        maq.ctx.Clone(),
+		order:      append([]memberaccount.OrderOption{}, maq.order...),
+		inters:     append([]Interceptor{}, maq.inters...),
+		predicates: append([]predicate.MemberAccount{}, maq.predicates...),
+		// clone intermediate query.
+		sql:       maq.sql.Clone(),
+		path:      maq.path,
+		modifiers: append([]func(*sql.Selector){}, maq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MemberAccount.Query().
+//		GroupBy(memberaccount.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (maq *MemberAccountQuery) GroupBy(field string, fields ...string) *MemberAccountGroupBy {
+	maq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MemberAccountGroupBy{build: maq}
+	grbuild.flds = &maq.ctx.Fields
+	grbuild.label = memberaccount.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.MemberAccount.Query().
+//		Select(memberaccount.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (maq *MemberAccountQuery) Select(fields ...string) *MemberAccountSelect {
+	maq.ctx.Fields = append(maq.ctx.Fields, fields...)
+	sbuild := &MemberAccountSelect{MemberAccountQuery: maq}
+	sbuild.label = memberaccount.Label
+	sbuild.flds, sbuild.scan = &maq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MemberAccountSelect configured with the given aggregations.
+func (maq *MemberAccountQuery) Aggregate(fns ...AggregateFunc) *MemberAccountSelect {
+	return maq.Select().Aggregate(fns...)
+}
+
+func (maq *MemberAccountQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range maq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, maq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range maq.ctx.Fields {
+		if !memberaccount.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if maq.path != nil {
+		prev, err := maq.path(ctx)
+		if err != nil {
+			return err
+		}
+		maq.sql = prev
+	}
+	return nil
+}
+
+func (maq *MemberAccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MemberAccount, error) {
+	var (
+		nodes = []*MemberAccount{}
+		_spec = maq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MemberAccount).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MemberAccount{config: maq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(maq.modifiers) > 0 {
+		_spec.Modifiers = maq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, maq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (maq *MemberAccountQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := maq.querySpec()
+	if len(maq.modifiers) > 0 {
+		_spec.Modifiers = maq.modifiers
+	}
+	_spec.Node.Columns = maq.ctx.Fields
+	if len(maq.ctx.Fields) > 0 {
+		_spec.Unique = maq.ctx.Unique != nil && *maq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, maq.driver, _spec)
+}
+
+func (maq *MemberAccountQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(memberaccount.Table, memberaccount.Columns, sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeUUID))
+	_spec.From = maq.sql
+	if unique := maq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if maq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := maq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, memberaccount.FieldID)
+		for i := range fields {
+			if fields[i] != memberaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := maq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := maq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := maq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := maq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (maq *MemberAccountQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(maq.driver.Dialect())
+	t1 := builder.Table(memberaccount.Table)
+	columns := maq.ctx.Fields
+	if len(columns) == 0 {
+		columns = memberaccount.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if maq.sql != nil {
+		selector = maq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if maq.ctx.Unique != nil && *maq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range maq.modifiers {
+		m(selector)
+	}
+	for _, p := range maq.predicates {
+		p(selector)
+	}
+	for _, p := range maq.order {
+		p(selector)
+	}
+	if offset := maq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := maq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (maq *MemberAccountQuery) ForUpdate(opts ...sql.LockOption) *MemberAccountQuery {
+	if maq.driver.Dialect() == dialect.Postgres {
+		maq.Unique(false)
+	}
+	maq.modifiers = append(maq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return maq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (maq *MemberAccountQuery) ForShare(opts ...sql.LockOption) *MemberAccountQuery {
+	if maq.driver.Dialect() == dialect.Postgres {
+		maq.Unique(false)
+	}
+	maq.modifiers = append(maq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return maq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (maq *MemberAccountQuery) Modify(modifiers ...func(s *sql.Selector)) *MemberAccountSelect {
+	maq.modifiers = append(maq.modifiers, modifiers...)
+	return maq.Select()
+}
+
+// MemberAccountGroupBy is the group-by builder for MemberAccount entities.
+type MemberAccountGroupBy struct {
+	selector
+	build *MemberAccountQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (magb *MemberAccountGroupBy) Aggregate(fns ...AggregateFunc) *MemberAccountGroupBy {
+	magb.fns = append(magb.fns, fns...)
+	return magb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (magb *MemberAccountGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, magb.build.ctx, ent.OpQueryGroupBy)
+	if err := magb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberAccountQuery, *MemberAccountGroupBy](ctx, magb.build, magb, magb.build.inters, v)
+}
+
+func (magb *MemberAccountGroupBy) sqlScan(ctx context.Context, root *MemberAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(magb.fns))
+	for _, fn := range magb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*magb.flds)+len(magb.fns))
+		for _, f := range *magb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*magb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := magb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MemberAccountSelect is the builder for selecting fields of MemberAccount entities.
+type MemberAccountSelect struct {
+	*MemberAccountQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mas *MemberAccountSelect) Aggregate(fns ...AggregateFunc) *MemberAccountSelect {
+	mas.fns = append(mas.fns, fns...)
+	return mas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mas *MemberAccountSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mas.ctx, ent.OpQuerySelect)
+	if err := mas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberAccountQuery, *MemberAccountSelect](ctx, mas.MemberAccountQuery, mas, mas.inters, v)
+}
+
+func (mas *MemberAccountSelect) sqlScan(ctx context.Context, root *MemberAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mas.fns))
+	for _, fn := range mas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mas *MemberAccountSelect) Modify(modifiers ...func(s *sql.Selector)) *MemberAccountSelect {
+	mas.modifiers = append(mas.modifiers, modifiers...)
+	return mas
+}
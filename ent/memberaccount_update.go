@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberAccountUpdate is the builder for updating MemberAccount entities.
+type MemberAccountUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MemberAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MemberAccountUpdate builder.
+func (mau *MemberAccountUpdate) Where(ps ...predicate.MemberAccount) *MemberAccountUpdate {
+	mau.mutation.Where(ps...)
+	return mau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mau *MemberAccountUpdate) SetUpdatedAt(t time.Time) *MemberAccountUpdate {
+	mau.mutation.SetUpdatedAt(t)
+	return mau
+}
+
+// SetBalance sets the "balance" field.
+func (mau *MemberAccountUpdate) SetBalance(d decimal.Decimal) *MemberAccountUpdate {
+	mau.mutation.SetBalance(d)
+	return mau
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (mau *MemberAccountUpdate) SetNillableBalance(d *decimal.Decimal) *MemberAccountUpdate {
+	if d != nil {
+		mau.SetBalance(*d)
+	}
+	return mau
+}
+
+// SetBonusBalance sets the "bonus_balance" field.
+func (mau *MemberAccountUpdate) SetBonusBalance(d decimal.Decimal) *MemberAccountUpdate {
+	mau.mutation.SetBonusBalance(d)
+	return mau
+}
+
+// SetNillableBonusBalance sets the "bonus_balance" field if the given value is not nil.
+func (mau *MemberAccountUpdate) SetNillableBonusBalance(d *decimal.Decimal) *MemberAccountUpdate {
+	if d != nil {
+		mau.SetBonusBalance(*d)
+	}
+	return mau
+}
+
+// Mutation returns the MemberAccountMutation object of the builder.
+func (mau *MemberAccountUpdate) Mutation() *MemberAccountMutation {
+	return mau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mau *MemberAccountUpdate) Save(ctx context.Context) (int, error) {
+	mau.defaults()
+	return withHooks(ctx, mau.sqlSave, mau.mutation, mau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mau *MemberAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := mau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mau *MemberAccountUpdate) Exec(ctx context.Context) error {
+	_, err := mau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mau *MemberAccountUpdate) ExecX(ctx context.Context) {
+	if err := mau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mau *MemberAccountUpdate) defaults() {
+	if _, ok := mau.mutation.UpdatedAt(); !ok {
+		v := memberaccount.UpdateDefaultUpdatedAt()
+		mau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mau *MemberAccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberAccountUpdate {
+	mau.modifiers = append(mau.modifiers, modifiers...)
+	return mau
+}
+
+func (mau *MemberAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(memberaccount.Table, memberaccount.Columns, sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeUUID))
+	if ps := mau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mau.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mau.mutation.Balance(); ok {
+		_spec.SetField(memberaccount.FieldBalance, field.TypeOther, value)
+	}
+	if value, ok := mau.mutation.BonusBalance(); ok {
+		_spec.SetField(memberaccount.FieldBonusBalance, field.TypeOther, value)
+	}
+	_spec.AddModifiers(mau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memberaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mau.mutation.done = true
+	return n, nil
+}
+
+// MemberAccountUpdateOne is the builder for updating a single MemberAccount entity.
+type MemberAccountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MemberAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mauo *MemberAccountUpdateOne) SetUpdatedAt(t time.Time) *MemberAccountUpdateOne {
+	mauo.mutation.SetUpdatedAt(t)
+	return mauo
+}
+
+// SetBalance sets the "balance" field.
+func (mauo *MemberAccountUpdateOne) SetBalance(d decimal.Decimal) *MemberAccountUpdateOne {
+	mauo.mutation.SetBalance(d)
+	return mauo
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (mauo *MemberAccountUpdateOne) SetNillableBalance(d *decimal.Decimal) *MemberAccountUpdateOne {
+	if d != nil {
+		mauo.SetBalance(*d)
+	}
+	return mauo
+}
+
+// SetBonusBalance sets the "bonus_balance" field.
+func (mauo *MemberAccountUpdateOne) SetBonusBalance(d decimal.Decimal) *MemberAccountUpdateOne {
+	mauo.mutation.SetBonusBalance(d)
+	return mauo
+}
+
+// SetNillableBonusBalance sets the "bonus_balance" field if the given value is not nil.
+func (mauo *MemberAccountUpdateOne) SetNillableBonusBalance(d *decimal.Decimal) *MemberAccountUpdateOne {
+	if d != nil {
+		mauo.SetBonusBalance(*d)
+	}
+	return mauo
+}
+
+// Mutation returns the MemberAccountMutation object of the builder.
+func (mauo *MemberAccountUpdateOne) Mutation() *MemberAccountMutation {
+	return mauo.mutation
+}
+
+// Where appends a list predicates to the MemberAccountUpdate builder.
+func (mauo *MemberAccountUpdateOne) Where(ps ...predicate.MemberAccount) *MemberAccountUpdateOne {
+	mauo.mutation.Where(ps...)
+	return mauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mauo *MemberAccountUpdateOne) Select(field string, fields ...string) *MemberAccountUpdateOne {
+	mauo.fields = append([]string{field}, fields...)
+	return mauo
+}
+
+// Save executes the query and returns the updated MemberAccount entity.
+func (mauo *MemberAccountUpdateOne) Save(ctx context.Context) (*MemberAccount, error) {
+	mauo.defaults()
+	return withHooks(ctx, mauo.sqlSave, mauo.mutation, mauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mauo *MemberAccountUpdateOne) SaveX(ctx context.Context) *MemberAccount {
+	node, err := mauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mauo *MemberAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := mauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mauo *MemberAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := mauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mauo *MemberAccountUpdateOne) defaults() {
+	if _, ok := mauo.mutation.UpdatedAt(); !ok {
+		v := memberaccount.UpdateDefaultUpdatedAt()
+		mauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mauo *MemberAccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberAccountUpdateOne {
+	mauo.modifiers = append(mauo.modifiers, modifiers...)
+	return mauo
+}
+
+func (mauo *MemberAccountUpdateOne) sqlSave(ctx context.Context) (_node *MemberAccount, err error) {
+	_spec := sqlgraph.NewUpdateSpec(memberaccount.Table, memberaccount.Columns, sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeUUID))
+	id, ok := mauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MemberAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, memberaccount.FieldID)
+		for _, f := range fields {
+			if !memberaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != memberaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mauo.mutation.Balance(); ok {
+		_spec.SetField(memberaccount.FieldBalance, field.TypeOther, value)
+	}
+	if value, ok := mauo.mutation.BonusBalance(); ok {
+		_spec.SetField(memberaccount.FieldBonusBalance, field.TypeOther, value)
+	}
+	_spec.AddModifiers(mauo.modifiers...)
+	_node = &MemberAccount{config: mauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memberaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccounttransaction"
+)
+
+// MemberAccountTransaction is the model entity for the MemberAccountTransaction schema.
+type MemberAccountTransaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 发生门店ID（品牌后台调整为空）
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 会员ID
+	MemberID uuid.UUID `json:"member_id,omitempty"`
+	// 账户ID
+	AccountID uuid.UUID `json:"account_id,omitempty"`
+	// 流水号
+	TransactionNo string `json:"transaction_no,omitempty"`
+	// 流水类型：top_up（充值）、payment（消费）、refund（退款退回）、adjustment（人工调整）
+	Type domain.MemberAccountTransactionType `json:"type,omitempty"`
+	// 本金变动（增加为正，减少为负）
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// 赠送金变动（增加为正，减少为负）
+	BonusAmount decimal.Decimal `json:"bonus_amount,omitempty"`
+	// 变动后本金余额
+	BalanceAfter decimal.Decimal `json:"balance_after,omitempty"`
+	// 变动后赠送金余额
+	BonusBalanceAfter decimal.Decimal `json:"bonus_balance_after,omitempty"`
+	// 关联订单ID
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// 关联订单号
+	OrderNo string `json:"order_no,omitempty"`
+	// 关联退款单ID
+	RefundOrderID uuid.UUID `json:"refund_order_id,omitempty"`
+	// 充值支付方式
+	PaymentMethod string `json:"payment_method,omitempty"`
+	// 操作人ID
+	OperatorID uuid.UUID `json:"operator_id,omitempty"`
+	// 操作人名称
+	OperatorName string `json:"operator_name,omitempty"`
+	// 备注
+	Remark       string `json:"remark,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MemberAccountTransaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case memberaccounttransaction.FieldAmount, memberaccounttransaction.FieldBonusAmount, memberaccounttransaction.FieldBalanceAfter, memberaccounttransaction.FieldBonusBalanceAfter:
+			values[i] = new(decimal.Decimal)
+		case memberaccounttransaction.FieldTransactionNo, memberaccounttransaction.FieldType, memberaccounttransaction.FieldOrderNo, memberaccounttransaction.FieldPaymentMethod, memberaccounttransaction.FieldOperatorName, memberaccounttransaction.FieldRemark:
+			values[i] = new(sql.NullString)
+		case memberaccounttransaction.FieldCreatedAt, memberaccounttransaction.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case memberaccounttransaction.FieldID, memberaccounttransaction.FieldMerchantID, memberaccounttransaction.FieldStoreID, memberaccounttransaction.FieldMemberID, memberaccounttransaction.FieldAccountID, memberaccounttransaction.FieldOrderID, memberaccounttransaction.FieldRefundOrderID, memberaccounttransaction.FieldOperatorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MemberAccountTransaction fields.
+func (mat *MemberAccountTransaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case memberaccounttransaction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mat.ID = *value
+			}
+		case memberaccounttransaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mat.CreatedAt = value.Time
+			}
+		case memberaccounttransaction.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mat.UpdatedAt = value.Time
+			}
+		case memberaccounttransaction.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				mat.MerchantID = *value
+			}
+		case memberaccounttransaction.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				mat.StoreID = *value
+			}
+		case memberaccounttransaction.FieldMemberID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field member_id", values[i])
+			} else if value != nil {
+				mat.MemberID = *value
+			}
+		case memberaccounttransaction.FieldAccountID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				mat.AccountID = *value
+			}
+		case memberaccounttransaction.FieldTransactionNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_no", values[i])
+			} else if value.Valid {
+				mat.TransactionNo = value.String
+			}
+		case memberaccounttransaction.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				mat.Type = domain.MemberAccountTransactionType(value.String)
+			}
+		case memberaccounttransaction.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				mat.Amount = *value
+			}
+		case memberaccounttransaction.FieldBonusAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field bonus_amount", values[i])
+			} else if value != nil {
+				mat.BonusAmount = *value
+			}
+		case memberaccounttransaction.FieldBalanceAfter:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_after", values[i])
+			} else if value != nil {
+				mat.BalanceAfter = *value
+			}
+		case memberaccounttransaction.FieldBonusBalanceAfter:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field bonus_balance_after", values[i])
+			} else if value != nil {
+				mat.BonusBalanceAfter = *value
+			}
+		case memberaccounttransaction.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				mat.OrderID = *value
+			}
+		case memberaccounttransaction.FieldOrderNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_no", values[i])
+			} else if value.Valid {
+				mat.OrderNo = value.String
+			}
+		case memberaccounttransaction.FieldRefundOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_order_id", values[i])
+			} else if value != nil {
+				mat.RefundOrderID = *value
+			}
+		case memberaccounttransaction.FieldPaymentMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_method", values[i])
+			} else if value.Valid {
+				mat.PaymentMethod = value.String
+			}
+		case memberaccounttransaction.FieldOperatorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value != nil {
+				mat.OperatorID = *value
+			}
+		case memberaccounttransaction.FieldOperatorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_name", values[i])
+			} else if value.Valid {
+				mat.OperatorName = value.String
+			}
+		case memberaccounttransaction.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				mat.Remark = value.String
+			}
+		default:
+			mat.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MemberAccountTransaction.
+// This includes values selected through modifiers, order, etc.
+func (mat *MemberAccountTransaction) Value(name string) (ent.Value, error) {
+	return mat.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MemberAccountTransaction.
+// Note that you need to call MemberAccountTransaction.Unwrap() before calling this method if this MemberAccountTransaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mat *MemberAccountTransaction) Update() *MemberAccountTransactionUpdateOne {
+	return NewMemberAccountTransactionClient(mat.config).UpdateOne(mat)
+}
+
+// Unwrap unwraps the MemberAccountTransaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mat *MemberAccountTransaction) Unwrap() *MemberAccountTransaction {
+	_tx, ok := mat.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MemberAccountTransaction is not a transactional entity")
+	}
+	mat.config.driver = _tx.drv
+	return mat
+}
+
+// String implements the fmt.Stringer.
+func (mat *MemberAccountTransaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("MemberAccountTransaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mat.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(mat.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mat.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", mat.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", mat.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("member_id=")
+	builder.WriteString(fmt.Sprintf("%v", mat.MemberID))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", mat.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("transaction_no=")
+	builder.WriteString(mat.TransactionNo)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", mat.Type))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", mat.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("bonus_amount=")
+	builder.WriteString(fmt.Sprintf("%v", mat.BonusAmount))
+	builder.WriteString(", ")
+	builder.WriteString("balance_after=")
+	builder.WriteString(fmt.Sprintf("%v", mat.BalanceAfter))
+	builder.WriteString(", ")
+	builder.WriteString("bonus_balance_after=")
+	builder.WriteString(fmt.Sprintf("%v", mat.BonusBalanceAfter))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", mat.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("order_no=")
+	builder.WriteString(mat.OrderNo)
+	builder.WriteString(", ")
+	builder.WriteString("refund_order_id=")
+	builder.WriteString(fmt.Sprintf("%v", mat.RefundOrderID))
+	builder.WriteString(", ")
+	builder.WriteString("payment_method=")
+	builder.WriteString(mat.PaymentMethod)
+	builder.WriteString(", ")
+	builder.WriteString("operator_id=")
+	builder.WriteString(fmt.Sprintf("%v", mat.OperatorID))
+	builder.WriteString(", ")
+	builder.WriteString("operator_name=")
+	builder.WriteString(mat.OperatorName)
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(mat.Remark)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MemberAccountTransactions is a parsable slice of MemberAccountTransaction.
+type MemberAccountTransactions []*MemberAccountTransaction
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package memberaccounttransaction
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	// Label holds the string label denoting the memberaccounttransaction type in the database.
+	Label = "member_account_transaction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldMemberID holds the string denoting the member_id field in the database.
+	FieldMemberID = "member_id"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldTransactionNo holds the string denoting the transaction_no field in the database.
+	FieldTransactionNo = "transaction_no"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldBonusAmount holds the string denoting the bonus_amount field in the database.
+	FieldBonusAmount = "bonus_amount"
+	// FieldBalanceAfter holds the string denoting the balance_after field in the database.
+	FieldBalanceAfter = "balance_after"
+	// FieldBonusBalanceAfter holds the string denoting the bonus_balance_after field in the database.
+	FieldBonusBalanceAfter = "bonus_balance_after"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldOrderNo holds the string denoting the order_no field in the database.
+	FieldOrderNo = "order_no"
+	// FieldRefundOrderID holds the string denoting the refund_order_id field in the database.
+	FieldRefundOrderID = "refund_order_id"
+	// FieldPaymentMethod holds the string denoting the payment_method field in the database.
+	FieldPaymentMethod = "payment_method"
+	// FieldOperatorID holds the string denoting the operator_id field in the database.
+	FieldOperatorID = "operator_id"
+	// FieldOperatorName holds the string denoting the operator_name field in the database.
+	FieldOperatorName = "operator_name"
+	// FieldRemark holds the string denoting the remark field in the database.
+	FieldRemark = "remark"
+	// Table holds the table name of the memberaccounttransaction in the database.
+	Table = "member_account_transactions"
+)
+
+// Columns holds all SQL columns for memberaccounttransaction fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldMemberID,
+	FieldAccountID,
+	FieldTransactionNo,
+	FieldType,
+	FieldAmount,
+	FieldBonusAmount,
+	FieldBalanceAfter,
+	FieldBonusBalanceAfter,
+	FieldOrderID,
+	FieldOrderNo,
+	FieldRefundOrderID,
+	FieldPaymentMethod,
+	FieldOperatorID,
+	FieldOperatorName,
+	FieldRemark,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultStoreID holds the default value on creation for the "store_id" field.
+	DefaultStoreID func() uuid.UUID
+	// TransactionNoValidator is a validator for the "transaction_no" field. It is called by the builders before save.
+	TransactionNoValidator func(string) error
+	// DefaultOrderID holds the default value on creation for the "order_id" field.
+	DefaultOrderID func() uuid.UUID
+	// DefaultOrderNo holds the default value on creation for the "order_no" field.
+	DefaultOrderNo string
+	// OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	OrderNoValidator func(string) error
+	// DefaultRefundOrderID holds the default value on creation for the "refund_order_id" field.
+	DefaultRefundOrderID func() uuid.UUID
+	// DefaultPaymentMethod holds the default value on creation for the "payment_method" field.
+	DefaultPaymentMethod string
+	// PaymentMethodValidator is a validator for the "payment_method" field. It is called by the builders before save.
+	PaymentMethodValidator func(string) error
+	// DefaultOperatorID holds the default value on creation for the "operator_id" field.
+	DefaultOperatorID func() uuid.UUID
+	// DefaultOperatorName holds the default value on creation for the "operator_name" field.
+	DefaultOperatorName string
+	// OperatorNameValidator is a validator for the "operator_name" field. It is called by the builders before save.
+	OperatorNameValidator func(string) error
+	// DefaultRemark holds the default value on creation for the "remark" field.
+	DefaultRemark string
+	// RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	RemarkValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type domain.MemberAccountTransactionType) error {
+	switch _type {
+	case "top_up", "payment", "refund", "adjustment":
+		return nil
+	default:
+		return fmt.Errorf("memberaccounttransaction: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the MemberAccountTransaction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByMemberID orders the results by the member_id field.
+func ByMemberID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemberID, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByTransactionNo orders the results by the transaction_no field.
+func ByTransactionNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionNo, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByBonusAmount orders the results by the bonus_amount field.
+func ByBonusAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBonusAmount, opts...).ToFunc()
+}
+
+// ByBalanceAfter orders the results by the balance_after field.
+func ByBalanceAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalanceAfter, opts...).ToFunc()
+}
+
+// ByBonusBalanceAfter orders the results by the bonus_balance_after field.
+func ByBonusBalanceAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBonusBalanceAfter, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByOrderNo orders the results by the order_no field.
+func ByOrderNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderNo, opts...).ToFunc()
+}
+
+// ByRefundOrderID orders the results by the refund_order_id field.
+func ByRefundOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundOrderID, opts...).ToFunc()
+}
+
+// ByPaymentMethod orders the results by the payment_method field.
+func ByPaymentMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentMethod, opts...).ToFunc()
+}
+
+// ByOperatorID orders the results by the operator_id field.
+func ByOperatorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperatorID, opts...).ToFunc()
+}
+
+// ByOperatorName orders the results by the operator_name field.
+func ByOperatorName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperatorName, opts...).ToFunc()
+}
+
+// ByRemark orders the results by the remark field.
+func ByRemark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemark, opts...).ToFunc()
+}
@@ -0,0 +1,1038 @@
+// Code generated by ent, DO NOT EDIT.
+
+package memberaccounttransaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldStoreID, v))
+}
+
+// MemberID applies equality check predicate on the "member_id" field. It's identical to MemberIDEQ.
+func MemberID(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldMemberID, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldAccountID, v))
+}
+
+// TransactionNo applies equality check predicate on the "transaction_no" field. It's identical to TransactionNoEQ.
+func TransactionNo(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldTransactionNo, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// BonusAmount applies equality check predicate on the "bonus_amount" field. It's identical to BonusAmountEQ.
+func BonusAmount(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldBonusAmount, v))
+}
+
+// BalanceAfter applies equality check predicate on the "balance_after" field. It's identical to BalanceAfterEQ.
+func BalanceAfter(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// BonusBalanceAfter applies equality check predicate on the "bonus_balance_after" field. It's identical to BonusBalanceAfterEQ.
+func BonusBalanceAfter(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldBonusBalanceAfter, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderNo applies equality check predicate on the "order_no" field. It's identical to OrderNoEQ.
+func OrderNo(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// RefundOrderID applies equality check predicate on the "refund_order_id" field. It's identical to RefundOrderIDEQ.
+func RefundOrderID(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldRefundOrderID, v))
+}
+
+// PaymentMethod applies equality check predicate on the "payment_method" field. It's identical to PaymentMethodEQ.
+func PaymentMethod(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldPaymentMethod, v))
+}
+
+// OperatorID applies equality check predicate on the "operator_id" field. It's identical to OperatorIDEQ.
+func OperatorID(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorName applies equality check predicate on the "operator_name" field. It's identical to OperatorNameEQ.
+func OperatorName(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldOperatorName, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldRemark, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldStoreID, v))
+}
+
+// MemberIDEQ applies the EQ predicate on the "member_id" field.
+func MemberIDEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldMemberID, v))
+}
+
+// MemberIDNEQ applies the NEQ predicate on the "member_id" field.
+func MemberIDNEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldMemberID, v))
+}
+
+// MemberIDIn applies the In predicate on the "member_id" field.
+func MemberIDIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldMemberID, vs...))
+}
+
+// MemberIDNotIn applies the NotIn predicate on the "member_id" field.
+func MemberIDNotIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldMemberID, vs...))
+}
+
+// MemberIDGT applies the GT predicate on the "member_id" field.
+func MemberIDGT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldMemberID, v))
+}
+
+// MemberIDGTE applies the GTE predicate on the "member_id" field.
+func MemberIDGTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldMemberID, v))
+}
+
+// MemberIDLT applies the LT predicate on the "member_id" field.
+func MemberIDLT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldMemberID, v))
+}
+
+// MemberIDLTE applies the LTE predicate on the "member_id" field.
+func MemberIDLTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldMemberID, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldAccountID, v))
+}
+
+// TransactionNoEQ applies the EQ predicate on the "transaction_no" field.
+func TransactionNoEQ(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldTransactionNo, v))
+}
+
+// TransactionNoNEQ applies the NEQ predicate on the "transaction_no" field.
+func TransactionNoNEQ(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldTransactionNo, v))
+}
+
+// TransactionNoIn applies the In predicate on the "transaction_no" field.
+func TransactionNoIn(vs ...string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldTransactionNo, vs...))
+}
+
+// TransactionNoNotIn applies the NotIn predicate on the "transaction_no" field.
+func TransactionNoNotIn(vs ...string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldTransactionNo, vs...))
+}
+
+// TransactionNoGT applies the GT predicate on the "transaction_no" field.
+func TransactionNoGT(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldTransactionNo, v))
+}
+
+// TransactionNoGTE applies the GTE predicate on the "transaction_no" field.
+func TransactionNoGTE(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldTransactionNo, v))
+}
+
+// TransactionNoLT applies the LT predicate on the "transaction_no" field.
+func TransactionNoLT(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldTransactionNo, v))
+}
+
+// TransactionNoLTE applies the LTE predicate on the "transaction_no" field.
+func TransactionNoLTE(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldTransactionNo, v))
+}
+
+// TransactionNoContains applies the Contains predicate on the "transaction_no" field.
+func TransactionNoContains(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldContains(FieldTransactionNo, v))
+}
+
+// TransactionNoHasPrefix applies the HasPrefix predicate on the "transaction_no" field.
+func TransactionNoHasPrefix(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldHasPrefix(FieldTransactionNo, v))
+}
+
+// TransactionNoHasSuffix applies the HasSuffix predicate on the "transaction_no" field.
+func TransactionNoHasSuffix(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldHasSuffix(FieldTransactionNo, v))
+}
+
+// TransactionNoEqualFold applies the EqualFold predicate on the "transaction_no" field.
+func TransactionNoEqualFold(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEqualFold(FieldTransactionNo, v))
+}
+
+// TransactionNoContainsFold applies the ContainsFold predicate on the "transaction_no" field.
+func TransactionNoContainsFold(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldContainsFold(FieldTransactionNo, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v domain.MemberAccountTransactionType) predicate.MemberAccountTransaction {
+	vc := v
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldType, vc))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v domain.MemberAccountTransactionType) predicate.MemberAccountTransaction {
+	vc := v
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldType, vc))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...domain.MemberAccountTransactionType) predicate.MemberAccountTransaction {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldType, v...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...domain.MemberAccountTransactionType) predicate.MemberAccountTransaction {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldType, v...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldAmount, v))
+}
+
+// BonusAmountEQ applies the EQ predicate on the "bonus_amount" field.
+func BonusAmountEQ(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldBonusAmount, v))
+}
+
+// BonusAmountNEQ applies the NEQ predicate on the "bonus_amount" field.
+func BonusAmountNEQ(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldBonusAmount, v))
+}
+
+// BonusAmountIn applies the In predicate on the "bonus_amount" field.
+func BonusAmountIn(vs ...decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldBonusAmount, vs...))
+}
+
+// BonusAmountNotIn applies the NotIn predicate on the "bonus_amount" field.
+func BonusAmountNotIn(vs ...decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldBonusAmount, vs...))
+}
+
+// BonusAmountGT applies the GT predicate on the "bonus_amount" field.
+func BonusAmountGT(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldBonusAmount, v))
+}
+
+// BonusAmountGTE applies the GTE predicate on the "bonus_amount" field.
+func BonusAmountGTE(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldBonusAmount, v))
+}
+
+// BonusAmountLT applies the LT predicate on the "bonus_amount" field.
+func BonusAmountLT(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldBonusAmount, v))
+}
+
+// BonusAmountLTE applies the LTE predicate on the "bonus_amount" field.
+func BonusAmountLTE(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldBonusAmount, v))
+}
+
+// BalanceAfterEQ applies the EQ predicate on the "balance_after" field.
+func BalanceAfterEQ(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterNEQ applies the NEQ predicate on the "balance_after" field.
+func BalanceAfterNEQ(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterIn applies the In predicate on the "balance_after" field.
+func BalanceAfterIn(vs ...decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterNotIn applies the NotIn predicate on the "balance_after" field.
+func BalanceAfterNotIn(vs ...decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterGT applies the GT predicate on the "balance_after" field.
+func BalanceAfterGT(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterGTE applies the GTE predicate on the "balance_after" field.
+func BalanceAfterGTE(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLT applies the LT predicate on the "balance_after" field.
+func BalanceAfterLT(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLTE applies the LTE predicate on the "balance_after" field.
+func BalanceAfterLTE(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldBalanceAfter, v))
+}
+
+// BonusBalanceAfterEQ applies the EQ predicate on the "bonus_balance_after" field.
+func BonusBalanceAfterEQ(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldBonusBalanceAfter, v))
+}
+
+// BonusBalanceAfterNEQ applies the NEQ predicate on the "bonus_balance_after" field.
+func BonusBalanceAfterNEQ(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldBonusBalanceAfter, v))
+}
+
+// BonusBalanceAfterIn applies the In predicate on the "bonus_balance_after" field.
+func BonusBalanceAfterIn(vs ...decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldBonusBalanceAfter, vs...))
+}
+
+// BonusBalanceAfterNotIn applies the NotIn predicate on the "bonus_balance_after" field.
+func BonusBalanceAfterNotIn(vs ...decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldBonusBalanceAfter, vs...))
+}
+
+// BonusBalanceAfterGT applies the GT predicate on the "bonus_balance_after" field.
+func BonusBalanceAfterGT(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldBonusBalanceAfter, v))
+}
+
+// BonusBalanceAfterGTE applies the GTE predicate on the "bonus_balance_after" field.
+func BonusBalanceAfterGTE(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldBonusBalanceAfter, v))
+}
+
+// BonusBalanceAfterLT applies the LT predicate on the "bonus_balance_after" field.
+func BonusBalanceAfterLT(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldBonusBalanceAfter, v))
+}
+
+// BonusBalanceAfterLTE applies the LTE predicate on the "bonus_balance_after" field.
+func BonusBalanceAfterLTE(v decimal.Decimal) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldBonusBalanceAfter, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderNoEQ applies the EQ predicate on the "order_no" field.
+func OrderNoEQ(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// OrderNoNEQ applies the NEQ predicate on the "order_no" field.
+func OrderNoNEQ(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldOrderNo, v))
+}
+
+// OrderNoIn applies the In predicate on the "order_no" field.
+func OrderNoIn(vs ...string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldOrderNo, vs...))
+}
+
+// OrderNoNotIn applies the NotIn predicate on the "order_no" field.
+func OrderNoNotIn(vs ...string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldOrderNo, vs...))
+}
+
+// OrderNoGT applies the GT predicate on the "order_no" field.
+func OrderNoGT(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldOrderNo, v))
+}
+
+// OrderNoGTE applies the GTE predicate on the "order_no" field.
+func OrderNoGTE(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldOrderNo, v))
+}
+
+// OrderNoLT applies the LT predicate on the "order_no" field.
+func OrderNoLT(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldOrderNo, v))
+}
+
+// OrderNoLTE applies the LTE predicate on the "order_no" field.
+func OrderNoLTE(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldOrderNo, v))
+}
+
+// OrderNoContains applies the Contains predicate on the "order_no" field.
+func OrderNoContains(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldContains(FieldOrderNo, v))
+}
+
+// OrderNoHasPrefix applies the HasPrefix predicate on the "order_no" field.
+func OrderNoHasPrefix(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldHasPrefix(FieldOrderNo, v))
+}
+
+// OrderNoHasSuffix applies the HasSuffix predicate on the "order_no" field.
+func OrderNoHasSuffix(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldHasSuffix(FieldOrderNo, v))
+}
+
+// OrderNoEqualFold applies the EqualFold predicate on the "order_no" field.
+func OrderNoEqualFold(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEqualFold(FieldOrderNo, v))
+}
+
+// OrderNoContainsFold applies the ContainsFold predicate on the "order_no" field.
+func OrderNoContainsFold(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldContainsFold(FieldOrderNo, v))
+}
+
+// RefundOrderIDEQ applies the EQ predicate on the "refund_order_id" field.
+func RefundOrderIDEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldRefundOrderID, v))
+}
+
+// RefundOrderIDNEQ applies the NEQ predicate on the "refund_order_id" field.
+func RefundOrderIDNEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldRefundOrderID, v))
+}
+
+// RefundOrderIDIn applies the In predicate on the "refund_order_id" field.
+func RefundOrderIDIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldRefundOrderID, vs...))
+}
+
+// RefundOrderIDNotIn applies the NotIn predicate on the "refund_order_id" field.
+func RefundOrderIDNotIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldRefundOrderID, vs...))
+}
+
+// RefundOrderIDGT applies the GT predicate on the "refund_order_id" field.
+func RefundOrderIDGT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldRefundOrderID, v))
+}
+
+// RefundOrderIDGTE applies the GTE predicate on the "refund_order_id" field.
+func RefundOrderIDGTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldRefundOrderID, v))
+}
+
+// RefundOrderIDLT applies the LT predicate on the "refund_order_id" field.
+func RefundOrderIDLT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldRefundOrderID, v))
+}
+
+// RefundOrderIDLTE applies the LTE predicate on the "refund_order_id" field.
+func RefundOrderIDLTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldRefundOrderID, v))
+}
+
+// PaymentMethodEQ applies the EQ predicate on the "payment_method" field.
+func PaymentMethodEQ(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldPaymentMethod, v))
+}
+
+// PaymentMethodNEQ applies the NEQ predicate on the "payment_method" field.
+func PaymentMethodNEQ(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldPaymentMethod, v))
+}
+
+// PaymentMethodIn applies the In predicate on the "payment_method" field.
+func PaymentMethodIn(vs ...string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldPaymentMethod, vs...))
+}
+
+// PaymentMethodNotIn applies the NotIn predicate on the "payment_method" field.
+func PaymentMethodNotIn(vs ...string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldPaymentMethod, vs...))
+}
+
+// PaymentMethodGT applies the GT predicate on the "payment_method" field.
+func PaymentMethodGT(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldPaymentMethod, v))
+}
+
+// PaymentMethodGTE applies the GTE predicate on the "payment_method" field.
+func PaymentMethodGTE(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldPaymentMethod, v))
+}
+
+// PaymentMethodLT applies the LT predicate on the "payment_method" field.
+func PaymentMethodLT(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldPaymentMethod, v))
+}
+
+// PaymentMethodLTE applies the LTE predicate on the "payment_method" field.
+func PaymentMethodLTE(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldPaymentMethod, v))
+}
+
+// PaymentMethodContains applies the Contains predicate on the "payment_method" field.
+func PaymentMethodContains(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldContains(FieldPaymentMethod, v))
+}
+
+// PaymentMethodHasPrefix applies the HasPrefix predicate on the "payment_method" field.
+func PaymentMethodHasPrefix(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldHasPrefix(FieldPaymentMethod, v))
+}
+
+// PaymentMethodHasSuffix applies the HasSuffix predicate on the "payment_method" field.
+func PaymentMethodHasSuffix(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldHasSuffix(FieldPaymentMethod, v))
+}
+
+// PaymentMethodEqualFold applies the EqualFold predicate on the "payment_method" field.
+func PaymentMethodEqualFold(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEqualFold(FieldPaymentMethod, v))
+}
+
+// PaymentMethodContainsFold applies the ContainsFold predicate on the "payment_method" field.
+func PaymentMethodContainsFold(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldContainsFold(FieldPaymentMethod, v))
+}
+
+// OperatorIDEQ applies the EQ predicate on the "operator_id" field.
+func OperatorIDEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorIDNEQ applies the NEQ predicate on the "operator_id" field.
+func OperatorIDNEQ(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldOperatorID, v))
+}
+
+// OperatorIDIn applies the In predicate on the "operator_id" field.
+func OperatorIDIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDNotIn applies the NotIn predicate on the "operator_id" field.
+func OperatorIDNotIn(vs ...uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDGT applies the GT predicate on the "operator_id" field.
+func OperatorIDGT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldOperatorID, v))
+}
+
+// OperatorIDGTE applies the GTE predicate on the "operator_id" field.
+func OperatorIDGTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldOperatorID, v))
+}
+
+// OperatorIDLT applies the LT predicate on the "operator_id" field.
+func OperatorIDLT(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldOperatorID, v))
+}
+
+// OperatorIDLTE applies the LTE predicate on the "operator_id" field.
+func OperatorIDLTE(v uuid.UUID) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldOperatorID, v))
+}
+
+// OperatorNameEQ applies the EQ predicate on the "operator_name" field.
+func OperatorNameEQ(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldOperatorName, v))
+}
+
+// OperatorNameNEQ applies the NEQ predicate on the "operator_name" field.
+func OperatorNameNEQ(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldOperatorName, v))
+}
+
+// OperatorNameIn applies the In predicate on the "operator_name" field.
+func OperatorNameIn(vs ...string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldOperatorName, vs...))
+}
+
+// OperatorNameNotIn applies the NotIn predicate on the "operator_name" field.
+func OperatorNameNotIn(vs ...string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldOperatorName, vs...))
+}
+
+// OperatorNameGT applies the GT predicate on the "operator_name" field.
+func OperatorNameGT(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldOperatorName, v))
+}
+
+// OperatorNameGTE applies the GTE predicate on the "operator_name" field.
+func OperatorNameGTE(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldOperatorName, v))
+}
+
+// OperatorNameLT applies the LT predicate on the "operator_name" field.
+func OperatorNameLT(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldOperatorName, v))
+}
+
+// OperatorNameLTE applies the LTE predicate on the "operator_name" field.
+func OperatorNameLTE(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldOperatorName, v))
+}
+
+// OperatorNameContains applies the Contains predicate on the "operator_name" field.
+func OperatorNameContains(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldContains(FieldOperatorName, v))
+}
+
+// OperatorNameHasPrefix applies the HasPrefix predicate on the "operator_name" field.
+func OperatorNameHasPrefix(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldHasPrefix(FieldOperatorName, v))
+}
+
+// OperatorNameHasSuffix applies the HasSuffix predicate on the "operator_name" field.
+func OperatorNameHasSuffix(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldHasSuffix(FieldOperatorName, v))
+}
+
+// OperatorNameEqualFold applies the EqualFold predicate on the "operator_name" field.
+func OperatorNameEqualFold(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEqualFold(FieldOperatorName, v))
+}
+
+// OperatorNameContainsFold applies the ContainsFold predicate on the "operator_name" field.
+func OperatorNameContainsFold(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldContainsFold(FieldOperatorName, v))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MemberAccountTransaction) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MemberAccountTransaction) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MemberAccountTransaction) predicate.MemberAccountTransaction {
+	return predicate.MemberAccountTransaction(sql.NotPredicates(p))
+}
@@ -0,0 +1,1003 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccounttransaction"
+)
+
+// MemberAccountTransactionCreate is the builder for creating a MemberAccountTransaction entity.
+type MemberAccountTransactionCreate struct {
+	config
+	mutation *MemberAccountTransactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (matc *MemberAccountTransactionCreate) SetCreatedAt(t time.Time) *MemberAccountTransactionCreate {
+	matc.mutation.SetCreatedAt(t)
+	return matc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (matc *MemberAccountTransactionCreate) SetNillableCreatedAt(t *time.Time) *MemberAccountTransactionCreate {
+	if t != nil {
+		matc.SetCreatedAt(*t)
+	}
+	return matc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (matc *MemberAccountTransactionCreate) SetUpdatedAt(t time.Time) *MemberAccountTransactionCreate {
+	matc.mutation.SetUpdatedAt(t)
+	return matc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (matc *MemberAccountTransactionCreate) SetNillableUpdatedAt(t *time.Time) *MemberAccountTransactionCreate {
+	if t != nil {
+		matc.SetUpdatedAt(*t)
+	}
+	return matc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (matc *MemberAccountTransactionCreate) SetMerchantID(u uuid.UUID) *MemberAccountTransactionCreate {
+	matc.mutation.SetMerchantID(u)
+	return matc
+}
+
+// SetStoreID sets the "store_id" field.
+func (matc *MemberAccountTransactionCreate) SetStoreID(u uuid.UUID) *MemberAccountTransactionCreate {
+	matc.mutation.SetStoreID(u)
+	return matc
+}
+
+// SetNillableStoreID sets the "store_id" field if the given value is not nil.
+func (matc *MemberAccountTransactionCreate) SetNillableStoreID(u *uuid.UUID) *MemberAccountTransactionCreate {
+	if u != nil {
+		matc.SetStoreID(*u)
+	}
+	return matc
+}
+
+// SetMemberID sets the "member_id" field.
+func (matc *MemberAccountTransactionCreate) SetMemberID(u uuid.UUID) *MemberAccountTransactionCreate {
+	matc.mutation.SetMemberID(u)
+	return matc
+}
+
+// SetAccountID sets the "account_id" field.
+func (matc *MemberAccountTransactionCreate) SetAccountID(u uuid.UUID) *MemberAccountTransactionCreate {
+	matc.mutation.SetAccountID(u)
+	return matc
+}
+
+// SetTransactionNo sets the "transaction_no" field.
+func (matc *MemberAccountTransactionCreate) SetTransactionNo(s string) *MemberAccountTransactionCreate {
+	matc.mutation.SetTransactionNo(s)
+	return matc
+}
+
+// SetType sets the "type" field.
+func (matc *MemberAccountTransactionCreate) SetType(datt domain.MemberAccountTransactionType) *MemberAccountTransactionCreate {
+	matc.mutation.SetType(datt)
+	return matc
+}
+
+// SetAmount sets the "amount" field.
+func (matc *MemberAccountTransactionCreate) SetAmount(d decimal.Decimal) *MemberAccountTransactionCreate {
+	matc.mutation.SetAmount(d)
+	return matc
+}
+
+// SetBonusAmount sets the "bonus_amount" field.
+func (matc *MemberAccountTransactionCreate) SetBonusAmount(d decimal.Decimal) *MemberAccountTransactionCreate {
+	matc.mutation.SetBonusAmount(d)
+	return matc
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (matc *MemberAccountTransactionCreate) SetBalanceAfter(d decimal.Decimal) *MemberAccountTransactionCreate {
+	matc.mutation.SetBalanceAfter(d)
+	return matc
+}
+
+// SetBonusBalanceAfter sets the "bonus_balance_after" field.
+func (matc *MemberAccountTransactionCreate) SetBonusBalanceAfter(d decimal.Decimal) *MemberAccountTransactionCreate {
+	matc.mutation.SetBonusBalanceAfter(d)
+	return matc
+}
+
+// SetOrderID sets the "order_id" field.
+func (matc *MemberAccountTransactionCreate) SetOrderID(u uuid.UUID) *MemberAccountTransactionCreate {
+	matc.mutation.SetOrderID(u)
+	return matc
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (matc *MemberAccountTransactionCreate) SetNillableOrderID(u *uuid.UUID) *MemberAccountTransactionCreate {
+	if u != nil {
+		matc.SetOrderID(*u)
+	}
+	return matc
+}
+
+// SetOrderNo sets the "order_no" field.
+func (matc *MemberAccountTransactionCreate) SetOrderNo(s string) *MemberAccountTransactionCreate {
+	matc.mutation.SetOrderNo(s)
+	return matc
+}
+
+// SetNillableOrderNo sets the "order_no" field if the given value is not nil.
+func (matc *MemberAccountTransactionCreate) SetNillableOrderNo(s *string) *MemberAccountTransactionCreate {
+	if s != nil {
+		matc.SetOrderNo(*s)
+	}
+	return matc
+}
+
+// SetRefundOrderID sets the "refund_order_id" field.
+func (matc *MemberAccountTransactionCreate) SetRefundOrderID(u uuid.UUID) *MemberAccountTransactionCreate {
+	matc.mutation.SetRefundOrderID(u)
+	return matc
+}
+
+// SetNillableRefundOrderID sets the "refund_order_id" field if the given value is not nil.
+func (matc *MemberAccountTransactionCreate) SetNillableRefundOrderID(u *uuid.UUID) *MemberAccountTransactionCreate {
+	if u != nil {
+		matc.SetRefundOrderID(*u)
+	}
+	return matc
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (matc *MemberAccountTransactionCreate) SetPaymentMethod(s string) *MemberAccountTransactionCreate {
+	matc.mutation.SetPaymentMethod(s)
+	return matc
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (matc *MemberAccountTransactionCreate) SetNillablePaymentMethod(s *string) *MemberAccountTransactionCreate {
+	if s != nil {
+		matc.SetPaymentMethod(*s)
+	}
+	return matc
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (matc *MemberAccountTransactionCreate) SetOperatorID(u uuid.UUID) *MemberAccountTransactionCreate {
+	matc.mutation.SetOperatorID(u)
+	return matc
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (matc *MemberAccountTransactionCreate) SetNillableOperatorID(u *uuid.UUID) *MemberAccountTransactionCreate {
+	if u != nil {
+		matc.SetOperatorID(*u)
+	}
+	return matc
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (matc *MemberAccountTransactionCreate) SetOperatorName(s string) *MemberAccountTransactionCreate {
+	matc.mutation.SetOperatorName(s)
+	return matc
+}
+
+// SetNillableOperatorName sets the "operator_name" field if the given value is not nil.
+func (matc *MemberAccountTransactionCreate) SetNillableOperatorName(s *string) *MemberAccountTransactionCreate {
+	if s != nil {
+		matc.SetOperatorName(*s)
+	}
+	return matc
+}
+
+// SetRemark sets the "remark" field.
+func (matc *MemberAccountTransactionCreate) SetRemark(s string) *MemberAccountTransactionCreate {
+	matc.mutation.SetRemark(s)
+	return matc
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (matc *MemberAccountTransactionCreate) SetNillableRemark(s *string) *MemberAccountTransactionCreate {
+	if s != nil {
+		matc.SetRemark(*s)
+	}
+	return matc
+}
+
+// SetID sets the "id" field.
+func (matc *MemberAccountTransactionCreate) SetID(u uuid.UUID) *MemberAccountTransactionCreate {
+	matc.mutation.SetID(u)
+	return matc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (matc *MemberAccountTransactionCreate) SetNillableID(u *uuid.UUID) *MemberAccountTransactionCreate {
+	if u != nil {
+		matc.SetID(*u)
+	}
+	return matc
+}
+
+// Mutation returns the MemberAccountTransactionMutation object of the builder.
+func (matc *MemberAccountTransactionCreate) Mutation() *MemberAccountTransactionMutation {
+	return matc.mutation
+}
+
+// Save creates the MemberAccountTransaction in the database.
+func (matc *MemberAccountTransactionCreate) Save(ctx context.Context) (*MemberAccountTransaction, error) {
+	matc.defaults()
+	return withHooks(ctx, matc.sqlSave, matc.mutation, matc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (matc *MemberAccountTransactionCreate) SaveX(ctx context.Context) *MemberAccountTransaction {
+	v, err := matc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (matc *MemberAccountTransactionCreate) Exec(ctx context.Context) error {
+	_, err := matc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (matc *MemberAccountTransactionCreate) ExecX(ctx context.Context) {
+	if err := matc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (matc *MemberAccountTransactionCreate) defaults() {
+	if _, ok := matc.mutation.CreatedAt(); !ok {
+		v := memberaccounttransaction.DefaultCreatedAt()
+		matc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := matc.mutation.UpdatedAt(); !ok {
+		v := memberaccounttransaction.DefaultUpdatedAt()
+		matc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := matc.mutation.StoreID(); !ok {
+		v := memberaccounttransaction.DefaultStoreID()
+		matc.mutation.SetStoreID(v)
+	}
+	if _, ok := matc.mutation.OrderID(); !ok {
+		v := memberaccounttransaction.DefaultOrderID()
+		matc.mutation.SetOrderID(v)
+	}
+	if _, ok := matc.mutation.OrderNo(); !ok {
+		v := memberaccounttransaction.DefaultOrderNo
+		matc.mutation.SetOrderNo(v)
+	}
+	if _, ok := matc.mutation.RefundOrderID(); !ok {
+		v := memberaccounttransaction.DefaultRefundOrderID()
+		matc.mutation.SetRefundOrderID(v)
+	}
+	if _, ok := matc.mutation.PaymentMethod(); !ok {
+		v := memberaccounttransaction.DefaultPaymentMethod
+		matc.mutation.SetPaymentMethod(v)
+	}
+	if _, ok := matc.mutation.OperatorID(); !ok {
+		v := memberaccounttransaction.DefaultOperatorID()
+		matc.mutation.SetOperatorID(v)
+	}
+	if _, ok := matc.mutation.OperatorName(); !ok {
+		v := memberaccounttransaction.DefaultOperatorName
+		matc.mutation.SetOperatorName(v)
+	}
+	if _, ok := matc.mutation.Remark(); !ok {
+		v := memberaccounttransaction.DefaultRemark
+		matc.mutation.SetRemark(v)
+	}
+	if _, ok := matc.mutation.ID(); !ok {
+		v := memberaccounttransaction.DefaultID()
+		matc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (matc *MemberAccountTransactionCreate) check() error {
+	if _, ok := matc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MemberAccountTransaction.created_at"`)}
+	}
+	if _, ok := matc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MemberAccountTransaction.updated_at"`)}
+	}
+	if _, ok := matc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "MemberAccountTransaction.merchant_id"`)}
+	}
+	if _, ok := matc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "MemberAccountTransaction.store_id"`)}
+	}
+	if _, ok := matc.mutation.MemberID(); !ok {
+		return &ValidationError{Name: "member_id", err: errors.New(`ent: missing required field "MemberAccountTransaction.member_id"`)}
+	}
+	if _, ok := matc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "MemberAccountTransaction.account_id"`)}
+	}
+	if _, ok := matc.mutation.TransactionNo(); !ok {
+		return &ValidationError{Name: "transaction_no", err: errors.New(`ent: missing required field "MemberAccountTransaction.transaction_no"`)}
+	}
+	if v, ok := matc.mutation.TransactionNo(); ok {
+		if err := memberaccounttransaction.TransactionNoValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_no", err: fmt.Errorf(`ent: validator failed for field "MemberAccountTransaction.transaction_no": %w`, err)}
+		}
+	}
+	if _, ok := matc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "MemberAccountTransaction.type"`)}
+	}
+	if v, ok := matc.mutation.GetType(); ok {
+		if err := memberaccounttransaction.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "MemberAccountTransaction.type": %w`, err)}
+		}
+	}
+	if _, ok := matc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "MemberAccountTransaction.amount"`)}
+	}
+	if _, ok := matc.mutation.BonusAmount(); !ok {
+		return &ValidationError{Name: "bonus_amount", err: errors.New(`ent: missing required field "MemberAccountTransaction.bonus_amount"`)}
+	}
+	if _, ok := matc.mutation.BalanceAfter(); !ok {
+		return &ValidationError{Name: "balance_after", err: errors.New(`ent: missing required field "MemberAccountTransaction.balance_after"`)}
+	}
+	if _, ok := matc.mutation.BonusBalanceAfter(); !ok {
+		return &ValidationError{Name: "bonus_balance_after", err: errors.New(`ent: missing required field "MemberAccountTransaction.bonus_balance_after"`)}
+	}
+	if _, ok := matc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "MemberAccountTransaction.order_id"`)}
+	}
+	if _, ok := matc.mutation.OrderNo(); !ok {
+		return &ValidationError{Name: "order_no", err: errors.New(`ent: missing required field "MemberAccountTransaction.order_no"`)}
+	}
+	if v, ok := matc.mutation.OrderNo(); ok {
+		if err := memberaccounttransaction.OrderNoValidator(v); err != nil {
+			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "MemberAccountTransaction.order_no": %w`, err)}
+		}
+	}
+	if _, ok := matc.mutation.RefundOrderID(); !ok {
+		return &ValidationError{Name: "refund_order_id", err: errors.New(`ent: missing required field "MemberAccountTransaction.refund_order_id"`)}
+	}
+	if _, ok := matc.mutation.PaymentMethod(); !ok {
+		return &ValidationError{Name: "payment_method", err: errors.New(`ent: missing required field "MemberAccountTransaction.payment_method"`)}
+	}
+	if v, ok := matc.mutation.PaymentMethod(); ok {
+		if err := memberaccounttransaction.PaymentMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "MemberAccountTransaction.payment_method": %w`, err)}
+		}
+	}
+	if _, ok := matc.mutation.OperatorID(); !ok {
+		return &ValidationError{Name: "operator_id", err: errors.New(`ent: missing required field "MemberAccountTransaction.operator_id"`)}
+	}
+	if _, ok := matc.mutation.OperatorName(); !ok {
+		return &ValidationError{Name: "operator_name", err: errors.New(`ent: missing required field "MemberAccountTransaction.operator_name"`)}
+	}
+	if v, ok := matc.mutation.OperatorName(); ok {
+		if err := memberaccounttransaction.OperatorNameValidator(v); err != nil {
+			return &ValidationError{Name: "operator_name", err: fmt.Errorf(`ent: validator failed for field "MemberAccountTransaction.operator_name": %w`, err)}
+		}
+	}
+	if _, ok := matc.mutation.Remark(); !ok {
+		return &ValidationError{Name: "remark", err: errors.New(`ent: missing required field "MemberAccountTransaction.remark"`)}
+	}
+	if v, ok := matc.mutation.Remark(); ok {
+		if err := memberaccounttransaction.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "MemberAccountTransaction.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (matc *MemberAccountTransactionCreate) sqlSave(ctx context.Context) (*MemberAccountTransaction, error) {
+	if err := matc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := matc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, matc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	matc.mutation.id = &_node.ID
+	matc.mutation.done = true
+	return _node, nil
+}
+
+func (matc *MemberAccountTransactionCreate) createSpec() (*MemberAccountTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MemberAccountTransaction{config: matc.config}
+		_spec = sqlgraph.NewCreateSpec(memberaccounttransaction.Table, sqlgraph.NewFieldSpec(memberaccounttransaction.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = matc.conflict
+	if id, ok := matc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := matc.mutation.CreatedAt(); ok {
+		_spec.SetField(memberaccounttransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := matc.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberaccounttransaction.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := matc.mutation.MerchantID(); ok {
+		_spec.SetField(memberaccounttransaction.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := matc.mutation.StoreID(); ok {
+		_spec.SetField(memberaccounttransaction.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := matc.mutation.MemberID(); ok {
+		_spec.SetField(memberaccounttransaction.FieldMemberID, field.TypeUUID, value)
+		_node.MemberID = value
+	}
+	if value, ok := matc.mutation.AccountID(); ok {
+		_spec.SetField(memberaccounttransaction.FieldAccountID, field.TypeUUID, value)
+		_node.AccountID = value
+	}
+	if value, ok := matc.mutation.TransactionNo(); ok {
+		_spec.SetField(memberaccounttransaction.FieldTransactionNo, field.TypeString, value)
+		_node.TransactionNo = value
+	}
+	if value, ok := matc.mutation.GetType(); ok {
+		_spec.SetField(memberaccounttransaction.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := matc.mutation.Amount(); ok {
+		_spec.SetField(memberaccounttransaction.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := matc.mutation.BonusAmount(); ok {
+		_spec.SetField(memberaccounttransaction.FieldBonusAmount, field.TypeOther, value)
+		_node.BonusAmount = value
+	}
+	if value, ok := matc.mutation.BalanceAfter(); ok {
+		_spec.SetField(memberaccounttransaction.FieldBalanceAfter, field.TypeOther, value)
+		_node.BalanceAfter = value
+	}
+	if value, ok := matc.mutation.BonusBalanceAfter(); ok {
+		_spec.SetField(memberaccounttransaction.FieldBonusBalanceAfter, field.TypeOther, value)
+		_node.BonusBalanceAfter = value
+	}
+	if value, ok := matc.mutation.OrderID(); ok {
+		_spec.SetField(memberaccounttransaction.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := matc.mutation.OrderNo(); ok {
+		_spec.SetField(memberaccounttransaction.FieldOrderNo, field.TypeString, value)
+		_node.OrderNo = value
+	}
+	if value, ok := matc.mutation.RefundOrderID(); ok {
+		_spec.SetField(memberaccounttransaction.FieldRefundOrderID, field.TypeUUID, value)
+		_node.RefundOrderID = value
+	}
+	if value, ok := matc.mutation.PaymentMethod(); ok {
+		_spec.SetField(memberaccounttransaction.FieldPaymentMethod, field.TypeString, value)
+		_node.PaymentMethod = value
+	}
+	if value, ok := matc.mutation.OperatorID(); ok {
+		_spec.SetField(memberaccounttransaction.FieldOperatorID, field.TypeUUID, value)
+		_node.OperatorID = value
+	}
+	if value, ok := matc.mutation.OperatorName(); ok {
+		_spec.SetField(memberaccounttransaction.FieldOperatorName, field.TypeString, value)
+		_node.OperatorName = value
+	}
+	if value, ok := matc.mutation.Remark(); ok {
+		_spec.SetField(memberaccounttransaction.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberAccountTransaction.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberAccountTransactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (matc *MemberAccountTransactionCreate) OnConflict(opts ...sql.ConflictOption) *MemberAccountTransactionUpsertOne {
+	matc.conflict = opts
+	return &MemberAccountTransactionUpsertOne{
+		create: matc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberAccountTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (matc *MemberAccountTransactionCreate) OnConflictColumns(columns ...string) *MemberAccountTransactionUpsertOne {
+	matc.conflict = append(matc.conflict, sql.ConflictColumns(columns...))
+	return &MemberAccountTransactionUpsertOne{
+		create: matc,
+	}
+}
+
+type (
+	// MemberAccountTransactionUpsertOne is the builder for "upsert"-ing
+	//  one MemberAccountTransaction node.
+	MemberAccountTransactionUpsertOne struct {
+		create *MemberAccountTransactionCreate
+	}
+
+	// MemberAccountTransactionUpsert is the "OnConflict" setter.
+	MemberAccountTransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberAccountTransactionUpsert) SetUpdatedAt(v time.Time) *MemberAccountTransactionUpsert {
+	u.Set(memberaccounttransaction.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberAccountTransactionUpsert) UpdateUpdatedAt() *MemberAccountTransactionUpsert {
+	u.SetExcluded(memberaccounttransaction.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MemberAccountTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(memberaccounttransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberAccountTransactionUpsertOne) UpdateNewValues() *MemberAccountTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.MemberID(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldMemberID)
+		}
+		if _, exists := u.create.mutation.AccountID(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldAccountID)
+		}
+		if _, exists := u.create.mutation.TransactionNo(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldTransactionNo)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldType)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldAmount)
+		}
+		if _, exists := u.create.mutation.BonusAmount(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldBonusAmount)
+		}
+		if _, exists := u.create.mutation.BalanceAfter(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldBalanceAfter)
+		}
+		if _, exists := u.create.mutation.BonusBalanceAfter(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldBonusBalanceAfter)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.OrderNo(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldOrderNo)
+		}
+		if _, exists := u.create.mutation.RefundOrderID(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldRefundOrderID)
+		}
+		if _, exists := u.create.mutation.PaymentMethod(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldPaymentMethod)
+		}
+		if _, exists := u.create.mutation.OperatorID(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldOperatorID)
+		}
+		if _, exists := u.create.mutation.OperatorName(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldOperatorName)
+		}
+		if _, exists := u.create.mutation.Remark(); exists {
+			s.SetIgnore(memberaccounttransaction.FieldRemark)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberAccountTransaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MemberAccountTransactionUpsertOne) Ignore() *MemberAccountTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberAccountTransactionUpsertOne) DoNothing() *MemberAccountTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberAccountTransactionCreate.OnConflict
+// documentation for more info.
+func (u *MemberAccountTransactionUpsertOne) Update(set func(*MemberAccountTransactionUpsert)) *MemberAccountTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberAccountTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberAccountTransactionUpsertOne) SetUpdatedAt(v time.Time) *MemberAccountTransactionUpsertOne {
+	return u.Update(func(s *MemberAccountTransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberAccountTransactionUpsertOne) UpdateUpdatedAt() *MemberAccountTransactionUpsertOne {
+	return u.Update(func(s *MemberAccountTransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberAccountTransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberAccountTransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberAccountTransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MemberAccountTransactionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MemberAccountTransactionUpsertOne.ID is not supported by MySQL driver. Use MemberAccountTransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MemberAccountTransactionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MemberAccountTransactionCreateBulk is the builder for creating many MemberAccountTransaction entities in bulk.
+type MemberAccountTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*MemberAccountTransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MemberAccountTransaction entities in the database.
+func (matcb *MemberAccountTransactionCreateBulk) Save(ctx context.Context) ([]*MemberAccountTransaction, error) {
+	if matcb.err != nil {
+		return nil, matcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(matcb.builders))
+	nodes := make([]*MemberAccountTransaction, len(matcb.builders))
+	mutators := make([]Mutator, len(matcb.builders))
+	for i := range matcb.builders {
+		func(i int, root context.Context) {
+			builder := matcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MemberAccountTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, matcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = matcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, matcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, matcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (matcb *MemberAccountTransactionCreateBulk) SaveX(ctx context.Context) []*MemberAccountTransaction {
+	v, err := matcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (matcb *MemberAccountTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := matcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (matcb *MemberAccountTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := matcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberAccountTransaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberAccountTransactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (matcb *MemberAccountTransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *MemberAccountTransactionUpsertBulk {
+	matcb.conflict = opts
+	return &MemberAccountTransactionUpsertBulk{
+		create: matcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberAccountTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (matcb *MemberAccountTransactionCreateBulk) OnConflictColumns(columns ...string) *MemberAccountTransactionUpsertBulk {
+	matcb.conflict = append(matcb.conflict, sql.ConflictColumns(columns...))
+	return &MemberAccountTransactionUpsertBulk{
+		create: matcb,
+	}
+}
+
+// MemberAccountTransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of MemberAccountTransaction nodes.
+type MemberAccountTransactionUpsertBulk struct {
+	create *MemberAccountTransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MemberAccountTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(memberaccounttransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberAccountTransactionUpsertBulk) UpdateNewValues() *MemberAccountTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldStoreID)
+			}
+			if _, exists := b.mutation.MemberID(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldMemberID)
+			}
+			if _, exists := b.mutation.AccountID(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldAccountID)
+			}
+			if _, exists := b.mutation.TransactionNo(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldTransactionNo)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldType)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldAmount)
+			}
+			if _, exists := b.mutation.BonusAmount(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldBonusAmount)
+			}
+			if _, exists := b.mutation.BalanceAfter(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldBalanceAfter)
+			}
+			if _, exists := b.mutation.BonusBalanceAfter(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldBonusBalanceAfter)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldOrderID)
+			}
+			if _, exists := b.mutation.OrderNo(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldOrderNo)
+			}
+			if _, exists := b.mutation.RefundOrderID(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldRefundOrderID)
+			}
+			if _, exists := b.mutation.PaymentMethod(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldPaymentMethod)
+			}
+			if _, exists := b.mutation.OperatorID(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldOperatorID)
+			}
+			if _, exists := b.mutation.OperatorName(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldOperatorName)
+			}
+			if _, exists := b.mutation.Remark(); exists {
+				s.SetIgnore(memberaccounttransaction.FieldRemark)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberAccountTransaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MemberAccountTransactionUpsertBulk) Ignore() *MemberAccountTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberAccountTransactionUpsertBulk) DoNothing() *MemberAccountTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberAccountTransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *MemberAccountTransactionUpsertBulk) Update(set func(*MemberAccountTransactionUpsert)) *MemberAccountTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberAccountTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberAccountTransactionUpsertBulk) SetUpdatedAt(v time.Time) *MemberAccountTransactionUpsertBulk {
+	return u.Update(func(s *MemberAccountTransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberAccountTransactionUpsertBulk) UpdateUpdatedAt() *MemberAccountTransactionUpsertBulk {
+	return u.Update(func(s *MemberAccountTransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberAccountTransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MemberAccountTransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberAccountTransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberAccountTransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
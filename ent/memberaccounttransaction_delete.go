@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccounttransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberAccountTransactionDelete is the builder for deleting a MemberAccountTransaction entity.
+type MemberAccountTransactionDelete struct {
+	config
+	hooks    []Hook
+	mutation *MemberAccountTransactionMutation
+}
+
+// Where appends a list predicates to the MemberAccountTransactionDelete builder.
+func (matd *MemberAccountTransactionDelete) Where(ps ...predicate.MemberAccountTransaction) *MemberAccountTransactionDelete {
+	matd.mutation.Where(ps...)
+	return matd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (matd *MemberAccountTransactionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, matd.sqlExec, matd.mutation, matd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (matd *MemberAccountTransactionDelete) ExecX(ctx context.Context) int {
+	n, err := matd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (matd *MemberAccountTransactionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(memberaccounttransaction.Table, sqlgraph.NewFieldSpec(memberaccounttransaction.FieldID, field.TypeUUID))
+	if ps := matd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, matd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	matd.mutation.done = true
+	return affected, err
+}
+
+// MemberAccountTransactionDeleteOne is the builder for deleting a single MemberAccountTransaction entity.
+type MemberAccountTransactionDeleteOne struct {
+	matd *MemberAccountTransactionDelete
+}
+
+// Where appends a list predicates to the MemberAccountTransactionDelete builder.
+func (matdo *MemberAccountTransactionDeleteOne) Where(ps ...predicate.MemberAccountTransaction) *MemberAccountTransactionDeleteOne {
+	matdo.matd.mutation.Where(ps...)
+	return matdo
+}
+
+// Exec executes the deletion query.
+func (matdo *MemberAccountTransactionDeleteOne) Exec(ctx context.Context) error {
+	n, err := matdo.matd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{memberaccounttransaction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (matdo *MemberAccountTransactionDeleteOne) ExecX(ctx context.Context) {
+	if err := matdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccounttransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberAccountTransactionQuery is the builder for querying MemberAccountTransaction entities.
+type MemberAccountTransactionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []memberaccounttransaction.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MemberAccountTransaction
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MemberAccountTransactionQuery builder.
+func (matq *MemberAccountTransactionQuery) Where(ps ...predicate.MemberAccountTransaction) *MemberAccountTransactionQuery {
+	matq.predicates = append(matq.predicates, ps...)
+	return matq
+}
+
+// Limit the number of records to be returned by this query.
+func (matq *MemberAccountTransactionQuery) Limit(limit int) *MemberAccountTransactionQuery {
+	matq.ctx.Limit = &limit
+	return matq
+}
+
+// Offset to start from.
+func (matq *MemberAccountTransactionQuery) Offset(offset int) *MemberAccountTransactionQuery {
+	matq.ctx.Offset = &offset
+	return matq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (matq *MemberAccountTransactionQuery) Unique(unique bool) *MemberAccountTransactionQuery {
+	matq.ctx.Unique = &unique
+	return matq
+}
+
+// Order specifies how the records should be ordered.
+func (matq *MemberAccountTransactionQuery) Order(o ...memberaccounttransaction.OrderOption) *MemberAccountTransactionQuery {
+	matq.order = append(matq.order, o...)
+	return matq
+}
+
+// First returns the first MemberAccountTransaction entity from the query.
+// Returns a *NotFoundError when no MemberAccountTransaction was found.
+func (matq *MemberAccountTransactionQuery) First(ctx context.Context) (*MemberAccountTransaction, error) {
+	nodes, err := matq.Limit(1).All(setContextOp(ctx, matq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{memberaccounttransaction.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (matq *MemberAccountTransactionQuery) FirstX(ctx context.Context) *MemberAccountTransaction {
+	node, err := matq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MemberAccountTransaction ID from the query.
+// Returns a *NotFoundError when no MemberAccountTransaction ID was found.
+func (matq *MemberAccountTransactionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = matq.Limit(1).IDs(setContextOp(ctx, matq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{memberaccounttransaction.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (matq *MemberAccountTransactionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := matq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MemberAccountTransaction entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MemberAccountTransaction entity is found.
+// Returns a *NotFoundError when no MemberAccountTransaction entities are found.
+func (matq *MemberAccountTransactionQuery) Only(ctx context.Context) (*MemberAccountTransaction, error) {
+	nodes, err := matq.Limit(2).All(setContextOp(ctx, matq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{memberaccounttransaction.Label}
+	default:
+		return nil, &NotSingularError{memberaccounttransaction.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (matq *MemberAccountTransactionQuery) OnlyX(ctx context.Context) *MemberAccountTransaction {
+	node, err := matq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MemberAccountTransaction ID in the query.
+// Returns a *NotSingularError when more than one MemberAccountTransaction ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (matq *MemberAccountTransactionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = matq.Limit(2).IDs(setContextOp(ctx, matq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{memberaccounttransaction.Label}
+	default:
+		err = &NotSingularError{memberaccounttransaction.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (matq *MemberAccountTransactionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := matq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MemberAccountTransactions.
+func (matq *MemberAccountTransactionQuery) All(ctx context.Context) ([]*MemberAccountTransaction, error) {
+	ctx = setContextOp(ctx, matq.ctx, ent.OpQueryAll)
+	if err := matq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MemberAccountTransaction, *MemberAccountTransactionQuery]()
+	return withInterceptors[[]*MemberAccountTransaction](ctx, matq, qr, matq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (matq *MemberAccountTransactionQuery) AllX(ctx context.Context) []*MemberAccountTransaction {
+	nodes, err := matq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MemberAccountTransaction IDs.
+func (matq *MemberAccountTransactionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if matq.ctx.Unique == nil && matq.path != nil {
+		matq.Unique(true)
+	}
+	ctx = setContextOp(ctx, matq.ctx, ent.OpQueryIDs)
+	if err = matq.Select(memberaccounttransaction.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (matq *MemberAccountTransactionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := matq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (matq *MemberAccountTransactionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, matq.ctx, ent.OpQueryCount)
+	if err := matq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, matq, querierCount[*MemberAccountTransactionQuery](), matq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (matq *MemberAccountTransactionQuery) CountX(ctx context.Context) int {
+	count, err := matq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (matq *MemberAccountTransactionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, matq.ctx, ent.OpQueryExist)
+	switch _, err := matq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (matq *MemberAccountTransactionQuery) ExistX(ctx context.Context) bool {
+	exist, err := matq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MemberAccountTransactionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (matq *MemberAccountTransactionQuery) Clone() *MemberAccountTransactionQuery {
+	if matq == nil {
+		return nil
+	}
+	return &MemberAccountTransactionQuery{
+		config:     matq.config,
+		ctx:        matq.ctx.Clone(),
+		order:      append([]memberaccounttransaction.OrderOption{}, matq.order...),
+		inters:     append([]Interceptor{}, matq.inters...),
+		predicates: append([]predicate.MemberAccountTransaction{}, matq.predicates...),
+		// clone intermediate query.
+		sql:       matq.sql.Clone(),
+		path:      matq.path,
+		modifiers: append([]func(*sql.Selector){}, matq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MemberAccountTransaction.Query().
+//		GroupBy(memberaccounttransaction.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (matq *MemberAccountTransactionQuery) GroupBy(field string, fields ...string) *MemberAccountTransactionGroupBy {
+	matq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MemberAccountTransactionGroupBy{build: matq}
+	grbuild.flds = &matq.ctx.Fields
+	grbuild.label = memberaccounttransaction.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.MemberAccountTransaction.Query().
+//		Select(memberaccounttransaction.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (matq *MemberAccountTransactionQuery) Select(fields ...string) *MemberAccountTransactionSelect {
+	matq.ctx.Fields = append(matq.ctx.Fields, fields...)
+	sbuild := &MemberAccountTransactionSelect{MemberAccountTransactionQuery: matq}
+	sbuild.label = memberaccounttransaction.Label
+	sbuild.flds, sbuild.scan = &matq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MemberAccountTransactionSelect configured with the given aggregations.
+func (matq *MemberAccountTransactionQuery) Aggregate(fns ...AggregateFunc) *MemberAccountTransactionSelect {
+	return matq.Select().Aggregate(fns...)
+}
+
+func (matq *MemberAccountTransactionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range matq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, matq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range matq.ctx.Fields {
+		if !memberaccounttransaction.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if matq.path != nil {
+		prev, err := matq.path(ctx)
+		if err != nil {
+			return err
+		}
+		matq.sql = prev
+	}
+	return nil
+}
+
+func (matq *MemberAccountTransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MemberAccountTransaction, error) {
+	var (
+		nodes = []*MemberAccountTransaction{}
+		_spec = matq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MemberAccountTransaction).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MemberAccountTransaction{config: matq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(matq.modifiers) > 0 {
+		_spec.Modifiers = matq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, matq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (matq *MemberAccountTransactionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := matq.querySpec()
+	if len(matq.modifiers) > 0 {
+		_spec.Modifiers = matq.modifiers
+	}
+	_spec.Node.Columns = matq.ctx.Fields
+	if len(matq.ctx.Fields) > 0 {
+		_spec.Unique = matq.ctx.Unique != nil && *matq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, matq.driver, _spec)
+}
+
+func (matq *MemberAccountTransactionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(memberaccounttransaction.Table, memberaccounttransaction.Columns, sqlgraph.NewFieldSpec(memberaccounttransaction.FieldID, field.TypeUUID))
+	_spec.From = matq.sql
+	if unique := matq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if matq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := matq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, memberaccounttransaction.FieldID)
+		for i := range fields {
+			if fields[i] != memberaccounttransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := matq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := matq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := matq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := matq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (matq *MemberAccountTransactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(matq.driver.Dialect())
+	t1 := builder.Table(memberaccounttransaction.Table)
+	columns := matq.ctx.Fields
+	if len(columns) == 0 {
+		columns = memberaccounttransaction.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if matq.sql != nil {
+		selector = matq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if matq.ctx.Unique != nil && *matq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range matq.modifiers {
+		m(selector)
+	}
+	for _, p := range matq.predicates {
+		p(selector)
+	}
+	for _, p := range matq.order {
+		p(selector)
+	}
+	if offset := matq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := matq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (matq *MemberAccountTransactionQuery) ForUpdate(opts ...sql.LockOption) *MemberAccountTransactionQuery {
+	if matq.driver.Dialect() == dialect.Postgres {
+		matq.Unique(false)
+	}
+	matq.modifiers = append(matq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return matq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (matq *MemberAccountTransactionQuery) ForShare(opts ...sql.LockOption) *MemberAccountTransactionQuery {
+	if matq.driver.Dialect() == dialect.Postgres {
+		matq.Unique(false)
+	}
+	matq.modifiers = append(matq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return matq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (matq *MemberAccountTransactionQuery) Modify(modifiers ...func(s *sql.Selector)) *MemberAccountTransactionSelect {
+	matq.modifiers = append(matq.modifiers, modifiers...)
+	return matq.Select()
+}
+
+// MemberAccountTransactionGroupBy is the group-by builder for MemberAccountTransaction entities.
+type MemberAccountTransactionGroupBy struct {
+	selector
+	build *MemberAccountTransactionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (matgb *MemberAccountTransactionGroupBy) Aggregate(fns ...AggregateFunc) *MemberAccountTransactionGroupBy {
+	matgb.fns = append(matgb.fns, fns...)
+	return matgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (matgb *MemberAccountTransactionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, matgb.build.ctx, ent.OpQueryGroupBy)
+	if err := matgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberAccountTransactionQuery, *MemberAccountTransactionGroupBy](ctx, matgb.build, matgb, matgb.build.inters, v)
+}
+
+func (matgb *MemberAccountTransactionGroupBy) sqlScan(ctx context.Context, root *MemberAccountTransactionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(matgb.fns))
+	for _, fn := range matgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*matgb.flds)+len(matgb.fns))
+		for _, f := range *matgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*matgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := matgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MemberAccountTransactionSelect is the builder for selecting fields of MemberAccountTransaction entities.
+type MemberAccountTransactionSelect struct {
+	*MemberAccountTransactionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mats *MemberAccountTransactionSelect) Aggregate(fns ...AggregateFunc) *MemberAccountTransactionSelect {
+	mats.fns = append(mats.fns, fns...)
+	return mats
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mats *MemberAccountTransactionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mats.ctx, ent.OpQuerySelect)
+	if err := mats.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberAccountTransactionQuery, *MemberAccountTransactionSelect](ctx, mats.MemberAccountTransactionQuery, mats, mats.inters, v)
+}
+
+func (mats *MemberAccountTransactionSelect) sqlScan(ctx context.Context, root *MemberAccountTransactionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mats.fns))
+	for _, fn := range mats.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mats.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mats.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mats *MemberAccountTransactionSelect) Modify(modifiers ...func(s *sql.Selector)) *MemberAccountTransactionSelect {
+	mats.modifiers = append(mats.modifiers, modifiers...)
+	return mats
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccounttransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberAccountTransactionUpdate is the builder for updating MemberAccountTransaction entities.
+type MemberAccountTransactionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MemberAccountTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MemberAccountTransactionUpdate builder.
+func (matu *MemberAccountTransactionUpdate) Where(ps ...predicate.MemberAccountTransaction) *MemberAccountTransactionUpdate {
+	matu.mutation.Where(ps...)
+	return matu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (matu *MemberAccountTransactionUpdate) SetUpdatedAt(t time.Time) *MemberAccountTransactionUpdate {
+	matu.mutation.SetUpdatedAt(t)
+	return matu
+}
+
+// Mutation returns the MemberAccountTransactionMutation object of the builder.
+func (matu *MemberAccountTransactionUpdate) Mutation() *MemberAccountTransactionMutation {
+	return matu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (matu *MemberAccountTransactionUpdate) Save(ctx context.Context) (int, error) {
+	matu.defaults()
+	return withHooks(ctx, matu.sqlSave, matu.mutation, matu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (matu *MemberAccountTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := matu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (matu *MemberAccountTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := matu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (matu *MemberAccountTransactionUpdate) ExecX(ctx context.Context) {
+	if err := matu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (matu *MemberAccountTransactionUpdate) defaults() {
+	if _, ok := matu.mutation.UpdatedAt(); !ok {
+		v := memberaccounttransaction.UpdateDefaultUpdatedAt()
+		matu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (matu *MemberAccountTransactionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberAccountTransactionUpdate {
+	matu.modifiers = append(matu.modifiers, modifiers...)
+	return matu
+}
+
+func (matu *MemberAccountTransactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(memberaccounttransaction.Table, memberaccounttransaction.Columns, sqlgraph.NewFieldSpec(memberaccounttransaction.FieldID, field.TypeUUID))
+	if ps := matu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := matu.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberaccounttransaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(matu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, matu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memberaccounttransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	matu.mutation.done = true
+	return n, nil
+}
+
+// MemberAccountTransactionUpdateOne is the builder for updating a single MemberAccountTransaction entity.
+type MemberAccountTransactionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MemberAccountTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (matuo *MemberAccountTransactionUpdateOne) SetUpdatedAt(t time.Time) *MemberAccountTransactionUpdateOne {
+	matuo.mutation.SetUpdatedAt(t)
+	return matuo
+}
+
+// Mutation returns the MemberAccountTransactionMutation object of the builder.
+func (matuo *MemberAccountTransactionUpdateOne) Mutation() *MemberAccountTransactionMutation {
+	return matuo.mutation
+}
+
+// Where appends a list predicates to the MemberAccountTransactionUpdate builder.
+func (matuo *MemberAccountTransactionUpdateOne) Where(ps ...predicate.MemberAccountTransaction) *MemberAccountTransactionUpdateOne {
+	matuo.mutation.Where(ps...)
+	return matuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (matuo *MemberAccountTransactionUpdateOne) Select(field string, fields ...string) *MemberAccountTransactionUpdateOne {
+	matuo.fields = append([]string{field}, fields...)
+	return matuo
+}
+
+// Save executes the query and returns the updated MemberAccountTransaction entity.
+func (matuo *MemberAccountTransactionUpdateOne) Save(ctx context.Context) (*MemberAccountTransaction, error) {
+	matuo.defaults()
+	return withHooks(ctx, matuo.sqlSave, matuo.mutation, matuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (matuo *MemberAccountTransactionUpdateOne) SaveX(ctx context.Context) *MemberAccountTransaction {
+	node, err := matuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (matuo *MemberAccountTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := matuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (matuo *MemberAccountTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := matuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (matuo *MemberAccountTransactionUpdateOne) defaults() {
+	if _, ok := matuo.mutation.UpdatedAt(); !ok {
+		v := memberaccounttransaction.UpdateDefaultUpdatedAt()
+		matuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (matuo *MemberAccountTransactionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberAccountTransactionUpdateOne {
+	matuo.modifiers = append(matuo.modifiers, modifiers...)
+	return matuo
+}
+
+func (matuo *MemberAccountTransactionUpdateOne) sqlSave(ctx context.Context) (_node *MemberAccountTransaction, err error) {
+	_spec := sqlgraph.NewUpdateSpec(memberaccounttransaction.Table, memberaccounttransaction.Columns, sqlgraph.NewFieldSpec(memberaccounttransaction.FieldID, field.TypeUUID))
+	id, ok := matuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MemberAccountTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := matuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, memberaccounttransaction.FieldID)
+		for _, f := range fields {
+			if !memberaccounttransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != memberaccounttransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := matuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := matuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberaccounttransaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(matuo.modifiers...)
+	_node = &MemberAccountTransaction{config: matuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, matuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memberaccounttransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	matuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsaccount"
+)
+
+// MemberPointsAccount is the model entity for the MemberPointsAccount schema.
+type MemberPointsAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 会员ID
+	MemberID uuid.UUID `json:"member_id,omitempty"`
+	// 可用积分
+	Balance      int64 `json:"balance,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MemberPointsAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case memberpointsaccount.FieldBalance:
+			values[i] = new(sql.NullInt64)
+		case memberpointsaccount.FieldCreatedAt, memberpointsaccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case memberpointsaccount.FieldID, memberpointsaccount.FieldMerchantID, memberpointsaccount.FieldMemberID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MemberPointsAccount fields.
+func (mpa *MemberPointsAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case memberpointsaccount.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mpa.ID = *value
+			}
+		case memberpointsaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mpa.CreatedAt = value.Time
+			}
+		case memberpointsaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mpa.UpdatedAt = value.Time
+			}
+		case memberpointsaccount.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				mpa.MerchantID = *value
+			}
+		case memberpointsaccount.FieldMemberID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field member_id", values[i])
+			} else if value != nil {
+				mpa.MemberID = *value
+			}
+		case memberpointsaccount.FieldBalance:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				mpa.Balance = value.Int64
+			}
+		default:
+			mpa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MemberPointsAccount.
+// This includes values selected through modifiers, order, etc.
+func (mpa *MemberPointsAccount) Value(name string) (ent.Value, error) {
+	return mpa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MemberPointsAccount.
+// Note that you need to call MemberPointsAccount.Unwrap() before calling this method if this MemberPointsAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mpa *MemberPointsAccount) Update() *MemberPointsAccountUpdateOne {
+	return NewMemberPointsAccountClient(mpa.config).UpdateOne(mpa)
+}
+
+// Unwrap unwraps the MemberPointsAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mpa *MemberPointsAccount) Unwrap() *MemberPointsAccount {
+	_tx, ok := mpa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MemberPointsAccount is not a transactional entity")
+	}
+	mpa.config.driver = _tx.drv
+	return mpa
+}
+
+// String implements the fmt.Stringer.
+func (mpa *MemberPointsAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("MemberPointsAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mpa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(mpa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mpa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpa.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("member_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpa.MemberID))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", mpa.Balance))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MemberPointsAccounts is a parsable slice of MemberPointsAccount.
+type MemberPointsAccounts []*MemberPointsAccount
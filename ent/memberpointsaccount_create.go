@@ -0,0 +1,675 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsaccount"
+)
+
+// MemberPointsAccountCreate is the builder for creating a MemberPointsAccount entity.
+type MemberPointsAccountCreate struct {
+	config
+	mutation *MemberPointsAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mpac *MemberPointsAccountCreate) SetCreatedAt(t time.Time) *MemberPointsAccountCreate {
+	mpac.mutation.SetCreatedAt(t)
+	return mpac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mpac *MemberPointsAccountCreate) SetNillableCreatedAt(t *time.Time) *MemberPointsAccountCreate {
+	if t != nil {
+		mpac.SetCreatedAt(*t)
+	}
+	return mpac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mpac *MemberPointsAccountCreate) SetUpdatedAt(t time.Time) *MemberPointsAccountCreate {
+	mpac.mutation.SetUpdatedAt(t)
+	return mpac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mpac *MemberPointsAccountCreate) SetNillableUpdatedAt(t *time.Time) *MemberPointsAccountCreate {
+	if t != nil {
+		mpac.SetUpdatedAt(*t)
+	}
+	return mpac
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (mpac *MemberPointsAccountCreate) SetMerchantID(u uuid.UUID) *MemberPointsAccountCreate {
+	mpac.mutation.SetMerchantID(u)
+	return mpac
+}
+
+// SetMemberID sets the "member_id" field.
+func (mpac *MemberPointsAccountCreate) SetMemberID(u uuid.UUID) *MemberPointsAccountCreate {
+	mpac.mutation.SetMemberID(u)
+	return mpac
+}
+
+// SetBalance sets the "balance" field.
+func (mpac *MemberPointsAccountCreate) SetBalance(i int64) *MemberPointsAccountCreate {
+	mpac.mutation.SetBalance(i)
+	return mpac
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (mpac *MemberPointsAccountCreate) SetNillableBalance(i *int64) *MemberPointsAccountCreate {
+	if i != nil {
+		mpac.SetBalance(*i)
+	}
+	return mpac
+}
+
+// SetID sets the "id" field.
+func (mpac *MemberPointsAccountCreate) SetID(u uuid.UUID) *MemberPointsAccountCreate {
+	mpac.mutation.SetID(u)
+	return mpac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mpac *MemberPointsAccountCreate) SetNillableID(u *uuid.UUID) *MemberPointsAccountCreate {
+	if u != nil {
+		mpac.SetID(*u)
+	}
+	return mpac
+}
+
+// Mutation returns the MemberPointsAccountMutation object of the builder.
+func (mpac *MemberPointsAccountCreate) Mutation() *MemberPointsAccountMutation {
+	return mpac.mutation
+}
+
+// Save creates the MemberPointsAccount in the database.
+func (mpac *MemberPointsAccountCreate) Save(ctx context.Context) (*MemberPointsAccount, error) {
+	mpac.defaults()
+	return withHooks(ctx, mpac.sqlSave, mpac.mutation, mpac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mpac *MemberPointsAccountCreate) SaveX(ctx context.Context) *MemberPointsAccount {
+	v, err := mpac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpac *MemberPointsAccountCreate) Exec(ctx context.Context) error {
+	_, err := mpac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpac *MemberPointsAccountCreate) ExecX(ctx context.Context) {
+	if err := mpac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpac *MemberPointsAccountCreate) defaults() {
+	if _, ok := mpac.mutation.CreatedAt(); !ok {
+		v := memberpointsaccount.DefaultCreatedAt()
+		mpac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mpac.mutation.UpdatedAt(); !ok {
+		v := memberpointsaccount.DefaultUpdatedAt()
+		mpac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mpac.mutation.Balance(); !ok {
+		v := memberpointsaccount.DefaultBalance
+		mpac.mutation.SetBalance(v)
+	}
+	if _, ok := mpac.mutation.ID(); !ok {
+		v := memberpointsaccount.DefaultID()
+		mpac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpac *MemberPointsAccountCreate) check() error {
+	if _, ok := mpac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MemberPointsAccount.created_at"`)}
+	}
+	if _, ok := mpac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MemberPointsAccount.updated_at"`)}
+	}
+	if _, ok := mpac.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "MemberPointsAccount.merchant_id"`)}
+	}
+	if _, ok := mpac.mutation.MemberID(); !ok {
+		return &ValidationError{Name: "member_id", err: errors.New(`ent: missing required field "MemberPointsAccount.member_id"`)}
+	}
+	if _, ok := mpac.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "MemberPointsAccount.balance"`)}
+	}
+	return nil
+}
+
+func (mpac *MemberPointsAccountCreate) sqlSave(ctx context.Context) (*MemberPointsAccount, error) {
+	if err := mpac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mpac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mpac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mpac.mutation.id = &_node.ID
+	mpac.mutation.done = true
+	return _node, nil
+}
+
+func (mpac *MemberPointsAccountCreate) createSpec() (*MemberPointsAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MemberPointsAccount{config: mpac.config}
+		_spec = sqlgraph.NewCreateSpec(memberpointsaccount.Table, sqlgraph.NewFieldSpec(memberpointsaccount.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mpac.conflict
+	if id, ok := mpac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mpac.mutation.CreatedAt(); ok {
+		_spec.SetField(memberpointsaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mpac.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberpointsaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mpac.mutation.MerchantID(); ok {
+		_spec.SetField(memberpointsaccount.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := mpac.mutation.MemberID(); ok {
+		_spec.SetField(memberpointsaccount.FieldMemberID, field.TypeUUID, value)
+		_node.MemberID = value
+	}
+	if value, ok := mpac.mutation.Balance(); ok {
+		_spec.SetField(memberpointsaccount.FieldBalance, field.TypeInt64, value)
+		_node.Balance = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberPointsAccount.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberPointsAccountUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mpac *MemberPointsAccountCreate) OnConflict(opts ...sql.ConflictOption) *MemberPointsAccountUpsertOne {
+	mpac.conflict = opts
+	return &MemberPointsAccountUpsertOne{
+		create: mpac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberPointsAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mpac *MemberPointsAccountCreate) OnConflictColumns(columns ...string) *MemberPointsAccountUpsertOne {
+	mpac.conflict = append(mpac.conflict, sql.ConflictColumns(columns...))
+	return &MemberPointsAccountUpsertOne{
+		create: mpac,
+	}
+}
+
+type (
+	// MemberPointsAccountUpsertOne is the builder for "upsert"-ing
+	//  one MemberPointsAccount node.
+	MemberPointsAccountUpsertOne struct {
+		create *MemberPointsAccountCreate
+	}
+
+	// MemberPointsAccountUpsert is the "OnConflict" setter.
+	MemberPointsAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberPointsAccountUpsert) SetUpdatedAt(v time.Time) *MemberPointsAccountUpsert {
+	u.Set(memberpointsaccount.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberPointsAccountUpsert) UpdateUpdatedAt() *MemberPointsAccountUpsert {
+	u.SetExcluded(memberpointsaccount.FieldUpdatedAt)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *MemberPointsAccountUpsert) SetBalance(v int64) *MemberPointsAccountUpsert {
+	u.Set(memberpointsaccount.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *MemberPointsAccountUpsert) UpdateBalance() *MemberPointsAccountUpsert {
+	u.SetExcluded(memberpointsaccount.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *MemberPointsAccountUpsert) AddBalance(v int64) *MemberPointsAccountUpsert {
+	u.Add(memberpointsaccount.FieldBalance, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MemberPointsAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(memberpointsaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberPointsAccountUpsertOne) UpdateNewValues() *MemberPointsAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(memberpointsaccount.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(memberpointsaccount.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(memberpointsaccount.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.MemberID(); exists {
+			s.SetIgnore(memberpointsaccount.FieldMemberID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberPointsAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MemberPointsAccountUpsertOne) Ignore() *MemberPointsAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberPointsAccountUpsertOne) DoNothing() *MemberPointsAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberPointsAccountCreate.OnConflict
+// documentation for more info.
+func (u *MemberPointsAccountUpsertOne) Update(set func(*MemberPointsAccountUpsert)) *MemberPointsAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberPointsAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberPointsAccountUpsertOne) SetUpdatedAt(v time.Time) *MemberPointsAccountUpsertOne {
+	return u.Update(func(s *MemberPointsAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberPointsAccountUpsertOne) UpdateUpdatedAt() *MemberPointsAccountUpsertOne {
+	return u.Update(func(s *MemberPointsAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *MemberPointsAccountUpsertOne) SetBalance(v int64) *MemberPointsAccountUpsertOne {
+	return u.Update(func(s *MemberPointsAccountUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *MemberPointsAccountUpsertOne) AddBalance(v int64) *MemberPointsAccountUpsertOne {
+	return u.Update(func(s *MemberPointsAccountUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *MemberPointsAccountUpsertOne) UpdateBalance() *MemberPointsAccountUpsertOne {
+	return u.Update(func(s *MemberPointsAccountUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberPointsAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberPointsAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberPointsAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MemberPointsAccountUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MemberPointsAccountUpsertOne.ID is not supported by MySQL driver. Use MemberPointsAccountUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MemberPointsAccountUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MemberPointsAccountCreateBulk is the builder for creating many MemberPointsAccount entities in bulk.
+type MemberPointsAccountCreateBulk struct {
+	config
+	err      error
+	builders []*MemberPointsAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MemberPointsAccount entities in the database.
+func (mpacb *MemberPointsAccountCreateBulk) Save(ctx context.Context) ([]*MemberPointsAccount, error) {
+	if mpacb.err != nil {
+		return nil, mpacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mpacb.builders))
+	nodes := make([]*MemberPointsAccount, len(mpacb.builders))
+	mutators := make([]Mutator, len(mpacb.builders))
+	for i := range mpacb.builders {
+		func(i int, root context.Context) {
+			builder := mpacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MemberPointsAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mpacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mpacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mpacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mpacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpacb *MemberPointsAccountCreateBulk) SaveX(ctx context.Context) []*MemberPointsAccount {
+	v, err := mpacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpacb *MemberPointsAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := mpacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpacb *MemberPointsAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := mpacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberPointsAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberPointsAccountUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mpacb *MemberPointsAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *MemberPointsAccountUpsertBulk {
+	mpacb.conflict = opts
+	return &MemberPointsAccountUpsertBulk{
+		create: mpacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberPointsAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mpacb *MemberPointsAccountCreateBulk) OnConflictColumns(columns ...string) *MemberPointsAccountUpsertBulk {
+	mpacb.conflict = append(mpacb.conflict, sql.ConflictColumns(columns...))
+	return &MemberPointsAccountUpsertBulk{
+		create: mpacb,
+	}
+}
+
+// MemberPointsAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of MemberPointsAccount nodes.
+type MemberPointsAccountUpsertBulk struct {
+	create *MemberPointsAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MemberPointsAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(memberpointsaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberPointsAccountUpsertBulk) UpdateNewValues() *MemberPointsAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(memberpointsaccount.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(memberpointsaccount.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(memberpointsaccount.FieldMerchantID)
+			}
+			if _, exists := b.mutation.MemberID(); exists {
+				s.SetIgnore(memberpointsaccount.FieldMemberID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberPointsAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MemberPointsAccountUpsertBulk) Ignore() *MemberPointsAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberPointsAccountUpsertBulk) DoNothing() *MemberPointsAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberPointsAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *MemberPointsAccountUpsertBulk) Update(set func(*MemberPointsAccountUpsert)) *MemberPointsAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberPointsAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberPointsAccountUpsertBulk) SetUpdatedAt(v time.Time) *MemberPointsAccountUpsertBulk {
+	return u.Update(func(s *MemberPointsAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberPointsAccountUpsertBulk) UpdateUpdatedAt() *MemberPointsAccountUpsertBulk {
+	return u.Update(func(s *MemberPointsAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *MemberPointsAccountUpsertBulk) SetBalance(v int64) *MemberPointsAccountUpsertBulk {
+	return u.Update(func(s *MemberPointsAccountUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *MemberPointsAccountUpsertBulk) AddBalance(v int64) *MemberPointsAccountUpsertBulk {
+	return u.Update(func(s *MemberPointsAccountUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *MemberPointsAccountUpsertBulk) UpdateBalance() *MemberPointsAccountUpsertBulk {
+	return u.Update(func(s *MemberPointsAccountUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberPointsAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MemberPointsAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberPointsAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberPointsAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
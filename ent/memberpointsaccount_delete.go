@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberPointsAccountDelete is the builder for deleting a MemberPointsAccount entity.
+type MemberPointsAccountDelete struct {
+	config
+	hooks    []Hook
+	mutation *MemberPointsAccountMutation
+}
+
+// Where appends a list predicates to the MemberPointsAccountDelete builder.
+func (mpad *MemberPointsAccountDelete) Where(ps ...predicate.MemberPointsAccount) *MemberPointsAccountDelete {
+	mpad.mutation.Where(ps...)
+	return mpad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mpad *MemberPointsAccountDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mpad.sqlExec, mpad.mutation, mpad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpad *MemberPointsAccountDelete) ExecX(ctx context.Context) int {
+	n, err := mpad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mpad *MemberPointsAccountDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(memberpointsaccount.Table, sqlgraph.NewFieldSpec(memberpointsaccount.FieldID, field.TypeUUID))
+	if ps := mpad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mpad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mpad.mutation.done = true
+	return affected, err
+}
+
+// MemberPointsAccountDeleteOne is the builder for deleting a single MemberPointsAccount entity.
+type MemberPointsAccountDeleteOne struct {
+	mpad *MemberPointsAccountDelete
+}
+
+// Where appends a list predicates to the MemberPointsAccountDelete builder.
+func (mpado *MemberPointsAccountDeleteOne) Where(ps ...predicate.MemberPointsAccount) *MemberPointsAccountDeleteOne {
+	mpado.mpad.mutation.Where(ps...)
+	return mpado
+}
+
+// Exec executes the deletion query.
+func (mpado *MemberPointsAccountDeleteOne) Exec(ctx context.Context) error {
+	n, err := mpado.mpad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{memberpointsaccount.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpado *MemberPointsAccountDeleteOne) ExecX(ctx context.Context) {
+	if err := mpado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
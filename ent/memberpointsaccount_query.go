@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberPointsAccountQuery is the builder for querying MemberPointsAccount entities.
+type MemberPointsAccountQuery struct {
+	config
+	ctx        *QueryContext
+	order      []memberpointsaccount.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MemberPointsAccount
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MemberPointsAccountQuery builder.
+func (mpaq *MemberPointsAccountQuery) Where(ps ...predicate.MemberPointsAccount) *MemberPointsAccountQuery {
+	mpaq.predicates = append(mpaq.predicates, ps...)
+	return mpaq
+}
+
+// Limit the number of records to be returned by this query.
+func (mpaq *MemberPointsAccountQuery) Limit(limit int) *MemberPointsAccountQuery {
+	mpaq.ctx.Limit = &limit
+	return mpaq
+}
+
+// Offset to start from.
+func (mpaq *MemberPointsAccountQuery) Offset(offset int) *MemberPointsAccountQuery {
+	mpaq.ctx.Offset = &offset
+	return mpaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mpaq *MemberPointsAccountQuery) Unique(unique bool) *MemberPointsAccountQuery {
+	mpaq.ctx.Unique = &unique
+	return mpaq
+}
+
+// Order specifies how the records should be ordered.
+func (mpaq *MemberPointsAccountQuery) Order(o ...memberpointsaccount.OrderOption) *MemberPointsAccountQuery {
+	mpaq.order = append(mpaq.order, o...)
+	return mpaq
+}
+
+// First returns the first MemberPointsAccount entity from the query.
+// Returns a *NotFoundError when no MemberPointsAccount was found.
+func (mpaq *MemberPointsAccountQuery) First(ctx context.Context) (*MemberPointsAccount, error) {
+	nodes, err := mpaq.Limit(1).All(setContextOp(ctx, mpaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{memberpointsaccount.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mpaq *MemberPointsAccountQuery) FirstX(ctx context.Context) *MemberPointsAccount {
+	node, err := mpaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MemberPointsAccount ID from the query.
+// Returns a *NotFoundError when no MemberPointsAccount ID was found.
+func (mpaq *MemberPointsAccountQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mpaq.Limit(1).IDs(setContextOp(ctx, mpaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{memberpointsaccount.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mpaq *MemberPointsAccountQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mpaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MemberPointsAccount entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MemberPointsAccount entity is found.
+// Returns a *NotFoundError when no MemberPointsAccount entities are found.
+func (mpaq *MemberPointsAccountQuery) Only(ctx context.Context) (*MemberPointsAccount, error) {
+	nodes, err := mpaq.Limit(2).All(setContextOp(ctx, mpaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{memberpointsaccount.Label}
+	default:
+		return nil, &NotSingularError{memberpointsaccount.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mpaq *MemberPointsAccountQuery) OnlyX(ctx context.Context) *MemberPointsAccount {
+	node, err := mpaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MemberPointsAccount ID in the query.
+// Returns a *NotSingularError when more than one MemberPointsAccount ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mpaq *MemberPointsAccountQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mpaq.Limit(2).IDs(setContextOp(ctx, mpaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{memberpointsaccount.Label}
+	default:
+		err = &NotSingularError{memberpointsaccount.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mpaq *MemberPointsAccountQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mpaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MemberPointsAccounts.
+func (mpaq *MemberPointsAccountQuery) All(ctx context.Context) ([]*MemberPointsAccount, error) {
+	ctx = setContextOp(ctx, mpaq.ctx, ent.OpQueryAll)
+	if err := mpaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MemberPointsAccount, *MemberPointsAccountQuery]()
+	return withInterceptors[[]*MemberPointsAccount](ctx, mpaq, qr, mpaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mpaq *MemberPointsAccountQuery) AllX(ctx context.Context) []*MemberPointsAccount {
+	nodes, err := mpaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MemberPointsAccount IDs.
+func (mpaq *MemberPointsAccountQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mpaq.ctx.Unique == nil && mpaq.path != nil {
+		mpaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mpaq.ctx, ent.OpQueryIDs)
+	if err = mpaq.Select(memberpointsaccount.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mpaq *MemberPointsAccountQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mpaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mpaq *MemberPointsAccountQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mpaq.ctx, ent.OpQueryCount)
+	if err := mpaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mpaq, querierCount[*MemberPointsAccountQuery](), mpaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mpaq *MemberPointsAccountQuery) CountX(ctx context.Context) int {
+	count, err := mpaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mpaq *MemberPointsAccountQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mpaq.ctx, ent.OpQueryExist)
+	switch _, err := mpaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mpaq *MemberPointsAccountQuery) ExistX(ctx context.Context) bool {
+	exist, err := mpaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MemberPointsAccountQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mpaq *MemberPointsAccountQuery) Clone() *MemberPointsAccountQuery {
+	if mpaq == nil {
+		return nil
+	}
+	return &MemberPointsAccountQuery{
+		config:     mpaq.config,
+		ctx:        mpaq.ctx.Clone(),
+		order:      append([]memberpointsaccount.OrderOption{}, mpaq.order...),
+		inters:     append([]Interceptor{}, mpaq.inters...),
+		predicates: append([]predicate.MemberPointsAccount{}, mpaq.predicates...),
+		// clone intermediate query.
+		sql:       mpaq.sql.Clone(),
+		path:      mpaq.path,
+		modifiers: append([]func(*sql.Selector){}, mpaq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MemberPointsAccount.Query().
+//		GroupBy(memberpointsaccount.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mpaq *MemberPointsAccountQuery) GroupBy(field string, fields ...string) *MemberPointsAccountGroupBy {
+	mpaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MemberPointsAccountGroupBy{build: mpaq}
+	grbuild.flds = &mpaq.ctx.Fields
+	grbuild.label = memberpointsaccount.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.MemberPointsAccount.Query().
+//		Select(memberpointsaccount.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (mpaq *MemberPointsAccountQuery) Select(fields ...string) *MemberPointsAccountSelect {
+	mpaq.ctx.Fields = append(mpaq.ctx.Fields, fields...)
+	sbuild := &MemberPointsAccountSelect{MemberPointsAccountQuery: mpaq}
+	sbuild.label = memberpointsaccount.Label
+	sbuild.flds, sbuild.scan = &mpaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MemberPointsAccountSelect configured with the given aggregations.
+func (mpaq *MemberPointsAccountQuery) Aggregate(fns ...AggregateFunc) *MemberPointsAccountSelect {
+	return mpaq.Select().Aggregate(fns...)
+}
+
+func (mpaq *MemberPointsAccountQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mpaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mpaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mpaq.ctx.Fields {
+		if !memberpointsaccount.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mpaq.path != nil {
+		prev, err := mpaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mpaq.sql = prev
+	}
+	return nil
+}
+
+func (mpaq *MemberPointsAccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MemberPointsAccount, error) {
+	var (
+		nodes = []*MemberPointsAccount{}
+		_spec = mpaq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MemberPointsAccount).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MemberPointsAccount{config: mpaq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(mpaq.modifiers) > 0 {
+		_spec.Modifiers = mpaq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mpaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mpaq *MemberPointsAccountQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mpaq.querySpec()
+	if len(mpaq.modifiers) > 0 {
+		_spec.Modifiers = mpaq.modifiers
+	}
+	_spec.Node.Columns = mpaq.ctx.Fields
+	if len(mpaq.ctx.Fields) > 0 {
+		_spec.Unique = mpaq.ctx.Unique != nil && *mpaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mpaq.driver, _spec)
+}
+
+func (mpaq *MemberPointsAccountQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(memberpointsaccount.Table, memberpointsaccount.Columns, sqlgraph.NewFieldSpec(memberpointsaccount.FieldID, field.TypeUUID))
+	_spec.From = mpaq.sql
+	if unique := mpaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mpaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mpaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, memberpointsaccount.FieldID)
+		for i := range fields {
+			if fields[i] != memberpointsaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mpaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mpaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mpaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mpaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mpaq *MemberPointsAccountQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mpaq.driver.Dialect())
+	t1 := builder.Table(memberpointsaccount.Table)
+	columns := mpaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = memberpointsaccount.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mpaq.sql != nil {
+		selector = mpaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mpaq.ctx.Unique != nil && *mpaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mpaq.modifiers {
+		m(selector)
+	}
+	for _, p := range mpaq.predicates {
+		p(selector)
+	}
+	for _, p := range mpaq.order {
+		p(selector)
+	}
+	if offset := mpaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mpaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mpaq *MemberPointsAccountQuery) ForUpdate(opts ...sql.LockOption) *MemberPointsAccountQuery {
+	if mpaq.driver.Dialect() == dialect.Postgres {
+		mpaq.Unique(false)
+	}
+	mpaq.modifiers = append(mpaq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mpaq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mpaq *MemberPointsAccountQuery) ForShare(opts ...sql.LockOption) *MemberPointsAccountQuery {
+	if mpaq.driver.Dialect() == dialect.Postgres {
+		mpaq.Unique(false)
+	}
+	mpaq.modifiers = append(mpaq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mpaq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mpaq *MemberPointsAccountQuery) Modify(modifiers ...func(s *sql.Selector)) *MemberPointsAccountSelect {
+	mpaq.modifiers = append(mpaq.modifiers, modifiers...)
+	return mpaq.Select()
+}
+
+// MemberPointsAccountGroupBy is the group-by builder for MemberPointsAccount entities.
+type MemberPointsAccountGroupBy struct {
+	selector
+	build *MemberPointsAccountQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mpagb *MemberPointsAccountGroupBy) Aggregate(fns ...AggregateFunc) *MemberPointsAccountGroupBy {
+	mpagb.fns = append(mpagb.fns, fns...)
+	return mpagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mpagb *MemberPointsAccountGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mpagb.build.ctx, ent.OpQueryGroupBy)
+	if err := mpagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberPointsAccountQuery, *MemberPointsAccountGroupBy](ctx, mpagb.build, mpagb, mpagb.build.inters, v)
+}
+
+func (mpagb *MemberPointsAccountGroupBy) sqlScan(ctx context.Context, root *MemberPointsAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mpagb.fns))
+	for _, fn := range mpagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mpagb.flds)+len(mpagb.fns))
+		for _, f := range *mpagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mpagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mpagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MemberPointsAccountSelect is the builder for selecting fields of MemberPointsAccount entities.
+type MemberPointsAccountSelect struct {
+	*MemberPointsAccountQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mpas *MemberPointsAccountSelect) Aggregate(fns ...AggregateFunc) *MemberPointsAccountSelect {
+	mpas.fns = append(mpas.fns, fns...)
+	return mpas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mpas *MemberPointsAccountSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mpas.ctx, ent.OpQuerySelect)
+	if err := mpas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberPointsAccountQuery, *MemberPointsAccountSelect](ctx, mpas.MemberPointsAccountQuery, mpas, mpas.inters, v)
+}
+
+func (mpas *MemberPointsAccountSelect) sqlScan(ctx context.Context, root *MemberPointsAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mpas.fns))
+	for _, fn := range mpas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mpas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mpas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mpas *MemberPointsAccountSelect) Modify(modifiers ...func(s *sql.Selector)) *MemberPointsAccountSelect {
+	mpas.modifiers = append(mpas.modifiers, modifiers...)
+	return mpas
+}
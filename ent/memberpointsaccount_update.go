@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberPointsAccountUpdate is the builder for updating MemberPointsAccount entities.
+type MemberPointsAccountUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MemberPointsAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MemberPointsAccountUpdate builder.
+func (mpau *MemberPointsAccountUpdate) Where(ps ...predicate.MemberPointsAccount) *MemberPointsAccountUpdate {
+	mpau.mutation.Where(ps...)
+	return mpau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mpau *MemberPointsAccountUpdate) SetUpdatedAt(t time.Time) *MemberPointsAccountUpdate {
+	mpau.mutation.SetUpdatedAt(t)
+	return mpau
+}
+
+// SetBalance sets the "balance" field.
+func (mpau *MemberPointsAccountUpdate) SetBalance(i int64) *MemberPointsAccountUpdate {
+	mpau.mutation.ResetBalance()
+	mpau.mutation.SetBalance(i)
+	return mpau
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (mpau *MemberPointsAccountUpdate) SetNillableBalance(i *int64) *MemberPointsAccountUpdate {
+	if i != nil {
+		mpau.SetBalance(*i)
+	}
+	return mpau
+}
+
+// AddBalance adds i to the "balance" field.
+func (mpau *MemberPointsAccountUpdate) AddBalance(i int64) *MemberPointsAccountUpdate {
+	mpau.mutation.AddBalance(i)
+	return mpau
+}
+
+// Mutation returns the MemberPointsAccountMutation object of the builder.
+func (mpau *MemberPointsAccountUpdate) Mutation() *MemberPointsAccountMutation {
+	return mpau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mpau *MemberPointsAccountUpdate) Save(ctx context.Context) (int, error) {
+	mpau.defaults()
+	return withHooks(ctx, mpau.sqlSave, mpau.mutation, mpau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpau *MemberPointsAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := mpau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mpau *MemberPointsAccountUpdate) Exec(ctx context.Context) error {
+	_, err := mpau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpau *MemberPointsAccountUpdate) ExecX(ctx context.Context) {
+	if err := mpau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpau *MemberPointsAccountUpdate) defaults() {
+	if _, ok := mpau.mutation.UpdatedAt(); !ok {
+		v := memberpointsaccount.UpdateDefaultUpdatedAt()
+		mpau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mpau *MemberPointsAccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberPointsAccountUpdate {
+	mpau.modifiers = append(mpau.modifiers, modifiers...)
+	return mpau
+}
+
+func (mpau *MemberPointsAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(memberpointsaccount.Table, memberpointsaccount.Columns, sqlgraph.NewFieldSpec(memberpointsaccount.FieldID, field.TypeUUID))
+	if ps := mpau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpau.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberpointsaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mpau.mutation.Balance(); ok {
+		_spec.SetField(memberpointsaccount.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := mpau.mutation.AddedBalance(); ok {
+		_spec.AddField(memberpointsaccount.FieldBalance, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(mpau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mpau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memberpointsaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mpau.mutation.done = true
+	return n, nil
+}
+
+// MemberPointsAccountUpdateOne is the builder for updating a single MemberPointsAccount entity.
+type MemberPointsAccountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MemberPointsAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mpauo *MemberPointsAccountUpdateOne) SetUpdatedAt(t time.Time) *MemberPointsAccountUpdateOne {
+	mpauo.mutation.SetUpdatedAt(t)
+	return mpauo
+}
+
+// SetBalance sets the "balance" field.
+func (mpauo *MemberPointsAccountUpdateOne) SetBalance(i int64) *MemberPointsAccountUpdateOne {
+	mpauo.mutation.ResetBalance()
+	mpauo.mutation.SetBalance(i)
+	return mpauo
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (mpauo *MemberPointsAccountUpdateOne) SetNillableBalance(i *int64) *MemberPointsAccountUpdateOne {
+	if i != nil {
+		mpauo.SetBalance(*i)
+	}
+	return mpauo
+}
+
+// AddBalance adds i to the "balance" field.
+func (mpauo *MemberPointsAccountUpdateOne) AddBalance(i int64) *MemberPointsAccountUpdateOne {
+	mpauo.mutation.AddBalance(i)
+	return mpauo
+}
+
+// Mutation returns the MemberPointsAccountMutation object of the builder.
+func (mpauo *MemberPointsAccountUpdateOne) Mutation() *MemberPointsAccountMutation {
+	return mpauo.mutation
+}
+
+// Where appends a list predicates to the MemberPointsAccountUpdate builder.
+func (mpauo *MemberPointsAccountUpdateOne) Where(ps ...predicate.MemberPointsAccount) *MemberPointsAccountUpdateOne {
+	mpauo.mutation.Where(ps...)
+	return mpauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mpauo *MemberPointsAccountUpdateOne) Select(field string, fields ...string) *MemberPointsAccountUpdateOne {
+	mpauo.fields = append([]string{field}, fields...)
+	return mpauo
+}
+
+// Save executes the query and returns the updated MemberPointsAccount entity.
+func (mpauo *MemberPointsAccountUpdateOne) Save(ctx context.Context) (*MemberPointsAccount, error) {
+	mpauo.defaults()
+	return withHooks(ctx, mpauo.sqlSave, mpauo.mutation, mpauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpauo *MemberPointsAccountUpdateOne) SaveX(ctx context.Context) *MemberPointsAccount {
+	node, err := mpauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mpauo *MemberPointsAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := mpauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpauo *MemberPointsAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := mpauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpauo *MemberPointsAccountUpdateOne) defaults() {
+	if _, ok := mpauo.mutation.UpdatedAt(); !ok {
+		v := memberpointsaccount.UpdateDefaultUpdatedAt()
+		mpauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mpauo *MemberPointsAccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberPointsAccountUpdateOne {
+	mpauo.modifiers = append(mpauo.modifiers, modifiers...)
+	return mpauo
+}
+
+func (mpauo *MemberPointsAccountUpdateOne) sqlSave(ctx context.Context) (_node *MemberPointsAccount, err error) {
+	_spec := sqlgraph.NewUpdateSpec(memberpointsaccount.Table, memberpointsaccount.Columns, sqlgraph.NewFieldSpec(memberpointsaccount.FieldID, field.TypeUUID))
+	id, ok := mpauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MemberPointsAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mpauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, memberpointsaccount.FieldID)
+		for _, f := range fields {
+			if !memberpointsaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != memberpointsaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mpauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberpointsaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mpauo.mutation.Balance(); ok {
+		_spec.SetField(memberpointsaccount.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := mpauo.mutation.AddedBalance(); ok {
+		_spec.AddField(memberpointsaccount.FieldBalance, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(mpauo.modifiers...)
+	_node = &MemberPointsAccount{config: mpauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mpauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memberpointsaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mpauo.mutation.done = true
+	return _node, nil
+}
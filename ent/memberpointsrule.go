@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsrule"
+)
+
+// MemberPointsRule is the model entity for the MemberPointsRule schema.
+type MemberPointsRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 是否开启积分获取
+	Enabled bool `json:"enabled,omitempty"`
+	// 基础比例：每消费1元获得的积分
+	EarnRate decimal.Decimal `json:"earn_rate,omitempty"`
+	// 分类积分比例
+	CategoryRates []domain.MemberPointsCategoryRate `json:"category_rates,omitempty"`
+	// 会员等级积分倍率
+	TierMultipliers []domain.MemberPointsTierMultiplier `json:"tier_multipliers,omitempty"`
+	// 积分有效天数，0 表示永久有效
+	ExpireDays int `json:"expire_days,omitempty"`
+	// 是否开启积分抵扣
+	RedeemEnabled bool `json:"redeem_enabled,omitempty"`
+	// 抵扣比例：多少积分抵扣1元
+	RedeemRate int64 `json:"redeem_rate,omitempty"`
+	// 单次最低使用积分
+	RedeemMinPoints int64 `json:"redeem_min_points,omitempty"`
+	// 抵扣金额占订单应收的最高比例（百分比）
+	RedeemMaxPercent decimal.Decimal `json:"redeem_max_percent,omitempty"`
+	// 积分兑换商品
+	RedeemProducts []domain.MemberPointsRedeemProduct `json:"redeem_products,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MemberPointsRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case memberpointsrule.FieldCategoryRates, memberpointsrule.FieldTierMultipliers, memberpointsrule.FieldRedeemProducts:
+			values[i] = new([]byte)
+		case memberpointsrule.FieldEarnRate, memberpointsrule.FieldRedeemMaxPercent:
+			values[i] = new(decimal.Decimal)
+		case memberpointsrule.FieldEnabled, memberpointsrule.FieldRedeemEnabled:
+			values[i] = new(sql.NullBool)
+		case memberpointsrule.FieldExpireDays, memberpointsrule.FieldRedeemRate, memberpointsrule.FieldRedeemMinPoints:
+			values[i] = new(sql.NullInt64)
+		case memberpointsrule.FieldCreatedAt, memberpointsrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case memberpointsrule.FieldID, memberpointsrule.FieldMerchantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MemberPointsRule fields.
+func (mpr *MemberPointsRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case memberpointsrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mpr.ID = *value
+			}
+		case memberpointsrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mpr.CreatedAt = value.Time
+			}
+		case memberpointsrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mpr.UpdatedAt = value.Time
+			}
+		case memberpointsrule.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				mpr.MerchantID = *value
+			}
+		case memberpointsrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				mpr.Enabled = value.Bool
+			}
+		case memberpointsrule.FieldEarnRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field earn_rate", values[i])
+			} else if value != nil {
+				mpr.EarnRate = *value
+			}
+		case memberpointsrule.FieldCategoryRates:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field category_rates", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mpr.CategoryRates); err != nil {
+					return fmt.Errorf("unmarshal field category_rates: %w", err)
+				}
+			}
+		case memberpointsrule.FieldTierMultipliers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tier_multipliers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mpr.TierMultipliers); err != nil {
+					return fmt.Errorf("unmarshal field tier_multipliers: %w", err)
+				}
+			}
+		case memberpointsrule.FieldExpireDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expire_days", values[i])
+			} else if value.Valid {
+				mpr.ExpireDays = int(value.Int64)
+			}
+		case memberpointsrule.FieldRedeemEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field redeem_enabled", values[i])
+			} else if value.Valid {
+				mpr.RedeemEnabled = value.Bool
+			}
+		case memberpointsrule.FieldRedeemRate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field redeem_rate", values[i])
+			} else if value.Valid {
+				mpr.RedeemRate = value.Int64
+			}
+		case memberpointsrule.FieldRedeemMinPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field redeem_min_points", values[i])
+			} else if value.Valid {
+				mpr.RedeemMinPoints = value.Int64
+			}
+		case memberpointsrule.FieldRedeemMaxPercent:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field redeem_max_percent", values[i])
+			} else if value != nil {
+				mpr.RedeemMaxPercent = *value
+			}
+		case memberpointsrule.FieldRedeemProducts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redeem_products", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mpr.RedeemProducts); err != nil {
+					return fmt.Errorf("unmarshal field redeem_products: %w", err)
+				}
+			}
+		default:
+			mpr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MemberPointsRule.
+// This includes values selected through modifiers, order, etc.
+func (mpr *MemberPointsRule) Value(name string) (ent.Value, error) {
+	return mpr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MemberPointsRule.
+// Note that you need to call MemberPointsRule.Unwrap() before calling this method if this MemberPointsRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mpr *MemberPointsRule) Update() *MemberPointsRuleUpdateOne {
+	return NewMemberPointsRuleClient(mpr.config).UpdateOne(mpr)
+}
+
+// Unwrap unwraps the MemberPointsRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mpr *MemberPointsRule) Unwrap() *MemberPointsRule {
+	_tx, ok := mpr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MemberPointsRule is not a transactional entity")
+	}
+	mpr.config.driver = _tx.drv
+	return mpr
+}
+
+// String implements the fmt.Stringer.
+func (mpr *MemberPointsRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("MemberPointsRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mpr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(mpr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mpr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpr.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", mpr.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("earn_rate=")
+	builder.WriteString(fmt.Sprintf("%v", mpr.EarnRate))
+	builder.WriteString(", ")
+	builder.WriteString("category_rates=")
+	builder.WriteString(fmt.Sprintf("%v", mpr.CategoryRates))
+	builder.WriteString(", ")
+	builder.WriteString("tier_multipliers=")
+	builder.WriteString(fmt.Sprintf("%v", mpr.TierMultipliers))
+	builder.WriteString(", ")
+	builder.WriteString("expire_days=")
+	builder.WriteString(fmt.Sprintf("%v", mpr.ExpireDays))
+	builder.WriteString(", ")
+	builder.WriteString("redeem_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", mpr.RedeemEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("redeem_rate=")
+	builder.WriteString(fmt.Sprintf("%v", mpr.RedeemRate))
+	builder.WriteString(", ")
+	builder.WriteString("redeem_min_points=")
+	builder.WriteString(fmt.Sprintf("%v", mpr.RedeemMinPoints))
+	builder.WriteString(", ")
+	builder.WriteString("redeem_max_percent=")
+	builder.WriteString(fmt.Sprintf("%v", mpr.RedeemMaxPercent))
+	builder.WriteString(", ")
+	builder.WriteString("redeem_products=")
+	builder.WriteString(fmt.Sprintf("%v", mpr.RedeemProducts))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MemberPointsRules is a parsable slice of MemberPointsRule.
+type MemberPointsRules []*MemberPointsRule
@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package memberpointsrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the memberpointsrule type in the database.
+	Label = "member_points_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldEarnRate holds the string denoting the earn_rate field in the database.
+	FieldEarnRate = "earn_rate"
+	// FieldCategoryRates holds the string denoting the category_rates field in the database.
+	FieldCategoryRates = "category_rates"
+	// FieldTierMultipliers holds the string denoting the tier_multipliers field in the database.
+	FieldTierMultipliers = "tier_multipliers"
+	// FieldExpireDays holds the string denoting the expire_days field in the database.
+	FieldExpireDays = "expire_days"
+	// FieldRedeemEnabled holds the string denoting the redeem_enabled field in the database.
+	FieldRedeemEnabled = "redeem_enabled"
+	// FieldRedeemRate holds the string denoting the redeem_rate field in the database.
+	FieldRedeemRate = "redeem_rate"
+	// FieldRedeemMinPoints holds the string denoting the redeem_min_points field in the database.
+	FieldRedeemMinPoints = "redeem_min_points"
+	// FieldRedeemMaxPercent holds the string denoting the redeem_max_percent field in the database.
+	FieldRedeemMaxPercent = "redeem_max_percent"
+	// FieldRedeemProducts holds the string denoting the redeem_products field in the database.
+	FieldRedeemProducts = "redeem_products"
+	// Table holds the table name of the memberpointsrule in the database.
+	Table = "member_points_rules"
+)
+
+// Columns holds all SQL columns for memberpointsrule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldEnabled,
+	FieldEarnRate,
+	FieldCategoryRates,
+	FieldTierMultipliers,
+	FieldExpireDays,
+	FieldRedeemEnabled,
+	FieldRedeemRate,
+	FieldRedeemMinPoints,
+	FieldRedeemMaxPercent,
+	FieldRedeemProducts,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultExpireDays holds the default value on creation for the "expire_days" field.
+	DefaultExpireDays int
+	// DefaultRedeemEnabled holds the default value on creation for the "redeem_enabled" field.
+	DefaultRedeemEnabled bool
+	// DefaultRedeemRate holds the default value on creation for the "redeem_rate" field.
+	DefaultRedeemRate int64
+	// DefaultRedeemMinPoints holds the default value on creation for the "redeem_min_points" field.
+	DefaultRedeemMinPoints int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the MemberPointsRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByEarnRate orders the results by the earn_rate field.
+func ByEarnRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEarnRate, opts...).ToFunc()
+}
+
+// ByExpireDays orders the results by the expire_days field.
+func ByExpireDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpireDays, opts...).ToFunc()
+}
+
+// ByRedeemEnabled orders the results by the redeem_enabled field.
+func ByRedeemEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeemEnabled, opts...).ToFunc()
+}
+
+// ByRedeemRate orders the results by the redeem_rate field.
+func ByRedeemRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeemRate, opts...).ToFunc()
+}
+
+// ByRedeemMinPoints orders the results by the redeem_min_points field.
+func ByRedeemMinPoints(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeemMinPoints, opts...).ToFunc()
+}
+
+// ByRedeemMaxPercent orders the results by the redeem_max_percent field.
+func ByRedeemMaxPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeemMaxPercent, opts...).ToFunc()
+}
@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package memberpointsrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EarnRate applies equality check predicate on the "earn_rate" field. It's identical to EarnRateEQ.
+func EarnRate(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldEarnRate, v))
+}
+
+// ExpireDays applies equality check predicate on the "expire_days" field. It's identical to ExpireDaysEQ.
+func ExpireDays(v int) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldExpireDays, v))
+}
+
+// RedeemEnabled applies equality check predicate on the "redeem_enabled" field. It's identical to RedeemEnabledEQ.
+func RedeemEnabled(v bool) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldRedeemEnabled, v))
+}
+
+// RedeemRate applies equality check predicate on the "redeem_rate" field. It's identical to RedeemRateEQ.
+func RedeemRate(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldRedeemRate, v))
+}
+
+// RedeemMinPoints applies equality check predicate on the "redeem_min_points" field. It's identical to RedeemMinPointsEQ.
+func RedeemMinPoints(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldRedeemMinPoints, v))
+}
+
+// RedeemMaxPercent applies equality check predicate on the "redeem_max_percent" field. It's identical to RedeemMaxPercentEQ.
+func RedeemMaxPercent(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldRedeemMaxPercent, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// EarnRateEQ applies the EQ predicate on the "earn_rate" field.
+func EarnRateEQ(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldEarnRate, v))
+}
+
+// EarnRateNEQ applies the NEQ predicate on the "earn_rate" field.
+func EarnRateNEQ(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNEQ(FieldEarnRate, v))
+}
+
+// EarnRateIn applies the In predicate on the "earn_rate" field.
+func EarnRateIn(vs ...decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIn(FieldEarnRate, vs...))
+}
+
+// EarnRateNotIn applies the NotIn predicate on the "earn_rate" field.
+func EarnRateNotIn(vs ...decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotIn(FieldEarnRate, vs...))
+}
+
+// EarnRateGT applies the GT predicate on the "earn_rate" field.
+func EarnRateGT(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGT(FieldEarnRate, v))
+}
+
+// EarnRateGTE applies the GTE predicate on the "earn_rate" field.
+func EarnRateGTE(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGTE(FieldEarnRate, v))
+}
+
+// EarnRateLT applies the LT predicate on the "earn_rate" field.
+func EarnRateLT(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLT(FieldEarnRate, v))
+}
+
+// EarnRateLTE applies the LTE predicate on the "earn_rate" field.
+func EarnRateLTE(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLTE(FieldEarnRate, v))
+}
+
+// CategoryRatesIsNil applies the IsNil predicate on the "category_rates" field.
+func CategoryRatesIsNil() predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIsNull(FieldCategoryRates))
+}
+
+// CategoryRatesNotNil applies the NotNil predicate on the "category_rates" field.
+func CategoryRatesNotNil() predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotNull(FieldCategoryRates))
+}
+
+// TierMultipliersIsNil applies the IsNil predicate on the "tier_multipliers" field.
+func TierMultipliersIsNil() predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIsNull(FieldTierMultipliers))
+}
+
+// TierMultipliersNotNil applies the NotNil predicate on the "tier_multipliers" field.
+func TierMultipliersNotNil() predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotNull(FieldTierMultipliers))
+}
+
+// ExpireDaysEQ applies the EQ predicate on the "expire_days" field.
+func ExpireDaysEQ(v int) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldExpireDays, v))
+}
+
+// ExpireDaysNEQ applies the NEQ predicate on the "expire_days" field.
+func ExpireDaysNEQ(v int) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNEQ(FieldExpireDays, v))
+}
+
+// ExpireDaysIn applies the In predicate on the "expire_days" field.
+func ExpireDaysIn(vs ...int) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIn(FieldExpireDays, vs...))
+}
+
+// ExpireDaysNotIn applies the NotIn predicate on the "expire_days" field.
+func ExpireDaysNotIn(vs ...int) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotIn(FieldExpireDays, vs...))
+}
+
+// ExpireDaysGT applies the GT predicate on the "expire_days" field.
+func ExpireDaysGT(v int) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGT(FieldExpireDays, v))
+}
+
+// ExpireDaysGTE applies the GTE predicate on the "expire_days" field.
+func ExpireDaysGTE(v int) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGTE(FieldExpireDays, v))
+}
+
+// ExpireDaysLT applies the LT predicate on the "expire_days" field.
+func ExpireDaysLT(v int) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLT(FieldExpireDays, v))
+}
+
+// ExpireDaysLTE applies the LTE predicate on the "expire_days" field.
+func ExpireDaysLTE(v int) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLTE(FieldExpireDays, v))
+}
+
+// RedeemEnabledEQ applies the EQ predicate on the "redeem_enabled" field.
+func RedeemEnabledEQ(v bool) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldRedeemEnabled, v))
+}
+
+// RedeemEnabledNEQ applies the NEQ predicate on the "redeem_enabled" field.
+func RedeemEnabledNEQ(v bool) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNEQ(FieldRedeemEnabled, v))
+}
+
+// RedeemRateEQ applies the EQ predicate on the "redeem_rate" field.
+func RedeemRateEQ(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldRedeemRate, v))
+}
+
+// RedeemRateNEQ applies the NEQ predicate on the "redeem_rate" field.
+func RedeemRateNEQ(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNEQ(FieldRedeemRate, v))
+}
+
+// RedeemRateIn applies the In predicate on the "redeem_rate" field.
+func RedeemRateIn(vs ...int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIn(FieldRedeemRate, vs...))
+}
+
+// RedeemRateNotIn applies the NotIn predicate on the "redeem_rate" field.
+func RedeemRateNotIn(vs ...int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotIn(FieldRedeemRate, vs...))
+}
+
+// RedeemRateGT applies the GT predicate on the "redeem_rate" field.
+func RedeemRateGT(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGT(FieldRedeemRate, v))
+}
+
+// RedeemRateGTE applies the GTE predicate on the "redeem_rate" field.
+func RedeemRateGTE(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGTE(FieldRedeemRate, v))
+}
+
+// RedeemRateLT applies the LT predicate on the "redeem_rate" field.
+func RedeemRateLT(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLT(FieldRedeemRate, v))
+}
+
+// RedeemRateLTE applies the LTE predicate on the "redeem_rate" field.
+func RedeemRateLTE(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLTE(FieldRedeemRate, v))
+}
+
+// RedeemMinPointsEQ applies the EQ predicate on the "redeem_min_points" field.
+func RedeemMinPointsEQ(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldRedeemMinPoints, v))
+}
+
+// RedeemMinPointsNEQ applies the NEQ predicate on the "redeem_min_points" field.
+func RedeemMinPointsNEQ(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNEQ(FieldRedeemMinPoints, v))
+}
+
+// RedeemMinPointsIn applies the In predicate on the "redeem_min_points" field.
+func RedeemMinPointsIn(vs ...int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIn(FieldRedeemMinPoints, vs...))
+}
+
+// RedeemMinPointsNotIn applies the NotIn predicate on the "redeem_min_points" field.
+func RedeemMinPointsNotIn(vs ...int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotIn(FieldRedeemMinPoints, vs...))
+}
+
+// RedeemMinPointsGT applies the GT predicate on the "redeem_min_points" field.
+func RedeemMinPointsGT(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGT(FieldRedeemMinPoints, v))
+}
+
+// RedeemMinPointsGTE applies the GTE predicate on the "redeem_min_points" field.
+func RedeemMinPointsGTE(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGTE(FieldRedeemMinPoints, v))
+}
+
+// RedeemMinPointsLT applies the LT predicate on the "redeem_min_points" field.
+func RedeemMinPointsLT(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLT(FieldRedeemMinPoints, v))
+}
+
+// RedeemMinPointsLTE applies the LTE predicate on the "redeem_min_points" field.
+func RedeemMinPointsLTE(v int64) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLTE(FieldRedeemMinPoints, v))
+}
+
+// RedeemMaxPercentEQ applies the EQ predicate on the "redeem_max_percent" field.
+func RedeemMaxPercentEQ(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldEQ(FieldRedeemMaxPercent, v))
+}
+
+// RedeemMaxPercentNEQ applies the NEQ predicate on the "redeem_max_percent" field.
+func RedeemMaxPercentNEQ(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNEQ(FieldRedeemMaxPercent, v))
+}
+
+// RedeemMaxPercentIn applies the In predicate on the "redeem_max_percent" field.
+func RedeemMaxPercentIn(vs ...decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIn(FieldRedeemMaxPercent, vs...))
+}
+
+// RedeemMaxPercentNotIn applies the NotIn predicate on the "redeem_max_percent" field.
+func RedeemMaxPercentNotIn(vs ...decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotIn(FieldRedeemMaxPercent, vs...))
+}
+
+// RedeemMaxPercentGT applies the GT predicate on the "redeem_max_percent" field.
+func RedeemMaxPercentGT(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGT(FieldRedeemMaxPercent, v))
+}
+
+// RedeemMaxPercentGTE applies the GTE predicate on the "redeem_max_percent" field.
+func RedeemMaxPercentGTE(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldGTE(FieldRedeemMaxPercent, v))
+}
+
+// RedeemMaxPercentLT applies the LT predicate on the "redeem_max_percent" field.
+func RedeemMaxPercentLT(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLT(FieldRedeemMaxPercent, v))
+}
+
+// RedeemMaxPercentLTE applies the LTE predicate on the "redeem_max_percent" field.
+func RedeemMaxPercentLTE(v decimal.Decimal) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldLTE(FieldRedeemMaxPercent, v))
+}
+
+// RedeemProductsIsNil applies the IsNil predicate on the "redeem_products" field.
+func RedeemProductsIsNil() predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldIsNull(FieldRedeemProducts))
+}
+
+// RedeemProductsNotNil applies the NotNil predicate on the "redeem_products" field.
+func RedeemProductsNotNil() predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.FieldNotNull(FieldRedeemProducts))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MemberPointsRule) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MemberPointsRule) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MemberPointsRule) predicate.MemberPointsRule {
+	return predicate.MemberPointsRule(sql.NotPredicates(p))
+}
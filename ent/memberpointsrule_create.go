@@ -0,0 +1,1274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsrule"
+)
+
+// MemberPointsRuleCreate is the builder for creating a MemberPointsRule entity.
+type MemberPointsRuleCreate struct {
+	config
+	mutation *MemberPointsRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mprc *MemberPointsRuleCreate) SetCreatedAt(t time.Time) *MemberPointsRuleCreate {
+	mprc.mutation.SetCreatedAt(t)
+	return mprc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mprc *MemberPointsRuleCreate) SetNillableCreatedAt(t *time.Time) *MemberPointsRuleCreate {
+	if t != nil {
+		mprc.SetCreatedAt(*t)
+	}
+	return mprc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mprc *MemberPointsRuleCreate) SetUpdatedAt(t time.Time) *MemberPointsRuleCreate {
+	mprc.mutation.SetUpdatedAt(t)
+	return mprc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mprc *MemberPointsRuleCreate) SetNillableUpdatedAt(t *time.Time) *MemberPointsRuleCreate {
+	if t != nil {
+		mprc.SetUpdatedAt(*t)
+	}
+	return mprc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (mprc *MemberPointsRuleCreate) SetMerchantID(u uuid.UUID) *MemberPointsRuleCreate {
+	mprc.mutation.SetMerchantID(u)
+	return mprc
+}
+
+// SetEnabled sets the "enabled" field.
+func (mprc *MemberPointsRuleCreate) SetEnabled(b bool) *MemberPointsRuleCreate {
+	mprc.mutation.SetEnabled(b)
+	return mprc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (mprc *MemberPointsRuleCreate) SetNillableEnabled(b *bool) *MemberPointsRuleCreate {
+	if b != nil {
+		mprc.SetEnabled(*b)
+	}
+	return mprc
+}
+
+// SetEarnRate sets the "earn_rate" field.
+func (mprc *MemberPointsRuleCreate) SetEarnRate(d decimal.Decimal) *MemberPointsRuleCreate {
+	mprc.mutation.SetEarnRate(d)
+	return mprc
+}
+
+// SetCategoryRates sets the "category_rates" field.
+func (mprc *MemberPointsRuleCreate) SetCategoryRates(dpcr []domain.MemberPointsCategoryRate) *MemberPointsRuleCreate {
+	mprc.mutation.SetCategoryRates(dpcr)
+	return mprc
+}
+
+// SetTierMultipliers sets the "tier_multipliers" field.
+func (mprc *MemberPointsRuleCreate) SetTierMultipliers(dptm []domain.MemberPointsTierMultiplier) *MemberPointsRuleCreate {
+	mprc.mutation.SetTierMultipliers(dptm)
+	return mprc
+}
+
+// SetExpireDays sets the "expire_days" field.
+func (mprc *MemberPointsRuleCreate) SetExpireDays(i int) *MemberPointsRuleCreate {
+	mprc.mutation.SetExpireDays(i)
+	return mprc
+}
+
+// SetNillableExpireDays sets the "expire_days" field if the given value is not nil.
+func (mprc *MemberPointsRuleCreate) SetNillableExpireDays(i *int) *MemberPointsRuleCreate {
+	if i != nil {
+		mprc.SetExpireDays(*i)
+	}
+	return mprc
+}
+
+// SetRedeemEnabled sets the "redeem_enabled" field.
+func (mprc *MemberPointsRuleCreate) SetRedeemEnabled(b bool) *MemberPointsRuleCreate {
+	mprc.mutation.SetRedeemEnabled(b)
+	return mprc
+}
+
+// SetNillableRedeemEnabled sets the "redeem_enabled" field if the given value is not nil.
+func (mprc *MemberPointsRuleCreate) SetNillableRedeemEnabled(b *bool) *MemberPointsRuleCreate {
+	if b != nil {
+		mprc.SetRedeemEnabled(*b)
+	}
+	return mprc
+}
+
+// SetRedeemRate sets the "redeem_rate" field.
+func (mprc *MemberPointsRuleCreate) SetRedeemRate(i int64) *MemberPointsRuleCreate {
+	mprc.mutation.SetRedeemRate(i)
+	return mprc
+}
+
+// SetNillableRedeemRate sets the "redeem_rate" field if the given value is not nil.
+func (mprc *MemberPointsRuleCreate) SetNillableRedeemRate(i *int64) *MemberPointsRuleCreate {
+	if i != nil {
+		mprc.SetRedeemRate(*i)
+	}
+	return mprc
+}
+
+// SetRedeemMinPoints sets the "redeem_min_points" field.
+func (mprc *MemberPointsRuleCreate) SetRedeemMinPoints(i int64) *MemberPointsRuleCreate {
+	mprc.mutation.SetRedeemMinPoints(i)
+	return mprc
+}
+
+// SetNillableRedeemMinPoints sets the "redeem_min_points" field if the given value is not nil.
+func (mprc *MemberPointsRuleCreate) SetNillableRedeemMinPoints(i *int64) *MemberPointsRuleCreate {
+	if i != nil {
+		mprc.SetRedeemMinPoints(*i)
+	}
+	return mprc
+}
+
+// SetRedeemMaxPercent sets the "redeem_max_percent" field.
+func (mprc *MemberPointsRuleCreate) SetRedeemMaxPercent(d decimal.Decimal) *MemberPointsRuleCreate {
+	mprc.mutation.SetRedeemMaxPercent(d)
+	return mprc
+}
+
+// SetRedeemProducts sets the "redeem_products" field.
+func (mprc *MemberPointsRuleCreate) SetRedeemProducts(dprp []domain.MemberPointsRedeemProduct) *MemberPointsRuleCreate {
+	mprc.mutation.SetRedeemProducts(dprp)
+	return mprc
+}
+
+// SetID sets the "id" field.
+func (mprc *MemberPointsRuleCreate) SetID(u uuid.UUID) *MemberPointsRuleCreate {
+	mprc.mutation.SetID(u)
+	return mprc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mprc *MemberPointsRuleCreate) SetNillableID(u *uuid.UUID) *MemberPointsRuleCreate {
+	if u != nil {
+		mprc.SetID(*u)
+	}
+	return mprc
+}
+
+// Mutation returns the MemberPointsRuleMutation object of the builder.
+func (mprc *MemberPointsRuleCreate) Mutation() *MemberPointsRuleMutation {
+	return mprc.mutation
+}
+
+// Save creates the MemberPointsRule in the database.
+func (mprc *MemberPointsRuleCreate) Save(ctx context.Context) (*MemberPointsRule, error) {
+	mprc.defaults()
+	return withHooks(ctx, mprc.sqlSave, mprc.mutation, mprc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mprc *MemberPointsRuleCreate) SaveX(ctx context.Context) *MemberPointsRule {
+	v, err := mprc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mprc *MemberPointsRuleCreate) Exec(ctx context.Context) error {
+	_, err := mprc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mprc *MemberPointsRuleCreate) ExecX(ctx context.Context) {
+	if err := mprc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mprc *MemberPointsRuleCreate) defaults() {
+	if _, ok := mprc.mutation.CreatedAt(); !ok {
+		v := memberpointsrule.DefaultCreatedAt()
+		mprc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mprc.mutation.UpdatedAt(); !ok {
+		v := memberpointsrule.DefaultUpdatedAt()
+		mprc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mprc.mutation.Enabled(); !ok {
+		v := memberpointsrule.DefaultEnabled
+		mprc.mutation.SetEnabled(v)
+	}
+	if _, ok := mprc.mutation.ExpireDays(); !ok {
+		v := memberpointsrule.DefaultExpireDays
+		mprc.mutation.SetExpireDays(v)
+	}
+	if _, ok := mprc.mutation.RedeemEnabled(); !ok {
+		v := memberpointsrule.DefaultRedeemEnabled
+		mprc.mutation.SetRedeemEnabled(v)
+	}
+	if _, ok := mprc.mutation.RedeemRate(); !ok {
+		v := memberpointsrule.DefaultRedeemRate
+		mprc.mutation.SetRedeemRate(v)
+	}
+	if _, ok := mprc.mutation.RedeemMinPoints(); !ok {
+		v := memberpointsrule.DefaultRedeemMinPoints
+		mprc.mutation.SetRedeemMinPoints(v)
+	}
+	if _, ok := mprc.mutation.ID(); !ok {
+		v := memberpointsrule.DefaultID()
+		mprc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mprc *MemberPointsRuleCreate) check() error {
+	if _, ok := mprc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MemberPointsRule.created_at"`)}
+	}
+	if _, ok := mprc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MemberPointsRule.updated_at"`)}
+	}
+	if _, ok := mprc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "MemberPointsRule.merchant_id"`)}
+	}
+	if _, ok := mprc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "MemberPointsRule.enabled"`)}
+	}
+	if _, ok := mprc.mutation.EarnRate(); !ok {
+		return &ValidationError{Name: "earn_rate", err: errors.New(`ent: missing required field "MemberPointsRule.earn_rate"`)}
+	}
+	if _, ok := mprc.mutation.ExpireDays(); !ok {
+		return &ValidationError{Name: "expire_days", err: errors.New(`ent: missing required field "MemberPointsRule.expire_days"`)}
+	}
+	if _, ok := mprc.mutation.RedeemEnabled(); !ok {
+		return &ValidationError{Name: "redeem_enabled", err: errors.New(`ent: missing required field "MemberPointsRule.redeem_enabled"`)}
+	}
+	if _, ok := mprc.mutation.RedeemRate(); !ok {
+		return &ValidationError{Name: "redeem_rate", err: errors.New(`ent: missing required field "MemberPointsRule.redeem_rate"`)}
+	}
+	if _, ok := mprc.mutation.RedeemMinPoints(); !ok {
+		return &ValidationError{Name: "redeem_min_points", err: errors.New(`ent: missing required field "MemberPointsRule.redeem_min_points"`)}
+	}
+	if _, ok := mprc.mutation.RedeemMaxPercent(); !ok {
+		return &ValidationError{Name: "redeem_max_percent", err: errors.New(`ent: missing required field "MemberPointsRule.redeem_max_percent"`)}
+	}
+	return nil
+}
+
+func (mprc *MemberPointsRuleCreate) sqlSave(ctx context.Context) (*MemberPointsRule, error) {
+	if err := mprc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mprc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mprc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mprc.mutation.id = &_node.ID
+	mprc.mutation.done = true
+	return _node, nil
+}
+
+func (mprc *MemberPointsRuleCreate) createSpec() (*MemberPointsRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MemberPointsRule{config: mprc.config}
+		_spec = sqlgraph.NewCreateSpec(memberpointsrule.Table, sqlgraph.NewFieldSpec(memberpointsrule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mprc.conflict
+	if id, ok := mprc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mprc.mutation.CreatedAt(); ok {
+		_spec.SetField(memberpointsrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mprc.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberpointsrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mprc.mutation.MerchantID(); ok {
+		_spec.SetField(memberpointsrule.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := mprc.mutation.Enabled(); ok {
+		_spec.SetField(memberpointsrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := mprc.mutation.EarnRate(); ok {
+		_spec.SetField(memberpointsrule.FieldEarnRate, field.TypeOther, value)
+		_node.EarnRate = value
+	}
+	if value, ok := mprc.mutation.CategoryRates(); ok {
+		_spec.SetField(memberpointsrule.FieldCategoryRates, field.TypeJSON, value)
+		_node.CategoryRates = value
+	}
+	if value, ok := mprc.mutation.TierMultipliers(); ok {
+		_spec.SetField(memberpointsrule.FieldTierMultipliers, field.TypeJSON, value)
+		_node.TierMultipliers = value
+	}
+	if value, ok := mprc.mutation.ExpireDays(); ok {
+		_spec.SetField(memberpointsrule.FieldExpireDays, field.TypeInt, value)
+		_node.ExpireDays = value
+	}
+	if value, ok := mprc.mutation.RedeemEnabled(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemEnabled, field.TypeBool, value)
+		_node.RedeemEnabled = value
+	}
+	if value, ok := mprc.mutation.RedeemRate(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemRate, field.TypeInt64, value)
+		_node.RedeemRate = value
+	}
+	if value, ok := mprc.mutation.RedeemMinPoints(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemMinPoints, field.TypeInt64, value)
+		_node.RedeemMinPoints = value
+	}
+	if value, ok := mprc.mutation.RedeemMaxPercent(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemMaxPercent, field.TypeOther, value)
+		_node.RedeemMaxPercent = value
+	}
+	if value, ok := mprc.mutation.RedeemProducts(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemProducts, field.TypeJSON, value)
+		_node.RedeemProducts = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberPointsRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberPointsRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mprc *MemberPointsRuleCreate) OnConflict(opts ...sql.ConflictOption) *MemberPointsRuleUpsertOne {
+	mprc.conflict = opts
+	return &MemberPointsRuleUpsertOne{
+		create: mprc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberPointsRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mprc *MemberPointsRuleCreate) OnConflictColumns(columns ...string) *MemberPointsRuleUpsertOne {
+	mprc.conflict = append(mprc.conflict, sql.ConflictColumns(columns...))
+	return &MemberPointsRuleUpsertOne{
+		create: mprc,
+	}
+}
+
+type (
+	// MemberPointsRuleUpsertOne is the builder for "upsert"-ing
+	//  one MemberPointsRule node.
+	MemberPointsRuleUpsertOne struct {
+		create *MemberPointsRuleCreate
+	}
+
+	// MemberPointsRuleUpsert is the "OnConflict" setter.
+	MemberPointsRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberPointsRuleUpsert) SetUpdatedAt(v time.Time) *MemberPointsRuleUpsert {
+	u.Set(memberpointsrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsert) UpdateUpdatedAt() *MemberPointsRuleUpsert {
+	u.SetExcluded(memberpointsrule.FieldUpdatedAt)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *MemberPointsRuleUpsert) SetEnabled(v bool) *MemberPointsRuleUpsert {
+	u.Set(memberpointsrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsert) UpdateEnabled() *MemberPointsRuleUpsert {
+	u.SetExcluded(memberpointsrule.FieldEnabled)
+	return u
+}
+
+// SetEarnRate sets the "earn_rate" field.
+func (u *MemberPointsRuleUpsert) SetEarnRate(v decimal.Decimal) *MemberPointsRuleUpsert {
+	u.Set(memberpointsrule.FieldEarnRate, v)
+	return u
+}
+
+// UpdateEarnRate sets the "earn_rate" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsert) UpdateEarnRate() *MemberPointsRuleUpsert {
+	u.SetExcluded(memberpointsrule.FieldEarnRate)
+	return u
+}
+
+// SetCategoryRates sets the "category_rates" field.
+func (u *MemberPointsRuleUpsert) SetCategoryRates(v []domain.MemberPointsCategoryRate) *MemberPointsRuleUpsert {
+	u.Set(memberpointsrule.FieldCategoryRates, v)
+	return u
+}
+
+// UpdateCategoryRates sets the "category_rates" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsert) UpdateCategoryRates() *MemberPointsRuleUpsert {
+	u.SetExcluded(memberpointsrule.FieldCategoryRates)
+	return u
+}
+
+// ClearCategoryRates clears the value of the "category_rates" field.
+func (u *MemberPointsRuleUpsert) ClearCategoryRates() *MemberPointsRuleUpsert {
+	u.SetNull(memberpointsrule.FieldCategoryRates)
+	return u
+}
+
+// SetTierMultipliers sets the "tier_multipliers" field.
+func (u *MemberPointsRuleUpsert) SetTierMultipliers(v []domain.MemberPointsTierMultiplier) *MemberPointsRuleUpsert {
+	u.Set(memberpointsrule.FieldTierMultipliers, v)
+	return u
+}
+
+// UpdateTierMultipliers sets the "tier_multipliers" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsert) UpdateTierMultipliers() *MemberPointsRuleUpsert {
+	u.SetExcluded(memberpointsrule.FieldTierMultipliers)
+	return u
+}
+
+// ClearTierMultipliers clears the value of the "tier_multipliers" field.
+func (u *MemberPointsRuleUpsert) ClearTierMultipliers() *MemberPointsRuleUpsert {
+	u.SetNull(memberpointsrule.FieldTierMultipliers)
+	return u
+}
+
+// SetExpireDays sets the "expire_days" field.
+func (u *MemberPointsRuleUpsert) SetExpireDays(v int) *MemberPointsRuleUpsert {
+	u.Set(memberpointsrule.FieldExpireDays, v)
+	return u
+}
+
+// UpdateExpireDays sets the "expire_days" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsert) UpdateExpireDays() *MemberPointsRuleUpsert {
+	u.SetExcluded(memberpointsrule.FieldExpireDays)
+	return u
+}
+
+// AddExpireDays adds v to the "expire_days" field.
+func (u *MemberPointsRuleUpsert) AddExpireDays(v int) *MemberPointsRuleUpsert {
+	u.Add(memberpointsrule.FieldExpireDays, v)
+	return u
+}
+
+// SetRedeemEnabled sets the "redeem_enabled" field.
+func (u *MemberPointsRuleUpsert) SetRedeemEnabled(v bool) *MemberPointsRuleUpsert {
+	u.Set(memberpointsrule.FieldRedeemEnabled, v)
+	return u
+}
+
+// UpdateRedeemEnabled sets the "redeem_enabled" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsert) UpdateRedeemEnabled() *MemberPointsRuleUpsert {
+	u.SetExcluded(memberpointsrule.FieldRedeemEnabled)
+	return u
+}
+
+// SetRedeemRate sets the "redeem_rate" field.
+func (u *MemberPointsRuleUpsert) SetRedeemRate(v int64) *MemberPointsRuleUpsert {
+	u.Set(memberpointsrule.FieldRedeemRate, v)
+	return u
+}
+
+// UpdateRedeemRate sets the "redeem_rate" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsert) UpdateRedeemRate() *MemberPointsRuleUpsert {
+	u.SetExcluded(memberpointsrule.FieldRedeemRate)
+	return u
+}
+
+// AddRedeemRate adds v to the "redeem_rate" field.
+func (u *MemberPointsRuleUpsert) AddRedeemRate(v int64) *MemberPointsRuleUpsert {
+	u.Add(memberpointsrule.FieldRedeemRate, v)
+	return u
+}
+
+// SetRedeemMinPoints sets the "redeem_min_points" field.
+func (u *MemberPointsRuleUpsert) SetRedeemMinPoints(v int64) *MemberPointsRuleUpsert {
+	u.Set(memberpointsrule.FieldRedeemMinPoints, v)
+	return u
+}
+
+// UpdateRedeemMinPoints sets the "redeem_min_points" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsert) UpdateRedeemMinPoints() *MemberPointsRuleUpsert {
+	u.SetExcluded(memberpointsrule.FieldRedeemMinPoints)
+	return u
+}
+
+// AddRedeemMinPoints adds v to the "redeem_min_points" field.
+func (u *MemberPointsRuleUpsert) AddRedeemMinPoints(v int64) *MemberPointsRuleUpsert {
+	u.Add(memberpointsrule.FieldRedeemMinPoints, v)
+	return u
+}
+
+// SetRedeemMaxPercent sets the "redeem_max_percent" field.
+func (u *MemberPointsRuleUpsert) SetRedeemMaxPercent(v decimal.Decimal) *MemberPointsRuleUpsert {
+	u.Set(memberpointsrule.FieldRedeemMaxPercent, v)
+	return u
+}
+
+// UpdateRedeemMaxPercent sets the "redeem_max_percent" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsert) UpdateRedeemMaxPercent() *MemberPointsRuleUpsert {
+	u.SetExcluded(memberpointsrule.FieldRedeemMaxPercent)
+	return u
+}
+
+// SetRedeemProducts sets the "redeem_products" field.
+func (u *MemberPointsRuleUpsert) SetRedeemProducts(v []domain.MemberPointsRedeemProduct) *MemberPointsRuleUpsert {
+	u.Set(memberpointsrule.FieldRedeemProducts, v)
+	return u
+}
+
+// UpdateRedeemProducts sets the "redeem_products" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsert) UpdateRedeemProducts() *MemberPointsRuleUpsert {
+	u.SetExcluded(memberpointsrule.FieldRedeemProducts)
+	return u
+}
+
+// ClearRedeemProducts clears the value of the "redeem_products" field.
+func (u *MemberPointsRuleUpsert) ClearRedeemProducts() *MemberPointsRuleUpsert {
+	u.SetNull(memberpointsrule.FieldRedeemProducts)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MemberPointsRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(memberpointsrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberPointsRuleUpsertOne) UpdateNewValues() *MemberPointsRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(memberpointsrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(memberpointsrule.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(memberpointsrule.FieldMerchantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberPointsRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MemberPointsRuleUpsertOne) Ignore() *MemberPointsRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberPointsRuleUpsertOne) DoNothing() *MemberPointsRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberPointsRuleCreate.OnConflict
+// documentation for more info.
+func (u *MemberPointsRuleUpsertOne) Update(set func(*MemberPointsRuleUpsert)) *MemberPointsRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberPointsRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberPointsRuleUpsertOne) SetUpdatedAt(v time.Time) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertOne) UpdateUpdatedAt() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *MemberPointsRuleUpsertOne) SetEnabled(v bool) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertOne) UpdateEnabled() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetEarnRate sets the "earn_rate" field.
+func (u *MemberPointsRuleUpsertOne) SetEarnRate(v decimal.Decimal) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetEarnRate(v)
+	})
+}
+
+// UpdateEarnRate sets the "earn_rate" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertOne) UpdateEarnRate() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateEarnRate()
+	})
+}
+
+// SetCategoryRates sets the "category_rates" field.
+func (u *MemberPointsRuleUpsertOne) SetCategoryRates(v []domain.MemberPointsCategoryRate) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetCategoryRates(v)
+	})
+}
+
+// UpdateCategoryRates sets the "category_rates" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertOne) UpdateCategoryRates() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateCategoryRates()
+	})
+}
+
+// ClearCategoryRates clears the value of the "category_rates" field.
+func (u *MemberPointsRuleUpsertOne) ClearCategoryRates() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.ClearCategoryRates()
+	})
+}
+
+// SetTierMultipliers sets the "tier_multipliers" field.
+func (u *MemberPointsRuleUpsertOne) SetTierMultipliers(v []domain.MemberPointsTierMultiplier) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetTierMultipliers(v)
+	})
+}
+
+// UpdateTierMultipliers sets the "tier_multipliers" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertOne) UpdateTierMultipliers() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateTierMultipliers()
+	})
+}
+
+// ClearTierMultipliers clears the value of the "tier_multipliers" field.
+func (u *MemberPointsRuleUpsertOne) ClearTierMultipliers() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.ClearTierMultipliers()
+	})
+}
+
+// SetExpireDays sets the "expire_days" field.
+func (u *MemberPointsRuleUpsertOne) SetExpireDays(v int) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetExpireDays(v)
+	})
+}
+
+// AddExpireDays adds v to the "expire_days" field.
+func (u *MemberPointsRuleUpsertOne) AddExpireDays(v int) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.AddExpireDays(v)
+	})
+}
+
+// UpdateExpireDays sets the "expire_days" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertOne) UpdateExpireDays() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateExpireDays()
+	})
+}
+
+// SetRedeemEnabled sets the "redeem_enabled" field.
+func (u *MemberPointsRuleUpsertOne) SetRedeemEnabled(v bool) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetRedeemEnabled(v)
+	})
+}
+
+// UpdateRedeemEnabled sets the "redeem_enabled" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertOne) UpdateRedeemEnabled() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateRedeemEnabled()
+	})
+}
+
+// SetRedeemRate sets the "redeem_rate" field.
+func (u *MemberPointsRuleUpsertOne) SetRedeemRate(v int64) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetRedeemRate(v)
+	})
+}
+
+// AddRedeemRate adds v to the "redeem_rate" field.
+func (u *MemberPointsRuleUpsertOne) AddRedeemRate(v int64) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.AddRedeemRate(v)
+	})
+}
+
+// UpdateRedeemRate sets the "redeem_rate" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertOne) UpdateRedeemRate() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateRedeemRate()
+	})
+}
+
+// SetRedeemMinPoints sets the "redeem_min_points" field.
+func (u *MemberPointsRuleUpsertOne) SetRedeemMinPoints(v int64) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetRedeemMinPoints(v)
+	})
+}
+
+// AddRedeemMinPoints adds v to the "redeem_min_points" field.
+func (u *MemberPointsRuleUpsertOne) AddRedeemMinPoints(v int64) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.AddRedeemMinPoints(v)
+	})
+}
+
+// UpdateRedeemMinPoints sets the "redeem_min_points" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertOne) UpdateRedeemMinPoints() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateRedeemMinPoints()
+	})
+}
+
+// SetRedeemMaxPercent sets the "redeem_max_percent" field.
+func (u *MemberPointsRuleUpsertOne) SetRedeemMaxPercent(v decimal.Decimal) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetRedeemMaxPercent(v)
+	})
+}
+
+// UpdateRedeemMaxPercent sets the "redeem_max_percent" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertOne) UpdateRedeemMaxPercent() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateRedeemMaxPercent()
+	})
+}
+
+// SetRedeemProducts sets the "redeem_products" field.
+func (u *MemberPointsRuleUpsertOne) SetRedeemProducts(v []domain.MemberPointsRedeemProduct) *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetRedeemProducts(v)
+	})
+}
+
+// UpdateRedeemProducts sets the "redeem_products" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertOne) UpdateRedeemProducts() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateRedeemProducts()
+	})
+}
+
+// ClearRedeemProducts clears the value of the "redeem_products" field.
+func (u *MemberPointsRuleUpsertOne) ClearRedeemProducts() *MemberPointsRuleUpsertOne {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.ClearRedeemProducts()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberPointsRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberPointsRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberPointsRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MemberPointsRuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MemberPointsRuleUpsertOne.ID is not supported by MySQL driver. Use MemberPointsRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MemberPointsRuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MemberPointsRuleCreateBulk is the builder for creating many MemberPointsRule entities in bulk.
+type MemberPointsRuleCreateBulk struct {
+	config
+	err      error
+	builders []*MemberPointsRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MemberPointsRule entities in the database.
+func (mprcb *MemberPointsRuleCreateBulk) Save(ctx context.Context) ([]*MemberPointsRule, error) {
+	if mprcb.err != nil {
+		return nil, mprcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mprcb.builders))
+	nodes := make([]*MemberPointsRule, len(mprcb.builders))
+	mutators := make([]Mutator, len(mprcb.builders))
+	for i := range mprcb.builders {
+		func(i int, root context.Context) {
+			builder := mprcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MemberPointsRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mprcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mprcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mprcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mprcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mprcb *MemberPointsRuleCreateBulk) SaveX(ctx context.Context) []*MemberPointsRule {
+	v, err := mprcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mprcb *MemberPointsRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := mprcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mprcb *MemberPointsRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := mprcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberPointsRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberPointsRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mprcb *MemberPointsRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *MemberPointsRuleUpsertBulk {
+	mprcb.conflict = opts
+	return &MemberPointsRuleUpsertBulk{
+		create: mprcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberPointsRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mprcb *MemberPointsRuleCreateBulk) OnConflictColumns(columns ...string) *MemberPointsRuleUpsertBulk {
+	mprcb.conflict = append(mprcb.conflict, sql.ConflictColumns(columns...))
+	return &MemberPointsRuleUpsertBulk{
+		create: mprcb,
+	}
+}
+
+// MemberPointsRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of MemberPointsRule nodes.
+type MemberPointsRuleUpsertBulk struct {
+	create *MemberPointsRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MemberPointsRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(memberpointsrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberPointsRuleUpsertBulk) UpdateNewValues() *MemberPointsRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(memberpointsrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(memberpointsrule.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(memberpointsrule.FieldMerchantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberPointsRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MemberPointsRuleUpsertBulk) Ignore() *MemberPointsRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberPointsRuleUpsertBulk) DoNothing() *MemberPointsRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberPointsRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *MemberPointsRuleUpsertBulk) Update(set func(*MemberPointsRuleUpsert)) *MemberPointsRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberPointsRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberPointsRuleUpsertBulk) SetUpdatedAt(v time.Time) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertBulk) UpdateUpdatedAt() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *MemberPointsRuleUpsertBulk) SetEnabled(v bool) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertBulk) UpdateEnabled() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetEarnRate sets the "earn_rate" field.
+func (u *MemberPointsRuleUpsertBulk) SetEarnRate(v decimal.Decimal) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetEarnRate(v)
+	})
+}
+
+// UpdateEarnRate sets the "earn_rate" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertBulk) UpdateEarnRate() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateEarnRate()
+	})
+}
+
+// SetCategoryRates sets the "category_rates" field.
+func (u *MemberPointsRuleUpsertBulk) SetCategoryRates(v []domain.MemberPointsCategoryRate) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetCategoryRates(v)
+	})
+}
+
+// UpdateCategoryRates sets the "category_rates" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertBulk) UpdateCategoryRates() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateCategoryRates()
+	})
+}
+
+// ClearCategoryRates clears the value of the "category_rates" field.
+func (u *MemberPointsRuleUpsertBulk) ClearCategoryRates() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.ClearCategoryRates()
+	})
+}
+
+// SetTierMultipliers sets the "tier_multipliers" field.
+func (u *MemberPointsRuleUpsertBulk) SetTierMultipliers(v []domain.MemberPointsTierMultiplier) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetTierMultipliers(v)
+	})
+}
+
+// UpdateTierMultipliers sets the "tier_multipliers" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertBulk) UpdateTierMultipliers() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateTierMultipliers()
+	})
+}
+
+// ClearTierMultipliers clears the value of the "tier_multipliers" field.
+func (u *MemberPointsRuleUpsertBulk) ClearTierMultipliers() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.ClearTierMultipliers()
+	})
+}
+
+// SetExpireDays sets the "expire_days" field.
+func (u *MemberPointsRuleUpsertBulk) SetExpireDays(v int) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetExpireDays(v)
+	})
+}
+
+// AddExpireDays adds v to the "expire_days" field.
+func (u *MemberPointsRuleUpsertBulk) AddExpireDays(v int) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.AddExpireDays(v)
+	})
+}
+
+// UpdateExpireDays sets the "expire_days" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertBulk) UpdateExpireDays() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateExpireDays()
+	})
+}
+
+// SetRedeemEnabled sets the "redeem_enabled" field.
+func (u *MemberPointsRuleUpsertBulk) SetRedeemEnabled(v bool) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetRedeemEnabled(v)
+	})
+}
+
+// UpdateRedeemEnabled sets the "redeem_enabled" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertBulk) UpdateRedeemEnabled() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateRedeemEnabled()
+	})
+}
+
+// SetRedeemRate sets the "redeem_rate" field.
+func (u *MemberPointsRuleUpsertBulk) SetRedeemRate(v int64) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetRedeemRate(v)
+	})
+}
+
+// AddRedeemRate adds v to the "redeem_rate" field.
+func (u *MemberPointsRuleUpsertBulk) AddRedeemRate(v int64) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.AddRedeemRate(v)
+	})
+}
+
+// UpdateRedeemRate sets the "redeem_rate" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertBulk) UpdateRedeemRate() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateRedeemRate()
+	})
+}
+
+// SetRedeemMinPoints sets the "redeem_min_points" field.
+func (u *MemberPointsRuleUpsertBulk) SetRedeemMinPoints(v int64) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetRedeemMinPoints(v)
+	})
+}
+
+// AddRedeemMinPoints adds v to the "redeem_min_points" field.
+func (u *MemberPointsRuleUpsertBulk) AddRedeemMinPoints(v int64) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.AddRedeemMinPoints(v)
+	})
+}
+
+// UpdateRedeemMinPoints sets the "redeem_min_points" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertBulk) UpdateRedeemMinPoints() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateRedeemMinPoints()
+	})
+}
+
+// SetRedeemMaxPercent sets the "redeem_max_percent" field.
+func (u *MemberPointsRuleUpsertBulk) SetRedeemMaxPercent(v decimal.Decimal) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetRedeemMaxPercent(v)
+	})
+}
+
+// UpdateRedeemMaxPercent sets the "redeem_max_percent" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertBulk) UpdateRedeemMaxPercent() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateRedeemMaxPercent()
+	})
+}
+
+// SetRedeemProducts sets the "redeem_products" field.
+func (u *MemberPointsRuleUpsertBulk) SetRedeemProducts(v []domain.MemberPointsRedeemProduct) *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.SetRedeemProducts(v)
+	})
+}
+
+// UpdateRedeemProducts sets the "redeem_products" field to the value that was provided on create.
+func (u *MemberPointsRuleUpsertBulk) UpdateRedeemProducts() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.UpdateRedeemProducts()
+	})
+}
+
+// ClearRedeemProducts clears the value of the "redeem_products" field.
+func (u *MemberPointsRuleUpsertBulk) ClearRedeemProducts() *MemberPointsRuleUpsertBulk {
+	return u.Update(func(s *MemberPointsRuleUpsert) {
+		s.ClearRedeemProducts()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberPointsRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MemberPointsRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberPointsRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberPointsRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
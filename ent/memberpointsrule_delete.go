@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberPointsRuleDelete is the builder for deleting a MemberPointsRule entity.
+type MemberPointsRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *MemberPointsRuleMutation
+}
+
+// Where appends a list predicates to the MemberPointsRuleDelete builder.
+func (mprd *MemberPointsRuleDelete) Where(ps ...predicate.MemberPointsRule) *MemberPointsRuleDelete {
+	mprd.mutation.Where(ps...)
+	return mprd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mprd *MemberPointsRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mprd.sqlExec, mprd.mutation, mprd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mprd *MemberPointsRuleDelete) ExecX(ctx context.Context) int {
+	n, err := mprd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mprd *MemberPointsRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(memberpointsrule.Table, sqlgraph.NewFieldSpec(memberpointsrule.FieldID, field.TypeUUID))
+	if ps := mprd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mprd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mprd.mutation.done = true
+	return affected, err
+}
+
+// MemberPointsRuleDeleteOne is the builder for deleting a single MemberPointsRule entity.
+type MemberPointsRuleDeleteOne struct {
+	mprd *MemberPointsRuleDelete
+}
+
+// Where appends a list predicates to the MemberPointsRuleDelete builder.
+func (mprdo *MemberPointsRuleDeleteOne) Where(ps ...predicate.MemberPointsRule) *MemberPointsRuleDeleteOne {
+	mprdo.mprd.mutation.Where(ps...)
+	return mprdo
+}
+
+// Exec executes the deletion query.
+func (mprdo *MemberPointsRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := mprdo.mprd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{memberpointsrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mprdo *MemberPointsRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := mprdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberPointsRuleQuery is the builder for querying MemberPointsRule entities.
+type MemberPointsRuleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []memberpointsrule.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MemberPointsRule
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MemberPointsRuleQuery builder.
+func (mprq *MemberPointsRuleQuery) Where(ps ...predicate.MemberPointsRule) *MemberPointsRuleQuery {
+	mprq.predicates = append(mprq.predicates, ps...)
+	return mprq
+}
+
+// Limit the number of records to be returned by this query.
+func (mprq *MemberPointsRuleQuery) Limit(limit int) *MemberPointsRuleQuery {
+	mprq.ctx.Limit = &limit
+	return mprq
+}
+
+// Offset to start from.
+func (mprq *MemberPointsRuleQuery) Offset(offset int) *MemberPointsRuleQuery {
+	mprq.ctx.Offset = &offset
+	return mprq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mprq *MemberPointsRuleQuery) Unique(unique bool) *MemberPointsRuleQuery {
+	mprq.ctx.Unique = &unique
+	return mprq
+}
+
+// Order specifies how the records should be ordered.
+func (mprq *MemberPointsRuleQuery) Order(o ...memberpointsrule.OrderOption) *MemberPointsRuleQuery {
+	mprq.order = append(mprq.order, o...)
+	return mprq
+}
+
+// First returns the first MemberPointsRule entity from the query.
+// Returns a *NotFoundError when no MemberPointsRule was found.
+func (mprq *MemberPointsRuleQuery) First(ctx context.Context) (*MemberPointsRule, error) {
+	nodes, err := mprq.Limit(1).All(setContextOp(ctx, mprq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{memberpointsrule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mprq *MemberPointsRuleQuery) FirstX(ctx context.Context) *MemberPointsRule {
+	node, err := mprq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MemberPointsRule ID from the query.
+// Returns a *NotFoundError when no MemberPointsRule ID was found.
+func (mprq *MemberPointsRuleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mprq.Limit(1).IDs(setContextOp(ctx, mprq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{memberpointsrule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mprq *MemberPointsRuleQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mprq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MemberPointsRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MemberPointsRule entity is found.
+// Returns a *NotFoundError when no MemberPointsRule entities are found.
+func (mprq *MemberPointsRuleQuery) Only(ctx context.Context) (*MemberPointsRule, error) {
+	nodes, err := mprq.Limit(2).All(setContextOp(ctx, mprq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{memberpointsrule.Label}
+	default:
+		return nil, &NotSingularError{memberpointsrule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mprq *MemberPointsRuleQuery) OnlyX(ctx context.Context) *MemberPointsRule {
+	node, err := mprq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MemberPointsRule ID in the query.
+// Returns a *NotSingularError when more than one MemberPointsRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mprq *MemberPointsRuleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mprq.Limit(2).IDs(setContextOp(ctx, mprq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{memberpointsrule.Label}
+	default:
+		err = &NotSingularError{memberpointsrule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mprq *MemberPointsRuleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mprq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MemberPointsRules.
+func (mprq *MemberPointsRuleQuery) All(ctx context.Context) ([]*MemberPointsRule, error) {
+	ctx = setContextOp(ctx, mprq.ctx, ent.OpQueryAll)
+	if err := mprq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MemberPointsRule, *MemberPointsRuleQuery]()
+	return withInterceptors[[]*MemberPointsRule](ctx, mprq, qr, mprq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mprq *MemberPointsRuleQuery) AllX(ctx context.Context) []*MemberPointsRule {
+	nodes, err := mprq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MemberPointsRule IDs.
+func (mprq *MemberPointsRuleQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mprq.ctx.Unique == nil && mprq.path != nil {
+		mprq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mprq.ctx, ent.OpQueryIDs)
+	if err = mprq.Select(memberpointsrule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mprq *MemberPointsRuleQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mprq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mprq *MemberPointsRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mprq.ctx, ent.OpQueryCount)
+	if err := mprq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mprq, querierCount[*MemberPointsRuleQuery](), mprq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mprq *MemberPointsRuleQuery) CountX(ctx context.Context) int {
+	count, err := mprq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mprq *MemberPointsRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mprq.ctx, ent.OpQueryExist)
+	switch _, err := mprq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mprq *MemberPointsRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := mprq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MemberPointsRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mprq *MemberPointsRuleQuery) Clone() *MemberPointsRuleQuery {
+	if mprq == nil {
+		return nil
+	}
+	return &MemberPointsRuleQuery{
+		config:     mprq.config,
+		ctx:        mprq.ctx.Clone(),
+		order:      append([]memberpointsrule.OrderOption{}, mprq.order...),
+		inters:     append([]Interceptor{}, mprq.inters...),
+		predicates: append([]predicate.MemberPointsRule{}, mprq.predicates...),
+		// clone intermediate query.
+		sql:       mprq.sql.Clone(),
+		path:      mprq.path,
+		modifiers: append([]func(*sql.Selector){}, mprq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MemberPointsRule.Query().
+//		GroupBy(memberpointsrule.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mprq *MemberPointsRuleQuery) GroupBy(field string, fields ...string) *MemberPointsRuleGroupBy {
+	mprq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MemberPointsRuleGroupBy{build: mprq}
+	grbuild.flds = &mprq.ctx.Fields
+	grbuild.label = memberpointsrule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.MemberPointsRule.Query().
+//		Select(memberpointsrule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (mprq *MemberPointsRuleQuery) Select(fields ...string) *MemberPointsRuleSelect {
+	mprq.ctx.Fields = append(mprq.ctx.Fields, fields...)
+	sbuild := &MemberPointsRuleSelect{MemberPointsRuleQuery: mprq}
+	sbuild.label = memberpointsrule.Label
+	sbuild.flds, sbuild.scan = &mprq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MemberPointsRuleSelect configured with the given aggregations.
+func (mprq *MemberPointsRuleQuery) Aggregate(fns ...AggregateFunc) *MemberPointsRuleSelect {
+	return mprq.Select().Aggregate(fns...)
+}
+
+func (mprq *MemberPointsRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mprq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mprq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mprq.ctx.Fields {
+		if !memberpointsrule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mprq.path != nil {
+		prev, err := mprq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mprq.sql = prev
+	}
+	return nil
+}
+
+func (mprq *MemberPointsRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MemberPointsRule, error) {
+	var (
+		nodes = []*MemberPointsRule{}
+		_spec = mprq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MemberPointsRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MemberPointsRule{config: mprq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(mprq.modifiers) > 0 {
+		_spec.Modifiers = mprq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mprq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mprq *MemberPointsRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mprq.querySpec()
+	if len(mprq.modifiers) > 0 {
+		_spec.Modifiers = mprq.modifiers
+	}
+	_spec.Node.Columns = mprq.ctx.Fields
+	if len(mprq.ctx.Fields) > 0 {
+		_spec.Unique = mprq.ctx.Unique != nil && *mprq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mprq.driver, _spec)
+}
+
+func (mprq *MemberPointsRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(memberpointsrule.Table, memberpointsrule.Columns, sqlgraph.NewFieldSpec(memberpointsrule.FieldID, field.TypeUUID))
+	_spec.From = mprq.sql
+	if unique := mprq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mprq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mprq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, memberpointsrule.FieldID)
+		for i := range fields {
+			if fields[i] != memberpointsrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mprq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mprq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mprq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mprq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mprq *MemberPointsRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mprq.driver.Dialect())
+	t1 := builder.Table(memberpointsrule.Table)
+	columns := mprq.ctx.Fields
+	if len(columns) == 0 {
+		columns = memberpointsrule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mprq.sql != nil {
+		selector = mprq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mprq.ctx.Unique != nil && *mprq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mprq.modifiers {
+		m(selector)
+	}
+	for _, p := range mprq.predicates {
+		p(selector)
+	}
+	for _, p := range mprq.order {
+		p(selector)
+	}
+	if offset := mprq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mprq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mprq *MemberPointsRuleQuery) ForUpdate(opts ...sql.LockOption) *MemberPointsRuleQuery {
+	if mprq.driver.Dialect() == dialect.Postgres {
+		mprq.Unique(false)
+	}
+	mprq.modifiers = append(mprq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mprq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mprq *MemberPointsRuleQuery) ForShare(opts ...sql.LockOption) *MemberPointsRuleQuery {
+	if mprq.driver.Dialect() == dialect.Postgres {
+		mprq.Unique(false)
+	}
+	mprq.modifiers = append(mprq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mprq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mprq *MemberPointsRuleQuery) Modify(modifiers ...func(s *sql.Selector)) *MemberPointsRuleSelect {
+	mprq.modifiers = append(mprq.modifiers, modifiers...)
+	return mprq.Select()
+}
+
+// MemberPointsRuleGroupBy is the group-by builder for MemberPointsRule entities.
+type MemberPointsRuleGroupBy struct {
+	selector
+	build *MemberPointsRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mprgb *MemberPointsRuleGroupBy) Aggregate(fns ...AggregateFunc) *MemberPointsRuleGroupBy {
+	mprgb.fns = append(mprgb.fns, fns...)
+	return mprgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mprgb *MemberPointsRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mprgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mprgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberPointsRuleQuery, *MemberPointsRuleGroupBy](ctx, mprgb.build, mprgb, mprgb.build.inters, v)
+}
+
+func (mprgb *MemberPointsRuleGroupBy) sqlScan(ctx context.Context, root *MemberPointsRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mprgb.fns))
+	for _, fn := range mprgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mprgb.flds)+len(mprgb.fns))
+		for _, f := range *mprgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mprgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mprgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MemberPointsRuleSelect is the builder for selecting fields of MemberPointsRule entities.
+type MemberPointsRuleSelect struct {
+	*MemberPointsRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mprs *MemberPointsRuleSelect) Aggregate(fns ...AggregateFunc) *MemberPointsRuleSelect {
+	mprs.fns = append(mprs.fns, fns...)
+	return mprs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mprs *MemberPointsRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mprs.ctx, ent.OpQuerySelect)
+	if err := mprs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberPointsRuleQuery, *MemberPointsRuleSelect](ctx, mprs.MemberPointsRuleQuery, mprs, mprs.inters, v)
+}
+
+func (mprs *MemberPointsRuleSelect) sqlScan(ctx context.Context, root *MemberPointsRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mprs.fns))
+	for _, fn := range mprs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mprs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mprs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mprs *MemberPointsRuleSelect) Modify(modifiers ...func(s *sql.Selector)) *MemberPointsRuleSelect {
+	mprs.modifiers = append(mprs.modifiers, modifiers...)
+	return mprs
+}
@@ -0,0 +1,703 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberPointsRuleUpdate is the builder for updating MemberPointsRule entities.
+type MemberPointsRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MemberPointsRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MemberPointsRuleUpdate builder.
+func (mpru *MemberPointsRuleUpdate) Where(ps ...predicate.MemberPointsRule) *MemberPointsRuleUpdate {
+	mpru.mutation.Where(ps...)
+	return mpru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mpru *MemberPointsRuleUpdate) SetUpdatedAt(t time.Time) *MemberPointsRuleUpdate {
+	mpru.mutation.SetUpdatedAt(t)
+	return mpru
+}
+
+// SetEnabled sets the "enabled" field.
+func (mpru *MemberPointsRuleUpdate) SetEnabled(b bool) *MemberPointsRuleUpdate {
+	mpru.mutation.SetEnabled(b)
+	return mpru
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (mpru *MemberPointsRuleUpdate) SetNillableEnabled(b *bool) *MemberPointsRuleUpdate {
+	if b != nil {
+		mpru.SetEnabled(*b)
+	}
+	return mpru
+}
+
+// SetEarnRate sets the "earn_rate" field.
+func (mpru *MemberPointsRuleUpdate) SetEarnRate(d decimal.Decimal) *MemberPointsRuleUpdate {
+	mpru.mutation.SetEarnRate(d)
+	return mpru
+}
+
+// SetNillableEarnRate sets the "earn_rate" field if the given value is not nil.
+func (mpru *MemberPointsRuleUpdate) SetNillableEarnRate(d *decimal.Decimal) *MemberPointsRuleUpdate {
+	if d != nil {
+		mpru.SetEarnRate(*d)
+	}
+	return mpru
+}
+
+// SetCategoryRates sets the "category_rates" field.
+func (mpru *MemberPointsRuleUpdate) SetCategoryRates(dpcr []domain.MemberPointsCategoryRate) *MemberPointsRuleUpdate {
+	mpru.mutation.SetCategoryRates(dpcr)
+	return mpru
+}
+
+// AppendCategoryRates appends dpcr to the "category_rates" field.
+func (mpru *MemberPointsRuleUpdate) AppendCategoryRates(dpcr []domain.MemberPointsCategoryRate) *MemberPointsRuleUpdate {
+	mpru.mutation.AppendCategoryRates(dpcr)
+	return mpru
+}
+
+// ClearCategoryRates clears the value of the "category_rates" field.
+func (mpru *MemberPointsRuleUpdate) ClearCategoryRates() *MemberPointsRuleUpdate {
+	mpru.mutation.ClearCategoryRates()
+	return mpru
+}
+
+// SetTierMultipliers sets the "tier_multipliers" field.
+func (mpru *MemberPointsRuleUpdate) SetTierMultipliers(dptm []domain.MemberPointsTierMultiplier) *MemberPointsRuleUpdate {
+	mpru.mutation.SetTierMultipliers(dptm)
+	return mpru
+}
+
+// AppendTierMultipliers appends dptm to the "tier_multipliers" field.
+func (mpru *MemberPointsRuleUpdate) AppendTierMultipliers(dptm []domain.MemberPointsTierMultiplier) *MemberPointsRuleUpdate {
+	mpru.mutation.AppendTierMultipliers(dptm)
+	return mpru
+}
+
+// ClearTierMultipliers clears the value of the "tier_multipliers" field.
+func (mpru *MemberPointsRuleUpdate) ClearTierMultipliers() *MemberPointsRuleUpdate {
+	mpru.mutation.ClearTierMultipliers()
+	return mpru
+}
+
+// SetExpireDays sets the "expire_days" field.
+func (mpru *MemberPointsRuleUpdate) SetExpireDays(i int) *MemberPointsRuleUpdate {
+	mpru.mutation.ResetExpireDays()
+	mpru.mutation.SetExpireDays(i)
+	return mpru
+}
+
+// SetNillableExpireDays sets the "expire_days" field if the given value is not nil.
+func (mpru *MemberPointsRuleUpdate) SetNillableExpireDays(i *int) *MemberPointsRuleUpdate {
+	if i != nil {
+		mpru.SetExpireDays(*i)
+	}
+	return mpru
+}
+
+// AddExpireDays adds i to the "expire_days" field.
+func (mpru *MemberPointsRuleUpdate) AddExpireDays(i int) *MemberPointsRuleUpdate {
+	mpru.mutation.AddExpireDays(i)
+	return mpru
+}
+
+// SetRedeemEnabled sets the "redeem_enabled" field.
+func (mpru *MemberPointsRuleUpdate) SetRedeemEnabled(b bool) *MemberPointsRuleUpdate {
+	mpru.mutation.SetRedeemEnabled(b)
+	return mpru
+}
+
+// SetNillableRedeemEnabled sets the "redeem_enabled" field if the given value is not nil.
+func (mpru *MemberPointsRuleUpdate) SetNillableRedeemEnabled(b *bool) *MemberPointsRuleUpdate {
+	if b != nil {
+		mpru.SetRedeemEnabled(*b)
+	}
+	return mpru
+}
+
+// SetRedeemRate sets the "redeem_rate" field.
+func (mpru *MemberPointsRuleUpdate) SetRedeemRate(i int64) *MemberPointsRuleUpdate {
+	mpru.mutation.ResetRedeemRate()
+	mpru.mutation.SetRedeemRate(i)
+	return mpru
+}
+
+// SetNillableRedeemRate sets the "redeem_rate" field if the given value is not nil.
+func (mpru *MemberPointsRuleUpdate) SetNillableRedeemRate(i *int64) *MemberPointsRuleUpdate {
+	if i != nil {
+		mpru.SetRedeemRate(*i)
+	}
+	return mpru
+}
+
+// AddRedeemRate adds i to the "redeem_rate" field.
+func (mpru *MemberPointsRuleUpdate) AddRedeemRate(i int64) *MemberPointsRuleUpdate {
+	mpru.mutation.AddRedeemRate(i)
+	return mpru
+}
+
+// SetRedeemMinPoints sets the "redeem_min_points" field.
+func (mpru *MemberPointsRuleUpdate) SetRedeemMinPoints(i int64) *MemberPointsRuleUpdate {
+	mpru.mutation.ResetRedeemMinPoints()
+	mpru.mutation.SetRedeemMinPoints(i)
+	return mpru
+}
+
+// SetNillableRedeemMinPoints sets the "redeem_min_points" field if the given value is not nil.
+func (mpru *MemberPointsRuleUpdate) SetNillableRedeemMinPoints(i *int64) *MemberPointsRuleUpdate {
+	if i != nil {
+		mpru.SetRedeemMinPoints(*i)
+	}
+	return mpru
+}
+
+// AddRedeemMinPoints adds i to the "redeem_min_points" field.
+func (mpru *MemberPointsRuleUpdate) AddRedeemMinPoints(i int64) *MemberPointsRuleUpdate {
+	mpru.mutation.AddRedeemMinPoints(i)
+	return mpru
+}
+
+// SetRedeemMaxPercent sets the "redeem_max_percent" field.
+func (mpru *MemberPointsRuleUpdate) SetRedeemMaxPercent(d decimal.Decimal) *MemberPointsRuleUpdate {
+	mpru.mutation.SetRedeemMaxPercent(d)
+	return mpru
+}
+
+// SetNillableRedeemMaxPercent sets the "redeem_max_percent" field if the given value is not nil.
+func (mpru *MemberPointsRuleUpdate) SetNillableRedeemMaxPercent(d *decimal.Decimal) *MemberPointsRuleUpdate {
+	if d != nil {
+		mpru.SetRedeemMaxPercent(*d)
+	}
+	return mpru
+}
+
+// SetRedeemProducts sets the "redeem_products" field.
+func (mpru *MemberPointsRuleUpdate) SetRedeemProducts(dprp []domain.MemberPointsRedeemProduct) *MemberPointsRuleUpdate {
+	mpru.mutation.SetRedeemProducts(dprp)
+	return mpru
+}
+
+// AppendRedeemProducts appends dprp to the "redeem_products" field.
+func (mpru *MemberPointsRuleUpdate) AppendRedeemProducts(dprp []domain.MemberPointsRedeemProduct) *MemberPointsRuleUpdate {
+	mpru.mutation.AppendRedeemProducts(dprp)
+	return mpru
+}
+
+// ClearRedeemProducts clears the value of the "redeem_products" field.
+func (mpru *MemberPointsRuleUpdate) ClearRedeemProducts() *MemberPointsRuleUpdate {
+	mpru.mutation.ClearRedeemProducts()
+	return mpru
+}
+
+// Mutation returns the MemberPointsRuleMutation object of the builder.
+func (mpru *MemberPointsRuleUpdate) Mutation() *MemberPointsRuleMutation {
+	return mpru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mpru *MemberPointsRuleUpdate) Save(ctx context.Context) (int, error) {
+	mpru.defaults()
+	return withHooks(ctx, mpru.sqlSave, mpru.mutation, mpru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpru *MemberPointsRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := mpru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mpru *MemberPointsRuleUpdate) Exec(ctx context.Context) error {
+	_, err := mpru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpru *MemberPointsRuleUpdate) ExecX(ctx context.Context) {
+	if err := mpru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpru *MemberPointsRuleUpdate) defaults() {
+	if _, ok := mpru.mutation.UpdatedAt(); !ok {
+		v := memberpointsrule.UpdateDefaultUpdatedAt()
+		mpru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mpru *MemberPointsRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberPointsRuleUpdate {
+	mpru.modifiers = append(mpru.modifiers, modifiers...)
+	return mpru
+}
+
+func (mpru *MemberPointsRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(memberpointsrule.Table, memberpointsrule.Columns, sqlgraph.NewFieldSpec(memberpointsrule.FieldID, field.TypeUUID))
+	if ps := mpru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpru.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberpointsrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mpru.mutation.Enabled(); ok {
+		_spec.SetField(memberpointsrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := mpru.mutation.EarnRate(); ok {
+		_spec.SetField(memberpointsrule.FieldEarnRate, field.TypeOther, value)
+	}
+	if value, ok := mpru.mutation.CategoryRates(); ok {
+		_spec.SetField(memberpointsrule.FieldCategoryRates, field.TypeJSON, value)
+	}
+	if value, ok := mpru.mutation.AppendedCategoryRates(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, memberpointsrule.FieldCategoryRates, value)
+		})
+	}
+	if mpru.mutation.CategoryRatesCleared() {
+		_spec.ClearField(memberpointsrule.FieldCategoryRates, field.TypeJSON)
+	}
+	if value, ok := mpru.mutation.TierMultipliers(); ok {
+		_spec.SetField(memberpointsrule.FieldTierMultipliers, field.TypeJSON, value)
+	}
+	if value, ok := mpru.mutation.AppendedTierMultipliers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, memberpointsrule.FieldTierMultipliers, value)
+		})
+	}
+	if mpru.mutation.TierMultipliersCleared() {
+		_spec.ClearField(memberpointsrule.FieldTierMultipliers, field.TypeJSON)
+	}
+	if value, ok := mpru.mutation.ExpireDays(); ok {
+		_spec.SetField(memberpointsrule.FieldExpireDays, field.TypeInt, value)
+	}
+	if value, ok := mpru.mutation.AddedExpireDays(); ok {
+		_spec.AddField(memberpointsrule.FieldExpireDays, field.TypeInt, value)
+	}
+	if value, ok := mpru.mutation.RedeemEnabled(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemEnabled, field.TypeBool, value)
+	}
+	if value, ok := mpru.mutation.RedeemRate(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemRate, field.TypeInt64, value)
+	}
+	if value, ok := mpru.mutation.AddedRedeemRate(); ok {
+		_spec.AddField(memberpointsrule.FieldRedeemRate, field.TypeInt64, value)
+	}
+	if value, ok := mpru.mutation.RedeemMinPoints(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemMinPoints, field.TypeInt64, value)
+	}
+	if value, ok := mpru.mutation.AddedRedeemMinPoints(); ok {
+		_spec.AddField(memberpointsrule.FieldRedeemMinPoints, field.TypeInt64, value)
+	}
+	if value, ok := mpru.mutation.RedeemMaxPercent(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemMaxPercent, field.TypeOther, value)
+	}
+	if value, ok := mpru.mutation.RedeemProducts(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemProducts, field.TypeJSON, value)
+	}
+	if value, ok := mpru.mutation.AppendedRedeemProducts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, memberpointsrule.FieldRedeemProducts, value)
+		})
+	}
+	if mpru.mutation.RedeemProductsCleared() {
+		_spec.ClearField(memberpointsrule.FieldRedeemProducts, field.TypeJSON)
+	}
+	_spec.AddModifiers(mpru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mpru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memberpointsrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mpru.mutation.done = true
+	return n, nil
+}
+
+// MemberPointsRuleUpdateOne is the builder for updating a single MemberPointsRule entity.
+type MemberPointsRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MemberPointsRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mpruo *MemberPointsRuleUpdateOne) SetUpdatedAt(t time.Time) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.SetUpdatedAt(t)
+	return mpruo
+}
+
+// SetEnabled sets the "enabled" field.
+func (mpruo *MemberPointsRuleUpdateOne) SetEnabled(b bool) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.SetEnabled(b)
+	return mpruo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (mpruo *MemberPointsRuleUpdateOne) SetNillableEnabled(b *bool) *MemberPointsRuleUpdateOne {
+	if b != nil {
+		mpruo.SetEnabled(*b)
+	}
+	return mpruo
+}
+
+// SetEarnRate sets the "earn_rate" field.
+func (mpruo *MemberPointsRuleUpdateOne) SetEarnRate(d decimal.Decimal) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.SetEarnRate(d)
+	return mpruo
+}
+
+// SetNillableEarnRate sets the "earn_rate" field if the given value is not nil.
+func (mpruo *MemberPointsRuleUpdateOne) SetNillableEarnRate(d *decimal.Decimal) *MemberPointsRuleUpdateOne {
+	if d != nil {
+		mpruo.SetEarnRate(*d)
+	}
+	return mpruo
+}
+
+// SetCategoryRates sets the "category_rates" field.
+func (mpruo *MemberPointsRuleUpdateOne) SetCategoryRates(dpcr []domain.MemberPointsCategoryRate) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.SetCategoryRates(dpcr)
+	return mpruo
+}
+
+// AppendCategoryRates appends dpcr to the "category_rates" field.
+func (mpruo *MemberPointsRuleUpdateOne) AppendCategoryRates(dpcr []domain.MemberPointsCategoryRate) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.AppendCategoryRates(dpcr)
+	return mpruo
+}
+
+// ClearCategoryRates clears the value of the "category_rates" field.
+func (mpruo *MemberPointsRuleUpdateOne) ClearCategoryRates() *MemberPointsRuleUpdateOne {
+	mpruo.mutation.ClearCategoryRates()
+	return mpruo
+}
+
+// SetTierMultipliers sets the "tier_multipliers" field.
+func (mpruo *MemberPointsRuleUpdateOne) SetTierMultipliers(dptm []domain.MemberPointsTierMultiplier) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.SetTierMultipliers(dptm)
+	return mpruo
+}
+
+// AppendTierMultipliers appends dptm to the "tier_multipliers" field.
+func (mpruo *MemberPointsRuleUpdateOne) AppendTierMultipliers(dptm []domain.MemberPointsTierMultiplier) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.AppendTierMultipliers(dptm)
+	return mpruo
+}
+
+// ClearTierMultipliers clears the value of the "tier_multipliers" field.
+func (mpruo *MemberPointsRuleUpdateOne) ClearTierMultipliers() *MemberPointsRuleUpdateOne {
+	mpruo.mutation.ClearTierMultipliers()
+	return mpruo
+}
+
+// SetExpireDays sets the "expire_days" field.
+func (mpruo *MemberPointsRuleUpdateOne) SetExpireDays(i int) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.ResetExpireDays()
+	mpruo.mutation.SetExpireDays(i)
+	return mpruo
+}
+
+// SetNillableExpireDays sets the "expire_days" field if the given value is not nil.
+func (mpruo *MemberPointsRuleUpdateOne) SetNillableExpireDays(i *int) *MemberPointsRuleUpdateOne {
+	if i != nil {
+		mpruo.SetExpireDays(*i)
+	}
+	return mpruo
+}
+
+// AddExpireDays adds i to the "expire_days" field.
+func (mpruo *MemberPointsRuleUpdateOne) AddExpireDays(i int) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.AddExpireDays(i)
+	return mpruo
+}
+
+// SetRedeemEnabled sets the "redeem_enabled" field.
+func (mpruo *MemberPointsRuleUpdateOne) SetRedeemEnabled(b bool) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.SetRedeemEnabled(b)
+	return mpruo
+}
+
+// SetNillableRedeemEnabled sets the "redeem_enabled" field if the given value is not nil.
+func (mpruo *MemberPointsRuleUpdateOne) SetNillableRedeemEnabled(b *bool) *MemberPointsRuleUpdateOne {
+	if b != nil {
+		mpruo.SetRedeemEnabled(*b)
+	}
+	return mpruo
+}
+
+// SetRedeemRate sets the "redeem_rate" field.
+func (mpruo *MemberPointsRuleUpdateOne) SetRedeemRate(i int64) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.ResetRedeemRate()
+	mpruo.mutation.SetRedeemRate(i)
+	return mpruo
+}
+
+// SetNillableRedeemRate sets the "redeem_rate" field if the given value is not nil.
+func (mpruo *MemberPointsRuleUpdateOne) SetNillableRedeemRate(i *int64) *MemberPointsRuleUpdateOne {
+	if i != nil {
+		mpruo.SetRedeemRate(*i)
+	}
+	return mpruo
+}
+
+// AddRedeemRate adds i to the "redeem_rate" field.
+func (mpruo *MemberPointsRuleUpdateOne) AddRedeemRate(i int64) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.AddRedeemRate(i)
+	return mpruo
+}
+
+// SetRedeemMinPoints sets the "redeem_min_points" field.
+func (mpruo *MemberPointsRuleUpdateOne) SetRedeemMinPoints(i int64) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.ResetRedeemMinPoints()
+	mpruo.mutation.SetRedeemMinPoints(i)
+	return mpruo
+}
+
+// SetNillableRedeemMinPoints sets the "redeem_min_points" field if the given value is not nil.
+func (mpruo *MemberPointsRuleUpdateOne) SetNillableRedeemMinPoints(i *int64) *MemberPointsRuleUpdateOne {
+	if i != nil {
+		mpruo.SetRedeemMinPoints(*i)
+	}
+	return mpruo
+}
+
+// AddRedeemMinPoints adds i to the "redeem_min_points" field.
+func (mpruo *MemberPointsRuleUpdateOne) AddRedeemMinPoints(i int64) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.AddRedeemMinPoints(i)
+	return mpruo
+}
+
+// SetRedeemMaxPercent sets the "redeem_max_percent" field.
+func (mpruo *MemberPointsRuleUpdateOne) SetRedeemMaxPercent(d decimal.Decimal) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.SetRedeemMaxPercent(d)
+	return mpruo
+}
+
+// SetNillableRedeemMaxPercent sets the "redeem_max_percent" field if the given value is not nil.
+func (mpruo *MemberPointsRuleUpdateOne) SetNillableRedeemMaxPercent(d *decimal.Decimal) *MemberPointsRuleUpdateOne {
+	if d != nil {
+		mpruo.SetRedeemMaxPercent(*d)
+	}
+	return mpruo
+}
+
+// SetRedeemProducts sets the "redeem_products" field.
+func (mpruo *MemberPointsRuleUpdateOne) SetRedeemProducts(dprp []domain.MemberPointsRedeemProduct) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.SetRedeemProducts(dprp)
+	return mpruo
+}
+
+// AppendRedeemProducts appends dprp to the "redeem_products" field.
+func (mpruo *MemberPointsRuleUpdateOne) AppendRedeemProducts(dprp []domain.MemberPointsRedeemProduct) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.AppendRedeemProducts(dprp)
+	return mpruo
+}
+
+// ClearRedeemProducts clears the value of the "redeem_products" field.
+func (mpruo *MemberPointsRuleUpdateOne) ClearRedeemProducts() *MemberPointsRuleUpdateOne {
+	mpruo.mutation.ClearRedeemProducts()
+	return mpruo
+}
+
+// Mutation returns the MemberPointsRuleMutation object of the builder.
+func (mpruo *MemberPointsRuleUpdateOne) Mutation() *MemberPointsRuleMutation {
+	return mpruo.mutation
+}
+
+// Where appends a list predicates to the MemberPointsRuleUpdate builder.
+func (mpruo *MemberPointsRuleUpdateOne) Where(ps ...predicate.MemberPointsRule) *MemberPointsRuleUpdateOne {
+	mpruo.mutation.Where(ps...)
+	return mpruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mpruo *MemberPointsRuleUpdateOne) Select(field string, fields ...string) *MemberPointsRuleUpdateOne {
+	mpruo.fields = append([]string{field}, fields...)
+	return mpruo
+}
+
+// Save executes the query and returns the updated MemberPointsRule entity.
+func (mpruo *MemberPointsRuleUpdateOne) Save(ctx context.Context) (*MemberPointsRule, error) {
+	mpruo.defaults()
+	return withHooks(ctx, mpruo.sqlSave, mpruo.mutation, mpruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpruo *MemberPointsRuleUpdateOne) SaveX(ctx context.Context) *MemberPointsRule {
+	node, err := mpruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mpruo *MemberPointsRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := mpruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpruo *MemberPointsRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := mpruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpruo *MemberPointsRuleUpdateOne) defaults() {
+	if _, ok := mpruo.mutation.UpdatedAt(); !ok {
+		v := memberpointsrule.UpdateDefaultUpdatedAt()
+		mpruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mpruo *MemberPointsRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberPointsRuleUpdateOne {
+	mpruo.modifiers = append(mpruo.modifiers, modifiers...)
+	return mpruo
+}
+
+func (mpruo *MemberPointsRuleUpdateOne) sqlSave(ctx context.Context) (_node *MemberPointsRule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(memberpointsrule.Table, memberpointsrule.Columns, sqlgraph.NewFieldSpec(memberpointsrule.FieldID, field.TypeUUID))
+	id, ok := mpruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MemberPointsRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mpruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, memberpointsrule.FieldID)
+		for _, f := range fields {
+			if !memberpointsrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != memberpointsrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mpruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberpointsrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mpruo.mutation.Enabled(); ok {
+		_spec.SetField(memberpointsrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := mpruo.mutation.EarnRate(); ok {
+		_spec.SetField(memberpointsrule.FieldEarnRate, field.TypeOther, value)
+	}
+	if value, ok := mpruo.mutation.CategoryRates(); ok {
+		_spec.SetField(memberpointsrule.FieldCategoryRates, field.TypeJSON, value)
+	}
+	if value, ok := mpruo.mutation.AppendedCategoryRates(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, memberpointsrule.FieldCategoryRates, value)
+		})
+	}
+	if mpruo.mutation.CategoryRatesCleared() {
+		_spec.ClearField(memberpointsrule.FieldCategoryRates, field.TypeJSON)
+	}
+	if value, ok := mpruo.mutation.TierMultipliers(); ok {
+		_spec.SetField(memberpointsrule.FieldTierMultipliers, field.TypeJSON, value)
+	}
+	if value, ok := mpruo.mutation.AppendedTierMultipliers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, memberpointsrule.FieldTierMultipliers, value)
+		})
+	}
+	if mpruo.mutation.TierMultipliersCleared() {
+		_spec.ClearField(memberpointsrule.FieldTierMultipliers, field.TypeJSON)
+	}
+	if value, ok := mpruo.mutation.ExpireDays(); ok {
+		_spec.SetField(memberpointsrule.FieldExpireDays, field.TypeInt, value)
+	}
+	if value, ok := mpruo.mutation.AddedExpireDays(); ok {
+		_spec.AddField(memberpointsrule.FieldExpireDays, field.TypeInt, value)
+	}
+	if value, ok := mpruo.mutation.RedeemEnabled(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemEnabled, field.TypeBool, value)
+	}
+	if value, ok := mpruo.mutation.RedeemRate(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemRate, field.TypeInt64, value)
+	}
+	if value, ok := mpruo.mutation.AddedRedeemRate(); ok {
+		_spec.AddField(memberpointsrule.FieldRedeemRate, field.TypeInt64, value)
+	}
+	if value, ok := mpruo.mutation.RedeemMinPoints(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemMinPoints, field.TypeInt64, value)
+	}
+	if value, ok := mpruo.mutation.AddedRedeemMinPoints(); ok {
+		_spec.AddField(memberpointsrule.FieldRedeemMinPoints, field.TypeInt64, value)
+	}
+	if value, ok := mpruo.mutation.RedeemMaxPercent(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemMaxPercent, field.TypeOther, value)
+	}
+	if value, ok := mpruo.mutation.RedeemProducts(); ok {
+		_spec.SetField(memberpointsrule.FieldRedeemProducts, field.TypeJSON, value)
+	}
+	if value, ok := mpruo.mutation.AppendedRedeemProducts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, memberpointsrule.FieldRedeemProducts, value)
+		})
+	}
+	if mpruo.mutation.RedeemProductsCleared() {
+		_spec.ClearField(memberpointsrule.FieldRedeemProducts, field.TypeJSON)
+	}
+	_spec.AddModifiers(mpruo.modifiers...)
+	_node = &MemberPointsRule{config: mpruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mpruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memberpointsrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mpruo.mutation.done = true
+	return _node, nil
+}
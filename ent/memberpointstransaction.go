@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointstransaction"
+)
+
+// MemberPointsTransaction is the model entity for the MemberPointsTransaction schema.
+type MemberPointsTransaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 发生门店ID（品牌后台调整和过期为空）
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 会员ID
+	MemberID uuid.UUID `json:"member_id,omitempty"`
+	// 账户ID
+	AccountID uuid.UUID `json:"account_id,omitempty"`
+	// 流水类型：earn（消费获得）、redeem（使用）、reverse（退款扣回）、restore（退款退回）、expire（过期）、adjustment（人工调整）
+	Type domain.MemberPointsTransactionType `json:"type,omitempty"`
+	// 积分变动（增加为正，减少为负）
+	Points int64 `json:"points,omitempty"`
+	// 变动后积分余额
+	BalanceAfter int64 `json:"balance_after,omitempty"`
+	// 入账积分剩余未使用部分
+	Remaining int64 `json:"remaining,omitempty"`
+	// 入账积分过期时间（为空表示永久有效）
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// 获得积分的消费金额或积分抵扣金额
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// 关联订单ID
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// 关联订单号
+	OrderNo string `json:"order_no,omitempty"`
+	// 关联退款单ID
+	RefundOrderID uuid.UUID `json:"refund_order_id,omitempty"`
+	// 兑换商品ID
+	ProductID uuid.UUID `json:"product_id,omitempty"`
+	// 操作人ID
+	OperatorID uuid.UUID `json:"operator_id,omitempty"`
+	// 操作人名称
+	OperatorName string `json:"operator_name,omitempty"`
+	// 备注
+	Remark       string `json:"remark,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MemberPointsTransaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case memberpointstransaction.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case memberpointstransaction.FieldPoints, memberpointstransaction.FieldBalanceAfter, memberpointstransaction.FieldRemaining:
+			values[i] = new(sql.NullInt64)
+		case memberpointstransaction.FieldType, memberpointstransaction.FieldOrderNo, memberpointstransaction.FieldOperatorName, memberpointstransaction.FieldRemark:
+			values[i] = new(sql.NullString)
+		case memberpointstransaction.FieldCreatedAt, memberpointstransaction.FieldUpdatedAt, memberpointstransaction.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case memberpointstransaction.FieldID, memberpointstransaction.FieldMerchantID, memberpointstransaction.FieldStoreID, memberpointstransaction.FieldMemberID, memberpointstransaction.FieldAccountID, memberpointstransaction.FieldOrderID, memberpointstransaction.FieldRefundOrderID, memberpointstransaction.FieldProductID, memberpointstransaction.FieldOperatorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MemberPointsTransaction fields.
+func (mpt *MemberPointsTransaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case memberpointstransaction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mpt.ID = *value
+			}
+		case memberpointstransaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mpt.CreatedAt = value.Time
+			}
+		case memberpointstransaction.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mpt.UpdatedAt = value.Time
+			}
+		case memberpointstransaction.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				mpt.MerchantID = *value
+			}
+		case memberpointstransaction.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				mpt.StoreID = *value
+			}
+		case memberpointstransaction.FieldMemberID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field member_id", values[i])
+			} else if value != nil {
+				mpt.MemberID = *value
+			}
+		case memberpointstransaction.FieldAccountID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				mpt.AccountID = *value
+			}
+		case memberpointstransaction.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				mpt.Type = domain.MemberPointsTransactionType(value.String)
+			}
+		case memberpointstransaction.FieldPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field points", values[i])
+			} else if value.Valid {
+				mpt.Points = value.Int64
+			}
+		case memberpointstransaction.FieldBalanceAfter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_after", values[i])
+			} else if value.Valid {
+				mpt.BalanceAfter = value.Int64
+			}
+		case memberpointstransaction.FieldRemaining:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field remaining", values[i])
+			} else if value.Valid {
+				mpt.Remaining = value.Int64
+			}
+		case memberpointstransaction.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				mpt.ExpiresAt = new(time.Time)
+				*mpt.ExpiresAt = value.Time
+			}
+		case memberpointstransaction.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				mpt.Amount = *value
+			}
+		case memberpointstransaction.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				mpt.OrderID = *value
+			}
+		case memberpointstransaction.FieldOrderNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_no", values[i])
+			} else if value.Valid {
+				mpt.OrderNo = value.String
+			}
+		case memberpointstransaction.FieldRefundOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_order_id", values[i])
+			} else if value != nil {
+				mpt.RefundOrderID = *value
+			}
+		case memberpointstransaction.FieldProductID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value != nil {
+				mpt.ProductID = *value
+			}
+		case memberpointstransaction.FieldOperatorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value != nil {
+				mpt.OperatorID = *value
+			}
+		case memberpointstransaction.FieldOperatorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_name", values[i])
+			} else if value.Valid {
+				mpt.OperatorName = value.String
+			}
+		case memberpointstransaction.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				mpt.Remark = value.String
+			}
+		default:
+			mpt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MemberPointsTransaction.
+// This includes values selected through modifiers, order, etc.
+func (mpt *MemberPointsTransaction) Value(name string) (ent.Value, error) {
+	return mpt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MemberPointsTransaction.
+// Note that you need to call MemberPointsTransaction.Unwrap() before calling this method if this MemberPointsTransaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mpt *MemberPointsTransaction) Update() *MemberPointsTransactionUpdateOne {
+	return NewMemberPointsTransactionClient(mpt.config).UpdateOne(mpt)
+}
+
+// Unwrap unwraps the MemberPointsTransaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mpt *MemberPointsTransaction) Unwrap() *MemberPointsTransaction {
+	_tx, ok := mpt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MemberPointsTransaction is not a transactional entity")
+	}
+	mpt.config.driver = _tx.drv
+	return mpt
+}
+
+// String implements the fmt.Stringer.
+func (mpt *MemberPointsTransaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("MemberPointsTransaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mpt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(mpt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mpt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("member_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.MemberID))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.Type))
+	builder.WriteString(", ")
+	builder.WriteString("points=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.Points))
+	builder.WriteString(", ")
+	builder.WriteString("balance_after=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.BalanceAfter))
+	builder.WriteString(", ")
+	builder.WriteString("remaining=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.Remaining))
+	builder.WriteString(", ")
+	if v := mpt.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("order_no=")
+	builder.WriteString(mpt.OrderNo)
+	builder.WriteString(", ")
+	builder.WriteString("refund_order_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.RefundOrderID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("operator_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpt.OperatorID))
+	builder.WriteString(", ")
+	builder.WriteString("operator_name=")
+	builder.WriteString(mpt.OperatorName)
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(mpt.Remark)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MemberPointsTransactions is a parsable slice of MemberPointsTransaction.
+type MemberPointsTransactions []*MemberPointsTransaction
@@ -0,0 +1,998 @@
+// Code generated by ent, DO NOT EDIT.
+
+package memberpointstransaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldStoreID, v))
+}
+
+// MemberID applies equality check predicate on the "member_id" field. It's identical to MemberIDEQ.
+func MemberID(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldMemberID, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Points applies equality check predicate on the "points" field. It's identical to PointsEQ.
+func Points(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldPoints, v))
+}
+
+// BalanceAfter applies equality check predicate on the "balance_after" field. It's identical to BalanceAfterEQ.
+func BalanceAfter(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// Remaining applies equality check predicate on the "remaining" field. It's identical to RemainingEQ.
+func Remaining(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldRemaining, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderNo applies equality check predicate on the "order_no" field. It's identical to OrderNoEQ.
+func OrderNo(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// RefundOrderID applies equality check predicate on the "refund_order_id" field. It's identical to RefundOrderIDEQ.
+func RefundOrderID(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldRefundOrderID, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldProductID, v))
+}
+
+// OperatorID applies equality check predicate on the "operator_id" field. It's identical to OperatorIDEQ.
+func OperatorID(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorName applies equality check predicate on the "operator_name" field. It's identical to OperatorNameEQ.
+func OperatorName(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldOperatorName, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldRemark, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldStoreID, v))
+}
+
+// MemberIDEQ applies the EQ predicate on the "member_id" field.
+func MemberIDEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldMemberID, v))
+}
+
+// MemberIDNEQ applies the NEQ predicate on the "member_id" field.
+func MemberIDNEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldMemberID, v))
+}
+
+// MemberIDIn applies the In predicate on the "member_id" field.
+func MemberIDIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldMemberID, vs...))
+}
+
+// MemberIDNotIn applies the NotIn predicate on the "member_id" field.
+func MemberIDNotIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldMemberID, vs...))
+}
+
+// MemberIDGT applies the GT predicate on the "member_id" field.
+func MemberIDGT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldMemberID, v))
+}
+
+// MemberIDGTE applies the GTE predicate on the "member_id" field.
+func MemberIDGTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldMemberID, v))
+}
+
+// MemberIDLT applies the LT predicate on the "member_id" field.
+func MemberIDLT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldMemberID, v))
+}
+
+// MemberIDLTE applies the LTE predicate on the "member_id" field.
+func MemberIDLTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldMemberID, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldAccountID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v domain.MemberPointsTransactionType) predicate.MemberPointsTransaction {
+	vc := v
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldType, vc))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v domain.MemberPointsTransactionType) predicate.MemberPointsTransaction {
+	vc := v
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldType, vc))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...domain.MemberPointsTransactionType) predicate.MemberPointsTransaction {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldType, v...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...domain.MemberPointsTransactionType) predicate.MemberPointsTransaction {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldType, v...))
+}
+
+// PointsEQ applies the EQ predicate on the "points" field.
+func PointsEQ(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldPoints, v))
+}
+
+// PointsNEQ applies the NEQ predicate on the "points" field.
+func PointsNEQ(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldPoints, v))
+}
+
+// PointsIn applies the In predicate on the "points" field.
+func PointsIn(vs ...int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldPoints, vs...))
+}
+
+// PointsNotIn applies the NotIn predicate on the "points" field.
+func PointsNotIn(vs ...int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldPoints, vs...))
+}
+
+// PointsGT applies the GT predicate on the "points" field.
+func PointsGT(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldPoints, v))
+}
+
+// PointsGTE applies the GTE predicate on the "points" field.
+func PointsGTE(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldPoints, v))
+}
+
+// PointsLT applies the LT predicate on the "points" field.
+func PointsLT(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldPoints, v))
+}
+
+// PointsLTE applies the LTE predicate on the "points" field.
+func PointsLTE(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldPoints, v))
+}
+
+// BalanceAfterEQ applies the EQ predicate on the "balance_after" field.
+func BalanceAfterEQ(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterNEQ applies the NEQ predicate on the "balance_after" field.
+func BalanceAfterNEQ(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterIn applies the In predicate on the "balance_after" field.
+func BalanceAfterIn(vs ...int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterNotIn applies the NotIn predicate on the "balance_after" field.
+func BalanceAfterNotIn(vs ...int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterGT applies the GT predicate on the "balance_after" field.
+func BalanceAfterGT(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterGTE applies the GTE predicate on the "balance_after" field.
+func BalanceAfterGTE(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLT applies the LT predicate on the "balance_after" field.
+func BalanceAfterLT(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLTE applies the LTE predicate on the "balance_after" field.
+func BalanceAfterLTE(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldBalanceAfter, v))
+}
+
+// RemainingEQ applies the EQ predicate on the "remaining" field.
+func RemainingEQ(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldRemaining, v))
+}
+
+// RemainingNEQ applies the NEQ predicate on the "remaining" field.
+func RemainingNEQ(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldRemaining, v))
+}
+
+// RemainingIn applies the In predicate on the "remaining" field.
+func RemainingIn(vs ...int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldRemaining, vs...))
+}
+
+// RemainingNotIn applies the NotIn predicate on the "remaining" field.
+func RemainingNotIn(vs ...int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldRemaining, vs...))
+}
+
+// RemainingGT applies the GT predicate on the "remaining" field.
+func RemainingGT(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldRemaining, v))
+}
+
+// RemainingGTE applies the GTE predicate on the "remaining" field.
+func RemainingGTE(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldRemaining, v))
+}
+
+// RemainingLT applies the LT predicate on the "remaining" field.
+func RemainingLT(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldRemaining, v))
+}
+
+// RemainingLTE applies the LTE predicate on the "remaining" field.
+func RemainingLTE(v int64) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldRemaining, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldAmount, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderNoEQ applies the EQ predicate on the "order_no" field.
+func OrderNoEQ(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// OrderNoNEQ applies the NEQ predicate on the "order_no" field.
+func OrderNoNEQ(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldOrderNo, v))
+}
+
+// OrderNoIn applies the In predicate on the "order_no" field.
+func OrderNoIn(vs ...string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldOrderNo, vs...))
+}
+
+// OrderNoNotIn applies the NotIn predicate on the "order_no" field.
+func OrderNoNotIn(vs ...string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldOrderNo, vs...))
+}
+
+// OrderNoGT applies the GT predicate on the "order_no" field.
+func OrderNoGT(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldOrderNo, v))
+}
+
+// OrderNoGTE applies the GTE predicate on the "order_no" field.
+func OrderNoGTE(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldOrderNo, v))
+}
+
+// OrderNoLT applies the LT predicate on the "order_no" field.
+func OrderNoLT(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldOrderNo, v))
+}
+
+// OrderNoLTE applies the LTE predicate on the "order_no" field.
+func OrderNoLTE(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldOrderNo, v))
+}
+
+// OrderNoContains applies the Contains predicate on the "order_no" field.
+func OrderNoContains(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldContains(FieldOrderNo, v))
+}
+
+// OrderNoHasPrefix applies the HasPrefix predicate on the "order_no" field.
+func OrderNoHasPrefix(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldHasPrefix(FieldOrderNo, v))
+}
+
+// OrderNoHasSuffix applies the HasSuffix predicate on the "order_no" field.
+func OrderNoHasSuffix(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldHasSuffix(FieldOrderNo, v))
+}
+
+// OrderNoEqualFold applies the EqualFold predicate on the "order_no" field.
+func OrderNoEqualFold(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEqualFold(FieldOrderNo, v))
+}
+
+// OrderNoContainsFold applies the ContainsFold predicate on the "order_no" field.
+func OrderNoContainsFold(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldContainsFold(FieldOrderNo, v))
+}
+
+// RefundOrderIDEQ applies the EQ predicate on the "refund_order_id" field.
+func RefundOrderIDEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldRefundOrderID, v))
+}
+
+// RefundOrderIDNEQ applies the NEQ predicate on the "refund_order_id" field.
+func RefundOrderIDNEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldRefundOrderID, v))
+}
+
+// RefundOrderIDIn applies the In predicate on the "refund_order_id" field.
+func RefundOrderIDIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldRefundOrderID, vs...))
+}
+
+// RefundOrderIDNotIn applies the NotIn predicate on the "refund_order_id" field.
+func RefundOrderIDNotIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldRefundOrderID, vs...))
+}
+
+// RefundOrderIDGT applies the GT predicate on the "refund_order_id" field.
+func RefundOrderIDGT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldRefundOrderID, v))
+}
+
+// RefundOrderIDGTE applies the GTE predicate on the "refund_order_id" field.
+func RefundOrderIDGTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldRefundOrderID, v))
+}
+
+// RefundOrderIDLT applies the LT predicate on the "refund_order_id" field.
+func RefundOrderIDLT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldRefundOrderID, v))
+}
+
+// RefundOrderIDLTE applies the LTE predicate on the "refund_order_id" field.
+func RefundOrderIDLTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldRefundOrderID, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldProductID, v))
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldProductID, v))
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldProductID, v))
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldProductID, v))
+}
+
+// OperatorIDEQ applies the EQ predicate on the "operator_id" field.
+func OperatorIDEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorIDNEQ applies the NEQ predicate on the "operator_id" field.
+func OperatorIDNEQ(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldOperatorID, v))
+}
+
+// OperatorIDIn applies the In predicate on the "operator_id" field.
+func OperatorIDIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDNotIn applies the NotIn predicate on the "operator_id" field.
+func OperatorIDNotIn(vs ...uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDGT applies the GT predicate on the "operator_id" field.
+func OperatorIDGT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldOperatorID, v))
+}
+
+// OperatorIDGTE applies the GTE predicate on the "operator_id" field.
+func OperatorIDGTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldOperatorID, v))
+}
+
+// OperatorIDLT applies the LT predicate on the "operator_id" field.
+func OperatorIDLT(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldOperatorID, v))
+}
+
+// OperatorIDLTE applies the LTE predicate on the "operator_id" field.
+func OperatorIDLTE(v uuid.UUID) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldOperatorID, v))
+}
+
+// OperatorNameEQ applies the EQ predicate on the "operator_name" field.
+func OperatorNameEQ(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldOperatorName, v))
+}
+
+// OperatorNameNEQ applies the NEQ predicate on the "operator_name" field.
+func OperatorNameNEQ(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldOperatorName, v))
+}
+
+// OperatorNameIn applies the In predicate on the "operator_name" field.
+func OperatorNameIn(vs ...string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldOperatorName, vs...))
+}
+
+// OperatorNameNotIn applies the NotIn predicate on the "operator_name" field.
+func OperatorNameNotIn(vs ...string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldOperatorName, vs...))
+}
+
+// OperatorNameGT applies the GT predicate on the "operator_name" field.
+func OperatorNameGT(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldOperatorName, v))
+}
+
+// OperatorNameGTE applies the GTE predicate on the "operator_name" field.
+func OperatorNameGTE(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldOperatorName, v))
+}
+
+// OperatorNameLT applies the LT predicate on the "operator_name" field.
+func OperatorNameLT(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldOperatorName, v))
+}
+
+// OperatorNameLTE applies the LTE predicate on the "operator_name" field.
+func OperatorNameLTE(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldOperatorName, v))
+}
+
+// OperatorNameContains applies the Contains predicate on the "operator_name" field.
+func OperatorNameContains(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldContains(FieldOperatorName, v))
+}
+
+// OperatorNameHasPrefix applies the HasPrefix predicate on the "operator_name" field.
+func OperatorNameHasPrefix(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldHasPrefix(FieldOperatorName, v))
+}
+
+// OperatorNameHasSuffix applies the HasSuffix predicate on the "operator_name" field.
+func OperatorNameHasSuffix(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldHasSuffix(FieldOperatorName, v))
+}
+
+// OperatorNameEqualFold applies the EqualFold predicate on the "operator_name" field.
+func OperatorNameEqualFold(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEqualFold(FieldOperatorName, v))
+}
+
+// OperatorNameContainsFold applies the ContainsFold predicate on the "operator_name" field.
+func OperatorNameContainsFold(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldContainsFold(FieldOperatorName, v))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MemberPointsTransaction) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MemberPointsTransaction) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MemberPointsTransaction) predicate.MemberPointsTransaction {
+	return predicate.MemberPointsTransaction(sql.NotPredicates(p))
+}
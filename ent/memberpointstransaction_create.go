@@ -0,0 +1,1064 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointstransaction"
+)
+
+// MemberPointsTransactionCreate is the builder for creating a MemberPointsTransaction entity.
+type MemberPointsTransactionCreate struct {
+	config
+	mutation *MemberPointsTransactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mptc *MemberPointsTransactionCreate) SetCreatedAt(t time.Time) *MemberPointsTransactionCreate {
+	mptc.mutation.SetCreatedAt(t)
+	return mptc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableCreatedAt(t *time.Time) *MemberPointsTransactionCreate {
+	if t != nil {
+		mptc.SetCreatedAt(*t)
+	}
+	return mptc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mptc *MemberPointsTransactionCreate) SetUpdatedAt(t time.Time) *MemberPointsTransactionCreate {
+	mptc.mutation.SetUpdatedAt(t)
+	return mptc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableUpdatedAt(t *time.Time) *MemberPointsTransactionCreate {
+	if t != nil {
+		mptc.SetUpdatedAt(*t)
+	}
+	return mptc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (mptc *MemberPointsTransactionCreate) SetMerchantID(u uuid.UUID) *MemberPointsTransactionCreate {
+	mptc.mutation.SetMerchantID(u)
+	return mptc
+}
+
+// SetStoreID sets the "store_id" field.
+func (mptc *MemberPointsTransactionCreate) SetStoreID(u uuid.UUID) *MemberPointsTransactionCreate {
+	mptc.mutation.SetStoreID(u)
+	return mptc
+}
+
+// SetNillableStoreID sets the "store_id" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableStoreID(u *uuid.UUID) *MemberPointsTransactionCreate {
+	if u != nil {
+		mptc.SetStoreID(*u)
+	}
+	return mptc
+}
+
+// SetMemberID sets the "member_id" field.
+func (mptc *MemberPointsTransactionCreate) SetMemberID(u uuid.UUID) *MemberPointsTransactionCreate {
+	mptc.mutation.SetMemberID(u)
+	return mptc
+}
+
+// SetAccountID sets the "account_id" field.
+func (mptc *MemberPointsTransactionCreate) SetAccountID(u uuid.UUID) *MemberPointsTransactionCreate {
+	mptc.mutation.SetAccountID(u)
+	return mptc
+}
+
+// SetType sets the "type" field.
+func (mptc *MemberPointsTransactionCreate) SetType(dptt domain.MemberPointsTransactionType) *MemberPointsTransactionCreate {
+	mptc.mutation.SetType(dptt)
+	return mptc
+}
+
+// SetPoints sets the "points" field.
+func (mptc *MemberPointsTransactionCreate) SetPoints(i int64) *MemberPointsTransactionCreate {
+	mptc.mutation.SetPoints(i)
+	return mptc
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (mptc *MemberPointsTransactionCreate) SetBalanceAfter(i int64) *MemberPointsTransactionCreate {
+	mptc.mutation.SetBalanceAfter(i)
+	return mptc
+}
+
+// SetRemaining sets the "remaining" field.
+func (mptc *MemberPointsTransactionCreate) SetRemaining(i int64) *MemberPointsTransactionCreate {
+	mptc.mutation.SetRemaining(i)
+	return mptc
+}
+
+// SetNillableRemaining sets the "remaining" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableRemaining(i *int64) *MemberPointsTransactionCreate {
+	if i != nil {
+		mptc.SetRemaining(*i)
+	}
+	return mptc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (mptc *MemberPointsTransactionCreate) SetExpiresAt(t time.Time) *MemberPointsTransactionCreate {
+	mptc.mutation.SetExpiresAt(t)
+	return mptc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableExpiresAt(t *time.Time) *MemberPointsTransactionCreate {
+	if t != nil {
+		mptc.SetExpiresAt(*t)
+	}
+	return mptc
+}
+
+// SetAmount sets the "amount" field.
+func (mptc *MemberPointsTransactionCreate) SetAmount(d decimal.Decimal) *MemberPointsTransactionCreate {
+	mptc.mutation.SetAmount(d)
+	return mptc
+}
+
+// SetOrderID sets the "order_id" field.
+func (mptc *MemberPointsTransactionCreate) SetOrderID(u uuid.UUID) *MemberPointsTransactionCreate {
+	mptc.mutation.SetOrderID(u)
+	return mptc
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableOrderID(u *uuid.UUID) *MemberPointsTransactionCreate {
+	if u != nil {
+		mptc.SetOrderID(*u)
+	}
+	return mptc
+}
+
+// SetOrderNo sets the "order_no" field.
+func (mptc *MemberPointsTransactionCreate) SetOrderNo(s string) *MemberPointsTransactionCreate {
+	mptc.mutation.SetOrderNo(s)
+	return mptc
+}
+
+// SetNillableOrderNo sets the "order_no" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableOrderNo(s *string) *MemberPointsTransactionCreate {
+	if s != nil {
+		mptc.SetOrderNo(*s)
+	}
+	return mptc
+}
+
+// SetRefundOrderID sets the "refund_order_id" field.
+func (mptc *MemberPointsTransactionCreate) SetRefundOrderID(u uuid.UUID) *MemberPointsTransactionCreate {
+	mptc.mutation.SetRefundOrderID(u)
+	return mptc
+}
+
+// SetNillableRefundOrderID sets the "refund_order_id" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableRefundOrderID(u *uuid.UUID) *MemberPointsTransactionCreate {
+	if u != nil {
+		mptc.SetRefundOrderID(*u)
+	}
+	return mptc
+}
+
+// SetProductID sets the "product_id" field.
+func (mptc *MemberPointsTransactionCreate) SetProductID(u uuid.UUID) *MemberPointsTransactionCreate {
+	mptc.mutation.SetProductID(u)
+	return mptc
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableProductID(u *uuid.UUID) *MemberPointsTransactionCreate {
+	if u != nil {
+		mptc.SetProductID(*u)
+	}
+	return mptc
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (mptc *MemberPointsTransactionCreate) SetOperatorID(u uuid.UUID) *MemberPointsTransactionCreate {
+	mptc.mutation.SetOperatorID(u)
+	return mptc
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableOperatorID(u *uuid.UUID) *MemberPointsTransactionCreate {
+	if u != nil {
+		mptc.SetOperatorID(*u)
+	}
+	return mptc
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (mptc *MemberPointsTransactionCreate) SetOperatorName(s string) *MemberPointsTransactionCreate {
+	mptc.mutation.SetOperatorName(s)
+	return mptc
+}
+
+// SetNillableOperatorName sets the "operator_name" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableOperatorName(s *string) *MemberPointsTransactionCreate {
+	if s != nil {
+		mptc.SetOperatorName(*s)
+	}
+	return mptc
+}
+
+// SetRemark sets the "remark" field.
+func (mptc *MemberPointsTransactionCreate) SetRemark(s string) *MemberPointsTransactionCreate {
+	mptc.mutation.SetRemark(s)
+	return mptc
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableRemark(s *string) *MemberPointsTransactionCreate {
+	if s != nil {
+		mptc.SetRemark(*s)
+	}
+	return mptc
+}
+
+// SetID sets the "id" field.
+func (mptc *MemberPointsTransactionCreate) SetID(u uuid.UUID) *MemberPointsTransactionCreate {
+	mptc.mutation.SetID(u)
+	return mptc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mptc *MemberPointsTransactionCreate) SetNillableID(u *uuid.UUID) *MemberPointsTransactionCreate {
+	if u != nil {
+		mptc.SetID(*u)
+	}
+	return mptc
+}
+
+// Mutation returns the MemberPointsTransactionMutation object of the builder.
+func (mptc *MemberPointsTransactionCreate) Mutation() *MemberPointsTransactionMutation {
+	return mptc.mutation
+}
+
+// Save creates the MemberPointsTransaction in the database.
+func (mptc *MemberPointsTransactionCreate) Save(ctx context.Context) (*MemberPointsTransaction, error) {
+	mptc.defaults()
+	return withHooks(ctx, mptc.sqlSave, mptc.mutation, mptc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mptc *MemberPointsTransactionCreate) SaveX(ctx context.Context) *MemberPointsTransaction {
+	v, err := mptc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mptc *MemberPointsTransactionCreate) Exec(ctx context.Context) error {
+	_, err := mptc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mptc *MemberPointsTransactionCreate) ExecX(ctx context.Context) {
+	if err := mptc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mptc *MemberPointsTransactionCreate) defaults() {
+	if _, ok := mptc.mutation.CreatedAt(); !ok {
+		v := memberpointstransaction.DefaultCreatedAt()
+		mptc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mptc.mutation.UpdatedAt(); !ok {
+		v := memberpointstransaction.DefaultUpdatedAt()
+		mptc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mptc.mutation.StoreID(); !ok {
+		v := memberpointstransaction.DefaultStoreID()
+		mptc.mutation.SetStoreID(v)
+	}
+	if _, ok := mptc.mutation.Remaining(); !ok {
+		v := memberpointstransaction.DefaultRemaining
+		mptc.mutation.SetRemaining(v)
+	}
+	if _, ok := mptc.mutation.OrderID(); !ok {
+		v := memberpointstransaction.DefaultOrderID()
+		mptc.mutation.SetOrderID(v)
+	}
+	if _, ok := mptc.mutation.OrderNo(); !ok {
+		v := memberpointstransaction.DefaultOrderNo
+		mptc.mutation.SetOrderNo(v)
+	}
+	if _, ok := mptc.mutation.RefundOrderID(); !ok {
+		v := memberpointstransaction.DefaultRefundOrderID()
+		mptc.mutation.SetRefundOrderID(v)
+	}
+	if _, ok := mptc.mutation.ProductID(); !ok {
+		v := memberpointstransaction.DefaultProductID()
+		mptc.mutation.SetProductID(v)
+	}
+	if _, ok := mptc.mutation.OperatorID(); !ok {
+		v := memberpointstransaction.DefaultOperatorID()
+		mptc.mutation.SetOperatorID(v)
+	}
+	if _, ok := mptc.mutation.OperatorName(); !ok {
+		v := memberpointstransaction.DefaultOperatorName
+		mptc.mutation.SetOperatorName(v)
+	}
+	if _, ok := mptc.mutation.Remark(); !ok {
+		v := memberpointstransaction.DefaultRemark
+		mptc.mutation.SetRemark(v)
+	}
+	if _, ok := mptc.mutation.ID(); !ok {
+		v := memberpointstransaction.DefaultID()
+		mptc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mptc *MemberPointsTransactionCreate) check() error {
+	if _, ok := mptc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MemberPointsTransaction.created_at"`)}
+	}
+	if _, ok := mptc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MemberPointsTransaction.updated_at"`)}
+	}
+	if _, ok := mptc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "MemberPointsTransaction.merchant_id"`)}
+	}
+	if _, ok := mptc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "MemberPointsTransaction.store_id"`)}
+	}
+	if _, ok := mptc.mutation.MemberID(); !ok {
+		return &ValidationError{Name: "member_id", err: errors.New(`ent: missing required field "MemberPointsTransaction.member_id"`)}
+	}
+	if _, ok := mptc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "MemberPointsTransaction.account_id"`)}
+	}
+	if _, ok := mptc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "MemberPointsTransaction.type"`)}
+	}
+	if v, ok := mptc.mutation.GetType(); ok {
+		if err := memberpointstransaction.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "MemberPointsTransaction.type": %w`, err)}
+		}
+	}
+	if _, ok := mptc.mutation.Points(); !ok {
+		return &ValidationError{Name: "points", err: errors.New(`ent: missing required field "MemberPointsTransaction.points"`)}
+	}
+	if _, ok := mptc.mutation.BalanceAfter(); !ok {
+		return &ValidationError{Name: "balance_after", err: errors.New(`ent: missing required field "MemberPointsTransaction.balance_after"`)}
+	}
+	if _, ok := mptc.mutation.Remaining(); !ok {
+		return &ValidationError{Name: "remaining", err: errors.New(`ent: missing required field "MemberPointsTransaction.remaining"`)}
+	}
+	if _, ok := mptc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "MemberPointsTransaction.amount"`)}
+	}
+	if _, ok := mptc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "MemberPointsTransaction.order_id"`)}
+	}
+	if _, ok := mptc.mutation.OrderNo(); !ok {
+		return &ValidationError{Name: "order_no", err: errors.New(`ent: missing required field "MemberPointsTransaction.order_no"`)}
+	}
+	if v, ok := mptc.mutation.OrderNo(); ok {
+		if err := memberpointstransaction.OrderNoValidator(v); err != nil {
+			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "MemberPointsTransaction.order_no": %w`, err)}
+		}
+	}
+	if _, ok := mptc.mutation.RefundOrderID(); !ok {
+		return &ValidationError{Name: "refund_order_id", err: errors.New(`ent: missing required field "MemberPointsTransaction.refund_order_id"`)}
+	}
+	if _, ok := mptc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "MemberPointsTransaction.product_id"`)}
+	}
+	if _, ok := mptc.mutation.OperatorID(); !ok {
+		return &ValidationError{Name: "operator_id", err: errors.New(`ent: missing required field "MemberPointsTransaction.operator_id"`)}
+	}
+	if _, ok := mptc.mutation.OperatorName(); !ok {
+		return &ValidationError{Name: "operator_name", err: errors.New(`ent: missing required field "MemberPointsTransaction.operator_name"`)}
+	}
+	if v, ok := mptc.mutation.OperatorName(); ok {
+		if err := memberpointstransaction.OperatorNameValidator(v); err != nil {
+			return &ValidationError{Name: "operator_name", err: fmt.Errorf(`ent: validator failed for field "MemberPointsTransaction.operator_name": %w`, err)}
+		}
+	}
+	if _, ok := mptc.mutation.Remark(); !ok {
+		return &ValidationError{Name: "remark", err: errors.New(`ent: missing required field "MemberPointsTransaction.remark"`)}
+	}
+	if v, ok := mptc.mutation.Remark(); ok {
+		if err := memberpointstransaction.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "MemberPointsTransaction.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mptc *MemberPointsTransactionCreate) sqlSave(ctx context.Context) (*MemberPointsTransaction, error) {
+	if err := mptc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mptc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mptc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mptc.mutation.id = &_node.ID
+	mptc.mutation.done = true
+	return _node, nil
+}
+
+func (mptc *MemberPointsTransactionCreate) createSpec() (*MemberPointsTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MemberPointsTransaction{config: mptc.config}
+		_spec = sqlgraph.NewCreateSpec(memberpointstransaction.Table, sqlgraph.NewFieldSpec(memberpointstransaction.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mptc.conflict
+	if id, ok := mptc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mptc.mutation.CreatedAt(); ok {
+		_spec.SetField(memberpointstransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mptc.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberpointstransaction.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mptc.mutation.MerchantID(); ok {
+		_spec.SetField(memberpointstransaction.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := mptc.mutation.StoreID(); ok {
+		_spec.SetField(memberpointstransaction.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := mptc.mutation.MemberID(); ok {
+		_spec.SetField(memberpointstransaction.FieldMemberID, field.TypeUUID, value)
+		_node.MemberID = value
+	}
+	if value, ok := mptc.mutation.AccountID(); ok {
+		_spec.SetField(memberpointstransaction.FieldAccountID, field.TypeUUID, value)
+		_node.AccountID = value
+	}
+	if value, ok := mptc.mutation.GetType(); ok {
+		_spec.SetField(memberpointstransaction.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := mptc.mutation.Points(); ok {
+		_spec.SetField(memberpointstransaction.FieldPoints, field.TypeInt64, value)
+		_node.Points = value
+	}
+	if value, ok := mptc.mutation.BalanceAfter(); ok {
+		_spec.SetField(memberpointstransaction.FieldBalanceAfter, field.TypeInt64, value)
+		_node.BalanceAfter = value
+	}
+	if value, ok := mptc.mutation.Remaining(); ok {
+		_spec.SetField(memberpointstransaction.FieldRemaining, field.TypeInt64, value)
+		_node.Remaining = value
+	}
+	if value, ok := mptc.mutation.ExpiresAt(); ok {
+		_spec.SetField(memberpointstransaction.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := mptc.mutation.Amount(); ok {
+		_spec.SetField(memberpointstransaction.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := mptc.mutation.OrderID(); ok {
+		_spec.SetField(memberpointstransaction.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := mptc.mutation.OrderNo(); ok {
+		_spec.SetField(memberpointstransaction.FieldOrderNo, field.TypeString, value)
+		_node.OrderNo = value
+	}
+	if value, ok := mptc.mutation.RefundOrderID(); ok {
+		_spec.SetField(memberpointstransaction.FieldRefundOrderID, field.TypeUUID, value)
+		_node.RefundOrderID = value
+	}
+	if value, ok := mptc.mutation.ProductID(); ok {
+		_spec.SetField(memberpointstransaction.FieldProductID, field.TypeUUID, value)
+		_node.ProductID = value
+	}
+	if value, ok := mptc.mutation.OperatorID(); ok {
+		_spec.SetField(memberpointstransaction.FieldOperatorID, field.TypeUUID, value)
+		_node.OperatorID = value
+	}
+	if value, ok := mptc.mutation.OperatorName(); ok {
+		_spec.SetField(memberpointstransaction.FieldOperatorName, field.TypeString, value)
+		_node.OperatorName = value
+	}
+	if value, ok := mptc.mutation.Remark(); ok {
+		_spec.SetField(memberpointstransaction.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberPointsTransaction.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberPointsTransactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mptc *MemberPointsTransactionCreate) OnConflict(opts ...sql.ConflictOption) *MemberPointsTransactionUpsertOne {
+	mptc.conflict = opts
+	return &MemberPointsTransactionUpsertOne{
+		create: mptc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberPointsTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mptc *MemberPointsTransactionCreate) OnConflictColumns(columns ...string) *MemberPointsTransactionUpsertOne {
+	mptc.conflict = append(mptc.conflict, sql.ConflictColumns(columns...))
+	return &MemberPointsTransactionUpsertOne{
+		create: mptc,
+	}
+}
+
+type (
+	// MemberPointsTransactionUpsertOne is the builder for "upsert"-ing
+	//  one MemberPointsTransaction node.
+	MemberPointsTransactionUpsertOne struct {
+		create *MemberPointsTransactionCreate
+	}
+
+	// MemberPointsTransactionUpsert is the "OnConflict" setter.
+	MemberPointsTransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberPointsTransactionUpsert) SetUpdatedAt(v time.Time) *MemberPointsTransactionUpsert {
+	u.Set(memberpointstransaction.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberPointsTransactionUpsert) UpdateUpdatedAt() *MemberPointsTransactionUpsert {
+	u.SetExcluded(memberpointstransaction.FieldUpdatedAt)
+	return u
+}
+
+// SetRemaining sets the "remaining" field.
+func (u *MemberPointsTransactionUpsert) SetRemaining(v int64) *MemberPointsTransactionUpsert {
+	u.Set(memberpointstransaction.FieldRemaining, v)
+	return u
+}
+
+// UpdateRemaining sets the "remaining" field to the value that was provided on create.
+func (u *MemberPointsTransactionUpsert) UpdateRemaining() *MemberPointsTransactionUpsert {
+	u.SetExcluded(memberpointstransaction.FieldRemaining)
+	return u
+}
+
+// AddRemaining adds v to the "remaining" field.
+func (u *MemberPointsTransactionUpsert) AddRemaining(v int64) *MemberPointsTransactionUpsert {
+	u.Add(memberpointstransaction.FieldRemaining, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MemberPointsTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(memberpointstransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberPointsTransactionUpsertOne) UpdateNewValues() *MemberPointsTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(memberpointstransaction.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(memberpointstransaction.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(memberpointstransaction.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(memberpointstransaction.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.MemberID(); exists {
+			s.SetIgnore(memberpointstransaction.FieldMemberID)
+		}
+		if _, exists := u.create.mutation.AccountID(); exists {
+			s.SetIgnore(memberpointstransaction.FieldAccountID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(memberpointstransaction.FieldType)
+		}
+		if _, exists := u.create.mutation.Points(); exists {
+			s.SetIgnore(memberpointstransaction.FieldPoints)
+		}
+		if _, exists := u.create.mutation.BalanceAfter(); exists {
+			s.SetIgnore(memberpointstransaction.FieldBalanceAfter)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(memberpointstransaction.FieldExpiresAt)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(memberpointstransaction.FieldAmount)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(memberpointstransaction.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.OrderNo(); exists {
+			s.SetIgnore(memberpointstransaction.FieldOrderNo)
+		}
+		if _, exists := u.create.mutation.RefundOrderID(); exists {
+			s.SetIgnore(memberpointstransaction.FieldRefundOrderID)
+		}
+		if _, exists := u.create.mutation.ProductID(); exists {
+			s.SetIgnore(memberpointstransaction.FieldProductID)
+		}
+		if _, exists := u.create.mutation.OperatorID(); exists {
+			s.SetIgnore(memberpointstransaction.FieldOperatorID)
+		}
+		if _, exists := u.create.mutation.OperatorName(); exists {
+			s.SetIgnore(memberpointstransaction.FieldOperatorName)
+		}
+		if _, exists := u.create.mutation.Remark(); exists {
+			s.SetIgnore(memberpointstransaction.FieldRemark)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberPointsTransaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MemberPointsTransactionUpsertOne) Ignore() *MemberPointsTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberPointsTransactionUpsertOne) DoNothing() *MemberPointsTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberPointsTransactionCreate.OnConflict
+// documentation for more info.
+func (u *MemberPointsTransactionUpsertOne) Update(set func(*MemberPointsTransactionUpsert)) *MemberPointsTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberPointsTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberPointsTransactionUpsertOne) SetUpdatedAt(v time.Time) *MemberPointsTransactionUpsertOne {
+	return u.Update(func(s *MemberPointsTransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberPointsTransactionUpsertOne) UpdateUpdatedAt() *MemberPointsTransactionUpsertOne {
+	return u.Update(func(s *MemberPointsTransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRemaining sets the "remaining" field.
+func (u *MemberPointsTransactionUpsertOne) SetRemaining(v int64) *MemberPointsTransactionUpsertOne {
+	return u.Update(func(s *MemberPointsTransactionUpsert) {
+		s.SetRemaining(v)
+	})
+}
+
+// AddRemaining adds v to the "remaining" field.
+func (u *MemberPointsTransactionUpsertOne) AddRemaining(v int64) *MemberPointsTransactionUpsertOne {
+	return u.Update(func(s *MemberPointsTransactionUpsert) {
+		s.AddRemaining(v)
+	})
+}
+
+// UpdateRemaining sets the "remaining" field to the value that was provided on create.
+func (u *MemberPointsTransactionUpsertOne) UpdateRemaining() *MemberPointsTransactionUpsertOne {
+	return u.Update(func(s *MemberPointsTransactionUpsert) {
+		s.UpdateRemaining()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberPointsTransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberPointsTransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberPointsTransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MemberPointsTransactionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MemberPointsTransactionUpsertOne.ID is not supported by MySQL driver. Use MemberPointsTransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MemberPointsTransactionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MemberPointsTransactionCreateBulk is the builder for creating many MemberPointsTransaction entities in bulk.
+type MemberPointsTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*MemberPointsTransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MemberPointsTransaction entities in the database.
+func (mptcb *MemberPointsTransactionCreateBulk) Save(ctx context.Context) ([]*MemberPointsTransaction, error) {
+	if mptcb.err != nil {
+		return nil, mptcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mptcb.builders))
+	nodes := make([]*MemberPointsTransaction, len(mptcb.builders))
+	mutators := make([]Mutator, len(mptcb.builders))
+	for i := range mptcb.builders {
+		func(i int, root context.Context) {
+			builder := mptcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MemberPointsTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mptcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mptcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mptcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mptcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mptcb *MemberPointsTransactionCreateBulk) SaveX(ctx context.Context) []*MemberPointsTransaction {
+	v, err := mptcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mptcb *MemberPointsTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mptcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mptcb *MemberPointsTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := mptcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberPointsTransaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberPointsTransactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mptcb *MemberPointsTransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *MemberPointsTransactionUpsertBulk {
+	mptcb.conflict = opts
+	return &MemberPointsTransactionUpsertBulk{
+		create: mptcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberPointsTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mptcb *MemberPointsTransactionCreateBulk) OnConflictColumns(columns ...string) *MemberPointsTransactionUpsertBulk {
+	mptcb.conflict = append(mptcb.conflict, sql.ConflictColumns(columns...))
+	return &MemberPointsTransactionUpsertBulk{
+		create: mptcb,
+	}
+}
+
+// MemberPointsTransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of MemberPointsTransaction nodes.
+type MemberPointsTransactionUpsertBulk struct {
+	create *MemberPointsTransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MemberPointsTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(memberpointstransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberPointsTransactionUpsertBulk) UpdateNewValues() *MemberPointsTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(memberpointstransaction.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(memberpointstransaction.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(memberpointstransaction.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(memberpointstransaction.FieldStoreID)
+			}
+			if _, exists := b.mutation.MemberID(); exists {
+				s.SetIgnore(memberpointstransaction.FieldMemberID)
+			}
+			if _, exists := b.mutation.AccountID(); exists {
+				s.SetIgnore(memberpointstransaction.FieldAccountID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(memberpointstransaction.FieldType)
+			}
+			if _, exists := b.mutation.Points(); exists {
+				s.SetIgnore(memberpointstransaction.FieldPoints)
+			}
+			if _, exists := b.mutation.BalanceAfter(); exists {
+				s.SetIgnore(memberpointstransaction.FieldBalanceAfter)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(memberpointstransaction.FieldExpiresAt)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(memberpointstransaction.FieldAmount)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(memberpointstransaction.FieldOrderID)
+			}
+			if _, exists := b.mutation.OrderNo(); exists {
+				s.SetIgnore(memberpointstransaction.FieldOrderNo)
+			}
+			if _, exists := b.mutation.RefundOrderID(); exists {
+				s.SetIgnore(memberpointstransaction.FieldRefundOrderID)
+			}
+			if _, exists := b.mutation.ProductID(); exists {
+				s.SetIgnore(memberpointstransaction.FieldProductID)
+			}
+			if _, exists := b.mutation.OperatorID(); exists {
+				s.SetIgnore(memberpointstransaction.FieldOperatorID)
+			}
+			if _, exists := b.mutation.OperatorName(); exists {
+				s.SetIgnore(memberpointstransaction.FieldOperatorName)
+			}
+			if _, exists := b.mutation.Remark(); exists {
+				s.SetIgnore(memberpointstransaction.FieldRemark)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberPointsTransaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MemberPointsTransactionUpsertBulk) Ignore() *MemberPointsTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberPointsTransactionUpsertBulk) DoNothing() *MemberPointsTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberPointsTransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *MemberPointsTransactionUpsertBulk) Update(set func(*MemberPointsTransactionUpsert)) *MemberPointsTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberPointsTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberPointsTransactionUpsertBulk) SetUpdatedAt(v time.Time) *MemberPointsTransactionUpsertBulk {
+	return u.Update(func(s *MemberPointsTransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberPointsTransactionUpsertBulk) UpdateUpdatedAt() *MemberPointsTransactionUpsertBulk {
+	return u.Update(func(s *MemberPointsTransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRemaining sets the "remaining" field.
+func (u *MemberPointsTransactionUpsertBulk) SetRemaining(v int64) *MemberPointsTransactionUpsertBulk {
+	return u.Update(func(s *MemberPointsTransactionUpsert) {
+		s.SetRemaining(v)
+	})
+}
+
+// AddRemaining adds v to the "remaining" field.
+func (u *MemberPointsTransactionUpsertBulk) AddRemaining(v int64) *MemberPointsTransactionUpsertBulk {
+	return u.Update(func(s *MemberPointsTransactionUpsert) {
+		s.AddRemaining(v)
+	})
+}
+
+// UpdateRemaining sets the "remaining" field to the value that was provided on create.
+func (u *MemberPointsTransactionUpsertBulk) UpdateRemaining() *MemberPointsTransactionUpsertBulk {
+	return u.Update(func(s *MemberPointsTransactionUpsert) {
+		s.UpdateRemaining()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberPointsTransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MemberPointsTransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberPointsTransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberPointsTransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
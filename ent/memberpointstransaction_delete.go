@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointstransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberPointsTransactionDelete is the builder for deleting a MemberPointsTransaction entity.
+type MemberPointsTransactionDelete struct {
+	config
+	hooks    []Hook
+	mutation *MemberPointsTransactionMutation
+}
+
+// Where appends a list predicates to the MemberPointsTransactionDelete builder.
+func (mptd *MemberPointsTransactionDelete) Where(ps ...predicate.MemberPointsTransaction) *MemberPointsTransactionDelete {
+	mptd.mutation.Where(ps...)
+	return mptd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mptd *MemberPointsTransactionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mptd.sqlExec, mptd.mutation, mptd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mptd *MemberPointsTransactionDelete) ExecX(ctx context.Context) int {
+	n, err := mptd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mptd *MemberPointsTransactionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(memberpointstransaction.Table, sqlgraph.NewFieldSpec(memberpointstransaction.FieldID, field.TypeUUID))
+	if ps := mptd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mptd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mptd.mutation.done = true
+	return affected, err
+}
+
+// MemberPointsTransactionDeleteOne is the builder for deleting a single MemberPointsTransaction entity.
+type MemberPointsTransactionDeleteOne struct {
+	mptd *MemberPointsTransactionDelete
+}
+
+// Where appends a list predicates to the MemberPointsTransactionDelete builder.
+func (mptdo *MemberPointsTransactionDeleteOne) Where(ps ...predicate.MemberPointsTransaction) *MemberPointsTransactionDeleteOne {
+	mptdo.mptd.mutation.Where(ps...)
+	return mptdo
+}
+
+// Exec executes the deletion query.
+func (mptdo *MemberPointsTransactionDeleteOne) Exec(ctx context.Context) error {
+	n, err := mptdo.mptd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{memberpointstransaction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mptdo *MemberPointsTransactionDeleteOne) ExecX(ctx context.Context) {
+	if err := mptdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
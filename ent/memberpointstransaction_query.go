@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointstransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberPointsTransactionQuery is the builder for querying MemberPointsTransaction entities.
+type MemberPointsTransactionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []memberpointstransaction.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MemberPointsTransaction
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MemberPointsTransactionQuery builder.
+func (mptq *MemberPointsTransactionQuery) Where(ps ...predicate.MemberPointsTransaction) *MemberPointsTransactionQuery {
+	mptq.predicates = append(mptq.predicates, ps...)
+	return mptq
+}
+
+// Limit the number of records to be returned by this query.
+func (mptq *MemberPointsTransactionQuery) Limit(limit int) *MemberPointsTransactionQuery {
+	mptq.ctx.Limit = &limit
+	return mptq
+}
+
+// Offset to start from.
+func (mptq *MemberPointsTransactionQuery) Offset(offset int) *MemberPointsTransactionQuery {
+	mptq.ctx.Offset = &offset
+	return mptq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mptq *MemberPointsTransactionQuery) Unique(unique bool) *MemberPointsTransactionQuery {
+	mptq.ctx.Unique = &unique
+	return mptq
+}
+
+// Order specifies how the records should be ordered.
+func (mptq *MemberPointsTransactionQuery) Order(o ...memberpointstransaction.OrderOption) *MemberPointsTransactionQuery {
+	mptq.order = append(mptq.order, o...)
+	return mptq
+}
+
+// First returns the first MemberPointsTransaction entity from the query.
+// Returns a *NotFoundError when no MemberPointsTransaction was found.
+func (mptq *MemberPointsTransactionQuery) First(ctx context.Context) (*MemberPointsTransaction, error) {
+	nodes, err := mptq.Limit(1).All(setContextOp(ctx, mptq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{memberpointstransaction.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mptq *MemberPointsTransactionQuery) FirstX(ctx context.Context) *MemberPointsTransaction {
+	node, err := mptq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MemberPointsTransaction ID from the query.
+// Returns a *NotFoundError when no MemberPointsTransaction ID was found.
+func (mptq *MemberPointsTransactionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mptq.Limit(1).IDs(setContextOp(ctx, mptq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{memberpointstransaction.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mptq *MemberPointsTransactionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mptq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MemberPointsTransaction entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MemberPointsTransaction entity is found.
+// Returns a *NotFoundError when no MemberPointsTransaction entities are found.
+func (mptq *MemberPointsTransactionQuery) Only(ctx context.Context) (*MemberPointsTransaction, error) {
+	nodes, err := mptq.Limit(2).All(setContextOp(ctx, mptq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{memberpointstransaction.Label}
+	default:
+		return nil, &NotSingularError{memberpointstransaction.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mptq *MemberPointsTransactionQuery) OnlyX(ctx context.Context) *MemberPointsTransaction {
+	node, err := mptq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MemberPointsTransaction ID in the query.
+// Returns a *NotSingularError when more than one MemberPointsTransaction ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mptq *MemberPointsTransactionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mptq.Limit(2).IDs(setContextOp(ctx, mptq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{memberpointstransaction.Label}
+	default:
+		err = &NotSingularError{memberpointstransaction.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mptq *MemberPointsTransactionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mptq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MemberPointsTransactions.
+func (mptq *MemberPointsTransactionQuery) All(ctx context.Context) ([]*MemberPointsTransaction, error) {
+	ctx = setContextOp(ctx, mptq.ctx, ent.OpQueryAll)
+	if err := mptq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MemberPointsTransaction, *MemberPointsTransactionQuery]()
+	return withInterceptors[[]*MemberPointsTransaction](ctx, mptq, qr, mptq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mptq *MemberPointsTransactionQuery) AllX(ctx context.Context) []*MemberPointsTransaction {
+	nodes, err := mptq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MemberPointsTransaction IDs.
+func (mptq *MemberPointsTransactionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mptq.ctx.Unique == nil && mptq.path != nil {
+		mptq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mptq.ctx, ent.OpQueryIDs)
+	if err = mptq.Select(memberpointstransaction.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mptq *MemberPointsTransactionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mptq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mptq *MemberPointsTransactionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mptq.ctx, ent.OpQueryCount)
+	if err := mptq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mptq, querierCount[*MemberPointsTransactionQuery](), mptq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mptq *MemberPointsTransactionQuery) CountX(ctx context.Context) int {
+	count, err := mptq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mptq *MemberPointsTransactionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mptq.ctx, ent.OpQueryExist)
+	switch _, err := mptq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mptq *MemberPointsTransactionQuery) ExistX(ctx context.Context) bool {
+	exist, err := mptq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MemberPointsTransactionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mptq *MemberPointsTransactionQuery) Clone() *MemberPointsTransactionQuery {
+	if mptq == nil {
+		return nil
+	}
+	return &MemberPointsTransactionQuery{
+		config:     mptq.config,
+		ctx:        mptq.ctx.Clone(),
+		order:      append([]memberpointstransaction.OrderOption{}, mptq.order...),
+		inters:     append([]Interceptor{}, mptq.inters...),
+		predicates: append([]predicate.MemberPointsTransaction{}, mptq.predicates...),
+		// clone intermediate query.
+		sql:       mptq.sql.Clone(),
+		path:      mptq.path,
+		modifiers: append([]func(*sql.Selector){}, mptq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MemberPointsTransaction.Query().
+//		GroupBy(memberpointstransaction.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mptq *MemberPointsTransactionQuery) GroupBy(field string, fields ...string) *MemberPointsTransactionGroupBy {
+	mptq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MemberPointsTransactionGroupBy{build: mptq}
+	grbuild.flds = &mptq.ctx.Fields
+	grbuild.label = memberpointstransaction.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.MemberPointsTransaction.Query().
+//		Select(memberpointstransaction.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (mptq *MemberPointsTransactionQuery) Select(fields ...string) *MemberPointsTransactionSelect {
+	mptq.ctx.Fields = append(mptq.ctx.Fields, fields...)
+	sbuild := &MemberPointsTransactionSelect{MemberPointsTransactionQuery: mptq}
+	sbuild.label = memberpointstransaction.Label
+	sbuild.flds, sbuild.scan = &mptq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MemberPointsTransactionSelect configured with the given aggregations.
+func (mptq *MemberPointsTransactionQuery) Aggregate(fns ...AggregateFunc) *MemberPointsTransactionSelect {
+	return mptq.Select().Aggregate(fns...)
+}
+
+func (mptq *MemberPointsTransactionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mptq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mptq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mptq.ctx.Fields {
+		if !memberpointstransaction.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mptq.path != nil {
+		prev, err := mptq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mptq.sql = prev
+	}
+	return nil
+}
+
+func (mptq *MemberPointsTransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MemberPointsTransaction, error) {
+	var (
+		nodes = []*MemberPointsTransaction{}
+		_spec = mptq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MemberPointsTransaction).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MemberPointsTransaction{config: mptq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(mptq.modifiers) > 0 {
+		_spec.Modifiers = mptq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mptq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mptq *MemberPointsTransactionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mptq.querySpec()
+	if len(mptq.modifiers) > 0 {
+		_spec.Modifiers = mptq.modifiers
+	}
+	_spec.Node.Columns = mptq.ctx.Fields
+	if len(mptq.ctx.Fields) > 0 {
+		_spec.Unique = mptq.ctx.Unique != nil && *mptq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mptq.driver, _spec)
+}
+
+func (mptq *MemberPointsTransactionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(memberpointstransaction.Table, memberpointstransaction.Columns, sqlgraph.NewFieldSpec(memberpointstransaction.FieldID, field.TypeUUID))
+	_spec.From = mptq.sql
+	if unique := mptq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mptq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mptq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, memberpointstransaction.FieldID)
+		for i := range fields {
+			if fields[i] != memberpointstransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mptq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mptq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mptq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mptq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mptq *MemberPointsTransactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mptq.driver.Dialect())
+	t1 := builder.Table(memberpointstransaction.Table)
+	columns := mptq.ctx.Fields
+	if len(columns) == 0 {
+		columns = memberpointstransaction.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mptq.sql != nil {
+		selector = mptq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mptq.ctx.Unique != nil && *mptq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mptq.modifiers {
+		m(selector)
+	}
+	for _, p := range mptq.predicates {
+		p(selector)
+	}
+	for _, p := range mptq.order {
+		p(selector)
+	}
+	if offset := mptq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mptq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mptq *MemberPointsTransactionQuery) ForUpdate(opts ...sql.LockOption) *MemberPointsTransactionQuery {
+	if mptq.driver.Dialect() == dialect.Postgres {
+		mptq.Unique(false)
+	}
+	mptq.modifiers = append(mptq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mptq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mptq *MemberPointsTransactionQuery) ForShare(opts ...sql.LockOption) *MemberPointsTransactionQuery {
+	if mptq.driver.Dialect() == dialect.Postgres {
+		mptq.Unique(false)
+	}
+	mptq.modifiers = append(mptq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mptq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mptq *MemberPointsTransactionQuery) Modify(modifiers ...func(s *sql.Selector)) *MemberPointsTransactionSelect {
+	mptq.modifiers = append(mptq.modifiers, modifiers...)
+	return mptq.Select()
+}
+
+// MemberPointsTransactionGroupBy is the group-by builder for MemberPointsTransaction entities.
+type MemberPointsTransactionGroupBy struct {
+	selector
+	build *MemberPointsTransactionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mptgb *MemberPointsTransactionGroupBy) Aggregate(fns ...AggregateFunc) *MemberPointsTransactionGroupBy {
+	mptgb.fns = append(mptgb.fns, fns...)
+	return mptgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mptgb *MemberPointsTransactionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mptgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mptgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberPointsTransactionQuery, *MemberPointsTransactionGroupBy](ctx, mptgb.build, mptgb, mptgb.build.inters, v)
+}
+
+func (mptgb *MemberPointsTransactionGroupBy) sqlScan(ctx context.Context, root *MemberPointsTransactionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mptgb.fns))
+	for _, fn := range mptgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mptgb.flds)+len(mptgb.fns))
+		for _, f := range *mptgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mptgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mptgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MemberPointsTransactionSelect is the builder for selecting fields of MemberPointsTransaction entities.
+type MemberPointsTransactionSelect struct {
+	*MemberPointsTransactionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mpts *MemberPointsTransactionSelect) Aggregate(fns ...AggregateFunc) *MemberPointsTransactionSelect {
+	mpts.fns = append(mpts.fns, fns...)
+	return mpts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mpts *MemberPointsTransactionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mpts.ctx, ent.OpQuerySelect)
+	if err := mpts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberPointsTransactionQuery, *MemberPointsTransactionSelect](ctx, mpts.MemberPointsTransactionQuery, mpts, mpts.inters, v)
+}
+
+func (mpts *MemberPointsTransactionSelect) sqlScan(ctx context.Context, root *MemberPointsTransactionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mpts.fns))
+	for _, fn := range mpts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mpts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mpts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mpts *MemberPointsTransactionSelect) Modify(modifiers ...func(s *sql.Selector)) *MemberPointsTransactionSelect {
+	mpts.modifiers = append(mpts.modifiers, modifiers...)
+	return mpts
+}
@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointstransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberPointsTransactionUpdate is the builder for updating MemberPointsTransaction entities.
+type MemberPointsTransactionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MemberPointsTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MemberPointsTransactionUpdate builder.
+func (mptu *MemberPointsTransactionUpdate) Where(ps ...predicate.MemberPointsTransaction) *MemberPointsTransactionUpdate {
+	mptu.mutation.Where(ps...)
+	return mptu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mptu *MemberPointsTransactionUpdate) SetUpdatedAt(t time.Time) *MemberPointsTransactionUpdate {
+	mptu.mutation.SetUpdatedAt(t)
+	return mptu
+}
+
+// SetRemaining sets the "remaining" field.
+func (mptu *MemberPointsTransactionUpdate) SetRemaining(i int64) *MemberPointsTransactionUpdate {
+	mptu.mutation.ResetRemaining()
+	mptu.mutation.SetRemaining(i)
+	return mptu
+}
+
+// SetNillableRemaining sets the "remaining" field if the given value is not nil.
+func (mptu *MemberPointsTransactionUpdate) SetNillableRemaining(i *int64) *MemberPointsTransactionUpdate {
+	if i != nil {
+		mptu.SetRemaining(*i)
+	}
+	return mptu
+}
+
+// AddRemaining adds i to the "remaining" field.
+func (mptu *MemberPointsTransactionUpdate) AddRemaining(i int64) *MemberPointsTransactionUpdate {
+	mptu.mutation.AddRemaining(i)
+	return mptu
+}
+
+// Mutation returns the MemberPointsTransactionMutation object of the builder.
+func (mptu *MemberPointsTransactionUpdate) Mutation() *MemberPointsTransactionMutation {
+	return mptu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mptu *MemberPointsTransactionUpdate) Save(ctx context.Context) (int, error) {
+	mptu.defaults()
+	return withHooks(ctx, mptu.sqlSave, mptu.mutation, mptu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mptu *MemberPointsTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mptu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mptu *MemberPointsTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := mptu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mptu *MemberPointsTransactionUpdate) ExecX(ctx context.Context) {
+	if err := mptu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mptu *MemberPointsTransactionUpdate) defaults() {
+	if _, ok := mptu.mutation.UpdatedAt(); !ok {
+		v := memberpointstransaction.UpdateDefaultUpdatedAt()
+		mptu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mptu *MemberPointsTransactionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberPointsTransactionUpdate {
+	mptu.modifiers = append(mptu.modifiers, modifiers...)
+	return mptu
+}
+
+func (mptu *MemberPointsTransactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(memberpointstransaction.Table, memberpointstransaction.Columns, sqlgraph.NewFieldSpec(memberpointstransaction.FieldID, field.TypeUUID))
+	if ps := mptu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mptu.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberpointstransaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mptu.mutation.Remaining(); ok {
+		_spec.SetField(memberpointstransaction.FieldRemaining, field.TypeInt64, value)
+	}
+	if value, ok := mptu.mutation.AddedRemaining(); ok {
+		_spec.AddField(memberpointstransaction.FieldRemaining, field.TypeInt64, value)
+	}
+	if mptu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(memberpointstransaction.FieldExpiresAt, field.TypeTime)
+	}
+	_spec.AddModifiers(mptu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mptu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memberpointstransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mptu.mutation.done = true
+	return n, nil
+}
+
+// MemberPointsTransactionUpdateOne is the builder for updating a single MemberPointsTransaction entity.
+type MemberPointsTransactionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MemberPointsTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mptuo *MemberPointsTransactionUpdateOne) SetUpdatedAt(t time.Time) *MemberPointsTransactionUpdateOne {
+	mptuo.mutation.SetUpdatedAt(t)
+	return mptuo
+}
+
+// SetRemaining sets the "remaining" field.
+func (mptuo *MemberPointsTransactionUpdateOne) SetRemaining(i int64) *MemberPointsTransactionUpdateOne {
+	mptuo.mutation.ResetRemaining()
+	mptuo.mutation.SetRemaining(i)
+	return mptuo
+}
+
+// SetNillableRemaining sets the "remaining" field if the given value is not nil.
+func (mptuo *MemberPointsTransactionUpdateOne) SetNillableRemaining(i *int64) *MemberPointsTransactionUpdateOne {
+	if i != nil {
+		mptuo.SetRemaining(*i)
+	}
+	return mptuo
+}
+
+// AddRemaining adds i to the "remaining" field.
+func (mptuo *MemberPointsTransactionUpdateOne) AddRemaining(i int64) *MemberPointsTransactionUpdateOne {
+	mptuo.mutation.AddRemaining(i)
+	return mptuo
+}
+
+// Mutation returns the MemberPointsTransactionMutation object of the builder.
+func (mptuo *MemberPointsTransactionUpdateOne) Mutation() *MemberPointsTransactionMutation {
+	return mptuo.mutation
+}
+
+// Where appends a list predicates to the MemberPointsTransactionUpdate builder.
+func (mptuo *MemberPointsTransactionUpdateOne) Where(ps ...predicate.MemberPointsTransaction) *MemberPointsTransactionUpdateOne {
+	mptuo.mutation.Where(ps...)
+	return mptuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mptuo *MemberPointsTransactionUpdateOne) Select(field string, fields ...string) *MemberPointsTransactionUpdateOne {
+	mptuo.fields = append([]string{field}, fields...)
+	return mptuo
+}
+
+// Save executes the query and returns the updated MemberPointsTransaction entity.
+func (mptuo *MemberPointsTransactionUpdateOne) Save(ctx context.Context) (*MemberPointsTransaction, error) {
+	mptuo.defaults()
+	return withHooks(ctx, mptuo.sqlSave, mptuo.mutation, mptuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mptuo *MemberPointsTransactionUpdateOne) SaveX(ctx context.Context) *MemberPointsTransaction {
+	node, err := mptuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mptuo *MemberPointsTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mptuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mptuo *MemberPointsTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := mptuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mptuo *MemberPointsTransactionUpdateOne) defaults() {
+	if _, ok := mptuo.mutation.UpdatedAt(); !ok {
+		v := memberpointstransaction.UpdateDefaultUpdatedAt()
+		mptuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mptuo *MemberPointsTransactionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberPointsTransactionUpdateOne {
+	mptuo.modifiers = append(mptuo.modifiers, modifiers...)
+	return mptuo
+}
+
+func (mptuo *MemberPointsTransactionUpdateOne) sqlSave(ctx context.Context) (_node *MemberPointsTransaction, err error) {
+	_spec := sqlgraph.NewUpdateSpec(memberpointstransaction.Table, memberpointstransaction.Columns, sqlgraph.NewFieldSpec(memberpointstransaction.FieldID, field.TypeUUID))
+	id, ok := mptuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MemberPointsTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mptuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, memberpointstransaction.FieldID)
+		for _, f := range fields {
+			if !memberpointstransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != memberpointstransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mptuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mptuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(memberpointstransaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mptuo.mutation.Remaining(); ok {
+		_spec.SetField(memberpointstransaction.FieldRemaining, field.TypeInt64, value)
+	}
+	if value, ok := mptuo.mutation.AddedRemaining(); ok {
+		_spec.AddField(memberpointstransaction.FieldRemaining, field.TypeInt64, value)
+	}
+	if mptuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(memberpointstransaction.FieldExpiresAt, field.TypeTime)
+	}
+	_spec.AddModifiers(mptuo.modifiers...)
+	_node = &MemberPointsTransaction{config: mptuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mptuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memberpointstransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mptuo.mutation.done = true
+	return _node, nil
+}
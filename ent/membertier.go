@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/membertier"
+)
+
+// MemberTier is the model entity for the MemberTier schema.
+type MemberTier struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 等级名称
+	Name string `json:"name,omitempty"`
+	// 等级序号，值越大等级越高
+	Level int `json:"level,omitempty"`
+	// 是否享受会员价
+	MemberPriceEnabled bool `json:"member_price_enabled,omitempty"`
+	// 等级说明
+	Description  string `json:"description,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MemberTier) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case membertier.FieldMemberPriceEnabled:
+			values[i] = new(sql.NullBool)
+		case membertier.FieldDeletedAt, membertier.FieldLevel:
+			values[i] = new(sql.NullInt64)
+		case membertier.FieldName, membertier.FieldDescription:
+			values[i] = new(sql.NullString)
+		case membertier.FieldCreatedAt, membertier.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case membertier.FieldID, membertier.FieldMerchantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MemberTier fields.
+func (mt *MemberTier) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case membertier.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mt.ID = *value
+			}
+		case membertier.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mt.CreatedAt = value.Time
+			}
+		case membertier.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mt.UpdatedAt = value.Time
+			}
+		case membertier.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				mt.DeletedAt = value.Int64
+			}
+		case membertier.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				mt.MerchantID = *value
+			}
+		case membertier.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				mt.Name = value.String
+			}
+		case membertier.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				mt.Level = int(value.Int64)
+			}
+		case membertier.FieldMemberPriceEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field member_price_enabled", values[i])
+			} else if value.Valid {
+				mt.MemberPriceEnabled = value.Bool
+			}
+		case membertier.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				mt.Description = value.String
+			}
+		default:
+			mt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MemberTier.
+// This includes values selected through modifiers, order, etc.
+func (mt *MemberTier) Value(name string) (ent.Value, error) {
+	return mt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MemberTier.
+// Note that you need to call MemberTier.Unwrap() before calling this method if this MemberTier
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mt *MemberTier) Update() *MemberTierUpdateOne {
+	return NewMemberTierClient(mt.config).UpdateOne(mt)
+}
+
+// Unwrap unwraps the MemberTier entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mt *MemberTier) Unwrap() *MemberTier {
+	_tx, ok := mt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MemberTier is not a transactional entity")
+	}
+	mt.config.driver = _tx.drv
+	return mt
+}
+
+// String implements the fmt.Stringer.
+func (mt *MemberTier) String() string {
+	var builder strings.Builder
+	builder.WriteString("MemberTier(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(mt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", mt.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", mt.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(mt.Name)
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", mt.Level))
+	builder.WriteString(", ")
+	builder.WriteString("member_price_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", mt.MemberPriceEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(mt.Description)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MemberTiers is a parsable slice of MemberTier.
+type MemberTiers []*MemberTier
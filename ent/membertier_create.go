@@ -0,0 +1,946 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/membertier"
+)
+
+// MemberTierCreate is the builder for creating a MemberTier entity.
+type MemberTierCreate struct {
+	config
+	mutation *MemberTierMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mtc *MemberTierCreate) SetCreatedAt(t time.Time) *MemberTierCreate {
+	mtc.mutation.SetCreatedAt(t)
+	return mtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mtc *MemberTierCreate) SetNillableCreatedAt(t *time.Time) *MemberTierCreate {
+	if t != nil {
+		mtc.SetCreatedAt(*t)
+	}
+	return mtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mtc *MemberTierCreate) SetUpdatedAt(t time.Time) *MemberTierCreate {
+	mtc.mutation.SetUpdatedAt(t)
+	return mtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mtc *MemberTierCreate) SetNillableUpdatedAt(t *time.Time) *MemberTierCreate {
+	if t != nil {
+		mtc.SetUpdatedAt(*t)
+	}
+	return mtc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mtc *MemberTierCreate) SetDeletedAt(i int64) *MemberTierCreate {
+	mtc.mutation.SetDeletedAt(i)
+	return mtc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mtc *MemberTierCreate) SetNillableDeletedAt(i *int64) *MemberTierCreate {
+	if i != nil {
+		mtc.SetDeletedAt(*i)
+	}
+	return mtc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (mtc *MemberTierCreate) SetMerchantID(u uuid.UUID) *MemberTierCreate {
+	mtc.mutation.SetMerchantID(u)
+	return mtc
+}
+
+// SetName sets the "name" field.
+func (mtc *MemberTierCreate) SetName(s string) *MemberTierCreate {
+	mtc.mutation.SetName(s)
+	return mtc
+}
+
+// SetLevel sets the "level" field.
+func (mtc *MemberTierCreate) SetLevel(i int) *MemberTierCreate {
+	mtc.mutation.SetLevel(i)
+	return mtc
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (mtc *MemberTierCreate) SetNillableLevel(i *int) *MemberTierCreate {
+	if i != nil {
+		mtc.SetLevel(*i)
+	}
+	return mtc
+}
+
+// SetMemberPriceEnabled sets the "member_price_enabled" field.
+func (mtc *MemberTierCreate) SetMemberPriceEnabled(b bool) *MemberTierCreate {
+	mtc.mutation.SetMemberPriceEnabled(b)
+	return mtc
+}
+
+// SetNillableMemberPriceEnabled sets the "member_price_enabled" field if the given value is not nil.
+func (mtc *MemberTierCreate) SetNillableMemberPriceEnabled(b *bool) *MemberTierCreate {
+	if b != nil {
+		mtc.SetMemberPriceEnabled(*b)
+	}
+	return mtc
+}
+
+// SetDescription sets the "description" field.
+func (mtc *MemberTierCreate) SetDescription(s string) *MemberTierCreate {
+	mtc.mutation.SetDescription(s)
+	return mtc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (mtc *MemberTierCreate) SetNillableDescription(s *string) *MemberTierCreate {
+	if s != nil {
+		mtc.SetDescription(*s)
+	}
+	return mtc
+}
+
+// SetID sets the "id" field.
+func (mtc *MemberTierCreate) SetID(u uuid.UUID) *MemberTierCreate {
+	mtc.mutation.SetID(u)
+	return mtc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mtc *MemberTierCreate) SetNillableID(u *uuid.UUID) *MemberTierCreate {
+	if u != nil {
+		mtc.SetID(*u)
+	}
+	return mtc
+}
+
+// Mutation returns the MemberTierMutation object of the builder.
+func (mtc *MemberTierCreate) Mutation() *MemberTierMutation {
+	return mtc.mutation
+}
+
+// Save creates the MemberTier in the database.
+func (mtc *MemberTierCreate) Save(ctx context.Context) (*MemberTier, error) {
+	if err := mtc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, mtc.sqlSave, mtc.mutation, mtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mtc *MemberTierCreate) SaveX(ctx context.Context) *MemberTier {
+	v, err := mtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mtc *MemberTierCreate) Exec(ctx context.Context) error {
+	_, err := mtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtc *MemberTierCreate) ExecX(ctx context.Context) {
+	if err := mtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mtc *MemberTierCreate) defaults() error {
+	if _, ok := mtc.mutation.CreatedAt(); !ok {
+		if membertier.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized membertier.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := membertier.DefaultCreatedAt()
+		mtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mtc.mutation.UpdatedAt(); !ok {
+		if membertier.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized membertier.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := membertier.DefaultUpdatedAt()
+		mtc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mtc.mutation.DeletedAt(); !ok {
+		v := membertier.DefaultDeletedAt
+		mtc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := mtc.mutation.Level(); !ok {
+		v := membertier.DefaultLevel
+		mtc.mutation.SetLevel(v)
+	}
+	if _, ok := mtc.mutation.MemberPriceEnabled(); !ok {
+		v := membertier.DefaultMemberPriceEnabled
+		mtc.mutation.SetMemberPriceEnabled(v)
+	}
+	if _, ok := mtc.mutation.Description(); !ok {
+		v := membertier.DefaultDescription
+		mtc.mutation.SetDescription(v)
+	}
+	if _, ok := mtc.mutation.ID(); !ok {
+		if membertier.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized membertier.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := membertier.DefaultID()
+		mtc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtc *MemberTierCreate) check() error {
+	if _, ok := mtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MemberTier.created_at"`)}
+	}
+	if _, ok := mtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MemberTier.updated_at"`)}
+	}
+	if _, ok := mtc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "MemberTier.deleted_at"`)}
+	}
+	if _, ok := mtc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "MemberTier.merchant_id"`)}
+	}
+	if _, ok := mtc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "MemberTier.name"`)}
+	}
+	if v, ok := mtc.mutation.Name(); ok {
+		if err := membertier.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MemberTier.name": %w`, err)}
+		}
+	}
+	if _, ok := mtc.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "MemberTier.level"`)}
+	}
+	if _, ok := mtc.mutation.MemberPriceEnabled(); !ok {
+		return &ValidationError{Name: "member_price_enabled", err: errors.New(`ent: missing required field "MemberTier.member_price_enabled"`)}
+	}
+	if _, ok := mtc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "MemberTier.description"`)}
+	}
+	if v, ok := mtc.mutation.Description(); ok {
+		if err := membertier.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "MemberTier.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mtc *MemberTierCreate) sqlSave(ctx context.Context) (*MemberTier, error) {
+	if err := mtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mtc.mutation.id = &_node.ID
+	mtc.mutation.done = true
+	return _node, nil
+}
+
+func (mtc *MemberTierCreate) createSpec() (*MemberTier, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MemberTier{config: mtc.config}
+		_spec = sqlgraph.NewCreateSpec(membertier.Table, sqlgraph.NewFieldSpec(membertier.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mtc.conflict
+	if id, ok := mtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mtc.mutation.CreatedAt(); ok {
+		_spec.SetField(membertier.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(membertier.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mtc.mutation.DeletedAt(); ok {
+		_spec.SetField(membertier.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := mtc.mutation.MerchantID(); ok {
+		_spec.SetField(membertier.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := mtc.mutation.Name(); ok {
+		_spec.SetField(membertier.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := mtc.mutation.Level(); ok {
+		_spec.SetField(membertier.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := mtc.mutation.MemberPriceEnabled(); ok {
+		_spec.SetField(membertier.FieldMemberPriceEnabled, field.TypeBool, value)
+		_node.MemberPriceEnabled = value
+	}
+	if value, ok := mtc.mutation.Description(); ok {
+		_spec.SetField(membertier.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberTier.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberTierUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mtc *MemberTierCreate) OnConflict(opts ...sql.ConflictOption) *MemberTierUpsertOne {
+	mtc.conflict = opts
+	return &MemberTierUpsertOne{
+		create: mtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberTier.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mtc *MemberTierCreate) OnConflictColumns(columns ...string) *MemberTierUpsertOne {
+	mtc.conflict = append(mtc.conflict, sql.ConflictColumns(columns...))
+	return &MemberTierUpsertOne{
+		create: mtc,
+	}
+}
+
+type (
+	// MemberTierUpsertOne is the builder for "upsert"-ing
+	//  one MemberTier node.
+	MemberTierUpsertOne struct {
+		create *MemberTierCreate
+	}
+
+	// MemberTierUpsert is the "OnConflict" setter.
+	MemberTierUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberTierUpsert) SetUpdatedAt(v time.Time) *MemberTierUpsert {
+	u.Set(membertier.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberTierUpsert) UpdateUpdatedAt() *MemberTierUpsert {
+	u.SetExcluded(membertier.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MemberTierUpsert) SetDeletedAt(v int64) *MemberTierUpsert {
+	u.Set(membertier.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MemberTierUpsert) UpdateDeletedAt() *MemberTierUpsert {
+	u.SetExcluded(membertier.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *MemberTierUpsert) AddDeletedAt(v int64) *MemberTierUpsert {
+	u.Add(membertier.FieldDeletedAt, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *MemberTierUpsert) SetName(v string) *MemberTierUpsert {
+	u.Set(membertier.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MemberTierUpsert) UpdateName() *MemberTierUpsert {
+	u.SetExcluded(membertier.FieldName)
+	return u
+}
+
+// SetLevel sets the "level" field.
+func (u *MemberTierUpsert) SetLevel(v int) *MemberTierUpsert {
+	u.Set(membertier.FieldLevel, v)
+	return u
+}
+
+// UpdateLevel sets the "level" field to the value that was provided on create.
+func (u *MemberTierUpsert) UpdateLevel() *MemberTierUpsert {
+	u.SetExcluded(membertier.FieldLevel)
+	return u
+}
+
+// AddLevel adds v to the "level" field.
+func (u *MemberTierUpsert) AddLevel(v int) *MemberTierUpsert {
+	u.Add(membertier.FieldLevel, v)
+	return u
+}
+
+// SetMemberPriceEnabled sets the "member_price_enabled" field.
+func (u *MemberTierUpsert) SetMemberPriceEnabled(v bool) *MemberTierUpsert {
+	u.Set(membertier.FieldMemberPriceEnabled, v)
+	return u
+}
+
+// UpdateMemberPriceEnabled sets the "member_price_enabled" field to the value that was provided on create.
+func (u *MemberTierUpsert) UpdateMemberPriceEnabled() *MemberTierUpsert {
+	u.SetExcluded(membertier.FieldMemberPriceEnabled)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *MemberTierUpsert) SetDescription(v string) *MemberTierUpsert {
+	u.Set(membertier.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *MemberTierUpsert) UpdateDescription() *MemberTierUpsert {
+	u.SetExcluded(membertier.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MemberTier.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(membertier.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberTierUpsertOne) UpdateNewValues() *MemberTierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(membertier.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(membertier.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(membertier.FieldMerchantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberTier.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MemberTierUpsertOne) Ignore() *MemberTierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberTierUpsertOne) DoNothing() *MemberTierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberTierCreate.OnConflict
+// documentation for more info.
+func (u *MemberTierUpsertOne) Update(set func(*MemberTierUpsert)) *MemberTierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberTierUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberTierUpsertOne) SetUpdatedAt(v time.Time) *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberTierUpsertOne) UpdateUpdatedAt() *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MemberTierUpsertOne) SetDeletedAt(v int64) *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *MemberTierUpsertOne) AddDeletedAt(v int64) *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MemberTierUpsertOne) UpdateDeletedAt() *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MemberTierUpsertOne) SetName(v string) *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MemberTierUpsertOne) UpdateName() *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetLevel sets the "level" field.
+func (u *MemberTierUpsertOne) SetLevel(v int) *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetLevel(v)
+	})
+}
+
+// AddLevel adds v to the "level" field.
+func (u *MemberTierUpsertOne) AddLevel(v int) *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.AddLevel(v)
+	})
+}
+
+// UpdateLevel sets the "level" field to the value that was provided on create.
+func (u *MemberTierUpsertOne) UpdateLevel() *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateLevel()
+	})
+}
+
+// SetMemberPriceEnabled sets the "member_price_enabled" field.
+func (u *MemberTierUpsertOne) SetMemberPriceEnabled(v bool) *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetMemberPriceEnabled(v)
+	})
+}
+
+// UpdateMemberPriceEnabled sets the "member_price_enabled" field to the value that was provided on create.
+func (u *MemberTierUpsertOne) UpdateMemberPriceEnabled() *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateMemberPriceEnabled()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *MemberTierUpsertOne) SetDescription(v string) *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *MemberTierUpsertOne) UpdateDescription() *MemberTierUpsertOne {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberTierUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberTierCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberTierUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MemberTierUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MemberTierUpsertOne.ID is not supported by MySQL driver. Use MemberTierUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MemberTierUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MemberTierCreateBulk is the builder for creating many MemberTier entities in bulk.
+type MemberTierCreateBulk struct {
+	config
+	err      error
+	builders []*MemberTierCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MemberTier entities in the database.
+func (mtcb *MemberTierCreateBulk) Save(ctx context.Context) ([]*MemberTier, error) {
+	if mtcb.err != nil {
+		return nil, mtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mtcb.builders))
+	nodes := make([]*MemberTier, len(mtcb.builders))
+	mutators := make([]Mutator, len(mtcb.builders))
+	for i := range mtcb.builders {
+		func(i int, root context.Context) {
+			builder := mtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MemberTierMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtcb *MemberTierCreateBulk) SaveX(ctx context.Context) []*MemberTier {
+	v, err := mtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mtcb *MemberTierCreateBulk) Exec(ctx context.Context) error {
+	_, err := mtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtcb *MemberTierCreateBulk) ExecX(ctx context.Context) {
+	if err := mtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MemberTier.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberTierUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mtcb *MemberTierCreateBulk) OnConflict(opts ...sql.ConflictOption) *MemberTierUpsertBulk {
+	mtcb.conflict = opts
+	return &MemberTierUpsertBulk{
+		create: mtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MemberTier.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mtcb *MemberTierCreateBulk) OnConflictColumns(columns ...string) *MemberTierUpsertBulk {
+	mtcb.conflict = append(mtcb.conflict, sql.ConflictColumns(columns...))
+	return &MemberTierUpsertBulk{
+		create: mtcb,
+	}
+}
+
+// MemberTierUpsertBulk is the builder for "upsert"-ing
+// a bulk of MemberTier nodes.
+type MemberTierUpsertBulk struct {
+	create *MemberTierCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MemberTier.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(membertier.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberTierUpsertBulk) UpdateNewValues() *MemberTierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(membertier.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(membertier.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(membertier.FieldMerchantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MemberTier.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MemberTierUpsertBulk) Ignore() *MemberTierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberTierUpsertBulk) DoNothing() *MemberTierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberTierCreateBulk.OnConflict
+// documentation for more info.
+func (u *MemberTierUpsertBulk) Update(set func(*MemberTierUpsert)) *MemberTierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberTierUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberTierUpsertBulk) SetUpdatedAt(v time.Time) *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberTierUpsertBulk) UpdateUpdatedAt() *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MemberTierUpsertBulk) SetDeletedAt(v int64) *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *MemberTierUpsertBulk) AddDeletedAt(v int64) *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MemberTierUpsertBulk) UpdateDeletedAt() *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MemberTierUpsertBulk) SetName(v string) *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MemberTierUpsertBulk) UpdateName() *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetLevel sets the "level" field.
+func (u *MemberTierUpsertBulk) SetLevel(v int) *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetLevel(v)
+	})
+}
+
+// AddLevel adds v to the "level" field.
+func (u *MemberTierUpsertBulk) AddLevel(v int) *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.AddLevel(v)
+	})
+}
+
+// UpdateLevel sets the "level" field to the value that was provided on create.
+func (u *MemberTierUpsertBulk) UpdateLevel() *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateLevel()
+	})
+}
+
+// SetMemberPriceEnabled sets the "member_price_enabled" field.
+func (u *MemberTierUpsertBulk) SetMemberPriceEnabled(v bool) *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetMemberPriceEnabled(v)
+	})
+}
+
+// UpdateMemberPriceEnabled sets the "member_price_enabled" field to the value that was provided on create.
+func (u *MemberTierUpsertBulk) UpdateMemberPriceEnabled() *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateMemberPriceEnabled()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *MemberTierUpsertBulk) SetDescription(v string) *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *MemberTierUpsertBulk) UpdateDescription() *MemberTierUpsertBulk {
+	return u.Update(func(s *MemberTierUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberTierUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MemberTierCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberTierCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberTierUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/membertier"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberTierDelete is the builder for deleting a MemberTier entity.
+type MemberTierDelete struct {
+	config
+	hooks    []Hook
+	mutation *MemberTierMutation
+}
+
+// Where appends a list predicates to the MemberTierDelete builder.
+func (mtd *MemberTierDelete) Where(ps ...predicate.MemberTier) *MemberTierDelete {
+	mtd.mutation.Where(ps...)
+	return mtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mtd *MemberTierDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mtd.sqlExec, mtd.mutation, mtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtd *MemberTierDelete) ExecX(ctx context.Context) int {
+	n, err := mtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mtd *MemberTierDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(membertier.Table, sqlgraph.NewFieldSpec(membertier.FieldID, field.TypeUUID))
+	if ps := mtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mtd.mutation.done = true
+	return affected, err
+}
+
+// MemberTierDeleteOne is the builder for deleting a single MemberTier entity.
+type MemberTierDeleteOne struct {
+	mtd *MemberTierDelete
+}
+
+// Where appends a list predicates to the MemberTierDelete builder.
+func (mtdo *MemberTierDeleteOne) Where(ps ...predicate.MemberTier) *MemberTierDeleteOne {
+	mtdo.mtd.mutation.Where(ps...)
+	return mtdo
+}
+
+// Exec executes the deletion query.
+func (mtdo *MemberTierDeleteOne) Exec(ctx context.Context) error {
+	n, err := mtdo.mtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{membertier.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtdo *MemberTierDeleteOne) ExecX(ctx context.Context) {
+	if err := mtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
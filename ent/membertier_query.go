@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/membertier"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberTierQuery is the builder for querying MemberTier entities.
+type MemberTierQuery struct {
+	config
+	ctx        *QueryContext
+	order      []membertier.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MemberTier
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MemberTierQuery builder.
+func (mtq *MemberTierQuery) Where(ps ...predicate.MemberTier) *MemberTierQuery {
+	mtq.predicates = append(mtq.predicates, ps...)
+	return mtq
+}
+
+// Limit the number of records to be returned by this query.
+func (mtq *MemberTierQuery) Limit(limit int) *MemberTierQuery {
+	mtq.ctx.Limit = &limit
+	return mtq
+}
+
+// Offset to start from.
+func (mtq *MemberTierQuery) Offset(offset int) *MemberTierQuery {
+	mtq.ctx.Offset = &offset
+	return mtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mtq *MemberTierQuery) Unique(unique bool) *MemberTierQuery {
+	mtq.ctx.Unique = &unique
+	return mtq
+}
+
+// Order specifies how the records should be ordered.
+func (mtq *MemberTierQuery) Order(o ...membertier.OrderOption) *MemberTierQuery {
+	mtq.order = append(mtq.order, o...)
+	return mtq
+}
+
+// First returns the first MemberTier entity from the query.
+// Returns a *NotFoundError when no MemberTier was found.
+func (mtq *MemberTierQuery) First(ctx context.Context) (*MemberTier, error) {
+	nodes, err := mtq.Limit(1).All(setContextOp(ctx, mtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{membertier.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mtq *MemberTierQuery) FirstX(ctx context.Context) *MemberTier {
+	node, err := mtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MemberTier ID from the query.
+// Returns a *NotFoundError when no MemberTier ID was found.
+func (mtq *MemberTierQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mtq.Limit(1).IDs(setContextOp(ctx, mtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{membertier.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mtq *MemberTierQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MemberTier entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MemberTier entity is found.
+// Returns a *NotFoundError when no MemberTier entities are found.
+func (mtq *MemberTierQuery) Only(ctx context.Context) (*MemberTier, error) {
+	nodes, err := mtq.Limit(2).All(setContextOp(ctx, mtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{membertier.Label}
+	default:
+		return nil, &NotSingularError{membertier.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mtq *MemberTierQuery) OnlyX(ctx context.Context) *MemberTier {
+	node, err := mtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MemberTier ID in the query.
+// Returns a *NotSingularError when more than one MemberTier ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mtq *MemberTierQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mtq.Limit(2).IDs(setContextOp(ctx, mtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{membertier.Label}
+	default:
+		err = &NotSingularError{membertier.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mtq *MemberTierQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MemberTiers.
+func (mtq *MemberTierQuery) All(ctx context.Context) ([]*MemberTier, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryAll)
+	if err := mtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MemberTier, *MemberTierQuery]()
+	return withInterceptors[[]*MemberTier](ctx, mtq, qr, mtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mtq *MemberTierQuery) AllX(ctx context.Context) []*MemberTier {
+	nodes, err := mtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MemberTier IDs.
+func (mtq *MemberTierQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mtq.ctx.Unique == nil && mtq.path != nil {
+		mtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryIDs)
+	if err = mtq.Select(membertier.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mtq *MemberTierQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mtq *MemberTierQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryCount)
+	if err := mtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mtq, querierCount[*MemberTierQuery](), mtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mtq *MemberTierQuery) CountX(ctx context.Context) int {
+	count, err := mtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mtq *MemberTierQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryExist)
+	switch _, err := mtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mtq *MemberTierQuery) ExistX(ctx context.Context) bool {
+	exist, err := mtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MemberTierQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mtq *MemberTierQuery) Clone() *MemberTierQuery {
+	if mtq == nil {
+		return nil
+	}
+	return &MemberTierQuery{
+		config:     mtq.config,
+		ctx:        mtq.ctx.Clone(),
+		order:      append([]membertier.OrderOption{}, mtq.order...),
+		inters:     append([]Interceptor{}, mtq.inters...),
+		predicates: append([]predicate.MemberTier{}, mtq.predicates...),
+		// clone intermediate query.
+		sql:       mtq.sql.Clone(),
+		path:      mtq.path,
+		modifiers: append([]func(*sql.Selector){}, mtq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MemberTier.Query().
+//		GroupBy(membertier.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mtq *MemberTierQuery) GroupBy(field string, fields ...string) *MemberTierGroupBy {
+	mtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MemberTierGroupBy{build: mtq}
+	grbuild.flds = &mtq.ctx.Fields
+	grbuild.label = membertier.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.MemberTier.Query().
+//		Select(membertier.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (mtq *MemberTierQuery) Select(fields ...string) *MemberTierSelect {
+	mtq.ctx.Fields = append(mtq.ctx.Fields, fields...)
+	sbuild := &MemberTierSelect{MemberTierQuery: mtq}
+	sbuild.label = membertier.Label
+	sbuild.flds, sbuild.scan = &mtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MemberTierSelect configured with the given aggregations.
+func (mtq *MemberTierQuery) Aggregate(fns ...AggregateFunc) *MemberTierSelect {
+	return mtq.Select().Aggregate(fns...)
+}
+
+func (mtq *MemberTierQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mtq.ctx.Fields {
+		if !membertier.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mtq.path != nil {
+		prev, err := mtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mtq.sql = prev
+	}
+	return nil
+}
+
+func (mtq *MemberTierQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MemberTier, error) {
+	var (
+		nodes = []*MemberTier{}
+		_spec = mtq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MemberTier).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MemberTier{config: mtq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(mtq.modifiers) > 0 {
+		_spec.Modifiers = mtq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mtq *MemberTierQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mtq.querySpec()
+	if len(mtq.modifiers) > 0 {
+		_spec.Modifiers = mtq.modifiers
+	}
+	_spec.Node.Columns = mtq.ctx.Fields
+	if len(mtq.ctx.Fields) > 0 {
+		_spec.Unique = mtq.ctx.Unique != nil && *mtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mtq.driver, _spec)
+}
+
+func (mtq *MemberTierQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(membertier.Table, membertier.Columns, sqlgraph.NewFieldSpec(membertier.FieldID, field.TypeUUID))
+	_spec.From = mtq.sql
+	if unique := mtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, membertier.FieldID)
+		for i := range fields {
+			if fields[i] != membertier.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mtq *MemberTierQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mtq.driver.Dialect())
+	t1 := builder.Table(membertier.Table)
+	columns := mtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = membertier.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mtq.sql != nil {
+		selector = mtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mtq.ctx.Unique != nil && *mtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mtq.modifiers {
+		m(selector)
+	}
+	for _, p := range mtq.predicates {
+		p(selector)
+	}
+	for _, p := range mtq.order {
+		p(selector)
+	}
+	if offset := mtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mtq *MemberTierQuery) ForUpdate(opts ...sql.LockOption) *MemberTierQuery {
+	if mtq.driver.Dialect() == dialect.Postgres {
+		mtq.Unique(false)
+	}
+	mtq.modifiers = append(mtq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mtq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mtq *MemberTierQuery) ForShare(opts ...sql.LockOption) *MemberTierQuery {
+	if mtq.driver.Dialect() == dialect.Postgres {
+		mtq.Unique(false)
+	}
+	mtq.modifiers = append(mtq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mtq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mtq *MemberTierQuery) Modify(modifiers ...func(s *sql.Selector)) *MemberTierSelect {
+	mtq.modifiers = append(mtq.modifiers, modifiers...)
+	return mtq.Select()
+}
+
+// MemberTierGroupBy is the group-by builder for MemberTier entities.
+type MemberTierGroupBy struct {
+	selector
+	build *MemberTierQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mtgb *MemberTierGroupBy) Aggregate(fns ...AggregateFunc) *MemberTierGroupBy {
+	mtgb.fns = append(mtgb.fns, fns...)
+	return mtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mtgb *MemberTierGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberTierQuery, *MemberTierGroupBy](ctx, mtgb.build, mtgb, mtgb.build.inters, v)
+}
+
+func (mtgb *MemberTierGroupBy) sqlScan(ctx context.Context, root *MemberTierQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mtgb.fns))
+	for _, fn := range mtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mtgb.flds)+len(mtgb.fns))
+		for _, f := range *mtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MemberTierSelect is the builder for selecting fields of MemberTier entities.
+type MemberTierSelect struct {
+	*MemberTierQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mts *MemberTierSelect) Aggregate(fns ...AggregateFunc) *MemberTierSelect {
+	mts.fns = append(mts.fns, fns...)
+	return mts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mts *MemberTierSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mts.ctx, ent.OpQuerySelect)
+	if err := mts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberTierQuery, *MemberTierSelect](ctx, mts.MemberTierQuery, mts, mts.inters, v)
+}
+
+func (mts *MemberTierSelect) sqlScan(ctx context.Context, root *MemberTierQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mts.fns))
+	for _, fn := range mts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mts *MemberTierSelect) Modify(modifiers ...func(s *sql.Selector)) *MemberTierSelect {
+	mts.modifiers = append(mts.modifiers, modifiers...)
+	return mts
+}
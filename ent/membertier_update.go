@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/membertier"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MemberTierUpdate is the builder for updating MemberTier entities.
+type MemberTierUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MemberTierMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MemberTierUpdate builder.
+func (mtu *MemberTierUpdate) Where(ps ...predicate.MemberTier) *MemberTierUpdate {
+	mtu.mutation.Where(ps...)
+	return mtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mtu *MemberTierUpdate) SetUpdatedAt(t time.Time) *MemberTierUpdate {
+	mtu.mutation.SetUpdatedAt(t)
+	return mtu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mtu *MemberTierUpdate) SetDeletedAt(i int64) *MemberTierUpdate {
+	mtu.mutation.ResetDeletedAt()
+	mtu.mutation.SetDeletedAt(i)
+	return mtu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mtu *MemberTierUpdate) SetNillableDeletedAt(i *int64) *MemberTierUpdate {
+	if i != nil {
+		mtu.SetDeletedAt(*i)
+	}
+	return mtu
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (mtu *MemberTierUpdate) AddDeletedAt(i int64) *MemberTierUpdate {
+	mtu.mutation.AddDeletedAt(i)
+	return mtu
+}
+
+// SetName sets the "name" field.
+func (mtu *MemberTierUpdate) SetName(s string) *MemberTierUpdate {
+	mtu.mutation.SetName(s)
+	return mtu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mtu *MemberTierUpdate) SetNillableName(s *string) *MemberTierUpdate {
+	if s != nil {
+		mtu.SetName(*s)
+	}
+	return mtu
+}
+
+// SetLevel sets the "level" field.
+func (mtu *MemberTierUpdate) SetLevel(i int) *MemberTierUpdate {
+	mtu.mutation.ResetLevel()
+	mtu.mutation.SetLevel(i)
+	return mtu
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (mtu *MemberTierUpdate) SetNillableLevel(i *int) *MemberTierUpdate {
+	if i != nil {
+		mtu.SetLevel(*i)
+	}
+	return mtu
+}
+
+// AddLevel adds i to the "level" field.
+func (mtu *MemberTierUpdate) AddLevel(i int) *MemberTierUpdate {
+	mtu.mutation.AddLevel(i)
+	return mtu
+}
+
+// SetMemberPriceEnabled sets the "member_price_enabled" field.
+func (mtu *MemberTierUpdate) SetMemberPriceEnabled(b bool) *MemberTierUpdate {
+	mtu.mutation.SetMemberPriceEnabled(b)
+	return mtu
+}
+
+// SetNillableMemberPriceEnabled sets the "member_price_enabled" field if the given value is not nil.
+func (mtu *MemberTierUpdate) SetNillableMemberPriceEnabled(b *bool) *MemberTierUpdate {
+	if b != nil {
+		mtu.SetMemberPriceEnabled(*b)
+	}
+	return mtu
+}
+
+// SetDescription sets the "description" field.
+func (mtu *MemberTierUpdate) SetDescription(s string) *MemberTierUpdate {
+	mtu.mutation.SetDescription(s)
+	return mtu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (mtu *MemberTierUpdate) SetNillableDescription(s *string) *MemberTierUpdate {
+	if s != nil {
+		mtu.SetDescription(*s)
+	}
+	return mtu
+}
+
+// Mutation returns the MemberTierMutation object of the builder.
+func (mtu *MemberTierUpdate) Mutation() *MemberTierMutation {
+	return mtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mtu *MemberTierUpdate) Save(ctx context.Context) (int, error) {
+	if err := mtu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, mtu.sqlSave, mtu.mutation, mtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtu *MemberTierUpdate) SaveX(ctx context.Context) int {
+	affected, err := mtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mtu *MemberTierUpdate) Exec(ctx context.Context) error {
+	_, err := mtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtu *MemberTierUpdate) ExecX(ctx context.Context) {
+	if err := mtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mtu *MemberTierUpdate) defaults() error {
+	if _, ok := mtu.mutation.UpdatedAt(); !ok {
+		if membertier.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized membertier.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := membertier.UpdateDefaultUpdatedAt()
+		mtu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtu *MemberTierUpdate) check() error {
+	if v, ok := mtu.mutation.Name(); ok {
+		if err := membertier.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MemberTier.name": %w`, err)}
+		}
+	}
+	if v, ok := mtu.mutation.Description(); ok {
+		if err := membertier.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "MemberTier.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mtu *MemberTierUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberTierUpdate {
+	mtu.modifiers = append(mtu.modifiers, modifiers...)
+	return mtu
+}
+
+func (mtu *MemberTierUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(membertier.Table, membertier.Columns, sqlgraph.NewFieldSpec(membertier.FieldID, field.TypeUUID))
+	if ps := mtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(membertier.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mtu.mutation.DeletedAt(); ok {
+		_spec.SetField(membertier.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := mtu.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(membertier.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := mtu.mutation.Name(); ok {
+		_spec.SetField(membertier.FieldName, field.TypeString, value)
+	}
+	if value, ok := mtu.mutation.Level(); ok {
+		_spec.SetField(membertier.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := mtu.mutation.AddedLevel(); ok {
+		_spec.AddField(membertier.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := mtu.mutation.MemberPriceEnabled(); ok {
+		_spec.SetField(membertier.FieldMemberPriceEnabled, field.TypeBool, value)
+	}
+	if value, ok := mtu.mutation.Description(); ok {
+		_spec.SetField(membertier.FieldDescription, field.TypeString, value)
+	}
+	_spec.AddModifiers(mtu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membertier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mtu.mutation.done = true
+	return n, nil
+}
+
+// MemberTierUpdateOne is the builder for updating a single MemberTier entity.
+type MemberTierUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MemberTierMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mtuo *MemberTierUpdateOne) SetUpdatedAt(t time.Time) *MemberTierUpdateOne {
+	mtuo.mutation.SetUpdatedAt(t)
+	return mtuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mtuo *MemberTierUpdateOne) SetDeletedAt(i int64) *MemberTierUpdateOne {
+	mtuo.mutation.ResetDeletedAt()
+	mtuo.mutation.SetDeletedAt(i)
+	return mtuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mtuo *MemberTierUpdateOne) SetNillableDeletedAt(i *int64) *MemberTierUpdateOne {
+	if i != nil {
+		mtuo.SetDeletedAt(*i)
+	}
+	return mtuo
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (mtuo *MemberTierUpdateOne) AddDeletedAt(i int64) *MemberTierUpdateOne {
+	mtuo.mutation.AddDeletedAt(i)
+	return mtuo
+}
+
+// SetName sets the "name" field.
+func (mtuo *MemberTierUpdateOne) SetName(s string) *MemberTierUpdateOne {
+	mtuo.mutation.SetName(s)
+	return mtuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mtuo *MemberTierUpdateOne) SetNillableName(s *string) *MemberTierUpdateOne {
+	if s != nil {
+		mtuo.SetName(*s)
+	}
+	return mtuo
+}
+
+// SetLevel sets the "level" field.
+func (mtuo *MemberTierUpdateOne) SetLevel(i int) *MemberTierUpdateOne {
+	mtuo.mutation.ResetLevel()
+	mtuo.mutation.SetLevel(i)
+	return mtuo
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (mtuo *MemberTierUpdateOne) SetNillableLevel(i *int) *MemberTierUpdateOne {
+	if i != nil {
+		mtuo.SetLevel(*i)
+	}
+	return mtuo
+}
+
+// AddLevel adds i to the "level" field.
+func (mtuo *MemberTierUpdateOne) AddLevel(i int) *MemberTierUpdateOne {
+	mtuo.mutation.AddLevel(i)
+	return mtuo
+}
+
+// SetMemberPriceEnabled sets the "member_price_enabled" field.
+func (mtuo *MemberTierUpdateOne) SetMemberPriceEnabled(b bool) *MemberTierUpdateOne {
+	mtuo.mutation.SetMemberPriceEnabled(b)
+	return mtuo
+}
+
+// SetNillableMemberPriceEnabled sets the "member_price_enabled" field if the given value is not nil.
+func (mtuo *MemberTierUpdateOne) SetNillableMemberPriceEnabled(b *bool) *MemberTierUpdateOne {
+	if b != nil {
+		mtuo.SetMemberPriceEnabled(*b)
+	}
+	return mtuo
+}
+
+// SetDescription sets the "description" field.
+func (mtuo *MemberTierUpdateOne) SetDescription(s string) *MemberTierUpdateOne {
+	mtuo.mutation.SetDescription(s)
+	return mtuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (mtuo *MemberTierUpdateOne) SetNillableDescription(s *string) *MemberTierUpdateOne {
+	if s != nil {
+		mtuo.SetDescription(*s)
+	}
+	return mtuo
+}
+
+// Mutation returns the MemberTierMutation object of the builder.
+func (mtuo *MemberTierUpdateOne) Mutation() *MemberTierMutation {
+	return mtuo.mutation
+}
+
+// Where appends a list predicates to the MemberTierUpdate builder.
+func (mtuo *MemberTierUpdateOne) Where(ps ...predicate.MemberTier) *MemberTierUpdateOne {
+	mtuo.mutation.Where(ps...)
+	return mtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mtuo *MemberTierUpdateOne) Select(field string, fields ...string) *MemberTierUpdateOne {
+	mtuo.fields = append([]string{field}, fields...)
+	return mtuo
+}
+
+// Save executes the query and returns the updated MemberTier entity.
+func (mtuo *MemberTierUpdateOne) Save(ctx context.Context) (*MemberTier, error) {
+	if err := mtuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, mtuo.sqlSave, mtuo.mutation, mtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtuo *MemberTierUpdateOne) SaveX(ctx context.Context) *MemberTier {
+	node, err := mtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mtuo *MemberTierUpdateOne) Exec(ctx context.Context) error {
+	_, err := mtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtuo *MemberTierUpdateOne) ExecX(ctx context.Context) {
+	if err := mtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mtuo *MemberTierUpdateOne) defaults() error {
+	if _, ok := mtuo.mutation.UpdatedAt(); !ok {
+		if membertier.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized membertier.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := membertier.UpdateDefaultUpdatedAt()
+		mtuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtuo *MemberTierUpdateOne) check() error {
+	if v, ok := mtuo.mutation.Name(); ok {
+		if err := membertier.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MemberTier.name": %w`, err)}
+		}
+	}
+	if v, ok := mtuo.mutation.Description(); ok {
+		if err := membertier.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "MemberTier.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mtuo *MemberTierUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MemberTierUpdateOne {
+	mtuo.modifiers = append(mtuo.modifiers, modifiers...)
+	return mtuo
+}
+
+func (mtuo *MemberTierUpdateOne) sqlSave(ctx context.Context) (_node *MemberTier, err error) {
+	if err := mtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(membertier.Table, membertier.Columns, sqlgraph.NewFieldSpec(membertier.FieldID, field.TypeUUID))
+	id, ok := mtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MemberTier.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, membertier.FieldID)
+		for _, f := range fields {
+			if !membertier.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != membertier.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(membertier.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mtuo.mutation.DeletedAt(); ok {
+		_spec.SetField(membertier.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := mtuo.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(membertier.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := mtuo.mutation.Name(); ok {
+		_spec.SetField(membertier.FieldName, field.TypeString, value)
+	}
+	if value, ok := mtuo.mutation.Level(); ok {
+		_spec.SetField(membertier.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := mtuo.mutation.AddedLevel(); ok {
+		_spec.AddField(membertier.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := mtuo.mutation.MemberPriceEnabled(); ok {
+		_spec.SetField(membertier.FieldMemberPriceEnabled, field.TypeBool, value)
+	}
+	if value, ok := mtuo.mutation.Description(); ok {
+		_spec.SetField(membertier.FieldDescription, field.TypeString, value)
+	}
+	_spec.AddModifiers(mtuo.modifiers...)
+	_node = &MemberTier{config: mtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membertier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mtuo.mutation.done = true
+	return _node, nil
+}
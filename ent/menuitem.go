@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menu"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menuitem"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
@@ -36,6 +38,8 @@ type MenuItem struct {
 	BasePrice *decimal.Decimal `json:"base_price,omitempty"`
 	// 会员价（可选，单位：令吉）
 	MemberPrice *decimal.Decimal `json:"member_price,omitempty"`
+	// 就餐时段价格（按门店就餐时段和星期覆盖基础价）
+	PeriodPrices domain.PeriodPrices `json:"period_prices,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MenuItemQuery when eager-loading is set.
 	Edges        MenuItemEdges `json:"edges"`
@@ -82,6 +86,8 @@ func (*MenuItem) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case menuitem.FieldBasePrice, menuitem.FieldMemberPrice:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case menuitem.FieldPeriodPrices:
+			values[i] = new([]byte)
 		case menuitem.FieldDeletedAt:
 			values[i] = new(sql.NullInt64)
 		case menuitem.FieldCreatedAt, menuitem.FieldUpdatedAt:
@@ -153,6 +159,14 @@ func (mi *MenuItem) assignValues(columns []string, values []any) error {
 				mi.MemberPrice = new(decimal.Decimal)
 				*mi.MemberPrice = *value.S.(*decimal.Decimal)
 			}
+		case menuitem.FieldPeriodPrices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field period_prices", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mi.PeriodPrices); err != nil {
+					return fmt.Errorf("unmarshal field period_prices: %w", err)
+				}
+			}
 		default:
 			mi.selectValues.Set(columns[i], values[i])
 		}
@@ -223,6 +237,9 @@ func (mi *MenuItem) String() string {
 		builder.WriteString("member_price=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("period_prices=")
+	builder.WriteString(fmt.Sprintf("%v", mi.PeriodPrices))
 	builder.WriteByte(')')
 	return builder.String()
 }
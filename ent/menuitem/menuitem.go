@@ -30,6 +30,8 @@ const (
 	FieldBasePrice = "base_price"
 	// FieldMemberPrice holds the string denoting the member_price field in the database.
 	FieldMemberPrice = "member_price"
+	// FieldPeriodPrices holds the string denoting the period_prices field in the database.
+	FieldPeriodPrices = "period_prices"
 	// EdgeMenu holds the string denoting the menu edge name in mutations.
 	EdgeMenu = "menu"
 	// EdgeProduct holds the string denoting the product edge name in mutations.
@@ -62,6 +64,7 @@ var Columns = []string{
 	FieldProductID,
 	FieldBasePrice,
 	FieldMemberPrice,
+	FieldPeriodPrices,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -352,6 +352,16 @@ func MemberPriceNotNil() predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldNotNull(FieldMemberPrice))
 }
 
+// PeriodPricesIsNil applies the IsNil predicate on the "period_prices" field.
+func PeriodPricesIsNil() predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldIsNull(FieldPeriodPrices))
+}
+
+// PeriodPricesNotNil applies the NotNil predicate on the "period_prices" field.
+func PeriodPricesNotNil() predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldNotNull(FieldPeriodPrices))
+}
+
 // HasMenu applies the HasEdge predicate on the "menu" edge.
 func HasMenu() predicate.MenuItem {
 	return predicate.MenuItem(func(s *sql.Selector) {
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menu"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menuitem"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
@@ -109,6 +110,12 @@ func (mic *MenuItemCreate) SetNillableMemberPrice(d *decimal.Decimal) *MenuItemC
 	return mic
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (mic *MenuItemCreate) SetPeriodPrices(dp domain.PeriodPrices) *MenuItemCreate {
+	mic.mutation.SetPeriodPrices(dp)
+	return mic
+}
+
 // SetID sets the "id" field.
 func (mic *MenuItemCreate) SetID(u uuid.UUID) *MenuItemCreate {
 	mic.mutation.SetID(u)
@@ -215,6 +222,11 @@ func (mic *MenuItemCreate) check() error {
 	if _, ok := mic.mutation.ProductID(); !ok {
 		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "MenuItem.product_id"`)}
 	}
+	if v, ok := mic.mutation.PeriodPrices(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "period_prices", err: fmt.Errorf(`ent: validator failed for field "MenuItem.period_prices": %w`, err)}
+		}
+	}
 	if len(mic.mutation.MenuIDs()) == 0 {
 		return &ValidationError{Name: "menu", err: errors.New(`ent: missing required edge "MenuItem.menu"`)}
 	}
@@ -277,6 +289,10 @@ func (mic *MenuItemCreate) createSpec() (*MenuItem, *sqlgraph.CreateSpec) {
 		_spec.SetField(menuitem.FieldMemberPrice, field.TypeOther, value)
 		_node.MemberPrice = &value
 	}
+	if value, ok := mic.mutation.PeriodPrices(); ok {
+		_spec.SetField(menuitem.FieldPeriodPrices, field.TypeJSON, value)
+		_node.PeriodPrices = value
+	}
 	if nodes := mic.mutation.MenuIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -429,6 +445,24 @@ func (u *MenuItemUpsert) ClearMemberPrice() *MenuItemUpsert {
 	return u
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (u *MenuItemUpsert) SetPeriodPrices(v domain.PeriodPrices) *MenuItemUpsert {
+	u.Set(menuitem.FieldPeriodPrices, v)
+	return u
+}
+
+// UpdatePeriodPrices sets the "period_prices" field to the value that was provided on create.
+func (u *MenuItemUpsert) UpdatePeriodPrices() *MenuItemUpsert {
+	u.SetExcluded(menuitem.FieldPeriodPrices)
+	return u
+}
+
+// ClearPeriodPrices clears the value of the "period_prices" field.
+func (u *MenuItemUpsert) ClearPeriodPrices() *MenuItemUpsert {
+	u.SetNull(menuitem.FieldPeriodPrices)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -563,6 +597,27 @@ func (u *MenuItemUpsertOne) ClearMemberPrice() *MenuItemUpsertOne {
 	})
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (u *MenuItemUpsertOne) SetPeriodPrices(v domain.PeriodPrices) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetPeriodPrices(v)
+	})
+}
+
+// UpdatePeriodPrices sets the "period_prices" field to the value that was provided on create.
+func (u *MenuItemUpsertOne) UpdatePeriodPrices() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdatePeriodPrices()
+	})
+}
+
+// ClearPeriodPrices clears the value of the "period_prices" field.
+func (u *MenuItemUpsertOne) ClearPeriodPrices() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.ClearPeriodPrices()
+	})
+}
+
 // Exec executes the query.
 func (u *MenuItemUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -864,6 +919,27 @@ func (u *MenuItemUpsertBulk) ClearMemberPrice() *MenuItemUpsertBulk {
 	})
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (u *MenuItemUpsertBulk) SetPeriodPrices(v domain.PeriodPrices) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetPeriodPrices(v)
+	})
+}
+
+// UpdatePeriodPrices sets the "period_prices" field to the value that was provided on create.
+func (u *MenuItemUpsertBulk) UpdatePeriodPrices() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdatePeriodPrices()
+	})
+}
+
+// ClearPeriodPrices clears the value of the "period_prices" field.
+func (u *MenuItemUpsertBulk) ClearPeriodPrices() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.ClearPeriodPrices()
+	})
+}
+
 // Exec executes the query.
 func (u *MenuItemUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -10,8 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menuitem"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
 )
@@ -97,6 +99,24 @@ func (miu *MenuItemUpdate) ClearMemberPrice() *MenuItemUpdate {
 	return miu
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (miu *MenuItemUpdate) SetPeriodPrices(dp domain.PeriodPrices) *MenuItemUpdate {
+	miu.mutation.SetPeriodPrices(dp)
+	return miu
+}
+
+// AppendPeriodPrices appends dp to the "period_prices" field.
+func (miu *MenuItemUpdate) AppendPeriodPrices(dp domain.PeriodPrices) *MenuItemUpdate {
+	miu.mutation.AppendPeriodPrices(dp)
+	return miu
+}
+
+// ClearPeriodPrices clears the value of the "period_prices" field.
+func (miu *MenuItemUpdate) ClearPeriodPrices() *MenuItemUpdate {
+	miu.mutation.ClearPeriodPrices()
+	return miu
+}
+
 // Mutation returns the MenuItemMutation object of the builder.
 func (miu *MenuItemUpdate) Mutation() *MenuItemMutation {
 	return miu.mutation
@@ -146,6 +166,11 @@ func (miu *MenuItemUpdate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (miu *MenuItemUpdate) check() error {
+	if v, ok := miu.mutation.PeriodPrices(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "period_prices", err: fmt.Errorf(`ent: validator failed for field "MenuItem.period_prices": %w`, err)}
+		}
+	}
 	if miu.mutation.MenuCleared() && len(miu.mutation.MenuIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "MenuItem.menu"`)
 	}
@@ -194,6 +219,17 @@ func (miu *MenuItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if miu.mutation.MemberPriceCleared() {
 		_spec.ClearField(menuitem.FieldMemberPrice, field.TypeOther)
 	}
+	if value, ok := miu.mutation.PeriodPrices(); ok {
+		_spec.SetField(menuitem.FieldPeriodPrices, field.TypeJSON, value)
+	}
+	if value, ok := miu.mutation.AppendedPeriodPrices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, menuitem.FieldPeriodPrices, value)
+		})
+	}
+	if miu.mutation.PeriodPricesCleared() {
+		_spec.ClearField(menuitem.FieldPeriodPrices, field.TypeJSON)
+	}
 	_spec.AddModifiers(miu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, miu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -283,6 +319,24 @@ func (miuo *MenuItemUpdateOne) ClearMemberPrice() *MenuItemUpdateOne {
 	return miuo
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (miuo *MenuItemUpdateOne) SetPeriodPrices(dp domain.PeriodPrices) *MenuItemUpdateOne {
+	miuo.mutation.SetPeriodPrices(dp)
+	return miuo
+}
+
+// AppendPeriodPrices appends dp to the "period_prices" field.
+func (miuo *MenuItemUpdateOne) AppendPeriodPrices(dp domain.PeriodPrices) *MenuItemUpdateOne {
+	miuo.mutation.AppendPeriodPrices(dp)
+	return miuo
+}
+
+// ClearPeriodPrices clears the value of the "period_prices" field.
+func (miuo *MenuItemUpdateOne) ClearPeriodPrices() *MenuItemUpdateOne {
+	miuo.mutation.ClearPeriodPrices()
+	return miuo
+}
+
 // Mutation returns the MenuItemMutation object of the builder.
 func (miuo *MenuItemUpdateOne) Mutation() *MenuItemMutation {
 	return miuo.mutation
@@ -345,6 +399,11 @@ func (miuo *MenuItemUpdateOne) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (miuo *MenuItemUpdateOne) check() error {
+	if v, ok := miuo.mutation.PeriodPrices(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "period_prices", err: fmt.Errorf(`ent: validator failed for field "MenuItem.period_prices": %w`, err)}
+		}
+	}
 	if miuo.mutation.MenuCleared() && len(miuo.mutation.MenuIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "MenuItem.menu"`)
 	}
@@ -410,6 +469,17 @@ func (miuo *MenuItemUpdateOne) sqlSave(ctx context.Context) (_node *MenuItem, er
 	if miuo.mutation.MemberPriceCleared() {
 		_spec.ClearField(menuitem.FieldMemberPrice, field.TypeOther)
 	}
+	if value, ok := miuo.mutation.PeriodPrices(); ok {
+		_spec.SetField(menuitem.FieldPeriodPrices, field.TypeJSON, value)
+	}
+	if value, ok := miuo.mutation.AppendedPeriodPrices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, menuitem.FieldPeriodPrices, value)
+		})
+	}
+	if miuo.mutation.PeriodPricesCleared() {
+		_spec.ClearField(menuitem.FieldPeriodPrices, field.TypeJSON)
+	}
 	_spec.AddModifiers(miuo.modifiers...)
 	_node = &MenuItem{config: miuo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/menuversion"
+)
+
+// MenuVersion is the model entity for the MenuVersion schema.
+type MenuVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 菜单ID
+	MenuID uuid.UUID `json:"menu_id,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 版本号（同一菜单内递增）
+	Version int `json:"version,omitempty"`
+	// 状态：draft（草稿）、published（已发布）、archived（已归档）
+	Status domain.MenuVersionStatus `json:"status,omitempty"`
+	// 菜单名称
+	Name string `json:"name,omitempty"`
+	// 适用门店ID列表
+	StoreIds []uuid.UUID `json:"store_ids,omitempty"`
+	// 菜单项快照
+	Items domain.MenuVersionItems `json:"items,omitempty"`
+	// 定时发布时间
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+	// 发布时间
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// 定时发布失败原因
+	PublishError string `json:"publish_error,omitempty"`
+	// 最后操作人ID（系统执行时为空）
+	OperatorID uuid.UUID `json:"operator_id,omitempty"`
+	// 最后操作人名称
+	OperatorName string `json:"operator_name,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MenuVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case menuversion.FieldStoreIds, menuversion.FieldItems:
+			values[i] = new([]byte)
+		case menuversion.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case menuversion.FieldStatus, menuversion.FieldName, menuversion.FieldPublishError, menuversion.FieldOperatorName:
+			values[i] = new(sql.NullString)
+		case menuversion.FieldCreatedAt, menuversion.FieldUpdatedAt, menuversion.FieldScheduledAt, menuversion.FieldPublishedAt:
+			values[i] = new(sql.NullTime)
+		case menuversion.FieldID, menuversion.FieldMenuID, menuversion.FieldMerchantID, menuversion.FieldStoreID, menuversion.FieldOperatorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MenuVersion fields.
+func (mv *MenuVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case menuversion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mv.ID = *value
+			}
+		case menuversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mv.CreatedAt = value.Time
+			}
+		case menuversion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mv.UpdatedAt = value.Time
+			}
+		case menuversion.FieldMenuID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field menu_id", values[i])
+			} else if value != nil {
+				mv.MenuID = *value
+			}
+		case menuversion.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				mv.MerchantID = *value
+			}
+		case menuversion.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				mv.StoreID = *value
+			}
+		case menuversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				mv.Version = int(value.Int64)
+			}
+		case menuversion.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				mv.Status = domain.MenuVersionStatus(value.String)
+			}
+		case menuversion.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				mv.Name = value.String
+			}
+		case menuversion.FieldStoreIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field store_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mv.StoreIds); err != nil {
+					return fmt.Errorf("unmarshal field store_ids: %w", err)
+				}
+			}
+		case menuversion.FieldItems:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field items", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mv.Items); err != nil {
+					return fmt.Errorf("unmarshal field items: %w", err)
+				}
+			}
+		case menuversion.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				mv.ScheduledAt = new(time.Time)
+				*mv.ScheduledAt = value.Time
+			}
+		case menuversion.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				mv.PublishedAt = new(time.Time)
+				*mv.PublishedAt = value.Time
+			}
+		case menuversion.FieldPublishError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field publish_error", values[i])
+			} else if value.Valid {
+				mv.PublishError = value.String
+			}
+		case menuversion.FieldOperatorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value != nil {
+				mv.OperatorID = *value
+			}
+		case menuversion.FieldOperatorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_name", values[i])
+			} else if value.Valid {
+				mv.OperatorName = value.String
+			}
+		default:
+			mv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MenuVersion.
+// This includes values selected through modifiers, order, etc.
+func (mv *MenuVersion) Value(name string) (ent.Value, error) {
+	return mv.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MenuVersion.
+// Note that you need to call MenuVersion.Unwrap() before calling this method if this MenuVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mv *MenuVersion) Update() *MenuVersionUpdateOne {
+	return NewMenuVersionClient(mv.config).UpdateOne(mv)
+}
+
+// Unwrap unwraps the MenuVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mv *MenuVersion) Unwrap() *MenuVersion {
+	_tx, ok := mv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MenuVersion is not a transactional entity")
+	}
+	mv.config.driver = _tx.drv
+	return mv
+}
+
+// String implements the fmt.Stringer.
+func (mv *MenuVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("MenuVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mv.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(mv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("menu_id=")
+	builder.WriteString(fmt.Sprintf("%v", mv.MenuID))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", mv.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", mv.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", mv.Version))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", mv.Status))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(mv.Name)
+	builder.WriteString(", ")
+	builder.WriteString("store_ids=")
+	builder.WriteString(fmt.Sprintf("%v", mv.StoreIds))
+	builder.WriteString(", ")
+	builder.WriteString("items=")
+	builder.WriteString(fmt.Sprintf("%v", mv.Items))
+	builder.WriteString(", ")
+	if v := mv.ScheduledAt; v != nil {
+		builder.WriteString("scheduled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := mv.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("publish_error=")
+	builder.WriteString(mv.PublishError)
+	builder.WriteString(", ")
+	builder.WriteString("operator_id=")
+	builder.WriteString(fmt.Sprintf("%v", mv.OperatorID))
+	builder.WriteString(", ")
+	builder.WriteString("operator_name=")
+	builder.WriteString(mv.OperatorName)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MenuVersions is a parsable slice of MenuVersion.
+type MenuVersions []*MenuVersion
@@ -0,0 +1,757 @@
+// Code generated by ent, DO NOT EDIT.
+
+package menuversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MenuID applies equality check predicate on the "menu_id" field. It's identical to MenuIDEQ.
+func MenuID(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldMenuID, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldStoreID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldName, v))
+}
+
+// ScheduledAt applies equality check predicate on the "scheduled_at" field. It's identical to ScheduledAtEQ.
+func ScheduledAt(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldScheduledAt, v))
+}
+
+// PublishedAt applies equality check predicate on the "published_at" field. It's identical to PublishedAtEQ.
+func PublishedAt(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// PublishError applies equality check predicate on the "publish_error" field. It's identical to PublishErrorEQ.
+func PublishError(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldPublishError, v))
+}
+
+// OperatorID applies equality check predicate on the "operator_id" field. It's identical to OperatorIDEQ.
+func OperatorID(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorName applies equality check predicate on the "operator_name" field. It's identical to OperatorNameEQ.
+func OperatorName(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldOperatorName, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MenuIDEQ applies the EQ predicate on the "menu_id" field.
+func MenuIDEQ(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldMenuID, v))
+}
+
+// MenuIDNEQ applies the NEQ predicate on the "menu_id" field.
+func MenuIDNEQ(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldMenuID, v))
+}
+
+// MenuIDIn applies the In predicate on the "menu_id" field.
+func MenuIDIn(vs ...uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldMenuID, vs...))
+}
+
+// MenuIDNotIn applies the NotIn predicate on the "menu_id" field.
+func MenuIDNotIn(vs ...uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldMenuID, vs...))
+}
+
+// MenuIDGT applies the GT predicate on the "menu_id" field.
+func MenuIDGT(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldMenuID, v))
+}
+
+// MenuIDGTE applies the GTE predicate on the "menu_id" field.
+func MenuIDGTE(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldMenuID, v))
+}
+
+// MenuIDLT applies the LT predicate on the "menu_id" field.
+func MenuIDLT(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldMenuID, v))
+}
+
+// MenuIDLTE applies the LTE predicate on the "menu_id" field.
+func MenuIDLTE(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldMenuID, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldStoreID, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v domain.MenuVersionStatus) predicate.MenuVersion {
+	vc := v
+	return predicate.MenuVersion(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v domain.MenuVersionStatus) predicate.MenuVersion {
+	vc := v
+	return predicate.MenuVersion(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...domain.MenuVersionStatus) predicate.MenuVersion {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MenuVersion(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...domain.MenuVersionStatus) predicate.MenuVersion {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MenuVersion(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldContainsFold(FieldName, v))
+}
+
+// StoreIdsIsNil applies the IsNil predicate on the "store_ids" field.
+func StoreIdsIsNil() predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIsNull(FieldStoreIds))
+}
+
+// StoreIdsNotNil applies the NotNil predicate on the "store_ids" field.
+func StoreIdsNotNil() predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotNull(FieldStoreIds))
+}
+
+// ItemsIsNil applies the IsNil predicate on the "items" field.
+func ItemsIsNil() predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIsNull(FieldItems))
+}
+
+// ItemsNotNil applies the NotNil predicate on the "items" field.
+func ItemsNotNil() predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotNull(FieldItems))
+}
+
+// ScheduledAtEQ applies the EQ predicate on the "scheduled_at" field.
+func ScheduledAtEQ(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtNEQ applies the NEQ predicate on the "scheduled_at" field.
+func ScheduledAtNEQ(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtIn applies the In predicate on the "scheduled_at" field.
+func ScheduledAtIn(vs ...time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtNotIn applies the NotIn predicate on the "scheduled_at" field.
+func ScheduledAtNotIn(vs ...time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtGT applies the GT predicate on the "scheduled_at" field.
+func ScheduledAtGT(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldScheduledAt, v))
+}
+
+// ScheduledAtGTE applies the GTE predicate on the "scheduled_at" field.
+func ScheduledAtGTE(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldScheduledAt, v))
+}
+
+// ScheduledAtLT applies the LT predicate on the "scheduled_at" field.
+func ScheduledAtLT(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldScheduledAt, v))
+}
+
+// ScheduledAtLTE applies the LTE predicate on the "scheduled_at" field.
+func ScheduledAtLTE(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldScheduledAt, v))
+}
+
+// ScheduledAtIsNil applies the IsNil predicate on the "scheduled_at" field.
+func ScheduledAtIsNil() predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIsNull(FieldScheduledAt))
+}
+
+// ScheduledAtNotNil applies the NotNil predicate on the "scheduled_at" field.
+func ScheduledAtNotNil() predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotNull(FieldScheduledAt))
+}
+
+// PublishedAtEQ applies the EQ predicate on the "published_at" field.
+func PublishedAtEQ(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtNEQ applies the NEQ predicate on the "published_at" field.
+func PublishedAtNEQ(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtIn applies the In predicate on the "published_at" field.
+func PublishedAtIn(vs ...time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtNotIn applies the NotIn predicate on the "published_at" field.
+func PublishedAtNotIn(vs ...time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtGT applies the GT predicate on the "published_at" field.
+func PublishedAtGT(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldPublishedAt, v))
+}
+
+// PublishedAtGTE applies the GTE predicate on the "published_at" field.
+func PublishedAtGTE(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldPublishedAt, v))
+}
+
+// PublishedAtLT applies the LT predicate on the "published_at" field.
+func PublishedAtLT(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldPublishedAt, v))
+}
+
+// PublishedAtLTE applies the LTE predicate on the "published_at" field.
+func PublishedAtLTE(v time.Time) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldPublishedAt, v))
+}
+
+// PublishedAtIsNil applies the IsNil predicate on the "published_at" field.
+func PublishedAtIsNil() predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIsNull(FieldPublishedAt))
+}
+
+// PublishedAtNotNil applies the NotNil predicate on the "published_at" field.
+func PublishedAtNotNil() predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotNull(FieldPublishedAt))
+}
+
+// PublishErrorEQ applies the EQ predicate on the "publish_error" field.
+func PublishErrorEQ(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldPublishError, v))
+}
+
+// PublishErrorNEQ applies the NEQ predicate on the "publish_error" field.
+func PublishErrorNEQ(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldPublishError, v))
+}
+
+// PublishErrorIn applies the In predicate on the "publish_error" field.
+func PublishErrorIn(vs ...string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldPublishError, vs...))
+}
+
+// PublishErrorNotIn applies the NotIn predicate on the "publish_error" field.
+func PublishErrorNotIn(vs ...string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldPublishError, vs...))
+}
+
+// PublishErrorGT applies the GT predicate on the "publish_error" field.
+func PublishErrorGT(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldPublishError, v))
+}
+
+// PublishErrorGTE applies the GTE predicate on the "publish_error" field.
+func PublishErrorGTE(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldPublishError, v))
+}
+
+// PublishErrorLT applies the LT predicate on the "publish_error" field.
+func PublishErrorLT(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldPublishError, v))
+}
+
+// PublishErrorLTE applies the LTE predicate on the "publish_error" field.
+func PublishErrorLTE(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldPublishError, v))
+}
+
+// PublishErrorContains applies the Contains predicate on the "publish_error" field.
+func PublishErrorContains(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldContains(FieldPublishError, v))
+}
+
+// PublishErrorHasPrefix applies the HasPrefix predicate on the "publish_error" field.
+func PublishErrorHasPrefix(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldHasPrefix(FieldPublishError, v))
+}
+
+// PublishErrorHasSuffix applies the HasSuffix predicate on the "publish_error" field.
+func PublishErrorHasSuffix(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldHasSuffix(FieldPublishError, v))
+}
+
+// PublishErrorEqualFold applies the EqualFold predicate on the "publish_error" field.
+func PublishErrorEqualFold(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEqualFold(FieldPublishError, v))
+}
+
+// PublishErrorContainsFold applies the ContainsFold predicate on the "publish_error" field.
+func PublishErrorContainsFold(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldContainsFold(FieldPublishError, v))
+}
+
+// OperatorIDEQ applies the EQ predicate on the "operator_id" field.
+func OperatorIDEQ(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorIDNEQ applies the NEQ predicate on the "operator_id" field.
+func OperatorIDNEQ(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldOperatorID, v))
+}
+
+// OperatorIDIn applies the In predicate on the "operator_id" field.
+func OperatorIDIn(vs ...uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDNotIn applies the NotIn predicate on the "operator_id" field.
+func OperatorIDNotIn(vs ...uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDGT applies the GT predicate on the "operator_id" field.
+func OperatorIDGT(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldOperatorID, v))
+}
+
+// OperatorIDGTE applies the GTE predicate on the "operator_id" field.
+func OperatorIDGTE(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldOperatorID, v))
+}
+
+// OperatorIDLT applies the LT predicate on the "operator_id" field.
+func OperatorIDLT(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldOperatorID, v))
+}
+
+// OperatorIDLTE applies the LTE predicate on the "operator_id" field.
+func OperatorIDLTE(v uuid.UUID) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldOperatorID, v))
+}
+
+// OperatorNameEQ applies the EQ predicate on the "operator_name" field.
+func OperatorNameEQ(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEQ(FieldOperatorName, v))
+}
+
+// OperatorNameNEQ applies the NEQ predicate on the "operator_name" field.
+func OperatorNameNEQ(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNEQ(FieldOperatorName, v))
+}
+
+// OperatorNameIn applies the In predicate on the "operator_name" field.
+func OperatorNameIn(vs ...string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldIn(FieldOperatorName, vs...))
+}
+
+// OperatorNameNotIn applies the NotIn predicate on the "operator_name" field.
+func OperatorNameNotIn(vs ...string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldNotIn(FieldOperatorName, vs...))
+}
+
+// OperatorNameGT applies the GT predicate on the "operator_name" field.
+func OperatorNameGT(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGT(FieldOperatorName, v))
+}
+
+// OperatorNameGTE applies the GTE predicate on the "operator_name" field.
+func OperatorNameGTE(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldGTE(FieldOperatorName, v))
+}
+
+// OperatorNameLT applies the LT predicate on the "operator_name" field.
+func OperatorNameLT(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLT(FieldOperatorName, v))
+}
+
+// OperatorNameLTE applies the LTE predicate on the "operator_name" field.
+func OperatorNameLTE(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldLTE(FieldOperatorName, v))
+}
+
+// OperatorNameContains applies the Contains predicate on the "operator_name" field.
+func OperatorNameContains(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldContains(FieldOperatorName, v))
+}
+
+// OperatorNameHasPrefix applies the HasPrefix predicate on the "operator_name" field.
+func OperatorNameHasPrefix(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldHasPrefix(FieldOperatorName, v))
+}
+
+// OperatorNameHasSuffix applies the HasSuffix predicate on the "operator_name" field.
+func OperatorNameHasSuffix(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldHasSuffix(FieldOperatorName, v))
+}
+
+// OperatorNameEqualFold applies the EqualFold predicate on the "operator_name" field.
+func OperatorNameEqualFold(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldEqualFold(FieldOperatorName, v))
+}
+
+// OperatorNameContainsFold applies the ContainsFold predicate on the "operator_name" field.
+func OperatorNameContainsFold(v string) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.FieldContainsFold(FieldOperatorName, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MenuVersion) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MenuVersion) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MenuVersion) predicate.MenuVersion {
+	return predicate.MenuVersion(sql.NotPredicates(p))
+}
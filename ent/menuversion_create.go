@@ -0,0 +1,1270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/menuversion"
+)
+
+// MenuVersionCreate is the builder for creating a MenuVersion entity.
+type MenuVersionCreate struct {
+	config
+	mutation *MenuVersionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mvc *MenuVersionCreate) SetCreatedAt(t time.Time) *MenuVersionCreate {
+	mvc.mutation.SetCreatedAt(t)
+	return mvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mvc *MenuVersionCreate) SetNillableCreatedAt(t *time.Time) *MenuVersionCreate {
+	if t != nil {
+		mvc.SetCreatedAt(*t)
+	}
+	return mvc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mvc *MenuVersionCreate) SetUpdatedAt(t time.Time) *MenuVersionCreate {
+	mvc.mutation.SetUpdatedAt(t)
+	return mvc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mvc *MenuVersionCreate) SetNillableUpdatedAt(t *time.Time) *MenuVersionCreate {
+	if t != nil {
+		mvc.SetUpdatedAt(*t)
+	}
+	return mvc
+}
+
+// SetMenuID sets the "menu_id" field.
+func (mvc *MenuVersionCreate) SetMenuID(u uuid.UUID) *MenuVersionCreate {
+	mvc.mutation.SetMenuID(u)
+	return mvc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (mvc *MenuVersionCreate) SetMerchantID(u uuid.UUID) *MenuVersionCreate {
+	mvc.mutation.SetMerchantID(u)
+	return mvc
+}
+
+// SetStoreID sets the "store_id" field.
+func (mvc *MenuVersionCreate) SetStoreID(u uuid.UUID) *MenuVersionCreate {
+	mvc.mutation.SetStoreID(u)
+	return mvc
+}
+
+// SetNillableStoreID sets the "store_id" field if the given value is not nil.
+func (mvc *MenuVersionCreate) SetNillableStoreID(u *uuid.UUID) *MenuVersionCreate {
+	if u != nil {
+		mvc.SetStoreID(*u)
+	}
+	return mvc
+}
+
+// SetVersion sets the "version" field.
+func (mvc *MenuVersionCreate) SetVersion(i int) *MenuVersionCreate {
+	mvc.mutation.SetVersion(i)
+	return mvc
+}
+
+// SetStatus sets the "status" field.
+func (mvc *MenuVersionCreate) SetStatus(dvs domain.MenuVersionStatus) *MenuVersionCreate {
+	mvc.mutation.SetStatus(dvs)
+	return mvc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mvc *MenuVersionCreate) SetNillableStatus(dvs *domain.MenuVersionStatus) *MenuVersionCreate {
+	if dvs != nil {
+		mvc.SetStatus(*dvs)
+	}
+	return mvc
+}
+
+// SetName sets the "name" field.
+func (mvc *MenuVersionCreate) SetName(s string) *MenuVersionCreate {
+	mvc.mutation.SetName(s)
+	return mvc
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (mvc *MenuVersionCreate) SetStoreIds(u []uuid.UUID) *MenuVersionCreate {
+	mvc.mutation.SetStoreIds(u)
+	return mvc
+}
+
+// SetItems sets the "items" field.
+func (mvc *MenuVersionCreate) SetItems(dvi domain.MenuVersionItems) *MenuVersionCreate {
+	mvc.mutation.SetItems(dvi)
+	return mvc
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (mvc *MenuVersionCreate) SetScheduledAt(t time.Time) *MenuVersionCreate {
+	mvc.mutation.SetScheduledAt(t)
+	return mvc
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (mvc *MenuVersionCreate) SetNillableScheduledAt(t *time.Time) *MenuVersionCreate {
+	if t != nil {
+		mvc.SetScheduledAt(*t)
+	}
+	return mvc
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (mvc *MenuVersionCreate) SetPublishedAt(t time.Time) *MenuVersionCreate {
+	mvc.mutation.SetPublishedAt(t)
+	return mvc
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (mvc *MenuVersionCreate) SetNillablePublishedAt(t *time.Time) *MenuVersionCreate {
+	if t != nil {
+		mvc.SetPublishedAt(*t)
+	}
+	return mvc
+}
+
+// SetPublishError sets the "publish_error" field.
+func (mvc *MenuVersionCreate) SetPublishError(s string) *MenuVersionCreate {
+	mvc.mutation.SetPublishError(s)
+	return mvc
+}
+
+// SetNillablePublishError sets the "publish_error" field if the given value is not nil.
+func (mvc *MenuVersionCreate) SetNillablePublishError(s *string) *MenuVersionCreate {
+	if s != nil {
+		mvc.SetPublishError(*s)
+	}
+	return mvc
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (mvc *MenuVersionCreate) SetOperatorID(u uuid.UUID) *MenuVersionCreate {
+	mvc.mutation.SetOperatorID(u)
+	return mvc
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (mvc *MenuVersionCreate) SetNillableOperatorID(u *uuid.UUID) *MenuVersionCreate {
+	if u != nil {
+		mvc.SetOperatorID(*u)
+	}
+	return mvc
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (mvc *MenuVersionCreate) SetOperatorName(s string) *MenuVersionCreate {
+	mvc.mutation.SetOperatorName(s)
+	return mvc
+}
+
+// SetNillableOperatorName sets the "operator_name" field if the given value is not nil.
+func (mvc *MenuVersionCreate) SetNillableOperatorName(s *string) *MenuVersionCreate {
+	if s != nil {
+		mvc.SetOperatorName(*s)
+	}
+	return mvc
+}
+
+// SetID sets the "id" field.
+func (mvc *MenuVersionCreate) SetID(u uuid.UUID) *MenuVersionCreate {
+	mvc.mutation.SetID(u)
+	return mvc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mvc *MenuVersionCreate) SetNillableID(u *uuid.UUID) *MenuVersionCreate {
+	if u != nil {
+		mvc.SetID(*u)
+	}
+	return mvc
+}
+
+// Mutation returns the MenuVersionMutation object of the builder.
+func (mvc *MenuVersionCreate) Mutation() *MenuVersionMutation {
+	return mvc.mutation
+}
+
+// Save creates the MenuVersion in the database.
+func (mvc *MenuVersionCreate) Save(ctx context.Context) (*MenuVersion, error) {
+	mvc.defaults()
+	return withHooks(ctx, mvc.sqlSave, mvc.mutation, mvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mvc *MenuVersionCreate) SaveX(ctx context.Context) *MenuVersion {
+	v, err := mvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mvc *MenuVersionCreate) Exec(ctx context.Context) error {
+	_, err := mvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvc *MenuVersionCreate) ExecX(ctx context.Context) {
+	if err := mvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mvc *MenuVersionCreate) defaults() {
+	if _, ok := mvc.mutation.CreatedAt(); !ok {
+		v := menuversion.DefaultCreatedAt()
+		mvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mvc.mutation.UpdatedAt(); !ok {
+		v := menuversion.DefaultUpdatedAt()
+		mvc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mvc.mutation.StoreID(); !ok {
+		v := menuversion.DefaultStoreID()
+		mvc.mutation.SetStoreID(v)
+	}
+	if _, ok := mvc.mutation.Status(); !ok {
+		v := menuversion.DefaultStatus
+		mvc.mutation.SetStatus(v)
+	}
+	if _, ok := mvc.mutation.PublishError(); !ok {
+		v := menuversion.DefaultPublishError
+		mvc.mutation.SetPublishError(v)
+	}
+	if _, ok := mvc.mutation.OperatorID(); !ok {
+		v := menuversion.DefaultOperatorID()
+		mvc.mutation.SetOperatorID(v)
+	}
+	if _, ok := mvc.mutation.OperatorName(); !ok {
+		v := menuversion.DefaultOperatorName
+		mvc.mutation.SetOperatorName(v)
+	}
+	if _, ok := mvc.mutation.ID(); !ok {
+		v := menuversion.DefaultID()
+		mvc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mvc *MenuVersionCreate) check() error {
+	if _, ok := mvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MenuVersion.created_at"`)}
+	}
+	if _, ok := mvc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MenuVersion.updated_at"`)}
+	}
+	if _, ok := mvc.mutation.MenuID(); !ok {
+		return &ValidationError{Name: "menu_id", err: errors.New(`ent: missing required field "MenuVersion.menu_id"`)}
+	}
+	if _, ok := mvc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "MenuVersion.merchant_id"`)}
+	}
+	if _, ok := mvc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "MenuVersion.store_id"`)}
+	}
+	if _, ok := mvc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "MenuVersion.version"`)}
+	}
+	if _, ok := mvc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "MenuVersion.status"`)}
+	}
+	if v, ok := mvc.mutation.Status(); ok {
+		if err := menuversion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.status": %w`, err)}
+		}
+	}
+	if _, ok := mvc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "MenuVersion.name"`)}
+	}
+	if v, ok := mvc.mutation.Name(); ok {
+		if err := menuversion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.name": %w`, err)}
+		}
+	}
+	if _, ok := mvc.mutation.PublishError(); !ok {
+		return &ValidationError{Name: "publish_error", err: errors.New(`ent: missing required field "MenuVersion.publish_error"`)}
+	}
+	if v, ok := mvc.mutation.PublishError(); ok {
+		if err := menuversion.PublishErrorValidator(v); err != nil {
+			return &ValidationError{Name: "publish_error", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.publish_error": %w`, err)}
+		}
+	}
+	if _, ok := mvc.mutation.OperatorID(); !ok {
+		return &ValidationError{Name: "operator_id", err: errors.New(`ent: missing required field "MenuVersion.operator_id"`)}
+	}
+	if _, ok := mvc.mutation.OperatorName(); !ok {
+		return &ValidationError{Name: "operator_name", err: errors.New(`ent: missing required field "MenuVersion.operator_name"`)}
+	}
+	if v, ok := mvc.mutation.OperatorName(); ok {
+		if err := menuversion.OperatorNameValidator(v); err != nil {
+			return &ValidationError{Name: "operator_name", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.operator_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mvc *MenuVersionCreate) sqlSave(ctx context.Context) (*MenuVersion, error) {
+	if err := mvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mvc.mutation.id = &_node.ID
+	mvc.mutation.done = true
+	return _node, nil
+}
+
+func (mvc *MenuVersionCreate) createSpec() (*MenuVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MenuVersion{config: mvc.config}
+		_spec = sqlgraph.NewCreateSpec(menuversion.Table, sqlgraph.NewFieldSpec(menuversion.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mvc.conflict
+	if id, ok := mvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mvc.mutation.CreatedAt(); ok {
+		_spec.SetField(menuversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mvc.mutation.UpdatedAt(); ok {
+		_spec.SetField(menuversion.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mvc.mutation.MenuID(); ok {
+		_spec.SetField(menuversion.FieldMenuID, field.TypeUUID, value)
+		_node.MenuID = value
+	}
+	if value, ok := mvc.mutation.MerchantID(); ok {
+		_spec.SetField(menuversion.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := mvc.mutation.StoreID(); ok {
+		_spec.SetField(menuversion.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := mvc.mutation.Version(); ok {
+		_spec.SetField(menuversion.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := mvc.mutation.Status(); ok {
+		_spec.SetField(menuversion.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := mvc.mutation.Name(); ok {
+		_spec.SetField(menuversion.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := mvc.mutation.StoreIds(); ok {
+		_spec.SetField(menuversion.FieldStoreIds, field.TypeJSON, value)
+		_node.StoreIds = value
+	}
+	if value, ok := mvc.mutation.Items(); ok {
+		_spec.SetField(menuversion.FieldItems, field.TypeJSON, value)
+		_node.Items = value
+	}
+	if value, ok := mvc.mutation.ScheduledAt(); ok {
+		_spec.SetField(menuversion.FieldScheduledAt, field.TypeTime, value)
+		_node.ScheduledAt = &value
+	}
+	if value, ok := mvc.mutation.PublishedAt(); ok {
+		_spec.SetField(menuversion.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if value, ok := mvc.mutation.PublishError(); ok {
+		_spec.SetField(menuversion.FieldPublishError, field.TypeString, value)
+		_node.PublishError = value
+	}
+	if value, ok := mvc.mutation.OperatorID(); ok {
+		_spec.SetField(menuversion.FieldOperatorID, field.TypeUUID, value)
+		_node.OperatorID = value
+	}
+	if value, ok := mvc.mutation.OperatorName(); ok {
+		_spec.SetField(menuversion.FieldOperatorName, field.TypeString, value)
+		_node.OperatorName = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MenuVersion.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MenuVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mvc *MenuVersionCreate) OnConflict(opts ...sql.ConflictOption) *MenuVersionUpsertOne {
+	mvc.conflict = opts
+	return &MenuVersionUpsertOne{
+		create: mvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MenuVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mvc *MenuVersionCreate) OnConflictColumns(columns ...string) *MenuVersionUpsertOne {
+	mvc.conflict = append(mvc.conflict, sql.ConflictColumns(columns...))
+	return &MenuVersionUpsertOne{
+		create: mvc,
+	}
+}
+
+type (
+	// MenuVersionUpsertOne is the builder for "upsert"-ing
+	//  one MenuVersion node.
+	MenuVersionUpsertOne struct {
+		create *MenuVersionCreate
+	}
+
+	// MenuVersionUpsert is the "OnConflict" setter.
+	MenuVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MenuVersionUpsert) SetUpdatedAt(v time.Time) *MenuVersionUpsert {
+	u.Set(menuversion.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MenuVersionUpsert) UpdateUpdatedAt() *MenuVersionUpsert {
+	u.SetExcluded(menuversion.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *MenuVersionUpsert) SetStatus(v domain.MenuVersionStatus) *MenuVersionUpsert {
+	u.Set(menuversion.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *MenuVersionUpsert) UpdateStatus() *MenuVersionUpsert {
+	u.SetExcluded(menuversion.FieldStatus)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *MenuVersionUpsert) SetName(v string) *MenuVersionUpsert {
+	u.Set(menuversion.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MenuVersionUpsert) UpdateName() *MenuVersionUpsert {
+	u.SetExcluded(menuversion.FieldName)
+	return u
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *MenuVersionUpsert) SetStoreIds(v []uuid.UUID) *MenuVersionUpsert {
+	u.Set(menuversion.FieldStoreIds, v)
+	return u
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *MenuVersionUpsert) UpdateStoreIds() *MenuVersionUpsert {
+	u.SetExcluded(menuversion.FieldStoreIds)
+	return u
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *MenuVersionUpsert) ClearStoreIds() *MenuVersionUpsert {
+	u.SetNull(menuversion.FieldStoreIds)
+	return u
+}
+
+// SetItems sets the "items" field.
+func (u *MenuVersionUpsert) SetItems(v domain.MenuVersionItems) *MenuVersionUpsert {
+	u.Set(menuversion.FieldItems, v)
+	return u
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *MenuVersionUpsert) UpdateItems() *MenuVersionUpsert {
+	u.SetExcluded(menuversion.FieldItems)
+	return u
+}
+
+// ClearItems clears the value of the "items" field.
+func (u *MenuVersionUpsert) ClearItems() *MenuVersionUpsert {
+	u.SetNull(menuversion.FieldItems)
+	return u
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *MenuVersionUpsert) SetScheduledAt(v time.Time) *MenuVersionUpsert {
+	u.Set(menuversion.FieldScheduledAt, v)
+	return u
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *MenuVersionUpsert) UpdateScheduledAt() *MenuVersionUpsert {
+	u.SetExcluded(menuversion.FieldScheduledAt)
+	return u
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (u *MenuVersionUpsert) ClearScheduledAt() *MenuVersionUpsert {
+	u.SetNull(menuversion.FieldScheduledAt)
+	return u
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *MenuVersionUpsert) SetPublishedAt(v time.Time) *MenuVersionUpsert {
+	u.Set(menuversion.FieldPublishedAt, v)
+	return u
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *MenuVersionUpsert) UpdatePublishedAt() *MenuVersionUpsert {
+	u.SetExcluded(menuversion.FieldPublishedAt)
+	return u
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *MenuVersionUpsert) ClearPublishedAt() *MenuVersionUpsert {
+	u.SetNull(menuversion.FieldPublishedAt)
+	return u
+}
+
+// SetPublishError sets the "publish_error" field.
+func (u *MenuVersionUpsert) SetPublishError(v string) *MenuVersionUpsert {
+	u.Set(menuversion.FieldPublishError, v)
+	return u
+}
+
+// UpdatePublishError sets the "publish_error" field to the value that was provided on create.
+func (u *MenuVersionUpsert) UpdatePublishError() *MenuVersionUpsert {
+	u.SetExcluded(menuversion.FieldPublishError)
+	return u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *MenuVersionUpsert) SetOperatorID(v uuid.UUID) *MenuVersionUpsert {
+	u.Set(menuversion.FieldOperatorID, v)
+	return u
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *MenuVersionUpsert) UpdateOperatorID() *MenuVersionUpsert {
+	u.SetExcluded(menuversion.FieldOperatorID)
+	return u
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (u *MenuVersionUpsert) SetOperatorName(v string) *MenuVersionUpsert {
+	u.Set(menuversion.FieldOperatorName, v)
+	return u
+}
+
+// UpdateOperatorName sets the "operator_name" field to the value that was provided on create.
+func (u *MenuVersionUpsert) UpdateOperatorName() *MenuVersionUpsert {
+	u.SetExcluded(menuversion.FieldOperatorName)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MenuVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(menuversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MenuVersionUpsertOne) UpdateNewValues() *MenuVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(menuversion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(menuversion.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MenuID(); exists {
+			s.SetIgnore(menuversion.FieldMenuID)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(menuversion.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(menuversion.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.Version(); exists {
+			s.SetIgnore(menuversion.FieldVersion)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MenuVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MenuVersionUpsertOne) Ignore() *MenuVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MenuVersionUpsertOne) DoNothing() *MenuVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MenuVersionCreate.OnConflict
+// documentation for more info.
+func (u *MenuVersionUpsertOne) Update(set func(*MenuVersionUpsert)) *MenuVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MenuVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MenuVersionUpsertOne) SetUpdatedAt(v time.Time) *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MenuVersionUpsertOne) UpdateUpdatedAt() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *MenuVersionUpsertOne) SetStatus(v domain.MenuVersionStatus) *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *MenuVersionUpsertOne) UpdateStatus() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MenuVersionUpsertOne) SetName(v string) *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MenuVersionUpsertOne) UpdateName() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *MenuVersionUpsertOne) SetStoreIds(v []uuid.UUID) *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetStoreIds(v)
+	})
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *MenuVersionUpsertOne) UpdateStoreIds() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateStoreIds()
+	})
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *MenuVersionUpsertOne) ClearStoreIds() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.ClearStoreIds()
+	})
+}
+
+// SetItems sets the "items" field.
+func (u *MenuVersionUpsertOne) SetItems(v domain.MenuVersionItems) *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetItems(v)
+	})
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *MenuVersionUpsertOne) UpdateItems() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateItems()
+	})
+}
+
+// ClearItems clears the value of the "items" field.
+func (u *MenuVersionUpsertOne) ClearItems() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.ClearItems()
+	})
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *MenuVersionUpsertOne) SetScheduledAt(v time.Time) *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetScheduledAt(v)
+	})
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *MenuVersionUpsertOne) UpdateScheduledAt() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateScheduledAt()
+	})
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (u *MenuVersionUpsertOne) ClearScheduledAt() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.ClearScheduledAt()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *MenuVersionUpsertOne) SetPublishedAt(v time.Time) *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *MenuVersionUpsertOne) UpdatePublishedAt() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *MenuVersionUpsertOne) ClearPublishedAt() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// SetPublishError sets the "publish_error" field.
+func (u *MenuVersionUpsertOne) SetPublishError(v string) *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetPublishError(v)
+	})
+}
+
+// UpdatePublishError sets the "publish_error" field to the value that was provided on create.
+func (u *MenuVersionUpsertOne) UpdatePublishError() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdatePublishError()
+	})
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *MenuVersionUpsertOne) SetOperatorID(v uuid.UUID) *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetOperatorID(v)
+	})
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *MenuVersionUpsertOne) UpdateOperatorID() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateOperatorID()
+	})
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (u *MenuVersionUpsertOne) SetOperatorName(v string) *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetOperatorName(v)
+	})
+}
+
+// UpdateOperatorName sets the "operator_name" field to the value that was provided on create.
+func (u *MenuVersionUpsertOne) UpdateOperatorName() *MenuVersionUpsertOne {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateOperatorName()
+	})
+}
+
+// Exec executes the query.
+func (u *MenuVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MenuVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MenuVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MenuVersionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MenuVersionUpsertOne.ID is not supported by MySQL driver. Use MenuVersionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MenuVersionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MenuVersionCreateBulk is the builder for creating many MenuVersion entities in bulk.
+type MenuVersionCreateBulk struct {
+	config
+	err      error
+	builders []*MenuVersionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MenuVersion entities in the database.
+func (mvcb *MenuVersionCreateBulk) Save(ctx context.Context) ([]*MenuVersion, error) {
+	if mvcb.err != nil {
+		return nil, mvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mvcb.builders))
+	nodes := make([]*MenuVersion, len(mvcb.builders))
+	mutators := make([]Mutator, len(mvcb.builders))
+	for i := range mvcb.builders {
+		func(i int, root context.Context) {
+			builder := mvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MenuVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mvcb *MenuVersionCreateBulk) SaveX(ctx context.Context) []*MenuVersion {
+	v, err := mvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mvcb *MenuVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvcb *MenuVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := mvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MenuVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MenuVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mvcb *MenuVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *MenuVersionUpsertBulk {
+	mvcb.conflict = opts
+	return &MenuVersionUpsertBulk{
+		create: mvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MenuVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mvcb *MenuVersionCreateBulk) OnConflictColumns(columns ...string) *MenuVersionUpsertBulk {
+	mvcb.conflict = append(mvcb.conflict, sql.ConflictColumns(columns...))
+	return &MenuVersionUpsertBulk{
+		create: mvcb,
+	}
+}
+
+// MenuVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of MenuVersion nodes.
+type MenuVersionUpsertBulk struct {
+	create *MenuVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MenuVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(menuversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MenuVersionUpsertBulk) UpdateNewValues() *MenuVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(menuversion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(menuversion.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MenuID(); exists {
+				s.SetIgnore(menuversion.FieldMenuID)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(menuversion.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(menuversion.FieldStoreID)
+			}
+			if _, exists := b.mutation.Version(); exists {
+				s.SetIgnore(menuversion.FieldVersion)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MenuVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MenuVersionUpsertBulk) Ignore() *MenuVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MenuVersionUpsertBulk) DoNothing() *MenuVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MenuVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *MenuVersionUpsertBulk) Update(set func(*MenuVersionUpsert)) *MenuVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MenuVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MenuVersionUpsertBulk) SetUpdatedAt(v time.Time) *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MenuVersionUpsertBulk) UpdateUpdatedAt() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *MenuVersionUpsertBulk) SetStatus(v domain.MenuVersionStatus) *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *MenuVersionUpsertBulk) UpdateStatus() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MenuVersionUpsertBulk) SetName(v string) *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MenuVersionUpsertBulk) UpdateName() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *MenuVersionUpsertBulk) SetStoreIds(v []uuid.UUID) *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetStoreIds(v)
+	})
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *MenuVersionUpsertBulk) UpdateStoreIds() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateStoreIds()
+	})
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *MenuVersionUpsertBulk) ClearStoreIds() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.ClearStoreIds()
+	})
+}
+
+// SetItems sets the "items" field.
+func (u *MenuVersionUpsertBulk) SetItems(v domain.MenuVersionItems) *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetItems(v)
+	})
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *MenuVersionUpsertBulk) UpdateItems() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateItems()
+	})
+}
+
+// ClearItems clears the value of the "items" field.
+func (u *MenuVersionUpsertBulk) ClearItems() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.ClearItems()
+	})
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *MenuVersionUpsertBulk) SetScheduledAt(v time.Time) *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetScheduledAt(v)
+	})
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *MenuVersionUpsertBulk) UpdateScheduledAt() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateScheduledAt()
+	})
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (u *MenuVersionUpsertBulk) ClearScheduledAt() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.ClearScheduledAt()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *MenuVersionUpsertBulk) SetPublishedAt(v time.Time) *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *MenuVersionUpsertBulk) UpdatePublishedAt() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *MenuVersionUpsertBulk) ClearPublishedAt() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// SetPublishError sets the "publish_error" field.
+func (u *MenuVersionUpsertBulk) SetPublishError(v string) *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetPublishError(v)
+	})
+}
+
+// UpdatePublishError sets the "publish_error" field to the value that was provided on create.
+func (u *MenuVersionUpsertBulk) UpdatePublishError() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdatePublishError()
+	})
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *MenuVersionUpsertBulk) SetOperatorID(v uuid.UUID) *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetOperatorID(v)
+	})
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *MenuVersionUpsertBulk) UpdateOperatorID() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateOperatorID()
+	})
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (u *MenuVersionUpsertBulk) SetOperatorName(v string) *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.SetOperatorName(v)
+	})
+}
+
+// UpdateOperatorName sets the "operator_name" field to the value that was provided on create.
+func (u *MenuVersionUpsertBulk) UpdateOperatorName() *MenuVersionUpsertBulk {
+	return u.Update(func(s *MenuVersionUpsert) {
+		s.UpdateOperatorName()
+	})
+}
+
+// Exec executes the query.
+func (u *MenuVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MenuVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MenuVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MenuVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/menuversion"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MenuVersionDelete is the builder for deleting a MenuVersion entity.
+type MenuVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *MenuVersionMutation
+}
+
+// Where appends a list predicates to the MenuVersionDelete builder.
+func (mvd *MenuVersionDelete) Where(ps ...predicate.MenuVersion) *MenuVersionDelete {
+	mvd.mutation.Where(ps...)
+	return mvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mvd *MenuVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mvd.sqlExec, mvd.mutation, mvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvd *MenuVersionDelete) ExecX(ctx context.Context) int {
+	n, err := mvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mvd *MenuVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(menuversion.Table, sqlgraph.NewFieldSpec(menuversion.FieldID, field.TypeUUID))
+	if ps := mvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mvd.mutation.done = true
+	return affected, err
+}
+
+// MenuVersionDeleteOne is the builder for deleting a single MenuVersion entity.
+type MenuVersionDeleteOne struct {
+	mvd *MenuVersionDelete
+}
+
+// Where appends a list predicates to the MenuVersionDelete builder.
+func (mvdo *MenuVersionDeleteOne) Where(ps ...predicate.MenuVersion) *MenuVersionDeleteOne {
+	mvdo.mvd.mutation.Where(ps...)
+	return mvdo
+}
+
+// Exec executes the deletion query.
+func (mvdo *MenuVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := mvdo.mvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{menuversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvdo *MenuVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := mvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
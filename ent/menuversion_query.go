@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/menuversion"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MenuVersionQuery is the builder for querying MenuVersion entities.
+type MenuVersionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []menuversion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MenuVersion
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MenuVersionQuery builder.
+func (mvq *MenuVersionQuery) Where(ps ...predicate.MenuVersion) *MenuVersionQuery {
+	mvq.predicates = append(mvq.predicates, ps...)
+	return mvq
+}
+
+// Limit the number of records to be returned by this query.
+func (mvq *MenuVersionQuery) Limit(limit int) *MenuVersionQuery {
+	mvq.ctx.Limit = &limit
+	return mvq
+}
+
+// Offset to start from.
+func (mvq *MenuVersionQuery) Offset(offset int) *MenuVersionQuery {
+	mvq.ctx.Offset = &offset
+	return mvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mvq *MenuVersionQuery) Unique(unique bool) *MenuVersionQuery {
+	mvq.ctx.Unique = &unique
+	return mvq
+}
+
+// Order specifies how the records should be ordered.
+func (mvq *MenuVersionQuery) Order(o ...menuversion.OrderOption) *MenuVersionQuery {
+	mvq.order = append(mvq.order, o...)
+	return mvq
+}
+
+// First returns the first MenuVersion entity from the query.
+// Returns a *NotFoundError when no MenuVersion was found.
+func (mvq *MenuVersionQuery) First(ctx context.Context) (*MenuVersion, error) {
+	nodes, err := mvq.Limit(1).All(setContextOp(ctx, mvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{menuversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mvq *MenuVersionQuery) FirstX(ctx context.Context) *MenuVersion {
+	node, err := mvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MenuVersion ID from the query.
+// Returns a *NotFoundError when no MenuVersion ID was found.
+func (mvq *MenuVersionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mvq.Limit(1).IDs(setContextOp(ctx, mvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{menuversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mvq *MenuVersionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MenuVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MenuVersion entity is found.
+// Returns a *NotFoundError when no MenuVersion entities are found.
+func (mvq *MenuVersionQuery) Only(ctx context.Context) (*MenuVersion, error) {
+	nodes, err := mvq.Limit(2).All(setContextOp(ctx, mvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{menuversion.Label}
+	default:
+		return nil, &NotSingularError{menuversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mvq *MenuVersionQuery) OnlyX(ctx context.Context) *MenuVersion {
+	node, err := mvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MenuVersion ID in the query.
+// Returns a *NotSingularError when more than one MenuVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mvq *MenuVersionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mvq.Limit(2).IDs(setContextOp(ctx, mvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{menuversion.Label}
+	default:
+		err = &NotSingularError{menuversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mvq *MenuVersionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MenuVersions.
+func (mvq *MenuVersionQuery) All(ctx context.Context) ([]*MenuVersion, error) {
+	ctx = setContextOp(ctx, mvq.ctx, ent.OpQueryAll)
+	if err := mvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MenuVersion, *MenuVersionQuery]()
+	return withInterceptors[[]*MenuVersion](ctx, mvq, qr, mvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mvq *MenuVersionQuery) AllX(ctx context.Context) []*MenuVersion {
+	nodes, err := mvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MenuVersion IDs.
+func (mvq *MenuVersionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mvq.ctx.Unique == nil && mvq.path != nil {
+		mvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mvq.ctx, ent.OpQueryIDs)
+	if err = mvq.Select(menuversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mvq *MenuVersionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mvq *MenuVersionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mvq.ctx, ent.OpQueryCount)
+	if err := mvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mvq, querierCount[*MenuVersionQuery](), mvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mvq *MenuVersionQuery) CountX(ctx context.Context) int {
+	count, err := mvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mvq *MenuVersionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mvq.ctx, ent.OpQueryExist)
+	switch _, err := mvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mvq *MenuVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := mvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MenuVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mvq *MenuVersionQuery) Clone() *MenuVersionQuery {
+	if mvq == nil {
+		return nil
+	}
+	return &MenuVersionQuery{
+		config:     mvq.config,
+		ctx:        mvq.ctx.Clone(),
+		order:      append([]menuversion.OrderOption{}, mvq.order...),
+		inters:     append([]Interceptor{}, mvq.inters...),
+		predicates: append([]predicate.MenuVersion{}, mvq.predicates...),
+		// clone intermediate query.
+		sql:       mvq.sql.Clone(),
+		path:      mvq.path,
+		modifiers: append([]func(*sql.Selector){}, mvq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MenuVersion.Query().
+//		GroupBy(menuversion.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mvq *MenuVersionQuery) GroupBy(field string, fields ...string) *MenuVersionGroupBy {
+	mvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MenuVersionGroupBy{build: mvq}
+	grbuild.flds = &mvq.ctx.Fields
+	grbuild.label = menuversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.MenuVersion.Query().
+//		Select(menuversion.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (mvq *MenuVersionQuery) Select(fields ...string) *MenuVersionSelect {
+	mvq.ctx.Fields = append(mvq.ctx.Fields, fields...)
+	sbuild := &MenuVersionSelect{MenuVersionQuery: mvq}
+	sbuild.label = menuversion.Label
+	sbuild.flds, sbuild.scan = &mvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MenuVersionSelect configured with the given aggregations.
+func (mvq *MenuVersionQuery) Aggregate(fns ...AggregateFunc) *MenuVersionSelect {
+	return mvq.Select().Aggregate(fns...)
+}
+
+func (mvq *MenuVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mvq.ctx.Fields {
+		if !menuversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mvq.path != nil {
+		prev, err := mvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mvq.sql = prev
+	}
+	return nil
+}
+
+func (mvq *MenuVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MenuVersion, error) {
+	var (
+		nodes = []*MenuVersion{}
+		_spec = mvq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MenuVersion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MenuVersion{config: mvq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(mvq.modifiers) > 0 {
+		_spec.Modifiers = mvq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mvq *MenuVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mvq.querySpec()
+	if len(mvq.modifiers) > 0 {
+		_spec.Modifiers = mvq.modifiers
+	}
+	_spec.Node.Columns = mvq.ctx.Fields
+	if len(mvq.ctx.Fields) > 0 {
+		_spec.Unique = mvq.ctx.Unique != nil && *mvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mvq.driver, _spec)
+}
+
+func (mvq *MenuVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(menuversion.Table, menuversion.Columns, sqlgraph.NewFieldSpec(menuversion.FieldID, field.TypeUUID))
+	_spec.From = mvq.sql
+	if unique := mvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, menuversion.FieldID)
+		for i := range fields {
+			if fields[i] != menuversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mvq *MenuVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mvq.driver.Dialect())
+	t1 := builder.Table(menuversion.Table)
+	columns := mvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = menuversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mvq.sql != nil {
+		selector = mvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mvq.ctx.Unique != nil && *mvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mvq.modifiers {
+		m(selector)
+	}
+	for _, p := range mvq.predicates {
+		p(selector)
+	}
+	for _, p := range mvq.order {
+		p(selector)
+	}
+	if offset := mvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mvq *MenuVersionQuery) ForUpdate(opts ...sql.LockOption) *MenuVersionQuery {
+	if mvq.driver.Dialect() == dialect.Postgres {
+		mvq.Unique(false)
+	}
+	mvq.modifiers = append(mvq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mvq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mvq *MenuVersionQuery) ForShare(opts ...sql.LockOption) *MenuVersionQuery {
+	if mvq.driver.Dialect() == dialect.Postgres {
+		mvq.Unique(false)
+	}
+	mvq.modifiers = append(mvq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mvq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mvq *MenuVersionQuery) Modify(modifiers ...func(s *sql.Selector)) *MenuVersionSelect {
+	mvq.modifiers = append(mvq.modifiers, modifiers...)
+	return mvq.Select()
+}
+
+// MenuVersionGroupBy is the group-by builder for MenuVersion entities.
+type MenuVersionGroupBy struct {
+	selector
+	build *MenuVersionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mvgb *MenuVersionGroupBy) Aggregate(fns ...AggregateFunc) *MenuVersionGroupBy {
+	mvgb.fns = append(mvgb.fns, fns...)
+	return mvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mvgb *MenuVersionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MenuVersionQuery, *MenuVersionGroupBy](ctx, mvgb.build, mvgb, mvgb.build.inters, v)
+}
+
+func (mvgb *MenuVersionGroupBy) sqlScan(ctx context.Context, root *MenuVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mvgb.fns))
+	for _, fn := range mvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mvgb.flds)+len(mvgb.fns))
+		for _, f := range *mvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MenuVersionSelect is the builder for selecting fields of MenuVersion entities.
+type MenuVersionSelect struct {
+	*MenuVersionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mvs *MenuVersionSelect) Aggregate(fns ...AggregateFunc) *MenuVersionSelect {
+	mvs.fns = append(mvs.fns, fns...)
+	return mvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mvs *MenuVersionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mvs.ctx, ent.OpQuerySelect)
+	if err := mvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MenuVersionQuery, *MenuVersionSelect](ctx, mvs.MenuVersionQuery, mvs, mvs.inters, v)
+}
+
+func (mvs *MenuVersionSelect) sqlScan(ctx context.Context, root *MenuVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mvs.fns))
+	for _, fn := range mvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mvs *MenuVersionSelect) Modify(modifiers ...func(s *sql.Selector)) *MenuVersionSelect {
+	mvs.modifiers = append(mvs.modifiers, modifiers...)
+	return mvs
+}
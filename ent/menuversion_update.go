@@ -0,0 +1,677 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/menuversion"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// MenuVersionUpdate is the builder for updating MenuVersion entities.
+type MenuVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MenuVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MenuVersionUpdate builder.
+func (mvu *MenuVersionUpdate) Where(ps ...predicate.MenuVersion) *MenuVersionUpdate {
+	mvu.mutation.Where(ps...)
+	return mvu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mvu *MenuVersionUpdate) SetUpdatedAt(t time.Time) *MenuVersionUpdate {
+	mvu.mutation.SetUpdatedAt(t)
+	return mvu
+}
+
+// SetStatus sets the "status" field.
+func (mvu *MenuVersionUpdate) SetStatus(dvs domain.MenuVersionStatus) *MenuVersionUpdate {
+	mvu.mutation.SetStatus(dvs)
+	return mvu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mvu *MenuVersionUpdate) SetNillableStatus(dvs *domain.MenuVersionStatus) *MenuVersionUpdate {
+	if dvs != nil {
+		mvu.SetStatus(*dvs)
+	}
+	return mvu
+}
+
+// SetName sets the "name" field.
+func (mvu *MenuVersionUpdate) SetName(s string) *MenuVersionUpdate {
+	mvu.mutation.SetName(s)
+	return mvu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mvu *MenuVersionUpdate) SetNillableName(s *string) *MenuVersionUpdate {
+	if s != nil {
+		mvu.SetName(*s)
+	}
+	return mvu
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (mvu *MenuVersionUpdate) SetStoreIds(u []uuid.UUID) *MenuVersionUpdate {
+	mvu.mutation.SetStoreIds(u)
+	return mvu
+}
+
+// AppendStoreIds appends u to the "store_ids" field.
+func (mvu *MenuVersionUpdate) AppendStoreIds(u []uuid.UUID) *MenuVersionUpdate {
+	mvu.mutation.AppendStoreIds(u)
+	return mvu
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (mvu *MenuVersionUpdate) ClearStoreIds() *MenuVersionUpdate {
+	mvu.mutation.ClearStoreIds()
+	return mvu
+}
+
+// SetItems sets the "items" field.
+func (mvu *MenuVersionUpdate) SetItems(dvi domain.MenuVersionItems) *MenuVersionUpdate {
+	mvu.mutation.SetItems(dvi)
+	return mvu
+}
+
+// AppendItems appends dvi to the "items" field.
+func (mvu *MenuVersionUpdate) AppendItems(dvi domain.MenuVersionItems) *MenuVersionUpdate {
+	mvu.mutation.AppendItems(dvi)
+	return mvu
+}
+
+// ClearItems clears the value of the "items" field.
+func (mvu *MenuVersionUpdate) ClearItems() *MenuVersionUpdate {
+	mvu.mutation.ClearItems()
+	return mvu
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (mvu *MenuVersionUpdate) SetScheduledAt(t time.Time) *MenuVersionUpdate {
+	mvu.mutation.SetScheduledAt(t)
+	return mvu
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (mvu *MenuVersionUpdate) SetNillableScheduledAt(t *time.Time) *MenuVersionUpdate {
+	if t != nil {
+		mvu.SetScheduledAt(*t)
+	}
+	return mvu
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (mvu *MenuVersionUpdate) ClearScheduledAt() *MenuVersionUpdate {
+	mvu.mutation.ClearScheduledAt()
+	return mvu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (mvu *MenuVersionUpdate) SetPublishedAt(t time.Time) *MenuVersionUpdate {
+	mvu.mutation.SetPublishedAt(t)
+	return mvu
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (mvu *MenuVersionUpdate) SetNillablePublishedAt(t *time.Time) *MenuVersionUpdate {
+	if t != nil {
+		mvu.SetPublishedAt(*t)
+	}
+	return mvu
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (mvu *MenuVersionUpdate) ClearPublishedAt() *MenuVersionUpdate {
+	mvu.mutation.ClearPublishedAt()
+	return mvu
+}
+
+// SetPublishError sets the "publish_error" field.
+func (mvu *MenuVersionUpdate) SetPublishError(s string) *MenuVersionUpdate {
+	mvu.mutation.SetPublishError(s)
+	return mvu
+}
+
+// SetNillablePublishError sets the "publish_error" field if the given value is not nil.
+func (mvu *MenuVersionUpdate) SetNillablePublishError(s *string) *MenuVersionUpdate {
+	if s != nil {
+		mvu.SetPublishError(*s)
+	}
+	return mvu
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (mvu *MenuVersionUpdate) SetOperatorID(u uuid.UUID) *MenuVersionUpdate {
+	mvu.mutation.SetOperatorID(u)
+	return mvu
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (mvu *MenuVersionUpdate) SetNillableOperatorID(u *uuid.UUID) *MenuVersionUpdate {
+	if u != nil {
+		mvu.SetOperatorID(*u)
+	}
+	return mvu
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (mvu *MenuVersionUpdate) SetOperatorName(s string) *MenuVersionUpdate {
+	mvu.mutation.SetOperatorName(s)
+	return mvu
+}
+
+// SetNillableOperatorName sets the "operator_name" field if the given value is not nil.
+func (mvu *MenuVersionUpdate) SetNillableOperatorName(s *string) *MenuVersionUpdate {
+	if s != nil {
+		mvu.SetOperatorName(*s)
+	}
+	return mvu
+}
+
+// Mutation returns the MenuVersionMutation object of the builder.
+func (mvu *MenuVersionUpdate) Mutation() *MenuVersionMutation {
+	return mvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mvu *MenuVersionUpdate) Save(ctx context.Context) (int, error) {
+	mvu.defaults()
+	return withHooks(ctx, mvu.sqlSave, mvu.mutation, mvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mvu *MenuVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mvu *MenuVersionUpdate) Exec(ctx context.Context) error {
+	_, err := mvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvu *MenuVersionUpdate) ExecX(ctx context.Context) {
+	if err := mvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mvu *MenuVersionUpdate) defaults() {
+	if _, ok := mvu.mutation.UpdatedAt(); !ok {
+		v := menuversion.UpdateDefaultUpdatedAt()
+		mvu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mvu *MenuVersionUpdate) check() error {
+	if v, ok := mvu.mutation.Status(); ok {
+		if err := menuversion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.status": %w`, err)}
+		}
+	}
+	if v, ok := mvu.mutation.Name(); ok {
+		if err := menuversion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.name": %w`, err)}
+		}
+	}
+	if v, ok := mvu.mutation.PublishError(); ok {
+		if err := menuversion.PublishErrorValidator(v); err != nil {
+			return &ValidationError{Name: "publish_error", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.publish_error": %w`, err)}
+		}
+	}
+	if v, ok := mvu.mutation.OperatorName(); ok {
+		if err := menuversion.OperatorNameValidator(v); err != nil {
+			return &ValidationError{Name: "operator_name", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.operator_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mvu *MenuVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MenuVersionUpdate {
+	mvu.modifiers = append(mvu.modifiers, modifiers...)
+	return mvu
+}
+
+func (mvu *MenuVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(menuversion.Table, menuversion.Columns, sqlgraph.NewFieldSpec(menuversion.FieldID, field.TypeUUID))
+	if ps := mvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mvu.mutation.UpdatedAt(); ok {
+		_spec.SetField(menuversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mvu.mutation.Status(); ok {
+		_spec.SetField(menuversion.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := mvu.mutation.Name(); ok {
+		_spec.SetField(menuversion.FieldName, field.TypeString, value)
+	}
+	if value, ok := mvu.mutation.StoreIds(); ok {
+		_spec.SetField(menuversion.FieldStoreIds, field.TypeJSON, value)
+	}
+	if value, ok := mvu.mutation.AppendedStoreIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, menuversion.FieldStoreIds, value)
+		})
+	}
+	if mvu.mutation.StoreIdsCleared() {
+		_spec.ClearField(menuversion.FieldStoreIds, field.TypeJSON)
+	}
+	if value, ok := mvu.mutation.Items(); ok {
+		_spec.SetField(menuversion.FieldItems, field.TypeJSON, value)
+	}
+	if value, ok := mvu.mutation.AppendedItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, menuversion.FieldItems, value)
+		})
+	}
+	if mvu.mutation.ItemsCleared() {
+		_spec.ClearField(menuversion.FieldItems, field.TypeJSON)
+	}
+	if value, ok := mvu.mutation.ScheduledAt(); ok {
+		_spec.SetField(menuversion.FieldScheduledAt, field.TypeTime, value)
+	}
+	if mvu.mutation.ScheduledAtCleared() {
+		_spec.ClearField(menuversion.FieldScheduledAt, field.TypeTime)
+	}
+	if value, ok := mvu.mutation.PublishedAt(); ok {
+		_spec.SetField(menuversion.FieldPublishedAt, field.TypeTime, value)
+	}
+	if mvu.mutation.PublishedAtCleared() {
+		_spec.ClearField(menuversion.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := mvu.mutation.PublishError(); ok {
+		_spec.SetField(menuversion.FieldPublishError, field.TypeString, value)
+	}
+	if value, ok := mvu.mutation.OperatorID(); ok {
+		_spec.SetField(menuversion.FieldOperatorID, field.TypeUUID, value)
+	}
+	if value, ok := mvu.mutation.OperatorName(); ok {
+		_spec.SetField(menuversion.FieldOperatorName, field.TypeString, value)
+	}
+	_spec.AddModifiers(mvu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menuversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mvu.mutation.done = true
+	return n, nil
+}
+
+// MenuVersionUpdateOne is the builder for updating a single MenuVersion entity.
+type MenuVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MenuVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mvuo *MenuVersionUpdateOne) SetUpdatedAt(t time.Time) *MenuVersionUpdateOne {
+	mvuo.mutation.SetUpdatedAt(t)
+	return mvuo
+}
+
+// SetStatus sets the "status" field.
+func (mvuo *MenuVersionUpdateOne) SetStatus(dvs domain.MenuVersionStatus) *MenuVersionUpdateOne {
+	mvuo.mutation.SetStatus(dvs)
+	return mvuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mvuo *MenuVersionUpdateOne) SetNillableStatus(dvs *domain.MenuVersionStatus) *MenuVersionUpdateOne {
+	if dvs != nil {
+		mvuo.SetStatus(*dvs)
+	}
+	return mvuo
+}
+
+// SetName sets the "name" field.
+func (mvuo *MenuVersionUpdateOne) SetName(s string) *MenuVersionUpdateOne {
+	mvuo.mutation.SetName(s)
+	return mvuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mvuo *MenuVersionUpdateOne) SetNillableName(s *string) *MenuVersionUpdateOne {
+	if s != nil {
+		mvuo.SetName(*s)
+	}
+	return mvuo
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (mvuo *MenuVersionUpdateOne) SetStoreIds(u []uuid.UUID) *MenuVersionUpdateOne {
+	mvuo.mutation.SetStoreIds(u)
+	return mvuo
+}
+
+// AppendStoreIds appends u to the "store_ids" field.
+func (mvuo *MenuVersionUpdateOne) AppendStoreIds(u []uuid.UUID) *MenuVersionUpdateOne {
+	mvuo.mutation.AppendStoreIds(u)
+	return mvuo
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (mvuo *MenuVersionUpdateOne) ClearStoreIds() *MenuVersionUpdateOne {
+	mvuo.mutation.ClearStoreIds()
+	return mvuo
+}
+
+// SetItems sets the "items" field.
+func (mvuo *MenuVersionUpdateOne) SetItems(dvi domain.MenuVersionItems) *MenuVersionUpdateOne {
+	mvuo.mutation.SetItems(dvi)
+	return mvuo
+}
+
+// AppendItems appends dvi to the "items" field.
+func (mvuo *MenuVersionUpdateOne) AppendItems(dvi domain.MenuVersionItems) *MenuVersionUpdateOne {
+	mvuo.mutation.AppendItems(dvi)
+	return mvuo
+}
+
+// ClearItems clears the value of the "items" field.
+func (mvuo *MenuVersionUpdateOne) ClearItems() *MenuVersionUpdateOne {
+	mvuo.mutation.ClearItems()
+	return mvuo
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (mvuo *MenuVersionUpdateOne) SetScheduledAt(t time.Time) *MenuVersionUpdateOne {
+	mvuo.mutation.SetScheduledAt(t)
+	return mvuo
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (mvuo *MenuVersionUpdateOne) SetNillableScheduledAt(t *time.Time) *MenuVersionUpdateOne {
+	if t != nil {
+		mvuo.SetScheduledAt(*t)
+	}
+	return mvuo
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (mvuo *MenuVersionUpdateOne) ClearScheduledAt() *MenuVersionUpdateOne {
+	mvuo.mutation.ClearScheduledAt()
+	return mvuo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (mvuo *MenuVersionUpdateOne) SetPublishedAt(t time.Time) *MenuVersionUpdateOne {
+	mvuo.mutation.SetPublishedAt(t)
+	return mvuo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (mvuo *MenuVersionUpdateOne) SetNillablePublishedAt(t *time.Time) *MenuVersionUpdateOne {
+	if t != nil {
+		mvuo.SetPublishedAt(*t)
+	}
+	return mvuo
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (mvuo *MenuVersionUpdateOne) ClearPublishedAt() *MenuVersionUpdateOne {
+	mvuo.mutation.ClearPublishedAt()
+	return mvuo
+}
+
+// SetPublishError sets the "publish_error" field.
+func (mvuo *MenuVersionUpdateOne) SetPublishError(s string) *MenuVersionUpdateOne {
+	mvuo.mutation.SetPublishError(s)
+	return mvuo
+}
+
+// SetNillablePublishError sets the "publish_error" field if the given value is not nil.
+func (mvuo *MenuVersionUpdateOne) SetNillablePublishError(s *string) *MenuVersionUpdateOne {
+	if s != nil {
+		mvuo.SetPublishError(*s)
+	}
+	return mvuo
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (mvuo *MenuVersionUpdateOne) SetOperatorID(u uuid.UUID) *MenuVersionUpdateOne {
+	mvuo.mutation.SetOperatorID(u)
+	return mvuo
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (mvuo *MenuVersionUpdateOne) SetNillableOperatorID(u *uuid.UUID) *MenuVersionUpdateOne {
+	if u != nil {
+		mvuo.SetOperatorID(*u)
+	}
+	return mvuo
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (mvuo *MenuVersionUpdateOne) SetOperatorName(s string) *MenuVersionUpdateOne {
+	mvuo.mutation.SetOperatorName(s)
+	return mvuo
+}
+
+// SetNillableOperatorName sets the "operator_name" field if the given value is not nil.
+func (mvuo *MenuVersionUpdateOne) SetNillableOperatorName(s *string) *MenuVersionUpdateOne {
+	if s != nil {
+		mvuo.SetOperatorName(*s)
+	}
+	return mvuo
+}
+
+// Mutation returns the MenuVersionMutation object of the builder.
+func (mvuo *MenuVersionUpdateOne) Mutation() *MenuVersionMutation {
+	return mvuo.mutation
+}
+
+// Where appends a list predicates to the MenuVersionUpdate builder.
+func (mvuo *MenuVersionUpdateOne) Where(ps ...predicate.MenuVersion) *MenuVersionUpdateOne {
+	mvuo.mutation.Where(ps...)
+	return mvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mvuo *MenuVersionUpdateOne) Select(field string, fields ...string) *MenuVersionUpdateOne {
+	mvuo.fields = append([]string{field}, fields...)
+	return mvuo
+}
+
+// Save executes the query and returns the updated MenuVersion entity.
+func (mvuo *MenuVersionUpdateOne) Save(ctx context.Context) (*MenuVersion, error) {
+	mvuo.defaults()
+	return withHooks(ctx, mvuo.sqlSave, mvuo.mutation, mvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mvuo *MenuVersionUpdateOne) SaveX(ctx context.Context) *MenuVersion {
+	node, err := mvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mvuo *MenuVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvuo *MenuVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := mvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mvuo *MenuVersionUpdateOne) defaults() {
+	if _, ok := mvuo.mutation.UpdatedAt(); !ok {
+		v := menuversion.UpdateDefaultUpdatedAt()
+		mvuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mvuo *MenuVersionUpdateOne) check() error {
+	if v, ok := mvuo.mutation.Status(); ok {
+		if err := menuversion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.status": %w`, err)}
+		}
+	}
+	if v, ok := mvuo.mutation.Name(); ok {
+		if err := menuversion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.name": %w`, err)}
+		}
+	}
+	if v, ok := mvuo.mutation.PublishError(); ok {
+		if err := menuversion.PublishErrorValidator(v); err != nil {
+			return &ValidationError{Name: "publish_error", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.publish_error": %w`, err)}
+		}
+	}
+	if v, ok := mvuo.mutation.OperatorName(); ok {
+		if err := menuversion.OperatorNameValidator(v); err != nil {
+			return &ValidationError{Name: "operator_name", err: fmt.Errorf(`ent: validator failed for field "MenuVersion.operator_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mvuo *MenuVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MenuVersionUpdateOne {
+	mvuo.modifiers = append(mvuo.modifiers, modifiers...)
+	return mvuo
+}
+
+func (mvuo *MenuVersionUpdateOne) sqlSave(ctx context.Context) (_node *MenuVersion, err error) {
+	if err := mvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(menuversion.Table, menuversion.Columns, sqlgraph.NewFieldSpec(menuversion.FieldID, field.TypeUUID))
+	id, ok := mvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MenuVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, menuversion.FieldID)
+		for _, f := range fields {
+			if !menuversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != menuversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mvuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(menuversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mvuo.mutation.Status(); ok {
+		_spec.SetField(menuversion.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := mvuo.mutation.Name(); ok {
+		_spec.SetField(menuversion.FieldName, field.TypeString, value)
+	}
+	if value, ok := mvuo.mutation.StoreIds(); ok {
+		_spec.SetField(menuversion.FieldStoreIds, field.TypeJSON, value)
+	}
+	if value, ok := mvuo.mutation.AppendedStoreIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, menuversion.FieldStoreIds, value)
+		})
+	}
+	if mvuo.mutation.StoreIdsCleared() {
+		_spec.ClearField(menuversion.FieldStoreIds, field.TypeJSON)
+	}
+	if value, ok := mvuo.mutation.Items(); ok {
+		_spec.SetField(menuversion.FieldItems, field.TypeJSON, value)
+	}
+	if value, ok := mvuo.mutation.AppendedItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, menuversion.FieldItems, value)
+		})
+	}
+	if mvuo.mutation.ItemsCleared() {
+		_spec.ClearField(menuversion.FieldItems, field.TypeJSON)
+	}
+	if value, ok := mvuo.mutation.ScheduledAt(); ok {
+		_spec.SetField(menuversion.FieldScheduledAt, field.TypeTime, value)
+	}
+	if mvuo.mutation.ScheduledAtCleared() {
+		_spec.ClearField(menuversion.FieldScheduledAt, field.TypeTime)
+	}
+	if value, ok := mvuo.mutation.PublishedAt(); ok {
+		_spec.SetField(menuversion.FieldPublishedAt, field.TypeTime, value)
+	}
+	if mvuo.mutation.PublishedAtCleared() {
+		_spec.ClearField(menuversion.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := mvuo.mutation.PublishError(); ok {
+		_spec.SetField(menuversion.FieldPublishError, field.TypeString, value)
+	}
+	if value, ok := mvuo.mutation.OperatorID(); ok {
+		_spec.SetField(menuversion.FieldOperatorID, field.TypeUUID, value)
+	}
+	if value, ok := mvuo.mutation.OperatorName(); ok {
+		_spec.SetField(menuversion.FieldOperatorName, field.TypeString, value)
+	}
+	_spec.AddModifiers(mvuo.modifiers...)
+	_node = &MenuVersion{config: mvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menuversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mvuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,53 @@
+-- Create "price_change_batches" table
+CREATE TABLE `price_change_batches` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  `name` varchar(255) NOT NULL,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `store_ids` json NULL,
+  `effective_at` timestamp NOT NULL,
+  `status` enum (
+    'pending',
+    'applied',
+    'cancelled',
+    'rolled_back',
+    'failed'
+  ) NOT NULL DEFAULT "pending",
+  `remark` varchar(255) NOT NULL DEFAULT "",
+  `fail_reason` varchar(1000) NOT NULL DEFAULT "",
+  `applied_at` timestamp NULL,
+  `operator_id` char(36) NOT NULL,
+  `operator_name` varchar(255) NOT NULL DEFAULT "",
+  `operation_logs` json NULL,
+  PRIMARY KEY (`id`),
+  INDEX `pricechangebatch_deleted_at` (`deleted_at`),
+  INDEX `pricechangebatch_merchant_id` (`merchant_id`),
+  INDEX `pricechangebatch_store_id` (`store_id`),
+  INDEX `pricechangebatch_status_effective_at` (`status`, `effective_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "price_change_items" table
+CREATE TABLE `price_change_items` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  `target_type` enum ('product_spec', 'menu_item') NOT NULL,
+  `product_id` char(36) NOT NULL,
+  `spec_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `product_name` varchar(255) NOT NULL DEFAULT "",
+  `spec_name` varchar(255) NOT NULL DEFAULT "",
+  `new_price` decimal(19, 4) NOT NULL,
+  `old_price` decimal(19, 4) NULL,
+  `target_id` char(36) NOT NULL,
+  `applied` bool NOT NULL DEFAULT 0,
+  `batch_id` char(36) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `pricechangeitem_deleted_at` (`deleted_at`),
+  INDEX `pricechangeitem_batch_id` (`batch_id`),
+  INDEX `pricechangeitem_product_id` (`product_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,7 @@
+-- Modify "menu_items" table
+ALTER TABLE `menu_items`
+ADD COLUMN `period_prices` json NULL;
+
+-- Modify "product_spec_relations" table
+ALTER TABLE `product_spec_relations`
+ADD COLUMN `period_prices` json NULL;
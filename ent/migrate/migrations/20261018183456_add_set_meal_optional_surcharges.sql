@@ -0,0 +1,3 @@
+-- Modify "set_meal_details" table
+ALTER TABLE `set_meal_details`
+ADD COLUMN `optional_surcharges` json NULL;
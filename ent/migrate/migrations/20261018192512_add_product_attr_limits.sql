@@ -0,0 +1,7 @@
+-- Modify "products" table
+ALTER TABLE `products`
+ADD COLUMN `attr_limits` json NULL;
+
+-- Modify "product_attr_relations" table
+ALTER TABLE `product_attr_relations`
+ADD COLUMN `max_quantity` bigint NOT NULL DEFAULT 1;
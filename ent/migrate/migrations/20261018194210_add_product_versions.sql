@@ -0,0 +1,25 @@
+-- Create "product_versions" table
+CREATE TABLE `product_versions` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `subject_type` enum ('product', 'product_spec', 'attr_item', 'menu_item') NOT NULL,
+  `product_id` char(36) NOT NULL,
+  `subject_id` char(36) NOT NULL,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `version` bigint NOT NULL,
+  `action` enum ('create', 'update', 'delete', 'restore') NOT NULL,
+  `name` varchar(255) NOT NULL DEFAULT "",
+  `snapshot` json NOT NULL,
+  `changes` json NULL,
+  `restored_from` bigint NOT NULL DEFAULT 0,
+  `operator_id` char(36) NOT NULL,
+  `operator_type` varchar(32) NOT NULL DEFAULT "",
+  `operator_name` varchar(255) NOT NULL DEFAULT "",
+  PRIMARY KEY (`id`),
+  INDEX `productversion_merchant_id_store_id` (`merchant_id`, `store_id`),
+  INDEX `productversion_product_id` (`product_id`),
+  UNIQUE INDEX `productversion_subject_type_product_id_subject_id_version` (`subject_type`, `product_id`, `subject_id`, `version`),
+  INDEX `productversion_subject_type_product_id_subject_id_created_at` (`subject_type`, `product_id`, `subject_id`, `created_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
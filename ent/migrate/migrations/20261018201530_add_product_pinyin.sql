@@ -0,0 +1,5 @@
+-- Modify "products" table
+ALTER TABLE `products`
+ADD COLUMN `pinyin` varchar(2000) NOT NULL DEFAULT "",
+ADD COLUMN `pinyin_initials` varchar(255) NOT NULL DEFAULT "",
+ADD INDEX `product_store_id_pinyin_initials` (`store_id`, `pinyin_initials`);
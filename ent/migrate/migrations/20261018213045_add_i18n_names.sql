@@ -0,0 +1,28 @@
+-- Modify "categories" table
+ALTER TABLE `categories`
+ADD COLUMN `name_i18n` json NULL;
+
+-- Modify "devices" table
+ALTER TABLE `devices`
+ADD COLUMN `print_locale` varchar(16) NOT NULL DEFAULT "";
+
+-- Modify "order_products" table
+ALTER TABLE `order_products`
+ADD COLUMN `product_name_i18n` json NULL;
+
+-- Modify "product_attr_items" table
+ALTER TABLE `product_attr_items`
+ADD COLUMN `name_i18n` json NULL;
+
+-- Modify "product_attrs" table
+ALTER TABLE `product_attrs`
+ADD COLUMN `name_i18n` json NULL;
+
+-- Modify "products" table
+ALTER TABLE `products`
+ADD COLUMN `name_i18n` json NULL,
+ADD COLUMN `description_i18n` json NULL;
+
+-- Modify "remarks" table
+ALTER TABLE `remarks`
+ADD COLUMN `name_i18n` json NULL;
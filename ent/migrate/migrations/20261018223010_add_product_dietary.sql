@@ -0,0 +1,8 @@
+-- Modify "products" table
+ALTER TABLE `products`
+ADD COLUMN `allergens` json NULL,
+ADD COLUMN `halal` bool NOT NULL DEFAULT 0,
+ADD COLUMN `vegetarian` bool NOT NULL DEFAULT 0,
+ADD COLUMN `vegan` bool NOT NULL DEFAULT 0,
+ADD COLUMN `spicy_level` bigint NOT NULL DEFAULT 0,
+ADD COLUMN `nutrition` json NULL;
@@ -0,0 +1,23 @@
+-- Create "menu_versions" table
+CREATE TABLE `menu_versions` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `menu_id` char(36) NOT NULL,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `version` bigint NOT NULL,
+  `status` enum ('draft', 'published', 'archived') NOT NULL DEFAULT "draft",
+  `name` varchar(255) NOT NULL,
+  `store_ids` json NULL,
+  `items` json NULL,
+  `scheduled_at` timestamp NULL,
+  `published_at` timestamp NULL,
+  `publish_error` varchar(1000) NOT NULL DEFAULT "",
+  `operator_id` char(36) NOT NULL,
+  `operator_name` varchar(255) NOT NULL DEFAULT "",
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `menuversion_menu_id_version` (`menu_id`, `version`),
+  INDEX `menuversion_menu_id_status` (`menu_id`, `status`),
+  INDEX `menuversion_status_scheduled_at` (`status`, `scheduled_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,34 @@
+-- Modify "orders" table
+ALTER TABLE `orders`
+ADD COLUMN `promotions` json NULL;
+
+-- Create "promotions" table
+CREATE TABLE `promotions` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `type` enum (
+    'order_discount',
+    'item_discount',
+    'buy_x_get_y',
+    'second_item_discount'
+  ) NOT NULL,
+  `rule` json NOT NULL,
+  `store_ids` json NULL,
+  `channels` json NULL,
+  `dining_ways` json NULL,
+  `start_at` timestamp NULL,
+  `end_at` timestamp NULL,
+  `time_windows` json NULL,
+  `priority` bigint NOT NULL DEFAULT 0,
+  `stackable` bool NOT NULL DEFAULT 0,
+  `enabled` bool NOT NULL DEFAULT 1,
+  PRIMARY KEY (`id`),
+  INDEX `promotion_deleted_at` (`deleted_at`),
+  INDEX `promotion_merchant_id_store_id` (`merchant_id`, `store_id`),
+  UNIQUE INDEX `promotion_merchant_id_store_id_name_deleted_at` (`merchant_id`, `store_id`, `name`, `deleted_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,55 @@
+-- Create "coupon_templates" table
+CREATE TABLE `coupon_templates` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `type` enum ('amount_off', 'discount', 'free_item') NOT NULL,
+  `value` decimal(19, 4) NOT NULL,
+  `max_discount` decimal(19, 4) NOT NULL,
+  `product_id` char(36) NOT NULL,
+  `min_spend` decimal(19, 4) NOT NULL,
+  `store_ids` json NULL,
+  `validity_type` enum ('fixed', 'relative') NOT NULL,
+  `valid_from` timestamp NULL,
+  `valid_to` timestamp NULL,
+  `valid_days` bigint NOT NULL DEFAULT 0,
+  `total_qty` bigint NOT NULL DEFAULT 0,
+  `issued_qty` bigint NOT NULL DEFAULT 0,
+  `description` varchar(1000) NOT NULL DEFAULT "",
+  `enabled` bool NOT NULL DEFAULT 1,
+  PRIMARY KEY (`id`),
+  INDEX `coupontemplate_deleted_at` (`deleted_at`),
+  INDEX `coupontemplate_merchant_id_store_id` (`merchant_id`, `store_id`),
+  UNIQUE INDEX `coupontemplate_merchant_id_store_id_name_deleted_at` (`merchant_id`, `store_id`, `name`, `deleted_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "coupons" table
+CREATE TABLE `coupons` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `template_id` char(36) NOT NULL,
+  `source` enum ('system', 'partner') NOT NULL DEFAULT "system",
+  `provider` varchar(50) NOT NULL DEFAULT "",
+  `code` varchar(64) NOT NULL,
+  `batch_no` varchar(64) NOT NULL DEFAULT "",
+  `name` varchar(255) NOT NULL DEFAULT "",
+  `type` enum ('amount_off', 'discount', 'free_item') NOT NULL,
+  `status` enum ('unused', 'used', 'invalidated') NOT NULL DEFAULT "unused",
+  `valid_from` timestamp NOT NULL,
+  `valid_to` timestamp NOT NULL,
+  `used_at` timestamp NULL,
+  `used_store_id` char(36) NOT NULL,
+  `order_id` char(36) NOT NULL,
+  `order_no` varchar(64) NOT NULL DEFAULT "",
+  `discount_amount` decimal(19, 4) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `coupon_merchant_id_provider_code` (`merchant_id`, `provider`, `code`),
+  INDEX `coupon_template_id` (`template_id`),
+  INDEX `coupon_order_id` (`order_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
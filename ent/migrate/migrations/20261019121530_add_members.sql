@@ -0,0 +1,49 @@
+-- Modify "orders" table
+ALTER TABLE `orders`
+ADD COLUMN `member_id` char(36) NULL,
+ADD COLUMN `member` json NULL,
+ADD INDEX `order_member_id` (`member_id`);
+
+-- Modify "order_products" table
+ALTER TABLE `order_products`
+ADD COLUMN `member_discount` decimal(10, 4) NULL;
+
+-- Create "member_tiers" table
+CREATE TABLE `member_tiers` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  `merchant_id` char(36) NOT NULL,
+  `name` varchar(50) NOT NULL,
+  `level` bigint NOT NULL DEFAULT 0,
+  `member_price_enabled` bool NOT NULL DEFAULT 1,
+  `description` varchar(255) NOT NULL DEFAULT "",
+  PRIMARY KEY (`id`),
+  INDEX `membertier_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `membertier_merchant_id_name_deleted_at` (`merchant_id`, `name`, `deleted_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "members" table
+CREATE TABLE `members` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  `merchant_id` char(36) NOT NULL,
+  `tier_id` char(36) NOT NULL,
+  `code` varchar(32) NOT NULL,
+  `name` varchar(50) NOT NULL DEFAULT "",
+  `phone` varchar(20) NOT NULL,
+  `openid` varchar(64) NOT NULL DEFAULT "",
+  `gender` enum ('male', 'female', 'other', 'unknown') NOT NULL DEFAULT "unknown",
+  `birthday` timestamp NULL,
+  `enabled` bool NOT NULL DEFAULT 1,
+  `remark` varchar(255) NOT NULL DEFAULT "",
+  PRIMARY KEY (`id`),
+  INDEX `member_deleted_at` (`deleted_at`),
+  INDEX `member_merchant_id_tier_id` (`merchant_id`, `tier_id`),
+  INDEX `member_merchant_id_openid` (`merchant_id`, `openid`),
+  UNIQUE INDEX `member_merchant_id_phone_deleted_at` (`merchant_id`, `phone`, `deleted_at`),
+  UNIQUE INDEX `member_merchant_id_code` (`merchant_id`, `code`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
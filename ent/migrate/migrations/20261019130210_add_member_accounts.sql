@@ -0,0 +1,43 @@
+-- Create "member_accounts" table
+CREATE TABLE `member_accounts` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `member_id` char(36) NOT NULL,
+  `balance` decimal(19, 4) NOT NULL,
+  `bonus_balance` decimal(19, 4) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `memberaccount_member_id` (`member_id`),
+  INDEX `memberaccount_merchant_id` (`merchant_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "member_account_transactions" table
+CREATE TABLE `member_account_transactions` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `member_id` char(36) NOT NULL,
+  `account_id` char(36) NOT NULL,
+  `transaction_no` varchar(64) NOT NULL,
+  `type` enum ('top_up', 'payment', 'refund', 'adjustment') NOT NULL,
+  `amount` decimal(19, 4) NOT NULL,
+  `bonus_amount` decimal(19, 4) NOT NULL,
+  `balance_after` decimal(19, 4) NOT NULL,
+  `bonus_balance_after` decimal(19, 4) NOT NULL,
+  `order_id` char(36) NOT NULL,
+  `order_no` varchar(64) NOT NULL DEFAULT "",
+  `refund_order_id` char(36) NOT NULL,
+  `payment_method` varchar(50) NOT NULL DEFAULT "",
+  `operator_id` char(36) NOT NULL,
+  `operator_name` varchar(100) NOT NULL DEFAULT "",
+  `remark` varchar(255) NOT NULL DEFAULT "",
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `memberaccounttransaction_merchant_id_transaction_no` (`merchant_id`, `transaction_no`),
+  INDEX `memberaccounttransaction_account_id_created_at` (`account_id`, `created_at`),
+  INDEX `memberaccounttransaction_merchant_id_store_id` (`merchant_id`, `store_id`),
+  INDEX `memberaccounttransaction_order_id` (`order_id`),
+  INDEX `memberaccounttransaction_refund_order_id` (`refund_order_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
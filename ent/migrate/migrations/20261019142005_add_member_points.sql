@@ -0,0 +1,63 @@
+-- Create "member_points_rules" table
+CREATE TABLE `member_points_rules` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `enabled` bool NOT NULL DEFAULT 0,
+  `earn_rate` decimal(19, 4) NOT NULL,
+  `category_rates` json NULL,
+  `tier_multipliers` json NULL,
+  `expire_days` bigint NOT NULL DEFAULT 0,
+  `redeem_enabled` bool NOT NULL DEFAULT 0,
+  `redeem_rate` bigint NOT NULL DEFAULT 0,
+  `redeem_min_points` bigint NOT NULL DEFAULT 0,
+  `redeem_max_percent` decimal(19, 4) NOT NULL,
+  `redeem_products` json NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `memberpointsrule_merchant_id` (`merchant_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "member_points_accounts" table
+CREATE TABLE `member_points_accounts` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `member_id` char(36) NOT NULL,
+  `balance` bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `memberpointsaccount_member_id` (`member_id`),
+  INDEX `memberpointsaccount_merchant_id` (`merchant_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "member_points_transactions" table
+CREATE TABLE `member_points_transactions` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `member_id` char(36) NOT NULL,
+  `account_id` char(36) NOT NULL,
+  `type` enum ('earn', 'redeem', 'reverse', 'restore', 'expire', 'adjustment') NOT NULL,
+  `points` bigint NOT NULL,
+  `balance_after` bigint NOT NULL,
+  `remaining` bigint NOT NULL DEFAULT 0,
+  `expires_at` timestamp NULL,
+  `amount` decimal(19, 4) NOT NULL,
+  `order_id` char(36) NOT NULL,
+  `order_no` varchar(64) NOT NULL DEFAULT "",
+  `refund_order_id` char(36) NOT NULL,
+  `product_id` char(36) NOT NULL,
+  `operator_id` char(36) NOT NULL,
+  `operator_name` varchar(100) NOT NULL DEFAULT "",
+  `remark` varchar(255) NOT NULL DEFAULT "",
+  PRIMARY KEY (`id`),
+  INDEX `memberpointstransaction_account_id_remaining` (`account_id`, `remaining`),
+  INDEX `memberpointstransaction_account_id_created_at` (`account_id`, `created_at`),
+  INDEX `memberpointstransaction_merchant_id_created_at` (`merchant_id`, `created_at`),
+  INDEX `memberpointstransaction_remaining_expires_at` (`remaining`, `expires_at`),
+  INDEX `memberpointstransaction_order_id` (`order_id`),
+  INDEX `memberpointstransaction_refund_order_id` (`refund_order_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
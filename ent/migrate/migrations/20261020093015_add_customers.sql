@@ -0,0 +1,16 @@
+-- Create "customers" table
+CREATE TABLE `customers` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `openid` varchar(64) NOT NULL,
+  `unionid` varchar(64) NOT NULL DEFAULT "",
+  `nickname` varchar(50) NOT NULL DEFAULT "",
+  `avatar` varchar(500) NOT NULL DEFAULT "",
+  `phone` varchar(20) NOT NULL DEFAULT "",
+  `last_login_at` timestamp NULL,
+  PRIMARY KEY (`id`),
+  INDEX `customer_unionid` (`unionid`),
+  INDEX `customer_phone` (`phone`),
+  UNIQUE INDEX `customer_openid` (`openid`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,54 @@
+-- Create "dining_tables" table
+CREATE TABLE `dining_tables` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `name` varchar(20) NOT NULL,
+  `seats` bigint NOT NULL DEFAULT 0,
+  `qr_token` varchar(32) NOT NULL,
+  `enabled` bool NOT NULL DEFAULT 1,
+  `sort_order` bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  INDEX `diningtable_deleted_at` (`deleted_at`),
+  INDEX `diningtable_store_id` (`store_id`),
+  UNIQUE INDEX `diningtable_qr_token` (`qr_token`),
+  UNIQUE INDEX `diningtable_store_id_name_deleted_at` (`store_id`, `name`, `deleted_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "scan_order_rules" table
+CREATE TABLE `scan_order_rules` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `enabled` bool NOT NULL DEFAULT 0,
+  `pay_mode` enum ('pay_first', 'pay_at_counter') NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `scanorderrule_merchant_id_store_id` (`merchant_id`, `store_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "kitchen_tickets" table
+CREATE TABLE `kitchen_tickets` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `order_id` char(36) NOT NULL,
+  `order_no` varchar(64) NOT NULL DEFAULT "",
+  `table_id` char(36) NOT NULL,
+  `table_name` varchar(20) NOT NULL DEFAULT "",
+  `batch` bigint NOT NULL DEFAULT 0,
+  `source` enum ('POS', 'H5', 'APP') NOT NULL,
+  `status` enum ('held', 'pending', 'printed') NOT NULL,
+  `items` json NOT NULL,
+  `remark` varchar(255) NOT NULL DEFAULT "",
+  `printed_at` timestamp NULL,
+  PRIMARY KEY (`id`),
+  INDEX `kitchenticket_store_id_status_created_at` (`store_id`, `status`, `created_at`),
+  INDEX `kitchenticket_order_id` (`order_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
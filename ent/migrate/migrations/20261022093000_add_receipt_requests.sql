@@ -0,0 +1,23 @@
+-- Create "receipt_requests" table
+CREATE TABLE `receipt_requests` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `order_id` char(36) NOT NULL,
+  `order_no` varchar(64) NOT NULL DEFAULT "",
+  `type` enum ('refund', 'invoice') NOT NULL,
+  `status` enum ('pending', 'accepted', 'rejected') NOT NULL,
+  `reason` varchar(255) NOT NULL DEFAULT "",
+  `contact_phone` varchar(20) NOT NULL DEFAULT "",
+  `invoice_title` varchar(100) NOT NULL DEFAULT "",
+  `invoice_tax_no` varchar(32) NOT NULL DEFAULT "",
+  `invoice_email` varchar(100) NOT NULL DEFAULT "",
+  `handle_remark` varchar(255) NOT NULL DEFAULT "",
+  `handled_by` char(36) NULL,
+  `handled_at` timestamp NULL,
+  PRIMARY KEY (`id`),
+  INDEX `receiptrequest_order_id` (`order_id`),
+  INDEX `receiptrequest_store_id_status_created_at` (`store_id`, `status`, `created_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
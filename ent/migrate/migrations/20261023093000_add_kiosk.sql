@@ -0,0 +1,19 @@
+-- Modify "devices" table
+ALTER TABLE `devices`
+MODIFY COLUMN `device_type` enum ('cashier', 'printer', 'kiosk') NOT NULL,
+ADD COLUMN `kiosk_pay_modes` json NULL,
+ADD COLUMN `kiosk_token` varchar(32) NULL,
+ADD UNIQUE INDEX `device_kiosk_token` (`kiosk_token`);
+
+-- Modify "orders" table
+ALTER TABLE `orders`
+MODIFY COLUMN `channel` enum ('POS', 'H5', 'APP', 'KIOSK') NOT NULL DEFAULT "POS",
+ADD COLUMN `pickup_no` varchar(16) NOT NULL DEFAULT "" AFTER `order_no`;
+
+-- Modify "refund_orders" table
+ALTER TABLE `refund_orders` MODIFY COLUMN `channel` enum ('POS', 'H5', 'APP', 'KIOSK') NOT NULL DEFAULT "POS";
+
+-- Modify "kitchen_tickets" table
+ALTER TABLE `kitchen_tickets`
+MODIFY COLUMN `source` enum ('POS', 'H5', 'APP', 'KIOSK') NOT NULL,
+ADD COLUMN `pickup_no` varchar(16) NOT NULL DEFAULT "" AFTER `table_name`;
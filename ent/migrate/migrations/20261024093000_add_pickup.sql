@@ -0,0 +1,32 @@
+-- Create "pickup_rules" table
+CREATE TABLE `pickup_rules` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `prefix` varchar(4) NOT NULL DEFAULT "",
+  `start` bigint NOT NULL,
+  `end` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `pickuprule_merchant_id_store_id` (`merchant_id`, `store_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "pickup_calls" table
+CREATE TABLE `pickup_calls` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `order_id` char(36) NOT NULL,
+  `order_no` varchar(64) NOT NULL DEFAULT "",
+  `pickup_no` varchar(16) NOT NULL,
+  `business_date` varchar(10) NOT NULL,
+  `status` enum ('waiting', 'ready', 'picked_up') NOT NULL,
+  `call_count` bigint NOT NULL DEFAULT 0,
+  `ready_at` timestamp NULL,
+  `picked_up_at` timestamp NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `pickupcall_order_id` (`order_id`),
+  INDEX `pickupcall_store_id_business_date_status` (`store_id`, `business_date`, `status`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
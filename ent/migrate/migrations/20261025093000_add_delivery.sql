@@ -0,0 +1,73 @@
+-- Modify "orders" table
+ALTER TABLE `orders`
+MODIFY COLUMN `dining_mode` enum ('DINE_IN', 'DELIVERY') NOT NULL DEFAULT "DINE_IN",
+MODIFY COLUMN `channel` enum ('POS', 'H5', 'APP', 'KIOSK', 'DELIVERY') NOT NULL DEFAULT "POS";
+
+-- Modify "refund_orders" table
+ALTER TABLE `refund_orders` MODIFY COLUMN `channel` enum ('POS', 'H5', 'APP', 'KIOSK', 'DELIVERY') NOT NULL DEFAULT "POS";
+
+-- Modify "kitchen_tickets" table
+ALTER TABLE `kitchen_tickets` MODIFY COLUMN `source` enum ('POS', 'H5', 'APP', 'KIOSK', 'DELIVERY') NOT NULL;
+
+-- Create "delivery_stores" table
+CREATE TABLE `delivery_stores` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `platform` enum ('grabfood', 'foodpanda') NOT NULL,
+  `platform_store_id` varchar(64) NOT NULL,
+  `enabled` bool NOT NULL DEFAULT 1,
+  `auto_accept` bool NOT NULL DEFAULT 0,
+  `auto_accept_max_amount` decimal(19, 4) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `deliverystore_merchant_id` (`merchant_id`),
+  UNIQUE INDEX `deliverystore_platform_platform_store_id` (`platform`, `platform_store_id`),
+  UNIQUE INDEX `deliverystore_store_id_platform` (`store_id`, `platform`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "delivery_product_mappings" table
+CREATE TABLE `delivery_product_mappings` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `platform` enum ('grabfood', 'foodpanda') NOT NULL,
+  `platform_sku` varchar(64) NOT NULL,
+  `product_id` char(36) NOT NULL,
+  `spec_id` char(36) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `deliveryproductmapping_merchant_id_platform_platform_sku` (`merchant_id`, `platform`, `platform_sku`),
+  INDEX `deliveryproductmapping_merchant_id_product_id` (`merchant_id`, `product_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "delivery_orders" table
+CREATE TABLE `delivery_orders` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `platform` enum ('grabfood', 'foodpanda') NOT NULL,
+  `platform_order_id` varchar(64) NOT NULL,
+  `short_code` varchar(32) NOT NULL DEFAULT "",
+  `status` enum ('pending', 'accepted', 'ready', 'rejected', 'cancelled') NOT NULL,
+  `order_id` char(36) NOT NULL,
+  `items` json NOT NULL,
+  `subtotal` decimal(19, 4) NOT NULL,
+  `delivery_fee` decimal(19, 4) NOT NULL,
+  `total` decimal(19, 4) NOT NULL,
+  `customer_name` varchar(64) NOT NULL DEFAULT "",
+  `customer_phone` varchar(32) NOT NULL DEFAULT "",
+  `address` varchar(255) NOT NULL DEFAULT "",
+  `remark` varchar(255) NOT NULL DEFAULT "",
+  `reject_reason` varchar(255) NOT NULL DEFAULT "",
+  `auto_accepted` bool NOT NULL DEFAULT 0,
+  `placed_at` timestamp NOT NULL,
+  `accepted_at` timestamp NULL,
+  `ready_at` timestamp NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `deliveryorder_platform_platform_order_id` (`platform`, `platform_order_id`),
+  INDEX `deliveryorder_store_id_status_placed_at` (`store_id`, `status`, `placed_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
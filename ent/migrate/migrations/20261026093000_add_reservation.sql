@@ -0,0 +1,50 @@
+-- Create "reservation_rules" table
+CREATE TABLE `reservation_rules` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `online_enabled` bool NOT NULL DEFAULT 0,
+  `slot_minutes` bigint NOT NULL,
+  `dining_minutes` bigint NOT NULL,
+  `min_advance_minutes` bigint NOT NULL DEFAULT 0,
+  `max_advance_days` bigint NOT NULL,
+  `max_party_size` bigint NOT NULL,
+  `deposit_per_guest` decimal(19, 4) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `reservationrule_store_id` (`store_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "reservations" table
+CREATE TABLE `reservations` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `source` enum ('store', 'online') NOT NULL,
+  `customer_id` char(36) NOT NULL,
+  `guest_name` varchar(64) NOT NULL,
+  `guest_phone` varchar(32) NOT NULL,
+  `party_size` bigint NOT NULL,
+  `reserved_at` timestamp NOT NULL,
+  `end_at` timestamp NOT NULL,
+  `dining_period` varchar(64) NOT NULL DEFAULT "",
+  `table_id` char(36) NOT NULL,
+  `table_name` varchar(64) NOT NULL DEFAULT "",
+  `area_preference` varchar(64) NOT NULL DEFAULT "",
+  `remark` varchar(255) NOT NULL DEFAULT "",
+  `status` enum ('booked', 'seated', 'no_show', 'cancelled') NOT NULL,
+  `deposit` decimal(19, 4) NOT NULL,
+  `deposit_status` enum ('none', 'unpaid', 'paid', 'refunded') NOT NULL,
+  `deposit_method` varchar(32) NOT NULL DEFAULT "",
+  `deposit_paid_at` timestamp NULL,
+  `order_id` char(36) NOT NULL,
+  `seated_at` timestamp NULL,
+  `cancelled_at` timestamp NULL,
+  `cancel_reason` varchar(255) NOT NULL DEFAULT "",
+  PRIMARY KEY (`id`),
+  INDEX `reservation_store_id_reserved_at` (`store_id`, `reserved_at`),
+  INDEX `reservation_customer_id_reserved_at` (`customer_id`, `reserved_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,42 @@
+-- Create "waitlist_rules" table
+CREATE TABLE `waitlist_rules` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `online_enabled` bool NOT NULL DEFAULT 0,
+  `small_max_size` bigint NOT NULL,
+  `medium_max_size` bigint NOT NULL,
+  `max_party_size` bigint NOT NULL,
+  `default_turn_minutes` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `waitlistrule_store_id` (`store_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "waitlist_tickets" table
+CREATE TABLE `waitlist_tickets` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `business_date` varchar(10) NOT NULL,
+  `bucket` enum ('small', 'medium', 'large') NOT NULL,
+  `ticket_no` varchar(16) NOT NULL,
+  `party_size` bigint NOT NULL,
+  `guest_phone` varchar(32) NOT NULL DEFAULT "",
+  `source` enum ('store', 'online') NOT NULL,
+  `customer_id` char(36) NOT NULL,
+  `status` enum ('waiting', 'called', 'seated', 'skipped', 'cancelled') NOT NULL,
+  `call_count` bigint NOT NULL DEFAULT 0,
+  `called_at` timestamp NULL,
+  `seated_at` timestamp NULL,
+  `cancelled_at` timestamp NULL,
+  `table_id` char(36) NOT NULL,
+  `table_name` varchar(64) NOT NULL DEFAULT "",
+  `order_id` char(36) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `waitlistticket_store_id_business_date` (`store_id`, `business_date`),
+  INDEX `waitlistticket_customer_id_business_date` (`customer_id`, `business_date`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
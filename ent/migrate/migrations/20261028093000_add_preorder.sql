@@ -0,0 +1,43 @@
+-- Create "preorder_rules" table
+CREATE TABLE `preorder_rules` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `enabled` bool NOT NULL DEFAULT 0,
+  `delivery_enabled` bool NOT NULL DEFAULT 0,
+  `slot_minutes` bigint NOT NULL,
+  `slot_capacity` bigint NOT NULL,
+  `lead_minutes` bigint NOT NULL DEFAULT 0,
+  `prep_minutes` bigint NOT NULL DEFAULT 0,
+  `max_advance_days` bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `preorderrule_store_id` (`store_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+
+-- Create "preorders" table
+CREATE TABLE `preorders` (
+  `id` char(36) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `merchant_id` char(36) NOT NULL,
+  `store_id` char(36) NOT NULL,
+  `customer_id` char(36) NOT NULL,
+  `order_id` char(36) NOT NULL,
+  `order_no` varchar(64) NOT NULL,
+  `fulfilment` enum ('pickup', 'delivery') NOT NULL,
+  `scheduled_at` timestamp NOT NULL,
+  `release_at` timestamp NOT NULL,
+  `contact_name` varchar(64) NOT NULL,
+  `contact_phone` varchar(32) NOT NULL,
+  `delivery_address` varchar(255) NOT NULL DEFAULT "",
+  `status` enum ('scheduled', 'released', 'cancelled') NOT NULL,
+  `released_at` timestamp NULL,
+  `cancelled_at` timestamp NULL,
+  `cancel_reason` varchar(255) NOT NULL DEFAULT "",
+  PRIMARY KEY (`id`),
+  INDEX `preorder_store_id_scheduled_at` (`store_id`, `scheduled_at`),
+  INDEX `preorder_customer_id_scheduled_at` (`customer_id`, `scheduled_at`),
+  UNIQUE INDEX `preorder_order_id` (`order_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
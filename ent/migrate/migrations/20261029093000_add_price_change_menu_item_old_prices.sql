@@ -0,0 +1,2 @@
+-- Modify "price_change_items" table
+ALTER TABLE `price_change_items` ADD COLUMN `menu_item_old_prices` json NULL;
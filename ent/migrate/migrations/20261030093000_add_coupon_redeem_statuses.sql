@@ -0,0 +1,2 @@
+-- Modify "coupons" table
+ALTER TABLE `coupons` MODIFY COLUMN `status` enum ('unused', 'used', 'invalidated', 'redeeming', 'redeem_failed') NOT NULL DEFAULT "unused";
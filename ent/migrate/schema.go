@@ -211,6 +211,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
 		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "name_i18n", Type: field.TypeJSON, Nullable: true},
 		{Name: "merchant_id", Type: field.TypeUUID},
 		{Name: "store_id", Type: field.TypeUUID},
 		{Name: "inherit_tax_rate", Type: field.TypeBool, Default: false},
@@ -231,31 +232,31 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "categories_categories_children",
-				Columns:    []*schema.Column{CategoriesColumns[11]},
+				Columns:    []*schema.Column{CategoriesColumns[12]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "categories_tax_fees_tax_rate",
-				Columns:    []*schema.Column{CategoriesColumns[12]},
+				Columns:    []*schema.Column{CategoriesColumns[13]},
 				RefColumns: []*schema.Column{TaxFeesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "categories_stalls_stall",
-				Columns:    []*schema.Column{CategoriesColumns[13]},
+				Columns:    []*schema.Column{CategoriesColumns[14]},
 				RefColumns: []*schema.Column{StallsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "categories_stalls_categories",
-				Columns:    []*schema.Column{CategoriesColumns[14]},
+				Columns:    []*schema.Column{CategoriesColumns[15]},
 				RefColumns: []*schema.Column{StallsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "categories_tax_fees_categories",
-				Columns:    []*schema.Column{CategoriesColumns[15]},
+				Columns:    []*schema.Column{CategoriesColumns[16]},
 				RefColumns: []*schema.Column{TaxFeesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -269,17 +270,253 @@ var (
 			{
 				Name:    "category_merchant_id",
 				Unique:  false,
-				Columns: []*schema.Column{CategoriesColumns[5]},
+				Columns: []*schema.Column{CategoriesColumns[6]},
 			},
 			{
 				Name:    "category_store_id",
 				Unique:  false,
-				Columns: []*schema.Column{CategoriesColumns[6]},
+				Columns: []*schema.Column{CategoriesColumns[7]},
 			},
 			{
 				Name:    "category_merchant_id_store_id_parent_id_name_deleted_at",
 				Unique:  true,
-				Columns: []*schema.Column{CategoriesColumns[5], CategoriesColumns[6], CategoriesColumns[11], CategoriesColumns[4], CategoriesColumns[3]},
+				Columns: []*schema.Column{CategoriesColumns[6], CategoriesColumns[7], CategoriesColumns[12], CategoriesColumns[4], CategoriesColumns[3]},
+			},
+		},
+	}
+	// CouponsColumns holds the columns for the "coupons" table.
+	CouponsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "template_id", Type: field.TypeUUID},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"system", "partner"}, Default: "system"},
+		{Name: "provider", Type: field.TypeString, Size: 50, Default: ""},
+		{Name: "code", Type: field.TypeString, Size: 64},
+		{Name: "batch_no", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "name", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"amount_off", "discount", "free_item"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"unused", "used", "invalidated", "redeeming", "redeem_failed"}, Default: "unused"},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "used_store_id", Type: field.TypeUUID},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "order_no", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "discount_amount", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+	}
+	// CouponsTable holds the schema information for the "coupons" table.
+	CouponsTable = &schema.Table{
+		Name:       "coupons",
+		Columns:    CouponsColumns,
+		PrimaryKey: []*schema.Column{CouponsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coupon_merchant_id_provider_code",
+				Unique:  true,
+				Columns: []*schema.Column{CouponsColumns[3], CouponsColumns[6], CouponsColumns[7]},
+			},
+			{
+				Name:    "coupon_template_id",
+				Unique:  false,
+				Columns: []*schema.Column{CouponsColumns[4]},
+			},
+			{
+				Name:    "coupon_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{CouponsColumns[16]},
+			},
+		},
+	}
+	// CouponTemplatesColumns holds the columns for the "coupon_templates" table.
+	CouponTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"amount_off", "discount", "free_item"}},
+		{Name: "value", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "max_discount", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "product_id", Type: field.TypeUUID},
+		{Name: "min_spend", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "store_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "validity_type", Type: field.TypeEnum, Enums: []string{"fixed", "relative"}},
+		{Name: "valid_from", Type: field.TypeTime, Nullable: true},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "valid_days", Type: field.TypeInt, Default: 0},
+		{Name: "total_qty", Type: field.TypeInt, Default: 0},
+		{Name: "issued_qty", Type: field.TypeInt, Default: 0},
+		{Name: "description", Type: field.TypeString, Size: 1000, Default: ""},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// CouponTemplatesTable holds the schema information for the "coupon_templates" table.
+	CouponTemplatesTable = &schema.Table{
+		Name:       "coupon_templates",
+		Columns:    CouponTemplatesColumns,
+		PrimaryKey: []*schema.Column{CouponTemplatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coupontemplate_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{CouponTemplatesColumns[3]},
+			},
+			{
+				Name:    "coupontemplate_merchant_id_store_id",
+				Unique:  false,
+				Columns: []*schema.Column{CouponTemplatesColumns[4], CouponTemplatesColumns[5]},
+			},
+			{
+				Name:    "coupontemplate_merchant_id_store_id_name_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{CouponTemplatesColumns[4], CouponTemplatesColumns[5], CouponTemplatesColumns[6], CouponTemplatesColumns[3]},
+			},
+		},
+	}
+	// CustomersColumns holds the columns for the "customers" table.
+	CustomersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "openid", Type: field.TypeString, Size: 64},
+		{Name: "unionid", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "nickname", Type: field.TypeString, Size: 50, Default: ""},
+		{Name: "avatar", Type: field.TypeString, Size: 500, Default: ""},
+		{Name: "phone", Type: field.TypeString, Size: 20, Default: ""},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+	}
+	// CustomersTable holds the schema information for the "customers" table.
+	CustomersTable = &schema.Table{
+		Name:       "customers",
+		Columns:    CustomersColumns,
+		PrimaryKey: []*schema.Column{CustomersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "customer_unionid",
+				Unique:  false,
+				Columns: []*schema.Column{CustomersColumns[4]},
+			},
+			{
+				Name:    "customer_phone",
+				Unique:  false,
+				Columns: []*schema.Column{CustomersColumns[7]},
+			},
+			{
+				Name:    "customer_openid",
+				Unique:  true,
+				Columns: []*schema.Column{CustomersColumns[3]},
+			},
+		},
+	}
+	// DeliveryOrdersColumns holds the columns for the "delivery_orders" table.
+	DeliveryOrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "platform", Type: field.TypeEnum, Enums: []string{"grabfood", "foodpanda"}},
+		{Name: "platform_order_id", Type: field.TypeString, Size: 64},
+		{Name: "short_code", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "ready", "rejected", "cancelled"}},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "items", Type: field.TypeJSON},
+		{Name: "subtotal", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "delivery_fee", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "total", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "customer_name", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "customer_phone", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "address", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "remark", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "reject_reason", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "auto_accepted", Type: field.TypeBool, Default: false},
+		{Name: "placed_at", Type: field.TypeTime},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ready_at", Type: field.TypeTime, Nullable: true},
+	}
+	// DeliveryOrdersTable holds the schema information for the "delivery_orders" table.
+	DeliveryOrdersTable = &schema.Table{
+		Name:       "delivery_orders",
+		Columns:    DeliveryOrdersColumns,
+		PrimaryKey: []*schema.Column{DeliveryOrdersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deliveryorder_platform_platform_order_id",
+				Unique:  true,
+				Columns: []*schema.Column{DeliveryOrdersColumns[5], DeliveryOrdersColumns[6]},
+			},
+			{
+				Name:    "deliveryorder_store_id_status_placed_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeliveryOrdersColumns[4], DeliveryOrdersColumns[8], DeliveryOrdersColumns[20]},
+			},
+		},
+	}
+	// DeliveryProductMappingsColumns holds the columns for the "delivery_product_mappings" table.
+	DeliveryProductMappingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "platform", Type: field.TypeEnum, Enums: []string{"grabfood", "foodpanda"}},
+		{Name: "platform_sku", Type: field.TypeString, Size: 64},
+		{Name: "product_id", Type: field.TypeUUID},
+		{Name: "spec_id", Type: field.TypeUUID},
+	}
+	// DeliveryProductMappingsTable holds the schema information for the "delivery_product_mappings" table.
+	DeliveryProductMappingsTable = &schema.Table{
+		Name:       "delivery_product_mappings",
+		Columns:    DeliveryProductMappingsColumns,
+		PrimaryKey: []*schema.Column{DeliveryProductMappingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deliveryproductmapping_merchant_id_platform_platform_sku",
+				Unique:  true,
+				Columns: []*schema.Column{DeliveryProductMappingsColumns[3], DeliveryProductMappingsColumns[4], DeliveryProductMappingsColumns[5]},
+			},
+			{
+				Name:    "deliveryproductmapping_merchant_id_product_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeliveryProductMappingsColumns[3], DeliveryProductMappingsColumns[6]},
+			},
+		},
+	}
+	// DeliveryStoresColumns holds the columns for the "delivery_stores" table.
+	DeliveryStoresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "platform", Type: field.TypeEnum, Enums: []string{"grabfood", "foodpanda"}},
+		{Name: "platform_store_id", Type: field.TypeString, Size: 64},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "auto_accept", Type: field.TypeBool, Default: false},
+		{Name: "auto_accept_max_amount", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+	}
+	// DeliveryStoresTable holds the schema information for the "delivery_stores" table.
+	DeliveryStoresTable = &schema.Table{
+		Name:       "delivery_stores",
+		Columns:    DeliveryStoresColumns,
+		PrimaryKey: []*schema.Column{DeliveryStoresColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deliverystore_store_id_platform",
+				Unique:  true,
+				Columns: []*schema.Column{DeliveryStoresColumns[4], DeliveryStoresColumns[5]},
+			},
+			{
+				Name:    "deliverystore_platform_platform_store_id",
+				Unique:  true,
+				Columns: []*schema.Column{DeliveryStoresColumns[5], DeliveryStoresColumns[6]},
+			},
+			{
+				Name:    "deliverystore_merchant_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeliveryStoresColumns[3]},
 			},
 		},
 	}
@@ -345,7 +582,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
 		{Name: "name", Type: field.TypeString, Size: 50},
-		{Name: "device_type", Type: field.TypeEnum, Enums: []string{"cashier", "printer"}},
+		{Name: "device_type", Type: field.TypeEnum, Enums: []string{"cashier", "printer", "kiosk"}},
 		{Name: "device_code", Type: field.TypeString, Size: 100, Default: ""},
 		{Name: "device_brand", Type: field.TypeString, Nullable: true},
 		{Name: "device_model", Type: field.TypeString, Nullable: true},
@@ -355,12 +592,15 @@ var (
 		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 50, Default: ""},
 		{Name: "sort_order", Type: field.TypeInt, Nullable: true, Default: 1000},
 		{Name: "paper_size", Type: field.TypeEnum, Nullable: true, Enums: []string{"58mm", "80mm"}},
+		{Name: "print_locale", Type: field.TypeString, Size: 16, Default: ""},
 		{Name: "connect_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"inside", "outside", "network"}},
 		{Name: "order_channels", Type: field.TypeJSON, Nullable: true},
 		{Name: "dining_ways", Type: field.TypeJSON, Nullable: true},
 		{Name: "device_stall_print_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"all", "combined", "separate"}},
 		{Name: "device_stall_receipt_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"all", "exclude"}},
 		{Name: "open_cash_drawer", Type: field.TypeBool, Nullable: true},
+		{Name: "kiosk_pay_modes", Type: field.TypeJSON, Nullable: true},
+		{Name: "kiosk_token", Type: field.TypeString, Nullable: true, Size: 32},
 		{Name: "merchant_id", Type: field.TypeUUID},
 		{Name: "stall_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "store_id", Type: field.TypeUUID},
@@ -373,19 +613,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "devices_merchants_devices",
-				Columns:    []*schema.Column{DevicesColumns[21]},
+				Columns:    []*schema.Column{DevicesColumns[24]},
 				RefColumns: []*schema.Column{MerchantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "devices_stalls_devices",
-				Columns:    []*schema.Column{DevicesColumns[22]},
+				Columns:    []*schema.Column{DevicesColumns[25]},
 				RefColumns: []*schema.Column{StallsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "devices_stores_devices",
-				Columns:    []*schema.Column{DevicesColumns[23]},
+				Columns:    []*schema.Column{DevicesColumns[26]},
 				RefColumns: []*schema.Column{StoresColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -399,22 +639,388 @@ var (
 			{
 				Name:    "device_merchant_id",
 				Unique:  false,
-				Columns: []*schema.Column{DevicesColumns[21]},
+				Columns: []*schema.Column{DevicesColumns[24]},
 			},
 			{
 				Name:    "device_store_id",
 				Unique:  false,
-				Columns: []*schema.Column{DevicesColumns[23]},
+				Columns: []*schema.Column{DevicesColumns[26]},
 			},
 			{
 				Name:    "device_stall_id",
 				Unique:  false,
-				Columns: []*schema.Column{DevicesColumns[22]},
+				Columns: []*schema.Column{DevicesColumns[25]},
+			},
+			{
+				Name:    "device_kiosk_token",
+				Unique:  true,
+				Columns: []*schema.Column{DevicesColumns[23]},
 			},
 			{
 				Name:    "idx_device_name_scope_deleted",
 				Unique:  true,
-				Columns: []*schema.Column{DevicesColumns[4], DevicesColumns[21], DevicesColumns[23], DevicesColumns[3]},
+				Columns: []*schema.Column{DevicesColumns[4], DevicesColumns[24], DevicesColumns[26], DevicesColumns[3]},
+			},
+		},
+	}
+	// DiningTablesColumns holds the columns for the "dining_tables" table.
+	DiningTablesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 20},
+		{Name: "seats", Type: field.TypeInt, Default: 0},
+		{Name: "qr_token", Type: field.TypeString, Size: 32},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+	}
+	// DiningTablesTable holds the schema information for the "dining_tables" table.
+	DiningTablesTable = &schema.Table{
+		Name:       "dining_tables",
+		Columns:    DiningTablesColumns,
+		PrimaryKey: []*schema.Column{DiningTablesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "diningtable_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{DiningTablesColumns[3]},
+			},
+			{
+				Name:    "diningtable_store_id",
+				Unique:  false,
+				Columns: []*schema.Column{DiningTablesColumns[5]},
+			},
+			{
+				Name:    "diningtable_qr_token",
+				Unique:  true,
+				Columns: []*schema.Column{DiningTablesColumns[8]},
+			},
+			{
+				Name:    "diningtable_store_id_name_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{DiningTablesColumns[5], DiningTablesColumns[6], DiningTablesColumns[3]},
+			},
+		},
+	}
+	// KitchenTicketsColumns holds the columns for the "kitchen_tickets" table.
+	KitchenTicketsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "order_no", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "table_id", Type: field.TypeUUID},
+		{Name: "table_name", Type: field.TypeString, Size: 20, Default: ""},
+		{Name: "pickup_no", Type: field.TypeString, Size: 16, Default: ""},
+		{Name: "batch", Type: field.TypeInt, Default: 0},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"POS", "H5", "APP", "KIOSK", "DELIVERY"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"held", "pending", "printed"}},
+		{Name: "items", Type: field.TypeJSON},
+		{Name: "remark", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "printed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// KitchenTicketsTable holds the schema information for the "kitchen_tickets" table.
+	KitchenTicketsTable = &schema.Table{
+		Name:       "kitchen_tickets",
+		Columns:    KitchenTicketsColumns,
+		PrimaryKey: []*schema.Column{KitchenTicketsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "kitchenticket_store_id_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{KitchenTicketsColumns[4], KitchenTicketsColumns[12], KitchenTicketsColumns[1]},
+			},
+			{
+				Name:    "kitchenticket_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{KitchenTicketsColumns[5]},
+			},
+		},
+	}
+	// MembersColumns holds the columns for the "members" table.
+	MembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "tier_id", Type: field.TypeUUID},
+		{Name: "code", Type: field.TypeString, Size: 32},
+		{Name: "name", Type: field.TypeString, Size: 50, Default: ""},
+		{Name: "phone", Type: field.TypeString, Size: 20},
+		{Name: "openid", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "gender", Type: field.TypeEnum, Enums: []string{"male", "female", "other", "unknown"}, Default: "unknown"},
+		{Name: "birthday", Type: field.TypeTime, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "remark", Type: field.TypeString, Size: 255, Default: ""},
+	}
+	// MembersTable holds the schema information for the "members" table.
+	MembersTable = &schema.Table{
+		Name:       "members",
+		Columns:    MembersColumns,
+		PrimaryKey: []*schema.Column{MembersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "member_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{MembersColumns[3]},
+			},
+			{
+				Name:    "member_merchant_id_tier_id",
+				Unique:  false,
+				Columns: []*schema.Column{MembersColumns[4], MembersColumns[5]},
+			},
+			{
+				Name:    "member_merchant_id_openid",
+				Unique:  false,
+				Columns: []*schema.Column{MembersColumns[4], MembersColumns[9]},
+			},
+			{
+				Name:    "member_merchant_id_phone_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{MembersColumns[4], MembersColumns[8], MembersColumns[3]},
+			},
+			{
+				Name:    "member_merchant_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{MembersColumns[4], MembersColumns[6]},
+			},
+		},
+	}
+	// MemberAccountsColumns holds the columns for the "member_accounts" table.
+	MemberAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "member_id", Type: field.TypeUUID},
+		{Name: "balance", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "bonus_balance", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+	}
+	// MemberAccountsTable holds the schema information for the "member_accounts" table.
+	MemberAccountsTable = &schema.Table{
+		Name:       "member_accounts",
+		Columns:    MemberAccountsColumns,
+		PrimaryKey: []*schema.Column{MemberAccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memberaccount_member_id",
+				Unique:  true,
+				Columns: []*schema.Column{MemberAccountsColumns[4]},
+			},
+			{
+				Name:    "memberaccount_merchant_id",
+				Unique:  false,
+				Columns: []*schema.Column{MemberAccountsColumns[3]},
+			},
+		},
+	}
+	// MemberAccountTransactionsColumns holds the columns for the "member_account_transactions" table.
+	MemberAccountTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "member_id", Type: field.TypeUUID},
+		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "transaction_no", Type: field.TypeString, Size: 64},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"top_up", "payment", "refund", "adjustment"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "bonus_amount", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "balance_after", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "bonus_balance_after", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "order_no", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "refund_order_id", Type: field.TypeUUID},
+		{Name: "payment_method", Type: field.TypeString, Size: 50, Default: ""},
+		{Name: "operator_id", Type: field.TypeUUID},
+		{Name: "operator_name", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "remark", Type: field.TypeString, Size: 255, Default: ""},
+	}
+	// MemberAccountTransactionsTable holds the schema information for the "member_account_transactions" table.
+	MemberAccountTransactionsTable = &schema.Table{
+		Name:       "member_account_transactions",
+		Columns:    MemberAccountTransactionsColumns,
+		PrimaryKey: []*schema.Column{MemberAccountTransactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memberaccounttransaction_merchant_id_transaction_no",
+				Unique:  true,
+				Columns: []*schema.Column{MemberAccountTransactionsColumns[3], MemberAccountTransactionsColumns[7]},
+			},
+			{
+				Name:    "memberaccounttransaction_account_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MemberAccountTransactionsColumns[6], MemberAccountTransactionsColumns[1]},
+			},
+			{
+				Name:    "memberaccounttransaction_merchant_id_store_id",
+				Unique:  false,
+				Columns: []*schema.Column{MemberAccountTransactionsColumns[3], MemberAccountTransactionsColumns[4]},
+			},
+			{
+				Name:    "memberaccounttransaction_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{MemberAccountTransactionsColumns[13]},
+			},
+			{
+				Name:    "memberaccounttransaction_refund_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{MemberAccountTransactionsColumns[15]},
+			},
+		},
+	}
+	// MemberPointsAccountsColumns holds the columns for the "member_points_accounts" table.
+	MemberPointsAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "member_id", Type: field.TypeUUID},
+		{Name: "balance", Type: field.TypeInt64, Default: 0},
+	}
+	// MemberPointsAccountsTable holds the schema information for the "member_points_accounts" table.
+	MemberPointsAccountsTable = &schema.Table{
+		Name:       "member_points_accounts",
+		Columns:    MemberPointsAccountsColumns,
+		PrimaryKey: []*schema.Column{MemberPointsAccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memberpointsaccount_member_id",
+				Unique:  true,
+				Columns: []*schema.Column{MemberPointsAccountsColumns[4]},
+			},
+			{
+				Name:    "memberpointsaccount_merchant_id",
+				Unique:  false,
+				Columns: []*schema.Column{MemberPointsAccountsColumns[3]},
+			},
+		},
+	}
+	// MemberPointsRulesColumns holds the columns for the "member_points_rules" table.
+	MemberPointsRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "enabled", Type: field.TypeBool, Default: false},
+		{Name: "earn_rate", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "category_rates", Type: field.TypeJSON, Nullable: true},
+		{Name: "tier_multipliers", Type: field.TypeJSON, Nullable: true},
+		{Name: "expire_days", Type: field.TypeInt, Default: 0},
+		{Name: "redeem_enabled", Type: field.TypeBool, Default: false},
+		{Name: "redeem_rate", Type: field.TypeInt64, Default: 0},
+		{Name: "redeem_min_points", Type: field.TypeInt64, Default: 0},
+		{Name: "redeem_max_percent", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "redeem_products", Type: field.TypeJSON, Nullable: true},
+	}
+	// MemberPointsRulesTable holds the schema information for the "member_points_rules" table.
+	MemberPointsRulesTable = &schema.Table{
+		Name:       "member_points_rules",
+		Columns:    MemberPointsRulesColumns,
+		PrimaryKey: []*schema.Column{MemberPointsRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memberpointsrule_merchant_id",
+				Unique:  true,
+				Columns: []*schema.Column{MemberPointsRulesColumns[3]},
+			},
+		},
+	}
+	// MemberPointsTransactionsColumns holds the columns for the "member_points_transactions" table.
+	MemberPointsTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "member_id", Type: field.TypeUUID},
+		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"earn", "redeem", "reverse", "restore", "expire", "adjustment"}},
+		{Name: "points", Type: field.TypeInt64},
+		{Name: "balance_after", Type: field.TypeInt64},
+		{Name: "remaining", Type: field.TypeInt64, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "order_no", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "refund_order_id", Type: field.TypeUUID},
+		{Name: "product_id", Type: field.TypeUUID},
+		{Name: "operator_id", Type: field.TypeUUID},
+		{Name: "operator_name", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "remark", Type: field.TypeString, Size: 255, Default: ""},
+	}
+	// MemberPointsTransactionsTable holds the schema information for the "member_points_transactions" table.
+	MemberPointsTransactionsTable = &schema.Table{
+		Name:       "member_points_transactions",
+		Columns:    MemberPointsTransactionsColumns,
+		PrimaryKey: []*schema.Column{MemberPointsTransactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memberpointstransaction_account_id_remaining",
+				Unique:  false,
+				Columns: []*schema.Column{MemberPointsTransactionsColumns[6], MemberPointsTransactionsColumns[10]},
+			},
+			{
+				Name:    "memberpointstransaction_account_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MemberPointsTransactionsColumns[6], MemberPointsTransactionsColumns[1]},
+			},
+			{
+				Name:    "memberpointstransaction_merchant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MemberPointsTransactionsColumns[3], MemberPointsTransactionsColumns[1]},
+			},
+			{
+				Name:    "memberpointstransaction_remaining_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{MemberPointsTransactionsColumns[10], MemberPointsTransactionsColumns[11]},
+			},
+			{
+				Name:    "memberpointstransaction_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{MemberPointsTransactionsColumns[13]},
+			},
+			{
+				Name:    "memberpointstransaction_refund_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{MemberPointsTransactionsColumns[15]},
+			},
+		},
+	}
+	// MemberTiersColumns holds the columns for the "member_tiers" table.
+	MemberTiersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "level", Type: field.TypeInt, Default: 0},
+		{Name: "member_price_enabled", Type: field.TypeBool, Default: true},
+		{Name: "description", Type: field.TypeString, Size: 255, Default: ""},
+	}
+	// MemberTiersTable holds the schema information for the "member_tiers" table.
+	MemberTiersTable = &schema.Table{
+		Name:       "member_tiers",
+		Columns:    MemberTiersColumns,
+		PrimaryKey: []*schema.Column{MemberTiersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "membertier_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{MemberTiersColumns[3]},
+			},
+			{
+				Name:    "membertier_merchant_id_name_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{MemberTiersColumns[4], MemberTiersColumns[5], MemberTiersColumns[3]},
 			},
 		},
 	}
@@ -466,6 +1072,7 @@ var (
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
 		{Name: "base_price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
 		{Name: "member_price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "period_prices", Type: field.TypeJSON, Nullable: true},
 		{Name: "menu_id", Type: field.TypeUUID},
 		{Name: "product_id", Type: field.TypeUUID},
 	}
@@ -477,13 +1084,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "menu_items_menus_items",
-				Columns:    []*schema.Column{MenuItemsColumns[6]},
+				Columns:    []*schema.Column{MenuItemsColumns[7]},
 				RefColumns: []*schema.Column{MenusColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "menu_items_products_menu_items",
-				Columns:    []*schema.Column{MenuItemsColumns[7]},
+				Columns:    []*schema.Column{MenuItemsColumns[8]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -497,12 +1104,54 @@ var (
 			{
 				Name:    "menuitem_menu_id",
 				Unique:  false,
-				Columns: []*schema.Column{MenuItemsColumns[6]},
+				Columns: []*schema.Column{MenuItemsColumns[7]},
 			},
 			{
 				Name:    "menuitem_product_id",
 				Unique:  false,
-				Columns: []*schema.Column{MenuItemsColumns[7]},
+				Columns: []*schema.Column{MenuItemsColumns[8]},
+			},
+		},
+	}
+	// MenuVersionsColumns holds the columns for the "menu_versions" table.
+	MenuVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "menu_id", Type: field.TypeUUID},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "published", "archived"}, Default: "draft"},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "store_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "items", Type: field.TypeJSON, Nullable: true},
+		{Name: "scheduled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "publish_error", Type: field.TypeString, Size: 1000, Default: ""},
+		{Name: "operator_id", Type: field.TypeUUID},
+		{Name: "operator_name", Type: field.TypeString, Size: 255, Default: ""},
+	}
+	// MenuVersionsTable holds the schema information for the "menu_versions" table.
+	MenuVersionsTable = &schema.Table{
+		Name:       "menu_versions",
+		Columns:    MenuVersionsColumns,
+		PrimaryKey: []*schema.Column{MenuVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "menuversion_menu_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{MenuVersionsColumns[3], MenuVersionsColumns[6]},
+			},
+			{
+				Name:    "menuversion_menu_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{MenuVersionsColumns[3], MenuVersionsColumns[7]},
+			},
+			{
+				Name:    "menuversion_status_scheduled_at",
+				Unique:  false,
+				Columns: []*schema.Column{MenuVersionsColumns[7], MenuVersionsColumns[11]},
 			},
 		},
 	}
@@ -620,26 +1269,30 @@ var (
 		{Name: "business_date", Type: field.TypeString},
 		{Name: "shift_no", Type: field.TypeString, Nullable: true},
 		{Name: "order_no", Type: field.TypeString},
+		{Name: "pickup_no", Type: field.TypeString, Size: 16, Default: ""},
 		{Name: "order_type", Type: field.TypeEnum, Enums: []string{"SALE", "REFUND", "PARTIAL_REFUND"}, Default: "SALE"},
 		{Name: "placed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "paid_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "placed_by", Type: field.TypeUUID, Nullable: true},
 		{Name: "placed_by_name", Type: field.TypeString, Nullable: true},
-		{Name: "dining_mode", Type: field.TypeEnum, Enums: []string{"DINE_IN"}, Default: "DINE_IN"},
+		{Name: "dining_mode", Type: field.TypeEnum, Enums: []string{"DINE_IN", "DELIVERY"}, Default: "DINE_IN"},
 		{Name: "order_status", Type: field.TypeEnum, Enums: []string{"PLACED", "COMPLETED", "CANCELLED"}, Default: "PLACED"},
 		{Name: "payment_status", Type: field.TypeEnum, Enums: []string{"UNPAID", "PAYING", "PAID", "REFUNDED"}, Default: "UNPAID"},
 		{Name: "table_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "table_name", Type: field.TypeString, Nullable: true},
 		{Name: "guest_count", Type: field.TypeInt, Nullable: true},
 		{Name: "store", Type: field.TypeJSON},
-		{Name: "channel", Type: field.TypeEnum, Enums: []string{"POS", "H5", "APP"}, Default: "POS"},
+		{Name: "channel", Type: field.TypeEnum, Enums: []string{"POS", "H5", "APP", "KIOSK", "DELIVERY"}, Default: "POS"},
 		{Name: "pos", Type: field.TypeJSON},
 		{Name: "cashier", Type: field.TypeJSON},
 		{Name: "tax_rates", Type: field.TypeJSON, Nullable: true},
 		{Name: "fees", Type: field.TypeJSON, Nullable: true},
 		{Name: "payments", Type: field.TypeJSON, Nullable: true},
 		{Name: "amount", Type: field.TypeJSON},
+		{Name: "promotions", Type: field.TypeJSON, Nullable: true},
+		{Name: "member_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "member", Type: field.TypeJSON, Nullable: true},
 		{Name: "remark", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "operation_logs", Type: field.TypeJSON, Nullable: true},
 	}
@@ -667,17 +1320,22 @@ var (
 			{
 				Name:    "order_table_id",
 				Unique:  false,
-				Columns: []*schema.Column{OrdersColumns[18]},
+				Columns: []*schema.Column{OrdersColumns[19]},
 			},
 			{
 				Name:    "order_order_status",
 				Unique:  false,
-				Columns: []*schema.Column{OrdersColumns[16]},
+				Columns: []*schema.Column{OrdersColumns[17]},
 			},
 			{
 				Name:    "order_payment_status",
 				Unique:  false,
-				Columns: []*schema.Column{OrdersColumns[17]},
+				Columns: []*schema.Column{OrdersColumns[18]},
+			},
+			{
+				Name:    "order_member_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[31]},
 			},
 			{
 				Name:    "order_store_id_order_no_deleted_at",
@@ -696,6 +1354,7 @@ var (
 		{Name: "index", Type: field.TypeInt, Default: 0},
 		{Name: "product_id", Type: field.TypeUUID},
 		{Name: "product_name", Type: field.TypeString},
+		{Name: "product_name_i18n", Type: field.TypeJSON, Nullable: true},
 		{Name: "product_type", Type: field.TypeEnum, Enums: []string{"normal", "set_meal"}, Default: "normal"},
 		{Name: "category", Type: field.TypeJSON, Nullable: true},
 		{Name: "product_unit", Type: field.TypeJSON, Nullable: true},
@@ -712,6 +1371,7 @@ var (
 		{Name: "amount_after_tax", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(10,4)", "sqlite3": "NUMERIC"}},
 		{Name: "total", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(10,4)", "sqlite3": "NUMERIC"}},
 		{Name: "promotion_discount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(10,4)", "sqlite3": "NUMERIC"}},
+		{Name: "member_discount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(10,4)", "sqlite3": "NUMERIC"}},
 		{Name: "attr_amount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(10,4)", "sqlite3": "NUMERIC"}},
 		{Name: "gift_amount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(10,4)", "sqlite3": "NUMERIC"}},
 		{Name: "void_qty", Type: field.TypeInt, Default: 0},
@@ -734,152 +1394,379 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "order_products_orders_order_products",
-				Columns:    []*schema.Column{OrderProductsColumns[36]},
+				Columns:    []*schema.Column{OrderProductsColumns[38]},
 				RefColumns: []*schema.Column{OrdersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "orderproduct_deleted_at",
-				Unique:  false,
-				Columns: []*schema.Column{OrderProductsColumns[3]},
-			},
-			{
-				Name:    "orderproduct_order_id",
+				Name:    "orderproduct_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{OrderProductsColumns[3]},
+			},
+			{
+				Name:    "orderproduct_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrderProductsColumns[38]},
+			},
+			{
+				Name:    "orderproduct_product_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrderProductsColumns[6]},
+			},
+			{
+				Name:    "orderproduct_order_id_order_item_id",
+				Unique:  true,
+				Columns: []*schema.Column{OrderProductsColumns[38], OrderProductsColumns[4]},
+			},
+		},
+	}
+	// PaymentAccountsColumns holds the columns for the "payment_accounts" table.
+	PaymentAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "channel", Type: field.TypeEnum, Enums: []string{"rm"}},
+		{Name: "merchant_number", Type: field.TypeString, Size: 255},
+		{Name: "merchant_name", Type: field.TypeString, Size: 255},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+	}
+	// PaymentAccountsTable holds the schema information for the "payment_accounts" table.
+	PaymentAccountsTable = &schema.Table{
+		Name:       "payment_accounts",
+		Columns:    PaymentAccountsColumns,
+		PrimaryKey: []*schema.Column{PaymentAccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentaccount_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentAccountsColumns[3]},
+			},
+			{
+				Name:    "paymentaccount_merchant_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentAccountsColumns[4]},
+			},
+			{
+				Name:    "paymentaccount_merchant_id_channel_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentAccountsColumns[4], PaymentAccountsColumns[5], PaymentAccountsColumns[3]},
+			},
+		},
+	}
+	// PaymentMethodsColumns holds the columns for the "payment_methods" table.
+	PaymentMethodsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "source_payment_method_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "accounting_rule", Type: field.TypeEnum, Enums: []string{"income", "discount"}, Default: "income"},
+		{Name: "payment_type", Type: field.TypeEnum, Enums: []string{"cash", "online_payment", "member_card", "custom_coupon", "partner_coupon", "bank_card"}, Default: "cash"},
+		{Name: "fee_rate", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(10,2)", "sqlite3": "NUMERIC"}},
+		{Name: "invoice_rule", Type: field.TypeEnum, Nullable: true, Enums: []string{"no_invoice", "actual_amount"}},
+		{Name: "cash_drawer_status", Type: field.TypeBool, Default: false},
+		{Name: "display_channels", Type: field.TypeJSON, Nullable: true},
+		{Name: "source", Type: field.TypeEnum, Nullable: true, Enums: []string{"brand", "store", "system"}},
+		{Name: "status", Type: field.TypeBool, Default: false},
+	}
+	// PaymentMethodsTable holds the schema information for the "payment_methods" table.
+	PaymentMethodsTable = &schema.Table{
+		Name:       "payment_methods",
+		Columns:    PaymentMethodsColumns,
+		PrimaryKey: []*schema.Column{PaymentMethodsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentmethod_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentMethodsColumns[3]},
+			},
+			{
+				Name:    "paymentmethod_merchant_id_store_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentMethodsColumns[5], PaymentMethodsColumns[6]},
+			},
+		},
+	}
+	// PermissionsColumns holds the columns for the "permissions" table.
+	PermissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "perm_code", Type: field.TypeString, Size: 150},
+		{Name: "name", Type: field.TypeString, Size: 150},
+		{Name: "method", Type: field.TypeString, Size: 10},
+		{Name: "path", Type: field.TypeString, Size: 255},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "menu_id", Type: field.TypeUUID},
+	}
+	// PermissionsTable holds the schema information for the "permissions" table.
+	PermissionsTable = &schema.Table{
+		Name:       "permissions",
+		Columns:    PermissionsColumns,
+		PrimaryKey: []*schema.Column{PermissionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "permissions_router_menus_permissions",
+				Columns:    []*schema.Column{PermissionsColumns[9]},
+				RefColumns: []*schema.Column{RouterMenusColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "permission_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{PermissionsColumns[3]},
+			},
+			{
+				Name:    "permission_menu_id",
+				Unique:  false,
+				Columns: []*schema.Column{PermissionsColumns[9]},
+			},
+			{
+				Name:    "permission_perm_code_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{PermissionsColumns[4], PermissionsColumns[3]},
+			},
+			{
+				Name:    "permission_method_path_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{PermissionsColumns[6], PermissionsColumns[7], PermissionsColumns[3]},
+			},
+		},
+	}
+	// PickupCallsColumns holds the columns for the "pickup_calls" table.
+	PickupCallsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "order_no", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "pickup_no", Type: field.TypeString, Size: 16},
+		{Name: "business_date", Type: field.TypeString, Size: 10},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "ready", "picked_up"}},
+		{Name: "call_count", Type: field.TypeInt, Default: 0},
+		{Name: "ready_at", Type: field.TypeTime, Nullable: true},
+		{Name: "picked_up_at", Type: field.TypeTime, Nullable: true},
+	}
+	// PickupCallsTable holds the schema information for the "pickup_calls" table.
+	PickupCallsTable = &schema.Table{
+		Name:       "pickup_calls",
+		Columns:    PickupCallsColumns,
+		PrimaryKey: []*schema.Column{PickupCallsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pickupcall_order_id",
+				Unique:  true,
+				Columns: []*schema.Column{PickupCallsColumns[5]},
+			},
+			{
+				Name:    "pickupcall_store_id_business_date_status",
+				Unique:  false,
+				Columns: []*schema.Column{PickupCallsColumns[4], PickupCallsColumns[8], PickupCallsColumns[9]},
+			},
+		},
+	}
+	// PickupRulesColumns holds the columns for the "pickup_rules" table.
+	PickupRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "prefix", Type: field.TypeString, Size: 4, Default: ""},
+		{Name: "start", Type: field.TypeInt},
+		{Name: "end", Type: field.TypeInt},
+	}
+	// PickupRulesTable holds the schema information for the "pickup_rules" table.
+	PickupRulesTable = &schema.Table{
+		Name:       "pickup_rules",
+		Columns:    PickupRulesColumns,
+		PrimaryKey: []*schema.Column{PickupRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pickuprule_merchant_id_store_id",
+				Unique:  true,
+				Columns: []*schema.Column{PickupRulesColumns[3], PickupRulesColumns[4]},
+			},
+		},
+	}
+	// PreordersColumns holds the columns for the "preorders" table.
+	PreordersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "customer_id", Type: field.TypeUUID},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "order_no", Type: field.TypeString, Size: 64},
+		{Name: "fulfilment", Type: field.TypeEnum, Enums: []string{"pickup", "delivery"}},
+		{Name: "scheduled_at", Type: field.TypeTime},
+		{Name: "release_at", Type: field.TypeTime},
+		{Name: "contact_name", Type: field.TypeString, Size: 64},
+		{Name: "contact_phone", Type: field.TypeString, Size: 32},
+		{Name: "delivery_address", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"scheduled", "released", "cancelled"}},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancel_reason", Type: field.TypeString, Size: 255, Default: ""},
+	}
+	// PreordersTable holds the schema information for the "preorders" table.
+	PreordersTable = &schema.Table{
+		Name:       "preorders",
+		Columns:    PreordersColumns,
+		PrimaryKey: []*schema.Column{PreordersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "preorder_store_id_scheduled_at",
 				Unique:  false,
-				Columns: []*schema.Column{OrderProductsColumns[36]},
+				Columns: []*schema.Column{PreordersColumns[4], PreordersColumns[9]},
 			},
 			{
-				Name:    "orderproduct_product_id",
+				Name:    "preorder_customer_id_scheduled_at",
 				Unique:  false,
-				Columns: []*schema.Column{OrderProductsColumns[6]},
+				Columns: []*schema.Column{PreordersColumns[5], PreordersColumns[9]},
 			},
 			{
-				Name:    "orderproduct_order_id_order_item_id",
+				Name:    "preorder_order_id",
 				Unique:  true,
-				Columns: []*schema.Column{OrderProductsColumns[36], OrderProductsColumns[4]},
+				Columns: []*schema.Column{PreordersColumns[6]},
 			},
 		},
 	}
-	// PaymentAccountsColumns holds the columns for the "payment_accounts" table.
-	PaymentAccountsColumns = []*schema.Column{
+	// PreorderRulesColumns holds the columns for the "preorder_rules" table.
+	PreorderRulesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
-		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
 		{Name: "merchant_id", Type: field.TypeUUID},
-		{Name: "channel", Type: field.TypeEnum, Enums: []string{"rm"}},
-		{Name: "merchant_number", Type: field.TypeString, Size: 255},
-		{Name: "merchant_name", Type: field.TypeString, Size: 255},
-		{Name: "is_default", Type: field.TypeBool, Default: false},
-	}
-	// PaymentAccountsTable holds the schema information for the "payment_accounts" table.
-	PaymentAccountsTable = &schema.Table{
-		Name:       "payment_accounts",
-		Columns:    PaymentAccountsColumns,
-		PrimaryKey: []*schema.Column{PaymentAccountsColumns[0]},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "enabled", Type: field.TypeBool, Default: false},
+		{Name: "delivery_enabled", Type: field.TypeBool, Default: false},
+		{Name: "slot_minutes", Type: field.TypeInt},
+		{Name: "slot_capacity", Type: field.TypeInt},
+		{Name: "lead_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "prep_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "max_advance_days", Type: field.TypeInt, Default: 0},
+	}
+	// PreorderRulesTable holds the schema information for the "preorder_rules" table.
+	PreorderRulesTable = &schema.Table{
+		Name:       "preorder_rules",
+		Columns:    PreorderRulesColumns,
+		PrimaryKey: []*schema.Column{PreorderRulesColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "paymentaccount_deleted_at",
-				Unique:  false,
-				Columns: []*schema.Column{PaymentAccountsColumns[3]},
-			},
-			{
-				Name:    "paymentaccount_merchant_id",
-				Unique:  false,
-				Columns: []*schema.Column{PaymentAccountsColumns[4]},
-			},
-			{
-				Name:    "paymentaccount_merchant_id_channel_deleted_at",
+				Name:    "preorderrule_store_id",
 				Unique:  true,
-				Columns: []*schema.Column{PaymentAccountsColumns[4], PaymentAccountsColumns[5], PaymentAccountsColumns[3]},
+				Columns: []*schema.Column{PreorderRulesColumns[4]},
 			},
 		},
 	}
-	// PaymentMethodsColumns holds the columns for the "payment_methods" table.
-	PaymentMethodsColumns = []*schema.Column{
+	// PriceChangeBatchesColumns holds the columns for the "price_change_batches" table.
+	PriceChangeBatchesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
-		{Name: "source_payment_method_id", Type: field.TypeUUID, Nullable: true},
-		{Name: "merchant_id", Type: field.TypeUUID},
-		{Name: "store_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "name", Type: field.TypeString, Size: 255},
-		{Name: "accounting_rule", Type: field.TypeEnum, Enums: []string{"income", "discount"}, Default: "income"},
-		{Name: "payment_type", Type: field.TypeEnum, Enums: []string{"cash", "online_payment", "member_card", "custom_coupon", "partner_coupon", "bank_card"}, Default: "cash"},
-		{Name: "fee_rate", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(10,2)", "sqlite3": "NUMERIC"}},
-		{Name: "invoice_rule", Type: field.TypeEnum, Nullable: true, Enums: []string{"no_invoice", "actual_amount"}},
-		{Name: "cash_drawer_status", Type: field.TypeBool, Default: false},
-		{Name: "display_channels", Type: field.TypeJSON, Nullable: true},
-		{Name: "source", Type: field.TypeEnum, Nullable: true, Enums: []string{"brand", "store", "system"}},
-		{Name: "status", Type: field.TypeBool, Default: false},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "store_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "effective_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "applied", "cancelled", "rolled_back", "failed"}, Default: "pending"},
+		{Name: "remark", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "fail_reason", Type: field.TypeString, Size: 1000, Default: ""},
+		{Name: "applied_at", Type: field.TypeTime, Nullable: true},
+		{Name: "operator_id", Type: field.TypeUUID},
+		{Name: "operator_name", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "operation_logs", Type: field.TypeJSON, Nullable: true},
 	}
-	// PaymentMethodsTable holds the schema information for the "payment_methods" table.
-	PaymentMethodsTable = &schema.Table{
-		Name:       "payment_methods",
-		Columns:    PaymentMethodsColumns,
-		PrimaryKey: []*schema.Column{PaymentMethodsColumns[0]},
+	// PriceChangeBatchesTable holds the schema information for the "price_change_batches" table.
+	PriceChangeBatchesTable = &schema.Table{
+		Name:       "price_change_batches",
+		Columns:    PriceChangeBatchesColumns,
+		PrimaryKey: []*schema.Column{PriceChangeBatchesColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "paymentmethod_deleted_at",
+				Name:    "pricechangebatch_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentMethodsColumns[3]},
+				Columns: []*schema.Column{PriceChangeBatchesColumns[3]},
 			},
 			{
-				Name:    "paymentmethod_merchant_id_store_id",
+				Name:    "pricechangebatch_merchant_id",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentMethodsColumns[5], PaymentMethodsColumns[6]},
+				Columns: []*schema.Column{PriceChangeBatchesColumns[5]},
+			},
+			{
+				Name:    "pricechangebatch_store_id",
+				Unique:  false,
+				Columns: []*schema.Column{PriceChangeBatchesColumns[6]},
+			},
+			{
+				Name:    "pricechangebatch_status_effective_at",
+				Unique:  false,
+				Columns: []*schema.Column{PriceChangeBatchesColumns[9], PriceChangeBatchesColumns[8]},
 			},
 		},
 	}
-	// PermissionsColumns holds the columns for the "permissions" table.
-	PermissionsColumns = []*schema.Column{
+	// PriceChangeItemsColumns holds the columns for the "price_change_items" table.
+	PriceChangeItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
-		{Name: "perm_code", Type: field.TypeString, Size: 150},
-		{Name: "name", Type: field.TypeString, Size: 150},
-		{Name: "method", Type: field.TypeString, Size: 10},
-		{Name: "path", Type: field.TypeString, Size: 255},
-		{Name: "enabled", Type: field.TypeBool, Default: true},
-		{Name: "menu_id", Type: field.TypeUUID},
-	}
-	// PermissionsTable holds the schema information for the "permissions" table.
-	PermissionsTable = &schema.Table{
-		Name:       "permissions",
-		Columns:    PermissionsColumns,
-		PrimaryKey: []*schema.Column{PermissionsColumns[0]},
+		{Name: "target_type", Type: field.TypeEnum, Enums: []string{"product_spec", "menu_item"}},
+		{Name: "product_id", Type: field.TypeUUID},
+		{Name: "spec_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "product_name", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "spec_name", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "new_price", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "old_price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "target_id", Type: field.TypeUUID},
+		{Name: "applied", Type: field.TypeBool, Default: false},
+		{Name: "menu_item_old_prices", Type: field.TypeJSON, Nullable: true},
+		{Name: "batch_id", Type: field.TypeUUID},
+	}
+	// PriceChangeItemsTable holds the schema information for the "price_change_items" table.
+	PriceChangeItemsTable = &schema.Table{
+		Name:       "price_change_items",
+		Columns:    PriceChangeItemsColumns,
+		PrimaryKey: []*schema.Column{PriceChangeItemsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "permissions_router_menus_permissions",
-				Columns:    []*schema.Column{PermissionsColumns[9]},
-				RefColumns: []*schema.Column{RouterMenusColumns[0]},
+				Symbol:     "price_change_items_price_change_batches_items",
+				Columns:    []*schema.Column{PriceChangeItemsColumns[15]},
+				RefColumns: []*schema.Column{PriceChangeBatchesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "permission_deleted_at",
+				Name:    "pricechangeitem_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{PermissionsColumns[3]},
+				Columns: []*schema.Column{PriceChangeItemsColumns[3]},
 			},
 			{
-				Name:    "permission_menu_id",
+				Name:    "pricechangeitem_batch_id",
 				Unique:  false,
-				Columns: []*schema.Column{PermissionsColumns[9]},
-			},
-			{
-				Name:    "permission_perm_code_deleted_at",
-				Unique:  true,
-				Columns: []*schema.Column{PermissionsColumns[4], PermissionsColumns[3]},
+				Columns: []*schema.Column{PriceChangeItemsColumns[15]},
 			},
 			{
-				Name:    "permission_method_path_deleted_at",
-				Unique:  true,
-				Columns: []*schema.Column{PermissionsColumns[6], PermissionsColumns[7], PermissionsColumns[3]},
+				Name:    "pricechangeitem_product_id",
+				Unique:  false,
+				Columns: []*schema.Column{PriceChangeItemsColumns[5]},
 			},
 		},
 	}
@@ -893,6 +1780,8 @@ var (
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "menu_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "mnemonic", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "pinyin", Type: field.TypeString, Size: 2000, Default: ""},
+		{Name: "pinyin_initials", Type: field.TypeString, Size: 255, Default: ""},
 		{Name: "shelf_life", Type: field.TypeInt, Default: 0},
 		{Name: "support_types", Type: field.TypeJSON},
 		{Name: "sale_status", Type: field.TypeEnum, Enums: []string{"on_sale", "off_sale"}, Default: "on_sale"},
@@ -907,6 +1796,15 @@ var (
 		{Name: "main_image", Type: field.TypeString, Size: 512, Default: ""},
 		{Name: "detail_images", Type: field.TypeJSON, Nullable: true},
 		{Name: "description", Type: field.TypeString, Size: 2000, Default: ""},
+		{Name: "name_i18n", Type: field.TypeJSON, Nullable: true},
+		{Name: "description_i18n", Type: field.TypeJSON, Nullable: true},
+		{Name: "allergens", Type: field.TypeJSON, Nullable: true},
+		{Name: "halal", Type: field.TypeBool, Default: false},
+		{Name: "vegetarian", Type: field.TypeBool, Default: false},
+		{Name: "vegan", Type: field.TypeBool, Default: false},
+		{Name: "spicy_level", Type: field.TypeInt, Default: 0},
+		{Name: "nutrition", Type: field.TypeJSON, Nullable: true},
+		{Name: "attr_limits", Type: field.TypeJSON, Nullable: true},
 		{Name: "estimated_cost_price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
 		{Name: "delivery_cost_price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
 		{Name: "merchant_id", Type: field.TypeUUID},
@@ -924,25 +1822,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "products_categories_products",
-				Columns:    []*schema.Column{ProductsColumns[26]},
+				Columns:    []*schema.Column{ProductsColumns[37]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "products_product_units_products",
-				Columns:    []*schema.Column{ProductsColumns[27]},
+				Columns:    []*schema.Column{ProductsColumns[38]},
 				RefColumns: []*schema.Column{ProductUnitsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "products_stalls_products",
-				Columns:    []*schema.Column{ProductsColumns[28]},
+				Columns:    []*schema.Column{ProductsColumns[39]},
 				RefColumns: []*schema.Column{StallsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "products_tax_fees_products",
-				Columns:    []*schema.Column{ProductsColumns[29]},
+				Columns:    []*schema.Column{ProductsColumns[40]},
 				RefColumns: []*schema.Column{TaxFeesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -956,22 +1854,27 @@ var (
 			{
 				Name:    "product_merchant_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductsColumns[24]},
+				Columns: []*schema.Column{ProductsColumns[35]},
 			},
 			{
 				Name:    "product_store_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductsColumns[25]},
+				Columns: []*schema.Column{ProductsColumns[36]},
 			},
 			{
 				Name:    "product_category_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductsColumns[26]},
+				Columns: []*schema.Column{ProductsColumns[37]},
+			},
+			{
+				Name:    "product_store_id_pinyin_initials",
+				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[36], ProductsColumns[9]},
 			},
 			{
 				Name:    "product_merchant_id_store_id_name_deleted_at",
 				Unique:  true,
-				Columns: []*schema.Column{ProductsColumns[24], ProductsColumns[25], ProductsColumns[5], ProductsColumns[3]},
+				Columns: []*schema.Column{ProductsColumns[35], ProductsColumns[36], ProductsColumns[5], ProductsColumns[3]},
 			},
 		},
 	}
@@ -982,6 +1885,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
 		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "name_i18n", Type: field.TypeJSON, Nullable: true},
 		{Name: "channels", Type: field.TypeJSON},
 		{Name: "merchant_id", Type: field.TypeUUID},
 		{Name: "store_id", Type: field.TypeUUID},
@@ -1001,17 +1905,17 @@ var (
 			{
 				Name:    "productattr_merchant_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductAttrsColumns[6]},
+				Columns: []*schema.Column{ProductAttrsColumns[7]},
 			},
 			{
 				Name:    "productattr_store_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductAttrsColumns[7]},
+				Columns: []*schema.Column{ProductAttrsColumns[8]},
 			},
 			{
 				Name:    "productattr_merchant_id_store_id_name_deleted_at",
 				Unique:  true,
-				Columns: []*schema.Column{ProductAttrsColumns[6], ProductAttrsColumns[7], ProductAttrsColumns[4], ProductAttrsColumns[3]},
+				Columns: []*schema.Column{ProductAttrsColumns[7], ProductAttrsColumns[8], ProductAttrsColumns[4], ProductAttrsColumns[3]},
 			},
 		},
 	}
@@ -1022,6 +1926,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
 		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "name_i18n", Type: field.TypeJSON, Nullable: true},
 		{Name: "image", Type: field.TypeString, Size: 512, Default: ""},
 		{Name: "base_price", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(10,2)", "sqlite3": "NUMERIC"}},
 		{Name: "product_count", Type: field.TypeInt, Default: 0},
@@ -1035,7 +1940,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "product_attr_items_product_attrs_items",
-				Columns:    []*schema.Column{ProductAttrItemsColumns[8]},
+				Columns:    []*schema.Column{ProductAttrItemsColumns[9]},
 				RefColumns: []*schema.Column{ProductAttrsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1049,12 +1954,12 @@ var (
 			{
 				Name:    "productattritem_attr_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductAttrItemsColumns[8]},
+				Columns: []*schema.Column{ProductAttrItemsColumns[9]},
 			},
 			{
 				Name:    "productattritem_attr_id_name_deleted_at",
 				Unique:  true,
-				Columns: []*schema.Column{ProductAttrItemsColumns[8], ProductAttrItemsColumns[4], ProductAttrItemsColumns[3]},
+				Columns: []*schema.Column{ProductAttrItemsColumns[9], ProductAttrItemsColumns[4], ProductAttrItemsColumns[3]},
 			},
 		},
 	}
@@ -1065,6 +1970,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
 		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "max_quantity", Type: field.TypeInt, Default: 1},
 		{Name: "product_id", Type: field.TypeUUID},
 		{Name: "attr_id", Type: field.TypeUUID},
 		{Name: "attr_item_id", Type: field.TypeUUID},
@@ -1077,19 +1983,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "product_attr_relations_products_product_attrs",
-				Columns:    []*schema.Column{ProductAttrRelationsColumns[5]},
+				Columns:    []*schema.Column{ProductAttrRelationsColumns[6]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "product_attr_relations_product_attrs_product_attrs",
-				Columns:    []*schema.Column{ProductAttrRelationsColumns[6]},
+				Columns:    []*schema.Column{ProductAttrRelationsColumns[7]},
 				RefColumns: []*schema.Column{ProductAttrsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "product_attr_relations_product_attr_items_product_attrs",
-				Columns:    []*schema.Column{ProductAttrRelationsColumns[7]},
+				Columns:    []*schema.Column{ProductAttrRelationsColumns[8]},
 				RefColumns: []*schema.Column{ProductAttrItemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1103,17 +2009,17 @@ var (
 			{
 				Name:    "productattrrelation_product_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductAttrRelationsColumns[5]},
+				Columns: []*schema.Column{ProductAttrRelationsColumns[6]},
 			},
 			{
 				Name:    "productattrrelation_attr_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductAttrRelationsColumns[6]},
+				Columns: []*schema.Column{ProductAttrRelationsColumns[7]},
 			},
 			{
 				Name:    "productattrrelation_attr_item_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductAttrRelationsColumns[7]},
+				Columns: []*schema.Column{ProductAttrRelationsColumns[8]},
 			},
 		},
 	}
@@ -1170,6 +2076,7 @@ var (
 		{Name: "other_price3", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "DECIMAL(10,2)", "sqlite3": "NUMERIC"}},
 		{Name: "barcode", Type: field.TypeString, Size: 255, Default: ""},
 		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "period_prices", Type: field.TypeJSON, Nullable: true},
 		{Name: "packing_fee_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "product_id", Type: field.TypeUUID},
 		{Name: "spec_id", Type: field.TypeUUID},
@@ -1182,19 +2089,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "product_spec_relations_additional_fees_product_specs",
-				Columns:    []*schema.Column{ProductSpecRelationsColumns[12]},
+				Columns:    []*schema.Column{ProductSpecRelationsColumns[13]},
 				RefColumns: []*schema.Column{AdditionalFeesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "product_spec_relations_products_product_specs",
-				Columns:    []*schema.Column{ProductSpecRelationsColumns[13]},
+				Columns:    []*schema.Column{ProductSpecRelationsColumns[14]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "product_spec_relations_product_specs_product_specs",
-				Columns:    []*schema.Column{ProductSpecRelationsColumns[14]},
+				Columns:    []*schema.Column{ProductSpecRelationsColumns[15]},
 				RefColumns: []*schema.Column{ProductSpecsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1208,17 +2115,17 @@ var (
 			{
 				Name:    "productspecrelation_product_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductSpecRelationsColumns[13]},
+				Columns: []*schema.Column{ProductSpecRelationsColumns[14]},
 			},
 			{
 				Name:    "productspecrelation_spec_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductSpecRelationsColumns[14]},
+				Columns: []*schema.Column{ProductSpecRelationsColumns[15]},
 			},
 			{
 				Name:    "productspecrelation_product_id_spec_id_deleted_at",
 				Unique:  true,
-				Columns: []*schema.Column{ProductSpecRelationsColumns[13], ProductSpecRelationsColumns[14], ProductSpecRelationsColumns[3]},
+				Columns: []*schema.Column{ProductSpecRelationsColumns[14], ProductSpecRelationsColumns[15], ProductSpecRelationsColumns[3]},
 			},
 		},
 	}
@@ -1301,6 +2208,54 @@ var (
 			},
 		},
 	}
+	// ProductVersionsColumns holds the columns for the "product_versions" table.
+	ProductVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "subject_type", Type: field.TypeEnum, Enums: []string{"product", "product_spec", "attr_item", "menu_item"}},
+		{Name: "product_id", Type: field.TypeUUID},
+		{Name: "subject_id", Type: field.TypeUUID},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "restore"}},
+		{Name: "name", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "snapshot", Type: field.TypeJSON},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "restored_from", Type: field.TypeInt, Default: 0},
+		{Name: "operator_id", Type: field.TypeUUID},
+		{Name: "operator_type", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "operator_name", Type: field.TypeString, Size: 255, Default: ""},
+	}
+	// ProductVersionsTable holds the schema information for the "product_versions" table.
+	ProductVersionsTable = &schema.Table{
+		Name:       "product_versions",
+		Columns:    ProductVersionsColumns,
+		PrimaryKey: []*schema.Column{ProductVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productversion_merchant_id_store_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductVersionsColumns[6], ProductVersionsColumns[7]},
+			},
+			{
+				Name:    "productversion_product_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductVersionsColumns[4]},
+			},
+			{
+				Name:    "productversion_subject_type_product_id_subject_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{ProductVersionsColumns[3], ProductVersionsColumns[4], ProductVersionsColumns[5], ProductVersionsColumns[8]},
+			},
+			{
+				Name:    "productversion_subject_type_product_id_subject_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProductVersionsColumns[3], ProductVersionsColumns[4], ProductVersionsColumns[5], ProductVersionsColumns[1]},
+			},
+		},
+	}
 	// ProfitDistributionBillsColumns holds the columns for the "profit_distribution_bills" table.
 	ProfitDistributionBillsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -1399,6 +2354,88 @@ var (
 			},
 		},
 	}
+	// PromotionsColumns holds the columns for the "promotions" table.
+	PromotionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"order_discount", "item_discount", "buy_x_get_y", "second_item_discount"}},
+		{Name: "rule", Type: field.TypeJSON},
+		{Name: "store_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "channels", Type: field.TypeJSON, Nullable: true},
+		{Name: "dining_ways", Type: field.TypeJSON, Nullable: true},
+		{Name: "start_at", Type: field.TypeTime, Nullable: true},
+		{Name: "end_at", Type: field.TypeTime, Nullable: true},
+		{Name: "time_windows", Type: field.TypeJSON, Nullable: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "stackable", Type: field.TypeBool, Default: false},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// PromotionsTable holds the schema information for the "promotions" table.
+	PromotionsTable = &schema.Table{
+		Name:       "promotions",
+		Columns:    PromotionsColumns,
+		PrimaryKey: []*schema.Column{PromotionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "promotion_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{PromotionsColumns[3]},
+			},
+			{
+				Name:    "promotion_merchant_id_store_id",
+				Unique:  false,
+				Columns: []*schema.Column{PromotionsColumns[4], PromotionsColumns[5]},
+			},
+			{
+				Name:    "promotion_merchant_id_store_id_name_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{PromotionsColumns[4], PromotionsColumns[5], PromotionsColumns[6], PromotionsColumns[3]},
+			},
+		},
+	}
+	// ReceiptRequestsColumns holds the columns for the "receipt_requests" table.
+	ReceiptRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "order_no", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"refund", "invoice"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "rejected"}},
+		{Name: "reason", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "contact_phone", Type: field.TypeString, Size: 20, Default: ""},
+		{Name: "invoice_title", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "invoice_tax_no", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "invoice_email", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "handle_remark", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "handled_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "handled_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ReceiptRequestsTable holds the schema information for the "receipt_requests" table.
+	ReceiptRequestsTable = &schema.Table{
+		Name:       "receipt_requests",
+		Columns:    ReceiptRequestsColumns,
+		PrimaryKey: []*schema.Column{ReceiptRequestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "receiptrequest_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReceiptRequestsColumns[5]},
+			},
+			{
+				Name:    "receiptrequest_store_id_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReceiptRequestsColumns[4], ReceiptRequestsColumns[8], ReceiptRequestsColumns[1]},
+			},
+		},
+	}
 	// RefundOrdersColumns holds the columns for the "refund_orders" table.
 	RefundOrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -1425,7 +2462,7 @@ var (
 		{Name: "approved_at", Type: field.TypeTime, Nullable: true},
 		{Name: "refunded_at", Type: field.TypeTime, Nullable: true},
 		{Name: "store", Type: field.TypeJSON},
-		{Name: "channel", Type: field.TypeEnum, Enums: []string{"POS", "H5", "APP"}, Default: "POS"},
+		{Name: "channel", Type: field.TypeEnum, Enums: []string{"POS", "H5", "APP", "KIOSK", "DELIVERY"}, Default: "POS"},
 		{Name: "pos", Type: field.TypeJSON},
 		{Name: "cashier", Type: field.TypeJSON},
 		{Name: "refund_amount", Type: field.TypeJSON},
@@ -1555,6 +2592,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
 		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "name_i18n", Type: field.TypeJSON, Nullable: true},
 		{Name: "remark_type", Type: field.TypeEnum, Enums: []string{"system", "brand", "store"}},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
 		{Name: "sort_order", Type: field.TypeInt, Default: 1000},
@@ -1570,13 +2608,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "remarks_merchants_remarks",
-				Columns:    []*schema.Column{RemarksColumns[9]},
+				Columns:    []*schema.Column{RemarksColumns[10]},
 				RefColumns: []*schema.Column{MerchantsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "remarks_stores_remarks",
-				Columns:    []*schema.Column{RemarksColumns[10]},
+				Columns:    []*schema.Column{RemarksColumns[11]},
 				RefColumns: []*schema.Column{StoresColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1590,17 +2628,92 @@ var (
 			{
 				Name:    "remark_remark_scene",
 				Unique:  false,
-				Columns: []*schema.Column{RemarksColumns[8]},
+				Columns: []*schema.Column{RemarksColumns[9]},
 			},
 			{
 				Name:    "remark_merchant_id",
 				Unique:  false,
-				Columns: []*schema.Column{RemarksColumns[9]},
+				Columns: []*schema.Column{RemarksColumns[10]},
 			},
 			{
 				Name:    "remark_store_id",
 				Unique:  false,
-				Columns: []*schema.Column{RemarksColumns[10]},
+				Columns: []*schema.Column{RemarksColumns[11]},
+			},
+		},
+	}
+	// ReservationsColumns holds the columns for the "reservations" table.
+	ReservationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"store", "online"}},
+		{Name: "customer_id", Type: field.TypeUUID},
+		{Name: "guest_name", Type: field.TypeString, Size: 64},
+		{Name: "guest_phone", Type: field.TypeString, Size: 32},
+		{Name: "party_size", Type: field.TypeInt},
+		{Name: "reserved_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime},
+		{Name: "dining_period", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "table_id", Type: field.TypeUUID},
+		{Name: "table_name", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "area_preference", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "remark", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"booked", "seated", "no_show", "cancelled"}},
+		{Name: "deposit", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+		{Name: "deposit_status", Type: field.TypeEnum, Enums: []string{"none", "unpaid", "paid", "refunded"}},
+		{Name: "deposit_method", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "deposit_paid_at", Type: field.TypeTime, Nullable: true},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "seated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancel_reason", Type: field.TypeString, Size: 255, Default: ""},
+	}
+	// ReservationsTable holds the schema information for the "reservations" table.
+	ReservationsTable = &schema.Table{
+		Name:       "reservations",
+		Columns:    ReservationsColumns,
+		PrimaryKey: []*schema.Column{ReservationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reservation_store_id_reserved_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReservationsColumns[4], ReservationsColumns[10]},
+			},
+			{
+				Name:    "reservation_customer_id_reserved_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReservationsColumns[6], ReservationsColumns[10]},
+			},
+		},
+	}
+	// ReservationRulesColumns holds the columns for the "reservation_rules" table.
+	ReservationRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "online_enabled", Type: field.TypeBool, Default: false},
+		{Name: "slot_minutes", Type: field.TypeInt},
+		{Name: "dining_minutes", Type: field.TypeInt},
+		{Name: "min_advance_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "max_advance_days", Type: field.TypeInt},
+		{Name: "max_party_size", Type: field.TypeInt},
+		{Name: "deposit_per_guest", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "DECIMAL(19,4)", "sqlite3": "NUMERIC"}},
+	}
+	// ReservationRulesTable holds the schema information for the "reservation_rules" table.
+	ReservationRulesTable = &schema.Table{
+		Name:       "reservation_rules",
+		Columns:    ReservationRulesColumns,
+		PrimaryKey: []*schema.Column{ReservationRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reservationrule_store_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReservationRulesColumns[4]},
 			},
 		},
 	}
@@ -1780,6 +2893,29 @@ var (
 			},
 		},
 	}
+	// ScanOrderRulesColumns holds the columns for the "scan_order_rules" table.
+	ScanOrderRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "enabled", Type: field.TypeBool, Default: false},
+		{Name: "pay_mode", Type: field.TypeEnum, Enums: []string{"pay_first", "pay_at_counter"}},
+	}
+	// ScanOrderRulesTable holds the schema information for the "scan_order_rules" table.
+	ScanOrderRulesTable = &schema.Table{
+		Name:       "scan_order_rules",
+		Columns:    ScanOrderRulesColumns,
+		PrimaryKey: []*schema.Column{ScanOrderRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scanorderrule_merchant_id_store_id",
+				Unique:  true,
+				Columns: []*schema.Column{ScanOrderRulesColumns[3], ScanOrderRulesColumns[4]},
+			},
+		},
+	}
 	// SetMealDetailsColumns holds the columns for the "set_meal_details" table.
 	SetMealDetailsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -1789,6 +2925,7 @@ var (
 		{Name: "quantity", Type: field.TypeInt, Default: 1},
 		{Name: "is_default", Type: field.TypeBool, Default: false},
 		{Name: "optional_product_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "optional_surcharges", Type: field.TypeJSON, Nullable: true},
 		{Name: "product_id", Type: field.TypeUUID},
 		{Name: "group_id", Type: field.TypeUUID},
 	}
@@ -1800,13 +2937,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "set_meal_details_products_set_meal_details",
-				Columns:    []*schema.Column{SetMealDetailsColumns[7]},
+				Columns:    []*schema.Column{SetMealDetailsColumns[8]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "set_meal_details_set_meal_groups_details",
-				Columns:    []*schema.Column{SetMealDetailsColumns[8]},
+				Columns:    []*schema.Column{SetMealDetailsColumns[9]},
 				RefColumns: []*schema.Column{SetMealGroupsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1820,12 +2957,12 @@ var (
 			{
 				Name:    "setmealdetail_group_id",
 				Unique:  false,
-				Columns: []*schema.Column{SetMealDetailsColumns[8]},
+				Columns: []*schema.Column{SetMealDetailsColumns[9]},
 			},
 			{
 				Name:    "setmealdetail_product_id",
 				Unique:  false,
-				Columns: []*schema.Column{SetMealDetailsColumns[7]},
+				Columns: []*schema.Column{SetMealDetailsColumns[8]},
 			},
 		},
 	}
@@ -2197,6 +3334,73 @@ var (
 			},
 		},
 	}
+	// WaitlistRulesColumns holds the columns for the "waitlist_rules" table.
+	WaitlistRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "online_enabled", Type: field.TypeBool, Default: false},
+		{Name: "small_max_size", Type: field.TypeInt},
+		{Name: "medium_max_size", Type: field.TypeInt},
+		{Name: "max_party_size", Type: field.TypeInt},
+		{Name: "default_turn_minutes", Type: field.TypeInt},
+	}
+	// WaitlistRulesTable holds the schema information for the "waitlist_rules" table.
+	WaitlistRulesTable = &schema.Table{
+		Name:       "waitlist_rules",
+		Columns:    WaitlistRulesColumns,
+		PrimaryKey: []*schema.Column{WaitlistRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "waitlistrule_store_id",
+				Unique:  true,
+				Columns: []*schema.Column{WaitlistRulesColumns[4]},
+			},
+		},
+	}
+	// WaitlistTicketsColumns holds the columns for the "waitlist_tickets" table.
+	WaitlistTicketsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "merchant_id", Type: field.TypeUUID},
+		{Name: "store_id", Type: field.TypeUUID},
+		{Name: "business_date", Type: field.TypeString, Size: 10},
+		{Name: "bucket", Type: field.TypeEnum, Enums: []string{"small", "medium", "large"}},
+		{Name: "ticket_no", Type: field.TypeString, Size: 16},
+		{Name: "party_size", Type: field.TypeInt},
+		{Name: "guest_phone", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"store", "online"}},
+		{Name: "customer_id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "called", "seated", "skipped", "cancelled"}},
+		{Name: "call_count", Type: field.TypeInt, Default: 0},
+		{Name: "called_at", Type: field.TypeTime, Nullable: true},
+		{Name: "seated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "table_id", Type: field.TypeUUID},
+		{Name: "table_name", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "order_id", Type: field.TypeUUID},
+	}
+	// WaitlistTicketsTable holds the schema information for the "waitlist_tickets" table.
+	WaitlistTicketsTable = &schema.Table{
+		Name:       "waitlist_tickets",
+		Columns:    WaitlistTicketsColumns,
+		PrimaryKey: []*schema.Column{WaitlistTicketsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "waitlistticket_store_id_business_date",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistTicketsColumns[4], WaitlistTicketsColumns[5]},
+			},
+			{
+				Name:    "waitlistticket_customer_id_business_date",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistTicketsColumns[11], WaitlistTicketsColumns[5]},
+			},
+		},
+	}
 	// MenuStoreRelationsColumns holds the columns for the "menu_store_relations" table.
 	MenuStoreRelationsColumns = []*schema.Column{
 		{Name: "menu_id", Type: field.TypeUUID},
@@ -2279,10 +3483,26 @@ var (
 		BackendUsersTable,
 		BusinessConfigsTable,
 		CategoriesTable,
+		CouponsTable,
+		CouponTemplatesTable,
+		CustomersTable,
+		DeliveryOrdersTable,
+		DeliveryProductMappingsTable,
+		DeliveryStoresTable,
 		DepartmentsTable,
 		DevicesTable,
+		DiningTablesTable,
+		KitchenTicketsTable,
+		MembersTable,
+		MemberAccountsTable,
+		MemberAccountTransactionsTable,
+		MemberPointsAccountsTable,
+		MemberPointsRulesTable,
+		MemberPointsTransactionsTable,
+		MemberTiersTable,
 		MenusTable,
 		MenuItemsTable,
+		MenuVersionsTable,
 		MerchantsTable,
 		MerchantBusinessTypesTable,
 		MerchantRenewalsTable,
@@ -2291,6 +3511,12 @@ var (
 		PaymentAccountsTable,
 		PaymentMethodsTable,
 		PermissionsTable,
+		PickupCallsTable,
+		PickupRulesTable,
+		PreordersTable,
+		PreorderRulesTable,
+		PriceChangeBatchesTable,
+		PriceChangeItemsTable,
 		ProductsTable,
 		ProductAttrsTable,
 		ProductAttrItemsTable,
@@ -2299,15 +3525,21 @@ var (
 		ProductSpecRelationsTable,
 		ProductTagsTable,
 		ProductUnitsTable,
+		ProductVersionsTable,
 		ProfitDistributionBillsTable,
 		ProfitDistributionRulesTable,
+		PromotionsTable,
+		ReceiptRequestsTable,
 		RefundOrdersTable,
 		RefundOrderProductsTable,
 		RemarksTable,
+		ReservationsTable,
+		ReservationRulesTable,
 		RolesTable,
 		RoleMenusTable,
 		RolePermissionsTable,
 		RouterMenusTable,
+		ScanOrderRulesTable,
 		SetMealDetailsTable,
 		SetMealGroupsTable,
 		StallsTable,
@@ -2316,6 +3548,8 @@ var (
 		StoreUsersTable,
 		TaxFeesTable,
 		UserRolesTable,
+		WaitlistRulesTable,
+		WaitlistTicketsTable,
 		MenuStoreRelationsTable,
 		ProductTagRelationsTable,
 		ProfitDistributionRuleStoreRelationsTable,
@@ -2343,6 +3577,7 @@ func init() {
 	MerchantRenewalsTable.ForeignKeys[0].RefTable = MerchantsTable
 	OrderProductsTable.ForeignKeys[0].RefTable = OrdersTable
 	PermissionsTable.ForeignKeys[0].RefTable = RouterMenusTable
+	PriceChangeItemsTable.ForeignKeys[0].RefTable = PriceChangeBatchesTable
 	ProductsTable.ForeignKeys[0].RefTable = CategoriesTable
 	ProductsTable.ForeignKeys[1].RefTable = ProductUnitsTable
 	ProductsTable.ForeignKeys[2].RefTable = StallsTable
@@ -37,6 +37,8 @@ type Order struct {
 	ShiftNo string `json:"shift_no,omitempty"`
 	// 订单号（门店内唯一可读编号）
 	OrderNo string `json:"order_no,omitempty"`
+	// 取餐号
+	PickupNo string `json:"pickup_no,omitempty"`
 	// 订单类型：SALE=销售单；REFUND=退单；PARTIAL_REFUND=部分退款单
 	OrderType domain.OrderType `json:"order_type,omitempty"`
 	// 下单时间
@@ -49,7 +51,7 @@ type Order struct {
 	PlacedBy uuid.UUID `json:"placed_by,omitempty"`
 	// 下单人名称
 	PlacedByName string `json:"placed_by_name,omitempty"`
-	// 就餐模式：DINE_IN=堂食
+	// 就餐模式：DINE_IN=堂食；DELIVERY=外卖配送
 	DiningMode domain.DiningMode `json:"dining_mode,omitempty"`
 	// 订单业务状态：PLACED=已下单；COMPLETED=已完成；CANCELLED=已取消
 	OrderStatus domain.OrderStatus `json:"order_status,omitempty"`
@@ -77,6 +79,12 @@ type Order struct {
 	Payments []domain.OrderPayment `json:"payments,omitempty"`
 	// 金额汇总
 	Amount domain.OrderAmount `json:"amount,omitempty"`
+	// 享受的促销活动
+	Promotions []domain.OrderPromotion `json:"promotions,omitempty"`
+	// 会员ID
+	MemberID uuid.UUID `json:"member_id,omitempty"`
+	// 关联会员信息
+	Member *domain.OrderMember `json:"member,omitempty"`
 	// 整单备注
 	Remark string `json:"remark,omitempty"`
 	// 操作日志
@@ -110,15 +118,15 @@ func (*Order) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case order.FieldStore, order.FieldPos, order.FieldCashier, order.FieldTaxRates, order.FieldFees, order.FieldPayments, order.FieldAmount, order.FieldOperationLogs:
+		case order.FieldStore, order.FieldPos, order.FieldCashier, order.FieldTaxRates, order.FieldFees, order.FieldPayments, order.FieldAmount, order.FieldPromotions, order.FieldMember, order.FieldOperationLogs:
 			values[i] = new([]byte)
 		case order.FieldDeletedAt, order.FieldGuestCount:
 			values[i] = new(sql.NullInt64)
-		case order.FieldBusinessDate, order.FieldShiftNo, order.FieldOrderNo, order.FieldOrderType, order.FieldPlacedByName, order.FieldDiningMode, order.FieldOrderStatus, order.FieldPaymentStatus, order.FieldTableName, order.FieldChannel, order.FieldRemark:
+		case order.FieldBusinessDate, order.FieldShiftNo, order.FieldOrderNo, order.FieldPickupNo, order.FieldOrderType, order.FieldPlacedByName, order.FieldDiningMode, order.FieldOrderStatus, order.FieldPaymentStatus, order.FieldTableName, order.FieldChannel, order.FieldRemark:
 			values[i] = new(sql.NullString)
 		case order.FieldCreatedAt, order.FieldUpdatedAt, order.FieldPlacedAt, order.FieldPaidAt, order.FieldCompletedAt:
 			values[i] = new(sql.NullTime)
-		case order.FieldID, order.FieldMerchantID, order.FieldStoreID, order.FieldPlacedBy, order.FieldTableID:
+		case order.FieldID, order.FieldMerchantID, order.FieldStoreID, order.FieldPlacedBy, order.FieldTableID, order.FieldMemberID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -189,6 +197,12 @@ func (o *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.OrderNo = value.String
 			}
+		case order.FieldPickupNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pickup_no", values[i])
+			} else if value.Valid {
+				o.PickupNo = value.String
+			}
 		case order.FieldOrderType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field order_type", values[i])
@@ -326,6 +340,28 @@ func (o *Order) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field amount: %w", err)
 				}
 			}
+		case order.FieldPromotions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field promotions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.Promotions); err != nil {
+					return fmt.Errorf("unmarshal field promotions: %w", err)
+				}
+			}
+		case order.FieldMemberID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field member_id", values[i])
+			} else if value != nil {
+				o.MemberID = *value
+			}
+		case order.FieldMember:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field member", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.Member); err != nil {
+					return fmt.Errorf("unmarshal field member: %w", err)
+				}
+			}
 		case order.FieldRemark:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field remark", values[i])
@@ -405,6 +441,9 @@ func (o *Order) String() string {
 	builder.WriteString("order_no=")
 	builder.WriteString(o.OrderNo)
 	builder.WriteString(", ")
+	builder.WriteString("pickup_no=")
+	builder.WriteString(o.PickupNo)
+	builder.WriteString(", ")
 	builder.WriteString("order_type=")
 	builder.WriteString(fmt.Sprintf("%v", o.OrderType))
 	builder.WriteString(", ")
@@ -471,6 +510,15 @@ func (o *Order) String() string {
 	builder.WriteString("amount=")
 	builder.WriteString(fmt.Sprintf("%v", o.Amount))
 	builder.WriteString(", ")
+	builder.WriteString("promotions=")
+	builder.WriteString(fmt.Sprintf("%v", o.Promotions))
+	builder.WriteString(", ")
+	builder.WriteString("member_id=")
+	builder.WriteString(fmt.Sprintf("%v", o.MemberID))
+	builder.WriteString(", ")
+	builder.WriteString("member=")
+	builder.WriteString(fmt.Sprintf("%v", o.Member))
+	builder.WriteString(", ")
 	builder.WriteString("remark=")
 	builder.WriteString(o.Remark)
 	builder.WriteString(", ")
@@ -34,6 +34,8 @@ const (
 	FieldShiftNo = "shift_no"
 	// FieldOrderNo holds the string denoting the order_no field in the database.
 	FieldOrderNo = "order_no"
+	// FieldPickupNo holds the string denoting the pickup_no field in the database.
+	FieldPickupNo = "pickup_no"
 	// FieldOrderType holds the string denoting the order_type field in the database.
 	FieldOrderType = "order_type"
 	// FieldPlacedAt holds the string denoting the placed_at field in the database.
@@ -74,6 +76,12 @@ const (
 	FieldPayments = "payments"
 	// FieldAmount holds the string denoting the amount field in the database.
 	FieldAmount = "amount"
+	// FieldPromotions holds the string denoting the promotions field in the database.
+	FieldPromotions = "promotions"
+	// FieldMemberID holds the string denoting the member_id field in the database.
+	FieldMemberID = "member_id"
+	// FieldMember holds the string denoting the member field in the database.
+	FieldMember = "member"
 	// FieldRemark holds the string denoting the remark field in the database.
 	FieldRemark = "remark"
 	// FieldOperationLogs holds the string denoting the operation_logs field in the database.
@@ -102,6 +110,7 @@ var Columns = []string{
 	FieldBusinessDate,
 	FieldShiftNo,
 	FieldOrderNo,
+	FieldPickupNo,
 	FieldOrderType,
 	FieldPlacedAt,
 	FieldPaidAt,
@@ -122,6 +131,9 @@ var Columns = []string{
 	FieldFees,
 	FieldPayments,
 	FieldAmount,
+	FieldPromotions,
+	FieldMemberID,
+	FieldMember,
 	FieldRemark,
 	FieldOperationLogs,
 }
@@ -156,6 +168,10 @@ var (
 	BusinessDateValidator func(string) error
 	// OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
 	OrderNoValidator func(string) error
+	// DefaultPickupNo holds the default value on creation for the "pickup_no" field.
+	DefaultPickupNo string
+	// PickupNoValidator is a validator for the "pickup_no" field. It is called by the builders before save.
+	PickupNoValidator func(string) error
 	// RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
 	RemarkValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
@@ -179,7 +195,7 @@ const DefaultDiningMode domain.DiningMode = "DINE_IN"
 // DiningModeValidator is a validator for the "dining_mode" field enum values. It is called by the builders before save.
 func DiningModeValidator(dm domain.DiningMode) error {
 	switch dm {
-	case "DINE_IN":
+	case "DINE_IN", "DELIVERY":
 		return nil
 	default:
 		return fmt.Errorf("order: invalid enum value for dining_mode field: %q", dm)
@@ -215,7 +231,7 @@ const DefaultChannel domain.Channel = "POS"
 // ChannelValidator is a validator for the "channel" field enum values. It is called by the builders before save.
 func ChannelValidator(c domain.Channel) error {
 	switch c {
-	case "POS", "H5", "APP":
+	case "POS", "H5", "APP", "KIOSK", "DELIVERY":
 		return nil
 	default:
 		return fmt.Errorf("order: invalid enum value for channel field: %q", c)
@@ -270,6 +286,11 @@ func ByOrderNo(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrderNo, opts...).ToFunc()
 }
 
+// ByPickupNo orders the results by the pickup_no field.
+func ByPickupNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPickupNo, opts...).ToFunc()
+}
+
 // ByOrderType orders the results by the order_type field.
 func ByOrderType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrderType, opts...).ToFunc()
@@ -335,6 +356,11 @@ func ByChannel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldChannel, opts...).ToFunc()
 }
 
+// ByMemberID orders the results by the member_id field.
+func ByMemberID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemberID, opts...).ToFunc()
+}
+
 // ByRemark orders the results by the remark field.
 func ByRemark(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRemark, opts...).ToFunc()
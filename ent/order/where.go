@@ -97,6 +97,11 @@ func OrderNo(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldOrderNo, v))
 }
 
+// PickupNo applies equality check predicate on the "pickup_no" field. It's identical to PickupNoEQ.
+func PickupNo(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPickupNo, v))
+}
+
 // PlacedAt applies equality check predicate on the "placed_at" field. It's identical to PlacedAtEQ.
 func PlacedAt(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldPlacedAt, v))
@@ -137,6 +142,11 @@ func GuestCount(v int) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldGuestCount, v))
 }
 
+// MemberID applies equality check predicate on the "member_id" field. It's identical to MemberIDEQ.
+func MemberID(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldMemberID, v))
+}
+
 // Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
 func Remark(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldRemark, v))
@@ -547,6 +557,71 @@ func OrderNoContainsFold(v string) predicate.Order {
 	return predicate.Order(sql.FieldContainsFold(FieldOrderNo, v))
 }
 
+// PickupNoEQ applies the EQ predicate on the "pickup_no" field.
+func PickupNoEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPickupNo, v))
+}
+
+// PickupNoNEQ applies the NEQ predicate on the "pickup_no" field.
+func PickupNoNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldPickupNo, v))
+}
+
+// PickupNoIn applies the In predicate on the "pickup_no" field.
+func PickupNoIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldPickupNo, vs...))
+}
+
+// PickupNoNotIn applies the NotIn predicate on the "pickup_no" field.
+func PickupNoNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldPickupNo, vs...))
+}
+
+// PickupNoGT applies the GT predicate on the "pickup_no" field.
+func PickupNoGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldPickupNo, v))
+}
+
+// PickupNoGTE applies the GTE predicate on the "pickup_no" field.
+func PickupNoGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldPickupNo, v))
+}
+
+// PickupNoLT applies the LT predicate on the "pickup_no" field.
+func PickupNoLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldPickupNo, v))
+}
+
+// PickupNoLTE applies the LTE predicate on the "pickup_no" field.
+func PickupNoLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldPickupNo, v))
+}
+
+// PickupNoContains applies the Contains predicate on the "pickup_no" field.
+func PickupNoContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldPickupNo, v))
+}
+
+// PickupNoHasPrefix applies the HasPrefix predicate on the "pickup_no" field.
+func PickupNoHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldPickupNo, v))
+}
+
+// PickupNoHasSuffix applies the HasSuffix predicate on the "pickup_no" field.
+func PickupNoHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldPickupNo, v))
+}
+
+// PickupNoEqualFold applies the EqualFold predicate on the "pickup_no" field.
+func PickupNoEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldPickupNo, v))
+}
+
+// PickupNoContainsFold applies the ContainsFold predicate on the "pickup_no" field.
+func PickupNoContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldPickupNo, v))
+}
+
 // OrderTypeEQ applies the EQ predicate on the "order_type" field.
 func OrderTypeEQ(v domain.OrderType) predicate.Order {
 	vc := v
@@ -1177,6 +1252,76 @@ func PaymentsNotNil() predicate.Order {
 	return predicate.Order(sql.FieldNotNull(FieldPayments))
 }
 
+// PromotionsIsNil applies the IsNil predicate on the "promotions" field.
+func PromotionsIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldPromotions))
+}
+
+// PromotionsNotNil applies the NotNil predicate on the "promotions" field.
+func PromotionsNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldPromotions))
+}
+
+// MemberIDEQ applies the EQ predicate on the "member_id" field.
+func MemberIDEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldMemberID, v))
+}
+
+// MemberIDNEQ applies the NEQ predicate on the "member_id" field.
+func MemberIDNEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldMemberID, v))
+}
+
+// MemberIDIn applies the In predicate on the "member_id" field.
+func MemberIDIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldMemberID, vs...))
+}
+
+// MemberIDNotIn applies the NotIn predicate on the "member_id" field.
+func MemberIDNotIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldMemberID, vs...))
+}
+
+// MemberIDGT applies the GT predicate on the "member_id" field.
+func MemberIDGT(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldMemberID, v))
+}
+
+// MemberIDGTE applies the GTE predicate on the "member_id" field.
+func MemberIDGTE(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldMemberID, v))
+}
+
+// MemberIDLT applies the LT predicate on the "member_id" field.
+func MemberIDLT(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldMemberID, v))
+}
+
+// MemberIDLTE applies the LTE predicate on the "member_id" field.
+func MemberIDLTE(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldMemberID, v))
+}
+
+// MemberIDIsNil applies the IsNil predicate on the "member_id" field.
+func MemberIDIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldMemberID))
+}
+
+// MemberIDNotNil applies the NotNil predicate on the "member_id" field.
+func MemberIDNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldMemberID))
+}
+
+// MemberIsNil applies the IsNil predicate on the "member" field.
+func MemberIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldMember))
+}
+
+// MemberNotNil applies the NotNil predicate on the "member" field.
+func MemberNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldMember))
+}
+
 // RemarkEQ applies the EQ predicate on the "remark" field.
 func RemarkEQ(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldRemark, v))
@@ -106,6 +106,20 @@ func (oc *OrderCreate) SetOrderNo(s string) *OrderCreate {
 	return oc
 }
 
+// SetPickupNo sets the "pickup_no" field.
+func (oc *OrderCreate) SetPickupNo(s string) *OrderCreate {
+	oc.mutation.SetPickupNo(s)
+	return oc
+}
+
+// SetNillablePickupNo sets the "pickup_no" field if the given value is not nil.
+func (oc *OrderCreate) SetNillablePickupNo(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetPickupNo(*s)
+	}
+	return oc
+}
+
 // SetOrderType sets the "order_type" field.
 func (oc *OrderCreate) SetOrderType(dt domain.OrderType) *OrderCreate {
 	oc.mutation.SetOrderType(dt)
@@ -330,6 +344,32 @@ func (oc *OrderCreate) SetAmount(da domain.OrderAmount) *OrderCreate {
 	return oc
 }
 
+// SetPromotions sets the "promotions" field.
+func (oc *OrderCreate) SetPromotions(dp []domain.OrderPromotion) *OrderCreate {
+	oc.mutation.SetPromotions(dp)
+	return oc
+}
+
+// SetMemberID sets the "member_id" field.
+func (oc *OrderCreate) SetMemberID(u uuid.UUID) *OrderCreate {
+	oc.mutation.SetMemberID(u)
+	return oc
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableMemberID(u *uuid.UUID) *OrderCreate {
+	if u != nil {
+		oc.SetMemberID(*u)
+	}
+	return oc
+}
+
+// SetMember sets the "member" field.
+func (oc *OrderCreate) SetMember(dm *domain.OrderMember) *OrderCreate {
+	oc.mutation.SetMember(dm)
+	return oc
+}
+
 // SetRemark sets the "remark" field.
 func (oc *OrderCreate) SetRemark(s string) *OrderCreate {
 	oc.mutation.SetRemark(s)
@@ -434,6 +474,10 @@ func (oc *OrderCreate) defaults() error {
 		v := order.DefaultDeletedAt
 		oc.mutation.SetDeletedAt(v)
 	}
+	if _, ok := oc.mutation.PickupNo(); !ok {
+		v := order.DefaultPickupNo
+		oc.mutation.SetPickupNo(v)
+	}
 	if _, ok := oc.mutation.OrderType(); !ok {
 		v := order.DefaultOrderType
 		oc.mutation.SetOrderType(v)
@@ -497,6 +541,14 @@ func (oc *OrderCreate) check() error {
 			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "Order.order_no": %w`, err)}
 		}
 	}
+	if _, ok := oc.mutation.PickupNo(); !ok {
+		return &ValidationError{Name: "pickup_no", err: errors.New(`ent: missing required field "Order.pickup_no"`)}
+	}
+	if v, ok := oc.mutation.PickupNo(); ok {
+		if err := order.PickupNoValidator(v); err != nil {
+			return &ValidationError{Name: "pickup_no", err: fmt.Errorf(`ent: validator failed for field "Order.pickup_no": %w`, err)}
+		}
+	}
 	if _, ok := oc.mutation.OrderType(); !ok {
 		return &ValidationError{Name: "order_type", err: errors.New(`ent: missing required field "Order.order_type"`)}
 	}
@@ -622,6 +674,10 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldOrderNo, field.TypeString, value)
 		_node.OrderNo = value
 	}
+	if value, ok := oc.mutation.PickupNo(); ok {
+		_spec.SetField(order.FieldPickupNo, field.TypeString, value)
+		_node.PickupNo = value
+	}
 	if value, ok := oc.mutation.OrderType(); ok {
 		_spec.SetField(order.FieldOrderType, field.TypeEnum, value)
 		_node.OrderType = value
@@ -702,6 +758,18 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldAmount, field.TypeJSON, value)
 		_node.Amount = value
 	}
+	if value, ok := oc.mutation.Promotions(); ok {
+		_spec.SetField(order.FieldPromotions, field.TypeJSON, value)
+		_node.Promotions = value
+	}
+	if value, ok := oc.mutation.MemberID(); ok {
+		_spec.SetField(order.FieldMemberID, field.TypeUUID, value)
+		_node.MemberID = value
+	}
+	if value, ok := oc.mutation.Member(); ok {
+		_spec.SetField(order.FieldMember, field.TypeJSON, value)
+		_node.Member = value
+	}
 	if value, ok := oc.mutation.Remark(); ok {
 		_spec.SetField(order.FieldRemark, field.TypeString, value)
 		_node.Remark = value
@@ -850,6 +918,18 @@ func (u *OrderUpsert) UpdateOrderNo() *OrderUpsert {
 	return u
 }
 
+// SetPickupNo sets the "pickup_no" field.
+func (u *OrderUpsert) SetPickupNo(v string) *OrderUpsert {
+	u.Set(order.FieldPickupNo, v)
+	return u
+}
+
+// UpdatePickupNo sets the "pickup_no" field to the value that was provided on create.
+func (u *OrderUpsert) UpdatePickupNo() *OrderUpsert {
+	u.SetExcluded(order.FieldPickupNo)
+	return u
+}
+
 // SetOrderType sets the "order_type" field.
 func (u *OrderUpsert) SetOrderType(v domain.OrderType) *OrderUpsert {
 	u.Set(order.FieldOrderType, v)
@@ -1162,6 +1242,60 @@ func (u *OrderUpsert) UpdateAmount() *OrderUpsert {
 	return u
 }
 
+// SetPromotions sets the "promotions" field.
+func (u *OrderUpsert) SetPromotions(v []domain.OrderPromotion) *OrderUpsert {
+	u.Set(order.FieldPromotions, v)
+	return u
+}
+
+// UpdatePromotions sets the "promotions" field to the value that was provided on create.
+func (u *OrderUpsert) UpdatePromotions() *OrderUpsert {
+	u.SetExcluded(order.FieldPromotions)
+	return u
+}
+
+// ClearPromotions clears the value of the "promotions" field.
+func (u *OrderUpsert) ClearPromotions() *OrderUpsert {
+	u.SetNull(order.FieldPromotions)
+	return u
+}
+
+// SetMemberID sets the "member_id" field.
+func (u *OrderUpsert) SetMemberID(v uuid.UUID) *OrderUpsert {
+	u.Set(order.FieldMemberID, v)
+	return u
+}
+
+// UpdateMemberID sets the "member_id" field to the value that was provided on create.
+func (u *OrderUpsert) UpdateMemberID() *OrderUpsert {
+	u.SetExcluded(order.FieldMemberID)
+	return u
+}
+
+// ClearMemberID clears the value of the "member_id" field.
+func (u *OrderUpsert) ClearMemberID() *OrderUpsert {
+	u.SetNull(order.FieldMemberID)
+	return u
+}
+
+// SetMember sets the "member" field.
+func (u *OrderUpsert) SetMember(v *domain.OrderMember) *OrderUpsert {
+	u.Set(order.FieldMember, v)
+	return u
+}
+
+// UpdateMember sets the "member" field to the value that was provided on create.
+func (u *OrderUpsert) UpdateMember() *OrderUpsert {
+	u.SetExcluded(order.FieldMember)
+	return u
+}
+
+// ClearMember clears the value of the "member" field.
+func (u *OrderUpsert) ClearMember() *OrderUpsert {
+	u.SetNull(order.FieldMember)
+	return u
+}
+
 // SetRemark sets the "remark" field.
 func (u *OrderUpsert) SetRemark(v string) *OrderUpsert {
 	u.Set(order.FieldRemark, v)
@@ -1339,6 +1473,20 @@ func (u *OrderUpsertOne) UpdateOrderNo() *OrderUpsertOne {
 	})
 }
 
+// SetPickupNo sets the "pickup_no" field.
+func (u *OrderUpsertOne) SetPickupNo(v string) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetPickupNo(v)
+	})
+}
+
+// UpdatePickupNo sets the "pickup_no" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdatePickupNo() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdatePickupNo()
+	})
+}
+
 // SetOrderType sets the "order_type" field.
 func (u *OrderUpsertOne) SetOrderType(v domain.OrderType) *OrderUpsertOne {
 	return u.Update(func(s *OrderUpsert) {
@@ -1703,6 +1851,69 @@ func (u *OrderUpsertOne) UpdateAmount() *OrderUpsertOne {
 	})
 }
 
+// SetPromotions sets the "promotions" field.
+func (u *OrderUpsertOne) SetPromotions(v []domain.OrderPromotion) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetPromotions(v)
+	})
+}
+
+// UpdatePromotions sets the "promotions" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdatePromotions() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdatePromotions()
+	})
+}
+
+// ClearPromotions clears the value of the "promotions" field.
+func (u *OrderUpsertOne) ClearPromotions() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.ClearPromotions()
+	})
+}
+
+// SetMemberID sets the "member_id" field.
+func (u *OrderUpsertOne) SetMemberID(v uuid.UUID) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetMemberID(v)
+	})
+}
+
+// UpdateMemberID sets the "member_id" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdateMemberID() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateMemberID()
+	})
+}
+
+// ClearMemberID clears the value of the "member_id" field.
+func (u *OrderUpsertOne) ClearMemberID() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.ClearMemberID()
+	})
+}
+
+// SetMember sets the "member" field.
+func (u *OrderUpsertOne) SetMember(v *domain.OrderMember) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetMember(v)
+	})
+}
+
+// UpdateMember sets the "member" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdateMember() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateMember()
+	})
+}
+
+// ClearMember clears the value of the "member" field.
+func (u *OrderUpsertOne) ClearMember() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.ClearMember()
+	})
+}
+
 // SetRemark sets the "remark" field.
 func (u *OrderUpsertOne) SetRemark(v string) *OrderUpsertOne {
 	return u.Update(func(s *OrderUpsert) {
@@ -2053,6 +2264,20 @@ func (u *OrderUpsertBulk) UpdateOrderNo() *OrderUpsertBulk {
 	})
 }
 
+// SetPickupNo sets the "pickup_no" field.
+func (u *OrderUpsertBulk) SetPickupNo(v string) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetPickupNo(v)
+	})
+}
+
+// UpdatePickupNo sets the "pickup_no" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdatePickupNo() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdatePickupNo()
+	})
+}
+
 // SetOrderType sets the "order_type" field.
 func (u *OrderUpsertBulk) SetOrderType(v domain.OrderType) *OrderUpsertBulk {
 	return u.Update(func(s *OrderUpsert) {
@@ -2417,6 +2642,69 @@ func (u *OrderUpsertBulk) UpdateAmount() *OrderUpsertBulk {
 	})
 }
 
+// SetPromotions sets the "promotions" field.
+func (u *OrderUpsertBulk) SetPromotions(v []domain.OrderPromotion) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetPromotions(v)
+	})
+}
+
+// UpdatePromotions sets the "promotions" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdatePromotions() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdatePromotions()
+	})
+}
+
+// ClearPromotions clears the value of the "promotions" field.
+func (u *OrderUpsertBulk) ClearPromotions() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.ClearPromotions()
+	})
+}
+
+// SetMemberID sets the "member_id" field.
+func (u *OrderUpsertBulk) SetMemberID(v uuid.UUID) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetMemberID(v)
+	})
+}
+
+// UpdateMemberID sets the "member_id" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdateMemberID() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateMemberID()
+	})
+}
+
+// ClearMemberID clears the value of the "member_id" field.
+func (u *OrderUpsertBulk) ClearMemberID() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.ClearMemberID()
+	})
+}
+
+// SetMember sets the "member" field.
+func (u *OrderUpsertBulk) SetMember(v *domain.OrderMember) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetMember(v)
+	})
+}
+
+// UpdateMember sets the "member" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdateMember() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateMember()
+	})
+}
+
+// ClearMember clears the value of the "member" field.
+func (u *OrderUpsertBulk) ClearMember() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.ClearMember()
+	})
+}
+
 // SetRemark sets the "remark" field.
 func (u *OrderUpsertBulk) SetRemark(v string) *OrderUpsertBulk {
 	return u.Update(func(s *OrderUpsert) {
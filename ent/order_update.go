@@ -108,6 +108,20 @@ func (ou *OrderUpdate) SetNillableOrderNo(s *string) *OrderUpdate {
 	return ou
 }
 
+// SetPickupNo sets the "pickup_no" field.
+func (ou *OrderUpdate) SetPickupNo(s string) *OrderUpdate {
+	ou.mutation.SetPickupNo(s)
+	return ou
+}
+
+// SetNillablePickupNo sets the "pickup_no" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillablePickupNo(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetPickupNo(*s)
+	}
+	return ou
+}
+
 // SetOrderType sets the "order_type" field.
 func (ou *OrderUpdate) SetOrderType(dt domain.OrderType) *OrderUpdate {
 	ou.mutation.SetOrderType(dt)
@@ -455,6 +469,56 @@ func (ou *OrderUpdate) SetNillableAmount(da *domain.OrderAmount) *OrderUpdate {
 	return ou
 }
 
+// SetPromotions sets the "promotions" field.
+func (ou *OrderUpdate) SetPromotions(dp []domain.OrderPromotion) *OrderUpdate {
+	ou.mutation.SetPromotions(dp)
+	return ou
+}
+
+// AppendPromotions appends dp to the "promotions" field.
+func (ou *OrderUpdate) AppendPromotions(dp []domain.OrderPromotion) *OrderUpdate {
+	ou.mutation.AppendPromotions(dp)
+	return ou
+}
+
+// ClearPromotions clears the value of the "promotions" field.
+func (ou *OrderUpdate) ClearPromotions() *OrderUpdate {
+	ou.mutation.ClearPromotions()
+	return ou
+}
+
+// SetMemberID sets the "member_id" field.
+func (ou *OrderUpdate) SetMemberID(u uuid.UUID) *OrderUpdate {
+	ou.mutation.SetMemberID(u)
+	return ou
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableMemberID(u *uuid.UUID) *OrderUpdate {
+	if u != nil {
+		ou.SetMemberID(*u)
+	}
+	return ou
+}
+
+// ClearMemberID clears the value of the "member_id" field.
+func (ou *OrderUpdate) ClearMemberID() *OrderUpdate {
+	ou.mutation.ClearMemberID()
+	return ou
+}
+
+// SetMember sets the "member" field.
+func (ou *OrderUpdate) SetMember(dm *domain.OrderMember) *OrderUpdate {
+	ou.mutation.SetMember(dm)
+	return ou
+}
+
+// ClearMember clears the value of the "member" field.
+func (ou *OrderUpdate) ClearMember() *OrderUpdate {
+	ou.mutation.ClearMember()
+	return ou
+}
+
 // SetRemark sets the "remark" field.
 func (ou *OrderUpdate) SetRemark(s string) *OrderUpdate {
 	ou.mutation.SetRemark(s)
@@ -588,6 +652,11 @@ func (ou *OrderUpdate) check() error {
 			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "Order.order_no": %w`, err)}
 		}
 	}
+	if v, ok := ou.mutation.PickupNo(); ok {
+		if err := order.PickupNoValidator(v); err != nil {
+			return &ValidationError{Name: "pickup_no", err: fmt.Errorf(`ent: validator failed for field "Order.pickup_no": %w`, err)}
+		}
+	}
 	if v, ok := ou.mutation.OrderType(); ok {
 		if err := order.OrderTypeValidator(v); err != nil {
 			return &ValidationError{Name: "order_type", err: fmt.Errorf(`ent: validator failed for field "Order.order_type": %w`, err)}
@@ -660,6 +729,9 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ou.mutation.OrderNo(); ok {
 		_spec.SetField(order.FieldOrderNo, field.TypeString, value)
 	}
+	if value, ok := ou.mutation.PickupNo(); ok {
+		_spec.SetField(order.FieldPickupNo, field.TypeString, value)
+	}
 	if value, ok := ou.mutation.OrderType(); ok {
 		_spec.SetField(order.FieldOrderType, field.TypeEnum, value)
 	}
@@ -771,6 +843,29 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ou.mutation.Amount(); ok {
 		_spec.SetField(order.FieldAmount, field.TypeJSON, value)
 	}
+	if value, ok := ou.mutation.Promotions(); ok {
+		_spec.SetField(order.FieldPromotions, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedPromotions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, order.FieldPromotions, value)
+		})
+	}
+	if ou.mutation.PromotionsCleared() {
+		_spec.ClearField(order.FieldPromotions, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.MemberID(); ok {
+		_spec.SetField(order.FieldMemberID, field.TypeUUID, value)
+	}
+	if ou.mutation.MemberIDCleared() {
+		_spec.ClearField(order.FieldMemberID, field.TypeUUID)
+	}
+	if value, ok := ou.mutation.Member(); ok {
+		_spec.SetField(order.FieldMember, field.TypeJSON, value)
+	}
+	if ou.mutation.MemberCleared() {
+		_spec.ClearField(order.FieldMember, field.TypeJSON)
+	}
 	if value, ok := ou.mutation.Remark(); ok {
 		_spec.SetField(order.FieldRemark, field.TypeString, value)
 	}
@@ -930,6 +1025,20 @@ func (ouo *OrderUpdateOne) SetNillableOrderNo(s *string) *OrderUpdateOne {
 	return ouo
 }
 
+// SetPickupNo sets the "pickup_no" field.
+func (ouo *OrderUpdateOne) SetPickupNo(s string) *OrderUpdateOne {
+	ouo.mutation.SetPickupNo(s)
+	return ouo
+}
+
+// SetNillablePickupNo sets the "pickup_no" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillablePickupNo(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetPickupNo(*s)
+	}
+	return ouo
+}
+
 // SetOrderType sets the "order_type" field.
 func (ouo *OrderUpdateOne) SetOrderType(dt domain.OrderType) *OrderUpdateOne {
 	ouo.mutation.SetOrderType(dt)
@@ -1277,6 +1386,56 @@ func (ouo *OrderUpdateOne) SetNillableAmount(da *domain.OrderAmount) *OrderUpdat
 	return ouo
 }
 
+// SetPromotions sets the "promotions" field.
+func (ouo *OrderUpdateOne) SetPromotions(dp []domain.OrderPromotion) *OrderUpdateOne {
+	ouo.mutation.SetPromotions(dp)
+	return ouo
+}
+
+// AppendPromotions appends dp to the "promotions" field.
+func (ouo *OrderUpdateOne) AppendPromotions(dp []domain.OrderPromotion) *OrderUpdateOne {
+	ouo.mutation.AppendPromotions(dp)
+	return ouo
+}
+
+// ClearPromotions clears the value of the "promotions" field.
+func (ouo *OrderUpdateOne) ClearPromotions() *OrderUpdateOne {
+	ouo.mutation.ClearPromotions()
+	return ouo
+}
+
+// SetMemberID sets the "member_id" field.
+func (ouo *OrderUpdateOne) SetMemberID(u uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.SetMemberID(u)
+	return ouo
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableMemberID(u *uuid.UUID) *OrderUpdateOne {
+	if u != nil {
+		ouo.SetMemberID(*u)
+	}
+	return ouo
+}
+
+// ClearMemberID clears the value of the "member_id" field.
+func (ouo *OrderUpdateOne) ClearMemberID() *OrderUpdateOne {
+	ouo.mutation.ClearMemberID()
+	return ouo
+}
+
+// SetMember sets the "member" field.
+func (ouo *OrderUpdateOne) SetMember(dm *domain.OrderMember) *OrderUpdateOne {
+	ouo.mutation.SetMember(dm)
+	return ouo
+}
+
+// ClearMember clears the value of the "member" field.
+func (ouo *OrderUpdateOne) ClearMember() *OrderUpdateOne {
+	ouo.mutation.ClearMember()
+	return ouo
+}
+
 // SetRemark sets the "remark" field.
 func (ouo *OrderUpdateOne) SetRemark(s string) *OrderUpdateOne {
 	ouo.mutation.SetRemark(s)
@@ -1423,6 +1582,11 @@ func (ouo *OrderUpdateOne) check() error {
 			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "Order.order_no": %w`, err)}
 		}
 	}
+	if v, ok := ouo.mutation.PickupNo(); ok {
+		if err := order.PickupNoValidator(v); err != nil {
+			return &ValidationError{Name: "pickup_no", err: fmt.Errorf(`ent: validator failed for field "Order.pickup_no": %w`, err)}
+		}
+	}
 	if v, ok := ouo.mutation.OrderType(); ok {
 		if err := order.OrderTypeValidator(v); err != nil {
 			return &ValidationError{Name: "order_type", err: fmt.Errorf(`ent: validator failed for field "Order.order_type": %w`, err)}
@@ -1512,6 +1676,9 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 	if value, ok := ouo.mutation.OrderNo(); ok {
 		_spec.SetField(order.FieldOrderNo, field.TypeString, value)
 	}
+	if value, ok := ouo.mutation.PickupNo(); ok {
+		_spec.SetField(order.FieldPickupNo, field.TypeString, value)
+	}
 	if value, ok := ouo.mutation.OrderType(); ok {
 		_spec.SetField(order.FieldOrderType, field.TypeEnum, value)
 	}
@@ -1623,6 +1790,29 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 	if value, ok := ouo.mutation.Amount(); ok {
 		_spec.SetField(order.FieldAmount, field.TypeJSON, value)
 	}
+	if value, ok := ouo.mutation.Promotions(); ok {
+		_spec.SetField(order.FieldPromotions, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedPromotions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, order.FieldPromotions, value)
+		})
+	}
+	if ouo.mutation.PromotionsCleared() {
+		_spec.ClearField(order.FieldPromotions, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.MemberID(); ok {
+		_spec.SetField(order.FieldMemberID, field.TypeUUID, value)
+	}
+	if ouo.mutation.MemberIDCleared() {
+		_spec.ClearField(order.FieldMemberID, field.TypeUUID)
+	}
+	if value, ok := ouo.mutation.Member(); ok {
+		_spec.SetField(order.FieldMember, field.TypeJSON, value)
+	}
+	if ouo.mutation.MemberCleared() {
+		_spec.ClearField(order.FieldMember, field.TypeJSON)
+	}
 	if value, ok := ouo.mutation.Remark(); ok {
 		_spec.SetField(order.FieldRemark, field.TypeString, value)
 	}
@@ -39,6 +39,8 @@ type OrderProduct struct {
 	ProductID uuid.UUID `json:"product_id,omitempty"`
 	// 商品名称
 	ProductName string `json:"product_name,omitempty"`
+	// 商品名称翻译（按语言）
+	ProductNameI18n domain.LocalizedText `json:"product_name_i18n,omitempty"`
 	// 商品类型：normal（普通商品）、set_meal（套餐商品）
 	ProductType domain.ProductType `json:"product_type,omitempty"`
 	// 分类信息
@@ -71,6 +73,8 @@ type OrderProduct struct {
 	Total *decimal.Decimal `json:"total,omitempty"`
 	// 促销优惠金额
 	PromotionDiscount *decimal.Decimal `json:"promotion_discount,omitempty"`
+	// 会员价优惠金额
+	MemberDiscount *decimal.Decimal `json:"member_discount,omitempty"`
 	// 做法金额
 	AttrAmount *decimal.Decimal `json:"attr_amount,omitempty"`
 	// 赠送金额
@@ -126,9 +130,9 @@ func (*OrderProduct) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case orderproduct.FieldSubtotal, orderproduct.FieldDiscountAmount, orderproduct.FieldAmountBeforeTax, orderproduct.FieldTaxRate, orderproduct.FieldTax, orderproduct.FieldAmountAfterTax, orderproduct.FieldTotal, orderproduct.FieldPromotionDiscount, orderproduct.FieldAttrAmount, orderproduct.FieldGiftAmount, orderproduct.FieldVoidAmount, orderproduct.FieldPrice:
+		case orderproduct.FieldSubtotal, orderproduct.FieldDiscountAmount, orderproduct.FieldAmountBeforeTax, orderproduct.FieldTaxRate, orderproduct.FieldTax, orderproduct.FieldAmountAfterTax, orderproduct.FieldTotal, orderproduct.FieldPromotionDiscount, orderproduct.FieldMemberDiscount, orderproduct.FieldAttrAmount, orderproduct.FieldGiftAmount, orderproduct.FieldVoidAmount, orderproduct.FieldPrice:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
-		case orderproduct.FieldCategory, orderproduct.FieldProductUnit, orderproduct.FieldGroups, orderproduct.FieldSpecRelations, orderproduct.FieldAttrRelations:
+		case orderproduct.FieldProductNameI18n, orderproduct.FieldCategory, orderproduct.FieldProductUnit, orderproduct.FieldGroups, orderproduct.FieldSpecRelations, orderproduct.FieldAttrRelations:
 			values[i] = new([]byte)
 		case orderproduct.FieldIsGift:
 			values[i] = new(sql.NullBool)
@@ -209,6 +213,14 @@ func (op *OrderProduct) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				op.ProductName = value.String
 			}
+		case orderproduct.FieldProductNameI18n:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field product_name_i18n", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &op.ProductNameI18n); err != nil {
+					return fmt.Errorf("unmarshal field product_name_i18n: %w", err)
+				}
+			}
 		case orderproduct.FieldProductType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field product_type", values[i])
@@ -317,6 +329,13 @@ func (op *OrderProduct) assignValues(columns []string, values []any) error {
 				op.PromotionDiscount = new(decimal.Decimal)
 				*op.PromotionDiscount = *value.S.(*decimal.Decimal)
 			}
+		case orderproduct.FieldMemberDiscount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field member_discount", values[i])
+			} else if value.Valid {
+				op.MemberDiscount = new(decimal.Decimal)
+				*op.MemberDiscount = *value.S.(*decimal.Decimal)
+			}
 		case orderproduct.FieldAttrAmount:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field attr_amount", values[i])
@@ -465,6 +484,9 @@ func (op *OrderProduct) String() string {
 	builder.WriteString("product_name=")
 	builder.WriteString(op.ProductName)
 	builder.WriteString(", ")
+	builder.WriteString("product_name_i18n=")
+	builder.WriteString(fmt.Sprintf("%v", op.ProductNameI18n))
+	builder.WriteString(", ")
 	builder.WriteString("product_type=")
 	builder.WriteString(fmt.Sprintf("%v", op.ProductType))
 	builder.WriteString(", ")
@@ -529,6 +551,11 @@ func (op *OrderProduct) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := op.MemberDiscount; v != nil {
+		builder.WriteString("member_discount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := op.AttrAmount; v != nil {
 		builder.WriteString("attr_amount=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -34,6 +34,8 @@ const (
 	FieldProductID = "product_id"
 	// FieldProductName holds the string denoting the product_name field in the database.
 	FieldProductName = "product_name"
+	// FieldProductNameI18n holds the string denoting the product_name_i18n field in the database.
+	FieldProductNameI18n = "product_name_i18n"
 	// FieldProductType holds the string denoting the product_type field in the database.
 	FieldProductType = "product_type"
 	// FieldCategory holds the string denoting the category field in the database.
@@ -66,6 +68,8 @@ const (
 	FieldTotal = "total"
 	// FieldPromotionDiscount holds the string denoting the promotion_discount field in the database.
 	FieldPromotionDiscount = "promotion_discount"
+	// FieldMemberDiscount holds the string denoting the member_discount field in the database.
+	FieldMemberDiscount = "member_discount"
 	// FieldAttrAmount holds the string denoting the attr_amount field in the database.
 	FieldAttrAmount = "attr_amount"
 	// FieldGiftAmount holds the string denoting the gift_amount field in the database.
@@ -114,6 +118,7 @@ var Columns = []string{
 	FieldIndex,
 	FieldProductID,
 	FieldProductName,
+	FieldProductNameI18n,
 	FieldProductType,
 	FieldCategory,
 	FieldProductUnit,
@@ -130,6 +135,7 @@ var Columns = []string{
 	FieldAmountAfterTax,
 	FieldTotal,
 	FieldPromotionDiscount,
+	FieldMemberDiscount,
 	FieldAttrAmount,
 	FieldGiftAmount,
 	FieldVoidQty,
@@ -326,6 +332,11 @@ func ByPromotionDiscount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPromotionDiscount, opts...).ToFunc()
 }
 
+// ByMemberDiscount orders the results by the member_discount field.
+func ByMemberDiscount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemberDiscount, opts...).ToFunc()
+}
+
 // ByAttrAmount orders the results by the attr_amount field.
 func ByAttrAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAttrAmount, opts...).ToFunc()
@@ -163,6 +163,11 @@ func PromotionDiscount(v decimal.Decimal) predicate.OrderProduct {
 	return predicate.OrderProduct(sql.FieldEQ(FieldPromotionDiscount, v))
 }
 
+// MemberDiscount applies equality check predicate on the "member_discount" field. It's identical to MemberDiscountEQ.
+func MemberDiscount(v decimal.Decimal) predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldEQ(FieldMemberDiscount, v))
+}
+
 // AttrAmount applies equality check predicate on the "attr_amount" field. It's identical to AttrAmountEQ.
 func AttrAmount(v decimal.Decimal) predicate.OrderProduct {
 	return predicate.OrderProduct(sql.FieldEQ(FieldAttrAmount, v))
@@ -558,6 +563,16 @@ func ProductNameContainsFold(v string) predicate.OrderProduct {
 	return predicate.OrderProduct(sql.FieldContainsFold(FieldProductName, v))
 }
 
+// ProductNameI18nIsNil applies the IsNil predicate on the "product_name_i18n" field.
+func ProductNameI18nIsNil() predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldIsNull(FieldProductNameI18n))
+}
+
+// ProductNameI18nNotNil applies the NotNil predicate on the "product_name_i18n" field.
+func ProductNameI18nNotNil() predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldNotNull(FieldProductNameI18n))
+}
+
 // ProductTypeEQ applies the EQ predicate on the "product_type" field.
 func ProductTypeEQ(v domain.ProductType) predicate.OrderProduct {
 	vc := v
@@ -1228,6 +1243,56 @@ func PromotionDiscountNotNil() predicate.OrderProduct {
 	return predicate.OrderProduct(sql.FieldNotNull(FieldPromotionDiscount))
 }
 
+// MemberDiscountEQ applies the EQ predicate on the "member_discount" field.
+func MemberDiscountEQ(v decimal.Decimal) predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldEQ(FieldMemberDiscount, v))
+}
+
+// MemberDiscountNEQ applies the NEQ predicate on the "member_discount" field.
+func MemberDiscountNEQ(v decimal.Decimal) predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldNEQ(FieldMemberDiscount, v))
+}
+
+// MemberDiscountIn applies the In predicate on the "member_discount" field.
+func MemberDiscountIn(vs ...decimal.Decimal) predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldIn(FieldMemberDiscount, vs...))
+}
+
+// MemberDiscountNotIn applies the NotIn predicate on the "member_discount" field.
+func MemberDiscountNotIn(vs ...decimal.Decimal) predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldNotIn(FieldMemberDiscount, vs...))
+}
+
+// MemberDiscountGT applies the GT predicate on the "member_discount" field.
+func MemberDiscountGT(v decimal.Decimal) predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldGT(FieldMemberDiscount, v))
+}
+
+// MemberDiscountGTE applies the GTE predicate on the "member_discount" field.
+func MemberDiscountGTE(v decimal.Decimal) predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldGTE(FieldMemberDiscount, v))
+}
+
+// MemberDiscountLT applies the LT predicate on the "member_discount" field.
+func MemberDiscountLT(v decimal.Decimal) predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldLT(FieldMemberDiscount, v))
+}
+
+// MemberDiscountLTE applies the LTE predicate on the "member_discount" field.
+func MemberDiscountLTE(v decimal.Decimal) predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldLTE(FieldMemberDiscount, v))
+}
+
+// MemberDiscountIsNil applies the IsNil predicate on the "member_discount" field.
+func MemberDiscountIsNil() predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldIsNull(FieldMemberDiscount))
+}
+
+// MemberDiscountNotNil applies the NotNil predicate on the "member_discount" field.
+func MemberDiscountNotNil() predicate.OrderProduct {
+	return predicate.OrderProduct(sql.FieldNotNull(FieldMemberDiscount))
+}
+
 // AttrAmountEQ applies the EQ predicate on the "attr_amount" field.
 func AttrAmountEQ(v decimal.Decimal) predicate.OrderProduct {
 	return predicate.OrderProduct(sql.FieldEQ(FieldAttrAmount, v))
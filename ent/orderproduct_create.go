@@ -107,6 +107,12 @@ func (opc *OrderProductCreate) SetProductName(s string) *OrderProductCreate {
 	return opc
 }
 
+// SetProductNameI18n sets the "product_name_i18n" field.
+func (opc *OrderProductCreate) SetProductNameI18n(dt domain.LocalizedText) *OrderProductCreate {
+	opc.mutation.SetProductNameI18n(dt)
+	return opc
+}
+
 // SetProductType sets the "product_type" field.
 func (opc *OrderProductCreate) SetProductType(dt domain.ProductType) *OrderProductCreate {
 	opc.mutation.SetProductType(dt)
@@ -331,6 +337,20 @@ func (opc *OrderProductCreate) SetNillablePromotionDiscount(d *decimal.Decimal)
 	return opc
 }
 
+// SetMemberDiscount sets the "member_discount" field.
+func (opc *OrderProductCreate) SetMemberDiscount(d decimal.Decimal) *OrderProductCreate {
+	opc.mutation.SetMemberDiscount(d)
+	return opc
+}
+
+// SetNillableMemberDiscount sets the "member_discount" field if the given value is not nil.
+func (opc *OrderProductCreate) SetNillableMemberDiscount(d *decimal.Decimal) *OrderProductCreate {
+	if d != nil {
+		opc.SetMemberDiscount(*d)
+	}
+	return opc
+}
+
 // SetAttrAmount sets the "attr_amount" field.
 func (opc *OrderProductCreate) SetAttrAmount(d decimal.Decimal) *OrderProductCreate {
 	opc.mutation.SetAttrAmount(d)
@@ -730,6 +750,10 @@ func (opc *OrderProductCreate) createSpec() (*OrderProduct, *sqlgraph.CreateSpec
 		_spec.SetField(orderproduct.FieldProductName, field.TypeString, value)
 		_node.ProductName = value
 	}
+	if value, ok := opc.mutation.ProductNameI18n(); ok {
+		_spec.SetField(orderproduct.FieldProductNameI18n, field.TypeJSON, value)
+		_node.ProductNameI18n = value
+	}
 	if value, ok := opc.mutation.ProductType(); ok {
 		_spec.SetField(orderproduct.FieldProductType, field.TypeEnum, value)
 		_node.ProductType = value
@@ -794,6 +818,10 @@ func (opc *OrderProductCreate) createSpec() (*OrderProduct, *sqlgraph.CreateSpec
 		_spec.SetField(orderproduct.FieldPromotionDiscount, field.TypeOther, value)
 		_node.PromotionDiscount = &value
 	}
+	if value, ok := opc.mutation.MemberDiscount(); ok {
+		_spec.SetField(orderproduct.FieldMemberDiscount, field.TypeOther, value)
+		_node.MemberDiscount = &value
+	}
 	if value, ok := opc.mutation.AttrAmount(); ok {
 		_spec.SetField(orderproduct.FieldAttrAmount, field.TypeOther, value)
 		_node.AttrAmount = &value
@@ -1007,6 +1035,24 @@ func (u *OrderProductUpsert) UpdateProductName() *OrderProductUpsert {
 	return u
 }
 
+// SetProductNameI18n sets the "product_name_i18n" field.
+func (u *OrderProductUpsert) SetProductNameI18n(v domain.LocalizedText) *OrderProductUpsert {
+	u.Set(orderproduct.FieldProductNameI18n, v)
+	return u
+}
+
+// UpdateProductNameI18n sets the "product_name_i18n" field to the value that was provided on create.
+func (u *OrderProductUpsert) UpdateProductNameI18n() *OrderProductUpsert {
+	u.SetExcluded(orderproduct.FieldProductNameI18n)
+	return u
+}
+
+// ClearProductNameI18n clears the value of the "product_name_i18n" field.
+func (u *OrderProductUpsert) ClearProductNameI18n() *OrderProductUpsert {
+	u.SetNull(orderproduct.FieldProductNameI18n)
+	return u
+}
+
 // SetProductType sets the "product_type" field.
 func (u *OrderProductUpsert) SetProductType(v domain.ProductType) *OrderProductUpsert {
 	u.Set(orderproduct.FieldProductType, v)
@@ -1271,6 +1317,24 @@ func (u *OrderProductUpsert) ClearPromotionDiscount() *OrderProductUpsert {
 	return u
 }
 
+// SetMemberDiscount sets the "member_discount" field.
+func (u *OrderProductUpsert) SetMemberDiscount(v decimal.Decimal) *OrderProductUpsert {
+	u.Set(orderproduct.FieldMemberDiscount, v)
+	return u
+}
+
+// UpdateMemberDiscount sets the "member_discount" field to the value that was provided on create.
+func (u *OrderProductUpsert) UpdateMemberDiscount() *OrderProductUpsert {
+	u.SetExcluded(orderproduct.FieldMemberDiscount)
+	return u
+}
+
+// ClearMemberDiscount clears the value of the "member_discount" field.
+func (u *OrderProductUpsert) ClearMemberDiscount() *OrderProductUpsert {
+	u.SetNull(orderproduct.FieldMemberDiscount)
+	return u
+}
+
 // SetAttrAmount sets the "attr_amount" field.
 func (u *OrderProductUpsert) SetAttrAmount(v decimal.Decimal) *OrderProductUpsert {
 	u.Set(orderproduct.FieldAttrAmount, v)
@@ -1650,6 +1714,27 @@ func (u *OrderProductUpsertOne) UpdateProductName() *OrderProductUpsertOne {
 	})
 }
 
+// SetProductNameI18n sets the "product_name_i18n" field.
+func (u *OrderProductUpsertOne) SetProductNameI18n(v domain.LocalizedText) *OrderProductUpsertOne {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.SetProductNameI18n(v)
+	})
+}
+
+// UpdateProductNameI18n sets the "product_name_i18n" field to the value that was provided on create.
+func (u *OrderProductUpsertOne) UpdateProductNameI18n() *OrderProductUpsertOne {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.UpdateProductNameI18n()
+	})
+}
+
+// ClearProductNameI18n clears the value of the "product_name_i18n" field.
+func (u *OrderProductUpsertOne) ClearProductNameI18n() *OrderProductUpsertOne {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.ClearProductNameI18n()
+	})
+}
+
 // SetProductType sets the "product_type" field.
 func (u *OrderProductUpsertOne) SetProductType(v domain.ProductType) *OrderProductUpsertOne {
 	return u.Update(func(s *OrderProductUpsert) {
@@ -1958,6 +2043,27 @@ func (u *OrderProductUpsertOne) ClearPromotionDiscount() *OrderProductUpsertOne
 	})
 }
 
+// SetMemberDiscount sets the "member_discount" field.
+func (u *OrderProductUpsertOne) SetMemberDiscount(v decimal.Decimal) *OrderProductUpsertOne {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.SetMemberDiscount(v)
+	})
+}
+
+// UpdateMemberDiscount sets the "member_discount" field to the value that was provided on create.
+func (u *OrderProductUpsertOne) UpdateMemberDiscount() *OrderProductUpsertOne {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.UpdateMemberDiscount()
+	})
+}
+
+// ClearMemberDiscount clears the value of the "member_discount" field.
+func (u *OrderProductUpsertOne) ClearMemberDiscount() *OrderProductUpsertOne {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.ClearMemberDiscount()
+	})
+}
+
 // SetAttrAmount sets the "attr_amount" field.
 func (u *OrderProductUpsertOne) SetAttrAmount(v decimal.Decimal) *OrderProductUpsertOne {
 	return u.Update(func(s *OrderProductUpsert) {
@@ -2540,6 +2646,27 @@ func (u *OrderProductUpsertBulk) UpdateProductName() *OrderProductUpsertBulk {
 	})
 }
 
+// SetProductNameI18n sets the "product_name_i18n" field.
+func (u *OrderProductUpsertBulk) SetProductNameI18n(v domain.LocalizedText) *OrderProductUpsertBulk {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.SetProductNameI18n(v)
+	})
+}
+
+// UpdateProductNameI18n sets the "product_name_i18n" field to the value that was provided on create.
+func (u *OrderProductUpsertBulk) UpdateProductNameI18n() *OrderProductUpsertBulk {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.UpdateProductNameI18n()
+	})
+}
+
+// ClearProductNameI18n clears the value of the "product_name_i18n" field.
+func (u *OrderProductUpsertBulk) ClearProductNameI18n() *OrderProductUpsertBulk {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.ClearProductNameI18n()
+	})
+}
+
 // SetProductType sets the "product_type" field.
 func (u *OrderProductUpsertBulk) SetProductType(v domain.ProductType) *OrderProductUpsertBulk {
 	return u.Update(func(s *OrderProductUpsert) {
@@ -2848,6 +2975,27 @@ func (u *OrderProductUpsertBulk) ClearPromotionDiscount() *OrderProductUpsertBul
 	})
 }
 
+// SetMemberDiscount sets the "member_discount" field.
+func (u *OrderProductUpsertBulk) SetMemberDiscount(v decimal.Decimal) *OrderProductUpsertBulk {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.SetMemberDiscount(v)
+	})
+}
+
+// UpdateMemberDiscount sets the "member_discount" field to the value that was provided on create.
+func (u *OrderProductUpsertBulk) UpdateMemberDiscount() *OrderProductUpsertBulk {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.UpdateMemberDiscount()
+	})
+}
+
+// ClearMemberDiscount clears the value of the "member_discount" field.
+func (u *OrderProductUpsertBulk) ClearMemberDiscount() *OrderProductUpsertBulk {
+	return u.Update(func(s *OrderProductUpsert) {
+		s.ClearMemberDiscount()
+	})
+}
+
 // SetAttrAmount sets the "attr_amount" field.
 func (u *OrderProductUpsertBulk) SetAttrAmount(v decimal.Decimal) *OrderProductUpsertBulk {
 	return u.Update(func(s *OrderProductUpsert) {
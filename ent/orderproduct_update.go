@@ -138,6 +138,18 @@ func (opu *OrderProductUpdate) SetNillableProductName(s *string) *OrderProductUp
 	return opu
 }
 
+// SetProductNameI18n sets the "product_name_i18n" field.
+func (opu *OrderProductUpdate) SetProductNameI18n(dt domain.LocalizedText) *OrderProductUpdate {
+	opu.mutation.SetProductNameI18n(dt)
+	return opu
+}
+
+// ClearProductNameI18n clears the value of the "product_name_i18n" field.
+func (opu *OrderProductUpdate) ClearProductNameI18n() *OrderProductUpdate {
+	opu.mutation.ClearProductNameI18n()
+	return opu
+}
+
 // SetProductType sets the "product_type" field.
 func (opu *OrderProductUpdate) SetProductType(dt domain.ProductType) *OrderProductUpdate {
 	opu.mutation.SetProductType(dt)
@@ -436,6 +448,26 @@ func (opu *OrderProductUpdate) ClearPromotionDiscount() *OrderProductUpdate {
 	return opu
 }
 
+// SetMemberDiscount sets the "member_discount" field.
+func (opu *OrderProductUpdate) SetMemberDiscount(d decimal.Decimal) *OrderProductUpdate {
+	opu.mutation.SetMemberDiscount(d)
+	return opu
+}
+
+// SetNillableMemberDiscount sets the "member_discount" field if the given value is not nil.
+func (opu *OrderProductUpdate) SetNillableMemberDiscount(d *decimal.Decimal) *OrderProductUpdate {
+	if d != nil {
+		opu.SetMemberDiscount(*d)
+	}
+	return opu
+}
+
+// ClearMemberDiscount clears the value of the "member_discount" field.
+func (opu *OrderProductUpdate) ClearMemberDiscount() *OrderProductUpdate {
+	opu.mutation.ClearMemberDiscount()
+	return opu
+}
+
 // SetAttrAmount sets the "attr_amount" field.
 func (opu *OrderProductUpdate) SetAttrAmount(d decimal.Decimal) *OrderProductUpdate {
 	opu.mutation.SetAttrAmount(d)
@@ -804,6 +836,12 @@ func (opu *OrderProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := opu.mutation.ProductName(); ok {
 		_spec.SetField(orderproduct.FieldProductName, field.TypeString, value)
 	}
+	if value, ok := opu.mutation.ProductNameI18n(); ok {
+		_spec.SetField(orderproduct.FieldProductNameI18n, field.TypeJSON, value)
+	}
+	if opu.mutation.ProductNameI18nCleared() {
+		_spec.ClearField(orderproduct.FieldProductNameI18n, field.TypeJSON)
+	}
 	if value, ok := opu.mutation.ProductType(); ok {
 		_spec.SetField(orderproduct.FieldProductType, field.TypeEnum, value)
 	}
@@ -888,6 +926,12 @@ func (opu *OrderProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if opu.mutation.PromotionDiscountCleared() {
 		_spec.ClearField(orderproduct.FieldPromotionDiscount, field.TypeOther)
 	}
+	if value, ok := opu.mutation.MemberDiscount(); ok {
+		_spec.SetField(orderproduct.FieldMemberDiscount, field.TypeOther, value)
+	}
+	if opu.mutation.MemberDiscountCleared() {
+		_spec.ClearField(orderproduct.FieldMemberDiscount, field.TypeOther)
+	}
 	if value, ok := opu.mutation.AttrAmount(); ok {
 		_spec.SetField(orderproduct.FieldAttrAmount, field.TypeOther, value)
 	}
@@ -1130,6 +1174,18 @@ func (opuo *OrderProductUpdateOne) SetNillableProductName(s *string) *OrderProdu
 	return opuo
 }
 
+// SetProductNameI18n sets the "product_name_i18n" field.
+func (opuo *OrderProductUpdateOne) SetProductNameI18n(dt domain.LocalizedText) *OrderProductUpdateOne {
+	opuo.mutation.SetProductNameI18n(dt)
+	return opuo
+}
+
+// ClearProductNameI18n clears the value of the "product_name_i18n" field.
+func (opuo *OrderProductUpdateOne) ClearProductNameI18n() *OrderProductUpdateOne {
+	opuo.mutation.ClearProductNameI18n()
+	return opuo
+}
+
 // SetProductType sets the "product_type" field.
 func (opuo *OrderProductUpdateOne) SetProductType(dt domain.ProductType) *OrderProductUpdateOne {
 	opuo.mutation.SetProductType(dt)
@@ -1428,6 +1484,26 @@ func (opuo *OrderProductUpdateOne) ClearPromotionDiscount() *OrderProductUpdateO
 	return opuo
 }
 
+// SetMemberDiscount sets the "member_discount" field.
+func (opuo *OrderProductUpdateOne) SetMemberDiscount(d decimal.Decimal) *OrderProductUpdateOne {
+	opuo.mutation.SetMemberDiscount(d)
+	return opuo
+}
+
+// SetNillableMemberDiscount sets the "member_discount" field if the given value is not nil.
+func (opuo *OrderProductUpdateOne) SetNillableMemberDiscount(d *decimal.Decimal) *OrderProductUpdateOne {
+	if d != nil {
+		opuo.SetMemberDiscount(*d)
+	}
+	return opuo
+}
+
+// ClearMemberDiscount clears the value of the "member_discount" field.
+func (opuo *OrderProductUpdateOne) ClearMemberDiscount() *OrderProductUpdateOne {
+	opuo.mutation.ClearMemberDiscount()
+	return opuo
+}
+
 // SetAttrAmount sets the "attr_amount" field.
 func (opuo *OrderProductUpdateOne) SetAttrAmount(d decimal.Decimal) *OrderProductUpdateOne {
 	opuo.mutation.SetAttrAmount(d)
@@ -1826,6 +1902,12 @@ func (opuo *OrderProductUpdateOne) sqlSave(ctx context.Context) (_node *OrderPro
 	if value, ok := opuo.mutation.ProductName(); ok {
 		_spec.SetField(orderproduct.FieldProductName, field.TypeString, value)
 	}
+	if value, ok := opuo.mutation.ProductNameI18n(); ok {
+		_spec.SetField(orderproduct.FieldProductNameI18n, field.TypeJSON, value)
+	}
+	if opuo.mutation.ProductNameI18nCleared() {
+		_spec.ClearField(orderproduct.FieldProductNameI18n, field.TypeJSON)
+	}
 	if value, ok := opuo.mutation.ProductType(); ok {
 		_spec.SetField(orderproduct.FieldProductType, field.TypeEnum, value)
 	}
@@ -1910,6 +1992,12 @@ func (opuo *OrderProductUpdateOne) sqlSave(ctx context.Context) (_node *OrderPro
 	if opuo.mutation.PromotionDiscountCleared() {
 		_spec.ClearField(orderproduct.FieldPromotionDiscount, field.TypeOther)
 	}
+	if value, ok := opuo.mutation.MemberDiscount(); ok {
+		_spec.SetField(orderproduct.FieldMemberDiscount, field.TypeOther, value)
+	}
+	if opuo.mutation.MemberDiscountCleared() {
+		_spec.ClearField(orderproduct.FieldMemberDiscount, field.TypeOther)
+	}
 	if value, ok := opuo.mutation.AttrAmount(); ok {
 		_spec.SetField(orderproduct.FieldAttrAmount, field.TypeOther, value)
 	}
@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickupcall"
+)
+
+// PickupCall is the model entity for the PickupCall schema.
+type PickupCall struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 订单ID
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// 订单号
+	OrderNo string `json:"order_no,omitempty"`
+	// 取餐号
+	PickupNo string `json:"pickup_no,omitempty"`
+	// 营业日
+	BusinessDate string `json:"business_date,omitempty"`
+	// 状态：waiting=制作中；ready=待取餐；picked_up=已取餐
+	Status domain.PickupCallStatus `json:"status,omitempty"`
+	// 叫号次数
+	CallCount int `json:"call_count,omitempty"`
+	// 最近叫号时间
+	ReadyAt *time.Time `json:"ready_at,omitempty"`
+	// 取餐时间
+	PickedUpAt   *time.Time `json:"picked_up_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PickupCall) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pickupcall.FieldCallCount:
+			values[i] = new(sql.NullInt64)
+		case pickupcall.FieldOrderNo, pickupcall.FieldPickupNo, pickupcall.FieldBusinessDate, pickupcall.FieldStatus:
+			values[i] = new(sql.NullString)
+		case pickupcall.FieldCreatedAt, pickupcall.FieldUpdatedAt, pickupcall.FieldReadyAt, pickupcall.FieldPickedUpAt:
+			values[i] = new(sql.NullTime)
+		case pickupcall.FieldID, pickupcall.FieldMerchantID, pickupcall.FieldStoreID, pickupcall.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PickupCall fields.
+func (pc *PickupCall) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pickupcall.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pc.ID = *value
+			}
+		case pickupcall.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pc.CreatedAt = value.Time
+			}
+		case pickupcall.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pc.UpdatedAt = value.Time
+			}
+		case pickupcall.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				pc.MerchantID = *value
+			}
+		case pickupcall.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				pc.StoreID = *value
+			}
+		case pickupcall.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				pc.OrderID = *value
+			}
+		case pickupcall.FieldOrderNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_no", values[i])
+			} else if value.Valid {
+				pc.OrderNo = value.String
+			}
+		case pickupcall.FieldPickupNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pickup_no", values[i])
+			} else if value.Valid {
+				pc.PickupNo = value.String
+			}
+		case pickupcall.FieldBusinessDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field business_date", values[i])
+			} else if value.Valid {
+				pc.BusinessDate = value.String
+			}
+		case pickupcall.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pc.Status = domain.PickupCallStatus(value.String)
+			}
+		case pickupcall.FieldCallCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field call_count", values[i])
+			} else if value.Valid {
+				pc.CallCount = int(value.Int64)
+			}
+		case pickupcall.FieldReadyAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ready_at", values[i])
+			} else if value.Valid {
+				pc.ReadyAt = new(time.Time)
+				*pc.ReadyAt = value.Time
+			}
+		case pickupcall.FieldPickedUpAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field picked_up_at", values[i])
+			} else if value.Valid {
+				pc.PickedUpAt = new(time.Time)
+				*pc.PickedUpAt = value.Time
+			}
+		default:
+			pc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PickupCall.
+// This includes values selected through modifiers, order, etc.
+func (pc *PickupCall) Value(name string) (ent.Value, error) {
+	return pc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PickupCall.
+// Note that you need to call PickupCall.Unwrap() before calling this method if this PickupCall
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pc *PickupCall) Update() *PickupCallUpdateOne {
+	return NewPickupCallClient(pc.config).UpdateOne(pc)
+}
+
+// Unwrap unwraps the PickupCall entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pc *PickupCall) Unwrap() *PickupCall {
+	_tx, ok := pc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PickupCall is not a transactional entity")
+	}
+	pc.config.driver = _tx.drv
+	return pc
+}
+
+// String implements the fmt.Stringer.
+func (pc *PickupCall) String() string {
+	var builder strings.Builder
+	builder.WriteString("PickupCall(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pc.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", pc.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", pc.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("order_no=")
+	builder.WriteString(pc.OrderNo)
+	builder.WriteString(", ")
+	builder.WriteString("pickup_no=")
+	builder.WriteString(pc.PickupNo)
+	builder.WriteString(", ")
+	builder.WriteString("business_date=")
+	builder.WriteString(pc.BusinessDate)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", pc.Status))
+	builder.WriteString(", ")
+	builder.WriteString("call_count=")
+	builder.WriteString(fmt.Sprintf("%v", pc.CallCount))
+	builder.WriteString(", ")
+	if v := pc.ReadyAt; v != nil {
+		builder.WriteString("ready_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pc.PickedUpAt; v != nil {
+		builder.WriteString("picked_up_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PickupCalls is a parsable slice of PickupCall.
+type PickupCalls []*PickupCall
@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pickupcall
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	// Label holds the string label denoting the pickupcall type in the database.
+	Label = "pickup_call"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldOrderNo holds the string denoting the order_no field in the database.
+	FieldOrderNo = "order_no"
+	// FieldPickupNo holds the string denoting the pickup_no field in the database.
+	FieldPickupNo = "pickup_no"
+	// FieldBusinessDate holds the string denoting the business_date field in the database.
+	FieldBusinessDate = "business_date"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCallCount holds the string denoting the call_count field in the database.
+	FieldCallCount = "call_count"
+	// FieldReadyAt holds the string denoting the ready_at field in the database.
+	FieldReadyAt = "ready_at"
+	// FieldPickedUpAt holds the string denoting the picked_up_at field in the database.
+	FieldPickedUpAt = "picked_up_at"
+	// Table holds the table name of the pickupcall in the database.
+	Table = "pickup_calls"
+)
+
+// Columns holds all SQL columns for pickupcall fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldOrderID,
+	FieldOrderNo,
+	FieldPickupNo,
+	FieldBusinessDate,
+	FieldStatus,
+	FieldCallCount,
+	FieldReadyAt,
+	FieldPickedUpAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultOrderNo holds the default value on creation for the "order_no" field.
+	DefaultOrderNo string
+	// OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	OrderNoValidator func(string) error
+	// PickupNoValidator is a validator for the "pickup_no" field. It is called by the builders before save.
+	PickupNoValidator func(string) error
+	// BusinessDateValidator is a validator for the "business_date" field. It is called by the builders before save.
+	BusinessDateValidator func(string) error
+	// DefaultCallCount holds the default value on creation for the "call_count" field.
+	DefaultCallCount int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s domain.PickupCallStatus) error {
+	switch s {
+	case "waiting", "ready", "picked_up":
+		return nil
+	default:
+		return fmt.Errorf("pickupcall: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the PickupCall queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByOrderNo orders the results by the order_no field.
+func ByOrderNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderNo, opts...).ToFunc()
+}
+
+// ByPickupNo orders the results by the pickup_no field.
+func ByPickupNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPickupNo, opts...).ToFunc()
+}
+
+// ByBusinessDate orders the results by the business_date field.
+func ByBusinessDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBusinessDate, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCallCount orders the results by the call_count field.
+func ByCallCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCallCount, opts...).ToFunc()
+}
+
+// ByReadyAt orders the results by the ready_at field.
+func ByReadyAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadyAt, opts...).ToFunc()
+}
+
+// ByPickedUpAt orders the results by the picked_up_at field.
+func ByPickedUpAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPickedUpAt, opts...).ToFunc()
+}
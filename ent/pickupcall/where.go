@@ -0,0 +1,692 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pickupcall
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldStoreID, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderNo applies equality check predicate on the "order_no" field. It's identical to OrderNoEQ.
+func OrderNo(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// PickupNo applies equality check predicate on the "pickup_no" field. It's identical to PickupNoEQ.
+func PickupNo(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldPickupNo, v))
+}
+
+// BusinessDate applies equality check predicate on the "business_date" field. It's identical to BusinessDateEQ.
+func BusinessDate(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldBusinessDate, v))
+}
+
+// CallCount applies equality check predicate on the "call_count" field. It's identical to CallCountEQ.
+func CallCount(v int) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldCallCount, v))
+}
+
+// ReadyAt applies equality check predicate on the "ready_at" field. It's identical to ReadyAtEQ.
+func ReadyAt(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldReadyAt, v))
+}
+
+// PickedUpAt applies equality check predicate on the "picked_up_at" field. It's identical to PickedUpAtEQ.
+func PickedUpAt(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldPickedUpAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldStoreID, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v uuid.UUID) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderNoEQ applies the EQ predicate on the "order_no" field.
+func OrderNoEQ(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// OrderNoNEQ applies the NEQ predicate on the "order_no" field.
+func OrderNoNEQ(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldOrderNo, v))
+}
+
+// OrderNoIn applies the In predicate on the "order_no" field.
+func OrderNoIn(vs ...string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldOrderNo, vs...))
+}
+
+// OrderNoNotIn applies the NotIn predicate on the "order_no" field.
+func OrderNoNotIn(vs ...string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldOrderNo, vs...))
+}
+
+// OrderNoGT applies the GT predicate on the "order_no" field.
+func OrderNoGT(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldOrderNo, v))
+}
+
+// OrderNoGTE applies the GTE predicate on the "order_no" field.
+func OrderNoGTE(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldOrderNo, v))
+}
+
+// OrderNoLT applies the LT predicate on the "order_no" field.
+func OrderNoLT(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldOrderNo, v))
+}
+
+// OrderNoLTE applies the LTE predicate on the "order_no" field.
+func OrderNoLTE(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldOrderNo, v))
+}
+
+// OrderNoContains applies the Contains predicate on the "order_no" field.
+func OrderNoContains(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldContains(FieldOrderNo, v))
+}
+
+// OrderNoHasPrefix applies the HasPrefix predicate on the "order_no" field.
+func OrderNoHasPrefix(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldHasPrefix(FieldOrderNo, v))
+}
+
+// OrderNoHasSuffix applies the HasSuffix predicate on the "order_no" field.
+func OrderNoHasSuffix(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldHasSuffix(FieldOrderNo, v))
+}
+
+// OrderNoEqualFold applies the EqualFold predicate on the "order_no" field.
+func OrderNoEqualFold(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEqualFold(FieldOrderNo, v))
+}
+
+// OrderNoContainsFold applies the ContainsFold predicate on the "order_no" field.
+func OrderNoContainsFold(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldContainsFold(FieldOrderNo, v))
+}
+
+// PickupNoEQ applies the EQ predicate on the "pickup_no" field.
+func PickupNoEQ(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldPickupNo, v))
+}
+
+// PickupNoNEQ applies the NEQ predicate on the "pickup_no" field.
+func PickupNoNEQ(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldPickupNo, v))
+}
+
+// PickupNoIn applies the In predicate on the "pickup_no" field.
+func PickupNoIn(vs ...string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldPickupNo, vs...))
+}
+
+// PickupNoNotIn applies the NotIn predicate on the "pickup_no" field.
+func PickupNoNotIn(vs ...string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldPickupNo, vs...))
+}
+
+// PickupNoGT applies the GT predicate on the "pickup_no" field.
+func PickupNoGT(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldPickupNo, v))
+}
+
+// PickupNoGTE applies the GTE predicate on the "pickup_no" field.
+func PickupNoGTE(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldPickupNo, v))
+}
+
+// PickupNoLT applies the LT predicate on the "pickup_no" field.
+func PickupNoLT(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldPickupNo, v))
+}
+
+// PickupNoLTE applies the LTE predicate on the "pickup_no" field.
+func PickupNoLTE(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldPickupNo, v))
+}
+
+// PickupNoContains applies the Contains predicate on the "pickup_no" field.
+func PickupNoContains(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldContains(FieldPickupNo, v))
+}
+
+// PickupNoHasPrefix applies the HasPrefix predicate on the "pickup_no" field.
+func PickupNoHasPrefix(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldHasPrefix(FieldPickupNo, v))
+}
+
+// PickupNoHasSuffix applies the HasSuffix predicate on the "pickup_no" field.
+func PickupNoHasSuffix(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldHasSuffix(FieldPickupNo, v))
+}
+
+// PickupNoEqualFold applies the EqualFold predicate on the "pickup_no" field.
+func PickupNoEqualFold(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEqualFold(FieldPickupNo, v))
+}
+
+// PickupNoContainsFold applies the ContainsFold predicate on the "pickup_no" field.
+func PickupNoContainsFold(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldContainsFold(FieldPickupNo, v))
+}
+
+// BusinessDateEQ applies the EQ predicate on the "business_date" field.
+func BusinessDateEQ(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldBusinessDate, v))
+}
+
+// BusinessDateNEQ applies the NEQ predicate on the "business_date" field.
+func BusinessDateNEQ(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldBusinessDate, v))
+}
+
+// BusinessDateIn applies the In predicate on the "business_date" field.
+func BusinessDateIn(vs ...string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldBusinessDate, vs...))
+}
+
+// BusinessDateNotIn applies the NotIn predicate on the "business_date" field.
+func BusinessDateNotIn(vs ...string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldBusinessDate, vs...))
+}
+
+// BusinessDateGT applies the GT predicate on the "business_date" field.
+func BusinessDateGT(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldBusinessDate, v))
+}
+
+// BusinessDateGTE applies the GTE predicate on the "business_date" field.
+func BusinessDateGTE(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldBusinessDate, v))
+}
+
+// BusinessDateLT applies the LT predicate on the "business_date" field.
+func BusinessDateLT(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldBusinessDate, v))
+}
+
+// BusinessDateLTE applies the LTE predicate on the "business_date" field.
+func BusinessDateLTE(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldBusinessDate, v))
+}
+
+// BusinessDateContains applies the Contains predicate on the "business_date" field.
+func BusinessDateContains(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldContains(FieldBusinessDate, v))
+}
+
+// BusinessDateHasPrefix applies the HasPrefix predicate on the "business_date" field.
+func BusinessDateHasPrefix(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldHasPrefix(FieldBusinessDate, v))
+}
+
+// BusinessDateHasSuffix applies the HasSuffix predicate on the "business_date" field.
+func BusinessDateHasSuffix(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldHasSuffix(FieldBusinessDate, v))
+}
+
+// BusinessDateEqualFold applies the EqualFold predicate on the "business_date" field.
+func BusinessDateEqualFold(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEqualFold(FieldBusinessDate, v))
+}
+
+// BusinessDateContainsFold applies the ContainsFold predicate on the "business_date" field.
+func BusinessDateContainsFold(v string) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldContainsFold(FieldBusinessDate, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v domain.PickupCallStatus) predicate.PickupCall {
+	vc := v
+	return predicate.PickupCall(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v domain.PickupCallStatus) predicate.PickupCall {
+	vc := v
+	return predicate.PickupCall(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...domain.PickupCallStatus) predicate.PickupCall {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PickupCall(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...domain.PickupCallStatus) predicate.PickupCall {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PickupCall(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// CallCountEQ applies the EQ predicate on the "call_count" field.
+func CallCountEQ(v int) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldCallCount, v))
+}
+
+// CallCountNEQ applies the NEQ predicate on the "call_count" field.
+func CallCountNEQ(v int) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldCallCount, v))
+}
+
+// CallCountIn applies the In predicate on the "call_count" field.
+func CallCountIn(vs ...int) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldCallCount, vs...))
+}
+
+// CallCountNotIn applies the NotIn predicate on the "call_count" field.
+func CallCountNotIn(vs ...int) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldCallCount, vs...))
+}
+
+// CallCountGT applies the GT predicate on the "call_count" field.
+func CallCountGT(v int) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldCallCount, v))
+}
+
+// CallCountGTE applies the GTE predicate on the "call_count" field.
+func CallCountGTE(v int) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldCallCount, v))
+}
+
+// CallCountLT applies the LT predicate on the "call_count" field.
+func CallCountLT(v int) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldCallCount, v))
+}
+
+// CallCountLTE applies the LTE predicate on the "call_count" field.
+func CallCountLTE(v int) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldCallCount, v))
+}
+
+// ReadyAtEQ applies the EQ predicate on the "ready_at" field.
+func ReadyAtEQ(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldReadyAt, v))
+}
+
+// ReadyAtNEQ applies the NEQ predicate on the "ready_at" field.
+func ReadyAtNEQ(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldReadyAt, v))
+}
+
+// ReadyAtIn applies the In predicate on the "ready_at" field.
+func ReadyAtIn(vs ...time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldReadyAt, vs...))
+}
+
+// ReadyAtNotIn applies the NotIn predicate on the "ready_at" field.
+func ReadyAtNotIn(vs ...time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldReadyAt, vs...))
+}
+
+// ReadyAtGT applies the GT predicate on the "ready_at" field.
+func ReadyAtGT(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldReadyAt, v))
+}
+
+// ReadyAtGTE applies the GTE predicate on the "ready_at" field.
+func ReadyAtGTE(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldReadyAt, v))
+}
+
+// ReadyAtLT applies the LT predicate on the "ready_at" field.
+func ReadyAtLT(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldReadyAt, v))
+}
+
+// ReadyAtLTE applies the LTE predicate on the "ready_at" field.
+func ReadyAtLTE(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldReadyAt, v))
+}
+
+// ReadyAtIsNil applies the IsNil predicate on the "ready_at" field.
+func ReadyAtIsNil() predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIsNull(FieldReadyAt))
+}
+
+// ReadyAtNotNil applies the NotNil predicate on the "ready_at" field.
+func ReadyAtNotNil() predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotNull(FieldReadyAt))
+}
+
+// PickedUpAtEQ applies the EQ predicate on the "picked_up_at" field.
+func PickedUpAtEQ(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldEQ(FieldPickedUpAt, v))
+}
+
+// PickedUpAtNEQ applies the NEQ predicate on the "picked_up_at" field.
+func PickedUpAtNEQ(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNEQ(FieldPickedUpAt, v))
+}
+
+// PickedUpAtIn applies the In predicate on the "picked_up_at" field.
+func PickedUpAtIn(vs ...time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIn(FieldPickedUpAt, vs...))
+}
+
+// PickedUpAtNotIn applies the NotIn predicate on the "picked_up_at" field.
+func PickedUpAtNotIn(vs ...time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotIn(FieldPickedUpAt, vs...))
+}
+
+// PickedUpAtGT applies the GT predicate on the "picked_up_at" field.
+func PickedUpAtGT(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGT(FieldPickedUpAt, v))
+}
+
+// PickedUpAtGTE applies the GTE predicate on the "picked_up_at" field.
+func PickedUpAtGTE(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldGTE(FieldPickedUpAt, v))
+}
+
+// PickedUpAtLT applies the LT predicate on the "picked_up_at" field.
+func PickedUpAtLT(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLT(FieldPickedUpAt, v))
+}
+
+// PickedUpAtLTE applies the LTE predicate on the "picked_up_at" field.
+func PickedUpAtLTE(v time.Time) predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldLTE(FieldPickedUpAt, v))
+}
+
+// PickedUpAtIsNil applies the IsNil predicate on the "picked_up_at" field.
+func PickedUpAtIsNil() predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldIsNull(FieldPickedUpAt))
+}
+
+// PickedUpAtNotNil applies the NotNil predicate on the "picked_up_at" field.
+func PickedUpAtNotNil() predicate.PickupCall {
+	return predicate.PickupCall(sql.FieldNotNull(FieldPickedUpAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PickupCall) predicate.PickupCall {
+	return predicate.PickupCall(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PickupCall) predicate.PickupCall {
+	return predicate.PickupCall(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PickupCall) predicate.PickupCall {
+	return predicate.PickupCall(sql.NotPredicates(p))
+}
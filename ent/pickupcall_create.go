@@ -0,0 +1,993 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickupcall"
+)
+
+// PickupCallCreate is the builder for creating a PickupCall entity.
+type PickupCallCreate struct {
+	config
+	mutation *PickupCallMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pcc *PickupCallCreate) SetCreatedAt(t time.Time) *PickupCallCreate {
+	pcc.mutation.SetCreatedAt(t)
+	return pcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pcc *PickupCallCreate) SetNillableCreatedAt(t *time.Time) *PickupCallCreate {
+	if t != nil {
+		pcc.SetCreatedAt(*t)
+	}
+	return pcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcc *PickupCallCreate) SetUpdatedAt(t time.Time) *PickupCallCreate {
+	pcc.mutation.SetUpdatedAt(t)
+	return pcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pcc *PickupCallCreate) SetNillableUpdatedAt(t *time.Time) *PickupCallCreate {
+	if t != nil {
+		pcc.SetUpdatedAt(*t)
+	}
+	return pcc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (pcc *PickupCallCreate) SetMerchantID(u uuid.UUID) *PickupCallCreate {
+	pcc.mutation.SetMerchantID(u)
+	return pcc
+}
+
+// SetStoreID sets the "store_id" field.
+func (pcc *PickupCallCreate) SetStoreID(u uuid.UUID) *PickupCallCreate {
+	pcc.mutation.SetStoreID(u)
+	return pcc
+}
+
+// SetOrderID sets the "order_id" field.
+func (pcc *PickupCallCreate) SetOrderID(u uuid.UUID) *PickupCallCreate {
+	pcc.mutation.SetOrderID(u)
+	return pcc
+}
+
+// SetOrderNo sets the "order_no" field.
+func (pcc *PickupCallCreate) SetOrderNo(s string) *PickupCallCreate {
+	pcc.mutation.SetOrderNo(s)
+	return pcc
+}
+
+// SetNillableOrderNo sets the "order_no" field if the given value is not nil.
+func (pcc *PickupCallCreate) SetNillableOrderNo(s *string) *PickupCallCreate {
+	if s != nil {
+		pcc.SetOrderNo(*s)
+	}
+	return pcc
+}
+
+// SetPickupNo sets the "pickup_no" field.
+func (pcc *PickupCallCreate) SetPickupNo(s string) *PickupCallCreate {
+	pcc.mutation.SetPickupNo(s)
+	return pcc
+}
+
+// SetBusinessDate sets the "business_date" field.
+func (pcc *PickupCallCreate) SetBusinessDate(s string) *PickupCallCreate {
+	pcc.mutation.SetBusinessDate(s)
+	return pcc
+}
+
+// SetStatus sets the "status" field.
+func (pcc *PickupCallCreate) SetStatus(dcs domain.PickupCallStatus) *PickupCallCreate {
+	pcc.mutation.SetStatus(dcs)
+	return pcc
+}
+
+// SetCallCount sets the "call_count" field.
+func (pcc *PickupCallCreate) SetCallCount(i int) *PickupCallCreate {
+	pcc.mutation.SetCallCount(i)
+	return pcc
+}
+
+// SetNillableCallCount sets the "call_count" field if the given value is not nil.
+func (pcc *PickupCallCreate) SetNillableCallCount(i *int) *PickupCallCreate {
+	if i != nil {
+		pcc.SetCallCount(*i)
+	}
+	return pcc
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (pcc *PickupCallCreate) SetReadyAt(t time.Time) *PickupCallCreate {
+	pcc.mutation.SetReadyAt(t)
+	return pcc
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (pcc *PickupCallCreate) SetNillableReadyAt(t *time.Time) *PickupCallCreate {
+	if t != nil {
+		pcc.SetReadyAt(*t)
+	}
+	return pcc
+}
+
+// SetPickedUpAt sets the "picked_up_at" field.
+func (pcc *PickupCallCreate) SetPickedUpAt(t time.Time) *PickupCallCreate {
+	pcc.mutation.SetPickedUpAt(t)
+	return pcc
+}
+
+// SetNillablePickedUpAt sets the "picked_up_at" field if the given value is not nil.
+func (pcc *PickupCallCreate) SetNillablePickedUpAt(t *time.Time) *PickupCallCreate {
+	if t != nil {
+		pcc.SetPickedUpAt(*t)
+	}
+	return pcc
+}
+
+// SetID sets the "id" field.
+func (pcc *PickupCallCreate) SetID(u uuid.UUID) *PickupCallCreate {
+	pcc.mutation.SetID(u)
+	return pcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pcc *PickupCallCreate) SetNillableID(u *uuid.UUID) *PickupCallCreate {
+	if u != nil {
+		pcc.SetID(*u)
+	}
+	return pcc
+}
+
+// Mutation returns the PickupCallMutation object of the builder.
+func (pcc *PickupCallCreate) Mutation() *PickupCallMutation {
+	return pcc.mutation
+}
+
+// Save creates the PickupCall in the database.
+func (pcc *PickupCallCreate) Save(ctx context.Context) (*PickupCall, error) {
+	pcc.defaults()
+	return withHooks(ctx, pcc.sqlSave, pcc.mutation, pcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcc *PickupCallCreate) SaveX(ctx context.Context) *PickupCall {
+	v, err := pcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcc *PickupCallCreate) Exec(ctx context.Context) error {
+	_, err := pcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcc *PickupCallCreate) ExecX(ctx context.Context) {
+	if err := pcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcc *PickupCallCreate) defaults() {
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		v := pickupcall.DefaultCreatedAt()
+		pcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pcc.mutation.UpdatedAt(); !ok {
+		v := pickupcall.DefaultUpdatedAt()
+		pcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pcc.mutation.OrderNo(); !ok {
+		v := pickupcall.DefaultOrderNo
+		pcc.mutation.SetOrderNo(v)
+	}
+	if _, ok := pcc.mutation.CallCount(); !ok {
+		v := pickupcall.DefaultCallCount
+		pcc.mutation.SetCallCount(v)
+	}
+	if _, ok := pcc.mutation.ID(); !ok {
+		v := pickupcall.DefaultID()
+		pcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcc *PickupCallCreate) check() error {
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PickupCall.created_at"`)}
+	}
+	if _, ok := pcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PickupCall.updated_at"`)}
+	}
+	if _, ok := pcc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "PickupCall.merchant_id"`)}
+	}
+	if _, ok := pcc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "PickupCall.store_id"`)}
+	}
+	if _, ok := pcc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "PickupCall.order_id"`)}
+	}
+	if _, ok := pcc.mutation.OrderNo(); !ok {
+		return &ValidationError{Name: "order_no", err: errors.New(`ent: missing required field "PickupCall.order_no"`)}
+	}
+	if v, ok := pcc.mutation.OrderNo(); ok {
+		if err := pickupcall.OrderNoValidator(v); err != nil {
+			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "PickupCall.order_no": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.PickupNo(); !ok {
+		return &ValidationError{Name: "pickup_no", err: errors.New(`ent: missing required field "PickupCall.pickup_no"`)}
+	}
+	if v, ok := pcc.mutation.PickupNo(); ok {
+		if err := pickupcall.PickupNoValidator(v); err != nil {
+			return &ValidationError{Name: "pickup_no", err: fmt.Errorf(`ent: validator failed for field "PickupCall.pickup_no": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.BusinessDate(); !ok {
+		return &ValidationError{Name: "business_date", err: errors.New(`ent: missing required field "PickupCall.business_date"`)}
+	}
+	if v, ok := pcc.mutation.BusinessDate(); ok {
+		if err := pickupcall.BusinessDateValidator(v); err != nil {
+			return &ValidationError{Name: "business_date", err: fmt.Errorf(`ent: validator failed for field "PickupCall.business_date": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PickupCall.status"`)}
+	}
+	if v, ok := pcc.mutation.Status(); ok {
+		if err := pickupcall.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PickupCall.status": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.CallCount(); !ok {
+		return &ValidationError{Name: "call_count", err: errors.New(`ent: missing required field "PickupCall.call_count"`)}
+	}
+	return nil
+}
+
+func (pcc *PickupCallCreate) sqlSave(ctx context.Context) (*PickupCall, error) {
+	if err := pcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pcc.mutation.id = &_node.ID
+	pcc.mutation.done = true
+	return _node, nil
+}
+
+func (pcc *PickupCallCreate) createSpec() (*PickupCall, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PickupCall{config: pcc.config}
+		_spec = sqlgraph.NewCreateSpec(pickupcall.Table, sqlgraph.NewFieldSpec(pickupcall.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pcc.conflict
+	if id, ok := pcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pcc.mutation.CreatedAt(); ok {
+		_spec.SetField(pickupcall.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(pickupcall.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pcc.mutation.MerchantID(); ok {
+		_spec.SetField(pickupcall.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := pcc.mutation.StoreID(); ok {
+		_spec.SetField(pickupcall.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := pcc.mutation.OrderID(); ok {
+		_spec.SetField(pickupcall.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := pcc.mutation.OrderNo(); ok {
+		_spec.SetField(pickupcall.FieldOrderNo, field.TypeString, value)
+		_node.OrderNo = value
+	}
+	if value, ok := pcc.mutation.PickupNo(); ok {
+		_spec.SetField(pickupcall.FieldPickupNo, field.TypeString, value)
+		_node.PickupNo = value
+	}
+	if value, ok := pcc.mutation.BusinessDate(); ok {
+		_spec.SetField(pickupcall.FieldBusinessDate, field.TypeString, value)
+		_node.BusinessDate = value
+	}
+	if value, ok := pcc.mutation.Status(); ok {
+		_spec.SetField(pickupcall.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := pcc.mutation.CallCount(); ok {
+		_spec.SetField(pickupcall.FieldCallCount, field.TypeInt, value)
+		_node.CallCount = value
+	}
+	if value, ok := pcc.mutation.ReadyAt(); ok {
+		_spec.SetField(pickupcall.FieldReadyAt, field.TypeTime, value)
+		_node.ReadyAt = &value
+	}
+	if value, ok := pcc.mutation.PickedUpAt(); ok {
+		_spec.SetField(pickupcall.FieldPickedUpAt, field.TypeTime, value)
+		_node.PickedUpAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PickupCall.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PickupCallUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pcc *PickupCallCreate) OnConflict(opts ...sql.ConflictOption) *PickupCallUpsertOne {
+	pcc.conflict = opts
+	return &PickupCallUpsertOne{
+		create: pcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PickupCall.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcc *PickupCallCreate) OnConflictColumns(columns ...string) *PickupCallUpsertOne {
+	pcc.conflict = append(pcc.conflict, sql.ConflictColumns(columns...))
+	return &PickupCallUpsertOne{
+		create: pcc,
+	}
+}
+
+type (
+	// PickupCallUpsertOne is the builder for "upsert"-ing
+	//  one PickupCall node.
+	PickupCallUpsertOne struct {
+		create *PickupCallCreate
+	}
+
+	// PickupCallUpsert is the "OnConflict" setter.
+	PickupCallUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PickupCallUpsert) SetUpdatedAt(v time.Time) *PickupCallUpsert {
+	u.Set(pickupcall.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PickupCallUpsert) UpdateUpdatedAt() *PickupCallUpsert {
+	u.SetExcluded(pickupcall.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PickupCallUpsert) SetStatus(v domain.PickupCallStatus) *PickupCallUpsert {
+	u.Set(pickupcall.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PickupCallUpsert) UpdateStatus() *PickupCallUpsert {
+	u.SetExcluded(pickupcall.FieldStatus)
+	return u
+}
+
+// SetCallCount sets the "call_count" field.
+func (u *PickupCallUpsert) SetCallCount(v int) *PickupCallUpsert {
+	u.Set(pickupcall.FieldCallCount, v)
+	return u
+}
+
+// UpdateCallCount sets the "call_count" field to the value that was provided on create.
+func (u *PickupCallUpsert) UpdateCallCount() *PickupCallUpsert {
+	u.SetExcluded(pickupcall.FieldCallCount)
+	return u
+}
+
+// AddCallCount adds v to the "call_count" field.
+func (u *PickupCallUpsert) AddCallCount(v int) *PickupCallUpsert {
+	u.Add(pickupcall.FieldCallCount, v)
+	return u
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (u *PickupCallUpsert) SetReadyAt(v time.Time) *PickupCallUpsert {
+	u.Set(pickupcall.FieldReadyAt, v)
+	return u
+}
+
+// UpdateReadyAt sets the "ready_at" field to the value that was provided on create.
+func (u *PickupCallUpsert) UpdateReadyAt() *PickupCallUpsert {
+	u.SetExcluded(pickupcall.FieldReadyAt)
+	return u
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (u *PickupCallUpsert) ClearReadyAt() *PickupCallUpsert {
+	u.SetNull(pickupcall.FieldReadyAt)
+	return u
+}
+
+// SetPickedUpAt sets the "picked_up_at" field.
+func (u *PickupCallUpsert) SetPickedUpAt(v time.Time) *PickupCallUpsert {
+	u.Set(pickupcall.FieldPickedUpAt, v)
+	return u
+}
+
+// UpdatePickedUpAt sets the "picked_up_at" field to the value that was provided on create.
+func (u *PickupCallUpsert) UpdatePickedUpAt() *PickupCallUpsert {
+	u.SetExcluded(pickupcall.FieldPickedUpAt)
+	return u
+}
+
+// ClearPickedUpAt clears the value of the "picked_up_at" field.
+func (u *PickupCallUpsert) ClearPickedUpAt() *PickupCallUpsert {
+	u.SetNull(pickupcall.FieldPickedUpAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PickupCall.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pickupcall.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PickupCallUpsertOne) UpdateNewValues() *PickupCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pickupcall.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pickupcall.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(pickupcall.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(pickupcall.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(pickupcall.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.OrderNo(); exists {
+			s.SetIgnore(pickupcall.FieldOrderNo)
+		}
+		if _, exists := u.create.mutation.PickupNo(); exists {
+			s.SetIgnore(pickupcall.FieldPickupNo)
+		}
+		if _, exists := u.create.mutation.BusinessDate(); exists {
+			s.SetIgnore(pickupcall.FieldBusinessDate)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PickupCall.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PickupCallUpsertOne) Ignore() *PickupCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PickupCallUpsertOne) DoNothing() *PickupCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PickupCallCreate.OnConflict
+// documentation for more info.
+func (u *PickupCallUpsertOne) Update(set func(*PickupCallUpsert)) *PickupCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PickupCallUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PickupCallUpsertOne) SetUpdatedAt(v time.Time) *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PickupCallUpsertOne) UpdateUpdatedAt() *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PickupCallUpsertOne) SetStatus(v domain.PickupCallStatus) *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PickupCallUpsertOne) UpdateStatus() *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCallCount sets the "call_count" field.
+func (u *PickupCallUpsertOne) SetCallCount(v int) *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.SetCallCount(v)
+	})
+}
+
+// AddCallCount adds v to the "call_count" field.
+func (u *PickupCallUpsertOne) AddCallCount(v int) *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.AddCallCount(v)
+	})
+}
+
+// UpdateCallCount sets the "call_count" field to the value that was provided on create.
+func (u *PickupCallUpsertOne) UpdateCallCount() *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.UpdateCallCount()
+	})
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (u *PickupCallUpsertOne) SetReadyAt(v time.Time) *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.SetReadyAt(v)
+	})
+}
+
+// UpdateReadyAt sets the "ready_at" field to the value that was provided on create.
+func (u *PickupCallUpsertOne) UpdateReadyAt() *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.UpdateReadyAt()
+	})
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (u *PickupCallUpsertOne) ClearReadyAt() *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.ClearReadyAt()
+	})
+}
+
+// SetPickedUpAt sets the "picked_up_at" field.
+func (u *PickupCallUpsertOne) SetPickedUpAt(v time.Time) *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.SetPickedUpAt(v)
+	})
+}
+
+// UpdatePickedUpAt sets the "picked_up_at" field to the value that was provided on create.
+func (u *PickupCallUpsertOne) UpdatePickedUpAt() *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.UpdatePickedUpAt()
+	})
+}
+
+// ClearPickedUpAt clears the value of the "picked_up_at" field.
+func (u *PickupCallUpsertOne) ClearPickedUpAt() *PickupCallUpsertOne {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.ClearPickedUpAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PickupCallUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PickupCallCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PickupCallUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PickupCallUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PickupCallUpsertOne.ID is not supported by MySQL driver. Use PickupCallUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PickupCallUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PickupCallCreateBulk is the builder for creating many PickupCall entities in bulk.
+type PickupCallCreateBulk struct {
+	config
+	err      error
+	builders []*PickupCallCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PickupCall entities in the database.
+func (pccb *PickupCallCreateBulk) Save(ctx context.Context) ([]*PickupCall, error) {
+	if pccb.err != nil {
+		return nil, pccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pccb.builders))
+	nodes := make([]*PickupCall, len(pccb.builders))
+	mutators := make([]Mutator, len(pccb.builders))
+	for i := range pccb.builders {
+		func(i int, root context.Context) {
+			builder := pccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PickupCallMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pccb *PickupCallCreateBulk) SaveX(ctx context.Context) []*PickupCall {
+	v, err := pccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pccb *PickupCallCreateBulk) Exec(ctx context.Context) error {
+	_, err := pccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pccb *PickupCallCreateBulk) ExecX(ctx context.Context) {
+	if err := pccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PickupCall.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PickupCallUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pccb *PickupCallCreateBulk) OnConflict(opts ...sql.ConflictOption) *PickupCallUpsertBulk {
+	pccb.conflict = opts
+	return &PickupCallUpsertBulk{
+		create: pccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PickupCall.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pccb *PickupCallCreateBulk) OnConflictColumns(columns ...string) *PickupCallUpsertBulk {
+	pccb.conflict = append(pccb.conflict, sql.ConflictColumns(columns...))
+	return &PickupCallUpsertBulk{
+		create: pccb,
+	}
+}
+
+// PickupCallUpsertBulk is the builder for "upsert"-ing
+// a bulk of PickupCall nodes.
+type PickupCallUpsertBulk struct {
+	create *PickupCallCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PickupCall.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pickupcall.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PickupCallUpsertBulk) UpdateNewValues() *PickupCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pickupcall.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pickupcall.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(pickupcall.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(pickupcall.FieldStoreID)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(pickupcall.FieldOrderID)
+			}
+			if _, exists := b.mutation.OrderNo(); exists {
+				s.SetIgnore(pickupcall.FieldOrderNo)
+			}
+			if _, exists := b.mutation.PickupNo(); exists {
+				s.SetIgnore(pickupcall.FieldPickupNo)
+			}
+			if _, exists := b.mutation.BusinessDate(); exists {
+				s.SetIgnore(pickupcall.FieldBusinessDate)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PickupCall.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PickupCallUpsertBulk) Ignore() *PickupCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PickupCallUpsertBulk) DoNothing() *PickupCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PickupCallCreateBulk.OnConflict
+// documentation for more info.
+func (u *PickupCallUpsertBulk) Update(set func(*PickupCallUpsert)) *PickupCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PickupCallUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PickupCallUpsertBulk) SetUpdatedAt(v time.Time) *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PickupCallUpsertBulk) UpdateUpdatedAt() *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PickupCallUpsertBulk) SetStatus(v domain.PickupCallStatus) *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PickupCallUpsertBulk) UpdateStatus() *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCallCount sets the "call_count" field.
+func (u *PickupCallUpsertBulk) SetCallCount(v int) *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.SetCallCount(v)
+	})
+}
+
+// AddCallCount adds v to the "call_count" field.
+func (u *PickupCallUpsertBulk) AddCallCount(v int) *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.AddCallCount(v)
+	})
+}
+
+// UpdateCallCount sets the "call_count" field to the value that was provided on create.
+func (u *PickupCallUpsertBulk) UpdateCallCount() *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.UpdateCallCount()
+	})
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (u *PickupCallUpsertBulk) SetReadyAt(v time.Time) *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.SetReadyAt(v)
+	})
+}
+
+// UpdateReadyAt sets the "ready_at" field to the value that was provided on create.
+func (u *PickupCallUpsertBulk) UpdateReadyAt() *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.UpdateReadyAt()
+	})
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (u *PickupCallUpsertBulk) ClearReadyAt() *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.ClearReadyAt()
+	})
+}
+
+// SetPickedUpAt sets the "picked_up_at" field.
+func (u *PickupCallUpsertBulk) SetPickedUpAt(v time.Time) *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.SetPickedUpAt(v)
+	})
+}
+
+// UpdatePickedUpAt sets the "picked_up_at" field to the value that was provided on create.
+func (u *PickupCallUpsertBulk) UpdatePickedUpAt() *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.UpdatePickedUpAt()
+	})
+}
+
+// ClearPickedUpAt clears the value of the "picked_up_at" field.
+func (u *PickupCallUpsertBulk) ClearPickedUpAt() *PickupCallUpsertBulk {
+	return u.Update(func(s *PickupCallUpsert) {
+		s.ClearPickedUpAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PickupCallUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PickupCallCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PickupCallCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PickupCallUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickupcall"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// PickupCallDelete is the builder for deleting a PickupCall entity.
+type PickupCallDelete struct {
+	config
+	hooks    []Hook
+	mutation *PickupCallMutation
+}
+
+// Where appends a list predicates to the PickupCallDelete builder.
+func (pcd *PickupCallDelete) Where(ps ...predicate.PickupCall) *PickupCallDelete {
+	pcd.mutation.Where(ps...)
+	return pcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pcd *PickupCallDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcd.sqlExec, pcd.mutation, pcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcd *PickupCallDelete) ExecX(ctx context.Context) int {
+	n, err := pcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pcd *PickupCallDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pickupcall.Table, sqlgraph.NewFieldSpec(pickupcall.FieldID, field.TypeUUID))
+	if ps := pcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pcd.mutation.done = true
+	return affected, err
+}
+
+// PickupCallDeleteOne is the builder for deleting a single PickupCall entity.
+type PickupCallDeleteOne struct {
+	pcd *PickupCallDelete
+}
+
+// Where appends a list predicates to the PickupCallDelete builder.
+func (pcdo *PickupCallDeleteOne) Where(ps ...predicate.PickupCall) *PickupCallDeleteOne {
+	pcdo.pcd.mutation.Where(ps...)
+	return pcdo
+}
+
+// Exec executes the deletion query.
+func (pcdo *PickupCallDeleteOne) Exec(ctx context.Context) error {
+	n, err := pcdo.pcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pickupcall.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcdo *PickupCallDeleteOne) ExecX(ctx context.Context) {
+	if err := pcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
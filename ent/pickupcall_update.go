@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickupcall"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// PickupCallUpdate is the builder for updating PickupCall entities.
+type PickupCallUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PickupCallMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PickupCallUpdate builder.
+func (pcu *PickupCallUpdate) Where(ps ...predicate.PickupCall) *PickupCallUpdate {
+	pcu.mutation.Where(ps...)
+	return pcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcu *PickupCallUpdate) SetUpdatedAt(t time.Time) *PickupCallUpdate {
+	pcu.mutation.SetUpdatedAt(t)
+	return pcu
+}
+
+// SetStatus sets the "status" field.
+func (pcu *PickupCallUpdate) SetStatus(dcs domain.PickupCallStatus) *PickupCallUpdate {
+	pcu.mutation.SetStatus(dcs)
+	return pcu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcu *PickupCallUpdate) SetNillableStatus(dcs *domain.PickupCallStatus) *PickupCallUpdate {
+	if dcs != nil {
+		pcu.SetStatus(*dcs)
+	}
+	return pcu
+}
+
+// SetCallCount sets the "call_count" field.
+func (pcu *PickupCallUpdate) SetCallCount(i int) *PickupCallUpdate {
+	pcu.mutation.ResetCallCount()
+	pcu.mutation.SetCallCount(i)
+	return pcu
+}
+
+// SetNillableCallCount sets the "call_count" field if the given value is not nil.
+func (pcu *PickupCallUpdate) SetNillableCallCount(i *int) *PickupCallUpdate {
+	if i != nil {
+		pcu.SetCallCount(*i)
+	}
+	return pcu
+}
+
+// AddCallCount adds i to the "call_count" field.
+func (pcu *PickupCallUpdate) AddCallCount(i int) *PickupCallUpdate {
+	pcu.mutation.AddCallCount(i)
+	return pcu
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (pcu *PickupCallUpdate) SetReadyAt(t time.Time) *PickupCallUpdate {
+	pcu.mutation.SetReadyAt(t)
+	return pcu
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (pcu *PickupCallUpdate) SetNillableReadyAt(t *time.Time) *PickupCallUpdate {
+	if t != nil {
+		pcu.SetReadyAt(*t)
+	}
+	return pcu
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (pcu *PickupCallUpdate) ClearReadyAt() *PickupCallUpdate {
+	pcu.mutation.ClearReadyAt()
+	return pcu
+}
+
+// SetPickedUpAt sets the "picked_up_at" field.
+func (pcu *PickupCallUpdate) SetPickedUpAt(t time.Time) *PickupCallUpdate {
+	pcu.mutation.SetPickedUpAt(t)
+	return pcu
+}
+
+// SetNillablePickedUpAt sets the "picked_up_at" field if the given value is not nil.
+func (pcu *PickupCallUpdate) SetNillablePickedUpAt(t *time.Time) *PickupCallUpdate {
+	if t != nil {
+		pcu.SetPickedUpAt(*t)
+	}
+	return pcu
+}
+
+// ClearPickedUpAt clears the value of the "picked_up_at" field.
+func (pcu *PickupCallUpdate) ClearPickedUpAt() *PickupCallUpdate {
+	pcu.mutation.ClearPickedUpAt()
+	return pcu
+}
+
+// Mutation returns the PickupCallMutation object of the builder.
+func (pcu *PickupCallUpdate) Mutation() *PickupCallMutation {
+	return pcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcu *PickupCallUpdate) Save(ctx context.Context) (int, error) {
+	pcu.defaults()
+	return withHooks(ctx, pcu.sqlSave, pcu.mutation, pcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcu *PickupCallUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcu *PickupCallUpdate) Exec(ctx context.Context) error {
+	_, err := pcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcu *PickupCallUpdate) ExecX(ctx context.Context) {
+	if err := pcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcu *PickupCallUpdate) defaults() {
+	if _, ok := pcu.mutation.UpdatedAt(); !ok {
+		v := pickupcall.UpdateDefaultUpdatedAt()
+		pcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcu *PickupCallUpdate) check() error {
+	if v, ok := pcu.mutation.Status(); ok {
+		if err := pickupcall.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PickupCall.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pcu *PickupCallUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PickupCallUpdate {
+	pcu.modifiers = append(pcu.modifiers, modifiers...)
+	return pcu
+}
+
+func (pcu *PickupCallUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pickupcall.Table, pickupcall.Columns, sqlgraph.NewFieldSpec(pickupcall.FieldID, field.TypeUUID))
+	if ps := pcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(pickupcall.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pcu.mutation.Status(); ok {
+		_spec.SetField(pickupcall.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := pcu.mutation.CallCount(); ok {
+		_spec.SetField(pickupcall.FieldCallCount, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.AddedCallCount(); ok {
+		_spec.AddField(pickupcall.FieldCallCount, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.ReadyAt(); ok {
+		_spec.SetField(pickupcall.FieldReadyAt, field.TypeTime, value)
+	}
+	if pcu.mutation.ReadyAtCleared() {
+		_spec.ClearField(pickupcall.FieldReadyAt, field.TypeTime)
+	}
+	if value, ok := pcu.mutation.PickedUpAt(); ok {
+		_spec.SetField(pickupcall.FieldPickedUpAt, field.TypeTime, value)
+	}
+	if pcu.mutation.PickedUpAtCleared() {
+		_spec.ClearField(pickupcall.FieldPickedUpAt, field.TypeTime)
+	}
+	_spec.AddModifiers(pcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pickupcall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pcu.mutation.done = true
+	return n, nil
+}
+
+// PickupCallUpdateOne is the builder for updating a single PickupCall entity.
+type PickupCallUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PickupCallMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcuo *PickupCallUpdateOne) SetUpdatedAt(t time.Time) *PickupCallUpdateOne {
+	pcuo.mutation.SetUpdatedAt(t)
+	return pcuo
+}
+
+// SetStatus sets the "status" field.
+func (pcuo *PickupCallUpdateOne) SetStatus(dcs domain.PickupCallStatus) *PickupCallUpdateOne {
+	pcuo.mutation.SetStatus(dcs)
+	return pcuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcuo *PickupCallUpdateOne) SetNillableStatus(dcs *domain.PickupCallStatus) *PickupCallUpdateOne {
+	if dcs != nil {
+		pcuo.SetStatus(*dcs)
+	}
+	return pcuo
+}
+
+// SetCallCount sets the "call_count" field.
+func (pcuo *PickupCallUpdateOne) SetCallCount(i int) *PickupCallUpdateOne {
+	pcuo.mutation.ResetCallCount()
+	pcuo.mutation.SetCallCount(i)
+	return pcuo
+}
+
+// SetNillableCallCount sets the "call_count" field if the given value is not nil.
+func (pcuo *PickupCallUpdateOne) SetNillableCallCount(i *int) *PickupCallUpdateOne {
+	if i != nil {
+		pcuo.SetCallCount(*i)
+	}
+	return pcuo
+}
+
+// AddCallCount adds i to the "call_count" field.
+func (pcuo *PickupCallUpdateOne) AddCallCount(i int) *PickupCallUpdateOne {
+	pcuo.mutation.AddCallCount(i)
+	return pcuo
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (pcuo *PickupCallUpdateOne) SetReadyAt(t time.Time) *PickupCallUpdateOne {
+	pcuo.mutation.SetReadyAt(t)
+	return pcuo
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (pcuo *PickupCallUpdateOne) SetNillableReadyAt(t *time.Time) *PickupCallUpdateOne {
+	if t != nil {
+		pcuo.SetReadyAt(*t)
+	}
+	return pcuo
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (pcuo *PickupCallUpdateOne) ClearReadyAt() *PickupCallUpdateOne {
+	pcuo.mutation.ClearReadyAt()
+	return pcuo
+}
+
+// SetPickedUpAt sets the "picked_up_at" field.
+func (pcuo *PickupCallUpdateOne) SetPickedUpAt(t time.Time) *PickupCallUpdateOne {
+	pcuo.mutation.SetPickedUpAt(t)
+	return pcuo
+}
+
+// SetNillablePickedUpAt sets the "picked_up_at" field if the given value is not nil.
+func (pcuo *PickupCallUpdateOne) SetNillablePickedUpAt(t *time.Time) *PickupCallUpdateOne {
+	if t != nil {
+		pcuo.SetPickedUpAt(*t)
+	}
+	return pcuo
+}
+
+// ClearPickedUpAt clears the value of the "picked_up_at" field.
+func (pcuo *PickupCallUpdateOne) ClearPickedUpAt() *PickupCallUpdateOne {
+	pcuo.mutation.ClearPickedUpAt()
+	return pcuo
+}
+
+// Mutation returns the PickupCallMutation object of the builder.
+func (pcuo *PickupCallUpdateOne) Mutation() *PickupCallMutation {
+	return pcuo.mutation
+}
+
+// Where appends a list predicates to the PickupCallUpdate builder.
+func (pcuo *PickupCallUpdateOne) Where(ps ...predicate.PickupCall) *PickupCallUpdateOne {
+	pcuo.mutation.Where(ps...)
+	return pcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcuo *PickupCallUpdateOne) Select(field string, fields ...string) *PickupCallUpdateOne {
+	pcuo.fields = append([]string{field}, fields...)
+	return pcuo
+}
+
+// Save executes the query and returns the updated PickupCall entity.
+func (pcuo *PickupCallUpdateOne) Save(ctx context.Context) (*PickupCall, error) {
+	pcuo.defaults()
+	return withHooks(ctx, pcuo.sqlSave, pcuo.mutation, pcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcuo *PickupCallUpdateOne) SaveX(ctx context.Context) *PickupCall {
+	node, err := pcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcuo *PickupCallUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcuo *PickupCallUpdateOne) ExecX(ctx context.Context) {
+	if err := pcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcuo *PickupCallUpdateOne) defaults() {
+	if _, ok := pcuo.mutation.UpdatedAt(); !ok {
+		v := pickupcall.UpdateDefaultUpdatedAt()
+		pcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcuo *PickupCallUpdateOne) check() error {
+	if v, ok := pcuo.mutation.Status(); ok {
+		if err := pickupcall.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PickupCall.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pcuo *PickupCallUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PickupCallUpdateOne {
+	pcuo.modifiers = append(pcuo.modifiers, modifiers...)
+	return pcuo
+}
+
+func (pcuo *PickupCallUpdateOne) sqlSave(ctx context.Context) (_node *PickupCall, err error) {
+	if err := pcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pickupcall.Table, pickupcall.Columns, sqlgraph.NewFieldSpec(pickupcall.FieldID, field.TypeUUID))
+	id, ok := pcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PickupCall.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pickupcall.FieldID)
+		for _, f := range fields {
+			if !pickupcall.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pickupcall.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(pickupcall.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pcuo.mutation.Status(); ok {
+		_spec.SetField(pickupcall.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := pcuo.mutation.CallCount(); ok {
+		_spec.SetField(pickupcall.FieldCallCount, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.AddedCallCount(); ok {
+		_spec.AddField(pickupcall.FieldCallCount, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.ReadyAt(); ok {
+		_spec.SetField(pickupcall.FieldReadyAt, field.TypeTime, value)
+	}
+	if pcuo.mutation.ReadyAtCleared() {
+		_spec.ClearField(pickupcall.FieldReadyAt, field.TypeTime)
+	}
+	if value, ok := pcuo.mutation.PickedUpAt(); ok {
+		_spec.SetField(pickupcall.FieldPickedUpAt, field.TypeTime, value)
+	}
+	if pcuo.mutation.PickedUpAtCleared() {
+		_spec.ClearField(pickupcall.FieldPickedUpAt, field.TypeTime)
+	}
+	_spec.AddModifiers(pcuo.modifiers...)
+	_node = &PickupCall{config: pcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pickupcall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickuprule"
+)
+
+// PickupRule is the model entity for the PickupRule schema.
+type PickupRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID，为空表示品牌商默认规则
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 号码前缀
+	Prefix string `json:"prefix,omitempty"`
+	// 起始号
+	Start int `json:"start,omitempty"`
+	// 结束号
+	End          int `json:"end,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PickupRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pickuprule.FieldStart, pickuprule.FieldEnd:
+			values[i] = new(sql.NullInt64)
+		case pickuprule.FieldPrefix:
+			values[i] = new(sql.NullString)
+		case pickuprule.FieldCreatedAt, pickuprule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case pickuprule.FieldID, pickuprule.FieldMerchantID, pickuprule.FieldStoreID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PickupRule fields.
+func (pr *PickupRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pickuprule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pr.ID = *value
+			}
+		case pickuprule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case pickuprule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		case pickuprule.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				pr.MerchantID = *value
+			}
+		case pickuprule.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				pr.StoreID = *value
+			}
+		case pickuprule.FieldPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prefix", values[i])
+			} else if value.Valid {
+				pr.Prefix = value.String
+			}
+		case pickuprule.FieldStart:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start", values[i])
+			} else if value.Valid {
+				pr.Start = int(value.Int64)
+			}
+		case pickuprule.FieldEnd:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end", values[i])
+			} else if value.Valid {
+				pr.End = int(value.Int64)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PickupRule.
+// This includes values selected through modifiers, order, etc.
+func (pr *PickupRule) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PickupRule.
+// Note that you need to call PickupRule.Unwrap() before calling this method if this PickupRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PickupRule) Update() *PickupRuleUpdateOne {
+	return NewPickupRuleClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PickupRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PickupRule) Unwrap() *PickupRule {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PickupRule is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PickupRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("PickupRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("prefix=")
+	builder.WriteString(pr.Prefix)
+	builder.WriteString(", ")
+	builder.WriteString("start=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Start))
+	builder.WriteString(", ")
+	builder.WriteString("end=")
+	builder.WriteString(fmt.Sprintf("%v", pr.End))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PickupRules is a parsable slice of PickupRule.
+type PickupRules []*PickupRule
@@ -0,0 +1,411 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pickuprule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldStoreID, v))
+}
+
+// Prefix applies equality check predicate on the "prefix" field. It's identical to PrefixEQ.
+func Prefix(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldPrefix, v))
+}
+
+// Start applies equality check predicate on the "start" field. It's identical to StartEQ.
+func Start(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldStart, v))
+}
+
+// End applies equality check predicate on the "end" field. It's identical to EndEQ.
+func End(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldEnd, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLTE(FieldStoreID, v))
+}
+
+// PrefixEQ applies the EQ predicate on the "prefix" field.
+func PrefixEQ(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldPrefix, v))
+}
+
+// PrefixNEQ applies the NEQ predicate on the "prefix" field.
+func PrefixNEQ(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNEQ(FieldPrefix, v))
+}
+
+// PrefixIn applies the In predicate on the "prefix" field.
+func PrefixIn(vs ...string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldIn(FieldPrefix, vs...))
+}
+
+// PrefixNotIn applies the NotIn predicate on the "prefix" field.
+func PrefixNotIn(vs ...string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNotIn(FieldPrefix, vs...))
+}
+
+// PrefixGT applies the GT predicate on the "prefix" field.
+func PrefixGT(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGT(FieldPrefix, v))
+}
+
+// PrefixGTE applies the GTE predicate on the "prefix" field.
+func PrefixGTE(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGTE(FieldPrefix, v))
+}
+
+// PrefixLT applies the LT predicate on the "prefix" field.
+func PrefixLT(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLT(FieldPrefix, v))
+}
+
+// PrefixLTE applies the LTE predicate on the "prefix" field.
+func PrefixLTE(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLTE(FieldPrefix, v))
+}
+
+// PrefixContains applies the Contains predicate on the "prefix" field.
+func PrefixContains(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldContains(FieldPrefix, v))
+}
+
+// PrefixHasPrefix applies the HasPrefix predicate on the "prefix" field.
+func PrefixHasPrefix(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldHasPrefix(FieldPrefix, v))
+}
+
+// PrefixHasSuffix applies the HasSuffix predicate on the "prefix" field.
+func PrefixHasSuffix(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldHasSuffix(FieldPrefix, v))
+}
+
+// PrefixEqualFold applies the EqualFold predicate on the "prefix" field.
+func PrefixEqualFold(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEqualFold(FieldPrefix, v))
+}
+
+// PrefixContainsFold applies the ContainsFold predicate on the "prefix" field.
+func PrefixContainsFold(v string) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldContainsFold(FieldPrefix, v))
+}
+
+// StartEQ applies the EQ predicate on the "start" field.
+func StartEQ(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldStart, v))
+}
+
+// StartNEQ applies the NEQ predicate on the "start" field.
+func StartNEQ(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNEQ(FieldStart, v))
+}
+
+// StartIn applies the In predicate on the "start" field.
+func StartIn(vs ...int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldIn(FieldStart, vs...))
+}
+
+// StartNotIn applies the NotIn predicate on the "start" field.
+func StartNotIn(vs ...int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNotIn(FieldStart, vs...))
+}
+
+// StartGT applies the GT predicate on the "start" field.
+func StartGT(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGT(FieldStart, v))
+}
+
+// StartGTE applies the GTE predicate on the "start" field.
+func StartGTE(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGTE(FieldStart, v))
+}
+
+// StartLT applies the LT predicate on the "start" field.
+func StartLT(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLT(FieldStart, v))
+}
+
+// StartLTE applies the LTE predicate on the "start" field.
+func StartLTE(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLTE(FieldStart, v))
+}
+
+// EndEQ applies the EQ predicate on the "end" field.
+func EndEQ(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldEQ(FieldEnd, v))
+}
+
+// EndNEQ applies the NEQ predicate on the "end" field.
+func EndNEQ(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNEQ(FieldEnd, v))
+}
+
+// EndIn applies the In predicate on the "end" field.
+func EndIn(vs ...int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldIn(FieldEnd, vs...))
+}
+
+// EndNotIn applies the NotIn predicate on the "end" field.
+func EndNotIn(vs ...int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldNotIn(FieldEnd, vs...))
+}
+
+// EndGT applies the GT predicate on the "end" field.
+func EndGT(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGT(FieldEnd, v))
+}
+
+// EndGTE applies the GTE predicate on the "end" field.
+func EndGTE(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldGTE(FieldEnd, v))
+}
+
+// EndLT applies the LT predicate on the "end" field.
+func EndLT(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLT(FieldEnd, v))
+}
+
+// EndLTE applies the LTE predicate on the "end" field.
+func EndLTE(v int) predicate.PickupRule {
+	return predicate.PickupRule(sql.FieldLTE(FieldEnd, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PickupRule) predicate.PickupRule {
+	return predicate.PickupRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PickupRule) predicate.PickupRule {
+	return predicate.PickupRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PickupRule) predicate.PickupRule {
+	return predicate.PickupRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,806 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickuprule"
+)
+
+// PickupRuleCreate is the builder for creating a PickupRule entity.
+type PickupRuleCreate struct {
+	config
+	mutation *PickupRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *PickupRuleCreate) SetCreatedAt(t time.Time) *PickupRuleCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prc *PickupRuleCreate) SetNillableCreatedAt(t *time.Time) *PickupRuleCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (prc *PickupRuleCreate) SetUpdatedAt(t time.Time) *PickupRuleCreate {
+	prc.mutation.SetUpdatedAt(t)
+	return prc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (prc *PickupRuleCreate) SetNillableUpdatedAt(t *time.Time) *PickupRuleCreate {
+	if t != nil {
+		prc.SetUpdatedAt(*t)
+	}
+	return prc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (prc *PickupRuleCreate) SetMerchantID(u uuid.UUID) *PickupRuleCreate {
+	prc.mutation.SetMerchantID(u)
+	return prc
+}
+
+// SetStoreID sets the "store_id" field.
+func (prc *PickupRuleCreate) SetStoreID(u uuid.UUID) *PickupRuleCreate {
+	prc.mutation.SetStoreID(u)
+	return prc
+}
+
+// SetPrefix sets the "prefix" field.
+func (prc *PickupRuleCreate) SetPrefix(s string) *PickupRuleCreate {
+	prc.mutation.SetPrefix(s)
+	return prc
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (prc *PickupRuleCreate) SetNillablePrefix(s *string) *PickupRuleCreate {
+	if s != nil {
+		prc.SetPrefix(*s)
+	}
+	return prc
+}
+
+// SetStart sets the "start" field.
+func (prc *PickupRuleCreate) SetStart(i int) *PickupRuleCreate {
+	prc.mutation.SetStart(i)
+	return prc
+}
+
+// SetEnd sets the "end" field.
+func (prc *PickupRuleCreate) SetEnd(i int) *PickupRuleCreate {
+	prc.mutation.SetEnd(i)
+	return prc
+}
+
+// SetID sets the "id" field.
+func (prc *PickupRuleCreate) SetID(u uuid.UUID) *PickupRuleCreate {
+	prc.mutation.SetID(u)
+	return prc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (prc *PickupRuleCreate) SetNillableID(u *uuid.UUID) *PickupRuleCreate {
+	if u != nil {
+		prc.SetID(*u)
+	}
+	return prc
+}
+
+// Mutation returns the PickupRuleMutation object of the builder.
+func (prc *PickupRuleCreate) Mutation() *PickupRuleMutation {
+	return prc.mutation
+}
+
+// Save creates the PickupRule in the database.
+func (prc *PickupRuleCreate) Save(ctx context.Context) (*PickupRule, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PickupRuleCreate) SaveX(ctx context.Context) *PickupRule {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PickupRuleCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PickupRuleCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *PickupRuleCreate) defaults() {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := pickuprule.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		v := pickuprule.DefaultUpdatedAt()
+		prc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := prc.mutation.Prefix(); !ok {
+		v := pickuprule.DefaultPrefix
+		prc.mutation.SetPrefix(v)
+	}
+	if _, ok := prc.mutation.ID(); !ok {
+		v := pickuprule.DefaultID()
+		prc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PickupRuleCreate) check() error {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PickupRule.created_at"`)}
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PickupRule.updated_at"`)}
+	}
+	if _, ok := prc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "PickupRule.merchant_id"`)}
+	}
+	if _, ok := prc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "PickupRule.store_id"`)}
+	}
+	if _, ok := prc.mutation.Prefix(); !ok {
+		return &ValidationError{Name: "prefix", err: errors.New(`ent: missing required field "PickupRule.prefix"`)}
+	}
+	if v, ok := prc.mutation.Prefix(); ok {
+		if err := pickuprule.PrefixValidator(v); err != nil {
+			return &ValidationError{Name: "prefix", err: fmt.Errorf(`ent: validator failed for field "PickupRule.prefix": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.Start(); !ok {
+		return &ValidationError{Name: "start", err: errors.New(`ent: missing required field "PickupRule.start"`)}
+	}
+	if _, ok := prc.mutation.End(); !ok {
+		return &ValidationError{Name: "end", err: errors.New(`ent: missing required field "PickupRule.end"`)}
+	}
+	return nil
+}
+
+func (prc *PickupRuleCreate) sqlSave(ctx context.Context) (*PickupRule, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *PickupRuleCreate) createSpec() (*PickupRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PickupRule{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(pickuprule.Table, sqlgraph.NewFieldSpec(pickuprule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = prc.conflict
+	if id, ok := prc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(pickuprule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := prc.mutation.UpdatedAt(); ok {
+		_spec.SetField(pickuprule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := prc.mutation.MerchantID(); ok {
+		_spec.SetField(pickuprule.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := prc.mutation.StoreID(); ok {
+		_spec.SetField(pickuprule.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := prc.mutation.Prefix(); ok {
+		_spec.SetField(pickuprule.FieldPrefix, field.TypeString, value)
+		_node.Prefix = value
+	}
+	if value, ok := prc.mutation.Start(); ok {
+		_spec.SetField(pickuprule.FieldStart, field.TypeInt, value)
+		_node.Start = value
+	}
+	if value, ok := prc.mutation.End(); ok {
+		_spec.SetField(pickuprule.FieldEnd, field.TypeInt, value)
+		_node.End = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PickupRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PickupRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (prc *PickupRuleCreate) OnConflict(opts ...sql.ConflictOption) *PickupRuleUpsertOne {
+	prc.conflict = opts
+	return &PickupRuleUpsertOne{
+		create: prc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PickupRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prc *PickupRuleCreate) OnConflictColumns(columns ...string) *PickupRuleUpsertOne {
+	prc.conflict = append(prc.conflict, sql.ConflictColumns(columns...))
+	return &PickupRuleUpsertOne{
+		create: prc,
+	}
+}
+
+type (
+	// PickupRuleUpsertOne is the builder for "upsert"-ing
+	//  one PickupRule node.
+	PickupRuleUpsertOne struct {
+		create *PickupRuleCreate
+	}
+
+	// PickupRuleUpsert is the "OnConflict" setter.
+	PickupRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PickupRuleUpsert) SetUpdatedAt(v time.Time) *PickupRuleUpsert {
+	u.Set(pickuprule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PickupRuleUpsert) UpdateUpdatedAt() *PickupRuleUpsert {
+	u.SetExcluded(pickuprule.FieldUpdatedAt)
+	return u
+}
+
+// SetPrefix sets the "prefix" field.
+func (u *PickupRuleUpsert) SetPrefix(v string) *PickupRuleUpsert {
+	u.Set(pickuprule.FieldPrefix, v)
+	return u
+}
+
+// UpdatePrefix sets the "prefix" field to the value that was provided on create.
+func (u *PickupRuleUpsert) UpdatePrefix() *PickupRuleUpsert {
+	u.SetExcluded(pickuprule.FieldPrefix)
+	return u
+}
+
+// SetStart sets the "start" field.
+func (u *PickupRuleUpsert) SetStart(v int) *PickupRuleUpsert {
+	u.Set(pickuprule.FieldStart, v)
+	return u
+}
+
+// UpdateStart sets the "start" field to the value that was provided on create.
+func (u *PickupRuleUpsert) UpdateStart() *PickupRuleUpsert {
+	u.SetExcluded(pickuprule.FieldStart)
+	return u
+}
+
+// AddStart adds v to the "start" field.
+func (u *PickupRuleUpsert) AddStart(v int) *PickupRuleUpsert {
+	u.Add(pickuprule.FieldStart, v)
+	return u
+}
+
+// SetEnd sets the "end" field.
+func (u *PickupRuleUpsert) SetEnd(v int) *PickupRuleUpsert {
+	u.Set(pickuprule.FieldEnd, v)
+	return u
+}
+
+// UpdateEnd sets the "end" field to the value that was provided on create.
+func (u *PickupRuleUpsert) UpdateEnd() *PickupRuleUpsert {
+	u.SetExcluded(pickuprule.FieldEnd)
+	return u
+}
+
+// AddEnd adds v to the "end" field.
+func (u *PickupRuleUpsert) AddEnd(v int) *PickupRuleUpsert {
+	u.Add(pickuprule.FieldEnd, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PickupRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pickuprule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PickupRuleUpsertOne) UpdateNewValues() *PickupRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pickuprule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pickuprule.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(pickuprule.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(pickuprule.FieldStoreID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PickupRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PickupRuleUpsertOne) Ignore() *PickupRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PickupRuleUpsertOne) DoNothing() *PickupRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PickupRuleCreate.OnConflict
+// documentation for more info.
+func (u *PickupRuleUpsertOne) Update(set func(*PickupRuleUpsert)) *PickupRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PickupRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PickupRuleUpsertOne) SetUpdatedAt(v time.Time) *PickupRuleUpsertOne {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PickupRuleUpsertOne) UpdateUpdatedAt() *PickupRuleUpsertOne {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPrefix sets the "prefix" field.
+func (u *PickupRuleUpsertOne) SetPrefix(v string) *PickupRuleUpsertOne {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.SetPrefix(v)
+	})
+}
+
+// UpdatePrefix sets the "prefix" field to the value that was provided on create.
+func (u *PickupRuleUpsertOne) UpdatePrefix() *PickupRuleUpsertOne {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.UpdatePrefix()
+	})
+}
+
+// SetStart sets the "start" field.
+func (u *PickupRuleUpsertOne) SetStart(v int) *PickupRuleUpsertOne {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.SetStart(v)
+	})
+}
+
+// AddStart adds v to the "start" field.
+func (u *PickupRuleUpsertOne) AddStart(v int) *PickupRuleUpsertOne {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.AddStart(v)
+	})
+}
+
+// UpdateStart sets the "start" field to the value that was provided on create.
+func (u *PickupRuleUpsertOne) UpdateStart() *PickupRuleUpsertOne {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.UpdateStart()
+	})
+}
+
+// SetEnd sets the "end" field.
+func (u *PickupRuleUpsertOne) SetEnd(v int) *PickupRuleUpsertOne {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.SetEnd(v)
+	})
+}
+
+// AddEnd adds v to the "end" field.
+func (u *PickupRuleUpsertOne) AddEnd(v int) *PickupRuleUpsertOne {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.AddEnd(v)
+	})
+}
+
+// UpdateEnd sets the "end" field to the value that was provided on create.
+func (u *PickupRuleUpsertOne) UpdateEnd() *PickupRuleUpsertOne {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.UpdateEnd()
+	})
+}
+
+// Exec executes the query.
+func (u *PickupRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PickupRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PickupRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PickupRuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PickupRuleUpsertOne.ID is not supported by MySQL driver. Use PickupRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PickupRuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PickupRuleCreateBulk is the builder for creating many PickupRule entities in bulk.
+type PickupRuleCreateBulk struct {
+	config
+	err      error
+	builders []*PickupRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PickupRule entities in the database.
+func (prcb *PickupRuleCreateBulk) Save(ctx context.Context) ([]*PickupRule, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PickupRule, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PickupRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = prcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PickupRuleCreateBulk) SaveX(ctx context.Context) []*PickupRule {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PickupRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PickupRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PickupRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PickupRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (prcb *PickupRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *PickupRuleUpsertBulk {
+	prcb.conflict = opts
+	return &PickupRuleUpsertBulk{
+		create: prcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PickupRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prcb *PickupRuleCreateBulk) OnConflictColumns(columns ...string) *PickupRuleUpsertBulk {
+	prcb.conflict = append(prcb.conflict, sql.ConflictColumns(columns...))
+	return &PickupRuleUpsertBulk{
+		create: prcb,
+	}
+}
+
+// PickupRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of PickupRule nodes.
+type PickupRuleUpsertBulk struct {
+	create *PickupRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PickupRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pickuprule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PickupRuleUpsertBulk) UpdateNewValues() *PickupRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pickuprule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pickuprule.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(pickuprule.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(pickuprule.FieldStoreID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PickupRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PickupRuleUpsertBulk) Ignore() *PickupRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PickupRuleUpsertBulk) DoNothing() *PickupRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PickupRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *PickupRuleUpsertBulk) Update(set func(*PickupRuleUpsert)) *PickupRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PickupRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PickupRuleUpsertBulk) SetUpdatedAt(v time.Time) *PickupRuleUpsertBulk {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PickupRuleUpsertBulk) UpdateUpdatedAt() *PickupRuleUpsertBulk {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPrefix sets the "prefix" field.
+func (u *PickupRuleUpsertBulk) SetPrefix(v string) *PickupRuleUpsertBulk {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.SetPrefix(v)
+	})
+}
+
+// UpdatePrefix sets the "prefix" field to the value that was provided on create.
+func (u *PickupRuleUpsertBulk) UpdatePrefix() *PickupRuleUpsertBulk {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.UpdatePrefix()
+	})
+}
+
+// SetStart sets the "start" field.
+func (u *PickupRuleUpsertBulk) SetStart(v int) *PickupRuleUpsertBulk {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.SetStart(v)
+	})
+}
+
+// AddStart adds v to the "start" field.
+func (u *PickupRuleUpsertBulk) AddStart(v int) *PickupRuleUpsertBulk {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.AddStart(v)
+	})
+}
+
+// UpdateStart sets the "start" field to the value that was provided on create.
+func (u *PickupRuleUpsertBulk) UpdateStart() *PickupRuleUpsertBulk {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.UpdateStart()
+	})
+}
+
+// SetEnd sets the "end" field.
+func (u *PickupRuleUpsertBulk) SetEnd(v int) *PickupRuleUpsertBulk {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.SetEnd(v)
+	})
+}
+
+// AddEnd adds v to the "end" field.
+func (u *PickupRuleUpsertBulk) AddEnd(v int) *PickupRuleUpsertBulk {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.AddEnd(v)
+	})
+}
+
+// UpdateEnd sets the "end" field to the value that was provided on create.
+func (u *PickupRuleUpsertBulk) UpdateEnd() *PickupRuleUpsertBulk {
+	return u.Update(func(s *PickupRuleUpsert) {
+		s.UpdateEnd()
+	})
+}
+
+// Exec executes the query.
+func (u *PickupRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PickupRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PickupRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PickupRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
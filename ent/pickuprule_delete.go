@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickuprule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// PickupRuleDelete is the builder for deleting a PickupRule entity.
+type PickupRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *PickupRuleMutation
+}
+
+// Where appends a list predicates to the PickupRuleDelete builder.
+func (prd *PickupRuleDelete) Where(ps ...predicate.PickupRule) *PickupRuleDelete {
+	prd.mutation.Where(ps...)
+	return prd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (prd *PickupRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, prd.sqlExec, prd.mutation, prd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prd *PickupRuleDelete) ExecX(ctx context.Context) int {
+	n, err := prd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (prd *PickupRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pickuprule.Table, sqlgraph.NewFieldSpec(pickuprule.FieldID, field.TypeUUID))
+	if ps := prd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, prd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	prd.mutation.done = true
+	return affected, err
+}
+
+// PickupRuleDeleteOne is the builder for deleting a single PickupRule entity.
+type PickupRuleDeleteOne struct {
+	prd *PickupRuleDelete
+}
+
+// Where appends a list predicates to the PickupRuleDelete builder.
+func (prdo *PickupRuleDeleteOne) Where(ps ...predicate.PickupRule) *PickupRuleDeleteOne {
+	prdo.prd.mutation.Where(ps...)
+	return prdo
+}
+
+// Exec executes the deletion query.
+func (prdo *PickupRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := prdo.prd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pickuprule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prdo *PickupRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := prdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
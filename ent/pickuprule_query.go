@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickuprule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// PickupRuleQuery is the builder for querying PickupRule entities.
+type PickupRuleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []pickuprule.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PickupRule
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PickupRuleQuery builder.
+func (prq *PickupRuleQuery) Where(ps ...predicate.PickupRule) *PickupRuleQuery {
+	prq.predicates = append(prq.predicates, ps...)
+	return prq
+}
+
+// Limit the number of records to be returned by this query.
+func (prq *PickupRuleQuery) Limit(limit int) *PickupRuleQuery {
+	prq.ctx.Limit = &limit
+	return prq
+}
+
+// Offset to start from.
+func (prq *PickupRuleQuery) Offset(offset int) *PickupRuleQuery {
+	prq.ctx.Offset = &offset
+	return prq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (prq *PickupRuleQuery) Unique(unique bool) *PickupRuleQuery {
+	prq.ctx.Unique = &unique
+	return prq
+}
+
+// Order specifies how the records should be ordered.
+func (prq *PickupRuleQuery) Order(o ...pickuprule.OrderOption) *PickupRuleQuery {
+	prq.order = append(prq.order, o...)
+	return prq
+}
+
+// First returns the first PickupRule entity from the query.
+// Returns a *NotFoundError when no PickupRule was found.
+func (prq *PickupRuleQuery) First(ctx context.Context) (*PickupRule, error) {
+	nodes, err := prq.Limit(1).All(setContextOp(ctx, prq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pickuprule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (prq *PickupRuleQuery) FirstX(ctx context.Context) *PickupRule {
+	node, err := prq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PickupRule ID from the query.
+// Returns a *NotFoundError when no PickupRule ID was found.
+func (prq *PickupRuleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = prq.Limit(1).IDs(setContextOp(ctx, prq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pickuprule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (prq *PickupRuleQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := prq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PickupRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PickupRule entity is found.
+// Returns a *NotFoundError when no PickupRule entities are found.
+func (prq *PickupRuleQuery) Only(ctx context.Context) (*PickupRule, error) {
+	nodes, err := prq.Limit(2).All(setContextOp(ctx, prq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pickuprule.Label}
+	default:
+		return nil, &NotSingularError{pickuprule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (prq *PickupRuleQuery) OnlyX(ctx context.Context) *PickupRule {
+	node, err := prq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PickupRule ID in the query.
+// Returns a *NotSingularError when more than one PickupRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (prq *PickupRuleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = prq.Limit(2).IDs(setContextOp(ctx, prq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pickuprule.Label}
+	default:
+		err = &NotSingularError{pickuprule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (prq *PickupRuleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := prq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PickupRules.
+func (prq *PickupRuleQuery) All(ctx context.Context) ([]*PickupRule, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryAll)
+	if err := prq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PickupRule, *PickupRuleQuery]()
+	return withInterceptors[[]*PickupRule](ctx, prq, qr, prq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (prq *PickupRuleQuery) AllX(ctx context.Context) []*PickupRule {
+	nodes, err := prq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PickupRule IDs.
+func (prq *PickupRuleQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if prq.ctx.Unique == nil && prq.path != nil {
+		prq.Unique(true)
+	}
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryIDs)
+	if err = prq.Select(pickuprule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (prq *PickupRuleQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := prq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (prq *PickupRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryCount)
+	if err := prq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, prq, querierCount[*PickupRuleQuery](), prq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (prq *PickupRuleQuery) CountX(ctx context.Context) int {
+	count, err := prq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (prq *PickupRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryExist)
+	switch _, err := prq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (prq *PickupRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := prq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PickupRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (prq *PickupRuleQuery) Clone() *PickupRuleQuery {
+	if prq == nil {
+		return nil
+	}
+	return &PickupRuleQuery{
+		config:     prq.config,
+		ctx:        prq.ctx.Clone(),
+		order:      append([]pickuprule.OrderOption{}, prq.order...),
+		inters:     append([]Interceptor{}, prq.inters...),
+		predicates: append([]predicate.PickupRule{}, prq.predicates...),
+		// clone intermediate query.
+		sql:       prq.sql.Clone(),
+		path:      prq.path,
+		modifiers: append([]func(*sql.Selector){}, prq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PickupRule.Query().
+//		GroupBy(pickuprule.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (prq *PickupRuleQuery) GroupBy(field string, fields ...string) *PickupRuleGroupBy {
+	prq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PickupRuleGroupBy{build: prq}
+	grbuild.flds = &prq.ctx.Fields
+	grbuild.label = pickuprule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PickupRule.Query().
+//		Select(pickuprule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (prq *PickupRuleQuery) Select(fields ...string) *PickupRuleSelect {
+	prq.ctx.Fields = append(prq.ctx.Fields, fields...)
+	sbuild := &PickupRuleSelect{PickupRuleQuery: prq}
+	sbuild.label = pickuprule.Label
+	sbuild.flds, sbuild.scan = &prq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PickupRuleSelect configured with the given aggregations.
+func (prq *PickupRuleQuery) Aggregate(fns ...AggregateFunc) *PickupRuleSelect {
+	return prq.Select().Aggregate(fns...)
+}
+
+func (prq *PickupRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range prq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, prq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range prq.ctx.Fields {
+		if !pickuprule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if prq.path != nil {
+		prev, err := prq.path(ctx)
+		if err != nil {
+			return err
+		}
+		prq.sql = prev
+	}
+	return nil
+}
+
+func (prq *PickupRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PickupRule, error) {
+	var (
+		nodes = []*PickupRule{}
+		_spec = prq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PickupRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PickupRule{config: prq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(prq.modifiers) > 0 {
+		_spec.Modifiers = prq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, prq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (prq *PickupRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := prq.querySpec()
+	if len(prq.modifiers) > 0 {
+		_spec.Modifiers = prq.modifiers
+	}
+	_spec.Node.Columns = prq.ctx.Fields
+	if len(prq.ctx.Fields) > 0 {
+		_spec.Unique = prq.ctx.Unique != nil && *prq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, prq.driver, _spec)
+}
+
+func (prq *PickupRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pickuprule.Table, pickuprule.Columns, sqlgraph.NewFieldSpec(pickuprule.FieldID, field.TypeUUID))
+	_spec.From = prq.sql
+	if unique := prq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if prq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := prq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pickuprule.FieldID)
+		for i := range fields {
+			if fields[i] != pickuprule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := prq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := prq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := prq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := prq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (prq *PickupRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(prq.driver.Dialect())
+	t1 := builder.Table(pickuprule.Table)
+	columns := prq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pickuprule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if prq.sql != nil {
+		selector = prq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if prq.ctx.Unique != nil && *prq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range prq.modifiers {
+		m(selector)
+	}
+	for _, p := range prq.predicates {
+		p(selector)
+	}
+	for _, p := range prq.order {
+		p(selector)
+	}
+	if offset := prq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := prq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (prq *PickupRuleQuery) ForUpdate(opts ...sql.LockOption) *PickupRuleQuery {
+	if prq.driver.Dialect() == dialect.Postgres {
+		prq.Unique(false)
+	}
+	prq.modifiers = append(prq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return prq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (prq *PickupRuleQuery) ForShare(opts ...sql.LockOption) *PickupRuleQuery {
+	if prq.driver.Dialect() == dialect.Postgres {
+		prq.Unique(false)
+	}
+	prq.modifiers = append(prq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return prq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (prq *PickupRuleQuery) Modify(modifiers ...func(s *sql.Selector)) *PickupRuleSelect {
+	prq.modifiers = append(prq.modifiers, modifiers...)
+	return prq.Select()
+}
+
+// PickupRuleGroupBy is the group-by builder for PickupRule entities.
+type PickupRuleGroupBy struct {
+	selector
+	build *PickupRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (prgb *PickupRuleGroupBy) Aggregate(fns ...AggregateFunc) *PickupRuleGroupBy {
+	prgb.fns = append(prgb.fns, fns...)
+	return prgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prgb *PickupRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, prgb.build.ctx, ent.OpQueryGroupBy)
+	if err := prgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PickupRuleQuery, *PickupRuleGroupBy](ctx, prgb.build, prgb, prgb.build.inters, v)
+}
+
+func (prgb *PickupRuleGroupBy) sqlScan(ctx context.Context, root *PickupRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(prgb.fns))
+	for _, fn := range prgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*prgb.flds)+len(prgb.fns))
+		for _, f := range *prgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*prgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PickupRuleSelect is the builder for selecting fields of PickupRule entities.
+type PickupRuleSelect struct {
+	*PickupRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (prs *PickupRuleSelect) Aggregate(fns ...AggregateFunc) *PickupRuleSelect {
+	prs.fns = append(prs.fns, fns...)
+	return prs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prs *PickupRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, prs.ctx, ent.OpQuerySelect)
+	if err := prs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PickupRuleQuery, *PickupRuleSelect](ctx, prs.PickupRuleQuery, prs, prs.inters, v)
+}
+
+func (prs *PickupRuleSelect) sqlScan(ctx context.Context, root *PickupRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(prs.fns))
+	for _, fn := range prs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*prs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (prs *PickupRuleSelect) Modify(modifiers ...func(s *sql.Selector)) *PickupRuleSelect {
+	prs.modifiers = append(prs.modifiers, modifiers...)
+	return prs
+}
@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickuprule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// PickupRuleUpdate is the builder for updating PickupRule entities.
+type PickupRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PickupRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PickupRuleUpdate builder.
+func (pru *PickupRuleUpdate) Where(ps ...predicate.PickupRule) *PickupRuleUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pru *PickupRuleUpdate) SetUpdatedAt(t time.Time) *PickupRuleUpdate {
+	pru.mutation.SetUpdatedAt(t)
+	return pru
+}
+
+// SetPrefix sets the "prefix" field.
+func (pru *PickupRuleUpdate) SetPrefix(s string) *PickupRuleUpdate {
+	pru.mutation.SetPrefix(s)
+	return pru
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (pru *PickupRuleUpdate) SetNillablePrefix(s *string) *PickupRuleUpdate {
+	if s != nil {
+		pru.SetPrefix(*s)
+	}
+	return pru
+}
+
+// SetStart sets the "start" field.
+func (pru *PickupRuleUpdate) SetStart(i int) *PickupRuleUpdate {
+	pru.mutation.ResetStart()
+	pru.mutation.SetStart(i)
+	return pru
+}
+
+// SetNillableStart sets the "start" field if the given value is not nil.
+func (pru *PickupRuleUpdate) SetNillableStart(i *int) *PickupRuleUpdate {
+	if i != nil {
+		pru.SetStart(*i)
+	}
+	return pru
+}
+
+// AddStart adds i to the "start" field.
+func (pru *PickupRuleUpdate) AddStart(i int) *PickupRuleUpdate {
+	pru.mutation.AddStart(i)
+	return pru
+}
+
+// SetEnd sets the "end" field.
+func (pru *PickupRuleUpdate) SetEnd(i int) *PickupRuleUpdate {
+	pru.mutation.ResetEnd()
+	pru.mutation.SetEnd(i)
+	return pru
+}
+
+// SetNillableEnd sets the "end" field if the given value is not nil.
+func (pru *PickupRuleUpdate) SetNillableEnd(i *int) *PickupRuleUpdate {
+	if i != nil {
+		pru.SetEnd(*i)
+	}
+	return pru
+}
+
+// AddEnd adds i to the "end" field.
+func (pru *PickupRuleUpdate) AddEnd(i int) *PickupRuleUpdate {
+	pru.mutation.AddEnd(i)
+	return pru
+}
+
+// Mutation returns the PickupRuleMutation object of the builder.
+func (pru *PickupRuleUpdate) Mutation() *PickupRuleMutation {
+	return pru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PickupRuleUpdate) Save(ctx context.Context) (int, error) {
+	pru.defaults()
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PickupRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PickupRuleUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PickupRuleUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pru *PickupRuleUpdate) defaults() {
+	if _, ok := pru.mutation.UpdatedAt(); !ok {
+		v := pickuprule.UpdateDefaultUpdatedAt()
+		pru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PickupRuleUpdate) check() error {
+	if v, ok := pru.mutation.Prefix(); ok {
+		if err := pickuprule.PrefixValidator(v); err != nil {
+			return &ValidationError{Name: "prefix", err: fmt.Errorf(`ent: validator failed for field "PickupRule.prefix": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pru *PickupRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PickupRuleUpdate {
+	pru.modifiers = append(pru.modifiers, modifiers...)
+	return pru
+}
+
+func (pru *PickupRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pickuprule.Table, pickuprule.Columns, sqlgraph.NewFieldSpec(pickuprule.FieldID, field.TypeUUID))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.UpdatedAt(); ok {
+		_spec.SetField(pickuprule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pru.mutation.Prefix(); ok {
+		_spec.SetField(pickuprule.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.Start(); ok {
+		_spec.SetField(pickuprule.FieldStart, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedStart(); ok {
+		_spec.AddField(pickuprule.FieldStart, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.End(); ok {
+		_spec.SetField(pickuprule.FieldEnd, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedEnd(); ok {
+		_spec.AddField(pickuprule.FieldEnd, field.TypeInt, value)
+	}
+	_spec.AddModifiers(pru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pickuprule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PickupRuleUpdateOne is the builder for updating a single PickupRule entity.
+type PickupRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PickupRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pruo *PickupRuleUpdateOne) SetUpdatedAt(t time.Time) *PickupRuleUpdateOne {
+	pruo.mutation.SetUpdatedAt(t)
+	return pruo
+}
+
+// SetPrefix sets the "prefix" field.
+func (pruo *PickupRuleUpdateOne) SetPrefix(s string) *PickupRuleUpdateOne {
+	pruo.mutation.SetPrefix(s)
+	return pruo
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (pruo *PickupRuleUpdateOne) SetNillablePrefix(s *string) *PickupRuleUpdateOne {
+	if s != nil {
+		pruo.SetPrefix(*s)
+	}
+	return pruo
+}
+
+// SetStart sets the "start" field.
+func (pruo *PickupRuleUpdateOne) SetStart(i int) *PickupRuleUpdateOne {
+	pruo.mutation.ResetStart()
+	pruo.mutation.SetStart(i)
+	return pruo
+}
+
+// SetNillableStart sets the "start" field if the given value is not nil.
+func (pruo *PickupRuleUpdateOne) SetNillableStart(i *int) *PickupRuleUpdateOne {
+	if i != nil {
+		pruo.SetStart(*i)
+	}
+	return pruo
+}
+
+// AddStart adds i to the "start" field.
+func (pruo *PickupRuleUpdateOne) AddStart(i int) *PickupRuleUpdateOne {
+	pruo.mutation.AddStart(i)
+	return pruo
+}
+
+// SetEnd sets the "end" field.
+func (pruo *PickupRuleUpdateOne) SetEnd(i int) *PickupRuleUpdateOne {
+	pruo.mutation.ResetEnd()
+	pruo.mutation.SetEnd(i)
+	return pruo
+}
+
+// SetNillableEnd sets the "end" field if the given value is not nil.
+func (pruo *PickupRuleUpdateOne) SetNillableEnd(i *int) *PickupRuleUpdateOne {
+	if i != nil {
+		pruo.SetEnd(*i)
+	}
+	return pruo
+}
+
+// AddEnd adds i to the "end" field.
+func (pruo *PickupRuleUpdateOne) AddEnd(i int) *PickupRuleUpdateOne {
+	pruo.mutation.AddEnd(i)
+	return pruo
+}
+
+// Mutation returns the PickupRuleMutation object of the builder.
+func (pruo *PickupRuleUpdateOne) Mutation() *PickupRuleMutation {
+	return pruo.mutation
+}
+
+// Where appends a list predicates to the PickupRuleUpdate builder.
+func (pruo *PickupRuleUpdateOne) Where(ps ...predicate.PickupRule) *PickupRuleUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PickupRuleUpdateOne) Select(field string, fields ...string) *PickupRuleUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PickupRule entity.
+func (pruo *PickupRuleUpdateOne) Save(ctx context.Context) (*PickupRule, error) {
+	pruo.defaults()
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PickupRuleUpdateOne) SaveX(ctx context.Context) *PickupRule {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PickupRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PickupRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pruo *PickupRuleUpdateOne) defaults() {
+	if _, ok := pruo.mutation.UpdatedAt(); !ok {
+		v := pickuprule.UpdateDefaultUpdatedAt()
+		pruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PickupRuleUpdateOne) check() error {
+	if v, ok := pruo.mutation.Prefix(); ok {
+		if err := pickuprule.PrefixValidator(v); err != nil {
+			return &ValidationError{Name: "prefix", err: fmt.Errorf(`ent: validator failed for field "PickupRule.prefix": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pruo *PickupRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PickupRuleUpdateOne {
+	pruo.modifiers = append(pruo.modifiers, modifiers...)
+	return pruo
+}
+
+func (pruo *PickupRuleUpdateOne) sqlSave(ctx context.Context) (_node *PickupRule, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pickuprule.Table, pickuprule.Columns, sqlgraph.NewFieldSpec(pickuprule.FieldID, field.TypeUUID))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PickupRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pickuprule.FieldID)
+		for _, f := range fields {
+			if !pickuprule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pickuprule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(pickuprule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pruo.mutation.Prefix(); ok {
+		_spec.SetField(pickuprule.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.Start(); ok {
+		_spec.SetField(pickuprule.FieldStart, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedStart(); ok {
+		_spec.AddField(pickuprule.FieldStart, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.End(); ok {
+		_spec.SetField(pickuprule.FieldEnd, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedEnd(); ok {
+		_spec.AddField(pickuprule.FieldEnd, field.TypeInt, value)
+	}
+	_spec.AddModifiers(pruo.modifiers...)
+	_node = &PickupRule{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pickuprule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
@@ -21,18 +21,66 @@ type BusinessConfig func(*sql.Selector)
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
+// Coupon is the predicate function for coupon builders.
+type Coupon func(*sql.Selector)
+
+// CouponTemplate is the predicate function for coupontemplate builders.
+type CouponTemplate func(*sql.Selector)
+
+// Customer is the predicate function for customer builders.
+type Customer func(*sql.Selector)
+
+// DeliveryOrder is the predicate function for deliveryorder builders.
+type DeliveryOrder func(*sql.Selector)
+
+// DeliveryProductMapping is the predicate function for deliveryproductmapping builders.
+type DeliveryProductMapping func(*sql.Selector)
+
+// DeliveryStore is the predicate function for deliverystore builders.
+type DeliveryStore func(*sql.Selector)
+
 // Department is the predicate function for department builders.
 type Department func(*sql.Selector)
 
 // Device is the predicate function for device builders.
 type Device func(*sql.Selector)
 
+// DiningTable is the predicate function for diningtable builders.
+type DiningTable func(*sql.Selector)
+
+// KitchenTicket is the predicate function for kitchenticket builders.
+type KitchenTicket func(*sql.Selector)
+
+// Member is the predicate function for member builders.
+type Member func(*sql.Selector)
+
+// MemberAccount is the predicate function for memberaccount builders.
+type MemberAccount func(*sql.Selector)
+
+// MemberAccountTransaction is the predicate function for memberaccounttransaction builders.
+type MemberAccountTransaction func(*sql.Selector)
+
+// MemberPointsAccount is the predicate function for memberpointsaccount builders.
+type MemberPointsAccount func(*sql.Selector)
+
+// MemberPointsRule is the predicate function for memberpointsrule builders.
+type MemberPointsRule func(*sql.Selector)
+
+// MemberPointsTransaction is the predicate function for memberpointstransaction builders.
+type MemberPointsTransaction func(*sql.Selector)
+
+// MemberTier is the predicate function for membertier builders.
+type MemberTier func(*sql.Selector)
+
 // Menu is the predicate function for menu builders.
 type Menu func(*sql.Selector)
 
 // MenuItem is the predicate function for menuitem builders.
 type MenuItem func(*sql.Selector)
 
+// MenuVersion is the predicate function for menuversion builders.
+type MenuVersion func(*sql.Selector)
+
 // Merchant is the predicate function for merchant builders.
 type Merchant func(*sql.Selector)
 
@@ -57,6 +105,24 @@ type PaymentMethod func(*sql.Selector)
 // Permission is the predicate function for permission builders.
 type Permission func(*sql.Selector)
 
+// PickupCall is the predicate function for pickupcall builders.
+type PickupCall func(*sql.Selector)
+
+// PickupRule is the predicate function for pickuprule builders.
+type PickupRule func(*sql.Selector)
+
+// Preorder is the predicate function for preorder builders.
+type Preorder func(*sql.Selector)
+
+// PreorderRule is the predicate function for preorderrule builders.
+type PreorderRule func(*sql.Selector)
+
+// PriceChangeBatch is the predicate function for pricechangebatch builders.
+type PriceChangeBatch func(*sql.Selector)
+
+// PriceChangeItem is the predicate function for pricechangeitem builders.
+type PriceChangeItem func(*sql.Selector)
+
 // Product is the predicate function for product builders.
 type Product func(*sql.Selector)
 
@@ -81,12 +147,21 @@ type ProductTag func(*sql.Selector)
 // ProductUnit is the predicate function for productunit builders.
 type ProductUnit func(*sql.Selector)
 
+// ProductVersion is the predicate function for productversion builders.
+type ProductVersion func(*sql.Selector)
+
 // ProfitDistributionBill is the predicate function for profitdistributionbill builders.
 type ProfitDistributionBill func(*sql.Selector)
 
 // ProfitDistributionRule is the predicate function for profitdistributionrule builders.
 type ProfitDistributionRule func(*sql.Selector)
 
+// Promotion is the predicate function for promotion builders.
+type Promotion func(*sql.Selector)
+
+// ReceiptRequest is the predicate function for receiptrequest builders.
+type ReceiptRequest func(*sql.Selector)
+
 // RefundOrder is the predicate function for refundorder builders.
 type RefundOrder func(*sql.Selector)
 
@@ -96,6 +171,12 @@ type RefundOrderProduct func(*sql.Selector)
 // Remark is the predicate function for remark builders.
 type Remark func(*sql.Selector)
 
+// Reservation is the predicate function for reservation builders.
+type Reservation func(*sql.Selector)
+
+// ReservationRule is the predicate function for reservationrule builders.
+type ReservationRule func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
@@ -108,6 +189,9 @@ type RolePermission func(*sql.Selector)
 // RouterMenu is the predicate function for routermenu builders.
 type RouterMenu func(*sql.Selector)
 
+// ScanOrderRule is the predicate function for scanorderrule builders.
+type ScanOrderRule func(*sql.Selector)
+
 // SetMealDetail is the predicate function for setmealdetail builders.
 type SetMealDetail func(*sql.Selector)
 
@@ -131,3 +215,9 @@ type TaxFee func(*sql.Selector)
 
 // UserRole is the predicate function for userrole builders.
 type UserRole func(*sql.Selector)
+
+// WaitlistRule is the predicate function for waitlistrule builders.
+type WaitlistRule func(*sql.Selector)
+
+// WaitlistTicket is the predicate function for waitlistticket builders.
+type WaitlistTicket func(*sql.Selector)
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorder"
+)
+
+// Preorder is the model entity for the Preorder schema.
+type Preorder struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 顾客ID
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+	// 订单ID
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// 订单号
+	OrderNo string `json:"order_no,omitempty"`
+	// 取餐方式：pickup=到店自取；delivery=门店配送
+	Fulfilment domain.PreorderFulfilment `json:"fulfilment,omitempty"`
+	// 预约取餐/送达时间
+	ScheduledAt time.Time `json:"scheduled_at,omitempty"`
+	// 计划送厨时间
+	ReleaseAt time.Time `json:"release_at,omitempty"`
+	// 联系人
+	ContactName string `json:"contact_name,omitempty"`
+	// 联系电话
+	ContactPhone string `json:"contact_phone,omitempty"`
+	// 配送地址
+	DeliveryAddress string `json:"delivery_address,omitempty"`
+	// 状态：scheduled=待送厨；released=已送厨；cancelled=已取消
+	Status domain.PreorderStatus `json:"status,omitempty"`
+	// 实际送厨时间
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	// 取消时间
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// 取消原因
+	CancelReason string `json:"cancel_reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Preorder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case preorder.FieldOrderNo, preorder.FieldFulfilment, preorder.FieldContactName, preorder.FieldContactPhone, preorder.FieldDeliveryAddress, preorder.FieldStatus, preorder.FieldCancelReason:
+			values[i] = new(sql.NullString)
+		case preorder.FieldCreatedAt, preorder.FieldUpdatedAt, preorder.FieldScheduledAt, preorder.FieldReleaseAt, preorder.FieldReleasedAt, preorder.FieldCancelledAt:
+			values[i] = new(sql.NullTime)
+		case preorder.FieldID, preorder.FieldMerchantID, preorder.FieldStoreID, preorder.FieldCustomerID, preorder.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Preorder fields.
+func (pr *Preorder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case preorder.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pr.ID = *value
+			}
+		case preorder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case preorder.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		case preorder.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				pr.MerchantID = *value
+			}
+		case preorder.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				pr.StoreID = *value
+			}
+		case preorder.FieldCustomerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value != nil {
+				pr.CustomerID = *value
+			}
+		case preorder.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				pr.OrderID = *value
+			}
+		case preorder.FieldOrderNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_no", values[i])
+			} else if value.Valid {
+				pr.OrderNo = value.String
+			}
+		case preorder.FieldFulfilment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfilment", values[i])
+			} else if value.Valid {
+				pr.Fulfilment = domain.PreorderFulfilment(value.String)
+			}
+		case preorder.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				pr.ScheduledAt = value.Time
+			}
+		case preorder.FieldReleaseAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field release_at", values[i])
+			} else if value.Valid {
+				pr.ReleaseAt = value.Time
+			}
+		case preorder.FieldContactName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field contact_name", values[i])
+			} else if value.Valid {
+				pr.ContactName = value.String
+			}
+		case preorder.FieldContactPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field contact_phone", values[i])
+			} else if value.Valid {
+				pr.ContactPhone = value.String
+			}
+		case preorder.FieldDeliveryAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_address", values[i])
+			} else if value.Valid {
+				pr.DeliveryAddress = value.String
+			}
+		case preorder.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pr.Status = domain.PreorderStatus(value.String)
+			}
+		case preorder.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				pr.ReleasedAt = new(time.Time)
+				*pr.ReleasedAt = value.Time
+			}
+		case preorder.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				pr.CancelledAt = new(time.Time)
+				*pr.CancelledAt = value.Time
+			}
+		case preorder.FieldCancelReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_reason", values[i])
+			} else if value.Valid {
+				pr.CancelReason = value.String
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Preorder.
+// This includes values selected through modifiers, order, etc.
+func (pr *Preorder) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Preorder.
+// Note that you need to call Preorder.Unwrap() before calling this method if this Preorder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Preorder) Update() *PreorderUpdateOne {
+	return NewPreorderClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Preorder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Preorder) Unwrap() *Preorder {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Preorder is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Preorder) String() string {
+	var builder strings.Builder
+	builder.WriteString("Preorder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.CustomerID))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("order_no=")
+	builder.WriteString(pr.OrderNo)
+	builder.WriteString(", ")
+	builder.WriteString("fulfilment=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Fulfilment))
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_at=")
+	builder.WriteString(pr.ScheduledAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("release_at=")
+	builder.WriteString(pr.ReleaseAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("contact_name=")
+	builder.WriteString(pr.ContactName)
+	builder.WriteString(", ")
+	builder.WriteString("contact_phone=")
+	builder.WriteString(pr.ContactPhone)
+	builder.WriteString(", ")
+	builder.WriteString("delivery_address=")
+	builder.WriteString(pr.DeliveryAddress)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Status))
+	builder.WriteString(", ")
+	if v := pr.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pr.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancel_reason=")
+	builder.WriteString(pr.CancelReason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Preorders is a parsable slice of Preorder.
+type Preorders []*Preorder
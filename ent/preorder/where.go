@@ -0,0 +1,952 @@
+// Code generated by ent, DO NOT EDIT.
+
+package preorder
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldStoreID, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderNo applies equality check predicate on the "order_no" field. It's identical to OrderNoEQ.
+func OrderNo(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// ScheduledAt applies equality check predicate on the "scheduled_at" field. It's identical to ScheduledAtEQ.
+func ScheduledAt(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldScheduledAt, v))
+}
+
+// ReleaseAt applies equality check predicate on the "release_at" field. It's identical to ReleaseAtEQ.
+func ReleaseAt(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldReleaseAt, v))
+}
+
+// ContactName applies equality check predicate on the "contact_name" field. It's identical to ContactNameEQ.
+func ContactName(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldContactName, v))
+}
+
+// ContactPhone applies equality check predicate on the "contact_phone" field. It's identical to ContactPhoneEQ.
+func ContactPhone(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldContactPhone, v))
+}
+
+// DeliveryAddress applies equality check predicate on the "delivery_address" field. It's identical to DeliveryAddressEQ.
+func DeliveryAddress(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldDeliveryAddress, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelReason applies equality check predicate on the "cancel_reason" field. It's identical to CancelReasonEQ.
+func CancelReason(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldCancelReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldStoreID, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v uuid.UUID) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderNoEQ applies the EQ predicate on the "order_no" field.
+func OrderNoEQ(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// OrderNoNEQ applies the NEQ predicate on the "order_no" field.
+func OrderNoNEQ(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldOrderNo, v))
+}
+
+// OrderNoIn applies the In predicate on the "order_no" field.
+func OrderNoIn(vs ...string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldOrderNo, vs...))
+}
+
+// OrderNoNotIn applies the NotIn predicate on the "order_no" field.
+func OrderNoNotIn(vs ...string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldOrderNo, vs...))
+}
+
+// OrderNoGT applies the GT predicate on the "order_no" field.
+func OrderNoGT(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldOrderNo, v))
+}
+
+// OrderNoGTE applies the GTE predicate on the "order_no" field.
+func OrderNoGTE(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldOrderNo, v))
+}
+
+// OrderNoLT applies the LT predicate on the "order_no" field.
+func OrderNoLT(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldOrderNo, v))
+}
+
+// OrderNoLTE applies the LTE predicate on the "order_no" field.
+func OrderNoLTE(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldOrderNo, v))
+}
+
+// OrderNoContains applies the Contains predicate on the "order_no" field.
+func OrderNoContains(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldContains(FieldOrderNo, v))
+}
+
+// OrderNoHasPrefix applies the HasPrefix predicate on the "order_no" field.
+func OrderNoHasPrefix(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldHasPrefix(FieldOrderNo, v))
+}
+
+// OrderNoHasSuffix applies the HasSuffix predicate on the "order_no" field.
+func OrderNoHasSuffix(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldHasSuffix(FieldOrderNo, v))
+}
+
+// OrderNoEqualFold applies the EqualFold predicate on the "order_no" field.
+func OrderNoEqualFold(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEqualFold(FieldOrderNo, v))
+}
+
+// OrderNoContainsFold applies the ContainsFold predicate on the "order_no" field.
+func OrderNoContainsFold(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldContainsFold(FieldOrderNo, v))
+}
+
+// FulfilmentEQ applies the EQ predicate on the "fulfilment" field.
+func FulfilmentEQ(v domain.PreorderFulfilment) predicate.Preorder {
+	vc := v
+	return predicate.Preorder(sql.FieldEQ(FieldFulfilment, vc))
+}
+
+// FulfilmentNEQ applies the NEQ predicate on the "fulfilment" field.
+func FulfilmentNEQ(v domain.PreorderFulfilment) predicate.Preorder {
+	vc := v
+	return predicate.Preorder(sql.FieldNEQ(FieldFulfilment, vc))
+}
+
+// FulfilmentIn applies the In predicate on the "fulfilment" field.
+func FulfilmentIn(vs ...domain.PreorderFulfilment) predicate.Preorder {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Preorder(sql.FieldIn(FieldFulfilment, v...))
+}
+
+// FulfilmentNotIn applies the NotIn predicate on the "fulfilment" field.
+func FulfilmentNotIn(vs ...domain.PreorderFulfilment) predicate.Preorder {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Preorder(sql.FieldNotIn(FieldFulfilment, v...))
+}
+
+// ScheduledAtEQ applies the EQ predicate on the "scheduled_at" field.
+func ScheduledAtEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtNEQ applies the NEQ predicate on the "scheduled_at" field.
+func ScheduledAtNEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtIn applies the In predicate on the "scheduled_at" field.
+func ScheduledAtIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtNotIn applies the NotIn predicate on the "scheduled_at" field.
+func ScheduledAtNotIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtGT applies the GT predicate on the "scheduled_at" field.
+func ScheduledAtGT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldScheduledAt, v))
+}
+
+// ScheduledAtGTE applies the GTE predicate on the "scheduled_at" field.
+func ScheduledAtGTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldScheduledAt, v))
+}
+
+// ScheduledAtLT applies the LT predicate on the "scheduled_at" field.
+func ScheduledAtLT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldScheduledAt, v))
+}
+
+// ScheduledAtLTE applies the LTE predicate on the "scheduled_at" field.
+func ScheduledAtLTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldScheduledAt, v))
+}
+
+// ReleaseAtEQ applies the EQ predicate on the "release_at" field.
+func ReleaseAtEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldReleaseAt, v))
+}
+
+// ReleaseAtNEQ applies the NEQ predicate on the "release_at" field.
+func ReleaseAtNEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldReleaseAt, v))
+}
+
+// ReleaseAtIn applies the In predicate on the "release_at" field.
+func ReleaseAtIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldReleaseAt, vs...))
+}
+
+// ReleaseAtNotIn applies the NotIn predicate on the "release_at" field.
+func ReleaseAtNotIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldReleaseAt, vs...))
+}
+
+// ReleaseAtGT applies the GT predicate on the "release_at" field.
+func ReleaseAtGT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldReleaseAt, v))
+}
+
+// ReleaseAtGTE applies the GTE predicate on the "release_at" field.
+func ReleaseAtGTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldReleaseAt, v))
+}
+
+// ReleaseAtLT applies the LT predicate on the "release_at" field.
+func ReleaseAtLT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldReleaseAt, v))
+}
+
+// ReleaseAtLTE applies the LTE predicate on the "release_at" field.
+func ReleaseAtLTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldReleaseAt, v))
+}
+
+// ContactNameEQ applies the EQ predicate on the "contact_name" field.
+func ContactNameEQ(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldContactName, v))
+}
+
+// ContactNameNEQ applies the NEQ predicate on the "contact_name" field.
+func ContactNameNEQ(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldContactName, v))
+}
+
+// ContactNameIn applies the In predicate on the "contact_name" field.
+func ContactNameIn(vs ...string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldContactName, vs...))
+}
+
+// ContactNameNotIn applies the NotIn predicate on the "contact_name" field.
+func ContactNameNotIn(vs ...string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldContactName, vs...))
+}
+
+// ContactNameGT applies the GT predicate on the "contact_name" field.
+func ContactNameGT(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldContactName, v))
+}
+
+// ContactNameGTE applies the GTE predicate on the "contact_name" field.
+func ContactNameGTE(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldContactName, v))
+}
+
+// ContactNameLT applies the LT predicate on the "contact_name" field.
+func ContactNameLT(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldContactName, v))
+}
+
+// ContactNameLTE applies the LTE predicate on the "contact_name" field.
+func ContactNameLTE(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldContactName, v))
+}
+
+// ContactNameContains applies the Contains predicate on the "contact_name" field.
+func ContactNameContains(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldContains(FieldContactName, v))
+}
+
+// ContactNameHasPrefix applies the HasPrefix predicate on the "contact_name" field.
+func ContactNameHasPrefix(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldHasPrefix(FieldContactName, v))
+}
+
+// ContactNameHasSuffix applies the HasSuffix predicate on the "contact_name" field.
+func ContactNameHasSuffix(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldHasSuffix(FieldContactName, v))
+}
+
+// ContactNameEqualFold applies the EqualFold predicate on the "contact_name" field.
+func ContactNameEqualFold(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEqualFold(FieldContactName, v))
+}
+
+// ContactNameContainsFold applies the ContainsFold predicate on the "contact_name" field.
+func ContactNameContainsFold(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldContainsFold(FieldContactName, v))
+}
+
+// ContactPhoneEQ applies the EQ predicate on the "contact_phone" field.
+func ContactPhoneEQ(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldContactPhone, v))
+}
+
+// ContactPhoneNEQ applies the NEQ predicate on the "contact_phone" field.
+func ContactPhoneNEQ(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldContactPhone, v))
+}
+
+// ContactPhoneIn applies the In predicate on the "contact_phone" field.
+func ContactPhoneIn(vs ...string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldContactPhone, vs...))
+}
+
+// ContactPhoneNotIn applies the NotIn predicate on the "contact_phone" field.
+func ContactPhoneNotIn(vs ...string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldContactPhone, vs...))
+}
+
+// ContactPhoneGT applies the GT predicate on the "contact_phone" field.
+func ContactPhoneGT(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldContactPhone, v))
+}
+
+// ContactPhoneGTE applies the GTE predicate on the "contact_phone" field.
+func ContactPhoneGTE(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldContactPhone, v))
+}
+
+// ContactPhoneLT applies the LT predicate on the "contact_phone" field.
+func ContactPhoneLT(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldContactPhone, v))
+}
+
+// ContactPhoneLTE applies the LTE predicate on the "contact_phone" field.
+func ContactPhoneLTE(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldContactPhone, v))
+}
+
+// ContactPhoneContains applies the Contains predicate on the "contact_phone" field.
+func ContactPhoneContains(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldContains(FieldContactPhone, v))
+}
+
+// ContactPhoneHasPrefix applies the HasPrefix predicate on the "contact_phone" field.
+func ContactPhoneHasPrefix(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldHasPrefix(FieldContactPhone, v))
+}
+
+// ContactPhoneHasSuffix applies the HasSuffix predicate on the "contact_phone" field.
+func ContactPhoneHasSuffix(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldHasSuffix(FieldContactPhone, v))
+}
+
+// ContactPhoneEqualFold applies the EqualFold predicate on the "contact_phone" field.
+func ContactPhoneEqualFold(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEqualFold(FieldContactPhone, v))
+}
+
+// ContactPhoneContainsFold applies the ContainsFold predicate on the "contact_phone" field.
+func ContactPhoneContainsFold(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldContainsFold(FieldContactPhone, v))
+}
+
+// DeliveryAddressEQ applies the EQ predicate on the "delivery_address" field.
+func DeliveryAddressEQ(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressNEQ applies the NEQ predicate on the "delivery_address" field.
+func DeliveryAddressNEQ(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressIn applies the In predicate on the "delivery_address" field.
+func DeliveryAddressIn(vs ...string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldDeliveryAddress, vs...))
+}
+
+// DeliveryAddressNotIn applies the NotIn predicate on the "delivery_address" field.
+func DeliveryAddressNotIn(vs ...string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldDeliveryAddress, vs...))
+}
+
+// DeliveryAddressGT applies the GT predicate on the "delivery_address" field.
+func DeliveryAddressGT(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressGTE applies the GTE predicate on the "delivery_address" field.
+func DeliveryAddressGTE(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressLT applies the LT predicate on the "delivery_address" field.
+func DeliveryAddressLT(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressLTE applies the LTE predicate on the "delivery_address" field.
+func DeliveryAddressLTE(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressContains applies the Contains predicate on the "delivery_address" field.
+func DeliveryAddressContains(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldContains(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressHasPrefix applies the HasPrefix predicate on the "delivery_address" field.
+func DeliveryAddressHasPrefix(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldHasPrefix(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressHasSuffix applies the HasSuffix predicate on the "delivery_address" field.
+func DeliveryAddressHasSuffix(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldHasSuffix(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressEqualFold applies the EqualFold predicate on the "delivery_address" field.
+func DeliveryAddressEqualFold(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEqualFold(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressContainsFold applies the ContainsFold predicate on the "delivery_address" field.
+func DeliveryAddressContainsFold(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldContainsFold(FieldDeliveryAddress, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v domain.PreorderStatus) predicate.Preorder {
+	vc := v
+	return predicate.Preorder(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v domain.PreorderStatus) predicate.Preorder {
+	vc := v
+	return predicate.Preorder(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...domain.PreorderStatus) predicate.Preorder {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Preorder(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...domain.PreorderStatus) predicate.Preorder {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Preorder(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.Preorder {
+	return predicate.Preorder(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.Preorder {
+	return predicate.Preorder(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CancelReasonEQ applies the EQ predicate on the "cancel_reason" field.
+func CancelReasonEQ(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEQ(FieldCancelReason, v))
+}
+
+// CancelReasonNEQ applies the NEQ predicate on the "cancel_reason" field.
+func CancelReasonNEQ(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNEQ(FieldCancelReason, v))
+}
+
+// CancelReasonIn applies the In predicate on the "cancel_reason" field.
+func CancelReasonIn(vs ...string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldIn(FieldCancelReason, vs...))
+}
+
+// CancelReasonNotIn applies the NotIn predicate on the "cancel_reason" field.
+func CancelReasonNotIn(vs ...string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldNotIn(FieldCancelReason, vs...))
+}
+
+// CancelReasonGT applies the GT predicate on the "cancel_reason" field.
+func CancelReasonGT(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGT(FieldCancelReason, v))
+}
+
+// CancelReasonGTE applies the GTE predicate on the "cancel_reason" field.
+func CancelReasonGTE(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldGTE(FieldCancelReason, v))
+}
+
+// CancelReasonLT applies the LT predicate on the "cancel_reason" field.
+func CancelReasonLT(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLT(FieldCancelReason, v))
+}
+
+// CancelReasonLTE applies the LTE predicate on the "cancel_reason" field.
+func CancelReasonLTE(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldLTE(FieldCancelReason, v))
+}
+
+// CancelReasonContains applies the Contains predicate on the "cancel_reason" field.
+func CancelReasonContains(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldContains(FieldCancelReason, v))
+}
+
+// CancelReasonHasPrefix applies the HasPrefix predicate on the "cancel_reason" field.
+func CancelReasonHasPrefix(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldHasPrefix(FieldCancelReason, v))
+}
+
+// CancelReasonHasSuffix applies the HasSuffix predicate on the "cancel_reason" field.
+func CancelReasonHasSuffix(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldHasSuffix(FieldCancelReason, v))
+}
+
+// CancelReasonEqualFold applies the EqualFold predicate on the "cancel_reason" field.
+func CancelReasonEqualFold(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldEqualFold(FieldCancelReason, v))
+}
+
+// CancelReasonContainsFold applies the ContainsFold predicate on the "cancel_reason" field.
+func CancelReasonContainsFold(v string) predicate.Preorder {
+	return predicate.Preorder(sql.FieldContainsFold(FieldCancelReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Preorder) predicate.Preorder {
+	return predicate.Preorder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Preorder) predicate.Preorder {
+	return predicate.Preorder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Preorder) predicate.Preorder {
+	return predicate.Preorder(sql.NotPredicates(p))
+}
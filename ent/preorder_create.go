@@ -0,0 +1,1083 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorder"
+)
+
+// PreorderCreate is the builder for creating a Preorder entity.
+type PreorderCreate struct {
+	config
+	mutation *PreorderMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PreorderCreate) SetCreatedAt(t time.Time) *PreorderCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PreorderCreate) SetNillableCreatedAt(t *time.Time) *PreorderCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PreorderCreate) SetUpdatedAt(t time.Time) *PreorderCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PreorderCreate) SetNillableUpdatedAt(t *time.Time) *PreorderCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (pc *PreorderCreate) SetMerchantID(u uuid.UUID) *PreorderCreate {
+	pc.mutation.SetMerchantID(u)
+	return pc
+}
+
+// SetStoreID sets the "store_id" field.
+func (pc *PreorderCreate) SetStoreID(u uuid.UUID) *PreorderCreate {
+	pc.mutation.SetStoreID(u)
+	return pc
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (pc *PreorderCreate) SetCustomerID(u uuid.UUID) *PreorderCreate {
+	pc.mutation.SetCustomerID(u)
+	return pc
+}
+
+// SetOrderID sets the "order_id" field.
+func (pc *PreorderCreate) SetOrderID(u uuid.UUID) *PreorderCreate {
+	pc.mutation.SetOrderID(u)
+	return pc
+}
+
+// SetOrderNo sets the "order_no" field.
+func (pc *PreorderCreate) SetOrderNo(s string) *PreorderCreate {
+	pc.mutation.SetOrderNo(s)
+	return pc
+}
+
+// SetFulfilment sets the "fulfilment" field.
+func (pc *PreorderCreate) SetFulfilment(df domain.PreorderFulfilment) *PreorderCreate {
+	pc.mutation.SetFulfilment(df)
+	return pc
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (pc *PreorderCreate) SetScheduledAt(t time.Time) *PreorderCreate {
+	pc.mutation.SetScheduledAt(t)
+	return pc
+}
+
+// SetReleaseAt sets the "release_at" field.
+func (pc *PreorderCreate) SetReleaseAt(t time.Time) *PreorderCreate {
+	pc.mutation.SetReleaseAt(t)
+	return pc
+}
+
+// SetContactName sets the "contact_name" field.
+func (pc *PreorderCreate) SetContactName(s string) *PreorderCreate {
+	pc.mutation.SetContactName(s)
+	return pc
+}
+
+// SetContactPhone sets the "contact_phone" field.
+func (pc *PreorderCreate) SetContactPhone(s string) *PreorderCreate {
+	pc.mutation.SetContactPhone(s)
+	return pc
+}
+
+// SetDeliveryAddress sets the "delivery_address" field.
+func (pc *PreorderCreate) SetDeliveryAddress(s string) *PreorderCreate {
+	pc.mutation.SetDeliveryAddress(s)
+	return pc
+}
+
+// SetNillableDeliveryAddress sets the "delivery_address" field if the given value is not nil.
+func (pc *PreorderCreate) SetNillableDeliveryAddress(s *string) *PreorderCreate {
+	if s != nil {
+		pc.SetDeliveryAddress(*s)
+	}
+	return pc
+}
+
+// SetStatus sets the "status" field.
+func (pc *PreorderCreate) SetStatus(ds domain.PreorderStatus) *PreorderCreate {
+	pc.mutation.SetStatus(ds)
+	return pc
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (pc *PreorderCreate) SetReleasedAt(t time.Time) *PreorderCreate {
+	pc.mutation.SetReleasedAt(t)
+	return pc
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (pc *PreorderCreate) SetNillableReleasedAt(t *time.Time) *PreorderCreate {
+	if t != nil {
+		pc.SetReleasedAt(*t)
+	}
+	return pc
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (pc *PreorderCreate) SetCancelledAt(t time.Time) *PreorderCreate {
+	pc.mutation.SetCancelledAt(t)
+	return pc
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (pc *PreorderCreate) SetNillableCancelledAt(t *time.Time) *PreorderCreate {
+	if t != nil {
+		pc.SetCancelledAt(*t)
+	}
+	return pc
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (pc *PreorderCreate) SetCancelReason(s string) *PreorderCreate {
+	pc.mutation.SetCancelReason(s)
+	return pc
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (pc *PreorderCreate) SetNillableCancelReason(s *string) *PreorderCreate {
+	if s != nil {
+		pc.SetCancelReason(*s)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PreorderCreate) SetID(u uuid.UUID) *PreorderCreate {
+	pc.mutation.SetID(u)
+	return pc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pc *PreorderCreate) SetNillableID(u *uuid.UUID) *PreorderCreate {
+	if u != nil {
+		pc.SetID(*u)
+	}
+	return pc
+}
+
+// Mutation returns the PreorderMutation object of the builder.
+func (pc *PreorderCreate) Mutation() *PreorderMutation {
+	return pc.mutation
+}
+
+// Save creates the Preorder in the database.
+func (pc *PreorderCreate) Save(ctx context.Context) (*Preorder, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PreorderCreate) SaveX(ctx context.Context) *Preorder {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PreorderCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PreorderCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PreorderCreate) defaults() {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := preorder.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := preorder.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pc.mutation.DeliveryAddress(); !ok {
+		v := preorder.DefaultDeliveryAddress
+		pc.mutation.SetDeliveryAddress(v)
+	}
+	if _, ok := pc.mutation.CancelReason(); !ok {
+		v := preorder.DefaultCancelReason
+		pc.mutation.SetCancelReason(v)
+	}
+	if _, ok := pc.mutation.ID(); !ok {
+		v := preorder.DefaultID()
+		pc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PreorderCreate) check() error {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Preorder.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Preorder.updated_at"`)}
+	}
+	if _, ok := pc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "Preorder.merchant_id"`)}
+	}
+	if _, ok := pc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "Preorder.store_id"`)}
+	}
+	if _, ok := pc.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "Preorder.customer_id"`)}
+	}
+	if _, ok := pc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "Preorder.order_id"`)}
+	}
+	if _, ok := pc.mutation.OrderNo(); !ok {
+		return &ValidationError{Name: "order_no", err: errors.New(`ent: missing required field "Preorder.order_no"`)}
+	}
+	if v, ok := pc.mutation.OrderNo(); ok {
+		if err := preorder.OrderNoValidator(v); err != nil {
+			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "Preorder.order_no": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Fulfilment(); !ok {
+		return &ValidationError{Name: "fulfilment", err: errors.New(`ent: missing required field "Preorder.fulfilment"`)}
+	}
+	if v, ok := pc.mutation.Fulfilment(); ok {
+		if err := preorder.FulfilmentValidator(v); err != nil {
+			return &ValidationError{Name: "fulfilment", err: fmt.Errorf(`ent: validator failed for field "Preorder.fulfilment": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.ScheduledAt(); !ok {
+		return &ValidationError{Name: "scheduled_at", err: errors.New(`ent: missing required field "Preorder.scheduled_at"`)}
+	}
+	if _, ok := pc.mutation.ReleaseAt(); !ok {
+		return &ValidationError{Name: "release_at", err: errors.New(`ent: missing required field "Preorder.release_at"`)}
+	}
+	if _, ok := pc.mutation.ContactName(); !ok {
+		return &ValidationError{Name: "contact_name", err: errors.New(`ent: missing required field "Preorder.contact_name"`)}
+	}
+	if v, ok := pc.mutation.ContactName(); ok {
+		if err := preorder.ContactNameValidator(v); err != nil {
+			return &ValidationError{Name: "contact_name", err: fmt.Errorf(`ent: validator failed for field "Preorder.contact_name": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.ContactPhone(); !ok {
+		return &ValidationError{Name: "contact_phone", err: errors.New(`ent: missing required field "Preorder.contact_phone"`)}
+	}
+	if v, ok := pc.mutation.ContactPhone(); ok {
+		if err := preorder.ContactPhoneValidator(v); err != nil {
+			return &ValidationError{Name: "contact_phone", err: fmt.Errorf(`ent: validator failed for field "Preorder.contact_phone": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.DeliveryAddress(); !ok {
+		return &ValidationError{Name: "delivery_address", err: errors.New(`ent: missing required field "Preorder.delivery_address"`)}
+	}
+	if v, ok := pc.mutation.DeliveryAddress(); ok {
+		if err := preorder.DeliveryAddressValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_address", err: fmt.Errorf(`ent: validator failed for field "Preorder.delivery_address": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Preorder.status"`)}
+	}
+	if v, ok := pc.mutation.Status(); ok {
+		if err := preorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Preorder.status": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.CancelReason(); !ok {
+		return &ValidationError{Name: "cancel_reason", err: errors.New(`ent: missing required field "Preorder.cancel_reason"`)}
+	}
+	if v, ok := pc.mutation.CancelReason(); ok {
+		if err := preorder.CancelReasonValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_reason", err: fmt.Errorf(`ent: validator failed for field "Preorder.cancel_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pc *PreorderCreate) sqlSave(ctx context.Context) (*Preorder, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PreorderCreate) createSpec() (*Preorder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Preorder{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(preorder.Table, sqlgraph.NewFieldSpec(preorder.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pc.conflict
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(preorder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(preorder.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pc.mutation.MerchantID(); ok {
+		_spec.SetField(preorder.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := pc.mutation.StoreID(); ok {
+		_spec.SetField(preorder.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := pc.mutation.CustomerID(); ok {
+		_spec.SetField(preorder.FieldCustomerID, field.TypeUUID, value)
+		_node.CustomerID = value
+	}
+	if value, ok := pc.mutation.OrderID(); ok {
+		_spec.SetField(preorder.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := pc.mutation.OrderNo(); ok {
+		_spec.SetField(preorder.FieldOrderNo, field.TypeString, value)
+		_node.OrderNo = value
+	}
+	if value, ok := pc.mutation.Fulfilment(); ok {
+		_spec.SetField(preorder.FieldFulfilment, field.TypeEnum, value)
+		_node.Fulfilment = value
+	}
+	if value, ok := pc.mutation.ScheduledAt(); ok {
+		_spec.SetField(preorder.FieldScheduledAt, field.TypeTime, value)
+		_node.ScheduledAt = value
+	}
+	if value, ok := pc.mutation.ReleaseAt(); ok {
+		_spec.SetField(preorder.FieldReleaseAt, field.TypeTime, value)
+		_node.ReleaseAt = value
+	}
+	if value, ok := pc.mutation.ContactName(); ok {
+		_spec.SetField(preorder.FieldContactName, field.TypeString, value)
+		_node.ContactName = value
+	}
+	if value, ok := pc.mutation.ContactPhone(); ok {
+		_spec.SetField(preorder.FieldContactPhone, field.TypeString, value)
+		_node.ContactPhone = value
+	}
+	if value, ok := pc.mutation.DeliveryAddress(); ok {
+		_spec.SetField(preorder.FieldDeliveryAddress, field.TypeString, value)
+		_node.DeliveryAddress = value
+	}
+	if value, ok := pc.mutation.Status(); ok {
+		_spec.SetField(preorder.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := pc.mutation.ReleasedAt(); ok {
+		_spec.SetField(preorder.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if value, ok := pc.mutation.CancelledAt(); ok {
+		_spec.SetField(preorder.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := pc.mutation.CancelReason(); ok {
+		_spec.SetField(preorder.FieldCancelReason, field.TypeString, value)
+		_node.CancelReason = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Preorder.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PreorderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PreorderCreate) OnConflict(opts ...sql.ConflictOption) *PreorderUpsertOne {
+	pc.conflict = opts
+	return &PreorderUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Preorder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PreorderCreate) OnConflictColumns(columns ...string) *PreorderUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PreorderUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PreorderUpsertOne is the builder for "upsert"-ing
+	//  one Preorder node.
+	PreorderUpsertOne struct {
+		create *PreorderCreate
+	}
+
+	// PreorderUpsert is the "OnConflict" setter.
+	PreorderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PreorderUpsert) SetUpdatedAt(v time.Time) *PreorderUpsert {
+	u.Set(preorder.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PreorderUpsert) UpdateUpdatedAt() *PreorderUpsert {
+	u.SetExcluded(preorder.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PreorderUpsert) SetStatus(v domain.PreorderStatus) *PreorderUpsert {
+	u.Set(preorder.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PreorderUpsert) UpdateStatus() *PreorderUpsert {
+	u.SetExcluded(preorder.FieldStatus)
+	return u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *PreorderUpsert) SetReleasedAt(v time.Time) *PreorderUpsert {
+	u.Set(preorder.FieldReleasedAt, v)
+	return u
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *PreorderUpsert) UpdateReleasedAt() *PreorderUpsert {
+	u.SetExcluded(preorder.FieldReleasedAt)
+	return u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *PreorderUpsert) ClearReleasedAt() *PreorderUpsert {
+	u.SetNull(preorder.FieldReleasedAt)
+	return u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *PreorderUpsert) SetCancelledAt(v time.Time) *PreorderUpsert {
+	u.Set(preorder.FieldCancelledAt, v)
+	return u
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *PreorderUpsert) UpdateCancelledAt() *PreorderUpsert {
+	u.SetExcluded(preorder.FieldCancelledAt)
+	return u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *PreorderUpsert) ClearCancelledAt() *PreorderUpsert {
+	u.SetNull(preorder.FieldCancelledAt)
+	return u
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (u *PreorderUpsert) SetCancelReason(v string) *PreorderUpsert {
+	u.Set(preorder.FieldCancelReason, v)
+	return u
+}
+
+// UpdateCancelReason sets the "cancel_reason" field to the value that was provided on create.
+func (u *PreorderUpsert) UpdateCancelReason() *PreorderUpsert {
+	u.SetExcluded(preorder.FieldCancelReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Preorder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(preorder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PreorderUpsertOne) UpdateNewValues() *PreorderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(preorder.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(preorder.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(preorder.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(preorder.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.CustomerID(); exists {
+			s.SetIgnore(preorder.FieldCustomerID)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(preorder.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.OrderNo(); exists {
+			s.SetIgnore(preorder.FieldOrderNo)
+		}
+		if _, exists := u.create.mutation.Fulfilment(); exists {
+			s.SetIgnore(preorder.FieldFulfilment)
+		}
+		if _, exists := u.create.mutation.ScheduledAt(); exists {
+			s.SetIgnore(preorder.FieldScheduledAt)
+		}
+		if _, exists := u.create.mutation.ReleaseAt(); exists {
+			s.SetIgnore(preorder.FieldReleaseAt)
+		}
+		if _, exists := u.create.mutation.ContactName(); exists {
+			s.SetIgnore(preorder.FieldContactName)
+		}
+		if _, exists := u.create.mutation.ContactPhone(); exists {
+			s.SetIgnore(preorder.FieldContactPhone)
+		}
+		if _, exists := u.create.mutation.DeliveryAddress(); exists {
+			s.SetIgnore(preorder.FieldDeliveryAddress)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Preorder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PreorderUpsertOne) Ignore() *PreorderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PreorderUpsertOne) DoNothing() *PreorderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PreorderCreate.OnConflict
+// documentation for more info.
+func (u *PreorderUpsertOne) Update(set func(*PreorderUpsert)) *PreorderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PreorderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PreorderUpsertOne) SetUpdatedAt(v time.Time) *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PreorderUpsertOne) UpdateUpdatedAt() *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PreorderUpsertOne) SetStatus(v domain.PreorderStatus) *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PreorderUpsertOne) UpdateStatus() *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *PreorderUpsertOne) SetReleasedAt(v time.Time) *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *PreorderUpsertOne) UpdateReleasedAt() *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *PreorderUpsertOne) ClearReleasedAt() *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *PreorderUpsertOne) SetCancelledAt(v time.Time) *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *PreorderUpsertOne) UpdateCancelledAt() *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *PreorderUpsertOne) ClearCancelledAt() *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (u *PreorderUpsertOne) SetCancelReason(v string) *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.SetCancelReason(v)
+	})
+}
+
+// UpdateCancelReason sets the "cancel_reason" field to the value that was provided on create.
+func (u *PreorderUpsertOne) UpdateCancelReason() *PreorderUpsertOne {
+	return u.Update(func(s *PreorderUpsert) {
+		s.UpdateCancelReason()
+	})
+}
+
+// Exec executes the query.
+func (u *PreorderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PreorderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PreorderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PreorderUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PreorderUpsertOne.ID is not supported by MySQL driver. Use PreorderUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PreorderUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PreorderCreateBulk is the builder for creating many Preorder entities in bulk.
+type PreorderCreateBulk struct {
+	config
+	err      error
+	builders []*PreorderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Preorder entities in the database.
+func (pcb *PreorderCreateBulk) Save(ctx context.Context) ([]*Preorder, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Preorder, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PreorderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PreorderCreateBulk) SaveX(ctx context.Context) []*Preorder {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PreorderCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PreorderCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Preorder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PreorderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PreorderCreateBulk) OnConflict(opts ...sql.ConflictOption) *PreorderUpsertBulk {
+	pcb.conflict = opts
+	return &PreorderUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Preorder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PreorderCreateBulk) OnConflictColumns(columns ...string) *PreorderUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PreorderUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PreorderUpsertBulk is the builder for "upsert"-ing
+// a bulk of Preorder nodes.
+type PreorderUpsertBulk struct {
+	create *PreorderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Preorder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(preorder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PreorderUpsertBulk) UpdateNewValues() *PreorderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(preorder.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(preorder.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(preorder.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(preorder.FieldStoreID)
+			}
+			if _, exists := b.mutation.CustomerID(); exists {
+				s.SetIgnore(preorder.FieldCustomerID)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(preorder.FieldOrderID)
+			}
+			if _, exists := b.mutation.OrderNo(); exists {
+				s.SetIgnore(preorder.FieldOrderNo)
+			}
+			if _, exists := b.mutation.Fulfilment(); exists {
+				s.SetIgnore(preorder.FieldFulfilment)
+			}
+			if _, exists := b.mutation.ScheduledAt(); exists {
+				s.SetIgnore(preorder.FieldScheduledAt)
+			}
+			if _, exists := b.mutation.ReleaseAt(); exists {
+				s.SetIgnore(preorder.FieldReleaseAt)
+			}
+			if _, exists := b.mutation.ContactName(); exists {
+				s.SetIgnore(preorder.FieldContactName)
+			}
+			if _, exists := b.mutation.ContactPhone(); exists {
+				s.SetIgnore(preorder.FieldContactPhone)
+			}
+			if _, exists := b.mutation.DeliveryAddress(); exists {
+				s.SetIgnore(preorder.FieldDeliveryAddress)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Preorder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PreorderUpsertBulk) Ignore() *PreorderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PreorderUpsertBulk) DoNothing() *PreorderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PreorderCreateBulk.OnConflict
+// documentation for more info.
+func (u *PreorderUpsertBulk) Update(set func(*PreorderUpsert)) *PreorderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PreorderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PreorderUpsertBulk) SetUpdatedAt(v time.Time) *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PreorderUpsertBulk) UpdateUpdatedAt() *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PreorderUpsertBulk) SetStatus(v domain.PreorderStatus) *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PreorderUpsertBulk) UpdateStatus() *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *PreorderUpsertBulk) SetReleasedAt(v time.Time) *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *PreorderUpsertBulk) UpdateReleasedAt() *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *PreorderUpsertBulk) ClearReleasedAt() *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *PreorderUpsertBulk) SetCancelledAt(v time.Time) *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *PreorderUpsertBulk) UpdateCancelledAt() *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *PreorderUpsertBulk) ClearCancelledAt() *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (u *PreorderUpsertBulk) SetCancelReason(v string) *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.SetCancelReason(v)
+	})
+}
+
+// UpdateCancelReason sets the "cancel_reason" field to the value that was provided on create.
+func (u *PreorderUpsertBulk) UpdateCancelReason() *PreorderUpsertBulk {
+	return u.Update(func(s *PreorderUpsert) {
+		s.UpdateCancelReason()
+	})
+}
+
+// Exec executes the query.
+func (u *PreorderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PreorderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PreorderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PreorderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,437 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorder"
+)
+
+// PreorderUpdate is the builder for updating Preorder entities.
+type PreorderUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PreorderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PreorderUpdate builder.
+func (pu *PreorderUpdate) Where(ps ...predicate.Preorder) *PreorderUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PreorderUpdate) SetUpdatedAt(t time.Time) *PreorderUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetStatus sets the "status" field.
+func (pu *PreorderUpdate) SetStatus(ds domain.PreorderStatus) *PreorderUpdate {
+	pu.mutation.SetStatus(ds)
+	return pu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pu *PreorderUpdate) SetNillableStatus(ds *domain.PreorderStatus) *PreorderUpdate {
+	if ds != nil {
+		pu.SetStatus(*ds)
+	}
+	return pu
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (pu *PreorderUpdate) SetReleasedAt(t time.Time) *PreorderUpdate {
+	pu.mutation.SetReleasedAt(t)
+	return pu
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (pu *PreorderUpdate) SetNillableReleasedAt(t *time.Time) *PreorderUpdate {
+	if t != nil {
+		pu.SetReleasedAt(*t)
+	}
+	return pu
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (pu *PreorderUpdate) ClearReleasedAt() *PreorderUpdate {
+	pu.mutation.ClearReleasedAt()
+	return pu
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (pu *PreorderUpdate) SetCancelledAt(t time.Time) *PreorderUpdate {
+	pu.mutation.SetCancelledAt(t)
+	return pu
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (pu *PreorderUpdate) SetNillableCancelledAt(t *time.Time) *PreorderUpdate {
+	if t != nil {
+		pu.SetCancelledAt(*t)
+	}
+	return pu
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (pu *PreorderUpdate) ClearCancelledAt() *PreorderUpdate {
+	pu.mutation.ClearCancelledAt()
+	return pu
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (pu *PreorderUpdate) SetCancelReason(s string) *PreorderUpdate {
+	pu.mutation.SetCancelReason(s)
+	return pu
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (pu *PreorderUpdate) SetNillableCancelReason(s *string) *PreorderUpdate {
+	if s != nil {
+		pu.SetCancelReason(*s)
+	}
+	return pu
+}
+
+// Mutation returns the PreorderMutation object of the builder.
+func (pu *PreorderUpdate) Mutation() *PreorderMutation {
+	return pu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PreorderUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PreorderUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PreorderUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PreorderUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PreorderUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := preorder.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PreorderUpdate) check() error {
+	if v, ok := pu.mutation.Status(); ok {
+		if err := preorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Preorder.status": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.CancelReason(); ok {
+		if err := preorder.CancelReasonValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_reason", err: fmt.Errorf(`ent: validator failed for field "Preorder.cancel_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pu *PreorderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PreorderUpdate {
+	pu.modifiers = append(pu.modifiers, modifiers...)
+	return pu
+}
+
+func (pu *PreorderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(preorder.Table, preorder.Columns, sqlgraph.NewFieldSpec(preorder.FieldID, field.TypeUUID))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(preorder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pu.mutation.Status(); ok {
+		_spec.SetField(preorder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := pu.mutation.ReleasedAt(); ok {
+		_spec.SetField(preorder.FieldReleasedAt, field.TypeTime, value)
+	}
+	if pu.mutation.ReleasedAtCleared() {
+		_spec.ClearField(preorder.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.CancelledAt(); ok {
+		_spec.SetField(preorder.FieldCancelledAt, field.TypeTime, value)
+	}
+	if pu.mutation.CancelledAtCleared() {
+		_spec.ClearField(preorder.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.CancelReason(); ok {
+		_spec.SetField(preorder.FieldCancelReason, field.TypeString, value)
+	}
+	_spec.AddModifiers(pu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{preorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PreorderUpdateOne is the builder for updating a single Preorder entity.
+type PreorderUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PreorderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PreorderUpdateOne) SetUpdatedAt(t time.Time) *PreorderUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetStatus sets the "status" field.
+func (puo *PreorderUpdateOne) SetStatus(ds domain.PreorderStatus) *PreorderUpdateOne {
+	puo.mutation.SetStatus(ds)
+	return puo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (puo *PreorderUpdateOne) SetNillableStatus(ds *domain.PreorderStatus) *PreorderUpdateOne {
+	if ds != nil {
+		puo.SetStatus(*ds)
+	}
+	return puo
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (puo *PreorderUpdateOne) SetReleasedAt(t time.Time) *PreorderUpdateOne {
+	puo.mutation.SetReleasedAt(t)
+	return puo
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (puo *PreorderUpdateOne) SetNillableReleasedAt(t *time.Time) *PreorderUpdateOne {
+	if t != nil {
+		puo.SetReleasedAt(*t)
+	}
+	return puo
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (puo *PreorderUpdateOne) ClearReleasedAt() *PreorderUpdateOne {
+	puo.mutation.ClearReleasedAt()
+	return puo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (puo *PreorderUpdateOne) SetCancelledAt(t time.Time) *PreorderUpdateOne {
+	puo.mutation.SetCancelledAt(t)
+	return puo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (puo *PreorderUpdateOne) SetNillableCancelledAt(t *time.Time) *PreorderUpdateOne {
+	if t != nil {
+		puo.SetCancelledAt(*t)
+	}
+	return puo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (puo *PreorderUpdateOne) ClearCancelledAt() *PreorderUpdateOne {
+	puo.mutation.ClearCancelledAt()
+	return puo
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (puo *PreorderUpdateOne) SetCancelReason(s string) *PreorderUpdateOne {
+	puo.mutation.SetCancelReason(s)
+	return puo
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (puo *PreorderUpdateOne) SetNillableCancelReason(s *string) *PreorderUpdateOne {
+	if s != nil {
+		puo.SetCancelReason(*s)
+	}
+	return puo
+}
+
+// Mutation returns the PreorderMutation object of the builder.
+func (puo *PreorderUpdateOne) Mutation() *PreorderMutation {
+	return puo.mutation
+}
+
+// Where appends a list predicates to the PreorderUpdate builder.
+func (puo *PreorderUpdateOne) Where(ps ...predicate.Preorder) *PreorderUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PreorderUpdateOne) Select(field string, fields ...string) *PreorderUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Preorder entity.
+func (puo *PreorderUpdateOne) Save(ctx context.Context) (*Preorder, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PreorderUpdateOne) SaveX(ctx context.Context) *Preorder {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PreorderUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PreorderUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PreorderUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := preorder.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PreorderUpdateOne) check() error {
+	if v, ok := puo.mutation.Status(); ok {
+		if err := preorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Preorder.status": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.CancelReason(); ok {
+		if err := preorder.CancelReasonValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_reason", err: fmt.Errorf(`ent: validator failed for field "Preorder.cancel_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (puo *PreorderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PreorderUpdateOne {
+	puo.modifiers = append(puo.modifiers, modifiers...)
+	return puo
+}
+
+func (puo *PreorderUpdateOne) sqlSave(ctx context.Context) (_node *Preorder, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(preorder.Table, preorder.Columns, sqlgraph.NewFieldSpec(preorder.FieldID, field.TypeUUID))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Preorder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, preorder.FieldID)
+		for _, f := range fields {
+			if !preorder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != preorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(preorder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := puo.mutation.Status(); ok {
+		_spec.SetField(preorder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := puo.mutation.ReleasedAt(); ok {
+		_spec.SetField(preorder.FieldReleasedAt, field.TypeTime, value)
+	}
+	if puo.mutation.ReleasedAtCleared() {
+		_spec.ClearField(preorder.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.CancelledAt(); ok {
+		_spec.SetField(preorder.FieldCancelledAt, field.TypeTime, value)
+	}
+	if puo.mutation.CancelledAtCleared() {
+		_spec.ClearField(preorder.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.CancelReason(); ok {
+		_spec.SetField(preorder.FieldCancelReason, field.TypeString, value)
+	}
+	_spec.AddModifiers(puo.modifiers...)
+	_node = &Preorder{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{preorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorderrule"
+)
+
+// PreorderRule is the model entity for the PreorderRule schema.
+type PreorderRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 是否开放预点单
+	Enabled bool `json:"enabled,omitempty"`
+	// 是否支持预约配送
+	DeliveryEnabled bool `json:"delivery_enabled,omitempty"`
+	// 时段间隔（分钟）
+	SlotMinutes int `json:"slot_minutes,omitempty"`
+	// 每个时段可接单数
+	SlotCapacity int `json:"slot_capacity,omitempty"`
+	// 需至少提前下单的分钟数
+	LeadMinutes int `json:"lead_minutes,omitempty"`
+	// 提前送厨的分钟数
+	PrepMinutes int `json:"prep_minutes,omitempty"`
+	// 最多可提前预约的天数
+	MaxAdvanceDays int `json:"max_advance_days,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PreorderRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case preorderrule.FieldEnabled, preorderrule.FieldDeliveryEnabled:
+			values[i] = new(sql.NullBool)
+		case preorderrule.FieldSlotMinutes, preorderrule.FieldSlotCapacity, preorderrule.FieldLeadMinutes, preorderrule.FieldPrepMinutes, preorderrule.FieldMaxAdvanceDays:
+			values[i] = new(sql.NullInt64)
+		case preorderrule.FieldCreatedAt, preorderrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case preorderrule.FieldID, preorderrule.FieldMerchantID, preorderrule.FieldStoreID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PreorderRule fields.
+func (pr *PreorderRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case preorderrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pr.ID = *value
+			}
+		case preorderrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case preorderrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		case preorderrule.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				pr.MerchantID = *value
+			}
+		case preorderrule.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				pr.StoreID = *value
+			}
+		case preorderrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				pr.Enabled = value.Bool
+			}
+		case preorderrule.FieldDeliveryEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_enabled", values[i])
+			} else if value.Valid {
+				pr.DeliveryEnabled = value.Bool
+			}
+		case preorderrule.FieldSlotMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field slot_minutes", values[i])
+			} else if value.Valid {
+				pr.SlotMinutes = int(value.Int64)
+			}
+		case preorderrule.FieldSlotCapacity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field slot_capacity", values[i])
+			} else if value.Valid {
+				pr.SlotCapacity = int(value.Int64)
+			}
+		case preorderrule.FieldLeadMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_minutes", values[i])
+			} else if value.Valid {
+				pr.LeadMinutes = int(value.Int64)
+			}
+		case preorderrule.FieldPrepMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prep_minutes", values[i])
+			} else if value.Valid {
+				pr.PrepMinutes = int(value.Int64)
+			}
+		case preorderrule.FieldMaxAdvanceDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_advance_days", values[i])
+			} else if value.Valid {
+				pr.MaxAdvanceDays = int(value.Int64)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PreorderRule.
+// This includes values selected through modifiers, order, etc.
+func (pr *PreorderRule) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PreorderRule.
+// Note that you need to call PreorderRule.Unwrap() before calling this method if this PreorderRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PreorderRule) Update() *PreorderRuleUpdateOne {
+	return NewPreorderRuleClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PreorderRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PreorderRule) Unwrap() *PreorderRule {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PreorderRule is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PreorderRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("PreorderRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("delivery_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", pr.DeliveryEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("slot_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", pr.SlotMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("slot_capacity=")
+	builder.WriteString(fmt.Sprintf("%v", pr.SlotCapacity))
+	builder.WriteString(", ")
+	builder.WriteString("lead_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", pr.LeadMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("prep_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", pr.PrepMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("max_advance_days=")
+	builder.WriteString(fmt.Sprintf("%v", pr.MaxAdvanceDays))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PreorderRules is a parsable slice of PreorderRule.
+type PreorderRules []*PreorderRule
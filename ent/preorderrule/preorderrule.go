@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package preorderrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the preorderrule type in the database.
+	Label = "preorder_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldDeliveryEnabled holds the string denoting the delivery_enabled field in the database.
+	FieldDeliveryEnabled = "delivery_enabled"
+	// FieldSlotMinutes holds the string denoting the slot_minutes field in the database.
+	FieldSlotMinutes = "slot_minutes"
+	// FieldSlotCapacity holds the string denoting the slot_capacity field in the database.
+	FieldSlotCapacity = "slot_capacity"
+	// FieldLeadMinutes holds the string denoting the lead_minutes field in the database.
+	FieldLeadMinutes = "lead_minutes"
+	// FieldPrepMinutes holds the string denoting the prep_minutes field in the database.
+	FieldPrepMinutes = "prep_minutes"
+	// FieldMaxAdvanceDays holds the string denoting the max_advance_days field in the database.
+	FieldMaxAdvanceDays = "max_advance_days"
+	// Table holds the table name of the preorderrule in the database.
+	Table = "preorder_rules"
+)
+
+// Columns holds all SQL columns for preorderrule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldEnabled,
+	FieldDeliveryEnabled,
+	FieldSlotMinutes,
+	FieldSlotCapacity,
+	FieldLeadMinutes,
+	FieldPrepMinutes,
+	FieldMaxAdvanceDays,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultDeliveryEnabled holds the default value on creation for the "delivery_enabled" field.
+	DefaultDeliveryEnabled bool
+	// DefaultLeadMinutes holds the default value on creation for the "lead_minutes" field.
+	DefaultLeadMinutes int
+	// DefaultPrepMinutes holds the default value on creation for the "prep_minutes" field.
+	DefaultPrepMinutes int
+	// DefaultMaxAdvanceDays holds the default value on creation for the "max_advance_days" field.
+	DefaultMaxAdvanceDays int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the PreorderRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByDeliveryEnabled orders the results by the delivery_enabled field.
+func ByDeliveryEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryEnabled, opts...).ToFunc()
+}
+
+// BySlotMinutes orders the results by the slot_minutes field.
+func BySlotMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlotMinutes, opts...).ToFunc()
+}
+
+// BySlotCapacity orders the results by the slot_capacity field.
+func BySlotCapacity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlotCapacity, opts...).ToFunc()
+}
+
+// ByLeadMinutes orders the results by the lead_minutes field.
+func ByLeadMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeadMinutes, opts...).ToFunc()
+}
+
+// ByPrepMinutes orders the results by the prep_minutes field.
+func ByPrepMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrepMinutes, opts...).ToFunc()
+}
+
+// ByMaxAdvanceDays orders the results by the max_advance_days field.
+func ByMaxAdvanceDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAdvanceDays, opts...).ToFunc()
+}
@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package preorderrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldStoreID, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// DeliveryEnabled applies equality check predicate on the "delivery_enabled" field. It's identical to DeliveryEnabledEQ.
+func DeliveryEnabled(v bool) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldDeliveryEnabled, v))
+}
+
+// SlotMinutes applies equality check predicate on the "slot_minutes" field. It's identical to SlotMinutesEQ.
+func SlotMinutes(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldSlotMinutes, v))
+}
+
+// SlotCapacity applies equality check predicate on the "slot_capacity" field. It's identical to SlotCapacityEQ.
+func SlotCapacity(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldSlotCapacity, v))
+}
+
+// LeadMinutes applies equality check predicate on the "lead_minutes" field. It's identical to LeadMinutesEQ.
+func LeadMinutes(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldLeadMinutes, v))
+}
+
+// PrepMinutes applies equality check predicate on the "prep_minutes" field. It's identical to PrepMinutesEQ.
+func PrepMinutes(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldPrepMinutes, v))
+}
+
+// MaxAdvanceDays applies equality check predicate on the "max_advance_days" field. It's identical to MaxAdvanceDaysEQ.
+func MaxAdvanceDays(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldMaxAdvanceDays, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLTE(FieldStoreID, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// DeliveryEnabledEQ applies the EQ predicate on the "delivery_enabled" field.
+func DeliveryEnabledEQ(v bool) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldDeliveryEnabled, v))
+}
+
+// DeliveryEnabledNEQ applies the NEQ predicate on the "delivery_enabled" field.
+func DeliveryEnabledNEQ(v bool) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldDeliveryEnabled, v))
+}
+
+// SlotMinutesEQ applies the EQ predicate on the "slot_minutes" field.
+func SlotMinutesEQ(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldSlotMinutes, v))
+}
+
+// SlotMinutesNEQ applies the NEQ predicate on the "slot_minutes" field.
+func SlotMinutesNEQ(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldSlotMinutes, v))
+}
+
+// SlotMinutesIn applies the In predicate on the "slot_minutes" field.
+func SlotMinutesIn(vs ...int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldIn(FieldSlotMinutes, vs...))
+}
+
+// SlotMinutesNotIn applies the NotIn predicate on the "slot_minutes" field.
+func SlotMinutesNotIn(vs ...int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNotIn(FieldSlotMinutes, vs...))
+}
+
+// SlotMinutesGT applies the GT predicate on the "slot_minutes" field.
+func SlotMinutesGT(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGT(FieldSlotMinutes, v))
+}
+
+// SlotMinutesGTE applies the GTE predicate on the "slot_minutes" field.
+func SlotMinutesGTE(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGTE(FieldSlotMinutes, v))
+}
+
+// SlotMinutesLT applies the LT predicate on the "slot_minutes" field.
+func SlotMinutesLT(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLT(FieldSlotMinutes, v))
+}
+
+// SlotMinutesLTE applies the LTE predicate on the "slot_minutes" field.
+func SlotMinutesLTE(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLTE(FieldSlotMinutes, v))
+}
+
+// SlotCapacityEQ applies the EQ predicate on the "slot_capacity" field.
+func SlotCapacityEQ(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldSlotCapacity, v))
+}
+
+// SlotCapacityNEQ applies the NEQ predicate on the "slot_capacity" field.
+func SlotCapacityNEQ(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldSlotCapacity, v))
+}
+
+// SlotCapacityIn applies the In predicate on the "slot_capacity" field.
+func SlotCapacityIn(vs ...int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldIn(FieldSlotCapacity, vs...))
+}
+
+// SlotCapacityNotIn applies the NotIn predicate on the "slot_capacity" field.
+func SlotCapacityNotIn(vs ...int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNotIn(FieldSlotCapacity, vs...))
+}
+
+// SlotCapacityGT applies the GT predicate on the "slot_capacity" field.
+func SlotCapacityGT(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGT(FieldSlotCapacity, v))
+}
+
+// SlotCapacityGTE applies the GTE predicate on the "slot_capacity" field.
+func SlotCapacityGTE(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGTE(FieldSlotCapacity, v))
+}
+
+// SlotCapacityLT applies the LT predicate on the "slot_capacity" field.
+func SlotCapacityLT(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLT(FieldSlotCapacity, v))
+}
+
+// SlotCapacityLTE applies the LTE predicate on the "slot_capacity" field.
+func SlotCapacityLTE(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLTE(FieldSlotCapacity, v))
+}
+
+// LeadMinutesEQ applies the EQ predicate on the "lead_minutes" field.
+func LeadMinutesEQ(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldLeadMinutes, v))
+}
+
+// LeadMinutesNEQ applies the NEQ predicate on the "lead_minutes" field.
+func LeadMinutesNEQ(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldLeadMinutes, v))
+}
+
+// LeadMinutesIn applies the In predicate on the "lead_minutes" field.
+func LeadMinutesIn(vs ...int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldIn(FieldLeadMinutes, vs...))
+}
+
+// LeadMinutesNotIn applies the NotIn predicate on the "lead_minutes" field.
+func LeadMinutesNotIn(vs ...int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNotIn(FieldLeadMinutes, vs...))
+}
+
+// LeadMinutesGT applies the GT predicate on the "lead_minutes" field.
+func LeadMinutesGT(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGT(FieldLeadMinutes, v))
+}
+
+// LeadMinutesGTE applies the GTE predicate on the "lead_minutes" field.
+func LeadMinutesGTE(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGTE(FieldLeadMinutes, v))
+}
+
+// LeadMinutesLT applies the LT predicate on the "lead_minutes" field.
+func LeadMinutesLT(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLT(FieldLeadMinutes, v))
+}
+
+// LeadMinutesLTE applies the LTE predicate on the "lead_minutes" field.
+func LeadMinutesLTE(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLTE(FieldLeadMinutes, v))
+}
+
+// PrepMinutesEQ applies the EQ predicate on the "prep_minutes" field.
+func PrepMinutesEQ(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldPrepMinutes, v))
+}
+
+// PrepMinutesNEQ applies the NEQ predicate on the "prep_minutes" field.
+func PrepMinutesNEQ(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldPrepMinutes, v))
+}
+
+// PrepMinutesIn applies the In predicate on the "prep_minutes" field.
+func PrepMinutesIn(vs ...int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldIn(FieldPrepMinutes, vs...))
+}
+
+// PrepMinutesNotIn applies the NotIn predicate on the "prep_minutes" field.
+func PrepMinutesNotIn(vs ...int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNotIn(FieldPrepMinutes, vs...))
+}
+
+// PrepMinutesGT applies the GT predicate on the "prep_minutes" field.
+func PrepMinutesGT(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGT(FieldPrepMinutes, v))
+}
+
+// PrepMinutesGTE applies the GTE predicate on the "prep_minutes" field.
+func PrepMinutesGTE(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGTE(FieldPrepMinutes, v))
+}
+
+// PrepMinutesLT applies the LT predicate on the "prep_minutes" field.
+func PrepMinutesLT(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLT(FieldPrepMinutes, v))
+}
+
+// PrepMinutesLTE applies the LTE predicate on the "prep_minutes" field.
+func PrepMinutesLTE(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLTE(FieldPrepMinutes, v))
+}
+
+// MaxAdvanceDaysEQ applies the EQ predicate on the "max_advance_days" field.
+func MaxAdvanceDaysEQ(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldEQ(FieldMaxAdvanceDays, v))
+}
+
+// MaxAdvanceDaysNEQ applies the NEQ predicate on the "max_advance_days" field.
+func MaxAdvanceDaysNEQ(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNEQ(FieldMaxAdvanceDays, v))
+}
+
+// MaxAdvanceDaysIn applies the In predicate on the "max_advance_days" field.
+func MaxAdvanceDaysIn(vs ...int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldIn(FieldMaxAdvanceDays, vs...))
+}
+
+// MaxAdvanceDaysNotIn applies the NotIn predicate on the "max_advance_days" field.
+func MaxAdvanceDaysNotIn(vs ...int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldNotIn(FieldMaxAdvanceDays, vs...))
+}
+
+// MaxAdvanceDaysGT applies the GT predicate on the "max_advance_days" field.
+func MaxAdvanceDaysGT(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGT(FieldMaxAdvanceDays, v))
+}
+
+// MaxAdvanceDaysGTE applies the GTE predicate on the "max_advance_days" field.
+func MaxAdvanceDaysGTE(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldGTE(FieldMaxAdvanceDays, v))
+}
+
+// MaxAdvanceDaysLT applies the LT predicate on the "max_advance_days" field.
+func MaxAdvanceDaysLT(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLT(FieldMaxAdvanceDays, v))
+}
+
+// MaxAdvanceDaysLTE applies the LTE predicate on the "max_advance_days" field.
+func MaxAdvanceDaysLTE(v int) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.FieldLTE(FieldMaxAdvanceDays, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PreorderRule) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PreorderRule) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PreorderRule) predicate.PreorderRule {
+	return predicate.PreorderRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorderrule"
+)
+
+// PreorderRuleCreate is the builder for creating a PreorderRule entity.
+type PreorderRuleCreate struct {
+	config
+	mutation *PreorderRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *PreorderRuleCreate) SetCreatedAt(t time.Time) *PreorderRuleCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prc *PreorderRuleCreate) SetNillableCreatedAt(t *time.Time) *PreorderRuleCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (prc *PreorderRuleCreate) SetUpdatedAt(t time.Time) *PreorderRuleCreate {
+	prc.mutation.SetUpdatedAt(t)
+	return prc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (prc *PreorderRuleCreate) SetNillableUpdatedAt(t *time.Time) *PreorderRuleCreate {
+	if t != nil {
+		prc.SetUpdatedAt(*t)
+	}
+	return prc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (prc *PreorderRuleCreate) SetMerchantID(u uuid.UUID) *PreorderRuleCreate {
+	prc.mutation.SetMerchantID(u)
+	return prc
+}
+
+// SetStoreID sets the "store_id" field.
+func (prc *PreorderRuleCreate) SetStoreID(u uuid.UUID) *PreorderRuleCreate {
+	prc.mutation.SetStoreID(u)
+	return prc
+}
+
+// SetEnabled sets the "enabled" field.
+func (prc *PreorderRuleCreate) SetEnabled(b bool) *PreorderRuleCreate {
+	prc.mutation.SetEnabled(b)
+	return prc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (prc *PreorderRuleCreate) SetNillableEnabled(b *bool) *PreorderRuleCreate {
+	if b != nil {
+		prc.SetEnabled(*b)
+	}
+	return prc
+}
+
+// SetDeliveryEnabled sets the "delivery_enabled" field.
+func (prc *PreorderRuleCreate) SetDeliveryEnabled(b bool) *PreorderRuleCreate {
+	prc.mutation.SetDeliveryEnabled(b)
+	return prc
+}
+
+// SetNillableDeliveryEnabled sets the "delivery_enabled" field if the given value is not nil.
+func (prc *PreorderRuleCreate) SetNillableDeliveryEnabled(b *bool) *PreorderRuleCreate {
+	if b != nil {
+		prc.SetDeliveryEnabled(*b)
+	}
+	return prc
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (prc *PreorderRuleCreate) SetSlotMinutes(i int) *PreorderRuleCreate {
+	prc.mutation.SetSlotMinutes(i)
+	return prc
+}
+
+// SetSlotCapacity sets the "slot_capacity" field.
+func (prc *PreorderRuleCreate) SetSlotCapacity(i int) *PreorderRuleCreate {
+	prc.mutation.SetSlotCapacity(i)
+	return prc
+}
+
+// SetLeadMinutes sets the "lead_minutes" field.
+func (prc *PreorderRuleCreate) SetLeadMinutes(i int) *PreorderRuleCreate {
+	prc.mutation.SetLeadMinutes(i)
+	return prc
+}
+
+// SetNillableLeadMinutes sets the "lead_minutes" field if the given value is not nil.
+func (prc *PreorderRuleCreate) SetNillableLeadMinutes(i *int) *PreorderRuleCreate {
+	if i != nil {
+		prc.SetLeadMinutes(*i)
+	}
+	return prc
+}
+
+// SetPrepMinutes sets the "prep_minutes" field.
+func (prc *PreorderRuleCreate) SetPrepMinutes(i int) *PreorderRuleCreate {
+	prc.mutation.SetPrepMinutes(i)
+	return prc
+}
+
+// SetNillablePrepMinutes sets the "prep_minutes" field if the given value is not nil.
+func (prc *PreorderRuleCreate) SetNillablePrepMinutes(i *int) *PreorderRuleCreate {
+	if i != nil {
+		prc.SetPrepMinutes(*i)
+	}
+	return prc
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (prc *PreorderRuleCreate) SetMaxAdvanceDays(i int) *PreorderRuleCreate {
+	prc.mutation.SetMaxAdvanceDays(i)
+	return prc
+}
+
+// SetNillableMaxAdvanceDays sets the "max_advance_days" field if the given value is not nil.
+func (prc *PreorderRuleCreate) SetNillableMaxAdvanceDays(i *int) *PreorderRuleCreate {
+	if i != nil {
+		prc.SetMaxAdvanceDays(*i)
+	}
+	return prc
+}
+
+// SetID sets the "id" field.
+func (prc *PreorderRuleCreate) SetID(u uuid.UUID) *PreorderRuleCreate {
+	prc.mutation.SetID(u)
+	return prc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (prc *PreorderRuleCreate) SetNillableID(u *uuid.UUID) *PreorderRuleCreate {
+	if u != nil {
+		prc.SetID(*u)
+	}
+	return prc
+}
+
+// Mutation returns the PreorderRuleMutation object of the builder.
+func (prc *PreorderRuleCreate) Mutation() *PreorderRuleMutation {
+	return prc.mutation
+}
+
+// Save creates the PreorderRule in the database.
+func (prc *PreorderRuleCreate) Save(ctx context.Context) (*PreorderRule, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PreorderRuleCreate) SaveX(ctx context.Context) *PreorderRule {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PreorderRuleCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PreorderRuleCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *PreorderRuleCreate) defaults() {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := preorderrule.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		v := preorderrule.DefaultUpdatedAt()
+		prc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := prc.mutation.Enabled(); !ok {
+		v := preorderrule.DefaultEnabled
+		prc.mutation.SetEnabled(v)
+	}
+	if _, ok := prc.mutation.DeliveryEnabled(); !ok {
+		v := preorderrule.DefaultDeliveryEnabled
+		prc.mutation.SetDeliveryEnabled(v)
+	}
+	if _, ok := prc.mutation.LeadMinutes(); !ok {
+		v := preorderrule.DefaultLeadMinutes
+		prc.mutation.SetLeadMinutes(v)
+	}
+	if _, ok := prc.mutation.PrepMinutes(); !ok {
+		v := preorderrule.DefaultPrepMinutes
+		prc.mutation.SetPrepMinutes(v)
+	}
+	if _, ok := prc.mutation.MaxAdvanceDays(); !ok {
+		v := preorderrule.DefaultMaxAdvanceDays
+		prc.mutation.SetMaxAdvanceDays(v)
+	}
+	if _, ok := prc.mutation.ID(); !ok {
+		v := preorderrule.DefaultID()
+		prc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PreorderRuleCreate) check() error {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PreorderRule.created_at"`)}
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PreorderRule.updated_at"`)}
+	}
+	if _, ok := prc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "PreorderRule.merchant_id"`)}
+	}
+	if _, ok := prc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "PreorderRule.store_id"`)}
+	}
+	if _, ok := prc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "PreorderRule.enabled"`)}
+	}
+	if _, ok := prc.mutation.DeliveryEnabled(); !ok {
+		return &ValidationError{Name: "delivery_enabled", err: errors.New(`ent: missing required field "PreorderRule.delivery_enabled"`)}
+	}
+	if _, ok := prc.mutation.SlotMinutes(); !ok {
+		return &ValidationError{Name: "slot_minutes", err: errors.New(`ent: missing required field "PreorderRule.slot_minutes"`)}
+	}
+	if _, ok := prc.mutation.SlotCapacity(); !ok {
+		return &ValidationError{Name: "slot_capacity", err: errors.New(`ent: missing required field "PreorderRule.slot_capacity"`)}
+	}
+	if _, ok := prc.mutation.LeadMinutes(); !ok {
+		return &ValidationError{Name: "lead_minutes", err: errors.New(`ent: missing required field "PreorderRule.lead_minutes"`)}
+	}
+	if _, ok := prc.mutation.PrepMinutes(); !ok {
+		return &ValidationError{Name: "prep_minutes", err: errors.New(`ent: missing required field "PreorderRule.prep_minutes"`)}
+	}
+	if _, ok := prc.mutation.MaxAdvanceDays(); !ok {
+		return &ValidationError{Name: "max_advance_days", err: errors.New(`ent: missing required field "PreorderRule.max_advance_days"`)}
+	}
+	return nil
+}
+
+func (prc *PreorderRuleCreate) sqlSave(ctx context.Context) (*PreorderRule, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *PreorderRuleCreate) createSpec() (*PreorderRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PreorderRule{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(preorderrule.Table, sqlgraph.NewFieldSpec(preorderrule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = prc.conflict
+	if id, ok := prc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(preorderrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := prc.mutation.UpdatedAt(); ok {
+		_spec.SetField(preorderrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := prc.mutation.MerchantID(); ok {
+		_spec.SetField(preorderrule.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := prc.mutation.StoreID(); ok {
+		_spec.SetField(preorderrule.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := prc.mutation.Enabled(); ok {
+		_spec.SetField(preorderrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := prc.mutation.DeliveryEnabled(); ok {
+		_spec.SetField(preorderrule.FieldDeliveryEnabled, field.TypeBool, value)
+		_node.DeliveryEnabled = value
+	}
+	if value, ok := prc.mutation.SlotMinutes(); ok {
+		_spec.SetField(preorderrule.FieldSlotMinutes, field.TypeInt, value)
+		_node.SlotMinutes = value
+	}
+	if value, ok := prc.mutation.SlotCapacity(); ok {
+		_spec.SetField(preorderrule.FieldSlotCapacity, field.TypeInt, value)
+		_node.SlotCapacity = value
+	}
+	if value, ok := prc.mutation.LeadMinutes(); ok {
+		_spec.SetField(preorderrule.FieldLeadMinutes, field.TypeInt, value)
+		_node.LeadMinutes = value
+	}
+	if value, ok := prc.mutation.PrepMinutes(); ok {
+		_spec.SetField(preorderrule.FieldPrepMinutes, field.TypeInt, value)
+		_node.PrepMinutes = value
+	}
+	if value, ok := prc.mutation.MaxAdvanceDays(); ok {
+		_spec.SetField(preorderrule.FieldMaxAdvanceDays, field.TypeInt, value)
+		_node.MaxAdvanceDays = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PreorderRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PreorderRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (prc *PreorderRuleCreate) OnConflict(opts ...sql.ConflictOption) *PreorderRuleUpsertOne {
+	prc.conflict = opts
+	return &PreorderRuleUpsertOne{
+		create: prc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PreorderRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prc *PreorderRuleCreate) OnConflictColumns(columns ...string) *PreorderRuleUpsertOne {
+	prc.conflict = append(prc.conflict, sql.ConflictColumns(columns...))
+	return &PreorderRuleUpsertOne{
+		create: prc,
+	}
+}
+
+type (
+	// PreorderRuleUpsertOne is the builder for "upsert"-ing
+	//  one PreorderRule node.
+	PreorderRuleUpsertOne struct {
+		create *PreorderRuleCreate
+	}
+
+	// PreorderRuleUpsert is the "OnConflict" setter.
+	PreorderRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PreorderRuleUpsert) SetUpdatedAt(v time.Time) *PreorderRuleUpsert {
+	u.Set(preorderrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PreorderRuleUpsert) UpdateUpdatedAt() *PreorderRuleUpsert {
+	u.SetExcluded(preorderrule.FieldUpdatedAt)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PreorderRuleUpsert) SetEnabled(v bool) *PreorderRuleUpsert {
+	u.Set(preorderrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PreorderRuleUpsert) UpdateEnabled() *PreorderRuleUpsert {
+	u.SetExcluded(preorderrule.FieldEnabled)
+	return u
+}
+
+// SetDeliveryEnabled sets the "delivery_enabled" field.
+func (u *PreorderRuleUpsert) SetDeliveryEnabled(v bool) *PreorderRuleUpsert {
+	u.Set(preorderrule.FieldDeliveryEnabled, v)
+	return u
+}
+
+// UpdateDeliveryEnabled sets the "delivery_enabled" field to the value that was provided on create.
+func (u *PreorderRuleUpsert) UpdateDeliveryEnabled() *PreorderRuleUpsert {
+	u.SetExcluded(preorderrule.FieldDeliveryEnabled)
+	return u
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (u *PreorderRuleUpsert) SetSlotMinutes(v int) *PreorderRuleUpsert {
+	u.Set(preorderrule.FieldSlotMinutes, v)
+	return u
+}
+
+// UpdateSlotMinutes sets the "slot_minutes" field to the value that was provided on create.
+func (u *PreorderRuleUpsert) UpdateSlotMinutes() *PreorderRuleUpsert {
+	u.SetExcluded(preorderrule.FieldSlotMinutes)
+	return u
+}
+
+// AddSlotMinutes adds v to the "slot_minutes" field.
+func (u *PreorderRuleUpsert) AddSlotMinutes(v int) *PreorderRuleUpsert {
+	u.Add(preorderrule.FieldSlotMinutes, v)
+	return u
+}
+
+// SetSlotCapacity sets the "slot_capacity" field.
+func (u *PreorderRuleUpsert) SetSlotCapacity(v int) *PreorderRuleUpsert {
+	u.Set(preorderrule.FieldSlotCapacity, v)
+	return u
+}
+
+// UpdateSlotCapacity sets the "slot_capacity" field to the value that was provided on create.
+func (u *PreorderRuleUpsert) UpdateSlotCapacity() *PreorderRuleUpsert {
+	u.SetExcluded(preorderrule.FieldSlotCapacity)
+	return u
+}
+
+// AddSlotCapacity adds v to the "slot_capacity" field.
+func (u *PreorderRuleUpsert) AddSlotCapacity(v int) *PreorderRuleUpsert {
+	u.Add(preorderrule.FieldSlotCapacity, v)
+	return u
+}
+
+// SetLeadMinutes sets the "lead_minutes" field.
+func (u *PreorderRuleUpsert) SetLeadMinutes(v int) *PreorderRuleUpsert {
+	u.Set(preorderrule.FieldLeadMinutes, v)
+	return u
+}
+
+// UpdateLeadMinutes sets the "lead_minutes" field to the value that was provided on create.
+func (u *PreorderRuleUpsert) UpdateLeadMinutes() *PreorderRuleUpsert {
+	u.SetExcluded(preorderrule.FieldLeadMinutes)
+	return u
+}
+
+// AddLeadMinutes adds v to the "lead_minutes" field.
+func (u *PreorderRuleUpsert) AddLeadMinutes(v int) *PreorderRuleUpsert {
+	u.Add(preorderrule.FieldLeadMinutes, v)
+	return u
+}
+
+// SetPrepMinutes sets the "prep_minutes" field.
+func (u *PreorderRuleUpsert) SetPrepMinutes(v int) *PreorderRuleUpsert {
+	u.Set(preorderrule.FieldPrepMinutes, v)
+	return u
+}
+
+// UpdatePrepMinutes sets the "prep_minutes" field to the value that was provided on create.
+func (u *PreorderRuleUpsert) UpdatePrepMinutes() *PreorderRuleUpsert {
+	u.SetExcluded(preorderrule.FieldPrepMinutes)
+	return u
+}
+
+// AddPrepMinutes adds v to the "prep_minutes" field.
+func (u *PreorderRuleUpsert) AddPrepMinutes(v int) *PreorderRuleUpsert {
+	u.Add(preorderrule.FieldPrepMinutes, v)
+	return u
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (u *PreorderRuleUpsert) SetMaxAdvanceDays(v int) *PreorderRuleUpsert {
+	u.Set(preorderrule.FieldMaxAdvanceDays, v)
+	return u
+}
+
+// UpdateMaxAdvanceDays sets the "max_advance_days" field to the value that was provided on create.
+func (u *PreorderRuleUpsert) UpdateMaxAdvanceDays() *PreorderRuleUpsert {
+	u.SetExcluded(preorderrule.FieldMaxAdvanceDays)
+	return u
+}
+
+// AddMaxAdvanceDays adds v to the "max_advance_days" field.
+func (u *PreorderRuleUpsert) AddMaxAdvanceDays(v int) *PreorderRuleUpsert {
+	u.Add(preorderrule.FieldMaxAdvanceDays, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PreorderRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(preorderrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PreorderRuleUpsertOne) UpdateNewValues() *PreorderRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(preorderrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(preorderrule.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(preorderrule.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(preorderrule.FieldStoreID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PreorderRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PreorderRuleUpsertOne) Ignore() *PreorderRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PreorderRuleUpsertOne) DoNothing() *PreorderRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PreorderRuleCreate.OnConflict
+// documentation for more info.
+func (u *PreorderRuleUpsertOne) Update(set func(*PreorderRuleUpsert)) *PreorderRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PreorderRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PreorderRuleUpsertOne) SetUpdatedAt(v time.Time) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PreorderRuleUpsertOne) UpdateUpdatedAt() *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PreorderRuleUpsertOne) SetEnabled(v bool) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PreorderRuleUpsertOne) UpdateEnabled() *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetDeliveryEnabled sets the "delivery_enabled" field.
+func (u *PreorderRuleUpsertOne) SetDeliveryEnabled(v bool) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetDeliveryEnabled(v)
+	})
+}
+
+// UpdateDeliveryEnabled sets the "delivery_enabled" field to the value that was provided on create.
+func (u *PreorderRuleUpsertOne) UpdateDeliveryEnabled() *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateDeliveryEnabled()
+	})
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (u *PreorderRuleUpsertOne) SetSlotMinutes(v int) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetSlotMinutes(v)
+	})
+}
+
+// AddSlotMinutes adds v to the "slot_minutes" field.
+func (u *PreorderRuleUpsertOne) AddSlotMinutes(v int) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.AddSlotMinutes(v)
+	})
+}
+
+// UpdateSlotMinutes sets the "slot_minutes" field to the value that was provided on create.
+func (u *PreorderRuleUpsertOne) UpdateSlotMinutes() *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateSlotMinutes()
+	})
+}
+
+// SetSlotCapacity sets the "slot_capacity" field.
+func (u *PreorderRuleUpsertOne) SetSlotCapacity(v int) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetSlotCapacity(v)
+	})
+}
+
+// AddSlotCapacity adds v to the "slot_capacity" field.
+func (u *PreorderRuleUpsertOne) AddSlotCapacity(v int) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.AddSlotCapacity(v)
+	})
+}
+
+// UpdateSlotCapacity sets the "slot_capacity" field to the value that was provided on create.
+func (u *PreorderRuleUpsertOne) UpdateSlotCapacity() *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateSlotCapacity()
+	})
+}
+
+// SetLeadMinutes sets the "lead_minutes" field.
+func (u *PreorderRuleUpsertOne) SetLeadMinutes(v int) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetLeadMinutes(v)
+	})
+}
+
+// AddLeadMinutes adds v to the "lead_minutes" field.
+func (u *PreorderRuleUpsertOne) AddLeadMinutes(v int) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.AddLeadMinutes(v)
+	})
+}
+
+// UpdateLeadMinutes sets the "lead_minutes" field to the value that was provided on create.
+func (u *PreorderRuleUpsertOne) UpdateLeadMinutes() *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateLeadMinutes()
+	})
+}
+
+// SetPrepMinutes sets the "prep_minutes" field.
+func (u *PreorderRuleUpsertOne) SetPrepMinutes(v int) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetPrepMinutes(v)
+	})
+}
+
+// AddPrepMinutes adds v to the "prep_minutes" field.
+func (u *PreorderRuleUpsertOne) AddPrepMinutes(v int) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.AddPrepMinutes(v)
+	})
+}
+
+// UpdatePrepMinutes sets the "prep_minutes" field to the value that was provided on create.
+func (u *PreorderRuleUpsertOne) UpdatePrepMinutes() *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdatePrepMinutes()
+	})
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (u *PreorderRuleUpsertOne) SetMaxAdvanceDays(v int) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetMaxAdvanceDays(v)
+	})
+}
+
+// AddMaxAdvanceDays adds v to the "max_advance_days" field.
+func (u *PreorderRuleUpsertOne) AddMaxAdvanceDays(v int) *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.AddMaxAdvanceDays(v)
+	})
+}
+
+// UpdateMaxAdvanceDays sets the "max_advance_days" field to the value that was provided on create.
+func (u *PreorderRuleUpsertOne) UpdateMaxAdvanceDays() *PreorderRuleUpsertOne {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateMaxAdvanceDays()
+	})
+}
+
+// Exec executes the query.
+func (u *PreorderRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PreorderRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PreorderRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PreorderRuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PreorderRuleUpsertOne.ID is not supported by MySQL driver. Use PreorderRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PreorderRuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PreorderRuleCreateBulk is the builder for creating many PreorderRule entities in bulk.
+type PreorderRuleCreateBulk struct {
+	config
+	err      error
+	builders []*PreorderRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PreorderRule entities in the database.
+func (prcb *PreorderRuleCreateBulk) Save(ctx context.Context) ([]*PreorderRule, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PreorderRule, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PreorderRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = prcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PreorderRuleCreateBulk) SaveX(ctx context.Context) []*PreorderRule {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PreorderRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PreorderRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PreorderRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PreorderRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (prcb *PreorderRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *PreorderRuleUpsertBulk {
+	prcb.conflict = opts
+	return &PreorderRuleUpsertBulk{
+		create: prcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PreorderRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prcb *PreorderRuleCreateBulk) OnConflictColumns(columns ...string) *PreorderRuleUpsertBulk {
+	prcb.conflict = append(prcb.conflict, sql.ConflictColumns(columns...))
+	return &PreorderRuleUpsertBulk{
+		create: prcb,
+	}
+}
+
+// PreorderRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of PreorderRule nodes.
+type PreorderRuleUpsertBulk struct {
+	create *PreorderRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PreorderRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(preorderrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PreorderRuleUpsertBulk) UpdateNewValues() *PreorderRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(preorderrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(preorderrule.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(preorderrule.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(preorderrule.FieldStoreID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PreorderRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PreorderRuleUpsertBulk) Ignore() *PreorderRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PreorderRuleUpsertBulk) DoNothing() *PreorderRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PreorderRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *PreorderRuleUpsertBulk) Update(set func(*PreorderRuleUpsert)) *PreorderRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PreorderRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PreorderRuleUpsertBulk) SetUpdatedAt(v time.Time) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PreorderRuleUpsertBulk) UpdateUpdatedAt() *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PreorderRuleUpsertBulk) SetEnabled(v bool) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PreorderRuleUpsertBulk) UpdateEnabled() *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetDeliveryEnabled sets the "delivery_enabled" field.
+func (u *PreorderRuleUpsertBulk) SetDeliveryEnabled(v bool) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetDeliveryEnabled(v)
+	})
+}
+
+// UpdateDeliveryEnabled sets the "delivery_enabled" field to the value that was provided on create.
+func (u *PreorderRuleUpsertBulk) UpdateDeliveryEnabled() *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateDeliveryEnabled()
+	})
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (u *PreorderRuleUpsertBulk) SetSlotMinutes(v int) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetSlotMinutes(v)
+	})
+}
+
+// AddSlotMinutes adds v to the "slot_minutes" field.
+func (u *PreorderRuleUpsertBulk) AddSlotMinutes(v int) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.AddSlotMinutes(v)
+	})
+}
+
+// UpdateSlotMinutes sets the "slot_minutes" field to the value that was provided on create.
+func (u *PreorderRuleUpsertBulk) UpdateSlotMinutes() *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateSlotMinutes()
+	})
+}
+
+// SetSlotCapacity sets the "slot_capacity" field.
+func (u *PreorderRuleUpsertBulk) SetSlotCapacity(v int) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetSlotCapacity(v)
+	})
+}
+
+// AddSlotCapacity adds v to the "slot_capacity" field.
+func (u *PreorderRuleUpsertBulk) AddSlotCapacity(v int) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.AddSlotCapacity(v)
+	})
+}
+
+// UpdateSlotCapacity sets the "slot_capacity" field to the value that was provided on create.
+func (u *PreorderRuleUpsertBulk) UpdateSlotCapacity() *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateSlotCapacity()
+	})
+}
+
+// SetLeadMinutes sets the "lead_minutes" field.
+func (u *PreorderRuleUpsertBulk) SetLeadMinutes(v int) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetLeadMinutes(v)
+	})
+}
+
+// AddLeadMinutes adds v to the "lead_minutes" field.
+func (u *PreorderRuleUpsertBulk) AddLeadMinutes(v int) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.AddLeadMinutes(v)
+	})
+}
+
+// UpdateLeadMinutes sets the "lead_minutes" field to the value that was provided on create.
+func (u *PreorderRuleUpsertBulk) UpdateLeadMinutes() *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateLeadMinutes()
+	})
+}
+
+// SetPrepMinutes sets the "prep_minutes" field.
+func (u *PreorderRuleUpsertBulk) SetPrepMinutes(v int) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetPrepMinutes(v)
+	})
+}
+
+// AddPrepMinutes adds v to the "prep_minutes" field.
+func (u *PreorderRuleUpsertBulk) AddPrepMinutes(v int) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.AddPrepMinutes(v)
+	})
+}
+
+// UpdatePrepMinutes sets the "prep_minutes" field to the value that was provided on create.
+func (u *PreorderRuleUpsertBulk) UpdatePrepMinutes() *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdatePrepMinutes()
+	})
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (u *PreorderRuleUpsertBulk) SetMaxAdvanceDays(v int) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.SetMaxAdvanceDays(v)
+	})
+}
+
+// AddMaxAdvanceDays adds v to the "max_advance_days" field.
+func (u *PreorderRuleUpsertBulk) AddMaxAdvanceDays(v int) *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.AddMaxAdvanceDays(v)
+	})
+}
+
+// UpdateMaxAdvanceDays sets the "max_advance_days" field to the value that was provided on create.
+func (u *PreorderRuleUpsertBulk) UpdateMaxAdvanceDays() *PreorderRuleUpsertBulk {
+	return u.Update(func(s *PreorderRuleUpsert) {
+		s.UpdateMaxAdvanceDays()
+	})
+}
+
+// Exec executes the query.
+func (u *PreorderRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PreorderRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PreorderRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PreorderRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
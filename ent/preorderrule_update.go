@@ -0,0 +1,566 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorderrule"
+)
+
+// PreorderRuleUpdate is the builder for updating PreorderRule entities.
+type PreorderRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PreorderRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PreorderRuleUpdate builder.
+func (pru *PreorderRuleUpdate) Where(ps ...predicate.PreorderRule) *PreorderRuleUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pru *PreorderRuleUpdate) SetUpdatedAt(t time.Time) *PreorderRuleUpdate {
+	pru.mutation.SetUpdatedAt(t)
+	return pru
+}
+
+// SetEnabled sets the "enabled" field.
+func (pru *PreorderRuleUpdate) SetEnabled(b bool) *PreorderRuleUpdate {
+	pru.mutation.SetEnabled(b)
+	return pru
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (pru *PreorderRuleUpdate) SetNillableEnabled(b *bool) *PreorderRuleUpdate {
+	if b != nil {
+		pru.SetEnabled(*b)
+	}
+	return pru
+}
+
+// SetDeliveryEnabled sets the "delivery_enabled" field.
+func (pru *PreorderRuleUpdate) SetDeliveryEnabled(b bool) *PreorderRuleUpdate {
+	pru.mutation.SetDeliveryEnabled(b)
+	return pru
+}
+
+// SetNillableDeliveryEnabled sets the "delivery_enabled" field if the given value is not nil.
+func (pru *PreorderRuleUpdate) SetNillableDeliveryEnabled(b *bool) *PreorderRuleUpdate {
+	if b != nil {
+		pru.SetDeliveryEnabled(*b)
+	}
+	return pru
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (pru *PreorderRuleUpdate) SetSlotMinutes(i int) *PreorderRuleUpdate {
+	pru.mutation.ResetSlotMinutes()
+	pru.mutation.SetSlotMinutes(i)
+	return pru
+}
+
+// SetNillableSlotMinutes sets the "slot_minutes" field if the given value is not nil.
+func (pru *PreorderRuleUpdate) SetNillableSlotMinutes(i *int) *PreorderRuleUpdate {
+	if i != nil {
+		pru.SetSlotMinutes(*i)
+	}
+	return pru
+}
+
+// AddSlotMinutes adds i to the "slot_minutes" field.
+func (pru *PreorderRuleUpdate) AddSlotMinutes(i int) *PreorderRuleUpdate {
+	pru.mutation.AddSlotMinutes(i)
+	return pru
+}
+
+// SetSlotCapacity sets the "slot_capacity" field.
+func (pru *PreorderRuleUpdate) SetSlotCapacity(i int) *PreorderRuleUpdate {
+	pru.mutation.ResetSlotCapacity()
+	pru.mutation.SetSlotCapacity(i)
+	return pru
+}
+
+// SetNillableSlotCapacity sets the "slot_capacity" field if the given value is not nil.
+func (pru *PreorderRuleUpdate) SetNillableSlotCapacity(i *int) *PreorderRuleUpdate {
+	if i != nil {
+		pru.SetSlotCapacity(*i)
+	}
+	return pru
+}
+
+// AddSlotCapacity adds i to the "slot_capacity" field.
+func (pru *PreorderRuleUpdate) AddSlotCapacity(i int) *PreorderRuleUpdate {
+	pru.mutation.AddSlotCapacity(i)
+	return pru
+}
+
+// SetLeadMinutes sets the "lead_minutes" field.
+func (pru *PreorderRuleUpdate) SetLeadMinutes(i int) *PreorderRuleUpdate {
+	pru.mutation.ResetLeadMinutes()
+	pru.mutation.SetLeadMinutes(i)
+	return pru
+}
+
+// SetNillableLeadMinutes sets the "lead_minutes" field if the given value is not nil.
+func (pru *PreorderRuleUpdate) SetNillableLeadMinutes(i *int) *PreorderRuleUpdate {
+	if i != nil {
+		pru.SetLeadMinutes(*i)
+	}
+	return pru
+}
+
+// AddLeadMinutes adds i to the "lead_minutes" field.
+func (pru *PreorderRuleUpdate) AddLeadMinutes(i int) *PreorderRuleUpdate {
+	pru.mutation.AddLeadMinutes(i)
+	return pru
+}
+
+// SetPrepMinutes sets the "prep_minutes" field.
+func (pru *PreorderRuleUpdate) SetPrepMinutes(i int) *PreorderRuleUpdate {
+	pru.mutation.ResetPrepMinutes()
+	pru.mutation.SetPrepMinutes(i)
+	return pru
+}
+
+// SetNillablePrepMinutes sets the "prep_minutes" field if the given value is not nil.
+func (pru *PreorderRuleUpdate) SetNillablePrepMinutes(i *int) *PreorderRuleUpdate {
+	if i != nil {
+		pru.SetPrepMinutes(*i)
+	}
+	return pru
+}
+
+// AddPrepMinutes adds i to the "prep_minutes" field.
+func (pru *PreorderRuleUpdate) AddPrepMinutes(i int) *PreorderRuleUpdate {
+	pru.mutation.AddPrepMinutes(i)
+	return pru
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (pru *PreorderRuleUpdate) SetMaxAdvanceDays(i int) *PreorderRuleUpdate {
+	pru.mutation.ResetMaxAdvanceDays()
+	pru.mutation.SetMaxAdvanceDays(i)
+	return pru
+}
+
+// SetNillableMaxAdvanceDays sets the "max_advance_days" field if the given value is not nil.
+func (pru *PreorderRuleUpdate) SetNillableMaxAdvanceDays(i *int) *PreorderRuleUpdate {
+	if i != nil {
+		pru.SetMaxAdvanceDays(*i)
+	}
+	return pru
+}
+
+// AddMaxAdvanceDays adds i to the "max_advance_days" field.
+func (pru *PreorderRuleUpdate) AddMaxAdvanceDays(i int) *PreorderRuleUpdate {
+	pru.mutation.AddMaxAdvanceDays(i)
+	return pru
+}
+
+// Mutation returns the PreorderRuleMutation object of the builder.
+func (pru *PreorderRuleUpdate) Mutation() *PreorderRuleMutation {
+	return pru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PreorderRuleUpdate) Save(ctx context.Context) (int, error) {
+	pru.defaults()
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PreorderRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PreorderRuleUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PreorderRuleUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pru *PreorderRuleUpdate) defaults() {
+	if _, ok := pru.mutation.UpdatedAt(); !ok {
+		v := preorderrule.UpdateDefaultUpdatedAt()
+		pru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pru *PreorderRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PreorderRuleUpdate {
+	pru.modifiers = append(pru.modifiers, modifiers...)
+	return pru
+}
+
+func (pru *PreorderRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(preorderrule.Table, preorderrule.Columns, sqlgraph.NewFieldSpec(preorderrule.FieldID, field.TypeUUID))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.UpdatedAt(); ok {
+		_spec.SetField(preorderrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pru.mutation.Enabled(); ok {
+		_spec.SetField(preorderrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := pru.mutation.DeliveryEnabled(); ok {
+		_spec.SetField(preorderrule.FieldDeliveryEnabled, field.TypeBool, value)
+	}
+	if value, ok := pru.mutation.SlotMinutes(); ok {
+		_spec.SetField(preorderrule.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedSlotMinutes(); ok {
+		_spec.AddField(preorderrule.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.SlotCapacity(); ok {
+		_spec.SetField(preorderrule.FieldSlotCapacity, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedSlotCapacity(); ok {
+		_spec.AddField(preorderrule.FieldSlotCapacity, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.LeadMinutes(); ok {
+		_spec.SetField(preorderrule.FieldLeadMinutes, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedLeadMinutes(); ok {
+		_spec.AddField(preorderrule.FieldLeadMinutes, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.PrepMinutes(); ok {
+		_spec.SetField(preorderrule.FieldPrepMinutes, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedPrepMinutes(); ok {
+		_spec.AddField(preorderrule.FieldPrepMinutes, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.MaxAdvanceDays(); ok {
+		_spec.SetField(preorderrule.FieldMaxAdvanceDays, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedMaxAdvanceDays(); ok {
+		_spec.AddField(preorderrule.FieldMaxAdvanceDays, field.TypeInt, value)
+	}
+	_spec.AddModifiers(pru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{preorderrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PreorderRuleUpdateOne is the builder for updating a single PreorderRule entity.
+type PreorderRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PreorderRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pruo *PreorderRuleUpdateOne) SetUpdatedAt(t time.Time) *PreorderRuleUpdateOne {
+	pruo.mutation.SetUpdatedAt(t)
+	return pruo
+}
+
+// SetEnabled sets the "enabled" field.
+func (pruo *PreorderRuleUpdateOne) SetEnabled(b bool) *PreorderRuleUpdateOne {
+	pruo.mutation.SetEnabled(b)
+	return pruo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (pruo *PreorderRuleUpdateOne) SetNillableEnabled(b *bool) *PreorderRuleUpdateOne {
+	if b != nil {
+		pruo.SetEnabled(*b)
+	}
+	return pruo
+}
+
+// SetDeliveryEnabled sets the "delivery_enabled" field.
+func (pruo *PreorderRuleUpdateOne) SetDeliveryEnabled(b bool) *PreorderRuleUpdateOne {
+	pruo.mutation.SetDeliveryEnabled(b)
+	return pruo
+}
+
+// SetNillableDeliveryEnabled sets the "delivery_enabled" field if the given value is not nil.
+func (pruo *PreorderRuleUpdateOne) SetNillableDeliveryEnabled(b *bool) *PreorderRuleUpdateOne {
+	if b != nil {
+		pruo.SetDeliveryEnabled(*b)
+	}
+	return pruo
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (pruo *PreorderRuleUpdateOne) SetSlotMinutes(i int) *PreorderRuleUpdateOne {
+	pruo.mutation.ResetSlotMinutes()
+	pruo.mutation.SetSlotMinutes(i)
+	return pruo
+}
+
+// SetNillableSlotMinutes sets the "slot_minutes" field if the given value is not nil.
+func (pruo *PreorderRuleUpdateOne) SetNillableSlotMinutes(i *int) *PreorderRuleUpdateOne {
+	if i != nil {
+		pruo.SetSlotMinutes(*i)
+	}
+	return pruo
+}
+
+// AddSlotMinutes adds i to the "slot_minutes" field.
+func (pruo *PreorderRuleUpdateOne) AddSlotMinutes(i int) *PreorderRuleUpdateOne {
+	pruo.mutation.AddSlotMinutes(i)
+	return pruo
+}
+
+// SetSlotCapacity sets the "slot_capacity" field.
+func (pruo *PreorderRuleUpdateOne) SetSlotCapacity(i int) *PreorderRuleUpdateOne {
+	pruo.mutation.ResetSlotCapacity()
+	pruo.mutation.SetSlotCapacity(i)
+	return pruo
+}
+
+// SetNillableSlotCapacity sets the "slot_capacity" field if the given value is not nil.
+func (pruo *PreorderRuleUpdateOne) SetNillableSlotCapacity(i *int) *PreorderRuleUpdateOne {
+	if i != nil {
+		pruo.SetSlotCapacity(*i)
+	}
+	return pruo
+}
+
+// AddSlotCapacity adds i to the "slot_capacity" field.
+func (pruo *PreorderRuleUpdateOne) AddSlotCapacity(i int) *PreorderRuleUpdateOne {
+	pruo.mutation.AddSlotCapacity(i)
+	return pruo
+}
+
+// SetLeadMinutes sets the "lead_minutes" field.
+func (pruo *PreorderRuleUpdateOne) SetLeadMinutes(i int) *PreorderRuleUpdateOne {
+	pruo.mutation.ResetLeadMinutes()
+	pruo.mutation.SetLeadMinutes(i)
+	return pruo
+}
+
+// SetNillableLeadMinutes sets the "lead_minutes" field if the given value is not nil.
+func (pruo *PreorderRuleUpdateOne) SetNillableLeadMinutes(i *int) *PreorderRuleUpdateOne {
+	if i != nil {
+		pruo.SetLeadMinutes(*i)
+	}
+	return pruo
+}
+
+// AddLeadMinutes adds i to the "lead_minutes" field.
+func (pruo *PreorderRuleUpdateOne) AddLeadMinutes(i int) *PreorderRuleUpdateOne {
+	pruo.mutation.AddLeadMinutes(i)
+	return pruo
+}
+
+// SetPrepMinutes sets the "prep_minutes" field.
+func (pruo *PreorderRuleUpdateOne) SetPrepMinutes(i int) *PreorderRuleUpdateOne {
+	pruo.mutation.ResetPrepMinutes()
+	pruo.mutation.SetPrepMinutes(i)
+	return pruo
+}
+
+// SetNillablePrepMinutes sets the "prep_minutes" field if the given value is not nil.
+func (pruo *PreorderRuleUpdateOne) SetNillablePrepMinutes(i *int) *PreorderRuleUpdateOne {
+	if i != nil {
+		pruo.SetPrepMinutes(*i)
+	}
+	return pruo
+}
+
+// AddPrepMinutes adds i to the "prep_minutes" field.
+func (pruo *PreorderRuleUpdateOne) AddPrepMinutes(i int) *PreorderRuleUpdateOne {
+	pruo.mutation.AddPrepMinutes(i)
+	return pruo
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (pruo *PreorderRuleUpdateOne) SetMaxAdvanceDays(i int) *PreorderRuleUpdateOne {
+	pruo.mutation.ResetMaxAdvanceDays()
+	pruo.mutation.SetMaxAdvanceDays(i)
+	return pruo
+}
+
+// SetNillableMaxAdvanceDays sets the "max_advance_days" field if the given value is not nil.
+func (pruo *PreorderRuleUpdateOne) SetNillableMaxAdvanceDays(i *int) *PreorderRuleUpdateOne {
+	if i != nil {
+		pruo.SetMaxAdvanceDays(*i)
+	}
+	return pruo
+}
+
+// AddMaxAdvanceDays adds i to the "max_advance_days" field.
+func (pruo *PreorderRuleUpdateOne) AddMaxAdvanceDays(i int) *PreorderRuleUpdateOne {
+	pruo.mutation.AddMaxAdvanceDays(i)
+	return pruo
+}
+
+// Mutation returns the PreorderRuleMutation object of the builder.
+func (pruo *PreorderRuleUpdateOne) Mutation() *PreorderRuleMutation {
+	return pruo.mutation
+}
+
+// Where appends a list predicates to the PreorderRuleUpdate builder.
+func (pruo *PreorderRuleUpdateOne) Where(ps ...predicate.PreorderRule) *PreorderRuleUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PreorderRuleUpdateOne) Select(field string, fields ...string) *PreorderRuleUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PreorderRule entity.
+func (pruo *PreorderRuleUpdateOne) Save(ctx context.Context) (*PreorderRule, error) {
+	pruo.defaults()
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PreorderRuleUpdateOne) SaveX(ctx context.Context) *PreorderRule {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PreorderRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PreorderRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pruo *PreorderRuleUpdateOne) defaults() {
+	if _, ok := pruo.mutation.UpdatedAt(); !ok {
+		v := preorderrule.UpdateDefaultUpdatedAt()
+		pruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pruo *PreorderRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PreorderRuleUpdateOne {
+	pruo.modifiers = append(pruo.modifiers, modifiers...)
+	return pruo
+}
+
+func (pruo *PreorderRuleUpdateOne) sqlSave(ctx context.Context) (_node *PreorderRule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(preorderrule.Table, preorderrule.Columns, sqlgraph.NewFieldSpec(preorderrule.FieldID, field.TypeUUID))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PreorderRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, preorderrule.FieldID)
+		for _, f := range fields {
+			if !preorderrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != preorderrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(preorderrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pruo.mutation.Enabled(); ok {
+		_spec.SetField(preorderrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := pruo.mutation.DeliveryEnabled(); ok {
+		_spec.SetField(preorderrule.FieldDeliveryEnabled, field.TypeBool, value)
+	}
+	if value, ok := pruo.mutation.SlotMinutes(); ok {
+		_spec.SetField(preorderrule.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedSlotMinutes(); ok {
+		_spec.AddField(preorderrule.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.SlotCapacity(); ok {
+		_spec.SetField(preorderrule.FieldSlotCapacity, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedSlotCapacity(); ok {
+		_spec.AddField(preorderrule.FieldSlotCapacity, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.LeadMinutes(); ok {
+		_spec.SetField(preorderrule.FieldLeadMinutes, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedLeadMinutes(); ok {
+		_spec.AddField(preorderrule.FieldLeadMinutes, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.PrepMinutes(); ok {
+		_spec.SetField(preorderrule.FieldPrepMinutes, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedPrepMinutes(); ok {
+		_spec.AddField(preorderrule.FieldPrepMinutes, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.MaxAdvanceDays(); ok {
+		_spec.SetField(preorderrule.FieldMaxAdvanceDays, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedMaxAdvanceDays(); ok {
+		_spec.AddField(preorderrule.FieldMaxAdvanceDays, field.TypeInt, value)
+	}
+	_spec.AddModifiers(pruo.modifiers...)
+	_node = &PreorderRule{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{preorderrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
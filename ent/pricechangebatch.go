@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+)
+
+// PriceChangeBatch is the model entity for the PriceChangeBatch schema.
+type PriceChangeBatch struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// 调价批次名称
+	Name string `json:"name,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID（品牌商创建时为空）
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 菜单价格适用门店ID列表
+	StoreIds []uuid.UUID `json:"store_ids,omitempty"`
+	// 生效时间
+	EffectiveAt time.Time `json:"effective_at,omitempty"`
+	// 状态：pending（待生效）、applied（已生效）、cancelled（已取消）、rolled_back（已回滚）、failed（执行失败）
+	Status domain.PriceChangeBatchStatus `json:"status,omitempty"`
+	// 备注
+	Remark string `json:"remark,omitempty"`
+	// 执行失败原因
+	FailReason string `json:"fail_reason,omitempty"`
+	// 实际生效时间
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+	// 创建人ID
+	OperatorID uuid.UUID `json:"operator_id,omitempty"`
+	// 创建人名称
+	OperatorName string `json:"operator_name,omitempty"`
+	// 操作日志
+	OperationLogs []domain.PriceChangeOperationLog `json:"operation_logs,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PriceChangeBatchQuery when eager-loading is set.
+	Edges        PriceChangeBatchEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PriceChangeBatchEdges holds the relations/edges for other nodes in the graph.
+type PriceChangeBatchEdges struct {
+	// 调价明细
+	Items []*PriceChangeItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e PriceChangeBatchEdges) ItemsOrErr() ([]*PriceChangeItem, error) {
+	if e.loadedTypes[0] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PriceChangeBatch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pricechangebatch.FieldStoreIds, pricechangebatch.FieldOperationLogs:
+			values[i] = new([]byte)
+		case pricechangebatch.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case pricechangebatch.FieldName, pricechangebatch.FieldStatus, pricechangebatch.FieldRemark, pricechangebatch.FieldFailReason, pricechangebatch.FieldOperatorName:
+			values[i] = new(sql.NullString)
+		case pricechangebatch.FieldCreatedAt, pricechangebatch.FieldUpdatedAt, pricechangebatch.FieldEffectiveAt, pricechangebatch.FieldAppliedAt:
+			values[i] = new(sql.NullTime)
+		case pricechangebatch.FieldID, pricechangebatch.FieldMerchantID, pricechangebatch.FieldStoreID, pricechangebatch.FieldOperatorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PriceChangeBatch fields.
+func (pcb *PriceChangeBatch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pricechangebatch.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pcb.ID = *value
+			}
+		case pricechangebatch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pcb.CreatedAt = value.Time
+			}
+		case pricechangebatch.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pcb.UpdatedAt = value.Time
+			}
+		case pricechangebatch.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pcb.DeletedAt = value.Int64
+			}
+		case pricechangebatch.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pcb.Name = value.String
+			}
+		case pricechangebatch.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				pcb.MerchantID = *value
+			}
+		case pricechangebatch.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				pcb.StoreID = *value
+			}
+		case pricechangebatch.FieldStoreIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field store_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pcb.StoreIds); err != nil {
+					return fmt.Errorf("unmarshal field store_ids: %w", err)
+				}
+			}
+		case pricechangebatch.FieldEffectiveAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_at", values[i])
+			} else if value.Valid {
+				pcb.EffectiveAt = value.Time
+			}
+		case pricechangebatch.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pcb.Status = domain.PriceChangeBatchStatus(value.String)
+			}
+		case pricechangebatch.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				pcb.Remark = value.String
+			}
+		case pricechangebatch.FieldFailReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fail_reason", values[i])
+			} else if value.Valid {
+				pcb.FailReason = value.String
+			}
+		case pricechangebatch.FieldAppliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_at", values[i])
+			} else if value.Valid {
+				pcb.AppliedAt = new(time.Time)
+				*pcb.AppliedAt = value.Time
+			}
+		case pricechangebatch.FieldOperatorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value != nil {
+				pcb.OperatorID = *value
+			}
+		case pricechangebatch.FieldOperatorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_name", values[i])
+			} else if value.Valid {
+				pcb.OperatorName = value.String
+			}
+		case pricechangebatch.FieldOperationLogs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field operation_logs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pcb.OperationLogs); err != nil {
+					return fmt.Errorf("unmarshal field operation_logs: %w", err)
+				}
+			}
+		default:
+			pcb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PriceChangeBatch.
+// This includes values selected through modifiers, order, etc.
+func (pcb *PriceChangeBatch) Value(name string) (ent.Value, error) {
+	return pcb.selectValues.Get(name)
+}
+
+// QueryItems queries the "items" edge of the PriceChangeBatch entity.
+func (pcb *PriceChangeBatch) QueryItems() *PriceChangeItemQuery {
+	return NewPriceChangeBatchClient(pcb.config).QueryItems(pcb)
+}
+
+// Update returns a builder for updating this PriceChangeBatch.
+// Note that you need to call PriceChangeBatch.Unwrap() before calling this method if this PriceChangeBatch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pcb *PriceChangeBatch) Update() *PriceChangeBatchUpdateOne {
+	return NewPriceChangeBatchClient(pcb.config).UpdateOne(pcb)
+}
+
+// Unwrap unwraps the PriceChangeBatch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pcb *PriceChangeBatch) Unwrap() *PriceChangeBatch {
+	_tx, ok := pcb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PriceChangeBatch is not a transactional entity")
+	}
+	pcb.config.driver = _tx.drv
+	return pcb
+}
+
+// String implements the fmt.Stringer.
+func (pcb *PriceChangeBatch) String() string {
+	var builder strings.Builder
+	builder.WriteString("PriceChangeBatch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pcb.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pcb.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pcb.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", pcb.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pcb.Name)
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pcb.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", pcb.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("store_ids=")
+	builder.WriteString(fmt.Sprintf("%v", pcb.StoreIds))
+	builder.WriteString(", ")
+	builder.WriteString("effective_at=")
+	builder.WriteString(pcb.EffectiveAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", pcb.Status))
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(pcb.Remark)
+	builder.WriteString(", ")
+	builder.WriteString("fail_reason=")
+	builder.WriteString(pcb.FailReason)
+	builder.WriteString(", ")
+	if v := pcb.AppliedAt; v != nil {
+		builder.WriteString("applied_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("operator_id=")
+	builder.WriteString(fmt.Sprintf("%v", pcb.OperatorID))
+	builder.WriteString(", ")
+	builder.WriteString("operator_name=")
+	builder.WriteString(pcb.OperatorName)
+	builder.WriteString(", ")
+	builder.WriteString("operation_logs=")
+	builder.WriteString(fmt.Sprintf("%v", pcb.OperationLogs))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PriceChangeBatches is a parsable slice of PriceChangeBatch.
+type PriceChangeBatches []*PriceChangeBatch
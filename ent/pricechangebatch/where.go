@@ -0,0 +1,796 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pricechangebatch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldName, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldStoreID, v))
+}
+
+// EffectiveAt applies equality check predicate on the "effective_at" field. It's identical to EffectiveAtEQ.
+func EffectiveAt(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldEffectiveAt, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldRemark, v))
+}
+
+// FailReason applies equality check predicate on the "fail_reason" field. It's identical to FailReasonEQ.
+func FailReason(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldFailReason, v))
+}
+
+// AppliedAt applies equality check predicate on the "applied_at" field. It's identical to AppliedAtEQ.
+func AppliedAt(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// OperatorID applies equality check predicate on the "operator_id" field. It's identical to OperatorIDEQ.
+func OperatorID(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorName applies equality check predicate on the "operator_name" field. It's identical to OperatorNameEQ.
+func OperatorName(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldOperatorName, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldContainsFold(FieldName, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldStoreID, v))
+}
+
+// StoreIdsIsNil applies the IsNil predicate on the "store_ids" field.
+func StoreIdsIsNil() predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIsNull(FieldStoreIds))
+}
+
+// StoreIdsNotNil applies the NotNil predicate on the "store_ids" field.
+func StoreIdsNotNil() predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotNull(FieldStoreIds))
+}
+
+// EffectiveAtEQ applies the EQ predicate on the "effective_at" field.
+func EffectiveAtEQ(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldEffectiveAt, v))
+}
+
+// EffectiveAtNEQ applies the NEQ predicate on the "effective_at" field.
+func EffectiveAtNEQ(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldEffectiveAt, v))
+}
+
+// EffectiveAtIn applies the In predicate on the "effective_at" field.
+func EffectiveAtIn(vs ...time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldEffectiveAt, vs...))
+}
+
+// EffectiveAtNotIn applies the NotIn predicate on the "effective_at" field.
+func EffectiveAtNotIn(vs ...time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldEffectiveAt, vs...))
+}
+
+// EffectiveAtGT applies the GT predicate on the "effective_at" field.
+func EffectiveAtGT(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldEffectiveAt, v))
+}
+
+// EffectiveAtGTE applies the GTE predicate on the "effective_at" field.
+func EffectiveAtGTE(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldEffectiveAt, v))
+}
+
+// EffectiveAtLT applies the LT predicate on the "effective_at" field.
+func EffectiveAtLT(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldEffectiveAt, v))
+}
+
+// EffectiveAtLTE applies the LTE predicate on the "effective_at" field.
+func EffectiveAtLTE(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldEffectiveAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v domain.PriceChangeBatchStatus) predicate.PriceChangeBatch {
+	vc := v
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v domain.PriceChangeBatchStatus) predicate.PriceChangeBatch {
+	vc := v
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...domain.PriceChangeBatchStatus) predicate.PriceChangeBatch {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...domain.PriceChangeBatchStatus) predicate.PriceChangeBatch {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// FailReasonEQ applies the EQ predicate on the "fail_reason" field.
+func FailReasonEQ(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldFailReason, v))
+}
+
+// FailReasonNEQ applies the NEQ predicate on the "fail_reason" field.
+func FailReasonNEQ(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldFailReason, v))
+}
+
+// FailReasonIn applies the In predicate on the "fail_reason" field.
+func FailReasonIn(vs ...string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldFailReason, vs...))
+}
+
+// FailReasonNotIn applies the NotIn predicate on the "fail_reason" field.
+func FailReasonNotIn(vs ...string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldFailReason, vs...))
+}
+
+// FailReasonGT applies the GT predicate on the "fail_reason" field.
+func FailReasonGT(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldFailReason, v))
+}
+
+// FailReasonGTE applies the GTE predicate on the "fail_reason" field.
+func FailReasonGTE(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldFailReason, v))
+}
+
+// FailReasonLT applies the LT predicate on the "fail_reason" field.
+func FailReasonLT(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldFailReason, v))
+}
+
+// FailReasonLTE applies the LTE predicate on the "fail_reason" field.
+func FailReasonLTE(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldFailReason, v))
+}
+
+// FailReasonContains applies the Contains predicate on the "fail_reason" field.
+func FailReasonContains(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldContains(FieldFailReason, v))
+}
+
+// FailReasonHasPrefix applies the HasPrefix predicate on the "fail_reason" field.
+func FailReasonHasPrefix(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldHasPrefix(FieldFailReason, v))
+}
+
+// FailReasonHasSuffix applies the HasSuffix predicate on the "fail_reason" field.
+func FailReasonHasSuffix(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldHasSuffix(FieldFailReason, v))
+}
+
+// FailReasonEqualFold applies the EqualFold predicate on the "fail_reason" field.
+func FailReasonEqualFold(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEqualFold(FieldFailReason, v))
+}
+
+// FailReasonContainsFold applies the ContainsFold predicate on the "fail_reason" field.
+func FailReasonContainsFold(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldContainsFold(FieldFailReason, v))
+}
+
+// AppliedAtEQ applies the EQ predicate on the "applied_at" field.
+func AppliedAtEQ(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtNEQ applies the NEQ predicate on the "applied_at" field.
+func AppliedAtNEQ(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtIn applies the In predicate on the "applied_at" field.
+func AppliedAtIn(vs ...time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtNotIn applies the NotIn predicate on the "applied_at" field.
+func AppliedAtNotIn(vs ...time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtGT applies the GT predicate on the "applied_at" field.
+func AppliedAtGT(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldAppliedAt, v))
+}
+
+// AppliedAtGTE applies the GTE predicate on the "applied_at" field.
+func AppliedAtGTE(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldAppliedAt, v))
+}
+
+// AppliedAtLT applies the LT predicate on the "applied_at" field.
+func AppliedAtLT(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldAppliedAt, v))
+}
+
+// AppliedAtLTE applies the LTE predicate on the "applied_at" field.
+func AppliedAtLTE(v time.Time) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldAppliedAt, v))
+}
+
+// AppliedAtIsNil applies the IsNil predicate on the "applied_at" field.
+func AppliedAtIsNil() predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIsNull(FieldAppliedAt))
+}
+
+// AppliedAtNotNil applies the NotNil predicate on the "applied_at" field.
+func AppliedAtNotNil() predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotNull(FieldAppliedAt))
+}
+
+// OperatorIDEQ applies the EQ predicate on the "operator_id" field.
+func OperatorIDEQ(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorIDNEQ applies the NEQ predicate on the "operator_id" field.
+func OperatorIDNEQ(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldOperatorID, v))
+}
+
+// OperatorIDIn applies the In predicate on the "operator_id" field.
+func OperatorIDIn(vs ...uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDNotIn applies the NotIn predicate on the "operator_id" field.
+func OperatorIDNotIn(vs ...uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDGT applies the GT predicate on the "operator_id" field.
+func OperatorIDGT(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldOperatorID, v))
+}
+
+// OperatorIDGTE applies the GTE predicate on the "operator_id" field.
+func OperatorIDGTE(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldOperatorID, v))
+}
+
+// OperatorIDLT applies the LT predicate on the "operator_id" field.
+func OperatorIDLT(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldOperatorID, v))
+}
+
+// OperatorIDLTE applies the LTE predicate on the "operator_id" field.
+func OperatorIDLTE(v uuid.UUID) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldOperatorID, v))
+}
+
+// OperatorNameEQ applies the EQ predicate on the "operator_name" field.
+func OperatorNameEQ(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEQ(FieldOperatorName, v))
+}
+
+// OperatorNameNEQ applies the NEQ predicate on the "operator_name" field.
+func OperatorNameNEQ(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNEQ(FieldOperatorName, v))
+}
+
+// OperatorNameIn applies the In predicate on the "operator_name" field.
+func OperatorNameIn(vs ...string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIn(FieldOperatorName, vs...))
+}
+
+// OperatorNameNotIn applies the NotIn predicate on the "operator_name" field.
+func OperatorNameNotIn(vs ...string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotIn(FieldOperatorName, vs...))
+}
+
+// OperatorNameGT applies the GT predicate on the "operator_name" field.
+func OperatorNameGT(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGT(FieldOperatorName, v))
+}
+
+// OperatorNameGTE applies the GTE predicate on the "operator_name" field.
+func OperatorNameGTE(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldGTE(FieldOperatorName, v))
+}
+
+// OperatorNameLT applies the LT predicate on the "operator_name" field.
+func OperatorNameLT(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLT(FieldOperatorName, v))
+}
+
+// OperatorNameLTE applies the LTE predicate on the "operator_name" field.
+func OperatorNameLTE(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldLTE(FieldOperatorName, v))
+}
+
+// OperatorNameContains applies the Contains predicate on the "operator_name" field.
+func OperatorNameContains(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldContains(FieldOperatorName, v))
+}
+
+// OperatorNameHasPrefix applies the HasPrefix predicate on the "operator_name" field.
+func OperatorNameHasPrefix(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldHasPrefix(FieldOperatorName, v))
+}
+
+// OperatorNameHasSuffix applies the HasSuffix predicate on the "operator_name" field.
+func OperatorNameHasSuffix(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldHasSuffix(FieldOperatorName, v))
+}
+
+// OperatorNameEqualFold applies the EqualFold predicate on the "operator_name" field.
+func OperatorNameEqualFold(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldEqualFold(FieldOperatorName, v))
+}
+
+// OperatorNameContainsFold applies the ContainsFold predicate on the "operator_name" field.
+func OperatorNameContainsFold(v string) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldContainsFold(FieldOperatorName, v))
+}
+
+// OperationLogsIsNil applies the IsNil predicate on the "operation_logs" field.
+func OperationLogsIsNil() predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldIsNull(FieldOperationLogs))
+}
+
+// OperationLogsNotNil applies the NotNil predicate on the "operation_logs" field.
+func OperationLogsNotNil() predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.FieldNotNull(FieldOperationLogs))
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.PriceChangeItem) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PriceChangeBatch) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PriceChangeBatch) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PriceChangeBatch) predicate.PriceChangeBatch {
+	return predicate.PriceChangeBatch(sql.NotPredicates(p))
+}
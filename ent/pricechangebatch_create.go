@@ -0,0 +1,1397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
+)
+
+// PriceChangeBatchCreate is the builder for creating a PriceChangeBatch entity.
+type PriceChangeBatchCreate struct {
+	config
+	mutation *PriceChangeBatchMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pcbc *PriceChangeBatchCreate) SetCreatedAt(t time.Time) *PriceChangeBatchCreate {
+	pcbc.mutation.SetCreatedAt(t)
+	return pcbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pcbc *PriceChangeBatchCreate) SetNillableCreatedAt(t *time.Time) *PriceChangeBatchCreate {
+	if t != nil {
+		pcbc.SetCreatedAt(*t)
+	}
+	return pcbc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcbc *PriceChangeBatchCreate) SetUpdatedAt(t time.Time) *PriceChangeBatchCreate {
+	pcbc.mutation.SetUpdatedAt(t)
+	return pcbc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pcbc *PriceChangeBatchCreate) SetNillableUpdatedAt(t *time.Time) *PriceChangeBatchCreate {
+	if t != nil {
+		pcbc.SetUpdatedAt(*t)
+	}
+	return pcbc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pcbc *PriceChangeBatchCreate) SetDeletedAt(i int64) *PriceChangeBatchCreate {
+	pcbc.mutation.SetDeletedAt(i)
+	return pcbc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pcbc *PriceChangeBatchCreate) SetNillableDeletedAt(i *int64) *PriceChangeBatchCreate {
+	if i != nil {
+		pcbc.SetDeletedAt(*i)
+	}
+	return pcbc
+}
+
+// SetName sets the "name" field.
+func (pcbc *PriceChangeBatchCreate) SetName(s string) *PriceChangeBatchCreate {
+	pcbc.mutation.SetName(s)
+	return pcbc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (pcbc *PriceChangeBatchCreate) SetMerchantID(u uuid.UUID) *PriceChangeBatchCreate {
+	pcbc.mutation.SetMerchantID(u)
+	return pcbc
+}
+
+// SetStoreID sets the "store_id" field.
+func (pcbc *PriceChangeBatchCreate) SetStoreID(u uuid.UUID) *PriceChangeBatchCreate {
+	pcbc.mutation.SetStoreID(u)
+	return pcbc
+}
+
+// SetNillableStoreID sets the "store_id" field if the given value is not nil.
+func (pcbc *PriceChangeBatchCreate) SetNillableStoreID(u *uuid.UUID) *PriceChangeBatchCreate {
+	if u != nil {
+		pcbc.SetStoreID(*u)
+	}
+	return pcbc
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (pcbc *PriceChangeBatchCreate) SetStoreIds(u []uuid.UUID) *PriceChangeBatchCreate {
+	pcbc.mutation.SetStoreIds(u)
+	return pcbc
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (pcbc *PriceChangeBatchCreate) SetEffectiveAt(t time.Time) *PriceChangeBatchCreate {
+	pcbc.mutation.SetEffectiveAt(t)
+	return pcbc
+}
+
+// SetStatus sets the "status" field.
+func (pcbc *PriceChangeBatchCreate) SetStatus(dcbs domain.PriceChangeBatchStatus) *PriceChangeBatchCreate {
+	pcbc.mutation.SetStatus(dcbs)
+	return pcbc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcbc *PriceChangeBatchCreate) SetNillableStatus(dcbs *domain.PriceChangeBatchStatus) *PriceChangeBatchCreate {
+	if dcbs != nil {
+		pcbc.SetStatus(*dcbs)
+	}
+	return pcbc
+}
+
+// SetRemark sets the "remark" field.
+func (pcbc *PriceChangeBatchCreate) SetRemark(s string) *PriceChangeBatchCreate {
+	pcbc.mutation.SetRemark(s)
+	return pcbc
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (pcbc *PriceChangeBatchCreate) SetNillableRemark(s *string) *PriceChangeBatchCreate {
+	if s != nil {
+		pcbc.SetRemark(*s)
+	}
+	return pcbc
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (pcbc *PriceChangeBatchCreate) SetFailReason(s string) *PriceChangeBatchCreate {
+	pcbc.mutation.SetFailReason(s)
+	return pcbc
+}
+
+// SetNillableFailReason sets the "fail_reason" field if the given value is not nil.
+func (pcbc *PriceChangeBatchCreate) SetNillableFailReason(s *string) *PriceChangeBatchCreate {
+	if s != nil {
+		pcbc.SetFailReason(*s)
+	}
+	return pcbc
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (pcbc *PriceChangeBatchCreate) SetAppliedAt(t time.Time) *PriceChangeBatchCreate {
+	pcbc.mutation.SetAppliedAt(t)
+	return pcbc
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (pcbc *PriceChangeBatchCreate) SetNillableAppliedAt(t *time.Time) *PriceChangeBatchCreate {
+	if t != nil {
+		pcbc.SetAppliedAt(*t)
+	}
+	return pcbc
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (pcbc *PriceChangeBatchCreate) SetOperatorID(u uuid.UUID) *PriceChangeBatchCreate {
+	pcbc.mutation.SetOperatorID(u)
+	return pcbc
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (pcbc *PriceChangeBatchCreate) SetOperatorName(s string) *PriceChangeBatchCreate {
+	pcbc.mutation.SetOperatorName(s)
+	return pcbc
+}
+
+// SetNillableOperatorName sets the "operator_name" field if the given value is not nil.
+func (pcbc *PriceChangeBatchCreate) SetNillableOperatorName(s *string) *PriceChangeBatchCreate {
+	if s != nil {
+		pcbc.SetOperatorName(*s)
+	}
+	return pcbc
+}
+
+// SetOperationLogs sets the "operation_logs" field.
+func (pcbc *PriceChangeBatchCreate) SetOperationLogs(dcol []domain.PriceChangeOperationLog) *PriceChangeBatchCreate {
+	pcbc.mutation.SetOperationLogs(dcol)
+	return pcbc
+}
+
+// SetID sets the "id" field.
+func (pcbc *PriceChangeBatchCreate) SetID(u uuid.UUID) *PriceChangeBatchCreate {
+	pcbc.mutation.SetID(u)
+	return pcbc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pcbc *PriceChangeBatchCreate) SetNillableID(u *uuid.UUID) *PriceChangeBatchCreate {
+	if u != nil {
+		pcbc.SetID(*u)
+	}
+	return pcbc
+}
+
+// AddItemIDs adds the "items" edge to the PriceChangeItem entity by IDs.
+func (pcbc *PriceChangeBatchCreate) AddItemIDs(ids ...uuid.UUID) *PriceChangeBatchCreate {
+	pcbc.mutation.AddItemIDs(ids...)
+	return pcbc
+}
+
+// AddItems adds the "items" edges to the PriceChangeItem entity.
+func (pcbc *PriceChangeBatchCreate) AddItems(p ...*PriceChangeItem) *PriceChangeBatchCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcbc.AddItemIDs(ids...)
+}
+
+// Mutation returns the PriceChangeBatchMutation object of the builder.
+func (pcbc *PriceChangeBatchCreate) Mutation() *PriceChangeBatchMutation {
+	return pcbc.mutation
+}
+
+// Save creates the PriceChangeBatch in the database.
+func (pcbc *PriceChangeBatchCreate) Save(ctx context.Context) (*PriceChangeBatch, error) {
+	if err := pcbc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, pcbc.sqlSave, pcbc.mutation, pcbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcbc *PriceChangeBatchCreate) SaveX(ctx context.Context) *PriceChangeBatch {
+	v, err := pcbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcbc *PriceChangeBatchCreate) Exec(ctx context.Context) error {
+	_, err := pcbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcbc *PriceChangeBatchCreate) ExecX(ctx context.Context) {
+	if err := pcbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcbc *PriceChangeBatchCreate) defaults() error {
+	if _, ok := pcbc.mutation.CreatedAt(); !ok {
+		if pricechangebatch.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pricechangebatch.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := pricechangebatch.DefaultCreatedAt()
+		pcbc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pcbc.mutation.UpdatedAt(); !ok {
+		if pricechangebatch.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pricechangebatch.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := pricechangebatch.DefaultUpdatedAt()
+		pcbc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pcbc.mutation.DeletedAt(); !ok {
+		v := pricechangebatch.DefaultDeletedAt
+		pcbc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := pcbc.mutation.StoreID(); !ok {
+		if pricechangebatch.DefaultStoreID == nil {
+			return fmt.Errorf("ent: uninitialized pricechangebatch.DefaultStoreID (forgotten import ent/runtime?)")
+		}
+		v := pricechangebatch.DefaultStoreID()
+		pcbc.mutation.SetStoreID(v)
+	}
+	if _, ok := pcbc.mutation.Status(); !ok {
+		v := pricechangebatch.DefaultStatus
+		pcbc.mutation.SetStatus(v)
+	}
+	if _, ok := pcbc.mutation.Remark(); !ok {
+		v := pricechangebatch.DefaultRemark
+		pcbc.mutation.SetRemark(v)
+	}
+	if _, ok := pcbc.mutation.FailReason(); !ok {
+		v := pricechangebatch.DefaultFailReason
+		pcbc.mutation.SetFailReason(v)
+	}
+	if _, ok := pcbc.mutation.OperatorName(); !ok {
+		v := pricechangebatch.DefaultOperatorName
+		pcbc.mutation.SetOperatorName(v)
+	}
+	if _, ok := pcbc.mutation.ID(); !ok {
+		if pricechangebatch.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized pricechangebatch.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := pricechangebatch.DefaultID()
+		pcbc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcbc *PriceChangeBatchCreate) check() error {
+	if _, ok := pcbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PriceChangeBatch.created_at"`)}
+	}
+	if _, ok := pcbc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PriceChangeBatch.updated_at"`)}
+	}
+	if _, ok := pcbc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "PriceChangeBatch.deleted_at"`)}
+	}
+	if _, ok := pcbc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PriceChangeBatch.name"`)}
+	}
+	if v, ok := pcbc.mutation.Name(); ok {
+		if err := pricechangebatch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.name": %w`, err)}
+		}
+	}
+	if _, ok := pcbc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "PriceChangeBatch.merchant_id"`)}
+	}
+	if _, ok := pcbc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "PriceChangeBatch.store_id"`)}
+	}
+	if _, ok := pcbc.mutation.EffectiveAt(); !ok {
+		return &ValidationError{Name: "effective_at", err: errors.New(`ent: missing required field "PriceChangeBatch.effective_at"`)}
+	}
+	if _, ok := pcbc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PriceChangeBatch.status"`)}
+	}
+	if v, ok := pcbc.mutation.Status(); ok {
+		if err := pricechangebatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.status": %w`, err)}
+		}
+	}
+	if _, ok := pcbc.mutation.Remark(); !ok {
+		return &ValidationError{Name: "remark", err: errors.New(`ent: missing required field "PriceChangeBatch.remark"`)}
+	}
+	if v, ok := pcbc.mutation.Remark(); ok {
+		if err := pricechangebatch.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.remark": %w`, err)}
+		}
+	}
+	if _, ok := pcbc.mutation.FailReason(); !ok {
+		return &ValidationError{Name: "fail_reason", err: errors.New(`ent: missing required field "PriceChangeBatch.fail_reason"`)}
+	}
+	if v, ok := pcbc.mutation.FailReason(); ok {
+		if err := pricechangebatch.FailReasonValidator(v); err != nil {
+			return &ValidationError{Name: "fail_reason", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.fail_reason": %w`, err)}
+		}
+	}
+	if _, ok := pcbc.mutation.OperatorID(); !ok {
+		return &ValidationError{Name: "operator_id", err: errors.New(`ent: missing required field "PriceChangeBatch.operator_id"`)}
+	}
+	if _, ok := pcbc.mutation.OperatorName(); !ok {
+		return &ValidationError{Name: "operator_name", err: errors.New(`ent: missing required field "PriceChangeBatch.operator_name"`)}
+	}
+	if v, ok := pcbc.mutation.OperatorName(); ok {
+		if err := pricechangebatch.OperatorNameValidator(v); err != nil {
+			return &ValidationError{Name: "operator_name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.operator_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pcbc *PriceChangeBatchCreate) sqlSave(ctx context.Context) (*PriceChangeBatch, error) {
+	if err := pcbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pcbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pcbc.mutation.id = &_node.ID
+	pcbc.mutation.done = true
+	return _node, nil
+}
+
+func (pcbc *PriceChangeBatchCreate) createSpec() (*PriceChangeBatch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PriceChangeBatch{config: pcbc.config}
+		_spec = sqlgraph.NewCreateSpec(pricechangebatch.Table, sqlgraph.NewFieldSpec(pricechangebatch.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pcbc.conflict
+	if id, ok := pcbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pcbc.mutation.CreatedAt(); ok {
+		_spec.SetField(pricechangebatch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pcbc.mutation.UpdatedAt(); ok {
+		_spec.SetField(pricechangebatch.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pcbc.mutation.DeletedAt(); ok {
+		_spec.SetField(pricechangebatch.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := pcbc.mutation.Name(); ok {
+		_spec.SetField(pricechangebatch.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pcbc.mutation.MerchantID(); ok {
+		_spec.SetField(pricechangebatch.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := pcbc.mutation.StoreID(); ok {
+		_spec.SetField(pricechangebatch.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := pcbc.mutation.StoreIds(); ok {
+		_spec.SetField(pricechangebatch.FieldStoreIds, field.TypeJSON, value)
+		_node.StoreIds = value
+	}
+	if value, ok := pcbc.mutation.EffectiveAt(); ok {
+		_spec.SetField(pricechangebatch.FieldEffectiveAt, field.TypeTime, value)
+		_node.EffectiveAt = value
+	}
+	if value, ok := pcbc.mutation.Status(); ok {
+		_spec.SetField(pricechangebatch.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := pcbc.mutation.Remark(); ok {
+		_spec.SetField(pricechangebatch.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	if value, ok := pcbc.mutation.FailReason(); ok {
+		_spec.SetField(pricechangebatch.FieldFailReason, field.TypeString, value)
+		_node.FailReason = value
+	}
+	if value, ok := pcbc.mutation.AppliedAt(); ok {
+		_spec.SetField(pricechangebatch.FieldAppliedAt, field.TypeTime, value)
+		_node.AppliedAt = &value
+	}
+	if value, ok := pcbc.mutation.OperatorID(); ok {
+		_spec.SetField(pricechangebatch.FieldOperatorID, field.TypeUUID, value)
+		_node.OperatorID = value
+	}
+	if value, ok := pcbc.mutation.OperatorName(); ok {
+		_spec.SetField(pricechangebatch.FieldOperatorName, field.TypeString, value)
+		_node.OperatorName = value
+	}
+	if value, ok := pcbc.mutation.OperationLogs(); ok {
+		_spec.SetField(pricechangebatch.FieldOperationLogs, field.TypeJSON, value)
+		_node.OperationLogs = value
+	}
+	if nodes := pcbc.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pricechangebatch.ItemsTable,
+			Columns: []string{pricechangebatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PriceChangeBatch.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PriceChangeBatchUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pcbc *PriceChangeBatchCreate) OnConflict(opts ...sql.ConflictOption) *PriceChangeBatchUpsertOne {
+	pcbc.conflict = opts
+	return &PriceChangeBatchUpsertOne{
+		create: pcbc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PriceChangeBatch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcbc *PriceChangeBatchCreate) OnConflictColumns(columns ...string) *PriceChangeBatchUpsertOne {
+	pcbc.conflict = append(pcbc.conflict, sql.ConflictColumns(columns...))
+	return &PriceChangeBatchUpsertOne{
+		create: pcbc,
+	}
+}
+
+type (
+	// PriceChangeBatchUpsertOne is the builder for "upsert"-ing
+	//  one PriceChangeBatch node.
+	PriceChangeBatchUpsertOne struct {
+		create *PriceChangeBatchCreate
+	}
+
+	// PriceChangeBatchUpsert is the "OnConflict" setter.
+	PriceChangeBatchUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PriceChangeBatchUpsert) SetUpdatedAt(v time.Time) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateUpdatedAt() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PriceChangeBatchUpsert) SetDeletedAt(v int64) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateDeletedAt() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *PriceChangeBatchUpsert) AddDeletedAt(v int64) *PriceChangeBatchUpsert {
+	u.Add(pricechangebatch.FieldDeletedAt, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PriceChangeBatchUpsert) SetName(v string) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateName() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldName)
+	return u
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *PriceChangeBatchUpsert) SetStoreIds(v []uuid.UUID) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldStoreIds, v)
+	return u
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateStoreIds() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldStoreIds)
+	return u
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *PriceChangeBatchUpsert) ClearStoreIds() *PriceChangeBatchUpsert {
+	u.SetNull(pricechangebatch.FieldStoreIds)
+	return u
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (u *PriceChangeBatchUpsert) SetEffectiveAt(v time.Time) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldEffectiveAt, v)
+	return u
+}
+
+// UpdateEffectiveAt sets the "effective_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateEffectiveAt() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldEffectiveAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PriceChangeBatchUpsert) SetStatus(v domain.PriceChangeBatchStatus) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateStatus() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldStatus)
+	return u
+}
+
+// SetRemark sets the "remark" field.
+func (u *PriceChangeBatchUpsert) SetRemark(v string) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldRemark, v)
+	return u
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateRemark() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldRemark)
+	return u
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (u *PriceChangeBatchUpsert) SetFailReason(v string) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldFailReason, v)
+	return u
+}
+
+// UpdateFailReason sets the "fail_reason" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateFailReason() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldFailReason)
+	return u
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *PriceChangeBatchUpsert) SetAppliedAt(v time.Time) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldAppliedAt, v)
+	return u
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateAppliedAt() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldAppliedAt)
+	return u
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (u *PriceChangeBatchUpsert) ClearAppliedAt() *PriceChangeBatchUpsert {
+	u.SetNull(pricechangebatch.FieldAppliedAt)
+	return u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *PriceChangeBatchUpsert) SetOperatorID(v uuid.UUID) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldOperatorID, v)
+	return u
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateOperatorID() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldOperatorID)
+	return u
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (u *PriceChangeBatchUpsert) SetOperatorName(v string) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldOperatorName, v)
+	return u
+}
+
+// UpdateOperatorName sets the "operator_name" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateOperatorName() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldOperatorName)
+	return u
+}
+
+// SetOperationLogs sets the "operation_logs" field.
+func (u *PriceChangeBatchUpsert) SetOperationLogs(v []domain.PriceChangeOperationLog) *PriceChangeBatchUpsert {
+	u.Set(pricechangebatch.FieldOperationLogs, v)
+	return u
+}
+
+// UpdateOperationLogs sets the "operation_logs" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsert) UpdateOperationLogs() *PriceChangeBatchUpsert {
+	u.SetExcluded(pricechangebatch.FieldOperationLogs)
+	return u
+}
+
+// ClearOperationLogs clears the value of the "operation_logs" field.
+func (u *PriceChangeBatchUpsert) ClearOperationLogs() *PriceChangeBatchUpsert {
+	u.SetNull(pricechangebatch.FieldOperationLogs)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PriceChangeBatch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pricechangebatch.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PriceChangeBatchUpsertOne) UpdateNewValues() *PriceChangeBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pricechangebatch.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pricechangebatch.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(pricechangebatch.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(pricechangebatch.FieldStoreID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PriceChangeBatch.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PriceChangeBatchUpsertOne) Ignore() *PriceChangeBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PriceChangeBatchUpsertOne) DoNothing() *PriceChangeBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PriceChangeBatchCreate.OnConflict
+// documentation for more info.
+func (u *PriceChangeBatchUpsertOne) Update(set func(*PriceChangeBatchUpsert)) *PriceChangeBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PriceChangeBatchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PriceChangeBatchUpsertOne) SetUpdatedAt(v time.Time) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateUpdatedAt() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PriceChangeBatchUpsertOne) SetDeletedAt(v int64) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *PriceChangeBatchUpsertOne) AddDeletedAt(v int64) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateDeletedAt() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PriceChangeBatchUpsertOne) SetName(v string) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateName() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *PriceChangeBatchUpsertOne) SetStoreIds(v []uuid.UUID) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetStoreIds(v)
+	})
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateStoreIds() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateStoreIds()
+	})
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *PriceChangeBatchUpsertOne) ClearStoreIds() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.ClearStoreIds()
+	})
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (u *PriceChangeBatchUpsertOne) SetEffectiveAt(v time.Time) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetEffectiveAt(v)
+	})
+}
+
+// UpdateEffectiveAt sets the "effective_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateEffectiveAt() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateEffectiveAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PriceChangeBatchUpsertOne) SetStatus(v domain.PriceChangeBatchStatus) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateStatus() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *PriceChangeBatchUpsertOne) SetRemark(v string) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateRemark() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (u *PriceChangeBatchUpsertOne) SetFailReason(v string) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetFailReason(v)
+	})
+}
+
+// UpdateFailReason sets the "fail_reason" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateFailReason() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateFailReason()
+	})
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *PriceChangeBatchUpsertOne) SetAppliedAt(v time.Time) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetAppliedAt(v)
+	})
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateAppliedAt() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateAppliedAt()
+	})
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (u *PriceChangeBatchUpsertOne) ClearAppliedAt() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.ClearAppliedAt()
+	})
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *PriceChangeBatchUpsertOne) SetOperatorID(v uuid.UUID) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetOperatorID(v)
+	})
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateOperatorID() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateOperatorID()
+	})
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (u *PriceChangeBatchUpsertOne) SetOperatorName(v string) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetOperatorName(v)
+	})
+}
+
+// UpdateOperatorName sets the "operator_name" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateOperatorName() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateOperatorName()
+	})
+}
+
+// SetOperationLogs sets the "operation_logs" field.
+func (u *PriceChangeBatchUpsertOne) SetOperationLogs(v []domain.PriceChangeOperationLog) *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetOperationLogs(v)
+	})
+}
+
+// UpdateOperationLogs sets the "operation_logs" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertOne) UpdateOperationLogs() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateOperationLogs()
+	})
+}
+
+// ClearOperationLogs clears the value of the "operation_logs" field.
+func (u *PriceChangeBatchUpsertOne) ClearOperationLogs() *PriceChangeBatchUpsertOne {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.ClearOperationLogs()
+	})
+}
+
+// Exec executes the query.
+func (u *PriceChangeBatchUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PriceChangeBatchCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PriceChangeBatchUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PriceChangeBatchUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PriceChangeBatchUpsertOne.ID is not supported by MySQL driver. Use PriceChangeBatchUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PriceChangeBatchUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PriceChangeBatchCreateBulk is the builder for creating many PriceChangeBatch entities in bulk.
+type PriceChangeBatchCreateBulk struct {
+	config
+	err      error
+	builders []*PriceChangeBatchCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PriceChangeBatch entities in the database.
+func (pcbcb *PriceChangeBatchCreateBulk) Save(ctx context.Context) ([]*PriceChangeBatch, error) {
+	if pcbcb.err != nil {
+		return nil, pcbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcbcb.builders))
+	nodes := make([]*PriceChangeBatch, len(pcbcb.builders))
+	mutators := make([]Mutator, len(pcbcb.builders))
+	for i := range pcbcb.builders {
+		func(i int, root context.Context) {
+			builder := pcbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PriceChangeBatchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcbcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcbcb *PriceChangeBatchCreateBulk) SaveX(ctx context.Context) []*PriceChangeBatch {
+	v, err := pcbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcbcb *PriceChangeBatchCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcbcb *PriceChangeBatchCreateBulk) ExecX(ctx context.Context) {
+	if err := pcbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PriceChangeBatch.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PriceChangeBatchUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pcbcb *PriceChangeBatchCreateBulk) OnConflict(opts ...sql.ConflictOption) *PriceChangeBatchUpsertBulk {
+	pcbcb.conflict = opts
+	return &PriceChangeBatchUpsertBulk{
+		create: pcbcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PriceChangeBatch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcbcb *PriceChangeBatchCreateBulk) OnConflictColumns(columns ...string) *PriceChangeBatchUpsertBulk {
+	pcbcb.conflict = append(pcbcb.conflict, sql.ConflictColumns(columns...))
+	return &PriceChangeBatchUpsertBulk{
+		create: pcbcb,
+	}
+}
+
+// PriceChangeBatchUpsertBulk is the builder for "upsert"-ing
+// a bulk of PriceChangeBatch nodes.
+type PriceChangeBatchUpsertBulk struct {
+	create *PriceChangeBatchCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PriceChangeBatch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pricechangebatch.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PriceChangeBatchUpsertBulk) UpdateNewValues() *PriceChangeBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pricechangebatch.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pricechangebatch.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(pricechangebatch.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(pricechangebatch.FieldStoreID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PriceChangeBatch.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PriceChangeBatchUpsertBulk) Ignore() *PriceChangeBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PriceChangeBatchUpsertBulk) DoNothing() *PriceChangeBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PriceChangeBatchCreateBulk.OnConflict
+// documentation for more info.
+func (u *PriceChangeBatchUpsertBulk) Update(set func(*PriceChangeBatchUpsert)) *PriceChangeBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PriceChangeBatchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PriceChangeBatchUpsertBulk) SetUpdatedAt(v time.Time) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateUpdatedAt() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PriceChangeBatchUpsertBulk) SetDeletedAt(v int64) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *PriceChangeBatchUpsertBulk) AddDeletedAt(v int64) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateDeletedAt() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PriceChangeBatchUpsertBulk) SetName(v string) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateName() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *PriceChangeBatchUpsertBulk) SetStoreIds(v []uuid.UUID) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetStoreIds(v)
+	})
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateStoreIds() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateStoreIds()
+	})
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *PriceChangeBatchUpsertBulk) ClearStoreIds() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.ClearStoreIds()
+	})
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (u *PriceChangeBatchUpsertBulk) SetEffectiveAt(v time.Time) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetEffectiveAt(v)
+	})
+}
+
+// UpdateEffectiveAt sets the "effective_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateEffectiveAt() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateEffectiveAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PriceChangeBatchUpsertBulk) SetStatus(v domain.PriceChangeBatchStatus) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateStatus() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *PriceChangeBatchUpsertBulk) SetRemark(v string) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateRemark() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (u *PriceChangeBatchUpsertBulk) SetFailReason(v string) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetFailReason(v)
+	})
+}
+
+// UpdateFailReason sets the "fail_reason" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateFailReason() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateFailReason()
+	})
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *PriceChangeBatchUpsertBulk) SetAppliedAt(v time.Time) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetAppliedAt(v)
+	})
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateAppliedAt() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateAppliedAt()
+	})
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (u *PriceChangeBatchUpsertBulk) ClearAppliedAt() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.ClearAppliedAt()
+	})
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *PriceChangeBatchUpsertBulk) SetOperatorID(v uuid.UUID) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetOperatorID(v)
+	})
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateOperatorID() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateOperatorID()
+	})
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (u *PriceChangeBatchUpsertBulk) SetOperatorName(v string) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetOperatorName(v)
+	})
+}
+
+// UpdateOperatorName sets the "operator_name" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateOperatorName() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateOperatorName()
+	})
+}
+
+// SetOperationLogs sets the "operation_logs" field.
+func (u *PriceChangeBatchUpsertBulk) SetOperationLogs(v []domain.PriceChangeOperationLog) *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.SetOperationLogs(v)
+	})
+}
+
+// UpdateOperationLogs sets the "operation_logs" field to the value that was provided on create.
+func (u *PriceChangeBatchUpsertBulk) UpdateOperationLogs() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.UpdateOperationLogs()
+	})
+}
+
+// ClearOperationLogs clears the value of the "operation_logs" field.
+func (u *PriceChangeBatchUpsertBulk) ClearOperationLogs() *PriceChangeBatchUpsertBulk {
+	return u.Update(func(s *PriceChangeBatchUpsert) {
+		s.ClearOperationLogs()
+	})
+}
+
+// Exec executes the query.
+func (u *PriceChangeBatchUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PriceChangeBatchCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PriceChangeBatchCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PriceChangeBatchUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
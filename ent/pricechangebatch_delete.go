@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+)
+
+// PriceChangeBatchDelete is the builder for deleting a PriceChangeBatch entity.
+type PriceChangeBatchDelete struct {
+	config
+	hooks    []Hook
+	mutation *PriceChangeBatchMutation
+}
+
+// Where appends a list predicates to the PriceChangeBatchDelete builder.
+func (pcbd *PriceChangeBatchDelete) Where(ps ...predicate.PriceChangeBatch) *PriceChangeBatchDelete {
+	pcbd.mutation.Where(ps...)
+	return pcbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pcbd *PriceChangeBatchDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcbd.sqlExec, pcbd.mutation, pcbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcbd *PriceChangeBatchDelete) ExecX(ctx context.Context) int {
+	n, err := pcbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pcbd *PriceChangeBatchDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pricechangebatch.Table, sqlgraph.NewFieldSpec(pricechangebatch.FieldID, field.TypeUUID))
+	if ps := pcbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pcbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pcbd.mutation.done = true
+	return affected, err
+}
+
+// PriceChangeBatchDeleteOne is the builder for deleting a single PriceChangeBatch entity.
+type PriceChangeBatchDeleteOne struct {
+	pcbd *PriceChangeBatchDelete
+}
+
+// Where appends a list predicates to the PriceChangeBatchDelete builder.
+func (pcbdo *PriceChangeBatchDeleteOne) Where(ps ...predicate.PriceChangeBatch) *PriceChangeBatchDeleteOne {
+	pcbdo.pcbd.mutation.Where(ps...)
+	return pcbdo
+}
+
+// Exec executes the deletion query.
+func (pcbdo *PriceChangeBatchDeleteOne) Exec(ctx context.Context) error {
+	n, err := pcbdo.pcbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pricechangebatch.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcbdo *PriceChangeBatchDeleteOne) ExecX(ctx context.Context) {
+	if err := pcbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
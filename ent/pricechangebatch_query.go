@@ -0,0 +1,657 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
+)
+
+// PriceChangeBatchQuery is the builder for querying PriceChangeBatch entities.
+type PriceChangeBatchQuery struct {
+	config
+	ctx        *QueryContext
+	order      []pricechangebatch.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PriceChangeBatch
+	withItems  *PriceChangeItemQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PriceChangeBatchQuery builder.
+func (pcbq *PriceChangeBatchQuery) Where(ps ...predicate.PriceChangeBatch) *PriceChangeBatchQuery {
+	pcbq.predicates = append(pcbq.predicates, ps...)
+	return pcbq
+}
+
+// Limit the number of records to be returned by this query.
+func (pcbq *PriceChangeBatchQuery) Limit(limit int) *PriceChangeBatchQuery {
+	pcbq.ctx.Limit = &limit
+	return pcbq
+}
+
+// Offset to start from.
+func (pcbq *PriceChangeBatchQuery) Offset(offset int) *PriceChangeBatchQuery {
+	pcbq.ctx.Offset = &offset
+	return pcbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pcbq *PriceChangeBatchQuery) Unique(unique bool) *PriceChangeBatchQuery {
+	pcbq.ctx.Unique = &unique
+	return pcbq
+}
+
+// Order specifies how the records should be ordered.
+func (pcbq *PriceChangeBatchQuery) Order(o ...pricechangebatch.OrderOption) *PriceChangeBatchQuery {
+	pcbq.order = append(pcbq.order, o...)
+	return pcbq
+}
+
+// QueryItems chains the current query on the "items" edge.
+func (pcbq *PriceChangeBatchQuery) QueryItems() *PriceChangeItemQuery {
+	query := (&PriceChangeItemClient{config: pcbq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pcbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pcbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pricechangebatch.Table, pricechangebatch.FieldID, selector),
+			sqlgraph.To(pricechangeitem.Table, pricechangeitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, pricechangebatch.ItemsTable, pricechangebatch.ItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pcbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PriceChangeBatch entity from the query.
+// Returns a *NotFoundError when no PriceChangeBatch was found.
+func (pcbq *PriceChangeBatchQuery) First(ctx context.Context) (*PriceChangeBatch, error) {
+	nodes, err := pcbq.Limit(1).All(setContextOp(ctx, pcbq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pricechangebatch.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pcbq *PriceChangeBatchQuery) FirstX(ctx context.Context) *PriceChangeBatch {
+	node, err := pcbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PriceChangeBatch ID from the query.
+// Returns a *NotFoundError when no PriceChangeBatch ID was found.
+func (pcbq *PriceChangeBatchQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pcbq.Limit(1).IDs(setContextOp(ctx, pcbq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pricechangebatch.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pcbq *PriceChangeBatchQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := pcbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PriceChangeBatch entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PriceChangeBatch entity is found.
+// Returns a *NotFoundError when no PriceChangeBatch entities are found.
+func (pcbq *PriceChangeBatchQuery) Only(ctx context.Context) (*PriceChangeBatch, error) {
+	nodes, err := pcbq.Limit(2).All(setContextOp(ctx, pcbq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pricechangebatch.Label}
+	default:
+		return nil, &NotSingularError{pricechangebatch.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pcbq *PriceChangeBatchQuery) OnlyX(ctx context.Context) *PriceChangeBatch {
+	node, err := pcbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PriceChangeBatch ID in the query.
+// Returns a *NotSingularError when more than one PriceChangeBatch ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pcbq *PriceChangeBatchQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pcbq.Limit(2).IDs(setContextOp(ctx, pcbq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pricechangebatch.Label}
+	default:
+		err = &NotSingularError{pricechangebatch.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pcbq *PriceChangeBatchQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := pcbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PriceChangeBatches.
+func (pcbq *PriceChangeBatchQuery) All(ctx context.Context) ([]*PriceChangeBatch, error) {
+	ctx = setContextOp(ctx, pcbq.ctx, ent.OpQueryAll)
+	if err := pcbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PriceChangeBatch, *PriceChangeBatchQuery]()
+	return withInterceptors[[]*PriceChangeBatch](ctx, pcbq, qr, pcbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pcbq *PriceChangeBatchQuery) AllX(ctx context.Context) []*PriceChangeBatch {
+	nodes, err := pcbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PriceChangeBatch IDs.
+func (pcbq *PriceChangeBatchQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if pcbq.ctx.Unique == nil && pcbq.path != nil {
+		pcbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pcbq.ctx, ent.OpQueryIDs)
+	if err = pcbq.Select(pricechangebatch.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pcbq *PriceChangeBatchQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := pcbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pcbq *PriceChangeBatchQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pcbq.ctx, ent.OpQueryCount)
+	if err := pcbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pcbq, querierCount[*PriceChangeBatchQuery](), pcbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pcbq *PriceChangeBatchQuery) CountX(ctx context.Context) int {
+	count, err := pcbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pcbq *PriceChangeBatchQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pcbq.ctx, ent.OpQueryExist)
+	switch _, err := pcbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pcbq *PriceChangeBatchQuery) ExistX(ctx context.Context) bool {
+	exist, err := pcbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PriceChangeBatchQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pcbq *PriceChangeBatchQuery) Clone() *PriceChangeBatchQuery {
+	if pcbq == nil {
+		return nil
+	}
+	return &PriceChangeBatchQuery{
+		config:     pcbq.config,
+		ctx:        pcbq.ctx.Clone(),
+		order:      append([]pricechangebatch.OrderOption{}, pcbq.order...),
+		inters:     append([]Interceptor{}, pcbq.inters...),
+		predicates: append([]predicate.PriceChangeBatch{}, pcbq.predicates...),
+		withItems:  pcbq.withItems.Clone(),
+		// clone intermediate query.
+		sql:       pcbq.sql.Clone(),
+		path:      pcbq.path,
+		modifiers: append([]func(*sql.Selector){}, pcbq.modifiers...),
+	}
+}
+
+// WithItems tells the query-builder to eager-load the nodes that are connected to
+// the "items" edge. The optional arguments are used to configure the query builder of the edge.
+func (pcbq *PriceChangeBatchQuery) WithItems(opts ...func(*PriceChangeItemQuery)) *PriceChangeBatchQuery {
+	query := (&PriceChangeItemClient{config: pcbq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pcbq.withItems = query
+	return pcbq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PriceChangeBatch.Query().
+//		GroupBy(pricechangebatch.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pcbq *PriceChangeBatchQuery) GroupBy(field string, fields ...string) *PriceChangeBatchGroupBy {
+	pcbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PriceChangeBatchGroupBy{build: pcbq}
+	grbuild.flds = &pcbq.ctx.Fields
+	grbuild.label = pricechangebatch.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PriceChangeBatch.Query().
+//		Select(pricechangebatch.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (pcbq *PriceChangeBatchQuery) Select(fields ...string) *PriceChangeBatchSelect {
+	pcbq.ctx.Fields = append(pcbq.ctx.Fields, fields...)
+	sbuild := &PriceChangeBatchSelect{PriceChangeBatchQuery: pcbq}
+	sbuild.label = pricechangebatch.Label
+	sbuild.flds, sbuild.scan = &pcbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PriceChangeBatchSelect configured with the given aggregations.
+func (pcbq *PriceChangeBatchQuery) Aggregate(fns ...AggregateFunc) *PriceChangeBatchSelect {
+	return pcbq.Select().Aggregate(fns...)
+}
+
+func (pcbq *PriceChangeBatchQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pcbq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pcbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pcbq.ctx.Fields {
+		if !pricechangebatch.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pcbq.path != nil {
+		prev, err := pcbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pcbq.sql = prev
+	}
+	return nil
+}
+
+func (pcbq *PriceChangeBatchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PriceChangeBatch, error) {
+	var (
+		nodes       = []*PriceChangeBatch{}
+		_spec       = pcbq.querySpec()
+		loadedTypes = [1]bool{
+			pcbq.withItems != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PriceChangeBatch).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PriceChangeBatch{config: pcbq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(pcbq.modifiers) > 0 {
+		_spec.Modifiers = pcbq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pcbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pcbq.withItems; query != nil {
+		if err := pcbq.loadItems(ctx, query, nodes,
+			func(n *PriceChangeBatch) { n.Edges.Items = []*PriceChangeItem{} },
+			func(n *PriceChangeBatch, e *PriceChangeItem) { n.Edges.Items = append(n.Edges.Items, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pcbq *PriceChangeBatchQuery) loadItems(ctx context.Context, query *PriceChangeItemQuery, nodes []*PriceChangeBatch, init func(*PriceChangeBatch), assign func(*PriceChangeBatch, *PriceChangeItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PriceChangeBatch)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pricechangeitem.FieldBatchID)
+	}
+	query.Where(predicate.PriceChangeItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(pricechangebatch.ItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BatchID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "batch_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (pcbq *PriceChangeBatchQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pcbq.querySpec()
+	if len(pcbq.modifiers) > 0 {
+		_spec.Modifiers = pcbq.modifiers
+	}
+	_spec.Node.Columns = pcbq.ctx.Fields
+	if len(pcbq.ctx.Fields) > 0 {
+		_spec.Unique = pcbq.ctx.Unique != nil && *pcbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pcbq.driver, _spec)
+}
+
+func (pcbq *PriceChangeBatchQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pricechangebatch.Table, pricechangebatch.Columns, sqlgraph.NewFieldSpec(pricechangebatch.FieldID, field.TypeUUID))
+	_spec.From = pcbq.sql
+	if unique := pcbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pcbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pcbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricechangebatch.FieldID)
+		for i := range fields {
+			if fields[i] != pricechangebatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pcbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pcbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pcbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pcbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pcbq *PriceChangeBatchQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pcbq.driver.Dialect())
+	t1 := builder.Table(pricechangebatch.Table)
+	columns := pcbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pricechangebatch.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pcbq.sql != nil {
+		selector = pcbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pcbq.ctx.Unique != nil && *pcbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range pcbq.modifiers {
+		m(selector)
+	}
+	for _, p := range pcbq.predicates {
+		p(selector)
+	}
+	for _, p := range pcbq.order {
+		p(selector)
+	}
+	if offset := pcbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pcbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pcbq *PriceChangeBatchQuery) ForUpdate(opts ...sql.LockOption) *PriceChangeBatchQuery {
+	if pcbq.driver.Dialect() == dialect.Postgres {
+		pcbq.Unique(false)
+	}
+	pcbq.modifiers = append(pcbq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pcbq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pcbq *PriceChangeBatchQuery) ForShare(opts ...sql.LockOption) *PriceChangeBatchQuery {
+	if pcbq.driver.Dialect() == dialect.Postgres {
+		pcbq.Unique(false)
+	}
+	pcbq.modifiers = append(pcbq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pcbq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pcbq *PriceChangeBatchQuery) Modify(modifiers ...func(s *sql.Selector)) *PriceChangeBatchSelect {
+	pcbq.modifiers = append(pcbq.modifiers, modifiers...)
+	return pcbq.Select()
+}
+
+// PriceChangeBatchGroupBy is the group-by builder for PriceChangeBatch entities.
+type PriceChangeBatchGroupBy struct {
+	selector
+	build *PriceChangeBatchQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pcbgb *PriceChangeBatchGroupBy) Aggregate(fns ...AggregateFunc) *PriceChangeBatchGroupBy {
+	pcbgb.fns = append(pcbgb.fns, fns...)
+	return pcbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcbgb *PriceChangeBatchGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcbgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pcbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PriceChangeBatchQuery, *PriceChangeBatchGroupBy](ctx, pcbgb.build, pcbgb, pcbgb.build.inters, v)
+}
+
+func (pcbgb *PriceChangeBatchGroupBy) sqlScan(ctx context.Context, root *PriceChangeBatchQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pcbgb.fns))
+	for _, fn := range pcbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pcbgb.flds)+len(pcbgb.fns))
+		for _, f := range *pcbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pcbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PriceChangeBatchSelect is the builder for selecting fields of PriceChangeBatch entities.
+type PriceChangeBatchSelect struct {
+	*PriceChangeBatchQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pcbs *PriceChangeBatchSelect) Aggregate(fns ...AggregateFunc) *PriceChangeBatchSelect {
+	pcbs.fns = append(pcbs.fns, fns...)
+	return pcbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcbs *PriceChangeBatchSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcbs.ctx, ent.OpQuerySelect)
+	if err := pcbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PriceChangeBatchQuery, *PriceChangeBatchSelect](ctx, pcbs.PriceChangeBatchQuery, pcbs, pcbs.inters, v)
+}
+
+func (pcbs *PriceChangeBatchSelect) sqlScan(ctx context.Context, root *PriceChangeBatchQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pcbs.fns))
+	for _, fn := range pcbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pcbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pcbs *PriceChangeBatchSelect) Modify(modifiers ...func(s *sql.Selector)) *PriceChangeBatchSelect {
+	pcbs.modifiers = append(pcbs.modifiers, modifiers...)
+	return pcbs
+}
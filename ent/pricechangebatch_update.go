@@ -0,0 +1,932 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
+)
+
+// PriceChangeBatchUpdate is the builder for updating PriceChangeBatch entities.
+type PriceChangeBatchUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PriceChangeBatchMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PriceChangeBatchUpdate builder.
+func (pcbu *PriceChangeBatchUpdate) Where(ps ...predicate.PriceChangeBatch) *PriceChangeBatchUpdate {
+	pcbu.mutation.Where(ps...)
+	return pcbu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcbu *PriceChangeBatchUpdate) SetUpdatedAt(t time.Time) *PriceChangeBatchUpdate {
+	pcbu.mutation.SetUpdatedAt(t)
+	return pcbu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pcbu *PriceChangeBatchUpdate) SetDeletedAt(i int64) *PriceChangeBatchUpdate {
+	pcbu.mutation.ResetDeletedAt()
+	pcbu.mutation.SetDeletedAt(i)
+	return pcbu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pcbu *PriceChangeBatchUpdate) SetNillableDeletedAt(i *int64) *PriceChangeBatchUpdate {
+	if i != nil {
+		pcbu.SetDeletedAt(*i)
+	}
+	return pcbu
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (pcbu *PriceChangeBatchUpdate) AddDeletedAt(i int64) *PriceChangeBatchUpdate {
+	pcbu.mutation.AddDeletedAt(i)
+	return pcbu
+}
+
+// SetName sets the "name" field.
+func (pcbu *PriceChangeBatchUpdate) SetName(s string) *PriceChangeBatchUpdate {
+	pcbu.mutation.SetName(s)
+	return pcbu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pcbu *PriceChangeBatchUpdate) SetNillableName(s *string) *PriceChangeBatchUpdate {
+	if s != nil {
+		pcbu.SetName(*s)
+	}
+	return pcbu
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (pcbu *PriceChangeBatchUpdate) SetStoreIds(u []uuid.UUID) *PriceChangeBatchUpdate {
+	pcbu.mutation.SetStoreIds(u)
+	return pcbu
+}
+
+// AppendStoreIds appends u to the "store_ids" field.
+func (pcbu *PriceChangeBatchUpdate) AppendStoreIds(u []uuid.UUID) *PriceChangeBatchUpdate {
+	pcbu.mutation.AppendStoreIds(u)
+	return pcbu
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (pcbu *PriceChangeBatchUpdate) ClearStoreIds() *PriceChangeBatchUpdate {
+	pcbu.mutation.ClearStoreIds()
+	return pcbu
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (pcbu *PriceChangeBatchUpdate) SetEffectiveAt(t time.Time) *PriceChangeBatchUpdate {
+	pcbu.mutation.SetEffectiveAt(t)
+	return pcbu
+}
+
+// SetNillableEffectiveAt sets the "effective_at" field if the given value is not nil.
+func (pcbu *PriceChangeBatchUpdate) SetNillableEffectiveAt(t *time.Time) *PriceChangeBatchUpdate {
+	if t != nil {
+		pcbu.SetEffectiveAt(*t)
+	}
+	return pcbu
+}
+
+// SetStatus sets the "status" field.
+func (pcbu *PriceChangeBatchUpdate) SetStatus(dcbs domain.PriceChangeBatchStatus) *PriceChangeBatchUpdate {
+	pcbu.mutation.SetStatus(dcbs)
+	return pcbu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcbu *PriceChangeBatchUpdate) SetNillableStatus(dcbs *domain.PriceChangeBatchStatus) *PriceChangeBatchUpdate {
+	if dcbs != nil {
+		pcbu.SetStatus(*dcbs)
+	}
+	return pcbu
+}
+
+// SetRemark sets the "remark" field.
+func (pcbu *PriceChangeBatchUpdate) SetRemark(s string) *PriceChangeBatchUpdate {
+	pcbu.mutation.SetRemark(s)
+	return pcbu
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (pcbu *PriceChangeBatchUpdate) SetNillableRemark(s *string) *PriceChangeBatchUpdate {
+	if s != nil {
+		pcbu.SetRemark(*s)
+	}
+	return pcbu
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (pcbu *PriceChangeBatchUpdate) SetFailReason(s string) *PriceChangeBatchUpdate {
+	pcbu.mutation.SetFailReason(s)
+	return pcbu
+}
+
+// SetNillableFailReason sets the "fail_reason" field if the given value is not nil.
+func (pcbu *PriceChangeBatchUpdate) SetNillableFailReason(s *string) *PriceChangeBatchUpdate {
+	if s != nil {
+		pcbu.SetFailReason(*s)
+	}
+	return pcbu
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (pcbu *PriceChangeBatchUpdate) SetAppliedAt(t time.Time) *PriceChangeBatchUpdate {
+	pcbu.mutation.SetAppliedAt(t)
+	return pcbu
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (pcbu *PriceChangeBatchUpdate) SetNillableAppliedAt(t *time.Time) *PriceChangeBatchUpdate {
+	if t != nil {
+		pcbu.SetAppliedAt(*t)
+	}
+	return pcbu
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (pcbu *PriceChangeBatchUpdate) ClearAppliedAt() *PriceChangeBatchUpdate {
+	pcbu.mutation.ClearAppliedAt()
+	return pcbu
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (pcbu *PriceChangeBatchUpdate) SetOperatorID(u uuid.UUID) *PriceChangeBatchUpdate {
+	pcbu.mutation.SetOperatorID(u)
+	return pcbu
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (pcbu *PriceChangeBatchUpdate) SetNillableOperatorID(u *uuid.UUID) *PriceChangeBatchUpdate {
+	if u != nil {
+		pcbu.SetOperatorID(*u)
+	}
+	return pcbu
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (pcbu *PriceChangeBatchUpdate) SetOperatorName(s string) *PriceChangeBatchUpdate {
+	pcbu.mutation.SetOperatorName(s)
+	return pcbu
+}
+
+// SetNillableOperatorName sets the "operator_name" field if the given value is not nil.
+func (pcbu *PriceChangeBatchUpdate) SetNillableOperatorName(s *string) *PriceChangeBatchUpdate {
+	if s != nil {
+		pcbu.SetOperatorName(*s)
+	}
+	return pcbu
+}
+
+// SetOperationLogs sets the "operation_logs" field.
+func (pcbu *PriceChangeBatchUpdate) SetOperationLogs(dcol []domain.PriceChangeOperationLog) *PriceChangeBatchUpdate {
+	pcbu.mutation.SetOperationLogs(dcol)
+	return pcbu
+}
+
+// AppendOperationLogs appends dcol to the "operation_logs" field.
+func (pcbu *PriceChangeBatchUpdate) AppendOperationLogs(dcol []domain.PriceChangeOperationLog) *PriceChangeBatchUpdate {
+	pcbu.mutation.AppendOperationLogs(dcol)
+	return pcbu
+}
+
+// ClearOperationLogs clears the value of the "operation_logs" field.
+func (pcbu *PriceChangeBatchUpdate) ClearOperationLogs() *PriceChangeBatchUpdate {
+	pcbu.mutation.ClearOperationLogs()
+	return pcbu
+}
+
+// AddItemIDs adds the "items" edge to the PriceChangeItem entity by IDs.
+func (pcbu *PriceChangeBatchUpdate) AddItemIDs(ids ...uuid.UUID) *PriceChangeBatchUpdate {
+	pcbu.mutation.AddItemIDs(ids...)
+	return pcbu
+}
+
+// AddItems adds the "items" edges to the PriceChangeItem entity.
+func (pcbu *PriceChangeBatchUpdate) AddItems(p ...*PriceChangeItem) *PriceChangeBatchUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcbu.AddItemIDs(ids...)
+}
+
+// Mutation returns the PriceChangeBatchMutation object of the builder.
+func (pcbu *PriceChangeBatchUpdate) Mutation() *PriceChangeBatchMutation {
+	return pcbu.mutation
+}
+
+// ClearItems clears all "items" edges to the PriceChangeItem entity.
+func (pcbu *PriceChangeBatchUpdate) ClearItems() *PriceChangeBatchUpdate {
+	pcbu.mutation.ClearItems()
+	return pcbu
+}
+
+// RemoveItemIDs removes the "items" edge to PriceChangeItem entities by IDs.
+func (pcbu *PriceChangeBatchUpdate) RemoveItemIDs(ids ...uuid.UUID) *PriceChangeBatchUpdate {
+	pcbu.mutation.RemoveItemIDs(ids...)
+	return pcbu
+}
+
+// RemoveItems removes "items" edges to PriceChangeItem entities.
+func (pcbu *PriceChangeBatchUpdate) RemoveItems(p ...*PriceChangeItem) *PriceChangeBatchUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcbu.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcbu *PriceChangeBatchUpdate) Save(ctx context.Context) (int, error) {
+	if err := pcbu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, pcbu.sqlSave, pcbu.mutation, pcbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcbu *PriceChangeBatchUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcbu *PriceChangeBatchUpdate) Exec(ctx context.Context) error {
+	_, err := pcbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcbu *PriceChangeBatchUpdate) ExecX(ctx context.Context) {
+	if err := pcbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcbu *PriceChangeBatchUpdate) defaults() error {
+	if _, ok := pcbu.mutation.UpdatedAt(); !ok {
+		if pricechangebatch.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pricechangebatch.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := pricechangebatch.UpdateDefaultUpdatedAt()
+		pcbu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcbu *PriceChangeBatchUpdate) check() error {
+	if v, ok := pcbu.mutation.Name(); ok {
+		if err := pricechangebatch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.name": %w`, err)}
+		}
+	}
+	if v, ok := pcbu.mutation.Status(); ok {
+		if err := pricechangebatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.status": %w`, err)}
+		}
+	}
+	if v, ok := pcbu.mutation.Remark(); ok {
+		if err := pricechangebatch.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.remark": %w`, err)}
+		}
+	}
+	if v, ok := pcbu.mutation.FailReason(); ok {
+		if err := pricechangebatch.FailReasonValidator(v); err != nil {
+			return &ValidationError{Name: "fail_reason", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.fail_reason": %w`, err)}
+		}
+	}
+	if v, ok := pcbu.mutation.OperatorName(); ok {
+		if err := pricechangebatch.OperatorNameValidator(v); err != nil {
+			return &ValidationError{Name: "operator_name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.operator_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pcbu *PriceChangeBatchUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PriceChangeBatchUpdate {
+	pcbu.modifiers = append(pcbu.modifiers, modifiers...)
+	return pcbu
+}
+
+func (pcbu *PriceChangeBatchUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pcbu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricechangebatch.Table, pricechangebatch.Columns, sqlgraph.NewFieldSpec(pricechangebatch.FieldID, field.TypeUUID))
+	if ps := pcbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcbu.mutation.UpdatedAt(); ok {
+		_spec.SetField(pricechangebatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pcbu.mutation.DeletedAt(); ok {
+		_spec.SetField(pricechangebatch.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := pcbu.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(pricechangebatch.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := pcbu.mutation.Name(); ok {
+		_spec.SetField(pricechangebatch.FieldName, field.TypeString, value)
+	}
+	if value, ok := pcbu.mutation.StoreIds(); ok {
+		_spec.SetField(pricechangebatch.FieldStoreIds, field.TypeJSON, value)
+	}
+	if value, ok := pcbu.mutation.AppendedStoreIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricechangebatch.FieldStoreIds, value)
+		})
+	}
+	if pcbu.mutation.StoreIdsCleared() {
+		_spec.ClearField(pricechangebatch.FieldStoreIds, field.TypeJSON)
+	}
+	if value, ok := pcbu.mutation.EffectiveAt(); ok {
+		_spec.SetField(pricechangebatch.FieldEffectiveAt, field.TypeTime, value)
+	}
+	if value, ok := pcbu.mutation.Status(); ok {
+		_spec.SetField(pricechangebatch.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := pcbu.mutation.Remark(); ok {
+		_spec.SetField(pricechangebatch.FieldRemark, field.TypeString, value)
+	}
+	if value, ok := pcbu.mutation.FailReason(); ok {
+		_spec.SetField(pricechangebatch.FieldFailReason, field.TypeString, value)
+	}
+	if value, ok := pcbu.mutation.AppliedAt(); ok {
+		_spec.SetField(pricechangebatch.FieldAppliedAt, field.TypeTime, value)
+	}
+	if pcbu.mutation.AppliedAtCleared() {
+		_spec.ClearField(pricechangebatch.FieldAppliedAt, field.TypeTime)
+	}
+	if value, ok := pcbu.mutation.OperatorID(); ok {
+		_spec.SetField(pricechangebatch.FieldOperatorID, field.TypeUUID, value)
+	}
+	if value, ok := pcbu.mutation.OperatorName(); ok {
+		_spec.SetField(pricechangebatch.FieldOperatorName, field.TypeString, value)
+	}
+	if value, ok := pcbu.mutation.OperationLogs(); ok {
+		_spec.SetField(pricechangebatch.FieldOperationLogs, field.TypeJSON, value)
+	}
+	if value, ok := pcbu.mutation.AppendedOperationLogs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricechangebatch.FieldOperationLogs, value)
+		})
+	}
+	if pcbu.mutation.OperationLogsCleared() {
+		_spec.ClearField(pricechangebatch.FieldOperationLogs, field.TypeJSON)
+	}
+	if pcbu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pricechangebatch.ItemsTable,
+			Columns: []string{pricechangebatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcbu.mutation.RemovedItemsIDs(); len(nodes) > 0 && !pcbu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pricechangebatch.ItemsTable,
+			Columns: []string{pricechangebatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcbu.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pricechangebatch.ItemsTable,
+			Columns: []string{pricechangebatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pcbu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pcbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricechangebatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pcbu.mutation.done = true
+	return n, nil
+}
+
+// PriceChangeBatchUpdateOne is the builder for updating a single PriceChangeBatch entity.
+type PriceChangeBatchUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PriceChangeBatchMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetUpdatedAt(t time.Time) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.SetUpdatedAt(t)
+	return pcbuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetDeletedAt(i int64) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.ResetDeletedAt()
+	pcbuo.mutation.SetDeletedAt(i)
+	return pcbuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pcbuo *PriceChangeBatchUpdateOne) SetNillableDeletedAt(i *int64) *PriceChangeBatchUpdateOne {
+	if i != nil {
+		pcbuo.SetDeletedAt(*i)
+	}
+	return pcbuo
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (pcbuo *PriceChangeBatchUpdateOne) AddDeletedAt(i int64) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.AddDeletedAt(i)
+	return pcbuo
+}
+
+// SetName sets the "name" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetName(s string) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.SetName(s)
+	return pcbuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pcbuo *PriceChangeBatchUpdateOne) SetNillableName(s *string) *PriceChangeBatchUpdateOne {
+	if s != nil {
+		pcbuo.SetName(*s)
+	}
+	return pcbuo
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetStoreIds(u []uuid.UUID) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.SetStoreIds(u)
+	return pcbuo
+}
+
+// AppendStoreIds appends u to the "store_ids" field.
+func (pcbuo *PriceChangeBatchUpdateOne) AppendStoreIds(u []uuid.UUID) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.AppendStoreIds(u)
+	return pcbuo
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (pcbuo *PriceChangeBatchUpdateOne) ClearStoreIds() *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.ClearStoreIds()
+	return pcbuo
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetEffectiveAt(t time.Time) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.SetEffectiveAt(t)
+	return pcbuo
+}
+
+// SetNillableEffectiveAt sets the "effective_at" field if the given value is not nil.
+func (pcbuo *PriceChangeBatchUpdateOne) SetNillableEffectiveAt(t *time.Time) *PriceChangeBatchUpdateOne {
+	if t != nil {
+		pcbuo.SetEffectiveAt(*t)
+	}
+	return pcbuo
+}
+
+// SetStatus sets the "status" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetStatus(dcbs domain.PriceChangeBatchStatus) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.SetStatus(dcbs)
+	return pcbuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcbuo *PriceChangeBatchUpdateOne) SetNillableStatus(dcbs *domain.PriceChangeBatchStatus) *PriceChangeBatchUpdateOne {
+	if dcbs != nil {
+		pcbuo.SetStatus(*dcbs)
+	}
+	return pcbuo
+}
+
+// SetRemark sets the "remark" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetRemark(s string) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.SetRemark(s)
+	return pcbuo
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (pcbuo *PriceChangeBatchUpdateOne) SetNillableRemark(s *string) *PriceChangeBatchUpdateOne {
+	if s != nil {
+		pcbuo.SetRemark(*s)
+	}
+	return pcbuo
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetFailReason(s string) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.SetFailReason(s)
+	return pcbuo
+}
+
+// SetNillableFailReason sets the "fail_reason" field if the given value is not nil.
+func (pcbuo *PriceChangeBatchUpdateOne) SetNillableFailReason(s *string) *PriceChangeBatchUpdateOne {
+	if s != nil {
+		pcbuo.SetFailReason(*s)
+	}
+	return pcbuo
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetAppliedAt(t time.Time) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.SetAppliedAt(t)
+	return pcbuo
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (pcbuo *PriceChangeBatchUpdateOne) SetNillableAppliedAt(t *time.Time) *PriceChangeBatchUpdateOne {
+	if t != nil {
+		pcbuo.SetAppliedAt(*t)
+	}
+	return pcbuo
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (pcbuo *PriceChangeBatchUpdateOne) ClearAppliedAt() *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.ClearAppliedAt()
+	return pcbuo
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetOperatorID(u uuid.UUID) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.SetOperatorID(u)
+	return pcbuo
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (pcbuo *PriceChangeBatchUpdateOne) SetNillableOperatorID(u *uuid.UUID) *PriceChangeBatchUpdateOne {
+	if u != nil {
+		pcbuo.SetOperatorID(*u)
+	}
+	return pcbuo
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetOperatorName(s string) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.SetOperatorName(s)
+	return pcbuo
+}
+
+// SetNillableOperatorName sets the "operator_name" field if the given value is not nil.
+func (pcbuo *PriceChangeBatchUpdateOne) SetNillableOperatorName(s *string) *PriceChangeBatchUpdateOne {
+	if s != nil {
+		pcbuo.SetOperatorName(*s)
+	}
+	return pcbuo
+}
+
+// SetOperationLogs sets the "operation_logs" field.
+func (pcbuo *PriceChangeBatchUpdateOne) SetOperationLogs(dcol []domain.PriceChangeOperationLog) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.SetOperationLogs(dcol)
+	return pcbuo
+}
+
+// AppendOperationLogs appends dcol to the "operation_logs" field.
+func (pcbuo *PriceChangeBatchUpdateOne) AppendOperationLogs(dcol []domain.PriceChangeOperationLog) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.AppendOperationLogs(dcol)
+	return pcbuo
+}
+
+// ClearOperationLogs clears the value of the "operation_logs" field.
+func (pcbuo *PriceChangeBatchUpdateOne) ClearOperationLogs() *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.ClearOperationLogs()
+	return pcbuo
+}
+
+// AddItemIDs adds the "items" edge to the PriceChangeItem entity by IDs.
+func (pcbuo *PriceChangeBatchUpdateOne) AddItemIDs(ids ...uuid.UUID) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.AddItemIDs(ids...)
+	return pcbuo
+}
+
+// AddItems adds the "items" edges to the PriceChangeItem entity.
+func (pcbuo *PriceChangeBatchUpdateOne) AddItems(p ...*PriceChangeItem) *PriceChangeBatchUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcbuo.AddItemIDs(ids...)
+}
+
+// Mutation returns the PriceChangeBatchMutation object of the builder.
+func (pcbuo *PriceChangeBatchUpdateOne) Mutation() *PriceChangeBatchMutation {
+	return pcbuo.mutation
+}
+
+// ClearItems clears all "items" edges to the PriceChangeItem entity.
+func (pcbuo *PriceChangeBatchUpdateOne) ClearItems() *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.ClearItems()
+	return pcbuo
+}
+
+// RemoveItemIDs removes the "items" edge to PriceChangeItem entities by IDs.
+func (pcbuo *PriceChangeBatchUpdateOne) RemoveItemIDs(ids ...uuid.UUID) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.RemoveItemIDs(ids...)
+	return pcbuo
+}
+
+// RemoveItems removes "items" edges to PriceChangeItem entities.
+func (pcbuo *PriceChangeBatchUpdateOne) RemoveItems(p ...*PriceChangeItem) *PriceChangeBatchUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcbuo.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the PriceChangeBatchUpdate builder.
+func (pcbuo *PriceChangeBatchUpdateOne) Where(ps ...predicate.PriceChangeBatch) *PriceChangeBatchUpdateOne {
+	pcbuo.mutation.Where(ps...)
+	return pcbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcbuo *PriceChangeBatchUpdateOne) Select(field string, fields ...string) *PriceChangeBatchUpdateOne {
+	pcbuo.fields = append([]string{field}, fields...)
+	return pcbuo
+}
+
+// Save executes the query and returns the updated PriceChangeBatch entity.
+func (pcbuo *PriceChangeBatchUpdateOne) Save(ctx context.Context) (*PriceChangeBatch, error) {
+	if err := pcbuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, pcbuo.sqlSave, pcbuo.mutation, pcbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcbuo *PriceChangeBatchUpdateOne) SaveX(ctx context.Context) *PriceChangeBatch {
+	node, err := pcbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcbuo *PriceChangeBatchUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcbuo *PriceChangeBatchUpdateOne) ExecX(ctx context.Context) {
+	if err := pcbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcbuo *PriceChangeBatchUpdateOne) defaults() error {
+	if _, ok := pcbuo.mutation.UpdatedAt(); !ok {
+		if pricechangebatch.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pricechangebatch.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := pricechangebatch.UpdateDefaultUpdatedAt()
+		pcbuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcbuo *PriceChangeBatchUpdateOne) check() error {
+	if v, ok := pcbuo.mutation.Name(); ok {
+		if err := pricechangebatch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.name": %w`, err)}
+		}
+	}
+	if v, ok := pcbuo.mutation.Status(); ok {
+		if err := pricechangebatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.status": %w`, err)}
+		}
+	}
+	if v, ok := pcbuo.mutation.Remark(); ok {
+		if err := pricechangebatch.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.remark": %w`, err)}
+		}
+	}
+	if v, ok := pcbuo.mutation.FailReason(); ok {
+		if err := pricechangebatch.FailReasonValidator(v); err != nil {
+			return &ValidationError{Name: "fail_reason", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.fail_reason": %w`, err)}
+		}
+	}
+	if v, ok := pcbuo.mutation.OperatorName(); ok {
+		if err := pricechangebatch.OperatorNameValidator(v); err != nil {
+			return &ValidationError{Name: "operator_name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeBatch.operator_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pcbuo *PriceChangeBatchUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PriceChangeBatchUpdateOne {
+	pcbuo.modifiers = append(pcbuo.modifiers, modifiers...)
+	return pcbuo
+}
+
+func (pcbuo *PriceChangeBatchUpdateOne) sqlSave(ctx context.Context) (_node *PriceChangeBatch, err error) {
+	if err := pcbuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricechangebatch.Table, pricechangebatch.Columns, sqlgraph.NewFieldSpec(pricechangebatch.FieldID, field.TypeUUID))
+	id, ok := pcbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PriceChangeBatch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricechangebatch.FieldID)
+		for _, f := range fields {
+			if !pricechangebatch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pricechangebatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcbuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(pricechangebatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pcbuo.mutation.DeletedAt(); ok {
+		_spec.SetField(pricechangebatch.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := pcbuo.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(pricechangebatch.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := pcbuo.mutation.Name(); ok {
+		_spec.SetField(pricechangebatch.FieldName, field.TypeString, value)
+	}
+	if value, ok := pcbuo.mutation.StoreIds(); ok {
+		_spec.SetField(pricechangebatch.FieldStoreIds, field.TypeJSON, value)
+	}
+	if value, ok := pcbuo.mutation.AppendedStoreIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricechangebatch.FieldStoreIds, value)
+		})
+	}
+	if pcbuo.mutation.StoreIdsCleared() {
+		_spec.ClearField(pricechangebatch.FieldStoreIds, field.TypeJSON)
+	}
+	if value, ok := pcbuo.mutation.EffectiveAt(); ok {
+		_spec.SetField(pricechangebatch.FieldEffectiveAt, field.TypeTime, value)
+	}
+	if value, ok := pcbuo.mutation.Status(); ok {
+		_spec.SetField(pricechangebatch.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := pcbuo.mutation.Remark(); ok {
+		_spec.SetField(pricechangebatch.FieldRemark, field.TypeString, value)
+	}
+	if value, ok := pcbuo.mutation.FailReason(); ok {
+		_spec.SetField(pricechangebatch.FieldFailReason, field.TypeString, value)
+	}
+	if value, ok := pcbuo.mutation.AppliedAt(); ok {
+		_spec.SetField(pricechangebatch.FieldAppliedAt, field.TypeTime, value)
+	}
+	if pcbuo.mutation.AppliedAtCleared() {
+		_spec.ClearField(pricechangebatch.FieldAppliedAt, field.TypeTime)
+	}
+	if value, ok := pcbuo.mutation.OperatorID(); ok {
+		_spec.SetField(pricechangebatch.FieldOperatorID, field.TypeUUID, value)
+	}
+	if value, ok := pcbuo.mutation.OperatorName(); ok {
+		_spec.SetField(pricechangebatch.FieldOperatorName, field.TypeString, value)
+	}
+	if value, ok := pcbuo.mutation.OperationLogs(); ok {
+		_spec.SetField(pricechangebatch.FieldOperationLogs, field.TypeJSON, value)
+	}
+	if value, ok := pcbuo.mutation.AppendedOperationLogs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricechangebatch.FieldOperationLogs, value)
+		})
+	}
+	if pcbuo.mutation.OperationLogsCleared() {
+		_spec.ClearField(pricechangebatch.FieldOperationLogs, field.TypeJSON)
+	}
+	if pcbuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pricechangebatch.ItemsTable,
+			Columns: []string{pricechangebatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcbuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !pcbuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pricechangebatch.ItemsTable,
+			Columns: []string{pricechangebatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcbuo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pricechangebatch.ItemsTable,
+			Columns: []string{pricechangebatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pcbuo.modifiers...)
+	_node = &PriceChangeBatch{config: pcbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricechangebatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pcbuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
+)
+
+// PriceChangeItem is the model entity for the PriceChangeItem schema.
+type PriceChangeItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// 调价批次ID
+	BatchID uuid.UUID `json:"batch_id,omitempty"`
+	// 调价对象：product_spec（商品规格价）、menu_item（菜单价）
+	TargetType domain.PriceChangeTargetType `json:"target_type,omitempty"`
+	// 商品ID
+	ProductID uuid.UUID `json:"product_id,omitempty"`
+	// 规格ID（商品规格价时必填）
+	SpecID uuid.UUID `json:"spec_id,omitempty"`
+	// 门店ID（菜单价时必填）
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 商品名称快照
+	ProductName string `json:"product_name,omitempty"`
+	// 规格名称快照
+	SpecName string `json:"spec_name,omitempty"`
+	// 调整后价格（单位：令吉）
+	NewPrice decimal.Decimal `json:"new_price,omitempty"`
+	// 调整前价格（生效时记录，用于回滚；菜单价为空表示原先未设置菜单价）
+	OldPrice *decimal.Decimal `json:"old_price,omitempty"`
+	// 实际调整的规格关联ID或菜单项ID（生效时记录）
+	TargetID uuid.UUID `json:"target_id,omitempty"`
+	// 是否已生效（门店菜单未包含该商品时跳过）
+	Applied bool `json:"applied,omitempty"`
+	// 菜单价：各菜单项调整前价格（生效时记录，用于逐项回滚）
+	MenuItemOldPrices []domain.PriceChangeMenuItemOldPrice `json:"menu_item_old_prices,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PriceChangeItemQuery when eager-loading is set.
+	Edges        PriceChangeItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PriceChangeItemEdges holds the relations/edges for other nodes in the graph.
+type PriceChangeItemEdges struct {
+	// 所属调价批次
+	Batch *PriceChangeBatch `json:"batch,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BatchOrErr returns the Batch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PriceChangeItemEdges) BatchOrErr() (*PriceChangeBatch, error) {
+	if e.Batch != nil {
+		return e.Batch, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: pricechangebatch.Label}
+	}
+	return nil, &NotLoadedError{edge: "batch"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PriceChangeItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pricechangeitem.FieldOldPrice:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case pricechangeitem.FieldMenuItemOldPrices:
+			values[i] = new([]byte)
+		case pricechangeitem.FieldNewPrice:
+			values[i] = new(decimal.Decimal)
+		case pricechangeitem.FieldApplied:
+			values[i] = new(sql.NullBool)
+		case pricechangeitem.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case pricechangeitem.FieldTargetType, pricechangeitem.FieldProductName, pricechangeitem.FieldSpecName:
+			values[i] = new(sql.NullString)
+		case pricechangeitem.FieldCreatedAt, pricechangeitem.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case pricechangeitem.FieldID, pricechangeitem.FieldBatchID, pricechangeitem.FieldProductID, pricechangeitem.FieldSpecID, pricechangeitem.FieldStoreID, pricechangeitem.FieldTargetID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PriceChangeItem fields.
+func (pci *PriceChangeItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pricechangeitem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pci.ID = *value
+			}
+		case pricechangeitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pci.CreatedAt = value.Time
+			}
+		case pricechangeitem.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pci.UpdatedAt = value.Time
+			}
+		case pricechangeitem.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pci.DeletedAt = value.Int64
+			}
+		case pricechangeitem.FieldBatchID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_id", values[i])
+			} else if value != nil {
+				pci.BatchID = *value
+			}
+		case pricechangeitem.FieldTargetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_type", values[i])
+			} else if value.Valid {
+				pci.TargetType = domain.PriceChangeTargetType(value.String)
+			}
+		case pricechangeitem.FieldProductID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value != nil {
+				pci.ProductID = *value
+			}
+		case pricechangeitem.FieldSpecID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field spec_id", values[i])
+			} else if value != nil {
+				pci.SpecID = *value
+			}
+		case pricechangeitem.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				pci.StoreID = *value
+			}
+		case pricechangeitem.FieldProductName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_name", values[i])
+			} else if value.Valid {
+				pci.ProductName = value.String
+			}
+		case pricechangeitem.FieldSpecName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spec_name", values[i])
+			} else if value.Valid {
+				pci.SpecName = value.String
+			}
+		case pricechangeitem.FieldNewPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field new_price", values[i])
+			} else if value != nil {
+				pci.NewPrice = *value
+			}
+		case pricechangeitem.FieldOldPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field old_price", values[i])
+			} else if value.Valid {
+				pci.OldPrice = new(decimal.Decimal)
+				*pci.OldPrice = *value.S.(*decimal.Decimal)
+			}
+		case pricechangeitem.FieldTargetID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value != nil {
+				pci.TargetID = *value
+			}
+		case pricechangeitem.FieldApplied:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field applied", values[i])
+			} else if value.Valid {
+				pci.Applied = value.Bool
+			}
+		case pricechangeitem.FieldMenuItemOldPrices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field menu_item_old_prices", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pci.MenuItemOldPrices); err != nil {
+					return fmt.Errorf("unmarshal field menu_item_old_prices: %w", err)
+				}
+			}
+		default:
+			pci.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PriceChangeItem.
+// This includes values selected through modifiers, order, etc.
+func (pci *PriceChangeItem) Value(name string) (ent.Value, error) {
+	return pci.selectValues.Get(name)
+}
+
+// QueryBatch queries the "batch" edge of the PriceChangeItem entity.
+func (pci *PriceChangeItem) QueryBatch() *PriceChangeBatchQuery {
+	return NewPriceChangeItemClient(pci.config).QueryBatch(pci)
+}
+
+// Update returns a builder for updating this PriceChangeItem.
+// Note that you need to call PriceChangeItem.Unwrap() before calling this method if this PriceChangeItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pci *PriceChangeItem) Update() *PriceChangeItemUpdateOne {
+	return NewPriceChangeItemClient(pci.config).UpdateOne(pci)
+}
+
+// Unwrap unwraps the PriceChangeItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pci *PriceChangeItem) Unwrap() *PriceChangeItem {
+	_tx, ok := pci.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PriceChangeItem is not a transactional entity")
+	}
+	pci.config.driver = _tx.drv
+	return pci
+}
+
+// String implements the fmt.Stringer.
+func (pci *PriceChangeItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("PriceChangeItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pci.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pci.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pci.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", pci.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("batch_id=")
+	builder.WriteString(fmt.Sprintf("%v", pci.BatchID))
+	builder.WriteString(", ")
+	builder.WriteString("target_type=")
+	builder.WriteString(fmt.Sprintf("%v", pci.TargetType))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pci.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("spec_id=")
+	builder.WriteString(fmt.Sprintf("%v", pci.SpecID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", pci.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("product_name=")
+	builder.WriteString(pci.ProductName)
+	builder.WriteString(", ")
+	builder.WriteString("spec_name=")
+	builder.WriteString(pci.SpecName)
+	builder.WriteString(", ")
+	builder.WriteString("new_price=")
+	builder.WriteString(fmt.Sprintf("%v", pci.NewPrice))
+	builder.WriteString(", ")
+	if v := pci.OldPrice; v != nil {
+		builder.WriteString("old_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", pci.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("applied=")
+	builder.WriteString(fmt.Sprintf("%v", pci.Applied))
+	builder.WriteString(", ")
+	builder.WriteString("menu_item_old_prices=")
+	builder.WriteString(fmt.Sprintf("%v", pci.MenuItemOldPrices))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PriceChangeItems is a parsable slice of PriceChangeItem.
+type PriceChangeItems []*PriceChangeItem
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pricechangeitem
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	// Label holds the string label denoting the pricechangeitem type in the database.
+	Label = "price_change_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldBatchID holds the string denoting the batch_id field in the database.
+	FieldBatchID = "batch_id"
+	// FieldTargetType holds the string denoting the target_type field in the database.
+	FieldTargetType = "target_type"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldSpecID holds the string denoting the spec_id field in the database.
+	FieldSpecID = "spec_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldProductName holds the string denoting the product_name field in the database.
+	FieldProductName = "product_name"
+	// FieldSpecName holds the string denoting the spec_name field in the database.
+	FieldSpecName = "spec_name"
+	// FieldNewPrice holds the string denoting the new_price field in the database.
+	FieldNewPrice = "new_price"
+	// FieldOldPrice holds the string denoting the old_price field in the database.
+	FieldOldPrice = "old_price"
+	// FieldTargetID holds the string denoting the target_id field in the database.
+	FieldTargetID = "target_id"
+	// FieldApplied holds the string denoting the applied field in the database.
+	FieldApplied = "applied"
+	// FieldMenuItemOldPrices holds the string denoting the menu_item_old_prices field in the database.
+	FieldMenuItemOldPrices = "menu_item_old_prices"
+	// EdgeBatch holds the string denoting the batch edge name in mutations.
+	EdgeBatch = "batch"
+	// Table holds the table name of the pricechangeitem in the database.
+	Table = "price_change_items"
+	// BatchTable is the table that holds the batch relation/edge.
+	BatchTable = "price_change_items"
+	// BatchInverseTable is the table name for the PriceChangeBatch entity.
+	// It exists in this package in order to avoid circular dependency with the "pricechangebatch" package.
+	BatchInverseTable = "price_change_batches"
+	// BatchColumn is the table column denoting the batch relation/edge.
+	BatchColumn = "batch_id"
+)
+
+// Columns holds all SQL columns for pricechangeitem fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldBatchID,
+	FieldTargetType,
+	FieldProductID,
+	FieldSpecID,
+	FieldStoreID,
+	FieldProductName,
+	FieldSpecName,
+	FieldNewPrice,
+	FieldOldPrice,
+	FieldTargetID,
+	FieldApplied,
+	FieldMenuItemOldPrices,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "gitlab.jiguang.dev/pos-dine/dine/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultDeletedAt holds the default value on creation for the "deleted_at" field.
+	DefaultDeletedAt int64
+	// DefaultSpecID holds the default value on creation for the "spec_id" field.
+	DefaultSpecID func() uuid.UUID
+	// DefaultStoreID holds the default value on creation for the "store_id" field.
+	DefaultStoreID func() uuid.UUID
+	// DefaultProductName holds the default value on creation for the "product_name" field.
+	DefaultProductName string
+	// ProductNameValidator is a validator for the "product_name" field. It is called by the builders before save.
+	ProductNameValidator func(string) error
+	// DefaultSpecName holds the default value on creation for the "spec_name" field.
+	DefaultSpecName string
+	// SpecNameValidator is a validator for the "spec_name" field. It is called by the builders before save.
+	SpecNameValidator func(string) error
+	// DefaultTargetID holds the default value on creation for the "target_id" field.
+	DefaultTargetID func() uuid.UUID
+	// DefaultApplied holds the default value on creation for the "applied" field.
+	DefaultApplied bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// TargetTypeValidator is a validator for the "target_type" field enum values. It is called by the builders before save.
+func TargetTypeValidator(tt domain.PriceChangeTargetType) error {
+	switch tt {
+	case "product_spec", "menu_item":
+		return nil
+	default:
+		return fmt.Errorf("pricechangeitem: invalid enum value for target_type field: %q", tt)
+	}
+}
+
+// OrderOption defines the ordering options for the PriceChangeItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByBatchID orders the results by the batch_id field.
+func ByBatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchID, opts...).ToFunc()
+}
+
+// ByTargetType orders the results by the target_type field.
+func ByTargetType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetType, opts...).ToFunc()
+}
+
+// ByProductID orders the results by the product_id field.
+func ByProductID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductID, opts...).ToFunc()
+}
+
+// BySpecID orders the results by the spec_id field.
+func BySpecID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpecID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByProductName orders the results by the product_name field.
+func ByProductName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductName, opts...).ToFunc()
+}
+
+// BySpecName orders the results by the spec_name field.
+func BySpecName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpecName, opts...).ToFunc()
+}
+
+// ByNewPrice orders the results by the new_price field.
+func ByNewPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewPrice, opts...).ToFunc()
+}
+
+// ByOldPrice orders the results by the old_price field.
+func ByOldPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldPrice, opts...).ToFunc()
+}
+
+// ByTargetID orders the results by the target_id field.
+func ByTargetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetID, opts...).ToFunc()
+}
+
+// ByApplied orders the results by the applied field.
+func ByApplied(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplied, opts...).ToFunc()
+}
+
+// ByBatchField orders the results by batch field.
+func ByBatchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBatchStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBatchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BatchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BatchTable, BatchColumn),
+	)
+}
@@ -0,0 +1,732 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pricechangeitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// BatchID applies equality check predicate on the "batch_id" field. It's identical to BatchIDEQ.
+func BatchID(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldBatchID, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldProductID, v))
+}
+
+// SpecID applies equality check predicate on the "spec_id" field. It's identical to SpecIDEQ.
+func SpecID(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldSpecID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldStoreID, v))
+}
+
+// ProductName applies equality check predicate on the "product_name" field. It's identical to ProductNameEQ.
+func ProductName(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldProductName, v))
+}
+
+// SpecName applies equality check predicate on the "spec_name" field. It's identical to SpecNameEQ.
+func SpecName(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldSpecName, v))
+}
+
+// NewPrice applies equality check predicate on the "new_price" field. It's identical to NewPriceEQ.
+func NewPrice(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldNewPrice, v))
+}
+
+// OldPrice applies equality check predicate on the "old_price" field. It's identical to OldPriceEQ.
+func OldPrice(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldOldPrice, v))
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldTargetID, v))
+}
+
+// Applied applies equality check predicate on the "applied" field. It's identical to AppliedEQ.
+func Applied(v bool) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldApplied, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// BatchIDEQ applies the EQ predicate on the "batch_id" field.
+func BatchIDEQ(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldBatchID, v))
+}
+
+// BatchIDNEQ applies the NEQ predicate on the "batch_id" field.
+func BatchIDNEQ(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldBatchID, v))
+}
+
+// BatchIDIn applies the In predicate on the "batch_id" field.
+func BatchIDIn(vs ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldBatchID, vs...))
+}
+
+// BatchIDNotIn applies the NotIn predicate on the "batch_id" field.
+func BatchIDNotIn(vs ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldBatchID, vs...))
+}
+
+// TargetTypeEQ applies the EQ predicate on the "target_type" field.
+func TargetTypeEQ(v domain.PriceChangeTargetType) predicate.PriceChangeItem {
+	vc := v
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldTargetType, vc))
+}
+
+// TargetTypeNEQ applies the NEQ predicate on the "target_type" field.
+func TargetTypeNEQ(v domain.PriceChangeTargetType) predicate.PriceChangeItem {
+	vc := v
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldTargetType, vc))
+}
+
+// TargetTypeIn applies the In predicate on the "target_type" field.
+func TargetTypeIn(vs ...domain.PriceChangeTargetType) predicate.PriceChangeItem {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceChangeItem(sql.FieldIn(FieldTargetType, v...))
+}
+
+// TargetTypeNotIn applies the NotIn predicate on the "target_type" field.
+func TargetTypeNotIn(vs ...domain.PriceChangeTargetType) predicate.PriceChangeItem {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldTargetType, v...))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldProductID, v))
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldProductID, v))
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldProductID, v))
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldProductID, v))
+}
+
+// SpecIDEQ applies the EQ predicate on the "spec_id" field.
+func SpecIDEQ(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldSpecID, v))
+}
+
+// SpecIDNEQ applies the NEQ predicate on the "spec_id" field.
+func SpecIDNEQ(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldSpecID, v))
+}
+
+// SpecIDIn applies the In predicate on the "spec_id" field.
+func SpecIDIn(vs ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldSpecID, vs...))
+}
+
+// SpecIDNotIn applies the NotIn predicate on the "spec_id" field.
+func SpecIDNotIn(vs ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldSpecID, vs...))
+}
+
+// SpecIDGT applies the GT predicate on the "spec_id" field.
+func SpecIDGT(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldSpecID, v))
+}
+
+// SpecIDGTE applies the GTE predicate on the "spec_id" field.
+func SpecIDGTE(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldSpecID, v))
+}
+
+// SpecIDLT applies the LT predicate on the "spec_id" field.
+func SpecIDLT(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldSpecID, v))
+}
+
+// SpecIDLTE applies the LTE predicate on the "spec_id" field.
+func SpecIDLTE(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldSpecID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldStoreID, v))
+}
+
+// ProductNameEQ applies the EQ predicate on the "product_name" field.
+func ProductNameEQ(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldProductName, v))
+}
+
+// ProductNameNEQ applies the NEQ predicate on the "product_name" field.
+func ProductNameNEQ(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldProductName, v))
+}
+
+// ProductNameIn applies the In predicate on the "product_name" field.
+func ProductNameIn(vs ...string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldProductName, vs...))
+}
+
+// ProductNameNotIn applies the NotIn predicate on the "product_name" field.
+func ProductNameNotIn(vs ...string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldProductName, vs...))
+}
+
+// ProductNameGT applies the GT predicate on the "product_name" field.
+func ProductNameGT(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldProductName, v))
+}
+
+// ProductNameGTE applies the GTE predicate on the "product_name" field.
+func ProductNameGTE(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldProductName, v))
+}
+
+// ProductNameLT applies the LT predicate on the "product_name" field.
+func ProductNameLT(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldProductName, v))
+}
+
+// ProductNameLTE applies the LTE predicate on the "product_name" field.
+func ProductNameLTE(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldProductName, v))
+}
+
+// ProductNameContains applies the Contains predicate on the "product_name" field.
+func ProductNameContains(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldContains(FieldProductName, v))
+}
+
+// ProductNameHasPrefix applies the HasPrefix predicate on the "product_name" field.
+func ProductNameHasPrefix(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldHasPrefix(FieldProductName, v))
+}
+
+// ProductNameHasSuffix applies the HasSuffix predicate on the "product_name" field.
+func ProductNameHasSuffix(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldHasSuffix(FieldProductName, v))
+}
+
+// ProductNameEqualFold applies the EqualFold predicate on the "product_name" field.
+func ProductNameEqualFold(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEqualFold(FieldProductName, v))
+}
+
+// ProductNameContainsFold applies the ContainsFold predicate on the "product_name" field.
+func ProductNameContainsFold(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldContainsFold(FieldProductName, v))
+}
+
+// SpecNameEQ applies the EQ predicate on the "spec_name" field.
+func SpecNameEQ(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldSpecName, v))
+}
+
+// SpecNameNEQ applies the NEQ predicate on the "spec_name" field.
+func SpecNameNEQ(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldSpecName, v))
+}
+
+// SpecNameIn applies the In predicate on the "spec_name" field.
+func SpecNameIn(vs ...string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldSpecName, vs...))
+}
+
+// SpecNameNotIn applies the NotIn predicate on the "spec_name" field.
+func SpecNameNotIn(vs ...string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldSpecName, vs...))
+}
+
+// SpecNameGT applies the GT predicate on the "spec_name" field.
+func SpecNameGT(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldSpecName, v))
+}
+
+// SpecNameGTE applies the GTE predicate on the "spec_name" field.
+func SpecNameGTE(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldSpecName, v))
+}
+
+// SpecNameLT applies the LT predicate on the "spec_name" field.
+func SpecNameLT(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldSpecName, v))
+}
+
+// SpecNameLTE applies the LTE predicate on the "spec_name" field.
+func SpecNameLTE(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldSpecName, v))
+}
+
+// SpecNameContains applies the Contains predicate on the "spec_name" field.
+func SpecNameContains(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldContains(FieldSpecName, v))
+}
+
+// SpecNameHasPrefix applies the HasPrefix predicate on the "spec_name" field.
+func SpecNameHasPrefix(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldHasPrefix(FieldSpecName, v))
+}
+
+// SpecNameHasSuffix applies the HasSuffix predicate on the "spec_name" field.
+func SpecNameHasSuffix(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldHasSuffix(FieldSpecName, v))
+}
+
+// SpecNameEqualFold applies the EqualFold predicate on the "spec_name" field.
+func SpecNameEqualFold(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEqualFold(FieldSpecName, v))
+}
+
+// SpecNameContainsFold applies the ContainsFold predicate on the "spec_name" field.
+func SpecNameContainsFold(v string) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldContainsFold(FieldSpecName, v))
+}
+
+// NewPriceEQ applies the EQ predicate on the "new_price" field.
+func NewPriceEQ(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldNewPrice, v))
+}
+
+// NewPriceNEQ applies the NEQ predicate on the "new_price" field.
+func NewPriceNEQ(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldNewPrice, v))
+}
+
+// NewPriceIn applies the In predicate on the "new_price" field.
+func NewPriceIn(vs ...decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldNewPrice, vs...))
+}
+
+// NewPriceNotIn applies the NotIn predicate on the "new_price" field.
+func NewPriceNotIn(vs ...decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldNewPrice, vs...))
+}
+
+// NewPriceGT applies the GT predicate on the "new_price" field.
+func NewPriceGT(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldNewPrice, v))
+}
+
+// NewPriceGTE applies the GTE predicate on the "new_price" field.
+func NewPriceGTE(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldNewPrice, v))
+}
+
+// NewPriceLT applies the LT predicate on the "new_price" field.
+func NewPriceLT(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldNewPrice, v))
+}
+
+// NewPriceLTE applies the LTE predicate on the "new_price" field.
+func NewPriceLTE(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldNewPrice, v))
+}
+
+// OldPriceEQ applies the EQ predicate on the "old_price" field.
+func OldPriceEQ(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldOldPrice, v))
+}
+
+// OldPriceNEQ applies the NEQ predicate on the "old_price" field.
+func OldPriceNEQ(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldOldPrice, v))
+}
+
+// OldPriceIn applies the In predicate on the "old_price" field.
+func OldPriceIn(vs ...decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldOldPrice, vs...))
+}
+
+// OldPriceNotIn applies the NotIn predicate on the "old_price" field.
+func OldPriceNotIn(vs ...decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldOldPrice, vs...))
+}
+
+// OldPriceGT applies the GT predicate on the "old_price" field.
+func OldPriceGT(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldOldPrice, v))
+}
+
+// OldPriceGTE applies the GTE predicate on the "old_price" field.
+func OldPriceGTE(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldOldPrice, v))
+}
+
+// OldPriceLT applies the LT predicate on the "old_price" field.
+func OldPriceLT(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldOldPrice, v))
+}
+
+// OldPriceLTE applies the LTE predicate on the "old_price" field.
+func OldPriceLTE(v decimal.Decimal) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldOldPrice, v))
+}
+
+// OldPriceIsNil applies the IsNil predicate on the "old_price" field.
+func OldPriceIsNil() predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIsNull(FieldOldPrice))
+}
+
+// OldPriceNotNil applies the NotNil predicate on the "old_price" field.
+func OldPriceNotNil() predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotNull(FieldOldPrice))
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldTargetID, v))
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIn(FieldTargetID, vs...))
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotIn(FieldTargetID, vs...))
+}
+
+// TargetIDGT applies the GT predicate on the "target_id" field.
+func TargetIDGT(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGT(FieldTargetID, v))
+}
+
+// TargetIDGTE applies the GTE predicate on the "target_id" field.
+func TargetIDGTE(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldGTE(FieldTargetID, v))
+}
+
+// TargetIDLT applies the LT predicate on the "target_id" field.
+func TargetIDLT(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLT(FieldTargetID, v))
+}
+
+// TargetIDLTE applies the LTE predicate on the "target_id" field.
+func TargetIDLTE(v uuid.UUID) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldLTE(FieldTargetID, v))
+}
+
+// AppliedEQ applies the EQ predicate on the "applied" field.
+func AppliedEQ(v bool) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldEQ(FieldApplied, v))
+}
+
+// AppliedNEQ applies the NEQ predicate on the "applied" field.
+func AppliedNEQ(v bool) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNEQ(FieldApplied, v))
+}
+
+// MenuItemOldPricesIsNil applies the IsNil predicate on the "menu_item_old_prices" field.
+func MenuItemOldPricesIsNil() predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldIsNull(FieldMenuItemOldPrices))
+}
+
+// MenuItemOldPricesNotNil applies the NotNil predicate on the "menu_item_old_prices" field.
+func MenuItemOldPricesNotNil() predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.FieldNotNull(FieldMenuItemOldPrices))
+}
+
+// HasBatch applies the HasEdge predicate on the "batch" edge.
+func HasBatch() predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BatchTable, BatchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBatchWith applies the HasEdge predicate on the "batch" edge with a given conditions (other predicates).
+func HasBatchWith(preds ...predicate.PriceChangeBatch) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(func(s *sql.Selector) {
+		step := newBatchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PriceChangeItem) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PriceChangeItem) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PriceChangeItem) predicate.PriceChangeItem {
+	return predicate.PriceChangeItem(sql.NotPredicates(p))
+}
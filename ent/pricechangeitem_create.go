@@ -0,0 +1,1277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
+)
+
+// PriceChangeItemCreate is the builder for creating a PriceChangeItem entity.
+type PriceChangeItemCreate struct {
+	config
+	mutation *PriceChangeItemMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pcic *PriceChangeItemCreate) SetCreatedAt(t time.Time) *PriceChangeItemCreate {
+	pcic.mutation.SetCreatedAt(t)
+	return pcic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pcic *PriceChangeItemCreate) SetNillableCreatedAt(t *time.Time) *PriceChangeItemCreate {
+	if t != nil {
+		pcic.SetCreatedAt(*t)
+	}
+	return pcic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcic *PriceChangeItemCreate) SetUpdatedAt(t time.Time) *PriceChangeItemCreate {
+	pcic.mutation.SetUpdatedAt(t)
+	return pcic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pcic *PriceChangeItemCreate) SetNillableUpdatedAt(t *time.Time) *PriceChangeItemCreate {
+	if t != nil {
+		pcic.SetUpdatedAt(*t)
+	}
+	return pcic
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pcic *PriceChangeItemCreate) SetDeletedAt(i int64) *PriceChangeItemCreate {
+	pcic.mutation.SetDeletedAt(i)
+	return pcic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pcic *PriceChangeItemCreate) SetNillableDeletedAt(i *int64) *PriceChangeItemCreate {
+	if i != nil {
+		pcic.SetDeletedAt(*i)
+	}
+	return pcic
+}
+
+// SetBatchID sets the "batch_id" field.
+func (pcic *PriceChangeItemCreate) SetBatchID(u uuid.UUID) *PriceChangeItemCreate {
+	pcic.mutation.SetBatchID(u)
+	return pcic
+}
+
+// SetTargetType sets the "target_type" field.
+func (pcic *PriceChangeItemCreate) SetTargetType(dctt domain.PriceChangeTargetType) *PriceChangeItemCreate {
+	pcic.mutation.SetTargetType(dctt)
+	return pcic
+}
+
+// SetProductID sets the "product_id" field.
+func (pcic *PriceChangeItemCreate) SetProductID(u uuid.UUID) *PriceChangeItemCreate {
+	pcic.mutation.SetProductID(u)
+	return pcic
+}
+
+// SetSpecID sets the "spec_id" field.
+func (pcic *PriceChangeItemCreate) SetSpecID(u uuid.UUID) *PriceChangeItemCreate {
+	pcic.mutation.SetSpecID(u)
+	return pcic
+}
+
+// SetNillableSpecID sets the "spec_id" field if the given value is not nil.
+func (pcic *PriceChangeItemCreate) SetNillableSpecID(u *uuid.UUID) *PriceChangeItemCreate {
+	if u != nil {
+		pcic.SetSpecID(*u)
+	}
+	return pcic
+}
+
+// SetStoreID sets the "store_id" field.
+func (pcic *PriceChangeItemCreate) SetStoreID(u uuid.UUID) *PriceChangeItemCreate {
+	pcic.mutation.SetStoreID(u)
+	return pcic
+}
+
+// SetNillableStoreID sets the "store_id" field if the given value is not nil.
+func (pcic *PriceChangeItemCreate) SetNillableStoreID(u *uuid.UUID) *PriceChangeItemCreate {
+	if u != nil {
+		pcic.SetStoreID(*u)
+	}
+	return pcic
+}
+
+// SetProductName sets the "product_name" field.
+func (pcic *PriceChangeItemCreate) SetProductName(s string) *PriceChangeItemCreate {
+	pcic.mutation.SetProductName(s)
+	return pcic
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (pcic *PriceChangeItemCreate) SetNillableProductName(s *string) *PriceChangeItemCreate {
+	if s != nil {
+		pcic.SetProductName(*s)
+	}
+	return pcic
+}
+
+// SetSpecName sets the "spec_name" field.
+func (pcic *PriceChangeItemCreate) SetSpecName(s string) *PriceChangeItemCreate {
+	pcic.mutation.SetSpecName(s)
+	return pcic
+}
+
+// SetNillableSpecName sets the "spec_name" field if the given value is not nil.
+func (pcic *PriceChangeItemCreate) SetNillableSpecName(s *string) *PriceChangeItemCreate {
+	if s != nil {
+		pcic.SetSpecName(*s)
+	}
+	return pcic
+}
+
+// SetNewPrice sets the "new_price" field.
+func (pcic *PriceChangeItemCreate) SetNewPrice(d decimal.Decimal) *PriceChangeItemCreate {
+	pcic.mutation.SetNewPrice(d)
+	return pcic
+}
+
+// SetOldPrice sets the "old_price" field.
+func (pcic *PriceChangeItemCreate) SetOldPrice(d decimal.Decimal) *PriceChangeItemCreate {
+	pcic.mutation.SetOldPrice(d)
+	return pcic
+}
+
+// SetNillableOldPrice sets the "old_price" field if the given value is not nil.
+func (pcic *PriceChangeItemCreate) SetNillableOldPrice(d *decimal.Decimal) *PriceChangeItemCreate {
+	if d != nil {
+		pcic.SetOldPrice(*d)
+	}
+	return pcic
+}
+
+// SetTargetID sets the "target_id" field.
+func (pcic *PriceChangeItemCreate) SetTargetID(u uuid.UUID) *PriceChangeItemCreate {
+	pcic.mutation.SetTargetID(u)
+	return pcic
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (pcic *PriceChangeItemCreate) SetNillableTargetID(u *uuid.UUID) *PriceChangeItemCreate {
+	if u != nil {
+		pcic.SetTargetID(*u)
+	}
+	return pcic
+}
+
+// SetApplied sets the "applied" field.
+func (pcic *PriceChangeItemCreate) SetApplied(b bool) *PriceChangeItemCreate {
+	pcic.mutation.SetApplied(b)
+	return pcic
+}
+
+// SetNillableApplied sets the "applied" field if the given value is not nil.
+func (pcic *PriceChangeItemCreate) SetNillableApplied(b *bool) *PriceChangeItemCreate {
+	if b != nil {
+		pcic.SetApplied(*b)
+	}
+	return pcic
+}
+
+// SetMenuItemOldPrices sets the "menu_item_old_prices" field.
+func (pcic *PriceChangeItemCreate) SetMenuItemOldPrices(dcmiop []domain.PriceChangeMenuItemOldPrice) *PriceChangeItemCreate {
+	pcic.mutation.SetMenuItemOldPrices(dcmiop)
+	return pcic
+}
+
+// SetID sets the "id" field.
+func (pcic *PriceChangeItemCreate) SetID(u uuid.UUID) *PriceChangeItemCreate {
+	pcic.mutation.SetID(u)
+	return pcic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pcic *PriceChangeItemCreate) SetNillableID(u *uuid.UUID) *PriceChangeItemCreate {
+	if u != nil {
+		pcic.SetID(*u)
+	}
+	return pcic
+}
+
+// SetBatch sets the "batch" edge to the PriceChangeBatch entity.
+func (pcic *PriceChangeItemCreate) SetBatch(p *PriceChangeBatch) *PriceChangeItemCreate {
+	return pcic.SetBatchID(p.ID)
+}
+
+// Mutation returns the PriceChangeItemMutation object of the builder.
+func (pcic *PriceChangeItemCreate) Mutation() *PriceChangeItemMutation {
+	return pcic.mutation
+}
+
+// Save creates the PriceChangeItem in the database.
+func (pcic *PriceChangeItemCreate) Save(ctx context.Context) (*PriceChangeItem, error) {
+	if err := pcic.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, pcic.sqlSave, pcic.mutation, pcic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcic *PriceChangeItemCreate) SaveX(ctx context.Context) *PriceChangeItem {
+	v, err := pcic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcic *PriceChangeItemCreate) Exec(ctx context.Context) error {
+	_, err := pcic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcic *PriceChangeItemCreate) ExecX(ctx context.Context) {
+	if err := pcic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcic *PriceChangeItemCreate) defaults() error {
+	if _, ok := pcic.mutation.CreatedAt(); !ok {
+		if pricechangeitem.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pricechangeitem.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := pricechangeitem.DefaultCreatedAt()
+		pcic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pcic.mutation.UpdatedAt(); !ok {
+		if pricechangeitem.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pricechangeitem.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := pricechangeitem.DefaultUpdatedAt()
+		pcic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pcic.mutation.DeletedAt(); !ok {
+		v := pricechangeitem.DefaultDeletedAt
+		pcic.mutation.SetDeletedAt(v)
+	}
+	if _, ok := pcic.mutation.SpecID(); !ok {
+		if pricechangeitem.DefaultSpecID == nil {
+			return fmt.Errorf("ent: uninitialized pricechangeitem.DefaultSpecID (forgotten import ent/runtime?)")
+		}
+		v := pricechangeitem.DefaultSpecID()
+		pcic.mutation.SetSpecID(v)
+	}
+	if _, ok := pcic.mutation.StoreID(); !ok {
+		if pricechangeitem.DefaultStoreID == nil {
+			return fmt.Errorf("ent: uninitialized pricechangeitem.DefaultStoreID (forgotten import ent/runtime?)")
+		}
+		v := pricechangeitem.DefaultStoreID()
+		pcic.mutation.SetStoreID(v)
+	}
+	if _, ok := pcic.mutation.ProductName(); !ok {
+		v := pricechangeitem.DefaultProductName
+		pcic.mutation.SetProductName(v)
+	}
+	if _, ok := pcic.mutation.SpecName(); !ok {
+		v := pricechangeitem.DefaultSpecName
+		pcic.mutation.SetSpecName(v)
+	}
+	if _, ok := pcic.mutation.TargetID(); !ok {
+		if pricechangeitem.DefaultTargetID == nil {
+			return fmt.Errorf("ent: uninitialized pricechangeitem.DefaultTargetID (forgotten import ent/runtime?)")
+		}
+		v := pricechangeitem.DefaultTargetID()
+		pcic.mutation.SetTargetID(v)
+	}
+	if _, ok := pcic.mutation.Applied(); !ok {
+		v := pricechangeitem.DefaultApplied
+		pcic.mutation.SetApplied(v)
+	}
+	if _, ok := pcic.mutation.ID(); !ok {
+		if pricechangeitem.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized pricechangeitem.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := pricechangeitem.DefaultID()
+		pcic.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcic *PriceChangeItemCreate) check() error {
+	if _, ok := pcic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PriceChangeItem.created_at"`)}
+	}
+	if _, ok := pcic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PriceChangeItem.updated_at"`)}
+	}
+	if _, ok := pcic.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "PriceChangeItem.deleted_at"`)}
+	}
+	if _, ok := pcic.mutation.BatchID(); !ok {
+		return &ValidationError{Name: "batch_id", err: errors.New(`ent: missing required field "PriceChangeItem.batch_id"`)}
+	}
+	if _, ok := pcic.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "PriceChangeItem.target_type"`)}
+	}
+	if v, ok := pcic.mutation.TargetType(); ok {
+		if err := pricechangeitem.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "PriceChangeItem.target_type": %w`, err)}
+		}
+	}
+	if _, ok := pcic.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "PriceChangeItem.product_id"`)}
+	}
+	if _, ok := pcic.mutation.SpecID(); !ok {
+		return &ValidationError{Name: "spec_id", err: errors.New(`ent: missing required field "PriceChangeItem.spec_id"`)}
+	}
+	if _, ok := pcic.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "PriceChangeItem.store_id"`)}
+	}
+	if _, ok := pcic.mutation.ProductName(); !ok {
+		return &ValidationError{Name: "product_name", err: errors.New(`ent: missing required field "PriceChangeItem.product_name"`)}
+	}
+	if v, ok := pcic.mutation.ProductName(); ok {
+		if err := pricechangeitem.ProductNameValidator(v); err != nil {
+			return &ValidationError{Name: "product_name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeItem.product_name": %w`, err)}
+		}
+	}
+	if _, ok := pcic.mutation.SpecName(); !ok {
+		return &ValidationError{Name: "spec_name", err: errors.New(`ent: missing required field "PriceChangeItem.spec_name"`)}
+	}
+	if v, ok := pcic.mutation.SpecName(); ok {
+		if err := pricechangeitem.SpecNameValidator(v); err != nil {
+			return &ValidationError{Name: "spec_name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeItem.spec_name": %w`, err)}
+		}
+	}
+	if _, ok := pcic.mutation.NewPrice(); !ok {
+		return &ValidationError{Name: "new_price", err: errors.New(`ent: missing required field "PriceChangeItem.new_price"`)}
+	}
+	if _, ok := pcic.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "PriceChangeItem.target_id"`)}
+	}
+	if _, ok := pcic.mutation.Applied(); !ok {
+		return &ValidationError{Name: "applied", err: errors.New(`ent: missing required field "PriceChangeItem.applied"`)}
+	}
+	if len(pcic.mutation.BatchIDs()) == 0 {
+		return &ValidationError{Name: "batch", err: errors.New(`ent: missing required edge "PriceChangeItem.batch"`)}
+	}
+	return nil
+}
+
+func (pcic *PriceChangeItemCreate) sqlSave(ctx context.Context) (*PriceChangeItem, error) {
+	if err := pcic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pcic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pcic.mutation.id = &_node.ID
+	pcic.mutation.done = true
+	return _node, nil
+}
+
+func (pcic *PriceChangeItemCreate) createSpec() (*PriceChangeItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PriceChangeItem{config: pcic.config}
+		_spec = sqlgraph.NewCreateSpec(pricechangeitem.Table, sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pcic.conflict
+	if id, ok := pcic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pcic.mutation.CreatedAt(); ok {
+		_spec.SetField(pricechangeitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pcic.mutation.UpdatedAt(); ok {
+		_spec.SetField(pricechangeitem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pcic.mutation.DeletedAt(); ok {
+		_spec.SetField(pricechangeitem.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := pcic.mutation.TargetType(); ok {
+		_spec.SetField(pricechangeitem.FieldTargetType, field.TypeEnum, value)
+		_node.TargetType = value
+	}
+	if value, ok := pcic.mutation.ProductID(); ok {
+		_spec.SetField(pricechangeitem.FieldProductID, field.TypeUUID, value)
+		_node.ProductID = value
+	}
+	if value, ok := pcic.mutation.SpecID(); ok {
+		_spec.SetField(pricechangeitem.FieldSpecID, field.TypeUUID, value)
+		_node.SpecID = value
+	}
+	if value, ok := pcic.mutation.StoreID(); ok {
+		_spec.SetField(pricechangeitem.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := pcic.mutation.ProductName(); ok {
+		_spec.SetField(pricechangeitem.FieldProductName, field.TypeString, value)
+		_node.ProductName = value
+	}
+	if value, ok := pcic.mutation.SpecName(); ok {
+		_spec.SetField(pricechangeitem.FieldSpecName, field.TypeString, value)
+		_node.SpecName = value
+	}
+	if value, ok := pcic.mutation.NewPrice(); ok {
+		_spec.SetField(pricechangeitem.FieldNewPrice, field.TypeOther, value)
+		_node.NewPrice = value
+	}
+	if value, ok := pcic.mutation.OldPrice(); ok {
+		_spec.SetField(pricechangeitem.FieldOldPrice, field.TypeOther, value)
+		_node.OldPrice = &value
+	}
+	if value, ok := pcic.mutation.TargetID(); ok {
+		_spec.SetField(pricechangeitem.FieldTargetID, field.TypeUUID, value)
+		_node.TargetID = value
+	}
+	if value, ok := pcic.mutation.Applied(); ok {
+		_spec.SetField(pricechangeitem.FieldApplied, field.TypeBool, value)
+		_node.Applied = value
+	}
+	if value, ok := pcic.mutation.MenuItemOldPrices(); ok {
+		_spec.SetField(pricechangeitem.FieldMenuItemOldPrices, field.TypeJSON, value)
+		_node.MenuItemOldPrices = value
+	}
+	if nodes := pcic.mutation.BatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricechangeitem.BatchTable,
+			Columns: []string{pricechangeitem.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechangebatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BatchID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PriceChangeItem.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PriceChangeItemUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pcic *PriceChangeItemCreate) OnConflict(opts ...sql.ConflictOption) *PriceChangeItemUpsertOne {
+	pcic.conflict = opts
+	return &PriceChangeItemUpsertOne{
+		create: pcic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PriceChangeItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcic *PriceChangeItemCreate) OnConflictColumns(columns ...string) *PriceChangeItemUpsertOne {
+	pcic.conflict = append(pcic.conflict, sql.ConflictColumns(columns...))
+	return &PriceChangeItemUpsertOne{
+		create: pcic,
+	}
+}
+
+type (
+	// PriceChangeItemUpsertOne is the builder for "upsert"-ing
+	//  one PriceChangeItem node.
+	PriceChangeItemUpsertOne struct {
+		create *PriceChangeItemCreate
+	}
+
+	// PriceChangeItemUpsert is the "OnConflict" setter.
+	PriceChangeItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PriceChangeItemUpsert) SetUpdatedAt(v time.Time) *PriceChangeItemUpsert {
+	u.Set(pricechangeitem.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PriceChangeItemUpsert) UpdateUpdatedAt() *PriceChangeItemUpsert {
+	u.SetExcluded(pricechangeitem.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PriceChangeItemUpsert) SetDeletedAt(v int64) *PriceChangeItemUpsert {
+	u.Set(pricechangeitem.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PriceChangeItemUpsert) UpdateDeletedAt() *PriceChangeItemUpsert {
+	u.SetExcluded(pricechangeitem.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *PriceChangeItemUpsert) AddDeletedAt(v int64) *PriceChangeItemUpsert {
+	u.Add(pricechangeitem.FieldDeletedAt, v)
+	return u
+}
+
+// SetProductName sets the "product_name" field.
+func (u *PriceChangeItemUpsert) SetProductName(v string) *PriceChangeItemUpsert {
+	u.Set(pricechangeitem.FieldProductName, v)
+	return u
+}
+
+// UpdateProductName sets the "product_name" field to the value that was provided on create.
+func (u *PriceChangeItemUpsert) UpdateProductName() *PriceChangeItemUpsert {
+	u.SetExcluded(pricechangeitem.FieldProductName)
+	return u
+}
+
+// SetSpecName sets the "spec_name" field.
+func (u *PriceChangeItemUpsert) SetSpecName(v string) *PriceChangeItemUpsert {
+	u.Set(pricechangeitem.FieldSpecName, v)
+	return u
+}
+
+// UpdateSpecName sets the "spec_name" field to the value that was provided on create.
+func (u *PriceChangeItemUpsert) UpdateSpecName() *PriceChangeItemUpsert {
+	u.SetExcluded(pricechangeitem.FieldSpecName)
+	return u
+}
+
+// SetNewPrice sets the "new_price" field.
+func (u *PriceChangeItemUpsert) SetNewPrice(v decimal.Decimal) *PriceChangeItemUpsert {
+	u.Set(pricechangeitem.FieldNewPrice, v)
+	return u
+}
+
+// UpdateNewPrice sets the "new_price" field to the value that was provided on create.
+func (u *PriceChangeItemUpsert) UpdateNewPrice() *PriceChangeItemUpsert {
+	u.SetExcluded(pricechangeitem.FieldNewPrice)
+	return u
+}
+
+// SetOldPrice sets the "old_price" field.
+func (u *PriceChangeItemUpsert) SetOldPrice(v decimal.Decimal) *PriceChangeItemUpsert {
+	u.Set(pricechangeitem.FieldOldPrice, v)
+	return u
+}
+
+// UpdateOldPrice sets the "old_price" field to the value that was provided on create.
+func (u *PriceChangeItemUpsert) UpdateOldPrice() *PriceChangeItemUpsert {
+	u.SetExcluded(pricechangeitem.FieldOldPrice)
+	return u
+}
+
+// ClearOldPrice clears the value of the "old_price" field.
+func (u *PriceChangeItemUpsert) ClearOldPrice() *PriceChangeItemUpsert {
+	u.SetNull(pricechangeitem.FieldOldPrice)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *PriceChangeItemUpsert) SetTargetID(v uuid.UUID) *PriceChangeItemUpsert {
+	u.Set(pricechangeitem.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *PriceChangeItemUpsert) UpdateTargetID() *PriceChangeItemUpsert {
+	u.SetExcluded(pricechangeitem.FieldTargetID)
+	return u
+}
+
+// SetApplied sets the "applied" field.
+func (u *PriceChangeItemUpsert) SetApplied(v bool) *PriceChangeItemUpsert {
+	u.Set(pricechangeitem.FieldApplied, v)
+	return u
+}
+
+// UpdateApplied sets the "applied" field to the value that was provided on create.
+func (u *PriceChangeItemUpsert) UpdateApplied() *PriceChangeItemUpsert {
+	u.SetExcluded(pricechangeitem.FieldApplied)
+	return u
+}
+
+// SetMenuItemOldPrices sets the "menu_item_old_prices" field.
+func (u *PriceChangeItemUpsert) SetMenuItemOldPrices(v []domain.PriceChangeMenuItemOldPrice) *PriceChangeItemUpsert {
+	u.Set(pricechangeitem.FieldMenuItemOldPrices, v)
+	return u
+}
+
+// UpdateMenuItemOldPrices sets the "menu_item_old_prices" field to the value that was provided on create.
+func (u *PriceChangeItemUpsert) UpdateMenuItemOldPrices() *PriceChangeItemUpsert {
+	u.SetExcluded(pricechangeitem.FieldMenuItemOldPrices)
+	return u
+}
+
+// ClearMenuItemOldPrices clears the value of the "menu_item_old_prices" field.
+func (u *PriceChangeItemUpsert) ClearMenuItemOldPrices() *PriceChangeItemUpsert {
+	u.SetNull(pricechangeitem.FieldMenuItemOldPrices)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PriceChangeItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pricechangeitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PriceChangeItemUpsertOne) UpdateNewValues() *PriceChangeItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pricechangeitem.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pricechangeitem.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.BatchID(); exists {
+			s.SetIgnore(pricechangeitem.FieldBatchID)
+		}
+		if _, exists := u.create.mutation.TargetType(); exists {
+			s.SetIgnore(pricechangeitem.FieldTargetType)
+		}
+		if _, exists := u.create.mutation.ProductID(); exists {
+			s.SetIgnore(pricechangeitem.FieldProductID)
+		}
+		if _, exists := u.create.mutation.SpecID(); exists {
+			s.SetIgnore(pricechangeitem.FieldSpecID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(pricechangeitem.FieldStoreID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PriceChangeItem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PriceChangeItemUpsertOne) Ignore() *PriceChangeItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PriceChangeItemUpsertOne) DoNothing() *PriceChangeItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PriceChangeItemCreate.OnConflict
+// documentation for more info.
+func (u *PriceChangeItemUpsertOne) Update(set func(*PriceChangeItemUpsert)) *PriceChangeItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PriceChangeItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PriceChangeItemUpsertOne) SetUpdatedAt(v time.Time) *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertOne) UpdateUpdatedAt() *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PriceChangeItemUpsertOne) SetDeletedAt(v int64) *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *PriceChangeItemUpsertOne) AddDeletedAt(v int64) *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertOne) UpdateDeletedAt() *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetProductName sets the "product_name" field.
+func (u *PriceChangeItemUpsertOne) SetProductName(v string) *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetProductName(v)
+	})
+}
+
+// UpdateProductName sets the "product_name" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertOne) UpdateProductName() *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateProductName()
+	})
+}
+
+// SetSpecName sets the "spec_name" field.
+func (u *PriceChangeItemUpsertOne) SetSpecName(v string) *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetSpecName(v)
+	})
+}
+
+// UpdateSpecName sets the "spec_name" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertOne) UpdateSpecName() *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateSpecName()
+	})
+}
+
+// SetNewPrice sets the "new_price" field.
+func (u *PriceChangeItemUpsertOne) SetNewPrice(v decimal.Decimal) *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetNewPrice(v)
+	})
+}
+
+// UpdateNewPrice sets the "new_price" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertOne) UpdateNewPrice() *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateNewPrice()
+	})
+}
+
+// SetOldPrice sets the "old_price" field.
+func (u *PriceChangeItemUpsertOne) SetOldPrice(v decimal.Decimal) *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetOldPrice(v)
+	})
+}
+
+// UpdateOldPrice sets the "old_price" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertOne) UpdateOldPrice() *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateOldPrice()
+	})
+}
+
+// ClearOldPrice clears the value of the "old_price" field.
+func (u *PriceChangeItemUpsertOne) ClearOldPrice() *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.ClearOldPrice()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *PriceChangeItemUpsertOne) SetTargetID(v uuid.UUID) *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertOne) UpdateTargetID() *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetApplied sets the "applied" field.
+func (u *PriceChangeItemUpsertOne) SetApplied(v bool) *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetApplied(v)
+	})
+}
+
+// UpdateApplied sets the "applied" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertOne) UpdateApplied() *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateApplied()
+	})
+}
+
+// SetMenuItemOldPrices sets the "menu_item_old_prices" field.
+func (u *PriceChangeItemUpsertOne) SetMenuItemOldPrices(v []domain.PriceChangeMenuItemOldPrice) *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetMenuItemOldPrices(v)
+	})
+}
+
+// UpdateMenuItemOldPrices sets the "menu_item_old_prices" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertOne) UpdateMenuItemOldPrices() *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateMenuItemOldPrices()
+	})
+}
+
+// ClearMenuItemOldPrices clears the value of the "menu_item_old_prices" field.
+func (u *PriceChangeItemUpsertOne) ClearMenuItemOldPrices() *PriceChangeItemUpsertOne {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.ClearMenuItemOldPrices()
+	})
+}
+
+// Exec executes the query.
+func (u *PriceChangeItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PriceChangeItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PriceChangeItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PriceChangeItemUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PriceChangeItemUpsertOne.ID is not supported by MySQL driver. Use PriceChangeItemUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PriceChangeItemUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PriceChangeItemCreateBulk is the builder for creating many PriceChangeItem entities in bulk.
+type PriceChangeItemCreateBulk struct {
+	config
+	err      error
+	builders []*PriceChangeItemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PriceChangeItem entities in the database.
+func (pcicb *PriceChangeItemCreateBulk) Save(ctx context.Context) ([]*PriceChangeItem, error) {
+	if pcicb.err != nil {
+		return nil, pcicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcicb.builders))
+	nodes := make([]*PriceChangeItem, len(pcicb.builders))
+	mutators := make([]Mutator, len(pcicb.builders))
+	for i := range pcicb.builders {
+		func(i int, root context.Context) {
+			builder := pcicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PriceChangeItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcicb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcicb *PriceChangeItemCreateBulk) SaveX(ctx context.Context) []*PriceChangeItem {
+	v, err := pcicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcicb *PriceChangeItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcicb *PriceChangeItemCreateBulk) ExecX(ctx context.Context) {
+	if err := pcicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PriceChangeItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PriceChangeItemUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pcicb *PriceChangeItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *PriceChangeItemUpsertBulk {
+	pcicb.conflict = opts
+	return &PriceChangeItemUpsertBulk{
+		create: pcicb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PriceChangeItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcicb *PriceChangeItemCreateBulk) OnConflictColumns(columns ...string) *PriceChangeItemUpsertBulk {
+	pcicb.conflict = append(pcicb.conflict, sql.ConflictColumns(columns...))
+	return &PriceChangeItemUpsertBulk{
+		create: pcicb,
+	}
+}
+
+// PriceChangeItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of PriceChangeItem nodes.
+type PriceChangeItemUpsertBulk struct {
+	create *PriceChangeItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PriceChangeItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pricechangeitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PriceChangeItemUpsertBulk) UpdateNewValues() *PriceChangeItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pricechangeitem.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pricechangeitem.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.BatchID(); exists {
+				s.SetIgnore(pricechangeitem.FieldBatchID)
+			}
+			if _, exists := b.mutation.TargetType(); exists {
+				s.SetIgnore(pricechangeitem.FieldTargetType)
+			}
+			if _, exists := b.mutation.ProductID(); exists {
+				s.SetIgnore(pricechangeitem.FieldProductID)
+			}
+			if _, exists := b.mutation.SpecID(); exists {
+				s.SetIgnore(pricechangeitem.FieldSpecID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(pricechangeitem.FieldStoreID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PriceChangeItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PriceChangeItemUpsertBulk) Ignore() *PriceChangeItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PriceChangeItemUpsertBulk) DoNothing() *PriceChangeItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PriceChangeItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *PriceChangeItemUpsertBulk) Update(set func(*PriceChangeItemUpsert)) *PriceChangeItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PriceChangeItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PriceChangeItemUpsertBulk) SetUpdatedAt(v time.Time) *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertBulk) UpdateUpdatedAt() *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PriceChangeItemUpsertBulk) SetDeletedAt(v int64) *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *PriceChangeItemUpsertBulk) AddDeletedAt(v int64) *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertBulk) UpdateDeletedAt() *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetProductName sets the "product_name" field.
+func (u *PriceChangeItemUpsertBulk) SetProductName(v string) *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetProductName(v)
+	})
+}
+
+// UpdateProductName sets the "product_name" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertBulk) UpdateProductName() *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateProductName()
+	})
+}
+
+// SetSpecName sets the "spec_name" field.
+func (u *PriceChangeItemUpsertBulk) SetSpecName(v string) *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetSpecName(v)
+	})
+}
+
+// UpdateSpecName sets the "spec_name" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertBulk) UpdateSpecName() *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateSpecName()
+	})
+}
+
+// SetNewPrice sets the "new_price" field.
+func (u *PriceChangeItemUpsertBulk) SetNewPrice(v decimal.Decimal) *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetNewPrice(v)
+	})
+}
+
+// UpdateNewPrice sets the "new_price" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertBulk) UpdateNewPrice() *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateNewPrice()
+	})
+}
+
+// SetOldPrice sets the "old_price" field.
+func (u *PriceChangeItemUpsertBulk) SetOldPrice(v decimal.Decimal) *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetOldPrice(v)
+	})
+}
+
+// UpdateOldPrice sets the "old_price" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertBulk) UpdateOldPrice() *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateOldPrice()
+	})
+}
+
+// ClearOldPrice clears the value of the "old_price" field.
+func (u *PriceChangeItemUpsertBulk) ClearOldPrice() *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.ClearOldPrice()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *PriceChangeItemUpsertBulk) SetTargetID(v uuid.UUID) *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertBulk) UpdateTargetID() *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetApplied sets the "applied" field.
+func (u *PriceChangeItemUpsertBulk) SetApplied(v bool) *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetApplied(v)
+	})
+}
+
+// UpdateApplied sets the "applied" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertBulk) UpdateApplied() *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateApplied()
+	})
+}
+
+// SetMenuItemOldPrices sets the "menu_item_old_prices" field.
+func (u *PriceChangeItemUpsertBulk) SetMenuItemOldPrices(v []domain.PriceChangeMenuItemOldPrice) *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.SetMenuItemOldPrices(v)
+	})
+}
+
+// UpdateMenuItemOldPrices sets the "menu_item_old_prices" field to the value that was provided on create.
+func (u *PriceChangeItemUpsertBulk) UpdateMenuItemOldPrices() *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.UpdateMenuItemOldPrices()
+	})
+}
+
+// ClearMenuItemOldPrices clears the value of the "menu_item_old_prices" field.
+func (u *PriceChangeItemUpsertBulk) ClearMenuItemOldPrices() *PriceChangeItemUpsertBulk {
+	return u.Update(func(s *PriceChangeItemUpsert) {
+		s.ClearMenuItemOldPrices()
+	})
+}
+
+// Exec executes the query.
+func (u *PriceChangeItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PriceChangeItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PriceChangeItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PriceChangeItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
+)
+
+// PriceChangeItemDelete is the builder for deleting a PriceChangeItem entity.
+type PriceChangeItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *PriceChangeItemMutation
+}
+
+// Where appends a list predicates to the PriceChangeItemDelete builder.
+func (pcid *PriceChangeItemDelete) Where(ps ...predicate.PriceChangeItem) *PriceChangeItemDelete {
+	pcid.mutation.Where(ps...)
+	return pcid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pcid *PriceChangeItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcid.sqlExec, pcid.mutation, pcid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcid *PriceChangeItemDelete) ExecX(ctx context.Context) int {
+	n, err := pcid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pcid *PriceChangeItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pricechangeitem.Table, sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID))
+	if ps := pcid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pcid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pcid.mutation.done = true
+	return affected, err
+}
+
+// PriceChangeItemDeleteOne is the builder for deleting a single PriceChangeItem entity.
+type PriceChangeItemDeleteOne struct {
+	pcid *PriceChangeItemDelete
+}
+
+// Where appends a list predicates to the PriceChangeItemDelete builder.
+func (pcido *PriceChangeItemDeleteOne) Where(ps ...predicate.PriceChangeItem) *PriceChangeItemDeleteOne {
+	pcido.pcid.mutation.Where(ps...)
+	return pcido
+}
+
+// Exec executes the deletion query.
+func (pcido *PriceChangeItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := pcido.pcid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pricechangeitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcido *PriceChangeItemDeleteOne) ExecX(ctx context.Context) {
+	if err := pcido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
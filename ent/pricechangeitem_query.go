@@ -0,0 +1,657 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
+)
+
+// PriceChangeItemQuery is the builder for querying PriceChangeItem entities.
+type PriceChangeItemQuery struct {
+	config
+	ctx        *QueryContext
+	order      []pricechangeitem.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PriceChangeItem
+	withBatch  *PriceChangeBatchQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PriceChangeItemQuery builder.
+func (pciq *PriceChangeItemQuery) Where(ps ...predicate.PriceChangeItem) *PriceChangeItemQuery {
+	pciq.predicates = append(pciq.predicates, ps...)
+	return pciq
+}
+
+// Limit the number of records to be returned by this query.
+func (pciq *PriceChangeItemQuery) Limit(limit int) *PriceChangeItemQuery {
+	pciq.ctx.Limit = &limit
+	return pciq
+}
+
+// Offset to start from.
+func (pciq *PriceChangeItemQuery) Offset(offset int) *PriceChangeItemQuery {
+	pciq.ctx.Offset = &offset
+	return pciq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pciq *PriceChangeItemQuery) Unique(unique bool) *PriceChangeItemQuery {
+	pciq.ctx.Unique = &unique
+	return pciq
+}
+
+// Order specifies how the records should be ordered.
+func (pciq *PriceChangeItemQuery) Order(o ...pricechangeitem.OrderOption) *PriceChangeItemQuery {
+	pciq.order = append(pciq.order, o...)
+	return pciq
+}
+
+// QueryBatch chains the current query on the "batch" edge.
+func (pciq *PriceChangeItemQuery) QueryBatch() *PriceChangeBatchQuery {
+	query := (&PriceChangeBatchClient{config: pciq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pciq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pciq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pricechangeitem.Table, pricechangeitem.FieldID, selector),
+			sqlgraph.To(pricechangebatch.Table, pricechangebatch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pricechangeitem.BatchTable, pricechangeitem.BatchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pciq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PriceChangeItem entity from the query.
+// Returns a *NotFoundError when no PriceChangeItem was found.
+func (pciq *PriceChangeItemQuery) First(ctx context.Context) (*PriceChangeItem, error) {
+	nodes, err := pciq.Limit(1).All(setContextOp(ctx, pciq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pricechangeitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pciq *PriceChangeItemQuery) FirstX(ctx context.Context) *PriceChangeItem {
+	node, err := pciq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PriceChangeItem ID from the query.
+// Returns a *NotFoundError when no PriceChangeItem ID was found.
+func (pciq *PriceChangeItemQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pciq.Limit(1).IDs(setContextOp(ctx, pciq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pricechangeitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pciq *PriceChangeItemQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := pciq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PriceChangeItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PriceChangeItem entity is found.
+// Returns a *NotFoundError when no PriceChangeItem entities are found.
+func (pciq *PriceChangeItemQuery) Only(ctx context.Context) (*PriceChangeItem, error) {
+	nodes, err := pciq.Limit(2).All(setContextOp(ctx, pciq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pricechangeitem.Label}
+	default:
+		return nil, &NotSingularError{pricechangeitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pciq *PriceChangeItemQuery) OnlyX(ctx context.Context) *PriceChangeItem {
+	node, err := pciq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PriceChangeItem ID in the query.
+// Returns a *NotSingularError when more than one PriceChangeItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pciq *PriceChangeItemQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pciq.Limit(2).IDs(setContextOp(ctx, pciq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pricechangeitem.Label}
+	default:
+		err = &NotSingularError{pricechangeitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pciq *PriceChangeItemQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := pciq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PriceChangeItems.
+func (pciq *PriceChangeItemQuery) All(ctx context.Context) ([]*PriceChangeItem, error) {
+	ctx = setContextOp(ctx, pciq.ctx, ent.OpQueryAll)
+	if err := pciq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PriceChangeItem, *PriceChangeItemQuery]()
+	return withInterceptors[[]*PriceChangeItem](ctx, pciq, qr, pciq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pciq *PriceChangeItemQuery) AllX(ctx context.Context) []*PriceChangeItem {
+	nodes, err := pciq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PriceChangeItem IDs.
+func (pciq *PriceChangeItemQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if pciq.ctx.Unique == nil && pciq.path != nil {
+		pciq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pciq.ctx, ent.OpQueryIDs)
+	if err = pciq.Select(pricechangeitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pciq *PriceChangeItemQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := pciq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pciq *PriceChangeItemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pciq.ctx, ent.OpQueryCount)
+	if err := pciq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pciq, querierCount[*PriceChangeItemQuery](), pciq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pciq *PriceChangeItemQuery) CountX(ctx context.Context) int {
+	count, err := pciq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pciq *PriceChangeItemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pciq.ctx, ent.OpQueryExist)
+	switch _, err := pciq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pciq *PriceChangeItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := pciq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PriceChangeItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pciq *PriceChangeItemQuery) Clone() *PriceChangeItemQuery {
+	if pciq == nil {
+		return nil
+	}
+	return &PriceChangeItemQuery{
+		config:     pciq.config,
+		ctx:        pciq.ctx.Clone(),
+		order:      append([]pricechangeitem.OrderOption{}, pciq.order...),
+		inters:     append([]Interceptor{}, pciq.inters...),
+		predicates: append([]predicate.PriceChangeItem{}, pciq.predicates...),
+		withBatch:  pciq.withBatch.Clone(),
+		// clone intermediate query.
+		sql:       pciq.sql.Clone(),
+		path:      pciq.path,
+		modifiers: append([]func(*sql.Selector){}, pciq.modifiers...),
+	}
+}
+
+// WithBatch tells the query-builder to eager-load the nodes that are connected to
+// the "batch" edge. The optional arguments are used to configure the query builder of the edge.
+func (pciq *PriceChangeItemQuery) WithBatch(opts ...func(*PriceChangeBatchQuery)) *PriceChangeItemQuery {
+	query := (&PriceChangeBatchClient{config: pciq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pciq.withBatch = query
+	return pciq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PriceChangeItem.Query().
+//		GroupBy(pricechangeitem.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pciq *PriceChangeItemQuery) GroupBy(field string, fields ...string) *PriceChangeItemGroupBy {
+	pciq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PriceChangeItemGroupBy{build: pciq}
+	grbuild.flds = &pciq.ctx.Fields
+	grbuild.label = pricechangeitem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PriceChangeItem.Query().
+//		Select(pricechangeitem.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (pciq *PriceChangeItemQuery) Select(fields ...string) *PriceChangeItemSelect {
+	pciq.ctx.Fields = append(pciq.ctx.Fields, fields...)
+	sbuild := &PriceChangeItemSelect{PriceChangeItemQuery: pciq}
+	sbuild.label = pricechangeitem.Label
+	sbuild.flds, sbuild.scan = &pciq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PriceChangeItemSelect configured with the given aggregations.
+func (pciq *PriceChangeItemQuery) Aggregate(fns ...AggregateFunc) *PriceChangeItemSelect {
+	return pciq.Select().Aggregate(fns...)
+}
+
+func (pciq *PriceChangeItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pciq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pciq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pciq.ctx.Fields {
+		if !pricechangeitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pciq.path != nil {
+		prev, err := pciq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pciq.sql = prev
+	}
+	return nil
+}
+
+func (pciq *PriceChangeItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PriceChangeItem, error) {
+	var (
+		nodes       = []*PriceChangeItem{}
+		_spec       = pciq.querySpec()
+		loadedTypes = [1]bool{
+			pciq.withBatch != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PriceChangeItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PriceChangeItem{config: pciq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(pciq.modifiers) > 0 {
+		_spec.Modifiers = pciq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pciq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pciq.withBatch; query != nil {
+		if err := pciq.loadBatch(ctx, query, nodes, nil,
+			func(n *PriceChangeItem, e *PriceChangeBatch) { n.Edges.Batch = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pciq *PriceChangeItemQuery) loadBatch(ctx context.Context, query *PriceChangeBatchQuery, nodes []*PriceChangeItem, init func(*PriceChangeItem), assign func(*PriceChangeItem, *PriceChangeBatch)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PriceChangeItem)
+	for i := range nodes {
+		fk := nodes[i].BatchID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(pricechangebatch.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "batch_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pciq *PriceChangeItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pciq.querySpec()
+	if len(pciq.modifiers) > 0 {
+		_spec.Modifiers = pciq.modifiers
+	}
+	_spec.Node.Columns = pciq.ctx.Fields
+	if len(pciq.ctx.Fields) > 0 {
+		_spec.Unique = pciq.ctx.Unique != nil && *pciq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pciq.driver, _spec)
+}
+
+func (pciq *PriceChangeItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pricechangeitem.Table, pricechangeitem.Columns, sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID))
+	_spec.From = pciq.sql
+	if unique := pciq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pciq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pciq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricechangeitem.FieldID)
+		for i := range fields {
+			if fields[i] != pricechangeitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if pciq.withBatch != nil {
+			_spec.Node.AddColumnOnce(pricechangeitem.FieldBatchID)
+		}
+	}
+	if ps := pciq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pciq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pciq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pciq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pciq *PriceChangeItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pciq.driver.Dialect())
+	t1 := builder.Table(pricechangeitem.Table)
+	columns := pciq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pricechangeitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pciq.sql != nil {
+		selector = pciq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pciq.ctx.Unique != nil && *pciq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range pciq.modifiers {
+		m(selector)
+	}
+	for _, p := range pciq.predicates {
+		p(selector)
+	}
+	for _, p := range pciq.order {
+		p(selector)
+	}
+	if offset := pciq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pciq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pciq *PriceChangeItemQuery) ForUpdate(opts ...sql.LockOption) *PriceChangeItemQuery {
+	if pciq.driver.Dialect() == dialect.Postgres {
+		pciq.Unique(false)
+	}
+	pciq.modifiers = append(pciq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pciq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pciq *PriceChangeItemQuery) ForShare(opts ...sql.LockOption) *PriceChangeItemQuery {
+	if pciq.driver.Dialect() == dialect.Postgres {
+		pciq.Unique(false)
+	}
+	pciq.modifiers = append(pciq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pciq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pciq *PriceChangeItemQuery) Modify(modifiers ...func(s *sql.Selector)) *PriceChangeItemSelect {
+	pciq.modifiers = append(pciq.modifiers, modifiers...)
+	return pciq.Select()
+}
+
+// PriceChangeItemGroupBy is the group-by builder for PriceChangeItem entities.
+type PriceChangeItemGroupBy struct {
+	selector
+	build *PriceChangeItemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pcigb *PriceChangeItemGroupBy) Aggregate(fns ...AggregateFunc) *PriceChangeItemGroupBy {
+	pcigb.fns = append(pcigb.fns, fns...)
+	return pcigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcigb *PriceChangeItemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcigb.build.ctx, ent.OpQueryGroupBy)
+	if err := pcigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PriceChangeItemQuery, *PriceChangeItemGroupBy](ctx, pcigb.build, pcigb, pcigb.build.inters, v)
+}
+
+func (pcigb *PriceChangeItemGroupBy) sqlScan(ctx context.Context, root *PriceChangeItemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pcigb.fns))
+	for _, fn := range pcigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pcigb.flds)+len(pcigb.fns))
+		for _, f := range *pcigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pcigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PriceChangeItemSelect is the builder for selecting fields of PriceChangeItem entities.
+type PriceChangeItemSelect struct {
+	*PriceChangeItemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pcis *PriceChangeItemSelect) Aggregate(fns ...AggregateFunc) *PriceChangeItemSelect {
+	pcis.fns = append(pcis.fns, fns...)
+	return pcis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcis *PriceChangeItemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcis.ctx, ent.OpQuerySelect)
+	if err := pcis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PriceChangeItemQuery, *PriceChangeItemSelect](ctx, pcis.PriceChangeItemQuery, pcis, pcis.inters, v)
+}
+
+func (pcis *PriceChangeItemSelect) sqlScan(ctx context.Context, root *PriceChangeItemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pcis.fns))
+	for _, fn := range pcis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pcis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pcis *PriceChangeItemSelect) Modify(modifiers ...func(s *sql.Selector)) *PriceChangeItemSelect {
+	pcis.modifiers = append(pcis.modifiers, modifiers...)
+	return pcis
+}
@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
+)
+
+// PriceChangeItemUpdate is the builder for updating PriceChangeItem entities.
+type PriceChangeItemUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PriceChangeItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PriceChangeItemUpdate builder.
+func (pciu *PriceChangeItemUpdate) Where(ps ...predicate.PriceChangeItem) *PriceChangeItemUpdate {
+	pciu.mutation.Where(ps...)
+	return pciu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pciu *PriceChangeItemUpdate) SetUpdatedAt(t time.Time) *PriceChangeItemUpdate {
+	pciu.mutation.SetUpdatedAt(t)
+	return pciu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pciu *PriceChangeItemUpdate) SetDeletedAt(i int64) *PriceChangeItemUpdate {
+	pciu.mutation.ResetDeletedAt()
+	pciu.mutation.SetDeletedAt(i)
+	return pciu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pciu *PriceChangeItemUpdate) SetNillableDeletedAt(i *int64) *PriceChangeItemUpdate {
+	if i != nil {
+		pciu.SetDeletedAt(*i)
+	}
+	return pciu
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (pciu *PriceChangeItemUpdate) AddDeletedAt(i int64) *PriceChangeItemUpdate {
+	pciu.mutation.AddDeletedAt(i)
+	return pciu
+}
+
+// SetProductName sets the "product_name" field.
+func (pciu *PriceChangeItemUpdate) SetProductName(s string) *PriceChangeItemUpdate {
+	pciu.mutation.SetProductName(s)
+	return pciu
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (pciu *PriceChangeItemUpdate) SetNillableProductName(s *string) *PriceChangeItemUpdate {
+	if s != nil {
+		pciu.SetProductName(*s)
+	}
+	return pciu
+}
+
+// SetSpecName sets the "spec_name" field.
+func (pciu *PriceChangeItemUpdate) SetSpecName(s string) *PriceChangeItemUpdate {
+	pciu.mutation.SetSpecName(s)
+	return pciu
+}
+
+// SetNillableSpecName sets the "spec_name" field if the given value is not nil.
+func (pciu *PriceChangeItemUpdate) SetNillableSpecName(s *string) *PriceChangeItemUpdate {
+	if s != nil {
+		pciu.SetSpecName(*s)
+	}
+	return pciu
+}
+
+// SetNewPrice sets the "new_price" field.
+func (pciu *PriceChangeItemUpdate) SetNewPrice(d decimal.Decimal) *PriceChangeItemUpdate {
+	pciu.mutation.SetNewPrice(d)
+	return pciu
+}
+
+// SetNillableNewPrice sets the "new_price" field if the given value is not nil.
+func (pciu *PriceChangeItemUpdate) SetNillableNewPrice(d *decimal.Decimal) *PriceChangeItemUpdate {
+	if d != nil {
+		pciu.SetNewPrice(*d)
+	}
+	return pciu
+}
+
+// SetOldPrice sets the "old_price" field.
+func (pciu *PriceChangeItemUpdate) SetOldPrice(d decimal.Decimal) *PriceChangeItemUpdate {
+	pciu.mutation.SetOldPrice(d)
+	return pciu
+}
+
+// SetNillableOldPrice sets the "old_price" field if the given value is not nil.
+func (pciu *PriceChangeItemUpdate) SetNillableOldPrice(d *decimal.Decimal) *PriceChangeItemUpdate {
+	if d != nil {
+		pciu.SetOldPrice(*d)
+	}
+	return pciu
+}
+
+// ClearOldPrice clears the value of the "old_price" field.
+func (pciu *PriceChangeItemUpdate) ClearOldPrice() *PriceChangeItemUpdate {
+	pciu.mutation.ClearOldPrice()
+	return pciu
+}
+
+// SetTargetID sets the "target_id" field.
+func (pciu *PriceChangeItemUpdate) SetTargetID(u uuid.UUID) *PriceChangeItemUpdate {
+	pciu.mutation.SetTargetID(u)
+	return pciu
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (pciu *PriceChangeItemUpdate) SetNillableTargetID(u *uuid.UUID) *PriceChangeItemUpdate {
+	if u != nil {
+		pciu.SetTargetID(*u)
+	}
+	return pciu
+}
+
+// SetApplied sets the "applied" field.
+func (pciu *PriceChangeItemUpdate) SetApplied(b bool) *PriceChangeItemUpdate {
+	pciu.mutation.SetApplied(b)
+	return pciu
+}
+
+// SetNillableApplied sets the "applied" field if the given value is not nil.
+func (pciu *PriceChangeItemUpdate) SetNillableApplied(b *bool) *PriceChangeItemUpdate {
+	if b != nil {
+		pciu.SetApplied(*b)
+	}
+	return pciu
+}
+
+// SetMenuItemOldPrices sets the "menu_item_old_prices" field.
+func (pciu *PriceChangeItemUpdate) SetMenuItemOldPrices(dcmiop []domain.PriceChangeMenuItemOldPrice) *PriceChangeItemUpdate {
+	pciu.mutation.SetMenuItemOldPrices(dcmiop)
+	return pciu
+}
+
+// AppendMenuItemOldPrices appends dcmiop to the "menu_item_old_prices" field.
+func (pciu *PriceChangeItemUpdate) AppendMenuItemOldPrices(dcmiop []domain.PriceChangeMenuItemOldPrice) *PriceChangeItemUpdate {
+	pciu.mutation.AppendMenuItemOldPrices(dcmiop)
+	return pciu
+}
+
+// ClearMenuItemOldPrices clears the value of the "menu_item_old_prices" field.
+func (pciu *PriceChangeItemUpdate) ClearMenuItemOldPrices() *PriceChangeItemUpdate {
+	pciu.mutation.ClearMenuItemOldPrices()
+	return pciu
+}
+
+// Mutation returns the PriceChangeItemMutation object of the builder.
+func (pciu *PriceChangeItemUpdate) Mutation() *PriceChangeItemMutation {
+	return pciu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pciu *PriceChangeItemUpdate) Save(ctx context.Context) (int, error) {
+	if err := pciu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, pciu.sqlSave, pciu.mutation, pciu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pciu *PriceChangeItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := pciu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pciu *PriceChangeItemUpdate) Exec(ctx context.Context) error {
+	_, err := pciu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pciu *PriceChangeItemUpdate) ExecX(ctx context.Context) {
+	if err := pciu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pciu *PriceChangeItemUpdate) defaults() error {
+	if _, ok := pciu.mutation.UpdatedAt(); !ok {
+		if pricechangeitem.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pricechangeitem.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := pricechangeitem.UpdateDefaultUpdatedAt()
+		pciu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pciu *PriceChangeItemUpdate) check() error {
+	if v, ok := pciu.mutation.ProductName(); ok {
+		if err := pricechangeitem.ProductNameValidator(v); err != nil {
+			return &ValidationError{Name: "product_name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeItem.product_name": %w`, err)}
+		}
+	}
+	if v, ok := pciu.mutation.SpecName(); ok {
+		if err := pricechangeitem.SpecNameValidator(v); err != nil {
+			return &ValidationError{Name: "spec_name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeItem.spec_name": %w`, err)}
+		}
+	}
+	if pciu.mutation.BatchCleared() && len(pciu.mutation.BatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PriceChangeItem.batch"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pciu *PriceChangeItemUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PriceChangeItemUpdate {
+	pciu.modifiers = append(pciu.modifiers, modifiers...)
+	return pciu
+}
+
+func (pciu *PriceChangeItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pciu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricechangeitem.Table, pricechangeitem.Columns, sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID))
+	if ps := pciu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pciu.mutation.UpdatedAt(); ok {
+		_spec.SetField(pricechangeitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pciu.mutation.DeletedAt(); ok {
+		_spec.SetField(pricechangeitem.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := pciu.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(pricechangeitem.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := pciu.mutation.ProductName(); ok {
+		_spec.SetField(pricechangeitem.FieldProductName, field.TypeString, value)
+	}
+	if value, ok := pciu.mutation.SpecName(); ok {
+		_spec.SetField(pricechangeitem.FieldSpecName, field.TypeString, value)
+	}
+	if value, ok := pciu.mutation.NewPrice(); ok {
+		_spec.SetField(pricechangeitem.FieldNewPrice, field.TypeOther, value)
+	}
+	if value, ok := pciu.mutation.OldPrice(); ok {
+		_spec.SetField(pricechangeitem.FieldOldPrice, field.TypeOther, value)
+	}
+	if pciu.mutation.OldPriceCleared() {
+		_spec.ClearField(pricechangeitem.FieldOldPrice, field.TypeOther)
+	}
+	if value, ok := pciu.mutation.TargetID(); ok {
+		_spec.SetField(pricechangeitem.FieldTargetID, field.TypeUUID, value)
+	}
+	if value, ok := pciu.mutation.Applied(); ok {
+		_spec.SetField(pricechangeitem.FieldApplied, field.TypeBool, value)
+	}
+	if value, ok := pciu.mutation.MenuItemOldPrices(); ok {
+		_spec.SetField(pricechangeitem.FieldMenuItemOldPrices, field.TypeJSON, value)
+	}
+	if value, ok := pciu.mutation.AppendedMenuItemOldPrices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricechangeitem.FieldMenuItemOldPrices, value)
+		})
+	}
+	if pciu.mutation.MenuItemOldPricesCleared() {
+		_spec.ClearField(pricechangeitem.FieldMenuItemOldPrices, field.TypeJSON)
+	}
+	_spec.AddModifiers(pciu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pciu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricechangeitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pciu.mutation.done = true
+	return n, nil
+}
+
+// PriceChangeItemUpdateOne is the builder for updating a single PriceChangeItem entity.
+type PriceChangeItemUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PriceChangeItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pciuo *PriceChangeItemUpdateOne) SetUpdatedAt(t time.Time) *PriceChangeItemUpdateOne {
+	pciuo.mutation.SetUpdatedAt(t)
+	return pciuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pciuo *PriceChangeItemUpdateOne) SetDeletedAt(i int64) *PriceChangeItemUpdateOne {
+	pciuo.mutation.ResetDeletedAt()
+	pciuo.mutation.SetDeletedAt(i)
+	return pciuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pciuo *PriceChangeItemUpdateOne) SetNillableDeletedAt(i *int64) *PriceChangeItemUpdateOne {
+	if i != nil {
+		pciuo.SetDeletedAt(*i)
+	}
+	return pciuo
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (pciuo *PriceChangeItemUpdateOne) AddDeletedAt(i int64) *PriceChangeItemUpdateOne {
+	pciuo.mutation.AddDeletedAt(i)
+	return pciuo
+}
+
+// SetProductName sets the "product_name" field.
+func (pciuo *PriceChangeItemUpdateOne) SetProductName(s string) *PriceChangeItemUpdateOne {
+	pciuo.mutation.SetProductName(s)
+	return pciuo
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (pciuo *PriceChangeItemUpdateOne) SetNillableProductName(s *string) *PriceChangeItemUpdateOne {
+	if s != nil {
+		pciuo.SetProductName(*s)
+	}
+	return pciuo
+}
+
+// SetSpecName sets the "spec_name" field.
+func (pciuo *PriceChangeItemUpdateOne) SetSpecName(s string) *PriceChangeItemUpdateOne {
+	pciuo.mutation.SetSpecName(s)
+	return pciuo
+}
+
+// SetNillableSpecName sets the "spec_name" field if the given value is not nil.
+func (pciuo *PriceChangeItemUpdateOne) SetNillableSpecName(s *string) *PriceChangeItemUpdateOne {
+	if s != nil {
+		pciuo.SetSpecName(*s)
+	}
+	return pciuo
+}
+
+// SetNewPrice sets the "new_price" field.
+func (pciuo *PriceChangeItemUpdateOne) SetNewPrice(d decimal.Decimal) *PriceChangeItemUpdateOne {
+	pciuo.mutation.SetNewPrice(d)
+	return pciuo
+}
+
+// SetNillableNewPrice sets the "new_price" field if the given value is not nil.
+func (pciuo *PriceChangeItemUpdateOne) SetNillableNewPrice(d *decimal.Decimal) *PriceChangeItemUpdateOne {
+	if d != nil {
+		pciuo.SetNewPrice(*d)
+	}
+	return pciuo
+}
+
+// SetOldPrice sets the "old_price" field.
+func (pciuo *PriceChangeItemUpdateOne) SetOldPrice(d decimal.Decimal) *PriceChangeItemUpdateOne {
+	pciuo.mutation.SetOldPrice(d)
+	return pciuo
+}
+
+// SetNillableOldPrice sets the "old_price" field if the given value is not nil.
+func (pciuo *PriceChangeItemUpdateOne) SetNillableOldPrice(d *decimal.Decimal) *PriceChangeItemUpdateOne {
+	if d != nil {
+		pciuo.SetOldPrice(*d)
+	}
+	return pciuo
+}
+
+// ClearOldPrice clears the value of the "old_price" field.
+func (pciuo *PriceChangeItemUpdateOne) ClearOldPrice() *PriceChangeItemUpdateOne {
+	pciuo.mutation.ClearOldPrice()
+	return pciuo
+}
+
+// SetTargetID sets the "target_id" field.
+func (pciuo *PriceChangeItemUpdateOne) SetTargetID(u uuid.UUID) *PriceChangeItemUpdateOne {
+	pciuo.mutation.SetTargetID(u)
+	return pciuo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (pciuo *PriceChangeItemUpdateOne) SetNillableTargetID(u *uuid.UUID) *PriceChangeItemUpdateOne {
+	if u != nil {
+		pciuo.SetTargetID(*u)
+	}
+	return pciuo
+}
+
+// SetApplied sets the "applied" field.
+func (pciuo *PriceChangeItemUpdateOne) SetApplied(b bool) *PriceChangeItemUpdateOne {
+	pciuo.mutation.SetApplied(b)
+	return pciuo
+}
+
+// SetNillableApplied sets the "applied" field if the given value is not nil.
+func (pciuo *PriceChangeItemUpdateOne) SetNillableApplied(b *bool) *PriceChangeItemUpdateOne {
+	if b != nil {
+		pciuo.SetApplied(*b)
+	}
+	return pciuo
+}
+
+// SetMenuItemOldPrices sets the "menu_item_old_prices" field.
+func (pciuo *PriceChangeItemUpdateOne) SetMenuItemOldPrices(dcmiop []domain.PriceChangeMenuItemOldPrice) *PriceChangeItemUpdateOne {
+	pciuo.mutation.SetMenuItemOldPrices(dcmiop)
+	return pciuo
+}
+
+// AppendMenuItemOldPrices appends dcmiop to the "menu_item_old_prices" field.
+func (pciuo *PriceChangeItemUpdateOne) AppendMenuItemOldPrices(dcmiop []domain.PriceChangeMenuItemOldPrice) *PriceChangeItemUpdateOne {
+	pciuo.mutation.AppendMenuItemOldPrices(dcmiop)
+	return pciuo
+}
+
+// ClearMenuItemOldPrices clears the value of the "menu_item_old_prices" field.
+func (pciuo *PriceChangeItemUpdateOne) ClearMenuItemOldPrices() *PriceChangeItemUpdateOne {
+	pciuo.mutation.ClearMenuItemOldPrices()
+	return pciuo
+}
+
+// Mutation returns the PriceChangeItemMutation object of the builder.
+func (pciuo *PriceChangeItemUpdateOne) Mutation() *PriceChangeItemMutation {
+	return pciuo.mutation
+}
+
+// Where appends a list predicates to the PriceChangeItemUpdate builder.
+func (pciuo *PriceChangeItemUpdateOne) Where(ps ...predicate.PriceChangeItem) *PriceChangeItemUpdateOne {
+	pciuo.mutation.Where(ps...)
+	return pciuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pciuo *PriceChangeItemUpdateOne) Select(field string, fields ...string) *PriceChangeItemUpdateOne {
+	pciuo.fields = append([]string{field}, fields...)
+	return pciuo
+}
+
+// Save executes the query and returns the updated PriceChangeItem entity.
+func (pciuo *PriceChangeItemUpdateOne) Save(ctx context.Context) (*PriceChangeItem, error) {
+	if err := pciuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, pciuo.sqlSave, pciuo.mutation, pciuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pciuo *PriceChangeItemUpdateOne) SaveX(ctx context.Context) *PriceChangeItem {
+	node, err := pciuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pciuo *PriceChangeItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := pciuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pciuo *PriceChangeItemUpdateOne) ExecX(ctx context.Context) {
+	if err := pciuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pciuo *PriceChangeItemUpdateOne) defaults() error {
+	if _, ok := pciuo.mutation.UpdatedAt(); !ok {
+		if pricechangeitem.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pricechangeitem.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := pricechangeitem.UpdateDefaultUpdatedAt()
+		pciuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pciuo *PriceChangeItemUpdateOne) check() error {
+	if v, ok := pciuo.mutation.ProductName(); ok {
+		if err := pricechangeitem.ProductNameValidator(v); err != nil {
+			return &ValidationError{Name: "product_name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeItem.product_name": %w`, err)}
+		}
+	}
+	if v, ok := pciuo.mutation.SpecName(); ok {
+		if err := pricechangeitem.SpecNameValidator(v); err != nil {
+			return &ValidationError{Name: "spec_name", err: fmt.Errorf(`ent: validator failed for field "PriceChangeItem.spec_name": %w`, err)}
+		}
+	}
+	if pciuo.mutation.BatchCleared() && len(pciuo.mutation.BatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PriceChangeItem.batch"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pciuo *PriceChangeItemUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PriceChangeItemUpdateOne {
+	pciuo.modifiers = append(pciuo.modifiers, modifiers...)
+	return pciuo
+}
+
+func (pciuo *PriceChangeItemUpdateOne) sqlSave(ctx context.Context) (_node *PriceChangeItem, err error) {
+	if err := pciuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricechangeitem.Table, pricechangeitem.Columns, sqlgraph.NewFieldSpec(pricechangeitem.FieldID, field.TypeUUID))
+	id, ok := pciuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PriceChangeItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pciuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricechangeitem.FieldID)
+		for _, f := range fields {
+			if !pricechangeitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pricechangeitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pciuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pciuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(pricechangeitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pciuo.mutation.DeletedAt(); ok {
+		_spec.SetField(pricechangeitem.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := pciuo.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(pricechangeitem.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := pciuo.mutation.ProductName(); ok {
+		_spec.SetField(pricechangeitem.FieldProductName, field.TypeString, value)
+	}
+	if value, ok := pciuo.mutation.SpecName(); ok {
+		_spec.SetField(pricechangeitem.FieldSpecName, field.TypeString, value)
+	}
+	if value, ok := pciuo.mutation.NewPrice(); ok {
+		_spec.SetField(pricechangeitem.FieldNewPrice, field.TypeOther, value)
+	}
+	if value, ok := pciuo.mutation.OldPrice(); ok {
+		_spec.SetField(pricechangeitem.FieldOldPrice, field.TypeOther, value)
+	}
+	if pciuo.mutation.OldPriceCleared() {
+		_spec.ClearField(pricechangeitem.FieldOldPrice, field.TypeOther)
+	}
+	if value, ok := pciuo.mutation.TargetID(); ok {
+		_spec.SetField(pricechangeitem.FieldTargetID, field.TypeUUID, value)
+	}
+	if value, ok := pciuo.mutation.Applied(); ok {
+		_spec.SetField(pricechangeitem.FieldApplied, field.TypeBool, value)
+	}
+	if value, ok := pciuo.mutation.MenuItemOldPrices(); ok {
+		_spec.SetField(pricechangeitem.FieldMenuItemOldPrices, field.TypeJSON, value)
+	}
+	if value, ok := pciuo.mutation.AppendedMenuItemOldPrices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricechangeitem.FieldMenuItemOldPrices, value)
+		})
+	}
+	if pciuo.mutation.MenuItemOldPricesCleared() {
+		_spec.ClearField(pricechangeitem.FieldMenuItemOldPrices, field.TypeJSON)
+	}
+	_spec.AddModifiers(pciuo.modifiers...)
+	_node = &PriceChangeItem{config: pciuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pciuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricechangeitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pciuo.mutation.done = true
+	return _node, nil
+}
@@ -42,6 +42,10 @@ type Product struct {
 	MenuID uuid.UUID `json:"menu_id,omitempty"`
 	// 助记词
 	Mnemonic string `json:"mnemonic,omitempty"`
+	// 商品名称拼音全拼（小写，保存时根据名称生成）
+	Pinyin string `json:"pinyin,omitempty"`
+	// 商品名称拼音首字母（小写，保存时根据名称生成）
+	PinyinInitials string `json:"pinyin_initials,omitempty"`
 	// 保质期（单位：天）
 	ShelfLife int `json:"shelf_life,omitempty"`
 	// 支持类型（堂食、外带）
@@ -76,6 +80,24 @@ type Product struct {
 	DetailImages []string `json:"detail_images,omitempty"`
 	// 菜品描述（可选）
 	Description string `json:"description,omitempty"`
+	// 商品名称翻译（按语言）
+	NameI18n domain.LocalizedText `json:"name_i18n,omitempty"`
+	// 菜品描述翻译（按语言）
+	DescriptionI18n domain.LocalizedText `json:"description_i18n,omitempty"`
+	// 过敏原（可选，可多选）
+	Allergens []domain.Allergen `json:"allergens,omitempty"`
+	// 是否清真
+	Halal bool `json:"halal,omitempty"`
+	// 是否素食
+	Vegetarian bool `json:"vegetarian,omitempty"`
+	// 是否纯素
+	Vegan bool `json:"vegan,omitempty"`
+	// 辣度：0（不辣）、1（微辣）、2（中辣）、3（特辣）
+	SpicyLevel domain.SpicyLevel `json:"spicy_level,omitempty"`
+	// 营养成分（可选，每份）
+	Nutrition *domain.ProductNutrition `json:"nutrition,omitempty"`
+	// 口味做法分组点单限制（可选，按口味做法分组配置最少/最多选择数量）
+	AttrLimits domain.ProductAttrLimits `json:"attr_limits,omitempty"`
 	// 预估成本价（可选，单位：令吉，仅套餐商品使用）
 	EstimatedCostPrice *decimal.Decimal `json:"estimated_cost_price,omitempty"`
 	// 外卖成本价（可选，单位：令吉，仅套餐商品使用）
@@ -222,13 +244,13 @@ func (*Product) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case product.FieldEstimatedCostPrice, product.FieldDeliveryCostPrice:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
-		case product.FieldSupportTypes, product.FieldSaleChannels, product.FieldDetailImages:
+		case product.FieldSupportTypes, product.FieldSaleChannels, product.FieldDetailImages, product.FieldNameI18n, product.FieldDescriptionI18n, product.FieldAllergens, product.FieldNutrition, product.FieldAttrLimits:
 			values[i] = new([]byte)
-		case product.FieldInheritTaxRate, product.FieldInheritStall:
+		case product.FieldInheritTaxRate, product.FieldInheritStall, product.FieldHalal, product.FieldVegetarian, product.FieldVegan:
 			values[i] = new(sql.NullBool)
-		case product.FieldDeletedAt, product.FieldShelfLife, product.FieldMinSaleQuantity, product.FieldAddSaleQuantity:
+		case product.FieldDeletedAt, product.FieldShelfLife, product.FieldMinSaleQuantity, product.FieldAddSaleQuantity, product.FieldSpicyLevel:
 			values[i] = new(sql.NullInt64)
-		case product.FieldType, product.FieldName, product.FieldMnemonic, product.FieldSaleStatus, product.FieldEffectiveDateType, product.FieldMainImage, product.FieldDescription:
+		case product.FieldType, product.FieldName, product.FieldMnemonic, product.FieldPinyin, product.FieldPinyinInitials, product.FieldSaleStatus, product.FieldEffectiveDateType, product.FieldMainImage, product.FieldDescription:
 			values[i] = new(sql.NullString)
 		case product.FieldCreatedAt, product.FieldUpdatedAt, product.FieldEffectiveStartTime, product.FieldEffectiveEndTime:
 			values[i] = new(sql.NullTime)
@@ -303,6 +325,18 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.Mnemonic = value.String
 			}
+		case product.FieldPinyin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pinyin", values[i])
+			} else if value.Valid {
+				pr.Pinyin = value.String
+			}
+		case product.FieldPinyinInitials:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pinyin_initials", values[i])
+			} else if value.Valid {
+				pr.PinyinInitials = value.String
+			}
 		case product.FieldShelfLife:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field shelf_life", values[i])
@@ -413,6 +447,70 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.Description = value.String
 			}
+		case product.FieldNameI18n:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field name_i18n", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.NameI18n); err != nil {
+					return fmt.Errorf("unmarshal field name_i18n: %w", err)
+				}
+			}
+		case product.FieldDescriptionI18n:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field description_i18n", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.DescriptionI18n); err != nil {
+					return fmt.Errorf("unmarshal field description_i18n: %w", err)
+				}
+			}
+		case product.FieldAllergens:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allergens", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.Allergens); err != nil {
+					return fmt.Errorf("unmarshal field allergens: %w", err)
+				}
+			}
+		case product.FieldHalal:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field halal", values[i])
+			} else if value.Valid {
+				pr.Halal = value.Bool
+			}
+		case product.FieldVegetarian:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field vegetarian", values[i])
+			} else if value.Valid {
+				pr.Vegetarian = value.Bool
+			}
+		case product.FieldVegan:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field vegan", values[i])
+			} else if value.Valid {
+				pr.Vegan = value.Bool
+			}
+		case product.FieldSpicyLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field spicy_level", values[i])
+			} else if value.Valid {
+				pr.SpicyLevel = domain.SpicyLevel(value.Int64)
+			}
+		case product.FieldNutrition:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field nutrition", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.Nutrition); err != nil {
+					return fmt.Errorf("unmarshal field nutrition: %w", err)
+				}
+			}
+		case product.FieldAttrLimits:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attr_limits", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.AttrLimits); err != nil {
+					return fmt.Errorf("unmarshal field attr_limits: %w", err)
+				}
+			}
 		case product.FieldEstimatedCostPrice:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field estimated_cost_price", values[i])
@@ -549,6 +647,12 @@ func (pr *Product) String() string {
 	builder.WriteString("mnemonic=")
 	builder.WriteString(pr.Mnemonic)
 	builder.WriteString(", ")
+	builder.WriteString("pinyin=")
+	builder.WriteString(pr.Pinyin)
+	builder.WriteString(", ")
+	builder.WriteString("pinyin_initials=")
+	builder.WriteString(pr.PinyinInitials)
+	builder.WriteString(", ")
 	builder.WriteString("shelf_life=")
 	builder.WriteString(fmt.Sprintf("%v", pr.ShelfLife))
 	builder.WriteString(", ")
@@ -604,6 +708,33 @@ func (pr *Product) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(pr.Description)
 	builder.WriteString(", ")
+	builder.WriteString("name_i18n=")
+	builder.WriteString(fmt.Sprintf("%v", pr.NameI18n))
+	builder.WriteString(", ")
+	builder.WriteString("description_i18n=")
+	builder.WriteString(fmt.Sprintf("%v", pr.DescriptionI18n))
+	builder.WriteString(", ")
+	builder.WriteString("allergens=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Allergens))
+	builder.WriteString(", ")
+	builder.WriteString("halal=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Halal))
+	builder.WriteString(", ")
+	builder.WriteString("vegetarian=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Vegetarian))
+	builder.WriteString(", ")
+	builder.WriteString("vegan=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Vegan))
+	builder.WriteString(", ")
+	builder.WriteString("spicy_level=")
+	builder.WriteString(fmt.Sprintf("%v", pr.SpicyLevel))
+	builder.WriteString(", ")
+	builder.WriteString("nutrition=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Nutrition))
+	builder.WriteString(", ")
+	builder.WriteString("attr_limits=")
+	builder.WriteString(fmt.Sprintf("%v", pr.AttrLimits))
+	builder.WriteString(", ")
 	if v := pr.EstimatedCostPrice; v != nil {
 		builder.WriteString("estimated_cost_price=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
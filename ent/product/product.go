@@ -34,6 +34,10 @@ const (
 	FieldMenuID = "menu_id"
 	// FieldMnemonic holds the string denoting the mnemonic field in the database.
 	FieldMnemonic = "mnemonic"
+	// FieldPinyin holds the string denoting the pinyin field in the database.
+	FieldPinyin = "pinyin"
+	// FieldPinyinInitials holds the string denoting the pinyin_initials field in the database.
+	FieldPinyinInitials = "pinyin_initials"
 	// FieldShelfLife holds the string denoting the shelf_life field in the database.
 	FieldShelfLife = "shelf_life"
 	// FieldSupportTypes holds the string denoting the support_types field in the database.
@@ -68,6 +72,24 @@ const (
 	FieldDetailImages = "detail_images"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldNameI18n holds the string denoting the name_i18n field in the database.
+	FieldNameI18n = "name_i18n"
+	// FieldDescriptionI18n holds the string denoting the description_i18n field in the database.
+	FieldDescriptionI18n = "description_i18n"
+	// FieldAllergens holds the string denoting the allergens field in the database.
+	FieldAllergens = "allergens"
+	// FieldHalal holds the string denoting the halal field in the database.
+	FieldHalal = "halal"
+	// FieldVegetarian holds the string denoting the vegetarian field in the database.
+	FieldVegetarian = "vegetarian"
+	// FieldVegan holds the string denoting the vegan field in the database.
+	FieldVegan = "vegan"
+	// FieldSpicyLevel holds the string denoting the spicy_level field in the database.
+	FieldSpicyLevel = "spicy_level"
+	// FieldNutrition holds the string denoting the nutrition field in the database.
+	FieldNutrition = "nutrition"
+	// FieldAttrLimits holds the string denoting the attr_limits field in the database.
+	FieldAttrLimits = "attr_limits"
 	// FieldEstimatedCostPrice holds the string denoting the estimated_cost_price field in the database.
 	FieldEstimatedCostPrice = "estimated_cost_price"
 	// FieldDeliveryCostPrice holds the string denoting the delivery_cost_price field in the database.
@@ -179,6 +201,8 @@ var Columns = []string{
 	FieldCategoryID,
 	FieldMenuID,
 	FieldMnemonic,
+	FieldPinyin,
+	FieldPinyinInitials,
 	FieldShelfLife,
 	FieldSupportTypes,
 	FieldUnitID,
@@ -196,6 +220,15 @@ var Columns = []string{
 	FieldMainImage,
 	FieldDetailImages,
 	FieldDescription,
+	FieldNameI18n,
+	FieldDescriptionI18n,
+	FieldAllergens,
+	FieldHalal,
+	FieldVegetarian,
+	FieldVegan,
+	FieldSpicyLevel,
+	FieldNutrition,
+	FieldAttrLimits,
 	FieldEstimatedCostPrice,
 	FieldDeliveryCostPrice,
 	FieldMerchantID,
@@ -240,6 +273,14 @@ var (
 	DefaultMnemonic string
 	// MnemonicValidator is a validator for the "mnemonic" field. It is called by the builders before save.
 	MnemonicValidator func(string) error
+	// DefaultPinyin holds the default value on creation for the "pinyin" field.
+	DefaultPinyin string
+	// PinyinValidator is a validator for the "pinyin" field. It is called by the builders before save.
+	PinyinValidator func(string) error
+	// DefaultPinyinInitials holds the default value on creation for the "pinyin_initials" field.
+	DefaultPinyinInitials string
+	// PinyinInitialsValidator is a validator for the "pinyin_initials" field. It is called by the builders before save.
+	PinyinInitialsValidator func(string) error
 	// DefaultShelfLife holds the default value on creation for the "shelf_life" field.
 	DefaultShelfLife int
 	// DefaultInheritTaxRate holds the default value on creation for the "inherit_tax_rate" field.
@@ -254,6 +295,14 @@ var (
 	DefaultDescription string
 	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	DescriptionValidator func(string) error
+	// DefaultHalal holds the default value on creation for the "halal" field.
+	DefaultHalal bool
+	// DefaultVegetarian holds the default value on creation for the "vegetarian" field.
+	DefaultVegetarian bool
+	// DefaultVegan holds the default value on creation for the "vegan" field.
+	DefaultVegan bool
+	// DefaultSpicyLevel holds the default value on creation for the "spicy_level" field.
+	DefaultSpicyLevel domain.SpicyLevel
 	// DefaultStoreID holds the default value on creation for the "store_id" field.
 	DefaultStoreID func() uuid.UUID
 	// DefaultID holds the default value on creation for the "id" field.
@@ -342,6 +391,16 @@ func ByMnemonic(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMnemonic, opts...).ToFunc()
 }
 
+// ByPinyin orders the results by the pinyin field.
+func ByPinyin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinyin, opts...).ToFunc()
+}
+
+// ByPinyinInitials orders the results by the pinyin_initials field.
+func ByPinyinInitials(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinyinInitials, opts...).ToFunc()
+}
+
 // ByShelfLife orders the results by the shelf_life field.
 func ByShelfLife(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldShelfLife, opts...).ToFunc()
@@ -412,6 +471,26 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByHalal orders the results by the halal field.
+func ByHalal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHalal, opts...).ToFunc()
+}
+
+// ByVegetarian orders the results by the vegetarian field.
+func ByVegetarian(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVegetarian, opts...).ToFunc()
+}
+
+// ByVegan orders the results by the vegan field.
+func ByVegan(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVegan, opts...).ToFunc()
+}
+
+// BySpicyLevel orders the results by the spicy_level field.
+func BySpicyLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpicyLevel, opts...).ToFunc()
+}
+
 // ByEstimatedCostPrice orders the results by the estimated_cost_price field.
 func ByEstimatedCostPrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEstimatedCostPrice, opts...).ToFunc()
@@ -93,6 +93,16 @@ func Mnemonic(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldMnemonic, v))
 }
 
+// Pinyin applies equality check predicate on the "pinyin" field. It's identical to PinyinEQ.
+func Pinyin(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPinyin, v))
+}
+
+// PinyinInitials applies equality check predicate on the "pinyin_initials" field. It's identical to PinyinInitialsEQ.
+func PinyinInitials(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPinyinInitials, v))
+}
+
 // ShelfLife applies equality check predicate on the "shelf_life" field. It's identical to ShelfLifeEQ.
 func ShelfLife(v int) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldShelfLife, v))
@@ -153,6 +163,27 @@ func Description(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldDescription, v))
 }
 
+// Halal applies equality check predicate on the "halal" field. It's identical to HalalEQ.
+func Halal(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldHalal, v))
+}
+
+// Vegetarian applies equality check predicate on the "vegetarian" field. It's identical to VegetarianEQ.
+func Vegetarian(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldVegetarian, v))
+}
+
+// Vegan applies equality check predicate on the "vegan" field. It's identical to VeganEQ.
+func Vegan(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldVegan, v))
+}
+
+// SpicyLevel applies equality check predicate on the "spicy_level" field. It's identical to SpicyLevelEQ.
+func SpicyLevel(v domain.SpicyLevel) predicate.Product {
+	vc := int(v)
+	return predicate.Product(sql.FieldEQ(FieldSpicyLevel, vc))
+}
+
 // EstimatedCostPrice applies equality check predicate on the "estimated_cost_price" field. It's identical to EstimatedCostPriceEQ.
 func EstimatedCostPrice(v decimal.Decimal) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldEstimatedCostPrice, v))
@@ -523,6 +554,136 @@ func MnemonicContainsFold(v string) predicate.Product {
 	return predicate.Product(sql.FieldContainsFold(FieldMnemonic, v))
 }
 
+// PinyinEQ applies the EQ predicate on the "pinyin" field.
+func PinyinEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPinyin, v))
+}
+
+// PinyinNEQ applies the NEQ predicate on the "pinyin" field.
+func PinyinNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldPinyin, v))
+}
+
+// PinyinIn applies the In predicate on the "pinyin" field.
+func PinyinIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldPinyin, vs...))
+}
+
+// PinyinNotIn applies the NotIn predicate on the "pinyin" field.
+func PinyinNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldPinyin, vs...))
+}
+
+// PinyinGT applies the GT predicate on the "pinyin" field.
+func PinyinGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldPinyin, v))
+}
+
+// PinyinGTE applies the GTE predicate on the "pinyin" field.
+func PinyinGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldPinyin, v))
+}
+
+// PinyinLT applies the LT predicate on the "pinyin" field.
+func PinyinLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldPinyin, v))
+}
+
+// PinyinLTE applies the LTE predicate on the "pinyin" field.
+func PinyinLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldPinyin, v))
+}
+
+// PinyinContains applies the Contains predicate on the "pinyin" field.
+func PinyinContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldPinyin, v))
+}
+
+// PinyinHasPrefix applies the HasPrefix predicate on the "pinyin" field.
+func PinyinHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldPinyin, v))
+}
+
+// PinyinHasSuffix applies the HasSuffix predicate on the "pinyin" field.
+func PinyinHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldPinyin, v))
+}
+
+// PinyinEqualFold applies the EqualFold predicate on the "pinyin" field.
+func PinyinEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldPinyin, v))
+}
+
+// PinyinContainsFold applies the ContainsFold predicate on the "pinyin" field.
+func PinyinContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldPinyin, v))
+}
+
+// PinyinInitialsEQ applies the EQ predicate on the "pinyin_initials" field.
+func PinyinInitialsEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPinyinInitials, v))
+}
+
+// PinyinInitialsNEQ applies the NEQ predicate on the "pinyin_initials" field.
+func PinyinInitialsNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldPinyinInitials, v))
+}
+
+// PinyinInitialsIn applies the In predicate on the "pinyin_initials" field.
+func PinyinInitialsIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldPinyinInitials, vs...))
+}
+
+// PinyinInitialsNotIn applies the NotIn predicate on the "pinyin_initials" field.
+func PinyinInitialsNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldPinyinInitials, vs...))
+}
+
+// PinyinInitialsGT applies the GT predicate on the "pinyin_initials" field.
+func PinyinInitialsGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldPinyinInitials, v))
+}
+
+// PinyinInitialsGTE applies the GTE predicate on the "pinyin_initials" field.
+func PinyinInitialsGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldPinyinInitials, v))
+}
+
+// PinyinInitialsLT applies the LT predicate on the "pinyin_initials" field.
+func PinyinInitialsLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldPinyinInitials, v))
+}
+
+// PinyinInitialsLTE applies the LTE predicate on the "pinyin_initials" field.
+func PinyinInitialsLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldPinyinInitials, v))
+}
+
+// PinyinInitialsContains applies the Contains predicate on the "pinyin_initials" field.
+func PinyinInitialsContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldPinyinInitials, v))
+}
+
+// PinyinInitialsHasPrefix applies the HasPrefix predicate on the "pinyin_initials" field.
+func PinyinInitialsHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldPinyinInitials, v))
+}
+
+// PinyinInitialsHasSuffix applies the HasSuffix predicate on the "pinyin_initials" field.
+func PinyinInitialsHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldPinyinInitials, v))
+}
+
+// PinyinInitialsEqualFold applies the EqualFold predicate on the "pinyin_initials" field.
+func PinyinInitialsEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldPinyinInitials, v))
+}
+
+// PinyinInitialsContainsFold applies the ContainsFold predicate on the "pinyin_initials" field.
+func PinyinInitialsContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldPinyinInitials, v))
+}
+
 // ShelfLifeEQ applies the EQ predicate on the "shelf_life" field.
 func ShelfLifeEQ(v int) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldShelfLife, v))
@@ -1073,6 +1234,140 @@ func DescriptionContainsFold(v string) predicate.Product {
 	return predicate.Product(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// NameI18nIsNil applies the IsNil predicate on the "name_i18n" field.
+func NameI18nIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldNameI18n))
+}
+
+// NameI18nNotNil applies the NotNil predicate on the "name_i18n" field.
+func NameI18nNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldNameI18n))
+}
+
+// DescriptionI18nIsNil applies the IsNil predicate on the "description_i18n" field.
+func DescriptionI18nIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldDescriptionI18n))
+}
+
+// DescriptionI18nNotNil applies the NotNil predicate on the "description_i18n" field.
+func DescriptionI18nNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldDescriptionI18n))
+}
+
+// AllergensIsNil applies the IsNil predicate on the "allergens" field.
+func AllergensIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldAllergens))
+}
+
+// AllergensNotNil applies the NotNil predicate on the "allergens" field.
+func AllergensNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldAllergens))
+}
+
+// HalalEQ applies the EQ predicate on the "halal" field.
+func HalalEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldHalal, v))
+}
+
+// HalalNEQ applies the NEQ predicate on the "halal" field.
+func HalalNEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldHalal, v))
+}
+
+// VegetarianEQ applies the EQ predicate on the "vegetarian" field.
+func VegetarianEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldVegetarian, v))
+}
+
+// VegetarianNEQ applies the NEQ predicate on the "vegetarian" field.
+func VegetarianNEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldVegetarian, v))
+}
+
+// VeganEQ applies the EQ predicate on the "vegan" field.
+func VeganEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldVegan, v))
+}
+
+// VeganNEQ applies the NEQ predicate on the "vegan" field.
+func VeganNEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldVegan, v))
+}
+
+// SpicyLevelEQ applies the EQ predicate on the "spicy_level" field.
+func SpicyLevelEQ(v domain.SpicyLevel) predicate.Product {
+	vc := int(v)
+	return predicate.Product(sql.FieldEQ(FieldSpicyLevel, vc))
+}
+
+// SpicyLevelNEQ applies the NEQ predicate on the "spicy_level" field.
+func SpicyLevelNEQ(v domain.SpicyLevel) predicate.Product {
+	vc := int(v)
+	return predicate.Product(sql.FieldNEQ(FieldSpicyLevel, vc))
+}
+
+// SpicyLevelIn applies the In predicate on the "spicy_level" field.
+func SpicyLevelIn(vs ...domain.SpicyLevel) predicate.Product {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int(vs[i])
+	}
+	return predicate.Product(sql.FieldIn(FieldSpicyLevel, v...))
+}
+
+// SpicyLevelNotIn applies the NotIn predicate on the "spicy_level" field.
+func SpicyLevelNotIn(vs ...domain.SpicyLevel) predicate.Product {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int(vs[i])
+	}
+	return predicate.Product(sql.FieldNotIn(FieldSpicyLevel, v...))
+}
+
+// SpicyLevelGT applies the GT predicate on the "spicy_level" field.
+func SpicyLevelGT(v domain.SpicyLevel) predicate.Product {
+	vc := int(v)
+	return predicate.Product(sql.FieldGT(FieldSpicyLevel, vc))
+}
+
+// SpicyLevelGTE applies the GTE predicate on the "spicy_level" field.
+func SpicyLevelGTE(v domain.SpicyLevel) predicate.Product {
+	vc := int(v)
+	return predicate.Product(sql.FieldGTE(FieldSpicyLevel, vc))
+}
+
+// SpicyLevelLT applies the LT predicate on the "spicy_level" field.
+func SpicyLevelLT(v domain.SpicyLevel) predicate.Product {
+	vc := int(v)
+	return predicate.Product(sql.FieldLT(FieldSpicyLevel, vc))
+}
+
+// SpicyLevelLTE applies the LTE predicate on the "spicy_level" field.
+func SpicyLevelLTE(v domain.SpicyLevel) predicate.Product {
+	vc := int(v)
+	return predicate.Product(sql.FieldLTE(FieldSpicyLevel, vc))
+}
+
+// NutritionIsNil applies the IsNil predicate on the "nutrition" field.
+func NutritionIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldNutrition))
+}
+
+// NutritionNotNil applies the NotNil predicate on the "nutrition" field.
+func NutritionNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldNutrition))
+}
+
+// AttrLimitsIsNil applies the IsNil predicate on the "attr_limits" field.
+func AttrLimitsIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldAttrLimits))
+}
+
+// AttrLimitsNotNil applies the NotNil predicate on the "attr_limits" field.
+func AttrLimitsNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldAttrLimits))
+}
+
 // EstimatedCostPriceEQ applies the EQ predicate on the "estimated_cost_price" field.
 func EstimatedCostPriceEQ(v decimal.Decimal) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldEstimatedCostPrice, v))
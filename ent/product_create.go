@@ -132,6 +132,34 @@ func (pc *ProductCreate) SetNillableMnemonic(s *string) *ProductCreate {
 	return pc
 }
 
+// SetPinyin sets the "pinyin" field.
+func (pc *ProductCreate) SetPinyin(s string) *ProductCreate {
+	pc.mutation.SetPinyin(s)
+	return pc
+}
+
+// SetNillablePinyin sets the "pinyin" field if the given value is not nil.
+func (pc *ProductCreate) SetNillablePinyin(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetPinyin(*s)
+	}
+	return pc
+}
+
+// SetPinyinInitials sets the "pinyin_initials" field.
+func (pc *ProductCreate) SetPinyinInitials(s string) *ProductCreate {
+	pc.mutation.SetPinyinInitials(s)
+	return pc
+}
+
+// SetNillablePinyinInitials sets the "pinyin_initials" field if the given value is not nil.
+func (pc *ProductCreate) SetNillablePinyinInitials(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetPinyinInitials(*s)
+	}
+	return pc
+}
+
 // SetShelfLife sets the "shelf_life" field.
 func (pc *ProductCreate) SetShelfLife(i int) *ProductCreate {
 	pc.mutation.SetShelfLife(i)
@@ -338,6 +366,92 @@ func (pc *ProductCreate) SetNillableDescription(s *string) *ProductCreate {
 	return pc
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (pc *ProductCreate) SetNameI18n(dt domain.LocalizedText) *ProductCreate {
+	pc.mutation.SetNameI18n(dt)
+	return pc
+}
+
+// SetDescriptionI18n sets the "description_i18n" field.
+func (pc *ProductCreate) SetDescriptionI18n(dt domain.LocalizedText) *ProductCreate {
+	pc.mutation.SetDescriptionI18n(dt)
+	return pc
+}
+
+// SetAllergens sets the "allergens" field.
+func (pc *ProductCreate) SetAllergens(d []domain.Allergen) *ProductCreate {
+	pc.mutation.SetAllergens(d)
+	return pc
+}
+
+// SetHalal sets the "halal" field.
+func (pc *ProductCreate) SetHalal(b bool) *ProductCreate {
+	pc.mutation.SetHalal(b)
+	return pc
+}
+
+// SetNillableHalal sets the "halal" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableHalal(b *bool) *ProductCreate {
+	if b != nil {
+		pc.SetHalal(*b)
+	}
+	return pc
+}
+
+// SetVegetarian sets the "vegetarian" field.
+func (pc *ProductCreate) SetVegetarian(b bool) *ProductCreate {
+	pc.mutation.SetVegetarian(b)
+	return pc
+}
+
+// SetNillableVegetarian sets the "vegetarian" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableVegetarian(b *bool) *ProductCreate {
+	if b != nil {
+		pc.SetVegetarian(*b)
+	}
+	return pc
+}
+
+// SetVegan sets the "vegan" field.
+func (pc *ProductCreate) SetVegan(b bool) *ProductCreate {
+	pc.mutation.SetVegan(b)
+	return pc
+}
+
+// SetNillableVegan sets the "vegan" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableVegan(b *bool) *ProductCreate {
+	if b != nil {
+		pc.SetVegan(*b)
+	}
+	return pc
+}
+
+// SetSpicyLevel sets the "spicy_level" field.
+func (pc *ProductCreate) SetSpicyLevel(dl domain.SpicyLevel) *ProductCreate {
+	pc.mutation.SetSpicyLevel(dl)
+	return pc
+}
+
+// SetNillableSpicyLevel sets the "spicy_level" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableSpicyLevel(dl *domain.SpicyLevel) *ProductCreate {
+	if dl != nil {
+		pc.SetSpicyLevel(*dl)
+	}
+	return pc
+}
+
+// SetNutrition sets the "nutrition" field.
+func (pc *ProductCreate) SetNutrition(dn *domain.ProductNutrition) *ProductCreate {
+	pc.mutation.SetNutrition(dn)
+	return pc
+}
+
+// SetAttrLimits sets the "attr_limits" field.
+func (pc *ProductCreate) SetAttrLimits(dal domain.ProductAttrLimits) *ProductCreate {
+	pc.mutation.SetAttrLimits(dal)
+	return pc
+}
+
 // SetEstimatedCostPrice sets the "estimated_cost_price" field.
 func (pc *ProductCreate) SetEstimatedCostPrice(d decimal.Decimal) *ProductCreate {
 	pc.mutation.SetEstimatedCostPrice(d)
@@ -573,6 +687,14 @@ func (pc *ProductCreate) defaults() error {
 		v := product.DefaultMnemonic
 		pc.mutation.SetMnemonic(v)
 	}
+	if _, ok := pc.mutation.Pinyin(); !ok {
+		v := product.DefaultPinyin
+		pc.mutation.SetPinyin(v)
+	}
+	if _, ok := pc.mutation.PinyinInitials(); !ok {
+		v := product.DefaultPinyinInitials
+		pc.mutation.SetPinyinInitials(v)
+	}
 	if _, ok := pc.mutation.ShelfLife(); !ok {
 		v := product.DefaultShelfLife
 		pc.mutation.SetShelfLife(v)
@@ -597,6 +719,22 @@ func (pc *ProductCreate) defaults() error {
 		v := product.DefaultDescription
 		pc.mutation.SetDescription(v)
 	}
+	if _, ok := pc.mutation.Halal(); !ok {
+		v := product.DefaultHalal
+		pc.mutation.SetHalal(v)
+	}
+	if _, ok := pc.mutation.Vegetarian(); !ok {
+		v := product.DefaultVegetarian
+		pc.mutation.SetVegetarian(v)
+	}
+	if _, ok := pc.mutation.Vegan(); !ok {
+		v := product.DefaultVegan
+		pc.mutation.SetVegan(v)
+	}
+	if _, ok := pc.mutation.SpicyLevel(); !ok {
+		v := product.DefaultSpicyLevel
+		pc.mutation.SetSpicyLevel(v)
+	}
 	if _, ok := pc.mutation.StoreID(); !ok {
 		if product.DefaultStoreID == nil {
 			return fmt.Errorf("ent: uninitialized product.DefaultStoreID (forgotten import ent/runtime?)")
@@ -652,6 +790,22 @@ func (pc *ProductCreate) check() error {
 			return &ValidationError{Name: "mnemonic", err: fmt.Errorf(`ent: validator failed for field "Product.mnemonic": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.Pinyin(); !ok {
+		return &ValidationError{Name: "pinyin", err: errors.New(`ent: missing required field "Product.pinyin"`)}
+	}
+	if v, ok := pc.mutation.Pinyin(); ok {
+		if err := product.PinyinValidator(v); err != nil {
+			return &ValidationError{Name: "pinyin", err: fmt.Errorf(`ent: validator failed for field "Product.pinyin": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.PinyinInitials(); !ok {
+		return &ValidationError{Name: "pinyin_initials", err: errors.New(`ent: missing required field "Product.pinyin_initials"`)}
+	}
+	if v, ok := pc.mutation.PinyinInitials(); ok {
+		if err := product.PinyinInitialsValidator(v); err != nil {
+			return &ValidationError{Name: "pinyin_initials", err: fmt.Errorf(`ent: validator failed for field "Product.pinyin_initials": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.ShelfLife(); !ok {
 		return &ValidationError{Name: "shelf_life", err: errors.New(`ent: missing required field "Product.shelf_life"`)}
 	}
@@ -699,6 +853,18 @@ func (pc *ProductCreate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Product.description": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.Halal(); !ok {
+		return &ValidationError{Name: "halal", err: errors.New(`ent: missing required field "Product.halal"`)}
+	}
+	if _, ok := pc.mutation.Vegetarian(); !ok {
+		return &ValidationError{Name: "vegetarian", err: errors.New(`ent: missing required field "Product.vegetarian"`)}
+	}
+	if _, ok := pc.mutation.Vegan(); !ok {
+		return &ValidationError{Name: "vegan", err: errors.New(`ent: missing required field "Product.vegan"`)}
+	}
+	if _, ok := pc.mutation.SpicyLevel(); !ok {
+		return &ValidationError{Name: "spicy_level", err: errors.New(`ent: missing required field "Product.spicy_level"`)}
+	}
 	if _, ok := pc.mutation.MerchantID(); !ok {
 		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "Product.merchant_id"`)}
 	}
@@ -775,6 +941,14 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldMnemonic, field.TypeString, value)
 		_node.Mnemonic = value
 	}
+	if value, ok := pc.mutation.Pinyin(); ok {
+		_spec.SetField(product.FieldPinyin, field.TypeString, value)
+		_node.Pinyin = value
+	}
+	if value, ok := pc.mutation.PinyinInitials(); ok {
+		_spec.SetField(product.FieldPinyinInitials, field.TypeString, value)
+		_node.PinyinInitials = value
+	}
 	if value, ok := pc.mutation.ShelfLife(); ok {
 		_spec.SetField(product.FieldShelfLife, field.TypeInt, value)
 		_node.ShelfLife = value
@@ -831,6 +1005,42 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := pc.mutation.NameI18n(); ok {
+		_spec.SetField(product.FieldNameI18n, field.TypeJSON, value)
+		_node.NameI18n = value
+	}
+	if value, ok := pc.mutation.DescriptionI18n(); ok {
+		_spec.SetField(product.FieldDescriptionI18n, field.TypeJSON, value)
+		_node.DescriptionI18n = value
+	}
+	if value, ok := pc.mutation.Allergens(); ok {
+		_spec.SetField(product.FieldAllergens, field.TypeJSON, value)
+		_node.Allergens = value
+	}
+	if value, ok := pc.mutation.Halal(); ok {
+		_spec.SetField(product.FieldHalal, field.TypeBool, value)
+		_node.Halal = value
+	}
+	if value, ok := pc.mutation.Vegetarian(); ok {
+		_spec.SetField(product.FieldVegetarian, field.TypeBool, value)
+		_node.Vegetarian = value
+	}
+	if value, ok := pc.mutation.Vegan(); ok {
+		_spec.SetField(product.FieldVegan, field.TypeBool, value)
+		_node.Vegan = value
+	}
+	if value, ok := pc.mutation.SpicyLevel(); ok {
+		_spec.SetField(product.FieldSpicyLevel, field.TypeInt, value)
+		_node.SpicyLevel = value
+	}
+	if value, ok := pc.mutation.Nutrition(); ok {
+		_spec.SetField(product.FieldNutrition, field.TypeJSON, value)
+		_node.Nutrition = value
+	}
+	if value, ok := pc.mutation.AttrLimits(); ok {
+		_spec.SetField(product.FieldAttrLimits, field.TypeJSON, value)
+		_node.AttrLimits = value
+	}
 	if value, ok := pc.mutation.EstimatedCostPrice(); ok {
 		_spec.SetField(product.FieldEstimatedCostPrice, field.TypeOther, value)
 		_node.EstimatedCostPrice = &value
@@ -1159,6 +1369,30 @@ func (u *ProductUpsert) UpdateMnemonic() *ProductUpsert {
 	return u
 }
 
+// SetPinyin sets the "pinyin" field.
+func (u *ProductUpsert) SetPinyin(v string) *ProductUpsert {
+	u.Set(product.FieldPinyin, v)
+	return u
+}
+
+// UpdatePinyin sets the "pinyin" field to the value that was provided on create.
+func (u *ProductUpsert) UpdatePinyin() *ProductUpsert {
+	u.SetExcluded(product.FieldPinyin)
+	return u
+}
+
+// SetPinyinInitials sets the "pinyin_initials" field.
+func (u *ProductUpsert) SetPinyinInitials(v string) *ProductUpsert {
+	u.Set(product.FieldPinyinInitials, v)
+	return u
+}
+
+// UpdatePinyinInitials sets the "pinyin_initials" field to the value that was provided on create.
+func (u *ProductUpsert) UpdatePinyinInitials() *ProductUpsert {
+	u.SetExcluded(product.FieldPinyinInitials)
+	return u
+}
+
 // SetShelfLife sets the "shelf_life" field.
 func (u *ProductUpsert) SetShelfLife(v int) *ProductUpsert {
 	u.Set(product.FieldShelfLife, v)
@@ -1429,6 +1663,150 @@ func (u *ProductUpsert) UpdateDescription() *ProductUpsert {
 	return u
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *ProductUpsert) SetNameI18n(v domain.LocalizedText) *ProductUpsert {
+	u.Set(product.FieldNameI18n, v)
+	return u
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateNameI18n() *ProductUpsert {
+	u.SetExcluded(product.FieldNameI18n)
+	return u
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *ProductUpsert) ClearNameI18n() *ProductUpsert {
+	u.SetNull(product.FieldNameI18n)
+	return u
+}
+
+// SetDescriptionI18n sets the "description_i18n" field.
+func (u *ProductUpsert) SetDescriptionI18n(v domain.LocalizedText) *ProductUpsert {
+	u.Set(product.FieldDescriptionI18n, v)
+	return u
+}
+
+// UpdateDescriptionI18n sets the "description_i18n" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateDescriptionI18n() *ProductUpsert {
+	u.SetExcluded(product.FieldDescriptionI18n)
+	return u
+}
+
+// ClearDescriptionI18n clears the value of the "description_i18n" field.
+func (u *ProductUpsert) ClearDescriptionI18n() *ProductUpsert {
+	u.SetNull(product.FieldDescriptionI18n)
+	return u
+}
+
+// SetAllergens sets the "allergens" field.
+func (u *ProductUpsert) SetAllergens(v []domain.Allergen) *ProductUpsert {
+	u.Set(product.FieldAllergens, v)
+	return u
+}
+
+// UpdateAllergens sets the "allergens" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateAllergens() *ProductUpsert {
+	u.SetExcluded(product.FieldAllergens)
+	return u
+}
+
+// ClearAllergens clears the value of the "allergens" field.
+func (u *ProductUpsert) ClearAllergens() *ProductUpsert {
+	u.SetNull(product.FieldAllergens)
+	return u
+}
+
+// SetHalal sets the "halal" field.
+func (u *ProductUpsert) SetHalal(v bool) *ProductUpsert {
+	u.Set(product.FieldHalal, v)
+	return u
+}
+
+// UpdateHalal sets the "halal" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateHalal() *ProductUpsert {
+	u.SetExcluded(product.FieldHalal)
+	return u
+}
+
+// SetVegetarian sets the "vegetarian" field.
+func (u *ProductUpsert) SetVegetarian(v bool) *ProductUpsert {
+	u.Set(product.FieldVegetarian, v)
+	return u
+}
+
+// UpdateVegetarian sets the "vegetarian" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateVegetarian() *ProductUpsert {
+	u.SetExcluded(product.FieldVegetarian)
+	return u
+}
+
+// SetVegan sets the "vegan" field.
+func (u *ProductUpsert) SetVegan(v bool) *ProductUpsert {
+	u.Set(product.FieldVegan, v)
+	return u
+}
+
+// UpdateVegan sets the "vegan" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateVegan() *ProductUpsert {
+	u.SetExcluded(product.FieldVegan)
+	return u
+}
+
+// SetSpicyLevel sets the "spicy_level" field.
+func (u *ProductUpsert) SetSpicyLevel(v domain.SpicyLevel) *ProductUpsert {
+	u.Set(product.FieldSpicyLevel, v)
+	return u
+}
+
+// UpdateSpicyLevel sets the "spicy_level" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateSpicyLevel() *ProductUpsert {
+	u.SetExcluded(product.FieldSpicyLevel)
+	return u
+}
+
+// AddSpicyLevel adds v to the "spicy_level" field.
+func (u *ProductUpsert) AddSpicyLevel(v domain.SpicyLevel) *ProductUpsert {
+	u.Add(product.FieldSpicyLevel, v)
+	return u
+}
+
+// SetNutrition sets the "nutrition" field.
+func (u *ProductUpsert) SetNutrition(v *domain.ProductNutrition) *ProductUpsert {
+	u.Set(product.FieldNutrition, v)
+	return u
+}
+
+// UpdateNutrition sets the "nutrition" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateNutrition() *ProductUpsert {
+	u.SetExcluded(product.FieldNutrition)
+	return u
+}
+
+// ClearNutrition clears the value of the "nutrition" field.
+func (u *ProductUpsert) ClearNutrition() *ProductUpsert {
+	u.SetNull(product.FieldNutrition)
+	return u
+}
+
+// SetAttrLimits sets the "attr_limits" field.
+func (u *ProductUpsert) SetAttrLimits(v domain.ProductAttrLimits) *ProductUpsert {
+	u.Set(product.FieldAttrLimits, v)
+	return u
+}
+
+// UpdateAttrLimits sets the "attr_limits" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateAttrLimits() *ProductUpsert {
+	u.SetExcluded(product.FieldAttrLimits)
+	return u
+}
+
+// ClearAttrLimits clears the value of the "attr_limits" field.
+func (u *ProductUpsert) ClearAttrLimits() *ProductUpsert {
+	u.SetNull(product.FieldAttrLimits)
+	return u
+}
+
 // SetEstimatedCostPrice sets the "estimated_cost_price" field.
 func (u *ProductUpsert) SetEstimatedCostPrice(v decimal.Decimal) *ProductUpsert {
 	u.Set(product.FieldEstimatedCostPrice, v)
@@ -1634,6 +2012,34 @@ func (u *ProductUpsertOne) UpdateMnemonic() *ProductUpsertOne {
 	})
 }
 
+// SetPinyin sets the "pinyin" field.
+func (u *ProductUpsertOne) SetPinyin(v string) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetPinyin(v)
+	})
+}
+
+// UpdatePinyin sets the "pinyin" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdatePinyin() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdatePinyin()
+	})
+}
+
+// SetPinyinInitials sets the "pinyin_initials" field.
+func (u *ProductUpsertOne) SetPinyinInitials(v string) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetPinyinInitials(v)
+	})
+}
+
+// UpdatePinyinInitials sets the "pinyin_initials" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdatePinyinInitials() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdatePinyinInitials()
+	})
+}
+
 // SetShelfLife sets the "shelf_life" field.
 func (u *ProductUpsertOne) SetShelfLife(v int) *ProductUpsertOne {
 	return u.Update(func(s *ProductUpsert) {
@@ -1949,6 +2355,174 @@ func (u *ProductUpsertOne) UpdateDescription() *ProductUpsertOne {
 	})
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *ProductUpsertOne) SetNameI18n(v domain.LocalizedText) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetNameI18n(v)
+	})
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateNameI18n() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateNameI18n()
+	})
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *ProductUpsertOne) ClearNameI18n() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearNameI18n()
+	})
+}
+
+// SetDescriptionI18n sets the "description_i18n" field.
+func (u *ProductUpsertOne) SetDescriptionI18n(v domain.LocalizedText) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetDescriptionI18n(v)
+	})
+}
+
+// UpdateDescriptionI18n sets the "description_i18n" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateDescriptionI18n() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateDescriptionI18n()
+	})
+}
+
+// ClearDescriptionI18n clears the value of the "description_i18n" field.
+func (u *ProductUpsertOne) ClearDescriptionI18n() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearDescriptionI18n()
+	})
+}
+
+// SetAllergens sets the "allergens" field.
+func (u *ProductUpsertOne) SetAllergens(v []domain.Allergen) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetAllergens(v)
+	})
+}
+
+// UpdateAllergens sets the "allergens" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateAllergens() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateAllergens()
+	})
+}
+
+// ClearAllergens clears the value of the "allergens" field.
+func (u *ProductUpsertOne) ClearAllergens() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearAllergens()
+	})
+}
+
+// SetHalal sets the "halal" field.
+func (u *ProductUpsertOne) SetHalal(v bool) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetHalal(v)
+	})
+}
+
+// UpdateHalal sets the "halal" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateHalal() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateHalal()
+	})
+}
+
+// SetVegetarian sets the "vegetarian" field.
+func (u *ProductUpsertOne) SetVegetarian(v bool) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetVegetarian(v)
+	})
+}
+
+// UpdateVegetarian sets the "vegetarian" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateVegetarian() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateVegetarian()
+	})
+}
+
+// SetVegan sets the "vegan" field.
+func (u *ProductUpsertOne) SetVegan(v bool) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetVegan(v)
+	})
+}
+
+// UpdateVegan sets the "vegan" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateVegan() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateVegan()
+	})
+}
+
+// SetSpicyLevel sets the "spicy_level" field.
+func (u *ProductUpsertOne) SetSpicyLevel(v domain.SpicyLevel) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetSpicyLevel(v)
+	})
+}
+
+// AddSpicyLevel adds v to the "spicy_level" field.
+func (u *ProductUpsertOne) AddSpicyLevel(v domain.SpicyLevel) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.AddSpicyLevel(v)
+	})
+}
+
+// UpdateSpicyLevel sets the "spicy_level" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateSpicyLevel() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateSpicyLevel()
+	})
+}
+
+// SetNutrition sets the "nutrition" field.
+func (u *ProductUpsertOne) SetNutrition(v *domain.ProductNutrition) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetNutrition(v)
+	})
+}
+
+// UpdateNutrition sets the "nutrition" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateNutrition() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateNutrition()
+	})
+}
+
+// ClearNutrition clears the value of the "nutrition" field.
+func (u *ProductUpsertOne) ClearNutrition() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearNutrition()
+	})
+}
+
+// SetAttrLimits sets the "attr_limits" field.
+func (u *ProductUpsertOne) SetAttrLimits(v domain.ProductAttrLimits) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetAttrLimits(v)
+	})
+}
+
+// UpdateAttrLimits sets the "attr_limits" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateAttrLimits() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateAttrLimits()
+	})
+}
+
+// ClearAttrLimits clears the value of the "attr_limits" field.
+func (u *ProductUpsertOne) ClearAttrLimits() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearAttrLimits()
+	})
+}
+
 // SetEstimatedCostPrice sets the "estimated_cost_price" field.
 func (u *ProductUpsertOne) SetEstimatedCostPrice(v decimal.Decimal) *ProductUpsertOne {
 	return u.Update(func(s *ProductUpsert) {
@@ -2327,6 +2901,34 @@ func (u *ProductUpsertBulk) UpdateMnemonic() *ProductUpsertBulk {
 	})
 }
 
+// SetPinyin sets the "pinyin" field.
+func (u *ProductUpsertBulk) SetPinyin(v string) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetPinyin(v)
+	})
+}
+
+// UpdatePinyin sets the "pinyin" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdatePinyin() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdatePinyin()
+	})
+}
+
+// SetPinyinInitials sets the "pinyin_initials" field.
+func (u *ProductUpsertBulk) SetPinyinInitials(v string) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetPinyinInitials(v)
+	})
+}
+
+// UpdatePinyinInitials sets the "pinyin_initials" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdatePinyinInitials() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdatePinyinInitials()
+	})
+}
+
 // SetShelfLife sets the "shelf_life" field.
 func (u *ProductUpsertBulk) SetShelfLife(v int) *ProductUpsertBulk {
 	return u.Update(func(s *ProductUpsert) {
@@ -2642,6 +3244,174 @@ func (u *ProductUpsertBulk) UpdateDescription() *ProductUpsertBulk {
 	})
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *ProductUpsertBulk) SetNameI18n(v domain.LocalizedText) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetNameI18n(v)
+	})
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateNameI18n() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateNameI18n()
+	})
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *ProductUpsertBulk) ClearNameI18n() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearNameI18n()
+	})
+}
+
+// SetDescriptionI18n sets the "description_i18n" field.
+func (u *ProductUpsertBulk) SetDescriptionI18n(v domain.LocalizedText) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetDescriptionI18n(v)
+	})
+}
+
+// UpdateDescriptionI18n sets the "description_i18n" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateDescriptionI18n() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateDescriptionI18n()
+	})
+}
+
+// ClearDescriptionI18n clears the value of the "description_i18n" field.
+func (u *ProductUpsertBulk) ClearDescriptionI18n() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearDescriptionI18n()
+	})
+}
+
+// SetAllergens sets the "allergens" field.
+func (u *ProductUpsertBulk) SetAllergens(v []domain.Allergen) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetAllergens(v)
+	})
+}
+
+// UpdateAllergens sets the "allergens" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateAllergens() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateAllergens()
+	})
+}
+
+// ClearAllergens clears the value of the "allergens" field.
+func (u *ProductUpsertBulk) ClearAllergens() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearAllergens()
+	})
+}
+
+// SetHalal sets the "halal" field.
+func (u *ProductUpsertBulk) SetHalal(v bool) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetHalal(v)
+	})
+}
+
+// UpdateHalal sets the "halal" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateHalal() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateHalal()
+	})
+}
+
+// SetVegetarian sets the "vegetarian" field.
+func (u *ProductUpsertBulk) SetVegetarian(v bool) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetVegetarian(v)
+	})
+}
+
+// UpdateVegetarian sets the "vegetarian" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateVegetarian() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateVegetarian()
+	})
+}
+
+// SetVegan sets the "vegan" field.
+func (u *ProductUpsertBulk) SetVegan(v bool) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetVegan(v)
+	})
+}
+
+// UpdateVegan sets the "vegan" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateVegan() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateVegan()
+	})
+}
+
+// SetSpicyLevel sets the "spicy_level" field.
+func (u *ProductUpsertBulk) SetSpicyLevel(v domain.SpicyLevel) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetSpicyLevel(v)
+	})
+}
+
+// AddSpicyLevel adds v to the "spicy_level" field.
+func (u *ProductUpsertBulk) AddSpicyLevel(v domain.SpicyLevel) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.AddSpicyLevel(v)
+	})
+}
+
+// UpdateSpicyLevel sets the "spicy_level" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateSpicyLevel() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateSpicyLevel()
+	})
+}
+
+// SetNutrition sets the "nutrition" field.
+func (u *ProductUpsertBulk) SetNutrition(v *domain.ProductNutrition) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetNutrition(v)
+	})
+}
+
+// UpdateNutrition sets the "nutrition" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateNutrition() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateNutrition()
+	})
+}
+
+// ClearNutrition clears the value of the "nutrition" field.
+func (u *ProductUpsertBulk) ClearNutrition() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearNutrition()
+	})
+}
+
+// SetAttrLimits sets the "attr_limits" field.
+func (u *ProductUpsertBulk) SetAttrLimits(v domain.ProductAttrLimits) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetAttrLimits(v)
+	})
+}
+
+// UpdateAttrLimits sets the "attr_limits" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateAttrLimits() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateAttrLimits()
+	})
+}
+
+// ClearAttrLimits clears the value of the "attr_limits" field.
+func (u *ProductUpsertBulk) ClearAttrLimits() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearAttrLimits()
+	})
+}
+
 // SetEstimatedCostPrice sets the "estimated_cost_price" field.
 func (u *ProductUpsertBulk) SetEstimatedCostPrice(v decimal.Decimal) *ProductUpsertBulk {
 	return u.Update(func(s *ProductUpsert) {
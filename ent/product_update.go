@@ -146,6 +146,34 @@ func (pu *ProductUpdate) SetNillableMnemonic(s *string) *ProductUpdate {
 	return pu
 }
 
+// SetPinyin sets the "pinyin" field.
+func (pu *ProductUpdate) SetPinyin(s string) *ProductUpdate {
+	pu.mutation.SetPinyin(s)
+	return pu
+}
+
+// SetNillablePinyin sets the "pinyin" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillablePinyin(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetPinyin(*s)
+	}
+	return pu
+}
+
+// SetPinyinInitials sets the "pinyin_initials" field.
+func (pu *ProductUpdate) SetPinyinInitials(s string) *ProductUpdate {
+	pu.mutation.SetPinyinInitials(s)
+	return pu
+}
+
+// SetNillablePinyinInitials sets the "pinyin_initials" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillablePinyinInitials(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetPinyinInitials(*s)
+	}
+	return pu
+}
+
 // SetShelfLife sets the "shelf_life" field.
 func (pu *ProductUpdate) SetShelfLife(i int) *ProductUpdate {
 	pu.mutation.ResetShelfLife()
@@ -447,6 +475,141 @@ func (pu *ProductUpdate) SetNillableDescription(s *string) *ProductUpdate {
 	return pu
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (pu *ProductUpdate) SetNameI18n(dt domain.LocalizedText) *ProductUpdate {
+	pu.mutation.SetNameI18n(dt)
+	return pu
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (pu *ProductUpdate) ClearNameI18n() *ProductUpdate {
+	pu.mutation.ClearNameI18n()
+	return pu
+}
+
+// SetDescriptionI18n sets the "description_i18n" field.
+func (pu *ProductUpdate) SetDescriptionI18n(dt domain.LocalizedText) *ProductUpdate {
+	pu.mutation.SetDescriptionI18n(dt)
+	return pu
+}
+
+// ClearDescriptionI18n clears the value of the "description_i18n" field.
+func (pu *ProductUpdate) ClearDescriptionI18n() *ProductUpdate {
+	pu.mutation.ClearDescriptionI18n()
+	return pu
+}
+
+// SetAllergens sets the "allergens" field.
+func (pu *ProductUpdate) SetAllergens(d []domain.Allergen) *ProductUpdate {
+	pu.mutation.SetAllergens(d)
+	return pu
+}
+
+// AppendAllergens appends d to the "allergens" field.
+func (pu *ProductUpdate) AppendAllergens(d []domain.Allergen) *ProductUpdate {
+	pu.mutation.AppendAllergens(d)
+	return pu
+}
+
+// ClearAllergens clears the value of the "allergens" field.
+func (pu *ProductUpdate) ClearAllergens() *ProductUpdate {
+	pu.mutation.ClearAllergens()
+	return pu
+}
+
+// SetHalal sets the "halal" field.
+func (pu *ProductUpdate) SetHalal(b bool) *ProductUpdate {
+	pu.mutation.SetHalal(b)
+	return pu
+}
+
+// SetNillableHalal sets the "halal" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableHalal(b *bool) *ProductUpdate {
+	if b != nil {
+		pu.SetHalal(*b)
+	}
+	return pu
+}
+
+// SetVegetarian sets the "vegetarian" field.
+func (pu *ProductUpdate) SetVegetarian(b bool) *ProductUpdate {
+	pu.mutation.SetVegetarian(b)
+	return pu
+}
+
+// SetNillableVegetarian sets the "vegetarian" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableVegetarian(b *bool) *ProductUpdate {
+	if b != nil {
+		pu.SetVegetarian(*b)
+	}
+	return pu
+}
+
+// SetVegan sets the "vegan" field.
+func (pu *ProductUpdate) SetVegan(b bool) *ProductUpdate {
+	pu.mutation.SetVegan(b)
+	return pu
+}
+
+// SetNillableVegan sets the "vegan" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableVegan(b *bool) *ProductUpdate {
+	if b != nil {
+		pu.SetVegan(*b)
+	}
+	return pu
+}
+
+// SetSpicyLevel sets the "spicy_level" field.
+func (pu *ProductUpdate) SetSpicyLevel(dl domain.SpicyLevel) *ProductUpdate {
+	pu.mutation.ResetSpicyLevel()
+	pu.mutation.SetSpicyLevel(dl)
+	return pu
+}
+
+// SetNillableSpicyLevel sets the "spicy_level" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableSpicyLevel(dl *domain.SpicyLevel) *ProductUpdate {
+	if dl != nil {
+		pu.SetSpicyLevel(*dl)
+	}
+	return pu
+}
+
+// AddSpicyLevel adds dl to the "spicy_level" field.
+func (pu *ProductUpdate) AddSpicyLevel(dl domain.SpicyLevel) *ProductUpdate {
+	pu.mutation.AddSpicyLevel(dl)
+	return pu
+}
+
+// SetNutrition sets the "nutrition" field.
+func (pu *ProductUpdate) SetNutrition(dn *domain.ProductNutrition) *ProductUpdate {
+	pu.mutation.SetNutrition(dn)
+	return pu
+}
+
+// ClearNutrition clears the value of the "nutrition" field.
+func (pu *ProductUpdate) ClearNutrition() *ProductUpdate {
+	pu.mutation.ClearNutrition()
+	return pu
+}
+
+// SetAttrLimits sets the "attr_limits" field.
+func (pu *ProductUpdate) SetAttrLimits(dal domain.ProductAttrLimits) *ProductUpdate {
+	pu.mutation.SetAttrLimits(dal)
+	return pu
+}
+
+// AppendAttrLimits appends dal to the "attr_limits" field.
+func (pu *ProductUpdate) AppendAttrLimits(dal domain.ProductAttrLimits) *ProductUpdate {
+	pu.mutation.AppendAttrLimits(dal)
+	return pu
+}
+
+// ClearAttrLimits clears the value of the "attr_limits" field.
+func (pu *ProductUpdate) ClearAttrLimits() *ProductUpdate {
+	pu.mutation.ClearAttrLimits()
+	return pu
+}
+
 // SetEstimatedCostPrice sets the "estimated_cost_price" field.
 func (pu *ProductUpdate) SetEstimatedCostPrice(d decimal.Decimal) *ProductUpdate {
 	pu.mutation.SetEstimatedCostPrice(d)
@@ -811,6 +974,16 @@ func (pu *ProductUpdate) check() error {
 			return &ValidationError{Name: "mnemonic", err: fmt.Errorf(`ent: validator failed for field "Product.mnemonic": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.Pinyin(); ok {
+		if err := product.PinyinValidator(v); err != nil {
+			return &ValidationError{Name: "pinyin", err: fmt.Errorf(`ent: validator failed for field "Product.pinyin": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.PinyinInitials(); ok {
+		if err := product.PinyinInitialsValidator(v); err != nil {
+			return &ValidationError{Name: "pinyin_initials", err: fmt.Errorf(`ent: validator failed for field "Product.pinyin_initials": %w`, err)}
+		}
+	}
 	if v, ok := pu.mutation.SaleStatus(); ok {
 		if err := product.SaleStatusValidator(v); err != nil {
 			return &ValidationError{Name: "sale_status", err: fmt.Errorf(`ent: validator failed for field "Product.sale_status": %w`, err)}
@@ -882,6 +1055,12 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Mnemonic(); ok {
 		_spec.SetField(product.FieldMnemonic, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.Pinyin(); ok {
+		_spec.SetField(product.FieldPinyin, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.PinyinInitials(); ok {
+		_spec.SetField(product.FieldPinyinInitials, field.TypeString, value)
+	}
 	if value, ok := pu.mutation.ShelfLife(); ok {
 		_spec.SetField(product.FieldShelfLife, field.TypeInt, value)
 	}
@@ -966,6 +1145,61 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Description(); ok {
 		_spec.SetField(product.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.NameI18n(); ok {
+		_spec.SetField(product.FieldNameI18n, field.TypeJSON, value)
+	}
+	if pu.mutation.NameI18nCleared() {
+		_spec.ClearField(product.FieldNameI18n, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.DescriptionI18n(); ok {
+		_spec.SetField(product.FieldDescriptionI18n, field.TypeJSON, value)
+	}
+	if pu.mutation.DescriptionI18nCleared() {
+		_spec.ClearField(product.FieldDescriptionI18n, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.Allergens(); ok {
+		_spec.SetField(product.FieldAllergens, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedAllergens(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, product.FieldAllergens, value)
+		})
+	}
+	if pu.mutation.AllergensCleared() {
+		_spec.ClearField(product.FieldAllergens, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.Halal(); ok {
+		_spec.SetField(product.FieldHalal, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.Vegetarian(); ok {
+		_spec.SetField(product.FieldVegetarian, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.Vegan(); ok {
+		_spec.SetField(product.FieldVegan, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.SpicyLevel(); ok {
+		_spec.SetField(product.FieldSpicyLevel, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedSpicyLevel(); ok {
+		_spec.AddField(product.FieldSpicyLevel, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.Nutrition(); ok {
+		_spec.SetField(product.FieldNutrition, field.TypeJSON, value)
+	}
+	if pu.mutation.NutritionCleared() {
+		_spec.ClearField(product.FieldNutrition, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.AttrLimits(); ok {
+		_spec.SetField(product.FieldAttrLimits, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedAttrLimits(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, product.FieldAttrLimits, value)
+		})
+	}
+	if pu.mutation.AttrLimitsCleared() {
+		_spec.ClearField(product.FieldAttrLimits, field.TypeJSON)
+	}
 	if value, ok := pu.mutation.EstimatedCostPrice(); ok {
 		_spec.SetField(product.FieldEstimatedCostPrice, field.TypeOther, value)
 	}
@@ -1489,6 +1723,34 @@ func (puo *ProductUpdateOne) SetNillableMnemonic(s *string) *ProductUpdateOne {
 	return puo
 }
 
+// SetPinyin sets the "pinyin" field.
+func (puo *ProductUpdateOne) SetPinyin(s string) *ProductUpdateOne {
+	puo.mutation.SetPinyin(s)
+	return puo
+}
+
+// SetNillablePinyin sets the "pinyin" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillablePinyin(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetPinyin(*s)
+	}
+	return puo
+}
+
+// SetPinyinInitials sets the "pinyin_initials" field.
+func (puo *ProductUpdateOne) SetPinyinInitials(s string) *ProductUpdateOne {
+	puo.mutation.SetPinyinInitials(s)
+	return puo
+}
+
+// SetNillablePinyinInitials sets the "pinyin_initials" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillablePinyinInitials(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetPinyinInitials(*s)
+	}
+	return puo
+}
+
 // SetShelfLife sets the "shelf_life" field.
 func (puo *ProductUpdateOne) SetShelfLife(i int) *ProductUpdateOne {
 	puo.mutation.ResetShelfLife()
@@ -1790,6 +2052,141 @@ func (puo *ProductUpdateOne) SetNillableDescription(s *string) *ProductUpdateOne
 	return puo
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (puo *ProductUpdateOne) SetNameI18n(dt domain.LocalizedText) *ProductUpdateOne {
+	puo.mutation.SetNameI18n(dt)
+	return puo
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (puo *ProductUpdateOne) ClearNameI18n() *ProductUpdateOne {
+	puo.mutation.ClearNameI18n()
+	return puo
+}
+
+// SetDescriptionI18n sets the "description_i18n" field.
+func (puo *ProductUpdateOne) SetDescriptionI18n(dt domain.LocalizedText) *ProductUpdateOne {
+	puo.mutation.SetDescriptionI18n(dt)
+	return puo
+}
+
+// ClearDescriptionI18n clears the value of the "description_i18n" field.
+func (puo *ProductUpdateOne) ClearDescriptionI18n() *ProductUpdateOne {
+	puo.mutation.ClearDescriptionI18n()
+	return puo
+}
+
+// SetAllergens sets the "allergens" field.
+func (puo *ProductUpdateOne) SetAllergens(d []domain.Allergen) *ProductUpdateOne {
+	puo.mutation.SetAllergens(d)
+	return puo
+}
+
+// AppendAllergens appends d to the "allergens" field.
+func (puo *ProductUpdateOne) AppendAllergens(d []domain.Allergen) *ProductUpdateOne {
+	puo.mutation.AppendAllergens(d)
+	return puo
+}
+
+// ClearAllergens clears the value of the "allergens" field.
+func (puo *ProductUpdateOne) ClearAllergens() *ProductUpdateOne {
+	puo.mutation.ClearAllergens()
+	return puo
+}
+
+// SetHalal sets the "halal" field.
+func (puo *ProductUpdateOne) SetHalal(b bool) *ProductUpdateOne {
+	puo.mutation.SetHalal(b)
+	return puo
+}
+
+// SetNillableHalal sets the "halal" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableHalal(b *bool) *ProductUpdateOne {
+	if b != nil {
+		puo.SetHalal(*b)
+	}
+	return puo
+}
+
+// SetVegetarian sets the "vegetarian" field.
+func (puo *ProductUpdateOne) SetVegetarian(b bool) *ProductUpdateOne {
+	puo.mutation.SetVegetarian(b)
+	return puo
+}
+
+// SetNillableVegetarian sets the "vegetarian" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableVegetarian(b *bool) *ProductUpdateOne {
+	if b != nil {
+		puo.SetVegetarian(*b)
+	}
+	return puo
+}
+
+// SetVegan sets the "vegan" field.
+func (puo *ProductUpdateOne) SetVegan(b bool) *ProductUpdateOne {
+	puo.mutation.SetVegan(b)
+	return puo
+}
+
+// SetNillableVegan sets the "vegan" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableVegan(b *bool) *ProductUpdateOne {
+	if b != nil {
+		puo.SetVegan(*b)
+	}
+	return puo
+}
+
+// SetSpicyLevel sets the "spicy_level" field.
+func (puo *ProductUpdateOne) SetSpicyLevel(dl domain.SpicyLevel) *ProductUpdateOne {
+	puo.mutation.ResetSpicyLevel()
+	puo.mutation.SetSpicyLevel(dl)
+	return puo
+}
+
+// SetNillableSpicyLevel sets the "spicy_level" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableSpicyLevel(dl *domain.SpicyLevel) *ProductUpdateOne {
+	if dl != nil {
+		puo.SetSpicyLevel(*dl)
+	}
+	return puo
+}
+
+// AddSpicyLevel adds dl to the "spicy_level" field.
+func (puo *ProductUpdateOne) AddSpicyLevel(dl domain.SpicyLevel) *ProductUpdateOne {
+	puo.mutation.AddSpicyLevel(dl)
+	return puo
+}
+
+// SetNutrition sets the "nutrition" field.
+func (puo *ProductUpdateOne) SetNutrition(dn *domain.ProductNutrition) *ProductUpdateOne {
+	puo.mutation.SetNutrition(dn)
+	return puo
+}
+
+// ClearNutrition clears the value of the "nutrition" field.
+func (puo *ProductUpdateOne) ClearNutrition() *ProductUpdateOne {
+	puo.mutation.ClearNutrition()
+	return puo
+}
+
+// SetAttrLimits sets the "attr_limits" field.
+func (puo *ProductUpdateOne) SetAttrLimits(dal domain.ProductAttrLimits) *ProductUpdateOne {
+	puo.mutation.SetAttrLimits(dal)
+	return puo
+}
+
+// AppendAttrLimits appends dal to the "attr_limits" field.
+func (puo *ProductUpdateOne) AppendAttrLimits(dal domain.ProductAttrLimits) *ProductUpdateOne {
+	puo.mutation.AppendAttrLimits(dal)
+	return puo
+}
+
+// ClearAttrLimits clears the value of the "attr_limits" field.
+func (puo *ProductUpdateOne) ClearAttrLimits() *ProductUpdateOne {
+	puo.mutation.ClearAttrLimits()
+	return puo
+}
+
 // SetEstimatedCostPrice sets the "estimated_cost_price" field.
 func (puo *ProductUpdateOne) SetEstimatedCostPrice(d decimal.Decimal) *ProductUpdateOne {
 	puo.mutation.SetEstimatedCostPrice(d)
@@ -2167,6 +2564,16 @@ func (puo *ProductUpdateOne) check() error {
 			return &ValidationError{Name: "mnemonic", err: fmt.Errorf(`ent: validator failed for field "Product.mnemonic": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.Pinyin(); ok {
+		if err := product.PinyinValidator(v); err != nil {
+			return &ValidationError{Name: "pinyin", err: fmt.Errorf(`ent: validator failed for field "Product.pinyin": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.PinyinInitials(); ok {
+		if err := product.PinyinInitialsValidator(v); err != nil {
+			return &ValidationError{Name: "pinyin_initials", err: fmt.Errorf(`ent: validator failed for field "Product.pinyin_initials": %w`, err)}
+		}
+	}
 	if v, ok := puo.mutation.SaleStatus(); ok {
 		if err := product.SaleStatusValidator(v); err != nil {
 			return &ValidationError{Name: "sale_status", err: fmt.Errorf(`ent: validator failed for field "Product.sale_status": %w`, err)}
@@ -2255,6 +2662,12 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	if value, ok := puo.mutation.Mnemonic(); ok {
 		_spec.SetField(product.FieldMnemonic, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.Pinyin(); ok {
+		_spec.SetField(product.FieldPinyin, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.PinyinInitials(); ok {
+		_spec.SetField(product.FieldPinyinInitials, field.TypeString, value)
+	}
 	if value, ok := puo.mutation.ShelfLife(); ok {
 		_spec.SetField(product.FieldShelfLife, field.TypeInt, value)
 	}
@@ -2339,6 +2752,61 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	if value, ok := puo.mutation.Description(); ok {
 		_spec.SetField(product.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.NameI18n(); ok {
+		_spec.SetField(product.FieldNameI18n, field.TypeJSON, value)
+	}
+	if puo.mutation.NameI18nCleared() {
+		_spec.ClearField(product.FieldNameI18n, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.DescriptionI18n(); ok {
+		_spec.SetField(product.FieldDescriptionI18n, field.TypeJSON, value)
+	}
+	if puo.mutation.DescriptionI18nCleared() {
+		_spec.ClearField(product.FieldDescriptionI18n, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.Allergens(); ok {
+		_spec.SetField(product.FieldAllergens, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedAllergens(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, product.FieldAllergens, value)
+		})
+	}
+	if puo.mutation.AllergensCleared() {
+		_spec.ClearField(product.FieldAllergens, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.Halal(); ok {
+		_spec.SetField(product.FieldHalal, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.Vegetarian(); ok {
+		_spec.SetField(product.FieldVegetarian, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.Vegan(); ok {
+		_spec.SetField(product.FieldVegan, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.SpicyLevel(); ok {
+		_spec.SetField(product.FieldSpicyLevel, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedSpicyLevel(); ok {
+		_spec.AddField(product.FieldSpicyLevel, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.Nutrition(); ok {
+		_spec.SetField(product.FieldNutrition, field.TypeJSON, value)
+	}
+	if puo.mutation.NutritionCleared() {
+		_spec.ClearField(product.FieldNutrition, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.AttrLimits(); ok {
+		_spec.SetField(product.FieldAttrLimits, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedAttrLimits(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, product.FieldAttrLimits, value)
+		})
+	}
+	if puo.mutation.AttrLimitsCleared() {
+		_spec.ClearField(product.FieldAttrLimits, field.TypeJSON)
+	}
 	if value, ok := puo.mutation.EstimatedCostPrice(); ok {
 		_spec.SetField(product.FieldEstimatedCostPrice, field.TypeOther, value)
 	}
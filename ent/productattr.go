@@ -29,6 +29,8 @@ type ProductAttr struct {
 	DeletedAt int64 `json:"deleted_at,omitempty"`
 	// 口味做法名称
 	Name string `json:"name,omitempty"`
+	// 口味做法名称翻译（按语言）
+	NameI18n domain.LocalizedText `json:"name_i18n,omitempty"`
 	// 售卖渠道列表
 	Channels []domain.SaleChannel `json:"channels,omitempty"`
 	// 品牌商ID
@@ -77,7 +79,7 @@ func (*ProductAttr) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case productattr.FieldChannels:
+		case productattr.FieldNameI18n, productattr.FieldChannels:
 			values[i] = new([]byte)
 		case productattr.FieldDeletedAt, productattr.FieldProductCount:
 			values[i] = new(sql.NullInt64)
@@ -132,6 +134,14 @@ func (pa *ProductAttr) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pa.Name = value.String
 			}
+		case productattr.FieldNameI18n:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field name_i18n", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pa.NameI18n); err != nil {
+					return fmt.Errorf("unmarshal field name_i18n: %w", err)
+				}
+			}
 		case productattr.FieldChannels:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field channels", values[i])
@@ -216,6 +226,9 @@ func (pa *ProductAttr) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(pa.Name)
 	builder.WriteString(", ")
+	builder.WriteString("name_i18n=")
+	builder.WriteString(fmt.Sprintf("%v", pa.NameI18n))
+	builder.WriteString(", ")
 	builder.WriteString("channels=")
 	builder.WriteString(fmt.Sprintf("%v", pa.Channels))
 	builder.WriteString(", ")
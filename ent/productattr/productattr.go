@@ -24,6 +24,8 @@ const (
 	FieldDeletedAt = "deleted_at"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldNameI18n holds the string denoting the name_i18n field in the database.
+	FieldNameI18n = "name_i18n"
 	// FieldChannels holds the string denoting the channels field in the database.
 	FieldChannels = "channels"
 	// FieldMerchantID holds the string denoting the merchant_id field in the database.
@@ -61,6 +63,7 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldDeletedAt,
 	FieldName,
+	FieldNameI18n,
 	FieldChannels,
 	FieldMerchantID,
 	FieldStoreID,
@@ -276,6 +276,16 @@ func NameContainsFold(v string) predicate.ProductAttr {
 	return predicate.ProductAttr(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameI18nIsNil applies the IsNil predicate on the "name_i18n" field.
+func NameI18nIsNil() predicate.ProductAttr {
+	return predicate.ProductAttr(sql.FieldIsNull(FieldNameI18n))
+}
+
+// NameI18nNotNil applies the NotNil predicate on the "name_i18n" field.
+func NameI18nNotNil() predicate.ProductAttr {
+	return predicate.ProductAttr(sql.FieldNotNull(FieldNameI18n))
+}
+
 // MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
 func MerchantIDEQ(v uuid.UUID) predicate.ProductAttr {
 	return predicate.ProductAttr(sql.FieldEQ(FieldMerchantID, v))
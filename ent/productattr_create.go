@@ -75,6 +75,12 @@ func (pac *ProductAttrCreate) SetName(s string) *ProductAttrCreate {
 	return pac
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (pac *ProductAttrCreate) SetNameI18n(dt domain.LocalizedText) *ProductAttrCreate {
+	pac.mutation.SetNameI18n(dt)
+	return pac
+}
+
 // SetChannels sets the "channels" field.
 func (pac *ProductAttrCreate) SetChannels(dc []domain.SaleChannel) *ProductAttrCreate {
 	pac.mutation.SetChannels(dc)
@@ -318,6 +324,10 @@ func (pac *ProductAttrCreate) createSpec() (*ProductAttr, *sqlgraph.CreateSpec)
 		_spec.SetField(productattr.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := pac.mutation.NameI18n(); ok {
+		_spec.SetField(productattr.FieldNameI18n, field.TypeJSON, value)
+		_node.NameI18n = value
+	}
 	if value, ok := pac.mutation.Channels(); ok {
 		_spec.SetField(productattr.FieldChannels, field.TypeJSON, value)
 		_node.Channels = value
@@ -460,6 +470,24 @@ func (u *ProductAttrUpsert) UpdateName() *ProductAttrUpsert {
 	return u
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *ProductAttrUpsert) SetNameI18n(v domain.LocalizedText) *ProductAttrUpsert {
+	u.Set(productattr.FieldNameI18n, v)
+	return u
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *ProductAttrUpsert) UpdateNameI18n() *ProductAttrUpsert {
+	u.SetExcluded(productattr.FieldNameI18n)
+	return u
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *ProductAttrUpsert) ClearNameI18n() *ProductAttrUpsert {
+	u.SetNull(productattr.FieldNameI18n)
+	return u
+}
+
 // SetChannels sets the "channels" field.
 func (u *ProductAttrUpsert) SetChannels(v []domain.SaleChannel) *ProductAttrUpsert {
 	u.Set(productattr.FieldChannels, v)
@@ -596,6 +624,27 @@ func (u *ProductAttrUpsertOne) UpdateName() *ProductAttrUpsertOne {
 	})
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *ProductAttrUpsertOne) SetNameI18n(v domain.LocalizedText) *ProductAttrUpsertOne {
+	return u.Update(func(s *ProductAttrUpsert) {
+		s.SetNameI18n(v)
+	})
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *ProductAttrUpsertOne) UpdateNameI18n() *ProductAttrUpsertOne {
+	return u.Update(func(s *ProductAttrUpsert) {
+		s.UpdateNameI18n()
+	})
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *ProductAttrUpsertOne) ClearNameI18n() *ProductAttrUpsertOne {
+	return u.Update(func(s *ProductAttrUpsert) {
+		s.ClearNameI18n()
+	})
+}
+
 // SetChannels sets the "channels" field.
 func (u *ProductAttrUpsertOne) SetChannels(v []domain.SaleChannel) *ProductAttrUpsertOne {
 	return u.Update(func(s *ProductAttrUpsert) {
@@ -904,6 +953,27 @@ func (u *ProductAttrUpsertBulk) UpdateName() *ProductAttrUpsertBulk {
 	})
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *ProductAttrUpsertBulk) SetNameI18n(v domain.LocalizedText) *ProductAttrUpsertBulk {
+	return u.Update(func(s *ProductAttrUpsert) {
+		s.SetNameI18n(v)
+	})
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *ProductAttrUpsertBulk) UpdateNameI18n() *ProductAttrUpsertBulk {
+	return u.Update(func(s *ProductAttrUpsert) {
+		s.UpdateNameI18n()
+	})
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *ProductAttrUpsertBulk) ClearNameI18n() *ProductAttrUpsertBulk {
+	return u.Update(func(s *ProductAttrUpsert) {
+		s.ClearNameI18n()
+	})
+}
+
 // SetChannels sets the "channels" field.
 func (u *ProductAttrUpsertBulk) SetChannels(v []domain.SaleChannel) *ProductAttrUpsertBulk {
 	return u.Update(func(s *ProductAttrUpsert) {
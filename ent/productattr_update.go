@@ -75,6 +75,18 @@ func (pau *ProductAttrUpdate) SetNillableName(s *string) *ProductAttrUpdate {
 	return pau
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (pau *ProductAttrUpdate) SetNameI18n(dt domain.LocalizedText) *ProductAttrUpdate {
+	pau.mutation.SetNameI18n(dt)
+	return pau
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (pau *ProductAttrUpdate) ClearNameI18n() *ProductAttrUpdate {
+	pau.mutation.ClearNameI18n()
+	return pau
+}
+
 // SetChannels sets the "channels" field.
 func (pau *ProductAttrUpdate) SetChannels(dc []domain.SaleChannel) *ProductAttrUpdate {
 	pau.mutation.SetChannels(dc)
@@ -267,6 +279,12 @@ func (pau *ProductAttrUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pau.mutation.Name(); ok {
 		_spec.SetField(productattr.FieldName, field.TypeString, value)
 	}
+	if value, ok := pau.mutation.NameI18n(); ok {
+		_spec.SetField(productattr.FieldNameI18n, field.TypeJSON, value)
+	}
+	if pau.mutation.NameI18nCleared() {
+		_spec.ClearField(productattr.FieldNameI18n, field.TypeJSON)
+	}
 	if value, ok := pau.mutation.Channels(); ok {
 		_spec.SetField(productattr.FieldChannels, field.TypeJSON, value)
 	}
@@ -434,6 +452,18 @@ func (pauo *ProductAttrUpdateOne) SetNillableName(s *string) *ProductAttrUpdateO
 	return pauo
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (pauo *ProductAttrUpdateOne) SetNameI18n(dt domain.LocalizedText) *ProductAttrUpdateOne {
+	pauo.mutation.SetNameI18n(dt)
+	return pauo
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (pauo *ProductAttrUpdateOne) ClearNameI18n() *ProductAttrUpdateOne {
+	pauo.mutation.ClearNameI18n()
+	return pauo
+}
+
 // SetChannels sets the "channels" field.
 func (pauo *ProductAttrUpdateOne) SetChannels(dc []domain.SaleChannel) *ProductAttrUpdateOne {
 	pauo.mutation.SetChannels(dc)
@@ -656,6 +686,12 @@ func (pauo *ProductAttrUpdateOne) sqlSave(ctx context.Context) (_node *ProductAt
 	if value, ok := pauo.mutation.Name(); ok {
 		_spec.SetField(productattr.FieldName, field.TypeString, value)
 	}
+	if value, ok := pauo.mutation.NameI18n(); ok {
+		_spec.SetField(productattr.FieldNameI18n, field.TypeJSON, value)
+	}
+	if pauo.mutation.NameI18nCleared() {
+		_spec.ClearField(productattr.FieldNameI18n, field.TypeJSON)
+	}
 	if value, ok := pauo.mutation.Channels(); ok {
 		_spec.SetField(productattr.FieldChannels, field.TypeJSON, value)
 	}
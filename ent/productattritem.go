@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattr"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattritem"
 )
@@ -31,6 +33,8 @@ type ProductAttrItem struct {
 	AttrID uuid.UUID `json:"attr_id,omitempty"`
 	// 口味做法项名称
 	Name string `json:"name,omitempty"`
+	// 口味做法项名称翻译（按语言）
+	NameI18n domain.LocalizedText `json:"name_i18n,omitempty"`
 	// 图片URL（可选）
 	Image string `json:"image,omitempty"`
 	// 基础加价（单位：分）
@@ -79,6 +83,8 @@ func (*ProductAttrItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case productattritem.FieldNameI18n:
+			values[i] = new([]byte)
 		case productattritem.FieldBasePrice:
 			values[i] = new(decimal.Decimal)
 		case productattritem.FieldDeletedAt, productattritem.FieldProductCount:
@@ -140,6 +146,14 @@ func (pai *ProductAttrItem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pai.Name = value.String
 			}
+		case productattritem.FieldNameI18n:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field name_i18n", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pai.NameI18n); err != nil {
+					return fmt.Errorf("unmarshal field name_i18n: %w", err)
+				}
+			}
 		case productattritem.FieldImage:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field image", values[i])
@@ -219,6 +233,9 @@ func (pai *ProductAttrItem) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(pai.Name)
 	builder.WriteString(", ")
+	builder.WriteString("name_i18n=")
+	builder.WriteString(fmt.Sprintf("%v", pai.NameI18n))
+	builder.WriteString(", ")
 	builder.WriteString("image=")
 	builder.WriteString(pai.Image)
 	builder.WriteString(", ")
@@ -27,6 +27,8 @@ const (
 	FieldAttrID = "attr_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldNameI18n holds the string denoting the name_i18n field in the database.
+	FieldNameI18n = "name_i18n"
 	// FieldImage holds the string denoting the image field in the database.
 	FieldImage = "image"
 	// FieldBasePrice holds the string denoting the base_price field in the database.
@@ -63,6 +65,7 @@ var Columns = []string{
 	FieldDeletedAt,
 	FieldAttrID,
 	FieldName,
+	FieldNameI18n,
 	FieldImage,
 	FieldBasePrice,
 	FieldProductCount,
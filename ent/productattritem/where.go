@@ -302,6 +302,16 @@ func NameContainsFold(v string) predicate.ProductAttrItem {
 	return predicate.ProductAttrItem(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameI18nIsNil applies the IsNil predicate on the "name_i18n" field.
+func NameI18nIsNil() predicate.ProductAttrItem {
+	return predicate.ProductAttrItem(sql.FieldIsNull(FieldNameI18n))
+}
+
+// NameI18nNotNil applies the NotNil predicate on the "name_i18n" field.
+func NameI18nNotNil() predicate.ProductAttrItem {
+	return predicate.ProductAttrItem(sql.FieldNotNull(FieldNameI18n))
+}
+
 // ImageEQ applies the EQ predicate on the "image" field.
 func ImageEQ(v string) predicate.ProductAttrItem {
 	return predicate.ProductAttrItem(sql.FieldEQ(FieldImage, v))
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattr"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattritem"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattrrelation"
@@ -81,6 +82,12 @@ func (paic *ProductAttrItemCreate) SetName(s string) *ProductAttrItemCreate {
 	return paic
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (paic *ProductAttrItemCreate) SetNameI18n(dt domain.LocalizedText) *ProductAttrItemCreate {
+	paic.mutation.SetNameI18n(dt)
+	return paic
+}
+
 // SetImage sets the "image" field.
 func (paic *ProductAttrItemCreate) SetImage(s string) *ProductAttrItemCreate {
 	paic.mutation.SetImage(s)
@@ -325,6 +332,10 @@ func (paic *ProductAttrItemCreate) createSpec() (*ProductAttrItem, *sqlgraph.Cre
 		_spec.SetField(productattritem.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := paic.mutation.NameI18n(); ok {
+		_spec.SetField(productattritem.FieldNameI18n, field.TypeJSON, value)
+		_node.NameI18n = value
+	}
 	if value, ok := paic.mutation.Image(); ok {
 		_spec.SetField(productattritem.FieldImage, field.TypeString, value)
 		_node.Image = value
@@ -464,6 +475,24 @@ func (u *ProductAttrItemUpsert) UpdateName() *ProductAttrItemUpsert {
 	return u
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *ProductAttrItemUpsert) SetNameI18n(v domain.LocalizedText) *ProductAttrItemUpsert {
+	u.Set(productattritem.FieldNameI18n, v)
+	return u
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *ProductAttrItemUpsert) UpdateNameI18n() *ProductAttrItemUpsert {
+	u.SetExcluded(productattritem.FieldNameI18n)
+	return u
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *ProductAttrItemUpsert) ClearNameI18n() *ProductAttrItemUpsert {
+	u.SetNull(productattritem.FieldNameI18n)
+	return u
+}
+
 // SetImage sets the "image" field.
 func (u *ProductAttrItemUpsert) SetImage(v string) *ProductAttrItemUpsert {
 	u.Set(productattritem.FieldImage, v)
@@ -609,6 +638,27 @@ func (u *ProductAttrItemUpsertOne) UpdateName() *ProductAttrItemUpsertOne {
 	})
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *ProductAttrItemUpsertOne) SetNameI18n(v domain.LocalizedText) *ProductAttrItemUpsertOne {
+	return u.Update(func(s *ProductAttrItemUpsert) {
+		s.SetNameI18n(v)
+	})
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *ProductAttrItemUpsertOne) UpdateNameI18n() *ProductAttrItemUpsertOne {
+	return u.Update(func(s *ProductAttrItemUpsert) {
+		s.UpdateNameI18n()
+	})
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *ProductAttrItemUpsertOne) ClearNameI18n() *ProductAttrItemUpsertOne {
+	return u.Update(func(s *ProductAttrItemUpsert) {
+		s.ClearNameI18n()
+	})
+}
+
 // SetImage sets the "image" field.
 func (u *ProductAttrItemUpsertOne) SetImage(v string) *ProductAttrItemUpsertOne {
 	return u.Update(func(s *ProductAttrItemUpsert) {
@@ -928,6 +978,27 @@ func (u *ProductAttrItemUpsertBulk) UpdateName() *ProductAttrItemUpsertBulk {
 	})
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *ProductAttrItemUpsertBulk) SetNameI18n(v domain.LocalizedText) *ProductAttrItemUpsertBulk {
+	return u.Update(func(s *ProductAttrItemUpsert) {
+		s.SetNameI18n(v)
+	})
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *ProductAttrItemUpsertBulk) UpdateNameI18n() *ProductAttrItemUpsertBulk {
+	return u.Update(func(s *ProductAttrItemUpsert) {
+		s.UpdateNameI18n()
+	})
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *ProductAttrItemUpsertBulk) ClearNameI18n() *ProductAttrItemUpsertBulk {
+	return u.Update(func(s *ProductAttrItemUpsert) {
+		s.ClearNameI18n()
+	})
+}
+
 // SetImage sets the "image" field.
 func (u *ProductAttrItemUpsertBulk) SetImage(v string) *ProductAttrItemUpsertBulk {
 	return u.Update(func(s *ProductAttrItemUpsert) {
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattritem"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattrrelation"
@@ -73,6 +74,18 @@ func (paiu *ProductAttrItemUpdate) SetNillableName(s *string) *ProductAttrItemUp
 	return paiu
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (paiu *ProductAttrItemUpdate) SetNameI18n(dt domain.LocalizedText) *ProductAttrItemUpdate {
+	paiu.mutation.SetNameI18n(dt)
+	return paiu
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (paiu *ProductAttrItemUpdate) ClearNameI18n() *ProductAttrItemUpdate {
+	paiu.mutation.ClearNameI18n()
+	return paiu
+}
+
 // SetImage sets the "image" field.
 func (paiu *ProductAttrItemUpdate) SetImage(s string) *ProductAttrItemUpdate {
 	paiu.mutation.SetImage(s)
@@ -253,6 +266,12 @@ func (paiu *ProductAttrItemUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if value, ok := paiu.mutation.Name(); ok {
 		_spec.SetField(productattritem.FieldName, field.TypeString, value)
 	}
+	if value, ok := paiu.mutation.NameI18n(); ok {
+		_spec.SetField(productattritem.FieldNameI18n, field.TypeJSON, value)
+	}
+	if paiu.mutation.NameI18nCleared() {
+		_spec.ClearField(productattritem.FieldNameI18n, field.TypeJSON)
+	}
 	if value, ok := paiu.mutation.Image(); ok {
 		_spec.SetField(productattritem.FieldImage, field.TypeString, value)
 	}
@@ -373,6 +392,18 @@ func (paiuo *ProductAttrItemUpdateOne) SetNillableName(s *string) *ProductAttrIt
 	return paiuo
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (paiuo *ProductAttrItemUpdateOne) SetNameI18n(dt domain.LocalizedText) *ProductAttrItemUpdateOne {
+	paiuo.mutation.SetNameI18n(dt)
+	return paiuo
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (paiuo *ProductAttrItemUpdateOne) ClearNameI18n() *ProductAttrItemUpdateOne {
+	paiuo.mutation.ClearNameI18n()
+	return paiuo
+}
+
 // SetImage sets the "image" field.
 func (paiuo *ProductAttrItemUpdateOne) SetImage(s string) *ProductAttrItemUpdateOne {
 	paiuo.mutation.SetImage(s)
@@ -583,6 +614,12 @@ func (paiuo *ProductAttrItemUpdateOne) sqlSave(ctx context.Context) (_node *Prod
 	if value, ok := paiuo.mutation.Name(); ok {
 		_spec.SetField(productattritem.FieldName, field.TypeString, value)
 	}
+	if value, ok := paiuo.mutation.NameI18n(); ok {
+		_spec.SetField(productattritem.FieldNameI18n, field.TypeJSON, value)
+	}
+	if paiuo.mutation.NameI18nCleared() {
+		_spec.ClearField(productattritem.FieldNameI18n, field.TypeJSON)
+	}
 	if value, ok := paiuo.mutation.Image(); ok {
 		_spec.SetField(productattritem.FieldImage, field.TypeString, value)
 	}
@@ -36,6 +36,8 @@ type ProductAttrRelation struct {
 	AttrItemID uuid.UUID `json:"attr_item_id,omitempty"`
 	// 是否默认项（当点单限制为必选一项时，必须设置其中一个为默认项）
 	IsDefault bool `json:"is_default,omitempty"`
+	// 单项最多可选数量（如加芝士 x2，默认 1）
+	MaxQuantity int `json:"max_quantity,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProductAttrRelationQuery when eager-loading is set.
 	Edges        ProductAttrRelationEdges `json:"edges"`
@@ -95,7 +97,7 @@ func (*ProductAttrRelation) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case productattrrelation.FieldIsDefault:
 			values[i] = new(sql.NullBool)
-		case productattrrelation.FieldDeletedAt:
+		case productattrrelation.FieldDeletedAt, productattrrelation.FieldMaxQuantity:
 			values[i] = new(sql.NullInt64)
 		case productattrrelation.FieldCreatedAt, productattrrelation.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -164,6 +166,12 @@ func (par *ProductAttrRelation) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				par.IsDefault = value.Bool
 			}
+		case productattrrelation.FieldMaxQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_quantity", values[i])
+			} else if value.Valid {
+				par.MaxQuantity = int(value.Int64)
+			}
 		default:
 			par.selectValues.Set(columns[i], values[i])
 		}
@@ -235,6 +243,9 @@ func (par *ProductAttrRelation) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_default=")
 	builder.WriteString(fmt.Sprintf("%v", par.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("max_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", par.MaxQuantity))
 	builder.WriteByte(')')
 	return builder.String()
 }
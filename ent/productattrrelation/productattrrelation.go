@@ -30,6 +30,8 @@ const (
 	FieldAttrItemID = "attr_item_id"
 	// FieldIsDefault holds the string denoting the is_default field in the database.
 	FieldIsDefault = "is_default"
+	// FieldMaxQuantity holds the string denoting the max_quantity field in the database.
+	FieldMaxQuantity = "max_quantity"
 	// EdgeProduct holds the string denoting the product edge name in mutations.
 	EdgeProduct = "product"
 	// EdgeAttr holds the string denoting the attr edge name in mutations.
@@ -71,6 +73,7 @@ var Columns = []string{
 	FieldAttrID,
 	FieldAttrItemID,
 	FieldIsDefault,
+	FieldMaxQuantity,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -101,6 +104,8 @@ var (
 	DefaultDeletedAt int64
 	// DefaultIsDefault holds the default value on creation for the "is_default" field.
 	DefaultIsDefault bool
+	// DefaultMaxQuantity holds the default value on creation for the "max_quantity" field.
+	DefaultMaxQuantity int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -148,6 +153,11 @@ func ByIsDefault(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsDefault, opts...).ToFunc()
 }
 
+// ByMaxQuantity orders the results by the max_quantity field.
+func ByMaxQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxQuantity, opts...).ToFunc()
+}
+
 // ByProductField orders the results by product field.
 func ByProductField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -91,6 +91,11 @@ func IsDefault(v bool) predicate.ProductAttrRelation {
 	return predicate.ProductAttrRelation(sql.FieldEQ(FieldIsDefault, v))
 }
 
+// MaxQuantity applies equality check predicate on the "max_quantity" field. It's identical to MaxQuantityEQ.
+func MaxQuantity(v int) predicate.ProductAttrRelation {
+	return predicate.ProductAttrRelation(sql.FieldEQ(FieldMaxQuantity, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ProductAttrRelation {
 	return predicate.ProductAttrRelation(sql.FieldEQ(FieldCreatedAt, v))
@@ -281,6 +286,46 @@ func IsDefaultNEQ(v bool) predicate.ProductAttrRelation {
 	return predicate.ProductAttrRelation(sql.FieldNEQ(FieldIsDefault, v))
 }
 
+// MaxQuantityEQ applies the EQ predicate on the "max_quantity" field.
+func MaxQuantityEQ(v int) predicate.ProductAttrRelation {
+	return predicate.ProductAttrRelation(sql.FieldEQ(FieldMaxQuantity, v))
+}
+
+// MaxQuantityNEQ applies the NEQ predicate on the "max_quantity" field.
+func MaxQuantityNEQ(v int) predicate.ProductAttrRelation {
+	return predicate.ProductAttrRelation(sql.FieldNEQ(FieldMaxQuantity, v))
+}
+
+// MaxQuantityIn applies the In predicate on the "max_quantity" field.
+func MaxQuantityIn(vs ...int) predicate.ProductAttrRelation {
+	return predicate.ProductAttrRelation(sql.FieldIn(FieldMaxQuantity, vs...))
+}
+
+// MaxQuantityNotIn applies the NotIn predicate on the "max_quantity" field.
+func MaxQuantityNotIn(vs ...int) predicate.ProductAttrRelation {
+	return predicate.ProductAttrRelation(sql.FieldNotIn(FieldMaxQuantity, vs...))
+}
+
+// MaxQuantityGT applies the GT predicate on the "max_quantity" field.
+func MaxQuantityGT(v int) predicate.ProductAttrRelation {
+	return predicate.ProductAttrRelation(sql.FieldGT(FieldMaxQuantity, v))
+}
+
+// MaxQuantityGTE applies the GTE predicate on the "max_quantity" field.
+func MaxQuantityGTE(v int) predicate.ProductAttrRelation {
+	return predicate.ProductAttrRelation(sql.FieldGTE(FieldMaxQuantity, v))
+}
+
+// MaxQuantityLT applies the LT predicate on the "max_quantity" field.
+func MaxQuantityLT(v int) predicate.ProductAttrRelation {
+	return predicate.ProductAttrRelation(sql.FieldLT(FieldMaxQuantity, v))
+}
+
+// MaxQuantityLTE applies the LTE predicate on the "max_quantity" field.
+func MaxQuantityLTE(v int) predicate.ProductAttrRelation {
+	return predicate.ProductAttrRelation(sql.FieldLTE(FieldMaxQuantity, v))
+}
+
 // HasProduct applies the HasEdge predicate on the "product" edge.
 func HasProduct() predicate.ProductAttrRelation {
 	return predicate.ProductAttrRelation(func(s *sql.Selector) {
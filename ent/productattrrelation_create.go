@@ -101,6 +101,20 @@ func (parc *ProductAttrRelationCreate) SetNillableIsDefault(b *bool) *ProductAtt
 	return parc
 }
 
+// SetMaxQuantity sets the "max_quantity" field.
+func (parc *ProductAttrRelationCreate) SetMaxQuantity(i int) *ProductAttrRelationCreate {
+	parc.mutation.SetMaxQuantity(i)
+	return parc
+}
+
+// SetNillableMaxQuantity sets the "max_quantity" field if the given value is not nil.
+func (parc *ProductAttrRelationCreate) SetNillableMaxQuantity(i *int) *ProductAttrRelationCreate {
+	if i != nil {
+		parc.SetMaxQuantity(*i)
+	}
+	return parc
+}
+
 // SetID sets the "id" field.
 func (parc *ProductAttrRelationCreate) SetID(u uuid.UUID) *ProductAttrRelationCreate {
 	parc.mutation.SetID(u)
@@ -189,6 +203,10 @@ func (parc *ProductAttrRelationCreate) defaults() error {
 		v := productattrrelation.DefaultIsDefault
 		parc.mutation.SetIsDefault(v)
 	}
+	if _, ok := parc.mutation.MaxQuantity(); !ok {
+		v := productattrrelation.DefaultMaxQuantity
+		parc.mutation.SetMaxQuantity(v)
+	}
 	if _, ok := parc.mutation.ID(); !ok {
 		if productattrrelation.DefaultID == nil {
 			return fmt.Errorf("ent: uninitialized productattrrelation.DefaultID (forgotten import ent/runtime?)")
@@ -222,6 +240,9 @@ func (parc *ProductAttrRelationCreate) check() error {
 	if _, ok := parc.mutation.IsDefault(); !ok {
 		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "ProductAttrRelation.is_default"`)}
 	}
+	if _, ok := parc.mutation.MaxQuantity(); !ok {
+		return &ValidationError{Name: "max_quantity", err: errors.New(`ent: missing required field "ProductAttrRelation.max_quantity"`)}
+	}
 	if len(parc.mutation.ProductIDs()) == 0 {
 		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "ProductAttrRelation.product"`)}
 	}
@@ -283,6 +304,10 @@ func (parc *ProductAttrRelationCreate) createSpec() (*ProductAttrRelation, *sqlg
 		_spec.SetField(productattrrelation.FieldIsDefault, field.TypeBool, value)
 		_node.IsDefault = value
 	}
+	if value, ok := parc.mutation.MaxQuantity(); ok {
+		_spec.SetField(productattrrelation.FieldMaxQuantity, field.TypeInt, value)
+		_node.MaxQuantity = value
+	}
 	if nodes := parc.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -428,6 +453,24 @@ func (u *ProductAttrRelationUpsert) UpdateIsDefault() *ProductAttrRelationUpsert
 	return u
 }
 
+// SetMaxQuantity sets the "max_quantity" field.
+func (u *ProductAttrRelationUpsert) SetMaxQuantity(v int) *ProductAttrRelationUpsert {
+	u.Set(productattrrelation.FieldMaxQuantity, v)
+	return u
+}
+
+// UpdateMaxQuantity sets the "max_quantity" field to the value that was provided on create.
+func (u *ProductAttrRelationUpsert) UpdateMaxQuantity() *ProductAttrRelationUpsert {
+	u.SetExcluded(productattrrelation.FieldMaxQuantity)
+	return u
+}
+
+// AddMaxQuantity adds v to the "max_quantity" field.
+func (u *ProductAttrRelationUpsert) AddMaxQuantity(v int) *ProductAttrRelationUpsert {
+	u.Add(productattrrelation.FieldMaxQuantity, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -537,6 +580,27 @@ func (u *ProductAttrRelationUpsertOne) UpdateIsDefault() *ProductAttrRelationUps
 	})
 }
 
+// SetMaxQuantity sets the "max_quantity" field.
+func (u *ProductAttrRelationUpsertOne) SetMaxQuantity(v int) *ProductAttrRelationUpsertOne {
+	return u.Update(func(s *ProductAttrRelationUpsert) {
+		s.SetMaxQuantity(v)
+	})
+}
+
+// AddMaxQuantity adds v to the "max_quantity" field.
+func (u *ProductAttrRelationUpsertOne) AddMaxQuantity(v int) *ProductAttrRelationUpsertOne {
+	return u.Update(func(s *ProductAttrRelationUpsert) {
+		s.AddMaxQuantity(v)
+	})
+}
+
+// UpdateMaxQuantity sets the "max_quantity" field to the value that was provided on create.
+func (u *ProductAttrRelationUpsertOne) UpdateMaxQuantity() *ProductAttrRelationUpsertOne {
+	return u.Update(func(s *ProductAttrRelationUpsert) {
+		s.UpdateMaxQuantity()
+	})
+}
+
 // Exec executes the query.
 func (u *ProductAttrRelationUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -813,6 +877,27 @@ func (u *ProductAttrRelationUpsertBulk) UpdateIsDefault() *ProductAttrRelationUp
 	})
 }
 
+// SetMaxQuantity sets the "max_quantity" field.
+func (u *ProductAttrRelationUpsertBulk) SetMaxQuantity(v int) *ProductAttrRelationUpsertBulk {
+	return u.Update(func(s *ProductAttrRelationUpsert) {
+		s.SetMaxQuantity(v)
+	})
+}
+
+// AddMaxQuantity adds v to the "max_quantity" field.
+func (u *ProductAttrRelationUpsertBulk) AddMaxQuantity(v int) *ProductAttrRelationUpsertBulk {
+	return u.Update(func(s *ProductAttrRelationUpsert) {
+		s.AddMaxQuantity(v)
+	})
+}
+
+// UpdateMaxQuantity sets the "max_quantity" field to the value that was provided on create.
+func (u *ProductAttrRelationUpsertBulk) UpdateMaxQuantity() *ProductAttrRelationUpsertBulk {
+	return u.Update(func(s *ProductAttrRelationUpsert) {
+		s.UpdateMaxQuantity()
+	})
+}
+
 // Exec executes the query.
 func (u *ProductAttrRelationUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
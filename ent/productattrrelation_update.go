@@ -70,6 +70,27 @@ func (paru *ProductAttrRelationUpdate) SetNillableIsDefault(b *bool) *ProductAtt
 	return paru
 }
 
+// SetMaxQuantity sets the "max_quantity" field.
+func (paru *ProductAttrRelationUpdate) SetMaxQuantity(i int) *ProductAttrRelationUpdate {
+	paru.mutation.ResetMaxQuantity()
+	paru.mutation.SetMaxQuantity(i)
+	return paru
+}
+
+// SetNillableMaxQuantity sets the "max_quantity" field if the given value is not nil.
+func (paru *ProductAttrRelationUpdate) SetNillableMaxQuantity(i *int) *ProductAttrRelationUpdate {
+	if i != nil {
+		paru.SetMaxQuantity(*i)
+	}
+	return paru
+}
+
+// AddMaxQuantity adds i to the "max_quantity" field.
+func (paru *ProductAttrRelationUpdate) AddMaxQuantity(i int) *ProductAttrRelationUpdate {
+	paru.mutation.AddMaxQuantity(i)
+	return paru
+}
+
 // Mutation returns the ProductAttrRelationMutation object of the builder.
 func (paru *ProductAttrRelationUpdate) Mutation() *ProductAttrRelationMutation {
 	return paru.mutation
@@ -161,6 +182,12 @@ func (paru *ProductAttrRelationUpdate) sqlSave(ctx context.Context) (n int, err
 	if value, ok := paru.mutation.IsDefault(); ok {
 		_spec.SetField(productattrrelation.FieldIsDefault, field.TypeBool, value)
 	}
+	if value, ok := paru.mutation.MaxQuantity(); ok {
+		_spec.SetField(productattrrelation.FieldMaxQuantity, field.TypeInt, value)
+	}
+	if value, ok := paru.mutation.AddedMaxQuantity(); ok {
+		_spec.AddField(productattrrelation.FieldMaxQuantity, field.TypeInt, value)
+	}
 	_spec.AddModifiers(paru.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, paru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -224,6 +251,27 @@ func (paruo *ProductAttrRelationUpdateOne) SetNillableIsDefault(b *bool) *Produc
 	return paruo
 }
 
+// SetMaxQuantity sets the "max_quantity" field.
+func (paruo *ProductAttrRelationUpdateOne) SetMaxQuantity(i int) *ProductAttrRelationUpdateOne {
+	paruo.mutation.ResetMaxQuantity()
+	paruo.mutation.SetMaxQuantity(i)
+	return paruo
+}
+
+// SetNillableMaxQuantity sets the "max_quantity" field if the given value is not nil.
+func (paruo *ProductAttrRelationUpdateOne) SetNillableMaxQuantity(i *int) *ProductAttrRelationUpdateOne {
+	if i != nil {
+		paruo.SetMaxQuantity(*i)
+	}
+	return paruo
+}
+
+// AddMaxQuantity adds i to the "max_quantity" field.
+func (paruo *ProductAttrRelationUpdateOne) AddMaxQuantity(i int) *ProductAttrRelationUpdateOne {
+	paruo.mutation.AddMaxQuantity(i)
+	return paruo
+}
+
 // Mutation returns the ProductAttrRelationMutation object of the builder.
 func (paruo *ProductAttrRelationUpdateOne) Mutation() *ProductAttrRelationMutation {
 	return paruo.mutation
@@ -345,6 +393,12 @@ func (paruo *ProductAttrRelationUpdateOne) sqlSave(ctx context.Context) (_node *
 	if value, ok := paruo.mutation.IsDefault(); ok {
 		_spec.SetField(productattrrelation.FieldIsDefault, field.TypeBool, value)
 	}
+	if value, ok := paruo.mutation.MaxQuantity(); ok {
+		_spec.SetField(productattrrelation.FieldMaxQuantity, field.TypeInt, value)
+	}
+	if value, ok := paruo.mutation.AddedMaxQuantity(); ok {
+		_spec.AddField(productattrrelation.FieldMaxQuantity, field.TypeInt, value)
+	}
 	_spec.AddModifiers(paruo.modifiers...)
 	_node = &ProductAttrRelation{config: paruo.config}
 	_spec.Assign = _node.assignValues
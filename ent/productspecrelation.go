@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/additionalfee"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productspec"
@@ -51,6 +53,8 @@ type ProductSpecRelation struct {
 	Barcode string `json:"barcode,omitempty"`
 	// 是否默认项（规格必须至少有一个默认项）
 	IsDefault bool `json:"is_default,omitempty"`
+	// 就餐时段价格（按门店就餐时段和星期覆盖基础价）
+	PeriodPrices domain.PeriodPrices `json:"period_prices,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProductSpecRelationQuery when eager-loading is set.
 	Edges        ProductSpecRelationEdges `json:"edges"`
@@ -112,6 +116,8 @@ func (*ProductSpecRelation) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case productspecrelation.FieldPackingFeeID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case productspecrelation.FieldPeriodPrices:
+			values[i] = new([]byte)
 		case productspecrelation.FieldBasePrice:
 			values[i] = new(decimal.Decimal)
 		case productspecrelation.FieldIsDefault:
@@ -235,6 +241,14 @@ func (psr *ProductSpecRelation) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				psr.IsDefault = value.Bool
 			}
+		case productspecrelation.FieldPeriodPrices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field period_prices", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &psr.PeriodPrices); err != nil {
+					return fmt.Errorf("unmarshal field period_prices: %w", err)
+				}
+			}
 		default:
 			psr.selectValues.Set(columns[i], values[i])
 		}
@@ -339,6 +353,9 @@ func (psr *ProductSpecRelation) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_default=")
 	builder.WriteString(fmt.Sprintf("%v", psr.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("period_prices=")
+	builder.WriteString(fmt.Sprintf("%v", psr.PeriodPrices))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -45,6 +45,8 @@ const (
 	FieldBarcode = "barcode"
 	// FieldIsDefault holds the string denoting the is_default field in the database.
 	FieldIsDefault = "is_default"
+	// FieldPeriodPrices holds the string denoting the period_prices field in the database.
+	FieldPeriodPrices = "period_prices"
 	// EdgeProduct holds the string denoting the product edge name in mutations.
 	EdgeProduct = "product"
 	// EdgeSpec holds the string denoting the spec edge name in mutations.
@@ -93,6 +95,7 @@ var Columns = []string{
 	FieldOtherPrice3,
 	FieldBarcode,
 	FieldIsDefault,
+	FieldPeriodPrices,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
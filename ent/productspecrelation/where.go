@@ -682,6 +682,16 @@ func IsDefaultNEQ(v bool) predicate.ProductSpecRelation {
 	return predicate.ProductSpecRelation(sql.FieldNEQ(FieldIsDefault, v))
 }
 
+// PeriodPricesIsNil applies the IsNil predicate on the "period_prices" field.
+func PeriodPricesIsNil() predicate.ProductSpecRelation {
+	return predicate.ProductSpecRelation(sql.FieldIsNull(FieldPeriodPrices))
+}
+
+// PeriodPricesNotNil applies the NotNil predicate on the "period_prices" field.
+func PeriodPricesNotNil() predicate.ProductSpecRelation {
+	return predicate.ProductSpecRelation(sql.FieldNotNull(FieldPeriodPrices))
+}
+
 // HasProduct applies the HasEdge predicate on the "product" edge.
 func HasProduct() predicate.ProductSpecRelation {
 	return predicate.ProductSpecRelation(func(s *sql.Selector) {
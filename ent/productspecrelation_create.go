@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/additionalfee"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productspec"
@@ -208,6 +209,12 @@ func (psrc *ProductSpecRelationCreate) SetNillableIsDefault(b *bool) *ProductSpe
 	return psrc
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (psrc *ProductSpecRelationCreate) SetPeriodPrices(dp domain.PeriodPrices) *ProductSpecRelationCreate {
+	psrc.mutation.SetPeriodPrices(dp)
+	return psrc
+}
+
 // SetID sets the "id" field.
 func (psrc *ProductSpecRelationCreate) SetID(u uuid.UUID) *ProductSpecRelationCreate {
 	psrc.mutation.SetID(u)
@@ -345,6 +352,11 @@ func (psrc *ProductSpecRelationCreate) check() error {
 	if _, ok := psrc.mutation.IsDefault(); !ok {
 		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "ProductSpecRelation.is_default"`)}
 	}
+	if v, ok := psrc.mutation.PeriodPrices(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "period_prices", err: fmt.Errorf(`ent: validator failed for field "ProductSpecRelation.period_prices": %w`, err)}
+		}
+	}
 	if len(psrc.mutation.ProductIDs()) == 0 {
 		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "ProductSpecRelation.product"`)}
 	}
@@ -431,6 +443,10 @@ func (psrc *ProductSpecRelationCreate) createSpec() (*ProductSpecRelation, *sqlg
 		_spec.SetField(productspecrelation.FieldIsDefault, field.TypeBool, value)
 		_node.IsDefault = value
 	}
+	if value, ok := psrc.mutation.PeriodPrices(); ok {
+		_spec.SetField(productspecrelation.FieldPeriodPrices, field.TypeJSON, value)
+		_node.PeriodPrices = value
+	}
 	if nodes := psrc.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -708,6 +724,24 @@ func (u *ProductSpecRelationUpsert) UpdateIsDefault() *ProductSpecRelationUpsert
 	return u
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (u *ProductSpecRelationUpsert) SetPeriodPrices(v domain.PeriodPrices) *ProductSpecRelationUpsert {
+	u.Set(productspecrelation.FieldPeriodPrices, v)
+	return u
+}
+
+// UpdatePeriodPrices sets the "period_prices" field to the value that was provided on create.
+func (u *ProductSpecRelationUpsert) UpdatePeriodPrices() *ProductSpecRelationUpsert {
+	u.SetExcluded(productspecrelation.FieldPeriodPrices)
+	return u
+}
+
+// ClearPeriodPrices clears the value of the "period_prices" field.
+func (u *ProductSpecRelationUpsert) ClearPeriodPrices() *ProductSpecRelationUpsert {
+	u.SetNull(productspecrelation.FieldPeriodPrices)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -968,6 +1002,27 @@ func (u *ProductSpecRelationUpsertOne) UpdateIsDefault() *ProductSpecRelationUps
 	})
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (u *ProductSpecRelationUpsertOne) SetPeriodPrices(v domain.PeriodPrices) *ProductSpecRelationUpsertOne {
+	return u.Update(func(s *ProductSpecRelationUpsert) {
+		s.SetPeriodPrices(v)
+	})
+}
+
+// UpdatePeriodPrices sets the "period_prices" field to the value that was provided on create.
+func (u *ProductSpecRelationUpsertOne) UpdatePeriodPrices() *ProductSpecRelationUpsertOne {
+	return u.Update(func(s *ProductSpecRelationUpsert) {
+		s.UpdatePeriodPrices()
+	})
+}
+
+// ClearPeriodPrices clears the value of the "period_prices" field.
+func (u *ProductSpecRelationUpsertOne) ClearPeriodPrices() *ProductSpecRelationUpsertOne {
+	return u.Update(func(s *ProductSpecRelationUpsert) {
+		s.ClearPeriodPrices()
+	})
+}
+
 // Exec executes the query.
 func (u *ProductSpecRelationUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1395,6 +1450,27 @@ func (u *ProductSpecRelationUpsertBulk) UpdateIsDefault() *ProductSpecRelationUp
 	})
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (u *ProductSpecRelationUpsertBulk) SetPeriodPrices(v domain.PeriodPrices) *ProductSpecRelationUpsertBulk {
+	return u.Update(func(s *ProductSpecRelationUpsert) {
+		s.SetPeriodPrices(v)
+	})
+}
+
+// UpdatePeriodPrices sets the "period_prices" field to the value that was provided on create.
+func (u *ProductSpecRelationUpsertBulk) UpdatePeriodPrices() *ProductSpecRelationUpsertBulk {
+	return u.Update(func(s *ProductSpecRelationUpsert) {
+		s.UpdatePeriodPrices()
+	})
+}
+
+// ClearPeriodPrices clears the value of the "period_prices" field.
+func (u *ProductSpecRelationUpsertBulk) ClearPeriodPrices() *ProductSpecRelationUpsertBulk {
+	return u.Update(func(s *ProductSpecRelationUpsert) {
+		s.ClearPeriodPrices()
+	})
+}
+
 // Exec executes the query.
 func (u *ProductSpecRelationUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
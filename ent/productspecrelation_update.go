@@ -10,9 +10,11 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/additionalfee"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productspecrelation"
@@ -221,6 +223,24 @@ func (psru *ProductSpecRelationUpdate) SetNillableIsDefault(b *bool) *ProductSpe
 	return psru
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (psru *ProductSpecRelationUpdate) SetPeriodPrices(dp domain.PeriodPrices) *ProductSpecRelationUpdate {
+	psru.mutation.SetPeriodPrices(dp)
+	return psru
+}
+
+// AppendPeriodPrices appends dp to the "period_prices" field.
+func (psru *ProductSpecRelationUpdate) AppendPeriodPrices(dp domain.PeriodPrices) *ProductSpecRelationUpdate {
+	psru.mutation.AppendPeriodPrices(dp)
+	return psru
+}
+
+// ClearPeriodPrices clears the value of the "period_prices" field.
+func (psru *ProductSpecRelationUpdate) ClearPeriodPrices() *ProductSpecRelationUpdate {
+	psru.mutation.ClearPeriodPrices()
+	return psru
+}
+
 // SetPackingFee sets the "packing_fee" edge to the AdditionalFee entity.
 func (psru *ProductSpecRelationUpdate) SetPackingFee(a *AdditionalFee) *ProductSpecRelationUpdate {
 	return psru.SetPackingFeeID(a.ID)
@@ -286,6 +306,11 @@ func (psru *ProductSpecRelationUpdate) check() error {
 			return &ValidationError{Name: "barcode", err: fmt.Errorf(`ent: validator failed for field "ProductSpecRelation.barcode": %w`, err)}
 		}
 	}
+	if v, ok := psru.mutation.PeriodPrices(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "period_prices", err: fmt.Errorf(`ent: validator failed for field "ProductSpecRelation.period_prices": %w`, err)}
+		}
+	}
 	if psru.mutation.ProductCleared() && len(psru.mutation.ProductIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ProductSpecRelation.product"`)
 	}
@@ -361,6 +386,17 @@ func (psru *ProductSpecRelationUpdate) sqlSave(ctx context.Context) (n int, err
 	if value, ok := psru.mutation.IsDefault(); ok {
 		_spec.SetField(productspecrelation.FieldIsDefault, field.TypeBool, value)
 	}
+	if value, ok := psru.mutation.PeriodPrices(); ok {
+		_spec.SetField(productspecrelation.FieldPeriodPrices, field.TypeJSON, value)
+	}
+	if value, ok := psru.mutation.AppendedPeriodPrices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, productspecrelation.FieldPeriodPrices, value)
+		})
+	}
+	if psru.mutation.PeriodPricesCleared() {
+		_spec.ClearField(productspecrelation.FieldPeriodPrices, field.TypeJSON)
+	}
 	if psru.mutation.PackingFeeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -601,6 +637,24 @@ func (psruo *ProductSpecRelationUpdateOne) SetNillableIsDefault(b *bool) *Produc
 	return psruo
 }
 
+// SetPeriodPrices sets the "period_prices" field.
+func (psruo *ProductSpecRelationUpdateOne) SetPeriodPrices(dp domain.PeriodPrices) *ProductSpecRelationUpdateOne {
+	psruo.mutation.SetPeriodPrices(dp)
+	return psruo
+}
+
+// AppendPeriodPrices appends dp to the "period_prices" field.
+func (psruo *ProductSpecRelationUpdateOne) AppendPeriodPrices(dp domain.PeriodPrices) *ProductSpecRelationUpdateOne {
+	psruo.mutation.AppendPeriodPrices(dp)
+	return psruo
+}
+
+// ClearPeriodPrices clears the value of the "period_prices" field.
+func (psruo *ProductSpecRelationUpdateOne) ClearPeriodPrices() *ProductSpecRelationUpdateOne {
+	psruo.mutation.ClearPeriodPrices()
+	return psruo
+}
+
 // SetPackingFee sets the "packing_fee" edge to the AdditionalFee entity.
 func (psruo *ProductSpecRelationUpdateOne) SetPackingFee(a *AdditionalFee) *ProductSpecRelationUpdateOne {
 	return psruo.SetPackingFeeID(a.ID)
@@ -679,6 +733,11 @@ func (psruo *ProductSpecRelationUpdateOne) check() error {
 			return &ValidationError{Name: "barcode", err: fmt.Errorf(`ent: validator failed for field "ProductSpecRelation.barcode": %w`, err)}
 		}
 	}
+	if v, ok := psruo.mutation.PeriodPrices(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "period_prices", err: fmt.Errorf(`ent: validator failed for field "ProductSpecRelation.period_prices": %w`, err)}
+		}
+	}
 	if psruo.mutation.ProductCleared() && len(psruo.mutation.ProductIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ProductSpecRelation.product"`)
 	}
@@ -771,6 +830,17 @@ func (psruo *ProductSpecRelationUpdateOne) sqlSave(ctx context.Context) (_node *
 	if value, ok := psruo.mutation.IsDefault(); ok {
 		_spec.SetField(productspecrelation.FieldIsDefault, field.TypeBool, value)
 	}
+	if value, ok := psruo.mutation.PeriodPrices(); ok {
+		_spec.SetField(productspecrelation.FieldPeriodPrices, field.TypeJSON, value)
+	}
+	if value, ok := psruo.mutation.AppendedPeriodPrices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, productspecrelation.FieldPeriodPrices, value)
+		})
+	}
+	if psruo.mutation.PeriodPricesCleared() {
+		_spec.ClearField(productspecrelation.FieldPeriodPrices, field.TypeJSON)
+	}
 	if psruo.mutation.PackingFeeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
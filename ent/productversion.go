@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/productversion"
+)
+
+// ProductVersion is the model entity for the ProductVersion schema.
+type ProductVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 对象类型：product（商品）、product_spec（商品规格）、attr_item（口味做法项）、menu_item（菜单项）
+	SubjectType domain.ProductVersionSubjectType `json:"subject_type,omitempty"`
+	// 关联商品ID（口味做法项为空）
+	ProductID uuid.UUID `json:"product_id,omitempty"`
+	// 对象ID（商品ID、规格ID、口味做法项ID或菜单ID）
+	SubjectID uuid.UUID `json:"subject_id,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 版本号（同一对象内递增）
+	Version int `json:"version,omitempty"`
+	// 操作类型：create（创建）、update（修改）、delete（删除）、restore（恢复历史版本）
+	Action domain.ProductVersionAction `json:"action,omitempty"`
+	// 对象名称快照
+	Name string `json:"name,omitempty"`
+	// 对象完整快照
+	Snapshot jsontext.Value `json:"snapshot,omitempty"`
+	// 相比上一版本变更的字段
+	Changes domain.ProductVersionChanges `json:"changes,omitempty"`
+	// 恢复来源版本号（仅恢复操作）
+	RestoredFrom int `json:"restored_from,omitempty"`
+	// 操作人ID（系统执行时为空）
+	OperatorID uuid.UUID `json:"operator_id,omitempty"`
+	// 操作人类型
+	OperatorType string `json:"operator_type,omitempty"`
+	// 操作人名称
+	OperatorName string `json:"operator_name,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productversion.FieldSnapshot, productversion.FieldChanges:
+			values[i] = new([]byte)
+		case productversion.FieldVersion, productversion.FieldRestoredFrom:
+			values[i] = new(sql.NullInt64)
+		case productversion.FieldSubjectType, productversion.FieldAction, productversion.FieldName, productversion.FieldOperatorType, productversion.FieldOperatorName:
+			values[i] = new(sql.NullString)
+		case productversion.FieldCreatedAt, productversion.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case productversion.FieldID, productversion.FieldProductID, productversion.FieldSubjectID, productversion.FieldMerchantID, productversion.FieldStoreID, productversion.FieldOperatorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductVersion fields.
+func (pv *ProductVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productversion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pv.ID = *value
+			}
+		case productversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pv.CreatedAt = value.Time
+			}
+		case productversion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pv.UpdatedAt = value.Time
+			}
+		case productversion.FieldSubjectType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_type", values[i])
+			} else if value.Valid {
+				pv.SubjectType = domain.ProductVersionSubjectType(value.String)
+			}
+		case productversion.FieldProductID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value != nil {
+				pv.ProductID = *value
+			}
+		case productversion.FieldSubjectID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_id", values[i])
+			} else if value != nil {
+				pv.SubjectID = *value
+			}
+		case productversion.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				pv.MerchantID = *value
+			}
+		case productversion.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				pv.StoreID = *value
+			}
+		case productversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pv.Version = int(value.Int64)
+			}
+		case productversion.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				pv.Action = domain.ProductVersionAction(value.String)
+			}
+		case productversion.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pv.Name = value.String
+			}
+		case productversion.FieldSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pv.Snapshot); err != nil {
+					return fmt.Errorf("unmarshal field snapshot: %w", err)
+				}
+			}
+		case productversion.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pv.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case productversion.FieldRestoredFrom:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field restored_from", values[i])
+			} else if value.Valid {
+				pv.RestoredFrom = int(value.Int64)
+			}
+		case productversion.FieldOperatorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value != nil {
+				pv.OperatorID = *value
+			}
+		case productversion.FieldOperatorType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_type", values[i])
+			} else if value.Valid {
+				pv.OperatorType = value.String
+			}
+		case productversion.FieldOperatorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_name", values[i])
+			} else if value.Valid {
+				pv.OperatorName = value.String
+			}
+		default:
+			pv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProductVersion.
+// This includes values selected through modifiers, order, etc.
+func (pv *ProductVersion) Value(name string) (ent.Value, error) {
+	return pv.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProductVersion.
+// Note that you need to call ProductVersion.Unwrap() before calling this method if this ProductVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pv *ProductVersion) Update() *ProductVersionUpdateOne {
+	return NewProductVersionClient(pv.config).UpdateOne(pv)
+}
+
+// Unwrap unwraps the ProductVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pv *ProductVersion) Unwrap() *ProductVersion {
+	_tx, ok := pv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductVersion is not a transactional entity")
+	}
+	pv.config.driver = _tx.drv
+	return pv
+}
+
+// String implements the fmt.Stringer.
+func (pv *ProductVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pv.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("subject_type=")
+	builder.WriteString(fmt.Sprintf("%v", pv.SubjectType))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pv.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("subject_id=")
+	builder.WriteString(fmt.Sprintf("%v", pv.SubjectID))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pv.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", pv.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Version))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Action))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pv.Name)
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Snapshot))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("restored_from=")
+	builder.WriteString(fmt.Sprintf("%v", pv.RestoredFrom))
+	builder.WriteString(", ")
+	builder.WriteString("operator_id=")
+	builder.WriteString(fmt.Sprintf("%v", pv.OperatorID))
+	builder.WriteString(", ")
+	builder.WriteString("operator_type=")
+	builder.WriteString(pv.OperatorType)
+	builder.WriteString(", ")
+	builder.WriteString("operator_name=")
+	builder.WriteString(pv.OperatorName)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductVersions is a parsable slice of ProductVersion.
+type ProductVersions []*ProductVersion
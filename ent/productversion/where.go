@@ -0,0 +1,757 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldProductID, v))
+}
+
+// SubjectID applies equality check predicate on the "subject_id" field. It's identical to SubjectIDEQ.
+func SubjectID(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldStoreID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldName, v))
+}
+
+// RestoredFrom applies equality check predicate on the "restored_from" field. It's identical to RestoredFromEQ.
+func RestoredFrom(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldRestoredFrom, v))
+}
+
+// OperatorID applies equality check predicate on the "operator_id" field. It's identical to OperatorIDEQ.
+func OperatorID(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorType applies equality check predicate on the "operator_type" field. It's identical to OperatorTypeEQ.
+func OperatorType(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldOperatorType, v))
+}
+
+// OperatorName applies equality check predicate on the "operator_name" field. It's identical to OperatorNameEQ.
+func OperatorName(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldOperatorName, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// SubjectTypeEQ applies the EQ predicate on the "subject_type" field.
+func SubjectTypeEQ(v domain.ProductVersionSubjectType) predicate.ProductVersion {
+	vc := v
+	return predicate.ProductVersion(sql.FieldEQ(FieldSubjectType, vc))
+}
+
+// SubjectTypeNEQ applies the NEQ predicate on the "subject_type" field.
+func SubjectTypeNEQ(v domain.ProductVersionSubjectType) predicate.ProductVersion {
+	vc := v
+	return predicate.ProductVersion(sql.FieldNEQ(FieldSubjectType, vc))
+}
+
+// SubjectTypeIn applies the In predicate on the "subject_type" field.
+func SubjectTypeIn(vs ...domain.ProductVersionSubjectType) predicate.ProductVersion {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductVersion(sql.FieldIn(FieldSubjectType, v...))
+}
+
+// SubjectTypeNotIn applies the NotIn predicate on the "subject_type" field.
+func SubjectTypeNotIn(vs ...domain.ProductVersionSubjectType) predicate.ProductVersion {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductVersion(sql.FieldNotIn(FieldSubjectType, v...))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldProductID, v))
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldProductID, v))
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldProductID, v))
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldProductID, v))
+}
+
+// SubjectIDEQ applies the EQ predicate on the "subject_id" field.
+func SubjectIDEQ(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SubjectIDNEQ applies the NEQ predicate on the "subject_id" field.
+func SubjectIDNEQ(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldSubjectID, v))
+}
+
+// SubjectIDIn applies the In predicate on the "subject_id" field.
+func SubjectIDIn(vs ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDNotIn applies the NotIn predicate on the "subject_id" field.
+func SubjectIDNotIn(vs ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDGT applies the GT predicate on the "subject_id" field.
+func SubjectIDGT(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldSubjectID, v))
+}
+
+// SubjectIDGTE applies the GTE predicate on the "subject_id" field.
+func SubjectIDGTE(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldSubjectID, v))
+}
+
+// SubjectIDLT applies the LT predicate on the "subject_id" field.
+func SubjectIDLT(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldSubjectID, v))
+}
+
+// SubjectIDLTE applies the LTE predicate on the "subject_id" field.
+func SubjectIDLTE(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldSubjectID, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldStoreID, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v domain.ProductVersionAction) predicate.ProductVersion {
+	vc := v
+	return predicate.ProductVersion(sql.FieldEQ(FieldAction, vc))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v domain.ProductVersionAction) predicate.ProductVersion {
+	vc := v
+	return predicate.ProductVersion(sql.FieldNEQ(FieldAction, vc))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...domain.ProductVersionAction) predicate.ProductVersion {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductVersion(sql.FieldIn(FieldAction, v...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...domain.ProductVersionAction) predicate.ProductVersion {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductVersion(sql.FieldNotIn(FieldAction, v...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldContainsFold(FieldName, v))
+}
+
+// ChangesIsNil applies the IsNil predicate on the "changes" field.
+func ChangesIsNil() predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIsNull(FieldChanges))
+}
+
+// ChangesNotNil applies the NotNil predicate on the "changes" field.
+func ChangesNotNil() predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotNull(FieldChanges))
+}
+
+// RestoredFromEQ applies the EQ predicate on the "restored_from" field.
+func RestoredFromEQ(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldRestoredFrom, v))
+}
+
+// RestoredFromNEQ applies the NEQ predicate on the "restored_from" field.
+func RestoredFromNEQ(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldRestoredFrom, v))
+}
+
+// RestoredFromIn applies the In predicate on the "restored_from" field.
+func RestoredFromIn(vs ...int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldRestoredFrom, vs...))
+}
+
+// RestoredFromNotIn applies the NotIn predicate on the "restored_from" field.
+func RestoredFromNotIn(vs ...int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldRestoredFrom, vs...))
+}
+
+// RestoredFromGT applies the GT predicate on the "restored_from" field.
+func RestoredFromGT(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldRestoredFrom, v))
+}
+
+// RestoredFromGTE applies the GTE predicate on the "restored_from" field.
+func RestoredFromGTE(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldRestoredFrom, v))
+}
+
+// RestoredFromLT applies the LT predicate on the "restored_from" field.
+func RestoredFromLT(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldRestoredFrom, v))
+}
+
+// RestoredFromLTE applies the LTE predicate on the "restored_from" field.
+func RestoredFromLTE(v int) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldRestoredFrom, v))
+}
+
+// OperatorIDEQ applies the EQ predicate on the "operator_id" field.
+func OperatorIDEQ(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorIDNEQ applies the NEQ predicate on the "operator_id" field.
+func OperatorIDNEQ(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldOperatorID, v))
+}
+
+// OperatorIDIn applies the In predicate on the "operator_id" field.
+func OperatorIDIn(vs ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDNotIn applies the NotIn predicate on the "operator_id" field.
+func OperatorIDNotIn(vs ...uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDGT applies the GT predicate on the "operator_id" field.
+func OperatorIDGT(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldOperatorID, v))
+}
+
+// OperatorIDGTE applies the GTE predicate on the "operator_id" field.
+func OperatorIDGTE(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldOperatorID, v))
+}
+
+// OperatorIDLT applies the LT predicate on the "operator_id" field.
+func OperatorIDLT(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldOperatorID, v))
+}
+
+// OperatorIDLTE applies the LTE predicate on the "operator_id" field.
+func OperatorIDLTE(v uuid.UUID) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldOperatorID, v))
+}
+
+// OperatorTypeEQ applies the EQ predicate on the "operator_type" field.
+func OperatorTypeEQ(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldOperatorType, v))
+}
+
+// OperatorTypeNEQ applies the NEQ predicate on the "operator_type" field.
+func OperatorTypeNEQ(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldOperatorType, v))
+}
+
+// OperatorTypeIn applies the In predicate on the "operator_type" field.
+func OperatorTypeIn(vs ...string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldOperatorType, vs...))
+}
+
+// OperatorTypeNotIn applies the NotIn predicate on the "operator_type" field.
+func OperatorTypeNotIn(vs ...string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldOperatorType, vs...))
+}
+
+// OperatorTypeGT applies the GT predicate on the "operator_type" field.
+func OperatorTypeGT(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldOperatorType, v))
+}
+
+// OperatorTypeGTE applies the GTE predicate on the "operator_type" field.
+func OperatorTypeGTE(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldOperatorType, v))
+}
+
+// OperatorTypeLT applies the LT predicate on the "operator_type" field.
+func OperatorTypeLT(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldOperatorType, v))
+}
+
+// OperatorTypeLTE applies the LTE predicate on the "operator_type" field.
+func OperatorTypeLTE(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldOperatorType, v))
+}
+
+// OperatorTypeContains applies the Contains predicate on the "operator_type" field.
+func OperatorTypeContains(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldContains(FieldOperatorType, v))
+}
+
+// OperatorTypeHasPrefix applies the HasPrefix predicate on the "operator_type" field.
+func OperatorTypeHasPrefix(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldHasPrefix(FieldOperatorType, v))
+}
+
+// OperatorTypeHasSuffix applies the HasSuffix predicate on the "operator_type" field.
+func OperatorTypeHasSuffix(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldHasSuffix(FieldOperatorType, v))
+}
+
+// OperatorTypeEqualFold applies the EqualFold predicate on the "operator_type" field.
+func OperatorTypeEqualFold(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEqualFold(FieldOperatorType, v))
+}
+
+// OperatorTypeContainsFold applies the ContainsFold predicate on the "operator_type" field.
+func OperatorTypeContainsFold(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldContainsFold(FieldOperatorType, v))
+}
+
+// OperatorNameEQ applies the EQ predicate on the "operator_name" field.
+func OperatorNameEQ(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEQ(FieldOperatorName, v))
+}
+
+// OperatorNameNEQ applies the NEQ predicate on the "operator_name" field.
+func OperatorNameNEQ(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNEQ(FieldOperatorName, v))
+}
+
+// OperatorNameIn applies the In predicate on the "operator_name" field.
+func OperatorNameIn(vs ...string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldIn(FieldOperatorName, vs...))
+}
+
+// OperatorNameNotIn applies the NotIn predicate on the "operator_name" field.
+func OperatorNameNotIn(vs ...string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldNotIn(FieldOperatorName, vs...))
+}
+
+// OperatorNameGT applies the GT predicate on the "operator_name" field.
+func OperatorNameGT(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGT(FieldOperatorName, v))
+}
+
+// OperatorNameGTE applies the GTE predicate on the "operator_name" field.
+func OperatorNameGTE(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldGTE(FieldOperatorName, v))
+}
+
+// OperatorNameLT applies the LT predicate on the "operator_name" field.
+func OperatorNameLT(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLT(FieldOperatorName, v))
+}
+
+// OperatorNameLTE applies the LTE predicate on the "operator_name" field.
+func OperatorNameLTE(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldLTE(FieldOperatorName, v))
+}
+
+// OperatorNameContains applies the Contains predicate on the "operator_name" field.
+func OperatorNameContains(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldContains(FieldOperatorName, v))
+}
+
+// OperatorNameHasPrefix applies the HasPrefix predicate on the "operator_name" field.
+func OperatorNameHasPrefix(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldHasPrefix(FieldOperatorName, v))
+}
+
+// OperatorNameHasSuffix applies the HasSuffix predicate on the "operator_name" field.
+func OperatorNameHasSuffix(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldHasSuffix(FieldOperatorName, v))
+}
+
+// OperatorNameEqualFold applies the EqualFold predicate on the "operator_name" field.
+func OperatorNameEqualFold(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldEqualFold(FieldOperatorName, v))
+}
+
+// OperatorNameContainsFold applies the ContainsFold predicate on the "operator_name" field.
+func OperatorNameContainsFold(v string) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.FieldContainsFold(FieldOperatorName, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProductVersion) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProductVersion) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProductVersion) predicate.ProductVersion {
+	return predicate.ProductVersion(sql.NotPredicates(p))
+}
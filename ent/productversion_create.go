@@ -0,0 +1,926 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/productversion"
+)
+
+// ProductVersionCreate is the builder for creating a ProductVersion entity.
+type ProductVersionCreate struct {
+	config
+	mutation *ProductVersionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pvc *ProductVersionCreate) SetCreatedAt(t time.Time) *ProductVersionCreate {
+	pvc.mutation.SetCreatedAt(t)
+	return pvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pvc *ProductVersionCreate) SetNillableCreatedAt(t *time.Time) *ProductVersionCreate {
+	if t != nil {
+		pvc.SetCreatedAt(*t)
+	}
+	return pvc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pvc *ProductVersionCreate) SetUpdatedAt(t time.Time) *ProductVersionCreate {
+	pvc.mutation.SetUpdatedAt(t)
+	return pvc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pvc *ProductVersionCreate) SetNillableUpdatedAt(t *time.Time) *ProductVersionCreate {
+	if t != nil {
+		pvc.SetUpdatedAt(*t)
+	}
+	return pvc
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (pvc *ProductVersionCreate) SetSubjectType(dvst domain.ProductVersionSubjectType) *ProductVersionCreate {
+	pvc.mutation.SetSubjectType(dvst)
+	return pvc
+}
+
+// SetProductID sets the "product_id" field.
+func (pvc *ProductVersionCreate) SetProductID(u uuid.UUID) *ProductVersionCreate {
+	pvc.mutation.SetProductID(u)
+	return pvc
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (pvc *ProductVersionCreate) SetNillableProductID(u *uuid.UUID) *ProductVersionCreate {
+	if u != nil {
+		pvc.SetProductID(*u)
+	}
+	return pvc
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (pvc *ProductVersionCreate) SetSubjectID(u uuid.UUID) *ProductVersionCreate {
+	pvc.mutation.SetSubjectID(u)
+	return pvc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (pvc *ProductVersionCreate) SetMerchantID(u uuid.UUID) *ProductVersionCreate {
+	pvc.mutation.SetMerchantID(u)
+	return pvc
+}
+
+// SetStoreID sets the "store_id" field.
+func (pvc *ProductVersionCreate) SetStoreID(u uuid.UUID) *ProductVersionCreate {
+	pvc.mutation.SetStoreID(u)
+	return pvc
+}
+
+// SetNillableStoreID sets the "store_id" field if the given value is not nil.
+func (pvc *ProductVersionCreate) SetNillableStoreID(u *uuid.UUID) *ProductVersionCreate {
+	if u != nil {
+		pvc.SetStoreID(*u)
+	}
+	return pvc
+}
+
+// SetVersion sets the "version" field.
+func (pvc *ProductVersionCreate) SetVersion(i int) *ProductVersionCreate {
+	pvc.mutation.SetVersion(i)
+	return pvc
+}
+
+// SetAction sets the "action" field.
+func (pvc *ProductVersionCreate) SetAction(dva domain.ProductVersionAction) *ProductVersionCreate {
+	pvc.mutation.SetAction(dva)
+	return pvc
+}
+
+// SetName sets the "name" field.
+func (pvc *ProductVersionCreate) SetName(s string) *ProductVersionCreate {
+	pvc.mutation.SetName(s)
+	return pvc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pvc *ProductVersionCreate) SetNillableName(s *string) *ProductVersionCreate {
+	if s != nil {
+		pvc.SetName(*s)
+	}
+	return pvc
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (pvc *ProductVersionCreate) SetSnapshot(j jsontext.Value) *ProductVersionCreate {
+	pvc.mutation.SetSnapshot(j)
+	return pvc
+}
+
+// SetChanges sets the "changes" field.
+func (pvc *ProductVersionCreate) SetChanges(dvc domain.ProductVersionChanges) *ProductVersionCreate {
+	pvc.mutation.SetChanges(dvc)
+	return pvc
+}
+
+// SetRestoredFrom sets the "restored_from" field.
+func (pvc *ProductVersionCreate) SetRestoredFrom(i int) *ProductVersionCreate {
+	pvc.mutation.SetRestoredFrom(i)
+	return pvc
+}
+
+// SetNillableRestoredFrom sets the "restored_from" field if the given value is not nil.
+func (pvc *ProductVersionCreate) SetNillableRestoredFrom(i *int) *ProductVersionCreate {
+	if i != nil {
+		pvc.SetRestoredFrom(*i)
+	}
+	return pvc
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (pvc *ProductVersionCreate) SetOperatorID(u uuid.UUID) *ProductVersionCreate {
+	pvc.mutation.SetOperatorID(u)
+	return pvc
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (pvc *ProductVersionCreate) SetNillableOperatorID(u *uuid.UUID) *ProductVersionCreate {
+	if u != nil {
+		pvc.SetOperatorID(*u)
+	}
+	return pvc
+}
+
+// SetOperatorType sets the "operator_type" field.
+func (pvc *ProductVersionCreate) SetOperatorType(s string) *ProductVersionCreate {
+	pvc.mutation.SetOperatorType(s)
+	return pvc
+}
+
+// SetNillableOperatorType sets the "operator_type" field if the given value is not nil.
+func (pvc *ProductVersionCreate) SetNillableOperatorType(s *string) *ProductVersionCreate {
+	if s != nil {
+		pvc.SetOperatorType(*s)
+	}
+	return pvc
+}
+
+// SetOperatorName sets the "operator_name" field.
+func (pvc *ProductVersionCreate) SetOperatorName(s string) *ProductVersionCreate {
+	pvc.mutation.SetOperatorName(s)
+	return pvc
+}
+
+// SetNillableOperatorName sets the "operator_name" field if the given value is not nil.
+func (pvc *ProductVersionCreate) SetNillableOperatorName(s *string) *ProductVersionCreate {
+	if s != nil {
+		pvc.SetOperatorName(*s)
+	}
+	return pvc
+}
+
+// SetID sets the "id" field.
+func (pvc *ProductVersionCreate) SetID(u uuid.UUID) *ProductVersionCreate {
+	pvc.mutation.SetID(u)
+	return pvc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pvc *ProductVersionCreate) SetNillableID(u *uuid.UUID) *ProductVersionCreate {
+	if u != nil {
+		pvc.SetID(*u)
+	}
+	return pvc
+}
+
+// Mutation returns the ProductVersionMutation object of the builder.
+func (pvc *ProductVersionCreate) Mutation() *ProductVersionMutation {
+	return pvc.mutation
+}
+
+// Save creates the ProductVersion in the database.
+func (pvc *ProductVersionCreate) Save(ctx context.Context) (*ProductVersion, error) {
+	pvc.defaults()
+	return withHooks(ctx, pvc.sqlSave, pvc.mutation, pvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pvc *ProductVersionCreate) SaveX(ctx context.Context) *ProductVersion {
+	v, err := pvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pvc *ProductVersionCreate) Exec(ctx context.Context) error {
+	_, err := pvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvc *ProductVersionCreate) ExecX(ctx context.Context) {
+	if err := pvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pvc *ProductVersionCreate) defaults() {
+	if _, ok := pvc.mutation.CreatedAt(); !ok {
+		v := productversion.DefaultCreatedAt()
+		pvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pvc.mutation.UpdatedAt(); !ok {
+		v := productversion.DefaultUpdatedAt()
+		pvc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pvc.mutation.ProductID(); !ok {
+		v := productversion.DefaultProductID()
+		pvc.mutation.SetProductID(v)
+	}
+	if _, ok := pvc.mutation.StoreID(); !ok {
+		v := productversion.DefaultStoreID()
+		pvc.mutation.SetStoreID(v)
+	}
+	if _, ok := pvc.mutation.Name(); !ok {
+		v := productversion.DefaultName
+		pvc.mutation.SetName(v)
+	}
+	if _, ok := pvc.mutation.RestoredFrom(); !ok {
+		v := productversion.DefaultRestoredFrom
+		pvc.mutation.SetRestoredFrom(v)
+	}
+	if _, ok := pvc.mutation.OperatorID(); !ok {
+		v := productversion.DefaultOperatorID()
+		pvc.mutation.SetOperatorID(v)
+	}
+	if _, ok := pvc.mutation.OperatorType(); !ok {
+		v := productversion.DefaultOperatorType
+		pvc.mutation.SetOperatorType(v)
+	}
+	if _, ok := pvc.mutation.OperatorName(); !ok {
+		v := productversion.DefaultOperatorName
+		pvc.mutation.SetOperatorName(v)
+	}
+	if _, ok := pvc.mutation.ID(); !ok {
+		v := productversion.DefaultID()
+		pvc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvc *ProductVersionCreate) check() error {
+	if _, ok := pvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductVersion.created_at"`)}
+	}
+	if _, ok := pvc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProductVersion.updated_at"`)}
+	}
+	if _, ok := pvc.mutation.SubjectType(); !ok {
+		return &ValidationError{Name: "subject_type", err: errors.New(`ent: missing required field "ProductVersion.subject_type"`)}
+	}
+	if v, ok := pvc.mutation.SubjectType(); ok {
+		if err := productversion.SubjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "subject_type", err: fmt.Errorf(`ent: validator failed for field "ProductVersion.subject_type": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductVersion.product_id"`)}
+	}
+	if _, ok := pvc.mutation.SubjectID(); !ok {
+		return &ValidationError{Name: "subject_id", err: errors.New(`ent: missing required field "ProductVersion.subject_id"`)}
+	}
+	if _, ok := pvc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "ProductVersion.merchant_id"`)}
+	}
+	if _, ok := pvc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "ProductVersion.store_id"`)}
+	}
+	if _, ok := pvc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ProductVersion.version"`)}
+	}
+	if _, ok := pvc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ProductVersion.action"`)}
+	}
+	if v, ok := pvc.mutation.Action(); ok {
+		if err := productversion.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ProductVersion.action": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ProductVersion.name"`)}
+	}
+	if v, ok := pvc.mutation.Name(); ok {
+		if err := productversion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductVersion.name": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.Snapshot(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required field "ProductVersion.snapshot"`)}
+	}
+	if _, ok := pvc.mutation.RestoredFrom(); !ok {
+		return &ValidationError{Name: "restored_from", err: errors.New(`ent: missing required field "ProductVersion.restored_from"`)}
+	}
+	if _, ok := pvc.mutation.OperatorID(); !ok {
+		return &ValidationError{Name: "operator_id", err: errors.New(`ent: missing required field "ProductVersion.operator_id"`)}
+	}
+	if _, ok := pvc.mutation.OperatorType(); !ok {
+		return &ValidationError{Name: "operator_type", err: errors.New(`ent: missing required field "ProductVersion.operator_type"`)}
+	}
+	if v, ok := pvc.mutation.OperatorType(); ok {
+		if err := productversion.OperatorTypeValidator(v); err != nil {
+			return &ValidationError{Name: "operator_type", err: fmt.Errorf(`ent: validator failed for field "ProductVersion.operator_type": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.OperatorName(); !ok {
+		return &ValidationError{Name: "operator_name", err: errors.New(`ent: missing required field "ProductVersion.operator_name"`)}
+	}
+	if v, ok := pvc.mutation.OperatorName(); ok {
+		if err := productversion.OperatorNameValidator(v); err != nil {
+			return &ValidationError{Name: "operator_name", err: fmt.Errorf(`ent: validator failed for field "ProductVersion.operator_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pvc *ProductVersionCreate) sqlSave(ctx context.Context) (*ProductVersion, error) {
+	if err := pvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pvc.mutation.id = &_node.ID
+	pvc.mutation.done = true
+	return _node, nil
+}
+
+func (pvc *ProductVersionCreate) createSpec() (*ProductVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductVersion{config: pvc.config}
+		_spec = sqlgraph.NewCreateSpec(productversion.Table, sqlgraph.NewFieldSpec(productversion.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pvc.conflict
+	if id, ok := pvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pvc.mutation.CreatedAt(); ok {
+		_spec.SetField(productversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pvc.mutation.UpdatedAt(); ok {
+		_spec.SetField(productversion.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pvc.mutation.SubjectType(); ok {
+		_spec.SetField(productversion.FieldSubjectType, field.TypeEnum, value)
+		_node.SubjectType = value
+	}
+	if value, ok := pvc.mutation.ProductID(); ok {
+		_spec.SetField(productversion.FieldProductID, field.TypeUUID, value)
+		_node.ProductID = value
+	}
+	if value, ok := pvc.mutation.SubjectID(); ok {
+		_spec.SetField(productversion.FieldSubjectID, field.TypeUUID, value)
+		_node.SubjectID = value
+	}
+	if value, ok := pvc.mutation.MerchantID(); ok {
+		_spec.SetField(productversion.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := pvc.mutation.StoreID(); ok {
+		_spec.SetField(productversion.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := pvc.mutation.Version(); ok {
+		_spec.SetField(productversion.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := pvc.mutation.Action(); ok {
+		_spec.SetField(productversion.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := pvc.mutation.Name(); ok {
+		_spec.SetField(productversion.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pvc.mutation.Snapshot(); ok {
+		_spec.SetField(productversion.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
+	if value, ok := pvc.mutation.Changes(); ok {
+		_spec.SetField(productversion.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := pvc.mutation.RestoredFrom(); ok {
+		_spec.SetField(productversion.FieldRestoredFrom, field.TypeInt, value)
+		_node.RestoredFrom = value
+	}
+	if value, ok := pvc.mutation.OperatorID(); ok {
+		_spec.SetField(productversion.FieldOperatorID, field.TypeUUID, value)
+		_node.OperatorID = value
+	}
+	if value, ok := pvc.mutation.OperatorType(); ok {
+		_spec.SetField(productversion.FieldOperatorType, field.TypeString, value)
+		_node.OperatorType = value
+	}
+	if value, ok := pvc.mutation.OperatorName(); ok {
+		_spec.SetField(productversion.FieldOperatorName, field.TypeString, value)
+		_node.OperatorName = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProductVersion.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProductVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pvc *ProductVersionCreate) OnConflict(opts ...sql.ConflictOption) *ProductVersionUpsertOne {
+	pvc.conflict = opts
+	return &ProductVersionUpsertOne{
+		create: pvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProductVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pvc *ProductVersionCreate) OnConflictColumns(columns ...string) *ProductVersionUpsertOne {
+	pvc.conflict = append(pvc.conflict, sql.ConflictColumns(columns...))
+	return &ProductVersionUpsertOne{
+		create: pvc,
+	}
+}
+
+type (
+	// ProductVersionUpsertOne is the builder for "upsert"-ing
+	//  one ProductVersion node.
+	ProductVersionUpsertOne struct {
+		create *ProductVersionCreate
+	}
+
+	// ProductVersionUpsert is the "OnConflict" setter.
+	ProductVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProductVersionUpsert) SetUpdatedAt(v time.Time) *ProductVersionUpsert {
+	u.Set(productversion.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProductVersionUpsert) UpdateUpdatedAt() *ProductVersionUpsert {
+	u.SetExcluded(productversion.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProductVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(productversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProductVersionUpsertOne) UpdateNewValues() *ProductVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(productversion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(productversion.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.SubjectType(); exists {
+			s.SetIgnore(productversion.FieldSubjectType)
+		}
+		if _, exists := u.create.mutation.ProductID(); exists {
+			s.SetIgnore(productversion.FieldProductID)
+		}
+		if _, exists := u.create.mutation.SubjectID(); exists {
+			s.SetIgnore(productversion.FieldSubjectID)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(productversion.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(productversion.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.Version(); exists {
+			s.SetIgnore(productversion.FieldVersion)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(productversion.FieldAction)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(productversion.FieldName)
+		}
+		if _, exists := u.create.mutation.Snapshot(); exists {
+			s.SetIgnore(productversion.FieldSnapshot)
+		}
+		if _, exists := u.create.mutation.Changes(); exists {
+			s.SetIgnore(productversion.FieldChanges)
+		}
+		if _, exists := u.create.mutation.RestoredFrom(); exists {
+			s.SetIgnore(productversion.FieldRestoredFrom)
+		}
+		if _, exists := u.create.mutation.OperatorID(); exists {
+			s.SetIgnore(productversion.FieldOperatorID)
+		}
+		if _, exists := u.create.mutation.OperatorType(); exists {
+			s.SetIgnore(productversion.FieldOperatorType)
+		}
+		if _, exists := u.create.mutation.OperatorName(); exists {
+			s.SetIgnore(productversion.FieldOperatorName)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProductVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProductVersionUpsertOne) Ignore() *ProductVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProductVersionUpsertOne) DoNothing() *ProductVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProductVersionCreate.OnConflict
+// documentation for more info.
+func (u *ProductVersionUpsertOne) Update(set func(*ProductVersionUpsert)) *ProductVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProductVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProductVersionUpsertOne) SetUpdatedAt(v time.Time) *ProductVersionUpsertOne {
+	return u.Update(func(s *ProductVersionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProductVersionUpsertOne) UpdateUpdatedAt() *ProductVersionUpsertOne {
+	return u.Update(func(s *ProductVersionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProductVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProductVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProductVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProductVersionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProductVersionUpsertOne.ID is not supported by MySQL driver. Use ProductVersionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProductVersionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProductVersionCreateBulk is the builder for creating many ProductVersion entities in bulk.
+type ProductVersionCreateBulk struct {
+	config
+	err      error
+	builders []*ProductVersionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProductVersion entities in the database.
+func (pvcb *ProductVersionCreateBulk) Save(ctx context.Context) ([]*ProductVersion, error) {
+	if pvcb.err != nil {
+		return nil, pvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pvcb.builders))
+	nodes := make([]*ProductVersion, len(pvcb.builders))
+	mutators := make([]Mutator, len(pvcb.builders))
+	for i := range pvcb.builders {
+		func(i int, root context.Context) {
+			builder := pvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvcb *ProductVersionCreateBulk) SaveX(ctx context.Context) []*ProductVersion {
+	v, err := pvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pvcb *ProductVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := pvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvcb *ProductVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := pvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProductVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProductVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pvcb *ProductVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProductVersionUpsertBulk {
+	pvcb.conflict = opts
+	return &ProductVersionUpsertBulk{
+		create: pvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProductVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pvcb *ProductVersionCreateBulk) OnConflictColumns(columns ...string) *ProductVersionUpsertBulk {
+	pvcb.conflict = append(pvcb.conflict, sql.ConflictColumns(columns...))
+	return &ProductVersionUpsertBulk{
+		create: pvcb,
+	}
+}
+
+// ProductVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProductVersion nodes.
+type ProductVersionUpsertBulk struct {
+	create *ProductVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProductVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(productversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProductVersionUpsertBulk) UpdateNewValues() *ProductVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(productversion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(productversion.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.SubjectType(); exists {
+				s.SetIgnore(productversion.FieldSubjectType)
+			}
+			if _, exists := b.mutation.ProductID(); exists {
+				s.SetIgnore(productversion.FieldProductID)
+			}
+			if _, exists := b.mutation.SubjectID(); exists {
+				s.SetIgnore(productversion.FieldSubjectID)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(productversion.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(productversion.FieldStoreID)
+			}
+			if _, exists := b.mutation.Version(); exists {
+				s.SetIgnore(productversion.FieldVersion)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(productversion.FieldAction)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(productversion.FieldName)
+			}
+			if _, exists := b.mutation.Snapshot(); exists {
+				s.SetIgnore(productversion.FieldSnapshot)
+			}
+			if _, exists := b.mutation.Changes(); exists {
+				s.SetIgnore(productversion.FieldChanges)
+			}
+			if _, exists := b.mutation.RestoredFrom(); exists {
+				s.SetIgnore(productversion.FieldRestoredFrom)
+			}
+			if _, exists := b.mutation.OperatorID(); exists {
+				s.SetIgnore(productversion.FieldOperatorID)
+			}
+			if _, exists := b.mutation.OperatorType(); exists {
+				s.SetIgnore(productversion.FieldOperatorType)
+			}
+			if _, exists := b.mutation.OperatorName(); exists {
+				s.SetIgnore(productversion.FieldOperatorName)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProductVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProductVersionUpsertBulk) Ignore() *ProductVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProductVersionUpsertBulk) DoNothing() *ProductVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProductVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProductVersionUpsertBulk) Update(set func(*ProductVersionUpsert)) *ProductVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProductVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProductVersionUpsertBulk) SetUpdatedAt(v time.Time) *ProductVersionUpsertBulk {
+	return u.Update(func(s *ProductVersionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProductVersionUpsertBulk) UpdateUpdatedAt() *ProductVersionUpsertBulk {
+	return u.Update(func(s *ProductVersionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProductVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProductVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProductVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProductVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/productversion"
+)
+
+// ProductVersionDelete is the builder for deleting a ProductVersion entity.
+type ProductVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProductVersionMutation
+}
+
+// Where appends a list predicates to the ProductVersionDelete builder.
+func (pvd *ProductVersionDelete) Where(ps ...predicate.ProductVersion) *ProductVersionDelete {
+	pvd.mutation.Where(ps...)
+	return pvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pvd *ProductVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pvd.sqlExec, pvd.mutation, pvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvd *ProductVersionDelete) ExecX(ctx context.Context) int {
+	n, err := pvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pvd *ProductVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(productversion.Table, sqlgraph.NewFieldSpec(productversion.FieldID, field.TypeUUID))
+	if ps := pvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pvd.mutation.done = true
+	return affected, err
+}
+
+// ProductVersionDeleteOne is the builder for deleting a single ProductVersion entity.
+type ProductVersionDeleteOne struct {
+	pvd *ProductVersionDelete
+}
+
+// Where appends a list predicates to the ProductVersionDelete builder.
+func (pvdo *ProductVersionDeleteOne) Where(ps ...predicate.ProductVersion) *ProductVersionDeleteOne {
+	pvdo.pvd.mutation.Where(ps...)
+	return pvdo
+}
+
+// Exec executes the deletion query.
+func (pvdo *ProductVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := pvdo.pvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{productversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvdo *ProductVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := pvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
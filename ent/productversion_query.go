@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/productversion"
+)
+
+// ProductVersionQuery is the builder for querying ProductVersion entities.
+type ProductVersionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []productversion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ProductVersion
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductVersionQuery builder.
+func (pvq *ProductVersionQuery) Where(ps ...predicate.ProductVersion) *ProductVersionQuery {
+	pvq.predicates = append(pvq.predicates, ps...)
+	return pvq
+}
+
+// Limit the number of records to be returned by this query.
+func (pvq *ProductVersionQuery) Limit(limit int) *ProductVersionQuery {
+	pvq.ctx.Limit = &limit
+	return pvq
+}
+
+// Offset to start from.
+func (pvq *ProductVersionQuery) Offset(offset int) *ProductVersionQuery {
+	pvq.ctx.Offset = &offset
+	return pvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pvq *ProductVersionQuery) Unique(unique bool) *ProductVersionQuery {
+	pvq.ctx.Unique = &unique
+	return pvq
+}
+
+// Order specifies how the records should be ordered.
+func (pvq *ProductVersionQuery) Order(o ...productversion.OrderOption) *ProductVersionQuery {
+	pvq.order = append(pvq.order, o...)
+	return pvq
+}
+
+// First returns the first ProductVersion entity from the query.
+// Returns a *NotFoundError when no ProductVersion was found.
+func (pvq *ProductVersionQuery) First(ctx context.Context) (*ProductVersion, error) {
+	nodes, err := pvq.Limit(1).All(setContextOp(ctx, pvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pvq *ProductVersionQuery) FirstX(ctx context.Context) *ProductVersion {
+	node, err := pvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductVersion ID from the query.
+// Returns a *NotFoundError when no ProductVersion ID was found.
+func (pvq *ProductVersionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pvq.Limit(1).IDs(setContextOp(ctx, pvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pvq *ProductVersionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := pvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductVersion entity is found.
+// Returns a *NotFoundError when no ProductVersion entities are found.
+func (pvq *ProductVersionQuery) Only(ctx context.Context) (*ProductVersion, error) {
+	nodes, err := pvq.Limit(2).All(setContextOp(ctx, pvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productversion.Label}
+	default:
+		return nil, &NotSingularError{productversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pvq *ProductVersionQuery) OnlyX(ctx context.Context) *ProductVersion {
+	node, err := pvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductVersion ID in the query.
+// Returns a *NotSingularError when more than one ProductVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pvq *ProductVersionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pvq.Limit(2).IDs(setContextOp(ctx, pvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productversion.Label}
+	default:
+		err = &NotSingularError{productversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pvq *ProductVersionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := pvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductVersions.
+func (pvq *ProductVersionQuery) All(ctx context.Context) ([]*ProductVersion, error) {
+	ctx = setContextOp(ctx, pvq.ctx, ent.OpQueryAll)
+	if err := pvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProductVersion, *ProductVersionQuery]()
+	return withInterceptors[[]*ProductVersion](ctx, pvq, qr, pvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pvq *ProductVersionQuery) AllX(ctx context.Context) []*ProductVersion {
+	nodes, err := pvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductVersion IDs.
+func (pvq *ProductVersionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if pvq.ctx.Unique == nil && pvq.path != nil {
+		pvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pvq.ctx, ent.OpQueryIDs)
+	if err = pvq.Select(productversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pvq *ProductVersionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := pvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pvq *ProductVersionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pvq.ctx, ent.OpQueryCount)
+	if err := pvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pvq, querierCount[*ProductVersionQuery](), pvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pvq *ProductVersionQuery) CountX(ctx context.Context) int {
+	count, err := pvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pvq *ProductVersionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pvq.ctx, ent.OpQueryExist)
+	switch _, err := pvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pvq *ProductVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := pvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pvq *ProductVersionQuery) Clone() *ProductVersionQuery {
+	if pvq == nil {
+		return nil
+	}
+	return &ProductVersionQuery{
+		config:     pvq.config,
+		ctx:        pvq.ctx.Clone(),
+		order:      append([]productversion.OrderOption{}, pvq.order...),
+		inters:     append([]Interceptor{}, pvq.inters...),
+		predicates: append([]predicate.ProductVersion{}, pvq.predicates...),
+		// clone intermediate query.
+		sql:       pvq.sql.Clone(),
+		path:      pvq.path,
+		modifiers: append([]func(*sql.Selector){}, pvq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductVersion.Query().
+//		GroupBy(productversion.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pvq *ProductVersionQuery) GroupBy(field string, fields ...string) *ProductVersionGroupBy {
+	pvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProductVersionGroupBy{build: pvq}
+	grbuild.flds = &pvq.ctx.Fields
+	grbuild.label = productversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ProductVersion.Query().
+//		Select(productversion.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (pvq *ProductVersionQuery) Select(fields ...string) *ProductVersionSelect {
+	pvq.ctx.Fields = append(pvq.ctx.Fields, fields...)
+	sbuild := &ProductVersionSelect{ProductVersionQuery: pvq}
+	sbuild.label = productversion.Label
+	sbuild.flds, sbuild.scan = &pvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProductVersionSelect configured with the given aggregations.
+func (pvq *ProductVersionQuery) Aggregate(fns ...AggregateFunc) *ProductVersionSelect {
+	return pvq.Select().Aggregate(fns...)
+}
+
+func (pvq *ProductVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pvq.ctx.Fields {
+		if !productversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pvq.path != nil {
+		prev, err := pvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pvq.sql = prev
+	}
+	return nil
+}
+
+func (pvq *ProductVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductVersion, error) {
+	var (
+		nodes = []*ProductVersion{}
+		_spec = pvq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProductVersion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProductVersion{config: pvq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(pvq.modifiers) > 0 {
+		_spec.Modifiers = pvq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pvq *ProductVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pvq.querySpec()
+	if len(pvq.modifiers) > 0 {
+		_spec.Modifiers = pvq.modifiers
+	}
+	_spec.Node.Columns = pvq.ctx.Fields
+	if len(pvq.ctx.Fields) > 0 {
+		_spec.Unique = pvq.ctx.Unique != nil && *pvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pvq.driver, _spec)
+}
+
+func (pvq *ProductVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(productversion.Table, productversion.Columns, sqlgraph.NewFieldSpec(productversion.FieldID, field.TypeUUID))
+	_spec.From = pvq.sql
+	if unique := pvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productversion.FieldID)
+		for i := range fields {
+			if fields[i] != productversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pvq *ProductVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pvq.driver.Dialect())
+	t1 := builder.Table(productversion.Table)
+	columns := pvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = productversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pvq.sql != nil {
+		selector = pvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pvq.ctx.Unique != nil && *pvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range pvq.modifiers {
+		m(selector)
+	}
+	for _, p := range pvq.predicates {
+		p(selector)
+	}
+	for _, p := range pvq.order {
+		p(selector)
+	}
+	if offset := pvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pvq *ProductVersionQuery) ForUpdate(opts ...sql.LockOption) *ProductVersionQuery {
+	if pvq.driver.Dialect() == dialect.Postgres {
+		pvq.Unique(false)
+	}
+	pvq.modifiers = append(pvq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pvq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pvq *ProductVersionQuery) ForShare(opts ...sql.LockOption) *ProductVersionQuery {
+	if pvq.driver.Dialect() == dialect.Postgres {
+		pvq.Unique(false)
+	}
+	pvq.modifiers = append(pvq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pvq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pvq *ProductVersionQuery) Modify(modifiers ...func(s *sql.Selector)) *ProductVersionSelect {
+	pvq.modifiers = append(pvq.modifiers, modifiers...)
+	return pvq.Select()
+}
+
+// ProductVersionGroupBy is the group-by builder for ProductVersion entities.
+type ProductVersionGroupBy struct {
+	selector
+	build *ProductVersionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pvgb *ProductVersionGroupBy) Aggregate(fns ...AggregateFunc) *ProductVersionGroupBy {
+	pvgb.fns = append(pvgb.fns, fns...)
+	return pvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pvgb *ProductVersionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductVersionQuery, *ProductVersionGroupBy](ctx, pvgb.build, pvgb, pvgb.build.inters, v)
+}
+
+func (pvgb *ProductVersionGroupBy) sqlScan(ctx context.Context, root *ProductVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pvgb.fns))
+	for _, fn := range pvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pvgb.flds)+len(pvgb.fns))
+		for _, f := range *pvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProductVersionSelect is the builder for selecting fields of ProductVersion entities.
+type ProductVersionSelect struct {
+	*ProductVersionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pvs *ProductVersionSelect) Aggregate(fns ...AggregateFunc) *ProductVersionSelect {
+	pvs.fns = append(pvs.fns, fns...)
+	return pvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pvs *ProductVersionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pvs.ctx, ent.OpQuerySelect)
+	if err := pvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductVersionQuery, *ProductVersionSelect](ctx, pvs.ProductVersionQuery, pvs, pvs.inters, v)
+}
+
+func (pvs *ProductVersionSelect) sqlScan(ctx context.Context, root *ProductVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pvs.fns))
+	for _, fn := range pvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pvs *ProductVersionSelect) Modify(modifiers ...func(s *sql.Selector)) *ProductVersionSelect {
+	pvs.modifiers = append(pvs.modifiers, modifiers...)
+	return pvs
+}
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/productversion"
+)
+
+// ProductVersionUpdate is the builder for updating ProductVersion entities.
+type ProductVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ProductVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ProductVersionUpdate builder.
+func (pvu *ProductVersionUpdate) Where(ps ...predicate.ProductVersion) *ProductVersionUpdate {
+	pvu.mutation.Where(ps...)
+	return pvu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pvu *ProductVersionUpdate) SetUpdatedAt(t time.Time) *ProductVersionUpdate {
+	pvu.mutation.SetUpdatedAt(t)
+	return pvu
+}
+
+// Mutation returns the ProductVersionMutation object of the builder.
+func (pvu *ProductVersionUpdate) Mutation() *ProductVersionMutation {
+	return pvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pvu *ProductVersionUpdate) Save(ctx context.Context) (int, error) {
+	pvu.defaults()
+	return withHooks(ctx, pvu.sqlSave, pvu.mutation, pvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvu *ProductVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pvu *ProductVersionUpdate) Exec(ctx context.Context) error {
+	_, err := pvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvu *ProductVersionUpdate) ExecX(ctx context.Context) {
+	if err := pvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pvu *ProductVersionUpdate) defaults() {
+	if _, ok := pvu.mutation.UpdatedAt(); !ok {
+		v := productversion.UpdateDefaultUpdatedAt()
+		pvu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pvu *ProductVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProductVersionUpdate {
+	pvu.modifiers = append(pvu.modifiers, modifiers...)
+	return pvu
+}
+
+func (pvu *ProductVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(productversion.Table, productversion.Columns, sqlgraph.NewFieldSpec(productversion.FieldID, field.TypeUUID))
+	if ps := pvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pvu.mutation.UpdatedAt(); ok {
+		_spec.SetField(productversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pvu.mutation.ChangesCleared() {
+		_spec.ClearField(productversion.FieldChanges, field.TypeJSON)
+	}
+	_spec.AddModifiers(pvu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pvu.mutation.done = true
+	return n, nil
+}
+
+// ProductVersionUpdateOne is the builder for updating a single ProductVersion entity.
+type ProductVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ProductVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pvuo *ProductVersionUpdateOne) SetUpdatedAt(t time.Time) *ProductVersionUpdateOne {
+	pvuo.mutation.SetUpdatedAt(t)
+	return pvuo
+}
+
+// Mutation returns the ProductVersionMutation object of the builder.
+func (pvuo *ProductVersionUpdateOne) Mutation() *ProductVersionMutation {
+	return pvuo.mutation
+}
+
+// Where appends a list predicates to the ProductVersionUpdate builder.
+func (pvuo *ProductVersionUpdateOne) Where(ps ...predicate.ProductVersion) *ProductVersionUpdateOne {
+	pvuo.mutation.Where(ps...)
+	return pvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pvuo *ProductVersionUpdateOne) Select(field string, fields ...string) *ProductVersionUpdateOne {
+	pvuo.fields = append([]string{field}, fields...)
+	return pvuo
+}
+
+// Save executes the query and returns the updated ProductVersion entity.
+func (pvuo *ProductVersionUpdateOne) Save(ctx context.Context) (*ProductVersion, error) {
+	pvuo.defaults()
+	return withHooks(ctx, pvuo.sqlSave, pvuo.mutation, pvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvuo *ProductVersionUpdateOne) SaveX(ctx context.Context) *ProductVersion {
+	node, err := pvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pvuo *ProductVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := pvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvuo *ProductVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := pvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pvuo *ProductVersionUpdateOne) defaults() {
+	if _, ok := pvuo.mutation.UpdatedAt(); !ok {
+		v := productversion.UpdateDefaultUpdatedAt()
+		pvuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pvuo *ProductVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProductVersionUpdateOne {
+	pvuo.modifiers = append(pvuo.modifiers, modifiers...)
+	return pvuo
+}
+
+func (pvuo *ProductVersionUpdateOne) sqlSave(ctx context.Context) (_node *ProductVersion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(productversion.Table, productversion.Columns, sqlgraph.NewFieldSpec(productversion.FieldID, field.TypeUUID))
+	id, ok := pvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productversion.FieldID)
+		for _, f := range fields {
+			if !productversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pvuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(productversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pvuo.mutation.ChangesCleared() {
+		_spec.ClearField(productversion.FieldChanges, field.TypeJSON)
+	}
+	_spec.AddModifiers(pvuo.modifiers...)
+	_node = &ProductVersion{config: pvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pvuo.mutation.done = true
+	return _node, nil
+}
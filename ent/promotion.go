@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/promotion"
+)
+
+// Promotion is the model entity for the Promotion schema.
+type Promotion struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID（门店创建的活动仅本门店适用）
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 活动名称
+	Name string `json:"name,omitempty"`
+	// 促销类型：order_discount（满减/满折）、item_discount（单品/分类折扣）、buy_x_get_y（买X送Y）、second_item_discount（第二份折扣）
+	Type domain.PromotionType `json:"type,omitempty"`
+	// 优惠规则
+	Rule domain.PromotionRule `json:"rule,omitempty"`
+	// 适用门店ID列表（为空表示全部门店）
+	StoreIds []uuid.UUID `json:"store_ids,omitempty"`
+	// 适用订单渠道（为空表示全部渠道）
+	Channels []domain.OrderChannel `json:"channels,omitempty"`
+	// 适用就餐方式（为空表示全部）
+	DiningWays []domain.DiningWay `json:"dining_ways,omitempty"`
+	// 活动开始时间
+	StartAt *time.Time `json:"start_at,omitempty"`
+	// 活动结束时间
+	EndAt *time.Time `json:"end_at,omitempty"`
+	// 活动时段（为空表示全天）
+	TimeWindows []domain.PromotionTimeWindow `json:"time_windows,omitempty"`
+	// 优先级，值越大越先计算
+	Priority int `json:"priority,omitempty"`
+	// 是否可与其他可叠加活动同时享受
+	Stackable bool `json:"stackable,omitempty"`
+	// 是否启用
+	Enabled      bool `json:"enabled,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Promotion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case promotion.FieldRule, promotion.FieldStoreIds, promotion.FieldChannels, promotion.FieldDiningWays, promotion.FieldTimeWindows:
+			values[i] = new([]byte)
+		case promotion.FieldStackable, promotion.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case promotion.FieldDeletedAt, promotion.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case promotion.FieldName, promotion.FieldType:
+			values[i] = new(sql.NullString)
+		case promotion.FieldCreatedAt, promotion.FieldUpdatedAt, promotion.FieldStartAt, promotion.FieldEndAt:
+			values[i] = new(sql.NullTime)
+		case promotion.FieldID, promotion.FieldMerchantID, promotion.FieldStoreID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Promotion fields.
+func (pr *Promotion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case promotion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pr.ID = *value
+			}
+		case promotion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case promotion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		case promotion.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pr.DeletedAt = value.Int64
+			}
+		case promotion.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				pr.MerchantID = *value
+			}
+		case promotion.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				pr.StoreID = *value
+			}
+		case promotion.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pr.Name = value.String
+			}
+		case promotion.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				pr.Type = domain.PromotionType(value.String)
+			}
+		case promotion.FieldRule:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rule", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.Rule); err != nil {
+					return fmt.Errorf("unmarshal field rule: %w", err)
+				}
+			}
+		case promotion.FieldStoreIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field store_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.StoreIds); err != nil {
+					return fmt.Errorf("unmarshal field store_ids: %w", err)
+				}
+			}
+		case promotion.FieldChannels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field channels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.Channels); err != nil {
+					return fmt.Errorf("unmarshal field channels: %w", err)
+				}
+			}
+		case promotion.FieldDiningWays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field dining_ways", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.DiningWays); err != nil {
+					return fmt.Errorf("unmarshal field dining_ways: %w", err)
+				}
+			}
+		case promotion.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				pr.StartAt = new(time.Time)
+				*pr.StartAt = value.Time
+			}
+		case promotion.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				pr.EndAt = new(time.Time)
+				*pr.EndAt = value.Time
+			}
+		case promotion.FieldTimeWindows:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field time_windows", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.TimeWindows); err != nil {
+					return fmt.Errorf("unmarshal field time_windows: %w", err)
+				}
+			}
+		case promotion.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				pr.Priority = int(value.Int64)
+			}
+		case promotion.FieldStackable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field stackable", values[i])
+			} else if value.Valid {
+				pr.Stackable = value.Bool
+			}
+		case promotion.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				pr.Enabled = value.Bool
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Promotion.
+// This includes values selected through modifiers, order, etc.
+func (pr *Promotion) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Promotion.
+// Note that you need to call Promotion.Unwrap() before calling this method if this Promotion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Promotion) Update() *PromotionUpdateOne {
+	return NewPromotionClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Promotion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Promotion) Unwrap() *Promotion {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Promotion is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Promotion) String() string {
+	var builder strings.Builder
+	builder.WriteString("Promotion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", pr.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Type))
+	builder.WriteString(", ")
+	builder.WriteString("rule=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Rule))
+	builder.WriteString(", ")
+	builder.WriteString("store_ids=")
+	builder.WriteString(fmt.Sprintf("%v", pr.StoreIds))
+	builder.WriteString(", ")
+	builder.WriteString("channels=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Channels))
+	builder.WriteString(", ")
+	builder.WriteString("dining_ways=")
+	builder.WriteString(fmt.Sprintf("%v", pr.DiningWays))
+	builder.WriteString(", ")
+	if v := pr.StartAt; v != nil {
+		builder.WriteString("start_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pr.EndAt; v != nil {
+		builder.WriteString("end_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("time_windows=")
+	builder.WriteString(fmt.Sprintf("%v", pr.TimeWindows))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("stackable=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Stackable))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Enabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Promotions is a parsable slice of Promotion.
+type Promotions []*Promotion
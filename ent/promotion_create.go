@@ -0,0 +1,1523 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/promotion"
+)
+
+// PromotionCreate is the builder for creating a Promotion entity.
+type PromotionCreate struct {
+	config
+	mutation *PromotionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PromotionCreate) SetCreatedAt(t time.Time) *PromotionCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableCreatedAt(t *time.Time) *PromotionCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PromotionCreate) SetUpdatedAt(t time.Time) *PromotionCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableUpdatedAt(t *time.Time) *PromotionCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *PromotionCreate) SetDeletedAt(i int64) *PromotionCreate {
+	pc.mutation.SetDeletedAt(i)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableDeletedAt(i *int64) *PromotionCreate {
+	if i != nil {
+		pc.SetDeletedAt(*i)
+	}
+	return pc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (pc *PromotionCreate) SetMerchantID(u uuid.UUID) *PromotionCreate {
+	pc.mutation.SetMerchantID(u)
+	return pc
+}
+
+// SetStoreID sets the "store_id" field.
+func (pc *PromotionCreate) SetStoreID(u uuid.UUID) *PromotionCreate {
+	pc.mutation.SetStoreID(u)
+	return pc
+}
+
+// SetNillableStoreID sets the "store_id" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableStoreID(u *uuid.UUID) *PromotionCreate {
+	if u != nil {
+		pc.SetStoreID(*u)
+	}
+	return pc
+}
+
+// SetName sets the "name" field.
+func (pc *PromotionCreate) SetName(s string) *PromotionCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetType sets the "type" field.
+func (pc *PromotionCreate) SetType(dt domain.PromotionType) *PromotionCreate {
+	pc.mutation.SetType(dt)
+	return pc
+}
+
+// SetRule sets the "rule" field.
+func (pc *PromotionCreate) SetRule(dr domain.PromotionRule) *PromotionCreate {
+	pc.mutation.SetRule(dr)
+	return pc
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (pc *PromotionCreate) SetStoreIds(u []uuid.UUID) *PromotionCreate {
+	pc.mutation.SetStoreIds(u)
+	return pc
+}
+
+// SetChannels sets the "channels" field.
+func (pc *PromotionCreate) SetChannels(dc []domain.OrderChannel) *PromotionCreate {
+	pc.mutation.SetChannels(dc)
+	return pc
+}
+
+// SetDiningWays sets the "dining_ways" field.
+func (pc *PromotionCreate) SetDiningWays(dw []domain.DiningWay) *PromotionCreate {
+	pc.mutation.SetDiningWays(dw)
+	return pc
+}
+
+// SetStartAt sets the "start_at" field.
+func (pc *PromotionCreate) SetStartAt(t time.Time) *PromotionCreate {
+	pc.mutation.SetStartAt(t)
+	return pc
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableStartAt(t *time.Time) *PromotionCreate {
+	if t != nil {
+		pc.SetStartAt(*t)
+	}
+	return pc
+}
+
+// SetEndAt sets the "end_at" field.
+func (pc *PromotionCreate) SetEndAt(t time.Time) *PromotionCreate {
+	pc.mutation.SetEndAt(t)
+	return pc
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableEndAt(t *time.Time) *PromotionCreate {
+	if t != nil {
+		pc.SetEndAt(*t)
+	}
+	return pc
+}
+
+// SetTimeWindows sets the "time_windows" field.
+func (pc *PromotionCreate) SetTimeWindows(dtw []domain.PromotionTimeWindow) *PromotionCreate {
+	pc.mutation.SetTimeWindows(dtw)
+	return pc
+}
+
+// SetPriority sets the "priority" field.
+func (pc *PromotionCreate) SetPriority(i int) *PromotionCreate {
+	pc.mutation.SetPriority(i)
+	return pc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillablePriority(i *int) *PromotionCreate {
+	if i != nil {
+		pc.SetPriority(*i)
+	}
+	return pc
+}
+
+// SetStackable sets the "stackable" field.
+func (pc *PromotionCreate) SetStackable(b bool) *PromotionCreate {
+	pc.mutation.SetStackable(b)
+	return pc
+}
+
+// SetNillableStackable sets the "stackable" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableStackable(b *bool) *PromotionCreate {
+	if b != nil {
+		pc.SetStackable(*b)
+	}
+	return pc
+}
+
+// SetEnabled sets the "enabled" field.
+func (pc *PromotionCreate) SetEnabled(b bool) *PromotionCreate {
+	pc.mutation.SetEnabled(b)
+	return pc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableEnabled(b *bool) *PromotionCreate {
+	if b != nil {
+		pc.SetEnabled(*b)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PromotionCreate) SetID(u uuid.UUID) *PromotionCreate {
+	pc.mutation.SetID(u)
+	return pc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableID(u *uuid.UUID) *PromotionCreate {
+	if u != nil {
+		pc.SetID(*u)
+	}
+	return pc
+}
+
+// Mutation returns the PromotionMutation object of the builder.
+func (pc *PromotionCreate) Mutation() *PromotionMutation {
+	return pc.mutation
+}
+
+// Save creates the Promotion in the database.
+func (pc *PromotionCreate) Save(ctx context.Context) (*Promotion, error) {
+	if err := pc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PromotionCreate) SaveX(ctx context.Context) *Promotion {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PromotionCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PromotionCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PromotionCreate) defaults() error {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		if promotion.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promotion.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := promotion.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		if promotion.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promotion.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := promotion.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pc.mutation.DeletedAt(); !ok {
+		v := promotion.DefaultDeletedAt
+		pc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := pc.mutation.StoreID(); !ok {
+		if promotion.DefaultStoreID == nil {
+			return fmt.Errorf("ent: uninitialized promotion.DefaultStoreID (forgotten import ent/runtime?)")
+		}
+		v := promotion.DefaultStoreID()
+		pc.mutation.SetStoreID(v)
+	}
+	if _, ok := pc.mutation.Priority(); !ok {
+		v := promotion.DefaultPriority
+		pc.mutation.SetPriority(v)
+	}
+	if _, ok := pc.mutation.Stackable(); !ok {
+		v := promotion.DefaultStackable
+		pc.mutation.SetStackable(v)
+	}
+	if _, ok := pc.mutation.Enabled(); !ok {
+		v := promotion.DefaultEnabled
+		pc.mutation.SetEnabled(v)
+	}
+	if _, ok := pc.mutation.ID(); !ok {
+		if promotion.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized promotion.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := promotion.DefaultID()
+		pc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PromotionCreate) check() error {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Promotion.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Promotion.updated_at"`)}
+	}
+	if _, ok := pc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "Promotion.deleted_at"`)}
+	}
+	if _, ok := pc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "Promotion.merchant_id"`)}
+	}
+	if _, ok := pc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "Promotion.store_id"`)}
+	}
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Promotion.name"`)}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := promotion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Promotion.name": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Promotion.type"`)}
+	}
+	if v, ok := pc.mutation.GetType(); ok {
+		if err := promotion.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Promotion.type": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Rule(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required field "Promotion.rule"`)}
+	}
+	if _, ok := pc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Promotion.priority"`)}
+	}
+	if _, ok := pc.mutation.Stackable(); !ok {
+		return &ValidationError{Name: "stackable", err: errors.New(`ent: missing required field "Promotion.stackable"`)}
+	}
+	if _, ok := pc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Promotion.enabled"`)}
+	}
+	return nil
+}
+
+func (pc *PromotionCreate) sqlSave(ctx context.Context) (*Promotion, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PromotionCreate) createSpec() (*Promotion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Promotion{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(promotion.Table, sqlgraph.NewFieldSpec(promotion.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pc.conflict
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(promotion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(promotion.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.SetField(promotion.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := pc.mutation.MerchantID(); ok {
+		_spec.SetField(promotion.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := pc.mutation.StoreID(); ok {
+		_spec.SetField(promotion.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.SetField(promotion.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.GetType(); ok {
+		_spec.SetField(promotion.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := pc.mutation.Rule(); ok {
+		_spec.SetField(promotion.FieldRule, field.TypeJSON, value)
+		_node.Rule = value
+	}
+	if value, ok := pc.mutation.StoreIds(); ok {
+		_spec.SetField(promotion.FieldStoreIds, field.TypeJSON, value)
+		_node.StoreIds = value
+	}
+	if value, ok := pc.mutation.Channels(); ok {
+		_spec.SetField(promotion.FieldChannels, field.TypeJSON, value)
+		_node.Channels = value
+	}
+	if value, ok := pc.mutation.DiningWays(); ok {
+		_spec.SetField(promotion.FieldDiningWays, field.TypeJSON, value)
+		_node.DiningWays = value
+	}
+	if value, ok := pc.mutation.StartAt(); ok {
+		_spec.SetField(promotion.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = &value
+	}
+	if value, ok := pc.mutation.EndAt(); ok {
+		_spec.SetField(promotion.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = &value
+	}
+	if value, ok := pc.mutation.TimeWindows(); ok {
+		_spec.SetField(promotion.FieldTimeWindows, field.TypeJSON, value)
+		_node.TimeWindows = value
+	}
+	if value, ok := pc.mutation.Priority(); ok {
+		_spec.SetField(promotion.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := pc.mutation.Stackable(); ok {
+		_spec.SetField(promotion.FieldStackable, field.TypeBool, value)
+		_node.Stackable = value
+	}
+	if value, ok := pc.mutation.Enabled(); ok {
+		_spec.SetField(promotion.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Promotion.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PromotionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PromotionCreate) OnConflict(opts ...sql.ConflictOption) *PromotionUpsertOne {
+	pc.conflict = opts
+	return &PromotionUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Promotion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PromotionCreate) OnConflictColumns(columns ...string) *PromotionUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PromotionUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PromotionUpsertOne is the builder for "upsert"-ing
+	//  one Promotion node.
+	PromotionUpsertOne struct {
+		create *PromotionCreate
+	}
+
+	// PromotionUpsert is the "OnConflict" setter.
+	PromotionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PromotionUpsert) SetUpdatedAt(v time.Time) *PromotionUpsert {
+	u.Set(promotion.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateUpdatedAt() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PromotionUpsert) SetDeletedAt(v int64) *PromotionUpsert {
+	u.Set(promotion.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateDeletedAt() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *PromotionUpsert) AddDeletedAt(v int64) *PromotionUpsert {
+	u.Add(promotion.FieldDeletedAt, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PromotionUpsert) SetName(v string) *PromotionUpsert {
+	u.Set(promotion.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateName() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldName)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *PromotionUpsert) SetType(v domain.PromotionType) *PromotionUpsert {
+	u.Set(promotion.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateType() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldType)
+	return u
+}
+
+// SetRule sets the "rule" field.
+func (u *PromotionUpsert) SetRule(v domain.PromotionRule) *PromotionUpsert {
+	u.Set(promotion.FieldRule, v)
+	return u
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateRule() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldRule)
+	return u
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *PromotionUpsert) SetStoreIds(v []uuid.UUID) *PromotionUpsert {
+	u.Set(promotion.FieldStoreIds, v)
+	return u
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateStoreIds() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldStoreIds)
+	return u
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *PromotionUpsert) ClearStoreIds() *PromotionUpsert {
+	u.SetNull(promotion.FieldStoreIds)
+	return u
+}
+
+// SetChannels sets the "channels" field.
+func (u *PromotionUpsert) SetChannels(v []domain.OrderChannel) *PromotionUpsert {
+	u.Set(promotion.FieldChannels, v)
+	return u
+}
+
+// UpdateChannels sets the "channels" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateChannels() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldChannels)
+	return u
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (u *PromotionUpsert) ClearChannels() *PromotionUpsert {
+	u.SetNull(promotion.FieldChannels)
+	return u
+}
+
+// SetDiningWays sets the "dining_ways" field.
+func (u *PromotionUpsert) SetDiningWays(v []domain.DiningWay) *PromotionUpsert {
+	u.Set(promotion.FieldDiningWays, v)
+	return u
+}
+
+// UpdateDiningWays sets the "dining_ways" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateDiningWays() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldDiningWays)
+	return u
+}
+
+// ClearDiningWays clears the value of the "dining_ways" field.
+func (u *PromotionUpsert) ClearDiningWays() *PromotionUpsert {
+	u.SetNull(promotion.FieldDiningWays)
+	return u
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *PromotionUpsert) SetStartAt(v time.Time) *PromotionUpsert {
+	u.Set(promotion.FieldStartAt, v)
+	return u
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateStartAt() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldStartAt)
+	return u
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (u *PromotionUpsert) ClearStartAt() *PromotionUpsert {
+	u.SetNull(promotion.FieldStartAt)
+	return u
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *PromotionUpsert) SetEndAt(v time.Time) *PromotionUpsert {
+	u.Set(promotion.FieldEndAt, v)
+	return u
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateEndAt() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldEndAt)
+	return u
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *PromotionUpsert) ClearEndAt() *PromotionUpsert {
+	u.SetNull(promotion.FieldEndAt)
+	return u
+}
+
+// SetTimeWindows sets the "time_windows" field.
+func (u *PromotionUpsert) SetTimeWindows(v []domain.PromotionTimeWindow) *PromotionUpsert {
+	u.Set(promotion.FieldTimeWindows, v)
+	return u
+}
+
+// UpdateTimeWindows sets the "time_windows" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateTimeWindows() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldTimeWindows)
+	return u
+}
+
+// ClearTimeWindows clears the value of the "time_windows" field.
+func (u *PromotionUpsert) ClearTimeWindows() *PromotionUpsert {
+	u.SetNull(promotion.FieldTimeWindows)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *PromotionUpsert) SetPriority(v int) *PromotionUpsert {
+	u.Set(promotion.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdatePriority() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *PromotionUpsert) AddPriority(v int) *PromotionUpsert {
+	u.Add(promotion.FieldPriority, v)
+	return u
+}
+
+// SetStackable sets the "stackable" field.
+func (u *PromotionUpsert) SetStackable(v bool) *PromotionUpsert {
+	u.Set(promotion.FieldStackable, v)
+	return u
+}
+
+// UpdateStackable sets the "stackable" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateStackable() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldStackable)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PromotionUpsert) SetEnabled(v bool) *PromotionUpsert {
+	u.Set(promotion.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PromotionUpsert) UpdateEnabled() *PromotionUpsert {
+	u.SetExcluded(promotion.FieldEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Promotion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(promotion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PromotionUpsertOne) UpdateNewValues() *PromotionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(promotion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(promotion.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(promotion.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(promotion.FieldStoreID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Promotion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PromotionUpsertOne) Ignore() *PromotionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PromotionUpsertOne) DoNothing() *PromotionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PromotionCreate.OnConflict
+// documentation for more info.
+func (u *PromotionUpsertOne) Update(set func(*PromotionUpsert)) *PromotionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PromotionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PromotionUpsertOne) SetUpdatedAt(v time.Time) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateUpdatedAt() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PromotionUpsertOne) SetDeletedAt(v int64) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *PromotionUpsertOne) AddDeletedAt(v int64) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateDeletedAt() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PromotionUpsertOne) SetName(v string) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateName() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *PromotionUpsertOne) SetType(v domain.PromotionType) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateType() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetRule sets the "rule" field.
+func (u *PromotionUpsertOne) SetRule(v domain.PromotionRule) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetRule(v)
+	})
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateRule() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateRule()
+	})
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *PromotionUpsertOne) SetStoreIds(v []uuid.UUID) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetStoreIds(v)
+	})
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateStoreIds() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateStoreIds()
+	})
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *PromotionUpsertOne) ClearStoreIds() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearStoreIds()
+	})
+}
+
+// SetChannels sets the "channels" field.
+func (u *PromotionUpsertOne) SetChannels(v []domain.OrderChannel) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetChannels(v)
+	})
+}
+
+// UpdateChannels sets the "channels" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateChannels() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateChannels()
+	})
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (u *PromotionUpsertOne) ClearChannels() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearChannels()
+	})
+}
+
+// SetDiningWays sets the "dining_ways" field.
+func (u *PromotionUpsertOne) SetDiningWays(v []domain.DiningWay) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetDiningWays(v)
+	})
+}
+
+// UpdateDiningWays sets the "dining_ways" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateDiningWays() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateDiningWays()
+	})
+}
+
+// ClearDiningWays clears the value of the "dining_ways" field.
+func (u *PromotionUpsertOne) ClearDiningWays() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearDiningWays()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *PromotionUpsertOne) SetStartAt(v time.Time) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateStartAt() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (u *PromotionUpsertOne) ClearStartAt() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *PromotionUpsertOne) SetEndAt(v time.Time) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateEndAt() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *PromotionUpsertOne) ClearEndAt() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearEndAt()
+	})
+}
+
+// SetTimeWindows sets the "time_windows" field.
+func (u *PromotionUpsertOne) SetTimeWindows(v []domain.PromotionTimeWindow) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetTimeWindows(v)
+	})
+}
+
+// UpdateTimeWindows sets the "time_windows" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateTimeWindows() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateTimeWindows()
+	})
+}
+
+// ClearTimeWindows clears the value of the "time_windows" field.
+func (u *PromotionUpsertOne) ClearTimeWindows() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearTimeWindows()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *PromotionUpsertOne) SetPriority(v int) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *PromotionUpsertOne) AddPriority(v int) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdatePriority() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetStackable sets the "stackable" field.
+func (u *PromotionUpsertOne) SetStackable(v bool) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetStackable(v)
+	})
+}
+
+// UpdateStackable sets the "stackable" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateStackable() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateStackable()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PromotionUpsertOne) SetEnabled(v bool) *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PromotionUpsertOne) UpdateEnabled() *PromotionUpsertOne {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *PromotionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PromotionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PromotionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PromotionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PromotionUpsertOne.ID is not supported by MySQL driver. Use PromotionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PromotionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PromotionCreateBulk is the builder for creating many Promotion entities in bulk.
+type PromotionCreateBulk struct {
+	config
+	err      error
+	builders []*PromotionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Promotion entities in the database.
+func (pcb *PromotionCreateBulk) Save(ctx context.Context) ([]*Promotion, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Promotion, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromotionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PromotionCreateBulk) SaveX(ctx context.Context) []*Promotion {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PromotionCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PromotionCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Promotion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PromotionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PromotionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PromotionUpsertBulk {
+	pcb.conflict = opts
+	return &PromotionUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Promotion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PromotionCreateBulk) OnConflictColumns(columns ...string) *PromotionUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PromotionUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PromotionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Promotion nodes.
+type PromotionUpsertBulk struct {
+	create *PromotionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Promotion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(promotion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PromotionUpsertBulk) UpdateNewValues() *PromotionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(promotion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(promotion.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(promotion.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(promotion.FieldStoreID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Promotion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PromotionUpsertBulk) Ignore() *PromotionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PromotionUpsertBulk) DoNothing() *PromotionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PromotionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PromotionUpsertBulk) Update(set func(*PromotionUpsert)) *PromotionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PromotionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PromotionUpsertBulk) SetUpdatedAt(v time.Time) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateUpdatedAt() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PromotionUpsertBulk) SetDeletedAt(v int64) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *PromotionUpsertBulk) AddDeletedAt(v int64) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateDeletedAt() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PromotionUpsertBulk) SetName(v string) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateName() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *PromotionUpsertBulk) SetType(v domain.PromotionType) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateType() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetRule sets the "rule" field.
+func (u *PromotionUpsertBulk) SetRule(v domain.PromotionRule) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetRule(v)
+	})
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateRule() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateRule()
+	})
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (u *PromotionUpsertBulk) SetStoreIds(v []uuid.UUID) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetStoreIds(v)
+	})
+}
+
+// UpdateStoreIds sets the "store_ids" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateStoreIds() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateStoreIds()
+	})
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (u *PromotionUpsertBulk) ClearStoreIds() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearStoreIds()
+	})
+}
+
+// SetChannels sets the "channels" field.
+func (u *PromotionUpsertBulk) SetChannels(v []domain.OrderChannel) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetChannels(v)
+	})
+}
+
+// UpdateChannels sets the "channels" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateChannels() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateChannels()
+	})
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (u *PromotionUpsertBulk) ClearChannels() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearChannels()
+	})
+}
+
+// SetDiningWays sets the "dining_ways" field.
+func (u *PromotionUpsertBulk) SetDiningWays(v []domain.DiningWay) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetDiningWays(v)
+	})
+}
+
+// UpdateDiningWays sets the "dining_ways" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateDiningWays() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateDiningWays()
+	})
+}
+
+// ClearDiningWays clears the value of the "dining_ways" field.
+func (u *PromotionUpsertBulk) ClearDiningWays() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearDiningWays()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *PromotionUpsertBulk) SetStartAt(v time.Time) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateStartAt() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (u *PromotionUpsertBulk) ClearStartAt() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *PromotionUpsertBulk) SetEndAt(v time.Time) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateEndAt() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *PromotionUpsertBulk) ClearEndAt() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearEndAt()
+	})
+}
+
+// SetTimeWindows sets the "time_windows" field.
+func (u *PromotionUpsertBulk) SetTimeWindows(v []domain.PromotionTimeWindow) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetTimeWindows(v)
+	})
+}
+
+// UpdateTimeWindows sets the "time_windows" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateTimeWindows() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateTimeWindows()
+	})
+}
+
+// ClearTimeWindows clears the value of the "time_windows" field.
+func (u *PromotionUpsertBulk) ClearTimeWindows() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.ClearTimeWindows()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *PromotionUpsertBulk) SetPriority(v int) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *PromotionUpsertBulk) AddPriority(v int) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdatePriority() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetStackable sets the "stackable" field.
+func (u *PromotionUpsertBulk) SetStackable(v bool) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetStackable(v)
+	})
+}
+
+// UpdateStackable sets the "stackable" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateStackable() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateStackable()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PromotionUpsertBulk) SetEnabled(v bool) *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PromotionUpsertBulk) UpdateEnabled() *PromotionUpsertBulk {
+	return u.Update(func(s *PromotionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *PromotionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PromotionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PromotionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PromotionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,893 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/promotion"
+)
+
+// PromotionUpdate is the builder for updating Promotion entities.
+type PromotionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PromotionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PromotionUpdate builder.
+func (pu *PromotionUpdate) Where(ps ...predicate.Promotion) *PromotionUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PromotionUpdate) SetUpdatedAt(t time.Time) *PromotionUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *PromotionUpdate) SetDeletedAt(i int64) *PromotionUpdate {
+	pu.mutation.ResetDeletedAt()
+	pu.mutation.SetDeletedAt(i)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableDeletedAt(i *int64) *PromotionUpdate {
+	if i != nil {
+		pu.SetDeletedAt(*i)
+	}
+	return pu
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (pu *PromotionUpdate) AddDeletedAt(i int64) *PromotionUpdate {
+	pu.mutation.AddDeletedAt(i)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PromotionUpdate) SetName(s string) *PromotionUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableName(s *string) *PromotionUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetType sets the "type" field.
+func (pu *PromotionUpdate) SetType(dt domain.PromotionType) *PromotionUpdate {
+	pu.mutation.SetType(dt)
+	return pu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableType(dt *domain.PromotionType) *PromotionUpdate {
+	if dt != nil {
+		pu.SetType(*dt)
+	}
+	return pu
+}
+
+// SetRule sets the "rule" field.
+func (pu *PromotionUpdate) SetRule(dr domain.PromotionRule) *PromotionUpdate {
+	pu.mutation.SetRule(dr)
+	return pu
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableRule(dr *domain.PromotionRule) *PromotionUpdate {
+	if dr != nil {
+		pu.SetRule(*dr)
+	}
+	return pu
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (pu *PromotionUpdate) SetStoreIds(u []uuid.UUID) *PromotionUpdate {
+	pu.mutation.SetStoreIds(u)
+	return pu
+}
+
+// AppendStoreIds appends u to the "store_ids" field.
+func (pu *PromotionUpdate) AppendStoreIds(u []uuid.UUID) *PromotionUpdate {
+	pu.mutation.AppendStoreIds(u)
+	return pu
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (pu *PromotionUpdate) ClearStoreIds() *PromotionUpdate {
+	pu.mutation.ClearStoreIds()
+	return pu
+}
+
+// SetChannels sets the "channels" field.
+func (pu *PromotionUpdate) SetChannels(dc []domain.OrderChannel) *PromotionUpdate {
+	pu.mutation.SetChannels(dc)
+	return pu
+}
+
+// AppendChannels appends dc to the "channels" field.
+func (pu *PromotionUpdate) AppendChannels(dc []domain.OrderChannel) *PromotionUpdate {
+	pu.mutation.AppendChannels(dc)
+	return pu
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (pu *PromotionUpdate) ClearChannels() *PromotionUpdate {
+	pu.mutation.ClearChannels()
+	return pu
+}
+
+// SetDiningWays sets the "dining_ways" field.
+func (pu *PromotionUpdate) SetDiningWays(dw []domain.DiningWay) *PromotionUpdate {
+	pu.mutation.SetDiningWays(dw)
+	return pu
+}
+
+// AppendDiningWays appends dw to the "dining_ways" field.
+func (pu *PromotionUpdate) AppendDiningWays(dw []domain.DiningWay) *PromotionUpdate {
+	pu.mutation.AppendDiningWays(dw)
+	return pu
+}
+
+// ClearDiningWays clears the value of the "dining_ways" field.
+func (pu *PromotionUpdate) ClearDiningWays() *PromotionUpdate {
+	pu.mutation.ClearDiningWays()
+	return pu
+}
+
+// SetStartAt sets the "start_at" field.
+func (pu *PromotionUpdate) SetStartAt(t time.Time) *PromotionUpdate {
+	pu.mutation.SetStartAt(t)
+	return pu
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableStartAt(t *time.Time) *PromotionUpdate {
+	if t != nil {
+		pu.SetStartAt(*t)
+	}
+	return pu
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (pu *PromotionUpdate) ClearStartAt() *PromotionUpdate {
+	pu.mutation.ClearStartAt()
+	return pu
+}
+
+// SetEndAt sets the "end_at" field.
+func (pu *PromotionUpdate) SetEndAt(t time.Time) *PromotionUpdate {
+	pu.mutation.SetEndAt(t)
+	return pu
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableEndAt(t *time.Time) *PromotionUpdate {
+	if t != nil {
+		pu.SetEndAt(*t)
+	}
+	return pu
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (pu *PromotionUpdate) ClearEndAt() *PromotionUpdate {
+	pu.mutation.ClearEndAt()
+	return pu
+}
+
+// SetTimeWindows sets the "time_windows" field.
+func (pu *PromotionUpdate) SetTimeWindows(dtw []domain.PromotionTimeWindow) *PromotionUpdate {
+	pu.mutation.SetTimeWindows(dtw)
+	return pu
+}
+
+// AppendTimeWindows appends dtw to the "time_windows" field.
+func (pu *PromotionUpdate) AppendTimeWindows(dtw []domain.PromotionTimeWindow) *PromotionUpdate {
+	pu.mutation.AppendTimeWindows(dtw)
+	return pu
+}
+
+// ClearTimeWindows clears the value of the "time_windows" field.
+func (pu *PromotionUpdate) ClearTimeWindows() *PromotionUpdate {
+	pu.mutation.ClearTimeWindows()
+	return pu
+}
+
+// SetPriority sets the "priority" field.
+func (pu *PromotionUpdate) SetPriority(i int) *PromotionUpdate {
+	pu.mutation.ResetPriority()
+	pu.mutation.SetPriority(i)
+	return pu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillablePriority(i *int) *PromotionUpdate {
+	if i != nil {
+		pu.SetPriority(*i)
+	}
+	return pu
+}
+
+// AddPriority adds i to the "priority" field.
+func (pu *PromotionUpdate) AddPriority(i int) *PromotionUpdate {
+	pu.mutation.AddPriority(i)
+	return pu
+}
+
+// SetStackable sets the "stackable" field.
+func (pu *PromotionUpdate) SetStackable(b bool) *PromotionUpdate {
+	pu.mutation.SetStackable(b)
+	return pu
+}
+
+// SetNillableStackable sets the "stackable" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableStackable(b *bool) *PromotionUpdate {
+	if b != nil {
+		pu.SetStackable(*b)
+	}
+	return pu
+}
+
+// SetEnabled sets the "enabled" field.
+func (pu *PromotionUpdate) SetEnabled(b bool) *PromotionUpdate {
+	pu.mutation.SetEnabled(b)
+	return pu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableEnabled(b *bool) *PromotionUpdate {
+	if b != nil {
+		pu.SetEnabled(*b)
+	}
+	return pu
+}
+
+// Mutation returns the PromotionMutation object of the builder.
+func (pu *PromotionUpdate) Mutation() *PromotionMutation {
+	return pu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PromotionUpdate) Save(ctx context.Context) (int, error) {
+	if err := pu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PromotionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PromotionUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PromotionUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PromotionUpdate) defaults() error {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		if promotion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promotion.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := promotion.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PromotionUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := promotion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Promotion.name": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.GetType(); ok {
+		if err := promotion.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Promotion.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pu *PromotionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PromotionUpdate {
+	pu.modifiers = append(pu.modifiers, modifiers...)
+	return pu
+}
+
+func (pu *PromotionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promotion.Table, promotion.Columns, sqlgraph.NewFieldSpec(promotion.FieldID, field.TypeUUID))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(promotion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.SetField(promotion.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(promotion.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(promotion.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.GetType(); ok {
+		_spec.SetField(promotion.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := pu.mutation.Rule(); ok {
+		_spec.SetField(promotion.FieldRule, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.StoreIds(); ok {
+		_spec.SetField(promotion.FieldStoreIds, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedStoreIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, promotion.FieldStoreIds, value)
+		})
+	}
+	if pu.mutation.StoreIdsCleared() {
+		_spec.ClearField(promotion.FieldStoreIds, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.Channels(); ok {
+		_spec.SetField(promotion.FieldChannels, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, promotion.FieldChannels, value)
+		})
+	}
+	if pu.mutation.ChannelsCleared() {
+		_spec.ClearField(promotion.FieldChannels, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.DiningWays(); ok {
+		_spec.SetField(promotion.FieldDiningWays, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedDiningWays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, promotion.FieldDiningWays, value)
+		})
+	}
+	if pu.mutation.DiningWaysCleared() {
+		_spec.ClearField(promotion.FieldDiningWays, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.StartAt(); ok {
+		_spec.SetField(promotion.FieldStartAt, field.TypeTime, value)
+	}
+	if pu.mutation.StartAtCleared() {
+		_spec.ClearField(promotion.FieldStartAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.EndAt(); ok {
+		_spec.SetField(promotion.FieldEndAt, field.TypeTime, value)
+	}
+	if pu.mutation.EndAtCleared() {
+		_spec.ClearField(promotion.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.TimeWindows(); ok {
+		_spec.SetField(promotion.FieldTimeWindows, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedTimeWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, promotion.FieldTimeWindows, value)
+		})
+	}
+	if pu.mutation.TimeWindowsCleared() {
+		_spec.ClearField(promotion.FieldTimeWindows, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.Priority(); ok {
+		_spec.SetField(promotion.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedPriority(); ok {
+		_spec.AddField(promotion.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.Stackable(); ok {
+		_spec.SetField(promotion.FieldStackable, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.Enabled(); ok {
+		_spec.SetField(promotion.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(pu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promotion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PromotionUpdateOne is the builder for updating a single Promotion entity.
+type PromotionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PromotionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PromotionUpdateOne) SetUpdatedAt(t time.Time) *PromotionUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *PromotionUpdateOne) SetDeletedAt(i int64) *PromotionUpdateOne {
+	puo.mutation.ResetDeletedAt()
+	puo.mutation.SetDeletedAt(i)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableDeletedAt(i *int64) *PromotionUpdateOne {
+	if i != nil {
+		puo.SetDeletedAt(*i)
+	}
+	return puo
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (puo *PromotionUpdateOne) AddDeletedAt(i int64) *PromotionUpdateOne {
+	puo.mutation.AddDeletedAt(i)
+	return puo
+}
+
+// SetName sets the "name" field.
+func (puo *PromotionUpdateOne) SetName(s string) *PromotionUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableName(s *string) *PromotionUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetType sets the "type" field.
+func (puo *PromotionUpdateOne) SetType(dt domain.PromotionType) *PromotionUpdateOne {
+	puo.mutation.SetType(dt)
+	return puo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableType(dt *domain.PromotionType) *PromotionUpdateOne {
+	if dt != nil {
+		puo.SetType(*dt)
+	}
+	return puo
+}
+
+// SetRule sets the "rule" field.
+func (puo *PromotionUpdateOne) SetRule(dr domain.PromotionRule) *PromotionUpdateOne {
+	puo.mutation.SetRule(dr)
+	return puo
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableRule(dr *domain.PromotionRule) *PromotionUpdateOne {
+	if dr != nil {
+		puo.SetRule(*dr)
+	}
+	return puo
+}
+
+// SetStoreIds sets the "store_ids" field.
+func (puo *PromotionUpdateOne) SetStoreIds(u []uuid.UUID) *PromotionUpdateOne {
+	puo.mutation.SetStoreIds(u)
+	return puo
+}
+
+// AppendStoreIds appends u to the "store_ids" field.
+func (puo *PromotionUpdateOne) AppendStoreIds(u []uuid.UUID) *PromotionUpdateOne {
+	puo.mutation.AppendStoreIds(u)
+	return puo
+}
+
+// ClearStoreIds clears the value of the "store_ids" field.
+func (puo *PromotionUpdateOne) ClearStoreIds() *PromotionUpdateOne {
+	puo.mutation.ClearStoreIds()
+	return puo
+}
+
+// SetChannels sets the "channels" field.
+func (puo *PromotionUpdateOne) SetChannels(dc []domain.OrderChannel) *PromotionUpdateOne {
+	puo.mutation.SetChannels(dc)
+	return puo
+}
+
+// AppendChannels appends dc to the "channels" field.
+func (puo *PromotionUpdateOne) AppendChannels(dc []domain.OrderChannel) *PromotionUpdateOne {
+	puo.mutation.AppendChannels(dc)
+	return puo
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (puo *PromotionUpdateOne) ClearChannels() *PromotionUpdateOne {
+	puo.mutation.ClearChannels()
+	return puo
+}
+
+// SetDiningWays sets the "dining_ways" field.
+func (puo *PromotionUpdateOne) SetDiningWays(dw []domain.DiningWay) *PromotionUpdateOne {
+	puo.mutation.SetDiningWays(dw)
+	return puo
+}
+
+// AppendDiningWays appends dw to the "dining_ways" field.
+func (puo *PromotionUpdateOne) AppendDiningWays(dw []domain.DiningWay) *PromotionUpdateOne {
+	puo.mutation.AppendDiningWays(dw)
+	return puo
+}
+
+// ClearDiningWays clears the value of the "dining_ways" field.
+func (puo *PromotionUpdateOne) ClearDiningWays() *PromotionUpdateOne {
+	puo.mutation.ClearDiningWays()
+	return puo
+}
+
+// SetStartAt sets the "start_at" field.
+func (puo *PromotionUpdateOne) SetStartAt(t time.Time) *PromotionUpdateOne {
+	puo.mutation.SetStartAt(t)
+	return puo
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableStartAt(t *time.Time) *PromotionUpdateOne {
+	if t != nil {
+		puo.SetStartAt(*t)
+	}
+	return puo
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (puo *PromotionUpdateOne) ClearStartAt() *PromotionUpdateOne {
+	puo.mutation.ClearStartAt()
+	return puo
+}
+
+// SetEndAt sets the "end_at" field.
+func (puo *PromotionUpdateOne) SetEndAt(t time.Time) *PromotionUpdateOne {
+	puo.mutation.SetEndAt(t)
+	return puo
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableEndAt(t *time.Time) *PromotionUpdateOne {
+	if t != nil {
+		puo.SetEndAt(*t)
+	}
+	return puo
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (puo *PromotionUpdateOne) ClearEndAt() *PromotionUpdateOne {
+	puo.mutation.ClearEndAt()
+	return puo
+}
+
+// SetTimeWindows sets the "time_windows" field.
+func (puo *PromotionUpdateOne) SetTimeWindows(dtw []domain.PromotionTimeWindow) *PromotionUpdateOne {
+	puo.mutation.SetTimeWindows(dtw)
+	return puo
+}
+
+// AppendTimeWindows appends dtw to the "time_windows" field.
+func (puo *PromotionUpdateOne) AppendTimeWindows(dtw []domain.PromotionTimeWindow) *PromotionUpdateOne {
+	puo.mutation.AppendTimeWindows(dtw)
+	return puo
+}
+
+// ClearTimeWindows clears the value of the "time_windows" field.
+func (puo *PromotionUpdateOne) ClearTimeWindows() *PromotionUpdateOne {
+	puo.mutation.ClearTimeWindows()
+	return puo
+}
+
+// SetPriority sets the "priority" field.
+func (puo *PromotionUpdateOne) SetPriority(i int) *PromotionUpdateOne {
+	puo.mutation.ResetPriority()
+	puo.mutation.SetPriority(i)
+	return puo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillablePriority(i *int) *PromotionUpdateOne {
+	if i != nil {
+		puo.SetPriority(*i)
+	}
+	return puo
+}
+
+// AddPriority adds i to the "priority" field.
+func (puo *PromotionUpdateOne) AddPriority(i int) *PromotionUpdateOne {
+	puo.mutation.AddPriority(i)
+	return puo
+}
+
+// SetStackable sets the "stackable" field.
+func (puo *PromotionUpdateOne) SetStackable(b bool) *PromotionUpdateOne {
+	puo.mutation.SetStackable(b)
+	return puo
+}
+
+// SetNillableStackable sets the "stackable" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableStackable(b *bool) *PromotionUpdateOne {
+	if b != nil {
+		puo.SetStackable(*b)
+	}
+	return puo
+}
+
+// SetEnabled sets the "enabled" field.
+func (puo *PromotionUpdateOne) SetEnabled(b bool) *PromotionUpdateOne {
+	puo.mutation.SetEnabled(b)
+	return puo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableEnabled(b *bool) *PromotionUpdateOne {
+	if b != nil {
+		puo.SetEnabled(*b)
+	}
+	return puo
+}
+
+// Mutation returns the PromotionMutation object of the builder.
+func (puo *PromotionUpdateOne) Mutation() *PromotionMutation {
+	return puo.mutation
+}
+
+// Where appends a list predicates to the PromotionUpdate builder.
+func (puo *PromotionUpdateOne) Where(ps ...predicate.Promotion) *PromotionUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PromotionUpdateOne) Select(field string, fields ...string) *PromotionUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Promotion entity.
+func (puo *PromotionUpdateOne) Save(ctx context.Context) (*Promotion, error) {
+	if err := puo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PromotionUpdateOne) SaveX(ctx context.Context) *Promotion {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PromotionUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PromotionUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PromotionUpdateOne) defaults() error {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		if promotion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promotion.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := promotion.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PromotionUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := promotion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Promotion.name": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.GetType(); ok {
+		if err := promotion.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Promotion.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (puo *PromotionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PromotionUpdateOne {
+	puo.modifiers = append(puo.modifiers, modifiers...)
+	return puo
+}
+
+func (puo *PromotionUpdateOne) sqlSave(ctx context.Context) (_node *Promotion, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promotion.Table, promotion.Columns, sqlgraph.NewFieldSpec(promotion.FieldID, field.TypeUUID))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Promotion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promotion.FieldID)
+		for _, f := range fields {
+			if !promotion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != promotion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(promotion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.SetField(promotion.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(promotion.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(promotion.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.GetType(); ok {
+		_spec.SetField(promotion.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := puo.mutation.Rule(); ok {
+		_spec.SetField(promotion.FieldRule, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.StoreIds(); ok {
+		_spec.SetField(promotion.FieldStoreIds, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedStoreIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, promotion.FieldStoreIds, value)
+		})
+	}
+	if puo.mutation.StoreIdsCleared() {
+		_spec.ClearField(promotion.FieldStoreIds, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.Channels(); ok {
+		_spec.SetField(promotion.FieldChannels, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, promotion.FieldChannels, value)
+		})
+	}
+	if puo.mutation.ChannelsCleared() {
+		_spec.ClearField(promotion.FieldChannels, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.DiningWays(); ok {
+		_spec.SetField(promotion.FieldDiningWays, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedDiningWays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, promotion.FieldDiningWays, value)
+		})
+	}
+	if puo.mutation.DiningWaysCleared() {
+		_spec.ClearField(promotion.FieldDiningWays, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.StartAt(); ok {
+		_spec.SetField(promotion.FieldStartAt, field.TypeTime, value)
+	}
+	if puo.mutation.StartAtCleared() {
+		_spec.ClearField(promotion.FieldStartAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.EndAt(); ok {
+		_spec.SetField(promotion.FieldEndAt, field.TypeTime, value)
+	}
+	if puo.mutation.EndAtCleared() {
+		_spec.ClearField(promotion.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.TimeWindows(); ok {
+		_spec.SetField(promotion.FieldTimeWindows, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedTimeWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, promotion.FieldTimeWindows, value)
+		})
+	}
+	if puo.mutation.TimeWindowsCleared() {
+		_spec.ClearField(promotion.FieldTimeWindows, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.Priority(); ok {
+		_spec.SetField(promotion.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedPriority(); ok {
+		_spec.AddField(promotion.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.Stackable(); ok {
+		_spec.SetField(promotion.FieldStackable, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.Enabled(); ok {
+		_spec.SetField(promotion.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(puo.modifiers...)
+	_node = &Promotion{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promotion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
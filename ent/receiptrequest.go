@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/receiptrequest"
+)
+
+// ReceiptRequest is the model entity for the ReceiptRequest schema.
+type ReceiptRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 订单ID
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// 订单号
+	OrderNo string `json:"order_no,omitempty"`
+	// 申请类型：refund=退款；invoice=开票
+	Type domain.ReceiptRequestType `json:"type,omitempty"`
+	// 状态：pending=待处理；accepted=已受理；rejected=已拒绝
+	Status domain.ReceiptRequestStatus `json:"status,omitempty"`
+	// 退款原因/开票备注
+	Reason string `json:"reason,omitempty"`
+	// 联系电话
+	ContactPhone string `json:"contact_phone,omitempty"`
+	// 发票抬头
+	InvoiceTitle string `json:"invoice_title,omitempty"`
+	// 纳税人识别号
+	InvoiceTaxNo string `json:"invoice_tax_no,omitempty"`
+	// 接收发票邮箱
+	InvoiceEmail string `json:"invoice_email,omitempty"`
+	// 处理说明
+	HandleRemark string `json:"handle_remark,omitempty"`
+	// 处理人ID
+	HandledBy uuid.UUID `json:"handled_by,omitempty"`
+	// 处理时间
+	HandledAt    *time.Time `json:"handled_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReceiptRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case receiptrequest.FieldOrderNo, receiptrequest.FieldType, receiptrequest.FieldStatus, receiptrequest.FieldReason, receiptrequest.FieldContactPhone, receiptrequest.FieldInvoiceTitle, receiptrequest.FieldInvoiceTaxNo, receiptrequest.FieldInvoiceEmail, receiptrequest.FieldHandleRemark:
+			values[i] = new(sql.NullString)
+		case receiptrequest.FieldCreatedAt, receiptrequest.FieldUpdatedAt, receiptrequest.FieldHandledAt:
+			values[i] = new(sql.NullTime)
+		case receiptrequest.FieldID, receiptrequest.FieldMerchantID, receiptrequest.FieldStoreID, receiptrequest.FieldOrderID, receiptrequest.FieldHandledBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReceiptRequest fields.
+func (rr *ReceiptRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case receiptrequest.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rr.ID = *value
+			}
+		case receiptrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rr.CreatedAt = value.Time
+			}
+		case receiptrequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rr.UpdatedAt = value.Time
+			}
+		case receiptrequest.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				rr.MerchantID = *value
+			}
+		case receiptrequest.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				rr.StoreID = *value
+			}
+		case receiptrequest.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				rr.OrderID = *value
+			}
+		case receiptrequest.FieldOrderNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_no", values[i])
+			} else if value.Valid {
+				rr.OrderNo = value.String
+			}
+		case receiptrequest.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				rr.Type = domain.ReceiptRequestType(value.String)
+			}
+		case receiptrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				rr.Status = domain.ReceiptRequestStatus(value.String)
+			}
+		case receiptrequest.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				rr.Reason = value.String
+			}
+		case receiptrequest.FieldContactPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field contact_phone", values[i])
+			} else if value.Valid {
+				rr.ContactPhone = value.String
+			}
+		case receiptrequest.FieldInvoiceTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_title", values[i])
+			} else if value.Valid {
+				rr.InvoiceTitle = value.String
+			}
+		case receiptrequest.FieldInvoiceTaxNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_tax_no", values[i])
+			} else if value.Valid {
+				rr.InvoiceTaxNo = value.String
+			}
+		case receiptrequest.FieldInvoiceEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_email", values[i])
+			} else if value.Valid {
+				rr.InvoiceEmail = value.String
+			}
+		case receiptrequest.FieldHandleRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handle_remark", values[i])
+			} else if value.Valid {
+				rr.HandleRemark = value.String
+			}
+		case receiptrequest.FieldHandledBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field handled_by", values[i])
+			} else if value != nil {
+				rr.HandledBy = *value
+			}
+		case receiptrequest.FieldHandledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field handled_at", values[i])
+			} else if value.Valid {
+				rr.HandledAt = new(time.Time)
+				*rr.HandledAt = value.Time
+			}
+		default:
+			rr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReceiptRequest.
+// This includes values selected through modifiers, order, etc.
+func (rr *ReceiptRequest) Value(name string) (ent.Value, error) {
+	return rr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ReceiptRequest.
+// Note that you need to call ReceiptRequest.Unwrap() before calling this method if this ReceiptRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rr *ReceiptRequest) Update() *ReceiptRequestUpdateOne {
+	return NewReceiptRequestClient(rr.config).UpdateOne(rr)
+}
+
+// Unwrap unwraps the ReceiptRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rr *ReceiptRequest) Unwrap() *ReceiptRequest {
+	_tx, ok := rr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReceiptRequest is not a transactional entity")
+	}
+	rr.config.driver = _tx.drv
+	return rr
+}
+
+// String implements the fmt.Stringer.
+func (rr *ReceiptRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReceiptRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", rr.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", rr.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", rr.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("order_no=")
+	builder.WriteString(rr.OrderNo)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Type))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(rr.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("contact_phone=")
+	builder.WriteString(rr.ContactPhone)
+	builder.WriteString(", ")
+	builder.WriteString("invoice_title=")
+	builder.WriteString(rr.InvoiceTitle)
+	builder.WriteString(", ")
+	builder.WriteString("invoice_tax_no=")
+	builder.WriteString(rr.InvoiceTaxNo)
+	builder.WriteString(", ")
+	builder.WriteString("invoice_email=")
+	builder.WriteString(rr.InvoiceEmail)
+	builder.WriteString(", ")
+	builder.WriteString("handle_remark=")
+	builder.WriteString(rr.HandleRemark)
+	builder.WriteString(", ")
+	builder.WriteString("handled_by=")
+	builder.WriteString(fmt.Sprintf("%v", rr.HandledBy))
+	builder.WriteString(", ")
+	if v := rr.HandledAt; v != nil {
+		builder.WriteString("handled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReceiptRequests is a parsable slice of ReceiptRequest.
+type ReceiptRequests []*ReceiptRequest
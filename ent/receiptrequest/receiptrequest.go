@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package receiptrequest
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	// Label holds the string label denoting the receiptrequest type in the database.
+	Label = "receipt_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldOrderNo holds the string denoting the order_no field in the database.
+	FieldOrderNo = "order_no"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldContactPhone holds the string denoting the contact_phone field in the database.
+	FieldContactPhone = "contact_phone"
+	// FieldInvoiceTitle holds the string denoting the invoice_title field in the database.
+	FieldInvoiceTitle = "invoice_title"
+	// FieldInvoiceTaxNo holds the string denoting the invoice_tax_no field in the database.
+	FieldInvoiceTaxNo = "invoice_tax_no"
+	// FieldInvoiceEmail holds the string denoting the invoice_email field in the database.
+	FieldInvoiceEmail = "invoice_email"
+	// FieldHandleRemark holds the string denoting the handle_remark field in the database.
+	FieldHandleRemark = "handle_remark"
+	// FieldHandledBy holds the string denoting the handled_by field in the database.
+	FieldHandledBy = "handled_by"
+	// FieldHandledAt holds the string denoting the handled_at field in the database.
+	FieldHandledAt = "handled_at"
+	// Table holds the table name of the receiptrequest in the database.
+	Table = "receipt_requests"
+)
+
+// Columns holds all SQL columns for receiptrequest fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldOrderID,
+	FieldOrderNo,
+	FieldType,
+	FieldStatus,
+	FieldReason,
+	FieldContactPhone,
+	FieldInvoiceTitle,
+	FieldInvoiceTaxNo,
+	FieldInvoiceEmail,
+	FieldHandleRemark,
+	FieldHandledBy,
+	FieldHandledAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultOrderNo holds the default value on creation for the "order_no" field.
+	DefaultOrderNo string
+	// OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	OrderNoValidator func(string) error
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+	// DefaultContactPhone holds the default value on creation for the "contact_phone" field.
+	DefaultContactPhone string
+	// ContactPhoneValidator is a validator for the "contact_phone" field. It is called by the builders before save.
+	ContactPhoneValidator func(string) error
+	// DefaultInvoiceTitle holds the default value on creation for the "invoice_title" field.
+	DefaultInvoiceTitle string
+	// InvoiceTitleValidator is a validator for the "invoice_title" field. It is called by the builders before save.
+	InvoiceTitleValidator func(string) error
+	// DefaultInvoiceTaxNo holds the default value on creation for the "invoice_tax_no" field.
+	DefaultInvoiceTaxNo string
+	// InvoiceTaxNoValidator is a validator for the "invoice_tax_no" field. It is called by the builders before save.
+	InvoiceTaxNoValidator func(string) error
+	// DefaultInvoiceEmail holds the default value on creation for the "invoice_email" field.
+	DefaultInvoiceEmail string
+	// InvoiceEmailValidator is a validator for the "invoice_email" field. It is called by the builders before save.
+	InvoiceEmailValidator func(string) error
+	// DefaultHandleRemark holds the default value on creation for the "handle_remark" field.
+	DefaultHandleRemark string
+	// HandleRemarkValidator is a validator for the "handle_remark" field. It is called by the builders before save.
+	HandleRemarkValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type domain.ReceiptRequestType) error {
+	switch _type {
+	case "refund", "invoice":
+		return nil
+	default:
+		return fmt.Errorf("receiptrequest: invalid enum value for type field: %q", _type)
+	}
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s domain.ReceiptRequestStatus) error {
+	switch s {
+	case "pending", "accepted", "rejected":
+		return nil
+	default:
+		return fmt.Errorf("receiptrequest: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ReceiptRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByOrderNo orders the results by the order_no field.
+func ByOrderNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderNo, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByContactPhone orders the results by the contact_phone field.
+func ByContactPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContactPhone, opts...).ToFunc()
+}
+
+// ByInvoiceTitle orders the results by the invoice_title field.
+func ByInvoiceTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceTitle, opts...).ToFunc()
+}
+
+// ByInvoiceTaxNo orders the results by the invoice_tax_no field.
+func ByInvoiceTaxNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceTaxNo, opts...).ToFunc()
+}
+
+// ByInvoiceEmail orders the results by the invoice_email field.
+func ByInvoiceEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceEmail, opts...).ToFunc()
+}
+
+// ByHandleRemark orders the results by the handle_remark field.
+func ByHandleRemark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandleRemark, opts...).ToFunc()
+}
+
+// ByHandledBy orders the results by the handled_by field.
+func ByHandledBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandledBy, opts...).ToFunc()
+}
+
+// ByHandledAt orders the results by the handled_at field.
+func ByHandledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandledAt, opts...).ToFunc()
+}
@@ -0,0 +1,957 @@
+// Code generated by ent, DO NOT EDIT.
+
+package receiptrequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldStoreID, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderNo applies equality check predicate on the "order_no" field. It's identical to OrderNoEQ.
+func OrderNo(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// ContactPhone applies equality check predicate on the "contact_phone" field. It's identical to ContactPhoneEQ.
+func ContactPhone(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldContactPhone, v))
+}
+
+// InvoiceTitle applies equality check predicate on the "invoice_title" field. It's identical to InvoiceTitleEQ.
+func InvoiceTitle(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldInvoiceTitle, v))
+}
+
+// InvoiceTaxNo applies equality check predicate on the "invoice_tax_no" field. It's identical to InvoiceTaxNoEQ.
+func InvoiceTaxNo(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceEmail applies equality check predicate on the "invoice_email" field. It's identical to InvoiceEmailEQ.
+func InvoiceEmail(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldInvoiceEmail, v))
+}
+
+// HandleRemark applies equality check predicate on the "handle_remark" field. It's identical to HandleRemarkEQ.
+func HandleRemark(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldHandleRemark, v))
+}
+
+// HandledBy applies equality check predicate on the "handled_by" field. It's identical to HandledByEQ.
+func HandledBy(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldHandledBy, v))
+}
+
+// HandledAt applies equality check predicate on the "handled_at" field. It's identical to HandledAtEQ.
+func HandledAt(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldHandledAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldStoreID, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderNoEQ applies the EQ predicate on the "order_no" field.
+func OrderNoEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldOrderNo, v))
+}
+
+// OrderNoNEQ applies the NEQ predicate on the "order_no" field.
+func OrderNoNEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldOrderNo, v))
+}
+
+// OrderNoIn applies the In predicate on the "order_no" field.
+func OrderNoIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldOrderNo, vs...))
+}
+
+// OrderNoNotIn applies the NotIn predicate on the "order_no" field.
+func OrderNoNotIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldOrderNo, vs...))
+}
+
+// OrderNoGT applies the GT predicate on the "order_no" field.
+func OrderNoGT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldOrderNo, v))
+}
+
+// OrderNoGTE applies the GTE predicate on the "order_no" field.
+func OrderNoGTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldOrderNo, v))
+}
+
+// OrderNoLT applies the LT predicate on the "order_no" field.
+func OrderNoLT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldOrderNo, v))
+}
+
+// OrderNoLTE applies the LTE predicate on the "order_no" field.
+func OrderNoLTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldOrderNo, v))
+}
+
+// OrderNoContains applies the Contains predicate on the "order_no" field.
+func OrderNoContains(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContains(FieldOrderNo, v))
+}
+
+// OrderNoHasPrefix applies the HasPrefix predicate on the "order_no" field.
+func OrderNoHasPrefix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasPrefix(FieldOrderNo, v))
+}
+
+// OrderNoHasSuffix applies the HasSuffix predicate on the "order_no" field.
+func OrderNoHasSuffix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasSuffix(FieldOrderNo, v))
+}
+
+// OrderNoEqualFold applies the EqualFold predicate on the "order_no" field.
+func OrderNoEqualFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEqualFold(FieldOrderNo, v))
+}
+
+// OrderNoContainsFold applies the ContainsFold predicate on the "order_no" field.
+func OrderNoContainsFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContainsFold(FieldOrderNo, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v domain.ReceiptRequestType) predicate.ReceiptRequest {
+	vc := v
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldType, vc))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v domain.ReceiptRequestType) predicate.ReceiptRequest {
+	vc := v
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldType, vc))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...domain.ReceiptRequestType) predicate.ReceiptRequest {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceiptRequest(sql.FieldIn(FieldType, v...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...domain.ReceiptRequestType) predicate.ReceiptRequest {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldType, v...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v domain.ReceiptRequestStatus) predicate.ReceiptRequest {
+	vc := v
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v domain.ReceiptRequestStatus) predicate.ReceiptRequest {
+	vc := v
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...domain.ReceiptRequestStatus) predicate.ReceiptRequest {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceiptRequest(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...domain.ReceiptRequestStatus) predicate.ReceiptRequest {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ContactPhoneEQ applies the EQ predicate on the "contact_phone" field.
+func ContactPhoneEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldContactPhone, v))
+}
+
+// ContactPhoneNEQ applies the NEQ predicate on the "contact_phone" field.
+func ContactPhoneNEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldContactPhone, v))
+}
+
+// ContactPhoneIn applies the In predicate on the "contact_phone" field.
+func ContactPhoneIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldContactPhone, vs...))
+}
+
+// ContactPhoneNotIn applies the NotIn predicate on the "contact_phone" field.
+func ContactPhoneNotIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldContactPhone, vs...))
+}
+
+// ContactPhoneGT applies the GT predicate on the "contact_phone" field.
+func ContactPhoneGT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldContactPhone, v))
+}
+
+// ContactPhoneGTE applies the GTE predicate on the "contact_phone" field.
+func ContactPhoneGTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldContactPhone, v))
+}
+
+// ContactPhoneLT applies the LT predicate on the "contact_phone" field.
+func ContactPhoneLT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldContactPhone, v))
+}
+
+// ContactPhoneLTE applies the LTE predicate on the "contact_phone" field.
+func ContactPhoneLTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldContactPhone, v))
+}
+
+// ContactPhoneContains applies the Contains predicate on the "contact_phone" field.
+func ContactPhoneContains(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContains(FieldContactPhone, v))
+}
+
+// ContactPhoneHasPrefix applies the HasPrefix predicate on the "contact_phone" field.
+func ContactPhoneHasPrefix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasPrefix(FieldContactPhone, v))
+}
+
+// ContactPhoneHasSuffix applies the HasSuffix predicate on the "contact_phone" field.
+func ContactPhoneHasSuffix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasSuffix(FieldContactPhone, v))
+}
+
+// ContactPhoneEqualFold applies the EqualFold predicate on the "contact_phone" field.
+func ContactPhoneEqualFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEqualFold(FieldContactPhone, v))
+}
+
+// ContactPhoneContainsFold applies the ContainsFold predicate on the "contact_phone" field.
+func ContactPhoneContainsFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContainsFold(FieldContactPhone, v))
+}
+
+// InvoiceTitleEQ applies the EQ predicate on the "invoice_title" field.
+func InvoiceTitleEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldInvoiceTitle, v))
+}
+
+// InvoiceTitleNEQ applies the NEQ predicate on the "invoice_title" field.
+func InvoiceTitleNEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldInvoiceTitle, v))
+}
+
+// InvoiceTitleIn applies the In predicate on the "invoice_title" field.
+func InvoiceTitleIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldInvoiceTitle, vs...))
+}
+
+// InvoiceTitleNotIn applies the NotIn predicate on the "invoice_title" field.
+func InvoiceTitleNotIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldInvoiceTitle, vs...))
+}
+
+// InvoiceTitleGT applies the GT predicate on the "invoice_title" field.
+func InvoiceTitleGT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldInvoiceTitle, v))
+}
+
+// InvoiceTitleGTE applies the GTE predicate on the "invoice_title" field.
+func InvoiceTitleGTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldInvoiceTitle, v))
+}
+
+// InvoiceTitleLT applies the LT predicate on the "invoice_title" field.
+func InvoiceTitleLT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldInvoiceTitle, v))
+}
+
+// InvoiceTitleLTE applies the LTE predicate on the "invoice_title" field.
+func InvoiceTitleLTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldInvoiceTitle, v))
+}
+
+// InvoiceTitleContains applies the Contains predicate on the "invoice_title" field.
+func InvoiceTitleContains(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContains(FieldInvoiceTitle, v))
+}
+
+// InvoiceTitleHasPrefix applies the HasPrefix predicate on the "invoice_title" field.
+func InvoiceTitleHasPrefix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasPrefix(FieldInvoiceTitle, v))
+}
+
+// InvoiceTitleHasSuffix applies the HasSuffix predicate on the "invoice_title" field.
+func InvoiceTitleHasSuffix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasSuffix(FieldInvoiceTitle, v))
+}
+
+// InvoiceTitleEqualFold applies the EqualFold predicate on the "invoice_title" field.
+func InvoiceTitleEqualFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEqualFold(FieldInvoiceTitle, v))
+}
+
+// InvoiceTitleContainsFold applies the ContainsFold predicate on the "invoice_title" field.
+func InvoiceTitleContainsFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContainsFold(FieldInvoiceTitle, v))
+}
+
+// InvoiceTaxNoEQ applies the EQ predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceTaxNoNEQ applies the NEQ predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoNEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceTaxNoIn applies the In predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldInvoiceTaxNo, vs...))
+}
+
+// InvoiceTaxNoNotIn applies the NotIn predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoNotIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldInvoiceTaxNo, vs...))
+}
+
+// InvoiceTaxNoGT applies the GT predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoGT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceTaxNoGTE applies the GTE predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoGTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceTaxNoLT applies the LT predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoLT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceTaxNoLTE applies the LTE predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoLTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceTaxNoContains applies the Contains predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoContains(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContains(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceTaxNoHasPrefix applies the HasPrefix predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoHasPrefix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasPrefix(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceTaxNoHasSuffix applies the HasSuffix predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoHasSuffix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasSuffix(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceTaxNoEqualFold applies the EqualFold predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoEqualFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEqualFold(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceTaxNoContainsFold applies the ContainsFold predicate on the "invoice_tax_no" field.
+func InvoiceTaxNoContainsFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContainsFold(FieldInvoiceTaxNo, v))
+}
+
+// InvoiceEmailEQ applies the EQ predicate on the "invoice_email" field.
+func InvoiceEmailEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldInvoiceEmail, v))
+}
+
+// InvoiceEmailNEQ applies the NEQ predicate on the "invoice_email" field.
+func InvoiceEmailNEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldInvoiceEmail, v))
+}
+
+// InvoiceEmailIn applies the In predicate on the "invoice_email" field.
+func InvoiceEmailIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldInvoiceEmail, vs...))
+}
+
+// InvoiceEmailNotIn applies the NotIn predicate on the "invoice_email" field.
+func InvoiceEmailNotIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldInvoiceEmail, vs...))
+}
+
+// InvoiceEmailGT applies the GT predicate on the "invoice_email" field.
+func InvoiceEmailGT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldInvoiceEmail, v))
+}
+
+// InvoiceEmailGTE applies the GTE predicate on the "invoice_email" field.
+func InvoiceEmailGTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldInvoiceEmail, v))
+}
+
+// InvoiceEmailLT applies the LT predicate on the "invoice_email" field.
+func InvoiceEmailLT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldInvoiceEmail, v))
+}
+
+// InvoiceEmailLTE applies the LTE predicate on the "invoice_email" field.
+func InvoiceEmailLTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldInvoiceEmail, v))
+}
+
+// InvoiceEmailContains applies the Contains predicate on the "invoice_email" field.
+func InvoiceEmailContains(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContains(FieldInvoiceEmail, v))
+}
+
+// InvoiceEmailHasPrefix applies the HasPrefix predicate on the "invoice_email" field.
+func InvoiceEmailHasPrefix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasPrefix(FieldInvoiceEmail, v))
+}
+
+// InvoiceEmailHasSuffix applies the HasSuffix predicate on the "invoice_email" field.
+func InvoiceEmailHasSuffix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasSuffix(FieldInvoiceEmail, v))
+}
+
+// InvoiceEmailEqualFold applies the EqualFold predicate on the "invoice_email" field.
+func InvoiceEmailEqualFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEqualFold(FieldInvoiceEmail, v))
+}
+
+// InvoiceEmailContainsFold applies the ContainsFold predicate on the "invoice_email" field.
+func InvoiceEmailContainsFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContainsFold(FieldInvoiceEmail, v))
+}
+
+// HandleRemarkEQ applies the EQ predicate on the "handle_remark" field.
+func HandleRemarkEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldHandleRemark, v))
+}
+
+// HandleRemarkNEQ applies the NEQ predicate on the "handle_remark" field.
+func HandleRemarkNEQ(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldHandleRemark, v))
+}
+
+// HandleRemarkIn applies the In predicate on the "handle_remark" field.
+func HandleRemarkIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldHandleRemark, vs...))
+}
+
+// HandleRemarkNotIn applies the NotIn predicate on the "handle_remark" field.
+func HandleRemarkNotIn(vs ...string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldHandleRemark, vs...))
+}
+
+// HandleRemarkGT applies the GT predicate on the "handle_remark" field.
+func HandleRemarkGT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldHandleRemark, v))
+}
+
+// HandleRemarkGTE applies the GTE predicate on the "handle_remark" field.
+func HandleRemarkGTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldHandleRemark, v))
+}
+
+// HandleRemarkLT applies the LT predicate on the "handle_remark" field.
+func HandleRemarkLT(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldHandleRemark, v))
+}
+
+// HandleRemarkLTE applies the LTE predicate on the "handle_remark" field.
+func HandleRemarkLTE(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldHandleRemark, v))
+}
+
+// HandleRemarkContains applies the Contains predicate on the "handle_remark" field.
+func HandleRemarkContains(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContains(FieldHandleRemark, v))
+}
+
+// HandleRemarkHasPrefix applies the HasPrefix predicate on the "handle_remark" field.
+func HandleRemarkHasPrefix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasPrefix(FieldHandleRemark, v))
+}
+
+// HandleRemarkHasSuffix applies the HasSuffix predicate on the "handle_remark" field.
+func HandleRemarkHasSuffix(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldHasSuffix(FieldHandleRemark, v))
+}
+
+// HandleRemarkEqualFold applies the EqualFold predicate on the "handle_remark" field.
+func HandleRemarkEqualFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEqualFold(FieldHandleRemark, v))
+}
+
+// HandleRemarkContainsFold applies the ContainsFold predicate on the "handle_remark" field.
+func HandleRemarkContainsFold(v string) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldContainsFold(FieldHandleRemark, v))
+}
+
+// HandledByEQ applies the EQ predicate on the "handled_by" field.
+func HandledByEQ(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldHandledBy, v))
+}
+
+// HandledByNEQ applies the NEQ predicate on the "handled_by" field.
+func HandledByNEQ(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldHandledBy, v))
+}
+
+// HandledByIn applies the In predicate on the "handled_by" field.
+func HandledByIn(vs ...uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldHandledBy, vs...))
+}
+
+// HandledByNotIn applies the NotIn predicate on the "handled_by" field.
+func HandledByNotIn(vs ...uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldHandledBy, vs...))
+}
+
+// HandledByGT applies the GT predicate on the "handled_by" field.
+func HandledByGT(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldHandledBy, v))
+}
+
+// HandledByGTE applies the GTE predicate on the "handled_by" field.
+func HandledByGTE(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldHandledBy, v))
+}
+
+// HandledByLT applies the LT predicate on the "handled_by" field.
+func HandledByLT(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldHandledBy, v))
+}
+
+// HandledByLTE applies the LTE predicate on the "handled_by" field.
+func HandledByLTE(v uuid.UUID) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldHandledBy, v))
+}
+
+// HandledByIsNil applies the IsNil predicate on the "handled_by" field.
+func HandledByIsNil() predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIsNull(FieldHandledBy))
+}
+
+// HandledByNotNil applies the NotNil predicate on the "handled_by" field.
+func HandledByNotNil() predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotNull(FieldHandledBy))
+}
+
+// HandledAtEQ applies the EQ predicate on the "handled_at" field.
+func HandledAtEQ(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldEQ(FieldHandledAt, v))
+}
+
+// HandledAtNEQ applies the NEQ predicate on the "handled_at" field.
+func HandledAtNEQ(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNEQ(FieldHandledAt, v))
+}
+
+// HandledAtIn applies the In predicate on the "handled_at" field.
+func HandledAtIn(vs ...time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIn(FieldHandledAt, vs...))
+}
+
+// HandledAtNotIn applies the NotIn predicate on the "handled_at" field.
+func HandledAtNotIn(vs ...time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotIn(FieldHandledAt, vs...))
+}
+
+// HandledAtGT applies the GT predicate on the "handled_at" field.
+func HandledAtGT(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGT(FieldHandledAt, v))
+}
+
+// HandledAtGTE applies the GTE predicate on the "handled_at" field.
+func HandledAtGTE(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldGTE(FieldHandledAt, v))
+}
+
+// HandledAtLT applies the LT predicate on the "handled_at" field.
+func HandledAtLT(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLT(FieldHandledAt, v))
+}
+
+// HandledAtLTE applies the LTE predicate on the "handled_at" field.
+func HandledAtLTE(v time.Time) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldLTE(FieldHandledAt, v))
+}
+
+// HandledAtIsNil applies the IsNil predicate on the "handled_at" field.
+func HandledAtIsNil() predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldIsNull(FieldHandledAt))
+}
+
+// HandledAtNotNil applies the NotNil predicate on the "handled_at" field.
+func HandledAtNotNil() predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.FieldNotNull(FieldHandledAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReceiptRequest) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReceiptRequest) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReceiptRequest) predicate.ReceiptRequest {
+	return predicate.ReceiptRequest(sql.NotPredicates(p))
+}
@@ -0,0 +1,1134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/receiptrequest"
+)
+
+// ReceiptRequestCreate is the builder for creating a ReceiptRequest entity.
+type ReceiptRequestCreate struct {
+	config
+	mutation *ReceiptRequestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrc *ReceiptRequestCreate) SetCreatedAt(t time.Time) *ReceiptRequestCreate {
+	rrc.mutation.SetCreatedAt(t)
+	return rrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableCreatedAt(t *time.Time) *ReceiptRequestCreate {
+	if t != nil {
+		rrc.SetCreatedAt(*t)
+	}
+	return rrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rrc *ReceiptRequestCreate) SetUpdatedAt(t time.Time) *ReceiptRequestCreate {
+	rrc.mutation.SetUpdatedAt(t)
+	return rrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableUpdatedAt(t *time.Time) *ReceiptRequestCreate {
+	if t != nil {
+		rrc.SetUpdatedAt(*t)
+	}
+	return rrc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (rrc *ReceiptRequestCreate) SetMerchantID(u uuid.UUID) *ReceiptRequestCreate {
+	rrc.mutation.SetMerchantID(u)
+	return rrc
+}
+
+// SetStoreID sets the "store_id" field.
+func (rrc *ReceiptRequestCreate) SetStoreID(u uuid.UUID) *ReceiptRequestCreate {
+	rrc.mutation.SetStoreID(u)
+	return rrc
+}
+
+// SetOrderID sets the "order_id" field.
+func (rrc *ReceiptRequestCreate) SetOrderID(u uuid.UUID) *ReceiptRequestCreate {
+	rrc.mutation.SetOrderID(u)
+	return rrc
+}
+
+// SetOrderNo sets the "order_no" field.
+func (rrc *ReceiptRequestCreate) SetOrderNo(s string) *ReceiptRequestCreate {
+	rrc.mutation.SetOrderNo(s)
+	return rrc
+}
+
+// SetNillableOrderNo sets the "order_no" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableOrderNo(s *string) *ReceiptRequestCreate {
+	if s != nil {
+		rrc.SetOrderNo(*s)
+	}
+	return rrc
+}
+
+// SetType sets the "type" field.
+func (rrc *ReceiptRequestCreate) SetType(drt domain.ReceiptRequestType) *ReceiptRequestCreate {
+	rrc.mutation.SetType(drt)
+	return rrc
+}
+
+// SetStatus sets the "status" field.
+func (rrc *ReceiptRequestCreate) SetStatus(drs domain.ReceiptRequestStatus) *ReceiptRequestCreate {
+	rrc.mutation.SetStatus(drs)
+	return rrc
+}
+
+// SetReason sets the "reason" field.
+func (rrc *ReceiptRequestCreate) SetReason(s string) *ReceiptRequestCreate {
+	rrc.mutation.SetReason(s)
+	return rrc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableReason(s *string) *ReceiptRequestCreate {
+	if s != nil {
+		rrc.SetReason(*s)
+	}
+	return rrc
+}
+
+// SetContactPhone sets the "contact_phone" field.
+func (rrc *ReceiptRequestCreate) SetContactPhone(s string) *ReceiptRequestCreate {
+	rrc.mutation.SetContactPhone(s)
+	return rrc
+}
+
+// SetNillableContactPhone sets the "contact_phone" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableContactPhone(s *string) *ReceiptRequestCreate {
+	if s != nil {
+		rrc.SetContactPhone(*s)
+	}
+	return rrc
+}
+
+// SetInvoiceTitle sets the "invoice_title" field.
+func (rrc *ReceiptRequestCreate) SetInvoiceTitle(s string) *ReceiptRequestCreate {
+	rrc.mutation.SetInvoiceTitle(s)
+	return rrc
+}
+
+// SetNillableInvoiceTitle sets the "invoice_title" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableInvoiceTitle(s *string) *ReceiptRequestCreate {
+	if s != nil {
+		rrc.SetInvoiceTitle(*s)
+	}
+	return rrc
+}
+
+// SetInvoiceTaxNo sets the "invoice_tax_no" field.
+func (rrc *ReceiptRequestCreate) SetInvoiceTaxNo(s string) *ReceiptRequestCreate {
+	rrc.mutation.SetInvoiceTaxNo(s)
+	return rrc
+}
+
+// SetNillableInvoiceTaxNo sets the "invoice_tax_no" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableInvoiceTaxNo(s *string) *ReceiptRequestCreate {
+	if s != nil {
+		rrc.SetInvoiceTaxNo(*s)
+	}
+	return rrc
+}
+
+// SetInvoiceEmail sets the "invoice_email" field.
+func (rrc *ReceiptRequestCreate) SetInvoiceEmail(s string) *ReceiptRequestCreate {
+	rrc.mutation.SetInvoiceEmail(s)
+	return rrc
+}
+
+// SetNillableInvoiceEmail sets the "invoice_email" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableInvoiceEmail(s *string) *ReceiptRequestCreate {
+	if s != nil {
+		rrc.SetInvoiceEmail(*s)
+	}
+	return rrc
+}
+
+// SetHandleRemark sets the "handle_remark" field.
+func (rrc *ReceiptRequestCreate) SetHandleRemark(s string) *ReceiptRequestCreate {
+	rrc.mutation.SetHandleRemark(s)
+	return rrc
+}
+
+// SetNillableHandleRemark sets the "handle_remark" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableHandleRemark(s *string) *ReceiptRequestCreate {
+	if s != nil {
+		rrc.SetHandleRemark(*s)
+	}
+	return rrc
+}
+
+// SetHandledBy sets the "handled_by" field.
+func (rrc *ReceiptRequestCreate) SetHandledBy(u uuid.UUID) *ReceiptRequestCreate {
+	rrc.mutation.SetHandledBy(u)
+	return rrc
+}
+
+// SetNillableHandledBy sets the "handled_by" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableHandledBy(u *uuid.UUID) *ReceiptRequestCreate {
+	if u != nil {
+		rrc.SetHandledBy(*u)
+	}
+	return rrc
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (rrc *ReceiptRequestCreate) SetHandledAt(t time.Time) *ReceiptRequestCreate {
+	rrc.mutation.SetHandledAt(t)
+	return rrc
+}
+
+// SetNillableHandledAt sets the "handled_at" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableHandledAt(t *time.Time) *ReceiptRequestCreate {
+	if t != nil {
+		rrc.SetHandledAt(*t)
+	}
+	return rrc
+}
+
+// SetID sets the "id" field.
+func (rrc *ReceiptRequestCreate) SetID(u uuid.UUID) *ReceiptRequestCreate {
+	rrc.mutation.SetID(u)
+	return rrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rrc *ReceiptRequestCreate) SetNillableID(u *uuid.UUID) *ReceiptRequestCreate {
+	if u != nil {
+		rrc.SetID(*u)
+	}
+	return rrc
+}
+
+// Mutation returns the ReceiptRequestMutation object of the builder.
+func (rrc *ReceiptRequestCreate) Mutation() *ReceiptRequestMutation {
+	return rrc.mutation
+}
+
+// Save creates the ReceiptRequest in the database.
+func (rrc *ReceiptRequestCreate) Save(ctx context.Context) (*ReceiptRequest, error) {
+	rrc.defaults()
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *ReceiptRequestCreate) SaveX(ctx context.Context) *ReceiptRequest {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *ReceiptRequestCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *ReceiptRequestCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *ReceiptRequestCreate) defaults() {
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		v := receiptrequest.DefaultCreatedAt()
+		rrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		v := receiptrequest.DefaultUpdatedAt()
+		rrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rrc.mutation.OrderNo(); !ok {
+		v := receiptrequest.DefaultOrderNo
+		rrc.mutation.SetOrderNo(v)
+	}
+	if _, ok := rrc.mutation.Reason(); !ok {
+		v := receiptrequest.DefaultReason
+		rrc.mutation.SetReason(v)
+	}
+	if _, ok := rrc.mutation.ContactPhone(); !ok {
+		v := receiptrequest.DefaultContactPhone
+		rrc.mutation.SetContactPhone(v)
+	}
+	if _, ok := rrc.mutation.InvoiceTitle(); !ok {
+		v := receiptrequest.DefaultInvoiceTitle
+		rrc.mutation.SetInvoiceTitle(v)
+	}
+	if _, ok := rrc.mutation.InvoiceTaxNo(); !ok {
+		v := receiptrequest.DefaultInvoiceTaxNo
+		rrc.mutation.SetInvoiceTaxNo(v)
+	}
+	if _, ok := rrc.mutation.InvoiceEmail(); !ok {
+		v := receiptrequest.DefaultInvoiceEmail
+		rrc.mutation.SetInvoiceEmail(v)
+	}
+	if _, ok := rrc.mutation.HandleRemark(); !ok {
+		v := receiptrequest.DefaultHandleRemark
+		rrc.mutation.SetHandleRemark(v)
+	}
+	if _, ok := rrc.mutation.ID(); !ok {
+		v := receiptrequest.DefaultID()
+		rrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *ReceiptRequestCreate) check() error {
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReceiptRequest.created_at"`)}
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReceiptRequest.updated_at"`)}
+	}
+	if _, ok := rrc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "ReceiptRequest.merchant_id"`)}
+	}
+	if _, ok := rrc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "ReceiptRequest.store_id"`)}
+	}
+	if _, ok := rrc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "ReceiptRequest.order_id"`)}
+	}
+	if _, ok := rrc.mutation.OrderNo(); !ok {
+		return &ValidationError{Name: "order_no", err: errors.New(`ent: missing required field "ReceiptRequest.order_no"`)}
+	}
+	if v, ok := rrc.mutation.OrderNo(); ok {
+		if err := receiptrequest.OrderNoValidator(v); err != nil {
+			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.order_no": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ReceiptRequest.type"`)}
+	}
+	if v, ok := rrc.mutation.GetType(); ok {
+		if err := receiptrequest.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.type": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReceiptRequest.status"`)}
+	}
+	if v, ok := rrc.mutation.Status(); ok {
+		if err := receiptrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "ReceiptRequest.reason"`)}
+	}
+	if v, ok := rrc.mutation.Reason(); ok {
+		if err := receiptrequest.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.reason": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.ContactPhone(); !ok {
+		return &ValidationError{Name: "contact_phone", err: errors.New(`ent: missing required field "ReceiptRequest.contact_phone"`)}
+	}
+	if v, ok := rrc.mutation.ContactPhone(); ok {
+		if err := receiptrequest.ContactPhoneValidator(v); err != nil {
+			return &ValidationError{Name: "contact_phone", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.contact_phone": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.InvoiceTitle(); !ok {
+		return &ValidationError{Name: "invoice_title", err: errors.New(`ent: missing required field "ReceiptRequest.invoice_title"`)}
+	}
+	if v, ok := rrc.mutation.InvoiceTitle(); ok {
+		if err := receiptrequest.InvoiceTitleValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_title", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.invoice_title": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.InvoiceTaxNo(); !ok {
+		return &ValidationError{Name: "invoice_tax_no", err: errors.New(`ent: missing required field "ReceiptRequest.invoice_tax_no"`)}
+	}
+	if v, ok := rrc.mutation.InvoiceTaxNo(); ok {
+		if err := receiptrequest.InvoiceTaxNoValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_tax_no", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.invoice_tax_no": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.InvoiceEmail(); !ok {
+		return &ValidationError{Name: "invoice_email", err: errors.New(`ent: missing required field "ReceiptRequest.invoice_email"`)}
+	}
+	if v, ok := rrc.mutation.InvoiceEmail(); ok {
+		if err := receiptrequest.InvoiceEmailValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_email", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.invoice_email": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.HandleRemark(); !ok {
+		return &ValidationError{Name: "handle_remark", err: errors.New(`ent: missing required field "ReceiptRequest.handle_remark"`)}
+	}
+	if v, ok := rrc.mutation.HandleRemark(); ok {
+		if err := receiptrequest.HandleRemarkValidator(v); err != nil {
+			return &ValidationError{Name: "handle_remark", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.handle_remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rrc *ReceiptRequestCreate) sqlSave(ctx context.Context) (*ReceiptRequest, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *ReceiptRequestCreate) createSpec() (*ReceiptRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReceiptRequest{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(receiptrequest.Table, sqlgraph.NewFieldSpec(receiptrequest.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rrc.conflict
+	if id, ok := rrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rrc.mutation.CreatedAt(); ok {
+		_spec.SetField(receiptrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(receiptrequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rrc.mutation.MerchantID(); ok {
+		_spec.SetField(receiptrequest.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := rrc.mutation.StoreID(); ok {
+		_spec.SetField(receiptrequest.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := rrc.mutation.OrderID(); ok {
+		_spec.SetField(receiptrequest.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := rrc.mutation.OrderNo(); ok {
+		_spec.SetField(receiptrequest.FieldOrderNo, field.TypeString, value)
+		_node.OrderNo = value
+	}
+	if value, ok := rrc.mutation.GetType(); ok {
+		_spec.SetField(receiptrequest.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := rrc.mutation.Status(); ok {
+		_spec.SetField(receiptrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rrc.mutation.Reason(); ok {
+		_spec.SetField(receiptrequest.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := rrc.mutation.ContactPhone(); ok {
+		_spec.SetField(receiptrequest.FieldContactPhone, field.TypeString, value)
+		_node.ContactPhone = value
+	}
+	if value, ok := rrc.mutation.InvoiceTitle(); ok {
+		_spec.SetField(receiptrequest.FieldInvoiceTitle, field.TypeString, value)
+		_node.InvoiceTitle = value
+	}
+	if value, ok := rrc.mutation.InvoiceTaxNo(); ok {
+		_spec.SetField(receiptrequest.FieldInvoiceTaxNo, field.TypeString, value)
+		_node.InvoiceTaxNo = value
+	}
+	if value, ok := rrc.mutation.InvoiceEmail(); ok {
+		_spec.SetField(receiptrequest.FieldInvoiceEmail, field.TypeString, value)
+		_node.InvoiceEmail = value
+	}
+	if value, ok := rrc.mutation.HandleRemark(); ok {
+		_spec.SetField(receiptrequest.FieldHandleRemark, field.TypeString, value)
+		_node.HandleRemark = value
+	}
+	if value, ok := rrc.mutation.HandledBy(); ok {
+		_spec.SetField(receiptrequest.FieldHandledBy, field.TypeUUID, value)
+		_node.HandledBy = value
+	}
+	if value, ok := rrc.mutation.HandledAt(); ok {
+		_spec.SetField(receiptrequest.FieldHandledAt, field.TypeTime, value)
+		_node.HandledAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReceiptRequest.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptRequestUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rrc *ReceiptRequestCreate) OnConflict(opts ...sql.ConflictOption) *ReceiptRequestUpsertOne {
+	rrc.conflict = opts
+	return &ReceiptRequestUpsertOne{
+		create: rrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReceiptRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrc *ReceiptRequestCreate) OnConflictColumns(columns ...string) *ReceiptRequestUpsertOne {
+	rrc.conflict = append(rrc.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptRequestUpsertOne{
+		create: rrc,
+	}
+}
+
+type (
+	// ReceiptRequestUpsertOne is the builder for "upsert"-ing
+	//  one ReceiptRequest node.
+	ReceiptRequestUpsertOne struct {
+		create *ReceiptRequestCreate
+	}
+
+	// ReceiptRequestUpsert is the "OnConflict" setter.
+	ReceiptRequestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiptRequestUpsert) SetUpdatedAt(v time.Time) *ReceiptRequestUpsert {
+	u.Set(receiptrequest.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiptRequestUpsert) UpdateUpdatedAt() *ReceiptRequestUpsert {
+	u.SetExcluded(receiptrequest.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReceiptRequestUpsert) SetStatus(v domain.ReceiptRequestStatus) *ReceiptRequestUpsert {
+	u.Set(receiptrequest.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReceiptRequestUpsert) UpdateStatus() *ReceiptRequestUpsert {
+	u.SetExcluded(receiptrequest.FieldStatus)
+	return u
+}
+
+// SetHandleRemark sets the "handle_remark" field.
+func (u *ReceiptRequestUpsert) SetHandleRemark(v string) *ReceiptRequestUpsert {
+	u.Set(receiptrequest.FieldHandleRemark, v)
+	return u
+}
+
+// UpdateHandleRemark sets the "handle_remark" field to the value that was provided on create.
+func (u *ReceiptRequestUpsert) UpdateHandleRemark() *ReceiptRequestUpsert {
+	u.SetExcluded(receiptrequest.FieldHandleRemark)
+	return u
+}
+
+// SetHandledBy sets the "handled_by" field.
+func (u *ReceiptRequestUpsert) SetHandledBy(v uuid.UUID) *ReceiptRequestUpsert {
+	u.Set(receiptrequest.FieldHandledBy, v)
+	return u
+}
+
+// UpdateHandledBy sets the "handled_by" field to the value that was provided on create.
+func (u *ReceiptRequestUpsert) UpdateHandledBy() *ReceiptRequestUpsert {
+	u.SetExcluded(receiptrequest.FieldHandledBy)
+	return u
+}
+
+// ClearHandledBy clears the value of the "handled_by" field.
+func (u *ReceiptRequestUpsert) ClearHandledBy() *ReceiptRequestUpsert {
+	u.SetNull(receiptrequest.FieldHandledBy)
+	return u
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (u *ReceiptRequestUpsert) SetHandledAt(v time.Time) *ReceiptRequestUpsert {
+	u.Set(receiptrequest.FieldHandledAt, v)
+	return u
+}
+
+// UpdateHandledAt sets the "handled_at" field to the value that was provided on create.
+func (u *ReceiptRequestUpsert) UpdateHandledAt() *ReceiptRequestUpsert {
+	u.SetExcluded(receiptrequest.FieldHandledAt)
+	return u
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (u *ReceiptRequestUpsert) ClearHandledAt() *ReceiptRequestUpsert {
+	u.SetNull(receiptrequest.FieldHandledAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receiptrequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptRequestUpsertOne) UpdateNewValues() *ReceiptRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(receiptrequest.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(receiptrequest.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(receiptrequest.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(receiptrequest.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(receiptrequest.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.OrderNo(); exists {
+			s.SetIgnore(receiptrequest.FieldOrderNo)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(receiptrequest.FieldType)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(receiptrequest.FieldReason)
+		}
+		if _, exists := u.create.mutation.ContactPhone(); exists {
+			s.SetIgnore(receiptrequest.FieldContactPhone)
+		}
+		if _, exists := u.create.mutation.InvoiceTitle(); exists {
+			s.SetIgnore(receiptrequest.FieldInvoiceTitle)
+		}
+		if _, exists := u.create.mutation.InvoiceTaxNo(); exists {
+			s.SetIgnore(receiptrequest.FieldInvoiceTaxNo)
+		}
+		if _, exists := u.create.mutation.InvoiceEmail(); exists {
+			s.SetIgnore(receiptrequest.FieldInvoiceEmail)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptRequest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReceiptRequestUpsertOne) Ignore() *ReceiptRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptRequestUpsertOne) DoNothing() *ReceiptRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptRequestCreate.OnConflict
+// documentation for more info.
+func (u *ReceiptRequestUpsertOne) Update(set func(*ReceiptRequestUpsert)) *ReceiptRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiptRequestUpsertOne) SetUpdatedAt(v time.Time) *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiptRequestUpsertOne) UpdateUpdatedAt() *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReceiptRequestUpsertOne) SetStatus(v domain.ReceiptRequestStatus) *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReceiptRequestUpsertOne) UpdateStatus() *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHandleRemark sets the "handle_remark" field.
+func (u *ReceiptRequestUpsertOne) SetHandleRemark(v string) *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.SetHandleRemark(v)
+	})
+}
+
+// UpdateHandleRemark sets the "handle_remark" field to the value that was provided on create.
+func (u *ReceiptRequestUpsertOne) UpdateHandleRemark() *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.UpdateHandleRemark()
+	})
+}
+
+// SetHandledBy sets the "handled_by" field.
+func (u *ReceiptRequestUpsertOne) SetHandledBy(v uuid.UUID) *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.SetHandledBy(v)
+	})
+}
+
+// UpdateHandledBy sets the "handled_by" field to the value that was provided on create.
+func (u *ReceiptRequestUpsertOne) UpdateHandledBy() *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.UpdateHandledBy()
+	})
+}
+
+// ClearHandledBy clears the value of the "handled_by" field.
+func (u *ReceiptRequestUpsertOne) ClearHandledBy() *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.ClearHandledBy()
+	})
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (u *ReceiptRequestUpsertOne) SetHandledAt(v time.Time) *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.SetHandledAt(v)
+	})
+}
+
+// UpdateHandledAt sets the "handled_at" field to the value that was provided on create.
+func (u *ReceiptRequestUpsertOne) UpdateHandledAt() *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.UpdateHandledAt()
+	})
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (u *ReceiptRequestUpsertOne) ClearHandledAt() *ReceiptRequestUpsertOne {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.ClearHandledAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiptRequestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptRequestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptRequestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReceiptRequestUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReceiptRequestUpsertOne.ID is not supported by MySQL driver. Use ReceiptRequestUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReceiptRequestUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReceiptRequestCreateBulk is the builder for creating many ReceiptRequest entities in bulk.
+type ReceiptRequestCreateBulk struct {
+	config
+	err      error
+	builders []*ReceiptRequestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReceiptRequest entities in the database.
+func (rrcb *ReceiptRequestCreateBulk) Save(ctx context.Context) ([]*ReceiptRequest, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*ReceiptRequest, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReceiptRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *ReceiptRequestCreateBulk) SaveX(ctx context.Context) []*ReceiptRequest {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *ReceiptRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *ReceiptRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReceiptRequest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptRequestUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rrcb *ReceiptRequestCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReceiptRequestUpsertBulk {
+	rrcb.conflict = opts
+	return &ReceiptRequestUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReceiptRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrcb *ReceiptRequestCreateBulk) OnConflictColumns(columns ...string) *ReceiptRequestUpsertBulk {
+	rrcb.conflict = append(rrcb.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptRequestUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// ReceiptRequestUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReceiptRequest nodes.
+type ReceiptRequestUpsertBulk struct {
+	create *ReceiptRequestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReceiptRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receiptrequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptRequestUpsertBulk) UpdateNewValues() *ReceiptRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(receiptrequest.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(receiptrequest.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(receiptrequest.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(receiptrequest.FieldStoreID)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(receiptrequest.FieldOrderID)
+			}
+			if _, exists := b.mutation.OrderNo(); exists {
+				s.SetIgnore(receiptrequest.FieldOrderNo)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(receiptrequest.FieldType)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(receiptrequest.FieldReason)
+			}
+			if _, exists := b.mutation.ContactPhone(); exists {
+				s.SetIgnore(receiptrequest.FieldContactPhone)
+			}
+			if _, exists := b.mutation.InvoiceTitle(); exists {
+				s.SetIgnore(receiptrequest.FieldInvoiceTitle)
+			}
+			if _, exists := b.mutation.InvoiceTaxNo(); exists {
+				s.SetIgnore(receiptrequest.FieldInvoiceTaxNo)
+			}
+			if _, exists := b.mutation.InvoiceEmail(); exists {
+				s.SetIgnore(receiptrequest.FieldInvoiceEmail)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptRequest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReceiptRequestUpsertBulk) Ignore() *ReceiptRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptRequestUpsertBulk) DoNothing() *ReceiptRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptRequestCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReceiptRequestUpsertBulk) Update(set func(*ReceiptRequestUpsert)) *ReceiptRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiptRequestUpsertBulk) SetUpdatedAt(v time.Time) *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiptRequestUpsertBulk) UpdateUpdatedAt() *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReceiptRequestUpsertBulk) SetStatus(v domain.ReceiptRequestStatus) *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReceiptRequestUpsertBulk) UpdateStatus() *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHandleRemark sets the "handle_remark" field.
+func (u *ReceiptRequestUpsertBulk) SetHandleRemark(v string) *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.SetHandleRemark(v)
+	})
+}
+
+// UpdateHandleRemark sets the "handle_remark" field to the value that was provided on create.
+func (u *ReceiptRequestUpsertBulk) UpdateHandleRemark() *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.UpdateHandleRemark()
+	})
+}
+
+// SetHandledBy sets the "handled_by" field.
+func (u *ReceiptRequestUpsertBulk) SetHandledBy(v uuid.UUID) *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.SetHandledBy(v)
+	})
+}
+
+// UpdateHandledBy sets the "handled_by" field to the value that was provided on create.
+func (u *ReceiptRequestUpsertBulk) UpdateHandledBy() *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.UpdateHandledBy()
+	})
+}
+
+// ClearHandledBy clears the value of the "handled_by" field.
+func (u *ReceiptRequestUpsertBulk) ClearHandledBy() *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.ClearHandledBy()
+	})
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (u *ReceiptRequestUpsertBulk) SetHandledAt(v time.Time) *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.SetHandledAt(v)
+	})
+}
+
+// UpdateHandledAt sets the "handled_at" field to the value that was provided on create.
+func (u *ReceiptRequestUpsertBulk) UpdateHandledAt() *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.UpdateHandledAt()
+	})
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (u *ReceiptRequestUpsertBulk) ClearHandledAt() *ReceiptRequestUpsertBulk {
+	return u.Update(func(s *ReceiptRequestUpsert) {
+		s.ClearHandledAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiptRequestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReceiptRequestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptRequestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptRequestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/receiptrequest"
+)
+
+// ReceiptRequestDelete is the builder for deleting a ReceiptRequest entity.
+type ReceiptRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReceiptRequestMutation
+}
+
+// Where appends a list predicates to the ReceiptRequestDelete builder.
+func (rrd *ReceiptRequestDelete) Where(ps ...predicate.ReceiptRequest) *ReceiptRequestDelete {
+	rrd.mutation.Where(ps...)
+	return rrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rrd *ReceiptRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rrd.sqlExec, rrd.mutation, rrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrd *ReceiptRequestDelete) ExecX(ctx context.Context) int {
+	n, err := rrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rrd *ReceiptRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(receiptrequest.Table, sqlgraph.NewFieldSpec(receiptrequest.FieldID, field.TypeUUID))
+	if ps := rrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rrd.mutation.done = true
+	return affected, err
+}
+
+// ReceiptRequestDeleteOne is the builder for deleting a single ReceiptRequest entity.
+type ReceiptRequestDeleteOne struct {
+	rrd *ReceiptRequestDelete
+}
+
+// Where appends a list predicates to the ReceiptRequestDelete builder.
+func (rrdo *ReceiptRequestDeleteOne) Where(ps ...predicate.ReceiptRequest) *ReceiptRequestDeleteOne {
+	rrdo.rrd.mutation.Where(ps...)
+	return rrdo
+}
+
+// Exec executes the deletion query.
+func (rrdo *ReceiptRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := rrdo.rrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{receiptrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrdo *ReceiptRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := rrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/receiptrequest"
+)
+
+// ReceiptRequestQuery is the builder for querying ReceiptRequest entities.
+type ReceiptRequestQuery struct {
+	config
+	ctx        *QueryContext
+	order      []receiptrequest.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ReceiptRequest
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReceiptRequestQuery builder.
+func (rrq *ReceiptRequestQuery) Where(ps ...predicate.ReceiptRequest) *ReceiptRequestQuery {
+	rrq.predicates = append(rrq.predicates, ps...)
+	return rrq
+}
+
+// Limit the number of records to be returned by this query.
+func (rrq *ReceiptRequestQuery) Limit(limit int) *ReceiptRequestQuery {
+	rrq.ctx.Limit = &limit
+	return rrq
+}
+
+// Offset to start from.
+func (rrq *ReceiptRequestQuery) Offset(offset int) *ReceiptRequestQuery {
+	rrq.ctx.Offset = &offset
+	return rrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rrq *ReceiptRequestQuery) Unique(unique bool) *ReceiptRequestQuery {
+	rrq.ctx.Unique = &unique
+	return rrq
+}
+
+// Order specifies how the records should be ordered.
+func (rrq *ReceiptRequestQuery) Order(o ...receiptrequest.OrderOption) *ReceiptRequestQuery {
+	rrq.order = append(rrq.order, o...)
+	return rrq
+}
+
+// First returns the first ReceiptRequest entity from the query.
+// Returns a *NotFoundError when no ReceiptRequest was found.
+func (rrq *ReceiptRequestQuery) First(ctx context.Context) (*ReceiptRequest, error) {
+	nodes, err := rrq.Limit(1).All(setContextOp(ctx, rrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{receiptrequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rrq *ReceiptRequestQuery) FirstX(ctx context.Context) *ReceiptRequest {
+	node, err := rrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReceiptRequest ID from the query.
+// Returns a *NotFoundError when no ReceiptRequest ID was found.
+func (rrq *ReceiptRequestQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rrq.Limit(1).IDs(setContextOp(ctx, rrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{receiptrequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rrq *ReceiptRequestQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReceiptRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReceiptRequest entity is found.
+// Returns a *NotFoundError when no ReceiptRequest entities are found.
+func (rrq *ReceiptRequestQuery) Only(ctx context.Context) (*ReceiptRequest, error) {
+	nodes, err := rrq.Limit(2).All(setContextOp(ctx, rrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{receiptrequest.Label}
+	default:
+		return nil, &NotSingularError{receiptrequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rrq *ReceiptRequestQuery) OnlyX(ctx context.Context) *ReceiptRequest {
+	node, err := rrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReceiptRequest ID in the query.
+// Returns a *NotSingularError when more than one ReceiptRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rrq *ReceiptRequestQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rrq.Limit(2).IDs(setContextOp(ctx, rrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{receiptrequest.Label}
+	default:
+		err = &NotSingularError{receiptrequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rrq *ReceiptRequestQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReceiptRequests.
+func (rrq *ReceiptRequestQuery) All(ctx context.Context) ([]*ReceiptRequest, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryAll)
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReceiptRequest, *ReceiptRequestQuery]()
+	return withInterceptors[[]*ReceiptRequest](ctx, rrq, qr, rrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rrq *ReceiptRequestQuery) AllX(ctx context.Context) []*ReceiptRequest {
+	nodes, err := rrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReceiptRequest IDs.
+func (rrq *ReceiptRequestQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if rrq.ctx.Unique == nil && rrq.path != nil {
+		rrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryIDs)
+	if err = rrq.Select(receiptrequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rrq *ReceiptRequestQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rrq *ReceiptRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryCount)
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rrq, querierCount[*ReceiptRequestQuery](), rrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rrq *ReceiptRequestQuery) CountX(ctx context.Context) int {
+	count, err := rrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rrq *ReceiptRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryExist)
+	switch _, err := rrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rrq *ReceiptRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := rrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReceiptRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rrq *ReceiptRequestQuery) Clone() *ReceiptRequestQuery {
+	if rrq == nil {
+		return nil
+	}
+	return &ReceiptRequestQuery{
+		config:     rrq.config,
+		ctx:        rrq.ctx.Clone(),
+		order:      append([]receiptrequest.OrderOption{}, rrq.order...),
+		inters:     append([]Interceptor{}, rrq.inters...),
+		predicates: append([]predicate.ReceiptRequest{}, rrq.predicates...),
+		// clone intermediate query.
+		sql:       rrq.sql.Clone(),
+		path:      rrq.path,
+		modifiers: append([]func(*sql.Selector){}, rrq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReceiptRequest.Query().
+//		GroupBy(receiptrequest.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rrq *ReceiptRequestQuery) GroupBy(field string, fields ...string) *ReceiptRequestGroupBy {
+	rrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReceiptRequestGroupBy{build: rrq}
+	grbuild.flds = &rrq.ctx.Fields
+	grbuild.label = receiptrequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ReceiptRequest.Query().
+//		Select(receiptrequest.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rrq *ReceiptRequestQuery) Select(fields ...string) *ReceiptRequestSelect {
+	rrq.ctx.Fields = append(rrq.ctx.Fields, fields...)
+	sbuild := &ReceiptRequestSelect{ReceiptRequestQuery: rrq}
+	sbuild.label = receiptrequest.Label
+	sbuild.flds, sbuild.scan = &rrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReceiptRequestSelect configured with the given aggregations.
+func (rrq *ReceiptRequestQuery) Aggregate(fns ...AggregateFunc) *ReceiptRequestSelect {
+	return rrq.Select().Aggregate(fns...)
+}
+
+func (rrq *ReceiptRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rrq.ctx.Fields {
+		if !receiptrequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rrq.path != nil {
+		prev, err := rrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rrq.sql = prev
+	}
+	return nil
+}
+
+func (rrq *ReceiptRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReceiptRequest, error) {
+	var (
+		nodes = []*ReceiptRequest{}
+		_spec = rrq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReceiptRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReceiptRequest{config: rrq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(rrq.modifiers) > 0 {
+		_spec.Modifiers = rrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rrq *ReceiptRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rrq.querySpec()
+	if len(rrq.modifiers) > 0 {
+		_spec.Modifiers = rrq.modifiers
+	}
+	_spec.Node.Columns = rrq.ctx.Fields
+	if len(rrq.ctx.Fields) > 0 {
+		_spec.Unique = rrq.ctx.Unique != nil && *rrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rrq.driver, _spec)
+}
+
+func (rrq *ReceiptRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(receiptrequest.Table, receiptrequest.Columns, sqlgraph.NewFieldSpec(receiptrequest.FieldID, field.TypeUUID))
+	_spec.From = rrq.sql
+	if unique := rrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, receiptrequest.FieldID)
+		for i := range fields {
+			if fields[i] != receiptrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rrq *ReceiptRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rrq.driver.Dialect())
+	t1 := builder.Table(receiptrequest.Table)
+	columns := rrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = receiptrequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rrq.sql != nil {
+		selector = rrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rrq.ctx.Unique != nil && *rrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rrq.modifiers {
+		m(selector)
+	}
+	for _, p := range rrq.predicates {
+		p(selector)
+	}
+	for _, p := range rrq.order {
+		p(selector)
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rrq *ReceiptRequestQuery) ForUpdate(opts ...sql.LockOption) *ReceiptRequestQuery {
+	if rrq.driver.Dialect() == dialect.Postgres {
+		rrq.Unique(false)
+	}
+	rrq.modifiers = append(rrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rrq *ReceiptRequestQuery) ForShare(opts ...sql.LockOption) *ReceiptRequestQuery {
+	if rrq.driver.Dialect() == dialect.Postgres {
+		rrq.Unique(false)
+	}
+	rrq.modifiers = append(rrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rrq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rrq *ReceiptRequestQuery) Modify(modifiers ...func(s *sql.Selector)) *ReceiptRequestSelect {
+	rrq.modifiers = append(rrq.modifiers, modifiers...)
+	return rrq.Select()
+}
+
+// ReceiptRequestGroupBy is the group-by builder for ReceiptRequest entities.
+type ReceiptRequestGroupBy struct {
+	selector
+	build *ReceiptRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rrgb *ReceiptRequestGroupBy) Aggregate(fns ...AggregateFunc) *ReceiptRequestGroupBy {
+	rrgb.fns = append(rrgb.fns, fns...)
+	return rrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrgb *ReceiptRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReceiptRequestQuery, *ReceiptRequestGroupBy](ctx, rrgb.build, rrgb, rrgb.build.inters, v)
+}
+
+func (rrgb *ReceiptRequestGroupBy) sqlScan(ctx context.Context, root *ReceiptRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rrgb.fns))
+	for _, fn := range rrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rrgb.flds)+len(rrgb.fns))
+		for _, f := range *rrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReceiptRequestSelect is the builder for selecting fields of ReceiptRequest entities.
+type ReceiptRequestSelect struct {
+	*ReceiptRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rrs *ReceiptRequestSelect) Aggregate(fns ...AggregateFunc) *ReceiptRequestSelect {
+	rrs.fns = append(rrs.fns, fns...)
+	return rrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrs *ReceiptRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrs.ctx, ent.OpQuerySelect)
+	if err := rrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReceiptRequestQuery, *ReceiptRequestSelect](ctx, rrs.ReceiptRequestQuery, rrs, rrs.inters, v)
+}
+
+func (rrs *ReceiptRequestSelect) sqlScan(ctx context.Context, root *ReceiptRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rrs.fns))
+	for _, fn := range rrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rrs *ReceiptRequestSelect) Modify(modifiers ...func(s *sql.Selector)) *ReceiptRequestSelect {
+	rrs.modifiers = append(rrs.modifiers, modifiers...)
+	return rrs
+}
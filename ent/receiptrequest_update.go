@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/receiptrequest"
+)
+
+// ReceiptRequestUpdate is the builder for updating ReceiptRequest entities.
+type ReceiptRequestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReceiptRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReceiptRequestUpdate builder.
+func (rru *ReceiptRequestUpdate) Where(ps ...predicate.ReceiptRequest) *ReceiptRequestUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rru *ReceiptRequestUpdate) SetUpdatedAt(t time.Time) *ReceiptRequestUpdate {
+	rru.mutation.SetUpdatedAt(t)
+	return rru
+}
+
+// SetStatus sets the "status" field.
+func (rru *ReceiptRequestUpdate) SetStatus(drs domain.ReceiptRequestStatus) *ReceiptRequestUpdate {
+	rru.mutation.SetStatus(drs)
+	return rru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rru *ReceiptRequestUpdate) SetNillableStatus(drs *domain.ReceiptRequestStatus) *ReceiptRequestUpdate {
+	if drs != nil {
+		rru.SetStatus(*drs)
+	}
+	return rru
+}
+
+// SetHandleRemark sets the "handle_remark" field.
+func (rru *ReceiptRequestUpdate) SetHandleRemark(s string) *ReceiptRequestUpdate {
+	rru.mutation.SetHandleRemark(s)
+	return rru
+}
+
+// SetNillableHandleRemark sets the "handle_remark" field if the given value is not nil.
+func (rru *ReceiptRequestUpdate) SetNillableHandleRemark(s *string) *ReceiptRequestUpdate {
+	if s != nil {
+		rru.SetHandleRemark(*s)
+	}
+	return rru
+}
+
+// SetHandledBy sets the "handled_by" field.
+func (rru *ReceiptRequestUpdate) SetHandledBy(u uuid.UUID) *ReceiptRequestUpdate {
+	rru.mutation.SetHandledBy(u)
+	return rru
+}
+
+// SetNillableHandledBy sets the "handled_by" field if the given value is not nil.
+func (rru *ReceiptRequestUpdate) SetNillableHandledBy(u *uuid.UUID) *ReceiptRequestUpdate {
+	if u != nil {
+		rru.SetHandledBy(*u)
+	}
+	return rru
+}
+
+// ClearHandledBy clears the value of the "handled_by" field.
+func (rru *ReceiptRequestUpdate) ClearHandledBy() *ReceiptRequestUpdate {
+	rru.mutation.ClearHandledBy()
+	return rru
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (rru *ReceiptRequestUpdate) SetHandledAt(t time.Time) *ReceiptRequestUpdate {
+	rru.mutation.SetHandledAt(t)
+	return rru
+}
+
+// SetNillableHandledAt sets the "handled_at" field if the given value is not nil.
+func (rru *ReceiptRequestUpdate) SetNillableHandledAt(t *time.Time) *ReceiptRequestUpdate {
+	if t != nil {
+		rru.SetHandledAt(*t)
+	}
+	return rru
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (rru *ReceiptRequestUpdate) ClearHandledAt() *ReceiptRequestUpdate {
+	rru.mutation.ClearHandledAt()
+	return rru
+}
+
+// Mutation returns the ReceiptRequestMutation object of the builder.
+func (rru *ReceiptRequestUpdate) Mutation() *ReceiptRequestMutation {
+	return rru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *ReceiptRequestUpdate) Save(ctx context.Context) (int, error) {
+	rru.defaults()
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *ReceiptRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *ReceiptRequestUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *ReceiptRequestUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rru *ReceiptRequestUpdate) defaults() {
+	if _, ok := rru.mutation.UpdatedAt(); !ok {
+		v := receiptrequest.UpdateDefaultUpdatedAt()
+		rru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rru *ReceiptRequestUpdate) check() error {
+	if v, ok := rru.mutation.Status(); ok {
+		if err := receiptrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.HandleRemark(); ok {
+		if err := receiptrequest.HandleRemarkValidator(v); err != nil {
+			return &ValidationError{Name: "handle_remark", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.handle_remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rru *ReceiptRequestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReceiptRequestUpdate {
+	rru.modifiers = append(rru.modifiers, modifiers...)
+	return rru
+}
+
+func (rru *ReceiptRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(receiptrequest.Table, receiptrequest.Columns, sqlgraph.NewFieldSpec(receiptrequest.FieldID, field.TypeUUID))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.UpdatedAt(); ok {
+		_spec.SetField(receiptrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rru.mutation.Status(); ok {
+		_spec.SetField(receiptrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rru.mutation.HandleRemark(); ok {
+		_spec.SetField(receiptrequest.FieldHandleRemark, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.HandledBy(); ok {
+		_spec.SetField(receiptrequest.FieldHandledBy, field.TypeUUID, value)
+	}
+	if rru.mutation.HandledByCleared() {
+		_spec.ClearField(receiptrequest.FieldHandledBy, field.TypeUUID)
+	}
+	if value, ok := rru.mutation.HandledAt(); ok {
+		_spec.SetField(receiptrequest.FieldHandledAt, field.TypeTime, value)
+	}
+	if rru.mutation.HandledAtCleared() {
+		_spec.ClearField(receiptrequest.FieldHandledAt, field.TypeTime)
+	}
+	_spec.AddModifiers(rru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receiptrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// ReceiptRequestUpdateOne is the builder for updating a single ReceiptRequest entity.
+type ReceiptRequestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReceiptRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rruo *ReceiptRequestUpdateOne) SetUpdatedAt(t time.Time) *ReceiptRequestUpdateOne {
+	rruo.mutation.SetUpdatedAt(t)
+	return rruo
+}
+
+// SetStatus sets the "status" field.
+func (rruo *ReceiptRequestUpdateOne) SetStatus(drs domain.ReceiptRequestStatus) *ReceiptRequestUpdateOne {
+	rruo.mutation.SetStatus(drs)
+	return rruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rruo *ReceiptRequestUpdateOne) SetNillableStatus(drs *domain.ReceiptRequestStatus) *ReceiptRequestUpdateOne {
+	if drs != nil {
+		rruo.SetStatus(*drs)
+	}
+	return rruo
+}
+
+// SetHandleRemark sets the "handle_remark" field.
+func (rruo *ReceiptRequestUpdateOne) SetHandleRemark(s string) *ReceiptRequestUpdateOne {
+	rruo.mutation.SetHandleRemark(s)
+	return rruo
+}
+
+// SetNillableHandleRemark sets the "handle_remark" field if the given value is not nil.
+func (rruo *ReceiptRequestUpdateOne) SetNillableHandleRemark(s *string) *ReceiptRequestUpdateOne {
+	if s != nil {
+		rruo.SetHandleRemark(*s)
+	}
+	return rruo
+}
+
+// SetHandledBy sets the "handled_by" field.
+func (rruo *ReceiptRequestUpdateOne) SetHandledBy(u uuid.UUID) *ReceiptRequestUpdateOne {
+	rruo.mutation.SetHandledBy(u)
+	return rruo
+}
+
+// SetNillableHandledBy sets the "handled_by" field if the given value is not nil.
+func (rruo *ReceiptRequestUpdateOne) SetNillableHandledBy(u *uuid.UUID) *ReceiptRequestUpdateOne {
+	if u != nil {
+		rruo.SetHandledBy(*u)
+	}
+	return rruo
+}
+
+// ClearHandledBy clears the value of the "handled_by" field.
+func (rruo *ReceiptRequestUpdateOne) ClearHandledBy() *ReceiptRequestUpdateOne {
+	rruo.mutation.ClearHandledBy()
+	return rruo
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (rruo *ReceiptRequestUpdateOne) SetHandledAt(t time.Time) *ReceiptRequestUpdateOne {
+	rruo.mutation.SetHandledAt(t)
+	return rruo
+}
+
+// SetNillableHandledAt sets the "handled_at" field if the given value is not nil.
+func (rruo *ReceiptRequestUpdateOne) SetNillableHandledAt(t *time.Time) *ReceiptRequestUpdateOne {
+	if t != nil {
+		rruo.SetHandledAt(*t)
+	}
+	return rruo
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (rruo *ReceiptRequestUpdateOne) ClearHandledAt() *ReceiptRequestUpdateOne {
+	rruo.mutation.ClearHandledAt()
+	return rruo
+}
+
+// Mutation returns the ReceiptRequestMutation object of the builder.
+func (rruo *ReceiptRequestUpdateOne) Mutation() *ReceiptRequestMutation {
+	return rruo.mutation
+}
+
+// Where appends a list predicates to the ReceiptRequestUpdate builder.
+func (rruo *ReceiptRequestUpdateOne) Where(ps ...predicate.ReceiptRequest) *ReceiptRequestUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *ReceiptRequestUpdateOne) Select(field string, fields ...string) *ReceiptRequestUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated ReceiptRequest entity.
+func (rruo *ReceiptRequestUpdateOne) Save(ctx context.Context) (*ReceiptRequest, error) {
+	rruo.defaults()
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *ReceiptRequestUpdateOne) SaveX(ctx context.Context) *ReceiptRequest {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *ReceiptRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *ReceiptRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rruo *ReceiptRequestUpdateOne) defaults() {
+	if _, ok := rruo.mutation.UpdatedAt(); !ok {
+		v := receiptrequest.UpdateDefaultUpdatedAt()
+		rruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rruo *ReceiptRequestUpdateOne) check() error {
+	if v, ok := rruo.mutation.Status(); ok {
+		if err := receiptrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.HandleRemark(); ok {
+		if err := receiptrequest.HandleRemarkValidator(v); err != nil {
+			return &ValidationError{Name: "handle_remark", err: fmt.Errorf(`ent: validator failed for field "ReceiptRequest.handle_remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rruo *ReceiptRequestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReceiptRequestUpdateOne {
+	rruo.modifiers = append(rruo.modifiers, modifiers...)
+	return rruo
+}
+
+func (rruo *ReceiptRequestUpdateOne) sqlSave(ctx context.Context) (_node *ReceiptRequest, err error) {
+	if err := rruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(receiptrequest.Table, receiptrequest.Columns, sqlgraph.NewFieldSpec(receiptrequest.FieldID, field.TypeUUID))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReceiptRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, receiptrequest.FieldID)
+		for _, f := range fields {
+			if !receiptrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != receiptrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(receiptrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rruo.mutation.Status(); ok {
+		_spec.SetField(receiptrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rruo.mutation.HandleRemark(); ok {
+		_spec.SetField(receiptrequest.FieldHandleRemark, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.HandledBy(); ok {
+		_spec.SetField(receiptrequest.FieldHandledBy, field.TypeUUID, value)
+	}
+	if rruo.mutation.HandledByCleared() {
+		_spec.ClearField(receiptrequest.FieldHandledBy, field.TypeUUID)
+	}
+	if value, ok := rruo.mutation.HandledAt(); ok {
+		_spec.SetField(receiptrequest.FieldHandledAt, field.TypeTime, value)
+	}
+	if rruo.mutation.HandledAtCleared() {
+		_spec.ClearField(receiptrequest.FieldHandledAt, field.TypeTime)
+	}
+	_spec.AddModifiers(rruo.modifiers...)
+	_node = &ReceiptRequest{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receiptrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
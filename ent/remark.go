@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -30,6 +31,8 @@ type Remark struct {
 	DeletedAt int64 `json:"deleted_at,omitempty"`
 	// 备注名称
 	Name string `json:"name,omitempty"`
+	// 备注名称翻译（按语言）
+	NameI18n domain.LocalizedText `json:"name_i18n,omitempty"`
 	// 备注类型：系统、品牌
 	RemarkType domain.RemarkType `json:"remark_type,omitempty"`
 	// 是否启用
@@ -86,6 +89,8 @@ func (*Remark) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case remark.FieldNameI18n:
+			values[i] = new([]byte)
 		case remark.FieldEnabled:
 			values[i] = new(sql.NullBool)
 		case remark.FieldDeletedAt, remark.FieldSortOrder:
@@ -141,6 +146,14 @@ func (r *Remark) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.Name = value.String
 			}
+		case remark.FieldNameI18n:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field name_i18n", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.NameI18n); err != nil {
+					return fmt.Errorf("unmarshal field name_i18n: %w", err)
+				}
+			}
 		case remark.FieldRemarkType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field remark_type", values[i])
@@ -235,6 +248,9 @@ func (r *Remark) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(r.Name)
 	builder.WriteString(", ")
+	builder.WriteString("name_i18n=")
+	builder.WriteString(fmt.Sprintf("%v", r.NameI18n))
+	builder.WriteString(", ")
 	builder.WriteString("remark_type=")
 	builder.WriteString(fmt.Sprintf("%v", r.RemarkType))
 	builder.WriteString(", ")
@@ -26,6 +26,8 @@ const (
 	FieldDeletedAt = "deleted_at"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldNameI18n holds the string denoting the name_i18n field in the database.
+	FieldNameI18n = "name_i18n"
 	// FieldRemarkType holds the string denoting the remark_type field in the database.
 	FieldRemarkType = "remark_type"
 	// FieldEnabled holds the string denoting the enabled field in the database.
@@ -67,6 +69,7 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldDeletedAt,
 	FieldName,
+	FieldNameI18n,
 	FieldRemarkType,
 	FieldEnabled,
 	FieldSortOrder,
@@ -282,6 +282,16 @@ func NameContainsFold(v string) predicate.Remark {
 	return predicate.Remark(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameI18nIsNil applies the IsNil predicate on the "name_i18n" field.
+func NameI18nIsNil() predicate.Remark {
+	return predicate.Remark(sql.FieldIsNull(FieldNameI18n))
+}
+
+// NameI18nNotNil applies the NotNil predicate on the "name_i18n" field.
+func NameI18nNotNil() predicate.Remark {
+	return predicate.Remark(sql.FieldNotNull(FieldNameI18n))
+}
+
 // RemarkTypeEQ applies the EQ predicate on the "remark_type" field.
 func RemarkTypeEQ(v domain.RemarkType) predicate.Remark {
 	vc := v
@@ -75,6 +75,12 @@ func (rc *RemarkCreate) SetName(s string) *RemarkCreate {
 	return rc
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (rc *RemarkCreate) SetNameI18n(dt domain.LocalizedText) *RemarkCreate {
+	rc.mutation.SetNameI18n(dt)
+	return rc
+}
+
 // SetRemarkType sets the "remark_type" field.
 func (rc *RemarkCreate) SetRemarkType(dt domain.RemarkType) *RemarkCreate {
 	rc.mutation.SetRemarkType(dt)
@@ -333,6 +339,10 @@ func (rc *RemarkCreate) createSpec() (*Remark, *sqlgraph.CreateSpec) {
 		_spec.SetField(remark.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := rc.mutation.NameI18n(); ok {
+		_spec.SetField(remark.FieldNameI18n, field.TypeJSON, value)
+		_node.NameI18n = value
+	}
 	if value, ok := rc.mutation.RemarkType(); ok {
 		_spec.SetField(remark.FieldRemarkType, field.TypeEnum, value)
 		_node.RemarkType = value
@@ -477,6 +487,24 @@ func (u *RemarkUpsert) UpdateName() *RemarkUpsert {
 	return u
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *RemarkUpsert) SetNameI18n(v domain.LocalizedText) *RemarkUpsert {
+	u.Set(remark.FieldNameI18n, v)
+	return u
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *RemarkUpsert) UpdateNameI18n() *RemarkUpsert {
+	u.SetExcluded(remark.FieldNameI18n)
+	return u
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *RemarkUpsert) ClearNameI18n() *RemarkUpsert {
+	u.SetNull(remark.FieldNameI18n)
+	return u
+}
+
 // SetEnabled sets the "enabled" field.
 func (u *RemarkUpsert) SetEnabled(v bool) *RemarkUpsert {
 	u.Set(remark.FieldEnabled, v)
@@ -628,6 +656,27 @@ func (u *RemarkUpsertOne) UpdateName() *RemarkUpsertOne {
 	})
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *RemarkUpsertOne) SetNameI18n(v domain.LocalizedText) *RemarkUpsertOne {
+	return u.Update(func(s *RemarkUpsert) {
+		s.SetNameI18n(v)
+	})
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *RemarkUpsertOne) UpdateNameI18n() *RemarkUpsertOne {
+	return u.Update(func(s *RemarkUpsert) {
+		s.UpdateNameI18n()
+	})
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *RemarkUpsertOne) ClearNameI18n() *RemarkUpsertOne {
+	return u.Update(func(s *RemarkUpsert) {
+		s.ClearNameI18n()
+	})
+}
+
 // SetEnabled sets the "enabled" field.
 func (u *RemarkUpsertOne) SetEnabled(v bool) *RemarkUpsertOne {
 	return u.Update(func(s *RemarkUpsert) {
@@ -953,6 +1002,27 @@ func (u *RemarkUpsertBulk) UpdateName() *RemarkUpsertBulk {
 	})
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (u *RemarkUpsertBulk) SetNameI18n(v domain.LocalizedText) *RemarkUpsertBulk {
+	return u.Update(func(s *RemarkUpsert) {
+		s.SetNameI18n(v)
+	})
+}
+
+// UpdateNameI18n sets the "name_i18n" field to the value that was provided on create.
+func (u *RemarkUpsertBulk) UpdateNameI18n() *RemarkUpsertBulk {
+	return u.Update(func(s *RemarkUpsert) {
+		s.UpdateNameI18n()
+	})
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (u *RemarkUpsertBulk) ClearNameI18n() *RemarkUpsertBulk {
+	return u.Update(func(s *RemarkUpsert) {
+		s.ClearNameI18n()
+	})
+}
+
 // SetEnabled sets the "enabled" field.
 func (u *RemarkUpsertBulk) SetEnabled(v bool) *RemarkUpsertBulk {
 	return u.Update(func(s *RemarkUpsert) {
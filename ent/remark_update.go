@@ -71,6 +71,18 @@ func (ru *RemarkUpdate) SetNillableName(s *string) *RemarkUpdate {
 	return ru
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (ru *RemarkUpdate) SetNameI18n(dt domain.LocalizedText) *RemarkUpdate {
+	ru.mutation.SetNameI18n(dt)
+	return ru
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (ru *RemarkUpdate) ClearNameI18n() *RemarkUpdate {
+	ru.mutation.ClearNameI18n()
+	return ru
+}
+
 // SetEnabled sets the "enabled" field.
 func (ru *RemarkUpdate) SetEnabled(b bool) *RemarkUpdate {
 	ru.mutation.SetEnabled(b)
@@ -212,6 +224,12 @@ func (ru *RemarkUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ru.mutation.Name(); ok {
 		_spec.SetField(remark.FieldName, field.TypeString, value)
 	}
+	if value, ok := ru.mutation.NameI18n(); ok {
+		_spec.SetField(remark.FieldNameI18n, field.TypeJSON, value)
+	}
+	if ru.mutation.NameI18nCleared() {
+		_spec.ClearField(remark.FieldNameI18n, field.TypeJSON)
+	}
 	if value, ok := ru.mutation.Enabled(); ok {
 		_spec.SetField(remark.FieldEnabled, field.TypeBool, value)
 	}
@@ -287,6 +305,18 @@ func (ruo *RemarkUpdateOne) SetNillableName(s *string) *RemarkUpdateOne {
 	return ruo
 }
 
+// SetNameI18n sets the "name_i18n" field.
+func (ruo *RemarkUpdateOne) SetNameI18n(dt domain.LocalizedText) *RemarkUpdateOne {
+	ruo.mutation.SetNameI18n(dt)
+	return ruo
+}
+
+// ClearNameI18n clears the value of the "name_i18n" field.
+func (ruo *RemarkUpdateOne) ClearNameI18n() *RemarkUpdateOne {
+	ruo.mutation.ClearNameI18n()
+	return ruo
+}
+
 // SetEnabled sets the "enabled" field.
 func (ruo *RemarkUpdateOne) SetEnabled(b bool) *RemarkUpdateOne {
 	ruo.mutation.SetEnabled(b)
@@ -458,6 +488,12 @@ func (ruo *RemarkUpdateOne) sqlSave(ctx context.Context) (_node *Remark, err err
 	if value, ok := ruo.mutation.Name(); ok {
 		_spec.SetField(remark.FieldName, field.TypeString, value)
 	}
+	if value, ok := ruo.mutation.NameI18n(); ok {
+		_spec.SetField(remark.FieldNameI18n, field.TypeJSON, value)
+	}
+	if ruo.mutation.NameI18nCleared() {
+		_spec.ClearField(remark.FieldNameI18n, field.TypeJSON)
+	}
 	if value, ok := ruo.mutation.Enabled(); ok {
 		_spec.SetField(remark.FieldEnabled, field.TypeBool, value)
 	}
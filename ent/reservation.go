@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservation"
+)
+
+// Reservation is the model entity for the Reservation schema.
+type Reservation struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 预订来源：store=门店代客预订；online=顾客线上预订
+	Source domain.ReservationSource `json:"source,omitempty"`
+	// 线上预订的顾客ID
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+	// 预订人姓名
+	GuestName string `json:"guest_name,omitempty"`
+	// 预订人手机号
+	GuestPhone string `json:"guest_phone,omitempty"`
+	// 用餐人数
+	PartySize int `json:"party_size,omitempty"`
+	// 预订到店时间
+	ReservedAt time.Time `json:"reserved_at,omitempty"`
+	// 预计用餐结束时间
+	EndAt time.Time `json:"end_at,omitempty"`
+	// 就餐时段名称
+	DiningPeriod string `json:"dining_period,omitempty"`
+	// 分配的桌台ID
+	TableID uuid.UUID `json:"table_id,omitempty"`
+	// 分配的桌台名称
+	TableName string `json:"table_name,omitempty"`
+	// 区域偏好
+	AreaPreference string `json:"area_preference,omitempty"`
+	// 备注
+	Remark string `json:"remark,omitempty"`
+	// 状态：booked=已预订；seated=已入座；no_show=未到店；cancelled=已取消
+	Status domain.ReservationStatus `json:"status,omitempty"`
+	// 定金金额
+	Deposit decimal.Decimal `json:"deposit,omitempty"`
+	// 定金状态：none=无需定金；unpaid=待收取；paid=已收取；refunded=已退还
+	DepositStatus domain.ReservationDepositStatus `json:"deposit_status,omitempty"`
+	// 定金支付方式
+	DepositMethod domain.PaymentMethodPayType `json:"deposit_method,omitempty"`
+	// 定金收取时间
+	DepositPaidAt *time.Time `json:"deposit_paid_at,omitempty"`
+	// 入座后生成的订单ID
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// 入座时间
+	SeatedAt *time.Time `json:"seated_at,omitempty"`
+	// 取消时间
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// 取消原因
+	CancelReason string `json:"cancel_reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reservation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reservation.FieldDeposit:
+			values[i] = new(decimal.Decimal)
+		case reservation.FieldPartySize:
+			values[i] = new(sql.NullInt64)
+		case reservation.FieldSource, reservation.FieldGuestName, reservation.FieldGuestPhone, reservation.FieldDiningPeriod, reservation.FieldTableName, reservation.FieldAreaPreference, reservation.FieldRemark, reservation.FieldStatus, reservation.FieldDepositStatus, reservation.FieldDepositMethod, reservation.FieldCancelReason:
+			values[i] = new(sql.NullString)
+		case reservation.FieldCreatedAt, reservation.FieldUpdatedAt, reservation.FieldReservedAt, reservation.FieldEndAt, reservation.FieldDepositPaidAt, reservation.FieldSeatedAt, reservation.FieldCancelledAt:
+			values[i] = new(sql.NullTime)
+		case reservation.FieldID, reservation.FieldMerchantID, reservation.FieldStoreID, reservation.FieldCustomerID, reservation.FieldTableID, reservation.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reservation fields.
+func (r *Reservation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reservation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				r.ID = *value
+			}
+		case reservation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case reservation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		case reservation.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				r.MerchantID = *value
+			}
+		case reservation.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				r.StoreID = *value
+			}
+		case reservation.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				r.Source = domain.ReservationSource(value.String)
+			}
+		case reservation.FieldCustomerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value != nil {
+				r.CustomerID = *value
+			}
+		case reservation.FieldGuestName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guest_name", values[i])
+			} else if value.Valid {
+				r.GuestName = value.String
+			}
+		case reservation.FieldGuestPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guest_phone", values[i])
+			} else if value.Valid {
+				r.GuestPhone = value.String
+			}
+		case reservation.FieldPartySize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field party_size", values[i])
+			} else if value.Valid {
+				r.PartySize = int(value.Int64)
+			}
+		case reservation.FieldReservedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reserved_at", values[i])
+			} else if value.Valid {
+				r.ReservedAt = value.Time
+			}
+		case reservation.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				r.EndAt = value.Time
+			}
+		case reservation.FieldDiningPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dining_period", values[i])
+			} else if value.Valid {
+				r.DiningPeriod = value.String
+			}
+		case reservation.FieldTableID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field table_id", values[i])
+			} else if value != nil {
+				r.TableID = *value
+			}
+		case reservation.FieldTableName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field table_name", values[i])
+			} else if value.Valid {
+				r.TableName = value.String
+			}
+		case reservation.FieldAreaPreference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field area_preference", values[i])
+			} else if value.Valid {
+				r.AreaPreference = value.String
+			}
+		case reservation.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				r.Remark = value.String
+			}
+		case reservation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = domain.ReservationStatus(value.String)
+			}
+		case reservation.FieldDeposit:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field deposit", values[i])
+			} else if value != nil {
+				r.Deposit = *value
+			}
+		case reservation.FieldDepositStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deposit_status", values[i])
+			} else if value.Valid {
+				r.DepositStatus = domain.ReservationDepositStatus(value.String)
+			}
+		case reservation.FieldDepositMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deposit_method", values[i])
+			} else if value.Valid {
+				r.DepositMethod = domain.PaymentMethodPayType(value.String)
+			}
+		case reservation.FieldDepositPaidAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deposit_paid_at", values[i])
+			} else if value.Valid {
+				r.DepositPaidAt = new(time.Time)
+				*r.DepositPaidAt = value.Time
+			}
+		case reservation.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				r.OrderID = *value
+			}
+		case reservation.FieldSeatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field seated_at", values[i])
+			} else if value.Valid {
+				r.SeatedAt = new(time.Time)
+				*r.SeatedAt = value.Time
+			}
+		case reservation.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				r.CancelledAt = new(time.Time)
+				*r.CancelledAt = value.Time
+			}
+		case reservation.FieldCancelReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_reason", values[i])
+			} else if value.Valid {
+				r.CancelReason = value.String
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reservation.
+// This includes values selected through modifiers, order, etc.
+func (r *Reservation) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Reservation.
+// Note that you need to call Reservation.Unwrap() before calling this method if this Reservation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reservation) Update() *ReservationUpdateOne {
+	return NewReservationClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reservation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reservation) Unwrap() *Reservation {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reservation is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reservation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reservation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", r.Source))
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.CustomerID))
+	builder.WriteString(", ")
+	builder.WriteString("guest_name=")
+	builder.WriteString(r.GuestName)
+	builder.WriteString(", ")
+	builder.WriteString("guest_phone=")
+	builder.WriteString(r.GuestPhone)
+	builder.WriteString(", ")
+	builder.WriteString("party_size=")
+	builder.WriteString(fmt.Sprintf("%v", r.PartySize))
+	builder.WriteString(", ")
+	builder.WriteString("reserved_at=")
+	builder.WriteString(r.ReservedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_at=")
+	builder.WriteString(r.EndAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("dining_period=")
+	builder.WriteString(r.DiningPeriod)
+	builder.WriteString(", ")
+	builder.WriteString("table_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.TableID))
+	builder.WriteString(", ")
+	builder.WriteString("table_name=")
+	builder.WriteString(r.TableName)
+	builder.WriteString(", ")
+	builder.WriteString("area_preference=")
+	builder.WriteString(r.AreaPreference)
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(r.Remark)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", ")
+	builder.WriteString("deposit=")
+	builder.WriteString(fmt.Sprintf("%v", r.Deposit))
+	builder.WriteString(", ")
+	builder.WriteString("deposit_status=")
+	builder.WriteString(fmt.Sprintf("%v", r.DepositStatus))
+	builder.WriteString(", ")
+	builder.WriteString("deposit_method=")
+	builder.WriteString(fmt.Sprintf("%v", r.DepositMethod))
+	builder.WriteString(", ")
+	if v := r.DepositPaidAt; v != nil {
+		builder.WriteString("deposit_paid_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.OrderID))
+	builder.WriteString(", ")
+	if v := r.SeatedAt; v != nil {
+		builder.WriteString("seated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := r.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancel_reason=")
+	builder.WriteString(r.CancelReason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reservations is a parsable slice of Reservation.
+type Reservations []*Reservation
@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reservation
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	// Label holds the string label denoting the reservation type in the database.
+	Label = "reservation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldCustomerID holds the string denoting the customer_id field in the database.
+	FieldCustomerID = "customer_id"
+	// FieldGuestName holds the string denoting the guest_name field in the database.
+	FieldGuestName = "guest_name"
+	// FieldGuestPhone holds the string denoting the guest_phone field in the database.
+	FieldGuestPhone = "guest_phone"
+	// FieldPartySize holds the string denoting the party_size field in the database.
+	FieldPartySize = "party_size"
+	// FieldReservedAt holds the string denoting the reserved_at field in the database.
+	FieldReservedAt = "reserved_at"
+	// FieldEndAt holds the string denoting the end_at field in the database.
+	FieldEndAt = "end_at"
+	// FieldDiningPeriod holds the string denoting the dining_period field in the database.
+	FieldDiningPeriod = "dining_period"
+	// FieldTableID holds the string denoting the table_id field in the database.
+	FieldTableID = "table_id"
+	// FieldTableName holds the string denoting the table_name field in the database.
+	FieldTableName = "table_name"
+	// FieldAreaPreference holds the string denoting the area_preference field in the database.
+	FieldAreaPreference = "area_preference"
+	// FieldRemark holds the string denoting the remark field in the database.
+	FieldRemark = "remark"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDeposit holds the string denoting the deposit field in the database.
+	FieldDeposit = "deposit"
+	// FieldDepositStatus holds the string denoting the deposit_status field in the database.
+	FieldDepositStatus = "deposit_status"
+	// FieldDepositMethod holds the string denoting the deposit_method field in the database.
+	FieldDepositMethod = "deposit_method"
+	// FieldDepositPaidAt holds the string denoting the deposit_paid_at field in the database.
+	FieldDepositPaidAt = "deposit_paid_at"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldSeatedAt holds the string denoting the seated_at field in the database.
+	FieldSeatedAt = "seated_at"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldCancelReason holds the string denoting the cancel_reason field in the database.
+	FieldCancelReason = "cancel_reason"
+	// Table holds the table name of the reservation in the database.
+	Table = "reservations"
+)
+
+// Columns holds all SQL columns for reservation fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldSource,
+	FieldCustomerID,
+	FieldGuestName,
+	FieldGuestPhone,
+	FieldPartySize,
+	FieldReservedAt,
+	FieldEndAt,
+	FieldDiningPeriod,
+	FieldTableID,
+	FieldTableName,
+	FieldAreaPreference,
+	FieldRemark,
+	FieldStatus,
+	FieldDeposit,
+	FieldDepositStatus,
+	FieldDepositMethod,
+	FieldDepositPaidAt,
+	FieldOrderID,
+	FieldSeatedAt,
+	FieldCancelledAt,
+	FieldCancelReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCustomerID holds the default value on creation for the "customer_id" field.
+	DefaultCustomerID func() uuid.UUID
+	// GuestNameValidator is a validator for the "guest_name" field. It is called by the builders before save.
+	GuestNameValidator func(string) error
+	// GuestPhoneValidator is a validator for the "guest_phone" field. It is called by the builders before save.
+	GuestPhoneValidator func(string) error
+	// DefaultDiningPeriod holds the default value on creation for the "dining_period" field.
+	DefaultDiningPeriod string
+	// DiningPeriodValidator is a validator for the "dining_period" field. It is called by the builders before save.
+	DiningPeriodValidator func(string) error
+	// DefaultTableID holds the default value on creation for the "table_id" field.
+	DefaultTableID func() uuid.UUID
+	// DefaultTableName holds the default value on creation for the "table_name" field.
+	DefaultTableName string
+	// TableNameValidator is a validator for the "table_name" field. It is called by the builders before save.
+	TableNameValidator func(string) error
+	// DefaultAreaPreference holds the default value on creation for the "area_preference" field.
+	DefaultAreaPreference string
+	// AreaPreferenceValidator is a validator for the "area_preference" field. It is called by the builders before save.
+	AreaPreferenceValidator func(string) error
+	// DefaultRemark holds the default value on creation for the "remark" field.
+	DefaultRemark string
+	// RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	RemarkValidator func(string) error
+	// DefaultDepositMethod holds the default value on creation for the "deposit_method" field.
+	DefaultDepositMethod domain.PaymentMethodPayType
+	// DepositMethodValidator is a validator for the "deposit_method" field. It is called by the builders before save.
+	DepositMethodValidator func(string) error
+	// DefaultOrderID holds the default value on creation for the "order_id" field.
+	DefaultOrderID func() uuid.UUID
+	// DefaultCancelReason holds the default value on creation for the "cancel_reason" field.
+	DefaultCancelReason string
+	// CancelReasonValidator is a validator for the "cancel_reason" field. It is called by the builders before save.
+	CancelReasonValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s domain.ReservationSource) error {
+	switch s {
+	case "store", "online":
+		return nil
+	default:
+		return fmt.Errorf("reservation: invalid enum value for source field: %q", s)
+	}
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s domain.ReservationStatus) error {
+	switch s {
+	case "booked", "seated", "no_show", "cancelled":
+		return nil
+	default:
+		return fmt.Errorf("reservation: invalid enum value for status field: %q", s)
+	}
+}
+
+// DepositStatusValidator is a validator for the "deposit_status" field enum values. It is called by the builders before save.
+func DepositStatusValidator(ds domain.ReservationDepositStatus) error {
+	switch ds {
+	case "none", "unpaid", "paid", "refunded":
+		return nil
+	default:
+		return fmt.Errorf("reservation: invalid enum value for deposit_status field: %q", ds)
+	}
+}
+
+// OrderOption defines the ordering options for the Reservation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByCustomerID orders the results by the customer_id field.
+func ByCustomerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerID, opts...).ToFunc()
+}
+
+// ByGuestName orders the results by the guest_name field.
+func ByGuestName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuestName, opts...).ToFunc()
+}
+
+// ByGuestPhone orders the results by the guest_phone field.
+func ByGuestPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuestPhone, opts...).ToFunc()
+}
+
+// ByPartySize orders the results by the party_size field.
+func ByPartySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPartySize, opts...).ToFunc()
+}
+
+// ByReservedAt orders the results by the reserved_at field.
+func ByReservedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReservedAt, opts...).ToFunc()
+}
+
+// ByEndAt orders the results by the end_at field.
+func ByEndAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndAt, opts...).ToFunc()
+}
+
+// ByDiningPeriod orders the results by the dining_period field.
+func ByDiningPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiningPeriod, opts...).ToFunc()
+}
+
+// ByTableID orders the results by the table_id field.
+func ByTableID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTableID, opts...).ToFunc()
+}
+
+// ByTableName orders the results by the table_name field.
+func ByTableName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTableName, opts...).ToFunc()
+}
+
+// ByAreaPreference orders the results by the area_preference field.
+func ByAreaPreference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAreaPreference, opts...).ToFunc()
+}
+
+// ByRemark orders the results by the remark field.
+func ByRemark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemark, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDeposit orders the results by the deposit field.
+func ByDeposit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeposit, opts...).ToFunc()
+}
+
+// ByDepositStatus orders the results by the deposit_status field.
+func ByDepositStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepositStatus, opts...).ToFunc()
+}
+
+// ByDepositMethod orders the results by the deposit_method field.
+func ByDepositMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepositMethod, opts...).ToFunc()
+}
+
+// ByDepositPaidAt orders the results by the deposit_paid_at field.
+func ByDepositPaidAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepositPaidAt, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// BySeatedAt orders the results by the seated_at field.
+func BySeatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeatedAt, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByCancelReason orders the results by the cancel_reason field.
+func ByCancelReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelReason, opts...).ToFunc()
+}
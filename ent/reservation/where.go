@@ -0,0 +1,1403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reservation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldStoreID, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// GuestName applies equality check predicate on the "guest_name" field. It's identical to GuestNameEQ.
+func GuestName(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldGuestName, v))
+}
+
+// GuestPhone applies equality check predicate on the "guest_phone" field. It's identical to GuestPhoneEQ.
+func GuestPhone(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldGuestPhone, v))
+}
+
+// PartySize applies equality check predicate on the "party_size" field. It's identical to PartySizeEQ.
+func PartySize(v int) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldPartySize, v))
+}
+
+// ReservedAt applies equality check predicate on the "reserved_at" field. It's identical to ReservedAtEQ.
+func ReservedAt(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldReservedAt, v))
+}
+
+// EndAt applies equality check predicate on the "end_at" field. It's identical to EndAtEQ.
+func EndAt(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldEndAt, v))
+}
+
+// DiningPeriod applies equality check predicate on the "dining_period" field. It's identical to DiningPeriodEQ.
+func DiningPeriod(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldDiningPeriod, v))
+}
+
+// TableID applies equality check predicate on the "table_id" field. It's identical to TableIDEQ.
+func TableID(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldTableID, v))
+}
+
+// TableName applies equality check predicate on the "table_name" field. It's identical to TableNameEQ.
+func TableName(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldTableName, v))
+}
+
+// AreaPreference applies equality check predicate on the "area_preference" field. It's identical to AreaPreferenceEQ.
+func AreaPreference(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldAreaPreference, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldRemark, v))
+}
+
+// Deposit applies equality check predicate on the "deposit" field. It's identical to DepositEQ.
+func Deposit(v decimal.Decimal) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldDeposit, v))
+}
+
+// DepositMethod applies equality check predicate on the "deposit_method" field. It's identical to DepositMethodEQ.
+func DepositMethod(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldEQ(FieldDepositMethod, vc))
+}
+
+// DepositPaidAt applies equality check predicate on the "deposit_paid_at" field. It's identical to DepositPaidAtEQ.
+func DepositPaidAt(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldDepositPaidAt, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldOrderID, v))
+}
+
+// SeatedAt applies equality check predicate on the "seated_at" field. It's identical to SeatedAtEQ.
+func SeatedAt(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldSeatedAt, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelReason applies equality check predicate on the "cancel_reason" field. It's identical to CancelReasonEQ.
+func CancelReason(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldCancelReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldStoreID, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v domain.ReservationSource) predicate.Reservation {
+	vc := v
+	return predicate.Reservation(sql.FieldEQ(FieldSource, vc))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v domain.ReservationSource) predicate.Reservation {
+	vc := v
+	return predicate.Reservation(sql.FieldNEQ(FieldSource, vc))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...domain.ReservationSource) predicate.Reservation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reservation(sql.FieldIn(FieldSource, v...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...domain.ReservationSource) predicate.Reservation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reservation(sql.FieldNotIn(FieldSource, v...))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// GuestNameEQ applies the EQ predicate on the "guest_name" field.
+func GuestNameEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldGuestName, v))
+}
+
+// GuestNameNEQ applies the NEQ predicate on the "guest_name" field.
+func GuestNameNEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldGuestName, v))
+}
+
+// GuestNameIn applies the In predicate on the "guest_name" field.
+func GuestNameIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldGuestName, vs...))
+}
+
+// GuestNameNotIn applies the NotIn predicate on the "guest_name" field.
+func GuestNameNotIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldGuestName, vs...))
+}
+
+// GuestNameGT applies the GT predicate on the "guest_name" field.
+func GuestNameGT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldGuestName, v))
+}
+
+// GuestNameGTE applies the GTE predicate on the "guest_name" field.
+func GuestNameGTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldGuestName, v))
+}
+
+// GuestNameLT applies the LT predicate on the "guest_name" field.
+func GuestNameLT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldGuestName, v))
+}
+
+// GuestNameLTE applies the LTE predicate on the "guest_name" field.
+func GuestNameLTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldGuestName, v))
+}
+
+// GuestNameContains applies the Contains predicate on the "guest_name" field.
+func GuestNameContains(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContains(FieldGuestName, v))
+}
+
+// GuestNameHasPrefix applies the HasPrefix predicate on the "guest_name" field.
+func GuestNameHasPrefix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasPrefix(FieldGuestName, v))
+}
+
+// GuestNameHasSuffix applies the HasSuffix predicate on the "guest_name" field.
+func GuestNameHasSuffix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasSuffix(FieldGuestName, v))
+}
+
+// GuestNameEqualFold applies the EqualFold predicate on the "guest_name" field.
+func GuestNameEqualFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEqualFold(FieldGuestName, v))
+}
+
+// GuestNameContainsFold applies the ContainsFold predicate on the "guest_name" field.
+func GuestNameContainsFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContainsFold(FieldGuestName, v))
+}
+
+// GuestPhoneEQ applies the EQ predicate on the "guest_phone" field.
+func GuestPhoneEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldGuestPhone, v))
+}
+
+// GuestPhoneNEQ applies the NEQ predicate on the "guest_phone" field.
+func GuestPhoneNEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldGuestPhone, v))
+}
+
+// GuestPhoneIn applies the In predicate on the "guest_phone" field.
+func GuestPhoneIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldGuestPhone, vs...))
+}
+
+// GuestPhoneNotIn applies the NotIn predicate on the "guest_phone" field.
+func GuestPhoneNotIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldGuestPhone, vs...))
+}
+
+// GuestPhoneGT applies the GT predicate on the "guest_phone" field.
+func GuestPhoneGT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldGuestPhone, v))
+}
+
+// GuestPhoneGTE applies the GTE predicate on the "guest_phone" field.
+func GuestPhoneGTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldGuestPhone, v))
+}
+
+// GuestPhoneLT applies the LT predicate on the "guest_phone" field.
+func GuestPhoneLT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldGuestPhone, v))
+}
+
+// GuestPhoneLTE applies the LTE predicate on the "guest_phone" field.
+func GuestPhoneLTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldGuestPhone, v))
+}
+
+// GuestPhoneContains applies the Contains predicate on the "guest_phone" field.
+func GuestPhoneContains(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContains(FieldGuestPhone, v))
+}
+
+// GuestPhoneHasPrefix applies the HasPrefix predicate on the "guest_phone" field.
+func GuestPhoneHasPrefix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasPrefix(FieldGuestPhone, v))
+}
+
+// GuestPhoneHasSuffix applies the HasSuffix predicate on the "guest_phone" field.
+func GuestPhoneHasSuffix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasSuffix(FieldGuestPhone, v))
+}
+
+// GuestPhoneEqualFold applies the EqualFold predicate on the "guest_phone" field.
+func GuestPhoneEqualFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEqualFold(FieldGuestPhone, v))
+}
+
+// GuestPhoneContainsFold applies the ContainsFold predicate on the "guest_phone" field.
+func GuestPhoneContainsFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContainsFold(FieldGuestPhone, v))
+}
+
+// PartySizeEQ applies the EQ predicate on the "party_size" field.
+func PartySizeEQ(v int) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldPartySize, v))
+}
+
+// PartySizeNEQ applies the NEQ predicate on the "party_size" field.
+func PartySizeNEQ(v int) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldPartySize, v))
+}
+
+// PartySizeIn applies the In predicate on the "party_size" field.
+func PartySizeIn(vs ...int) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldPartySize, vs...))
+}
+
+// PartySizeNotIn applies the NotIn predicate on the "party_size" field.
+func PartySizeNotIn(vs ...int) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldPartySize, vs...))
+}
+
+// PartySizeGT applies the GT predicate on the "party_size" field.
+func PartySizeGT(v int) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldPartySize, v))
+}
+
+// PartySizeGTE applies the GTE predicate on the "party_size" field.
+func PartySizeGTE(v int) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldPartySize, v))
+}
+
+// PartySizeLT applies the LT predicate on the "party_size" field.
+func PartySizeLT(v int) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldPartySize, v))
+}
+
+// PartySizeLTE applies the LTE predicate on the "party_size" field.
+func PartySizeLTE(v int) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldPartySize, v))
+}
+
+// ReservedAtEQ applies the EQ predicate on the "reserved_at" field.
+func ReservedAtEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldReservedAt, v))
+}
+
+// ReservedAtNEQ applies the NEQ predicate on the "reserved_at" field.
+func ReservedAtNEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldReservedAt, v))
+}
+
+// ReservedAtIn applies the In predicate on the "reserved_at" field.
+func ReservedAtIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldReservedAt, vs...))
+}
+
+// ReservedAtNotIn applies the NotIn predicate on the "reserved_at" field.
+func ReservedAtNotIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldReservedAt, vs...))
+}
+
+// ReservedAtGT applies the GT predicate on the "reserved_at" field.
+func ReservedAtGT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldReservedAt, v))
+}
+
+// ReservedAtGTE applies the GTE predicate on the "reserved_at" field.
+func ReservedAtGTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldReservedAt, v))
+}
+
+// ReservedAtLT applies the LT predicate on the "reserved_at" field.
+func ReservedAtLT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldReservedAt, v))
+}
+
+// ReservedAtLTE applies the LTE predicate on the "reserved_at" field.
+func ReservedAtLTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldReservedAt, v))
+}
+
+// EndAtEQ applies the EQ predicate on the "end_at" field.
+func EndAtEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldEndAt, v))
+}
+
+// EndAtNEQ applies the NEQ predicate on the "end_at" field.
+func EndAtNEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldEndAt, v))
+}
+
+// EndAtIn applies the In predicate on the "end_at" field.
+func EndAtIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldEndAt, vs...))
+}
+
+// EndAtNotIn applies the NotIn predicate on the "end_at" field.
+func EndAtNotIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldEndAt, vs...))
+}
+
+// EndAtGT applies the GT predicate on the "end_at" field.
+func EndAtGT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldEndAt, v))
+}
+
+// EndAtGTE applies the GTE predicate on the "end_at" field.
+func EndAtGTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldEndAt, v))
+}
+
+// EndAtLT applies the LT predicate on the "end_at" field.
+func EndAtLT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldEndAt, v))
+}
+
+// EndAtLTE applies the LTE predicate on the "end_at" field.
+func EndAtLTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldEndAt, v))
+}
+
+// DiningPeriodEQ applies the EQ predicate on the "dining_period" field.
+func DiningPeriodEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldDiningPeriod, v))
+}
+
+// DiningPeriodNEQ applies the NEQ predicate on the "dining_period" field.
+func DiningPeriodNEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldDiningPeriod, v))
+}
+
+// DiningPeriodIn applies the In predicate on the "dining_period" field.
+func DiningPeriodIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldDiningPeriod, vs...))
+}
+
+// DiningPeriodNotIn applies the NotIn predicate on the "dining_period" field.
+func DiningPeriodNotIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldDiningPeriod, vs...))
+}
+
+// DiningPeriodGT applies the GT predicate on the "dining_period" field.
+func DiningPeriodGT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldDiningPeriod, v))
+}
+
+// DiningPeriodGTE applies the GTE predicate on the "dining_period" field.
+func DiningPeriodGTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldDiningPeriod, v))
+}
+
+// DiningPeriodLT applies the LT predicate on the "dining_period" field.
+func DiningPeriodLT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldDiningPeriod, v))
+}
+
+// DiningPeriodLTE applies the LTE predicate on the "dining_period" field.
+func DiningPeriodLTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldDiningPeriod, v))
+}
+
+// DiningPeriodContains applies the Contains predicate on the "dining_period" field.
+func DiningPeriodContains(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContains(FieldDiningPeriod, v))
+}
+
+// DiningPeriodHasPrefix applies the HasPrefix predicate on the "dining_period" field.
+func DiningPeriodHasPrefix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasPrefix(FieldDiningPeriod, v))
+}
+
+// DiningPeriodHasSuffix applies the HasSuffix predicate on the "dining_period" field.
+func DiningPeriodHasSuffix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasSuffix(FieldDiningPeriod, v))
+}
+
+// DiningPeriodEqualFold applies the EqualFold predicate on the "dining_period" field.
+func DiningPeriodEqualFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEqualFold(FieldDiningPeriod, v))
+}
+
+// DiningPeriodContainsFold applies the ContainsFold predicate on the "dining_period" field.
+func DiningPeriodContainsFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContainsFold(FieldDiningPeriod, v))
+}
+
+// TableIDEQ applies the EQ predicate on the "table_id" field.
+func TableIDEQ(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldTableID, v))
+}
+
+// TableIDNEQ applies the NEQ predicate on the "table_id" field.
+func TableIDNEQ(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldTableID, v))
+}
+
+// TableIDIn applies the In predicate on the "table_id" field.
+func TableIDIn(vs ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldTableID, vs...))
+}
+
+// TableIDNotIn applies the NotIn predicate on the "table_id" field.
+func TableIDNotIn(vs ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldTableID, vs...))
+}
+
+// TableIDGT applies the GT predicate on the "table_id" field.
+func TableIDGT(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldTableID, v))
+}
+
+// TableIDGTE applies the GTE predicate on the "table_id" field.
+func TableIDGTE(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldTableID, v))
+}
+
+// TableIDLT applies the LT predicate on the "table_id" field.
+func TableIDLT(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldTableID, v))
+}
+
+// TableIDLTE applies the LTE predicate on the "table_id" field.
+func TableIDLTE(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldTableID, v))
+}
+
+// TableNameEQ applies the EQ predicate on the "table_name" field.
+func TableNameEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldTableName, v))
+}
+
+// TableNameNEQ applies the NEQ predicate on the "table_name" field.
+func TableNameNEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldTableName, v))
+}
+
+// TableNameIn applies the In predicate on the "table_name" field.
+func TableNameIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldTableName, vs...))
+}
+
+// TableNameNotIn applies the NotIn predicate on the "table_name" field.
+func TableNameNotIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldTableName, vs...))
+}
+
+// TableNameGT applies the GT predicate on the "table_name" field.
+func TableNameGT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldTableName, v))
+}
+
+// TableNameGTE applies the GTE predicate on the "table_name" field.
+func TableNameGTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldTableName, v))
+}
+
+// TableNameLT applies the LT predicate on the "table_name" field.
+func TableNameLT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldTableName, v))
+}
+
+// TableNameLTE applies the LTE predicate on the "table_name" field.
+func TableNameLTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldTableName, v))
+}
+
+// TableNameContains applies the Contains predicate on the "table_name" field.
+func TableNameContains(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContains(FieldTableName, v))
+}
+
+// TableNameHasPrefix applies the HasPrefix predicate on the "table_name" field.
+func TableNameHasPrefix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasPrefix(FieldTableName, v))
+}
+
+// TableNameHasSuffix applies the HasSuffix predicate on the "table_name" field.
+func TableNameHasSuffix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasSuffix(FieldTableName, v))
+}
+
+// TableNameEqualFold applies the EqualFold predicate on the "table_name" field.
+func TableNameEqualFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEqualFold(FieldTableName, v))
+}
+
+// TableNameContainsFold applies the ContainsFold predicate on the "table_name" field.
+func TableNameContainsFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContainsFold(FieldTableName, v))
+}
+
+// AreaPreferenceEQ applies the EQ predicate on the "area_preference" field.
+func AreaPreferenceEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldAreaPreference, v))
+}
+
+// AreaPreferenceNEQ applies the NEQ predicate on the "area_preference" field.
+func AreaPreferenceNEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldAreaPreference, v))
+}
+
+// AreaPreferenceIn applies the In predicate on the "area_preference" field.
+func AreaPreferenceIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldAreaPreference, vs...))
+}
+
+// AreaPreferenceNotIn applies the NotIn predicate on the "area_preference" field.
+func AreaPreferenceNotIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldAreaPreference, vs...))
+}
+
+// AreaPreferenceGT applies the GT predicate on the "area_preference" field.
+func AreaPreferenceGT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldAreaPreference, v))
+}
+
+// AreaPreferenceGTE applies the GTE predicate on the "area_preference" field.
+func AreaPreferenceGTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldAreaPreference, v))
+}
+
+// AreaPreferenceLT applies the LT predicate on the "area_preference" field.
+func AreaPreferenceLT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldAreaPreference, v))
+}
+
+// AreaPreferenceLTE applies the LTE predicate on the "area_preference" field.
+func AreaPreferenceLTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldAreaPreference, v))
+}
+
+// AreaPreferenceContains applies the Contains predicate on the "area_preference" field.
+func AreaPreferenceContains(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContains(FieldAreaPreference, v))
+}
+
+// AreaPreferenceHasPrefix applies the HasPrefix predicate on the "area_preference" field.
+func AreaPreferenceHasPrefix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasPrefix(FieldAreaPreference, v))
+}
+
+// AreaPreferenceHasSuffix applies the HasSuffix predicate on the "area_preference" field.
+func AreaPreferenceHasSuffix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasSuffix(FieldAreaPreference, v))
+}
+
+// AreaPreferenceEqualFold applies the EqualFold predicate on the "area_preference" field.
+func AreaPreferenceEqualFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEqualFold(FieldAreaPreference, v))
+}
+
+// AreaPreferenceContainsFold applies the ContainsFold predicate on the "area_preference" field.
+func AreaPreferenceContainsFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContainsFold(FieldAreaPreference, v))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v domain.ReservationStatus) predicate.Reservation {
+	vc := v
+	return predicate.Reservation(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v domain.ReservationStatus) predicate.Reservation {
+	vc := v
+	return predicate.Reservation(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...domain.ReservationStatus) predicate.Reservation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reservation(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...domain.ReservationStatus) predicate.Reservation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reservation(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// DepositEQ applies the EQ predicate on the "deposit" field.
+func DepositEQ(v decimal.Decimal) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldDeposit, v))
+}
+
+// DepositNEQ applies the NEQ predicate on the "deposit" field.
+func DepositNEQ(v decimal.Decimal) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldDeposit, v))
+}
+
+// DepositIn applies the In predicate on the "deposit" field.
+func DepositIn(vs ...decimal.Decimal) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldDeposit, vs...))
+}
+
+// DepositNotIn applies the NotIn predicate on the "deposit" field.
+func DepositNotIn(vs ...decimal.Decimal) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldDeposit, vs...))
+}
+
+// DepositGT applies the GT predicate on the "deposit" field.
+func DepositGT(v decimal.Decimal) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldDeposit, v))
+}
+
+// DepositGTE applies the GTE predicate on the "deposit" field.
+func DepositGTE(v decimal.Decimal) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldDeposit, v))
+}
+
+// DepositLT applies the LT predicate on the "deposit" field.
+func DepositLT(v decimal.Decimal) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldDeposit, v))
+}
+
+// DepositLTE applies the LTE predicate on the "deposit" field.
+func DepositLTE(v decimal.Decimal) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldDeposit, v))
+}
+
+// DepositStatusEQ applies the EQ predicate on the "deposit_status" field.
+func DepositStatusEQ(v domain.ReservationDepositStatus) predicate.Reservation {
+	vc := v
+	return predicate.Reservation(sql.FieldEQ(FieldDepositStatus, vc))
+}
+
+// DepositStatusNEQ applies the NEQ predicate on the "deposit_status" field.
+func DepositStatusNEQ(v domain.ReservationDepositStatus) predicate.Reservation {
+	vc := v
+	return predicate.Reservation(sql.FieldNEQ(FieldDepositStatus, vc))
+}
+
+// DepositStatusIn applies the In predicate on the "deposit_status" field.
+func DepositStatusIn(vs ...domain.ReservationDepositStatus) predicate.Reservation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reservation(sql.FieldIn(FieldDepositStatus, v...))
+}
+
+// DepositStatusNotIn applies the NotIn predicate on the "deposit_status" field.
+func DepositStatusNotIn(vs ...domain.ReservationDepositStatus) predicate.Reservation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reservation(sql.FieldNotIn(FieldDepositStatus, v...))
+}
+
+// DepositMethodEQ applies the EQ predicate on the "deposit_method" field.
+func DepositMethodEQ(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldEQ(FieldDepositMethod, vc))
+}
+
+// DepositMethodNEQ applies the NEQ predicate on the "deposit_method" field.
+func DepositMethodNEQ(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldNEQ(FieldDepositMethod, vc))
+}
+
+// DepositMethodIn applies the In predicate on the "deposit_method" field.
+func DepositMethodIn(vs ...domain.PaymentMethodPayType) predicate.Reservation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Reservation(sql.FieldIn(FieldDepositMethod, v...))
+}
+
+// DepositMethodNotIn applies the NotIn predicate on the "deposit_method" field.
+func DepositMethodNotIn(vs ...domain.PaymentMethodPayType) predicate.Reservation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Reservation(sql.FieldNotIn(FieldDepositMethod, v...))
+}
+
+// DepositMethodGT applies the GT predicate on the "deposit_method" field.
+func DepositMethodGT(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldGT(FieldDepositMethod, vc))
+}
+
+// DepositMethodGTE applies the GTE predicate on the "deposit_method" field.
+func DepositMethodGTE(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldGTE(FieldDepositMethod, vc))
+}
+
+// DepositMethodLT applies the LT predicate on the "deposit_method" field.
+func DepositMethodLT(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldLT(FieldDepositMethod, vc))
+}
+
+// DepositMethodLTE applies the LTE predicate on the "deposit_method" field.
+func DepositMethodLTE(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldLTE(FieldDepositMethod, vc))
+}
+
+// DepositMethodContains applies the Contains predicate on the "deposit_method" field.
+func DepositMethodContains(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldContains(FieldDepositMethod, vc))
+}
+
+// DepositMethodHasPrefix applies the HasPrefix predicate on the "deposit_method" field.
+func DepositMethodHasPrefix(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldHasPrefix(FieldDepositMethod, vc))
+}
+
+// DepositMethodHasSuffix applies the HasSuffix predicate on the "deposit_method" field.
+func DepositMethodHasSuffix(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldHasSuffix(FieldDepositMethod, vc))
+}
+
+// DepositMethodEqualFold applies the EqualFold predicate on the "deposit_method" field.
+func DepositMethodEqualFold(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldEqualFold(FieldDepositMethod, vc))
+}
+
+// DepositMethodContainsFold applies the ContainsFold predicate on the "deposit_method" field.
+func DepositMethodContainsFold(v domain.PaymentMethodPayType) predicate.Reservation {
+	vc := string(v)
+	return predicate.Reservation(sql.FieldContainsFold(FieldDepositMethod, vc))
+}
+
+// DepositPaidAtEQ applies the EQ predicate on the "deposit_paid_at" field.
+func DepositPaidAtEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldDepositPaidAt, v))
+}
+
+// DepositPaidAtNEQ applies the NEQ predicate on the "deposit_paid_at" field.
+func DepositPaidAtNEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldDepositPaidAt, v))
+}
+
+// DepositPaidAtIn applies the In predicate on the "deposit_paid_at" field.
+func DepositPaidAtIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldDepositPaidAt, vs...))
+}
+
+// DepositPaidAtNotIn applies the NotIn predicate on the "deposit_paid_at" field.
+func DepositPaidAtNotIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldDepositPaidAt, vs...))
+}
+
+// DepositPaidAtGT applies the GT predicate on the "deposit_paid_at" field.
+func DepositPaidAtGT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldDepositPaidAt, v))
+}
+
+// DepositPaidAtGTE applies the GTE predicate on the "deposit_paid_at" field.
+func DepositPaidAtGTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldDepositPaidAt, v))
+}
+
+// DepositPaidAtLT applies the LT predicate on the "deposit_paid_at" field.
+func DepositPaidAtLT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldDepositPaidAt, v))
+}
+
+// DepositPaidAtLTE applies the LTE predicate on the "deposit_paid_at" field.
+func DepositPaidAtLTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldDepositPaidAt, v))
+}
+
+// DepositPaidAtIsNil applies the IsNil predicate on the "deposit_paid_at" field.
+func DepositPaidAtIsNil() predicate.Reservation {
+	return predicate.Reservation(sql.FieldIsNull(FieldDepositPaidAt))
+}
+
+// DepositPaidAtNotNil applies the NotNil predicate on the "deposit_paid_at" field.
+func DepositPaidAtNotNil() predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotNull(FieldDepositPaidAt))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v uuid.UUID) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldOrderID, v))
+}
+
+// SeatedAtEQ applies the EQ predicate on the "seated_at" field.
+func SeatedAtEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldSeatedAt, v))
+}
+
+// SeatedAtNEQ applies the NEQ predicate on the "seated_at" field.
+func SeatedAtNEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldSeatedAt, v))
+}
+
+// SeatedAtIn applies the In predicate on the "seated_at" field.
+func SeatedAtIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldSeatedAt, vs...))
+}
+
+// SeatedAtNotIn applies the NotIn predicate on the "seated_at" field.
+func SeatedAtNotIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldSeatedAt, vs...))
+}
+
+// SeatedAtGT applies the GT predicate on the "seated_at" field.
+func SeatedAtGT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldSeatedAt, v))
+}
+
+// SeatedAtGTE applies the GTE predicate on the "seated_at" field.
+func SeatedAtGTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldSeatedAt, v))
+}
+
+// SeatedAtLT applies the LT predicate on the "seated_at" field.
+func SeatedAtLT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldSeatedAt, v))
+}
+
+// SeatedAtLTE applies the LTE predicate on the "seated_at" field.
+func SeatedAtLTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldSeatedAt, v))
+}
+
+// SeatedAtIsNil applies the IsNil predicate on the "seated_at" field.
+func SeatedAtIsNil() predicate.Reservation {
+	return predicate.Reservation(sql.FieldIsNull(FieldSeatedAt))
+}
+
+// SeatedAtNotNil applies the NotNil predicate on the "seated_at" field.
+func SeatedAtNotNil() predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotNull(FieldSeatedAt))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.Reservation {
+	return predicate.Reservation(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CancelReasonEQ applies the EQ predicate on the "cancel_reason" field.
+func CancelReasonEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEQ(FieldCancelReason, v))
+}
+
+// CancelReasonNEQ applies the NEQ predicate on the "cancel_reason" field.
+func CancelReasonNEQ(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNEQ(FieldCancelReason, v))
+}
+
+// CancelReasonIn applies the In predicate on the "cancel_reason" field.
+func CancelReasonIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldIn(FieldCancelReason, vs...))
+}
+
+// CancelReasonNotIn applies the NotIn predicate on the "cancel_reason" field.
+func CancelReasonNotIn(vs ...string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldNotIn(FieldCancelReason, vs...))
+}
+
+// CancelReasonGT applies the GT predicate on the "cancel_reason" field.
+func CancelReasonGT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGT(FieldCancelReason, v))
+}
+
+// CancelReasonGTE applies the GTE predicate on the "cancel_reason" field.
+func CancelReasonGTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldGTE(FieldCancelReason, v))
+}
+
+// CancelReasonLT applies the LT predicate on the "cancel_reason" field.
+func CancelReasonLT(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLT(FieldCancelReason, v))
+}
+
+// CancelReasonLTE applies the LTE predicate on the "cancel_reason" field.
+func CancelReasonLTE(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldLTE(FieldCancelReason, v))
+}
+
+// CancelReasonContains applies the Contains predicate on the "cancel_reason" field.
+func CancelReasonContains(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContains(FieldCancelReason, v))
+}
+
+// CancelReasonHasPrefix applies the HasPrefix predicate on the "cancel_reason" field.
+func CancelReasonHasPrefix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasPrefix(FieldCancelReason, v))
+}
+
+// CancelReasonHasSuffix applies the HasSuffix predicate on the "cancel_reason" field.
+func CancelReasonHasSuffix(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldHasSuffix(FieldCancelReason, v))
+}
+
+// CancelReasonEqualFold applies the EqualFold predicate on the "cancel_reason" field.
+func CancelReasonEqualFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldEqualFold(FieldCancelReason, v))
+}
+
+// CancelReasonContainsFold applies the ContainsFold predicate on the "cancel_reason" field.
+func CancelReasonContainsFold(v string) predicate.Reservation {
+	return predicate.Reservation(sql.FieldContainsFold(FieldCancelReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Reservation) predicate.Reservation {
+	return predicate.Reservation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Reservation) predicate.Reservation {
+	return predicate.Reservation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Reservation) predicate.Reservation {
+	return predicate.Reservation(sql.NotPredicates(p))
+}
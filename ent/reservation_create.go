@@ -0,0 +1,1569 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservation"
+)
+
+// ReservationCreate is the builder for creating a Reservation entity.
+type ReservationCreate struct {
+	config
+	mutation *ReservationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReservationCreate) SetCreatedAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableCreatedAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *ReservationCreate) SetUpdatedAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableUpdatedAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (rc *ReservationCreate) SetMerchantID(u uuid.UUID) *ReservationCreate {
+	rc.mutation.SetMerchantID(u)
+	return rc
+}
+
+// SetStoreID sets the "store_id" field.
+func (rc *ReservationCreate) SetStoreID(u uuid.UUID) *ReservationCreate {
+	rc.mutation.SetStoreID(u)
+	return rc
+}
+
+// SetSource sets the "source" field.
+func (rc *ReservationCreate) SetSource(ds domain.ReservationSource) *ReservationCreate {
+	rc.mutation.SetSource(ds)
+	return rc
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (rc *ReservationCreate) SetCustomerID(u uuid.UUID) *ReservationCreate {
+	rc.mutation.SetCustomerID(u)
+	return rc
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableCustomerID(u *uuid.UUID) *ReservationCreate {
+	if u != nil {
+		rc.SetCustomerID(*u)
+	}
+	return rc
+}
+
+// SetGuestName sets the "guest_name" field.
+func (rc *ReservationCreate) SetGuestName(s string) *ReservationCreate {
+	rc.mutation.SetGuestName(s)
+	return rc
+}
+
+// SetGuestPhone sets the "guest_phone" field.
+func (rc *ReservationCreate) SetGuestPhone(s string) *ReservationCreate {
+	rc.mutation.SetGuestPhone(s)
+	return rc
+}
+
+// SetPartySize sets the "party_size" field.
+func (rc *ReservationCreate) SetPartySize(i int) *ReservationCreate {
+	rc.mutation.SetPartySize(i)
+	return rc
+}
+
+// SetReservedAt sets the "reserved_at" field.
+func (rc *ReservationCreate) SetReservedAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetReservedAt(t)
+	return rc
+}
+
+// SetEndAt sets the "end_at" field.
+func (rc *ReservationCreate) SetEndAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetEndAt(t)
+	return rc
+}
+
+// SetDiningPeriod sets the "dining_period" field.
+func (rc *ReservationCreate) SetDiningPeriod(s string) *ReservationCreate {
+	rc.mutation.SetDiningPeriod(s)
+	return rc
+}
+
+// SetNillableDiningPeriod sets the "dining_period" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableDiningPeriod(s *string) *ReservationCreate {
+	if s != nil {
+		rc.SetDiningPeriod(*s)
+	}
+	return rc
+}
+
+// SetTableID sets the "table_id" field.
+func (rc *ReservationCreate) SetTableID(u uuid.UUID) *ReservationCreate {
+	rc.mutation.SetTableID(u)
+	return rc
+}
+
+// SetNillableTableID sets the "table_id" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableTableID(u *uuid.UUID) *ReservationCreate {
+	if u != nil {
+		rc.SetTableID(*u)
+	}
+	return rc
+}
+
+// SetTableName sets the "table_name" field.
+func (rc *ReservationCreate) SetTableName(s string) *ReservationCreate {
+	rc.mutation.SetTableName(s)
+	return rc
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableTableName(s *string) *ReservationCreate {
+	if s != nil {
+		rc.SetTableName(*s)
+	}
+	return rc
+}
+
+// SetAreaPreference sets the "area_preference" field.
+func (rc *ReservationCreate) SetAreaPreference(s string) *ReservationCreate {
+	rc.mutation.SetAreaPreference(s)
+	return rc
+}
+
+// SetNillableAreaPreference sets the "area_preference" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableAreaPreference(s *string) *ReservationCreate {
+	if s != nil {
+		rc.SetAreaPreference(*s)
+	}
+	return rc
+}
+
+// SetRemark sets the "remark" field.
+func (rc *ReservationCreate) SetRemark(s string) *ReservationCreate {
+	rc.mutation.SetRemark(s)
+	return rc
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableRemark(s *string) *ReservationCreate {
+	if s != nil {
+		rc.SetRemark(*s)
+	}
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReservationCreate) SetStatus(ds domain.ReservationStatus) *ReservationCreate {
+	rc.mutation.SetStatus(ds)
+	return rc
+}
+
+// SetDeposit sets the "deposit" field.
+func (rc *ReservationCreate) SetDeposit(d decimal.Decimal) *ReservationCreate {
+	rc.mutation.SetDeposit(d)
+	return rc
+}
+
+// SetDepositStatus sets the "deposit_status" field.
+func (rc *ReservationCreate) SetDepositStatus(dds domain.ReservationDepositStatus) *ReservationCreate {
+	rc.mutation.SetDepositStatus(dds)
+	return rc
+}
+
+// SetDepositMethod sets the "deposit_method" field.
+func (rc *ReservationCreate) SetDepositMethod(dmpt domain.PaymentMethodPayType) *ReservationCreate {
+	rc.mutation.SetDepositMethod(dmpt)
+	return rc
+}
+
+// SetNillableDepositMethod sets the "deposit_method" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableDepositMethod(dmpt *domain.PaymentMethodPayType) *ReservationCreate {
+	if dmpt != nil {
+		rc.SetDepositMethod(*dmpt)
+	}
+	return rc
+}
+
+// SetDepositPaidAt sets the "deposit_paid_at" field.
+func (rc *ReservationCreate) SetDepositPaidAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetDepositPaidAt(t)
+	return rc
+}
+
+// SetNillableDepositPaidAt sets the "deposit_paid_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableDepositPaidAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetDepositPaidAt(*t)
+	}
+	return rc
+}
+
+// SetOrderID sets the "order_id" field.
+func (rc *ReservationCreate) SetOrderID(u uuid.UUID) *ReservationCreate {
+	rc.mutation.SetOrderID(u)
+	return rc
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableOrderID(u *uuid.UUID) *ReservationCreate {
+	if u != nil {
+		rc.SetOrderID(*u)
+	}
+	return rc
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (rc *ReservationCreate) SetSeatedAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetSeatedAt(t)
+	return rc
+}
+
+// SetNillableSeatedAt sets the "seated_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableSeatedAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetSeatedAt(*t)
+	}
+	return rc
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (rc *ReservationCreate) SetCancelledAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetCancelledAt(t)
+	return rc
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableCancelledAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetCancelledAt(*t)
+	}
+	return rc
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (rc *ReservationCreate) SetCancelReason(s string) *ReservationCreate {
+	rc.mutation.SetCancelReason(s)
+	return rc
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableCancelReason(s *string) *ReservationCreate {
+	if s != nil {
+		rc.SetCancelReason(*s)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReservationCreate) SetID(u uuid.UUID) *ReservationCreate {
+	rc.mutation.SetID(u)
+	return rc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableID(u *uuid.UUID) *ReservationCreate {
+	if u != nil {
+		rc.SetID(*u)
+	}
+	return rc
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (rc *ReservationCreate) Mutation() *ReservationMutation {
+	return rc.mutation
+}
+
+// Save creates the Reservation in the database.
+func (rc *ReservationCreate) Save(ctx context.Context) (*Reservation, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReservationCreate) SaveX(ctx context.Context) *Reservation {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReservationCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReservationCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReservationCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := reservation.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := reservation.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rc.mutation.CustomerID(); !ok {
+		v := reservation.DefaultCustomerID()
+		rc.mutation.SetCustomerID(v)
+	}
+	if _, ok := rc.mutation.DiningPeriod(); !ok {
+		v := reservation.DefaultDiningPeriod
+		rc.mutation.SetDiningPeriod(v)
+	}
+	if _, ok := rc.mutation.TableID(); !ok {
+		v := reservation.DefaultTableID()
+		rc.mutation.SetTableID(v)
+	}
+	if _, ok := rc.mutation.TableName(); !ok {
+		v := reservation.DefaultTableName
+		rc.mutation.SetTableName(v)
+	}
+	if _, ok := rc.mutation.AreaPreference(); !ok {
+		v := reservation.DefaultAreaPreference
+		rc.mutation.SetAreaPreference(v)
+	}
+	if _, ok := rc.mutation.Remark(); !ok {
+		v := reservation.DefaultRemark
+		rc.mutation.SetRemark(v)
+	}
+	if _, ok := rc.mutation.DepositMethod(); !ok {
+		v := reservation.DefaultDepositMethod
+		rc.mutation.SetDepositMethod(v)
+	}
+	if _, ok := rc.mutation.OrderID(); !ok {
+		v := reservation.DefaultOrderID()
+		rc.mutation.SetOrderID(v)
+	}
+	if _, ok := rc.mutation.CancelReason(); !ok {
+		v := reservation.DefaultCancelReason
+		rc.mutation.SetCancelReason(v)
+	}
+	if _, ok := rc.mutation.ID(); !ok {
+		v := reservation.DefaultID()
+		rc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReservationCreate) check() error {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Reservation.created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Reservation.updated_at"`)}
+	}
+	if _, ok := rc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "Reservation.merchant_id"`)}
+	}
+	if _, ok := rc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "Reservation.store_id"`)}
+	}
+	if _, ok := rc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Reservation.source"`)}
+	}
+	if v, ok := rc.mutation.Source(); ok {
+		if err := reservation.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Reservation.source": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "Reservation.customer_id"`)}
+	}
+	if _, ok := rc.mutation.GuestName(); !ok {
+		return &ValidationError{Name: "guest_name", err: errors.New(`ent: missing required field "Reservation.guest_name"`)}
+	}
+	if v, ok := rc.mutation.GuestName(); ok {
+		if err := reservation.GuestNameValidator(v); err != nil {
+			return &ValidationError{Name: "guest_name", err: fmt.Errorf(`ent: validator failed for field "Reservation.guest_name": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.GuestPhone(); !ok {
+		return &ValidationError{Name: "guest_phone", err: errors.New(`ent: missing required field "Reservation.guest_phone"`)}
+	}
+	if v, ok := rc.mutation.GuestPhone(); ok {
+		if err := reservation.GuestPhoneValidator(v); err != nil {
+			return &ValidationError{Name: "guest_phone", err: fmt.Errorf(`ent: validator failed for field "Reservation.guest_phone": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.PartySize(); !ok {
+		return &ValidationError{Name: "party_size", err: errors.New(`ent: missing required field "Reservation.party_size"`)}
+	}
+	if _, ok := rc.mutation.ReservedAt(); !ok {
+		return &ValidationError{Name: "reserved_at", err: errors.New(`ent: missing required field "Reservation.reserved_at"`)}
+	}
+	if _, ok := rc.mutation.EndAt(); !ok {
+		return &ValidationError{Name: "end_at", err: errors.New(`ent: missing required field "Reservation.end_at"`)}
+	}
+	if _, ok := rc.mutation.DiningPeriod(); !ok {
+		return &ValidationError{Name: "dining_period", err: errors.New(`ent: missing required field "Reservation.dining_period"`)}
+	}
+	if v, ok := rc.mutation.DiningPeriod(); ok {
+		if err := reservation.DiningPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "dining_period", err: fmt.Errorf(`ent: validator failed for field "Reservation.dining_period": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.TableID(); !ok {
+		return &ValidationError{Name: "table_id", err: errors.New(`ent: missing required field "Reservation.table_id"`)}
+	}
+	if _, ok := rc.mutation.TableName(); !ok {
+		return &ValidationError{Name: "table_name", err: errors.New(`ent: missing required field "Reservation.table_name"`)}
+	}
+	if v, ok := rc.mutation.TableName(); ok {
+		if err := reservation.TableNameValidator(v); err != nil {
+			return &ValidationError{Name: "table_name", err: fmt.Errorf(`ent: validator failed for field "Reservation.table_name": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.AreaPreference(); !ok {
+		return &ValidationError{Name: "area_preference", err: errors.New(`ent: missing required field "Reservation.area_preference"`)}
+	}
+	if v, ok := rc.mutation.AreaPreference(); ok {
+		if err := reservation.AreaPreferenceValidator(v); err != nil {
+			return &ValidationError{Name: "area_preference", err: fmt.Errorf(`ent: validator failed for field "Reservation.area_preference": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Remark(); !ok {
+		return &ValidationError{Name: "remark", err: errors.New(`ent: missing required field "Reservation.remark"`)}
+	}
+	if v, ok := rc.mutation.Remark(); ok {
+		if err := reservation.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "Reservation.remark": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Reservation.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := reservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reservation.status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Deposit(); !ok {
+		return &ValidationError{Name: "deposit", err: errors.New(`ent: missing required field "Reservation.deposit"`)}
+	}
+	if _, ok := rc.mutation.DepositStatus(); !ok {
+		return &ValidationError{Name: "deposit_status", err: errors.New(`ent: missing required field "Reservation.deposit_status"`)}
+	}
+	if v, ok := rc.mutation.DepositStatus(); ok {
+		if err := reservation.DepositStatusValidator(v); err != nil {
+			return &ValidationError{Name: "deposit_status", err: fmt.Errorf(`ent: validator failed for field "Reservation.deposit_status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.DepositMethod(); !ok {
+		return &ValidationError{Name: "deposit_method", err: errors.New(`ent: missing required field "Reservation.deposit_method"`)}
+	}
+	if v, ok := rc.mutation.DepositMethod(); ok {
+		if err := reservation.DepositMethodValidator(string(v)); err != nil {
+			return &ValidationError{Name: "deposit_method", err: fmt.Errorf(`ent: validator failed for field "Reservation.deposit_method": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "Reservation.order_id"`)}
+	}
+	if _, ok := rc.mutation.CancelReason(); !ok {
+		return &ValidationError{Name: "cancel_reason", err: errors.New(`ent: missing required field "Reservation.cancel_reason"`)}
+	}
+	if v, ok := rc.mutation.CancelReason(); ok {
+		if err := reservation.CancelReasonValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_reason", err: fmt.Errorf(`ent: validator failed for field "Reservation.cancel_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rc *ReservationCreate) sqlSave(ctx context.Context) (*Reservation, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReservationCreate) createSpec() (*Reservation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reservation{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(reservation.Table, sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rc.conflict
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(reservation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.SetField(reservation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rc.mutation.MerchantID(); ok {
+		_spec.SetField(reservation.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := rc.mutation.StoreID(); ok {
+		_spec.SetField(reservation.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := rc.mutation.Source(); ok {
+		_spec.SetField(reservation.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := rc.mutation.CustomerID(); ok {
+		_spec.SetField(reservation.FieldCustomerID, field.TypeUUID, value)
+		_node.CustomerID = value
+	}
+	if value, ok := rc.mutation.GuestName(); ok {
+		_spec.SetField(reservation.FieldGuestName, field.TypeString, value)
+		_node.GuestName = value
+	}
+	if value, ok := rc.mutation.GuestPhone(); ok {
+		_spec.SetField(reservation.FieldGuestPhone, field.TypeString, value)
+		_node.GuestPhone = value
+	}
+	if value, ok := rc.mutation.PartySize(); ok {
+		_spec.SetField(reservation.FieldPartySize, field.TypeInt, value)
+		_node.PartySize = value
+	}
+	if value, ok := rc.mutation.ReservedAt(); ok {
+		_spec.SetField(reservation.FieldReservedAt, field.TypeTime, value)
+		_node.ReservedAt = value
+	}
+	if value, ok := rc.mutation.EndAt(); ok {
+		_spec.SetField(reservation.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = value
+	}
+	if value, ok := rc.mutation.DiningPeriod(); ok {
+		_spec.SetField(reservation.FieldDiningPeriod, field.TypeString, value)
+		_node.DiningPeriod = value
+	}
+	if value, ok := rc.mutation.TableID(); ok {
+		_spec.SetField(reservation.FieldTableID, field.TypeUUID, value)
+		_node.TableID = value
+	}
+	if value, ok := rc.mutation.TableName(); ok {
+		_spec.SetField(reservation.FieldTableName, field.TypeString, value)
+		_node.TableName = value
+	}
+	if value, ok := rc.mutation.AreaPreference(); ok {
+		_spec.SetField(reservation.FieldAreaPreference, field.TypeString, value)
+		_node.AreaPreference = value
+	}
+	if value, ok := rc.mutation.Remark(); ok {
+		_spec.SetField(reservation.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(reservation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.Deposit(); ok {
+		_spec.SetField(reservation.FieldDeposit, field.TypeOther, value)
+		_node.Deposit = value
+	}
+	if value, ok := rc.mutation.DepositStatus(); ok {
+		_spec.SetField(reservation.FieldDepositStatus, field.TypeEnum, value)
+		_node.DepositStatus = value
+	}
+	if value, ok := rc.mutation.DepositMethod(); ok {
+		_spec.SetField(reservation.FieldDepositMethod, field.TypeString, value)
+		_node.DepositMethod = value
+	}
+	if value, ok := rc.mutation.DepositPaidAt(); ok {
+		_spec.SetField(reservation.FieldDepositPaidAt, field.TypeTime, value)
+		_node.DepositPaidAt = &value
+	}
+	if value, ok := rc.mutation.OrderID(); ok {
+		_spec.SetField(reservation.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := rc.mutation.SeatedAt(); ok {
+		_spec.SetField(reservation.FieldSeatedAt, field.TypeTime, value)
+		_node.SeatedAt = &value
+	}
+	if value, ok := rc.mutation.CancelledAt(); ok {
+		_spec.SetField(reservation.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := rc.mutation.CancelReason(); ok {
+		_spec.SetField(reservation.FieldCancelReason, field.TypeString, value)
+		_node.CancelReason = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Reservation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReservationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *ReservationCreate) OnConflict(opts ...sql.ConflictOption) *ReservationUpsertOne {
+	rc.conflict = opts
+	return &ReservationUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *ReservationCreate) OnConflictColumns(columns ...string) *ReservationUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &ReservationUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// ReservationUpsertOne is the builder for "upsert"-ing
+	//  one Reservation node.
+	ReservationUpsertOne struct {
+		create *ReservationCreate
+	}
+
+	// ReservationUpsert is the "OnConflict" setter.
+	ReservationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReservationUpsert) SetUpdatedAt(v time.Time) *ReservationUpsert {
+	u.Set(reservation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateUpdatedAt() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldUpdatedAt)
+	return u
+}
+
+// SetTableID sets the "table_id" field.
+func (u *ReservationUpsert) SetTableID(v uuid.UUID) *ReservationUpsert {
+	u.Set(reservation.FieldTableID, v)
+	return u
+}
+
+// UpdateTableID sets the "table_id" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateTableID() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldTableID)
+	return u
+}
+
+// SetTableName sets the "table_name" field.
+func (u *ReservationUpsert) SetTableName(v string) *ReservationUpsert {
+	u.Set(reservation.FieldTableName, v)
+	return u
+}
+
+// UpdateTableName sets the "table_name" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateTableName() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldTableName)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReservationUpsert) SetStatus(v domain.ReservationStatus) *ReservationUpsert {
+	u.Set(reservation.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateStatus() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldStatus)
+	return u
+}
+
+// SetDepositStatus sets the "deposit_status" field.
+func (u *ReservationUpsert) SetDepositStatus(v domain.ReservationDepositStatus) *ReservationUpsert {
+	u.Set(reservation.FieldDepositStatus, v)
+	return u
+}
+
+// UpdateDepositStatus sets the "deposit_status" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateDepositStatus() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldDepositStatus)
+	return u
+}
+
+// SetDepositMethod sets the "deposit_method" field.
+func (u *ReservationUpsert) SetDepositMethod(v domain.PaymentMethodPayType) *ReservationUpsert {
+	u.Set(reservation.FieldDepositMethod, v)
+	return u
+}
+
+// UpdateDepositMethod sets the "deposit_method" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateDepositMethod() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldDepositMethod)
+	return u
+}
+
+// SetDepositPaidAt sets the "deposit_paid_at" field.
+func (u *ReservationUpsert) SetDepositPaidAt(v time.Time) *ReservationUpsert {
+	u.Set(reservation.FieldDepositPaidAt, v)
+	return u
+}
+
+// UpdateDepositPaidAt sets the "deposit_paid_at" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateDepositPaidAt() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldDepositPaidAt)
+	return u
+}
+
+// ClearDepositPaidAt clears the value of the "deposit_paid_at" field.
+func (u *ReservationUpsert) ClearDepositPaidAt() *ReservationUpsert {
+	u.SetNull(reservation.FieldDepositPaidAt)
+	return u
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *ReservationUpsert) SetOrderID(v uuid.UUID) *ReservationUpsert {
+	u.Set(reservation.FieldOrderID, v)
+	return u
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateOrderID() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldOrderID)
+	return u
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (u *ReservationUpsert) SetSeatedAt(v time.Time) *ReservationUpsert {
+	u.Set(reservation.FieldSeatedAt, v)
+	return u
+}
+
+// UpdateSeatedAt sets the "seated_at" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateSeatedAt() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldSeatedAt)
+	return u
+}
+
+// ClearSeatedAt clears the value of the "seated_at" field.
+func (u *ReservationUpsert) ClearSeatedAt() *ReservationUpsert {
+	u.SetNull(reservation.FieldSeatedAt)
+	return u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *ReservationUpsert) SetCancelledAt(v time.Time) *ReservationUpsert {
+	u.Set(reservation.FieldCancelledAt, v)
+	return u
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateCancelledAt() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldCancelledAt)
+	return u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *ReservationUpsert) ClearCancelledAt() *ReservationUpsert {
+	u.SetNull(reservation.FieldCancelledAt)
+	return u
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (u *ReservationUpsert) SetCancelReason(v string) *ReservationUpsert {
+	u.Set(reservation.FieldCancelReason, v)
+	return u
+}
+
+// UpdateCancelReason sets the "cancel_reason" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateCancelReason() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldCancelReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reservation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReservationUpsertOne) UpdateNewValues() *ReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reservation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reservation.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(reservation.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(reservation.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(reservation.FieldSource)
+		}
+		if _, exists := u.create.mutation.CustomerID(); exists {
+			s.SetIgnore(reservation.FieldCustomerID)
+		}
+		if _, exists := u.create.mutation.GuestName(); exists {
+			s.SetIgnore(reservation.FieldGuestName)
+		}
+		if _, exists := u.create.mutation.GuestPhone(); exists {
+			s.SetIgnore(reservation.FieldGuestPhone)
+		}
+		if _, exists := u.create.mutation.PartySize(); exists {
+			s.SetIgnore(reservation.FieldPartySize)
+		}
+		if _, exists := u.create.mutation.ReservedAt(); exists {
+			s.SetIgnore(reservation.FieldReservedAt)
+		}
+		if _, exists := u.create.mutation.EndAt(); exists {
+			s.SetIgnore(reservation.FieldEndAt)
+		}
+		if _, exists := u.create.mutation.DiningPeriod(); exists {
+			s.SetIgnore(reservation.FieldDiningPeriod)
+		}
+		if _, exists := u.create.mutation.AreaPreference(); exists {
+			s.SetIgnore(reservation.FieldAreaPreference)
+		}
+		if _, exists := u.create.mutation.Remark(); exists {
+			s.SetIgnore(reservation.FieldRemark)
+		}
+		if _, exists := u.create.mutation.Deposit(); exists {
+			s.SetIgnore(reservation.FieldDeposit)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReservationUpsertOne) Ignore() *ReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReservationUpsertOne) DoNothing() *ReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReservationCreate.OnConflict
+// documentation for more info.
+func (u *ReservationUpsertOne) Update(set func(*ReservationUpsert)) *ReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReservationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReservationUpsertOne) SetUpdatedAt(v time.Time) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateUpdatedAt() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTableID sets the "table_id" field.
+func (u *ReservationUpsertOne) SetTableID(v uuid.UUID) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetTableID(v)
+	})
+}
+
+// UpdateTableID sets the "table_id" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateTableID() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateTableID()
+	})
+}
+
+// SetTableName sets the "table_name" field.
+func (u *ReservationUpsertOne) SetTableName(v string) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetTableName(v)
+	})
+}
+
+// UpdateTableName sets the "table_name" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateTableName() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateTableName()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReservationUpsertOne) SetStatus(v domain.ReservationStatus) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateStatus() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDepositStatus sets the "deposit_status" field.
+func (u *ReservationUpsertOne) SetDepositStatus(v domain.ReservationDepositStatus) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetDepositStatus(v)
+	})
+}
+
+// UpdateDepositStatus sets the "deposit_status" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateDepositStatus() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateDepositStatus()
+	})
+}
+
+// SetDepositMethod sets the "deposit_method" field.
+func (u *ReservationUpsertOne) SetDepositMethod(v domain.PaymentMethodPayType) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetDepositMethod(v)
+	})
+}
+
+// UpdateDepositMethod sets the "deposit_method" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateDepositMethod() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateDepositMethod()
+	})
+}
+
+// SetDepositPaidAt sets the "deposit_paid_at" field.
+func (u *ReservationUpsertOne) SetDepositPaidAt(v time.Time) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetDepositPaidAt(v)
+	})
+}
+
+// UpdateDepositPaidAt sets the "deposit_paid_at" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateDepositPaidAt() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateDepositPaidAt()
+	})
+}
+
+// ClearDepositPaidAt clears the value of the "deposit_paid_at" field.
+func (u *ReservationUpsertOne) ClearDepositPaidAt() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.ClearDepositPaidAt()
+	})
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *ReservationUpsertOne) SetOrderID(v uuid.UUID) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetOrderID(v)
+	})
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateOrderID() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateOrderID()
+	})
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (u *ReservationUpsertOne) SetSeatedAt(v time.Time) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetSeatedAt(v)
+	})
+}
+
+// UpdateSeatedAt sets the "seated_at" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateSeatedAt() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateSeatedAt()
+	})
+}
+
+// ClearSeatedAt clears the value of the "seated_at" field.
+func (u *ReservationUpsertOne) ClearSeatedAt() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.ClearSeatedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *ReservationUpsertOne) SetCancelledAt(v time.Time) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateCancelledAt() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *ReservationUpsertOne) ClearCancelledAt() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (u *ReservationUpsertOne) SetCancelReason(v string) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetCancelReason(v)
+	})
+}
+
+// UpdateCancelReason sets the "cancel_reason" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateCancelReason() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateCancelReason()
+	})
+}
+
+// Exec executes the query.
+func (u *ReservationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReservationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReservationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReservationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReservationUpsertOne.ID is not supported by MySQL driver. Use ReservationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReservationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReservationCreateBulk is the builder for creating many Reservation entities in bulk.
+type ReservationCreateBulk struct {
+	config
+	err      error
+	builders []*ReservationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Reservation entities in the database.
+func (rcb *ReservationCreateBulk) Save(ctx context.Context) ([]*Reservation, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reservation, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReservationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReservationCreateBulk) SaveX(ctx context.Context) []*Reservation {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReservationCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReservationCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Reservation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReservationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *ReservationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReservationUpsertBulk {
+	rcb.conflict = opts
+	return &ReservationUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *ReservationCreateBulk) OnConflictColumns(columns ...string) *ReservationUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &ReservationUpsertBulk{
+		create: rcb,
+	}
+}
+
+// ReservationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Reservation nodes.
+type ReservationUpsertBulk struct {
+	create *ReservationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reservation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReservationUpsertBulk) UpdateNewValues() *ReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reservation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reservation.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(reservation.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(reservation.FieldStoreID)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(reservation.FieldSource)
+			}
+			if _, exists := b.mutation.CustomerID(); exists {
+				s.SetIgnore(reservation.FieldCustomerID)
+			}
+			if _, exists := b.mutation.GuestName(); exists {
+				s.SetIgnore(reservation.FieldGuestName)
+			}
+			if _, exists := b.mutation.GuestPhone(); exists {
+				s.SetIgnore(reservation.FieldGuestPhone)
+			}
+			if _, exists := b.mutation.PartySize(); exists {
+				s.SetIgnore(reservation.FieldPartySize)
+			}
+			if _, exists := b.mutation.ReservedAt(); exists {
+				s.SetIgnore(reservation.FieldReservedAt)
+			}
+			if _, exists := b.mutation.EndAt(); exists {
+				s.SetIgnore(reservation.FieldEndAt)
+			}
+			if _, exists := b.mutation.DiningPeriod(); exists {
+				s.SetIgnore(reservation.FieldDiningPeriod)
+			}
+			if _, exists := b.mutation.AreaPreference(); exists {
+				s.SetIgnore(reservation.FieldAreaPreference)
+			}
+			if _, exists := b.mutation.Remark(); exists {
+				s.SetIgnore(reservation.FieldRemark)
+			}
+			if _, exists := b.mutation.Deposit(); exists {
+				s.SetIgnore(reservation.FieldDeposit)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReservationUpsertBulk) Ignore() *ReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReservationUpsertBulk) DoNothing() *ReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReservationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReservationUpsertBulk) Update(set func(*ReservationUpsert)) *ReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReservationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReservationUpsertBulk) SetUpdatedAt(v time.Time) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateUpdatedAt() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTableID sets the "table_id" field.
+func (u *ReservationUpsertBulk) SetTableID(v uuid.UUID) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetTableID(v)
+	})
+}
+
+// UpdateTableID sets the "table_id" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateTableID() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateTableID()
+	})
+}
+
+// SetTableName sets the "table_name" field.
+func (u *ReservationUpsertBulk) SetTableName(v string) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetTableName(v)
+	})
+}
+
+// UpdateTableName sets the "table_name" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateTableName() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateTableName()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReservationUpsertBulk) SetStatus(v domain.ReservationStatus) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateStatus() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDepositStatus sets the "deposit_status" field.
+func (u *ReservationUpsertBulk) SetDepositStatus(v domain.ReservationDepositStatus) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetDepositStatus(v)
+	})
+}
+
+// UpdateDepositStatus sets the "deposit_status" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateDepositStatus() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateDepositStatus()
+	})
+}
+
+// SetDepositMethod sets the "deposit_method" field.
+func (u *ReservationUpsertBulk) SetDepositMethod(v domain.PaymentMethodPayType) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetDepositMethod(v)
+	})
+}
+
+// UpdateDepositMethod sets the "deposit_method" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateDepositMethod() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateDepositMethod()
+	})
+}
+
+// SetDepositPaidAt sets the "deposit_paid_at" field.
+func (u *ReservationUpsertBulk) SetDepositPaidAt(v time.Time) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetDepositPaidAt(v)
+	})
+}
+
+// UpdateDepositPaidAt sets the "deposit_paid_at" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateDepositPaidAt() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateDepositPaidAt()
+	})
+}
+
+// ClearDepositPaidAt clears the value of the "deposit_paid_at" field.
+func (u *ReservationUpsertBulk) ClearDepositPaidAt() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.ClearDepositPaidAt()
+	})
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *ReservationUpsertBulk) SetOrderID(v uuid.UUID) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetOrderID(v)
+	})
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateOrderID() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateOrderID()
+	})
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (u *ReservationUpsertBulk) SetSeatedAt(v time.Time) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetSeatedAt(v)
+	})
+}
+
+// UpdateSeatedAt sets the "seated_at" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateSeatedAt() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateSeatedAt()
+	})
+}
+
+// ClearSeatedAt clears the value of the "seated_at" field.
+func (u *ReservationUpsertBulk) ClearSeatedAt() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.ClearSeatedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *ReservationUpsertBulk) SetCancelledAt(v time.Time) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateCancelledAt() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *ReservationUpsertBulk) ClearCancelledAt() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (u *ReservationUpsertBulk) SetCancelReason(v string) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetCancelReason(v)
+	})
+}
+
+// UpdateCancelReason sets the "cancel_reason" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateCancelReason() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateCancelReason()
+	})
+}
+
+// Exec executes the query.
+func (u *ReservationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReservationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReservationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReservationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservation"
+)
+
+// ReservationUpdate is the builder for updating Reservation entities.
+type ReservationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReservationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReservationUpdate builder.
+func (ru *ReservationUpdate) Where(ps ...predicate.Reservation) *ReservationUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *ReservationUpdate) SetUpdatedAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetTableID sets the "table_id" field.
+func (ru *ReservationUpdate) SetTableID(u uuid.UUID) *ReservationUpdate {
+	ru.mutation.SetTableID(u)
+	return ru
+}
+
+// SetNillableTableID sets the "table_id" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableTableID(u *uuid.UUID) *ReservationUpdate {
+	if u != nil {
+		ru.SetTableID(*u)
+	}
+	return ru
+}
+
+// SetTableName sets the "table_name" field.
+func (ru *ReservationUpdate) SetTableName(s string) *ReservationUpdate {
+	ru.mutation.SetTableName(s)
+	return ru
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableTableName(s *string) *ReservationUpdate {
+	if s != nil {
+		ru.SetTableName(*s)
+	}
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReservationUpdate) SetStatus(ds domain.ReservationStatus) *ReservationUpdate {
+	ru.mutation.SetStatus(ds)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableStatus(ds *domain.ReservationStatus) *ReservationUpdate {
+	if ds != nil {
+		ru.SetStatus(*ds)
+	}
+	return ru
+}
+
+// SetDepositStatus sets the "deposit_status" field.
+func (ru *ReservationUpdate) SetDepositStatus(dds domain.ReservationDepositStatus) *ReservationUpdate {
+	ru.mutation.SetDepositStatus(dds)
+	return ru
+}
+
+// SetNillableDepositStatus sets the "deposit_status" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableDepositStatus(dds *domain.ReservationDepositStatus) *ReservationUpdate {
+	if dds != nil {
+		ru.SetDepositStatus(*dds)
+	}
+	return ru
+}
+
+// SetDepositMethod sets the "deposit_method" field.
+func (ru *ReservationUpdate) SetDepositMethod(dmpt domain.PaymentMethodPayType) *ReservationUpdate {
+	ru.mutation.SetDepositMethod(dmpt)
+	return ru
+}
+
+// SetNillableDepositMethod sets the "deposit_method" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableDepositMethod(dmpt *domain.PaymentMethodPayType) *ReservationUpdate {
+	if dmpt != nil {
+		ru.SetDepositMethod(*dmpt)
+	}
+	return ru
+}
+
+// SetDepositPaidAt sets the "deposit_paid_at" field.
+func (ru *ReservationUpdate) SetDepositPaidAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetDepositPaidAt(t)
+	return ru
+}
+
+// SetNillableDepositPaidAt sets the "deposit_paid_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableDepositPaidAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetDepositPaidAt(*t)
+	}
+	return ru
+}
+
+// ClearDepositPaidAt clears the value of the "deposit_paid_at" field.
+func (ru *ReservationUpdate) ClearDepositPaidAt() *ReservationUpdate {
+	ru.mutation.ClearDepositPaidAt()
+	return ru
+}
+
+// SetOrderID sets the "order_id" field.
+func (ru *ReservationUpdate) SetOrderID(u uuid.UUID) *ReservationUpdate {
+	ru.mutation.SetOrderID(u)
+	return ru
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableOrderID(u *uuid.UUID) *ReservationUpdate {
+	if u != nil {
+		ru.SetOrderID(*u)
+	}
+	return ru
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (ru *ReservationUpdate) SetSeatedAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetSeatedAt(t)
+	return ru
+}
+
+// SetNillableSeatedAt sets the "seated_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableSeatedAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetSeatedAt(*t)
+	}
+	return ru
+}
+
+// ClearSeatedAt clears the value of the "seated_at" field.
+func (ru *ReservationUpdate) ClearSeatedAt() *ReservationUpdate {
+	ru.mutation.ClearSeatedAt()
+	return ru
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (ru *ReservationUpdate) SetCancelledAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetCancelledAt(t)
+	return ru
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableCancelledAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetCancelledAt(*t)
+	}
+	return ru
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (ru *ReservationUpdate) ClearCancelledAt() *ReservationUpdate {
+	ru.mutation.ClearCancelledAt()
+	return ru
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (ru *ReservationUpdate) SetCancelReason(s string) *ReservationUpdate {
+	ru.mutation.SetCancelReason(s)
+	return ru
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableCancelReason(s *string) *ReservationUpdate {
+	if s != nil {
+		ru.SetCancelReason(*s)
+	}
+	return ru
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (ru *ReservationUpdate) Mutation() *ReservationMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReservationUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReservationUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReservationUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReservationUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *ReservationUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := reservation.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReservationUpdate) check() error {
+	if v, ok := ru.mutation.TableName(); ok {
+		if err := reservation.TableNameValidator(v); err != nil {
+			return &ValidationError{Name: "table_name", err: fmt.Errorf(`ent: validator failed for field "Reservation.table_name": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Status(); ok {
+		if err := reservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reservation.status": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.DepositStatus(); ok {
+		if err := reservation.DepositStatusValidator(v); err != nil {
+			return &ValidationError{Name: "deposit_status", err: fmt.Errorf(`ent: validator failed for field "Reservation.deposit_status": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.DepositMethod(); ok {
+		if err := reservation.DepositMethodValidator(string(v)); err != nil {
+			return &ValidationError{Name: "deposit_method", err: fmt.Errorf(`ent: validator failed for field "Reservation.deposit_method": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.CancelReason(); ok {
+		if err := reservation.CancelReasonValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_reason", err: fmt.Errorf(`ent: validator failed for field "Reservation.cancel_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ru *ReservationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReservationUpdate {
+	ru.modifiers = append(ru.modifiers, modifiers...)
+	return ru
+}
+
+func (ru *ReservationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reservation.Table, reservation.Columns, sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeUUID))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(reservation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.TableID(); ok {
+		_spec.SetField(reservation.FieldTableID, field.TypeUUID, value)
+	}
+	if value, ok := ru.mutation.TableName(); ok {
+		_spec.SetField(reservation.FieldTableName, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(reservation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.DepositStatus(); ok {
+		_spec.SetField(reservation.FieldDepositStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.DepositMethod(); ok {
+		_spec.SetField(reservation.FieldDepositMethod, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.DepositPaidAt(); ok {
+		_spec.SetField(reservation.FieldDepositPaidAt, field.TypeTime, value)
+	}
+	if ru.mutation.DepositPaidAtCleared() {
+		_spec.ClearField(reservation.FieldDepositPaidAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.OrderID(); ok {
+		_spec.SetField(reservation.FieldOrderID, field.TypeUUID, value)
+	}
+	if value, ok := ru.mutation.SeatedAt(); ok {
+		_spec.SetField(reservation.FieldSeatedAt, field.TypeTime, value)
+	}
+	if ru.mutation.SeatedAtCleared() {
+		_spec.ClearField(reservation.FieldSeatedAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.CancelledAt(); ok {
+		_spec.SetField(reservation.FieldCancelledAt, field.TypeTime, value)
+	}
+	if ru.mutation.CancelledAtCleared() {
+		_spec.ClearField(reservation.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.CancelReason(); ok {
+		_spec.SetField(reservation.FieldCancelReason, field.TypeString, value)
+	}
+	_spec.AddModifiers(ru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReservationUpdateOne is the builder for updating a single Reservation entity.
+type ReservationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReservationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *ReservationUpdateOne) SetUpdatedAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetTableID sets the "table_id" field.
+func (ruo *ReservationUpdateOne) SetTableID(u uuid.UUID) *ReservationUpdateOne {
+	ruo.mutation.SetTableID(u)
+	return ruo
+}
+
+// SetNillableTableID sets the "table_id" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableTableID(u *uuid.UUID) *ReservationUpdateOne {
+	if u != nil {
+		ruo.SetTableID(*u)
+	}
+	return ruo
+}
+
+// SetTableName sets the "table_name" field.
+func (ruo *ReservationUpdateOne) SetTableName(s string) *ReservationUpdateOne {
+	ruo.mutation.SetTableName(s)
+	return ruo
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableTableName(s *string) *ReservationUpdateOne {
+	if s != nil {
+		ruo.SetTableName(*s)
+	}
+	return ruo
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReservationUpdateOne) SetStatus(ds domain.ReservationStatus) *ReservationUpdateOne {
+	ruo.mutation.SetStatus(ds)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableStatus(ds *domain.ReservationStatus) *ReservationUpdateOne {
+	if ds != nil {
+		ruo.SetStatus(*ds)
+	}
+	return ruo
+}
+
+// SetDepositStatus sets the "deposit_status" field.
+func (ruo *ReservationUpdateOne) SetDepositStatus(dds domain.ReservationDepositStatus) *ReservationUpdateOne {
+	ruo.mutation.SetDepositStatus(dds)
+	return ruo
+}
+
+// SetNillableDepositStatus sets the "deposit_status" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableDepositStatus(dds *domain.ReservationDepositStatus) *ReservationUpdateOne {
+	if dds != nil {
+		ruo.SetDepositStatus(*dds)
+	}
+	return ruo
+}
+
+// SetDepositMethod sets the "deposit_method" field.
+func (ruo *ReservationUpdateOne) SetDepositMethod(dmpt domain.PaymentMethodPayType) *ReservationUpdateOne {
+	ruo.mutation.SetDepositMethod(dmpt)
+	return ruo
+}
+
+// SetNillableDepositMethod sets the "deposit_method" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableDepositMethod(dmpt *domain.PaymentMethodPayType) *ReservationUpdateOne {
+	if dmpt != nil {
+		ruo.SetDepositMethod(*dmpt)
+	}
+	return ruo
+}
+
+// SetDepositPaidAt sets the "deposit_paid_at" field.
+func (ruo *ReservationUpdateOne) SetDepositPaidAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetDepositPaidAt(t)
+	return ruo
+}
+
+// SetNillableDepositPaidAt sets the "deposit_paid_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableDepositPaidAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetDepositPaidAt(*t)
+	}
+	return ruo
+}
+
+// ClearDepositPaidAt clears the value of the "deposit_paid_at" field.
+func (ruo *ReservationUpdateOne) ClearDepositPaidAt() *ReservationUpdateOne {
+	ruo.mutation.ClearDepositPaidAt()
+	return ruo
+}
+
+// SetOrderID sets the "order_id" field.
+func (ruo *ReservationUpdateOne) SetOrderID(u uuid.UUID) *ReservationUpdateOne {
+	ruo.mutation.SetOrderID(u)
+	return ruo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableOrderID(u *uuid.UUID) *ReservationUpdateOne {
+	if u != nil {
+		ruo.SetOrderID(*u)
+	}
+	return ruo
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (ruo *ReservationUpdateOne) SetSeatedAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetSeatedAt(t)
+	return ruo
+}
+
+// SetNillableSeatedAt sets the "seated_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableSeatedAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetSeatedAt(*t)
+	}
+	return ruo
+}
+
+// ClearSeatedAt clears the value of the "seated_at" field.
+func (ruo *ReservationUpdateOne) ClearSeatedAt() *ReservationUpdateOne {
+	ruo.mutation.ClearSeatedAt()
+	return ruo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (ruo *ReservationUpdateOne) SetCancelledAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetCancelledAt(t)
+	return ruo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableCancelledAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetCancelledAt(*t)
+	}
+	return ruo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (ruo *ReservationUpdateOne) ClearCancelledAt() *ReservationUpdateOne {
+	ruo.mutation.ClearCancelledAt()
+	return ruo
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (ruo *ReservationUpdateOne) SetCancelReason(s string) *ReservationUpdateOne {
+	ruo.mutation.SetCancelReason(s)
+	return ruo
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableCancelReason(s *string) *ReservationUpdateOne {
+	if s != nil {
+		ruo.SetCancelReason(*s)
+	}
+	return ruo
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (ruo *ReservationUpdateOne) Mutation() *ReservationMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReservationUpdate builder.
+func (ruo *ReservationUpdateOne) Where(ps ...predicate.Reservation) *ReservationUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReservationUpdateOne) Select(field string, fields ...string) *ReservationUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reservation entity.
+func (ruo *ReservationUpdateOne) Save(ctx context.Context) (*Reservation, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReservationUpdateOne) SaveX(ctx context.Context) *Reservation {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReservationUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReservationUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *ReservationUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := reservation.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReservationUpdateOne) check() error {
+	if v, ok := ruo.mutation.TableName(); ok {
+		if err := reservation.TableNameValidator(v); err != nil {
+			return &ValidationError{Name: "table_name", err: fmt.Errorf(`ent: validator failed for field "Reservation.table_name": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := reservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reservation.status": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.DepositStatus(); ok {
+		if err := reservation.DepositStatusValidator(v); err != nil {
+			return &ValidationError{Name: "deposit_status", err: fmt.Errorf(`ent: validator failed for field "Reservation.deposit_status": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.DepositMethod(); ok {
+		if err := reservation.DepositMethodValidator(string(v)); err != nil {
+			return &ValidationError{Name: "deposit_method", err: fmt.Errorf(`ent: validator failed for field "Reservation.deposit_method": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.CancelReason(); ok {
+		if err := reservation.CancelReasonValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_reason", err: fmt.Errorf(`ent: validator failed for field "Reservation.cancel_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ruo *ReservationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReservationUpdateOne {
+	ruo.modifiers = append(ruo.modifiers, modifiers...)
+	return ruo
+}
+
+func (ruo *ReservationUpdateOne) sqlSave(ctx context.Context) (_node *Reservation, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reservation.Table, reservation.Columns, sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeUUID))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reservation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reservation.FieldID)
+		for _, f := range fields {
+			if !reservation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reservation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(reservation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.TableID(); ok {
+		_spec.SetField(reservation.FieldTableID, field.TypeUUID, value)
+	}
+	if value, ok := ruo.mutation.TableName(); ok {
+		_spec.SetField(reservation.FieldTableName, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(reservation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.DepositStatus(); ok {
+		_spec.SetField(reservation.FieldDepositStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.DepositMethod(); ok {
+		_spec.SetField(reservation.FieldDepositMethod, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.DepositPaidAt(); ok {
+		_spec.SetField(reservation.FieldDepositPaidAt, field.TypeTime, value)
+	}
+	if ruo.mutation.DepositPaidAtCleared() {
+		_spec.ClearField(reservation.FieldDepositPaidAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.OrderID(); ok {
+		_spec.SetField(reservation.FieldOrderID, field.TypeUUID, value)
+	}
+	if value, ok := ruo.mutation.SeatedAt(); ok {
+		_spec.SetField(reservation.FieldSeatedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.SeatedAtCleared() {
+		_spec.ClearField(reservation.FieldSeatedAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.CancelledAt(); ok {
+		_spec.SetField(reservation.FieldCancelledAt, field.TypeTime, value)
+	}
+	if ruo.mutation.CancelledAtCleared() {
+		_spec.ClearField(reservation.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.CancelReason(); ok {
+		_spec.SetField(reservation.FieldCancelReason, field.TypeString, value)
+	}
+	_spec.AddModifiers(ruo.modifiers...)
+	_node = &Reservation{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
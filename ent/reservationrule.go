@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservationrule"
+)
+
+// ReservationRule is the model entity for the ReservationRule schema.
+type ReservationRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 是否开放顾客线上预订
+	OnlineEnabled bool `json:"online_enabled,omitempty"`
+	// 时段间隔（分钟）
+	SlotMinutes int `json:"slot_minutes,omitempty"`
+	// 用餐时长（分钟）
+	DiningMinutes int `json:"dining_minutes,omitempty"`
+	// 线上预订需至少提前的分钟数
+	MinAdvanceMinutes int `json:"min_advance_minutes,omitempty"`
+	// 最多可提前预订的天数
+	MaxAdvanceDays int `json:"max_advance_days,omitempty"`
+	// 单笔预订人数上限
+	MaxPartySize int `json:"max_party_size,omitempty"`
+	// 线上预订每位定金
+	DepositPerGuest decimal.Decimal `json:"deposit_per_guest,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReservationRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reservationrule.FieldDepositPerGuest:
+			values[i] = new(decimal.Decimal)
+		case reservationrule.FieldOnlineEnabled:
+			values[i] = new(sql.NullBool)
+		case reservationrule.FieldSlotMinutes, reservationrule.FieldDiningMinutes, reservationrule.FieldMinAdvanceMinutes, reservationrule.FieldMaxAdvanceDays, reservationrule.FieldMaxPartySize:
+			values[i] = new(sql.NullInt64)
+		case reservationrule.FieldCreatedAt, reservationrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case reservationrule.FieldID, reservationrule.FieldMerchantID, reservationrule.FieldStoreID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReservationRule fields.
+func (rr *ReservationRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reservationrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rr.ID = *value
+			}
+		case reservationrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rr.CreatedAt = value.Time
+			}
+		case reservationrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rr.UpdatedAt = value.Time
+			}
+		case reservationrule.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				rr.MerchantID = *value
+			}
+		case reservationrule.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				rr.StoreID = *value
+			}
+		case reservationrule.FieldOnlineEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field online_enabled", values[i])
+			} else if value.Valid {
+				rr.OnlineEnabled = value.Bool
+			}
+		case reservationrule.FieldSlotMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field slot_minutes", values[i])
+			} else if value.Valid {
+				rr.SlotMinutes = int(value.Int64)
+			}
+		case reservationrule.FieldDiningMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dining_minutes", values[i])
+			} else if value.Valid {
+				rr.DiningMinutes = int(value.Int64)
+			}
+		case reservationrule.FieldMinAdvanceMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_advance_minutes", values[i])
+			} else if value.Valid {
+				rr.MinAdvanceMinutes = int(value.Int64)
+			}
+		case reservationrule.FieldMaxAdvanceDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_advance_days", values[i])
+			} else if value.Valid {
+				rr.MaxAdvanceDays = int(value.Int64)
+			}
+		case reservationrule.FieldMaxPartySize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_party_size", values[i])
+			} else if value.Valid {
+				rr.MaxPartySize = int(value.Int64)
+			}
+		case reservationrule.FieldDepositPerGuest:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field deposit_per_guest", values[i])
+			} else if value != nil {
+				rr.DepositPerGuest = *value
+			}
+		default:
+			rr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReservationRule.
+// This includes values selected through modifiers, order, etc.
+func (rr *ReservationRule) Value(name string) (ent.Value, error) {
+	return rr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ReservationRule.
+// Note that you need to call ReservationRule.Unwrap() before calling this method if this ReservationRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rr *ReservationRule) Update() *ReservationRuleUpdateOne {
+	return NewReservationRuleClient(rr.config).UpdateOne(rr)
+}
+
+// Unwrap unwraps the ReservationRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rr *ReservationRule) Unwrap() *ReservationRule {
+	_tx, ok := rr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReservationRule is not a transactional entity")
+	}
+	rr.config.driver = _tx.drv
+	return rr
+}
+
+// String implements the fmt.Stringer.
+func (rr *ReservationRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReservationRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", rr.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", rr.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("online_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", rr.OnlineEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("slot_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", rr.SlotMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("dining_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", rr.DiningMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("min_advance_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", rr.MinAdvanceMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("max_advance_days=")
+	builder.WriteString(fmt.Sprintf("%v", rr.MaxAdvanceDays))
+	builder.WriteString(", ")
+	builder.WriteString("max_party_size=")
+	builder.WriteString(fmt.Sprintf("%v", rr.MaxPartySize))
+	builder.WriteString(", ")
+	builder.WriteString("deposit_per_guest=")
+	builder.WriteString(fmt.Sprintf("%v", rr.DepositPerGuest))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReservationRules is a parsable slice of ReservationRule.
+type ReservationRules []*ReservationRule
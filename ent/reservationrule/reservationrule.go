@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reservationrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the reservationrule type in the database.
+	Label = "reservation_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldOnlineEnabled holds the string denoting the online_enabled field in the database.
+	FieldOnlineEnabled = "online_enabled"
+	// FieldSlotMinutes holds the string denoting the slot_minutes field in the database.
+	FieldSlotMinutes = "slot_minutes"
+	// FieldDiningMinutes holds the string denoting the dining_minutes field in the database.
+	FieldDiningMinutes = "dining_minutes"
+	// FieldMinAdvanceMinutes holds the string denoting the min_advance_minutes field in the database.
+	FieldMinAdvanceMinutes = "min_advance_minutes"
+	// FieldMaxAdvanceDays holds the string denoting the max_advance_days field in the database.
+	FieldMaxAdvanceDays = "max_advance_days"
+	// FieldMaxPartySize holds the string denoting the max_party_size field in the database.
+	FieldMaxPartySize = "max_party_size"
+	// FieldDepositPerGuest holds the string denoting the deposit_per_guest field in the database.
+	FieldDepositPerGuest = "deposit_per_guest"
+	// Table holds the table name of the reservationrule in the database.
+	Table = "reservation_rules"
+)
+
+// Columns holds all SQL columns for reservationrule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldOnlineEnabled,
+	FieldSlotMinutes,
+	FieldDiningMinutes,
+	FieldMinAdvanceMinutes,
+	FieldMaxAdvanceDays,
+	FieldMaxPartySize,
+	FieldDepositPerGuest,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultOnlineEnabled holds the default value on creation for the "online_enabled" field.
+	DefaultOnlineEnabled bool
+	// DefaultMinAdvanceMinutes holds the default value on creation for the "min_advance_minutes" field.
+	DefaultMinAdvanceMinutes int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ReservationRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByOnlineEnabled orders the results by the online_enabled field.
+func ByOnlineEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnlineEnabled, opts...).ToFunc()
+}
+
+// BySlotMinutes orders the results by the slot_minutes field.
+func BySlotMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlotMinutes, opts...).ToFunc()
+}
+
+// ByDiningMinutes orders the results by the dining_minutes field.
+func ByDiningMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiningMinutes, opts...).ToFunc()
+}
+
+// ByMinAdvanceMinutes orders the results by the min_advance_minutes field.
+func ByMinAdvanceMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinAdvanceMinutes, opts...).ToFunc()
+}
+
+// ByMaxAdvanceDays orders the results by the max_advance_days field.
+func ByMaxAdvanceDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAdvanceDays, opts...).ToFunc()
+}
+
+// ByMaxPartySize orders the results by the max_party_size field.
+func ByMaxPartySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxPartySize, opts...).ToFunc()
+}
+
+// ByDepositPerGuest orders the results by the deposit_per_guest field.
+func ByDepositPerGuest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepositPerGuest, opts...).ToFunc()
+}
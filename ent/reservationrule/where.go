@@ -0,0 +1,537 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reservationrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldStoreID, v))
+}
+
+// OnlineEnabled applies equality check predicate on the "online_enabled" field. It's identical to OnlineEnabledEQ.
+func OnlineEnabled(v bool) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldOnlineEnabled, v))
+}
+
+// SlotMinutes applies equality check predicate on the "slot_minutes" field. It's identical to SlotMinutesEQ.
+func SlotMinutes(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldSlotMinutes, v))
+}
+
+// DiningMinutes applies equality check predicate on the "dining_minutes" field. It's identical to DiningMinutesEQ.
+func DiningMinutes(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldDiningMinutes, v))
+}
+
+// MinAdvanceMinutes applies equality check predicate on the "min_advance_minutes" field. It's identical to MinAdvanceMinutesEQ.
+func MinAdvanceMinutes(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldMinAdvanceMinutes, v))
+}
+
+// MaxAdvanceDays applies equality check predicate on the "max_advance_days" field. It's identical to MaxAdvanceDaysEQ.
+func MaxAdvanceDays(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldMaxAdvanceDays, v))
+}
+
+// MaxPartySize applies equality check predicate on the "max_party_size" field. It's identical to MaxPartySizeEQ.
+func MaxPartySize(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldMaxPartySize, v))
+}
+
+// DepositPerGuest applies equality check predicate on the "deposit_per_guest" field. It's identical to DepositPerGuestEQ.
+func DepositPerGuest(v decimal.Decimal) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldDepositPerGuest, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLTE(FieldStoreID, v))
+}
+
+// OnlineEnabledEQ applies the EQ predicate on the "online_enabled" field.
+func OnlineEnabledEQ(v bool) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldOnlineEnabled, v))
+}
+
+// OnlineEnabledNEQ applies the NEQ predicate on the "online_enabled" field.
+func OnlineEnabledNEQ(v bool) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldOnlineEnabled, v))
+}
+
+// SlotMinutesEQ applies the EQ predicate on the "slot_minutes" field.
+func SlotMinutesEQ(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldSlotMinutes, v))
+}
+
+// SlotMinutesNEQ applies the NEQ predicate on the "slot_minutes" field.
+func SlotMinutesNEQ(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldSlotMinutes, v))
+}
+
+// SlotMinutesIn applies the In predicate on the "slot_minutes" field.
+func SlotMinutesIn(vs ...int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldIn(FieldSlotMinutes, vs...))
+}
+
+// SlotMinutesNotIn applies the NotIn predicate on the "slot_minutes" field.
+func SlotMinutesNotIn(vs ...int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNotIn(FieldSlotMinutes, vs...))
+}
+
+// SlotMinutesGT applies the GT predicate on the "slot_minutes" field.
+func SlotMinutesGT(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGT(FieldSlotMinutes, v))
+}
+
+// SlotMinutesGTE applies the GTE predicate on the "slot_minutes" field.
+func SlotMinutesGTE(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGTE(FieldSlotMinutes, v))
+}
+
+// SlotMinutesLT applies the LT predicate on the "slot_minutes" field.
+func SlotMinutesLT(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLT(FieldSlotMinutes, v))
+}
+
+// SlotMinutesLTE applies the LTE predicate on the "slot_minutes" field.
+func SlotMinutesLTE(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLTE(FieldSlotMinutes, v))
+}
+
+// DiningMinutesEQ applies the EQ predicate on the "dining_minutes" field.
+func DiningMinutesEQ(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldDiningMinutes, v))
+}
+
+// DiningMinutesNEQ applies the NEQ predicate on the "dining_minutes" field.
+func DiningMinutesNEQ(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldDiningMinutes, v))
+}
+
+// DiningMinutesIn applies the In predicate on the "dining_minutes" field.
+func DiningMinutesIn(vs ...int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldIn(FieldDiningMinutes, vs...))
+}
+
+// DiningMinutesNotIn applies the NotIn predicate on the "dining_minutes" field.
+func DiningMinutesNotIn(vs ...int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNotIn(FieldDiningMinutes, vs...))
+}
+
+// DiningMinutesGT applies the GT predicate on the "dining_minutes" field.
+func DiningMinutesGT(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGT(FieldDiningMinutes, v))
+}
+
+// DiningMinutesGTE applies the GTE predicate on the "dining_minutes" field.
+func DiningMinutesGTE(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGTE(FieldDiningMinutes, v))
+}
+
+// DiningMinutesLT applies the LT predicate on the "dining_minutes" field.
+func DiningMinutesLT(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLT(FieldDiningMinutes, v))
+}
+
+// DiningMinutesLTE applies the LTE predicate on the "dining_minutes" field.
+func DiningMinutesLTE(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLTE(FieldDiningMinutes, v))
+}
+
+// MinAdvanceMinutesEQ applies the EQ predicate on the "min_advance_minutes" field.
+func MinAdvanceMinutesEQ(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldMinAdvanceMinutes, v))
+}
+
+// MinAdvanceMinutesNEQ applies the NEQ predicate on the "min_advance_minutes" field.
+func MinAdvanceMinutesNEQ(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldMinAdvanceMinutes, v))
+}
+
+// MinAdvanceMinutesIn applies the In predicate on the "min_advance_minutes" field.
+func MinAdvanceMinutesIn(vs ...int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldIn(FieldMinAdvanceMinutes, vs...))
+}
+
+// MinAdvanceMinutesNotIn applies the NotIn predicate on the "min_advance_minutes" field.
+func MinAdvanceMinutesNotIn(vs ...int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNotIn(FieldMinAdvanceMinutes, vs...))
+}
+
+// MinAdvanceMinutesGT applies the GT predicate on the "min_advance_minutes" field.
+func MinAdvanceMinutesGT(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGT(FieldMinAdvanceMinutes, v))
+}
+
+// MinAdvanceMinutesGTE applies the GTE predicate on the "min_advance_minutes" field.
+func MinAdvanceMinutesGTE(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGTE(FieldMinAdvanceMinutes, v))
+}
+
+// MinAdvanceMinutesLT applies the LT predicate on the "min_advance_minutes" field.
+func MinAdvanceMinutesLT(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLT(FieldMinAdvanceMinutes, v))
+}
+
+// MinAdvanceMinutesLTE applies the LTE predicate on the "min_advance_minutes" field.
+func MinAdvanceMinutesLTE(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLTE(FieldMinAdvanceMinutes, v))
+}
+
+// MaxAdvanceDaysEQ applies the EQ predicate on the "max_advance_days" field.
+func MaxAdvanceDaysEQ(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldMaxAdvanceDays, v))
+}
+
+// MaxAdvanceDaysNEQ applies the NEQ predicate on the "max_advance_days" field.
+func MaxAdvanceDaysNEQ(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldMaxAdvanceDays, v))
+}
+
+// MaxAdvanceDaysIn applies the In predicate on the "max_advance_days" field.
+func MaxAdvanceDaysIn(vs ...int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldIn(FieldMaxAdvanceDays, vs...))
+}
+
+// MaxAdvanceDaysNotIn applies the NotIn predicate on the "max_advance_days" field.
+func MaxAdvanceDaysNotIn(vs ...int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNotIn(FieldMaxAdvanceDays, vs...))
+}
+
+// MaxAdvanceDaysGT applies the GT predicate on the "max_advance_days" field.
+func MaxAdvanceDaysGT(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGT(FieldMaxAdvanceDays, v))
+}
+
+// MaxAdvanceDaysGTE applies the GTE predicate on the "max_advance_days" field.
+func MaxAdvanceDaysGTE(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGTE(FieldMaxAdvanceDays, v))
+}
+
+// MaxAdvanceDaysLT applies the LT predicate on the "max_advance_days" field.
+func MaxAdvanceDaysLT(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLT(FieldMaxAdvanceDays, v))
+}
+
+// MaxAdvanceDaysLTE applies the LTE predicate on the "max_advance_days" field.
+func MaxAdvanceDaysLTE(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLTE(FieldMaxAdvanceDays, v))
+}
+
+// MaxPartySizeEQ applies the EQ predicate on the "max_party_size" field.
+func MaxPartySizeEQ(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldMaxPartySize, v))
+}
+
+// MaxPartySizeNEQ applies the NEQ predicate on the "max_party_size" field.
+func MaxPartySizeNEQ(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldMaxPartySize, v))
+}
+
+// MaxPartySizeIn applies the In predicate on the "max_party_size" field.
+func MaxPartySizeIn(vs ...int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldIn(FieldMaxPartySize, vs...))
+}
+
+// MaxPartySizeNotIn applies the NotIn predicate on the "max_party_size" field.
+func MaxPartySizeNotIn(vs ...int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNotIn(FieldMaxPartySize, vs...))
+}
+
+// MaxPartySizeGT applies the GT predicate on the "max_party_size" field.
+func MaxPartySizeGT(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGT(FieldMaxPartySize, v))
+}
+
+// MaxPartySizeGTE applies the GTE predicate on the "max_party_size" field.
+func MaxPartySizeGTE(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGTE(FieldMaxPartySize, v))
+}
+
+// MaxPartySizeLT applies the LT predicate on the "max_party_size" field.
+func MaxPartySizeLT(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLT(FieldMaxPartySize, v))
+}
+
+// MaxPartySizeLTE applies the LTE predicate on the "max_party_size" field.
+func MaxPartySizeLTE(v int) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLTE(FieldMaxPartySize, v))
+}
+
+// DepositPerGuestEQ applies the EQ predicate on the "deposit_per_guest" field.
+func DepositPerGuestEQ(v decimal.Decimal) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldEQ(FieldDepositPerGuest, v))
+}
+
+// DepositPerGuestNEQ applies the NEQ predicate on the "deposit_per_guest" field.
+func DepositPerGuestNEQ(v decimal.Decimal) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNEQ(FieldDepositPerGuest, v))
+}
+
+// DepositPerGuestIn applies the In predicate on the "deposit_per_guest" field.
+func DepositPerGuestIn(vs ...decimal.Decimal) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldIn(FieldDepositPerGuest, vs...))
+}
+
+// DepositPerGuestNotIn applies the NotIn predicate on the "deposit_per_guest" field.
+func DepositPerGuestNotIn(vs ...decimal.Decimal) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldNotIn(FieldDepositPerGuest, vs...))
+}
+
+// DepositPerGuestGT applies the GT predicate on the "deposit_per_guest" field.
+func DepositPerGuestGT(v decimal.Decimal) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGT(FieldDepositPerGuest, v))
+}
+
+// DepositPerGuestGTE applies the GTE predicate on the "deposit_per_guest" field.
+func DepositPerGuestGTE(v decimal.Decimal) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldGTE(FieldDepositPerGuest, v))
+}
+
+// DepositPerGuestLT applies the LT predicate on the "deposit_per_guest" field.
+func DepositPerGuestLT(v decimal.Decimal) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLT(FieldDepositPerGuest, v))
+}
+
+// DepositPerGuestLTE applies the LTE predicate on the "deposit_per_guest" field.
+func DepositPerGuestLTE(v decimal.Decimal) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.FieldLTE(FieldDepositPerGuest, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReservationRule) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReservationRule) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReservationRule) predicate.ReservationRule {
+	return predicate.ReservationRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1086 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservationrule"
+)
+
+// ReservationRuleCreate is the builder for creating a ReservationRule entity.
+type ReservationRuleCreate struct {
+	config
+	mutation *ReservationRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrc *ReservationRuleCreate) SetCreatedAt(t time.Time) *ReservationRuleCreate {
+	rrc.mutation.SetCreatedAt(t)
+	return rrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrc *ReservationRuleCreate) SetNillableCreatedAt(t *time.Time) *ReservationRuleCreate {
+	if t != nil {
+		rrc.SetCreatedAt(*t)
+	}
+	return rrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rrc *ReservationRuleCreate) SetUpdatedAt(t time.Time) *ReservationRuleCreate {
+	rrc.mutation.SetUpdatedAt(t)
+	return rrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rrc *ReservationRuleCreate) SetNillableUpdatedAt(t *time.Time) *ReservationRuleCreate {
+	if t != nil {
+		rrc.SetUpdatedAt(*t)
+	}
+	return rrc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (rrc *ReservationRuleCreate) SetMerchantID(u uuid.UUID) *ReservationRuleCreate {
+	rrc.mutation.SetMerchantID(u)
+	return rrc
+}
+
+// SetStoreID sets the "store_id" field.
+func (rrc *ReservationRuleCreate) SetStoreID(u uuid.UUID) *ReservationRuleCreate {
+	rrc.mutation.SetStoreID(u)
+	return rrc
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (rrc *ReservationRuleCreate) SetOnlineEnabled(b bool) *ReservationRuleCreate {
+	rrc.mutation.SetOnlineEnabled(b)
+	return rrc
+}
+
+// SetNillableOnlineEnabled sets the "online_enabled" field if the given value is not nil.
+func (rrc *ReservationRuleCreate) SetNillableOnlineEnabled(b *bool) *ReservationRuleCreate {
+	if b != nil {
+		rrc.SetOnlineEnabled(*b)
+	}
+	return rrc
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (rrc *ReservationRuleCreate) SetSlotMinutes(i int) *ReservationRuleCreate {
+	rrc.mutation.SetSlotMinutes(i)
+	return rrc
+}
+
+// SetDiningMinutes sets the "dining_minutes" field.
+func (rrc *ReservationRuleCreate) SetDiningMinutes(i int) *ReservationRuleCreate {
+	rrc.mutation.SetDiningMinutes(i)
+	return rrc
+}
+
+// SetMinAdvanceMinutes sets the "min_advance_minutes" field.
+func (rrc *ReservationRuleCreate) SetMinAdvanceMinutes(i int) *ReservationRuleCreate {
+	rrc.mutation.SetMinAdvanceMinutes(i)
+	return rrc
+}
+
+// SetNillableMinAdvanceMinutes sets the "min_advance_minutes" field if the given value is not nil.
+func (rrc *ReservationRuleCreate) SetNillableMinAdvanceMinutes(i *int) *ReservationRuleCreate {
+	if i != nil {
+		rrc.SetMinAdvanceMinutes(*i)
+	}
+	return rrc
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (rrc *ReservationRuleCreate) SetMaxAdvanceDays(i int) *ReservationRuleCreate {
+	rrc.mutation.SetMaxAdvanceDays(i)
+	return rrc
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (rrc *ReservationRuleCreate) SetMaxPartySize(i int) *ReservationRuleCreate {
+	rrc.mutation.SetMaxPartySize(i)
+	return rrc
+}
+
+// SetDepositPerGuest sets the "deposit_per_guest" field.
+func (rrc *ReservationRuleCreate) SetDepositPerGuest(d decimal.Decimal) *ReservationRuleCreate {
+	rrc.mutation.SetDepositPerGuest(d)
+	return rrc
+}
+
+// SetID sets the "id" field.
+func (rrc *ReservationRuleCreate) SetID(u uuid.UUID) *ReservationRuleCreate {
+	rrc.mutation.SetID(u)
+	return rrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rrc *ReservationRuleCreate) SetNillableID(u *uuid.UUID) *ReservationRuleCreate {
+	if u != nil {
+		rrc.SetID(*u)
+	}
+	return rrc
+}
+
+// Mutation returns the ReservationRuleMutation object of the builder.
+func (rrc *ReservationRuleCreate) Mutation() *ReservationRuleMutation {
+	return rrc.mutation
+}
+
+// Save creates the ReservationRule in the database.
+func (rrc *ReservationRuleCreate) Save(ctx context.Context) (*ReservationRule, error) {
+	rrc.defaults()
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *ReservationRuleCreate) SaveX(ctx context.Context) *ReservationRule {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *ReservationRuleCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *ReservationRuleCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *ReservationRuleCreate) defaults() {
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		v := reservationrule.DefaultCreatedAt()
+		rrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		v := reservationrule.DefaultUpdatedAt()
+		rrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rrc.mutation.OnlineEnabled(); !ok {
+		v := reservationrule.DefaultOnlineEnabled
+		rrc.mutation.SetOnlineEnabled(v)
+	}
+	if _, ok := rrc.mutation.MinAdvanceMinutes(); !ok {
+		v := reservationrule.DefaultMinAdvanceMinutes
+		rrc.mutation.SetMinAdvanceMinutes(v)
+	}
+	if _, ok := rrc.mutation.ID(); !ok {
+		v := reservationrule.DefaultID()
+		rrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *ReservationRuleCreate) check() error {
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReservationRule.created_at"`)}
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReservationRule.updated_at"`)}
+	}
+	if _, ok := rrc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "ReservationRule.merchant_id"`)}
+	}
+	if _, ok := rrc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "ReservationRule.store_id"`)}
+	}
+	if _, ok := rrc.mutation.OnlineEnabled(); !ok {
+		return &ValidationError{Name: "online_enabled", err: errors.New(`ent: missing required field "ReservationRule.online_enabled"`)}
+	}
+	if _, ok := rrc.mutation.SlotMinutes(); !ok {
+		return &ValidationError{Name: "slot_minutes", err: errors.New(`ent: missing required field "ReservationRule.slot_minutes"`)}
+	}
+	if _, ok := rrc.mutation.DiningMinutes(); !ok {
+		return &ValidationError{Name: "dining_minutes", err: errors.New(`ent: missing required field "ReservationRule.dining_minutes"`)}
+	}
+	if _, ok := rrc.mutation.MinAdvanceMinutes(); !ok {
+		return &ValidationError{Name: "min_advance_minutes", err: errors.New(`ent: missing required field "ReservationRule.min_advance_minutes"`)}
+	}
+	if _, ok := rrc.mutation.MaxAdvanceDays(); !ok {
+		return &ValidationError{Name: "max_advance_days", err: errors.New(`ent: missing required field "ReservationRule.max_advance_days"`)}
+	}
+	if _, ok := rrc.mutation.MaxPartySize(); !ok {
+		return &ValidationError{Name: "max_party_size", err: errors.New(`ent: missing required field "ReservationRule.max_party_size"`)}
+	}
+	if _, ok := rrc.mutation.DepositPerGuest(); !ok {
+		return &ValidationError{Name: "deposit_per_guest", err: errors.New(`ent: missing required field "ReservationRule.deposit_per_guest"`)}
+	}
+	return nil
+}
+
+func (rrc *ReservationRuleCreate) sqlSave(ctx context.Context) (*ReservationRule, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *ReservationRuleCreate) createSpec() (*ReservationRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReservationRule{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(reservationrule.Table, sqlgraph.NewFieldSpec(reservationrule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rrc.conflict
+	if id, ok := rrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rrc.mutation.CreatedAt(); ok {
+		_spec.SetField(reservationrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(reservationrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rrc.mutation.MerchantID(); ok {
+		_spec.SetField(reservationrule.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := rrc.mutation.StoreID(); ok {
+		_spec.SetField(reservationrule.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := rrc.mutation.OnlineEnabled(); ok {
+		_spec.SetField(reservationrule.FieldOnlineEnabled, field.TypeBool, value)
+		_node.OnlineEnabled = value
+	}
+	if value, ok := rrc.mutation.SlotMinutes(); ok {
+		_spec.SetField(reservationrule.FieldSlotMinutes, field.TypeInt, value)
+		_node.SlotMinutes = value
+	}
+	if value, ok := rrc.mutation.DiningMinutes(); ok {
+		_spec.SetField(reservationrule.FieldDiningMinutes, field.TypeInt, value)
+		_node.DiningMinutes = value
+	}
+	if value, ok := rrc.mutation.MinAdvanceMinutes(); ok {
+		_spec.SetField(reservationrule.FieldMinAdvanceMinutes, field.TypeInt, value)
+		_node.MinAdvanceMinutes = value
+	}
+	if value, ok := rrc.mutation.MaxAdvanceDays(); ok {
+		_spec.SetField(reservationrule.FieldMaxAdvanceDays, field.TypeInt, value)
+		_node.MaxAdvanceDays = value
+	}
+	if value, ok := rrc.mutation.MaxPartySize(); ok {
+		_spec.SetField(reservationrule.FieldMaxPartySize, field.TypeInt, value)
+		_node.MaxPartySize = value
+	}
+	if value, ok := rrc.mutation.DepositPerGuest(); ok {
+		_spec.SetField(reservationrule.FieldDepositPerGuest, field.TypeOther, value)
+		_node.DepositPerGuest = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReservationRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReservationRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rrc *ReservationRuleCreate) OnConflict(opts ...sql.ConflictOption) *ReservationRuleUpsertOne {
+	rrc.conflict = opts
+	return &ReservationRuleUpsertOne{
+		create: rrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReservationRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrc *ReservationRuleCreate) OnConflictColumns(columns ...string) *ReservationRuleUpsertOne {
+	rrc.conflict = append(rrc.conflict, sql.ConflictColumns(columns...))
+	return &ReservationRuleUpsertOne{
+		create: rrc,
+	}
+}
+
+type (
+	// ReservationRuleUpsertOne is the builder for "upsert"-ing
+	//  one ReservationRule node.
+	ReservationRuleUpsertOne struct {
+		create *ReservationRuleCreate
+	}
+
+	// ReservationRuleUpsert is the "OnConflict" setter.
+	ReservationRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReservationRuleUpsert) SetUpdatedAt(v time.Time) *ReservationRuleUpsert {
+	u.Set(reservationrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReservationRuleUpsert) UpdateUpdatedAt() *ReservationRuleUpsert {
+	u.SetExcluded(reservationrule.FieldUpdatedAt)
+	return u
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (u *ReservationRuleUpsert) SetOnlineEnabled(v bool) *ReservationRuleUpsert {
+	u.Set(reservationrule.FieldOnlineEnabled, v)
+	return u
+}
+
+// UpdateOnlineEnabled sets the "online_enabled" field to the value that was provided on create.
+func (u *ReservationRuleUpsert) UpdateOnlineEnabled() *ReservationRuleUpsert {
+	u.SetExcluded(reservationrule.FieldOnlineEnabled)
+	return u
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (u *ReservationRuleUpsert) SetSlotMinutes(v int) *ReservationRuleUpsert {
+	u.Set(reservationrule.FieldSlotMinutes, v)
+	return u
+}
+
+// UpdateSlotMinutes sets the "slot_minutes" field to the value that was provided on create.
+func (u *ReservationRuleUpsert) UpdateSlotMinutes() *ReservationRuleUpsert {
+	u.SetExcluded(reservationrule.FieldSlotMinutes)
+	return u
+}
+
+// AddSlotMinutes adds v to the "slot_minutes" field.
+func (u *ReservationRuleUpsert) AddSlotMinutes(v int) *ReservationRuleUpsert {
+	u.Add(reservationrule.FieldSlotMinutes, v)
+	return u
+}
+
+// SetDiningMinutes sets the "dining_minutes" field.
+func (u *ReservationRuleUpsert) SetDiningMinutes(v int) *ReservationRuleUpsert {
+	u.Set(reservationrule.FieldDiningMinutes, v)
+	return u
+}
+
+// UpdateDiningMinutes sets the "dining_minutes" field to the value that was provided on create.
+func (u *ReservationRuleUpsert) UpdateDiningMinutes() *ReservationRuleUpsert {
+	u.SetExcluded(reservationrule.FieldDiningMinutes)
+	return u
+}
+
+// AddDiningMinutes adds v to the "dining_minutes" field.
+func (u *ReservationRuleUpsert) AddDiningMinutes(v int) *ReservationRuleUpsert {
+	u.Add(reservationrule.FieldDiningMinutes, v)
+	return u
+}
+
+// SetMinAdvanceMinutes sets the "min_advance_minutes" field.
+func (u *ReservationRuleUpsert) SetMinAdvanceMinutes(v int) *ReservationRuleUpsert {
+	u.Set(reservationrule.FieldMinAdvanceMinutes, v)
+	return u
+}
+
+// UpdateMinAdvanceMinutes sets the "min_advance_minutes" field to the value that was provided on create.
+func (u *ReservationRuleUpsert) UpdateMinAdvanceMinutes() *ReservationRuleUpsert {
+	u.SetExcluded(reservationrule.FieldMinAdvanceMinutes)
+	return u
+}
+
+// AddMinAdvanceMinutes adds v to the "min_advance_minutes" field.
+func (u *ReservationRuleUpsert) AddMinAdvanceMinutes(v int) *ReservationRuleUpsert {
+	u.Add(reservationrule.FieldMinAdvanceMinutes, v)
+	return u
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (u *ReservationRuleUpsert) SetMaxAdvanceDays(v int) *ReservationRuleUpsert {
+	u.Set(reservationrule.FieldMaxAdvanceDays, v)
+	return u
+}
+
+// UpdateMaxAdvanceDays sets the "max_advance_days" field to the value that was provided on create.
+func (u *ReservationRuleUpsert) UpdateMaxAdvanceDays() *ReservationRuleUpsert {
+	u.SetExcluded(reservationrule.FieldMaxAdvanceDays)
+	return u
+}
+
+// AddMaxAdvanceDays adds v to the "max_advance_days" field.
+func (u *ReservationRuleUpsert) AddMaxAdvanceDays(v int) *ReservationRuleUpsert {
+	u.Add(reservationrule.FieldMaxAdvanceDays, v)
+	return u
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (u *ReservationRuleUpsert) SetMaxPartySize(v int) *ReservationRuleUpsert {
+	u.Set(reservationrule.FieldMaxPartySize, v)
+	return u
+}
+
+// UpdateMaxPartySize sets the "max_party_size" field to the value that was provided on create.
+func (u *ReservationRuleUpsert) UpdateMaxPartySize() *ReservationRuleUpsert {
+	u.SetExcluded(reservationrule.FieldMaxPartySize)
+	return u
+}
+
+// AddMaxPartySize adds v to the "max_party_size" field.
+func (u *ReservationRuleUpsert) AddMaxPartySize(v int) *ReservationRuleUpsert {
+	u.Add(reservationrule.FieldMaxPartySize, v)
+	return u
+}
+
+// SetDepositPerGuest sets the "deposit_per_guest" field.
+func (u *ReservationRuleUpsert) SetDepositPerGuest(v decimal.Decimal) *ReservationRuleUpsert {
+	u.Set(reservationrule.FieldDepositPerGuest, v)
+	return u
+}
+
+// UpdateDepositPerGuest sets the "deposit_per_guest" field to the value that was provided on create.
+func (u *ReservationRuleUpsert) UpdateDepositPerGuest() *ReservationRuleUpsert {
+	u.SetExcluded(reservationrule.FieldDepositPerGuest)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReservationRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reservationrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReservationRuleUpsertOne) UpdateNewValues() *ReservationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reservationrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reservationrule.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(reservationrule.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(reservationrule.FieldStoreID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReservationRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReservationRuleUpsertOne) Ignore() *ReservationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReservationRuleUpsertOne) DoNothing() *ReservationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReservationRuleCreate.OnConflict
+// documentation for more info.
+func (u *ReservationRuleUpsertOne) Update(set func(*ReservationRuleUpsert)) *ReservationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReservationRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReservationRuleUpsertOne) SetUpdatedAt(v time.Time) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReservationRuleUpsertOne) UpdateUpdatedAt() *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (u *ReservationRuleUpsertOne) SetOnlineEnabled(v bool) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetOnlineEnabled(v)
+	})
+}
+
+// UpdateOnlineEnabled sets the "online_enabled" field to the value that was provided on create.
+func (u *ReservationRuleUpsertOne) UpdateOnlineEnabled() *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateOnlineEnabled()
+	})
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (u *ReservationRuleUpsertOne) SetSlotMinutes(v int) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetSlotMinutes(v)
+	})
+}
+
+// AddSlotMinutes adds v to the "slot_minutes" field.
+func (u *ReservationRuleUpsertOne) AddSlotMinutes(v int) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.AddSlotMinutes(v)
+	})
+}
+
+// UpdateSlotMinutes sets the "slot_minutes" field to the value that was provided on create.
+func (u *ReservationRuleUpsertOne) UpdateSlotMinutes() *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateSlotMinutes()
+	})
+}
+
+// SetDiningMinutes sets the "dining_minutes" field.
+func (u *ReservationRuleUpsertOne) SetDiningMinutes(v int) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetDiningMinutes(v)
+	})
+}
+
+// AddDiningMinutes adds v to the "dining_minutes" field.
+func (u *ReservationRuleUpsertOne) AddDiningMinutes(v int) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.AddDiningMinutes(v)
+	})
+}
+
+// UpdateDiningMinutes sets the "dining_minutes" field to the value that was provided on create.
+func (u *ReservationRuleUpsertOne) UpdateDiningMinutes() *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateDiningMinutes()
+	})
+}
+
+// SetMinAdvanceMinutes sets the "min_advance_minutes" field.
+func (u *ReservationRuleUpsertOne) SetMinAdvanceMinutes(v int) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetMinAdvanceMinutes(v)
+	})
+}
+
+// AddMinAdvanceMinutes adds v to the "min_advance_minutes" field.
+func (u *ReservationRuleUpsertOne) AddMinAdvanceMinutes(v int) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.AddMinAdvanceMinutes(v)
+	})
+}
+
+// UpdateMinAdvanceMinutes sets the "min_advance_minutes" field to the value that was provided on create.
+func (u *ReservationRuleUpsertOne) UpdateMinAdvanceMinutes() *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateMinAdvanceMinutes()
+	})
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (u *ReservationRuleUpsertOne) SetMaxAdvanceDays(v int) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetMaxAdvanceDays(v)
+	})
+}
+
+// AddMaxAdvanceDays adds v to the "max_advance_days" field.
+func (u *ReservationRuleUpsertOne) AddMaxAdvanceDays(v int) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.AddMaxAdvanceDays(v)
+	})
+}
+
+// UpdateMaxAdvanceDays sets the "max_advance_days" field to the value that was provided on create.
+func (u *ReservationRuleUpsertOne) UpdateMaxAdvanceDays() *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateMaxAdvanceDays()
+	})
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (u *ReservationRuleUpsertOne) SetMaxPartySize(v int) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetMaxPartySize(v)
+	})
+}
+
+// AddMaxPartySize adds v to the "max_party_size" field.
+func (u *ReservationRuleUpsertOne) AddMaxPartySize(v int) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.AddMaxPartySize(v)
+	})
+}
+
+// UpdateMaxPartySize sets the "max_party_size" field to the value that was provided on create.
+func (u *ReservationRuleUpsertOne) UpdateMaxPartySize() *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateMaxPartySize()
+	})
+}
+
+// SetDepositPerGuest sets the "deposit_per_guest" field.
+func (u *ReservationRuleUpsertOne) SetDepositPerGuest(v decimal.Decimal) *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetDepositPerGuest(v)
+	})
+}
+
+// UpdateDepositPerGuest sets the "deposit_per_guest" field to the value that was provided on create.
+func (u *ReservationRuleUpsertOne) UpdateDepositPerGuest() *ReservationRuleUpsertOne {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateDepositPerGuest()
+	})
+}
+
+// Exec executes the query.
+func (u *ReservationRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReservationRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReservationRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReservationRuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReservationRuleUpsertOne.ID is not supported by MySQL driver. Use ReservationRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReservationRuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReservationRuleCreateBulk is the builder for creating many ReservationRule entities in bulk.
+type ReservationRuleCreateBulk struct {
+	config
+	err      error
+	builders []*ReservationRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReservationRule entities in the database.
+func (rrcb *ReservationRuleCreateBulk) Save(ctx context.Context) ([]*ReservationRule, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*ReservationRule, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReservationRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *ReservationRuleCreateBulk) SaveX(ctx context.Context) []*ReservationRule {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *ReservationRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *ReservationRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReservationRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReservationRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rrcb *ReservationRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReservationRuleUpsertBulk {
+	rrcb.conflict = opts
+	return &ReservationRuleUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReservationRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrcb *ReservationRuleCreateBulk) OnConflictColumns(columns ...string) *ReservationRuleUpsertBulk {
+	rrcb.conflict = append(rrcb.conflict, sql.ConflictColumns(columns...))
+	return &ReservationRuleUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// ReservationRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReservationRule nodes.
+type ReservationRuleUpsertBulk struct {
+	create *ReservationRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReservationRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reservationrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReservationRuleUpsertBulk) UpdateNewValues() *ReservationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reservationrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reservationrule.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(reservationrule.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(reservationrule.FieldStoreID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReservationRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReservationRuleUpsertBulk) Ignore() *ReservationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReservationRuleUpsertBulk) DoNothing() *ReservationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReservationRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReservationRuleUpsertBulk) Update(set func(*ReservationRuleUpsert)) *ReservationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReservationRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReservationRuleUpsertBulk) SetUpdatedAt(v time.Time) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReservationRuleUpsertBulk) UpdateUpdatedAt() *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (u *ReservationRuleUpsertBulk) SetOnlineEnabled(v bool) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetOnlineEnabled(v)
+	})
+}
+
+// UpdateOnlineEnabled sets the "online_enabled" field to the value that was provided on create.
+func (u *ReservationRuleUpsertBulk) UpdateOnlineEnabled() *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateOnlineEnabled()
+	})
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (u *ReservationRuleUpsertBulk) SetSlotMinutes(v int) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetSlotMinutes(v)
+	})
+}
+
+// AddSlotMinutes adds v to the "slot_minutes" field.
+func (u *ReservationRuleUpsertBulk) AddSlotMinutes(v int) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.AddSlotMinutes(v)
+	})
+}
+
+// UpdateSlotMinutes sets the "slot_minutes" field to the value that was provided on create.
+func (u *ReservationRuleUpsertBulk) UpdateSlotMinutes() *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateSlotMinutes()
+	})
+}
+
+// SetDiningMinutes sets the "dining_minutes" field.
+func (u *ReservationRuleUpsertBulk) SetDiningMinutes(v int) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetDiningMinutes(v)
+	})
+}
+
+// AddDiningMinutes adds v to the "dining_minutes" field.
+func (u *ReservationRuleUpsertBulk) AddDiningMinutes(v int) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.AddDiningMinutes(v)
+	})
+}
+
+// UpdateDiningMinutes sets the "dining_minutes" field to the value that was provided on create.
+func (u *ReservationRuleUpsertBulk) UpdateDiningMinutes() *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateDiningMinutes()
+	})
+}
+
+// SetMinAdvanceMinutes sets the "min_advance_minutes" field.
+func (u *ReservationRuleUpsertBulk) SetMinAdvanceMinutes(v int) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetMinAdvanceMinutes(v)
+	})
+}
+
+// AddMinAdvanceMinutes adds v to the "min_advance_minutes" field.
+func (u *ReservationRuleUpsertBulk) AddMinAdvanceMinutes(v int) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.AddMinAdvanceMinutes(v)
+	})
+}
+
+// UpdateMinAdvanceMinutes sets the "min_advance_minutes" field to the value that was provided on create.
+func (u *ReservationRuleUpsertBulk) UpdateMinAdvanceMinutes() *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateMinAdvanceMinutes()
+	})
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (u *ReservationRuleUpsertBulk) SetMaxAdvanceDays(v int) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetMaxAdvanceDays(v)
+	})
+}
+
+// AddMaxAdvanceDays adds v to the "max_advance_days" field.
+func (u *ReservationRuleUpsertBulk) AddMaxAdvanceDays(v int) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.AddMaxAdvanceDays(v)
+	})
+}
+
+// UpdateMaxAdvanceDays sets the "max_advance_days" field to the value that was provided on create.
+func (u *ReservationRuleUpsertBulk) UpdateMaxAdvanceDays() *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateMaxAdvanceDays()
+	})
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (u *ReservationRuleUpsertBulk) SetMaxPartySize(v int) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetMaxPartySize(v)
+	})
+}
+
+// AddMaxPartySize adds v to the "max_party_size" field.
+func (u *ReservationRuleUpsertBulk) AddMaxPartySize(v int) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.AddMaxPartySize(v)
+	})
+}
+
+// UpdateMaxPartySize sets the "max_party_size" field to the value that was provided on create.
+func (u *ReservationRuleUpsertBulk) UpdateMaxPartySize() *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateMaxPartySize()
+	})
+}
+
+// SetDepositPerGuest sets the "deposit_per_guest" field.
+func (u *ReservationRuleUpsertBulk) SetDepositPerGuest(v decimal.Decimal) *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.SetDepositPerGuest(v)
+	})
+}
+
+// UpdateDepositPerGuest sets the "deposit_per_guest" field to the value that was provided on create.
+func (u *ReservationRuleUpsertBulk) UpdateDepositPerGuest() *ReservationRuleUpsertBulk {
+	return u.Update(func(s *ReservationRuleUpsert) {
+		s.UpdateDepositPerGuest()
+	})
+}
+
+// Exec executes the query.
+func (u *ReservationRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReservationRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReservationRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReservationRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,567 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservationrule"
+)
+
+// ReservationRuleUpdate is the builder for updating ReservationRule entities.
+type ReservationRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReservationRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReservationRuleUpdate builder.
+func (rru *ReservationRuleUpdate) Where(ps ...predicate.ReservationRule) *ReservationRuleUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rru *ReservationRuleUpdate) SetUpdatedAt(t time.Time) *ReservationRuleUpdate {
+	rru.mutation.SetUpdatedAt(t)
+	return rru
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (rru *ReservationRuleUpdate) SetOnlineEnabled(b bool) *ReservationRuleUpdate {
+	rru.mutation.SetOnlineEnabled(b)
+	return rru
+}
+
+// SetNillableOnlineEnabled sets the "online_enabled" field if the given value is not nil.
+func (rru *ReservationRuleUpdate) SetNillableOnlineEnabled(b *bool) *ReservationRuleUpdate {
+	if b != nil {
+		rru.SetOnlineEnabled(*b)
+	}
+	return rru
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (rru *ReservationRuleUpdate) SetSlotMinutes(i int) *ReservationRuleUpdate {
+	rru.mutation.ResetSlotMinutes()
+	rru.mutation.SetSlotMinutes(i)
+	return rru
+}
+
+// SetNillableSlotMinutes sets the "slot_minutes" field if the given value is not nil.
+func (rru *ReservationRuleUpdate) SetNillableSlotMinutes(i *int) *ReservationRuleUpdate {
+	if i != nil {
+		rru.SetSlotMinutes(*i)
+	}
+	return rru
+}
+
+// AddSlotMinutes adds i to the "slot_minutes" field.
+func (rru *ReservationRuleUpdate) AddSlotMinutes(i int) *ReservationRuleUpdate {
+	rru.mutation.AddSlotMinutes(i)
+	return rru
+}
+
+// SetDiningMinutes sets the "dining_minutes" field.
+func (rru *ReservationRuleUpdate) SetDiningMinutes(i int) *ReservationRuleUpdate {
+	rru.mutation.ResetDiningMinutes()
+	rru.mutation.SetDiningMinutes(i)
+	return rru
+}
+
+// SetNillableDiningMinutes sets the "dining_minutes" field if the given value is not nil.
+func (rru *ReservationRuleUpdate) SetNillableDiningMinutes(i *int) *ReservationRuleUpdate {
+	if i != nil {
+		rru.SetDiningMinutes(*i)
+	}
+	return rru
+}
+
+// AddDiningMinutes adds i to the "dining_minutes" field.
+func (rru *ReservationRuleUpdate) AddDiningMinutes(i int) *ReservationRuleUpdate {
+	rru.mutation.AddDiningMinutes(i)
+	return rru
+}
+
+// SetMinAdvanceMinutes sets the "min_advance_minutes" field.
+func (rru *ReservationRuleUpdate) SetMinAdvanceMinutes(i int) *ReservationRuleUpdate {
+	rru.mutation.ResetMinAdvanceMinutes()
+	rru.mutation.SetMinAdvanceMinutes(i)
+	return rru
+}
+
+// SetNillableMinAdvanceMinutes sets the "min_advance_minutes" field if the given value is not nil.
+func (rru *ReservationRuleUpdate) SetNillableMinAdvanceMinutes(i *int) *ReservationRuleUpdate {
+	if i != nil {
+		rru.SetMinAdvanceMinutes(*i)
+	}
+	return rru
+}
+
+// AddMinAdvanceMinutes adds i to the "min_advance_minutes" field.
+func (rru *ReservationRuleUpdate) AddMinAdvanceMinutes(i int) *ReservationRuleUpdate {
+	rru.mutation.AddMinAdvanceMinutes(i)
+	return rru
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (rru *ReservationRuleUpdate) SetMaxAdvanceDays(i int) *ReservationRuleUpdate {
+	rru.mutation.ResetMaxAdvanceDays()
+	rru.mutation.SetMaxAdvanceDays(i)
+	return rru
+}
+
+// SetNillableMaxAdvanceDays sets the "max_advance_days" field if the given value is not nil.
+func (rru *ReservationRuleUpdate) SetNillableMaxAdvanceDays(i *int) *ReservationRuleUpdate {
+	if i != nil {
+		rru.SetMaxAdvanceDays(*i)
+	}
+	return rru
+}
+
+// AddMaxAdvanceDays adds i to the "max_advance_days" field.
+func (rru *ReservationRuleUpdate) AddMaxAdvanceDays(i int) *ReservationRuleUpdate {
+	rru.mutation.AddMaxAdvanceDays(i)
+	return rru
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (rru *ReservationRuleUpdate) SetMaxPartySize(i int) *ReservationRuleUpdate {
+	rru.mutation.ResetMaxPartySize()
+	rru.mutation.SetMaxPartySize(i)
+	return rru
+}
+
+// SetNillableMaxPartySize sets the "max_party_size" field if the given value is not nil.
+func (rru *ReservationRuleUpdate) SetNillableMaxPartySize(i *int) *ReservationRuleUpdate {
+	if i != nil {
+		rru.SetMaxPartySize(*i)
+	}
+	return rru
+}
+
+// AddMaxPartySize adds i to the "max_party_size" field.
+func (rru *ReservationRuleUpdate) AddMaxPartySize(i int) *ReservationRuleUpdate {
+	rru.mutation.AddMaxPartySize(i)
+	return rru
+}
+
+// SetDepositPerGuest sets the "deposit_per_guest" field.
+func (rru *ReservationRuleUpdate) SetDepositPerGuest(d decimal.Decimal) *ReservationRuleUpdate {
+	rru.mutation.SetDepositPerGuest(d)
+	return rru
+}
+
+// SetNillableDepositPerGuest sets the "deposit_per_guest" field if the given value is not nil.
+func (rru *ReservationRuleUpdate) SetNillableDepositPerGuest(d *decimal.Decimal) *ReservationRuleUpdate {
+	if d != nil {
+		rru.SetDepositPerGuest(*d)
+	}
+	return rru
+}
+
+// Mutation returns the ReservationRuleMutation object of the builder.
+func (rru *ReservationRuleUpdate) Mutation() *ReservationRuleMutation {
+	return rru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *ReservationRuleUpdate) Save(ctx context.Context) (int, error) {
+	rru.defaults()
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *ReservationRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *ReservationRuleUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *ReservationRuleUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rru *ReservationRuleUpdate) defaults() {
+	if _, ok := rru.mutation.UpdatedAt(); !ok {
+		v := reservationrule.UpdateDefaultUpdatedAt()
+		rru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rru *ReservationRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReservationRuleUpdate {
+	rru.modifiers = append(rru.modifiers, modifiers...)
+	return rru
+}
+
+func (rru *ReservationRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reservationrule.Table, reservationrule.Columns, sqlgraph.NewFieldSpec(reservationrule.FieldID, field.TypeUUID))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.UpdatedAt(); ok {
+		_spec.SetField(reservationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rru.mutation.OnlineEnabled(); ok {
+		_spec.SetField(reservationrule.FieldOnlineEnabled, field.TypeBool, value)
+	}
+	if value, ok := rru.mutation.SlotMinutes(); ok {
+		_spec.SetField(reservationrule.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedSlotMinutes(); ok {
+		_spec.AddField(reservationrule.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.DiningMinutes(); ok {
+		_spec.SetField(reservationrule.FieldDiningMinutes, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedDiningMinutes(); ok {
+		_spec.AddField(reservationrule.FieldDiningMinutes, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.MinAdvanceMinutes(); ok {
+		_spec.SetField(reservationrule.FieldMinAdvanceMinutes, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedMinAdvanceMinutes(); ok {
+		_spec.AddField(reservationrule.FieldMinAdvanceMinutes, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.MaxAdvanceDays(); ok {
+		_spec.SetField(reservationrule.FieldMaxAdvanceDays, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedMaxAdvanceDays(); ok {
+		_spec.AddField(reservationrule.FieldMaxAdvanceDays, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.MaxPartySize(); ok {
+		_spec.SetField(reservationrule.FieldMaxPartySize, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedMaxPartySize(); ok {
+		_spec.AddField(reservationrule.FieldMaxPartySize, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.DepositPerGuest(); ok {
+		_spec.SetField(reservationrule.FieldDepositPerGuest, field.TypeOther, value)
+	}
+	_spec.AddModifiers(rru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// ReservationRuleUpdateOne is the builder for updating a single ReservationRule entity.
+type ReservationRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReservationRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rruo *ReservationRuleUpdateOne) SetUpdatedAt(t time.Time) *ReservationRuleUpdateOne {
+	rruo.mutation.SetUpdatedAt(t)
+	return rruo
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (rruo *ReservationRuleUpdateOne) SetOnlineEnabled(b bool) *ReservationRuleUpdateOne {
+	rruo.mutation.SetOnlineEnabled(b)
+	return rruo
+}
+
+// SetNillableOnlineEnabled sets the "online_enabled" field if the given value is not nil.
+func (rruo *ReservationRuleUpdateOne) SetNillableOnlineEnabled(b *bool) *ReservationRuleUpdateOne {
+	if b != nil {
+		rruo.SetOnlineEnabled(*b)
+	}
+	return rruo
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (rruo *ReservationRuleUpdateOne) SetSlotMinutes(i int) *ReservationRuleUpdateOne {
+	rruo.mutation.ResetSlotMinutes()
+	rruo.mutation.SetSlotMinutes(i)
+	return rruo
+}
+
+// SetNillableSlotMinutes sets the "slot_minutes" field if the given value is not nil.
+func (rruo *ReservationRuleUpdateOne) SetNillableSlotMinutes(i *int) *ReservationRuleUpdateOne {
+	if i != nil {
+		rruo.SetSlotMinutes(*i)
+	}
+	return rruo
+}
+
+// AddSlotMinutes adds i to the "slot_minutes" field.
+func (rruo *ReservationRuleUpdateOne) AddSlotMinutes(i int) *ReservationRuleUpdateOne {
+	rruo.mutation.AddSlotMinutes(i)
+	return rruo
+}
+
+// SetDiningMinutes sets the "dining_minutes" field.
+func (rruo *ReservationRuleUpdateOne) SetDiningMinutes(i int) *ReservationRuleUpdateOne {
+	rruo.mutation.ResetDiningMinutes()
+	rruo.mutation.SetDiningMinutes(i)
+	return rruo
+}
+
+// SetNillableDiningMinutes sets the "dining_minutes" field if the given value is not nil.
+func (rruo *ReservationRuleUpdateOne) SetNillableDiningMinutes(i *int) *ReservationRuleUpdateOne {
+	if i != nil {
+		rruo.SetDiningMinutes(*i)
+	}
+	return rruo
+}
+
+// AddDiningMinutes adds i to the "dining_minutes" field.
+func (rruo *ReservationRuleUpdateOne) AddDiningMinutes(i int) *ReservationRuleUpdateOne {
+	rruo.mutation.AddDiningMinutes(i)
+	return rruo
+}
+
+// SetMinAdvanceMinutes sets the "min_advance_minutes" field.
+func (rruo *ReservationRuleUpdateOne) SetMinAdvanceMinutes(i int) *ReservationRuleUpdateOne {
+	rruo.mutation.ResetMinAdvanceMinutes()
+	rruo.mutation.SetMinAdvanceMinutes(i)
+	return rruo
+}
+
+// SetNillableMinAdvanceMinutes sets the "min_advance_minutes" field if the given value is not nil.
+func (rruo *ReservationRuleUpdateOne) SetNillableMinAdvanceMinutes(i *int) *ReservationRuleUpdateOne {
+	if i != nil {
+		rruo.SetMinAdvanceMinutes(*i)
+	}
+	return rruo
+}
+
+// AddMinAdvanceMinutes adds i to the "min_advance_minutes" field.
+func (rruo *ReservationRuleUpdateOne) AddMinAdvanceMinutes(i int) *ReservationRuleUpdateOne {
+	rruo.mutation.AddMinAdvanceMinutes(i)
+	return rruo
+}
+
+// SetMaxAdvanceDays sets the "max_advance_days" field.
+func (rruo *ReservationRuleUpdateOne) SetMaxAdvanceDays(i int) *ReservationRuleUpdateOne {
+	rruo.mutation.ResetMaxAdvanceDays()
+	rruo.mutation.SetMaxAdvanceDays(i)
+	return rruo
+}
+
+// SetNillableMaxAdvanceDays sets the "max_advance_days" field if the given value is not nil.
+func (rruo *ReservationRuleUpdateOne) SetNillableMaxAdvanceDays(i *int) *ReservationRuleUpdateOne {
+	if i != nil {
+		rruo.SetMaxAdvanceDays(*i)
+	}
+	return rruo
+}
+
+// AddMaxAdvanceDays adds i to the "max_advance_days" field.
+func (rruo *ReservationRuleUpdateOne) AddMaxAdvanceDays(i int) *ReservationRuleUpdateOne {
+	rruo.mutation.AddMaxAdvanceDays(i)
+	return rruo
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (rruo *ReservationRuleUpdateOne) SetMaxPartySize(i int) *ReservationRuleUpdateOne {
+	rruo.mutation.ResetMaxPartySize()
+	rruo.mutation.SetMaxPartySize(i)
+	return rruo
+}
+
+// SetNillableMaxPartySize sets the "max_party_size" field if the given value is not nil.
+func (rruo *ReservationRuleUpdateOne) SetNillableMaxPartySize(i *int) *ReservationRuleUpdateOne {
+	if i != nil {
+		rruo.SetMaxPartySize(*i)
+	}
+	return rruo
+}
+
+// AddMaxPartySize adds i to the "max_party_size" field.
+func (rruo *ReservationRuleUpdateOne) AddMaxPartySize(i int) *ReservationRuleUpdateOne {
+	rruo.mutation.AddMaxPartySize(i)
+	return rruo
+}
+
+// SetDepositPerGuest sets the "deposit_per_guest" field.
+func (rruo *ReservationRuleUpdateOne) SetDepositPerGuest(d decimal.Decimal) *ReservationRuleUpdateOne {
+	rruo.mutation.SetDepositPerGuest(d)
+	return rruo
+}
+
+// SetNillableDepositPerGuest sets the "deposit_per_guest" field if the given value is not nil.
+func (rruo *ReservationRuleUpdateOne) SetNillableDepositPerGuest(d *decimal.Decimal) *ReservationRuleUpdateOne {
+	if d != nil {
+		rruo.SetDepositPerGuest(*d)
+	}
+	return rruo
+}
+
+// Mutation returns the ReservationRuleMutation object of the builder.
+func (rruo *ReservationRuleUpdateOne) Mutation() *ReservationRuleMutation {
+	return rruo.mutation
+}
+
+// Where appends a list predicates to the ReservationRuleUpdate builder.
+func (rruo *ReservationRuleUpdateOne) Where(ps ...predicate.ReservationRule) *ReservationRuleUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *ReservationRuleUpdateOne) Select(field string, fields ...string) *ReservationRuleUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated ReservationRule entity.
+func (rruo *ReservationRuleUpdateOne) Save(ctx context.Context) (*ReservationRule, error) {
+	rruo.defaults()
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *ReservationRuleUpdateOne) SaveX(ctx context.Context) *ReservationRule {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *ReservationRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *ReservationRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rruo *ReservationRuleUpdateOne) defaults() {
+	if _, ok := rruo.mutation.UpdatedAt(); !ok {
+		v := reservationrule.UpdateDefaultUpdatedAt()
+		rruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rruo *ReservationRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReservationRuleUpdateOne {
+	rruo.modifiers = append(rruo.modifiers, modifiers...)
+	return rruo
+}
+
+func (rruo *ReservationRuleUpdateOne) sqlSave(ctx context.Context) (_node *ReservationRule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reservationrule.Table, reservationrule.Columns, sqlgraph.NewFieldSpec(reservationrule.FieldID, field.TypeUUID))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReservationRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reservationrule.FieldID)
+		for _, f := range fields {
+			if !reservationrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reservationrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(reservationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rruo.mutation.OnlineEnabled(); ok {
+		_spec.SetField(reservationrule.FieldOnlineEnabled, field.TypeBool, value)
+	}
+	if value, ok := rruo.mutation.SlotMinutes(); ok {
+		_spec.SetField(reservationrule.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedSlotMinutes(); ok {
+		_spec.AddField(reservationrule.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.DiningMinutes(); ok {
+		_spec.SetField(reservationrule.FieldDiningMinutes, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedDiningMinutes(); ok {
+		_spec.AddField(reservationrule.FieldDiningMinutes, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.MinAdvanceMinutes(); ok {
+		_spec.SetField(reservationrule.FieldMinAdvanceMinutes, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedMinAdvanceMinutes(); ok {
+		_spec.AddField(reservationrule.FieldMinAdvanceMinutes, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.MaxAdvanceDays(); ok {
+		_spec.SetField(reservationrule.FieldMaxAdvanceDays, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedMaxAdvanceDays(); ok {
+		_spec.AddField(reservationrule.FieldMaxAdvanceDays, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.MaxPartySize(); ok {
+		_spec.SetField(reservationrule.FieldMaxPartySize, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedMaxPartySize(); ok {
+		_spec.AddField(reservationrule.FieldMaxPartySize, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.DepositPerGuest(); ok {
+		_spec.SetField(reservationrule.FieldDepositPerGuest, field.TypeOther, value)
+	}
+	_spec.AddModifiers(rruo.modifiers...)
+	_node = &ReservationRule{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
@@ -7,15 +7,32 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/additionalfee"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/adminuser"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/backenduser"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/businessconfig"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/category"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupon"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupontemplate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/customer"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryorder"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryproductmapping"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliverystore"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/department"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/device"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/diningtable"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/kitchenticket"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/member"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccounttransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointstransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/membertier"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menu"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menuitem"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/menuversion"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchant"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchantbusinesstype"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/merchantrenewal"
@@ -24,6 +41,12 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/paymentaccount"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/paymentmethod"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/permission"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickupcall"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickuprule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorder"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorderrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattr"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattritem"
@@ -32,15 +55,21 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productspecrelation"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/producttag"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productunit"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/productversion"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/profitdistributionbill"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/profitdistributionrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/promotion"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/receiptrequest"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/refundorder"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/refundorderproduct"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/remark"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservation"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservationrule"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/role"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/rolemenu"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/rolepermission"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/routermenu"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/scanorderrule"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/schema"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealdetail"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealgroup"
@@ -50,6 +79,8 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/ent/storeuser"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/taxfee"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/userrole"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistrule"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistticket"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -388,33 +419,381 @@ func init() {
 		}
 	}()
 	// categoryDescStoreID is the schema descriptor for store_id field.
-	categoryDescStoreID := categoryFields[2].Descriptor()
+	categoryDescStoreID := categoryFields[3].Descriptor()
 	// category.DefaultStoreID holds the default value on creation for the store_id field.
 	category.DefaultStoreID = categoryDescStoreID.Default.(func() uuid.UUID)
 	// categoryDescParentID is the schema descriptor for parent_id field.
-	categoryDescParentID := categoryFields[3].Descriptor()
+	categoryDescParentID := categoryFields[4].Descriptor()
 	// category.DefaultParentID holds the default value on creation for the parent_id field.
 	category.DefaultParentID = categoryDescParentID.Default.(func() uuid.UUID)
 	// categoryDescInheritTaxRate is the schema descriptor for inherit_tax_rate field.
-	categoryDescInheritTaxRate := categoryFields[4].Descriptor()
+	categoryDescInheritTaxRate := categoryFields[5].Descriptor()
 	// category.DefaultInheritTaxRate holds the default value on creation for the inherit_tax_rate field.
 	category.DefaultInheritTaxRate = categoryDescInheritTaxRate.Default.(bool)
 	// categoryDescInheritStall is the schema descriptor for inherit_stall field.
-	categoryDescInheritStall := categoryFields[6].Descriptor()
+	categoryDescInheritStall := categoryFields[7].Descriptor()
 	// category.DefaultInheritStall holds the default value on creation for the inherit_stall field.
 	category.DefaultInheritStall = categoryDescInheritStall.Default.(bool)
 	// categoryDescProductCount is the schema descriptor for product_count field.
-	categoryDescProductCount := categoryFields[8].Descriptor()
+	categoryDescProductCount := categoryFields[9].Descriptor()
 	// category.DefaultProductCount holds the default value on creation for the product_count field.
 	category.DefaultProductCount = categoryDescProductCount.Default.(int)
 	// categoryDescSortOrder is the schema descriptor for sort_order field.
-	categoryDescSortOrder := categoryFields[9].Descriptor()
+	categoryDescSortOrder := categoryFields[10].Descriptor()
 	// category.DefaultSortOrder holds the default value on creation for the sort_order field.
 	category.DefaultSortOrder = categoryDescSortOrder.Default.(int)
 	// categoryDescID is the schema descriptor for id field.
 	categoryDescID := categoryMixinFields0[0].Descriptor()
 	// category.DefaultID holds the default value on creation for the id field.
 	category.DefaultID = categoryDescID.Default.(func() uuid.UUID)
+	couponMixin := schema.Coupon{}.Mixin()
+	couponMixinFields0 := couponMixin[0].Fields()
+	_ = couponMixinFields0
+	couponMixinFields1 := couponMixin[1].Fields()
+	_ = couponMixinFields1
+	couponFields := schema.Coupon{}.Fields()
+	_ = couponFields
+	// couponDescCreatedAt is the schema descriptor for created_at field.
+	couponDescCreatedAt := couponMixinFields1[0].Descriptor()
+	// coupon.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coupon.DefaultCreatedAt = couponDescCreatedAt.Default.(func() time.Time)
+	// couponDescUpdatedAt is the schema descriptor for updated_at field.
+	couponDescUpdatedAt := couponMixinFields1[1].Descriptor()
+	// coupon.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coupon.DefaultUpdatedAt = couponDescUpdatedAt.Default.(func() time.Time)
+	// coupon.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coupon.UpdateDefaultUpdatedAt = couponDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// couponDescTemplateID is the schema descriptor for template_id field.
+	couponDescTemplateID := couponFields[1].Descriptor()
+	// coupon.DefaultTemplateID holds the default value on creation for the template_id field.
+	coupon.DefaultTemplateID = couponDescTemplateID.Default.(func() uuid.UUID)
+	// couponDescProvider is the schema descriptor for provider field.
+	couponDescProvider := couponFields[3].Descriptor()
+	// coupon.DefaultProvider holds the default value on creation for the provider field.
+	coupon.DefaultProvider = couponDescProvider.Default.(string)
+	// coupon.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	coupon.ProviderValidator = couponDescProvider.Validators[0].(func(string) error)
+	// couponDescCode is the schema descriptor for code field.
+	couponDescCode := couponFields[4].Descriptor()
+	// coupon.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	coupon.CodeValidator = func() func(string) error {
+		validators := couponDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// couponDescBatchNo is the schema descriptor for batch_no field.
+	couponDescBatchNo := couponFields[5].Descriptor()
+	// coupon.DefaultBatchNo holds the default value on creation for the batch_no field.
+	coupon.DefaultBatchNo = couponDescBatchNo.Default.(string)
+	// coupon.BatchNoValidator is a validator for the "batch_no" field. It is called by the builders before save.
+	coupon.BatchNoValidator = couponDescBatchNo.Validators[0].(func(string) error)
+	// couponDescName is the schema descriptor for name field.
+	couponDescName := couponFields[6].Descriptor()
+	// coupon.DefaultName holds the default value on creation for the name field.
+	coupon.DefaultName = couponDescName.Default.(string)
+	// coupon.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	coupon.NameValidator = couponDescName.Validators[0].(func(string) error)
+	// couponDescUsedStoreID is the schema descriptor for used_store_id field.
+	couponDescUsedStoreID := couponFields[12].Descriptor()
+	// coupon.DefaultUsedStoreID holds the default value on creation for the used_store_id field.
+	coupon.DefaultUsedStoreID = couponDescUsedStoreID.Default.(func() uuid.UUID)
+	// couponDescOrderID is the schema descriptor for order_id field.
+	couponDescOrderID := couponFields[13].Descriptor()
+	// coupon.DefaultOrderID holds the default value on creation for the order_id field.
+	coupon.DefaultOrderID = couponDescOrderID.Default.(func() uuid.UUID)
+	// couponDescOrderNo is the schema descriptor for order_no field.
+	couponDescOrderNo := couponFields[14].Descriptor()
+	// coupon.DefaultOrderNo holds the default value on creation for the order_no field.
+	coupon.DefaultOrderNo = couponDescOrderNo.Default.(string)
+	// coupon.OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	coupon.OrderNoValidator = couponDescOrderNo.Validators[0].(func(string) error)
+	// couponDescID is the schema descriptor for id field.
+	couponDescID := couponMixinFields0[0].Descriptor()
+	// coupon.DefaultID holds the default value on creation for the id field.
+	coupon.DefaultID = couponDescID.Default.(func() uuid.UUID)
+	coupontemplateMixin := schema.CouponTemplate{}.Mixin()
+	coupontemplateMixinHooks2 := coupontemplateMixin[2].Hooks()
+	coupontemplate.Hooks[0] = coupontemplateMixinHooks2[0]
+	coupontemplateMixinInters2 := coupontemplateMixin[2].Interceptors()
+	coupontemplate.Interceptors[0] = coupontemplateMixinInters2[0]
+	coupontemplateMixinFields0 := coupontemplateMixin[0].Fields()
+	_ = coupontemplateMixinFields0
+	coupontemplateMixinFields1 := coupontemplateMixin[1].Fields()
+	_ = coupontemplateMixinFields1
+	coupontemplateMixinFields2 := coupontemplateMixin[2].Fields()
+	_ = coupontemplateMixinFields2
+	coupontemplateFields := schema.CouponTemplate{}.Fields()
+	_ = coupontemplateFields
+	// coupontemplateDescCreatedAt is the schema descriptor for created_at field.
+	coupontemplateDescCreatedAt := coupontemplateMixinFields1[0].Descriptor()
+	// coupontemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coupontemplate.DefaultCreatedAt = coupontemplateDescCreatedAt.Default.(func() time.Time)
+	// coupontemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	coupontemplateDescUpdatedAt := coupontemplateMixinFields1[1].Descriptor()
+	// coupontemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coupontemplate.DefaultUpdatedAt = coupontemplateDescUpdatedAt.Default.(func() time.Time)
+	// coupontemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coupontemplate.UpdateDefaultUpdatedAt = coupontemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// coupontemplateDescDeletedAt is the schema descriptor for deleted_at field.
+	coupontemplateDescDeletedAt := coupontemplateMixinFields2[0].Descriptor()
+	// coupontemplate.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	coupontemplate.DefaultDeletedAt = coupontemplateDescDeletedAt.Default.(int64)
+	// coupontemplateDescStoreID is the schema descriptor for store_id field.
+	coupontemplateDescStoreID := coupontemplateFields[1].Descriptor()
+	// coupontemplate.DefaultStoreID holds the default value on creation for the store_id field.
+	coupontemplate.DefaultStoreID = coupontemplateDescStoreID.Default.(func() uuid.UUID)
+	// coupontemplateDescName is the schema descriptor for name field.
+	coupontemplateDescName := coupontemplateFields[2].Descriptor()
+	// coupontemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	coupontemplate.NameValidator = func() func(string) error {
+		validators := coupontemplateDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// coupontemplateDescProductID is the schema descriptor for product_id field.
+	coupontemplateDescProductID := coupontemplateFields[6].Descriptor()
+	// coupontemplate.DefaultProductID holds the default value on creation for the product_id field.
+	coupontemplate.DefaultProductID = coupontemplateDescProductID.Default.(func() uuid.UUID)
+	// coupontemplateDescValidDays is the schema descriptor for valid_days field.
+	coupontemplateDescValidDays := coupontemplateFields[12].Descriptor()
+	// coupontemplate.DefaultValidDays holds the default value on creation for the valid_days field.
+	coupontemplate.DefaultValidDays = coupontemplateDescValidDays.Default.(int)
+	// coupontemplateDescTotalQty is the schema descriptor for total_qty field.
+	coupontemplateDescTotalQty := coupontemplateFields[13].Descriptor()
+	// coupontemplate.DefaultTotalQty holds the default value on creation for the total_qty field.
+	coupontemplate.DefaultTotalQty = coupontemplateDescTotalQty.Default.(int)
+	// coupontemplateDescIssuedQty is the schema descriptor for issued_qty field.
+	coupontemplateDescIssuedQty := coupontemplateFields[14].Descriptor()
+	// coupontemplate.DefaultIssuedQty holds the default value on creation for the issued_qty field.
+	coupontemplate.DefaultIssuedQty = coupontemplateDescIssuedQty.Default.(int)
+	// coupontemplateDescDescription is the schema descriptor for description field.
+	coupontemplateDescDescription := coupontemplateFields[15].Descriptor()
+	// coupontemplate.DefaultDescription holds the default value on creation for the description field.
+	coupontemplate.DefaultDescription = coupontemplateDescDescription.Default.(string)
+	// coupontemplate.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	coupontemplate.DescriptionValidator = coupontemplateDescDescription.Validators[0].(func(string) error)
+	// coupontemplateDescEnabled is the schema descriptor for enabled field.
+	coupontemplateDescEnabled := coupontemplateFields[16].Descriptor()
+	// coupontemplate.DefaultEnabled holds the default value on creation for the enabled field.
+	coupontemplate.DefaultEnabled = coupontemplateDescEnabled.Default.(bool)
+	// coupontemplateDescID is the schema descriptor for id field.
+	coupontemplateDescID := coupontemplateMixinFields0[0].Descriptor()
+	// coupontemplate.DefaultID holds the default value on creation for the id field.
+	coupontemplate.DefaultID = coupontemplateDescID.Default.(func() uuid.UUID)
+	customerMixin := schema.Customer{}.Mixin()
+	customerMixinFields0 := customerMixin[0].Fields()
+	_ = customerMixinFields0
+	customerMixinFields1 := customerMixin[1].Fields()
+	_ = customerMixinFields1
+	customerFields := schema.Customer{}.Fields()
+	_ = customerFields
+	// customerDescCreatedAt is the schema descriptor for created_at field.
+	customerDescCreatedAt := customerMixinFields1[0].Descriptor()
+	// customer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	customer.DefaultCreatedAt = customerDescCreatedAt.Default.(func() time.Time)
+	// customerDescUpdatedAt is the schema descriptor for updated_at field.
+	customerDescUpdatedAt := customerMixinFields1[1].Descriptor()
+	// customer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	customer.DefaultUpdatedAt = customerDescUpdatedAt.Default.(func() time.Time)
+	// customer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	customer.UpdateDefaultUpdatedAt = customerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// customerDescOpenid is the schema descriptor for openid field.
+	customerDescOpenid := customerFields[0].Descriptor()
+	// customer.OpenidValidator is a validator for the "openid" field. It is called by the builders before save.
+	customer.OpenidValidator = func() func(string) error {
+		validators := customerDescOpenid.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(openid string) error {
+			for _, fn := range fns {
+				if err := fn(openid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// customerDescUnionid is the schema descriptor for unionid field.
+	customerDescUnionid := customerFields[1].Descriptor()
+	// customer.DefaultUnionid holds the default value on creation for the unionid field.
+	customer.DefaultUnionid = customerDescUnionid.Default.(string)
+	// customer.UnionidValidator is a validator for the "unionid" field. It is called by the builders before save.
+	customer.UnionidValidator = customerDescUnionid.Validators[0].(func(string) error)
+	// customerDescNickname is the schema descriptor for nickname field.
+	customerDescNickname := customerFields[2].Descriptor()
+	// customer.DefaultNickname holds the default value on creation for the nickname field.
+	customer.DefaultNickname = customerDescNickname.Default.(string)
+	// customer.NicknameValidator is a validator for the "nickname" field. It is called by the builders before save.
+	customer.NicknameValidator = customerDescNickname.Validators[0].(func(string) error)
+	// customerDescAvatar is the schema descriptor for avatar field.
+	customerDescAvatar := customerFields[3].Descriptor()
+	// customer.DefaultAvatar holds the default value on creation for the avatar field.
+	customer.DefaultAvatar = customerDescAvatar.Default.(string)
+	// customer.AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
+	customer.AvatarValidator = customerDescAvatar.Validators[0].(func(string) error)
+	// customerDescPhone is the schema descriptor for phone field.
+	customerDescPhone := customerFields[4].Descriptor()
+	// customer.DefaultPhone holds the default value on creation for the phone field.
+	customer.DefaultPhone = customerDescPhone.Default.(string)
+	// customer.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	customer.PhoneValidator = customerDescPhone.Validators[0].(func(string) error)
+	// customerDescID is the schema descriptor for id field.
+	customerDescID := customerMixinFields0[0].Descriptor()
+	// customer.DefaultID holds the default value on creation for the id field.
+	customer.DefaultID = customerDescID.Default.(func() uuid.UUID)
+	deliveryorderMixin := schema.DeliveryOrder{}.Mixin()
+	deliveryorderMixinFields0 := deliveryorderMixin[0].Fields()
+	_ = deliveryorderMixinFields0
+	deliveryorderMixinFields1 := deliveryorderMixin[1].Fields()
+	_ = deliveryorderMixinFields1
+	deliveryorderFields := schema.DeliveryOrder{}.Fields()
+	_ = deliveryorderFields
+	// deliveryorderDescCreatedAt is the schema descriptor for created_at field.
+	deliveryorderDescCreatedAt := deliveryorderMixinFields1[0].Descriptor()
+	// deliveryorder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deliveryorder.DefaultCreatedAt = deliveryorderDescCreatedAt.Default.(func() time.Time)
+	// deliveryorderDescUpdatedAt is the schema descriptor for updated_at field.
+	deliveryorderDescUpdatedAt := deliveryorderMixinFields1[1].Descriptor()
+	// deliveryorder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deliveryorder.DefaultUpdatedAt = deliveryorderDescUpdatedAt.Default.(func() time.Time)
+	// deliveryorder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deliveryorder.UpdateDefaultUpdatedAt = deliveryorderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deliveryorderDescPlatformOrderID is the schema descriptor for platform_order_id field.
+	deliveryorderDescPlatformOrderID := deliveryorderFields[3].Descriptor()
+	// deliveryorder.PlatformOrderIDValidator is a validator for the "platform_order_id" field. It is called by the builders before save.
+	deliveryorder.PlatformOrderIDValidator = deliveryorderDescPlatformOrderID.Validators[0].(func(string) error)
+	// deliveryorderDescShortCode is the schema descriptor for short_code field.
+	deliveryorderDescShortCode := deliveryorderFields[4].Descriptor()
+	// deliveryorder.DefaultShortCode holds the default value on creation for the short_code field.
+	deliveryorder.DefaultShortCode = deliveryorderDescShortCode.Default.(string)
+	// deliveryorder.ShortCodeValidator is a validator for the "short_code" field. It is called by the builders before save.
+	deliveryorder.ShortCodeValidator = deliveryorderDescShortCode.Validators[0].(func(string) error)
+	// deliveryorderDescOrderID is the schema descriptor for order_id field.
+	deliveryorderDescOrderID := deliveryorderFields[6].Descriptor()
+	// deliveryorder.DefaultOrderID holds the default value on creation for the order_id field.
+	deliveryorder.DefaultOrderID = deliveryorderDescOrderID.Default.(func() uuid.UUID)
+	// deliveryorderDescCustomerName is the schema descriptor for customer_name field.
+	deliveryorderDescCustomerName := deliveryorderFields[11].Descriptor()
+	// deliveryorder.DefaultCustomerName holds the default value on creation for the customer_name field.
+	deliveryorder.DefaultCustomerName = deliveryorderDescCustomerName.Default.(string)
+	// deliveryorder.CustomerNameValidator is a validator for the "customer_name" field. It is called by the builders before save.
+	deliveryorder.CustomerNameValidator = deliveryorderDescCustomerName.Validators[0].(func(string) error)
+	// deliveryorderDescCustomerPhone is the schema descriptor for customer_phone field.
+	deliveryorderDescCustomerPhone := deliveryorderFields[12].Descriptor()
+	// deliveryorder.DefaultCustomerPhone holds the default value on creation for the customer_phone field.
+	deliveryorder.DefaultCustomerPhone = deliveryorderDescCustomerPhone.Default.(string)
+	// deliveryorder.CustomerPhoneValidator is a validator for the "customer_phone" field. It is called by the builders before save.
+	deliveryorder.CustomerPhoneValidator = deliveryorderDescCustomerPhone.Validators[0].(func(string) error)
+	// deliveryorderDescAddress is the schema descriptor for address field.
+	deliveryorderDescAddress := deliveryorderFields[13].Descriptor()
+	// deliveryorder.DefaultAddress holds the default value on creation for the address field.
+	deliveryorder.DefaultAddress = deliveryorderDescAddress.Default.(string)
+	// deliveryorder.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	deliveryorder.AddressValidator = deliveryorderDescAddress.Validators[0].(func(string) error)
+	// deliveryorderDescRemark is the schema descriptor for remark field.
+	deliveryorderDescRemark := deliveryorderFields[14].Descriptor()
+	// deliveryorder.DefaultRemark holds the default value on creation for the remark field.
+	deliveryorder.DefaultRemark = deliveryorderDescRemark.Default.(string)
+	// deliveryorder.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	deliveryorder.RemarkValidator = deliveryorderDescRemark.Validators[0].(func(string) error)
+	// deliveryorderDescRejectReason is the schema descriptor for reject_reason field.
+	deliveryorderDescRejectReason := deliveryorderFields[15].Descriptor()
+	// deliveryorder.DefaultRejectReason holds the default value on creation for the reject_reason field.
+	deliveryorder.DefaultRejectReason = deliveryorderDescRejectReason.Default.(string)
+	// deliveryorder.RejectReasonValidator is a validator for the "reject_reason" field. It is called by the builders before save.
+	deliveryorder.RejectReasonValidator = deliveryorderDescRejectReason.Validators[0].(func(string) error)
+	// deliveryorderDescAutoAccepted is the schema descriptor for auto_accepted field.
+	deliveryorderDescAutoAccepted := deliveryorderFields[16].Descriptor()
+	// deliveryorder.DefaultAutoAccepted holds the default value on creation for the auto_accepted field.
+	deliveryorder.DefaultAutoAccepted = deliveryorderDescAutoAccepted.Default.(bool)
+	// deliveryorderDescID is the schema descriptor for id field.
+	deliveryorderDescID := deliveryorderMixinFields0[0].Descriptor()
+	// deliveryorder.DefaultID holds the default value on creation for the id field.
+	deliveryorder.DefaultID = deliveryorderDescID.Default.(func() uuid.UUID)
+	deliveryproductmappingMixin := schema.DeliveryProductMapping{}.Mixin()
+	deliveryproductmappingMixinFields0 := deliveryproductmappingMixin[0].Fields()
+	_ = deliveryproductmappingMixinFields0
+	deliveryproductmappingMixinFields1 := deliveryproductmappingMixin[1].Fields()
+	_ = deliveryproductmappingMixinFields1
+	deliveryproductmappingFields := schema.DeliveryProductMapping{}.Fields()
+	_ = deliveryproductmappingFields
+	// deliveryproductmappingDescCreatedAt is the schema descriptor for created_at field.
+	deliveryproductmappingDescCreatedAt := deliveryproductmappingMixinFields1[0].Descriptor()
+	// deliveryproductmapping.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deliveryproductmapping.DefaultCreatedAt = deliveryproductmappingDescCreatedAt.Default.(func() time.Time)
+	// deliveryproductmappingDescUpdatedAt is the schema descriptor for updated_at field.
+	deliveryproductmappingDescUpdatedAt := deliveryproductmappingMixinFields1[1].Descriptor()
+	// deliveryproductmapping.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deliveryproductmapping.DefaultUpdatedAt = deliveryproductmappingDescUpdatedAt.Default.(func() time.Time)
+	// deliveryproductmapping.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deliveryproductmapping.UpdateDefaultUpdatedAt = deliveryproductmappingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deliveryproductmappingDescPlatformSku is the schema descriptor for platform_sku field.
+	deliveryproductmappingDescPlatformSku := deliveryproductmappingFields[2].Descriptor()
+	// deliveryproductmapping.PlatformSkuValidator is a validator for the "platform_sku" field. It is called by the builders before save.
+	deliveryproductmapping.PlatformSkuValidator = deliveryproductmappingDescPlatformSku.Validators[0].(func(string) error)
+	// deliveryproductmappingDescSpecID is the schema descriptor for spec_id field.
+	deliveryproductmappingDescSpecID := deliveryproductmappingFields[4].Descriptor()
+	// deliveryproductmapping.DefaultSpecID holds the default value on creation for the spec_id field.
+	deliveryproductmapping.DefaultSpecID = deliveryproductmappingDescSpecID.Default.(func() uuid.UUID)
+	// deliveryproductmappingDescID is the schema descriptor for id field.
+	deliveryproductmappingDescID := deliveryproductmappingMixinFields0[0].Descriptor()
+	// deliveryproductmapping.DefaultID holds the default value on creation for the id field.
+	deliveryproductmapping.DefaultID = deliveryproductmappingDescID.Default.(func() uuid.UUID)
+	deliverystoreMixin := schema.DeliveryStore{}.Mixin()
+	deliverystoreMixinFields0 := deliverystoreMixin[0].Fields()
+	_ = deliverystoreMixinFields0
+	deliverystoreMixinFields1 := deliverystoreMixin[1].Fields()
+	_ = deliverystoreMixinFields1
+	deliverystoreFields := schema.DeliveryStore{}.Fields()
+	_ = deliverystoreFields
+	// deliverystoreDescCreatedAt is the schema descriptor for created_at field.
+	deliverystoreDescCreatedAt := deliverystoreMixinFields1[0].Descriptor()
+	// deliverystore.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deliverystore.DefaultCreatedAt = deliverystoreDescCreatedAt.Default.(func() time.Time)
+	// deliverystoreDescUpdatedAt is the schema descriptor for updated_at field.
+	deliverystoreDescUpdatedAt := deliverystoreMixinFields1[1].Descriptor()
+	// deliverystore.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deliverystore.DefaultUpdatedAt = deliverystoreDescUpdatedAt.Default.(func() time.Time)
+	// deliverystore.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deliverystore.UpdateDefaultUpdatedAt = deliverystoreDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deliverystoreDescPlatformStoreID is the schema descriptor for platform_store_id field.
+	deliverystoreDescPlatformStoreID := deliverystoreFields[3].Descriptor()
+	// deliverystore.PlatformStoreIDValidator is a validator for the "platform_store_id" field. It is called by the builders before save.
+	deliverystore.PlatformStoreIDValidator = deliverystoreDescPlatformStoreID.Validators[0].(func(string) error)
+	// deliverystoreDescEnabled is the schema descriptor for enabled field.
+	deliverystoreDescEnabled := deliverystoreFields[4].Descriptor()
+	// deliverystore.DefaultEnabled holds the default value on creation for the enabled field.
+	deliverystore.DefaultEnabled = deliverystoreDescEnabled.Default.(bool)
+	// deliverystoreDescAutoAccept is the schema descriptor for auto_accept field.
+	deliverystoreDescAutoAccept := deliverystoreFields[5].Descriptor()
+	// deliverystore.DefaultAutoAccept holds the default value on creation for the auto_accept field.
+	deliverystore.DefaultAutoAccept = deliverystoreDescAutoAccept.Default.(bool)
+	// deliverystoreDescID is the schema descriptor for id field.
+	deliverystoreDescID := deliverystoreMixinFields0[0].Descriptor()
+	// deliverystore.DefaultID holds the default value on creation for the id field.
+	deliverystore.DefaultID = deliverystoreDescID.Default.(func() uuid.UUID)
 	departmentMixin := schema.Department{}.Mixin()
 	departmentMixinHooks2 := departmentMixin[2].Hooks()
 	department.Hooks[0] = departmentMixinHooks2[0]
@@ -519,10 +898,533 @@ func init() {
 	deviceDescSortOrder := deviceFields[11].Descriptor()
 	// device.DefaultSortOrder holds the default value on creation for the sort_order field.
 	device.DefaultSortOrder = deviceDescSortOrder.Default.(int)
+	// deviceDescPrintLocale is the schema descriptor for print_locale field.
+	deviceDescPrintLocale := deviceFields[13].Descriptor()
+	// device.DefaultPrintLocale holds the default value on creation for the print_locale field.
+	device.DefaultPrintLocale = deviceDescPrintLocale.Default.(string)
+	// device.PrintLocaleValidator is a validator for the "print_locale" field. It is called by the builders before save.
+	device.PrintLocaleValidator = deviceDescPrintLocale.Validators[0].(func(string) error)
+	// deviceDescKioskToken is the schema descriptor for kiosk_token field.
+	deviceDescKioskToken := deviceFields[22].Descriptor()
+	// device.KioskTokenValidator is a validator for the "kiosk_token" field. It is called by the builders before save.
+	device.KioskTokenValidator = deviceDescKioskToken.Validators[0].(func(string) error)
 	// deviceDescID is the schema descriptor for id field.
 	deviceDescID := deviceMixinFields0[0].Descriptor()
 	// device.DefaultID holds the default value on creation for the id field.
 	device.DefaultID = deviceDescID.Default.(func() uuid.UUID)
+	diningtableMixin := schema.DiningTable{}.Mixin()
+	diningtableMixinHooks2 := diningtableMixin[2].Hooks()
+	diningtable.Hooks[0] = diningtableMixinHooks2[0]
+	diningtableMixinInters2 := diningtableMixin[2].Interceptors()
+	diningtable.Interceptors[0] = diningtableMixinInters2[0]
+	diningtableMixinFields0 := diningtableMixin[0].Fields()
+	_ = diningtableMixinFields0
+	diningtableMixinFields1 := diningtableMixin[1].Fields()
+	_ = diningtableMixinFields1
+	diningtableMixinFields2 := diningtableMixin[2].Fields()
+	_ = diningtableMixinFields2
+	diningtableFields := schema.DiningTable{}.Fields()
+	_ = diningtableFields
+	// diningtableDescCreatedAt is the schema descriptor for created_at field.
+	diningtableDescCreatedAt := diningtableMixinFields1[0].Descriptor()
+	// diningtable.DefaultCreatedAt holds the default value on creation for the created_at field.
+	diningtable.DefaultCreatedAt = diningtableDescCreatedAt.Default.(func() time.Time)
+	// diningtableDescUpdatedAt is the schema descriptor for updated_at field.
+	diningtableDescUpdatedAt := diningtableMixinFields1[1].Descriptor()
+	// diningtable.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	diningtable.DefaultUpdatedAt = diningtableDescUpdatedAt.Default.(func() time.Time)
+	// diningtable.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	diningtable.UpdateDefaultUpdatedAt = diningtableDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// diningtableDescDeletedAt is the schema descriptor for deleted_at field.
+	diningtableDescDeletedAt := diningtableMixinFields2[0].Descriptor()
+	// diningtable.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	diningtable.DefaultDeletedAt = diningtableDescDeletedAt.Default.(int64)
+	// diningtableDescName is the schema descriptor for name field.
+	diningtableDescName := diningtableFields[2].Descriptor()
+	// diningtable.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	diningtable.NameValidator = func() func(string) error {
+		validators := diningtableDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// diningtableDescSeats is the schema descriptor for seats field.
+	diningtableDescSeats := diningtableFields[3].Descriptor()
+	// diningtable.DefaultSeats holds the default value on creation for the seats field.
+	diningtable.DefaultSeats = diningtableDescSeats.Default.(int)
+	// diningtableDescQrToken is the schema descriptor for qr_token field.
+	diningtableDescQrToken := diningtableFields[4].Descriptor()
+	// diningtable.QrTokenValidator is a validator for the "qr_token" field. It is called by the builders before save.
+	diningtable.QrTokenValidator = func() func(string) error {
+		validators := diningtableDescQrToken.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(qr_token string) error {
+			for _, fn := range fns {
+				if err := fn(qr_token); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// diningtableDescEnabled is the schema descriptor for enabled field.
+	diningtableDescEnabled := diningtableFields[5].Descriptor()
+	// diningtable.DefaultEnabled holds the default value on creation for the enabled field.
+	diningtable.DefaultEnabled = diningtableDescEnabled.Default.(bool)
+	// diningtableDescSortOrder is the schema descriptor for sort_order field.
+	diningtableDescSortOrder := diningtableFields[6].Descriptor()
+	// diningtable.DefaultSortOrder holds the default value on creation for the sort_order field.
+	diningtable.DefaultSortOrder = diningtableDescSortOrder.Default.(int)
+	// diningtableDescID is the schema descriptor for id field.
+	diningtableDescID := diningtableMixinFields0[0].Descriptor()
+	// diningtable.DefaultID holds the default value on creation for the id field.
+	diningtable.DefaultID = diningtableDescID.Default.(func() uuid.UUID)
+	kitchenticketMixin := schema.KitchenTicket{}.Mixin()
+	kitchenticketMixinFields0 := kitchenticketMixin[0].Fields()
+	_ = kitchenticketMixinFields0
+	kitchenticketMixinFields1 := kitchenticketMixin[1].Fields()
+	_ = kitchenticketMixinFields1
+	kitchenticketFields := schema.KitchenTicket{}.Fields()
+	_ = kitchenticketFields
+	// kitchenticketDescCreatedAt is the schema descriptor for created_at field.
+	kitchenticketDescCreatedAt := kitchenticketMixinFields1[0].Descriptor()
+	// kitchenticket.DefaultCreatedAt holds the default value on creation for the created_at field.
+	kitchenticket.DefaultCreatedAt = kitchenticketDescCreatedAt.Default.(func() time.Time)
+	// kitchenticketDescUpdatedAt is the schema descriptor for updated_at field.
+	kitchenticketDescUpdatedAt := kitchenticketMixinFields1[1].Descriptor()
+	// kitchenticket.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	kitchenticket.DefaultUpdatedAt = kitchenticketDescUpdatedAt.Default.(func() time.Time)
+	// kitchenticket.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	kitchenticket.UpdateDefaultUpdatedAt = kitchenticketDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// kitchenticketDescOrderNo is the schema descriptor for order_no field.
+	kitchenticketDescOrderNo := kitchenticketFields[3].Descriptor()
+	// kitchenticket.DefaultOrderNo holds the default value on creation for the order_no field.
+	kitchenticket.DefaultOrderNo = kitchenticketDescOrderNo.Default.(string)
+	// kitchenticket.OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	kitchenticket.OrderNoValidator = kitchenticketDescOrderNo.Validators[0].(func(string) error)
+	// kitchenticketDescTableName is the schema descriptor for table_name field.
+	kitchenticketDescTableName := kitchenticketFields[5].Descriptor()
+	// kitchenticket.DefaultTableName holds the default value on creation for the table_name field.
+	kitchenticket.DefaultTableName = kitchenticketDescTableName.Default.(string)
+	// kitchenticket.TableNameValidator is a validator for the "table_name" field. It is called by the builders before save.
+	kitchenticket.TableNameValidator = kitchenticketDescTableName.Validators[0].(func(string) error)
+	// kitchenticketDescPickupNo is the schema descriptor for pickup_no field.
+	kitchenticketDescPickupNo := kitchenticketFields[6].Descriptor()
+	// kitchenticket.DefaultPickupNo holds the default value on creation for the pickup_no field.
+	kitchenticket.DefaultPickupNo = kitchenticketDescPickupNo.Default.(string)
+	// kitchenticket.PickupNoValidator is a validator for the "pickup_no" field. It is called by the builders before save.
+	kitchenticket.PickupNoValidator = kitchenticketDescPickupNo.Validators[0].(func(string) error)
+	// kitchenticketDescBatch is the schema descriptor for batch field.
+	kitchenticketDescBatch := kitchenticketFields[7].Descriptor()
+	// kitchenticket.DefaultBatch holds the default value on creation for the batch field.
+	kitchenticket.DefaultBatch = kitchenticketDescBatch.Default.(int)
+	// kitchenticketDescRemark is the schema descriptor for remark field.
+	kitchenticketDescRemark := kitchenticketFields[11].Descriptor()
+	// kitchenticket.DefaultRemark holds the default value on creation for the remark field.
+	kitchenticket.DefaultRemark = kitchenticketDescRemark.Default.(string)
+	// kitchenticket.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	kitchenticket.RemarkValidator = kitchenticketDescRemark.Validators[0].(func(string) error)
+	// kitchenticketDescID is the schema descriptor for id field.
+	kitchenticketDescID := kitchenticketMixinFields0[0].Descriptor()
+	// kitchenticket.DefaultID holds the default value on creation for the id field.
+	kitchenticket.DefaultID = kitchenticketDescID.Default.(func() uuid.UUID)
+	memberMixin := schema.Member{}.Mixin()
+	memberMixinHooks2 := memberMixin[2].Hooks()
+	member.Hooks[0] = memberMixinHooks2[0]
+	memberMixinInters2 := memberMixin[2].Interceptors()
+	member.Interceptors[0] = memberMixinInters2[0]
+	memberMixinFields0 := memberMixin[0].Fields()
+	_ = memberMixinFields0
+	memberMixinFields1 := memberMixin[1].Fields()
+	_ = memberMixinFields1
+	memberMixinFields2 := memberMixin[2].Fields()
+	_ = memberMixinFields2
+	memberFields := schema.Member{}.Fields()
+	_ = memberFields
+	// memberDescCreatedAt is the schema descriptor for created_at field.
+	memberDescCreatedAt := memberMixinFields1[0].Descriptor()
+	// member.DefaultCreatedAt holds the default value on creation for the created_at field.
+	member.DefaultCreatedAt = memberDescCreatedAt.Default.(func() time.Time)
+	// memberDescUpdatedAt is the schema descriptor for updated_at field.
+	memberDescUpdatedAt := memberMixinFields1[1].Descriptor()
+	// member.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	member.DefaultUpdatedAt = memberDescUpdatedAt.Default.(func() time.Time)
+	// member.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	member.UpdateDefaultUpdatedAt = memberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// memberDescDeletedAt is the schema descriptor for deleted_at field.
+	memberDescDeletedAt := memberMixinFields2[0].Descriptor()
+	// member.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	member.DefaultDeletedAt = memberDescDeletedAt.Default.(int64)
+	// memberDescTierID is the schema descriptor for tier_id field.
+	memberDescTierID := memberFields[1].Descriptor()
+	// member.DefaultTierID holds the default value on creation for the tier_id field.
+	member.DefaultTierID = memberDescTierID.Default.(func() uuid.UUID)
+	// memberDescCode is the schema descriptor for code field.
+	memberDescCode := memberFields[2].Descriptor()
+	// member.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	member.CodeValidator = func() func(string) error {
+		validators := memberDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// memberDescName is the schema descriptor for name field.
+	memberDescName := memberFields[3].Descriptor()
+	// member.DefaultName holds the default value on creation for the name field.
+	member.DefaultName = memberDescName.Default.(string)
+	// member.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	member.NameValidator = memberDescName.Validators[0].(func(string) error)
+	// memberDescPhone is the schema descriptor for phone field.
+	memberDescPhone := memberFields[4].Descriptor()
+	// member.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	member.PhoneValidator = func() func(string) error {
+		validators := memberDescPhone.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(phone string) error {
+			for _, fn := range fns {
+				if err := fn(phone); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// memberDescOpenid is the schema descriptor for openid field.
+	memberDescOpenid := memberFields[5].Descriptor()
+	// member.DefaultOpenid holds the default value on creation for the openid field.
+	member.DefaultOpenid = memberDescOpenid.Default.(string)
+	// member.OpenidValidator is a validator for the "openid" field. It is called by the builders before save.
+	member.OpenidValidator = memberDescOpenid.Validators[0].(func(string) error)
+	// memberDescEnabled is the schema descriptor for enabled field.
+	memberDescEnabled := memberFields[8].Descriptor()
+	// member.DefaultEnabled holds the default value on creation for the enabled field.
+	member.DefaultEnabled = memberDescEnabled.Default.(bool)
+	// memberDescRemark is the schema descriptor for remark field.
+	memberDescRemark := memberFields[9].Descriptor()
+	// member.DefaultRemark holds the default value on creation for the remark field.
+	member.DefaultRemark = memberDescRemark.Default.(string)
+	// member.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	member.RemarkValidator = memberDescRemark.Validators[0].(func(string) error)
+	// memberDescID is the schema descriptor for id field.
+	memberDescID := memberMixinFields0[0].Descriptor()
+	// member.DefaultID holds the default value on creation for the id field.
+	member.DefaultID = memberDescID.Default.(func() uuid.UUID)
+	memberaccountMixin := schema.MemberAccount{}.Mixin()
+	memberaccountMixinFields0 := memberaccountMixin[0].Fields()
+	_ = memberaccountMixinFields0
+	memberaccountMixinFields1 := memberaccountMixin[1].Fields()
+	_ = memberaccountMixinFields1
+	memberaccountFields := schema.MemberAccount{}.Fields()
+	_ = memberaccountFields
+	// memberaccountDescCreatedAt is the schema descriptor for created_at field.
+	memberaccountDescCreatedAt := memberaccountMixinFields1[0].Descriptor()
+	// memberaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	memberaccount.DefaultCreatedAt = memberaccountDescCreatedAt.Default.(func() time.Time)
+	// memberaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	memberaccountDescUpdatedAt := memberaccountMixinFields1[1].Descriptor()
+	// memberaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	memberaccount.DefaultUpdatedAt = memberaccountDescUpdatedAt.Default.(func() time.Time)
+	// memberaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	memberaccount.UpdateDefaultUpdatedAt = memberaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// memberaccountDescID is the schema descriptor for id field.
+	memberaccountDescID := memberaccountMixinFields0[0].Descriptor()
+	// memberaccount.DefaultID holds the default value on creation for the id field.
+	memberaccount.DefaultID = memberaccountDescID.Default.(func() uuid.UUID)
+	memberaccounttransactionMixin := schema.MemberAccountTransaction{}.Mixin()
+	memberaccounttransactionMixinFields0 := memberaccounttransactionMixin[0].Fields()
+	_ = memberaccounttransactionMixinFields0
+	memberaccounttransactionMixinFields1 := memberaccounttransactionMixin[1].Fields()
+	_ = memberaccounttransactionMixinFields1
+	memberaccounttransactionFields := schema.MemberAccountTransaction{}.Fields()
+	_ = memberaccounttransactionFields
+	// memberaccounttransactionDescCreatedAt is the schema descriptor for created_at field.
+	memberaccounttransactionDescCreatedAt := memberaccounttransactionMixinFields1[0].Descriptor()
+	// memberaccounttransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	memberaccounttransaction.DefaultCreatedAt = memberaccounttransactionDescCreatedAt.Default.(func() time.Time)
+	// memberaccounttransactionDescUpdatedAt is the schema descriptor for updated_at field.
+	memberaccounttransactionDescUpdatedAt := memberaccounttransactionMixinFields1[1].Descriptor()
+	// memberaccounttransaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	memberaccounttransaction.DefaultUpdatedAt = memberaccounttransactionDescUpdatedAt.Default.(func() time.Time)
+	// memberaccounttransaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	memberaccounttransaction.UpdateDefaultUpdatedAt = memberaccounttransactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// memberaccounttransactionDescStoreID is the schema descriptor for store_id field.
+	memberaccounttransactionDescStoreID := memberaccounttransactionFields[1].Descriptor()
+	// memberaccounttransaction.DefaultStoreID holds the default value on creation for the store_id field.
+	memberaccounttransaction.DefaultStoreID = memberaccounttransactionDescStoreID.Default.(func() uuid.UUID)
+	// memberaccounttransactionDescTransactionNo is the schema descriptor for transaction_no field.
+	memberaccounttransactionDescTransactionNo := memberaccounttransactionFields[4].Descriptor()
+	// memberaccounttransaction.TransactionNoValidator is a validator for the "transaction_no" field. It is called by the builders before save.
+	memberaccounttransaction.TransactionNoValidator = func() func(string) error {
+		validators := memberaccounttransactionDescTransactionNo.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(transaction_no string) error {
+			for _, fn := range fns {
+				if err := fn(transaction_no); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// memberaccounttransactionDescOrderID is the schema descriptor for order_id field.
+	memberaccounttransactionDescOrderID := memberaccounttransactionFields[10].Descriptor()
+	// memberaccounttransaction.DefaultOrderID holds the default value on creation for the order_id field.
+	memberaccounttransaction.DefaultOrderID = memberaccounttransactionDescOrderID.Default.(func() uuid.UUID)
+	// memberaccounttransactionDescOrderNo is the schema descriptor for order_no field.
+	memberaccounttransactionDescOrderNo := memberaccounttransactionFields[11].Descriptor()
+	// memberaccounttransaction.DefaultOrderNo holds the default value on creation for the order_no field.
+	memberaccounttransaction.DefaultOrderNo = memberaccounttransactionDescOrderNo.Default.(string)
+	// memberaccounttransaction.OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	memberaccounttransaction.OrderNoValidator = memberaccounttransactionDescOrderNo.Validators[0].(func(string) error)
+	// memberaccounttransactionDescRefundOrderID is the schema descriptor for refund_order_id field.
+	memberaccounttransactionDescRefundOrderID := memberaccounttransactionFields[12].Descriptor()
+	// memberaccounttransaction.DefaultRefundOrderID holds the default value on creation for the refund_order_id field.
+	memberaccounttransaction.DefaultRefundOrderID = memberaccounttransactionDescRefundOrderID.Default.(func() uuid.UUID)
+	// memberaccounttransactionDescPaymentMethod is the schema descriptor for payment_method field.
+	memberaccounttransactionDescPaymentMethod := memberaccounttransactionFields[13].Descriptor()
+	// memberaccounttransaction.DefaultPaymentMethod holds the default value on creation for the payment_method field.
+	memberaccounttransaction.DefaultPaymentMethod = memberaccounttransactionDescPaymentMethod.Default.(string)
+	// memberaccounttransaction.PaymentMethodValidator is a validator for the "payment_method" field. It is called by the builders before save.
+	memberaccounttransaction.PaymentMethodValidator = memberaccounttransactionDescPaymentMethod.Validators[0].(func(string) error)
+	// memberaccounttransactionDescOperatorID is the schema descriptor for operator_id field.
+	memberaccounttransactionDescOperatorID := memberaccounttransactionFields[14].Descriptor()
+	// memberaccounttransaction.DefaultOperatorID holds the default value on creation for the operator_id field.
+	memberaccounttransaction.DefaultOperatorID = memberaccounttransactionDescOperatorID.Default.(func() uuid.UUID)
+	// memberaccounttransactionDescOperatorName is the schema descriptor for operator_name field.
+	memberaccounttransactionDescOperatorName := memberaccounttransactionFields[15].Descriptor()
+	// memberaccounttransaction.DefaultOperatorName holds the default value on creation for the operator_name field.
+	memberaccounttransaction.DefaultOperatorName = memberaccounttransactionDescOperatorName.Default.(string)
+	// memberaccounttransaction.OperatorNameValidator is a validator for the "operator_name" field. It is called by the builders before save.
+	memberaccounttransaction.OperatorNameValidator = memberaccounttransactionDescOperatorName.Validators[0].(func(string) error)
+	// memberaccounttransactionDescRemark is the schema descriptor for remark field.
+	memberaccounttransactionDescRemark := memberaccounttransactionFields[16].Descriptor()
+	// memberaccounttransaction.DefaultRemark holds the default value on creation for the remark field.
+	memberaccounttransaction.DefaultRemark = memberaccounttransactionDescRemark.Default.(string)
+	// memberaccounttransaction.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	memberaccounttransaction.RemarkValidator = memberaccounttransactionDescRemark.Validators[0].(func(string) error)
+	// memberaccounttransactionDescID is the schema descriptor for id field.
+	memberaccounttransactionDescID := memberaccounttransactionMixinFields0[0].Descriptor()
+	// memberaccounttransaction.DefaultID holds the default value on creation for the id field.
+	memberaccounttransaction.DefaultID = memberaccounttransactionDescID.Default.(func() uuid.UUID)
+	memberpointsaccountMixin := schema.MemberPointsAccount{}.Mixin()
+	memberpointsaccountMixinFields0 := memberpointsaccountMixin[0].Fields()
+	_ = memberpointsaccountMixinFields0
+	memberpointsaccountMixinFields1 := memberpointsaccountMixin[1].Fields()
+	_ = memberpointsaccountMixinFields1
+	memberpointsaccountFields := schema.MemberPointsAccount{}.Fields()
+	_ = memberpointsaccountFields
+	// memberpointsaccountDescCreatedAt is the schema descriptor for created_at field.
+	memberpointsaccountDescCreatedAt := memberpointsaccountMixinFields1[0].Descriptor()
+	// memberpointsaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	memberpointsaccount.DefaultCreatedAt = memberpointsaccountDescCreatedAt.Default.(func() time.Time)
+	// memberpointsaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	memberpointsaccountDescUpdatedAt := memberpointsaccountMixinFields1[1].Descriptor()
+	// memberpointsaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	memberpointsaccount.DefaultUpdatedAt = memberpointsaccountDescUpdatedAt.Default.(func() time.Time)
+	// memberpointsaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	memberpointsaccount.UpdateDefaultUpdatedAt = memberpointsaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// memberpointsaccountDescBalance is the schema descriptor for balance field.
+	memberpointsaccountDescBalance := memberpointsaccountFields[2].Descriptor()
+	// memberpointsaccount.DefaultBalance holds the default value on creation for the balance field.
+	memberpointsaccount.DefaultBalance = memberpointsaccountDescBalance.Default.(int64)
+	// memberpointsaccountDescID is the schema descriptor for id field.
+	memberpointsaccountDescID := memberpointsaccountMixinFields0[0].Descriptor()
+	// memberpointsaccount.DefaultID holds the default value on creation for the id field.
+	memberpointsaccount.DefaultID = memberpointsaccountDescID.Default.(func() uuid.UUID)
+	memberpointsruleMixin := schema.MemberPointsRule{}.Mixin()
+	memberpointsruleMixinFields0 := memberpointsruleMixin[0].Fields()
+	_ = memberpointsruleMixinFields0
+	memberpointsruleMixinFields1 := memberpointsruleMixin[1].Fields()
+	_ = memberpointsruleMixinFields1
+	memberpointsruleFields := schema.MemberPointsRule{}.Fields()
+	_ = memberpointsruleFields
+	// memberpointsruleDescCreatedAt is the schema descriptor for created_at field.
+	memberpointsruleDescCreatedAt := memberpointsruleMixinFields1[0].Descriptor()
+	// memberpointsrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	memberpointsrule.DefaultCreatedAt = memberpointsruleDescCreatedAt.Default.(func() time.Time)
+	// memberpointsruleDescUpdatedAt is the schema descriptor for updated_at field.
+	memberpointsruleDescUpdatedAt := memberpointsruleMixinFields1[1].Descriptor()
+	// memberpointsrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	memberpointsrule.DefaultUpdatedAt = memberpointsruleDescUpdatedAt.Default.(func() time.Time)
+	// memberpointsrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	memberpointsrule.UpdateDefaultUpdatedAt = memberpointsruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// memberpointsruleDescEnabled is the schema descriptor for enabled field.
+	memberpointsruleDescEnabled := memberpointsruleFields[1].Descriptor()
+	// memberpointsrule.DefaultEnabled holds the default value on creation for the enabled field.
+	memberpointsrule.DefaultEnabled = memberpointsruleDescEnabled.Default.(bool)
+	// memberpointsruleDescExpireDays is the schema descriptor for expire_days field.
+	memberpointsruleDescExpireDays := memberpointsruleFields[5].Descriptor()
+	// memberpointsrule.DefaultExpireDays holds the default value on creation for the expire_days field.
+	memberpointsrule.DefaultExpireDays = memberpointsruleDescExpireDays.Default.(int)
+	// memberpointsruleDescRedeemEnabled is the schema descriptor for redeem_enabled field.
+	memberpointsruleDescRedeemEnabled := memberpointsruleFields[6].Descriptor()
+	// memberpointsrule.DefaultRedeemEnabled holds the default value on creation for the redeem_enabled field.
+	memberpointsrule.DefaultRedeemEnabled = memberpointsruleDescRedeemEnabled.Default.(bool)
+	// memberpointsruleDescRedeemRate is the schema descriptor for redeem_rate field.
+	memberpointsruleDescRedeemRate := memberpointsruleFields[7].Descriptor()
+	// memberpointsrule.DefaultRedeemRate holds the default value on creation for the redeem_rate field.
+	memberpointsrule.DefaultRedeemRate = memberpointsruleDescRedeemRate.Default.(int64)
+	// memberpointsruleDescRedeemMinPoints is the schema descriptor for redeem_min_points field.
+	memberpointsruleDescRedeemMinPoints := memberpointsruleFields[8].Descriptor()
+	// memberpointsrule.DefaultRedeemMinPoints holds the default value on creation for the redeem_min_points field.
+	memberpointsrule.DefaultRedeemMinPoints = memberpointsruleDescRedeemMinPoints.Default.(int64)
+	// memberpointsruleDescID is the schema descriptor for id field.
+	memberpointsruleDescID := memberpointsruleMixinFields0[0].Descriptor()
+	// memberpointsrule.DefaultID holds the default value on creation for the id field.
+	memberpointsrule.DefaultID = memberpointsruleDescID.Default.(func() uuid.UUID)
+	memberpointstransactionMixin := schema.MemberPointsTransaction{}.Mixin()
+	memberpointstransactionMixinFields0 := memberpointstransactionMixin[0].Fields()
+	_ = memberpointstransactionMixinFields0
+	memberpointstransactionMixinFields1 := memberpointstransactionMixin[1].Fields()
+	_ = memberpointstransactionMixinFields1
+	memberpointstransactionFields := schema.MemberPointsTransaction{}.Fields()
+	_ = memberpointstransactionFields
+	// memberpointstransactionDescCreatedAt is the schema descriptor for created_at field.
+	memberpointstransactionDescCreatedAt := memberpointstransactionMixinFields1[0].Descriptor()
+	// memberpointstransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	memberpointstransaction.DefaultCreatedAt = memberpointstransactionDescCreatedAt.Default.(func() time.Time)
+	// memberpointstransactionDescUpdatedAt is the schema descriptor for updated_at field.
+	memberpointstransactionDescUpdatedAt := memberpointstransactionMixinFields1[1].Descriptor()
+	// memberpointstransaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	memberpointstransaction.DefaultUpdatedAt = memberpointstransactionDescUpdatedAt.Default.(func() time.Time)
+	// memberpointstransaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	memberpointstransaction.UpdateDefaultUpdatedAt = memberpointstransactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// memberpointstransactionDescStoreID is the schema descriptor for store_id field.
+	memberpointstransactionDescStoreID := memberpointstransactionFields[1].Descriptor()
+	// memberpointstransaction.DefaultStoreID holds the default value on creation for the store_id field.
+	memberpointstransaction.DefaultStoreID = memberpointstransactionDescStoreID.Default.(func() uuid.UUID)
+	// memberpointstransactionDescRemaining is the schema descriptor for remaining field.
+	memberpointstransactionDescRemaining := memberpointstransactionFields[7].Descriptor()
+	// memberpointstransaction.DefaultRemaining holds the default value on creation for the remaining field.
+	memberpointstransaction.DefaultRemaining = memberpointstransactionDescRemaining.Default.(int64)
+	// memberpointstransactionDescOrderID is the schema descriptor for order_id field.
+	memberpointstransactionDescOrderID := memberpointstransactionFields[10].Descriptor()
+	// memberpointstransaction.DefaultOrderID holds the default value on creation for the order_id field.
+	memberpointstransaction.DefaultOrderID = memberpointstransactionDescOrderID.Default.(func() uuid.UUID)
+	// memberpointstransactionDescOrderNo is the schema descriptor for order_no field.
+	memberpointstransactionDescOrderNo := memberpointstransactionFields[11].Descriptor()
+	// memberpointstransaction.DefaultOrderNo holds the default value on creation for the order_no field.
+	memberpointstransaction.DefaultOrderNo = memberpointstransactionDescOrderNo.Default.(string)
+	// memberpointstransaction.OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	memberpointstransaction.OrderNoValidator = memberpointstransactionDescOrderNo.Validators[0].(func(string) error)
+	// memberpointstransactionDescRefundOrderID is the schema descriptor for refund_order_id field.
+	memberpointstransactionDescRefundOrderID := memberpointstransactionFields[12].Descriptor()
+	// memberpointstransaction.DefaultRefundOrderID holds the default value on creation for the refund_order_id field.
+	memberpointstransaction.DefaultRefundOrderID = memberpointstransactionDescRefundOrderID.Default.(func() uuid.UUID)
+	// memberpointstransactionDescProductID is the schema descriptor for product_id field.
+	memberpointstransactionDescProductID := memberpointstransactionFields[13].Descriptor()
+	// memberpointstransaction.DefaultProductID holds the default value on creation for the product_id field.
+	memberpointstransaction.DefaultProductID = memberpointstransactionDescProductID.Default.(func() uuid.UUID)
+	// memberpointstransactionDescOperatorID is the schema descriptor for operator_id field.
+	memberpointstransactionDescOperatorID := memberpointstransactionFields[14].Descriptor()
+	// memberpointstransaction.DefaultOperatorID holds the default value on creation for the operator_id field.
+	memberpointstransaction.DefaultOperatorID = memberpointstransactionDescOperatorID.Default.(func() uuid.UUID)
+	// memberpointstransactionDescOperatorName is the schema descriptor for operator_name field.
+	memberpointstransactionDescOperatorName := memberpointstransactionFields[15].Descriptor()
+	// memberpointstransaction.DefaultOperatorName holds the default value on creation for the operator_name field.
+	memberpointstransaction.DefaultOperatorName = memberpointstransactionDescOperatorName.Default.(string)
+	// memberpointstransaction.OperatorNameValidator is a validator for the "operator_name" field. It is called by the builders before save.
+	memberpointstransaction.OperatorNameValidator = memberpointstransactionDescOperatorName.Validators[0].(func(string) error)
+	// memberpointstransactionDescRemark is the schema descriptor for remark field.
+	memberpointstransactionDescRemark := memberpointstransactionFields[16].Descriptor()
+	// memberpointstransaction.DefaultRemark holds the default value on creation for the remark field.
+	memberpointstransaction.DefaultRemark = memberpointstransactionDescRemark.Default.(string)
+	// memberpointstransaction.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	memberpointstransaction.RemarkValidator = memberpointstransactionDescRemark.Validators[0].(func(string) error)
+	// memberpointstransactionDescID is the schema descriptor for id field.
+	memberpointstransactionDescID := memberpointstransactionMixinFields0[0].Descriptor()
+	// memberpointstransaction.DefaultID holds the default value on creation for the id field.
+	memberpointstransaction.DefaultID = memberpointstransactionDescID.Default.(func() uuid.UUID)
+	membertierMixin := schema.MemberTier{}.Mixin()
+	membertierMixinHooks2 := membertierMixin[2].Hooks()
+	membertier.Hooks[0] = membertierMixinHooks2[0]
+	membertierMixinInters2 := membertierMixin[2].Interceptors()
+	membertier.Interceptors[0] = membertierMixinInters2[0]
+	membertierMixinFields0 := membertierMixin[0].Fields()
+	_ = membertierMixinFields0
+	membertierMixinFields1 := membertierMixin[1].Fields()
+	_ = membertierMixinFields1
+	membertierMixinFields2 := membertierMixin[2].Fields()
+	_ = membertierMixinFields2
+	membertierFields := schema.MemberTier{}.Fields()
+	_ = membertierFields
+	// membertierDescCreatedAt is the schema descriptor for created_at field.
+	membertierDescCreatedAt := membertierMixinFields1[0].Descriptor()
+	// membertier.DefaultCreatedAt holds the default value on creation for the created_at field.
+	membertier.DefaultCreatedAt = membertierDescCreatedAt.Default.(func() time.Time)
+	// membertierDescUpdatedAt is the schema descriptor for updated_at field.
+	membertierDescUpdatedAt := membertierMixinFields1[1].Descriptor()
+	// membertier.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	membertier.DefaultUpdatedAt = membertierDescUpdatedAt.Default.(func() time.Time)
+	// membertier.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	membertier.UpdateDefaultUpdatedAt = membertierDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// membertierDescDeletedAt is the schema descriptor for deleted_at field.
+	membertierDescDeletedAt := membertierMixinFields2[0].Descriptor()
+	// membertier.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	membertier.DefaultDeletedAt = membertierDescDeletedAt.Default.(int64)
+	// membertierDescName is the schema descriptor for name field.
+	membertierDescName := membertierFields[1].Descriptor()
+	// membertier.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	membertier.NameValidator = func() func(string) error {
+		validators := membertierDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// membertierDescLevel is the schema descriptor for level field.
+	membertierDescLevel := membertierFields[2].Descriptor()
+	// membertier.DefaultLevel holds the default value on creation for the level field.
+	membertier.DefaultLevel = membertierDescLevel.Default.(int)
+	// membertierDescMemberPriceEnabled is the schema descriptor for member_price_enabled field.
+	membertierDescMemberPriceEnabled := membertierFields[3].Descriptor()
+	// membertier.DefaultMemberPriceEnabled holds the default value on creation for the member_price_enabled field.
+	membertier.DefaultMemberPriceEnabled = membertierDescMemberPriceEnabled.Default.(bool)
+	// membertierDescDescription is the schema descriptor for description field.
+	membertierDescDescription := membertierFields[4].Descriptor()
+	// membertier.DefaultDescription holds the default value on creation for the description field.
+	membertier.DefaultDescription = membertierDescDescription.Default.(string)
+	// membertier.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	membertier.DescriptionValidator = membertierDescDescription.Validators[0].(func(string) error)
+	// membertierDescID is the schema descriptor for id field.
+	membertierDescID := membertierMixinFields0[0].Descriptor()
+	// membertier.DefaultID holds the default value on creation for the id field.
+	membertier.DefaultID = membertierDescID.Default.(func() uuid.UUID)
 	menuMixin := schema.Menu{}.Mixin()
 	menuMixinHooks2 := menuMixin[2].Hooks()
 	menu.Hooks[0] = menuMixinHooks2[0]
@@ -615,6 +1517,65 @@ func init() {
 	menuitemDescID := menuitemMixinFields0[0].Descriptor()
 	// menuitem.DefaultID holds the default value on creation for the id field.
 	menuitem.DefaultID = menuitemDescID.Default.(func() uuid.UUID)
+	menuversionMixin := schema.MenuVersion{}.Mixin()
+	menuversionMixinFields0 := menuversionMixin[0].Fields()
+	_ = menuversionMixinFields0
+	menuversionMixinFields1 := menuversionMixin[1].Fields()
+	_ = menuversionMixinFields1
+	menuversionFields := schema.MenuVersion{}.Fields()
+	_ = menuversionFields
+	// menuversionDescCreatedAt is the schema descriptor for created_at field.
+	menuversionDescCreatedAt := menuversionMixinFields1[0].Descriptor()
+	// menuversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	menuversion.DefaultCreatedAt = menuversionDescCreatedAt.Default.(func() time.Time)
+	// menuversionDescUpdatedAt is the schema descriptor for updated_at field.
+	menuversionDescUpdatedAt := menuversionMixinFields1[1].Descriptor()
+	// menuversion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	menuversion.DefaultUpdatedAt = menuversionDescUpdatedAt.Default.(func() time.Time)
+	// menuversion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	menuversion.UpdateDefaultUpdatedAt = menuversionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// menuversionDescStoreID is the schema descriptor for store_id field.
+	menuversionDescStoreID := menuversionFields[2].Descriptor()
+	// menuversion.DefaultStoreID holds the default value on creation for the store_id field.
+	menuversion.DefaultStoreID = menuversionDescStoreID.Default.(func() uuid.UUID)
+	// menuversionDescName is the schema descriptor for name field.
+	menuversionDescName := menuversionFields[5].Descriptor()
+	// menuversion.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	menuversion.NameValidator = func() func(string) error {
+		validators := menuversionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// menuversionDescPublishError is the schema descriptor for publish_error field.
+	menuversionDescPublishError := menuversionFields[10].Descriptor()
+	// menuversion.DefaultPublishError holds the default value on creation for the publish_error field.
+	menuversion.DefaultPublishError = menuversionDescPublishError.Default.(string)
+	// menuversion.PublishErrorValidator is a validator for the "publish_error" field. It is called by the builders before save.
+	menuversion.PublishErrorValidator = menuversionDescPublishError.Validators[0].(func(string) error)
+	// menuversionDescOperatorID is the schema descriptor for operator_id field.
+	menuversionDescOperatorID := menuversionFields[11].Descriptor()
+	// menuversion.DefaultOperatorID holds the default value on creation for the operator_id field.
+	menuversion.DefaultOperatorID = menuversionDescOperatorID.Default.(func() uuid.UUID)
+	// menuversionDescOperatorName is the schema descriptor for operator_name field.
+	menuversionDescOperatorName := menuversionFields[12].Descriptor()
+	// menuversion.DefaultOperatorName holds the default value on creation for the operator_name field.
+	menuversion.DefaultOperatorName = menuversionDescOperatorName.Default.(string)
+	// menuversion.OperatorNameValidator is a validator for the "operator_name" field. It is called by the builders before save.
+	menuversion.OperatorNameValidator = menuversionDescOperatorName.Validators[0].(func(string) error)
+	// menuversionDescID is the schema descriptor for id field.
+	menuversionDescID := menuversionMixinFields0[0].Descriptor()
+	// menuversion.DefaultID holds the default value on creation for the id field.
+	menuversion.DefaultID = menuversionDescID.Default.(func() uuid.UUID)
 	merchantMixin := schema.Merchant{}.Mixin()
 	merchantMixinHooks2 := merchantMixin[2].Hooks()
 	merchant.Hooks[0] = merchantMixinHooks2[0]
@@ -883,8 +1844,14 @@ func init() {
 	orderDescOrderNo := orderFields[4].Descriptor()
 	// order.OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
 	order.OrderNoValidator = orderDescOrderNo.Validators[0].(func(string) error)
+	// orderDescPickupNo is the schema descriptor for pickup_no field.
+	orderDescPickupNo := orderFields[5].Descriptor()
+	// order.DefaultPickupNo holds the default value on creation for the pickup_no field.
+	order.DefaultPickupNo = orderDescPickupNo.Default.(string)
+	// order.PickupNoValidator is a validator for the "pickup_no" field. It is called by the builders before save.
+	order.PickupNoValidator = orderDescPickupNo.Validators[0].(func(string) error)
 	// orderDescRemark is the schema descriptor for remark field.
-	orderDescRemark := orderFields[25].Descriptor()
+	orderDescRemark := orderFields[29].Descriptor()
 	// order.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
 	order.RemarkValidator = orderDescRemark.Validators[0].(func(string) error)
 	// orderDescID is the schema descriptor for id field.
@@ -931,31 +1898,31 @@ func init() {
 	// orderproduct.ProductNameValidator is a validator for the "product_name" field. It is called by the builders before save.
 	orderproduct.ProductNameValidator = orderproductDescProductName.Validators[0].(func(string) error)
 	// orderproductDescMainImage is the schema descriptor for main_image field.
-	orderproductDescMainImage := orderproductFields[8].Descriptor()
+	orderproductDescMainImage := orderproductFields[9].Descriptor()
 	// orderproduct.DefaultMainImage holds the default value on creation for the main_image field.
 	orderproduct.DefaultMainImage = orderproductDescMainImage.Default.(string)
 	// orderproduct.MainImageValidator is a validator for the "main_image" field. It is called by the builders before save.
 	orderproduct.MainImageValidator = orderproductDescMainImage.Validators[0].(func(string) error)
 	// orderproductDescDescription is the schema descriptor for description field.
-	orderproductDescDescription := orderproductFields[9].Descriptor()
+	orderproductDescDescription := orderproductFields[10].Descriptor()
 	// orderproduct.DefaultDescription holds the default value on creation for the description field.
 	orderproduct.DefaultDescription = orderproductDescDescription.Default.(string)
 	// orderproduct.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	orderproduct.DescriptionValidator = orderproductDescDescription.Validators[0].(func(string) error)
 	// orderproductDescIsGift is the schema descriptor for is_gift field.
-	orderproductDescIsGift := orderproductFields[10].Descriptor()
+	orderproductDescIsGift := orderproductFields[11].Descriptor()
 	// orderproduct.DefaultIsGift holds the default value on creation for the is_gift field.
 	orderproduct.DefaultIsGift = orderproductDescIsGift.Default.(bool)
 	// orderproductDescQty is the schema descriptor for qty field.
-	orderproductDescQty := orderproductFields[11].Descriptor()
+	orderproductDescQty := orderproductFields[12].Descriptor()
 	// orderproduct.DefaultQty holds the default value on creation for the qty field.
 	orderproduct.DefaultQty = orderproductDescQty.Default.(int)
 	// orderproductDescGiftQty is the schema descriptor for gift_qty field.
-	orderproductDescGiftQty := orderproductFields[12].Descriptor()
+	orderproductDescGiftQty := orderproductFields[13].Descriptor()
 	// orderproduct.DefaultGiftQty holds the default value on creation for the gift_qty field.
 	orderproduct.DefaultGiftQty = orderproductDescGiftQty.Default.(int)
 	// orderproductDescVoidQty is the schema descriptor for void_qty field.
-	orderproductDescVoidQty := orderproductFields[23].Descriptor()
+	orderproductDescVoidQty := orderproductFields[25].Descriptor()
 	// orderproduct.DefaultVoidQty holds the default value on creation for the void_qty field.
 	orderproduct.DefaultVoidQty = orderproductDescVoidQty.Default.(int)
 	// orderproductDescID is the schema descriptor for id field.
@@ -1197,6 +2164,288 @@ func init() {
 	permissionDescID := permissionMixinFields0[0].Descriptor()
 	// permission.DefaultID holds the default value on creation for the id field.
 	permission.DefaultID = permissionDescID.Default.(func() uuid.UUID)
+	pickupcallMixin := schema.PickupCall{}.Mixin()
+	pickupcallMixinFields0 := pickupcallMixin[0].Fields()
+	_ = pickupcallMixinFields0
+	pickupcallMixinFields1 := pickupcallMixin[1].Fields()
+	_ = pickupcallMixinFields1
+	pickupcallFields := schema.PickupCall{}.Fields()
+	_ = pickupcallFields
+	// pickupcallDescCreatedAt is the schema descriptor for created_at field.
+	pickupcallDescCreatedAt := pickupcallMixinFields1[0].Descriptor()
+	// pickupcall.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pickupcall.DefaultCreatedAt = pickupcallDescCreatedAt.Default.(func() time.Time)
+	// pickupcallDescUpdatedAt is the schema descriptor for updated_at field.
+	pickupcallDescUpdatedAt := pickupcallMixinFields1[1].Descriptor()
+	// pickupcall.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pickupcall.DefaultUpdatedAt = pickupcallDescUpdatedAt.Default.(func() time.Time)
+	// pickupcall.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pickupcall.UpdateDefaultUpdatedAt = pickupcallDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pickupcallDescOrderNo is the schema descriptor for order_no field.
+	pickupcallDescOrderNo := pickupcallFields[3].Descriptor()
+	// pickupcall.DefaultOrderNo holds the default value on creation for the order_no field.
+	pickupcall.DefaultOrderNo = pickupcallDescOrderNo.Default.(string)
+	// pickupcall.OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	pickupcall.OrderNoValidator = pickupcallDescOrderNo.Validators[0].(func(string) error)
+	// pickupcallDescPickupNo is the schema descriptor for pickup_no field.
+	pickupcallDescPickupNo := pickupcallFields[4].Descriptor()
+	// pickupcall.PickupNoValidator is a validator for the "pickup_no" field. It is called by the builders before save.
+	pickupcall.PickupNoValidator = pickupcallDescPickupNo.Validators[0].(func(string) error)
+	// pickupcallDescBusinessDate is the schema descriptor for business_date field.
+	pickupcallDescBusinessDate := pickupcallFields[5].Descriptor()
+	// pickupcall.BusinessDateValidator is a validator for the "business_date" field. It is called by the builders before save.
+	pickupcall.BusinessDateValidator = pickupcallDescBusinessDate.Validators[0].(func(string) error)
+	// pickupcallDescCallCount is the schema descriptor for call_count field.
+	pickupcallDescCallCount := pickupcallFields[7].Descriptor()
+	// pickupcall.DefaultCallCount holds the default value on creation for the call_count field.
+	pickupcall.DefaultCallCount = pickupcallDescCallCount.Default.(int)
+	// pickupcallDescID is the schema descriptor for id field.
+	pickupcallDescID := pickupcallMixinFields0[0].Descriptor()
+	// pickupcall.DefaultID holds the default value on creation for the id field.
+	pickupcall.DefaultID = pickupcallDescID.Default.(func() uuid.UUID)
+	pickupruleMixin := schema.PickupRule{}.Mixin()
+	pickupruleMixinFields0 := pickupruleMixin[0].Fields()
+	_ = pickupruleMixinFields0
+	pickupruleMixinFields1 := pickupruleMixin[1].Fields()
+	_ = pickupruleMixinFields1
+	pickupruleFields := schema.PickupRule{}.Fields()
+	_ = pickupruleFields
+	// pickupruleDescCreatedAt is the schema descriptor for created_at field.
+	pickupruleDescCreatedAt := pickupruleMixinFields1[0].Descriptor()
+	// pickuprule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pickuprule.DefaultCreatedAt = pickupruleDescCreatedAt.Default.(func() time.Time)
+	// pickupruleDescUpdatedAt is the schema descriptor for updated_at field.
+	pickupruleDescUpdatedAt := pickupruleMixinFields1[1].Descriptor()
+	// pickuprule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pickuprule.DefaultUpdatedAt = pickupruleDescUpdatedAt.Default.(func() time.Time)
+	// pickuprule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pickuprule.UpdateDefaultUpdatedAt = pickupruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pickupruleDescPrefix is the schema descriptor for prefix field.
+	pickupruleDescPrefix := pickupruleFields[2].Descriptor()
+	// pickuprule.DefaultPrefix holds the default value on creation for the prefix field.
+	pickuprule.DefaultPrefix = pickupruleDescPrefix.Default.(string)
+	// pickuprule.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	pickuprule.PrefixValidator = pickupruleDescPrefix.Validators[0].(func(string) error)
+	// pickupruleDescID is the schema descriptor for id field.
+	pickupruleDescID := pickupruleMixinFields0[0].Descriptor()
+	// pickuprule.DefaultID holds the default value on creation for the id field.
+	pickuprule.DefaultID = pickupruleDescID.Default.(func() uuid.UUID)
+	preorderMixin := schema.Preorder{}.Mixin()
+	preorderMixinFields0 := preorderMixin[0].Fields()
+	_ = preorderMixinFields0
+	preorderMixinFields1 := preorderMixin[1].Fields()
+	_ = preorderMixinFields1
+	preorderFields := schema.Preorder{}.Fields()
+	_ = preorderFields
+	// preorderDescCreatedAt is the schema descriptor for created_at field.
+	preorderDescCreatedAt := preorderMixinFields1[0].Descriptor()
+	// preorder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	preorder.DefaultCreatedAt = preorderDescCreatedAt.Default.(func() time.Time)
+	// preorderDescUpdatedAt is the schema descriptor for updated_at field.
+	preorderDescUpdatedAt := preorderMixinFields1[1].Descriptor()
+	// preorder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	preorder.DefaultUpdatedAt = preorderDescUpdatedAt.Default.(func() time.Time)
+	// preorder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	preorder.UpdateDefaultUpdatedAt = preorderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// preorderDescOrderNo is the schema descriptor for order_no field.
+	preorderDescOrderNo := preorderFields[4].Descriptor()
+	// preorder.OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	preorder.OrderNoValidator = preorderDescOrderNo.Validators[0].(func(string) error)
+	// preorderDescContactName is the schema descriptor for contact_name field.
+	preorderDescContactName := preorderFields[8].Descriptor()
+	// preorder.ContactNameValidator is a validator for the "contact_name" field. It is called by the builders before save.
+	preorder.ContactNameValidator = preorderDescContactName.Validators[0].(func(string) error)
+	// preorderDescContactPhone is the schema descriptor for contact_phone field.
+	preorderDescContactPhone := preorderFields[9].Descriptor()
+	// preorder.ContactPhoneValidator is a validator for the "contact_phone" field. It is called by the builders before save.
+	preorder.ContactPhoneValidator = preorderDescContactPhone.Validators[0].(func(string) error)
+	// preorderDescDeliveryAddress is the schema descriptor for delivery_address field.
+	preorderDescDeliveryAddress := preorderFields[10].Descriptor()
+	// preorder.DefaultDeliveryAddress holds the default value on creation for the delivery_address field.
+	preorder.DefaultDeliveryAddress = preorderDescDeliveryAddress.Default.(string)
+	// preorder.DeliveryAddressValidator is a validator for the "delivery_address" field. It is called by the builders before save.
+	preorder.DeliveryAddressValidator = preorderDescDeliveryAddress.Validators[0].(func(string) error)
+	// preorderDescCancelReason is the schema descriptor for cancel_reason field.
+	preorderDescCancelReason := preorderFields[14].Descriptor()
+	// preorder.DefaultCancelReason holds the default value on creation for the cancel_reason field.
+	preorder.DefaultCancelReason = preorderDescCancelReason.Default.(string)
+	// preorder.CancelReasonValidator is a validator for the "cancel_reason" field. It is called by the builders before save.
+	preorder.CancelReasonValidator = preorderDescCancelReason.Validators[0].(func(string) error)
+	// preorderDescID is the schema descriptor for id field.
+	preorderDescID := preorderMixinFields0[0].Descriptor()
+	// preorder.DefaultID holds the default value on creation for the id field.
+	preorder.DefaultID = preorderDescID.Default.(func() uuid.UUID)
+	preorderruleMixin := schema.PreorderRule{}.Mixin()
+	preorderruleMixinFields0 := preorderruleMixin[0].Fields()
+	_ = preorderruleMixinFields0
+	preorderruleMixinFields1 := preorderruleMixin[1].Fields()
+	_ = preorderruleMixinFields1
+	preorderruleFields := schema.PreorderRule{}.Fields()
+	_ = preorderruleFields
+	// preorderruleDescCreatedAt is the schema descriptor for created_at field.
+	preorderruleDescCreatedAt := preorderruleMixinFields1[0].Descriptor()
+	// preorderrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	preorderrule.DefaultCreatedAt = preorderruleDescCreatedAt.Default.(func() time.Time)
+	// preorderruleDescUpdatedAt is the schema descriptor for updated_at field.
+	preorderruleDescUpdatedAt := preorderruleMixinFields1[1].Descriptor()
+	// preorderrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	preorderrule.DefaultUpdatedAt = preorderruleDescUpdatedAt.Default.(func() time.Time)
+	// preorderrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	preorderrule.UpdateDefaultUpdatedAt = preorderruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// preorderruleDescEnabled is the schema descriptor for enabled field.
+	preorderruleDescEnabled := preorderruleFields[2].Descriptor()
+	// preorderrule.DefaultEnabled holds the default value on creation for the enabled field.
+	preorderrule.DefaultEnabled = preorderruleDescEnabled.Default.(bool)
+	// preorderruleDescDeliveryEnabled is the schema descriptor for delivery_enabled field.
+	preorderruleDescDeliveryEnabled := preorderruleFields[3].Descriptor()
+	// preorderrule.DefaultDeliveryEnabled holds the default value on creation for the delivery_enabled field.
+	preorderrule.DefaultDeliveryEnabled = preorderruleDescDeliveryEnabled.Default.(bool)
+	// preorderruleDescLeadMinutes is the schema descriptor for lead_minutes field.
+	preorderruleDescLeadMinutes := preorderruleFields[6].Descriptor()
+	// preorderrule.DefaultLeadMinutes holds the default value on creation for the lead_minutes field.
+	preorderrule.DefaultLeadMinutes = preorderruleDescLeadMinutes.Default.(int)
+	// preorderruleDescPrepMinutes is the schema descriptor for prep_minutes field.
+	preorderruleDescPrepMinutes := preorderruleFields[7].Descriptor()
+	// preorderrule.DefaultPrepMinutes holds the default value on creation for the prep_minutes field.
+	preorderrule.DefaultPrepMinutes = preorderruleDescPrepMinutes.Default.(int)
+	// preorderruleDescMaxAdvanceDays is the schema descriptor for max_advance_days field.
+	preorderruleDescMaxAdvanceDays := preorderruleFields[8].Descriptor()
+	// preorderrule.DefaultMaxAdvanceDays holds the default value on creation for the max_advance_days field.
+	preorderrule.DefaultMaxAdvanceDays = preorderruleDescMaxAdvanceDays.Default.(int)
+	// preorderruleDescID is the schema descriptor for id field.
+	preorderruleDescID := preorderruleMixinFields0[0].Descriptor()
+	// preorderrule.DefaultID holds the default value on creation for the id field.
+	preorderrule.DefaultID = preorderruleDescID.Default.(func() uuid.UUID)
+	pricechangebatchMixin := schema.PriceChangeBatch{}.Mixin()
+	pricechangebatchMixinHooks2 := pricechangebatchMixin[2].Hooks()
+	pricechangebatch.Hooks[0] = pricechangebatchMixinHooks2[0]
+	pricechangebatchMixinInters2 := pricechangebatchMixin[2].Interceptors()
+	pricechangebatch.Interceptors[0] = pricechangebatchMixinInters2[0]
+	pricechangebatchMixinFields0 := pricechangebatchMixin[0].Fields()
+	_ = pricechangebatchMixinFields0
+	pricechangebatchMixinFields1 := pricechangebatchMixin[1].Fields()
+	_ = pricechangebatchMixinFields1
+	pricechangebatchMixinFields2 := pricechangebatchMixin[2].Fields()
+	_ = pricechangebatchMixinFields2
+	pricechangebatchFields := schema.PriceChangeBatch{}.Fields()
+	_ = pricechangebatchFields
+	// pricechangebatchDescCreatedAt is the schema descriptor for created_at field.
+	pricechangebatchDescCreatedAt := pricechangebatchMixinFields1[0].Descriptor()
+	// pricechangebatch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pricechangebatch.DefaultCreatedAt = pricechangebatchDescCreatedAt.Default.(func() time.Time)
+	// pricechangebatchDescUpdatedAt is the schema descriptor for updated_at field.
+	pricechangebatchDescUpdatedAt := pricechangebatchMixinFields1[1].Descriptor()
+	// pricechangebatch.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pricechangebatch.DefaultUpdatedAt = pricechangebatchDescUpdatedAt.Default.(func() time.Time)
+	// pricechangebatch.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pricechangebatch.UpdateDefaultUpdatedAt = pricechangebatchDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pricechangebatchDescDeletedAt is the schema descriptor for deleted_at field.
+	pricechangebatchDescDeletedAt := pricechangebatchMixinFields2[0].Descriptor()
+	// pricechangebatch.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	pricechangebatch.DefaultDeletedAt = pricechangebatchDescDeletedAt.Default.(int64)
+	// pricechangebatchDescName is the schema descriptor for name field.
+	pricechangebatchDescName := pricechangebatchFields[0].Descriptor()
+	// pricechangebatch.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	pricechangebatch.NameValidator = func() func(string) error {
+		validators := pricechangebatchDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// pricechangebatchDescStoreID is the schema descriptor for store_id field.
+	pricechangebatchDescStoreID := pricechangebatchFields[2].Descriptor()
+	// pricechangebatch.DefaultStoreID holds the default value on creation for the store_id field.
+	pricechangebatch.DefaultStoreID = pricechangebatchDescStoreID.Default.(func() uuid.UUID)
+	// pricechangebatchDescRemark is the schema descriptor for remark field.
+	pricechangebatchDescRemark := pricechangebatchFields[6].Descriptor()
+	// pricechangebatch.DefaultRemark holds the default value on creation for the remark field.
+	pricechangebatch.DefaultRemark = pricechangebatchDescRemark.Default.(string)
+	// pricechangebatch.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	pricechangebatch.RemarkValidator = pricechangebatchDescRemark.Validators[0].(func(string) error)
+	// pricechangebatchDescFailReason is the schema descriptor for fail_reason field.
+	pricechangebatchDescFailReason := pricechangebatchFields[7].Descriptor()
+	// pricechangebatch.DefaultFailReason holds the default value on creation for the fail_reason field.
+	pricechangebatch.DefaultFailReason = pricechangebatchDescFailReason.Default.(string)
+	// pricechangebatch.FailReasonValidator is a validator for the "fail_reason" field. It is called by the builders before save.
+	pricechangebatch.FailReasonValidator = pricechangebatchDescFailReason.Validators[0].(func(string) error)
+	// pricechangebatchDescOperatorName is the schema descriptor for operator_name field.
+	pricechangebatchDescOperatorName := pricechangebatchFields[10].Descriptor()
+	// pricechangebatch.DefaultOperatorName holds the default value on creation for the operator_name field.
+	pricechangebatch.DefaultOperatorName = pricechangebatchDescOperatorName.Default.(string)
+	// pricechangebatch.OperatorNameValidator is a validator for the "operator_name" field. It is called by the builders before save.
+	pricechangebatch.OperatorNameValidator = pricechangebatchDescOperatorName.Validators[0].(func(string) error)
+	// pricechangebatchDescID is the schema descriptor for id field.
+	pricechangebatchDescID := pricechangebatchMixinFields0[0].Descriptor()
+	// pricechangebatch.DefaultID holds the default value on creation for the id field.
+	pricechangebatch.DefaultID = pricechangebatchDescID.Default.(func() uuid.UUID)
+	pricechangeitemMixin := schema.PriceChangeItem{}.Mixin()
+	pricechangeitemMixinHooks2 := pricechangeitemMixin[2].Hooks()
+	pricechangeitem.Hooks[0] = pricechangeitemMixinHooks2[0]
+	pricechangeitemMixinInters2 := pricechangeitemMixin[2].Interceptors()
+	pricechangeitem.Interceptors[0] = pricechangeitemMixinInters2[0]
+	pricechangeitemMixinFields0 := pricechangeitemMixin[0].Fields()
+	_ = pricechangeitemMixinFields0
+	pricechangeitemMixinFields1 := pricechangeitemMixin[1].Fields()
+	_ = pricechangeitemMixinFields1
+	pricechangeitemMixinFields2 := pricechangeitemMixin[2].Fields()
+	_ = pricechangeitemMixinFields2
+	pricechangeitemFields := schema.PriceChangeItem{}.Fields()
+	_ = pricechangeitemFields
+	// pricechangeitemDescCreatedAt is the schema descriptor for created_at field.
+	pricechangeitemDescCreatedAt := pricechangeitemMixinFields1[0].Descriptor()
+	// pricechangeitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pricechangeitem.DefaultCreatedAt = pricechangeitemDescCreatedAt.Default.(func() time.Time)
+	// pricechangeitemDescUpdatedAt is the schema descriptor for updated_at field.
+	pricechangeitemDescUpdatedAt := pricechangeitemMixinFields1[1].Descriptor()
+	// pricechangeitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pricechangeitem.DefaultUpdatedAt = pricechangeitemDescUpdatedAt.Default.(func() time.Time)
+	// pricechangeitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pricechangeitem.UpdateDefaultUpdatedAt = pricechangeitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pricechangeitemDescDeletedAt is the schema descriptor for deleted_at field.
+	pricechangeitemDescDeletedAt := pricechangeitemMixinFields2[0].Descriptor()
+	// pricechangeitem.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	pricechangeitem.DefaultDeletedAt = pricechangeitemDescDeletedAt.Default.(int64)
+	// pricechangeitemDescSpecID is the schema descriptor for spec_id field.
+	pricechangeitemDescSpecID := pricechangeitemFields[3].Descriptor()
+	// pricechangeitem.DefaultSpecID holds the default value on creation for the spec_id field.
+	pricechangeitem.DefaultSpecID = pricechangeitemDescSpecID.Default.(func() uuid.UUID)
+	// pricechangeitemDescStoreID is the schema descriptor for store_id field.
+	pricechangeitemDescStoreID := pricechangeitemFields[4].Descriptor()
+	// pricechangeitem.DefaultStoreID holds the default value on creation for the store_id field.
+	pricechangeitem.DefaultStoreID = pricechangeitemDescStoreID.Default.(func() uuid.UUID)
+	// pricechangeitemDescProductName is the schema descriptor for product_name field.
+	pricechangeitemDescProductName := pricechangeitemFields[5].Descriptor()
+	// pricechangeitem.DefaultProductName holds the default value on creation for the product_name field.
+	pricechangeitem.DefaultProductName = pricechangeitemDescProductName.Default.(string)
+	// pricechangeitem.ProductNameValidator is a validator for the "product_name" field. It is called by the builders before save.
+	pricechangeitem.ProductNameValidator = pricechangeitemDescProductName.Validators[0].(func(string) error)
+	// pricechangeitemDescSpecName is the schema descriptor for spec_name field.
+	pricechangeitemDescSpecName := pricechangeitemFields[6].Descriptor()
+	// pricechangeitem.DefaultSpecName holds the default value on creation for the spec_name field.
+	pricechangeitem.DefaultSpecName = pricechangeitemDescSpecName.Default.(string)
+	// pricechangeitem.SpecNameValidator is a validator for the "spec_name" field. It is called by the builders before save.
+	pricechangeitem.SpecNameValidator = pricechangeitemDescSpecName.Validators[0].(func(string) error)
+	// pricechangeitemDescTargetID is the schema descriptor for target_id field.
+	pricechangeitemDescTargetID := pricechangeitemFields[9].Descriptor()
+	// pricechangeitem.DefaultTargetID holds the default value on creation for the target_id field.
+	pricechangeitem.DefaultTargetID = pricechangeitemDescTargetID.Default.(func() uuid.UUID)
+	// pricechangeitemDescApplied is the schema descriptor for applied field.
+	pricechangeitemDescApplied := pricechangeitemFields[10].Descriptor()
+	// pricechangeitem.DefaultApplied holds the default value on creation for the applied field.
+	pricechangeitem.DefaultApplied = pricechangeitemDescApplied.Default.(bool)
+	// pricechangeitemDescID is the schema descriptor for id field.
+	pricechangeitemDescID := pricechangeitemMixinFields0[0].Descriptor()
+	// pricechangeitem.DefaultID holds the default value on creation for the id field.
+	pricechangeitem.DefaultID = pricechangeitemDescID.Default.(func() uuid.UUID)
 	productMixin := schema.Product{}.Mixin()
 	productMixinHooks2 := productMixin[2].Hooks()
 	product.Hooks[0] = productMixinHooks2[0]
@@ -1248,32 +2497,60 @@ func init() {
 	product.DefaultMnemonic = productDescMnemonic.Default.(string)
 	// product.MnemonicValidator is a validator for the "mnemonic" field. It is called by the builders before save.
 	product.MnemonicValidator = productDescMnemonic.Validators[0].(func(string) error)
+	// productDescPinyin is the schema descriptor for pinyin field.
+	productDescPinyin := productFields[5].Descriptor()
+	// product.DefaultPinyin holds the default value on creation for the pinyin field.
+	product.DefaultPinyin = productDescPinyin.Default.(string)
+	// product.PinyinValidator is a validator for the "pinyin" field. It is called by the builders before save.
+	product.PinyinValidator = productDescPinyin.Validators[0].(func(string) error)
+	// productDescPinyinInitials is the schema descriptor for pinyin_initials field.
+	productDescPinyinInitials := productFields[6].Descriptor()
+	// product.DefaultPinyinInitials holds the default value on creation for the pinyin_initials field.
+	product.DefaultPinyinInitials = productDescPinyinInitials.Default.(string)
+	// product.PinyinInitialsValidator is a validator for the "pinyin_initials" field. It is called by the builders before save.
+	product.PinyinInitialsValidator = productDescPinyinInitials.Validators[0].(func(string) error)
 	// productDescShelfLife is the schema descriptor for shelf_life field.
-	productDescShelfLife := productFields[5].Descriptor()
+	productDescShelfLife := productFields[7].Descriptor()
 	// product.DefaultShelfLife holds the default value on creation for the shelf_life field.
 	product.DefaultShelfLife = productDescShelfLife.Default.(int)
 	// productDescInheritTaxRate is the schema descriptor for inherit_tax_rate field.
-	productDescInheritTaxRate := productFields[15].Descriptor()
+	productDescInheritTaxRate := productFields[17].Descriptor()
 	// product.DefaultInheritTaxRate holds the default value on creation for the inherit_tax_rate field.
 	product.DefaultInheritTaxRate = productDescInheritTaxRate.Default.(bool)
 	// productDescInheritStall is the schema descriptor for inherit_stall field.
-	productDescInheritStall := productFields[17].Descriptor()
+	productDescInheritStall := productFields[19].Descriptor()
 	// product.DefaultInheritStall holds the default value on creation for the inherit_stall field.
 	product.DefaultInheritStall = productDescInheritStall.Default.(bool)
 	// productDescMainImage is the schema descriptor for main_image field.
-	productDescMainImage := productFields[19].Descriptor()
+	productDescMainImage := productFields[21].Descriptor()
 	// product.DefaultMainImage holds the default value on creation for the main_image field.
 	product.DefaultMainImage = productDescMainImage.Default.(string)
 	// product.MainImageValidator is a validator for the "main_image" field. It is called by the builders before save.
 	product.MainImageValidator = productDescMainImage.Validators[0].(func(string) error)
 	// productDescDescription is the schema descriptor for description field.
-	productDescDescription := productFields[21].Descriptor()
+	productDescDescription := productFields[23].Descriptor()
 	// product.DefaultDescription holds the default value on creation for the description field.
 	product.DefaultDescription = productDescDescription.Default.(string)
 	// product.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	product.DescriptionValidator = productDescDescription.Validators[0].(func(string) error)
+	// productDescHalal is the schema descriptor for halal field.
+	productDescHalal := productFields[27].Descriptor()
+	// product.DefaultHalal holds the default value on creation for the halal field.
+	product.DefaultHalal = productDescHalal.Default.(bool)
+	// productDescVegetarian is the schema descriptor for vegetarian field.
+	productDescVegetarian := productFields[28].Descriptor()
+	// product.DefaultVegetarian holds the default value on creation for the vegetarian field.
+	product.DefaultVegetarian = productDescVegetarian.Default.(bool)
+	// productDescVegan is the schema descriptor for vegan field.
+	productDescVegan := productFields[29].Descriptor()
+	// product.DefaultVegan holds the default value on creation for the vegan field.
+	product.DefaultVegan = productDescVegan.Default.(bool)
+	// productDescSpicyLevel is the schema descriptor for spicy_level field.
+	productDescSpicyLevel := productFields[30].Descriptor()
+	// product.DefaultSpicyLevel holds the default value on creation for the spicy_level field.
+	product.DefaultSpicyLevel = domain.SpicyLevel(productDescSpicyLevel.Default.(int))
 	// productDescStoreID is the schema descriptor for store_id field.
-	productDescStoreID := productFields[25].Descriptor()
+	productDescStoreID := productFields[36].Descriptor()
 	// product.DefaultStoreID holds the default value on creation for the store_id field.
 	product.DefaultStoreID = productDescStoreID.Default.(func() uuid.UUID)
 	// productDescID is the schema descriptor for id field.
@@ -1326,11 +2603,11 @@ func init() {
 		}
 	}()
 	// productattrDescStoreID is the schema descriptor for store_id field.
-	productattrDescStoreID := productattrFields[3].Descriptor()
+	productattrDescStoreID := productattrFields[4].Descriptor()
 	// productattr.DefaultStoreID holds the default value on creation for the store_id field.
 	productattr.DefaultStoreID = productattrDescStoreID.Default.(func() uuid.UUID)
 	// productattrDescProductCount is the schema descriptor for product_count field.
-	productattrDescProductCount := productattrFields[4].Descriptor()
+	productattrDescProductCount := productattrFields[5].Descriptor()
 	// productattr.DefaultProductCount holds the default value on creation for the product_count field.
 	productattr.DefaultProductCount = productattrDescProductCount.Default.(int)
 	// productattrDescID is the schema descriptor for id field.
@@ -1383,17 +2660,17 @@ func init() {
 		}
 	}()
 	// productattritemDescImage is the schema descriptor for image field.
-	productattritemDescImage := productattritemFields[2].Descriptor()
+	productattritemDescImage := productattritemFields[3].Descriptor()
 	// productattritem.DefaultImage holds the default value on creation for the image field.
 	productattritem.DefaultImage = productattritemDescImage.Default.(string)
 	// productattritem.ImageValidator is a validator for the "image" field. It is called by the builders before save.
 	productattritem.ImageValidator = productattritemDescImage.Validators[0].(func(string) error)
 	// productattritemDescBasePrice is the schema descriptor for base_price field.
-	productattritemDescBasePrice := productattritemFields[3].Descriptor()
+	productattritemDescBasePrice := productattritemFields[4].Descriptor()
 	// productattritem.DefaultBasePrice holds the default value on creation for the base_price field.
 	productattritem.DefaultBasePrice = productattritemDescBasePrice.Default.(decimal.Decimal)
 	// productattritemDescProductCount is the schema descriptor for product_count field.
-	productattritemDescProductCount := productattritemFields[4].Descriptor()
+	productattritemDescProductCount := productattritemFields[5].Descriptor()
 	// productattritem.DefaultProductCount holds the default value on creation for the product_count field.
 	productattritem.DefaultProductCount = productattritemDescProductCount.Default.(int)
 	// productattritemDescID is the schema descriptor for id field.
@@ -1431,6 +2708,10 @@ func init() {
 	productattrrelationDescIsDefault := productattrrelationFields[3].Descriptor()
 	// productattrrelation.DefaultIsDefault holds the default value on creation for the is_default field.
 	productattrrelation.DefaultIsDefault = productattrrelationDescIsDefault.Default.(bool)
+	// productattrrelationDescMaxQuantity is the schema descriptor for max_quantity field.
+	productattrrelationDescMaxQuantity := productattrrelationFields[4].Descriptor()
+	// productattrrelation.DefaultMaxQuantity holds the default value on creation for the max_quantity field.
+	productattrrelation.DefaultMaxQuantity = productattrrelationDescMaxQuantity.Default.(int)
 	// productattrrelationDescID is the schema descriptor for id field.
 	productattrrelationDescID := productattrrelationMixinFields0[0].Descriptor()
 	// productattrrelation.DefaultID holds the default value on creation for the id field.
@@ -1651,6 +2932,61 @@ func init() {
 	productunitDescID := productunitMixinFields0[0].Descriptor()
 	// productunit.DefaultID holds the default value on creation for the id field.
 	productunit.DefaultID = productunitDescID.Default.(func() uuid.UUID)
+	productversionMixin := schema.ProductVersion{}.Mixin()
+	productversionMixinFields0 := productversionMixin[0].Fields()
+	_ = productversionMixinFields0
+	productversionMixinFields1 := productversionMixin[1].Fields()
+	_ = productversionMixinFields1
+	productversionFields := schema.ProductVersion{}.Fields()
+	_ = productversionFields
+	// productversionDescCreatedAt is the schema descriptor for created_at field.
+	productversionDescCreatedAt := productversionMixinFields1[0].Descriptor()
+	// productversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productversion.DefaultCreatedAt = productversionDescCreatedAt.Default.(func() time.Time)
+	// productversionDescUpdatedAt is the schema descriptor for updated_at field.
+	productversionDescUpdatedAt := productversionMixinFields1[1].Descriptor()
+	// productversion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productversion.DefaultUpdatedAt = productversionDescUpdatedAt.Default.(func() time.Time)
+	// productversion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productversion.UpdateDefaultUpdatedAt = productversionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// productversionDescProductID is the schema descriptor for product_id field.
+	productversionDescProductID := productversionFields[1].Descriptor()
+	// productversion.DefaultProductID holds the default value on creation for the product_id field.
+	productversion.DefaultProductID = productversionDescProductID.Default.(func() uuid.UUID)
+	// productversionDescStoreID is the schema descriptor for store_id field.
+	productversionDescStoreID := productversionFields[4].Descriptor()
+	// productversion.DefaultStoreID holds the default value on creation for the store_id field.
+	productversion.DefaultStoreID = productversionDescStoreID.Default.(func() uuid.UUID)
+	// productversionDescName is the schema descriptor for name field.
+	productversionDescName := productversionFields[7].Descriptor()
+	// productversion.DefaultName holds the default value on creation for the name field.
+	productversion.DefaultName = productversionDescName.Default.(string)
+	// productversion.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	productversion.NameValidator = productversionDescName.Validators[0].(func(string) error)
+	// productversionDescRestoredFrom is the schema descriptor for restored_from field.
+	productversionDescRestoredFrom := productversionFields[10].Descriptor()
+	// productversion.DefaultRestoredFrom holds the default value on creation for the restored_from field.
+	productversion.DefaultRestoredFrom = productversionDescRestoredFrom.Default.(int)
+	// productversionDescOperatorID is the schema descriptor for operator_id field.
+	productversionDescOperatorID := productversionFields[11].Descriptor()
+	// productversion.DefaultOperatorID holds the default value on creation for the operator_id field.
+	productversion.DefaultOperatorID = productversionDescOperatorID.Default.(func() uuid.UUID)
+	// productversionDescOperatorType is the schema descriptor for operator_type field.
+	productversionDescOperatorType := productversionFields[12].Descriptor()
+	// productversion.DefaultOperatorType holds the default value on creation for the operator_type field.
+	productversion.DefaultOperatorType = productversionDescOperatorType.Default.(string)
+	// productversion.OperatorTypeValidator is a validator for the "operator_type" field. It is called by the builders before save.
+	productversion.OperatorTypeValidator = productversionDescOperatorType.Validators[0].(func(string) error)
+	// productversionDescOperatorName is the schema descriptor for operator_name field.
+	productversionDescOperatorName := productversionFields[13].Descriptor()
+	// productversion.DefaultOperatorName holds the default value on creation for the operator_name field.
+	productversion.DefaultOperatorName = productversionDescOperatorName.Default.(string)
+	// productversion.OperatorNameValidator is a validator for the "operator_name" field. It is called by the builders before save.
+	productversion.OperatorNameValidator = productversionDescOperatorName.Validators[0].(func(string) error)
+	// productversionDescID is the schema descriptor for id field.
+	productversionDescID := productversionMixinFields0[0].Descriptor()
+	// productversion.DefaultID holds the default value on creation for the id field.
+	productversion.DefaultID = productversionDescID.Default.(func() uuid.UUID)
 	profitdistributionbillMixin := schema.ProfitDistributionBill{}.Mixin()
 	profitdistributionbillMixinHooks2 := profitdistributionbillMixin[2].Hooks()
 	profitdistributionbill.Hooks[0] = profitdistributionbillMixinHooks2[0]
@@ -1757,6 +3093,134 @@ func init() {
 	profitdistributionruleDescID := profitdistributionruleMixinFields0[0].Descriptor()
 	// profitdistributionrule.DefaultID holds the default value on creation for the id field.
 	profitdistributionrule.DefaultID = profitdistributionruleDescID.Default.(func() uuid.UUID)
+	promotionMixin := schema.Promotion{}.Mixin()
+	promotionMixinHooks2 := promotionMixin[2].Hooks()
+	promotion.Hooks[0] = promotionMixinHooks2[0]
+	promotionMixinInters2 := promotionMixin[2].Interceptors()
+	promotion.Interceptors[0] = promotionMixinInters2[0]
+	promotionMixinFields0 := promotionMixin[0].Fields()
+	_ = promotionMixinFields0
+	promotionMixinFields1 := promotionMixin[1].Fields()
+	_ = promotionMixinFields1
+	promotionMixinFields2 := promotionMixin[2].Fields()
+	_ = promotionMixinFields2
+	promotionFields := schema.Promotion{}.Fields()
+	_ = promotionFields
+	// promotionDescCreatedAt is the schema descriptor for created_at field.
+	promotionDescCreatedAt := promotionMixinFields1[0].Descriptor()
+	// promotion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	promotion.DefaultCreatedAt = promotionDescCreatedAt.Default.(func() time.Time)
+	// promotionDescUpdatedAt is the schema descriptor for updated_at field.
+	promotionDescUpdatedAt := promotionMixinFields1[1].Descriptor()
+	// promotion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	promotion.DefaultUpdatedAt = promotionDescUpdatedAt.Default.(func() time.Time)
+	// promotion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	promotion.UpdateDefaultUpdatedAt = promotionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// promotionDescDeletedAt is the schema descriptor for deleted_at field.
+	promotionDescDeletedAt := promotionMixinFields2[0].Descriptor()
+	// promotion.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	promotion.DefaultDeletedAt = promotionDescDeletedAt.Default.(int64)
+	// promotionDescStoreID is the schema descriptor for store_id field.
+	promotionDescStoreID := promotionFields[1].Descriptor()
+	// promotion.DefaultStoreID holds the default value on creation for the store_id field.
+	promotion.DefaultStoreID = promotionDescStoreID.Default.(func() uuid.UUID)
+	// promotionDescName is the schema descriptor for name field.
+	promotionDescName := promotionFields[2].Descriptor()
+	// promotion.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	promotion.NameValidator = func() func(string) error {
+		validators := promotionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// promotionDescPriority is the schema descriptor for priority field.
+	promotionDescPriority := promotionFields[11].Descriptor()
+	// promotion.DefaultPriority holds the default value on creation for the priority field.
+	promotion.DefaultPriority = promotionDescPriority.Default.(int)
+	// promotionDescStackable is the schema descriptor for stackable field.
+	promotionDescStackable := promotionFields[12].Descriptor()
+	// promotion.DefaultStackable holds the default value on creation for the stackable field.
+	promotion.DefaultStackable = promotionDescStackable.Default.(bool)
+	// promotionDescEnabled is the schema descriptor for enabled field.
+	promotionDescEnabled := promotionFields[13].Descriptor()
+	// promotion.DefaultEnabled holds the default value on creation for the enabled field.
+	promotion.DefaultEnabled = promotionDescEnabled.Default.(bool)
+	// promotionDescID is the schema descriptor for id field.
+	promotionDescID := promotionMixinFields0[0].Descriptor()
+	// promotion.DefaultID holds the default value on creation for the id field.
+	promotion.DefaultID = promotionDescID.Default.(func() uuid.UUID)
+	receiptrequestMixin := schema.ReceiptRequest{}.Mixin()
+	receiptrequestMixinFields0 := receiptrequestMixin[0].Fields()
+	_ = receiptrequestMixinFields0
+	receiptrequestMixinFields1 := receiptrequestMixin[1].Fields()
+	_ = receiptrequestMixinFields1
+	receiptrequestFields := schema.ReceiptRequest{}.Fields()
+	_ = receiptrequestFields
+	// receiptrequestDescCreatedAt is the schema descriptor for created_at field.
+	receiptrequestDescCreatedAt := receiptrequestMixinFields1[0].Descriptor()
+	// receiptrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	receiptrequest.DefaultCreatedAt = receiptrequestDescCreatedAt.Default.(func() time.Time)
+	// receiptrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	receiptrequestDescUpdatedAt := receiptrequestMixinFields1[1].Descriptor()
+	// receiptrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	receiptrequest.DefaultUpdatedAt = receiptrequestDescUpdatedAt.Default.(func() time.Time)
+	// receiptrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	receiptrequest.UpdateDefaultUpdatedAt = receiptrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// receiptrequestDescOrderNo is the schema descriptor for order_no field.
+	receiptrequestDescOrderNo := receiptrequestFields[3].Descriptor()
+	// receiptrequest.DefaultOrderNo holds the default value on creation for the order_no field.
+	receiptrequest.DefaultOrderNo = receiptrequestDescOrderNo.Default.(string)
+	// receiptrequest.OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	receiptrequest.OrderNoValidator = receiptrequestDescOrderNo.Validators[0].(func(string) error)
+	// receiptrequestDescReason is the schema descriptor for reason field.
+	receiptrequestDescReason := receiptrequestFields[6].Descriptor()
+	// receiptrequest.DefaultReason holds the default value on creation for the reason field.
+	receiptrequest.DefaultReason = receiptrequestDescReason.Default.(string)
+	// receiptrequest.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	receiptrequest.ReasonValidator = receiptrequestDescReason.Validators[0].(func(string) error)
+	// receiptrequestDescContactPhone is the schema descriptor for contact_phone field.
+	receiptrequestDescContactPhone := receiptrequestFields[7].Descriptor()
+	// receiptrequest.DefaultContactPhone holds the default value on creation for the contact_phone field.
+	receiptrequest.DefaultContactPhone = receiptrequestDescContactPhone.Default.(string)
+	// receiptrequest.ContactPhoneValidator is a validator for the "contact_phone" field. It is called by the builders before save.
+	receiptrequest.ContactPhoneValidator = receiptrequestDescContactPhone.Validators[0].(func(string) error)
+	// receiptrequestDescInvoiceTitle is the schema descriptor for invoice_title field.
+	receiptrequestDescInvoiceTitle := receiptrequestFields[8].Descriptor()
+	// receiptrequest.DefaultInvoiceTitle holds the default value on creation for the invoice_title field.
+	receiptrequest.DefaultInvoiceTitle = receiptrequestDescInvoiceTitle.Default.(string)
+	// receiptrequest.InvoiceTitleValidator is a validator for the "invoice_title" field. It is called by the builders before save.
+	receiptrequest.InvoiceTitleValidator = receiptrequestDescInvoiceTitle.Validators[0].(func(string) error)
+	// receiptrequestDescInvoiceTaxNo is the schema descriptor for invoice_tax_no field.
+	receiptrequestDescInvoiceTaxNo := receiptrequestFields[9].Descriptor()
+	// receiptrequest.DefaultInvoiceTaxNo holds the default value on creation for the invoice_tax_no field.
+	receiptrequest.DefaultInvoiceTaxNo = receiptrequestDescInvoiceTaxNo.Default.(string)
+	// receiptrequest.InvoiceTaxNoValidator is a validator for the "invoice_tax_no" field. It is called by the builders before save.
+	receiptrequest.InvoiceTaxNoValidator = receiptrequestDescInvoiceTaxNo.Validators[0].(func(string) error)
+	// receiptrequestDescInvoiceEmail is the schema descriptor for invoice_email field.
+	receiptrequestDescInvoiceEmail := receiptrequestFields[10].Descriptor()
+	// receiptrequest.DefaultInvoiceEmail holds the default value on creation for the invoice_email field.
+	receiptrequest.DefaultInvoiceEmail = receiptrequestDescInvoiceEmail.Default.(string)
+	// receiptrequest.InvoiceEmailValidator is a validator for the "invoice_email" field. It is called by the builders before save.
+	receiptrequest.InvoiceEmailValidator = receiptrequestDescInvoiceEmail.Validators[0].(func(string) error)
+	// receiptrequestDescHandleRemark is the schema descriptor for handle_remark field.
+	receiptrequestDescHandleRemark := receiptrequestFields[11].Descriptor()
+	// receiptrequest.DefaultHandleRemark holds the default value on creation for the handle_remark field.
+	receiptrequest.DefaultHandleRemark = receiptrequestDescHandleRemark.Default.(string)
+	// receiptrequest.HandleRemarkValidator is a validator for the "handle_remark" field. It is called by the builders before save.
+	receiptrequest.HandleRemarkValidator = receiptrequestDescHandleRemark.Validators[0].(func(string) error)
+	// receiptrequestDescID is the schema descriptor for id field.
+	receiptrequestDescID := receiptrequestMixinFields0[0].Descriptor()
+	// receiptrequest.DefaultID holds the default value on creation for the id field.
+	receiptrequest.DefaultID = receiptrequestDescID.Default.(func() uuid.UUID)
 	refundorderMixin := schema.RefundOrder{}.Mixin()
 	refundorderMixinHooks2 := refundorderMixin[2].Hooks()
 	refundorder.Hooks[0] = refundorderMixinHooks2[0]
@@ -1897,17 +3361,123 @@ func init() {
 		}
 	}()
 	// remarkDescEnabled is the schema descriptor for enabled field.
-	remarkDescEnabled := remarkFields[2].Descriptor()
+	remarkDescEnabled := remarkFields[3].Descriptor()
 	// remark.DefaultEnabled holds the default value on creation for the enabled field.
 	remark.DefaultEnabled = remarkDescEnabled.Default.(bool)
 	// remarkDescSortOrder is the schema descriptor for sort_order field.
-	remarkDescSortOrder := remarkFields[3].Descriptor()
+	remarkDescSortOrder := remarkFields[4].Descriptor()
 	// remark.DefaultSortOrder holds the default value on creation for the sort_order field.
 	remark.DefaultSortOrder = remarkDescSortOrder.Default.(int)
 	// remarkDescID is the schema descriptor for id field.
 	remarkDescID := remarkMixinFields0[0].Descriptor()
 	// remark.DefaultID holds the default value on creation for the id field.
 	remark.DefaultID = remarkDescID.Default.(func() uuid.UUID)
+	reservationMixin := schema.Reservation{}.Mixin()
+	reservationMixinFields0 := reservationMixin[0].Fields()
+	_ = reservationMixinFields0
+	reservationMixinFields1 := reservationMixin[1].Fields()
+	_ = reservationMixinFields1
+	reservationFields := schema.Reservation{}.Fields()
+	_ = reservationFields
+	// reservationDescCreatedAt is the schema descriptor for created_at field.
+	reservationDescCreatedAt := reservationMixinFields1[0].Descriptor()
+	// reservation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reservation.DefaultCreatedAt = reservationDescCreatedAt.Default.(func() time.Time)
+	// reservationDescUpdatedAt is the schema descriptor for updated_at field.
+	reservationDescUpdatedAt := reservationMixinFields1[1].Descriptor()
+	// reservation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reservation.DefaultUpdatedAt = reservationDescUpdatedAt.Default.(func() time.Time)
+	// reservation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reservation.UpdateDefaultUpdatedAt = reservationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reservationDescCustomerID is the schema descriptor for customer_id field.
+	reservationDescCustomerID := reservationFields[3].Descriptor()
+	// reservation.DefaultCustomerID holds the default value on creation for the customer_id field.
+	reservation.DefaultCustomerID = reservationDescCustomerID.Default.(func() uuid.UUID)
+	// reservationDescGuestName is the schema descriptor for guest_name field.
+	reservationDescGuestName := reservationFields[4].Descriptor()
+	// reservation.GuestNameValidator is a validator for the "guest_name" field. It is called by the builders before save.
+	reservation.GuestNameValidator = reservationDescGuestName.Validators[0].(func(string) error)
+	// reservationDescGuestPhone is the schema descriptor for guest_phone field.
+	reservationDescGuestPhone := reservationFields[5].Descriptor()
+	// reservation.GuestPhoneValidator is a validator for the "guest_phone" field. It is called by the builders before save.
+	reservation.GuestPhoneValidator = reservationDescGuestPhone.Validators[0].(func(string) error)
+	// reservationDescDiningPeriod is the schema descriptor for dining_period field.
+	reservationDescDiningPeriod := reservationFields[9].Descriptor()
+	// reservation.DefaultDiningPeriod holds the default value on creation for the dining_period field.
+	reservation.DefaultDiningPeriod = reservationDescDiningPeriod.Default.(string)
+	// reservation.DiningPeriodValidator is a validator for the "dining_period" field. It is called by the builders before save.
+	reservation.DiningPeriodValidator = reservationDescDiningPeriod.Validators[0].(func(string) error)
+	// reservationDescTableID is the schema descriptor for table_id field.
+	reservationDescTableID := reservationFields[10].Descriptor()
+	// reservation.DefaultTableID holds the default value on creation for the table_id field.
+	reservation.DefaultTableID = reservationDescTableID.Default.(func() uuid.UUID)
+	// reservationDescTableName is the schema descriptor for table_name field.
+	reservationDescTableName := reservationFields[11].Descriptor()
+	// reservation.DefaultTableName holds the default value on creation for the table_name field.
+	reservation.DefaultTableName = reservationDescTableName.Default.(string)
+	// reservation.TableNameValidator is a validator for the "table_name" field. It is called by the builders before save.
+	reservation.TableNameValidator = reservationDescTableName.Validators[0].(func(string) error)
+	// reservationDescAreaPreference is the schema descriptor for area_preference field.
+	reservationDescAreaPreference := reservationFields[12].Descriptor()
+	// reservation.DefaultAreaPreference holds the default value on creation for the area_preference field.
+	reservation.DefaultAreaPreference = reservationDescAreaPreference.Default.(string)
+	// reservation.AreaPreferenceValidator is a validator for the "area_preference" field. It is called by the builders before save.
+	reservation.AreaPreferenceValidator = reservationDescAreaPreference.Validators[0].(func(string) error)
+	// reservationDescRemark is the schema descriptor for remark field.
+	reservationDescRemark := reservationFields[13].Descriptor()
+	// reservation.DefaultRemark holds the default value on creation for the remark field.
+	reservation.DefaultRemark = reservationDescRemark.Default.(string)
+	// reservation.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	reservation.RemarkValidator = reservationDescRemark.Validators[0].(func(string) error)
+	// reservationDescDepositMethod is the schema descriptor for deposit_method field.
+	reservationDescDepositMethod := reservationFields[17].Descriptor()
+	// reservation.DefaultDepositMethod holds the default value on creation for the deposit_method field.
+	reservation.DefaultDepositMethod = domain.PaymentMethodPayType(reservationDescDepositMethod.Default.(string))
+	// reservation.DepositMethodValidator is a validator for the "deposit_method" field. It is called by the builders before save.
+	reservation.DepositMethodValidator = reservationDescDepositMethod.Validators[0].(func(string) error)
+	// reservationDescOrderID is the schema descriptor for order_id field.
+	reservationDescOrderID := reservationFields[19].Descriptor()
+	// reservation.DefaultOrderID holds the default value on creation for the order_id field.
+	reservation.DefaultOrderID = reservationDescOrderID.Default.(func() uuid.UUID)
+	// reservationDescCancelReason is the schema descriptor for cancel_reason field.
+	reservationDescCancelReason := reservationFields[22].Descriptor()
+	// reservation.DefaultCancelReason holds the default value on creation for the cancel_reason field.
+	reservation.DefaultCancelReason = reservationDescCancelReason.Default.(string)
+	// reservation.CancelReasonValidator is a validator for the "cancel_reason" field. It is called by the builders before save.
+	reservation.CancelReasonValidator = reservationDescCancelReason.Validators[0].(func(string) error)
+	// reservationDescID is the schema descriptor for id field.
+	reservationDescID := reservationMixinFields0[0].Descriptor()
+	// reservation.DefaultID holds the default value on creation for the id field.
+	reservation.DefaultID = reservationDescID.Default.(func() uuid.UUID)
+	reservationruleMixin := schema.ReservationRule{}.Mixin()
+	reservationruleMixinFields0 := reservationruleMixin[0].Fields()
+	_ = reservationruleMixinFields0
+	reservationruleMixinFields1 := reservationruleMixin[1].Fields()
+	_ = reservationruleMixinFields1
+	reservationruleFields := schema.ReservationRule{}.Fields()
+	_ = reservationruleFields
+	// reservationruleDescCreatedAt is the schema descriptor for created_at field.
+	reservationruleDescCreatedAt := reservationruleMixinFields1[0].Descriptor()
+	// reservationrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reservationrule.DefaultCreatedAt = reservationruleDescCreatedAt.Default.(func() time.Time)
+	// reservationruleDescUpdatedAt is the schema descriptor for updated_at field.
+	reservationruleDescUpdatedAt := reservationruleMixinFields1[1].Descriptor()
+	// reservationrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reservationrule.DefaultUpdatedAt = reservationruleDescUpdatedAt.Default.(func() time.Time)
+	// reservationrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reservationrule.UpdateDefaultUpdatedAt = reservationruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reservationruleDescOnlineEnabled is the schema descriptor for online_enabled field.
+	reservationruleDescOnlineEnabled := reservationruleFields[2].Descriptor()
+	// reservationrule.DefaultOnlineEnabled holds the default value on creation for the online_enabled field.
+	reservationrule.DefaultOnlineEnabled = reservationruleDescOnlineEnabled.Default.(bool)
+	// reservationruleDescMinAdvanceMinutes is the schema descriptor for min_advance_minutes field.
+	reservationruleDescMinAdvanceMinutes := reservationruleFields[5].Descriptor()
+	// reservationrule.DefaultMinAdvanceMinutes holds the default value on creation for the min_advance_minutes field.
+	reservationrule.DefaultMinAdvanceMinutes = reservationruleDescMinAdvanceMinutes.Default.(int)
+	// reservationruleDescID is the schema descriptor for id field.
+	reservationruleDescID := reservationruleMixinFields0[0].Descriptor()
+	// reservationrule.DefaultID holds the default value on creation for the id field.
+	reservationrule.DefaultID = reservationruleDescID.Default.(func() uuid.UUID)
 	roleMixin := schema.Role{}.Mixin()
 	roleMixinHooks2 := roleMixin[2].Hooks()
 	role.Hooks[0] = roleMixinHooks2[0]
@@ -2082,6 +3652,31 @@ func init() {
 	routermenuDescID := routermenuMixinFields0[0].Descriptor()
 	// routermenu.DefaultID holds the default value on creation for the id field.
 	routermenu.DefaultID = routermenuDescID.Default.(func() uuid.UUID)
+	scanorderruleMixin := schema.ScanOrderRule{}.Mixin()
+	scanorderruleMixinFields0 := scanorderruleMixin[0].Fields()
+	_ = scanorderruleMixinFields0
+	scanorderruleMixinFields1 := scanorderruleMixin[1].Fields()
+	_ = scanorderruleMixinFields1
+	scanorderruleFields := schema.ScanOrderRule{}.Fields()
+	_ = scanorderruleFields
+	// scanorderruleDescCreatedAt is the schema descriptor for created_at field.
+	scanorderruleDescCreatedAt := scanorderruleMixinFields1[0].Descriptor()
+	// scanorderrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scanorderrule.DefaultCreatedAt = scanorderruleDescCreatedAt.Default.(func() time.Time)
+	// scanorderruleDescUpdatedAt is the schema descriptor for updated_at field.
+	scanorderruleDescUpdatedAt := scanorderruleMixinFields1[1].Descriptor()
+	// scanorderrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scanorderrule.DefaultUpdatedAt = scanorderruleDescUpdatedAt.Default.(func() time.Time)
+	// scanorderrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scanorderrule.UpdateDefaultUpdatedAt = scanorderruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scanorderruleDescEnabled is the schema descriptor for enabled field.
+	scanorderruleDescEnabled := scanorderruleFields[2].Descriptor()
+	// scanorderrule.DefaultEnabled holds the default value on creation for the enabled field.
+	scanorderrule.DefaultEnabled = scanorderruleDescEnabled.Default.(bool)
+	// scanorderruleDescID is the schema descriptor for id field.
+	scanorderruleDescID := scanorderruleMixinFields0[0].Descriptor()
+	// scanorderrule.DefaultID holds the default value on creation for the id field.
+	scanorderrule.DefaultID = scanorderruleDescID.Default.(func() uuid.UUID)
 	setmealdetailMixin := schema.SetMealDetail{}.Mixin()
 	setmealdetailMixinHooks2 := setmealdetailMixin[2].Hooks()
 	setmealdetail.Hooks[0] = setmealdetailMixinHooks2[0]
@@ -2626,6 +4221,88 @@ func init() {
 	userroleDescID := userroleMixinFields0[0].Descriptor()
 	// userrole.DefaultID holds the default value on creation for the id field.
 	userrole.DefaultID = userroleDescID.Default.(func() uuid.UUID)
+	waitlistruleMixin := schema.WaitlistRule{}.Mixin()
+	waitlistruleMixinFields0 := waitlistruleMixin[0].Fields()
+	_ = waitlistruleMixinFields0
+	waitlistruleMixinFields1 := waitlistruleMixin[1].Fields()
+	_ = waitlistruleMixinFields1
+	waitlistruleFields := schema.WaitlistRule{}.Fields()
+	_ = waitlistruleFields
+	// waitlistruleDescCreatedAt is the schema descriptor for created_at field.
+	waitlistruleDescCreatedAt := waitlistruleMixinFields1[0].Descriptor()
+	// waitlistrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	waitlistrule.DefaultCreatedAt = waitlistruleDescCreatedAt.Default.(func() time.Time)
+	// waitlistruleDescUpdatedAt is the schema descriptor for updated_at field.
+	waitlistruleDescUpdatedAt := waitlistruleMixinFields1[1].Descriptor()
+	// waitlistrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	waitlistrule.DefaultUpdatedAt = waitlistruleDescUpdatedAt.Default.(func() time.Time)
+	// waitlistrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	waitlistrule.UpdateDefaultUpdatedAt = waitlistruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// waitlistruleDescOnlineEnabled is the schema descriptor for online_enabled field.
+	waitlistruleDescOnlineEnabled := waitlistruleFields[2].Descriptor()
+	// waitlistrule.DefaultOnlineEnabled holds the default value on creation for the online_enabled field.
+	waitlistrule.DefaultOnlineEnabled = waitlistruleDescOnlineEnabled.Default.(bool)
+	// waitlistruleDescID is the schema descriptor for id field.
+	waitlistruleDescID := waitlistruleMixinFields0[0].Descriptor()
+	// waitlistrule.DefaultID holds the default value on creation for the id field.
+	waitlistrule.DefaultID = waitlistruleDescID.Default.(func() uuid.UUID)
+	waitlistticketMixin := schema.WaitlistTicket{}.Mixin()
+	waitlistticketMixinFields0 := waitlistticketMixin[0].Fields()
+	_ = waitlistticketMixinFields0
+	waitlistticketMixinFields1 := waitlistticketMixin[1].Fields()
+	_ = waitlistticketMixinFields1
+	waitlistticketFields := schema.WaitlistTicket{}.Fields()
+	_ = waitlistticketFields
+	// waitlistticketDescCreatedAt is the schema descriptor for created_at field.
+	waitlistticketDescCreatedAt := waitlistticketMixinFields1[0].Descriptor()
+	// waitlistticket.DefaultCreatedAt holds the default value on creation for the created_at field.
+	waitlistticket.DefaultCreatedAt = waitlistticketDescCreatedAt.Default.(func() time.Time)
+	// waitlistticketDescUpdatedAt is the schema descriptor for updated_at field.
+	waitlistticketDescUpdatedAt := waitlistticketMixinFields1[1].Descriptor()
+	// waitlistticket.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	waitlistticket.DefaultUpdatedAt = waitlistticketDescUpdatedAt.Default.(func() time.Time)
+	// waitlistticket.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	waitlistticket.UpdateDefaultUpdatedAt = waitlistticketDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// waitlistticketDescBusinessDate is the schema descriptor for business_date field.
+	waitlistticketDescBusinessDate := waitlistticketFields[2].Descriptor()
+	// waitlistticket.BusinessDateValidator is a validator for the "business_date" field. It is called by the builders before save.
+	waitlistticket.BusinessDateValidator = waitlistticketDescBusinessDate.Validators[0].(func(string) error)
+	// waitlistticketDescTicketNo is the schema descriptor for ticket_no field.
+	waitlistticketDescTicketNo := waitlistticketFields[4].Descriptor()
+	// waitlistticket.TicketNoValidator is a validator for the "ticket_no" field. It is called by the builders before save.
+	waitlistticket.TicketNoValidator = waitlistticketDescTicketNo.Validators[0].(func(string) error)
+	// waitlistticketDescGuestPhone is the schema descriptor for guest_phone field.
+	waitlistticketDescGuestPhone := waitlistticketFields[6].Descriptor()
+	// waitlistticket.DefaultGuestPhone holds the default value on creation for the guest_phone field.
+	waitlistticket.DefaultGuestPhone = waitlistticketDescGuestPhone.Default.(string)
+	// waitlistticket.GuestPhoneValidator is a validator for the "guest_phone" field. It is called by the builders before save.
+	waitlistticket.GuestPhoneValidator = waitlistticketDescGuestPhone.Validators[0].(func(string) error)
+	// waitlistticketDescCustomerID is the schema descriptor for customer_id field.
+	waitlistticketDescCustomerID := waitlistticketFields[8].Descriptor()
+	// waitlistticket.DefaultCustomerID holds the default value on creation for the customer_id field.
+	waitlistticket.DefaultCustomerID = waitlistticketDescCustomerID.Default.(func() uuid.UUID)
+	// waitlistticketDescCallCount is the schema descriptor for call_count field.
+	waitlistticketDescCallCount := waitlistticketFields[10].Descriptor()
+	// waitlistticket.DefaultCallCount holds the default value on creation for the call_count field.
+	waitlistticket.DefaultCallCount = waitlistticketDescCallCount.Default.(int)
+	// waitlistticketDescTableID is the schema descriptor for table_id field.
+	waitlistticketDescTableID := waitlistticketFields[14].Descriptor()
+	// waitlistticket.DefaultTableID holds the default value on creation for the table_id field.
+	waitlistticket.DefaultTableID = waitlistticketDescTableID.Default.(func() uuid.UUID)
+	// waitlistticketDescTableName is the schema descriptor for table_name field.
+	waitlistticketDescTableName := waitlistticketFields[15].Descriptor()
+	// waitlistticket.DefaultTableName holds the default value on creation for the table_name field.
+	waitlistticket.DefaultTableName = waitlistticketDescTableName.Default.(string)
+	// waitlistticket.TableNameValidator is a validator for the "table_name" field. It is called by the builders before save.
+	waitlistticket.TableNameValidator = waitlistticketDescTableName.Validators[0].(func(string) error)
+	// waitlistticketDescOrderID is the schema descriptor for order_id field.
+	waitlistticketDescOrderID := waitlistticketFields[16].Descriptor()
+	// waitlistticket.DefaultOrderID holds the default value on creation for the order_id field.
+	waitlistticket.DefaultOrderID = waitlistticketDescOrderID.Default.(func() uuid.UUID)
+	// waitlistticketDescID is the schema descriptor for id field.
+	waitlistticketDescID := waitlistticketMixinFields0[0].Descriptor()
+	// waitlistticket.DefaultID holds the default value on creation for the id field.
+	waitlistticket.DefaultID = waitlistticketDescID.Default.(func() uuid.UUID)
 }
 
 const (
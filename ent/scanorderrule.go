@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/scanorderrule"
+)
+
+// ScanOrderRule is the model entity for the ScanOrderRule schema.
+type ScanOrderRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID，为空表示品牌商默认规则
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 是否开启扫码点餐
+	Enabled bool `json:"enabled,omitempty"`
+	// 支付方式：pay_first=先付后吃；pay_at_counter=前台结账
+	PayMode      domain.ScanOrderPayMode `json:"pay_mode,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScanOrderRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scanorderrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case scanorderrule.FieldPayMode:
+			values[i] = new(sql.NullString)
+		case scanorderrule.FieldCreatedAt, scanorderrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case scanorderrule.FieldID, scanorderrule.FieldMerchantID, scanorderrule.FieldStoreID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScanOrderRule fields.
+func (sor *ScanOrderRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scanorderrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sor.ID = *value
+			}
+		case scanorderrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sor.CreatedAt = value.Time
+			}
+		case scanorderrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sor.UpdatedAt = value.Time
+			}
+		case scanorderrule.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				sor.MerchantID = *value
+			}
+		case scanorderrule.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				sor.StoreID = *value
+			}
+		case scanorderrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				sor.Enabled = value.Bool
+			}
+		case scanorderrule.FieldPayMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pay_mode", values[i])
+			} else if value.Valid {
+				sor.PayMode = domain.ScanOrderPayMode(value.String)
+			}
+		default:
+			sor.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScanOrderRule.
+// This includes values selected through modifiers, order, etc.
+func (sor *ScanOrderRule) Value(name string) (ent.Value, error) {
+	return sor.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ScanOrderRule.
+// Note that you need to call ScanOrderRule.Unwrap() before calling this method if this ScanOrderRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sor *ScanOrderRule) Update() *ScanOrderRuleUpdateOne {
+	return NewScanOrderRuleClient(sor.config).UpdateOne(sor)
+}
+
+// Unwrap unwraps the ScanOrderRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sor *ScanOrderRule) Unwrap() *ScanOrderRule {
+	_tx, ok := sor.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScanOrderRule is not a transactional entity")
+	}
+	sor.config.driver = _tx.drv
+	return sor
+}
+
+// String implements the fmt.Stringer.
+func (sor *ScanOrderRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScanOrderRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sor.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sor.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sor.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", sor.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", sor.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", sor.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("pay_mode=")
+	builder.WriteString(fmt.Sprintf("%v", sor.PayMode))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScanOrderRules is a parsable slice of ScanOrderRule.
+type ScanOrderRules []*ScanOrderRule
@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/scanorderrule"
+)
+
+// ScanOrderRuleCreate is the builder for creating a ScanOrderRule entity.
+type ScanOrderRuleCreate struct {
+	config
+	mutation *ScanOrderRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sorc *ScanOrderRuleCreate) SetCreatedAt(t time.Time) *ScanOrderRuleCreate {
+	sorc.mutation.SetCreatedAt(t)
+	return sorc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sorc *ScanOrderRuleCreate) SetNillableCreatedAt(t *time.Time) *ScanOrderRuleCreate {
+	if t != nil {
+		sorc.SetCreatedAt(*t)
+	}
+	return sorc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sorc *ScanOrderRuleCreate) SetUpdatedAt(t time.Time) *ScanOrderRuleCreate {
+	sorc.mutation.SetUpdatedAt(t)
+	return sorc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sorc *ScanOrderRuleCreate) SetNillableUpdatedAt(t *time.Time) *ScanOrderRuleCreate {
+	if t != nil {
+		sorc.SetUpdatedAt(*t)
+	}
+	return sorc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (sorc *ScanOrderRuleCreate) SetMerchantID(u uuid.UUID) *ScanOrderRuleCreate {
+	sorc.mutation.SetMerchantID(u)
+	return sorc
+}
+
+// SetStoreID sets the "store_id" field.
+func (sorc *ScanOrderRuleCreate) SetStoreID(u uuid.UUID) *ScanOrderRuleCreate {
+	sorc.mutation.SetStoreID(u)
+	return sorc
+}
+
+// SetEnabled sets the "enabled" field.
+func (sorc *ScanOrderRuleCreate) SetEnabled(b bool) *ScanOrderRuleCreate {
+	sorc.mutation.SetEnabled(b)
+	return sorc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (sorc *ScanOrderRuleCreate) SetNillableEnabled(b *bool) *ScanOrderRuleCreate {
+	if b != nil {
+		sorc.SetEnabled(*b)
+	}
+	return sorc
+}
+
+// SetPayMode sets the "pay_mode" field.
+func (sorc *ScanOrderRuleCreate) SetPayMode(dopm domain.ScanOrderPayMode) *ScanOrderRuleCreate {
+	sorc.mutation.SetPayMode(dopm)
+	return sorc
+}
+
+// SetID sets the "id" field.
+func (sorc *ScanOrderRuleCreate) SetID(u uuid.UUID) *ScanOrderRuleCreate {
+	sorc.mutation.SetID(u)
+	return sorc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sorc *ScanOrderRuleCreate) SetNillableID(u *uuid.UUID) *ScanOrderRuleCreate {
+	if u != nil {
+		sorc.SetID(*u)
+	}
+	return sorc
+}
+
+// Mutation returns the ScanOrderRuleMutation object of the builder.
+func (sorc *ScanOrderRuleCreate) Mutation() *ScanOrderRuleMutation {
+	return sorc.mutation
+}
+
+// Save creates the ScanOrderRule in the database.
+func (sorc *ScanOrderRuleCreate) Save(ctx context.Context) (*ScanOrderRule, error) {
+	sorc.defaults()
+	return withHooks(ctx, sorc.sqlSave, sorc.mutation, sorc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sorc *ScanOrderRuleCreate) SaveX(ctx context.Context) *ScanOrderRule {
+	v, err := sorc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sorc *ScanOrderRuleCreate) Exec(ctx context.Context) error {
+	_, err := sorc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sorc *ScanOrderRuleCreate) ExecX(ctx context.Context) {
+	if err := sorc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sorc *ScanOrderRuleCreate) defaults() {
+	if _, ok := sorc.mutation.CreatedAt(); !ok {
+		v := scanorderrule.DefaultCreatedAt()
+		sorc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sorc.mutation.UpdatedAt(); !ok {
+		v := scanorderrule.DefaultUpdatedAt()
+		sorc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sorc.mutation.Enabled(); !ok {
+		v := scanorderrule.DefaultEnabled
+		sorc.mutation.SetEnabled(v)
+	}
+	if _, ok := sorc.mutation.ID(); !ok {
+		v := scanorderrule.DefaultID()
+		sorc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sorc *ScanOrderRuleCreate) check() error {
+	if _, ok := sorc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScanOrderRule.created_at"`)}
+	}
+	if _, ok := sorc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScanOrderRule.updated_at"`)}
+	}
+	if _, ok := sorc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "ScanOrderRule.merchant_id"`)}
+	}
+	if _, ok := sorc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "ScanOrderRule.store_id"`)}
+	}
+	if _, ok := sorc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ScanOrderRule.enabled"`)}
+	}
+	if _, ok := sorc.mutation.PayMode(); !ok {
+		return &ValidationError{Name: "pay_mode", err: errors.New(`ent: missing required field "ScanOrderRule.pay_mode"`)}
+	}
+	if v, ok := sorc.mutation.PayMode(); ok {
+		if err := scanorderrule.PayModeValidator(v); err != nil {
+			return &ValidationError{Name: "pay_mode", err: fmt.Errorf(`ent: validator failed for field "ScanOrderRule.pay_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sorc *ScanOrderRuleCreate) sqlSave(ctx context.Context) (*ScanOrderRule, error) {
+	if err := sorc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sorc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sorc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sorc.mutation.id = &_node.ID
+	sorc.mutation.done = true
+	return _node, nil
+}
+
+func (sorc *ScanOrderRuleCreate) createSpec() (*ScanOrderRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScanOrderRule{config: sorc.config}
+		_spec = sqlgraph.NewCreateSpec(scanorderrule.Table, sqlgraph.NewFieldSpec(scanorderrule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sorc.conflict
+	if id, ok := sorc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sorc.mutation.CreatedAt(); ok {
+		_spec.SetField(scanorderrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sorc.mutation.UpdatedAt(); ok {
+		_spec.SetField(scanorderrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sorc.mutation.MerchantID(); ok {
+		_spec.SetField(scanorderrule.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := sorc.mutation.StoreID(); ok {
+		_spec.SetField(scanorderrule.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := sorc.mutation.Enabled(); ok {
+		_spec.SetField(scanorderrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := sorc.mutation.PayMode(); ok {
+		_spec.SetField(scanorderrule.FieldPayMode, field.TypeEnum, value)
+		_node.PayMode = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScanOrderRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScanOrderRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sorc *ScanOrderRuleCreate) OnConflict(opts ...sql.ConflictOption) *ScanOrderRuleUpsertOne {
+	sorc.conflict = opts
+	return &ScanOrderRuleUpsertOne{
+		create: sorc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScanOrderRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sorc *ScanOrderRuleCreate) OnConflictColumns(columns ...string) *ScanOrderRuleUpsertOne {
+	sorc.conflict = append(sorc.conflict, sql.ConflictColumns(columns...))
+	return &ScanOrderRuleUpsertOne{
+		create: sorc,
+	}
+}
+
+type (
+	// ScanOrderRuleUpsertOne is the builder for "upsert"-ing
+	//  one ScanOrderRule node.
+	ScanOrderRuleUpsertOne struct {
+		create *ScanOrderRuleCreate
+	}
+
+	// ScanOrderRuleUpsert is the "OnConflict" setter.
+	ScanOrderRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScanOrderRuleUpsert) SetUpdatedAt(v time.Time) *ScanOrderRuleUpsert {
+	u.Set(scanorderrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScanOrderRuleUpsert) UpdateUpdatedAt() *ScanOrderRuleUpsert {
+	u.SetExcluded(scanorderrule.FieldUpdatedAt)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScanOrderRuleUpsert) SetEnabled(v bool) *ScanOrderRuleUpsert {
+	u.Set(scanorderrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScanOrderRuleUpsert) UpdateEnabled() *ScanOrderRuleUpsert {
+	u.SetExcluded(scanorderrule.FieldEnabled)
+	return u
+}
+
+// SetPayMode sets the "pay_mode" field.
+func (u *ScanOrderRuleUpsert) SetPayMode(v domain.ScanOrderPayMode) *ScanOrderRuleUpsert {
+	u.Set(scanorderrule.FieldPayMode, v)
+	return u
+}
+
+// UpdatePayMode sets the "pay_mode" field to the value that was provided on create.
+func (u *ScanOrderRuleUpsert) UpdatePayMode() *ScanOrderRuleUpsert {
+	u.SetExcluded(scanorderrule.FieldPayMode)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScanOrderRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scanorderrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScanOrderRuleUpsertOne) UpdateNewValues() *ScanOrderRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(scanorderrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(scanorderrule.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(scanorderrule.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(scanorderrule.FieldStoreID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScanOrderRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScanOrderRuleUpsertOne) Ignore() *ScanOrderRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScanOrderRuleUpsertOne) DoNothing() *ScanOrderRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScanOrderRuleCreate.OnConflict
+// documentation for more info.
+func (u *ScanOrderRuleUpsertOne) Update(set func(*ScanOrderRuleUpsert)) *ScanOrderRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScanOrderRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScanOrderRuleUpsertOne) SetUpdatedAt(v time.Time) *ScanOrderRuleUpsertOne {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScanOrderRuleUpsertOne) UpdateUpdatedAt() *ScanOrderRuleUpsertOne {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScanOrderRuleUpsertOne) SetEnabled(v bool) *ScanOrderRuleUpsertOne {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScanOrderRuleUpsertOne) UpdateEnabled() *ScanOrderRuleUpsertOne {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPayMode sets the "pay_mode" field.
+func (u *ScanOrderRuleUpsertOne) SetPayMode(v domain.ScanOrderPayMode) *ScanOrderRuleUpsertOne {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.SetPayMode(v)
+	})
+}
+
+// UpdatePayMode sets the "pay_mode" field to the value that was provided on create.
+func (u *ScanOrderRuleUpsertOne) UpdatePayMode() *ScanOrderRuleUpsertOne {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.UpdatePayMode()
+	})
+}
+
+// Exec executes the query.
+func (u *ScanOrderRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScanOrderRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScanOrderRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScanOrderRuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ScanOrderRuleUpsertOne.ID is not supported by MySQL driver. Use ScanOrderRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScanOrderRuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScanOrderRuleCreateBulk is the builder for creating many ScanOrderRule entities in bulk.
+type ScanOrderRuleCreateBulk struct {
+	config
+	err      error
+	builders []*ScanOrderRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScanOrderRule entities in the database.
+func (sorcb *ScanOrderRuleCreateBulk) Save(ctx context.Context) ([]*ScanOrderRule, error) {
+	if sorcb.err != nil {
+		return nil, sorcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sorcb.builders))
+	nodes := make([]*ScanOrderRule, len(sorcb.builders))
+	mutators := make([]Mutator, len(sorcb.builders))
+	for i := range sorcb.builders {
+		func(i int, root context.Context) {
+			builder := sorcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScanOrderRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sorcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sorcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sorcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sorcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sorcb *ScanOrderRuleCreateBulk) SaveX(ctx context.Context) []*ScanOrderRule {
+	v, err := sorcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sorcb *ScanOrderRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := sorcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sorcb *ScanOrderRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := sorcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScanOrderRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScanOrderRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sorcb *ScanOrderRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScanOrderRuleUpsertBulk {
+	sorcb.conflict = opts
+	return &ScanOrderRuleUpsertBulk{
+		create: sorcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScanOrderRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sorcb *ScanOrderRuleCreateBulk) OnConflictColumns(columns ...string) *ScanOrderRuleUpsertBulk {
+	sorcb.conflict = append(sorcb.conflict, sql.ConflictColumns(columns...))
+	return &ScanOrderRuleUpsertBulk{
+		create: sorcb,
+	}
+}
+
+// ScanOrderRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScanOrderRule nodes.
+type ScanOrderRuleUpsertBulk struct {
+	create *ScanOrderRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScanOrderRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scanorderrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScanOrderRuleUpsertBulk) UpdateNewValues() *ScanOrderRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(scanorderrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(scanorderrule.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(scanorderrule.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(scanorderrule.FieldStoreID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScanOrderRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScanOrderRuleUpsertBulk) Ignore() *ScanOrderRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScanOrderRuleUpsertBulk) DoNothing() *ScanOrderRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScanOrderRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScanOrderRuleUpsertBulk) Update(set func(*ScanOrderRuleUpsert)) *ScanOrderRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScanOrderRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScanOrderRuleUpsertBulk) SetUpdatedAt(v time.Time) *ScanOrderRuleUpsertBulk {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScanOrderRuleUpsertBulk) UpdateUpdatedAt() *ScanOrderRuleUpsertBulk {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScanOrderRuleUpsertBulk) SetEnabled(v bool) *ScanOrderRuleUpsertBulk {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScanOrderRuleUpsertBulk) UpdateEnabled() *ScanOrderRuleUpsertBulk {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPayMode sets the "pay_mode" field.
+func (u *ScanOrderRuleUpsertBulk) SetPayMode(v domain.ScanOrderPayMode) *ScanOrderRuleUpsertBulk {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.SetPayMode(v)
+	})
+}
+
+// UpdatePayMode sets the "pay_mode" field to the value that was provided on create.
+func (u *ScanOrderRuleUpsertBulk) UpdatePayMode() *ScanOrderRuleUpsertBulk {
+	return u.Update(func(s *ScanOrderRuleUpsert) {
+		s.UpdatePayMode()
+	})
+}
+
+// Exec executes the query.
+func (u *ScanOrderRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScanOrderRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScanOrderRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScanOrderRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
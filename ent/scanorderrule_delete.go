@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/scanorderrule"
+)
+
+// ScanOrderRuleDelete is the builder for deleting a ScanOrderRule entity.
+type ScanOrderRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScanOrderRuleMutation
+}
+
+// Where appends a list predicates to the ScanOrderRuleDelete builder.
+func (sord *ScanOrderRuleDelete) Where(ps ...predicate.ScanOrderRule) *ScanOrderRuleDelete {
+	sord.mutation.Where(ps...)
+	return sord
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sord *ScanOrderRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sord.sqlExec, sord.mutation, sord.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sord *ScanOrderRuleDelete) ExecX(ctx context.Context) int {
+	n, err := sord.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sord *ScanOrderRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(scanorderrule.Table, sqlgraph.NewFieldSpec(scanorderrule.FieldID, field.TypeUUID))
+	if ps := sord.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sord.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sord.mutation.done = true
+	return affected, err
+}
+
+// ScanOrderRuleDeleteOne is the builder for deleting a single ScanOrderRule entity.
+type ScanOrderRuleDeleteOne struct {
+	sord *ScanOrderRuleDelete
+}
+
+// Where appends a list predicates to the ScanOrderRuleDelete builder.
+func (sordo *ScanOrderRuleDeleteOne) Where(ps ...predicate.ScanOrderRule) *ScanOrderRuleDeleteOne {
+	sordo.sord.mutation.Where(ps...)
+	return sordo
+}
+
+// Exec executes the deletion query.
+func (sordo *ScanOrderRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := sordo.sord.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{scanorderrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sordo *ScanOrderRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := sordo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/scanorderrule"
+)
+
+// ScanOrderRuleQuery is the builder for querying ScanOrderRule entities.
+type ScanOrderRuleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []scanorderrule.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ScanOrderRule
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScanOrderRuleQuery builder.
+func (sorq *ScanOrderRuleQuery) Where(ps ...predicate.ScanOrderRule) *ScanOrderRuleQuery {
+	sorq.predicates = append(sorq.predicates, ps...)
+	return sorq
+}
+
+// Limit the number of records to be returned by this query.
+func (sorq *ScanOrderRuleQuery) Limit(limit int) *ScanOrderRuleQuery {
+	sorq.ctx.Limit = &limit
+	return sorq
+}
+
+// Offset to start from.
+func (sorq *ScanOrderRuleQuery) Offset(offset int) *ScanOrderRuleQuery {
+	sorq.ctx.Offset = &offset
+	return sorq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sorq *ScanOrderRuleQuery) Unique(unique bool) *ScanOrderRuleQuery {
+	sorq.ctx.Unique = &unique
+	return sorq
+}
+
+// Order specifies how the records should be ordered.
+func (sorq *ScanOrderRuleQuery) Order(o ...scanorderrule.OrderOption) *ScanOrderRuleQuery {
+	sorq.order = append(sorq.order, o...)
+	return sorq
+}
+
+// First returns the first ScanOrderRule entity from the query.
+// Returns a *NotFoundError when no ScanOrderRule was found.
+func (sorq *ScanOrderRuleQuery) First(ctx context.Context) (*ScanOrderRule, error) {
+	nodes, err := sorq.Limit(1).All(setContextOp(ctx, sorq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{scanorderrule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sorq *ScanOrderRuleQuery) FirstX(ctx context.Context) *ScanOrderRule {
+	node, err := sorq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScanOrderRule ID from the query.
+// Returns a *NotFoundError when no ScanOrderRule ID was found.
+func (sorq *ScanOrderRuleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sorq.Limit(1).IDs(setContextOp(ctx, sorq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{scanorderrule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sorq *ScanOrderRuleQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := sorq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScanOrderRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScanOrderRule entity is found.
+// Returns a *NotFoundError when no ScanOrderRule entities are found.
+func (sorq *ScanOrderRuleQuery) Only(ctx context.Context) (*ScanOrderRule, error) {
+	nodes, err := sorq.Limit(2).All(setContextOp(ctx, sorq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{scanorderrule.Label}
+	default:
+		return nil, &NotSingularError{scanorderrule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sorq *ScanOrderRuleQuery) OnlyX(ctx context.Context) *ScanOrderRule {
+	node, err := sorq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScanOrderRule ID in the query.
+// Returns a *NotSingularError when more than one ScanOrderRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sorq *ScanOrderRuleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sorq.Limit(2).IDs(setContextOp(ctx, sorq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{scanorderrule.Label}
+	default:
+		err = &NotSingularError{scanorderrule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sorq *ScanOrderRuleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := sorq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScanOrderRules.
+func (sorq *ScanOrderRuleQuery) All(ctx context.Context) ([]*ScanOrderRule, error) {
+	ctx = setContextOp(ctx, sorq.ctx, ent.OpQueryAll)
+	if err := sorq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScanOrderRule, *ScanOrderRuleQuery]()
+	return withInterceptors[[]*ScanOrderRule](ctx, sorq, qr, sorq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sorq *ScanOrderRuleQuery) AllX(ctx context.Context) []*ScanOrderRule {
+	nodes, err := sorq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScanOrderRule IDs.
+func (sorq *ScanOrderRuleQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if sorq.ctx.Unique == nil && sorq.path != nil {
+		sorq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sorq.ctx, ent.OpQueryIDs)
+	if err = sorq.Select(scanorderrule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sorq *ScanOrderRuleQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := sorq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sorq *ScanOrderRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sorq.ctx, ent.OpQueryCount)
+	if err := sorq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sorq, querierCount[*ScanOrderRuleQuery](), sorq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sorq *ScanOrderRuleQuery) CountX(ctx context.Context) int {
+	count, err := sorq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sorq *ScanOrderRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sorq.ctx, ent.OpQueryExist)
+	switch _, err := sorq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sorq *ScanOrderRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := sorq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScanOrderRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sorq *ScanOrderRuleQuery) Clone() *ScanOrderRuleQuery {
+	if sorq == nil {
+		return nil
+	}
+	return &ScanOrderRuleQuery{
+		config:     sorq.config,
+		ctx:        sorq.ctx.Clone(),
+		order:      append([]scanorderrule.OrderOption{}, sorq.order...),
+		inters:     append([]Interceptor{}, sorq.inters...),
+		predicates: append([]predicate.ScanOrderRule{}, sorq.predicates...),
+		// clone intermediate query.
+		sql:       sorq.sql.Clone(),
+		path:      sorq.path,
+		modifiers: append([]func(*sql.Selector){}, sorq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScanOrderRule.Query().
+//		GroupBy(scanorderrule.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (sorq *ScanOrderRuleQuery) GroupBy(field string, fields ...string) *ScanOrderRuleGroupBy {
+	sorq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScanOrderRuleGroupBy{build: sorq}
+	grbuild.flds = &sorq.ctx.Fields
+	grbuild.label = scanorderrule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ScanOrderRule.Query().
+//		Select(scanorderrule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (sorq *ScanOrderRuleQuery) Select(fields ...string) *ScanOrderRuleSelect {
+	sorq.ctx.Fields = append(sorq.ctx.Fields, fields...)
+	sbuild := &ScanOrderRuleSelect{ScanOrderRuleQuery: sorq}
+	sbuild.label = scanorderrule.Label
+	sbuild.flds, sbuild.scan = &sorq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScanOrderRuleSelect configured with the given aggregations.
+func (sorq *ScanOrderRuleQuery) Aggregate(fns ...AggregateFunc) *ScanOrderRuleSelect {
+	return sorq.Select().Aggregate(fns...)
+}
+
+func (sorq *ScanOrderRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sorq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sorq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sorq.ctx.Fields {
+		if !scanorderrule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sorq.path != nil {
+		prev, err := sorq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sorq.sql = prev
+	}
+	return nil
+}
+
+func (sorq *ScanOrderRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScanOrderRule, error) {
+	var (
+		nodes = []*ScanOrderRule{}
+		_spec = sorq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScanOrderRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScanOrderRule{config: sorq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(sorq.modifiers) > 0 {
+		_spec.Modifiers = sorq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sorq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (sorq *ScanOrderRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sorq.querySpec()
+	if len(sorq.modifiers) > 0 {
+		_spec.Modifiers = sorq.modifiers
+	}
+	_spec.Node.Columns = sorq.ctx.Fields
+	if len(sorq.ctx.Fields) > 0 {
+		_spec.Unique = sorq.ctx.Unique != nil && *sorq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sorq.driver, _spec)
+}
+
+func (sorq *ScanOrderRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(scanorderrule.Table, scanorderrule.Columns, sqlgraph.NewFieldSpec(scanorderrule.FieldID, field.TypeUUID))
+	_spec.From = sorq.sql
+	if unique := sorq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sorq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sorq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scanorderrule.FieldID)
+		for i := range fields {
+			if fields[i] != scanorderrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sorq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sorq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sorq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sorq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sorq *ScanOrderRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sorq.driver.Dialect())
+	t1 := builder.Table(scanorderrule.Table)
+	columns := sorq.ctx.Fields
+	if len(columns) == 0 {
+		columns = scanorderrule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sorq.sql != nil {
+		selector = sorq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sorq.ctx.Unique != nil && *sorq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range sorq.modifiers {
+		m(selector)
+	}
+	for _, p := range sorq.predicates {
+		p(selector)
+	}
+	for _, p := range sorq.order {
+		p(selector)
+	}
+	if offset := sorq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sorq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (sorq *ScanOrderRuleQuery) ForUpdate(opts ...sql.LockOption) *ScanOrderRuleQuery {
+	if sorq.driver.Dialect() == dialect.Postgres {
+		sorq.Unique(false)
+	}
+	sorq.modifiers = append(sorq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return sorq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (sorq *ScanOrderRuleQuery) ForShare(opts ...sql.LockOption) *ScanOrderRuleQuery {
+	if sorq.driver.Dialect() == dialect.Postgres {
+		sorq.Unique(false)
+	}
+	sorq.modifiers = append(sorq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return sorq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sorq *ScanOrderRuleQuery) Modify(modifiers ...func(s *sql.Selector)) *ScanOrderRuleSelect {
+	sorq.modifiers = append(sorq.modifiers, modifiers...)
+	return sorq.Select()
+}
+
+// ScanOrderRuleGroupBy is the group-by builder for ScanOrderRule entities.
+type ScanOrderRuleGroupBy struct {
+	selector
+	build *ScanOrderRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sorgb *ScanOrderRuleGroupBy) Aggregate(fns ...AggregateFunc) *ScanOrderRuleGroupBy {
+	sorgb.fns = append(sorgb.fns, fns...)
+	return sorgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sorgb *ScanOrderRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sorgb.build.ctx, ent.OpQueryGroupBy)
+	if err := sorgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScanOrderRuleQuery, *ScanOrderRuleGroupBy](ctx, sorgb.build, sorgb, sorgb.build.inters, v)
+}
+
+func (sorgb *ScanOrderRuleGroupBy) sqlScan(ctx context.Context, root *ScanOrderRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sorgb.fns))
+	for _, fn := range sorgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sorgb.flds)+len(sorgb.fns))
+		for _, f := range *sorgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sorgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sorgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScanOrderRuleSelect is the builder for selecting fields of ScanOrderRule entities.
+type ScanOrderRuleSelect struct {
+	*ScanOrderRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sors *ScanOrderRuleSelect) Aggregate(fns ...AggregateFunc) *ScanOrderRuleSelect {
+	sors.fns = append(sors.fns, fns...)
+	return sors
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sors *ScanOrderRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sors.ctx, ent.OpQuerySelect)
+	if err := sors.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScanOrderRuleQuery, *ScanOrderRuleSelect](ctx, sors.ScanOrderRuleQuery, sors, sors.inters, v)
+}
+
+func (sors *ScanOrderRuleSelect) sqlScan(ctx context.Context, root *ScanOrderRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sors.fns))
+	for _, fn := range sors.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sors.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sors.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sors *ScanOrderRuleSelect) Modify(modifiers ...func(s *sql.Selector)) *ScanOrderRuleSelect {
+	sors.modifiers = append(sors.modifiers, modifiers...)
+	return sors
+}
@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/scanorderrule"
+)
+
+// ScanOrderRuleUpdate is the builder for updating ScanOrderRule entities.
+type ScanOrderRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScanOrderRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScanOrderRuleUpdate builder.
+func (soru *ScanOrderRuleUpdate) Where(ps ...predicate.ScanOrderRule) *ScanOrderRuleUpdate {
+	soru.mutation.Where(ps...)
+	return soru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (soru *ScanOrderRuleUpdate) SetUpdatedAt(t time.Time) *ScanOrderRuleUpdate {
+	soru.mutation.SetUpdatedAt(t)
+	return soru
+}
+
+// SetEnabled sets the "enabled" field.
+func (soru *ScanOrderRuleUpdate) SetEnabled(b bool) *ScanOrderRuleUpdate {
+	soru.mutation.SetEnabled(b)
+	return soru
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (soru *ScanOrderRuleUpdate) SetNillableEnabled(b *bool) *ScanOrderRuleUpdate {
+	if b != nil {
+		soru.SetEnabled(*b)
+	}
+	return soru
+}
+
+// SetPayMode sets the "pay_mode" field.
+func (soru *ScanOrderRuleUpdate) SetPayMode(dopm domain.ScanOrderPayMode) *ScanOrderRuleUpdate {
+	soru.mutation.SetPayMode(dopm)
+	return soru
+}
+
+// SetNillablePayMode sets the "pay_mode" field if the given value is not nil.
+func (soru *ScanOrderRuleUpdate) SetNillablePayMode(dopm *domain.ScanOrderPayMode) *ScanOrderRuleUpdate {
+	if dopm != nil {
+		soru.SetPayMode(*dopm)
+	}
+	return soru
+}
+
+// Mutation returns the ScanOrderRuleMutation object of the builder.
+func (soru *ScanOrderRuleUpdate) Mutation() *ScanOrderRuleMutation {
+	return soru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (soru *ScanOrderRuleUpdate) Save(ctx context.Context) (int, error) {
+	soru.defaults()
+	return withHooks(ctx, soru.sqlSave, soru.mutation, soru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (soru *ScanOrderRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := soru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (soru *ScanOrderRuleUpdate) Exec(ctx context.Context) error {
+	_, err := soru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (soru *ScanOrderRuleUpdate) ExecX(ctx context.Context) {
+	if err := soru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (soru *ScanOrderRuleUpdate) defaults() {
+	if _, ok := soru.mutation.UpdatedAt(); !ok {
+		v := scanorderrule.UpdateDefaultUpdatedAt()
+		soru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (soru *ScanOrderRuleUpdate) check() error {
+	if v, ok := soru.mutation.PayMode(); ok {
+		if err := scanorderrule.PayModeValidator(v); err != nil {
+			return &ValidationError{Name: "pay_mode", err: fmt.Errorf(`ent: validator failed for field "ScanOrderRule.pay_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (soru *ScanOrderRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScanOrderRuleUpdate {
+	soru.modifiers = append(soru.modifiers, modifiers...)
+	return soru
+}
+
+func (soru *ScanOrderRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := soru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scanorderrule.Table, scanorderrule.Columns, sqlgraph.NewFieldSpec(scanorderrule.FieldID, field.TypeUUID))
+	if ps := soru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := soru.mutation.UpdatedAt(); ok {
+		_spec.SetField(scanorderrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := soru.mutation.Enabled(); ok {
+		_spec.SetField(scanorderrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := soru.mutation.PayMode(); ok {
+		_spec.SetField(scanorderrule.FieldPayMode, field.TypeEnum, value)
+	}
+	_spec.AddModifiers(soru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, soru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scanorderrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	soru.mutation.done = true
+	return n, nil
+}
+
+// ScanOrderRuleUpdateOne is the builder for updating a single ScanOrderRule entity.
+type ScanOrderRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScanOrderRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (soruo *ScanOrderRuleUpdateOne) SetUpdatedAt(t time.Time) *ScanOrderRuleUpdateOne {
+	soruo.mutation.SetUpdatedAt(t)
+	return soruo
+}
+
+// SetEnabled sets the "enabled" field.
+func (soruo *ScanOrderRuleUpdateOne) SetEnabled(b bool) *ScanOrderRuleUpdateOne {
+	soruo.mutation.SetEnabled(b)
+	return soruo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (soruo *ScanOrderRuleUpdateOne) SetNillableEnabled(b *bool) *ScanOrderRuleUpdateOne {
+	if b != nil {
+		soruo.SetEnabled(*b)
+	}
+	return soruo
+}
+
+// SetPayMode sets the "pay_mode" field.
+func (soruo *ScanOrderRuleUpdateOne) SetPayMode(dopm domain.ScanOrderPayMode) *ScanOrderRuleUpdateOne {
+	soruo.mutation.SetPayMode(dopm)
+	return soruo
+}
+
+// SetNillablePayMode sets the "pay_mode" field if the given value is not nil.
+func (soruo *ScanOrderRuleUpdateOne) SetNillablePayMode(dopm *domain.ScanOrderPayMode) *ScanOrderRuleUpdateOne {
+	if dopm != nil {
+		soruo.SetPayMode(*dopm)
+	}
+	return soruo
+}
+
+// Mutation returns the ScanOrderRuleMutation object of the builder.
+func (soruo *ScanOrderRuleUpdateOne) Mutation() *ScanOrderRuleMutation {
+	return soruo.mutation
+}
+
+// Where appends a list predicates to the ScanOrderRuleUpdate builder.
+func (soruo *ScanOrderRuleUpdateOne) Where(ps ...predicate.ScanOrderRule) *ScanOrderRuleUpdateOne {
+	soruo.mutation.Where(ps...)
+	return soruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (soruo *ScanOrderRuleUpdateOne) Select(field string, fields ...string) *ScanOrderRuleUpdateOne {
+	soruo.fields = append([]string{field}, fields...)
+	return soruo
+}
+
+// Save executes the query and returns the updated ScanOrderRule entity.
+func (soruo *ScanOrderRuleUpdateOne) Save(ctx context.Context) (*ScanOrderRule, error) {
+	soruo.defaults()
+	return withHooks(ctx, soruo.sqlSave, soruo.mutation, soruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (soruo *ScanOrderRuleUpdateOne) SaveX(ctx context.Context) *ScanOrderRule {
+	node, err := soruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (soruo *ScanOrderRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := soruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (soruo *ScanOrderRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := soruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (soruo *ScanOrderRuleUpdateOne) defaults() {
+	if _, ok := soruo.mutation.UpdatedAt(); !ok {
+		v := scanorderrule.UpdateDefaultUpdatedAt()
+		soruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (soruo *ScanOrderRuleUpdateOne) check() error {
+	if v, ok := soruo.mutation.PayMode(); ok {
+		if err := scanorderrule.PayModeValidator(v); err != nil {
+			return &ValidationError{Name: "pay_mode", err: fmt.Errorf(`ent: validator failed for field "ScanOrderRule.pay_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (soruo *ScanOrderRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScanOrderRuleUpdateOne {
+	soruo.modifiers = append(soruo.modifiers, modifiers...)
+	return soruo
+}
+
+func (soruo *ScanOrderRuleUpdateOne) sqlSave(ctx context.Context) (_node *ScanOrderRule, err error) {
+	if err := soruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scanorderrule.Table, scanorderrule.Columns, sqlgraph.NewFieldSpec(scanorderrule.FieldID, field.TypeUUID))
+	id, ok := soruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScanOrderRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := soruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scanorderrule.FieldID)
+		for _, f := range fields {
+			if !scanorderrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scanorderrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := soruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := soruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(scanorderrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := soruo.mutation.Enabled(); ok {
+		_spec.SetField(scanorderrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := soruo.mutation.PayMode(); ok {
+		_spec.SetField(scanorderrule.FieldPayMode, field.TypeEnum, value)
+	}
+	_spec.AddModifiers(soruo.modifiers...)
+	_node = &ScanOrderRule{config: soruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, soruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scanorderrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	soruo.mutation.done = true
+	return _node, nil
+}
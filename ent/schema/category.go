@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
 )
 
@@ -28,6 +29,7 @@ func (Category) Mixin() []ent.Mixin {
 func (Category) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").MaxLen(255).NotEmpty().Comment("分类名称"),
+		field.JSON("name_i18n", domain.LocalizedText{}).Optional().Comment("分类名称翻译（按语言）"),
 		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
 		field.UUID("store_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("门店ID"),
 		field.UUID("parent_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("父分类ID，为空表示一级分类"),
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// Coupon 优惠券（券码）
+type Coupon struct {
+	ent.Schema
+}
+
+func (Coupon) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the Coupon.
+func (Coupon) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("template_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("模板ID（三方券为空）"),
+		field.Enum("source").
+			GoType(domain.CouponSource("")).
+			Default(string(domain.CouponSourceSystem)).
+			Immutable().
+			Comment("来源：system（系统自定义券）、partner（三方合作券）"),
+		field.String("provider").MaxLen(50).Default("").Immutable().Comment("三方券平台（系统券为空）"),
+		field.String("code").MaxLen(64).NotEmpty().Immutable().Comment("券码"),
+		field.String("batch_no").MaxLen(64).Default("").Immutable().Comment("发放批次号"),
+		field.String("name").MaxLen(255).Default("").Comment("券名称"),
+		field.Enum("type").
+			GoType(domain.CouponType("")).
+			Comment("券类型"),
+		field.Enum("status").
+			GoType(domain.CouponStatus("")).
+			Default(string(domain.CouponStatusUnused)).
+			Comment("状态：unused（未使用）、used（已使用）、invalidated（已作废）、redeeming（三方核销中）、redeem_failed（三方核销失败）"),
+		field.Time("valid_from").Comment("有效期开始时间"),
+		field.Time("valid_to").Comment("有效期结束时间"),
+		field.Time("used_at").Optional().Nillable().Comment("使用时间"),
+		field.UUID("used_store_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("使用门店ID"),
+		field.UUID("order_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("使用订单ID"),
+		field.String("order_no").MaxLen(64).Default("").Comment("使用订单号"),
+		field.Other("discount_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Comment("优惠金额"),
+	}
+}
+
+func (Coupon) Indexes() []ent.Index {
+	return []ent.Index{
+		// 券码在品牌商及平台内唯一
+		index.Fields("merchant_id", "provider", "code").Unique(),
+		index.Fields("template_id"),
+		index.Fields("order_id"),
+	}
+}
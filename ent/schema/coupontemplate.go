@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// CouponTemplate 优惠券模板
+type CouponTemplate struct {
+	ent.Schema
+}
+
+func (CouponTemplate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+		schematype.SoftDeleteMixin{},
+	}
+}
+
+// Fields of the CouponTemplate.
+func (CouponTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("门店ID（门店创建的券仅本门店可用）"),
+		field.String("name").MaxLen(255).NotEmpty().Comment("券名称"),
+		field.Enum("type").
+			GoType(domain.CouponType("")).
+			Comment("券类型：amount_off（代金券）、discount（折扣券）、free_item（兑换券）"),
+		field.Other("value", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Comment("优惠值（代金券为减免金额，折扣券为减免比例）"),
+		field.Other("max_discount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Comment("折扣券最高优惠金额（0 表示不限）"),
+		field.UUID("product_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("兑换商品ID（兑换券）"),
+		field.Other("min_spend", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Comment("使用门槛（0 表示无门槛）"),
+		field.JSON("store_ids", []uuid.UUID{}).Optional().Comment("适用门店ID列表（为空表示全部门店）"),
+		field.Enum("validity_type").
+			GoType(domain.CouponValidityType("")).
+			Comment("有效期类型：fixed（固定日期）、relative（发放后 N 天内有效）"),
+		field.Time("valid_from").Optional().Nillable().Comment("有效期开始时间"),
+		field.Time("valid_to").Optional().Nillable().Comment("有效期结束时间"),
+		field.Int("valid_days").Default(0).Comment("有效天数"),
+		field.Int("total_qty").Default(0).Comment("发放总量（0 表示不限）"),
+		field.Int("issued_qty").Default(0).Comment("已发放数量"),
+		field.String("description").MaxLen(1000).Default("").Comment("使用说明"),
+		field.Bool("enabled").Default(true).Comment("是否启用"),
+	}
+}
+
+func (CouponTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("merchant_id", "store_id"),
+		// 唯一索引
+		index.Fields("merchant_id", "store_id", "name", "deleted_at").Unique(),
+	}
+}
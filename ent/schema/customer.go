@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// Customer 客户（微信小程序用户）
+type Customer struct {
+	ent.Schema
+}
+
+func (Customer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the Customer.
+func (Customer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("openid").MaxLen(64).NotEmpty().Immutable().Comment("微信小程序 openid"),
+		field.String("unionid").MaxLen(64).Default("").Comment("微信开放平台 unionid"),
+		field.String("nickname").MaxLen(50).Default("").Comment("昵称"),
+		field.String("avatar").MaxLen(500).Default("").Comment("头像"),
+		field.String("phone").MaxLen(20).Default("").Comment("手机号"),
+		field.Time("last_login_at").Optional().Nillable().Comment("最后登录时间"),
+	}
+}
+
+func (Customer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("unionid"),
+		index.Fields("phone"),
+		// 唯一索引
+		index.Fields("openid").Unique(),
+	}
+}
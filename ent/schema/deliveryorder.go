@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// DeliveryOrder 外卖平台订单
+type DeliveryOrder struct {
+	ent.Schema
+}
+
+func (DeliveryOrder) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the DeliveryOrder.
+func (DeliveryOrder) Fields() []ent.Field {
+	amount := func(name, comment string) ent.Field {
+		return field.Other(name, decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Immutable().
+			Comment(comment)
+	}
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.Enum("platform").GoType(domain.DeliveryPlatformCode("")).Immutable().Comment("外卖平台"),
+		field.String("platform_order_id").MaxLen(64).Immutable().Comment("平台订单ID"),
+		field.String("short_code").MaxLen(32).Default("").Immutable().Comment("平台取餐码"),
+		field.Enum("status").GoType(domain.DeliveryOrderStatus("")).Comment("状态：pending=待接单；accepted=已接单；ready=已出餐；rejected=已拒单；cancelled=已取消"),
+		field.UUID("order_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("门店订单ID"),
+		field.JSON("items", []domain.DeliveryOrderItem{}).Comment("商品"),
+		amount("subtotal", "商品小计"),
+		amount("delivery_fee", "配送费"),
+		amount("total", "订单合计"),
+		field.String("customer_name").MaxLen(64).Default("").Immutable().Comment("顾客姓名"),
+		field.String("customer_phone").MaxLen(32).Default("").Immutable().Comment("顾客电话"),
+		field.String("address").MaxLen(255).Default("").Immutable().Comment("配送地址"),
+		field.String("remark").MaxLen(255).Default("").Immutable().Comment("订单备注"),
+		field.String("reject_reason").MaxLen(255).Default("").Comment("拒单原因"),
+		field.Bool("auto_accepted").Default(false).Comment("是否自动接单"),
+		field.Time("placed_at").Immutable().Comment("下单时间"),
+		field.Time("accepted_at").Optional().Nillable().Comment("接单时间"),
+		field.Time("ready_at").Optional().Nillable().Comment("出餐时间"),
+	}
+}
+
+func (DeliveryOrder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("platform", "platform_order_id").Unique(),
+		index.Fields("store_id", "status", "placed_at"),
+	}
+}
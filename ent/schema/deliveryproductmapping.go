@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// DeliveryProductMapping 外卖平台商品编码与本地商品规格的映射
+type DeliveryProductMapping struct {
+	ent.Schema
+}
+
+func (DeliveryProductMapping) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the DeliveryProductMapping.
+func (DeliveryProductMapping) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.Enum("platform").GoType(domain.DeliveryPlatformCode("")).Immutable().Comment("外卖平台"),
+		field.String("platform_sku").MaxLen(64).Comment("平台商品编码"),
+		field.UUID("product_id", uuid.UUID{}).Comment("商品ID"),
+		field.UUID("spec_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("规格ID，为空表示默认规格"),
+	}
+}
+
+func (DeliveryProductMapping) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("merchant_id", "platform", "platform_sku").Unique(),
+		index.Fields("merchant_id", "product_id"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// DeliveryStore 门店绑定的外卖平台店铺
+type DeliveryStore struct {
+	ent.Schema
+}
+
+func (DeliveryStore) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the DeliveryStore.
+func (DeliveryStore) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.Enum("platform").GoType(domain.DeliveryPlatformCode("")).Immutable().Comment("外卖平台：grabfood；foodpanda"),
+		field.String("platform_store_id").MaxLen(64).Comment("平台门店ID"),
+		field.Bool("enabled").Default(true).Comment("是否接收平台订单"),
+		field.Bool("auto_accept").Default(false).Comment("是否自动接单"),
+		field.Other("auto_accept_max_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Comment("自动接单金额上限，为0不限制"),
+	}
+}
+
+func (DeliveryStore) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("store_id", "platform").Unique(),
+		index.Fields("platform", "platform_store_id").Unique(),
+		index.Fields("merchant_id"),
+	}
+}
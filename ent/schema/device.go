@@ -38,7 +38,7 @@ func (Device) Fields() []ent.Field {
 			Comment("门店 ID"),
 		field.Enum("device_type").
 			GoType(domain.DeviceType("")).
-			Comment("设备类型：收银机/打印机"),
+			Comment("设备类型：收银机/打印机/自助点餐机"),
 		field.String("device_code").
 			MaxLen(100).
 			Default("").
@@ -73,6 +73,10 @@ func (Device) Fields() []ent.Field {
 			GoType(domain.PaperSize("")).
 			Optional().
 			Comment("打印纸张尺寸"),
+		field.String("print_locale").
+			MaxLen(16).
+			Default("").
+			Comment("打印语言（为空时使用商品原始名称）"),
 		field.Enum("connect_type").
 			GoType(domain.DeviceConnectType("")).
 			Optional().
@@ -97,6 +101,14 @@ func (Device) Fields() []ent.Field {
 		field.Bool("open_cash_drawer").
 			Optional().
 			Comment("是否开钱箱"),
+		field.JSON("kiosk_pay_modes", []domain.KioskPayMode{}).
+			Optional().
+			Comment("自助点餐机支持的支付方式，多选"),
+		field.String("kiosk_token").
+			MaxLen(32).
+			Optional().
+			Nillable().
+			Comment("自助点餐机访问令牌"),
 	}
 }
 
@@ -128,6 +140,8 @@ func (Device) Indexes() []ent.Index {
 		index.Fields("merchant_id"),
 		index.Fields("store_id"),
 		index.Fields("stall_id"),
+		index.Fields("kiosk_token").
+			Unique(),
 		index.Fields("name", "merchant_id", "store_id", "deleted_at").
 			Unique().
 			StorageKey("idx_device_name_scope_deleted"),
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// DiningTable 门店桌台
+type DiningTable struct {
+	ent.Schema
+}
+
+func (DiningTable) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+		schematype.SoftDeleteMixin{},
+	}
+}
+
+// Fields of the DiningTable.
+func (DiningTable) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.String("name").MaxLen(20).NotEmpty().Comment("桌台名称"),
+		field.Int("seats").Default(0).Comment("座位数"),
+		field.String("qr_token").MaxLen(32).NotEmpty().Comment("二维码令牌"),
+		field.Bool("enabled").Default(true).Comment("是否启用"),
+		field.Int("sort_order").Default(0).Comment("排序，值越小越靠前"),
+	}
+}
+
+func (DiningTable) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("store_id"),
+		// 唯一索引
+		index.Fields("qr_token").Unique(),
+		index.Fields("store_id", "name", "deleted_at").Unique(),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// KitchenTicket 厨房单
+type KitchenTicket struct {
+	ent.Schema
+}
+
+func (KitchenTicket) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the KitchenTicket.
+func (KitchenTicket) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.UUID("order_id", uuid.UUID{}).Immutable().Comment("订单ID"),
+		field.String("order_no").MaxLen(64).Default("").Immutable().Comment("订单号"),
+		field.UUID("table_id", uuid.UUID{}).Immutable().Comment("桌台ID"),
+		field.String("table_name").MaxLen(20).Default("").Immutable().Comment("桌台名称"),
+		field.String("pickup_no").MaxLen(16).Default("").Immutable().Comment("取餐号"),
+		field.Int("batch").Default(0).Immutable().Comment("下单序号"),
+		field.Enum("source").GoType(domain.Channel("")).Immutable().Comment("下单来源"),
+		field.Enum("status").GoType(domain.KitchenTicketStatus("")).Comment("状态：held=待支付；pending=待出单；printed=已出单"),
+		field.JSON("items", []domain.KitchenTicketItem{}).Immutable().Comment("出品商品"),
+		field.String("remark").MaxLen(255).Default("").Immutable().Comment("整单备注"),
+		field.Time("printed_at").Optional().Nillable().Comment("出单时间"),
+	}
+}
+
+func (KitchenTicket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("store_id", "status", "created_at"),
+		index.Fields("order_id"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// Member 会员
+type Member struct {
+	ent.Schema
+}
+
+func (Member) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+		schematype.SoftDeleteMixin{},
+	}
+}
+
+// Fields of the Member.
+func (Member) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("tier_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("会员等级ID"),
+		field.String("code").MaxLen(32).NotEmpty().Immutable().Comment("会员码（会员二维码内容）"),
+		field.String("name").MaxLen(50).Default("").Comment("姓名"),
+		field.String("phone").MaxLen(20).NotEmpty().Comment("手机号"),
+		field.String("openid").MaxLen(64).Default("").Comment("微信 openid"),
+		field.Enum("gender").
+			GoType(domain.Gender("")).
+			Default(string(domain.GenderUnknown)).
+			Comment("性别"),
+		field.Time("birthday").Optional().Nillable().Comment("生日"),
+		field.Bool("enabled").Default(true).Comment("是否启用"),
+		field.String("remark").MaxLen(255).Default("").Comment("备注"),
+	}
+}
+
+func (Member) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("merchant_id", "tier_id"),
+		index.Fields("merchant_id", "openid"),
+		// 唯一索引
+		index.Fields("merchant_id", "phone", "deleted_at").Unique(),
+		index.Fields("merchant_id", "code").Unique(),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// MemberAccount 会员储值账户
+type MemberAccount struct {
+	ent.Schema
+}
+
+func (MemberAccount) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the MemberAccount.
+func (MemberAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("member_id", uuid.UUID{}).Immutable().Comment("会员ID"),
+		field.Other("balance", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Comment("本金余额"),
+		field.Other("bonus_balance", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Comment("赠送金余额"),
+	}
+}
+
+func (MemberAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("member_id").Unique(),
+		index.Fields("merchant_id"),
+	}
+}
@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// MemberAccountTransaction 会员储值流水（只追加，不修改）
+type MemberAccountTransaction struct {
+	ent.Schema
+}
+
+func (MemberAccountTransaction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the MemberAccountTransaction.
+func (MemberAccountTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("发生门店ID（品牌后台调整为空）"),
+		field.UUID("member_id", uuid.UUID{}).Immutable().Comment("会员ID"),
+		field.UUID("account_id", uuid.UUID{}).Immutable().Comment("账户ID"),
+		field.String("transaction_no").MaxLen(64).NotEmpty().Immutable().Comment("流水号"),
+		field.Enum("type").
+			GoType(domain.MemberAccountTransactionType("")).
+			Immutable().
+			Comment("流水类型：top_up（充值）、payment（消费）、refund（退款退回）、adjustment（人工调整）"),
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Immutable().
+			Comment("本金变动（增加为正，减少为负）"),
+		field.Other("bonus_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Immutable().
+			Comment("赠送金变动（增加为正，减少为负）"),
+		field.Other("balance_after", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Immutable().
+			Comment("变动后本金余额"),
+		field.Other("bonus_balance_after", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Immutable().
+			Comment("变动后赠送金余额"),
+		field.UUID("order_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("关联订单ID"),
+		field.String("order_no").MaxLen(64).Default("").Immutable().Comment("关联订单号"),
+		field.UUID("refund_order_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("关联退款单ID"),
+		field.String("payment_method").MaxLen(50).Default("").Immutable().Comment("充值支付方式"),
+		field.UUID("operator_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("操作人ID"),
+		field.String("operator_name").MaxLen(100).Default("").Immutable().Comment("操作人名称"),
+		field.String("remark").MaxLen(255).Default("").Immutable().Comment("备注"),
+	}
+}
+
+func (MemberAccountTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("merchant_id", "transaction_no").Unique(),
+		index.Fields("account_id", "created_at"),
+		index.Fields("merchant_id", "store_id"),
+		index.Fields("order_id"),
+		index.Fields("refund_order_id"),
+	}
+}
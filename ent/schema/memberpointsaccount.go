@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// MemberPointsAccount 会员积分账户
+type MemberPointsAccount struct {
+	ent.Schema
+}
+
+func (MemberPointsAccount) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the MemberPointsAccount.
+func (MemberPointsAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("member_id", uuid.UUID{}).Immutable().Comment("会员ID"),
+		field.Int64("balance").Default(0).Comment("可用积分"),
+	}
+}
+
+func (MemberPointsAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("member_id").Unique(),
+		index.Fields("merchant_id"),
+	}
+}
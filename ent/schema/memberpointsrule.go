@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// MemberPointsRule 会员积分规则（每个品牌商一条）
+type MemberPointsRule struct {
+	ent.Schema
+}
+
+func (MemberPointsRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the MemberPointsRule.
+func (MemberPointsRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.Bool("enabled").Default(false).Comment("是否开启积分获取"),
+		field.Other("earn_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Comment("基础比例：每消费1元获得的积分"),
+		field.JSON("category_rates", []domain.MemberPointsCategoryRate{}).Optional().Comment("分类积分比例"),
+		field.JSON("tier_multipliers", []domain.MemberPointsTierMultiplier{}).Optional().Comment("会员等级积分倍率"),
+		field.Int("expire_days").Default(0).Comment("积分有效天数，0 表示永久有效"),
+		field.Bool("redeem_enabled").Default(false).Comment("是否开启积分抵扣"),
+		field.Int64("redeem_rate").Default(0).Comment("抵扣比例：多少积分抵扣1元"),
+		field.Int64("redeem_min_points").Default(0).Comment("单次最低使用积分"),
+		field.Other("redeem_max_percent", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Comment("抵扣金额占订单应收的最高比例（百分比）"),
+		field.JSON("redeem_products", []domain.MemberPointsRedeemProduct{}).Optional().Comment("积分兑换商品"),
+	}
+}
+
+func (MemberPointsRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("merchant_id").Unique(),
+	}
+}
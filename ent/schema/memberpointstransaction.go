@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// MemberPointsTransaction 会员积分流水（除入账剩余积分外不修改）
+type MemberPointsTransaction struct {
+	ent.Schema
+}
+
+func (MemberPointsTransaction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the MemberPointsTransaction.
+func (MemberPointsTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("发生门店ID（品牌后台调整和过期为空）"),
+		field.UUID("member_id", uuid.UUID{}).Immutable().Comment("会员ID"),
+		field.UUID("account_id", uuid.UUID{}).Immutable().Comment("账户ID"),
+		field.Enum("type").
+			GoType(domain.MemberPointsTransactionType("")).
+			Immutable().
+			Comment("流水类型：earn（消费获得）、redeem（使用）、reverse（退款扣回）、restore（退款退回）、expire（过期）、adjustment（人工调整）"),
+		field.Int64("points").Immutable().Comment("积分变动（增加为正，减少为负）"),
+		field.Int64("balance_after").Immutable().Comment("变动后积分余额"),
+		field.Int64("remaining").Default(0).Comment("入账积分剩余未使用部分"),
+		field.Time("expires_at").Optional().Nillable().Immutable().Comment("入账积分过期时间（为空表示永久有效）"),
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Immutable().
+			Comment("获得积分的消费金额或积分抵扣金额"),
+		field.UUID("order_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("关联订单ID"),
+		field.String("order_no").MaxLen(64).Default("").Immutable().Comment("关联订单号"),
+		field.UUID("refund_order_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("关联退款单ID"),
+		field.UUID("product_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("兑换商品ID"),
+		field.UUID("operator_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("操作人ID"),
+		field.String("operator_name").MaxLen(100).Default("").Immutable().Comment("操作人名称"),
+		field.String("remark").MaxLen(255).Default("").Immutable().Comment("备注"),
+	}
+}
+
+func (MemberPointsTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "remaining"),
+		index.Fields("account_id", "created_at"),
+		index.Fields("merchant_id", "created_at"),
+		index.Fields("remaining", "expires_at"),
+		index.Fields("order_id"),
+		index.Fields("refund_order_id"),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// MemberTier 会员等级
+type MemberTier struct {
+	ent.Schema
+}
+
+func (MemberTier) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+		schematype.SoftDeleteMixin{},
+	}
+}
+
+// Fields of the MemberTier.
+func (MemberTier) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.String("name").MaxLen(50).NotEmpty().Comment("等级名称"),
+		field.Int("level").Default(0).Comment("等级序号，值越大等级越高"),
+		field.Bool("member_price_enabled").Default(true).Comment("是否享受会员价"),
+		field.String("description").MaxLen(255).Default("").Comment("等级说明"),
+	}
+}
+
+func (MemberTier) Indexes() []ent.Index {
+	return []ent.Index{
+		// 唯一索引
+		index.Fields("merchant_id", "name", "deleted_at").Unique(),
+	}
+}
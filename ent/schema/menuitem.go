@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
 )
 
@@ -45,6 +46,7 @@ func (MenuItem) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("会员价（可选，单位：令吉）"),
+		field.JSON("period_prices", domain.PeriodPrices{}).Optional().Comment("就餐时段价格（按门店就餐时段和星期覆盖基础价）"),
 	}
 }
 
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// MenuVersion 菜单版本（草稿、已发布、已归档）
+type MenuVersion struct {
+	ent.Schema
+}
+
+func (MenuVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the MenuVersion.
+func (MenuVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("menu_id", uuid.UUID{}).Immutable().Comment("菜单ID"),
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("门店ID"),
+		field.Int("version").Immutable().Comment("版本号（同一菜单内递增）"),
+		field.Enum("status").
+			GoType(domain.MenuVersionStatus("")).
+			Default(string(domain.MenuVersionStatusDraft)).
+			Comment("状态：draft（草稿）、published（已发布）、archived（已归档）"),
+		field.String("name").MaxLen(255).NotEmpty().Comment("菜单名称"),
+		field.JSON("store_ids", []uuid.UUID{}).Optional().Comment("适用门店ID列表"),
+		field.JSON("items", domain.MenuVersionItems{}).Optional().Comment("菜单项快照"),
+		field.Time("scheduled_at").Optional().Nillable().Comment("定时发布时间"),
+		field.Time("published_at").Optional().Nillable().Comment("发布时间"),
+		field.String("publish_error").MaxLen(1000).Default("").Comment("定时发布失败原因"),
+		field.UUID("operator_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("最后操作人ID（系统执行时为空）"),
+		field.String("operator_name").MaxLen(255).Default("").Comment("最后操作人名称"),
+	}
+}
+
+func (MenuVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("menu_id", "version").Unique(),
+		index.Fields("menu_id", "status"),
+		// 定时任务按状态和定时发布时间扫描
+		index.Fields("status", "scheduled_at"),
+	}
+}
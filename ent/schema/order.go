@@ -31,6 +31,7 @@ func (Order) Fields() []ent.Field {
 		field.String("business_date").NotEmpty().Comment("营业日（门店营业日，字符串）"),
 		field.String("shift_no").Optional().Comment("班次号"),
 		field.String("order_no").NotEmpty().Comment("订单号（门店内唯一可读编号）"),
+		field.String("pickup_no").MaxLen(16).Default("").Comment("取餐号"),
 
 		field.Enum("order_type").GoType(domain.OrderType("")).Default(string(domain.OrderTypeSale)).Comment("订单类型：SALE=销售单；REFUND=退单；PARTIAL_REFUND=部分退款单"),
 
@@ -41,7 +42,7 @@ func (Order) Fields() []ent.Field {
 		field.UUID("placed_by", uuid.UUID{}).Optional().Comment("下单人ID"),
 		field.String("placed_by_name").Optional().Comment("下单人名称"),
 
-		field.Enum("dining_mode").GoType(domain.DiningMode("")).Default(string(domain.DiningModeDineIn)).Comment("就餐模式：DINE_IN=堂食"),
+		field.Enum("dining_mode").GoType(domain.DiningMode("")).Default(string(domain.DiningModeDineIn)).Comment("就餐模式：DINE_IN=堂食；DELIVERY=外卖配送"),
 		field.Enum("order_status").GoType(domain.OrderStatus("")).Default(string(domain.OrderStatusPlaced)).Comment("订单业务状态：PLACED=已下单；COMPLETED=已完成；CANCELLED=已取消"),
 		field.Enum("payment_status").GoType(domain.PaymentStatus("")).Default(string(domain.PaymentStatusUnpaid)).Comment("支付状态：UNPAID=未支付；PAYING=支付中；PAID=已支付；REFUNDED=全额退款"),
 
@@ -59,6 +60,10 @@ func (Order) Fields() []ent.Field {
 		field.JSON("payments", []domain.OrderPayment{}).Optional().Comment("支付记录"),
 
 		field.JSON("amount", domain.OrderAmount{}).Comment("金额汇总"),
+		field.JSON("promotions", []domain.OrderPromotion{}).Optional().Comment("享受的促销活动"),
+
+		field.UUID("member_id", uuid.UUID{}).Optional().Comment("会员ID"),
+		field.JSON("member", &domain.OrderMember{}).Optional().Comment("关联会员信息"),
 
 		field.String("remark").MaxLen(255).Optional().Comment("整单备注"),
 
@@ -73,6 +78,7 @@ func (Order) Indexes() []ent.Index {
 		index.Fields("table_id"),
 		index.Fields("order_status"),
 		index.Fields("payment_status"),
+		index.Fields("member_id"),
 		index.Fields("store_id", "order_no", "deleted_at").Unique(),
 	}
 }
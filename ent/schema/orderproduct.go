@@ -36,6 +36,7 @@ func (OrderProduct) Fields() []ent.Field {
 		// ========== 商品基础信息（来自 domain.Product）==========
 		field.UUID("product_id", uuid.UUID{}).Comment("商品ID"),
 		field.String("product_name").NotEmpty().Comment("商品名称"),
+		field.JSON("product_name_i18n", domain.LocalizedText{}).Optional().Comment("商品名称翻译（按语言）"),
 		field.Enum("product_type").GoType(domain.ProductType("")).Default(string(domain.ProductTypeNormal)).Comment("商品类型：normal（普通商品）、set_meal（套餐商品）"),
 		field.JSON("category", domain.Category{}).Optional().Comment("分类信息"),
 		field.JSON("product_unit", domain.ProductUnit{}).Optional().Comment("商品单位信息"),
@@ -112,6 +113,16 @@ func (OrderProduct) Fields() []ent.Field {
 			Nillable().
 			Comment("促销优惠金额"),
 
+		// ========== 会员信息 ==========
+		field.Other("member_discount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(10,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Optional().
+			Nillable().
+			Comment("会员价优惠金额"),
+
 		// ========== 做法金额与赠送金额 ==========
 		field.Other("attr_amount", decimal.Decimal{}).
 			SchemaType(map[string]string{
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// PickupCall 取餐号叫号记录
+type PickupCall struct {
+	ent.Schema
+}
+
+func (PickupCall) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the PickupCall.
+func (PickupCall) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.UUID("order_id", uuid.UUID{}).Immutable().Comment("订单ID"),
+		field.String("order_no").MaxLen(64).Default("").Immutable().Comment("订单号"),
+		field.String("pickup_no").MaxLen(16).Immutable().Comment("取餐号"),
+		field.String("business_date").MaxLen(10).Immutable().Comment("营业日"),
+		field.Enum("status").GoType(domain.PickupCallStatus("")).Comment("状态：waiting=制作中；ready=待取餐；picked_up=已取餐"),
+		field.Int("call_count").Default(0).Comment("叫号次数"),
+		field.Time("ready_at").Optional().Nillable().Comment("最近叫号时间"),
+		field.Time("picked_up_at").Optional().Nillable().Comment("取餐时间"),
+	}
+}
+
+func (PickupCall) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id").Unique(),
+		index.Fields("store_id", "business_date", "status"),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// PickupRule 取餐号规则（品牌商默认规则 store_id 为空，门店规则覆盖默认规则）
+type PickupRule struct {
+	ent.Schema
+}
+
+func (PickupRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the PickupRule.
+func (PickupRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID，为空表示品牌商默认规则"),
+		field.String("prefix").MaxLen(4).Default("").Comment("号码前缀"),
+		field.Int("start").Comment("起始号"),
+		field.Int("end").Comment("结束号"),
+	}
+}
+
+func (PickupRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("merchant_id", "store_id").Unique(),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// Preorder 预点单
+type Preorder struct {
+	ent.Schema
+}
+
+func (Preorder) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the Preorder.
+func (Preorder) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.UUID("customer_id", uuid.UUID{}).Immutable().Comment("顾客ID"),
+		field.UUID("order_id", uuid.UUID{}).Immutable().Comment("订单ID"),
+		field.String("order_no").MaxLen(64).Immutable().Comment("订单号"),
+		field.Enum("fulfilment").GoType(domain.PreorderFulfilment("")).Immutable().Comment("取餐方式：pickup=到店自取；delivery=门店配送"),
+		field.Time("scheduled_at").Immutable().Comment("预约取餐/送达时间"),
+		field.Time("release_at").Immutable().Comment("计划送厨时间"),
+		field.String("contact_name").MaxLen(64).Immutable().Comment("联系人"),
+		field.String("contact_phone").MaxLen(32).Immutable().Comment("联系电话"),
+		field.String("delivery_address").MaxLen(255).Default("").Immutable().Comment("配送地址"),
+		field.Enum("status").GoType(domain.PreorderStatus("")).Comment("状态：scheduled=待送厨；released=已送厨；cancelled=已取消"),
+		field.Time("released_at").Optional().Nillable().Comment("实际送厨时间"),
+		field.Time("cancelled_at").Optional().Nillable().Comment("取消时间"),
+		field.String("cancel_reason").MaxLen(255).Default("").Comment("取消原因"),
+	}
+}
+
+func (Preorder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("store_id", "scheduled_at"),
+		index.Fields("customer_id", "scheduled_at"),
+		index.Fields("order_id").Unique(),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// PreorderRule 门店预点单规则
+type PreorderRule struct {
+	ent.Schema
+}
+
+func (PreorderRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the PreorderRule.
+func (PreorderRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.Bool("enabled").Default(false).Comment("是否开放预点单"),
+		field.Bool("delivery_enabled").Default(false).Comment("是否支持预约配送"),
+		field.Int("slot_minutes").Comment("时段间隔（分钟）"),
+		field.Int("slot_capacity").Comment("每个时段可接单数"),
+		field.Int("lead_minutes").Default(0).Comment("需至少提前下单的分钟数"),
+		field.Int("prep_minutes").Default(0).Comment("提前送厨的分钟数"),
+		field.Int("max_advance_days").Default(0).Comment("最多可提前预约的天数"),
+	}
+}
+
+func (PreorderRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("store_id").Unique(),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// PriceChangeBatch 定时调价批次
+type PriceChangeBatch struct {
+	ent.Schema
+}
+
+func (PriceChangeBatch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+		schematype.SoftDeleteMixin{},
+	}
+}
+
+// Fields of the PriceChangeBatch.
+func (PriceChangeBatch) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255).NotEmpty().Comment("调价批次名称"),
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("门店ID（品牌商创建时为空）"),
+		field.JSON("store_ids", []uuid.UUID{}).Optional().Comment("菜单价格适用门店ID列表"),
+		field.Time("effective_at").Comment("生效时间"),
+		field.Enum("status").
+			GoType(domain.PriceChangeBatchStatus("")).
+			Default(string(domain.PriceChangeBatchStatusPending)).
+			Comment("状态：pending（待生效）、applied（已生效）、cancelled（已取消）、rolled_back（已回滚）、failed（执行失败）"),
+		field.String("remark").MaxLen(255).Default("").Comment("备注"),
+		field.String("fail_reason").MaxLen(1000).Default("").Comment("执行失败原因"),
+		field.Time("applied_at").Optional().Nillable().Comment("实际生效时间"),
+		field.UUID("operator_id", uuid.UUID{}).Comment("创建人ID"),
+		field.String("operator_name").MaxLen(255).Default("").Comment("创建人名称"),
+		field.JSON("operation_logs", []domain.PriceChangeOperationLog{}).Optional().Comment("操作日志"),
+	}
+}
+
+func (PriceChangeBatch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("merchant_id"),
+		index.Fields("store_id"),
+		// 定时任务按状态和生效时间扫描
+		index.Fields("status", "effective_at"),
+	}
+}
+
+// Edges of the PriceChangeBatch.
+func (PriceChangeBatch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", PriceChangeItem.Type).Comment("调价明细"),
+	}
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// PriceChangeItem 定时调价明细
+type PriceChangeItem struct {
+	ent.Schema
+}
+
+func (PriceChangeItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+		schematype.SoftDeleteMixin{},
+	}
+}
+
+// Fields of the PriceChangeItem.
+func (PriceChangeItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("batch_id", uuid.UUID{}).Immutable().Comment("调价批次ID"),
+		field.Enum("target_type").
+			GoType(domain.PriceChangeTargetType("")).
+			Immutable().
+			Comment("调价对象：product_spec（商品规格价）、menu_item（菜单价）"),
+		field.UUID("product_id", uuid.UUID{}).Immutable().Comment("商品ID"),
+		field.UUID("spec_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("规格ID（商品规格价时必填）"),
+		field.UUID("store_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("门店ID（菜单价时必填）"),
+		field.String("product_name").MaxLen(255).Default("").Comment("商品名称快照"),
+		field.String("spec_name").MaxLen(255).Default("").Comment("规格名称快照"),
+		field.Other("new_price", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Comment("调整后价格（单位：令吉）"),
+		field.Other("old_price", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Optional().
+			Nillable().
+			Comment("调整前价格（生效时记录，用于回滚；菜单价为空表示原先未设置菜单价）"),
+		field.UUID("target_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("实际调整的规格关联ID或菜单项ID（生效时记录）"),
+		field.Bool("applied").Default(false).Comment("是否已生效（门店菜单未包含该商品时跳过）"),
+		field.JSON("menu_item_old_prices", []domain.PriceChangeMenuItemOldPrice{}).
+			Optional().
+			Comment("菜单价：各菜单项调整前价格（生效时记录，用于逐项回滚）"),
+	}
+}
+
+func (PriceChangeItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("batch_id"),
+		index.Fields("product_id"),
+	}
+}
+
+// Edges of the PriceChangeItem.
+func (PriceChangeItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("batch", PriceChangeBatch.Type).
+			Ref("items").
+			Field("batch_id").
+			Immutable().
+			Required().
+			Unique().
+			Comment("所属调价批次"),
+	}
+}
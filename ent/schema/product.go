@@ -34,6 +34,8 @@ func (Product) Fields() []ent.Field {
 		field.UUID("category_id", uuid.UUID{}).Comment("分类ID（支持一级分类和二级分类）"),
 		field.UUID("menu_id", uuid.UUID{}).Optional().Comment("菜单ID"),
 		field.String("mnemonic").MaxLen(255).Default("").Comment("助记词"),
+		field.String("pinyin").MaxLen(2000).Default("").Comment("商品名称拼音全拼（小写，保存时根据名称生成）"),
+		field.String("pinyin_initials").MaxLen(255).Default("").Comment("商品名称拼音首字母（小写，保存时根据名称生成）"),
 		field.Int("shelf_life").Default(0).Comment("保质期（单位：天）"),
 		field.JSON("support_types", []domain.ProductSupportType{}).Comment("支持类型（堂食、外带）"),
 		// 属性关联
@@ -59,6 +61,21 @@ func (Product) Fields() []ent.Field {
 		field.JSON("detail_images", []string{}).Optional().Comment("详情图片（可选，多张）"),
 		field.String("description").MaxLen(2000).Default("").Comment("菜品描述（可选）"),
 
+		// 多语言
+		field.JSON("name_i18n", domain.LocalizedText{}).Optional().Comment("商品名称翻译（按语言）"),
+		field.JSON("description_i18n", domain.LocalizedText{}).Optional().Comment("菜品描述翻译（按语言）"),
+
+		// 过敏原与饮食属性
+		field.JSON("allergens", []domain.Allergen{}).Optional().Comment("过敏原（可选，可多选）"),
+		field.Bool("halal").Default(false).Comment("是否清真"),
+		field.Bool("vegetarian").Default(false).Comment("是否素食"),
+		field.Bool("vegan").Default(false).Comment("是否纯素"),
+		field.Int("spicy_level").GoType(domain.SpicyLevel(0)).Default(0).Comment("辣度：0（不辣）、1（微辣）、2（中辣）、3（特辣）"),
+		field.JSON("nutrition", &domain.ProductNutrition{}).Optional().Comment("营养成分（可选，每份）"),
+
+		// 口味做法点单限制
+		field.JSON("attr_limits", domain.ProductAttrLimits{}).Optional().Comment("口味做法分组点单限制（可选，按口味做法分组配置最少/最多选择数量）"),
+
 		// 套餐信息
 		field.Other("estimated_cost_price", decimal.Decimal{}).
 			SchemaType(map[string]string{
@@ -88,6 +105,7 @@ func (Product) Indexes() []ent.Index {
 		index.Fields("merchant_id"),
 		index.Fields("store_id"),
 		index.Fields("category_id"),
+		index.Fields("store_id", "pinyin_initials"),
 		// 唯一索引
 		index.Fields("merchant_id", "store_id", "name", "deleted_at").Unique(),
 	}
@@ -27,6 +27,7 @@ func (ProductAttr) Mixin() []ent.Mixin {
 func (ProductAttr) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").MaxLen(255).NotEmpty().Comment("口味做法名称"),
+		field.JSON("name_i18n", domain.LocalizedText{}).Optional().Comment("口味做法名称翻译（按语言）"),
 		field.JSON("channels", []domain.SaleChannel{}).Comment("售卖渠道列表"),
 		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
 		field.UUID("store_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("门店ID"),
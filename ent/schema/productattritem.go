@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
 )
 
@@ -29,6 +30,7 @@ func (ProductAttrItem) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("attr_id", uuid.UUID{}).Immutable().Comment("口味做法ID（外键）"),
 		field.String("name").MaxLen(255).NotEmpty().Comment("口味做法项名称"),
+		field.JSON("name_i18n", domain.LocalizedText{}).Optional().Comment("口味做法项名称翻译（按语言）"),
 		field.String("image").Default("").MaxLen(512).Comment("图片URL（可选）"),
 		field.Other("base_price", decimal.Decimal{}).
 			SchemaType(map[string]string{
@@ -29,6 +29,7 @@ func (ProductAttrRelation) Fields() []ent.Field {
 		field.UUID("attr_id", uuid.UUID{}).Immutable().Comment("口味做法ID（外键）"),
 		field.UUID("attr_item_id", uuid.UUID{}).Immutable().Comment("口味做法项ID（外键）"),
 		field.Bool("is_default").Default(false).Comment("是否默认项（当点单限制为必选一项时，必须设置其中一个为默认项）"),
+		field.Int("max_quantity").Default(1).Comment("单项最多可选数量（如加芝士 x2，默认 1）"),
 	}
 }
 
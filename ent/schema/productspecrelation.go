@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
 )
 
@@ -79,6 +80,7 @@ func (ProductSpecRelation) Fields() []ent.Field {
 			Comment("其他价格3（可选，单位：分）"),
 		field.String("barcode").MaxLen(255).Default("").Comment("条形码（可选，字符串，无限制）"),
 		field.Bool("is_default").Default(false).Comment("是否默认项（规格必须至少有一个默认项）"),
+		field.JSON("period_prices", domain.PeriodPrices{}).Optional().Comment("就餐时段价格（按门店就餐时段和星期覆盖基础价）"),
 	}
 }
 
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// ProductVersion 商品历史版本（商品、商品规格、口味做法项、菜单项）
+type ProductVersion struct {
+	ent.Schema
+}
+
+func (ProductVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the ProductVersion.
+func (ProductVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("subject_type").
+			GoType(domain.ProductVersionSubjectType("")).
+			Immutable().
+			Comment("对象类型：product（商品）、product_spec（商品规格）、attr_item（口味做法项）、menu_item（菜单项）"),
+		field.UUID("product_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("关联商品ID（口味做法项为空）"),
+		field.UUID("subject_id", uuid.UUID{}).Immutable().Comment("对象ID（商品ID、规格ID、口味做法项ID或菜单ID）"),
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("门店ID"),
+		field.Int("version").Immutable().Comment("版本号（同一对象内递增）"),
+		field.Enum("action").
+			GoType(domain.ProductVersionAction("")).
+			Immutable().
+			Comment("操作类型：create（创建）、update（修改）、delete（删除）、restore（恢复历史版本）"),
+		field.String("name").MaxLen(255).Default("").Immutable().Comment("对象名称快照"),
+		field.JSON("snapshot", json.RawMessage{}).Immutable().Comment("对象完整快照"),
+		field.JSON("changes", domain.ProductVersionChanges{}).Optional().Immutable().Comment("相比上一版本变更的字段"),
+		field.Int("restored_from").Default(0).Immutable().Comment("恢复来源版本号（仅恢复操作）"),
+		field.UUID("operator_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("操作人ID（系统执行时为空）"),
+		field.String("operator_type").MaxLen(32).Default("").Immutable().Comment("操作人类型"),
+		field.String("operator_name").MaxLen(255).Default("").Immutable().Comment("操作人名称"),
+	}
+}
+
+func (ProductVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("merchant_id", "store_id"),
+		index.Fields("product_id"),
+		// 按对象查询最新版本及时间点版本
+		index.Fields("subject_type", "product_id", "subject_id", "version").Unique(),
+		index.Fields("subject_type", "product_id", "subject_id", "created_at"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// Promotion 促销活动
+type Promotion struct {
+	ent.Schema
+}
+
+func (Promotion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+		schematype.SoftDeleteMixin{},
+	}
+}
+
+// Fields of the Promotion.
+func (Promotion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("门店ID（门店创建的活动仅本门店适用）"),
+		field.String("name").MaxLen(255).NotEmpty().Comment("活动名称"),
+		field.Enum("type").
+			GoType(domain.PromotionType("")).
+			Comment("促销类型：order_discount（满减/满折）、item_discount（单品/分类折扣）、buy_x_get_y（买X送Y）、second_item_discount（第二份折扣）"),
+		field.JSON("rule", domain.PromotionRule{}).Comment("优惠规则"),
+		field.JSON("store_ids", []uuid.UUID{}).Optional().Comment("适用门店ID列表（为空表示全部门店）"),
+		field.JSON("channels", []domain.OrderChannel{}).Optional().Comment("适用订单渠道（为空表示全部渠道）"),
+		field.JSON("dining_ways", []domain.DiningWay{}).Optional().Comment("适用就餐方式（为空表示全部）"),
+		field.Time("start_at").Optional().Nillable().Comment("活动开始时间"),
+		field.Time("end_at").Optional().Nillable().Comment("活动结束时间"),
+		field.JSON("time_windows", []domain.PromotionTimeWindow{}).Optional().Comment("活动时段（为空表示全天）"),
+		field.Int("priority").Default(0).Comment("优先级，值越大越先计算"),
+		field.Bool("stackable").Default(false).Comment("是否可与其他可叠加活动同时享受"),
+		field.Bool("enabled").Default(true).Comment("是否启用"),
+	}
+}
+
+func (Promotion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("merchant_id", "store_id"),
+		// 唯一索引
+		index.Fields("merchant_id", "store_id", "name", "deleted_at").Unique(),
+	}
+}
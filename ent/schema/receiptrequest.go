@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// ReceiptRequest 电子小票退款/开票申请
+type ReceiptRequest struct {
+	ent.Schema
+}
+
+func (ReceiptRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the ReceiptRequest.
+func (ReceiptRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.UUID("order_id", uuid.UUID{}).Immutable().Comment("订单ID"),
+		field.String("order_no").MaxLen(64).Default("").Immutable().Comment("订单号"),
+		field.Enum("type").GoType(domain.ReceiptRequestType("")).Immutable().Comment("申请类型：refund=退款；invoice=开票"),
+		field.Enum("status").GoType(domain.ReceiptRequestStatus("")).Comment("状态：pending=待处理；accepted=已受理；rejected=已拒绝"),
+		field.String("reason").MaxLen(255).Default("").Immutable().Comment("退款原因/开票备注"),
+		field.String("contact_phone").MaxLen(20).Default("").Immutable().Comment("联系电话"),
+		field.String("invoice_title").MaxLen(100).Default("").Immutable().Comment("发票抬头"),
+		field.String("invoice_tax_no").MaxLen(32).Default("").Immutable().Comment("纳税人识别号"),
+		field.String("invoice_email").MaxLen(100).Default("").Immutable().Comment("接收发票邮箱"),
+		field.String("handle_remark").MaxLen(255).Default("").Comment("处理说明"),
+		field.UUID("handled_by", uuid.UUID{}).Optional().Comment("处理人ID"),
+		field.Time("handled_at").Optional().Nillable().Comment("处理时间"),
+	}
+}
+
+func (ReceiptRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id"),
+		index.Fields("store_id", "status", "created_at"),
+	}
+}
@@ -30,6 +30,9 @@ func (Remark) Fields() []ent.Field {
 			NotEmpty().
 			MaxLen(50).
 			Comment("备注名称"),
+		field.JSON("name_i18n", domain.LocalizedText{}).
+			Optional().
+			Comment("备注名称翻译（按语言）"),
 
 		field.Enum("remark_type").
 			GoType(domain.RemarkType("")).
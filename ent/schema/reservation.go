@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// Reservation 桌台预订
+type Reservation struct {
+	ent.Schema
+}
+
+func (Reservation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the Reservation.
+func (Reservation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.Enum("source").GoType(domain.ReservationSource("")).Immutable().Comment("预订来源：store=门店代客预订；online=顾客线上预订"),
+		field.UUID("customer_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("线上预订的顾客ID"),
+		field.String("guest_name").MaxLen(64).Immutable().Comment("预订人姓名"),
+		field.String("guest_phone").MaxLen(32).Immutable().Comment("预订人手机号"),
+		field.Int("party_size").Immutable().Comment("用餐人数"),
+		field.Time("reserved_at").Immutable().Comment("预订到店时间"),
+		field.Time("end_at").Immutable().Comment("预计用餐结束时间"),
+		field.String("dining_period").MaxLen(64).Default("").Immutable().Comment("就餐时段名称"),
+		field.UUID("table_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("分配的桌台ID"),
+		field.String("table_name").MaxLen(64).Default("").Comment("分配的桌台名称"),
+		field.String("area_preference").MaxLen(64).Default("").Immutable().Comment("区域偏好"),
+		field.String("remark").MaxLen(255).Default("").Immutable().Comment("备注"),
+		field.Enum("status").GoType(domain.ReservationStatus("")).Comment("状态：booked=已预订；seated=已入座；no_show=未到店；cancelled=已取消"),
+		field.Other("deposit", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Immutable().
+			Comment("定金金额"),
+		field.Enum("deposit_status").GoType(domain.ReservationDepositStatus("")).Comment("定金状态：none=无需定金；unpaid=待收取；paid=已收取；refunded=已退还"),
+		field.String("deposit_method").MaxLen(32).GoType(domain.PaymentMethodPayType("")).Default("").Comment("定金支付方式"),
+		field.Time("deposit_paid_at").Optional().Nillable().Comment("定金收取时间"),
+		field.UUID("order_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("入座后生成的订单ID"),
+		field.Time("seated_at").Optional().Nillable().Comment("入座时间"),
+		field.Time("cancelled_at").Optional().Nillable().Comment("取消时间"),
+		field.String("cancel_reason").MaxLen(255).Default("").Comment("取消原因"),
+	}
+}
+
+func (Reservation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("store_id", "reserved_at"),
+		index.Fields("customer_id", "reserved_at"),
+	}
+}
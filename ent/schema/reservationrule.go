@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// ReservationRule 门店预订规则
+type ReservationRule struct {
+	ent.Schema
+}
+
+func (ReservationRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the ReservationRule.
+func (ReservationRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.Bool("online_enabled").Default(false).Comment("是否开放顾客线上预订"),
+		field.Int("slot_minutes").Comment("时段间隔（分钟）"),
+		field.Int("dining_minutes").Comment("用餐时长（分钟）"),
+		field.Int("min_advance_minutes").Default(0).Comment("线上预订需至少提前的分钟数"),
+		field.Int("max_advance_days").Comment("最多可提前预订的天数"),
+		field.Int("max_party_size").Comment("单笔预订人数上限"),
+		field.Other("deposit_per_guest", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:  "DECIMAL(19,4)",
+				dialect.SQLite: "NUMERIC",
+			}).
+			Comment("线上预订每位定金"),
+	}
+}
+
+func (ReservationRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("store_id").Unique(),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// ScanOrderRule 扫码点餐规则（品牌商默认规则 store_id 为空，门店规则覆盖默认规则）
+type ScanOrderRule struct {
+	ent.Schema
+}
+
+func (ScanOrderRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the ScanOrderRule.
+func (ScanOrderRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID，为空表示品牌商默认规则"),
+		field.Bool("enabled").Default(false).Comment("是否开启扫码点餐"),
+		field.Enum("pay_mode").GoType(domain.ScanOrderPayMode("")).Comment("支付方式：pay_first=先付后吃；pay_at_counter=前台结账"),
+	}
+}
+
+func (ScanOrderRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("merchant_id", "store_id").Unique(),
+	}
+}
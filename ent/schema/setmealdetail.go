@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
 )
 
@@ -30,6 +31,7 @@ func (SetMealDetail) Fields() []ent.Field {
 		field.Int("quantity").Default(1).Comment("数量（必选，必须为正整数）"),
 		field.Bool("is_default").Default(false).Comment("是否默认（必选，每个套餐组中只能有一个默认项）"),
 		field.JSON("optional_product_ids", []uuid.UUID{}).Optional().Comment("备选商品（可选，多选，当商品库存不足时，自动替换为备选商品）"),
+		field.JSON("optional_surcharges", map[uuid.UUID]decimal.Decimal{}).Optional().Comment("备选商品加价（备选商品ID -> 每份加价金额，未配置表示不加价）"),
 	}
 }
 
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// WaitlistRule 门店排队规则
+type WaitlistRule struct {
+	ent.Schema
+}
+
+func (WaitlistRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the WaitlistRule.
+func (WaitlistRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.Bool("online_enabled").Default(false).Comment("是否开放顾客扫码取号"),
+		field.Int("small_max_size").Comment("小桌人数上限"),
+		field.Int("medium_max_size").Comment("中桌人数上限"),
+		field.Int("max_party_size").Comment("单次取号人数上限"),
+		field.Int("default_turn_minutes").Comment("默认翻台时长（分钟）"),
+	}
+}
+
+func (WaitlistRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("store_id").Unique(),
+	}
+}
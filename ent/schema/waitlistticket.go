@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+)
+
+// WaitlistTicket 排队号
+type WaitlistTicket struct {
+	ent.Schema
+}
+
+func (WaitlistTicket) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		schematype.UUIDMixin{},
+		schematype.TimeMixin{},
+	}
+}
+
+// Fields of the WaitlistTicket.
+func (WaitlistTicket) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("merchant_id", uuid.UUID{}).Immutable().Comment("品牌商ID"),
+		field.UUID("store_id", uuid.UUID{}).Immutable().Comment("门店ID"),
+		field.String("business_date").MaxLen(10).Immutable().Comment("营业日"),
+		field.Enum("bucket").GoType(domain.WaitlistBucket("")).Immutable().Comment("分组：small=小桌；medium=中桌；large=大桌"),
+		field.String("ticket_no").MaxLen(16).Immutable().Comment("排队号"),
+		field.Int("party_size").Immutable().Comment("用餐人数"),
+		field.String("guest_phone").MaxLen(32).Default("").Immutable().Comment("顾客手机号"),
+		field.Enum("source").GoType(domain.WaitlistTicketSource("")).Immutable().Comment("取号来源：store=门店取号；online=顾客扫码取号"),
+		field.UUID("customer_id", uuid.UUID{}).Default(schematype.NilUUID()).Immutable().Comment("扫码取号的顾客ID"),
+		field.Enum("status").GoType(domain.WaitlistTicketStatus("")).Comment("状态：waiting=等待中；called=已叫号；seated=已入座；skipped=已过号；cancelled=已取消"),
+		field.Int("call_count").Default(0).Comment("叫号次数"),
+		field.Time("called_at").Optional().Nillable().Comment("最近叫号时间"),
+		field.Time("seated_at").Optional().Nillable().Comment("入座时间"),
+		field.Time("cancelled_at").Optional().Nillable().Comment("取消或过号时间"),
+		field.UUID("table_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("入座桌台ID"),
+		field.String("table_name").MaxLen(64).Default("").Comment("入座桌台名称"),
+		field.UUID("order_id", uuid.UUID{}).Default(schematype.NilUUID()).Comment("入座后生成的订单ID"),
+	}
+}
+
+func (WaitlistTicket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("store_id", "business_date"),
+		index.Fields("customer_id", "business_date"),
+	}
+}
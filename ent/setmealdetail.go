@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealdetail"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealgroup"
@@ -38,6 +39,8 @@ type SetMealDetail struct {
 	IsDefault bool `json:"is_default,omitempty"`
 	// 备选商品（可选，多选，当商品库存不足时，自动替换为备选商品）
 	OptionalProductIds []uuid.UUID `json:"optional_product_ids,omitempty"`
+	// 备选商品加价（备选商品ID -> 每份加价金额，未配置表示不加价）
+	OptionalSurcharges map[uuid.UUID]decimal.Decimal `json:"optional_surcharges,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SetMealDetailQuery when eager-loading is set.
 	Edges        SetMealDetailEdges `json:"edges"`
@@ -82,7 +85,7 @@ func (*SetMealDetail) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case setmealdetail.FieldOptionalProductIds:
+		case setmealdetail.FieldOptionalProductIds, setmealdetail.FieldOptionalSurcharges:
 			values[i] = new([]byte)
 		case setmealdetail.FieldIsDefault:
 			values[i] = new(sql.NullBool)
@@ -163,6 +166,14 @@ func (smd *SetMealDetail) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field optional_product_ids: %w", err)
 				}
 			}
+		case setmealdetail.FieldOptionalSurcharges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_surcharges", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &smd.OptionalSurcharges); err != nil {
+					return fmt.Errorf("unmarshal field optional_surcharges: %w", err)
+				}
+			}
 		default:
 			smd.selectValues.Set(columns[i], values[i])
 		}
@@ -232,6 +243,9 @@ func (smd *SetMealDetail) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("optional_product_ids=")
 	builder.WriteString(fmt.Sprintf("%v", smd.OptionalProductIds))
+	builder.WriteString(", ")
+	builder.WriteString("optional_surcharges=")
+	builder.WriteString(fmt.Sprintf("%v", smd.OptionalSurcharges))
 	builder.WriteByte(')')
 	return builder.String()
 }
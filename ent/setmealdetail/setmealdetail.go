@@ -32,6 +32,8 @@ const (
 	FieldIsDefault = "is_default"
 	// FieldOptionalProductIds holds the string denoting the optional_product_ids field in the database.
 	FieldOptionalProductIds = "optional_product_ids"
+	// FieldOptionalSurcharges holds the string denoting the optional_surcharges field in the database.
+	FieldOptionalSurcharges = "optional_surcharges"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// EdgeProduct holds the string denoting the product edge name in mutations.
@@ -65,6 +67,7 @@ var Columns = []string{
 	FieldQuantity,
 	FieldIsDefault,
 	FieldOptionalProductIds,
+	FieldOptionalSurcharges,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
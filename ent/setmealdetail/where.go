@@ -311,6 +311,16 @@ func OptionalProductIdsNotNil() predicate.SetMealDetail {
 	return predicate.SetMealDetail(sql.FieldNotNull(FieldOptionalProductIds))
 }
 
+// OptionalSurchargesIsNil applies the IsNil predicate on the "optional_surcharges" field.
+func OptionalSurchargesIsNil() predicate.SetMealDetail {
+	return predicate.SetMealDetail(sql.FieldIsNull(FieldOptionalSurcharges))
+}
+
+// OptionalSurchargesNotNil applies the NotNil predicate on the "optional_surcharges" field.
+func OptionalSurchargesNotNil() predicate.SetMealDetail {
+	return predicate.SetMealDetail(sql.FieldNotNull(FieldOptionalSurcharges))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.SetMealDetail {
 	return predicate.SetMealDetail(func(s *sql.Selector) {
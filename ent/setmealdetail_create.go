@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealdetail"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealgroup"
@@ -114,6 +115,12 @@ func (smdc *SetMealDetailCreate) SetOptionalProductIds(u []uuid.UUID) *SetMealDe
 	return smdc
 }
 
+// SetOptionalSurcharges sets the "optional_surcharges" field.
+func (smdc *SetMealDetailCreate) SetOptionalSurcharges(m map[uuid.UUID]decimal.Decimal) *SetMealDetailCreate {
+	smdc.mutation.SetOptionalSurcharges(m)
+	return smdc
+}
+
 // SetID sets the "id" field.
 func (smdc *SetMealDetailCreate) SetID(u uuid.UUID) *SetMealDetailCreate {
 	smdc.mutation.SetID(u)
@@ -300,6 +307,10 @@ func (smdc *SetMealDetailCreate) createSpec() (*SetMealDetail, *sqlgraph.CreateS
 		_spec.SetField(setmealdetail.FieldOptionalProductIds, field.TypeJSON, value)
 		_node.OptionalProductIds = value
 	}
+	if value, ok := smdc.mutation.OptionalSurcharges(); ok {
+		_spec.SetField(setmealdetail.FieldOptionalSurcharges, field.TypeJSON, value)
+		_node.OptionalSurcharges = value
+	}
 	if nodes := smdc.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -464,6 +475,24 @@ func (u *SetMealDetailUpsert) ClearOptionalProductIds() *SetMealDetailUpsert {
 	return u
 }
 
+// SetOptionalSurcharges sets the "optional_surcharges" field.
+func (u *SetMealDetailUpsert) SetOptionalSurcharges(v map[uuid.UUID]decimal.Decimal) *SetMealDetailUpsert {
+	u.Set(setmealdetail.FieldOptionalSurcharges, v)
+	return u
+}
+
+// UpdateOptionalSurcharges sets the "optional_surcharges" field to the value that was provided on create.
+func (u *SetMealDetailUpsert) UpdateOptionalSurcharges() *SetMealDetailUpsert {
+	u.SetExcluded(setmealdetail.FieldOptionalSurcharges)
+	return u
+}
+
+// ClearOptionalSurcharges clears the value of the "optional_surcharges" field.
+func (u *SetMealDetailUpsert) ClearOptionalSurcharges() *SetMealDetailUpsert {
+	u.SetNull(setmealdetail.FieldOptionalSurcharges)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -612,6 +641,27 @@ func (u *SetMealDetailUpsertOne) ClearOptionalProductIds() *SetMealDetailUpsertO
 	})
 }
 
+// SetOptionalSurcharges sets the "optional_surcharges" field.
+func (u *SetMealDetailUpsertOne) SetOptionalSurcharges(v map[uuid.UUID]decimal.Decimal) *SetMealDetailUpsertOne {
+	return u.Update(func(s *SetMealDetailUpsert) {
+		s.SetOptionalSurcharges(v)
+	})
+}
+
+// UpdateOptionalSurcharges sets the "optional_surcharges" field to the value that was provided on create.
+func (u *SetMealDetailUpsertOne) UpdateOptionalSurcharges() *SetMealDetailUpsertOne {
+	return u.Update(func(s *SetMealDetailUpsert) {
+		s.UpdateOptionalSurcharges()
+	})
+}
+
+// ClearOptionalSurcharges clears the value of the "optional_surcharges" field.
+func (u *SetMealDetailUpsertOne) ClearOptionalSurcharges() *SetMealDetailUpsertOne {
+	return u.Update(func(s *SetMealDetailUpsert) {
+		s.ClearOptionalSurcharges()
+	})
+}
+
 // Exec executes the query.
 func (u *SetMealDetailUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -927,6 +977,27 @@ func (u *SetMealDetailUpsertBulk) ClearOptionalProductIds() *SetMealDetailUpsert
 	})
 }
 
+// SetOptionalSurcharges sets the "optional_surcharges" field.
+func (u *SetMealDetailUpsertBulk) SetOptionalSurcharges(v map[uuid.UUID]decimal.Decimal) *SetMealDetailUpsertBulk {
+	return u.Update(func(s *SetMealDetailUpsert) {
+		s.SetOptionalSurcharges(v)
+	})
+}
+
+// UpdateOptionalSurcharges sets the "optional_surcharges" field to the value that was provided on create.
+func (u *SetMealDetailUpsertBulk) UpdateOptionalSurcharges() *SetMealDetailUpsertBulk {
+	return u.Update(func(s *SetMealDetailUpsert) {
+		s.UpdateOptionalSurcharges()
+	})
+}
+
+// ClearOptionalSurcharges clears the value of the "optional_surcharges" field.
+func (u *SetMealDetailUpsertBulk) ClearOptionalSurcharges() *SetMealDetailUpsertBulk {
+	return u.Update(func(s *SetMealDetailUpsert) {
+		s.ClearOptionalSurcharges()
+	})
+}
+
 // Exec executes the query.
 func (u *SetMealDetailUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/setmealdetail"
 )
@@ -111,6 +112,18 @@ func (smdu *SetMealDetailUpdate) ClearOptionalProductIds() *SetMealDetailUpdate
 	return smdu
 }
 
+// SetOptionalSurcharges sets the "optional_surcharges" field.
+func (smdu *SetMealDetailUpdate) SetOptionalSurcharges(m map[uuid.UUID]decimal.Decimal) *SetMealDetailUpdate {
+	smdu.mutation.SetOptionalSurcharges(m)
+	return smdu
+}
+
+// ClearOptionalSurcharges clears the value of the "optional_surcharges" field.
+func (smdu *SetMealDetailUpdate) ClearOptionalSurcharges() *SetMealDetailUpdate {
+	smdu.mutation.ClearOptionalSurcharges()
+	return smdu
+}
+
 // Mutation returns the SetMealDetailMutation object of the builder.
 func (smdu *SetMealDetailUpdate) Mutation() *SetMealDetailMutation {
 	return smdu.mutation
@@ -216,6 +229,12 @@ func (smdu *SetMealDetailUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if smdu.mutation.OptionalProductIdsCleared() {
 		_spec.ClearField(setmealdetail.FieldOptionalProductIds, field.TypeJSON)
 	}
+	if value, ok := smdu.mutation.OptionalSurcharges(); ok {
+		_spec.SetField(setmealdetail.FieldOptionalSurcharges, field.TypeJSON, value)
+	}
+	if smdu.mutation.OptionalSurchargesCleared() {
+		_spec.ClearField(setmealdetail.FieldOptionalSurcharges, field.TypeJSON)
+	}
 	_spec.AddModifiers(smdu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, smdu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -318,6 +337,18 @@ func (smduo *SetMealDetailUpdateOne) ClearOptionalProductIds() *SetMealDetailUpd
 	return smduo
 }
 
+// SetOptionalSurcharges sets the "optional_surcharges" field.
+func (smduo *SetMealDetailUpdateOne) SetOptionalSurcharges(m map[uuid.UUID]decimal.Decimal) *SetMealDetailUpdateOne {
+	smduo.mutation.SetOptionalSurcharges(m)
+	return smduo
+}
+
+// ClearOptionalSurcharges clears the value of the "optional_surcharges" field.
+func (smduo *SetMealDetailUpdateOne) ClearOptionalSurcharges() *SetMealDetailUpdateOne {
+	smduo.mutation.ClearOptionalSurcharges()
+	return smduo
+}
+
 // Mutation returns the SetMealDetailMutation object of the builder.
 func (smduo *SetMealDetailUpdateOne) Mutation() *SetMealDetailMutation {
 	return smduo.mutation
@@ -453,6 +484,12 @@ func (smduo *SetMealDetailUpdateOne) sqlSave(ctx context.Context) (_node *SetMea
 	if smduo.mutation.OptionalProductIdsCleared() {
 		_spec.ClearField(setmealdetail.FieldOptionalProductIds, field.TypeJSON)
 	}
+	if value, ok := smduo.mutation.OptionalSurcharges(); ok {
+		_spec.SetField(setmealdetail.FieldOptionalSurcharges, field.TypeJSON, value)
+	}
+	if smduo.mutation.OptionalSurchargesCleared() {
+		_spec.ClearField(setmealdetail.FieldOptionalSurcharges, field.TypeJSON)
+	}
 	_spec.AddModifiers(smduo.modifiers...)
 	_node = &SetMealDetail{config: smduo.config}
 	_spec.Assign = _node.assignValues
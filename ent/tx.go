@@ -24,14 +24,46 @@ type Tx struct {
 	BusinessConfig *BusinessConfigClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// Coupon is the client for interacting with the Coupon builders.
+	Coupon *CouponClient
+	// CouponTemplate is the client for interacting with the CouponTemplate builders.
+	CouponTemplate *CouponTemplateClient
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
+	// DeliveryOrder is the client for interacting with the DeliveryOrder builders.
+	DeliveryOrder *DeliveryOrderClient
+	// DeliveryProductMapping is the client for interacting with the DeliveryProductMapping builders.
+	DeliveryProductMapping *DeliveryProductMappingClient
+	// DeliveryStore is the client for interacting with the DeliveryStore builders.
+	DeliveryStore *DeliveryStoreClient
 	// Department is the client for interacting with the Department builders.
 	Department *DepartmentClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// DiningTable is the client for interacting with the DiningTable builders.
+	DiningTable *DiningTableClient
+	// KitchenTicket is the client for interacting with the KitchenTicket builders.
+	KitchenTicket *KitchenTicketClient
+	// Member is the client for interacting with the Member builders.
+	Member *MemberClient
+	// MemberAccount is the client for interacting with the MemberAccount builders.
+	MemberAccount *MemberAccountClient
+	// MemberAccountTransaction is the client for interacting with the MemberAccountTransaction builders.
+	MemberAccountTransaction *MemberAccountTransactionClient
+	// MemberPointsAccount is the client for interacting with the MemberPointsAccount builders.
+	MemberPointsAccount *MemberPointsAccountClient
+	// MemberPointsRule is the client for interacting with the MemberPointsRule builders.
+	MemberPointsRule *MemberPointsRuleClient
+	// MemberPointsTransaction is the client for interacting with the MemberPointsTransaction builders.
+	MemberPointsTransaction *MemberPointsTransactionClient
+	// MemberTier is the client for interacting with the MemberTier builders.
+	MemberTier *MemberTierClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
 	// MenuItem is the client for interacting with the MenuItem builders.
 	MenuItem *MenuItemClient
+	// MenuVersion is the client for interacting with the MenuVersion builders.
+	MenuVersion *MenuVersionClient
 	// Merchant is the client for interacting with the Merchant builders.
 	Merchant *MerchantClient
 	// MerchantBusinessType is the client for interacting with the MerchantBusinessType builders.
@@ -48,6 +80,18 @@ type Tx struct {
 	PaymentMethod *PaymentMethodClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
+	// PickupCall is the client for interacting with the PickupCall builders.
+	PickupCall *PickupCallClient
+	// PickupRule is the client for interacting with the PickupRule builders.
+	PickupRule *PickupRuleClient
+	// Preorder is the client for interacting with the Preorder builders.
+	Preorder *PreorderClient
+	// PreorderRule is the client for interacting with the PreorderRule builders.
+	PreorderRule *PreorderRuleClient
+	// PriceChangeBatch is the client for interacting with the PriceChangeBatch builders.
+	PriceChangeBatch *PriceChangeBatchClient
+	// PriceChangeItem is the client for interacting with the PriceChangeItem builders.
+	PriceChangeItem *PriceChangeItemClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
 	// ProductAttr is the client for interacting with the ProductAttr builders.
@@ -64,16 +108,26 @@ type Tx struct {
 	ProductTag *ProductTagClient
 	// ProductUnit is the client for interacting with the ProductUnit builders.
 	ProductUnit *ProductUnitClient
+	// ProductVersion is the client for interacting with the ProductVersion builders.
+	ProductVersion *ProductVersionClient
 	// ProfitDistributionBill is the client for interacting with the ProfitDistributionBill builders.
 	ProfitDistributionBill *ProfitDistributionBillClient
 	// ProfitDistributionRule is the client for interacting with the ProfitDistributionRule builders.
 	ProfitDistributionRule *ProfitDistributionRuleClient
+	// Promotion is the client for interacting with the Promotion builders.
+	Promotion *PromotionClient
+	// ReceiptRequest is the client for interacting with the ReceiptRequest builders.
+	ReceiptRequest *ReceiptRequestClient
 	// RefundOrder is the client for interacting with the RefundOrder builders.
 	RefundOrder *RefundOrderClient
 	// RefundOrderProduct is the client for interacting with the RefundOrderProduct builders.
 	RefundOrderProduct *RefundOrderProductClient
 	// Remark is the client for interacting with the Remark builders.
 	Remark *RemarkClient
+	// Reservation is the client for interacting with the Reservation builders.
+	Reservation *ReservationClient
+	// ReservationRule is the client for interacting with the ReservationRule builders.
+	ReservationRule *ReservationRuleClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RoleMenu is the client for interacting with the RoleMenu builders.
@@ -82,6 +136,8 @@ type Tx struct {
 	RolePermission *RolePermissionClient
 	// RouterMenu is the client for interacting with the RouterMenu builders.
 	RouterMenu *RouterMenuClient
+	// ScanOrderRule is the client for interacting with the ScanOrderRule builders.
+	ScanOrderRule *ScanOrderRuleClient
 	// SetMealDetail is the client for interacting with the SetMealDetail builders.
 	SetMealDetail *SetMealDetailClient
 	// SetMealGroup is the client for interacting with the SetMealGroup builders.
@@ -98,6 +154,10 @@ type Tx struct {
 	TaxFee *TaxFeeClient
 	// UserRole is the client for interacting with the UserRole builders.
 	UserRole *UserRoleClient
+	// WaitlistRule is the client for interacting with the WaitlistRule builders.
+	WaitlistRule *WaitlistRuleClient
+	// WaitlistTicket is the client for interacting with the WaitlistTicket builders.
+	WaitlistTicket *WaitlistTicketClient
 
 	// lazily loaded.
 	client     *Client
@@ -234,10 +294,26 @@ func (tx *Tx) init() {
 	tx.BackendUser = NewBackendUserClient(tx.config)
 	tx.BusinessConfig = NewBusinessConfigClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
+	tx.Coupon = NewCouponClient(tx.config)
+	tx.CouponTemplate = NewCouponTemplateClient(tx.config)
+	tx.Customer = NewCustomerClient(tx.config)
+	tx.DeliveryOrder = NewDeliveryOrderClient(tx.config)
+	tx.DeliveryProductMapping = NewDeliveryProductMappingClient(tx.config)
+	tx.DeliveryStore = NewDeliveryStoreClient(tx.config)
 	tx.Department = NewDepartmentClient(tx.config)
 	tx.Device = NewDeviceClient(tx.config)
+	tx.DiningTable = NewDiningTableClient(tx.config)
+	tx.KitchenTicket = NewKitchenTicketClient(tx.config)
+	tx.Member = NewMemberClient(tx.config)
+	tx.MemberAccount = NewMemberAccountClient(tx.config)
+	tx.MemberAccountTransaction = NewMemberAccountTransactionClient(tx.config)
+	tx.MemberPointsAccount = NewMemberPointsAccountClient(tx.config)
+	tx.MemberPointsRule = NewMemberPointsRuleClient(tx.config)
+	tx.MemberPointsTransaction = NewMemberPointsTransactionClient(tx.config)
+	tx.MemberTier = NewMemberTierClient(tx.config)
 	tx.Menu = NewMenuClient(tx.config)
 	tx.MenuItem = NewMenuItemClient(tx.config)
+	tx.MenuVersion = NewMenuVersionClient(tx.config)
 	tx.Merchant = NewMerchantClient(tx.config)
 	tx.MerchantBusinessType = NewMerchantBusinessTypeClient(tx.config)
 	tx.MerchantRenewal = NewMerchantRenewalClient(tx.config)
@@ -246,6 +322,12 @@ func (tx *Tx) init() {
 	tx.PaymentAccount = NewPaymentAccountClient(tx.config)
 	tx.PaymentMethod = NewPaymentMethodClient(tx.config)
 	tx.Permission = NewPermissionClient(tx.config)
+	tx.PickupCall = NewPickupCallClient(tx.config)
+	tx.PickupRule = NewPickupRuleClient(tx.config)
+	tx.Preorder = NewPreorderClient(tx.config)
+	tx.PreorderRule = NewPreorderRuleClient(tx.config)
+	tx.PriceChangeBatch = NewPriceChangeBatchClient(tx.config)
+	tx.PriceChangeItem = NewPriceChangeItemClient(tx.config)
 	tx.Product = NewProductClient(tx.config)
 	tx.ProductAttr = NewProductAttrClient(tx.config)
 	tx.ProductAttrItem = NewProductAttrItemClient(tx.config)
@@ -254,15 +336,21 @@ func (tx *Tx) init() {
 	tx.ProductSpecRelation = NewProductSpecRelationClient(tx.config)
 	tx.ProductTag = NewProductTagClient(tx.config)
 	tx.ProductUnit = NewProductUnitClient(tx.config)
+	tx.ProductVersion = NewProductVersionClient(tx.config)
 	tx.ProfitDistributionBill = NewProfitDistributionBillClient(tx.config)
 	tx.ProfitDistributionRule = NewProfitDistributionRuleClient(tx.config)
+	tx.Promotion = NewPromotionClient(tx.config)
+	tx.ReceiptRequest = NewReceiptRequestClient(tx.config)
 	tx.RefundOrder = NewRefundOrderClient(tx.config)
 	tx.RefundOrderProduct = NewRefundOrderProductClient(tx.config)
 	tx.Remark = NewRemarkClient(tx.config)
+	tx.Reservation = NewReservationClient(tx.config)
+	tx.ReservationRule = NewReservationRuleClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
 	tx.RoleMenu = NewRoleMenuClient(tx.config)
 	tx.RolePermission = NewRolePermissionClient(tx.config)
 	tx.RouterMenu = NewRouterMenuClient(tx.config)
+	tx.ScanOrderRule = NewScanOrderRuleClient(tx.config)
 	tx.SetMealDetail = NewSetMealDetailClient(tx.config)
 	tx.SetMealGroup = NewSetMealGroupClient(tx.config)
 	tx.Stall = NewStallClient(tx.config)
@@ -271,6 +359,8 @@ func (tx *Tx) init() {
 	tx.StoreUser = NewStoreUserClient(tx.config)
 	tx.TaxFee = NewTaxFeeClient(tx.config)
 	tx.UserRole = NewUserRoleClient(tx.config)
+	tx.WaitlistRule = NewWaitlistRuleClient(tx.config)
+	tx.WaitlistTicket = NewWaitlistTicketClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistrule"
+)
+
+// WaitlistRule is the model entity for the WaitlistRule schema.
+type WaitlistRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 是否开放顾客扫码取号
+	OnlineEnabled bool `json:"online_enabled,omitempty"`
+	// 小桌人数上限
+	SmallMaxSize int `json:"small_max_size,omitempty"`
+	// 中桌人数上限
+	MediumMaxSize int `json:"medium_max_size,omitempty"`
+	// 单次取号人数上限
+	MaxPartySize int `json:"max_party_size,omitempty"`
+	// 默认翻台时长（分钟）
+	DefaultTurnMinutes int `json:"default_turn_minutes,omitempty"`
+	selectValues       sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WaitlistRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case waitlistrule.FieldOnlineEnabled:
+			values[i] = new(sql.NullBool)
+		case waitlistrule.FieldSmallMaxSize, waitlistrule.FieldMediumMaxSize, waitlistrule.FieldMaxPartySize, waitlistrule.FieldDefaultTurnMinutes:
+			values[i] = new(sql.NullInt64)
+		case waitlistrule.FieldCreatedAt, waitlistrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case waitlistrule.FieldID, waitlistrule.FieldMerchantID, waitlistrule.FieldStoreID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WaitlistRule fields.
+func (wr *WaitlistRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case waitlistrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wr.ID = *value
+			}
+		case waitlistrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wr.CreatedAt = value.Time
+			}
+		case waitlistrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wr.UpdatedAt = value.Time
+			}
+		case waitlistrule.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				wr.MerchantID = *value
+			}
+		case waitlistrule.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				wr.StoreID = *value
+			}
+		case waitlistrule.FieldOnlineEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field online_enabled", values[i])
+			} else if value.Valid {
+				wr.OnlineEnabled = value.Bool
+			}
+		case waitlistrule.FieldSmallMaxSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field small_max_size", values[i])
+			} else if value.Valid {
+				wr.SmallMaxSize = int(value.Int64)
+			}
+		case waitlistrule.FieldMediumMaxSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field medium_max_size", values[i])
+			} else if value.Valid {
+				wr.MediumMaxSize = int(value.Int64)
+			}
+		case waitlistrule.FieldMaxPartySize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_party_size", values[i])
+			} else if value.Valid {
+				wr.MaxPartySize = int(value.Int64)
+			}
+		case waitlistrule.FieldDefaultTurnMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_turn_minutes", values[i])
+			} else if value.Valid {
+				wr.DefaultTurnMinutes = int(value.Int64)
+			}
+		default:
+			wr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WaitlistRule.
+// This includes values selected through modifiers, order, etc.
+func (wr *WaitlistRule) Value(name string) (ent.Value, error) {
+	return wr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WaitlistRule.
+// Note that you need to call WaitlistRule.Unwrap() before calling this method if this WaitlistRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wr *WaitlistRule) Update() *WaitlistRuleUpdateOne {
+	return NewWaitlistRuleClient(wr.config).UpdateOne(wr)
+}
+
+// Unwrap unwraps the WaitlistRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wr *WaitlistRule) Unwrap() *WaitlistRule {
+	_tx, ok := wr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WaitlistRule is not a transactional entity")
+	}
+	wr.config.driver = _tx.drv
+	return wr
+}
+
+// String implements the fmt.Stringer.
+func (wr *WaitlistRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("WaitlistRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", wr.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", wr.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("online_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", wr.OnlineEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("small_max_size=")
+	builder.WriteString(fmt.Sprintf("%v", wr.SmallMaxSize))
+	builder.WriteString(", ")
+	builder.WriteString("medium_max_size=")
+	builder.WriteString(fmt.Sprintf("%v", wr.MediumMaxSize))
+	builder.WriteString(", ")
+	builder.WriteString("max_party_size=")
+	builder.WriteString(fmt.Sprintf("%v", wr.MaxPartySize))
+	builder.WriteString(", ")
+	builder.WriteString("default_turn_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", wr.DefaultTurnMinutes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WaitlistRules is a parsable slice of WaitlistRule.
+type WaitlistRules []*WaitlistRule
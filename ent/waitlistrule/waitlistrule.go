@@ -0,0 +1,127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package waitlistrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the waitlistrule type in the database.
+	Label = "waitlist_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldOnlineEnabled holds the string denoting the online_enabled field in the database.
+	FieldOnlineEnabled = "online_enabled"
+	// FieldSmallMaxSize holds the string denoting the small_max_size field in the database.
+	FieldSmallMaxSize = "small_max_size"
+	// FieldMediumMaxSize holds the string denoting the medium_max_size field in the database.
+	FieldMediumMaxSize = "medium_max_size"
+	// FieldMaxPartySize holds the string denoting the max_party_size field in the database.
+	FieldMaxPartySize = "max_party_size"
+	// FieldDefaultTurnMinutes holds the string denoting the default_turn_minutes field in the database.
+	FieldDefaultTurnMinutes = "default_turn_minutes"
+	// Table holds the table name of the waitlistrule in the database.
+	Table = "waitlist_rules"
+)
+
+// Columns holds all SQL columns for waitlistrule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldOnlineEnabled,
+	FieldSmallMaxSize,
+	FieldMediumMaxSize,
+	FieldMaxPartySize,
+	FieldDefaultTurnMinutes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultOnlineEnabled holds the default value on creation for the "online_enabled" field.
+	DefaultOnlineEnabled bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the WaitlistRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByOnlineEnabled orders the results by the online_enabled field.
+func ByOnlineEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnlineEnabled, opts...).ToFunc()
+}
+
+// BySmallMaxSize orders the results by the small_max_size field.
+func BySmallMaxSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSmallMaxSize, opts...).ToFunc()
+}
+
+// ByMediumMaxSize orders the results by the medium_max_size field.
+func ByMediumMaxSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMediumMaxSize, opts...).ToFunc()
+}
+
+// ByMaxPartySize orders the results by the max_party_size field.
+func ByMaxPartySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxPartySize, opts...).ToFunc()
+}
+
+// ByDefaultTurnMinutes orders the results by the default_turn_minutes field.
+func ByDefaultTurnMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultTurnMinutes, opts...).ToFunc()
+}
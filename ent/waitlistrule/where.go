@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package waitlistrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldStoreID, v))
+}
+
+// OnlineEnabled applies equality check predicate on the "online_enabled" field. It's identical to OnlineEnabledEQ.
+func OnlineEnabled(v bool) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldOnlineEnabled, v))
+}
+
+// SmallMaxSize applies equality check predicate on the "small_max_size" field. It's identical to SmallMaxSizeEQ.
+func SmallMaxSize(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldSmallMaxSize, v))
+}
+
+// MediumMaxSize applies equality check predicate on the "medium_max_size" field. It's identical to MediumMaxSizeEQ.
+func MediumMaxSize(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldMediumMaxSize, v))
+}
+
+// MaxPartySize applies equality check predicate on the "max_party_size" field. It's identical to MaxPartySizeEQ.
+func MaxPartySize(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldMaxPartySize, v))
+}
+
+// DefaultTurnMinutes applies equality check predicate on the "default_turn_minutes" field. It's identical to DefaultTurnMinutesEQ.
+func DefaultTurnMinutes(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldDefaultTurnMinutes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLTE(FieldStoreID, v))
+}
+
+// OnlineEnabledEQ applies the EQ predicate on the "online_enabled" field.
+func OnlineEnabledEQ(v bool) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldOnlineEnabled, v))
+}
+
+// OnlineEnabledNEQ applies the NEQ predicate on the "online_enabled" field.
+func OnlineEnabledNEQ(v bool) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNEQ(FieldOnlineEnabled, v))
+}
+
+// SmallMaxSizeEQ applies the EQ predicate on the "small_max_size" field.
+func SmallMaxSizeEQ(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldSmallMaxSize, v))
+}
+
+// SmallMaxSizeNEQ applies the NEQ predicate on the "small_max_size" field.
+func SmallMaxSizeNEQ(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNEQ(FieldSmallMaxSize, v))
+}
+
+// SmallMaxSizeIn applies the In predicate on the "small_max_size" field.
+func SmallMaxSizeIn(vs ...int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldIn(FieldSmallMaxSize, vs...))
+}
+
+// SmallMaxSizeNotIn applies the NotIn predicate on the "small_max_size" field.
+func SmallMaxSizeNotIn(vs ...int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNotIn(FieldSmallMaxSize, vs...))
+}
+
+// SmallMaxSizeGT applies the GT predicate on the "small_max_size" field.
+func SmallMaxSizeGT(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGT(FieldSmallMaxSize, v))
+}
+
+// SmallMaxSizeGTE applies the GTE predicate on the "small_max_size" field.
+func SmallMaxSizeGTE(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGTE(FieldSmallMaxSize, v))
+}
+
+// SmallMaxSizeLT applies the LT predicate on the "small_max_size" field.
+func SmallMaxSizeLT(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLT(FieldSmallMaxSize, v))
+}
+
+// SmallMaxSizeLTE applies the LTE predicate on the "small_max_size" field.
+func SmallMaxSizeLTE(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLTE(FieldSmallMaxSize, v))
+}
+
+// MediumMaxSizeEQ applies the EQ predicate on the "medium_max_size" field.
+func MediumMaxSizeEQ(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldMediumMaxSize, v))
+}
+
+// MediumMaxSizeNEQ applies the NEQ predicate on the "medium_max_size" field.
+func MediumMaxSizeNEQ(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNEQ(FieldMediumMaxSize, v))
+}
+
+// MediumMaxSizeIn applies the In predicate on the "medium_max_size" field.
+func MediumMaxSizeIn(vs ...int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldIn(FieldMediumMaxSize, vs...))
+}
+
+// MediumMaxSizeNotIn applies the NotIn predicate on the "medium_max_size" field.
+func MediumMaxSizeNotIn(vs ...int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNotIn(FieldMediumMaxSize, vs...))
+}
+
+// MediumMaxSizeGT applies the GT predicate on the "medium_max_size" field.
+func MediumMaxSizeGT(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGT(FieldMediumMaxSize, v))
+}
+
+// MediumMaxSizeGTE applies the GTE predicate on the "medium_max_size" field.
+func MediumMaxSizeGTE(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGTE(FieldMediumMaxSize, v))
+}
+
+// MediumMaxSizeLT applies the LT predicate on the "medium_max_size" field.
+func MediumMaxSizeLT(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLT(FieldMediumMaxSize, v))
+}
+
+// MediumMaxSizeLTE applies the LTE predicate on the "medium_max_size" field.
+func MediumMaxSizeLTE(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLTE(FieldMediumMaxSize, v))
+}
+
+// MaxPartySizeEQ applies the EQ predicate on the "max_party_size" field.
+func MaxPartySizeEQ(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldMaxPartySize, v))
+}
+
+// MaxPartySizeNEQ applies the NEQ predicate on the "max_party_size" field.
+func MaxPartySizeNEQ(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNEQ(FieldMaxPartySize, v))
+}
+
+// MaxPartySizeIn applies the In predicate on the "max_party_size" field.
+func MaxPartySizeIn(vs ...int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldIn(FieldMaxPartySize, vs...))
+}
+
+// MaxPartySizeNotIn applies the NotIn predicate on the "max_party_size" field.
+func MaxPartySizeNotIn(vs ...int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNotIn(FieldMaxPartySize, vs...))
+}
+
+// MaxPartySizeGT applies the GT predicate on the "max_party_size" field.
+func MaxPartySizeGT(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGT(FieldMaxPartySize, v))
+}
+
+// MaxPartySizeGTE applies the GTE predicate on the "max_party_size" field.
+func MaxPartySizeGTE(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGTE(FieldMaxPartySize, v))
+}
+
+// MaxPartySizeLT applies the LT predicate on the "max_party_size" field.
+func MaxPartySizeLT(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLT(FieldMaxPartySize, v))
+}
+
+// MaxPartySizeLTE applies the LTE predicate on the "max_party_size" field.
+func MaxPartySizeLTE(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLTE(FieldMaxPartySize, v))
+}
+
+// DefaultTurnMinutesEQ applies the EQ predicate on the "default_turn_minutes" field.
+func DefaultTurnMinutesEQ(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldEQ(FieldDefaultTurnMinutes, v))
+}
+
+// DefaultTurnMinutesNEQ applies the NEQ predicate on the "default_turn_minutes" field.
+func DefaultTurnMinutesNEQ(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNEQ(FieldDefaultTurnMinutes, v))
+}
+
+// DefaultTurnMinutesIn applies the In predicate on the "default_turn_minutes" field.
+func DefaultTurnMinutesIn(vs ...int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldIn(FieldDefaultTurnMinutes, vs...))
+}
+
+// DefaultTurnMinutesNotIn applies the NotIn predicate on the "default_turn_minutes" field.
+func DefaultTurnMinutesNotIn(vs ...int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldNotIn(FieldDefaultTurnMinutes, vs...))
+}
+
+// DefaultTurnMinutesGT applies the GT predicate on the "default_turn_minutes" field.
+func DefaultTurnMinutesGT(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGT(FieldDefaultTurnMinutes, v))
+}
+
+// DefaultTurnMinutesGTE applies the GTE predicate on the "default_turn_minutes" field.
+func DefaultTurnMinutesGTE(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldGTE(FieldDefaultTurnMinutes, v))
+}
+
+// DefaultTurnMinutesLT applies the LT predicate on the "default_turn_minutes" field.
+func DefaultTurnMinutesLT(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLT(FieldDefaultTurnMinutes, v))
+}
+
+// DefaultTurnMinutesLTE applies the LTE predicate on the "default_turn_minutes" field.
+func DefaultTurnMinutesLTE(v int) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.FieldLTE(FieldDefaultTurnMinutes, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WaitlistRule) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WaitlistRule) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WaitlistRule) predicate.WaitlistRule {
+	return predicate.WaitlistRule(sql.NotPredicates(p))
+}
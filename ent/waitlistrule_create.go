@@ -0,0 +1,947 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistrule"
+)
+
+// WaitlistRuleCreate is the builder for creating a WaitlistRule entity.
+type WaitlistRuleCreate struct {
+	config
+	mutation *WaitlistRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wrc *WaitlistRuleCreate) SetCreatedAt(t time.Time) *WaitlistRuleCreate {
+	wrc.mutation.SetCreatedAt(t)
+	return wrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wrc *WaitlistRuleCreate) SetNillableCreatedAt(t *time.Time) *WaitlistRuleCreate {
+	if t != nil {
+		wrc.SetCreatedAt(*t)
+	}
+	return wrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wrc *WaitlistRuleCreate) SetUpdatedAt(t time.Time) *WaitlistRuleCreate {
+	wrc.mutation.SetUpdatedAt(t)
+	return wrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wrc *WaitlistRuleCreate) SetNillableUpdatedAt(t *time.Time) *WaitlistRuleCreate {
+	if t != nil {
+		wrc.SetUpdatedAt(*t)
+	}
+	return wrc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (wrc *WaitlistRuleCreate) SetMerchantID(u uuid.UUID) *WaitlistRuleCreate {
+	wrc.mutation.SetMerchantID(u)
+	return wrc
+}
+
+// SetStoreID sets the "store_id" field.
+func (wrc *WaitlistRuleCreate) SetStoreID(u uuid.UUID) *WaitlistRuleCreate {
+	wrc.mutation.SetStoreID(u)
+	return wrc
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (wrc *WaitlistRuleCreate) SetOnlineEnabled(b bool) *WaitlistRuleCreate {
+	wrc.mutation.SetOnlineEnabled(b)
+	return wrc
+}
+
+// SetNillableOnlineEnabled sets the "online_enabled" field if the given value is not nil.
+func (wrc *WaitlistRuleCreate) SetNillableOnlineEnabled(b *bool) *WaitlistRuleCreate {
+	if b != nil {
+		wrc.SetOnlineEnabled(*b)
+	}
+	return wrc
+}
+
+// SetSmallMaxSize sets the "small_max_size" field.
+func (wrc *WaitlistRuleCreate) SetSmallMaxSize(i int) *WaitlistRuleCreate {
+	wrc.mutation.SetSmallMaxSize(i)
+	return wrc
+}
+
+// SetMediumMaxSize sets the "medium_max_size" field.
+func (wrc *WaitlistRuleCreate) SetMediumMaxSize(i int) *WaitlistRuleCreate {
+	wrc.mutation.SetMediumMaxSize(i)
+	return wrc
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (wrc *WaitlistRuleCreate) SetMaxPartySize(i int) *WaitlistRuleCreate {
+	wrc.mutation.SetMaxPartySize(i)
+	return wrc
+}
+
+// SetDefaultTurnMinutes sets the "default_turn_minutes" field.
+func (wrc *WaitlistRuleCreate) SetDefaultTurnMinutes(i int) *WaitlistRuleCreate {
+	wrc.mutation.SetDefaultTurnMinutes(i)
+	return wrc
+}
+
+// SetID sets the "id" field.
+func (wrc *WaitlistRuleCreate) SetID(u uuid.UUID) *WaitlistRuleCreate {
+	wrc.mutation.SetID(u)
+	return wrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wrc *WaitlistRuleCreate) SetNillableID(u *uuid.UUID) *WaitlistRuleCreate {
+	if u != nil {
+		wrc.SetID(*u)
+	}
+	return wrc
+}
+
+// Mutation returns the WaitlistRuleMutation object of the builder.
+func (wrc *WaitlistRuleCreate) Mutation() *WaitlistRuleMutation {
+	return wrc.mutation
+}
+
+// Save creates the WaitlistRule in the database.
+func (wrc *WaitlistRuleCreate) Save(ctx context.Context) (*WaitlistRule, error) {
+	wrc.defaults()
+	return withHooks(ctx, wrc.sqlSave, wrc.mutation, wrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wrc *WaitlistRuleCreate) SaveX(ctx context.Context) *WaitlistRule {
+	v, err := wrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wrc *WaitlistRuleCreate) Exec(ctx context.Context) error {
+	_, err := wrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wrc *WaitlistRuleCreate) ExecX(ctx context.Context) {
+	if err := wrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wrc *WaitlistRuleCreate) defaults() {
+	if _, ok := wrc.mutation.CreatedAt(); !ok {
+		v := waitlistrule.DefaultCreatedAt()
+		wrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wrc.mutation.UpdatedAt(); !ok {
+		v := waitlistrule.DefaultUpdatedAt()
+		wrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wrc.mutation.OnlineEnabled(); !ok {
+		v := waitlistrule.DefaultOnlineEnabled
+		wrc.mutation.SetOnlineEnabled(v)
+	}
+	if _, ok := wrc.mutation.ID(); !ok {
+		v := waitlistrule.DefaultID()
+		wrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wrc *WaitlistRuleCreate) check() error {
+	if _, ok := wrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WaitlistRule.created_at"`)}
+	}
+	if _, ok := wrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WaitlistRule.updated_at"`)}
+	}
+	if _, ok := wrc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "WaitlistRule.merchant_id"`)}
+	}
+	if _, ok := wrc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "WaitlistRule.store_id"`)}
+	}
+	if _, ok := wrc.mutation.OnlineEnabled(); !ok {
+		return &ValidationError{Name: "online_enabled", err: errors.New(`ent: missing required field "WaitlistRule.online_enabled"`)}
+	}
+	if _, ok := wrc.mutation.SmallMaxSize(); !ok {
+		return &ValidationError{Name: "small_max_size", err: errors.New(`ent: missing required field "WaitlistRule.small_max_size"`)}
+	}
+	if _, ok := wrc.mutation.MediumMaxSize(); !ok {
+		return &ValidationError{Name: "medium_max_size", err: errors.New(`ent: missing required field "WaitlistRule.medium_max_size"`)}
+	}
+	if _, ok := wrc.mutation.MaxPartySize(); !ok {
+		return &ValidationError{Name: "max_party_size", err: errors.New(`ent: missing required field "WaitlistRule.max_party_size"`)}
+	}
+	if _, ok := wrc.mutation.DefaultTurnMinutes(); !ok {
+		return &ValidationError{Name: "default_turn_minutes", err: errors.New(`ent: missing required field "WaitlistRule.default_turn_minutes"`)}
+	}
+	return nil
+}
+
+func (wrc *WaitlistRuleCreate) sqlSave(ctx context.Context) (*WaitlistRule, error) {
+	if err := wrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wrc.mutation.id = &_node.ID
+	wrc.mutation.done = true
+	return _node, nil
+}
+
+func (wrc *WaitlistRuleCreate) createSpec() (*WaitlistRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WaitlistRule{config: wrc.config}
+		_spec = sqlgraph.NewCreateSpec(waitlistrule.Table, sqlgraph.NewFieldSpec(waitlistrule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = wrc.conflict
+	if id, ok := wrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wrc.mutation.CreatedAt(); ok {
+		_spec.SetField(waitlistrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wrc.mutation.MerchantID(); ok {
+		_spec.SetField(waitlistrule.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := wrc.mutation.StoreID(); ok {
+		_spec.SetField(waitlistrule.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := wrc.mutation.OnlineEnabled(); ok {
+		_spec.SetField(waitlistrule.FieldOnlineEnabled, field.TypeBool, value)
+		_node.OnlineEnabled = value
+	}
+	if value, ok := wrc.mutation.SmallMaxSize(); ok {
+		_spec.SetField(waitlistrule.FieldSmallMaxSize, field.TypeInt, value)
+		_node.SmallMaxSize = value
+	}
+	if value, ok := wrc.mutation.MediumMaxSize(); ok {
+		_spec.SetField(waitlistrule.FieldMediumMaxSize, field.TypeInt, value)
+		_node.MediumMaxSize = value
+	}
+	if value, ok := wrc.mutation.MaxPartySize(); ok {
+		_spec.SetField(waitlistrule.FieldMaxPartySize, field.TypeInt, value)
+		_node.MaxPartySize = value
+	}
+	if value, ok := wrc.mutation.DefaultTurnMinutes(); ok {
+		_spec.SetField(waitlistrule.FieldDefaultTurnMinutes, field.TypeInt, value)
+		_node.DefaultTurnMinutes = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WaitlistRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WaitlistRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wrc *WaitlistRuleCreate) OnConflict(opts ...sql.ConflictOption) *WaitlistRuleUpsertOne {
+	wrc.conflict = opts
+	return &WaitlistRuleUpsertOne{
+		create: wrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WaitlistRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wrc *WaitlistRuleCreate) OnConflictColumns(columns ...string) *WaitlistRuleUpsertOne {
+	wrc.conflict = append(wrc.conflict, sql.ConflictColumns(columns...))
+	return &WaitlistRuleUpsertOne{
+		create: wrc,
+	}
+}
+
+type (
+	// WaitlistRuleUpsertOne is the builder for "upsert"-ing
+	//  one WaitlistRule node.
+	WaitlistRuleUpsertOne struct {
+		create *WaitlistRuleCreate
+	}
+
+	// WaitlistRuleUpsert is the "OnConflict" setter.
+	WaitlistRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WaitlistRuleUpsert) SetUpdatedAt(v time.Time) *WaitlistRuleUpsert {
+	u.Set(waitlistrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WaitlistRuleUpsert) UpdateUpdatedAt() *WaitlistRuleUpsert {
+	u.SetExcluded(waitlistrule.FieldUpdatedAt)
+	return u
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (u *WaitlistRuleUpsert) SetOnlineEnabled(v bool) *WaitlistRuleUpsert {
+	u.Set(waitlistrule.FieldOnlineEnabled, v)
+	return u
+}
+
+// UpdateOnlineEnabled sets the "online_enabled" field to the value that was provided on create.
+func (u *WaitlistRuleUpsert) UpdateOnlineEnabled() *WaitlistRuleUpsert {
+	u.SetExcluded(waitlistrule.FieldOnlineEnabled)
+	return u
+}
+
+// SetSmallMaxSize sets the "small_max_size" field.
+func (u *WaitlistRuleUpsert) SetSmallMaxSize(v int) *WaitlistRuleUpsert {
+	u.Set(waitlistrule.FieldSmallMaxSize, v)
+	return u
+}
+
+// UpdateSmallMaxSize sets the "small_max_size" field to the value that was provided on create.
+func (u *WaitlistRuleUpsert) UpdateSmallMaxSize() *WaitlistRuleUpsert {
+	u.SetExcluded(waitlistrule.FieldSmallMaxSize)
+	return u
+}
+
+// AddSmallMaxSize adds v to the "small_max_size" field.
+func (u *WaitlistRuleUpsert) AddSmallMaxSize(v int) *WaitlistRuleUpsert {
+	u.Add(waitlistrule.FieldSmallMaxSize, v)
+	return u
+}
+
+// SetMediumMaxSize sets the "medium_max_size" field.
+func (u *WaitlistRuleUpsert) SetMediumMaxSize(v int) *WaitlistRuleUpsert {
+	u.Set(waitlistrule.FieldMediumMaxSize, v)
+	return u
+}
+
+// UpdateMediumMaxSize sets the "medium_max_size" field to the value that was provided on create.
+func (u *WaitlistRuleUpsert) UpdateMediumMaxSize() *WaitlistRuleUpsert {
+	u.SetExcluded(waitlistrule.FieldMediumMaxSize)
+	return u
+}
+
+// AddMediumMaxSize adds v to the "medium_max_size" field.
+func (u *WaitlistRuleUpsert) AddMediumMaxSize(v int) *WaitlistRuleUpsert {
+	u.Add(waitlistrule.FieldMediumMaxSize, v)
+	return u
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (u *WaitlistRuleUpsert) SetMaxPartySize(v int) *WaitlistRuleUpsert {
+	u.Set(waitlistrule.FieldMaxPartySize, v)
+	return u
+}
+
+// UpdateMaxPartySize sets the "max_party_size" field to the value that was provided on create.
+func (u *WaitlistRuleUpsert) UpdateMaxPartySize() *WaitlistRuleUpsert {
+	u.SetExcluded(waitlistrule.FieldMaxPartySize)
+	return u
+}
+
+// AddMaxPartySize adds v to the "max_party_size" field.
+func (u *WaitlistRuleUpsert) AddMaxPartySize(v int) *WaitlistRuleUpsert {
+	u.Add(waitlistrule.FieldMaxPartySize, v)
+	return u
+}
+
+// SetDefaultTurnMinutes sets the "default_turn_minutes" field.
+func (u *WaitlistRuleUpsert) SetDefaultTurnMinutes(v int) *WaitlistRuleUpsert {
+	u.Set(waitlistrule.FieldDefaultTurnMinutes, v)
+	return u
+}
+
+// UpdateDefaultTurnMinutes sets the "default_turn_minutes" field to the value that was provided on create.
+func (u *WaitlistRuleUpsert) UpdateDefaultTurnMinutes() *WaitlistRuleUpsert {
+	u.SetExcluded(waitlistrule.FieldDefaultTurnMinutes)
+	return u
+}
+
+// AddDefaultTurnMinutes adds v to the "default_turn_minutes" field.
+func (u *WaitlistRuleUpsert) AddDefaultTurnMinutes(v int) *WaitlistRuleUpsert {
+	u.Add(waitlistrule.FieldDefaultTurnMinutes, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WaitlistRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(waitlistrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WaitlistRuleUpsertOne) UpdateNewValues() *WaitlistRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(waitlistrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(waitlistrule.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(waitlistrule.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(waitlistrule.FieldStoreID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WaitlistRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WaitlistRuleUpsertOne) Ignore() *WaitlistRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WaitlistRuleUpsertOne) DoNothing() *WaitlistRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WaitlistRuleCreate.OnConflict
+// documentation for more info.
+func (u *WaitlistRuleUpsertOne) Update(set func(*WaitlistRuleUpsert)) *WaitlistRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WaitlistRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WaitlistRuleUpsertOne) SetUpdatedAt(v time.Time) *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertOne) UpdateUpdatedAt() *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (u *WaitlistRuleUpsertOne) SetOnlineEnabled(v bool) *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetOnlineEnabled(v)
+	})
+}
+
+// UpdateOnlineEnabled sets the "online_enabled" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertOne) UpdateOnlineEnabled() *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateOnlineEnabled()
+	})
+}
+
+// SetSmallMaxSize sets the "small_max_size" field.
+func (u *WaitlistRuleUpsertOne) SetSmallMaxSize(v int) *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetSmallMaxSize(v)
+	})
+}
+
+// AddSmallMaxSize adds v to the "small_max_size" field.
+func (u *WaitlistRuleUpsertOne) AddSmallMaxSize(v int) *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.AddSmallMaxSize(v)
+	})
+}
+
+// UpdateSmallMaxSize sets the "small_max_size" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertOne) UpdateSmallMaxSize() *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateSmallMaxSize()
+	})
+}
+
+// SetMediumMaxSize sets the "medium_max_size" field.
+func (u *WaitlistRuleUpsertOne) SetMediumMaxSize(v int) *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetMediumMaxSize(v)
+	})
+}
+
+// AddMediumMaxSize adds v to the "medium_max_size" field.
+func (u *WaitlistRuleUpsertOne) AddMediumMaxSize(v int) *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.AddMediumMaxSize(v)
+	})
+}
+
+// UpdateMediumMaxSize sets the "medium_max_size" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertOne) UpdateMediumMaxSize() *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateMediumMaxSize()
+	})
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (u *WaitlistRuleUpsertOne) SetMaxPartySize(v int) *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetMaxPartySize(v)
+	})
+}
+
+// AddMaxPartySize adds v to the "max_party_size" field.
+func (u *WaitlistRuleUpsertOne) AddMaxPartySize(v int) *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.AddMaxPartySize(v)
+	})
+}
+
+// UpdateMaxPartySize sets the "max_party_size" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertOne) UpdateMaxPartySize() *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateMaxPartySize()
+	})
+}
+
+// SetDefaultTurnMinutes sets the "default_turn_minutes" field.
+func (u *WaitlistRuleUpsertOne) SetDefaultTurnMinutes(v int) *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetDefaultTurnMinutes(v)
+	})
+}
+
+// AddDefaultTurnMinutes adds v to the "default_turn_minutes" field.
+func (u *WaitlistRuleUpsertOne) AddDefaultTurnMinutes(v int) *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.AddDefaultTurnMinutes(v)
+	})
+}
+
+// UpdateDefaultTurnMinutes sets the "default_turn_minutes" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertOne) UpdateDefaultTurnMinutes() *WaitlistRuleUpsertOne {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateDefaultTurnMinutes()
+	})
+}
+
+// Exec executes the query.
+func (u *WaitlistRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WaitlistRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WaitlistRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WaitlistRuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WaitlistRuleUpsertOne.ID is not supported by MySQL driver. Use WaitlistRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WaitlistRuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WaitlistRuleCreateBulk is the builder for creating many WaitlistRule entities in bulk.
+type WaitlistRuleCreateBulk struct {
+	config
+	err      error
+	builders []*WaitlistRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WaitlistRule entities in the database.
+func (wrcb *WaitlistRuleCreateBulk) Save(ctx context.Context) ([]*WaitlistRule, error) {
+	if wrcb.err != nil {
+		return nil, wrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wrcb.builders))
+	nodes := make([]*WaitlistRule, len(wrcb.builders))
+	mutators := make([]Mutator, len(wrcb.builders))
+	for i := range wrcb.builders {
+		func(i int, root context.Context) {
+			builder := wrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WaitlistRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wrcb *WaitlistRuleCreateBulk) SaveX(ctx context.Context) []*WaitlistRule {
+	v, err := wrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wrcb *WaitlistRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := wrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wrcb *WaitlistRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := wrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WaitlistRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WaitlistRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wrcb *WaitlistRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *WaitlistRuleUpsertBulk {
+	wrcb.conflict = opts
+	return &WaitlistRuleUpsertBulk{
+		create: wrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WaitlistRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wrcb *WaitlistRuleCreateBulk) OnConflictColumns(columns ...string) *WaitlistRuleUpsertBulk {
+	wrcb.conflict = append(wrcb.conflict, sql.ConflictColumns(columns...))
+	return &WaitlistRuleUpsertBulk{
+		create: wrcb,
+	}
+}
+
+// WaitlistRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of WaitlistRule nodes.
+type WaitlistRuleUpsertBulk struct {
+	create *WaitlistRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WaitlistRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(waitlistrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WaitlistRuleUpsertBulk) UpdateNewValues() *WaitlistRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(waitlistrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(waitlistrule.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(waitlistrule.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(waitlistrule.FieldStoreID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WaitlistRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WaitlistRuleUpsertBulk) Ignore() *WaitlistRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WaitlistRuleUpsertBulk) DoNothing() *WaitlistRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WaitlistRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *WaitlistRuleUpsertBulk) Update(set func(*WaitlistRuleUpsert)) *WaitlistRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WaitlistRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WaitlistRuleUpsertBulk) SetUpdatedAt(v time.Time) *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertBulk) UpdateUpdatedAt() *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (u *WaitlistRuleUpsertBulk) SetOnlineEnabled(v bool) *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetOnlineEnabled(v)
+	})
+}
+
+// UpdateOnlineEnabled sets the "online_enabled" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertBulk) UpdateOnlineEnabled() *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateOnlineEnabled()
+	})
+}
+
+// SetSmallMaxSize sets the "small_max_size" field.
+func (u *WaitlistRuleUpsertBulk) SetSmallMaxSize(v int) *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetSmallMaxSize(v)
+	})
+}
+
+// AddSmallMaxSize adds v to the "small_max_size" field.
+func (u *WaitlistRuleUpsertBulk) AddSmallMaxSize(v int) *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.AddSmallMaxSize(v)
+	})
+}
+
+// UpdateSmallMaxSize sets the "small_max_size" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertBulk) UpdateSmallMaxSize() *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateSmallMaxSize()
+	})
+}
+
+// SetMediumMaxSize sets the "medium_max_size" field.
+func (u *WaitlistRuleUpsertBulk) SetMediumMaxSize(v int) *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetMediumMaxSize(v)
+	})
+}
+
+// AddMediumMaxSize adds v to the "medium_max_size" field.
+func (u *WaitlistRuleUpsertBulk) AddMediumMaxSize(v int) *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.AddMediumMaxSize(v)
+	})
+}
+
+// UpdateMediumMaxSize sets the "medium_max_size" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertBulk) UpdateMediumMaxSize() *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateMediumMaxSize()
+	})
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (u *WaitlistRuleUpsertBulk) SetMaxPartySize(v int) *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetMaxPartySize(v)
+	})
+}
+
+// AddMaxPartySize adds v to the "max_party_size" field.
+func (u *WaitlistRuleUpsertBulk) AddMaxPartySize(v int) *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.AddMaxPartySize(v)
+	})
+}
+
+// UpdateMaxPartySize sets the "max_party_size" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertBulk) UpdateMaxPartySize() *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateMaxPartySize()
+	})
+}
+
+// SetDefaultTurnMinutes sets the "default_turn_minutes" field.
+func (u *WaitlistRuleUpsertBulk) SetDefaultTurnMinutes(v int) *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.SetDefaultTurnMinutes(v)
+	})
+}
+
+// AddDefaultTurnMinutes adds v to the "default_turn_minutes" field.
+func (u *WaitlistRuleUpsertBulk) AddDefaultTurnMinutes(v int) *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.AddDefaultTurnMinutes(v)
+	})
+}
+
+// UpdateDefaultTurnMinutes sets the "default_turn_minutes" field to the value that was provided on create.
+func (u *WaitlistRuleUpsertBulk) UpdateDefaultTurnMinutes() *WaitlistRuleUpsertBulk {
+	return u.Update(func(s *WaitlistRuleUpsert) {
+		s.UpdateDefaultTurnMinutes()
+	})
+}
+
+// Exec executes the query.
+func (u *WaitlistRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WaitlistRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WaitlistRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WaitlistRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistrule"
+)
+
+// WaitlistRuleDelete is the builder for deleting a WaitlistRule entity.
+type WaitlistRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistRuleMutation
+}
+
+// Where appends a list predicates to the WaitlistRuleDelete builder.
+func (wrd *WaitlistRuleDelete) Where(ps ...predicate.WaitlistRule) *WaitlistRuleDelete {
+	wrd.mutation.Where(ps...)
+	return wrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wrd *WaitlistRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wrd.sqlExec, wrd.mutation, wrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wrd *WaitlistRuleDelete) ExecX(ctx context.Context) int {
+	n, err := wrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wrd *WaitlistRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(waitlistrule.Table, sqlgraph.NewFieldSpec(waitlistrule.FieldID, field.TypeUUID))
+	if ps := wrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wrd.mutation.done = true
+	return affected, err
+}
+
+// WaitlistRuleDeleteOne is the builder for deleting a single WaitlistRule entity.
+type WaitlistRuleDeleteOne struct {
+	wrd *WaitlistRuleDelete
+}
+
+// Where appends a list predicates to the WaitlistRuleDelete builder.
+func (wrdo *WaitlistRuleDeleteOne) Where(ps ...predicate.WaitlistRule) *WaitlistRuleDeleteOne {
+	wrdo.wrd.mutation.Where(ps...)
+	return wrdo
+}
+
+// Exec executes the deletion query.
+func (wrdo *WaitlistRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := wrdo.wrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{waitlistrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wrdo *WaitlistRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := wrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
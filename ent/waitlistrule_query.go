@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistrule"
+)
+
+// WaitlistRuleQuery is the builder for querying WaitlistRule entities.
+type WaitlistRuleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []waitlistrule.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WaitlistRule
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WaitlistRuleQuery builder.
+func (wrq *WaitlistRuleQuery) Where(ps ...predicate.WaitlistRule) *WaitlistRuleQuery {
+	wrq.predicates = append(wrq.predicates, ps...)
+	return wrq
+}
+
+// Limit the number of records to be returned by this query.
+func (wrq *WaitlistRuleQuery) Limit(limit int) *WaitlistRuleQuery {
+	wrq.ctx.Limit = &limit
+	return wrq
+}
+
+// Offset to start from.
+func (wrq *WaitlistRuleQuery) Offset(offset int) *WaitlistRuleQuery {
+	wrq.ctx.Offset = &offset
+	return wrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wrq *WaitlistRuleQuery) Unique(unique bool) *WaitlistRuleQuery {
+	wrq.ctx.Unique = &unique
+	return wrq
+}
+
+// Order specifies how the records should be ordered.
+func (wrq *WaitlistRuleQuery) Order(o ...waitlistrule.OrderOption) *WaitlistRuleQuery {
+	wrq.order = append(wrq.order, o...)
+	return wrq
+}
+
+// First returns the first WaitlistRule entity from the query.
+// Returns a *NotFoundError when no WaitlistRule was found.
+func (wrq *WaitlistRuleQuery) First(ctx context.Context) (*WaitlistRule, error) {
+	nodes, err := wrq.Limit(1).All(setContextOp(ctx, wrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{waitlistrule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wrq *WaitlistRuleQuery) FirstX(ctx context.Context) *WaitlistRule {
+	node, err := wrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WaitlistRule ID from the query.
+// Returns a *NotFoundError when no WaitlistRule ID was found.
+func (wrq *WaitlistRuleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wrq.Limit(1).IDs(setContextOp(ctx, wrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{waitlistrule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wrq *WaitlistRuleQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WaitlistRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WaitlistRule entity is found.
+// Returns a *NotFoundError when no WaitlistRule entities are found.
+func (wrq *WaitlistRuleQuery) Only(ctx context.Context) (*WaitlistRule, error) {
+	nodes, err := wrq.Limit(2).All(setContextOp(ctx, wrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{waitlistrule.Label}
+	default:
+		return nil, &NotSingularError{waitlistrule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wrq *WaitlistRuleQuery) OnlyX(ctx context.Context) *WaitlistRule {
+	node, err := wrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WaitlistRule ID in the query.
+// Returns a *NotSingularError when more than one WaitlistRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wrq *WaitlistRuleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wrq.Limit(2).IDs(setContextOp(ctx, wrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{waitlistrule.Label}
+	default:
+		err = &NotSingularError{waitlistrule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wrq *WaitlistRuleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WaitlistRules.
+func (wrq *WaitlistRuleQuery) All(ctx context.Context) ([]*WaitlistRule, error) {
+	ctx = setContextOp(ctx, wrq.ctx, ent.OpQueryAll)
+	if err := wrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WaitlistRule, *WaitlistRuleQuery]()
+	return withInterceptors[[]*WaitlistRule](ctx, wrq, qr, wrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wrq *WaitlistRuleQuery) AllX(ctx context.Context) []*WaitlistRule {
+	nodes, err := wrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WaitlistRule IDs.
+func (wrq *WaitlistRuleQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if wrq.ctx.Unique == nil && wrq.path != nil {
+		wrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wrq.ctx, ent.OpQueryIDs)
+	if err = wrq.Select(waitlistrule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wrq *WaitlistRuleQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wrq *WaitlistRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wrq.ctx, ent.OpQueryCount)
+	if err := wrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wrq, querierCount[*WaitlistRuleQuery](), wrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wrq *WaitlistRuleQuery) CountX(ctx context.Context) int {
+	count, err := wrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wrq *WaitlistRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wrq.ctx, ent.OpQueryExist)
+	switch _, err := wrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wrq *WaitlistRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := wrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WaitlistRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wrq *WaitlistRuleQuery) Clone() *WaitlistRuleQuery {
+	if wrq == nil {
+		return nil
+	}
+	return &WaitlistRuleQuery{
+		config:     wrq.config,
+		ctx:        wrq.ctx.Clone(),
+		order:      append([]waitlistrule.OrderOption{}, wrq.order...),
+		inters:     append([]Interceptor{}, wrq.inters...),
+		predicates: append([]predicate.WaitlistRule{}, wrq.predicates...),
+		// clone intermediate query.
+		sql:       wrq.sql.Clone(),
+		path:      wrq.path,
+		modifiers: append([]func(*sql.Selector){}, wrq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WaitlistRule.Query().
+//		GroupBy(waitlistrule.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wrq *WaitlistRuleQuery) GroupBy(field string, fields ...string) *WaitlistRuleGroupBy {
+	wrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WaitlistRuleGroupBy{build: wrq}
+	grbuild.flds = &wrq.ctx.Fields
+	grbuild.label = waitlistrule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.WaitlistRule.Query().
+//		Select(waitlistrule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (wrq *WaitlistRuleQuery) Select(fields ...string) *WaitlistRuleSelect {
+	wrq.ctx.Fields = append(wrq.ctx.Fields, fields...)
+	sbuild := &WaitlistRuleSelect{WaitlistRuleQuery: wrq}
+	sbuild.label = waitlistrule.Label
+	sbuild.flds, sbuild.scan = &wrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WaitlistRuleSelect configured with the given aggregations.
+func (wrq *WaitlistRuleQuery) Aggregate(fns ...AggregateFunc) *WaitlistRuleSelect {
+	return wrq.Select().Aggregate(fns...)
+}
+
+func (wrq *WaitlistRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wrq.ctx.Fields {
+		if !waitlistrule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wrq.path != nil {
+		prev, err := wrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wrq.sql = prev
+	}
+	return nil
+}
+
+func (wrq *WaitlistRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WaitlistRule, error) {
+	var (
+		nodes = []*WaitlistRule{}
+		_spec = wrq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WaitlistRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WaitlistRule{config: wrq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(wrq.modifiers) > 0 {
+		_spec.Modifiers = wrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wrq *WaitlistRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wrq.querySpec()
+	if len(wrq.modifiers) > 0 {
+		_spec.Modifiers = wrq.modifiers
+	}
+	_spec.Node.Columns = wrq.ctx.Fields
+	if len(wrq.ctx.Fields) > 0 {
+		_spec.Unique = wrq.ctx.Unique != nil && *wrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wrq.driver, _spec)
+}
+
+func (wrq *WaitlistRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(waitlistrule.Table, waitlistrule.Columns, sqlgraph.NewFieldSpec(waitlistrule.FieldID, field.TypeUUID))
+	_spec.From = wrq.sql
+	if unique := wrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistrule.FieldID)
+		for i := range fields {
+			if fields[i] != waitlistrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wrq *WaitlistRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wrq.driver.Dialect())
+	t1 := builder.Table(waitlistrule.Table)
+	columns := wrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = waitlistrule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wrq.sql != nil {
+		selector = wrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wrq.ctx.Unique != nil && *wrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range wrq.modifiers {
+		m(selector)
+	}
+	for _, p := range wrq.predicates {
+		p(selector)
+	}
+	for _, p := range wrq.order {
+		p(selector)
+	}
+	if offset := wrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (wrq *WaitlistRuleQuery) ForUpdate(opts ...sql.LockOption) *WaitlistRuleQuery {
+	if wrq.driver.Dialect() == dialect.Postgres {
+		wrq.Unique(false)
+	}
+	wrq.modifiers = append(wrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return wrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (wrq *WaitlistRuleQuery) ForShare(opts ...sql.LockOption) *WaitlistRuleQuery {
+	if wrq.driver.Dialect() == dialect.Postgres {
+		wrq.Unique(false)
+	}
+	wrq.modifiers = append(wrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return wrq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wrq *WaitlistRuleQuery) Modify(modifiers ...func(s *sql.Selector)) *WaitlistRuleSelect {
+	wrq.modifiers = append(wrq.modifiers, modifiers...)
+	return wrq.Select()
+}
+
+// WaitlistRuleGroupBy is the group-by builder for WaitlistRule entities.
+type WaitlistRuleGroupBy struct {
+	selector
+	build *WaitlistRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wrgb *WaitlistRuleGroupBy) Aggregate(fns ...AggregateFunc) *WaitlistRuleGroupBy {
+	wrgb.fns = append(wrgb.fns, fns...)
+	return wrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wrgb *WaitlistRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WaitlistRuleQuery, *WaitlistRuleGroupBy](ctx, wrgb.build, wrgb, wrgb.build.inters, v)
+}
+
+func (wrgb *WaitlistRuleGroupBy) sqlScan(ctx context.Context, root *WaitlistRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wrgb.fns))
+	for _, fn := range wrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wrgb.flds)+len(wrgb.fns))
+		for _, f := range *wrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WaitlistRuleSelect is the builder for selecting fields of WaitlistRule entities.
+type WaitlistRuleSelect struct {
+	*WaitlistRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wrs *WaitlistRuleSelect) Aggregate(fns ...AggregateFunc) *WaitlistRuleSelect {
+	wrs.fns = append(wrs.fns, fns...)
+	return wrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wrs *WaitlistRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wrs.ctx, ent.OpQuerySelect)
+	if err := wrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WaitlistRuleQuery, *WaitlistRuleSelect](ctx, wrs.WaitlistRuleQuery, wrs, wrs.inters, v)
+}
+
+func (wrs *WaitlistRuleSelect) sqlScan(ctx context.Context, root *WaitlistRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wrs.fns))
+	for _, fn := range wrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wrs *WaitlistRuleSelect) Modify(modifiers ...func(s *sql.Selector)) *WaitlistRuleSelect {
+	wrs.modifiers = append(wrs.modifiers, modifiers...)
+	return wrs
+}
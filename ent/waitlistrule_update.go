@@ -0,0 +1,478 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistrule"
+)
+
+// WaitlistRuleUpdate is the builder for updating WaitlistRule entities.
+type WaitlistRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WaitlistRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WaitlistRuleUpdate builder.
+func (wru *WaitlistRuleUpdate) Where(ps ...predicate.WaitlistRule) *WaitlistRuleUpdate {
+	wru.mutation.Where(ps...)
+	return wru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wru *WaitlistRuleUpdate) SetUpdatedAt(t time.Time) *WaitlistRuleUpdate {
+	wru.mutation.SetUpdatedAt(t)
+	return wru
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (wru *WaitlistRuleUpdate) SetOnlineEnabled(b bool) *WaitlistRuleUpdate {
+	wru.mutation.SetOnlineEnabled(b)
+	return wru
+}
+
+// SetNillableOnlineEnabled sets the "online_enabled" field if the given value is not nil.
+func (wru *WaitlistRuleUpdate) SetNillableOnlineEnabled(b *bool) *WaitlistRuleUpdate {
+	if b != nil {
+		wru.SetOnlineEnabled(*b)
+	}
+	return wru
+}
+
+// SetSmallMaxSize sets the "small_max_size" field.
+func (wru *WaitlistRuleUpdate) SetSmallMaxSize(i int) *WaitlistRuleUpdate {
+	wru.mutation.ResetSmallMaxSize()
+	wru.mutation.SetSmallMaxSize(i)
+	return wru
+}
+
+// SetNillableSmallMaxSize sets the "small_max_size" field if the given value is not nil.
+func (wru *WaitlistRuleUpdate) SetNillableSmallMaxSize(i *int) *WaitlistRuleUpdate {
+	if i != nil {
+		wru.SetSmallMaxSize(*i)
+	}
+	return wru
+}
+
+// AddSmallMaxSize adds i to the "small_max_size" field.
+func (wru *WaitlistRuleUpdate) AddSmallMaxSize(i int) *WaitlistRuleUpdate {
+	wru.mutation.AddSmallMaxSize(i)
+	return wru
+}
+
+// SetMediumMaxSize sets the "medium_max_size" field.
+func (wru *WaitlistRuleUpdate) SetMediumMaxSize(i int) *WaitlistRuleUpdate {
+	wru.mutation.ResetMediumMaxSize()
+	wru.mutation.SetMediumMaxSize(i)
+	return wru
+}
+
+// SetNillableMediumMaxSize sets the "medium_max_size" field if the given value is not nil.
+func (wru *WaitlistRuleUpdate) SetNillableMediumMaxSize(i *int) *WaitlistRuleUpdate {
+	if i != nil {
+		wru.SetMediumMaxSize(*i)
+	}
+	return wru
+}
+
+// AddMediumMaxSize adds i to the "medium_max_size" field.
+func (wru *WaitlistRuleUpdate) AddMediumMaxSize(i int) *WaitlistRuleUpdate {
+	wru.mutation.AddMediumMaxSize(i)
+	return wru
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (wru *WaitlistRuleUpdate) SetMaxPartySize(i int) *WaitlistRuleUpdate {
+	wru.mutation.ResetMaxPartySize()
+	wru.mutation.SetMaxPartySize(i)
+	return wru
+}
+
+// SetNillableMaxPartySize sets the "max_party_size" field if the given value is not nil.
+func (wru *WaitlistRuleUpdate) SetNillableMaxPartySize(i *int) *WaitlistRuleUpdate {
+	if i != nil {
+		wru.SetMaxPartySize(*i)
+	}
+	return wru
+}
+
+// AddMaxPartySize adds i to the "max_party_size" field.
+func (wru *WaitlistRuleUpdate) AddMaxPartySize(i int) *WaitlistRuleUpdate {
+	wru.mutation.AddMaxPartySize(i)
+	return wru
+}
+
+// SetDefaultTurnMinutes sets the "default_turn_minutes" field.
+func (wru *WaitlistRuleUpdate) SetDefaultTurnMinutes(i int) *WaitlistRuleUpdate {
+	wru.mutation.ResetDefaultTurnMinutes()
+	wru.mutation.SetDefaultTurnMinutes(i)
+	return wru
+}
+
+// SetNillableDefaultTurnMinutes sets the "default_turn_minutes" field if the given value is not nil.
+func (wru *WaitlistRuleUpdate) SetNillableDefaultTurnMinutes(i *int) *WaitlistRuleUpdate {
+	if i != nil {
+		wru.SetDefaultTurnMinutes(*i)
+	}
+	return wru
+}
+
+// AddDefaultTurnMinutes adds i to the "default_turn_minutes" field.
+func (wru *WaitlistRuleUpdate) AddDefaultTurnMinutes(i int) *WaitlistRuleUpdate {
+	wru.mutation.AddDefaultTurnMinutes(i)
+	return wru
+}
+
+// Mutation returns the WaitlistRuleMutation object of the builder.
+func (wru *WaitlistRuleUpdate) Mutation() *WaitlistRuleMutation {
+	return wru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wru *WaitlistRuleUpdate) Save(ctx context.Context) (int, error) {
+	wru.defaults()
+	return withHooks(ctx, wru.sqlSave, wru.mutation, wru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wru *WaitlistRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := wru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wru *WaitlistRuleUpdate) Exec(ctx context.Context) error {
+	_, err := wru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wru *WaitlistRuleUpdate) ExecX(ctx context.Context) {
+	if err := wru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wru *WaitlistRuleUpdate) defaults() {
+	if _, ok := wru.mutation.UpdatedAt(); !ok {
+		v := waitlistrule.UpdateDefaultUpdatedAt()
+		wru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wru *WaitlistRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WaitlistRuleUpdate {
+	wru.modifiers = append(wru.modifiers, modifiers...)
+	return wru
+}
+
+func (wru *WaitlistRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(waitlistrule.Table, waitlistrule.Columns, sqlgraph.NewFieldSpec(waitlistrule.FieldID, field.TypeUUID))
+	if ps := wru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wru.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wru.mutation.OnlineEnabled(); ok {
+		_spec.SetField(waitlistrule.FieldOnlineEnabled, field.TypeBool, value)
+	}
+	if value, ok := wru.mutation.SmallMaxSize(); ok {
+		_spec.SetField(waitlistrule.FieldSmallMaxSize, field.TypeInt, value)
+	}
+	if value, ok := wru.mutation.AddedSmallMaxSize(); ok {
+		_spec.AddField(waitlistrule.FieldSmallMaxSize, field.TypeInt, value)
+	}
+	if value, ok := wru.mutation.MediumMaxSize(); ok {
+		_spec.SetField(waitlistrule.FieldMediumMaxSize, field.TypeInt, value)
+	}
+	if value, ok := wru.mutation.AddedMediumMaxSize(); ok {
+		_spec.AddField(waitlistrule.FieldMediumMaxSize, field.TypeInt, value)
+	}
+	if value, ok := wru.mutation.MaxPartySize(); ok {
+		_spec.SetField(waitlistrule.FieldMaxPartySize, field.TypeInt, value)
+	}
+	if value, ok := wru.mutation.AddedMaxPartySize(); ok {
+		_spec.AddField(waitlistrule.FieldMaxPartySize, field.TypeInt, value)
+	}
+	if value, ok := wru.mutation.DefaultTurnMinutes(); ok {
+		_spec.SetField(waitlistrule.FieldDefaultTurnMinutes, field.TypeInt, value)
+	}
+	if value, ok := wru.mutation.AddedDefaultTurnMinutes(); ok {
+		_spec.AddField(waitlistrule.FieldDefaultTurnMinutes, field.TypeInt, value)
+	}
+	_spec.AddModifiers(wru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wru.mutation.done = true
+	return n, nil
+}
+
+// WaitlistRuleUpdateOne is the builder for updating a single WaitlistRule entity.
+type WaitlistRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WaitlistRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wruo *WaitlistRuleUpdateOne) SetUpdatedAt(t time.Time) *WaitlistRuleUpdateOne {
+	wruo.mutation.SetUpdatedAt(t)
+	return wruo
+}
+
+// SetOnlineEnabled sets the "online_enabled" field.
+func (wruo *WaitlistRuleUpdateOne) SetOnlineEnabled(b bool) *WaitlistRuleUpdateOne {
+	wruo.mutation.SetOnlineEnabled(b)
+	return wruo
+}
+
+// SetNillableOnlineEnabled sets the "online_enabled" field if the given value is not nil.
+func (wruo *WaitlistRuleUpdateOne) SetNillableOnlineEnabled(b *bool) *WaitlistRuleUpdateOne {
+	if b != nil {
+		wruo.SetOnlineEnabled(*b)
+	}
+	return wruo
+}
+
+// SetSmallMaxSize sets the "small_max_size" field.
+func (wruo *WaitlistRuleUpdateOne) SetSmallMaxSize(i int) *WaitlistRuleUpdateOne {
+	wruo.mutation.ResetSmallMaxSize()
+	wruo.mutation.SetSmallMaxSize(i)
+	return wruo
+}
+
+// SetNillableSmallMaxSize sets the "small_max_size" field if the given value is not nil.
+func (wruo *WaitlistRuleUpdateOne) SetNillableSmallMaxSize(i *int) *WaitlistRuleUpdateOne {
+	if i != nil {
+		wruo.SetSmallMaxSize(*i)
+	}
+	return wruo
+}
+
+// AddSmallMaxSize adds i to the "small_max_size" field.
+func (wruo *WaitlistRuleUpdateOne) AddSmallMaxSize(i int) *WaitlistRuleUpdateOne {
+	wruo.mutation.AddSmallMaxSize(i)
+	return wruo
+}
+
+// SetMediumMaxSize sets the "medium_max_size" field.
+func (wruo *WaitlistRuleUpdateOne) SetMediumMaxSize(i int) *WaitlistRuleUpdateOne {
+	wruo.mutation.ResetMediumMaxSize()
+	wruo.mutation.SetMediumMaxSize(i)
+	return wruo
+}
+
+// SetNillableMediumMaxSize sets the "medium_max_size" field if the given value is not nil.
+func (wruo *WaitlistRuleUpdateOne) SetNillableMediumMaxSize(i *int) *WaitlistRuleUpdateOne {
+	if i != nil {
+		wruo.SetMediumMaxSize(*i)
+	}
+	return wruo
+}
+
+// AddMediumMaxSize adds i to the "medium_max_size" field.
+func (wruo *WaitlistRuleUpdateOne) AddMediumMaxSize(i int) *WaitlistRuleUpdateOne {
+	wruo.mutation.AddMediumMaxSize(i)
+	return wruo
+}
+
+// SetMaxPartySize sets the "max_party_size" field.
+func (wruo *WaitlistRuleUpdateOne) SetMaxPartySize(i int) *WaitlistRuleUpdateOne {
+	wruo.mutation.ResetMaxPartySize()
+	wruo.mutation.SetMaxPartySize(i)
+	return wruo
+}
+
+// SetNillableMaxPartySize sets the "max_party_size" field if the given value is not nil.
+func (wruo *WaitlistRuleUpdateOne) SetNillableMaxPartySize(i *int) *WaitlistRuleUpdateOne {
+	if i != nil {
+		wruo.SetMaxPartySize(*i)
+	}
+	return wruo
+}
+
+// AddMaxPartySize adds i to the "max_party_size" field.
+func (wruo *WaitlistRuleUpdateOne) AddMaxPartySize(i int) *WaitlistRuleUpdateOne {
+	wruo.mutation.AddMaxPartySize(i)
+	return wruo
+}
+
+// SetDefaultTurnMinutes sets the "default_turn_minutes" field.
+func (wruo *WaitlistRuleUpdateOne) SetDefaultTurnMinutes(i int) *WaitlistRuleUpdateOne {
+	wruo.mutation.ResetDefaultTurnMinutes()
+	wruo.mutation.SetDefaultTurnMinutes(i)
+	return wruo
+}
+
+// SetNillableDefaultTurnMinutes sets the "default_turn_minutes" field if the given value is not nil.
+func (wruo *WaitlistRuleUpdateOne) SetNillableDefaultTurnMinutes(i *int) *WaitlistRuleUpdateOne {
+	if i != nil {
+		wruo.SetDefaultTurnMinutes(*i)
+	}
+	return wruo
+}
+
+// AddDefaultTurnMinutes adds i to the "default_turn_minutes" field.
+func (wruo *WaitlistRuleUpdateOne) AddDefaultTurnMinutes(i int) *WaitlistRuleUpdateOne {
+	wruo.mutation.AddDefaultTurnMinutes(i)
+	return wruo
+}
+
+// Mutation returns the WaitlistRuleMutation object of the builder.
+func (wruo *WaitlistRuleUpdateOne) Mutation() *WaitlistRuleMutation {
+	return wruo.mutation
+}
+
+// Where appends a list predicates to the WaitlistRuleUpdate builder.
+func (wruo *WaitlistRuleUpdateOne) Where(ps ...predicate.WaitlistRule) *WaitlistRuleUpdateOne {
+	wruo.mutation.Where(ps...)
+	return wruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wruo *WaitlistRuleUpdateOne) Select(field string, fields ...string) *WaitlistRuleUpdateOne {
+	wruo.fields = append([]string{field}, fields...)
+	return wruo
+}
+
+// Save executes the query and returns the updated WaitlistRule entity.
+func (wruo *WaitlistRuleUpdateOne) Save(ctx context.Context) (*WaitlistRule, error) {
+	wruo.defaults()
+	return withHooks(ctx, wruo.sqlSave, wruo.mutation, wruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wruo *WaitlistRuleUpdateOne) SaveX(ctx context.Context) *WaitlistRule {
+	node, err := wruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wruo *WaitlistRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := wruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wruo *WaitlistRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := wruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wruo *WaitlistRuleUpdateOne) defaults() {
+	if _, ok := wruo.mutation.UpdatedAt(); !ok {
+		v := waitlistrule.UpdateDefaultUpdatedAt()
+		wruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wruo *WaitlistRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WaitlistRuleUpdateOne {
+	wruo.modifiers = append(wruo.modifiers, modifiers...)
+	return wruo
+}
+
+func (wruo *WaitlistRuleUpdateOne) sqlSave(ctx context.Context) (_node *WaitlistRule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(waitlistrule.Table, waitlistrule.Columns, sqlgraph.NewFieldSpec(waitlistrule.FieldID, field.TypeUUID))
+	id, ok := wruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WaitlistRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistrule.FieldID)
+		for _, f := range fields {
+			if !waitlistrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != waitlistrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wruo.mutation.OnlineEnabled(); ok {
+		_spec.SetField(waitlistrule.FieldOnlineEnabled, field.TypeBool, value)
+	}
+	if value, ok := wruo.mutation.SmallMaxSize(); ok {
+		_spec.SetField(waitlistrule.FieldSmallMaxSize, field.TypeInt, value)
+	}
+	if value, ok := wruo.mutation.AddedSmallMaxSize(); ok {
+		_spec.AddField(waitlistrule.FieldSmallMaxSize, field.TypeInt, value)
+	}
+	if value, ok := wruo.mutation.MediumMaxSize(); ok {
+		_spec.SetField(waitlistrule.FieldMediumMaxSize, field.TypeInt, value)
+	}
+	if value, ok := wruo.mutation.AddedMediumMaxSize(); ok {
+		_spec.AddField(waitlistrule.FieldMediumMaxSize, field.TypeInt, value)
+	}
+	if value, ok := wruo.mutation.MaxPartySize(); ok {
+		_spec.SetField(waitlistrule.FieldMaxPartySize, field.TypeInt, value)
+	}
+	if value, ok := wruo.mutation.AddedMaxPartySize(); ok {
+		_spec.AddField(waitlistrule.FieldMaxPartySize, field.TypeInt, value)
+	}
+	if value, ok := wruo.mutation.DefaultTurnMinutes(); ok {
+		_spec.SetField(waitlistrule.FieldDefaultTurnMinutes, field.TypeInt, value)
+	}
+	if value, ok := wruo.mutation.AddedDefaultTurnMinutes(); ok {
+		_spec.AddField(waitlistrule.FieldDefaultTurnMinutes, field.TypeInt, value)
+	}
+	_spec.AddModifiers(wruo.modifiers...)
+	_node = &WaitlistRule{config: wruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistticket"
+)
+
+// WaitlistTicket is the model entity for the WaitlistTicket schema.
+type WaitlistTicket struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID as primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 品牌商ID
+	MerchantID uuid.UUID `json:"merchant_id,omitempty"`
+	// 门店ID
+	StoreID uuid.UUID `json:"store_id,omitempty"`
+	// 营业日
+	BusinessDate string `json:"business_date,omitempty"`
+	// 分组：small=小桌；medium=中桌；large=大桌
+	Bucket domain.WaitlistBucket `json:"bucket,omitempty"`
+	// 排队号
+	TicketNo string `json:"ticket_no,omitempty"`
+	// 用餐人数
+	PartySize int `json:"party_size,omitempty"`
+	// 顾客手机号
+	GuestPhone string `json:"guest_phone,omitempty"`
+	// 取号来源：store=门店取号；online=顾客扫码取号
+	Source domain.WaitlistTicketSource `json:"source,omitempty"`
+	// 扫码取号的顾客ID
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+	// 状态：waiting=等待中；called=已叫号；seated=已入座；skipped=已过号；cancelled=已取消
+	Status domain.WaitlistTicketStatus `json:"status,omitempty"`
+	// 叫号次数
+	CallCount int `json:"call_count,omitempty"`
+	// 最近叫号时间
+	CalledAt *time.Time `json:"called_at,omitempty"`
+	// 入座时间
+	SeatedAt *time.Time `json:"seated_at,omitempty"`
+	// 取消或过号时间
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// 入座桌台ID
+	TableID uuid.UUID `json:"table_id,omitempty"`
+	// 入座桌台名称
+	TableName string `json:"table_name,omitempty"`
+	// 入座后生成的订单ID
+	OrderID      uuid.UUID `json:"order_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WaitlistTicket) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case waitlistticket.FieldPartySize, waitlistticket.FieldCallCount:
+			values[i] = new(sql.NullInt64)
+		case waitlistticket.FieldBusinessDate, waitlistticket.FieldBucket, waitlistticket.FieldTicketNo, waitlistticket.FieldGuestPhone, waitlistticket.FieldSource, waitlistticket.FieldStatus, waitlistticket.FieldTableName:
+			values[i] = new(sql.NullString)
+		case waitlistticket.FieldCreatedAt, waitlistticket.FieldUpdatedAt, waitlistticket.FieldCalledAt, waitlistticket.FieldSeatedAt, waitlistticket.FieldCancelledAt:
+			values[i] = new(sql.NullTime)
+		case waitlistticket.FieldID, waitlistticket.FieldMerchantID, waitlistticket.FieldStoreID, waitlistticket.FieldCustomerID, waitlistticket.FieldTableID, waitlistticket.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WaitlistTicket fields.
+func (wt *WaitlistTicket) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case waitlistticket.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wt.ID = *value
+			}
+		case waitlistticket.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wt.CreatedAt = value.Time
+			}
+		case waitlistticket.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wt.UpdatedAt = value.Time
+			}
+		case waitlistticket.FieldMerchantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value != nil {
+				wt.MerchantID = *value
+			}
+		case waitlistticket.FieldStoreID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field store_id", values[i])
+			} else if value != nil {
+				wt.StoreID = *value
+			}
+		case waitlistticket.FieldBusinessDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field business_date", values[i])
+			} else if value.Valid {
+				wt.BusinessDate = value.String
+			}
+		case waitlistticket.FieldBucket:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket", values[i])
+			} else if value.Valid {
+				wt.Bucket = domain.WaitlistBucket(value.String)
+			}
+		case waitlistticket.FieldTicketNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_no", values[i])
+			} else if value.Valid {
+				wt.TicketNo = value.String
+			}
+		case waitlistticket.FieldPartySize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field party_size", values[i])
+			} else if value.Valid {
+				wt.PartySize = int(value.Int64)
+			}
+		case waitlistticket.FieldGuestPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guest_phone", values[i])
+			} else if value.Valid {
+				wt.GuestPhone = value.String
+			}
+		case waitlistticket.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				wt.Source = domain.WaitlistTicketSource(value.String)
+			}
+		case waitlistticket.FieldCustomerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value != nil {
+				wt.CustomerID = *value
+			}
+		case waitlistticket.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				wt.Status = domain.WaitlistTicketStatus(value.String)
+			}
+		case waitlistticket.FieldCallCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field call_count", values[i])
+			} else if value.Valid {
+				wt.CallCount = int(value.Int64)
+			}
+		case waitlistticket.FieldCalledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field called_at", values[i])
+			} else if value.Valid {
+				wt.CalledAt = new(time.Time)
+				*wt.CalledAt = value.Time
+			}
+		case waitlistticket.FieldSeatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field seated_at", values[i])
+			} else if value.Valid {
+				wt.SeatedAt = new(time.Time)
+				*wt.SeatedAt = value.Time
+			}
+		case waitlistticket.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				wt.CancelledAt = new(time.Time)
+				*wt.CancelledAt = value.Time
+			}
+		case waitlistticket.FieldTableID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field table_id", values[i])
+			} else if value != nil {
+				wt.TableID = *value
+			}
+		case waitlistticket.FieldTableName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field table_name", values[i])
+			} else if value.Valid {
+				wt.TableName = value.String
+			}
+		case waitlistticket.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				wt.OrderID = *value
+			}
+		default:
+			wt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WaitlistTicket.
+// This includes values selected through modifiers, order, etc.
+func (wt *WaitlistTicket) Value(name string) (ent.Value, error) {
+	return wt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WaitlistTicket.
+// Note that you need to call WaitlistTicket.Unwrap() before calling this method if this WaitlistTicket
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wt *WaitlistTicket) Update() *WaitlistTicketUpdateOne {
+	return NewWaitlistTicketClient(wt.config).UpdateOne(wt)
+}
+
+// Unwrap unwraps the WaitlistTicket entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wt *WaitlistTicket) Unwrap() *WaitlistTicket {
+	_tx, ok := wt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WaitlistTicket is not a transactional entity")
+	}
+	wt.config.driver = _tx.drv
+	return wt
+}
+
+// String implements the fmt.Stringer.
+func (wt *WaitlistTicket) String() string {
+	var builder strings.Builder
+	builder.WriteString("WaitlistTicket(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_id=")
+	builder.WriteString(fmt.Sprintf("%v", wt.MerchantID))
+	builder.WriteString(", ")
+	builder.WriteString("store_id=")
+	builder.WriteString(fmt.Sprintf("%v", wt.StoreID))
+	builder.WriteString(", ")
+	builder.WriteString("business_date=")
+	builder.WriteString(wt.BusinessDate)
+	builder.WriteString(", ")
+	builder.WriteString("bucket=")
+	builder.WriteString(fmt.Sprintf("%v", wt.Bucket))
+	builder.WriteString(", ")
+	builder.WriteString("ticket_no=")
+	builder.WriteString(wt.TicketNo)
+	builder.WriteString(", ")
+	builder.WriteString("party_size=")
+	builder.WriteString(fmt.Sprintf("%v", wt.PartySize))
+	builder.WriteString(", ")
+	builder.WriteString("guest_phone=")
+	builder.WriteString(wt.GuestPhone)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", wt.Source))
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(fmt.Sprintf("%v", wt.CustomerID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", wt.Status))
+	builder.WriteString(", ")
+	builder.WriteString("call_count=")
+	builder.WriteString(fmt.Sprintf("%v", wt.CallCount))
+	builder.WriteString(", ")
+	if v := wt.CalledAt; v != nil {
+		builder.WriteString("called_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := wt.SeatedAt; v != nil {
+		builder.WriteString("seated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := wt.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("table_id=")
+	builder.WriteString(fmt.Sprintf("%v", wt.TableID))
+	builder.WriteString(", ")
+	builder.WriteString("table_name=")
+	builder.WriteString(wt.TableName)
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", wt.OrderID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WaitlistTickets is a parsable slice of WaitlistTicket.
+type WaitlistTickets []*WaitlistTicket
@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package waitlistticket
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+const (
+	// Label holds the string label denoting the waitlistticket type in the database.
+	Label = "waitlist_ticket"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
+	// FieldStoreID holds the string denoting the store_id field in the database.
+	FieldStoreID = "store_id"
+	// FieldBusinessDate holds the string denoting the business_date field in the database.
+	FieldBusinessDate = "business_date"
+	// FieldBucket holds the string denoting the bucket field in the database.
+	FieldBucket = "bucket"
+	// FieldTicketNo holds the string denoting the ticket_no field in the database.
+	FieldTicketNo = "ticket_no"
+	// FieldPartySize holds the string denoting the party_size field in the database.
+	FieldPartySize = "party_size"
+	// FieldGuestPhone holds the string denoting the guest_phone field in the database.
+	FieldGuestPhone = "guest_phone"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldCustomerID holds the string denoting the customer_id field in the database.
+	FieldCustomerID = "customer_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCallCount holds the string denoting the call_count field in the database.
+	FieldCallCount = "call_count"
+	// FieldCalledAt holds the string denoting the called_at field in the database.
+	FieldCalledAt = "called_at"
+	// FieldSeatedAt holds the string denoting the seated_at field in the database.
+	FieldSeatedAt = "seated_at"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldTableID holds the string denoting the table_id field in the database.
+	FieldTableID = "table_id"
+	// FieldTableName holds the string denoting the table_name field in the database.
+	FieldTableName = "table_name"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// Table holds the table name of the waitlistticket in the database.
+	Table = "waitlist_tickets"
+)
+
+// Columns holds all SQL columns for waitlistticket fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMerchantID,
+	FieldStoreID,
+	FieldBusinessDate,
+	FieldBucket,
+	FieldTicketNo,
+	FieldPartySize,
+	FieldGuestPhone,
+	FieldSource,
+	FieldCustomerID,
+	FieldStatus,
+	FieldCallCount,
+	FieldCalledAt,
+	FieldSeatedAt,
+	FieldCancelledAt,
+	FieldTableID,
+	FieldTableName,
+	FieldOrderID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// BusinessDateValidator is a validator for the "business_date" field. It is called by the builders before save.
+	BusinessDateValidator func(string) error
+	// TicketNoValidator is a validator for the "ticket_no" field. It is called by the builders before save.
+	TicketNoValidator func(string) error
+	// DefaultGuestPhone holds the default value on creation for the "guest_phone" field.
+	DefaultGuestPhone string
+	// GuestPhoneValidator is a validator for the "guest_phone" field. It is called by the builders before save.
+	GuestPhoneValidator func(string) error
+	// DefaultCustomerID holds the default value on creation for the "customer_id" field.
+	DefaultCustomerID func() uuid.UUID
+	// DefaultCallCount holds the default value on creation for the "call_count" field.
+	DefaultCallCount int
+	// DefaultTableID holds the default value on creation for the "table_id" field.
+	DefaultTableID func() uuid.UUID
+	// DefaultTableName holds the default value on creation for the "table_name" field.
+	DefaultTableName string
+	// TableNameValidator is a validator for the "table_name" field. It is called by the builders before save.
+	TableNameValidator func(string) error
+	// DefaultOrderID holds the default value on creation for the "order_id" field.
+	DefaultOrderID func() uuid.UUID
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// BucketValidator is a validator for the "bucket" field enum values. It is called by the builders before save.
+func BucketValidator(b domain.WaitlistBucket) error {
+	switch b {
+	case "small", "medium", "large":
+		return nil
+	default:
+		return fmt.Errorf("waitlistticket: invalid enum value for bucket field: %q", b)
+	}
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s domain.WaitlistTicketSource) error {
+	switch s {
+	case "store", "online":
+		return nil
+	default:
+		return fmt.Errorf("waitlistticket: invalid enum value for source field: %q", s)
+	}
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s domain.WaitlistTicketStatus) error {
+	switch s {
+	case "waiting", "called", "seated", "skipped", "cancelled":
+		return nil
+	default:
+		return fmt.Errorf("waitlistticket: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the WaitlistTicket queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
+// ByStoreID orders the results by the store_id field.
+func ByStoreID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreID, opts...).ToFunc()
+}
+
+// ByBusinessDate orders the results by the business_date field.
+func ByBusinessDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBusinessDate, opts...).ToFunc()
+}
+
+// ByBucket orders the results by the bucket field.
+func ByBucket(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBucket, opts...).ToFunc()
+}
+
+// ByTicketNo orders the results by the ticket_no field.
+func ByTicketNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTicketNo, opts...).ToFunc()
+}
+
+// ByPartySize orders the results by the party_size field.
+func ByPartySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPartySize, opts...).ToFunc()
+}
+
+// ByGuestPhone orders the results by the guest_phone field.
+func ByGuestPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuestPhone, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByCustomerID orders the results by the customer_id field.
+func ByCustomerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCallCount orders the results by the call_count field.
+func ByCallCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCallCount, opts...).ToFunc()
+}
+
+// ByCalledAt orders the results by the called_at field.
+func ByCalledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCalledAt, opts...).ToFunc()
+}
+
+// BySeatedAt orders the results by the seated_at field.
+func BySeatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeatedAt, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByTableID orders the results by the table_id field.
+func ByTableID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTableID, opts...).ToFunc()
+}
+
+// ByTableName orders the results by the table_name field.
+func ByTableName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTableName, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
@@ -0,0 +1,1012 @@
+// Code generated by ent, DO NOT EDIT.
+
+package waitlistticket
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// StoreID applies equality check predicate on the "store_id" field. It's identical to StoreIDEQ.
+func StoreID(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldStoreID, v))
+}
+
+// BusinessDate applies equality check predicate on the "business_date" field. It's identical to BusinessDateEQ.
+func BusinessDate(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldBusinessDate, v))
+}
+
+// TicketNo applies equality check predicate on the "ticket_no" field. It's identical to TicketNoEQ.
+func TicketNo(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldTicketNo, v))
+}
+
+// PartySize applies equality check predicate on the "party_size" field. It's identical to PartySizeEQ.
+func PartySize(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldPartySize, v))
+}
+
+// GuestPhone applies equality check predicate on the "guest_phone" field. It's identical to GuestPhoneEQ.
+func GuestPhone(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldGuestPhone, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CallCount applies equality check predicate on the "call_count" field. It's identical to CallCountEQ.
+func CallCount(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldCallCount, v))
+}
+
+// CalledAt applies equality check predicate on the "called_at" field. It's identical to CalledAtEQ.
+func CalledAt(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldCalledAt, v))
+}
+
+// SeatedAt applies equality check predicate on the "seated_at" field. It's identical to SeatedAtEQ.
+func SeatedAt(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldSeatedAt, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// TableID applies equality check predicate on the "table_id" field. It's identical to TableIDEQ.
+func TableID(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldTableID, v))
+}
+
+// TableName applies equality check predicate on the "table_name" field. It's identical to TableNameEQ.
+func TableName(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldTableName, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldOrderID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// StoreIDEQ applies the EQ predicate on the "store_id" field.
+func StoreIDEQ(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldStoreID, v))
+}
+
+// StoreIDNEQ applies the NEQ predicate on the "store_id" field.
+func StoreIDNEQ(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldStoreID, v))
+}
+
+// StoreIDIn applies the In predicate on the "store_id" field.
+func StoreIDIn(vs ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldStoreID, vs...))
+}
+
+// StoreIDNotIn applies the NotIn predicate on the "store_id" field.
+func StoreIDNotIn(vs ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldStoreID, vs...))
+}
+
+// StoreIDGT applies the GT predicate on the "store_id" field.
+func StoreIDGT(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldStoreID, v))
+}
+
+// StoreIDGTE applies the GTE predicate on the "store_id" field.
+func StoreIDGTE(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldStoreID, v))
+}
+
+// StoreIDLT applies the LT predicate on the "store_id" field.
+func StoreIDLT(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldStoreID, v))
+}
+
+// StoreIDLTE applies the LTE predicate on the "store_id" field.
+func StoreIDLTE(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldStoreID, v))
+}
+
+// BusinessDateEQ applies the EQ predicate on the "business_date" field.
+func BusinessDateEQ(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldBusinessDate, v))
+}
+
+// BusinessDateNEQ applies the NEQ predicate on the "business_date" field.
+func BusinessDateNEQ(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldBusinessDate, v))
+}
+
+// BusinessDateIn applies the In predicate on the "business_date" field.
+func BusinessDateIn(vs ...string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldBusinessDate, vs...))
+}
+
+// BusinessDateNotIn applies the NotIn predicate on the "business_date" field.
+func BusinessDateNotIn(vs ...string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldBusinessDate, vs...))
+}
+
+// BusinessDateGT applies the GT predicate on the "business_date" field.
+func BusinessDateGT(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldBusinessDate, v))
+}
+
+// BusinessDateGTE applies the GTE predicate on the "business_date" field.
+func BusinessDateGTE(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldBusinessDate, v))
+}
+
+// BusinessDateLT applies the LT predicate on the "business_date" field.
+func BusinessDateLT(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldBusinessDate, v))
+}
+
+// BusinessDateLTE applies the LTE predicate on the "business_date" field.
+func BusinessDateLTE(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldBusinessDate, v))
+}
+
+// BusinessDateContains applies the Contains predicate on the "business_date" field.
+func BusinessDateContains(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldContains(FieldBusinessDate, v))
+}
+
+// BusinessDateHasPrefix applies the HasPrefix predicate on the "business_date" field.
+func BusinessDateHasPrefix(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldHasPrefix(FieldBusinessDate, v))
+}
+
+// BusinessDateHasSuffix applies the HasSuffix predicate on the "business_date" field.
+func BusinessDateHasSuffix(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldHasSuffix(FieldBusinessDate, v))
+}
+
+// BusinessDateEqualFold applies the EqualFold predicate on the "business_date" field.
+func BusinessDateEqualFold(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEqualFold(FieldBusinessDate, v))
+}
+
+// BusinessDateContainsFold applies the ContainsFold predicate on the "business_date" field.
+func BusinessDateContainsFold(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldContainsFold(FieldBusinessDate, v))
+}
+
+// BucketEQ applies the EQ predicate on the "bucket" field.
+func BucketEQ(v domain.WaitlistBucket) predicate.WaitlistTicket {
+	vc := v
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldBucket, vc))
+}
+
+// BucketNEQ applies the NEQ predicate on the "bucket" field.
+func BucketNEQ(v domain.WaitlistBucket) predicate.WaitlistTicket {
+	vc := v
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldBucket, vc))
+}
+
+// BucketIn applies the In predicate on the "bucket" field.
+func BucketIn(vs ...domain.WaitlistBucket) predicate.WaitlistTicket {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WaitlistTicket(sql.FieldIn(FieldBucket, v...))
+}
+
+// BucketNotIn applies the NotIn predicate on the "bucket" field.
+func BucketNotIn(vs ...domain.WaitlistBucket) predicate.WaitlistTicket {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldBucket, v...))
+}
+
+// TicketNoEQ applies the EQ predicate on the "ticket_no" field.
+func TicketNoEQ(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldTicketNo, v))
+}
+
+// TicketNoNEQ applies the NEQ predicate on the "ticket_no" field.
+func TicketNoNEQ(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldTicketNo, v))
+}
+
+// TicketNoIn applies the In predicate on the "ticket_no" field.
+func TicketNoIn(vs ...string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldTicketNo, vs...))
+}
+
+// TicketNoNotIn applies the NotIn predicate on the "ticket_no" field.
+func TicketNoNotIn(vs ...string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldTicketNo, vs...))
+}
+
+// TicketNoGT applies the GT predicate on the "ticket_no" field.
+func TicketNoGT(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldTicketNo, v))
+}
+
+// TicketNoGTE applies the GTE predicate on the "ticket_no" field.
+func TicketNoGTE(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldTicketNo, v))
+}
+
+// TicketNoLT applies the LT predicate on the "ticket_no" field.
+func TicketNoLT(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldTicketNo, v))
+}
+
+// TicketNoLTE applies the LTE predicate on the "ticket_no" field.
+func TicketNoLTE(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldTicketNo, v))
+}
+
+// TicketNoContains applies the Contains predicate on the "ticket_no" field.
+func TicketNoContains(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldContains(FieldTicketNo, v))
+}
+
+// TicketNoHasPrefix applies the HasPrefix predicate on the "ticket_no" field.
+func TicketNoHasPrefix(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldHasPrefix(FieldTicketNo, v))
+}
+
+// TicketNoHasSuffix applies the HasSuffix predicate on the "ticket_no" field.
+func TicketNoHasSuffix(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldHasSuffix(FieldTicketNo, v))
+}
+
+// TicketNoEqualFold applies the EqualFold predicate on the "ticket_no" field.
+func TicketNoEqualFold(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEqualFold(FieldTicketNo, v))
+}
+
+// TicketNoContainsFold applies the ContainsFold predicate on the "ticket_no" field.
+func TicketNoContainsFold(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldContainsFold(FieldTicketNo, v))
+}
+
+// PartySizeEQ applies the EQ predicate on the "party_size" field.
+func PartySizeEQ(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldPartySize, v))
+}
+
+// PartySizeNEQ applies the NEQ predicate on the "party_size" field.
+func PartySizeNEQ(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldPartySize, v))
+}
+
+// PartySizeIn applies the In predicate on the "party_size" field.
+func PartySizeIn(vs ...int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldPartySize, vs...))
+}
+
+// PartySizeNotIn applies the NotIn predicate on the "party_size" field.
+func PartySizeNotIn(vs ...int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldPartySize, vs...))
+}
+
+// PartySizeGT applies the GT predicate on the "party_size" field.
+func PartySizeGT(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldPartySize, v))
+}
+
+// PartySizeGTE applies the GTE predicate on the "party_size" field.
+func PartySizeGTE(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldPartySize, v))
+}
+
+// PartySizeLT applies the LT predicate on the "party_size" field.
+func PartySizeLT(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldPartySize, v))
+}
+
+// PartySizeLTE applies the LTE predicate on the "party_size" field.
+func PartySizeLTE(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldPartySize, v))
+}
+
+// GuestPhoneEQ applies the EQ predicate on the "guest_phone" field.
+func GuestPhoneEQ(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldGuestPhone, v))
+}
+
+// GuestPhoneNEQ applies the NEQ predicate on the "guest_phone" field.
+func GuestPhoneNEQ(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldGuestPhone, v))
+}
+
+// GuestPhoneIn applies the In predicate on the "guest_phone" field.
+func GuestPhoneIn(vs ...string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldGuestPhone, vs...))
+}
+
+// GuestPhoneNotIn applies the NotIn predicate on the "guest_phone" field.
+func GuestPhoneNotIn(vs ...string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldGuestPhone, vs...))
+}
+
+// GuestPhoneGT applies the GT predicate on the "guest_phone" field.
+func GuestPhoneGT(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldGuestPhone, v))
+}
+
+// GuestPhoneGTE applies the GTE predicate on the "guest_phone" field.
+func GuestPhoneGTE(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldGuestPhone, v))
+}
+
+// GuestPhoneLT applies the LT predicate on the "guest_phone" field.
+func GuestPhoneLT(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldGuestPhone, v))
+}
+
+// GuestPhoneLTE applies the LTE predicate on the "guest_phone" field.
+func GuestPhoneLTE(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldGuestPhone, v))
+}
+
+// GuestPhoneContains applies the Contains predicate on the "guest_phone" field.
+func GuestPhoneContains(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldContains(FieldGuestPhone, v))
+}
+
+// GuestPhoneHasPrefix applies the HasPrefix predicate on the "guest_phone" field.
+func GuestPhoneHasPrefix(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldHasPrefix(FieldGuestPhone, v))
+}
+
+// GuestPhoneHasSuffix applies the HasSuffix predicate on the "guest_phone" field.
+func GuestPhoneHasSuffix(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldHasSuffix(FieldGuestPhone, v))
+}
+
+// GuestPhoneEqualFold applies the EqualFold predicate on the "guest_phone" field.
+func GuestPhoneEqualFold(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEqualFold(FieldGuestPhone, v))
+}
+
+// GuestPhoneContainsFold applies the ContainsFold predicate on the "guest_phone" field.
+func GuestPhoneContainsFold(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldContainsFold(FieldGuestPhone, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v domain.WaitlistTicketSource) predicate.WaitlistTicket {
+	vc := v
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldSource, vc))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v domain.WaitlistTicketSource) predicate.WaitlistTicket {
+	vc := v
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldSource, vc))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...domain.WaitlistTicketSource) predicate.WaitlistTicket {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WaitlistTicket(sql.FieldIn(FieldSource, v...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...domain.WaitlistTicketSource) predicate.WaitlistTicket {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldSource, v...))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v domain.WaitlistTicketStatus) predicate.WaitlistTicket {
+	vc := v
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v domain.WaitlistTicketStatus) predicate.WaitlistTicket {
+	vc := v
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...domain.WaitlistTicketStatus) predicate.WaitlistTicket {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WaitlistTicket(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...domain.WaitlistTicketStatus) predicate.WaitlistTicket {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// CallCountEQ applies the EQ predicate on the "call_count" field.
+func CallCountEQ(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldCallCount, v))
+}
+
+// CallCountNEQ applies the NEQ predicate on the "call_count" field.
+func CallCountNEQ(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldCallCount, v))
+}
+
+// CallCountIn applies the In predicate on the "call_count" field.
+func CallCountIn(vs ...int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldCallCount, vs...))
+}
+
+// CallCountNotIn applies the NotIn predicate on the "call_count" field.
+func CallCountNotIn(vs ...int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldCallCount, vs...))
+}
+
+// CallCountGT applies the GT predicate on the "call_count" field.
+func CallCountGT(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldCallCount, v))
+}
+
+// CallCountGTE applies the GTE predicate on the "call_count" field.
+func CallCountGTE(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldCallCount, v))
+}
+
+// CallCountLT applies the LT predicate on the "call_count" field.
+func CallCountLT(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldCallCount, v))
+}
+
+// CallCountLTE applies the LTE predicate on the "call_count" field.
+func CallCountLTE(v int) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldCallCount, v))
+}
+
+// CalledAtEQ applies the EQ predicate on the "called_at" field.
+func CalledAtEQ(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldCalledAt, v))
+}
+
+// CalledAtNEQ applies the NEQ predicate on the "called_at" field.
+func CalledAtNEQ(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldCalledAt, v))
+}
+
+// CalledAtIn applies the In predicate on the "called_at" field.
+func CalledAtIn(vs ...time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldCalledAt, vs...))
+}
+
+// CalledAtNotIn applies the NotIn predicate on the "called_at" field.
+func CalledAtNotIn(vs ...time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldCalledAt, vs...))
+}
+
+// CalledAtGT applies the GT predicate on the "called_at" field.
+func CalledAtGT(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldCalledAt, v))
+}
+
+// CalledAtGTE applies the GTE predicate on the "called_at" field.
+func CalledAtGTE(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldCalledAt, v))
+}
+
+// CalledAtLT applies the LT predicate on the "called_at" field.
+func CalledAtLT(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldCalledAt, v))
+}
+
+// CalledAtLTE applies the LTE predicate on the "called_at" field.
+func CalledAtLTE(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldCalledAt, v))
+}
+
+// CalledAtIsNil applies the IsNil predicate on the "called_at" field.
+func CalledAtIsNil() predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIsNull(FieldCalledAt))
+}
+
+// CalledAtNotNil applies the NotNil predicate on the "called_at" field.
+func CalledAtNotNil() predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotNull(FieldCalledAt))
+}
+
+// SeatedAtEQ applies the EQ predicate on the "seated_at" field.
+func SeatedAtEQ(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldSeatedAt, v))
+}
+
+// SeatedAtNEQ applies the NEQ predicate on the "seated_at" field.
+func SeatedAtNEQ(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldSeatedAt, v))
+}
+
+// SeatedAtIn applies the In predicate on the "seated_at" field.
+func SeatedAtIn(vs ...time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldSeatedAt, vs...))
+}
+
+// SeatedAtNotIn applies the NotIn predicate on the "seated_at" field.
+func SeatedAtNotIn(vs ...time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldSeatedAt, vs...))
+}
+
+// SeatedAtGT applies the GT predicate on the "seated_at" field.
+func SeatedAtGT(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldSeatedAt, v))
+}
+
+// SeatedAtGTE applies the GTE predicate on the "seated_at" field.
+func SeatedAtGTE(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldSeatedAt, v))
+}
+
+// SeatedAtLT applies the LT predicate on the "seated_at" field.
+func SeatedAtLT(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldSeatedAt, v))
+}
+
+// SeatedAtLTE applies the LTE predicate on the "seated_at" field.
+func SeatedAtLTE(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldSeatedAt, v))
+}
+
+// SeatedAtIsNil applies the IsNil predicate on the "seated_at" field.
+func SeatedAtIsNil() predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIsNull(FieldSeatedAt))
+}
+
+// SeatedAtNotNil applies the NotNil predicate on the "seated_at" field.
+func SeatedAtNotNil() predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotNull(FieldSeatedAt))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// TableIDEQ applies the EQ predicate on the "table_id" field.
+func TableIDEQ(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldTableID, v))
+}
+
+// TableIDNEQ applies the NEQ predicate on the "table_id" field.
+func TableIDNEQ(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldTableID, v))
+}
+
+// TableIDIn applies the In predicate on the "table_id" field.
+func TableIDIn(vs ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldTableID, vs...))
+}
+
+// TableIDNotIn applies the NotIn predicate on the "table_id" field.
+func TableIDNotIn(vs ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldTableID, vs...))
+}
+
+// TableIDGT applies the GT predicate on the "table_id" field.
+func TableIDGT(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldTableID, v))
+}
+
+// TableIDGTE applies the GTE predicate on the "table_id" field.
+func TableIDGTE(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldTableID, v))
+}
+
+// TableIDLT applies the LT predicate on the "table_id" field.
+func TableIDLT(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldTableID, v))
+}
+
+// TableIDLTE applies the LTE predicate on the "table_id" field.
+func TableIDLTE(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldTableID, v))
+}
+
+// TableNameEQ applies the EQ predicate on the "table_name" field.
+func TableNameEQ(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldTableName, v))
+}
+
+// TableNameNEQ applies the NEQ predicate on the "table_name" field.
+func TableNameNEQ(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldTableName, v))
+}
+
+// TableNameIn applies the In predicate on the "table_name" field.
+func TableNameIn(vs ...string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldTableName, vs...))
+}
+
+// TableNameNotIn applies the NotIn predicate on the "table_name" field.
+func TableNameNotIn(vs ...string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldTableName, vs...))
+}
+
+// TableNameGT applies the GT predicate on the "table_name" field.
+func TableNameGT(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldTableName, v))
+}
+
+// TableNameGTE applies the GTE predicate on the "table_name" field.
+func TableNameGTE(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldTableName, v))
+}
+
+// TableNameLT applies the LT predicate on the "table_name" field.
+func TableNameLT(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldTableName, v))
+}
+
+// TableNameLTE applies the LTE predicate on the "table_name" field.
+func TableNameLTE(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldTableName, v))
+}
+
+// TableNameContains applies the Contains predicate on the "table_name" field.
+func TableNameContains(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldContains(FieldTableName, v))
+}
+
+// TableNameHasPrefix applies the HasPrefix predicate on the "table_name" field.
+func TableNameHasPrefix(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldHasPrefix(FieldTableName, v))
+}
+
+// TableNameHasSuffix applies the HasSuffix predicate on the "table_name" field.
+func TableNameHasSuffix(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldHasSuffix(FieldTableName, v))
+}
+
+// TableNameEqualFold applies the EqualFold predicate on the "table_name" field.
+func TableNameEqualFold(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEqualFold(FieldTableName, v))
+}
+
+// TableNameContainsFold applies the ContainsFold predicate on the "table_name" field.
+func TableNameContainsFold(v string) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldContainsFold(FieldTableName, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v uuid.UUID) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.FieldLTE(FieldOrderID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WaitlistTicket) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WaitlistTicket) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WaitlistTicket) predicate.WaitlistTicket {
+	return predicate.WaitlistTicket(sql.NotPredicates(p))
+}
@@ -0,0 +1,1350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistticket"
+)
+
+// WaitlistTicketCreate is the builder for creating a WaitlistTicket entity.
+type WaitlistTicketCreate struct {
+	config
+	mutation *WaitlistTicketMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wtc *WaitlistTicketCreate) SetCreatedAt(t time.Time) *WaitlistTicketCreate {
+	wtc.mutation.SetCreatedAt(t)
+	return wtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableCreatedAt(t *time.Time) *WaitlistTicketCreate {
+	if t != nil {
+		wtc.SetCreatedAt(*t)
+	}
+	return wtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wtc *WaitlistTicketCreate) SetUpdatedAt(t time.Time) *WaitlistTicketCreate {
+	wtc.mutation.SetUpdatedAt(t)
+	return wtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableUpdatedAt(t *time.Time) *WaitlistTicketCreate {
+	if t != nil {
+		wtc.SetUpdatedAt(*t)
+	}
+	return wtc
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (wtc *WaitlistTicketCreate) SetMerchantID(u uuid.UUID) *WaitlistTicketCreate {
+	wtc.mutation.SetMerchantID(u)
+	return wtc
+}
+
+// SetStoreID sets the "store_id" field.
+func (wtc *WaitlistTicketCreate) SetStoreID(u uuid.UUID) *WaitlistTicketCreate {
+	wtc.mutation.SetStoreID(u)
+	return wtc
+}
+
+// SetBusinessDate sets the "business_date" field.
+func (wtc *WaitlistTicketCreate) SetBusinessDate(s string) *WaitlistTicketCreate {
+	wtc.mutation.SetBusinessDate(s)
+	return wtc
+}
+
+// SetBucket sets the "bucket" field.
+func (wtc *WaitlistTicketCreate) SetBucket(db domain.WaitlistBucket) *WaitlistTicketCreate {
+	wtc.mutation.SetBucket(db)
+	return wtc
+}
+
+// SetTicketNo sets the "ticket_no" field.
+func (wtc *WaitlistTicketCreate) SetTicketNo(s string) *WaitlistTicketCreate {
+	wtc.mutation.SetTicketNo(s)
+	return wtc
+}
+
+// SetPartySize sets the "party_size" field.
+func (wtc *WaitlistTicketCreate) SetPartySize(i int) *WaitlistTicketCreate {
+	wtc.mutation.SetPartySize(i)
+	return wtc
+}
+
+// SetGuestPhone sets the "guest_phone" field.
+func (wtc *WaitlistTicketCreate) SetGuestPhone(s string) *WaitlistTicketCreate {
+	wtc.mutation.SetGuestPhone(s)
+	return wtc
+}
+
+// SetNillableGuestPhone sets the "guest_phone" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableGuestPhone(s *string) *WaitlistTicketCreate {
+	if s != nil {
+		wtc.SetGuestPhone(*s)
+	}
+	return wtc
+}
+
+// SetSource sets the "source" field.
+func (wtc *WaitlistTicketCreate) SetSource(dts domain.WaitlistTicketSource) *WaitlistTicketCreate {
+	wtc.mutation.SetSource(dts)
+	return wtc
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (wtc *WaitlistTicketCreate) SetCustomerID(u uuid.UUID) *WaitlistTicketCreate {
+	wtc.mutation.SetCustomerID(u)
+	return wtc
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableCustomerID(u *uuid.UUID) *WaitlistTicketCreate {
+	if u != nil {
+		wtc.SetCustomerID(*u)
+	}
+	return wtc
+}
+
+// SetStatus sets the "status" field.
+func (wtc *WaitlistTicketCreate) SetStatus(dts domain.WaitlistTicketStatus) *WaitlistTicketCreate {
+	wtc.mutation.SetStatus(dts)
+	return wtc
+}
+
+// SetCallCount sets the "call_count" field.
+func (wtc *WaitlistTicketCreate) SetCallCount(i int) *WaitlistTicketCreate {
+	wtc.mutation.SetCallCount(i)
+	return wtc
+}
+
+// SetNillableCallCount sets the "call_count" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableCallCount(i *int) *WaitlistTicketCreate {
+	if i != nil {
+		wtc.SetCallCount(*i)
+	}
+	return wtc
+}
+
+// SetCalledAt sets the "called_at" field.
+func (wtc *WaitlistTicketCreate) SetCalledAt(t time.Time) *WaitlistTicketCreate {
+	wtc.mutation.SetCalledAt(t)
+	return wtc
+}
+
+// SetNillableCalledAt sets the "called_at" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableCalledAt(t *time.Time) *WaitlistTicketCreate {
+	if t != nil {
+		wtc.SetCalledAt(*t)
+	}
+	return wtc
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (wtc *WaitlistTicketCreate) SetSeatedAt(t time.Time) *WaitlistTicketCreate {
+	wtc.mutation.SetSeatedAt(t)
+	return wtc
+}
+
+// SetNillableSeatedAt sets the "seated_at" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableSeatedAt(t *time.Time) *WaitlistTicketCreate {
+	if t != nil {
+		wtc.SetSeatedAt(*t)
+	}
+	return wtc
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (wtc *WaitlistTicketCreate) SetCancelledAt(t time.Time) *WaitlistTicketCreate {
+	wtc.mutation.SetCancelledAt(t)
+	return wtc
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableCancelledAt(t *time.Time) *WaitlistTicketCreate {
+	if t != nil {
+		wtc.SetCancelledAt(*t)
+	}
+	return wtc
+}
+
+// SetTableID sets the "table_id" field.
+func (wtc *WaitlistTicketCreate) SetTableID(u uuid.UUID) *WaitlistTicketCreate {
+	wtc.mutation.SetTableID(u)
+	return wtc
+}
+
+// SetNillableTableID sets the "table_id" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableTableID(u *uuid.UUID) *WaitlistTicketCreate {
+	if u != nil {
+		wtc.SetTableID(*u)
+	}
+	return wtc
+}
+
+// SetTableName sets the "table_name" field.
+func (wtc *WaitlistTicketCreate) SetTableName(s string) *WaitlistTicketCreate {
+	wtc.mutation.SetTableName(s)
+	return wtc
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableTableName(s *string) *WaitlistTicketCreate {
+	if s != nil {
+		wtc.SetTableName(*s)
+	}
+	return wtc
+}
+
+// SetOrderID sets the "order_id" field.
+func (wtc *WaitlistTicketCreate) SetOrderID(u uuid.UUID) *WaitlistTicketCreate {
+	wtc.mutation.SetOrderID(u)
+	return wtc
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableOrderID(u *uuid.UUID) *WaitlistTicketCreate {
+	if u != nil {
+		wtc.SetOrderID(*u)
+	}
+	return wtc
+}
+
+// SetID sets the "id" field.
+func (wtc *WaitlistTicketCreate) SetID(u uuid.UUID) *WaitlistTicketCreate {
+	wtc.mutation.SetID(u)
+	return wtc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wtc *WaitlistTicketCreate) SetNillableID(u *uuid.UUID) *WaitlistTicketCreate {
+	if u != nil {
+		wtc.SetID(*u)
+	}
+	return wtc
+}
+
+// Mutation returns the WaitlistTicketMutation object of the builder.
+func (wtc *WaitlistTicketCreate) Mutation() *WaitlistTicketMutation {
+	return wtc.mutation
+}
+
+// Save creates the WaitlistTicket in the database.
+func (wtc *WaitlistTicketCreate) Save(ctx context.Context) (*WaitlistTicket, error) {
+	wtc.defaults()
+	return withHooks(ctx, wtc.sqlSave, wtc.mutation, wtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wtc *WaitlistTicketCreate) SaveX(ctx context.Context) *WaitlistTicket {
+	v, err := wtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wtc *WaitlistTicketCreate) Exec(ctx context.Context) error {
+	_, err := wtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtc *WaitlistTicketCreate) ExecX(ctx context.Context) {
+	if err := wtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wtc *WaitlistTicketCreate) defaults() {
+	if _, ok := wtc.mutation.CreatedAt(); !ok {
+		v := waitlistticket.DefaultCreatedAt()
+		wtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wtc.mutation.UpdatedAt(); !ok {
+		v := waitlistticket.DefaultUpdatedAt()
+		wtc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wtc.mutation.GuestPhone(); !ok {
+		v := waitlistticket.DefaultGuestPhone
+		wtc.mutation.SetGuestPhone(v)
+	}
+	if _, ok := wtc.mutation.CustomerID(); !ok {
+		v := waitlistticket.DefaultCustomerID()
+		wtc.mutation.SetCustomerID(v)
+	}
+	if _, ok := wtc.mutation.CallCount(); !ok {
+		v := waitlistticket.DefaultCallCount
+		wtc.mutation.SetCallCount(v)
+	}
+	if _, ok := wtc.mutation.TableID(); !ok {
+		v := waitlistticket.DefaultTableID()
+		wtc.mutation.SetTableID(v)
+	}
+	if _, ok := wtc.mutation.TableName(); !ok {
+		v := waitlistticket.DefaultTableName
+		wtc.mutation.SetTableName(v)
+	}
+	if _, ok := wtc.mutation.OrderID(); !ok {
+		v := waitlistticket.DefaultOrderID()
+		wtc.mutation.SetOrderID(v)
+	}
+	if _, ok := wtc.mutation.ID(); !ok {
+		v := waitlistticket.DefaultID()
+		wtc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wtc *WaitlistTicketCreate) check() error {
+	if _, ok := wtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WaitlistTicket.created_at"`)}
+	}
+	if _, ok := wtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WaitlistTicket.updated_at"`)}
+	}
+	if _, ok := wtc.mutation.MerchantID(); !ok {
+		return &ValidationError{Name: "merchant_id", err: errors.New(`ent: missing required field "WaitlistTicket.merchant_id"`)}
+	}
+	if _, ok := wtc.mutation.StoreID(); !ok {
+		return &ValidationError{Name: "store_id", err: errors.New(`ent: missing required field "WaitlistTicket.store_id"`)}
+	}
+	if _, ok := wtc.mutation.BusinessDate(); !ok {
+		return &ValidationError{Name: "business_date", err: errors.New(`ent: missing required field "WaitlistTicket.business_date"`)}
+	}
+	if v, ok := wtc.mutation.BusinessDate(); ok {
+		if err := waitlistticket.BusinessDateValidator(v); err != nil {
+			return &ValidationError{Name: "business_date", err: fmt.Errorf(`ent: validator failed for field "WaitlistTicket.business_date": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.Bucket(); !ok {
+		return &ValidationError{Name: "bucket", err: errors.New(`ent: missing required field "WaitlistTicket.bucket"`)}
+	}
+	if v, ok := wtc.mutation.Bucket(); ok {
+		if err := waitlistticket.BucketValidator(v); err != nil {
+			return &ValidationError{Name: "bucket", err: fmt.Errorf(`ent: validator failed for field "WaitlistTicket.bucket": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.TicketNo(); !ok {
+		return &ValidationError{Name: "ticket_no", err: errors.New(`ent: missing required field "WaitlistTicket.ticket_no"`)}
+	}
+	if v, ok := wtc.mutation.TicketNo(); ok {
+		if err := waitlistticket.TicketNoValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_no", err: fmt.Errorf(`ent: validator failed for field "WaitlistTicket.ticket_no": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.PartySize(); !ok {
+		return &ValidationError{Name: "party_size", err: errors.New(`ent: missing required field "WaitlistTicket.party_size"`)}
+	}
+	if _, ok := wtc.mutation.GuestPhone(); !ok {
+		return &ValidationError{Name: "guest_phone", err: errors.New(`ent: missing required field "WaitlistTicket.guest_phone"`)}
+	}
+	if v, ok := wtc.mutation.GuestPhone(); ok {
+		if err := waitlistticket.GuestPhoneValidator(v); err != nil {
+			return &ValidationError{Name: "guest_phone", err: fmt.Errorf(`ent: validator failed for field "WaitlistTicket.guest_phone": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "WaitlistTicket.source"`)}
+	}
+	if v, ok := wtc.mutation.Source(); ok {
+		if err := waitlistticket.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "WaitlistTicket.source": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "WaitlistTicket.customer_id"`)}
+	}
+	if _, ok := wtc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WaitlistTicket.status"`)}
+	}
+	if v, ok := wtc.mutation.Status(); ok {
+		if err := waitlistticket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistTicket.status": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.CallCount(); !ok {
+		return &ValidationError{Name: "call_count", err: errors.New(`ent: missing required field "WaitlistTicket.call_count"`)}
+	}
+	if _, ok := wtc.mutation.TableID(); !ok {
+		return &ValidationError{Name: "table_id", err: errors.New(`ent: missing required field "WaitlistTicket.table_id"`)}
+	}
+	if _, ok := wtc.mutation.TableName(); !ok {
+		return &ValidationError{Name: "table_name", err: errors.New(`ent: missing required field "WaitlistTicket.table_name"`)}
+	}
+	if v, ok := wtc.mutation.TableName(); ok {
+		if err := waitlistticket.TableNameValidator(v); err != nil {
+			return &ValidationError{Name: "table_name", err: fmt.Errorf(`ent: validator failed for field "WaitlistTicket.table_name": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "WaitlistTicket.order_id"`)}
+	}
+	return nil
+}
+
+func (wtc *WaitlistTicketCreate) sqlSave(ctx context.Context) (*WaitlistTicket, error) {
+	if err := wtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wtc.mutation.id = &_node.ID
+	wtc.mutation.done = true
+	return _node, nil
+}
+
+func (wtc *WaitlistTicketCreate) createSpec() (*WaitlistTicket, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WaitlistTicket{config: wtc.config}
+		_spec = sqlgraph.NewCreateSpec(waitlistticket.Table, sqlgraph.NewFieldSpec(waitlistticket.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = wtc.conflict
+	if id, ok := wtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wtc.mutation.CreatedAt(); ok {
+		_spec.SetField(waitlistticket.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistticket.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wtc.mutation.MerchantID(); ok {
+		_spec.SetField(waitlistticket.FieldMerchantID, field.TypeUUID, value)
+		_node.MerchantID = value
+	}
+	if value, ok := wtc.mutation.StoreID(); ok {
+		_spec.SetField(waitlistticket.FieldStoreID, field.TypeUUID, value)
+		_node.StoreID = value
+	}
+	if value, ok := wtc.mutation.BusinessDate(); ok {
+		_spec.SetField(waitlistticket.FieldBusinessDate, field.TypeString, value)
+		_node.BusinessDate = value
+	}
+	if value, ok := wtc.mutation.Bucket(); ok {
+		_spec.SetField(waitlistticket.FieldBucket, field.TypeEnum, value)
+		_node.Bucket = value
+	}
+	if value, ok := wtc.mutation.TicketNo(); ok {
+		_spec.SetField(waitlistticket.FieldTicketNo, field.TypeString, value)
+		_node.TicketNo = value
+	}
+	if value, ok := wtc.mutation.PartySize(); ok {
+		_spec.SetField(waitlistticket.FieldPartySize, field.TypeInt, value)
+		_node.PartySize = value
+	}
+	if value, ok := wtc.mutation.GuestPhone(); ok {
+		_spec.SetField(waitlistticket.FieldGuestPhone, field.TypeString, value)
+		_node.GuestPhone = value
+	}
+	if value, ok := wtc.mutation.Source(); ok {
+		_spec.SetField(waitlistticket.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := wtc.mutation.CustomerID(); ok {
+		_spec.SetField(waitlistticket.FieldCustomerID, field.TypeUUID, value)
+		_node.CustomerID = value
+	}
+	if value, ok := wtc.mutation.Status(); ok {
+		_spec.SetField(waitlistticket.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wtc.mutation.CallCount(); ok {
+		_spec.SetField(waitlistticket.FieldCallCount, field.TypeInt, value)
+		_node.CallCount = value
+	}
+	if value, ok := wtc.mutation.CalledAt(); ok {
+		_spec.SetField(waitlistticket.FieldCalledAt, field.TypeTime, value)
+		_node.CalledAt = &value
+	}
+	if value, ok := wtc.mutation.SeatedAt(); ok {
+		_spec.SetField(waitlistticket.FieldSeatedAt, field.TypeTime, value)
+		_node.SeatedAt = &value
+	}
+	if value, ok := wtc.mutation.CancelledAt(); ok {
+		_spec.SetField(waitlistticket.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := wtc.mutation.TableID(); ok {
+		_spec.SetField(waitlistticket.FieldTableID, field.TypeUUID, value)
+		_node.TableID = value
+	}
+	if value, ok := wtc.mutation.TableName(); ok {
+		_spec.SetField(waitlistticket.FieldTableName, field.TypeString, value)
+		_node.TableName = value
+	}
+	if value, ok := wtc.mutation.OrderID(); ok {
+		_spec.SetField(waitlistticket.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WaitlistTicket.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WaitlistTicketUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wtc *WaitlistTicketCreate) OnConflict(opts ...sql.ConflictOption) *WaitlistTicketUpsertOne {
+	wtc.conflict = opts
+	return &WaitlistTicketUpsertOne{
+		create: wtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WaitlistTicket.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wtc *WaitlistTicketCreate) OnConflictColumns(columns ...string) *WaitlistTicketUpsertOne {
+	wtc.conflict = append(wtc.conflict, sql.ConflictColumns(columns...))
+	return &WaitlistTicketUpsertOne{
+		create: wtc,
+	}
+}
+
+type (
+	// WaitlistTicketUpsertOne is the builder for "upsert"-ing
+	//  one WaitlistTicket node.
+	WaitlistTicketUpsertOne struct {
+		create *WaitlistTicketCreate
+	}
+
+	// WaitlistTicketUpsert is the "OnConflict" setter.
+	WaitlistTicketUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WaitlistTicketUpsert) SetUpdatedAt(v time.Time) *WaitlistTicketUpsert {
+	u.Set(waitlistticket.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsert) UpdateUpdatedAt() *WaitlistTicketUpsert {
+	u.SetExcluded(waitlistticket.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WaitlistTicketUpsert) SetStatus(v domain.WaitlistTicketStatus) *WaitlistTicketUpsert {
+	u.Set(waitlistticket.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WaitlistTicketUpsert) UpdateStatus() *WaitlistTicketUpsert {
+	u.SetExcluded(waitlistticket.FieldStatus)
+	return u
+}
+
+// SetCallCount sets the "call_count" field.
+func (u *WaitlistTicketUpsert) SetCallCount(v int) *WaitlistTicketUpsert {
+	u.Set(waitlistticket.FieldCallCount, v)
+	return u
+}
+
+// UpdateCallCount sets the "call_count" field to the value that was provided on create.
+func (u *WaitlistTicketUpsert) UpdateCallCount() *WaitlistTicketUpsert {
+	u.SetExcluded(waitlistticket.FieldCallCount)
+	return u
+}
+
+// AddCallCount adds v to the "call_count" field.
+func (u *WaitlistTicketUpsert) AddCallCount(v int) *WaitlistTicketUpsert {
+	u.Add(waitlistticket.FieldCallCount, v)
+	return u
+}
+
+// SetCalledAt sets the "called_at" field.
+func (u *WaitlistTicketUpsert) SetCalledAt(v time.Time) *WaitlistTicketUpsert {
+	u.Set(waitlistticket.FieldCalledAt, v)
+	return u
+}
+
+// UpdateCalledAt sets the "called_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsert) UpdateCalledAt() *WaitlistTicketUpsert {
+	u.SetExcluded(waitlistticket.FieldCalledAt)
+	return u
+}
+
+// ClearCalledAt clears the value of the "called_at" field.
+func (u *WaitlistTicketUpsert) ClearCalledAt() *WaitlistTicketUpsert {
+	u.SetNull(waitlistticket.FieldCalledAt)
+	return u
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (u *WaitlistTicketUpsert) SetSeatedAt(v time.Time) *WaitlistTicketUpsert {
+	u.Set(waitlistticket.FieldSeatedAt, v)
+	return u
+}
+
+// UpdateSeatedAt sets the "seated_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsert) UpdateSeatedAt() *WaitlistTicketUpsert {
+	u.SetExcluded(waitlistticket.FieldSeatedAt)
+	return u
+}
+
+// ClearSeatedAt clears the value of the "seated_at" field.
+func (u *WaitlistTicketUpsert) ClearSeatedAt() *WaitlistTicketUpsert {
+	u.SetNull(waitlistticket.FieldSeatedAt)
+	return u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *WaitlistTicketUpsert) SetCancelledAt(v time.Time) *WaitlistTicketUpsert {
+	u.Set(waitlistticket.FieldCancelledAt, v)
+	return u
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsert) UpdateCancelledAt() *WaitlistTicketUpsert {
+	u.SetExcluded(waitlistticket.FieldCancelledAt)
+	return u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *WaitlistTicketUpsert) ClearCancelledAt() *WaitlistTicketUpsert {
+	u.SetNull(waitlistticket.FieldCancelledAt)
+	return u
+}
+
+// SetTableID sets the "table_id" field.
+func (u *WaitlistTicketUpsert) SetTableID(v uuid.UUID) *WaitlistTicketUpsert {
+	u.Set(waitlistticket.FieldTableID, v)
+	return u
+}
+
+// UpdateTableID sets the "table_id" field to the value that was provided on create.
+func (u *WaitlistTicketUpsert) UpdateTableID() *WaitlistTicketUpsert {
+	u.SetExcluded(waitlistticket.FieldTableID)
+	return u
+}
+
+// SetTableName sets the "table_name" field.
+func (u *WaitlistTicketUpsert) SetTableName(v string) *WaitlistTicketUpsert {
+	u.Set(waitlistticket.FieldTableName, v)
+	return u
+}
+
+// UpdateTableName sets the "table_name" field to the value that was provided on create.
+func (u *WaitlistTicketUpsert) UpdateTableName() *WaitlistTicketUpsert {
+	u.SetExcluded(waitlistticket.FieldTableName)
+	return u
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *WaitlistTicketUpsert) SetOrderID(v uuid.UUID) *WaitlistTicketUpsert {
+	u.Set(waitlistticket.FieldOrderID, v)
+	return u
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *WaitlistTicketUpsert) UpdateOrderID() *WaitlistTicketUpsert {
+	u.SetExcluded(waitlistticket.FieldOrderID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WaitlistTicket.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(waitlistticket.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WaitlistTicketUpsertOne) UpdateNewValues() *WaitlistTicketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(waitlistticket.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(waitlistticket.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.MerchantID(); exists {
+			s.SetIgnore(waitlistticket.FieldMerchantID)
+		}
+		if _, exists := u.create.mutation.StoreID(); exists {
+			s.SetIgnore(waitlistticket.FieldStoreID)
+		}
+		if _, exists := u.create.mutation.BusinessDate(); exists {
+			s.SetIgnore(waitlistticket.FieldBusinessDate)
+		}
+		if _, exists := u.create.mutation.Bucket(); exists {
+			s.SetIgnore(waitlistticket.FieldBucket)
+		}
+		if _, exists := u.create.mutation.TicketNo(); exists {
+			s.SetIgnore(waitlistticket.FieldTicketNo)
+		}
+		if _, exists := u.create.mutation.PartySize(); exists {
+			s.SetIgnore(waitlistticket.FieldPartySize)
+		}
+		if _, exists := u.create.mutation.GuestPhone(); exists {
+			s.SetIgnore(waitlistticket.FieldGuestPhone)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(waitlistticket.FieldSource)
+		}
+		if _, exists := u.create.mutation.CustomerID(); exists {
+			s.SetIgnore(waitlistticket.FieldCustomerID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WaitlistTicket.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WaitlistTicketUpsertOne) Ignore() *WaitlistTicketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WaitlistTicketUpsertOne) DoNothing() *WaitlistTicketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WaitlistTicketCreate.OnConflict
+// documentation for more info.
+func (u *WaitlistTicketUpsertOne) Update(set func(*WaitlistTicketUpsert)) *WaitlistTicketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WaitlistTicketUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WaitlistTicketUpsertOne) SetUpdatedAt(v time.Time) *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertOne) UpdateUpdatedAt() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WaitlistTicketUpsertOne) SetStatus(v domain.WaitlistTicketStatus) *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertOne) UpdateStatus() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCallCount sets the "call_count" field.
+func (u *WaitlistTicketUpsertOne) SetCallCount(v int) *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetCallCount(v)
+	})
+}
+
+// AddCallCount adds v to the "call_count" field.
+func (u *WaitlistTicketUpsertOne) AddCallCount(v int) *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.AddCallCount(v)
+	})
+}
+
+// UpdateCallCount sets the "call_count" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertOne) UpdateCallCount() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateCallCount()
+	})
+}
+
+// SetCalledAt sets the "called_at" field.
+func (u *WaitlistTicketUpsertOne) SetCalledAt(v time.Time) *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetCalledAt(v)
+	})
+}
+
+// UpdateCalledAt sets the "called_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertOne) UpdateCalledAt() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateCalledAt()
+	})
+}
+
+// ClearCalledAt clears the value of the "called_at" field.
+func (u *WaitlistTicketUpsertOne) ClearCalledAt() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.ClearCalledAt()
+	})
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (u *WaitlistTicketUpsertOne) SetSeatedAt(v time.Time) *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetSeatedAt(v)
+	})
+}
+
+// UpdateSeatedAt sets the "seated_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertOne) UpdateSeatedAt() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateSeatedAt()
+	})
+}
+
+// ClearSeatedAt clears the value of the "seated_at" field.
+func (u *WaitlistTicketUpsertOne) ClearSeatedAt() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.ClearSeatedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *WaitlistTicketUpsertOne) SetCancelledAt(v time.Time) *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertOne) UpdateCancelledAt() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *WaitlistTicketUpsertOne) ClearCancelledAt() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetTableID sets the "table_id" field.
+func (u *WaitlistTicketUpsertOne) SetTableID(v uuid.UUID) *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetTableID(v)
+	})
+}
+
+// UpdateTableID sets the "table_id" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertOne) UpdateTableID() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateTableID()
+	})
+}
+
+// SetTableName sets the "table_name" field.
+func (u *WaitlistTicketUpsertOne) SetTableName(v string) *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetTableName(v)
+	})
+}
+
+// UpdateTableName sets the "table_name" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertOne) UpdateTableName() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateTableName()
+	})
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *WaitlistTicketUpsertOne) SetOrderID(v uuid.UUID) *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetOrderID(v)
+	})
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertOne) UpdateOrderID() *WaitlistTicketUpsertOne {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateOrderID()
+	})
+}
+
+// Exec executes the query.
+func (u *WaitlistTicketUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WaitlistTicketCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WaitlistTicketUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WaitlistTicketUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WaitlistTicketUpsertOne.ID is not supported by MySQL driver. Use WaitlistTicketUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WaitlistTicketUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WaitlistTicketCreateBulk is the builder for creating many WaitlistTicket entities in bulk.
+type WaitlistTicketCreateBulk struct {
+	config
+	err      error
+	builders []*WaitlistTicketCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WaitlistTicket entities in the database.
+func (wtcb *WaitlistTicketCreateBulk) Save(ctx context.Context) ([]*WaitlistTicket, error) {
+	if wtcb.err != nil {
+		return nil, wtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wtcb.builders))
+	nodes := make([]*WaitlistTicket, len(wtcb.builders))
+	mutators := make([]Mutator, len(wtcb.builders))
+	for i := range wtcb.builders {
+		func(i int, root context.Context) {
+			builder := wtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WaitlistTicketMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtcb *WaitlistTicketCreateBulk) SaveX(ctx context.Context) []*WaitlistTicket {
+	v, err := wtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wtcb *WaitlistTicketCreateBulk) Exec(ctx context.Context) error {
+	_, err := wtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtcb *WaitlistTicketCreateBulk) ExecX(ctx context.Context) {
+	if err := wtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WaitlistTicket.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WaitlistTicketUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wtcb *WaitlistTicketCreateBulk) OnConflict(opts ...sql.ConflictOption) *WaitlistTicketUpsertBulk {
+	wtcb.conflict = opts
+	return &WaitlistTicketUpsertBulk{
+		create: wtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WaitlistTicket.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wtcb *WaitlistTicketCreateBulk) OnConflictColumns(columns ...string) *WaitlistTicketUpsertBulk {
+	wtcb.conflict = append(wtcb.conflict, sql.ConflictColumns(columns...))
+	return &WaitlistTicketUpsertBulk{
+		create: wtcb,
+	}
+}
+
+// WaitlistTicketUpsertBulk is the builder for "upsert"-ing
+// a bulk of WaitlistTicket nodes.
+type WaitlistTicketUpsertBulk struct {
+	create *WaitlistTicketCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WaitlistTicket.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(waitlistticket.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WaitlistTicketUpsertBulk) UpdateNewValues() *WaitlistTicketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(waitlistticket.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(waitlistticket.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.MerchantID(); exists {
+				s.SetIgnore(waitlistticket.FieldMerchantID)
+			}
+			if _, exists := b.mutation.StoreID(); exists {
+				s.SetIgnore(waitlistticket.FieldStoreID)
+			}
+			if _, exists := b.mutation.BusinessDate(); exists {
+				s.SetIgnore(waitlistticket.FieldBusinessDate)
+			}
+			if _, exists := b.mutation.Bucket(); exists {
+				s.SetIgnore(waitlistticket.FieldBucket)
+			}
+			if _, exists := b.mutation.TicketNo(); exists {
+				s.SetIgnore(waitlistticket.FieldTicketNo)
+			}
+			if _, exists := b.mutation.PartySize(); exists {
+				s.SetIgnore(waitlistticket.FieldPartySize)
+			}
+			if _, exists := b.mutation.GuestPhone(); exists {
+				s.SetIgnore(waitlistticket.FieldGuestPhone)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(waitlistticket.FieldSource)
+			}
+			if _, exists := b.mutation.CustomerID(); exists {
+				s.SetIgnore(waitlistticket.FieldCustomerID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WaitlistTicket.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WaitlistTicketUpsertBulk) Ignore() *WaitlistTicketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WaitlistTicketUpsertBulk) DoNothing() *WaitlistTicketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WaitlistTicketCreateBulk.OnConflict
+// documentation for more info.
+func (u *WaitlistTicketUpsertBulk) Update(set func(*WaitlistTicketUpsert)) *WaitlistTicketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WaitlistTicketUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WaitlistTicketUpsertBulk) SetUpdatedAt(v time.Time) *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertBulk) UpdateUpdatedAt() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WaitlistTicketUpsertBulk) SetStatus(v domain.WaitlistTicketStatus) *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertBulk) UpdateStatus() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCallCount sets the "call_count" field.
+func (u *WaitlistTicketUpsertBulk) SetCallCount(v int) *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetCallCount(v)
+	})
+}
+
+// AddCallCount adds v to the "call_count" field.
+func (u *WaitlistTicketUpsertBulk) AddCallCount(v int) *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.AddCallCount(v)
+	})
+}
+
+// UpdateCallCount sets the "call_count" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertBulk) UpdateCallCount() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateCallCount()
+	})
+}
+
+// SetCalledAt sets the "called_at" field.
+func (u *WaitlistTicketUpsertBulk) SetCalledAt(v time.Time) *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetCalledAt(v)
+	})
+}
+
+// UpdateCalledAt sets the "called_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertBulk) UpdateCalledAt() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateCalledAt()
+	})
+}
+
+// ClearCalledAt clears the value of the "called_at" field.
+func (u *WaitlistTicketUpsertBulk) ClearCalledAt() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.ClearCalledAt()
+	})
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (u *WaitlistTicketUpsertBulk) SetSeatedAt(v time.Time) *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetSeatedAt(v)
+	})
+}
+
+// UpdateSeatedAt sets the "seated_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertBulk) UpdateSeatedAt() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateSeatedAt()
+	})
+}
+
+// ClearSeatedAt clears the value of the "seated_at" field.
+func (u *WaitlistTicketUpsertBulk) ClearSeatedAt() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.ClearSeatedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *WaitlistTicketUpsertBulk) SetCancelledAt(v time.Time) *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertBulk) UpdateCancelledAt() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *WaitlistTicketUpsertBulk) ClearCancelledAt() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetTableID sets the "table_id" field.
+func (u *WaitlistTicketUpsertBulk) SetTableID(v uuid.UUID) *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetTableID(v)
+	})
+}
+
+// UpdateTableID sets the "table_id" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertBulk) UpdateTableID() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateTableID()
+	})
+}
+
+// SetTableName sets the "table_name" field.
+func (u *WaitlistTicketUpsertBulk) SetTableName(v string) *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetTableName(v)
+	})
+}
+
+// UpdateTableName sets the "table_name" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertBulk) UpdateTableName() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateTableName()
+	})
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *WaitlistTicketUpsertBulk) SetOrderID(v uuid.UUID) *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.SetOrderID(v)
+	})
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *WaitlistTicketUpsertBulk) UpdateOrderID() *WaitlistTicketUpsertBulk {
+	return u.Update(func(s *WaitlistTicketUpsert) {
+		s.UpdateOrderID()
+	})
+}
+
+// Exec executes the query.
+func (u *WaitlistTicketUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WaitlistTicketCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WaitlistTicketCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WaitlistTicketUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
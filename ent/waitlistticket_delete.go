@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistticket"
+)
+
+// WaitlistTicketDelete is the builder for deleting a WaitlistTicket entity.
+type WaitlistTicketDelete struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistTicketMutation
+}
+
+// Where appends a list predicates to the WaitlistTicketDelete builder.
+func (wtd *WaitlistTicketDelete) Where(ps ...predicate.WaitlistTicket) *WaitlistTicketDelete {
+	wtd.mutation.Where(ps...)
+	return wtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wtd *WaitlistTicketDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wtd.sqlExec, wtd.mutation, wtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtd *WaitlistTicketDelete) ExecX(ctx context.Context) int {
+	n, err := wtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wtd *WaitlistTicketDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(waitlistticket.Table, sqlgraph.NewFieldSpec(waitlistticket.FieldID, field.TypeUUID))
+	if ps := wtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wtd.mutation.done = true
+	return affected, err
+}
+
+// WaitlistTicketDeleteOne is the builder for deleting a single WaitlistTicket entity.
+type WaitlistTicketDeleteOne struct {
+	wtd *WaitlistTicketDelete
+}
+
+// Where appends a list predicates to the WaitlistTicketDelete builder.
+func (wtdo *WaitlistTicketDeleteOne) Where(ps ...predicate.WaitlistTicket) *WaitlistTicketDeleteOne {
+	wtdo.wtd.mutation.Where(ps...)
+	return wtdo
+}
+
+// Exec executes the deletion query.
+func (wtdo *WaitlistTicketDeleteOne) Exec(ctx context.Context) error {
+	n, err := wtdo.wtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{waitlistticket.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtdo *WaitlistTicketDeleteOne) ExecX(ctx context.Context) {
+	if err := wtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistticket"
+)
+
+// WaitlistTicketQuery is the builder for querying WaitlistTicket entities.
+type WaitlistTicketQuery struct {
+	config
+	ctx        *QueryContext
+	order      []waitlistticket.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WaitlistTicket
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WaitlistTicketQuery builder.
+func (wtq *WaitlistTicketQuery) Where(ps ...predicate.WaitlistTicket) *WaitlistTicketQuery {
+	wtq.predicates = append(wtq.predicates, ps...)
+	return wtq
+}
+
+// Limit the number of records to be returned by this query.
+func (wtq *WaitlistTicketQuery) Limit(limit int) *WaitlistTicketQuery {
+	wtq.ctx.Limit = &limit
+	return wtq
+}
+
+// Offset to start from.
+func (wtq *WaitlistTicketQuery) Offset(offset int) *WaitlistTicketQuery {
+	wtq.ctx.Offset = &offset
+	return wtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wtq *WaitlistTicketQuery) Unique(unique bool) *WaitlistTicketQuery {
+	wtq.ctx.Unique = &unique
+	return wtq
+}
+
+// Order specifies how the records should be ordered.
+func (wtq *WaitlistTicketQuery) Order(o ...waitlistticket.OrderOption) *WaitlistTicketQuery {
+	wtq.order = append(wtq.order, o...)
+	return wtq
+}
+
+// First returns the first WaitlistTicket entity from the query.
+// Returns a *NotFoundError when no WaitlistTicket was found.
+func (wtq *WaitlistTicketQuery) First(ctx context.Context) (*WaitlistTicket, error) {
+	nodes, err := wtq.Limit(1).All(setContextOp(ctx, wtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{waitlistticket.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wtq *WaitlistTicketQuery) FirstX(ctx context.Context) *WaitlistTicket {
+	node, err := wtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WaitlistTicket ID from the query.
+// Returns a *NotFoundError when no WaitlistTicket ID was found.
+func (wtq *WaitlistTicketQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wtq.Limit(1).IDs(setContextOp(ctx, wtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{waitlistticket.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wtq *WaitlistTicketQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WaitlistTicket entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WaitlistTicket entity is found.
+// Returns a *NotFoundError when no WaitlistTicket entities are found.
+func (wtq *WaitlistTicketQuery) Only(ctx context.Context) (*WaitlistTicket, error) {
+	nodes, err := wtq.Limit(2).All(setContextOp(ctx, wtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{waitlistticket.Label}
+	default:
+		return nil, &NotSingularError{waitlistticket.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wtq *WaitlistTicketQuery) OnlyX(ctx context.Context) *WaitlistTicket {
+	node, err := wtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WaitlistTicket ID in the query.
+// Returns a *NotSingularError when more than one WaitlistTicket ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wtq *WaitlistTicketQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wtq.Limit(2).IDs(setContextOp(ctx, wtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{waitlistticket.Label}
+	default:
+		err = &NotSingularError{waitlistticket.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wtq *WaitlistTicketQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WaitlistTickets.
+func (wtq *WaitlistTicketQuery) All(ctx context.Context) ([]*WaitlistTicket, error) {
+	ctx = setContextOp(ctx, wtq.ctx, ent.OpQueryAll)
+	if err := wtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WaitlistTicket, *WaitlistTicketQuery]()
+	return withInterceptors[[]*WaitlistTicket](ctx, wtq, qr, wtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wtq *WaitlistTicketQuery) AllX(ctx context.Context) []*WaitlistTicket {
+	nodes, err := wtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WaitlistTicket IDs.
+func (wtq *WaitlistTicketQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if wtq.ctx.Unique == nil && wtq.path != nil {
+		wtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wtq.ctx, ent.OpQueryIDs)
+	if err = wtq.Select(waitlistticket.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wtq *WaitlistTicketQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wtq *WaitlistTicketQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wtq.ctx, ent.OpQueryCount)
+	if err := wtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wtq, querierCount[*WaitlistTicketQuery](), wtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wtq *WaitlistTicketQuery) CountX(ctx context.Context) int {
+	count, err := wtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wtq *WaitlistTicketQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wtq.ctx, ent.OpQueryExist)
+	switch _, err := wtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wtq *WaitlistTicketQuery) ExistX(ctx context.Context) bool {
+	exist, err := wtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WaitlistTicketQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wtq *WaitlistTicketQuery) Clone() *WaitlistTicketQuery {
+	if wtq == nil {
+		return nil
+	}
+	return &WaitlistTicketQuery{
+		config:     wtq.config,
+		ctx:        wtq.ctx.Clone(),
+		order:      append([]waitlistticket.OrderOption{}, wtq.order...),
+		inters:     append([]Interceptor{}, wtq.inters...),
+		predicates: append([]predicate.WaitlistTicket{}, wtq.predicates...),
+		// clone intermediate query.
+		sql:       wtq.sql.Clone(),
+		path:      wtq.path,
+		modifiers: append([]func(*sql.Selector){}, wtq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WaitlistTicket.Query().
+//		GroupBy(waitlistticket.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wtq *WaitlistTicketQuery) GroupBy(field string, fields ...string) *WaitlistTicketGroupBy {
+	wtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WaitlistTicketGroupBy{build: wtq}
+	grbuild.flds = &wtq.ctx.Fields
+	grbuild.label = waitlistticket.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.WaitlistTicket.Query().
+//		Select(waitlistticket.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (wtq *WaitlistTicketQuery) Select(fields ...string) *WaitlistTicketSelect {
+	wtq.ctx.Fields = append(wtq.ctx.Fields, fields...)
+	sbuild := &WaitlistTicketSelect{WaitlistTicketQuery: wtq}
+	sbuild.label = waitlistticket.Label
+	sbuild.flds, sbuild.scan = &wtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WaitlistTicketSelect configured with the given aggregations.
+func (wtq *WaitlistTicketQuery) Aggregate(fns ...AggregateFunc) *WaitlistTicketSelect {
+	return wtq.Select().Aggregate(fns...)
+}
+
+func (wtq *WaitlistTicketQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wtq.ctx.Fields {
+		if !waitlistticket.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wtq.path != nil {
+		prev, err := wtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wtq.sql = prev
+	}
+	return nil
+}
+
+func (wtq *WaitlistTicketQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WaitlistTicket, error) {
+	var (
+		nodes = []*WaitlistTicket{}
+		_spec = wtq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WaitlistTicket).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WaitlistTicket{config: wtq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(wtq.modifiers) > 0 {
+		_spec.Modifiers = wtq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wtq *WaitlistTicketQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wtq.querySpec()
+	if len(wtq.modifiers) > 0 {
+		_spec.Modifiers = wtq.modifiers
+	}
+	_spec.Node.Columns = wtq.ctx.Fields
+	if len(wtq.ctx.Fields) > 0 {
+		_spec.Unique = wtq.ctx.Unique != nil && *wtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wtq.driver, _spec)
+}
+
+func (wtq *WaitlistTicketQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(waitlistticket.Table, waitlistticket.Columns, sqlgraph.NewFieldSpec(waitlistticket.FieldID, field.TypeUUID))
+	_spec.From = wtq.sql
+	if unique := wtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistticket.FieldID)
+		for i := range fields {
+			if fields[i] != waitlistticket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wtq *WaitlistTicketQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wtq.driver.Dialect())
+	t1 := builder.Table(waitlistticket.Table)
+	columns := wtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = waitlistticket.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wtq.sql != nil {
+		selector = wtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wtq.ctx.Unique != nil && *wtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range wtq.modifiers {
+		m(selector)
+	}
+	for _, p := range wtq.predicates {
+		p(selector)
+	}
+	for _, p := range wtq.order {
+		p(selector)
+	}
+	if offset := wtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (wtq *WaitlistTicketQuery) ForUpdate(opts ...sql.LockOption) *WaitlistTicketQuery {
+	if wtq.driver.Dialect() == dialect.Postgres {
+		wtq.Unique(false)
+	}
+	wtq.modifiers = append(wtq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return wtq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (wtq *WaitlistTicketQuery) ForShare(opts ...sql.LockOption) *WaitlistTicketQuery {
+	if wtq.driver.Dialect() == dialect.Postgres {
+		wtq.Unique(false)
+	}
+	wtq.modifiers = append(wtq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return wtq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wtq *WaitlistTicketQuery) Modify(modifiers ...func(s *sql.Selector)) *WaitlistTicketSelect {
+	wtq.modifiers = append(wtq.modifiers, modifiers...)
+	return wtq.Select()
+}
+
+// WaitlistTicketGroupBy is the group-by builder for WaitlistTicket entities.
+type WaitlistTicketGroupBy struct {
+	selector
+	build *WaitlistTicketQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wtgb *WaitlistTicketGroupBy) Aggregate(fns ...AggregateFunc) *WaitlistTicketGroupBy {
+	wtgb.fns = append(wtgb.fns, fns...)
+	return wtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wtgb *WaitlistTicketGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WaitlistTicketQuery, *WaitlistTicketGroupBy](ctx, wtgb.build, wtgb, wtgb.build.inters, v)
+}
+
+func (wtgb *WaitlistTicketGroupBy) sqlScan(ctx context.Context, root *WaitlistTicketQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wtgb.fns))
+	for _, fn := range wtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wtgb.flds)+len(wtgb.fns))
+		for _, f := range *wtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WaitlistTicketSelect is the builder for selecting fields of WaitlistTicket entities.
+type WaitlistTicketSelect struct {
+	*WaitlistTicketQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wts *WaitlistTicketSelect) Aggregate(fns ...AggregateFunc) *WaitlistTicketSelect {
+	wts.fns = append(wts.fns, fns...)
+	return wts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wts *WaitlistTicketSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wts.ctx, ent.OpQuerySelect)
+	if err := wts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WaitlistTicketQuery, *WaitlistTicketSelect](ctx, wts.WaitlistTicketQuery, wts, wts.inters, v)
+}
+
+func (wts *WaitlistTicketSelect) sqlScan(ctx context.Context, root *WaitlistTicketQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wts.fns))
+	for _, fn := range wts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wts *WaitlistTicketSelect) Modify(modifiers ...func(s *sql.Selector)) *WaitlistTicketSelect {
+	wts.modifiers = append(wts.modifiers, modifiers...)
+	return wts
+}
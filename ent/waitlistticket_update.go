@@ -0,0 +1,612 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistticket"
+)
+
+// WaitlistTicketUpdate is the builder for updating WaitlistTicket entities.
+type WaitlistTicketUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WaitlistTicketMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WaitlistTicketUpdate builder.
+func (wtu *WaitlistTicketUpdate) Where(ps ...predicate.WaitlistTicket) *WaitlistTicketUpdate {
+	wtu.mutation.Where(ps...)
+	return wtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wtu *WaitlistTicketUpdate) SetUpdatedAt(t time.Time) *WaitlistTicketUpdate {
+	wtu.mutation.SetUpdatedAt(t)
+	return wtu
+}
+
+// SetStatus sets the "status" field.
+func (wtu *WaitlistTicketUpdate) SetStatus(dts domain.WaitlistTicketStatus) *WaitlistTicketUpdate {
+	wtu.mutation.SetStatus(dts)
+	return wtu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wtu *WaitlistTicketUpdate) SetNillableStatus(dts *domain.WaitlistTicketStatus) *WaitlistTicketUpdate {
+	if dts != nil {
+		wtu.SetStatus(*dts)
+	}
+	return wtu
+}
+
+// SetCallCount sets the "call_count" field.
+func (wtu *WaitlistTicketUpdate) SetCallCount(i int) *WaitlistTicketUpdate {
+	wtu.mutation.ResetCallCount()
+	wtu.mutation.SetCallCount(i)
+	return wtu
+}
+
+// SetNillableCallCount sets the "call_count" field if the given value is not nil.
+func (wtu *WaitlistTicketUpdate) SetNillableCallCount(i *int) *WaitlistTicketUpdate {
+	if i != nil {
+		wtu.SetCallCount(*i)
+	}
+	return wtu
+}
+
+// AddCallCount adds i to the "call_count" field.
+func (wtu *WaitlistTicketUpdate) AddCallCount(i int) *WaitlistTicketUpdate {
+	wtu.mutation.AddCallCount(i)
+	return wtu
+}
+
+// SetCalledAt sets the "called_at" field.
+func (wtu *WaitlistTicketUpdate) SetCalledAt(t time.Time) *WaitlistTicketUpdate {
+	wtu.mutation.SetCalledAt(t)
+	return wtu
+}
+
+// SetNillableCalledAt sets the "called_at" field if the given value is not nil.
+func (wtu *WaitlistTicketUpdate) SetNillableCalledAt(t *time.Time) *WaitlistTicketUpdate {
+	if t != nil {
+		wtu.SetCalledAt(*t)
+	}
+	return wtu
+}
+
+// ClearCalledAt clears the value of the "called_at" field.
+func (wtu *WaitlistTicketUpdate) ClearCalledAt() *WaitlistTicketUpdate {
+	wtu.mutation.ClearCalledAt()
+	return wtu
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (wtu *WaitlistTicketUpdate) SetSeatedAt(t time.Time) *WaitlistTicketUpdate {
+	wtu.mutation.SetSeatedAt(t)
+	return wtu
+}
+
+// SetNillableSeatedAt sets the "seated_at" field if the given value is not nil.
+func (wtu *WaitlistTicketUpdate) SetNillableSeatedAt(t *time.Time) *WaitlistTicketUpdate {
+	if t != nil {
+		wtu.SetSeatedAt(*t)
+	}
+	return wtu
+}
+
+// ClearSeatedAt clears the value of the "seated_at" field.
+func (wtu *WaitlistTicketUpdate) ClearSeatedAt() *WaitlistTicketUpdate {
+	wtu.mutation.ClearSeatedAt()
+	return wtu
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (wtu *WaitlistTicketUpdate) SetCancelledAt(t time.Time) *WaitlistTicketUpdate {
+	wtu.mutation.SetCancelledAt(t)
+	return wtu
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (wtu *WaitlistTicketUpdate) SetNillableCancelledAt(t *time.Time) *WaitlistTicketUpdate {
+	if t != nil {
+		wtu.SetCancelledAt(*t)
+	}
+	return wtu
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (wtu *WaitlistTicketUpdate) ClearCancelledAt() *WaitlistTicketUpdate {
+	wtu.mutation.ClearCancelledAt()
+	return wtu
+}
+
+// SetTableID sets the "table_id" field.
+func (wtu *WaitlistTicketUpdate) SetTableID(u uuid.UUID) *WaitlistTicketUpdate {
+	wtu.mutation.SetTableID(u)
+	return wtu
+}
+
+// SetNillableTableID sets the "table_id" field if the given value is not nil.
+func (wtu *WaitlistTicketUpdate) SetNillableTableID(u *uuid.UUID) *WaitlistTicketUpdate {
+	if u != nil {
+		wtu.SetTableID(*u)
+	}
+	return wtu
+}
+
+// SetTableName sets the "table_name" field.
+func (wtu *WaitlistTicketUpdate) SetTableName(s string) *WaitlistTicketUpdate {
+	wtu.mutation.SetTableName(s)
+	return wtu
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (wtu *WaitlistTicketUpdate) SetNillableTableName(s *string) *WaitlistTicketUpdate {
+	if s != nil {
+		wtu.SetTableName(*s)
+	}
+	return wtu
+}
+
+// SetOrderID sets the "order_id" field.
+func (wtu *WaitlistTicketUpdate) SetOrderID(u uuid.UUID) *WaitlistTicketUpdate {
+	wtu.mutation.SetOrderID(u)
+	return wtu
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (wtu *WaitlistTicketUpdate) SetNillableOrderID(u *uuid.UUID) *WaitlistTicketUpdate {
+	if u != nil {
+		wtu.SetOrderID(*u)
+	}
+	return wtu
+}
+
+// Mutation returns the WaitlistTicketMutation object of the builder.
+func (wtu *WaitlistTicketUpdate) Mutation() *WaitlistTicketMutation {
+	return wtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wtu *WaitlistTicketUpdate) Save(ctx context.Context) (int, error) {
+	wtu.defaults()
+	return withHooks(ctx, wtu.sqlSave, wtu.mutation, wtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtu *WaitlistTicketUpdate) SaveX(ctx context.Context) int {
+	affected, err := wtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wtu *WaitlistTicketUpdate) Exec(ctx context.Context) error {
+	_, err := wtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtu *WaitlistTicketUpdate) ExecX(ctx context.Context) {
+	if err := wtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wtu *WaitlistTicketUpdate) defaults() {
+	if _, ok := wtu.mutation.UpdatedAt(); !ok {
+		v := waitlistticket.UpdateDefaultUpdatedAt()
+		wtu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wtu *WaitlistTicketUpdate) check() error {
+	if v, ok := wtu.mutation.Status(); ok {
+		if err := waitlistticket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistTicket.status": %w`, err)}
+		}
+	}
+	if v, ok := wtu.mutation.TableName(); ok {
+		if err := waitlistticket.TableNameValidator(v); err != nil {
+			return &ValidationError{Name: "table_name", err: fmt.Errorf(`ent: validator failed for field "WaitlistTicket.table_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wtu *WaitlistTicketUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WaitlistTicketUpdate {
+	wtu.modifiers = append(wtu.modifiers, modifiers...)
+	return wtu
+}
+
+func (wtu *WaitlistTicketUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistticket.Table, waitlistticket.Columns, sqlgraph.NewFieldSpec(waitlistticket.FieldID, field.TypeUUID))
+	if ps := wtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistticket.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wtu.mutation.Status(); ok {
+		_spec.SetField(waitlistticket.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wtu.mutation.CallCount(); ok {
+		_spec.SetField(waitlistticket.FieldCallCount, field.TypeInt, value)
+	}
+	if value, ok := wtu.mutation.AddedCallCount(); ok {
+		_spec.AddField(waitlistticket.FieldCallCount, field.TypeInt, value)
+	}
+	if value, ok := wtu.mutation.CalledAt(); ok {
+		_spec.SetField(waitlistticket.FieldCalledAt, field.TypeTime, value)
+	}
+	if wtu.mutation.CalledAtCleared() {
+		_spec.ClearField(waitlistticket.FieldCalledAt, field.TypeTime)
+	}
+	if value, ok := wtu.mutation.SeatedAt(); ok {
+		_spec.SetField(waitlistticket.FieldSeatedAt, field.TypeTime, value)
+	}
+	if wtu.mutation.SeatedAtCleared() {
+		_spec.ClearField(waitlistticket.FieldSeatedAt, field.TypeTime)
+	}
+	if value, ok := wtu.mutation.CancelledAt(); ok {
+		_spec.SetField(waitlistticket.FieldCancelledAt, field.TypeTime, value)
+	}
+	if wtu.mutation.CancelledAtCleared() {
+		_spec.ClearField(waitlistticket.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := wtu.mutation.TableID(); ok {
+		_spec.SetField(waitlistticket.FieldTableID, field.TypeUUID, value)
+	}
+	if value, ok := wtu.mutation.TableName(); ok {
+		_spec.SetField(waitlistticket.FieldTableName, field.TypeString, value)
+	}
+	if value, ok := wtu.mutation.OrderID(); ok {
+		_spec.SetField(waitlistticket.FieldOrderID, field.TypeUUID, value)
+	}
+	_spec.AddModifiers(wtu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistticket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wtu.mutation.done = true
+	return n, nil
+}
+
+// WaitlistTicketUpdateOne is the builder for updating a single WaitlistTicket entity.
+type WaitlistTicketUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WaitlistTicketMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wtuo *WaitlistTicketUpdateOne) SetUpdatedAt(t time.Time) *WaitlistTicketUpdateOne {
+	wtuo.mutation.SetUpdatedAt(t)
+	return wtuo
+}
+
+// SetStatus sets the "status" field.
+func (wtuo *WaitlistTicketUpdateOne) SetStatus(dts domain.WaitlistTicketStatus) *WaitlistTicketUpdateOne {
+	wtuo.mutation.SetStatus(dts)
+	return wtuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wtuo *WaitlistTicketUpdateOne) SetNillableStatus(dts *domain.WaitlistTicketStatus) *WaitlistTicketUpdateOne {
+	if dts != nil {
+		wtuo.SetStatus(*dts)
+	}
+	return wtuo
+}
+
+// SetCallCount sets the "call_count" field.
+func (wtuo *WaitlistTicketUpdateOne) SetCallCount(i int) *WaitlistTicketUpdateOne {
+	wtuo.mutation.ResetCallCount()
+	wtuo.mutation.SetCallCount(i)
+	return wtuo
+}
+
+// SetNillableCallCount sets the "call_count" field if the given value is not nil.
+func (wtuo *WaitlistTicketUpdateOne) SetNillableCallCount(i *int) *WaitlistTicketUpdateOne {
+	if i != nil {
+		wtuo.SetCallCount(*i)
+	}
+	return wtuo
+}
+
+// AddCallCount adds i to the "call_count" field.
+func (wtuo *WaitlistTicketUpdateOne) AddCallCount(i int) *WaitlistTicketUpdateOne {
+	wtuo.mutation.AddCallCount(i)
+	return wtuo
+}
+
+// SetCalledAt sets the "called_at" field.
+func (wtuo *WaitlistTicketUpdateOne) SetCalledAt(t time.Time) *WaitlistTicketUpdateOne {
+	wtuo.mutation.SetCalledAt(t)
+	return wtuo
+}
+
+// SetNillableCalledAt sets the "called_at" field if the given value is not nil.
+func (wtuo *WaitlistTicketUpdateOne) SetNillableCalledAt(t *time.Time) *WaitlistTicketUpdateOne {
+	if t != nil {
+		wtuo.SetCalledAt(*t)
+	}
+	return wtuo
+}
+
+// ClearCalledAt clears the value of the "called_at" field.
+func (wtuo *WaitlistTicketUpdateOne) ClearCalledAt() *WaitlistTicketUpdateOne {
+	wtuo.mutation.ClearCalledAt()
+	return wtuo
+}
+
+// SetSeatedAt sets the "seated_at" field.
+func (wtuo *WaitlistTicketUpdateOne) SetSeatedAt(t time.Time) *WaitlistTicketUpdateOne {
+	wtuo.mutation.SetSeatedAt(t)
+	return wtuo
+}
+
+// SetNillableSeatedAt sets the "seated_at" field if the given value is not nil.
+func (wtuo *WaitlistTicketUpdateOne) SetNillableSeatedAt(t *time.Time) *WaitlistTicketUpdateOne {
+	if t != nil {
+		wtuo.SetSeatedAt(*t)
+	}
+	return wtuo
+}
+
+// ClearSeatedAt clears the value of the "seated_at" field.
+func (wtuo *WaitlistTicketUpdateOne) ClearSeatedAt() *WaitlistTicketUpdateOne {
+	wtuo.mutation.ClearSeatedAt()
+	return wtuo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (wtuo *WaitlistTicketUpdateOne) SetCancelledAt(t time.Time) *WaitlistTicketUpdateOne {
+	wtuo.mutation.SetCancelledAt(t)
+	return wtuo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (wtuo *WaitlistTicketUpdateOne) SetNillableCancelledAt(t *time.Time) *WaitlistTicketUpdateOne {
+	if t != nil {
+		wtuo.SetCancelledAt(*t)
+	}
+	return wtuo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (wtuo *WaitlistTicketUpdateOne) ClearCancelledAt() *WaitlistTicketUpdateOne {
+	wtuo.mutation.ClearCancelledAt()
+	return wtuo
+}
+
+// SetTableID sets the "table_id" field.
+func (wtuo *WaitlistTicketUpdateOne) SetTableID(u uuid.UUID) *WaitlistTicketUpdateOne {
+	wtuo.mutation.SetTableID(u)
+	return wtuo
+}
+
+// SetNillableTableID sets the "table_id" field if the given value is not nil.
+func (wtuo *WaitlistTicketUpdateOne) SetNillableTableID(u *uuid.UUID) *WaitlistTicketUpdateOne {
+	if u != nil {
+		wtuo.SetTableID(*u)
+	}
+	return wtuo
+}
+
+// SetTableName sets the "table_name" field.
+func (wtuo *WaitlistTicketUpdateOne) SetTableName(s string) *WaitlistTicketUpdateOne {
+	wtuo.mutation.SetTableName(s)
+	return wtuo
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (wtuo *WaitlistTicketUpdateOne) SetNillableTableName(s *string) *WaitlistTicketUpdateOne {
+	if s != nil {
+		wtuo.SetTableName(*s)
+	}
+	return wtuo
+}
+
+// SetOrderID sets the "order_id" field.
+func (wtuo *WaitlistTicketUpdateOne) SetOrderID(u uuid.UUID) *WaitlistTicketUpdateOne {
+	wtuo.mutation.SetOrderID(u)
+	return wtuo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (wtuo *WaitlistTicketUpdateOne) SetNillableOrderID(u *uuid.UUID) *WaitlistTicketUpdateOne {
+	if u != nil {
+		wtuo.SetOrderID(*u)
+	}
+	return wtuo
+}
+
+// Mutation returns the WaitlistTicketMutation object of the builder.
+func (wtuo *WaitlistTicketUpdateOne) Mutation() *WaitlistTicketMutation {
+	return wtuo.mutation
+}
+
+// Where appends a list predicates to the WaitlistTicketUpdate builder.
+func (wtuo *WaitlistTicketUpdateOne) Where(ps ...predicate.WaitlistTicket) *WaitlistTicketUpdateOne {
+	wtuo.mutation.Where(ps...)
+	return wtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wtuo *WaitlistTicketUpdateOne) Select(field string, fields ...string) *WaitlistTicketUpdateOne {
+	wtuo.fields = append([]string{field}, fields...)
+	return wtuo
+}
+
+// Save executes the query and returns the updated WaitlistTicket entity.
+func (wtuo *WaitlistTicketUpdateOne) Save(ctx context.Context) (*WaitlistTicket, error) {
+	wtuo.defaults()
+	return withHooks(ctx, wtuo.sqlSave, wtuo.mutation, wtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtuo *WaitlistTicketUpdateOne) SaveX(ctx context.Context) *WaitlistTicket {
+	node, err := wtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wtuo *WaitlistTicketUpdateOne) Exec(ctx context.Context) error {
+	_, err := wtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtuo *WaitlistTicketUpdateOne) ExecX(ctx context.Context) {
+	if err := wtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wtuo *WaitlistTicketUpdateOne) defaults() {
+	if _, ok := wtuo.mutation.UpdatedAt(); !ok {
+		v := waitlistticket.UpdateDefaultUpdatedAt()
+		wtuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wtuo *WaitlistTicketUpdateOne) check() error {
+	if v, ok := wtuo.mutation.Status(); ok {
+		if err := waitlistticket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistTicket.status": %w`, err)}
+		}
+	}
+	if v, ok := wtuo.mutation.TableName(); ok {
+		if err := waitlistticket.TableNameValidator(v); err != nil {
+			return &ValidationError{Name: "table_name", err: fmt.Errorf(`ent: validator failed for field "WaitlistTicket.table_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wtuo *WaitlistTicketUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WaitlistTicketUpdateOne {
+	wtuo.modifiers = append(wtuo.modifiers, modifiers...)
+	return wtuo
+}
+
+func (wtuo *WaitlistTicketUpdateOne) sqlSave(ctx context.Context) (_node *WaitlistTicket, err error) {
+	if err := wtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistticket.Table, waitlistticket.Columns, sqlgraph.NewFieldSpec(waitlistticket.FieldID, field.TypeUUID))
+	id, ok := wtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WaitlistTicket.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistticket.FieldID)
+		for _, f := range fields {
+			if !waitlistticket.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != waitlistticket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistticket.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wtuo.mutation.Status(); ok {
+		_spec.SetField(waitlistticket.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wtuo.mutation.CallCount(); ok {
+		_spec.SetField(waitlistticket.FieldCallCount, field.TypeInt, value)
+	}
+	if value, ok := wtuo.mutation.AddedCallCount(); ok {
+		_spec.AddField(waitlistticket.FieldCallCount, field.TypeInt, value)
+	}
+	if value, ok := wtuo.mutation.CalledAt(); ok {
+		_spec.SetField(waitlistticket.FieldCalledAt, field.TypeTime, value)
+	}
+	if wtuo.mutation.CalledAtCleared() {
+		_spec.ClearField(waitlistticket.FieldCalledAt, field.TypeTime)
+	}
+	if value, ok := wtuo.mutation.SeatedAt(); ok {
+		_spec.SetField(waitlistticket.FieldSeatedAt, field.TypeTime, value)
+	}
+	if wtuo.mutation.SeatedAtCleared() {
+		_spec.ClearField(waitlistticket.FieldSeatedAt, field.TypeTime)
+	}
+	if value, ok := wtuo.mutation.CancelledAt(); ok {
+		_spec.SetField(waitlistticket.FieldCancelledAt, field.TypeTime, value)
+	}
+	if wtuo.mutation.CancelledAtCleared() {
+		_spec.ClearField(waitlistticket.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := wtuo.mutation.TableID(); ok {
+		_spec.SetField(waitlistticket.FieldTableID, field.TypeUUID, value)
+	}
+	if value, ok := wtuo.mutation.TableName(); ok {
+		_spec.SetField(waitlistticket.FieldTableName, field.TypeString, value)
+	}
+	if value, ok := wtuo.mutation.OrderID(); ok {
+		_spec.SetField(waitlistticket.FieldOrderID, field.TypeUUID, value)
+	}
+	_spec.AddModifiers(wtuo.modifiers...)
+	_node = &WaitlistTicket{config: wtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistticket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wtuo.mutation.done = true
+	return _node, nil
+}
@@ -72,6 +72,7 @@ const (
 	DeviceNotExists  ErrCode = "DEVICE_NOT_EXISTS"  // 设备不存在
 	DeviceNameExists ErrCode = "DEVICE_NAME_EXISTS" // 设备名称已存在
 	DeviceCodeExists ErrCode = "DEVICE_CODE_EXISTS" // 设备编号已存在
+	DeviceNotKiosk   ErrCode = "DEVICE_NOT_KIOSK"   // 设备不是自助点餐机
 
 	// 税费与附加费
 	AdditionalFeeNotExists   ErrCode = "ADDITIONAL_FEE_NOT_EXISTS"    // 附加费不存在
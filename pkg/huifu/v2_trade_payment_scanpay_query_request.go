@@ -0,0 +1,36 @@
+/**
+ * 扫码交易查询
+ *
+ * @Author sdk-generator
+ * @Description 汇付天下
+ */
+package huifu
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type V2TradePaymentScanpayQueryRequest struct {
+	HuifuId     string `json:"huifu_id" structs:"huifu_id"`             // 商户号
+	OrgReqDate  string `json:"org_req_date" structs:"org_req_date"`     // 原机构请求日期
+	OrgReqSeqId string `json:"org_req_seq_id" structs:"org_req_seq_id"` // 原机构请求流水号
+
+	ExtendInfos map[string]interface{} `json:"extend_infos" structs:"extend_infos"` // 扩展字段
+}
+
+func (bp *BsPay) StrV2TradePaymentScanpayQueryRequest(ctx context.Context, reqStr string) (map[string]interface{}, error) {
+	reqParam := V2TradePaymentScanpayQueryRequest{}
+	json.Unmarshal([]byte(reqStr), &reqParam)
+	json.Unmarshal([]byte(reqStr), &reqParam.ExtendInfos)
+	return bp.V2TradePaymentScanpayQueryRequest(ctx, reqParam)
+}
+
+func (bp *BsPay) V2TradePaymentScanpayQueryRequest(ctx context.Context, reqParam V2TradePaymentScanpayQueryRequest) (map[string]interface{}, error) {
+	var url = BASE_API_TEST_URL_V2
+	if bp.IsProdMode {
+		url = BASE_API_URL_V2
+	}
+	reqUrl := url + V2_TRADE_PAYMENT_SCANPAY_QUERY
+	return PostRequest(ctx, reqUrl, ToMap(reqParam), bp.Msc)
+}
@@ -10,12 +10,17 @@ const (
 	DefaultLocale = "en-US"
 	LocaleEnUS    = "en-US"
 	LocaleZhCN    = "zh-CN"
+	LocaleMsMY    = "ms-MY"
 )
 
+// SupportedLocales 支持的语言
+var SupportedLocales = []string{LocaleEnUS, LocaleZhCN, LocaleMsMY}
+
 type contextKey string
 
 const (
 	localizerKey contextKey = "i18n_localizer"
+	localeKey    contextKey = "i18n_locale"
 )
 
 var (
@@ -47,15 +52,24 @@ func WithLocalizer(ctx context.Context, locale string) context.Context {
 		locale = DefaultLocale
 	}
 
+	ctx = context.WithValue(ctx, localeKey, locale)
+
 	b := getBundle()
 	if b == nil {
 		return ctx
 	}
 
-	localizer := i18n.NewLocalizer(b, locale)
+	// 缺少对应语言的翻译时回退到默认语言
+	localizer := i18n.NewLocalizer(b, locale, DefaultLocale)
 	return context.WithValue(ctx, localizerKey, localizer)
 }
 
+// LocaleFromContext 获取请求的语言环境，未设置时返回空字符串
+func LocaleFromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(localeKey).(string)
+	return locale
+}
+
 // Translate 翻译消息，如果翻译失败则返回 messageID
 // ctx: 上下文，用于获取语言环境
 // messageID: 消息 ID
@@ -0,0 +1,63 @@
+package pinyin
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+var index = sync.OnceValue(func() map[rune]string {
+	m := make(map[rune]string, 21000)
+	for _, t := range table {
+		for _, r := range t.chars {
+			m[r] = t.syllable
+		}
+	}
+	return m
+})
+
+// Syllable 返回单个汉字的拼音（不带声调，ü 以 v 表示），非汉字或未收录时返回空字符串
+func Syllable(r rune) string {
+	return index()[r]
+}
+
+// Spell 生成文本的拼音全拼和首字母缩写，结果均为小写
+//
+// 汉字转换为拼音；英文字母和数字转为小写后保留，英文单词在首字母缩写中只取首个字母；其他字符忽略。
+// 例如 "宫保鸡丁" 生成 "gongbaojiding" 和 "gbjd"，"Iced Coffee 2杯" 生成 "icedcoffee2bei" 和 "ic2b"。
+func Spell(s string) (full, initials string) {
+	var fb, ib strings.Builder
+	inWord := false
+	for _, r := range s {
+		switch {
+		case r < unicode.MaxASCII && unicode.IsLetter(r):
+			r = unicode.ToLower(r)
+			fb.WriteRune(r)
+			if !inWord {
+				ib.WriteRune(r)
+			}
+			inWord = true
+		case r < unicode.MaxASCII && unicode.IsDigit(r):
+			fb.WriteRune(r)
+			ib.WriteRune(r)
+			inWord = false
+		default:
+			inWord = false
+			if py := Syllable(r); py != "" {
+				fb.WriteString(py)
+				ib.WriteByte(py[0])
+			}
+		}
+	}
+	return fb.String(), ib.String()
+}
+
+// NormalizeKeyword 规范化搜索关键字：转为小写并去除空白字符
+func NormalizeKeyword(keyword string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, keyword)
+}
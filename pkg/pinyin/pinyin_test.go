@@ -0,0 +1,43 @@
+package pinyin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpell(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		full     string
+		initials string
+	}{
+		{name: "中文", input: "宫保鸡丁", full: "gongbaojiding", initials: "gbjd"},
+		{name: "常用多音字", input: "鱼香茄子", full: "yuxiangqiezi", initials: "yxqz"},
+		{name: "韵母ü", input: "绿豆沙", full: "lvdousha", initials: "lds"},
+		{name: "中英文混合", input: "Iced Coffee 2杯", full: "icedcoffee2bei", initials: "ic2b"},
+		{name: "数字", input: "可乐330ml", full: "kele330ml", initials: "kl330m"},
+		{name: "忽略符号", input: "【招牌】红烧肉", full: "zhaopaihongshaorou", initials: "zphsr"},
+		{name: "空字符串", input: "", full: "", initials: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			full, initials := Spell(tt.input)
+			assert.Equal(t, tt.full, full)
+			assert.Equal(t, tt.initials, initials)
+		})
+	}
+}
+
+func TestSyllable(t *testing.T) {
+	assert.Equal(t, "guang", Syllable('光'))
+	assert.Equal(t, "lan", Syllable('兰'))
+	assert.Equal(t, "suo", Syllable('缩'))
+	assert.Equal(t, "", Syllable('a'))
+}
+
+func TestNormalizeKeyword(t *testing.T) {
+	assert.Equal(t, "gbjd", NormalizeKeyword(" GB JD "))
+}
@@ -47,6 +47,18 @@ func (s *DailySequence) Next(ctx context.Context, prefix string) (int64, error)
 	return val, nil
 }
 
+// NextInRange 获取 [start, end] 范围内的下一个序列号，超过 end 后从 start 重新开始循环
+func (s *DailySequence) NextInRange(ctx context.Context, prefix string, start, end int64) (int64, error) {
+	if start > end {
+		return 0, fmt.Errorf("invalid sequence range [%d, %d]", start, end)
+	}
+	val, err := s.Next(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+	return start + (val-1)%(end-start+1), nil
+}
+
 // Current 获取当前序列号
 func (s *DailySequence) Current(ctx context.Context, prefix string) (int64, error) {
 	now := s.clock.Now()
@@ -115,6 +115,57 @@ func TestDailySequence_Next(t *testing.T) {
 	}
 }
 
+func TestDailySequence_NextInRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end int64
+		times      int
+		want       []int64
+		wantErr    bool
+	}{
+		{
+			name:  "从起始号开始递增",
+			start: 101,
+			end:   199,
+			times: 3,
+			want:  []int64{101, 102, 103},
+		},
+		{
+			name:  "超过结束号后从起始号循环",
+			start: 1,
+			end:   3,
+			times: 5,
+			want:  []int64{1, 2, 3, 1, 2},
+		},
+		{
+			name:    "范围无效",
+			start:   10,
+			end:     1,
+			times:   1,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := newTestRedis(t)
+			seq := NewDailySequence(tr.rdb)
+
+			var got []int64
+			for range tt.times {
+				val, err := seq.NextInRange(context.Background(), "test", tt.start, tt.end)
+				if tt.wantErr {
+					assert.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				got = append(got, val)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestDailySequence_Current(t *testing.T) {
 	tests := []struct {
 		name    string
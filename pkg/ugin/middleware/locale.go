@@ -60,7 +60,7 @@ func normalizeLocale(locale string) string {
 	locale = strings.TrimSpace(locale)
 	locale = strings.ToLower(locale)
 
-	// 支持简写：en -> en-US, zh -> zh-CN
+	// 支持简写：en -> en-US, zh -> zh-CN, ms -> ms-MY
 	switch locale {
 	case "en":
 		return i18n.LocaleEnUS
@@ -70,6 +70,8 @@ func normalizeLocale(locale string) string {
 		return i18n.LocaleZhCN
 	case "en-us", "en_us":
 		return i18n.LocaleEnUS
+	case "ms", "ms-my", "ms_my":
+		return i18n.LocaleMsMY
 	default:
 		return i18n.DefaultLocale
 	}
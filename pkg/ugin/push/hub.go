@@ -0,0 +1,252 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// streamMaxLen 每个主题保留的最大事件数，用于断线重连补发
+	streamMaxLen = 1000
+	// streamTTL 主题无新事件后的保留时长
+	streamTTL = 24 * time.Hour
+	// subscriptionBuffer 订阅事件缓冲区大小
+	subscriptionBuffer = 64
+)
+
+// publishScript 从全局序列分配事件ID并写入主题 Stream，保证各主题事件ID全局递增，可共用一个重连游标
+var publishScript = redis.NewScript(`
+	local id = redis.call('INCR', KEYS[1]) .. '-0'
+	redis.call('XADD', KEYS[2], 'MAXLEN', '~', ARGV[1], id, 'type', ARGV[2], 'data', ARGV[3])
+	redis.call('EXPIRE', KEYS[2], ARGV[4])
+	return id
+`)
+
+const seqKey = "push:seq"
+
+// Event 推送事件
+type Event struct {
+	ID    string          `json:"id"`    // 事件ID（全局递增），客户端重连时作为游标
+	Topic string          `json:"topic"` // 推送主题
+	Type  string          `json:"type"`  // 事件类型
+	Data  json.RawMessage `json:"data"`  // 事件内容
+}
+
+// Hub 基于 Redis 的推送中心：Stream 保存近期事件用于重连补发，Pub/Sub 实时分发到所有实例
+type Hub struct {
+	rdb redis.UniversalClient
+}
+
+// NewHub 创建推送中心
+func NewHub(rdb redis.UniversalClient) *Hub {
+	return &Hub{rdb: rdb}
+}
+
+// Publish 向主题发布事件
+func (h *Hub) Publish(ctx context.Context, topic, typ string, data any) (*Event, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("marshal event data: %w", err)
+	}
+
+	id, err := publishScript.Run(ctx, h.rdb,
+		[]string{seqKey, streamKey(topic)},
+		streamMaxLen, typ, string(payload), int64(streamTTL/time.Second),
+	).Text()
+	if err != nil {
+		return nil, fmt.Errorf("add event to stream: %w", err)
+	}
+
+	event := &Event{ID: id, Topic: topic, Type: typ, Data: payload}
+	msg, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("marshal event: %w", err)
+	}
+	if err := h.rdb.Publish(ctx, channelKey(topic), msg).Err(); err != nil {
+		return nil, fmt.Errorf("publish event: %w", err)
+	}
+	return event, nil
+}
+
+// Subscribe 订阅多个主题，cursor 不为空时先补发各主题中晚于 cursor 的事件
+func (h *Hub) Subscribe(ctx context.Context, topics []string, cursor string) (*Subscription, error) {
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("no topics to subscribe")
+	}
+	if cursor != "" {
+		if _, _, ok := parseID(cursor); !ok {
+			return nil, fmt.Errorf("invalid cursor: %s", cursor)
+		}
+	}
+
+	channels := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		channels = append(channels, channelKey(topic))
+	}
+	ps := h.rdb.Subscribe(ctx, channels...)
+	// 等待订阅确认后再读取历史事件，避免遗漏两者之间发布的事件
+	for range channels {
+		if _, err := ps.Receive(ctx); err != nil {
+			ps.Close()
+			return nil, fmt.Errorf("subscribe: %w", err)
+		}
+	}
+
+	var backlog []*Event
+	if cursor != "" {
+		for _, topic := range topics {
+			events, err := h.history(ctx, topic, cursor)
+			if err != nil {
+				ps.Close()
+				return nil, err
+			}
+			backlog = append(backlog, events...)
+		}
+		sort.SliceStable(backlog, func(i, j int) bool {
+			return compareID(backlog[i].ID, backlog[j].ID) < 0
+		})
+	}
+
+	sub := &Subscription{
+		ps:     ps,
+		events: make(chan *Event, subscriptionBuffer),
+		done:   make(chan struct{}),
+	}
+	go sub.run(backlog)
+	return sub, nil
+}
+
+// history 查询主题中晚于 cursor 的事件
+func (h *Hub) history(ctx context.Context, topic, cursor string) ([]*Event, error) {
+	msgs, err := h.rdb.XRange(ctx, streamKey(topic), "("+cursor, "+").Result()
+	if err != nil {
+		return nil, fmt.Errorf("read stream: %w", err)
+	}
+	events := make([]*Event, 0, len(msgs))
+	for _, msg := range msgs {
+		typ, _ := msg.Values["type"].(string)
+		data, _ := msg.Values["data"].(string)
+		events = append(events, &Event{ID: msg.ID, Topic: topic, Type: typ, Data: json.RawMessage(data)})
+	}
+	return events, nil
+}
+
+// Subscription 订阅，调用方需在结束时 Close
+type Subscription struct {
+	ps        *redis.PubSub
+	events    chan *Event
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// Events 事件通道，订阅关闭后通道关闭
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Close 关闭订阅
+func (s *Subscription) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		err = s.ps.Close()
+	})
+	return err
+}
+
+func (s *Subscription) run(backlog []*Event) {
+	defer close(s.events)
+
+	// 记录各主题已发送的最后事件，丢弃补发与实时消息重叠的部分
+	last := make(map[string]string)
+	for _, event := range backlog {
+		if !s.send(event) {
+			return
+		}
+		last[event.Topic] = event.ID
+	}
+
+	ch := s.ps.Channel()
+	for {
+		select {
+		case <-s.done:
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var event Event
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				continue
+			}
+			if id, ok := last[event.Topic]; ok && compareID(event.ID, id) <= 0 {
+				continue
+			}
+			last[event.Topic] = event.ID
+			if !s.send(&event) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Subscription) send(event *Event) bool {
+	select {
+	case s.events <- event:
+		return true
+	case <-s.done:
+		return false
+	}
+}
+
+func streamKey(topic string) string {
+	return "push:stream:" + topic
+}
+
+func channelKey(topic string) string {
+	return "push:channel:" + topic
+}
+
+// parseID 解析事件ID（Redis Stream ID 格式：序号-0）
+func parseID(id string) (ms, seq uint64, ok bool) {
+	msPart, seqPart, found := strings.Cut(id, "-")
+	if !found {
+		return 0, 0, false
+	}
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err = strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return ms, seq, true
+}
+
+func compareID(a, b string) int {
+	ams, aseq, _ := parseID(a)
+	bms, bseq, _ := parseID(b)
+	switch {
+	case ams != bms:
+		if ams < bms {
+			return -1
+		}
+		return 1
+	case aseq != bseq:
+		if aseq < bseq {
+			return -1
+		}
+		return 1
+	default:
+		return 0
+	}
+}
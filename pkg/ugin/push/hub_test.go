@@ -0,0 +1,87 @@
+package push
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHub(t *testing.T) *Hub {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return NewHub(rdb)
+}
+
+func receive(t *testing.T, sub *Subscription) *Event {
+	t.Helper()
+	select {
+	case event := <-sub.Events():
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for event")
+		return nil
+	}
+}
+
+func TestHub_PublishSubscribe(t *testing.T) {
+	ctx := context.Background()
+	hub := newTestHub(t)
+
+	sub, err := hub.Subscribe(ctx, []string{"store:1", "table:1"}, "")
+	require.NoError(t, err)
+	defer sub.Close()
+
+	published, err := hub.Publish(ctx, "table:1", "cart.updated", map[string]int{"qty": 2})
+	require.NoError(t, err)
+	_, err = hub.Publish(ctx, "store:2", "order.created", nil)
+	require.NoError(t, err)
+	_, err = hub.Publish(ctx, "store:1", "order.created", map[string]string{"order_no": "A001"})
+	require.NoError(t, err)
+
+	event := receive(t, sub)
+	require.Equal(t, published.ID, event.ID)
+	require.Equal(t, "table:1", event.Topic)
+	require.Equal(t, "cart.updated", event.Type)
+	require.JSONEq(t, `{"qty":2}`, string(event.Data))
+
+	event = receive(t, sub)
+	require.Equal(t, "store:1", event.Topic)
+	require.JSONEq(t, `{"order_no":"A001"}`, string(event.Data))
+}
+
+func TestHub_ResumeFromCursor(t *testing.T) {
+	ctx := context.Background()
+	hub := newTestHub(t)
+
+	first, err := hub.Publish(ctx, "store:1", "order.created", 1)
+	require.NoError(t, err)
+	_, err = hub.Publish(ctx, "store:1", "order.updated", 2)
+	require.NoError(t, err)
+	_, err = hub.Publish(ctx, "device:1", "config.updated", 3)
+	require.NoError(t, err)
+
+	// 断线重连后补发游标之后的事件，再接收实时事件
+	sub, err := hub.Subscribe(ctx, []string{"store:1", "device:1"}, first.ID)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	require.Equal(t, "order.updated", receive(t, sub).Type)
+	require.Equal(t, "config.updated", receive(t, sub).Type)
+
+	_, err = hub.Publish(ctx, "store:1", "order.updated", 4)
+	require.NoError(t, err)
+	event := receive(t, sub)
+	require.JSONEq(t, `4`, string(event.Data))
+}
+
+func TestHub_InvalidCursor(t *testing.T) {
+	hub := newTestHub(t)
+
+	_, err := hub.Subscribe(context.Background(), []string{"store:1"}, "bad")
+	require.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package push
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// heartbeatInterval 心跳间隔，防止代理断开空闲连接
+const heartbeatInterval = 25 * time.Second
+
+// LastEventID 获取客户端重连游标：EventSource 自动携带 Last-Event-ID 请求头，也支持 last_event_id 查询参数
+func LastEventID(c *gin.Context) string {
+	if id := c.GetHeader("Last-Event-ID"); id != "" {
+		return id
+	}
+	return c.Query("last_event_id")
+}
+
+// ServeSSE 以 Server-Sent Events 方式输出订阅事件，直到客户端断开或订阅关闭
+func ServeSSE(c *gin.Context, sub *Subscription) {
+	defer sub.Close()
+
+	w := c.Writer
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			w.Flush()
+		case event, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, event.Data); err != nil {
+				return
+			}
+			w.Flush()
+		}
+	}
+}
@@ -53,6 +53,7 @@ func (repo *CategoryRepository) Create(ctx context.Context, cat *domain.Category
 	builder := repo.Client.Category.Create().
 		SetID(cat.ID).
 		SetName(cat.Name).
+		SetNameI18n(cat.NameI18n).
 		SetMerchantID(cat.MerchantID).
 		SetStoreID(cat.StoreID).
 		SetParentID(cat.ParentID).
@@ -92,6 +93,7 @@ func (repo *CategoryRepository) CreateBulk(ctx context.Context, categories []*do
 		builder := repo.Client.Category.Create().
 			SetID(cat.ID).
 			SetName(cat.Name).
+			SetNameI18n(cat.NameI18n).
 			SetMerchantID(cat.MerchantID).
 			SetStoreID(cat.StoreID).
 			SetParentID(cat.ParentID).
@@ -120,6 +122,7 @@ func (repo *CategoryRepository) Update(ctx context.Context, cat *domain.Category
 
 	builder := repo.Client.Category.UpdateOneID(cat.ID).
 		SetName(cat.Name).
+		SetNameI18n(cat.NameI18n).
 		SetInheritTaxRate(cat.InheritTaxRate).
 		SetInheritStall(cat.InheritStall).
 		SetSortOrder(cat.SortOrder).
@@ -354,6 +357,7 @@ func convertCategoryToDomain(ec *ent.Category) *domain.Category {
 	cat := &domain.Category{
 		ID:             ec.ID,
 		Name:           ec.Name,
+		NameI18n:       ec.NameI18n,
 		MerchantID:     ec.MerchantID,
 		StoreID:        ec.StoreID,
 		ParentID:       ec.ParentID,
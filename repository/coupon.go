@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupon"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.CouponRepository = (*CouponRepository)(nil)
+
+type CouponRepository struct {
+	Client *ent.Client
+}
+
+func NewCouponRepository(client *ent.Client) *CouponRepository {
+	return &CouponRepository{
+		Client: client,
+	}
+}
+
+// CreateBulk 批量创建优惠券
+func (repo *CouponRepository) CreateBulk(ctx context.Context, coupons domain.Coupons) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponRepository.CreateBulk")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if len(coupons) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.CouponCreate, 0, len(coupons))
+	for _, c := range coupons {
+		builders = append(builders, repo.Client.Coupon.Create().
+			SetID(c.ID).
+			SetMerchantID(c.MerchantID).
+			SetTemplateID(c.TemplateID).
+			SetSource(c.Source).
+			SetProvider(c.Provider).
+			SetCode(c.Code).
+			SetBatchNo(c.BatchNo).
+			SetName(c.Name).
+			SetType(c.Type).
+			SetStatus(c.Status).
+			SetValidFrom(c.ValidFrom).
+			SetValidTo(c.ValidTo).
+			SetNillableUsedAt(c.UsedAt).
+			SetUsedStoreID(c.UsedStoreID).
+			SetOrderID(c.OrderID).
+			SetOrderNo(c.OrderNo).
+			SetDiscountAmount(c.DiscountAmount))
+	}
+
+	created, err := repo.Client.Coupon.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create coupons: %w", err)
+	}
+	for i, ec := range created {
+		coupons[i].CreatedAt = ec.CreatedAt
+		coupons[i].UpdatedAt = ec.UpdatedAt
+	}
+	return nil
+}
+
+// Update 更新优惠券状态及核销信息
+func (repo *CouponRepository) Update(ctx context.Context, c *domain.Coupon) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if c == nil {
+		return fmt.Errorf("coupon is nil")
+	}
+
+	builder := repo.Client.Coupon.UpdateOneID(c.ID).
+		SetStatus(c.Status).
+		SetUsedStoreID(c.UsedStoreID).
+		SetOrderID(c.OrderID).
+		SetOrderNo(c.OrderNo).
+		SetDiscountAmount(c.DiscountAmount)
+	if c.UsedAt != nil {
+		builder = builder.SetUsedAt(*c.UsedAt)
+	} else {
+		builder = builder.ClearUsedAt()
+	}
+
+	ec, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrCouponNotExists)
+		}
+		return fmt.Errorf("failed to update coupon: %w", err)
+	}
+
+	c.UpdatedAt = ec.UpdatedAt
+	return nil
+}
+
+// FindByID 根据ID查询优惠券
+func (repo *CouponRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.Coupon, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ec, err := repo.Client.Coupon.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrCouponNotExists)
+		}
+		return nil, err
+	}
+	return convertCouponToDomain(ec), nil
+}
+
+// FindByCode 根据券码查询优惠券
+func (repo *CouponRepository) FindByCode(ctx context.Context, merchantID uuid.UUID, provider, code string) (res *domain.Coupon, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponRepository.FindByCode")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ec, err := repo.Client.Coupon.Query().
+		Where(
+			coupon.MerchantID(merchantID),
+			coupon.Provider(provider),
+			coupon.Code(code),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrCouponNotExists)
+		}
+		return nil, err
+	}
+	return convertCouponToDomain(ec), nil
+}
+
+// ListByOrderID 查询订单使用的优惠券
+func (repo *CouponRepository) ListByOrderID(ctx context.Context, orderID uuid.UUID) (res domain.Coupons, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponRepository.ListByOrderID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	coupons, err := repo.Client.Coupon.Query().
+		Where(coupon.OrderID(orderID)).
+		Order(ent.Asc(coupon.FieldUsedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res = make(domain.Coupons, 0, len(coupons))
+	for _, ec := range coupons {
+		res = append(res, convertCouponToDomain(ec))
+	}
+	return res, nil
+}
+
+// PagedListBySearch 分页查询优惠券
+func (repo *CouponRepository) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.CouponSearchParams,
+) (res *domain.CouponSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponRepository.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.Coupon.Query().Where(coupon.MerchantID(params.MerchantID))
+	if params.TemplateID != uuid.Nil {
+		query.Where(coupon.TemplateID(params.TemplateID))
+	}
+	if params.Code != "" {
+		query.Where(coupon.Code(params.Code))
+	}
+	if params.BatchNo != "" {
+		query.Where(coupon.BatchNo(params.BatchNo))
+	}
+	if params.Status != "" {
+		query.Where(coupon.StatusEQ(params.Status))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coupons, err := query.
+		Offset(page.Offset()).
+		Limit(page.Size).
+		Order(ent.Desc(coupon.FieldCreatedAt), ent.Asc(coupon.FieldCode)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(domain.Coupons, 0, len(coupons))
+	for _, ec := range coupons {
+		items = append(items, convertCouponToDomain(ec))
+	}
+
+	page.SetTotal(total)
+
+	return &domain.CouponSearchRes{
+		Pagination: page,
+		Items:      items,
+	}, nil
+}
+
+func convertCouponToDomain(ec *ent.Coupon) *domain.Coupon {
+	if ec == nil {
+		return nil
+	}
+	return &domain.Coupon{
+		ID:             ec.ID,
+		MerchantID:     ec.MerchantID,
+		TemplateID:     ec.TemplateID,
+		Source:         ec.Source,
+		Provider:       ec.Provider,
+		Code:           ec.Code,
+		BatchNo:        ec.BatchNo,
+		Name:           ec.Name,
+		Type:           ec.Type,
+		Status:         ec.Status,
+		ValidFrom:      ec.ValidFrom,
+		ValidTo:        ec.ValidTo,
+		UsedAt:         ec.UsedAt,
+		UsedStoreID:    ec.UsedStoreID,
+		OrderID:        ec.OrderID,
+		OrderNo:        ec.OrderNo,
+		DiscountAmount: ec.DiscountAmount,
+		CreatedAt:      ec.CreatedAt,
+		UpdatedAt:      ec.UpdatedAt,
+	}
+}
@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/coupontemplate"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.CouponTemplateRepository = (*CouponTemplateRepository)(nil)
+
+type CouponTemplateRepository struct {
+	Client *ent.Client
+}
+
+func NewCouponTemplateRepository(client *ent.Client) *CouponTemplateRepository {
+	return &CouponTemplateRepository{
+		Client: client,
+	}
+}
+
+// Create 创建优惠券模板
+func (repo *CouponTemplateRepository) Create(ctx context.Context, t *domain.CouponTemplate) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponTemplateRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if t == nil {
+		return fmt.Errorf("coupon template is nil")
+	}
+
+	et, err := repo.Client.CouponTemplate.Create().
+		SetID(t.ID).
+		SetMerchantID(t.MerchantID).
+		SetStoreID(t.StoreID).
+		SetName(t.Name).
+		SetType(t.Type).
+		SetValue(t.Value).
+		SetMaxDiscount(t.MaxDiscount).
+		SetProductID(t.ProductID).
+		SetMinSpend(t.MinSpend).
+		SetStoreIds(t.StoreIDs).
+		SetValidityType(t.ValidityType).
+		SetNillableValidFrom(t.ValidFrom).
+		SetNillableValidTo(t.ValidTo).
+		SetValidDays(t.ValidDays).
+		SetTotalQty(t.TotalQty).
+		SetIssuedQty(t.IssuedQty).
+		SetDescription(t.Description).
+		SetEnabled(t.Enabled).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create coupon template: %w", err)
+	}
+
+	t.CreatedAt = et.CreatedAt
+	t.UpdatedAt = et.UpdatedAt
+	return nil
+}
+
+// Update 更新优惠券模板
+func (repo *CouponTemplateRepository) Update(ctx context.Context, t *domain.CouponTemplate) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponTemplateRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if t == nil {
+		return fmt.Errorf("coupon template is nil")
+	}
+
+	builder := repo.Client.CouponTemplate.UpdateOneID(t.ID).
+		SetName(t.Name).
+		SetType(t.Type).
+		SetValue(t.Value).
+		SetMaxDiscount(t.MaxDiscount).
+		SetProductID(t.ProductID).
+		SetMinSpend(t.MinSpend).
+		SetStoreIds(t.StoreIDs).
+		SetValidityType(t.ValidityType).
+		SetValidDays(t.ValidDays).
+		SetTotalQty(t.TotalQty).
+		SetIssuedQty(t.IssuedQty).
+		SetDescription(t.Description).
+		SetEnabled(t.Enabled)
+	if t.ValidFrom != nil {
+		builder = builder.SetValidFrom(*t.ValidFrom)
+	} else {
+		builder = builder.ClearValidFrom()
+	}
+	if t.ValidTo != nil {
+		builder = builder.SetValidTo(*t.ValidTo)
+	} else {
+		builder = builder.ClearValidTo()
+	}
+
+	et, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrCouponTemplateNotExists)
+		}
+		return fmt.Errorf("failed to update coupon template: %w", err)
+	}
+
+	t.UpdatedAt = et.UpdatedAt
+	return nil
+}
+
+// Delete 删除优惠券模板
+func (repo *CouponTemplateRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponTemplateRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.CouponTemplate.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrCouponTemplateNotExists)
+		}
+		return fmt.Errorf("failed to delete coupon template: %w", err)
+	}
+	return nil
+}
+
+// FindByID 根据ID查询优惠券模板
+func (repo *CouponTemplateRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.CouponTemplate, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponTemplateRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	et, err := repo.Client.CouponTemplate.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrCouponTemplateNotExists)
+		}
+		return nil, err
+	}
+	return convertCouponTemplateToDomain(et), nil
+}
+
+// FindByIDForUpdate 锁定查询优惠券模板（用于发放的并发控制）
+func (repo *CouponTemplateRepository) FindByIDForUpdate(ctx context.Context, id uuid.UUID) (res *domain.CouponTemplate, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponTemplateRepository.FindByIDForUpdate")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	et, err := repo.Client.CouponTemplate.Query().
+		Where(coupontemplate.ID(id)).
+		ForUpdate(). // 行级锁：防止并发发放超出发放总量
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrCouponTemplateNotExists)
+		}
+		return nil, err
+	}
+	return convertCouponTemplateToDomain(et), nil
+}
+
+// Exists 检查优惠券模板名称是否已存在
+func (repo *CouponTemplateRepository) Exists(ctx context.Context, params domain.CouponTemplateExistsParams) (exists bool, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponTemplateRepository.Exists")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.CouponTemplate.Query().Where(
+		coupontemplate.MerchantID(params.MerchantID),
+		coupontemplate.StoreID(params.StoreID),
+		coupontemplate.Name(params.Name),
+	)
+	if params.ExcludeID != uuid.Nil {
+		query.Where(coupontemplate.IDNEQ(params.ExcludeID))
+	}
+	return query.Exist(ctx)
+}
+
+// PagedListBySearch 分页查询优惠券模板
+func (repo *CouponTemplateRepository) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.CouponTemplateSearchParams,
+) (res *domain.CouponTemplateSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CouponTemplateRepository.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.CouponTemplate.Query().Where(
+		coupontemplate.MerchantID(params.MerchantID),
+		coupontemplate.StoreID(params.StoreID),
+	)
+	if params.Name != "" {
+		query.Where(coupontemplate.NameContains(params.Name))
+	}
+	if params.Type != "" {
+		query.Where(coupontemplate.TypeEQ(params.Type))
+	}
+	if params.Enabled != nil {
+		query.Where(coupontemplate.Enabled(*params.Enabled))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := query.
+		Offset(page.Offset()).
+		Limit(page.Size).
+		Order(ent.Desc(coupontemplate.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(domain.CouponTemplates, 0, len(templates))
+	for _, t := range templates {
+		items = append(items, convertCouponTemplateToDomain(t))
+	}
+
+	page.SetTotal(total)
+
+	return &domain.CouponTemplateSearchRes{
+		Pagination: page,
+		Items:      items,
+	}, nil
+}
+
+func convertCouponTemplateToDomain(et *ent.CouponTemplate) *domain.CouponTemplate {
+	if et == nil {
+		return nil
+	}
+	return &domain.CouponTemplate{
+		ID:           et.ID,
+		MerchantID:   et.MerchantID,
+		StoreID:      et.StoreID,
+		Name:         et.Name,
+		Type:         et.Type,
+		Value:        et.Value,
+		MaxDiscount:  et.MaxDiscount,
+		ProductID:    et.ProductID,
+		MinSpend:     et.MinSpend,
+		StoreIDs:     et.StoreIds,
+		ValidityType: et.ValidityType,
+		ValidFrom:    et.ValidFrom,
+		ValidTo:      et.ValidTo,
+		ValidDays:    et.ValidDays,
+		TotalQty:     et.TotalQty,
+		IssuedQty:    et.IssuedQty,
+		Description:  et.Description,
+		Enabled:      et.Enabled,
+		CreatedAt:    et.CreatedAt,
+		UpdatedAt:    et.UpdatedAt,
+	}
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type CouponTemplateRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *CouponTemplateRepository
+	ctx  context.Context
+}
+
+func TestCouponTemplateRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CouponTemplateRepositoryTestSuite))
+}
+
+func (s *CouponTemplateRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &CouponTemplateRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *CouponTemplateRepositoryTestSuite) newTemplate(merchantID uuid.UUID, name string) *domain.CouponTemplate {
+	return &domain.CouponTemplate{
+		ID:           uuid.New(),
+		MerchantID:   merchantID,
+		Name:         name,
+		Type:         domain.CouponTypeAmountOff,
+		Value:        decimal.NewFromInt(10),
+		MinSpend:     decimal.NewFromInt(50),
+		ValidityType: domain.CouponValidityTypeRelative,
+		ValidDays:    30,
+		TotalQty:     100,
+		Enabled:      true,
+	}
+}
+
+func (s *CouponTemplateRepositoryTestSuite) TestCouponTemplate_CRUD() {
+	merchantID := uuid.New()
+	t1 := s.newTemplate(merchantID, "10元代金券")
+
+	s.T().Run("创建成功", func(t *testing.T) {
+		require.NoError(t, s.repo.Create(s.ctx, t1))
+
+		found, err := s.repo.FindByID(s.ctx, t1.ID)
+		require.NoError(t, err)
+		require.Equal(t, "10元代金券", found.Name)
+		require.True(t, found.Value.Equal(decimal.NewFromInt(10)))
+		require.True(t, found.MinSpend.Equal(decimal.NewFromInt(50)))
+		require.Equal(t, 30, found.ValidDays)
+		require.Nil(t, found.ValidFrom)
+	})
+
+	s.T().Run("空入参", func(t *testing.T) {
+		require.Error(t, s.repo.Create(s.ctx, nil))
+	})
+
+	s.T().Run("名称已存在", func(t *testing.T) {
+		exists, err := s.repo.Exists(s.ctx, domain.CouponTemplateExistsParams{MerchantID: merchantID, Name: t1.Name})
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		exists, err = s.repo.Exists(s.ctx, domain.CouponTemplateExistsParams{MerchantID: merchantID, Name: t1.Name, ExcludeID: t1.ID})
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	s.T().Run("更新发放数量", func(t *testing.T) {
+		found, err := s.repo.FindByID(s.ctx, t1.ID)
+		require.NoError(t, err)
+		found.IssuedQty = 20
+		require.NoError(t, s.repo.Update(s.ctx, found))
+
+		found, err = s.repo.FindByID(s.ctx, t1.ID)
+		require.NoError(t, err)
+		require.Equal(t, 20, found.IssuedQty)
+	})
+
+	s.T().Run("分页查询", func(t *testing.T) {
+		require.NoError(t, s.repo.Create(s.ctx, s.newTemplate(merchantID, "20元代金券")))
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.CouponTemplateSearchParams{
+			MerchantID: merchantID,
+			Name:       "20元",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Total)
+	})
+
+	s.T().Run("删除", func(t *testing.T) {
+		require.NoError(t, s.repo.Delete(s.ctx, t1.ID))
+		_, err := s.repo.FindByID(s.ctx, t1.ID)
+		require.True(t, domain.IsNotFound(err))
+		require.True(t, domain.IsNotFound(s.repo.Delete(s.ctx, uuid.New())))
+	})
+}
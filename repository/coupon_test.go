@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type CouponRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *CouponRepository
+	ctx  context.Context
+}
+
+func TestCouponRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CouponRepositoryTestSuite))
+}
+
+func (s *CouponRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &CouponRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *CouponRepositoryTestSuite) issue(merchantID uuid.UUID, qty int) domain.Coupons {
+	validFrom := time.Now().Add(-time.Hour)
+	validTo := time.Now().Add(time.Hour)
+	template := &domain.CouponTemplate{
+		ID:           uuid.New(),
+		MerchantID:   merchantID,
+		Name:         "10元代金券",
+		Type:         domain.CouponTypeAmountOff,
+		Value:        decimal.NewFromInt(10),
+		ValidityType: domain.CouponValidityTypeFixed,
+		ValidFrom:    &validFrom,
+		ValidTo:      &validTo,
+		Enabled:      true,
+	}
+	coupons, err := template.Issue(qty, "B001", time.Now())
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.repo.CreateBulk(s.ctx, coupons))
+	return coupons
+}
+
+func (s *CouponRepositoryTestSuite) TestCoupon_CreateAndFind() {
+	merchantID := uuid.New()
+	coupons := s.issue(merchantID, 3)
+
+	s.T().Run("按券码查询", func(t *testing.T) {
+		found, err := s.repo.FindByCode(s.ctx, merchantID, "", coupons[0].Code)
+		require.NoError(t, err)
+		require.Equal(t, coupons[0].ID, found.ID)
+		require.Equal(t, domain.CouponStatusUnused, found.Status)
+		require.Equal(t, domain.CouponSourceSystem, found.Source)
+
+		_, err = s.repo.FindByCode(s.ctx, uuid.New(), "", coupons[0].Code)
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("券码重复", func(t *testing.T) {
+		dup := *coupons[0]
+		dup.ID = uuid.New()
+		require.True(t, domain.IsConflict(s.repo.CreateBulk(s.ctx, domain.Coupons{&dup})))
+	})
+
+	s.T().Run("分页查询", func(t *testing.T) {
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.CouponSearchParams{
+			MerchantID: merchantID,
+			TemplateID: coupons[0].TemplateID,
+			Status:     domain.CouponStatusUnused,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, res.Total)
+	})
+}
+
+func (s *CouponRepositoryTestSuite) TestCoupon_RedeemAndRestore() {
+	merchantID := uuid.New()
+	c := s.issue(merchantID, 1)[0]
+	order := &domain.Order{ID: uuid.New(), StoreID: uuid.New(), OrderNo: "A0001"}
+
+	s.T().Run("核销", func(t *testing.T) {
+		c.MarkUsed(order, decimal.NewFromInt(10), time.Now())
+		require.NoError(t, s.repo.Update(s.ctx, c))
+
+		coupons, err := s.repo.ListByOrderID(s.ctx, order.ID)
+		require.NoError(t, err)
+		require.Len(t, coupons, 1)
+		require.Equal(t, domain.CouponStatusUsed, coupons[0].Status)
+		require.Equal(t, "A0001", coupons[0].OrderNo)
+		require.NotNil(t, coupons[0].UsedAt)
+		require.True(t, coupons[0].DiscountAmount.Equal(decimal.NewFromInt(10)))
+	})
+
+	s.T().Run("撤销核销", func(t *testing.T) {
+		c.Restore()
+		require.NoError(t, s.repo.Update(s.ctx, c))
+
+		coupons, err := s.repo.ListByOrderID(s.ctx, order.ID)
+		require.NoError(t, err)
+		require.Empty(t, coupons)
+
+		found, err := s.repo.FindByID(s.ctx, c.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.CouponStatusUnused, found.Status)
+		require.Nil(t, found.UsedAt)
+	})
+
+	s.T().Run("不存在", func(t *testing.T) {
+		require.True(t, domain.IsNotFound(s.repo.Update(s.ctx, &domain.Coupon{ID: uuid.New(), Status: domain.CouponStatusUsed})))
+	})
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/customer"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.CustomerRepository = (*CustomerRepository)(nil)
+
+type CustomerRepository struct {
+	Client *ent.Client
+}
+
+func NewCustomerRepository(client *ent.Client) *CustomerRepository {
+	return &CustomerRepository{
+		Client: client,
+	}
+}
+
+func (repo *CustomerRepository) Create(ctx context.Context, c *domain.Customer) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CustomerRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if c == nil {
+		return fmt.Errorf("customer is nil")
+	}
+
+	ec, err := repo.Client.Customer.Create().
+		SetID(c.ID).
+		SetOpenid(c.OpenID).
+		SetUnionid(c.UnionID).
+		SetNickname(c.Nickname).
+		SetAvatar(c.Avatar).
+		SetPhone(c.Phone).
+		SetNillableLastLoginAt(c.LastLoginAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create customer: %w", err)
+	}
+
+	c.CreatedAt = ec.CreatedAt
+	c.UpdatedAt = ec.UpdatedAt
+	return nil
+}
+
+func (repo *CustomerRepository) Update(ctx context.Context, c *domain.Customer) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CustomerRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if c == nil {
+		return fmt.Errorf("customer is nil")
+	}
+
+	builder := repo.Client.Customer.UpdateOneID(c.ID).
+		SetUnionid(c.UnionID).
+		SetNickname(c.Nickname).
+		SetAvatar(c.Avatar).
+		SetPhone(c.Phone)
+	if c.LastLoginAt != nil {
+		builder = builder.SetLastLoginAt(*c.LastLoginAt)
+	}
+
+	ec, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrCustomerNotExists)
+		}
+		return fmt.Errorf("failed to update customer: %w", err)
+	}
+
+	c.UpdatedAt = ec.UpdatedAt
+	return nil
+}
+
+func (repo *CustomerRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.Customer, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CustomerRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ec, err := repo.Client.Customer.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrCustomerNotExists)
+		}
+		return nil, err
+	}
+	return convertCustomerToDomain(ec), nil
+}
+
+// FindByOpenID 根据微信小程序 openid 查询客户
+func (repo *CustomerRepository) FindByOpenID(ctx context.Context, openID string) (res *domain.Customer, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "CustomerRepository.FindByOpenID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ec, err := repo.Client.Customer.Query().
+		Where(customer.Openid(openID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrCustomerNotExists)
+		}
+		return nil, err
+	}
+	return convertCustomerToDomain(ec), nil
+}
+
+func convertCustomerToDomain(ec *ent.Customer) *domain.Customer {
+	if ec == nil {
+		return nil
+	}
+	return &domain.Customer{
+		ID:          ec.ID,
+		OpenID:      ec.Openid,
+		UnionID:     ec.Unionid,
+		Nickname:    ec.Nickname,
+		Avatar:      ec.Avatar,
+		Phone:       ec.Phone,
+		LastLoginAt: ec.LastLoginAt,
+		CreatedAt:   ec.CreatedAt,
+		UpdatedAt:   ec.UpdatedAt,
+	}
+}
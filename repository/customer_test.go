@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type CustomerRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *CustomerRepository
+	ctx  context.Context
+}
+
+func TestCustomerRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomerRepositoryTestSuite))
+}
+
+func (s *CustomerRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &CustomerRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *CustomerRepositoryTestSuite) TestCustomer_Save() {
+	c := domain.NewCustomer(&domain.WechatSession{OpenID: "openid-1"})
+	c.Login(&domain.WechatSession{OpenID: "openid-1"}, time.Now().Truncate(time.Second))
+	require.NoError(s.T(), s.repo.Create(s.ctx, c))
+
+	s.T().Run("openid 唯一", func(t *testing.T) {
+		dup := domain.NewCustomer(&domain.WechatSession{OpenID: "openid-1"})
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, dup)))
+	})
+
+	s.T().Run("按 openid 查询", func(t *testing.T) {
+		got, err := s.repo.FindByOpenID(s.ctx, "openid-1")
+		require.NoError(t, err)
+		require.Equal(t, c.ID, got.ID)
+		require.NotNil(t, got.LastLoginAt)
+
+		_, err = s.repo.FindByOpenID(s.ctx, "openid-2")
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("更新资料", func(t *testing.T) {
+		c.Login(&domain.WechatSession{OpenID: "openid-1", UnionID: "unionid-1"}, time.Now())
+		c.Nickname = "小明"
+		c.Avatar = "https://example.com/avatar.png"
+		c.Phone = "13800138000"
+		require.NoError(t, s.repo.Update(s.ctx, c))
+
+		got, err := s.repo.FindByID(s.ctx, c.ID)
+		require.NoError(t, err)
+		require.Equal(t, "openid-1", got.OpenID)
+		require.Equal(t, "unionid-1", got.UnionID)
+		require.Equal(t, "小明", got.Nickname)
+		require.Equal(t, "https://example.com/avatar.png", got.Avatar)
+		require.Equal(t, "13800138000", got.Phone)
+
+		_, err = s.repo.FindByID(s.ctx, uuid.New())
+		require.True(t, domain.IsNotFound(err))
+	})
+}
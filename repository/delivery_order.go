@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryorder"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.DeliveryOrderRepository = (*DeliveryOrderRepository)(nil)
+
+type DeliveryOrderRepository struct {
+	Client *ent.Client
+}
+
+func NewDeliveryOrderRepository(client *ent.Client) *DeliveryOrderRepository {
+	return &DeliveryOrderRepository{
+		Client: client,
+	}
+}
+
+func (repo *DeliveryOrderRepository) Create(ctx context.Context, order *domain.DeliveryOrder) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryOrderRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if order == nil {
+		return fmt.Errorf("delivery order is nil")
+	}
+
+	eo, err := repo.Client.DeliveryOrder.Create().
+		SetID(order.ID).
+		SetMerchantID(order.MerchantID).
+		SetStoreID(order.StoreID).
+		SetPlatform(order.Platform).
+		SetPlatformOrderID(order.PlatformOrderID).
+		SetShortCode(order.ShortCode).
+		SetStatus(order.Status).
+		SetOrderID(order.OrderID).
+		SetItems(order.Items).
+		SetSubtotal(order.Subtotal).
+		SetDeliveryFee(order.DeliveryFee).
+		SetTotal(order.Total).
+		SetCustomerName(order.CustomerName).
+		SetCustomerPhone(order.CustomerPhone).
+		SetAddress(order.Address).
+		SetRemark(order.Remark).
+		SetRejectReason(order.RejectReason).
+		SetAutoAccepted(order.AutoAccepted).
+		SetPlacedAt(order.PlacedAt).
+		SetNillableAcceptedAt(order.AcceptedAt).
+		SetNillableReadyAt(order.ReadyAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create delivery order: %w", err)
+	}
+
+	order.CreatedAt = eo.CreatedAt
+	order.UpdatedAt = eo.UpdatedAt
+	return nil
+}
+
+func (repo *DeliveryOrderRepository) Update(ctx context.Context, order *domain.DeliveryOrder) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryOrderRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if order == nil {
+		return fmt.Errorf("delivery order is nil")
+	}
+
+	eo, err := repo.Client.DeliveryOrder.UpdateOneID(order.ID).
+		SetStatus(order.Status).
+		SetOrderID(order.OrderID).
+		SetItems(order.Items).
+		SetRejectReason(order.RejectReason).
+		SetAutoAccepted(order.AutoAccepted).
+		SetNillableAcceptedAt(order.AcceptedAt).
+		SetNillableReadyAt(order.ReadyAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrDeliveryOrderNotExists)
+		}
+		return fmt.Errorf("failed to update delivery order: %w", err)
+	}
+
+	order.UpdatedAt = eo.UpdatedAt
+	return nil
+}
+
+func (repo *DeliveryOrderRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.DeliveryOrder, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryOrderRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	eo, err := repo.Client.DeliveryOrder.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrDeliveryOrderNotExists)
+		}
+		return nil, fmt.Errorf("failed to find delivery order: %w", err)
+	}
+	return convertDeliveryOrderToDomain(eo), nil
+}
+
+func (repo *DeliveryOrderRepository) FindByPlatformOrder(ctx context.Context, platform domain.DeliveryPlatformCode, platformOrderID string) (res *domain.DeliveryOrder, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryOrderRepository.FindByPlatformOrder")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	eo, err := repo.Client.DeliveryOrder.Query().
+		Where(
+			deliveryorder.PlatformEQ(platform),
+			deliveryorder.PlatformOrderID(platformOrderID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrDeliveryOrderNotExists)
+		}
+		return nil, fmt.Errorf("failed to find delivery order: %w", err)
+	}
+	return convertDeliveryOrderToDomain(eo), nil
+}
+
+func (repo *DeliveryOrderRepository) List(ctx context.Context, params domain.DeliveryOrderListParams) (res []*domain.DeliveryOrder, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryOrderRepository.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.DeliveryOrder.Query().
+		Where(deliveryorder.StoreID(params.StoreID))
+	if len(params.Statuses) > 0 {
+		query.Where(deliveryorder.StatusIn(params.Statuses...))
+	}
+	if !params.PlacedFrom.IsZero() {
+		query.Where(deliveryorder.PlacedAtGTE(params.PlacedFrom))
+	}
+
+	orders, err := query.Order(ent.Desc(deliveryorder.FieldPlacedAt)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delivery orders: %w", err)
+	}
+	return lo.Map(orders, func(item *ent.DeliveryOrder, _ int) *domain.DeliveryOrder {
+		return convertDeliveryOrderToDomain(item)
+	}), nil
+}
+
+func convertDeliveryOrderToDomain(eo *ent.DeliveryOrder) *domain.DeliveryOrder {
+	if eo == nil {
+		return nil
+	}
+	return &domain.DeliveryOrder{
+		ID:              eo.ID,
+		MerchantID:      eo.MerchantID,
+		StoreID:         eo.StoreID,
+		Platform:        eo.Platform,
+		PlatformOrderID: eo.PlatformOrderID,
+		ShortCode:       eo.ShortCode,
+		Status:          eo.Status,
+		OrderID:         eo.OrderID,
+		Items:           eo.Items,
+		Subtotal:        eo.Subtotal,
+		DeliveryFee:     eo.DeliveryFee,
+		Total:           eo.Total,
+		CustomerName:    eo.CustomerName,
+		CustomerPhone:   eo.CustomerPhone,
+		Address:         eo.Address,
+		Remark:          eo.Remark,
+		RejectReason:    eo.RejectReason,
+		AutoAccepted:    eo.AutoAccepted,
+		PlacedAt:        eo.PlacedAt,
+		AcceptedAt:      eo.AcceptedAt,
+		ReadyAt:         eo.ReadyAt,
+		CreatedAt:       eo.CreatedAt,
+		UpdatedAt:       eo.UpdatedAt,
+	}
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type DeliveryOrderRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *DeliveryOrderRepository
+	ctx  context.Context
+}
+
+func TestDeliveryOrderRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryOrderRepositoryTestSuite))
+}
+
+func (s *DeliveryOrderRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &DeliveryOrderRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *DeliveryOrderRepositoryTestSuite) TestDeliveryOrder_Flow() {
+	store := &domain.DeliveryStore{
+		MerchantID: uuid.New(),
+		StoreID:    uuid.New(),
+		Platform:   domain.DeliveryPlatformGrabFood,
+	}
+	placedAt := time.Now().Truncate(time.Second)
+	newOrder := func(platformOrderID string, placedAt time.Time) *domain.DeliveryOrder {
+		order := domain.NewDeliveryOrder(store, &domain.DeliveryWebhookEvent{
+			Type:            domain.DeliveryWebhookOrderCreated,
+			PlatformOrderID: platformOrderID,
+			Order: &domain.DeliveryPlatformOrder{
+				ShortCode: "GF-" + platformOrderID,
+				Items: []domain.DeliveryOrderItem{
+					{SKU: "SKU-A", Name: "牛肉饭", Qty: 2, Price: decimal.NewFromInt(12)},
+				},
+				Subtotal:    decimal.NewFromInt(24),
+				DeliveryFee: decimal.NewFromInt(3),
+				Total:       decimal.NewFromInt(27),
+				PlacedAt:    placedAt,
+			},
+		})
+		require.NoError(s.T(), s.repo.Create(s.ctx, order))
+		return order
+	}
+	first := newOrder("1001", placedAt)
+	second := newOrder("1002", placedAt.Add(time.Minute))
+
+	s.T().Run("平台订单只接收一次", func(t *testing.T) {
+		dup := domain.NewDeliveryOrder(store, &domain.DeliveryWebhookEvent{
+			PlatformOrderID: "1001",
+			Order:           &domain.DeliveryPlatformOrder{PlacedAt: placedAt},
+		})
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, dup)))
+
+		got, err := s.repo.FindByPlatformOrder(s.ctx, domain.DeliveryPlatformGrabFood, "1001")
+		require.NoError(t, err)
+		require.Equal(t, first.ID, got.ID)
+		require.Len(t, got.Items, 1)
+		require.Equal(t, 2, got.Items[0].Qty)
+		require.True(t, decimal.NewFromInt(27).Equal(got.Total))
+	})
+
+	s.T().Run("接单后更新", func(t *testing.T) {
+		productID := uuid.New()
+		first.ApplyMappings([]*domain.DeliveryProductMapping{{PlatformSKU: "SKU-A", ProductID: productID}})
+		orderID := uuid.New()
+		require.NoError(t, first.Accept(orderID, true, placedAt.Add(time.Minute)))
+		require.NoError(t, s.repo.Update(s.ctx, first))
+
+		got, err := s.repo.FindByID(s.ctx, first.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.DeliveryOrderStatusAccepted, got.Status)
+		require.Equal(t, orderID, got.OrderID)
+		require.Equal(t, productID, got.Items[0].ProductID)
+		require.True(t, got.AutoAccepted)
+		require.NotNil(t, got.AcceptedAt)
+	})
+
+	s.T().Run("按状态查询", func(t *testing.T) {
+		list, err := s.repo.List(s.ctx, domain.DeliveryOrderListParams{StoreID: store.StoreID})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, second.ID, list[0].ID)
+
+		list, err = s.repo.List(s.ctx, domain.DeliveryOrderListParams{
+			StoreID:  store.StoreID,
+			Statuses: []domain.DeliveryOrderStatus{domain.DeliveryOrderStatusPending},
+		})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, second.ID, list[0].ID)
+
+		list, err = s.repo.List(s.ctx, domain.DeliveryOrderListParams{
+			StoreID:    store.StoreID,
+			PlacedFrom: placedAt.Add(30 * time.Second),
+		})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+	})
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliveryproductmapping"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.DeliveryProductMappingRepository = (*DeliveryProductMappingRepository)(nil)
+
+type DeliveryProductMappingRepository struct {
+	Client *ent.Client
+}
+
+func NewDeliveryProductMappingRepository(client *ent.Client) *DeliveryProductMappingRepository {
+	return &DeliveryProductMappingRepository{
+		Client: client,
+	}
+}
+
+func (repo *DeliveryProductMappingRepository) Create(ctx context.Context, mapping *domain.DeliveryProductMapping) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryProductMappingRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if mapping == nil {
+		return fmt.Errorf("delivery product mapping is nil")
+	}
+
+	em, err := repo.Client.DeliveryProductMapping.Create().
+		SetID(mapping.ID).
+		SetMerchantID(mapping.MerchantID).
+		SetPlatform(mapping.Platform).
+		SetPlatformSku(mapping.PlatformSKU).
+		SetProductID(mapping.ProductID).
+		SetSpecID(mapping.SpecID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(domain.ErrDeliveryMappingExists)
+		}
+		return fmt.Errorf("failed to create delivery product mapping: %w", err)
+	}
+
+	mapping.CreatedAt = em.CreatedAt
+	mapping.UpdatedAt = em.UpdatedAt
+	return nil
+}
+
+func (repo *DeliveryProductMappingRepository) Update(ctx context.Context, mapping *domain.DeliveryProductMapping) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryProductMappingRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if mapping == nil {
+		return fmt.Errorf("delivery product mapping is nil")
+	}
+
+	em, err := repo.Client.DeliveryProductMapping.UpdateOneID(mapping.ID).
+		SetPlatformSku(mapping.PlatformSKU).
+		SetProductID(mapping.ProductID).
+		SetSpecID(mapping.SpecID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrDeliveryMappingNotExists)
+		}
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(domain.ErrDeliveryMappingExists)
+		}
+		return fmt.Errorf("failed to update delivery product mapping: %w", err)
+	}
+
+	mapping.UpdatedAt = em.UpdatedAt
+	return nil
+}
+
+func (repo *DeliveryProductMappingRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryProductMappingRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.DeliveryProductMapping.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrDeliveryMappingNotExists)
+		}
+		return fmt.Errorf("failed to delete delivery product mapping: %w", err)
+	}
+	return nil
+}
+
+func (repo *DeliveryProductMappingRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.DeliveryProductMapping, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryProductMappingRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	em, err := repo.Client.DeliveryProductMapping.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrDeliveryMappingNotExists)
+		}
+		return nil, fmt.Errorf("failed to find delivery product mapping: %w", err)
+	}
+	return convertDeliveryProductMappingToDomain(em), nil
+}
+
+func (repo *DeliveryProductMappingRepository) List(ctx context.Context, params domain.DeliveryProductMappingListParams) (res []*domain.DeliveryProductMapping, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryProductMappingRepository.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.DeliveryProductMapping.Query().
+		Where(deliveryproductmapping.MerchantID(params.MerchantID))
+	if params.Platform != "" {
+		query.Where(deliveryproductmapping.PlatformEQ(params.Platform))
+	}
+	if len(params.PlatformSKUs) > 0 {
+		query.Where(deliveryproductmapping.PlatformSkuIn(params.PlatformSKUs...))
+	}
+	if len(params.ProductIDs) > 0 {
+		query.Where(deliveryproductmapping.ProductIDIn(params.ProductIDs...))
+	}
+
+	mappings, err := query.Order(ent.Asc(deliveryproductmapping.FieldPlatformSku)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delivery product mappings: %w", err)
+	}
+	return lo.Map(mappings, func(item *ent.DeliveryProductMapping, _ int) *domain.DeliveryProductMapping {
+		return convertDeliveryProductMappingToDomain(item)
+	}), nil
+}
+
+func convertDeliveryProductMappingToDomain(em *ent.DeliveryProductMapping) *domain.DeliveryProductMapping {
+	if em == nil {
+		return nil
+	}
+	return &domain.DeliveryProductMapping{
+		ID:          em.ID,
+		MerchantID:  em.MerchantID,
+		Platform:    em.Platform,
+		PlatformSKU: em.PlatformSku,
+		ProductID:   em.ProductID,
+		SpecID:      em.SpecID,
+		CreatedAt:   em.CreatedAt,
+		UpdatedAt:   em.UpdatedAt,
+	}
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type DeliveryProductMappingRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *DeliveryProductMappingRepository
+	ctx  context.Context
+}
+
+func TestDeliveryProductMappingRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryProductMappingRepositoryTestSuite))
+}
+
+func (s *DeliveryProductMappingRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &DeliveryProductMappingRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *DeliveryProductMappingRepositoryTestSuite) TestDeliveryProductMapping_Flow() {
+	merchantID := uuid.New()
+	productA := uuid.New()
+	productB := uuid.New()
+	newMapping := func(platform domain.DeliveryPlatformCode, sku string, productID uuid.UUID) *domain.DeliveryProductMapping {
+		m := &domain.DeliveryProductMapping{
+			ID:          uuid.New(),
+			MerchantID:  merchantID,
+			Platform:    platform,
+			PlatformSKU: sku,
+			ProductID:   productID,
+		}
+		require.NoError(s.T(), s.repo.Create(s.ctx, m))
+		return m
+	}
+	a := newMapping(domain.DeliveryPlatformGrabFood, "SKU-A", productA)
+	b := newMapping(domain.DeliveryPlatformGrabFood, "SKU-B", productB)
+	newMapping(domain.DeliveryPlatformFoodpanda, "SKU-A", productA)
+
+	s.T().Run("同一平台编码只能映射一次", func(t *testing.T) {
+		dup := &domain.DeliveryProductMapping{
+			ID:          uuid.New(),
+			MerchantID:  merchantID,
+			Platform:    domain.DeliveryPlatformGrabFood,
+			PlatformSKU: "SKU-A",
+			ProductID:   productB,
+		}
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, dup)))
+	})
+
+	s.T().Run("按编码与商品查询", func(t *testing.T) {
+		list, err := s.repo.List(s.ctx, domain.DeliveryProductMappingListParams{
+			MerchantID:   merchantID,
+			Platform:     domain.DeliveryPlatformGrabFood,
+			PlatformSKUs: []string{"SKU-A", "SKU-X"},
+		})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, a.ID, list[0].ID)
+		require.Equal(t, uuid.Nil, list[0].SpecID)
+
+		list, err = s.repo.List(s.ctx, domain.DeliveryProductMappingListParams{
+			MerchantID: merchantID,
+			ProductIDs: []uuid.UUID{productA},
+		})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+	})
+
+	s.T().Run("更新与删除", func(t *testing.T) {
+		specID := uuid.New()
+		b.SpecID = specID
+		require.NoError(t, s.repo.Update(s.ctx, b))
+		got, err := s.repo.FindByID(s.ctx, b.ID)
+		require.NoError(t, err)
+		require.Equal(t, specID, got.SpecID)
+
+		require.NoError(t, s.repo.Delete(s.ctx, b.ID))
+		_, err = s.repo.FindByID(s.ctx, b.ID)
+		require.True(t, domain.IsNotFound(err))
+	})
+}
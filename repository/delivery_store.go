@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/deliverystore"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.DeliveryStoreRepository = (*DeliveryStoreRepository)(nil)
+
+type DeliveryStoreRepository struct {
+	Client *ent.Client
+}
+
+func NewDeliveryStoreRepository(client *ent.Client) *DeliveryStoreRepository {
+	return &DeliveryStoreRepository{
+		Client: client,
+	}
+}
+
+func (repo *DeliveryStoreRepository) Create(ctx context.Context, store *domain.DeliveryStore) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryStoreRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if store == nil {
+		return fmt.Errorf("delivery store is nil")
+	}
+
+	es, err := repo.Client.DeliveryStore.Create().
+		SetID(store.ID).
+		SetMerchantID(store.MerchantID).
+		SetStoreID(store.StoreID).
+		SetPlatform(store.Platform).
+		SetPlatformStoreID(store.PlatformStoreID).
+		SetEnabled(store.Enabled).
+		SetAutoAccept(store.AutoAccept).
+		SetAutoAcceptMaxAmount(store.AutoAcceptMaxAmount).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(domain.ErrDeliveryStoreExists)
+		}
+		return fmt.Errorf("failed to create delivery store: %w", err)
+	}
+
+	store.CreatedAt = es.CreatedAt
+	store.UpdatedAt = es.UpdatedAt
+	return nil
+}
+
+func (repo *DeliveryStoreRepository) Update(ctx context.Context, store *domain.DeliveryStore) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryStoreRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if store == nil {
+		return fmt.Errorf("delivery store is nil")
+	}
+
+	es, err := repo.Client.DeliveryStore.UpdateOneID(store.ID).
+		SetPlatformStoreID(store.PlatformStoreID).
+		SetEnabled(store.Enabled).
+		SetAutoAccept(store.AutoAccept).
+		SetAutoAcceptMaxAmount(store.AutoAcceptMaxAmount).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrDeliveryStoreNotExists)
+		}
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(domain.ErrDeliveryStoreExists)
+		}
+		return fmt.Errorf("failed to update delivery store: %w", err)
+	}
+
+	store.UpdatedAt = es.UpdatedAt
+	return nil
+}
+
+func (repo *DeliveryStoreRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryStoreRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.DeliveryStore.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrDeliveryStoreNotExists)
+		}
+		return fmt.Errorf("failed to delete delivery store: %w", err)
+	}
+	return nil
+}
+
+func (repo *DeliveryStoreRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.DeliveryStore, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryStoreRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	es, err := repo.Client.DeliveryStore.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrDeliveryStoreNotExists)
+		}
+		return nil, fmt.Errorf("failed to find delivery store: %w", err)
+	}
+	return convertDeliveryStoreToDomain(es), nil
+}
+
+func (repo *DeliveryStoreRepository) FindByPlatformStore(ctx context.Context, platform domain.DeliveryPlatformCode, platformStoreID string) (res *domain.DeliveryStore, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryStoreRepository.FindByPlatformStore")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	es, err := repo.Client.DeliveryStore.Query().
+		Where(
+			deliverystore.PlatformEQ(platform),
+			deliverystore.PlatformStoreID(platformStoreID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrDeliveryStoreNotExists)
+		}
+		return nil, fmt.Errorf("failed to find delivery store: %w", err)
+	}
+	return convertDeliveryStoreToDomain(es), nil
+}
+
+func (repo *DeliveryStoreRepository) FindByStore(ctx context.Context, storeID uuid.UUID, platform domain.DeliveryPlatformCode) (res *domain.DeliveryStore, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryStoreRepository.FindByStore")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	es, err := repo.Client.DeliveryStore.Query().
+		Where(
+			deliverystore.StoreID(storeID),
+			deliverystore.PlatformEQ(platform),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrDeliveryStoreNotExists)
+		}
+		return nil, fmt.Errorf("failed to find delivery store: %w", err)
+	}
+	return convertDeliveryStoreToDomain(es), nil
+}
+
+func (repo *DeliveryStoreRepository) List(ctx context.Context, params domain.DeliveryStoreListParams) (res []*domain.DeliveryStore, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeliveryStoreRepository.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.DeliveryStore.Query().
+		Where(deliverystore.MerchantID(params.MerchantID))
+	if params.StoreID != uuid.Nil {
+		query.Where(deliverystore.StoreID(params.StoreID))
+	}
+	if params.Platform != "" {
+		query.Where(deliverystore.PlatformEQ(params.Platform))
+	}
+	if params.Enabled != nil {
+		query.Where(deliverystore.Enabled(*params.Enabled))
+	}
+
+	stores, err := query.Order(ent.Asc(deliverystore.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delivery stores: %w", err)
+	}
+	return lo.Map(stores, func(item *ent.DeliveryStore, _ int) *domain.DeliveryStore {
+		return convertDeliveryStoreToDomain(item)
+	}), nil
+}
+
+func convertDeliveryStoreToDomain(es *ent.DeliveryStore) *domain.DeliveryStore {
+	if es == nil {
+		return nil
+	}
+	return &domain.DeliveryStore{
+		ID:                  es.ID,
+		MerchantID:          es.MerchantID,
+		StoreID:             es.StoreID,
+		Platform:            es.Platform,
+		PlatformStoreID:     es.PlatformStoreID,
+		Enabled:             es.Enabled,
+		AutoAccept:          es.AutoAccept,
+		AutoAcceptMaxAmount: es.AutoAcceptMaxAmount,
+		CreatedAt:           es.CreatedAt,
+		UpdatedAt:           es.UpdatedAt,
+	}
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type DeliveryStoreRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *DeliveryStoreRepository
+	ctx  context.Context
+}
+
+func TestDeliveryStoreRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryStoreRepositoryTestSuite))
+}
+
+func (s *DeliveryStoreRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &DeliveryStoreRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *DeliveryStoreRepositoryTestSuite) TestDeliveryStore_Flow() {
+	merchantID := uuid.New()
+	storeID := uuid.New()
+	grab := &domain.DeliveryStore{
+		ID:                  uuid.New(),
+		MerchantID:          merchantID,
+		StoreID:             storeID,
+		Platform:            domain.DeliveryPlatformGrabFood,
+		PlatformStoreID:     "GF-001",
+		Enabled:             true,
+		AutoAccept:          true,
+		AutoAcceptMaxAmount: decimal.NewFromInt(200),
+	}
+	require.NoError(s.T(), s.repo.Create(s.ctx, grab))
+	panda := &domain.DeliveryStore{
+		ID:              uuid.New(),
+		MerchantID:      merchantID,
+		StoreID:         storeID,
+		Platform:        domain.DeliveryPlatformFoodpanda,
+		PlatformStoreID: "FP-001",
+	}
+	require.NoError(s.T(), s.repo.Create(s.ctx, panda))
+
+	s.T().Run("门店同一平台只能绑定一个店铺", func(t *testing.T) {
+		dup := &domain.DeliveryStore{
+			ID:              uuid.New(),
+			MerchantID:      merchantID,
+			StoreID:         storeID,
+			Platform:        domain.DeliveryPlatformGrabFood,
+			PlatformStoreID: "GF-002",
+		}
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, dup)))
+
+		dup.StoreID = uuid.New()
+		dup.PlatformStoreID = "GF-001"
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, dup)))
+	})
+
+	s.T().Run("按平台门店与门店查询", func(t *testing.T) {
+		got, err := s.repo.FindByPlatformStore(s.ctx, domain.DeliveryPlatformGrabFood, "GF-001")
+		require.NoError(t, err)
+		require.Equal(t, grab.ID, got.ID)
+		require.True(t, got.AutoAccept)
+		require.True(t, decimal.NewFromInt(200).Equal(got.AutoAcceptMaxAmount))
+
+		_, err = s.repo.FindByPlatformStore(s.ctx, domain.DeliveryPlatformFoodpanda, "GF-001")
+		require.True(t, domain.IsNotFound(err))
+
+		got, err = s.repo.FindByStore(s.ctx, storeID, domain.DeliveryPlatformFoodpanda)
+		require.NoError(t, err)
+		require.Equal(t, panda.ID, got.ID)
+	})
+
+	s.T().Run("更新与列表", func(t *testing.T) {
+		panda.Enabled = true
+		panda.PlatformStoreID = "FP-002"
+		require.NoError(t, s.repo.Update(s.ctx, panda))
+
+		enabled := true
+		list, err := s.repo.List(s.ctx, domain.DeliveryStoreListParams{MerchantID: merchantID, Enabled: &enabled})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+
+		list, err = s.repo.List(s.ctx, domain.DeliveryStoreListParams{
+			MerchantID: merchantID,
+			StoreID:    storeID,
+			Platform:   domain.DeliveryPlatformFoodpanda,
+		})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, "FP-002", list[0].PlatformStoreID)
+	})
+
+	s.T().Run("删除", func(t *testing.T) {
+		require.NoError(t, s.repo.Delete(s.ctx, panda.ID))
+		_, err := s.repo.FindByID(s.ctx, panda.ID)
+		require.True(t, domain.IsNotFound(err))
+		require.True(t, domain.IsNotFound(s.repo.Delete(s.ctx, panda.ID)))
+	})
+}
@@ -86,6 +86,7 @@ func (repo *DeviceRepository) Create(ctx context.Context, domainDevice *domain.D
 	case domain.DeviceTypePrinter:
 		builder = builder.SetIP(domainDevice.IP).
 			SetPaperSize(domainDevice.PaperSize).
+			SetPrintLocale(domainDevice.PrintLocale).
 			SetConnectType(domainDevice.ConnectType).
 			SetStallID(domainDevice.StallID).
 			SetOrderChannels(domainDevice.OrderChannels).
@@ -94,6 +95,8 @@ func (repo *DeviceRepository) Create(ctx context.Context, domainDevice *domain.D
 			SetDeviceStallReceiptType(domainDevice.DeviceStallReceiptType)
 	case domain.DeviceTypeCashier:
 		builder = builder.SetOpenCashDrawer(domainDevice.OpenCashDrawer)
+	case domain.DeviceTypeKiosk:
+		builder = builder.SetKioskPayModes(domainDevice.KioskPayModes)
 	}
 	created, err := builder.Save(ctx)
 	if err != nil {
@@ -127,6 +130,7 @@ func (repo *DeviceRepository) Update(ctx context.Context, domainDevice *domain.D
 	case domain.DeviceTypePrinter:
 		builder = builder.SetIP(domainDevice.IP).
 			SetPaperSize(domainDevice.PaperSize).
+			SetPrintLocale(domainDevice.PrintLocale).
 			SetConnectType(domainDevice.ConnectType).
 			SetStallID(domainDevice.StallID).
 			SetOrderChannels(domainDevice.OrderChannels).
@@ -135,6 +139,8 @@ func (repo *DeviceRepository) Update(ctx context.Context, domainDevice *domain.D
 			SetDeviceStallReceiptType(domainDevice.DeviceStallReceiptType)
 	case domain.DeviceTypeCashier:
 		builder = builder.SetOpenCashDrawer(domainDevice.OpenCashDrawer)
+	case domain.DeviceTypeKiosk:
+		builder = builder.SetKioskPayModes(domainDevice.KioskPayModes)
 	}
 	updated, err := builder.Save(ctx)
 	if err != nil {
@@ -276,6 +282,43 @@ func (repo *DeviceRepository) OffLine(ctx context.Context, ids ...uuid.UUID) (er
 	return
 }
 
+func (repo *DeviceRepository) FindByKioskToken(ctx context.Context, token string) (domainDevice *domain.Device, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeviceRepository.FindByKioskToken")
+	defer func() { util.SpanErrFinish(span, err) }()
+
+	es, err := repo.Client.Device.Query().
+		Where(device.KioskToken(token)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = domain.NotFoundError(domain.ErrDeviceNotExists)
+			return
+		}
+		err = fmt.Errorf("failed to find device by kiosk token: %w", err)
+		return
+	}
+	domainDevice = convertDeviceToDomain(es)
+	return
+}
+
+func (repo *DeviceRepository) UpdateKioskToken(ctx context.Context, id uuid.UUID, token string) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DeviceRepository.UpdateKioskToken")
+	defer func() { util.SpanErrFinish(span, err) }()
+
+	err = repo.Client.Device.UpdateOneID(id).
+		SetKioskToken(token).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = domain.NotFoundError(domain.ErrDeviceNotExists)
+			return
+		}
+		err = fmt.Errorf("failed to update device kiosk token: %w", err)
+		return
+	}
+	return
+}
+
 func (repo *DeviceRepository) buildFilterQuery(filter *domain.DeviceListFilter) *ent.DeviceQuery {
 	query := repo.Client.Device.Query()
 	if filter == nil {
@@ -335,6 +378,7 @@ func convertDeviceToDomain(es *ent.Device) (d *domain.Device) {
 		IP:                     es.IP,
 		Status:                 es.Status,
 		PaperSize:              es.PaperSize,
+		PrintLocale:            es.PrintLocale,
 		ConnectType:            es.ConnectType,
 		StallID:                es.StallID,
 		OrderChannels:          es.OrderChannels,
@@ -342,10 +386,14 @@ func convertDeviceToDomain(es *ent.Device) (d *domain.Device) {
 		DeviceStallPrintType:   es.DeviceStallPrintType,
 		DeviceStallReceiptType: es.DeviceStallReceiptType,
 		OpenCashDrawer:         es.OpenCashDrawer,
+		KioskPayModes:          es.KioskPayModes,
 		SortOrder:              es.SortOrder,
 		CreatedAt:              es.CreatedAt,
 		UpdatedAt:              es.UpdatedAt,
 	}
+	if es.KioskToken != nil {
+		d.KioskToken = *es.KioskToken
+	}
 	if es.Edges.Store != nil {
 		d.Store = convertStore(es.Edges.Store)
 	}
@@ -258,3 +258,46 @@ func (s *DeviceRepositoryTestSuite) TestDevice_Exists() {
 		require.True(t, exists)
 	})
 }
+
+func (s *DeviceRepositoryTestSuite) TestDevice_KioskToken() {
+	device := s.newCashier("kiosk")
+	device.Name = "自助点餐机-kiosk"
+	device.DeviceType = domain.DeviceTypeKiosk
+	device.OpenCashDrawer = false
+	device.KioskPayModes = []domain.KioskPayMode{domain.KioskPayModePayAtKiosk}
+	require.NoError(s.T(), s.repo.Create(s.ctx, device))
+
+	s.T().Run("未生成令牌时查询不到", func(t *testing.T) {
+		_, err := s.repo.FindByKioskToken(s.ctx, "not-exists")
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("生成令牌后可查询", func(t *testing.T) {
+		token, err := domain.NewDeviceKioskToken()
+		require.NoError(t, err)
+		require.NoError(t, s.repo.UpdateKioskToken(s.ctx, device.ID, token))
+
+		found, err := s.repo.FindByKioskToken(s.ctx, token)
+		require.NoError(t, err)
+		require.Equal(t, device.ID, found.ID)
+		require.Equal(t, token, found.KioskToken)
+		require.Equal(t, []domain.KioskPayMode{domain.KioskPayModePayAtKiosk}, found.KioskPayModes)
+	})
+
+	s.T().Run("重置令牌后旧令牌失效", func(t *testing.T) {
+		oldToken, err := domain.NewDeviceKioskToken()
+		require.NoError(t, err)
+		require.NoError(t, s.repo.UpdateKioskToken(s.ctx, device.ID, oldToken))
+		newToken, err := domain.NewDeviceKioskToken()
+		require.NoError(t, err)
+		require.NoError(t, s.repo.UpdateKioskToken(s.ctx, device.ID, newToken))
+
+		_, err = s.repo.FindByKioskToken(s.ctx, oldToken)
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("设备不存在", func(t *testing.T) {
+		err := s.repo.UpdateKioskToken(s.ctx, uuid.New(), "token")
+		require.True(t, domain.IsNotFound(err))
+	})
+}
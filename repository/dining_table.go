@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/diningtable"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.DiningTableRepository = (*DiningTableRepository)(nil)
+
+type DiningTableRepository struct {
+	Client *ent.Client
+}
+
+func NewDiningTableRepository(client *ent.Client) *DiningTableRepository {
+	return &DiningTableRepository{
+		Client: client,
+	}
+}
+
+func (repo *DiningTableRepository) Create(ctx context.Context, table *domain.DiningTable) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DiningTableRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if table == nil {
+		return fmt.Errorf("dining table is nil")
+	}
+
+	et, err := repo.Client.DiningTable.Create().
+		SetID(table.ID).
+		SetMerchantID(table.MerchantID).
+		SetStoreID(table.StoreID).
+		SetName(table.Name).
+		SetSeats(table.Seats).
+		SetQrToken(table.QRToken).
+		SetEnabled(table.Enabled).
+		SetSortOrder(table.SortOrder).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create dining table: %w", err)
+	}
+
+	table.CreatedAt = et.CreatedAt
+	table.UpdatedAt = et.UpdatedAt
+	return nil
+}
+
+func (repo *DiningTableRepository) Update(ctx context.Context, table *domain.DiningTable) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DiningTableRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if table == nil {
+		return fmt.Errorf("dining table is nil")
+	}
+
+	et, err := repo.Client.DiningTable.UpdateOneID(table.ID).
+		SetName(table.Name).
+		SetSeats(table.Seats).
+		SetQrToken(table.QRToken).
+		SetEnabled(table.Enabled).
+		SetSortOrder(table.SortOrder).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrDiningTableNotExists)
+		}
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to update dining table: %w", err)
+	}
+
+	table.UpdatedAt = et.UpdatedAt
+	return nil
+}
+
+func (repo *DiningTableRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DiningTableRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.DiningTable.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrDiningTableNotExists)
+		}
+		return fmt.Errorf("failed to delete dining table: %w", err)
+	}
+	return nil
+}
+
+func (repo *DiningTableRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.DiningTable, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DiningTableRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	et, err := repo.Client.DiningTable.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrDiningTableNotExists)
+		}
+		return nil, fmt.Errorf("failed to find dining table: %w", err)
+	}
+	return convertDiningTableToDomain(et), nil
+}
+
+func (repo *DiningTableRepository) FindByQRToken(ctx context.Context, token string) (res *domain.DiningTable, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DiningTableRepository.FindByQRToken")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	et, err := repo.Client.DiningTable.Query().
+		Where(diningtable.QrToken(token)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrDiningTableNotExists)
+		}
+		return nil, fmt.Errorf("failed to find dining table by qr token: %w", err)
+	}
+	return convertDiningTableToDomain(et), nil
+}
+
+func (repo *DiningTableRepository) Exists(ctx context.Context, params domain.DiningTableExistsParams) (exists bool, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DiningTableRepository.Exists")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.DiningTable.Query().
+		Where(diningtable.StoreID(params.StoreID))
+	if params.Name != "" {
+		query = query.Where(diningtable.Name(params.Name))
+	}
+	if params.ExcludeID != uuid.Nil {
+		query = query.Where(diningtable.IDNEQ(params.ExcludeID))
+	}
+
+	exists, err = query.Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check dining table existence: %w", err)
+	}
+	return exists, nil
+}
+
+func (repo *DiningTableRepository) ListByStoreID(ctx context.Context, storeID uuid.UUID) (res []*domain.DiningTable, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "DiningTableRepository.ListByStoreID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	tables, err := repo.Client.DiningTable.Query().
+		Where(diningtable.StoreID(storeID)).
+		Order(ent.Asc(diningtable.FieldSortOrder), ent.Asc(diningtable.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dining tables: %w", err)
+	}
+	return lo.Map(tables, func(item *ent.DiningTable, _ int) *domain.DiningTable {
+		return convertDiningTableToDomain(item)
+	}), nil
+}
+
+func convertDiningTableToDomain(et *ent.DiningTable) *domain.DiningTable {
+	if et == nil {
+		return nil
+	}
+	return &domain.DiningTable{
+		ID:         et.ID,
+		MerchantID: et.MerchantID,
+		StoreID:    et.StoreID,
+		Name:       et.Name,
+		Seats:      et.Seats,
+		QRToken:    et.QrToken,
+		Enabled:    et.Enabled,
+		SortOrder:  et.SortOrder,
+		CreatedAt:  et.CreatedAt,
+		UpdatedAt:  et.UpdatedAt,
+	}
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type DiningTableRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *DiningTableRepository
+	ctx  context.Context
+}
+
+func TestDiningTableRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(DiningTableRepositoryTestSuite))
+}
+
+func (s *DiningTableRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &DiningTableRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *DiningTableRepositoryTestSuite) newTable(storeID uuid.UUID, name string, sortOrder int) *domain.DiningTable {
+	token, err := domain.NewDiningTableQRToken()
+	require.NoError(s.T(), err)
+	return &domain.DiningTable{
+		ID:         uuid.New(),
+		MerchantID: uuid.New(),
+		StoreID:    storeID,
+		Name:       name,
+		Seats:      4,
+		QRToken:    token,
+		Enabled:    true,
+		SortOrder:  sortOrder,
+	}
+}
+
+func (s *DiningTableRepositoryTestSuite) TestDiningTable_Save() {
+	storeID := uuid.New()
+	a1 := s.newTable(storeID, "A1", 2)
+	a2 := s.newTable(storeID, "A2", 1)
+	require.NoError(s.T(), s.repo.Create(s.ctx, a1))
+	require.NoError(s.T(), s.repo.Create(s.ctx, a2))
+
+	s.T().Run("同门店名称唯一", func(t *testing.T) {
+		exists, err := s.repo.Exists(s.ctx, domain.DiningTableExistsParams{StoreID: storeID, Name: "A1"})
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		exists, err = s.repo.Exists(s.ctx, domain.DiningTableExistsParams{StoreID: storeID, Name: "A1", ExcludeID: a1.ID})
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		dup := s.newTable(storeID, "A1", 0)
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, dup)))
+	})
+
+	s.T().Run("按二维码令牌查询", func(t *testing.T) {
+		got, err := s.repo.FindByQRToken(s.ctx, a1.QRToken)
+		require.NoError(t, err)
+		require.Equal(t, a1.ID, got.ID)
+		require.Equal(t, storeID, got.StoreID)
+
+		_, err = s.repo.FindByQRToken(s.ctx, "NOTEXISTS")
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("更新并重置二维码", func(t *testing.T) {
+		oldToken := a1.QRToken
+		token, err := domain.NewDiningTableQRToken()
+		require.NoError(t, err)
+		a1.QRToken = token
+		a1.Seats = 6
+		a1.Enabled = false
+		require.NoError(t, s.repo.Update(s.ctx, a1))
+
+		got, err := s.repo.FindByID(s.ctx, a1.ID)
+		require.NoError(t, err)
+		require.Equal(t, 6, got.Seats)
+		require.False(t, got.Enabled)
+		require.Equal(t, token, got.QRToken)
+
+		_, err = s.repo.FindByQRToken(s.ctx, oldToken)
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("按排序查询门店桌台", func(t *testing.T) {
+		tables, err := s.repo.ListByStoreID(s.ctx, storeID)
+		require.NoError(t, err)
+		require.Len(t, tables, 2)
+		require.Equal(t, "A2", tables[0].Name)
+		require.Equal(t, "A1", tables[1].Name)
+	})
+
+	s.T().Run("删除后名称可复用", func(t *testing.T) {
+		require.NoError(t, s.repo.Delete(s.ctx, a2.ID))
+		_, err := s.repo.FindByID(s.ctx, a2.ID)
+		require.True(t, domain.IsNotFound(err))
+
+		require.NoError(t, s.repo.Create(s.ctx, s.newTable(storeID, "A2", 0)))
+	})
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/kitchenticket"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.KitchenTicketRepository = (*KitchenTicketRepository)(nil)
+
+type KitchenTicketRepository struct {
+	Client *ent.Client
+}
+
+func NewKitchenTicketRepository(client *ent.Client) *KitchenTicketRepository {
+	return &KitchenTicketRepository{
+		Client: client,
+	}
+}
+
+func (repo *KitchenTicketRepository) Create(ctx context.Context, ticket *domain.KitchenTicket) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "KitchenTicketRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if ticket == nil {
+		return fmt.Errorf("kitchen ticket is nil")
+	}
+
+	et, err := repo.Client.KitchenTicket.Create().
+		SetID(ticket.ID).
+		SetMerchantID(ticket.MerchantID).
+		SetStoreID(ticket.StoreID).
+		SetOrderID(ticket.OrderID).
+		SetOrderNo(ticket.OrderNo).
+		SetTableID(ticket.TableID).
+		SetTableName(ticket.TableName).
+		SetPickupNo(ticket.PickupNo).
+		SetBatch(ticket.Batch).
+		SetSource(ticket.Source).
+		SetStatus(ticket.Status).
+		SetItems(ticket.Items).
+		SetRemark(ticket.Remark).
+		SetNillablePrintedAt(ticket.PrintedAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create kitchen ticket: %w", err)
+	}
+
+	ticket.CreatedAt = et.CreatedAt
+	ticket.UpdatedAt = et.UpdatedAt
+	return nil
+}
+
+func (repo *KitchenTicketRepository) ListByStatus(ctx context.Context, storeID uuid.UUID, status domain.KitchenTicketStatus) (res []*domain.KitchenTicket, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "KitchenTicketRepository.ListByStatus")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	tickets, err := repo.Client.KitchenTicket.Query().
+		Where(
+			kitchenticket.StoreID(storeID),
+			kitchenticket.StatusEQ(status),
+		).
+		Order(ent.Asc(kitchenticket.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kitchen tickets: %w", err)
+	}
+	return lo.Map(tickets, func(item *ent.KitchenTicket, _ int) *domain.KitchenTicket {
+		return convertKitchenTicketToDomain(item)
+	}), nil
+}
+
+func (repo *KitchenTicketRepository) ListByOrderID(ctx context.Context, orderID uuid.UUID) (res []*domain.KitchenTicket, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "KitchenTicketRepository.ListByOrderID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	tickets, err := repo.Client.KitchenTicket.Query().
+		Where(kitchenticket.OrderID(orderID)).
+		Order(ent.Asc(kitchenticket.FieldBatch)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kitchen tickets: %w", err)
+	}
+	return lo.Map(tickets, func(item *ent.KitchenTicket, _ int) *domain.KitchenTicket {
+		return convertKitchenTicketToDomain(item)
+	}), nil
+}
+
+func (repo *KitchenTicketRepository) ReleaseByOrderID(ctx context.Context, orderID uuid.UUID) (n int, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "KitchenTicketRepository.ReleaseByOrderID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	n, err = repo.Client.KitchenTicket.Update().
+		Where(
+			kitchenticket.OrderID(orderID),
+			kitchenticket.StatusEQ(domain.KitchenTicketStatusHeld),
+		).
+		SetStatus(domain.KitchenTicketStatusPending).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to release kitchen tickets: %w", err)
+	}
+	return n, nil
+}
+
+func (repo *KitchenTicketRepository) MarkPrinted(ctx context.Context, storeID uuid.UUID, ids []uuid.UUID, at time.Time) (n int, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "KitchenTicketRepository.MarkPrinted")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	n, err = repo.Client.KitchenTicket.Update().
+		Where(
+			kitchenticket.StoreID(storeID),
+			kitchenticket.IDIn(ids...),
+			kitchenticket.StatusEQ(domain.KitchenTicketStatusPending),
+		).
+		SetStatus(domain.KitchenTicketStatusPrinted).
+		SetPrintedAt(at).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark kitchen tickets printed: %w", err)
+	}
+	return n, nil
+}
+
+func convertKitchenTicketToDomain(et *ent.KitchenTicket) *domain.KitchenTicket {
+	if et == nil {
+		return nil
+	}
+	return &domain.KitchenTicket{
+		ID:         et.ID,
+		MerchantID: et.MerchantID,
+		StoreID:    et.StoreID,
+		OrderID:    et.OrderID,
+		OrderNo:    et.OrderNo,
+		TableID:    et.TableID,
+		TableName:  et.TableName,
+		PickupNo:   et.PickupNo,
+		Batch:      et.Batch,
+		Source:     et.Source,
+		Status:     et.Status,
+		Items:      et.Items,
+		Remark:     et.Remark,
+		PrintedAt:  et.PrintedAt,
+		CreatedAt:  et.CreatedAt,
+		UpdatedAt:  et.UpdatedAt,
+	}
+}
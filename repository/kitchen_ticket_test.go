@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type KitchenTicketRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *KitchenTicketRepository
+	ctx  context.Context
+}
+
+func TestKitchenTicketRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(KitchenTicketRepositoryTestSuite))
+}
+
+func (s *KitchenTicketRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &KitchenTicketRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *KitchenTicketRepositoryTestSuite) TestKitchenTicket_Flow() {
+	storeID := uuid.New()
+	stallID := uuid.New()
+	productID := uuid.New()
+	order := &domain.Order{
+		ID:         uuid.New(),
+		MerchantID: uuid.New(),
+		StoreID:    storeID,
+		OrderNo:    "S00120261021000001",
+		TableID:    uuid.New(),
+		TableName:  "A1",
+		Channel:    domain.ChannelH5,
+		OrderProducts: []domain.OrderProduct{
+			{Index: 1, ProductID: productID, ProductName: "宫保鸡丁", Qty: 1},
+			{Index: 2, ProductID: productID, ProductName: "宫保鸡丁", Qty: 2, Note: "少辣"},
+		},
+	}
+	stallIDs := map[uuid.UUID]uuid.UUID{productID: stallID}
+
+	pending := domain.NewKitchenTicket(order, 1, false, stallIDs)
+	require.NoError(s.T(), s.repo.Create(s.ctx, pending))
+	held := domain.NewKitchenTicket(order, 2, true, stallIDs)
+	require.NoError(s.T(), s.repo.Create(s.ctx, held))
+
+	s.T().Run("按批次生成出品商品", func(t *testing.T) {
+		tickets, err := s.repo.ListByStatus(s.ctx, storeID, domain.KitchenTicketStatusHeld)
+		require.NoError(t, err)
+		require.Len(t, tickets, 1)
+		require.Equal(t, 2, tickets[0].Batch)
+		require.Len(t, tickets[0].Items, 1)
+		require.Equal(t, 2, tickets[0].Items[0].Qty)
+		require.Equal(t, "少辣", tickets[0].Items[0].Note)
+		require.Equal(t, stallID, tickets[0].Items[0].StallID)
+	})
+
+	s.T().Run("订单支付后放行", func(t *testing.T) {
+		n, err := s.repo.ReleaseByOrderID(s.ctx, order.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		tickets, err := s.repo.ListByStatus(s.ctx, storeID, domain.KitchenTicketStatusPending)
+		require.NoError(t, err)
+		require.Len(t, tickets, 2)
+		require.Equal(t, pending.ID, tickets[0].ID)
+	})
+
+	s.T().Run("标记已出单", func(t *testing.T) {
+		n, err := s.repo.MarkPrinted(s.ctx, uuid.New(), []uuid.UUID{pending.ID}, time.Now())
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+
+		n, err = s.repo.MarkPrinted(s.ctx, storeID, []uuid.UUID{pending.ID, held.ID}, time.Now())
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+
+		tickets, err := s.repo.ListByStatus(s.ctx, storeID, domain.KitchenTicketStatusPending)
+		require.NoError(t, err)
+		require.Empty(t, tickets)
+
+		tickets, err = s.repo.ListByStatus(s.ctx, storeID, domain.KitchenTicketStatusPrinted)
+		require.NoError(t, err)
+		require.Len(t, tickets, 2)
+		require.NotNil(t, tickets[0].PrintedAt)
+	})
+
+	s.T().Run("查询订单厨房单", func(t *testing.T) {
+		tickets, err := s.repo.ListByOrderID(s.ctx, order.ID)
+		require.NoError(t, err)
+		require.Len(t, tickets, 2)
+		require.Equal(t, 1, tickets[0].Batch)
+		require.Equal(t, 2, tickets[1].Batch)
+
+		tickets, err = s.repo.ListByOrderID(s.ctx, uuid.New())
+		require.NoError(t, err)
+		require.Empty(t, tickets)
+	})
+}
@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/member"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/membertier"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.MemberRepository = (*MemberRepository)(nil)
+
+type MemberRepository struct {
+	Client *ent.Client
+}
+
+func NewMemberRepository(client *ent.Client) *MemberRepository {
+	return &MemberRepository{
+		Client: client,
+	}
+}
+
+// Create 创建会员
+func (repo *MemberRepository) Create(ctx context.Context, m *domain.Member) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if m == nil {
+		return fmt.Errorf("member is nil")
+	}
+
+	em, err := repo.Client.Member.Create().
+		SetID(m.ID).
+		SetMerchantID(m.MerchantID).
+		SetTierID(m.TierID).
+		SetCode(m.Code).
+		SetName(m.Name).
+		SetPhone(m.Phone).
+		SetOpenid(m.OpenID).
+		SetGender(m.Gender).
+		SetNillableBirthday(m.Birthday).
+		SetEnabled(m.Enabled).
+		SetRemark(m.Remark).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create member: %w", err)
+	}
+
+	m.CreatedAt = em.CreatedAt
+	m.UpdatedAt = em.UpdatedAt
+	return nil
+}
+
+// Update 更新会员
+func (repo *MemberRepository) Update(ctx context.Context, m *domain.Member) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if m == nil {
+		return fmt.Errorf("member is nil")
+	}
+
+	builder := repo.Client.Member.UpdateOneID(m.ID).
+		SetTierID(m.TierID).
+		SetName(m.Name).
+		SetPhone(m.Phone).
+		SetOpenid(m.OpenID).
+		SetGender(m.Gender).
+		SetEnabled(m.Enabled).
+		SetRemark(m.Remark)
+	if m.Birthday != nil {
+		builder = builder.SetBirthday(*m.Birthday)
+	} else {
+		builder = builder.ClearBirthday()
+	}
+
+	em, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrMemberNotExists)
+		}
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to update member: %w", err)
+	}
+
+	m.UpdatedAt = em.UpdatedAt
+	return nil
+}
+
+// Delete 删除会员
+func (repo *MemberRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.Member.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrMemberNotExists)
+		}
+		return fmt.Errorf("failed to delete member: %w", err)
+	}
+	return nil
+}
+
+// FindByID 根据ID查询会员
+func (repo *MemberRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.Member, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return repo.findOne(ctx, member.ID(id))
+}
+
+// FindByPhone 根据手机号查询会员
+func (repo *MemberRepository) FindByPhone(ctx context.Context, merchantID uuid.UUID, phone string) (res *domain.Member, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberRepository.FindByPhone")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return repo.findOne(ctx, member.MerchantID(merchantID), member.Phone(phone))
+}
+
+// FindByCode 根据会员码查询会员
+func (repo *MemberRepository) FindByCode(ctx context.Context, merchantID uuid.UUID, code string) (res *domain.Member, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberRepository.FindByCode")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return repo.findOne(ctx, member.MerchantID(merchantID), member.Code(code))
+}
+
+// FindByOpenID 根据微信 openid 查询会员
+func (repo *MemberRepository) FindByOpenID(ctx context.Context, merchantID uuid.UUID, openID string) (res *domain.Member, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberRepository.FindByOpenID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if openID == "" {
+		return nil, domain.NotFoundError(domain.ErrMemberNotExists)
+	}
+	return repo.findOne(ctx, member.MerchantID(merchantID), member.Openid(openID))
+}
+
+// Exists 检查手机号、openid 是否已被其他会员使用，或等级下是否有会员
+func (repo *MemberRepository) Exists(ctx context.Context, params domain.MemberExistsParams) (exists bool, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberRepository.Exists")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.Member.Query().Where(member.MerchantID(params.MerchantID))
+	if params.Phone != "" {
+		query.Where(member.Phone(params.Phone))
+	}
+	if params.OpenID != "" {
+		query.Where(member.Openid(params.OpenID))
+	}
+	if params.TierID != uuid.Nil {
+		query.Where(member.TierID(params.TierID))
+	}
+	if params.ExcludeID != uuid.Nil {
+		query.Where(member.IDNEQ(params.ExcludeID))
+	}
+	return query.Exist(ctx)
+}
+
+// PagedListBySearch 分页查询会员
+func (repo *MemberRepository) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.MemberSearchParams,
+) (res *domain.MemberSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberRepository.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.Member.Query().Where(member.MerchantID(params.MerchantID))
+	if params.TierID != uuid.Nil {
+		query.Where(member.TierID(params.TierID))
+	}
+	if params.Keyword != "" {
+		query.Where(member.Or(
+			member.NameContains(params.Keyword),
+			member.PhoneContains(params.Keyword),
+		))
+	}
+	if params.Enabled != nil {
+		query.Where(member.Enabled(*params.Enabled))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := query.
+		Offset(page.Offset()).
+		Limit(page.Size).
+		Order(ent.Desc(member.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(domain.Members, 0, len(members))
+	for _, em := range members {
+		items = append(items, convertMemberToDomain(em))
+	}
+	if err = repo.loadTiers(ctx, items); err != nil {
+		return nil, err
+	}
+
+	page.SetTotal(total)
+
+	return &domain.MemberSearchRes{
+		Pagination: page,
+		Items:      items,
+	}, nil
+}
+
+func (repo *MemberRepository) findOne(ctx context.Context, ps ...predicate.Member) (*domain.Member, error) {
+	em, err := repo.Client.Member.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrMemberNotExists)
+		}
+		return nil, err
+	}
+	m := convertMemberToDomain(em)
+	if err = repo.loadTiers(ctx, domain.Members{m}); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// loadTiers 批量加载会员等级，等级已删除时忽略
+func (repo *MemberRepository) loadTiers(ctx context.Context, members domain.Members) error {
+	tierIDs := lo.Uniq(lo.FilterMap(members, func(m *domain.Member, _ int) (uuid.UUID, bool) {
+		return m.TierID, m.TierID != uuid.Nil
+	}))
+	if len(tierIDs) == 0 {
+		return nil
+	}
+
+	tiers, err := repo.Client.MemberTier.Query().Where(membertier.IDIn(tierIDs...)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load member tiers: %w", err)
+	}
+	tierMap := lo.KeyBy(tiers, func(et *ent.MemberTier) uuid.UUID { return et.ID })
+	for _, m := range members {
+		if et, ok := tierMap[m.TierID]; ok {
+			m.Tier = convertMemberTierToDomain(et)
+		}
+	}
+	return nil
+}
+
+func convertMemberToDomain(em *ent.Member) *domain.Member {
+	if em == nil {
+		return nil
+	}
+	return &domain.Member{
+		ID:         em.ID,
+		MerchantID: em.MerchantID,
+		TierID:     em.TierID,
+		Code:       em.Code,
+		Name:       em.Name,
+		Phone:      em.Phone,
+		OpenID:     em.Openid,
+		Gender:     em.Gender,
+		Birthday:   em.Birthday,
+		Enabled:    em.Enabled,
+		Remark:     em.Remark,
+		CreatedAt:  em.CreatedAt,
+		UpdatedAt:  em.UpdatedAt,
+	}
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.MemberAccountRepository = (*MemberAccountRepository)(nil)
+
+type MemberAccountRepository struct {
+	Client *ent.Client
+}
+
+func NewMemberAccountRepository(client *ent.Client) *MemberAccountRepository {
+	return &MemberAccountRepository{
+		Client: client,
+	}
+}
+
+// Create 开立储值账户
+func (repo *MemberAccountRepository) Create(ctx context.Context, account *domain.MemberAccount) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberAccountRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if account == nil {
+		return fmt.Errorf("member account is nil")
+	}
+
+	ea, err := repo.Client.MemberAccount.Create().
+		SetID(account.ID).
+		SetMerchantID(account.MerchantID).
+		SetMemberID(account.MemberID).
+		SetBalance(account.Balance).
+		SetBonusBalance(account.BonusBalance).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create member account: %w", err)
+	}
+
+	account.CreatedAt = ea.CreatedAt
+	account.UpdatedAt = ea.UpdatedAt
+	return nil
+}
+
+// UpdateBalance 更新账户余额，调用方需先锁定账户
+func (repo *MemberAccountRepository) UpdateBalance(ctx context.Context, account *domain.MemberAccount) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberAccountRepository.UpdateBalance")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if account == nil {
+		return fmt.Errorf("member account is nil")
+	}
+
+	ea, err := repo.Client.MemberAccount.UpdateOneID(account.ID).
+		SetBalance(account.Balance).
+		SetBonusBalance(account.BonusBalance).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrMemberAccountNotExists)
+		}
+		return fmt.Errorf("failed to update member account balance: %w", err)
+	}
+
+	account.UpdatedAt = ea.UpdatedAt
+	return nil
+}
+
+// FindByMemberID 根据会员查询储值账户
+func (repo *MemberAccountRepository) FindByMemberID(ctx context.Context, memberID uuid.UUID) (res *domain.MemberAccount, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberAccountRepository.FindByMemberID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ea, err := repo.Client.MemberAccount.Query().
+		Where(memberaccount.MemberID(memberID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrMemberAccountNotExists)
+		}
+		return nil, err
+	}
+	return convertMemberAccountToDomain(ea), nil
+}
+
+// FindByMemberIDForUpdate 锁定查询储值账户（用于余额变动的并发控制）
+func (repo *MemberAccountRepository) FindByMemberIDForUpdate(ctx context.Context, memberID uuid.UUID) (res *domain.MemberAccount, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberAccountRepository.FindByMemberIDForUpdate")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ea, err := repo.Client.MemberAccount.Query().
+		Where(memberaccount.MemberID(memberID)).
+		ForUpdate(). // 行级锁：防止并发扣款导致余额透支
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrMemberAccountNotExists)
+		}
+		return nil, err
+	}
+	return convertMemberAccountToDomain(ea), nil
+}
+
+// SumBalance 汇总品牌商全部储值账户余额
+func (repo *MemberAccountRepository) SumBalance(ctx context.Context, merchantID uuid.UUID) (balance, bonusBalance decimal.Decimal, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberAccountRepository.SumBalance")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	var rows []struct {
+		Balance      decimal.Decimal `json:"balance"`
+		BonusBalance decimal.Decimal `json:"bonus_balance"`
+	}
+	err = repo.Client.MemberAccount.Query().
+		Where(memberaccount.MerchantID(merchantID)).
+		Modify(func(s *entsql.Selector) {
+			s.Select().
+				AppendSelectExprAs(entsql.Raw(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(memberaccount.FieldBalance))), "balance").
+				AppendSelectExprAs(entsql.Raw(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(memberaccount.FieldBonusBalance))), "bonus_balance")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("failed to sum member account balance: %w", err)
+	}
+	if len(rows) == 0 {
+		return decimal.Zero, decimal.Zero, nil
+	}
+	return rows[0].Balance, rows[0].BonusBalance, nil
+}
+
+func convertMemberAccountToDomain(ea *ent.MemberAccount) *domain.MemberAccount {
+	if ea == nil {
+		return nil
+	}
+	return &domain.MemberAccount{
+		ID:           ea.ID,
+		MerchantID:   ea.MerchantID,
+		MemberID:     ea.MemberID,
+		Balance:      ea.Balance,
+		BonusBalance: ea.BonusBalance,
+		CreatedAt:    ea.CreatedAt,
+		UpdatedAt:    ea.UpdatedAt,
+	}
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type MemberAccountRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *MemberAccountRepository
+	ctx  context.Context
+}
+
+func TestMemberAccountRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemberAccountRepositoryTestSuite))
+}
+
+func (s *MemberAccountRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &MemberAccountRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *MemberAccountRepositoryTestSuite) TestMemberAccount_Balance() {
+	merchantID := uuid.New()
+	member := &domain.Member{ID: uuid.New(), MerchantID: merchantID}
+	account := domain.NewMemberAccount(member)
+	require.NoError(s.T(), s.repo.Create(s.ctx, account))
+
+	s.T().Run("每个会员只有一个账户", func(t *testing.T) {
+		dup := domain.NewMemberAccount(member)
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, dup)))
+	})
+
+	s.T().Run("更新余额", func(t *testing.T) {
+		_, err := account.TopUp(decimal.RequireFromString("100.10"), decimal.RequireFromString("20"))
+		require.NoError(t, err)
+		_, err = account.Pay(decimal.RequireFromString("100.30"))
+		require.NoError(t, err)
+		require.NoError(t, s.repo.UpdateBalance(s.ctx, account))
+
+		found, err := s.repo.FindByMemberID(s.ctx, member.ID)
+		require.NoError(t, err)
+		require.True(t, found.Balance.IsZero())
+		require.True(t, decimal.RequireFromString("19.80").Equal(found.BonusBalance), found.BonusBalance.String())
+
+		_, err = s.repo.FindByMemberID(s.ctx, uuid.New())
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("汇总余额", func(t *testing.T) {
+		other := domain.NewMemberAccount(&domain.Member{ID: uuid.New(), MerchantID: merchantID})
+		_, err := other.TopUp(decimal.RequireFromString("50.05"), decimal.Zero)
+		require.NoError(t, err)
+		require.NoError(t, s.repo.Create(s.ctx, other))
+
+		balance, bonus, err := s.repo.SumBalance(s.ctx, merchantID)
+		require.NoError(t, err)
+		require.True(t, decimal.RequireFromString("50.05").Equal(balance), balance.String())
+		require.True(t, decimal.RequireFromString("19.80").Equal(bonus), bonus.String())
+
+		balance, bonus, err = s.repo.SumBalance(s.ctx, uuid.New())
+		require.NoError(t, err)
+		require.True(t, balance.IsZero())
+		require.True(t, bonus.IsZero())
+	})
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberaccounttransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.MemberAccountTransactionRepository = (*MemberAccountTransactionRepository)(nil)
+
+type MemberAccountTransactionRepository struct {
+	Client *ent.Client
+}
+
+func NewMemberAccountTransactionRepository(client *ent.Client) *MemberAccountTransactionRepository {
+	return &MemberAccountTransactionRepository{
+		Client: client,
+	}
+}
+
+// Create 追加储值流水
+func (repo *MemberAccountTransactionRepository) Create(ctx context.Context, txn *domain.MemberAccountTransaction) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberAccountTransactionRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if txn == nil {
+		return fmt.Errorf("member account transaction is nil")
+	}
+
+	et, err := repo.Client.MemberAccountTransaction.Create().
+		SetID(txn.ID).
+		SetMerchantID(txn.MerchantID).
+		SetStoreID(txn.StoreID).
+		SetMemberID(txn.MemberID).
+		SetAccountID(txn.AccountID).
+		SetTransactionNo(txn.TransactionNo).
+		SetType(txn.Type).
+		SetAmount(txn.Amount).
+		SetBonusAmount(txn.BonusAmount).
+		SetBalanceAfter(txn.BalanceAfter).
+		SetBonusBalanceAfter(txn.BonusBalanceAfter).
+		SetOrderID(txn.OrderID).
+		SetOrderNo(txn.OrderNo).
+		SetRefundOrderID(txn.RefundOrderID).
+		SetPaymentMethod(string(txn.PaymentMethod)).
+		SetOperatorID(txn.Operator.ID).
+		SetOperatorName(txn.Operator.Name).
+		SetRemark(txn.Remark).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create member account transaction: %w", err)
+	}
+
+	txn.CreatedAt = et.CreatedAt
+	return nil
+}
+
+// ListByOrderID 查询订单关联的储值流水（消费和退款）
+func (repo *MemberAccountTransactionRepository) ListByOrderID(ctx context.Context, orderID uuid.UUID) (res domain.MemberAccountTransactions, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberAccountTransactionRepository.ListByOrderID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	txns, err := repo.Client.MemberAccountTransaction.Query().
+		Where(memberaccounttransaction.OrderID(orderID)).
+		Order(ent.Asc(memberaccounttransaction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res = make(domain.MemberAccountTransactions, 0, len(txns))
+	for _, et := range txns {
+		res = append(res, convertMemberAccountTransactionToDomain(et))
+	}
+	return res, nil
+}
+
+// ExistsByRefundOrderID 检查退款单是否已退回储值
+func (repo *MemberAccountTransactionRepository) ExistsByRefundOrderID(ctx context.Context, refundOrderID uuid.UUID) (exists bool, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberAccountTransactionRepository.ExistsByRefundOrderID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return repo.Client.MemberAccountTransaction.Query().
+		Where(memberaccounttransaction.RefundOrderID(refundOrderID)).
+		Exist(ctx)
+}
+
+// PagedListBySearch 分页查询储值流水
+func (repo *MemberAccountTransactionRepository) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.MemberAccountTransactionSearchParams,
+) (res *domain.MemberAccountTransactionSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberAccountTransactionRepository.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.MemberAccountTransaction.Query().
+		Where(memberaccounttransaction.MerchantID(params.MerchantID))
+	if params.StoreID != uuid.Nil {
+		query.Where(memberaccounttransaction.StoreID(params.StoreID))
+	}
+	if params.MemberID != uuid.Nil {
+		query.Where(memberaccounttransaction.MemberID(params.MemberID))
+	}
+	if params.Type != "" {
+		query.Where(memberaccounttransaction.TypeEQ(params.Type))
+	}
+	if params.StartAt != nil {
+		query.Where(memberaccounttransaction.CreatedAtGTE(*params.StartAt))
+	}
+	if params.EndAt != nil {
+		query.Where(memberaccounttransaction.CreatedAtLTE(*params.EndAt))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txns, err := query.
+		Offset(page.Offset()).
+		Limit(page.Size).
+		Order(ent.Desc(memberaccounttransaction.FieldCreatedAt), ent.Desc(memberaccounttransaction.FieldTransactionNo)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(domain.MemberAccountTransactions, 0, len(txns))
+	for _, et := range txns {
+		items = append(items, convertMemberAccountTransactionToDomain(et))
+	}
+
+	page.SetTotal(total)
+
+	return &domain.MemberAccountTransactionSearchRes{
+		Pagination: page,
+		Items:      items,
+	}, nil
+}
+
+// LiabilityByStore 按发生门店汇总储值流水
+func (repo *MemberAccountTransactionRepository) LiabilityByStore(ctx context.Context, merchantID uuid.UUID) (res []*domain.MemberAccountLiabilityItem, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberAccountTransactionRepository.LiabilityByStore")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	var rows []domain.MemberAccountLiabilityItem
+	err = repo.Client.MemberAccountTransaction.Query().
+		Where(memberaccounttransaction.MerchantID(merchantID)).
+		Modify(func(s *entsql.Selector) {
+			typ := s.C(memberaccounttransaction.FieldType)
+			amount := s.C(memberaccounttransaction.FieldAmount)
+			bonus := s.C(memberaccounttransaction.FieldBonusAmount)
+			sumByType := func(t domain.MemberAccountTransactionType, expr string) string {
+				return fmt.Sprintf("COALESCE(SUM(CASE WHEN %s = '%s' THEN %s ELSE 0 END), 0)", typ, t, expr)
+			}
+			total := fmt.Sprintf("%s + %s", amount, bonus)
+			s.Select(s.C(memberaccounttransaction.FieldStoreID)).
+				AppendSelectExprAs(entsql.Raw(sumByType(domain.MemberAccountTransactionTypeTopUp, amount)), "top_up_amount").
+				AppendSelectExprAs(entsql.Raw(sumByType(domain.MemberAccountTransactionTypeTopUp, bonus)), "top_up_bonus").
+				// 消费流水为负数，取反后展示为消费金额
+				AppendSelectExprAs(entsql.Raw(sumByType(domain.MemberAccountTransactionTypePayment, fmt.Sprintf("-(%s)", total))), "payment_amount").
+				AppendSelectExprAs(entsql.Raw(sumByType(domain.MemberAccountTransactionTypeRefund, total)), "refund_amount").
+				AppendSelectExprAs(entsql.Raw(sumByType(domain.MemberAccountTransactionTypeAdjustment, total)), "adjustment_amount").
+				AppendSelectExprAs(entsql.Raw(fmt.Sprintf("COALESCE(SUM(%s), 0)", amount)), "balance").
+				AppendSelectExprAs(entsql.Raw(fmt.Sprintf("COALESCE(SUM(%s), 0)", bonus)), "bonus_balance").
+				GroupBy(s.C(memberaccounttransaction.FieldStoreID))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query member account liability: %w", err)
+	}
+
+	res = make([]*domain.MemberAccountLiabilityItem, len(rows))
+	for i := range rows {
+		res[i] = &rows[i]
+	}
+	return res, nil
+}
+
+func convertMemberAccountTransactionToDomain(et *ent.MemberAccountTransaction) *domain.MemberAccountTransaction {
+	if et == nil {
+		return nil
+	}
+	return &domain.MemberAccountTransaction{
+		ID:                et.ID,
+		MerchantID:        et.MerchantID,
+		StoreID:           et.StoreID,
+		MemberID:          et.MemberID,
+		AccountID:         et.AccountID,
+		TransactionNo:     et.TransactionNo,
+		Type:              et.Type,
+		Amount:            et.Amount,
+		BonusAmount:       et.BonusAmount,
+		BalanceAfter:      et.BalanceAfter,
+		BonusBalanceAfter: et.BonusBalanceAfter,
+		OrderID:           et.OrderID,
+		OrderNo:           et.OrderNo,
+		RefundOrderID:     et.RefundOrderID,
+		PaymentMethod:     domain.PaymentMethodPayType(et.PaymentMethod),
+		Operator: domain.OrderOperator{
+			ID:   et.OperatorID,
+			Name: et.OperatorName,
+		},
+		Remark:    et.Remark,
+		CreatedAt: et.CreatedAt,
+	}
+}
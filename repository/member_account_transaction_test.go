@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type MemberAccountTransactionRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *MemberAccountTransactionRepository
+	ctx  context.Context
+	seq  int
+}
+
+func TestMemberAccountTransactionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemberAccountTransactionRepositoryTestSuite))
+}
+
+func (s *MemberAccountTransactionRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &MemberAccountTransactionRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *MemberAccountTransactionRepositoryTestSuite) save(txn *domain.MemberAccountTransaction, err error, storeID uuid.UUID) *domain.MemberAccountTransaction {
+	require.NoError(s.T(), err)
+	s.seq++
+	txn.StoreID = storeID
+	txn.TransactionNo = fmt.Sprintf("SV20261019%08d", s.seq)
+	require.NoError(s.T(), s.repo.Create(s.ctx, txn))
+	return txn
+}
+
+func (s *MemberAccountTransactionRepositoryTestSuite) TestMemberAccountTransaction_Order() {
+	account := domain.NewMemberAccount(&domain.Member{ID: uuid.New(), MerchantID: uuid.New()})
+	storeID := uuid.New()
+	txn, err := account.TopUp(decimal.NewFromInt(100), decimal.NewFromInt(10))
+	s.save(txn, err, storeID)
+
+	orderID := uuid.New()
+	pay, err := account.Pay(decimal.RequireFromString("105.50"))
+	pay.OrderID = orderID
+	s.save(pay, err, storeID)
+
+	s.T().Run("流水号唯一", func(t *testing.T) {
+		dup := *pay
+		dup.ID = uuid.New()
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, &dup)))
+	})
+
+	s.T().Run("订单退回储值", func(t *testing.T) {
+		txns, err := s.repo.ListByOrderID(s.ctx, orderID)
+		require.NoError(t, err)
+		require.Len(t, txns, 1)
+
+		principal, bonus, err := txns.RefundableSplit(decimal.RequireFromString("102"))
+		require.NoError(t, err)
+		require.True(t, decimal.NewFromInt(100).Equal(principal), principal.String())
+		require.True(t, decimal.NewFromInt(2).Equal(bonus), bonus.String())
+
+		_, _, err = txns.RefundableSplit(decimal.RequireFromString("105.51"))
+		require.ErrorIs(t, err, domain.ErrMemberAccountRefundExceeded)
+
+		refundOrderID := uuid.New()
+		refund, err := account.Refund(principal, bonus)
+		refund.OrderID = orderID
+		refund.RefundOrderID = refundOrderID
+		s.save(refund, err, storeID)
+
+		exists, err := s.repo.ExistsByRefundOrderID(s.ctx, refundOrderID)
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		txns, err = s.repo.ListByOrderID(s.ctx, orderID)
+		require.NoError(t, err)
+		_, _, err = txns.RefundableSplit(decimal.RequireFromString("3.51"))
+		require.ErrorIs(t, err, domain.ErrMemberAccountRefundExceeded)
+	})
+}
+
+func (s *MemberAccountTransactionRepositoryTestSuite) TestMemberAccountTransaction_Liability() {
+	merchantID := uuid.New()
+	storeA, storeB := uuid.New(), uuid.New()
+	alice := domain.NewMemberAccount(&domain.Member{ID: uuid.New(), MerchantID: merchantID})
+	bob := domain.NewMemberAccount(&domain.Member{ID: uuid.New(), MerchantID: merchantID})
+
+	txn, err := alice.TopUp(decimal.RequireFromString("200"), decimal.RequireFromString("20"))
+	s.save(txn, err, storeA)
+	txn, err = bob.TopUp(decimal.RequireFromString("0.30"), decimal.Zero)
+	s.save(txn, err, storeA)
+	txn, err = alice.Pay(decimal.RequireFromString("210.10"))
+	s.save(txn, err, storeB)
+	txn, err = bob.Adjust(decimal.RequireFromString("-0.10"), decimal.Zero, "收银差错")
+	s.save(txn, err, uuid.Nil)
+
+	s.T().Run("按门店汇总", func(t *testing.T) {
+		items, err := s.repo.LiabilityByStore(s.ctx, merchantID)
+		require.NoError(t, err)
+		require.Len(t, items, 3)
+
+		byStore := make(map[uuid.UUID]*domain.MemberAccountLiabilityItem)
+		total := decimal.Zero
+		for _, item := range items {
+			byStore[item.StoreID] = item
+			total = total.Add(item.Balance).Add(item.BonusBalance)
+		}
+		require.True(t, decimal.RequireFromString("200.30").Equal(byStore[storeA].TopUpAmount))
+		require.True(t, decimal.RequireFromString("20").Equal(byStore[storeA].TopUpBonus))
+		require.True(t, decimal.RequireFromString("210.10").Equal(byStore[storeB].PaymentAmount))
+		require.True(t, decimal.RequireFromString("-0.10").Equal(byStore[uuid.Nil].AdjustmentAmount))
+
+		// 流水合计等于账户余额合计
+		require.True(t, alice.TotalBalance().Add(bob.TotalBalance()).Equal(total), total.String())
+	})
+
+	s.T().Run("分页查询", func(t *testing.T) {
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.MemberAccountTransactionSearchParams{
+			MerchantID: merchantID,
+			MemberID:   alice.MemberID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, res.Total)
+
+		res, err = s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.MemberAccountTransactionSearchParams{
+			MerchantID: merchantID,
+			Type:       domain.MemberAccountTransactionTypeTopUp,
+			StoreID:    storeA,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, res.Total)
+	})
+}
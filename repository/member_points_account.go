@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsaccount"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.MemberPointsAccountRepository = (*MemberPointsAccountRepository)(nil)
+
+type MemberPointsAccountRepository struct {
+	Client *ent.Client
+}
+
+func NewMemberPointsAccountRepository(client *ent.Client) *MemberPointsAccountRepository {
+	return &MemberPointsAccountRepository{
+		Client: client,
+	}
+}
+
+// Create 开立积分账户
+func (repo *MemberPointsAccountRepository) Create(ctx context.Context, account *domain.MemberPointsAccount) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsAccountRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if account == nil {
+		return fmt.Errorf("member points account is nil")
+	}
+
+	ea, err := repo.Client.MemberPointsAccount.Create().
+		SetID(account.ID).
+		SetMerchantID(account.MerchantID).
+		SetMemberID(account.MemberID).
+		SetBalance(account.Balance).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create member points account: %w", err)
+	}
+
+	account.CreatedAt = ea.CreatedAt
+	account.UpdatedAt = ea.UpdatedAt
+	return nil
+}
+
+// UpdateBalance 更新积分余额，调用方需先锁定账户
+func (repo *MemberPointsAccountRepository) UpdateBalance(ctx context.Context, account *domain.MemberPointsAccount) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsAccountRepository.UpdateBalance")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if account == nil {
+		return fmt.Errorf("member points account is nil")
+	}
+
+	ea, err := repo.Client.MemberPointsAccount.UpdateOneID(account.ID).
+		SetBalance(account.Balance).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrMemberPointsAccountNotExists)
+		}
+		return fmt.Errorf("failed to update member points account balance: %w", err)
+	}
+
+	account.UpdatedAt = ea.UpdatedAt
+	return nil
+}
+
+// FindByMemberID 根据会员查询积分账户
+func (repo *MemberPointsAccountRepository) FindByMemberID(ctx context.Context, memberID uuid.UUID) (res *domain.MemberPointsAccount, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsAccountRepository.FindByMemberID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ea, err := repo.Client.MemberPointsAccount.Query().
+		Where(memberpointsaccount.MemberID(memberID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrMemberPointsAccountNotExists)
+		}
+		return nil, err
+	}
+	return convertMemberPointsAccountToDomain(ea), nil
+}
+
+// FindByMemberIDForUpdate 锁定查询积分账户（用于积分变动的并发控制）
+func (repo *MemberPointsAccountRepository) FindByMemberIDForUpdate(ctx context.Context, memberID uuid.UUID) (res *domain.MemberPointsAccount, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsAccountRepository.FindByMemberIDForUpdate")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ea, err := repo.Client.MemberPointsAccount.Query().
+		Where(memberpointsaccount.MemberID(memberID)).
+		ForUpdate(). // 行级锁：同时串行化该账户入账流水剩余积分的扣减
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrMemberPointsAccountNotExists)
+		}
+		return nil, err
+	}
+	return convertMemberPointsAccountToDomain(ea), nil
+}
+
+func convertMemberPointsAccountToDomain(ea *ent.MemberPointsAccount) *domain.MemberPointsAccount {
+	if ea == nil {
+		return nil
+	}
+	return &domain.MemberPointsAccount{
+		ID:         ea.ID,
+		MerchantID: ea.MerchantID,
+		MemberID:   ea.MemberID,
+		Balance:    ea.Balance,
+		CreatedAt:  ea.CreatedAt,
+		UpdatedAt:  ea.UpdatedAt,
+	}
+}
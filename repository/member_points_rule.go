@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointsrule"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.MemberPointsRuleRepository = (*MemberPointsRuleRepository)(nil)
+
+type MemberPointsRuleRepository struct {
+	Client *ent.Client
+}
+
+func NewMemberPointsRuleRepository(client *ent.Client) *MemberPointsRuleRepository {
+	return &MemberPointsRuleRepository{
+		Client: client,
+	}
+}
+
+func (repo *MemberPointsRuleRepository) Create(ctx context.Context, rule *domain.MemberPointsRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsRuleRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("member points rule is nil")
+	}
+
+	er, err := repo.Client.MemberPointsRule.Create().
+		SetID(rule.ID).
+		SetMerchantID(rule.MerchantID).
+		SetEnabled(rule.Enabled).
+		SetEarnRate(rule.EarnRate).
+		SetCategoryRates(rule.CategoryRates).
+		SetTierMultipliers(rule.TierMultipliers).
+		SetExpireDays(rule.ExpireDays).
+		SetRedeemEnabled(rule.RedeemEnabled).
+		SetRedeemRate(rule.RedeemRate).
+		SetRedeemMinPoints(rule.RedeemMinPoints).
+		SetRedeemMaxPercent(rule.RedeemMaxPercent).
+		SetRedeemProducts(rule.RedeemProducts).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create member points rule: %w", err)
+	}
+
+	rule.CreatedAt = er.CreatedAt
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *MemberPointsRuleRepository) Update(ctx context.Context, rule *domain.MemberPointsRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsRuleRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("member points rule is nil")
+	}
+
+	er, err := repo.Client.MemberPointsRule.UpdateOneID(rule.ID).
+		SetEnabled(rule.Enabled).
+		SetEarnRate(rule.EarnRate).
+		SetCategoryRates(rule.CategoryRates).
+		SetTierMultipliers(rule.TierMultipliers).
+		SetExpireDays(rule.ExpireDays).
+		SetRedeemEnabled(rule.RedeemEnabled).
+		SetRedeemRate(rule.RedeemRate).
+		SetRedeemMinPoints(rule.RedeemMinPoints).
+		SetRedeemMaxPercent(rule.RedeemMaxPercent).
+		SetRedeemProducts(rule.RedeemProducts).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrMemberPointsRuleNotExists)
+		}
+		return fmt.Errorf("failed to update member points rule: %w", err)
+	}
+
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *MemberPointsRuleRepository) FindByMerchantID(ctx context.Context, merchantID uuid.UUID) (res *domain.MemberPointsRule, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsRuleRepository.FindByMerchantID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	er, err := repo.Client.MemberPointsRule.Query().
+		Where(memberpointsrule.MerchantID(merchantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrMemberPointsRuleNotExists)
+		}
+		return nil, err
+	}
+	return convertMemberPointsRuleToDomain(er), nil
+}
+
+func convertMemberPointsRuleToDomain(er *ent.MemberPointsRule) *domain.MemberPointsRule {
+	if er == nil {
+		return nil
+	}
+	return &domain.MemberPointsRule{
+		ID:               er.ID,
+		MerchantID:       er.MerchantID,
+		Enabled:          er.Enabled,
+		EarnRate:         er.EarnRate,
+		CategoryRates:    er.CategoryRates,
+		TierMultipliers:  er.TierMultipliers,
+		ExpireDays:       er.ExpireDays,
+		RedeemEnabled:    er.RedeemEnabled,
+		RedeemRate:       er.RedeemRate,
+		RedeemMinPoints:  er.RedeemMinPoints,
+		RedeemMaxPercent: er.RedeemMaxPercent,
+		RedeemProducts:   er.RedeemProducts,
+		CreatedAt:        er.CreatedAt,
+		UpdatedAt:        er.UpdatedAt,
+	}
+}
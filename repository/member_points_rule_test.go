@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type MemberPointsRuleRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *MemberPointsRuleRepository
+	ctx  context.Context
+}
+
+func TestMemberPointsRuleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemberPointsRuleRepositoryTestSuite))
+}
+
+func (s *MemberPointsRuleRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &MemberPointsRuleRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *MemberPointsRuleRepositoryTestSuite) TestMemberPointsRule_Save() {
+	merchantID := uuid.New()
+	categoryID := uuid.New()
+	tierID := uuid.New()
+	productID := uuid.New()
+	rule := &domain.MemberPointsRule{
+		ID:         uuid.New(),
+		MerchantID: merchantID,
+		Enabled:    true,
+		EarnRate:   decimal.NewFromInt(1),
+		CategoryRates: []domain.MemberPointsCategoryRate{
+			{CategoryID: categoryID, Rate: decimal.NewFromInt(2)},
+		},
+		TierMultipliers: []domain.MemberPointsTierMultiplier{
+			{TierID: tierID, Multiplier: decimal.RequireFromString("1.5")},
+		},
+		ExpireDays:       365,
+		RedeemEnabled:    true,
+		RedeemRate:       100,
+		RedeemMinPoints:  100,
+		RedeemMaxPercent: decimal.NewFromInt(50),
+		RedeemProducts: []domain.MemberPointsRedeemProduct{
+			{ProductID: productID, ProductName: "可乐", Points: 500},
+		},
+	}
+	require.NoError(s.T(), s.repo.Create(s.ctx, rule))
+
+	s.T().Run("每个品牌商一条规则", func(t *testing.T) {
+		dup := *rule
+		dup.ID = uuid.New()
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, &dup)))
+	})
+
+	s.T().Run("查询规则", func(t *testing.T) {
+		got, err := s.repo.FindByMerchantID(s.ctx, merchantID)
+		require.NoError(t, err)
+		require.True(t, got.Enabled)
+		require.Equal(t, 365, got.ExpireDays)
+		require.Equal(t, int64(100), got.RedeemRate)
+		require.True(t, decimal.NewFromInt(50).Equal(got.RedeemMaxPercent))
+		require.Len(t, got.CategoryRates, 1)
+		require.Equal(t, categoryID, got.CategoryRates[0].CategoryID)
+		require.True(t, decimal.NewFromInt(2).Equal(got.CategoryRates[0].Rate))
+		require.Len(t, got.TierMultipliers, 1)
+		require.Equal(t, tierID, got.TierMultipliers[0].TierID)
+		require.Len(t, got.RedeemProducts, 1)
+		require.Equal(t, int64(500), got.RedeemProducts[0].Points)
+
+		_, err = s.repo.FindByMerchantID(s.ctx, uuid.New())
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("更新规则", func(t *testing.T) {
+		rule.Enabled = false
+		rule.CategoryRates = nil
+		rule.RedeemProducts = nil
+		require.NoError(t, s.repo.Update(s.ctx, rule))
+
+		got, err := s.repo.FindByMerchantID(s.ctx, merchantID)
+		require.NoError(t, err)
+		require.False(t, got.Enabled)
+		require.Empty(t, got.CategoryRates)
+		require.Empty(t, got.RedeemProducts)
+		require.Len(t, got.TierMultipliers, 1)
+	})
+}
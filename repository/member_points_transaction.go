@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/memberpointstransaction"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.MemberPointsTransactionRepository = (*MemberPointsTransactionRepository)(nil)
+
+type MemberPointsTransactionRepository struct {
+	Client *ent.Client
+}
+
+func NewMemberPointsTransactionRepository(client *ent.Client) *MemberPointsTransactionRepository {
+	return &MemberPointsTransactionRepository{
+		Client: client,
+	}
+}
+
+// Create 追加积分流水
+func (repo *MemberPointsTransactionRepository) Create(ctx context.Context, txn *domain.MemberPointsTransaction) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsTransactionRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if txn == nil {
+		return fmt.Errorf("member points transaction is nil")
+	}
+
+	et, err := repo.Client.MemberPointsTransaction.Create().
+		SetID(txn.ID).
+		SetMerchantID(txn.MerchantID).
+		SetStoreID(txn.StoreID).
+		SetMemberID(txn.MemberID).
+		SetAccountID(txn.AccountID).
+		SetType(txn.Type).
+		SetPoints(txn.Points).
+		SetBalanceAfter(txn.BalanceAfter).
+		SetRemaining(txn.Remaining).
+		SetNillableExpiresAt(txn.ExpiresAt).
+		SetAmount(txn.Amount).
+		SetOrderID(txn.OrderID).
+		SetOrderNo(txn.OrderNo).
+		SetRefundOrderID(txn.RefundOrderID).
+		SetProductID(txn.ProductID).
+		SetOperatorID(txn.Operator.ID).
+		SetOperatorName(txn.Operator.Name).
+		SetRemark(txn.Remark).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create member points transaction: %w", err)
+	}
+
+	txn.CreatedAt = et.CreatedAt
+	return nil
+}
+
+// UpdateRemaining 更新入账流水的剩余积分，调用方需先锁定积分账户
+func (repo *MemberPointsTransactionRepository) UpdateRemaining(ctx context.Context, txn *domain.MemberPointsTransaction) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsTransactionRepository.UpdateRemaining")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if txn == nil {
+		return fmt.Errorf("member points transaction is nil")
+	}
+
+	err = repo.Client.MemberPointsTransaction.UpdateOneID(txn.ID).
+		SetRemaining(txn.Remaining).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update member points transaction remaining: %w", err)
+	}
+	return nil
+}
+
+// ListByOrderID 查询订单关联的积分流水
+func (repo *MemberPointsTransactionRepository) ListByOrderID(ctx context.Context, orderID uuid.UUID) (res domain.MemberPointsTransactions, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsTransactionRepository.ListByOrderID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	txns, err := repo.Client.MemberPointsTransaction.Query().
+		Where(memberpointstransaction.OrderID(orderID)).
+		Order(ent.Asc(memberpointstransaction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertMemberPointsTransactionsToDomain(txns), nil
+}
+
+// ListAvailable 查询账户剩余积分大于0的入账流水，先到期的在前
+func (repo *MemberPointsTransactionRepository) ListAvailable(ctx context.Context, accountID uuid.UUID) (res domain.MemberPointsTransactions, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsTransactionRepository.ListAvailable")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	txns, err := repo.Client.MemberPointsTransaction.Query().
+		Where(
+			memberpointstransaction.AccountID(accountID),
+			memberpointstransaction.RemainingGT(0),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res = convertMemberPointsTransactionsToDomain(txns)
+	// 永久有效的排在最后，数据库对 NULL 的排序规则不统一，在内存中排序
+	res.SortForDebit()
+	return res, nil
+}
+
+// ListExpiredMemberIDs 查询有已过期未处理积分的会员
+func (repo *MemberPointsTransactionRepository) ListExpiredMemberIDs(ctx context.Context, at time.Time, limit int) (res []uuid.UUID, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsTransactionRepository.ListExpiredMemberIDs")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	var rows []struct {
+		MemberID uuid.UUID `json:"member_id"`
+	}
+	err = repo.Client.MemberPointsTransaction.Query().
+		Where(
+			memberpointstransaction.RemainingGT(0),
+			memberpointstransaction.ExpiresAtLTE(at),
+		).
+		Unique(true).
+		Limit(limit).
+		Select(memberpointstransaction.FieldMemberID).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired member points: %w", err)
+	}
+
+	res = make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, row.MemberID)
+	}
+	return res, nil
+}
+
+// ExistsByRefundOrderID 检查退款单是否已处理积分
+func (repo *MemberPointsTransactionRepository) ExistsByRefundOrderID(ctx context.Context, refundOrderID uuid.UUID) (exists bool, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsTransactionRepository.ExistsByRefundOrderID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return repo.Client.MemberPointsTransaction.Query().
+		Where(memberpointstransaction.RefundOrderID(refundOrderID)).
+		Exist(ctx)
+}
+
+// PagedListBySearch 分页查询积分流水
+func (repo *MemberPointsTransactionRepository) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.MemberPointsTransactionSearchParams,
+) (res *domain.MemberPointsTransactionSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberPointsTransactionRepository.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.MemberPointsTransaction.Query().
+		Where(memberpointstransaction.MerchantID(params.MerchantID))
+	if params.StoreID != uuid.Nil {
+		query.Where(memberpointstransaction.StoreID(params.StoreID))
+	}
+	if params.MemberID != uuid.Nil {
+		query.Where(memberpointstransaction.MemberID(params.MemberID))
+	}
+	if params.Type != "" {
+		query.Where(memberpointstransaction.TypeEQ(params.Type))
+	}
+	if params.StartAt != nil {
+		query.Where(memberpointstransaction.CreatedAtGTE(*params.StartAt))
+	}
+	if params.EndAt != nil {
+		query.Where(memberpointstransaction.CreatedAtLTE(*params.EndAt))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txns, err := query.
+		Offset(page.Offset()).
+		Limit(page.Size).
+		Order(ent.Desc(memberpointstransaction.FieldCreatedAt), ent.Desc(memberpointstransaction.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page.SetTotal(total)
+
+	return &domain.MemberPointsTransactionSearchRes{
+		Pagination: page,
+		Items:      convertMemberPointsTransactionsToDomain(txns),
+	}, nil
+}
+
+func convertMemberPointsTransactionsToDomain(txns []*ent.MemberPointsTransaction) domain.MemberPointsTransactions {
+	res := make(domain.MemberPointsTransactions, 0, len(txns))
+	for _, et := range txns {
+		res = append(res, convertMemberPointsTransactionToDomain(et))
+	}
+	return res
+}
+
+func convertMemberPointsTransactionToDomain(et *ent.MemberPointsTransaction) *domain.MemberPointsTransaction {
+	if et == nil {
+		return nil
+	}
+	return &domain.MemberPointsTransaction{
+		ID:            et.ID,
+		MerchantID:    et.MerchantID,
+		StoreID:       et.StoreID,
+		MemberID:      et.MemberID,
+		AccountID:     et.AccountID,
+		Type:          et.Type,
+		Points:        et.Points,
+		BalanceAfter:  et.BalanceAfter,
+		Remaining:     et.Remaining,
+		ExpiresAt:     et.ExpiresAt,
+		Amount:        et.Amount,
+		OrderID:       et.OrderID,
+		OrderNo:       et.OrderNo,
+		RefundOrderID: et.RefundOrderID,
+		ProductID:     et.ProductID,
+		Operator: domain.OrderOperator{
+			ID:   et.OperatorID,
+			Name: et.OperatorName,
+		},
+		Remark:    et.Remark,
+		CreatedAt: et.CreatedAt,
+	}
+}
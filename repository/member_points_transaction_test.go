@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type MemberPointsTransactionRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo        *MemberPointsTransactionRepository
+	accountRepo *MemberPointsAccountRepository
+	ctx         context.Context
+}
+
+func TestMemberPointsTransactionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemberPointsTransactionRepositoryTestSuite))
+}
+
+func (s *MemberPointsTransactionRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &MemberPointsTransactionRepository{Client: s.client}
+	s.accountRepo = &MemberPointsAccountRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *MemberPointsTransactionRepositoryTestSuite) newAccount() *domain.MemberPointsAccount {
+	account := domain.NewMemberPointsAccount(&domain.Member{ID: uuid.New(), MerchantID: uuid.New()})
+	require.NoError(s.T(), s.accountRepo.Create(s.ctx, account))
+	return account
+}
+
+func (s *MemberPointsTransactionRepositoryTestSuite) save(
+	account *domain.MemberPointsAccount,
+	txn *domain.MemberPointsTransaction,
+	changed domain.MemberPointsTransactions,
+) *domain.MemberPointsTransaction {
+	require.NoError(s.T(), s.accountRepo.UpdateBalance(s.ctx, account))
+	for _, credit := range changed {
+		require.NoError(s.T(), s.repo.UpdateRemaining(s.ctx, credit))
+	}
+	require.NoError(s.T(), s.repo.Create(s.ctx, txn))
+	return txn
+}
+
+func (s *MemberPointsTransactionRepositoryTestSuite) credit(account *domain.MemberPointsAccount, points int64, expiresAt *time.Time) *domain.MemberPointsTransaction {
+	txn, err := account.Credit(domain.MemberPointsTransactionTypeEarn, points, expiresAt)
+	require.NoError(s.T(), err)
+	return s.save(account, txn, nil)
+}
+
+func (s *MemberPointsTransactionRepositoryTestSuite) TestMemberPointsTransaction_Debit() {
+	account := s.newAccount()
+	now := time.Now().Truncate(time.Second)
+	later := now.AddDate(0, 1, 0)
+	sooner := now.AddDate(0, 0, 7)
+
+	permanent := s.credit(account, 300, nil)
+	expiresLater := s.credit(account, 200, &later)
+	expiresSooner := s.credit(account, 100, &sooner)
+
+	s.T().Run("按过期时间先到先扣", func(t *testing.T) {
+		credits, err := s.repo.ListAvailable(s.ctx, account.ID)
+		require.NoError(t, err)
+		require.Len(t, credits, 3)
+		require.Equal(t, expiresSooner.ID, credits[0].ID)
+		require.Equal(t, expiresLater.ID, credits[1].ID)
+		require.Equal(t, permanent.ID, credits[2].ID)
+
+		txn, changed, err := account.Debit(domain.MemberPointsTransactionTypeRedeem, 150, credits)
+		require.NoError(t, err)
+		require.Len(t, changed, 2)
+		s.save(account, txn, changed)
+		require.Equal(t, int64(-150), txn.Points)
+		require.Equal(t, int64(450), txn.BalanceAfter)
+
+		credits, err = s.repo.ListAvailable(s.ctx, account.ID)
+		require.NoError(t, err)
+		require.Len(t, credits, 2)
+		require.Equal(t, expiresLater.ID, credits[0].ID)
+		require.Equal(t, int64(150), credits[0].Remaining)
+		require.Equal(t, int64(300), credits[1].Remaining)
+	})
+
+	s.T().Run("余额不足", func(t *testing.T) {
+		credits, err := s.repo.ListAvailable(s.ctx, account.ID)
+		require.NoError(t, err)
+		_, _, err = account.Debit(domain.MemberPointsTransactionTypeRedeem, 451, credits)
+		require.ErrorIs(t, err, domain.ErrMemberPointsNotEnough)
+		require.Equal(t, int64(450), account.Balance)
+	})
+
+	s.T().Run("账户余额", func(t *testing.T) {
+		got, err := s.accountRepo.FindByMemberID(s.ctx, account.MemberID)
+		require.NoError(t, err)
+		require.Equal(t, int64(450), got.Balance)
+	})
+}
+
+func (s *MemberPointsTransactionRepositoryTestSuite) TestMemberPointsTransaction_Expire() {
+	now := time.Now().Truncate(time.Second)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	expired := s.newAccount()
+	s.credit(expired, 100, &past)
+	s.credit(expired, 50, &future)
+	valid := s.newAccount()
+	s.credit(valid, 80, &future)
+	s.credit(valid, 20, nil)
+
+	memberIDs, err := s.repo.ListExpiredMemberIDs(s.ctx, now, 10)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []uuid.UUID{expired.MemberID}, memberIDs)
+
+	credits, err := s.repo.ListAvailable(s.ctx, expired.ID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(100), credits[0].Remaining)
+	txn := expired.Expire(credits[0])
+	s.save(expired, txn, domain.MemberPointsTransactions{credits[0]})
+	require.Equal(s.T(), int64(-100), txn.Points)
+	require.Equal(s.T(), int64(50), expired.Balance)
+
+	memberIDs, err = s.repo.ListExpiredMemberIDs(s.ctx, now, 10)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), memberIDs)
+}
+
+func (s *MemberPointsTransactionRepositoryTestSuite) TestMemberPointsTransaction_Order() {
+	account := s.newAccount()
+	orderID := uuid.New()
+	storeID := uuid.New()
+
+	earn, err := account.Credit(domain.MemberPointsTransactionTypeEarn, 120, nil)
+	require.NoError(s.T(), err)
+	earn.StoreID = storeID
+	earn.OrderID = orderID
+	s.save(account, earn, nil)
+
+	credits, err := s.repo.ListAvailable(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	redeem, changed, err := account.Debit(domain.MemberPointsTransactionTypeRedeem, 20, credits)
+	require.NoError(s.T(), err)
+	redeem.StoreID = storeID
+	redeem.OrderID = orderID
+	s.save(account, redeem, changed)
+
+	s.T().Run("订单积分汇总", func(t *testing.T) {
+		txns, err := s.repo.ListByOrderID(s.ctx, orderID)
+		require.NoError(t, err)
+		require.Len(t, txns, 2)
+
+		summary := txns.OrderSummary()
+		require.NotNil(t, summary.Earn)
+		require.Equal(t, earn.ID, summary.Earn.ID)
+		require.Equal(t, int64(20), summary.Redeemed)
+	})
+
+	s.T().Run("退款单已处理", func(t *testing.T) {
+		refundOrderID := uuid.New()
+		credits, err := s.repo.ListAvailable(s.ctx, account.ID)
+		require.NoError(t, err)
+		reverse, changed, err := account.Debit(domain.MemberPointsTransactionTypeReverse, 100, credits)
+		require.NoError(t, err)
+		reverse.OrderID = orderID
+		reverse.RefundOrderID = refundOrderID
+		s.save(account, reverse, changed)
+
+		exists, err := s.repo.ExistsByRefundOrderID(s.ctx, refundOrderID)
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, int64(0), account.Balance)
+	})
+
+	s.T().Run("分页查询", func(t *testing.T) {
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.MemberPointsTransactionSearchParams{
+			MerchantID: account.MerchantID,
+			MemberID:   account.MemberID,
+			Type:       domain.MemberPointsTransactionTypeRedeem,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Total)
+		require.Equal(t, redeem.ID, res.Items[0].ID)
+	})
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type MemberRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo     *MemberRepository
+	tierRepo *MemberTierRepository
+	ctx      context.Context
+}
+
+func TestMemberRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemberRepositoryTestSuite))
+}
+
+func (s *MemberRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &MemberRepository{Client: s.client}
+	s.tierRepo = &MemberTierRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *MemberRepositoryTestSuite) newMember(merchantID, tierID uuid.UUID, name, phone string) *domain.Member {
+	m := &domain.Member{
+		ID:         uuid.New(),
+		MerchantID: merchantID,
+		TierID:     tierID,
+		Name:       name,
+		Phone:      phone,
+		Gender:     domain.GenderUnknown,
+		Enabled:    true,
+	}
+	require.NoError(s.T(), m.GenerateCode())
+	require.NoError(s.T(), s.repo.Create(s.ctx, m))
+	return m
+}
+
+func (s *MemberRepositoryTestSuite) TestMember_CreateAndFind() {
+	merchantID := uuid.New()
+	tier := &domain.MemberTier{ID: uuid.New(), MerchantID: merchantID, Name: "金卡", MemberPriceEnabled: true}
+	require.NoError(s.T(), s.tierRepo.Create(s.ctx, tier))
+
+	member := s.newMember(merchantID, tier.ID, "张三", "13800000001")
+
+	s.T().Run("按手机号查询", func(t *testing.T) {
+		found, err := s.repo.FindByPhone(s.ctx, merchantID, "13800000001")
+		require.NoError(t, err)
+		require.Equal(t, member.ID, found.ID)
+		require.NotNil(t, found.Tier)
+		require.Equal(t, "金卡", found.Tier.Name)
+		require.True(t, found.MemberPriceEnabled())
+
+		_, err = s.repo.FindByPhone(s.ctx, uuid.New(), "13800000001")
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("按会员码查询", func(t *testing.T) {
+		found, err := s.repo.FindByCode(s.ctx, merchantID, member.Code)
+		require.NoError(t, err)
+		require.Equal(t, member.ID, found.ID)
+	})
+
+	s.T().Run("手机号重复", func(t *testing.T) {
+		dup := *member
+		dup.ID = uuid.New()
+		require.NoError(t, dup.GenerateCode())
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, &dup)))
+
+		exists, err := s.repo.Exists(s.ctx, domain.MemberExistsParams{MerchantID: merchantID, Phone: member.Phone})
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+
+	s.T().Run("绑定微信", func(t *testing.T) {
+		member.OpenID = "openid-001"
+		require.NoError(t, s.repo.Update(s.ctx, member))
+
+		found, err := s.repo.FindByOpenID(s.ctx, merchantID, "openid-001")
+		require.NoError(t, err)
+		require.Equal(t, member.ID, found.ID)
+
+		exists, err := s.repo.Exists(s.ctx, domain.MemberExistsParams{MerchantID: merchantID, OpenID: "openid-001", ExcludeID: member.ID})
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	s.T().Run("等级下有会员", func(t *testing.T) {
+		exists, err := s.repo.Exists(s.ctx, domain.MemberExistsParams{MerchantID: merchantID, TierID: tier.ID})
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+
+	s.T().Run("删除后手机号可重新注册", func(t *testing.T) {
+		require.NoError(t, s.repo.Delete(s.ctx, member.ID))
+		_, err := s.repo.FindByID(s.ctx, member.ID)
+		require.True(t, domain.IsNotFound(err))
+
+		s.newMember(merchantID, uuid.Nil, "张三", "13800000001")
+	})
+}
+
+func (s *MemberRepositoryTestSuite) TestMember_PagedListBySearch() {
+	merchantID := uuid.New()
+	s.newMember(merchantID, uuid.Nil, "张三", "13800000001")
+	s.newMember(merchantID, uuid.Nil, "李四", "13800000002")
+	disabled := s.newMember(merchantID, uuid.Nil, "王五", "13900000003")
+	disabled.Enabled = false
+	require.NoError(s.T(), s.repo.Update(s.ctx, disabled))
+	s.newMember(uuid.New(), uuid.Nil, "赵六", "13800000004")
+
+	res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.MemberSearchParams{MerchantID: merchantID})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 3, res.Total)
+
+	res, err = s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.MemberSearchParams{
+		MerchantID: merchantID,
+		Keyword:    "138",
+	})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 2, res.Total)
+
+	enabled := false
+	res, err = s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.MemberSearchParams{
+		MerchantID: merchantID,
+		Enabled:    &enabled,
+	})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, res.Total)
+	require.Equal(s.T(), disabled.ID, res.Items[0].ID)
+}
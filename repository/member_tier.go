@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/membertier"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.MemberTierRepository = (*MemberTierRepository)(nil)
+
+type MemberTierRepository struct {
+	Client *ent.Client
+}
+
+func NewMemberTierRepository(client *ent.Client) *MemberTierRepository {
+	return &MemberTierRepository{
+		Client: client,
+	}
+}
+
+// Create 创建会员等级
+func (repo *MemberTierRepository) Create(ctx context.Context, tier *domain.MemberTier) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberTierRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if tier == nil {
+		return fmt.Errorf("member tier is nil")
+	}
+
+	et, err := repo.Client.MemberTier.Create().
+		SetID(tier.ID).
+		SetMerchantID(tier.MerchantID).
+		SetName(tier.Name).
+		SetLevel(tier.Level).
+		SetMemberPriceEnabled(tier.MemberPriceEnabled).
+		SetDescription(tier.Description).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create member tier: %w", err)
+	}
+
+	tier.CreatedAt = et.CreatedAt
+	tier.UpdatedAt = et.UpdatedAt
+	return nil
+}
+
+// Update 更新会员等级
+func (repo *MemberTierRepository) Update(ctx context.Context, tier *domain.MemberTier) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberTierRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if tier == nil {
+		return fmt.Errorf("member tier is nil")
+	}
+
+	et, err := repo.Client.MemberTier.UpdateOneID(tier.ID).
+		SetName(tier.Name).
+		SetLevel(tier.Level).
+		SetMemberPriceEnabled(tier.MemberPriceEnabled).
+		SetDescription(tier.Description).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrMemberTierNotExists)
+		}
+		return fmt.Errorf("failed to update member tier: %w", err)
+	}
+
+	tier.UpdatedAt = et.UpdatedAt
+	return nil
+}
+
+// Delete 删除会员等级
+func (repo *MemberTierRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberTierRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.MemberTier.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrMemberTierNotExists)
+		}
+		return fmt.Errorf("failed to delete member tier: %w", err)
+	}
+	return nil
+}
+
+// FindByID 根据ID查询会员等级
+func (repo *MemberTierRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.MemberTier, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberTierRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	et, err := repo.Client.MemberTier.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrMemberTierNotExists)
+		}
+		return nil, err
+	}
+	return convertMemberTierToDomain(et), nil
+}
+
+// Exists 检查会员等级名称是否已存在
+func (repo *MemberTierRepository) Exists(ctx context.Context, params domain.MemberTierExistsParams) (exists bool, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberTierRepository.Exists")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.MemberTier.Query().Where(
+		membertier.MerchantID(params.MerchantID),
+		membertier.Name(params.Name),
+	)
+	if params.ExcludeID != uuid.Nil {
+		query.Where(membertier.IDNEQ(params.ExcludeID))
+	}
+	return query.Exist(ctx)
+}
+
+// ListByMerchantID 查询品牌商的全部会员等级
+func (repo *MemberTierRepository) ListByMerchantID(ctx context.Context, merchantID uuid.UUID) (res domain.MemberTiers, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MemberTierRepository.ListByMerchantID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	tiers, err := repo.Client.MemberTier.Query().
+		Where(membertier.MerchantID(merchantID)).
+		Order(ent.Asc(membertier.FieldLevel), ent.Asc(membertier.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res = make(domain.MemberTiers, 0, len(tiers))
+	for _, et := range tiers {
+		res = append(res, convertMemberTierToDomain(et))
+	}
+	return res, nil
+}
+
+func convertMemberTierToDomain(et *ent.MemberTier) *domain.MemberTier {
+	if et == nil {
+		return nil
+	}
+	return &domain.MemberTier{
+		ID:                 et.ID,
+		MerchantID:         et.MerchantID,
+		Name:               et.Name,
+		Level:              et.Level,
+		MemberPriceEnabled: et.MemberPriceEnabled,
+		Description:        et.Description,
+		CreatedAt:          et.CreatedAt,
+		UpdatedAt:          et.UpdatedAt,
+	}
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type MemberTierRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *MemberTierRepository
+	ctx  context.Context
+}
+
+func TestMemberTierRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemberTierRepositoryTestSuite))
+}
+
+func (s *MemberTierRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &MemberTierRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *MemberTierRepositoryTestSuite) TestMemberTier_CRUD() {
+	merchantID := uuid.New()
+	gold := &domain.MemberTier{ID: uuid.New(), MerchantID: merchantID, Name: "金卡", Level: 2, MemberPriceEnabled: true}
+	silver := &domain.MemberTier{ID: uuid.New(), MerchantID: merchantID, Name: "银卡", Level: 1}
+	require.NoError(s.T(), s.repo.Create(s.ctx, gold))
+	require.NoError(s.T(), s.repo.Create(s.ctx, silver))
+
+	s.T().Run("名称唯一", func(t *testing.T) {
+		exists, err := s.repo.Exists(s.ctx, domain.MemberTierExistsParams{MerchantID: merchantID, Name: "金卡"})
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		exists, err = s.repo.Exists(s.ctx, domain.MemberTierExistsParams{MerchantID: merchantID, Name: "金卡", ExcludeID: gold.ID})
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	s.T().Run("按等级序号排序", func(t *testing.T) {
+		tiers, err := s.repo.ListByMerchantID(s.ctx, merchantID)
+		require.NoError(t, err)
+		require.Len(t, tiers, 2)
+		require.Equal(t, silver.ID, tiers[0].ID)
+		require.Equal(t, gold.ID, tiers[1].ID)
+	})
+
+	s.T().Run("更新", func(t *testing.T) {
+		silver.MemberPriceEnabled = true
+		silver.Description = "消费满1000元"
+		require.NoError(t, s.repo.Update(s.ctx, silver))
+
+		found, err := s.repo.FindByID(s.ctx, silver.ID)
+		require.NoError(t, err)
+		require.True(t, found.MemberPriceEnabled)
+		require.Equal(t, "消费满1000元", found.Description)
+	})
+
+	s.T().Run("删除", func(t *testing.T) {
+		require.NoError(t, s.repo.Delete(s.ctx, gold.ID))
+		_, err := s.repo.FindByID(s.ctx, gold.ID)
+		require.True(t, domain.IsNotFound(err))
+	})
+}
@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/menu"
@@ -113,6 +115,9 @@ func (repo *MenuRepository) Create(ctx context.Context, m *domain.Menu) (err err
 			if item.MemberPrice != nil {
 				builder.SetMemberPrice(*item.MemberPrice)
 			}
+			if len(item.PeriodPrices) > 0 {
+				builder.SetPeriodPrices(item.PeriodPrices)
+			}
 			itemBuilders = append(itemBuilders, builder)
 		}
 		_, err = repo.Client.MenuItem.CreateBulk(itemBuilders...).Save(ctx)
@@ -153,7 +158,7 @@ func (repo *MenuRepository) Update(ctx context.Context, m *domain.Menu) (err err
 		for _, store := range m.Stores {
 			storeIDs = append(storeIDs, store.ID)
 		}
-		builder = builder.AddStoreIDs(storeIDs...)
+		builder = builder.ClearStores().AddStoreIDs(storeIDs...)
 	} else {
 		builder = builder.ClearStores()
 	}
@@ -178,6 +183,9 @@ func (repo *MenuRepository) Update(ctx context.Context, m *domain.Menu) (err err
 			if item.MemberPrice != nil {
 				builder.SetMemberPrice(*item.MemberPrice)
 			}
+			if len(item.PeriodPrices) > 0 {
+				builder.SetPeriodPrices(item.PeriodPrices)
+			}
 			itemBuilders = append(itemBuilders, builder)
 		}
 		_, err = repo.Client.MenuItem.CreateBulk(itemBuilders...).Save(ctx)
@@ -370,27 +378,7 @@ func convertMenuToDomain(em *ent.Menu) *domain.Menu {
 	if len(em.Edges.Items) > 0 {
 		m.Items = make(domain.MenuItems, 0, len(em.Edges.Items))
 		for _, item := range em.Edges.Items {
-			itemDomain := &domain.MenuItem{
-				ID:        item.ID,
-				MenuID:    item.MenuID,
-				ProductID: item.ProductID,
-				CreatedAt: item.CreatedAt,
-				UpdatedAt: item.UpdatedAt,
-			}
-
-			if item.BasePrice != nil {
-				itemDomain.BasePrice = item.BasePrice
-			}
-			if item.MemberPrice != nil {
-				itemDomain.MemberPrice = item.MemberPrice
-			}
-
-			// 关联商品信息
-			if item.Edges.Product != nil {
-				itemDomain.Product = convertProductToDomain(item.Edges.Product)
-			}
-
-			m.Items = append(m.Items, itemDomain)
+			m.Items = append(m.Items, convertMenuItemToDomain(item))
 		}
 	}
 
@@ -407,6 +395,30 @@ func convertMenuToDomain(em *ent.Menu) *domain.Menu {
 	return m
 }
 
+func convertMenuItemToDomain(item *ent.MenuItem) *domain.MenuItem {
+	itemDomain := &domain.MenuItem{
+		ID:           item.ID,
+		MenuID:       item.MenuID,
+		ProductID:    item.ProductID,
+		PeriodPrices: item.PeriodPrices,
+		CreatedAt:    item.CreatedAt,
+		UpdatedAt:    item.UpdatedAt,
+	}
+
+	if item.BasePrice != nil {
+		itemDomain.BasePrice = item.BasePrice
+	}
+	if item.MemberPrice != nil {
+		itemDomain.MemberPrice = item.MemberPrice
+	}
+
+	// 关联商品信息
+	if item.Edges.Product != nil {
+		itemDomain.Product = convertProductToDomain(item.Edges.Product)
+	}
+	return itemDomain
+}
+
 func (repo *MenuRepository) ListAllStoreMenus(
 	ctx context.Context,
 	params domain.MenuListAllParams,
@@ -436,6 +448,14 @@ func (repo *MenuRepository) ListAllStoreMenus(
 	// 加载菜单项和商品的完整信息
 	query = query.
 		WithItems(func(query *ent.MenuItemQuery) {
+			// 助记搜索只加载匹配的菜单项
+			if params.Keyword != "" {
+				query.Where(menuitem.HasProductWith(productKeywordPredicate(params.Keyword)))
+			}
+			// 过敏原与饮食属性筛选只加载满足条件的菜单项
+			if !params.Dietary.IsEmpty() {
+				query.Where(menuitem.HasProductWith(productDietaryPredicate(params.Dietary)))
+			}
 			query.WithProduct(func(query *ent.ProductQuery) {
 				// 加载单位
 				query.WithUnit()
@@ -471,8 +491,93 @@ func (repo *MenuRepository) ListAllStoreMenus(
 	items := make(domain.Menus, 0, len(entMenus))
 	for _, m := range entMenus {
 		menuDomain := convertMenuToDomain(m)
+		// 搜索或筛选时忽略没有匹配菜单项的菜单
+		if (params.Keyword != "" || !params.Dietary.IsEmpty()) && len(menuDomain.Items) == 0 {
+			continue
+		}
+		if params.Keyword != "" {
+			// 助记搜索时菜单项按匹配程度排序
+			slices.SortStableFunc(menuDomain.Items, func(a, b *domain.MenuItem) int {
+				return productKeywordRank(a.Product, params.Keyword) - productKeywordRank(b.Product, params.Keyword)
+			})
+		}
 		items = append(items, menuDomain)
 	}
 
 	return items, nil
 }
+
+// ListStoreMenuItemsByProductID 查询门店生效菜单（品牌商菜单关联该门店或门店自建菜单）中指定商品的菜单项
+func (repo *MenuRepository) ListStoreMenuItemsByProductID(
+	ctx context.Context,
+	storeID, productID uuid.UUID,
+) (res domain.MenuItems, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuRepository.ListStoreMenuItemsByProductID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	entItems, err := repo.Client.MenuItem.Query().
+		Where(
+			menuitem.ProductID(productID),
+			menuitem.HasMenuWith(menu.Or(
+				menu.And(
+					menu.StoreIDEQ(uuid.Nil),
+					menu.HasStoresWith(store.IDEQ(storeID)),
+				),
+				menu.StoreIDEQ(storeID),
+			)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res = make(domain.MenuItems, 0, len(entItems))
+	for _, item := range entItems {
+		res = append(res, convertMenuItemToDomain(item))
+	}
+	return res, nil
+}
+
+// UpdateItemBasePrice 更新菜单项基础价
+func (repo *MenuRepository) UpdateItemBasePrice(ctx context.Context, id uuid.UUID, basePrice *decimal.Decimal) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuRepository.UpdateItemBasePrice")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	builder := repo.Client.MenuItem.UpdateOneID(id)
+	if basePrice != nil {
+		builder = builder.SetBasePrice(*basePrice)
+	} else {
+		builder = builder.ClearBasePrice()
+	}
+	return builder.Exec(ctx)
+}
+
+// UpdateItemPrices 更新菜单项基础价、会员价及就餐时段价格（为空时清除）
+func (repo *MenuRepository) UpdateItemPrices(ctx context.Context, item *domain.MenuItem) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuRepository.UpdateItemPrices")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	builder := repo.Client.MenuItem.UpdateOneID(item.ID)
+	if item.BasePrice != nil {
+		builder = builder.SetBasePrice(*item.BasePrice)
+	} else {
+		builder = builder.ClearBasePrice()
+	}
+	if item.MemberPrice != nil {
+		builder = builder.SetMemberPrice(*item.MemberPrice)
+	} else {
+		builder = builder.ClearMemberPrice()
+	}
+	if len(item.PeriodPrices) > 0 {
+		builder = builder.SetPeriodPrices(item.PeriodPrices)
+	} else {
+		builder = builder.ClearPeriodPrices()
+	}
+	return builder.Exec(ctx)
+}
@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/menuversion"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.MenuVersionRepository = (*MenuVersionRepository)(nil)
+
+type MenuVersionRepository struct {
+	Client *ent.Client
+}
+
+func NewMenuVersionRepository(client *ent.Client) *MenuVersionRepository {
+	return &MenuVersionRepository{
+		Client: client,
+	}
+}
+
+// Create 创建菜单版本
+func (repo *MenuVersionRepository) Create(ctx context.Context, v *domain.MenuVersion) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuVersionRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if v == nil {
+		return fmt.Errorf("menu version is nil")
+	}
+
+	ev, err := repo.Client.MenuVersion.Create().
+		SetID(v.ID).
+		SetMenuID(v.MenuID).
+		SetMerchantID(v.MerchantID).
+		SetStoreID(v.StoreID).
+		SetVersion(v.Version).
+		SetStatus(v.Status).
+		SetName(v.Name).
+		SetStoreIds(v.StoreIDs).
+		SetItems(v.Items).
+		SetNillableScheduledAt(v.ScheduledAt).
+		SetNillablePublishedAt(v.PublishedAt).
+		SetPublishError(v.PublishError).
+		SetOperatorID(v.OperatorID).
+		SetOperatorName(v.OperatorName).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	v.CreatedAt = ev.CreatedAt
+	v.UpdatedAt = ev.UpdatedAt
+	return nil
+}
+
+// Update 更新菜单版本内容及状态
+func (repo *MenuVersionRepository) Update(ctx context.Context, v *domain.MenuVersion) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuVersionRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if v == nil {
+		return fmt.Errorf("menu version is nil")
+	}
+
+	builder := repo.Client.MenuVersion.UpdateOneID(v.ID).
+		SetStatus(v.Status).
+		SetName(v.Name).
+		SetStoreIds(v.StoreIDs).
+		SetItems(v.Items).
+		SetPublishError(v.PublishError).
+		SetOperatorID(v.OperatorID).
+		SetOperatorName(v.OperatorName)
+	if v.ScheduledAt != nil {
+		builder = builder.SetScheduledAt(*v.ScheduledAt)
+	} else {
+		builder = builder.ClearScheduledAt()
+	}
+	if v.PublishedAt != nil {
+		builder = builder.SetPublishedAt(*v.PublishedAt)
+	} else {
+		builder = builder.ClearPublishedAt()
+	}
+
+	ev, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrMenuVersionNotExists)
+		}
+		return err
+	}
+
+	v.UpdatedAt = ev.UpdatedAt
+	return nil
+}
+
+// FindByStatus 查询菜单指定状态的最新版本
+func (repo *MenuVersionRepository) FindByStatus(
+	ctx context.Context,
+	menuID uuid.UUID,
+	status domain.MenuVersionStatus,
+) (res *domain.MenuVersion, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuVersionRepository.FindByStatus")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ev, err := repo.Client.MenuVersion.Query().
+		Where(
+			menuversion.MenuID(menuID),
+			menuversion.StatusEQ(status),
+		).
+		Order(ent.Desc(menuversion.FieldVersion)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrMenuVersionNotExists)
+		}
+		return nil, err
+	}
+
+	return convertMenuVersionToDomain(ev), nil
+}
+
+// FindDraftForUpdate 锁定查询菜单草稿
+func (repo *MenuVersionRepository) FindDraftForUpdate(ctx context.Context, menuID uuid.UUID) (res *domain.MenuVersion, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuVersionRepository.FindDraftForUpdate")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ev, err := repo.Client.MenuVersion.Query().
+		Where(
+			menuversion.MenuID(menuID),
+			menuversion.StatusEQ(domain.MenuVersionStatusDraft),
+		).
+		ForUpdate(). // 行级锁：防止定时任务与手动发布并发处理同一草稿
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrMenuDraftNotExists)
+		}
+		return nil, err
+	}
+
+	return convertMenuVersionToDomain(ev), nil
+}
+
+// FindPreviousArchived 查询版本号小于指定版本的最新归档版本
+func (repo *MenuVersionRepository) FindPreviousArchived(
+	ctx context.Context,
+	menuID uuid.UUID,
+	beforeVersion int,
+) (res *domain.MenuVersion, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuVersionRepository.FindPreviousArchived")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ev, err := repo.Client.MenuVersion.Query().
+		Where(
+			menuversion.MenuID(menuID),
+			menuversion.StatusEQ(domain.MenuVersionStatusArchived),
+			menuversion.VersionLT(beforeVersion),
+		).
+		Order(ent.Desc(menuversion.FieldVersion)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrMenuNoPreviousVersion)
+		}
+		return nil, err
+	}
+
+	return convertMenuVersionToDomain(ev), nil
+}
+
+// MaxVersion 查询菜单当前最大版本号
+func (repo *MenuVersionRepository) MaxVersion(ctx context.Context, menuID uuid.UUID) (res int, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuVersionRepository.MaxVersion")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ev, err := repo.Client.MenuVersion.Query().
+		Where(menuversion.MenuID(menuID)).
+		Order(ent.Desc(menuversion.FieldVersion)).
+		Select(menuversion.FieldVersion).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return ev.Version, nil
+}
+
+// ListByMenuID 查询菜单全部版本（按版本号倒序）
+func (repo *MenuVersionRepository) ListByMenuID(ctx context.Context, menuID uuid.UUID) (res domain.MenuVersions, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuVersionRepository.ListByMenuID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	evs, err := repo.Client.MenuVersion.Query().
+		Where(menuversion.MenuID(menuID)).
+		Order(ent.Desc(menuversion.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res = make(domain.MenuVersions, 0, len(evs))
+	for _, ev := range evs {
+		res = append(res, convertMenuVersionToDomain(ev))
+	}
+	return res, nil
+}
+
+// ListDueDraftMenuIDs 查询已到定时发布时间的草稿所属菜单ID
+func (repo *MenuVersionRepository) ListDueDraftMenuIDs(ctx context.Context, now time.Time) (ids []uuid.UUID, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuVersionRepository.ListDueDraftMenuIDs")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.MenuVersion.Query().
+		Where(
+			menuversion.StatusEQ(domain.MenuVersionStatusDraft),
+			menuversion.ScheduledAtLTE(now),
+		).
+		Order(ent.Asc(menuversion.FieldScheduledAt)).
+		Select(menuversion.FieldMenuID).
+		Scan(ctx, &ids)
+	return ids, err
+}
+
+// DeleteByMenuID 删除菜单全部版本
+func (repo *MenuVersionRepository) DeleteByMenuID(ctx context.Context, menuID uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "MenuVersionRepository.DeleteByMenuID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	_, err = repo.Client.MenuVersion.Delete().
+		Where(menuversion.MenuID(menuID)).
+		Exec(ctx)
+	return err
+}
+
+// ============================================
+// 转换函数
+// ============================================
+
+func convertMenuVersionToDomain(ev *ent.MenuVersion) *domain.MenuVersion {
+	if ev == nil {
+		return nil
+	}
+
+	return &domain.MenuVersion{
+		ID:           ev.ID,
+		MenuID:       ev.MenuID,
+		MerchantID:   ev.MerchantID,
+		StoreID:      ev.StoreID,
+		Version:      ev.Version,
+		Status:       ev.Status,
+		Name:         ev.Name,
+		StoreIDs:     ev.StoreIds,
+		Items:        ev.Items,
+		ScheduledAt:  ev.ScheduledAt,
+		PublishedAt:  ev.PublishedAt,
+		PublishError: ev.PublishError,
+		OperatorID:   ev.OperatorID,
+		OperatorName: ev.OperatorName,
+		CreatedAt:    ev.CreatedAt,
+		UpdatedAt:    ev.UpdatedAt,
+	}
+}
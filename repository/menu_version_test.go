@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type MenuVersionRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *MenuVersionRepository
+	ctx  context.Context
+}
+
+func TestMenuVersionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MenuVersionRepositoryTestSuite))
+}
+
+func (s *MenuVersionRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &MenuVersionRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *MenuVersionRepositoryTestSuite) newVersion(menuID uuid.UUID, version int, status domain.MenuVersionStatus) *domain.MenuVersion {
+	price := decimal.NewFromInt(28)
+	menu := &domain.Menu{
+		ID:         menuID,
+		MerchantID: uuid.New(),
+		Name:       "午市菜单",
+		Stores:     []*domain.StoreSimple{{ID: uuid.New()}},
+		Items: domain.MenuItems{
+			{ID: uuid.New(), ProductID: uuid.New(), BasePrice: &price},
+		},
+	}
+	return domain.NewMenuVersion(menu, version, status, domain.ProductVersionOperator{ID: uuid.New(), Name: "操作员"})
+}
+
+func (s *MenuVersionRepositoryTestSuite) TestMenuVersion_CreateAndFind() {
+	menuID := uuid.New()
+	published := s.newVersion(menuID, 1, domain.MenuVersionStatusPublished)
+	now := time.Now()
+	published.PublishedAt = &now
+	draft := s.newVersion(menuID, 2, domain.MenuVersionStatusDraft)
+
+	s.T().Run("创建成功", func(t *testing.T) {
+		require.NoError(t, s.repo.Create(s.ctx, published))
+		require.NoError(t, s.repo.Create(s.ctx, draft))
+
+		found, err := s.repo.FindByStatus(s.ctx, menuID, domain.MenuVersionStatusDraft)
+		require.NoError(t, err)
+		require.Equal(t, 2, found.Version)
+		require.Equal(t, draft.StoreIDs, found.StoreIDs)
+		require.Len(t, found.Items, 1)
+		require.True(t, found.Items[0].BasePrice.Equal(decimal.NewFromInt(28)))
+		require.Nil(t, found.ScheduledAt)
+
+		found, err = s.repo.FindByStatus(s.ctx, menuID, domain.MenuVersionStatusPublished)
+		require.NoError(t, err)
+		require.Equal(t, 1, found.Version)
+		require.NotNil(t, found.PublishedAt)
+	})
+
+	s.T().Run("空入参", func(t *testing.T) {
+		require.Error(t, s.repo.Create(s.ctx, nil))
+	})
+
+	s.T().Run("版本号重复", func(t *testing.T) {
+		require.Error(t, s.repo.Create(s.ctx, s.newVersion(menuID, 2, domain.MenuVersionStatusArchived)))
+	})
+
+	s.T().Run("不存在", func(t *testing.T) {
+		_, err := s.repo.FindByStatus(s.ctx, menuID, domain.MenuVersionStatusArchived)
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("最大版本号", func(t *testing.T) {
+		maxVersion, err := s.repo.MaxVersion(s.ctx, menuID)
+		require.NoError(t, err)
+		require.Equal(t, 2, maxVersion)
+
+		maxVersion, err = s.repo.MaxVersion(s.ctx, uuid.New())
+		require.NoError(t, err)
+		require.Equal(t, 0, maxVersion)
+	})
+}
+
+func (s *MenuVersionRepositoryTestSuite) TestMenuVersion_UpdateAndListDue() {
+	menuID := uuid.New()
+	draft := s.newVersion(menuID, 1, domain.MenuVersionStatusDraft)
+	require.NoError(s.T(), s.repo.Create(s.ctx, draft))
+
+	s.T().Run("未定时不在待发布列表", func(t *testing.T) {
+		ids, err := s.repo.ListDueDraftMenuIDs(s.ctx, time.Now())
+		require.NoError(t, err)
+		require.NotContains(t, ids, menuID)
+	})
+
+	s.T().Run("定时发布到期", func(t *testing.T) {
+		scheduledAt := time.Now().Add(time.Minute)
+		draft.ScheduledAt = &scheduledAt
+		draft.Name = "晚市菜单"
+		require.NoError(t, s.repo.Update(s.ctx, draft))
+
+		ids, err := s.repo.ListDueDraftMenuIDs(s.ctx, time.Now())
+		require.NoError(t, err)
+		require.NotContains(t, ids, menuID)
+
+		ids, err = s.repo.ListDueDraftMenuIDs(s.ctx, scheduledAt.Add(time.Second))
+		require.NoError(t, err)
+		require.Contains(t, ids, menuID)
+
+		found, err := s.repo.FindByStatus(s.ctx, menuID, domain.MenuVersionStatusDraft)
+		require.NoError(t, err)
+		require.Equal(t, "晚市菜单", found.Name)
+		require.NotNil(t, found.ScheduledAt)
+	})
+
+	s.T().Run("发布后不在待发布列表", func(t *testing.T) {
+		now := time.Now()
+		draft.Status = domain.MenuVersionStatusPublished
+		draft.ScheduledAt = nil
+		draft.PublishedAt = &now
+		require.NoError(t, s.repo.Update(s.ctx, draft))
+
+		ids, err := s.repo.ListDueDraftMenuIDs(s.ctx, now.Add(time.Hour))
+		require.NoError(t, err)
+		require.NotContains(t, ids, menuID)
+	})
+
+	s.T().Run("不存在", func(t *testing.T) {
+		require.Error(t, s.repo.Update(s.ctx, s.newVersion(uuid.New(), 1, domain.MenuVersionStatusDraft)))
+	})
+}
+
+func (s *MenuVersionRepositoryTestSuite) TestMenuVersion_PreviousArchivedAndDelete() {
+	menuID := uuid.New()
+	for i, status := range []domain.MenuVersionStatus{
+		domain.MenuVersionStatusArchived,
+		domain.MenuVersionStatusArchived,
+		domain.MenuVersionStatusPublished,
+		domain.MenuVersionStatusArchived,
+	} {
+		require.NoError(s.T(), s.repo.Create(s.ctx, s.newVersion(menuID, i+1, status)))
+	}
+
+	s.T().Run("上一个归档版本", func(t *testing.T) {
+		prev, err := s.repo.FindPreviousArchived(s.ctx, menuID, 3)
+		require.NoError(t, err)
+		require.Equal(t, 2, prev.Version)
+
+		_, err = s.repo.FindPreviousArchived(s.ctx, menuID, 1)
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("版本列表", func(t *testing.T) {
+		versions, err := s.repo.ListByMenuID(s.ctx, menuID)
+		require.NoError(t, err)
+		require.Len(t, versions, 4)
+		require.Equal(t, 4, versions[0].Version)
+	})
+
+	s.T().Run("删除", func(t *testing.T) {
+		require.NoError(t, s.repo.DeleteByMenuID(s.ctx, menuID))
+		versions, err := s.repo.ListByMenuID(s.ctx, menuID)
+		require.NoError(t, err)
+		require.Empty(t, versions)
+	})
+}
@@ -3,17 +3,21 @@ package repository
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	entcategory "gitlab.jiguang.dev/pos-dine/dine/ent/category"
 	entorder "gitlab.jiguang.dev/pos-dine/dine/ent/order"
 	entorderproduct "gitlab.jiguang.dev/pos-dine/dine/ent/orderproduct"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
+	entproduct "gitlab.jiguang.dev/pos-dine/dine/ent/product"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
 )
@@ -48,6 +52,66 @@ func (repo *OrderRepository) FindByID(ctx context.Context, id uuid.UUID) (res *d
 	return convertOrderToDomain(eo), nil
 }
 
+func (repo *OrderRepository) FindOpenByTableID(ctx context.Context, tableID uuid.UUID) (res *domain.Order, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "OrderRepository.FindOpenByTableID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	eo, err := repo.Client.Order.Query().
+		Where(
+			entorder.TableID(tableID),
+			entorder.OrderStatusEQ(domain.OrderStatusPlaced),
+			entorder.PaymentStatusEQ(domain.PaymentStatusUnpaid),
+		).
+		Order(ent.Desc(entorder.FieldCreatedAt)).
+		WithOrderProducts().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(err)
+		}
+		return nil, fmt.Errorf("failed to get open order: %w", err)
+	}
+
+	return convertOrderToDomain(eo), nil
+}
+
+func (repo *OrderRepository) ListTableTurns(ctx context.Context, storeID uuid.UUID, since time.Time, limit int) (res []*domain.TableTurn, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "OrderRepository.ListTableTurns")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	eos, err := repo.Client.Order.Query().
+		Where(
+			entorder.StoreID(storeID),
+			entorder.DiningModeEQ(domain.DiningModeDineIn),
+			entorder.PaymentStatusEQ(domain.PaymentStatusPaid),
+			entorder.TableIDNotNil(),
+			entorder.TableIDNEQ(uuid.Nil),
+			entorder.PlacedAtNotNil(),
+			entorder.PaidAtGTE(since),
+		).
+		Order(ent.Desc(entorder.FieldPaidAt)).
+		Limit(limit).
+		Select(entorder.FieldTableID, entorder.FieldPlacedAt, entorder.FieldPaidAt).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list table turns: %w", err)
+	}
+
+	res = make([]*domain.TableTurn, 0, len(eos))
+	for _, eo := range eos {
+		res = append(res, &domain.TableTurn{
+			TableID:  eo.TableID,
+			PlacedAt: *eo.PlacedAt,
+			PaidAt:   *eo.PaidAt,
+		})
+	}
+	return res, nil
+}
+
 func (repo *OrderRepository) Create(ctx context.Context, o *domain.Order) (err error) {
 	span, ctx := util.StartSpan(ctx, "repository", "OrderRepository.Create")
 	defer func() {
@@ -59,6 +123,7 @@ func (repo *OrderRepository) Create(ctx context.Context, o *domain.Order) (err e
 		SetStoreID(o.StoreID).
 		SetBusinessDate(o.BusinessDate).
 		SetOrderNo(o.OrderNo).
+		SetPickupNo(o.PickupNo).
 		SetDiningMode(o.DiningMode).
 		SetChannel(o.Channel)
 
@@ -125,6 +190,16 @@ func (repo *OrderRepository) Create(ctx context.Context, o *domain.Order) (err e
 	// Amount
 	builder = builder.SetAmount(o.Amount)
 
+	// Promotions
+	if len(o.Promotions) > 0 {
+		builder = builder.SetPromotions(o.Promotions)
+	}
+
+	// Member
+	if o.Member != nil {
+		builder = builder.SetMemberID(o.Member.ID).SetMember(o.Member)
+	}
+
 	// Remark
 	if o.Remark != "" {
 		builder = builder.SetRemark(o.Remark)
@@ -177,6 +252,9 @@ func (repo *OrderRepository) Update(ctx context.Context, o *domain.Order) (err e
 	if o.OrderNo != "" {
 		builder = builder.SetOrderNo(o.OrderNo)
 	}
+	if o.PickupNo != "" {
+		builder = builder.SetPickupNo(o.PickupNo)
+	}
 	if o.OrderType != "" {
 		builder = builder.SetOrderType(o.OrderType)
 	}
@@ -239,6 +317,16 @@ func (repo *OrderRepository) Update(ctx context.Context, o *domain.Order) (err e
 	// Amount
 	builder = builder.SetAmount(o.Amount)
 
+	// Promotions
+	if len(o.Promotions) > 0 {
+		builder = builder.SetPromotions(o.Promotions)
+	}
+
+	// Member
+	if o.Member != nil {
+		builder = builder.SetMemberID(o.Member.ID).SetMember(o.Member)
+	}
+
 	// Remark
 	if o.Remark != "" {
 		builder = builder.SetRemark(o.Remark)
@@ -333,6 +421,9 @@ func (repo *OrderRepository) List(ctx context.Context, params domain.OrderListPa
 	if params.PaymentStatus != "" {
 		query.Where(entorder.PaymentStatusEQ(params.PaymentStatus))
 	}
+	if params.MemberID != uuid.Nil {
+		query.Where(entorder.MemberID(params.MemberID))
+	}
 
 	pageInfo := upagination.New(params.Page, params.Size)
 
@@ -365,6 +456,7 @@ func (repo *OrderRepository) createOrderProduct(ctx context.Context, op *domain.
 		SetIndex(op.Index).
 		SetProductID(op.ProductID).
 		SetProductName(op.ProductName).
+		SetProductNameI18n(op.ProductNameI18n).
 		SetProductType(op.ProductType).
 		SetQty(op.Qty).
 		SetIsGift(op.IsGift)
@@ -413,6 +505,9 @@ func (repo *OrderRepository) createOrderProduct(ctx context.Context, op *domain.
 	if !op.PromotionDiscount.IsZero() {
 		builder = builder.SetPromotionDiscount(op.PromotionDiscount)
 	}
+	if !op.MemberDiscount.IsZero() {
+		builder = builder.SetMemberDiscount(op.MemberDiscount)
+	}
 
 	// 做法金额与赠送金额
 	if !op.AttrAmount.IsZero() {
@@ -505,6 +600,7 @@ func convertOrderToDomain(eo *ent.Order) *domain.Order {
 		BusinessDate: eo.BusinessDate,
 		ShiftNo:      eo.ShiftNo,
 		OrderNo:      eo.OrderNo,
+		PickupNo:     eo.PickupNo,
 
 		OrderType: eo.OrderType,
 
@@ -532,6 +628,10 @@ func convertOrderToDomain(eo *ent.Order) *domain.Order {
 		Payments: eo.Payments,
 		Amount:   eo.Amount,
 
+		Promotions: eo.Promotions,
+
+		Member: eo.Member,
+
 		Remark: eo.Remark,
 
 		OperationLogs: eo.OperationLogs,
@@ -547,7 +647,7 @@ func convertOrderProductToDomain(eop *ent.OrderProduct) domain.OrderProduct {
 	}
 
 	var subtotal, discountAmount, amountBeforeTax, taxRate, tax, amountAfterTax, total decimal.Decimal
-	var promotionDiscount, voidAmount, price, attrAmount, giftAmount decimal.Decimal
+	var promotionDiscount, memberDiscount, voidAmount, price, attrAmount, giftAmount decimal.Decimal
 
 	if eop.Subtotal != nil {
 		subtotal = *eop.Subtotal
@@ -573,6 +673,9 @@ func convertOrderProductToDomain(eop *ent.OrderProduct) domain.OrderProduct {
 	if eop.PromotionDiscount != nil {
 		promotionDiscount = *eop.PromotionDiscount
 	}
+	if eop.MemberDiscount != nil {
+		memberDiscount = *eop.MemberDiscount
+	}
 	if eop.AttrAmount != nil {
 		attrAmount = *eop.AttrAmount
 	}
@@ -595,13 +698,14 @@ func convertOrderProductToDomain(eop *ent.OrderProduct) domain.OrderProduct {
 		OrderItemID: eop.OrderItemID,
 		Index:       eop.Index,
 
-		ProductID:   eop.ProductID,
-		ProductName: eop.ProductName,
-		ProductType: eop.ProductType,
-		Category:    eop.Category,
-		ProductUnit: eop.ProductUnit,
-		MainImage:   eop.MainImage,
-		Description: eop.Description,
+		ProductID:       eop.ProductID,
+		ProductName:     eop.ProductName,
+		ProductNameI18n: eop.ProductNameI18n,
+		ProductType:     eop.ProductType,
+		Category:        eop.Category,
+		ProductUnit:     eop.ProductUnit,
+		MainImage:       eop.MainImage,
+		Description:     eop.Description,
 
 		Qty:             eop.Qty,
 		IsGift:          eop.IsGift,
@@ -616,6 +720,8 @@ func convertOrderProductToDomain(eop *ent.OrderProduct) domain.OrderProduct {
 
 		PromotionDiscount: promotionDiscount,
 
+		MemberDiscount: memberDiscount,
+
 		AttrAmount: attrAmount,
 		GiftAmount: giftAmount,
 
@@ -776,6 +882,10 @@ func (repo *OrderRepository) ProductSalesSummary(ctx context.Context, params dom
 		util.SpanErrFinish(span, err)
 	}()
 
+	if params.SetMealComponents {
+		return repo.setMealComponentSalesSummary(ctx, params)
+	}
+
 	type productSalesSummaryRow struct {
 		domain.ProductSalesSummaryItem
 		Total int `json:"total"`
@@ -936,6 +1046,154 @@ func (repo *OrderRepository) ProductSalesSummary(ctx context.Context, params dom
 	return res, total, nil
 }
 
+// setMealComponentBatchSize 套餐子商品汇总每批读取的订单商品数
+const setMealComponentBatchSize = 500
+
+// setMealComponentSalesSummary 按套餐子商品汇总销售，数量与金额取自下单时的套餐快照（分摊金额）
+func (repo *OrderRepository) setMealComponentSalesSummary(ctx context.Context, params domain.ProductSalesSummaryParams) (res []*domain.ProductSalesSummaryItem, total int, err error) {
+	orderPredicates := []predicate.Order{
+		entorder.MerchantID(params.MerchantID),
+		entorder.OrderTypeEQ(domain.OrderTypeSale),
+		entorder.PaymentStatusEQ(domain.PaymentStatusPaid),
+	}
+	if len(params.StoreIDs) > 0 {
+		orderPredicates = append(orderPredicates, entorder.StoreIDIn(params.StoreIDs...))
+	}
+	if params.BusinessDateStart != "" {
+		orderPredicates = append(orderPredicates, entorder.BusinessDateGTE(params.BusinessDateStart))
+	}
+	if params.BusinessDateEnd != "" {
+		orderPredicates = append(orderPredicates, entorder.BusinessDateLTE(params.BusinessDateEnd))
+	}
+	if params.OrderChannel != "" {
+		orderPredicates = append(orderPredicates, entorder.ChannelEQ(params.OrderChannel))
+	}
+
+	// 按子商品分类过滤：子商品所属分类或其父分类匹配
+	var categoryProductIDs map[uuid.UUID]struct{}
+	if params.CategoryID != uuid.Nil {
+		ids, err := repo.Client.Product.Query().
+			Where(
+				entproduct.MerchantID(params.MerchantID),
+				entproduct.Or(
+					entproduct.CategoryID(params.CategoryID),
+					entproduct.HasCategoryWith(entcategory.ParentID(params.CategoryID)),
+				),
+			).
+			IDs(ctx)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to query category products: %w", err)
+		}
+		if len(ids) == 0 {
+			return []*domain.ProductSalesSummaryItem{}, 0, nil
+		}
+		categoryProductIDs = make(map[uuid.UUID]struct{}, len(ids))
+		for _, id := range ids {
+			categoryProductIDs[id] = struct{}{}
+		}
+	}
+
+	// 按ID分批读取套餐商品快照，内存中仅保留按子商品累计的汇总结果
+	items := make(map[uuid.UUID]*domain.ProductSalesSummaryItem)
+	lastID := uuid.Nil
+	for {
+		eops, err := repo.Client.OrderProduct.Query().
+			Where(
+				entorderproduct.HasOrderWith(orderPredicates...),
+				entorderproduct.ProductTypeEQ(domain.ProductTypeSetMeal),
+				entorderproduct.IDGT(lastID),
+			).
+			Order(ent.Asc(entorderproduct.FieldID)).
+			Limit(setMealComponentBatchSize).
+			Select(
+				entorderproduct.FieldID,
+				entorderproduct.FieldQty,
+				entorderproduct.FieldVoidQty,
+				entorderproduct.FieldGroups,
+			).
+			All(ctx)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to query set meal order products: %w", err)
+		}
+		for _, eop := range eops {
+			addSetMealComponentSales(items, eop, params.ProductName, categoryProductIDs)
+		}
+		if len(eops) < setMealComponentBatchSize {
+			break
+		}
+		lastID = eops[len(eops)-1].ID
+	}
+
+	list := lo.Values(items)
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].SalesAmount.Equal(list[j].SalesAmount) {
+			return list[i].SalesAmount.GreaterThan(list[j].SalesAmount)
+		}
+		return list[i].ProductID.String() < list[j].ProductID.String()
+	})
+	for _, item := range list {
+		item.SalesAmount = item.SalesAmount.Round(2)
+		item.Subtotal = item.Subtotal.Round(2)
+		item.RefundAmount = item.RefundAmount.Round(2)
+		if item.SalesQty > 0 {
+			item.AvgPrice = item.SalesAmount.Div(decimal.NewFromInt(int64(item.SalesQty))).Round(2)
+		}
+	}
+
+	pageInfo := upagination.New(params.Page, params.Size)
+	total = len(list)
+	start := min(pageInfo.Offset(), total)
+	end := min(start+pageInfo.Size, total)
+	return list[start:end], total, nil
+}
+
+// addSetMealComponentSales 将一行套餐商品的子商品销售累加到汇总结果，categoryProductIDs 为空表示不按分类过滤
+func addSetMealComponentSales(
+	items map[uuid.UUID]*domain.ProductSalesSummaryItem,
+	eop *ent.OrderProduct,
+	productName string,
+	categoryProductIDs map[uuid.UUID]struct{},
+) {
+	if eop.Qty <= 0 {
+		return
+	}
+	// 退菜部分按比例扣减分摊金额
+	soldRatio := decimal.NewFromInt(int64(eop.Qty - eop.VoidQty)).Div(decimal.NewFromInt(int64(eop.Qty)))
+	for _, group := range eop.Groups {
+		for _, detail := range group.Details {
+			name := detail.SelectedProductName
+			if name == "" && detail.Product != nil {
+				name = detail.Product.Name
+			}
+			if productName != "" && !strings.Contains(name, productName) {
+				continue
+			}
+
+			productID := detail.ServedProductID()
+			if categoryProductIDs != nil {
+				if _, ok := categoryProductIDs[productID]; !ok {
+					continue
+				}
+			}
+			item, ok := items[productID]
+			if !ok {
+				item = &domain.ProductSalesSummaryItem{
+					ProductID:   productID,
+					ProductName: name,
+					ProductType: domain.ProductTypeNormal,
+				}
+				items[productID] = item
+			}
+			voidAmount := detail.AllocatedAmount.Sub(detail.AllocatedAmount.Mul(soldRatio))
+			item.SalesQty += detail.Quantity * (eop.Qty - eop.VoidQty)
+			item.RefundQty += detail.Quantity * eop.VoidQty
+			item.Subtotal = item.Subtotal.Add(detail.AllocatedAmount)
+			item.SalesAmount = item.SalesAmount.Add(detail.AllocatedAmount.Sub(voidAmount))
+			item.RefundAmount = item.RefundAmount.Add(voidAmount)
+		}
+	}
+}
+
 func (repo *OrderRepository) ProductSalesDetail(ctx context.Context, params domain.ProductSalesDetailParams) (res []*domain.ProductSalesDetailItem, total int, err error) {
 	span, ctx := util.StartSpan(ctx, "repository", "OrderRepository.ProductSalesDetail")
 	defer func() {
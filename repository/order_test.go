@@ -138,6 +138,64 @@ func (s *OrderTestSuite) TestOrder_FindByID() {
 	})
 }
 
+func (s *OrderTestSuite) TestOrder_FindOpenByTableID() {
+	storeID := uuid.NewString()
+	tableID := uuid.New()
+
+	paid := s.newTestOrder(storeID, "NO-TABLE-PAID")
+	paid.TableID = tableID
+	paid.PaymentStatus = domain.PaymentStatusPaid
+	require.NoError(s.T(), s.repo.Create(s.ctx, paid))
+
+	s.T().Run("桌台没有未结账订单", func(t *testing.T) {
+		_, err := s.repo.FindOpenByTableID(s.ctx, tableID)
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("查询未结账订单", func(t *testing.T) {
+		open := s.newTestOrder(storeID, "NO-TABLE-OPEN")
+		open.TableID = tableID
+		open.OrderProducts = []domain.OrderProduct{
+			{ID: uuid.New(), OrderItemID: "item-1", Index: 1, ProductID: uuid.New(), ProductName: "宫保鸡丁", ProductType: domain.ProductTypeNormal, Qty: 1},
+		}
+		require.NoError(t, s.repo.Create(s.ctx, open))
+
+		found, err := s.repo.FindOpenByTableID(s.ctx, tableID)
+		require.NoError(t, err)
+		require.Equal(t, open.ID, found.ID)
+		require.Len(t, found.OrderProducts, 1)
+	})
+}
+
+func (s *OrderTestSuite) TestOrder_ListTableTurns() {
+	storeID := uuid.NewString()
+	tableID := uuid.New()
+	now := time.Now().Truncate(time.Second)
+	newOrder := func(orderNo string, paymentStatus domain.PaymentStatus, placedAt, paidAt time.Time) {
+		o := s.newTestOrder(storeID, orderNo)
+		o.TableID = tableID
+		o.PaymentStatus = paymentStatus
+		o.PlacedAt = placedAt
+		o.PaidAt = paidAt
+		require.NoError(s.T(), s.repo.Create(s.ctx, o))
+	}
+	newOrder("NO-TURN-1", domain.PaymentStatusPaid, now.Add(-3*time.Hour), now.Add(-2*time.Hour))
+	newOrder("NO-TURN-2", domain.PaymentStatusPaid, now.Add(-90*time.Minute), now.Add(-30*time.Minute))
+	newOrder("NO-TURN-OLD", domain.PaymentStatusPaid, now.Add(-50*time.Hour), now.Add(-49*time.Hour))
+	newOrder("NO-TURN-OPEN", domain.PaymentStatusUnpaid, now.Add(-10*time.Minute), time.Time{})
+
+	turns, err := s.repo.ListTableTurns(s.ctx, uuid.MustParse(storeID), now.Add(-24*time.Hour), 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), turns, 2)
+	require.Equal(s.T(), tableID, turns[0].TableID)
+	require.Equal(s.T(), time.Hour, turns[0].PaidAt.Sub(turns[0].PlacedAt))
+	require.True(s.T(), turns[0].PaidAt.After(turns[1].PaidAt))
+
+	turns, err = s.repo.ListTableTurns(s.ctx, uuid.MustParse(storeID), now.Add(-24*time.Hour), 1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), turns, 1)
+}
+
 func (s *OrderTestSuite) TestOrder_Update() {
 	storeID := uuid.NewString()
 	order := s.newTestOrder(storeID, "NO-UPD")
@@ -383,3 +441,109 @@ func (s *OrderTestSuite) TestOrder_CreateWithProducts() {
 		require.True(t, op.GiftAmount.Equal(decimal.NewFromInt(10)))
 	})
 }
+
+func (s *OrderTestSuite) TestOrder_SetMealComponentSalesSummary() {
+	s.T().Run("按套餐子商品汇总分摊金额", func(t *testing.T) {
+		order := s.newTestOrder(uuid.NewString(), "NO-SETMEAL-001")
+		order.OrderType = domain.OrderTypeSale
+		order.PaymentStatus = domain.PaymentStatusPaid
+
+		burgerID, friesID, saladID := uuid.New(), uuid.New(), uuid.New()
+		order.OrderProducts = []domain.OrderProduct{
+			{
+				ID:          uuid.New(),
+				OrderItemID: "item-001",
+				Index:       1,
+				ProductID:   uuid.New(),
+				ProductName: "汉堡套餐",
+				ProductType: domain.ProductTypeSetMeal,
+				Qty:         2,
+				VoidQty:     1,
+				Price:       decimal.NewFromInt(30),
+				Subtotal:    decimal.NewFromInt(60),
+				Total:       decimal.NewFromInt(60),
+				Groups: domain.SetMealGroups{
+					{
+						ID:   uuid.New(),
+						Name: "主食",
+						Details: domain.SetMealDetails{
+							{ID: uuid.New(), ProductID: burgerID, SelectedProductName: "汉堡", Quantity: 1, AllocatedAmount: decimal.NewFromInt(40)},
+						},
+					},
+					{
+						ID:            uuid.New(),
+						Name:          "小食",
+						SelectionType: domain.SetMealGroupSelectionTypeOptional,
+						Details: domain.SetMealDetails{
+							{ID: uuid.New(), ProductID: friesID, SelectedProductID: saladID, SelectedProductName: "沙拉", Quantity: 2, AllocatedAmount: decimal.NewFromInt(20)},
+						},
+					},
+				},
+			},
+		}
+		require.NoError(t, s.repo.Create(s.ctx, order))
+
+		items, total, err := s.repo.ProductSalesSummary(s.ctx, domain.ProductSalesSummaryParams{
+			MerchantID:        order.MerchantID,
+			SetMealComponents: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+
+		require.Equal(t, burgerID, items[0].ProductID)
+		require.Equal(t, 1, items[0].SalesQty)
+		require.Equal(t, 1, items[0].RefundQty)
+		require.True(t, items[0].SalesAmount.Equal(decimal.NewFromInt(20)))
+		require.True(t, items[0].Subtotal.Equal(decimal.NewFromInt(40)))
+
+		// 替换后的子商品按实际出品商品统计
+		require.Equal(t, saladID, items[1].ProductID)
+		require.Equal(t, "沙拉", items[1].ProductName)
+		require.Equal(t, 2, items[1].SalesQty)
+		require.True(t, items[1].SalesAmount.Equal(decimal.NewFromInt(10)))
+
+		items, total, err = s.repo.ProductSalesSummary(s.ctx, domain.ProductSalesSummaryParams{
+			MerchantID:        order.MerchantID,
+			ProductName:       "沙拉",
+			SetMealComponents: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		require.Equal(t, saladID, items[0].ProductID)
+
+		// 按子商品分类过滤，匹配一级分类时包含其二级分类下的商品
+		parentID, childID, otherID := uuid.New(), uuid.New(), uuid.New()
+		s.client.Category.Create().SetID(parentID).SetName("主食").SetMerchantID(order.MerchantID).SaveX(s.ctx)
+		s.client.Category.Create().SetID(childID).SetName("汉堡").SetMerchantID(order.MerchantID).SetParentID(parentID).SaveX(s.ctx)
+		s.client.Category.Create().SetID(otherID).SetName("小食").SetMerchantID(order.MerchantID).SaveX(s.ctx)
+		for id, categoryID := range map[uuid.UUID]uuid.UUID{burgerID: childID, saladID: otherID} {
+			s.client.Product.Create().
+				SetID(id).
+				SetName(id.String()).
+				SetMerchantID(order.MerchantID).
+				SetCategoryID(categoryID).
+				SetUnitID(uuid.New()).
+				SetSupportTypes([]domain.ProductSupportType{}).
+				SetSaleChannels([]domain.SaleChannel{}).
+				SaveX(s.ctx)
+		}
+
+		items, total, err = s.repo.ProductSalesSummary(s.ctx, domain.ProductSalesSummaryParams{
+			MerchantID:        order.MerchantID,
+			CategoryID:        parentID,
+			SetMealComponents: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		require.Equal(t, burgerID, items[0].ProductID)
+
+		items, total, err = s.repo.ProductSalesSummary(s.ctx, domain.ProductSalesSummaryParams{
+			MerchantID:        order.MerchantID,
+			CategoryID:        uuid.New(),
+			SetMealComponents: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 0, total)
+		require.Empty(t, items)
+	})
+}
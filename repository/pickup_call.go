@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickupcall"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.PickupCallRepository = (*PickupCallRepository)(nil)
+
+type PickupCallRepository struct {
+	Client *ent.Client
+}
+
+func NewPickupCallRepository(client *ent.Client) *PickupCallRepository {
+	return &PickupCallRepository{
+		Client: client,
+	}
+}
+
+func (repo *PickupCallRepository) Create(ctx context.Context, call *domain.PickupCall) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PickupCallRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if call == nil {
+		return fmt.Errorf("pickup call is nil")
+	}
+
+	ec, err := repo.Client.PickupCall.Create().
+		SetID(call.ID).
+		SetMerchantID(call.MerchantID).
+		SetStoreID(call.StoreID).
+		SetOrderID(call.OrderID).
+		SetOrderNo(call.OrderNo).
+		SetPickupNo(call.PickupNo).
+		SetBusinessDate(call.BusinessDate).
+		SetStatus(call.Status).
+		SetCallCount(call.CallCount).
+		SetNillableReadyAt(call.ReadyAt).
+		SetNillablePickedUpAt(call.PickedUpAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create pickup call: %w", err)
+	}
+
+	call.CreatedAt = ec.CreatedAt
+	call.UpdatedAt = ec.UpdatedAt
+	return nil
+}
+
+func (repo *PickupCallRepository) Update(ctx context.Context, call *domain.PickupCall) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PickupCallRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if call == nil {
+		return fmt.Errorf("pickup call is nil")
+	}
+
+	ec, err := repo.Client.PickupCall.UpdateOneID(call.ID).
+		SetStatus(call.Status).
+		SetCallCount(call.CallCount).
+		SetNillableReadyAt(call.ReadyAt).
+		SetNillablePickedUpAt(call.PickedUpAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrPickupCallNotExists)
+		}
+		return fmt.Errorf("failed to update pickup call: %w", err)
+	}
+
+	call.UpdatedAt = ec.UpdatedAt
+	return nil
+}
+
+func (repo *PickupCallRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.PickupCall, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PickupCallRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ec, err := repo.Client.PickupCall.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrPickupCallNotExists)
+		}
+		return nil, fmt.Errorf("failed to find pickup call: %w", err)
+	}
+	return convertPickupCallToDomain(ec), nil
+}
+
+func (repo *PickupCallRepository) FindByOrderID(ctx context.Context, orderID uuid.UUID) (res *domain.PickupCall, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PickupCallRepository.FindByOrderID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ec, err := repo.Client.PickupCall.Query().
+		Where(pickupcall.OrderID(orderID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrPickupCallNotExists)
+		}
+		return nil, fmt.Errorf("failed to find pickup call: %w", err)
+	}
+	return convertPickupCallToDomain(ec), nil
+}
+
+func (repo *PickupCallRepository) List(ctx context.Context, params domain.PickupCallListParams) (res []*domain.PickupCall, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PickupCallRepository.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.PickupCall.Query().
+		Where(
+			pickupcall.StoreID(params.StoreID),
+			pickupcall.BusinessDate(params.BusinessDate),
+			pickupcall.StatusEQ(params.Status),
+		)
+	switch params.Status {
+	case domain.PickupCallStatusReady:
+		query.Order(ent.Desc(pickupcall.FieldReadyAt))
+	case domain.PickupCallStatusPickedUp:
+		query.Order(ent.Desc(pickupcall.FieldPickedUpAt))
+	default:
+		query.Order(ent.Asc(pickupcall.FieldCreatedAt))
+	}
+	if params.Limit > 0 {
+		query.Limit(params.Limit)
+	}
+
+	calls, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pickup calls: %w", err)
+	}
+	return lo.Map(calls, func(item *ent.PickupCall, _ int) *domain.PickupCall {
+		return convertPickupCallToDomain(item)
+	}), nil
+}
+
+func convertPickupCallToDomain(ec *ent.PickupCall) *domain.PickupCall {
+	if ec == nil {
+		return nil
+	}
+	return &domain.PickupCall{
+		ID:           ec.ID,
+		MerchantID:   ec.MerchantID,
+		StoreID:      ec.StoreID,
+		OrderID:      ec.OrderID,
+		OrderNo:      ec.OrderNo,
+		PickupNo:     ec.PickupNo,
+		BusinessDate: ec.BusinessDate,
+		Status:       ec.Status,
+		CallCount:    ec.CallCount,
+		ReadyAt:      ec.ReadyAt,
+		PickedUpAt:   ec.PickedUpAt,
+		CreatedAt:    ec.CreatedAt,
+		UpdatedAt:    ec.UpdatedAt,
+	}
+}
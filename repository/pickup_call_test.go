@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type PickupCallRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *PickupCallRepository
+	ctx  context.Context
+}
+
+func TestPickupCallRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PickupCallRepositoryTestSuite))
+}
+
+func (s *PickupCallRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &PickupCallRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *PickupCallRepositoryTestSuite) TestPickupCall_Flow() {
+	storeID := uuid.New()
+	businessDate := "2026-10-24"
+	newCall := func(pickupNo string) *domain.PickupCall {
+		call := domain.NewPickupCall(&domain.Order{
+			ID:           uuid.New(),
+			MerchantID:   uuid.New(),
+			StoreID:      storeID,
+			OrderNo:      "S00120261024" + pickupNo,
+			PickupNo:     pickupNo,
+			BusinessDate: businessDate,
+		})
+		require.NoError(s.T(), s.repo.Create(s.ctx, call))
+		return call
+	}
+	first := newCall("001")
+	second := newCall("002")
+	third := newCall("003")
+
+	s.T().Run("同一订单只有一个取餐号", func(t *testing.T) {
+		dup := domain.NewPickupCall(&domain.Order{ID: first.OrderID, StoreID: storeID, PickupNo: "004", BusinessDate: businessDate})
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, dup)))
+
+		got, err := s.repo.FindByOrderID(s.ctx, first.OrderID)
+		require.NoError(t, err)
+		require.Equal(t, first.ID, got.ID)
+		require.Equal(t, "001", got.PickupNo)
+	})
+
+	s.T().Run("叫号与取餐", func(t *testing.T) {
+		now := time.Now().Truncate(time.Second)
+		require.NoError(t, first.Call(now))
+		require.NoError(t, s.repo.Update(s.ctx, first))
+		require.NoError(t, second.Call(now.Add(time.Minute)))
+		require.NoError(t, s.repo.Update(s.ctx, second))
+		require.NoError(t, first.PickUp(now.Add(2*time.Minute)))
+		require.NoError(t, s.repo.Update(s.ctx, first))
+
+		got, err := s.repo.FindByID(s.ctx, first.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.PickupCallStatusPickedUp, got.Status)
+		require.Equal(t, 1, got.CallCount)
+		require.NotNil(t, got.ReadyAt)
+		require.NotNil(t, got.PickedUpAt)
+	})
+
+	s.T().Run("按状态查询", func(t *testing.T) {
+		list := func(status domain.PickupCallStatus) []string {
+			calls, err := s.repo.List(s.ctx, domain.PickupCallListParams{
+				StoreID:      storeID,
+				BusinessDate: businessDate,
+				Status:       status,
+			})
+			require.NoError(t, err)
+			nos := make([]string, 0, len(calls))
+			for _, c := range calls {
+				nos = append(nos, c.PickupNo)
+			}
+			return nos
+		}
+		require.Equal(t, []string{third.PickupNo}, list(domain.PickupCallStatusWaiting))
+		require.Equal(t, []string{second.PickupNo}, list(domain.PickupCallStatusReady))
+		require.Equal(t, []string{first.PickupNo}, list(domain.PickupCallStatusPickedUp))
+
+		calls, err := s.repo.List(s.ctx, domain.PickupCallListParams{
+			StoreID:      storeID,
+			BusinessDate: "2026-10-25",
+			Status:       domain.PickupCallStatusWaiting,
+		})
+		require.NoError(t, err)
+		require.Empty(t, calls)
+	})
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pickuprule"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.PickupRuleRepository = (*PickupRuleRepository)(nil)
+
+type PickupRuleRepository struct {
+	Client *ent.Client
+}
+
+func NewPickupRuleRepository(client *ent.Client) *PickupRuleRepository {
+	return &PickupRuleRepository{
+		Client: client,
+	}
+}
+
+func (repo *PickupRuleRepository) Create(ctx context.Context, rule *domain.PickupRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PickupRuleRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("pickup rule is nil")
+	}
+
+	er, err := repo.Client.PickupRule.Create().
+		SetID(rule.ID).
+		SetMerchantID(rule.MerchantID).
+		SetStoreID(rule.StoreID).
+		SetPrefix(rule.Prefix).
+		SetStart(rule.Start).
+		SetEnd(rule.End).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create pickup rule: %w", err)
+	}
+
+	rule.CreatedAt = er.CreatedAt
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *PickupRuleRepository) Update(ctx context.Context, rule *domain.PickupRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PickupRuleRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("pickup rule is nil")
+	}
+
+	er, err := repo.Client.PickupRule.UpdateOneID(rule.ID).
+		SetPrefix(rule.Prefix).
+		SetStart(rule.Start).
+		SetEnd(rule.End).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrPickupRuleNotExists)
+		}
+		return fmt.Errorf("failed to update pickup rule: %w", err)
+	}
+
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *PickupRuleRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PickupRuleRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.PickupRule.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrPickupRuleNotExists)
+		}
+		return fmt.Errorf("failed to delete pickup rule: %w", err)
+	}
+	return nil
+}
+
+func (repo *PickupRuleRepository) FindByStore(ctx context.Context, merchantID, storeID uuid.UUID) (res *domain.PickupRule, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PickupRuleRepository.FindByStore")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	er, err := repo.Client.PickupRule.Query().
+		Where(
+			pickuprule.MerchantID(merchantID),
+			pickuprule.StoreID(storeID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrPickupRuleNotExists)
+		}
+		return nil, fmt.Errorf("failed to find pickup rule: %w", err)
+	}
+	return convertPickupRuleToDomain(er), nil
+}
+
+func convertPickupRuleToDomain(er *ent.PickupRule) *domain.PickupRule {
+	if er == nil {
+		return nil
+	}
+	return &domain.PickupRule{
+		StoreRuleMeta: domain.StoreRuleMeta{
+			ID:         er.ID,
+			MerchantID: er.MerchantID,
+			StoreID:    er.StoreID,
+			CreatedAt:  er.CreatedAt,
+			UpdatedAt:  er.UpdatedAt,
+		},
+		Prefix: er.Prefix,
+		Start:  er.Start,
+		End:    er.End,
+	}
+}
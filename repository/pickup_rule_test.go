@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+)
+
+func TestPickupRuleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, &StoreRuleRepositoryTestSuite[*domain.PickupRule]{
+		newRepo: func(client *ent.Client) domain.StoreRuleRepository[*domain.PickupRule] {
+			return NewPickupRuleRepository(client)
+		},
+		newRule: domain.DefaultPickupRule,
+		update: func(rule *domain.PickupRule) {
+			rule.Prefix = "B"
+			rule.Start = 100
+			rule.End = 199
+		},
+		assertRule: func(t *testing.T, want, got *domain.PickupRule) {
+			require.Equal(t, want.Prefix, got.Prefix)
+			require.Equal(t, want.Start, got.Start)
+			require.Equal(t, want.End, got.End)
+		},
+	})
+}
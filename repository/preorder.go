@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorder"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.PreorderRepository = (*PreorderRepository)(nil)
+
+type PreorderRepository struct {
+	Client *ent.Client
+}
+
+func NewPreorderRepository(client *ent.Client) *PreorderRepository {
+	return &PreorderRepository{
+		Client: client,
+	}
+}
+
+func (repo *PreorderRepository) Create(ctx context.Context, p *domain.Preorder) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PreorderRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if p == nil {
+		return fmt.Errorf("preorder is nil")
+	}
+
+	ep, err := repo.Client.Preorder.Create().
+		SetID(p.ID).
+		SetMerchantID(p.MerchantID).
+		SetStoreID(p.StoreID).
+		SetCustomerID(p.CustomerID).
+		SetOrderID(p.OrderID).
+		SetOrderNo(p.OrderNo).
+		SetFulfilment(p.Fulfilment).
+		SetScheduledAt(p.ScheduledAt).
+		SetReleaseAt(p.ReleaseAt).
+		SetContactName(p.ContactName).
+		SetContactPhone(p.ContactPhone).
+		SetDeliveryAddress(p.DeliveryAddress).
+		SetStatus(p.Status).
+		SetNillableReleasedAt(p.ReleasedAt).
+		SetNillableCancelledAt(p.CancelledAt).
+		SetCancelReason(p.CancelReason).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create preorder: %w", err)
+	}
+
+	p.CreatedAt = ep.CreatedAt
+	p.UpdatedAt = ep.UpdatedAt
+	return nil
+}
+
+func (repo *PreorderRepository) Update(ctx context.Context, p *domain.Preorder) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PreorderRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if p == nil {
+		return fmt.Errorf("preorder is nil")
+	}
+
+	ep, err := repo.Client.Preorder.UpdateOneID(p.ID).
+		SetStatus(p.Status).
+		SetNillableReleasedAt(p.ReleasedAt).
+		SetNillableCancelledAt(p.CancelledAt).
+		SetCancelReason(p.CancelReason).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrPreorderNotExists)
+		}
+		return fmt.Errorf("failed to update preorder: %w", err)
+	}
+
+	p.UpdatedAt = ep.UpdatedAt
+	return nil
+}
+
+func (repo *PreorderRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.Preorder, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PreorderRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ep, err := repo.Client.Preorder.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrPreorderNotExists)
+		}
+		return nil, fmt.Errorf("failed to find preorder: %w", err)
+	}
+	return convertPreorderToDomain(ep), nil
+}
+
+func (repo *PreorderRepository) List(ctx context.Context, params domain.PreorderListParams) (res []*domain.Preorder, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PreorderRepository.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.Preorder.Query()
+	if params.StoreID != uuid.Nil {
+		query.Where(preorder.StoreID(params.StoreID))
+	}
+	if params.CustomerID != uuid.Nil {
+		query.Where(preorder.CustomerID(params.CustomerID))
+	}
+	if len(params.Statuses) > 0 {
+		query.Where(preorder.StatusIn(params.Statuses...))
+	}
+	if !params.From.IsZero() {
+		query.Where(preorder.ScheduledAtGTE(params.From))
+	}
+	if !params.To.IsZero() {
+		query.Where(preorder.ScheduledAtLT(params.To))
+	}
+
+	preorders, err := query.Order(ent.Asc(preorder.FieldScheduledAt), ent.Asc(preorder.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list preorders: %w", err)
+	}
+	return lo.Map(preorders, func(item *ent.Preorder, _ int) *domain.Preorder {
+		return convertPreorderToDomain(item)
+	}), nil
+}
+
+func convertPreorderToDomain(ep *ent.Preorder) *domain.Preorder {
+	if ep == nil {
+		return nil
+	}
+	return &domain.Preorder{
+		ID:              ep.ID,
+		MerchantID:      ep.MerchantID,
+		StoreID:         ep.StoreID,
+		CustomerID:      ep.CustomerID,
+		OrderID:         ep.OrderID,
+		OrderNo:         ep.OrderNo,
+		Fulfilment:      ep.Fulfilment,
+		ScheduledAt:     ep.ScheduledAt,
+		ReleaseAt:       ep.ReleaseAt,
+		ContactName:     ep.ContactName,
+		ContactPhone:    ep.ContactPhone,
+		DeliveryAddress: ep.DeliveryAddress,
+		Status:          ep.Status,
+		ReleasedAt:      ep.ReleasedAt,
+		CancelledAt:     ep.CancelledAt,
+		CancelReason:    ep.CancelReason,
+		CreatedAt:       ep.CreatedAt,
+		UpdatedAt:       ep.UpdatedAt,
+	}
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/preorderrule"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.PreorderRuleRepository = (*PreorderRuleRepository)(nil)
+
+type PreorderRuleRepository struct {
+	Client *ent.Client
+}
+
+func NewPreorderRuleRepository(client *ent.Client) *PreorderRuleRepository {
+	return &PreorderRuleRepository{
+		Client: client,
+	}
+}
+
+func (repo *PreorderRuleRepository) Create(ctx context.Context, rule *domain.PreorderRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PreorderRuleRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("preorder rule is nil")
+	}
+
+	er, err := repo.Client.PreorderRule.Create().
+		SetID(rule.ID).
+		SetMerchantID(rule.MerchantID).
+		SetStoreID(rule.StoreID).
+		SetEnabled(rule.Enabled).
+		SetDeliveryEnabled(rule.DeliveryEnabled).
+		SetSlotMinutes(rule.SlotMinutes).
+		SetSlotCapacity(rule.SlotCapacity).
+		SetLeadMinutes(rule.LeadMinutes).
+		SetPrepMinutes(rule.PrepMinutes).
+		SetMaxAdvanceDays(rule.MaxAdvanceDays).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create preorder rule: %w", err)
+	}
+
+	rule.CreatedAt = er.CreatedAt
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *PreorderRuleRepository) Update(ctx context.Context, rule *domain.PreorderRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PreorderRuleRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("preorder rule is nil")
+	}
+
+	er, err := repo.Client.PreorderRule.UpdateOneID(rule.ID).
+		SetEnabled(rule.Enabled).
+		SetDeliveryEnabled(rule.DeliveryEnabled).
+		SetSlotMinutes(rule.SlotMinutes).
+		SetSlotCapacity(rule.SlotCapacity).
+		SetLeadMinutes(rule.LeadMinutes).
+		SetPrepMinutes(rule.PrepMinutes).
+		SetMaxAdvanceDays(rule.MaxAdvanceDays).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrPreorderRuleNotExists)
+		}
+		return fmt.Errorf("failed to update preorder rule: %w", err)
+	}
+
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *PreorderRuleRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PreorderRuleRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.PreorderRule.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrPreorderRuleNotExists)
+		}
+		return fmt.Errorf("failed to delete preorder rule: %w", err)
+	}
+	return nil
+}
+
+func (repo *PreorderRuleRepository) FindByStore(ctx context.Context, merchantID, storeID uuid.UUID) (res *domain.PreorderRule, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PreorderRuleRepository.FindByStore")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	er, err := repo.Client.PreorderRule.Query().
+		Where(
+			preorderrule.MerchantID(merchantID),
+			preorderrule.StoreID(storeID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrPreorderRuleNotExists)
+		}
+		return nil, fmt.Errorf("failed to find preorder rule: %w", err)
+	}
+	return convertPreorderRuleToDomain(er), nil
+}
+
+func convertPreorderRuleToDomain(er *ent.PreorderRule) *domain.PreorderRule {
+	if er == nil {
+		return nil
+	}
+	return &domain.PreorderRule{
+		StoreRuleMeta: domain.StoreRuleMeta{
+			ID:         er.ID,
+			MerchantID: er.MerchantID,
+			StoreID:    er.StoreID,
+			CreatedAt:  er.CreatedAt,
+			UpdatedAt:  er.UpdatedAt,
+		},
+		Enabled:         er.Enabled,
+		DeliveryEnabled: er.DeliveryEnabled,
+		SlotMinutes:     er.SlotMinutes,
+		SlotCapacity:    er.SlotCapacity,
+		LeadMinutes:     er.LeadMinutes,
+		PrepMinutes:     er.PrepMinutes,
+		MaxAdvanceDays:  er.MaxAdvanceDays,
+	}
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+)
+
+func TestPreorderRuleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, &StoreRuleRepositoryTestSuite[*domain.PreorderRule]{
+		newRepo: func(client *ent.Client) domain.StoreRuleRepository[*domain.PreorderRule] {
+			return NewPreorderRuleRepository(client)
+		},
+		newRule: domain.DefaultPreorderRule,
+		update: func(rule *domain.PreorderRule) {
+			rule.Enabled = true
+			rule.DeliveryEnabled = true
+			rule.SlotCapacity = 25
+			rule.PrepMinutes = 40
+		},
+		assertRule: func(t *testing.T, want, got *domain.PreorderRule) {
+			require.Equal(t, want.Enabled, got.Enabled)
+			require.Equal(t, want.DeliveryEnabled, got.DeliveryEnabled)
+			require.Equal(t, want.SlotMinutes, got.SlotMinutes)
+			require.Equal(t, want.SlotCapacity, got.SlotCapacity)
+			require.Equal(t, want.LeadMinutes, got.LeadMinutes)
+			require.Equal(t, want.PrepMinutes, got.PrepMinutes)
+			require.Equal(t, want.MaxAdvanceDays, got.MaxAdvanceDays)
+		},
+	})
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type PreorderRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *PreorderRepository
+	ctx  context.Context
+}
+
+func TestPreorderRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PreorderRepositoryTestSuite))
+}
+
+func (s *PreorderRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &PreorderRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *PreorderRepositoryTestSuite) TestPreorder_Flow() {
+	merchantID := uuid.New()
+	storeID := uuid.New()
+	customerID := uuid.New()
+	day := time.Date(2026, 10, 28, 0, 0, 0, 0, time.Local)
+	newPreorder := func(at time.Time, customerID uuid.UUID, fulfilment domain.PreorderFulfilment) *domain.Preorder {
+		p := &domain.Preorder{
+			ID:           uuid.New(),
+			MerchantID:   merchantID,
+			StoreID:      storeID,
+			CustomerID:   customerID,
+			OrderID:      uuid.New(),
+			OrderNo:      "S0012026102800000" + at.Format("15"),
+			Fulfilment:   fulfilment,
+			ScheduledAt:  at,
+			ReleaseAt:    at.Add(-20 * time.Minute),
+			ContactName:  "张三",
+			ContactPhone: "13800000000",
+			Status:       domain.PreorderStatusScheduled,
+		}
+		if fulfilment == domain.PreorderFulfilmentDelivery {
+			p.DeliveryAddress = "科技园路1号"
+		}
+		require.NoError(s.T(), s.repo.Create(s.ctx, p))
+		return p
+	}
+	dinner := newPreorder(day.Add(18*time.Hour), uuid.New(), domain.PreorderFulfilmentDelivery)
+	lunch := newPreorder(day.Add(12*time.Hour+30*time.Minute), customerID, domain.PreorderFulfilmentPickup)
+	nextDay := newPreorder(day.Add(36*time.Hour), customerID, domain.PreorderFulfilmentPickup)
+
+	s.T().Run("订单唯一", func(t *testing.T) {
+		dup := *lunch
+		dup.ID = uuid.New()
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, &dup)))
+	})
+
+	s.T().Run("送厨与取消", func(t *testing.T) {
+		now := day.Add(12*time.Hour + 10*time.Minute).Truncate(time.Second)
+		require.NoError(t, lunch.Release(now))
+		require.NoError(t, s.repo.Update(s.ctx, lunch))
+		require.NoError(t, dinner.Cancel("临时有事", now))
+		require.NoError(t, s.repo.Update(s.ctx, dinner))
+
+		got, err := s.repo.FindByID(s.ctx, lunch.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.PreorderStatusReleased, got.Status)
+		require.NotNil(t, got.ReleasedAt)
+		require.True(t, got.ScheduledAt.Equal(lunch.ScheduledAt))
+		require.True(t, got.ReleaseAt.Equal(lunch.ReleaseAt))
+
+		got, err = s.repo.FindByID(s.ctx, dinner.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.PreorderStatusCancelled, got.Status)
+		require.Equal(t, "临时有事", got.CancelReason)
+		require.Equal(t, domain.PreorderFulfilmentDelivery, got.Fulfilment)
+		require.Equal(t, "科技园路1号", got.DeliveryAddress)
+	})
+
+	s.T().Run("按日期和状态查询", func(t *testing.T) {
+		list, err := s.repo.List(s.ctx, domain.PreorderListParams{
+			StoreID: storeID,
+			From:    day,
+			To:      day.AddDate(0, 0, 1),
+		})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, lunch.ID, list[0].ID)
+		require.Equal(t, dinner.ID, list[1].ID)
+
+		list, err = s.repo.List(s.ctx, domain.PreorderListParams{
+			StoreID:  storeID,
+			Statuses: []domain.PreorderStatus{domain.PreorderStatusScheduled},
+		})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, nextDay.ID, list[0].ID)
+	})
+
+	s.T().Run("按顾客查询", func(t *testing.T) {
+		list, err := s.repo.List(s.ctx, domain.PreorderListParams{CustomerID: customerID})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, lunch.ID, list[0].ID)
+		require.Equal(t, nextDay.ID, list[1].ID)
+	})
+
+	s.T().Run("不存在", func(t *testing.T) {
+		_, err := s.repo.FindByID(s.ctx, uuid.New())
+		require.True(t, domain.IsNotFound(err))
+	})
+}
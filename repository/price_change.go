@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangebatch"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/pricechangeitem"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.PriceChangeRepository = (*PriceChangeRepository)(nil)
+
+type PriceChangeRepository struct {
+	Client *ent.Client
+}
+
+func NewPriceChangeRepository(client *ent.Client) *PriceChangeRepository {
+	return &PriceChangeRepository{
+		Client: client,
+	}
+}
+
+// Create 创建调价批次及明细
+func (repo *PriceChangeRepository) Create(ctx context.Context, batch *domain.PriceChangeBatch) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PriceChangeRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if batch == nil {
+		return fmt.Errorf("price change batch is nil")
+	}
+
+	eb, err := repo.Client.PriceChangeBatch.Create().
+		SetID(batch.ID).
+		SetName(batch.Name).
+		SetMerchantID(batch.MerchantID).
+		SetStoreID(batch.StoreID).
+		SetStoreIds(batch.StoreIDs).
+		SetEffectiveAt(batch.EffectiveAt).
+		SetStatus(batch.Status).
+		SetRemark(batch.Remark).
+		SetOperatorID(batch.OperatorID).
+		SetOperatorName(batch.OperatorName).
+		SetOperationLogs(batch.OperationLogs).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(batch.Items) > 0 {
+		builders := make([]*ent.PriceChangeItemCreate, 0, len(batch.Items))
+		for _, item := range batch.Items {
+			builders = append(builders, repo.Client.PriceChangeItem.Create().
+				SetID(item.ID).
+				SetBatchID(batch.ID).
+				SetTargetType(item.TargetType).
+				SetProductID(item.ProductID).
+				SetSpecID(item.SpecID).
+				SetStoreID(item.StoreID).
+				SetProductName(item.ProductName).
+				SetSpecName(item.SpecName).
+				SetNewPrice(item.NewPrice))
+		}
+		if _, err = repo.Client.PriceChangeItem.CreateBulk(builders...).Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	batch.CreatedAt = eb.CreatedAt
+	batch.UpdatedAt = eb.UpdatedAt
+	return nil
+}
+
+// FindByID 查询调价批次详情（包含明细）
+func (repo *PriceChangeRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.PriceChangeBatch, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PriceChangeRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	eb, err := repo.Client.PriceChangeBatch.Query().
+		Where(pricechangebatch.ID(id)).
+		WithItems(func(query *ent.PriceChangeItemQuery) {
+			query.Order(ent.Asc(pricechangeitem.FieldCreatedAt))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrPriceChangeBatchNotExists)
+		}
+		return nil, err
+	}
+
+	return convertPriceChangeBatchToDomain(eb), nil
+}
+
+// FindForUpdate 锁定查询调价批次（用于执行、取消、回滚的并发控制）
+func (repo *PriceChangeRepository) FindForUpdate(ctx context.Context, id uuid.UUID) (res *domain.PriceChangeBatch, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PriceChangeRepository.FindForUpdate")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	eb, err := repo.Client.PriceChangeBatch.Query().
+		Where(pricechangebatch.ID(id)).
+		ForUpdate(). // 行级锁：防止定时任务与手动操作并发处理同一批次
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrPriceChangeBatchNotExists)
+		}
+		return nil, err
+	}
+
+	items, err := repo.Client.PriceChangeItem.Query().
+		Where(pricechangeitem.BatchID(id)).
+		Order(ent.Asc(pricechangeitem.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res = convertPriceChangeBatchToDomain(eb)
+	res.Items = make(domain.PriceChangeItems, 0, len(items))
+	for _, item := range items {
+		res.Items = append(res.Items, convertPriceChangeItemToDomain(item))
+	}
+	return res, nil
+}
+
+// Update 更新调价批次状态及操作日志
+func (repo *PriceChangeRepository) Update(ctx context.Context, batch *domain.PriceChangeBatch) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PriceChangeRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if batch == nil {
+		return fmt.Errorf("price change batch is nil")
+	}
+
+	builder := repo.Client.PriceChangeBatch.UpdateOneID(batch.ID).
+		SetStatus(batch.Status).
+		SetFailReason(batch.FailReason).
+		SetOperationLogs(batch.OperationLogs)
+	if batch.AppliedAt != nil {
+		builder = builder.SetAppliedAt(*batch.AppliedAt)
+	} else {
+		builder = builder.ClearAppliedAt()
+	}
+
+	eb, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrPriceChangeBatchNotExists)
+		}
+		return err
+	}
+
+	batch.UpdatedAt = eb.UpdatedAt
+	return nil
+}
+
+// UpdateItem 更新调价明细的执行结果
+func (repo *PriceChangeRepository) UpdateItem(ctx context.Context, item *domain.PriceChangeItem) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PriceChangeRepository.UpdateItem")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	builder := repo.Client.PriceChangeItem.UpdateOneID(item.ID).
+		SetTargetID(item.TargetID).
+		SetApplied(item.Applied).
+		SetMenuItemOldPrices(item.MenuItemOldPrices)
+	if item.OldPrice != nil {
+		builder = builder.SetOldPrice(*item.OldPrice)
+	} else {
+		builder = builder.ClearOldPrice()
+	}
+
+	_, err = builder.Save(ctx)
+	return err
+}
+
+// ListDueIDs 查询已到生效时间且待生效的调价批次ID
+func (repo *PriceChangeRepository) ListDueIDs(ctx context.Context, now time.Time) (ids []uuid.UUID, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PriceChangeRepository.ListDueIDs")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return repo.Client.PriceChangeBatch.Query().
+		Where(
+			pricechangebatch.StatusEQ(domain.PriceChangeBatchStatusPending),
+			pricechangebatch.EffectiveAtLTE(now),
+		).
+		Order(ent.Asc(pricechangebatch.FieldEffectiveAt)).
+		IDs(ctx)
+}
+
+// PagedListBySearch 分页查询调价批次
+func (repo *PriceChangeRepository) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.PriceChangeSearchParams,
+) (res *domain.PriceChangeSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PriceChangeRepository.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.PriceChangeBatch.Query().Where(
+		pricechangebatch.MerchantID(params.MerchantID),
+		pricechangebatch.StoreID(params.StoreID),
+	)
+
+	if params.Name != "" {
+		query.Where(pricechangebatch.NameContains(params.Name))
+	}
+	if params.Status != "" {
+		query.Where(pricechangebatch.StatusEQ(params.Status))
+	}
+
+	// 获取总数
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按生效时间倒序排列
+	batches, err := query.
+		Offset(page.Offset()).
+		Limit(page.Size).
+		Order(ent.Desc(pricechangebatch.FieldEffectiveAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(domain.PriceChangeBatches, 0, len(batches))
+	for _, b := range batches {
+		items = append(items, convertPriceChangeBatchToDomain(b))
+	}
+
+	page.SetTotal(total)
+
+	return &domain.PriceChangeSearchRes{
+		Pagination: page,
+		Items:      items,
+	}, nil
+}
+
+// ============================================
+// 转换函数
+// ============================================
+
+func convertPriceChangeBatchToDomain(eb *ent.PriceChangeBatch) *domain.PriceChangeBatch {
+	if eb == nil {
+		return nil
+	}
+
+	batch := &domain.PriceChangeBatch{
+		ID:            eb.ID,
+		Name:          eb.Name,
+		MerchantID:    eb.MerchantID,
+		StoreID:       eb.StoreID,
+		StoreIDs:      eb.StoreIds,
+		EffectiveAt:   eb.EffectiveAt,
+		Status:        eb.Status,
+		Remark:        eb.Remark,
+		FailReason:    eb.FailReason,
+		AppliedAt:     eb.AppliedAt,
+		OperatorID:    eb.OperatorID,
+		OperatorName:  eb.OperatorName,
+		OperationLogs: eb.OperationLogs,
+		CreatedAt:     eb.CreatedAt,
+		UpdatedAt:     eb.UpdatedAt,
+	}
+
+	if len(eb.Edges.Items) > 0 {
+		batch.Items = make(domain.PriceChangeItems, 0, len(eb.Edges.Items))
+		for _, item := range eb.Edges.Items {
+			batch.Items = append(batch.Items, convertPriceChangeItemToDomain(item))
+		}
+	}
+	return batch
+}
+
+func convertPriceChangeItemToDomain(ei *ent.PriceChangeItem) *domain.PriceChangeItem {
+	if ei == nil {
+		return nil
+	}
+
+	return &domain.PriceChangeItem{
+		ID:          ei.ID,
+		BatchID:     ei.BatchID,
+		TargetType:  ei.TargetType,
+		ProductID:   ei.ProductID,
+		SpecID:      ei.SpecID,
+		StoreID:     ei.StoreID,
+		ProductName: ei.ProductName,
+		SpecName:    ei.SpecName,
+		NewPrice:    ei.NewPrice,
+		OldPrice:    ei.OldPrice,
+		TargetID:    ei.TargetID,
+		Applied:     ei.Applied,
+		CreatedAt:   ei.CreatedAt,
+		UpdatedAt:   ei.UpdatedAt,
+
+		MenuItemOldPrices: ei.MenuItemOldPrices,
+	}
+}
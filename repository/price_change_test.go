@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type PriceChangeRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *PriceChangeRepository
+	ctx  context.Context
+}
+
+func TestPriceChangeRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PriceChangeRepositoryTestSuite))
+}
+
+func (s *PriceChangeRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &PriceChangeRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *PriceChangeRepositoryTestSuite) newBatch(name string, merchantID uuid.UUID, effectiveAt time.Time) *domain.PriceChangeBatch {
+	batchID := uuid.New()
+	return &domain.PriceChangeBatch{
+		ID:           batchID,
+		Name:         name,
+		MerchantID:   merchantID,
+		EffectiveAt:  effectiveAt,
+		Status:       domain.PriceChangeBatchStatusPending,
+		OperatorID:   uuid.New(),
+		OperatorName: "操作员",
+		Items: domain.PriceChangeItems{
+			{
+				ID:          uuid.New(),
+				BatchID:     batchID,
+				TargetType:  domain.PriceChangeTargetTypeProductSpec,
+				ProductID:   uuid.New(),
+				SpecID:      uuid.New(),
+				ProductName: "宫保鸡丁",
+				SpecName:    "大份",
+				NewPrice:    decimal.NewFromInt(38),
+			},
+		},
+	}
+}
+
+func (s *PriceChangeRepositoryTestSuite) TestPriceChange_CreateAndFind() {
+	batch := s.newBatch("春季调价", uuid.New(), time.Now().Add(time.Hour))
+	batch.AddLog(domain.PriceChangeOperationTypeCreate, domain.PriceChangeOperator{Name: "操作员"}, "创建")
+
+	s.T().Run("创建成功", func(t *testing.T) {
+		require.NoError(t, s.repo.Create(s.ctx, batch))
+
+		found, err := s.repo.FindByID(s.ctx, batch.ID)
+		require.NoError(t, err)
+		require.Equal(t, batch.Name, found.Name)
+		require.Equal(t, domain.PriceChangeBatchStatusPending, found.Status)
+		require.Len(t, found.Items, 1)
+		require.True(t, found.Items[0].NewPrice.Equal(decimal.NewFromInt(38)))
+		require.Nil(t, found.Items[0].OldPrice)
+		require.Len(t, found.OperationLogs, 1)
+	})
+
+	s.T().Run("空入参", func(t *testing.T) {
+		require.Error(t, s.repo.Create(s.ctx, nil))
+	})
+
+	s.T().Run("不存在", func(t *testing.T) {
+		_, err := s.repo.FindByID(s.ctx, uuid.New())
+		require.Error(t, err)
+		require.True(t, domain.IsNotFound(err))
+	})
+}
+
+func (s *PriceChangeRepositoryTestSuite) TestPriceChange_UpdateAndUpdateItem() {
+	batch := s.newBatch("夏季调价", uuid.New(), time.Now().Add(time.Hour))
+	require.NoError(s.T(), s.repo.Create(s.ctx, batch))
+
+	s.T().Run("记录执行结果", func(t *testing.T) {
+		item := batch.Items[0]
+		oldPrice := decimal.NewFromInt(32)
+		item.OldPrice = &oldPrice
+		item.TargetID = uuid.New()
+		item.Applied = true
+		require.NoError(t, s.repo.UpdateItem(s.ctx, item))
+
+		now := time.Now()
+		batch.Status = domain.PriceChangeBatchStatusApplied
+		batch.AppliedAt = &now
+		batch.AddLog(domain.PriceChangeOperationTypeApply, domain.PriceChangeSystemOperator, "生效")
+		require.NoError(t, s.repo.Update(s.ctx, batch))
+
+		found, err := s.repo.FindByID(s.ctx, batch.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.PriceChangeBatchStatusApplied, found.Status)
+		require.NotNil(t, found.AppliedAt)
+		require.Len(t, found.OperationLogs, 1)
+		require.Len(t, found.Items, 1)
+		require.True(t, found.Items[0].Applied)
+		require.True(t, found.Items[0].OldPrice.Equal(oldPrice))
+		require.Equal(t, item.TargetID, found.Items[0].TargetID)
+	})
+
+	s.T().Run("记录各菜单项原价", func(t *testing.T) {
+		item := batch.Items[0]
+		oldPrice := decimal.NewFromInt(30)
+		item.MenuItemOldPrices = []domain.PriceChangeMenuItemOldPrice{
+			{MenuItemID: uuid.New(), OldPrice: &oldPrice},
+			{MenuItemID: uuid.New()},
+		}
+		require.NoError(t, s.repo.UpdateItem(s.ctx, item))
+
+		found, err := s.repo.FindByID(s.ctx, batch.ID)
+		require.NoError(t, err)
+		require.Len(t, found.Items[0].MenuItemOldPrices, 2)
+		require.Equal(t, item.MenuItemOldPrices[0].MenuItemID, found.Items[0].MenuItemOldPrices[0].MenuItemID)
+		require.True(t, found.Items[0].MenuItemOldPrices[0].OldPrice.Equal(oldPrice))
+		require.Nil(t, found.Items[0].MenuItemOldPrices[1].OldPrice)
+	})
+
+	s.T().Run("不存在的ID", func(t *testing.T) {
+		missing := s.newBatch("不存在", uuid.New(), time.Now())
+		err := s.repo.Update(s.ctx, missing)
+		require.Error(t, err)
+		require.True(t, domain.IsNotFound(err))
+	})
+}
+
+func (s *PriceChangeRepositoryTestSuite) TestPriceChange_ListDueIDs() {
+	merchantID := uuid.New()
+	due := s.newBatch("已到期", merchantID, time.Now().Add(-time.Minute))
+	future := s.newBatch("未到期", merchantID, time.Now().Add(time.Hour))
+	cancelled := s.newBatch("已取消", merchantID, time.Now().Add(-time.Minute))
+	cancelled.Status = domain.PriceChangeBatchStatusCancelled
+	for _, b := range []*domain.PriceChangeBatch{due, future, cancelled} {
+		require.NoError(s.T(), s.repo.Create(s.ctx, b))
+	}
+
+	ids, err := s.repo.ListDueIDs(s.ctx, time.Now())
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []uuid.UUID{due.ID}, ids)
+}
+
+func (s *PriceChangeRepositoryTestSuite) TestPriceChange_PagedListBySearch() {
+	merchantID := uuid.New()
+	require.NoError(s.T(), s.repo.Create(s.ctx, s.newBatch("春季调价", merchantID, time.Now().Add(time.Hour))))
+	require.NoError(s.T(), s.repo.Create(s.ctx, s.newBatch("夏季调价", merchantID, time.Now().Add(2*time.Hour))))
+	require.NoError(s.T(), s.repo.Create(s.ctx, s.newBatch("其他品牌", uuid.New(), time.Now().Add(time.Hour))))
+
+	s.T().Run("按品牌商查询", func(t *testing.T) {
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.PriceChangeSearchParams{
+			MerchantID: merchantID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, res.Total)
+		require.Equal(t, "夏季调价", res.Items[0].Name)
+	})
+
+	s.T().Run("按名称查询", func(t *testing.T) {
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.PriceChangeSearchParams{
+			MerchantID: merchantID,
+			Name:       "春季",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Total)
+	})
+}
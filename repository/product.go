@@ -6,15 +6,18 @@ import (
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/category"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/predicate"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/product"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productattrrelation"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/productspecrelation"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/pinyin"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
 )
@@ -133,6 +136,9 @@ func (repo *ProductRepository) Create(ctx context.Context, p *domain.Product) (e
 		util.SpanErrFinish(span, err)
 	}()
 
+	// 名称拼音随商品保存生成，用于助记搜索
+	p.Pinyin, p.PinyinInitials = pinyin.Spell(p.Name)
+
 	builder := repo.Client.Product.Create().
 		SetID(p.ID).
 		SetName(p.Name).
@@ -142,6 +148,8 @@ func (repo *ProductRepository) Create(ctx context.Context, p *domain.Product) (e
 		SetCategoryID(p.CategoryID).
 		SetUnitID(p.UnitID).
 		SetMnemonic(p.Mnemonic).
+		SetPinyin(p.Pinyin).
+		SetPinyinInitials(p.PinyinInitials).
 		SetShelfLife(p.ShelfLife).
 		SetSupportTypes(p.SupportTypes).
 		SetSaleStatus(p.SaleStatus).
@@ -151,7 +159,14 @@ func (repo *ProductRepository) Create(ctx context.Context, p *domain.Product) (e
 		SetInheritTaxRate(p.InheritTaxRate).
 		SetInheritStall(p.InheritStall).
 		SetMainImage(p.MainImage).
-		SetDescription(p.Description)
+		SetDescription(p.Description).
+		SetNameI18n(p.NameI18n).
+		SetDescriptionI18n(p.DescriptionI18n).
+		SetAllergens(p.Allergens).
+		SetHalal(p.Halal).
+		SetVegetarian(p.Vegetarian).
+		SetVegan(p.Vegan).
+		SetSpicyLevel(p.SpicyLevel)
 
 	// 套餐属性（仅套餐商品使用）
 	if p.EstimatedCostPrice != nil {
@@ -184,6 +199,12 @@ func (repo *ProductRepository) Create(ctx context.Context, p *domain.Product) (e
 	if len(p.DetailImages) > 0 {
 		builder = builder.SetDetailImages(p.DetailImages)
 	}
+	if len(p.AttrLimits) > 0 {
+		builder = builder.SetAttrLimits(p.AttrLimits)
+	}
+	if p.Nutrition != nil {
+		builder = builder.SetNutrition(p.Nutrition)
+	}
 
 	// 设置标签（Many2Many）
 	if len(p.Tags) > 0 {
@@ -229,11 +250,15 @@ func (repo *ProductRepository) Update(ctx context.Context, p *domain.Product) (e
 		return err
 	}
 
+	p.Pinyin, p.PinyinInitials = pinyin.Spell(p.Name)
+
 	builder := repo.Client.Product.UpdateOneID(p.ID).
 		SetName(p.Name).
 		SetCategoryID(p.CategoryID).
 		SetUnitID(p.UnitID).
 		SetMnemonic(p.Mnemonic).
+		SetPinyin(p.Pinyin).
+		SetPinyinInitials(p.PinyinInitials).
 		SetShelfLife(p.ShelfLife).
 		SetSupportTypes(p.SupportTypes).
 		SetSaleStatus(p.SaleStatus).
@@ -243,7 +268,14 @@ func (repo *ProductRepository) Update(ctx context.Context, p *domain.Product) (e
 		SetInheritTaxRate(p.InheritTaxRate).
 		SetInheritStall(p.InheritStall).
 		SetMainImage(p.MainImage).
-		SetDescription(p.Description)
+		SetDescription(p.Description).
+		SetNameI18n(p.NameI18n).
+		SetDescriptionI18n(p.DescriptionI18n).
+		SetAllergens(p.Allergens).
+		SetHalal(p.Halal).
+		SetVegetarian(p.Vegetarian).
+		SetVegan(p.Vegan).
+		SetSpicyLevel(p.SpicyLevel)
 
 	// 套餐属性（仅套餐商品使用）
 	if p.EstimatedCostPrice != nil {
@@ -293,6 +325,16 @@ func (repo *ProductRepository) Update(ctx context.Context, p *domain.Product) (e
 	} else {
 		builder = builder.ClearDetailImages()
 	}
+	if len(p.AttrLimits) > 0 {
+		builder = builder.SetAttrLimits(p.AttrLimits)
+	} else {
+		builder = builder.ClearAttrLimits()
+	}
+	if p.Nutrition != nil {
+		builder = builder.SetNutrition(p.Nutrition)
+	} else {
+		builder = builder.ClearNutrition()
+	}
 
 	// 更新标签
 	if len(p.Tags) > 0 {
@@ -309,6 +351,64 @@ func (repo *ProductRepository) Update(ctx context.Context, p *domain.Product) (e
 	return err
 }
 
+// UpdateDietary 只更新商品的过敏原与饮食属性（用于套餐根据子商品重新计算）
+func (repo *ProductRepository) UpdateDietary(ctx context.Context, p *domain.Product) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ProductRepository.UpdateDietary")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	builder := repo.Client.Product.UpdateOneID(p.ID).
+		SetAllergens(p.Allergens).
+		SetHalal(p.Halal).
+		SetVegetarian(p.Vegetarian).
+		SetVegan(p.Vegan).
+		SetSpicyLevel(p.SpicyLevel)
+	if p.Nutrition != nil {
+		builder = builder.SetNutrition(p.Nutrition)
+	} else {
+		builder = builder.ClearNutrition()
+	}
+	return builder.Exec(ctx)
+}
+
+func (repo *ProductRepository) ListMissingPinyin(ctx context.Context, afterID uuid.UUID, limit int) (res domain.Products, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ProductRepository.ListMissingPinyin")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	eps, err := repo.Client.Product.Query().
+		Where(
+			product.IDGT(afterID),
+			product.Pinyin(""),
+		).
+		Order(ent.Asc(product.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res = make(domain.Products, 0, len(eps))
+	for _, ep := range eps {
+		res = append(res, convertProductToDomain(ep))
+	}
+	return res, nil
+}
+
+func (repo *ProductRepository) UpdatePinyin(ctx context.Context, p *domain.Product) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ProductRepository.UpdatePinyin")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	p.Pinyin, p.PinyinInitials = pinyin.Spell(p.Name)
+	return repo.Client.Product.UpdateOneID(p.ID).
+		SetPinyin(p.Pinyin).
+		SetPinyinInitials(p.PinyinInitials).
+		Exec(ctx)
+}
+
 func (repo *ProductRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
 	span, ctx := util.StartSpan(ctx, "repository", "ProductRepository.Delete")
 	defer func() {
@@ -395,12 +495,59 @@ func (repo *ProductRepository) PagedListBySearch(
 	}()
 
 	query := repo.Client.Product.Query()
+	applyProductSearchParams(query, params)
+
+	// 获取总数
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 预加载关联数据
+	query = query.
+		WithCategory(). // 预加载分类信息
+		WithProductSpecs(
+			func(query *ent.ProductSpecRelationQuery) {
+				query.WithSpec()
+			},
+		) // 预加载规格信息
+
+	// 分页处理
+	query = query.
+		Offset(page.Offset()).
+		Limit(page.Size)
 
+	// 按助记搜索匹配程度、创建时间倒序排列
+	entProducts, err := query.Order(productKeywordOrder(params.Keyword), ent.Desc(product.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(domain.Products, 0, len(entProducts))
+	for _, p := range entProducts {
+		items = append(items, convertProductToDomain(p))
+	}
+
+	page.SetTotal(total)
+
+	return &domain.ProductSearchRes{
+		Pagination: page,
+		Items:      items,
+	}, nil
+}
+
+// applyProductSearchParams 应用商品查询条件
+func applyProductSearchParams(query *ent.ProductQuery, params domain.ProductSearchParams) {
 	// 必填条件：品牌商ID
 	if params.MerchantID != uuid.Nil {
 		query.Where(product.MerchantID(params.MerchantID))
 	}
 
+	// 可选条件：商品ID列表
+	if len(params.IDs) > 0 {
+		query.Where(product.IDIn(params.IDs...))
+	}
+
 	// 可选条件：门店ID
 	if params.OnlyMerchant {
 		query.Where(product.StoreID(uuid.Nil))
@@ -413,6 +560,11 @@ func (repo *ProductRepository) PagedListBySearch(
 		query.Where(product.NameContains(params.Name))
 	}
 
+	// 可选条件：助记搜索关键字（助记词、拼音首字母、拼音全拼、名称任一匹配）
+	if params.Keyword != "" {
+		query.Where(productKeywordPredicate(params.Keyword))
+	}
+
 	// 可选条件：售卖状态
 	if params.SaleStatus != "" {
 		query.Where(product.SaleStatusEQ(params.SaleStatus))
@@ -454,43 +606,131 @@ func (repo *ProductRepository) PagedListBySearch(
 	if params.EndAt != nil {
 		query.Where(product.CreatedAtLTE(util.DayEnd(*params.EndAt)))
 	}
-	// 获取总数
-	total, err := query.Clone().Count(ctx)
-	if err != nil {
-		return nil, err
+
+	// 可选条件：过敏原与饮食属性
+	if !params.Dietary.IsEmpty() {
+		query.Where(productDietaryPredicate(params.Dietary))
 	}
+}
 
-	// 预加载关联数据
-	query = query.
-		WithCategory(). // 预加载分类信息
+// productDietaryPredicate 过敏原与饮食属性筛选条件，与 domain.ProductDietaryFilter.Match 一致
+func productDietaryPredicate(filter domain.ProductDietaryFilter) predicate.Product {
+	ps := make([]predicate.Product, 0)
+	for _, allergen := range filter.ExcludeAllergens {
+		ps = append(ps, product.Or(
+			product.AllergensIsNil(),
+			product.Not(func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(s.C(product.FieldAllergens), allergen))
+			}),
+		))
+	}
+	if filter.Halal {
+		ps = append(ps, product.Halal(true))
+	}
+	if filter.Vegetarian {
+		ps = append(ps, product.Vegetarian(true))
+	}
+	if filter.Vegan {
+		ps = append(ps, product.Vegan(true))
+	}
+	if filter.MaxSpicyLevel != nil {
+		ps = append(ps, product.SpicyLevelLTE(*filter.MaxSpicyLevel))
+	}
+	return product.And(ps...)
+}
+
+// productKeywordPredicate 助记搜索条件：助记词完全匹配、拼音首字母前缀、拼音全拼前缀或名称包含关键字
+func productKeywordPredicate(keyword string) predicate.Product {
+	normalized := pinyin.NormalizeKeyword(keyword)
+	return product.Or(
+		product.MnemonicEqualFold(normalized),
+		product.PinyinInitialsHasPrefix(normalized),
+		product.PinyinHasPrefix(normalized),
+		product.NameContainsFold(strings.TrimSpace(keyword)),
+	)
+}
+
+// productKeywordRank 计算商品的助记搜索匹配程度，数值越小越靠前，与 productKeywordOrder 一致
+func productKeywordRank(p *domain.Product, keyword string) int {
+	keyword = pinyin.NormalizeKeyword(keyword)
+	switch {
+	case p == nil:
+		return 3
+	case strings.EqualFold(p.Mnemonic, keyword):
+		return 0
+	case strings.HasPrefix(p.PinyinInitials, keyword):
+		return 1
+	case strings.HasPrefix(p.Pinyin, keyword):
+		return 2
+	default:
+		return 3
+	}
+}
+
+// productKeywordOrder 按助记搜索匹配程度排序：助记词完全匹配、拼音首字母前缀、拼音全拼前缀、名称包含
+func productKeywordOrder(keyword string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		keyword := pinyin.NormalizeKeyword(keyword)
+		if keyword == "" {
+			return
+		}
+		ranks := []*sql.Predicate{
+			sql.EqualFold(s.C(product.FieldMnemonic), keyword),
+			sql.HasPrefix(s.C(product.FieldPinyinInitials), keyword),
+			sql.HasPrefix(s.C(product.FieldPinyin), keyword),
+		}
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("CASE")
+			for i, rank := range ranks {
+				b.WriteString(" WHEN ").Join(rank).WriteString(fmt.Sprintf(" THEN %d", i))
+			}
+			b.WriteString(fmt.Sprintf(" ELSE %d END", len(ranks)))
+		}))
+	}
+}
+
+func (repo *ProductRepository) ListDetailsBySearch(
+	ctx context.Context,
+	params domain.ProductSearchParams,
+) (res domain.Products, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ProductRepository.ListDetailsBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.Product.Query()
+	applyProductSearchParams(query, params)
+
+	entProducts, err := query.
+		WithCategory(
+			func(query *ent.CategoryQuery) {
+				query.WithParent()
+			},
+		).
+		WithUnit().
+		WithTags().
 		WithProductSpecs(
 			func(query *ent.ProductSpecRelationQuery) {
 				query.WithSpec()
 			},
-		) // 预加载规格信息
-
-	// 分页处理
-	query = query.
-		Offset(page.Offset()).
-		Limit(page.Size)
-
-	// 按创建时间倒序排列
-	entProducts, err := query.Order(ent.Desc(product.FieldCreatedAt)).All(ctx)
+		).
+		WithProductAttrs(
+			func(query *ent.ProductAttrRelationQuery) {
+				query.WithAttr()
+				query.WithAttrItem()
+			},
+		).
+		Order(productKeywordOrder(params.Keyword), ent.Desc(product.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	items := make(domain.Products, 0, len(entProducts))
+	res = make(domain.Products, 0, len(entProducts))
 	for _, p := range entProducts {
-		items = append(items, convertProductToDomain(p))
+		res = append(res, convertProductToDomain(p))
 	}
-
-	page.SetTotal(total)
-
-	return &domain.ProductSearchRes{
-		Pagination: page,
-		Items:      items,
-	}, nil
+	return res, nil
 }
 
 func (repo *ProductRepository) CountByCategoryIDs(ctx context.Context, categoryIDs []uuid.UUID) (map[uuid.UUID]int, error) {
@@ -676,6 +916,8 @@ func convertProductToDomain(ep *ent.Product) *domain.Product {
 		CategoryID:        ep.CategoryID,
 		MenuID:            ep.MenuID,
 		Mnemonic:          ep.Mnemonic,
+		Pinyin:            ep.Pinyin,
+		PinyinInitials:    ep.PinyinInitials,
 		ShelfLife:         ep.ShelfLife,
 		SupportTypes:      ep.SupportTypes,
 		UnitID:            ep.UnitID,
@@ -690,6 +932,14 @@ func convertProductToDomain(ep *ent.Product) *domain.Product {
 		StallID:           ep.StallID,
 		MainImage:         ep.MainImage,
 		Description:       ep.Description,
+		NameI18n:          ep.NameI18n,
+		DescriptionI18n:   ep.DescriptionI18n,
+		Allergens:         ep.Allergens,
+		Halal:             ep.Halal,
+		Vegetarian:        ep.Vegetarian,
+		Vegan:             ep.Vegan,
+		SpicyLevel:        ep.SpicyLevel,
+		Nutrition:         ep.Nutrition,
 		CreatedAt:         ep.CreatedAt,
 		UpdatedAt:         ep.UpdatedAt,
 	}
@@ -707,6 +957,9 @@ func convertProductToDomain(ep *ent.Product) *domain.Product {
 	if ep.EffectiveEndTime != nil {
 		p.EffectiveEndTime = ep.EffectiveEndTime
 	}
+	if len(ep.AttrLimits) > 0 {
+		p.AttrLimits = ep.AttrLimits
+	}
 	if len(ep.DetailImages) > 0 {
 		p.DetailImages = ep.DetailImages
 	}
@@ -79,6 +79,7 @@ func (repo *ProductAttrRepository) Create(ctx context.Context, attr *domain.Prod
 	builder := repo.Client.ProductAttr.Create().
 		SetID(attr.ID).
 		SetName(attr.Name).
+		SetNameI18n(attr.NameI18n).
 		SetChannels(attr.Channels).
 		SetMerchantID(attr.MerchantID).
 		SetStoreID(attr.StoreID).
@@ -103,6 +104,7 @@ func (repo *ProductAttrRepository) Update(ctx context.Context, attr *domain.Prod
 
 	builder := repo.Client.ProductAttr.UpdateOneID(attr.ID).
 		SetName(attr.Name).
+		SetNameI18n(attr.NameI18n).
 		SetChannels(attr.Channels).
 		SetProductCount(attr.ProductCount)
 
@@ -213,6 +215,7 @@ func (repo *ProductAttrRepository) CreateBulk(ctx context.Context, attrs []*doma
 		builder := repo.Client.ProductAttr.Create().
 			SetID(attr.ID).
 			SetName(attr.Name).
+			SetNameI18n(attr.NameI18n).
 			SetChannels(attr.Channels).
 			SetMerchantID(attr.MerchantID).
 			SetStoreID(attr.StoreID).
@@ -262,6 +265,7 @@ func (repo *ProductAttrRepository) CreateItems(ctx context.Context, items []*dom
 			SetID(item.ID).
 			SetAttrID(item.AttrID).
 			SetName(item.Name).
+			SetNameI18n(item.NameI18n).
 			SetBasePrice(item.BasePrice).
 			SetProductCount(item.ProductCount).
 			SetImage(item.Image)
@@ -291,6 +295,7 @@ func (repo *ProductAttrRepository) SaveItems(ctx context.Context, items []*domai
 			SetID(item.ID).
 			SetAttrID(item.AttrID).
 			SetName(item.Name).
+			SetNameI18n(item.NameI18n).
 			SetBasePrice(item.BasePrice).
 			SetProductCount(item.ProductCount).
 			SetImage(item.Image)
@@ -380,6 +385,7 @@ func convertProductAttrToDomain(ea *ent.ProductAttr) *domain.ProductAttr {
 	attr := &domain.ProductAttr{
 		ID:           ea.ID,
 		Name:         ea.Name,
+		NameI18n:     ea.NameI18n,
 		Channels:     ea.Channels,
 		MerchantID:   ea.MerchantID,
 		StoreID:      ea.StoreID,
@@ -409,6 +415,7 @@ func convertProductAttrItemToDomain(ei *ent.ProductAttrItem) *domain.ProductAttr
 		ID:           ei.ID,
 		AttrID:       ei.AttrID,
 		Name:         ei.Name,
+		NameI18n:     ei.NameI18n,
 		Image:        ei.Image,
 		BasePrice:    ei.BasePrice,
 		ProductCount: ei.ProductCount,
@@ -38,7 +38,8 @@ func (repo *ProductAttrRelRepository) CreateBulk(ctx context.Context, relations
 			SetProductID(relation.ProductID).
 			SetAttrID(relation.AttrID).
 			SetAttrItemID(relation.AttrItemID).
-			SetIsDefault(relation.IsDefault)
+			SetIsDefault(relation.IsDefault).
+			SetMaxQuantity(max(relation.MaxQuantity, 1))
 
 		builders = append(builders, builder)
 	}
@@ -53,13 +54,14 @@ func convertProductAttrRelationToDomain(er *ent.ProductAttrRelation) *domain.Pro
 	}
 
 	attr := &domain.ProductAttrRelation{
-		ID:         er.ID,
-		ProductID:  er.ProductID,
-		AttrID:     er.AttrID,
-		AttrItemID: er.AttrItemID,
-		IsDefault:  er.IsDefault,
-		CreatedAt:  er.CreatedAt,
-		UpdatedAt:  er.UpdatedAt,
+		ID:          er.ID,
+		ProductID:   er.ProductID,
+		AttrID:      er.AttrID,
+		AttrItemID:  er.AttrItemID,
+		IsDefault:   er.IsDefault,
+		MaxQuantity: er.MaxQuantity,
+		CreatedAt:   er.CreatedAt,
+		UpdatedAt:   er.UpdatedAt,
 	}
 
 	if er.Edges.Attr != nil {
@@ -3,8 +3,11 @@ package repository
 import (
 	"context"
 
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/productspecrelation"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
 )
 
@@ -57,6 +60,9 @@ func (repo *ProductSpecRelRepository) CreateBulk(ctx context.Context, relations
 		if relation.OtherPrice3 != nil {
 			builder = builder.SetOtherPrice3(*relation.OtherPrice3)
 		}
+		if len(relation.PeriodPrices) > 0 {
+			builder = builder.SetPeriodPrices(relation.PeriodPrices)
+		}
 
 		builders = append(builders, builder)
 	}
@@ -71,14 +77,15 @@ func convertProductSpecRelationToDomain(er *ent.ProductSpecRelation) *domain.Pro
 	}
 
 	relation := &domain.ProductSpecRelation{
-		ID:        er.ID,
-		ProductID: er.ProductID,
-		SpecID:    er.SpecID,
-		BasePrice: er.BasePrice,
-		Barcode:   er.Barcode,
-		IsDefault: er.IsDefault,
-		CreatedAt: er.CreatedAt,
-		UpdatedAt: er.UpdatedAt,
+		ID:           er.ID,
+		ProductID:    er.ProductID,
+		SpecID:       er.SpecID,
+		BasePrice:    er.BasePrice,
+		Barcode:      er.Barcode,
+		IsDefault:    er.IsDefault,
+		PeriodPrices: er.PeriodPrices,
+		CreatedAt:    er.CreatedAt,
+		UpdatedAt:    er.UpdatedAt,
 	}
 	if er.PackingFeeID != nil {
 		relation.PackingFeeID = *er.PackingFeeID
@@ -108,3 +115,104 @@ func convertProductSpecRelationToDomain(er *ent.ProductSpecRelation) *domain.Pro
 	}
 	return relation
 }
+
+// FindByProductAndSpec 根据商品ID和规格ID查询规格关联
+func (repo *ProductSpecRelRepository) FindByProductAndSpec(ctx context.Context, productID, specID uuid.UUID) (res *domain.ProductSpecRelation, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ProductSpecRelRepository.FindByProductAndSpec")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	er, err := repo.Client.ProductSpecRelation.Query().
+		Where(
+			productspecrelation.ProductID(productID),
+			productspecrelation.SpecID(specID),
+		).
+		WithSpec().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrPriceChangeProductSpecNotExists)
+		}
+		return nil, err
+	}
+
+	return convertProductSpecRelationToDomain(er), nil
+}
+
+// UpdateBasePrice 更新规格关联的基础价格
+func (repo *ProductSpecRelRepository) UpdateBasePrice(ctx context.Context, id uuid.UUID, basePrice decimal.Decimal) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ProductSpecRelRepository.UpdateBasePrice")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.ProductSpecRelation.UpdateOneID(id).
+		SetBasePrice(basePrice).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrPriceChangeProductSpecNotExists)
+		}
+		return err
+	}
+	return nil
+}
+
+// Update 更新规格关联的价格、打包费及条码信息
+func (repo *ProductSpecRelRepository) Update(ctx context.Context, relation *domain.ProductSpecRelation) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ProductSpecRelRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	builder := repo.Client.ProductSpecRelation.UpdateOneID(relation.ID).
+		SetBasePrice(relation.BasePrice).
+		SetBarcode(relation.Barcode).
+		SetIsDefault(relation.IsDefault)
+
+	if relation.PackingFeeID != uuid.Nil {
+		builder = builder.SetPackingFeeID(relation.PackingFeeID)
+	} else {
+		builder = builder.ClearPackingFeeID()
+	}
+	if relation.MemberPrice != nil {
+		builder = builder.SetMemberPrice(*relation.MemberPrice)
+	} else {
+		builder = builder.ClearMemberPrice()
+	}
+	if relation.EstimatedCostPrice != nil {
+		builder = builder.SetEstimatedCostPrice(*relation.EstimatedCostPrice)
+	} else {
+		builder = builder.ClearEstimatedCostPrice()
+	}
+	if relation.OtherPrice1 != nil {
+		builder = builder.SetOtherPrice1(*relation.OtherPrice1)
+	} else {
+		builder = builder.ClearOtherPrice1()
+	}
+	if relation.OtherPrice2 != nil {
+		builder = builder.SetOtherPrice2(*relation.OtherPrice2)
+	} else {
+		builder = builder.ClearOtherPrice2()
+	}
+	if relation.OtherPrice3 != nil {
+		builder = builder.SetOtherPrice3(*relation.OtherPrice3)
+	} else {
+		builder = builder.ClearOtherPrice3()
+	}
+	if len(relation.PeriodPrices) > 0 {
+		builder = builder.SetPeriodPrices(relation.PeriodPrices)
+	} else {
+		builder = builder.ClearPeriodPrices()
+	}
+
+	err = builder.Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrPriceChangeProductSpecNotExists)
+		}
+		return err
+	}
+	return nil
+}
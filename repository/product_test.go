@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type ProductRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *ProductRepository
+	ctx  context.Context
+}
+
+func TestProductRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ProductRepositoryTestSuite))
+}
+
+func (s *ProductRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &ProductRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *ProductRepositoryTestSuite) createProduct(merchantID uuid.UUID, name, mnemonic string) *domain.Product {
+	p := &domain.Product{
+		ID:           uuid.New(),
+		Name:         name,
+		MerchantID:   merchantID,
+		Type:         domain.ProductTypeNormal,
+		CategoryID:   uuid.New(),
+		UnitID:       uuid.New(),
+		Mnemonic:     mnemonic,
+		SupportTypes: []domain.ProductSupportType{},
+		SaleStatus:   domain.ProductSaleStatusOnSale,
+		SaleChannels: []domain.SaleChannel{},
+	}
+	require.NoError(s.T(), s.repo.Create(s.ctx, p))
+	return p
+}
+
+func (s *ProductRepositoryTestSuite) TestProduct_GeneratePinyin() {
+	p := s.createProduct(uuid.New(), "宫保鸡丁", "")
+
+	found, err := s.repo.FindByID(s.ctx, p.ID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "gongbaojiding", found.Pinyin)
+	require.Equal(s.T(), "gbjd", found.PinyinInitials)
+
+	// 修改名称后重新生成
+	found.Name = "鱼香肉丝"
+	require.NoError(s.T(), s.repo.Update(s.ctx, found))
+	found, err = s.repo.FindByID(s.ctx, p.ID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "yuxiangrousi", found.Pinyin)
+	require.Equal(s.T(), "yxrs", found.PinyinInitials)
+}
+
+func (s *ProductRepositoryTestSuite) TestProduct_BackfillPinyin() {
+	merchantID := uuid.New()
+	legacy := s.createProduct(merchantID, "麻婆豆腐", "")
+	symbol := s.createProduct(merchantID, "☕", "")
+	s.createProduct(merchantID, "宫保鸡丁", "")
+	// 模拟上线拼音搜索前创建的历史商品
+	s.repo.Client.Product.UpdateOneID(legacy.ID).SetPinyin("").SetPinyinInitials("").ExecX(s.ctx)
+
+	products, err := s.repo.ListMissingPinyin(s.ctx, uuid.Nil, 10)
+	require.NoError(s.T(), err)
+	ids := lo.Map(products, func(p *domain.Product, _ int) uuid.UUID { return p.ID })
+	require.ElementsMatch(s.T(), []uuid.UUID{legacy.ID, symbol.ID}, ids)
+
+	// 按ID分批查询
+	first := min(legacy.ID.String(), symbol.ID.String())
+	products, err = s.repo.ListMissingPinyin(s.ctx, uuid.MustParse(first), 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), products, 1)
+	require.NotEqual(s.T(), first, products[0].ID.String())
+
+	require.NoError(s.T(), s.repo.UpdatePinyin(s.ctx, legacy))
+	found, err := s.repo.FindByID(s.ctx, legacy.ID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "mapodoufu", found.Pinyin)
+	require.Equal(s.T(), "mpdf", found.PinyinInitials)
+}
+
+func (s *ProductRepositoryTestSuite) TestProduct_PagedListByKeyword() {
+	merchantID := uuid.New()
+	nameMatch := s.createProduct(merchantID, "Ajda", "")
+	pinyinMatch := s.createProduct(merchantID, "JD Coffee", "")
+	initialsMatch := s.createProduct(merchantID, "鸡丁炒饭", "")
+	mnemonicMatch := s.createProduct(merchantID, "招牌炒饭", "JD")
+	s.createProduct(merchantID, "宫保鸡丁", "")
+	s.createProduct(uuid.New(), "鸡丁拌面", "")
+
+	s.T().Run("按匹配程度排序", func(t *testing.T) {
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.ProductSearchParams{
+			MerchantID: merchantID,
+			Keyword:    "jd",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 4, res.Total)
+		ids := make([]uuid.UUID, 0, len(res.Items))
+		for _, item := range res.Items {
+			ids = append(ids, item.ID)
+		}
+		require.Equal(t, []uuid.UUID{mnemonicMatch.ID, initialsMatch.ID, pinyinMatch.ID, nameMatch.ID}, ids)
+	})
+
+	s.T().Run("拼音全拼前缀", func(t *testing.T) {
+		res, err := s.repo.ListDetailsBySearch(s.ctx, domain.ProductSearchParams{
+			MerchantID: merchantID,
+			Keyword:    "Gong Bao",
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, "宫保鸡丁", res[0].Name)
+	})
+}
+
+func (s *ProductRepositoryTestSuite) TestProduct_I18n() {
+	p := &domain.Product{
+		ID:         uuid.New(),
+		Name:       "宫保鸡丁",
+		MerchantID: uuid.New(),
+		Type:       domain.ProductTypeNormal,
+		CategoryID: uuid.New(),
+		UnitID:     uuid.New(),
+		NameI18n: domain.LocalizedText{
+			"en-US": "Kung Pao Chicken",
+			"ms-MY": "Ayam Kung Pao",
+		},
+		Description:     "微辣",
+		DescriptionI18n: domain.LocalizedText{"en-US": "Mildly spicy"},
+		SupportTypes:    []domain.ProductSupportType{},
+		SaleStatus:      domain.ProductSaleStatusOnSale,
+		SaleChannels:    []domain.SaleChannel{},
+	}
+	require.NoError(s.T(), s.repo.Create(s.ctx, p))
+
+	found, err := s.repo.FindByID(s.ctx, p.ID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), p.NameI18n, found.NameI18n)
+	require.Equal(s.T(), p.DescriptionI18n, found.DescriptionI18n)
+
+	found.Localize("ms-MY")
+	require.Equal(s.T(), "Ayam Kung Pao", found.Name)
+	// 未配置该语言的描述保留原文
+	require.Equal(s.T(), "微辣", found.Description)
+}
+
+func (s *ProductRepositoryTestSuite) TestProduct_DietaryFilter() {
+	merchantID := uuid.New()
+	create := func(name string, modify func(p *domain.Product)) *domain.Product {
+		p := &domain.Product{
+			ID:           uuid.New(),
+			Name:         name,
+			MerchantID:   merchantID,
+			Type:         domain.ProductTypeNormal,
+			CategoryID:   uuid.New(),
+			UnitID:       uuid.New(),
+			SupportTypes: []domain.ProductSupportType{},
+			SaleStatus:   domain.ProductSaleStatusOnSale,
+			SaleChannels: []domain.SaleChannel{},
+		}
+		modify(p)
+		require.NoError(s.T(), s.repo.Create(s.ctx, p))
+		return p
+	}
+	salad := create("蔬菜沙拉", func(p *domain.Product) {
+		p.Halal, p.Vegetarian, p.Vegan = true, true, true
+	})
+	satay := create("沙爹鸡肉串", func(p *domain.Product) {
+		p.Allergens = []domain.Allergen{domain.AllergenPeanut}
+		p.Halal = true
+		p.SpicyLevel = domain.SpicyLevelMild
+	})
+	laksa := create("叻沙", func(p *domain.Product) {
+		p.Allergens = []domain.Allergen{domain.AllergenCrustacean, domain.AllergenFish}
+		p.SpicyLevel = domain.SpicyLevelHot
+	})
+
+	search := func(filter domain.ProductDietaryFilter) []uuid.UUID {
+		res, err := s.repo.ListDetailsBySearch(s.ctx, domain.ProductSearchParams{
+			MerchantID: merchantID,
+			Dietary:    filter,
+		})
+		require.NoError(s.T(), err)
+		ids := make([]uuid.UUID, 0, len(res))
+		for _, p := range res {
+			ids = append(ids, p.ID)
+		}
+		return ids
+	}
+
+	s.T().Run("排除过敏原", func(t *testing.T) {
+		ids := search(domain.ProductDietaryFilter{ExcludeAllergens: []domain.Allergen{domain.AllergenPeanut}})
+		require.ElementsMatch(t, []uuid.UUID{salad.ID, laksa.ID}, ids)
+	})
+
+	s.T().Run("清真且最高微辣", func(t *testing.T) {
+		level := domain.SpicyLevelMild
+		ids := search(domain.ProductDietaryFilter{Halal: true, MaxSpicyLevel: &level})
+		require.ElementsMatch(t, []uuid.UUID{salad.ID, satay.ID}, ids)
+	})
+
+	s.T().Run("纯素", func(t *testing.T) {
+		ids := search(domain.ProductDietaryFilter{Vegan: true})
+		require.Equal(t, []uuid.UUID{salad.ID}, ids)
+	})
+}
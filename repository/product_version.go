@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/productversion"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.ProductVersionRepository = (*ProductVersionRepository)(nil)
+
+type ProductVersionRepository struct {
+	Client *ent.Client
+}
+
+func NewProductVersionRepository(client *ent.Client) *ProductVersionRepository {
+	return &ProductVersionRepository{
+		Client: client,
+	}
+}
+
+// Create 创建历史版本
+func (repo *ProductVersionRepository) Create(ctx context.Context, version *domain.ProductVersion) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ProductVersionRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if version == nil {
+		return fmt.Errorf("product version is nil")
+	}
+
+	ev, err := repo.Client.ProductVersion.Create().
+		SetID(version.ID).
+		SetSubjectType(version.SubjectType).
+		SetProductID(version.ProductID).
+		SetSubjectID(version.SubjectID).
+		SetMerchantID(version.MerchantID).
+		SetStoreID(version.StoreID).
+		SetVersion(version.Version).
+		SetAction(version.Action).
+		SetName(version.Name).
+		SetSnapshot(version.Snapshot).
+		SetChanges(version.Changes).
+		SetRestoredFrom(version.RestoredFrom).
+		SetOperatorID(version.OperatorID).
+		SetOperatorType(string(version.OperatorType)).
+		SetOperatorName(version.OperatorName).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	version.CreatedAt = ev.CreatedAt
+	return nil
+}
+
+// FindByID 查询历史版本
+func (repo *ProductVersionRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.ProductVersion, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ProductVersionRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ev, err := repo.Client.ProductVersion.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrProductVersionNotExists)
+		}
+		return nil, err
+	}
+
+	return convertProductVersionToDomain(ev), nil
+}
+
+// FindLatest 查询对象在指定时间及之前的最新版本
+func (repo *ProductVersionRepository) FindLatest(ctx context.Context, key domain.ProductVersionKey, at *time.Time) (res *domain.ProductVersion, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ProductVersionRepository.FindLatest")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.ProductVersion.Query().
+		Where(
+			productversion.SubjectTypeEQ(key.SubjectType),
+			productversion.ProductID(key.ProductID),
+			productversion.SubjectID(key.SubjectID),
+		)
+	if at != nil {
+		query.Where(productversion.CreatedAtLTE(*at))
+	}
+
+	ev, err := query.
+		Order(ent.Desc(productversion.FieldVersion)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrProductVersionNotExists)
+		}
+		return nil, err
+	}
+
+	return convertProductVersionToDomain(ev), nil
+}
+
+// PagedListBySearch 分页查询历史版本
+func (repo *ProductVersionRepository) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.ProductVersionSearchParams,
+) (res *domain.ProductVersionSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ProductVersionRepository.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.ProductVersion.Query().Where(
+		productversion.MerchantID(params.MerchantID),
+		productversion.StoreID(params.StoreID),
+	)
+
+	if params.SubjectType != "" {
+		query.Where(productversion.SubjectTypeEQ(params.SubjectType))
+	}
+	if params.ProductID != uuid.Nil {
+		query.Where(productversion.ProductID(params.ProductID))
+	}
+	if params.SubjectID != uuid.Nil {
+		query.Where(productversion.SubjectID(params.SubjectID))
+	}
+	if params.Action != "" {
+		query.Where(productversion.ActionEQ(params.Action))
+	}
+	if params.StartAt != nil {
+		query.Where(productversion.CreatedAtGTE(*params.StartAt))
+	}
+	if params.EndAt != nil {
+		query.Where(productversion.CreatedAtLTE(*params.EndAt))
+	}
+
+	// 获取总数
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按操作时间倒序排列
+	versions, err := query.
+		Offset(page.Offset()).
+		Limit(page.Size).
+		Order(ent.Desc(productversion.FieldCreatedAt), ent.Desc(productversion.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(domain.ProductVersions, 0, len(versions))
+	for _, v := range versions {
+		items = append(items, convertProductVersionToDomain(v))
+	}
+
+	page.SetTotal(total)
+
+	return &domain.ProductVersionSearchRes{
+		Pagination: page,
+		Items:      items,
+	}, nil
+}
+
+// ============================================
+// 转换函数
+// ============================================
+
+func convertProductVersionToDomain(ev *ent.ProductVersion) *domain.ProductVersion {
+	if ev == nil {
+		return nil
+	}
+
+	return &domain.ProductVersion{
+		ID: ev.ID,
+		ProductVersionKey: domain.ProductVersionKey{
+			SubjectType: ev.SubjectType,
+			ProductID:   ev.ProductID,
+			SubjectID:   ev.SubjectID,
+		},
+		MerchantID:   ev.MerchantID,
+		StoreID:      ev.StoreID,
+		Version:      ev.Version,
+		Action:       ev.Action,
+		Name:         ev.Name,
+		Snapshot:     ev.Snapshot,
+		Changes:      ev.Changes,
+		RestoredFrom: ev.RestoredFrom,
+		OperatorID:   ev.OperatorID,
+		OperatorType: domain.UserType(ev.OperatorType),
+		OperatorName: ev.OperatorName,
+		CreatedAt:    ev.CreatedAt,
+	}
+}
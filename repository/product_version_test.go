@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type ProductVersionRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *ProductVersionRepository
+	ctx  context.Context
+}
+
+func TestProductVersionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ProductVersionRepositoryTestSuite))
+}
+
+func (s *ProductVersionRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &ProductVersionRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *ProductVersionRepositoryTestSuite) newVersion(
+	key domain.ProductVersionKey,
+	merchantID uuid.UUID,
+	version int,
+	action domain.ProductVersionAction,
+	snapshot string,
+) *domain.ProductVersion {
+	return &domain.ProductVersion{
+		ID:                uuid.New(),
+		ProductVersionKey: key,
+		MerchantID:        merchantID,
+		Version:           version,
+		Action:            action,
+		Name:              "宫保鸡丁",
+		Snapshot:          json.RawMessage(snapshot),
+		Changes:           domain.ProductVersionChanges{},
+		OperatorID:        uuid.New(),
+		OperatorType:      domain.UserTypeBackend,
+		OperatorName:      "操作员",
+	}
+}
+
+func (s *ProductVersionRepositoryTestSuite) TestProductVersion_CreateAndFindLatest() {
+	merchantID := uuid.New()
+	productID := uuid.New()
+	key := domain.ProductVersionKey{
+		SubjectType: domain.ProductVersionSubjectTypeProduct,
+		ProductID:   productID,
+		SubjectID:   productID,
+	}
+
+	v1 := s.newVersion(key, merchantID, 1, domain.ProductVersionActionCreate, `{"name":"宫保鸡丁"}`)
+	require.NoError(s.T(), s.repo.Create(s.ctx, v1))
+	between := time.Now()
+	time.Sleep(10 * time.Millisecond)
+
+	v2 := s.newVersion(key, merchantID, 2, domain.ProductVersionActionUpdate, `{"name":"宫保鸡丁（大）"}`)
+	v2.Changes = domain.ProductVersionChanges{{Field: "name", Old: json.RawMessage(`"宫保鸡丁"`), New: json.RawMessage(`"宫保鸡丁（大）"`)}}
+	require.NoError(s.T(), s.repo.Create(s.ctx, v2))
+
+	s.T().Run("查询详情", func(t *testing.T) {
+		found, err := s.repo.FindByID(s.ctx, v2.ID)
+		require.NoError(t, err)
+		require.Equal(t, key, found.ProductVersionKey)
+		require.Equal(t, 2, found.Version)
+		require.Len(t, found.Changes, 1)
+		require.Equal(t, "name", found.Changes[0].Field)
+		require.JSONEq(t, `{"name":"宫保鸡丁（大）"}`, string(found.Snapshot))
+	})
+
+	s.T().Run("查询最新版本", func(t *testing.T) {
+		latest, err := s.repo.FindLatest(s.ctx, key, nil)
+		require.NoError(t, err)
+		require.Equal(t, v2.ID, latest.ID)
+	})
+
+	s.T().Run("查询时间点版本", func(t *testing.T) {
+		found, err := s.repo.FindLatest(s.ctx, key, &between)
+		require.NoError(t, err)
+		require.Equal(t, v1.ID, found.ID)
+
+		before := between.Add(-time.Hour)
+		_, err = s.repo.FindLatest(s.ctx, key, &before)
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("版本号重复", func(t *testing.T) {
+		require.Error(t, s.repo.Create(s.ctx, s.newVersion(key, merchantID, 2, domain.ProductVersionActionUpdate, `{}`)))
+	})
+
+	s.T().Run("不存在", func(t *testing.T) {
+		_, err := s.repo.FindByID(s.ctx, uuid.New())
+		require.True(t, domain.IsNotFound(err))
+	})
+}
+
+func (s *ProductVersionRepositoryTestSuite) TestProductVersion_PagedListBySearch() {
+	merchantID := uuid.New()
+	productID := uuid.New()
+	specID := uuid.New()
+	productKey := domain.ProductVersionKey{
+		SubjectType: domain.ProductVersionSubjectTypeProduct,
+		ProductID:   productID,
+		SubjectID:   productID,
+	}
+	specKey := domain.ProductVersionKey{
+		SubjectType: domain.ProductVersionSubjectTypeProductSpec,
+		ProductID:   productID,
+		SubjectID:   specID,
+	}
+
+	require.NoError(s.T(), s.repo.Create(s.ctx, s.newVersion(productKey, merchantID, 1, domain.ProductVersionActionCreate, `{}`)))
+	require.NoError(s.T(), s.repo.Create(s.ctx, s.newVersion(productKey, merchantID, 2, domain.ProductVersionActionUpdate, `{}`)))
+	require.NoError(s.T(), s.repo.Create(s.ctx, s.newVersion(specKey, merchantID, 1, domain.ProductVersionActionCreate, `{}`)))
+	// 其他品牌商
+	require.NoError(s.T(), s.repo.Create(s.ctx, s.newVersion(domain.ProductVersionKey{
+		SubjectType: domain.ProductVersionSubjectTypeProduct,
+		ProductID:   uuid.New(),
+		SubjectID:   uuid.New(),
+	}, uuid.New(), 1, domain.ProductVersionActionCreate, `{}`)))
+
+	s.T().Run("按商品查询", func(t *testing.T) {
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.ProductVersionSearchParams{
+			MerchantID: merchantID,
+			ProductID:  productID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, res.Total)
+	})
+
+	s.T().Run("按对象类型和操作查询", func(t *testing.T) {
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.ProductVersionSearchParams{
+			MerchantID:  merchantID,
+			SubjectType: domain.ProductVersionSubjectTypeProduct,
+			Action:      domain.ProductVersionActionUpdate,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Total)
+		require.Equal(t, 2, res.Items[0].Version)
+	})
+}
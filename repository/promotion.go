@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/promotion"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.PromotionRepository = (*PromotionRepository)(nil)
+
+type PromotionRepository struct {
+	Client *ent.Client
+}
+
+func NewPromotionRepository(client *ent.Client) *PromotionRepository {
+	return &PromotionRepository{
+		Client: client,
+	}
+}
+
+// Create 创建促销活动
+func (repo *PromotionRepository) Create(ctx context.Context, p *domain.Promotion) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PromotionRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if p == nil {
+		return fmt.Errorf("promotion is nil")
+	}
+
+	ep, err := repo.Client.Promotion.Create().
+		SetID(p.ID).
+		SetMerchantID(p.MerchantID).
+		SetStoreID(p.StoreID).
+		SetName(p.Name).
+		SetType(p.Type).
+		SetRule(p.Rule).
+		SetStoreIds(p.StoreIDs).
+		SetChannels(p.Channels).
+		SetDiningWays(p.DiningWays).
+		SetNillableStartAt(p.StartAt).
+		SetNillableEndAt(p.EndAt).
+		SetTimeWindows(p.TimeWindows).
+		SetPriority(p.Priority).
+		SetStackable(p.Stackable).
+		SetEnabled(p.Enabled).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create promotion: %w", err)
+	}
+
+	p.CreatedAt = ep.CreatedAt
+	p.UpdatedAt = ep.UpdatedAt
+	return nil
+}
+
+// Update 更新促销活动
+func (repo *PromotionRepository) Update(ctx context.Context, p *domain.Promotion) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PromotionRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if p == nil {
+		return fmt.Errorf("promotion is nil")
+	}
+
+	builder := repo.Client.Promotion.UpdateOneID(p.ID).
+		SetName(p.Name).
+		SetType(p.Type).
+		SetRule(p.Rule).
+		SetStoreIds(p.StoreIDs).
+		SetChannels(p.Channels).
+		SetDiningWays(p.DiningWays).
+		SetTimeWindows(p.TimeWindows).
+		SetPriority(p.Priority).
+		SetStackable(p.Stackable).
+		SetEnabled(p.Enabled)
+	if p.StartAt != nil {
+		builder = builder.SetStartAt(*p.StartAt)
+	} else {
+		builder = builder.ClearStartAt()
+	}
+	if p.EndAt != nil {
+		builder = builder.SetEndAt(*p.EndAt)
+	} else {
+		builder = builder.ClearEndAt()
+	}
+
+	ep, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrPromotionNotExists)
+		}
+		return fmt.Errorf("failed to update promotion: %w", err)
+	}
+
+	p.UpdatedAt = ep.UpdatedAt
+	return nil
+}
+
+// Delete 删除促销活动
+func (repo *PromotionRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PromotionRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.Promotion.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrPromotionNotExists)
+		}
+		return fmt.Errorf("failed to delete promotion: %w", err)
+	}
+	return nil
+}
+
+// FindByID 根据ID查询促销活动
+func (repo *PromotionRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.Promotion, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PromotionRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	ep, err := repo.Client.Promotion.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrPromotionNotExists)
+		}
+		return nil, err
+	}
+	return convertPromotionToDomain(ep), nil
+}
+
+// Exists 检查促销活动名称是否已存在
+func (repo *PromotionRepository) Exists(ctx context.Context, params domain.PromotionExistsParams) (exists bool, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PromotionRepository.Exists")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.Promotion.Query().Where(
+		promotion.MerchantID(params.MerchantID),
+		promotion.StoreID(params.StoreID),
+		promotion.Name(params.Name),
+	)
+	if params.ExcludeID != uuid.Nil {
+		query.Where(promotion.IDNEQ(params.ExcludeID))
+	}
+	return query.Exist(ctx)
+}
+
+// PagedListBySearch 分页查询促销活动
+func (repo *PromotionRepository) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.PromotionSearchParams,
+) (res *domain.PromotionSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PromotionRepository.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.Promotion.Query().Where(
+		promotion.MerchantID(params.MerchantID),
+		promotion.StoreID(params.StoreID),
+	)
+	if params.Name != "" {
+		query.Where(promotion.NameContains(params.Name))
+	}
+	if params.Type != "" {
+		query.Where(promotion.TypeEQ(params.Type))
+	}
+	if params.Enabled != nil {
+		query.Where(promotion.Enabled(*params.Enabled))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按优先级、创建时间倒序排列
+	promotions, err := query.
+		Offset(page.Offset()).
+		Limit(page.Size).
+		Order(ent.Desc(promotion.FieldPriority), ent.Desc(promotion.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(domain.Promotions, 0, len(promotions))
+	for _, p := range promotions {
+		items = append(items, convertPromotionToDomain(p))
+	}
+
+	page.SetTotal(total)
+
+	return &domain.PromotionSearchRes{
+		Pagination: page,
+		Items:      items,
+	}, nil
+}
+
+// ListAvailable 查询门店在指定时间可能生效的已启用活动
+func (repo *PromotionRepository) ListAvailable(ctx context.Context, merchantID, storeID uuid.UUID, at time.Time) (res domain.Promotions, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "PromotionRepository.ListAvailable")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	promotions, err := repo.Client.Promotion.Query().
+		Where(
+			promotion.MerchantID(merchantID),
+			promotion.StoreIDIn(uuid.Nil, storeID),
+			promotion.Enabled(true),
+			promotion.Or(promotion.StartAtIsNil(), promotion.StartAtLTE(at)),
+			promotion.Or(promotion.EndAtIsNil(), promotion.EndAtGTE(at)),
+		).
+		Order(ent.Desc(promotion.FieldPriority), ent.Asc(promotion.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res = make(domain.Promotions, 0, len(promotions))
+	for _, p := range promotions {
+		res = append(res, convertPromotionToDomain(p))
+	}
+	return res, nil
+}
+
+func convertPromotionToDomain(ep *ent.Promotion) *domain.Promotion {
+	if ep == nil {
+		return nil
+	}
+	return &domain.Promotion{
+		ID:          ep.ID,
+		MerchantID:  ep.MerchantID,
+		StoreID:     ep.StoreID,
+		Name:        ep.Name,
+		Type:        ep.Type,
+		Rule:        ep.Rule,
+		StoreIDs:    ep.StoreIds,
+		Channels:    ep.Channels,
+		DiningWays:  ep.DiningWays,
+		StartAt:     ep.StartAt,
+		EndAt:       ep.EndAt,
+		TimeWindows: ep.TimeWindows,
+		Priority:    ep.Priority,
+		Stackable:   ep.Stackable,
+		Enabled:     ep.Enabled,
+		CreatedAt:   ep.CreatedAt,
+		UpdatedAt:   ep.UpdatedAt,
+	}
+}
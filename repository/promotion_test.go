@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type PromotionRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *PromotionRepository
+	ctx  context.Context
+}
+
+func TestPromotionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PromotionRepositoryTestSuite))
+}
+
+func (s *PromotionRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &PromotionRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *PromotionRepositoryTestSuite) newPromotion(merchantID, storeID uuid.UUID, name string) *domain.Promotion {
+	return &domain.Promotion{
+		ID:         uuid.New(),
+		MerchantID: merchantID,
+		StoreID:    storeID,
+		Name:       name,
+		Type:       domain.PromotionTypeOrderDiscount,
+		Rule: domain.PromotionRule{
+			Threshold:     decimal.NewFromInt(100),
+			DiscountType:  domain.PromotionDiscountTypeAmount,
+			DiscountValue: decimal.NewFromInt(20),
+		},
+		Channels: []domain.OrderChannel{domain.OrderChannelPOS},
+		Enabled:  true,
+	}
+}
+
+func (s *PromotionRepositoryTestSuite) TestPromotion_CRUD() {
+	merchantID := uuid.New()
+	p := s.newPromotion(merchantID, uuid.Nil, "满100减20")
+
+	s.T().Run("创建成功", func(t *testing.T) {
+		require.NoError(t, s.repo.Create(s.ctx, p))
+
+		found, err := s.repo.FindByID(s.ctx, p.ID)
+		require.NoError(t, err)
+		require.Equal(t, "满100减20", found.Name)
+		require.Equal(t, uuid.Nil, found.StoreID)
+		require.True(t, found.Rule.Threshold.Equal(decimal.NewFromInt(100)))
+		require.Equal(t, []domain.OrderChannel{domain.OrderChannelPOS}, found.Channels)
+		require.Nil(t, found.StartAt)
+	})
+
+	s.T().Run("空入参", func(t *testing.T) {
+		require.Error(t, s.repo.Create(s.ctx, nil))
+	})
+
+	s.T().Run("名称已存在", func(t *testing.T) {
+		exists, err := s.repo.Exists(s.ctx, domain.PromotionExistsParams{MerchantID: merchantID, Name: p.Name})
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		exists, err = s.repo.Exists(s.ctx, domain.PromotionExistsParams{MerchantID: merchantID, Name: p.Name, ExcludeID: p.ID})
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	s.T().Run("更新成功", func(t *testing.T) {
+		startAt := time.Now().Add(-time.Hour)
+		p.Name = "满200减50"
+		p.StartAt = &startAt
+		p.Stackable = true
+		require.NoError(t, s.repo.Update(s.ctx, p))
+
+		found, err := s.repo.FindByID(s.ctx, p.ID)
+		require.NoError(t, err)
+		require.Equal(t, "满200减50", found.Name)
+		require.NotNil(t, found.StartAt)
+		require.True(t, found.Stackable)
+
+		p.StartAt = nil
+		require.NoError(t, s.repo.Update(s.ctx, p))
+		found, err = s.repo.FindByID(s.ctx, p.ID)
+		require.NoError(t, err)
+		require.Nil(t, found.StartAt)
+	})
+
+	s.T().Run("删除", func(t *testing.T) {
+		require.NoError(t, s.repo.Delete(s.ctx, p.ID))
+		_, err := s.repo.FindByID(s.ctx, p.ID)
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("不存在", func(t *testing.T) {
+		require.True(t, domain.IsNotFound(s.repo.Update(s.ctx, s.newPromotion(merchantID, uuid.Nil, "不存在"))))
+		require.True(t, domain.IsNotFound(s.repo.Delete(s.ctx, uuid.New())))
+	})
+}
+
+func (s *PromotionRepositoryTestSuite) TestPromotion_PagedListBySearch() {
+	merchantID := uuid.New()
+	p1 := s.newPromotion(merchantID, uuid.Nil, "午市满减")
+	p1.Priority = 1
+	p2 := s.newPromotion(merchantID, uuid.Nil, "晚市满减")
+	p2.Priority = 2
+	p2.Enabled = false
+	p3 := s.newPromotion(merchantID, uuid.New(), "门店满减")
+	for _, p := range []*domain.Promotion{p1, p2, p3} {
+		require.NoError(s.T(), s.repo.Create(s.ctx, p))
+	}
+
+	s.T().Run("品牌活动", func(t *testing.T) {
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.PromotionSearchParams{MerchantID: merchantID})
+		require.NoError(t, err)
+		require.Equal(t, 2, res.Total)
+		require.Equal(t, p2.ID, res.Items[0].ID)
+	})
+
+	s.T().Run("按名称和状态筛选", func(t *testing.T) {
+		enabled := true
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.PromotionSearchParams{
+			MerchantID: merchantID,
+			Name:       "满减",
+			Enabled:    &enabled,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		require.Equal(t, p1.ID, res.Items[0].ID)
+	})
+}
+
+func (s *PromotionRepositoryTestSuite) TestPromotion_ListAvailable() {
+	merchantID := uuid.New()
+	storeID := uuid.New()
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	brand := s.newPromotion(merchantID, uuid.Nil, "品牌活动")
+	store := s.newPromotion(merchantID, storeID, "本店活动")
+	store.Priority = 5
+	otherStore := s.newPromotion(merchantID, uuid.New(), "其他门店活动")
+	disabled := s.newPromotion(merchantID, uuid.Nil, "已停用活动")
+	disabled.Enabled = false
+	notStarted := s.newPromotion(merchantID, uuid.Nil, "未开始活动")
+	notStarted.StartAt = &future
+	ended := s.newPromotion(merchantID, uuid.Nil, "已结束活动")
+	ended.EndAt = &past
+	for _, p := range []*domain.Promotion{brand, store, otherStore, disabled, notStarted, ended} {
+		require.NoError(s.T(), s.repo.Create(s.ctx, p))
+	}
+
+	promotions, err := s.repo.ListAvailable(s.ctx, merchantID, storeID, now)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), promotions, 2)
+	require.Equal(s.T(), store.ID, promotions[0].ID)
+	require.Equal(s.T(), brand.ID, promotions[1].ID)
+}
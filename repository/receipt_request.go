@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/receiptrequest"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.ReceiptRequestRepository = (*ReceiptRequestRepository)(nil)
+
+type ReceiptRequestRepository struct {
+	Client *ent.Client
+}
+
+func NewReceiptRequestRepository(client *ent.Client) *ReceiptRequestRepository {
+	return &ReceiptRequestRepository{
+		Client: client,
+	}
+}
+
+func (repo *ReceiptRequestRepository) Create(ctx context.Context, request *domain.ReceiptRequest) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReceiptRequestRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if request == nil {
+		return fmt.Errorf("receipt request is nil")
+	}
+
+	builder := repo.Client.ReceiptRequest.Create().
+		SetID(request.ID).
+		SetMerchantID(request.MerchantID).
+		SetStoreID(request.StoreID).
+		SetOrderID(request.OrderID).
+		SetOrderNo(request.OrderNo).
+		SetType(request.Type).
+		SetStatus(request.Status).
+		SetReason(request.Reason).
+		SetContactPhone(request.ContactPhone).
+		SetInvoiceTitle(request.InvoiceTitle).
+		SetInvoiceTaxNo(request.InvoiceTaxNo).
+		SetInvoiceEmail(request.InvoiceEmail).
+		SetHandleRemark(request.HandleRemark).
+		SetNillableHandledAt(request.HandledAt)
+	if request.HandledBy != uuid.Nil {
+		builder.SetHandledBy(request.HandledBy)
+	}
+	et, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create receipt request: %w", err)
+	}
+
+	request.CreatedAt = et.CreatedAt
+	request.UpdatedAt = et.UpdatedAt
+	return nil
+}
+
+func (repo *ReceiptRequestRepository) Update(ctx context.Context, request *domain.ReceiptRequest) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReceiptRequestRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if request == nil {
+		return fmt.Errorf("receipt request is nil")
+	}
+
+	builder := repo.Client.ReceiptRequest.UpdateOneID(request.ID).
+		SetStatus(request.Status).
+		SetHandleRemark(request.HandleRemark).
+		SetNillableHandledAt(request.HandledAt)
+	if request.HandledBy != uuid.Nil {
+		builder.SetHandledBy(request.HandledBy)
+	}
+	et, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrReceiptRequestNotExists)
+		}
+		return fmt.Errorf("failed to update receipt request: %w", err)
+	}
+
+	request.UpdatedAt = et.UpdatedAt
+	return nil
+}
+
+func (repo *ReceiptRequestRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.ReceiptRequest, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReceiptRequestRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	et, err := repo.Client.ReceiptRequest.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrReceiptRequestNotExists)
+		}
+		return nil, fmt.Errorf("failed to find receipt request: %w", err)
+	}
+	return convertReceiptRequestToDomain(et), nil
+}
+
+func (repo *ReceiptRequestRepository) ListByOrderID(ctx context.Context, orderID uuid.UUID) (res []*domain.ReceiptRequest, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReceiptRequestRepository.ListByOrderID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	requests, err := repo.Client.ReceiptRequest.Query().
+		Where(receiptrequest.OrderID(orderID)).
+		Order(ent.Asc(receiptrequest.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list receipt requests: %w", err)
+	}
+	return lo.Map(requests, func(item *ent.ReceiptRequest, _ int) *domain.ReceiptRequest {
+		return convertReceiptRequestToDomain(item)
+	}), nil
+}
+
+func (repo *ReceiptRequestRepository) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.ReceiptRequestSearchParams,
+) (res *domain.ReceiptRequestSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReceiptRequestRepository.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.ReceiptRequest.Query().
+		Where(receiptrequest.StoreID(params.StoreID))
+	if params.Type != "" {
+		query.Where(receiptrequest.TypeEQ(params.Type))
+	}
+	if params.Status != "" {
+		query.Where(receiptrequest.StatusEQ(params.Status))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := query.
+		Offset(page.Offset()).
+		Limit(page.Size).
+		Order(ent.Desc(receiptrequest.FieldCreatedAt), ent.Desc(receiptrequest.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page.SetTotal(total)
+
+	return &domain.ReceiptRequestSearchRes{
+		Pagination: page,
+		Items: lo.Map(requests, func(item *ent.ReceiptRequest, _ int) *domain.ReceiptRequest {
+			return convertReceiptRequestToDomain(item)
+		}),
+	}, nil
+}
+
+func convertReceiptRequestToDomain(et *ent.ReceiptRequest) *domain.ReceiptRequest {
+	if et == nil {
+		return nil
+	}
+	return &domain.ReceiptRequest{
+		ID:           et.ID,
+		MerchantID:   et.MerchantID,
+		StoreID:      et.StoreID,
+		OrderID:      et.OrderID,
+		OrderNo:      et.OrderNo,
+		Type:         et.Type,
+		Status:       et.Status,
+		Reason:       et.Reason,
+		ContactPhone: et.ContactPhone,
+		InvoiceTitle: et.InvoiceTitle,
+		InvoiceTaxNo: et.InvoiceTaxNo,
+		InvoiceEmail: et.InvoiceEmail,
+		HandleRemark: et.HandleRemark,
+		HandledBy:    et.HandledBy,
+		HandledAt:    et.HandledAt,
+		CreatedAt:    et.CreatedAt,
+		UpdatedAt:    et.UpdatedAt,
+	}
+}
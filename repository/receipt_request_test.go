@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+)
+
+type ReceiptRequestRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *ReceiptRequestRepository
+	ctx  context.Context
+}
+
+func TestReceiptRequestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptRequestRepositoryTestSuite))
+}
+
+func (s *ReceiptRequestRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &ReceiptRequestRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *ReceiptRequestRepositoryTestSuite) newRequest(storeID, orderID uuid.UUID, typ domain.ReceiptRequestType) *domain.ReceiptRequest {
+	return &domain.ReceiptRequest{
+		ID:           uuid.New(),
+		MerchantID:   uuid.New(),
+		StoreID:      storeID,
+		OrderID:      orderID,
+		OrderNo:      "S00120261022000001",
+		Type:         typ,
+		Status:       domain.ReceiptRequestStatusPending,
+		Reason:       "菜品有误",
+		ContactPhone: "13800000000",
+	}
+}
+
+func (s *ReceiptRequestRepositoryTestSuite) TestReceiptRequest_Flow() {
+	storeID := uuid.New()
+	orderID := uuid.New()
+	refund := s.newRequest(storeID, orderID, domain.ReceiptRequestTypeRefund)
+	require.NoError(s.T(), s.repo.Create(s.ctx, refund))
+	invoice := s.newRequest(storeID, orderID, domain.ReceiptRequestTypeInvoice)
+	invoice.InvoiceTitle = "某某科技有限公司"
+	invoice.InvoiceTaxNo = "91310000MA1FL0000X"
+	require.NoError(s.T(), s.repo.Create(s.ctx, invoice))
+	require.NoError(s.T(), s.repo.Create(s.ctx, s.newRequest(uuid.New(), uuid.New(), domain.ReceiptRequestTypeRefund)))
+
+	s.T().Run("查询订单申请", func(t *testing.T) {
+		requests, err := s.repo.ListByOrderID(s.ctx, orderID)
+		require.NoError(t, err)
+		require.Len(t, requests, 2)
+	})
+
+	s.T().Run("处理申请", func(t *testing.T) {
+		handledBy := uuid.New()
+		require.NoError(t, refund.Handle(true, "已原路退款", handledBy, time.Now()))
+		require.NoError(t, s.repo.Update(s.ctx, refund))
+
+		got, err := s.repo.FindByID(s.ctx, refund.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.ReceiptRequestStatusAccepted, got.Status)
+		require.Equal(t, "已原路退款", got.HandleRemark)
+		require.Equal(t, handledBy, got.HandledBy)
+		require.NotNil(t, got.HandledAt)
+	})
+
+	s.T().Run("分页查询门店申请", func(t *testing.T) {
+		res, err := s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.ReceiptRequestSearchParams{
+			StoreID: storeID,
+			Status:  domain.ReceiptRequestStatusPending,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Total)
+		require.Equal(t, invoice.ID, res.Items[0].ID)
+		require.Equal(t, "某某科技有限公司", res.Items[0].InvoiceTitle)
+
+		res, err = s.repo.PagedListBySearch(s.ctx, upagination.New(1, 10), domain.ReceiptRequestSearchParams{
+			StoreID: storeID,
+			Type:    domain.ReceiptRequestTypeRefund,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Total)
+	})
+
+	s.T().Run("申请不存在", func(t *testing.T) {
+		_, err := s.repo.FindByID(s.ctx, uuid.New())
+		require.True(t, domain.IsNotFound(err))
+	})
+}
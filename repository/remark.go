@@ -51,6 +51,7 @@ func (repo *RemarkRepository) Create(ctx context.Context, remark *domain.Remark)
 	builder := repo.Client.Remark.Create().
 		SetID(remark.ID).
 		SetName(remark.Name).
+		SetNameI18n(remark.NameI18n).
 		SetRemarkType(remark.RemarkType).
 		SetEnabled(remark.Enabled).
 		SetSortOrder(remark.SortOrder).
@@ -84,6 +85,7 @@ func (repo *RemarkRepository) Update(ctx context.Context, remark *domain.Remark)
 
 	builder := repo.Client.Remark.UpdateOneID(remark.ID).
 		SetName(remark.Name).
+		SetNameI18n(remark.NameI18n).
 		SetEnabled(remark.Enabled).
 		SetSortOrder(remark.SortOrder)
 	updated, err := builder.Save(ctx)
@@ -222,6 +224,7 @@ func convertRemarkToDomain(er *ent.Remark) *domain.Remark {
 	return &domain.Remark{
 		ID:          er.ID,
 		Name:        er.Name,
+		NameI18n:    er.NameI18n,
 		RemarkType:  er.RemarkType,
 		Enabled:     er.Enabled,
 		SortOrder:   er.SortOrder,
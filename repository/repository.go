@@ -52,6 +52,35 @@ type Repository struct {
 	storePaymentAccountRepo    *StorePaymentAccountRepository
 	refundOrderRepo            *RefundOrderRepository
 	businessConfigRepo         *BusinessConfigRepository
+	priceChangeRepo            *PriceChangeRepository
+	productVersionRepo         *ProductVersionRepository
+	menuVersionRepo            *MenuVersionRepository
+	promotionRepo              *PromotionRepository
+	couponTemplateRepo         *CouponTemplateRepository
+	couponRepo                 *CouponRepository
+	memberTierRepo             *MemberTierRepository
+	memberRepo                 *MemberRepository
+	memberAccountRepo          *MemberAccountRepository
+	memberAccountTxnRepo       *MemberAccountTransactionRepository
+	memberPointsRuleRepo       *MemberPointsRuleRepository
+	memberPointsAccountRepo    *MemberPointsAccountRepository
+	memberPointsTxnRepo        *MemberPointsTransactionRepository
+	customerRepo               *CustomerRepository
+	diningTableRepo            *DiningTableRepository
+	scanOrderRuleRepo          *ScanOrderRuleRepository
+	kitchenTicketRepo          *KitchenTicketRepository
+	receiptRequestRepo         *ReceiptRequestRepository
+	pickupRuleRepo             *PickupRuleRepository
+	pickupCallRepo             *PickupCallRepository
+	deliveryStoreRepo          *DeliveryStoreRepository
+	deliveryProductMappingRepo *DeliveryProductMappingRepository
+	deliveryOrderRepo          *DeliveryOrderRepository
+	reservationRuleRepo        *ReservationRuleRepository
+	reservationRepo            *ReservationRepository
+	waitlistRuleRepo           *WaitlistRuleRepository
+	waitlistTicketRepo         *WaitlistTicketRepository
+	preorderRuleRepo           *PreorderRuleRepository
+	preorderRepo               *PreorderRepository
 }
 
 func (repo *Repository) IsTransactionActive() bool {
@@ -450,3 +479,264 @@ func (repo *Repository) BusinessConfigRepo() domain.BusinessConfigRepository {
 	}
 	return repo.businessConfigRepo
 }
+
+func (repo *Repository) PriceChangeRepo() domain.PriceChangeRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.priceChangeRepo == nil {
+		repo.priceChangeRepo = NewPriceChangeRepository(repo.client)
+	}
+	return repo.priceChangeRepo
+}
+
+func (repo *Repository) ProductVersionRepo() domain.ProductVersionRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.productVersionRepo == nil {
+		repo.productVersionRepo = NewProductVersionRepository(repo.client)
+	}
+	return repo.productVersionRepo
+}
+
+func (repo *Repository) MenuVersionRepo() domain.MenuVersionRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.menuVersionRepo == nil {
+		repo.menuVersionRepo = NewMenuVersionRepository(repo.client)
+	}
+	return repo.menuVersionRepo
+}
+
+func (repo *Repository) PromotionRepo() domain.PromotionRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.promotionRepo == nil {
+		repo.promotionRepo = NewPromotionRepository(repo.client)
+	}
+	return repo.promotionRepo
+}
+
+func (repo *Repository) CouponTemplateRepo() domain.CouponTemplateRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.couponTemplateRepo == nil {
+		repo.couponTemplateRepo = NewCouponTemplateRepository(repo.client)
+	}
+	return repo.couponTemplateRepo
+}
+
+func (repo *Repository) CouponRepo() domain.CouponRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.couponRepo == nil {
+		repo.couponRepo = NewCouponRepository(repo.client)
+	}
+	return repo.couponRepo
+}
+
+func (repo *Repository) MemberTierRepo() domain.MemberTierRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.memberTierRepo == nil {
+		repo.memberTierRepo = NewMemberTierRepository(repo.client)
+	}
+	return repo.memberTierRepo
+}
+
+func (repo *Repository) MemberRepo() domain.MemberRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.memberRepo == nil {
+		repo.memberRepo = NewMemberRepository(repo.client)
+	}
+	return repo.memberRepo
+}
+
+func (repo *Repository) MemberAccountRepo() domain.MemberAccountRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.memberAccountRepo == nil {
+		repo.memberAccountRepo = NewMemberAccountRepository(repo.client)
+	}
+	return repo.memberAccountRepo
+}
+
+func (repo *Repository) MemberAccountTransactionRepo() domain.MemberAccountTransactionRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.memberAccountTxnRepo == nil {
+		repo.memberAccountTxnRepo = NewMemberAccountTransactionRepository(repo.client)
+	}
+	return repo.memberAccountTxnRepo
+}
+
+func (repo *Repository) MemberPointsRuleRepo() domain.MemberPointsRuleRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.memberPointsRuleRepo == nil {
+		repo.memberPointsRuleRepo = NewMemberPointsRuleRepository(repo.client)
+	}
+	return repo.memberPointsRuleRepo
+}
+
+func (repo *Repository) MemberPointsAccountRepo() domain.MemberPointsAccountRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.memberPointsAccountRepo == nil {
+		repo.memberPointsAccountRepo = NewMemberPointsAccountRepository(repo.client)
+	}
+	return repo.memberPointsAccountRepo
+}
+
+func (repo *Repository) MemberPointsTransactionRepo() domain.MemberPointsTransactionRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.memberPointsTxnRepo == nil {
+		repo.memberPointsTxnRepo = NewMemberPointsTransactionRepository(repo.client)
+	}
+	return repo.memberPointsTxnRepo
+}
+
+func (repo *Repository) CustomerRepo() domain.CustomerRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.customerRepo == nil {
+		repo.customerRepo = NewCustomerRepository(repo.client)
+	}
+	return repo.customerRepo
+}
+
+func (repo *Repository) DiningTableRepo() domain.DiningTableRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.diningTableRepo == nil {
+		repo.diningTableRepo = NewDiningTableRepository(repo.client)
+	}
+	return repo.diningTableRepo
+}
+
+func (repo *Repository) ScanOrderRuleRepo() domain.ScanOrderRuleRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.scanOrderRuleRepo == nil {
+		repo.scanOrderRuleRepo = NewScanOrderRuleRepository(repo.client)
+	}
+	return repo.scanOrderRuleRepo
+}
+
+func (repo *Repository) KitchenTicketRepo() domain.KitchenTicketRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.kitchenTicketRepo == nil {
+		repo.kitchenTicketRepo = NewKitchenTicketRepository(repo.client)
+	}
+	return repo.kitchenTicketRepo
+}
+
+func (repo *Repository) ReceiptRequestRepo() domain.ReceiptRequestRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.receiptRequestRepo == nil {
+		repo.receiptRequestRepo = NewReceiptRequestRepository(repo.client)
+	}
+	return repo.receiptRequestRepo
+}
+
+func (repo *Repository) PickupRuleRepo() domain.PickupRuleRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.pickupRuleRepo == nil {
+		repo.pickupRuleRepo = NewPickupRuleRepository(repo.client)
+	}
+	return repo.pickupRuleRepo
+}
+
+func (repo *Repository) PickupCallRepo() domain.PickupCallRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.pickupCallRepo == nil {
+		repo.pickupCallRepo = NewPickupCallRepository(repo.client)
+	}
+	return repo.pickupCallRepo
+}
+
+func (repo *Repository) DeliveryStoreRepo() domain.DeliveryStoreRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.deliveryStoreRepo == nil {
+		repo.deliveryStoreRepo = NewDeliveryStoreRepository(repo.client)
+	}
+	return repo.deliveryStoreRepo
+}
+
+func (repo *Repository) DeliveryProductMappingRepo() domain.DeliveryProductMappingRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.deliveryProductMappingRepo == nil {
+		repo.deliveryProductMappingRepo = NewDeliveryProductMappingRepository(repo.client)
+	}
+	return repo.deliveryProductMappingRepo
+}
+
+func (repo *Repository) DeliveryOrderRepo() domain.DeliveryOrderRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.deliveryOrderRepo == nil {
+		repo.deliveryOrderRepo = NewDeliveryOrderRepository(repo.client)
+	}
+	return repo.deliveryOrderRepo
+}
+
+func (repo *Repository) ReservationRuleRepo() domain.ReservationRuleRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.reservationRuleRepo == nil {
+		repo.reservationRuleRepo = NewReservationRuleRepository(repo.client)
+	}
+	return repo.reservationRuleRepo
+}
+
+func (repo *Repository) ReservationRepo() domain.ReservationRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.reservationRepo == nil {
+		repo.reservationRepo = NewReservationRepository(repo.client)
+	}
+	return repo.reservationRepo
+}
+
+func (repo *Repository) WaitlistRuleRepo() domain.WaitlistRuleRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.waitlistRuleRepo == nil {
+		repo.waitlistRuleRepo = NewWaitlistRuleRepository(repo.client)
+	}
+	return repo.waitlistRuleRepo
+}
+
+func (repo *Repository) WaitlistTicketRepo() domain.WaitlistTicketRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.waitlistTicketRepo == nil {
+		repo.waitlistTicketRepo = NewWaitlistTicketRepository(repo.client)
+	}
+	return repo.waitlistTicketRepo
+}
+
+func (repo *Repository) PreorderRuleRepo() domain.PreorderRuleRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.preorderRuleRepo == nil {
+		repo.preorderRuleRepo = NewPreorderRuleRepository(repo.client)
+	}
+	return repo.preorderRuleRepo
+}
+
+func (repo *Repository) PreorderRepo() domain.PreorderRepository {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.preorderRepo == nil {
+		repo.preorderRepo = NewPreorderRepository(repo.client)
+	}
+	return repo.preorderRepo
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservation"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.ReservationRepository = (*ReservationRepository)(nil)
+
+type ReservationRepository struct {
+	Client *ent.Client
+}
+
+func NewReservationRepository(client *ent.Client) *ReservationRepository {
+	return &ReservationRepository{
+		Client: client,
+	}
+}
+
+func (repo *ReservationRepository) Create(ctx context.Context, r *domain.Reservation) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReservationRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if r == nil {
+		return fmt.Errorf("reservation is nil")
+	}
+
+	er, err := repo.Client.Reservation.Create().
+		SetID(r.ID).
+		SetMerchantID(r.MerchantID).
+		SetStoreID(r.StoreID).
+		SetSource(r.Source).
+		SetCustomerID(r.CustomerID).
+		SetGuestName(r.GuestName).
+		SetGuestPhone(r.GuestPhone).
+		SetPartySize(r.PartySize).
+		SetReservedAt(r.ReservedAt).
+		SetEndAt(r.EndAt).
+		SetDiningPeriod(r.DiningPeriod).
+		SetTableID(r.TableID).
+		SetTableName(r.TableName).
+		SetAreaPreference(r.AreaPreference).
+		SetRemark(r.Remark).
+		SetStatus(r.Status).
+		SetDeposit(r.Deposit).
+		SetDepositStatus(r.DepositStatus).
+		SetDepositMethod(r.DepositMethod).
+		SetNillableDepositPaidAt(r.DepositPaidAt).
+		SetOrderID(r.OrderID).
+		SetNillableSeatedAt(r.SeatedAt).
+		SetNillableCancelledAt(r.CancelledAt).
+		SetCancelReason(r.CancelReason).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create reservation: %w", err)
+	}
+
+	r.CreatedAt = er.CreatedAt
+	r.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *ReservationRepository) Update(ctx context.Context, r *domain.Reservation) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReservationRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if r == nil {
+		return fmt.Errorf("reservation is nil")
+	}
+
+	er, err := repo.Client.Reservation.UpdateOneID(r.ID).
+		SetTableID(r.TableID).
+		SetTableName(r.TableName).
+		SetStatus(r.Status).
+		SetDepositStatus(r.DepositStatus).
+		SetDepositMethod(r.DepositMethod).
+		SetNillableDepositPaidAt(r.DepositPaidAt).
+		SetOrderID(r.OrderID).
+		SetNillableSeatedAt(r.SeatedAt).
+		SetNillableCancelledAt(r.CancelledAt).
+		SetCancelReason(r.CancelReason).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrReservationNotExists)
+		}
+		return fmt.Errorf("failed to update reservation: %w", err)
+	}
+
+	r.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *ReservationRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.Reservation, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReservationRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	er, err := repo.Client.Reservation.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrReservationNotExists)
+		}
+		return nil, fmt.Errorf("failed to find reservation: %w", err)
+	}
+	return convertReservationToDomain(er), nil
+}
+
+func (repo *ReservationRepository) List(ctx context.Context, params domain.ReservationListParams) (res []*domain.Reservation, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReservationRepository.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.Reservation.Query()
+	if params.StoreID != uuid.Nil {
+		query.Where(reservation.StoreID(params.StoreID))
+	}
+	if params.CustomerID != uuid.Nil {
+		query.Where(reservation.CustomerID(params.CustomerID))
+	}
+	if len(params.Statuses) > 0 {
+		query.Where(reservation.StatusIn(params.Statuses...))
+	}
+	if !params.From.IsZero() {
+		query.Where(reservation.ReservedAtGTE(params.From))
+	}
+	if !params.To.IsZero() {
+		query.Where(reservation.ReservedAtLT(params.To))
+	}
+
+	reservations, err := query.Order(ent.Asc(reservation.FieldReservedAt)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reservations: %w", err)
+	}
+	return lo.Map(reservations, func(item *ent.Reservation, _ int) *domain.Reservation {
+		return convertReservationToDomain(item)
+	}), nil
+}
+
+func convertReservationToDomain(er *ent.Reservation) *domain.Reservation {
+	if er == nil {
+		return nil
+	}
+	return &domain.Reservation{
+		ID:             er.ID,
+		MerchantID:     er.MerchantID,
+		StoreID:        er.StoreID,
+		Source:         er.Source,
+		CustomerID:     er.CustomerID,
+		GuestName:      er.GuestName,
+		GuestPhone:     er.GuestPhone,
+		PartySize:      er.PartySize,
+		ReservedAt:     er.ReservedAt,
+		EndAt:          er.EndAt,
+		DiningPeriod:   er.DiningPeriod,
+		TableID:        er.TableID,
+		TableName:      er.TableName,
+		AreaPreference: er.AreaPreference,
+		Remark:         er.Remark,
+		Status:         er.Status,
+		Deposit:        er.Deposit,
+		DepositStatus:  er.DepositStatus,
+		DepositMethod:  er.DepositMethod,
+		DepositPaidAt:  er.DepositPaidAt,
+		OrderID:        er.OrderID,
+		SeatedAt:       er.SeatedAt,
+		CancelledAt:    er.CancelledAt,
+		CancelReason:   er.CancelReason,
+		CreatedAt:      er.CreatedAt,
+		UpdatedAt:      er.UpdatedAt,
+	}
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/reservationrule"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.ReservationRuleRepository = (*ReservationRuleRepository)(nil)
+
+type ReservationRuleRepository struct {
+	Client *ent.Client
+}
+
+func NewReservationRuleRepository(client *ent.Client) *ReservationRuleRepository {
+	return &ReservationRuleRepository{
+		Client: client,
+	}
+}
+
+func (repo *ReservationRuleRepository) Create(ctx context.Context, rule *domain.ReservationRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReservationRuleRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("reservation rule is nil")
+	}
+
+	er, err := repo.Client.ReservationRule.Create().
+		SetID(rule.ID).
+		SetMerchantID(rule.MerchantID).
+		SetStoreID(rule.StoreID).
+		SetOnlineEnabled(rule.OnlineEnabled).
+		SetSlotMinutes(rule.SlotMinutes).
+		SetDiningMinutes(rule.DiningMinutes).
+		SetMinAdvanceMinutes(rule.MinAdvanceMinutes).
+		SetMaxAdvanceDays(rule.MaxAdvanceDays).
+		SetMaxPartySize(rule.MaxPartySize).
+		SetDepositPerGuest(rule.DepositPerGuest).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create reservation rule: %w", err)
+	}
+
+	rule.CreatedAt = er.CreatedAt
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *ReservationRuleRepository) Update(ctx context.Context, rule *domain.ReservationRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReservationRuleRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("reservation rule is nil")
+	}
+
+	er, err := repo.Client.ReservationRule.UpdateOneID(rule.ID).
+		SetOnlineEnabled(rule.OnlineEnabled).
+		SetSlotMinutes(rule.SlotMinutes).
+		SetDiningMinutes(rule.DiningMinutes).
+		SetMinAdvanceMinutes(rule.MinAdvanceMinutes).
+		SetMaxAdvanceDays(rule.MaxAdvanceDays).
+		SetMaxPartySize(rule.MaxPartySize).
+		SetDepositPerGuest(rule.DepositPerGuest).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrReservationRuleNotExists)
+		}
+		return fmt.Errorf("failed to update reservation rule: %w", err)
+	}
+
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *ReservationRuleRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReservationRuleRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.ReservationRule.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrReservationRuleNotExists)
+		}
+		return fmt.Errorf("failed to delete reservation rule: %w", err)
+	}
+	return nil
+}
+
+func (repo *ReservationRuleRepository) FindByStore(ctx context.Context, merchantID, storeID uuid.UUID) (res *domain.ReservationRule, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ReservationRuleRepository.FindByStore")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	er, err := repo.Client.ReservationRule.Query().
+		Where(
+			reservationrule.MerchantID(merchantID),
+			reservationrule.StoreID(storeID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrReservationRuleNotExists)
+		}
+		return nil, fmt.Errorf("failed to find reservation rule: %w", err)
+	}
+	return convertReservationRuleToDomain(er), nil
+}
+
+func convertReservationRuleToDomain(er *ent.ReservationRule) *domain.ReservationRule {
+	if er == nil {
+		return nil
+	}
+	return &domain.ReservationRule{
+		StoreRuleMeta: domain.StoreRuleMeta{
+			ID:         er.ID,
+			MerchantID: er.MerchantID,
+			StoreID:    er.StoreID,
+			CreatedAt:  er.CreatedAt,
+			UpdatedAt:  er.UpdatedAt,
+		},
+		OnlineEnabled:     er.OnlineEnabled,
+		SlotMinutes:       er.SlotMinutes,
+		DiningMinutes:     er.DiningMinutes,
+		MinAdvanceMinutes: er.MinAdvanceMinutes,
+		MaxAdvanceDays:    er.MaxAdvanceDays,
+		MaxPartySize:      er.MaxPartySize,
+		DepositPerGuest:   er.DepositPerGuest,
+	}
+}
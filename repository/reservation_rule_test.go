@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+)
+
+func TestReservationRuleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, &StoreRuleRepositoryTestSuite[*domain.ReservationRule]{
+		newRepo: func(client *ent.Client) domain.StoreRuleRepository[*domain.ReservationRule] {
+			return NewReservationRuleRepository(client)
+		},
+		newRule: domain.DefaultReservationRule,
+		update: func(rule *domain.ReservationRule) {
+			rule.OnlineEnabled = true
+			rule.SlotMinutes = 15
+			rule.MaxPartySize = 12
+			rule.DepositPerGuest = decimal.RequireFromString("20.5")
+		},
+		assertRule: func(t *testing.T, want, got *domain.ReservationRule) {
+			require.Equal(t, want.OnlineEnabled, got.OnlineEnabled)
+			require.Equal(t, want.SlotMinutes, got.SlotMinutes)
+			require.Equal(t, want.DiningMinutes, got.DiningMinutes)
+			require.Equal(t, want.MinAdvanceMinutes, got.MinAdvanceMinutes)
+			require.Equal(t, want.MaxAdvanceDays, got.MaxAdvanceDays)
+			require.Equal(t, want.MaxPartySize, got.MaxPartySize)
+			require.True(t, want.DepositPerGuest.Equal(got.DepositPerGuest))
+		},
+	})
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type ReservationRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *ReservationRepository
+	ctx  context.Context
+}
+
+func TestReservationRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ReservationRepositoryTestSuite))
+}
+
+func (s *ReservationRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &ReservationRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *ReservationRepositoryTestSuite) TestReservation_Flow() {
+	merchantID := uuid.New()
+	storeID := uuid.New()
+	customerID := uuid.New()
+	day := time.Date(2026, 10, 26, 0, 0, 0, 0, time.Local)
+	newReservation := func(at time.Time, customerID uuid.UUID) *domain.Reservation {
+		r := &domain.Reservation{
+			ID:            uuid.New(),
+			MerchantID:    merchantID,
+			StoreID:       storeID,
+			Source:        domain.ReservationSourceStore,
+			CustomerID:    customerID,
+			GuestName:     "张三",
+			GuestPhone:    "13800000000",
+			PartySize:     4,
+			ReservedAt:    at,
+			EndAt:         at.Add(90 * time.Minute),
+			TableID:       uuid.New(),
+			TableName:     "A1",
+			Status:        domain.ReservationStatusBooked,
+			Deposit:       decimal.NewFromInt(100),
+			DepositStatus: domain.ReservationDepositStatusUnpaid,
+		}
+		if customerID != uuid.Nil {
+			r.Source = domain.ReservationSourceOnline
+		}
+		require.NoError(s.T(), s.repo.Create(s.ctx, r))
+		return r
+	}
+	dinner := newReservation(day.Add(18*time.Hour), uuid.Nil)
+	lunch := newReservation(day.Add(12*time.Hour), customerID)
+	nextDay := newReservation(day.Add(36*time.Hour), uuid.Nil)
+
+	s.T().Run("收取定金并入座", func(t *testing.T) {
+		now := day.Add(17 * time.Hour).Truncate(time.Second)
+		require.NoError(t, dinner.CollectDeposit(domain.PaymentMethodPayTypeCash, now))
+		orderID := uuid.New()
+		table := &domain.DiningTable{ID: uuid.New(), Name: "B2"}
+		require.NoError(t, dinner.Seat(table, orderID, now))
+		require.NoError(t, s.repo.Update(s.ctx, dinner))
+
+		got, err := s.repo.FindByID(s.ctx, dinner.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.ReservationStatusSeated, got.Status)
+		require.Equal(t, domain.ReservationDepositStatusPaid, got.DepositStatus)
+		require.Equal(t, domain.PaymentMethodPayTypeCash, got.DepositMethod)
+		require.Equal(t, table.ID, got.TableID)
+		require.Equal(t, "B2", got.TableName)
+		require.Equal(t, orderID, got.OrderID)
+		require.NotNil(t, got.SeatedAt)
+		require.True(t, decimal.NewFromInt(100).Equal(got.Deposit))
+	})
+
+	s.T().Run("按日期和状态查询", func(t *testing.T) {
+		list, err := s.repo.List(s.ctx, domain.ReservationListParams{
+			StoreID: storeID,
+			From:    day,
+			To:      day.AddDate(0, 0, 1),
+		})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, lunch.ID, list[0].ID)
+		require.Equal(t, dinner.ID, list[1].ID)
+
+		list, err = s.repo.List(s.ctx, domain.ReservationListParams{
+			StoreID:  storeID,
+			Statuses: []domain.ReservationStatus{domain.ReservationStatusBooked},
+		})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, lunch.ID, list[0].ID)
+		require.Equal(t, nextDay.ID, list[1].ID)
+	})
+
+	s.T().Run("按顾客查询", func(t *testing.T) {
+		list, err := s.repo.List(s.ctx, domain.ReservationListParams{CustomerID: customerID})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, lunch.ID, list[0].ID)
+		require.Equal(t, domain.ReservationSourceOnline, list[0].Source)
+	})
+
+	s.T().Run("不存在", func(t *testing.T) {
+		_, err := s.repo.FindByID(s.ctx, uuid.New())
+		require.True(t, domain.IsNotFound(err))
+	})
+}
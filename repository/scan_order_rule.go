@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/scanorderrule"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.ScanOrderRuleRepository = (*ScanOrderRuleRepository)(nil)
+
+type ScanOrderRuleRepository struct {
+	Client *ent.Client
+}
+
+func NewScanOrderRuleRepository(client *ent.Client) *ScanOrderRuleRepository {
+	return &ScanOrderRuleRepository{
+		Client: client,
+	}
+}
+
+func (repo *ScanOrderRuleRepository) Create(ctx context.Context, rule *domain.ScanOrderRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ScanOrderRuleRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("scan order rule is nil")
+	}
+
+	er, err := repo.Client.ScanOrderRule.Create().
+		SetID(rule.ID).
+		SetMerchantID(rule.MerchantID).
+		SetStoreID(rule.StoreID).
+		SetEnabled(rule.Enabled).
+		SetPayMode(rule.PayMode).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create scan order rule: %w", err)
+	}
+
+	rule.CreatedAt = er.CreatedAt
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *ScanOrderRuleRepository) Update(ctx context.Context, rule *domain.ScanOrderRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ScanOrderRuleRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("scan order rule is nil")
+	}
+
+	er, err := repo.Client.ScanOrderRule.UpdateOneID(rule.ID).
+		SetEnabled(rule.Enabled).
+		SetPayMode(rule.PayMode).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrScanOrderRuleNotExists)
+		}
+		return fmt.Errorf("failed to update scan order rule: %w", err)
+	}
+
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *ScanOrderRuleRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ScanOrderRuleRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.ScanOrderRule.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrScanOrderRuleNotExists)
+		}
+		return fmt.Errorf("failed to delete scan order rule: %w", err)
+	}
+	return nil
+}
+
+func (repo *ScanOrderRuleRepository) FindByStore(ctx context.Context, merchantID, storeID uuid.UUID) (res *domain.ScanOrderRule, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "ScanOrderRuleRepository.FindByStore")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	er, err := repo.Client.ScanOrderRule.Query().
+		Where(
+			scanorderrule.MerchantID(merchantID),
+			scanorderrule.StoreID(storeID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrScanOrderRuleNotExists)
+		}
+		return nil, fmt.Errorf("failed to find scan order rule: %w", err)
+	}
+	return convertScanOrderRuleToDomain(er), nil
+}
+
+func convertScanOrderRuleToDomain(er *ent.ScanOrderRule) *domain.ScanOrderRule {
+	if er == nil {
+		return nil
+	}
+	return &domain.ScanOrderRule{
+		StoreRuleMeta: domain.StoreRuleMeta{
+			ID:         er.ID,
+			MerchantID: er.MerchantID,
+			StoreID:    er.StoreID,
+			CreatedAt:  er.CreatedAt,
+			UpdatedAt:  er.UpdatedAt,
+		},
+		Enabled: er.Enabled,
+		PayMode: er.PayMode,
+	}
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+)
+
+func TestScanOrderRuleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, &StoreRuleRepositoryTestSuite[*domain.ScanOrderRule]{
+		newRepo: func(client *ent.Client) domain.StoreRuleRepository[*domain.ScanOrderRule] {
+			return NewScanOrderRuleRepository(client)
+		},
+		newRule: domain.DefaultScanOrderRule,
+		update: func(rule *domain.ScanOrderRule) {
+			rule.Enabled = true
+			rule.PayMode = domain.ScanOrderPayModePayFirst
+		},
+		assertRule: func(t *testing.T, want, got *domain.ScanOrderRule) {
+			require.Equal(t, want.Enabled, got.Enabled)
+			require.Equal(t, want.PayMode, got.PayMode)
+		},
+	})
+}
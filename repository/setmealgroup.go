@@ -3,7 +3,10 @@ package repository
 import (
 	"context"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/ent"
 	"gitlab.jiguang.dev/pos-dine/dine/ent/schema/schematype"
@@ -73,6 +76,9 @@ func (repo *SetMealGroupRepository) CreateDetails(ctx context.Context, details [
 		if len(detail.OptionalProductIDs) > 0 {
 			builder = builder.SetOptionalProductIds(detail.OptionalProductIDs)
 		}
+		if len(detail.OptionalSurcharges) > 0 {
+			builder = builder.SetOptionalSurcharges(detail.OptionalSurcharges)
+		}
 
 		builders = append(builders, builder)
 	}
@@ -135,6 +141,30 @@ func (repo *SetMealGroupRepository) CheckProductBelongToSetMeal(ctx context.Cont
 	return exist, nil
 }
 
+// ListSetMealIDsByProductID 查询套餐组详情中包含指定商品（含备选商品）的套餐商品ID
+func (repo *SetMealGroupRepository) ListSetMealIDsByProductID(ctx context.Context, productID uuid.UUID) (res []uuid.UUID, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "SetMealGroupRepository.ListSetMealIDsByProductID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+	groups, err := repo.Client.SetMealGroup.Query().
+		Where(setmealgroup.HasDetailsWith(setmealdetail.Or(
+			setmealdetail.ProductIDEQ(productID),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(s.C(setmealdetail.FieldOptionalProductIds), productID.String()))
+			},
+		))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]uuid.UUID, 0, len(groups))
+	for _, group := range groups {
+		res = append(res, group.ProductID)
+	}
+	return lo.Uniq(res), nil
+}
+
 // ============================================
 // 转换函数
 // ============================================
@@ -172,6 +202,7 @@ func convertSetMealDetailToDomain(ed *ent.SetMealDetail) *domain.SetMealDetail {
 		Quantity:           ed.Quantity,
 		IsDefault:          ed.IsDefault,
 		OptionalProductIDs: ed.OptionalProductIds,
+		OptionalSurcharges: ed.OptionalSurcharges,
 		CreatedAt:          ed.CreatedAt,
 		UpdatedAt:          ed.UpdatedAt,
 	}
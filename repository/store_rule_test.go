@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+)
+
+// StoreRuleRepositoryTestSuite 门店规则仓储的通用用例：品牌商与门店各一条、按门店查询、更新与删除
+type StoreRuleRepositoryTestSuite[R domain.StoreRule] struct {
+	RepositoryTestSuite
+	repo domain.StoreRuleRepository[R]
+	ctx  context.Context
+
+	newRepo func(client *ent.Client) domain.StoreRuleRepository[R]
+	newRule func(merchantID, storeID uuid.UUID) R
+	// update 修改规则内容，用于验证更新
+	update func(rule R)
+	// assertRule 比较规则内容
+	assertRule func(t *testing.T, want, got R)
+}
+
+func (s *StoreRuleRepositoryTestSuite[R]) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = s.newRepo(s.client)
+	s.ctx = context.Background()
+}
+
+func (s *StoreRuleRepositoryTestSuite[R]) TestStoreRule_Save() {
+	merchantID := uuid.New()
+	storeID := uuid.New()
+
+	merchantRule := s.newRule(merchantID, uuid.Nil)
+	merchantRule.Meta().ID = uuid.New()
+	require.NoError(s.T(), s.repo.Create(s.ctx, merchantRule))
+	storeRule := s.newRule(merchantID, storeID)
+	storeRule.Meta().ID = uuid.New()
+	require.NoError(s.T(), s.repo.Create(s.ctx, storeRule))
+
+	s.T().Run("品牌商与门店各一条", func(t *testing.T) {
+		dup := s.newRule(merchantID, storeID)
+		dup.Meta().ID = uuid.New()
+		require.True(t, domain.IsConflict(s.repo.Create(s.ctx, dup)))
+	})
+
+	s.T().Run("按门店查询", func(t *testing.T) {
+		got, err := s.repo.FindByStore(s.ctx, merchantID, uuid.Nil)
+		require.NoError(t, err)
+		require.Equal(t, merchantRule.Meta().ID, got.Meta().ID)
+		s.assertRule(t, merchantRule, got)
+
+		got, err = s.repo.FindByStore(s.ctx, merchantID, storeID)
+		require.NoError(t, err)
+		require.Equal(t, storeRule.Meta().ID, got.Meta().ID)
+		require.Equal(t, storeID, got.Meta().StoreID)
+		s.assertRule(t, storeRule, got)
+
+		_, err = s.repo.FindByStore(s.ctx, merchantID, uuid.New())
+		require.True(t, domain.IsNotFound(err))
+	})
+
+	s.T().Run("更新与删除", func(t *testing.T) {
+		s.update(storeRule)
+		require.NoError(t, s.repo.Update(s.ctx, storeRule))
+
+		got, err := s.repo.FindByStore(s.ctx, merchantID, storeID)
+		require.NoError(t, err)
+		s.assertRule(t, storeRule, got)
+
+		require.NoError(t, s.repo.Delete(s.ctx, storeRule.Meta().ID))
+		_, err = s.repo.FindByStore(s.ctx, merchantID, storeID)
+		require.True(t, domain.IsNotFound(err))
+		require.True(t, domain.IsNotFound(s.repo.Delete(s.ctx, storeRule.Meta().ID)))
+	})
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistrule"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.WaitlistRuleRepository = (*WaitlistRuleRepository)(nil)
+
+type WaitlistRuleRepository struct {
+	Client *ent.Client
+}
+
+func NewWaitlistRuleRepository(client *ent.Client) *WaitlistRuleRepository {
+	return &WaitlistRuleRepository{
+		Client: client,
+	}
+}
+
+func (repo *WaitlistRuleRepository) Create(ctx context.Context, rule *domain.WaitlistRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "WaitlistRuleRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("waitlist rule is nil")
+	}
+
+	er, err := repo.Client.WaitlistRule.Create().
+		SetID(rule.ID).
+		SetMerchantID(rule.MerchantID).
+		SetStoreID(rule.StoreID).
+		SetOnlineEnabled(rule.OnlineEnabled).
+		SetSmallMaxSize(rule.SmallMaxSize).
+		SetMediumMaxSize(rule.MediumMaxSize).
+		SetMaxPartySize(rule.MaxPartySize).
+		SetDefaultTurnMinutes(rule.DefaultTurnMinutes).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create waitlist rule: %w", err)
+	}
+
+	rule.CreatedAt = er.CreatedAt
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *WaitlistRuleRepository) Update(ctx context.Context, rule *domain.WaitlistRule) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "WaitlistRuleRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if rule == nil {
+		return fmt.Errorf("waitlist rule is nil")
+	}
+
+	er, err := repo.Client.WaitlistRule.UpdateOneID(rule.ID).
+		SetOnlineEnabled(rule.OnlineEnabled).
+		SetSmallMaxSize(rule.SmallMaxSize).
+		SetMediumMaxSize(rule.MediumMaxSize).
+		SetMaxPartySize(rule.MaxPartySize).
+		SetDefaultTurnMinutes(rule.DefaultTurnMinutes).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrWaitlistRuleNotExists)
+		}
+		return fmt.Errorf("failed to update waitlist rule: %w", err)
+	}
+
+	rule.UpdatedAt = er.UpdatedAt
+	return nil
+}
+
+func (repo *WaitlistRuleRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "WaitlistRuleRepository.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = repo.Client.WaitlistRule.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrWaitlistRuleNotExists)
+		}
+		return fmt.Errorf("failed to delete waitlist rule: %w", err)
+	}
+	return nil
+}
+
+func (repo *WaitlistRuleRepository) FindByStore(ctx context.Context, merchantID, storeID uuid.UUID) (res *domain.WaitlistRule, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "WaitlistRuleRepository.FindByStore")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	er, err := repo.Client.WaitlistRule.Query().
+		Where(
+			waitlistrule.MerchantID(merchantID),
+			waitlistrule.StoreID(storeID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrWaitlistRuleNotExists)
+		}
+		return nil, fmt.Errorf("failed to find waitlist rule: %w", err)
+	}
+	return convertWaitlistRuleToDomain(er), nil
+}
+
+func convertWaitlistRuleToDomain(er *ent.WaitlistRule) *domain.WaitlistRule {
+	if er == nil {
+		return nil
+	}
+	return &domain.WaitlistRule{
+		StoreRuleMeta: domain.StoreRuleMeta{
+			ID:         er.ID,
+			MerchantID: er.MerchantID,
+			StoreID:    er.StoreID,
+			CreatedAt:  er.CreatedAt,
+			UpdatedAt:  er.UpdatedAt,
+		},
+		OnlineEnabled:      er.OnlineEnabled,
+		SmallMaxSize:       er.SmallMaxSize,
+		MediumMaxSize:      er.MediumMaxSize,
+		MaxPartySize:       er.MaxPartySize,
+		DefaultTurnMinutes: er.DefaultTurnMinutes,
+	}
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+)
+
+func TestWaitlistRuleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, &StoreRuleRepositoryTestSuite[*domain.WaitlistRule]{
+		newRepo: func(client *ent.Client) domain.StoreRuleRepository[*domain.WaitlistRule] {
+			return NewWaitlistRuleRepository(client)
+		},
+		newRule: domain.DefaultWaitlistRule,
+		update: func(rule *domain.WaitlistRule) {
+			rule.OnlineEnabled = true
+			rule.MediumMaxSize = 6
+			rule.DefaultTurnMinutes = 60
+		},
+		assertRule: func(t *testing.T, want, got *domain.WaitlistRule) {
+			require.Equal(t, want.OnlineEnabled, got.OnlineEnabled)
+			require.Equal(t, want.SmallMaxSize, got.SmallMaxSize)
+			require.Equal(t, want.MediumMaxSize, got.MediumMaxSize)
+			require.Equal(t, want.MaxPartySize, got.MaxPartySize)
+			require.Equal(t, want.DefaultTurnMinutes, got.DefaultTurnMinutes)
+		},
+	})
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/ent"
+	"gitlab.jiguang.dev/pos-dine/dine/ent/waitlistticket"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.WaitlistTicketRepository = (*WaitlistTicketRepository)(nil)
+
+type WaitlistTicketRepository struct {
+	Client *ent.Client
+}
+
+func NewWaitlistTicketRepository(client *ent.Client) *WaitlistTicketRepository {
+	return &WaitlistTicketRepository{
+		Client: client,
+	}
+}
+
+func (repo *WaitlistTicketRepository) Create(ctx context.Context, t *domain.WaitlistTicket) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "WaitlistTicketRepository.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if t == nil {
+		return fmt.Errorf("waitlist ticket is nil")
+	}
+
+	et, err := repo.Client.WaitlistTicket.Create().
+		SetID(t.ID).
+		SetMerchantID(t.MerchantID).
+		SetStoreID(t.StoreID).
+		SetBusinessDate(t.BusinessDate).
+		SetBucket(t.Bucket).
+		SetTicketNo(t.TicketNo).
+		SetPartySize(t.PartySize).
+		SetGuestPhone(t.GuestPhone).
+		SetSource(t.Source).
+		SetCustomerID(t.CustomerID).
+		SetStatus(t.Status).
+		SetCallCount(t.CallCount).
+		SetNillableCalledAt(t.CalledAt).
+		SetNillableSeatedAt(t.SeatedAt).
+		SetNillableCancelledAt(t.CancelledAt).
+		SetTableID(t.TableID).
+		SetTableName(t.TableName).
+		SetOrderID(t.OrderID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ConflictError(err)
+		}
+		return fmt.Errorf("failed to create waitlist ticket: %w", err)
+	}
+
+	t.CreatedAt = et.CreatedAt
+	t.UpdatedAt = et.UpdatedAt
+	return nil
+}
+
+func (repo *WaitlistTicketRepository) Update(ctx context.Context, t *domain.WaitlistTicket) (err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "WaitlistTicketRepository.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if t == nil {
+		return fmt.Errorf("waitlist ticket is nil")
+	}
+
+	et, err := repo.Client.WaitlistTicket.UpdateOneID(t.ID).
+		SetStatus(t.Status).
+		SetCallCount(t.CallCount).
+		SetNillableCalledAt(t.CalledAt).
+		SetNillableSeatedAt(t.SeatedAt).
+		SetNillableCancelledAt(t.CancelledAt).
+		SetTableID(t.TableID).
+		SetTableName(t.TableName).
+		SetOrderID(t.OrderID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.NotFoundError(domain.ErrWaitlistTicketNotExists)
+		}
+		return fmt.Errorf("failed to update waitlist ticket: %w", err)
+	}
+
+	t.UpdatedAt = et.UpdatedAt
+	return nil
+}
+
+func (repo *WaitlistTicketRepository) FindByID(ctx context.Context, id uuid.UUID) (res *domain.WaitlistTicket, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "WaitlistTicketRepository.FindByID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	et, err := repo.Client.WaitlistTicket.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.NotFoundError(domain.ErrWaitlistTicketNotExists)
+		}
+		return nil, fmt.Errorf("failed to find waitlist ticket: %w", err)
+	}
+	return convertWaitlistTicketToDomain(et), nil
+}
+
+func (repo *WaitlistTicketRepository) List(ctx context.Context, params domain.WaitlistTicketListParams) (res []*domain.WaitlistTicket, err error) {
+	span, ctx := util.StartSpan(ctx, "repository", "WaitlistTicketRepository.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	query := repo.Client.WaitlistTicket.Query()
+	if params.StoreID != uuid.Nil {
+		query.Where(waitlistticket.StoreID(params.StoreID))
+	}
+	if params.CustomerID != uuid.Nil {
+		query.Where(waitlistticket.CustomerID(params.CustomerID))
+	}
+	if params.BusinessDate != "" {
+		query.Where(waitlistticket.BusinessDate(params.BusinessDate))
+	}
+	if params.Bucket != "" {
+		query.Where(waitlistticket.BucketEQ(params.Bucket))
+	}
+	if len(params.Statuses) > 0 {
+		query.Where(waitlistticket.StatusIn(params.Statuses...))
+	}
+
+	tickets, err := query.Order(ent.Asc(waitlistticket.FieldCreatedAt), ent.Asc(waitlistticket.FieldTicketNo)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waitlist tickets: %w", err)
+	}
+	return lo.Map(tickets, func(item *ent.WaitlistTicket, _ int) *domain.WaitlistTicket {
+		return convertWaitlistTicketToDomain(item)
+	}), nil
+}
+
+func convertWaitlistTicketToDomain(et *ent.WaitlistTicket) *domain.WaitlistTicket {
+	if et == nil {
+		return nil
+	}
+	return &domain.WaitlistTicket{
+		ID:           et.ID,
+		MerchantID:   et.MerchantID,
+		StoreID:      et.StoreID,
+		BusinessDate: et.BusinessDate,
+		Bucket:       et.Bucket,
+		TicketNo:     et.TicketNo,
+		PartySize:    et.PartySize,
+		GuestPhone:   et.GuestPhone,
+		Source:       et.Source,
+		CustomerID:   et.CustomerID,
+		Status:       et.Status,
+		CallCount:    et.CallCount,
+		CalledAt:     et.CalledAt,
+		SeatedAt:     et.SeatedAt,
+		CancelledAt:  et.CancelledAt,
+		TableID:      et.TableID,
+		TableName:    et.TableName,
+		OrderID:      et.OrderID,
+		CreatedAt:    et.CreatedAt,
+		UpdatedAt:    et.UpdatedAt,
+	}
+}
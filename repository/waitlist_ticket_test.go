@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+type WaitlistTicketRepositoryTestSuite struct {
+	RepositoryTestSuite
+	repo *WaitlistTicketRepository
+	ctx  context.Context
+}
+
+func TestWaitlistTicketRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WaitlistTicketRepositoryTestSuite))
+}
+
+func (s *WaitlistTicketRepositoryTestSuite) SetupTest() {
+	s.RepositoryTestSuite.SetupTest()
+	s.repo = &WaitlistTicketRepository{Client: s.client}
+	s.ctx = context.Background()
+}
+
+func (s *WaitlistTicketRepositoryTestSuite) TestWaitlistTicket_Flow() {
+	merchantID := uuid.New()
+	storeID := uuid.New()
+	customerID := uuid.New()
+	newTicket := func(bucket domain.WaitlistBucket, no int64, businessDate string, customerID uuid.UUID) *domain.WaitlistTicket {
+		t := &domain.WaitlistTicket{
+			ID:           uuid.New(),
+			MerchantID:   merchantID,
+			StoreID:      storeID,
+			BusinessDate: businessDate,
+			Bucket:       bucket,
+			TicketNo:     bucket.FormatTicketNo(no),
+			PartySize:    2,
+			Source:       domain.WaitlistTicketSourceStore,
+			CustomerID:   customerID,
+			Status:       domain.WaitlistTicketStatusWaiting,
+		}
+		if customerID != uuid.Nil {
+			t.Source = domain.WaitlistTicketSourceOnline
+		}
+		require.NoError(s.T(), s.repo.Create(s.ctx, t))
+		return t
+	}
+	a1 := newTicket(domain.WaitlistBucketSmall, 1, "2026-10-27", uuid.Nil)
+	a2 := newTicket(domain.WaitlistBucketSmall, 2, "2026-10-27", customerID)
+	b1 := newTicket(domain.WaitlistBucketMedium, 1, "2026-10-27", uuid.Nil)
+	newTicket(domain.WaitlistBucketSmall, 1, "2026-10-26", customerID)
+
+	s.T().Run("按营业日和分组查询", func(t *testing.T) {
+		got, err := s.repo.List(s.ctx, domain.WaitlistTicketListParams{
+			StoreID:      storeID,
+			BusinessDate: "2026-10-27",
+			Bucket:       domain.WaitlistBucketSmall,
+		})
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		require.Equal(t, "A001", got[0].TicketNo)
+		require.Equal(t, "A002", got[1].TicketNo)
+
+		got, err = s.repo.List(s.ctx, domain.WaitlistTicketListParams{StoreID: storeID, BusinessDate: "2026-10-27"})
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+	})
+
+	s.T().Run("按顾客查询", func(t *testing.T) {
+		got, err := s.repo.List(s.ctx, domain.WaitlistTicketListParams{CustomerID: customerID, BusinessDate: "2026-10-27"})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Equal(t, a2.ID, got[0].ID)
+		require.Equal(t, domain.WaitlistTicketSourceOnline, got[0].Source)
+	})
+
+	s.T().Run("叫号、入座与过号", func(t *testing.T) {
+		now := time.Now().Truncate(time.Second)
+		require.NoError(t, a1.Call(now))
+		require.NoError(t, a1.Call(now))
+		require.NoError(t, s.repo.Update(s.ctx, a1))
+
+		table := &domain.DiningTable{ID: uuid.New(), Name: "A1"}
+		orderID := uuid.New()
+		require.NoError(t, a1.Seat(table, orderID, now))
+		require.NoError(t, s.repo.Update(s.ctx, a1))
+		require.NoError(t, b1.Skip(now))
+		require.NoError(t, s.repo.Update(s.ctx, b1))
+
+		got, err := s.repo.FindByID(s.ctx, a1.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.WaitlistTicketStatusSeated, got.Status)
+		require.Equal(t, 2, got.CallCount)
+		require.NotNil(t, got.CalledAt)
+		require.NotNil(t, got.SeatedAt)
+		require.Equal(t, table.ID, got.TableID)
+		require.Equal(t, "A1", got.TableName)
+		require.Equal(t, orderID, got.OrderID)
+
+		active, err := s.repo.List(s.ctx, domain.WaitlistTicketListParams{
+			StoreID:      storeID,
+			BusinessDate: "2026-10-27",
+			Statuses:     domain.WaitlistActiveStatuses,
+		})
+		require.NoError(t, err)
+		require.Len(t, active, 1)
+		require.Equal(t, a2.ID, active[0].ID)
+	})
+
+	s.T().Run("不存在", func(t *testing.T) {
+		_, err := s.repo.FindByID(s.ctx, uuid.New())
+		require.True(t, domain.IsNotFound(err))
+		require.True(t, domain.IsNotFound(s.repo.Update(s.ctx, &domain.WaitlistTicket{ID: uuid.New(), Status: domain.WaitlistTicketStatusWaiting})))
+	})
+}
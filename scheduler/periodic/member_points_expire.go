@@ -0,0 +1,37 @@
+package periodic
+
+import (
+	"time"
+
+	"github.com/hibiken/asynq"
+	"gitlab.jiguang.dev/pos-dine/dine/scheduler/task"
+)
+
+type MemberPointsExpireConfig struct {
+	baseConfig
+}
+
+type MemberPointsExpire struct {
+	config MemberPointsExpireConfig
+}
+
+func NewMemberPointsExpire(config MemberPointsExpireConfig) *MemberPointsExpire {
+	return &MemberPointsExpire{config: config}
+}
+
+func (p *MemberPointsExpire) Register(scheduler *asynq.Scheduler) (err error) {
+	opts := []asynq.Option{
+		asynq.TaskID(task.TaskTypeMemberPointsExpire),
+	}
+
+	if p.config.Timeout > 0 {
+		opts = append(opts, asynq.Timeout(time.Duration(p.config.Timeout)*time.Second))
+	}
+
+	_, err = scheduler.Register(
+		p.config.Cron,
+		asynq.NewTask(task.TaskTypeMemberPointsExpire, nil),
+		opts...,
+	)
+	return
+}
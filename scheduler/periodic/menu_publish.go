@@ -0,0 +1,37 @@
+package periodic
+
+import (
+	"time"
+
+	"github.com/hibiken/asynq"
+	"gitlab.jiguang.dev/pos-dine/dine/scheduler/task"
+)
+
+type MenuPublishConfig struct {
+	baseConfig
+}
+
+type MenuPublish struct {
+	config MenuPublishConfig
+}
+
+func NewMenuPublish(config MenuPublishConfig) *MenuPublish {
+	return &MenuPublish{config: config}
+}
+
+func (p *MenuPublish) Register(scheduler *asynq.Scheduler) (err error) {
+	opts := []asynq.Option{
+		asynq.TaskID(task.TaskTypeMenuPublish),
+	}
+
+	if p.config.Timeout > 0 {
+		opts = append(opts, asynq.Timeout(time.Duration(p.config.Timeout)*time.Second))
+	}
+
+	_, err = scheduler.Register(
+		p.config.Cron,
+		asynq.NewTask(task.TaskTypeMenuPublish, nil),
+		opts...,
+	)
+	return
+}
@@ -0,0 +1,37 @@
+package periodic
+
+import (
+	"time"
+
+	"github.com/hibiken/asynq"
+	"gitlab.jiguang.dev/pos-dine/dine/scheduler/task"
+)
+
+type PriceChangeConfig struct {
+	baseConfig
+}
+
+type PriceChange struct {
+	config PriceChangeConfig
+}
+
+func NewPriceChange(config PriceChangeConfig) *PriceChange {
+	return &PriceChange{config: config}
+}
+
+func (p *PriceChange) Register(scheduler *asynq.Scheduler) (err error) {
+	opts := []asynq.Option{
+		asynq.TaskID(task.TaskTypePriceChange),
+	}
+
+	if p.config.Timeout > 0 {
+		opts = append(opts, asynq.Timeout(time.Duration(p.config.Timeout)*time.Second))
+	}
+
+	_, err = scheduler.Register(
+		p.config.Cron,
+		asynq.NewTask(task.TaskTypePriceChange, nil),
+		opts...,
+	)
+	return
+}
@@ -0,0 +1,37 @@
+package periodic
+
+import (
+	"time"
+
+	"github.com/hibiken/asynq"
+	"gitlab.jiguang.dev/pos-dine/dine/scheduler/task"
+)
+
+type ProductPinyinBackfillConfig struct {
+	baseConfig
+}
+
+type ProductPinyinBackfill struct {
+	config ProductPinyinBackfillConfig
+}
+
+func NewProductPinyinBackfill(config ProductPinyinBackfillConfig) *ProductPinyinBackfill {
+	return &ProductPinyinBackfill{config: config}
+}
+
+func (p *ProductPinyinBackfill) Register(scheduler *asynq.Scheduler) (err error) {
+	opts := []asynq.Option{
+		asynq.TaskID(task.TaskTypeProductPinyinBackfill),
+	}
+
+	if p.config.Timeout > 0 {
+		opts = append(opts, asynq.Timeout(time.Duration(p.config.Timeout)*time.Second))
+	}
+
+	_, err = scheduler.Register(
+		p.config.Cron,
+		asynq.NewTask(task.TaskTypeProductPinyinBackfill, nil),
+		opts...,
+	)
+	return
+}
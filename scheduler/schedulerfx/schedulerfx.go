@@ -12,10 +12,19 @@ var Module = fx.Module(
 	// provide handlers
 	fx.Provide(
 		asHandler(task.NewProfitDistributionTask),
+		asHandler(task.NewPriceChangeTask),
+		asHandler(task.NewMenuPublishTask),
+		asHandler(task.NewMemberPointsExpireTask),
+		asHandler(task.NewPreorderReleaseTask),
+		asHandler(task.NewProductPinyinBackfillTask),
 	),
 	// provide periodic tasks
 	fx.Provide(
 		asPeriodic(periodic.NewProfitDistribution),
+		asPeriodic(periodic.NewPriceChange),
+		asPeriodic(periodic.NewMenuPublish),
+		asPeriodic(periodic.NewMemberPointsExpire),
+		asPeriodic(periodic.NewProductPinyinBackfill),
 	),
 )
 
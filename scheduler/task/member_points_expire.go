@@ -0,0 +1,49 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/alert"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+const (
+	TaskTypeMemberPointsExpire = "member_points_expire"
+)
+
+type MemberPointsExpireTask struct {
+	MemberPointsInteractor domain.MemberPointsInteractor
+	Alert                  alert.Alert
+}
+
+func NewMemberPointsExpireTask(interactor domain.MemberPointsInteractor, alert alert.Alert) *MemberPointsExpireTask {
+	return &MemberPointsExpireTask{
+		MemberPointsInteractor: interactor,
+		Alert:                  alert,
+	}
+}
+
+func (task *MemberPointsExpireTask) Type() string {
+	return TaskTypeMemberPointsExpire
+}
+
+// 定时清理已到过期时间的会员积分
+func (task *MemberPointsExpireTask) ProcessTask(ctx context.Context, t *asynq.Task) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberPointsExpireTask.ProcessTask")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+	logger := logging.FromContext(ctx).Named("MemberPointsExpireTask.ProcessTask")
+	ctx = logging.NewContext(ctx, logger)
+
+	err = task.MemberPointsInteractor.ExpireDue(ctx)
+	if err != nil {
+		logger.Errorf("会员积分过期处理失败: %v", err)
+		task.Alert.Notify(ctx, fmt.Sprintf("会员积分过期处理失败: %v", err))
+	}
+	return
+}
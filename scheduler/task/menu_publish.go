@@ -0,0 +1,49 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/alert"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+const (
+	TaskTypeMenuPublish = "menu_publish"
+)
+
+type MenuPublishTask struct {
+	MenuInteractor domain.MenuInteractor
+	Alert          alert.Alert
+}
+
+func NewMenuPublishTask(interactor domain.MenuInteractor, alert alert.Alert) *MenuPublishTask {
+	return &MenuPublishTask{
+		MenuInteractor: interactor,
+		Alert:          alert,
+	}
+}
+
+func (task *MenuPublishTask) Type() string {
+	return TaskTypeMenuPublish
+}
+
+// 定时发布已到发布时间的菜单草稿
+func (task *MenuPublishTask) ProcessTask(ctx context.Context, t *asynq.Task) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MenuPublishTask.ProcessTask")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+	logger := logging.FromContext(ctx).Named("MenuPublishTask.ProcessTask")
+	ctx = logging.NewContext(ctx, logger)
+
+	err = task.MenuInteractor.PublishDueDrafts(ctx)
+	if err != nil {
+		logger.Errorf("菜单定时发布失败: %v", err)
+		task.Alert.Notify(ctx, fmt.Sprintf("菜单定时发布失败: %v", err))
+	}
+	return
+}
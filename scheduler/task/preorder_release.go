@@ -0,0 +1,67 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/alert"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+const (
+	TaskTypePreorderRelease = "preorder_release"
+)
+
+// PreorderReleasePayload 预点单送厨任务参数
+type PreorderReleasePayload struct {
+	PreorderID uuid.UUID `json:"preorder_id"`
+}
+
+// NewPreorderReleaseTaskID 预点单送厨任务ID，同一预点单只创建一个任务
+func NewPreorderReleaseTaskID(preorderID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", TaskTypePreorderRelease, preorderID)
+}
+
+type PreorderReleaseTask struct {
+	PreorderInteractor domain.PreorderInteractor
+	Alert              alert.Alert
+}
+
+func NewPreorderReleaseTask(interactor domain.PreorderInteractor, alert alert.Alert) *PreorderReleaseTask {
+	return &PreorderReleaseTask{
+		PreorderInteractor: interactor,
+		Alert:              alert,
+	}
+}
+
+func (task *PreorderReleaseTask) Type() string {
+	return TaskTypePreorderRelease
+}
+
+// 到达计划送厨时间后将预点单送厨，失败时由 asynq 重试
+func (task *PreorderReleaseTask) ProcessTask(ctx context.Context, t *asynq.Task) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderReleaseTask.ProcessTask")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+	logger := logging.FromContext(ctx).Named("PreorderReleaseTask.ProcessTask")
+	ctx = logging.NewContext(ctx, logger)
+
+	var payload PreorderReleasePayload
+	if err = json.Unmarshal(t.Payload(), &payload); err != nil {
+		logger.Errorf("预点单送厨任务参数无效: %v", err)
+		return fmt.Errorf("invalid preorder release payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	err = task.PreorderInteractor.ReleaseDue(ctx, payload.PreorderID)
+	if err != nil {
+		logger.Errorf("预点单[%s]送厨失败: %v", payload.PreorderID, err)
+		task.Alert.Notify(ctx, fmt.Sprintf("预点单[%s]送厨失败: %v", payload.PreorderID, err))
+	}
+	return
+}
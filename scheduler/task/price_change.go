@@ -0,0 +1,49 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/alert"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+const (
+	TaskTypePriceChange = "price_change"
+)
+
+type PriceChangeTask struct {
+	PriceChangeInteractor domain.PriceChangeInteractor
+	Alert                 alert.Alert
+}
+
+func NewPriceChangeTask(interactor domain.PriceChangeInteractor, alert alert.Alert) *PriceChangeTask {
+	return &PriceChangeTask{
+		PriceChangeInteractor: interactor,
+		Alert:                 alert,
+	}
+}
+
+func (task *PriceChangeTask) Type() string {
+	return TaskTypePriceChange
+}
+
+// 定时执行已到生效时间的调价批次
+func (task *PriceChangeTask) ProcessTask(ctx context.Context, t *asynq.Task) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PriceChangeTask.ProcessTask")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+	logger := logging.FromContext(ctx).Named("PriceChangeTask.ProcessTask")
+	ctx = logging.NewContext(ctx, logger)
+
+	err = task.PriceChangeInteractor.ApplyDueBatches(ctx)
+	if err != nil {
+		logger.Errorf("执行定时调价失败: %v", err)
+		task.Alert.Notify(ctx, fmt.Sprintf("执行定时调价失败: %v", err))
+	}
+	return
+}
@@ -0,0 +1,53 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/alert"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+const (
+	TaskTypeProductPinyinBackfill = "product_pinyin_backfill"
+)
+
+type ProductPinyinBackfillTask struct {
+	ProductInteractor domain.ProductInteractor
+	Alert             alert.Alert
+}
+
+func NewProductPinyinBackfillTask(interactor domain.ProductInteractor, alert alert.Alert) *ProductPinyinBackfillTask {
+	return &ProductPinyinBackfillTask{
+		ProductInteractor: interactor,
+		Alert:             alert,
+	}
+}
+
+func (task *ProductPinyinBackfillTask) Type() string {
+	return TaskTypeProductPinyinBackfill
+}
+
+// 为尚未生成名称拼音的历史商品补全拼音，补全完成后再次执行无需处理
+func (task *ProductPinyinBackfillTask) ProcessTask(ctx context.Context, t *asynq.Task) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ProductPinyinBackfillTask.ProcessTask")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+	logger := logging.FromContext(ctx).Named("ProductPinyinBackfillTask.ProcessTask")
+	ctx = logging.NewContext(ctx, logger)
+
+	filled, err := task.ProductInteractor.BackfillPinyin(ctx)
+	if err != nil {
+		logger.Errorf("商品拼音补全失败: %v", err)
+		task.Alert.Notify(ctx, fmt.Sprintf("商品拼音补全失败: %v", err))
+		return
+	}
+	if filled > 0 {
+		logger.Infof("商品拼音补全完成，共补全 %d 个商品", filled)
+	}
+	return
+}
@@ -11,12 +11,14 @@ import (
 var _ domain.BusinessConfigInteractor = (*BusinessConfigInteractor)(nil)
 
 type BusinessConfigInteractor struct {
-	DS domain.DataStore
+	DS     domain.DataStore
+	Pusher domain.Pusher
 }
 
-func NewBusinessConfigInteractor(ds domain.DataStore) *BusinessConfigInteractor {
+func NewBusinessConfigInteractor(ds domain.DataStore, pusher domain.Pusher) *BusinessConfigInteractor {
 	return &BusinessConfigInteractor{
-		DS: ds,
+		DS:     ds,
+		Pusher: pusher,
 	}
 }
 
@@ -38,9 +40,31 @@ func (i *BusinessConfigInteractor) UpsertConfig(ctx context.Context, configs []*
 		util.SpanErrFinish(span, err)
 	}()
 
-	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
 		return ds.BusinessConfigRepo().UpsertConfig(ctx, configs)
 	})
+	if err != nil {
+		return err
+	}
+
+	i.pushConfigUpdated(ctx, configs)
+	return nil
+}
+
+// pushConfigUpdated 按门店（或品牌商）汇总变更的参数键名并通知客户端
+func (i *BusinessConfigInteractor) pushConfigUpdated(ctx context.Context, configs []*domain.BusinessConfig) {
+	keys := make(map[domain.PushTopic][]string)
+	topics := make([]domain.PushTopic, 0)
+	for _, config := range configs {
+		topic := domain.ScopedPushTopic(config.MerchantID, config.StoreID)
+		if _, ok := keys[topic]; !ok {
+			topics = append(topics, topic)
+		}
+		keys[topic] = append(keys[topic], config.Key)
+	}
+	for _, topic := range topics {
+		i.Pusher.Push(ctx, domain.PushEventConfigUpdated, &domain.ConfigUpdatedPush{Keys: keys[topic]}, topic)
+	}
 }
 func (i *BusinessConfigInteractor) Distribute(ctx context.Context, params domain.BusinessConfigDistributeParams, user domain.User) (err error) {
 	span, ctx := util.StartSpan(ctx, "usecase", "BusinessConfigInteractor.UpsertConfig")
@@ -49,6 +49,7 @@ func (i *CategoryInteractor) Update(ctx context.Context, category *domain.Catego
 
 		// 3. 将请求数据合并到 existingCategory
 		existingCategory.Name = category.Name
+		existingCategory.NameI18n = category.NameI18n
 		existingCategory.InheritTaxRate = category.InheritTaxRate
 		existingCategory.InheritStall = category.InheritStall
 		existingCategory.TaxRateID = category.TaxRateID
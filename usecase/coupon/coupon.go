@@ -0,0 +1,421 @@
+package coupon
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.CouponInteractor = (*CouponInteractor)(nil)
+
+type CouponInteractor struct {
+	DS           domain.DataStore
+	MutexManager domain.MutexManager
+	Verifiers    domain.PartnerCouponVerifierRegistry
+}
+
+func NewCouponInteractor(
+	ds domain.DataStore,
+	mutexManager domain.MutexManager,
+	verifiers domain.PartnerCouponVerifierRegistry,
+) *CouponInteractor {
+	return &CouponInteractor{
+		DS:           ds,
+		MutexManager: mutexManager,
+		Verifiers:    verifiers,
+	}
+}
+
+func (i *CouponInteractor) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.CouponSearchParams,
+) (res *domain.CouponSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponInteractor.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.CouponRepo().PagedListBySearch(ctx, page, params)
+}
+
+// Invalidate 作废未使用的券
+func (i *CouponInteractor) Invalidate(ctx context.Context, id uuid.UUID, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponInteractor.Invalidate")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		c, err := ds.CouponRepo().FindByID(ctx, id)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return domain.ParamsError(domain.ErrCouponNotExists)
+			}
+			return err
+		}
+		if c.MerchantID != user.GetMerchantID() || c.Source != domain.CouponSourceSystem {
+			return domain.ParamsError(domain.ErrCouponNotExists)
+		}
+		// 门店只能作废本门店模板发放的券
+		if _, err = findTemplate(ctx, ds, c.TemplateID, user, false); err != nil {
+			return err
+		}
+		switch c.Status {
+		case domain.CouponStatusUsed:
+			return domain.ParamsError(domain.ErrCouponUsed)
+		case domain.CouponStatusInvalidated:
+			return nil
+		}
+		c.Status = domain.CouponStatusInvalidated
+		return ds.CouponRepo().Update(ctx, c)
+	})
+}
+
+// Verify 校验券码并试算优惠金额
+func (i *CouponInteractor) Verify(ctx context.Context, params domain.CouponRedeemParams) (res *domain.CouponRedeemRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponInteractor.Verify")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	c, _, discount, err := prepareRedeem(ctx, i.DS, params, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &domain.CouponRedeemRes{Coupon: c, DiscountAmount: discount.Amount}, nil
+}
+
+// Redeem 核销券码，同一券码加分布式锁，防止并发重复核销；同一订单加订单锁，多张券依次按剩余应收计算优惠
+func (i *CouponInteractor) Redeem(ctx context.Context, params domain.CouponRedeemParams) (res *domain.CouponRedeemRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponInteractor.Redeem")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	unlock, err := i.lock(ctx, params.MerchantID, "", params.Code)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	unlockOrder, err := i.lockOrder(ctx, params.OrderID, params.MerchantID, params.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockOrder()
+
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		now := time.Now()
+		c, order, discount, err := prepareRedeem(ctx, ds, params, now)
+		if err != nil {
+			return err
+		}
+
+		c.MarkUsed(order, discount.Amount, now)
+		if err = ds.CouponRepo().Update(ctx, c); err != nil {
+			return err
+		}
+		if err = applyOrderCoupon(ctx, ds, order, params.Operator, c, discount, now); err != nil {
+			return err
+		}
+		res = &domain.CouponRedeemRes{Coupon: c, DiscountAmount: discount.Amount}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// VerifyPartner 通过三方平台校验三方券
+func (i *CouponInteractor) VerifyPartner(ctx context.Context, params domain.PartnerCouponRedeemParams) (res *domain.PartnerCoupon, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponInteractor.VerifyPartner")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	verifier, err := i.Verifiers.Get(params.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = findPartnerCoupon(ctx, i.DS, params); err != nil {
+		return nil, err
+	}
+	return verifier.Verify(ctx, params.StoreID, params.Code)
+}
+
+// RedeemPartner 在三方平台核销三方券
+//
+// 先提交三方核销中的本地记录（占用券码），再调用三方平台核销，避免在数据库事务内等待外部接口；
+// 三方核销成功后将记录标记为已使用，失败时标记为核销失败，券码可重新核销。
+func (i *CouponInteractor) RedeemPartner(ctx context.Context, params domain.PartnerCouponRedeemParams) (res *domain.Coupon, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponInteractor.RedeemPartner")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+	logger := logging.FromContext(ctx).Named("CouponInteractor.RedeemPartner")
+
+	verifier, err := i.Verifiers.Get(params.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := i.lock(ctx, params.MerchantID, params.Provider, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	unlockOrder, err := i.lockOrder(ctx, params.OrderID, params.MerchantID, params.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockOrder()
+
+	c, err := findPartnerCoupon(ctx, i.DS, params)
+	if err != nil {
+		return nil, err
+	}
+	pc, err := verifier.Verify(ctx, params.StoreID, params.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 提交三方核销中的本地记录
+	var order *domain.Order
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		o, err := findRedeemOrder(ctx, ds, params.OrderID, params.MerchantID, params.StoreID)
+		if err != nil {
+			return err
+		}
+		order = o
+		if !order.Amount.AmountDue.IsPositive() {
+			return domain.ParamsError(domain.ErrCouponOrderFullyDiscounted)
+		}
+
+		discount := decimal.Min(pc.Amount, order.Amount.AmountDue)
+		if c != nil {
+			// 核销失败的记录重新核销
+			c.MarkRedeeming(order, discount, time.Now())
+			return ds.CouponRepo().Update(ctx, c)
+		}
+		c = &domain.Coupon{
+			ID:         uuid.New(),
+			MerchantID: params.MerchantID,
+			Source:     domain.CouponSourcePartner,
+			Provider:   pc.Provider,
+			Code:       pc.Code,
+			Name:       pc.Name,
+			Type:       domain.CouponTypeAmountOff,
+			ValidFrom:  pc.ValidFrom,
+			ValidTo:    pc.ValidTo,
+		}
+		c.MarkRedeeming(order, discount, time.Now())
+		if err = ds.CouponRepo().CreateBulk(ctx, domain.Coupons{c}); err != nil {
+			if domain.IsConflict(err) {
+				return domain.ParamsError(domain.ErrPartnerCouponRedeemed)
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 调用三方平台核销，失败时将本地记录标记为核销失败
+	if err = verifier.Redeem(ctx, params.StoreID, params.Code, order.OrderNo); err != nil {
+		c.MarkRedeemFailed()
+		if markErr := i.DS.CouponRepo().Update(ctx, c); markErr != nil {
+			logger.Errorf("三方券 %s 标记核销失败失败: %v", params.Code, markErr)
+		}
+		return nil, err
+	}
+
+	// 3. 三方核销成功，标记已使用，优惠计入订单并记录订单日志
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		now := time.Now()
+		order, err := ds.OrderRepo().FindByID(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		discount := &domain.CouponDiscount{
+			Amount: decimal.Max(decimal.Min(c.DiscountAmount, order.Amount.AmountDue), decimal.Zero),
+			Line:   -1,
+		}
+		c.MarkUsed(order, discount.Amount, now)
+		if err := ds.CouponRepo().Update(ctx, c); err != nil {
+			return err
+		}
+		return applyOrderCoupon(ctx, ds, order, params.Operator, c, discount, now)
+	})
+	if err != nil {
+		// 三方平台已核销，本地记录保持核销中，需人工核对
+		logger.Errorf("三方券 %s 已在三方平台核销，本地标记已使用失败: %v", params.Code, err)
+		return nil, err
+	}
+	return c, nil
+}
+
+func (i *CouponInteractor) ListByOrderID(ctx context.Context, orderID uuid.UUID) (res domain.Coupons, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponInteractor.ListByOrderID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.CouponRepo().ListByOrderID(ctx, orderID)
+}
+
+// RestoreByOrder 订单全额退款后恢复订单使用的系统券，三方券不恢复
+func RestoreByOrder(ctx context.Context, ds domain.DataStore, orderID uuid.UUID) error {
+	coupons, err := ds.CouponRepo().ListByOrderID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	for _, c := range coupons {
+		if c.Source != domain.CouponSourceSystem || c.Status != domain.CouponStatusUsed {
+			continue
+		}
+		c.Restore()
+		if err = ds.CouponRepo().Update(ctx, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *CouponInteractor) lock(ctx context.Context, merchantID uuid.UUID, provider, code string) (func(), error) {
+	mu := i.MutexManager.NewMutex(domain.NewMutexCouponKey(merchantID, provider, code))
+	if err := mu.Lock(ctx); err != nil {
+		if domain.IsAlreadyTakenError(err) {
+			return nil, domain.ParamsError(domain.ErrCouponRedeemBusy)
+		}
+		return nil, err
+	}
+	return func() {
+		_, _ = mu.Unlock(ctx)
+	}, nil
+}
+
+// lockOrder 加订单锁，同一订单的券核销与积分抵扣、储值支付依次进行，避免按同一应收金额重复计算优惠
+func (i *CouponInteractor) lockOrder(ctx context.Context, orderID, merchantID, storeID uuid.UUID) (func(), error) {
+	order, err := findRedeemOrder(ctx, i.DS, orderID, merchantID, storeID)
+	if err != nil {
+		return nil, err
+	}
+	mu := i.MutexManager.NewMutex(domain.NewMutexOrderKey(order.OrderNo))
+	if err := mu.Lock(ctx); err != nil {
+		if domain.IsAlreadyTakenError(err) {
+			return nil, domain.ParamsError(domain.ErrCouponRedeemBusy)
+		}
+		return nil, err
+	}
+	return func() {
+		_, _ = mu.Unlock(ctx)
+	}, nil
+}
+
+// prepareRedeem 查询券和订单，校验可用性并计算优惠金额
+func prepareRedeem(
+	ctx context.Context,
+	ds domain.DataStore,
+	params domain.CouponRedeemParams,
+	now time.Time,
+) (*domain.Coupon, *domain.Order, *domain.CouponDiscount, error) {
+	c, err := ds.CouponRepo().FindByCode(ctx, params.MerchantID, "", params.Code)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, nil, nil, domain.ParamsError(domain.ErrCouponNotExists)
+		}
+		return nil, nil, nil, err
+	}
+	if err = c.CheckUsable(now); err != nil {
+		return nil, nil, nil, domain.ParamsError(err)
+	}
+
+	template, err := ds.CouponTemplateRepo().FindByID(ctx, c.TemplateID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, nil, nil, domain.ParamsError(domain.ErrCouponTemplateNotExists)
+		}
+		return nil, nil, nil, err
+	}
+	if !template.Applicable(params.StoreID) {
+		return nil, nil, nil, domain.ParamsError(domain.ErrCouponStoreNotApplicable)
+	}
+
+	order, err := findRedeemOrder(ctx, ds, params.OrderID, params.MerchantID, params.StoreID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	discount, err := template.CalculateDiscount(order)
+	if err != nil {
+		return nil, nil, nil, domain.ParamsError(err)
+	}
+	return c, order, discount, nil
+}
+
+// findRedeemOrder 查询待核销订单，已支付订单不能再使用券
+func findRedeemOrder(ctx context.Context, ds domain.DataStore, orderID, merchantID, storeID uuid.UUID) (*domain.Order, error) {
+	order, err := ds.OrderRepo().FindByID(ctx, orderID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrCouponOrderNotExists)
+		}
+		return nil, err
+	}
+	if order.MerchantID != merchantID || order.StoreID != storeID {
+		return nil, domain.ParamsError(domain.ErrCouponOrderNotExists)
+	}
+	switch order.PaymentStatus {
+	case domain.PaymentStatusPaid, domain.PaymentStatusRefunded:
+		return nil, domain.ParamsError(domain.ErrCouponOrderPaid)
+	}
+	return order, nil
+}
+
+// findPartnerCoupon 查询三方券的本地核销记录，未核销时返回 nil，核销失败的记录返回用于重新核销
+func findPartnerCoupon(ctx context.Context, ds domain.DataStore, params domain.PartnerCouponRedeemParams) (*domain.Coupon, error) {
+	c, err := ds.CouponRepo().FindByCode(ctx, params.MerchantID, params.Provider, params.Code)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	switch c.Status {
+	case domain.CouponStatusRedeemFailed:
+		return c, nil
+	case domain.CouponStatusRedeeming:
+		return nil, domain.ParamsError(domain.ErrCouponRedeemBusy)
+	}
+	return nil, domain.ParamsError(domain.ErrPartnerCouponRedeemed)
+}
+
+// applyOrderCoupon 券优惠计入订单金额并记录订单日志，兑换券同时更新兑换商品行的优惠金额
+func applyOrderCoupon(
+	ctx context.Context,
+	ds domain.DataStore,
+	order *domain.Order,
+	operator domain.OrderOperator,
+	c *domain.Coupon,
+	discount *domain.CouponDiscount,
+	now time.Time,
+) error {
+	order.ApplyCoupon(discount)
+	order.AddCouponLog(operator, domain.CouponContent{
+		CouponID:   c.ID,
+		CouponName: c.Name,
+		Amount:     c.DiscountAmount,
+	}, now)
+	// 未调整商品行时不改写订单商品
+	if discount.Line < 0 {
+		order.OrderProducts = nil
+	}
+	return ds.OrderRepo().Update(ctx, order)
+}
@@ -0,0 +1,157 @@
+package coupon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/domain/mock"
+)
+
+// noopMutexManager 测试用的分布式锁，加锁总是成功
+type noopMutexManager struct{}
+
+func (noopMutexManager) NewMutex(string, ...domain.MutexOption) domain.Mutex { return noopMutex{} }
+
+type noopMutex struct{}
+
+func (noopMutex) Lock(context.Context) error           { return nil }
+func (noopMutex) Unlock(context.Context) (bool, error) { return true, nil }
+func (noopMutex) TryLock(context.Context) error        { return nil }
+
+func TestCouponInteractorRedeemTwoCoupons(t *testing.T) {
+	dec := decimal.RequireFromString
+	merchantID := uuid.New()
+	storeID := uuid.New()
+	productA, productB := uuid.New(), uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		templates    [2]domain.CouponTemplate
+		wantDiscount [2]string
+		wantErr      error // 第二张券的错误
+		wantDue      string
+		wantLineDisc string // 商品 A 行优惠金额
+	}{
+		{
+			name: "两张代金券优惠不超过订单应收",
+			templates: [2]domain.CouponTemplate{
+				{Type: domain.CouponTypeAmountOff, Value: dec("25")},
+				{Type: domain.CouponTypeAmountOff, Value: dec("25")},
+			},
+			wantDiscount: [2]string{"25", "5"},
+			wantDue:      "0",
+			wantLineDisc: "0",
+		},
+		{
+			name: "折扣券按扣除前一张券后的应收计算",
+			templates: [2]domain.CouponTemplate{
+				{Type: domain.CouponTypeAmountOff, Value: dec("10")},
+				{Type: domain.CouponTypeDiscount, Value: dec("50")},
+			},
+			wantDiscount: [2]string{"10", "10"},
+			wantDue:      "10",
+			wantLineDisc: "0",
+		},
+		{
+			name: "兑换券不能重复兑换同一份商品",
+			templates: [2]domain.CouponTemplate{
+				{Type: domain.CouponTypeFreeItem, ProductID: productA},
+				{Type: domain.CouponTypeFreeItem, ProductID: productA},
+			},
+			wantDiscount: [2]string{"20", ""},
+			wantErr:      domain.ErrCouponProductNotInOrder,
+			wantDue:      "10",
+			wantLineDisc: "20",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ds := mock.NewMockDataStore(ctrl)
+			orderRepo := mock.NewMockOrderRepository(ctrl)
+			couponRepo := mock.NewMockCouponRepository(ctrl)
+			templateRepo := mock.NewMockCouponTemplateRepository(ctrl)
+			ds.EXPECT().OrderRepo().Return(orderRepo).AnyTimes()
+			ds.EXPECT().CouponRepo().Return(couponRepo).AnyTimes()
+			ds.EXPECT().CouponTemplateRepo().Return(templateRepo).AnyTimes()
+			ds.EXPECT().Atomic(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context, ds domain.DataStore) error) error {
+					return fn(ctx, ds)
+				}).AnyTimes()
+
+			// 订单：商品 A 20 元、商品 B 10 元，应收 30 元
+			saved := domain.Order{
+				ID:            uuid.New(),
+				MerchantID:    merchantID,
+				StoreID:       storeID,
+				OrderNo:       "O0001",
+				PaymentStatus: domain.PaymentStatusUnpaid,
+				OrderProducts: []domain.OrderProduct{
+					{ProductID: productA, Price: dec("20"), Qty: 1, Subtotal: dec("20")},
+					{ProductID: productB, Price: dec("10"), Qty: 1, Subtotal: dec("10")},
+				},
+				Amount: domain.OrderAmount{ItemsSubtotal: dec("30"), AmountDue: dec("30")},
+			}
+			orderRepo.EXPECT().FindByID(gomock.Any(), saved.ID).DoAndReturn(func(context.Context, uuid.UUID) (*domain.Order, error) {
+				o := saved
+				o.OrderProducts = append([]domain.OrderProduct(nil), saved.OrderProducts...)
+				return &o, nil
+			}).AnyTimes()
+			orderRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o *domain.Order) error {
+				saved.Amount = o.Amount
+				saved.OperationLogs = o.OperationLogs
+				if len(o.OrderProducts) > 0 {
+					saved.OrderProducts = append([]domain.OrderProduct(nil), o.OrderProducts...)
+				}
+				return nil
+			}).AnyTimes()
+
+			interactor := NewCouponInteractor(ds, noopMutexManager{}, nil)
+			for k, tmpl := range tt.templates {
+				template := tmpl
+				template.ID = uuid.New()
+				template.MerchantID = merchantID
+				c := &domain.Coupon{
+					ID:         uuid.New(),
+					MerchantID: merchantID,
+					TemplateID: template.ID,
+					Source:     domain.CouponSourceSystem,
+					Code:       uuid.NewString(),
+					Type:       template.Type,
+					Status:     domain.CouponStatusUnused,
+					ValidFrom:  now.Add(-time.Hour),
+					ValidTo:    now.Add(time.Hour),
+				}
+				couponRepo.EXPECT().FindByCode(gomock.Any(), merchantID, "", c.Code).Return(c, nil)
+				templateRepo.EXPECT().FindByID(gomock.Any(), template.ID).Return(&template, nil)
+				if k == 0 || tt.wantErr == nil {
+					couponRepo.EXPECT().Update(gomock.Any(), c).Return(nil)
+				}
+
+				res, err := interactor.Redeem(context.Background(), domain.CouponRedeemParams{
+					MerchantID: merchantID,
+					StoreID:    storeID,
+					OrderID:    saved.ID,
+					Code:       c.Code,
+				})
+				if k == 1 && tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					continue
+				}
+				require.NoError(t, err)
+				require.True(t, res.DiscountAmount.Equal(dec(tt.wantDiscount[k])), res.DiscountAmount.String())
+			}
+
+			require.True(t, saved.Amount.AmountDue.Equal(dec(tt.wantDue)), saved.Amount.AmountDue.String())
+			require.True(t, saved.Amount.DiscountTotal.Equal(dec("30").Sub(dec(tt.wantDue))))
+			require.True(t, saved.OrderProducts[0].DiscountAmount.Equal(dec(tt.wantLineDisc)))
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package coupon
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.CouponTemplateInteractor = (*CouponTemplateInteractor)(nil)
+
+type CouponTemplateInteractor struct {
+	DS domain.DataStore
+}
+
+func NewCouponTemplateInteractor(ds domain.DataStore) *CouponTemplateInteractor {
+	return &CouponTemplateInteractor{
+		DS: ds,
+	}
+}
+
+func (i *CouponTemplateInteractor) Create(ctx context.Context, template *domain.CouponTemplate, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponTemplateInteractor.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	template.MerchantID = user.GetMerchantID()
+	template.StoreID = user.GetStoreID()
+	template.IssuedQty = 0
+	if err = prepareTemplate(template); err != nil {
+		return err
+	}
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		if err := validateTemplateBusinessRules(ctx, ds, template, uuid.Nil); err != nil {
+			return err
+		}
+		return ds.CouponTemplateRepo().Create(ctx, template)
+	})
+}
+
+func (i *CouponTemplateInteractor) Update(ctx context.Context, template *domain.CouponTemplate, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponTemplateInteractor.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		existing, err := findTemplate(ctx, ds, template.ID, user, true)
+		if err != nil {
+			return err
+		}
+		template.MerchantID = existing.MerchantID
+		template.StoreID = existing.StoreID
+		template.IssuedQty = existing.IssuedQty
+		if err = prepareTemplate(template); err != nil {
+			return err
+		}
+		// 发放总量不能小于已发放数量
+		if template.TotalQty > 0 && template.TotalQty < template.IssuedQty {
+			return domain.ParamsError(domain.ErrCouponStockNotEnough)
+		}
+		if err = validateTemplateBusinessRules(ctx, ds, template, template.ID); err != nil {
+			return err
+		}
+		return ds.CouponTemplateRepo().Update(ctx, template)
+	})
+}
+
+func (i *CouponTemplateInteractor) Delete(ctx context.Context, id uuid.UUID, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponTemplateInteractor.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		template, err := findTemplate(ctx, ds, id, user, true)
+		if err != nil {
+			return err
+		}
+		if template.IssuedQty > 0 {
+			return domain.ParamsError(domain.ErrCouponTemplateHasIssued)
+		}
+		return ds.CouponTemplateRepo().Delete(ctx, id)
+	})
+}
+
+func (i *CouponTemplateInteractor) GetDetail(ctx context.Context, id uuid.UUID, user domain.User) (res *domain.CouponTemplate, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponTemplateInteractor.GetDetail")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return findTemplate(ctx, i.DS, id, user, false)
+}
+
+func (i *CouponTemplateInteractor) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.CouponTemplateSearchParams,
+) (res *domain.CouponTemplateSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponTemplateInteractor.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.CouponTemplateRepo().PagedListBySearch(ctx, page, params)
+}
+
+// Issue 按模板批量生成券码，模板加锁防止超发
+func (i *CouponTemplateInteractor) Issue(ctx context.Context, id uuid.UUID, qty int, user domain.User) (res domain.Coupons, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CouponTemplateInteractor.Issue")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		template, err := findTemplate(ctx, ds, id, user, true)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		coupons, err := template.Issue(qty, now.Format("20060102150405"), now)
+		if err != nil {
+			return domain.ParamsError(err)
+		}
+		if err = ds.CouponRepo().CreateBulk(ctx, coupons); err != nil {
+			return err
+		}
+		if err = ds.CouponTemplateRepo().Update(ctx, template); err != nil {
+			return err
+		}
+		res = coupons
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ============================================
+// 校验函数
+// ============================================
+
+func findTemplate(ctx context.Context, ds domain.DataStore, id uuid.UUID, user domain.User, forUpdate bool) (*domain.CouponTemplate, error) {
+	var (
+		template *domain.CouponTemplate
+		err      error
+	)
+	if forUpdate {
+		template, err = ds.CouponTemplateRepo().FindByIDForUpdate(ctx, id)
+	} else {
+		template, err = ds.CouponTemplateRepo().FindByID(ctx, id)
+	}
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrCouponTemplateNotExists)
+		}
+		return nil, err
+	}
+	if !domain.VerifyOwnerShip(user, template.MerchantID, template.StoreID) {
+		return nil, domain.ParamsError(domain.ErrCouponTemplateNotExists)
+	}
+	return template, nil
+}
+
+// prepareTemplate 整理并校验模板设置，门店券仅适用于本门店
+func prepareTemplate(template *domain.CouponTemplate) error {
+	if template.StoreID != uuid.Nil {
+		template.StoreIDs = nil
+	}
+	template.StoreIDs = lo.Uniq(template.StoreIDs)
+	if template.ValidityType == domain.CouponValidityTypeRelative {
+		template.ValidFrom, template.ValidTo = nil, nil
+	} else {
+		template.ValidDays = 0
+	}
+	if template.Type != domain.CouponTypeFreeItem {
+		template.ProductID = uuid.Nil
+	}
+	if err := template.Validate(); err != nil {
+		return domain.ParamsError(err)
+	}
+	return nil
+}
+
+func validateTemplateBusinessRules(ctx context.Context, ds domain.DataStore, template *domain.CouponTemplate, excludeID uuid.UUID) error {
+	// 1. 检查名称是否唯一
+	exists, err := ds.CouponTemplateRepo().Exists(ctx, domain.CouponTemplateExistsParams{
+		MerchantID: template.MerchantID,
+		StoreID:    template.StoreID,
+		Name:       template.Name,
+		ExcludeID:  excludeID,
+	})
+	if err != nil {
+		return err
+	}
+	if exists {
+		return domain.ParamsError(domain.ErrCouponTemplateNameExists)
+	}
+
+	// 2. 检查兑换商品是否属于当前品牌商
+	if template.Type == domain.CouponTypeFreeItem {
+		product, err := ds.ProductRepo().FindByID(ctx, template.ProductID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return domain.ParamsError(domain.ErrCouponProductRequired)
+			}
+			return err
+		}
+		if product.MerchantID != template.MerchantID {
+			return domain.ParamsError(domain.ErrCouponProductRequired)
+		}
+	}
+
+	// 3. 检查适用门店是否属于当前品牌商
+	if len(template.StoreIDs) == 0 {
+		return nil
+	}
+	stores, err := ds.StoreRepo().ListByIDs(ctx, template.StoreIDs)
+	if err != nil {
+		return err
+	}
+	if len(stores) != len(template.StoreIDs) {
+		return domain.ParamsError(domain.ErrCouponStoreInvalid)
+	}
+	for _, store := range stores {
+		if store.MerchantID != template.MerchantID {
+			return domain.ParamsError(domain.ErrCouponStoreInvalid)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,442 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/menuprice"
+)
+
+var _ domain.DeliveryOrderInteractor = (*DeliveryOrderInteractor)(nil)
+
+type DeliveryOrderInteractor struct {
+	DS           domain.DataStore
+	Registry     domain.DeliveryPlatformRegistry
+	Seq          domain.DailySequence
+	Pusher       domain.Pusher
+	MutexManager domain.MutexManager
+}
+
+func NewDeliveryOrderInteractor(
+	ds domain.DataStore,
+	registry domain.DeliveryPlatformRegistry,
+	seq domain.DailySequence,
+	pusher domain.Pusher,
+	mutexManager domain.MutexManager,
+) *DeliveryOrderInteractor {
+	return &DeliveryOrderInteractor{
+		DS:           ds,
+		Registry:     registry,
+		Seq:          seq,
+		Pusher:       pusher,
+		MutexManager: mutexManager,
+	}
+}
+
+func (i *DeliveryOrderInteractor) HandleWebhook(ctx context.Context, platformCode domain.DeliveryPlatformCode, header http.Header, body []byte) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliveryOrderInteractor.HandleWebhook")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	platform, err := i.Registry.Get(platformCode)
+	if err != nil {
+		return err
+	}
+	event, err := platform.ParseWebhook(ctx, header, body)
+	if err != nil {
+		return domain.ParamsError(err)
+	}
+	if event == nil {
+		return nil
+	}
+
+	binding, err := i.DS.DeliveryStoreRepo().FindByPlatformStore(ctx, platformCode, event.PlatformStoreID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrDeliveryStoreNotExists)
+		}
+		return err
+	}
+	if !binding.Enabled {
+		return domain.ParamsError(domain.ErrDeliveryStoreNotExists)
+	}
+
+	switch event.Type {
+	case domain.DeliveryWebhookOrderCreated:
+		return i.receive(ctx, binding, event)
+	case domain.DeliveryWebhookOrderCancelled:
+		return i.cancel(ctx, binding, event)
+	}
+	return nil
+}
+
+func (i *DeliveryOrderInteractor) List(ctx context.Context, merchantID uuid.UUID, params domain.DeliveryOrderListParams) (res []*domain.DeliveryOrder, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliveryOrderInteractor.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if err = verifyStore(ctx, i.DS, merchantID, params.StoreID); err != nil {
+		return nil, err
+	}
+	return i.DS.DeliveryOrderRepo().List(ctx, params)
+}
+
+func (i *DeliveryOrderInteractor) Accept(ctx context.Context, merchantID, storeID, id uuid.UUID) (res *domain.DeliveryOrder, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliveryOrderInteractor.Accept")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = i.withOrder(ctx, id, func() error {
+		res, err = i.findOrder(ctx, merchantID, storeID, id)
+		if err != nil {
+			return err
+		}
+		binding, err := i.findBinding(ctx, res)
+		if err != nil {
+			return err
+		}
+		return i.accept(ctx, binding, res, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *DeliveryOrderInteractor) Reject(ctx context.Context, merchantID, storeID, id uuid.UUID, reason string) (res *domain.DeliveryOrder, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliveryOrderInteractor.Reject")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if reason == "" {
+		return nil, domain.ParamsError(domain.ErrDeliveryRejectReasonMissing)
+	}
+	err = i.withOrder(ctx, id, func() error {
+		res, err = i.findOrder(ctx, merchantID, storeID, id)
+		if err != nil {
+			return err
+		}
+		binding, err := i.findBinding(ctx, res)
+		if err != nil {
+			return err
+		}
+		if err = res.Reject(reason); err != nil {
+			return domain.ParamsError(err)
+		}
+		platform, err := i.Registry.Get(res.Platform)
+		if err != nil {
+			return err
+		}
+		if err = platform.RejectOrder(ctx, binding.PlatformStoreID, res.PlatformOrderID, reason); err != nil {
+			return platformError(ctx, "拒单", res.Platform, err)
+		}
+		return i.DS.DeliveryOrderRepo().Update(ctx, res)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.pushOrder(ctx, domain.PushEventDeliveryOrderUpdated, res)
+	return res, nil
+}
+
+func (i *DeliveryOrderInteractor) MarkReady(ctx context.Context, merchantID, storeID, id uuid.UUID) (res *domain.DeliveryOrder, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliveryOrderInteractor.MarkReady")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = i.withOrder(ctx, id, func() error {
+		res, err = i.findOrder(ctx, merchantID, storeID, id)
+		if err != nil {
+			return err
+		}
+		binding, err := i.findBinding(ctx, res)
+		if err != nil {
+			return err
+		}
+		if err = res.Ready(time.Now()); err != nil {
+			return domain.ParamsError(err)
+		}
+		platform, err := i.Registry.Get(res.Platform)
+		if err != nil {
+			return err
+		}
+		if err = platform.MarkReady(ctx, binding.PlatformStoreID, res.PlatformOrderID); err != nil {
+			return platformError(ctx, "出餐通知", res.Platform, err)
+		}
+		return i.DS.DeliveryOrderRepo().Update(ctx, res)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.pushOrder(ctx, domain.PushEventDeliveryOrderUpdated, res)
+	return res, nil
+}
+
+// receive 接收新订单，平台重复推送时忽略；满足自动接单规则时立即接单，自动接单失败保留待接单由门店处理
+func (i *DeliveryOrderInteractor) receive(ctx context.Context, binding *domain.DeliveryStore, event *domain.DeliveryWebhookEvent) error {
+	if event.Order == nil {
+		return domain.ParamsError(domain.ErrDeliveryPayloadInvalid)
+	}
+	_, err := i.DS.DeliveryOrderRepo().FindByPlatformOrder(ctx, binding.Platform, event.PlatformOrderID)
+	if err == nil {
+		return nil
+	}
+	if !domain.IsNotFound(err) {
+		return err
+	}
+
+	order := domain.NewDeliveryOrder(binding, event)
+	if err = i.applyMappings(ctx, order); err != nil {
+		return err
+	}
+	if err = i.DS.DeliveryOrderRepo().Create(ctx, order); err != nil {
+		if domain.IsConflict(err) {
+			return nil
+		}
+		return err
+	}
+	i.pushOrder(ctx, domain.PushEventDeliveryOrderCreated, order)
+
+	if !binding.CanAutoAccept(order) {
+		return nil
+	}
+	err = i.withOrder(ctx, order.ID, func() error {
+		return i.accept(ctx, binding, order, true)
+	})
+	if err != nil {
+		logging.FromContext(ctx).Named("DeliveryOrderInteractor.HandleWebhook").
+			Errorf("外卖订单 %s 自动接单失败: %v", order.ID, err)
+	}
+	return nil
+}
+
+// cancel 平台取消订单；已接单的门店订单由门店按取消流程处理
+func (i *DeliveryOrderInteractor) cancel(ctx context.Context, binding *domain.DeliveryStore, event *domain.DeliveryWebhookEvent) error {
+	order, err := i.DS.DeliveryOrderRepo().FindByPlatformOrder(ctx, binding.Platform, event.PlatformOrderID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return i.withOrder(ctx, order.ID, func() error {
+		if !order.Cancel() {
+			return nil
+		}
+		if err := i.DS.DeliveryOrderRepo().Update(ctx, order); err != nil {
+			return err
+		}
+		i.pushOrder(ctx, domain.PushEventDeliveryOrderUpdated, order)
+		return nil
+	})
+}
+
+// accept 通知平台接单后生成门店订单并送厨。商品按映射计价校验后以平台单价入账，
+// 订单应收与平台合计一致，并以平台订单ID记为已支付
+func (i *DeliveryOrderInteractor) accept(ctx context.Context, binding *domain.DeliveryStore, order *domain.DeliveryOrder, auto bool) error {
+	if order.Status != domain.DeliveryOrderStatusPending {
+		return domain.ParamsError(domain.ErrDeliveryOrderStatusInvalid)
+	}
+	if !order.Mapped() {
+		// 接收后新增的映射在接单时补全
+		if err := i.applyMappings(ctx, order); err != nil {
+			return err
+		}
+		if !order.Mapped() {
+			return domain.ParamsError(domain.ErrDeliveryOrderUnmapped)
+		}
+	}
+	store, err := i.DS.StoreRepo().GetDetail(ctx, order.StoreID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return err
+	}
+
+	now := time.Now()
+	productIDs := lo.Map(order.Items, func(item domain.DeliveryOrderItem, _ int) uuid.UUID { return item.ProductID })
+	p, err := menuprice.NewPricer(ctx, i.DS, menuprice.Delivery, store, productIDs, now)
+	if err != nil {
+		return err
+	}
+	lines := make([]*menuprice.Line, 0, len(order.Items))
+	for _, item := range order.Items {
+		line, err := p.Price(ctx, item.ProductID, item.SpecID, nil, nil)
+		if err != nil {
+			return domain.ParamsError(err)
+		}
+		line.Price = item.Price
+		line.AttrUnit = decimal.Zero
+		lines = append(lines, line)
+	}
+
+	posOrder, err := i.newOrder(ctx, store, order, now)
+	if err != nil {
+		return err
+	}
+
+	platform, err := i.Registry.Get(order.Platform)
+	if err != nil {
+		return err
+	}
+	if err = platform.AcceptOrder(ctx, binding.PlatformStoreID, order.PlatformOrderID); err != nil {
+		return platformError(ctx, "接单", order.Platform, err)
+	}
+
+	var ticket *domain.KitchenTicket
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		batch := posOrder.NextBatchIndex()
+		products := make([]domain.OrderProduct, 0, len(lines))
+		stallIDs := make(map[uuid.UUID]uuid.UUID, len(lines))
+		for idx, line := range lines {
+			item := order.Items[idx]
+			products = append(products, line.OrderProduct(item.Qty, item.Remark, batch))
+			stallIDs[line.Product.ID] = line.Product.EffectiveStallID()
+		}
+		posOrder.AddProducts(products)
+		posOrder.AddPlaceOrderLog(domain.OrderOperator{Name: order.Platform.Label()}, products, now)
+		posOrder.ApplyDeliveryAmount(order.DeliveryFee, order.Total)
+		posOrder.PayByDeliveryPlatform(order.PlatformOrderID, now)
+		if err := ds.OrderRepo().Create(ctx, posOrder); err != nil {
+			return err
+		}
+
+		// 平台订单已付款，厨房单直接出单
+		ticket = domain.NewKitchenTicket(posOrder, batch, false, stallIDs)
+		if err := ds.KitchenTicketRepo().Create(ctx, ticket); err != nil {
+			return err
+		}
+
+		if err := order.Accept(posOrder.ID, auto, now); err != nil {
+			return domain.ParamsError(err)
+		}
+		return ds.DeliveryOrderRepo().Update(ctx, order)
+	})
+	if err != nil {
+		return err
+	}
+
+	i.Pusher.Push(ctx, domain.PushEventOrderCreated, posOrder, domain.OrderPushTopics(posOrder)...)
+	i.Pusher.Push(ctx, domain.PushEventKitchenTicketCreated, ticket, domain.StorePushTopic(posOrder.StoreID))
+	i.pushOrder(ctx, domain.PushEventDeliveryOrderUpdated, order)
+	return nil
+}
+
+func (i *DeliveryOrderInteractor) applyMappings(ctx context.Context, order *domain.DeliveryOrder) error {
+	mappings, err := i.DS.DeliveryProductMappingRepo().List(ctx, domain.DeliveryProductMappingListParams{
+		MerchantID:   order.MerchantID,
+		Platform:     order.Platform,
+		PlatformSKUs: order.SKUs(),
+	})
+	if err != nil {
+		return err
+	}
+	order.ApplyMappings(mappings)
+	return nil
+}
+
+// newOrder 创建外卖门店订单，以平台取餐码作为取餐号
+func (i *DeliveryOrderInteractor) newOrder(ctx context.Context, store *domain.Store, order *domain.DeliveryOrder, now time.Time) (*domain.Order, error) {
+	orderNo, err := i.newOrderNo(ctx, store, now)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &domain.Order{
+		ID:            uuid.New(),
+		MerchantID:    store.MerchantID,
+		StoreID:       store.ID,
+		BusinessDate:  now.Format(time.DateOnly),
+		OrderNo:       orderNo,
+		PickupNo:      order.ShortCode,
+		OrderType:     domain.OrderTypeSale,
+		PlacedAt:      now,
+		PlacedByName:  order.Platform.Label(),
+		DiningMode:    domain.DiningModeDelivery,
+		OrderStatus:   domain.OrderStatusPlaced,
+		PaymentStatus: domain.PaymentStatusUnpaid,
+		Channel:       domain.ChannelDelivery,
+		Remark:        order.Remark,
+		Store: domain.OrderStore{
+			ID:           store.ID,
+			MerchantID:   store.MerchantID,
+			StoreCode:    store.StoreCode,
+			ContactPhone: store.ContactPhone,
+			StoreName:    store.StoreName,
+		},
+	}
+	if store.Merchant != nil {
+		res.Store.MerchantName = store.Merchant.MerchantName
+	}
+	return res, nil
+}
+
+// newOrderNo 生成订单号：门店编码 + YYYYMMDD + 6位当日序号，与 POS 下单共用序号
+func (i *DeliveryOrderInteractor) newOrderNo(ctx context.Context, store *domain.Store, now time.Time) (string, error) {
+	prefix := fmt.Sprintf("%s:%s", domain.DailySequencePrefixOrderNo, store.ID.String())
+	seq, err := i.Seq.Next(ctx, prefix)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate order_no: %w", err)
+	}
+	return fmt.Sprintf("%s%s%06d", store.StoreCode, now.Format("20060102"), seq), nil
+}
+
+// withOrder 持有外卖订单锁执行，避免自动接单与门店手动操作并发
+func (i *DeliveryOrderInteractor) withOrder(ctx context.Context, id uuid.UUID, fn func() error) error {
+	mu := i.MutexManager.NewMutex(domain.NewMutexDeliveryOrderKey(id))
+	if err := mu.Lock(ctx); err != nil {
+		if domain.IsAlreadyTakenError(err) {
+			return domain.ParamsError(domain.ErrDeliveryOrderBusy)
+		}
+		return err
+	}
+	defer func() {
+		_, _ = mu.Unlock(ctx)
+	}()
+	return fn()
+}
+
+func (i *DeliveryOrderInteractor) findOrder(ctx context.Context, merchantID, storeID, id uuid.UUID) (*domain.DeliveryOrder, error) {
+	order, err := i.DS.DeliveryOrderRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrDeliveryOrderNotExists)
+		}
+		return nil, err
+	}
+	if order.MerchantID != merchantID || order.StoreID != storeID {
+		return nil, domain.ParamsError(domain.ErrDeliveryOrderNotExists)
+	}
+	return order, nil
+}
+
+func (i *DeliveryOrderInteractor) findBinding(ctx context.Context, order *domain.DeliveryOrder) (*domain.DeliveryStore, error) {
+	binding, err := i.DS.DeliveryStoreRepo().FindByStore(ctx, order.StoreID, order.Platform)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrDeliveryStoreNotExists)
+		}
+		return nil, err
+	}
+	return binding, nil
+}
+
+func (i *DeliveryOrderInteractor) pushOrder(ctx context.Context, event domain.PushEventType, order *domain.DeliveryOrder) {
+	i.Pusher.Push(ctx, event, domain.NewDeliveryOrderPush(order), domain.StorePushTopic(order.StoreID))
+}
@@ -0,0 +1,389 @@
+package delivery
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+// defaultCategoryName 商品未设置分类时推送到平台的分类名称
+const defaultCategoryName = "其他"
+
+var _ domain.DeliverySettingInteractor = (*DeliverySettingInteractor)(nil)
+
+type DeliverySettingInteractor struct {
+	DS       domain.DataStore
+	Registry domain.DeliveryPlatformRegistry
+}
+
+func NewDeliverySettingInteractor(ds domain.DataStore, registry domain.DeliveryPlatformRegistry) *DeliverySettingInteractor {
+	return &DeliverySettingInteractor{
+		DS:       ds,
+		Registry: registry,
+	}
+}
+
+func (i *DeliverySettingInteractor) Platforms(ctx context.Context) []domain.DeliveryPlatformCode {
+	return i.Registry.Codes()
+}
+
+func (i *DeliverySettingInteractor) ListStores(ctx context.Context, merchantID, storeID uuid.UUID) (res []*domain.DeliveryStore, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliverySettingInteractor.ListStores")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.DeliveryStoreRepo().List(ctx, domain.DeliveryStoreListParams{
+		MerchantID: merchantID,
+		StoreID:    storeID,
+	})
+}
+
+func (i *DeliverySettingInteractor) SaveStore(ctx context.Context, store *domain.DeliveryStore) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliverySettingInteractor.SaveStore")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if err = store.Validate(); err != nil {
+		return domain.ParamsError(err)
+	}
+	if _, err = i.Registry.Get(store.Platform); err != nil {
+		return err
+	}
+	if err = verifyStore(ctx, i.DS, store.MerchantID, store.StoreID); err != nil {
+		return err
+	}
+
+	if store.ID == uuid.Nil {
+		store.ID = uuid.New()
+		err = i.DS.DeliveryStoreRepo().Create(ctx, store)
+	} else {
+		var existing *domain.DeliveryStore
+		existing, err = i.findStore(ctx, store.MerchantID, store.StoreID, store.ID)
+		if err != nil {
+			return err
+		}
+		// 平台不可修改，换平台需删除后重新绑定
+		store.Platform = existing.Platform
+		store.CreatedAt = existing.CreatedAt
+		err = i.DS.DeliveryStoreRepo().Update(ctx, store)
+	}
+	if domain.IsConflict(err) {
+		return domain.ParamsError(domain.ErrDeliveryStoreExists)
+	}
+	return err
+}
+
+func (i *DeliverySettingInteractor) DeleteStore(ctx context.Context, merchantID, storeID, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliverySettingInteractor.DeleteStore")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	store, err := i.findStore(ctx, merchantID, storeID, id)
+	if err != nil {
+		return err
+	}
+	return i.DS.DeliveryStoreRepo().Delete(ctx, store.ID)
+}
+
+func (i *DeliverySettingInteractor) ListMappings(ctx context.Context, merchantID uuid.UUID, platform domain.DeliveryPlatformCode) (res []*domain.DeliveryProductMapping, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliverySettingInteractor.ListMappings")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.DeliveryProductMappingRepo().List(ctx, domain.DeliveryProductMappingListParams{
+		MerchantID: merchantID,
+		Platform:   platform,
+	})
+}
+
+// SaveMapping 保存商品映射，商品需属于品牌商且开放三方外卖渠道，规格需为商品已关联的规格
+func (i *DeliverySettingInteractor) SaveMapping(ctx context.Context, mapping *domain.DeliveryProductMapping) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliverySettingInteractor.SaveMapping")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if err = mapping.Validate(); err != nil {
+		return domain.ParamsError(err)
+	}
+	product, err := i.DS.ProductRepo().GetDetail(ctx, mapping.ProductID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrProductNotExists)
+		}
+		return err
+	}
+	if product.MerchantID != mapping.MerchantID {
+		return domain.ParamsError(domain.ErrProductNotExists)
+	}
+	if product.Type == domain.ProductTypeSetMeal || !slices.Contains(product.SaleChannels, domain.SaleChannelThirdPartyDelivery) {
+		return domain.ParamsError(domain.ErrDeliveryProductUnavailable)
+	}
+	if mapping.SpecID != uuid.Nil && !slices.ContainsFunc(product.SpecRelations, func(rel *domain.ProductSpecRelation) bool {
+		return rel.SpecID == mapping.SpecID
+	}) {
+		return domain.ParamsError(domain.ErrScanOrderSpecInvalid)
+	}
+
+	if mapping.ID == uuid.Nil {
+		mapping.ID = uuid.New()
+		err = i.DS.DeliveryProductMappingRepo().Create(ctx, mapping)
+	} else {
+		var existing *domain.DeliveryProductMapping
+		existing, err = i.findMapping(ctx, mapping.MerchantID, mapping.ID)
+		if err != nil {
+			return err
+		}
+		mapping.Platform = existing.Platform
+		mapping.CreatedAt = existing.CreatedAt
+		err = i.DS.DeliveryProductMappingRepo().Update(ctx, mapping)
+	}
+	if domain.IsConflict(err) {
+		return domain.ParamsError(domain.ErrDeliveryMappingExists)
+	}
+	return err
+}
+
+func (i *DeliverySettingInteractor) DeleteMapping(ctx context.Context, merchantID, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliverySettingInteractor.DeleteMapping")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	mapping, err := i.findMapping(ctx, merchantID, id)
+	if err != nil {
+		return err
+	}
+	return i.DS.DeliveryProductMappingRepo().Delete(ctx, mapping.ID)
+}
+
+// PushMenu 按门店当前菜单生成外卖菜单，价格优先级与自助点餐一致：菜单项价格 > 映射规格价格 > 默认规格价格
+func (i *DeliverySettingInteractor) PushMenu(ctx context.Context, merchantID, storeID uuid.UUID, platformCode domain.DeliveryPlatformCode) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeliverySettingInteractor.PushMenu")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	platform, err := i.Registry.Get(platformCode)
+	if err != nil {
+		return err
+	}
+	binding, err := i.DS.DeliveryStoreRepo().FindByStore(ctx, storeID, platformCode)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrDeliveryStoreNotExists)
+		}
+		return err
+	}
+	if binding.MerchantID != merchantID {
+		return domain.ParamsError(domain.ErrDeliveryStoreNotExists)
+	}
+	store, err := i.DS.StoreRepo().GetDetail(ctx, storeID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return err
+	}
+
+	now := time.Now()
+	menus, err := i.DS.MenuRepo().ListAllStoreMenus(ctx, domain.MenuListAllParams{
+		MerchantID: merchantID,
+		StoreID:    storeID,
+		At:         now,
+	})
+	if err != nil {
+		return err
+	}
+	domain.ApplyMenuCurrentPrices(menus, store.DiningPeriods, now)
+
+	mappings, err := i.DS.DeliveryProductMappingRepo().List(ctx, domain.DeliveryProductMappingListParams{
+		MerchantID: merchantID,
+		Platform:   platformCode,
+	})
+	if err != nil {
+		return err
+	}
+	menu := buildMenu(menus, mappings)
+	if err = platform.PushMenu(ctx, binding.PlatformStoreID, menu); err != nil {
+		return platformError(ctx, "推送菜单", platformCode, err)
+	}
+	return nil
+}
+
+// SyncAvailability 商品售卖状态同步到已映射该商品的平台门店；品牌商品同步到品牌商全部门店，门店商品只同步到该门店
+func (i *DeliverySettingInteractor) SyncAvailability(ctx context.Context, product *domain.Product) {
+	logger := logging.FromContext(ctx).Named("DeliverySettingInteractor.SyncAvailability")
+
+	mappings, err := i.DS.DeliveryProductMappingRepo().List(ctx, domain.DeliveryProductMappingListParams{
+		MerchantID: product.MerchantID,
+		ProductIDs: []uuid.UUID{product.ID},
+	})
+	if err != nil {
+		logger.Errorf("查询商品 %s 的外卖映射失败: %v", product.ID, err)
+		return
+	}
+	if len(mappings) == 0 {
+		return
+	}
+	enabled := true
+	stores, err := i.DS.DeliveryStoreRepo().List(ctx, domain.DeliveryStoreListParams{
+		MerchantID: product.MerchantID,
+		StoreID:    product.StoreID,
+		Enabled:    &enabled,
+	})
+	if err != nil {
+		logger.Errorf("查询外卖门店绑定失败: %v", err)
+		return
+	}
+
+	available := product.SaleStatus == domain.ProductSaleStatusOnSale
+	byPlatform := lo.GroupBy(mappings, func(m *domain.DeliveryProductMapping) domain.DeliveryPlatformCode { return m.Platform })
+	for _, store := range stores {
+		skus, ok := byPlatform[store.Platform]
+		if !ok {
+			continue
+		}
+		platform, err := i.Registry.Get(store.Platform)
+		if err != nil {
+			continue
+		}
+		items := lo.Map(skus, func(m *domain.DeliveryProductMapping, _ int) domain.DeliverySKUAvailability {
+			return domain.DeliverySKUAvailability{SKU: m.PlatformSKU, Available: available}
+		})
+		if err := platform.UpdateAvailability(ctx, store.PlatformStoreID, items); err != nil {
+			logger.Errorf("同步商品 %s 售卖状态到 %s 门店 %s 失败: %v", product.ID, store.Platform, store.PlatformStoreID, err)
+		}
+	}
+}
+
+func (i *DeliverySettingInteractor) findStore(ctx context.Context, merchantID, storeID, id uuid.UUID) (*domain.DeliveryStore, error) {
+	store, err := i.DS.DeliveryStoreRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrDeliveryStoreNotExists)
+		}
+		return nil, err
+	}
+	if store.MerchantID != merchantID || (storeID != uuid.Nil && store.StoreID != storeID) {
+		return nil, domain.ParamsError(domain.ErrDeliveryStoreNotExists)
+	}
+	return store, nil
+}
+
+func (i *DeliverySettingInteractor) findMapping(ctx context.Context, merchantID, id uuid.UUID) (*domain.DeliveryProductMapping, error) {
+	mapping, err := i.DS.DeliveryProductMappingRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrDeliveryMappingNotExists)
+		}
+		return nil, err
+	}
+	if mapping.MerchantID != merchantID {
+		return nil, domain.ParamsError(domain.ErrDeliveryMappingNotExists)
+	}
+	return mapping, nil
+}
+
+// buildMenu 只推送已映射且开放三方外卖渠道的商品，停售商品标记为不可售，按商品分类分组
+func buildMenu(menus domain.Menus, mappings []*domain.DeliveryProductMapping) *domain.DeliveryMenu {
+	byProduct := lo.GroupBy(mappings, func(m *domain.DeliveryProductMapping) uuid.UUID { return m.ProductID })
+	menu := &domain.DeliveryMenu{}
+	categories := make(map[string]int)
+	pushed := make(map[string]struct{})
+	for _, m := range menus {
+		for _, item := range m.Items {
+			product := item.Product
+			if product == nil || product.Type == domain.ProductTypeSetMeal ||
+				!slices.Contains(product.SaleChannels, domain.SaleChannelThirdPartyDelivery) {
+				continue
+			}
+			for _, mapping := range byProduct[product.ID] {
+				if _, ok := pushed[mapping.PlatformSKU]; ok {
+					continue
+				}
+				price, ok := menuItemPrice(item, mapping.SpecID)
+				if !ok {
+					continue
+				}
+				pushed[mapping.PlatformSKU] = struct{}{}
+
+				name := defaultCategoryName
+				if product.Category != nil && product.Category.Name != "" {
+					name = product.Category.Name
+				}
+				idx, ok := categories[name]
+				if !ok {
+					idx = len(menu.Categories)
+					categories[name] = idx
+					menu.Categories = append(menu.Categories, domain.DeliveryMenuCategory{Name: name})
+				}
+				menu.Categories[idx].Items = append(menu.Categories[idx].Items, domain.DeliveryMenuItem{
+					SKU:         mapping.PlatformSKU,
+					Name:        product.Name,
+					Description: product.Description,
+					Image:       product.MainImage,
+					Price:       price,
+					Available:   product.SaleStatus == domain.ProductSaleStatusOnSale,
+				})
+			}
+		}
+	}
+	return menu
+}
+
+// menuItemPrice 菜单项当前价格：菜单项价格 > 映射规格价格 > 默认规格价格
+func menuItemPrice(item *domain.MenuItem, specID uuid.UUID) (decimal.Decimal, bool) {
+	if item.CurrentPrice != nil && item.CurrentPrice.BasePrice != nil {
+		return *item.CurrentPrice.BasePrice, true
+	}
+	rels := item.Product.SpecRelations
+	idx := slices.IndexFunc(rels, func(rel *domain.ProductSpecRelation) bool {
+		if specID == uuid.Nil {
+			return rel.IsDefault
+		}
+		return rel.SpecID == specID
+	})
+	if idx < 0 {
+		if specID != uuid.Nil || len(rels) == 0 {
+			return decimal.Zero, false
+		}
+		idx = 0
+	}
+	spec := rels[idx]
+	if spec.CurrentPrice == nil || spec.CurrentPrice.BasePrice == nil {
+		return spec.BasePrice, true
+	}
+	return *spec.CurrentPrice.BasePrice, true
+}
+
+// platformError 记录平台接口错误，向调用方返回统一的提示
+func platformError(ctx context.Context, action string, platform domain.DeliveryPlatformCode, err error) error {
+	logging.FromContext(ctx).Named("delivery").Errorf("%s%s失败: %v", platform.Label(), action, err)
+	return domain.ParamsError(domain.ErrDeliveryPlatformFailed)
+}
+
+func verifyStore(ctx context.Context, ds domain.DataStore, merchantID, storeID uuid.UUID) error {
+	store, err := ds.StoreRepo().FindByID(ctx, storeID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return err
+	}
+	if store.MerchantID != merchantID {
+		return domain.ParamsError(domain.ErrStoreNotExists)
+	}
+	return nil
+}
@@ -278,6 +278,34 @@ func (interactor *DeviceInteractor) SyncStoreDeviceStatus(ctx context.Context, m
 	return
 }
 
+func (interactor *DeviceInteractor) ResetKioskToken(ctx context.Context, id uuid.UUID, user domain.User) (token string, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DeviceInteractor.ResetKioskToken")
+	defer func() { util.SpanErrFinish(span, err) }()
+
+	device, err := interactor.DS.DeviceRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return "", domain.ErrDeviceNotExists
+		}
+		return "", err
+	}
+	if err = verifyDeviceOwnership(user, device); err != nil {
+		return "", err
+	}
+	if device.DeviceType != domain.DeviceTypeKiosk {
+		return "", domain.ErrDeviceNotKiosk
+	}
+
+	token, err = domain.NewDeviceKioskToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate kiosk token: %w", err)
+	}
+	if err = interactor.DS.DeviceRepo().UpdateKioskToken(ctx, id, token); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
 func verifyDeviceOwnership(user domain.User, device *domain.Device) error {
 	switch user.GetUserType() {
 	case domain.UserTypeAdmin:
@@ -0,0 +1,148 @@
+package diningtable
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.DiningTableInteractor = (*DiningTableInteractor)(nil)
+
+type DiningTableInteractor struct {
+	DS domain.DataStore
+}
+
+func NewDiningTableInteractor(ds domain.DataStore) *DiningTableInteractor {
+	return &DiningTableInteractor{
+		DS: ds,
+	}
+}
+
+func (i *DiningTableInteractor) Create(ctx context.Context, table *domain.DiningTable, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DiningTableInteractor.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	table.MerchantID = user.GetMerchantID()
+	table.StoreID = user.GetStoreID()
+	table.QRToken, err = domain.NewDiningTableQRToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate qr token: %w", err)
+	}
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		if err := checkNameUnique(ctx, ds, table, uuid.Nil); err != nil {
+			return err
+		}
+		table.ID = uuid.New()
+		return ds.DiningTableRepo().Create(ctx, table)
+	})
+}
+
+func (i *DiningTableInteractor) Update(ctx context.Context, table *domain.DiningTable, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DiningTableInteractor.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		existing, err := findTable(ctx, ds, table.ID, user)
+		if err != nil {
+			return err
+		}
+		table.MerchantID = existing.MerchantID
+		table.StoreID = existing.StoreID
+		table.QRToken = existing.QRToken
+		table.CreatedAt = existing.CreatedAt
+		if err = checkNameUnique(ctx, ds, table, table.ID); err != nil {
+			return err
+		}
+		return ds.DiningTableRepo().Update(ctx, table)
+	})
+}
+
+func (i *DiningTableInteractor) Delete(ctx context.Context, id uuid.UUID, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DiningTableInteractor.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		if _, err := findTable(ctx, ds, id, user); err != nil {
+			return err
+		}
+		return ds.DiningTableRepo().Delete(ctx, id)
+	})
+}
+
+func (i *DiningTableInteractor) List(ctx context.Context, user domain.User) (res []*domain.DiningTable, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DiningTableInteractor.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.DiningTableRepo().ListByStoreID(ctx, user.GetStoreID())
+}
+
+func (i *DiningTableInteractor) ResetQRToken(ctx context.Context, id uuid.UUID, user domain.User) (res *domain.DiningTable, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "DiningTableInteractor.ResetQRToken")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		table, err := findTable(ctx, ds, id, user)
+		if err != nil {
+			return err
+		}
+		table.QRToken, err = domain.NewDiningTableQRToken()
+		if err != nil {
+			return fmt.Errorf("failed to generate qr token: %w", err)
+		}
+		if err = ds.DiningTableRepo().Update(ctx, table); err != nil {
+			return err
+		}
+		res = table
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ============================================
+// 校验函数
+// ============================================
+
+func findTable(ctx context.Context, ds domain.DataStore, id uuid.UUID, user domain.User) (*domain.DiningTable, error) {
+	table, err := ds.DiningTableRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrDiningTableNotExists)
+		}
+		return nil, err
+	}
+	if !domain.VerifyOwnerShip(user, table.MerchantID, table.StoreID) {
+		return nil, domain.ParamsError(domain.ErrDiningTableNotExists)
+	}
+	return table, nil
+}
+
+func checkNameUnique(ctx context.Context, ds domain.DataStore, table *domain.DiningTable, excludeID uuid.UUID) error {
+	exists, err := ds.DiningTableRepo().Exists(ctx, domain.DiningTableExistsParams{
+		StoreID:   table.StoreID,
+		Name:      table.Name,
+		ExcludeID: excludeID,
+	})
+	if err != nil {
+		return err
+	}
+	if exists {
+		return domain.ParamsError(domain.ErrDiningTableNameExists)
+	}
+	return nil
+}
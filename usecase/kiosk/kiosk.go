@@ -0,0 +1,622 @@
+package kiosk
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/menuprice"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/pickup"
+)
+
+// maxUpsells 最多推荐的套餐数量
+const maxUpsells = 3
+
+var _ domain.KioskInteractor = (*KioskInteractor)(nil)
+
+type KioskInteractor struct {
+	DS              domain.DataStore
+	Seq             domain.DailySequence
+	MutexManager    domain.MutexManager
+	Gateway         domain.PaymentGateway
+	Pusher          domain.Pusher
+	OrderInteractor domain.OrderInteractor
+}
+
+func NewKioskInteractor(
+	ds domain.DataStore,
+	seq domain.DailySequence,
+	mutexManager domain.MutexManager,
+	gateway domain.PaymentGateway,
+	pusher domain.Pusher,
+	orderInteractor domain.OrderInteractor,
+) *KioskInteractor {
+	return &KioskInteractor{
+		DS:              ds,
+		Seq:             seq,
+		MutexManager:    mutexManager,
+		Gateway:         gateway,
+		Pusher:          pusher,
+		OrderInteractor: orderInteractor,
+	}
+}
+
+func (i *KioskInteractor) Authenticate(ctx context.Context, token string) (res *domain.Device, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "KioskInteractor.Authenticate")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if token == "" {
+		return nil, domain.ErrKioskTokenInvalid
+	}
+	device, err := i.DS.DeviceRepo().FindByKioskToken(ctx, token)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ErrKioskTokenInvalid
+		}
+		return nil, err
+	}
+	if device.DeviceType != domain.DeviceTypeKiosk {
+		return nil, domain.ErrKioskTokenInvalid
+	}
+	if !device.Enabled {
+		return nil, domain.ErrKioskDeviceDisabled
+	}
+	return device, nil
+}
+
+func (i *KioskInteractor) GetInfo(ctx context.Context, device *domain.Device) (res *domain.KioskInfo, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "KioskInteractor.GetInfo")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	store, err := i.resolveStore(ctx, device)
+	if err != nil {
+		return nil, err
+	}
+	payModes := device.KioskPayModes
+	if len(payModes) == 0 {
+		payModes = []domain.KioskPayMode{domain.KioskPayModePayAtKiosk, domain.KioskPayModePayAtCounter}
+	}
+	return &domain.KioskInfo{
+		DeviceID:   device.ID,
+		DeviceName: device.Name,
+		MerchantID: store.MerchantID,
+		StoreID:    store.ID,
+		StoreName:  store.StoreName,
+		PayModes:   payModes,
+	}, nil
+}
+
+func (i *KioskInteractor) ListMenus(ctx context.Context, device *domain.Device) (res domain.Menus, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "KioskInteractor.ListMenus")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	store, err := i.resolveStore(ctx, device)
+	if err != nil {
+		return nil, err
+	}
+	return i.listMenus(ctx, store, time.Now())
+}
+
+func (i *KioskInteractor) ListUpsells(ctx context.Context, device *domain.Device, productIDs []uuid.UUID) (res []*domain.KioskUpsell, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "KioskInteractor.ListUpsells")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if len(productIDs) == 0 {
+		return []*domain.KioskUpsell{}, nil
+	}
+	store, err := i.resolveStore(ctx, device)
+	if err != nil {
+		return nil, err
+	}
+	menus, err := i.listMenus(ctx, store, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 菜单中的单品当前价格，用于计算套餐内商品单点合计
+	prices := make(map[uuid.UUID]decimal.Decimal)
+	setMeals := make(map[uuid.UUID]decimal.Decimal)
+	for _, menu := range menus {
+		for _, item := range menu.Items {
+			price, ok := currentPrice(item)
+			if !ok {
+				continue
+			}
+			if item.Product.Type == domain.ProductTypeSetMeal {
+				setMeals[item.ProductID] = price
+			} else {
+				prices[item.ProductID] = price
+			}
+		}
+	}
+
+	selected := lo.SliceToMap(productIDs, func(id uuid.UUID) (uuid.UUID, struct{}) { return id, struct{}{} })
+	res = make([]*domain.KioskUpsell, 0)
+	for setMealID, price := range setMeals {
+		if _, ok := selected[setMealID]; ok {
+			continue
+		}
+		product, err := i.DS.ProductRepo().GetDetail(ctx, setMealID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get set meal product: %w", err)
+		}
+		upsell := buildUpsell(product, price, prices, selected)
+		if upsell != nil {
+			res = append(res, upsell)
+		}
+	}
+
+	// 优先推荐包含已选商品多、节省金额多的套餐
+	slices.SortFunc(res, func(a, b *domain.KioskUpsell) int {
+		if len(a.ProductIDs) != len(b.ProductIDs) {
+			return len(b.ProductIDs) - len(a.ProductIDs)
+		}
+		return b.Saving.Cmp(a.Saving)
+	})
+	if len(res) > maxUpsells {
+		res = res[:maxUpsells]
+	}
+	return res, nil
+}
+
+func (i *KioskInteractor) Submit(ctx context.Context, device *domain.Device, params domain.KioskOrderParams) (res *domain.Order, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "KioskInteractor.Submit")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if !device.SupportsKioskPayMode(params.PayMode) {
+		return nil, domain.ParamsError(domain.ErrKioskPayModeInvalid)
+	}
+	if len(params.Items) == 0 {
+		return nil, domain.ParamsError(domain.ErrKioskCartEmpty)
+	}
+	for _, item := range params.Items {
+		if item.Qty <= 0 {
+			return nil, domain.ParamsError(domain.ErrKioskItemQtyInvalid)
+		}
+	}
+	store, err := i.resolveStore(ctx, device)
+	if err != nil {
+		return nil, err
+	}
+	// 机上支付需门店已配置收款账户，避免下单后无法支付
+	if params.PayMode == domain.KioskPayModePayAtKiosk {
+		if _, err = i.merchantNumber(ctx, store); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	productIDs := lo.Map(params.Items, func(item domain.KioskOrderItemParams, _ int) uuid.UUID { return item.ProductID })
+	p, err := menuprice.NewPricer(ctx, i.DS, menuprice.Kiosk, store, productIDs, now)
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]*menuprice.Line, 0, len(params.Items))
+	for _, item := range params.Items {
+		line, err := p.Price(ctx, item.ProductID, item.SpecID, item.Attrs, item.Groups)
+		if err != nil {
+			return nil, domain.ParamsError(err)
+		}
+		lines = append(lines, line)
+	}
+
+	order, err := i.newOrder(ctx, store, device, now)
+	if err != nil {
+		return nil, err
+	}
+	order.Remark = params.Remark
+
+	batch := order.NextBatchIndex()
+	products := make([]domain.OrderProduct, 0, len(lines))
+	stallIDs := make(map[uuid.UUID]uuid.UUID, len(lines))
+	for idx, line := range lines {
+		item := params.Items[idx]
+		products = append(products, line.OrderProduct(item.Qty, item.Remark, batch))
+		stallIDs[line.Product.ID] = line.Product.EffectiveStallID()
+	}
+	order.AddProducts(products)
+	order.AddPlaceOrderLog(domain.OrderOperator{ID: device.ID, Name: device.Name}, products, now)
+
+	// 与 POS 下单共用计价、套餐与口味做法校验、会员价和促销计算
+	err = i.OrderInteractor.Create(ctx, order, func(ctx context.Context, ds domain.DataStore, order *domain.Order) error {
+		// 自助点餐订单支付后送厨：机上支付成功或前台收款后放行
+		return ds.KitchenTicketRepo().Create(ctx, domain.NewKitchenTicket(order, batch, true, stallIDs))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func (i *KioskInteractor) Pay(ctx context.Context, device *domain.Device, orderID uuid.UUID, authCode string) (res *domain.Order, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "KioskInteractor.Pay")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if !device.SupportsKioskPayMode(domain.KioskPayModePayAtKiosk) {
+		return nil, domain.ParamsError(domain.ErrKioskPayModeInvalid)
+	}
+	order, err := i.findOrder(ctx, device, orderID)
+	if err != nil {
+		return nil, err
+	}
+	store, err := i.resolveStore(ctx, device)
+	if err != nil {
+		return nil, err
+	}
+	merchantNumber, err := i.merchantNumber(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.withOrder(ctx, order, func() error {
+		// 加锁后重新读取，避免重复扣款
+		if order, err = i.findOrder(ctx, device, orderID); err != nil {
+			return err
+		}
+		switch {
+		case order.PaymentStatus == domain.PaymentStatusPaid:
+			return domain.ParamsError(domain.ErrKioskOrderPaid)
+		case order.PendingOnlinePayment() != nil:
+			return domain.ParamsError(domain.ErrKioskPaymentBusy)
+		}
+
+		// 先记录支付中流水，网关超时或处理中时可凭交易号查询结果
+		now := time.Now()
+		paymentNo := order.NewOnlinePaymentNo()
+		order.StartOnlinePayment(paymentNo, order.AmountUnpaid(), domain.OrderPOS{ID: device.ID, Name: device.Name}, now)
+		if err := i.savePayment(ctx, order); err != nil {
+			return err
+		}
+
+		result, err := i.Gateway.Micropay(ctx, domain.PaymentGatewayMicropayParams{
+			MerchantNumber: merchantNumber,
+			PaymentNo:      paymentNo,
+			RequestedAt:    now,
+			Amount:         order.AmountUnpaid(),
+			Description:    store.StoreName,
+			AuthCode:       authCode,
+		})
+		if err != nil {
+			// 网关请求失败时交易结果未知，保留支付中状态待查询
+			return fmt.Errorf("failed to micropay: %w", err)
+		}
+		return i.applyResult(ctx, order, paymentNo, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func (i *KioskInteractor) QueryPayment(ctx context.Context, device *domain.Device, orderID uuid.UUID) (res *domain.Order, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "KioskInteractor.QueryPayment")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	order, err := i.findOrder(ctx, device, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.PendingOnlinePayment() == nil {
+		return order, nil
+	}
+	store, err := i.resolveStore(ctx, device)
+	if err != nil {
+		return nil, err
+	}
+	merchantNumber, err := i.merchantNumber(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.withOrder(ctx, order, func() error {
+		if order, err = i.findOrder(ctx, device, orderID); err != nil {
+			return err
+		}
+		payment := order.PendingOnlinePayment()
+		if payment == nil {
+			return nil
+		}
+		result, err := i.Gateway.Query(ctx, domain.PaymentGatewayQueryParams{
+			MerchantNumber: merchantNumber,
+			PaymentNo:      payment.PaymentNo,
+			RequestedAt:    payment.RequestedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to query payment: %w", err)
+		}
+		return i.applyResult(ctx, order, payment.PaymentNo, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// applyResult 按网关结果更新支付流水：成功后放行厨房单，失败时返回失败原因，处理中保持不变
+func (i *KioskInteractor) applyResult(ctx context.Context, order *domain.Order, paymentNo string, result *domain.PaymentGatewayResult) error {
+	switch result.Status {
+	case domain.PaymentGatewayStatusSuccess:
+		order.CompleteOnlinePayment(paymentNo, result.PaidAt)
+		var released int
+		err := i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+			if err := i.savePaymentWith(ctx, ds, order); err != nil {
+				return err
+			}
+			if order.PaymentStatus != domain.PaymentStatusPaid {
+				return nil
+			}
+			var err error
+			released, err = ds.KitchenTicketRepo().ReleaseByOrderID(ctx, order.ID)
+			if err != nil {
+				return err
+			}
+			return pickup.OpenCall(ctx, ds, order)
+		})
+		if err != nil {
+			return err
+		}
+		i.Pusher.Push(ctx, domain.PushEventOrderUpdated, order, domain.OrderPushTopics(order)...)
+		if released > 0 {
+			i.pushReleasedTickets(ctx, order)
+		}
+		return nil
+	case domain.PaymentGatewayStatusFailed:
+		order.CancelOnlinePayment(paymentNo)
+		if err := i.savePayment(ctx, order); err != nil {
+			return err
+		}
+		if result.Message != "" {
+			return domain.ParamsErrorf("%w：%s", domain.ErrKioskPaymentFailed, result.Message)
+		}
+		return domain.ParamsError(domain.ErrKioskPaymentFailed)
+	}
+	return nil
+}
+
+// pushReleasedTickets 通知门店厨房单已可出单
+func (i *KioskInteractor) pushReleasedTickets(ctx context.Context, order *domain.Order) {
+	tickets, err := i.DS.KitchenTicketRepo().ListByOrderID(ctx, order.ID)
+	if err != nil {
+		return
+	}
+	for _, ticket := range tickets {
+		if ticket.Status == domain.KitchenTicketStatusPending {
+			i.Pusher.Push(ctx, domain.PushEventKitchenTicketCreated, ticket, domain.StorePushTopic(order.StoreID))
+		}
+	}
+}
+
+// savePayment 只更新订单支付信息
+func (i *KioskInteractor) savePayment(ctx context.Context, order *domain.Order) error {
+	return i.savePaymentWith(ctx, i.DS, order)
+}
+
+func (i *KioskInteractor) savePaymentWith(ctx context.Context, ds domain.DataStore, order *domain.Order) error {
+	products := order.OrderProducts
+	order.OrderProducts = nil
+	defer func() {
+		order.OrderProducts = products
+	}()
+	return ds.OrderRepo().Update(ctx, order)
+}
+
+// withOrder 持有订单锁执行支付，同一订单的支付与查询串行执行
+func (i *KioskInteractor) withOrder(ctx context.Context, order *domain.Order, fn func() error) error {
+	mu := i.MutexManager.NewMutex(domain.NewMutexOrderKey(order.OrderNo))
+	if err := mu.Lock(ctx); err != nil {
+		if domain.IsAlreadyTakenError(err) {
+			return domain.ParamsError(domain.ErrKioskPaymentBusy)
+		}
+		return err
+	}
+	defer func() {
+		_, _ = mu.Unlock(ctx)
+	}()
+	return fn()
+}
+
+// findOrder 查询本门店自助点餐订单
+func (i *KioskInteractor) findOrder(ctx context.Context, device *domain.Device, orderID uuid.UUID) (*domain.Order, error) {
+	order, err := i.DS.OrderRepo().FindByID(ctx, orderID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrKioskOrderNotExists)
+		}
+		return nil, err
+	}
+	if order.StoreID != device.StoreID || order.Channel != domain.ChannelKiosk {
+		return nil, domain.ParamsError(domain.ErrKioskOrderNotExists)
+	}
+	return order, nil
+}
+
+// newOrder 创建自助点餐订单并分配取餐号
+func (i *KioskInteractor) newOrder(ctx context.Context, store *domain.Store, device *domain.Device, now time.Time) (*domain.Order, error) {
+	orderNo, err := i.newOrderNo(ctx, store, now)
+	if err != nil {
+		return nil, err
+	}
+	pickupNo, err := pickup.NewPickupNo(ctx, i.DS, i.Seq, store.MerchantID, store.ID, now.Format(time.DateOnly))
+	if err != nil {
+		return nil, err
+	}
+
+	order := &domain.Order{
+		ID:            uuid.New(),
+		MerchantID:    store.MerchantID,
+		StoreID:       store.ID,
+		BusinessDate:  now.Format(time.DateOnly),
+		OrderNo:       orderNo,
+		PickupNo:      pickupNo,
+		OrderType:     domain.OrderTypeSale,
+		PlacedAt:      now,
+		PlacedBy:      device.ID,
+		PlacedByName:  device.Name,
+		DiningMode:    domain.DiningModeDineIn,
+		OrderStatus:   domain.OrderStatusPlaced,
+		PaymentStatus: domain.PaymentStatusUnpaid,
+		Channel:       domain.ChannelKiosk,
+		Pos: domain.OrderPOS{
+			ID:   device.ID,
+			Name: device.Name,
+		},
+		Store: domain.OrderStore{
+			ID:           store.ID,
+			MerchantID:   store.MerchantID,
+			StoreCode:    store.StoreCode,
+			ContactPhone: store.ContactPhone,
+			StoreName:    store.StoreName,
+		},
+	}
+	if store.Merchant != nil {
+		order.Store.MerchantName = store.Merchant.MerchantName
+	}
+	return order, nil
+}
+
+// newOrderNo 生成订单号：门店编码 + YYYYMMDD + 6位当日序号，与 POS 下单共用序号
+func (i *KioskInteractor) newOrderNo(ctx context.Context, store *domain.Store, now time.Time) (string, error) {
+	prefix := fmt.Sprintf("%s:%s", domain.DailySequencePrefixOrderNo, store.ID.String())
+	seq, err := i.Seq.Next(ctx, prefix)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate order_no: %w", err)
+	}
+	return fmt.Sprintf("%s%s%06d", store.StoreCode, now.Format("20060102"), seq), nil
+}
+
+// merchantNumber 门店收款账户的支付商户号，优先使用默认收款账户
+func (i *KioskInteractor) merchantNumber(ctx context.Context, store *domain.Store) (string, error) {
+	res, err := i.DS.StorePaymentAccountRepo().PagedListBySearch(ctx, upagination.New(1, upagination.MaxSize), domain.StorePaymentAccountSearchParams{
+		MerchantID: store.MerchantID,
+		StoreIDs:   []uuid.UUID{store.ID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list store payment accounts: %w", err)
+	}
+	if len(res.Items) == 0 {
+		return "", domain.ParamsError(domain.ErrKioskPaymentAccountMissing)
+	}
+	account := res.Items[0]
+	for _, item := range res.Items {
+		if item.PaymentAccount != nil && item.PaymentAccount.IsDefault {
+			account = item
+			break
+		}
+	}
+	return account.MerchantNumber, nil
+}
+
+// listMenus 查询门店可自助点餐的菜单并计算当前价格
+func (i *KioskInteractor) listMenus(ctx context.Context, store *domain.Store, now time.Time) (domain.Menus, error) {
+	menus, err := i.DS.MenuRepo().ListAllStoreMenus(ctx, domain.MenuListAllParams{
+		MerchantID: store.MerchantID,
+		StoreID:    store.ID,
+		At:         now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	domain.ApplyMenuCurrentPrices(menus, store.DiningPeriods, now)
+	return menuprice.Kiosk.FilterMenus(menus), nil
+}
+
+// resolveStore 查询自助点餐机所在门店并校验营业状态
+func (i *KioskInteractor) resolveStore(ctx context.Context, device *domain.Device) (*domain.Store, error) {
+	store, err := i.DS.StoreRepo().GetDetail(ctx, device.StoreID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return nil, err
+	}
+	if store.Status != domain.StoreStatusOpen {
+		return nil, domain.ParamsError(domain.ErrKioskStoreClosed)
+	}
+	return store, nil
+}
+
+// currentPrice 菜单项当前价格：菜单项价格 > 默认规格价格
+func currentPrice(item *domain.MenuItem) (decimal.Decimal, bool) {
+	if item.CurrentPrice != nil && item.CurrentPrice.BasePrice != nil {
+		return *item.CurrentPrice.BasePrice, true
+	}
+	if item.Product == nil || len(item.Product.SpecRelations) == 0 {
+		return decimal.Zero, false
+	}
+	spec := item.Product.SpecRelations[0]
+	for _, rel := range item.Product.SpecRelations {
+		if rel.IsDefault {
+			spec = rel
+			break
+		}
+	}
+	if spec.CurrentPrice == nil || spec.CurrentPrice.BasePrice == nil {
+		return spec.BasePrice, true
+	}
+	return *spec.CurrentPrice.BasePrice, true
+}
+
+// buildUpsell 计算套餐默认搭配的单点合计，包含已选商品且比单点便宜时推荐
+func buildUpsell(
+	setMeal *domain.Product,
+	price decimal.Decimal,
+	prices map[uuid.UUID]decimal.Decimal,
+	selected map[uuid.UUID]struct{},
+) *domain.KioskUpsell {
+	upsell := &domain.KioskUpsell{
+		SetMeal:    setMeal,
+		Price:      price,
+		ItemsPrice: decimal.Zero,
+		ProductIDs: make([]uuid.UUID, 0),
+	}
+	for _, group := range setMeal.Groups {
+		if len(group.Details) == 0 {
+			continue
+		}
+		detail := group.Details[0]
+		for _, d := range group.Details {
+			if d.IsDefault {
+				detail = d
+				break
+			}
+		}
+		itemPrice, ok := prices[detail.ProductID]
+		if !ok {
+			// 子商品未在菜单单独售卖时无法比较单点价格
+			return nil
+		}
+		upsell.ItemsPrice = upsell.ItemsPrice.Add(itemPrice.Mul(decimal.NewFromInt(int64(detail.Quantity))))
+		matched := append([]uuid.UUID{detail.ProductID}, detail.OptionalProductIDs...)
+		for _, id := range matched {
+			if _, ok := selected[id]; ok && !slices.Contains(upsell.ProductIDs, id) {
+				upsell.ProductIDs = append(upsell.ProductIDs, id)
+			}
+		}
+	}
+	upsell.Saving = upsell.ItemsPrice.Sub(price)
+	if len(upsell.ProductIDs) == 0 || !upsell.Saving.IsPositive() {
+		return nil
+	}
+	return upsell
+}
@@ -0,0 +1,114 @@
+package kitchenticket
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.KitchenTicketInteractor = (*KitchenTicketInteractor)(nil)
+
+type KitchenTicketInteractor struct {
+	DS     domain.DataStore
+	Pusher domain.Pusher
+}
+
+func NewKitchenTicketInteractor(ds domain.DataStore, pusher domain.Pusher) *KitchenTicketInteractor {
+	return &KitchenTicketInteractor{
+		DS:     ds,
+		Pusher: pusher,
+	}
+}
+
+func (i *KitchenTicketInteractor) ListPending(ctx context.Context, merchantID, storeID, deviceID uuid.UUID) (res []*domain.KitchenTicket, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "KitchenTicketInteractor.ListPending")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if err = verifyStore(ctx, i.DS, merchantID, storeID); err != nil {
+		return nil, err
+	}
+	locale, err := printLocale(ctx, i.DS, storeID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	res, err = i.DS.KitchenTicketRepo().ListByStatus(ctx, storeID, domain.KitchenTicketStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	for _, ticket := range res {
+		ticket.Localize(locale)
+	}
+	return res, nil
+}
+
+func (i *KitchenTicketInteractor) MarkPrinted(ctx context.Context, merchantID, storeID uuid.UUID, ids []uuid.UUID) (n int, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "KitchenTicketInteractor.MarkPrinted")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if err = verifyStore(ctx, i.DS, merchantID, storeID); err != nil {
+		return 0, err
+	}
+	pending, err := i.DS.KitchenTicketRepo().ListByStatus(ctx, storeID, domain.KitchenTicketStatusPending)
+	if err != nil {
+		return 0, err
+	}
+	n, err = i.DS.KitchenTicketRepo().MarkPrinted(ctx, storeID, ids, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	// 按订单通知电子小票页面出品进度变化
+	printed := make(map[uuid.UUID][]uuid.UUID)
+	for _, ticket := range pending {
+		if lo.Contains(ids, ticket.ID) {
+			printed[ticket.OrderID] = append(printed[ticket.OrderID], ticket.ID)
+		}
+	}
+	for orderID, ticketIDs := range printed {
+		i.Pusher.Push(ctx, domain.PushEventKitchenTicketPrinted, domain.KitchenTicketPrintedPush{
+			OrderID:   orderID,
+			TicketIDs: ticketIDs,
+		}, domain.OrderPushTopic(orderID))
+	}
+	return n, nil
+}
+
+func verifyStore(ctx context.Context, ds domain.DataStore, merchantID, storeID uuid.UUID) error {
+	store, err := ds.StoreRepo().FindByID(ctx, storeID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return err
+	}
+	if store.MerchantID != merchantID {
+		return domain.ParamsError(domain.ErrStoreNotExists)
+	}
+	return nil
+}
+
+// printLocale 返回门店打印机的打印语言，未指定打印机时返回空（使用商品原始名称）
+func printLocale(ctx context.Context, ds domain.DataStore, storeID, deviceID uuid.UUID) (string, error) {
+	if deviceID == uuid.Nil {
+		return "", nil
+	}
+	device, err := ds.DeviceRepo().FindByID(ctx, deviceID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return "", domain.ParamsError(domain.ErrDeviceNotExists)
+		}
+		return "", err
+	}
+	if device.StoreID != storeID {
+		return "", domain.ParamsError(domain.ErrDeviceNotExists)
+	}
+	return device.PrintLocale, nil
+}
@@ -0,0 +1,445 @@
+package member
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.MemberAccountInteractor = (*MemberAccountInteractor)(nil)
+
+type MemberAccountInteractor struct {
+	DS           domain.DataStore
+	Seq          domain.DailySequence
+	MutexManager domain.MutexManager
+}
+
+func NewMemberAccountInteractor(
+	ds domain.DataStore,
+	seq domain.DailySequence,
+	mutexManager domain.MutexManager,
+) *MemberAccountInteractor {
+	return &MemberAccountInteractor{
+		DS:           ds,
+		Seq:          seq,
+		MutexManager: mutexManager,
+	}
+}
+
+func (i *MemberAccountInteractor) GetByMemberID(ctx context.Context, merchantID, memberID uuid.UUID) (res *domain.MemberAccount, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberAccountInteractor.GetByMemberID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	member, err := findMember(ctx, i.DS, memberID, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	res, err = i.DS.MemberAccountRepo().FindByMemberID(ctx, member.ID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.NewMemberAccount(member), nil
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// TopUp 充值，本金和赠送金分别入账
+func (i *MemberAccountInteractor) TopUp(ctx context.Context, params domain.MemberAccountTopUpParams) (res *domain.MemberAccountTransaction, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberAccountInteractor.TopUp")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if err = checkStore(ctx, i.DS, params.StoreID, params.MerchantID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	txnNo, err := i.newTransactionNo(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		member, err := findEnabledMember(ctx, ds, params.MemberID, params.MerchantID)
+		if err != nil {
+			return err
+		}
+		account, err := lockAccount(ctx, ds, member)
+		if err != nil {
+			return err
+		}
+
+		txn, err := account.TopUp(params.Amount, params.BonusAmount)
+		if err != nil {
+			return domain.ParamsError(err)
+		}
+		txn.StoreID = params.StoreID
+		txn.TransactionNo = txnNo
+		txn.PaymentMethod = params.PaymentMethod
+		txn.Operator = params.Operator
+		txn.Remark = params.Remark
+		if err = saveTransaction(ctx, ds, account, txn); err != nil {
+			return err
+		}
+		res = txn
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PayOrder 使用储值余额支付订单，同一订单加分布式锁，账户加行级锁防止透支
+func (i *MemberAccountInteractor) PayOrder(ctx context.Context, params domain.MemberAccountPayParams) (res *domain.Order, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberAccountInteractor.PayOrder")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	order, err := findPayOrder(ctx, i.DS, params)
+	if err != nil {
+		return nil, err
+	}
+	mu := i.MutexManager.NewMutex(domain.NewMutexOrderKey(order.OrderNo))
+	if err = mu.Lock(ctx); err != nil {
+		if domain.IsAlreadyTakenError(err) {
+			return nil, domain.ParamsError(domain.ErrMemberAccountPaymentBusy)
+		}
+		return nil, err
+	}
+	defer func() {
+		_, _ = mu.Unlock(ctx)
+	}()
+
+	now := time.Now()
+	txnNo, err := i.newTransactionNo(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		// 加锁后重新查询订单，避免使用锁外读取的已付金额
+		order, err := findPayOrder(ctx, ds, params)
+		if err != nil {
+			return err
+		}
+		memberID := params.MemberID
+		if order.Member != nil && order.Member.ID != uuid.Nil {
+			if memberID != uuid.Nil && memberID != order.Member.ID {
+				return domain.ParamsError(domain.ErrMemberAccountOrderMemberInvalid)
+			}
+			memberID = order.Member.ID
+		}
+		member, err := findEnabledMember(ctx, ds, memberID, params.MerchantID)
+		if err != nil {
+			return err
+		}
+		if params.Amount.GreaterThan(order.AmountUnpaid()) {
+			return domain.ParamsError(domain.ErrMemberAccountPaymentExceeded)
+		}
+
+		account, err := ds.MemberAccountRepo().FindByMemberIDForUpdate(ctx, member.ID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return domain.ParamsError(domain.ErrMemberAccountBalanceNotEnough)
+			}
+			return err
+		}
+		txn, err := account.Pay(params.Amount)
+		if err != nil {
+			return domain.ParamsError(err)
+		}
+		txn.StoreID = order.StoreID
+		txn.TransactionNo = txnNo
+		txn.OrderID = order.ID
+		txn.OrderNo = order.OrderNo
+		txn.Operator = params.Operator
+		if err = saveTransaction(ctx, ds, account, txn); err != nil {
+			return err
+		}
+
+		order.PayByMemberAccount(txn, params.POS, params.Cashier, now)
+		// 仅更新支付信息，不改写订单商品
+		order.OrderProducts = nil
+		if err = ds.OrderRepo().Update(ctx, order); err != nil {
+			return err
+		}
+		res = order
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Adjust 人工调整余额（品牌后台），必须填写调整原因
+func (i *MemberAccountInteractor) Adjust(ctx context.Context, params domain.MemberAccountAdjustParams, user domain.User) (res *domain.MemberAccountTransaction, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberAccountInteractor.Adjust")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	now := time.Now()
+	txnNo, err := i.newTransactionNo(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		member, err := findMember(ctx, ds, params.MemberID, user.GetMerchantID())
+		if err != nil {
+			return err
+		}
+		account, err := lockAccount(ctx, ds, member)
+		if err != nil {
+			return err
+		}
+
+		txn, err := account.Adjust(params.Amount, params.BonusAmount, params.Remark)
+		if err != nil {
+			return domain.ParamsError(err)
+		}
+		txn.StoreID = user.GetStoreID()
+		txn.TransactionNo = txnNo
+		txn.Operator = params.Operator
+		if err = saveTransaction(ctx, ds, account, txn); err != nil {
+			return err
+		}
+		res = txn
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *MemberAccountInteractor) PagedListTransactions(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.MemberAccountTransactionSearchParams,
+) (res *domain.MemberAccountTransactionSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberAccountInteractor.PagedListTransactions")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.MemberAccountTransactionRepo().PagedListBySearch(ctx, page, params)
+}
+
+// LiabilityReport 储值负债报表，同时核对流水合计与账户余额合计
+func (i *MemberAccountInteractor) LiabilityReport(ctx context.Context, user domain.User) (res *domain.MemberAccountLiabilityReport, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberAccountInteractor.LiabilityReport")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	merchantID := user.GetMerchantID()
+	items, err := i.DS.MemberAccountTransactionRepo().LiabilityByStore(ctx, merchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	storeIDs := lo.FilterMap(items, func(item *domain.MemberAccountLiabilityItem, _ int) (uuid.UUID, bool) {
+		return item.StoreID, item.StoreID != uuid.Nil
+	})
+	if len(storeIDs) > 0 {
+		stores, err := i.DS.StoreRepo().ListByIDs(ctx, storeIDs)
+		if err != nil {
+			return nil, err
+		}
+		storeNames := make(map[uuid.UUID]string, len(stores))
+		for _, store := range stores {
+			storeNames[store.ID] = store.StoreName
+		}
+		for _, item := range items {
+			item.StoreName = storeNames[item.StoreID]
+		}
+	}
+
+	res = &domain.MemberAccountLiabilityReport{
+		Items:        items,
+		Balance:      decimal.Zero,
+		BonusBalance: decimal.Zero,
+	}
+	for _, item := range items {
+		res.Balance = res.Balance.Add(item.Balance)
+		res.BonusBalance = res.BonusBalance.Add(item.BonusBalance)
+	}
+	res.AccountBalance, res.AccountBonus, err = i.DS.MemberAccountRepo().SumBalance(ctx, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	res.Reconciled = res.Balance.Equal(res.AccountBalance) && res.BonusBalance.Equal(res.AccountBonus)
+	return res, nil
+}
+
+// RefundByRefundOrder 退款单完成后将储值支付和余额退款部分退回会员储值账户，同一退款单只退回一次
+func RefundByRefundOrder(ctx context.Context, ds domain.DataStore, seq domain.DailySequence, refundOrder *domain.RefundOrder) error {
+	original, balance := refundOrder.MemberAccountRefundAmount()
+	if !original.Add(balance).IsPositive() {
+		return nil
+	}
+	refunded, err := ds.MemberAccountTransactionRepo().ExistsByRefundOrderID(ctx, refundOrder.ID)
+	if err != nil {
+		return err
+	}
+	if refunded {
+		return nil
+	}
+
+	order, err := ds.OrderRepo().FindByID(ctx, refundOrder.OriginOrderID)
+	if err != nil {
+		return err
+	}
+	orderTxns, err := ds.MemberAccountTransactionRepo().ListByOrderID(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+
+	// 原路退回的部分退回到支付时使用的账户
+	memberID := uuid.Nil
+	if len(orderTxns) > 0 {
+		memberID = orderTxns[0].MemberID
+	} else if order.Member != nil {
+		memberID = order.Member.ID
+	}
+	if memberID == uuid.Nil {
+		return domain.ParamsError(domain.ErrMemberAccountRefundNoMember)
+	}
+
+	principal, bonus := decimal.Zero, decimal.Zero
+	if original.IsPositive() {
+		principal, bonus, err = orderTxns.RefundableSplit(original)
+		if err != nil {
+			return domain.ParamsError(err)
+		}
+	}
+	// 其他支付方式退到余额的部分按本金入账
+	principal = principal.Add(balance)
+
+	member, err := findMember(ctx, ds, memberID, order.MerchantID)
+	if err != nil {
+		return err
+	}
+	account, err := lockAccount(ctx, ds, member)
+	if err != nil {
+		return err
+	}
+	txn, err := account.Refund(principal, bonus)
+	if err != nil {
+		return domain.ParamsError(err)
+	}
+	txn.StoreID = refundOrder.StoreID
+	txn.TransactionNo, err = newTransactionNo(ctx, seq, time.Now())
+	if err != nil {
+		return err
+	}
+	txn.OrderID = order.ID
+	txn.OrderNo = order.OrderNo
+	txn.RefundOrderID = refundOrder.ID
+	txn.Operator = domain.OrderOperator{ID: refundOrder.RefundedBy, Name: refundOrder.RefundedByName}
+	txn.Remark = refundOrder.RefundNo
+	return saveTransaction(ctx, ds, account, txn)
+}
+
+func (i *MemberAccountInteractor) newTransactionNo(ctx context.Context, now time.Time) (string, error) {
+	return newTransactionNo(ctx, i.Seq, now)
+}
+
+// newTransactionNo 生成储值流水号：SV + YYYYMMDD + 8位当日序号
+func newTransactionNo(ctx context.Context, seq domain.DailySequence, now time.Time) (string, error) {
+	n, err := seq.Next(ctx, domain.DailySequencePrefixMemberAccountTxnNo)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate member account transaction number: %w", err)
+	}
+	return fmt.Sprintf("SV%s%08d", now.Format("20060102"), n), nil
+}
+
+// ============================================
+// 校验函数
+// ============================================
+
+// lockAccount 锁定会员储值账户，未开户时开立新账户
+func lockAccount(ctx context.Context, ds domain.DataStore, member *domain.Member) (*domain.MemberAccount, error) {
+	account, err := ds.MemberAccountRepo().FindByMemberIDForUpdate(ctx, member.ID)
+	if err == nil {
+		return account, nil
+	}
+	if !domain.IsNotFound(err) {
+		return nil, err
+	}
+
+	account = domain.NewMemberAccount(member)
+	if err = ds.MemberAccountRepo().Create(ctx, account); err != nil {
+		// 并发开户时使用已开立的账户
+		if domain.IsConflict(err) {
+			return ds.MemberAccountRepo().FindByMemberIDForUpdate(ctx, member.ID)
+		}
+		return nil, err
+	}
+	return account, nil
+}
+
+// saveTransaction 保存账户余额并追加流水
+func saveTransaction(ctx context.Context, ds domain.DataStore, account *domain.MemberAccount, txn *domain.MemberAccountTransaction) error {
+	if err := ds.MemberAccountRepo().UpdateBalance(ctx, account); err != nil {
+		return err
+	}
+	return ds.MemberAccountTransactionRepo().Create(ctx, txn)
+}
+
+func findEnabledMember(ctx context.Context, ds domain.DataStore, id, merchantID uuid.UUID) (*domain.Member, error) {
+	member, err := findMember(ctx, ds, id, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Enabled {
+		return nil, domain.ParamsError(domain.ErrMemberDisabled)
+	}
+	return member, nil
+}
+
+func findPayOrder(ctx context.Context, ds domain.DataStore, params domain.MemberAccountPayParams) (*domain.Order, error) {
+	order, err := ds.OrderRepo().FindByID(ctx, params.OrderID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrMemberAccountOrderNotExists)
+		}
+		return nil, err
+	}
+	if order.MerchantID != params.MerchantID || order.StoreID != params.StoreID {
+		return nil, domain.ParamsError(domain.ErrMemberAccountOrderNotExists)
+	}
+	switch order.PaymentStatus {
+	case domain.PaymentStatusPaid, domain.PaymentStatusRefunded:
+		return nil, domain.ParamsError(domain.ErrMemberAccountOrderPaid)
+	}
+	return order, nil
+}
+
+func checkStore(ctx context.Context, ds domain.DataStore, storeID, merchantID uuid.UUID) error {
+	store, err := ds.StoreRepo().FindByID(ctx, storeID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return err
+	}
+	if store.MerchantID != merchantID {
+		return domain.ParamsError(domain.ErrStoreNotExists)
+	}
+	return nil
+}
@@ -0,0 +1,324 @@
+package member
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/promotion"
+)
+
+var _ domain.MemberInteractor = (*MemberInteractor)(nil)
+
+type MemberInteractor struct {
+	DS domain.DataStore
+}
+
+func NewMemberInteractor(ds domain.DataStore) *MemberInteractor {
+	return &MemberInteractor{
+		DS: ds,
+	}
+}
+
+func (i *MemberInteractor) Create(ctx context.Context, member *domain.Member, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberInteractor.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	member.MerchantID = user.GetMerchantID()
+	if err = member.Validate(); err != nil {
+		return domain.ParamsError(err)
+	}
+	if err = member.GenerateCode(); err != nil {
+		return err
+	}
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		if err := validateBusinessRules(ctx, ds, member, uuid.Nil); err != nil {
+			return err
+		}
+		if err := ds.MemberRepo().Create(ctx, member); err != nil {
+			if domain.IsConflict(err) {
+				return domain.ParamsError(domain.ErrMemberPhoneExists)
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+func (i *MemberInteractor) Update(ctx context.Context, member *domain.Member, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberInteractor.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if err = member.Validate(); err != nil {
+		return domain.ParamsError(err)
+	}
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		existing, err := findMember(ctx, ds, member.ID, user.GetMerchantID())
+		if err != nil {
+			return err
+		}
+		member.MerchantID = existing.MerchantID
+		member.Code = existing.Code
+		if err = validateBusinessRules(ctx, ds, member, member.ID); err != nil {
+			return err
+		}
+		if err = ds.MemberRepo().Update(ctx, member); err != nil {
+			if domain.IsConflict(err) {
+				return domain.ParamsError(domain.ErrMemberPhoneExists)
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+func (i *MemberInteractor) Delete(ctx context.Context, id uuid.UUID, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberInteractor.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		if _, err := findMember(ctx, ds, id, user.GetMerchantID()); err != nil {
+			return err
+		}
+		return ds.MemberRepo().Delete(ctx, id)
+	})
+}
+
+func (i *MemberInteractor) GetDetail(ctx context.Context, id uuid.UUID, user domain.User) (res *domain.Member, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberInteractor.GetDetail")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return findMember(ctx, i.DS, id, user.GetMerchantID())
+}
+
+func (i *MemberInteractor) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.MemberSearchParams,
+) (res *domain.MemberSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberInteractor.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.MemberRepo().PagedListBySearch(ctx, page, params)
+}
+
+// Lookup 按手机号或会员码查找会员，同时提供时优先使用会员码
+func (i *MemberInteractor) Lookup(ctx context.Context, params domain.MemberLookupParams) (res *domain.Member, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberInteractor.Lookup")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	switch {
+	case params.Code != "":
+		res, err = i.DS.MemberRepo().FindByCode(ctx, params.MerchantID, params.Code)
+	case params.Phone != "":
+		res, err = i.DS.MemberRepo().FindByPhone(ctx, params.MerchantID, params.Phone)
+	default:
+		return nil, domain.ParamsError(domain.ErrMemberLookupKeyRequired)
+	}
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrMemberNotExists)
+		}
+		return nil, err
+	}
+	if !res.Enabled {
+		return nil, domain.ParamsError(domain.ErrMemberDisabled)
+	}
+	return res, nil
+}
+
+// LookupByCustomer 查找小程序客户在品牌商下的会员
+//
+// 优先按 openid 查找；尚未关联时按客户已绑定的手机号查找，并将 openid 关联到该会员。
+func (i *MemberInteractor) LookupByCustomer(ctx context.Context, merchantID uuid.UUID, customer *domain.Customer) (res *domain.Member, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberInteractor.LookupByCustomer")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		member, err := ds.MemberRepo().FindByOpenID(ctx, merchantID, customer.OpenID)
+		if err == nil {
+			res = member
+			return nil
+		}
+		if !domain.IsNotFound(err) {
+			return err
+		}
+		if customer.Phone == "" {
+			return domain.ParamsError(domain.ErrMemberNotExists)
+		}
+
+		member, err = ds.MemberRepo().FindByPhone(ctx, merchantID, customer.Phone)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return domain.ParamsError(domain.ErrMemberNotExists)
+			}
+			return err
+		}
+		if member.OpenID != "" {
+			return domain.ParamsError(domain.ErrMemberWechatBound)
+		}
+		member.OpenID = customer.OpenID
+		if err := ds.MemberRepo().Update(ctx, member); err != nil {
+			return err
+		}
+		res = member
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !res.Enabled {
+		return nil, domain.ParamsError(domain.ErrMemberDisabled)
+	}
+	return res, nil
+}
+
+// AttachToOrder 为未支付订单关联会员，按会员价重新计算会员优惠和促销优惠
+func (i *MemberInteractor) AttachToOrder(ctx context.Context, params domain.MemberAttachParams) (res *domain.Order, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberInteractor.AttachToOrder")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		order, err := ds.OrderRepo().FindByID(ctx, params.OrderID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return domain.ParamsError(domain.ErrMemberOrderNotExists)
+			}
+			return err
+		}
+		if order.MerchantID != params.MerchantID || order.StoreID != params.StoreID {
+			return domain.ParamsError(domain.ErrMemberOrderNotExists)
+		}
+		if order.PaymentStatus != "" && order.PaymentStatus != domain.PaymentStatusUnpaid {
+			return domain.ParamsError(domain.ErrMemberOrderPaid)
+		}
+
+		order.Member = &domain.OrderMember{ID: params.MemberID}
+		if err = ApplyToOrder(ctx, ds, order); err != nil {
+			return err
+		}
+		// 会员价改变了商品金额，重新计算促销优惠
+		if err = promotion.ApplyToOrder(ctx, ds, order); err != nil {
+			return err
+		}
+		if err = ds.OrderRepo().Update(ctx, order); err != nil {
+			return err
+		}
+		res = order
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListOrders 查询会员消费记录，按下单时间倒序
+func (i *MemberInteractor) ListOrders(
+	ctx context.Context,
+	page *upagination.Pagination,
+	id uuid.UUID,
+	user domain.User,
+) (res *domain.MemberOrderSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberInteractor.ListOrders")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	member, err := findMember(ctx, i.DS, id, user.GetMerchantID())
+	if err != nil {
+		return nil, err
+	}
+
+	orders, total, err := i.DS.OrderRepo().List(ctx, domain.OrderListParams{
+		MerchantID: member.MerchantID,
+		MemberID:   member.ID,
+		Page:       page.Page,
+		Size:       page.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page.SetTotal(total)
+	return &domain.MemberOrderSearchRes{
+		Pagination: page,
+		Items:      orders,
+	}, nil
+}
+
+// ============================================
+// 校验函数
+// ============================================
+
+func findMember(ctx context.Context, ds domain.DataStore, id, merchantID uuid.UUID) (*domain.Member, error) {
+	member, err := ds.MemberRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrMemberNotExists)
+		}
+		return nil, err
+	}
+	if member.MerchantID != merchantID {
+		return nil, domain.ParamsError(domain.ErrMemberNotExists)
+	}
+	return member, nil
+}
+
+func validateBusinessRules(ctx context.Context, ds domain.DataStore, member *domain.Member, excludeID uuid.UUID) error {
+	// 1. 检查手机号是否已注册
+	exists, err := ds.MemberRepo().Exists(ctx, domain.MemberExistsParams{
+		MerchantID: member.MerchantID,
+		Phone:      member.Phone,
+		ExcludeID:  excludeID,
+	})
+	if err != nil {
+		return err
+	}
+	if exists {
+		return domain.ParamsError(domain.ErrMemberPhoneExists)
+	}
+
+	// 2. 检查微信是否已绑定其他会员
+	if member.OpenID != "" {
+		exists, err = ds.MemberRepo().Exists(ctx, domain.MemberExistsParams{
+			MerchantID: member.MerchantID,
+			OpenID:     member.OpenID,
+			ExcludeID:  excludeID,
+		})
+		if err != nil {
+			return err
+		}
+		if exists {
+			return domain.ParamsError(domain.ErrMemberOpenIDExists)
+		}
+	}
+
+	// 3. 检查会员等级是否属于当前品牌商
+	if member.TierID != uuid.Nil {
+		if _, err = findTier(ctx, ds, member.TierID, member.MerchantID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,582 @@
+package member
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+// expireBatchSize 积分过期每批处理的会员数
+const expireBatchSize = 100
+
+var _ domain.MemberPointsInteractor = (*MemberPointsInteractor)(nil)
+
+type MemberPointsInteractor struct {
+	DS           domain.DataStore
+	MutexManager domain.MutexManager
+}
+
+func NewMemberPointsInteractor(ds domain.DataStore, mutexManager domain.MutexManager) *MemberPointsInteractor {
+	return &MemberPointsInteractor{
+		DS:           ds,
+		MutexManager: mutexManager,
+	}
+}
+
+func (i *MemberPointsInteractor) GetRule(ctx context.Context, user domain.User) (res *domain.MemberPointsRule, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberPointsInteractor.GetRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	res, err = i.DS.MemberPointsRuleRepo().FindByMerchantID(ctx, user.GetMerchantID())
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return &domain.MemberPointsRule{
+				MerchantID:       user.GetMerchantID(),
+				EarnRate:         decimal.Zero,
+				RedeemMaxPercent: decimal.Zero,
+			}, nil
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// SaveRule 保存积分规则，品牌商首次保存时创建
+func (i *MemberPointsInteractor) SaveRule(ctx context.Context, rule *domain.MemberPointsRule, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberPointsInteractor.SaveRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	rule.MerchantID = user.GetMerchantID()
+	if err = rule.Validate(); err != nil {
+		return domain.ParamsError(err)
+	}
+	if err = i.checkRuleRefs(ctx, rule); err != nil {
+		return err
+	}
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		existing, err := ds.MemberPointsRuleRepo().FindByMerchantID(ctx, rule.MerchantID)
+		if err != nil {
+			if !domain.IsNotFound(err) {
+				return err
+			}
+			rule.ID = uuid.New()
+			return ds.MemberPointsRuleRepo().Create(ctx, rule)
+		}
+		rule.ID = existing.ID
+		return ds.MemberPointsRuleRepo().Update(ctx, rule)
+	})
+}
+
+func (i *MemberPointsInteractor) GetByMemberID(ctx context.Context, merchantID, memberID uuid.UUID) (res *domain.MemberPointsAccount, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberPointsInteractor.GetByMemberID")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	member, err := findMember(ctx, i.DS, memberID, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	res, err = i.DS.MemberPointsAccountRepo().FindByMemberID(ctx, member.ID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.NewMemberPointsAccount(member), nil
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// Redeem 使用积分抵扣订单金额或兑换订单中的商品，与储值支付共用订单锁，账户加行级锁防止超用
+func (i *MemberPointsInteractor) Redeem(ctx context.Context, params domain.MemberPointsRedeemParams) (res *domain.MemberPointsTransaction, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberPointsInteractor.Redeem")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	order, err := findRedeemPointsOrder(ctx, i.DS, params)
+	if err != nil {
+		return nil, err
+	}
+	mu := i.MutexManager.NewMutex(domain.NewMutexOrderKey(order.OrderNo))
+	if err = mu.Lock(ctx); err != nil {
+		if domain.IsAlreadyTakenError(err) {
+			return nil, domain.ParamsError(domain.ErrMemberPointsRedeemBusy)
+		}
+		return nil, err
+	}
+	defer func() {
+		_, _ = mu.Unlock(ctx)
+	}()
+
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		now := time.Now()
+		// 加锁后重新查询订单，避免使用锁外读取的订单状态
+		order, err := findRedeemPointsOrder(ctx, ds, params)
+		if err != nil {
+			return err
+		}
+		member, err := findEnabledMember(ctx, ds, order.Member.ID, params.MerchantID)
+		if err != nil {
+			return err
+		}
+		rule, err := ds.MemberPointsRuleRepo().FindByMerchantID(ctx, params.MerchantID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return domain.ParamsError(domain.ErrMemberPointsRedeemDisabled)
+			}
+			return err
+		}
+		orderTxns, err := ds.MemberPointsTransactionRepo().ListByOrderID(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		summary := orderTxns.OrderSummary()
+
+		points, discount := params.Points, decimal.Zero
+		switch params.Type {
+		case domain.MemberPointsRedeemTypeDiscount:
+			discount, err = rule.DiscountForPoints(order, points, summary.RedeemedAmount)
+		case domain.MemberPointsRedeemTypeProduct:
+			points, discount, err = rule.ProductForPoints(order, params.ProductID, summary.RedeemedProducts[params.ProductID])
+		default:
+			return domain.ParamsError(domain.ErrMemberPointsRedeemDisabled)
+		}
+		if err != nil {
+			return domain.ParamsError(err)
+		}
+
+		account, err := lockPointsAccount(ctx, ds, member)
+		if err != nil {
+			return err
+		}
+		credits, err := ds.MemberPointsTransactionRepo().ListAvailable(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+		txn, changed, err := account.Debit(domain.MemberPointsTransactionTypeRedeem, points, credits)
+		if err != nil {
+			return domain.ParamsError(err)
+		}
+		txn.StoreID = order.StoreID
+		txn.Amount = discount
+		txn.OrderID = order.ID
+		txn.OrderNo = order.OrderNo
+		if params.Type == domain.MemberPointsRedeemTypeProduct {
+			txn.ProductID = params.ProductID
+		}
+		txn.Operator = params.Operator
+		if err = savePointsTransaction(ctx, ds, account, txn, changed); err != nil {
+			return err
+		}
+
+		// 仅记录操作日志，不改写订单商品
+		order.AddPointsLog(params.Operator, txn, now)
+		order.OrderProducts = nil
+		if err = ds.OrderRepo().Update(ctx, order); err != nil {
+			return err
+		}
+		res = txn
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Adjust 人工调整积分（品牌后台），必须填写调整原因
+func (i *MemberPointsInteractor) Adjust(ctx context.Context, params domain.MemberPointsAdjustParams, user domain.User) (res *domain.MemberPointsTransaction, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberPointsInteractor.Adjust")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		member, err := findMember(ctx, ds, params.MemberID, user.GetMerchantID())
+		if err != nil {
+			return err
+		}
+		rule, err := findPointsRule(ctx, ds, member.MerchantID)
+		if err != nil {
+			return err
+		}
+		account, err := lockPointsAccount(ctx, ds, member)
+		if err != nil {
+			return err
+		}
+		credits, err := ds.MemberPointsTransactionRepo().ListAvailable(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		txn, changed, err := account.Adjust(params.Points, params.Remark, credits, rule.ExpiresAt(time.Now()))
+		if err != nil {
+			return domain.ParamsError(err)
+		}
+		txn.StoreID = user.GetStoreID()
+		txn.Operator = params.Operator
+		if err = savePointsTransaction(ctx, ds, account, txn, changed); err != nil {
+			return err
+		}
+		res = txn
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *MemberPointsInteractor) PagedListTransactions(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.MemberPointsTransactionSearchParams,
+) (res *domain.MemberPointsTransactionSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberPointsInteractor.PagedListTransactions")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.MemberPointsTransactionRepo().PagedListBySearch(ctx, page, params)
+}
+
+// ExpireDue 分批处理已到过期时间的入账积分，每个会员在独立事务中处理
+func (i *MemberPointsInteractor) ExpireDue(ctx context.Context) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberPointsInteractor.ExpireDue")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	now := time.Now()
+	for {
+		memberIDs, err := i.DS.MemberPointsTransactionRepo().ListExpiredMemberIDs(ctx, now, expireBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, memberID := range memberIDs {
+			err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+				return expireMemberPoints(ctx, ds, memberID, now)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(memberIDs) < expireBatchSize {
+			return nil
+		}
+	}
+}
+
+// EarnByOrder 订单完成后按积分规则为关联会员发放积分，同一订单只发放一次
+func EarnByOrder(ctx context.Context, ds domain.DataStore, orderID uuid.UUID) error {
+	order, err := ds.OrderRepo().FindByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if order.OrderStatus != domain.OrderStatusCompleted ||
+		order.PaymentStatus != domain.PaymentStatusPaid ||
+		order.Member == nil || order.Member.ID == uuid.Nil {
+		return nil
+	}
+	rule, err := ds.MemberPointsRuleRepo().FindByMerchantID(ctx, order.MerchantID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !rule.Enabled {
+		return nil
+	}
+	member, err := findMember(ctx, ds, order.Member.ID, order.MerchantID)
+	if err != nil {
+		return err
+	}
+	if !member.Enabled {
+		return nil
+	}
+
+	// 先锁定账户再检查是否已发放，防止重复完成订单时并发发放
+	account, err := lockPointsAccount(ctx, ds, member)
+	if err != nil {
+		return err
+	}
+	orderTxns, err := ds.MemberPointsTransactionRepo().ListByOrderID(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	summary := orderTxns.OrderSummary()
+	if summary.Earn != nil {
+		return nil
+	}
+
+	// 实际消费金额不含找零和积分抵扣部分
+	spend := decimal.Min(order.Amount.AmountPaid.Sub(order.Amount.ChangeAmount), order.Amount.AmountDue)
+	for _, txn := range orderTxns {
+		if txn.Type == domain.MemberPointsTransactionTypeRedeem {
+			spend = spend.Sub(txn.Amount)
+		}
+	}
+	points := rule.CalculateEarn(order, member.TierID, spend)
+	if points <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	txn, err := account.Credit(domain.MemberPointsTransactionTypeEarn, points, rule.ExpiresAt(now))
+	if err != nil {
+		return err
+	}
+	txn.StoreID = order.StoreID
+	txn.Amount = spend
+	txn.OrderID = order.ID
+	txn.OrderNo = order.OrderNo
+	return savePointsTransaction(ctx, ds, account, txn, nil)
+}
+
+// ReverseByRefundOrder 退款单完成后扣回订单获得的积分，全额退款时退回订单使用的积分，同一退款单只处理一次
+//
+// 扣回时优先扣减该订单获得积分的剩余部分，会员积分余额不足时只扣到零。
+func ReverseByRefundOrder(ctx context.Context, ds domain.DataStore, refundOrder *domain.RefundOrder) error {
+	orderTxns, err := ds.MemberPointsTransactionRepo().ListByOrderID(ctx, refundOrder.OriginOrderID)
+	if err != nil {
+		return err
+	}
+	if len(orderTxns) == 0 {
+		return nil
+	}
+	processed, err := ds.MemberPointsTransactionRepo().ExistsByRefundOrderID(ctx, refundOrder.ID)
+	if err != nil {
+		return err
+	}
+	if processed {
+		return nil
+	}
+
+	member, err := findMember(ctx, ds, orderTxns[0].MemberID, orderTxns[0].MerchantID)
+	if err != nil {
+		return err
+	}
+	account, err := lockPointsAccount(ctx, ds, member)
+	if err != nil {
+		return err
+	}
+	// 加锁后重新汇总，防止并发退款重复扣回
+	orderTxns, err = ds.MemberPointsTransactionRepo().ListByOrderID(ctx, refundOrder.OriginOrderID)
+	if err != nil {
+		return err
+	}
+	summary := orderTxns.OrderSummary()
+	operator := domain.OrderOperator{ID: refundOrder.RefundedBy, Name: refundOrder.RefundedByName}
+	fill := func(txn *domain.MemberPointsTransaction) {
+		txn.StoreID = refundOrder.StoreID
+		txn.OrderID = refundOrder.OriginOrderID
+		txn.OrderNo = refundOrder.OriginOrderNo
+		txn.RefundOrderID = refundOrder.ID
+		txn.Operator = operator
+		txn.Remark = refundOrder.RefundNo
+	}
+
+	if points := min(summary.ReversePoints(refundOrder), account.Balance); points > 0 {
+		credits, err := ds.MemberPointsTransactionRepo().ListAvailable(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+		credits = prioritizeCredit(credits, summary.Earn.ID)
+		txn, changed, err := account.Debit(domain.MemberPointsTransactionTypeReverse, points, credits)
+		if err != nil {
+			return err
+		}
+		fill(txn)
+		if err = savePointsTransaction(ctx, ds, account, txn, changed); err != nil {
+			return err
+		}
+	}
+
+	if refundOrder.RefundType != domain.RefundTypeFull {
+		return nil
+	}
+	if points := summary.Redeemed - summary.Restored; points > 0 {
+		rule, err := findPointsRule(ctx, ds, member.MerchantID)
+		if err != nil {
+			return err
+		}
+		txn, err := account.Credit(domain.MemberPointsTransactionTypeRestore, points, rule.ExpiresAt(time.Now()))
+		if err != nil {
+			return err
+		}
+		fill(txn)
+		if err = savePointsTransaction(ctx, ds, account, txn, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expireMemberPoints 扣减会员已过期入账流水的剩余积分，每条入账流水生成一条过期流水
+func expireMemberPoints(ctx context.Context, ds domain.DataStore, memberID uuid.UUID, now time.Time) error {
+	account, err := ds.MemberPointsAccountRepo().FindByMemberIDForUpdate(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	credits, err := ds.MemberPointsTransactionRepo().ListAvailable(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+	for _, credit := range credits {
+		if credit.ExpiresAt == nil || credit.ExpiresAt.After(now) {
+			continue
+		}
+		txn := account.Expire(credit)
+		txn.OrderID = credit.OrderID
+		txn.OrderNo = credit.OrderNo
+		if err = savePointsTransaction(ctx, ds, account, txn, domain.MemberPointsTransactions{credit}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prioritizeCredit 将指定入账流水排到最前面优先扣减
+func prioritizeCredit(credits domain.MemberPointsTransactions, id uuid.UUID) domain.MemberPointsTransactions {
+	res := make(domain.MemberPointsTransactions, 0, len(credits))
+	for _, credit := range credits {
+		if credit.ID == id {
+			res = append(res, credit)
+		}
+	}
+	for _, credit := range credits {
+		if credit.ID != id {
+			res = append(res, credit)
+		}
+	}
+	return res
+}
+
+// lockPointsAccount 锁定会员积分账户，未开户时开立新账户
+func lockPointsAccount(ctx context.Context, ds domain.DataStore, member *domain.Member) (*domain.MemberPointsAccount, error) {
+	account, err := ds.MemberPointsAccountRepo().FindByMemberIDForUpdate(ctx, member.ID)
+	if err == nil {
+		return account, nil
+	}
+	if !domain.IsNotFound(err) {
+		return nil, err
+	}
+
+	account = domain.NewMemberPointsAccount(member)
+	if err = ds.MemberPointsAccountRepo().Create(ctx, account); err != nil {
+		// 并发开户时使用已开立的账户
+		if domain.IsConflict(err) {
+			return ds.MemberPointsAccountRepo().FindByMemberIDForUpdate(ctx, member.ID)
+		}
+		return nil, err
+	}
+	return account, nil
+}
+
+// savePointsTransaction 保存积分余额、追加流水并更新被扣减的入账流水
+func savePointsTransaction(
+	ctx context.Context,
+	ds domain.DataStore,
+	account *domain.MemberPointsAccount,
+	txn *domain.MemberPointsTransaction,
+	changed domain.MemberPointsTransactions,
+) error {
+	if err := ds.MemberPointsAccountRepo().UpdateBalance(ctx, account); err != nil {
+		return err
+	}
+	for _, credit := range changed {
+		if err := ds.MemberPointsTransactionRepo().UpdateRemaining(ctx, credit); err != nil {
+			return err
+		}
+	}
+	return ds.MemberPointsTransactionRepo().Create(ctx, txn)
+}
+
+// findPointsRule 查询积分规则，未设置时返回 nil（积分永久有效）
+func findPointsRule(ctx context.Context, ds domain.DataStore, merchantID uuid.UUID) (*domain.MemberPointsRule, error) {
+	rule, err := ds.MemberPointsRuleRepo().FindByMerchantID(ctx, merchantID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+func findRedeemPointsOrder(ctx context.Context, ds domain.DataStore, params domain.MemberPointsRedeemParams) (*domain.Order, error) {
+	order, err := ds.OrderRepo().FindByID(ctx, params.OrderID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrMemberPointsOrderNotExists)
+		}
+		return nil, err
+	}
+	if order.MerchantID != params.MerchantID || order.StoreID != params.StoreID {
+		return nil, domain.ParamsError(domain.ErrMemberPointsOrderNotExists)
+	}
+	switch order.PaymentStatus {
+	case domain.PaymentStatusPaid, domain.PaymentStatusRefunded:
+		return nil, domain.ParamsError(domain.ErrMemberPointsOrderPaid)
+	}
+	if order.Member == nil || order.Member.ID == uuid.Nil {
+		return nil, domain.ParamsError(domain.ErrMemberPointsOrderNoMember)
+	}
+	return order, nil
+}
+
+// checkRuleRefs 校验规则引用的会员等级和兑换商品属于当前品牌商，并补全兑换商品名称
+func (i *MemberPointsInteractor) checkRuleRefs(ctx context.Context, rule *domain.MemberPointsRule) error {
+	if len(rule.TierMultipliers) > 0 {
+		tiers, err := i.DS.MemberTierRepo().ListByMerchantID(ctx, rule.MerchantID)
+		if err != nil {
+			return err
+		}
+		tierIDs := make(map[uuid.UUID]struct{}, len(tiers))
+		for _, tier := range tiers {
+			tierIDs[tier.ID] = struct{}{}
+		}
+		for _, tm := range rule.TierMultipliers {
+			if _, ok := tierIDs[tm.TierID]; !ok {
+				return domain.ParamsError(domain.ErrMemberTierNotExists)
+			}
+		}
+	}
+
+	if len(rule.RedeemProducts) > 0 {
+		ids := make([]uuid.UUID, 0, len(rule.RedeemProducts))
+		for _, p := range rule.RedeemProducts {
+			ids = append(ids, p.ProductID)
+		}
+		products, err := i.DS.ProductRepo().ListByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		names := make(map[uuid.UUID]string, len(products))
+		for _, p := range products {
+			if p.MerchantID == rule.MerchantID {
+				names[p.ID] = p.Name
+			}
+		}
+		for k := range rule.RedeemProducts {
+			name, ok := names[rule.RedeemProducts[k].ProductID]
+			if !ok {
+				return domain.ParamsError(domain.ErrMemberPointsRuleProductNotExists)
+			}
+			rule.RedeemProducts[k].ProductName = name
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package member
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// ApplyToOrder 按订单关联会员计算会员价优惠，未关联会员或会员等级不享受会员价时清除会员优惠
+//
+// 会员价优先级：门店菜单项会员价（含时段价） > 商品规格会员价（含时段价）
+func ApplyToOrder(ctx context.Context, ds domain.DataStore, order *domain.Order) error {
+	if order.Member == nil || order.Member.ID == uuid.Nil {
+		order.Member = nil
+		order.ApplyMemberPrices(nil)
+		return nil
+	}
+
+	member, err := findMember(ctx, ds, order.Member.ID, order.MerchantID)
+	if err != nil {
+		return err
+	}
+	if !member.Enabled {
+		return domain.ParamsError(domain.ErrMemberDisabled)
+	}
+	order.Member = member.ToOrderMember()
+	if !member.MemberPriceEnabled() {
+		order.ApplyMemberPrices(nil)
+		return nil
+	}
+
+	prices, err := resolveMemberPrices(ctx, ds, order)
+	if err != nil {
+		return err
+	}
+	order.ApplyMemberPrices(prices)
+	return nil
+}
+
+// resolveMemberPrices 查询订单商品在下单时间生效的会员单价，键为订单商品下标
+func resolveMemberPrices(ctx context.Context, ds domain.DataStore, order *domain.Order) (map[int]decimal.Decimal, error) {
+	store, err := ds.StoreRepo().FindByID(ctx, order.StoreID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return nil, err
+	}
+	at := order.PlacedAt
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	menuItems := make(map[uuid.UUID]domain.MenuItems)
+	prices := make(map[int]decimal.Decimal)
+	for i := range order.OrderProducts {
+		op := &order.OrderProducts[i]
+		if op.IsGift {
+			continue
+		}
+
+		// 1. 门店菜单项会员价
+		items, ok := menuItems[op.ProductID]
+		if !ok {
+			items, err = ds.MenuRepo().ListStoreMenuItemsByProductID(ctx, order.StoreID, op.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			menuItems[op.ProductID] = items
+		}
+		if len(items) > 0 {
+			item := items[0]
+			cp := domain.ResolvePrice(item.BasePrice, item.MemberPrice, item.PeriodPrices, store.DiningPeriods, at)
+			if cp.MemberPrice != nil {
+				prices[i] = *cp.MemberPrice
+				continue
+			}
+		}
+
+		// 2. 商品规格会员价
+		if len(op.SpecRelations) == 0 || op.SpecRelations[0] == nil {
+			continue
+		}
+		rel, err := ds.ProductSpecRelRepo().FindByProductAndSpec(ctx, op.ProductID, op.SpecRelations[0].SpecID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		basePrice := rel.BasePrice
+		cp := domain.ResolvePrice(&basePrice, rel.MemberPrice, rel.PeriodPrices, store.DiningPeriods, at)
+		if cp.MemberPrice != nil {
+			prices[i] = *cp.MemberPrice
+		}
+	}
+	return prices, nil
+}
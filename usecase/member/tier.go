@@ -0,0 +1,123 @@
+package member
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.MemberTierInteractor = (*MemberTierInteractor)(nil)
+
+type MemberTierInteractor struct {
+	DS domain.DataStore
+}
+
+func NewMemberTierInteractor(ds domain.DataStore) *MemberTierInteractor {
+	return &MemberTierInteractor{
+		DS: ds,
+	}
+}
+
+func (i *MemberTierInteractor) Create(ctx context.Context, tier *domain.MemberTier, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberTierInteractor.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	tier.MerchantID = user.GetMerchantID()
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		if err := checkTierNameUnique(ctx, ds, tier, uuid.Nil); err != nil {
+			return err
+		}
+		return ds.MemberTierRepo().Create(ctx, tier)
+	})
+}
+
+func (i *MemberTierInteractor) Update(ctx context.Context, tier *domain.MemberTier, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberTierInteractor.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		existing, err := findTier(ctx, ds, tier.ID, user.GetMerchantID())
+		if err != nil {
+			return err
+		}
+		tier.MerchantID = existing.MerchantID
+		if err = checkTierNameUnique(ctx, ds, tier, tier.ID); err != nil {
+			return err
+		}
+		return ds.MemberTierRepo().Update(ctx, tier)
+	})
+}
+
+func (i *MemberTierInteractor) Delete(ctx context.Context, id uuid.UUID, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberTierInteractor.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		tier, err := findTier(ctx, ds, id, user.GetMerchantID())
+		if err != nil {
+			return err
+		}
+		// 等级下有会员时不能删除
+		inUse, err := ds.MemberRepo().Exists(ctx, domain.MemberExistsParams{
+			MerchantID: tier.MerchantID,
+			TierID:     tier.ID,
+		})
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return domain.ParamsError(domain.ErrMemberTierInUse)
+		}
+		return ds.MemberTierRepo().Delete(ctx, id)
+	})
+}
+
+func (i *MemberTierInteractor) List(ctx context.Context, user domain.User) (res domain.MemberTiers, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MemberTierInteractor.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.MemberTierRepo().ListByMerchantID(ctx, user.GetMerchantID())
+}
+
+// ============================================
+// 校验函数
+// ============================================
+
+func findTier(ctx context.Context, ds domain.DataStore, id, merchantID uuid.UUID) (*domain.MemberTier, error) {
+	tier, err := ds.MemberTierRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrMemberTierNotExists)
+		}
+		return nil, err
+	}
+	if tier.MerchantID != merchantID {
+		return nil, domain.ParamsError(domain.ErrMemberTierNotExists)
+	}
+	return tier, nil
+}
+
+func checkTierNameUnique(ctx context.Context, ds domain.DataStore, tier *domain.MemberTier, excludeID uuid.UUID) error {
+	exists, err := ds.MemberTierRepo().Exists(ctx, domain.MemberTierExistsParams{
+		MerchantID: tier.MerchantID,
+		Name:       tier.Name,
+		ExcludeID:  excludeID,
+	})
+	if err != nil {
+		return err
+	}
+	if exists {
+		return domain.ParamsError(domain.ErrMemberTierNameExists)
+	}
+	return nil
+}
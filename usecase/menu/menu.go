@@ -3,12 +3,14 @@ package menu
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
 )
 
 var _ domain.MenuInteractor = (*MenuInteractor)(nil)
@@ -38,14 +40,31 @@ func (i *MenuInteractor) Create(ctx context.Context, menu *domain.Menu, user dom
 
 	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
 		// 业务规则校验
-		if err = validateMenuBusinessRules(ctx, ds, menu, user, uuid.Nil); err != nil {
+		if err = validateMenuBusinessRules(ctx, ds, menu, uuid.Nil); err != nil {
 			return err
 		}
 		// 创建菜单
-		return ds.MenuRepo().Create(ctx, menu)
+		if err = ds.MenuRepo().Create(ctx, menu); err != nil {
+			return err
+		}
+		// 新建菜单直接作为第一个发布版本
+		operator := productversion.OperatorFromUser(user)
+		now := time.Now()
+		published := domain.NewMenuVersion(menu, 1, domain.MenuVersionStatusPublished, operator)
+		published.PublishedAt = &now
+		if err = ds.MenuVersionRepo().Create(ctx, published); err != nil {
+			return err
+		}
+		// 记录菜单项历史版本
+		after, err := ds.MenuRepo().GetDetail(ctx, menu.ID)
+		if err != nil {
+			return err
+		}
+		return productversion.RecordMenuItems(ctx, ds, nil, after, operator)
 	})
 }
 
+// Update 保存菜单草稿，门店菜单在草稿发布后才会变更
 func (i *MenuInteractor) Update(ctx context.Context, menu *domain.Menu, user domain.User) (err error) {
 	span, ctx := util.StartSpan(ctx, "usecase", "MenuInteractor.Update")
 	defer func() {
@@ -54,22 +73,40 @@ func (i *MenuInteractor) Update(ctx context.Context, menu *domain.Menu, user dom
 
 	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
 		// 验证菜单存在
-		menu, err := ds.MenuRepo().FindByID(ctx, menu.ID)
+		existing, err := findMenu(ctx, ds, menu.ID, user)
 		if err != nil {
-			if domain.IsNotFound(err) {
-				return domain.ParamsError(domain.ErrMenuNotExists)
-			}
 			return err
 		}
-		if !domain.VerifyOwnerShip(user, menu.MerchantID, menu.StoreID) {
-			return domain.ParamsError(domain.ErrMenuNotExists)
-		}
+		menu.MerchantID = existing.MerchantID
+		menu.StoreID = existing.StoreID
 		// 业务规则校验（排除自身）
-		if err = validateMenuBusinessRules(ctx, ds, menu, user, menu.ID); err != nil {
+		if err = validateMenuBusinessRules(ctx, ds, menu, menu.ID); err != nil {
+			return err
+		}
+
+		operator := productversion.OperatorFromUser(user)
+		draft, err := ds.MenuVersionRepo().FindDraftForUpdate(ctx, menu.ID)
+		if err != nil && !domain.IsNotFound(err) {
+			return err
+		}
+		if draft != nil {
+			draft.SetContent(menu)
+			draft.PublishError = ""
+			draft.OperatorID = operator.ID
+			draft.OperatorName = operator.Name
+			return ds.MenuVersionRepo().Update(ctx, draft)
+		}
+
+		// 历史菜单没有版本记录时，先将当前门店菜单记录为发布版本，以便回滚
+		if _, err = ensurePublishedVersion(ctx, ds, menu.ID, operator); err != nil {
+			return err
+		}
+		maxVersion, err := ds.MenuVersionRepo().MaxVersion(ctx, menu.ID)
+		if err != nil {
 			return err
 		}
-		// 更新菜单
-		return ds.MenuRepo().Update(ctx, menu)
+		draft = domain.NewMenuVersion(menu, maxVersion+1, domain.MenuVersionStatusDraft, operator)
+		return ds.MenuVersionRepo().Create(ctx, draft)
 	})
 }
 
@@ -94,7 +131,18 @@ func (i *MenuInteractor) Delete(ctx context.Context, id uuid.UUID, user domain.U
 		if menu.StoreCount > 0 {
 			return domain.ParamsError(domain.ErrMenuHasStores)
 		}
-		// 删除菜单
+		// 记录菜单项历史版本
+		before, err := ds.MenuRepo().GetDetail(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err = productversion.RecordMenuItems(ctx, ds, before, nil, productversion.OperatorFromUser(user)); err != nil {
+			return err
+		}
+		// 删除菜单及版本
+		if err = ds.MenuVersionRepo().DeleteByMenuID(ctx, id); err != nil {
+			return err
+		}
 		return ds.MenuRepo().Delete(ctx, id)
 	})
 }
@@ -115,6 +163,12 @@ func (i *MenuInteractor) GetDetail(ctx context.Context, id uuid.UUID, user domai
 	if menu.MerchantID != user.GetMerchantID() {
 		return nil, domain.ParamsError(domain.ErrMenuNotExists)
 	}
+	// 附带待发布草稿
+	draft, err := i.DS.MenuVersionRepo().FindByStatus(ctx, id, domain.MenuVersionStatusDraft)
+	if err != nil && !domain.IsNotFound(err) {
+		return nil, err
+	}
+	menu.Draft = draft
 	return menu, nil
 }
 
@@ -139,7 +193,7 @@ func (i *MenuInteractor) PagedListBySearch(
 // ============================================
 
 func validateMenuBusinessRules(ctx context.Context, ds domain.DataStore,
-	menu *domain.Menu, user domain.User, excludeMenuID uuid.UUID,
+	menu *domain.Menu, excludeMenuID uuid.UUID,
 ) error {
 	// 1. 检查菜单名称是否唯一
 	exists, err := ds.MenuRepo().Exists(ctx, domain.MenuExistsParams{
@@ -191,10 +245,15 @@ func validateMenuBusinessRules(ctx context.Context, ds domain.DataStore,
 			return domain.ParamsError(fmt.Errorf("菜品ID %s 不存在", item.ProductID))
 		}
 
-		// 检查菜品是否有效
-		if !domain.VerifyOwnerShip(user, product.MerchantID, product.StoreID) {
+		// 检查菜品是否有效（与菜单属于同一品牌商/门店）
+		if product.MerchantID != menu.MerchantID || product.StoreID != menu.StoreID {
 			return domain.ParamsError(domain.ErrMenuItemProductInvalid)
 		}
+
+		// 检查时段价格配置
+		if err = item.PeriodPrices.Validate(); err != nil {
+			return domain.ParamsError(err)
+		}
 	}
 	return nil
 }
@@ -207,5 +266,28 @@ func (i *MenuInteractor) ListAllStoreMenus(
 	defer func() {
 		util.SpanErrFinish(span, err)
 	}()
-	return i.DS.MenuRepo().ListAllStoreMenus(ctx, params)
+
+	store, err := i.DS.StoreRepo().FindByID(ctx, params.StoreID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return nil, err
+	}
+	if store.MerchantID != params.MerchantID {
+		return nil, domain.ParamsError(domain.ErrStoreNotExists)
+	}
+
+	menus, err := i.DS.MenuRepo().ListAllStoreMenus(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按门店就餐时段计算当前生效价格
+	at := params.At
+	if at.IsZero() {
+		at = time.Now()
+	}
+	domain.ApplyMenuCurrentPrices(menus, store.DiningPeriods, at)
+	return menus, nil
 }
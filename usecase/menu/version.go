@@ -0,0 +1,355 @@
+package menu
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
+)
+
+// 发布失败原因最大长度（与数据库字段长度一致）
+const maxPublishErrorLen = 1000
+
+func (i *MenuInteractor) GetDraft(ctx context.Context, id uuid.UUID, user domain.User) (res *domain.MenuVersion, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MenuInteractor.GetDraft")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if _, err = findMenu(ctx, i.DS, id, user); err != nil {
+		return nil, err
+	}
+	draft, err := i.DS.MenuVersionRepo().FindByStatus(ctx, id, domain.MenuVersionStatusDraft)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrMenuDraftNotExists)
+		}
+		return nil, err
+	}
+
+	// 预览时附带商品信息
+	productIDs := lo.Map(draft.Items, func(item *domain.MenuVersionItem, _ int) uuid.UUID {
+		return item.ProductID
+	})
+	products, err := i.DS.ProductRepo().ListByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	productMap := lo.SliceToMap(products, func(product *domain.Product) (uuid.UUID, *domain.Product) {
+		return product.ID, product
+	})
+	for _, item := range draft.Items {
+		item.Product = productMap[item.ProductID]
+	}
+	return draft, nil
+}
+
+func (i *MenuInteractor) Publish(ctx context.Context, id uuid.UUID, scheduledAt *time.Time, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MenuInteractor.Publish")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if scheduledAt != nil && !scheduledAt.After(time.Now()) {
+		return domain.ParamsError(domain.ErrMenuPublishTimeInvalid)
+	}
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		draft, err := findDraftForUpdate(ctx, ds, id, user)
+		if err != nil {
+			return err
+		}
+
+		operator := productversion.OperatorFromUser(user)
+		if scheduledAt != nil {
+			draft.ScheduledAt = scheduledAt
+			draft.PublishError = ""
+			draft.OperatorID = operator.ID
+			draft.OperatorName = operator.Name
+			return ds.MenuVersionRepo().Update(ctx, draft)
+		}
+		return publishDraft(ctx, ds, draft, operator)
+	})
+}
+
+func (i *MenuInteractor) CancelScheduledPublish(ctx context.Context, id uuid.UUID, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MenuInteractor.CancelScheduledPublish")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		draft, err := findDraftForUpdate(ctx, ds, id, user)
+		if err != nil {
+			return err
+		}
+		if draft.ScheduledAt == nil {
+			return domain.ParamsError(domain.ErrMenuDraftNotScheduled)
+		}
+
+		operator := productversion.OperatorFromUser(user)
+		draft.ScheduledAt = nil
+		draft.OperatorID = operator.ID
+		draft.OperatorName = operator.Name
+		return ds.MenuVersionRepo().Update(ctx, draft)
+	})
+}
+
+func (i *MenuInteractor) Rollback(ctx context.Context, id uuid.UUID, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MenuInteractor.Rollback")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		if _, err := findMenu(ctx, ds, id, user); err != nil {
+			return err
+		}
+		current, err := ds.MenuVersionRepo().FindByStatus(ctx, id, domain.MenuVersionStatusPublished)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return domain.ParamsError(domain.ErrMenuNoPreviousVersion)
+			}
+			return err
+		}
+		previous, err := ds.MenuVersionRepo().FindPreviousArchived(ctx, id, current.Version)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return domain.ParamsError(domain.ErrMenuNoPreviousVersion)
+			}
+			return err
+		}
+
+		operator := productversion.OperatorFromUser(user)
+		if err = applyVersion(ctx, ds, previous, current, operator); err != nil {
+			return err
+		}
+
+		current.Status = domain.MenuVersionStatusArchived
+		if err = ds.MenuVersionRepo().Update(ctx, current); err != nil {
+			return err
+		}
+		now := time.Now()
+		previous.Status = domain.MenuVersionStatusPublished
+		previous.PublishedAt = &now
+		previous.OperatorID = operator.ID
+		previous.OperatorName = operator.Name
+		return ds.MenuVersionRepo().Update(ctx, previous)
+	})
+}
+
+func (i *MenuInteractor) ListVersions(ctx context.Context, id uuid.UUID, user domain.User) (res domain.MenuVersions, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MenuInteractor.ListVersions")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if _, err = findMenu(ctx, i.DS, id, user); err != nil {
+		return nil, err
+	}
+	return i.DS.MenuVersionRepo().ListByMenuID(ctx, id)
+}
+
+func (i *MenuInteractor) PublishDueDrafts(ctx context.Context) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "MenuInteractor.PublishDueDrafts")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+	logger := logging.FromContext(ctx).Named("MenuInteractor.PublishDueDrafts")
+	ctx = logging.NewContext(ctx, logger)
+
+	menuIDs, err := i.DS.MenuVersionRepo().ListDueDraftMenuIDs(ctx, time.Now())
+	if err != nil {
+		logger.Errorf("获取待发布的菜单草稿失败: %v", err)
+		return err
+	}
+	if len(menuIDs) == 0 {
+		return nil
+	}
+	logger.Infof("找到 %d 个待发布的菜单草稿", len(menuIDs))
+
+	var failed []string
+	for _, menuID := range menuIDs {
+		if err := i.publishDue(ctx, menuID); err != nil {
+			logger.Errorf("菜单 %s 定时发布失败: %v", menuID, err)
+			failed = append(failed, fmt.Sprintf("menu_id=%s err=%v", menuID, err))
+
+			// 发布回退后单独记录失败原因并取消定时，避免下次重复执行
+			if markErr := i.markPublishFailed(ctx, menuID, err); markErr != nil {
+				logger.Errorf("菜单 %s 记录发布失败原因失败: %v", menuID, markErr)
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("部分菜单定时发布失败: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// publishDue 在同一事务内发布到期的菜单草稿
+func (i *MenuInteractor) publishDue(ctx context.Context, menuID uuid.UUID) error {
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		draft, err := ds.MenuVersionRepo().FindDraftForUpdate(ctx, menuID)
+		if err != nil {
+			// 已被手动发布或删除
+			if domain.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		// 已取消定时或修改了发布时间
+		if draft.ScheduledAt == nil || draft.ScheduledAt.After(time.Now()) {
+			return nil
+		}
+		return publishDraft(ctx, ds, draft, domain.ProductVersionSystemOperator)
+	})
+}
+
+// markPublishFailed 记录定时发布失败原因
+func (i *MenuInteractor) markPublishFailed(ctx context.Context, menuID uuid.UUID, cause error) error {
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		draft, err := ds.MenuVersionRepo().FindDraftForUpdate(ctx, menuID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		reason := []rune(cause.Error())
+		if len(reason) > maxPublishErrorLen {
+			reason = reason[:maxPublishErrorLen]
+		}
+		draft.ScheduledAt = nil
+		draft.PublishError = string(reason)
+		return ds.MenuVersionRepo().Update(ctx, draft)
+	})
+}
+
+// ============================================
+// 内部函数
+// ============================================
+
+func findMenu(ctx context.Context, ds domain.DataStore, id uuid.UUID, user domain.User) (*domain.Menu, error) {
+	menu, err := ds.MenuRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrMenuNotExists)
+		}
+		return nil, err
+	}
+	if !domain.VerifyOwnerShip(user, menu.MerchantID, menu.StoreID) {
+		return nil, domain.ParamsError(domain.ErrMenuNotExists)
+	}
+	return menu, nil
+}
+
+func findDraftForUpdate(ctx context.Context, ds domain.DataStore, id uuid.UUID, user domain.User) (*domain.MenuVersion, error) {
+	if _, err := findMenu(ctx, ds, id, user); err != nil {
+		return nil, err
+	}
+	draft, err := ds.MenuVersionRepo().FindDraftForUpdate(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrMenuDraftNotExists)
+		}
+		return nil, err
+	}
+	return draft, nil
+}
+
+// ensurePublishedVersion 查询菜单当前发布版本，历史菜单没有版本记录时根据门店菜单生成
+func ensurePublishedVersion(
+	ctx context.Context,
+	ds domain.DataStore,
+	menuID uuid.UUID,
+	operator domain.ProductVersionOperator,
+) (*domain.MenuVersion, error) {
+	published, err := ds.MenuVersionRepo().FindByStatus(ctx, menuID, domain.MenuVersionStatusPublished)
+	if err == nil {
+		return published, nil
+	}
+	if !domain.IsNotFound(err) {
+		return nil, err
+	}
+
+	live, err := ds.MenuRepo().GetDetail(ctx, menuID)
+	if err != nil {
+		return nil, err
+	}
+	maxVersion, err := ds.MenuVersionRepo().MaxVersion(ctx, menuID)
+	if err != nil {
+		return nil, err
+	}
+	published = domain.NewMenuVersion(live, maxVersion+1, domain.MenuVersionStatusPublished, operator)
+	published.PublishedAt = &live.UpdatedAt
+	if err = ds.MenuVersionRepo().Create(ctx, published); err != nil {
+		return nil, err
+	}
+	return published, nil
+}
+
+// publishDraft 发布草稿：覆盖门店菜单，原发布版本归档
+func publishDraft(ctx context.Context, ds domain.DataStore, draft *domain.MenuVersion, operator domain.ProductVersionOperator) error {
+	current, err := ds.MenuVersionRepo().FindByStatus(ctx, draft.MenuID, domain.MenuVersionStatusPublished)
+	if err != nil && !domain.IsNotFound(err) {
+		return err
+	}
+
+	if err = applyVersion(ctx, ds, draft, current, operator); err != nil {
+		return err
+	}
+
+	if current != nil {
+		current.Status = domain.MenuVersionStatusArchived
+		if err = ds.MenuVersionRepo().Update(ctx, current); err != nil {
+			return err
+		}
+	}
+	now := time.Now()
+	draft.Status = domain.MenuVersionStatusPublished
+	draft.PublishedAt = &now
+	draft.ScheduledAt = nil
+	draft.PublishError = ""
+	draft.OperatorID = operator.ID
+	draft.OperatorName = operator.Name
+	return ds.MenuVersionRepo().Update(ctx, draft)
+}
+
+// applyVersion 将版本内容覆盖到门店菜单，并记录菜单项历史版本
+//
+// base 为当前发布版本，本版本未修改的价格沿用门店菜单的当前价格，避免覆盖批量调价等版本之外的修改。
+func applyVersion(
+	ctx context.Context,
+	ds domain.DataStore,
+	v, base *domain.MenuVersion,
+	operator domain.ProductVersionOperator,
+) error {
+	before, err := ds.MenuRepo().GetDetail(ctx, v.MenuID)
+	if err != nil {
+		return err
+	}
+	v.KeepLivePrices(base, before)
+
+	menu := v.ToMenu()
+	// 发布前重新校验，草稿保存后菜品可能已被删除
+	if err = validateMenuBusinessRules(ctx, ds, menu, menu.ID); err != nil {
+		return err
+	}
+	if err = ds.MenuRepo().Update(ctx, menu); err != nil {
+		return err
+	}
+	after, err := ds.MenuRepo().GetDetail(ctx, menu.ID)
+	if err != nil {
+		return err
+	}
+	return productversion.RecordMenuItems(ctx, ds, before, after, operator)
+}
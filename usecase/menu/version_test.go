@@ -0,0 +1,127 @@
+package menu
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/domain/mock"
+)
+
+func TestMenuInteractorPublishKeepsLivePrices(t *testing.T) {
+	price := func(s string) *decimal.Decimal {
+		d := decimal.RequireFromString(s)
+		return &d
+	}
+	merchantID := uuid.New()
+	menuID := uuid.New()
+	productA, productB := uuid.New(), uuid.New()
+	user := &domain.BackendUser{ID: uuid.New(), MerchantID: merchantID}
+
+	newVersion := func(status domain.MenuVersionStatus, version int, a, b *decimal.Decimal) *domain.MenuVersion {
+		return &domain.MenuVersion{
+			ID:         uuid.New(),
+			MenuID:     menuID,
+			MerchantID: merchantID,
+			Version:    version,
+			Status:     status,
+			Name:       "午市菜单",
+			Items: domain.MenuVersionItems{
+				{ProductID: productA, BasePrice: a},
+				{ProductID: productB, BasePrice: b},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		draftA    *decimal.Decimal
+		draftB    *decimal.Decimal
+		wantA     string
+		wantB     string
+		published bool
+	}{
+		// 发布版本 A=10、B=20，之后批量调价将门店菜单 A 改为 11
+		{name: "草稿未修改的价格沿用调价结果", draftA: price("10"), draftB: price("25"), wantA: "11", wantB: "25", published: true},
+		{name: "草稿修改过的价格以草稿为准", draftA: price("12"), draftB: price("20"), wantA: "12", wantB: "20", published: true},
+		{name: "没有发布版本时按草稿发布", draftA: price("10"), draftB: price("20"), wantA: "10", wantB: "20"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ds := mock.NewMockDataStore(ctrl)
+			menuRepo := mock.NewMockMenuRepository(ctrl)
+			versionRepo := mock.NewMockMenuVersionRepository(ctrl)
+			storeRepo := mock.NewMockStoreRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			productVersionRepo := mock.NewMockProductVersionRepository(ctrl)
+			ds.EXPECT().MenuRepo().Return(menuRepo).AnyTimes()
+			ds.EXPECT().MenuVersionRepo().Return(versionRepo).AnyTimes()
+			ds.EXPECT().StoreRepo().Return(storeRepo).AnyTimes()
+			ds.EXPECT().ProductRepo().Return(productRepo).AnyTimes()
+			ds.EXPECT().ProductVersionRepo().Return(productVersionRepo).AnyTimes()
+			ds.EXPECT().Atomic(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context, ds domain.DataStore) error) error {
+					return fn(ctx, ds)
+				})
+
+			live := &domain.Menu{
+				ID:         menuID,
+				MerchantID: merchantID,
+				Name:       "午市菜单",
+				Items: domain.MenuItems{
+					{MenuID: menuID, ProductID: productA, BasePrice: price("11")},
+					{MenuID: menuID, ProductID: productB, BasePrice: price("20")},
+				},
+			}
+			var updated *domain.Menu
+			menuRepo.EXPECT().FindByID(gomock.Any(), menuID).Return(live, nil)
+			menuRepo.EXPECT().GetDetail(gomock.Any(), menuID).Return(live, nil)
+			menuRepo.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+			menuRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, menu *domain.Menu) error {
+				updated = menu
+				return nil
+			})
+			menuRepo.EXPECT().GetDetail(gomock.Any(), menuID).DoAndReturn(func(context.Context, uuid.UUID) (*domain.Menu, error) {
+				return updated, nil
+			})
+			storeRepo.EXPECT().ListByIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+			productRepo.EXPECT().ListByIDs(gomock.Any(), gomock.Any()).Return(domain.Products{
+				{ID: productA, MerchantID: merchantID},
+				{ID: productB, MerchantID: merchantID},
+			}, nil)
+			productVersionRepo.EXPECT().FindLatest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domain.NotFoundError(errors.New("not found"))).AnyTimes()
+			productVersionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			draft := newVersion(domain.MenuVersionStatusDraft, 2, tt.draftA, tt.draftB)
+			versionRepo.EXPECT().FindDraftForUpdate(gomock.Any(), menuID).Return(draft, nil)
+			if tt.published {
+				versionRepo.EXPECT().FindByStatus(gomock.Any(), menuID, domain.MenuVersionStatusPublished).
+					Return(newVersion(domain.MenuVersionStatusPublished, 1, price("10"), price("20")), nil)
+			} else {
+				versionRepo.EXPECT().FindByStatus(gomock.Any(), menuID, domain.MenuVersionStatusPublished).
+					Return(nil, domain.NotFoundError(errors.New("not found")))
+			}
+			versionRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			err := NewMenuInteractor(ds).Publish(context.Background(), menuID, nil, user)
+			require.NoError(t, err)
+
+			require.NotNil(t, updated)
+			prices := make(map[uuid.UUID]string)
+			for _, item := range updated.Items {
+				prices[item.ProductID] = item.BasePrice.String()
+			}
+			require.Equal(t, tt.wantA, prices[productA])
+			require.Equal(t, tt.wantB, prices[productB])
+			// 发布后的版本快照与门店菜单价格一致
+			require.Equal(t, domain.MenuVersionStatusPublished, draft.Status)
+			require.Equal(t, tt.wantA, draft.Items[0].BasePrice.String())
+		})
+	}
+}
@@ -0,0 +1,325 @@
+package menuprice
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// Channel 自助点餐渠道的计价规则
+type Channel struct {
+	SaleChannel    domain.SaleChannel // 商品需开放的售卖渠道
+	SetMeal        bool               // 是否支持套餐
+	ErrUnavailable error              // 商品不可点时返回的错误
+	ErrSetMeal     error              // 不支持套餐时返回的错误
+}
+
+var (
+	// ScanOrder 扫码点餐：暂不支持套餐
+	ScanOrder = Channel{
+		SaleChannel:    domain.SaleChannelScanOrdering,
+		ErrUnavailable: domain.ErrScanOrderProductUnavailable,
+		ErrSetMeal:     domain.ErrScanOrderSetMealUnsupported,
+	}
+	// Kiosk 自助点餐机：支持套餐
+	Kiosk = Channel{
+		SaleChannel:    domain.SaleChannelSelfService,
+		SetMeal:        true,
+		ErrUnavailable: domain.ErrKioskProductUnavailable,
+	}
+	// Preorder 顾客小程序预点单：支持套餐
+	Preorder = Channel{
+		SaleChannel:    domain.SaleChannelMobileOrdering,
+		SetMeal:        true,
+		ErrUnavailable: domain.ErrPreorderProductUnavailable,
+	}
+	// Delivery 三方外卖：平台商品按规格映射，不支持套餐
+	Delivery = Channel{
+		SaleChannel:    domain.SaleChannelThirdPartyDelivery,
+		ErrUnavailable: domain.ErrDeliveryProductUnavailable,
+		ErrSetMeal:     domain.ErrDeliveryProductUnavailable,
+	}
+)
+
+// Orderable 商品是否可在该渠道点单
+func (c Channel) Orderable(p *domain.Product) bool {
+	return c.saleable(p) && (c.SetMeal || p.Type != domain.ProductTypeSetMeal)
+}
+
+// saleable 商品是否在售且开放该渠道
+func (c Channel) saleable(p *domain.Product) bool {
+	return p != nil &&
+		p.SaleStatus == domain.ProductSaleStatusOnSale &&
+		slices.Contains(p.SaleChannels, c.SaleChannel)
+}
+
+// FilterMenus 只保留该渠道可点的菜单项，过滤后为空的菜单不返回
+func (c Channel) FilterMenus(menus domain.Menus) domain.Menus {
+	res := make(domain.Menus, 0, len(menus))
+	for _, menu := range menus {
+		items := make(domain.MenuItems, 0, len(menu.Items))
+		for _, item := range menu.Items {
+			if c.Orderable(item.Product) {
+				items = append(items, item)
+			}
+		}
+		if len(items) == 0 {
+			continue
+		}
+		menu.Items = items
+		res = append(res, menu)
+	}
+	return res
+}
+
+// Line 按门店菜单计价后的商品
+type Line struct {
+	Product       *domain.Product
+	Spec          *domain.ProductSpecRelation
+	Price         decimal.Decimal // 单价（不含做法加价，套餐含替换加价）
+	AttrUnit      decimal.Decimal // 单份做法加价
+	AttrRelations domain.ProductAttrRelations
+	Groups        domain.SetMealGroups // 套餐选择（已补全子商品名称与出品部门）
+
+	components map[uuid.UUID]*domain.Product // 套餐子商品，用于金额分摊
+}
+
+// UnitPrice 含做法加价的单价
+func (l *Line) UnitPrice() decimal.Decimal {
+	return l.Price.Add(l.AttrUnit)
+}
+
+// AttrNames 口味做法名称
+func (l *Line) AttrNames() []string {
+	names := make([]string, 0, len(l.AttrRelations))
+	for _, attr := range l.AttrRelations {
+		if attr.AttrItem != nil {
+			names = append(names, attr.AttrItem.Name)
+		}
+	}
+	return names
+}
+
+// Pricer 按门店菜单与就餐时段为自助点餐商品计价，价格优先级：菜单项价格 > 规格价格
+type Pricer struct {
+	ds        domain.DataStore
+	channel   Channel
+	store     *domain.Store
+	at        time.Time
+	products  map[uuid.UUID]*domain.Product
+	menuItems map[uuid.UUID]*domain.MenuItem
+}
+
+// NewPricer 加载商品详情与门店菜单项
+func NewPricer(
+	ctx context.Context,
+	ds domain.DataStore,
+	channel Channel,
+	store *domain.Store,
+	productIDs []uuid.UUID,
+	at time.Time,
+) (*Pricer, error) {
+	productIDs = lo.Uniq(productIDs)
+	products, err := ds.ProductRepo().ListDetailsBySearch(ctx, domain.ProductSearchParams{
+		MerchantID: store.MerchantID,
+		IDs:        productIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list products: %w", err)
+	}
+
+	p := &Pricer{
+		ds:        ds,
+		channel:   channel,
+		store:     store,
+		at:        at,
+		products:  lo.KeyBy(products, func(p *domain.Product) uuid.UUID { return p.ID }),
+		menuItems: make(map[uuid.UUID]*domain.MenuItem, len(productIDs)),
+	}
+	for _, productID := range productIDs {
+		items, err := ds.MenuRepo().ListStoreMenuItemsByProductID(ctx, store.ID, productID)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) > 0 {
+			p.menuItems[productID] = items[0]
+		}
+	}
+	return p, nil
+}
+
+// Price 校验商品可点并计算单价与做法加价，套餐商品需传入套餐选择
+func (p *Pricer) Price(
+	ctx context.Context,
+	productID, specID uuid.UUID,
+	attrs []domain.TableCartAttr,
+	groups domain.SetMealGroups,
+) (*Line, error) {
+	product, ok := p.products[productID]
+	item, inMenu := p.menuItems[productID]
+	if !ok || !inMenu || !p.channel.saleable(product) {
+		return nil, p.channel.ErrUnavailable
+	}
+	if product.Type == domain.ProductTypeSetMeal && !p.channel.SetMeal {
+		return nil, p.channel.ErrSetMeal
+	}
+
+	line := &Line{Product: product}
+	if len(product.SpecRelations) > 0 {
+		idx := slices.IndexFunc(product.SpecRelations, func(rel *domain.ProductSpecRelation) bool {
+			if specID == uuid.Nil {
+				return rel.IsDefault
+			}
+			return rel.SpecID == specID
+		})
+		switch {
+		case idx >= 0:
+			line.Spec = product.SpecRelations[idx]
+		case specID == uuid.Nil:
+			line.Spec = product.SpecRelations[0]
+		default:
+			return nil, domain.ErrScanOrderSpecInvalid
+		}
+	} else if specID != uuid.Nil {
+		return nil, domain.ErrScanOrderSpecInvalid
+	}
+
+	current := domain.ResolvePrice(item.BasePrice, item.MemberPrice, item.PeriodPrices, p.store.DiningPeriods, p.at)
+	switch {
+	case current.BasePrice != nil:
+		line.Price = *current.BasePrice
+	case line.Spec != nil:
+		basePrice := line.Spec.BasePrice
+		specPrice := domain.ResolvePrice(&basePrice, line.Spec.MemberPrice, line.Spec.PeriodPrices, p.store.DiningPeriods, p.at)
+		line.Price = *specPrice.BasePrice
+	default:
+		return nil, p.channel.ErrUnavailable
+	}
+
+	configs := lo.KeyBy(product.AttrRelations, func(rel *domain.ProductAttrRelation) uuid.UUID { return rel.AttrItemID })
+	for _, attr := range attrs {
+		config, ok := configs[attr.AttrItemID]
+		if !ok {
+			return nil, domain.ErrProductAttrSelectionInvalid
+		}
+		rel := *config
+		rel.Quantity = attr.Quantity
+		line.AttrRelations = append(line.AttrRelations, &rel)
+	}
+	attrUnit, err := product.ValidateAttrSelection(line.AttrRelations)
+	if err != nil {
+		return nil, err
+	}
+	line.AttrUnit = attrUnit
+
+	if product.Type == domain.ProductTypeSetMeal {
+		if err = p.priceSetMeal(ctx, line, groups); err != nil {
+			return nil, err
+		}
+	}
+	return line, nil
+}
+
+// priceSetMeal 校验套餐选择，补全子商品名称与出品部门，替换加价计入单价
+func (p *Pricer) priceSetMeal(ctx context.Context, line *Line, groups domain.SetMealGroups) error {
+	setMeal, err := p.ds.ProductRepo().GetDetail(ctx, line.Product.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get set meal product: %w", err)
+	}
+	if err = setMeal.Groups.ValidateSelection(groups); err != nil {
+		return err
+	}
+
+	productIDs := make([]uuid.UUID, 0)
+	for _, group := range groups {
+		for _, detail := range group.Details {
+			productIDs = append(productIDs, detail.ServedProductID())
+		}
+	}
+	components, err := p.ds.ProductRepo().ListDetailsBySearch(ctx, domain.ProductSearchParams{
+		MerchantID: p.store.MerchantID,
+		IDs:        lo.Uniq(productIDs),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list set meal component products: %w", err)
+	}
+	componentMap := lo.KeyBy(components, func(p *domain.Product) uuid.UUID { return p.ID })
+
+	line.Groups = cloneGroups(groups)
+	for _, group := range line.Groups {
+		for _, detail := range group.Details {
+			component, ok := componentMap[detail.ServedProductID()]
+			if !ok {
+				return domain.ErrSetMealSelectionSubstituteInvalid
+			}
+			detail.SelectedProductName = component.Name
+			detail.SelectedProductNameI18n = component.NameI18n
+			detail.StallID = component.EffectiveStallID()
+		}
+	}
+	line.Price = line.Price.Add(line.Groups.SurchargeTotal())
+	line.components = componentMap
+	return nil
+}
+
+// OrderProduct 生成订单商品明细
+func (l *Line) OrderProduct(qty int, remark string, batch int) domain.OrderProduct {
+	q := decimal.NewFromInt(int64(qty))
+	attrAmount := l.AttrUnit.Mul(q)
+	subtotal := l.Price.Mul(q).Add(attrAmount)
+	op := domain.OrderProduct{
+		ID:              uuid.New(),
+		OrderItemID:     uuid.NewString(),
+		Index:           batch,
+		ProductID:       l.Product.ID,
+		ProductName:     l.Product.Name,
+		ProductNameI18n: l.Product.NameI18n,
+		ProductType:     l.Product.Type,
+		MainImage:       l.Product.MainImage,
+		Description:     l.Product.Description,
+		Price:           l.Price,
+		Qty:             qty,
+		Subtotal:        subtotal,
+		AmountBeforeTax: subtotal,
+		AmountAfterTax:  subtotal,
+		Total:           subtotal,
+		AttrAmount:      attrAmount,
+		Note:            remark,
+		AttrRelations:   l.AttrRelations,
+	}
+	if len(l.Groups) > 0 {
+		op.Groups = cloneGroups(l.Groups)
+		domain.AllocateSetMealAmount(op.Groups, subtotal, l.components)
+	}
+	if l.Product.Category != nil {
+		op.Category = *l.Product.Category
+	}
+	if l.Product.Unit != nil {
+		op.ProductUnit = *l.Product.Unit
+	}
+	if l.Spec != nil {
+		op.SpecRelations = domain.ProductSpecRelations{l.Spec}
+	}
+	return op
+}
+
+// cloneGroups 复制套餐选择，分摊金额按订单商品行写入，不能共用
+func cloneGroups(groups domain.SetMealGroups) domain.SetMealGroups {
+	res := make(domain.SetMealGroups, 0, len(groups))
+	for _, group := range groups {
+		g := *group
+		g.Details = make([]*domain.SetMealDetail, 0, len(group.Details))
+		for _, detail := range group.Details {
+			d := *detail
+			g.Details = append(g.Details, &d)
+		}
+		res = append(res, &g)
+	}
+	return res
+}
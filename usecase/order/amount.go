@@ -0,0 +1,39 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+// settleAmount 商品小计或优惠金额被服务端调整后，按附加费配置重算比例附加费与舍入金额，金额未调整时保持 POS 提交的结果
+func (interactor *OrderInteractor) settleAmount(ctx context.Context, order *domain.Order, before domain.OrderAmount) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "OrderInteractor.settleAmount")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if order.Amount.ItemsSubtotal.Equal(before.ItemsSubtotal) && order.Amount.DiscountTotal.Equal(before.DiscountTotal) {
+		return nil
+	}
+
+	feeIDs := make([]uuid.UUID, 0, len(order.Fees))
+	for _, fee := range order.Fees {
+		if fee.FeeID != uuid.Nil {
+			feeIDs = append(feeIDs, fee.FeeID)
+		}
+	}
+	var fees []*domain.AdditionalFee
+	if len(feeIDs) > 0 {
+		fees, err = interactor.DS.AdditionalFeeRepo().ListByIDs(ctx, lo.Uniq(feeIDs))
+		if err != nil {
+			return fmt.Errorf("failed to list additional fees: %w", err)
+		}
+	}
+	order.SettleAmount(before, lo.KeyBy(fees, func(fee *domain.AdditionalFee) uuid.UUID { return fee.ID }))
+	return nil
+}
@@ -0,0 +1,72 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+// prepareAttrProducts 按商品口味做法配置校验订单商品的口味做法选择，并重新计算做法金额
+//
+// 同时补全商品名称翻译，供出单时按打印机语言打印商品名称。
+//
+// 未支付订单以计算结果修正做法金额、商品小计和订单金额；已支付订单的做法金额不符时拒绝下单。
+func (interactor *OrderInteractor) prepareAttrProducts(ctx context.Context, order *domain.Order) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "OrderInteractor.prepareAttrProducts")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if len(order.OrderProducts) == 0 {
+		return nil
+	}
+
+	productIDs := lo.Uniq(lo.Map(order.OrderProducts, func(op domain.OrderProduct, _ int) uuid.UUID {
+		return op.ProductID
+	}))
+	products, err := interactor.DS.ProductRepo().ListDetailsBySearch(ctx, domain.ProductSearchParams{
+		MerchantID: order.MerchantID,
+		IDs:        productIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list order products: %w", err)
+	}
+	productMap := lo.KeyBy(products, func(p *domain.Product) uuid.UUID { return p.ID })
+
+	paid := order.PaymentStatus != "" && order.PaymentStatus != domain.PaymentStatusUnpaid
+	for i := range order.OrderProducts {
+		op := &order.OrderProducts[i]
+		p, ok := productMap[op.ProductID]
+		if !ok {
+			// 商品已不存在时无法校验点单限制，仅拒绝携带口味做法的商品
+			if len(op.AttrRelations) > 0 {
+				return domain.ParamsErrorf("%s：%w", op.ProductName, domain.ErrProductAttrSelectionInvalid)
+			}
+			continue
+		}
+		op.ProductNameI18n = p.NameI18n
+
+		unitAmount, err := p.ValidateAttrSelection(op.AttrRelations)
+		if err != nil {
+			return domain.ParamsErrorf("%s：%w", op.ProductName, err)
+		}
+		attrAmount := unitAmount.Mul(decimal.NewFromInt(int64(op.Qty)))
+		if attrAmount.Equal(op.AttrAmount) {
+			continue
+		}
+		// 已支付的订单金额不可调整，做法金额不符时拒绝
+		if paid {
+			return domain.ParamsErrorf("%s：%w", op.ProductName, domain.ErrProductAttrAmountMismatch)
+		}
+		// 以服务端计算的做法金额为准，差额同步到商品小计和订单金额
+		delta := attrAmount.Sub(op.AttrAmount)
+		op.AttrAmount = attrAmount
+		order.AdjustProductSubtotal(i, delta)
+	}
+	return nil
+}
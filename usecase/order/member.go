@@ -0,0 +1,22 @@
+package order
+
+import (
+	"context"
+
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/member"
+)
+
+// applyMemberPrices 按关联会员计算未支付订单的会员价优惠，已支付的订单保持原有金额不变
+func (interactor *OrderInteractor) applyMemberPrices(ctx context.Context, order *domain.Order) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "OrderInteractor.applyMemberPrices")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if order.PaymentStatus != "" && order.PaymentStatus != domain.PaymentStatusUnpaid {
+		return nil
+	}
+	return member.ApplyToOrder(ctx, interactor.DS, order)
+}
@@ -7,37 +7,87 @@ import (
 	"github.com/google/uuid"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/member"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/pickup"
 )
 
 var _ domain.OrderInteractor = (*OrderInteractor)(nil)
 
 type OrderInteractor struct {
-	DS domain.DataStore
+	DS     domain.DataStore
+	Pusher domain.Pusher
 }
 
-func NewOrderInteractor(ds domain.DataStore) *OrderInteractor {
+func NewOrderInteractor(ds domain.DataStore, pusher domain.Pusher) *OrderInteractor {
 	return &OrderInteractor{
-		DS: ds,
+		DS:     ds,
+		Pusher: pusher,
 	}
 }
 
-func (interactor *OrderInteractor) Create(ctx context.Context, order *domain.Order) (err error) {
+func (interactor *OrderInteractor) Create(ctx context.Context, order *domain.Order, hooks ...domain.OrderCreateHook) (err error) {
 	span, ctx := util.StartSpan(ctx, "usecase", "OrderInteractor.Create")
 	defer func() {
 		util.SpanErrFinish(span, err)
 	}()
 
+	if err = interactor.prepareProducts(ctx, order); err != nil {
+		return err
+	}
+
 	// 创建订单和商品需要在同一事务内
 	err = interactor.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
-		return ds.OrderRepo().Create(ctx, order)
+		if err := ds.OrderRepo().Create(ctx, order); err != nil {
+			return err
+		}
+		for _, hook := range hooks {
+			if err := hook(ctx, ds, order); err != nil {
+				return err
+			}
+		}
+		return nil
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create order: %w", err)
 	}
 
+	interactor.Pusher.Push(ctx, domain.PushEventOrderCreated, order, domain.OrderPushTopics(order)...)
 	return nil
 }
 
+// prepareProducts 下单和更新商品明细时计算商品价格、校验点单选择并计算优惠，金额被调整时重算附加费与舍入
+func (interactor *OrderInteractor) prepareProducts(ctx context.Context, order *domain.Order) error {
+	before := order.Amount
+
+	// 按下单时间的时段价格计算商品单价
+	if err := interactor.applyCurrentPrices(ctx, order); err != nil {
+		return err
+	}
+
+	// 校验口味做法选择并计算做法金额
+	if err := interactor.prepareAttrProducts(ctx, order); err != nil {
+		return err
+	}
+
+	// 校验套餐选择并分摊套餐金额
+	if err := interactor.prepareSetMealProducts(ctx, order); err != nil {
+		return err
+	}
+
+	// 计算会员价优惠
+	if err := interactor.applyMemberPrices(ctx, order); err != nil {
+		return err
+	}
+
+	// 计算促销优惠
+	if err := interactor.applyPromotions(ctx, order); err != nil {
+		return err
+	}
+
+	// 重算比例附加费与舍入
+	return interactor.settleAmount(ctx, order, before)
+}
+
 func (interactor *OrderInteractor) Get(ctx context.Context, id uuid.UUID) (res *domain.Order, err error) {
 	span, ctx := util.StartSpan(ctx, "usecase", "OrderInteractor.Get")
 	defer func() {
@@ -57,14 +107,50 @@ func (interactor *OrderInteractor) Update(ctx context.Context, order *domain.Ord
 		util.SpanErrFinish(span, err)
 	}()
 
+	// 更新商品明细时与下单一致地计价和校验
+	if len(order.OrderProducts) > 0 {
+		current, err := interactor.DS.OrderRepo().FindByID(ctx, order.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find order: %w", err)
+		}
+		order.FillFrom(current)
+		if err = interactor.prepareProducts(ctx, order); err != nil {
+			return err
+		}
+	}
+
 	// 更新订单和商品需要在同一事务内
 	err = interactor.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
-		return ds.OrderRepo().Update(ctx, order)
+		// 事务内重新读取订单状态，支付和完成的后续处理只在状态变化时执行一次
+		current, err := ds.OrderRepo().FindByID(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		if err := ds.OrderRepo().Update(ctx, order); err != nil {
+			return err
+		}
+		// 先付后吃的扫码订单与自助点餐订单支付后送厨
+		if order.PaymentStatus == domain.PaymentStatusPaid && current.PaymentStatus != domain.PaymentStatusPaid {
+			if _, err := ds.KitchenTicketRepo().ReleaseByOrderID(ctx, order.ID); err != nil {
+				return err
+			}
+			paid := *order
+			paid.FillFrom(current)
+			if err := pickup.OpenCall(ctx, ds, &paid); err != nil {
+				return err
+			}
+		}
+		// 订单完成时为关联会员发放积分
+		if order.OrderStatus == domain.OrderStatusCompleted && current.OrderStatus != domain.OrderStatusCompleted {
+			return member.EarnByOrder(ctx, ds, order.ID)
+		}
+		return nil
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update order: %w", err)
 	}
 
+	interactor.Pusher.Push(ctx, domain.PushEventOrderUpdated, order, domain.OrderPushTopics(order)...)
 	return nil
 }
 
@@ -0,0 +1,98 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/domain/mock"
+)
+
+func TestOrderInteractorUpdateStatusSideEffects(t *testing.T) {
+	tests := []struct {
+		name          string
+		currentPay    domain.PaymentStatus
+		currentStatus domain.OrderStatus
+		pay           domain.PaymentStatus
+		status        domain.OrderStatus
+		wantRelease   bool
+		wantEarn      bool
+	}{
+		{
+			name:       "支付时送厨并创建叫号",
+			currentPay: domain.PaymentStatusUnpaid, currentStatus: domain.OrderStatusPlaced,
+			pay: domain.PaymentStatusPaid, status: domain.OrderStatusPlaced,
+			wantRelease: true,
+		},
+		{
+			name:       "已支付订单再次更新不重复送厨",
+			currentPay: domain.PaymentStatusPaid, currentStatus: domain.OrderStatusPlaced,
+			pay: domain.PaymentStatusPaid, status: domain.OrderStatusPlaced,
+		},
+		{
+			name:       "订单完成时发放积分",
+			currentPay: domain.PaymentStatusPaid, currentStatus: domain.OrderStatusPlaced,
+			pay: domain.PaymentStatusPaid, status: domain.OrderStatusCompleted,
+			wantEarn: true,
+		},
+		{
+			name:       "已完成订单再次更新不重复发放积分",
+			currentPay: domain.PaymentStatusPaid, currentStatus: domain.OrderStatusCompleted,
+			pay: domain.PaymentStatusPaid, status: domain.OrderStatusCompleted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ds := mock.NewMockDataStore(ctrl)
+			orderRepo := mock.NewMockOrderRepository(ctrl)
+			ticketRepo := mock.NewMockKitchenTicketRepository(ctrl)
+			callRepo := mock.NewMockPickupCallRepository(ctrl)
+			pusher := mock.NewMockPusher(ctrl)
+			ds.EXPECT().OrderRepo().Return(orderRepo).AnyTimes()
+			ds.EXPECT().KitchenTicketRepo().Return(ticketRepo).AnyTimes()
+			ds.EXPECT().PickupCallRepo().Return(callRepo).AnyTimes()
+			ds.EXPECT().Atomic(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context, ds domain.DataStore) error) error {
+					return fn(ctx, ds)
+				})
+			pusher.EXPECT().Push(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			current := &domain.Order{
+				ID:            uuid.New(),
+				MerchantID:    uuid.New(),
+				StoreID:       uuid.New(),
+				PickupNo:      "A001",
+				OrderStatus:   tt.currentStatus,
+				PaymentStatus: tt.currentPay,
+			}
+			order := &domain.Order{ID: current.ID, OrderStatus: tt.status, PaymentStatus: tt.pay}
+
+			orderRepo.EXPECT().FindByID(gomock.Any(), current.ID).Return(current, nil)
+			orderRepo.EXPECT().Update(gomock.Any(), order).Return(nil)
+			if tt.wantRelease {
+				ticketRepo.EXPECT().ReleaseByOrderID(gomock.Any(), current.ID).Return(1, nil)
+				callRepo.EXPECT().FindByOrderID(gomock.Any(), current.ID).Return(nil, domain.NotFoundError(errors.New("not found")))
+				callRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, call *domain.PickupCall) error {
+					require.Equal(t, "A001", call.PickupNo)
+					return nil
+				})
+			}
+			if tt.wantEarn {
+				// 订单未关联会员，发放积分时查询订单后直接返回
+				orderRepo.EXPECT().FindByID(gomock.Any(), current.ID).Return(&domain.Order{
+					ID:            current.ID,
+					OrderStatus:   domain.OrderStatusCompleted,
+					PaymentStatus: domain.PaymentStatusPaid,
+				}, nil)
+			}
+
+			err := NewOrderInteractor(ds, pusher).Update(context.Background(), order)
+			require.NoError(t, err)
+		})
+	}
+}
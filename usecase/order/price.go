@@ -0,0 +1,120 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+// applyCurrentPrices 按下单时间为未支付订单中命中就餐时段或星期价格的商品改用时段价，已支付的订单保持原有金额不变
+//
+// POS 按菜单常规价下单：以商品行单价（扣除套餐替换加价）匹配其来源的门店菜单项，菜单项未设置价格时比对规格价格。
+// 仅当来源菜单项（或其沿用的规格）命中时段价时调整单价；未命中时段价、无法确定来源菜单项的商品保留 POS 提交的单价。
+func (interactor *OrderInteractor) applyCurrentPrices(ctx context.Context, order *domain.Order) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "OrderInteractor.applyCurrentPrices")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if order.PaymentStatus != "" && order.PaymentStatus != domain.PaymentStatusUnpaid {
+		return nil
+	}
+	if len(order.OrderProducts) == 0 {
+		return nil
+	}
+
+	store, err := interactor.DS.StoreRepo().FindByID(ctx, order.StoreID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return fmt.Errorf("failed to find store: %w", err)
+	}
+	at := order.PlacedAt
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	menuItems := make(map[uuid.UUID]domain.MenuItems)
+	for i := range order.OrderProducts {
+		op := &order.OrderProducts[i]
+		if op.IsGift {
+			continue
+		}
+
+		items, ok := menuItems[op.ProductID]
+		if !ok {
+			items, err = interactor.DS.MenuRepo().ListStoreMenuItemsByProductID(ctx, order.StoreID, op.ProductID)
+			if err != nil {
+				return fmt.Errorf("failed to list store menu items: %w", err)
+			}
+			menuItems[op.ProductID] = items
+		}
+		if len(items) == 0 {
+			continue
+		}
+
+		var spec *domain.ProductSpecRelation
+		if len(op.SpecRelations) > 0 && op.SpecRelations[0] != nil {
+			spec, err = interactor.DS.ProductSpecRelRepo().FindByProductAndSpec(ctx, op.ProductID, op.SpecRelations[0].SpecID)
+			if err != nil && !domain.IsNotFound(err) {
+				return fmt.Errorf("failed to find product spec: %w", err)
+			}
+		}
+
+		// 套餐单价包含所选子商品的替换加价
+		surcharge := op.Groups.SurchargeTotal()
+		item := matchMenuItem(items, spec, op.Price.Sub(surcharge))
+		if item == nil {
+			continue
+		}
+		price := periodPrice(item, spec, store.DiningPeriods, at)
+		if price == nil {
+			continue
+		}
+
+		newPrice := price.Add(surcharge)
+		if newPrice.Equal(op.Price) {
+			continue
+		}
+		delta := newPrice.Sub(op.Price).Mul(decimal.NewFromInt(int64(op.Qty)))
+		op.Price = newPrice
+		order.AdjustProductSubtotal(i, delta)
+	}
+	return nil
+}
+
+// matchMenuItem 按商品行的常规单价匹配来源菜单项：菜单项设置了价格时比对菜单项价格，否则比对规格价格
+func matchMenuItem(items domain.MenuItems, spec *domain.ProductSpecRelation, price decimal.Decimal) *domain.MenuItem {
+	for _, item := range items {
+		regular := item.BasePrice
+		if regular == nil && spec != nil {
+			regular = &spec.BasePrice
+		}
+		if regular != nil && regular.Equal(price) {
+			return item
+		}
+	}
+	return nil
+}
+
+// periodPrice 菜单项在指定时间命中的时段价；菜单项未设置价格而沿用规格价格时取规格命中的时段价，均未命中返回 nil
+//
+// 价格优先级与自助点餐一致：菜单项时段价 > 菜单项基础价 > 规格时段价 > 规格基础价。
+func periodPrice(item *domain.MenuItem, spec *domain.ProductSpecRelation, periods []domain.DiningPeriod, at time.Time) *decimal.Decimal {
+	if p := item.PeriodPrices.Match(periods, at); p != nil {
+		return &p.BasePrice
+	}
+	if item.BasePrice != nil || spec == nil {
+		return nil
+	}
+	if p := spec.PeriodPrices.Match(periods, at); p != nil {
+		return &p.BasePrice
+	}
+	return nil
+}
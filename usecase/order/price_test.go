@@ -0,0 +1,96 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/domain/mock"
+)
+
+func TestOrderInteractorApplyCurrentPrices(t *testing.T) {
+	dec := decimal.RequireFromString
+	price := func(s string) *decimal.Decimal {
+		d := dec(s)
+		return &d
+	}
+	storeID := uuid.New()
+	productID := uuid.New()
+	specID := uuid.New()
+	lunch := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+	evening := time.Date(2026, 10, 19, 20, 0, 0, 0, time.Local)
+	store := &domain.Store{
+		ID:            storeID,
+		DiningPeriods: []domain.DiningPeriod{{Name: "午市", StartTime: "11:00:00", EndTime: "14:00:00"}},
+	}
+	// 门店两个菜单包含同一商品：堂食菜单 20 元（午市 15 元），外卖菜单沿用规格价 18 元（规格午市 16 元）
+	menuItems := domain.MenuItems{
+		{ID: uuid.New(), ProductID: productID, BasePrice: price("20"), PeriodPrices: domain.PeriodPrices{{DiningPeriod: "午市", BasePrice: dec("15")}}},
+		{ID: uuid.New(), ProductID: productID},
+	}
+	spec := &domain.ProductSpecRelation{
+		ProductID:    productID,
+		SpecID:       specID,
+		BasePrice:    dec("18"),
+		PeriodPrices: domain.PeriodPrices{{DiningPeriod: "午市", BasePrice: dec("16")}},
+	}
+
+	tests := []struct {
+		name      string
+		price     string
+		placedAt  time.Time
+		wantPrice string
+	}{
+		{name: "未命中时段价保留提交的单价", price: "20", placedAt: evening, wantPrice: "20"},
+		{name: "按菜单项价格匹配并使用菜单项时段价", price: "20", placedAt: lunch, wantPrice: "15"},
+		{name: "按规格价格匹配并使用规格时段价", price: "18", placedAt: lunch, wantPrice: "16"},
+		{name: "无法匹配来源菜单项时保留提交的单价", price: "19", placedAt: lunch, wantPrice: "19"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ds := mock.NewMockDataStore(ctrl)
+			storeRepo := mock.NewMockStoreRepository(ctrl)
+			menuRepo := mock.NewMockMenuRepository(ctrl)
+			specRepo := mock.NewMockProductSpecRelRepository(ctrl)
+			ds.EXPECT().StoreRepo().Return(storeRepo).AnyTimes()
+			ds.EXPECT().MenuRepo().Return(menuRepo).AnyTimes()
+			ds.EXPECT().ProductSpecRelRepo().Return(specRepo).AnyTimes()
+			storeRepo.EXPECT().FindByID(gomock.Any(), storeID).Return(store, nil)
+			menuRepo.EXPECT().ListStoreMenuItemsByProductID(gomock.Any(), storeID, productID).Return(menuItems, nil)
+			specRepo.EXPECT().FindByProductAndSpec(gomock.Any(), productID, specID).Return(spec, nil)
+
+			subtotal := dec(tt.price).Mul(decimal.NewFromInt(2))
+			order := &domain.Order{
+				StoreID:  storeID,
+				PlacedAt: tt.placedAt,
+				OrderProducts: []domain.OrderProduct{{
+					ProductID:       productID,
+					Price:           dec(tt.price),
+					Qty:             2,
+					Subtotal:        subtotal,
+					AmountBeforeTax: subtotal,
+					AmountAfterTax:  subtotal,
+					Total:           subtotal,
+					SpecRelations:   domain.ProductSpecRelations{{SpecID: specID}},
+				}},
+				Amount: domain.OrderAmount{ItemsSubtotal: subtotal, AmountDue: subtotal},
+			}
+
+			err := NewOrderInteractor(ds, nil).applyCurrentPrices(context.Background(), order)
+			require.NoError(t, err)
+
+			want := dec(tt.wantPrice)
+			wantSubtotal := want.Mul(decimal.NewFromInt(2))
+			require.True(t, order.OrderProducts[0].Price.Equal(want), order.OrderProducts[0].Price.String())
+			require.True(t, order.OrderProducts[0].Subtotal.Equal(wantSubtotal))
+			require.True(t, order.Amount.ItemsSubtotal.Equal(wantSubtotal))
+			require.True(t, order.Amount.AmountDue.Equal(wantSubtotal))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+// prepareSetMealProducts 校验订单中套餐商品的选择，并补全子商品的出品部门与分摊金额
+func (interactor *OrderInteractor) prepareSetMealProducts(ctx context.Context, order *domain.Order) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "OrderInteractor.prepareSetMealProducts")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	for i := range order.OrderProducts {
+		op := &order.OrderProducts[i]
+		if op.ProductType != domain.ProductTypeSetMeal {
+			continue
+		}
+
+		setMeal, err := interactor.DS.ProductRepo().GetDetail(ctx, op.ProductID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return domain.ParamsError(domain.ErrSetMealSelectionProductInvalid)
+			}
+			return fmt.Errorf("failed to get set meal product: %w", err)
+		}
+		if setMeal.Type != domain.ProductTypeSetMeal || setMeal.MerchantID != order.MerchantID {
+			return domain.ParamsError(domain.ErrSetMealSelectionProductInvalid)
+		}
+
+		if err = setMeal.Groups.ValidateSelection(op.Groups); err != nil {
+			return domain.ParamsErrorf("%s：%w", op.ProductName, err)
+		}
+
+		// 查询实际出品的子商品（含规格与分类），用于出品部门与金额分摊
+		productIDs := make([]uuid.UUID, 0)
+		for _, group := range op.Groups {
+			for _, detail := range group.Details {
+				productIDs = append(productIDs, detail.ServedProductID())
+			}
+		}
+		products, err := interactor.DS.ProductRepo().ListDetailsBySearch(ctx, domain.ProductSearchParams{
+			MerchantID: order.MerchantID,
+			IDs:        lo.Uniq(productIDs),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list set meal component products: %w", err)
+		}
+		productMap := lo.KeyBy(products, func(p *domain.Product) uuid.UUID { return p.ID })
+
+		for _, group := range op.Groups {
+			for _, detail := range group.Details {
+				p, ok := productMap[detail.ServedProductID()]
+				if !ok {
+					return domain.ParamsError(domain.ErrSetMealSelectionSubstituteInvalid)
+				}
+				detail.SelectedProductName = p.Name
+				detail.SelectedProductNameI18n = p.NameI18n
+				detail.StallID = p.EffectiveStallID()
+			}
+		}
+
+		domain.AllocateSetMealAmount(op.Groups, op.Subtotal, productMap)
+	}
+	return nil
+}
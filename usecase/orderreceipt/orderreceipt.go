@@ -0,0 +1,269 @@
+package orderreceipt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.OrderReceiptInteractor = (*OrderReceiptInteractor)(nil)
+
+type OrderReceiptInteractor struct {
+	DS     domain.DataStore
+	Config domain.ReceiptConfig
+	Pusher domain.Pusher
+}
+
+func NewOrderReceiptInteractor(ds domain.DataStore, config domain.ReceiptConfig, pusher domain.Pusher) *OrderReceiptInteractor {
+	return &OrderReceiptInteractor{
+		DS:     ds,
+		Config: config,
+		Pusher: pusher,
+	}
+}
+
+func (i *OrderReceiptInteractor) CreateLink(ctx context.Context, merchantID, orderID uuid.UUID) (res *domain.ReceiptLink, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "OrderReceiptInteractor.CreateLink")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	order, err := i.DS.OrderRepo().FindByID(ctx, orderID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrReceiptOrderNotExists)
+		}
+		return nil, err
+	}
+	if order.MerchantID != merchantID {
+		return nil, domain.ParamsError(domain.ErrReceiptOrderNotExists)
+	}
+
+	return i.newLink(order.ID)
+}
+
+func (i *OrderReceiptInteractor) GetPrint(ctx context.Context, merchantID, orderID, deviceID uuid.UUID) (res *domain.OrderReceipt, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "OrderReceiptInteractor.GetPrint")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	order, err := i.DS.OrderRepo().FindByID(ctx, orderID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrReceiptOrderNotExists)
+		}
+		return nil, err
+	}
+	if order.MerchantID != merchantID {
+		return nil, domain.ParamsError(domain.ErrReceiptOrderNotExists)
+	}
+
+	var locale string
+	if deviceID != uuid.Nil {
+		device, err := i.DS.DeviceRepo().FindByID(ctx, deviceID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return nil, domain.ParamsError(domain.ErrDeviceNotExists)
+			}
+			return nil, err
+		}
+		if device.StoreID != order.StoreID {
+			return nil, domain.ParamsError(domain.ErrDeviceNotExists)
+		}
+		locale = device.PrintLocale
+	}
+
+	tickets, err := i.DS.KitchenTicketRepo().ListByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	link, err := i.newLink(order.ID)
+	if err != nil {
+		return nil, err
+	}
+	res = domain.NewOrderReceipt(order, tickets, nil)
+	res.QRCode = link.URL
+	res.Localize(locale)
+	return res, nil
+}
+
+func (i *OrderReceiptInteractor) Get(ctx context.Context, token string) (res *domain.OrderReceipt, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "OrderReceiptInteractor.Get")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	order, err := i.findOrder(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	tickets, err := i.DS.KitchenTicketRepo().ListByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	requests, err := i.DS.ReceiptRequestRepo().ListByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewOrderReceipt(order, tickets, requests), nil
+}
+
+func (i *OrderReceiptInteractor) SubmitRequest(
+	ctx context.Context,
+	token string,
+	params domain.OrderReceiptSubmitParams,
+) (res *domain.ReceiptRequest, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "OrderReceiptInteractor.SubmitRequest")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	order, err := i.findOrder(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &domain.ReceiptRequest{
+		ID:           uuid.New(),
+		MerchantID:   order.MerchantID,
+		StoreID:      order.StoreID,
+		OrderID:      order.ID,
+		OrderNo:      order.OrderNo,
+		Type:         params.Type,
+		Status:       domain.ReceiptRequestStatusPending,
+		Reason:       params.Reason,
+		ContactPhone: params.ContactPhone,
+		InvoiceTitle: params.InvoiceTitle,
+		InvoiceTaxNo: params.InvoiceTaxNo,
+		InvoiceEmail: params.InvoiceEmail,
+	}
+	if err = request.Validate(order); err != nil {
+		return nil, domain.ParamsError(err)
+	}
+
+	// 同类申请被拒绝后可重新提交
+	existing, err := i.DS.ReceiptRequestRepo().ListByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range existing {
+		if r.Type == request.Type && r.Status != domain.ReceiptRequestStatusRejected {
+			return nil, domain.ParamsError(domain.ErrReceiptRequestExists)
+		}
+	}
+
+	if err = i.DS.ReceiptRequestRepo().Create(ctx, request); err != nil {
+		return nil, err
+	}
+
+	i.Pusher.Push(ctx, domain.PushEventReceiptRequestCreated, request, domain.StorePushTopic(request.StoreID))
+	return request, nil
+}
+
+func (i *OrderReceiptInteractor) PagedListRequests(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.ReceiptRequestSearchParams,
+	user domain.User,
+) (res *domain.ReceiptRequestSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "OrderReceiptInteractor.PagedListRequests")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	params.StoreID = user.GetStoreID()
+	return i.DS.ReceiptRequestRepo().PagedListBySearch(ctx, page, params)
+}
+
+func (i *OrderReceiptInteractor) HandleRequest(ctx context.Context, id uuid.UUID, accepted bool, remark string, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "OrderReceiptInteractor.HandleRequest")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	request, err := i.DS.ReceiptRequestRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrReceiptRequestNotExists)
+		}
+		return err
+	}
+	if request.MerchantID != user.GetMerchantID() || request.StoreID != user.GetStoreID() {
+		return domain.ParamsError(domain.ErrReceiptRequestNotExists)
+	}
+	if err = request.Handle(accepted, remark, user.GetUserID(), time.Now()); err != nil {
+		return domain.ParamsError(err)
+	}
+	if err = i.DS.ReceiptRequestRepo().Update(ctx, request); err != nil {
+		return err
+	}
+
+	i.Pusher.Push(ctx, domain.PushEventReceiptRequestHandled, request, domain.OrderPushTopic(request.OrderID))
+	return nil
+}
+
+// newLink 签发订单电子小票链接
+func (i *OrderReceiptInteractor) newLink(orderID uuid.UUID) (*domain.ReceiptLink, error) {
+	expiresAt := time.Now().AddDate(0, 0, i.Config.ExpireDays)
+	token, err := SignToken(i.Config.Secret, orderID, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ReceiptLink{
+		URL:       strings.TrimRight(i.Config.BaseURL, "/") + "/receipt/" + token,
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (i *OrderReceiptInteractor) findOrder(ctx context.Context, token string) (*domain.Order, error) {
+	orderID, err := ParseToken(i.Config.Secret, token)
+	if err != nil {
+		return nil, err
+	}
+	order, err := i.DS.OrderRepo().FindByID(ctx, orderID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrReceiptTokenInvalid)
+		}
+		return nil, err
+	}
+	return order, nil
+}
+
+// SignToken 签发电子小票令牌
+func SignToken(secret string, orderID uuid.UUID, expiresAt time.Time) (string, error) {
+	claims := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   orderID.String(),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	})
+	token, err := claims.SignedString([]byte(secret))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign receipt token: %w", err)
+	}
+	return token, nil
+}
+
+// ParseToken 校验电子小票令牌并返回订单ID，令牌无效或过期时返回参数错误
+func ParseToken(secret, token string) (uuid.UUID, error) {
+	claims := &jwt.RegisteredClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil || !parsed.Valid {
+		return uuid.Nil, domain.ParamsError(domain.ErrReceiptTokenInvalid)
+	}
+	orderID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return uuid.Nil, domain.ParamsError(domain.ErrReceiptTokenInvalid)
+	}
+	return orderID, nil
+}
@@ -0,0 +1,210 @@
+package pickup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.PickupCallInteractor = (*PickupCallInteractor)(nil)
+
+type PickupCallInteractor struct {
+	DS     domain.DataStore
+	Seq    domain.DailySequence
+	Pusher domain.Pusher
+}
+
+func NewPickupCallInteractor(ds domain.DataStore, seq domain.DailySequence, pusher domain.Pusher) *PickupCallInteractor {
+	return &PickupCallInteractor{
+		DS:     ds,
+		Seq:    seq,
+		Pusher: pusher,
+	}
+}
+
+func (i *PickupCallInteractor) Assign(ctx context.Context, merchantID, storeID, orderID uuid.UUID) (res *domain.PickupCall, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PickupCallInteractor.Assign")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	order, err := i.DS.OrderRepo().FindByID(ctx, orderID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrPickupOrderInvalid)
+		}
+		return nil, err
+	}
+	if order.MerchantID != merchantID || order.StoreID != storeID || order.OrderStatus == domain.OrderStatusCancelled {
+		return nil, domain.ParamsError(domain.ErrPickupOrderInvalid)
+	}
+
+	res, err = i.DS.PickupCallRepo().FindByOrderID(ctx, orderID)
+	if err == nil {
+		return res, nil
+	}
+	if !domain.IsNotFound(err) {
+		return nil, err
+	}
+
+	if order.PickupNo == "" {
+		order.PickupNo, err = NewPickupNo(ctx, i.DS, i.Seq, order.MerchantID, order.StoreID, order.BusinessDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res = domain.NewPickupCall(order)
+
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		// 只更新订单字段，不替换订单商品
+		order.OrderProducts = nil
+		if err := ds.OrderRepo().Update(ctx, order); err != nil {
+			return err
+		}
+		return ds.PickupCallRepo().Create(ctx, res)
+	})
+	if err != nil {
+		if domain.IsConflict(err) {
+			// 并发分配时返回已创建的记录
+			return i.DS.PickupCallRepo().FindByOrderID(ctx, orderID)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *PickupCallInteractor) Call(ctx context.Context, merchantID, storeID, id uuid.UUID) (res *domain.PickupCall, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PickupCallInteractor.Call")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	res, err = i.findCall(ctx, merchantID, storeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = res.Call(time.Now()); err != nil {
+		return nil, domain.ParamsError(err)
+	}
+	if err = i.DS.PickupCallRepo().Update(ctx, res); err != nil {
+		return nil, err
+	}
+
+	i.Pusher.Push(ctx, domain.PushEventPickupCalled, domain.NewPickupCallPush(res),
+		domain.StorePushTopic(res.StoreID), domain.OrderPushTopic(res.OrderID))
+	return res, nil
+}
+
+func (i *PickupCallInteractor) PickUp(ctx context.Context, merchantID, storeID, id uuid.UUID) (res *domain.PickupCall, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PickupCallInteractor.PickUp")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	res, err = i.findCall(ctx, merchantID, storeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = res.PickUp(time.Now()); err != nil {
+		return nil, domain.ParamsError(err)
+	}
+	if err = i.DS.PickupCallRepo().Update(ctx, res); err != nil {
+		return nil, err
+	}
+
+	i.Pusher.Push(ctx, domain.PushEventPickupPickedUp, domain.NewPickupCallPush(res),
+		domain.StorePushTopic(res.StoreID), domain.OrderPushTopic(res.OrderID))
+	return res, nil
+}
+
+func (i *PickupCallInteractor) Display(ctx context.Context, merchantID, storeID uuid.UUID, businessDate string) (res *domain.PickupDisplay, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PickupCallInteractor.Display")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if err = verifyStore(ctx, i.DS, merchantID, storeID); err != nil {
+		return nil, err
+	}
+	if businessDate == "" {
+		businessDate = time.Now().Format(time.DateOnly)
+	}
+
+	res = &domain.PickupDisplay{BusinessDate: businessDate}
+	params := domain.PickupCallListParams{StoreID: storeID, BusinessDate: businessDate}
+
+	params.Status = domain.PickupCallStatusWaiting
+	if res.Waiting, err = i.DS.PickupCallRepo().List(ctx, params); err != nil {
+		return nil, err
+	}
+	params.Status = domain.PickupCallStatusReady
+	if res.Ready, err = i.DS.PickupCallRepo().List(ctx, params); err != nil {
+		return nil, err
+	}
+	params.Status = domain.PickupCallStatusPickedUp
+	params.Limit = domain.PickupRecentCallSize
+	if res.Recent, err = i.DS.PickupCallRepo().List(ctx, params); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *PickupCallInteractor) findCall(ctx context.Context, merchantID, storeID, id uuid.UUID) (*domain.PickupCall, error) {
+	call, err := i.DS.PickupCallRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrPickupCallNotExists)
+		}
+		return nil, err
+	}
+	if call.MerchantID != merchantID || call.StoreID != storeID {
+		return nil, domain.ParamsError(domain.ErrPickupCallNotExists)
+	}
+	return call, nil
+}
+
+// NewPickupNo 按门店生效的取餐号规则生成营业日内的取餐号，超过结束号后从起始号循环
+func NewPickupNo(ctx context.Context, ds domain.DataStore, seq domain.DailySequence, merchantID, storeID uuid.UUID, businessDate string) (string, error) {
+	rule, err := ResolveRule(ctx, ds, merchantID, storeID)
+	if err != nil {
+		return "", err
+	}
+	n, err := seq.NextInRange(ctx, domain.PickupSequencePrefix(storeID, businessDate), int64(rule.Start), int64(rule.End))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate pickup_no: %w", err)
+	}
+	return rule.Format(n), nil
+}
+
+func verifyStore(ctx context.Context, ds domain.DataStore, merchantID, storeID uuid.UUID) error {
+	store, err := ds.StoreRepo().FindByID(ctx, storeID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return err
+	}
+	if store.MerchantID != merchantID {
+		return domain.ParamsError(domain.ErrStoreNotExists)
+	}
+	return nil
+}
+
+// OpenCall 订单支付送厨后创建待叫号记录，未分配取餐号或已创建时跳过
+func OpenCall(ctx context.Context, ds domain.DataStore, order *domain.Order) error {
+	if order.PickupNo == "" {
+		return nil
+	}
+	_, err := ds.PickupCallRepo().FindByOrderID(ctx, order.ID)
+	if err == nil {
+		return nil
+	}
+	if !domain.IsNotFound(err) {
+		return err
+	}
+	return ds.PickupCallRepo().Create(ctx, domain.NewPickupCall(order))
+}
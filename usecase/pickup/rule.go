@@ -0,0 +1,64 @@
+package pickup
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/storerule"
+)
+
+// rules 取餐号规则：门店规则 > 品牌商默认规则 > 系统默认规则
+var rules = storerule.Rules[*domain.PickupRule]{
+	Repo: func(ds domain.DataStore) domain.StoreRuleRepository[*domain.PickupRule] {
+		return ds.PickupRuleRepo()
+	},
+	Default: domain.DefaultPickupRule,
+	Inherit: true,
+}
+
+var _ domain.PickupRuleInteractor = (*PickupRuleInteractor)(nil)
+
+type PickupRuleInteractor struct {
+	DS domain.DataStore
+}
+
+func NewPickupRuleInteractor(ds domain.DataStore) *PickupRuleInteractor {
+	return &PickupRuleInteractor{
+		DS: ds,
+	}
+}
+
+func (i *PickupRuleInteractor) GetRule(ctx context.Context, user domain.User) (res *domain.PickupRule, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PickupRuleInteractor.GetRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return ResolveRule(ctx, i.DS, user.GetMerchantID(), user.GetStoreID())
+}
+
+// SaveRule 保存当前用户所属层级的规则，首次保存时创建
+func (i *PickupRuleInteractor) SaveRule(ctx context.Context, rule *domain.PickupRule, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PickupRuleInteractor.SaveRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return rules.Save(ctx, i.DS, rule, user)
+}
+
+func (i *PickupRuleInteractor) DeleteRule(ctx context.Context, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PickupRuleInteractor.DeleteRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return rules.Delete(ctx, i.DS, user)
+}
+
+// ResolveRule 查询门店生效的取餐号规则：门店规则 > 品牌商默认规则 > 系统默认规则
+func ResolveRule(ctx context.Context, ds domain.DataStore, merchantID, storeID uuid.UUID) (*domain.PickupRule, error) {
+	return rules.Resolve(ctx, ds, merchantID, storeID)
+}
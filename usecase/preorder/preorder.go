@@ -0,0 +1,674 @@
+package preorder
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/member"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/menuprice"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/pickup"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/promotion"
+)
+
+var _ domain.PreorderInteractor = (*PreorderInteractor)(nil)
+
+type PreorderInteractor struct {
+	DS           domain.DataStore
+	Seq          domain.DailySequence
+	MutexManager domain.MutexManager
+	Scheduler    domain.PreorderScheduler
+	Pusher       domain.Pusher
+}
+
+func NewPreorderInteractor(
+	ds domain.DataStore,
+	seq domain.DailySequence,
+	mutexManager domain.MutexManager,
+	scheduler domain.PreorderScheduler,
+	pusher domain.Pusher,
+) *PreorderInteractor {
+	return &PreorderInteractor{
+		DS:           ds,
+		Seq:          seq,
+		MutexManager: mutexManager,
+		Scheduler:    scheduler,
+		Pusher:       pusher,
+	}
+}
+
+func (i *PreorderInteractor) Slots(ctx context.Context, storeID uuid.UUID, date string) (res []*domain.PreorderSlot, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderInteractor.Slots")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	day, err := time.ParseInLocation(time.DateOnly, date, time.Local)
+	if err != nil {
+		return nil, domain.ParamsError(domain.ErrPreorderDateInvalid)
+	}
+	store, rule, err := i.resolve(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err = checkDate(rule, day, now); err != nil {
+		return nil, err
+	}
+
+	preorders, err := i.DS.PreorderRepo().List(ctx, domain.PreorderListParams{
+		StoreID: store.ID,
+		From:    day,
+		To:      day.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	earliest := rule.Earliest(now)
+	res = make([]*domain.PreorderSlot, 0)
+	for _, at := range rule.SlotTimes(store, day) {
+		remaining := max(rule.SlotCapacity-domain.CountInSlot(preorders, at, at.Add(rule.SlotDuration())), 0)
+		res = append(res, &domain.PreorderSlot{
+			At:        at,
+			Time:      at.Format("15:04"),
+			Capacity:  rule.SlotCapacity,
+			Remaining: remaining,
+			Available: remaining > 0 && !at.Before(earliest),
+		})
+	}
+	return res, nil
+}
+
+func (i *PreorderInteractor) ListMenus(ctx context.Context, storeID uuid.UUID, at time.Time) (res domain.Menus, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderInteractor.ListMenus")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	store, _, err := i.resolve(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	menus, err := i.DS.MenuRepo().ListAllStoreMenus(ctx, domain.MenuListAllParams{
+		MerchantID: store.MerchantID,
+		StoreID:    store.ID,
+		At:         at,
+	})
+	if err != nil {
+		return nil, err
+	}
+	domain.ApplyMenuCurrentPrices(menus, store.DiningPeriods, at)
+	return menuprice.Preorder.FilterMenus(menus), nil
+}
+
+func (i *PreorderInteractor) Submit(ctx context.Context, customer *domain.Customer, params domain.PreorderSubmitParams) (res *domain.Preorder, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderInteractor.Submit")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if err = checkParams(customer, &params); err != nil {
+		return nil, err
+	}
+	store, rule, err := i.resolve(ctx, params.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	if params.Fulfilment == domain.PreorderFulfilmentDelivery && !rule.DeliveryEnabled {
+		return nil, domain.ParamsError(domain.ErrPreorderDeliveryDisabled)
+	}
+
+	// 预约时间需为当天营业时段内的可预约时间，且满足最少提前下单时间
+	now := time.Now()
+	scheduledAt := params.ScheduledAt.In(time.Local)
+	if err = checkDate(rule, startOfDay(scheduledAt), now); err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(rule.SlotTimes(store, scheduledAt), scheduledAt.Equal) {
+		return nil, domain.ParamsError(domain.ErrPreorderTimeInvalid)
+	}
+	if scheduledAt.Before(rule.Earliest(now)) {
+		return nil, domain.ParamsError(domain.ErrPreorderTooLate)
+	}
+
+	// 按预约时间的菜单与就餐时段计价
+	productIDs := lo.Map(params.Items, func(item domain.PreorderItemParams, _ int) uuid.UUID { return item.ProductID })
+	p, err := menuprice.NewPricer(ctx, i.DS, menuprice.Preorder, store, productIDs, scheduledAt)
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]*menuprice.Line, 0, len(params.Items))
+	for _, item := range params.Items {
+		line, err := p.Price(ctx, item.ProductID, item.SpecID, item.Attrs, item.Groups)
+		if err != nil {
+			return nil, domain.ParamsError(err)
+		}
+		lines = append(lines, line)
+	}
+
+	order, err := i.newOrder(ctx, store, customer, params, scheduledAt, now)
+	if err != nil {
+		return nil, err
+	}
+	preorder := &domain.Preorder{
+		ID:              uuid.New(),
+		MerchantID:      store.MerchantID,
+		StoreID:         store.ID,
+		CustomerID:      customer.ID,
+		OrderID:         order.ID,
+		OrderNo:         order.OrderNo,
+		Fulfilment:      params.Fulfilment,
+		ScheduledAt:     scheduledAt,
+		ReleaseAt:       rule.ReleaseAt(scheduledAt),
+		ContactName:     params.ContactName,
+		ContactPhone:    params.ContactPhone,
+		DeliveryAddress: params.DeliveryAddress,
+		Status:          domain.PreorderStatusScheduled,
+	}
+
+	var tickets []*domain.KitchenTicket
+	err = i.withStore(ctx, store.ID, func() error {
+		return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+			booked, err := ds.PreorderRepo().List(ctx, domain.PreorderListParams{
+				StoreID:  store.ID,
+				Statuses: []domain.PreorderStatus{domain.PreorderStatusScheduled, domain.PreorderStatusReleased},
+				From:     scheduledAt,
+				To:       scheduledAt.Add(rule.SlotDuration()),
+			})
+			if err != nil {
+				return err
+			}
+			if len(booked) >= rule.SlotCapacity {
+				return domain.ParamsError(domain.ErrPreorderSlotFull)
+			}
+
+			batch := order.NextBatchIndex()
+			products := make([]domain.OrderProduct, 0, len(lines))
+			for idx, line := range lines {
+				item := params.Items[idx]
+				products = append(products, line.OrderProduct(item.Qty, item.Remark, batch))
+			}
+			order.AddProducts(products)
+			order.AddPlaceOrderLog(domain.OrderOperator{ID: customer.ID, Name: customer.Nickname}, products, now)
+			if err = member.ApplyToOrder(ctx, ds, order); err != nil {
+				return err
+			}
+			if err = promotion.ApplyToOrder(ctx, ds, order); err != nil {
+				return err
+			}
+			if err = ds.OrderRepo().Create(ctx, order); err != nil {
+				return err
+			}
+			if err = ds.PreorderRepo().Create(ctx, preorder); err != nil {
+				return err
+			}
+
+			// 已到送厨时间（提前送厨时间大于最少提前下单时间）时直接送厨，否则创建定时送厨任务，
+			// 任务在事务内创建，事务回滚后任务执行时查询不到预点单会直接跳过
+			if !preorder.ReleaseAt.After(now) {
+				tickets, err = i.release(ctx, ds, preorder, order, now)
+				return err
+			}
+			return i.Scheduler.ScheduleRelease(ctx, preorder)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.Pusher.Push(ctx, domain.PushEventOrderCreated, order, domain.OrderPushTopics(order)...)
+	i.Pusher.Push(ctx, domain.PushEventPreorderCreated, preorder, domain.StorePushTopic(store.ID))
+	i.pushTickets(ctx, tickets)
+	preorder.Order = order
+	return preorder, nil
+}
+
+func (i *PreorderInteractor) ListByCustomer(ctx context.Context, customerID uuid.UUID) (res []*domain.Preorder, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderInteractor.ListByCustomer")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.PreorderRepo().List(ctx, domain.PreorderListParams{
+		CustomerID: customerID,
+		From:       startOfDay(time.Now()),
+	})
+}
+
+func (i *PreorderInteractor) GetByCustomer(ctx context.Context, customerID, id uuid.UUID) (res *domain.Preorder, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderInteractor.GetByCustomer")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	res, err = i.find(ctx, id, ownedByCustomer(customerID))
+	if err != nil {
+		return nil, err
+	}
+	res.Order, err = i.DS.OrderRepo().FindByID(ctx, res.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find preorder order: %w", err)
+	}
+	return res, nil
+}
+
+func (i *PreorderInteractor) CancelByCustomer(ctx context.Context, customerID, id uuid.UUID) (res *domain.Preorder, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderInteractor.CancelByCustomer")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.cancel(ctx, id, ownedByCustomer(customerID), "顾客取消")
+}
+
+func (i *PreorderInteractor) List(ctx context.Context, merchantID, storeID uuid.UUID, date string, statuses []domain.PreorderStatus) (res []*domain.Preorder, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderInteractor.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	day, err := time.ParseInLocation(time.DateOnly, date, time.Local)
+	if err != nil {
+		return nil, domain.ParamsError(domain.ErrPreorderDateInvalid)
+	}
+	if _, err = i.findStore(ctx, merchantID, storeID); err != nil {
+		return nil, err
+	}
+	return i.DS.PreorderRepo().List(ctx, domain.PreorderListParams{
+		StoreID:  storeID,
+		Statuses: statuses,
+		From:     day,
+		To:       day.AddDate(0, 0, 1),
+	})
+}
+
+func (i *PreorderInteractor) Release(ctx context.Context, merchantID, storeID, id uuid.UUID) (res *domain.Preorder, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderInteractor.Release")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.releaseLocked(ctx, id, ownedByStore(merchantID, storeID))
+}
+
+func (i *PreorderInteractor) Cancel(ctx context.Context, merchantID, storeID, id uuid.UUID, reason string) (res *domain.Preorder, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderInteractor.Cancel")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.cancel(ctx, id, ownedByStore(merchantID, storeID), reason)
+}
+
+func (i *PreorderInteractor) ReleaseDue(ctx context.Context, id uuid.UUID) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderInteractor.ReleaseDue")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+	logger := logging.FromContext(ctx).Named("PreorderInteractor.ReleaseDue")
+
+	preorder, err := i.DS.PreorderRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			logger.Infof("预点单[%s]不存在，跳过送厨", id)
+			return nil
+		}
+		return err
+	}
+	if preorder.Status != domain.PreorderStatusScheduled {
+		return nil
+	}
+	_, err = i.releaseLocked(ctx, id, func(*domain.Preorder) bool { return true })
+	if domain.IsParamsError(err) {
+		// 加锁期间已被门店提前送厨或取消
+		logger.Infof("预点单[%s]跳过送厨: %v", id, err)
+		return nil
+	}
+	return err
+}
+
+// releaseLocked 持有门店预点单锁送厨并推送
+func (i *PreorderInteractor) releaseLocked(ctx context.Context, id uuid.UUID, owns func(p *domain.Preorder) bool) (*domain.Preorder, error) {
+	var (
+		preorder *domain.Preorder
+		order    *domain.Order
+		tickets  []*domain.KitchenTicket
+	)
+	p, err := i.find(ctx, id, owns)
+	if err != nil {
+		return nil, err
+	}
+	err = i.withStore(ctx, p.StoreID, func() error {
+		preorder, err = i.find(ctx, id, owns)
+		if err != nil {
+			return err
+		}
+		if preorder.Status != domain.PreorderStatusScheduled {
+			return domain.ParamsError(domain.ErrPreorderStatusInvalid)
+		}
+		order, err = i.DS.OrderRepo().FindByID(ctx, preorder.OrderID)
+		if err != nil {
+			return fmt.Errorf("failed to find preorder order: %w", err)
+		}
+		return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+			tickets, err = i.release(ctx, ds, preorder, order, time.Now())
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.Pusher.Push(ctx, domain.PushEventOrderUpdated, order, domain.OrderPushTopics(order)...)
+	i.Pusher.Push(ctx, domain.PushEventPreorderUpdated, preorder, domain.StorePushTopic(preorder.StoreID))
+	i.pushTickets(ctx, tickets)
+	return preorder, nil
+}
+
+// release 送厨：为订单各批商品生成待出单的厨房单，到店自取的订单分配取餐号并创建待叫号记录
+func (i *PreorderInteractor) release(
+	ctx context.Context,
+	ds domain.DataStore,
+	preorder *domain.Preorder,
+	order *domain.Order,
+	now time.Time,
+) ([]*domain.KitchenTicket, error) {
+	if err := preorder.Release(now); err != nil {
+		return nil, domain.ParamsError(err)
+	}
+	if err := ds.PreorderRepo().Update(ctx, preorder); err != nil {
+		return nil, err
+	}
+
+	if preorder.Fulfilment == domain.PreorderFulfilmentPickup && order.PickupNo == "" {
+		pickupNo, err := pickup.NewPickupNo(ctx, ds, i.Seq, order.MerchantID, order.StoreID, order.BusinessDate)
+		if err != nil {
+			return nil, err
+		}
+		order.PickupNo = pickupNo
+		if err = saveOrder(ctx, ds, order); err != nil {
+			return nil, err
+		}
+		if err = pickup.OpenCall(ctx, ds, order); err != nil {
+			return nil, err
+		}
+	}
+
+	// 出品部门按送厨时的商品配置
+	productIDs := lo.Uniq(lo.Map(order.OrderProducts, func(op domain.OrderProduct, _ int) uuid.UUID { return op.ProductID }))
+	products, err := ds.ProductRepo().ListDetailsBySearch(ctx, domain.ProductSearchParams{
+		MerchantID: order.MerchantID,
+		IDs:        productIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list products: %w", err)
+	}
+	stallIDs := make(map[uuid.UUID]uuid.UUID, len(products))
+	for _, p := range products {
+		stallIDs[p.ID] = p.EffectiveStallID()
+	}
+
+	batches := lo.Uniq(lo.Map(order.OrderProducts, func(op domain.OrderProduct, _ int) int { return op.Index }))
+	slices.Sort(batches)
+	tickets := make([]*domain.KitchenTicket, 0, len(batches))
+	for _, batch := range batches {
+		ticket := domain.NewKitchenTicket(order, batch, false, stallIDs)
+		if err := ds.KitchenTicketRepo().Create(ctx, ticket); err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, ticket)
+	}
+	return tickets, nil
+}
+
+// cancel 持有门店预点单锁取消送厨前的预点单并取消订单，已支付的订单需由门店处理退款
+func (i *PreorderInteractor) cancel(ctx context.Context, id uuid.UUID, owns func(p *domain.Preorder) bool, reason string) (*domain.Preorder, error) {
+	var (
+		preorder *domain.Preorder
+		order    *domain.Order
+	)
+	p, err := i.find(ctx, id, owns)
+	if err != nil {
+		return nil, err
+	}
+	err = i.withStore(ctx, p.StoreID, func() error {
+		preorder, err = i.find(ctx, id, owns)
+		if err != nil {
+			return err
+		}
+		order, err = i.DS.OrderRepo().FindByID(ctx, preorder.OrderID)
+		if err != nil {
+			return fmt.Errorf("failed to find preorder order: %w", err)
+		}
+		if err = preorder.Cancel(reason, time.Now()); err != nil {
+			return domain.ParamsError(err)
+		}
+		if order.PaymentStatus == domain.PaymentStatusPaid || order.PaymentStatus == domain.PaymentStatusPaying {
+			return domain.ParamsError(domain.ErrPreorderOrderPaid)
+		}
+		order.OrderStatus = domain.OrderStatusCancelled
+		return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+			if err := ds.PreorderRepo().Update(ctx, preorder); err != nil {
+				return err
+			}
+			return saveOrder(ctx, ds, order)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 定时送厨任务执行时预点单已取消，直接跳过
+	i.Pusher.Push(ctx, domain.PushEventOrderUpdated, order, domain.OrderPushTopics(order)...)
+	i.Pusher.Push(ctx, domain.PushEventPreorderUpdated, preorder, domain.StorePushTopic(preorder.StoreID))
+	return preorder, nil
+}
+
+// pushTickets 通知门店厨房单已可出单
+func (i *PreorderInteractor) pushTickets(ctx context.Context, tickets []*domain.KitchenTicket) {
+	for _, ticket := range tickets {
+		i.Pusher.Push(ctx, domain.PushEventKitchenTicketCreated, ticket, domain.StorePushTopic(ticket.StoreID))
+	}
+}
+
+// resolve 查询门店与预点单规则，校验门店营业状态和预点单开关
+func (i *PreorderInteractor) resolve(ctx context.Context, storeID uuid.UUID) (*domain.Store, *domain.PreorderRule, error) {
+	store, err := i.findStore(ctx, uuid.Nil, storeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if store.Status != domain.StoreStatusOpen {
+		return nil, nil, domain.ParamsError(domain.ErrPreorderStoreClosed)
+	}
+	rule, err := ResolveRule(ctx, i.DS, store.MerchantID, store.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !rule.Enabled {
+		return nil, nil, domain.ParamsError(domain.ErrPreorderDisabled)
+	}
+	return store, rule, nil
+}
+
+// findStore 查询门店详情，merchantID 不为空时校验门店归属
+func (i *PreorderInteractor) findStore(ctx context.Context, merchantID, storeID uuid.UUID) (*domain.Store, error) {
+	store, err := i.DS.StoreRepo().GetDetail(ctx, storeID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return nil, err
+	}
+	if merchantID != uuid.Nil && store.MerchantID != merchantID {
+		return nil, domain.ParamsError(domain.ErrStoreNotExists)
+	}
+	return store, nil
+}
+
+func (i *PreorderInteractor) find(ctx context.Context, id uuid.UUID, owns func(p *domain.Preorder) bool) (*domain.Preorder, error) {
+	p, err := i.DS.PreorderRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrPreorderNotExists)
+		}
+		return nil, err
+	}
+	if !owns(p) {
+		return nil, domain.ParamsError(domain.ErrPreorderNotExists)
+	}
+	return p, nil
+}
+
+// withStore 持有门店预点单锁执行，时段容量校验、送厨与取消串行进行
+func (i *PreorderInteractor) withStore(ctx context.Context, storeID uuid.UUID, fn func() error) error {
+	mu := i.MutexManager.NewMutex(domain.NewMutexPreorderKey(storeID))
+	if err := mu.Lock(ctx); err != nil {
+		if domain.IsAlreadyTakenError(err) {
+			return domain.ParamsError(domain.ErrPreorderBusy)
+		}
+		return err
+	}
+	defer func() {
+		_, _ = mu.Unlock(ctx)
+	}()
+	return fn()
+}
+
+// newOrder 创建顾客预点单订单，营业日为预约日期；顾客已是品牌会员时关联会员
+func (i *PreorderInteractor) newOrder(
+	ctx context.Context,
+	store *domain.Store,
+	customer *domain.Customer,
+	params domain.PreorderSubmitParams,
+	scheduledAt, now time.Time,
+) (*domain.Order, error) {
+	orderNo, err := i.newOrderNo(ctx, store, now)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &domain.Order{
+		ID:            uuid.New(),
+		MerchantID:    store.MerchantID,
+		StoreID:       store.ID,
+		BusinessDate:  scheduledAt.Format(time.DateOnly),
+		OrderNo:       orderNo,
+		OrderType:     domain.OrderTypeSale,
+		PlacedAt:      now,
+		PlacedBy:      customer.ID,
+		PlacedByName:  customer.Nickname,
+		DiningMode:    params.Fulfilment.DiningMode(),
+		OrderStatus:   domain.OrderStatusPlaced,
+		PaymentStatus: domain.PaymentStatusUnpaid,
+		Channel:       domain.ChannelApp,
+		Remark:        params.Remark,
+		Store: domain.OrderStore{
+			ID:           store.ID,
+			MerchantID:   store.MerchantID,
+			StoreCode:    store.StoreCode,
+			ContactPhone: store.ContactPhone,
+			StoreName:    store.StoreName,
+		},
+	}
+	if store.Merchant != nil {
+		order.Store.MerchantName = store.Merchant.MerchantName
+	}
+
+	m, err := i.DS.MemberRepo().FindByOpenID(ctx, store.MerchantID, customer.OpenID)
+	if err != nil {
+		if !domain.IsNotFound(err) {
+			return nil, err
+		}
+	} else if m.Enabled {
+		order.Member = m.ToOrderMember()
+	}
+	return order, nil
+}
+
+// newOrderNo 生成订单号：门店编码 + YYYYMMDD + 6位当日序号，与 POS 下单共用序号
+func (i *PreorderInteractor) newOrderNo(ctx context.Context, store *domain.Store, now time.Time) (string, error) {
+	prefix := fmt.Sprintf("%s:%s", domain.DailySequencePrefixOrderNo, store.ID.String())
+	seq, err := i.Seq.Next(ctx, prefix)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate order_no: %w", err)
+	}
+	return fmt.Sprintf("%s%s%06d", store.StoreCode, now.Format("20060102"), seq), nil
+}
+
+// saveOrder 只更新订单信息，不重写订单商品
+func saveOrder(ctx context.Context, ds domain.DataStore, order *domain.Order) error {
+	products := order.OrderProducts
+	order.OrderProducts = nil
+	defer func() {
+		order.OrderProducts = products
+	}()
+	return ds.OrderRepo().Update(ctx, order)
+}
+
+// checkParams 校验下单参数，联系人未填写时使用顾客昵称和手机号
+func checkParams(customer *domain.Customer, params *domain.PreorderSubmitParams) error {
+	if !params.Fulfilment.Valid() {
+		return domain.ParamsError(domain.ErrPreorderFulfilmentInvalid)
+	}
+	if len(params.Items) == 0 {
+		return domain.ParamsError(domain.ErrPreorderCartEmpty)
+	}
+	for _, item := range params.Items {
+		if item.Qty <= 0 {
+			return domain.ParamsError(domain.ErrPreorderItemQtyInvalid)
+		}
+	}
+	params.ContactName = strings.TrimSpace(params.ContactName)
+	params.ContactPhone = strings.TrimSpace(params.ContactPhone)
+	if params.ContactName == "" {
+		params.ContactName = customer.Nickname
+	}
+	if params.ContactPhone == "" {
+		params.ContactPhone = customer.Phone
+	}
+	if params.ContactName == "" || params.ContactPhone == "" {
+		return domain.ParamsError(domain.ErrPreorderContactRequired)
+	}
+	params.DeliveryAddress = strings.TrimSpace(params.DeliveryAddress)
+	if params.Fulfilment == domain.PreorderFulfilmentDelivery && params.DeliveryAddress == "" {
+		return domain.ParamsError(domain.ErrPreorderAddressRequired)
+	}
+	return nil
+}
+
+func ownedByStore(merchantID, storeID uuid.UUID) func(p *domain.Preorder) bool {
+	return func(p *domain.Preorder) bool {
+		return p.MerchantID == merchantID && p.StoreID == storeID
+	}
+}
+
+func ownedByCustomer(customerID uuid.UUID) func(p *domain.Preorder) bool {
+	return func(p *domain.Preorder) bool {
+		return p.CustomerID == customerID
+	}
+}
+
+// checkDate 校验预约日期在今天至最多可提前天数之间
+func checkDate(rule *domain.PreorderRule, day, now time.Time) error {
+	today := startOfDay(now)
+	if day.Before(today) || day.After(today.AddDate(0, 0, rule.MaxAdvanceDays)) {
+		return domain.ParamsError(domain.ErrPreorderDateInvalid)
+	}
+	return nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
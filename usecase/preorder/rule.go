@@ -0,0 +1,54 @@
+package preorder
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/storerule"
+)
+
+// rules 门店预点单规则，未配置时使用默认规则
+var rules = storerule.Rules[*domain.PreorderRule]{
+	Repo: func(ds domain.DataStore) domain.StoreRuleRepository[*domain.PreorderRule] {
+		return ds.PreorderRuleRepo()
+	},
+	Default: domain.DefaultPreorderRule,
+}
+
+var _ domain.PreorderRuleInteractor = (*PreorderRuleInteractor)(nil)
+
+type PreorderRuleInteractor struct {
+	DS domain.DataStore
+}
+
+func NewPreorderRuleInteractor(ds domain.DataStore) *PreorderRuleInteractor {
+	return &PreorderRuleInteractor{
+		DS: ds,
+	}
+}
+
+func (i *PreorderRuleInteractor) GetRule(ctx context.Context, user domain.User) (res *domain.PreorderRule, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderRuleInteractor.GetRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return ResolveRule(ctx, i.DS, user.GetMerchantID(), user.GetStoreID())
+}
+
+// SaveRule 保存当前门店的预点单规则，首次保存时创建
+func (i *PreorderRuleInteractor) SaveRule(ctx context.Context, rule *domain.PreorderRule, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PreorderRuleInteractor.SaveRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return rules.Save(ctx, i.DS, rule, user)
+}
+
+// ResolveRule 查询门店的预点单规则，未配置时返回默认规则
+func ResolveRule(ctx context.Context, ds domain.DataStore, merchantID, storeID uuid.UUID) (*domain.PreorderRule, error) {
+	return rules.Resolve(ctx, ds, merchantID, storeID)
+}
@@ -0,0 +1,484 @@
+package pricechange
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
+)
+
+// 执行失败原因最大长度（与数据库字段长度一致）
+const maxFailReasonLen = 1000
+
+var _ domain.PriceChangeInteractor = (*PriceChangeInteractor)(nil)
+
+type PriceChangeInteractor struct {
+	DS domain.DataStore
+}
+
+func NewPriceChangeInteractor(ds domain.DataStore) *PriceChangeInteractor {
+	return &PriceChangeInteractor{
+		DS: ds,
+	}
+}
+
+func (i *PriceChangeInteractor) Create(ctx context.Context, batch *domain.PriceChangeBatch, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PriceChangeInteractor.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if !batch.EffectiveAt.After(time.Now()) {
+		return domain.ParamsError(domain.ErrPriceChangeEffectiveAtInvalid)
+	}
+	if len(batch.Items) == 0 {
+		return domain.ParamsError(domain.ErrPriceChangeItemsEmpty)
+	}
+
+	// 门店端只能调整本门店的菜单价
+	if user.GetStoreID() != uuid.Nil {
+		batch.StoreIDs = []uuid.UUID{user.GetStoreID()}
+	}
+	batch.StoreIDs = lo.Uniq(batch.StoreIDs)
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		if err := validateStores(ctx, ds, batch); err != nil {
+			return err
+		}
+
+		items, err := buildItems(ctx, ds, batch, user)
+		if err != nil {
+			return err
+		}
+		batch.Items = items
+		batch.Status = domain.PriceChangeBatchStatusPending
+		batch.AddLog(domain.PriceChangeOperationTypeCreate, domain.PriceChangeOperator{
+			ID:   batch.OperatorID,
+			Name: batch.OperatorName,
+		}, fmt.Sprintf("创建调价批次，共 %d 项，生效时间 %s", len(items), batch.EffectiveAt.Format(time.DateTime)))
+
+		return ds.PriceChangeRepo().Create(ctx, batch)
+	})
+}
+
+func (i *PriceChangeInteractor) GetDetail(ctx context.Context, id uuid.UUID, user domain.User) (res *domain.PriceChangeBatch, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PriceChangeInteractor.GetDetail")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	batch, err := i.DS.PriceChangeRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrPriceChangeBatchNotExists)
+		}
+		return nil, err
+	}
+	if !domain.VerifyOwnerShip(user, batch.MerchantID, batch.StoreID) {
+		return nil, domain.ParamsError(domain.ErrPriceChangeBatchNotExists)
+	}
+	return batch, nil
+}
+
+func (i *PriceChangeInteractor) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.PriceChangeSearchParams,
+) (res *domain.PriceChangeSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PriceChangeInteractor.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.PriceChangeRepo().PagedListBySearch(ctx, page, params)
+}
+
+func (i *PriceChangeInteractor) Cancel(ctx context.Context, id uuid.UUID, user domain.User, operator domain.PriceChangeOperator) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PriceChangeInteractor.Cancel")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		batch, err := findBatchForUpdate(ctx, ds, id, user)
+		if err != nil {
+			return err
+		}
+		if batch.Status != domain.PriceChangeBatchStatusPending {
+			return domain.ParamsError(domain.ErrPriceChangeBatchCannotCancel)
+		}
+
+		batch.Status = domain.PriceChangeBatchStatusCancelled
+		batch.AddLog(domain.PriceChangeOperationTypeCancel, operator, "取消调价批次")
+		return ds.PriceChangeRepo().Update(ctx, batch)
+	})
+}
+
+func (i *PriceChangeInteractor) Rollback(ctx context.Context, id uuid.UUID, user domain.User, operator domain.PriceChangeOperator) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PriceChangeInteractor.Rollback")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		batch, err := findBatchForUpdate(ctx, ds, id, user)
+		if err != nil {
+			return err
+		}
+		if batch.Status != domain.PriceChangeBatchStatusApplied {
+			return domain.ParamsError(domain.ErrPriceChangeBatchCannotRollback)
+		}
+
+		restored, skipped := 0, 0
+		for _, item := range batch.Items {
+			if !item.Applied {
+				continue
+			}
+			ok, changed, err := rollbackItem(ctx, ds, item, domain.ProductVersionOperator{
+				ID:   operator.ID,
+				Type: user.GetUserType(),
+				Name: operator.Name,
+			})
+			if err != nil {
+				return err
+			}
+			if ok {
+				restored++
+			}
+			if changed {
+				skipped++
+			}
+		}
+
+		content := fmt.Sprintf("回滚调价批次，恢复 %d 项原价", restored)
+		if skipped > 0 {
+			content += fmt.Sprintf("，%d 项价格在生效后已被修改，未恢复", skipped)
+		}
+		batch.Status = domain.PriceChangeBatchStatusRolledBack
+		batch.AddLog(domain.PriceChangeOperationTypeRollback, operator, content)
+		return ds.PriceChangeRepo().Update(ctx, batch)
+	})
+}
+
+func (i *PriceChangeInteractor) ApplyDueBatches(ctx context.Context) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PriceChangeInteractor.ApplyDueBatches")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+	logger := logging.FromContext(ctx).Named("PriceChangeInteractor.ApplyDueBatches")
+	ctx = logging.NewContext(ctx, logger)
+
+	ids, err := i.DS.PriceChangeRepo().ListDueIDs(ctx, time.Now())
+	if err != nil {
+		logger.Errorf("获取待生效的调价批次失败: %v", err)
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	logger.Infof("找到 %d 个待生效的调价批次", len(ids))
+
+	var failed []string
+	for _, id := range ids {
+		if err := i.applyBatch(ctx, id); err != nil {
+			logger.Errorf("调价批次 %s 执行失败: %v", id, err)
+			failed = append(failed, fmt.Sprintf("batch_id=%s err=%v", id, err))
+
+			// 整批回退后单独记录失败状态，避免下次重复执行
+			if markErr := i.markFailed(ctx, id, err); markErr != nil {
+				logger.Errorf("调价批次 %s 标记失败状态失败: %v", id, markErr)
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("部分调价批次执行失败: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// applyBatch 在同一事务内执行整个调价批次，任一明细失败则整批回退
+func (i *PriceChangeInteractor) applyBatch(ctx context.Context, id uuid.UUID) error {
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		batch, err := ds.PriceChangeRepo().FindForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		// 已被取消或已处理
+		if batch.Status != domain.PriceChangeBatchStatusPending {
+			return nil
+		}
+
+		applied, skipped := 0, 0
+		for _, item := range batch.Items {
+			if err := applyItem(ctx, ds, item, domain.ProductVersionSystemOperator); err != nil {
+				return fmt.Errorf("商品 %s %s: %w", item.ProductName, item.SpecName, err)
+			}
+			if item.Applied {
+				applied++
+			} else {
+				skipped++
+			}
+		}
+
+		now := time.Now()
+		batch.Status = domain.PriceChangeBatchStatusApplied
+		batch.AppliedAt = &now
+		batch.AddLog(domain.PriceChangeOperationTypeApply, domain.PriceChangeSystemOperator,
+			fmt.Sprintf("调价生效，成功 %d 项，跳过 %d 项（门店菜单未包含该商品）", applied, skipped))
+		return ds.PriceChangeRepo().Update(ctx, batch)
+	})
+}
+
+// markFailed 记录调价批次执行失败
+func (i *PriceChangeInteractor) markFailed(ctx context.Context, id uuid.UUID, cause error) error {
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		batch, err := ds.PriceChangeRepo().FindForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if batch.Status != domain.PriceChangeBatchStatusPending {
+			return nil
+		}
+
+		reason := []rune(cause.Error())
+		if len(reason) > maxFailReasonLen {
+			reason = reason[:maxFailReasonLen]
+		}
+		batch.Status = domain.PriceChangeBatchStatusFailed
+		batch.FailReason = string(reason)
+		batch.AddLog(domain.PriceChangeOperationTypeFail, domain.PriceChangeSystemOperator, "调价执行失败，价格未变更")
+		return ds.PriceChangeRepo().Update(ctx, batch)
+	})
+}
+
+// ============================================
+// 内部函数
+// ============================================
+
+func findBatchForUpdate(ctx context.Context, ds domain.DataStore, id uuid.UUID, user domain.User) (*domain.PriceChangeBatch, error) {
+	batch, err := ds.PriceChangeRepo().FindForUpdate(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrPriceChangeBatchNotExists)
+		}
+		return nil, err
+	}
+	if !domain.VerifyOwnerShip(user, batch.MerchantID, batch.StoreID) {
+		return nil, domain.ParamsError(domain.ErrPriceChangeBatchNotExists)
+	}
+	return batch, nil
+}
+
+// validateStores 检查门店是否有效且属于当前品牌商
+func validateStores(ctx context.Context, ds domain.DataStore, batch *domain.PriceChangeBatch) error {
+	if len(batch.StoreIDs) == 0 {
+		return nil
+	}
+	stores, err := ds.StoreRepo().ListByIDs(ctx, batch.StoreIDs)
+	if err != nil {
+		return err
+	}
+	if len(stores) != len(batch.StoreIDs) {
+		return domain.ParamsError(domain.ErrPriceChangeStoreInvalid)
+	}
+	for _, store := range stores {
+		if store.MerchantID != batch.MerchantID {
+			return domain.ParamsError(domain.ErrPriceChangeStoreInvalid)
+		}
+	}
+	return nil
+}
+
+// buildItems 校验调价明细并补充快照信息，菜单价按适用门店展开
+func buildItems(ctx context.Context, ds domain.DataStore, batch *domain.PriceChangeBatch, user domain.User) (domain.PriceChangeItems, error) {
+	productIDs := lo.Uniq(lo.Map(batch.Items, func(item *domain.PriceChangeItem, _ int) uuid.UUID {
+		return item.ProductID
+	}))
+	products, err := ds.ProductRepo().ListByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	productMap := lo.SliceToMap(products, func(product *domain.Product) (uuid.UUID, *domain.Product) {
+		return product.ID, product
+	})
+
+	items := make(domain.PriceChangeItems, 0, len(batch.Items))
+	for _, item := range batch.Items {
+		if item.NewPrice.IsNegative() {
+			return nil, domain.ParamsError(domain.ErrPriceChangeItemInvalid)
+		}
+		product, ok := productMap[item.ProductID]
+		if !ok || product.MerchantID != batch.MerchantID {
+			return nil, domain.ParamsError(fmt.Errorf("商品ID %s 不存在", item.ProductID))
+		}
+
+		switch item.TargetType {
+		case domain.PriceChangeTargetTypeProductSpec:
+			// 规格价影响商品本身，仅允许调整本级创建的商品
+			if !domain.VerifyOwnerShip(user, product.MerchantID, product.StoreID) {
+				return nil, domain.ParamsError(domain.ErrPriceChangeItemInvalid)
+			}
+			rel, err := ds.ProductSpecRelRepo().FindByProductAndSpec(ctx, item.ProductID, item.SpecID)
+			if err != nil {
+				if domain.IsNotFound(err) {
+					return nil, domain.ParamsError(domain.ErrPriceChangeProductSpecNotExists)
+				}
+				return nil, err
+			}
+			items = append(items, &domain.PriceChangeItem{
+				ID:          uuid.New(),
+				BatchID:     batch.ID,
+				TargetType:  item.TargetType,
+				ProductID:   item.ProductID,
+				SpecID:      item.SpecID,
+				ProductName: product.Name,
+				SpecName:    rel.SpecName,
+				NewPrice:    item.NewPrice,
+			})
+		case domain.PriceChangeTargetTypeMenuItem:
+			if len(batch.StoreIDs) == 0 {
+				return nil, domain.ParamsError(domain.ErrPriceChangeMenuItemNoStore)
+			}
+			for _, storeID := range batch.StoreIDs {
+				items = append(items, &domain.PriceChangeItem{
+					ID:          uuid.New(),
+					BatchID:     batch.ID,
+					TargetType:  item.TargetType,
+					ProductID:   item.ProductID,
+					StoreID:     storeID,
+					ProductName: product.Name,
+					NewPrice:    item.NewPrice,
+				})
+			}
+		default:
+			return nil, domain.ParamsError(domain.ErrPriceChangeItemInvalid)
+		}
+	}
+	return items, nil
+}
+
+// applyItem 执行单个调价明细，记录原价用于回滚
+func applyItem(ctx context.Context, ds domain.DataStore, item *domain.PriceChangeItem, operator domain.ProductVersionOperator) error {
+	switch item.TargetType {
+	case domain.PriceChangeTargetTypeProductSpec:
+		rel, err := ds.ProductSpecRelRepo().FindByProductAndSpec(ctx, item.ProductID, item.SpecID)
+		if err != nil {
+			return err
+		}
+		if err = ds.ProductSpecRelRepo().UpdateBasePrice(ctx, rel.ID, item.NewPrice); err != nil {
+			return err
+		}
+		if err = productversion.RecordProduct(ctx, ds, item.ProductID, domain.ProductVersionActionUpdate, operator); err != nil {
+			return err
+		}
+		oldPrice := rel.BasePrice
+		item.OldPrice = &oldPrice
+		item.TargetID = rel.ID
+		item.Applied = true
+	case domain.PriceChangeTargetTypeMenuItem:
+		menuItems, err := ds.MenuRepo().ListStoreMenuItemsByProductID(ctx, item.StoreID, item.ProductID)
+		if err != nil {
+			return err
+		}
+		// 门店菜单未包含该商品，跳过
+		if len(menuItems) == 0 {
+			return nil
+		}
+		newPrice := item.NewPrice
+		oldPrices := make([]domain.PriceChangeMenuItemOldPrice, 0, len(menuItems))
+		for _, menuItem := range menuItems {
+			if err = ds.MenuRepo().UpdateItemBasePrice(ctx, menuItem.ID, &newPrice); err != nil {
+				return err
+			}
+			if err = productversion.SyncMenuDraftItem(ctx, ds, menuItem.MenuID, menuItem.ProductID); err != nil {
+				return err
+			}
+			if err = productversion.RecordMenuItem(ctx, ds, menuItem.MenuID, menuItem.ProductID, operator); err != nil {
+				return err
+			}
+			// 原菜单价为空表示使用商品规格价，回滚时清除菜单价
+			oldPrices = append(oldPrices, domain.PriceChangeMenuItemOldPrice{
+				MenuItemID: menuItem.ID,
+				OldPrice:   menuItem.BasePrice,
+			})
+		}
+		item.OldPrice = menuItems[0].BasePrice
+		item.TargetID = menuItems[0].ID
+		item.MenuItemOldPrices = oldPrices
+		item.Applied = true
+	default:
+		return domain.ErrPriceChangeItemInvalid
+	}
+	return ds.PriceChangeRepo().UpdateItem(ctx, item)
+}
+
+// rollbackItem 恢复单个调价明细的原价，目标已不存在时跳过；
+// 当前价格与本批次调整后的价格不一致（被其他批次或手工修改）时不覆盖，changed 返回 true
+func rollbackItem(ctx context.Context, ds domain.DataStore, item *domain.PriceChangeItem, operator domain.ProductVersionOperator) (restored, changed bool, err error) {
+	switch item.TargetType {
+	case domain.PriceChangeTargetTypeProductSpec:
+		if item.OldPrice == nil {
+			return false, false, nil
+		}
+		rel, err := ds.ProductSpecRelRepo().FindByProductAndSpec(ctx, item.ProductID, item.SpecID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return false, false, nil
+			}
+			return false, false, err
+		}
+		if !rel.BasePrice.Equal(item.NewPrice) {
+			return false, true, nil
+		}
+		if err = ds.ProductSpecRelRepo().UpdateBasePrice(ctx, rel.ID, *item.OldPrice); err != nil {
+			return false, false, err
+		}
+		if err = productversion.RecordProduct(ctx, ds, item.ProductID, domain.ProductVersionActionUpdate, operator); err != nil {
+			return false, false, err
+		}
+		return true, false, nil
+	case domain.PriceChangeTargetTypeMenuItem:
+		menuItems, err := ds.MenuRepo().ListStoreMenuItemsByProductID(ctx, item.StoreID, item.ProductID)
+		if err != nil {
+			return false, false, err
+		}
+		oldPrices := make(map[uuid.UUID]*decimal.Decimal, len(item.MenuItemOldPrices))
+		for _, p := range item.MenuItemOldPrices {
+			oldPrices[p.MenuItemID] = p.OldPrice
+		}
+		for _, menuItem := range menuItems {
+			oldPrice, ok := oldPrices[menuItem.ID]
+			// 生效后新加入菜单的菜单项未被本次调价修改，保持不变
+			if !ok {
+				continue
+			}
+			if menuItem.BasePrice == nil || !menuItem.BasePrice.Equal(item.NewPrice) {
+				changed = true
+				continue
+			}
+			if err = ds.MenuRepo().UpdateItemBasePrice(ctx, menuItem.ID, oldPrice); err != nil {
+				return false, false, err
+			}
+			if err = productversion.SyncMenuDraftItem(ctx, ds, menuItem.MenuID, menuItem.ProductID); err != nil {
+				return false, false, err
+			}
+			if err = productversion.RecordMenuItem(ctx, ds, menuItem.MenuID, menuItem.ProductID, operator); err != nil {
+				return false, false, err
+			}
+			restored = true
+		}
+		return restored, changed, nil
+	default:
+		return false, false, nil
+	}
+}
@@ -13,15 +13,36 @@ import (
 var _ domain.ProductInteractor = (*ProductInteractor)(nil)
 
 type ProductInteractor struct {
-	DS domain.DataStore
+	DS              domain.DataStore
+	Storage         domain.ObjectStorage
+	Pusher          domain.Pusher
+	DeliverySetting domain.DeliverySettingInteractor
 }
 
-func NewProductInteractor(ds domain.DataStore) *ProductInteractor {
+func NewProductInteractor(
+	ds domain.DataStore,
+	storage domain.ObjectStorage,
+	pusher domain.Pusher,
+	deliverySetting domain.DeliverySettingInteractor,
+) *ProductInteractor {
 	return &ProductInteractor{
-		DS: ds,
+		DS:              ds,
+		Storage:         storage,
+		Pusher:          pusher,
+		DeliverySetting: deliverySetting,
 	}
 }
 
+// pushSaleStatus 通知客户端商品售卖状态变更，门店商品推送到门店，品牌商品推送到品牌商；同时同步到已绑定的外卖平台
+func (i *ProductInteractor) pushSaleStatus(ctx context.Context, product *domain.Product) {
+	topic := domain.ScopedPushTopic(product.MerchantID, product.StoreID)
+	i.Pusher.Push(ctx, domain.PushEventProductSaleStatus, &domain.ProductSaleStatusPush{
+		ProductID:  product.ID,
+		SaleStatus: product.SaleStatus,
+	}, topic)
+	i.DeliverySetting.SyncAvailability(ctx, product)
+}
+
 // validateSpecRelations 校验规格关联
 func validateSpecRelations(product *domain.Product) (err error) {
 	if len(product.SpecRelations) == 0 {
@@ -46,6 +67,11 @@ func validateSpecRelations(product *domain.Product) (err error) {
 		if specRel.IsDefault {
 			defaultSpecCount++
 		}
+
+		// 验证时段价格配置
+		if err = specRel.PeriodPrices.Validate(); err != nil {
+			return domain.ParamsError(err)
+		}
 	}
 
 	if defaultSpecCount != 1 {
@@ -79,6 +105,11 @@ func validateAttrRelations(product *domain.Product) (err error) {
 			return domain.ParamsError(domain.ErrProductAttrRelationNoDefault)
 		}
 	}
+
+	// 验证口味做法分组点单限制
+	if err = product.AttrLimits.Validate(product.AttrRelations); err != nil {
+		return domain.ParamsError(err)
+	}
 	return nil
 }
 
@@ -125,6 +156,13 @@ func validateSetMealGroups(product *domain.Product) (err error) {
 					}
 				}
 			}
+
+			// 验证备选商品加价：只能为备选商品配置，且不能为负数
+			for optionalProductID, surcharge := range detail.OptionalSurcharges {
+				if !lo.Contains(detail.OptionalProductIDs, optionalProductID) || surcharge.IsNegative() {
+					return domain.ParamsError(domain.ErrSetMealGroupSurchargeInvalid)
+				}
+			}
 		}
 
 		if defaultDetailCount != 1 {
@@ -142,7 +180,7 @@ func validateProductParams(product *domain.Product) (err error) {
 	}
 
 	// 2. 验证口味做法关联
-	if len(product.AttrRelations) > 0 {
+	if len(product.AttrRelations) > 0 || len(product.AttrLimits) > 0 {
 		if err = validateAttrRelations(product); err != nil {
 			return err
 		}
@@ -337,6 +375,7 @@ func (i *ProductInteractor) validateProductBusinessRules(ctx context.Context, ds
 				productIDs = append(productIDs, detail.OptionalProductIDs...)
 			}
 		}
+		productIDs = lo.Uniq(productIDs)
 		setmealProducts, err := ds.ProductRepo().ListByIDs(ctx, productIDs)
 		if err != nil {
 			return err
@@ -439,3 +478,37 @@ func (i *ProductInteractor) checkStall(ctx context.Context, ds domain.DataStore,
 	}
 	return nil
 }
+
+// inheritSetMealDietary 根据套餐组中的子商品计算套餐的过敏原与饮食属性
+func inheritSetMealDietary(ctx context.Context, ds domain.DataStore, setMeal *domain.Product) error {
+	components, err := ds.ProductRepo().ListByIDs(ctx, setMeal.Groups.ComponentIDs())
+	if err != nil {
+		return err
+	}
+	setMeal.InheritDietary(lo.KeyBy(components, func(p *domain.Product) uuid.UUID { return p.ID }))
+	return nil
+}
+
+// refreshSetMealDietary 子商品变更后重新计算包含该商品的套餐的过敏原与饮食属性
+func refreshSetMealDietary(ctx context.Context, ds domain.DataStore, productID uuid.UUID) error {
+	setMealIDs, err := ds.SetMealGroupRepo().ListSetMealIDsByProductID(ctx, productID)
+	if err != nil {
+		return err
+	}
+	for _, id := range setMealIDs {
+		setMeal, err := ds.ProductRepo().GetDetail(ctx, id)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if err = inheritSetMealDietary(ctx, ds, setMeal); err != nil {
+			return err
+		}
+		if err = ds.ProductRepo().UpdateDietary(ctx, setMeal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
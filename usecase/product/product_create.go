@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
 )
 
 func (i *ProductInteractor) Create(ctx context.Context, product *domain.Product, user domain.User) (err error) {
@@ -43,7 +44,9 @@ func (i *ProductInteractor) Create(ctx context.Context, product *domain.Product,
 				return err
 			}
 		}
-		return nil
+
+		// 记录商品历史版本
+		return productversion.RecordProduct(ctx, ds, product.ID, domain.ProductVersionActionCreate, productversion.OperatorFromUser(user))
 	})
 	return err
 }
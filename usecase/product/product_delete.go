@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
 )
 
 func (i *ProductInteractor) Delete(ctx context.Context, id uuid.UUID, user domain.User) (err error) {
@@ -40,7 +41,12 @@ func (i *ProductInteractor) Delete(ctx context.Context, id uuid.UUID, user domai
 			}
 		}
 
-		// 4. 删除商品
+		// 4. 记录删除前的商品历史版本
+		if err = productversion.RecordProduct(ctx, ds, id, domain.ProductVersionActionDelete, productversion.OperatorFromUser(user)); err != nil {
+			return err
+		}
+
+		// 5. 删除商品
 		err = ds.ProductRepo().Delete(ctx, id)
 		if err != nil {
 			return err
@@ -10,6 +10,7 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
 )
 
 const (
@@ -74,7 +75,7 @@ func (i *ProductInteractor) Distribute(ctx context.Context, params domain.Produc
 				return
 			default:
 				logger.Infof("开始处理门店下任务：worker_id=%d, store_id=%s", workerID, storeID.String())
-				err := i.distributeToStore(ctx, product, storeID)
+				err := i.distributeToStore(ctx, product, storeID, user)
 				results <- jobResult{
 					storeID: storeID,
 					err:     err,
@@ -122,6 +123,7 @@ func (i *ProductInteractor) distributeToStore(
 	ctx context.Context,
 	brandProduct *domain.Product,
 	storeID uuid.UUID,
+	user domain.User,
 ) error {
 	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
 		// 1. 检查门店是否存在同名商品
@@ -195,7 +197,8 @@ func (i *ProductInteractor) distributeToStore(
 			}
 		}
 
-		return nil
+		// 2.7 记录门店商品历史版本
+		return productversion.RecordProduct(ctx, ds, storeProduct.ID, domain.ProductVersionActionCreate, productversion.OperatorFromUser(user))
 	})
 }
 
@@ -220,6 +223,7 @@ func (i *ProductInteractor) findOrCreateCategory(
 		storeCategory = &domain.Category{
 			ID:         uuid.New(),
 			Name:       brandCategory.Name,
+			NameI18n:   brandCategory.NameI18n,
 			MerchantID: brandCategory.MerchantID,
 			StoreID:    storeID,
 		}
@@ -240,6 +244,7 @@ func (i *ProductInteractor) findOrCreateCategory(
 		storeRootCategory = &domain.Category{
 			ID:         uuid.New(),
 			Name:       brandCategory.Parent.Name,
+			NameI18n:   brandCategory.Parent.NameI18n,
 			MerchantID: brandCategory.MerchantID,
 			StoreID:    storeID,
 		}
@@ -249,6 +254,7 @@ func (i *ProductInteractor) findOrCreateCategory(
 		storeCategory := &domain.Category{
 			ID:         uuid.New(),
 			Name:       brandCategory.Name,
+			NameI18n:   brandCategory.NameI18n,
 			MerchantID: brandCategory.MerchantID,
 			StoreID:    storeID,
 			ParentID:   storeRootCategory.ID,
@@ -273,6 +279,7 @@ func (i *ProductInteractor) findOrCreateCategory(
 	storeCategory = &domain.Category{
 		ID:         uuid.New(),
 		Name:       brandCategory.Name,
+		NameI18n:   brandCategory.NameI18n,
 		MerchantID: brandCategory.MerchantID,
 		StoreID:    storeID,
 		ParentID:   storeRootCategory.ID,
@@ -406,6 +413,7 @@ func (i *ProductInteractor) findOrCreateAttrs(
 			attr := &domain.ProductAttr{
 				ID:         uuid.New(),
 				Name:       attrName,
+				NameI18n:   attrRels[0].Attr.NameI18n,
 				MerchantID: merchantID,
 				StoreID:    storeID,
 				Channels:   attrRels[0].Attr.Channels,
@@ -417,6 +425,7 @@ func (i *ProductInteractor) findOrCreateAttrs(
 					ID:        uuid.New(),
 					AttrID:    attr.ID,
 					Name:      attrRel.AttrItem.Name,
+					NameI18n:  attrRel.AttrItem.NameI18n,
 					Image:     attrRel.AttrItem.Image,
 					BasePrice: attrRel.AttrItem.BasePrice,
 				}
@@ -464,6 +473,7 @@ func (i *ProductInteractor) findOrCreateAttrs(
 					ID:        uuid.New(),
 					AttrID:    attrID,
 					Name:      attrItemName,
+					NameI18n:  attrRel.AttrItem.NameI18n,
 					Image:     attrRel.AttrItem.Image,
 					BasePrice: attrRel.AttrItem.BasePrice,
 				}
@@ -0,0 +1,792 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/xuri/excelize/v2"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
+)
+
+const (
+	productImportMaxRows   = 2000 // 单次导入最大商品数
+	productImportBatchSize = 50   // 每批次写入的商品数
+)
+
+// 商品导入导出的列定义，导入模板与导出文件共用同一格式
+const (
+	productExcelColName = iota
+	productExcelColCategory
+	productExcelColUnit
+	productExcelColSpecs
+	productExcelColAttrs
+	productExcelColTags
+	productExcelColTaxRate
+	productExcelColStall
+	productExcelColMnemonic
+	productExcelColSaleStatus
+	productExcelColMinSaleQuantity
+	productExcelColAddSaleQuantity
+	productExcelColDescription
+)
+
+var productExcelHeaders = []string{
+	"商品名称*",
+	"分类*（一级分类/二级分类）",
+	"单位*",
+	"规格及价格*（规格:价格;规格:价格，*标记默认项）",
+	"口味做法（分组:项*,项;分组:项，*标记默认项）",
+	"标签（多个用,分隔）",
+	"税率（为空则继承分类）",
+	"出品部门（为空则继承分类）",
+	"助记词",
+	"售卖状态（在售/停售）",
+	"起售份数",
+	"加售份数",
+	"描述",
+}
+
+var productExcelTemplateExample = []string{
+	"招牌牛肉面",
+	"主食/面类",
+	"碗",
+	"大份:32*;小份:26",
+	"辣度:不辣*,微辣,特辣;面型:细面*,宽面",
+	"招牌,新品",
+	"",
+	"",
+	"zpnrm",
+	"在售",
+	"1",
+	"1",
+	"慢炖牛骨汤底",
+}
+
+var productSaleStatusNames = map[domain.ProductSaleStatus]string{
+	domain.ProductSaleStatusOnSale:  "在售",
+	domain.ProductSaleStatusOffSale: "停售",
+}
+
+const productExcelDefaultMark = "*"
+
+// productExcelDict 导入导出所需的基础资料字典，按名称解析、按ID回写
+type productExcelDict struct {
+	categories    map[string]*domain.Category
+	categoryPaths map[uuid.UUID]string
+	units         map[string]*domain.ProductUnit
+	specs         map[string]*domain.ProductSpec
+	attrs         map[string]*domain.ProductAttr
+	tags          map[string]*domain.ProductTag
+	taxRates      map[string]*domain.TaxFee
+	taxRateNames  map[uuid.UUID]string
+	stalls        map[string]*domain.Stall
+	stallNames    map[uuid.UUID]string
+}
+
+// productImportRow 待导入的商品行
+type productImportRow struct {
+	row      int
+	product  *domain.Product
+	isUpdate bool
+}
+
+func (i *ProductInteractor) ImportTemplate(ctx context.Context, user domain.User) (url string, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ProductInteractor.ImportTemplate")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.Storage.ExportExcel(ctx, domain.ObjectStorageSceneProduct, "商品导入模板",
+		productExcelHeaders, [][]string{productExcelTemplateExample})
+}
+
+func (i *ProductInteractor) Export(ctx context.Context, params domain.ProductSearchParams, user domain.User) (url string, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ProductInteractor.Export")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	// 只能导出当前品牌商/门店下的普通商品
+	params.MerchantID = user.GetMerchantID()
+	params.StoreID = user.GetStoreID()
+	params.OnlyMerchant = user.GetStoreID() == uuid.Nil
+	params.Type = domain.ProductTypeNormal
+
+	products, err := i.DS.ProductRepo().ListDetailsBySearch(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	dict, err := i.loadProductExcelDict(ctx, i.DS, user)
+	if err != nil {
+		return "", err
+	}
+
+	contents := make([][]string, 0, len(products))
+	for _, product := range products {
+		contents = append(contents, dict.formatRow(product))
+	}
+
+	return i.Storage.ExportExcel(ctx, domain.ObjectStorageSceneProduct, "商品导出", productExcelHeaders, contents)
+}
+
+func (i *ProductInteractor) Import(ctx context.Context, reader io.Reader, user domain.User) (res *domain.ProductImportResult, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ProductInteractor.Import")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	rows, err := readProductExcelRows(ctx, reader)
+	if err != nil {
+		return nil, err
+	}
+	total := 0
+	for _, cells := range rows {
+		if !isBlankRow(cells) {
+			total++
+		}
+	}
+	if total == 0 {
+		return nil, domain.ParamsError(domain.ErrProductImportEmpty)
+	}
+	if total > productImportMaxRows {
+		return nil, domain.ParamsErrorf("%s（最多%d个）", domain.ErrProductImportTooManyRows.Error(), productImportMaxRows)
+	}
+
+	dict, err := i.loadProductExcelDict(ctx, i.DS, user)
+	if err != nil {
+		return nil, err
+	}
+
+	// 已存在的同名商品按名称更新
+	existingProducts, err := i.DS.ProductRepo().ListDetailsBySearch(ctx, domain.ProductSearchParams{
+		MerchantID:   user.GetMerchantID(),
+		StoreID:      user.GetStoreID(),
+		OnlyMerchant: user.GetStoreID() == uuid.Nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+	existingMap := make(map[string]*domain.Product, len(existingProducts))
+	for _, product := range existingProducts {
+		existingMap[product.Name] = product
+	}
+
+	res = &domain.ProductImportResult{
+		Total:  total,
+		Errors: make([]*domain.ProductImportRowError, 0),
+	}
+	addRowError := func(row int, name string, err error) {
+		res.Errors = append(res.Errors, &domain.ProductImportRowError{
+			Row:     row,
+			Name:    name,
+			Message: err.Error(),
+		})
+	}
+
+	// 1. 解析并校验参数格式
+	pending := make([]*productImportRow, 0, len(rows))
+	nameRows := make(map[string]int, len(rows))
+	for idx, cells := range rows {
+		rowNum := idx + 2 // 第1行为表头
+		if isBlankRow(cells) {
+			continue
+		}
+
+		name := cellValue(cells, productExcelColName)
+		if _, ok := nameRows[name]; ok && name != "" {
+			addRowError(rowNum, name, domain.ErrProductImportNameRepeated)
+			continue
+		}
+		nameRows[name] = rowNum
+
+		existing := existingMap[name]
+		if existing != nil && existing.Type != domain.ProductTypeNormal {
+			addRowError(rowNum, name, domain.ErrProductImportSetMeal)
+			continue
+		}
+
+		product, err := dict.parseRow(cells, existing, user)
+		if err != nil {
+			addRowError(rowNum, name, err)
+			continue
+		}
+		if err := validateProductParams(product); err != nil {
+			addRowError(rowNum, name, err)
+			continue
+		}
+
+		pending = append(pending, &productImportRow{
+			row:      rowNum,
+			product:  product,
+			isUpdate: existing != nil,
+		})
+	}
+
+	// 2. 分批校验业务规则并写入，单批次在同一事务中执行
+	for start := 0; start < len(pending); start += productImportBatchSize {
+		batch := pending[start:min(start+productImportBatchSize, len(pending))]
+		var created, updated int
+		var batchErrors []*domain.ProductImportRowError
+
+		err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+			created, updated, batchErrors = 0, 0, nil
+			for _, item := range batch {
+				product := item.product
+				excludeID := uuid.Nil
+				if item.isUpdate {
+					excludeID = product.ID
+				}
+				if err := i.validateProductBusinessRules(ctx, ds, product, user, excludeID); err != nil {
+					if domain.IsParamsError(err) || errors.Is(err, domain.ErrProductNameExists) {
+						batchErrors = append(batchErrors, &domain.ProductImportRowError{
+							Row:     item.row,
+							Name:    product.Name,
+							Message: err.Error(),
+						})
+						continue
+					}
+					return err
+				}
+
+				if item.isUpdate {
+					if err := ds.ProductRepo().Update(ctx, product); err != nil {
+						return err
+					}
+					updated++
+				} else {
+					if err := ds.ProductRepo().Create(ctx, product); err != nil {
+						return err
+					}
+					created++
+				}
+
+				if len(product.SpecRelations) > 0 {
+					if err := ds.ProductSpecRelRepo().CreateBulk(ctx, product.SpecRelations); err != nil {
+						return err
+					}
+				}
+				if len(product.AttrRelations) > 0 {
+					if err := ds.ProductAttrRelRepo().CreateBulk(ctx, product.AttrRelations); err != nil {
+						return err
+					}
+				}
+
+				action := domain.ProductVersionActionCreate
+				if item.isUpdate {
+					action = domain.ProductVersionActionUpdate
+				}
+				if err := productversion.RecordProduct(ctx, ds, product.ID, action, productversion.OperatorFromUser(user)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		res.Created += created
+		res.Updated += updated
+		res.Errors = append(res.Errors, batchErrors...)
+	}
+
+	sort.Slice(res.Errors, func(a, b int) bool {
+		return res.Errors[a].Row < res.Errors[b].Row
+	})
+	res.Failed = len(res.Errors)
+	return res, nil
+}
+
+// readProductExcelRows 读取第一个工作表的数据行（不含表头）
+func readProductExcelRows(ctx context.Context, reader io.Reader) ([][]string, error) {
+	f, err := excelize.OpenReader(reader)
+	if err != nil {
+		return nil, domain.ParamsError(domain.ErrProductImportFileInvalid)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			logger := logging.FromContext(ctx).Named("ProductInteractor.Import")
+			logger.Errorw("failed to close file", "error", err)
+		}
+	}()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, domain.ParamsError(domain.ErrProductImportFileInvalid)
+	}
+	rows, err := f.GetRows(sheets[0])
+	if err != nil {
+		return nil, domain.ParamsError(domain.ErrProductImportFileInvalid)
+	}
+	if len(rows) == 0 || cellValue(rows[0], productExcelColName) != productExcelHeaders[productExcelColName] {
+		return nil, domain.ParamsError(domain.ErrProductImportFileInvalid)
+	}
+	return rows[1:], nil
+}
+
+// loadProductExcelDict 加载当前品牌商/门店下的基础资料
+func (i *ProductInteractor) loadProductExcelDict(ctx context.Context, ds domain.DataStore, user domain.User) (*productExcelDict, error) {
+	merchantID, storeID := user.GetMerchantID(), user.GetStoreID()
+	onlyMerchant := storeID == uuid.Nil
+	page := func() *upagination.Pagination {
+		return upagination.New(1, upagination.MaxSize)
+	}
+
+	dict := &productExcelDict{
+		categories:    make(map[string]*domain.Category),
+		categoryPaths: make(map[uuid.UUID]string),
+		units:         make(map[string]*domain.ProductUnit),
+		specs:         make(map[string]*domain.ProductSpec),
+		attrs:         make(map[string]*domain.ProductAttr),
+		tags:          make(map[string]*domain.ProductTag),
+		taxRates:      make(map[string]*domain.TaxFee),
+		taxRateNames:  make(map[uuid.UUID]string),
+		stalls:        make(map[string]*domain.Stall),
+		stallNames:    make(map[uuid.UUID]string),
+	}
+
+	categories, err := ds.CategoryRepo().ListBySearch(ctx, domain.CategorySearchParams{
+		MerchantID:   merchantID,
+		StoreID:      storeID,
+		OnlyMerchant: onlyMerchant,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, root := range categories {
+		// 导入时分类路径的全角分隔符已转为半角，字典键保持一致
+		dict.categories[excelFullWidthReplacer.Replace(root.Name)] = root
+		dict.categoryPaths[root.ID] = root.Name
+		for _, child := range root.Childrens {
+			path := root.Name + "/" + child.Name
+			dict.categories[excelFullWidthReplacer.Replace(path)] = child
+			dict.categoryPaths[child.ID] = path
+		}
+	}
+
+	units, err := ds.ProductUnitRepo().PagedListBySearch(ctx, page(), domain.ProductUnitSearchParams{
+		MerchantID:   merchantID,
+		StoreID:      storeID,
+		OnlyMerchant: onlyMerchant,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, unit := range units.Items {
+		dict.units[unit.Name] = unit
+	}
+
+	specs, err := ds.ProductSpecRepo().PagedListBySearch(ctx, page(), domain.ProductSpecSearchParams{
+		MerchantID:   merchantID,
+		StoreID:      storeID,
+		OnlyMerchant: onlyMerchant,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, spec := range specs.Items {
+		dict.specs[spec.Name] = spec
+	}
+
+	attrs, err := ds.ProductAttrRepo().ListBySearch(ctx, domain.ProductAttrSearchParams{
+		MerchantID:   merchantID,
+		StoreID:      storeID,
+		OnlyMerchant: onlyMerchant,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range attrs {
+		dict.attrs[attr.Name] = attr
+	}
+
+	tags, err := ds.ProductTagRepo().PagedListBySearch(ctx, page(), domain.ProductTagSearchParams{
+		MerchantID:   merchantID,
+		StoreID:      storeID,
+		OnlyMerchant: onlyMerchant,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags.Items {
+		dict.tags[tag.Name] = tag
+	}
+
+	taxRates, _, err := ds.TaxFeeRepo().GetTaxFees(ctx, page(), &domain.TaxFeeListFilter{
+		MerchantID: merchantID,
+		StoreID:    storeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, taxRate := range taxRates {
+		if !domain.VerifyOwnerShip(user, taxRate.MerchantID, taxRate.StoreID) {
+			continue
+		}
+		dict.taxRates[taxRate.Name] = taxRate
+		dict.taxRateNames[taxRate.ID] = taxRate.Name
+	}
+
+	stalls, _, err := ds.StallRepo().GetStalls(ctx, page(), &domain.StallListFilter{
+		MerchantID: merchantID,
+		StoreID:    storeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, stall := range stalls {
+		if !domain.VerifyOwnerShip(user, stall.MerchantID, stall.StoreID) {
+			continue
+		}
+		dict.stalls[stall.Name] = stall
+		dict.stallNames[stall.ID] = stall.Name
+	}
+
+	return dict, nil
+}
+
+// parseRow 将一行数据解析为商品，existing 不为空时在已有商品基础上覆盖导入字段
+func (d *productExcelDict) parseRow(cells []string, existing *domain.Product, user domain.User) (*domain.Product, error) {
+	product := &domain.Product{
+		ID:                uuid.New(),
+		Type:              domain.ProductTypeNormal,
+		MerchantID:        user.GetMerchantID(),
+		StoreID:           user.GetStoreID(),
+		EffectiveDateType: domain.EffectiveDateTypeDaily,
+	}
+	existingSpecs := make(map[uuid.UUID]*domain.ProductSpecRelation)
+	if existing != nil {
+		copied := *existing
+		product = &copied
+		for _, specRel := range existing.SpecRelations {
+			existingSpecs[specRel.SpecID] = specRel
+		}
+	}
+
+	product.Name = cellValue(cells, productExcelColName)
+	if product.Name == "" {
+		return nil, domain.ParamsErrorf("商品名称不能为空")
+	}
+	if len([]rune(product.Name)) > 255 {
+		return nil, domain.ParamsErrorf("商品名称不能超过255个字符")
+	}
+
+	// 分类
+	category, ok := d.categories[normalizeCategoryPath(listCellValue(cells, productExcelColCategory))]
+	if !ok {
+		return nil, domain.ParamsError(domain.ErrProductCategoryNotExists)
+	}
+	product.CategoryID = category.ID
+
+	// 单位
+	unit, ok := d.units[cellValue(cells, productExcelColUnit)]
+	if !ok {
+		return nil, domain.ParamsError(domain.ErrProductUnitInvalid)
+	}
+	product.UnitID = unit.ID
+
+	// 规格及价格
+	specRelations, err := d.parseSpecs(listCellValue(cells, productExcelColSpecs), product.ID, existingSpecs)
+	if err != nil {
+		return nil, err
+	}
+	product.SpecRelations = specRelations
+
+	// 口味做法
+	attrRelations, err := d.parseAttrs(listCellValue(cells, productExcelColAttrs), product.ID)
+	if err != nil {
+		return nil, err
+	}
+	product.AttrRelations = attrRelations
+	if existing != nil {
+		// 保留已有口味做法项的可选数量，移除不再关联的口味做法分组的点单限制
+		existingAttrs := lo.KeyBy(existing.AttrRelations, func(rel *domain.ProductAttrRelation) uuid.UUID { return rel.AttrItemID })
+		for _, attrRel := range attrRelations {
+			if old, ok := existingAttrs[attrRel.AttrItemID]; ok {
+				attrRel.MaxQuantity = old.MaxQuantity
+			}
+		}
+		product.AttrLimits = lo.Filter(existing.AttrLimits, func(l *domain.ProductAttrLimit, _ int) bool {
+			return lo.ContainsBy(attrRelations, func(rel *domain.ProductAttrRelation) bool { return rel.AttrID == l.AttrID })
+		})
+	}
+
+	// 标签
+	product.Tags = nil
+	for _, name := range splitExcelList(listCellValue(cells, productExcelColTags), ",") {
+		tag, ok := d.tags[name]
+		if !ok {
+			return nil, domain.ParamsErrorf("%s：%s", domain.ErrProductTagInvalid.Error(), name)
+		}
+		product.Tags = append(product.Tags, &domain.ProductTag{ID: tag.ID, Name: tag.Name})
+	}
+
+	// 税率，为空继承分类
+	product.InheritTaxRate, product.TaxRateID = true, uuid.Nil
+	if name := cellValue(cells, productExcelColTaxRate); name != "" {
+		taxRate, ok := d.taxRates[name]
+		if !ok {
+			return nil, domain.ParamsError(domain.ErrProductTaxRateNotExists)
+		}
+		product.InheritTaxRate, product.TaxRateID = false, taxRate.ID
+	}
+
+	// 出品部门，为空继承分类
+	product.InheritStall, product.StallID = true, uuid.Nil
+	if name := cellValue(cells, productExcelColStall); name != "" {
+		stall, ok := d.stalls[name]
+		if !ok {
+			return nil, domain.ParamsError(domain.ErrProductStallNotExists)
+		}
+		product.InheritStall, product.StallID = false, stall.ID
+	}
+
+	product.Mnemonic = cellValue(cells, productExcelColMnemonic)
+	product.Description = cellValue(cells, productExcelColDescription)
+
+	// 售卖状态，为空默认在售
+	product.SaleStatus = domain.ProductSaleStatusOnSale
+	if status := cellValue(cells, productExcelColSaleStatus); status != "" {
+		matched := false
+		for saleStatus, name := range productSaleStatusNames {
+			if status == name {
+				product.SaleStatus, matched = saleStatus, true
+				break
+			}
+		}
+		if !matched {
+			return nil, domain.ParamsErrorf("售卖状态无效：%s", status)
+		}
+	}
+
+	if product.MinSaleQuantity, err = parseQuantity(cellValue(cells, productExcelColMinSaleQuantity), "起售份数"); err != nil {
+		return nil, err
+	}
+	if product.AddSaleQuantity, err = parseQuantity(cellValue(cells, productExcelColAddSaleQuantity), "加售份数"); err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// parseSpecs 解析规格及价格，格式：规格:价格;规格:价格，未标记默认项时第一个规格为默认项
+func (d *productExcelDict) parseSpecs(value string, productID uuid.UUID,
+	existingSpecs map[uuid.UUID]*domain.ProductSpecRelation,
+) (domain.ProductSpecRelations, error) {
+	items := splitExcelList(value, ";")
+	if len(items) == 0 {
+		return nil, domain.ParamsError(domain.ErrProductSpecRelationNoDefault)
+	}
+
+	relations := make(domain.ProductSpecRelations, 0, len(items))
+	hasDefault := false
+	for _, item := range items {
+		name, priceStr, ok := strings.Cut(item, ":")
+		if !ok {
+			return nil, domain.ParamsErrorf("规格格式错误：%s", item)
+		}
+		name, isDefault := trimDefaultMark(name)
+		spec, ok := d.specs[name]
+		if !ok {
+			return nil, domain.ParamsErrorf("%s：%s", domain.ErrProductSpecInvalid.Error(), name)
+		}
+		price, err := decimal.NewFromString(strings.TrimSpace(priceStr))
+		if err != nil || price.IsNegative() {
+			return nil, domain.ParamsErrorf("规格价格无效：%s", item)
+		}
+
+		relation := &domain.ProductSpecRelation{
+			ID:        uuid.New(),
+			ProductID: productID,
+			SpecID:    spec.ID,
+			BasePrice: price,
+			IsDefault: isDefault,
+		}
+		// 更新时保留导入文件之外的规格价格配置
+		if existing, ok := existingSpecs[spec.ID]; ok {
+			relation.MemberPrice = existing.MemberPrice
+			relation.PackingFeeID = existing.PackingFeeID
+			relation.EstimatedCostPrice = existing.EstimatedCostPrice
+			relation.OtherPrice1 = existing.OtherPrice1
+			relation.OtherPrice2 = existing.OtherPrice2
+			relation.OtherPrice3 = existing.OtherPrice3
+			relation.Barcode = existing.Barcode
+			relation.PeriodPrices = existing.PeriodPrices
+		}
+		hasDefault = hasDefault || isDefault
+		relations = append(relations, relation)
+	}
+	if !hasDefault {
+		relations[0].IsDefault = true
+	}
+	return relations, nil
+}
+
+// parseAttrs 解析口味做法，格式：分组:项*,项;分组:项
+func (d *productExcelDict) parseAttrs(value string, productID uuid.UUID) (domain.ProductAttrRelations, error) {
+	var relations domain.ProductAttrRelations
+	for _, group := range splitExcelList(value, ";") {
+		attrName, itemsStr, ok := strings.Cut(group, ":")
+		if !ok {
+			return nil, domain.ParamsErrorf("口味做法格式错误：%s", group)
+		}
+		attrName = strings.TrimSpace(attrName)
+		attr, ok := d.attrs[attrName]
+		if !ok {
+			return nil, domain.ParamsErrorf("%s：%s", domain.ErrProductAttrInvalid.Error(), attrName)
+		}
+		for _, itemName := range splitExcelList(itemsStr, ",") {
+			itemName, isDefault := trimDefaultMark(itemName)
+			var attrItem *domain.ProductAttrItem
+			for _, item := range attr.Items {
+				if item.Name == itemName {
+					attrItem = item
+					break
+				}
+			}
+			if attrItem == nil {
+				return nil, domain.ParamsErrorf("%s：%s:%s", domain.ErrProductAttrInvalid.Error(), attrName, itemName)
+			}
+			relations = append(relations, &domain.ProductAttrRelation{
+				ID:         uuid.New(),
+				ProductID:  productID,
+				AttrID:     attr.ID,
+				AttrItemID: attrItem.ID,
+				IsDefault:  isDefault,
+			})
+		}
+	}
+	return relations, nil
+}
+
+// formatRow 将商品格式化为一行数据，与导入模板格式一致
+func (d *productExcelDict) formatRow(product *domain.Product) []string {
+	row := make([]string, len(productExcelHeaders))
+	row[productExcelColName] = product.Name
+	row[productExcelColCategory] = d.categoryPaths[product.CategoryID]
+	if product.Unit != nil {
+		row[productExcelColUnit] = product.Unit.Name
+	}
+
+	specs := make([]string, 0, len(product.SpecRelations))
+	for _, specRel := range product.SpecRelations {
+		name := specRel.SpecName
+		if specRel.IsDefault {
+			name += productExcelDefaultMark
+		}
+		specs = append(specs, fmt.Sprintf("%s:%s", name, specRel.BasePrice.String()))
+	}
+	row[productExcelColSpecs] = strings.Join(specs, ";")
+
+	// 按分组聚合口味做法项，保持首次出现的顺序
+	attrNames := make([]string, 0)
+	attrItems := make(map[string][]string)
+	for _, attrRel := range product.AttrRelations {
+		if attrRel.Attr == nil || attrRel.AttrItem == nil {
+			continue
+		}
+		if _, ok := attrItems[attrRel.Attr.Name]; !ok {
+			attrNames = append(attrNames, attrRel.Attr.Name)
+		}
+		name := attrRel.AttrItem.Name
+		if attrRel.IsDefault {
+			name += productExcelDefaultMark
+		}
+		attrItems[attrRel.Attr.Name] = append(attrItems[attrRel.Attr.Name], name)
+	}
+	attrs := make([]string, 0, len(attrNames))
+	for _, name := range attrNames {
+		attrs = append(attrs, name+":"+strings.Join(attrItems[name], ","))
+	}
+	row[productExcelColAttrs] = strings.Join(attrs, ";")
+
+	tags := make([]string, 0, len(product.Tags))
+	for _, tag := range product.Tags {
+		tags = append(tags, tag.Name)
+	}
+	row[productExcelColTags] = strings.Join(tags, ",")
+
+	if !product.InheritTaxRate {
+		row[productExcelColTaxRate] = d.taxRateNames[product.TaxRateID]
+	}
+	if !product.InheritStall {
+		row[productExcelColStall] = d.stallNames[product.StallID]
+	}
+	row[productExcelColMnemonic] = product.Mnemonic
+	row[productExcelColSaleStatus] = productSaleStatusNames[product.SaleStatus]
+	row[productExcelColMinSaleQuantity] = strconv.Itoa(product.MinSaleQuantity)
+	row[productExcelColAddSaleQuantity] = strconv.Itoa(product.AddSaleQuantity)
+	row[productExcelColDescription] = product.Description
+	return row
+}
+
+// excelFullWidthReplacer 兼容中文输入法下的全角分隔符
+var excelFullWidthReplacer = strings.NewReplacer("：", ":", "；", ";", "，", ",", "＊", "*", "／", "/")
+
+func cellValue(cells []string, col int) string {
+	if col >= len(cells) {
+		return ""
+	}
+	return strings.TrimSpace(cells[col])
+}
+
+// listCellValue 读取按分隔符解析的列（分类、规格、做法、标签），全角分隔符转为半角
+func listCellValue(cells []string, col int) string {
+	return excelFullWidthReplacer.Replace(cellValue(cells, col))
+}
+
+func isBlankRow(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func splitExcelList(value, sep string) []string {
+	parts := strings.Split(value, sep)
+	res := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			res = append(res, part)
+		}
+	}
+	return res
+}
+
+func trimDefaultMark(name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	if trimmed, ok := strings.CutSuffix(name, productExcelDefaultMark); ok {
+		return strings.TrimSpace(trimmed), true
+	}
+	return name, false
+}
+
+func normalizeCategoryPath(path string) string {
+	return strings.Join(splitExcelList(path, "/"), "/")
+}
+
+func parseQuantity(value, field string) (int, error) {
+	if value == "" {
+		return 1, nil
+	}
+	quantity, err := strconv.Atoi(value)
+	if err != nil || quantity < 1 {
+		return 0, domain.ParamsErrorf("%s必须为大于0的整数", field)
+	}
+	return quantity, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
 )
 
 func (i *ProductInteractor) OffSale(ctx context.Context, id uuid.UUID, user domain.User) (err error) {
@@ -14,9 +15,11 @@ func (i *ProductInteractor) OffSale(ctx context.Context, id uuid.UUID, user doma
 		util.SpanErrFinish(span, err)
 	}()
 
-	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+	var product *domain.Product
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
 		// 1. 验证商品存在
-		product, err := ds.ProductRepo().FindByID(ctx, id)
+		var err error
+		product, err = ds.ProductRepo().FindByID(ctx, id)
 		if err != nil {
 			if domain.IsNotFound(err) {
 				return domain.ParamsError(domain.ErrProductNotExists)
@@ -52,6 +55,13 @@ func (i *ProductInteractor) OffSale(ctx context.Context, id uuid.UUID, user doma
 			return err
 		}
 
-		return nil
+		// 记录商品历史版本
+		return productversion.RecordProduct(ctx, ds, product.ID, domain.ProductVersionActionUpdate, productversion.OperatorFromUser(user))
 	})
+	if err != nil {
+		return err
+	}
+
+	i.pushSaleStatus(ctx, product)
+	return nil
 }
@@ -0,0 +1,40 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+// pinyinBackfillBatchSize 补全拼音每批处理的商品数
+const pinyinBackfillBatchSize = 200
+
+// BackfillPinyin 为上线拼音搜索前创建的商品补全名称拼音，按ID分批处理，名称无法生成拼音的商品跳过
+func (i *ProductInteractor) BackfillPinyin(ctx context.Context) (filled int, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ProductInteractor.BackfillPinyin")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	lastID := uuid.Nil
+	for {
+		products, err := i.DS.ProductRepo().ListMissingPinyin(ctx, lastID, pinyinBackfillBatchSize)
+		if err != nil {
+			return filled, fmt.Errorf("failed to list products missing pinyin: %w", err)
+		}
+		for _, product := range products {
+			if err = i.DS.ProductRepo().UpdatePinyin(ctx, product); err != nil {
+				return filled, fmt.Errorf("failed to update product pinyin: %w", err)
+			}
+			if product.Pinyin != "" {
+				filled++
+			}
+		}
+		if len(products) < pinyinBackfillBatchSize {
+			return filled, nil
+		}
+		lastID = products[len(products)-1].ID
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
 )
 
 func (i *ProductInteractor) Update(ctx context.Context, product *domain.Product, user domain.User) (err error) {
@@ -63,7 +64,13 @@ func (i *ProductInteractor) Update(ctx context.Context, product *domain.Product,
 			}
 		}
 
-		return nil
+		// 重新计算包含该商品的套餐的过敏原与饮食属性
+		if err = refreshSetMealDietary(ctx, ds, product.ID); err != nil {
+			return err
+		}
+
+		// 记录商品历史版本
+		return productversion.RecordProduct(ctx, ds, product.ID, domain.ProductVersionActionUpdate, productversion.OperatorFromUser(user))
 	})
 
 	return err
@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
 )
 
 func (i *ProductInteractor) CreateSetMeal(ctx context.Context, product *domain.Product, user domain.User) (err error) {
@@ -23,6 +24,11 @@ func (i *ProductInteractor) CreateSetMeal(ctx context.Context, product *domain.P
 			return err
 		}
 
+		// 过敏原与饮食属性根据子商品计算
+		if err = inheritSetMealDietary(ctx, ds, product); err != nil {
+			return err
+		}
+
 		// 创建商品
 		err := ds.ProductRepo().Create(ctx, product)
 		if err != nil {
@@ -55,7 +61,8 @@ func (i *ProductInteractor) CreateSetMeal(ctx context.Context, product *domain.P
 			}
 		}
 
-		return nil
+		// 记录商品历史版本
+		return productversion.RecordProduct(ctx, ds, product.ID, domain.ProductVersionActionCreate, productversion.OperatorFromUser(user))
 	})
 
 	return nil
@@ -5,6 +5,7 @@ import (
 
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
 )
 
 func (i *ProductInteractor) UpdateSetMeal(ctx context.Context, product *domain.Product, user domain.User) (err error) {
@@ -44,6 +45,11 @@ func (i *ProductInteractor) UpdateSetMeal(ctx context.Context, product *domain.P
 			return err
 		}
 
+		// 过敏原与饮食属性根据子商品计算
+		if err = inheritSetMealDietary(ctx, ds, product); err != nil {
+			return err
+		}
+
 		// 删除旧的套餐组和套餐组详情（物理删除）
 		if err = ds.SetMealGroupRepo().DeleteByProductID(ctx, product.ID); err != nil {
 			return err
@@ -79,7 +85,8 @@ func (i *ProductInteractor) UpdateSetMeal(ctx context.Context, product *domain.P
 			}
 		}
 
-		return nil
+		// 记录商品历史版本
+		return productversion.RecordProduct(ctx, ds, product.ID, domain.ProductVersionActionUpdate, productversion.OperatorFromUser(user))
 	})
 
 	return err
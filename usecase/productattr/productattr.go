@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
 )
 
 var _ domain.ProductAttrInteractor = (*ProductAttrInteractor)(nil)
@@ -20,7 +21,7 @@ func NewProductAttrInteractor(ds domain.DataStore) *ProductAttrInteractor {
 	}
 }
 
-func (i *ProductAttrInteractor) Create(ctx context.Context, attr *domain.ProductAttr) (err error) {
+func (i *ProductAttrInteractor) Create(ctx context.Context, attr *domain.ProductAttr, user domain.User) (err error) {
 	span, ctx := util.StartSpan(ctx, "usecase", "ProductAttrInteractor.Create")
 	defer func() {
 		util.SpanErrFinish(span, err)
@@ -54,7 +55,8 @@ func (i *ProductAttrInteractor) Create(ctx context.Context, attr *domain.Product
 			}
 		}
 
-		return nil
+		// 5. 记录口味做法项历史版本
+		return productversion.RecordAttrItems(ctx, ds, nil, attr, productversion.OperatorFromUser(user))
 	})
 }
 
@@ -134,6 +136,7 @@ func (i *ProductAttrInteractor) Update(ctx context.Context, attr *domain.Product
 
 		// 6. 更新口味做法基本信息
 		existingAttr.Name = attr.Name
+		existingAttr.NameI18n = attr.NameI18n
 		existingAttr.Channels = attr.Channels
 		err = ds.ProductAttrRepo().Update(ctx, existingAttr)
 		if err != nil {
@@ -148,7 +151,10 @@ func (i *ProductAttrInteractor) Update(ctx context.Context, attr *domain.Product
 			}
 		}
 
-		return nil
+		// 8. 记录口味做法项历史版本
+		attr.MerchantID = existingAttr.MerchantID
+		attr.StoreID = existingAttr.StoreID
+		return productversion.RecordAttrItems(ctx, ds, existingAttr, attr, productversion.OperatorFromUser(user))
 	})
 }
 
@@ -222,7 +228,13 @@ func (i *ProductAttrInteractor) DeleteItem(ctx context.Context, id uuid.UUID, us
 			return err
 		}
 
-		return nil
+		// 5. 记录口味做法项历史版本
+		return productversion.RecordAttrItems(ctx, ds, &domain.ProductAttr{
+			ID:         attr.ID,
+			MerchantID: attr.MerchantID,
+			StoreID:    attr.StoreID,
+			Items:      []*domain.ProductAttrItem{item},
+		}, nil, productversion.OperatorFromUser(user))
 	})
 }
 
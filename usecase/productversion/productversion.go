@@ -0,0 +1,283 @@
+package productversion
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.ProductVersionInteractor = (*ProductVersionInteractor)(nil)
+
+type ProductVersionInteractor struct {
+	DS                domain.DataStore
+	ProductInteractor domain.ProductInteractor
+}
+
+func NewProductVersionInteractor(ds domain.DataStore, productInteractor domain.ProductInteractor) *ProductVersionInteractor {
+	return &ProductVersionInteractor{
+		DS:                ds,
+		ProductInteractor: productInteractor,
+	}
+}
+
+func (i *ProductVersionInteractor) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.ProductVersionSearchParams,
+) (res *domain.ProductVersionSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ProductVersionInteractor.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.ProductVersionRepo().PagedListBySearch(ctx, page, params)
+}
+
+func (i *ProductVersionInteractor) GetDetail(ctx context.Context, id uuid.UUID, user domain.User) (res *domain.ProductVersion, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ProductVersionInteractor.GetDetail")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	version, err := i.DS.ProductVersionRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrProductVersionNotExists)
+		}
+		return nil, err
+	}
+	if !domain.VerifyOwnerShip(user, version.MerchantID, version.StoreID) {
+		return nil, domain.ParamsError(domain.ErrProductVersionNotExists)
+	}
+	return version, nil
+}
+
+func (i *ProductVersionInteractor) GetSnapshotAt(
+	ctx context.Context,
+	key domain.ProductVersionKey,
+	at time.Time,
+	user domain.User,
+) (res *domain.ProductVersion, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ProductVersionInteractor.GetSnapshotAt")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	version, err := i.DS.ProductVersionRepo().FindLatest(ctx, key, &at)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrProductVersionNotExists)
+		}
+		return nil, err
+	}
+	if !domain.VerifyOwnerShip(user, version.MerchantID, version.StoreID) {
+		return nil, domain.ParamsError(domain.ErrProductVersionNotExists)
+	}
+	return version, nil
+}
+
+func (i *ProductVersionInteractor) Diff(ctx context.Context, fromID, toID uuid.UUID, user domain.User) (res *domain.ProductVersionDiff, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ProductVersionInteractor.Diff")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	from, err := i.GetDetail(ctx, fromID, user)
+	if err != nil {
+		return nil, err
+	}
+	to, err := i.GetDetail(ctx, toID, user)
+	if err != nil {
+		return nil, err
+	}
+	if from.ProductVersionKey != to.ProductVersionKey {
+		return nil, domain.ParamsError(domain.ErrProductVersionSubjectMismatch)
+	}
+
+	changes, err := domain.DiffProductSnapshots(from.Snapshot, to.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ProductVersionDiff{
+		From:    from,
+		To:      to,
+		Changes: changes,
+	}, nil
+}
+
+func (i *ProductVersionInteractor) Restore(ctx context.Context, id uuid.UUID, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ProductVersionInteractor.Restore")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	version, err := i.GetDetail(ctx, id, user)
+	if err != nil {
+		return err
+	}
+	if version.Action == domain.ProductVersionActionDelete {
+		return domain.ParamsError(domain.ErrProductVersionCannotRestore)
+	}
+
+	ctx = withRestore(ctx, version)
+	operator := OperatorFromUser(user)
+
+	switch version.SubjectType {
+	case domain.ProductVersionSubjectTypeProduct:
+		// 商品恢复复用商品编辑流程，保证业务规则校验一致
+		return i.restoreProduct(ctx, version, user)
+	case domain.ProductVersionSubjectTypeProductSpec:
+		return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+			return restoreSpecRelation(ctx, ds, version, operator)
+		})
+	case domain.ProductVersionSubjectTypeAttrItem:
+		return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+			return restoreAttrItem(ctx, ds, version, operator)
+		})
+	case domain.ProductVersionSubjectTypeMenuItem:
+		return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+			return restoreMenuItem(ctx, ds, version, operator)
+		})
+	default:
+		return domain.ParamsError(domain.ErrProductVersionCannotRestore)
+	}
+}
+
+// ============================================
+// 内部函数
+// ============================================
+
+func (i *ProductVersionInteractor) restoreProduct(ctx context.Context, version *domain.ProductVersion, user domain.User) error {
+	existing, err := i.DS.ProductRepo().FindByID(ctx, version.SubjectID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrProductVersionSubjectNotExists)
+		}
+		return err
+	}
+
+	var product domain.Product
+	if err := json.Unmarshal(version.Snapshot, &product); err != nil {
+		return err
+	}
+	product.ID = existing.ID
+	product.MerchantID = existing.MerchantID
+	product.StoreID = existing.StoreID
+
+	// 关联数据在保存时重建，重新生成关联ID
+	for _, rel := range product.SpecRelations {
+		rel.ID = uuid.New()
+		rel.ProductID = product.ID
+	}
+	for _, rel := range product.AttrRelations {
+		rel.ID = uuid.New()
+		rel.ProductID = product.ID
+	}
+	for _, group := range product.Groups {
+		group.ID = uuid.New()
+		group.ProductID = product.ID
+		for _, detail := range group.Details {
+			detail.ID = uuid.New()
+			detail.GroupID = group.ID
+		}
+	}
+
+	if product.Type == domain.ProductTypeSetMeal {
+		return i.ProductInteractor.UpdateSetMeal(ctx, &product, user)
+	}
+	return i.ProductInteractor.Update(ctx, &product, user)
+}
+
+func restoreSpecRelation(ctx context.Context, ds domain.DataStore, version *domain.ProductVersion, operator domain.ProductVersionOperator) error {
+	rel, err := ds.ProductSpecRelRepo().FindByProductAndSpec(ctx, version.ProductID, version.SubjectID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrProductVersionSubjectNotExists)
+		}
+		return err
+	}
+
+	var snapshot domain.ProductSpecRelation
+	if err := json.Unmarshal(version.Snapshot, &snapshot); err != nil {
+		return err
+	}
+	snapshot.ID = rel.ID
+	if err := ds.ProductSpecRelRepo().Update(ctx, &snapshot); err != nil {
+		return err
+	}
+
+	return RecordProduct(ctx, ds, version.ProductID, domain.ProductVersionActionUpdate, operator)
+}
+
+func restoreAttrItem(ctx context.Context, ds domain.DataStore, version *domain.ProductVersion, operator domain.ProductVersionOperator) error {
+	item, err := ds.ProductAttrRepo().FindItemByID(ctx, version.SubjectID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrProductVersionSubjectNotExists)
+		}
+		return err
+	}
+	before, err := ds.ProductAttrRepo().GetDetail(ctx, item.AttrID)
+	if err != nil {
+		return err
+	}
+
+	var snapshot domain.ProductAttrItem
+	if err := json.Unmarshal(version.Snapshot, &snapshot); err != nil {
+		return err
+	}
+	item.Name = snapshot.Name
+	item.Image = snapshot.Image
+	item.BasePrice = snapshot.BasePrice
+	if err := ds.ProductAttrRepo().SaveItems(ctx, []*domain.ProductAttrItem{item}); err != nil {
+		return err
+	}
+
+	after, err := ds.ProductAttrRepo().GetDetail(ctx, item.AttrID)
+	if err != nil {
+		return err
+	}
+	return RecordAttrItems(ctx, ds, before, after, operator)
+}
+
+func restoreMenuItem(ctx context.Context, ds domain.DataStore, version *domain.ProductVersion, operator domain.ProductVersionOperator) error {
+	menu, err := ds.MenuRepo().GetDetail(ctx, version.SubjectID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return domain.ParamsError(domain.ErrProductVersionSubjectNotExists)
+		}
+		return err
+	}
+
+	var item *domain.MenuItem
+	for _, menuItem := range menu.Items {
+		if menuItem.ProductID == version.ProductID {
+			item = menuItem
+			break
+		}
+	}
+	if item == nil {
+		return domain.ParamsError(domain.ErrProductVersionSubjectNotExists)
+	}
+
+	var snapshot domain.MenuItem
+	if err := json.Unmarshal(version.Snapshot, &snapshot); err != nil {
+		return err
+	}
+	item.BasePrice = snapshot.BasePrice
+	item.MemberPrice = snapshot.MemberPrice
+	item.PeriodPrices = snapshot.PeriodPrices
+	if err := ds.MenuRepo().UpdateItemPrices(ctx, item); err != nil {
+		return err
+	}
+	if err := SyncMenuDraftItem(ctx, ds, menu.ID, item.ProductID); err != nil {
+		return err
+	}
+
+	return RecordMenuItem(ctx, ds, menu.ID, item.ProductID, operator)
+}
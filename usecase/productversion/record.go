@@ -0,0 +1,371 @@
+package productversion
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// ============================================
+// 历史版本记录
+// ============================================
+
+type restoreCtxKey struct{}
+
+// withRestore 标记当前操作为恢复指定历史版本，对应对象的记录将以恢复操作写入
+func withRestore(ctx context.Context, version *domain.ProductVersion) context.Context {
+	return context.WithValue(ctx, restoreCtxKey{}, version)
+}
+
+func restoreFromContext(ctx context.Context) (*domain.ProductVersion, bool) {
+	version, ok := ctx.Value(restoreCtxKey{}).(*domain.ProductVersion)
+	return version, ok
+}
+
+// OperatorFromUser 根据用例传入的操作用户构造操作人，用户为空时视为系统操作
+func OperatorFromUser(user domain.User) domain.ProductVersionOperator {
+	if user == nil {
+		return domain.ProductVersionSystemOperator
+	}
+
+	operator := domain.ProductVersionOperator{
+		ID:   user.GetUserID(),
+		Type: user.GetUserType(),
+	}
+	switch u := user.(type) {
+	case *domain.StoreUser:
+		operator.Name = u.Nickname
+	case *domain.BackendUser:
+		operator.Name = u.Nickname
+	case *domain.AdminUser:
+		operator.Name = u.Nickname
+	}
+	return operator
+}
+
+// RecordProduct 记录商品及其规格的历史版本
+//
+// 删除操作需在删除前调用，记录删除前的快照；其他操作在保存后调用。
+// 相比上一版本商品快照已移除的规格会记录为删除。
+func RecordProduct(
+	ctx context.Context,
+	ds domain.DataStore,
+	productID uuid.UUID,
+	action domain.ProductVersionAction,
+	operator domain.ProductVersionOperator,
+) error {
+	product, err := ds.ProductRepo().GetDetail(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	productKey := domain.ProductVersionKey{
+		SubjectType: domain.ProductVersionSubjectTypeProduct,
+		ProductID:   product.ID,
+		SubjectID:   product.ID,
+	}
+
+	// 以上一版本商品快照中的规格判断规格的新增与移除
+	var prevSpecs map[uuid.UUID]*domain.ProductSpecRelation
+	latest, err := ds.ProductVersionRepo().FindLatest(ctx, productKey, nil)
+	if err != nil && !domain.IsNotFound(err) {
+		return err
+	}
+	if latest != nil && latest.Action != domain.ProductVersionActionDelete {
+		var prev domain.Product
+		if err := json.Unmarshal(latest.Snapshot, &prev); err != nil {
+			return err
+		}
+		prevSpecs = make(map[uuid.UUID]*domain.ProductSpecRelation, len(prev.SpecRelations))
+		for _, rel := range prev.SpecRelations {
+			prevSpecs[rel.SpecID] = rel
+		}
+	}
+
+	if err := record(ctx, ds, productKey, product.MerchantID, product.StoreID, product.Name, action, product, operator); err != nil {
+		return err
+	}
+
+	for _, rel := range product.SpecRelations {
+		specAction := action
+		if action == domain.ProductVersionActionUpdate && prevSpecs != nil && prevSpecs[rel.SpecID] == nil {
+			specAction = domain.ProductVersionActionCreate
+		}
+		if err := recordSpecRelation(ctx, ds, product, rel, specAction, operator); err != nil {
+			return err
+		}
+		delete(prevSpecs, rel.SpecID)
+	}
+
+	// 已从商品移除的规格
+	if action != domain.ProductVersionActionDelete {
+		for _, rel := range prevSpecs {
+			if err := recordSpecRelation(ctx, ds, product, rel, domain.ProductVersionActionDelete, operator); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func recordSpecRelation(
+	ctx context.Context,
+	ds domain.DataStore,
+	product *domain.Product,
+	rel *domain.ProductSpecRelation,
+	action domain.ProductVersionAction,
+	operator domain.ProductVersionOperator,
+) error {
+	key := domain.ProductVersionKey{
+		SubjectType: domain.ProductVersionSubjectTypeProductSpec,
+		ProductID:   product.ID,
+		SubjectID:   rel.SpecID,
+	}
+	snapshot := *rel
+	snapshot.CurrentPrice = nil
+	return record(ctx, ds, key, product.MerchantID, product.StoreID, rel.SpecName, action, &snapshot, operator)
+}
+
+// RecordAttrItems 对比口味做法保存前后的做法项，记录新增、修改和删除的历史版本
+//
+// before 为空表示新建口味做法，after 为空表示删除。
+func RecordAttrItems(
+	ctx context.Context,
+	ds domain.DataStore,
+	before, after *domain.ProductAttr,
+	operator domain.ProductVersionOperator,
+) error {
+	attr := after
+	if attr == nil {
+		attr = before
+	}
+	if attr == nil {
+		return nil
+	}
+
+	prevItems := make(map[uuid.UUID]*domain.ProductAttrItem)
+	if before != nil {
+		for _, item := range before.Items {
+			prevItems[item.ID] = item
+		}
+	}
+
+	if after != nil {
+		for _, item := range after.Items {
+			action := domain.ProductVersionActionCreate
+			if prevItems[item.ID] != nil {
+				action = domain.ProductVersionActionUpdate
+			}
+			if err := recordAttrItem(ctx, ds, attr, item, action, operator); err != nil {
+				return err
+			}
+			delete(prevItems, item.ID)
+		}
+	}
+
+	for _, item := range prevItems {
+		if err := recordAttrItem(ctx, ds, attr, item, domain.ProductVersionActionDelete, operator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func recordAttrItem(
+	ctx context.Context,
+	ds domain.DataStore,
+	attr *domain.ProductAttr,
+	item *domain.ProductAttrItem,
+	action domain.ProductVersionAction,
+	operator domain.ProductVersionOperator,
+) error {
+	key := domain.ProductVersionKey{
+		SubjectType: domain.ProductVersionSubjectTypeAttrItem,
+		SubjectID:   item.ID,
+	}
+	return record(ctx, ds, key, attr.MerchantID, attr.StoreID, item.Name, action, item, operator)
+}
+
+// RecordMenuItems 对比菜单保存前后的菜单项，记录新增、修改和删除的历史版本
+//
+// before 为空表示新建菜单，after 为空表示删除。
+func RecordMenuItems(
+	ctx context.Context,
+	ds domain.DataStore,
+	before, after *domain.Menu,
+	operator domain.ProductVersionOperator,
+) error {
+	menu := after
+	if menu == nil {
+		menu = before
+	}
+	if menu == nil {
+		return nil
+	}
+
+	prevItems := make(map[uuid.UUID]*domain.MenuItem)
+	if before != nil {
+		for _, item := range before.Items {
+			prevItems[item.ProductID] = item
+		}
+	}
+
+	if after != nil {
+		for _, item := range after.Items {
+			action := domain.ProductVersionActionCreate
+			if prevItems[item.ProductID] != nil {
+				action = domain.ProductVersionActionUpdate
+			}
+			if err := recordMenuItem(ctx, ds, menu, item, action, operator); err != nil {
+				return err
+			}
+			delete(prevItems, item.ProductID)
+		}
+	}
+
+	for _, item := range prevItems {
+		if err := recordMenuItem(ctx, ds, menu, item, domain.ProductVersionActionDelete, operator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordMenuItem 记录菜单中指定商品的菜单项修改
+func RecordMenuItem(
+	ctx context.Context,
+	ds domain.DataStore,
+	menuID, productID uuid.UUID,
+	operator domain.ProductVersionOperator,
+) error {
+	menu, err := ds.MenuRepo().GetDetail(ctx, menuID)
+	if err != nil {
+		return err
+	}
+	for _, item := range menu.Items {
+		if item.ProductID == productID {
+			return recordMenuItem(ctx, ds, menu, item, domain.ProductVersionActionUpdate, operator)
+		}
+	}
+	return nil
+}
+
+// SyncMenuDraftItem 将门店菜单中指定商品菜单项的当前价格同步到菜单草稿
+//
+// 批量调价、恢复历史版本等直接修改门店菜单项价格后调用，避免草稿发布时覆盖这些修改。
+func SyncMenuDraftItem(ctx context.Context, ds domain.DataStore, menuID, productID uuid.UUID) error {
+	draft, err := ds.MenuVersionRepo().FindDraftForUpdate(ctx, menuID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	menu, err := ds.MenuRepo().GetDetail(ctx, menuID)
+	if err != nil {
+		return err
+	}
+	for _, item := range menu.Items {
+		if item.ProductID == productID {
+			if !draft.SetItemPrices(item) {
+				return nil
+			}
+			return ds.MenuVersionRepo().Update(ctx, draft)
+		}
+	}
+	return nil
+}
+
+func recordMenuItem(
+	ctx context.Context,
+	ds domain.DataStore,
+	menu *domain.Menu,
+	item *domain.MenuItem,
+	action domain.ProductVersionAction,
+	operator domain.ProductVersionOperator,
+) error {
+	key := domain.ProductVersionKey{
+		SubjectType: domain.ProductVersionSubjectTypeMenuItem,
+		ProductID:   item.ProductID,
+		SubjectID:   menu.ID,
+	}
+	var name string
+	if item.Product != nil {
+		name = item.Product.Name
+	}
+	snapshot := *item
+	snapshot.Product = nil
+	snapshot.CurrentPrice = nil
+	return record(ctx, ds, key, menu.MerchantID, menu.StoreID, name, action, &snapshot, operator)
+}
+
+// record 写入对象的下一个历史版本，修改操作没有字段变化时不记录
+func record(
+	ctx context.Context,
+	ds domain.DataStore,
+	key domain.ProductVersionKey,
+	merchantID, storeID uuid.UUID,
+	name string,
+	action domain.ProductVersionAction,
+	subject any,
+	operator domain.ProductVersionOperator,
+) error {
+	snapshot, err := json.Marshal(subject)
+	if err != nil {
+		return err
+	}
+
+	latest, err := ds.ProductVersionRepo().FindLatest(ctx, key, nil)
+	if err != nil && !domain.IsNotFound(err) {
+		return err
+	}
+
+	version := 1
+	var prevSnapshot json.RawMessage
+	if latest != nil {
+		version = latest.Version + 1
+		if latest.Action == domain.ProductVersionActionDelete {
+			// 删除后重新添加视为新建
+			if action == domain.ProductVersionActionUpdate {
+				action = domain.ProductVersionActionCreate
+			}
+		} else {
+			prevSnapshot = latest.Snapshot
+		}
+	}
+
+	var restoredFrom int
+	if restore, ok := restoreFromContext(ctx); ok && restore.ProductVersionKey == key && action == domain.ProductVersionActionUpdate {
+		action = domain.ProductVersionActionRestore
+		restoredFrom = restore.Version
+	}
+
+	changes := make(domain.ProductVersionChanges, 0)
+	if action != domain.ProductVersionActionDelete {
+		changes, err = domain.DiffProductSnapshots(prevSnapshot, snapshot)
+		if err != nil {
+			return err
+		}
+		if action == domain.ProductVersionActionUpdate && len(changes) == 0 {
+			return nil
+		}
+	}
+
+	return ds.ProductVersionRepo().Create(ctx, &domain.ProductVersion{
+		ID:                uuid.New(),
+		ProductVersionKey: key,
+		MerchantID:        merchantID,
+		StoreID:           storeID,
+		Version:           version,
+		Action:            action,
+		Name:              name,
+		Snapshot:          snapshot,
+		Changes:           changes,
+		RestoredFrom:      restoredFrom,
+		OperatorID:        operator.ID,
+		OperatorType:      operator.Type,
+		OperatorName:      operator.Name,
+	})
+}
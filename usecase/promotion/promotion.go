@@ -0,0 +1,213 @@
+package promotion
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/upagination"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.PromotionInteractor = (*PromotionInteractor)(nil)
+
+type PromotionInteractor struct {
+	DS domain.DataStore
+}
+
+func NewPromotionInteractor(ds domain.DataStore) *PromotionInteractor {
+	return &PromotionInteractor{
+		DS: ds,
+	}
+}
+
+func (i *PromotionInteractor) Create(ctx context.Context, promotion *domain.Promotion, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PromotionInteractor.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	promotion.MerchantID = user.GetMerchantID()
+	promotion.StoreID = user.GetStoreID()
+	if err = prepare(promotion); err != nil {
+		return err
+	}
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		if err := validateBusinessRules(ctx, ds, promotion, uuid.Nil); err != nil {
+			return err
+		}
+		return ds.PromotionRepo().Create(ctx, promotion)
+	})
+}
+
+func (i *PromotionInteractor) Update(ctx context.Context, promotion *domain.Promotion, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PromotionInteractor.Update")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		existing, err := findPromotion(ctx, ds, promotion.ID, user)
+		if err != nil {
+			return err
+		}
+		promotion.MerchantID = existing.MerchantID
+		promotion.StoreID = existing.StoreID
+		if err = prepare(promotion); err != nil {
+			return err
+		}
+		if err = validateBusinessRules(ctx, ds, promotion, promotion.ID); err != nil {
+			return err
+		}
+		return ds.PromotionRepo().Update(ctx, promotion)
+	})
+}
+
+func (i *PromotionInteractor) Delete(ctx context.Context, id uuid.UUID, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PromotionInteractor.Delete")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		if _, err := findPromotion(ctx, ds, id, user); err != nil {
+			return err
+		}
+		return ds.PromotionRepo().Delete(ctx, id)
+	})
+}
+
+func (i *PromotionInteractor) GetDetail(ctx context.Context, id uuid.UUID, user domain.User) (res *domain.Promotion, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PromotionInteractor.GetDetail")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return findPromotion(ctx, i.DS, id, user)
+}
+
+func (i *PromotionInteractor) PagedListBySearch(
+	ctx context.Context,
+	page *upagination.Pagination,
+	params domain.PromotionSearchParams,
+) (res *domain.PromotionSearchRes, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PromotionInteractor.PagedListBySearch")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.PromotionRepo().PagedListBySearch(ctx, page, params)
+}
+
+// SetEnabled 启用或停用促销活动
+func (i *PromotionInteractor) SetEnabled(ctx context.Context, id uuid.UUID, enabled bool, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PromotionInteractor.SetEnabled")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		promotion, err := findPromotion(ctx, ds, id, user)
+		if err != nil {
+			return err
+		}
+		if promotion.Enabled == enabled {
+			return nil
+		}
+		promotion.Enabled = enabled
+		return ds.PromotionRepo().Update(ctx, promotion)
+	})
+}
+
+// Calculate 计算订单可享受的促销优惠（不保存订单）
+func (i *PromotionInteractor) Calculate(ctx context.Context, order *domain.Order) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PromotionInteractor.Calculate")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return ApplyToOrder(ctx, i.DS, order)
+}
+
+// ApplyToOrder 查询门店当前生效的促销活动并计算订单优惠，优惠结果写回订单
+func ApplyToOrder(ctx context.Context, ds domain.DataStore, order *domain.Order) error {
+	at := order.PlacedAt
+	if at.IsZero() {
+		at = time.Now()
+	}
+	promotions, err := ds.PromotionRepo().ListAvailable(ctx, order.MerchantID, order.StoreID, at)
+	if err != nil {
+		return err
+	}
+	order.ApplyPromotions(promotions, at)
+	return nil
+}
+
+// ============================================
+// 校验函数
+// ============================================
+
+func findPromotion(ctx context.Context, ds domain.DataStore, id uuid.UUID, user domain.User) (*domain.Promotion, error) {
+	promotion, err := ds.PromotionRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrPromotionNotExists)
+		}
+		return nil, err
+	}
+	if !domain.VerifyOwnerShip(user, promotion.MerchantID, promotion.StoreID) {
+		return nil, domain.ParamsError(domain.ErrPromotionNotExists)
+	}
+	return promotion, nil
+}
+
+// prepare 整理并校验活动设置，门店活动仅适用于本门店
+func prepare(promotion *domain.Promotion) error {
+	if promotion.StoreID != uuid.Nil {
+		promotion.StoreIDs = nil
+	}
+	promotion.StoreIDs = lo.Uniq(promotion.StoreIDs)
+	promotion.Channels = lo.Uniq(promotion.Channels)
+	promotion.DiningWays = lo.Uniq(promotion.DiningWays)
+	if err := promotion.Validate(); err != nil {
+		return domain.ParamsError(err)
+	}
+	return nil
+}
+
+func validateBusinessRules(ctx context.Context, ds domain.DataStore, promotion *domain.Promotion, excludeID uuid.UUID) error {
+	// 1. 检查活动名称是否唯一
+	exists, err := ds.PromotionRepo().Exists(ctx, domain.PromotionExistsParams{
+		MerchantID: promotion.MerchantID,
+		StoreID:    promotion.StoreID,
+		Name:       promotion.Name,
+		ExcludeID:  excludeID,
+	})
+	if err != nil {
+		return err
+	}
+	if exists {
+		return domain.ParamsError(domain.ErrPromotionNameExists)
+	}
+
+	// 2. 检查适用门店是否属于当前品牌商
+	if len(promotion.StoreIDs) == 0 {
+		return nil
+	}
+	stores, err := ds.StoreRepo().ListByIDs(ctx, promotion.StoreIDs)
+	if err != nil {
+		return err
+	}
+	if len(stores) != len(promotion.StoreIDs) {
+		return domain.ParamsError(domain.ErrPromotionStoreInvalid)
+	}
+	for _, store := range stores {
+		if store.MerchantID != promotion.MerchantID {
+			return domain.ParamsError(domain.ErrPromotionStoreInvalid)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package push
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/orderreceipt"
+)
+
+var _ domain.PushInteractor = (*PushInteractor)(nil)
+
+type PushInteractor struct {
+	DS      domain.DataStore
+	Receipt domain.ReceiptConfig
+}
+
+func NewPushInteractor(ds domain.DataStore, receipt domain.ReceiptConfig) *PushInteractor {
+	return &PushInteractor{
+		DS:      ds,
+		Receipt: receipt,
+	}
+}
+
+func (i *PushInteractor) Topics(ctx context.Context, params domain.PushSubscribeParams) (res []domain.PushTopic, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "PushInteractor.Topics")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	// 电子小票页面只能订阅对应订单
+	if params.ReceiptToken != "" {
+		orderID, err := orderreceipt.ParseToken(i.Receipt.Secret, params.ReceiptToken)
+		if err != nil {
+			return nil, err
+		}
+		return []domain.PushTopic{domain.OrderPushTopic(orderID)}, nil
+	}
+
+	// 排队状态页只能订阅排队号所在门店的排队主题
+	if params.WaitlistID != uuid.Nil {
+		ticket, err := i.DS.WaitlistTicketRepo().FindByID(ctx, params.WaitlistID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return nil, domain.ParamsError(domain.ErrWaitlistTicketNotExists)
+			}
+			return nil, err
+		}
+		return []domain.PushTopic{domain.WaitlistPushTopic(ticket.StoreID)}, nil
+	}
+
+	// 顾客端只能订阅所在桌台
+	if params.TableToken != "" {
+		table, err := i.DS.DiningTableRepo().FindByQRToken(ctx, params.TableToken)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return nil, domain.ParamsError(domain.ErrDiningTableNotExists)
+			}
+			return nil, err
+		}
+		if !table.Enabled {
+			return nil, domain.ParamsError(domain.ErrDiningTableDisabled)
+		}
+		return []domain.PushTopic{domain.TablePushTopic(table.ID)}, nil
+	}
+
+	if params.StoreID == uuid.Nil {
+		return nil, domain.ParamsError(domain.ErrPushTopicRequired)
+	}
+	store, err := i.DS.StoreRepo().FindByID(ctx, params.StoreID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return nil, err
+	}
+	if store.MerchantID != params.MerchantID {
+		return nil, domain.ParamsError(domain.ErrStoreNotExists)
+	}
+	res = []domain.PushTopic{
+		domain.MerchantPushTopic(store.MerchantID),
+		domain.StorePushTopic(store.ID),
+	}
+
+	if params.DeviceID != uuid.Nil {
+		device, err := i.DS.DeviceRepo().FindByID(ctx, params.DeviceID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return nil, domain.ParamsError(domain.ErrPushDeviceInvalid)
+			}
+			return nil, err
+		}
+		if device.StoreID != store.ID {
+			return nil, domain.ParamsError(domain.ErrPushDeviceInvalid)
+		}
+		res = append(res, domain.DevicePushTopic(device.ID))
+	}
+	return res, nil
+}
@@ -7,16 +7,19 @@ import (
 	"github.com/google/uuid"
 	"gitlab.jiguang.dev/pos-dine/dine/domain"
 	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/coupon"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/member"
 )
 
 var _ domain.RefundOrderInteractor = (*RefundOrderInteractor)(nil)
 
 type RefundOrderInteractor struct {
-	DS domain.DataStore
+	DS  domain.DataStore
+	Seq domain.DailySequence
 }
 
-func NewRefundOrderInteractor(ds domain.DataStore) *RefundOrderInteractor {
-	return &RefundOrderInteractor{DS: ds}
+func NewRefundOrderInteractor(ds domain.DataStore, seq domain.DailySequence) *RefundOrderInteractor {
+	return &RefundOrderInteractor{DS: ds, Seq: seq}
 }
 
 func (uc *RefundOrderInteractor) Create(ctx context.Context, refundOrder *domain.RefundOrder) (err error) {
@@ -38,7 +41,16 @@ func (uc *RefundOrderInteractor) Create(ctx context.Context, refundOrder *domain
 	refundOrder.OriginAmountPaid = originOrder.Amount.AmountPaid
 
 	err = uc.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
-		return ds.RefundOrderRepo().Create(ctx, refundOrder)
+		if err := ds.RefundOrderRepo().Create(ctx, refundOrder); err != nil {
+			return err
+		}
+		if err := restoreCoupons(ctx, ds, refundOrder); err != nil {
+			return err
+		}
+		if err := reverseMemberPoints(ctx, ds, refundOrder); err != nil {
+			return err
+		}
+		return uc.refundMemberAccount(ctx, ds, refundOrder)
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create refund order: %w", err)
@@ -63,7 +75,24 @@ func (uc *RefundOrderInteractor) Update(ctx context.Context, refundOrder *domain
 	defer func() { util.SpanErrFinish(span, err) }()
 
 	err = uc.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
-		return ds.RefundOrderRepo().Update(ctx, refundOrder)
+		if err := ds.RefundOrderRepo().Update(ctx, refundOrder); err != nil {
+			return err
+		}
+		if refundOrder.RefundStatus != domain.RefundStatusCompleted {
+			return nil
+		}
+		// 更新参数不含退款类型和原订单，重新查询完整退款单
+		updated, err := ds.RefundOrderRepo().FindByID(ctx, refundOrder.ID)
+		if err != nil {
+			return err
+		}
+		if err = restoreCoupons(ctx, ds, updated); err != nil {
+			return err
+		}
+		if err = reverseMemberPoints(ctx, ds, updated); err != nil {
+			return err
+		}
+		return uc.refundMemberAccount(ctx, ds, updated)
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update refund order: %w", err)
@@ -106,3 +135,27 @@ func (uc *RefundOrderInteractor) List(ctx context.Context, params domain.RefundO
 	}
 	return res, total, nil
 }
+
+// restoreCoupons 全额退款完成后恢复原订单使用的优惠券
+func restoreCoupons(ctx context.Context, ds domain.DataStore, refundOrder *domain.RefundOrder) error {
+	if refundOrder.RefundType != domain.RefundTypeFull || refundOrder.RefundStatus != domain.RefundStatusCompleted {
+		return nil
+	}
+	return coupon.RestoreByOrder(ctx, ds, refundOrder.OriginOrderID)
+}
+
+// reverseMemberPoints 退款完成后扣回订单获得的积分，全额退款时退回订单使用的积分
+func reverseMemberPoints(ctx context.Context, ds domain.DataStore, refundOrder *domain.RefundOrder) error {
+	if refundOrder.RefundStatus != domain.RefundStatusCompleted {
+		return nil
+	}
+	return member.ReverseByRefundOrder(ctx, ds, refundOrder)
+}
+
+// refundMemberAccount 退款完成后退回储值余额
+func (uc *RefundOrderInteractor) refundMemberAccount(ctx context.Context, ds domain.DataStore, refundOrder *domain.RefundOrder) error {
+	if refundOrder.RefundStatus != domain.RefundStatusCompleted {
+		return nil
+	}
+	return member.RefundByRefundOrder(ctx, ds, uc.Seq, refundOrder)
+}
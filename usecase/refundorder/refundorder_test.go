@@ -0,0 +1,127 @@
+package refundorder
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/domain/mock"
+)
+
+func TestRefundOrderInteractorReverseMemberPoints(t *testing.T) {
+	merchantID := uuid.New()
+	memberID := uuid.New()
+	accountID := uuid.New()
+	orderID := uuid.New()
+
+	tests := []struct {
+		name        string
+		refundType  domain.RefundType
+		refundTotal string
+		wantLedger  []int64 // 退款产生的积分流水
+		wantBalance int64
+		wantEarn    int64 // 订单获得积分入账流水的剩余积分
+	}{
+		{
+			name:        "全额退款扣回获得积分并退回使用积分",
+			refundType:  domain.RefundTypeFull,
+			refundTotal: "100",
+			wantLedger:  []int64{-100, 30},
+			wantBalance: 130,
+			wantEarn:    0,
+		},
+		{
+			name:        "部分退款按比例扣回获得积分",
+			refundType:  domain.RefundTypePartial,
+			refundTotal: "40",
+			wantLedger:  []int64{-40},
+			wantBalance: 160,
+			wantEarn:    60,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ds := mock.NewMockDataStore(ctrl)
+			refundOrderRepo := mock.NewMockRefundOrderRepository(ctrl)
+			couponRepo := mock.NewMockCouponRepository(ctrl)
+			memberRepo := mock.NewMockMemberRepository(ctrl)
+			accountRepo := mock.NewMockMemberPointsAccountRepository(ctrl)
+			txnRepo := mock.NewMockMemberPointsTransactionRepository(ctrl)
+			ruleRepo := mock.NewMockMemberPointsRuleRepository(ctrl)
+			ds.EXPECT().RefundOrderRepo().Return(refundOrderRepo).AnyTimes()
+			ds.EXPECT().CouponRepo().Return(couponRepo).AnyTimes()
+			ds.EXPECT().MemberRepo().Return(memberRepo).AnyTimes()
+			ds.EXPECT().MemberPointsAccountRepo().Return(accountRepo).AnyTimes()
+			ds.EXPECT().MemberPointsTransactionRepo().Return(txnRepo).AnyTimes()
+			ds.EXPECT().MemberPointsRuleRepo().Return(ruleRepo).AnyTimes()
+			ds.EXPECT().Atomic(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context, ds domain.DataStore) error) error {
+					return fn(ctx, ds)
+				})
+
+			refundOrder := &domain.RefundOrder{
+				ID:            uuid.New(),
+				MerchantID:    merchantID,
+				OriginOrderID: orderID,
+				RefundNo:      "R0001",
+				RefundType:    tt.refundType,
+				RefundStatus:  domain.RefundStatusCompleted,
+				RefundAmount:  domain.RefundAmount{RefundTotal: decimal.RequireFromString(tt.refundTotal)},
+			}
+
+			// 订单获得 100 积分（消费 100 元），并使用 30 积分抵扣
+			earn := &domain.MemberPointsTransaction{
+				ID: uuid.New(), MerchantID: merchantID, MemberID: memberID, AccountID: accountID,
+				Type: domain.MemberPointsTransactionTypeEarn, Points: 100, Remaining: 100, Amount: decimal.NewFromInt(100),
+			}
+			redeem := &domain.MemberPointsTransaction{
+				ID: uuid.New(), MerchantID: merchantID, MemberID: memberID, AccountID: accountID,
+				Type: domain.MemberPointsTransactionTypeRedeem, Points: -30, Amount: decimal.NewFromInt(3),
+			}
+			other := &domain.MemberPointsTransaction{
+				ID: uuid.New(), MerchantID: merchantID, MemberID: memberID, AccountID: accountID,
+				Type: domain.MemberPointsTransactionTypeAdjustment, Points: 100, Remaining: 100,
+			}
+			account := &domain.MemberPointsAccount{ID: accountID, MerchantID: merchantID, MemberID: memberID, Balance: 200}
+
+			// 更新退款单为已完成
+			refundOrderRepo.EXPECT().Update(gomock.Any(), refundOrder).Return(nil)
+			refundOrderRepo.EXPECT().FindByID(gomock.Any(), refundOrder.ID).Return(refundOrder, nil)
+			couponRepo.EXPECT().ListByOrderID(gomock.Any(), orderID).Return(nil, nil).AnyTimes()
+			txnRepo.EXPECT().ListByOrderID(gomock.Any(), orderID).Return(domain.MemberPointsTransactions{earn, redeem}, nil).Times(2)
+			txnRepo.EXPECT().ExistsByRefundOrderID(gomock.Any(), refundOrder.ID).Return(false, nil)
+			memberRepo.EXPECT().FindByID(gomock.Any(), memberID).Return(&domain.Member{ID: memberID, MerchantID: merchantID}, nil)
+			accountRepo.EXPECT().FindByMemberIDForUpdate(gomock.Any(), memberID).Return(account, nil)
+			txnRepo.EXPECT().ListAvailable(gomock.Any(), accountID).Return(domain.MemberPointsTransactions{other, earn}, nil)
+			ruleRepo.EXPECT().FindByMerchantID(gomock.Any(), merchantID).Return(nil, domain.NotFoundError(errors.New("not found"))).AnyTimes()
+			accountRepo.EXPECT().UpdateBalance(gomock.Any(), account).Return(nil).AnyTimes()
+			txnRepo.EXPECT().UpdateRemaining(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			var ledger []*domain.MemberPointsTransaction
+			txnRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, txn *domain.MemberPointsTransaction) error {
+				ledger = append(ledger, txn)
+				return nil
+			}).AnyTimes()
+
+			err := NewRefundOrderInteractor(ds, nil).Update(context.Background(), refundOrder)
+			require.NoError(t, err)
+
+			points := make([]int64, 0, len(ledger))
+			for _, txn := range ledger {
+				points = append(points, txn.Points)
+				require.Equal(t, refundOrder.ID, txn.RefundOrderID)
+				require.Equal(t, orderID, txn.OrderID)
+			}
+			require.Equal(t, tt.wantLedger, points)
+			require.Equal(t, tt.wantBalance, account.Balance)
+			require.Equal(t, tt.wantEarn, earn.Remaining)
+			require.Equal(t, int64(100), other.Remaining)
+		})
+	}
+}
@@ -22,6 +22,7 @@ func (interactor *RemarkInteractor) Create(ctx context.Context, remark *domain.C
 
 	domainRemark := &domain.Remark{
 		Name:        remark.Name,
+		NameI18n:    remark.NameI18n,
 		RemarkType:  remark.RemarkType,
 		Enabled:     remark.Enabled,
 		SortOrder:   remark.SortOrder,
@@ -79,6 +80,7 @@ func (interactor *RemarkInteractor) Update(ctx context.Context, remark *domain.U
 		domainRemark := &domain.Remark{
 			ID:          remark.ID,
 			Name:        remark.Name,
+			NameI18n:    remark.NameI18n,
 			Enabled:     remark.Enabled,
 			SortOrder:   remark.SortOrder,
 			RemarkType:  oldRemark.RemarkType,
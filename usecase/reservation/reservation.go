@@ -0,0 +1,585 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.ReservationInteractor = (*ReservationInteractor)(nil)
+
+type ReservationInteractor struct {
+	DS           domain.DataStore
+	MutexManager domain.MutexManager
+	Seq          domain.DailySequence
+	Pusher       domain.Pusher
+}
+
+func NewReservationInteractor(
+	ds domain.DataStore,
+	mutexManager domain.MutexManager,
+	seq domain.DailySequence,
+	pusher domain.Pusher,
+) *ReservationInteractor {
+	return &ReservationInteractor{
+		DS:           ds,
+		MutexManager: mutexManager,
+		Seq:          seq,
+		Pusher:       pusher,
+	}
+}
+
+func (i *ReservationInteractor) Slots(ctx context.Context, params domain.ReservationSlotParams) (res []*domain.ReservationSlot, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationInteractor.Slots")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	day, _, err := domain.ReservationDay(params.Date)
+	if err != nil {
+		return nil, domain.ParamsError(domain.ErrReservationDateInvalid)
+	}
+	store, rule, err := i.resolve(ctx, params.MerchantID, params.StoreID, params.Online, params.PartySize)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err = checkDate(rule, day, now); err != nil {
+		return nil, err
+	}
+
+	tables, err := i.DS.DiningTableRepo().ListByStoreID(ctx, store.ID)
+	if err != nil {
+		return nil, err
+	}
+	reservations, err := dayReservations(ctx, i.DS, store.ID, day, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := bookingCutoff(rule, params.Online, now)
+	res = make([]*domain.ReservationSlot, 0)
+	for _, st := range rule.SlotTimes(store, day) {
+		free := domain.FreeTables(tables, reservations, st.At, st.At.Add(rule.DiningDuration()), params.PartySize)
+		res = append(res, &domain.ReservationSlot{
+			At:           st.At,
+			Time:         st.At.Format("15:04"),
+			DiningPeriod: st.DiningPeriod,
+			Available:    len(free) > 0 && st.At.After(cutoff),
+			FreeTables:   len(free),
+		})
+	}
+	return res, nil
+}
+
+// Create 创建预订：到店时间需落在可预订时段上，同一门店串行分配桌台避免重复占用
+func (i *ReservationInteractor) Create(ctx context.Context, params domain.ReservationCreateParams) (res *domain.Reservation, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationInteractor.Create")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	online := params.Customer != nil
+	if online {
+		if params.GuestName == "" {
+			params.GuestName = params.Customer.Nickname
+		}
+		if params.GuestPhone == "" {
+			params.GuestPhone = params.Customer.Phone
+		}
+	}
+	params.GuestName = strings.TrimSpace(params.GuestName)
+	params.GuestPhone = strings.TrimSpace(params.GuestPhone)
+	if params.GuestName == "" || params.GuestPhone == "" {
+		return nil, domain.ParamsError(domain.ErrReservationGuestRequired)
+	}
+	if params.Deposit.IsNegative() {
+		return nil, domain.ParamsError(domain.ErrReservationDepositInvalid)
+	}
+
+	store, rule, err := i.resolve(ctx, params.MerchantID, params.StoreID, online, params.PartySize)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	reservedAt := params.ReservedAt.In(time.Local)
+	day := startOfDay(reservedAt)
+	if err = checkDate(rule, day, now); err != nil {
+		return nil, err
+	}
+
+	var slot *domain.ReservationSlotTime
+	for _, st := range rule.SlotTimes(store, day) {
+		if st.At.Equal(reservedAt) {
+			slot = &st
+			break
+		}
+	}
+	if slot == nil {
+		return nil, domain.ParamsError(domain.ErrReservationSlotInvalid)
+	}
+	if !slot.At.After(bookingCutoff(rule, online, now)) {
+		if online {
+			return nil, domain.ParamsError(domain.ErrReservationTooLate)
+		}
+		return nil, domain.ParamsError(domain.ErrReservationSlotInvalid)
+	}
+
+	res = &domain.Reservation{
+		ID:             uuid.New(),
+		MerchantID:     store.MerchantID,
+		StoreID:        store.ID,
+		Source:         domain.ReservationSourceStore,
+		GuestName:      params.GuestName,
+		GuestPhone:     params.GuestPhone,
+		PartySize:      params.PartySize,
+		ReservedAt:     slot.At,
+		EndAt:          slot.At.Add(rule.DiningDuration()),
+		DiningPeriod:   slot.DiningPeriod,
+		AreaPreference: params.AreaPreference,
+		Remark:         params.Remark,
+		Status:         domain.ReservationStatusBooked,
+		Deposit:        params.Deposit,
+		DepositStatus:  domain.ReservationDepositStatusNone,
+	}
+	if online {
+		res.Source = domain.ReservationSourceOnline
+		res.CustomerID = params.Customer.ID
+		res.Deposit = rule.DepositPerGuest.Mul(decimal.NewFromInt(int64(params.PartySize)))
+	}
+	if res.Deposit.IsPositive() {
+		res.DepositStatus = domain.ReservationDepositStatusUnpaid
+	}
+
+	err = i.withStore(ctx, store.ID, func() error {
+		tables, err := i.DS.DiningTableRepo().ListByStoreID(ctx, store.ID)
+		if err != nil {
+			return err
+		}
+		reservations, err := dayReservations(ctx, i.DS, store.ID, day, rule)
+		if err != nil {
+			return err
+		}
+		table := domain.AllocateTable(tables, reservations, res.ReservedAt, res.EndAt, res.PartySize, params.TableID)
+		if table == nil {
+			return domain.ParamsError(domain.ErrReservationSlotFull)
+		}
+		res.TableID = table.ID
+		res.TableName = table.Name
+		return i.DS.ReservationRepo().Create(ctx, res)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.Pusher.Push(ctx, domain.PushEventReservationCreated, res, domain.StorePushTopic(res.StoreID))
+	return res, nil
+}
+
+func (i *ReservationInteractor) List(ctx context.Context, merchantID, storeID uuid.UUID, date string, statuses []domain.ReservationStatus) (res []*domain.Reservation, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationInteractor.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	start, end, err := domain.ReservationDay(date)
+	if err != nil {
+		return nil, domain.ParamsError(domain.ErrReservationDateInvalid)
+	}
+	if _, err = i.findStore(ctx, merchantID, storeID); err != nil {
+		return nil, err
+	}
+	return i.DS.ReservationRepo().List(ctx, domain.ReservationListParams{
+		StoreID:  storeID,
+		Statuses: statuses,
+		From:     start,
+		To:       end,
+	})
+}
+
+// Calendar 按天汇总指定月份（YYYY-MM）的预订，返回当月每一天
+func (i *ReservationInteractor) Calendar(ctx context.Context, merchantID, storeID uuid.UUID, month string) (res []*domain.ReservationCalendarDay, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationInteractor.Calendar")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	start, err := time.ParseInLocation("2006-01", month, time.Local)
+	if err != nil {
+		return nil, domain.ParamsError(domain.ErrReservationDateInvalid)
+	}
+	end := start.AddDate(0, 1, 0)
+	if _, err = i.findStore(ctx, merchantID, storeID); err != nil {
+		return nil, err
+	}
+	reservations, err := i.DS.ReservationRepo().List(ctx, domain.ReservationListParams{
+		StoreID: storeID,
+		From:    start,
+		To:      end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	days := make(map[string]*domain.ReservationCalendarDay)
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		day := &domain.ReservationCalendarDay{Date: d.Format(time.DateOnly)}
+		days[day.Date] = day
+		res = append(res, day)
+	}
+	for _, r := range reservations {
+		day, ok := days[r.ReservedAt.In(time.Local).Format(time.DateOnly)]
+		if !ok {
+			continue
+		}
+		switch {
+		case r.HoldsTable():
+			day.Count++
+			day.Guests += r.PartySize
+		case r.Status == domain.ReservationStatusNoShow:
+			day.NoShowCount++
+		}
+	}
+	return res, nil
+}
+
+func (i *ReservationInteractor) CollectDeposit(ctx context.Context, merchantID, storeID, id uuid.UUID, method domain.PaymentMethodPayType) (res *domain.Reservation, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationInteractor.CollectDeposit")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.transition(ctx, id, ownedByStore(merchantID, storeID), func(r *domain.Reservation) error {
+		return r.CollectDeposit(method, time.Now())
+	})
+}
+
+func (i *ReservationInteractor) Cancel(ctx context.Context, merchantID, storeID, id uuid.UUID, reason string) (res *domain.Reservation, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationInteractor.Cancel")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.transition(ctx, id, ownedByStore(merchantID, storeID), func(r *domain.Reservation) error {
+		return r.Cancel(reason, time.Now())
+	})
+}
+
+func (i *ReservationInteractor) MarkNoShow(ctx context.Context, merchantID, storeID, id uuid.UUID) (res *domain.Reservation, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationInteractor.MarkNoShow")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.transition(ctx, id, ownedByStore(merchantID, storeID), func(r *domain.Reservation) error {
+		return r.MarkNoShow()
+	})
+}
+
+// Seat 安排入座：在桌台开一笔空的堂食订单，顾客到桌后继续点餐
+func (i *ReservationInteractor) Seat(ctx context.Context, merchantID, storeID, id, tableID uuid.UUID) (res *domain.Reservation, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationInteractor.Seat")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	owns := ownedByStore(merchantID, storeID)
+	res, err = i.find(ctx, id, owns)
+	if err != nil {
+		return nil, err
+	}
+
+	var order *domain.Order
+	err = i.withStore(ctx, res.StoreID, func() error {
+		// 加锁后重新读取，避免覆盖并发修改
+		res, err = i.find(ctx, id, owns)
+		if err != nil {
+			return err
+		}
+		if res.Status != domain.ReservationStatusBooked {
+			return domain.ParamsError(domain.ErrReservationStatusInvalid)
+		}
+		now := time.Now()
+		if !startOfDay(res.ReservedAt.In(time.Local)).Equal(startOfDay(now)) {
+			return domain.ParamsError(domain.ErrReservationNotToday)
+		}
+
+		if tableID == uuid.Nil {
+			tableID = res.TableID
+		}
+		table, err := i.DS.DiningTableRepo().FindByID(ctx, tableID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return domain.ParamsError(domain.ErrReservationTableInvalid)
+			}
+			return err
+		}
+		if table.StoreID != res.StoreID || !table.Enabled || table.Seats < res.PartySize {
+			return domain.ParamsError(domain.ErrReservationTableInvalid)
+		}
+		if _, err = i.DS.OrderRepo().FindOpenByTableID(ctx, table.ID); err == nil {
+			return domain.ParamsError(domain.ErrReservationTableOccupied)
+		} else if !domain.IsNotFound(err) {
+			return err
+		}
+
+		order, err = i.newOrder(ctx, res, table, now)
+		if err != nil {
+			return err
+		}
+		if err = res.Seat(table, order.ID, now); err != nil {
+			return domain.ParamsError(err)
+		}
+		return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+			if err := ds.OrderRepo().Create(ctx, order); err != nil {
+				return err
+			}
+			return ds.ReservationRepo().Update(ctx, res)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.Pusher.Push(ctx, domain.PushEventOrderCreated, order, domain.OrderPushTopics(order)...)
+	i.Pusher.Push(ctx, domain.PushEventReservationUpdated, res, domain.StorePushTopic(res.StoreID))
+	return res, nil
+}
+
+func (i *ReservationInteractor) ListByCustomer(ctx context.Context, customerID uuid.UUID) (res []*domain.Reservation, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationInteractor.ListByCustomer")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return i.DS.ReservationRepo().List(ctx, domain.ReservationListParams{
+		CustomerID: customerID,
+		From:       startOfDay(time.Now()),
+	})
+}
+
+func (i *ReservationInteractor) CancelByCustomer(ctx context.Context, customerID, id uuid.UUID) (res *domain.Reservation, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationInteractor.CancelByCustomer")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	owns := func(r *domain.Reservation) bool {
+		return r.CustomerID == customerID
+	}
+	return i.transition(ctx, id, owns, func(r *domain.Reservation) error {
+		now := time.Now()
+		if !r.ReservedAt.After(now) {
+			return domain.ErrReservationStatusInvalid
+		}
+		return r.Cancel("顾客取消", now)
+	})
+}
+
+// resolve 查询门店与预订规则，并校验线上预订开关和用餐人数
+func (i *ReservationInteractor) resolve(ctx context.Context, merchantID, storeID uuid.UUID, online bool, partySize int) (*domain.Store, *domain.ReservationRule, error) {
+	store, err := i.findStore(ctx, merchantID, storeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rule, err := ResolveRule(ctx, i.DS, store.MerchantID, store.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if online {
+		if store.Status != domain.StoreStatusOpen {
+			return nil, nil, domain.ParamsError(domain.ErrReservationStoreClosed)
+		}
+		if !rule.OnlineEnabled {
+			return nil, nil, domain.ParamsError(domain.ErrReservationOnlineDisabled)
+		}
+	}
+	if partySize < 1 || partySize > rule.MaxPartySize {
+		return nil, nil, domain.ParamsError(domain.ErrReservationPartySizeInvalid)
+	}
+	return store, rule, nil
+}
+
+// findStore 查询门店详情，merchantID 不为空时校验门店归属
+func (i *ReservationInteractor) findStore(ctx context.Context, merchantID, storeID uuid.UUID) (*domain.Store, error) {
+	store, err := i.DS.StoreRepo().GetDetail(ctx, storeID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return nil, err
+	}
+	if merchantID != uuid.Nil && store.MerchantID != merchantID {
+		return nil, domain.ParamsError(domain.ErrStoreNotExists)
+	}
+	return store, nil
+}
+
+func (i *ReservationInteractor) find(ctx context.Context, id uuid.UUID, owns func(r *domain.Reservation) bool) (*domain.Reservation, error) {
+	r, err := i.DS.ReservationRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrReservationNotExists)
+		}
+		return nil, err
+	}
+	if !owns(r) {
+		return nil, domain.ParamsError(domain.ErrReservationNotExists)
+	}
+	return r, nil
+}
+
+// transition 持有门店预订锁变更预订状态并推送
+func (i *ReservationInteractor) transition(ctx context.Context, id uuid.UUID, owns func(r *domain.Reservation) bool, apply func(r *domain.Reservation) error) (*domain.Reservation, error) {
+	r, err := i.find(ctx, id, owns)
+	if err != nil {
+		return nil, err
+	}
+	err = i.withStore(ctx, r.StoreID, func() error {
+		r, err = i.find(ctx, id, owns)
+		if err != nil {
+			return err
+		}
+		if err = apply(r); err != nil {
+			return domain.ParamsError(err)
+		}
+		return i.DS.ReservationRepo().Update(ctx, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.Pusher.Push(ctx, domain.PushEventReservationUpdated, r, domain.StorePushTopic(r.StoreID))
+	return r, nil
+}
+
+// withStore 持有门店预订锁执行，分配桌台和状态变更串行进行
+func (i *ReservationInteractor) withStore(ctx context.Context, storeID uuid.UUID, fn func() error) error {
+	mu := i.MutexManager.NewMutex(domain.NewMutexReservationKey(storeID))
+	if err := mu.Lock(ctx); err != nil {
+		if domain.IsAlreadyTakenError(err) {
+			return domain.ParamsError(domain.ErrReservationBusy)
+		}
+		return err
+	}
+	defer func() {
+		_, _ = mu.Unlock(ctx)
+	}()
+	return fn()
+}
+
+// newOrder 为入座的预订开一笔空的堂食订单，线上预订的顾客已是会员时关联会员
+func (i *ReservationInteractor) newOrder(ctx context.Context, r *domain.Reservation, table *domain.DiningTable, now time.Time) (*domain.Order, error) {
+	store, err := i.findStore(ctx, r.MerchantID, r.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	orderNo, err := i.newOrderNo(ctx, store, now)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &domain.Order{
+		ID:            uuid.New(),
+		MerchantID:    store.MerchantID,
+		StoreID:       store.ID,
+		BusinessDate:  now.Format(time.DateOnly),
+		OrderNo:       orderNo,
+		OrderType:     domain.OrderTypeSale,
+		PlacedAt:      now,
+		PlacedByName:  r.GuestName,
+		DiningMode:    domain.DiningModeDineIn,
+		OrderStatus:   domain.OrderStatusPlaced,
+		PaymentStatus: domain.PaymentStatusUnpaid,
+		TableID:       table.ID,
+		TableName:     table.Name,
+		GuestCount:    r.PartySize,
+		Channel:       domain.ChannelPOS,
+		Remark:        r.Remark,
+		Store: domain.OrderStore{
+			ID:           store.ID,
+			MerchantID:   store.MerchantID,
+			StoreCode:    store.StoreCode,
+			ContactPhone: store.ContactPhone,
+			StoreName:    store.StoreName,
+		},
+	}
+	if store.Merchant != nil {
+		order.Store.MerchantName = store.Merchant.MerchantName
+	}
+	order.PayByDeposit(r)
+
+	if r.CustomerID == uuid.Nil {
+		return order, nil
+	}
+	customer, err := i.DS.CustomerRepo().FindByID(ctx, r.CustomerID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return order, nil
+		}
+		return nil, err
+	}
+	m, err := i.DS.MemberRepo().FindByOpenID(ctx, store.MerchantID, customer.OpenID)
+	if err != nil {
+		if !domain.IsNotFound(err) {
+			return nil, err
+		}
+	} else if m.Enabled {
+		order.Member = m.ToOrderMember()
+	}
+	return order, nil
+}
+
+// newOrderNo 生成订单号：门店编码 + YYYYMMDD + 6位当日序号，与 POS 下单共用序号
+func (i *ReservationInteractor) newOrderNo(ctx context.Context, store *domain.Store, now time.Time) (string, error) {
+	prefix := fmt.Sprintf("%s:%s", domain.DailySequencePrefixOrderNo, store.ID.String())
+	seq, err := i.Seq.Next(ctx, prefix)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate order_no: %w", err)
+	}
+	return fmt.Sprintf("%s%s%06d", store.StoreCode, now.Format("20060102"), seq), nil
+}
+
+func ownedByStore(merchantID, storeID uuid.UUID) func(r *domain.Reservation) bool {
+	return func(r *domain.Reservation) bool {
+		return r.MerchantID == merchantID && r.StoreID == storeID
+	}
+}
+
+// dayReservations 查询占用当天桌台的预订，前一天跨零点的预订也计入
+func dayReservations(ctx context.Context, ds domain.DataStore, storeID uuid.UUID, day time.Time, rule *domain.ReservationRule) ([]*domain.Reservation, error) {
+	return ds.ReservationRepo().List(ctx, domain.ReservationListParams{
+		StoreID:  storeID,
+		Statuses: []domain.ReservationStatus{domain.ReservationStatusBooked, domain.ReservationStatusSeated},
+		From:     day.Add(-rule.DiningDuration()),
+		To:       day.AddDate(0, 0, 1),
+	})
+}
+
+// checkDate 校验预订日期在今天至最多可提前天数之间
+func checkDate(rule *domain.ReservationRule, day, now time.Time) error {
+	today := startOfDay(now)
+	if day.Before(today) || day.After(today.AddDate(0, 0, rule.MaxAdvanceDays)) {
+		return domain.ParamsError(domain.ErrReservationDateInvalid)
+	}
+	return nil
+}
+
+// bookingCutoff 可预订的最早到店时间：线上预订需提前规则设置的分钟数
+func bookingCutoff(rule *domain.ReservationRule, online bool, now time.Time) time.Time {
+	if online {
+		return now.Add(time.Duration(rule.MinAdvanceMinutes) * time.Minute)
+	}
+	return now
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,54 @@
+package reservation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/storerule"
+)
+
+// rules 门店预订规则，未配置时使用默认规则
+var rules = storerule.Rules[*domain.ReservationRule]{
+	Repo: func(ds domain.DataStore) domain.StoreRuleRepository[*domain.ReservationRule] {
+		return ds.ReservationRuleRepo()
+	},
+	Default: domain.DefaultReservationRule,
+}
+
+var _ domain.ReservationRuleInteractor = (*ReservationRuleInteractor)(nil)
+
+type ReservationRuleInteractor struct {
+	DS domain.DataStore
+}
+
+func NewReservationRuleInteractor(ds domain.DataStore) *ReservationRuleInteractor {
+	return &ReservationRuleInteractor{
+		DS: ds,
+	}
+}
+
+func (i *ReservationRuleInteractor) GetRule(ctx context.Context, user domain.User) (res *domain.ReservationRule, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationRuleInteractor.GetRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return ResolveRule(ctx, i.DS, user.GetMerchantID(), user.GetStoreID())
+}
+
+// SaveRule 保存当前门店的预订规则，首次保存时创建
+func (i *ReservationRuleInteractor) SaveRule(ctx context.Context, rule *domain.ReservationRule, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ReservationRuleInteractor.SaveRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return rules.Save(ctx, i.DS, rule, user)
+}
+
+// ResolveRule 查询门店的预订规则，未配置时返回默认规则
+func ResolveRule(ctx context.Context, ds domain.DataStore, merchantID, storeID uuid.UUID) (*domain.ReservationRule, error) {
+	return rules.Resolve(ctx, ds, merchantID, storeID)
+}
@@ -0,0 +1,64 @@
+package scanorder
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/storerule"
+)
+
+// rules 扫码点餐规则：门店规则 > 品牌商默认规则 > 系统默认规则
+var rules = storerule.Rules[*domain.ScanOrderRule]{
+	Repo: func(ds domain.DataStore) domain.StoreRuleRepository[*domain.ScanOrderRule] {
+		return ds.ScanOrderRuleRepo()
+	},
+	Default: domain.DefaultScanOrderRule,
+	Inherit: true,
+}
+
+var _ domain.ScanOrderRuleInteractor = (*ScanOrderRuleInteractor)(nil)
+
+type ScanOrderRuleInteractor struct {
+	DS domain.DataStore
+}
+
+func NewScanOrderRuleInteractor(ds domain.DataStore) *ScanOrderRuleInteractor {
+	return &ScanOrderRuleInteractor{
+		DS: ds,
+	}
+}
+
+func (i *ScanOrderRuleInteractor) GetRule(ctx context.Context, user domain.User) (res *domain.ScanOrderRule, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ScanOrderRuleInteractor.GetRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return ResolveRule(ctx, i.DS, user.GetMerchantID(), user.GetStoreID())
+}
+
+// SaveRule 保存当前用户所属层级的规则，首次保存时创建
+func (i *ScanOrderRuleInteractor) SaveRule(ctx context.Context, rule *domain.ScanOrderRule, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ScanOrderRuleInteractor.SaveRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return rules.Save(ctx, i.DS, rule, user)
+}
+
+func (i *ScanOrderRuleInteractor) DeleteRule(ctx context.Context, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ScanOrderRuleInteractor.DeleteRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return rules.Delete(ctx, i.DS, user)
+}
+
+// ResolveRule 查询门店生效的扫码点餐规则：门店规则 > 品牌商默认规则 > 系统默认规则
+func ResolveRule(ctx context.Context, ds domain.DataStore, merchantID, storeID uuid.UUID) (*domain.ScanOrderRule, error) {
+	return rules.Resolve(ctx, ds, merchantID, storeID)
+}
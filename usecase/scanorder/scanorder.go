@@ -0,0 +1,460 @@
+package scanorder
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/member"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/menuprice"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/promotion"
+)
+
+var _ domain.ScanOrderInteractor = (*ScanOrderInteractor)(nil)
+
+type ScanOrderInteractor struct {
+	DS           domain.DataStore
+	CartStore    domain.TableCartStore
+	MutexManager domain.MutexManager
+	Seq          domain.DailySequence
+	Pusher       domain.Pusher
+}
+
+func NewScanOrderInteractor(
+	ds domain.DataStore,
+	cartStore domain.TableCartStore,
+	mutexManager domain.MutexManager,
+	seq domain.DailySequence,
+	pusher domain.Pusher,
+) *ScanOrderInteractor {
+	return &ScanOrderInteractor{
+		DS:           ds,
+		CartStore:    cartStore,
+		MutexManager: mutexManager,
+		Seq:          seq,
+		Pusher:       pusher,
+	}
+}
+
+// scanTable 二维码令牌解析出的桌台、门店与生效规则
+type scanTable struct {
+	table *domain.DiningTable
+	store *domain.Store
+	rule  *domain.ScanOrderRule
+}
+
+func (i *ScanOrderInteractor) GetTable(ctx context.Context, token string) (res *domain.ScanOrderTable, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ScanOrderInteractor.GetTable")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	st, err := i.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ScanOrderTable{
+		TableID:    st.table.ID,
+		TableName:  st.table.Name,
+		Seats:      st.table.Seats,
+		MerchantID: st.store.MerchantID,
+		StoreID:    st.store.ID,
+		StoreName:  st.store.StoreName,
+		PayMode:    st.rule.PayMode,
+	}, nil
+}
+
+func (i *ScanOrderInteractor) ListMenus(ctx context.Context, token string) (res domain.Menus, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ScanOrderInteractor.ListMenus")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	st, err := i.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	menus, err := i.DS.MenuRepo().ListAllStoreMenus(ctx, domain.MenuListAllParams{
+		MerchantID: st.store.MerchantID,
+		StoreID:    st.store.ID,
+		At:         now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	domain.ApplyMenuCurrentPrices(menus, st.store.DiningPeriods, now)
+
+	// 只返回可扫码点餐的商品
+	return menuprice.ScanOrder.FilterMenus(menus), nil
+}
+
+func (i *ScanOrderInteractor) GetCart(ctx context.Context, token string) (res *domain.TableCart, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ScanOrderInteractor.GetCart")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	st, err := i.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return i.CartStore.Get(ctx, st.table.ID)
+}
+
+func (i *ScanOrderInteractor) AddCartItem(
+	ctx context.Context,
+	token string,
+	params domain.ScanOrderCartItemParams,
+	customer *domain.Customer,
+) (res *domain.TableCart, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ScanOrderInteractor.AddCartItem")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	st, err := i.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	p, err := menuprice.NewPricer(ctx, i.DS, menuprice.ScanOrder, st.store, []uuid.UUID{params.ProductID}, now)
+	if err != nil {
+		return nil, err
+	}
+	line, err := p.Price(ctx, params.ProductID, params.SpecID, params.Attrs, nil)
+	if err != nil {
+		return nil, domain.ParamsError(err)
+	}
+
+	item := &domain.TableCartItem{
+		ProductID:    line.Product.ID,
+		ProductName:  line.Product.Name,
+		Attrs:        params.Attrs,
+		Price:        line.UnitPrice(),
+		Qty:          params.Qty,
+		Remark:       params.Remark,
+		CustomerID:   customer.ID,
+		CustomerName: customer.Nickname,
+	}
+	if line.Spec != nil {
+		item.SpecID = line.Spec.SpecID
+		item.SpecName = line.Spec.SpecName
+	}
+	item.AttrNames = line.AttrNames()
+
+	err = i.withCart(ctx, st.table.ID, func(cart *domain.TableCart) error {
+		if err := cart.AddItem(item, now); err != nil {
+			return domain.ParamsError(err)
+		}
+		res = cart
+		return i.CartStore.Save(ctx, cart)
+	})
+	if err != nil {
+		return nil, err
+	}
+	i.Pusher.Push(ctx, domain.PushEventCartUpdated, res, domain.TablePushTopic(st.table.ID))
+	return res, nil
+}
+
+func (i *ScanOrderInteractor) UpdateCartItem(ctx context.Context, token string, itemID uuid.UUID, qty int) (res *domain.TableCart, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ScanOrderInteractor.UpdateCartItem")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	st, err := i.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	err = i.withCart(ctx, st.table.ID, func(cart *domain.TableCart) error {
+		if err := cart.UpdateItemQty(itemID, qty, time.Now()); err != nil {
+			return domain.ParamsError(err)
+		}
+		res = cart
+		return i.CartStore.Save(ctx, cart)
+	})
+	if err != nil {
+		return nil, err
+	}
+	i.Pusher.Push(ctx, domain.PushEventCartUpdated, res, domain.TablePushTopic(st.table.ID))
+	return res, nil
+}
+
+func (i *ScanOrderInteractor) ClearCart(ctx context.Context, token string) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ScanOrderInteractor.ClearCart")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	st, err := i.resolve(ctx, token)
+	if err != nil {
+		return err
+	}
+	err = i.withCart(ctx, st.table.ID, func(*domain.TableCart) error {
+		return i.CartStore.Delete(ctx, st.table.ID)
+	})
+	if err != nil {
+		return err
+	}
+	i.Pusher.Push(ctx, domain.PushEventCartUpdated, domain.NewTableCart(st.table.ID), domain.TablePushTopic(st.table.ID))
+	return nil
+}
+
+func (i *ScanOrderInteractor) Submit(
+	ctx context.Context,
+	token string,
+	params domain.ScanOrderSubmitParams,
+	customer *domain.Customer,
+) (res *domain.Order, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "ScanOrderInteractor.Submit")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	st, err := i.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	var result *submitResult
+	err = i.withCart(ctx, st.table.ID, func(cart *domain.TableCart) error {
+		if cart.IsEmpty() {
+			return domain.ParamsError(domain.ErrTableCartEmpty)
+		}
+		result, err = i.submit(ctx, st, cart, params, customer)
+		if err != nil {
+			return err
+		}
+		return i.CartStore.Delete(ctx, st.table.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 通知门店 POS 新订单/加菜，通知同桌顾客购物车已清空
+	order := result.order
+	event := domain.PushEventOrderUpdated
+	if result.created {
+		event = domain.PushEventOrderCreated
+	}
+	i.Pusher.Push(ctx, event, order, domain.OrderPushTopics(order)...)
+	if result.ticket.Status == domain.KitchenTicketStatusPending {
+		i.Pusher.Push(ctx, domain.PushEventKitchenTicketCreated, result.ticket, domain.StorePushTopic(order.StoreID))
+	}
+	i.Pusher.Push(ctx, domain.PushEventCartUpdated, domain.NewTableCart(st.table.ID), domain.TablePushTopic(st.table.ID))
+	return order, nil
+}
+
+// submitResult 提交购物车结果
+type submitResult struct {
+	order   *domain.Order
+	created bool // 是否新建订单，否则为追加到未结账订单
+	ticket  *domain.KitchenTicket
+}
+
+// submit 按门店菜单重新计价购物车商品，生成或追加订单并送厨
+func (i *ScanOrderInteractor) submit(
+	ctx context.Context,
+	st *scanTable,
+	cart *domain.TableCart,
+	params domain.ScanOrderSubmitParams,
+	customer *domain.Customer,
+) (*submitResult, error) {
+	now := time.Now()
+	productIDs := make([]uuid.UUID, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+	p, err := menuprice.NewPricer(ctx, i.DS, menuprice.ScanOrder, st.store, productIDs, now)
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]*menuprice.Line, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		line, err := p.Price(ctx, item.ProductID, item.SpecID, item.Attrs, nil)
+		if err != nil {
+			return nil, domain.ParamsErrorf("%s：%w", item.ProductName, err)
+		}
+		lines = append(lines, line)
+	}
+
+	payFirst := st.rule.PayMode == domain.ScanOrderPayModePayFirst
+	var (
+		order  *domain.Order
+		isNew  bool
+		ticket *domain.KitchenTicket
+	)
+	err = i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		// 前台结账时同桌加菜追加到未结账订单
+		var err error
+		if !payFirst {
+			order, err = ds.OrderRepo().FindOpenByTableID(ctx, st.table.ID)
+			if err != nil && !domain.IsNotFound(err) {
+				return err
+			}
+		}
+		isNew = order == nil
+		if isNew {
+			if order, err = i.newOrder(ctx, ds, st, customer, now); err != nil {
+				return err
+			}
+		}
+		if params.GuestCount > 0 {
+			order.GuestCount = params.GuestCount
+		}
+		if params.Remark != "" {
+			order.Remark = params.Remark
+		}
+
+		batch := order.NextBatchIndex()
+		products := make([]domain.OrderProduct, 0, len(lines))
+		stallIDs := make(map[uuid.UUID]uuid.UUID, len(lines))
+		for idx, line := range lines {
+			item := cart.Items[idx]
+			products = append(products, line.OrderProduct(item.Qty, item.Remark, batch))
+			stallIDs[line.Product.ID] = line.Product.EffectiveStallID()
+		}
+		order.AddProducts(products)
+		order.AddPlaceOrderLog(domain.OrderOperator{ID: customer.ID, Name: customer.Nickname}, products, now)
+
+		// 追加商品后重新计算会员价与促销优惠
+		if err = member.ApplyToOrder(ctx, ds, order); err != nil {
+			return err
+		}
+		if err = promotion.ApplyToOrder(ctx, ds, order); err != nil {
+			return err
+		}
+
+		if isNew {
+			err = ds.OrderRepo().Create(ctx, order)
+		} else {
+			err = ds.OrderRepo().Update(ctx, order)
+		}
+		if err != nil {
+			return err
+		}
+
+		// 先付后吃的厨房单待订单支付后送厨
+		ticket = domain.NewKitchenTicket(order, batch, payFirst, stallIDs)
+		return ds.KitchenTicketRepo().Create(ctx, ticket)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &submitResult{order: order, created: isNew, ticket: ticket}, nil
+}
+
+// newOrder 创建桌台扫码订单
+func (i *ScanOrderInteractor) newOrder(ctx context.Context, ds domain.DataStore, st *scanTable, customer *domain.Customer, now time.Time) (*domain.Order, error) {
+	businessDate := now.Format(time.DateOnly)
+	orderNo, err := i.newOrderNo(ctx, st.store, now)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &domain.Order{
+		ID:            uuid.New(),
+		MerchantID:    st.store.MerchantID,
+		StoreID:       st.store.ID,
+		BusinessDate:  businessDate,
+		OrderNo:       orderNo,
+		OrderType:     domain.OrderTypeSale,
+		PlacedAt:      now,
+		PlacedBy:      customer.ID,
+		PlacedByName:  customer.Nickname,
+		DiningMode:    domain.DiningModeDineIn,
+		OrderStatus:   domain.OrderStatusPlaced,
+		PaymentStatus: domain.PaymentStatusUnpaid,
+		TableID:       st.table.ID,
+		TableName:     st.table.Name,
+		Channel:       domain.ChannelH5,
+		Store: domain.OrderStore{
+			ID:           st.store.ID,
+			MerchantID:   st.store.MerchantID,
+			StoreCode:    st.store.StoreCode,
+			ContactPhone: st.store.ContactPhone,
+			StoreName:    st.store.StoreName,
+		},
+	}
+	if st.store.Merchant != nil {
+		order.Store.MerchantName = st.store.Merchant.MerchantName
+	}
+
+	// 顾客已是品牌会员时关联会员，享受会员价
+	m, err := ds.MemberRepo().FindByOpenID(ctx, st.store.MerchantID, customer.OpenID)
+	if err != nil {
+		if !domain.IsNotFound(err) {
+			return nil, err
+		}
+	} else if m.Enabled {
+		order.Member = m.ToOrderMember()
+	}
+	return order, nil
+}
+
+// newOrderNo 生成订单号：门店编码 + YYYYMMDD + 6位当日序号，与 POS 下单共用序号
+func (i *ScanOrderInteractor) newOrderNo(ctx context.Context, store *domain.Store, now time.Time) (string, error) {
+	prefix := fmt.Sprintf("%s:%s", domain.DailySequencePrefixOrderNo, store.ID.String())
+	seq, err := i.Seq.Next(ctx, prefix)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate order_no: %w", err)
+	}
+	return fmt.Sprintf("%s%s%06d", store.StoreCode, now.Format("20060102"), seq), nil
+}
+
+// resolve 解析二维码令牌，校验桌台、门店与扫码点餐规则
+func (i *ScanOrderInteractor) resolve(ctx context.Context, token string) (*scanTable, error) {
+	table, err := i.DS.DiningTableRepo().FindByQRToken(ctx, token)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrDiningTableNotExists)
+		}
+		return nil, err
+	}
+	if !table.Enabled {
+		return nil, domain.ParamsError(domain.ErrDiningTableDisabled)
+	}
+
+	store, err := i.DS.StoreRepo().GetDetail(ctx, table.StoreID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return nil, err
+	}
+	if store.Status != domain.StoreStatusOpen {
+		return nil, domain.ParamsError(domain.ErrScanOrderStoreClosed)
+	}
+
+	rule, err := ResolveRule(ctx, i.DS, table.MerchantID, table.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	if !rule.Enabled {
+		return nil, domain.ParamsError(domain.ErrScanOrderDisabled)
+	}
+	return &scanTable{table: table, store: store, rule: rule}, nil
+}
+
+// withCart 持有桌台购物车锁读取并修改购物车，同桌多位顾客并发加菜时串行执行
+func (i *ScanOrderInteractor) withCart(ctx context.Context, tableID uuid.UUID, fn func(cart *domain.TableCart) error) error {
+	mu := i.MutexManager.NewMutex(domain.NewMutexCartKey(tableID))
+	if err := mu.Lock(ctx); err != nil {
+		if domain.IsAlreadyTakenError(err) {
+			return domain.ParamsError(domain.ErrScanOrderBusy)
+		}
+		return err
+	}
+	defer func() {
+		_, _ = mu.Unlock(ctx)
+	}()
+
+	cart, err := i.CartStore.Get(ctx, tableID)
+	if err != nil {
+		return err
+	}
+	return fn(cart)
+}
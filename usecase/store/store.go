@@ -190,7 +190,7 @@ func (interactor *StoreInteractor) GetStores(ctx context.Context,
 			MerchantName: filter.MerchantName,
 		})
 		if err != nil {
-			return
+			return nil, 0, err
 		}
 
 		merchantIDs := lo.Map(merchantList, func(item *domain.Merchant, _ int) uuid.UUID {
@@ -385,20 +385,28 @@ func validateDiningPeriods(periods []domain.DiningPeriod) error {
 		return nil
 	}
 	seen := make(map[string]struct{})
+	// 跨零点时段拆分为当天和次日两段后检查重叠
+	var ranges [][2]string
 	for _, p := range periods {
-		if p.StartTime >= p.EndTime {
+		if p.StartTime == p.EndTime {
 			return domain.ParamsError(domain.ErrStoreDiningPeriodTimeInvalid)
 		}
 		if _, ok := seen[p.Name]; ok {
 			return domain.ParamsError(domain.ErrStoreDiningPeriodNameExists)
 		}
 		seen[p.Name] = struct{}{}
+		if p.CrossesMidnight() {
+			ranges = append(ranges, [2]string{p.StartTime, "24:00:00"}, [2]string{"00:00:00", p.EndTime})
+			continue
+		}
+		ranges = append(ranges, [2]string{p.StartTime, p.EndTime})
 	}
 
 	sort.Slice(periods, func(i, j int) bool { return periods[i].StartTime < periods[j].StartTime })
-	for i := 1; i < len(periods); i++ {
-		prev, cur := periods[i-1], periods[i]
-		if cur.StartTime < prev.EndTime {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+	for i := 1; i < len(ranges); i++ {
+		prev, cur := ranges[i-1], ranges[i]
+		if cur[0] < prev[1] {
 			return domain.ParamsError(domain.ErrStoreDiningPeriodConflict)
 		}
 	}
@@ -0,0 +1,84 @@
+package storerule
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+)
+
+// Rules 门店规则的查询、保存与删除，每个门店一条规则
+type Rules[R domain.StoreRule] struct {
+	// Repo 规则仓储
+	Repo func(ds domain.DataStore) domain.StoreRuleRepository[R]
+	// Default 未配置规则时的默认规则
+	Default func(merchantID, storeID uuid.UUID) R
+	// Inherit 门店未配置时是否沿用品牌商默认规则
+	Inherit bool
+}
+
+// Resolve 查询门店生效的规则：门店规则 > 品牌商默认规则（Inherit 时）> 系统默认规则
+func (r Rules[R]) Resolve(ctx context.Context, ds domain.DataStore, merchantID, storeID uuid.UUID) (res R, err error) {
+	repo := r.Repo(ds)
+	if storeID != uuid.Nil {
+		res, err = repo.FindByStore(ctx, merchantID, storeID)
+		if err == nil {
+			return res, nil
+		}
+		if !domain.IsNotFound(err) {
+			return res, err
+		}
+	}
+	if !r.Inherit {
+		return r.Default(merchantID, storeID), nil
+	}
+
+	res, err = repo.FindByStore(ctx, merchantID, uuid.Nil)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return r.Default(merchantID, uuid.Nil), nil
+		}
+		return res, err
+	}
+	return res, nil
+}
+
+// Save 校验并保存当前用户所属层级的规则，首次保存时创建
+func (r Rules[R]) Save(ctx context.Context, ds domain.DataStore, rule R, user domain.User) error {
+	meta := rule.Meta()
+	meta.MerchantID = user.GetMerchantID()
+	meta.StoreID = user.GetStoreID()
+	if err := rule.Validate(); err != nil {
+		return domain.ParamsError(err)
+	}
+
+	return ds.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		repo := r.Repo(ds)
+		existing, err := repo.FindByStore(ctx, meta.MerchantID, meta.StoreID)
+		if err != nil {
+			if !domain.IsNotFound(err) {
+				return err
+			}
+			meta.ID = uuid.New()
+			return repo.Create(ctx, rule)
+		}
+		meta.ID = existing.Meta().ID
+		meta.CreatedAt = existing.Meta().CreatedAt
+		return repo.Update(ctx, rule)
+	})
+}
+
+// Delete 删除当前用户所属层级的规则，未配置时忽略
+func (r Rules[R]) Delete(ctx context.Context, ds domain.DataStore, user domain.User) error {
+	return ds.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+		repo := r.Repo(ds)
+		existing, err := repo.FindByStore(ctx, user.GetMerchantID(), user.GetStoreID())
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return repo.Delete(ctx, existing.Meta().ID)
+	})
+}
@@ -0,0 +1,134 @@
+package storerule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/domain/mock"
+)
+
+func newRules(inherit bool) Rules[*domain.ScanOrderRule] {
+	return Rules[*domain.ScanOrderRule]{
+		Repo: func(ds domain.DataStore) domain.StoreRuleRepository[*domain.ScanOrderRule] {
+			return ds.ScanOrderRuleRepo()
+		},
+		Default: domain.DefaultScanOrderRule,
+		Inherit: inherit,
+	}
+}
+
+func TestRulesResolve(t *testing.T) {
+	merchantID := uuid.New()
+	storeID := uuid.New()
+	notFound := domain.NotFoundError(errors.New("not found"))
+	storeRule := &domain.ScanOrderRule{StoreRuleMeta: domain.StoreRuleMeta{ID: uuid.New(), MerchantID: merchantID, StoreID: storeID}}
+	merchantRule := &domain.ScanOrderRule{StoreRuleMeta: domain.StoreRuleMeta{ID: uuid.New(), MerchantID: merchantID}}
+
+	tests := []struct {
+		name     string
+		inherit  bool
+		store    *domain.ScanOrderRule
+		merchant *domain.ScanOrderRule
+		wantID   uuid.UUID
+		wantMeta domain.StoreRuleMeta // 返回默认规则时的归属
+	}{
+		{name: "门店已配置", inherit: true, store: storeRule, wantID: storeRule.ID},
+		{name: "门店未配置沿用品牌商默认规则", inherit: true, merchant: merchantRule, wantID: merchantRule.ID},
+		{name: "均未配置返回品牌商层级的默认规则", inherit: true, wantMeta: domain.StoreRuleMeta{MerchantID: merchantID}},
+		{name: "不沿用品牌商规则时返回门店默认规则", inherit: false, wantMeta: domain.StoreRuleMeta{MerchantID: merchantID, StoreID: storeID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ds := mock.NewMockDataStore(ctrl)
+			repo := mock.NewMockScanOrderRuleRepository(ctrl)
+			ds.EXPECT().ScanOrderRuleRepo().Return(repo).AnyTimes()
+
+			if tt.store != nil {
+				repo.EXPECT().FindByStore(gomock.Any(), merchantID, storeID).Return(tt.store, nil)
+			} else {
+				repo.EXPECT().FindByStore(gomock.Any(), merchantID, storeID).Return(nil, notFound)
+			}
+			if tt.store == nil && tt.inherit {
+				if tt.merchant != nil {
+					repo.EXPECT().FindByStore(gomock.Any(), merchantID, uuid.Nil).Return(tt.merchant, nil)
+				} else {
+					repo.EXPECT().FindByStore(gomock.Any(), merchantID, uuid.Nil).Return(nil, notFound)
+				}
+			}
+
+			got, err := newRules(tt.inherit).Resolve(context.Background(), ds, merchantID, storeID)
+			require.NoError(t, err)
+			if tt.wantID != uuid.Nil {
+				require.Equal(t, tt.wantID, got.ID)
+				return
+			}
+			require.Equal(t, tt.wantMeta, got.StoreRuleMeta)
+			require.Equal(t, domain.ScanOrderPayModePayAtCounter, got.PayMode)
+		})
+	}
+}
+
+func TestRulesSave(t *testing.T) {
+	user := &domain.StoreUser{ID: uuid.New(), MerchantID: uuid.New(), StoreID: uuid.New()}
+	existing := &domain.ScanOrderRule{StoreRuleMeta: domain.StoreRuleMeta{
+		ID:        uuid.New(),
+		CreatedAt: time.Now().Add(-time.Hour),
+	}}
+
+	tests := []struct {
+		name     string
+		payMode  domain.ScanOrderPayMode
+		existing *domain.ScanOrderRule
+		wantErr  error
+	}{
+		{name: "首次保存时创建", payMode: domain.ScanOrderPayModePayFirst},
+		{name: "已配置时沿用ID更新", payMode: domain.ScanOrderPayModePayFirst, existing: existing},
+		{name: "规则内容无效", payMode: "unknown", wantErr: domain.ErrScanOrderPayModeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ds := mock.NewMockDataStore(ctrl)
+			repo := mock.NewMockScanOrderRuleRepository(ctrl)
+			ds.EXPECT().ScanOrderRuleRepo().Return(repo).AnyTimes()
+			ds.EXPECT().Atomic(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context, ds domain.DataStore) error) error {
+					return fn(ctx, ds)
+				}).AnyTimes()
+
+			rule := &domain.ScanOrderRule{PayMode: tt.payMode}
+			switch {
+			case tt.wantErr != nil:
+			case tt.existing != nil:
+				repo.EXPECT().FindByStore(gomock.Any(), user.MerchantID, user.StoreID).Return(tt.existing, nil)
+				repo.EXPECT().Update(gomock.Any(), rule).Return(nil)
+			default:
+				repo.EXPECT().FindByStore(gomock.Any(), user.MerchantID, user.StoreID).
+					Return(nil, domain.NotFoundError(errors.New("not found")))
+				repo.EXPECT().Create(gomock.Any(), rule).Return(nil)
+			}
+
+			err := newRules(true).Save(context.Background(), ds, rule, user)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.True(t, domain.IsParamsError(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, user.MerchantID, rule.MerchantID)
+			require.Equal(t, user.StoreID, rule.StoreID)
+			require.NotEqual(t, uuid.Nil, rule.ID)
+			if tt.existing != nil {
+				require.Equal(t, tt.existing.ID, rule.ID)
+				require.Equal(t, tt.existing.CreatedAt, rule.CreatedAt)
+			}
+		})
+	}
+}
@@ -5,30 +5,46 @@ import (
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/additionalfee"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/businessconfig"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/category"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/coupon"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/delivery"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/department"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/device"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/diningtable"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/kiosk"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/kitchenticket"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/member"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/menu"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/merchant"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/order"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/orderreceipt"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/paymentaccount"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/paymentmethod"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/permission"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/pickup"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/preorder"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/pricechange"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/product"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/productattr"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/productspec"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/producttag"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/productunit"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/productversion"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/profitdistributionbill"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/profitdistributionrule"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/promotion"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/push"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/refundorder"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/remark"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/reservation"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/role"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/routermenu"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/scanorder"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/stall"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/store"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/storepaymentaccount"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/taxfee"
 	"gitlab.jiguang.dev/pos-dine/dine/usecase/userauth"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/waitlist"
 	"go.uber.org/fx"
 )
 
@@ -155,5 +171,113 @@ var Module = fx.Module(
 			role.NewRoleMenuInteractor,
 			fx.As(new(domain.RoleMenuInteractor)),
 		),
+		fx.Annotate(
+			pricechange.NewPriceChangeInteractor,
+			fx.As(new(domain.PriceChangeInteractor)),
+		),
+		fx.Annotate(
+			productversion.NewProductVersionInteractor,
+			fx.As(new(domain.ProductVersionInteractor)),
+		),
+		fx.Annotate(
+			promotion.NewPromotionInteractor,
+			fx.As(new(domain.PromotionInteractor)),
+		),
+		fx.Annotate(
+			coupon.NewCouponTemplateInteractor,
+			fx.As(new(domain.CouponTemplateInteractor)),
+		),
+		fx.Annotate(
+			coupon.NewCouponInteractor,
+			fx.As(new(domain.CouponInteractor)),
+		),
+		fx.Annotate(
+			member.NewMemberTierInteractor,
+			fx.As(new(domain.MemberTierInteractor)),
+		),
+		fx.Annotate(
+			member.NewMemberInteractor,
+			fx.As(new(domain.MemberInteractor)),
+		),
+		fx.Annotate(
+			member.NewMemberAccountInteractor,
+			fx.As(new(domain.MemberAccountInteractor)),
+		),
+		fx.Annotate(
+			member.NewMemberPointsInteractor,
+			fx.As(new(domain.MemberPointsInteractor)),
+		),
+		fx.Annotate(
+			userauth.NewCustomerInteractor,
+			fx.As(new(domain.CustomerInteractor)),
+		),
+		fx.Annotate(
+			diningtable.NewDiningTableInteractor,
+			fx.As(new(domain.DiningTableInteractor)),
+		),
+		fx.Annotate(
+			scanorder.NewScanOrderRuleInteractor,
+			fx.As(new(domain.ScanOrderRuleInteractor)),
+		),
+		fx.Annotate(
+			scanorder.NewScanOrderInteractor,
+			fx.As(new(domain.ScanOrderInteractor)),
+		),
+		fx.Annotate(
+			kiosk.NewKioskInteractor,
+			fx.As(new(domain.KioskInteractor)),
+		),
+		fx.Annotate(
+			kitchenticket.NewKitchenTicketInteractor,
+			fx.As(new(domain.KitchenTicketInteractor)),
+		),
+		fx.Annotate(
+			pickup.NewPickupRuleInteractor,
+			fx.As(new(domain.PickupRuleInteractor)),
+		),
+		fx.Annotate(
+			pickup.NewPickupCallInteractor,
+			fx.As(new(domain.PickupCallInteractor)),
+		),
+		fx.Annotate(
+			delivery.NewDeliverySettingInteractor,
+			fx.As(new(domain.DeliverySettingInteractor)),
+		),
+		fx.Annotate(
+			delivery.NewDeliveryOrderInteractor,
+			fx.As(new(domain.DeliveryOrderInteractor)),
+		),
+		fx.Annotate(
+			reservation.NewReservationRuleInteractor,
+			fx.As(new(domain.ReservationRuleInteractor)),
+		),
+		fx.Annotate(
+			reservation.NewReservationInteractor,
+			fx.As(new(domain.ReservationInteractor)),
+		),
+		fx.Annotate(
+			waitlist.NewWaitlistRuleInteractor,
+			fx.As(new(domain.WaitlistRuleInteractor)),
+		),
+		fx.Annotate(
+			waitlist.NewWaitlistInteractor,
+			fx.As(new(domain.WaitlistInteractor)),
+		),
+		fx.Annotate(
+			preorder.NewPreorderRuleInteractor,
+			fx.As(new(domain.PreorderRuleInteractor)),
+		),
+		fx.Annotate(
+			preorder.NewPreorderInteractor,
+			fx.As(new(domain.PreorderInteractor)),
+		),
+		fx.Annotate(
+			push.NewPushInteractor,
+			fx.As(new(domain.PushInteractor)),
+		),
+		fx.Annotate(
+			orderreceipt.NewOrderReceiptInteractor,
+			fx.As(new(domain.OrderReceiptInteractor)),
+		),
 	),
 )
@@ -0,0 +1,177 @@
+package userauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/logging"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+var _ domain.CustomerInteractor = (*CustomerInteractor)(nil)
+
+type CustomerInteractor struct {
+	AuthConfig  domain.AuthConfig
+	DS          domain.DataStore
+	MiniProgram domain.WechatMiniProgram
+}
+
+func NewCustomerInteractor(authConfig domain.AuthConfig, dataStore domain.DataStore, miniProgram domain.WechatMiniProgram) *CustomerInteractor {
+	return &CustomerInteractor{
+		AuthConfig:  authConfig,
+		DS:          dataStore,
+		MiniProgram: miniProgram,
+	}
+}
+
+func (interactor *CustomerInteractor) Login(ctx context.Context, code string) (token string, expAt time.Time, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CustomerInteractor.Login")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	session, err := interactor.MiniProgram.Code2Session(ctx, code)
+	if err != nil {
+		if errors.Is(err, domain.ErrWechatLoginCodeInvalid) {
+			err = domain.ParamsError(err)
+		}
+		return
+	}
+
+	customer, err := interactor.saveLogin(ctx, session)
+	if err != nil {
+		return
+	}
+
+	expAt = time.Now().Add(time.Duration(interactor.AuthConfig.Expire) * time.Second)
+	claims := jwt.NewWithClaims(jwt.SigningMethodHS256, AuthToken{
+		ID: customer.ID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expAt),
+		},
+	})
+
+	token, err = claims.SignedString([]byte(interactor.AuthConfig.Secret))
+	if err != nil {
+		err = fmt.Errorf("failed to sign token: %w", err)
+		return
+	}
+
+	return
+}
+
+// saveLogin 记录登录，首次登录时创建客户（并发首次登录时以先创建者为准）
+func (interactor *CustomerInteractor) saveLogin(ctx context.Context, session *domain.WechatSession) (*domain.Customer, error) {
+	now := time.Now()
+	customer, err := interactor.DS.CustomerRepo().FindByOpenID(ctx, session.OpenID)
+	if err == nil {
+		customer.Login(session, now)
+		if err := interactor.DS.CustomerRepo().Update(ctx, customer); err != nil {
+			return nil, err
+		}
+		return customer, nil
+	}
+	if !domain.IsNotFound(err) {
+		return nil, err
+	}
+
+	customer = domain.NewCustomer(session)
+	customer.Login(session, now)
+	err = interactor.DS.CustomerRepo().Create(ctx, customer)
+	if err == nil {
+		return customer, nil
+	}
+	if !domain.IsConflict(err) {
+		return nil, err
+	}
+	return interactor.DS.CustomerRepo().FindByOpenID(ctx, session.OpenID)
+}
+
+func (interactor *CustomerInteractor) Authenticate(ctx context.Context, token string) (customer *domain.Customer, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CustomerInteractor.Authenticate")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+	logger := logging.FromContext(ctx).Named("CustomerInteractor.Authenticate")
+
+	claims := &AuthToken{}
+	tokenInfo, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		return []byte(interactor.AuthConfig.Secret), nil
+	})
+	if err != nil {
+		if !errors.Is(err, jwt.ErrTokenNotValidYet) && !errors.Is(err, jwt.ErrTokenExpired) {
+			logger.Errorw("failed to parse token", "error", err)
+		}
+		err = domain.ErrTokenInvalid
+		return
+	}
+
+	if !tokenInfo.Valid {
+		err = domain.ErrTokenInvalid
+		return
+	}
+
+	customer, err = interactor.DS.CustomerRepo().FindByID(ctx, claims.ID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			err = domain.ErrTokenInvalid
+			return
+		}
+		err = fmt.Errorf("failed to find customer[%s]: %w", claims.ID, err)
+		return
+	}
+
+	return
+}
+
+func (interactor *CustomerInteractor) UpdateProfile(ctx context.Context, params domain.CustomerProfileParams) (customer *domain.Customer, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CustomerInteractor.UpdateProfile")
+	defer func() { util.SpanErrFinish(span, err) }()
+
+	customer, err = interactor.DS.CustomerRepo().FindByID(ctx, params.ID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrCustomerNotExists)
+		}
+		return nil, err
+	}
+
+	customer.Nickname = params.Nickname
+	customer.Avatar = params.Avatar
+	if err = interactor.DS.CustomerRepo().Update(ctx, customer); err != nil {
+		return nil, err
+	}
+	return customer, nil
+}
+
+func (interactor *CustomerInteractor) BindPhone(ctx context.Context, id uuid.UUID, code string) (customer *domain.Customer, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "CustomerInteractor.BindPhone")
+	defer func() { util.SpanErrFinish(span, err) }()
+
+	phone, err := interactor.MiniProgram.GetPhoneNumber(ctx, code)
+	if err != nil {
+		if errors.Is(err, domain.ErrWechatPhoneCodeInvalid) {
+			return nil, domain.ParamsError(err)
+		}
+		return nil, err
+	}
+
+	customer, err = interactor.DS.CustomerRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrCustomerNotExists)
+		}
+		return nil, err
+	}
+
+	customer.Phone = phone
+	if err = interactor.DS.CustomerRepo().Update(ctx, customer); err != nil {
+		return nil, err
+	}
+	return customer, nil
+}
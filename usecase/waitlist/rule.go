@@ -0,0 +1,54 @@
+package waitlist
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+	"gitlab.jiguang.dev/pos-dine/dine/usecase/storerule"
+)
+
+// rules 门店排队规则，未配置时使用默认规则
+var rules = storerule.Rules[*domain.WaitlistRule]{
+	Repo: func(ds domain.DataStore) domain.StoreRuleRepository[*domain.WaitlistRule] {
+		return ds.WaitlistRuleRepo()
+	},
+	Default: domain.DefaultWaitlistRule,
+}
+
+var _ domain.WaitlistRuleInteractor = (*WaitlistRuleInteractor)(nil)
+
+type WaitlistRuleInteractor struct {
+	DS domain.DataStore
+}
+
+func NewWaitlistRuleInteractor(ds domain.DataStore) *WaitlistRuleInteractor {
+	return &WaitlistRuleInteractor{
+		DS: ds,
+	}
+}
+
+func (i *WaitlistRuleInteractor) GetRule(ctx context.Context, user domain.User) (res *domain.WaitlistRule, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistRuleInteractor.GetRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return ResolveRule(ctx, i.DS, user.GetMerchantID(), user.GetStoreID())
+}
+
+// SaveRule 保存当前门店的排队规则，首次保存时创建
+func (i *WaitlistRuleInteractor) SaveRule(ctx context.Context, rule *domain.WaitlistRule, user domain.User) (err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistRuleInteractor.SaveRule")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	return rules.Save(ctx, i.DS, rule, user)
+}
+
+// ResolveRule 查询门店的排队规则，未配置时返回默认规则
+func ResolveRule(ctx context.Context, ds domain.DataStore, merchantID, storeID uuid.UUID) (*domain.WaitlistRule, error) {
+	return rules.Resolve(ctx, ds, merchantID, storeID)
+}
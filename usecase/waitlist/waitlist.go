@@ -0,0 +1,574 @@
+package waitlist
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.jiguang.dev/pos-dine/dine/domain"
+	"gitlab.jiguang.dev/pos-dine/dine/pkg/util"
+)
+
+const (
+	// turnoverLookback 统计翻台时长的时间范围
+	turnoverLookback = 7 * 24 * time.Hour
+	// turnoverSamples 统计翻台时长最多使用的订单数
+	turnoverSamples = 200
+)
+
+var _ domain.WaitlistInteractor = (*WaitlistInteractor)(nil)
+
+type WaitlistInteractor struct {
+	DS           domain.DataStore
+	MutexManager domain.MutexManager
+	Seq          domain.DailySequence
+	Pusher       domain.Pusher
+}
+
+func NewWaitlistInteractor(
+	ds domain.DataStore,
+	mutexManager domain.MutexManager,
+	seq domain.DailySequence,
+	pusher domain.Pusher,
+) *WaitlistInteractor {
+	return &WaitlistInteractor{
+		DS:           ds,
+		MutexManager: mutexManager,
+		Seq:          seq,
+		Pusher:       pusher,
+	}
+}
+
+func (i *WaitlistInteractor) Queue(ctx context.Context, merchantID, storeID uuid.UUID) (res *domain.WaitlistQueue, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistInteractor.Queue")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	store, err := i.findStore(ctx, merchantID, storeID)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := ResolveRule(ctx, i.DS, store.MerchantID, store.ID)
+	if err != nil {
+		return nil, err
+	}
+	tickets, err := i.DS.WaitlistTicketRepo().List(ctx, domain.WaitlistTicketListParams{
+		StoreID:      store.ID,
+		BusinessDate: time.Now().Format(time.DateOnly),
+	})
+	if err != nil {
+		return nil, err
+	}
+	turnovers, err := i.turnovers(ctx, store.ID, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &domain.WaitlistQueue{
+		StoreID:       store.ID,
+		StoreName:     store.StoreName,
+		OnlineEnabled: rule.OnlineEnabled,
+	}
+	for _, bucket := range domain.WaitlistBuckets {
+		item := &domain.WaitlistQueueBucket{Bucket: bucket}
+		item.MinSize, item.MaxSize = rule.SizeRange(bucket)
+		var lastCalledAt time.Time
+		for _, t := range tickets {
+			if t.Bucket != bucket {
+				continue
+			}
+			if t.Active() {
+				item.Waiting++
+			}
+			if t.CalledAt != nil && t.CalledAt.After(lastCalledAt) {
+				lastCalledAt = *t.CalledAt
+				item.CalledTicketNo = t.TicketNo
+			}
+		}
+		item.EstimatedMinutes = turnovers[bucket].EstimateMinutes(item.Waiting + 1)
+		res.Buckets = append(res.Buckets, item)
+	}
+	return res, nil
+}
+
+// Take 取号：按人数确定分组，分组内按当日序号生成排队号
+func (i *WaitlistInteractor) Take(ctx context.Context, params domain.WaitlistTakeParams) (res *domain.WaitlistTicket, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistInteractor.Take")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	online := params.Customer != nil
+	if online && params.GuestPhone == "" {
+		params.GuestPhone = params.Customer.Phone
+	}
+	store, err := i.findStore(ctx, params.MerchantID, params.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := ResolveRule(ctx, i.DS, store.MerchantID, store.ID)
+	if err != nil {
+		return nil, err
+	}
+	if online {
+		if store.Status != domain.StoreStatusOpen {
+			return nil, domain.ParamsError(domain.ErrWaitlistStoreClosed)
+		}
+		if !rule.OnlineEnabled {
+			return nil, domain.ParamsError(domain.ErrWaitlistOnlineDisabled)
+		}
+	}
+	if params.PartySize < 1 || params.PartySize > rule.MaxPartySize {
+		return nil, domain.ParamsError(domain.ErrWaitlistPartySizeInvalid)
+	}
+
+	now := time.Now()
+	res = &domain.WaitlistTicket{
+		ID:           uuid.New(),
+		MerchantID:   store.MerchantID,
+		StoreID:      store.ID,
+		BusinessDate: now.Format(time.DateOnly),
+		Bucket:       rule.Bucket(params.PartySize),
+		PartySize:    params.PartySize,
+		GuestPhone:   strings.TrimSpace(params.GuestPhone),
+		Source:       domain.WaitlistTicketSourceStore,
+		Status:       domain.WaitlistTicketStatusWaiting,
+	}
+	if online {
+		res.Source = domain.WaitlistTicketSourceOnline
+		res.CustomerID = params.Customer.ID
+	}
+
+	err = i.withStore(ctx, store.ID, func() error {
+		// 顾客在同一门店同时只能持有一个排队号
+		if online {
+			existing, err := i.DS.WaitlistTicketRepo().List(ctx, domain.WaitlistTicketListParams{
+				StoreID:      store.ID,
+				CustomerID:   res.CustomerID,
+				BusinessDate: res.BusinessDate,
+				Statuses:     domain.WaitlistActiveStatuses,
+			})
+			if err != nil {
+				return err
+			}
+			if len(existing) > 0 {
+				return domain.ParamsError(domain.ErrWaitlistTicketExists)
+			}
+		}
+
+		n, err := i.Seq.Next(ctx, domain.WaitlistSequencePrefix(store.ID, res.BusinessDate, res.Bucket))
+		if err != nil {
+			return fmt.Errorf("failed to generate waitlist ticket_no: %w", err)
+		}
+		res.TicketNo = res.Bucket.FormatTicketNo(n)
+		return i.DS.WaitlistTicketRepo().Create(ctx, res)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.push(ctx, domain.PushEventWaitlistTaken, res)
+	return res, nil
+}
+
+func (i *WaitlistInteractor) List(ctx context.Context, merchantID, storeID uuid.UUID, businessDate string, statuses []domain.WaitlistTicketStatus) (res []*domain.WaitlistTicket, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistInteractor.List")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	if businessDate == "" {
+		businessDate = time.Now().Format(time.DateOnly)
+	}
+	if _, err = i.findStore(ctx, merchantID, storeID); err != nil {
+		return nil, err
+	}
+	return i.DS.WaitlistTicketRepo().List(ctx, domain.WaitlistTicketListParams{
+		StoreID:      storeID,
+		BusinessDate: businessDate,
+		Statuses:     statuses,
+	})
+}
+
+func (i *WaitlistInteractor) Call(ctx context.Context, merchantID, storeID, id uuid.UUID) (res *domain.WaitlistTicket, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistInteractor.Call")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	res, err = i.transition(ctx, id, ownedByStore(merchantID, storeID), func(t *domain.WaitlistTicket) error {
+		return t.Call(time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	i.push(ctx, domain.PushEventWaitlistCalled, res)
+	return res, nil
+}
+
+func (i *WaitlistInteractor) Skip(ctx context.Context, merchantID, storeID, id uuid.UUID) (res *domain.WaitlistTicket, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistInteractor.Skip")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	res, err = i.transition(ctx, id, ownedByStore(merchantID, storeID), func(t *domain.WaitlistTicket) error {
+		return t.Skip(time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	i.push(ctx, domain.PushEventWaitlistUpdated, res)
+	return res, nil
+}
+
+func (i *WaitlistInteractor) Cancel(ctx context.Context, merchantID, storeID, id uuid.UUID) (res *domain.WaitlistTicket, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistInteractor.Cancel")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	res, err = i.transition(ctx, id, ownedByStore(merchantID, storeID), func(t *domain.WaitlistTicket) error {
+		return t.Cancel(time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	i.push(ctx, domain.PushEventWaitlistUpdated, res)
+	return res, nil
+}
+
+// Seat 安排入座：在桌台开一笔空的堂食订单，顾客到桌后继续点餐
+func (i *WaitlistInteractor) Seat(ctx context.Context, merchantID, storeID, id, tableID uuid.UUID) (res *domain.WaitlistTicket, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistInteractor.Seat")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	owns := ownedByStore(merchantID, storeID)
+	res, err = i.find(ctx, id, owns)
+	if err != nil {
+		return nil, err
+	}
+
+	var order *domain.Order
+	err = i.withStore(ctx, res.StoreID, func() error {
+		// 加锁后重新读取，避免覆盖并发修改
+		res, err = i.find(ctx, id, owns)
+		if err != nil {
+			return err
+		}
+		if !res.Active() {
+			return domain.ParamsError(domain.ErrWaitlistStatusInvalid)
+		}
+
+		table, err := i.DS.DiningTableRepo().FindByID(ctx, tableID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return domain.ParamsError(domain.ErrWaitlistTableInvalid)
+			}
+			return err
+		}
+		if table.StoreID != res.StoreID || !table.Enabled || table.Seats < res.PartySize {
+			return domain.ParamsError(domain.ErrWaitlistTableInvalid)
+		}
+		if _, err = i.DS.OrderRepo().FindOpenByTableID(ctx, table.ID); err == nil {
+			return domain.ParamsError(domain.ErrWaitlistTableOccupied)
+		} else if !domain.IsNotFound(err) {
+			return err
+		}
+
+		now := time.Now()
+		order, err = i.newOrder(ctx, res, table, now)
+		if err != nil {
+			return err
+		}
+		if err = res.Seat(table, order.ID, now); err != nil {
+			return domain.ParamsError(err)
+		}
+		return i.DS.Atomic(ctx, func(ctx context.Context, ds domain.DataStore) error {
+			if err := ds.OrderRepo().Create(ctx, order); err != nil {
+				return err
+			}
+			return ds.WaitlistTicketRepo().Update(ctx, res)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.Pusher.Push(ctx, domain.PushEventOrderCreated, order, domain.OrderPushTopics(order)...)
+	i.push(ctx, domain.PushEventWaitlistUpdated, res)
+	return res, nil
+}
+
+func (i *WaitlistInteractor) GetStatus(ctx context.Context, id uuid.UUID) (res *domain.WaitlistTicketView, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistInteractor.GetStatus")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	t, err := i.find(ctx, id, func(*domain.WaitlistTicket) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	return i.view(ctx, t)
+}
+
+func (i *WaitlistInteractor) ListByCustomer(ctx context.Context, customerID uuid.UUID) (res []*domain.WaitlistTicketView, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistInteractor.ListByCustomer")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	tickets, err := i.DS.WaitlistTicketRepo().List(ctx, domain.WaitlistTicketListParams{
+		CustomerID:   customerID,
+		BusinessDate: time.Now().Format(time.DateOnly),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*domain.WaitlistTicketView, 0, len(tickets))
+	for _, t := range tickets {
+		v, err := i.view(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+func (i *WaitlistInteractor) CancelByCustomer(ctx context.Context, customerID, id uuid.UUID) (res *domain.WaitlistTicketView, err error) {
+	span, ctx := util.StartSpan(ctx, "usecase", "WaitlistInteractor.CancelByCustomer")
+	defer func() {
+		util.SpanErrFinish(span, err)
+	}()
+
+	owns := func(t *domain.WaitlistTicket) bool {
+		return t.CustomerID == customerID
+	}
+	t, err := i.transition(ctx, id, owns, func(t *domain.WaitlistTicket) error {
+		return t.Cancel(time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	i.push(ctx, domain.PushEventWaitlistUpdated, t)
+	return i.view(ctx, t)
+}
+
+// view 顾客端排队状态：排队中的号码计算前面的桌数和预计等待时间
+func (i *WaitlistInteractor) view(ctx context.Context, t *domain.WaitlistTicket) (*domain.WaitlistTicketView, error) {
+	store, err := i.findStore(ctx, uuid.Nil, t.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	res := &domain.WaitlistTicketView{
+		ID:        t.ID,
+		StoreID:   t.StoreID,
+		StoreName: store.StoreName,
+		Bucket:    t.Bucket,
+		TicketNo:  t.TicketNo,
+		PartySize: t.PartySize,
+		Status:    t.Status,
+		CalledAt:  t.CalledAt,
+		TableName: t.TableName,
+		CreatedAt: t.CreatedAt,
+	}
+	if t.Status != domain.WaitlistTicketStatusWaiting {
+		return res, nil
+	}
+
+	queue, err := i.DS.WaitlistTicketRepo().List(ctx, domain.WaitlistTicketListParams{
+		StoreID:      t.StoreID,
+		BusinessDate: t.BusinessDate,
+		Bucket:       t.Bucket,
+		Statuses:     domain.WaitlistActiveStatuses,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, q := range queue {
+		if q.ID == t.ID {
+			break
+		}
+		res.Ahead++
+	}
+
+	rule, err := ResolveRule(ctx, i.DS, store.MerchantID, store.ID)
+	if err != nil {
+		return nil, err
+	}
+	turnovers, err := i.turnovers(ctx, store.ID, rule)
+	if err != nil {
+		return nil, err
+	}
+	res.EstimatedMinutes = turnovers[t.Bucket].EstimateMinutes(res.Ahead + 1)
+	return res, nil
+}
+
+// turnovers 按分组统计门店近期的翻台情况
+func (i *WaitlistInteractor) turnovers(ctx context.Context, storeID uuid.UUID, rule *domain.WaitlistRule) (map[domain.WaitlistBucket]domain.WaitlistTurnover, error) {
+	tables, err := i.DS.DiningTableRepo().ListByStoreID(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	turns, err := i.DS.OrderRepo().ListTableTurns(ctx, storeID, time.Now().Add(-turnoverLookback), turnoverSamples)
+	if err != nil {
+		return nil, err
+	}
+	return domain.WaitlistTurnovers(rule, tables, turns), nil
+}
+
+// findStore 查询门店详情，merchantID 不为空时校验门店归属
+func (i *WaitlistInteractor) findStore(ctx context.Context, merchantID, storeID uuid.UUID) (*domain.Store, error) {
+	store, err := i.DS.StoreRepo().GetDetail(ctx, storeID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrStoreNotExists)
+		}
+		return nil, err
+	}
+	if merchantID != uuid.Nil && store.MerchantID != merchantID {
+		return nil, domain.ParamsError(domain.ErrStoreNotExists)
+	}
+	return store, nil
+}
+
+func (i *WaitlistInteractor) find(ctx context.Context, id uuid.UUID, owns func(t *domain.WaitlistTicket) bool) (*domain.WaitlistTicket, error) {
+	t, err := i.DS.WaitlistTicketRepo().FindByID(ctx, id)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil, domain.ParamsError(domain.ErrWaitlistTicketNotExists)
+		}
+		return nil, err
+	}
+	if !owns(t) {
+		return nil, domain.ParamsError(domain.ErrWaitlistTicketNotExists)
+	}
+	return t, nil
+}
+
+// transition 持有门店排队锁变更排队号状态
+func (i *WaitlistInteractor) transition(ctx context.Context, id uuid.UUID, owns func(t *domain.WaitlistTicket) bool, apply func(t *domain.WaitlistTicket) error) (*domain.WaitlistTicket, error) {
+	t, err := i.find(ctx, id, owns)
+	if err != nil {
+		return nil, err
+	}
+	err = i.withStore(ctx, t.StoreID, func() error {
+		t, err = i.find(ctx, id, owns)
+		if err != nil {
+			return err
+		}
+		if err = apply(t); err != nil {
+			return domain.ParamsError(err)
+		}
+		return i.DS.WaitlistTicketRepo().Update(ctx, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// withStore 持有门店排队锁执行，取号与状态变更串行进行
+func (i *WaitlistInteractor) withStore(ctx context.Context, storeID uuid.UUID, fn func() error) error {
+	mu := i.MutexManager.NewMutex(domain.NewMutexWaitlistKey(storeID))
+	if err := mu.Lock(ctx); err != nil {
+		if domain.IsAlreadyTakenError(err) {
+			return domain.ParamsError(domain.ErrWaitlistBusy)
+		}
+		return err
+	}
+	defer func() {
+		_, _ = mu.Unlock(ctx)
+	}()
+	return fn()
+}
+
+// push 完整排队号推送到门店，不含手机号的变更推送到门店排队主题供顾客端刷新
+func (i *WaitlistInteractor) push(ctx context.Context, event domain.PushEventType, t *domain.WaitlistTicket) {
+	i.Pusher.Push(ctx, event, t, domain.StorePushTopic(t.StoreID))
+	i.Pusher.Push(ctx, event, domain.NewWaitlistTicketPush(t), domain.WaitlistPushTopic(t.StoreID))
+}
+
+// newOrder 为入座的排队号开一笔空的堂食订单，扫码取号的顾客已是会员时关联会员
+func (i *WaitlistInteractor) newOrder(ctx context.Context, t *domain.WaitlistTicket, table *domain.DiningTable, now time.Time) (*domain.Order, error) {
+	store, err := i.findStore(ctx, t.MerchantID, t.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	orderNo, err := i.newOrderNo(ctx, store, now)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &domain.Order{
+		ID:            uuid.New(),
+		MerchantID:    store.MerchantID,
+		StoreID:       store.ID,
+		BusinessDate:  now.Format(time.DateOnly),
+		OrderNo:       orderNo,
+		OrderType:     domain.OrderTypeSale,
+		PlacedAt:      now,
+		DiningMode:    domain.DiningModeDineIn,
+		OrderStatus:   domain.OrderStatusPlaced,
+		PaymentStatus: domain.PaymentStatusUnpaid,
+		TableID:       table.ID,
+		TableName:     table.Name,
+		GuestCount:    t.PartySize,
+		Channel:       domain.ChannelPOS,
+		Store: domain.OrderStore{
+			ID:           store.ID,
+			MerchantID:   store.MerchantID,
+			StoreCode:    store.StoreCode,
+			ContactPhone: store.ContactPhone,
+			StoreName:    store.StoreName,
+		},
+	}
+	if store.Merchant != nil {
+		order.Store.MerchantName = store.Merchant.MerchantName
+	}
+
+	if t.CustomerID == uuid.Nil {
+		return order, nil
+	}
+	customer, err := i.DS.CustomerRepo().FindByID(ctx, t.CustomerID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return order, nil
+		}
+		return nil, err
+	}
+	order.PlacedBy = customer.ID
+	order.PlacedByName = customer.Nickname
+	m, err := i.DS.MemberRepo().FindByOpenID(ctx, store.MerchantID, customer.OpenID)
+	if err != nil {
+		if !domain.IsNotFound(err) {
+			return nil, err
+		}
+	} else if m.Enabled {
+		order.Member = m.ToOrderMember()
+	}
+	return order, nil
+}
+
+// newOrderNo 生成订单号：门店编码 + YYYYMMDD + 6位当日序号，与 POS 下单共用序号
+func (i *WaitlistInteractor) newOrderNo(ctx context.Context, store *domain.Store, now time.Time) (string, error) {
+	prefix := fmt.Sprintf("%s:%s", domain.DailySequencePrefixOrderNo, store.ID.String())
+	seq, err := i.Seq.Next(ctx, prefix)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate order_no: %w", err)
+	}
+	return fmt.Sprintf("%s%s%06d", store.StoreCode, now.Format("20060102"), seq), nil
+}
+
+func ownedByStore(merchantID, storeID uuid.UUID) func(t *domain.WaitlistTicket) bool {
+	return func(t *domain.WaitlistTicket) bool {
+		return t.MerchantID == merchantID && t.StoreID == storeID
+	}
+}